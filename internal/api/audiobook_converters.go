@@ -0,0 +1,137 @@
+package api
+
+import (
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+)
+
+func audiobookToOgen(b *audiobook.Book) ogen.Audiobook {
+	narrators := b.Narrators
+	if narrators == nil {
+		narrators = []string{}
+	}
+	genres := b.Genres
+	if genres == nil {
+		genres = []string{}
+	}
+	result := ogen.Audiobook{
+		ID:           b.ID,
+		Title:        b.Title,
+		AuthorCredit: b.AuthorCredit,
+		Narrators:    narrators,
+		Genres:       genres,
+		DurationMs:   b.DurationMs,
+		FileCount:    int(b.FileCount),
+		ChapterCount: int(b.ChapterCount),
+		CreatedAt:    b.CreatedAt,
+	}
+	if b.Subtitle != nil {
+		result.Subtitle = ogen.NewOptString(*b.Subtitle)
+	}
+	if b.SeriesID != nil {
+		result.SeriesID = ogen.NewOptUUID(*b.SeriesID)
+	}
+	if b.SeriesIndex != nil {
+		result.SeriesIndex = ogen.NewOptFloat32(*b.SeriesIndex)
+	}
+	if b.Description != nil {
+		result.Description = ogen.NewOptString(*b.Description)
+	}
+	if b.Publisher != nil {
+		result.Publisher = ogen.NewOptString(*b.Publisher)
+	}
+	if b.Year != nil {
+		result.Year = ogen.NewOptInt(int(*b.Year))
+	}
+	if b.Language != nil {
+		result.Language = ogen.NewOptString(*b.Language)
+	}
+	if b.ASIN != nil {
+		result.Asin = ogen.NewOptString(*b.ASIN)
+	}
+	if b.CoverPath != nil {
+		result.CoverPath = ogen.NewOptString(*b.CoverPath)
+	}
+	return result
+}
+
+func audiobooksToOgen(books []audiobook.Book) []ogen.Audiobook {
+	result := make([]ogen.Audiobook, len(books))
+	for i := range books {
+		result[i] = audiobookToOgen(&books[i])
+	}
+	return result
+}
+
+func audiobookAuthorsToOgen(authors []audiobook.Author) []ogen.AudiobookAuthor {
+	result := make([]ogen.AudiobookAuthor, len(authors))
+	for i, a := range authors {
+		result[i] = ogen.AudiobookAuthor{ID: a.ID, Name: a.Name, SortName: a.SortName}
+	}
+	return result
+}
+
+func audiobookSeriesToOgen(s *audiobook.Series) ogen.AudiobookSeries {
+	return ogen.AudiobookSeries{ID: s.ID, Name: s.Name, SortName: s.SortName}
+}
+
+func audiobookFilesToOgen(files []audiobook.BookFile) []ogen.AudiobookFile {
+	result := make([]ogen.AudiobookFile, len(files))
+	for i, f := range files {
+		result[i] = ogen.AudiobookFile{
+			ID:         f.ID,
+			Position:   int(f.Position),
+			DurationMs: f.DurationMs,
+			OffsetMs:   f.OffsetMs,
+			FileSize:   f.FileSize,
+		}
+		if f.Container != nil {
+			result[i].Container = ogen.NewOptString(*f.Container)
+		}
+	}
+	return result
+}
+
+func audiobookChapterToOgen(c *audiobook.Chapter) ogen.AudiobookChapter {
+	return ogen.AudiobookChapter{
+		Position: int(c.Position),
+		Title:    c.Title,
+		StartMs:  c.StartMs,
+		EndMs:    c.EndMs,
+	}
+}
+
+func audiobookChaptersToOgen(chapters []audiobook.Chapter) []ogen.AudiobookChapter {
+	result := make([]ogen.AudiobookChapter, len(chapters))
+	for i := range chapters {
+		result[i] = audiobookChapterToOgen(&chapters[i])
+	}
+	return result
+}
+
+// audiobookProgressToOgen converts listening progress. With the book's
+// files and chapters it also reports where the position falls.
+func audiobookProgressToOgen(p *audiobook.Progress, files []audiobook.BookFile, chapters []audiobook.Chapter) ogen.AudiobookProgress {
+	result := ogen.AudiobookProgress{
+		BookID:           p.BookID,
+		PositionMs:       p.PositionMs,
+		DurationMs:       p.DurationMs,
+		PlaybackSpeed:    p.PlaybackSpeed,
+		ProgressPercent:  float32(p.Percent()),
+		RemainingSeconds: int(p.Remaining().Seconds()),
+		IsCompleted:      p.IsCompleted,
+		ListenCount:      int(p.ListenCount),
+		LastListenedAt:   p.LastListenedAt,
+	}
+	if p.CompletedAt != nil {
+		result.CompletedAt = ogen.NewOptDateTime(*p.CompletedAt)
+	}
+	if file, position := audiobook.FileAt(files, p.PositionMs); file != nil {
+		result.FileID = ogen.NewOptUUID(file.ID)
+		result.FilePositionMs = ogen.NewOptInt64(position)
+	}
+	if chapter := audiobook.ChapterAt(chapters, p.PositionMs); chapter != nil {
+		result.Chapter = ogen.NewOptAudiobookChapter(audiobookChapterToOgen(chapter))
+	}
+	return result
+}
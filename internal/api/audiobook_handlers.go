@@ -0,0 +1,345 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ListAudiobooks returns a paginated list of audiobooks.
+func (h *Handler) ListAudiobooks(ctx context.Context, params ogen.ListAudiobooksParams) (ogen.ListAudiobooksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return &ogen.AudiobookListResponse{Items: []ogen.Audiobook{}}, nil
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+
+	books, total, err := h.audiobookService.ListBooks(ctx, audiobook.BookListFilters{
+		LibraryPaths: libraryPaths,
+		OrderBy:      string(params.OrderBy.Or(ogen.ListAudiobooksOrderByAdded)),
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.AudiobookListResponse{
+		Items: audiobooksToOgen(books),
+		Total: total,
+	}, nil
+}
+
+// GetAudiobook returns an audiobook with its authors, series, files,
+// chapters and the caller's listening progress.
+func (h *Handler) GetAudiobook(ctx context.Context, params ogen.GetAudiobookParams) (ogen.GetAudiobookRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return (*ogen.GetAudiobookNotFound)(OgenNotFound("Audiobook not found")), nil
+	}
+
+	book, err := h.accessibleAudiobook(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, audiobook.ErrBookNotFound) {
+			return (*ogen.GetAudiobookNotFound)(OgenNotFound("Audiobook not found")), nil
+		}
+		return nil, err
+	}
+	authors, err := h.audiobookService.GetBookAuthors(ctx, book.ID)
+	if err != nil {
+		return nil, err
+	}
+	files, err := h.audiobookService.GetBookFiles(ctx, book.ID)
+	if err != nil {
+		return nil, err
+	}
+	chapters, err := h.audiobookService.GetBookChapters(ctx, book.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ogen.AudiobookDetail{
+		Book:     audiobookToOgen(book),
+		Authors:  audiobookAuthorsToOgen(authors),
+		Files:    audiobookFilesToOgen(files),
+		Chapters: audiobookChaptersToOgen(chapters),
+	}
+	if book.SeriesID != nil {
+		if series, err := h.audiobookService.GetSeries(ctx, *book.SeriesID); err == nil {
+			result.Series = ogen.NewOptAudiobookSeries(audiobookSeriesToOgen(series))
+		}
+	}
+	if progress, err := h.audiobookService.GetProgress(ctx, userID, book.ID); err == nil {
+		result.Progress = ogen.NewOptAudiobookProgress(audiobookProgressToOgen(progress, files, chapters))
+	}
+	return result, nil
+}
+
+// GetContinueListening returns the caller's started, unfinished audiobooks.
+func (h *Handler) GetContinueListening(ctx context.Context, params ogen.GetContinueListeningParams) (ogen.GetContinueListeningRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []ogen.AudiobookContinueListeningItem{}
+	if h.audiobookService == nil {
+		return (*ogen.GetContinueListeningOKApplicationJSON)(&result), nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.audiobookService.GetContinueListening(ctx, userID, libraryPaths, util.SafeIntToInt32(params.Limit.Or(10)))
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		result = append(result, ogen.AudiobookContinueListeningItem{
+			Book:     audiobookToOgen(&items[i].Book),
+			Progress: audiobookProgressToOgen(&items[i].Progress, nil, nil),
+		})
+	}
+	return (*ogen.GetContinueListeningOKApplicationJSON)(&result), nil
+}
+
+// ListAudiobookAuthors returns a paginated list of authors.
+func (h *Handler) ListAudiobookAuthors(ctx context.Context, params ogen.ListAudiobookAuthorsParams) (ogen.ListAudiobookAuthorsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return &ogen.AudiobookAuthorListResponse{Items: []ogen.AudiobookAuthor{}}, nil
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+
+	authors, total, err := h.audiobookService.ListAuthors(ctx, audiobook.AuthorListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.AudiobookAuthorListResponse{
+		Items: audiobookAuthorsToOgen(authors),
+		Total: total,
+	}, nil
+}
+
+// GetAudiobookAuthorBooks returns the audiobooks of an author.
+func (h *Handler) GetAudiobookAuthorBooks(ctx context.Context, params ogen.GetAudiobookAuthorBooksParams) (ogen.GetAudiobookAuthorBooksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return (*ogen.GetAudiobookAuthorBooksNotFound)(OgenNotFound("Author not found")), nil
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := h.audiobookService.ListAuthorBooks(ctx, params.ID, libraryPaths)
+	if err != nil {
+		if errors.Is(err, audiobook.ErrAuthorNotFound) {
+			return (*ogen.GetAudiobookAuthorBooksNotFound)(OgenNotFound("Author not found")), nil
+		}
+		return nil, err
+	}
+
+	result := audiobooksToOgen(books)
+	return (*ogen.GetAudiobookAuthorBooksOKApplicationJSON)(&result), nil
+}
+
+// ListAudiobookSeries returns a paginated list of series.
+func (h *Handler) ListAudiobookSeries(ctx context.Context, params ogen.ListAudiobookSeriesParams) (ogen.ListAudiobookSeriesRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return &ogen.AudiobookSeriesListResponse{Items: []ogen.AudiobookSeries{}}, nil
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+
+	series, total, err := h.audiobookService.ListSeries(ctx, audiobook.SeriesListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ogen.AudiobookSeries, len(series))
+	for i := range series {
+		items[i] = audiobookSeriesToOgen(&series[i])
+	}
+	return &ogen.AudiobookSeriesListResponse{Items: items, Total: total}, nil
+}
+
+// GetAudiobookSeriesBooks returns the audiobooks of a series in series order.
+func (h *Handler) GetAudiobookSeriesBooks(ctx context.Context, params ogen.GetAudiobookSeriesBooksParams) (ogen.GetAudiobookSeriesBooksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return (*ogen.GetAudiobookSeriesBooksNotFound)(OgenNotFound("Series not found")), nil
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := h.audiobookService.ListSeriesBooks(ctx, params.ID, libraryPaths)
+	if err != nil {
+		if errors.Is(err, audiobook.ErrSeriesNotFound) {
+			return (*ogen.GetAudiobookSeriesBooksNotFound)(OgenNotFound("Series not found")), nil
+		}
+		return nil, err
+	}
+
+	result := audiobooksToOgen(books)
+	return (*ogen.GetAudiobookSeriesBooksOKApplicationJSON)(&result), nil
+}
+
+// GetAudiobookProgress returns the caller's listening position in a book.
+func (h *Handler) GetAudiobookProgress(ctx context.Context, params ogen.GetAudiobookProgressParams) (ogen.GetAudiobookProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return (*ogen.GetAudiobookProgressNotFound)(OgenNotFound("Progress not found")), nil
+	}
+	if _, err := h.accessibleAudiobook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, audiobook.ErrBookNotFound) {
+			return (*ogen.GetAudiobookProgressNotFound)(OgenNotFound("Progress not found")), nil
+		}
+		return nil, err
+	}
+
+	progress, err := h.audiobookService.GetProgress(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, audiobook.ErrProgressNotFound) {
+			return (*ogen.GetAudiobookProgressNotFound)(OgenNotFound("Progress not found")), nil
+		}
+		return nil, err
+	}
+	return h.audiobookProgressWithPosition(ctx, progress)
+}
+
+// UpdateAudiobookProgress records the caller's listening position.
+func (h *Handler) UpdateAudiobookProgress(ctx context.Context, req *ogen.AudiobookProgressUpdate, params ogen.UpdateAudiobookProgressParams) (ogen.UpdateAudiobookProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return (*ogen.UpdateAudiobookProgressNotFound)(OgenNotFound("Audiobook not found")), nil
+	}
+	if _, err := h.accessibleAudiobook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, audiobook.ErrBookNotFound) {
+			return (*ogen.UpdateAudiobookProgressNotFound)(OgenNotFound("Audiobook not found")), nil
+		}
+		return nil, err
+	}
+
+	update := audiobook.ProgressUpdate{
+		PositionMs:    req.PositionMs,
+		PlaybackSpeed: req.PlaybackSpeed.Or(audiobook.DefaultPlaybackSpeed),
+	}
+	if fileID, ok := req.FileID.Get(); ok {
+		update.FileID = &fileID
+	}
+
+	progress, err := h.audiobookService.UpdateProgress(ctx, userID, params.ID, update)
+	switch {
+	case errors.Is(err, audiobook.ErrBookNotFound):
+		return (*ogen.UpdateAudiobookProgressNotFound)(OgenNotFound("Audiobook not found")), nil
+	case errors.Is(err, audiobook.ErrBookFileNotFound):
+		return (*ogen.UpdateAudiobookProgressNotFound)(OgenNotFound("File not found in this audiobook")), nil
+	case errors.Is(err, audiobook.ErrInvalidPlaybackSpeed):
+		return (*ogen.UpdateAudiobookProgressBadRequest)(OgenBadRequest(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	return h.audiobookProgressWithPosition(ctx, progress)
+}
+
+// DeleteAudiobookProgress removes the caller's listening progress.
+func (h *Handler) DeleteAudiobookProgress(ctx context.Context, params ogen.DeleteAudiobookProgressParams) (ogen.DeleteAudiobookProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.audiobookService == nil {
+		return (*ogen.DeleteAudiobookProgressNotFound)(OgenNotFound("Audiobook not found")), nil
+	}
+
+	if err := h.audiobookService.DeleteProgress(ctx, userID, params.ID); err != nil {
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	return &ogen.DeleteAudiobookProgressNoContent{}, nil
+}
+
+// audiobookProgressWithPosition converts progress including the file and
+// chapter playing at the position.
+func (h *Handler) audiobookProgressWithPosition(ctx context.Context, progress *audiobook.Progress) (*ogen.AudiobookProgress, error) {
+	files, err := h.audiobookService.GetBookFiles(ctx, progress.BookID)
+	if err != nil {
+		return nil, err
+	}
+	chapters, err := h.audiobookService.GetBookChapters(ctx, progress.BookID)
+	if err != nil {
+		return nil, err
+	}
+	result := audiobookProgressToOgen(progress, files, chapters)
+	return &result, nil
+}
+
+// accessibleAudiobook returns a book if its files are in an audiobook
+// library the user can access, and audiobook.ErrBookNotFound otherwise.
+func (h *Handler) accessibleAudiobook(ctx context.Context, userID, bookID uuid.UUID) (*audiobook.Book, error) {
+	book, err := h.audiobookService.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeAudiobook)
+	if err != nil {
+		return nil, err
+	}
+	if !inLibraryPaths(book.Path, libraryPaths) {
+		return nil, audiobook.ErrBookNotFound
+	}
+	return book, nil
+}
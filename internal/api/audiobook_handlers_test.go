@@ -0,0 +1,265 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// audiobookMockService is a minimal mock for audiobook.Service used by the audiobook handler tests.
+type audiobookMockService struct {
+	audiobook.Service
+	books    map[uuid.UUID]*audiobook.Book
+	files    []audiobook.BookFile
+	chapters []audiobook.Chapter
+	progress *audiobook.Progress
+	update   *audiobook.ProgressUpdate
+
+	bookFilters   audiobook.BookListFilters
+	continuePaths []string
+}
+
+func (m *audiobookMockService) GetBook(_ context.Context, id uuid.UUID) (*audiobook.Book, error) {
+	if b, ok := m.books[id]; ok {
+		return b, nil
+	}
+	return nil, audiobook.ErrBookNotFound
+}
+
+func (m *audiobookMockService) GetBookAuthors(_ context.Context, _ uuid.UUID) ([]audiobook.Author, error) {
+	return []audiobook.Author{{ID: uuid.New(), Name: "Andy Weir", SortName: "Weir, Andy"}}, nil
+}
+
+func (m *audiobookMockService) GetBookFiles(_ context.Context, _ uuid.UUID) ([]audiobook.BookFile, error) {
+	return m.files, nil
+}
+
+func (m *audiobookMockService) GetBookChapters(_ context.Context, _ uuid.UUID) ([]audiobook.Chapter, error) {
+	return m.chapters, nil
+}
+
+func (m *audiobookMockService) GetProgress(_ context.Context, _, _ uuid.UUID) (*audiobook.Progress, error) {
+	if m.progress == nil {
+		return nil, audiobook.ErrProgressNotFound
+	}
+	return m.progress, nil
+}
+
+func (m *audiobookMockService) UpdateProgress(_ context.Context, userID, bookID uuid.UUID, update audiobook.ProgressUpdate) (*audiobook.Progress, error) {
+	m.update = &update
+	if _, ok := m.books[bookID]; !ok {
+		return nil, audiobook.ErrBookNotFound
+	}
+	if update.PlaybackSpeed > audiobook.MaxPlaybackSpeed {
+		return nil, fmt.Errorf("%w: %.2f", audiobook.ErrInvalidPlaybackSpeed, update.PlaybackSpeed)
+	}
+	return &audiobook.Progress{
+		UserID:        userID,
+		BookID:        bookID,
+		PositionMs:    update.PositionMs,
+		DurationMs:    m.books[bookID].DurationMs,
+		PlaybackSpeed: update.PlaybackSpeed,
+	}, nil
+}
+
+func (m *audiobookMockService) ListBooks(_ context.Context, filters audiobook.BookListFilters) ([]audiobook.Book, int64, error) {
+	m.bookFilters = filters
+	return nil, 0, nil
+}
+
+func (m *audiobookMockService) GetContinueListening(_ context.Context, _ uuid.UUID, libraryPaths []string, _ int32) ([]audiobook.ContinueListeningItem, error) {
+	m.continuePaths = libraryPaths
+	var items []audiobook.ContinueListeningItem
+	for _, b := range m.books {
+		items = append(items, audiobook.ContinueListeningItem{Book: *b, Progress: *m.progress})
+	}
+	return items, nil
+}
+
+// newAudiobookMockService returns a two-file book with a chapter per file.
+func newAudiobookMockService(bookID uuid.UUID) *audiobookMockService {
+	return &audiobookMockService{
+		books: map[uuid.UUID]*audiobook.Book{
+			bookID: {ID: bookID, Title: "Project Hail Mary", AuthorCredit: "Andy Weir", DurationMs: 7_200_000, FileCount: 2, ChapterCount: 2},
+		},
+		files: []audiobook.BookFile{
+			{ID: uuid.New(), BookID: bookID, Position: 1, OffsetMs: 0, DurationMs: 3_600_000},
+			{ID: uuid.New(), BookID: bookID, Position: 2, OffsetMs: 3_600_000, DurationMs: 3_600_000},
+		},
+		chapters: []audiobook.Chapter{
+			{Position: 1, Title: "Chapter 1", StartMs: 0, EndMs: 3_600_000},
+			{Position: 2, Title: "Chapter 2", StartMs: 3_600_000, EndMs: 7_200_000},
+		},
+	}
+}
+
+func TestHandler_GetAudiobook(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	svc := newAudiobookMockService(bookID)
+	svc.progress = &audiobook.Progress{BookID: bookID, PositionMs: 4_000_000, DurationMs: 7_200_000, PlaybackSpeed: 1.5, LastListenedAt: time.Now()}
+	handler := &Handler{logger: logging.NewTestLogger(), audiobookService: svc}
+
+	ctx := contextWithUserID(context.Background(), uuid.New())
+	result, err := handler.GetAudiobook(ctx, ogen.GetAudiobookParams{ID: bookID})
+	require.NoError(t, err)
+
+	detail, ok := result.(*ogen.AudiobookDetail)
+	require.True(t, ok, "expected *ogen.AudiobookDetail, got %T", result)
+	assert.Equal(t, "Project Hail Mary", detail.Book.Title)
+	require.Len(t, detail.Authors, 1)
+	assert.Len(t, detail.Files, 2)
+	assert.Len(t, detail.Chapters, 2)
+
+	progress, ok := detail.Progress.Get()
+	require.True(t, ok)
+	assert.Equal(t, svc.files[1].ID, progress.FileID.Or(uuid.Nil))
+	assert.Equal(t, int64(400_000), progress.FilePositionMs.Or(0))
+	assert.Equal(t, "Chapter 2", progress.Chapter.Or(ogen.AudiobookChapter{}).Title)
+	assert.Equal(t, 2133, progress.RemainingSeconds, "remaining time accounts for playback speed")
+}
+
+func TestHandler_GetAudiobook_NotFound(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger(), audiobookService: newAudiobookMockService(uuid.New())}
+
+	result, err := handler.GetAudiobook(contextWithUserID(context.Background(), uuid.New()), ogen.GetAudiobookParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetAudiobookNotFound)
+	assert.True(t, ok, "expected *ogen.GetAudiobookNotFound, got %T", result)
+}
+
+func TestHandler_UpdateAudiobookProgress(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	svc := newAudiobookMockService(bookID)
+	handler := &Handler{logger: logging.NewTestLogger(), audiobookService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	fileID := svc.files[1].ID
+	result, err := handler.UpdateAudiobookProgress(ctx, &ogen.AudiobookProgressUpdate{
+		PositionMs: 3_700_000,
+		FileID:     ogen.NewOptUUID(fileID),
+	}, ogen.UpdateAudiobookProgressParams{ID: bookID})
+	require.NoError(t, err)
+
+	progress, ok := result.(*ogen.AudiobookProgress)
+	require.True(t, ok, "expected *ogen.AudiobookProgress, got %T", result)
+	assert.Equal(t, int64(3_700_000), progress.PositionMs)
+	require.NotNil(t, svc.update)
+	assert.Equal(t, &fileID, svc.update.FileID)
+	assert.Equal(t, audiobook.DefaultPlaybackSpeed, svc.update.PlaybackSpeed, "speed defaults to normal")
+}
+
+func TestHandler_UpdateAudiobookProgress_Errors(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), audiobookService: newAudiobookMockService(bookID)}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.UpdateAudiobookProgress(ctx, &ogen.AudiobookProgressUpdate{
+		PositionMs:    1000,
+		PlaybackSpeed: ogen.NewOptFloat32(8),
+	}, ogen.UpdateAudiobookProgressParams{ID: bookID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.UpdateAudiobookProgressBadRequest)
+	assert.True(t, ok, "expected *ogen.UpdateAudiobookProgressBadRequest, got %T", result)
+
+	result, err = handler.UpdateAudiobookProgress(ctx, &ogen.AudiobookProgressUpdate{PositionMs: 1000},
+		ogen.UpdateAudiobookProgressParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UpdateAudiobookProgressNotFound)
+	assert.True(t, ok, "expected *ogen.UpdateAudiobookProgressNotFound, got %T", result)
+}
+
+func TestHandler_GetContinueListening(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	svc := newAudiobookMockService(bookID)
+	svc.progress = &audiobook.Progress{BookID: bookID, PositionMs: 600_000, DurationMs: 7_200_000, PlaybackSpeed: 1}
+	handler := &Handler{logger: logging.NewTestLogger(), audiobookService: svc}
+
+	result, err := handler.GetContinueListening(contextWithUserID(context.Background(), uuid.New()), ogen.GetContinueListeningParams{})
+	require.NoError(t, err)
+
+	items, ok := result.(*ogen.GetContinueListeningOKApplicationJSON)
+	require.True(t, ok, "expected *ogen.GetContinueListeningOKApplicationJSON, got %T", result)
+	require.Len(t, *items, 1)
+	assert.Equal(t, "Project Hail Mary", (*items)[0].Book.Title)
+	assert.Equal(t, int64(600_000), (*items)[0].Progress.PositionMs)
+}
+
+func TestHandler_Audiobook_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListAudiobooks(ctx, ogen.ListAudiobooksParams{})
+	require.NoError(t, err)
+	assert.Empty(t, list.(*ogen.AudiobookListResponse).Items)
+
+	result, err := handler.GetAudiobook(ctx, ogen.GetAudiobookParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetAudiobookNotFound)
+	assert.True(t, ok)
+
+	continueListening, err := handler.GetContinueListening(ctx, ogen.GetContinueListeningParams{})
+	require.NoError(t, err)
+	assert.Empty(t, *continueListening.(*ogen.GetContinueListeningOKApplicationJSON))
+}
+
+func TestHandler_Audiobook_LibraryAccess(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	shared := library.Library{ID: uuid.New(), Name: "Shared", Type: library.LibraryTypeAudiobook, Paths: []string{"/audiobooks/shared"}}
+	private := library.Library{ID: uuid.New(), Name: "Private", Type: library.LibraryTypeAudiobook, Paths: []string{"/audiobooks/private"}}
+
+	bookID := uuid.New()
+	svc := newAudiobookMockService(bookID)
+	svc.books[bookID].Path = "/audiobooks/private/Project Hail Mary"
+	handler := &Handler{
+		logger:           logging.NewTestLogger(),
+		audiobookService: svc,
+		libraryService:   newStubLibraryService([]library.Library{shared, private}, map[uuid.UUID][]uuid.UUID{userID: {shared.ID}}),
+	}
+	ctx := contextWithUserID(context.Background(), userID)
+
+	_, err := handler.ListAudiobooks(ctx, ogen.ListAudiobooksParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/audiobooks/shared/"}, svc.bookFilters.LibraryPaths)
+
+	svc.progress = &audiobook.Progress{BookID: bookID}
+	_, err = handler.GetContinueListening(ctx, ogen.GetContinueListeningParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/audiobooks/shared/"}, svc.continuePaths)
+
+	book, err := handler.GetAudiobook(ctx, ogen.GetAudiobookParams{ID: bookID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetAudiobookNotFound{}, book)
+
+	progress, err := handler.UpdateAudiobookProgress(ctx, &ogen.AudiobookProgressUpdate{PositionMs: 1000},
+		ogen.UpdateAudiobookProgressParams{ID: bookID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UpdateAudiobookProgressNotFound{}, progress)
+	assert.Nil(t, svc.update, "progress is not recorded for an inaccessible book")
+
+	err = handler.checkPlaybackAccess(ctx, userID, &ogen.StartPlaybackRequest{MediaType: ogen.StartPlaybackRequestMediaTypeAudiobook, MediaID: bookID})
+	assert.ErrorIs(t, err, errLibraryDenied)
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/book"
+)
+
+func bookToOgen(b *book.Book) ogen.Book {
+	genres := b.Genres
+	if genres == nil {
+		genres = []string{}
+	}
+	result := ogen.Book{
+		ID:           b.ID,
+		Kind:         ogen.BookKind(b.Kind),
+		Format:       ogen.BookFormat(b.Format),
+		Title:        b.Title,
+		AuthorCredit: b.AuthorCredit,
+		Genres:       genres,
+		PageCount:    int(b.PageCount),
+		RightToLeft:  b.RightToLeft,
+		HasCover:     b.HasCover(),
+		FileSize:     b.FileSize,
+		CreatedAt:    b.CreatedAt,
+	}
+	if b.Subtitle != nil {
+		result.Subtitle = ogen.NewOptString(*b.Subtitle)
+	}
+	if b.SeriesID != nil {
+		result.SeriesID = ogen.NewOptUUID(*b.SeriesID)
+	}
+	if b.SeriesIndex != nil {
+		result.SeriesIndex = ogen.NewOptFloat32(*b.SeriesIndex)
+	}
+	if b.Description != nil {
+		result.Description = ogen.NewOptString(*b.Description)
+	}
+	if b.Publisher != nil {
+		result.Publisher = ogen.NewOptString(*b.Publisher)
+	}
+	if b.Year != nil {
+		result.Year = ogen.NewOptInt(int(*b.Year))
+	}
+	if b.Language != nil {
+		result.Language = ogen.NewOptString(*b.Language)
+	}
+	if b.ISBN != nil {
+		result.Isbn = ogen.NewOptString(*b.ISBN)
+	}
+	return result
+}
+
+func booksToOgen(books []book.Book) []ogen.Book {
+	result := make([]ogen.Book, len(books))
+	for i := range books {
+		result[i] = bookToOgen(&books[i])
+	}
+	return result
+}
+
+func bookAuthorsToOgen(authors []book.Author) []ogen.BookAuthor {
+	result := make([]ogen.BookAuthor, len(authors))
+	for i, a := range authors {
+		result[i] = ogen.BookAuthor{ID: a.ID, Name: a.Name, SortName: a.SortName}
+	}
+	return result
+}
+
+func bookSeriesToOgen(s *book.Series) ogen.BookSeries {
+	return ogen.BookSeries{ID: s.ID, Name: s.Name, SortName: s.SortName}
+}
+
+func bookProgressToOgen(p *book.Progress) ogen.BookProgress {
+	result := ogen.BookProgress{
+		BookID:          p.BookID,
+		Page:            int(p.Page),
+		ProgressPercent: p.Percent,
+		IsCompleted:     p.IsCompleted,
+		ReadCount:       int(p.ReadCount),
+		LastReadAt:      p.LastReadAt,
+	}
+	if p.Locator != nil {
+		result.Locator = ogen.NewOptString(*p.Locator)
+	}
+	if p.CompletedAt != nil {
+		result.CompletedAt = ogen.NewOptDateTime(*p.CompletedAt)
+	}
+	return result
+}
@@ -0,0 +1,444 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ListBooks returns a paginated list of books and comics.
+func (h *Handler) ListBooks(ctx context.Context, params ogen.ListBooksParams) (ogen.ListBooksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return &ogen.BookListResponse{Items: []ogen.Book{}}, nil
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	books, total, err := h.bookService.ListBooks(ctx, book.BookListFilters{
+		Kind:         book.Kind(params.Kind.Or("")),
+		Query:        params.Q.Or(""),
+		LibraryPaths: libraryPaths,
+		OrderBy:      string(params.OrderBy.Or(ogen.ListBooksOrderByAdded)),
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.BookListResponse{
+		Items: booksToOgen(books),
+		Total: total,
+	}, nil
+}
+
+// GetBook returns a book with its authors, series and the caller's reading
+// progress.
+func (h *Handler) GetBook(ctx context.Context, params ogen.GetBookParams) (ogen.GetBookRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.GetBookNotFound)(OgenNotFound("Book not found")), nil
+	}
+
+	b, err := h.accessibleBook(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.GetBookNotFound)(OgenNotFound("Book not found")), nil
+		}
+		return nil, err
+	}
+	authors, err := h.bookService.GetBookAuthors(ctx, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ogen.BookDetail{
+		Book:    bookToOgen(b),
+		Authors: bookAuthorsToOgen(authors),
+	}
+	if b.SeriesID != nil {
+		if series, err := h.bookService.GetSeries(ctx, *b.SeriesID); err == nil {
+			result.Series = ogen.NewOptBookSeries(bookSeriesToOgen(series))
+		}
+	}
+	if progress, err := h.bookService.GetProgress(ctx, userID, b.ID); err == nil {
+		result.Progress = ogen.NewOptBookProgress(bookProgressToOgen(progress))
+	}
+	return result, nil
+}
+
+// GetContinueReading returns the caller's started, unfinished books.
+func (h *Handler) GetContinueReading(ctx context.Context, params ogen.GetContinueReadingParams) (ogen.GetContinueReadingRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []ogen.BookContinueReadingItem{}
+	if h.bookService == nil {
+		return (*ogen.GetContinueReadingOKApplicationJSON)(&result), nil
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.bookService.GetContinueReading(ctx, userID,
+		book.Kind(params.Kind.Or("")), libraryPaths, util.SafeIntToInt32(params.Limit.Or(10)))
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		result = append(result, ogen.BookContinueReadingItem{
+			Book:     bookToOgen(&items[i].Book),
+			Progress: bookProgressToOgen(&items[i].Progress),
+		})
+	}
+	return (*ogen.GetContinueReadingOKApplicationJSON)(&result), nil
+}
+
+// ListBookAuthors returns a paginated list of authors.
+func (h *Handler) ListBookAuthors(ctx context.Context, params ogen.ListBookAuthorsParams) (ogen.ListBookAuthorsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return &ogen.BookAuthorListResponse{Items: []ogen.BookAuthor{}}, nil
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	authors, total, err := h.bookService.ListAuthors(ctx, book.AuthorListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.BookAuthorListResponse{
+		Items: bookAuthorsToOgen(authors),
+		Total: total,
+	}, nil
+}
+
+// GetBookAuthorBooks returns the books of an author.
+func (h *Handler) GetBookAuthorBooks(ctx context.Context, params ogen.GetBookAuthorBooksParams) (ogen.GetBookAuthorBooksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.GetBookAuthorBooksNotFound)(OgenNotFound("Author not found")), nil
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := h.bookService.ListAuthorBooks(ctx, params.ID, libraryPaths)
+	if err != nil {
+		if errors.Is(err, book.ErrAuthorNotFound) {
+			return (*ogen.GetBookAuthorBooksNotFound)(OgenNotFound("Author not found")), nil
+		}
+		return nil, err
+	}
+
+	result := booksToOgen(books)
+	return (*ogen.GetBookAuthorBooksOKApplicationJSON)(&result), nil
+}
+
+// ListBookSeries returns a paginated list of series.
+func (h *Handler) ListBookSeries(ctx context.Context, params ogen.ListBookSeriesParams) (ogen.ListBookSeriesRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return &ogen.BookSeriesListResponse{Items: []ogen.BookSeries{}}, nil
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	series, total, err := h.bookService.ListSeries(ctx, book.SeriesListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ogen.BookSeries, len(series))
+	for i := range series {
+		items[i] = bookSeriesToOgen(&series[i])
+	}
+	return &ogen.BookSeriesListResponse{Items: items, Total: total}, nil
+}
+
+// GetBookSeriesBooks returns the books of a series in series order.
+func (h *Handler) GetBookSeriesBooks(ctx context.Context, params ogen.GetBookSeriesBooksParams) (ogen.GetBookSeriesBooksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.GetBookSeriesBooksNotFound)(OgenNotFound("Series not found")), nil
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := h.bookService.ListSeriesBooks(ctx, params.ID, libraryPaths)
+	if err != nil {
+		if errors.Is(err, book.ErrSeriesNotFound) {
+			return (*ogen.GetBookSeriesBooksNotFound)(OgenNotFound("Series not found")), nil
+		}
+		return nil, err
+	}
+
+	result := booksToOgen(books)
+	return (*ogen.GetBookSeriesBooksOKApplicationJSON)(&result), nil
+}
+
+// GetBookCover returns the cover thumbnail of a book.
+func (h *Handler) GetBookCover(ctx context.Context, params ogen.GetBookCoverParams) (ogen.GetBookCoverRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.GetBookCoverNotFound)(OgenNotFound("Cover not found")), nil
+	}
+
+	if _, err := h.accessibleBook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.GetBookCoverNotFound)(OgenNotFound("Book not found")), nil
+		}
+		return nil, err
+	}
+
+	cover, err := h.bookService.GetCover(ctx, params.ID)
+	switch {
+	case errors.Is(err, book.ErrBookNotFound):
+		return (*ogen.GetBookCoverNotFound)(OgenNotFound("Book not found")), nil
+	case errors.Is(err, book.ErrCoverNotFound):
+		return (*ogen.GetBookCoverNotFound)(OgenNotFound("Cover not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	if cover.ContentType == "image/png" {
+		return &ogen.GetBookCoverOKImagePNG{Data: bytes.NewReader(cover.Data)}, nil
+	}
+	return &ogen.GetBookCoverOKImageJpeg{Data: bytes.NewReader(cover.Data)}, nil
+}
+
+// GetBookPage returns a page image of a comic.
+func (h *Handler) GetBookPage(ctx context.Context, params ogen.GetBookPageParams) (ogen.GetBookPageRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.GetBookPageNotFound)(OgenNotFound("Page not found")), nil
+	}
+
+	if _, err := h.accessibleBook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.GetBookPageNotFound)(OgenNotFound("Book not found")), nil
+		}
+		return nil, err
+	}
+
+	page, err := h.bookService.GetPage(ctx, params.ID, params.Page)
+	switch {
+	case errors.Is(err, book.ErrBookNotFound):
+		return (*ogen.GetBookPageNotFound)(OgenNotFound("Book not found")), nil
+	case errors.Is(err, book.ErrPageNotFound):
+		return (*ogen.GetBookPageNotFound)(OgenNotFound("Page not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	data := bytes.NewReader(page.Data)
+	switch page.ContentType {
+	case "image/png":
+		return &ogen.GetBookPageOKImagePNG{Data: data}, nil
+	case "image/webp":
+		return &ogen.GetBookPageOKImageWEBP{Data: data}, nil
+	case "image/gif":
+		return &ogen.GetBookPageOKImageGIF{Data: data}, nil
+	default:
+		return &ogen.GetBookPageOKImageJpeg{Data: data}, nil
+	}
+}
+
+// DownloadBookFile streams the original book file.
+func (h *Handler) DownloadBookFile(ctx context.Context, params ogen.DownloadBookFileParams) (ogen.DownloadBookFileRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.DownloadBookFileNotFound)(OgenNotFound("Book not found")), nil
+	}
+
+	if _, err := h.accessibleBook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.DownloadBookFileNotFound)(OgenNotFound("Book not found")), nil
+		}
+		return nil, err
+	}
+
+	b, f, err := h.bookService.OpenFile(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.DownloadBookFileNotFound)(OgenNotFound("Book not found")), nil
+		}
+		return nil, err
+	}
+
+	// The response encoder closes the file once it is sent
+	return &ogen.DownloadBookFileOKHeaders{
+		ContentDisposition: ogen.NewOptString(bookContentDisposition(b)),
+		Response:           ogen.DownloadBookFileOK{Data: f},
+	}, nil
+}
+
+// GetBookProgress returns the caller's reading position in a book.
+func (h *Handler) GetBookProgress(ctx context.Context, params ogen.GetBookProgressParams) (ogen.GetBookProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.GetBookProgressNotFound)(OgenNotFound("Progress not found")), nil
+	}
+
+	if _, err := h.accessibleBook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.GetBookProgressNotFound)(OgenNotFound("Progress not found")), nil
+		}
+		return nil, err
+	}
+
+	progress, err := h.bookService.GetProgress(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, book.ErrProgressNotFound) {
+			return (*ogen.GetBookProgressNotFound)(OgenNotFound("Progress not found")), nil
+		}
+		return nil, err
+	}
+	result := bookProgressToOgen(progress)
+	return &result, nil
+}
+
+// UpdateBookProgress records the caller's reading position.
+func (h *Handler) UpdateBookProgress(ctx context.Context, req *ogen.BookProgressUpdate, params ogen.UpdateBookProgressParams) (ogen.UpdateBookProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.UpdateBookProgressNotFound)(OgenNotFound("Book not found")), nil
+	}
+
+	if _, err := h.accessibleBook(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, book.ErrBookNotFound) {
+			return (*ogen.UpdateBookProgressNotFound)(OgenNotFound("Book not found")), nil
+		}
+		return nil, err
+	}
+
+	update := book.ProgressUpdate{
+		Page:    util.SafeIntToInt32(req.Page.Or(0)),
+		Locator: req.Locator.Or(""),
+	}
+	if percent, ok := req.ProgressPercent.Get(); ok {
+		update.Percent = &percent
+	}
+
+	progress, err := h.bookService.UpdateProgress(ctx, userID, params.ID, update)
+	switch {
+	case errors.Is(err, book.ErrBookNotFound):
+		return (*ogen.UpdateBookProgressNotFound)(OgenNotFound("Book not found")), nil
+	case errors.Is(err, book.ErrInvalidProgress):
+		return (*ogen.UpdateBookProgressBadRequest)(OgenBadRequest(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	result := bookProgressToOgen(progress)
+	return &result, nil
+}
+
+// DeleteBookProgress removes the caller's reading progress.
+func (h *Handler) DeleteBookProgress(ctx context.Context, params ogen.DeleteBookProgressParams) (ogen.DeleteBookProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.bookService == nil {
+		return (*ogen.DeleteBookProgressNotFound)(OgenNotFound("Book not found")), nil
+	}
+
+	if err := h.bookService.DeleteProgress(ctx, userID, params.ID); err != nil {
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	return &ogen.DeleteBookProgressNoContent{}, nil
+}
+
+// accessibleBookPaths returns the path prefixes of the book and comic
+// libraries the user may browse, or nil when the user may browse all of
+// them.
+func (h *Handler) accessibleBookPaths(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	return h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeBook, library.LibraryTypeComic)
+}
+
+// accessibleBook returns a book if its file is in a book or comic library
+// the user can access, and book.ErrBookNotFound otherwise.
+func (h *Handler) accessibleBook(ctx context.Context, userID, bookID uuid.UUID) (*book.Book, error) {
+	b, err := h.bookService.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	libraryPaths, err := h.accessibleBookPaths(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !inLibraryPaths(b.FilePath, libraryPaths) {
+		return nil, book.ErrBookNotFound
+	}
+	return b, nil
+}
+
+// bookContentDisposition names the download after the original file.
+func bookContentDisposition(b *book.Book) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": filepath.Base(b.FilePath)})
+}
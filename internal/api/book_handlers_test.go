@@ -0,0 +1,393 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// bookMockService is a minimal mock for book.Service used by the book handler tests.
+type bookMockService struct {
+	book.Service
+	books    map[uuid.UUID]*book.Book
+	progress *book.Progress
+	update   *book.ProgressUpdate
+	filters  *book.BookListFilters
+	pages    map[int]*book.Image
+
+	continuePaths []string
+}
+
+func (m *bookMockService) GetBook(_ context.Context, id uuid.UUID) (*book.Book, error) {
+	if b, ok := m.books[id]; ok {
+		return b, nil
+	}
+	return nil, book.ErrBookNotFound
+}
+
+func (m *bookMockService) ListBooks(_ context.Context, filters book.BookListFilters) ([]book.Book, int64, error) {
+	m.filters = &filters
+	var books []book.Book
+	for _, b := range m.books {
+		books = append(books, *b)
+	}
+	return books, int64(len(books)), nil
+}
+
+func (m *bookMockService) GetBookAuthors(_ context.Context, _ uuid.UUID) ([]book.Author, error) {
+	return []book.Author{{ID: uuid.New(), Name: "Frank Herbert", SortName: "Herbert, Frank"}}, nil
+}
+
+func (m *bookMockService) GetSeries(_ context.Context, id uuid.UUID) (*book.Series, error) {
+	return &book.Series{ID: id, Name: "Dune Chronicles", SortName: "Dune Chronicles"}, nil
+}
+
+func (m *bookMockService) GetProgress(_ context.Context, _, _ uuid.UUID) (*book.Progress, error) {
+	if m.progress == nil {
+		return nil, book.ErrProgressNotFound
+	}
+	return m.progress, nil
+}
+
+func (m *bookMockService) UpdateProgress(_ context.Context, userID, bookID uuid.UUID, update book.ProgressUpdate) (*book.Progress, error) {
+	m.update = &update
+	if _, ok := m.books[bookID]; !ok {
+		return nil, book.ErrBookNotFound
+	}
+	if update.Page == 0 && update.Locator == "" && update.Percent == nil {
+		return nil, fmt.Errorf("%w: page, locator or percent is required", book.ErrInvalidProgress)
+	}
+	progress := &book.Progress{UserID: userID, BookID: bookID, Page: update.Page, LastReadAt: time.Now()}
+	if update.Locator != "" {
+		progress.Locator = &update.Locator
+	}
+	if update.Percent != nil {
+		progress.Percent = *update.Percent
+	}
+	return progress, nil
+}
+
+func (m *bookMockService) GetPage(_ context.Context, bookID uuid.UUID, page int) (*book.Image, error) {
+	if _, ok := m.books[bookID]; !ok {
+		return nil, book.ErrBookNotFound
+	}
+	if img, ok := m.pages[page]; ok {
+		return img, nil
+	}
+	return nil, book.ErrPageNotFound
+}
+
+func (m *bookMockService) GetCover(_ context.Context, bookID uuid.UUID) (*book.Image, error) {
+	b, ok := m.books[bookID]
+	if !ok {
+		return nil, book.ErrBookNotFound
+	}
+	if !b.HasCover() {
+		return nil, book.ErrCoverNotFound
+	}
+	return &book.Image{Data: []byte("cover"), ContentType: "image/png"}, nil
+}
+
+func (m *bookMockService) OpenFile(_ context.Context, bookID uuid.UUID) (*book.Book, *os.File, error) {
+	b, ok := m.books[bookID]
+	if !ok {
+		return nil, nil, book.ErrBookNotFound
+	}
+	f, err := os.Open(b.FilePath)
+	return b, f, err
+}
+
+func (m *bookMockService) GetContinueReading(_ context.Context, _ uuid.UUID, kind book.Kind, libraryPaths []string, _ int32) ([]book.ContinueReadingItem, error) {
+	m.continuePaths = libraryPaths
+	var items []book.ContinueReadingItem
+	for _, b := range m.books {
+		if kind == "" || b.Kind == kind {
+			items = append(items, book.ContinueReadingItem{Book: *b, Progress: *m.progress})
+		}
+	}
+	return items, nil
+}
+
+// newBookMockService returns a mock with one EPUB and one comic.
+func newBookMockService(bookID, comicID uuid.UUID) *bookMockService {
+	seriesID := uuid.New()
+	return &bookMockService{
+		books: map[uuid.UUID]*book.Book{
+			bookID: {
+				ID: bookID, Kind: book.KindBook, Format: ebook.FormatEPUB, Title: "Dune",
+				AuthorCredit: "Frank Herbert", SeriesID: &seriesID, SeriesIndex: new(float32(1)),
+				ISBN: new("9780441172719"), CoverEntry: new("cover.jpg"),
+			},
+			comicID: {
+				ID: comicID, Kind: book.KindComic, Format: ebook.FormatCBZ, Title: "Saga #1",
+				PageCount: 2, Pages: []string{"01.jpg", "02.png"},
+			},
+		},
+		pages: map[int]*book.Image{
+			1: {Data: []byte("one"), ContentType: "image/jpeg"},
+			2: {Data: []byte("two"), ContentType: "image/png"},
+		},
+	}
+}
+
+func TestHandler_GetBook(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	svc := newBookMockService(bookID, uuid.New())
+	svc.progress = &book.Progress{BookID: bookID, Locator: new("epubcfi(/6/4!/4/2/1:0)"), Percent: 42, LastReadAt: time.Now()}
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: svc}
+
+	ctx := contextWithUserID(context.Background(), uuid.New())
+	result, err := handler.GetBook(ctx, ogen.GetBookParams{ID: bookID})
+	require.NoError(t, err)
+
+	detail, ok := result.(*ogen.BookDetail)
+	require.True(t, ok, "expected *ogen.BookDetail, got %T", result)
+	assert.Equal(t, "Dune", detail.Book.Title)
+	assert.Equal(t, ogen.BookKindBook, detail.Book.Kind)
+	assert.Equal(t, ogen.BookFormatEpub, detail.Book.Format)
+	assert.Equal(t, "9780441172719", detail.Book.Isbn.Or(""))
+	assert.True(t, detail.Book.HasCover)
+	require.Len(t, detail.Authors, 1)
+	assert.Equal(t, "Dune Chronicles", detail.Series.Or(ogen.BookSeries{}).Name)
+
+	progress, ok := detail.Progress.Get()
+	require.True(t, ok)
+	assert.Equal(t, "epubcfi(/6/4!/4/2/1:0)", progress.Locator.Or(""))
+	assert.InDelta(t, 42, progress.ProgressPercent, 0.001)
+}
+
+func TestHandler_GetBook_NotFound(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: newBookMockService(uuid.New(), uuid.New())}
+
+	result, err := handler.GetBook(contextWithUserID(context.Background(), uuid.New()), ogen.GetBookParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetBookNotFound)
+	assert.True(t, ok, "expected *ogen.GetBookNotFound, got %T", result)
+}
+
+func TestHandler_ListBooks_Filters(t *testing.T) {
+	t.Parallel()
+
+	svc := newBookMockService(uuid.New(), uuid.New())
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: svc}
+
+	result, err := handler.ListBooks(contextWithUserID(context.Background(), uuid.New()), ogen.ListBooksParams{
+		Kind:  ogen.NewOptListBooksKind(ogen.ListBooksKindComic),
+		Q:     ogen.NewOptString("saga"),
+		Limit: ogen.NewOptInt(20),
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.(*ogen.BookListResponse).Items, 2)
+
+	require.NotNil(t, svc.filters)
+	assert.Equal(t, book.KindComic, svc.filters.Kind)
+	assert.Equal(t, "saga", svc.filters.Query)
+	assert.Equal(t, "added", svc.filters.OrderBy)
+	assert.Equal(t, int32(20), svc.filters.Limit)
+}
+
+func TestHandler_UpdateBookProgress(t *testing.T) {
+	t.Parallel()
+
+	comicID := uuid.New()
+	svc := newBookMockService(uuid.New(), comicID)
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.UpdateBookProgress(ctx, &ogen.BookProgressUpdate{Page: ogen.NewOptInt(2)},
+		ogen.UpdateBookProgressParams{ID: comicID})
+	require.NoError(t, err)
+
+	progress, ok := result.(*ogen.BookProgress)
+	require.True(t, ok, "expected *ogen.BookProgress, got %T", result)
+	assert.Equal(t, 2, progress.Page)
+	require.NotNil(t, svc.update)
+	assert.Equal(t, int32(2), svc.update.Page)
+	assert.Nil(t, svc.update.Percent, "percent is left to the service when not reported")
+}
+
+func TestHandler_UpdateBookProgress_Errors(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: newBookMockService(bookID, uuid.New())}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.UpdateBookProgress(ctx, &ogen.BookProgressUpdate{}, ogen.UpdateBookProgressParams{ID: bookID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.UpdateBookProgressBadRequest)
+	assert.True(t, ok, "expected *ogen.UpdateBookProgressBadRequest, got %T", result)
+
+	result, err = handler.UpdateBookProgress(ctx, &ogen.BookProgressUpdate{Page: ogen.NewOptInt(1)},
+		ogen.UpdateBookProgressParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UpdateBookProgressNotFound)
+	assert.True(t, ok, "expected *ogen.UpdateBookProgressNotFound, got %T", result)
+}
+
+func TestHandler_GetBookPage(t *testing.T) {
+	t.Parallel()
+
+	comicID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: newBookMockService(uuid.New(), comicID)}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetBookPage(ctx, ogen.GetBookPageParams{ID: comicID, Page: 2})
+	require.NoError(t, err)
+	png, ok := result.(*ogen.GetBookPageOKImagePNG)
+	require.True(t, ok, "expected *ogen.GetBookPageOKImagePNG, got %T", result)
+	data, err := io.ReadAll(png.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "two", string(data))
+
+	result, err = handler.GetBookPage(ctx, ogen.GetBookPageParams{ID: comicID, Page: 3})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetBookPageNotFound)
+	assert.True(t, ok, "expected *ogen.GetBookPageNotFound, got %T", result)
+}
+
+func TestHandler_GetBookCover(t *testing.T) {
+	t.Parallel()
+
+	bookID, comicID := uuid.New(), uuid.New()
+	svc := newBookMockService(bookID, comicID)
+	svc.books[comicID].Pages = nil
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetBookCover(ctx, ogen.GetBookCoverParams{ID: bookID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetBookCoverOKImagePNG)
+	assert.True(t, ok, "expected *ogen.GetBookCoverOKImagePNG, got %T", result)
+
+	result, err = handler.GetBookCover(ctx, ogen.GetBookCoverParams{ID: comicID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetBookCoverNotFound)
+	assert.True(t, ok, "expected *ogen.GetBookCoverNotFound, got %T", result)
+}
+
+func TestHandler_DownloadBookFile(t *testing.T) {
+	t.Parallel()
+
+	bookID := uuid.New()
+	svc := newBookMockService(bookID, uuid.New())
+	path := filepath.Join(t.TempDir(), "Frank Herbert - Dune.epub")
+	require.NoError(t, os.WriteFile(path, []byte("epub"), 0o600))
+	svc.books[bookID].FilePath = path
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: svc}
+
+	result, err := handler.DownloadBookFile(contextWithUserID(context.Background(), uuid.New()), ogen.DownloadBookFileParams{ID: bookID})
+	require.NoError(t, err)
+
+	download, ok := result.(*ogen.DownloadBookFileOKHeaders)
+	require.True(t, ok, "expected *ogen.DownloadBookFileOKHeaders, got %T", result)
+	assert.Equal(t, `attachment; filename="Frank Herbert - Dune.epub"`, download.ContentDisposition.Or(""))
+	data, err := io.ReadAll(download.Response.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "epub", string(data))
+	require.NoError(t, download.Response.Data.(io.Closer).Close())
+}
+
+func TestHandler_GetContinueReading(t *testing.T) {
+	t.Parallel()
+
+	bookID, comicID := uuid.New(), uuid.New()
+	svc := newBookMockService(bookID, comicID)
+	svc.progress = &book.Progress{Page: 1, Percent: 50}
+	handler := &Handler{logger: logging.NewTestLogger(), bookService: svc}
+
+	result, err := handler.GetContinueReading(contextWithUserID(context.Background(), uuid.New()), ogen.GetContinueReadingParams{
+		Kind: ogen.NewOptGetContinueReadingKind(ogen.GetContinueReadingKindComic),
+	})
+	require.NoError(t, err)
+
+	items, ok := result.(*ogen.GetContinueReadingOKApplicationJSON)
+	require.True(t, ok, "expected *ogen.GetContinueReadingOKApplicationJSON, got %T", result)
+	require.Len(t, *items, 1)
+	assert.Equal(t, "Saga #1", (*items)[0].Book.Title)
+	assert.Equal(t, 1, (*items)[0].Progress.Page)
+}
+
+func TestHandler_Book_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListBooks(ctx, ogen.ListBooksParams{})
+	require.NoError(t, err)
+	assert.Empty(t, list.(*ogen.BookListResponse).Items)
+
+	result, err := handler.GetBook(ctx, ogen.GetBookParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetBookNotFound)
+	assert.True(t, ok)
+
+	continueReading, err := handler.GetContinueReading(ctx, ogen.GetContinueReadingParams{})
+	require.NoError(t, err)
+	assert.Empty(t, *continueReading.(*ogen.GetContinueReadingOKApplicationJSON))
+}
+
+func TestHandler_Book_LibraryAccess(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	shared := library.Library{ID: uuid.New(), Name: "Shared", Type: library.LibraryTypeBook, Paths: []string{"/books/shared"}}
+	private := library.Library{ID: uuid.New(), Name: "Private", Type: library.LibraryTypeBook, Paths: []string{"/books/private"}}
+	comics := library.Library{ID: uuid.New(), Name: "Comics", Type: library.LibraryTypeComic, Paths: []string{"/comics"}}
+
+	bookID, comicID := uuid.New(), uuid.New()
+	svc := newBookMockService(bookID, comicID)
+	svc.books[bookID].FilePath = "/books/private/Dune.epub"
+	svc.books[comicID].FilePath = "/comics/Saga 001.cbz"
+	handler := &Handler{
+		logger:      logging.NewTestLogger(),
+		bookService: svc,
+		libraryService: newStubLibraryService([]library.Library{shared, private, comics},
+			map[uuid.UUID][]uuid.UUID{userID: {shared.ID, comics.ID}}),
+	}
+	ctx := contextWithUserID(context.Background(), userID)
+
+	_, err := handler.ListBooks(ctx, ogen.ListBooksParams{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/books/shared/", "/comics/"}, svc.filters.LibraryPaths)
+
+	svc.progress = &book.Progress{BookID: comicID}
+	_, err = handler.GetContinueReading(ctx, ogen.GetContinueReadingParams{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/books/shared/", "/comics/"}, svc.continuePaths)
+
+	detail, err := handler.GetBook(ctx, ogen.GetBookParams{ID: bookID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetBookNotFound{}, detail)
+
+	cover, err := handler.GetBookCover(ctx, ogen.GetBookCoverParams{ID: bookID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetBookCoverNotFound{}, cover)
+
+	download, err := handler.DownloadBookFile(ctx, ogen.DownloadBookFileParams{ID: bookID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.DownloadBookFileNotFound{}, download)
+
+	page, err := handler.GetBookPage(ctx, ogen.GetBookPageParams{ID: comicID, Page: 1})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetBookPageOKImageJpeg{}, page, "comics in a granted library stay readable")
+}
@@ -13,7 +13,17 @@ import (
 	"github.com/lusoris/revenge/internal/api/middleware"
 	"github.com/lusoris/revenge/internal/api/ogen"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/livetv"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/photo"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/content/qar"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/errors"
 	"github.com/lusoris/revenge/internal/infra/database/db"
@@ -22,11 +32,15 @@ import (
 	"github.com/lusoris/revenge/internal/playback"
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/auth"
+	"github.com/lusoris/revenge/internal/service/homefeed"
 	"github.com/lusoris/revenge/internal/service/library"
 	"github.com/lusoris/revenge/internal/service/metadata"
 	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/service/oidc"
+	"github.com/lusoris/revenge/internal/service/parental"
+	"github.com/lusoris/revenge/internal/service/people"
 	"github.com/lusoris/revenge/internal/service/rbac"
 	"github.com/lusoris/revenge/internal/service/search"
 	"github.com/lusoris/revenge/internal/service/session"
@@ -40,39 +54,58 @@ import (
 
 // Handler implements the ogen.Handler interface for health check endpoints.
 type Handler struct {
-	logger               *slog.Logger
-	cfg                  *config.Config
-	healthService        *health.Service
-	settingsService      settings.Service
-	userService          *user.CachedService
-	authService          *auth.Service
-	sessionService       *session.Service
-	rbacService          *rbac.Service
-	apikeyService        apikeys.Service
-	oidcService          *oidc.Service
-	activityService      *activity.Service
-	libraryService       *library.CachedService
-	searchService        *search.MovieSearchService
-	tvshowSearchService  *search.TVShowSearchService
-	episodeSearchService *search.EpisodeSearchService
-	seasonSearchService  *search.SeasonSearchService
-	personSearchService  *search.PersonSearchService
-	tokenManager         auth.TokenManager
-	mfaHandler           *MFAHandler
-	movieHandler         *movie.Handler
-	metadataService      metadata.Service
-	imageService         *image.Service
-	tvshowService        tvshow.Service       // TV show service
-	radarrService        radarrService        // Optional: Radarr sync service
-	sonarrService        sonarrService        // Optional: Sonarr sync service
-	riverClient          riverClient          // Optional: River job queue client
-	playbackService      *playback.Service    // Optional: HLS streaming service
-	notificationService  notification.Service // Optional: Notification dispatcher
+	logger                 *slog.Logger
+	cfg                    *config.Config
+	healthService          *health.Service
+	settingsService        settings.Service
+	userService            *user.CachedService
+	authService            *auth.Service
+	sessionService         *session.Service
+	rbacService            *rbac.Service
+	apikeyService          apikeys.Service
+	oidcService            *oidc.Service
+	activityService        *activity.Service
+	libraryService         *library.CachedService
+	searchService          *search.MovieSearchService
+	tvshowSearchService    *search.TVShowSearchService
+	episodeSearchService   *search.EpisodeSearchService
+	seasonSearchService    *search.SeasonSearchService
+	personSearchService    *search.PersonSearchService
+	musicSearchService     *search.MusicSearchService
+	homeVideoSearchService *search.HomeVideoSearchService
+	tokenManager           auth.TokenManager
+	mfaHandler             *MFAHandler
+	movieHandler           *movie.Handler
+	metadataService        metadata.Service
+	imageService           *image.Service
+	artworkService         *artwork.Service       // Optional: local artwork lookup
+	peopleService          *people.Service        // Optional: shared cast and crew
+	parentalService        *parental.Service      // Optional: per-user content restrictions
+	metadataHistory        metadataHistoryService // Optional: metadata change history
+	tvshowService          tvshow.Service         // TV show service
+	musicService           music.Service          // Optional: music service
+	audiobookService       audiobook.Service      // Optional: audiobook service
+	bookService            book.Service           // Optional: e-book and comic service
+	photoService           photo.Service          // Optional: photo service
+	homeVideoService       homevideo.Service      // Optional: home video service
+	musicVideoService      musicvideo.Service     // Optional: music video service
+	podcastService         podcast.Service        // Optional: podcast service
+	qarService             qar.Service            // Optional: adult content, nil while disabled
+	liveTVService          livetv.Service         // Optional: live TV and DVR, nil while disabled
+	homeFeedService        *homefeed.Service      // Optional: home feed across the content modules
+	radarrService          radarrService          // Optional: Radarr sync service
+	sonarrService          sonarrService          // Optional: Sonarr sync service
+	riverClient            riverClient            // Optional: River job queue client
+	playbackService        *playback.Service      // Optional: HLS streaming service
+	notificationService    notification.Service   // Optional: Notification dispatcher
+	matchQueueService      *library.MatchQueueService
+	movieMatchService      movieMatchService
 }
 
 // riverClient is an interface for the River job queue client.
 type riverClient interface {
 	Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) (*rivertype.JobInsertResult, error)
+	JobCancel(ctx context.Context, id int64) (*rivertype.JobRow, error)
 }
 
 // HandleApiKeyAuth implements the SecurityHandler interface.
@@ -266,6 +299,15 @@ func (h *Handler) NewError(ctx context.Context, err error) *ogen.ErrorStatusCode
 		}
 	}
 
+	// Content blocked by the user's parental controls
+	if errors.Is(err, content.ErrParentalRestriction) {
+		h.logger.Info("Request blocked by parental controls", slog.Any("error", err))
+		return &ogen.ErrorStatusCode{
+			StatusCode: 403,
+			Response:   parentalRestrictionError(),
+		}
+	}
+
 	// Check if error carries its own status code (e.g. rate limit 429)
 	var sc statusCoder
 	if errors.As(err, &sc) {
@@ -594,15 +636,23 @@ func prefsToOgenResponse(prefs *db.SharedUserPreference) *ogen.UserPreferences {
 		ProfileVisibility: ogen.NewOptUserPreferencesProfileVisibility(
 			ogen.UserPreferencesProfileVisibility(stringPtrToString(prefs.ProfileVisibility)),
 		),
-		ShowEmail:        ogen.NewOptBool(boolPtrToBool(prefs.ShowEmail)),
-		ShowActivity:     ogen.NewOptBool(boolPtrToBool(prefs.ShowActivity)),
-		Theme:            ogen.NewOptUserPreferencesTheme(ogen.UserPreferencesTheme(stringPtrToString(prefs.Theme))),
-		DisplayLanguage:  ogen.NewOptString(stringPtrToString(prefs.DisplayLanguage)),
-		ContentLanguage:  ogen.NewOptString(stringPtrToString(prefs.ContentLanguage)),
-		MetadataLanguage: ogen.NewOptString(stringPtrToString(prefs.MetadataLanguage)),
-		ShowAdultContent: ogen.NewOptBool(boolPtrToBool(prefs.ShowAdultContent)),
-		ShowSpoilers:     ogen.NewOptBool(boolPtrToBool(prefs.ShowSpoilers)),
-		AutoPlayVideos:   ogen.NewOptBool(boolPtrToBool(prefs.AutoPlayVideos)),
+		ShowEmail:             ogen.NewOptBool(boolPtrToBool(prefs.ShowEmail)),
+		ShowActivity:          ogen.NewOptBool(boolPtrToBool(prefs.ShowActivity)),
+		Theme:                 ogen.NewOptUserPreferencesTheme(ogen.UserPreferencesTheme(stringPtrToString(prefs.Theme))),
+		DisplayLanguage:       ogen.NewOptString(stringPtrToString(prefs.DisplayLanguage)),
+		ContentLanguage:       ogen.NewOptString(stringPtrToString(prefs.ContentLanguage)),
+		MetadataLanguage:      ogen.NewOptString(stringPtrToString(prefs.MetadataLanguage)),
+		ShowAdultContent:      ogen.NewOptBool(boolPtrToBool(prefs.ShowAdultContent)),
+		ShowSpoilers:          ogen.NewOptBool(boolPtrToBool(prefs.ShowSpoilers)),
+		AutoPlayVideos:        ogen.NewOptBool(boolPtrToBool(prefs.AutoPlayVideos)),
+		HiddenTags:            prefs.HiddenTags,
+		MetadataLanguageChain: prefs.MetadataLanguageChain,
+	}
+	if out.HiddenTags == nil {
+		out.HiddenTags = []string{}
+	}
+	if out.MetadataLanguageChain == nil {
+		out.MetadataLanguageChain = []string{}
 	}
 
 	if len(prefs.EmailNotifications) > 0 {
@@ -835,6 +885,12 @@ func (h *Handler) UpdateUserPreferences(ctx context.Context, req *ogen.UserPrefe
 	if autoPlay, ok := req.AutoPlayVideos.Get(); ok {
 		params.AutoPlayVideos = &autoPlay
 	}
+	if req.HiddenTags != nil {
+		params.HiddenTags = normalizeTagSlugs(req.HiddenTags)
+	}
+	if req.MetadataLanguageChain != nil {
+		params.MetadataLanguageChain = req.MetadataLanguageChain
+	}
 
 	prefs, err := h.userService.UpdateUserPreferences(ctx, params)
 	if err != nil {
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	metadatajobs "github.com/lusoris/revenge/internal/service/metadata/jobs"
+)
+
+// ListMovieArtwork lists the artwork candidates of a movie.
+func (h *Handler) ListMovieArtwork(ctx context.Context, params ogen.ListMovieArtworkParams) (ogen.ListMovieArtworkRes, error) {
+	result, err := h.listArtwork(ctx, artwork.ContentTypeMovie, params.ID, params.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return (*ogen.ListMovieArtworkOKApplicationJSON)(&result), nil
+}
+
+// SelectMovieArtwork makes an artwork candidate the image used for its kind
+// and locks a chosen poster or backdrop on the movie (admin only).
+func (h *Handler) SelectMovieArtwork(ctx context.Context, params ogen.SelectMovieArtworkParams) (ogen.SelectMovieArtworkRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.SelectMovieArtworkUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.SelectMovieArtworkForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceManual, &adminID)
+	if h.artworkService == nil {
+		return (*ogen.SelectMovieArtworkNotFound)(OgenNotFound("Artwork not found")), nil
+	}
+
+	art, err := h.artworkService.Choose(ctx, artwork.ContentTypeMovie, params.ID, params.ArtworkId)
+	if err != nil {
+		if errors.Is(err, artwork.ErrNotFound) {
+			return (*ogen.SelectMovieArtworkNotFound)(OgenNotFound("Artwork not found")), nil
+		}
+		return nil, err
+	}
+
+	if edit, ok := artworkEdit(art); ok {
+		_, err := h.movieHandler.UpdateMetadata(ctx, params.ID.String(), movie.MetadataEdit{
+			PosterPath:   edit.poster,
+			BackdropPath: edit.backdrop,
+		})
+		if err != nil {
+			if errors.Is(err, movie.ErrMovieNotFound) {
+				return (*ogen.SelectMovieArtworkNotFound)(OgenNotFound("Movie not found")), nil
+			}
+			return nil, err
+		}
+	}
+
+	h.cacheArtwork(ctx, art)
+	return artworkToOgen(art), nil
+}
+
+// ListTVShowArtwork lists the artwork candidates of a series.
+func (h *Handler) ListTVShowArtwork(ctx context.Context, params ogen.ListTVShowArtworkParams) (ogen.ListTVShowArtworkRes, error) {
+	result, err := h.listArtwork(ctx, artwork.ContentTypeSeries, params.ID, params.Kind)
+	if err != nil {
+		return nil, err
+	}
+	return (*ogen.ListTVShowArtworkOKApplicationJSON)(&result), nil
+}
+
+// SelectTVShowArtwork makes an artwork candidate the image used for its kind
+// and locks a chosen poster or backdrop on the series (admin only).
+func (h *Handler) SelectTVShowArtwork(ctx context.Context, params ogen.SelectTVShowArtworkParams) (ogen.SelectTVShowArtworkRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.SelectTVShowArtworkUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.SelectTVShowArtworkForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceManual, &adminID)
+	if h.artworkService == nil {
+		return (*ogen.SelectTVShowArtworkNotFound)(OgenNotFound("Artwork not found")), nil
+	}
+
+	art, err := h.artworkService.Choose(ctx, artwork.ContentTypeSeries, params.ID, params.ArtworkId)
+	if err != nil {
+		if errors.Is(err, artwork.ErrNotFound) {
+			return (*ogen.SelectTVShowArtworkNotFound)(OgenNotFound("Artwork not found")), nil
+		}
+		return nil, err
+	}
+
+	if edit, ok := artworkEdit(art); ok {
+		_, err := h.tvshowService.UpdateSeriesMetadata(ctx, params.ID, tvshow.SeriesMetadataEdit{
+			PosterPath:   edit.poster,
+			BackdropPath: edit.backdrop,
+		})
+		if err != nil {
+			return (*ogen.SelectTVShowArtworkNotFound)(OgenNotFound("TV show not found")), nil
+		}
+	}
+
+	h.cacheArtwork(ctx, art)
+	return artworkToOgen(art), nil
+}
+
+// listArtwork returns the ranked artwork candidates of an item, optionally
+// limited to one kind.
+func (h *Handler) listArtwork(ctx context.Context, contentType string, contentID uuid.UUID, kind ogen.OptArtworkKind) ([]ogen.Artwork, error) {
+	result := []ogen.Artwork{}
+	if h.artworkService == nil {
+		return result, nil
+	}
+
+	items, err := h.artworkService.Candidates(ctx, contentType, contentID, h.GetMetadataLanguage(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if kind.Set && items[i].Kind != string(kind.Value) {
+			continue
+		}
+		result = append(result, *artworkToOgen(&items[i]))
+	}
+	return result, nil
+}
+
+// cacheArtwork queues a chosen image for the local image cache and its
+// placeholder.
+func (h *Handler) cacheArtwork(ctx context.Context, art *artwork.Artwork) {
+	if h.riverClient == nil || !art.NeedsCaching() {
+		return
+	}
+	_, err := h.riverClient.Insert(ctx, metadatajobs.DownloadImageArgs{
+		ContentType: art.ContentType,
+		ContentID:   art.ContentID.String(),
+		ImageType:   art.Kind,
+		Path:        art.ImagePath(),
+		ArtworkID:   art.ID,
+	}, nil)
+	if err != nil {
+		h.logger.Warn("failed to queue artwork download",
+			slog.String("artwork_id", art.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// artworkPaths holds the poster or backdrop path a chosen image sets.
+type artworkPaths struct {
+	poster   *string
+	backdrop *string
+}
+
+// artworkEdit returns the metadata edit for a chosen poster or backdrop.
+// Other kinds are not stored on the item.
+func artworkEdit(art *artwork.Artwork) (artworkPaths, bool) {
+	path := art.ImagePath()
+	switch art.Kind {
+	case artwork.KindPoster:
+		return artworkPaths{poster: &path}, true
+	case artwork.KindBackdrop:
+		return artworkPaths{backdrop: &path}, true
+	default:
+		return artworkPaths{}, false
+	}
+}
+
+func artworkToOgen(art *artwork.Artwork) *ogen.Artwork {
+	o := &ogen.Artwork{
+		ID:           art.ID,
+		Kind:         ogen.ArtworkKind(art.Kind),
+		Source:       art.Source,
+		Path:         art.ImagePath(),
+		Selected:     art.Selected,
+		UserSelected: art.UserSelected,
+	}
+	if art.Language != nil && *art.Language != "" {
+		o.Language = ogen.NewOptString(*art.Language)
+	}
+	if art.Width != nil {
+		o.Width = ogen.NewOptInt(int(*art.Width))
+	}
+	if art.Height != nil {
+		o.Height = ogen.NewOptInt(int(*art.Height))
+	}
+	if art.VoteCount > 0 {
+		o.VoteAverage = ogen.NewOptFloat64(art.VoteAverage)
+		o.VoteCount = ogen.NewOptInt(int(art.VoteCount))
+	}
+	if art.CachedAt != nil {
+		o.CachedAt = ogen.NewOptDateTime(*art.CachedAt)
+	}
+	setOpt(&o.Blurhash, art.BlurHash)
+	setOpt(&o.DominantColor, art.DominantColor)
+	return o
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+func TestHandler_Artwork_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := context.Background()
+
+	movieRes, err := handler.SelectMovieArtwork(ctx, ogen.SelectMovieArtworkParams{ID: uuid.New(), ArtworkId: uuid.New()})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.SelectMovieArtworkUnauthorized{}, movieRes)
+
+	seriesRes, err := handler.SelectTVShowArtwork(ctx, ogen.SelectTVShowArtworkParams{ID: uuid.New(), ArtworkId: uuid.New()})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.SelectTVShowArtworkUnauthorized{}, seriesRes)
+}
+
+func TestHandler_ListArtwork_NoService(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	res, err := handler.ListMovieArtwork(context.Background(), ogen.ListMovieArtworkParams{ID: uuid.New()})
+	require.NoError(t, err)
+	list, ok := res.(*ogen.ListMovieArtworkOKApplicationJSON)
+	require.True(t, ok)
+	assert.Empty(t, *list)
+}
+
+func TestArtworkToOgen(t *testing.T) {
+	t.Parallel()
+
+	cachedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	provider := &artwork.Artwork{
+		ID:           uuid.New(),
+		Kind:         artwork.KindPoster,
+		Source:       "tmdb",
+		Path:         "/poster.jpg",
+		Language:     new("de"),
+		Width:        new(int32(1000)),
+		Height:       new(int32(1500)),
+		VoteAverage:  5.4,
+		VoteCount:    12,
+		Selected:     true,
+		UserSelected: true,
+		CachedAt:     &cachedAt,
+	}
+	o := artworkToOgen(provider)
+	assert.Equal(t, ogen.ArtworkKindPoster, o.Kind)
+	assert.Equal(t, "/poster.jpg", o.Path)
+	assert.Equal(t, "de", o.Language.Value)
+	assert.Equal(t, 1500, o.Height.Value)
+	assert.Equal(t, 5.4, o.VoteAverage.Value)
+	assert.Equal(t, 12, o.VoteCount.Value)
+	assert.True(t, o.Selected)
+	assert.True(t, o.UserSelected)
+	assert.Equal(t, cachedAt, o.CachedAt.Value)
+
+	local := &artwork.Artwork{ID: uuid.New(), Kind: artwork.KindBackdrop, Source: artwork.SourceLocal, Path: "/media/movie/fanart.jpg"}
+	o = artworkToOgen(local)
+	assert.Equal(t, artwork.LocalPath(local.ID), o.Path, "local artwork must not expose the file path")
+	assert.False(t, o.Language.Set)
+	assert.False(t, o.VoteAverage.Set)
+}
+
+func TestArtworkEdit(t *testing.T) {
+	t.Parallel()
+
+	edit, ok := artworkEdit(&artwork.Artwork{Kind: artwork.KindPoster, Source: "tmdb", Path: "/p.jpg"})
+	require.True(t, ok)
+	require.NotNil(t, edit.poster)
+	assert.Equal(t, "/p.jpg", *edit.poster)
+	assert.Nil(t, edit.backdrop)
+
+	edit, ok = artworkEdit(&artwork.Artwork{Kind: artwork.KindBackdrop, Source: "tmdb", Path: "/b.jpg"})
+	require.True(t, ok)
+	assert.Nil(t, edit.poster)
+	assert.Equal(t, "/b.jpg", *edit.backdrop)
+
+	_, ok = artworkEdit(&artwork.Artwork{Kind: artwork.KindLogo, Source: "fanarttv", Path: "https://assets.fanart.tv/l.png"})
+	assert.False(t, ok)
+}
@@ -12,10 +12,22 @@ import (
 	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/api/ogen"
+	audiobookjobs "github.com/lusoris/revenge/internal/content/audiobook/jobs"
+	"github.com/lusoris/revenge/internal/content/book"
+	bookjobs "github.com/lusoris/revenge/internal/content/book/jobs"
+	homevideojobs "github.com/lusoris/revenge/internal/content/homevideo/jobs"
 	"github.com/lusoris/revenge/internal/content/movie/moviejobs"
+	musicjobs "github.com/lusoris/revenge/internal/content/music/jobs"
+	musicvideojobs "github.com/lusoris/revenge/internal/content/musicvideo/jobs"
+	photojobs "github.com/lusoris/revenge/internal/content/photo/jobs"
+	podcastjobs "github.com/lusoris/revenge/internal/content/podcast/jobs"
+	qarjobs "github.com/lusoris/revenge/internal/content/qar/jobs"
 	tvshowjobs "github.com/lusoris/revenge/internal/content/tvshow/jobs"
 	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/metadata"
+	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/validate"
+	"github.com/riverqueue/river/rivertype"
 )
 
 // ============================================================================
@@ -101,6 +113,9 @@ func (h *Handler) CreateLibrary(ctx context.Context, req *ogen.CreateLibraryRequ
 		provider := req.MetadataProvider.Value
 		createReq.MetadataProvider = &provider
 	}
+	if req.MetadataPolicy.IsSet() {
+		createReq.MetadataPolicy = metadataPolicyFromOgen(req.MetadataPolicy.Value)
+	}
 	if req.PreferredLanguage.IsSet() {
 		createReq.PreferredLanguage = req.PreferredLanguage.Value
 	}
@@ -122,6 +137,12 @@ func (h *Handler) CreateLibrary(ctx context.Context, req *ogen.CreateLibraryRequ
 				Message: "Invalid library type",
 			}, nil
 		}
+		if errors.Is(err, metadata.ErrInvalidPolicy) {
+			return &ogen.CreateLibraryBadRequest{
+				Code:    400,
+				Message: err.Error(),
+			}, nil
+		}
 		h.logger.Error("failed to create library", slog.Any("error", err))
 		return &ogen.CreateLibraryBadRequest{
 			Code:    500,
@@ -213,6 +234,10 @@ func (h *Handler) UpdateLibrary(ctx context.Context, req *ogen.UpdateLibraryRequ
 	if req.MetadataProvider.IsSet() {
 		update.MetadataProvider = &req.MetadataProvider.Value
 	}
+	if req.MetadataPolicy.IsSet() {
+		policy := metadataPolicyFromOgen(req.MetadataPolicy.Value)
+		update.MetadataPolicy = &policy
+	}
 	if req.PreferredLanguage.IsSet() {
 		update.PreferredLanguage = &req.PreferredLanguage.Value
 	}
@@ -235,6 +260,12 @@ func (h *Handler) UpdateLibrary(ctx context.Context, req *ogen.UpdateLibraryRequ
 				Message: "Library with this name already exists",
 			}, nil
 		}
+		if errors.Is(err, metadata.ErrInvalidPolicy) {
+			return &ogen.UpdateLibraryBadRequest{
+				Code:    400,
+				Message: err.Error(),
+			}, nil
+		}
 		h.logger.Error("failed to update library", slog.Any("error", err))
 		return &ogen.UpdateLibraryNotFound{
 			Code:    500,
@@ -335,20 +366,164 @@ func (h *Handler) TriggerLibraryScan(ctx context.Context, req *ogen.TriggerLibra
 			switch lib.Type {
 			case library.LibraryTypeTVShow:
 				libID := params.LibraryId
-				_, insertErr := h.riverClient.Insert(ctx, tvshowjobs.LibraryScanArgs{
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, tvshowjobs.LibraryScanArgs{
 					Paths:      lib.Paths,
 					Force:      scanType == "full",
 					LibraryID:  &libID,
 					AutoCreate: true,
+					ScanID:     &scanID,
 				}, nil)
 				if insertErr != nil {
 					h.logger.Error("failed to enqueue tvshow scan job",
 						slog.String("scan_id", scan.ID.String()),
 						slog.Any("error", insertErr),
 					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypeMusic:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, musicjobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue music scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypeAudiobook:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, audiobookjobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue audiobook scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypeBook, library.LibraryTypeComic:
+				libID := params.LibraryId
+				scanID := scan.ID
+				kind := book.KindBook
+				if lib.Type == library.LibraryTypeComic {
+					kind = book.KindComic
+				}
+				res, insertErr := h.riverClient.Insert(ctx, bookjobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					BookKind:  kind,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue book scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypePhoto:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, photojobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue photo scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypeHomeVideo:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, homevideojobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue home video scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypeMusicVideo:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, musicvideojobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue music video scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypeAdult:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, qarjobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					Force:     scanType == "full",
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue adult scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
+				}
+			case library.LibraryTypePodcast:
+				libID := params.LibraryId
+				scanID := scan.ID
+				res, insertErr := h.riverClient.Insert(ctx, podcastjobs.LibraryScanArgs{
+					Paths:     lib.Paths,
+					LibraryID: &libID,
+					ScanID:    &scanID,
+				}, nil)
+				if insertErr != nil {
+					h.logger.Error("failed to enqueue podcast scan job",
+						slog.String("scan_id", scan.ID.String()),
+						slog.Any("error", insertErr),
+					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
 				}
 			default: // movie (and any future types fall back to movie scan)
-				_, insertErr := h.riverClient.Insert(ctx, moviejobs.MovieLibraryScanArgs{
+				res, insertErr := h.riverClient.Insert(ctx, moviejobs.MovieLibraryScanArgs{
 					ScanID:    scan.ID.String(),
 					LibraryID: params.LibraryId.String(),
 					Paths:     lib.Paths,
@@ -359,6 +534,8 @@ func (h *Handler) TriggerLibraryScan(ctx context.Context, req *ogen.TriggerLibra
 						slog.String("scan_id", scan.ID.String()),
 						slog.Any("error", insertErr),
 					)
+				} else {
+					scan = h.attachScanJob(ctx, scan, res)
 				}
 			}
 		}
@@ -367,6 +544,75 @@ func (h *Handler) TriggerLibraryScan(ctx context.Context, req *ogen.TriggerLibra
 	return convertLibraryScanToOgen(scan), nil
 }
 
+// attachScanJob records the job performing a scan so the scan can be
+// cancelled. The scan is returned unchanged if that fails.
+func (h *Handler) attachScanJob(ctx context.Context, scan *library.LibraryScan, res *rivertype.JobInsertResult) *library.LibraryScan {
+	if res == nil || res.Job == nil {
+		return scan
+	}
+	updated, err := h.libraryService.AttachScanJob(ctx, scan.ID, res.Job.ID)
+	if err != nil {
+		h.logger.Warn("failed to record scan job",
+			slog.String("scan_id", scan.ID.String()),
+			slog.Int64("job_id", res.Job.ID),
+			slog.Any("error", err),
+		)
+		return scan
+	}
+	return updated
+}
+
+// CancelLibraryScan cancels a pending or running library scan. Admin only.
+// POST /api/v1/libraries/{libraryId}/scans/{scanId}/cancel
+func (h *Handler) CancelLibraryScan(ctx context.Context, params ogen.CancelLibraryScanParams) (ogen.CancelLibraryScanRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.CancelLibraryScanUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.CancelLibraryScanForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	scan, err := h.libraryService.GetScan(ctx, params.ScanId)
+	if err != nil && !errors.Is(err, library.ErrScanNotFound) {
+		h.logger.Error("failed to get library scan", slog.Any("error", err))
+		return nil, err
+	}
+	if err != nil || scan.LibraryID != params.LibraryId {
+		return &ogen.CancelLibraryScanNotFound{Code: 404, Message: "Scan not found"}, nil
+	}
+
+	// Mark the scan first so the worker sees it as already cancelled.
+	scan, err = h.libraryService.CancelActiveScan(ctx, params.ScanId)
+	if err != nil {
+		if errors.Is(err, library.ErrScanNotActive) {
+			return &ogen.CancelLibraryScanConflict{Code: 409, Message: "Scan is not pending or running"}, nil
+		}
+		h.logger.Error("failed to cancel library scan", slog.Any("error", err))
+		return nil, err
+	}
+
+	if scan.JobID != nil && h.riverClient != nil {
+		if _, err := h.riverClient.JobCancel(ctx, *scan.JobID); err != nil {
+			h.logger.Warn("failed to cancel scan job",
+				slog.String("scan_id", scan.ID.String()),
+				slog.Int64("job_id", *scan.JobID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if h.notificationService != nil {
+		_ = h.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanCancelled).
+			WithData("library_id", scan.LibraryID.String()).
+			WithData("scan_id", scan.ID.String()))
+	}
+
+	return convertLibraryScanToOgen(scan), nil
+}
+
 // ListLibraryScans returns the scan history for a library.
 // GET /api/v1/libraries/{libraryId}/scans
 func (h *Handler) ListLibraryScans(ctx context.Context, params ogen.ListLibraryScansParams) (ogen.ListLibraryScansRes, error) {
@@ -608,6 +854,7 @@ func convertLibraries(libs []library.Library) []ogen.Library {
 			ScanOnStartup:      lib.ScanOnStartup,
 			RealtimeMonitoring: lib.RealtimeMonitoring,
 			MetadataProvider:   optStringFromPtr(lib.MetadataProvider),
+			MetadataPolicy:     metadataPolicyToOgen(lib.MetadataPolicy),
 			PreferredLanguage:  optStringFromVal(lib.PreferredLanguage),
 			ScannerConfig:      convertScannerConfigToOgen(lib.ScannerConfig),
 			CreatedAt:          lib.CreatedAt,
@@ -627,6 +874,7 @@ func convertLibraryToOgen(lib *library.Library) *ogen.Library {
 		ScanOnStartup:      lib.ScanOnStartup,
 		RealtimeMonitoring: lib.RealtimeMonitoring,
 		MetadataProvider:   optStringFromPtr(lib.MetadataProvider),
+		MetadataPolicy:     metadataPolicyToOgen(lib.MetadataPolicy),
 		PreferredLanguage:  optStringFromVal(lib.PreferredLanguage),
 		ScannerConfig:      convertScannerConfigToOgen(lib.ScannerConfig),
 		CreatedAt:          lib.CreatedAt,
@@ -634,6 +882,80 @@ func convertLibraryToOgen(lib *library.Library) *ogen.Library {
 	}
 }
 
+func metadataPolicyFromOgen(p ogen.MetadataProviderPolicy) metadata.ProviderPolicy {
+	policy := metadata.ProviderPolicy{
+		Providers:              providerChainFromOgen(p.Providers),
+		EnableProviderFallback: optPtr(p.EnableProviderFallback),
+		EnableEnrichment:       optPtr(p.EnableEnrichment),
+	}
+	if fields, ok := p.Fields.Get(); ok {
+		chains := map[metadata.Field]ogen.MetadataProviderChain{
+			metadata.FieldTitle:    fields.Title,
+			metadata.FieldOverview: fields.Overview,
+			metadata.FieldEpisodes: fields.Episodes,
+			metadata.FieldImages:   fields.Images,
+			metadata.FieldRatings:  fields.Ratings,
+			metadata.FieldCast:     fields.Cast,
+			metadata.FieldTags:     fields.Tags,
+		}
+		for field, chain := range chains {
+			if len(chain) == 0 {
+				continue
+			}
+			if policy.Fields == nil {
+				policy.Fields = make(map[metadata.Field][]metadata.ProviderID)
+			}
+			policy.Fields[field] = providerChainFromOgen(chain)
+		}
+	}
+	return policy
+}
+
+func metadataPolicyToOgen(p metadata.ProviderPolicy) ogen.OptMetadataProviderPolicy {
+	if p.IsZero() {
+		return ogen.OptMetadataProviderPolicy{}
+	}
+	o := ogen.MetadataProviderPolicy{
+		Providers: providerChainToOgen(p.Providers),
+	}
+	setOpt(&o.EnableProviderFallback, p.EnableProviderFallback)
+	setOpt(&o.EnableEnrichment, p.EnableEnrichment)
+	if len(p.Fields) > 0 {
+		o.Fields.SetTo(ogen.MetadataProviderPolicyFields{
+			Title:    providerChainToOgen(p.Fields[metadata.FieldTitle]),
+			Overview: providerChainToOgen(p.Fields[metadata.FieldOverview]),
+			Episodes: providerChainToOgen(p.Fields[metadata.FieldEpisodes]),
+			Images:   providerChainToOgen(p.Fields[metadata.FieldImages]),
+			Ratings:  providerChainToOgen(p.Fields[metadata.FieldRatings]),
+			Cast:     providerChainToOgen(p.Fields[metadata.FieldCast]),
+			Tags:     providerChainToOgen(p.Fields[metadata.FieldTags]),
+		})
+	}
+	return ogen.NewOptMetadataProviderPolicy(o)
+}
+
+func providerChainFromOgen(chain ogen.MetadataProviderChain) []metadata.ProviderID {
+	if len(chain) == 0 {
+		return nil
+	}
+	out := make([]metadata.ProviderID, len(chain))
+	for i, id := range chain {
+		out[i] = metadata.ProviderID(id)
+	}
+	return out
+}
+
+func providerChainToOgen(chain []metadata.ProviderID) ogen.MetadataProviderChain {
+	if len(chain) == 0 {
+		return nil
+	}
+	out := make(ogen.MetadataProviderChain, len(chain))
+	for i, id := range chain {
+		out[i] = string(id)
+	}
+	return out
+}
+
 func convertLibraryScanToOgen(scan *library.LibraryScan) *ogen.LibraryScan {
 	result := &ogen.LibraryScan{
 		ID:           scan.ID,
@@ -650,6 +972,9 @@ func convertLibraryScanToOgen(scan *library.LibraryScan) *ogen.LibraryScan {
 		CompletedAt:  optDateTimeFromPtr(scan.CompletedAt),
 		CreatedAt:    scan.CreatedAt,
 	}
+	if scan.JobID != nil {
+		result.JobID = ogen.NewOptInt64(*scan.JobID)
+	}
 	return result
 }
 
@@ -671,6 +996,9 @@ func convertLibraryScans(scans []library.LibraryScan) []ogen.LibraryScan {
 			CompletedAt:  optDateTimeFromPtr(scan.CompletedAt),
 			CreatedAt:    scan.CreatedAt,
 		}
+		if scan.JobID != nil {
+			result[i].JobID = ogen.NewOptInt64(*scan.JobID)
+		}
 	}
 	return result
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/lusoris/revenge/internal/infra/logging"
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/metadata"
 	"github.com/lusoris/revenge/internal/service/rbac"
 	"github.com/lusoris/revenge/internal/testutil"
 )
@@ -172,6 +173,27 @@ func TestHandler_ListLibraryScans_NoAuth(t *testing.T) {
 	assert.Equal(t, "Authentication required", unauthorized.Message)
 }
 
+// TestHandler_CancelLibraryScan_NoAuth verifies that CancelLibraryScan returns 401
+// when no user is present in the context.
+func TestHandler_CancelLibraryScan_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{
+		logger: logging.NewTestLogger(),
+	}
+
+	ctx := context.Background()
+	params := ogen.CancelLibraryScanParams{LibraryId: uuid.New(), ScanId: uuid.New()}
+
+	result, err := handler.CancelLibraryScan(ctx, params)
+	require.NoError(t, err)
+
+	unauthorized, ok := result.(*ogen.CancelLibraryScanUnauthorized)
+	require.True(t, ok, "expected *ogen.CancelLibraryScanUnauthorized, got %T", result)
+	assert.Equal(t, 401, unauthorized.Code)
+	assert.Equal(t, "Authentication required", unauthorized.Message)
+}
+
 // TestHandler_ListLibraryPermissions_NotAdmin verifies that ListLibraryPermissions returns 401
 // when no user is in the context (unauthenticated).
 func TestHandler_ListLibraryPermissions_NotAdmin(t *testing.T) {
@@ -612,3 +634,89 @@ func TestHandler_ListGenres_TVShowError(t *testing.T) {
 	require.True(t, ok, "expected *ogen.Error, got %T", result)
 	assert.Equal(t, 500, errResp.Code)
 }
+
+// TestHandler_CancelLibraryScan_AdminSuccess verifies that a triggered scan
+// records its job and that cancelling it stops the job and marks the scan
+// cancelled. A second cancel is rejected.
+func TestHandler_CancelLibraryScan_AdminSuccess(t *testing.T) {
+	t.Parallel()
+	handler, _, adminID := setupLibraryTestHandler(t)
+	mockRiver := &mockRiverClient{}
+	handler.riverClient = mockRiver
+
+	ctx := WithUserID(context.Background(), adminID)
+
+	createResult, err := handler.CreateLibrary(ctx, &ogen.CreateLibraryRequest{
+		Name:  "Movies",
+		Type:  ogen.CreateLibraryRequestTypeMovie,
+		Paths: []string{"/media/movies"},
+	})
+	require.NoError(t, err)
+	lib, ok := createResult.(*ogen.Library)
+	require.True(t, ok, "expected *ogen.Library from create, got %T", createResult)
+
+	triggerResult, err := handler.TriggerLibraryScan(ctx,
+		&ogen.TriggerLibraryScanReq{ScanType: ogen.TriggerLibraryScanReqScanType("full")},
+		ogen.TriggerLibraryScanParams{LibraryId: lib.ID})
+	require.NoError(t, err)
+	scan, ok := triggerResult.(*ogen.LibraryScan)
+	require.True(t, ok, "expected *ogen.LibraryScan, got %T", triggerResult)
+	assert.Equal(t, ogen.NewOptInt64(1), scan.JobID)
+
+	params := ogen.CancelLibraryScanParams{LibraryId: lib.ID, ScanId: scan.ID}
+	result, err := handler.CancelLibraryScan(ctx, params)
+	require.NoError(t, err)
+
+	cancelled, ok := result.(*ogen.LibraryScan)
+	require.True(t, ok, "expected *ogen.LibraryScan, got %T", result)
+	assert.Equal(t, ogen.LibraryScanStatus("cancelled"), cancelled.Status)
+	assert.True(t, cancelled.CompletedAt.IsSet())
+	assert.Equal(t, []int64{1}, mockRiver.cancelledJobs)
+
+	result, err = handler.CancelLibraryScan(ctx, params)
+	require.NoError(t, err)
+	conflict, ok := result.(*ogen.CancelLibraryScanConflict)
+	require.True(t, ok, "expected *ogen.CancelLibraryScanConflict, got %T", result)
+	assert.Equal(t, 409, conflict.Code)
+}
+
+// TestHandler_CancelLibraryScan_NotFound verifies that cancelling an unknown
+// scan returns a 404 Not Found response.
+func TestHandler_CancelLibraryScan_NotFound(t *testing.T) {
+	t.Parallel()
+	handler, _, adminID := setupLibraryTestHandler(t)
+
+	ctx := WithUserID(context.Background(), adminID)
+
+	params := ogen.CancelLibraryScanParams{LibraryId: uuid.New(), ScanId: uuid.New()}
+	result, err := handler.CancelLibraryScan(ctx, params)
+	require.NoError(t, err)
+
+	notFound, ok := result.(*ogen.CancelLibraryScanNotFound)
+	require.True(t, ok, "expected *ogen.CancelLibraryScanNotFound, got %T", result)
+	assert.Equal(t, 404, notFound.Code)
+	assert.Equal(t, "Scan not found", notFound.Message)
+}
+
+// TestMetadataPolicyConverters verifies that provider policies survive the
+// round trip through the API types.
+func TestMetadataPolicyConverters(t *testing.T) {
+	t.Parallel()
+
+	policy := metadata.ProviderPolicy{
+		Providers: []metadata.ProviderID{metadata.ProviderAniList, metadata.ProviderAniDB},
+		Fields: map[metadata.Field][]metadata.ProviderID{
+			metadata.FieldImages: {metadata.ProviderTMDb},
+		},
+		EnableProviderFallback: new(false),
+	}
+
+	o, ok := metadataPolicyToOgen(policy).Get()
+	require.True(t, ok)
+	assert.Equal(t, ogen.MetadataProviderChain{"tmdb"}, o.Fields.Value.Images)
+	assert.Nil(t, o.Fields.Value.Title)
+	assert.False(t, o.EnableEnrichment.IsSet())
+
+	assert.Equal(t, policy, metadataPolicyFromOgen(o))
+	assert.False(t, metadataPolicyToOgen(metadata.ProviderPolicy{}).IsSet())
+}
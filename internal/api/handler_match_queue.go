@@ -0,0 +1,307 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/validate"
+)
+
+// movieMatchService is the part of the movie library service used for
+// manual identification.
+type movieMatchService interface {
+	IdentifyFile(ctx context.Context, filePath string, match movie.ManualMatch) (*movie.MatchResult, error)
+	UnlockFileMatch(ctx context.Context, fileID uuid.UUID) (*movie.MovieFile, error)
+}
+
+// errInvalidIdentifyRequest is returned when an identify request names no
+// usable candidate or provider ID.
+var errInvalidIdentifyRequest = errors.New("invalid identify request")
+
+// AdminListMatchQueue lists files waiting for manual identification. Admin only.
+// GET /api/v1/admin/match-queue
+func (h *Handler) AdminListMatchQueue(ctx context.Context, params ogen.AdminListMatchQueueParams) (ogen.AdminListMatchQueueRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminListMatchQueueUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminListMatchQueueForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	filter := library.MatchQueueFilter{}
+	if params.Status.IsSet() {
+		status := string(params.Status.Value)
+		filter.Status = &status
+	}
+	if params.ContentType.IsSet() {
+		contentType := string(params.ContentType.Value)
+		filter.ContentType = &contentType
+	}
+	if params.LibraryID.IsSet() {
+		libraryID := params.LibraryID.Value
+		filter.LibraryID = &libraryID
+	}
+	if params.Limit.IsSet() {
+		l, err := validate.SafeInt32(params.Limit.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit: %w", err)
+		}
+		filter.Limit = l
+	}
+	if params.Offset.IsSet() {
+		o, err := validate.SafeInt32(params.Offset.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset: %w", err)
+		}
+		filter.Offset = o
+	}
+
+	items, total, err := h.matchQueueService.List(ctx, filter)
+	if err != nil {
+		h.logger.Error("failed to list match queue", slog.Any("error", err))
+		return nil, err
+	}
+
+	result := make([]ogen.MatchQueueItem, len(items))
+	for i := range items {
+		result[i] = *matchQueueItemToOgen(&items[i])
+	}
+
+	return &ogen.MatchQueueListResponse{
+		Items: result,
+		Total: total,
+	}, nil
+}
+
+// AdminGetMatchQueueItem returns a single queued file. Admin only.
+// GET /api/v1/admin/match-queue/{itemId}
+func (h *Handler) AdminGetMatchQueueItem(ctx context.Context, params ogen.AdminGetMatchQueueItemParams) (ogen.AdminGetMatchQueueItemRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminGetMatchQueueItemUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminGetMatchQueueItemForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	item, err := h.matchQueueService.Get(ctx, params.ItemId)
+	if err != nil {
+		if errors.Is(err, library.ErrMatchQueueItemNotFound) {
+			return &ogen.AdminGetMatchQueueItemNotFound{Code: 404, Message: "Match queue item not found"}, nil
+		}
+		h.logger.Error("failed to get match queue item", slog.Any("error", err))
+		return nil, err
+	}
+
+	return matchQueueItemToOgen(item), nil
+}
+
+// AdminIdentifyMatchQueueItem manually matches a queued file by candidate or
+// provider ID and locks the match. Admin only.
+// POST /api/v1/admin/match-queue/{itemId}/identify
+func (h *Handler) AdminIdentifyMatchQueueItem(ctx context.Context, req *ogen.IdentifyMatchRequest, params ogen.AdminIdentifyMatchQueueItemParams) (ogen.AdminIdentifyMatchQueueItemRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminIdentifyMatchQueueItemUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminIdentifyMatchQueueItemForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	item, err := h.matchQueueService.Get(ctx, params.ItemId)
+	if err != nil {
+		if errors.Is(err, library.ErrMatchQueueItemNotFound) {
+			return &ogen.AdminIdentifyMatchQueueItemNotFound{Code: 404, Message: "Match queue item not found"}, nil
+		}
+		h.logger.Error("failed to get match queue item", slog.Any("error", err))
+		return nil, err
+	}
+
+	if item.ContentType != library.LibraryTypeMovie {
+		return &ogen.AdminIdentifyMatchQueueItemBadRequest{
+			Code:    400,
+			Message: "Manual identification is only supported for movie files",
+		}, nil
+	}
+
+	match, err := manualMovieMatchFromRequest(req, item.Candidates)
+	if err != nil {
+		return &ogen.AdminIdentifyMatchQueueItemBadRequest{Code: 400, Message: err.Error()}, nil
+	}
+
+	result, err := h.movieMatchService.IdentifyFile(ctx, item.FilePath, match)
+	if err != nil {
+		if errors.Is(err, movie.ErrNoProviderID) {
+			return &ogen.AdminIdentifyMatchQueueItemBadRequest{Code: 400, Message: err.Error()}, nil
+		}
+		h.logger.Error("failed to identify file",
+			slog.String("file_path", item.FilePath),
+			slog.Any("error", err),
+		)
+		return nil, err
+	}
+
+	resolved, err := h.matchQueueService.Resolve(ctx, item.ID, result.Movie.ID, &adminID)
+	if err != nil {
+		h.logger.Error("failed to resolve match queue item", slog.Any("error", err))
+		return nil, err
+	}
+
+	return matchQueueItemToOgen(resolved), nil
+}
+
+// AdminIgnoreMatchQueueItem hides a queued file. Admin only.
+// POST /api/v1/admin/match-queue/{itemId}/ignore
+func (h *Handler) AdminIgnoreMatchQueueItem(ctx context.Context, params ogen.AdminIgnoreMatchQueueItemParams) (ogen.AdminIgnoreMatchQueueItemRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminIgnoreMatchQueueItemUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminIgnoreMatchQueueItemForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	item, err := h.matchQueueService.Ignore(ctx, params.ItemId)
+	if err != nil {
+		if errors.Is(err, library.ErrMatchQueueItemNotFound) {
+			return &ogen.AdminIgnoreMatchQueueItemNotFound{Code: 404, Message: "Match queue item not found"}, nil
+		}
+		if errors.Is(err, library.ErrMatchQueueItemClosed) {
+			return &ogen.AdminIgnoreMatchQueueItemConflict{Code: 409, Message: "Match queue item already resolved"}, nil
+		}
+		h.logger.Error("failed to ignore match queue item", slog.Any("error", err))
+		return nil, err
+	}
+
+	return matchQueueItemToOgen(item), nil
+}
+
+// AdminUnlockMovieFileMatch clears a manual match lock. Admin only.
+// DELETE /api/v1/admin/movies/files/{fileId}/match-lock
+func (h *Handler) AdminUnlockMovieFileMatch(ctx context.Context, params ogen.AdminUnlockMovieFileMatchParams) (ogen.AdminUnlockMovieFileMatchRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminUnlockMovieFileMatchUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminUnlockMovieFileMatchForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	file, err := h.movieMatchService.UnlockFileMatch(ctx, params.FileId)
+	if err != nil {
+		if errors.Is(err, movie.ErrMovieFileNotFound) {
+			return &ogen.AdminUnlockMovieFileMatchNotFound{Code: 404, Message: "Movie file not found"}, nil
+		}
+		h.logger.Error("failed to unlock movie file match", slog.Any("error", err))
+		return nil, err
+	}
+
+	return movieFileToOgen(file), nil
+}
+
+// manualMovieMatchFromRequest resolves an identify request into provider IDs,
+// either from the chosen candidate or from an explicit provider ID.
+func manualMovieMatchFromRequest(req *ogen.IdentifyMatchRequest, candidates []library.MatchCandidate) (movie.ManualMatch, error) {
+	if req.CandidateIndex.IsSet() {
+		idx := req.CandidateIndex.Value
+		if idx < 0 || idx >= len(candidates) {
+			return movie.ManualMatch{}, fmt.Errorf("%w: candidate index out of range", errInvalidIdentifyRequest)
+		}
+		c := candidates[idx]
+		match := movie.ManualMatch{IMDbID: c.IMDbID}
+		if c.Provider == "tmdb" {
+			id, err := parseTMDbID(c.ProviderID)
+			if err != nil {
+				return movie.ManualMatch{}, err
+			}
+			match.TMDbID = &id
+		}
+		return match, nil
+	}
+
+	if !req.Provider.IsSet() || !req.ProviderID.IsSet() || strings.TrimSpace(req.ProviderID.Value) == "" {
+		return movie.ManualMatch{}, fmt.Errorf("%w: candidate_index or provider and provider_id required", errInvalidIdentifyRequest)
+	}
+
+	providerID := strings.TrimSpace(req.ProviderID.Value)
+	switch req.Provider.Value {
+	case ogen.IdentifyMatchRequestProviderTmdb:
+		id, err := parseTMDbID(providerID)
+		if err != nil {
+			return movie.ManualMatch{}, err
+		}
+		return movie.ManualMatch{TMDbID: &id}, nil
+	case ogen.IdentifyMatchRequestProviderImdb:
+		if !strings.HasPrefix(providerID, "tt") {
+			return movie.ManualMatch{}, fmt.Errorf("%w: IMDb IDs start with tt", errInvalidIdentifyRequest)
+		}
+		return movie.ManualMatch{IMDbID: &providerID}, nil
+	default:
+		return movie.ManualMatch{}, fmt.Errorf("%w: %s IDs are not supported for movies", errInvalidIdentifyRequest, req.Provider.Value)
+	}
+}
+
+func parseTMDbID(s string) (int32, error) {
+	id, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: invalid TMDb ID %q", errInvalidIdentifyRequest, s)
+	}
+	return int32(id), nil
+}
+
+func matchQueueItemToOgen(item *library.MatchQueueItem) *ogen.MatchQueueItem {
+	o := &ogen.MatchQueueItem{
+		ID:          item.ID,
+		ContentType: ogen.MatchQueueItemContentType(item.ContentType),
+		FilePath:    item.FilePath,
+		Status:      ogen.MatchQueueItemStatus(item.Status),
+		Candidates:  make([]ogen.MatchCandidate, len(item.Candidates)),
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+	setOpt(&o.LibraryID, item.LibraryID)
+	setOpt(&o.ParsedTitle, item.ParsedTitle)
+	setOptConv(&o.ParsedYear, item.ParsedYear, int32ToInt)
+	setOpt(&o.Reason, item.Reason)
+	setOpt(&o.BestConfidence, item.BestConfidence)
+	setOpt(&o.ResolvedContentID, item.ResolvedContentID)
+	setOpt(&o.ResolvedAt, item.ResolvedAt)
+
+	for i, c := range item.Candidates {
+		oc := ogen.MatchCandidate{
+			Provider:   c.Provider,
+			ProviderID: c.ProviderID,
+			Title:      c.Title,
+			Confidence: c.Confidence,
+		}
+		setOpt(&oc.ImdbID, c.IMDbID)
+		setOptConv(&oc.Year, c.Year, int32ToInt)
+		setOpt(&oc.Overview, c.Overview)
+		setOpt(&oc.PosterPath, c.PosterPath)
+		o.Candidates[i] = oc
+	}
+
+	return o
+}
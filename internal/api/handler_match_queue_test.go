@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+func TestHandler_AdminListMatchQueue_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.AdminListMatchQueue(context.Background(), ogen.AdminListMatchQueueParams{})
+	require.NoError(t, err)
+
+	unauthorized, ok := result.(*ogen.AdminListMatchQueueUnauthorized)
+	require.True(t, ok, "expected *ogen.AdminListMatchQueueUnauthorized, got %T", result)
+	assert.Equal(t, 401, unauthorized.Code)
+}
+
+func TestHandler_AdminIdentifyMatchQueueItem_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.AdminIdentifyMatchQueueItem(context.Background(), &ogen.IdentifyMatchRequest{},
+		ogen.AdminIdentifyMatchQueueItemParams{ItemId: uuid.New()})
+	require.NoError(t, err)
+
+	unauthorized, ok := result.(*ogen.AdminIdentifyMatchQueueItemUnauthorized)
+	require.True(t, ok, "expected *ogen.AdminIdentifyMatchQueueItemUnauthorized, got %T", result)
+	assert.Equal(t, 401, unauthorized.Code)
+}
+
+func TestHandler_AdminUnlockMovieFileMatch_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.AdminUnlockMovieFileMatch(context.Background(), ogen.AdminUnlockMovieFileMatchParams{FileId: uuid.New()})
+	require.NoError(t, err)
+
+	_, ok := result.(*ogen.AdminUnlockMovieFileMatchUnauthorized)
+	require.True(t, ok, "expected *ogen.AdminUnlockMovieFileMatchUnauthorized, got %T", result)
+}
+
+func TestManualMovieMatchFromRequest(t *testing.T) {
+	t.Parallel()
+
+	imdb := "tt0133093"
+	candidates := []library.MatchCandidate{
+		{Provider: "tmdb", ProviderID: "603", IMDbID: &imdb, Title: "The Matrix", Confidence: 0.42},
+	}
+
+	t.Run("candidate index", func(t *testing.T) {
+		match, err := manualMovieMatchFromRequest(&ogen.IdentifyMatchRequest{
+			CandidateIndex: ogen.NewOptInt(0),
+		}, candidates)
+		require.NoError(t, err)
+		require.NotNil(t, match.TMDbID)
+		assert.Equal(t, int32(603), *match.TMDbID)
+		assert.Equal(t, &imdb, match.IMDbID)
+	})
+
+	t.Run("candidate index out of range", func(t *testing.T) {
+		_, err := manualMovieMatchFromRequest(&ogen.IdentifyMatchRequest{
+			CandidateIndex: ogen.NewOptInt(3),
+		}, candidates)
+		assert.ErrorIs(t, err, errInvalidIdentifyRequest)
+	})
+
+	t.Run("tmdb id", func(t *testing.T) {
+		match, err := manualMovieMatchFromRequest(&ogen.IdentifyMatchRequest{
+			Provider:   ogen.NewOptIdentifyMatchRequestProvider(ogen.IdentifyMatchRequestProviderTmdb),
+			ProviderID: ogen.NewOptString("27205"),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(27205), *match.TMDbID)
+		assert.Nil(t, match.IMDbID)
+	})
+
+	t.Run("imdb id", func(t *testing.T) {
+		match, err := manualMovieMatchFromRequest(&ogen.IdentifyMatchRequest{
+			Provider:   ogen.NewOptIdentifyMatchRequestProvider(ogen.IdentifyMatchRequestProviderImdb),
+			ProviderID: ogen.NewOptString(imdb),
+		}, nil)
+		require.NoError(t, err)
+		assert.Nil(t, match.TMDbID)
+		assert.Equal(t, imdb, *match.IMDbID)
+	})
+
+	t.Run("invalid ids", func(t *testing.T) {
+		for _, req := range []*ogen.IdentifyMatchRequest{
+			{},
+			{Provider: ogen.NewOptIdentifyMatchRequestProvider(ogen.IdentifyMatchRequestProviderTmdb), ProviderID: ogen.NewOptString("abc")},
+			{Provider: ogen.NewOptIdentifyMatchRequestProvider(ogen.IdentifyMatchRequestProviderImdb), ProviderID: ogen.NewOptString("133093")},
+			{Provider: ogen.NewOptIdentifyMatchRequestProvider("tvdb"), ProviderID: ogen.NewOptString("81189")},
+		} {
+			_, err := manualMovieMatchFromRequest(req, nil)
+			assert.ErrorIs(t, err, errInvalidIdentifyRequest)
+		}
+	})
+}
+
+func TestMatchQueueItemToOgen(t *testing.T) {
+	t.Parallel()
+
+	libraryID := uuid.New()
+	title := "Unknown Rip"
+	best := 0.31
+	now := time.Now()
+
+	o := matchQueueItemToOgen(&library.MatchQueueItem{
+		ID:             uuid.New(),
+		LibraryID:      &libraryID,
+		ContentType:    "movie",
+		FilePath:       "/movies/Unknown Rip.mkv",
+		ParsedTitle:    &title,
+		Status:         library.MatchQueueStatusLowConfidence,
+		BestConfidence: &best,
+		Candidates: []library.MatchCandidate{
+			{Provider: "tmdb", ProviderID: "1", Title: "Something", Confidence: best},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	assert.Equal(t, ogen.MatchQueueItemStatusLowConfidence, o.Status)
+	assert.Equal(t, libraryID, o.LibraryID.Value)
+	assert.Equal(t, title, o.ParsedTitle.Value)
+	assert.False(t, o.ResolvedContentID.IsSet())
+	require.Len(t, o.Candidates, 1)
+	assert.Equal(t, "1", o.Candidates[0].ProviderID)
+}
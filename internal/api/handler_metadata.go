@@ -9,7 +9,10 @@ import (
 
 	"log/slog"
 
+	"github.com/google/uuid"
+
 	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/metadata"
 )
 
@@ -187,8 +190,15 @@ func (h *Handler) GetProxiedImage(ctx context.Context, params ogen.GetProxiedIma
 	size := string(params.Size)
 	path := "/" + params.Path
 
-	// Fetch image
-	data, contentType, err := h.imageService.FetchImage(ctx, imageType, path, size)
+	// Fetch image; local artwork paths resolve to files next to the media
+	var data []byte
+	var contentType string
+	var err error
+	if artworkID, ok := artwork.ParseLocalPath(path); ok {
+		data, contentType, err = h.fetchLocalArtwork(ctx, artworkID, imageType, size)
+	} else {
+		data, contentType, err = h.imageService.FetchImage(ctx, imageType, path, size)
+	}
 	if err != nil {
 		h.logger.Error("Image fetch failed", slog.Any("error", err))
 		return &ogen.GetProxiedImageNotFound{}, nil
@@ -208,6 +218,22 @@ func (h *Handler) GetProxiedImage(ctx context.Context, params ogen.GetProxiedIma
 	}
 }
 
+// fetchLocalArtwork serves a local artwork record through the image
+// service, which resizes and caches it.
+func (h *Handler) fetchLocalArtwork(ctx context.Context, artworkID uuid.UUID, imageType, size string) ([]byte, string, error) {
+	if h.artworkService == nil {
+		return nil, "", artwork.ErrNotFound
+	}
+	art, err := h.artworkService.Get(ctx, artworkID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !art.IsLocal() {
+		return nil, "", artwork.ErrNotFound
+	}
+	return h.imageService.FetchLocalImage(ctx, imageType, art.Path, size)
+}
+
 // GetCollectionMetadata gets detailed collection info from TMDb.
 func (h *Handler) GetCollectionMetadata(ctx context.Context, params ogen.GetCollectionMetadataParams) (ogen.GetCollectionMetadataRes, error) {
 	// Get collection details from shared metadata service
@@ -1206,6 +1232,15 @@ func convertImages(images *metadata.Images) *ogen.MetadataImages {
 	if len(images.Logos) > 0 {
 		response.Logos = convertImageSlice(images.Logos)
 	}
+	if len(images.ClearArt) > 0 {
+		response.Clearart = convertImageSlice(images.ClearArt)
+	}
+	if len(images.Banners) > 0 {
+		response.Banners = convertImageSlice(images.Banners)
+	}
+	if len(images.Thumbs) > 0 {
+		response.Thumbs = convertImageSlice(images.Thumbs)
+	}
 	if len(images.Stills) > 0 {
 		response.Stills = convertImageSlice(images.Stills)
 	}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+)
+
+// UpdateMovieMetadata applies a manual metadata edit to a movie and locks
+// the edited fields (admin only).
+func (h *Handler) UpdateMovieMetadata(ctx context.Context, req *ogen.MovieMetadataEdit, params ogen.UpdateMovieMetadataParams) (ogen.UpdateMovieMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UpdateMovieMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UpdateMovieMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceManual, &adminID)
+
+	m, err := h.movieHandler.UpdateMetadata(ctx, params.ID.String(), movieMetadataEditFromOgen(req))
+	if err != nil {
+		if errors.Is(err, movie.ErrMovieNotFound) {
+			return (*ogen.UpdateMovieMetadataNotFound)(OgenNotFound("Movie not found")), nil
+		}
+		return nil, err
+	}
+
+	return movieToOgen(m), nil
+}
+
+// UnlockMovieMetadataField clears the lock on a movie metadata field (admin only).
+func (h *Handler) UnlockMovieMetadataField(ctx context.Context, params ogen.UnlockMovieMetadataFieldParams) (ogen.UnlockMovieMetadataFieldRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UnlockMovieMetadataFieldUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UnlockMovieMetadataFieldForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	m, err := h.movieHandler.UnlockMetadataField(ctx, params.ID.String(), string(params.Field))
+	if err != nil {
+		if errors.Is(err, content.ErrUnknownLockField) {
+			return (*ogen.UnlockMovieMetadataFieldBadRequest)(OgenBadRequest(err.Error())), nil
+		}
+		if errors.Is(err, movie.ErrMovieNotFound) {
+			return (*ogen.UnlockMovieMetadataFieldNotFound)(OgenNotFound("Movie not found")), nil
+		}
+		return nil, err
+	}
+
+	return movieToOgen(m), nil
+}
+
+// UpdateTVShowMetadata applies a manual metadata edit to a series and locks
+// the edited fields (admin only).
+func (h *Handler) UpdateTVShowMetadata(ctx context.Context, req *ogen.TVSeriesMetadataEdit, params ogen.UpdateTVShowMetadataParams) (ogen.UpdateTVShowMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UpdateTVShowMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UpdateTVShowMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceManual, &adminID)
+
+	series, err := h.tvshowService.UpdateSeriesMetadata(ctx, params.ID, seriesMetadataEditFromOgen(req))
+	if err != nil {
+		return (*ogen.UpdateTVShowMetadataNotFound)(OgenNotFound("TV show not found")), nil
+	}
+
+	return seriesToOgen(series), nil
+}
+
+// UnlockTVShowMetadataField clears the lock on a series metadata field (admin only).
+func (h *Handler) UnlockTVShowMetadataField(ctx context.Context, params ogen.UnlockTVShowMetadataFieldParams) (ogen.UnlockTVShowMetadataFieldRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UnlockTVShowMetadataFieldUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UnlockTVShowMetadataFieldForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	series, err := h.tvshowService.UnlockSeriesFields(ctx, params.ID, string(params.Field))
+	if err != nil {
+		if errors.Is(err, content.ErrUnknownLockField) {
+			return (*ogen.UnlockTVShowMetadataFieldBadRequest)(OgenBadRequest(err.Error())), nil
+		}
+		return (*ogen.UnlockTVShowMetadataFieldNotFound)(OgenNotFound("TV show not found")), nil
+	}
+
+	return seriesToOgen(series), nil
+}
+
+// UpdateTVSeasonMetadata applies a manual metadata edit to a season and locks
+// the edited fields (admin only).
+func (h *Handler) UpdateTVSeasonMetadata(ctx context.Context, req *ogen.TVSeasonMetadataEdit, params ogen.UpdateTVSeasonMetadataParams) (ogen.UpdateTVSeasonMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UpdateTVSeasonMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UpdateTVSeasonMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceManual, &adminID)
+
+	season, err := h.tvshowService.UpdateSeasonMetadata(ctx, params.ID, tvshow.SeasonMetadataEdit{
+		Name:        optPtr(req.Name),
+		Overview:    optPtr(req.Overview),
+		AirDate:     optPtr(req.AirDate),
+		VoteAverage: optPtr(req.VoteAverage),
+		PosterPath:  optPtr(req.PosterPath),
+	})
+	if err != nil {
+		return (*ogen.UpdateTVSeasonMetadataNotFound)(OgenNotFound("Season not found")), nil
+	}
+
+	return seasonToOgen(season), nil
+}
+
+// UnlockTVSeasonMetadataField clears the lock on a season metadata field (admin only).
+func (h *Handler) UnlockTVSeasonMetadataField(ctx context.Context, params ogen.UnlockTVSeasonMetadataFieldParams) (ogen.UnlockTVSeasonMetadataFieldRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UnlockTVSeasonMetadataFieldUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UnlockTVSeasonMetadataFieldForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	season, err := h.tvshowService.UnlockSeasonFields(ctx, params.ID, string(params.Field))
+	if err != nil {
+		if errors.Is(err, content.ErrUnknownLockField) {
+			return (*ogen.UnlockTVSeasonMetadataFieldBadRequest)(OgenBadRequest(err.Error())), nil
+		}
+		return (*ogen.UnlockTVSeasonMetadataFieldNotFound)(OgenNotFound("Season not found")), nil
+	}
+
+	return seasonToOgen(season), nil
+}
+
+// UpdateTVEpisodeMetadata applies a manual metadata edit to an episode and
+// locks the edited fields (admin only).
+func (h *Handler) UpdateTVEpisodeMetadata(ctx context.Context, req *ogen.TVEpisodeMetadataEdit, params ogen.UpdateTVEpisodeMetadataParams) (ogen.UpdateTVEpisodeMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UpdateTVEpisodeMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UpdateTVEpisodeMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceManual, &adminID)
+
+	episode, err := h.tvshowService.UpdateEpisodeMetadata(ctx, params.ID, tvshow.EpisodeMetadataEdit{
+		Title:       optPtr(req.Title),
+		Overview:    optPtr(req.Overview),
+		AirDate:     optPtr(req.AirDate),
+		VoteAverage: optPtr(req.VoteAverage),
+		StillPath:   optPtr(req.StillPath),
+	})
+	if err != nil {
+		return (*ogen.UpdateTVEpisodeMetadataNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	return episodeToOgen(episode), nil
+}
+
+// UnlockTVEpisodeMetadataField clears the lock on an episode metadata field (admin only).
+func (h *Handler) UnlockTVEpisodeMetadataField(ctx context.Context, params ogen.UnlockTVEpisodeMetadataFieldParams) (ogen.UnlockTVEpisodeMetadataFieldRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UnlockTVEpisodeMetadataFieldUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UnlockTVEpisodeMetadataFieldForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	episode, err := h.tvshowService.UnlockEpisodeFields(ctx, params.ID, string(params.Field))
+	if err != nil {
+		if errors.Is(err, content.ErrUnknownLockField) {
+			return (*ogen.UnlockTVEpisodeMetadataFieldBadRequest)(OgenBadRequest(err.Error())), nil
+		}
+		return (*ogen.UnlockTVEpisodeMetadataFieldNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	return episodeToOgen(episode), nil
+}
+
+// movieMetadataEditFromOgen converts an ogen movie edit request to the domain edit.
+func movieMetadataEditFromOgen(req *ogen.MovieMetadataEdit) movie.MetadataEdit {
+	return movie.MetadataEdit{
+		Title:         optPtr(req.Title),
+		SortTitle:     optPtr(req.SortTitle),
+		OriginalTitle: optPtr(req.OriginalTitle),
+		Overview:      optPtr(req.Overview),
+		Tagline:       optPtr(req.Tagline),
+		ReleaseDate:   optPtr(req.ReleaseDate),
+		Genres:        req.Genres,
+		VoteAverage:   optPtr(req.VoteAverage),
+		PosterPath:    optPtr(req.PosterPath),
+		BackdropPath:  optPtr(req.BackdropPath),
+	}
+}
+
+// seriesMetadataEditFromOgen converts an ogen series edit request to the domain edit.
+func seriesMetadataEditFromOgen(req *ogen.TVSeriesMetadataEdit) tvshow.SeriesMetadataEdit {
+	return tvshow.SeriesMetadataEdit{
+		Title:         optPtr(req.Title),
+		SortTitle:     optPtr(req.SortTitle),
+		OriginalTitle: optPtr(req.OriginalTitle),
+		Overview:      optPtr(req.Overview),
+		Tagline:       optPtr(req.Tagline),
+		FirstAirDate:  optPtr(req.FirstAirDate),
+		Genres:        req.Genres,
+		VoteAverage:   optPtr(req.VoteAverage),
+		PosterPath:    optPtr(req.PosterPath),
+		BackdropPath:  optPtr(req.BackdropPath),
+	}
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+func TestHandler_MetadataEdit_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := context.Background()
+	id := uuid.New()
+
+	movieRes, err := handler.UpdateMovieMetadata(ctx, &ogen.MovieMetadataEdit{}, ogen.UpdateMovieMetadataParams{ID: id})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UpdateMovieMetadataUnauthorized{}, movieRes)
+
+	movieUnlock, err := handler.UnlockMovieMetadataField(ctx, ogen.UnlockMovieMetadataFieldParams{ID: id, Field: ogen.MetadataLockFieldTitle})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UnlockMovieMetadataFieldUnauthorized{}, movieUnlock)
+
+	seriesRes, err := handler.UpdateTVShowMetadata(ctx, &ogen.TVSeriesMetadataEdit{}, ogen.UpdateTVShowMetadataParams{ID: id})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UpdateTVShowMetadataUnauthorized{}, seriesRes)
+
+	seasonRes, err := handler.UpdateTVSeasonMetadata(ctx, &ogen.TVSeasonMetadataEdit{}, ogen.UpdateTVSeasonMetadataParams{ID: id})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UpdateTVSeasonMetadataUnauthorized{}, seasonRes)
+
+	episodeUnlock, err := handler.UnlockTVEpisodeMetadataField(ctx, ogen.UnlockTVEpisodeMetadataFieldParams{ID: id, Field: ogen.MetadataLockFieldStill})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UnlockTVEpisodeMetadataFieldUnauthorized{}, episodeUnlock)
+}
+
+func TestMovieMetadataEditFromOgen(t *testing.T) {
+	t.Parallel()
+
+	release := time.Date(1999, 10, 15, 0, 0, 0, 0, time.UTC)
+	edit := movieMetadataEditFromOgen(&ogen.MovieMetadataEdit{
+		Title:       ogen.NewOptString("Fight Club"),
+		ReleaseDate: ogen.NewOptDate(release),
+		Genres:      []string{"Drama"},
+	})
+
+	require.NotNil(t, edit.Title)
+	assert.Equal(t, "Fight Club", *edit.Title)
+	require.NotNil(t, edit.ReleaseDate)
+	assert.Equal(t, release, *edit.ReleaseDate)
+	assert.Equal(t, []string{"Drama"}, edit.Genres)
+	assert.Nil(t, edit.Overview)
+	assert.Nil(t, edit.PosterPath)
+}
+
+func TestConverters_LockedFields(t *testing.T) {
+	t.Parallel()
+
+	locked := content.LockedFields{content.FieldOverview, content.FieldTitle}
+
+	m := movieToOgen(&movie.Movie{ID: uuid.New(), LockedFields: locked})
+	assert.Equal(t, []string{"overview", "title"}, m.LockedFields)
+
+	sortTitle := "Office, The"
+	s := seriesToOgen(&tvshow.Series{ID: uuid.New(), SortTitle: &sortTitle, LockedFields: locked})
+	assert.Equal(t, []string{"overview", "title"}, s.LockedFields)
+	assert.Equal(t, "Office, The", s.SortTitle.Value)
+
+	e := episodeToOgen(&tvshow.Episode{ID: uuid.New(), LockedFields: locked})
+	assert.Equal(t, []string{"overview", "title"}, e.LockedFields)
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/service/metadatahistory"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// metadataHistoryService lists recorded metadata changes and resolves
+// previous versions.
+type metadataHistoryService interface {
+	List(ctx context.Context, contentType string, contentID uuid.UUID, limit, offset int32) ([]metadatahistory.Change, int64, error)
+	ValuesAt(ctx context.Context, contentType string, contentID uuid.UUID, version int32) (content.Snapshot, error)
+}
+
+// ListMovieMetadataHistory lists the metadata changes of a movie (admin only).
+func (h *Handler) ListMovieMetadataHistory(ctx context.Context, params ogen.ListMovieMetadataHistoryParams) (ogen.ListMovieMetadataHistoryRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.ListMovieMetadataHistoryUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.ListMovieMetadataHistoryForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	return h.listMetadataHistory(ctx, content.ContentTypeMovie, params.ID, params.Limit, params.Offset)
+}
+
+// RollbackMovieMetadata restores the movie metadata of a previous version
+// (admin only).
+func (h *Handler) RollbackMovieMetadata(ctx context.Context, params ogen.RollbackMovieMetadataParams) (ogen.RollbackMovieMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.RollbackMovieMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.RollbackMovieMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	values, err := h.metadataValuesAt(ctx, content.ContentTypeMovie, params.ID, params.Version)
+	if err != nil {
+		if errors.Is(err, metadatahistory.ErrVersionNotFound) {
+			return (*ogen.RollbackMovieMetadataNotFound)(OgenNotFound("Version not found")), nil
+		}
+		return nil, err
+	}
+
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRollback, &adminID)
+	m, err := h.movieHandler.RestoreMetadata(ctx, params.ID.String(), values)
+	if err != nil {
+		if errors.Is(err, content.ErrCannotClearFields) {
+			return &ogen.RollbackMovieMetadataConflict{Code: 409, Message: err.Error()}, nil
+		}
+		if errors.Is(err, movie.ErrMovieNotFound) {
+			return (*ogen.RollbackMovieMetadataNotFound)(OgenNotFound("Movie not found")), nil
+		}
+		return nil, err
+	}
+
+	return movieToOgen(m), nil
+}
+
+// ListTVShowMetadataHistory lists the metadata changes of a series (admin only).
+func (h *Handler) ListTVShowMetadataHistory(ctx context.Context, params ogen.ListTVShowMetadataHistoryParams) (ogen.ListTVShowMetadataHistoryRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.ListTVShowMetadataHistoryUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.ListTVShowMetadataHistoryForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	return h.listMetadataHistory(ctx, content.ContentTypeSeries, params.ID, params.Limit, params.Offset)
+}
+
+// RollbackTVShowMetadata restores the series metadata of a previous version
+// (admin only).
+func (h *Handler) RollbackTVShowMetadata(ctx context.Context, params ogen.RollbackTVShowMetadataParams) (ogen.RollbackTVShowMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.RollbackTVShowMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.RollbackTVShowMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	values, err := h.metadataValuesAt(ctx, content.ContentTypeSeries, params.ID, params.Version)
+	if err != nil {
+		if errors.Is(err, metadatahistory.ErrVersionNotFound) {
+			return (*ogen.RollbackTVShowMetadataNotFound)(OgenNotFound("Version not found")), nil
+		}
+		return nil, err
+	}
+
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRollback, &adminID)
+	series, err := h.tvshowService.RestoreSeriesMetadata(ctx, params.ID, values)
+	if err != nil {
+		if errors.Is(err, content.ErrCannotClearFields) {
+			return &ogen.RollbackTVShowMetadataConflict{Code: 409, Message: err.Error()}, nil
+		}
+		return (*ogen.RollbackTVShowMetadataNotFound)(OgenNotFound("TV show not found")), nil
+	}
+
+	return seriesToOgen(series), nil
+}
+
+// ListTVSeasonMetadataHistory lists the metadata changes of a season (admin only).
+func (h *Handler) ListTVSeasonMetadataHistory(ctx context.Context, params ogen.ListTVSeasonMetadataHistoryParams) (ogen.ListTVSeasonMetadataHistoryRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.ListTVSeasonMetadataHistoryUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.ListTVSeasonMetadataHistoryForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	return h.listMetadataHistory(ctx, content.ContentTypeSeason, params.ID, params.Limit, params.Offset)
+}
+
+// RollbackTVSeasonMetadata restores the season metadata of a previous
+// version (admin only).
+func (h *Handler) RollbackTVSeasonMetadata(ctx context.Context, params ogen.RollbackTVSeasonMetadataParams) (ogen.RollbackTVSeasonMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.RollbackTVSeasonMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.RollbackTVSeasonMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	values, err := h.metadataValuesAt(ctx, content.ContentTypeSeason, params.ID, params.Version)
+	if err != nil {
+		if errors.Is(err, metadatahistory.ErrVersionNotFound) {
+			return (*ogen.RollbackTVSeasonMetadataNotFound)(OgenNotFound("Version not found")), nil
+		}
+		return nil, err
+	}
+
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRollback, &adminID)
+	season, err := h.tvshowService.RestoreSeasonMetadata(ctx, params.ID, values)
+	if err != nil {
+		if errors.Is(err, content.ErrCannotClearFields) {
+			return &ogen.RollbackTVSeasonMetadataConflict{Code: 409, Message: err.Error()}, nil
+		}
+		return (*ogen.RollbackTVSeasonMetadataNotFound)(OgenNotFound("Season not found")), nil
+	}
+
+	return seasonToOgen(season), nil
+}
+
+// ListTVEpisodeMetadataHistory lists the metadata changes of an episode
+// (admin only).
+func (h *Handler) ListTVEpisodeMetadataHistory(ctx context.Context, params ogen.ListTVEpisodeMetadataHistoryParams) (ogen.ListTVEpisodeMetadataHistoryRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.ListTVEpisodeMetadataHistoryUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.ListTVEpisodeMetadataHistoryForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	return h.listMetadataHistory(ctx, content.ContentTypeEpisode, params.ID, params.Limit, params.Offset)
+}
+
+// RollbackTVEpisodeMetadata restores the episode metadata of a previous
+// version, including its numbering (admin only).
+func (h *Handler) RollbackTVEpisodeMetadata(ctx context.Context, params ogen.RollbackTVEpisodeMetadataParams) (ogen.RollbackTVEpisodeMetadataRes, error) {
+	adminID, err := h.requireAdmin(ctx)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.RollbackTVEpisodeMetadataUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.RollbackTVEpisodeMetadataForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	values, err := h.metadataValuesAt(ctx, content.ContentTypeEpisode, params.ID, params.Version)
+	if err != nil {
+		if errors.Is(err, metadatahistory.ErrVersionNotFound) {
+			return (*ogen.RollbackTVEpisodeMetadataNotFound)(OgenNotFound("Version not found")), nil
+		}
+		return nil, err
+	}
+
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRollback, &adminID)
+	episode, err := h.tvshowService.RestoreEpisodeMetadata(ctx, params.ID, values)
+	if err != nil {
+		if errors.Is(err, content.ErrCannotClearFields) {
+			return &ogen.RollbackTVEpisodeMetadataConflict{Code: 409, Message: err.Error()}, nil
+		}
+		return (*ogen.RollbackTVEpisodeMetadataNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	return episodeToOgen(episode), nil
+}
+
+// listMetadataHistory returns a page of an item's metadata changes. Without
+// a history service the history is empty.
+func (h *Handler) listMetadataHistory(ctx context.Context, contentType string, contentID uuid.UUID, limit, offset ogen.OptInt) (*ogen.MetadataHistory, error) {
+	result := &ogen.MetadataHistory{Items: []ogen.MetadataChange{}}
+	if h.metadataHistory == nil {
+		return result, nil
+	}
+
+	changes, total, err := h.metadataHistory.List(ctx, contentType, contentID,
+		util.SafeIntToInt32(limit.Or(20)), util.SafeIntToInt32(offset.Or(0)))
+	if err != nil {
+		return nil, err
+	}
+	for i := range changes {
+		result.Items = append(result.Items, metadataChangeToOgen(&changes[i]))
+	}
+	result.Total = total
+	return result, nil
+}
+
+// metadataValuesAt returns the field values to write back to roll an item
+// back to a version.
+func (h *Handler) metadataValuesAt(ctx context.Context, contentType string, contentID uuid.UUID, version int32) (content.Snapshot, error) {
+	if h.metadataHistory == nil {
+		return nil, metadatahistory.ErrVersionNotFound
+	}
+	return h.metadataHistory.ValuesAt(ctx, contentType, contentID, version)
+}
+
+func metadataChangeToOgen(c *metadatahistory.Change) ogen.MetadataChange {
+	o := ogen.MetadataChange{
+		Version:   c.Version,
+		Source:    ogen.MetadataChangeSource(c.Source),
+		Changes:   make([]ogen.MetadataFieldChange, 0, len(c.Changes)),
+		CreatedAt: c.CreatedAt,
+	}
+	if c.ActorID != nil {
+		o.ActorID = ogen.NewOptUUID(*c.ActorID)
+	}
+
+	for _, field := range slices.Sorted(maps.Keys(c.Changes)) {
+		fc := c.Changes[field]
+		o.Changes = append(o.Changes, ogen.MetadataFieldChange{
+			Field: field,
+			Old:   nilStringToOgen(fc.Old),
+			New:   nilStringToOgen(fc.New),
+		})
+	}
+	return o
+}
+
+// nilStringToOgen returns a set optional string that is null for nil.
+func nilStringToOgen(s *string) ogen.OptNilString {
+	if s == nil {
+		return ogen.OptNilString{Set: true, Null: true}
+	}
+	return ogen.NewOptNilString(*s)
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/metadatahistory"
+)
+
+func TestHandler_MetadataHistory_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := context.Background()
+	id := uuid.New()
+
+	movieRes, err := handler.ListMovieMetadataHistory(ctx, ogen.ListMovieMetadataHistoryParams{ID: id})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.ListMovieMetadataHistoryUnauthorized{}, movieRes)
+
+	rollbackRes, err := handler.RollbackMovieMetadata(ctx, ogen.RollbackMovieMetadataParams{ID: id, Version: 1})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.RollbackMovieMetadataUnauthorized{}, rollbackRes)
+
+	episodeRes, err := handler.RollbackTVEpisodeMetadata(ctx, ogen.RollbackTVEpisodeMetadataParams{ID: id, Version: 0})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.RollbackTVEpisodeMetadataUnauthorized{}, episodeRes)
+}
+
+func TestHandler_ListMetadataHistory_NoService(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.listMetadataHistory(context.Background(), content.ContentTypeMovie, uuid.New(), ogen.OptInt{}, ogen.OptInt{})
+	require.NoError(t, err)
+	assert.Empty(t, result.Items)
+	assert.Zero(t, result.Total)
+
+	_, err = handler.metadataValuesAt(context.Background(), content.ContentTypeMovie, uuid.New(), 1)
+	assert.ErrorIs(t, err, metadatahistory.ErrVersionNotFound)
+}
+
+func TestMetadataChangeToOgen(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	o := metadataChangeToOgen(&metadatahistory.Change{
+		Version: 2,
+		Source:  content.ChangeSourceManual,
+		ActorID: &actorID,
+		Changes: map[string]content.FieldChange{
+			content.FieldTitle:  {Old: new("Dark"), New: new("Dark (2017)")},
+			content.FieldPoster: {New: new("/p.jpg")},
+		},
+		CreatedAt: created,
+	})
+
+	assert.Equal(t, int32(2), o.Version)
+	assert.Equal(t, ogen.MetadataChangeSourceManual, o.Source)
+	assert.Equal(t, ogen.NewOptUUID(actorID), o.ActorID)
+	assert.Equal(t, created, o.CreatedAt)
+	require.Len(t, o.Changes, 2)
+	assert.Equal(t, "poster", o.Changes[0].Field)
+	assert.True(t, o.Changes[0].Old.IsNull())
+	assert.Equal(t, "title", o.Changes[1].Field)
+	assert.Equal(t, ogen.NewOptNilString("Dark"), o.Changes[1].Old)
+	assert.Equal(t, ogen.NewOptNilString("Dark (2017)"), o.Changes[1].New)
+}
@@ -49,6 +49,10 @@ func (m *mockMetadataService) GetMovieImages(_ context.Context, _ string) (*meta
 	return nil, nil
 }
 
+func (m *mockMetadataService) GetAllMovieImages(_ context.Context, _ string) ([]metadata.ProviderImages, error) {
+	return nil, nil
+}
+
 func (m *mockMetadataService) GetMovieReleaseDates(_ context.Context, _ string) ([]metadata.ReleaseDate, error) {
 	return nil, nil
 }
@@ -81,6 +85,10 @@ func (m *mockMetadataService) GetTVShowImages(_ context.Context, _ string) (*met
 	return nil, nil
 }
 
+func (m *mockMetadataService) GetAllTVShowImages(_ context.Context, _ string) ([]metadata.ProviderImages, error) {
+	return nil, nil
+}
+
 func (m *mockMetadataService) GetTVShowContentRatings(_ context.Context, _ string) ([]metadata.ContentRating, error) {
 	return nil, nil
 }
@@ -125,6 +133,14 @@ func (m *mockMetadataService) GetCollectionMetadata(_ context.Context, _ string,
 	return m.collectionMetadata, m.collectionMetadataErr
 }
 
+func (m *mockMetadataService) SearchRecording(_ context.Context, _, _ string, _ metadata.SearchOptions) ([]metadata.RecordingSearchResult, error) {
+	return nil, nil
+}
+
+func (m *mockMetadataService) GetRecording(_ context.Context, _ string) (*metadata.RecordingSearchResult, error) {
+	return nil, nil
+}
+
 func (m *mockMetadataService) GetImageURL(_ string, _ metadata.ImageSize) string {
 	return ""
 }
@@ -147,6 +163,12 @@ func (m *mockMetadataService) RegisterProvider(_ metadata.Provider) {}
 
 func (m *mockMetadataService) SetJobQueue(_ metadata.JobQueue) {}
 
+func (m *mockMetadataService) SetLanguageSource(_ metadata.LanguageSource) {}
+
+func (m *mockMetadataService) RefreshLanguages(_ context.Context, languages []string) []string {
+	return languages
+}
+
 func (m *mockMetadataService) GetProviders() []metadata.Provider {
 	return nil
 }
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/go-faster/jx"
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/service/parental"
+)
+
+// restrictContent attaches the parental controls and hidden tags of the
+// requesting user to ctx, so listings and search only show content the user
+// may access and wants to see.
+func (h *Handler) restrictContent(ctx context.Context) (context.Context, error) {
+	userID, ok := h.getUserID(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	filter, err := h.parentalFilter(ctx, userID)
+	if err != nil {
+		return ctx, err
+	}
+	if hidden := h.hiddenTags(ctx, userID); len(hidden) > 0 {
+		merged := content.AccessFilter{AllowUnrated: true}
+		if filter != nil {
+			merged = *filter
+		}
+		merged.BlockedTags = append(slices.Clone(merged.BlockedTags), hidden...)
+		filter = &merged
+	}
+	if filter == nil {
+		return ctx, nil
+	}
+	return content.WithAccessFilter(ctx, filter), nil
+}
+
+// enforceParentalControls attaches only the parental controls of the
+// requesting user to ctx. Playback uses it: hidden tags declutter listings
+// but never block an item the user opens directly.
+func (h *Handler) enforceParentalControls(ctx context.Context) (context.Context, error) {
+	userID, ok := h.getUserID(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	filter, err := h.parentalFilter(ctx, userID)
+	if err != nil || filter == nil {
+		return ctx, err
+	}
+	return content.WithAccessFilter(ctx, filter), nil
+}
+
+// parentalFilter returns the parental access filter of a user, or nil when
+// parental controls are disabled or the user is unrestricted.
+func (h *Handler) parentalFilter(ctx context.Context, userID uuid.UUID) (*content.AccessFilter, error) {
+	if h.parentalService == nil {
+		return nil, nil
+	}
+	return h.parentalService.Filter(ctx, userID)
+}
+
+// hiddenTags returns the genre and tag slugs a user chose to hide.
+func (h *Handler) hiddenTags(ctx context.Context, userID uuid.UUID) []string {
+	if h.userService == nil {
+		return nil
+	}
+	prefs, err := h.userService.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return nil
+	}
+	return prefs.HiddenTags
+}
+
+// parentalRestrictionError is the error body returned for content blocked by
+// parental controls. The reason detail lets clients tell it apart from
+// permission errors and offer the PIN override.
+func parentalRestrictionError() ogen.Error {
+	return ogen.Error{
+		Code:    403,
+		Message: "Content blocked by parental controls",
+		Details: ogen.NewOptErrorDetails(ogen.ErrorDetails{
+			"reason": jx.Raw(`"parental_controls"`),
+		}),
+	}
+}
+
+// GetMyParentalControls returns the restrictions of the current user.
+// GET /api/v1/users/me/parental-controls
+func (h *Handler) GetMyParentalControls(ctx context.Context) (ogen.GetMyParentalControlsRes, error) {
+	userID, ok := h.getUserID(ctx)
+	if !ok {
+		return &ogen.Error{Code: 401, Message: "Unauthorized"}, nil
+	}
+	if h.parentalService == nil {
+		return parentalControlsToOgen(&parental.Controls{UserID: userID, UnratedPolicy: parental.UnratedAllow}), nil
+	}
+
+	controls, err := h.parentalService.Get(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get parental controls", slog.Any("error", err))
+		return nil, err
+	}
+	return parentalControlsToOgen(controls), nil
+}
+
+// UnlockParentalControls lifts the restrictions of the current user for a
+// limited time when the override PIN matches.
+// POST /api/v1/users/me/parental-controls/unlock
+func (h *Handler) UnlockParentalControls(ctx context.Context, req *ogen.ParentalControlsUnlockRequest) (ogen.UnlockParentalControlsRes, error) {
+	userID, ok := h.getUserID(ctx)
+	if !ok {
+		return &ogen.UnlockParentalControlsUnauthorized{Code: 401, Message: "Authentication required"}, nil
+	}
+	if h.parentalService == nil {
+		return &ogen.UnlockParentalControlsBadRequest{Code: 400, Message: "No parental controls PIN set"}, nil
+	}
+
+	controls, err := h.parentalService.Unlock(ctx, userID, req.Pin)
+	if err != nil {
+		switch {
+		case errors.Is(err, parental.ErrNoPIN):
+			return &ogen.UnlockParentalControlsBadRequest{Code: 400, Message: "No parental controls PIN set"}, nil
+		case errors.Is(err, parental.ErrInvalidPIN):
+			return &ogen.UnlockParentalControlsForbidden{Code: 403, Message: "Invalid PIN"}, nil
+		case errors.Is(err, parental.ErrPINLockedOut):
+			return &ogen.UnlockParentalControlsForbidden{Code: 403, Message: "Too many invalid PINs, try again later"}, nil
+		}
+		h.logger.Error("failed to unlock parental controls", slog.Any("error", err))
+		return nil, err
+	}
+	return parentalControlsToOgen(controls), nil
+}
+
+// AdminGetParentalControls returns the restrictions of a user.
+// GET /api/v1/admin/users/{userId}/parental-controls
+func (h *Handler) AdminGetParentalControls(ctx context.Context, params ogen.AdminGetParentalControlsParams) (ogen.AdminGetParentalControlsRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminGetParentalControlsUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminGetParentalControlsForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.parentalService == nil {
+		return nil, errors.New("parental controls not available")
+	}
+
+	controls, err := h.parentalService.Get(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to get parental controls", slog.Any("error", err))
+		return nil, err
+	}
+	return parentalControlsToOgen(controls), nil
+}
+
+// AdminUpdateParentalControls replaces the restrictions of a user.
+// PUT /api/v1/admin/users/{userId}/parental-controls
+func (h *Handler) AdminUpdateParentalControls(ctx context.Context, req *ogen.ParentalControlsUpdate, params ogen.AdminUpdateParentalControlsParams) (ogen.AdminUpdateParentalControlsRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminUpdateParentalControlsUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminUpdateParentalControlsForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.parentalService == nil {
+		return nil, errors.New("parental controls not available")
+	}
+
+	update := parental.UpdateParams{
+		BlockedTags:   req.BlockedTags,
+		UnratedPolicy: parental.UnratedPolicy(req.UnratedPolicy.Or(ogen.ParentalControlsUpdateUnratedPolicyAllow)),
+		PIN:           optPtr(req.Pin),
+	}
+	if maxAge, ok := req.MaxAge.Get(); ok {
+		update.MaxAge = new(int32(maxAge))
+	}
+	if update.BlockedTags == nil {
+		update.BlockedTags = []string{}
+	}
+
+	controls, err := h.parentalService.Update(ctx, params.UserId, update)
+	if err != nil {
+		if errors.Is(err, parental.ErrInvalidControls) {
+			return &ogen.AdminUpdateParentalControlsBadRequest{Code: 400, Message: err.Error()}, nil
+		}
+		h.logger.Error("failed to update parental controls", slog.Any("error", err))
+		return nil, err
+	}
+	return parentalControlsToOgen(controls), nil
+}
+
+// parentalControlsToOgen converts parental controls to the API response.
+func parentalControlsToOgen(c *parental.Controls) *ogen.ParentalControls {
+	res := &ogen.ParentalControls{
+		UserID:        c.UserID,
+		BlockedTags:   c.BlockedTags,
+		UnratedPolicy: ogen.ParentalControlsUnratedPolicy(c.UnratedPolicy),
+		HasPin:        c.HasPIN(),
+	}
+	if res.BlockedTags == nil {
+		res.BlockedTags = []string{}
+	}
+	if c.MaxAge != nil {
+		res.MaxAge = ogen.NewOptInt(int(*c.MaxAge))
+	}
+	setOpt(&res.OverrideUntil, c.OverrideUntil)
+	if !c.UpdatedAt.IsZero() {
+		res.UpdatedAt = ogen.NewOptDateTime(c.UpdatedAt)
+	}
+	return res
+}
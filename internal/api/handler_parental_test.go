@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/activity"
+	"github.com/lusoris/revenge/internal/service/parental"
+	"github.com/lusoris/revenge/internal/service/user"
+)
+
+// stubParentalRepository serves the controls of one user from memory.
+type stubParentalRepository struct {
+	parental.Repository
+	controls *parental.Controls
+}
+
+func (r *stubParentalRepository) Get(_ context.Context, userID uuid.UUID) (*parental.Controls, error) {
+	if r.controls == nil || r.controls.UserID != userID {
+		return nil, parental.ErrNotFound
+	}
+	return r.controls, nil
+}
+
+func newParentalTestHandler(repo parental.Repository) *Handler {
+	logger := logging.NewTestLogger()
+	return &Handler{
+		logger:          logger,
+		parentalService: parental.NewService(repo, logger),
+	}
+}
+
+func TestHandler_RestrictContent(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV7())
+	maxAge := int32(12)
+	handler := newParentalTestHandler(&stubParentalRepository{controls: &parental.Controls{
+		UserID:        userID,
+		MaxAge:        &maxAge,
+		BlockedTags:   []string{"horror"},
+		UnratedPolicy: parental.UnratedBlock,
+	}})
+
+	ctx, err := handler.restrictContent(WithUserID(context.Background(), userID))
+	require.NoError(t, err)
+	filter := content.AccessFilterFromContext(ctx)
+	require.NotNil(t, filter)
+	assert.Equal(t, &maxAge, filter.MaxAge)
+	assert.False(t, filter.AllowUnrated)
+	assert.Equal(t, []string{"horror"}, filter.BlockedTags)
+
+	ctx, err = handler.restrictContent(WithUserID(context.Background(), uuid.New()))
+	require.NoError(t, err)
+	assert.Nil(t, content.AccessFilterFromContext(ctx))
+}
+
+// stubPreferencesRepository serves the preferences of one user from memory.
+type stubPreferencesRepository struct {
+	user.Repository
+	prefs *db.SharedUserPreference
+}
+
+func (r *stubPreferencesRepository) GetUserPreferences(_ context.Context, _ uuid.UUID) (*db.SharedUserPreference, error) {
+	return r.prefs, nil
+}
+
+func TestHandler_RestrictContent_HiddenTags(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV7())
+	logger := logging.NewTestLogger()
+	prefsRepo := &stubPreferencesRepository{prefs: &db.SharedUserPreference{UserID: userID, HiddenTags: []string{"clowns"}}}
+	handler := newParentalTestHandler(&stubParentalRepository{})
+	handler.userService = user.NewCachedService(
+		user.NewService(nil, prefsRepo, activity.NewNoopLogger(), nil, config.AvatarConfig{}), nil, logger)
+	ctx := WithUserID(context.Background(), userID)
+
+	// Hidden tags alone restrict listings without an age limit.
+	restricted, err := handler.restrictContent(ctx)
+	require.NoError(t, err)
+	filter := content.AccessFilterFromContext(restricted)
+	require.NotNil(t, filter)
+	assert.Nil(t, filter.MaxAge)
+	assert.True(t, filter.AllowUnrated)
+	assert.Equal(t, []string{"clowns"}, filter.BlockedTags)
+
+	// They merge with parental controls.
+	maxAge := int32(12)
+	handler.parentalService = parental.NewService(&stubParentalRepository{controls: &parental.Controls{
+		UserID:        userID,
+		MaxAge:        &maxAge,
+		BlockedTags:   []string{"horror"},
+		UnratedPolicy: parental.UnratedAllow,
+	}}, logger)
+	restricted, err = handler.restrictContent(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"horror", "clowns"}, content.AccessFilterFromContext(restricted).BlockedTags)
+
+	// Playback only enforces parental controls.
+	restricted, err = handler.enforceParentalControls(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"horror"}, content.AccessFilterFromContext(restricted).BlockedTags)
+}
+
+func TestNormalizeTagSlugs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"time-travel", "gore"}, normalizeTagSlugs([]string{"Time Travel", "gore", "time-travel", "  "}))
+	assert.Equal(t, []string{}, normalizeTagSlugs([]string{}))
+}
+
+func TestHandler_GetMyParentalControls(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV7())
+	maxAge := int32(16)
+	pinHash := "hash"
+	handler := newParentalTestHandler(&stubParentalRepository{controls: &parental.Controls{
+		UserID:        userID,
+		MaxAge:        &maxAge,
+		UnratedPolicy: parental.UnratedAllow,
+		PINHash:       &pinHash,
+	}})
+
+	result, err := handler.GetMyParentalControls(WithUserID(context.Background(), userID))
+	require.NoError(t, err)
+	got, ok := result.(*ogen.ParentalControls)
+	require.True(t, ok, "expected *ogen.ParentalControls, got %T", result)
+	assert.Equal(t, 16, got.MaxAge.Value)
+	assert.True(t, got.HasPin)
+	assert.Equal(t, []string{}, got.BlockedTags)
+	assert.Equal(t, ogen.ParentalControlsUnratedPolicyAllow, got.UnratedPolicy)
+}
+
+func TestHandler_NewError_ParentalRestriction(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result := handler.NewError(context.Background(), fmt.Errorf("start session: %w", content.ErrParentalRestriction))
+	assert.Equal(t, 403, result.StatusCode)
+	assert.Equal(t, "Content blocked by parental controls", result.Response.Message)
+	assert.Equal(t, `"parental_controls"`, string(result.Response.Details.Value["reason"]))
+}
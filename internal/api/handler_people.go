@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	metadatajobs "github.com/lusoris/revenge/internal/service/metadata/jobs"
+	"github.com/lusoris/revenge/internal/service/people"
+)
+
+// GetPerson returns a person known from the library's credits.
+// GET /api/v1/people/{personId}
+func (h *Handler) GetPerson(ctx context.Context, params ogen.GetPersonParams) (ogen.GetPersonRes, error) {
+	if h.peopleService == nil {
+		return &ogen.GetPersonNotFound{Code: 404, Message: "Person not found"}, nil
+	}
+
+	person, err := h.peopleService.Get(ctx, params.PersonId)
+	if err != nil {
+		if errors.Is(err, people.ErrNotFound) {
+			return &ogen.GetPersonNotFound{Code: 404, Message: "Person not found"}, nil
+		}
+		h.logger.Error("failed to get person", slog.Any("error", err))
+		return nil, err
+	}
+
+	return personToOgen(person, params.Language.Or("")), nil
+}
+
+// GetPersonFilmography returns the person's credits on movies and series in
+// the library, leaving out titles the caller's restrictions hide.
+// GET /api/v1/people/{personId}/filmography
+func (h *Handler) GetPersonFilmography(ctx context.Context, params ogen.GetPersonFilmographyParams) (ogen.GetPersonFilmographyRes, error) {
+	if h.peopleService == nil {
+		return &ogen.GetPersonFilmographyNotFound{Code: 404, Message: "Person not found"}, nil
+	}
+
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filmography, err := h.peopleService.GetFilmography(ctx, params.PersonId)
+	if err != nil {
+		if errors.Is(err, people.ErrNotFound) {
+			return &ogen.GetPersonFilmographyNotFound{Code: 404, Message: "Person not found"}, nil
+		}
+		h.logger.Error("failed to get person filmography", slog.Any("error", err))
+		return nil, err
+	}
+
+	return filmographyToOgen(filmography), nil
+}
+
+// RefreshPerson queues a metadata refresh for a person.
+// POST /api/v1/people/{personId}/refresh
+func (h *Handler) RefreshPerson(ctx context.Context, params ogen.RefreshPersonParams) (ogen.RefreshPersonRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.RefreshPersonUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.RefreshPersonForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	if h.peopleService == nil {
+		return &ogen.RefreshPersonNotFound{Code: 404, Message: "Person not found"}, nil
+	}
+	if _, err := h.peopleService.Get(ctx, params.PersonId); err != nil {
+		if errors.Is(err, people.ErrNotFound) {
+			return &ogen.RefreshPersonNotFound{Code: 404, Message: "Person not found"}, nil
+		}
+		h.logger.Error("failed to get person", slog.Any("error", err))
+		return nil, err
+	}
+
+	if h.riverClient == nil {
+		return nil, errors.New("job queue not available")
+	}
+	result, err := h.riverClient.Insert(ctx, metadatajobs.RefreshPersonArgs{
+		PersonID: params.PersonId,
+		Force:    true,
+	}, nil)
+	if err != nil {
+		h.logger.Error("failed to enqueue person refresh", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &ogen.RefreshPersonAccepted{
+		Message: ogen.NewOptString("Person refresh queued"),
+		JobID:   ogen.NewOptInt64(result.Job.ID),
+	}, nil
+}
+
+// personToOgen converts a person to the API type, picking the biography in
+// the requested language.
+func personToOgen(p *people.Person, language string) *ogen.Person {
+	o := &ogen.Person{
+		ID:          p.ID,
+		Name:        p.Name,
+		AlsoKnownAs: p.AlsoKnownAs,
+		Gender:      ogen.NewOptInt(int(p.Gender)),
+	}
+	setOptConv(&o.TmdbID, p.TMDbID, int32ToInt)
+	setOptConv(&o.TvdbID, p.TVDbID, int32ToInt)
+	setOpt(&o.ImdbID, p.IMDbID)
+	setOpt(&o.Biography, p.BiographyFor(language))
+	setOpt(&o.Birthday, p.Birthday)
+	setOpt(&o.Deathday, p.Deathday)
+	setOpt(&o.PlaceOfBirth, p.PlaceOfBirth)
+	setOpt(&o.ProfilePath, p.ProfilePath)
+	setOpt(&o.ProfilePlaceholder, imagePlaceholderToOgen(p.ImagePlaceholders.For(p.ProfilePath)))
+	setOpt(&o.Homepage, p.Homepage)
+	setOpt(&o.KnownForDepartment, p.KnownForDepartment)
+	setOpt(&o.Popularity, p.Popularity)
+	setOpt(&o.MetadataUpdatedAt, p.MetadataUpdatedAt)
+	return o
+}
+
+// filmographyToOgen converts a filmography to the API type.
+func filmographyToOgen(f *people.Filmography) *ogen.PersonFilmography {
+	o := &ogen.PersonFilmography{
+		Movies: make([]ogen.PersonMovieCredit, 0, len(f.Movies)),
+		Series: make([]ogen.PersonSeriesCredit, 0, len(f.Series)),
+	}
+	for _, c := range f.Movies {
+		credit := ogen.PersonMovieCredit{
+			MovieID:    c.MovieID,
+			Title:      c.Title,
+			CreditType: ogen.PersonMovieCreditCreditType(c.CreditType),
+		}
+		setOptConv(&credit.Year, c.Year, int32ToInt)
+		setOpt(&credit.PosterPath, c.PosterPath)
+		setOpt(&credit.Character, c.Character)
+		setOpt(&credit.Job, c.Job)
+		setOpt(&credit.Department, c.Department)
+		setOptConv(&credit.CastOrder, c.CastOrder, int32ToInt)
+		o.Movies = append(o.Movies, credit)
+	}
+	for _, c := range f.Series {
+		credit := ogen.PersonSeriesCredit{
+			SeriesID:     c.SeriesID,
+			Title:        c.Title,
+			CreditType:   ogen.PersonSeriesCreditCreditType(c.CreditType),
+			EpisodeCount: int(c.EpisodeCount),
+		}
+		setOpt(&credit.FirstAirDate, c.FirstAirDate)
+		setOpt(&credit.PosterPath, c.PosterPath)
+		setOpt(&credit.Character, c.Character)
+		setOpt(&credit.Job, c.Job)
+		setOpt(&credit.Department, c.Department)
+		o.Series = append(o.Series, credit)
+	}
+	return o
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/parental"
+	"github.com/lusoris/revenge/internal/service/people"
+)
+
+// stubPeopleRepository serves people and credits from memory.
+type stubPeopleRepository struct {
+	people.Repository
+	person *people.Person
+	movies []people.MovieCredit
+	series []people.SeriesCredit
+	filter *content.AccessFilter // access filter of the last credits lookup
+}
+
+func (r *stubPeopleRepository) Get(_ context.Context, id uuid.UUID) (*people.Person, error) {
+	if r.person == nil || r.person.ID != id {
+		return nil, people.ErrNotFound
+	}
+	return r.person, nil
+}
+
+func (r *stubPeopleRepository) ListMovieCredits(ctx context.Context, _ uuid.UUID) ([]people.MovieCredit, error) {
+	r.filter = content.AccessFilterFromContext(ctx)
+	return r.movies, nil
+}
+
+func (r *stubPeopleRepository) ListSeriesCredits(context.Context, uuid.UUID) ([]people.SeriesCredit, error) {
+	return r.series, nil
+}
+
+func newPeopleTestHandler(repo people.Repository) *Handler {
+	logger := logging.NewTestLogger()
+	return &Handler{
+		logger:        logger,
+		peopleService: people.NewService(repo, nil, nil, logger),
+	}
+}
+
+func TestHandler_GetPerson(t *testing.T) {
+	t.Parallel()
+
+	bio := "English biography"
+	birthday := time.Date(1940, 4, 25, 0, 0, 0, 0, time.UTC)
+	tmdbID := int32(1158)
+	person := &people.Person{
+		ID:          uuid.Must(uuid.NewV7()),
+		TMDbID:      &tmdbID,
+		Name:        "Al Pacino",
+		Biography:   &bio,
+		Biographies: map[string]string{"de": "Deutsche Biografie"},
+		Birthday:    &birthday,
+		Gender:      2,
+	}
+	handler := newPeopleTestHandler(&stubPeopleRepository{person: person})
+
+	result, err := handler.GetPerson(context.Background(), ogen.GetPersonParams{
+		PersonId: person.ID,
+		Language: ogen.NewOptString("de"),
+	})
+	require.NoError(t, err)
+
+	got, ok := result.(*ogen.Person)
+	require.True(t, ok, "expected *ogen.Person, got %T", result)
+	assert.Equal(t, "Al Pacino", got.Name)
+	assert.Equal(t, 1158, got.TmdbID.Value)
+	assert.Equal(t, "Deutsche Biografie", got.Biography.Value)
+	assert.Equal(t, birthday, got.Birthday.Value)
+	assert.Equal(t, 2, got.Gender.Value)
+}
+
+func TestHandler_GetPerson_NotFound(t *testing.T) {
+	t.Parallel()
+
+	handler := newPeopleTestHandler(&stubPeopleRepository{})
+
+	result, err := handler.GetPerson(context.Background(), ogen.GetPersonParams{PersonId: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetPersonNotFound)
+	assert.True(t, ok, "expected *ogen.GetPersonNotFound, got %T", result)
+}
+
+func TestHandler_GetPersonFilmography(t *testing.T) {
+	t.Parallel()
+
+	year := int32(1995)
+	character := "Vincent Hanna"
+	person := &people.Person{ID: uuid.Must(uuid.NewV7()), Name: "Al Pacino"}
+	handler := newPeopleTestHandler(&stubPeopleRepository{
+		person: person,
+		movies: []people.MovieCredit{{MovieID: uuid.New(), Title: "Heat", Year: &year, CreditType: "cast", Character: &character}},
+		series: []people.SeriesCredit{{SeriesID: uuid.New(), Title: "Angels in America", CreditType: "cast", EpisodeCount: 6}},
+	})
+
+	result, err := handler.GetPersonFilmography(context.Background(), ogen.GetPersonFilmographyParams{PersonId: person.ID})
+	require.NoError(t, err)
+
+	got, ok := result.(*ogen.PersonFilmography)
+	require.True(t, ok, "expected *ogen.PersonFilmography, got %T", result)
+	require.Len(t, got.Movies, 1)
+	assert.Equal(t, "Heat", got.Movies[0].Title)
+	assert.Equal(t, 1995, got.Movies[0].Year.Value)
+	assert.Equal(t, "Vincent Hanna", got.Movies[0].Character.Value)
+	require.Len(t, got.Series, 1)
+	assert.Equal(t, 6, got.Series[0].EpisodeCount)
+}
+
+func TestHandler_GetPersonFilmography_RestrictedUser(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	maxAge := int32(12)
+	person := &people.Person{ID: uuid.Must(uuid.NewV7()), Name: "Al Pacino"}
+	repo := &stubPeopleRepository{person: person}
+	handler := newPeopleTestHandler(repo)
+	handler.parentalService = parental.NewService(&stubParentalRepository{controls: &parental.Controls{
+		UserID:        userID,
+		MaxAge:        &maxAge,
+		UnratedPolicy: parental.UnratedBlock,
+	}}, logging.NewTestLogger())
+
+	_, err := handler.GetPersonFilmography(WithUserID(context.Background(), userID), ogen.GetPersonFilmographyParams{PersonId: person.ID})
+	require.NoError(t, err)
+
+	require.NotNil(t, repo.filter, "credits are listed with the caller's restrictions")
+	assert.Equal(t, &maxAge, repo.filter.MaxAge)
+	assert.False(t, repo.filter.AllowUnrated)
+}
+
+func TestHandler_GetPersonFilmography_NotFound(t *testing.T) {
+	t.Parallel()
+
+	handler := newPeopleTestHandler(&stubPeopleRepository{})
+
+	result, err := handler.GetPersonFilmography(context.Background(), ogen.GetPersonFilmographyParams{PersonId: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetPersonFilmographyNotFound)
+	assert.True(t, ok, "expected *ogen.GetPersonFilmographyNotFound, got %T", result)
+}
+
+func TestHandler_RefreshPerson_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.RefreshPerson(context.Background(), ogen.RefreshPersonParams{PersonId: uuid.New()})
+	require.NoError(t, err)
+
+	unauthorized, ok := result.(*ogen.RefreshPersonUnauthorized)
+	require.True(t, ok, "expected *ogen.RefreshPersonUnauthorized, got %T", result)
+	assert.Equal(t, 401, unauthorized.Code)
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -11,7 +12,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/api/middleware"
 	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
 	"github.com/lusoris/revenge/internal/playback"
+	"github.com/lusoris/revenge/internal/service/library"
 )
 
 // ============================================================================
@@ -36,6 +43,21 @@ func (h *Handler) StartPlaybackSession(ctx context.Context, req *ogen.StartPlayb
 		}, nil
 	}
 
+	ctx, err := h.enforceParentalControls(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.checkPlaybackAccess(ctx, userID, req); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return &ogen.StartPlaybackSessionNotFound{
+				Code:    404,
+				Message: "Media not found",
+			}, nil
+		}
+		return nil, err
+	}
+
 	// Convert ogen request to internal request
 	pbReq := &playback.StartPlaybackRequest{
 		MediaType: playback.MediaType(req.MediaType),
@@ -61,6 +83,10 @@ func (h *Handler) StartPlaybackSession(ctx context.Context, req *ogen.StartPlayb
 
 	sess, err := h.playbackService.StartSession(ctx, userID, pbReq)
 	if err != nil {
+		if errors.Is(err, content.ErrParentalRestriction) {
+			res := ogen.StartPlaybackSessionForbidden(parentalRestrictionError())
+			return &res, nil
+		}
 		h.logger.Error("failed to start playback session",
 			slog.Any("error", err),
 			slog.String("user_id", userID.String()),
@@ -74,6 +100,64 @@ func (h *Handler) StartPlaybackSession(ctx context.Context, req *ogen.StartPlayb
 	return sessionToOgen(sess), nil
 }
 
+// checkPlaybackAccess returns errLibraryDenied unless the user can access
+// the library of the requested media, so media of hidden libraries can't
+// be streamed by ID.
+func (h *Handler) checkPlaybackAccess(ctx context.Context, userID uuid.UUID, req *ogen.StartPlaybackRequest) error {
+	switch playback.MediaType(req.MediaType) {
+	case playback.MediaTypeTrack:
+		if h.musicService == nil {
+			return nil
+		}
+		_, err := h.accessibleMusicTrack(ctx, userID, req.MediaID)
+		if errors.Is(err, music.ErrTrackNotFound) {
+			return errLibraryDenied
+		}
+		return err
+	case playback.MediaTypeAudiobook:
+		if h.audiobookService == nil {
+			return nil
+		}
+		_, err := h.accessibleAudiobook(ctx, userID, req.MediaID)
+		if errors.Is(err, audiobook.ErrBookNotFound) {
+			return errLibraryDenied
+		}
+		return err
+	case playback.MediaTypeHomeVideo:
+		if h.homeVideoService == nil {
+			return nil
+		}
+		_, err := h.accessibleHomeVideo(ctx, userID, req.MediaID)
+		if errors.Is(err, homevideo.ErrVideoNotFound) {
+			return errLibraryDenied
+		}
+		return err
+	case playback.MediaTypeMusicVideo:
+		if h.musicVideoService == nil {
+			return nil
+		}
+		_, err := h.accessibleMusicVideo(ctx, userID, req.MediaID)
+		if errors.Is(err, musicvideo.ErrVideoNotFound) {
+			return errLibraryDenied
+		}
+		return err
+	case playback.MediaTypeChannel:
+		if h.liveTVService == nil {
+			return nil
+		}
+		// Channels belong to no library. Live TV records into TV
+		// libraries, so streaming a channel needs access to one of them.
+		libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeTVShow)
+		if err != nil {
+			return err
+		}
+		if libraryIDs != nil && len(libraryIDs) == 0 {
+			return errLibraryDenied
+		}
+	}
+	return nil
+}
+
 // GetPlaybackSession returns metadata for an active playback session.
 // GET /api/v1/playback/sessions/{sessionId}
 func (h *Handler) GetPlaybackSession(ctx context.Context, params ogen.GetPlaybackSessionParams) (ogen.GetPlaybackSessionRes, error) {
@@ -195,6 +279,44 @@ func sessionToOgen(sess *playback.Session) *ogen.PlaybackSession {
 	}
 }
 
+// HeartbeatPlaybackSession implements the generated heartbeat operation. The
+// route is served by heartbeatHandler, which is registered on the mux ahead
+// of ogen; this keeps the generated interface satisfied.
+// POST /api/v1/playback/sessions/{sessionId}/heartbeat
+func (h *Handler) HeartbeatPlaybackSession(ctx context.Context, req ogen.OptHeartbeatPlaybackSessionReq, params ogen.HeartbeatPlaybackSessionParams) (ogen.HeartbeatPlaybackSessionRes, error) {
+	if h.playbackService == nil {
+		return &ogen.HeartbeatPlaybackSessionNotFound{
+			Code:    404,
+			Message: "Playback is not enabled",
+		}, nil
+	}
+
+	userID, ok := h.getUserID(ctx)
+	if !ok {
+		return &ogen.HeartbeatPlaybackSessionUnauthorized{
+			Code:    401,
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var position *int
+	if req.IsSet() && req.Value.PositionSeconds.IsSet() {
+		position = &req.Value.PositionSeconds.Value
+	}
+
+	if _, ok := h.playbackService.HeartbeatSession(params.SessionId, position); !ok {
+		return &ogen.HeartbeatPlaybackSessionNotFound{
+			Code:    404,
+			Message: "Session not found",
+		}, nil
+	}
+	if position != nil {
+		h.invalidateHomeFeed(ctx, userID)
+	}
+
+	return &ogen.HeartbeatPlaybackSessionNoContent{}, nil
+}
+
 // heartbeatRequest is the optional JSON body for the heartbeat endpoint.
 type heartbeatRequest struct {
 	PositionSeconds *int `json:"position_seconds,omitempty"`
@@ -255,6 +377,11 @@ func (h *Handler) heartbeatHandler() http.Handler {
 			slog.String("user_id", claims.UserID.String()),
 		)
 
+		// A reported position moves the item on the user's home feed.
+		if req.PositionSeconds != nil {
+			h.invalidateHomeFeed(r.Context(), claims.UserID)
+		}
+
 		// Return 204 No Content (heartbeat accepted)
 		_ = sess // session returned for future use (e.g., returning updated expiry)
 		w.WriteHeader(http.StatusNoContent)
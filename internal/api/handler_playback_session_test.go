@@ -204,7 +204,7 @@ func TestHandler_StartPlaybackSession_AuthenticatedNoSession(t *testing.T) {
 	defer sm.Close()
 
 	pm := testPipelineManagerForAPI(t)
-	svc, err := playback.NewService(cfg, sm, pm, nil, &playbackMovieSvc{}, nil, logging.NewTestLogger())
+	svc, err := playback.NewService(cfg, sm, pm, nil, &playbackMovieSvc{}, nil, nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 	require.NoError(t, err)
 	defer svc.Close()
 
@@ -241,7 +241,7 @@ func TestHandler_GetPlaybackSession_SessionExists(t *testing.T) {
 	defer sm.Close()
 
 	pm := testPipelineManagerForAPI(t)
-	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, logging.NewTestLogger())
+	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 	require.NoError(t, err)
 	defer svc.Close()
 
@@ -292,7 +292,7 @@ func TestHandler_GetPlaybackSession_SessionNotFound(t *testing.T) {
 	defer sm.Close()
 
 	pm := testPipelineManagerForAPI(t)
-	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, logging.NewTestLogger())
+	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 	require.NoError(t, err)
 	defer svc.Close()
 
@@ -326,7 +326,7 @@ func TestHandler_StopPlaybackSession_SessionExists(t *testing.T) {
 	defer sm.Close()
 
 	pm := testPipelineManagerForAPI(t)
-	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, logging.NewTestLogger())
+	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 	require.NoError(t, err)
 	defer svc.Close()
 
@@ -373,7 +373,7 @@ func TestHandler_StopPlaybackSession_NotFound(t *testing.T) {
 	defer sm.Close()
 
 	pm := testPipelineManagerForAPI(t)
-	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, logging.NewTestLogger())
+	svc, err := playback.NewService(cfg, sm, pm, nil, nil, nil, nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 	require.NoError(t, err)
 	defer svc.Close()
 
@@ -10,7 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/music"
 	"github.com/lusoris/revenge/internal/playback"
+	"github.com/lusoris/revenge/internal/service/library"
 )
 
 // ============================================================================
@@ -61,6 +63,58 @@ func TestHandler_StartPlaybackSession_Unauthorized(t *testing.T) {
 	assert.Equal(t, "Authentication required", unauth.Message)
 }
 
+func TestHandler_StartPlaybackSession_LibraryDenied(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	videoID := uuid.New()
+	homeVideos := newHomeVideoMockService(uuid.New(), uuid.New(), videoID)
+	family := library.Library{ID: homeVideos.videos[videoID].LibraryID, Name: "Family", Type: library.LibraryTypeHomeVideo}
+	musicVideoID := uuid.New()
+	musicVideos := newMusicVideoMockService(uuid.New(), musicVideoID)
+	clips := library.Library{ID: musicVideos.videos[musicVideoID].LibraryID, Name: "Clips", Type: library.LibraryTypeMusicVideo}
+	trackID := uuid.New()
+	tracks := &musicMockService{tracks: map[uuid.UUID]*music.Track{
+		trackID: {ID: trackID, FilePath: "/music/private/Song.flac"},
+	}}
+	private := library.Library{ID: uuid.New(), Name: "Private", Type: library.LibraryTypeMusic, Paths: []string{"/music/private"}}
+
+	// The playback service is never reached: access is denied first.
+	handler := &Handler{
+		logger:            logging.NewTestLogger(),
+		playbackService:   new(playback.Service),
+		homeVideoService:  homeVideos,
+		musicService:      tracks,
+		musicVideoService: musicVideos,
+		liveTVService:     &liveTVMockService{},
+		libraryService:    newStubLibraryService([]library.Library{family, clips, private}, nil),
+	}
+	ctx := WithUserID(context.Background(), userID)
+
+	tests := []struct {
+		name      string
+		mediaType ogen.StartPlaybackRequestMediaType
+		mediaID   uuid.UUID
+	}{
+		{"track", ogen.StartPlaybackRequestMediaTypeTrack, trackID},
+		{"home video", ogen.StartPlaybackRequestMediaTypeHomeVideo, videoID},
+		{"music video", ogen.StartPlaybackRequestMediaTypeMusicVideo, musicVideoID},
+		{"channel", ogen.StartPlaybackRequestMediaTypeChannel, uuid.New()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler.StartPlaybackSession(ctx, &ogen.StartPlaybackRequest{
+				MediaType: tt.mediaType,
+				MediaID:   tt.mediaID,
+			})
+			require.NoError(t, err)
+			notFound, ok := result.(*ogen.StartPlaybackSessionNotFound)
+			require.True(t, ok, "expected *ogen.StartPlaybackSessionNotFound, got %T", result)
+			assert.Equal(t, "Media not found", notFound.Message)
+		})
+	}
+}
+
 // ============================================================================
 // GetPlaybackSession Tests
 // ============================================================================
@@ -67,8 +67,10 @@ func (m *mockRadarrService) LookupMovie(_ context.Context, _ string) ([]radarr.M
 
 // mockRiverClient is a mock implementation of riverClient for testing.
 type mockRiverClient struct {
-	insertedArgs []river.JobArgs
-	insertError  error
+	insertedArgs  []river.JobArgs
+	insertError   error
+	cancelledJobs []int64
+	cancelError   error
 }
 
 func (m *mockRiverClient) Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) (*rivertype.JobInsertResult, error) {
@@ -79,6 +81,14 @@ func (m *mockRiverClient) Insert(ctx context.Context, args river.JobArgs, opts *
 	return &rivertype.JobInsertResult{Job: &rivertype.JobRow{ID: 1}}, nil
 }
 
+func (m *mockRiverClient) JobCancel(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	if m.cancelError != nil {
+		return nil, m.cancelError
+	}
+	m.cancelledJobs = append(m.cancelledJobs, id)
+	return &rivertype.JobRow{ID: id}, nil
+}
+
 func setupRadarrTestHandler(t *testing.T) (*Handler, testutil.DB, uuid.UUID) {
 	t.Helper()
 	testDB := testutil.NewFastTestDB(t)
@@ -10,13 +10,20 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie/moviejobs"
 	tvshowjobs "github.com/lusoris/revenge/internal/content/tvshow/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
 	"github.com/lusoris/revenge/internal/service/search"
 )
 
 // SearchLibraryMovies searches movies in the library using Typesense.
 func (h *Handler) SearchLibraryMovies(ctx context.Context, params ogen.SearchLibraryMoviesParams) (ogen.SearchLibraryMoviesRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build search params
 	searchParams := search.SearchParams{
 		Query:             params.Q,
@@ -24,7 +31,7 @@ func (h *Handler) SearchLibraryMovies(ctx context.Context, params ogen.SearchLib
 		PerPage:           20,
 		SortBy:            "popularity:desc",
 		IncludeHighlights: true,
-		FacetBy:           []string{"genres", "year", "status", "directors", "resolution", "has_file"},
+		FacetBy:           []string{"genres", "tags", "year", "status", "directors", "resolution", "has_file"},
 	}
 
 	if params.Page.Set {
@@ -63,13 +70,14 @@ func (h *Handler) SearchLibraryMovies(ctx context.Context, params ogen.SearchLib
 	}
 
 	// Convert hits
+	chain := h.GetMetadataLanguageChain(ctx)
 	for _, hit := range result.Hits {
 		apiHit := ogen.SearchHit{
 			Score: ogen.NewOptFloat32(float32(hit.Score)),
 		}
 
 		// Convert document
-		doc := hit.Document
+		doc := LocalizeMovieDocument(hit.Document, chain)
 		apiDoc := ogen.SearchDocument{
 			ID:             ogen.NewOptUUID(parseUUID(doc.ID)),
 			TmdbID:         ogen.NewOptInt(int(doc.TMDbID)),
@@ -88,6 +96,8 @@ func (h *Handler) SearchLibraryMovies(ctx context.Context, params ogen.SearchLib
 			Resolution:     ogen.NewOptString(doc.Resolution),
 			QualityProfile: ogen.NewOptString(doc.QualityProfile),
 		}
+		apiDoc.PosterPlaceholder = searchPlaceholderToOgen(doc.PosterBlurHash, doc.PosterColor)
+		apiDoc.BackdropPlaceholder = searchPlaceholderToOgen(doc.BackdropBlurHash, doc.BackdropColor)
 
 		// Convert release date from unix timestamp
 		if doc.ReleaseDate > 0 {
@@ -100,6 +110,10 @@ func (h *Handler) SearchLibraryMovies(ctx context.Context, params ogen.SearchLib
 			apiDoc.Genres = make([]string, len(doc.Genres))
 			copy(apiDoc.Genres, doc.Genres)
 		}
+		if len(doc.Tags) > 0 {
+			apiDoc.Tags = make([]string, len(doc.Tags))
+			copy(apiDoc.Tags, doc.Tags)
+		}
 		if len(doc.Cast) > 0 {
 			apiDoc.Cast = make([]string, len(doc.Cast))
 			copy(apiDoc.Cast, doc.Cast)
@@ -150,6 +164,11 @@ func (h *Handler) SearchLibraryMovies(ctx context.Context, params ogen.SearchLib
 
 // AutocompleteMovies provides autocomplete suggestions for movie titles.
 func (h *Handler) AutocompleteMovies(ctx context.Context, params ogen.AutocompleteMoviesParams) (ogen.AutocompleteMoviesRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := 5
 	if params.Limit.Set {
 		limit = params.Limit.Value
@@ -170,7 +189,7 @@ func (h *Handler) AutocompleteMovies(ctx context.Context, params ogen.Autocomple
 
 // GetSearchFacets returns available facet values for filtering.
 func (h *Handler) GetSearchFacets(ctx context.Context) (ogen.GetSearchFacetsRes, error) {
-	facetNames := []string{"genres", "year", "status", "directors", "resolution", "has_file"}
+	facetNames := []string{"genres", "tags", "year", "status", "directors", "resolution", "has_file"}
 
 	facets, err := h.searchService.GetFacets(ctx, facetNames)
 	if err != nil {
@@ -183,6 +202,9 @@ func (h *Handler) GetSearchFacets(ctx context.Context) (ogen.GetSearchFacetsRes,
 	if values, ok := facets["genres"]; ok {
 		response.Genres = convertFacetValues(values)
 	}
+	if values, ok := facets["tags"]; ok {
+		response.Tags = convertFacetValues(values)
+	}
 	if values, ok := facets["year"]; ok {
 		response.Years = convertFacetValues(values)
 	}
@@ -248,6 +270,11 @@ func (h *Handler) ReindexTVShowSearch(ctx context.Context) (ogen.ReindexTVShowSe
 
 // SearchLibraryTVShows searches TV shows in the library using Typesense.
 func (h *Handler) SearchLibraryTVShows(ctx context.Context, params ogen.SearchLibraryTVShowsParams) (ogen.SearchLibraryTVShowsRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if h.tvshowSearchService == nil {
 		return &ogen.TVShowSearchResults{
 			TotalHits:    ogen.NewOptInt(0),
@@ -264,7 +291,7 @@ func (h *Handler) SearchLibraryTVShows(ctx context.Context, params ogen.SearchLi
 		PerPage:           20,
 		SortBy:            "popularity:desc",
 		IncludeHighlights: true,
-		FacetBy:           []string{"genres", "year", "status", "type", "networks", "has_file"},
+		FacetBy:           []string{"genres", "tags", "year", "status", "type", "networks", "has_file"},
 	}
 
 	if params.Page.Set {
@@ -300,12 +327,13 @@ func (h *Handler) SearchLibraryTVShows(ctx context.Context, params ogen.SearchLi
 		Hits:         make([]ogen.TVShowSearchHit, 0, len(result.Hits)),
 	}
 
+	chain := h.GetMetadataLanguageChain(ctx)
 	for _, hit := range result.Hits {
 		apiHit := ogen.TVShowSearchHit{
 			Score: ogen.NewOptFloat32(float32(hit.Score)),
 		}
 
-		doc := hit.Document
+		doc := LocalizeTVShowDocument(hit.Document, chain)
 		apiDoc := ogen.TVShowSearchDocument{
 			ID:            ogen.NewOptUUID(parseUUID(doc.ID)),
 			TmdbID:        ogen.NewOptInt(int(doc.TMDbID)),
@@ -325,6 +353,8 @@ func (h *Handler) SearchLibraryTVShows(ctx context.Context, params ogen.SearchLi
 			TotalSeasons:  ogen.NewOptInt(int(doc.TotalSeasons)),
 			TotalEpisodes: ogen.NewOptInt(int(doc.TotalEpisodes)),
 		}
+		apiDoc.PosterPlaceholder = searchPlaceholderToOgen(doc.PosterBlurHash, doc.PosterColor)
+		apiDoc.BackdropPlaceholder = searchPlaceholderToOgen(doc.BackdropBlurHash, doc.BackdropColor)
 
 		if doc.FirstAirDate > 0 {
 			t := time.Unix(doc.FirstAirDate, 0)
@@ -335,6 +365,10 @@ func (h *Handler) SearchLibraryTVShows(ctx context.Context, params ogen.SearchLi
 			apiDoc.Genres = make([]string, len(doc.Genres))
 			copy(apiDoc.Genres, doc.Genres)
 		}
+		if len(doc.Tags) > 0 {
+			apiDoc.Tags = make([]string, len(doc.Tags))
+			copy(apiDoc.Tags, doc.Tags)
+		}
 		if len(doc.Cast) > 0 {
 			apiDoc.Cast = make([]string, len(doc.Cast))
 			copy(apiDoc.Cast, doc.Cast)
@@ -376,6 +410,11 @@ func (h *Handler) SearchLibraryTVShows(ctx context.Context, params ogen.SearchLi
 
 // AutocompleteTVShows provides autocomplete suggestions for TV show titles.
 func (h *Handler) AutocompleteTVShows(ctx context.Context, params ogen.AutocompleteTVShowsParams) (ogen.AutocompleteTVShowsRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if h.tvshowSearchService == nil {
 		return &ogen.AutocompleteResults{Suggestions: []string{}}, nil
 	}
@@ -400,7 +439,7 @@ func (h *Handler) GetTVShowSearchFacets(ctx context.Context) (ogen.GetTVShowSear
 		return &ogen.TVShowSearchFacets{}, nil
 	}
 
-	facetNames := []string{"genres", "year", "status", "type", "networks", "has_file"}
+	facetNames := []string{"genres", "tags", "year", "status", "type", "networks", "has_file"}
 
 	facets, err := h.tvshowSearchService.GetFacets(ctx, facetNames)
 	if err != nil {
@@ -413,6 +452,9 @@ func (h *Handler) GetTVShowSearchFacets(ctx context.Context) (ogen.GetTVShowSear
 	if values, ok := facets["genres"]; ok {
 		response.Genres = convertFacetValues(values)
 	}
+	if values, ok := facets["tags"]; ok {
+		response.Tags = convertFacetValues(values)
+	}
 	if values, ok := facets["year"]; ok {
 		response.Years = convertFacetValues(values)
 	}
@@ -436,12 +478,18 @@ func (h *Handler) GetTVShowSearchFacets(ctx context.Context) (ogen.GetTVShowSear
 
 // SearchMulti searches across all collections in parallel and returns merged results.
 func (h *Handler) SearchMulti(ctx context.Context, params ogen.SearchMultiParams) (ogen.SearchMultiRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := 5
 	if params.Limit.Set {
 		limit = params.Limit.Value
 	}
 
 	response := &ogen.MultiSearchResults{}
+	chain := h.GetMetadataLanguageChain(ctx)
 	var mu sync.Mutex
 	var wg sync.WaitGroup
 
@@ -461,7 +509,7 @@ func (h *Handler) SearchMulti(ctx context.Context, params ogen.SearchMultiParams
 				return
 			}
 			mu.Lock()
-			response.Movies = ogen.NewOptSearchResults(h.convertMovieResults(result))
+			response.Movies = ogen.NewOptSearchResults(h.convertMovieResults(result, chain))
 			mu.Unlock()
 		}()
 	}
@@ -482,7 +530,7 @@ func (h *Handler) SearchMulti(ctx context.Context, params ogen.SearchMultiParams
 				return
 			}
 			mu.Lock()
-			response.Tvshows = ogen.NewOptTVShowSearchResults(h.convertTVShowResults(result))
+			response.Tvshows = ogen.NewOptTVShowSearchResults(h.convertTVShowResults(result, chain))
 			mu.Unlock()
 		}()
 	}
@@ -550,13 +598,136 @@ func (h *Handler) SearchMulti(ctx context.Context, params ogen.SearchMultiParams
 		}()
 	}
 
+	// Search music albums
+	if h.musicSearchService != nil && h.musicSearchService.IsEnabled() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := h.musicSearchService.SearchAlbums(ctx, search.MusicSearchParams{
+				Query:   params.Q,
+				Page:    1,
+				PerPage: limit,
+				SortBy:  "_text_match:desc",
+			})
+			if err != nil {
+				h.logger.Warn("multi-search: music unavailable", slog.Any("error", err))
+				return
+			}
+			mu.Lock()
+			response.Music = ogen.NewOptMusicSearchResults(convertMusicResults(result))
+			mu.Unlock()
+		}()
+	}
+
+	// Search home videos of the libraries the user may access
+	if h.homeVideoSearchService != nil && h.homeVideoSearchService.IsEnabled() {
+		if userID, err := GetUserID(ctx); err == nil {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeHomeVideo)
+				if err != nil {
+					h.logger.Warn("multi-search: home video libraries unavailable", slog.Any("error", err))
+					return
+				}
+				result, err := h.homeVideoSearchService.SearchVideos(ctx, search.HomeVideoSearchParams{
+					Query:      params.Q,
+					Page:       1,
+					PerPage:    limit,
+					SortBy:     "_text_match:desc",
+					LibraryIDs: libraryIDs,
+				})
+				if err != nil {
+					h.logger.Warn("multi-search: home videos unavailable", slog.Any("error", err))
+					return
+				}
+				mu.Lock()
+				response.HomeVideos = ogen.NewOptHomeVideoSearchResults(convertHomeVideoResults(result))
+				mu.Unlock()
+			}()
+		}
+	}
+
 	wg.Wait()
 
 	return response, nil
 }
 
-// convertMovieResults converts movie search results to API response type.
-func (h *Handler) convertMovieResults(result *search.SearchResult) ogen.SearchResults {
+// SearchLibraryMusic searches music albums by title, artist or track title.
+func (h *Handler) SearchLibraryMusic(ctx context.Context, params ogen.SearchLibraryMusicParams) (ogen.SearchLibraryMusicRes, error) {
+	searchParams := search.DefaultMusicSearchParams()
+	searchParams.Query = params.Q
+	if params.Page.Set {
+		searchParams.Page = params.Page.Value
+	}
+	if params.PerPage.Set {
+		searchParams.PerPage = params.PerPage.Value
+	}
+	if params.FilterBy.Set {
+		searchParams.FilterBy = params.FilterBy.Value
+	}
+
+	if h.musicSearchService == nil {
+		empty := convertMusicResults(nil)
+		return &empty, nil
+	}
+
+	result, err := h.musicSearchService.SearchAlbums(ctx, searchParams)
+	if err != nil {
+		h.logger.Warn("music search unavailable, returning empty results", slog.Any("error", err))
+		empty := convertMusicResults(nil)
+		empty.CurrentPage = ogen.NewOptInt(searchParams.Page)
+		return &empty, nil
+	}
+
+	response := convertMusicResults(result)
+	return &response, nil
+}
+
+// SearchLibraryHomeVideos searches home videos by title, description or
+// folder, within the home video libraries the caller can access.
+func (h *Handler) SearchLibraryHomeVideos(ctx context.Context, params ogen.SearchLibraryHomeVideosParams) (ogen.SearchLibraryHomeVideosRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searchParams := search.DefaultHomeVideoSearchParams()
+	searchParams.Query = params.Q
+	if params.Page.Set {
+		searchParams.Page = params.Page.Value
+	}
+	if params.PerPage.Set {
+		searchParams.PerPage = params.PerPage.Value
+	}
+	if params.FilterBy.Set {
+		searchParams.FilterBy = params.FilterBy.Value
+	}
+
+	if h.homeVideoSearchService == nil {
+		empty := convertHomeVideoResults(nil)
+		return &empty, nil
+	}
+
+	searchParams.LibraryIDs, err = h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeHomeVideo)
+	if err != nil {
+		return nil, err
+	}
+	result, err := h.homeVideoSearchService.SearchVideos(ctx, searchParams)
+	if err != nil {
+		h.logger.Warn("home video search unavailable, returning empty results", slog.Any("error", err))
+		empty := convertHomeVideoResults(nil)
+		empty.CurrentPage = ogen.NewOptInt(searchParams.Page)
+		return &empty, nil
+	}
+
+	response := convertHomeVideoResults(result)
+	return &response, nil
+}
+
+// convertMovieResults converts movie search results to API response type,
+// localizing documents along the language chain.
+func (h *Handler) convertMovieResults(result *search.SearchResult, chain content.LanguageChain) ogen.SearchResults {
 	if result == nil {
 		return ogen.SearchResults{Hits: []ogen.SearchHit{}}
 	}
@@ -568,7 +739,7 @@ func (h *Handler) convertMovieResults(result *search.SearchResult) ogen.SearchRe
 	}
 
 	for _, hit := range result.Hits {
-		doc := hit.Document
+		doc := LocalizeMovieDocument(hit.Document, chain)
 		apiDoc := ogen.SearchDocument{
 			ID:          ogen.NewOptUUID(parseUUID(doc.ID)),
 			TmdbID:      ogen.NewOptInt(int(doc.TMDbID)),
@@ -579,6 +750,7 @@ func (h *Handler) convertMovieResults(result *search.SearchResult) ogen.SearchRe
 			HasFile:     ogen.NewOptBool(doc.HasFile),
 			Year:        ogen.NewOptInt(int(doc.Year)),
 		}
+		apiDoc.PosterPlaceholder = searchPlaceholderToOgen(doc.PosterBlurHash, doc.PosterColor)
 		if doc.ReleaseDate > 0 {
 			apiDoc.ReleaseDate = ogen.NewOptDate(time.Unix(doc.ReleaseDate, 0))
 		}
@@ -591,8 +763,9 @@ func (h *Handler) convertMovieResults(result *search.SearchResult) ogen.SearchRe
 	return resp
 }
 
-// convertTVShowResults converts TV show search results to API response type.
-func (h *Handler) convertTVShowResults(result *search.TVShowSearchResult) ogen.TVShowSearchResults {
+// convertTVShowResults converts TV show search results to API response type,
+// localizing documents along the language chain.
+func (h *Handler) convertTVShowResults(result *search.TVShowSearchResult, chain content.LanguageChain) ogen.TVShowSearchResults {
 	if result == nil {
 		return ogen.TVShowSearchResults{Hits: []ogen.TVShowSearchHit{}}
 	}
@@ -604,7 +777,7 @@ func (h *Handler) convertTVShowResults(result *search.TVShowSearchResult) ogen.T
 	}
 
 	for _, hit := range result.Hits {
-		doc := hit.Document
+		doc := LocalizeTVShowDocument(hit.Document, chain)
 		apiDoc := ogen.TVShowSearchDocument{
 			ID:          ogen.NewOptUUID(parseUUID(doc.ID)),
 			TmdbID:      ogen.NewOptInt(int(doc.TMDbID)),
@@ -615,6 +788,7 @@ func (h *Handler) convertTVShowResults(result *search.TVShowSearchResult) ogen.T
 			HasFile:     ogen.NewOptBool(doc.HasFile),
 			Year:        ogen.NewOptInt(int(doc.Year)),
 		}
+		apiDoc.PosterPlaceholder = searchPlaceholderToOgen(doc.PosterBlurHash, doc.PosterColor)
 		if doc.FirstAirDate > 0 {
 			apiDoc.FirstAirDate = ogen.NewOptDate(time.Unix(doc.FirstAirDate, 0))
 		}
@@ -655,6 +829,7 @@ func (h *Handler) convertEpisodeResults(result *search.EpisodeSearchResult) ogen
 			SeriesTitle:      ogen.NewOptString(doc.SeriesTitle),
 			SeriesPosterPath: ogen.NewOptString(doc.SeriesPosterPath),
 		}
+		apiDoc.StillPlaceholder = searchPlaceholderToOgen(doc.StillBlurHash, doc.StillColor)
 		resp.Hits = append(resp.Hits, ogen.EpisodeSearchHit{
 			Document: ogen.NewOptEpisodeSearchDocument(apiDoc),
 			Score:    ogen.NewOptFloat32(float32(hit.Score)),
@@ -690,6 +865,7 @@ func (h *Handler) convertSeasonResults(result *search.SeasonSearchResult) ogen.S
 			SeriesTitle:      ogen.NewOptString(doc.SeriesTitle),
 			SeriesPosterPath: ogen.NewOptString(doc.SeriesPosterPath),
 		}
+		apiDoc.PosterPlaceholder = searchPlaceholderToOgen(doc.PosterBlurHash, doc.PosterColor)
 		resp.Hits = append(resp.Hits, ogen.SeasonSearchHit{
 			Document: ogen.NewOptSeasonSearchDocument(apiDoc),
 			Score:    ogen.NewOptFloat32(float32(hit.Score)),
@@ -699,6 +875,133 @@ func (h *Handler) convertSeasonResults(result *search.SeasonSearchResult) ogen.S
 	return resp
 }
 
+// convertMusicResults converts music album search results to API response type.
+func convertMusicResults(result *search.MusicSearchResult) ogen.MusicSearchResults {
+	if result == nil {
+		return ogen.MusicSearchResults{
+			TotalHits:    ogen.NewOptInt(0),
+			TotalPages:   ogen.NewOptInt(0),
+			CurrentPage:  ogen.NewOptInt(1),
+			SearchTimeMs: ogen.NewOptInt(0),
+			Hits:         []ogen.MusicSearchHit{},
+		}
+	}
+
+	resp := ogen.MusicSearchResults{
+		TotalHits:    ogen.NewOptInt(result.TotalHits),
+		TotalPages:   ogen.NewOptInt(result.TotalPages),
+		CurrentPage:  ogen.NewOptInt(result.CurrentPage),
+		SearchTimeMs: ogen.NewOptInt(int(result.SearchTime.Milliseconds())),
+		Hits:         make([]ogen.MusicSearchHit, 0, len(result.Hits)),
+	}
+
+	for _, hit := range result.Hits {
+		doc := hit.Document
+		apiHit := ogen.MusicSearchHit{
+			Document: ogen.NewOptMusicSearchDocument(ogen.MusicSearchDocument{
+				ID:            ogen.NewOptUUID(parseUUID(doc.ID)),
+				Title:         ogen.NewOptString(doc.Title),
+				ArtistCredit:  ogen.NewOptString(doc.ArtistCredit),
+				Artists:       doc.Artists,
+				TrackTitles:   doc.TrackTitles,
+				Genres:        doc.Genres,
+				Year:          ogen.NewOptInt(int(doc.Year)),
+				IsCompilation: ogen.NewOptBool(doc.IsCompilation),
+				TrackCount:    ogen.NewOptInt(int(doc.TrackCount)),
+				CoverPath:     ogen.NewOptString(doc.CoverPath),
+			}),
+			Score: ogen.NewOptFloat32(float32(hit.Score)),
+		}
+		if len(hit.Highlights) > 0 {
+			apiHit.Highlights = ogen.NewOptMusicSearchHitHighlights(ogen.MusicSearchHitHighlights(hit.Highlights))
+		}
+		resp.Hits = append(resp.Hits, apiHit)
+	}
+
+	if len(result.Facets) > 0 {
+		facets := make(ogen.MusicSearchResultsFacets, len(result.Facets))
+		for field, values := range result.Facets {
+			apiValues := make([]ogen.FacetValue, len(values))
+			for i, v := range values {
+				apiValues[i] = ogen.FacetValue{
+					Value: ogen.NewOptString(v.Value),
+					Count: ogen.NewOptInt(v.Count),
+				}
+			}
+			facets[field] = apiValues
+		}
+		resp.Facets = ogen.NewOptMusicSearchResultsFacets(facets)
+	}
+
+	return resp
+}
+
+// convertHomeVideoResults converts home video search results to API response type.
+func convertHomeVideoResults(result *search.HomeVideoSearchResult) ogen.HomeVideoSearchResults {
+	if result == nil {
+		return ogen.HomeVideoSearchResults{
+			TotalHits:    ogen.NewOptInt(0),
+			TotalPages:   ogen.NewOptInt(0),
+			CurrentPage:  ogen.NewOptInt(1),
+			SearchTimeMs: ogen.NewOptInt(0),
+			Hits:         []ogen.HomeVideoSearchHit{},
+		}
+	}
+
+	resp := ogen.HomeVideoSearchResults{
+		TotalHits:    ogen.NewOptInt(result.TotalHits),
+		TotalPages:   ogen.NewOptInt(result.TotalPages),
+		CurrentPage:  ogen.NewOptInt(result.CurrentPage),
+		SearchTimeMs: ogen.NewOptInt(int(result.SearchTime.Milliseconds())),
+		Hits:         make([]ogen.HomeVideoSearchHit, 0, len(result.Hits)),
+	}
+
+	for _, hit := range result.Hits {
+		doc := hit.Document
+		apiDoc := ogen.HomeVideoSearchDocument{
+			ID:              ogen.NewOptUUID(parseUUID(doc.ID)),
+			LibraryID:       ogen.NewOptUUID(parseUUID(doc.LibraryID)),
+			FolderID:        ogen.NewOptUUID(parseUUID(doc.FolderID)),
+			Title:           ogen.NewOptString(doc.Title),
+			Folders:         doc.Folders,
+			Year:            ogen.NewOptInt(int(doc.Year)),
+			DurationSeconds: ogen.NewOptFloat64(doc.DurationSeconds),
+			HasThumbnail:    ogen.NewOptBool(doc.HasThumbnail),
+		}
+		if doc.Description != "" {
+			apiDoc.Description = ogen.NewOptString(doc.Description)
+		}
+		if doc.RecordedAt != 0 {
+			apiDoc.RecordedAt = ogen.NewOptDateTime(time.Unix(doc.RecordedAt, 0).UTC())
+		}
+		apiHit := ogen.HomeVideoSearchHit{
+			Document: ogen.NewOptHomeVideoSearchDocument(apiDoc),
+			Score:    ogen.NewOptFloat32(float32(hit.Score)),
+		}
+		if len(hit.Highlights) > 0 {
+			apiHit.Highlights = ogen.NewOptHomeVideoSearchHitHighlights(ogen.HomeVideoSearchHitHighlights(hit.Highlights))
+		}
+		resp.Hits = append(resp.Hits, apiHit)
+	}
+
+	if len(result.Facets) > 0 {
+		facets := make(ogen.HomeVideoSearchResultsFacets, len(result.Facets))
+		for field, values := range result.Facets {
+			apiValues := make([]ogen.FacetValue, len(values))
+			for i, v := range values {
+				apiValues[i] = ogen.FacetValue{
+					Value: ogen.NewOptString(v.Value),
+					Count: ogen.NewOptInt(v.Count),
+				}
+			}
+			facets[field] = apiValues
+		}
+		resp.Facets = ogen.NewOptHomeVideoSearchResultsFacets(facets)
+	}
+
+	return resp
+}
+
 // convertPersonResults converts person search results to API response type.
 func (h *Handler) convertPersonResults(result *search.PersonSearchResult) ogen.PersonSearchResults {
 	if result == nil {
@@ -721,6 +1024,7 @@ func (h *Handler) convertPersonResults(result *search.PersonSearchResult) ogen.P
 			TvshowCount:  ogen.NewOptInt(int(doc.TVShowCount)),
 			TotalCredits: ogen.NewOptInt(int(doc.TotalCredits)),
 		}
+		apiDoc.ProfilePlaceholder = searchPlaceholderToOgen(doc.ProfileBlurHash, doc.ProfileColor)
 		if len(doc.KnownFor) > 0 {
 			apiDoc.KnownFor = make([]string, len(doc.KnownFor))
 			copy(apiDoc.KnownFor, doc.KnownFor)
@@ -742,6 +1046,19 @@ func (h *Handler) convertPersonResults(result *search.PersonSearchResult) ogen.P
 	return resp
 }
 
+// searchPlaceholderToOgen converts the placeholder fields of a search
+// document to ogen format.
+func searchPlaceholderToOgen(blurHash, color string) ogen.OptImagePlaceholder {
+	if blurHash == "" {
+		return ogen.OptImagePlaceholder{}
+	}
+	ph := ogen.ImagePlaceholder{Blurhash: blurHash}
+	if color != "" {
+		ph.Color = ogen.NewOptString(color)
+	}
+	return ogen.NewOptImagePlaceholder(ph)
+}
+
 func parseUUID(s string) uuid.UUID {
 	id, err := uuid.Parse(s)
 	if err != nil {
@@ -0,0 +1,166 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// showSpoilers reports whether the requesting user opted in to spoiler tags.
+func (h *Handler) showSpoilers(ctx context.Context) bool {
+	userID, ok := h.getUserID(ctx)
+	if !ok || h.userService == nil {
+		return false
+	}
+	prefs, err := h.userService.GetUserPreferences(ctx, userID)
+	return err == nil && prefs.ShowSpoilers != nil && *prefs.ShowSpoilers
+}
+
+// normalizeTagSlugs slugifies user supplied tags and drops empty and
+// duplicate entries.
+func normalizeTagSlugs(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		slug := util.Slugify(tag)
+		if slug != "" && !slices.Contains(out, slug) {
+			out = append(out, slug)
+		}
+	}
+	return out
+}
+
+// GetMovieTags returns the keywords and tags of a movie.
+// GET /api/v1/movies/{id}/tags
+func (h *Handler) GetMovieTags(ctx context.Context, params ogen.GetMovieTagsParams) (ogen.GetMovieTagsRes, error) {
+	tags, err := h.movieHandler.GetMovieTags(ctx, params.ID.String())
+	if err != nil {
+		if errors.Is(err, movie.ErrMovieNotFound) {
+			return (*ogen.GetMovieTagsNotFound)(OgenNotFound("Movie not found")), nil
+		}
+		return nil, err
+	}
+
+	spoilers := h.showSpoilers(ctx)
+	result := make(ogen.GetMovieTagsOKApplicationJSON, 0, len(tags))
+	for _, t := range tags {
+		if t.Spoiler && !spoilers {
+			continue
+		}
+		result = append(result, ogen.ContentTag{Slug: t.Slug, Name: t.Name, Spoiler: t.Spoiler})
+	}
+	return &result, nil
+}
+
+// GetTVShowTags returns the keywords and tags of a TV show.
+// GET /api/v1/tvshows/{id}/tags
+func (h *Handler) GetTVShowTags(ctx context.Context, params ogen.GetTVShowTagsParams) (ogen.GetTVShowTagsRes, error) {
+	tags, err := h.tvshowService.GetSeriesTags(ctx, params.ID)
+	if err != nil {
+		return (*ogen.GetTVShowTagsNotFound)(OgenNotFound("TV show not found")), nil
+	}
+
+	spoilers := h.showSpoilers(ctx)
+	result := make(ogen.GetTVShowTagsOKApplicationJSON, 0, len(tags))
+	for _, t := range tags {
+		if t.Spoiler && !spoilers {
+			continue
+		}
+		result = append(result, ogen.ContentTag{Slug: t.Slug, Name: t.Name, Spoiler: t.Spoiler})
+	}
+	return &result, nil
+}
+
+// ListTags returns the most used tags across movies and TV shows.
+// GET /api/v1/tags
+func (h *Handler) ListTags(ctx context.Context, params ogen.ListTagsParams) (ogen.ListTagsRes, error) {
+	limit := util.SafeIntToInt32(params.Limit.Or(100))
+
+	movieTags, err := h.movieHandler.ListDistinctTags(ctx, limit)
+	if err != nil {
+		h.logger.Error("failed to list movie tags", slog.Any("error", err))
+		return &ogen.Error{Code: 500, Message: "Failed to list tags"}, nil
+	}
+
+	tvTags, err := h.tvshowService.ListDistinctTags(ctx, limit)
+	if err != nil {
+		h.logger.Error("failed to list tvshow tags", slog.Any("error", err))
+		return &ogen.Error{Code: 500, Message: "Failed to list tags"}, nil
+	}
+
+	// Merge by slug: the same tag can appear in both movies and TV shows
+	bySlug := make(map[string]*ogen.Tag, len(movieTags)+len(tvTags))
+	for _, t := range movieTags {
+		bySlug[t.Slug] = &ogen.Tag{Slug: t.Slug, Name: t.Name, MovieCount: t.ItemCount}
+	}
+	for _, t := range tvTags {
+		if m, ok := bySlug[t.Slug]; ok {
+			m.TvshowCount = t.ItemCount
+		} else {
+			bySlug[t.Slug] = &ogen.Tag{Slug: t.Slug, Name: t.Name, TvshowCount: t.ItemCount}
+		}
+	}
+
+	result := make(ogen.ListTagsOKApplicationJSON, 0, len(bySlug))
+	for _, t := range bySlug {
+		result = append(result, *t)
+	}
+	slices.SortFunc(result, func(a, b ogen.Tag) int {
+		return cmp.Or(
+			cmp.Compare(b.MovieCount+b.TvshowCount, a.MovieCount+a.TvshowCount),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return &result, nil
+}
+
+// ListMoviesByTag returns movies carrying a tag.
+// GET /api/v1/tags/{slug}/movies
+func (h *Handler) ListMoviesByTag(ctx context.Context, params ogen.ListMoviesByTagParams) (ogen.ListMoviesByTagRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := h.movieHandler.GetMoviesByTag(ctx, params.Slug, movie.PaginationParams{
+		Limit:  util.SafeIntToInt32(params.Limit.Or(20)),
+		Offset: util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	localized := LocalizeMovies(movies, h.GetMetadataLanguageChain(ctx))
+	result := make(ogen.ListMoviesByTagOKApplicationJSON, len(localized))
+	for i, m := range localized {
+		result[i] = *movieToOgen(&m)
+	}
+	return &result, nil
+}
+
+// ListTVShowsByTag returns TV shows carrying a tag.
+// GET /api/v1/tags/{slug}/tvshows
+func (h *Handler) ListTVShowsByTag(ctx context.Context, params ogen.ListTVShowsByTagParams) (ogen.ListTVShowsByTagRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := h.tvshowService.ListByTag(ctx, params.Slug,
+		util.SafeIntToInt32(params.Limit.Or(20)), util.SafeIntToInt32(params.Offset.Or(0)))
+	if err != nil {
+		return nil, err
+	}
+
+	localized := LocalizeSeriesList(series, h.GetMetadataLanguageChain(ctx))
+	result := make(ogen.ListTVShowsByTagOKApplicationJSON, len(localized))
+	for i, s := range localized {
+		result[i] = *seriesToOgen(&s)
+	}
+	return &result, nil
+}
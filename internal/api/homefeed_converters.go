@@ -0,0 +1,59 @@
+package api
+
+import (
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/service/homefeed"
+)
+
+func homeFeedRowToOgen(r *homefeed.Row) ogen.HomeFeedRow {
+	result := ogen.HomeFeedRow{
+		ID:    r.ID,
+		Kind:  ogen.HomeFeedRowKind(r.Kind),
+		Title: r.Title,
+		Items: make([]ogen.HomeFeedItem, len(r.Items)),
+		Total: r.Total,
+	}
+	setOpt(&result.LibraryID, r.LibraryID)
+	if r.LibraryType != "" {
+		result.LibraryType.SetTo(r.LibraryType)
+	}
+	for i := range r.Items {
+		result.Items[i] = homeFeedItemToOgen(&r.Items[i])
+	}
+	return result
+}
+
+func homeFeedRowsToOgen(rows []homefeed.Row) []ogen.HomeFeedRow {
+	result := make([]ogen.HomeFeedRow, len(rows))
+	for i := range rows {
+		result[i] = homeFeedRowToOgen(&rows[i])
+	}
+	return result
+}
+
+func homeFeedItemToOgen(item *homefeed.Item) ogen.HomeFeedItem {
+	result := ogen.HomeFeedItem{
+		Type:    ogen.HomeFeedItemType(item.Type),
+		ID:      item.ID,
+		Title:   item.Title,
+		AddedAt: item.AddedAt,
+	}
+	if item.Subtitle != "" {
+		result.Subtitle.SetTo(item.Subtitle)
+	}
+	setOpt(&result.ParentID, item.ParentID)
+	setOptConv(&result.SeasonNumber, item.SeasonNumber, int32ToInt)
+	setOptConv(&result.EpisodeNumber, item.EpisodeNumber, int32ToInt)
+	setOpt(&result.LibraryID, item.LibraryID)
+	setOptConv(&result.Year, item.Year, int32ToInt)
+	setOpt(&result.PosterPath, item.PosterPath)
+	if item.ProgressSeconds > 0 {
+		result.ProgressSeconds.SetTo(int(item.ProgressSeconds))
+	}
+	if item.DurationSeconds > 0 {
+		result.DurationSeconds.SetTo(int(item.DurationSeconds))
+	}
+	setOptConv(&result.ProgressPercent, item.ProgressPercent, int32ToInt)
+	setOpt(&result.LastActivityAt, item.LastActivityAt)
+	return result
+}
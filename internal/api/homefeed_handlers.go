@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/service/homefeed"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// GetHomeFeed returns the rows of the caller's home feed.
+func (h *Handler) GetHomeFeed(ctx context.Context, params ogen.GetHomeFeedParams) (ogen.GetHomeFeedRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeFeedService == nil {
+		return &ogen.HomeFeed{Rows: []ogen.HomeFeedRow{}}, nil
+	}
+
+	ctx, err = h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := h.homeFeedService.Feed(ctx, h.homeFeedViewer(ctx, userID), util.SafeIntToInt32(params.Limit.Or(homefeed.DefaultRowLimit)))
+	if err != nil {
+		return nil, err
+	}
+	return &ogen.HomeFeed{Rows: homeFeedRowsToOgen(rows)}, nil
+}
+
+// GetHomeFeedRow returns a page of a home feed row.
+func (h *Handler) GetHomeFeedRow(ctx context.Context, params ogen.GetHomeFeedRowParams) (ogen.GetHomeFeedRowRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeFeedService == nil {
+		return (*ogen.GetHomeFeedRowNotFound)(OgenNotFound("Row not found")), nil
+	}
+
+	ctx, err = h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := h.homeFeedService.Row(ctx, h.homeFeedViewer(ctx, userID), params.ID,
+		util.SafeIntToInt32(params.Limit.Or(homefeed.DefaultRowLimit)),
+		util.SafeIntToInt32(params.Offset.Or(0)))
+	if err != nil {
+		if errors.Is(err, homefeed.ErrRowNotFound) {
+			return (*ogen.GetHomeFeedRowNotFound)(OgenNotFound("Row not found")), nil
+		}
+		return nil, err
+	}
+	result := homeFeedRowToOgen(row)
+	return &result, nil
+}
+
+// homeFeedViewer returns the viewer the feed of a user is built for.
+func (h *Handler) homeFeedViewer(ctx context.Context, userID uuid.UUID) homefeed.Viewer {
+	return homefeed.Viewer{UserID: userID, IsAdmin: h.isAdmin(ctx)}
+}
+
+// invalidateHomeFeed drops the user's cached home feed after their
+// progress changed. A failure only delays the change until the cached
+// rows expire.
+func (h *Handler) invalidateHomeFeed(ctx context.Context, userID uuid.UUID) {
+	if h.homeFeedService == nil {
+		return
+	}
+	if err := h.homeFeedService.Invalidate(ctx, userID); err != nil {
+		h.logger.Warn("failed to invalidate home feed",
+			slog.String("user_id", userID.String()), slog.Any("error", err))
+	}
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/playback"
+	"github.com/lusoris/revenge/internal/service/activity"
+	"github.com/lusoris/revenge/internal/service/homefeed"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/parental"
+	"github.com/lusoris/revenge/internal/service/rbac"
+)
+
+// homeFeedLibraries returns the same libraries to every user.
+type homeFeedLibraries struct {
+	libraries []library.Library
+}
+
+func (l *homeFeedLibraries) ListEnabled(context.Context) ([]library.Library, error) {
+	return l.libraries, nil
+}
+
+func (l *homeFeedLibraries) ListAccessible(context.Context, uuid.UUID) ([]library.Library, error) {
+	return l.libraries, nil
+}
+
+// homeFeedMovies is a minimal movie.Service with a movie in progress and
+// a recently added one. Like the repository, it leaves out movies the
+// access filter on the context rejects.
+type homeFeedMovies struct {
+	movie.Service
+	inProgress movie.ContinueWatchingItem
+	recent     movie.Movie
+	calls      int // continue watching lookups
+}
+
+func (m *homeFeedMovies) GetContinueWatching(ctx context.Context, _ uuid.UUID, _ []string, _ int32) ([]movie.ContinueWatchingItem, error) {
+	m.calls++
+	if !content.AccessFilterFromContext(ctx).Allows(m.inProgress.MinAge, nil) {
+		return nil, nil
+	}
+	return []movie.ContinueWatchingItem{m.inProgress}, nil
+}
+
+func (m *homeFeedMovies) ListRecentlyAdded(ctx context.Context, _ []string, _, _ int32) ([]movie.Movie, int64, error) {
+	if !content.AccessFilterFromContext(ctx).Allows(m.recent.MinAge, nil) {
+		return nil, 0, nil
+	}
+	return []movie.Movie{m.recent}, 1, nil
+}
+
+func (m *homeFeedMovies) GetWatchHistory(context.Context, uuid.UUID, int32, int32) ([]movie.WatchedMovieItem, error) {
+	return nil, nil
+}
+
+func (m *homeFeedMovies) ListTopRated(context.Context, int32, []string, int32, int32) ([]movie.Movie, int64, error) {
+	return nil, 0, nil
+}
+
+// newHomeFeedTestHandler returns a handler over a movie library holding a
+// 12+ movie in progress and an unrestricted recent one, with an in-memory
+// RBAC service without admins. The cache may be nil.
+func newHomeFeedTestHandler(t *testing.T, c *cache.Cache) (*Handler, *homeFeedMovies) {
+	t.Helper()
+
+	enforcer, err := casbin.NewSyncedEnforcer("../../config/casbin_model.conf")
+	require.NoError(t, err)
+	rbacService := rbac.NewService(enforcer, logging.NewTestLogger(), activity.NewNoopLogger())
+
+	movies := &homeFeedMovies{
+		inProgress: movie.ContinueWatchingItem{
+			Movie:           movie.Movie{ID: uuid.New(), Title: "Dune", Year: new(int32(2021)), MinAge: new(int32(12))},
+			ProgressSeconds: 2250, DurationSeconds: 9000,
+			LastWatchedAt: time.Date(2026, 10, 1, 20, 0, 0, 0, time.UTC),
+		},
+		recent: movie.Movie{ID: uuid.New(), Title: "Arrival", MinAge: new(int32(0))},
+	}
+	libraries := &homeFeedLibraries{libraries: []library.Library{
+		{ID: uuid.New(), Name: "Movies", Type: library.LibraryTypeMovie, Enabled: true},
+	}}
+	svc := homefeed.NewService(libraries, homefeed.Sources{Movies: movies}, c, logging.NewTestLogger())
+	return &Handler{logger: logging.NewTestLogger(), homeFeedService: svc, rbacService: rbacService}, movies
+}
+
+func TestHandler_GetHomeFeed(t *testing.T) {
+	handler, movies := newHomeFeedTestHandler(t, nil)
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetHomeFeed(ctx, ogen.GetHomeFeedParams{})
+	require.NoError(t, err)
+	feed, ok := result.(*ogen.HomeFeed)
+	require.True(t, ok, "expected *ogen.HomeFeed, got %T", result)
+
+	ids := make([]string, len(feed.Rows))
+	for i, row := range feed.Rows {
+		ids[i] = row.ID
+	}
+	assert.Equal(t, []string{"on_deck", "continue_watching", "recently_added:movie"}, ids)
+
+	row := feed.Rows[1]
+	assert.Equal(t, ogen.HomeFeedRowKindContinueWatching, row.Kind)
+	assert.Equal(t, int64(1), row.Total)
+	require.Len(t, row.Items, 1)
+	item := row.Items[0]
+	assert.Equal(t, ogen.HomeFeedItemTypeMovie, item.Type)
+	assert.Equal(t, movies.inProgress.ID, item.ID)
+	assert.Equal(t, 2021, item.Year.Or(0))
+	assert.Equal(t, 2250, item.ProgressSeconds.Or(0))
+	assert.Equal(t, 25, item.ProgressPercent.Or(0))
+	assert.True(t, item.LastActivityAt.IsSet())
+	assert.False(t, item.ParentID.IsSet())
+
+	recent := feed.Rows[2]
+	assert.Equal(t, "movie", recent.LibraryType.Or(""))
+	assert.False(t, recent.LibraryID.IsSet())
+	assert.False(t, recent.Items[0].ProgressPercent.IsSet())
+}
+
+func TestHandler_GetHomeFeedRow(t *testing.T) {
+	handler, movies := newHomeFeedTestHandler(t, nil)
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{ID: "recently_added:movie"})
+	require.NoError(t, err)
+	row, ok := result.(*ogen.HomeFeedRow)
+	require.True(t, ok, "expected *ogen.HomeFeedRow, got %T", result)
+	require.Len(t, row.Items, 1)
+	assert.Equal(t, movies.recent.ID, row.Items[0].ID)
+
+	result, err = handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{
+		ID:     "recently_added:movie",
+		Offset: ogen.NewOptInt(1),
+	})
+	require.NoError(t, err)
+	row, ok = result.(*ogen.HomeFeedRow)
+	require.True(t, ok, "expected *ogen.HomeFeedRow, got %T", result)
+	assert.Empty(t, row.Items)
+	assert.Equal(t, int64(1), row.Total)
+
+	result, err = handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{ID: "recently_added:" + uuid.NewString()})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetHomeFeedRowNotFound{}, result)
+}
+
+func TestHandler_GetHomeFeed_RestrictedUser(t *testing.T) {
+	handler, movies := newHomeFeedTestHandler(t, nil)
+	userID := uuid.New()
+	maxAge := int32(6)
+	handler.parentalService = parental.NewService(&stubParentalRepository{controls: &parental.Controls{
+		UserID:        userID,
+		MaxAge:        &maxAge,
+		UnratedPolicy: parental.UnratedBlock,
+	}}, logging.NewTestLogger())
+	ctx := contextWithUserID(context.Background(), userID)
+
+	result, err := handler.GetHomeFeed(ctx, ogen.GetHomeFeedParams{})
+	require.NoError(t, err)
+	feed, ok := result.(*ogen.HomeFeed)
+	require.True(t, ok, "expected *ogen.HomeFeed, got %T", result)
+	for _, row := range feed.Rows {
+		for _, item := range row.Items {
+			assert.NotEqual(t, movies.inProgress.ID, item.ID, "row %s holds a restricted movie", row.ID)
+		}
+	}
+
+	rowResult, err := handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{ID: "recently_added:movie"})
+	require.NoError(t, err)
+	row, ok := rowResult.(*ogen.HomeFeedRow)
+	require.True(t, ok, "expected *ogen.HomeFeedRow, got %T", rowResult)
+	require.Len(t, row.Items, 1)
+	assert.Equal(t, movies.recent.ID, row.Items[0].ID)
+}
+
+func TestHandler_HomeFeed_ServiceUnavailable(t *testing.T) {
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetHomeFeed(ctx, ogen.GetHomeFeedParams{})
+	require.NoError(t, err)
+	feed, ok := result.(*ogen.HomeFeed)
+	require.True(t, ok, "expected *ogen.HomeFeed, got %T", result)
+	assert.Empty(t, feed.Rows)
+
+	row, err := handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{ID: "on_deck"})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetHomeFeedRowNotFound{}, row)
+}
+
+func TestHandler_PodcastProgress_InvalidatesHomeFeed(t *testing.T) {
+	c, err := cache.NewCache(nil, 1000, time.Minute)
+	require.NoError(t, err)
+	handler, movies := newHomeFeedTestHandler(t, c)
+	podcasts, _, episodes := newPodcastMockService(t)
+	handler.podcastService = podcasts
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	load := func() {
+		t.Helper()
+		_, err := handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{ID: "continue_watching"})
+		require.NoError(t, err)
+	}
+
+	load()
+	load()
+	assert.Equal(t, 1, movies.calls, "second load is served from the cache")
+
+	_, err = handler.UpdatePodcastEpisodeProgress(ctx, &ogen.PodcastEpisodeProgressUpdate{PositionSeconds: 120},
+		ogen.UpdatePodcastEpisodeProgressParams{ID: episodes[0].ID})
+	require.NoError(t, err)
+	load()
+	assert.Equal(t, 2, movies.calls, "progress updates invalidate the feed")
+
+	_, err = handler.DeletePodcastEpisodeProgress(ctx, ogen.DeletePodcastEpisodeProgressParams{ID: episodes[0].ID})
+	require.NoError(t, err)
+	load()
+	assert.Equal(t, 3, movies.calls, "removed progress invalidates the feed")
+}
+
+func TestHandler_Heartbeat_InvalidatesHomeFeed(t *testing.T) {
+	c, err := cache.NewCache(nil, 1000, time.Minute)
+	require.NoError(t, err)
+	handler, movies := newHomeFeedTestHandler(t, c)
+
+	sm, err := playback.NewSessionManager(10, 30*time.Minute, logging.NewTestLogger())
+	require.NoError(t, err)
+	defer sm.Close()
+	svc, err := playback.NewService(testPlaybackConfig(), sm, testPipelineManagerForAPI(t), nil, nil, nil, nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
+	require.NoError(t, err)
+	defer svc.Close()
+	handler.playbackService = svc
+
+	userID := uuid.New()
+	sess := &playback.Session{ID: uuid.Must(uuid.NewV7()), UserID: userID, MediaType: playback.MediaTypeMovie, MediaID: movies.inProgress.ID}
+	require.NoError(t, sm.Create(sess))
+	ctx := contextWithUserID(context.Background(), userID)
+
+	load := func() {
+		t.Helper()
+		_, err := handler.GetHomeFeedRow(ctx, ogen.GetHomeFeedRowParams{ID: "continue_watching"})
+		require.NoError(t, err)
+	}
+	heartbeat := func(req ogen.OptHeartbeatPlaybackSessionReq) {
+		t.Helper()
+		result, err := handler.HeartbeatPlaybackSession(ctx, req, ogen.HeartbeatPlaybackSessionParams{SessionId: sess.ID})
+		require.NoError(t, err)
+		require.IsType(t, &ogen.HeartbeatPlaybackSessionNoContent{}, result)
+	}
+
+	load()
+	heartbeat(ogen.OptHeartbeatPlaybackSessionReq{})
+	load()
+	assert.Equal(t, 1, movies.calls, "heartbeats without a position keep the feed")
+
+	heartbeat(ogen.NewOptHeartbeatPlaybackSessionReq(ogen.HeartbeatPlaybackSessionReq{PositionSeconds: ogen.NewOptInt(600)}))
+	load()
+	assert.Equal(t, 2, movies.calls, "reported positions invalidate the feed")
+}
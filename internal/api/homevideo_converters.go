@@ -0,0 +1,81 @@
+package api
+
+import (
+	"path/filepath"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+)
+
+func homeVideoToOgen(v *homevideo.Video) ogen.HomeVideo {
+	result := ogen.HomeVideo{
+		ID:               v.ID,
+		LibraryID:        v.LibraryID,
+		FolderID:         v.FolderID,
+		Title:            v.Title,
+		TitleSource:      ogen.HomeVideoTitleSource(v.TitleSource),
+		FileName:         filepath.Base(v.FilePath),
+		FileSize:         v.FileSize,
+		RecordedAt:       v.RecordedAt,
+		RecordedAtSource: ogen.HomeVideoRecordedAtSource(v.RecordedAtSource),
+		DurationSeconds:  v.DurationSeconds,
+		Width:            int(v.Width),
+		Height:           int(v.Height),
+		HasThumbnail:     v.HasThumbnail(),
+		CreatedAt:        v.CreatedAt,
+	}
+	setOpt(&result.Description, v.Description)
+	setOpt(&result.Container, v.Container)
+	setOpt(&result.VideoCodec, v.VideoCodec)
+	setOpt(&result.AudioCodec, v.AudioCodec)
+	return result
+}
+
+func homeVideosToOgen(videos []homevideo.Video) []ogen.HomeVideo {
+	result := make([]ogen.HomeVideo, len(videos))
+	for i := range videos {
+		result[i] = homeVideoToOgen(&videos[i])
+	}
+	return result
+}
+
+func homeVideoFolderToOgen(f *homevideo.Folder) ogen.HomeVideoFolder {
+	result := ogen.HomeVideoFolder{
+		ID:          f.ID,
+		LibraryID:   f.LibraryID,
+		Title:       f.Title,
+		FolderCount: f.FolderCount,
+		VideoCount:  f.VideoCount,
+		CreatedAt:   f.CreatedAt,
+	}
+	setOpt(&result.ParentID, f.ParentID)
+	setOpt(&result.CoverVideoID, f.CoverVideoID)
+	return result
+}
+
+func homeVideoFoldersToOgen(folders []homevideo.Folder) []ogen.HomeVideoFolder {
+	result := make([]ogen.HomeVideoFolder, len(folders))
+	for i := range folders {
+		result[i] = homeVideoFolderToOgen(&folders[i])
+	}
+	return result
+}
+
+func homeVideoFolderRefsToOgen(refs []homevideo.FolderRef) []ogen.HomeVideoFolderRef {
+	result := make([]ogen.HomeVideoFolderRef, len(refs))
+	for i, r := range refs {
+		result[i] = ogen.HomeVideoFolderRef{ID: r.ID, Title: r.Title}
+	}
+	return result
+}
+
+func homeVideoProgressToOgen(p *homevideo.WatchProgress) ogen.HomeVideoProgress {
+	return ogen.HomeVideoProgress{
+		VideoID:         p.VideoID,
+		ProgressSeconds: int(p.ProgressSeconds),
+		DurationSeconds: int(p.DurationSeconds),
+		IsCompleted:     p.IsCompleted,
+		WatchCount:      int(p.WatchCount),
+		LastWatchedAt:   p.LastWatchedAt,
+	}
+}
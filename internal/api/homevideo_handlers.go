@@ -0,0 +1,350 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ListHomeVideos returns a paginated list of videos from the caller's home
+// video libraries.
+func (h *Handler) ListHomeVideos(ctx context.Context, params ogen.ListHomeVideosParams) (ogen.ListHomeVideosRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return &ogen.HomeVideoListResponse{Items: []ogen.HomeVideo{}}, nil
+	}
+
+	if libraryID, ok := params.LibraryID.Get(); ok {
+		if err := h.checkLibrary(ctx, userID, libraryID, library.LibraryTypeHomeVideo); err != nil {
+			if errors.Is(err, errLibraryDenied) {
+				return (*ogen.ListHomeVideosForbidden)(OgenForbidden("Access denied to this library")), nil
+			}
+			return nil, err
+		}
+	}
+	if folderID, ok := params.FolderID.Get(); ok {
+		if _, err := h.accessibleHomeVideoFolder(ctx, userID, folderID); err != nil {
+			if errors.Is(err, homevideo.ErrFolderNotFound) {
+				return (*ogen.ListHomeVideosNotFound)(OgenNotFound("Folder not found")), nil
+			}
+			return nil, err
+		}
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeHomeVideo)
+	if err != nil {
+		return nil, err
+	}
+	videos, total, err := h.homeVideoService.ListVideos(ctx, homevideo.VideoListFilters{
+		LibraryIDs: libraryIDs,
+		LibraryID:  optPtr[uuid.UUID](params.LibraryID),
+		FolderID:   optPtr[uuid.UUID](params.FolderID),
+		Sort:       homevideo.VideoSort(params.Sort.Or(ogen.ListHomeVideosSortRecorded)),
+		Limit:      util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.HomeVideoListResponse{
+		Items: homeVideosToOgen(videos),
+		Total: total,
+	}, nil
+}
+
+// ListHomeVideoContinueWatching returns the home videos the caller started
+// but did not finish, last watched first.
+func (h *Handler) ListHomeVideoContinueWatching(ctx context.Context, params ogen.ListHomeVideoContinueWatchingParams) (ogen.ListHomeVideoContinueWatchingRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []ogen.HomeVideoContinueWatchingItem{}
+	if h.homeVideoService == nil {
+		return (*ogen.ListHomeVideoContinueWatchingOKApplicationJSON)(&result), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeHomeVideo)
+	if err != nil {
+		return nil, err
+	}
+	items, err := h.homeVideoService.ListContinueWatching(ctx, userID, libraryIDs,
+		util.SafeIntToInt32(params.Limit.Or(10)))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		result = append(result, ogen.HomeVideoContinueWatchingItem{
+			Video:           homeVideoToOgen(&items[i].Video),
+			ProgressSeconds: int(items[i].ProgressSeconds),
+			DurationSeconds: int(items[i].DurationSeconds),
+			LastWatchedAt:   items[i].LastWatchedAt,
+		})
+	}
+	return (*ogen.ListHomeVideoContinueWatchingOKApplicationJSON)(&result), nil
+}
+
+// ListHomeVideoFolders returns the subfolders of a folder, or the library
+// roots when no parent is given.
+func (h *Handler) ListHomeVideoFolders(ctx context.Context, params ogen.ListHomeVideoFoldersParams) (ogen.ListHomeVideoFoldersRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return &ogen.HomeVideoFolderListResponse{Items: []ogen.HomeVideoFolder{}}, nil
+	}
+
+	if libraryID, ok := params.LibraryID.Get(); ok {
+		if err := h.checkLibrary(ctx, userID, libraryID, library.LibraryTypeHomeVideo); err != nil {
+			if errors.Is(err, errLibraryDenied) {
+				return (*ogen.ListHomeVideoFoldersForbidden)(OgenForbidden("Access denied to this library")), nil
+			}
+			return nil, err
+		}
+	}
+	if parentID, ok := params.ParentID.Get(); ok {
+		if _, err := h.accessibleHomeVideoFolder(ctx, userID, parentID); err != nil {
+			if errors.Is(err, homevideo.ErrFolderNotFound) {
+				return (*ogen.ListHomeVideoFoldersNotFound)(OgenNotFound("Folder not found")), nil
+			}
+			return nil, err
+		}
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeHomeVideo)
+	if err != nil {
+		return nil, err
+	}
+	folders, total, err := h.homeVideoService.ListFolders(ctx, homevideo.FolderListFilters{
+		LibraryIDs: libraryIDs,
+		LibraryID:  optPtr[uuid.UUID](params.LibraryID),
+		ParentID:   optPtr[uuid.UUID](params.ParentID),
+		Limit:      util.SafeIntToInt32(params.Limit.Or(100)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.HomeVideoFolderListResponse{
+		Items: homeVideoFoldersToOgen(folders),
+		Total: total,
+	}, nil
+}
+
+// GetHomeVideoFolder returns a folder with its breadcrumb trail.
+func (h *Handler) GetHomeVideoFolder(ctx context.Context, params ogen.GetHomeVideoFolderParams) (ogen.GetHomeVideoFolderRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return (*ogen.GetHomeVideoFolderNotFound)(OgenNotFound("Folder not found")), nil
+	}
+
+	folder, err := h.accessibleHomeVideoFolder(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, homevideo.ErrFolderNotFound) {
+			return (*ogen.GetHomeVideoFolderNotFound)(OgenNotFound("Folder not found")), nil
+		}
+		return nil, err
+	}
+	refs, err := h.homeVideoService.GetFolderPath(ctx, folder.ID)
+	if err != nil {
+		if errors.Is(err, homevideo.ErrFolderNotFound) {
+			return (*ogen.GetHomeVideoFolderNotFound)(OgenNotFound("Folder not found")), nil
+		}
+		return nil, err
+	}
+
+	result := homeVideoFolderToOgen(folder)
+	result.Breadcrumbs = homeVideoFolderRefsToOgen(refs)
+	return &result, nil
+}
+
+// GetHomeVideo returns a home video.
+func (h *Handler) GetHomeVideo(ctx context.Context, params ogen.GetHomeVideoParams) (ogen.GetHomeVideoRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return (*ogen.GetHomeVideoNotFound)(OgenNotFound("Home video not found")), nil
+	}
+
+	video, err := h.accessibleHomeVideo(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, homevideo.ErrVideoNotFound) {
+			return (*ogen.GetHomeVideoNotFound)(OgenNotFound("Home video not found")), nil
+		}
+		return nil, err
+	}
+	result := homeVideoToOgen(video)
+	return &result, nil
+}
+
+// GetHomeVideoThumbnail returns the frame grabbed from a home video.
+func (h *Handler) GetHomeVideoThumbnail(ctx context.Context, params ogen.GetHomeVideoThumbnailParams) (ogen.GetHomeVideoThumbnailRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return (*ogen.GetHomeVideoThumbnailNotFound)(OgenNotFound("Home video not found")), nil
+	}
+
+	if _, err := h.accessibleHomeVideo(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, homevideo.ErrVideoNotFound) {
+			return (*ogen.GetHomeVideoThumbnailNotFound)(OgenNotFound("Home video not found")), nil
+		}
+		return nil, err
+	}
+
+	thumb, err := h.homeVideoService.GetThumbnail(ctx, params.ID)
+	switch {
+	case errors.Is(err, homevideo.ErrVideoNotFound):
+		return (*ogen.GetHomeVideoThumbnailNotFound)(OgenNotFound("Home video not found")), nil
+	case errors.Is(err, homevideo.ErrNoThumbnail), errors.Is(err, homevideo.ErrThumbnailsDisabled):
+		return (*ogen.GetHomeVideoThumbnailNotFound)(OgenNotFound("Thumbnail not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	// The response encoder closes the thumbnail once it is sent
+	return &ogen.GetHomeVideoThumbnailOK{Data: thumb}, nil
+}
+
+// GetHomeVideoProgress returns the caller's playback position in a home
+// video.
+func (h *Handler) GetHomeVideoProgress(ctx context.Context, params ogen.GetHomeVideoProgressParams) (ogen.GetHomeVideoProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return (*ogen.GetHomeVideoProgressNotFound)(OgenNotFound("Watch progress not found")), nil
+	}
+
+	if _, err := h.accessibleHomeVideo(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, homevideo.ErrVideoNotFound) {
+			return (*ogen.GetHomeVideoProgressNotFound)(OgenNotFound("Home video not found")), nil
+		}
+		return nil, err
+	}
+
+	progress, err := h.homeVideoService.GetWatchProgress(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, homevideo.ErrProgressNotFound) {
+			return (*ogen.GetHomeVideoProgressNotFound)(OgenNotFound("Watch progress not found")), nil
+		}
+		return nil, err
+	}
+	result := homeVideoProgressToOgen(progress)
+	return &result, nil
+}
+
+// UpdateHomeVideoProgress records the caller's playback position in a home
+// video.
+func (h *Handler) UpdateHomeVideoProgress(ctx context.Context, req *ogen.HomeVideoProgressUpdate, params ogen.UpdateHomeVideoProgressParams) (ogen.UpdateHomeVideoProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return (*ogen.UpdateHomeVideoProgressNotFound)(OgenNotFound("Home video not found")), nil
+	}
+
+	if _, err := h.accessibleHomeVideo(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, homevideo.ErrVideoNotFound) {
+			return (*ogen.UpdateHomeVideoProgressNotFound)(OgenNotFound("Home video not found")), nil
+		}
+		return nil, err
+	}
+
+	progress, err := h.homeVideoService.UpdateWatchProgress(ctx, userID, params.ID,
+		util.SafeIntToInt32(req.ProgressSeconds), util.SafeIntToInt32(req.DurationSeconds))
+	if err != nil {
+		switch {
+		case errors.Is(err, homevideo.ErrVideoNotFound):
+			return (*ogen.UpdateHomeVideoProgressNotFound)(OgenNotFound("Home video not found")), nil
+		case errors.Is(err, homevideo.ErrInvalidProgress):
+			return (*ogen.UpdateHomeVideoProgressBadRequest)(OgenBadRequest("Progress and duration must not be negative")), nil
+		}
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	result := homeVideoProgressToOgen(progress)
+	return &result, nil
+}
+
+// DeleteHomeVideoProgress clears the caller's playback position in a home
+// video.
+func (h *Handler) DeleteHomeVideoProgress(ctx context.Context, params ogen.DeleteHomeVideoProgressParams) (ogen.DeleteHomeVideoProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.homeVideoService == nil {
+		return (*ogen.DeleteHomeVideoProgressNotFound)(OgenNotFound("Watch progress not found")), nil
+	}
+
+	if _, err := h.accessibleHomeVideo(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, homevideo.ErrVideoNotFound) {
+			return (*ogen.DeleteHomeVideoProgressNotFound)(OgenNotFound("Home video not found")), nil
+		}
+		return nil, err
+	}
+
+	if err := h.homeVideoService.DeleteWatchProgress(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, homevideo.ErrProgressNotFound) {
+			return (*ogen.DeleteHomeVideoProgressNotFound)(OgenNotFound("Watch progress not found")), nil
+		}
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	return &ogen.DeleteHomeVideoProgressNoContent{}, nil
+}
+
+// accessibleHomeVideo returns a video of a library the user can access,
+// else homevideo.ErrVideoNotFound.
+func (h *Handler) accessibleHomeVideo(ctx context.Context, userID, videoID uuid.UUID) (*homevideo.Video, error) {
+	video, err := h.homeVideoService.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, userID, video.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return nil, homevideo.ErrVideoNotFound
+		}
+		return nil, err
+	}
+	return video, nil
+}
+
+// accessibleHomeVideoFolder returns a folder of a library the user can
+// access, else homevideo.ErrFolderNotFound.
+func (h *Handler) accessibleHomeVideoFolder(ctx context.Context, userID, folderID uuid.UUID) (*homevideo.Folder, error) {
+	folder, err := h.homeVideoService.GetFolder(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, userID, folder.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return nil, homevideo.ErrFolderNotFound
+		}
+		return nil, err
+	}
+	return folder, nil
+}
@@ -0,0 +1,278 @@
+package api
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+// homeVideoMockService is a minimal mock for homevideo.Service used by the
+// home video handler tests.
+type homeVideoMockService struct {
+	homevideo.Service
+	videos   map[uuid.UUID]*homevideo.Video
+	folders  map[uuid.UUID]*homevideo.Folder
+	progress map[uuid.UUID]*homevideo.WatchProgress
+	filters  *homevideo.VideoListFilters
+}
+
+func (m *homeVideoMockService) GetVideo(_ context.Context, id uuid.UUID) (*homevideo.Video, error) {
+	if v, ok := m.videos[id]; ok {
+		return v, nil
+	}
+	return nil, homevideo.ErrVideoNotFound
+}
+
+func (m *homeVideoMockService) ListVideos(_ context.Context, filters homevideo.VideoListFilters) ([]homevideo.Video, int64, error) {
+	m.filters = &filters
+	var videos []homevideo.Video
+	for _, v := range m.videos {
+		videos = append(videos, *v)
+	}
+	return videos, int64(len(videos)), nil
+}
+
+func (m *homeVideoMockService) GetThumbnail(_ context.Context, videoID uuid.UUID) (io.ReadCloser, error) {
+	v, ok := m.videos[videoID]
+	if !ok {
+		return nil, homevideo.ErrVideoNotFound
+	}
+	if !v.HasThumbnail() {
+		return nil, homevideo.ErrNoThumbnail
+	}
+	return io.NopCloser(strings.NewReader(*v.ThumbnailKey)), nil
+}
+
+func (m *homeVideoMockService) GetFolder(_ context.Context, id uuid.UUID) (*homevideo.Folder, error) {
+	if f, ok := m.folders[id]; ok {
+		return f, nil
+	}
+	return nil, homevideo.ErrFolderNotFound
+}
+
+func (m *homeVideoMockService) GetFolderPath(_ context.Context, id uuid.UUID) ([]homevideo.FolderRef, error) {
+	var refs []homevideo.FolderRef
+	for f, ok := m.folders[id]; ok; {
+		refs = append([]homevideo.FolderRef{{ID: f.ID, Title: f.Title}}, refs...)
+		if f.ParentID == nil {
+			break
+		}
+		f, ok = m.folders[*f.ParentID]
+	}
+	if len(refs) == 0 {
+		return nil, homevideo.ErrFolderNotFound
+	}
+	return refs, nil
+}
+
+func (m *homeVideoMockService) UpdateWatchProgress(_ context.Context, userID, videoID uuid.UUID, progressSeconds, durationSeconds int32) (*homevideo.WatchProgress, error) {
+	if progressSeconds < 0 || durationSeconds < 0 {
+		return nil, homevideo.ErrInvalidProgress
+	}
+	p := &homevideo.WatchProgress{
+		UserID: userID, VideoID: videoID, ProgressSeconds: progressSeconds, DurationSeconds: durationSeconds,
+		IsCompleted: float64(progressSeconds) > float64(durationSeconds)*homevideo.CompletedThreshold,
+		WatchCount:  1, LastWatchedAt: time.Now(),
+	}
+	m.progress[videoID] = p
+	return p, nil
+}
+
+func (m *homeVideoMockService) DeleteWatchProgress(_ context.Context, _, videoID uuid.UUID) error {
+	if _, ok := m.progress[videoID]; !ok {
+		return homevideo.ErrProgressNotFound
+	}
+	delete(m.progress, videoID)
+	return nil
+}
+
+// newHomeVideoMockService returns a mock with a library root folder, a
+// subfolder and a video in it with a thumbnail.
+func newHomeVideoMockService(rootID, folderID, videoID uuid.UUID) *homeVideoMockService {
+	libraryID := uuid.New()
+	return &homeVideoMockService{
+		videos: map[uuid.UUID]*homevideo.Video{
+			videoID: {
+				ID: videoID, LibraryID: libraryID, FolderID: folderID, FilePath: "/videos/2024/Summer/2024-06-30 Beach Day.mp4",
+				FileSize: 1 << 20, Container: new("mp4"), Title: "Beach Day", TitleSource: homevideo.TitleSourceFilename,
+				RecordedAt: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), RecordedAtSource: homevideo.RecordedAtSourceFilename,
+				DurationSeconds: 95.5, Width: 1920, Height: 1080, VideoCodec: new("h264"),
+				ThumbnailKey: new("thumbnail"),
+			},
+		},
+		folders: map[uuid.UUID]*homevideo.Folder{
+			rootID:   {ID: rootID, LibraryID: libraryID, Path: "/videos", Title: "videos", FolderCount: 1, VideoCount: 1},
+			folderID: {ID: folderID, LibraryID: libraryID, ParentID: &rootID, Path: "/videos/2024/Summer", Title: "Summer", VideoCount: 1},
+		},
+		progress: map[uuid.UUID]*homevideo.WatchProgress{},
+	}
+}
+
+func TestHandler_GetHomeVideo(t *testing.T) {
+	t.Parallel()
+
+	videoID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), homeVideoService: newHomeVideoMockService(uuid.New(), uuid.New(), videoID)}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetHomeVideo(ctx, ogen.GetHomeVideoParams{ID: videoID})
+	require.NoError(t, err)
+	v, ok := result.(*ogen.HomeVideo)
+	require.True(t, ok, "expected *ogen.HomeVideo, got %T", result)
+	assert.Equal(t, "Beach Day", v.Title)
+	assert.Equal(t, "2024-06-30 Beach Day.mp4", v.FileName)
+	assert.Equal(t, ogen.HomeVideoTitleSourceFilename, v.TitleSource)
+	assert.Equal(t, "h264", v.VideoCodec.Or(""))
+	assert.False(t, v.AudioCodec.IsSet())
+	assert.True(t, v.HasThumbnail)
+
+	result, err = handler.GetHomeVideo(ctx, ogen.GetHomeVideoParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetHomeVideoNotFound)
+	assert.True(t, ok, "expected *ogen.GetHomeVideoNotFound, got %T", result)
+}
+
+func TestHandler_ListHomeVideos_Filters(t *testing.T) {
+	t.Parallel()
+
+	folderID := uuid.New()
+	svc := newHomeVideoMockService(uuid.New(), folderID, uuid.New())
+	handler := &Handler{logger: logging.NewTestLogger(), homeVideoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.ListHomeVideos(ctx, ogen.ListHomeVideosParams{
+		FolderID: ogen.NewOptUUID(folderID),
+		Sort:     ogen.NewOptListHomeVideosSort(ogen.ListHomeVideosSortTitle),
+		Limit:    ogen.NewOptInt(20),
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.(*ogen.HomeVideoListResponse).Items, 1)
+
+	require.NotNil(t, svc.filters)
+	assert.Nil(t, svc.filters.LibraryIDs, "all libraries without library permissions")
+	assert.Equal(t, &folderID, svc.filters.FolderID)
+	assert.Equal(t, homevideo.VideoSortTitle, svc.filters.Sort)
+	assert.Equal(t, int32(20), svc.filters.Limit)
+
+	result, err = handler.ListHomeVideos(ctx, ogen.ListHomeVideosParams{FolderID: ogen.NewOptUUID(uuid.New())})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.ListHomeVideosNotFound)
+	assert.True(t, ok, "expected *ogen.ListHomeVideosNotFound, got %T", result)
+}
+
+func TestHandler_GetHomeVideoFolder_Breadcrumbs(t *testing.T) {
+	t.Parallel()
+
+	rootID, folderID := uuid.New(), uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), homeVideoService: newHomeVideoMockService(rootID, folderID, uuid.New())}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetHomeVideoFolder(ctx, ogen.GetHomeVideoFolderParams{ID: folderID})
+	require.NoError(t, err)
+	folder, ok := result.(*ogen.HomeVideoFolder)
+	require.True(t, ok, "expected *ogen.HomeVideoFolder, got %T", result)
+	assert.Equal(t, rootID, folder.ParentID.Or(uuid.Nil))
+	require.Len(t, folder.Breadcrumbs, 2)
+	assert.Equal(t, "videos", folder.Breadcrumbs[0].Title)
+	assert.Equal(t, "Summer", folder.Breadcrumbs[1].Title)
+
+	result, err = handler.GetHomeVideoFolder(ctx, ogen.GetHomeVideoFolderParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetHomeVideoFolderNotFound)
+	assert.True(t, ok, "expected *ogen.GetHomeVideoFolderNotFound, got %T", result)
+}
+
+func TestHandler_GetHomeVideoThumbnail(t *testing.T) {
+	t.Parallel()
+
+	videoID := uuid.New()
+	svc := newHomeVideoMockService(uuid.New(), uuid.New(), videoID)
+	handler := &Handler{logger: logging.NewTestLogger(), homeVideoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetHomeVideoThumbnail(ctx, ogen.GetHomeVideoThumbnailParams{ID: videoID})
+	require.NoError(t, err)
+	jpeg, ok := result.(*ogen.GetHomeVideoThumbnailOK)
+	require.True(t, ok, "expected *ogen.GetHomeVideoThumbnailOK, got %T", result)
+	data, err := io.ReadAll(jpeg.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "thumbnail", string(data))
+
+	svc.videos[videoID].ThumbnailKey = nil
+	result, err = handler.GetHomeVideoThumbnail(ctx, ogen.GetHomeVideoThumbnailParams{ID: videoID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetHomeVideoThumbnailNotFound)
+	assert.True(t, ok, "expected *ogen.GetHomeVideoThumbnailNotFound, got %T", result)
+}
+
+func TestHandler_HomeVideoProgress(t *testing.T) {
+	t.Parallel()
+
+	videoID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), homeVideoService: newHomeVideoMockService(uuid.New(), uuid.New(), videoID)}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.UpdateHomeVideoProgress(ctx, &ogen.HomeVideoProgressUpdate{ProgressSeconds: 90, DurationSeconds: 95},
+		ogen.UpdateHomeVideoProgressParams{ID: videoID})
+	require.NoError(t, err)
+	progress, ok := result.(*ogen.HomeVideoProgress)
+	require.True(t, ok, "expected *ogen.HomeVideoProgress, got %T", result)
+	assert.Equal(t, 90, progress.ProgressSeconds)
+	assert.True(t, progress.IsCompleted)
+
+	result, err = handler.UpdateHomeVideoProgress(ctx, &ogen.HomeVideoProgressUpdate{ProgressSeconds: -1},
+		ogen.UpdateHomeVideoProgressParams{ID: videoID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UpdateHomeVideoProgressBadRequest{}, result)
+
+	result, err = handler.UpdateHomeVideoProgress(ctx, &ogen.HomeVideoProgressUpdate{},
+		ogen.UpdateHomeVideoProgressParams{ID: uuid.New()})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.UpdateHomeVideoProgressNotFound{}, result)
+
+	deleted, err := handler.DeleteHomeVideoProgress(ctx, ogen.DeleteHomeVideoProgressParams{ID: videoID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.DeleteHomeVideoProgressNoContent{}, deleted)
+
+	deleted, err = handler.DeleteHomeVideoProgress(ctx, ogen.DeleteHomeVideoProgressParams{ID: videoID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.DeleteHomeVideoProgressNotFound{}, deleted)
+}
+
+func TestHandler_HomeVideo_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListHomeVideos(ctx, ogen.ListHomeVideosParams{})
+	require.NoError(t, err)
+	assert.Empty(t, list.(*ogen.HomeVideoListResponse).Items)
+
+	folders, err := handler.ListHomeVideoFolders(ctx, ogen.ListHomeVideoFoldersParams{})
+	require.NoError(t, err)
+	assert.Empty(t, folders.(*ogen.HomeVideoFolderListResponse).Items)
+
+	cw, err := handler.ListHomeVideoContinueWatching(ctx, ogen.ListHomeVideoContinueWatchingParams{})
+	require.NoError(t, err)
+	assert.Empty(t, *cw.(*ogen.ListHomeVideoContinueWatchingOKApplicationJSON))
+
+	search, err := handler.SearchLibraryHomeVideos(ctx, ogen.SearchLibraryHomeVideosParams{Q: "beach"})
+	require.NoError(t, err)
+	assert.Empty(t, search.(*ogen.HomeVideoSearchResults).Hits)
+
+	result, err := handler.GetHomeVideo(ctx, ogen.GetHomeVideoParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetHomeVideoNotFound)
+	assert.True(t, ok)
+}
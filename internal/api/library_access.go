@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// errLibraryDenied is returned when a user cannot access a library or the
+// library is not of the expected type. Handlers map it to a not-found or
+// forbidden response so hidden libraries are not revealed.
+var errLibraryDenied = errors.New("access denied to this library")
+
+// checkLibrary returns errLibraryDenied unless the library exists, is of
+// type libType and the user can access it.
+func (h *Handler) checkLibrary(ctx context.Context, userID, libraryID uuid.UUID, libType string) error {
+	if h.libraryService == nil {
+		return nil
+	}
+	lib, err := h.libraryService.Get(ctx, libraryID)
+	if err != nil {
+		if errors.Is(err, library.ErrNotFound) {
+			return errLibraryDenied
+		}
+		return err
+	}
+	if lib.Type != libType {
+		return errLibraryDenied
+	}
+	return h.checkLibraryAccess(ctx, userID, libraryID)
+}
+
+// checkLibraryAccess returns errLibraryDenied unless the user can access
+// the library. The user need not be the caller, e.g. when sharing with
+// another user.
+func (h *Handler) checkLibraryAccess(ctx context.Context, userID, libraryID uuid.UUID) error {
+	if h.libraryService == nil {
+		return nil
+	}
+	isAdmin, err := h.userIsAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	canAccess, err := h.libraryService.CanAccess(ctx, libraryID, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !canAccess {
+		return errLibraryDenied
+	}
+	return nil
+}
+
+// accessibleLibraryIDs returns the libraries of the given types the user
+// may browse, or nil when the user may browse all of them.
+func (h *Handler) accessibleLibraryIDs(ctx context.Context, userID uuid.UUID, libTypes ...string) ([]uuid.UUID, error) {
+	libs, err := h.accessibleLibraries(ctx, userID, libTypes...)
+	if err != nil || libs == nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(libs))
+	for i, lib := range libs {
+		ids[i] = lib.ID
+	}
+	return ids, nil
+}
+
+// accessibleLibraryPaths returns the path prefixes of the libraries of the
+// given types the user may browse, or nil when the user may browse all of
+// them. Modules whose items carry no library ID are scoped by file path.
+func (h *Handler) accessibleLibraryPaths(ctx context.Context, userID uuid.UUID, libTypes ...string) ([]string, error) {
+	libs, err := h.accessibleLibraries(ctx, userID, libTypes...)
+	if err != nil || libs == nil {
+		return nil, err
+	}
+	paths := []string{}
+	for _, lib := range libs {
+		paths = append(paths, lib.PathPrefixes()...)
+	}
+	return paths, nil
+}
+
+// accessibleLibraries returns the libraries of the given types the user may
+// browse, or nil when the user may browse all of them.
+func (h *Handler) accessibleLibraries(ctx context.Context, userID uuid.UUID, libTypes ...string) ([]library.Library, error) {
+	if h.libraryService == nil {
+		return nil, nil
+	}
+	isAdmin, err := h.userIsAdmin(ctx, userID)
+	if err != nil || isAdmin {
+		return nil, err
+	}
+
+	all, err := h.libraryService.ListAccessible(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	libs := []library.Library{}
+	for _, lib := range all {
+		if slices.Contains(libTypes, lib.Type) {
+			libs = append(libs, lib)
+		}
+	}
+	return libs, nil
+}
+
+// inLibraryPaths reports whether a file lies under one of the path
+// prefixes returned by accessibleLibraryPaths. Nil prefixes allow any file.
+func inLibraryPaths(filePath string, prefixes []string) bool {
+	if prefixes == nil {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(filePath, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// userIsAdmin reports whether a user has the admin role, which grants
+// access to every library. Unlike isAdmin it checks any user, not just the
+// caller, and surfaces role lookup errors.
+func (h *Handler) userIsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	if h.rbacService == nil {
+		return false, nil
+	}
+	return h.rbacService.HasRole(ctx, userID, "admin")
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/activity"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// stubLibraryRepository is an in-memory library.Repository holding
+// libraries and the view permissions granted on them.
+type stubLibraryRepository struct {
+	library.Repository
+	libraries []library.Library
+	grants    map[uuid.UUID][]uuid.UUID // user ID -> library IDs
+}
+
+func (r *stubLibraryRepository) Get(_ context.Context, id uuid.UUID) (*library.Library, error) {
+	for i := range r.libraries {
+		if r.libraries[i].ID == id {
+			return &r.libraries[i], nil
+		}
+	}
+	return nil, library.ErrNotFound
+}
+
+func (r *stubLibraryRepository) ListEnabled(context.Context) ([]library.Library, error) {
+	return r.libraries, nil
+}
+
+func (r *stubLibraryRepository) ListByType(_ context.Context, libType string) ([]library.Library, error) {
+	var libs []library.Library
+	for _, lib := range r.libraries {
+		if lib.Type == libType {
+			libs = append(libs, lib)
+		}
+	}
+	return libs, nil
+}
+
+func (r *stubLibraryRepository) CheckPermission(_ context.Context, libraryID, userID uuid.UUID, _ string) (bool, error) {
+	for _, id := range r.grants[userID] {
+		if id == libraryID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *stubLibraryRepository) GetUserAccessibleLibraries(_ context.Context, userID uuid.UUID) ([]library.Library, error) {
+	var libs []library.Library
+	for _, lib := range r.libraries {
+		if ok, _ := r.CheckPermission(context.Background(), lib.ID, userID, library.PermissionView); ok {
+			libs = append(libs, lib)
+		}
+	}
+	return libs, nil
+}
+
+// newStubLibraryService returns an uncached library service over the
+// given libraries, granting each user view access to the listed libraries.
+func newStubLibraryService(libs []library.Library, grants map[uuid.UUID][]uuid.UUID) *library.CachedService {
+	repo := &stubLibraryRepository{libraries: libs, grants: grants}
+	return library.NewCachedService(
+		library.NewService(repo, logging.NewTestLogger(), activity.NewNoopLogger()),
+		nil,
+		logging.NewTestLogger(),
+	)
+}
+
+func TestHandler_LibraryAccess(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	granted := library.Library{ID: uuid.New(), Name: "Family", Type: library.LibraryTypeHomeVideo}
+	hidden := library.Library{ID: uuid.New(), Name: "Private", Type: library.LibraryTypeHomeVideo}
+	photos := library.Library{ID: uuid.New(), Name: "Photos", Type: library.LibraryTypePhoto}
+	handler := &Handler{
+		logger: logging.NewTestLogger(),
+		libraryService: newStubLibraryService(
+			[]library.Library{granted, hidden, photos},
+			map[uuid.UUID][]uuid.UUID{userID: {granted.ID, photos.ID}},
+		),
+	}
+	ctx := context.Background()
+
+	require.NoError(t, handler.checkLibrary(ctx, userID, granted.ID, library.LibraryTypeHomeVideo))
+	assert.ErrorIs(t, handler.checkLibrary(ctx, userID, hidden.ID, library.LibraryTypeHomeVideo), errLibraryDenied)
+	assert.ErrorIs(t, handler.checkLibrary(ctx, userID, photos.ID, library.LibraryTypeHomeVideo), errLibraryDenied, "wrong library type")
+	assert.ErrorIs(t, handler.checkLibrary(ctx, userID, uuid.New(), library.LibraryTypeHomeVideo), errLibraryDenied, "unknown library")
+
+	ids, err := handler.accessibleLibraryIDs(ctx, userID, library.LibraryTypeHomeVideo)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{granted.ID}, ids)
+
+	ids, err = handler.accessibleLibraryIDs(ctx, uuid.New(), library.LibraryTypeHomeVideo)
+	require.NoError(t, err)
+	assert.NotNil(t, ids, "a user without grants is restricted to no libraries")
+	assert.Empty(t, ids)
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"time"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/livetv"
+)
+
+func liveTVSourceToOgen(s *livetv.Source) ogen.LiveTVSource {
+	result := ogen.LiveTVSource{
+		ID:        s.ID,
+		Name:      s.Name,
+		Kind:      ogen.LiveTVSourceKind(s.Kind),
+		Location:  s.Location,
+		Enabled:   s.Enabled,
+		CreatedAt: s.CreatedAt,
+	}
+	setOpt(&result.LastRefreshedAt, s.LastRefreshedAt)
+	setOpt(&result.LastError, s.LastError)
+	return result
+}
+
+// liveTVChannelToOgen converts a channel. Stream URLs can carry IPTV
+// credentials, so they are only shown to admins.
+func liveTVChannelToOgen(c *livetv.Channel, withStreamURL bool) ogen.LiveTVChannel {
+	result := ogen.LiveTVChannel{
+		ID:       c.ID,
+		SourceID: c.SourceID,
+		Name:     c.Name,
+		Enabled:  c.Enabled,
+	}
+	setOpt(&result.Number, c.Number)
+	setOpt(&result.GuideID, c.GuideID)
+	setOpt(&result.LogoURL, c.LogoURL)
+	setOpt(&result.Group, c.GroupTitle)
+	if withStreamURL {
+		result.StreamURL = ogen.NewOptString(c.StreamURL)
+	}
+	return result
+}
+
+func liveTVProgramToOgen(p *livetv.Program) ogen.LiveTVProgram {
+	categories := p.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+	result := ogen.LiveTVProgram{
+		ID:             p.ID,
+		GuideChannelID: p.GuideChannelID,
+		StartsAt:       p.StartsAt,
+		EndsAt:         p.EndsAt,
+		Title:          p.Title,
+		Categories:     categories,
+		IsNew:          p.IsNew,
+	}
+	setOpt(&result.Subtitle, p.Subtitle)
+	setOpt(&result.Description, p.Description)
+	setOptConv(&result.SeasonNumber, p.SeasonNumber, int32ToInt)
+	setOptConv(&result.EpisodeNumber, p.EpisodeNumber, int32ToInt)
+	setOpt(&result.EpisodeLabel, p.EpisodeLabel)
+	setOpt(&result.IconURL, p.IconURL)
+	if p.AirDate != nil {
+		result.AirDate = ogen.NewOptDate(*p.AirDate)
+	}
+	return result
+}
+
+func liveTVGuideToOgen(guide []livetv.ChannelGuide, withStreamURL bool) []ogen.LiveTVChannelGuide {
+	result := make([]ogen.LiveTVChannelGuide, len(guide))
+	for i := range guide {
+		programs := make([]ogen.LiveTVProgram, len(guide[i].Programs))
+		for j := range guide[i].Programs {
+			programs[j] = liveTVProgramToOgen(&guide[i].Programs[j])
+		}
+		result[i] = ogen.LiveTVChannelGuide{
+			Channel:  liveTVChannelToOgen(&guide[i].Channel, withStreamURL),
+			Programs: programs,
+		}
+	}
+	return result
+}
+
+func liveTVRecordingToOgen(r *livetv.Recording) ogen.LiveTVRecording {
+	result := ogen.LiveTVRecording{
+		ID:                   r.ID,
+		LibraryID:            r.LibraryID,
+		ChannelName:          r.ChannelName,
+		Title:                r.Title,
+		StartsAt:             r.StartsAt,
+		EndsAt:               r.EndsAt,
+		PaddingBeforeSeconds: int(r.PaddingBefore / time.Second),
+		PaddingAfterSeconds:  int(r.PaddingAfter / time.Second),
+		Status:               ogen.LiveTVRecordingStatus(r.Status),
+		CreatedAt:            r.CreatedAt,
+	}
+	setOpt(&result.ChannelID, r.ChannelID)
+	setOpt(&result.ProgramID, r.ProgramID)
+	setOpt(&result.SeriesRuleID, r.SeriesRuleID)
+	setOpt(&result.Subtitle, r.Subtitle)
+	setOpt(&result.Description, r.Description)
+	setOptConv(&result.SeasonNumber, r.SeasonNumber, int32ToInt)
+	setOptConv(&result.EpisodeNumber, r.EpisodeNumber, int32ToInt)
+	if r.FilePath != nil {
+		result.FileSize = ogen.NewOptInt64(r.FileSize)
+	}
+	setOpt(&result.Error, r.Error)
+	return result
+}
+
+func liveTVSeriesRuleToOgen(r *livetv.SeriesRule) ogen.LiveTVSeriesRule {
+	result := ogen.LiveTVSeriesRule{
+		ID:                   r.ID,
+		LibraryID:            r.LibraryID,
+		Title:                r.Title,
+		NewOnly:              r.NewOnly,
+		PaddingBeforeSeconds: int(r.PaddingBefore / time.Second),
+		PaddingAfterSeconds:  int(r.PaddingAfter / time.Second),
+		Enabled:              r.Enabled,
+		CreatedAt:            r.CreatedAt,
+	}
+	setOpt(&result.ChannelID, r.ChannelID)
+	return result
+}
+
+// optSeconds converts an optional number of seconds to a duration.
+func optSeconds(opt ogen.OptInt) *time.Duration {
+	if v, ok := opt.Get(); ok {
+		d := time.Duration(v) * time.Second
+		return &d
+	}
+	return nil
+}
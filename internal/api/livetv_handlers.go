@@ -0,0 +1,553 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/livetv"
+	livetvjobs "github.com/lusoris/revenge/internal/content/livetv/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// defaultGuideWindow is the guide window used when the request sets no end.
+const defaultGuideWindow = 6 * time.Hour
+
+// ListLiveTVChannels returns the channels in playlist order.
+func (h *Handler) ListLiveTVChannels(ctx context.Context, params ogen.ListLiveTVChannelsParams) (ogen.ListLiveTVChannelsRes, error) {
+	result := []ogen.LiveTVChannel{}
+	if h.liveTVService == nil {
+		return (*ogen.ListLiveTVChannelsOKApplicationJSON)(&result), nil
+	}
+
+	isAdmin := h.isAdmin(ctx)
+	channels, err := h.liveTVService.ListChannels(ctx, isAdmin && params.IncludeDisabled.Or(false))
+	if err != nil {
+		return nil, err
+	}
+	for i := range channels {
+		result = append(result, liveTVChannelToOgen(&channels[i], isAdmin))
+	}
+	return (*ogen.ListLiveTVChannelsOKApplicationJSON)(&result), nil
+}
+
+// GetLiveTVChannel returns a channel. Disabled channels are only shown to
+// admins.
+func (h *Handler) GetLiveTVChannel(ctx context.Context, params ogen.GetLiveTVChannelParams) (ogen.GetLiveTVChannelRes, error) {
+	if h.liveTVService == nil {
+		return (*ogen.GetLiveTVChannelNotFound)(OgenNotFound("Channel not found")), nil
+	}
+
+	isAdmin := h.isAdmin(ctx)
+	ch, err := h.liveTVService.GetChannel(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, livetv.ErrChannelNotFound) {
+			return (*ogen.GetLiveTVChannelNotFound)(OgenNotFound("Channel not found")), nil
+		}
+		return nil, err
+	}
+	if !ch.Enabled && !isAdmin {
+		return (*ogen.GetLiveTVChannelNotFound)(OgenNotFound("Channel not found")), nil
+	}
+
+	result := liveTVChannelToOgen(ch, isAdmin)
+	return &result, nil
+}
+
+// UpdateLiveTVChannel enables or disables a channel. Admin only.
+func (h *Handler) UpdateLiveTVChannel(ctx context.Context, req *ogen.LiveTVChannelUpdate, params ogen.UpdateLiveTVChannelParams) (ogen.UpdateLiveTVChannelRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UpdateLiveTVChannelUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UpdateLiveTVChannelForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.UpdateLiveTVChannelNotFound)(OgenNotFound("Channel not found")), nil
+	}
+
+	ch, err := h.liveTVService.SetChannelEnabled(ctx, params.ID, req.Enabled)
+	if err != nil {
+		if errors.Is(err, livetv.ErrChannelNotFound) {
+			return (*ogen.UpdateLiveTVChannelNotFound)(OgenNotFound("Channel not found")), nil
+		}
+		return nil, err
+	}
+
+	result := liveTVChannelToOgen(ch, true)
+	return &result, nil
+}
+
+// GetLiveTVGuide returns the enabled channels, or one channel, with their
+// programs in a time window.
+func (h *Handler) GetLiveTVGuide(ctx context.Context, params ogen.GetLiveTVGuideParams) (ogen.GetLiveTVGuideRes, error) {
+	result := []ogen.LiveTVChannelGuide{}
+	if h.liveTVService == nil {
+		if params.ChannelID.IsSet() {
+			return (*ogen.GetLiveTVGuideNotFound)(OgenNotFound("Channel not found")), nil
+		}
+		return (*ogen.GetLiveTVGuideOKApplicationJSON)(&result), nil
+	}
+
+	start := params.Start.Or(time.Now())
+	end := params.End.Or(start.Add(defaultGuideWindow))
+	isAdmin := h.isAdmin(ctx)
+
+	guide, err := h.liveTVService.Guide(ctx, start, end, optPtr(params.ChannelID))
+	switch {
+	case errors.Is(err, livetv.ErrInvalidGuideWindow):
+		return (*ogen.GetLiveTVGuideBadRequest)(OgenBadRequest("The guide window must end after it starts and span at most 14 days")), nil
+	case errors.Is(err, livetv.ErrChannelNotFound):
+		return (*ogen.GetLiveTVGuideNotFound)(OgenNotFound("Channel not found")), nil
+	case err != nil:
+		return nil, err
+	}
+	if len(guide) == 1 && !guide[0].Channel.Enabled && !isAdmin {
+		return (*ogen.GetLiveTVGuideNotFound)(OgenNotFound("Channel not found")), nil
+	}
+
+	result = liveTVGuideToOgen(guide, isAdmin)
+	return (*ogen.GetLiveTVGuideOKApplicationJSON)(&result), nil
+}
+
+// GetLiveTVProgram returns a program of the guide.
+func (h *Handler) GetLiveTVProgram(ctx context.Context, params ogen.GetLiveTVProgramParams) (ogen.GetLiveTVProgramRes, error) {
+	if h.liveTVService == nil {
+		return (*ogen.GetLiveTVProgramNotFound)(OgenNotFound("Program not found")), nil
+	}
+
+	p, err := h.liveTVService.GetProgram(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, livetv.ErrProgramNotFound) {
+			return (*ogen.GetLiveTVProgramNotFound)(OgenNotFound("Program not found")), nil
+		}
+		return nil, err
+	}
+
+	result := liveTVProgramToOgen(p)
+	return &result, nil
+}
+
+// ListLiveTVSources returns the configured channel lists and guides.
+// Admin only.
+func (h *Handler) ListLiveTVSources(ctx context.Context) (ogen.ListLiveTVSourcesRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.ListLiveTVSourcesUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.ListLiveTVSourcesForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	result := []ogen.LiveTVSource{}
+	if h.liveTVService == nil {
+		return (*ogen.ListLiveTVSourcesOKApplicationJSON)(&result), nil
+	}
+
+	sources, err := h.liveTVService.ListSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sources {
+		result = append(result, liveTVSourceToOgen(&sources[i]))
+	}
+	return (*ogen.ListLiveTVSourcesOKApplicationJSON)(&result), nil
+}
+
+// CreateLiveTVSource adds a channel list or guide and queues its first
+// refresh. Admin only.
+func (h *Handler) CreateLiveTVSource(ctx context.Context, req *ogen.LiveTVSourceCreate) (ogen.CreateLiveTVSourceRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.CreateLiveTVSourceUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.CreateLiveTVSourceForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.CreateLiveTVSourceNotFound)(OgenNotFound("Live TV is not enabled")), nil
+	}
+
+	src, err := h.liveTVService.AddSource(ctx, livetv.CreateSourceParams{
+		Name:     req.Name.Or(""),
+		Kind:     string(req.Kind),
+		Location: req.Location,
+		Enabled:  req.Enabled.Or(true),
+	})
+	switch {
+	case errors.Is(err, livetv.ErrInvalidSource):
+		return (*ogen.CreateLiveTVSourceBadRequest)(OgenBadRequest(err.Error())), nil
+	case errors.Is(err, livetv.ErrSourceExists):
+		return &ogen.CreateLiveTVSourceConflict{Code: 409, Message: "A source with this location already exists"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	h.logger.Info("live TV source added",
+		slog.String("source_id", src.ID.String()),
+		slog.String("kind", src.Kind),
+		slog.String("location", src.Location),
+	)
+	if src.Enabled {
+		h.queueLiveTVSourceRefresh(ctx, src.ID)
+	}
+
+	result := liveTVSourceToOgen(src)
+	return &result, nil
+}
+
+// DeleteLiveTVSource removes a source with its channels or programs.
+// Admin only.
+func (h *Handler) DeleteLiveTVSource(ctx context.Context, params ogen.DeleteLiveTVSourceParams) (ogen.DeleteLiveTVSourceRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.DeleteLiveTVSourceUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.DeleteLiveTVSourceForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.DeleteLiveTVSourceNotFound)(OgenNotFound("Source not found")), nil
+	}
+
+	if err := h.liveTVService.DeleteSource(ctx, params.ID); err != nil {
+		if errors.Is(err, livetv.ErrSourceNotFound) {
+			return (*ogen.DeleteLiveTVSourceNotFound)(OgenNotFound("Source not found")), nil
+		}
+		return nil, err
+	}
+	return &ogen.DeleteLiveTVSourceNoContent{}, nil
+}
+
+// RefreshLiveTVSource queues a reload of a source. Admin only.
+func (h *Handler) RefreshLiveTVSource(ctx context.Context, params ogen.RefreshLiveTVSourceParams) (ogen.RefreshLiveTVSourceRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.RefreshLiveTVSourceUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.RefreshLiveTVSourceForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.RefreshLiveTVSourceNotFound)(OgenNotFound("Source not found")), nil
+	}
+	if _, err := h.liveTVService.GetSource(ctx, params.ID); err != nil {
+		if errors.Is(err, livetv.ErrSourceNotFound) {
+			return (*ogen.RefreshLiveTVSourceNotFound)(OgenNotFound("Source not found")), nil
+		}
+		return nil, err
+	}
+
+	if h.riverClient == nil {
+		return nil, errors.New("job queue not available")
+	}
+	result, err := h.riverClient.Insert(ctx, livetvjobs.SourceRefreshArgs{SourceID: params.ID}, nil)
+	if err != nil {
+		h.logger.Error("failed to enqueue live TV source refresh", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &ogen.RefreshLiveTVSourceAccepted{
+		Message: ogen.NewOptString("Source refresh queued"),
+		JobID:   ogen.NewOptInt64(result.Job.ID),
+	}, nil
+}
+
+// ListLiveTVRecordings returns the caller's recordings.
+func (h *Handler) ListLiveTVRecordings(ctx context.Context, params ogen.ListLiveTVRecordingsParams) (ogen.ListLiveTVRecordingsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return &ogen.LiveTVRecordingListResponse{Items: []ogen.LiveTVRecording{}}, nil
+	}
+
+	filters := livetv.RecordingListFilters{
+		Limit:  util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset: util.SafeIntToInt32(params.Offset.Or(0)),
+	}
+	if status, ok := params.Status.Get(); ok {
+		s := string(status)
+		filters.Status = &s
+	}
+
+	recordings, total, err := h.liveTVService.ListRecordings(ctx, userID, filters)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]ogen.LiveTVRecording, len(recordings))
+	for i := range recordings {
+		items[i] = liveTVRecordingToOgen(&recordings[i])
+	}
+	return &ogen.LiveTVRecordingListResponse{Items: items, Total: total}, nil
+}
+
+// CreateLiveTVRecording schedules a recording of a program or of a
+// channel's time window and queues its capture.
+func (h *Handler) CreateLiveTVRecording(ctx context.Context, req *ogen.LiveTVRecordingCreate) (ogen.CreateLiveTVRecordingRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.CreateLiveTVRecordingNotFound)(OgenNotFound("Live TV is not enabled")), nil
+	}
+	if err := h.checkLiveTVLibraryAccess(ctx, userID, req.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return (*ogen.CreateLiveTVRecordingForbidden)(OgenForbidden("Access denied to this library")), nil
+		}
+		return nil, err
+	}
+
+	rec, err := h.liveTVService.ScheduleRecording(ctx, userID, livetv.RecordingRequest{
+		ProgramID:     optPtr(req.ProgramID),
+		ChannelID:     optPtr(req.ChannelID),
+		Title:         req.Title.Or(""),
+		StartsAt:      req.StartsAt.Or(time.Time{}),
+		EndsAt:        req.EndsAt.Or(time.Time{}),
+		PaddingBefore: optSeconds(req.PaddingBeforeSeconds),
+		PaddingAfter:  optSeconds(req.PaddingAfterSeconds),
+		LibraryID:     optPtr(req.LibraryID),
+	})
+	switch {
+	case errors.Is(err, livetv.ErrProgramNotFound):
+		return (*ogen.CreateLiveTVRecordingNotFound)(OgenNotFound("Program not found")), nil
+	case errors.Is(err, livetv.ErrChannelNotFound):
+		return (*ogen.CreateLiveTVRecordingNotFound)(OgenNotFound("Channel not found")), nil
+	case errors.Is(err, livetv.ErrAlreadyScheduled):
+		return &ogen.CreateLiveTVRecordingConflict{Code: 409, Message: "The program is already scheduled"}, nil
+	case errors.Is(err, livetv.ErrInvalidRecording), errors.Is(err, livetv.ErrInvalidLibrary),
+		errors.Is(err, livetv.ErrNoLibrary), errors.Is(err, livetv.ErrNoRecordingDirectory):
+		return (*ogen.CreateLiveTVRecordingBadRequest)(OgenBadRequest(err.Error())), nil
+	case errors.Is(err, library.ErrNotFound):
+		return (*ogen.CreateLiveTVRecordingBadRequest)(OgenBadRequest("Library not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	h.logger.Info("recording scheduled",
+		slog.String("recording_id", rec.ID.String()),
+		slog.String("title", rec.Title),
+		slog.String("channel", rec.ChannelName),
+		slog.Time("starts_at", rec.StartsAt),
+		slog.String("user_id", userID.String()),
+	)
+	h.queueLiveTVRecording(ctx, rec)
+
+	result := liveTVRecordingToOgen(rec)
+	return &result, nil
+}
+
+// GetLiveTVRecording returns a recording of the caller.
+func (h *Handler) GetLiveTVRecording(ctx context.Context, params ogen.GetLiveTVRecordingParams) (ogen.GetLiveTVRecordingRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.GetLiveTVRecordingNotFound)(OgenNotFound("Recording not found")), nil
+	}
+
+	rec, err := h.liveTVService.GetRecording(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, livetv.ErrRecordingNotFound) {
+			return (*ogen.GetLiveTVRecordingNotFound)(OgenNotFound("Recording not found")), nil
+		}
+		return nil, err
+	}
+	if rec.UserID != userID && !h.isAdmin(ctx) {
+		return (*ogen.GetLiveTVRecordingForbidden)(OgenForbidden("Access denied")), nil
+	}
+
+	result := liveTVRecordingToOgen(rec)
+	return &result, nil
+}
+
+// DeleteLiveTVRecording cancels a scheduled recording or removes a
+// finished one of the caller.
+func (h *Handler) DeleteLiveTVRecording(ctx context.Context, params ogen.DeleteLiveTVRecordingParams) (ogen.DeleteLiveTVRecordingRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.DeleteLiveTVRecordingNotFound)(OgenNotFound("Recording not found")), nil
+	}
+
+	rec, err := h.liveTVService.GetRecording(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, livetv.ErrRecordingNotFound) {
+			return (*ogen.DeleteLiveTVRecordingNotFound)(OgenNotFound("Recording not found")), nil
+		}
+		return nil, err
+	}
+	if rec.UserID != userID && !h.isAdmin(ctx) {
+		return (*ogen.DeleteLiveTVRecordingForbidden)(OgenForbidden("Access denied")), nil
+	}
+
+	err = h.liveTVService.DeleteRecording(ctx, rec.ID)
+	switch {
+	case errors.Is(err, livetv.ErrRecordingInProgress):
+		return &ogen.DeleteLiveTVRecordingConflict{Code: 409, Message: "The recording is in progress"}, nil
+	case errors.Is(err, livetv.ErrRecordingNotFound):
+		return (*ogen.DeleteLiveTVRecordingNotFound)(OgenNotFound("Recording not found")), nil
+	case err != nil:
+		return nil, err
+	}
+	return &ogen.DeleteLiveTVRecordingNoContent{}, nil
+}
+
+// ListLiveTVSeriesRules returns the caller's series rules.
+func (h *Handler) ListLiveTVSeriesRules(ctx context.Context) (ogen.ListLiveTVSeriesRulesRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []ogen.LiveTVSeriesRule{}
+	if h.liveTVService == nil {
+		return (*ogen.ListLiveTVSeriesRulesOKApplicationJSON)(&result), nil
+	}
+
+	rules, err := h.liveTVService.ListSeriesRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		result = append(result, liveTVSeriesRuleToOgen(&rules[i]))
+	}
+	return (*ogen.ListLiveTVSeriesRulesOKApplicationJSON)(&result), nil
+}
+
+// CreateLiveTVSeriesRule adds a series rule and queues the scheduling of
+// its airings.
+func (h *Handler) CreateLiveTVSeriesRule(ctx context.Context, req *ogen.LiveTVSeriesRuleCreate) (ogen.CreateLiveTVSeriesRuleRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.CreateLiveTVSeriesRuleNotFound)(OgenNotFound("Live TV is not enabled")), nil
+	}
+	if err := h.checkLiveTVLibraryAccess(ctx, userID, req.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return (*ogen.CreateLiveTVSeriesRuleForbidden)(OgenForbidden("Access denied to this library")), nil
+		}
+		return nil, err
+	}
+
+	rule, err := h.liveTVService.CreateSeriesRule(ctx, userID, livetv.SeriesRuleRequest{
+		Title:         req.Title,
+		ChannelID:     optPtr(req.ChannelID),
+		NewOnly:       req.NewOnly.Or(false),
+		PaddingBefore: optSeconds(req.PaddingBeforeSeconds),
+		PaddingAfter:  optSeconds(req.PaddingAfterSeconds),
+		LibraryID:     optPtr(req.LibraryID),
+	})
+	switch {
+	case errors.Is(err, livetv.ErrChannelNotFound):
+		return (*ogen.CreateLiveTVSeriesRuleNotFound)(OgenNotFound("Channel not found")), nil
+	case errors.Is(err, livetv.ErrInvalidRecording), errors.Is(err, livetv.ErrInvalidLibrary),
+		errors.Is(err, livetv.ErrNoLibrary), errors.Is(err, livetv.ErrNoRecordingDirectory):
+		return (*ogen.CreateLiveTVSeriesRuleBadRequest)(OgenBadRequest(err.Error())), nil
+	case errors.Is(err, library.ErrNotFound):
+		return (*ogen.CreateLiveTVSeriesRuleBadRequest)(OgenBadRequest("Library not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	if h.riverClient != nil {
+		if _, err := h.riverClient.Insert(ctx, livetvjobs.ScheduleArgs{}, nil); err != nil {
+			h.logger.Warn("failed to enqueue series rule scheduling", slog.Any("error", err))
+		}
+	}
+
+	result := liveTVSeriesRuleToOgen(rule)
+	return &result, nil
+}
+
+// DeleteLiveTVSeriesRule removes a series rule of the caller and cancels
+// its pending recordings.
+func (h *Handler) DeleteLiveTVSeriesRule(ctx context.Context, params ogen.DeleteLiveTVSeriesRuleParams) (ogen.DeleteLiveTVSeriesRuleRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.liveTVService == nil {
+		return (*ogen.DeleteLiveTVSeriesRuleNotFound)(OgenNotFound("Series rule not found")), nil
+	}
+
+	rule, err := h.liveTVService.GetSeriesRule(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, livetv.ErrSeriesRuleNotFound) {
+			return (*ogen.DeleteLiveTVSeriesRuleNotFound)(OgenNotFound("Series rule not found")), nil
+		}
+		return nil, err
+	}
+	if rule.UserID != userID && !h.isAdmin(ctx) {
+		return (*ogen.DeleteLiveTVSeriesRuleForbidden)(OgenForbidden("Access denied")), nil
+	}
+
+	if err := h.liveTVService.DeleteSeriesRule(ctx, rule.ID); err != nil {
+		if errors.Is(err, livetv.ErrSeriesRuleNotFound) {
+			return (*ogen.DeleteLiveTVSeriesRuleNotFound)(OgenNotFound("Series rule not found")), nil
+		}
+		return nil, err
+	}
+	return &ogen.DeleteLiveTVSeriesRuleNoContent{}, nil
+}
+
+// checkLiveTVLibraryAccess returns errLibraryDenied unless the caller can
+// access the requested library. Without a request the service picks the
+// library.
+func (h *Handler) checkLiveTVLibraryAccess(ctx context.Context, userID uuid.UUID, requested ogen.OptUUID) error {
+	libraryID, ok := requested.Get()
+	if !ok {
+		return nil
+	}
+	return h.checkLibraryAccess(ctx, userID, libraryID)
+}
+
+// queueLiveTVSourceRefresh queues a source refresh. Failures are logged;
+// the scheduled refresh catches up.
+func (h *Handler) queueLiveTVSourceRefresh(ctx context.Context, sourceID uuid.UUID) {
+	if h.riverClient == nil {
+		return
+	}
+	if _, err := h.riverClient.Insert(ctx, livetvjobs.SourceRefreshArgs{SourceID: sourceID}, nil); err != nil {
+		h.logger.Warn("failed to enqueue live TV source refresh",
+			slog.String("source_id", sourceID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// queueLiveTVRecording queues the capture of a recording for its start.
+// Failures are logged; the schedule job queues pending recordings again.
+func (h *Handler) queueLiveTVRecording(ctx context.Context, rec *livetv.Recording) {
+	if h.riverClient == nil {
+		return
+	}
+	job := livetvjobs.RecordJob(rec)
+	if _, err := h.riverClient.Insert(ctx, job.Args, job.InsertOpts); err != nil {
+		h.logger.Warn("failed to enqueue recording",
+			slog.String("recording_id", rec.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
@@ -0,0 +1,347 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/livetv"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/activity"
+	"github.com/lusoris/revenge/internal/service/rbac"
+)
+
+// liveTVMockService is a minimal mock for livetv.Service used by the live TV handler tests.
+type liveTVMockService struct {
+	livetv.Service
+	channels   map[uuid.UUID]*livetv.Channel
+	recordings map[uuid.UUID]*livetv.Recording
+	rules      map[uuid.UUID]*livetv.SeriesRule
+	deleted    []uuid.UUID
+}
+
+func (m *liveTVMockService) ListChannels(_ context.Context, includeDisabled bool) ([]livetv.Channel, error) {
+	var result []livetv.Channel
+	for _, ch := range m.channels {
+		if ch.Enabled || includeDisabled {
+			result = append(result, *ch)
+		}
+	}
+	return result, nil
+}
+
+func (m *liveTVMockService) GetChannel(_ context.Context, id uuid.UUID) (*livetv.Channel, error) {
+	if ch, ok := m.channels[id]; ok {
+		return ch, nil
+	}
+	return nil, livetv.ErrChannelNotFound
+}
+
+func (m *liveTVMockService) Guide(_ context.Context, start, end time.Time, channelID *uuid.UUID) ([]livetv.ChannelGuide, error) {
+	if !end.After(start) {
+		return nil, livetv.ErrInvalidGuideWindow
+	}
+	if channelID == nil {
+		return nil, nil
+	}
+	ch, ok := m.channels[*channelID]
+	if !ok {
+		return nil, livetv.ErrChannelNotFound
+	}
+	return []livetv.ChannelGuide{{Channel: *ch, Programs: []livetv.Program{{ID: uuid.New(), Title: "The Show", StartsAt: start, EndsAt: end}}}}, nil
+}
+
+func (m *liveTVMockService) AddSource(_ context.Context, params livetv.CreateSourceParams) (*livetv.Source, error) {
+	if params.Kind != livetv.SourceKindM3U && params.Kind != livetv.SourceKindXMLTV {
+		return nil, livetv.ErrInvalidSource
+	}
+	return &livetv.Source{ID: uuid.New(), Name: params.Name, Kind: params.Kind, Location: params.Location, Enabled: params.Enabled}, nil
+}
+
+func (m *liveTVMockService) ScheduleRecording(_ context.Context, userID uuid.UUID, req livetv.RecordingRequest) (*livetv.Recording, error) {
+	if req.ChannelID == nil {
+		return nil, livetv.ErrInvalidRecording
+	}
+	ch, ok := m.channels[*req.ChannelID]
+	if !ok {
+		return nil, livetv.ErrChannelNotFound
+	}
+	rec := &livetv.Recording{
+		ID:          uuid.New(),
+		UserID:      userID,
+		ChannelID:   &ch.ID,
+		ChannelName: ch.Name,
+		Title:       req.Title,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		Status:      livetv.RecordingStatusScheduled,
+	}
+	if req.PaddingAfter != nil {
+		rec.PaddingAfter = *req.PaddingAfter
+	}
+	return rec, nil
+}
+
+func (m *liveTVMockService) GetRecording(_ context.Context, id uuid.UUID) (*livetv.Recording, error) {
+	if rec, ok := m.recordings[id]; ok {
+		return rec, nil
+	}
+	return nil, livetv.ErrRecordingNotFound
+}
+
+func (m *liveTVMockService) DeleteRecording(_ context.Context, id uuid.UUID) error {
+	if m.recordings[id].Status == livetv.RecordingStatusRecording {
+		return livetv.ErrRecordingInProgress
+	}
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func (m *liveTVMockService) GetSeriesRule(_ context.Context, id uuid.UUID) (*livetv.SeriesRule, error) {
+	if rule, ok := m.rules[id]; ok {
+		return rule, nil
+	}
+	return nil, livetv.ErrSeriesRuleNotFound
+}
+
+func (m *liveTVMockService) DeleteSeriesRule(_ context.Context, id uuid.UUID) error {
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+// newLiveTVTestHandler returns a handler over an enabled and a disabled
+// channel, with an in-memory RBAC service granting adminID the admin role.
+func newLiveTVTestHandler(t *testing.T, adminID uuid.UUID) (*Handler, *liveTVMockService, *livetv.Channel, *livetv.Channel) {
+	t.Helper()
+
+	enforcer, err := casbin.NewSyncedEnforcer("../../config/casbin_model.conf")
+	require.NoError(t, err)
+	rbacService := rbac.NewService(enforcer, logging.NewTestLogger(), activity.NewNoopLogger())
+	require.NoError(t, rbacService.AssignRole(context.Background(), adminID, "admin"))
+
+	enabled := &livetv.Channel{ID: uuid.New(), Name: "WXYZ HD", StreamURL: "http://192.168.1.10:5004/auto/v5.1", Enabled: true}
+	disabled := &livetv.Channel{ID: uuid.New(), Name: "Shopping", StreamURL: "http://192.168.1.10:5004/auto/v9.1"}
+	svc := &liveTVMockService{
+		channels:   map[uuid.UUID]*livetv.Channel{enabled.ID: enabled, disabled.ID: disabled},
+		recordings: map[uuid.UUID]*livetv.Recording{},
+		rules:      map[uuid.UUID]*livetv.SeriesRule{},
+	}
+	handler := &Handler{logger: logging.NewTestLogger(), liveTVService: svc, rbacService: rbacService}
+	return handler, svc, enabled, disabled
+}
+
+func TestHandler_ListLiveTVChannels(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	handler, _, enabled, _ := newLiveTVTestHandler(t, adminID)
+
+	result, err := handler.ListLiveTVChannels(contextWithUserID(context.Background(), uuid.New()),
+		ogen.ListLiveTVChannelsParams{IncludeDisabled: ogen.NewOptBool(true)})
+	require.NoError(t, err)
+	channels := *result.(*ogen.ListLiveTVChannelsOKApplicationJSON)
+	require.Len(t, channels, 1, "users don't see disabled channels")
+	assert.Equal(t, enabled.ID, channels[0].ID)
+	assert.False(t, channels[0].StreamURL.IsSet(), "stream urls are admin only")
+
+	result, err = handler.ListLiveTVChannels(contextWithUserID(context.Background(), adminID),
+		ogen.ListLiveTVChannelsParams{IncludeDisabled: ogen.NewOptBool(true)})
+	require.NoError(t, err)
+	channels = *result.(*ogen.ListLiveTVChannelsOKApplicationJSON)
+	require.Len(t, channels, 2)
+	assert.True(t, channels[0].StreamURL.IsSet())
+}
+
+func TestHandler_GetLiveTVChannel_Disabled(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	handler, _, _, disabled := newLiveTVTestHandler(t, adminID)
+
+	result, err := handler.GetLiveTVChannel(contextWithUserID(context.Background(), uuid.New()), ogen.GetLiveTVChannelParams{ID: disabled.ID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetLiveTVChannelNotFound)
+	assert.True(t, ok, "expected *ogen.GetLiveTVChannelNotFound, got %T", result)
+
+	result, err = handler.GetLiveTVChannel(contextWithUserID(context.Background(), adminID), ogen.GetLiveTVChannelParams{ID: disabled.ID})
+	require.NoError(t, err)
+	ch, ok := result.(*ogen.LiveTVChannel)
+	require.True(t, ok, "expected *ogen.LiveTVChannel, got %T", result)
+	assert.Equal(t, disabled.StreamURL, ch.StreamURL.Or(""))
+}
+
+func TestHandler_GetLiveTVGuide(t *testing.T) {
+	t.Parallel()
+
+	handler, _, enabled, disabled := newLiveTVTestHandler(t, uuid.New())
+	ctx := contextWithUserID(context.Background(), uuid.New())
+	start := time.Date(2024, 6, 30, 20, 0, 0, 0, time.UTC)
+
+	result, err := handler.GetLiveTVGuide(ctx, ogen.GetLiveTVGuideParams{
+		Start:     ogen.NewOptDateTime(start),
+		ChannelID: ogen.NewOptUUID(enabled.ID),
+	})
+	require.NoError(t, err)
+	guide := *result.(*ogen.GetLiveTVGuideOKApplicationJSON)
+	require.Len(t, guide, 1)
+	require.Len(t, guide[0].Programs, 1)
+	assert.Equal(t, start.Add(defaultGuideWindow), guide[0].Programs[0].EndsAt, "the window defaults to six hours")
+
+	result, err = handler.GetLiveTVGuide(ctx, ogen.GetLiveTVGuideParams{
+		Start: ogen.NewOptDateTime(start),
+		End:   ogen.NewOptDateTime(start.Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetLiveTVGuideBadRequest)
+	assert.True(t, ok, "expected *ogen.GetLiveTVGuideBadRequest, got %T", result)
+
+	result, err = handler.GetLiveTVGuide(ctx, ogen.GetLiveTVGuideParams{ChannelID: ogen.NewOptUUID(disabled.ID)})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetLiveTVGuideNotFound)
+	assert.True(t, ok, "expected *ogen.GetLiveTVGuideNotFound, got %T", result)
+}
+
+func TestHandler_LiveTVSources_AdminOnly(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	handler, _, _, _ := newLiveTVTestHandler(t, adminID)
+
+	result, err := handler.ListLiveTVSources(context.Background())
+	require.NoError(t, err)
+	_, ok := result.(*ogen.ListLiveTVSourcesUnauthorized)
+	assert.True(t, ok, "expected *ogen.ListLiveTVSourcesUnauthorized, got %T", result)
+
+	created, err := handler.CreateLiveTVSource(contextWithUserID(context.Background(), uuid.New()),
+		&ogen.LiveTVSourceCreate{Kind: ogen.LiveTVSourceKindM3u, Location: "http://192.168.1.10/lineup.m3u"})
+	require.NoError(t, err)
+	_, ok = created.(*ogen.CreateLiveTVSourceForbidden)
+	assert.True(t, ok, "expected *ogen.CreateLiveTVSourceForbidden, got %T", created)
+
+	adminCtx := contextWithUserID(context.Background(), adminID)
+	created, err = handler.CreateLiveTVSource(adminCtx,
+		&ogen.LiveTVSourceCreate{Kind: ogen.LiveTVSourceKindM3u, Location: "http://192.168.1.10/lineup.m3u"})
+	require.NoError(t, err)
+	src, ok := created.(*ogen.LiveTVSource)
+	require.True(t, ok, "expected *ogen.LiveTVSource, got %T", created)
+	assert.True(t, src.Enabled, "sources are enabled by default")
+
+	created, err = handler.CreateLiveTVSource(adminCtx,
+		&ogen.LiveTVSourceCreate{Kind: "hdhr", Location: "http://192.168.1.10/lineup.m3u"})
+	require.NoError(t, err)
+	_, ok = created.(*ogen.CreateLiveTVSourceBadRequest)
+	assert.True(t, ok, "expected *ogen.CreateLiveTVSourceBadRequest, got %T", created)
+}
+
+func TestHandler_CreateLiveTVRecording(t *testing.T) {
+	t.Parallel()
+
+	handler, _, enabled, _ := newLiveTVTestHandler(t, uuid.New())
+	userID := uuid.New()
+	ctx := contextWithUserID(context.Background(), userID)
+	start := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	result, err := handler.CreateLiveTVRecording(ctx, &ogen.LiveTVRecordingCreate{
+		ChannelID:           ogen.NewOptUUID(enabled.ID),
+		Title:               ogen.NewOptString("Evening News"),
+		StartsAt:            ogen.NewOptDateTime(start),
+		EndsAt:              ogen.NewOptDateTime(start.Add(30 * time.Minute)),
+		PaddingAfterSeconds: ogen.NewOptInt(120),
+	})
+	require.NoError(t, err)
+	rec, ok := result.(*ogen.LiveTVRecording)
+	require.True(t, ok, "expected *ogen.LiveTVRecording, got %T", result)
+	assert.Equal(t, "WXYZ HD", rec.ChannelName)
+	assert.Equal(t, 120, rec.PaddingAfterSeconds)
+	assert.Equal(t, ogen.LiveTVRecordingStatusScheduled, rec.Status)
+	assert.False(t, rec.FileSize.IsSet())
+
+	result, err = handler.CreateLiveTVRecording(ctx, &ogen.LiveTVRecordingCreate{Title: ogen.NewOptString("News")})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.CreateLiveTVRecordingBadRequest)
+	assert.True(t, ok, "expected *ogen.CreateLiveTVRecordingBadRequest, got %T", result)
+
+	result, err = handler.CreateLiveTVRecording(ctx, &ogen.LiveTVRecordingCreate{ChannelID: ogen.NewOptUUID(uuid.New())})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.CreateLiveTVRecordingNotFound)
+	assert.True(t, ok, "expected *ogen.CreateLiveTVRecordingNotFound, got %T", result)
+}
+
+func TestHandler_DeleteLiveTVRecording(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	handler, svc, _, _ := newLiveTVTestHandler(t, adminID)
+	owner := uuid.New()
+	scheduled := &livetv.Recording{ID: uuid.New(), UserID: owner, Status: livetv.RecordingStatusScheduled}
+	running := &livetv.Recording{ID: uuid.New(), UserID: owner, Status: livetv.RecordingStatusRecording}
+	svc.recordings[scheduled.ID] = scheduled
+	svc.recordings[running.ID] = running
+
+	result, err := handler.DeleteLiveTVRecording(contextWithUserID(context.Background(), uuid.New()),
+		ogen.DeleteLiveTVRecordingParams{ID: scheduled.ID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.DeleteLiveTVRecordingForbidden)
+	assert.True(t, ok, "expected *ogen.DeleteLiveTVRecordingForbidden, got %T", result)
+
+	result, err = handler.DeleteLiveTVRecording(contextWithUserID(context.Background(), owner),
+		ogen.DeleteLiveTVRecordingParams{ID: running.ID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.DeleteLiveTVRecordingConflict)
+	assert.True(t, ok, "expected *ogen.DeleteLiveTVRecordingConflict, got %T", result)
+
+	result, err = handler.DeleteLiveTVRecording(contextWithUserID(context.Background(), adminID),
+		ogen.DeleteLiveTVRecordingParams{ID: scheduled.ID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.DeleteLiveTVRecordingNoContent)
+	assert.True(t, ok, "expected *ogen.DeleteLiveTVRecordingNoContent, got %T", result)
+	assert.Equal(t, []uuid.UUID{scheduled.ID}, svc.deleted)
+}
+
+func TestHandler_DeleteLiveTVSeriesRule(t *testing.T) {
+	t.Parallel()
+
+	handler, svc, _, _ := newLiveTVTestHandler(t, uuid.New())
+	owner := uuid.New()
+	rule := &livetv.SeriesRule{ID: uuid.New(), UserID: owner, Title: "The Show"}
+	svc.rules[rule.ID] = rule
+
+	result, err := handler.DeleteLiveTVSeriesRule(contextWithUserID(context.Background(), uuid.New()),
+		ogen.DeleteLiveTVSeriesRuleParams{ID: rule.ID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.DeleteLiveTVSeriesRuleForbidden)
+	assert.True(t, ok, "expected *ogen.DeleteLiveTVSeriesRuleForbidden, got %T", result)
+
+	result, err = handler.DeleteLiveTVSeriesRule(contextWithUserID(context.Background(), owner),
+		ogen.DeleteLiveTVSeriesRuleParams{ID: rule.ID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.DeleteLiveTVSeriesRuleNoContent)
+	assert.True(t, ok, "expected *ogen.DeleteLiveTVSeriesRuleNoContent, got %T", result)
+	assert.Equal(t, []uuid.UUID{rule.ID}, svc.deleted)
+}
+
+func TestHandler_LiveTV_Disabled(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	channels, err := handler.ListLiveTVChannels(ctx, ogen.ListLiveTVChannelsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, *channels.(*ogen.ListLiveTVChannelsOKApplicationJSON))
+
+	recordings, err := handler.ListLiveTVRecordings(ctx, ogen.ListLiveTVRecordingsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, recordings.(*ogen.LiveTVRecordingListResponse).Items)
+
+	result, err := handler.CreateLiveTVRecording(ctx, &ogen.LiveTVRecordingCreate{})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.CreateLiveTVRecordingNotFound)
+	assert.True(t, ok, "expected *ogen.CreateLiveTVRecordingNotFound, got %T", result)
+}
@@ -5,8 +5,10 @@ import (
 	"strings"
 
 	"github.com/lusoris/revenge/internal/api/middleware"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
 	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/service/search"
 )
 
 // GetMetadataLanguage returns the user's preferred metadata language.
@@ -31,6 +33,21 @@ func (h *Handler) GetMetadataLanguage(ctx context.Context) string {
 	return "en"
 }
 
+// GetMetadataLanguageChain returns the translation fallback chain used to
+// localize titles, overviews and taglines for the current user. Users without
+// a configured chain fall back to their metadata language, then English, then
+// the original language.
+func (h *Handler) GetMetadataLanguageChain(ctx context.Context) content.LanguageChain {
+	if userID, err := GetUserID(ctx); err == nil {
+		prefs, err := h.userService.GetUserPreferences(ctx, userID)
+		if err == nil && len(prefs.MetadataLanguageChain) > 0 {
+			return prefs.MetadataLanguageChain
+		}
+	}
+
+	return content.DefaultLanguageChain(h.GetMetadataLanguage(ctx))
+}
+
 // parseAcceptLanguage parses Accept-Language header and returns best match.
 // Example: "de-DE,de;q=0.9,en-US;q=0.8,en;q=0.7" → "de"
 func parseAcceptLanguage(header string) string {
@@ -60,20 +77,20 @@ func parseAcceptLanguage(header string) string {
 	return firstLang
 }
 
-// LocalizeMovie returns a localized copy of the movie with fields in the preferred language.
-func LocalizeMovie(m *movie.Movie, lang string) *movie.Movie {
+// LocalizeMovie returns a copy of the movie with fields resolved along the language chain.
+func LocalizeMovie(m *movie.Movie, chain content.LanguageChain) *movie.Movie {
 	if m == nil {
 		return nil
 	}
 
 	localized := *m
-	localized.Title = m.GetTitle(lang)
+	localized.Title = m.TitleIn(chain)
 
-	if overview := m.GetOverview(lang); overview != "" {
+	if overview := m.OverviewIn(chain); overview != "" {
 		localized.Overview = &overview
 	}
 
-	if tagline := m.GetTagline(lang); tagline != "" {
+	if tagline := m.TaglineIn(chain); tagline != "" {
 		localized.Tagline = &tagline
 	}
 
@@ -81,28 +98,28 @@ func LocalizeMovie(m *movie.Movie, lang string) *movie.Movie {
 }
 
 // LocalizeMovies localizes a slice of movies.
-func LocalizeMovies(movies []movie.Movie, lang string) []movie.Movie {
+func LocalizeMovies(movies []movie.Movie, chain content.LanguageChain) []movie.Movie {
 	localized := make([]movie.Movie, len(movies))
 	for i := range movies {
-		localized[i] = *LocalizeMovie(&movies[i], lang)
+		localized[i] = *LocalizeMovie(&movies[i], chain)
 	}
 	return localized
 }
 
-// LocalizeSeries returns a localized copy of the series with fields in the preferred language.
-func LocalizeSeries(s *tvshow.Series, lang string) *tvshow.Series {
+// LocalizeSeries returns a copy of the series with fields resolved along the language chain.
+func LocalizeSeries(s *tvshow.Series, chain content.LanguageChain) *tvshow.Series {
 	if s == nil {
 		return nil
 	}
 
 	localized := *s
-	localized.Title = s.GetTitle(lang)
+	localized.Title = s.TitleIn(chain)
 
-	if overview := s.GetOverview(lang); overview != "" {
+	if overview := s.OverviewIn(chain); overview != "" {
 		localized.Overview = &overview
 	}
 
-	if tagline := s.GetTagline(lang); tagline != "" {
+	if tagline := s.TaglineIn(chain); tagline != "" {
 		localized.Tagline = &tagline
 	}
 
@@ -110,90 +127,129 @@ func LocalizeSeries(s *tvshow.Series, lang string) *tvshow.Series {
 }
 
 // LocalizeSeriesList localizes a slice of series.
-func LocalizeSeriesList(series []tvshow.Series, lang string) []tvshow.Series {
+func LocalizeSeriesList(series []tvshow.Series, chain content.LanguageChain) []tvshow.Series {
 	localized := make([]tvshow.Series, len(series))
 	for i := range series {
-		localized[i] = *LocalizeSeries(&series[i], lang)
+		localized[i] = *LocalizeSeries(&series[i], chain)
 	}
 	return localized
 }
 
-// LocalizeContinueWatchingItem localizes a continue watching item.
-func LocalizeContinueWatchingItem(item *movie.ContinueWatchingItem, lang string) *movie.ContinueWatchingItem {
-	if item == nil {
+// LocalizeSeason returns a copy of the season with fields resolved along the language chain.
+func LocalizeSeason(s *tvshow.Season, chain content.LanguageChain) *tvshow.Season {
+	if s == nil {
 		return nil
 	}
 
-	localized := *item
+	localized := *s
+	localized.Name = s.NameIn(chain)
 
-	tempMovie := movie.Movie{
-		Title:         item.Title,
-		Overview:      item.Overview,
-		Tagline:       item.Tagline,
-		OriginalTitle: item.OriginalTitle,
-		TitlesI18n:    item.TitlesI18n,
-		TaglinesI18n:  item.TaglinesI18n,
-		OverviewsI18n: item.OverviewsI18n,
+	if overview := s.OverviewIn(chain); overview != "" {
+		localized.Overview = &overview
 	}
 
-	localized.Title = tempMovie.GetTitle(lang)
+	return &localized
+}
 
-	if overview := tempMovie.GetOverview(lang); overview != "" {
-		localized.Overview = &overview
+// LocalizeSeasons localizes a slice of seasons.
+func LocalizeSeasons(seasons []tvshow.Season, chain content.LanguageChain) []tvshow.Season {
+	localized := make([]tvshow.Season, len(seasons))
+	for i := range seasons {
+		localized[i] = *LocalizeSeason(&seasons[i], chain)
 	}
+	return localized
+}
 
-	if tagline := tempMovie.GetTagline(lang); tagline != "" {
-		localized.Tagline = &tagline
+// LocalizeEpisode returns a copy of the episode with fields resolved along the language chain.
+func LocalizeEpisode(e *tvshow.Episode, chain content.LanguageChain) *tvshow.Episode {
+	if e == nil {
+		return nil
+	}
+
+	localized := *e
+	localized.Title = e.TitleIn(chain)
+
+	if overview := e.OverviewIn(chain); overview != "" {
+		localized.Overview = &overview
 	}
 
 	return &localized
 }
 
-// LocalizeContinueWatchingItems localizes a slice of continue watching items.
-func LocalizeContinueWatchingItems(items []movie.ContinueWatchingItem, lang string) []movie.ContinueWatchingItem {
-	localized := make([]movie.ContinueWatchingItem, len(items))
-	for i := range items {
-		localized[i] = *LocalizeContinueWatchingItem(&items[i], lang)
+// LocalizeEpisodes localizes a slice of episodes.
+func LocalizeEpisodes(episodes []tvshow.Episode, chain content.LanguageChain) []tvshow.Episode {
+	localized := make([]tvshow.Episode, len(episodes))
+	for i := range episodes {
+		localized[i] = *LocalizeEpisode(&episodes[i], chain)
 	}
 	return localized
 }
 
-// LocalizeWatchedMovieItem localizes a watched movie item.
-func LocalizeWatchedMovieItem(item *movie.WatchedMovieItem, lang string) *movie.WatchedMovieItem {
+// LocalizeContinueWatchingItem localizes a continue watching item.
+func LocalizeContinueWatchingItem(item *movie.ContinueWatchingItem, chain content.LanguageChain) *movie.ContinueWatchingItem {
 	if item == nil {
 		return nil
 	}
 
 	localized := *item
+	localized.Movie = *LocalizeMovie(&item.Movie, chain)
 
-	tempMovie := movie.Movie{
-		Title:         item.Title,
-		Overview:      item.Overview,
-		Tagline:       item.Tagline,
-		OriginalTitle: item.OriginalTitle,
-		TitlesI18n:    item.TitlesI18n,
-		TaglinesI18n:  item.TaglinesI18n,
-		OverviewsI18n: item.OverviewsI18n,
-	}
-
-	localized.Title = tempMovie.GetTitle(lang)
+	return &localized
+}
 
-	if overview := tempMovie.GetOverview(lang); overview != "" {
-		localized.Overview = &overview
+// LocalizeContinueWatchingItems localizes a slice of continue watching items.
+func LocalizeContinueWatchingItems(items []movie.ContinueWatchingItem, chain content.LanguageChain) []movie.ContinueWatchingItem {
+	localized := make([]movie.ContinueWatchingItem, len(items))
+	for i := range items {
+		localized[i] = *LocalizeContinueWatchingItem(&items[i], chain)
 	}
+	return localized
+}
 
-	if tagline := tempMovie.GetTagline(lang); tagline != "" {
-		localized.Tagline = &tagline
+// LocalizeWatchedMovieItem localizes a watched movie item.
+func LocalizeWatchedMovieItem(item *movie.WatchedMovieItem, chain content.LanguageChain) *movie.WatchedMovieItem {
+	if item == nil {
+		return nil
 	}
 
+	localized := *item
+	localized.Movie = *LocalizeMovie(&item.Movie, chain)
+
 	return &localized
 }
 
 // LocalizeWatchedMovieItems localizes a slice of watched movie items.
-func LocalizeWatchedMovieItems(items []movie.WatchedMovieItem, lang string) []movie.WatchedMovieItem {
+func LocalizeWatchedMovieItems(items []movie.WatchedMovieItem, chain content.LanguageChain) []movie.WatchedMovieItem {
 	localized := make([]movie.WatchedMovieItem, len(items))
 	for i := range items {
-		localized[i] = *LocalizeWatchedMovieItem(&items[i], lang)
+		localized[i] = *LocalizeWatchedMovieItem(&items[i], chain)
 	}
 	return localized
 }
+
+// LocalizeMovieDocument returns a copy of a movie search document with title,
+// overview and tagline resolved along the language chain.
+func LocalizeMovieDocument(doc search.MovieDocument, chain content.LanguageChain) search.MovieDocument {
+	if title, ok := chain.ResolveTitle(doc.TitlesI18n, doc.OriginalLanguage, doc.OriginalTitle); ok {
+		doc.Title = title
+	}
+	if overview, ok := chain.Resolve(doc.OverviewsI18n, doc.OriginalLanguage); ok {
+		doc.Overview = overview
+	}
+	if tagline, ok := chain.Resolve(doc.TaglinesI18n, doc.OriginalLanguage); ok {
+		doc.Tagline = tagline
+	}
+	return doc
+}
+
+// LocalizeTVShowDocument returns a copy of a TV show search document with
+// title and overview resolved along the language chain.
+func LocalizeTVShowDocument(doc search.TVShowDocument, chain content.LanguageChain) search.TVShowDocument {
+	if title, ok := chain.ResolveTitle(doc.TitlesI18n, doc.OriginalLanguage, doc.OriginalTitle); ok {
+		doc.Title = title
+	}
+	if overview, ok := chain.Resolve(doc.OverviewsI18n, doc.OriginalLanguage); ok {
+		doc.Overview = overview
+	}
+	return doc
+}
@@ -1,9 +1,19 @@
 package api
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/activity"
+	"github.com/lusoris/revenge/internal/service/search"
+	"github.com/lusoris/revenge/internal/service/user"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -89,7 +99,7 @@ func TestLocalizeMovie(t *testing.T) {
 			},
 		}
 
-		localized := LocalizeMovie(m, "de")
+		localized := LocalizeMovie(m, content.DefaultLanguageChain("de"))
 
 		require.NotNil(t, localized)
 		assert.Equal(t, "Deutscher Titel", localized.Title)
@@ -118,7 +128,7 @@ func TestLocalizeMovie(t *testing.T) {
 			},
 		}
 
-		localized := LocalizeMovie(m, "ja") // Japanese not available
+		localized := LocalizeMovie(m, content.DefaultLanguageChain("ja")) // Japanese not available
 
 		require.NotNil(t, localized)
 		assert.Equal(t, "English Title", localized.Title) // Falls back to English
@@ -137,14 +147,14 @@ func TestLocalizeMovie(t *testing.T) {
 			},
 		}
 
-		localized := LocalizeMovie(m, "ja")
+		localized := LocalizeMovie(m, content.DefaultLanguageChain("ja"))
 
 		require.NotNil(t, localized)
 		assert.Equal(t, "Originaltitel", localized.Title)
 	})
 
 	t.Run("returns original movie when nil", func(t *testing.T) {
-		result := LocalizeMovie(nil, "en")
+		result := LocalizeMovie(nil, content.DefaultLanguageChain("en"))
 		assert.Nil(t, result)
 	})
 
@@ -156,7 +166,7 @@ func TestLocalizeMovie(t *testing.T) {
 			OriginalTitle: &originalTitle,
 		}
 
-		localized := LocalizeMovie(m, "de")
+		localized := LocalizeMovie(m, content.DefaultLanguageChain("de"))
 
 		require.NotNil(t, localized)
 		// Falls back through chain: TitlesI18n[de] → TitlesI18n[en] → OriginalTitle → Title
@@ -205,7 +215,7 @@ func TestLocalizeMovies(t *testing.T) {
 		},
 	}
 
-	localized := LocalizeMovies(movies, "de")
+	localized := LocalizeMovies(movies, content.DefaultLanguageChain("de"))
 
 	require.Len(t, localized, 2)
 	assert.Equal(t, "Film 1", localized[0].Title)
@@ -245,7 +255,7 @@ func TestLocalizeContinueWatchingItem(t *testing.T) {
 			DurationSeconds: 7200,
 		}
 
-		localized := LocalizeContinueWatchingItem(item, "de")
+		localized := LocalizeContinueWatchingItem(item, content.DefaultLanguageChain("de"))
 
 		require.NotNil(t, localized)
 		assert.Equal(t, "Deutscher Titel", localized.Title)
@@ -256,7 +266,7 @@ func TestLocalizeContinueWatchingItem(t *testing.T) {
 	})
 
 	t.Run("returns nil when item is nil", func(t *testing.T) {
-		result := LocalizeContinueWatchingItem(nil, "en")
+		result := LocalizeContinueWatchingItem(nil, content.DefaultLanguageChain("en"))
 		assert.Nil(t, result)
 	})
 }
@@ -291,7 +301,7 @@ func TestLocalizeWatchedMovieItem(t *testing.T) {
 			WatchCount: 3,
 		}
 
-		localized := LocalizeWatchedMovieItem(item, "fr")
+		localized := LocalizeWatchedMovieItem(item, content.DefaultLanguageChain("fr"))
 
 		require.NotNil(t, localized)
 		assert.Equal(t, "Titre Français", localized.Title)
@@ -301,7 +311,72 @@ func TestLocalizeWatchedMovieItem(t *testing.T) {
 	})
 
 	t.Run("returns nil when item is nil", func(t *testing.T) {
-		result := LocalizeWatchedMovieItem(nil, "en")
+		result := LocalizeWatchedMovieItem(nil, content.DefaultLanguageChain("en"))
 		assert.Nil(t, result)
 	})
 }
+
+func TestLocalizeSeasonAndEpisode(t *testing.T) {
+	chain := content.LanguageChain{"de-AT", "de", content.LanguageOriginal, "en"}
+
+	season := &tvshow.Season{
+		Name:      "Season 1",
+		NamesI18n: map[string]string{"en": "Season 1", "de": "Staffel 1"},
+	}
+	localizedSeason := LocalizeSeason(season, chain)
+	assert.Equal(t, "Staffel 1", localizedSeason.Name)
+	assert.Equal(t, "Season 1", season.Name)
+
+	episode := &tvshow.Episode{
+		Title:         "Pilot",
+		TitlesI18n:    map[string]string{"en": "Pilot"},
+		OverviewsI18n: map[string]string{"de-AT": "Es geht los.", "de": "Es beginnt."},
+	}
+	localizedEpisode := LocalizeEpisode(episode, chain)
+	assert.Equal(t, "Pilot", localizedEpisode.Title)
+	require.NotNil(t, localizedEpisode.Overview)
+	assert.Equal(t, "Es geht los.", *localizedEpisode.Overview)
+
+	assert.Nil(t, LocalizeSeason(nil, chain))
+	assert.Nil(t, LocalizeEpisode(nil, chain))
+	assert.Len(t, LocalizeEpisodes([]tvshow.Episode{*episode}, chain), 1)
+}
+
+func TestHandler_GetMetadataLanguageChain(t *testing.T) {
+	userID := uuid.Must(uuid.NewV7())
+	prefs := &db.SharedUserPreference{UserID: userID, MetadataLanguage: new("fr")}
+	handler := &Handler{
+		logger: logging.NewTestLogger(),
+		userService: user.NewCachedService(
+			user.NewService(nil, &stubPreferencesRepository{prefs: prefs}, activity.NewNoopLogger(), nil, config.AvatarConfig{}),
+			nil, logging.NewTestLogger()),
+	}
+	ctx := WithUserID(context.Background(), userID)
+
+	// Without a chain the single metadata language gets the implicit fallbacks.
+	assert.Equal(t, content.LanguageChain{"fr", "en", content.LanguageOriginal}, handler.GetMetadataLanguageChain(ctx))
+
+	prefs.MetadataLanguageChain = []string{"de-AT", "de", content.LanguageOriginal, "en"}
+	assert.Equal(t, content.LanguageChain{"de-AT", "de", content.LanguageOriginal, "en"}, handler.GetMetadataLanguageChain(ctx))
+}
+
+func TestLocalizeSearchDocuments(t *testing.T) {
+	chain := content.LanguageChain{"de-AT", "de", content.LanguageOriginal, "en"}
+
+	movieDoc := LocalizeMovieDocument(search.MovieDocument{
+		Title:            "Spirited Away",
+		OriginalTitle:    "千と千尋の神隠し",
+		OriginalLanguage: "ja",
+		Overview:         "A girl wanders into a world of spirits.",
+		TitlesI18n:       map[string]string{"en": "Spirited Away"},
+		OverviewsI18n:    map[string]string{"de": "Ein Mädchen gerät in eine Geisterwelt."},
+	}, chain)
+	assert.Equal(t, "千と千尋の神隠し", movieDoc.Title)
+	assert.Equal(t, "Ein Mädchen gerät in eine Geisterwelt.", movieDoc.Overview)
+
+	showDoc := LocalizeTVShowDocument(search.TVShowDocument{
+		Title:      "Dark",
+		TitlesI18n: map[string]string{"de": "Dark"},
+	}, content.LanguageChain{"fr"})
+	assert.Equal(t, "Dark", showDoc.Title)
+}
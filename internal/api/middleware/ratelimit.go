@@ -62,6 +62,9 @@ func AuthRateLimitConfig() RateLimitConfig {
 			"VerifyEmail",
 			"BeginWebAuthnLogin",
 			"FinishWebAuthnLogin",
+			"UnlockParentalControls",
+			"UnlockLegacy",
+			"SetLegacyPIN",
 		},
 		CleanupInterval: 5 * time.Minute,
 		TTL:             10 * time.Minute,
@@ -65,6 +65,9 @@ func AuthRedisRateLimiterConfig() RedisRateLimiterConfig {
 			"VerifyEmail",
 			"BeginWebAuthnLogin",
 			"FinishWebAuthnLogin",
+			"UnlockParentalControls",
+			"UnlockLegacy",
+			"SetLegacyPIN",
 		},
 		KeyPrefix: "ratelimit:auth:",
 	}
@@ -55,6 +55,9 @@ func TestRedisRateLimiter_AuthConfig(t *testing.T) {
 	assert.Contains(t, config.Operations, "VerifyEmail")
 	assert.Contains(t, config.Operations, "BeginWebAuthnLogin")
 	assert.Contains(t, config.Operations, "FinishWebAuthnLogin")
+	assert.Contains(t, config.Operations, "UnlockParentalControls")
+	assert.Contains(t, config.Operations, "UnlockLegacy")
+	assert.Contains(t, config.Operations, "SetLegacyPIN")
 }
 
 func TestRedisRateLimiter_ShouldLimit(t *testing.T) {
@@ -48,6 +48,9 @@ func TestRateLimiter_AuthConfig(t *testing.T) {
 	assert.Contains(t, config.Operations, "VerifyEmail")
 	assert.Contains(t, config.Operations, "BeginWebAuthnLogin")
 	assert.Contains(t, config.Operations, "FinishWebAuthnLogin")
+	assert.Contains(t, config.Operations, "UnlockParentalControls")
+	assert.Contains(t, config.Operations, "UnlockLegacy")
+	assert.Contains(t, config.Operations, "SetLegacyPIN")
 }
 
 func TestRateLimiter_ShouldLimit(t *testing.T) {
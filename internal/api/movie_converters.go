@@ -4,8 +4,22 @@ import (
 	"github.com/lusoris/revenge/internal/api/ogen"
 	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
+// imagePlaceholderToOgen converts an image placeholder to ogen format.
+// Returns nil when no placeholder has been computed, for use with setOpt.
+func imagePlaceholderToOgen(ph *blurhash.Placeholder) *ogen.ImagePlaceholder {
+	if ph == nil {
+		return nil
+	}
+	o := &ogen.ImagePlaceholder{Blurhash: ph.BlurHash}
+	if ph.Color != "" {
+		o.Color = ogen.NewOptString(ph.Color)
+	}
+	return o
+}
+
 // externalRatingsToOgen converts domain external ratings to ogen format.
 // Works with both movie.ExternalRating and tvshow.ExternalRating (both are
 // type aliases for content.ExternalRating).
@@ -39,6 +53,7 @@ func movieToOgen(m *movie.Movie) *ogen.Movie {
 	}
 	setOpt(&o.ImdbID, m.IMDbID)
 	setOpt(&o.OriginalTitle, m.OriginalTitle)
+	setOpt(&o.SortTitle, m.SortTitle)
 	setOptConv(&o.Year, m.Year, int32ToInt)
 	setOpt(&o.ReleaseDate, m.ReleaseDate)
 	setOptConv(&o.Runtime, m.Runtime, int32ToInt)
@@ -48,6 +63,8 @@ func movieToOgen(m *movie.Movie) *ogen.Movie {
 	setOpt(&o.OriginalLanguage, m.OriginalLanguage)
 	setOpt(&o.PosterPath, m.PosterPath)
 	setOpt(&o.BackdropPath, m.BackdropPath)
+	setOpt(&o.PosterPlaceholder, imagePlaceholderToOgen(m.ImagePlaceholders.For(m.PosterPath)))
+	setOpt(&o.BackdropPlaceholder, imagePlaceholderToOgen(m.ImagePlaceholders.For(m.BackdropPath)))
 	setOpt(&o.TrailerURL, m.TrailerURL)
 	setOptDecimalFloat32(&o.VoteAverage, m.VoteAverage)
 	setOptConv(&o.VoteCount, m.VoteCount, int32ToInt)
@@ -62,6 +79,10 @@ func movieToOgen(m *movie.Movie) *ogen.Movie {
 	setOptConv(&o.RadarrID, m.RadarrID, int32ToInt)
 
 	o.ExternalRatings = externalRatingsToOgen(m.ExternalRatings)
+	o.LockedFields = m.LockedFields
+	if len(m.MetadataSources) > 0 {
+		o.MetadataSources.SetTo(ogen.MovieMetadataSources(m.MetadataSources))
+	}
 
 	return o
 }
@@ -108,10 +129,41 @@ func movieFileToOgen(f *movie.MovieFile) *ogen.MovieFile {
 	setOptConv(&o.RadarrFileID, f.RadarrFileID, int32ToInt)
 	setOpt(&o.LastScannedAt, f.LastScannedAt)
 	setOpt(&o.IsMonitored, f.IsMonitored)
+	o.MatchLocked = ogen.NewOptBool(f.MatchLocked)
+	setOpt(&o.Edition, f.Edition)
 
 	return o
 }
 
+// movieEditionToOgen converts a movie edition domain type to ogen MovieEdition.
+func movieEditionToOgen(e *movie.Edition) ogen.MovieEdition {
+	o := ogen.MovieEdition{
+		Files: make([]ogen.MovieFile, len(e.Files)),
+	}
+	if e.Name != "" {
+		o.Name.SetTo(e.Name)
+	}
+	for i := range e.Files {
+		o.Files[i] = *movieFileToOgen(&e.Files[i])
+	}
+	return o
+}
+
+// movieExtraToOgen converts a movie extra domain type to ogen MovieExtra.
+func movieExtraToOgen(e *movie.MovieExtra) ogen.MovieExtra {
+	o := ogen.MovieExtra{
+		ID:        e.ID,
+		MovieID:   e.MovieID,
+		ExtraType: ogen.MovieExtraExtraType(e.ExtraType),
+		Title:     e.Title,
+		FileSize:  ogen.NewOptInt64(e.FileSize),
+		CreatedAt: ogen.NewOptDateTime(e.CreatedAt),
+	}
+	setOpt(&o.Container, e.Container)
+	setOptConv(&o.DurationSeconds, e.DurationSeconds, int32ToInt)
+	return o
+}
+
 // movieCreditToOgen converts a movie credit domain type to ogen MovieCredit.
 func movieCreditToOgen(c *movie.MovieCredit) *ogen.MovieCredit {
 	o := &ogen.MovieCredit{
@@ -129,6 +181,7 @@ func movieCreditToOgen(c *movie.MovieCredit) *ogen.MovieCredit {
 	setOpt(&o.Department, c.Department)
 	setOptConv(&o.CastOrder, c.CastOrder, int32ToInt)
 	setOpt(&o.ProfilePath, c.ProfilePath)
+	setOpt(&o.PersonID, c.PersonID)
 
 	return o
 }
@@ -207,6 +260,8 @@ func continueWatchingItemToOgen(item *movie.ContinueWatchingItem) ogen.ContinueW
 	setOpt(&o.OriginalLanguage, item.OriginalLanguage)
 	setOpt(&o.PosterPath, item.PosterPath)
 	setOpt(&o.BackdropPath, item.BackdropPath)
+	setOpt(&o.PosterPlaceholder, imagePlaceholderToOgen(item.ImagePlaceholders.For(item.PosterPath)))
+	setOpt(&o.BackdropPlaceholder, imagePlaceholderToOgen(item.ImagePlaceholders.For(item.BackdropPath)))
 	setOpt(&o.TrailerURL, item.TrailerURL)
 	setOptDecimalFloat32(&o.VoteAverage, item.VoteAverage)
 	setOptConv(&o.VoteCount, item.VoteCount, int32ToInt)
@@ -251,6 +306,8 @@ func watchedMovieItemToOgen(item *movie.WatchedMovieItem) ogen.WatchedMovieItem
 	setOpt(&o.OriginalLanguage, item.OriginalLanguage)
 	setOpt(&o.PosterPath, item.PosterPath)
 	setOpt(&o.BackdropPath, item.BackdropPath)
+	setOpt(&o.PosterPlaceholder, imagePlaceholderToOgen(item.ImagePlaceholders.For(item.PosterPath)))
+	setOpt(&o.BackdropPlaceholder, imagePlaceholderToOgen(item.ImagePlaceholders.For(item.BackdropPath)))
 	setOpt(&o.TrailerURL, item.TrailerURL)
 	setOptDecimalFloat32(&o.VoteAverage, item.VoteAverage)
 	setOptConv(&o.VoteCount, item.VoteCount, int32ToInt)
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/lusoris/revenge/internal/api/ogen"
 	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
 )
@@ -81,6 +82,10 @@ func TestMovieToOgen(t *testing.T) {
 			ExternalRatings: []movie.ExternalRating{
 				{Source: "IMDb", Value: "8.8/10", Score: 88.0},
 			},
+			ImagePlaceholders: content.ImagePlaceholders{
+				"/poster.jpg": {BlurHash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj", Color: "#1a2b3c"},
+				"/old.jpg":    {BlurHash: "L00000fQfQfQfQfQfQfQfQfQfQfQ"},
+			},
 		}
 
 		o := movieToOgen(m)
@@ -108,6 +113,9 @@ func TestMovieToOgen(t *testing.T) {
 		assert.True(t, o.MetadataUpdatedAt.Set)
 		assert.True(t, o.RadarrID.Set)
 		require.Len(t, o.ExternalRatings, 1)
+		assert.Equal(t, "LEHV6nWB2yk8pyo0adR*.7kCMdnj", o.PosterPlaceholder.Value.Blurhash)
+		assert.Equal(t, "#1a2b3c", o.PosterPlaceholder.Value.Color.Value)
+		assert.False(t, o.BackdropPlaceholder.Set, "placeholders of other images are not used")
 	})
 
 	t.Run("minimal movie", func(t *testing.T) {
@@ -156,6 +164,7 @@ func TestMovieFileToOgen(t *testing.T) {
 			RadarrFileID:      int32Ptr(42),
 			LastScannedAt:     &now,
 			IsMonitored:       boolPtr(true),
+			Edition:           stringPtr("Extended"),
 			CreatedAt:         now,
 			UpdatedAt:         now,
 		}
@@ -178,6 +187,7 @@ func TestMovieFileToOgen(t *testing.T) {
 		assert.True(t, o.RadarrFileID.Set)
 		assert.True(t, o.LastScannedAt.Set)
 		assert.True(t, o.IsMonitored.Set)
+		assert.Equal(t, "Extended", o.Edition.Value)
 	})
 
 	t.Run("minimal file", func(t *testing.T) {
@@ -192,9 +202,45 @@ func TestMovieFileToOgen(t *testing.T) {
 		assert.False(t, o.VideoCodec.Set)
 		assert.Nil(t, o.AudioLanguages)
 		assert.Nil(t, o.SubtitleLanguages)
+		assert.False(t, o.Edition.Set)
 	})
 }
 
+// ============================================================================
+// movieEditionToOgen / movieExtraToOgen
+// ============================================================================
+
+func TestMovieEditionToOgen(t *testing.T) {
+	file := movie.MovieFile{ID: uuid.Must(uuid.NewV7()), FileName: "alien.mkv"}
+
+	o := movieEditionToOgen(&movie.Edition{Files: []movie.MovieFile{file}})
+	assert.False(t, o.Name.Set, "default edition has no name")
+	require.Len(t, o.Files, 1)
+	assert.Equal(t, file.ID, o.Files[0].ID.Value)
+
+	o = movieEditionToOgen(&movie.Edition{Name: "Director's Cut"})
+	assert.Equal(t, "Director's Cut", o.Name.Value)
+	assert.Empty(t, o.Files)
+}
+
+func TestMovieExtraToOgen(t *testing.T) {
+	e := &movie.MovieExtra{
+		ID:        uuid.Must(uuid.NewV7()),
+		MovieID:   uuid.Must(uuid.NewV7()),
+		ExtraType: "deleted_scene",
+		Title:     "Bank Job",
+		FileSize:  1024,
+		Container: stringPtr("mkv"),
+	}
+
+	o := movieExtraToOgen(e)
+	assert.Equal(t, e.ID, o.ID)
+	assert.Equal(t, ogen.MovieExtraExtraTypeDeletedScene, o.ExtraType)
+	assert.Equal(t, "Bank Job", o.Title)
+	assert.Equal(t, "mkv", o.Container.Value)
+	assert.False(t, o.DurationSeconds.Set)
+}
+
 // ============================================================================
 // movieCreditToOgen
 // ============================================================================
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/lusoris/revenge/internal/api/ogen"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/movie/moviejobs"
 	"github.com/lusoris/revenge/internal/service/metadata"
 	"github.com/lusoris/revenge/internal/util"
 )
@@ -22,16 +24,21 @@ func (h *Handler) GetMovie(ctx context.Context, params ogen.GetMovieParams) (oge
 	}
 
 	// Get user's preferred language
-	lang := h.GetMetadataLanguage(ctx)
+	chain := h.GetMetadataLanguageChain(ctx)
 
 	// Localize movie fields
-	localizedMovie := LocalizeMovie(m, lang)
+	localizedMovie := LocalizeMovie(m, chain)
 
 	return movieToOgen(localizedMovie), nil
 }
 
 // ListMovies delegates to the movie handler.
 func (h *Handler) ListMovies(ctx context.Context, params ogen.ListMoviesParams) (ogen.ListMoviesRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	handlerParams := movie.ListMoviesParams{
 		OrderBy: string(params.OrderBy.Or("created_at")),
 		Limit:   util.SafeIntToInt32(params.Limit.Or(20)),
@@ -50,8 +57,8 @@ func (h *Handler) ListMovies(ctx context.Context, params ogen.ListMoviesParams)
 	}
 
 	// Get user's preferred language and localize results
-	lang := h.GetMetadataLanguage(ctx)
-	localizedMovies := LocalizeMovies(movies, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localizedMovies := LocalizeMovies(movies, chain)
 
 	result := make([]ogen.Movie, len(localizedMovies))
 	for i, m := range localizedMovies {
@@ -66,6 +73,11 @@ func (h *Handler) ListMovies(ctx context.Context, params ogen.ListMoviesParams)
 
 // SearchMovies delegates to the movie handler.
 func (h *Handler) SearchMovies(ctx context.Context, params ogen.SearchMoviesParams) (ogen.SearchMoviesRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	handlerParams := movie.SearchMoviesParams{
 		Query:  params.Query,
 		Limit:  util.SafeIntToInt32(params.Limit.Or(20)),
@@ -78,8 +90,8 @@ func (h *Handler) SearchMovies(ctx context.Context, params ogen.SearchMoviesPara
 	}
 
 	// Get user's preferred language and localize results
-	lang := h.GetMetadataLanguage(ctx)
-	localizedMovies := LocalizeMovies(movies, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localizedMovies := LocalizeMovies(movies, chain)
 
 	result := make([]ogen.Movie, len(localizedMovies))
 	for i, m := range localizedMovies {
@@ -91,6 +103,11 @@ func (h *Handler) SearchMovies(ctx context.Context, params ogen.SearchMoviesPara
 
 // GetRecentlyAdded delegates to the movie handler.
 func (h *Handler) GetRecentlyAdded(ctx context.Context, params ogen.GetRecentlyAddedParams) (ogen.GetRecentlyAddedRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := util.SafeIntToInt32(params.Limit.Or(20))
 	offset := util.SafeIntToInt32(params.Offset.Or(0))
 	handlerParams := movie.PaginationParams{
@@ -104,8 +121,8 @@ func (h *Handler) GetRecentlyAdded(ctx context.Context, params ogen.GetRecentlyA
 	}
 
 	// Get user's preferred language and localize results
-	lang := h.GetMetadataLanguage(ctx)
-	localizedMovies := LocalizeMovies(movies, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localizedMovies := LocalizeMovies(movies, chain)
 
 	items := make([]ogen.Movie, len(localizedMovies))
 	for i, m := range localizedMovies {
@@ -123,6 +140,11 @@ func (h *Handler) GetRecentlyAdded(ctx context.Context, params ogen.GetRecentlyA
 
 // GetTopRated delegates to the movie handler.
 func (h *Handler) GetTopRated(ctx context.Context, params ogen.GetTopRatedParams) (ogen.GetTopRatedRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := util.SafeIntToInt32(params.Limit.Or(20))
 	offset := util.SafeIntToInt32(params.Offset.Or(0))
 	handlerParams := movie.TopRatedParams{
@@ -137,8 +159,8 @@ func (h *Handler) GetTopRated(ctx context.Context, params ogen.GetTopRatedParams
 	}
 
 	// Get user's preferred language and localize results
-	lang := h.GetMetadataLanguage(ctx)
-	localizedMovies := LocalizeMovies(movies, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localizedMovies := LocalizeMovies(movies, chain)
 
 	items := make([]ogen.Movie, len(localizedMovies))
 	for i, m := range localizedMovies {
@@ -156,6 +178,11 @@ func (h *Handler) GetTopRated(ctx context.Context, params ogen.GetTopRatedParams
 
 // GetContinueWatching delegates to the movie handler.
 func (h *Handler) GetContinueWatching(ctx context.Context, params ogen.GetContinueWatchingParams) (ogen.GetContinueWatchingRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	userID, err := GetUserID(ctx)
 	if err != nil {
 		return nil, err
@@ -168,8 +195,8 @@ func (h *Handler) GetContinueWatching(ctx context.Context, params ogen.GetContin
 	}
 
 	// Get user's preferred language and localize results
-	lang := h.GetMetadataLanguage(ctx)
-	localizedItems := LocalizeContinueWatchingItems(items, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localizedItems := LocalizeContinueWatchingItems(items, chain)
 
 	result := make([]ogen.ContinueWatchingItem, len(localizedItems))
 	for i, item := range localizedItems {
@@ -196,8 +223,8 @@ func (h *Handler) GetWatchHistory(ctx context.Context, params ogen.GetWatchHisto
 	}
 
 	// Get user's preferred language and localize results
-	lang := h.GetMetadataLanguage(ctx)
-	localizedItems := LocalizeWatchedMovieItems(items, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localizedItems := LocalizeWatchedMovieItems(items, chain)
 
 	result := make([]ogen.WatchedMovieItem, len(localizedItems))
 	for i, item := range localizedItems {
@@ -240,6 +267,42 @@ func (h *Handler) GetMovieFiles(ctx context.Context, params ogen.GetMovieFilesPa
 	return (*ogen.GetMovieFilesOKApplicationJSON)(&result), nil
 }
 
+// GetMovieEditions delegates to the movie handler.
+func (h *Handler) GetMovieEditions(ctx context.Context, params ogen.GetMovieEditionsParams) (ogen.GetMovieEditionsRes, error) {
+	editions, err := h.movieHandler.GetMovieEditions(ctx, params.ID.String())
+	if err != nil {
+		if errors.Is(err, movie.ErrMovieNotFound) {
+			return (*ogen.GetMovieEditionsNotFound)(OgenNotFound("Movie not found")), nil
+		}
+		return nil, err
+	}
+
+	result := make([]ogen.MovieEdition, len(editions))
+	for i := range editions {
+		result[i] = movieEditionToOgen(&editions[i])
+	}
+
+	return (*ogen.GetMovieEditionsOKApplicationJSON)(&result), nil
+}
+
+// GetMovieExtras delegates to the movie handler.
+func (h *Handler) GetMovieExtras(ctx context.Context, params ogen.GetMovieExtrasParams) (ogen.GetMovieExtrasRes, error) {
+	extras, err := h.movieHandler.GetMovieExtras(ctx, params.ID.String())
+	if err != nil {
+		if errors.Is(err, movie.ErrMovieNotFound) {
+			return (*ogen.GetMovieExtrasNotFound)(OgenNotFound("Movie not found")), nil
+		}
+		return nil, err
+	}
+
+	result := make([]ogen.MovieExtra, len(extras))
+	for i := range extras {
+		result[i] = movieExtraToOgen(&extras[i])
+	}
+
+	return (*ogen.GetMovieExtrasOKApplicationJSON)(&result), nil
+}
+
 // GetMovieCast delegates to the movie handler.
 func (h *Handler) GetMovieCast(ctx context.Context, params ogen.GetMovieCastParams) (ogen.GetMovieCastRes, error) {
 	limit := util.SafeIntToInt32(params.Limit.Or(50))
@@ -371,6 +434,7 @@ func (h *Handler) UpdateWatchProgress(ctx context.Context, req *ogen.UpdateWatch
 		return nil, err
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return movieWatchedToOgen(progress), nil
 }
 
@@ -389,6 +453,7 @@ func (h *Handler) DeleteWatchProgress(ctx context.Context, params ogen.DeleteWat
 		return nil, err
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return &ogen.DeleteWatchProgressNoContent{}, nil
 }
 
@@ -407,6 +472,7 @@ func (h *Handler) MarkAsWatched(ctx context.Context, params ogen.MarkAsWatchedPa
 		return nil, err
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return &ogen.MarkAsWatchedNoContent{}, nil
 }
 
@@ -446,8 +512,9 @@ func (h *Handler) GetCollectionMovies(ctx context.Context, params ogen.GetCollec
 		return nil, err
 	}
 
-	result := make([]ogen.Movie, len(movies))
-	for i, m := range movies {
+	localized := LocalizeMovies(movies, h.GetMetadataLanguageChain(ctx))
+	result := make([]ogen.Movie, len(localized))
+	for i, m := range localized {
 		result[i] = *movieToOgen(&m)
 	}
 
@@ -538,3 +605,40 @@ func (h *Handler) GetSimilarMovies(ctx context.Context, params ogen.GetSimilarMo
 		TotalResults:  ogen.NewOptInt(totalResults),
 	}, nil
 }
+
+// AdminExportMovieNFO queues an NFO export for one movie or the whole movie
+// library. Admin only.
+// POST /api/v1/admin/movies/nfo-export
+func (h *Handler) AdminExportMovieNFO(ctx context.Context, req ogen.OptNFOExportRequest) (ogen.AdminExportMovieNFORes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminExportMovieNFOUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminExportMovieNFOForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	if h.riverClient == nil {
+		return nil, fmt.Errorf("job queue not available")
+	}
+
+	args := moviejobs.MovieNFOExportArgs{}
+	if movieID, ok := req.Value.MovieID.Get(); req.Set && ok {
+		args.MovieID = &movieID
+	}
+
+	result, err := h.riverClient.Insert(ctx, args, nil)
+	if err != nil {
+		h.logger.Error("failed to enqueue nfo export job", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to enqueue nfo export job: %w", err)
+	}
+
+	h.logger.Info("nfo export job enqueued", slog.Int64("job_id", result.Job.ID))
+
+	return &ogen.NFOExportResponse{
+		Message: "NFO export job queued",
+		JobID:   result.Job.ID,
+	}, nil
+}
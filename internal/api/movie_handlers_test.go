@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+func TestHandler_AdminExportMovieNFO_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.AdminExportMovieNFO(context.Background(), ogen.OptNFOExportRequest{})
+	require.NoError(t, err)
+
+	unauthorized, ok := result.(*ogen.AdminExportMovieNFOUnauthorized)
+	require.True(t, ok, "expected *ogen.AdminExportMovieNFOUnauthorized, got %T", result)
+	assert.Equal(t, 401, unauthorized.Code)
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/music"
+)
+
+func musicArtistToOgen(a *music.Artist) ogen.MusicArtist {
+	result := ogen.MusicArtist{
+		ID:       a.ID,
+		Name:     a.Name,
+		SortName: a.SortName,
+	}
+	if a.MusicBrainzID != nil {
+		result.MusicbrainzID = ogen.NewOptUUID(*a.MusicBrainzID)
+	}
+	return result
+}
+
+func musicArtistsToOgen(artists []music.Artist) []ogen.MusicArtist {
+	result := make([]ogen.MusicArtist, len(artists))
+	for i := range artists {
+		result[i] = musicArtistToOgen(&artists[i])
+	}
+	return result
+}
+
+func musicAlbumToOgen(r *music.Release) ogen.MusicAlbum {
+	genres := r.Genres
+	if genres == nil {
+		genres = []string{}
+	}
+	result := ogen.MusicAlbum{
+		ID:             r.ID,
+		ReleaseGroupID: r.ReleaseGroupID,
+		Title:          r.Title,
+		ArtistCredit:   r.ArtistCredit,
+		IsCompilation:  r.IsCompilation,
+		Genres:         genres,
+		DiscCount:      int(r.DiscCount),
+		TrackCount:     int(r.TrackCount),
+		DurationMs:     r.DurationMs,
+		CreatedAt:      r.CreatedAt,
+	}
+	if r.MusicBrainzID != nil {
+		result.MusicbrainzID = ogen.NewOptUUID(*r.MusicBrainzID)
+	}
+	if r.Year != nil {
+		result.Year = ogen.NewOptInt(int(*r.Year))
+	}
+	if r.ReleaseDate != nil {
+		result.ReleaseDate = ogen.NewOptDate(*r.ReleaseDate)
+	}
+	if r.Label != nil {
+		result.Label = ogen.NewOptString(*r.Label)
+	}
+	if r.CatalogNumber != nil {
+		result.CatalogNumber = ogen.NewOptString(*r.CatalogNumber)
+	}
+	if r.Country != nil {
+		result.Country = ogen.NewOptString(*r.Country)
+	}
+	if r.ReplayGainGain != nil {
+		result.ReplaygainGain = ogen.NewOptFloat32(*r.ReplayGainGain)
+	}
+	if r.ReplayGainPeak != nil {
+		result.ReplaygainPeak = ogen.NewOptFloat32(*r.ReplayGainPeak)
+	}
+	if r.CoverPath != nil {
+		result.CoverPath = ogen.NewOptString(*r.CoverPath)
+	}
+	return result
+}
+
+func musicAlbumsToOgen(releases []music.Release) []ogen.MusicAlbum {
+	result := make([]ogen.MusicAlbum, len(releases))
+	for i := range releases {
+		result[i] = musicAlbumToOgen(&releases[i])
+	}
+	return result
+}
+
+func musicTrackToOgen(t *music.Track) ogen.MusicTrack {
+	result := ogen.MusicTrack{
+		ID:           t.ID,
+		AlbumID:      t.ReleaseID,
+		Title:        t.Title,
+		ArtistCredit: t.ArtistCredit,
+		DiscNumber:   int(t.DiscNumber),
+	}
+	if t.TrackNumber != nil {
+		result.TrackNumber = ogen.NewOptInt(int(*t.TrackNumber))
+	}
+	if t.DurationMs != nil {
+		result.DurationMs = ogen.NewOptInt(int(*t.DurationMs))
+	}
+	if t.ISRC != nil {
+		result.Isrc = ogen.NewOptString(*t.ISRC)
+	}
+	if t.MusicBrainzRecordingID != nil {
+		result.MusicbrainzRecordingID = ogen.NewOptUUID(*t.MusicBrainzRecordingID)
+	}
+	if t.MusicBrainzTrackID != nil {
+		result.MusicbrainzTrackID = ogen.NewOptUUID(*t.MusicBrainzTrackID)
+	}
+	if t.ReplayGainGain != nil {
+		result.ReplaygainGain = ogen.NewOptFloat32(*t.ReplayGainGain)
+	}
+	if t.ReplayGainPeak != nil {
+		result.ReplaygainPeak = ogen.NewOptFloat32(*t.ReplayGainPeak)
+	}
+	if t.Container != nil {
+		result.Container = ogen.NewOptString(*t.Container)
+	}
+	return result
+}
+
+func musicTracksToOgen(tracks []music.Track) []ogen.MusicTrack {
+	result := make([]ogen.MusicTrack, len(tracks))
+	for i := range tracks {
+		result[i] = musicTrackToOgen(&tracks[i])
+	}
+	return result
+}
+
+func musicDiscToOgen(d music.Disc) ogen.MusicDisc {
+	result := ogen.MusicDisc{
+		Number: int(d.Number),
+		Tracks: musicTracksToOgen(d.Tracks),
+	}
+	if d.Subtitle != nil {
+		result.Subtitle = ogen.NewOptString(*d.Subtitle)
+	}
+	return result
+}
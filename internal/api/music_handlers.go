@@ -0,0 +1,308 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ListMusicArtists returns a paginated list of artists.
+func (h *Handler) ListMusicArtists(ctx context.Context, params ogen.ListMusicArtistsParams) (ogen.ListMusicArtistsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return &ogen.MusicArtistListResponse{Items: []ogen.MusicArtist{}}, nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	filters := music.ArtistListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	}
+
+	var (
+		artists []music.Artist
+		total   int64
+	)
+	if params.AlbumArtists.Or(false) {
+		artists, total, err = h.musicService.ListAlbumArtists(ctx, filters)
+	} else {
+		artists, total, err = h.musicService.ListArtists(ctx, filters)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.MusicArtistListResponse{
+		Items: musicArtistsToOgen(artists),
+		Total: total,
+	}, nil
+}
+
+// GetMusicArtist returns an artist by ID.
+func (h *Handler) GetMusicArtist(ctx context.Context, params ogen.GetMusicArtistParams) (ogen.GetMusicArtistRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return (*ogen.GetMusicArtistNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	artist, err := h.musicService.GetArtist(ctx, params.ID)
+	if err != nil {
+		return (*ogen.GetMusicArtistNotFound)(OgenNotFound("Artist not found")), nil
+	}
+	visible, err := h.musicArtistVisible(ctx, userID, artist.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return (*ogen.GetMusicArtistNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	result := musicArtistToOgen(artist)
+	return &result, nil
+}
+
+// GetMusicArtistAlbums returns the albums an artist is credited on.
+func (h *Handler) GetMusicArtistAlbums(ctx context.Context, params ogen.GetMusicArtistAlbumsParams) (ogen.GetMusicArtistAlbumsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return (*ogen.GetMusicArtistAlbumsNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	releases, err := h.musicService.ListArtistReleases(ctx, params.ID, libraryPaths)
+	if err != nil {
+		return (*ogen.GetMusicArtistAlbumsNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	result := musicAlbumsToOgen(releases)
+	return (*ogen.GetMusicArtistAlbumsOKApplicationJSON)(&result), nil
+}
+
+// GetMusicArtistTracks returns the tracks an artist performs on.
+func (h *Handler) GetMusicArtistTracks(ctx context.Context, params ogen.GetMusicArtistTracksParams) (ogen.GetMusicArtistTracksRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return (*ogen.GetMusicArtistTracksNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	tracks, err := h.musicService.ListArtistTracks(ctx, params.ID, libraryPaths,
+		util.SafeIntToInt32(params.Limit.Or(100)), util.SafeIntToInt32(params.Offset.Or(0)))
+	if err != nil {
+		return (*ogen.GetMusicArtistTracksNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	result := musicTracksToOgen(tracks)
+	return (*ogen.GetMusicArtistTracksOKApplicationJSON)(&result), nil
+}
+
+// ListMusicAlbums returns a paginated list of albums.
+func (h *Handler) ListMusicAlbums(ctx context.Context, params ogen.ListMusicAlbumsParams) (ogen.ListMusicAlbumsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return &ogen.MusicAlbumListResponse{Items: []ogen.MusicAlbum{}}, nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	releases, total, err := h.musicService.ListReleases(ctx, music.ReleaseListFilters{
+		LibraryPaths: libraryPaths,
+		OrderBy:      string(params.OrderBy.Or(ogen.ListMusicAlbumsOrderByCreatedAt)),
+		Limit:        util.SafeIntToInt32(params.Limit.Or(20)),
+		Offset:       util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.MusicAlbumListResponse{
+		Items: musicAlbumsToOgen(releases),
+		Total: total,
+	}, nil
+}
+
+// GetMusicAlbum returns an album with its artists and tracks grouped by disc.
+func (h *Handler) GetMusicAlbum(ctx context.Context, params ogen.GetMusicAlbumParams) (ogen.GetMusicAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return (*ogen.GetMusicAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	release, err := h.musicService.GetRelease(ctx, params.ID)
+	if err != nil {
+		return (*ogen.GetMusicAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+	discs, err := h.musicService.GetReleaseDiscs(ctx, release.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !discsInLibraryPaths(discs, libraryPaths) {
+		return (*ogen.GetMusicAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	artists, err := h.musicService.GetReleaseArtists(ctx, release.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &ogen.MusicAlbumDetail{
+		Album:   musicAlbumToOgen(release),
+		Artists: musicArtistsToOgen(artists),
+		Discs:   make([]ogen.MusicDisc, len(discs)),
+	}
+	for i, disc := range discs {
+		detail.Discs[i] = musicDiscToOgen(disc)
+	}
+	return detail, nil
+}
+
+// GetMusicAlbumEditions returns all editions in an album's release group.
+func (h *Handler) GetMusicAlbumEditions(ctx context.Context, params ogen.GetMusicAlbumEditionsParams) (ogen.GetMusicAlbumEditionsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return (*ogen.GetMusicAlbumEditionsNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	release, err := h.musicService.GetRelease(ctx, params.ID)
+	if err != nil {
+		return (*ogen.GetMusicAlbumEditionsNotFound)(OgenNotFound("Album not found")), nil
+	}
+	discs, err := h.musicService.GetReleaseDiscs(ctx, release.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !discsInLibraryPaths(discs, libraryPaths) {
+		return (*ogen.GetMusicAlbumEditionsNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	editions, err := h.musicService.ListReleaseEditions(ctx, release.ReleaseGroupID, libraryPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	result := musicAlbumsToOgen(editions)
+	return (*ogen.GetMusicAlbumEditionsOKApplicationJSON)(&result), nil
+}
+
+// GetMusicTrack returns a track with its performing artists.
+func (h *Handler) GetMusicTrack(ctx context.Context, params ogen.GetMusicTrackParams) (ogen.GetMusicTrackRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicService == nil {
+		return (*ogen.GetMusicTrackNotFound)(OgenNotFound("Track not found")), nil
+	}
+
+	track, err := h.accessibleMusicTrack(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, music.ErrTrackNotFound) {
+			return (*ogen.GetMusicTrackNotFound)(OgenNotFound("Track not found")), nil
+		}
+		return nil, err
+	}
+
+	artists, err := h.musicService.GetTrackArtists(ctx, track.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := musicTrackToOgen(track)
+	result.Artists = musicArtistsToOgen(artists)
+	return &result, nil
+}
+
+// accessibleMusicTrack returns a track stored in a library the user can
+// access, else music.ErrTrackNotFound.
+func (h *Handler) accessibleMusicTrack(ctx context.Context, userID, trackID uuid.UUID) (*music.Track, error) {
+	track, err := h.musicService.GetTrack(ctx, trackID)
+	if err != nil {
+		return nil, err
+	}
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil {
+		return nil, err
+	}
+	if !inLibraryPaths(track.FilePath, libraryPaths) {
+		return nil, music.ErrTrackNotFound
+	}
+	return track, nil
+}
+
+// musicArtistVisible reports whether an artist performs on or is credited
+// with a track in a library the user can access.
+func (h *Handler) musicArtistVisible(ctx context.Context, userID, artistID uuid.UUID) (bool, error) {
+	libraryPaths, err := h.accessibleLibraryPaths(ctx, userID, library.LibraryTypeMusic)
+	if err != nil || libraryPaths == nil {
+		return err == nil, err
+	}
+	releases, err := h.musicService.ListArtistReleases(ctx, artistID, libraryPaths)
+	if err != nil || len(releases) > 0 {
+		return len(releases) > 0, err
+	}
+	tracks, err := h.musicService.ListArtistTracks(ctx, artistID, libraryPaths, 1, 0)
+	return len(tracks) > 0, err
+}
+
+// discsInLibraryPaths reports whether a release has a track under the
+// library paths, so albums of hidden libraries can't be opened by ID.
+func discsInLibraryPaths(discs []music.Disc, libraryPaths []string) bool {
+	if libraryPaths == nil {
+		return true
+	}
+	for _, disc := range discs {
+		for _, track := range disc.Tracks {
+			if inLibraryPaths(track.FilePath, libraryPaths) {
+				return true
+			}
+		}
+	}
+	return false
+}
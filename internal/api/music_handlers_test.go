@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// musicMockService is a minimal mock for music.Service used by the music handler tests.
+type musicMockService struct {
+	music.Service
+	releases map[uuid.UUID]*music.Release
+	artists  []music.Artist
+	discs    []music.Disc
+	tracks   map[uuid.UUID]*music.Track
+
+	releaseFilters music.ReleaseListFilters
+}
+
+func (m *musicMockService) GetRelease(_ context.Context, id uuid.UUID) (*music.Release, error) {
+	if r, ok := m.releases[id]; ok {
+		return r, nil
+	}
+	return nil, music.ErrReleaseNotFound
+}
+
+func (m *musicMockService) ListReleases(_ context.Context, filters music.ReleaseListFilters) ([]music.Release, int64, error) {
+	m.releaseFilters = filters
+	return nil, 0, nil
+}
+
+func (m *musicMockService) GetReleaseArtists(_ context.Context, _ uuid.UUID) ([]music.Artist, error) {
+	return m.artists, nil
+}
+
+func (m *musicMockService) GetReleaseDiscs(_ context.Context, _ uuid.UUID) ([]music.Disc, error) {
+	return m.discs, nil
+}
+
+func (m *musicMockService) GetTrack(_ context.Context, id uuid.UUID) (*music.Track, error) {
+	if t, ok := m.tracks[id]; ok {
+		return t, nil
+	}
+	return nil, music.ErrTrackNotFound
+}
+
+func (m *musicMockService) GetTrackArtists(_ context.Context, _ uuid.UUID) ([]music.Artist, error) {
+	return m.artists, nil
+}
+
+func TestHandler_GetMusicAlbum(t *testing.T) {
+	t.Parallel()
+
+	releaseID := uuid.New()
+	trackNumber := int32(1)
+	subtitle := "Bonus"
+	svc := &musicMockService{
+		releases: map[uuid.UUID]*music.Release{
+			releaseID: {ID: releaseID, Title: "Abbey Road", ArtistCredit: "The Beatles", DiscCount: 2, TrackCount: 2},
+		},
+		artists: []music.Artist{{ID: uuid.New(), Name: "The Beatles", SortName: "Beatles, The"}},
+		discs: []music.Disc{
+			{Number: 1, Tracks: []music.Track{{ID: uuid.New(), ReleaseID: releaseID, Title: "Come Together", DiscNumber: 1, TrackNumber: &trackNumber}}},
+			{Number: 2, Subtitle: &subtitle, Tracks: []music.Track{{ID: uuid.New(), ReleaseID: releaseID, Title: "Her Majesty", DiscNumber: 2}}},
+		},
+	}
+	handler := &Handler{logger: logging.NewTestLogger(), musicService: svc}
+
+	result, err := handler.GetMusicAlbum(contextWithUserID(context.Background(), uuid.New()), ogen.GetMusicAlbumParams{ID: releaseID})
+	require.NoError(t, err)
+
+	detail, ok := result.(*ogen.MusicAlbumDetail)
+	require.True(t, ok, "expected *ogen.MusicAlbumDetail, got %T", result)
+	assert.Equal(t, "Abbey Road", detail.Album.Title)
+	require.Len(t, detail.Artists, 1)
+	assert.Equal(t, "The Beatles", detail.Artists[0].Name)
+	require.Len(t, detail.Discs, 2)
+	assert.Equal(t, 1, detail.Discs[0].Tracks[0].TrackNumber.Or(0))
+	assert.Equal(t, "Bonus", detail.Discs[1].Subtitle.Or(""))
+}
+
+func TestHandler_GetMusicAlbum_NotFound(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger(), musicService: &musicMockService{}}
+
+	result, err := handler.GetMusicAlbum(contextWithUserID(context.Background(), uuid.New()), ogen.GetMusicAlbumParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetMusicAlbumNotFound)
+	assert.True(t, ok, "expected *ogen.GetMusicAlbumNotFound, got %T", result)
+}
+
+func TestHandler_GetMusicTrack(t *testing.T) {
+	t.Parallel()
+
+	trackID := uuid.New()
+	gain := float32(-6.5)
+	svc := &musicMockService{
+		tracks: map[uuid.UUID]*music.Track{
+			trackID: {ID: trackID, Title: "Come Together", DiscNumber: 1, ReplayGainGain: &gain},
+		},
+		artists: []music.Artist{{ID: uuid.New(), Name: "The Beatles"}},
+	}
+	handler := &Handler{logger: logging.NewTestLogger(), musicService: svc}
+
+	result, err := handler.GetMusicTrack(contextWithUserID(context.Background(), uuid.New()), ogen.GetMusicTrackParams{ID: trackID})
+	require.NoError(t, err)
+
+	track, ok := result.(*ogen.MusicTrack)
+	require.True(t, ok, "expected *ogen.MusicTrack, got %T", result)
+	assert.Equal(t, "Come Together", track.Title)
+	assert.Equal(t, gain, track.ReplaygainGain.Or(0))
+	require.Len(t, track.Artists, 1)
+}
+
+func TestHandler_Music_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	artists, err := handler.ListMusicArtists(ctx, ogen.ListMusicArtistsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, artists.(*ogen.MusicArtistListResponse).Items)
+
+	track, err := handler.GetMusicTrack(ctx, ogen.GetMusicTrackParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := track.(*ogen.GetMusicTrackNotFound)
+	assert.True(t, ok, "expected *ogen.GetMusicTrackNotFound, got %T", track)
+}
+
+func TestHandler_Music_LibraryAccess(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	shared := library.Library{ID: uuid.New(), Name: "Shared", Type: library.LibraryTypeMusic, Paths: []string{"/music/shared"}}
+	private := library.Library{ID: uuid.New(), Name: "Private", Type: library.LibraryTypeMusic, Paths: []string{"/music/private/"}}
+
+	releaseID := uuid.New()
+	hiddenTrack := uuid.New()
+	svc := &musicMockService{
+		releases: map[uuid.UUID]*music.Release{releaseID: {ID: releaseID, Title: "Demos"}},
+		discs:    []music.Disc{{Number: 1, Tracks: []music.Track{{ID: hiddenTrack, FilePath: "/music/private/Demos/01.flac"}}}},
+		tracks:   map[uuid.UUID]*music.Track{hiddenTrack: {ID: hiddenTrack, FilePath: "/music/private/Demos/01.flac"}},
+	}
+	handler := &Handler{
+		logger:         logging.NewTestLogger(),
+		musicService:   svc,
+		libraryService: newStubLibraryService([]library.Library{shared, private}, map[uuid.UUID][]uuid.UUID{userID: {shared.ID}}),
+	}
+	ctx := contextWithUserID(context.Background(), userID)
+
+	_, err := handler.ListMusicAlbums(ctx, ogen.ListMusicAlbumsParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/music/shared/"}, svc.releaseFilters.LibraryPaths)
+
+	album, err := handler.GetMusicAlbum(ctx, ogen.GetMusicAlbumParams{ID: releaseID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetMusicAlbumNotFound{}, album)
+
+	track, err := handler.GetMusicTrack(ctx, ogen.GetMusicTrackParams{ID: hiddenTrack})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.GetMusicTrackNotFound{}, track)
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"path/filepath"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+)
+
+func musicVideoToOgen(v *musicvideo.Video) ogen.MusicVideo {
+	result := ogen.MusicVideo{
+		ID:              v.ID,
+		LibraryID:       v.LibraryID,
+		ArtistID:        v.ArtistID,
+		ArtistName:      v.ArtistName,
+		ArtistCredit:    v.ArtistCredit,
+		Title:           v.Title,
+		TitleSource:     ogen.MusicVideoTitleSource(v.TitleSource),
+		FileName:        filepath.Base(v.FilePath),
+		FileSize:        v.FileSize,
+		DurationSeconds: v.DurationSeconds,
+		Width:           int(v.Width),
+		Height:          int(v.Height),
+		MatchStatus:     ogen.MusicVideoMatchStatus(v.MatchStatus),
+		CreatedAt:       v.CreatedAt,
+	}
+	setOptConv(&result.Year, v.Year, int32ToInt)
+	setOpt(&result.Container, v.Container)
+	setOpt(&result.VideoCodec, v.VideoCodec)
+	setOpt(&result.AudioCodec, v.AudioCodec)
+	setOpt(&result.MusicbrainzRecordingID, v.MusicBrainzRecordingID)
+	setOpt(&result.MatchedAt, v.MatchedAt)
+	return result
+}
+
+func musicVideosToOgen(videos []musicvideo.Video) []ogen.MusicVideo {
+	result := make([]ogen.MusicVideo, len(videos))
+	for i := range videos {
+		result[i] = musicVideoToOgen(&videos[i])
+	}
+	return result
+}
+
+func musicVideoArtistToOgen(a *musicvideo.Artist) ogen.MusicVideoArtist {
+	result := ogen.MusicVideoArtist{
+		ID:         a.ID,
+		LibraryID:  a.LibraryID,
+		Name:       a.Name,
+		SortName:   a.SortName,
+		VideoCount: a.VideoCount,
+		CreatedAt:  a.CreatedAt,
+	}
+	setOpt(&result.Disambiguation, a.Disambiguation)
+	setOpt(&result.MusicbrainzArtistID, a.MusicBrainzID)
+	return result
+}
+
+func musicVideoArtistsToOgen(artists []musicvideo.Artist) []ogen.MusicVideoArtist {
+	result := make([]ogen.MusicVideoArtist, len(artists))
+	for i := range artists {
+		result[i] = musicVideoArtistToOgen(&artists[i])
+	}
+	return result
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ListMusicVideos returns a paginated list of videos from the caller's
+// music video libraries.
+func (h *Handler) ListMusicVideos(ctx context.Context, params ogen.ListMusicVideosParams) (ogen.ListMusicVideosRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicVideoService == nil {
+		return &ogen.MusicVideoListResponse{Items: []ogen.MusicVideo{}}, nil
+	}
+
+	if libraryID, ok := params.LibraryID.Get(); ok {
+		if err := h.checkLibrary(ctx, userID, libraryID, library.LibraryTypeMusicVideo); err != nil {
+			if errors.Is(err, errLibraryDenied) {
+				return (*ogen.ListMusicVideosForbidden)(OgenForbidden("Access denied to this library")), nil
+			}
+			return nil, err
+		}
+	}
+	if artistID, ok := params.ArtistID.Get(); ok {
+		if _, err := h.accessibleMusicVideoArtist(ctx, userID, artistID); err != nil {
+			if errors.Is(err, musicvideo.ErrArtistNotFound) {
+				return (*ogen.ListMusicVideosNotFound)(OgenNotFound("Artist not found")), nil
+			}
+			return nil, err
+		}
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeMusicVideo)
+	if err != nil {
+		return nil, err
+	}
+	videos, total, err := h.musicVideoService.ListVideos(ctx, musicvideo.VideoListFilters{
+		LibraryIDs: libraryIDs,
+		LibraryID:  optPtr[uuid.UUID](params.LibraryID),
+		ArtistID:   optPtr[uuid.UUID](params.ArtistID),
+		Sort:       musicvideo.VideoSort(params.Sort.Or(ogen.ListMusicVideosSortArtist)),
+		Limit:      util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.MusicVideoListResponse{
+		Items: musicVideosToOgen(videos),
+		Total: total,
+	}, nil
+}
+
+// GetMusicVideo returns a music video.
+func (h *Handler) GetMusicVideo(ctx context.Context, params ogen.GetMusicVideoParams) (ogen.GetMusicVideoRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicVideoService == nil {
+		return (*ogen.GetMusicVideoNotFound)(OgenNotFound("Music video not found")), nil
+	}
+
+	video, err := h.accessibleMusicVideo(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, musicvideo.ErrVideoNotFound) {
+			return (*ogen.GetMusicVideoNotFound)(OgenNotFound("Music video not found")), nil
+		}
+		return nil, err
+	}
+	result := musicVideoToOgen(video)
+	return &result, nil
+}
+
+// ListMusicVideoArtists returns the artists of the caller's music video
+// libraries by sort name.
+func (h *Handler) ListMusicVideoArtists(ctx context.Context, params ogen.ListMusicVideoArtistsParams) (ogen.ListMusicVideoArtistsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicVideoService == nil {
+		return &ogen.MusicVideoArtistListResponse{Items: []ogen.MusicVideoArtist{}}, nil
+	}
+
+	if libraryID, ok := params.LibraryID.Get(); ok {
+		if err := h.checkLibrary(ctx, userID, libraryID, library.LibraryTypeMusicVideo); err != nil {
+			if errors.Is(err, errLibraryDenied) {
+				return (*ogen.ListMusicVideoArtistsForbidden)(OgenForbidden("Access denied to this library")), nil
+			}
+			return nil, err
+		}
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypeMusicVideo)
+	if err != nil {
+		return nil, err
+	}
+	artists, total, err := h.musicVideoService.ListArtists(ctx, musicvideo.ArtistListFilters{
+		LibraryIDs: libraryIDs,
+		LibraryID:  optPtr[uuid.UUID](params.LibraryID),
+		Limit:      util.SafeIntToInt32(params.Limit.Or(100)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.MusicVideoArtistListResponse{
+		Items: musicVideoArtistsToOgen(artists),
+		Total: total,
+	}, nil
+}
+
+// GetMusicVideoArtist returns a music video artist.
+func (h *Handler) GetMusicVideoArtist(ctx context.Context, params ogen.GetMusicVideoArtistParams) (ogen.GetMusicVideoArtistRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.musicVideoService == nil {
+		return (*ogen.GetMusicVideoArtistNotFound)(OgenNotFound("Artist not found")), nil
+	}
+
+	artist, err := h.accessibleMusicVideoArtist(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, musicvideo.ErrArtistNotFound) {
+			return (*ogen.GetMusicVideoArtistNotFound)(OgenNotFound("Artist not found")), nil
+		}
+		return nil, err
+	}
+	result := musicVideoArtistToOgen(artist)
+	return &result, nil
+}
+
+// accessibleMusicVideo returns a video of a library the user can access,
+// else musicvideo.ErrVideoNotFound.
+func (h *Handler) accessibleMusicVideo(ctx context.Context, userID, videoID uuid.UUID) (*musicvideo.Video, error) {
+	video, err := h.musicVideoService.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, userID, video.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return nil, musicvideo.ErrVideoNotFound
+		}
+		return nil, err
+	}
+	return video, nil
+}
+
+// accessibleMusicVideoArtist returns an artist of a library the user can
+// access, else musicvideo.ErrArtistNotFound.
+func (h *Handler) accessibleMusicVideoArtist(ctx context.Context, userID, artistID uuid.UUID) (*musicvideo.Artist, error) {
+	artist, err := h.musicVideoService.GetArtist(ctx, artistID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, userID, artist.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return nil, musicvideo.ErrArtistNotFound
+		}
+		return nil, err
+	}
+	return artist, nil
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+// musicVideoMockService is a minimal mock for musicvideo.Service used by
+// the music video handler tests.
+type musicVideoMockService struct {
+	musicvideo.Service
+	videos        map[uuid.UUID]*musicvideo.Video
+	artists       map[uuid.UUID]*musicvideo.Artist
+	filters       *musicvideo.VideoListFilters
+	artistFilters *musicvideo.ArtistListFilters
+}
+
+func (m *musicVideoMockService) GetVideo(_ context.Context, id uuid.UUID) (*musicvideo.Video, error) {
+	if v, ok := m.videos[id]; ok {
+		return v, nil
+	}
+	return nil, musicvideo.ErrVideoNotFound
+}
+
+func (m *musicVideoMockService) ListVideos(_ context.Context, filters musicvideo.VideoListFilters) ([]musicvideo.Video, int64, error) {
+	m.filters = &filters
+	var videos []musicvideo.Video
+	for _, v := range m.videos {
+		videos = append(videos, *v)
+	}
+	return videos, int64(len(videos)), nil
+}
+
+func (m *musicVideoMockService) GetArtist(_ context.Context, id uuid.UUID) (*musicvideo.Artist, error) {
+	if a, ok := m.artists[id]; ok {
+		return a, nil
+	}
+	return nil, musicvideo.ErrArtistNotFound
+}
+
+func (m *musicVideoMockService) ListArtists(_ context.Context, filters musicvideo.ArtistListFilters) ([]musicvideo.Artist, int64, error) {
+	m.artistFilters = &filters
+	var artists []musicvideo.Artist
+	for _, a := range m.artists {
+		artists = append(artists, *a)
+	}
+	return artists, int64(len(artists)), nil
+}
+
+// newMusicVideoMockService returns a mock with an artist matched on
+// MusicBrainz and a matched video of it.
+func newMusicVideoMockService(artistID, videoID uuid.UUID) *musicVideoMockService {
+	libraryID := uuid.New()
+	matchedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	return &musicVideoMockService{
+		videos: map[uuid.UUID]*musicvideo.Video{
+			videoID: {
+				ID: videoID, LibraryID: libraryID, ArtistID: artistID, FilePath: "/music-videos/a-ha - Take On Me (Official Video).mp4",
+				FileSize: 1 << 20, Container: new("mp4"), Title: "Take On Me", TitleSource: musicvideo.TitleSourceMusicBrainz,
+				ArtistName: "a-ha", ArtistCredit: "a-ha", Year: new(int32(1985)),
+				DurationSeconds: 225.3, Width: 1440, Height: 1080, VideoCodec: new("h264"),
+				MusicBrainzRecordingID: new(uuid.MustParse("b1a9c0e9-d987-4042-ae91-78d6a3267d69")),
+				MatchStatus:            musicvideo.MatchStatusMatched, MatchedAt: &matchedAt,
+			},
+		},
+		artists: map[uuid.UUID]*musicvideo.Artist{
+			artistID: {
+				ID: artistID, LibraryID: libraryID, Name: "a-ha", SortName: "a-ha",
+				Disambiguation: new("Norwegian band"), MusicBrainzID: new(uuid.MustParse("7364dea6-ca9a-48e3-be01-b44ad0d19897")),
+				VideoCount: 1,
+			},
+		},
+	}
+}
+
+func TestHandler_GetMusicVideo(t *testing.T) {
+	t.Parallel()
+
+	videoID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), musicVideoService: newMusicVideoMockService(uuid.New(), videoID)}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetMusicVideo(ctx, ogen.GetMusicVideoParams{ID: videoID})
+	require.NoError(t, err)
+	v, ok := result.(*ogen.MusicVideo)
+	require.True(t, ok, "expected *ogen.MusicVideo, got %T", result)
+	assert.Equal(t, "Take On Me", v.Title)
+	assert.Equal(t, "a-ha", v.ArtistName)
+	assert.Equal(t, "a-ha - Take On Me (Official Video).mp4", v.FileName)
+	assert.Equal(t, ogen.MusicVideoTitleSourceMusicbrainz, v.TitleSource)
+	assert.Equal(t, ogen.MusicVideoMatchStatusMatched, v.MatchStatus)
+	assert.Equal(t, 1985, v.Year.Or(0))
+	assert.True(t, v.MusicbrainzRecordingID.IsSet())
+	assert.False(t, v.AudioCodec.IsSet())
+
+	result, err = handler.GetMusicVideo(ctx, ogen.GetMusicVideoParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetMusicVideoNotFound)
+	assert.True(t, ok, "expected *ogen.GetMusicVideoNotFound, got %T", result)
+}
+
+func TestHandler_ListMusicVideos_Filters(t *testing.T) {
+	t.Parallel()
+
+	artistID := uuid.New()
+	svc := newMusicVideoMockService(artistID, uuid.New())
+	handler := &Handler{logger: logging.NewTestLogger(), musicVideoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.ListMusicVideos(ctx, ogen.ListMusicVideosParams{
+		ArtistID: ogen.NewOptUUID(artistID),
+		Sort:     ogen.NewOptListMusicVideosSort(ogen.ListMusicVideosSortYear),
+		Limit:    ogen.NewOptInt(20),
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.(*ogen.MusicVideoListResponse).Items, 1)
+
+	require.NotNil(t, svc.filters)
+	assert.Nil(t, svc.filters.LibraryIDs, "all libraries without library permissions")
+	assert.Equal(t, &artistID, svc.filters.ArtistID)
+	assert.Equal(t, musicvideo.VideoSortYear, svc.filters.Sort)
+	assert.Equal(t, int32(20), svc.filters.Limit)
+
+	result, err = handler.ListMusicVideos(ctx, ogen.ListMusicVideosParams{ArtistID: ogen.NewOptUUID(uuid.New())})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.ListMusicVideosNotFound)
+	assert.True(t, ok, "expected *ogen.ListMusicVideosNotFound, got %T", result)
+}
+
+func TestHandler_MusicVideoArtists(t *testing.T) {
+	t.Parallel()
+
+	artistID := uuid.New()
+	svc := newMusicVideoMockService(artistID, uuid.New())
+	handler := &Handler{logger: logging.NewTestLogger(), musicVideoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListMusicVideoArtists(ctx, ogen.ListMusicVideoArtistsParams{})
+	require.NoError(t, err)
+	artists := list.(*ogen.MusicVideoArtistListResponse)
+	require.Len(t, artists.Items, 1)
+	assert.Equal(t, int64(1), artists.Items[0].VideoCount)
+	require.NotNil(t, svc.artistFilters)
+	assert.Equal(t, int32(100), svc.artistFilters.Limit)
+
+	result, err := handler.GetMusicVideoArtist(ctx, ogen.GetMusicVideoArtistParams{ID: artistID})
+	require.NoError(t, err)
+	artist, ok := result.(*ogen.MusicVideoArtist)
+	require.True(t, ok, "expected *ogen.MusicVideoArtist, got %T", result)
+	assert.Equal(t, "Norwegian band", artist.Disambiguation.Or(""))
+	assert.True(t, artist.MusicbrainzArtistID.IsSet())
+
+	result, err = handler.GetMusicVideoArtist(ctx, ogen.GetMusicVideoArtistParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetMusicVideoArtistNotFound)
+	assert.True(t, ok, "expected *ogen.GetMusicVideoArtistNotFound, got %T", result)
+}
+
+func TestHandler_MusicVideo_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListMusicVideos(ctx, ogen.ListMusicVideosParams{})
+	require.NoError(t, err)
+	assert.Empty(t, list.(*ogen.MusicVideoListResponse).Items)
+
+	artists, err := handler.ListMusicVideoArtists(ctx, ogen.ListMusicVideoArtistsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, artists.(*ogen.MusicVideoArtistListResponse).Items)
+
+	result, err := handler.GetMusicVideo(ctx, ogen.GetMusicVideoParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetMusicVideoNotFound)
+	assert.True(t, ok)
+}
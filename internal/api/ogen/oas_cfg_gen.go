@@ -17,6 +17,8 @@ import (
 )
 
 var regexMap = map[string]ogenregex.Regexp{
+	"^([0-9]{4,8})?$": ogenregex.MustCompile("^([0-9]{4,8})?$"),
+	"^[0-9]{4,8}$":    ogenregex.MustCompile("^[0-9]{4,8}$"),
 	"^[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}$": ogenregex.MustCompile("^[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}$"),
 	"^[a-zA-Z0-9_-]+$":       ogenregex.MustCompile("^[a-zA-Z0-9_-]+$"),
 	"^[a-z]{2}(-[A-Z]{2})?$": ogenregex.MustCompile("^[a-z]{2}(-[A-Z]{2})?$"),
@@ -2,6 +2,14 @@
 
 package ogen
 
+// setDefaults set default value of fields.
+func (s *AudiobookProgressUpdate) setDefaults() {
+	{
+		val := float32(1)
+		s.PlaybackSpeed.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *ClaimMappings) setDefaults() {
 	{
@@ -26,6 +34,22 @@ func (s *ClaimMappings) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *ClientProfile) setDefaults() {
+	{
+		val := bool(false)
+		s.SupportsHdr10.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.SupportsHlg.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.SupportsDolbyVision.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *CreateLibraryRequest) setDefaults() {
 	{
@@ -70,6 +94,38 @@ func (s *CreateOIDCProviderRequest) setDefaults() {
 	}
 }
 
+// setDefaults set default value of fields.
+func (s *LiveTVSeriesRuleCreate) setDefaults() {
+	{
+		val := bool(false)
+		s.NewOnly.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *LiveTVSourceCreate) setDefaults() {
+	{
+		val := bool(true)
+		s.Enabled.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *ParentalControlsUpdate) setDefaults() {
+	{
+		val := ParentalControlsUpdateUnratedPolicy("allow")
+		s.UnratedPolicy.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *PhotoAlbumShareRequest) setDefaults() {
+	{
+		val := bool(false)
+		s.CanContribute.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *StartPlaybackRequest) setDefaults() {
 	{
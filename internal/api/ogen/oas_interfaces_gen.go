@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package ogen
 
+type AddPhotoAlbumPhotosRes interface {
+	addPhotoAlbumPhotosRes()
+}
+
 type AddPolicyRes interface {
 	addPolicyRes()
 }
@@ -25,10 +29,26 @@ type AdminEnableOIDCProviderRes interface {
 	adminEnableOIDCProviderRes()
 }
 
+type AdminExportMovieNFORes interface {
+	adminExportMovieNFORes()
+}
+
+type AdminExportTVShowNFORes interface {
+	adminExportTVShowNFORes()
+}
+
+type AdminGetMatchQueueItemRes interface {
+	adminGetMatchQueueItemRes()
+}
+
 type AdminGetOIDCProviderRes interface {
 	adminGetOIDCProviderRes()
 }
 
+type AdminGetParentalControlsRes interface {
+	adminGetParentalControlsRes()
+}
+
 type AdminGetRadarrQualityProfilesRes interface {
 	adminGetRadarrQualityProfilesRes()
 }
@@ -53,6 +73,18 @@ type AdminGetSonarrStatusRes interface {
 	adminGetSonarrStatusRes()
 }
 
+type AdminIdentifyMatchQueueItemRes interface {
+	adminIdentifyMatchQueueItemRes()
+}
+
+type AdminIgnoreMatchQueueItemRes interface {
+	adminIgnoreMatchQueueItemRes()
+}
+
+type AdminListMatchQueueRes interface {
+	adminListMatchQueueRes()
+}
+
 type AdminListOIDCProvidersRes interface {
 	adminListOIDCProvidersRes()
 }
@@ -73,10 +105,18 @@ type AdminTriggerSonarrSyncRes interface {
 	adminTriggerSonarrSyncRes()
 }
 
+type AdminUnlockMovieFileMatchRes interface {
+	adminUnlockMovieFileMatchRes()
+}
+
 type AdminUpdateOIDCProviderRes interface {
 	adminUpdateOIDCProviderRes()
 }
 
+type AdminUpdateParentalControlsRes interface {
+	adminUpdateParentalControlsRes()
+}
+
 type AssignRoleRes interface {
 	assignRoleRes()
 }
@@ -97,6 +137,10 @@ type BeginWebAuthnRegistrationRes interface {
 	beginWebAuthnRegistrationRes()
 }
 
+type CancelLibraryScanRes interface {
+	cancelLibraryScanRes()
+}
+
 type ChangePasswordRes interface {
 	changePasswordRes()
 }
@@ -109,14 +153,66 @@ type CreateLibraryRes interface {
 	createLibraryRes()
 }
 
+type CreateLiveTVRecordingRes interface {
+	createLiveTVRecordingRes()
+}
+
+type CreateLiveTVSeriesRuleRes interface {
+	createLiveTVSeriesRuleRes()
+}
+
+type CreateLiveTVSourceRes interface {
+	createLiveTVSourceRes()
+}
+
+type CreatePhotoAlbumRes interface {
+	createPhotoAlbumRes()
+}
+
 type CreateRoleRes interface {
 	createRoleRes()
 }
 
+type DeleteAudiobookProgressRes interface {
+	deleteAudiobookProgressRes()
+}
+
+type DeleteBookProgressRes interface {
+	deleteBookProgressRes()
+}
+
+type DeleteHomeVideoProgressRes interface {
+	deleteHomeVideoProgressRes()
+}
+
 type DeleteLibraryRes interface {
 	deleteLibraryRes()
 }
 
+type DeleteLiveTVRecordingRes interface {
+	deleteLiveTVRecordingRes()
+}
+
+type DeleteLiveTVSeriesRuleRes interface {
+	deleteLiveTVSeriesRuleRes()
+}
+
+type DeleteLiveTVSourceRes interface {
+	deleteLiveTVSourceRes()
+}
+
+type DeletePhotoAlbumRes interface {
+	deletePhotoAlbumRes()
+}
+
+type DeletePodcastEpisodeDownloadRes interface {
+	deletePodcastEpisodeDownloadRes()
+}
+
+type DeletePodcastEpisodeProgressRes interface {
+	deletePodcastEpisodeProgressRes()
+}
+
 type DeleteRoleRes interface {
 	deleteRoleRes()
 }
@@ -145,6 +241,22 @@ type DisableTOTPRes interface {
 	disableTOTPRes()
 }
 
+type DownloadBookFileRes interface {
+	downloadBookFileRes()
+}
+
+type DownloadLegacySceneFileRes interface {
+	downloadLegacySceneFileRes()
+}
+
+type DownloadPhotoFileRes interface {
+	downloadPhotoFileRes()
+}
+
+type DownloadPodcastEpisodeRes interface {
+	downloadPodcastEpisodeRes()
+}
+
 type EnableMFARes interface {
 	enableMFARes()
 }
@@ -173,6 +285,46 @@ type GetActivityStatsRes interface {
 	getActivityStatsRes()
 }
 
+type GetAudiobookAuthorBooksRes interface {
+	getAudiobookAuthorBooksRes()
+}
+
+type GetAudiobookProgressRes interface {
+	getAudiobookProgressRes()
+}
+
+type GetAudiobookRes interface {
+	getAudiobookRes()
+}
+
+type GetAudiobookSeriesBooksRes interface {
+	getAudiobookSeriesBooksRes()
+}
+
+type GetBookAuthorBooksRes interface {
+	getBookAuthorBooksRes()
+}
+
+type GetBookCoverRes interface {
+	getBookCoverRes()
+}
+
+type GetBookPageRes interface {
+	getBookPageRes()
+}
+
+type GetBookProgressRes interface {
+	getBookProgressRes()
+}
+
+type GetBookRes interface {
+	getBookRes()
+}
+
+type GetBookSeriesBooksRes interface {
+	getBookSeriesBooksRes()
+}
+
 type GetCollectionMetadataRes interface {
 	getCollectionMetadataRes()
 }
@@ -185,6 +337,14 @@ type GetCollectionRes interface {
 	getCollectionRes()
 }
 
+type GetContinueListeningRes interface {
+	getContinueListeningRes()
+}
+
+type GetContinueReadingRes interface {
+	getContinueReadingRes()
+}
+
 type GetContinueWatchingRes interface {
 	getContinueWatchingRes()
 }
@@ -205,10 +365,66 @@ type GetEpisodeMetadataRes interface {
 	getEpisodeMetadataRes()
 }
 
+type GetHomeFeedRes interface {
+	getHomeFeedRes()
+}
+
+type GetHomeFeedRowRes interface {
+	getHomeFeedRowRes()
+}
+
+type GetHomeVideoFolderRes interface {
+	getHomeVideoFolderRes()
+}
+
+type GetHomeVideoProgressRes interface {
+	getHomeVideoProgressRes()
+}
+
+type GetHomeVideoRes interface {
+	getHomeVideoRes()
+}
+
+type GetHomeVideoThumbnailRes interface {
+	getHomeVideoThumbnailRes()
+}
+
+type GetLegacyAccessRes interface {
+	getLegacyAccessRes()
+}
+
+type GetLegacyPerformerRes interface {
+	getLegacyPerformerRes()
+}
+
+type GetLegacySceneRes interface {
+	getLegacySceneRes()
+}
+
+type GetLegacyStudioRes interface {
+	getLegacyStudioRes()
+}
+
 type GetLibraryRes interface {
 	getLibraryRes()
 }
 
+type GetLiveTVChannelRes interface {
+	getLiveTVChannelRes()
+}
+
+type GetLiveTVGuideRes interface {
+	getLiveTVGuideRes()
+}
+
+type GetLiveTVProgramRes interface {
+	getLiveTVProgramRes()
+}
+
+type GetLiveTVRecordingRes interface {
+	getLiveTVRecordingRes()
+}
+
 type GetMFAStatusRes interface {
 	getMFAStatusRes()
 }
@@ -225,10 +441,18 @@ type GetMovieCrewRes interface {
 	getMovieCrewRes()
 }
 
+type GetMovieEditionsRes interface {
+	getMovieEditionsRes()
+}
+
 type GetMovieExternalIDsRes interface {
 	getMovieExternalIDsRes()
 }
 
+type GetMovieExtrasRes interface {
+	getMovieExtrasRes()
+}
+
 type GetMovieFilesRes interface {
 	getMovieFilesRes()
 }
@@ -257,6 +481,50 @@ type GetMovieRes interface {
 	getMovieRes()
 }
 
+type GetMovieTagsRes interface {
+	getMovieTagsRes()
+}
+
+type GetMusicAlbumEditionsRes interface {
+	getMusicAlbumEditionsRes()
+}
+
+type GetMusicAlbumRes interface {
+	getMusicAlbumRes()
+}
+
+type GetMusicArtistAlbumsRes interface {
+	getMusicArtistAlbumsRes()
+}
+
+type GetMusicArtistRes interface {
+	getMusicArtistRes()
+}
+
+type GetMusicArtistTracksRes interface {
+	getMusicArtistTracksRes()
+}
+
+type GetMusicTrackRes interface {
+	getMusicTrackRes()
+}
+
+type GetMusicVideoArtistRes interface {
+	getMusicVideoArtistRes()
+}
+
+type GetMusicVideoRes interface {
+	getMusicVideoRes()
+}
+
+type GetMyParentalControlsRes interface {
+	getMyParentalControlsRes()
+}
+
+type GetPersonFilmographyRes interface {
+	getPersonFilmographyRes()
+}
+
 type GetPersonMetadataCreditsRes interface {
 	getPersonMetadataCreditsRes()
 }
@@ -269,10 +537,46 @@ type GetPersonMetadataRes interface {
 	getPersonMetadataRes()
 }
 
+type GetPersonRes interface {
+	getPersonRes()
+}
+
+type GetPhotoAlbumRes interface {
+	getPhotoAlbumRes()
+}
+
+type GetPhotoRes interface {
+	getPhotoRes()
+}
+
+type GetPhotoThumbnailRes interface {
+	getPhotoThumbnailRes()
+}
+
+type GetPhotoTimelineRes interface {
+	getPhotoTimelineRes()
+}
+
 type GetPlaybackSessionRes interface {
 	getPlaybackSessionRes()
 }
 
+type GetPodcastEpisodeProgressRes interface {
+	getPodcastEpisodeProgressRes()
+}
+
+type GetPodcastEpisodeRes interface {
+	getPodcastEpisodeRes()
+}
+
+type GetPodcastEpisodeTranscriptRes interface {
+	getPodcastEpisodeTranscriptRes()
+}
+
+type GetPodcastRes interface {
+	getPodcastRes()
+}
+
 type GetProxiedImageRes interface {
 	getProxiedImageRes()
 }
@@ -413,6 +717,10 @@ type GetTVShowSeasonsRes interface {
 	getTVShowSeasonsRes()
 }
 
+type GetTVShowTagsRes interface {
+	getTVShowTagsRes()
+}
+
 type GetTVShowWatchStatsRes interface {
 	getTVShowWatchStatsRes()
 }
@@ -473,6 +781,10 @@ type HandleSonarrWebhookRes interface {
 	handleSonarrWebhookRes()
 }
 
+type HeartbeatPlaybackSessionRes interface {
+	heartbeatPlaybackSessionRes()
+}
+
 type InitOIDCLinkRes interface {
 	initOIDCLinkRes()
 }
@@ -481,10 +793,58 @@ type ListAPIKeysRes interface {
 	listAPIKeysRes()
 }
 
+type ListAudiobookAuthorsRes interface {
+	listAudiobookAuthorsRes()
+}
+
+type ListAudiobookSeriesRes interface {
+	listAudiobookSeriesRes()
+}
+
+type ListAudiobooksRes interface {
+	listAudiobooksRes()
+}
+
+type ListBookAuthorsRes interface {
+	listBookAuthorsRes()
+}
+
+type ListBookSeriesRes interface {
+	listBookSeriesRes()
+}
+
+type ListBooksRes interface {
+	listBooksRes()
+}
+
 type ListGenresRes interface {
 	listGenresRes()
 }
 
+type ListHomeVideoContinueWatchingRes interface {
+	listHomeVideoContinueWatchingRes()
+}
+
+type ListHomeVideoFoldersRes interface {
+	listHomeVideoFoldersRes()
+}
+
+type ListHomeVideosRes interface {
+	listHomeVideosRes()
+}
+
+type ListLegacyPerformersRes interface {
+	listLegacyPerformersRes()
+}
+
+type ListLegacyScenesRes interface {
+	listLegacyScenesRes()
+}
+
+type ListLegacyStudiosRes interface {
+	listLegacyStudiosRes()
+}
+
 type ListLibrariesRes interface {
 	listLibrariesRes()
 }
@@ -497,18 +857,90 @@ type ListLibraryScansRes interface {
 	listLibraryScansRes()
 }
 
+type ListLiveTVChannelsRes interface {
+	listLiveTVChannelsRes()
+}
+
+type ListLiveTVRecordingsRes interface {
+	listLiveTVRecordingsRes()
+}
+
+type ListLiveTVSeriesRulesRes interface {
+	listLiveTVSeriesRulesRes()
+}
+
+type ListLiveTVSourcesRes interface {
+	listLiveTVSourcesRes()
+}
+
 type ListMetadataProvidersRes interface {
 	listMetadataProvidersRes()
 }
 
+type ListMovieArtworkRes interface {
+	listMovieArtworkRes()
+}
+
+type ListMovieMetadataHistoryRes interface {
+	listMovieMetadataHistoryRes()
+}
+
+type ListMoviesByTagRes interface {
+	listMoviesByTagRes()
+}
+
 type ListMoviesRes interface {
 	listMoviesRes()
 }
 
+type ListMusicAlbumsRes interface {
+	listMusicAlbumsRes()
+}
+
+type ListMusicArtistsRes interface {
+	listMusicArtistsRes()
+}
+
+type ListMusicVideoArtistsRes interface {
+	listMusicVideoArtistsRes()
+}
+
+type ListMusicVideosRes interface {
+	listMusicVideosRes()
+}
+
+type ListNewPodcastEpisodesRes interface {
+	listNewPodcastEpisodesRes()
+}
+
 type ListPermissionsRes interface {
 	listPermissionsRes()
 }
 
+type ListPhotoAlbumPhotosRes interface {
+	listPhotoAlbumPhotosRes()
+}
+
+type ListPhotoAlbumSharesRes interface {
+	listPhotoAlbumSharesRes()
+}
+
+type ListPhotoAlbumsRes interface {
+	listPhotoAlbumsRes()
+}
+
+type ListPhotosRes interface {
+	listPhotosRes()
+}
+
+type ListPodcastEpisodesRes interface {
+	listPodcastEpisodesRes()
+}
+
+type ListPodcastsRes interface {
+	listPodcastsRes()
+}
+
 type ListPoliciesRes interface {
 	listPoliciesRes()
 }
@@ -525,10 +957,34 @@ type ListSessionsRes interface {
 	listSessionsRes()
 }
 
+type ListTVEpisodeMetadataHistoryRes interface {
+	listTVEpisodeMetadataHistoryRes()
+}
+
+type ListTVSeasonMetadataHistoryRes interface {
+	listTVSeasonMetadataHistoryRes()
+}
+
+type ListTVShowArtworkRes interface {
+	listTVShowArtworkRes()
+}
+
+type ListTVShowMetadataHistoryRes interface {
+	listTVShowMetadataHistoryRes()
+}
+
+type ListTVShowsByTagRes interface {
+	listTVShowsByTagRes()
+}
+
 type ListTVShowsRes interface {
 	listTVShowsRes()
 }
 
+type ListTagsRes interface {
+	listTagsRes()
+}
+
 type ListUserOIDCLinksRes interface {
 	listUserOIDCLinksRes()
 }
@@ -541,6 +997,10 @@ type ListWebAuthnCredentialsRes interface {
 	listWebAuthnCredentialsRes()
 }
 
+type LockLegacyRes interface {
+	lockLegacyRes()
+}
+
 type LoginRes interface {
 	loginRes()
 }
@@ -577,10 +1037,22 @@ type OidcCallbackRes interface {
 	oidcCallbackRes()
 }
 
+type RefreshLiveTVSourceRes interface {
+	refreshLiveTVSourceRes()
+}
+
 type RefreshMovieMetadataRes interface {
 	refreshMovieMetadataRes()
 }
 
+type RefreshPersonRes interface {
+	refreshPersonRes()
+}
+
+type RefreshPodcastRes interface {
+	refreshPodcastRes()
+}
+
 type RefreshSessionRes interface {
 	refreshSessionRes()
 }
@@ -609,6 +1081,10 @@ type ReindexTVShowSearchRes interface {
 	reindexTVShowSearchRes()
 }
 
+type RemovePhotoAlbumPhotoRes interface {
+	removePhotoAlbumPhotoRes()
+}
+
 type RemovePolicyRes interface {
 	removePolicyRes()
 }
@@ -641,14 +1117,38 @@ type RevokeSessionRes interface {
 	revokeSessionRes()
 }
 
+type RollbackMovieMetadataRes interface {
+	rollbackMovieMetadataRes()
+}
+
+type RollbackTVEpisodeMetadataRes interface {
+	rollbackTVEpisodeMetadataRes()
+}
+
+type RollbackTVSeasonMetadataRes interface {
+	rollbackTVSeasonMetadataRes()
+}
+
+type RollbackTVShowMetadataRes interface {
+	rollbackTVShowMetadataRes()
+}
+
 type SearchActivityLogsRes interface {
 	searchActivityLogsRes()
 }
 
+type SearchLibraryHomeVideosRes interface {
+	searchLibraryHomeVideosRes()
+}
+
 type SearchLibraryMoviesRes interface {
 	searchLibraryMoviesRes()
 }
 
+type SearchLibraryMusicRes interface {
+	searchLibraryMusicRes()
+}
+
 type SearchLibraryTVShowsRes interface {
 	searchLibraryTVShowsRes()
 }
@@ -677,10 +1177,26 @@ type SearchTVShowsRes interface {
 	searchTVShowsRes()
 }
 
+type SelectMovieArtworkRes interface {
+	selectMovieArtworkRes()
+}
+
+type SelectTVShowArtworkRes interface {
+	selectTVShowArtworkRes()
+}
+
+type SetLegacyPINRes interface {
+	setLegacyPINRes()
+}
+
 type SetupTOTPRes interface {
 	setupTOTPRes()
 }
 
+type SharePhotoAlbumRes interface {
+	sharePhotoAlbumRes()
+}
+
 type StartPlaybackSessionRes interface {
 	startPlaybackSessionRes()
 }
@@ -689,6 +1205,14 @@ type StopPlaybackSessionRes interface {
 	stopPlaybackSessionRes()
 }
 
+type SubscribePodcastFeedRes interface {
+	subscribePodcastFeedRes()
+}
+
+type SubscribePodcastRes interface {
+	subscribePodcastRes()
+}
+
 type TriggerLibraryScanRes interface {
 	triggerLibraryScanRes()
 }
@@ -697,14 +1221,78 @@ type UnlinkOIDCProviderRes interface {
 	unlinkOIDCProviderRes()
 }
 
+type UnlockLegacyRes interface {
+	unlockLegacyRes()
+}
+
+type UnlockMovieMetadataFieldRes interface {
+	unlockMovieMetadataFieldRes()
+}
+
+type UnlockParentalControlsRes interface {
+	unlockParentalControlsRes()
+}
+
+type UnlockTVEpisodeMetadataFieldRes interface {
+	unlockTVEpisodeMetadataFieldRes()
+}
+
+type UnlockTVSeasonMetadataFieldRes interface {
+	unlockTVSeasonMetadataFieldRes()
+}
+
+type UnlockTVShowMetadataFieldRes interface {
+	unlockTVShowMetadataFieldRes()
+}
+
+type UnsharePhotoAlbumRes interface {
+	unsharePhotoAlbumRes()
+}
+
+type UnsubscribePodcastRes interface {
+	unsubscribePodcastRes()
+}
+
+type UpdateAudiobookProgressRes interface {
+	updateAudiobookProgressRes()
+}
+
+type UpdateBookProgressRes interface {
+	updateBookProgressRes()
+}
+
 type UpdateCurrentUserRes interface {
 	updateCurrentUserRes()
 }
 
+type UpdateHomeVideoProgressRes interface {
+	updateHomeVideoProgressRes()
+}
+
 type UpdateLibraryRes interface {
 	updateLibraryRes()
 }
 
+type UpdateLiveTVChannelRes interface {
+	updateLiveTVChannelRes()
+}
+
+type UpdateMovieMetadataRes interface {
+	updateMovieMetadataRes()
+}
+
+type UpdatePhotoAlbumRes interface {
+	updatePhotoAlbumRes()
+}
+
+type UpdatePodcastEpisodeProgressRes interface {
+	updatePodcastEpisodeProgressRes()
+}
+
+type UpdatePodcastSettingsRes interface {
+	updatePodcastSettingsRes()
+}
+
 type UpdateRolePermissionsRes interface {
 	updateRolePermissionsRes()
 }
@@ -713,10 +1301,22 @@ type UpdateServerSettingRes interface {
 	updateServerSettingRes()
 }
 
+type UpdateTVEpisodeMetadataRes interface {
+	updateTVEpisodeMetadataRes()
+}
+
 type UpdateTVEpisodeProgressRes interface {
 	updateTVEpisodeProgressRes()
 }
 
+type UpdateTVSeasonMetadataRes interface {
+	updateTVSeasonMetadataRes()
+}
+
+type UpdateTVShowMetadataRes interface {
+	updateTVShowMetadataRes()
+}
+
 type UpdateUserPreferencesRes interface {
 	updateUserPreferencesRes()
 }
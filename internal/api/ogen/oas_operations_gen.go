@@ -6,35 +6,59 @@ package ogen
 type OperationName = string
 
 const (
+	AddPhotoAlbumPhotosOperation             OperationName = "AddPhotoAlbumPhotos"
 	AddPolicyOperation                       OperationName = "AddPolicy"
 	AdminCreateOIDCProviderOperation         OperationName = "AdminCreateOIDCProvider"
 	AdminDeleteOIDCProviderOperation         OperationName = "AdminDeleteOIDCProvider"
 	AdminDeleteUserOperation                 OperationName = "AdminDeleteUser"
 	AdminDisableOIDCProviderOperation        OperationName = "AdminDisableOIDCProvider"
 	AdminEnableOIDCProviderOperation         OperationName = "AdminEnableOIDCProvider"
+	AdminExportMovieNFOOperation             OperationName = "AdminExportMovieNFO"
+	AdminExportTVShowNFOOperation            OperationName = "AdminExportTVShowNFO"
+	AdminGetMatchQueueItemOperation          OperationName = "AdminGetMatchQueueItem"
 	AdminGetOIDCProviderOperation            OperationName = "AdminGetOIDCProvider"
+	AdminGetParentalControlsOperation        OperationName = "AdminGetParentalControls"
 	AdminGetRadarrQualityProfilesOperation   OperationName = "AdminGetRadarrQualityProfiles"
 	AdminGetRadarrRootFoldersOperation       OperationName = "AdminGetRadarrRootFolders"
 	AdminGetRadarrStatusOperation            OperationName = "AdminGetRadarrStatus"
 	AdminGetSonarrQualityProfilesOperation   OperationName = "AdminGetSonarrQualityProfiles"
 	AdminGetSonarrRootFoldersOperation       OperationName = "AdminGetSonarrRootFolders"
 	AdminGetSonarrStatusOperation            OperationName = "AdminGetSonarrStatus"
+	AdminIdentifyMatchQueueItemOperation     OperationName = "AdminIdentifyMatchQueueItem"
+	AdminIgnoreMatchQueueItemOperation       OperationName = "AdminIgnoreMatchQueueItem"
+	AdminListMatchQueueOperation             OperationName = "AdminListMatchQueue"
 	AdminListOIDCProvidersOperation          OperationName = "AdminListOIDCProviders"
 	AdminListUsersOperation                  OperationName = "AdminListUsers"
 	AdminSetDefaultOIDCProviderOperation     OperationName = "AdminSetDefaultOIDCProvider"
 	AdminTriggerRadarrSyncOperation          OperationName = "AdminTriggerRadarrSync"
 	AdminTriggerSonarrSyncOperation          OperationName = "AdminTriggerSonarrSync"
+	AdminUnlockMovieFileMatchOperation       OperationName = "AdminUnlockMovieFileMatch"
 	AdminUpdateOIDCProviderOperation         OperationName = "AdminUpdateOIDCProvider"
+	AdminUpdateParentalControlsOperation     OperationName = "AdminUpdateParentalControls"
 	AssignRoleOperation                      OperationName = "AssignRole"
 	AutocompleteMoviesOperation              OperationName = "AutocompleteMovies"
 	AutocompleteTVShowsOperation             OperationName = "AutocompleteTVShows"
 	BeginWebAuthnLoginOperation              OperationName = "BeginWebAuthnLogin"
 	BeginWebAuthnRegistrationOperation       OperationName = "BeginWebAuthnRegistration"
+	CancelLibraryScanOperation               OperationName = "CancelLibraryScan"
 	ChangePasswordOperation                  OperationName = "ChangePassword"
 	CreateAPIKeyOperation                    OperationName = "CreateAPIKey"
 	CreateLibraryOperation                   OperationName = "CreateLibrary"
+	CreateLiveTVRecordingOperation           OperationName = "CreateLiveTVRecording"
+	CreateLiveTVSeriesRuleOperation          OperationName = "CreateLiveTVSeriesRule"
+	CreateLiveTVSourceOperation              OperationName = "CreateLiveTVSource"
+	CreatePhotoAlbumOperation                OperationName = "CreatePhotoAlbum"
 	CreateRoleOperation                      OperationName = "CreateRole"
+	DeleteAudiobookProgressOperation         OperationName = "DeleteAudiobookProgress"
+	DeleteBookProgressOperation              OperationName = "DeleteBookProgress"
+	DeleteHomeVideoProgressOperation         OperationName = "DeleteHomeVideoProgress"
 	DeleteLibraryOperation                   OperationName = "DeleteLibrary"
+	DeleteLiveTVRecordingOperation           OperationName = "DeleteLiveTVRecording"
+	DeleteLiveTVSeriesRuleOperation          OperationName = "DeleteLiveTVSeriesRule"
+	DeleteLiveTVSourceOperation              OperationName = "DeleteLiveTVSource"
+	DeletePhotoAlbumOperation                OperationName = "DeletePhotoAlbum"
+	DeletePodcastEpisodeDownloadOperation    OperationName = "DeletePodcastEpisodeDownload"
+	DeletePodcastEpisodeProgressOperation    OperationName = "DeletePodcastEpisodeProgress"
 	DeleteRoleOperation                      OperationName = "DeleteRole"
 	DeleteTVEpisodeProgressOperation         OperationName = "DeleteTVEpisodeProgress"
 	DeleteUserSettingOperation               OperationName = "DeleteUserSetting"
@@ -42,6 +66,10 @@ const (
 	DeleteWebAuthnCredentialOperation        OperationName = "DeleteWebAuthnCredential"
 	DisableMFAOperation                      OperationName = "DisableMFA"
 	DisableTOTPOperation                     OperationName = "DisableTOTP"
+	DownloadBookFileOperation                OperationName = "DownloadBookFile"
+	DownloadLegacySceneFileOperation         OperationName = "DownloadLegacySceneFile"
+	DownloadPhotoFileOperation               OperationName = "DownloadPhotoFile"
+	DownloadPodcastEpisodeOperation          OperationName = "DownloadPodcastEpisode"
 	EnableMFAOperation                       OperationName = "EnableMFA"
 	FinishWebAuthnLoginOperation             OperationName = "FinishWebAuthnLogin"
 	FinishWebAuthnRegistrationOperation      OperationName = "FinishWebAuthnRegistration"
@@ -49,32 +77,80 @@ const (
 	GenerateBackupCodesOperation             OperationName = "GenerateBackupCodes"
 	GetAPIKeyOperation                       OperationName = "GetAPIKey"
 	GetActivityStatsOperation                OperationName = "GetActivityStats"
+	GetAudiobookOperation                    OperationName = "GetAudiobook"
+	GetAudiobookAuthorBooksOperation         OperationName = "GetAudiobookAuthorBooks"
+	GetAudiobookProgressOperation            OperationName = "GetAudiobookProgress"
+	GetAudiobookSeriesBooksOperation         OperationName = "GetAudiobookSeriesBooks"
+	GetBookOperation                         OperationName = "GetBook"
+	GetBookAuthorBooksOperation              OperationName = "GetBookAuthorBooks"
+	GetBookCoverOperation                    OperationName = "GetBookCover"
+	GetBookPageOperation                     OperationName = "GetBookPage"
+	GetBookProgressOperation                 OperationName = "GetBookProgress"
+	GetBookSeriesBooksOperation              OperationName = "GetBookSeriesBooks"
 	GetCollectionOperation                   OperationName = "GetCollection"
 	GetCollectionMetadataOperation           OperationName = "GetCollectionMetadata"
 	GetCollectionMoviesOperation             OperationName = "GetCollectionMovies"
+	GetContinueListeningOperation            OperationName = "GetContinueListening"
+	GetContinueReadingOperation              OperationName = "GetContinueReading"
 	GetContinueWatchingOperation             OperationName = "GetContinueWatching"
 	GetCurrentSessionOperation               OperationName = "GetCurrentSession"
 	GetCurrentUserOperation                  OperationName = "GetCurrentUser"
 	GetEpisodeMetadataOperation              OperationName = "GetEpisodeMetadata"
 	GetEpisodeMetadataImagesOperation        OperationName = "GetEpisodeMetadataImages"
+	GetHomeFeedOperation                     OperationName = "GetHomeFeed"
+	GetHomeFeedRowOperation                  OperationName = "GetHomeFeedRow"
+	GetHomeVideoOperation                    OperationName = "GetHomeVideo"
+	GetHomeVideoFolderOperation              OperationName = "GetHomeVideoFolder"
+	GetHomeVideoProgressOperation            OperationName = "GetHomeVideoProgress"
+	GetHomeVideoThumbnailOperation           OperationName = "GetHomeVideoThumbnail"
+	GetLegacyAccessOperation                 OperationName = "GetLegacyAccess"
+	GetLegacyPerformerOperation              OperationName = "GetLegacyPerformer"
+	GetLegacySceneOperation                  OperationName = "GetLegacyScene"
+	GetLegacyStudioOperation                 OperationName = "GetLegacyStudio"
 	GetLibraryOperation                      OperationName = "GetLibrary"
+	GetLiveTVChannelOperation                OperationName = "GetLiveTVChannel"
+	GetLiveTVGuideOperation                  OperationName = "GetLiveTVGuide"
+	GetLiveTVProgramOperation                OperationName = "GetLiveTVProgram"
+	GetLiveTVRecordingOperation              OperationName = "GetLiveTVRecording"
 	GetLivenessOperation                     OperationName = "GetLiveness"
 	GetMFAStatusOperation                    OperationName = "GetMFAStatus"
 	GetMovieOperation                        OperationName = "GetMovie"
 	GetMovieCastOperation                    OperationName = "GetMovieCast"
 	GetMovieCollectionOperation              OperationName = "GetMovieCollection"
 	GetMovieCrewOperation                    OperationName = "GetMovieCrew"
+	GetMovieEditionsOperation                OperationName = "GetMovieEditions"
 	GetMovieExternalIDsOperation             OperationName = "GetMovieExternalIDs"
+	GetMovieExtrasOperation                  OperationName = "GetMovieExtras"
 	GetMovieFilesOperation                   OperationName = "GetMovieFiles"
 	GetMovieGenresOperation                  OperationName = "GetMovieGenres"
 	GetMovieMetadataOperation                OperationName = "GetMovieMetadata"
 	GetMovieMetadataCreditsOperation         OperationName = "GetMovieMetadataCredits"
 	GetMovieMetadataImagesOperation          OperationName = "GetMovieMetadataImages"
 	GetMovieRecommendationsMetadataOperation OperationName = "GetMovieRecommendationsMetadata"
+	GetMovieTagsOperation                    OperationName = "GetMovieTags"
+	GetMusicAlbumOperation                   OperationName = "GetMusicAlbum"
+	GetMusicAlbumEditionsOperation           OperationName = "GetMusicAlbumEditions"
+	GetMusicArtistOperation                  OperationName = "GetMusicArtist"
+	GetMusicArtistAlbumsOperation            OperationName = "GetMusicArtistAlbums"
+	GetMusicArtistTracksOperation            OperationName = "GetMusicArtistTracks"
+	GetMusicTrackOperation                   OperationName = "GetMusicTrack"
+	GetMusicVideoOperation                   OperationName = "GetMusicVideo"
+	GetMusicVideoArtistOperation             OperationName = "GetMusicVideoArtist"
+	GetMyParentalControlsOperation           OperationName = "GetMyParentalControls"
+	GetPersonOperation                       OperationName = "GetPerson"
+	GetPersonFilmographyOperation            OperationName = "GetPersonFilmography"
 	GetPersonMetadataOperation               OperationName = "GetPersonMetadata"
 	GetPersonMetadataCreditsOperation        OperationName = "GetPersonMetadataCredits"
 	GetPersonMetadataImagesOperation         OperationName = "GetPersonMetadataImages"
+	GetPhotoOperation                        OperationName = "GetPhoto"
+	GetPhotoAlbumOperation                   OperationName = "GetPhotoAlbum"
+	GetPhotoThumbnailOperation               OperationName = "GetPhotoThumbnail"
+	GetPhotoTimelineOperation                OperationName = "GetPhotoTimeline"
 	GetPlaybackSessionOperation              OperationName = "GetPlaybackSession"
+	GetPodcastOperation                      OperationName = "GetPodcast"
+	GetPodcastEpisodeOperation               OperationName = "GetPodcastEpisode"
+	GetPodcastEpisodeProgressOperation       OperationName = "GetPodcastEpisodeProgress"
+	GetPodcastEpisodeTranscriptOperation     OperationName = "GetPodcastEpisodeTranscript"
 	GetProxiedImageOperation                 OperationName = "GetProxiedImage"
 	GetReadinessOperation                    OperationName = "GetReadiness"
 	GetRecentActionsOperation                OperationName = "GetRecentActions"
@@ -110,6 +186,7 @@ const (
 	GetTVShowNextEpisodeOperation            OperationName = "GetTVShowNextEpisode"
 	GetTVShowSearchFacetsOperation           OperationName = "GetTVShowSearchFacets"
 	GetTVShowSeasonsOperation                OperationName = "GetTVShowSeasons"
+	GetTVShowTagsOperation                   OperationName = "GetTVShowTags"
 	GetTVShowWatchStatsOperation             OperationName = "GetTVShowWatchStats"
 	GetTopRatedOperation                     OperationName = "GetTopRated"
 	GetUpcomingEpisodesOperation             OperationName = "GetUpcomingEpisodes"
@@ -125,24 +202,62 @@ const (
 	GrantLibraryPermissionOperation          OperationName = "GrantLibraryPermission"
 	HandleRadarrWebhookOperation             OperationName = "HandleRadarrWebhook"
 	HandleSonarrWebhookOperation             OperationName = "HandleSonarrWebhook"
+	HeartbeatPlaybackSessionOperation        OperationName = "HeartbeatPlaybackSession"
 	InitOIDCLinkOperation                    OperationName = "InitOIDCLink"
 	ListAPIKeysOperation                     OperationName = "ListAPIKeys"
+	ListAudiobookAuthorsOperation            OperationName = "ListAudiobookAuthors"
+	ListAudiobookSeriesOperation             OperationName = "ListAudiobookSeries"
+	ListAudiobooksOperation                  OperationName = "ListAudiobooks"
+	ListBookAuthorsOperation                 OperationName = "ListBookAuthors"
+	ListBookSeriesOperation                  OperationName = "ListBookSeries"
+	ListBooksOperation                       OperationName = "ListBooks"
 	ListGenresOperation                      OperationName = "ListGenres"
+	ListHomeVideoContinueWatchingOperation   OperationName = "ListHomeVideoContinueWatching"
+	ListHomeVideoFoldersOperation            OperationName = "ListHomeVideoFolders"
+	ListHomeVideosOperation                  OperationName = "ListHomeVideos"
+	ListLegacyPerformersOperation            OperationName = "ListLegacyPerformers"
+	ListLegacyScenesOperation                OperationName = "ListLegacyScenes"
+	ListLegacyStudiosOperation               OperationName = "ListLegacyStudios"
 	ListLibrariesOperation                   OperationName = "ListLibraries"
 	ListLibraryPermissionsOperation          OperationName = "ListLibraryPermissions"
 	ListLibraryScansOperation                OperationName = "ListLibraryScans"
+	ListLiveTVChannelsOperation              OperationName = "ListLiveTVChannels"
+	ListLiveTVRecordingsOperation            OperationName = "ListLiveTVRecordings"
+	ListLiveTVSeriesRulesOperation           OperationName = "ListLiveTVSeriesRules"
+	ListLiveTVSourcesOperation               OperationName = "ListLiveTVSources"
 	ListMetadataProvidersOperation           OperationName = "ListMetadataProviders"
+	ListMovieArtworkOperation                OperationName = "ListMovieArtwork"
+	ListMovieMetadataHistoryOperation        OperationName = "ListMovieMetadataHistory"
 	ListMoviesOperation                      OperationName = "ListMovies"
+	ListMoviesByTagOperation                 OperationName = "ListMoviesByTag"
+	ListMusicAlbumsOperation                 OperationName = "ListMusicAlbums"
+	ListMusicArtistsOperation                OperationName = "ListMusicArtists"
+	ListMusicVideoArtistsOperation           OperationName = "ListMusicVideoArtists"
+	ListMusicVideosOperation                 OperationName = "ListMusicVideos"
+	ListNewPodcastEpisodesOperation          OperationName = "ListNewPodcastEpisodes"
 	ListOIDCProvidersOperation               OperationName = "ListOIDCProviders"
 	ListPermissionsOperation                 OperationName = "ListPermissions"
+	ListPhotoAlbumPhotosOperation            OperationName = "ListPhotoAlbumPhotos"
+	ListPhotoAlbumSharesOperation            OperationName = "ListPhotoAlbumShares"
+	ListPhotoAlbumsOperation                 OperationName = "ListPhotoAlbums"
+	ListPhotosOperation                      OperationName = "ListPhotos"
+	ListPodcastEpisodesOperation             OperationName = "ListPodcastEpisodes"
+	ListPodcastsOperation                    OperationName = "ListPodcasts"
 	ListPoliciesOperation                    OperationName = "ListPolicies"
 	ListRolesOperation                       OperationName = "ListRoles"
 	ListServerSettingsOperation              OperationName = "ListServerSettings"
 	ListSessionsOperation                    OperationName = "ListSessions"
+	ListTVEpisodeMetadataHistoryOperation    OperationName = "ListTVEpisodeMetadataHistory"
+	ListTVSeasonMetadataHistoryOperation     OperationName = "ListTVSeasonMetadataHistory"
+	ListTVShowArtworkOperation               OperationName = "ListTVShowArtwork"
+	ListTVShowMetadataHistoryOperation       OperationName = "ListTVShowMetadataHistory"
 	ListTVShowsOperation                     OperationName = "ListTVShows"
+	ListTVShowsByTagOperation                OperationName = "ListTVShowsByTag"
+	ListTagsOperation                        OperationName = "ListTags"
 	ListUserOIDCLinksOperation               OperationName = "ListUserOIDCLinks"
 	ListUserSettingsOperation                OperationName = "ListUserSettings"
 	ListWebAuthnCredentialsOperation         OperationName = "ListWebAuthnCredentials"
+	LockLegacyOperation                      OperationName = "LockLegacy"
 	LoginOperation                           OperationName = "Login"
 	LogoutOperation                          OperationName = "Logout"
 	LogoutAllOperation                       OperationName = "LogoutAll"
@@ -152,7 +267,10 @@ const (
 	MarkTVEpisodesBulkWatchedOperation       OperationName = "MarkTVEpisodesBulkWatched"
 	OidcAuthorizeOperation                   OperationName = "OidcAuthorize"
 	OidcCallbackOperation                    OperationName = "OidcCallback"
+	RefreshLiveTVSourceOperation             OperationName = "RefreshLiveTVSource"
 	RefreshMovieMetadataOperation            OperationName = "RefreshMovieMetadata"
+	RefreshPersonOperation                   OperationName = "RefreshPerson"
+	RefreshPodcastOperation                  OperationName = "RefreshPodcast"
 	RefreshSessionOperation                  OperationName = "RefreshSession"
 	RefreshTVShowMetadataOperation           OperationName = "RefreshTVShowMetadata"
 	RefreshTokenOperation                    OperationName = "RefreshToken"
@@ -160,6 +278,7 @@ const (
 	RegisterOperation                        OperationName = "Register"
 	ReindexSearchOperation                   OperationName = "ReindexSearch"
 	ReindexTVShowSearchOperation             OperationName = "ReindexTVShowSearch"
+	RemovePhotoAlbumPhotoOperation           OperationName = "RemovePhotoAlbumPhoto"
 	RemovePolicyOperation                    OperationName = "RemovePolicy"
 	RemoveRoleOperation                      OperationName = "RemoveRole"
 	RenameWebAuthnCredentialOperation        OperationName = "RenameWebAuthnCredential"
@@ -168,8 +287,14 @@ const (
 	RevokeAPIKeyOperation                    OperationName = "RevokeAPIKey"
 	RevokeLibraryPermissionOperation         OperationName = "RevokeLibraryPermission"
 	RevokeSessionOperation                   OperationName = "RevokeSession"
+	RollbackMovieMetadataOperation           OperationName = "RollbackMovieMetadata"
+	RollbackTVEpisodeMetadataOperation       OperationName = "RollbackTVEpisodeMetadata"
+	RollbackTVSeasonMetadataOperation        OperationName = "RollbackTVSeasonMetadata"
+	RollbackTVShowMetadataOperation          OperationName = "RollbackTVShowMetadata"
 	SearchActivityLogsOperation              OperationName = "SearchActivityLogs"
+	SearchLibraryHomeVideosOperation         OperationName = "SearchLibraryHomeVideos"
 	SearchLibraryMoviesOperation             OperationName = "SearchLibraryMovies"
+	SearchLibraryMusicOperation              OperationName = "SearchLibraryMusic"
 	SearchLibraryTVShowsOperation            OperationName = "SearchLibraryTVShows"
 	SearchMoviesOperation                    OperationName = "SearchMovies"
 	SearchMoviesMetadataOperation            OperationName = "SearchMoviesMetadata"
@@ -177,16 +302,41 @@ const (
 	SearchPersonMetadataOperation            OperationName = "SearchPersonMetadata"
 	SearchTVShowsOperation                   OperationName = "SearchTVShows"
 	SearchTVShowsMetadataOperation           OperationName = "SearchTVShowsMetadata"
+	SelectMovieArtworkOperation              OperationName = "SelectMovieArtwork"
+	SelectTVShowArtworkOperation             OperationName = "SelectTVShowArtwork"
+	SetLegacyPINOperation                    OperationName = "SetLegacyPIN"
 	SetupTOTPOperation                       OperationName = "SetupTOTP"
+	SharePhotoAlbumOperation                 OperationName = "SharePhotoAlbum"
 	StartPlaybackSessionOperation            OperationName = "StartPlaybackSession"
 	StopPlaybackSessionOperation             OperationName = "StopPlaybackSession"
+	SubscribePodcastOperation                OperationName = "SubscribePodcast"
+	SubscribePodcastFeedOperation            OperationName = "SubscribePodcastFeed"
 	TriggerLibraryScanOperation              OperationName = "TriggerLibraryScan"
 	UnlinkOIDCProviderOperation              OperationName = "UnlinkOIDCProvider"
+	UnlockLegacyOperation                    OperationName = "UnlockLegacy"
+	UnlockMovieMetadataFieldOperation        OperationName = "UnlockMovieMetadataField"
+	UnlockParentalControlsOperation          OperationName = "UnlockParentalControls"
+	UnlockTVEpisodeMetadataFieldOperation    OperationName = "UnlockTVEpisodeMetadataField"
+	UnlockTVSeasonMetadataFieldOperation     OperationName = "UnlockTVSeasonMetadataField"
+	UnlockTVShowMetadataFieldOperation       OperationName = "UnlockTVShowMetadataField"
+	UnsharePhotoAlbumOperation               OperationName = "UnsharePhotoAlbum"
+	UnsubscribePodcastOperation              OperationName = "UnsubscribePodcast"
+	UpdateAudiobookProgressOperation         OperationName = "UpdateAudiobookProgress"
+	UpdateBookProgressOperation              OperationName = "UpdateBookProgress"
 	UpdateCurrentUserOperation               OperationName = "UpdateCurrentUser"
+	UpdateHomeVideoProgressOperation         OperationName = "UpdateHomeVideoProgress"
 	UpdateLibraryOperation                   OperationName = "UpdateLibrary"
+	UpdateLiveTVChannelOperation             OperationName = "UpdateLiveTVChannel"
+	UpdateMovieMetadataOperation             OperationName = "UpdateMovieMetadata"
+	UpdatePhotoAlbumOperation                OperationName = "UpdatePhotoAlbum"
+	UpdatePodcastEpisodeProgressOperation    OperationName = "UpdatePodcastEpisodeProgress"
+	UpdatePodcastSettingsOperation           OperationName = "UpdatePodcastSettings"
 	UpdateRolePermissionsOperation           OperationName = "UpdateRolePermissions"
 	UpdateServerSettingOperation             OperationName = "UpdateServerSetting"
+	UpdateTVEpisodeMetadataOperation         OperationName = "UpdateTVEpisodeMetadata"
 	UpdateTVEpisodeProgressOperation         OperationName = "UpdateTVEpisodeProgress"
+	UpdateTVSeasonMetadataOperation          OperationName = "UpdateTVSeasonMetadata"
+	UpdateTVShowMetadataOperation            OperationName = "UpdateTVShowMetadata"
 	UpdateUserPreferencesOperation           OperationName = "UpdateUserPreferences"
 	UpdateUserSettingOperation               OperationName = "UpdateUserSetting"
 	UpdateWatchProgressOperation             OperationName = "UpdateWatchProgress"
@@ -16,6 +16,72 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// AddPhotoAlbumPhotosParams is parameters of addPhotoAlbumPhotos operation.
+type AddPhotoAlbumPhotosParams struct {
+	// Album ID.
+	ID uuid.UUID
+}
+
+func unpackAddPhotoAlbumPhotosParams(packed middleware.Parameters) (params AddPhotoAlbumPhotosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAddPhotoAlbumPhotosParams(args [1]string, argsEscaped bool, r *http.Request) (params AddPhotoAlbumPhotosParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // AdminDeleteOIDCProviderParams is parameters of adminDeleteOIDCProvider operation.
 type AdminDeleteOIDCProviderParams struct {
 	// Provider ID.
@@ -280,6 +346,72 @@ func decodeAdminEnableOIDCProviderParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
+// AdminGetMatchQueueItemParams is parameters of adminGetMatchQueueItem operation.
+type AdminGetMatchQueueItemParams struct {
+	// Match queue item ID.
+	ItemId uuid.UUID
+}
+
+func unpackAdminGetMatchQueueItemParams(packed middleware.Parameters) (params AdminGetMatchQueueItemParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "itemId",
+			In:   "path",
+		}
+		params.ItemId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminGetMatchQueueItemParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminGetMatchQueueItemParams, _ error) {
+	// Decode path: itemId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "itemId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ItemId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "itemId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // AdminGetOIDCProviderParams is parameters of adminGetOIDCProvider operation.
 type AdminGetOIDCProviderParams struct {
 	// Provider ID.
@@ -346,208 +478,409 @@ func decodeAdminGetOIDCProviderParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// AdminListUsersParams is parameters of adminListUsers operation.
-type AdminListUsersParams struct {
-	// Text search across username, email, and display name.
-	Query OptString `json:",omitempty,omitzero"`
-	// Filter by active status.
-	IsActive OptBool `json:",omitempty,omitzero"`
-	// Filter by admin status.
-	IsAdmin OptBool `json:",omitempty,omitzero"`
-	// Maximum number of results (default 50, max 100).
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of results to skip.
-	Offset OptInt `json:",omitempty,omitzero"`
+// AdminGetParentalControlsParams is parameters of adminGetParentalControls operation.
+type AdminGetParentalControlsParams struct {
+	// User ID.
+	UserId uuid.UUID
 }
 
-func unpackAdminListUsersParams(packed middleware.Parameters) (params AdminListUsersParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "query",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Query = v.(OptString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "is_active",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IsActive = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "is_admin",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.IsAdmin = v.(OptBool)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackAdminGetParentalControlsParams(packed middleware.Parameters) (params AdminGetParentalControlsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Name: "userId",
+			In:   "path",
 		}
+		params.UserId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeAdminListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params AdminListUsersParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: query.
+func decodeAdminGetParentalControlsParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminGetParentalControlsParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "query",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotQueryVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotQueryVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Query.SetTo(paramsDotQueryVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Query.Get(); ok {
-					if err := func() error {
-						if err := (validate.String{
-							MinLength:     0,
-							MinLengthSet:  false,
-							MaxLength:     200,
-							MaxLengthSet:  true,
-							Email:         false,
-							Hostname:      false,
-							Regex:         nil,
-							MinNumeric:    0,
-							MinNumericSet: false,
-							MaxNumeric:    0,
-							MaxNumericSet: false,
-						}).Validate(string(value)); err != nil {
-							return errors.Wrap(err, "string")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "query",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: is_active.
+	return params, nil
+}
+
+// AdminIdentifyMatchQueueItemParams is parameters of adminIdentifyMatchQueueItem operation.
+type AdminIdentifyMatchQueueItemParams struct {
+	// Match queue item ID.
+	ItemId uuid.UUID
+}
+
+func unpackAdminIdentifyMatchQueueItemParams(packed middleware.Parameters) (params AdminIdentifyMatchQueueItemParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "itemId",
+			In:   "path",
+		}
+		params.ItemId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminIdentifyMatchQueueItemParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminIdentifyMatchQueueItemParams, _ error) {
+	// Decode path: itemId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "itemId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ItemId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "itemId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AdminIgnoreMatchQueueItemParams is parameters of adminIgnoreMatchQueueItem operation.
+type AdminIgnoreMatchQueueItemParams struct {
+	// Match queue item ID.
+	ItemId uuid.UUID
+}
+
+func unpackAdminIgnoreMatchQueueItemParams(packed middleware.Parameters) (params AdminIgnoreMatchQueueItemParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "itemId",
+			In:   "path",
+		}
+		params.ItemId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeAdminIgnoreMatchQueueItemParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminIgnoreMatchQueueItemParams, _ error) {
+	// Decode path: itemId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "itemId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ItemId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "itemId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AdminListMatchQueueParams is parameters of adminListMatchQueue operation.
+type AdminListMatchQueueParams struct {
+	// Filter by queue status.
+	Status OptAdminListMatchQueueStatus `json:",omitempty,omitzero"`
+	// Filter by content type.
+	ContentType OptAdminListMatchQueueContentType `json:",omitempty,omitzero"`
+	// Filter by library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Maximum number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of results to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackAdminListMatchQueueParams(packed middleware.Parameters) (params AdminListMatchQueueParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptAdminListMatchQueueStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "content_type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ContentType = v.(OptAdminListMatchQueueContentType)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeAdminListMatchQueueParams(args [0]string, argsEscaped bool, r *http.Request) (params AdminListMatchQueueParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: status.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "is_active",
+			Name:    "status",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIsActiveVal bool
+				var paramsDotStatusVal AdminListMatchQueueStatus
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotIsActiveVal = c
+					paramsDotStatusVal = AdminListMatchQueueStatus(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IsActive.SetTo(paramsDotIsActiveVal)
+				params.Status.SetTo(paramsDotStatusVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "is_active",
+			Name: "status",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: is_admin.
+	// Decode query: content_type.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "is_admin",
+			Name:    "content_type",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotIsAdminVal bool
+				var paramsDotContentTypeVal AdminListMatchQueueContentType
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotIsAdminVal = c
+					paramsDotContentTypeVal = AdminListMatchQueueContentType(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.IsAdmin.SetTo(paramsDotIsAdminVal)
+				params.ContentType.SetTo(paramsDotContentTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.ContentType.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "content_type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -556,7 +889,7 @@ func decodeAdminListUsersParams(args [0]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "is_admin",
+			Name: "library_id",
 			In:   "query",
 			Err:  err,
 		}
@@ -602,10 +935,10 @@ func decodeAdminListUsersParams(args [0]string, argsEscaped bool, r *http.Reques
 				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
+							MinSet:        false,
+							Min:           0,
 							MaxSet:        true,
-							Max:           100,
+							Max:           200,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -669,31 +1002,6 @@ func decodeAdminListUsersParams(args [0]string, argsEscaped bool, r *http.Reques
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
@@ -706,293 +1014,224 @@ func decodeAdminListUsersParams(args [0]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// AdminSetDefaultOIDCProviderParams is parameters of adminSetDefaultOIDCProvider operation.
-type AdminSetDefaultOIDCProviderParams struct {
-	// Provider ID.
-	ProviderId uuid.UUID
+// AdminListUsersParams is parameters of adminListUsers operation.
+type AdminListUsersParams struct {
+	// Text search across username, email, and display name.
+	Query OptString `json:",omitempty,omitzero"`
+	// Filter by active status.
+	IsActive OptBool `json:",omitempty,omitzero"`
+	// Filter by admin status.
+	IsAdmin OptBool `json:",omitempty,omitzero"`
+	// Maximum number of results (default 50, max 100).
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of results to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackAdminSetDefaultOIDCProviderParams(packed middleware.Parameters) (params AdminSetDefaultOIDCProviderParams) {
+func unpackAdminListUsersParams(packed middleware.Parameters) (params AdminListUsersParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "providerId",
-			In:   "path",
+			Name: "query",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Query = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "is_active",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IsActive = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "is_admin",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IsAdmin = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
 		}
-		params.ProviderId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeAdminSetDefaultOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminSetDefaultOIDCProviderParams, _ error) {
-	// Decode path: providerId.
+func decodeAdminListUsersParams(args [0]string, argsEscaped bool, r *http.Request) (params AdminListUsersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: query.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "providerId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQueryVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQueryVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ProviderId = c
+				params.Query.SetTo(paramsDotQueryVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Query.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     200,
+							MaxLengthSet:  true,
+							Email:         false,
+							Hostname:      false,
+							Regex:         nil,
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "providerId",
-			In:   "path",
+			Name: "query",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// AdminUpdateOIDCProviderParams is parameters of adminUpdateOIDCProvider operation.
-type AdminUpdateOIDCProviderParams struct {
-	// Provider ID.
-	ProviderId uuid.UUID
-}
-
-func unpackAdminUpdateOIDCProviderParams(packed middleware.Parameters) (params AdminUpdateOIDCProviderParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "providerId",
-			In:   "path",
-		}
-		params.ProviderId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeAdminUpdateOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminUpdateOIDCProviderParams, _ error) {
-	// Decode path: providerId.
+	// Decode query: is_active.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "is_active",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "providerId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIsActiveVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIsActiveVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ProviderId = c
+				params.IsActive.SetTo(paramsDotIsActiveVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "providerId",
-			In:   "path",
+			Name: "is_active",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// AssignRoleParams is parameters of assignRole operation.
-type AssignRoleParams struct {
-	// User ID.
-	UserId uuid.UUID
-}
-
-func unpackAssignRoleParams(packed middleware.Parameters) (params AssignRoleParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "userId",
-			In:   "path",
-		}
-		params.UserId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeAssignRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params AssignRoleParams, _ error) {
-	// Decode path: userId.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.UserId = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// AutocompleteMoviesParams is parameters of autocompleteMovies operation.
-type AutocompleteMoviesParams struct {
-	// Partial search query.
-	Q string
-	// Maximum suggestions to return.
-	Limit OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackAutocompleteMoviesParams(packed middleware.Parameters) (params AutocompleteMoviesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
-		}
-		params.Q = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeAutocompleteMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params AutocompleteMoviesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	// Decode query: is_admin.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "is_admin",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotIsAdminVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIsAdminVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Q = c
+				params.IsAdmin.SetTo(paramsDotIsAdminVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     100,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Q)); err != nil {
-					return errors.Wrap(err, "string")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
+			Name: "is_admin",
 			In:   "query",
 			Err:  err,
 		}
 	}
 	// Set default value for query: limit.
 	{
-		val := int(5)
+		val := int(50)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -1034,7 +1273,7 @@ func decodeAutocompleteMoviesParams(args [0]string, argsEscaped bool, r *http.Re
 							MinSet:        true,
 							Min:           1,
 							MaxSet:        true,
-							Max:           20,
+							Max:           100,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -1061,111 +1300,22 @@ func decodeAutocompleteMoviesParams(args [0]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// AutocompleteTVShowsParams is parameters of autocompleteTVShows operation.
-type AutocompleteTVShowsParams struct {
-	// Partial search query.
-	Q string
-	// Maximum suggestions to return.
-	Limit OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackAutocompleteTVShowsParams(packed middleware.Parameters) (params AutocompleteTVShowsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
-		}
-		params.Q = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeAutocompleteTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params AutocompleteTVShowsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Q = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     100,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Q)); err != nil {
-					return errors.Wrap(err, "string")
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: limit.
+	// Set default value for query: offset.
 	{
-		val := int(5)
-		params.Limit.SetTo(val)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	// Decode query: limit.
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotOffsetVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1177,24 +1327,24 @@ func decodeAutocompleteTVShowsParams(args [0]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.Offset.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           20,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -1216,7 +1366,7 @@ func decodeAutocompleteTVShowsParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
@@ -1224,25 +1374,25 @@ func decodeAutocompleteTVShowsParams(args [0]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// DeleteLibraryParams is parameters of deleteLibrary operation.
-type DeleteLibraryParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
+// AdminSetDefaultOIDCProviderParams is parameters of adminSetDefaultOIDCProvider operation.
+type AdminSetDefaultOIDCProviderParams struct {
+	// Provider ID.
+	ProviderId uuid.UUID
 }
 
-func unpackDeleteLibraryParams(packed middleware.Parameters) (params DeleteLibraryParams) {
+func unpackAdminSetDefaultOIDCProviderParams(packed middleware.Parameters) (params AdminSetDefaultOIDCProviderParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "providerId",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.ProviderId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteLibraryParams, _ error) {
-	// Decode path: libraryId.
+func decodeAdminSetDefaultOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminSetDefaultOIDCProviderParams, _ error) {
+	// Decode path: providerId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1254,7 +1404,7 @@ func decodeDeleteLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "providerId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1271,7 +1421,7 @@ func decodeDeleteLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.LibraryId = c
+				params.ProviderId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1282,7 +1432,7 @@ func decodeDeleteLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "providerId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1290,25 +1440,25 @@ func decodeDeleteLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// DeleteRoleParams is parameters of deleteRole operation.
-type DeleteRoleParams struct {
-	// Role name.
-	RoleName string
+// AdminUnlockMovieFileMatchParams is parameters of adminUnlockMovieFileMatch operation.
+type AdminUnlockMovieFileMatchParams struct {
+	// Movie file ID.
+	FileId uuid.UUID
 }
 
-func unpackDeleteRoleParams(packed middleware.Parameters) (params DeleteRoleParams) {
+func unpackAdminUnlockMovieFileMatchParams(packed middleware.Parameters) (params AdminUnlockMovieFileMatchParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "roleName",
+			Name: "fileId",
 			In:   "path",
 		}
-		params.RoleName = packed[key].(string)
+		params.FileId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteRoleParams, _ error) {
-	// Decode path: roleName.
+func decodeAdminUnlockMovieFileMatchParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminUnlockMovieFileMatchParams, _ error) {
+	// Decode path: fileId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1320,7 +1470,7 @@ func decodeDeleteRoleParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "roleName",
+				Param:   "fileId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1332,12 +1482,12 @@ func decodeDeleteRoleParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.RoleName = c
+				params.FileId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1348,7 +1498,7 @@ func decodeDeleteRoleParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "roleName",
+			Name: "fileId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1356,25 +1506,25 @@ func decodeDeleteRoleParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DeleteTVEpisodeProgressParams is parameters of deleteTVEpisodeProgress operation.
-type DeleteTVEpisodeProgressParams struct {
-	// Episode ID.
-	ID uuid.UUID
+// AdminUpdateOIDCProviderParams is parameters of adminUpdateOIDCProvider operation.
+type AdminUpdateOIDCProviderParams struct {
+	// Provider ID.
+	ProviderId uuid.UUID
 }
 
-func unpackDeleteTVEpisodeProgressParams(packed middleware.Parameters) (params DeleteTVEpisodeProgressParams) {
+func unpackAdminUpdateOIDCProviderParams(packed middleware.Parameters) (params AdminUpdateOIDCProviderParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "providerId",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.ProviderId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTVEpisodeProgressParams, _ error) {
-	// Decode path: id.
+func decodeAdminUpdateOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminUpdateOIDCProviderParams, _ error) {
+	// Decode path: providerId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1386,7 +1536,7 @@ func decodeDeleteTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "providerId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1403,7 +1553,7 @@ func decodeDeleteTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.ID = c
+				params.ProviderId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1414,7 +1564,7 @@ func decodeDeleteTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "providerId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1422,25 +1572,25 @@ func decodeDeleteTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// DeleteUserSettingParams is parameters of deleteUserSetting operation.
-type DeleteUserSettingParams struct {
-	// Setting key.
-	Key string
+// AdminUpdateParentalControlsParams is parameters of adminUpdateParentalControls operation.
+type AdminUpdateParentalControlsParams struct {
+	// User ID.
+	UserId uuid.UUID
 }
 
-func unpackDeleteUserSettingParams(packed middleware.Parameters) (params DeleteUserSettingParams) {
+func unpackAdminUpdateParentalControlsParams(packed middleware.Parameters) (params AdminUpdateParentalControlsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "key",
+			Name: "userId",
 			In:   "path",
 		}
-		params.Key = packed[key].(string)
+		params.UserId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteUserSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserSettingParams, _ error) {
-	// Decode path: key.
+func decodeAdminUpdateParentalControlsParams(args [1]string, argsEscaped bool, r *http.Request) (params AdminUpdateParentalControlsParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1452,7 +1602,7 @@ func decodeDeleteUserSettingParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "key",
+				Param:   "userId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1464,12 +1614,12 @@ func decodeDeleteUserSettingParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Key = c
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1480,7 +1630,7 @@ func decodeDeleteUserSettingParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "key",
+			Name: "userId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1488,25 +1638,25 @@ func decodeDeleteUserSettingParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// DeleteWatchProgressParams is parameters of deleteWatchProgress operation.
-type DeleteWatchProgressParams struct {
-	// Movie ID.
-	ID uuid.UUID
+// AssignRoleParams is parameters of assignRole operation.
+type AssignRoleParams struct {
+	// User ID.
+	UserId uuid.UUID
 }
 
-func unpackDeleteWatchProgressParams(packed middleware.Parameters) (params DeleteWatchProgressParams) {
+func unpackAssignRoleParams(packed middleware.Parameters) (params AssignRoleParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "userId",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.UserId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteWatchProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWatchProgressParams, _ error) {
-	// Decode path: id.
+func decodeAssignRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params AssignRoleParams, _ error) {
+	// Decode path: userId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1518,7 +1668,7 @@ func decodeDeleteWatchProgressParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "userId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1535,7 +1685,7 @@ func decodeDeleteWatchProgressParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.ID = c
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1546,7 +1696,7 @@ func decodeDeleteWatchProgressParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "userId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1554,156 +1704,354 @@ func decodeDeleteWatchProgressParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// DeleteWebAuthnCredentialParams is parameters of deleteWebAuthnCredential operation.
-type DeleteWebAuthnCredentialParams struct {
-	CredentialId uuid.UUID
+// AutocompleteMoviesParams is parameters of autocompleteMovies operation.
+type AutocompleteMoviesParams struct {
+	// Partial search query.
+	Q string
+	// Maximum suggestions to return.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackDeleteWebAuthnCredentialParams(packed middleware.Parameters) (params DeleteWebAuthnCredentialParams) {
+func unpackAutocompleteMoviesParams(packed middleware.Parameters) (params AutocompleteMoviesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "credentialId",
-			In:   "path",
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
 		}
-		params.CredentialId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeDeleteWebAuthnCredentialParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWebAuthnCredentialParams, _ error) {
-	// Decode path: credentialId.
+func decodeAutocompleteMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params AutocompleteMoviesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "credentialId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.CredentialId = c
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     100,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "credentialId",
-			In:   "path",
+			Name: "q",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetAPIKeyParams is parameters of getAPIKey operation.
-type GetAPIKeyParams struct {
-	// API Key ID.
-	KeyId uuid.UUID
-}
-
-func unpackGetAPIKeyParams(packed middleware.Parameters) (params GetAPIKeyParams) {
+	// Set default value for query: limit.
 	{
-		key := middleware.ParameterKey{
-			Name: "keyId",
-			In:   "path",
-		}
-		params.KeyId = packed[key].(uuid.UUID)
+		val := int(5)
+		params.Limit.SetTo(val)
 	}
-	return params
-}
-
-func decodeGetAPIKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAPIKeyParams, _ error) {
-	// Decode path: keyId.
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "keyId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.KeyId = c
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           20,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AutocompleteTVShowsParams is parameters of autocompleteTVShows operation.
+type AutocompleteTVShowsParams struct {
+	// Partial search query.
+	Q string
+	// Maximum suggestions to return.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackAutocompleteTVShowsParams(packed middleware.Parameters) (params AutocompleteTVShowsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeAutocompleteTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params AutocompleteTVShowsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     100,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "keyId",
-			In:   "path",
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(5)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           20,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetCollectionParams is parameters of getCollection operation.
-type GetCollectionParams struct {
-	// Collection ID.
-	ID uuid.UUID
+// CancelLibraryScanParams is parameters of cancelLibraryScan operation.
+type CancelLibraryScanParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+	// Scan ID.
+	ScanId uuid.UUID
 }
 
-func unpackGetCollectionParams(packed middleware.Parameters) (params GetCollectionParams) {
+func unpackCancelLibraryScanParams(packed middleware.Parameters) (params CancelLibraryScanParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "libraryId",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.LibraryId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "scanId",
+			In:   "path",
+		}
+		params.ScanId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCollectionParams, _ error) {
-	// Decode path: id.
+func decodeCancelLibraryScanParams(args [2]string, argsEscaped bool, r *http.Request) (params CancelLibraryScanParams, _ error) {
+	// Decode path: libraryId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1715,7 +2063,7 @@ func decodeGetCollectionParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "libraryId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1732,7 +2080,7 @@ func decodeGetCollectionParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.ID = c
+				params.LibraryId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1743,37 +2091,16 @@ func decodeGetCollectionParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "libraryId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetCollectionMetadataParams is parameters of getCollectionMetadata operation.
-type GetCollectionMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-}
-
-func unpackGetCollectionMetadataParams(packed middleware.Parameters) (params GetCollectionMetadataParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetCollectionMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCollectionMetadataParams, _ error) {
-	// Decode path: id.
+	// Decode path: scanId.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1781,7 +2108,7 @@ func decodeGetCollectionMetadataParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "scanId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1793,12 +2120,12 @@ func decodeGetCollectionMetadataParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.ScanId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1809,7 +2136,7 @@ func decodeGetCollectionMetadataParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "scanId",
 			In:   "path",
 			Err:  err,
 		}
@@ -1817,13 +2144,13 @@ func decodeGetCollectionMetadataParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// GetCollectionMoviesParams is parameters of getCollectionMovies operation.
-type GetCollectionMoviesParams struct {
-	// Collection ID.
+// DeleteAudiobookProgressParams is parameters of deleteAudiobookProgress operation.
+type DeleteAudiobookProgressParams struct {
+	// Audiobook ID.
 	ID uuid.UUID
 }
 
-func unpackGetCollectionMoviesParams(packed middleware.Parameters) (params GetCollectionMoviesParams) {
+func unpackDeleteAudiobookProgressParams(packed middleware.Parameters) (params DeleteAudiobookProgressParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1834,7 +2161,7 @@ func unpackGetCollectionMoviesParams(packed middleware.Parameters) (params GetCo
 	return params
 }
 
-func decodeGetCollectionMoviesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCollectionMoviesParams, _ error) {
+func decodeDeleteAudiobookProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteAudiobookProgressParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1883,136 +2210,90 @@ func decodeGetCollectionMoviesParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetContinueWatchingParams is parameters of getContinueWatching operation.
-type GetContinueWatchingParams struct {
-	Limit OptInt `json:",omitempty,omitzero"`
+// DeleteBookProgressParams is parameters of deleteBookProgress operation.
+type DeleteBookProgressParams struct {
+	// Book ID.
+	ID uuid.UUID
 }
 
-func unpackGetContinueWatchingParams(packed middleware.Parameters) (params GetContinueWatchingParams) {
+func unpackDeleteBookProgressParams(packed middleware.Parameters) (params DeleteBookProgressParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetContinueWatchingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetContinueWatchingParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(10)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+func decodeDeleteBookProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           50,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetEpisodeMetadataParams is parameters of getEpisodeMetadata operation.
-type GetEpisodeMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// Season number.
-	SeasonNumber int
-	// Episode number.
-	EpisodeNumber int
+// DeleteHomeVideoProgressParams is parameters of deleteHomeVideoProgress operation.
+type DeleteHomeVideoProgressParams struct {
+	// Home video ID.
+	ID uuid.UUID
 }
 
-func unpackGetEpisodeMetadataParams(packed middleware.Parameters) (params GetEpisodeMetadataParams) {
+func unpackDeleteHomeVideoProgressParams(packed middleware.Parameters) (params DeleteHomeVideoProgressParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "seasonNumber",
-			In:   "path",
-		}
-		params.SeasonNumber = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "episodeNumber",
-			In:   "path",
-		}
-		params.EpisodeNumber = packed[key].(int)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Request) (params GetEpisodeMetadataParams, _ error) {
+func decodeDeleteHomeVideoProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteHomeVideoProgressParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2037,7 +2318,7 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -2058,11 +2339,32 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
-	// Decode path: seasonNumber.
+	return params, nil
+}
+
+// DeleteLibraryParams is parameters of deleteLibrary operation.
+type DeleteLibraryParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+}
+
+func unpackDeleteLibraryParams(packed middleware.Parameters) (params DeleteLibraryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "libraryId",
+			In:   "path",
+		}
+		params.LibraryId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteLibraryParams, _ error) {
+	// Decode path: libraryId.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2070,7 +2372,7 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "seasonNumber",
+				Param:   "libraryId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2082,12 +2384,12 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.SeasonNumber = c
+				params.LibraryId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2098,16 +2400,37 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "seasonNumber",
+			Name: "libraryId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: episodeNumber.
+	return params, nil
+}
+
+// DeleteLiveTVRecordingParams is parameters of deleteLiveTVRecording operation.
+type DeleteLiveTVRecordingParams struct {
+	// Recording ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteLiveTVRecordingParams(packed middleware.Parameters) (params DeleteLiveTVRecordingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteLiveTVRecordingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteLiveTVRecordingParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2115,7 +2438,7 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "episodeNumber",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2127,12 +2450,12 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.EpisodeNumber = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2143,7 +2466,7 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "episodeNumber",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2151,54 +2474,24 @@ func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetEpisodeMetadataImagesParams is parameters of getEpisodeMetadataImages operation.
-type GetEpisodeMetadataImagesParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// Season number.
-	SeasonNumber int
-	// Episode number.
-	EpisodeNumber int
-	// ISO 639-1 language filter.
-	Language OptString `json:",omitempty,omitzero"`
+// DeleteLiveTVSeriesRuleParams is parameters of deleteLiveTVSeriesRule operation.
+type DeleteLiveTVSeriesRuleParams struct {
+	// Series rule ID.
+	ID uuid.UUID
 }
 
-func unpackGetEpisodeMetadataImagesParams(packed middleware.Parameters) (params GetEpisodeMetadataImagesParams) {
+func unpackDeleteLiveTVSeriesRuleParams(packed middleware.Parameters) (params DeleteLiveTVSeriesRuleParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "seasonNumber",
-			In:   "path",
-		}
-		params.SeasonNumber = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "episodeNumber",
-			In:   "path",
-		}
-		params.EpisodeNumber = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *http.Request) (params GetEpisodeMetadataImagesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeDeleteLiveTVSeriesRuleParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteLiveTVSeriesRuleParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2223,7 +2516,7 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -2244,11 +2537,32 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 			Err:  err,
 		}
 	}
-	// Decode path: seasonNumber.
+	return params, nil
+}
+
+// DeleteLiveTVSourceParams is parameters of deleteLiveTVSource operation.
+type DeleteLiveTVSourceParams struct {
+	// Source ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteLiveTVSourceParams(packed middleware.Parameters) (params DeleteLiveTVSourceParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteLiveTVSourceParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteLiveTVSourceParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2256,7 +2570,7 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "seasonNumber",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2268,12 +2582,12 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.SeasonNumber = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2284,16 +2598,37 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "seasonNumber",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: episodeNumber.
+	return params, nil
+}
+
+// DeletePhotoAlbumParams is parameters of deletePhotoAlbum operation.
+type DeletePhotoAlbumParams struct {
+	// Album ID.
+	ID uuid.UUID
+}
+
+func unpackDeletePhotoAlbumParams(packed middleware.Parameters) (params DeletePhotoAlbumParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeletePhotoAlbumParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePhotoAlbumParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -2301,7 +2636,7 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "episodeNumber",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2313,12 +2648,12 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.EpisodeNumber = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2329,74 +2664,99 @@ func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "episodeNumber",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: language.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// DeletePodcastEpisodeDownloadParams is parameters of deletePodcastEpisodeDownload operation.
+type DeletePodcastEpisodeDownloadParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
+
+func unpackDeletePodcastEpisodeDownloadParams(packed middleware.Parameters) (params DeletePodcastEpisodeDownloadParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeDeletePodcastEpisodeDownloadParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePodcastEpisodeDownloadParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Language.SetTo(paramsDotLanguageVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetLibraryParams is parameters of getLibrary operation.
-type GetLibraryParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
+// DeletePodcastEpisodeProgressParams is parameters of deletePodcastEpisodeProgress operation.
+type DeletePodcastEpisodeProgressParams struct {
+	// Episode ID.
+	ID uuid.UUID
 }
 
-func unpackGetLibraryParams(packed middleware.Parameters) (params GetLibraryParams) {
+func unpackDeletePodcastEpisodeProgressParams(packed middleware.Parameters) (params DeletePodcastEpisodeProgressParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLibraryParams, _ error) {
-	// Decode path: libraryId.
+func decodeDeletePodcastEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePodcastEpisodeProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2408,7 +2768,7 @@ func decodeGetLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2425,7 +2785,7 @@ func decodeGetLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.LibraryId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2436,7 +2796,7 @@ func decodeGetLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -2444,25 +2804,25 @@ func decodeGetLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// GetMovieParams is parameters of getMovie operation.
-type GetMovieParams struct {
-	// Movie ID.
-	ID uuid.UUID
+// DeleteRoleParams is parameters of deleteRole operation.
+type DeleteRoleParams struct {
+	// Role name.
+	RoleName string
 }
 
-func unpackGetMovieParams(packed middleware.Parameters) (params GetMovieParams) {
+func unpackDeleteRoleParams(packed middleware.Parameters) (params DeleteRoleParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "roleName",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.RoleName = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetMovieParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieParams, _ error) {
-	// Decode path: id.
+func decodeDeleteRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteRoleParams, _ error) {
+	// Decode path: roleName.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2474,7 +2834,7 @@ func decodeGetMovieParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "roleName",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2486,12 +2846,12 @@ func decodeGetMovieParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.RoleName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2502,7 +2862,7 @@ func decodeGetMovieParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "roleName",
 			In:   "path",
 			Err:  err,
 		}
@@ -2510,15 +2870,13 @@ func decodeGetMovieParams(args [1]string, argsEscaped bool, r *http.Request) (pa
 	return params, nil
 }
 
-// GetMovieCastParams is parameters of getMovieCast operation.
-type GetMovieCastParams struct {
-	// Movie ID.
-	ID     uuid.UUID
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// DeleteTVEpisodeProgressParams is parameters of deleteTVEpisodeProgress operation.
+type DeleteTVEpisodeProgressParams struct {
+	// Episode ID.
+	ID uuid.UUID
 }
 
-func unpackGetMovieCastParams(packed middleware.Parameters) (params GetMovieCastParams) {
+func unpackDeleteTVEpisodeProgressParams(packed middleware.Parameters) (params DeleteTVEpisodeProgressParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2526,29 +2884,10 @@ func unpackGetMovieCastParams(packed middleware.Parameters) (params GetMovieCast
 		}
 		params.ID = packed[key].(uuid.UUID)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
 	return params
 }
 
-func decodeGetMovieCastParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieCastParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeDeleteTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteTVEpisodeProgressParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2594,158 +2933,213 @@ func decodeGetMovieCastParams(args [1]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// DeleteUserSettingParams is parameters of deleteUserSetting operation.
+type DeleteUserSettingParams struct {
+	// Setting key.
+	Key string
+}
+
+func unpackDeleteUserSettingParams(packed middleware.Parameters) (params DeleteUserSettingParams) {
 	{
-		val := int(50)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeDeleteUserSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteUserSettingParams, _ error) {
+	// Decode path: key.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Key = c
 				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "key",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// DeleteWatchProgressParams is parameters of deleteWatchProgress operation.
+type DeleteWatchProgressParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackDeleteWatchProgressParams(packed middleware.Parameters) (params DeleteWatchProgressParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeDeleteWatchProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWatchProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteWebAuthnCredentialParams is parameters of deleteWebAuthnCredential operation.
+type DeleteWebAuthnCredentialParams struct {
+	CredentialId uuid.UUID
+}
+
+func unpackDeleteWebAuthnCredentialParams(packed middleware.Parameters) (params DeleteWebAuthnCredentialParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "credentialId",
+			In:   "path",
+		}
+		params.CredentialId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeleteWebAuthnCredentialParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteWebAuthnCredentialParams, _ error) {
+	// Decode path: credentialId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "credentialId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.CredentialId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "credentialId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetMovieCollectionParams is parameters of getMovieCollection operation.
-type GetMovieCollectionParams struct {
-	// Movie ID.
+// DownloadBookFileParams is parameters of downloadBookFile operation.
+type DownloadBookFileParams struct {
+	// Book ID.
 	ID uuid.UUID
 }
 
-func unpackGetMovieCollectionParams(packed middleware.Parameters) (params GetMovieCollectionParams) {
+func unpackDownloadBookFileParams(packed middleware.Parameters) (params DownloadBookFileParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2756,7 +3150,7 @@ func unpackGetMovieCollectionParams(packed middleware.Parameters) (params GetMov
 	return params
 }
 
-func decodeGetMovieCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieCollectionParams, _ error) {
+func decodeDownloadBookFileParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadBookFileParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2805,15 +3199,13 @@ func decodeGetMovieCollectionParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetMovieCrewParams is parameters of getMovieCrew operation.
-type GetMovieCrewParams struct {
-	// Movie ID.
-	ID     uuid.UUID
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// DownloadLegacySceneFileParams is parameters of downloadLegacySceneFile operation.
+type DownloadLegacySceneFileParams struct {
+	// Scene ID.
+	ID uuid.UUID
 }
 
-func unpackGetMovieCrewParams(packed middleware.Parameters) (params GetMovieCrewParams) {
+func unpackDownloadLegacySceneFileParams(packed middleware.Parameters) (params DownloadLegacySceneFileParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -2821,29 +3213,10 @@ func unpackGetMovieCrewParams(packed middleware.Parameters) (params GetMovieCrew
 		}
 		params.ID = packed[key].(uuid.UUID)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
 	return params
 }
 
-func decodeGetMovieCrewParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieCrewParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeDownloadLegacySceneFileParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadLegacySceneFileParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -2889,170 +3262,160 @@ func decodeGetMovieCrewParams(args [1]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// DownloadPhotoFileParams is parameters of downloadPhotoFile operation.
+type DownloadPhotoFileParams struct {
+	// Photo ID.
+	ID uuid.UUID
+}
+
+func unpackDownloadPhotoFileParams(packed middleware.Parameters) (params DownloadPhotoFileParams) {
 	{
-		val := int(50)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeDownloadPhotoFileParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadPhotoFileParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// DownloadPodcastEpisodeParams is parameters of downloadPodcastEpisode operation.
+type DownloadPodcastEpisodeParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
+
+func unpackDownloadPodcastEpisodeParams(packed middleware.Parameters) (params DownloadPodcastEpisodeParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeDownloadPodcastEpisodeParams(args [1]string, argsEscaped bool, r *http.Request) (params DownloadPodcastEpisodeParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetMovieExternalIDsParams is parameters of getMovieExternalIDs operation.
-type GetMovieExternalIDsParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetAPIKeyParams is parameters of getAPIKey operation.
+type GetAPIKeyParams struct {
+	// API Key ID.
+	KeyId uuid.UUID
 }
 
-func unpackGetMovieExternalIDsParams(packed middleware.Parameters) (params GetMovieExternalIDsParams) {
+func unpackGetAPIKeyParams(packed middleware.Parameters) (params GetAPIKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "keyId",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.KeyId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetMovieExternalIDsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieExternalIDsParams, _ error) {
-	// Decode path: id.
+func decodeGetAPIKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAPIKeyParams, _ error) {
+	// Decode path: keyId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3064,7 +3427,7 @@ func decodeGetMovieExternalIDsParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "keyId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3076,12 +3439,12 @@ func decodeGetMovieExternalIDsParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.KeyId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3092,7 +3455,7 @@ func decodeGetMovieExternalIDsParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "keyId",
 			In:   "path",
 			Err:  err,
 		}
@@ -3100,13 +3463,13 @@ func decodeGetMovieExternalIDsParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetMovieFilesParams is parameters of getMovieFiles operation.
-type GetMovieFilesParams struct {
-	// Movie ID.
+// GetAudiobookParams is parameters of getAudiobook operation.
+type GetAudiobookParams struct {
+	// Audiobook ID.
 	ID uuid.UUID
 }
 
-func unpackGetMovieFilesParams(packed middleware.Parameters) (params GetMovieFilesParams) {
+func unpackGetAudiobookParams(packed middleware.Parameters) (params GetAudiobookParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3117,7 +3480,7 @@ func unpackGetMovieFilesParams(packed middleware.Parameters) (params GetMovieFil
 	return params
 }
 
-func decodeGetMovieFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieFilesParams, _ error) {
+func decodeGetAudiobookParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAudiobookParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3166,13 +3529,13 @@ func decodeGetMovieFilesParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetMovieGenresParams is parameters of getMovieGenres operation.
-type GetMovieGenresParams struct {
-	// Movie ID.
+// GetAudiobookAuthorBooksParams is parameters of getAudiobookAuthorBooks operation.
+type GetAudiobookAuthorBooksParams struct {
+	// Author ID.
 	ID uuid.UUID
 }
 
-func unpackGetMovieGenresParams(packed middleware.Parameters) (params GetMovieGenresParams) {
+func unpackGetAudiobookAuthorBooksParams(packed middleware.Parameters) (params GetAudiobookAuthorBooksParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -3183,7 +3546,7 @@ func unpackGetMovieGenresParams(packed middleware.Parameters) (params GetMovieGe
 	return params
 }
 
-func decodeGetMovieGenresParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieGenresParams, _ error) {
+func decodeGetAudiobookAuthorBooksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAudiobookAuthorBooksParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3232,24 +3595,24 @@ func decodeGetMovieGenresParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetMovieMetadataParams is parameters of getMovieMetadata operation.
-type GetMovieMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetAudiobookProgressParams is parameters of getAudiobookProgress operation.
+type GetAudiobookProgressParams struct {
+	// Audiobook ID.
+	ID uuid.UUID
 }
 
-func unpackGetMovieMetadataParams(packed middleware.Parameters) (params GetMovieMetadataParams) {
+func unpackGetAudiobookProgressParams(packed middleware.Parameters) (params GetAudiobookProgressParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieMetadataParams, _ error) {
+func decodeGetAudiobookProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAudiobookProgressParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3274,7 +3637,7 @@ func decodeGetMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3298,24 +3661,24 @@ func decodeGetMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetMovieMetadataCreditsParams is parameters of getMovieMetadataCredits operation.
-type GetMovieMetadataCreditsParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetAudiobookSeriesBooksParams is parameters of getAudiobookSeriesBooks operation.
+type GetAudiobookSeriesBooksParams struct {
+	// Series ID.
+	ID uuid.UUID
 }
 
-func unpackGetMovieMetadataCreditsParams(packed middleware.Parameters) (params GetMovieMetadataCreditsParams) {
+func unpackGetAudiobookSeriesBooksParams(packed middleware.Parameters) (params GetAudiobookSeriesBooksParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetMovieMetadataCreditsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieMetadataCreditsParams, _ error) {
+func decodeGetAudiobookSeriesBooksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAudiobookSeriesBooksParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3340,7 +3703,7 @@ func decodeGetMovieMetadataCreditsParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3364,36 +3727,24 @@ func decodeGetMovieMetadataCreditsParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetMovieMetadataImagesParams is parameters of getMovieMetadataImages operation.
-type GetMovieMetadataImagesParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// ISO 639-1 language filter (e.g. en, de).
-	Language OptString `json:",omitempty,omitzero"`
+// GetBookParams is parameters of getBook operation.
+type GetBookParams struct {
+	// Book ID.
+	ID uuid.UUID
 }
 
-func unpackGetMovieMetadataImagesParams(packed middleware.Parameters) (params GetMovieMetadataImagesParams) {
+func unpackGetBookParams(packed middleware.Parameters) (params GetBookParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetMovieMetadataImagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieMetadataImagesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetBookParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3418,7 +3769,7 @@ func decodeGetMovieMetadataImagesParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3439,80 +3790,27 @@ func decodeGetMovieMetadataImagesParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Decode query: language.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// GetBookAuthorBooksParams is parameters of getBookAuthorBooks operation.
+type GetBookAuthorBooksParams struct {
+	// Author ID.
+	ID uuid.UUID
+}
 
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Language.SetTo(paramsDotLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetMovieRecommendationsMetadataParams is parameters of getMovieRecommendationsMetadata operation.
-type GetMovieRecommendationsMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// Maximum number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackGetMovieRecommendationsMetadataParams(packed middleware.Parameters) (params GetMovieRecommendationsMetadataParams) {
+func unpackGetBookAuthorBooksParams(packed middleware.Parameters) (params GetBookAuthorBooksParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetMovieRecommendationsMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieRecommendationsMetadataParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetBookAuthorBooksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookAuthorBooksParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3537,7 +3835,7 @@ func decodeGetMovieRecommendationsMetadataParams(args [1]string, argsEscaped boo
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3558,98 +3856,27 @@ func decodeGetMovieRecommendationsMetadataParams(args [1]string, argsEscaped boo
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	return params, nil
 }
 
-// GetPersonMetadataParams is parameters of getPersonMetadata operation.
-type GetPersonMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetBookCoverParams is parameters of getBookCover operation.
+type GetBookCoverParams struct {
+	// Book ID.
+	ID uuid.UUID
 }
 
-func unpackGetPersonMetadataParams(packed middleware.Parameters) (params GetPersonMetadataParams) {
+func unpackGetBookCoverParams(packed middleware.Parameters) (params GetBookCoverParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPersonMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonMetadataParams, _ error) {
+func decodeGetBookCoverParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookCoverParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3674,7 +3901,7 @@ func decodeGetPersonMetadataParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3698,24 +3925,33 @@ func decodeGetPersonMetadataParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetPersonMetadataCreditsParams is parameters of getPersonMetadataCredits operation.
-type GetPersonMetadataCreditsParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetBookPageParams is parameters of getBookPage operation.
+type GetBookPageParams struct {
+	// Book ID.
+	ID uuid.UUID
+	// Page number.
+	Page int
 }
 
-func unpackGetPersonMetadataCreditsParams(packed middleware.Parameters) (params GetPersonMetadataCreditsParams) {
+func unpackGetBookPageParams(packed middleware.Parameters) (params GetBookPageParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "path",
+		}
+		params.Page = packed[key].(int)
 	}
 	return params
 }
 
-func decodeGetPersonMetadataCreditsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonMetadataCreditsParams, _ error) {
+func decodeGetBookPageParams(args [2]string, argsEscaped bool, r *http.Request) (params GetBookPageParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3740,7 +3976,7 @@ func decodeGetPersonMetadataCreditsParams(args [1]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3761,27 +3997,90 @@ func decodeGetPersonMetadataCreditsParams(args [1]string, argsEscaped bool, r *h
 			Err:  err,
 		}
 	}
+	// Decode path: page.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "page",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Page = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           1,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.Page)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// GetPersonMetadataImagesParams is parameters of getPersonMetadataImages operation.
-type GetPersonMetadataImagesParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetBookProgressParams is parameters of getBookProgress operation.
+type GetBookProgressParams struct {
+	// Book ID.
+	ID uuid.UUID
 }
 
-func unpackGetPersonMetadataImagesParams(packed middleware.Parameters) (params GetPersonMetadataImagesParams) {
+func unpackGetBookProgressParams(packed middleware.Parameters) (params GetBookProgressParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPersonMetadataImagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonMetadataImagesParams, _ error) {
+func decodeGetBookProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookProgressParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -3806,7 +4105,7 @@ func decodeGetPersonMetadataImagesParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -3830,24 +4129,25 @@ func decodeGetPersonMetadataImagesParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetPlaybackSessionParams is parameters of getPlaybackSession operation.
-type GetPlaybackSessionParams struct {
-	SessionId uuid.UUID
+// GetBookSeriesBooksParams is parameters of getBookSeriesBooks operation.
+type GetBookSeriesBooksParams struct {
+	// Series ID.
+	ID uuid.UUID
 }
 
-func unpackGetPlaybackSessionParams(packed middleware.Parameters) (params GetPlaybackSessionParams) {
+func unpackGetBookSeriesBooksParams(packed middleware.Parameters) (params GetBookSeriesBooksParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "sessionId",
+			Name: "id",
 			In:   "path",
 		}
-		params.SessionId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaybackSessionParams, _ error) {
-	// Decode path: sessionId.
+func decodeGetBookSeriesBooksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookSeriesBooksParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3859,7 +4159,7 @@ func decodeGetPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "sessionId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3876,7 +4176,7 @@ func decodeGetPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.SessionId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3887,7 +4187,7 @@ func decodeGetPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sessionId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -3895,43 +4195,25 @@ func decodeGetPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetProxiedImageParams is parameters of getProxiedImage operation.
-type GetProxiedImageParams struct {
-	// Image type.
-	Type GetProxiedImageType
-	// Image size.
-	Size GetProxiedImageSize
-	// TMDb image path (e.g., /abc123.jpg).
-	Path string
+// GetCollectionParams is parameters of getCollection operation.
+type GetCollectionParams struct {
+	// Collection ID.
+	ID uuid.UUID
 }
 
-func unpackGetProxiedImageParams(packed middleware.Parameters) (params GetProxiedImageParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "type",
-			In:   "path",
-		}
-		params.Type = packed[key].(GetProxiedImageType)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "size",
-			In:   "path",
-		}
-		params.Size = packed[key].(GetProxiedImageSize)
-	}
+func unpackGetCollectionParams(packed middleware.Parameters) (params GetCollectionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "path",
+			Name: "id",
 			In:   "path",
 		}
-		params.Path = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Request) (params GetProxiedImageParams, _ error) {
-	// Decode path: type.
+func decodeGetCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCollectionParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3943,7 +4225,7 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "type",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3955,20 +4237,12 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Type = GetProxiedImageType(c)
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Type.Validate(); err != nil {
-					return err
-				}
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3979,16 +4253,37 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "type",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: size.
+	return params, nil
+}
+
+// GetCollectionMetadataParams is parameters of getCollectionMetadata operation.
+type GetCollectionMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+}
+
+func unpackGetCollectionMetadataParams(packed middleware.Parameters) (params GetCollectionMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetCollectionMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCollectionMetadataParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -3996,7 +4291,7 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "size",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4013,15 +4308,7 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				params.Size = GetProxiedImageSize(c)
-				return nil
-			}(); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Size.Validate(); err != nil {
-					return err
-				}
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4032,16 +4319,37 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "size",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: path.
+	return params, nil
+}
+
+// GetCollectionMoviesParams is parameters of getCollectionMovies operation.
+type GetCollectionMoviesParams struct {
+	// Collection ID.
+	ID uuid.UUID
+}
+
+func unpackGetCollectionMoviesParams(packed middleware.Parameters) (params GetCollectionMoviesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCollectionMoviesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCollectionMoviesParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[2]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[2])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -4049,7 +4357,7 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "path",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4061,12 +4369,12 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Path = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4077,7 +4385,7 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "path",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -4085,13 +4393,12 @@ func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetRecentActionsParams is parameters of getRecentActions operation.
-type GetRecentActionsParams struct {
-	// Maximum number of results (default 20, max 50).
+// GetContinueListeningParams is parameters of getContinueListening operation.
+type GetContinueListeningParams struct {
 	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetRecentActionsParams(packed middleware.Parameters) (params GetRecentActionsParams) {
+func unpackGetContinueListeningParams(packed middleware.Parameters) (params GetContinueListeningParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "limit",
@@ -4104,11 +4411,11 @@ func unpackGetRecentActionsParams(packed middleware.Parameters) (params GetRecen
 	return params
 }
 
-func decodeGetRecentActionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentActionsParams, _ error) {
+func decodeGetContinueListeningParams(args [0]string, argsEscaped bool, r *http.Request) (params GetContinueListeningParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Set default value for query: limit.
 	{
-		val := int(20)
+		val := int(10)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -4180,88 +4487,74 @@ func decodeGetRecentActionsParams(args [0]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetRecentEpisodesParams is parameters of getRecentEpisodes operation.
-type GetRecentEpisodesParams struct {
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetContinueReadingParams is parameters of getContinueReading operation.
+type GetContinueReadingParams struct {
+	// Only list books or comics (both when omitted).
+	Kind  OptGetContinueReadingKind `json:",omitempty,omitzero"`
+	Limit OptInt                    `json:",omitempty,omitzero"`
 }
 
-func unpackGetRecentEpisodesParams(packed middleware.Parameters) (params GetRecentEpisodesParams) {
+func unpackGetContinueReadingParams(packed middleware.Parameters) (params GetContinueReadingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "kind",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.Kind = v.(OptGetContinueReadingKind)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "limit",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.Limit = v.(OptInt)
 		}
 	}
 	return params
 }
 
-func decodeGetRecentEpisodesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentEpisodesParams, _ error) {
+func decodeGetContinueReadingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetContinueReadingParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode query: kind.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "kind",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotKindVal GetContinueReadingKind
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotKindVal = GetContinueReadingKind(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Kind.SetTo(paramsDotKindVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.Kind.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -4276,27 +4569,27 @@ func decodeGetRecentEpisodesParams(args [0]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "kind",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	// Set default value for query: limit.
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		val := int(10)
+		params.Limit.SetTo(val)
 	}
-	// Decode query: offset.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4308,24 +4601,24 @@ func decodeGetRecentEpisodesParams(args [0]string, argsEscaped bool, r *http.Req
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
+				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -4347,7 +4640,7 @@ func decodeGetRecentEpisodesParams(args [0]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
@@ -4355,13 +4648,12 @@ func decodeGetRecentEpisodesParams(args [0]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetRecentlyAddedParams is parameters of getRecentlyAdded operation.
-type GetRecentlyAddedParams struct {
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetContinueWatchingParams is parameters of getContinueWatching operation.
+type GetContinueWatchingParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetRecentlyAddedParams(packed middleware.Parameters) (params GetRecentlyAddedParams) {
+func unpackGetContinueWatchingParams(packed middleware.Parameters) (params GetContinueWatchingParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "limit",
@@ -4371,23 +4663,14 @@ func unpackGetRecentlyAddedParams(packed middleware.Parameters) (params GetRecen
 			params.Limit = v.(OptInt)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
 	return params
 }
 
-func decodeGetRecentlyAddedParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentlyAddedParams, _ error) {
+func decodeGetContinueWatchingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetContinueWatchingParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Set default value for query: limit.
 	{
-		val := int(20)
+		val := int(10)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -4429,7 +4712,7 @@ func decodeGetRecentlyAddedParams(args [0]string, argsEscaped bool, r *http.Requ
 							MinSet:        true,
 							Min:           1,
 							MaxSet:        true,
-							Max:           100,
+							Max:           50,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -4456,197 +4739,447 @@ func decodeGetRecentlyAddedParams(args [0]string, argsEscaped bool, r *http.Requ
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// GetEpisodeMetadataParams is parameters of getEpisodeMetadata operation.
+type GetEpisodeMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// Season number.
+	SeasonNumber int
+	// Episode number.
+	EpisodeNumber int
+}
+
+func unpackGetEpisodeMetadataParams(packed middleware.Parameters) (params GetEpisodeMetadataParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
 	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	{
+		key := middleware.ParameterKey{
+			Name: "seasonNumber",
+			In:   "path",
 		}
+		params.SeasonNumber = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "episodeNumber",
+			In:   "path",
+		}
+		params.EpisodeNumber = packed[key].(int)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetEpisodeMetadataParams(args [3]string, argsEscaped bool, r *http.Request) (params GetEpisodeMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: seasonNumber.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "seasonNumber",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
 				}
+
+				params.SeasonNumber = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "seasonNumber",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: episodeNumber.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "episodeNumber",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.EpisodeNumber = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "episodeNumber",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetRecentlyAddedTVShowsParams is parameters of getRecentlyAddedTVShows operation.
-type GetRecentlyAddedTVShowsParams struct {
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetEpisodeMetadataImagesParams is parameters of getEpisodeMetadataImages operation.
+type GetEpisodeMetadataImagesParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// Season number.
+	SeasonNumber int
+	// Episode number.
+	EpisodeNumber int
+	// ISO 639-1 language filter.
+	Language OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetRecentlyAddedTVShowsParams(packed middleware.Parameters) (params GetRecentlyAddedTVShowsParams) {
+func unpackGetEpisodeMetadataImagesParams(packed middleware.Parameters) (params GetEpisodeMetadataImagesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "seasonNumber",
+			In:   "path",
 		}
+		params.SeasonNumber = packed[key].(int)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "episodeNumber",
+			In:   "path",
+		}
+		params.EpisodeNumber = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.Language = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetRecentlyAddedTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentlyAddedTVShowsParams, _ error) {
+func decodeGetEpisodeMetadataImagesParams(args [3]string, argsEscaped bool, r *http.Request) (params GetEpisodeMetadataImagesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode path: seasonNumber.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "seasonNumber",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.SeasonNumber = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "seasonNumber",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: episodeNumber.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "episodeNumber",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.EpisodeNumber = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "episodeNumber",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "language",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetHomeFeedParams is parameters of getHomeFeed operation.
+type GetHomeFeedParams struct {
+	// Items per row.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetHomeFeedParams(packed middleware.Parameters) (params GetHomeFeedParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetHomeFeedParams(args [0]string, argsEscaped bool, r *http.Request) (params GetHomeFeedParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -4658,24 +5191,24 @@ func decodeGetRecentlyAddedTVShowsParams(args [0]string, argsEscaped bool, r *ht
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
+				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -4697,7 +5230,7 @@ func decodeGetRecentlyAddedTVShowsParams(args [0]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
@@ -4705,32 +5238,22 @@ func decodeGetRecentlyAddedTVShowsParams(args [0]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetResourceActivityLogsParams is parameters of getResourceActivityLogs operation.
-type GetResourceActivityLogsParams struct {
-	// Resource type (user, library, movie, etc.).
-	ResourceType string
-	// Resource ID.
-	ResourceId uuid.UUID
-	// Maximum number of results (default 50, max 100).
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of results to skip.
+// GetHomeFeedRowParams is parameters of getHomeFeedRow operation.
+type GetHomeFeedRowParams struct {
+	// Row ID as returned by the home feed, e.g. on_deck,
+	// recently_added:movie or recently_added:<library id>.
+	ID     string
+	Limit  OptInt `json:",omitempty,omitzero"`
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetResourceActivityLogsParams(packed middleware.Parameters) (params GetResourceActivityLogsParams) {
+func unpackGetHomeFeedRowParams(packed middleware.Parameters) (params GetHomeFeedRowParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "resourceType",
-			In:   "path",
-		}
-		params.ResourceType = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "resourceId",
+			Name: "id",
 			In:   "path",
 		}
-		params.ResourceId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -4753,9 +5276,9 @@ func unpackGetResourceActivityLogsParams(packed middleware.Parameters) (params G
 	return params
 }
 
-func decodeGetResourceActivityLogsParams(args [2]string, argsEscaped bool, r *http.Request) (params GetResourceActivityLogsParams, _ error) {
+func decodeGetHomeFeedRowParams(args [1]string, argsEscaped bool, r *http.Request) (params GetHomeFeedRowParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: resourceType.
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4767,7 +5290,7 @@ func decodeGetResourceActivityLogsParams(args [2]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "resourceType",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4784,52 +5307,7 @@ func decodeGetResourceActivityLogsParams(args [2]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.ResourceType = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "resourceType",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode path: resourceId.
-	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "resourceId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.ResourceId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4840,14 +5318,14 @@ func decodeGetResourceActivityLogsParams(args [2]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "resourceId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
 	// Set default value for query: limit.
 	{
-		val := int(50)
+		val := int(20)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -4990,25 +5468,25 @@ func decodeGetResourceActivityLogsParams(args [2]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetRoleParams is parameters of getRole operation.
-type GetRoleParams struct {
-	// Role name.
-	RoleName string
+// GetHomeVideoParams is parameters of getHomeVideo operation.
+type GetHomeVideoParams struct {
+	// Home video ID.
+	ID uuid.UUID
 }
 
-func unpackGetRoleParams(packed middleware.Parameters) (params GetRoleParams) {
+func unpackGetHomeVideoParams(packed middleware.Parameters) (params GetHomeVideoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "roleName",
+			Name: "id",
 			In:   "path",
 		}
-		params.RoleName = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRoleParams, _ error) {
-	// Decode path: roleName.
+func decodeGetHomeVideoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetHomeVideoParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5020,7 +5498,7 @@ func decodeGetRoleParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "roleName",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5032,12 +5510,12 @@ func decodeGetRoleParams(args [1]string, argsEscaped bool, r *http.Request) (par
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.RoleName = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5048,7 +5526,7 @@ func decodeGetRoleParams(args [1]string, argsEscaped bool, r *http.Request) (par
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "roleName",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -5056,33 +5534,24 @@ func decodeGetRoleParams(args [1]string, argsEscaped bool, r *http.Request) (par
 	return params, nil
 }
 
-// GetSeasonMetadataParams is parameters of getSeasonMetadata operation.
-type GetSeasonMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// Season number.
-	SeasonNumber int
+// GetHomeVideoFolderParams is parameters of getHomeVideoFolder operation.
+type GetHomeVideoFolderParams struct {
+	// Folder ID.
+	ID uuid.UUID
 }
 
-func unpackGetSeasonMetadataParams(packed middleware.Parameters) (params GetSeasonMetadataParams) {
+func unpackGetHomeVideoFolderParams(packed middleware.Parameters) (params GetHomeVideoFolderParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "seasonNumber",
-			In:   "path",
-		}
-		params.SeasonNumber = packed[key].(int)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Request) (params GetSeasonMetadataParams, _ error) {
+func decodeGetHomeVideoFolderParams(args [1]string, argsEscaped bool, r *http.Request) (params GetHomeVideoFolderParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5107,7 +5576,7 @@ func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -5128,11 +5597,32 @@ func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
-	// Decode path: seasonNumber.
+	return params, nil
+}
+
+// GetHomeVideoProgressParams is parameters of getHomeVideoProgress operation.
+type GetHomeVideoProgressParams struct {
+	// Home video ID.
+	ID uuid.UUID
+}
+
+func unpackGetHomeVideoProgressParams(packed middleware.Parameters) (params GetHomeVideoProgressParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetHomeVideoProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetHomeVideoProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5140,7 +5630,7 @@ func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "seasonNumber",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5152,12 +5642,12 @@ func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.SeasonNumber = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5168,7 +5658,7 @@ func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "seasonNumber",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -5176,45 +5666,24 @@ func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetSeasonMetadataImagesParams is parameters of getSeasonMetadataImages operation.
-type GetSeasonMetadataImagesParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// Season number.
-	SeasonNumber int
-	// ISO 639-1 language filter.
-	Language OptString `json:",omitempty,omitzero"`
+// GetHomeVideoThumbnailParams is parameters of getHomeVideoThumbnail operation.
+type GetHomeVideoThumbnailParams struct {
+	// Home video ID.
+	ID uuid.UUID
 }
 
-func unpackGetSeasonMetadataImagesParams(packed middleware.Parameters) (params GetSeasonMetadataImagesParams) {
+func unpackGetHomeVideoThumbnailParams(packed middleware.Parameters) (params GetHomeVideoThumbnailParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "seasonNumber",
-			In:   "path",
-		}
-		params.SeasonNumber = packed[key].(int)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetSeasonMetadataImagesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetHomeVideoThumbnailParams(args [1]string, argsEscaped bool, r *http.Request) (params GetHomeVideoThumbnailParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5239,7 +5708,7 @@ func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -5260,11 +5729,32 @@ func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *ht
 			Err:  err,
 		}
 	}
-	// Decode path: seasonNumber.
+	return params, nil
+}
+
+// GetLegacyPerformerParams is parameters of getLegacyPerformer operation.
+type GetLegacyPerformerParams struct {
+	// Performer ID.
+	ID uuid.UUID
+}
+
+func unpackGetLegacyPerformerParams(packed middleware.Parameters) (params GetLegacyPerformerParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetLegacyPerformerParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLegacyPerformerParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -5272,7 +5762,7 @@ func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "seasonNumber",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5284,12 +5774,12 @@ func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.SeasonNumber = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5300,74 +5790,33 @@ func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "seasonNumber",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: language.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Language.SetTo(paramsDotLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	return params, nil
 }
 
-// GetServerSettingParams is parameters of getServerSetting operation.
-type GetServerSettingParams struct {
-	// Setting key.
-	Key string
+// GetLegacySceneParams is parameters of getLegacyScene operation.
+type GetLegacySceneParams struct {
+	// Scene ID.
+	ID uuid.UUID
 }
 
-func unpackGetServerSettingParams(packed middleware.Parameters) (params GetServerSettingParams) {
+func unpackGetLegacySceneParams(packed middleware.Parameters) (params GetLegacySceneParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "key",
+			Name: "id",
 			In:   "path",
 		}
-		params.Key = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetServerSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetServerSettingParams, _ error) {
-	// Decode path: key.
+func decodeGetLegacySceneParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLegacySceneParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5379,7 +5828,7 @@ func decodeGetServerSettingParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "key",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5391,12 +5840,12 @@ func decodeGetServerSettingParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Key = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5407,7 +5856,7 @@ func decodeGetServerSettingParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "key",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -5415,13 +5864,13 @@ func decodeGetServerSettingParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetSimilarMoviesParams is parameters of getSimilarMovies operation.
-type GetSimilarMoviesParams struct {
-	// Movie ID.
+// GetLegacyStudioParams is parameters of getLegacyStudio operation.
+type GetLegacyStudioParams struct {
+	// Studio ID.
 	ID uuid.UUID
 }
 
-func unpackGetSimilarMoviesParams(packed middleware.Parameters) (params GetSimilarMoviesParams) {
+func unpackGetLegacyStudioParams(packed middleware.Parameters) (params GetLegacyStudioParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -5432,7 +5881,7 @@ func unpackGetSimilarMoviesParams(packed middleware.Parameters) (params GetSimil
 	return params
 }
 
-func decodeGetSimilarMoviesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSimilarMoviesParams, _ error) {
+func decodeGetLegacyStudioParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLegacyStudioParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5481,37 +5930,25 @@ func decodeGetSimilarMoviesParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetSimilarMoviesMetadataParams is parameters of getSimilarMoviesMetadata operation.
-type GetSimilarMoviesMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
-	// Maximum number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
+// GetLibraryParams is parameters of getLibrary operation.
+type GetLibraryParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
 }
 
-func unpackGetSimilarMoviesMetadataParams(packed middleware.Parameters) (params GetSimilarMoviesMetadataParams) {
+func unpackGetLibraryParams(packed middleware.Parameters) (params GetLibraryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "libraryId",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
+		params.LibraryId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetSimilarMoviesMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSimilarMoviesMetadataParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: id.
+func decodeGetLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLibraryParams, _ error) {
+	// Decode path: libraryId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -5523,7 +5960,7 @@ func decodeGetSimilarMoviesMetadataParams(args [1]string, argsEscaped bool, r *h
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "libraryId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -5535,12 +5972,12 @@ func decodeGetSimilarMoviesMetadataParams(args [1]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.LibraryId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -5551,172 +5988,242 @@ func decodeGetSimilarMoviesMetadataParams(args [1]string, argsEscaped bool, r *h
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "libraryId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// GetLiveTVChannelParams is parameters of getLiveTVChannel operation.
+type GetLiveTVChannelParams struct {
+	// Channel ID.
+	ID uuid.UUID
+}
+
+func unpackGetLiveTVChannelParams(packed middleware.Parameters) (params GetLiveTVChannelParams) {
 	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+func decodeGetLiveTVChannelParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLiveTVChannelParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetTVContinueWatchingParams is parameters of getTVContinueWatching operation.
-type GetTVContinueWatchingParams struct {
-	Limit OptInt `json:",omitempty,omitzero"`
+// GetLiveTVGuideParams is parameters of getLiveTVGuide operation.
+type GetLiveTVGuideParams struct {
+	// Window start (default now).
+	Start OptDateTime `json:",omitempty,omitzero"`
+	// Window end (default six hours after start).
+	End OptDateTime `json:",omitempty,omitzero"`
+	// Only this channel.
+	ChannelID OptUUID `json:",omitempty,omitzero"`
 }
 
-func unpackGetTVContinueWatchingParams(packed middleware.Parameters) (params GetTVContinueWatchingParams) {
+func unpackGetLiveTVGuideParams(packed middleware.Parameters) (params GetLiveTVGuideParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
+			Name: "start",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			params.Start = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.End = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "channel_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ChannelID = v.(OptUUID)
 		}
 	}
 	return params
 }
 
-func decodeGetTVContinueWatchingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTVContinueWatchingParams, _ error) {
+func decodeGetLiveTVGuideParams(args [0]string, argsEscaped bool, r *http.Request) (params GetLiveTVGuideParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Start.SetTo(paramsDotStartVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
 	}
-	// Decode query: limit.
+	// Decode query: end.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "end",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotEndVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotEndVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.End.SetTo(paramsDotEndVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: channel_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "channel_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotChannelIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
 						return err
 					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotChannelIDVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.ChannelID.SetTo(paramsDotChannelIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "channel_id",
 			In:   "query",
 			Err:  err,
 		}
@@ -5724,13 +6231,13 @@ func decodeGetTVContinueWatchingParams(args [0]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// GetTVEpisodeParams is parameters of getTVEpisode operation.
-type GetTVEpisodeParams struct {
-	// Episode ID.
+// GetLiveTVProgramParams is parameters of getLiveTVProgram operation.
+type GetLiveTVProgramParams struct {
+	// Program ID.
 	ID uuid.UUID
 }
 
-func unpackGetTVEpisodeParams(packed middleware.Parameters) (params GetTVEpisodeParams) {
+func unpackGetLiveTVProgramParams(packed middleware.Parameters) (params GetLiveTVProgramParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -5741,7 +6248,7 @@ func unpackGetTVEpisodeParams(packed middleware.Parameters) (params GetTVEpisode
 	return params
 }
 
-func decodeGetTVEpisodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVEpisodeParams, _ error) {
+func decodeGetLiveTVProgramParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLiveTVProgramParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5790,13 +6297,13 @@ func decodeGetTVEpisodeParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetTVEpisodeFilesParams is parameters of getTVEpisodeFiles operation.
-type GetTVEpisodeFilesParams struct {
-	// Episode ID.
+// GetLiveTVRecordingParams is parameters of getLiveTVRecording operation.
+type GetLiveTVRecordingParams struct {
+	// Recording ID.
 	ID uuid.UUID
 }
 
-func unpackGetTVEpisodeFilesParams(packed middleware.Parameters) (params GetTVEpisodeFilesParams) {
+func unpackGetLiveTVRecordingParams(packed middleware.Parameters) (params GetLiveTVRecordingParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -5807,7 +6314,7 @@ func unpackGetTVEpisodeFilesParams(packed middleware.Parameters) (params GetTVEp
 	return params
 }
 
-func decodeGetTVEpisodeFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVEpisodeFilesParams, _ error) {
+func decodeGetLiveTVRecordingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetLiveTVRecordingParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5856,13 +6363,13 @@ func decodeGetTVEpisodeFilesParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetTVEpisodeProgressParams is parameters of getTVEpisodeProgress operation.
-type GetTVEpisodeProgressParams struct {
-	// Episode ID.
+// GetMovieParams is parameters of getMovie operation.
+type GetMovieParams struct {
+	// Movie ID.
 	ID uuid.UUID
 }
 
-func unpackGetTVEpisodeProgressParams(packed middleware.Parameters) (params GetTVEpisodeProgressParams) {
+func unpackGetMovieParams(packed middleware.Parameters) (params GetMovieParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -5873,7 +6380,7 @@ func unpackGetTVEpisodeProgressParams(packed middleware.Parameters) (params GetT
 	return params
 }
 
-func decodeGetTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVEpisodeProgressParams, _ error) {
+func decodeGetMovieParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5922,13 +6429,15 @@ func decodeGetTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetTVSeasonParams is parameters of getTVSeason operation.
-type GetTVSeasonParams struct {
-	// Season ID.
-	ID uuid.UUID
+// GetMovieCastParams is parameters of getMovieCast operation.
+type GetMovieCastParams struct {
+	// Movie ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetTVSeasonParams(packed middleware.Parameters) (params GetTVSeasonParams) {
+func unpackGetMovieCastParams(packed middleware.Parameters) (params GetMovieCastParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -5936,10 +6445,29 @@ func unpackGetTVSeasonParams(packed middleware.Parameters) (params GetTVSeasonPa
 		}
 		params.ID = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
 	return params
 }
 
-func decodeGetTVSeasonParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVSeasonParams, _ error) {
+func decodeGetMovieCastParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieCastParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -5985,226 +6513,7 @@ func decodeGetTVSeasonParams(args [1]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetTVSeasonEpisodesParams is parameters of getTVSeasonEpisodes operation.
-type GetTVSeasonEpisodesParams struct {
-	// Season ID.
-	ID uuid.UUID
-}
-
-func unpackGetTVSeasonEpisodesParams(packed middleware.Parameters) (params GetTVSeasonEpisodesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetTVSeasonEpisodesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVSeasonEpisodesParams, _ error) {
-	// Decode path: id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.ID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetTVShowParams is parameters of getTVShow operation.
-type GetTVShowParams struct {
-	// TV Show ID.
-	ID uuid.UUID
-}
-
-func unpackGetTVShowParams(packed middleware.Parameters) (params GetTVShowParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetTVShowParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowParams, _ error) {
-	// Decode path: id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.ID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetTVShowCastParams is parameters of getTVShowCast operation.
-type GetTVShowCastParams struct {
-	// TV Show ID.
-	ID     uuid.UUID
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackGetTVShowCastParams(packed middleware.Parameters) (params GetTVShowCastParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeGetTVShowCastParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowCastParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: id.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.ID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Set default value for query: limit.
+	// Set default value for query: limit.
 	{
 		val := int(50)
 		params.Limit.SetTo(val)
@@ -6349,24 +6658,24 @@ func decodeGetTVShowCastParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetTVShowContentRatingsParams is parameters of getTVShowContentRatings operation.
-type GetTVShowContentRatingsParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetMovieCollectionParams is parameters of getMovieCollection operation.
+type GetMovieCollectionParams struct {
+	// Movie ID.
+	ID uuid.UUID
 }
 
-func unpackGetTVShowContentRatingsParams(packed middleware.Parameters) (params GetTVShowContentRatingsParams) {
+func unpackGetMovieCollectionParams(packed middleware.Parameters) (params GetMovieCollectionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetTVShowContentRatingsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowContentRatingsParams, _ error) {
+func decodeGetMovieCollectionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieCollectionParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -6391,7 +6700,7 @@ func decodeGetTVShowContentRatingsParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -6415,15 +6724,15 @@ func decodeGetTVShowContentRatingsParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetTVShowCrewParams is parameters of getTVShowCrew operation.
-type GetTVShowCrewParams struct {
-	// TV Show ID.
+// GetMovieCrewParams is parameters of getMovieCrew operation.
+type GetMovieCrewParams struct {
+	// Movie ID.
 	ID     uuid.UUID
 	Limit  OptInt `json:",omitempty,omitzero"`
 	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetTVShowCrewParams(packed middleware.Parameters) (params GetTVShowCrewParams) {
+func unpackGetMovieCrewParams(packed middleware.Parameters) (params GetMovieCrewParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -6452,7 +6761,7 @@ func unpackGetTVShowCrewParams(packed middleware.Parameters) (params GetTVShowCr
 	return params
 }
 
-func decodeGetTVShowCrewParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowCrewParams, _ error) {
+func decodeGetMovieCrewParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieCrewParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
@@ -6644,13 +6953,13 @@ func decodeGetTVShowCrewParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetTVShowEpisodesParams is parameters of getTVShowEpisodes operation.
-type GetTVShowEpisodesParams struct {
-	// TV Show ID.
+// GetMovieEditionsParams is parameters of getMovieEditions operation.
+type GetMovieEditionsParams struct {
+	// Movie ID.
 	ID uuid.UUID
 }
 
-func unpackGetTVShowEpisodesParams(packed middleware.Parameters) (params GetTVShowEpisodesParams) {
+func unpackGetMovieEditionsParams(packed middleware.Parameters) (params GetMovieEditionsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -6661,7 +6970,7 @@ func unpackGetTVShowEpisodesParams(packed middleware.Parameters) (params GetTVSh
 	return params
 }
 
-func decodeGetTVShowEpisodesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowEpisodesParams, _ error) {
+func decodeGetMovieEditionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieEditionsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -6710,13 +7019,13 @@ func decodeGetTVShowEpisodesParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetTVShowExternalIDsParams is parameters of getTVShowExternalIDs operation.
-type GetTVShowExternalIDsParams struct {
+// GetMovieExternalIDsParams is parameters of getMovieExternalIDs operation.
+type GetMovieExternalIDsParams struct {
 	// Metadata provider ID (e.g. TMDb ID).
 	ID string
 }
 
-func unpackGetTVShowExternalIDsParams(packed middleware.Parameters) (params GetTVShowExternalIDsParams) {
+func unpackGetMovieExternalIDsParams(packed middleware.Parameters) (params GetMovieExternalIDsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -6727,7 +7036,7 @@ func unpackGetTVShowExternalIDsParams(packed middleware.Parameters) (params GetT
 	return params
 }
 
-func decodeGetTVShowExternalIDsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowExternalIDsParams, _ error) {
+func decodeGetMovieExternalIDsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieExternalIDsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -6776,13 +7085,13 @@ func decodeGetTVShowExternalIDsParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetTVShowGenresParams is parameters of getTVShowGenres operation.
-type GetTVShowGenresParams struct {
-	// TV Show ID.
+// GetMovieExtrasParams is parameters of getMovieExtras operation.
+type GetMovieExtrasParams struct {
+	// Movie ID.
 	ID uuid.UUID
 }
 
-func unpackGetTVShowGenresParams(packed middleware.Parameters) (params GetTVShowGenresParams) {
+func unpackGetMovieExtrasParams(packed middleware.Parameters) (params GetMovieExtrasParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -6793,7 +7102,7 @@ func unpackGetTVShowGenresParams(packed middleware.Parameters) (params GetTVShow
 	return params
 }
 
-func decodeGetTVShowGenresParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowGenresParams, _ error) {
+func decodeGetMovieExtrasParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieExtrasParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -6842,24 +7151,24 @@ func decodeGetTVShowGenresParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// GetTVShowMetadataParams is parameters of getTVShowMetadata operation.
-type GetTVShowMetadataParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetMovieFilesParams is parameters of getMovieFiles operation.
+type GetMovieFilesParams struct {
+	// Movie ID.
+	ID uuid.UUID
 }
 
-func unpackGetTVShowMetadataParams(packed middleware.Parameters) (params GetTVShowMetadataParams) {
+func unpackGetMovieFilesParams(packed middleware.Parameters) (params GetMovieFilesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowMetadataParams, _ error) {
+func decodeGetMovieFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieFilesParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -6884,7 +7193,7 @@ func decodeGetTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -6908,24 +7217,24 @@ func decodeGetTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetTVShowMetadataCreditsParams is parameters of getTVShowMetadataCredits operation.
-type GetTVShowMetadataCreditsParams struct {
-	// Metadata provider ID (e.g. TMDb ID).
-	ID string
+// GetMovieGenresParams is parameters of getMovieGenres operation.
+type GetMovieGenresParams struct {
+	// Movie ID.
+	ID uuid.UUID
 }
 
-func unpackGetTVShowMetadataCreditsParams(packed middleware.Parameters) (params GetTVShowMetadataCreditsParams) {
+func unpackGetMovieGenresParams(packed middleware.Parameters) (params GetMovieGenresParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetTVShowMetadataCreditsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowMetadataCreditsParams, _ error) {
+func decodeGetMovieGenresParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieGenresParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -6950,7 +7259,7 @@ func decodeGetTVShowMetadataCreditsParams(args [1]string, argsEscaped bool, r *h
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
@@ -6974,15 +7283,13 @@ func decodeGetTVShowMetadataCreditsParams(args [1]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetTVShowMetadataImagesParams is parameters of getTVShowMetadataImages operation.
-type GetTVShowMetadataImagesParams struct {
+// GetMovieMetadataParams is parameters of getMovieMetadata operation.
+type GetMovieMetadataParams struct {
 	// Metadata provider ID (e.g. TMDb ID).
 	ID string
-	// ISO 639-1 language filter.
-	Language OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetTVShowMetadataImagesParams(packed middleware.Parameters) (params GetTVShowMetadataImagesParams) {
+func unpackGetMovieMetadataParams(packed middleware.Parameters) (params GetMovieMetadataParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -6990,20 +7297,10 @@ func unpackGetTVShowMetadataImagesParams(packed middleware.Parameters) (params G
 		}
 		params.ID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
-		}
-	}
 	return params
 }
 
-func decodeGetTVShowMetadataImagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowMetadataImagesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
+func decodeGetMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieMetadataParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -7049,68 +7346,27 @@ func decodeGetTVShowMetadataImagesParams(args [1]string, argsEscaped bool, r *ht
 			Err:  err,
 		}
 	}
-	// Decode query: language.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Language.SetTo(paramsDotLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
-			Err:  err,
-		}
-	}
 	return params, nil
 }
 
-// GetTVShowNetworksParams is parameters of getTVShowNetworks operation.
-type GetTVShowNetworksParams struct {
-	// TV Show ID.
-	ID uuid.UUID
+// GetMovieMetadataCreditsParams is parameters of getMovieMetadataCredits operation.
+type GetMovieMetadataCreditsParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
 }
 
-func unpackGetTVShowNetworksParams(packed middleware.Parameters) (params GetTVShowNetworksParams) {
+func unpackGetMovieMetadataCreditsParams(packed middleware.Parameters) (params GetMovieMetadataCreditsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetTVShowNetworksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowNetworksParams, _ error) {
+func decodeGetMovieMetadataCreditsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieMetadataCreditsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -7135,7 +7391,7 @@ func decodeGetTVShowNetworksParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -7159,24 +7415,36 @@ func decodeGetTVShowNetworksParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetTVShowNextEpisodeParams is parameters of getTVShowNextEpisode operation.
-type GetTVShowNextEpisodeParams struct {
-	// TV Show ID.
-	ID uuid.UUID
+// GetMovieMetadataImagesParams is parameters of getMovieMetadataImages operation.
+type GetMovieMetadataImagesParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// ISO 639-1 language filter (e.g. en, de).
+	Language OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetTVShowNextEpisodeParams(packed middleware.Parameters) (params GetTVShowNextEpisodeParams) {
+func unpackGetMovieMetadataImagesParams(packed middleware.Parameters) (params GetMovieMetadataImagesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptString)
+		}
 	}
 	return params
 }
 
-func decodeGetTVShowNextEpisodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowNextEpisodeParams, _ error) {
+func decodeGetMovieMetadataImagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieMetadataImagesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -7201,7 +7469,7 @@ func decodeGetTVShowNextEpisodeParams(args [1]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -7222,93 +7490,80 @@ func decodeGetTVShowNextEpisodeParams(args [1]string, argsEscaped bool, r *http.
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetTVShowSeasonsParams is parameters of getTVShowSeasons operation.
-type GetTVShowSeasonsParams struct {
-	// TV Show ID.
-	ID uuid.UUID
-}
-
-func unpackGetTVShowSeasonsParams(packed middleware.Parameters) (params GetTVShowSeasonsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetTVShowSeasonsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowSeasonsParams, _ error) {
-	// Decode path: id.
+	// Decode query: language.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLanguageVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Language.SetTo(paramsDotLanguageVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "language",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetTVShowWatchStatsParams is parameters of getTVShowWatchStats operation.
-type GetTVShowWatchStatsParams struct {
-	// TV Show ID.
-	ID uuid.UUID
+// GetMovieRecommendationsMetadataParams is parameters of getMovieRecommendationsMetadata operation.
+type GetMovieRecommendationsMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// Maximum number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackGetTVShowWatchStatsParams(packed middleware.Parameters) (params GetTVShowWatchStatsParams) {
+func unpackGetMovieRecommendationsMetadataParams(packed middleware.Parameters) (params GetMovieRecommendationsMetadataParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
 	}
 	return params
 }
 
-func decodeGetTVShowWatchStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowWatchStatsParams, _ error) {
+func decodeGetMovieRecommendationsMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieRecommendationsMetadataParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -7333,7 +7588,7 @@ func decodeGetTVShowWatchStatsParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -7354,122 +7609,7 @@ func decodeGetTVShowWatchStatsParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetTopRatedParams is parameters of getTopRated operation.
-type GetTopRatedParams struct {
-	// Minimum number of votes required.
-	MinVotes OptInt `json:",omitempty,omitzero"`
-	Limit    OptInt `json:",omitempty,omitzero"`
-	Offset   OptInt `json:",omitempty,omitzero"`
-}
-
-func unpackGetTopRatedParams(packed middleware.Parameters) (params GetTopRatedParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "min_votes",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.MinVotes = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
-	return params
-}
-
-func decodeGetTopRatedParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTopRatedParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: min_votes.
-	{
-		val := int(100)
-		params.MinVotes.SetTo(val)
-	}
-	// Decode query: min_votes.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "min_votes",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotMinVotesVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotMinVotesVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.MinVotes.SetTo(paramsDotMinVotesVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.MinVotes.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "min_votes",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: limit.
+	// Set default value for query: limit.
 	{
 		val := int(20)
 		params.Limit.SetTo(val)
@@ -7540,297 +7680,226 @@ func decodeGetTopRatedParams(args [0]string, argsEscaped bool, r *http.Request)
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// GetMovieTagsParams is parameters of getMovieTags operation.
+type GetMovieTagsParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackGetMovieTagsParams(packed middleware.Parameters) (params GetMovieTagsParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeGetMovieTagsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMovieTagsParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUpcomingEpisodesParams is parameters of getUpcomingEpisodes operation.
-type GetUpcomingEpisodesParams struct {
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetMusicAlbumParams is parameters of getMusicAlbum operation.
+type GetMusicAlbumParams struct {
+	// Album (release) ID.
+	ID uuid.UUID
 }
 
-func unpackGetUpcomingEpisodesParams(packed middleware.Parameters) (params GetUpcomingEpisodesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackGetMusicAlbumParams(packed middleware.Parameters) (params GetMusicAlbumParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUpcomingEpisodesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUpcomingEpisodesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+func decodeGetMusicAlbumParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicAlbumParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// GetMusicAlbumEditionsParams is parameters of getMusicAlbumEditions operation.
+type GetMusicAlbumEditionsParams struct {
+	// Album (release) ID.
+	ID uuid.UUID
+}
+
+func unpackGetMusicAlbumEditionsParams(packed middleware.Parameters) (params GetMusicAlbumEditionsParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeGetMusicAlbumEditionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicAlbumEditionsParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUserActivityLogsParams is parameters of getUserActivityLogs operation.
-type GetUserActivityLogsParams struct {
-	// User ID.
-	UserId uuid.UUID
-	// Maximum number of results (default 50, max 100).
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of results to skip.
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetMusicArtistParams is parameters of getMusicArtist operation.
+type GetMusicArtistParams struct {
+	// Artist ID.
+	ID uuid.UUID
 }
 
-func unpackGetUserActivityLogsParams(packed middleware.Parameters) (params GetUserActivityLogsParams) {
+func unpackGetMusicArtistParams(packed middleware.Parameters) (params GetMusicArtistParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "id",
 			In:   "path",
 		}
-		params.UserId = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUserActivityLogsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserActivityLogsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: userId.
+func decodeGetMusicArtistParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicArtistParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -7842,7 +7911,7 @@ func decodeGetUserActivityLogsParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -7859,7 +7928,7 @@ func decodeGetUserActivityLogsParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.UserId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -7870,60 +7939,213 @@ func decodeGetUserActivityLogsParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// GetMusicArtistAlbumsParams is parameters of getMusicArtistAlbums operation.
+type GetMusicArtistAlbumsParams struct {
+	// Artist ID.
+	ID uuid.UUID
+}
+
+func unpackGetMusicArtistAlbumsParams(packed middleware.Parameters) (params GetMusicArtistAlbumsParams) {
 	{
-		val := int(50)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeGetMusicArtistAlbumsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicArtistAlbumsParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMusicArtistTracksParams is parameters of getMusicArtistTracks operation.
+type GetMusicArtistTracksParams struct {
+	// Artist ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetMusicArtistTracksParams(packed middleware.Parameters) (params GetMusicArtistTracksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetMusicArtistTracksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicArtistTracksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
 							Pattern:       nil,
 						}).Validate(int64(value)); err != nil {
 							return errors.Wrap(err, "int")
@@ -8020,25 +8242,25 @@ func decodeGetUserActivityLogsParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// GetUserByIdParams is parameters of getUserById operation.
-type GetUserByIdParams struct {
-	// User ID.
-	UserId uuid.UUID
+// GetMusicTrackParams is parameters of getMusicTrack operation.
+type GetMusicTrackParams struct {
+	// Track ID.
+	ID uuid.UUID
 }
 
-func unpackGetUserByIdParams(packed middleware.Parameters) (params GetUserByIdParams) {
+func unpackGetMusicTrackParams(packed middleware.Parameters) (params GetMusicTrackParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "id",
 			In:   "path",
 		}
-		params.UserId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUserByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserByIdParams, _ error) {
-	// Decode path: userId.
+func decodeGetMusicTrackParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicTrackParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8050,7 +8272,7 @@ func decodeGetUserByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8067,7 +8289,7 @@ func decodeGetUserByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.UserId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8078,7 +8300,7 @@ func decodeGetUserByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8086,25 +8308,25 @@ func decodeGetUserByIdParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetUserRolesParams is parameters of getUserRoles operation.
-type GetUserRolesParams struct {
-	// User ID.
-	UserId uuid.UUID
+// GetMusicVideoParams is parameters of getMusicVideo operation.
+type GetMusicVideoParams struct {
+	// Music video ID.
+	ID uuid.UUID
 }
 
-func unpackGetUserRolesParams(packed middleware.Parameters) (params GetUserRolesParams) {
+func unpackGetMusicVideoParams(packed middleware.Parameters) (params GetMusicVideoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "id",
 			In:   "path",
 		}
-		params.UserId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUserRolesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserRolesParams, _ error) {
-	// Decode path: userId.
+func decodeGetMusicVideoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicVideoParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8116,7 +8338,7 @@ func decodeGetUserRolesParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8133,7 +8355,7 @@ func decodeGetUserRolesParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.UserId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8144,7 +8366,7 @@ func decodeGetUserRolesParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8152,25 +8374,25 @@ func decodeGetUserRolesParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetUserSettingParams is parameters of getUserSetting operation.
-type GetUserSettingParams struct {
-	// Setting key.
-	Key string
+// GetMusicVideoArtistParams is parameters of getMusicVideoArtist operation.
+type GetMusicVideoArtistParams struct {
+	// Artist ID.
+	ID uuid.UUID
 }
 
-func unpackGetUserSettingParams(packed middleware.Parameters) (params GetUserSettingParams) {
+func unpackGetMusicVideoArtistParams(packed middleware.Parameters) (params GetMusicVideoArtistParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "key",
+			Name: "id",
 			In:   "path",
 		}
-		params.Key = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetUserSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserSettingParams, _ error) {
-	// Decode path: key.
+func decodeGetMusicVideoArtistParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMusicVideoArtistParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8182,7 +8404,7 @@ func decodeGetUserSettingParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "key",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8194,12 +8416,12 @@ func decodeGetUserSettingParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Key = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8210,7 +8432,7 @@ func decodeGetUserSettingParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "key",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8218,199 +8440,209 @@ func decodeGetUserSettingParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetWatchHistoryParams is parameters of getWatchHistory operation.
-type GetWatchHistoryParams struct {
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetPersonParams is parameters of getPerson operation.
+type GetPersonParams struct {
+	// Person ID.
+	PersonId uuid.UUID
+	// Preferred biography language (ISO 639-1).
+	Language OptString `json:",omitempty,omitzero"`
 }
 
-func unpackGetWatchHistoryParams(packed middleware.Parameters) (params GetWatchHistoryParams) {
+func unpackGetPersonParams(packed middleware.Parameters) (params GetPersonParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "personId",
+			In:   "path",
 		}
+		params.PersonId = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "language",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.Language = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetWatchHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWatchHistoryParams, _ error) {
+func decodeGetPersonParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: personId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "personId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
+
+				params.PersonId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "personId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
+	// Decode query: language.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "language",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLanguageVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLanguageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Language.SetTo(paramsDotLanguageVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPersonFilmographyParams is parameters of getPersonFilmography operation.
+type GetPersonFilmographyParams struct {
+	// Person ID.
+	PersonId uuid.UUID
+}
+
+func unpackGetPersonFilmographyParams(packed middleware.Parameters) (params GetPersonFilmographyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "personId",
+			In:   "path",
+		}
+		params.PersonId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetPersonFilmographyParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonFilmographyParams, _ error) {
+	// Decode path: personId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "personId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.PersonId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "personId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetWatchProgressParams is parameters of getWatchProgress operation.
-type GetWatchProgressParams struct {
-	// Movie ID.
-	ID uuid.UUID
+// GetPersonMetadataParams is parameters of getPersonMetadata operation.
+type GetPersonMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
 }
 
-func unpackGetWatchProgressParams(packed middleware.Parameters) (params GetWatchProgressParams) {
+func unpackGetPersonMetadataParams(packed middleware.Parameters) (params GetPersonMetadataParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
 			In:   "path",
 		}
-		params.ID = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetWatchProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWatchProgressParams, _ error) {
+func decodeGetPersonMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonMetadataParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -8435,7 +8667,7 @@ func decodeGetWatchProgressParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
@@ -8459,25 +8691,25 @@ func decodeGetWatchProgressParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GrantLibraryPermissionParams is parameters of grantLibraryPermission operation.
-type GrantLibraryPermissionParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
+// GetPersonMetadataCreditsParams is parameters of getPersonMetadataCredits operation.
+type GetPersonMetadataCreditsParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
 }
 
-func unpackGrantLibraryPermissionParams(packed middleware.Parameters) (params GrantLibraryPermissionParams) {
+func unpackGetPersonMetadataCreditsParams(packed middleware.Parameters) (params GetPersonMetadataCreditsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGrantLibraryPermissionParams(args [1]string, argsEscaped bool, r *http.Request) (params GrantLibraryPermissionParams, _ error) {
-	// Decode path: libraryId.
+func decodeGetPersonMetadataCreditsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonMetadataCreditsParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8489,7 +8721,7 @@ func decodeGrantLibraryPermissionParams(args [1]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8501,12 +8733,12 @@ func decodeGrantLibraryPermissionParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.LibraryId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8517,7 +8749,7 @@ func decodeGrantLibraryPermissionParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8525,25 +8757,25 @@ func decodeGrantLibraryPermissionParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// InitOIDCLinkParams is parameters of initOIDCLink operation.
-type InitOIDCLinkParams struct {
-	// Provider name to link.
-	Provider string
+// GetPersonMetadataImagesParams is parameters of getPersonMetadataImages operation.
+type GetPersonMetadataImagesParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
 }
 
-func unpackInitOIDCLinkParams(packed middleware.Parameters) (params InitOIDCLinkParams) {
+func unpackGetPersonMetadataImagesParams(packed middleware.Parameters) (params GetPersonMetadataImagesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "provider",
+			Name: "id",
 			In:   "path",
 		}
-		params.Provider = packed[key].(string)
+		params.ID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeInitOIDCLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params InitOIDCLinkParams, _ error) {
-	// Decode path: provider.
+func decodeGetPersonMetadataImagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPersonMetadataImagesParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8555,7 +8787,7 @@ func decodeInitOIDCLinkParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "provider",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8572,7 +8804,7 @@ func decodeInitOIDCLinkParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Provider = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8583,7 +8815,7 @@ func decodeInitOIDCLinkParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8591,25 +8823,25 @@ func decodeInitOIDCLinkParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// ListLibraryPermissionsParams is parameters of listLibraryPermissions operation.
-type ListLibraryPermissionsParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
+// GetPhotoParams is parameters of getPhoto operation.
+type GetPhotoParams struct {
+	// Photo ID.
+	ID uuid.UUID
 }
 
-func unpackListLibraryPermissionsParams(packed middleware.Parameters) (params ListLibraryPermissionsParams) {
+func unpackGetPhotoParams(packed middleware.Parameters) (params GetPhotoParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListLibraryPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListLibraryPermissionsParams, _ error) {
-	// Decode path: libraryId.
+func decodeGetPhotoParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPhotoParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8621,7 +8853,7 @@ func decodeListLibraryPermissionsParams(args [1]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8638,7 +8870,7 @@ func decodeListLibraryPermissionsParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.LibraryId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8649,7 +8881,7 @@ func decodeListLibraryPermissionsParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -8657,48 +8889,25 @@ func decodeListLibraryPermissionsParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// ListLibraryScansParams is parameters of listLibraryScans operation.
-type ListLibraryScansParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
-	// Maximum number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of results to skip.
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetPhotoAlbumParams is parameters of getPhotoAlbum operation.
+type GetPhotoAlbumParams struct {
+	// Album ID.
+	ID uuid.UUID
 }
 
-func unpackListLibraryScansParams(packed middleware.Parameters) (params ListLibraryScansParams) {
+func unpackGetPhotoAlbumParams(packed middleware.Parameters) (params GetPhotoAlbumParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Request) (params ListLibraryScansParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: libraryId.
+func decodeGetPhotoAlbumParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPhotoAlbumParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -8710,7 +8919,7 @@ func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -8727,7 +8936,7 @@ func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.LibraryId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -8738,63 +8947,131 @@ func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
+	return params, nil
+}
+
+// GetPhotoThumbnailParams is parameters of getPhotoThumbnail operation.
+type GetPhotoThumbnailParams struct {
+	// Photo ID.
+	ID uuid.UUID
+	// Thumbnail width.
+	Size OptGetPhotoThumbnailSize `json:",omitempty,omitzero"`
+}
+
+func unpackGetPhotoThumbnailParams(packed middleware.Parameters) (params GetPhotoThumbnailParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptGetPhotoThumbnailSize)
+		}
+	}
+	return params
+}
+
+func decodeGetPhotoThumbnailParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPhotoThumbnailParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: size.
+	{
+		val := GetPhotoThumbnailSize("w320")
+		params.Size.SetTo(val)
+	}
+	// Decode query: size.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "size",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotSizeVal GetPhotoThumbnailSize
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotSizeVal = GetPhotoThumbnailSize(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.Size.SetTo(paramsDotSizeVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
+				if value, ok := params.Size.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        false,
-							Min:           0,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -8809,44 +9086,104 @@ func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "size",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// GetPhotoTimelineParams is parameters of getPhotoTimeline operation.
+type GetPhotoTimelineParams struct {
+	// Only include photos of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Only include photos or clips (both when omitted).
+	Kind        OptGetPhotoTimelineKind        `json:",omitempty,omitzero"`
+	Granularity OptGetPhotoTimelineGranularity `json:",omitempty,omitzero"`
+	Limit       OptInt                         `json:",omitempty,omitzero"`
+	Offset      OptInt                         `json:",omitempty,omitzero"`
+}
+
+func unpackGetPhotoTimelineParams(packed middleware.Parameters) (params GetPhotoTimelineParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
 	}
-	// Decode query: offset.
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptGetPhotoTimelineKind)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "granularity",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Granularity = v.(OptGetPhotoTimelineGranularity)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetPhotoTimelineParams(args [0]string, argsEscaped bool, r *http.Request) (params GetPhotoTimelineParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "library_id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLibraryIDVal uuid.UUID
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToUUID(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLibraryIDVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -8855,73 +9192,83 @@ func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "library_id",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// ListMoviesParams is parameters of listMovies operation.
-type ListMoviesParams struct {
-	// Sort order (title, year, added, rating).
-	OrderBy OptListMoviesOrderBy `json:",omitempty,omitzero"`
-	// Number of movies per page.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of movies to skip.
-	Offset OptInt `json:",omitempty,omitzero"`
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal GetPhotoTimelineKind
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackListMoviesParams(packed middleware.Parameters) (params ListMoviesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "order_by",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.OrderBy = v.(OptListMoviesOrderBy)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = GetPhotoTimelineKind(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Kind.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
 			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Err:  err,
 		}
 	}
-	return params
-}
-
-func decodeListMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMoviesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: order_by.
+	// Set default value for query: granularity.
 	{
-		val := ListMoviesOrderBy("added")
-		params.OrderBy.SetTo(val)
+		val := GetPhotoTimelineGranularity("day")
+		params.Granularity.SetTo(val)
 	}
-	// Decode query: order_by.
+	// Decode query: granularity.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order_by",
+			Name:    "granularity",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderByVal ListMoviesOrderBy
+				var paramsDotGranularityVal GetPhotoTimelineGranularity
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -8933,18 +9280,18 @@ func decodeListMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (
 						return err
 					}
 
-					paramsDotOrderByVal = ListMoviesOrderBy(c)
+					paramsDotGranularityVal = GetPhotoTimelineGranularity(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.OrderBy.SetTo(paramsDotOrderByVal)
+				params.Granularity.SetTo(paramsDotGranularityVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.OrderBy.Get(); ok {
+				if value, ok := params.Granularity.Get(); ok {
 					if err := func() error {
 						if err := value.Validate(); err != nil {
 							return err
@@ -8962,14 +9309,14 @@ func decodeListMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order_by",
+			Name: "granularity",
 			In:   "query",
 			Err:  err,
 		}
 	}
 	// Set default value for query: limit.
 	{
-		val := int(20)
+		val := int(100)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -9011,7 +9358,7 @@ func decodeListMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (
 							MinSet:        true,
 							Min:           1,
 							MaxSet:        true,
-							Max:           100,
+							Max:           1000,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -9112,260 +9459,78 @@ func decodeListMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// ListTVShowsParams is parameters of listTVShows operation.
-type ListTVShowsParams struct {
-	// Field to sort by.
-	OrderBy OptListTVShowsOrderBy `json:",omitempty,omitzero"`
-	Limit   OptInt                `json:",omitempty,omitzero"`
-	Offset  OptInt                `json:",omitempty,omitzero"`
+// GetPlaybackSessionParams is parameters of getPlaybackSession operation.
+type GetPlaybackSessionParams struct {
+	SessionId uuid.UUID
 }
 
-func unpackListTVShowsParams(packed middleware.Parameters) (params ListTVShowsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "order_by",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.OrderBy = v.(OptListTVShowsOrderBy)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackGetPlaybackSessionParams(packed middleware.Parameters) (params GetPlaybackSessionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Name: "sessionId",
+			In:   "path",
 		}
+		params.SessionId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeListTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTVShowsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: order_by.
-	{
-		val := ListTVShowsOrderBy("created_at")
-		params.OrderBy.SetTo(val)
-	}
-	// Decode query: order_by.
+func decodeGetPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPlaybackSessionParams, _ error) {
+	// Decode path: sessionId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderByVal ListTVShowsOrderBy
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOrderByVal = ListTVShowsOrderBy(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.OrderBy.SetTo(paramsDotOrderByVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.OrderBy.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "order_by",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "sessionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Set default value for query: offset.
-	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
+
+				params.SessionId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "sessionId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// MarkAsWatchedParams is parameters of markAsWatched operation.
-type MarkAsWatchedParams struct {
-	// Movie ID.
+// GetPodcastParams is parameters of getPodcast operation.
+type GetPodcastParams struct {
+	// Podcast ID.
 	ID uuid.UUID
 }
 
-func unpackMarkAsWatchedParams(packed middleware.Parameters) (params MarkAsWatchedParams) {
+func unpackGetPodcastParams(packed middleware.Parameters) (params GetPodcastParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -9376,7 +9541,7 @@ func unpackMarkAsWatchedParams(packed middleware.Parameters) (params MarkAsWatch
 	return params
 }
 
-func decodeMarkAsWatchedParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkAsWatchedParams, _ error) {
+func decodeGetPodcastParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPodcastParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -9425,13 +9590,13 @@ func decodeMarkAsWatchedParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// MarkTVEpisodeWatchedParams is parameters of markTVEpisodeWatched operation.
-type MarkTVEpisodeWatchedParams struct {
+// GetPodcastEpisodeParams is parameters of getPodcastEpisode operation.
+type GetPodcastEpisodeParams struct {
 	// Episode ID.
 	ID uuid.UUID
 }
 
-func unpackMarkTVEpisodeWatchedParams(packed middleware.Parameters) (params MarkTVEpisodeWatchedParams) {
+func unpackGetPodcastEpisodeParams(packed middleware.Parameters) (params GetPodcastEpisodeParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -9442,7 +9607,7 @@ func unpackMarkTVEpisodeWatchedParams(packed middleware.Parameters) (params Mark
 	return params
 }
 
-func decodeMarkTVEpisodeWatchedParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkTVEpisodeWatchedParams, _ error) {
+func decodeGetPodcastEpisodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPodcastEpisodeParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -9491,37 +9656,25 @@ func decodeMarkTVEpisodeWatchedParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// OidcAuthorizeParams is parameters of oidcAuthorize operation.
-type OidcAuthorizeParams struct {
-	// Provider name (e.g., authentik, keycloak).
-	Provider string
-	// URL to redirect after successful auth.
-	RedirectURL OptString `json:",omitempty,omitzero"`
+// GetPodcastEpisodeProgressParams is parameters of getPodcastEpisodeProgress operation.
+type GetPodcastEpisodeProgressParams struct {
+	// Episode ID.
+	ID uuid.UUID
 }
 
-func unpackOidcAuthorizeParams(packed middleware.Parameters) (params OidcAuthorizeParams) {
+func unpackGetPodcastEpisodeProgressParams(packed middleware.Parameters) (params GetPodcastEpisodeProgressParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "provider",
+			Name: "id",
 			In:   "path",
 		}
-		params.Provider = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "redirect_url",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.RedirectURL = v.(OptString)
-		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeOidcAuthorizeParams(args [1]string, argsEscaped bool, r *http.Request) (params OidcAuthorizeParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: provider.
+func decodeGetPodcastEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPodcastEpisodeProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -9533,7 +9686,7 @@ func decodeOidcAuthorizeParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "provider",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -9545,12 +9698,12 @@ func decodeOidcAuthorizeParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Provider = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -9561,115 +9714,117 @@ func decodeOidcAuthorizeParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: redirect_url.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "redirect_url",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetPodcastEpisodeTranscriptParams is parameters of getPodcastEpisodeTranscript operation.
+type GetPodcastEpisodeTranscriptParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
+
+func unpackGetPodcastEpisodeTranscriptParams(packed middleware.Parameters) (params GetPodcastEpisodeTranscriptParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotRedirectURLVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetPodcastEpisodeTranscriptParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPodcastEpisodeTranscriptParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotRedirectURLVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.RedirectURL.SetTo(paramsDotRedirectURLVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "redirect_url",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// OidcCallbackParams is parameters of oidcCallback operation.
-type OidcCallbackParams struct {
-	// Provider name.
-	Provider string
-	// Authorization code from provider.
-	Code string
-	// OAuth2 state parameter.
-	State string
-	// Error code if authorization failed.
-	Error OptString `json:",omitempty,omitzero"`
-	// Error description if authorization failed.
-	ErrorDescription OptString `json:",omitempty,omitzero"`
+// GetProxiedImageParams is parameters of getProxiedImage operation.
+type GetProxiedImageParams struct {
+	// Image type.
+	Type GetProxiedImageType
+	// Image size.
+	Size GetProxiedImageSize
+	// TMDb image path (e.g., /abc123.jpg) or local artwork path (local-{artwork id}).
+	Path string
 }
 
-func unpackOidcCallbackParams(packed middleware.Parameters) (params OidcCallbackParams) {
+func unpackGetProxiedImageParams(packed middleware.Parameters) (params GetProxiedImageParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "provider",
+			Name: "type",
 			In:   "path",
 		}
-		params.Provider = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "code",
-			In:   "query",
-		}
-		params.Code = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "state",
-			In:   "query",
-		}
-		params.State = packed[key].(string)
+		params.Type = packed[key].(GetProxiedImageType)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "error",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Error = v.(OptString)
+			Name: "size",
+			In:   "path",
 		}
+		params.Size = packed[key].(GetProxiedImageSize)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "error_description",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ErrorDescription = v.(OptString)
+			Name: "path",
+			In:   "path",
 		}
+		params.Path = packed[key].(string)
 	}
 	return params
 }
 
-func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request) (params OidcCallbackParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: provider.
+func decodeGetProxiedImageParams(args [3]string, argsEscaped bool, r *http.Request) (params GetProxiedImageParams, _ error) {
+	// Decode path: type.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -9681,7 +9836,7 @@ func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "provider",
+				Param:   "type",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -9698,7 +9853,15 @@ func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Provider = c
+				params.Type = GetProxiedImageType(c)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Type.Validate(); err != nil {
+					return err
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -9709,21 +9872,30 @@ func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
+			Name: "type",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: code.
+	// Decode path: size.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "size",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -9734,32 +9906,49 @@ func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.Code = c
+				params.Size = GetProxiedImageSize(c)
 				return nil
-			}); err != nil {
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Size.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "code",
-			In:   "query",
+			Name: "size",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: state.
+	// Decode path: path.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "state",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "path",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -9770,528 +9959,696 @@ func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.State = c
+				params.Path = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "state",
-			In:   "query",
+			Name: "path",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: error.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "error",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
+	return params, nil
+}
+
+// GetRecentActionsParams is parameters of getRecentActions operation.
+type GetRecentActionsParams struct {
+	// Maximum number of results (default 20, max 50).
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetRecentActionsParams(packed middleware.Parameters) (params GetRecentActionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetRecentActionsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentActionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotErrorVal string
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotErrorVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Error.SetTo(paramsDotErrorVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "error",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: error_description.
+	return params, nil
+}
+
+// GetRecentEpisodesParams is parameters of getRecentEpisodes operation.
+type GetRecentEpisodesParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetRecentEpisodesParams(packed middleware.Parameters) (params GetRecentEpisodesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetRecentEpisodesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentEpisodesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "error_description",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotErrorDescriptionVal string
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotErrorDescriptionVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ErrorDescription.SetTo(paramsDotErrorDescriptionVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "error_description",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// RefreshMovieMetadataParams is parameters of refreshMovieMetadata operation.
-type RefreshMovieMetadataParams struct {
-	// Movie ID.
-	ID uuid.UUID
-}
-
-func unpackRefreshMovieMetadataParams(packed middleware.Parameters) (params RefreshMovieMetadataParams) {
+	// Set default value for query: offset.
 	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(uuid.UUID)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	return params
-}
-
-func decodeRefreshMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshMovieMetadataParams, _ error) {
-	// Decode path: id.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// RefreshTVShowMetadataParams is parameters of refreshTVShowMetadata operation.
-type RefreshTVShowMetadataParams struct {
-	// TV Show ID.
-	ID uuid.UUID
+// GetRecentlyAddedParams is parameters of getRecentlyAdded operation.
+type GetRecentlyAddedParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackRefreshTVShowMetadataParams(packed middleware.Parameters) (params RefreshTVShowMetadataParams) {
+func unpackGetRecentlyAddedParams(packed middleware.Parameters) (params GetRecentlyAddedParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
 		}
-		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRefreshTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshTVShowMetadataParams, _ error) {
-	// Decode path: id.
+func decodeGetRecentlyAddedParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentlyAddedParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.ID = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// RemoveRoleParams is parameters of removeRole operation.
-type RemoveRoleParams struct {
-	// User ID.
-	UserId uuid.UUID
-	// Role name.
-	Role string
-}
-
-func unpackRemoveRoleParams(packed middleware.Parameters) (params RemoveRoleParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "userId",
-			In:   "path",
-		}
-		params.UserId = packed[key].(uuid.UUID)
-	}
+	// Set default value for query: offset.
 	{
-		key := middleware.ParameterKey{
-			Name: "role",
-			In:   "path",
-		}
-		params.Role = packed[key].(string)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	return params
-}
-
-func decodeRemoveRoleParams(args [2]string, argsEscaped bool, r *http.Request) (params RemoveRoleParams, _ error) {
-	// Decode path: userId.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.UserId = c
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode path: role.
-	if err := func() error {
-		param := args[1]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "role",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
 			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
-
-				params.Role = c
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "role",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// RenameWebAuthnCredentialParams is parameters of renameWebAuthnCredential operation.
-type RenameWebAuthnCredentialParams struct {
-	CredentialId uuid.UUID
+// GetRecentlyAddedTVShowsParams is parameters of getRecentlyAddedTVShows operation.
+type GetRecentlyAddedTVShowsParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackRenameWebAuthnCredentialParams(packed middleware.Parameters) (params RenameWebAuthnCredentialParams) {
+func unpackGetRecentlyAddedTVShowsParams(packed middleware.Parameters) (params GetRecentlyAddedTVShowsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "credentialId",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
 		}
-		params.CredentialId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRenameWebAuthnCredentialParams(args [1]string, argsEscaped bool, r *http.Request) (params RenameWebAuthnCredentialParams, _ error) {
-	// Decode path: credentialId.
+func decodeGetRecentlyAddedTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetRecentlyAddedTVShowsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "credentialId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.CredentialId = c
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "credentialId",
-			In:   "path",
+			Name: "limit",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// RevokeAPIKeyParams is parameters of revokeAPIKey operation.
-type RevokeAPIKeyParams struct {
-	// API Key ID.
-	KeyId uuid.UUID
-}
-
-func unpackRevokeAPIKeyParams(packed middleware.Parameters) (params RevokeAPIKeyParams) {
+	// Set default value for query: offset.
 	{
-		key := middleware.ParameterKey{
-			Name: "keyId",
-			In:   "path",
-		}
-		params.KeyId = packed[key].(uuid.UUID)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	return params
-}
-
-func decodeRevokeAPIKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeAPIKeyParams, _ error) {
-	// Decode path: keyId.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "keyId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToUUID(val)
-				if err != nil {
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.KeyId = c
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "keyId",
-			In:   "path",
+			Name: "offset",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// RevokeLibraryPermissionParams is parameters of revokeLibraryPermission operation.
-type RevokeLibraryPermissionParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
-	// User ID.
-	UserId uuid.UUID
-	// Permission to revoke.
-	Permission RevokeLibraryPermissionPermission
+// GetResourceActivityLogsParams is parameters of getResourceActivityLogs operation.
+type GetResourceActivityLogsParams struct {
+	// Resource type (user, library, movie, etc.).
+	ResourceType string
+	// Resource ID.
+	ResourceId uuid.UUID
+	// Maximum number of results (default 50, max 100).
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of results to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
 }
 
-func unpackRevokeLibraryPermissionParams(packed middleware.Parameters) (params RevokeLibraryPermissionParams) {
+func unpackGetResourceActivityLogsParams(packed middleware.Parameters) (params GetResourceActivityLogsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "resourceType",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.ResourceType = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "userId",
+			Name: "resourceId",
 			In:   "path",
 		}
-		params.UserId = packed[key].(uuid.UUID)
+		params.ResourceId = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "permission",
+			Name: "limit",
 			In:   "query",
 		}
-		params.Permission = packed[key].(RevokeLibraryPermissionPermission)
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
 	}
 	return params
 }
 
-func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *http.Request) (params RevokeLibraryPermissionParams, _ error) {
+func decodeGetResourceActivityLogsParams(args [2]string, argsEscaped bool, r *http.Request) (params GetResourceActivityLogsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: libraryId.
+	// Decode path: resourceType.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -10303,7 +10660,7 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "resourceType",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -10315,12 +10672,12 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.LibraryId = c
+				params.ResourceType = c
 				return nil
 			}(); err != nil {
 				return err
@@ -10331,12 +10688,12 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "resourceType",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: userId.
+	// Decode path: resourceId.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -10348,7 +10705,7 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "userId",
+				Param:   "resourceId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -10365,7 +10722,7 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.UserId = c
+				params.ResourceId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -10376,51 +10733,149 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "userId",
+			Name: "resourceId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: permission.
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "permission",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Permission = RevokeLibraryPermissionPermission(c)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if err := params.Permission.Validate(); err != nil {
-					return err
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "permission",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
@@ -10428,25 +10883,25 @@ func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// RevokeSessionParams is parameters of revokeSession operation.
-type RevokeSessionParams struct {
-	// Session ID.
-	SessionId uuid.UUID
+// GetRoleParams is parameters of getRole operation.
+type GetRoleParams struct {
+	// Role name.
+	RoleName string
 }
 
-func unpackRevokeSessionParams(packed middleware.Parameters) (params RevokeSessionParams) {
+func unpackGetRoleParams(packed middleware.Parameters) (params GetRoleParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "sessionId",
+			Name: "roleName",
 			In:   "path",
 		}
-		params.SessionId = packed[key].(uuid.UUID)
+		params.RoleName = packed[key].(string)
 	}
 	return params
 }
 
-func decodeRevokeSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeSessionParams, _ error) {
-	// Decode path: sessionId.
+func decodeGetRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params GetRoleParams, _ error) {
+	// Decode path: roleName.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -10458,7 +10913,7 @@ func decodeRevokeSessionParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "sessionId",
+				Param:   "roleName",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -10470,12 +10925,12 @@ func decodeRevokeSessionParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.SessionId = c
+				params.RoleName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -10486,7 +10941,7 @@ func decodeRevokeSessionParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sessionId",
+			Name: "roleName",
 			In:   "path",
 			Err:  err,
 		}
@@ -10494,208 +10949,266 @@ func decodeRevokeSessionParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// SearchActivityLogsParams is parameters of searchActivityLogs operation.
-type SearchActivityLogsParams struct {
-	// Filter by user ID.
-	UserID OptUUID `json:",omitempty,omitzero"`
-	// Filter by action type.
-	Action OptString `json:",omitempty,omitzero"`
-	// Filter by resource type.
-	ResourceType OptString `json:",omitempty,omitzero"`
-	// Filter by resource ID.
-	ResourceID OptUUID `json:",omitempty,omitzero"`
-	// Filter by success status.
-	Success OptBool `json:",omitempty,omitzero"`
-	// Filter by start time (ISO 8601).
-	StartTime OptDateTime `json:",omitempty,omitzero"`
-	// Filter by end time (ISO 8601).
-	EndTime OptDateTime `json:",omitempty,omitzero"`
-	// Maximum number of results (default 50, max 100).
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Number of results to skip.
-	Offset OptInt `json:",omitempty,omitzero"`
+// GetSeasonMetadataParams is parameters of getSeasonMetadata operation.
+type GetSeasonMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// Season number.
+	SeasonNumber int
 }
 
-func unpackSearchActivityLogsParams(packed middleware.Parameters) (params SearchActivityLogsParams) {
+func unpackGetSeasonMetadataParams(packed middleware.Parameters) (params GetSeasonMetadataParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "user_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.UserID = v.(OptUUID)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "action",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Action = v.(OptString)
+			Name: "seasonNumber",
+			In:   "path",
 		}
+		params.SeasonNumber = packed[key].(int)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "resource_type",
-			In:   "query",
+	return params
+}
+
+func decodeGetSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Request) (params GetSeasonMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-		if v, ok := packed[key]; ok {
-			params.ResourceType = v.(OptString)
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "resource_id",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.ResourceID = v.(OptUUID)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "success",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Success = v.(OptBool)
+	// Decode path: seasonNumber.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start_time",
-			In:   "query",
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "seasonNumber",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.SeasonNumber = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
-		if v, ok := packed[key]; ok {
-			params.StartTime = v.(OptDateTime)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "seasonNumber",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// GetSeasonMetadataImagesParams is parameters of getSeasonMetadataImages operation.
+type GetSeasonMetadataImagesParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// Season number.
+	SeasonNumber int
+	// ISO 639-1 language filter.
+	Language OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetSeasonMetadataImagesParams(packed middleware.Parameters) (params GetSeasonMetadataImagesParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "end_time",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.EndTime = v.(OptDateTime)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "seasonNumber",
+			In:   "path",
 		}
+		params.SeasonNumber = packed[key].(int)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
+			Name: "language",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			params.Language = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchActivityLogsParams, _ error) {
+func decodeGetSeasonMetadataImagesParams(args [2]string, argsEscaped bool, r *http.Request) (params GetSeasonMetadataImagesParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: user_id.
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "user_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotUserIDVal uuid.UUID
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToUUID(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotUserIDVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.UserID.SetTo(paramsDotUserIDVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "user_id",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: action.
+	// Decode path: seasonNumber.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "action",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "seasonNumber",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotActionVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotActionVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToInt(val)
+				if err != nil {
 					return err
 				}
-				params.Action.SetTo(paramsDotActionVal)
+
+				params.SeasonNumber = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "action",
-			In:   "query",
+			Name: "seasonNumber",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: resource_type.
+	// Decode query: language.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "resource_type",
+			Name:    "language",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotResourceTypeVal string
+				var paramsDotLanguageVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -10707,12 +11220,12 @@ func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Re
 						return err
 					}
 
-					paramsDotResourceTypeVal = c
+					paramsDotLanguageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.ResourceType.SetTo(paramsDotResourceTypeVal)
+				params.Language.SetTo(paramsDotLanguageVal)
 				return nil
 			}); err != nil {
 				return err
@@ -10721,178 +11234,224 @@ func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "resource_type",
+			Name: "language",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: resource_id.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "resource_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotResourceIDVal uuid.UUID
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// GetServerSettingParams is parameters of getServerSetting operation.
+type GetServerSettingParams struct {
+	// Setting key.
+	Key string
+}
 
-					c, err := conv.ToUUID(val)
-					if err != nil {
-						return err
-					}
+func unpackGetServerSettingParams(packed middleware.Parameters) (params GetServerSettingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
+	}
+	return params
+}
 
-					paramsDotResourceIDVal = c
-					return nil
-				}(); err != nil {
+func decodeGetServerSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetServerSettingParams, _ error) {
+	// Decode path: key.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.ResourceID.SetTo(paramsDotResourceIDVal)
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Key = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "resource_id",
-			In:   "query",
+			Name: "key",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: success.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "success",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// GetSimilarMoviesParams is parameters of getSimilarMovies operation.
+type GetSimilarMoviesParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackGetSimilarMoviesParams(packed middleware.Parameters) (params GetSimilarMoviesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSuccessVal bool
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeGetSimilarMoviesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSimilarMoviesParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToBool(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotSuccessVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Success.SetTo(paramsDotSuccessVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "success",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: start_time.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start_time",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStartTimeVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
+// GetSimilarMoviesMetadataParams is parameters of getSimilarMoviesMetadata operation.
+type GetSimilarMoviesMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// Maximum number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
 
-					paramsDotStartTimeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.StartTime.SetTo(paramsDotStartTimeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
+func unpackGetSimilarMoviesMetadataParams(packed middleware.Parameters) (params GetSimilarMoviesMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "start_time",
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
 		}
 	}
-	// Decode query: end_time.
+	return params
+}
+
+func decodeGetSimilarMoviesMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetSimilarMoviesMetadataParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end_time",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotEndTimeVal time.Time
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToDateTime(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotEndTimeVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.EndTime.SetTo(paramsDotEndTimeVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end_time",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	// Set default value for query: limit.
 	{
-		val := int(50)
+		val := int(20)
 		params.Limit.SetTo(val)
 	}
 	// Decode query: limit.
@@ -10961,22 +11520,45 @@ func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// GetTVContinueWatchingParams is parameters of getTVContinueWatching operation.
+type GetTVContinueWatchingParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetTVContinueWatchingParams(packed middleware.Parameters) (params GetTVContinueWatchingParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
-	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
 		}
-
-		if err := q.HasParam(cfg); err == nil {
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTVContinueWatchingParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTVContinueWatchingParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -10988,24 +11570,24 @@ func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Re
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
+				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
 							MinExclusive:  false,
 							MaxExclusive:  false,
 							MultipleOfSet: false,
@@ -11027,7 +11609,7 @@ func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
@@ -11035,215 +11617,16517 @@ func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// SearchLibraryMoviesParams is parameters of searchLibraryMovies operation.
-type SearchLibraryMoviesParams struct {
-	// Search query (searches title, overview, cast, directors).
-	Q string
-	// Page number for pagination.
-	Page OptInt `json:",omitempty,omitzero"`
-	// Results per page.
-	PerPage OptInt `json:",omitempty,omitzero"`
-	// Sort field and direction.
-	SortBy OptSearchLibraryMoviesSortBy `json:",omitempty,omitzero"`
-	// Typesense filter syntax. Examples:
-	// - `genres:=Action`
-	// - `year:>=2020`
-	// - `has_file:=true && genres:=Action`.
-	FilterBy OptString `json:",omitempty,omitzero"`
+// GetTVEpisodeParams is parameters of getTVEpisode operation.
+type GetTVEpisodeParams struct {
+	// Episode ID.
+	ID uuid.UUID
 }
 
-func unpackSearchLibraryMoviesParams(packed middleware.Parameters) (params SearchLibraryMoviesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
-		}
-		params.Q = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "page",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "per_page",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.PerPage = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sort_by",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.SortBy = v.(OptSearchLibraryMoviesSortBy)
-		}
-	}
+func unpackGetTVEpisodeParams(packed middleware.Parameters) (params GetTVEpisodeParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "filter_by",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.FilterBy = v.(OptString)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeSearchLibraryMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchLibraryMoviesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
+func decodeGetTVEpisodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVEpisodeParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Q = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     200,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Q)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
+	return params, nil
+}
+
+// GetTVEpisodeFilesParams is parameters of getTVEpisodeFiles operation.
+type GetTVEpisodeFilesParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVEpisodeFilesParams(packed middleware.Parameters) (params GetTVEpisodeFilesParams) {
 	{
-		val := int(1)
-		params.Page.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: page.
+	return params
+}
+
+func decodeGetTVEpisodeFilesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVEpisodeFilesParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotPageVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
-			In:   "query",
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVEpisodeProgressParams is parameters of getTVEpisodeProgress operation.
+type GetTVEpisodeProgressParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVEpisodeProgressParams(packed middleware.Parameters) (params GetTVEpisodeProgressParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVEpisodeProgressParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVSeasonParams is parameters of getTVSeason operation.
+type GetTVSeasonParams struct {
+	// Season ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVSeasonParams(packed middleware.Parameters) (params GetTVSeasonParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVSeasonParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVSeasonParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVSeasonEpisodesParams is parameters of getTVSeasonEpisodes operation.
+type GetTVSeasonEpisodesParams struct {
+	// Season ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVSeasonEpisodesParams(packed middleware.Parameters) (params GetTVSeasonEpisodesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVSeasonEpisodesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVSeasonEpisodesParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowParams is parameters of getTVShow operation.
+type GetTVShowParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowParams(packed middleware.Parameters) (params GetTVShowParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowCastParams is parameters of getTVShowCast operation.
+type GetTVShowCastParams struct {
+	// TV Show ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetTVShowCastParams(packed middleware.Parameters) (params GetTVShowCastParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTVShowCastParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowCastParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowContentRatingsParams is parameters of getTVShowContentRatings operation.
+type GetTVShowContentRatingsParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+}
+
+func unpackGetTVShowContentRatingsParams(packed middleware.Parameters) (params GetTVShowContentRatingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTVShowContentRatingsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowContentRatingsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowCrewParams is parameters of getTVShowCrew operation.
+type GetTVShowCrewParams struct {
+	// TV Show ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetTVShowCrewParams(packed middleware.Parameters) (params GetTVShowCrewParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTVShowCrewParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowCrewParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowEpisodesParams is parameters of getTVShowEpisodes operation.
+type GetTVShowEpisodesParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowEpisodesParams(packed middleware.Parameters) (params GetTVShowEpisodesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowEpisodesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowEpisodesParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowExternalIDsParams is parameters of getTVShowExternalIDs operation.
+type GetTVShowExternalIDsParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+}
+
+func unpackGetTVShowExternalIDsParams(packed middleware.Parameters) (params GetTVShowExternalIDsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTVShowExternalIDsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowExternalIDsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowGenresParams is parameters of getTVShowGenres operation.
+type GetTVShowGenresParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowGenresParams(packed middleware.Parameters) (params GetTVShowGenresParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowGenresParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowGenresParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowMetadataParams is parameters of getTVShowMetadata operation.
+type GetTVShowMetadataParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+}
+
+func unpackGetTVShowMetadataParams(packed middleware.Parameters) (params GetTVShowMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowMetadataCreditsParams is parameters of getTVShowMetadataCredits operation.
+type GetTVShowMetadataCreditsParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+}
+
+func unpackGetTVShowMetadataCreditsParams(packed middleware.Parameters) (params GetTVShowMetadataCreditsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTVShowMetadataCreditsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowMetadataCreditsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowMetadataImagesParams is parameters of getTVShowMetadataImages operation.
+type GetTVShowMetadataImagesParams struct {
+	// Metadata provider ID (e.g. TMDb ID).
+	ID string
+	// ISO 639-1 language filter.
+	Language OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetTVShowMetadataImagesParams(packed middleware.Parameters) (params GetTVShowMetadataImagesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetTVShowMetadataImagesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowMetadataImagesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowNetworksParams is parameters of getTVShowNetworks operation.
+type GetTVShowNetworksParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowNetworksParams(packed middleware.Parameters) (params GetTVShowNetworksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowNetworksParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowNetworksParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowNextEpisodeParams is parameters of getTVShowNextEpisode operation.
+type GetTVShowNextEpisodeParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowNextEpisodeParams(packed middleware.Parameters) (params GetTVShowNextEpisodeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowNextEpisodeParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowNextEpisodeParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowSeasonsParams is parameters of getTVShowSeasons operation.
+type GetTVShowSeasonsParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowSeasonsParams(packed middleware.Parameters) (params GetTVShowSeasonsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowSeasonsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowSeasonsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowTagsParams is parameters of getTVShowTags operation.
+type GetTVShowTagsParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowTagsParams(packed middleware.Parameters) (params GetTVShowTagsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowTagsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowTagsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTVShowWatchStatsParams is parameters of getTVShowWatchStats operation.
+type GetTVShowWatchStatsParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackGetTVShowWatchStatsParams(packed middleware.Parameters) (params GetTVShowWatchStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetTVShowWatchStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTVShowWatchStatsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTopRatedParams is parameters of getTopRated operation.
+type GetTopRatedParams struct {
+	// Minimum number of votes required.
+	MinVotes OptInt `json:",omitempty,omitzero"`
+	Limit    OptInt `json:",omitempty,omitzero"`
+	Offset   OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetTopRatedParams(packed middleware.Parameters) (params GetTopRatedParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "min_votes",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MinVotes = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTopRatedParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTopRatedParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: min_votes.
+	{
+		val := int(100)
+		params.MinVotes.SetTo(val)
+	}
+	// Decode query: min_votes.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "min_votes",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMinVotesVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMinVotesVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MinVotes.SetTo(paramsDotMinVotesVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MinVotes.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "min_votes",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUpcomingEpisodesParams is parameters of getUpcomingEpisodes operation.
+type GetUpcomingEpisodesParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetUpcomingEpisodesParams(packed middleware.Parameters) (params GetUpcomingEpisodesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetUpcomingEpisodesParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUpcomingEpisodesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserActivityLogsParams is parameters of getUserActivityLogs operation.
+type GetUserActivityLogsParams struct {
+	// User ID.
+	UserId uuid.UUID
+	// Maximum number of results (default 50, max 100).
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of results to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetUserActivityLogsParams(packed middleware.Parameters) (params GetUserActivityLogsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetUserActivityLogsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserActivityLogsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserByIdParams is parameters of getUserById operation.
+type GetUserByIdParams struct {
+	// User ID.
+	UserId uuid.UUID
+}
+
+func unpackGetUserByIdParams(packed middleware.Parameters) (params GetUserByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetUserByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserByIdParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserRolesParams is parameters of getUserRoles operation.
+type GetUserRolesParams struct {
+	// User ID.
+	UserId uuid.UUID
+}
+
+func unpackGetUserRolesParams(packed middleware.Parameters) (params GetUserRolesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetUserRolesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserRolesParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserSettingParams is parameters of getUserSetting operation.
+type GetUserSettingParams struct {
+	// Setting key.
+	Key string
+}
+
+func unpackGetUserSettingParams(packed middleware.Parameters) (params GetUserSettingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "key",
+			In:   "path",
+		}
+		params.Key = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetUserSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserSettingParams, _ error) {
+	// Decode path: key.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "key",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Key = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "key",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWatchHistoryParams is parameters of getWatchHistory operation.
+type GetWatchHistoryParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetWatchHistoryParams(packed middleware.Parameters) (params GetWatchHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetWatchHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params GetWatchHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetWatchProgressParams is parameters of getWatchProgress operation.
+type GetWatchProgressParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackGetWatchProgressParams(packed middleware.Parameters) (params GetWatchProgressParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetWatchProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params GetWatchProgressParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GrantLibraryPermissionParams is parameters of grantLibraryPermission operation.
+type GrantLibraryPermissionParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+}
+
+func unpackGrantLibraryPermissionParams(packed middleware.Parameters) (params GrantLibraryPermissionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "libraryId",
+			In:   "path",
+		}
+		params.LibraryId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGrantLibraryPermissionParams(args [1]string, argsEscaped bool, r *http.Request) (params GrantLibraryPermissionParams, _ error) {
+	// Decode path: libraryId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "libraryId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.LibraryId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "libraryId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// HeartbeatPlaybackSessionParams is parameters of heartbeatPlaybackSession operation.
+type HeartbeatPlaybackSessionParams struct {
+	SessionId uuid.UUID
+}
+
+func unpackHeartbeatPlaybackSessionParams(packed middleware.Parameters) (params HeartbeatPlaybackSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "sessionId",
+			In:   "path",
+		}
+		params.SessionId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeHeartbeatPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params HeartbeatPlaybackSessionParams, _ error) {
+	// Decode path: sessionId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "sessionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.SessionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sessionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// InitOIDCLinkParams is parameters of initOIDCLink operation.
+type InitOIDCLinkParams struct {
+	// Provider name to link.
+	Provider string
+}
+
+func unpackInitOIDCLinkParams(packed middleware.Parameters) (params InitOIDCLinkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "path",
+		}
+		params.Provider = packed[key].(string)
+	}
+	return params
+}
+
+func decodeInitOIDCLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params InitOIDCLinkParams, _ error) {
+	// Decode path: provider.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "provider",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Provider = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provider",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAudiobookAuthorsParams is parameters of listAudiobookAuthors operation.
+type ListAudiobookAuthorsParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListAudiobookAuthorsParams(packed middleware.Parameters) (params ListAudiobookAuthorsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListAudiobookAuthorsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAudiobookAuthorsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAudiobookSeriesParams is parameters of listAudiobookSeries operation.
+type ListAudiobookSeriesParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListAudiobookSeriesParams(packed middleware.Parameters) (params ListAudiobookSeriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListAudiobookSeriesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAudiobookSeriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAudiobooksParams is parameters of listAudiobooks operation.
+type ListAudiobooksParams struct {
+	// Sort order (added is newest first).
+	OrderBy OptListAudiobooksOrderBy `json:",omitempty,omitzero"`
+	Limit   OptInt                   `json:",omitempty,omitzero"`
+	Offset  OptInt                   `json:",omitempty,omitzero"`
+}
+
+func unpackListAudiobooksParams(packed middleware.Parameters) (params ListAudiobooksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptListAudiobooksOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListAudiobooksParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAudiobooksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: order_by.
+	{
+		val := ListAudiobooksOrderBy("added")
+		params.OrderBy.SetTo(val)
+	}
+	// Decode query: order_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal ListAudiobooksOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = ListAudiobooksOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListBookAuthorsParams is parameters of listBookAuthors operation.
+type ListBookAuthorsParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListBookAuthorsParams(packed middleware.Parameters) (params ListBookAuthorsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListBookAuthorsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListBookAuthorsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListBookSeriesParams is parameters of listBookSeries operation.
+type ListBookSeriesParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListBookSeriesParams(packed middleware.Parameters) (params ListBookSeriesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListBookSeriesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListBookSeriesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListBooksParams is parameters of listBooks operation.
+type ListBooksParams struct {
+	// Only list books or comics (both when omitted).
+	Kind OptListBooksKind `json:",omitempty,omitzero"`
+	// Match against title, authors and series.
+	Q OptString `json:",omitempty,omitzero"`
+	// Sort order (added is newest first).
+	OrderBy OptListBooksOrderBy `json:",omitempty,omitzero"`
+	Limit   OptInt              `json:",omitempty,omitzero"`
+	Offset  OptInt              `json:",omitempty,omitzero"`
+}
+
+func unpackListBooksParams(packed middleware.Parameters) (params ListBooksParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptListBooksKind)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptListBooksOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListBooksParams(args [0]string, argsEscaped bool, r *http.Request) (params ListBooksParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal ListBooksKind
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = ListBooksKind(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Kind.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: order_by.
+	{
+		val := ListBooksOrderBy("added")
+		params.OrderBy.SetTo(val)
+	}
+	// Decode query: order_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal ListBooksOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = ListBooksOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListHomeVideoContinueWatchingParams is parameters of listHomeVideoContinueWatching operation.
+type ListHomeVideoContinueWatchingParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListHomeVideoContinueWatchingParams(packed middleware.Parameters) (params ListHomeVideoContinueWatchingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListHomeVideoContinueWatchingParams(args [0]string, argsEscaped bool, r *http.Request) (params ListHomeVideoContinueWatchingParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(10)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListHomeVideoFoldersParams is parameters of listHomeVideoFolders operation.
+type ListHomeVideoFoldersParams struct {
+	// Folder to list the subfolders of.
+	ParentID OptUUID `json:",omitempty,omitzero"`
+	// Only list folders of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	Limit     OptInt  `json:",omitempty,omitzero"`
+	Offset    OptInt  `json:",omitempty,omitzero"`
+}
+
+func unpackListHomeVideoFoldersParams(packed middleware.Parameters) (params ListHomeVideoFoldersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "parent_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ParentID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListHomeVideoFoldersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListHomeVideoFoldersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: parent_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "parent_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotParentIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotParentIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ParentID.SetTo(paramsDotParentIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "parent_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListHomeVideosParams is parameters of listHomeVideos operation.
+type ListHomeVideosParams struct {
+	// Only list videos of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Only list the videos directly in this folder.
+	FolderID OptUUID `json:",omitempty,omitzero"`
+	// Latest recordings first, by title, or latest added first.
+	Sort   OptListHomeVideosSort `json:",omitempty,omitzero"`
+	Limit  OptInt                `json:",omitempty,omitzero"`
+	Offset OptInt                `json:",omitempty,omitzero"`
+}
+
+func unpackListHomeVideosParams(packed middleware.Parameters) (params ListHomeVideosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "folder_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FolderID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptListHomeVideosSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListHomeVideosParams(args [0]string, argsEscaped bool, r *http.Request) (params ListHomeVideosParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: folder_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "folder_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFolderIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFolderIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FolderID.SetTo(paramsDotFolderIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "folder_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := ListHomeVideosSort("recorded")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ListHomeVideosSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ListHomeVideosSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLegacyPerformersParams is parameters of listLegacyPerformers operation.
+type ListLegacyPerformersParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListLegacyPerformersParams(packed middleware.Parameters) (params ListLegacyPerformersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListLegacyPerformersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListLegacyPerformersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLegacyScenesParams is parameters of listLegacyScenes operation.
+type ListLegacyScenesParams struct {
+	// Only list scenes of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Only list scenes of this studio.
+	StudioID OptUUID `json:",omitempty,omitzero"`
+	// Only list scenes with this performer.
+	PerformerID OptUUID `json:",omitempty,omitzero"`
+	// Only list matched or unmatched scenes (both when omitted).
+	MatchStatus OptListLegacyScenesMatchStatus `json:",omitempty,omitzero"`
+	Sort        OptListLegacyScenesSort        `json:",omitempty,omitzero"`
+	Limit       OptInt                         `json:",omitempty,omitzero"`
+	Offset      OptInt                         `json:",omitempty,omitzero"`
+}
+
+func unpackListLegacyScenesParams(packed middleware.Parameters) (params ListLegacyScenesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "studio_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StudioID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "performer_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PerformerID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "match_status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MatchStatus = v.(OptListLegacyScenesMatchStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptListLegacyScenesSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListLegacyScenesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListLegacyScenesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: studio_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "studio_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStudioIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStudioIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StudioID.SetTo(paramsDotStudioIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "studio_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: performer_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "performer_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPerformerIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPerformerIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PerformerID.SetTo(paramsDotPerformerIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "performer_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: match_status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "match_status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMatchStatusVal ListLegacyScenesMatchStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMatchStatusVal = ListLegacyScenesMatchStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MatchStatus.SetTo(paramsDotMatchStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MatchStatus.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "match_status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := ListLegacyScenesSort("added")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ListLegacyScenesSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ListLegacyScenesSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLegacyStudiosParams is parameters of listLegacyStudios operation.
+type ListLegacyStudiosParams struct {
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListLegacyStudiosParams(packed middleware.Parameters) (params ListLegacyStudiosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListLegacyStudiosParams(args [0]string, argsEscaped bool, r *http.Request) (params ListLegacyStudiosParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLibraryPermissionsParams is parameters of listLibraryPermissions operation.
+type ListLibraryPermissionsParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+}
+
+func unpackListLibraryPermissionsParams(packed middleware.Parameters) (params ListLibraryPermissionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "libraryId",
+			In:   "path",
+		}
+		params.LibraryId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListLibraryPermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListLibraryPermissionsParams, _ error) {
+	// Decode path: libraryId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "libraryId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.LibraryId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "libraryId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLibraryScansParams is parameters of listLibraryScans operation.
+type ListLibraryScansParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+	// Maximum number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of results to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListLibraryScansParams(packed middleware.Parameters) (params ListLibraryScansParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "libraryId",
+			In:   "path",
+		}
+		params.LibraryId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListLibraryScansParams(args [1]string, argsEscaped bool, r *http.Request) (params ListLibraryScansParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: libraryId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "libraryId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.LibraryId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "libraryId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        false,
+							Min:           0,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLiveTVChannelsParams is parameters of listLiveTVChannels operation.
+type ListLiveTVChannelsParams struct {
+	// Also list disabled channels (admin only).
+	IncludeDisabled OptBool `json:",omitempty,omitzero"`
+}
+
+func unpackListLiveTVChannelsParams(packed middleware.Parameters) (params ListLiveTVChannelsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "include_disabled",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeDisabled = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeListLiveTVChannelsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListLiveTVChannelsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: include_disabled.
+	{
+		val := bool(false)
+		params.IncludeDisabled.SetTo(val)
+	}
+	// Decode query: include_disabled.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "include_disabled",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeDisabledVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeDisabledVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeDisabled.SetTo(paramsDotIncludeDisabledVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "include_disabled",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListLiveTVRecordingsParams is parameters of listLiveTVRecordings operation.
+type ListLiveTVRecordingsParams struct {
+	Status OptLiveTVRecordingStatus `json:",omitempty,omitzero"`
+	Limit  OptInt                   `json:",omitempty,omitzero"`
+	Offset OptInt                   `json:",omitempty,omitzero"`
+}
+
+func unpackListLiveTVRecordingsParams(packed middleware.Parameters) (params ListLiveTVRecordingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptLiveTVRecordingStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListLiveTVRecordingsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListLiveTVRecordingsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal LiveTVRecordingStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = LiveTVRecordingStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMovieArtworkParams is parameters of listMovieArtwork operation.
+type ListMovieArtworkParams struct {
+	// Movie ID.
+	ID uuid.UUID
+	// Only list candidates of this kind.
+	Kind OptArtworkKind `json:",omitempty,omitzero"`
+}
+
+func unpackListMovieArtworkParams(packed middleware.Parameters) (params ListMovieArtworkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptArtworkKind)
+		}
+	}
+	return params
+}
+
+func decodeListMovieArtworkParams(args [1]string, argsEscaped bool, r *http.Request) (params ListMovieArtworkParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal ArtworkKind
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = ArtworkKind(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Kind.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMovieMetadataHistoryParams is parameters of listMovieMetadataHistory operation.
+type ListMovieMetadataHistoryParams struct {
+	// Movie ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListMovieMetadataHistoryParams(packed middleware.Parameters) (params ListMovieMetadataHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMovieMetadataHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params ListMovieMetadataHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMoviesParams is parameters of listMovies operation.
+type ListMoviesParams struct {
+	// Sort order (title, year, added, rating).
+	OrderBy OptListMoviesOrderBy `json:",omitempty,omitzero"`
+	// Number of movies per page.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of movies to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListMoviesParams(packed middleware.Parameters) (params ListMoviesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptListMoviesOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMoviesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: order_by.
+	{
+		val := ListMoviesOrderBy("added")
+		params.OrderBy.SetTo(val)
+	}
+	// Decode query: order_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal ListMoviesOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = ListMoviesOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMoviesByTagParams is parameters of listMoviesByTag operation.
+type ListMoviesByTagParams struct {
+	// Tag slug.
+	Slug   string
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListMoviesByTagParams(packed middleware.Parameters) (params ListMoviesByTagParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMoviesByTagParams(args [1]string, argsEscaped bool, r *http.Request) (params ListMoviesByTagParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMusicAlbumsParams is parameters of listMusicAlbums operation.
+type ListMusicAlbumsParams struct {
+	// Field to sort by.
+	OrderBy OptListMusicAlbumsOrderBy `json:",omitempty,omitzero"`
+	Limit   OptInt                    `json:",omitempty,omitzero"`
+	Offset  OptInt                    `json:",omitempty,omitzero"`
+}
+
+func unpackListMusicAlbumsParams(packed middleware.Parameters) (params ListMusicAlbumsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptListMusicAlbumsOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMusicAlbumsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMusicAlbumsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: order_by.
+	{
+		val := ListMusicAlbumsOrderBy("created_at")
+		params.OrderBy.SetTo(val)
+	}
+	// Decode query: order_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal ListMusicAlbumsOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = ListMusicAlbumsOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMusicArtistsParams is parameters of listMusicArtists operation.
+type ListMusicArtistsParams struct {
+	// Only return album artists.
+	AlbumArtists OptBool `json:",omitempty,omitzero"`
+	Limit        OptInt  `json:",omitempty,omitzero"`
+	Offset       OptInt  `json:",omitempty,omitzero"`
+}
+
+func unpackListMusicArtistsParams(packed middleware.Parameters) (params ListMusicArtistsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "album_artists",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.AlbumArtists = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMusicArtistsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMusicArtistsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: album_artists.
+	{
+		val := bool(false)
+		params.AlbumArtists.SetTo(val)
+	}
+	// Decode query: album_artists.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "album_artists",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAlbumArtistsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAlbumArtistsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.AlbumArtists.SetTo(paramsDotAlbumArtistsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "album_artists",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMusicVideoArtistsParams is parameters of listMusicVideoArtists operation.
+type ListMusicVideoArtistsParams struct {
+	// Only list artists of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	Limit     OptInt  `json:",omitempty,omitzero"`
+	Offset    OptInt  `json:",omitempty,omitzero"`
+}
+
+func unpackListMusicVideoArtistsParams(packed middleware.Parameters) (params ListMusicVideoArtistsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMusicVideoArtistsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMusicVideoArtistsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListMusicVideosParams is parameters of listMusicVideos operation.
+type ListMusicVideosParams struct {
+	// Only list videos of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Only list the videos of this artist.
+	ArtistID OptUUID `json:",omitempty,omitzero"`
+	// By artist and title, by title, latest release first, or latest added first.
+	Sort   OptListMusicVideosSort `json:",omitempty,omitzero"`
+	Limit  OptInt                 `json:",omitempty,omitzero"`
+	Offset OptInt                 `json:",omitempty,omitzero"`
+}
+
+func unpackListMusicVideosParams(packed middleware.Parameters) (params ListMusicVideosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "artist_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ArtistID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptListMusicVideosSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListMusicVideosParams(args [0]string, argsEscaped bool, r *http.Request) (params ListMusicVideosParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: artist_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "artist_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotArtistIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotArtistIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ArtistID.SetTo(paramsDotArtistIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "artist_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := ListMusicVideosSort("artist")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ListMusicVideosSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ListMusicVideosSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListNewPodcastEpisodesParams is parameters of listNewPodcastEpisodes operation.
+type ListNewPodcastEpisodesParams struct {
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListNewPodcastEpisodesParams(packed middleware.Parameters) (params ListNewPodcastEpisodesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListNewPodcastEpisodesParams(args [0]string, argsEscaped bool, r *http.Request) (params ListNewPodcastEpisodesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListPhotoAlbumPhotosParams is parameters of listPhotoAlbumPhotos operation.
+type ListPhotoAlbumPhotosParams struct {
+	// Album ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListPhotoAlbumPhotosParams(packed middleware.Parameters) (params ListPhotoAlbumPhotosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListPhotoAlbumPhotosParams(args [1]string, argsEscaped bool, r *http.Request) (params ListPhotoAlbumPhotosParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListPhotoAlbumSharesParams is parameters of listPhotoAlbumShares operation.
+type ListPhotoAlbumSharesParams struct {
+	// Album ID.
+	ID uuid.UUID
+}
+
+func unpackListPhotoAlbumSharesParams(packed middleware.Parameters) (params ListPhotoAlbumSharesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListPhotoAlbumSharesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListPhotoAlbumSharesParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListPhotoAlbumsParams is parameters of listPhotoAlbums operation.
+type ListPhotoAlbumsParams struct {
+	// Only list albums of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Only list folder or user albums (both when omitted).
+	Kind   OptListPhotoAlbumsKind `json:",omitempty,omitzero"`
+	Limit  OptInt                 `json:",omitempty,omitzero"`
+	Offset OptInt                 `json:",omitempty,omitzero"`
+}
+
+func unpackListPhotoAlbumsParams(packed middleware.Parameters) (params ListPhotoAlbumsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptListPhotoAlbumsKind)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListPhotoAlbumsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListPhotoAlbumsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal ListPhotoAlbumsKind
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = ListPhotoAlbumsKind(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Kind.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListPhotosParams is parameters of listPhotos operation.
+type ListPhotosParams struct {
+	// Only list photos of this library.
+	LibraryID OptUUID `json:",omitempty,omitzero"`
+	// Only list photos or clips (both when omitted).
+	Kind OptListPhotosKind `json:",omitempty,omitzero"`
+	// Only list photos taken at or after this time.
+	TakenAfter OptDateTime `json:",omitempty,omitzero"`
+	// Only list photos taken before this time.
+	TakenBefore OptDateTime `json:",omitempty,omitzero"`
+	// Capture time order.
+	Order  OptListPhotosOrder `json:",omitempty,omitzero"`
+	Limit  OptInt             `json:",omitempty,omitzero"`
+	Offset OptInt             `json:",omitempty,omitzero"`
+}
+
+func unpackListPhotosParams(packed middleware.Parameters) (params ListPhotosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptListPhotosKind)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "taken_after",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TakenAfter = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "taken_before",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.TakenBefore = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptListPhotosOrder)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListPhotosParams(args [0]string, argsEscaped bool, r *http.Request) (params ListPhotosParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal ListPhotosKind
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = ListPhotosKind(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Kind.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: taken_after.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "taken_after",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTakenAfterVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTakenAfterVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TakenAfter.SetTo(paramsDotTakenAfterVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "taken_after",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: taken_before.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "taken_before",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTakenBeforeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTakenBeforeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.TakenBefore.SetTo(paramsDotTakenBeforeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "taken_before",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: order.
+	{
+		val := ListPhotosOrder("newest")
+		params.Order.SetTo(val)
+	}
+	// Decode query: order.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderVal ListPhotosOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = ListPhotosOrder(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Order.SetTo(paramsDotOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Order.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListPodcastEpisodesParams is parameters of listPodcastEpisodes operation.
+type ListPodcastEpisodesParams struct {
+	// Podcast ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListPodcastEpisodesParams(packed middleware.Parameters) (params ListPodcastEpisodesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListPodcastEpisodesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListPodcastEpisodesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListPodcastsParams is parameters of listPodcasts operation.
+type ListPodcastsParams struct {
+	// Only podcasts the user subscribes to.
+	Subscribed OptBool `json:",omitempty,omitzero"`
+	LibraryID  OptUUID `json:",omitempty,omitzero"`
+	// Matches title and author.
+	Q      OptString `json:",omitempty,omitzero"`
+	Limit  OptInt    `json:",omitempty,omitzero"`
+	Offset OptInt    `json:",omitempty,omitzero"`
+}
+
+func unpackListPodcastsParams(packed middleware.Parameters) (params ListPodcastsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "subscribed",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Subscribed = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "library_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.LibraryID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Q = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListPodcastsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListPodcastsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: subscribed.
+	{
+		val := bool(false)
+		params.Subscribed.SetTo(val)
+	}
+	// Decode query: subscribed.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "subscribed",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSubscribedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSubscribedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Subscribed.SetTo(paramsDotSubscribedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "subscribed",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: library_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "library_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLibraryIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLibraryIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.LibraryID.SetTo(paramsDotLibraryIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "library_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotQVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotQVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Q.SetTo(paramsDotQVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Q.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     200,
+							MaxLengthSet:  true,
+							Email:         false,
+							Hostname:      false,
+							Regex:         nil,
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           200,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTVEpisodeMetadataHistoryParams is parameters of listTVEpisodeMetadataHistory operation.
+type ListTVEpisodeMetadataHistoryParams struct {
+	// Episode ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListTVEpisodeMetadataHistoryParams(packed middleware.Parameters) (params ListTVEpisodeMetadataHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListTVEpisodeMetadataHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params ListTVEpisodeMetadataHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTVSeasonMetadataHistoryParams is parameters of listTVSeasonMetadataHistory operation.
+type ListTVSeasonMetadataHistoryParams struct {
+	// Season ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListTVSeasonMetadataHistoryParams(packed middleware.Parameters) (params ListTVSeasonMetadataHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListTVSeasonMetadataHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params ListTVSeasonMetadataHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTVShowArtworkParams is parameters of listTVShowArtwork operation.
+type ListTVShowArtworkParams struct {
+	// Series ID.
+	ID uuid.UUID
+	// Only list candidates of this kind.
+	Kind OptArtworkKind `json:",omitempty,omitzero"`
+}
+
+func unpackListTVShowArtworkParams(packed middleware.Parameters) (params ListTVShowArtworkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "kind",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Kind = v.(OptArtworkKind)
+		}
+	}
+	return params
+}
+
+func decodeListTVShowArtworkParams(args [1]string, argsEscaped bool, r *http.Request) (params ListTVShowArtworkParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: kind.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotKindVal ArtworkKind
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotKindVal = ArtworkKind(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Kind.SetTo(paramsDotKindVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Kind.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "kind",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTVShowMetadataHistoryParams is parameters of listTVShowMetadataHistory operation.
+type ListTVShowMetadataHistoryParams struct {
+	// Series ID.
+	ID     uuid.UUID
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListTVShowMetadataHistoryParams(packed middleware.Parameters) (params ListTVShowMetadataHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListTVShowMetadataHistoryParams(args [1]string, argsEscaped bool, r *http.Request) (params ListTVShowMetadataHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTVShowsParams is parameters of listTVShows operation.
+type ListTVShowsParams struct {
+	// Field to sort by.
+	OrderBy OptListTVShowsOrderBy `json:",omitempty,omitzero"`
+	Limit   OptInt                `json:",omitempty,omitzero"`
+	Offset  OptInt                `json:",omitempty,omitzero"`
+}
+
+func unpackListTVShowsParams(packed middleware.Parameters) (params ListTVShowsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "order_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptListTVShowsOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTVShowsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: order_by.
+	{
+		val := ListTVShowsOrderBy("created_at")
+		params.OrderBy.SetTo(val)
+	}
+	// Decode query: order_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderByVal ListTVShowsOrderBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderByVal = ListTVShowsOrderBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrderBy.SetTo(paramsDotOrderByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.OrderBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTVShowsByTagParams is parameters of listTVShowsByTag operation.
+type ListTVShowsByTagParams struct {
+	// Tag slug.
+	Slug   string
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListTVShowsByTagParams(packed middleware.Parameters) (params ListTVShowsByTagParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListTVShowsByTagParams(args [1]string, argsEscaped bool, r *http.Request) (params ListTVShowsByTagParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListTagsParams is parameters of listTags operation.
+type ListTagsParams struct {
+	// Maximum number of tags per content type.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackListTagsParams(packed middleware.Parameters) (params ListTagsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListTagsParams(args [0]string, argsEscaped bool, r *http.Request) (params ListTagsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(100)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           500,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// MarkAsWatchedParams is parameters of markAsWatched operation.
+type MarkAsWatchedParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackMarkAsWatchedParams(packed middleware.Parameters) (params MarkAsWatchedParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeMarkAsWatchedParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkAsWatchedParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// MarkTVEpisodeWatchedParams is parameters of markTVEpisodeWatched operation.
+type MarkTVEpisodeWatchedParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
+
+func unpackMarkTVEpisodeWatchedParams(packed middleware.Parameters) (params MarkTVEpisodeWatchedParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeMarkTVEpisodeWatchedParams(args [1]string, argsEscaped bool, r *http.Request) (params MarkTVEpisodeWatchedParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// OidcAuthorizeParams is parameters of oidcAuthorize operation.
+type OidcAuthorizeParams struct {
+	// Provider name (e.g., authentik, keycloak).
+	Provider string
+	// URL to redirect after successful auth.
+	RedirectURL OptString `json:",omitempty,omitzero"`
+}
+
+func unpackOidcAuthorizeParams(packed middleware.Parameters) (params OidcAuthorizeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "path",
+		}
+		params.Provider = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "redirect_url",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.RedirectURL = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeOidcAuthorizeParams(args [1]string, argsEscaped bool, r *http.Request) (params OidcAuthorizeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: provider.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "provider",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Provider = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provider",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: redirect_url.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "redirect_url",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotRedirectURLVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotRedirectURLVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.RedirectURL.SetTo(paramsDotRedirectURLVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "redirect_url",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// OidcCallbackParams is parameters of oidcCallback operation.
+type OidcCallbackParams struct {
+	// Provider name.
+	Provider string
+	// Authorization code from provider.
+	Code string
+	// OAuth2 state parameter.
+	State string
+	// Error code if authorization failed.
+	Error OptString `json:",omitempty,omitzero"`
+	// Error description if authorization failed.
+	ErrorDescription OptString `json:",omitempty,omitzero"`
+}
+
+func unpackOidcCallbackParams(packed middleware.Parameters) (params OidcCallbackParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "path",
+		}
+		params.Provider = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "query",
+		}
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "state",
+			In:   "query",
+		}
+		params.State = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "error",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Error = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "error_description",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ErrorDescription = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeOidcCallbackParams(args [1]string, argsEscaped bool, r *http.Request) (params OidcCallbackParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: provider.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "provider",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Provider = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provider",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: code.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: state.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.State = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "state",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: error.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "error",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotErrorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotErrorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Error.SetTo(paramsDotErrorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "error",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: error_description.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "error_description",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotErrorDescriptionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotErrorDescriptionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ErrorDescription.SetTo(paramsDotErrorDescriptionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "error_description",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RefreshLiveTVSourceParams is parameters of refreshLiveTVSource operation.
+type RefreshLiveTVSourceParams struct {
+	// Source ID.
+	ID uuid.UUID
+}
+
+func unpackRefreshLiveTVSourceParams(packed middleware.Parameters) (params RefreshLiveTVSourceParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRefreshLiveTVSourceParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshLiveTVSourceParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RefreshMovieMetadataParams is parameters of refreshMovieMetadata operation.
+type RefreshMovieMetadataParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackRefreshMovieMetadataParams(packed middleware.Parameters) (params RefreshMovieMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRefreshMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshMovieMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RefreshPersonParams is parameters of refreshPerson operation.
+type RefreshPersonParams struct {
+	// Person ID.
+	PersonId uuid.UUID
+}
+
+func unpackRefreshPersonParams(packed middleware.Parameters) (params RefreshPersonParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "personId",
+			In:   "path",
+		}
+		params.PersonId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRefreshPersonParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshPersonParams, _ error) {
+	// Decode path: personId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "personId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PersonId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "personId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RefreshPodcastParams is parameters of refreshPodcast operation.
+type RefreshPodcastParams struct {
+	// Podcast ID.
+	ID uuid.UUID
+}
+
+func unpackRefreshPodcastParams(packed middleware.Parameters) (params RefreshPodcastParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRefreshPodcastParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshPodcastParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RefreshTVShowMetadataParams is parameters of refreshTVShowMetadata operation.
+type RefreshTVShowMetadataParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+}
+
+func unpackRefreshTVShowMetadataParams(packed middleware.Parameters) (params RefreshTVShowMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRefreshTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params RefreshTVShowMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RemovePhotoAlbumPhotoParams is parameters of removePhotoAlbumPhoto operation.
+type RemovePhotoAlbumPhotoParams struct {
+	// Album ID.
+	ID uuid.UUID
+	// Photo ID.
+	PhotoId uuid.UUID
+}
+
+func unpackRemovePhotoAlbumPhotoParams(packed middleware.Parameters) (params RemovePhotoAlbumPhotoParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "photoId",
+			In:   "path",
+		}
+		params.PhotoId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRemovePhotoAlbumPhotoParams(args [2]string, argsEscaped bool, r *http.Request) (params RemovePhotoAlbumPhotoParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: photoId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "photoId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.PhotoId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "photoId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RemoveRoleParams is parameters of removeRole operation.
+type RemoveRoleParams struct {
+	// User ID.
+	UserId uuid.UUID
+	// Role name.
+	Role string
+}
+
+func unpackRemoveRoleParams(packed middleware.Parameters) (params RemoveRoleParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "role",
+			In:   "path",
+		}
+		params.Role = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRemoveRoleParams(args [2]string, argsEscaped bool, r *http.Request) (params RemoveRoleParams, _ error) {
+	// Decode path: userId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: role.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "role",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Role = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "role",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RenameWebAuthnCredentialParams is parameters of renameWebAuthnCredential operation.
+type RenameWebAuthnCredentialParams struct {
+	CredentialId uuid.UUID
+}
+
+func unpackRenameWebAuthnCredentialParams(packed middleware.Parameters) (params RenameWebAuthnCredentialParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "credentialId",
+			In:   "path",
+		}
+		params.CredentialId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRenameWebAuthnCredentialParams(args [1]string, argsEscaped bool, r *http.Request) (params RenameWebAuthnCredentialParams, _ error) {
+	// Decode path: credentialId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "credentialId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CredentialId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "credentialId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeAPIKeyParams is parameters of revokeAPIKey operation.
+type RevokeAPIKeyParams struct {
+	// API Key ID.
+	KeyId uuid.UUID
+}
+
+func unpackRevokeAPIKeyParams(packed middleware.Parameters) (params RevokeAPIKeyParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "keyId",
+			In:   "path",
+		}
+		params.KeyId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRevokeAPIKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeAPIKeyParams, _ error) {
+	// Decode path: keyId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "keyId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.KeyId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "keyId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeLibraryPermissionParams is parameters of revokeLibraryPermission operation.
+type RevokeLibraryPermissionParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+	// User ID.
+	UserId uuid.UUID
+	// Permission to revoke.
+	Permission RevokeLibraryPermissionPermission
+}
+
+func unpackRevokeLibraryPermissionParams(packed middleware.Parameters) (params RevokeLibraryPermissionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "libraryId",
+			In:   "path",
+		}
+		params.LibraryId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "permission",
+			In:   "query",
+		}
+		params.Permission = packed[key].(RevokeLibraryPermissionPermission)
+	}
+	return params
+}
+
+func decodeRevokeLibraryPermissionParams(args [2]string, argsEscaped bool, r *http.Request) (params RevokeLibraryPermissionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: libraryId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "libraryId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.LibraryId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "libraryId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: userId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.UserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "userId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: permission.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "permission",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Permission = RevokeLibraryPermissionPermission(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Permission.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "permission",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RevokeSessionParams is parameters of revokeSession operation.
+type RevokeSessionParams struct {
+	// Session ID.
+	SessionId uuid.UUID
+}
+
+func unpackRevokeSessionParams(packed middleware.Parameters) (params RevokeSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "sessionId",
+			In:   "path",
+		}
+		params.SessionId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRevokeSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeSessionParams, _ error) {
+	// Decode path: sessionId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "sessionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.SessionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sessionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RollbackMovieMetadataParams is parameters of rollbackMovieMetadata operation.
+type RollbackMovieMetadataParams struct {
+	// Movie ID.
+	ID uuid.UUID
+	// Version to roll back to.
+	Version int32
+}
+
+func unpackRollbackMovieMetadataParams(packed middleware.Parameters) (params RollbackMovieMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "path",
+		}
+		params.Version = packed[key].(int32)
+	}
+	return params
+}
+
+func decodeRollbackMovieMetadataParams(args [2]string, argsEscaped bool, r *http.Request) (params RollbackMovieMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: version.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "version",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Version = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.Version)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RollbackTVEpisodeMetadataParams is parameters of rollbackTVEpisodeMetadata operation.
+type RollbackTVEpisodeMetadataParams struct {
+	// Episode ID.
+	ID uuid.UUID
+	// Version to roll back to.
+	Version int32
+}
+
+func unpackRollbackTVEpisodeMetadataParams(packed middleware.Parameters) (params RollbackTVEpisodeMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "path",
+		}
+		params.Version = packed[key].(int32)
+	}
+	return params
+}
+
+func decodeRollbackTVEpisodeMetadataParams(args [2]string, argsEscaped bool, r *http.Request) (params RollbackTVEpisodeMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: version.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "version",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Version = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.Version)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RollbackTVSeasonMetadataParams is parameters of rollbackTVSeasonMetadata operation.
+type RollbackTVSeasonMetadataParams struct {
+	// Season ID.
+	ID uuid.UUID
+	// Version to roll back to.
+	Version int32
+}
+
+func unpackRollbackTVSeasonMetadataParams(packed middleware.Parameters) (params RollbackTVSeasonMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "path",
+		}
+		params.Version = packed[key].(int32)
+	}
+	return params
+}
+
+func decodeRollbackTVSeasonMetadataParams(args [2]string, argsEscaped bool, r *http.Request) (params RollbackTVSeasonMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: version.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "version",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Version = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.Version)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RollbackTVShowMetadataParams is parameters of rollbackTVShowMetadata operation.
+type RollbackTVShowMetadataParams struct {
+	// Series ID.
+	ID uuid.UUID
+	// Version to roll back to.
+	Version int32
+}
+
+func unpackRollbackTVShowMetadataParams(packed middleware.Parameters) (params RollbackTVShowMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "path",
+		}
+		params.Version = packed[key].(int32)
+	}
+	return params
+}
+
+func decodeRollbackTVShowMetadataParams(args [2]string, argsEscaped bool, r *http.Request) (params RollbackTVShowMetadataParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: version.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "version",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt32(val)
+				if err != nil {
+					return err
+				}
+
+				params.Version = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.Int{
+					MinSet:        true,
+					Min:           0,
+					MaxSet:        false,
+					Max:           0,
+					MinExclusive:  false,
+					MaxExclusive:  false,
+					MultipleOfSet: false,
+					MultipleOf:    0,
+					Pattern:       nil,
+				}).Validate(int64(params.Version)); err != nil {
+					return errors.Wrap(err, "int")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchActivityLogsParams is parameters of searchActivityLogs operation.
+type SearchActivityLogsParams struct {
+	// Filter by user ID.
+	UserID OptUUID `json:",omitempty,omitzero"`
+	// Filter by action type.
+	Action OptString `json:",omitempty,omitzero"`
+	// Filter by resource type.
+	ResourceType OptString `json:",omitempty,omitzero"`
+	// Filter by resource ID.
+	ResourceID OptUUID `json:",omitempty,omitzero"`
+	// Filter by success status.
+	Success OptBool `json:",omitempty,omitzero"`
+	// Filter by start time (ISO 8601).
+	StartTime OptDateTime `json:",omitempty,omitzero"`
+	// Filter by end time (ISO 8601).
+	EndTime OptDateTime `json:",omitempty,omitzero"`
+	// Maximum number of results (default 50, max 100).
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Number of results to skip.
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackSearchActivityLogsParams(packed middleware.Parameters) (params SearchActivityLogsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "user_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.UserID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "action",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Action = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "resource_type",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResourceType = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "resource_id",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ResourceID = v.(OptUUID)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "success",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Success = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start_time",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.StartTime = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end_time",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.EndTime = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeSearchActivityLogsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchActivityLogsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: user_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "user_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUserIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUserIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.UserID.SetTo(paramsDotUserIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "user_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: action.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "action",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotActionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Action.SetTo(paramsDotActionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "action",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: resource_type.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "resource_type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotResourceTypeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotResourceTypeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ResourceType.SetTo(paramsDotResourceTypeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "resource_type",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: resource_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "resource_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotResourceIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotResourceIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ResourceID.SetTo(paramsDotResourceIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "resource_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: success.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "success",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSuccessVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSuccessVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Success.SetTo(paramsDotSuccessVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "success",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: start_time.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start_time",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStartTimeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStartTimeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.StartTime.SetTo(paramsDotStartTimeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start_time",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end_time.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end_time",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotEndTimeVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotEndTimeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.EndTime.SetTo(paramsDotEndTimeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end_time",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(50)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchLibraryHomeVideosParams is parameters of searchLibraryHomeVideos operation.
+type SearchLibraryHomeVideosParams struct {
+	// Search query (searches title, description, folders).
+	Q string
+	// Page number for pagination.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Results per page.
+	PerPage OptInt `json:",omitempty,omitzero"`
+	// Typesense filter syntax. Examples:
+	// - `year:=2024`
+	// - `folders:=Summer`.
+	FilterBy OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchLibraryHomeVideosParams(packed middleware.Parameters) (params SearchLibraryHomeVideosParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "per_page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PerPage = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FilterBy = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchLibraryHomeVideosParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchLibraryHomeVideosParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     200,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: per_page.
+	{
+		val := int(20)
+		params.PerPage.SetTo(val)
+	}
+	// Decode query: per_page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPerPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPerPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PerPage.SetTo(paramsDotPerPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PerPage.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "per_page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: filter_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FilterBy.SetTo(paramsDotFilterByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchLibraryMoviesParams is parameters of searchLibraryMovies operation.
+type SearchLibraryMoviesParams struct {
+	// Search query (searches title, overview, cast, directors).
+	Q string
+	// Page number for pagination.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Results per page.
+	PerPage OptInt `json:",omitempty,omitzero"`
+	// Sort field and direction.
+	SortBy OptSearchLibraryMoviesSortBy `json:",omitempty,omitzero"`
+	// Typesense filter syntax. Examples:
+	// - `genres:=Action`
+	// - `year:>=2020`
+	// - `has_file:=true && genres:=Action`
+	// - `tags:=[time travel]`.
+	FilterBy OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchLibraryMoviesParams(packed middleware.Parameters) (params SearchLibraryMoviesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "per_page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PerPage = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortBy = v.(OptSearchLibraryMoviesSortBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FilterBy = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchLibraryMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchLibraryMoviesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     200,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: per_page.
+	{
+		val := int(20)
+		params.PerPage.SetTo(val)
+	}
+	// Decode query: per_page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPerPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPerPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PerPage.SetTo(paramsDotPerPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PerPage.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "per_page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort_by.
+	{
+		val := SearchLibraryMoviesSortBy("popularity:desc")
+		params.SortBy.SetTo(val)
+	}
+	// Decode query: sort_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortByVal SearchLibraryMoviesSortBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortByVal = SearchLibraryMoviesSortBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortBy.SetTo(paramsDotSortByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: filter_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FilterBy.SetTo(paramsDotFilterByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchLibraryMusicParams is parameters of searchLibraryMusic operation.
+type SearchLibraryMusicParams struct {
+	// Search query (searches album title, artists, track titles).
+	Q string
+	// Page number for pagination.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Results per page.
+	PerPage OptInt `json:",omitempty,omitzero"`
+	// Typesense filter syntax. Examples:
+	// - `genres:=Rock`
+	// - `year:>=1990`
+	// - `is_compilation:=false`.
+	FilterBy OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchLibraryMusicParams(packed middleware.Parameters) (params SearchLibraryMusicParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "per_page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PerPage = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FilterBy = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchLibraryMusicParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchLibraryMusicParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     200,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: per_page.
+	{
+		val := int(20)
+		params.PerPage.SetTo(val)
+	}
+	// Decode query: per_page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPerPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPerPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PerPage.SetTo(paramsDotPerPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PerPage.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "per_page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: filter_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FilterBy.SetTo(paramsDotFilterByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchLibraryTVShowsParams is parameters of searchLibraryTVShows operation.
+type SearchLibraryTVShowsParams struct {
+	// Search query (searches title, overview, cast, networks).
+	Q string
+	// Page number for pagination.
+	Page OptInt `json:",omitempty,omitzero"`
+	// Results per page.
+	PerPage OptInt `json:",omitempty,omitzero"`
+	// Sort field and direction.
+	SortBy OptSearchLibraryTVShowsSortBy `json:",omitempty,omitzero"`
+	// Typesense filter syntax. Examples:
+	// - `genres:=Drama`
+	// - `year:>=2020`
+	// - `has_file:=true && status:=Returning Series`
+	// - `tags:=[time travel]`.
+	FilterBy OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchLibraryTVShowsParams(packed middleware.Parameters) (params SearchLibraryTVShowsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "per_page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.PerPage = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortBy = v.(OptSearchLibraryTVShowsSortBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "filter_by",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.FilterBy = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchLibraryTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchLibraryTVShowsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     200,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: page.
+	{
+		val := int(1)
+		params.Page.SetTo(val)
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	// Set default value for query: per_page.
 	{
 		val := int(20)
-		params.PerPage.SetTo(val)
+		params.PerPage.SetTo(val)
+	}
+	// Decode query: per_page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "per_page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPerPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPerPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.PerPage.SetTo(paramsDotPerPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.PerPage.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "per_page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort_by.
+	{
+		val := SearchLibraryTVShowsSortBy("popularity:desc")
+		params.SortBy.SetTo(val)
+	}
+	// Decode query: sort_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortByVal SearchLibraryTVShowsSortBy
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortByVal = SearchLibraryTVShowsSortBy(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortBy.SetTo(paramsDotSortByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: filter_by.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "filter_by",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFilterByVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFilterByVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.FilterBy.SetTo(paramsDotFilterByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "filter_by",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchMoviesParams is parameters of searchMovies operation.
+type SearchMoviesParams struct {
+	// Search query.
+	Query  string
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackSearchMoviesParams(packed middleware.Parameters) (params SearchMoviesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "query",
+			In:   "query",
+		}
+		params.Query = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeSearchMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMoviesParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: query.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Query = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "query",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: offset.
+	{
+		val := int(0)
+		params.Offset.SetTo(val)
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Offset.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchMoviesMetadataParams is parameters of searchMoviesMetadata operation.
+type SearchMoviesMetadataParams struct {
+	// Search query (movie title).
+	Q string
+	// Filter by release year.
+	Year OptInt `json:",omitempty,omitzero"`
+	// Maximum results to return.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Metadata provider to search (default tmdb). Use 'radarr' to search via the configured Radarr
+	// instance.
+	Provider OptSearchMoviesMetadataProvider `json:",omitempty,omitzero"`
+	// ISO 639-1 language code (e.g., en, de, pt-BR).
+	Language OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchMoviesMetadataParams(packed middleware.Parameters) (params SearchMoviesMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "year",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Year = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Provider = v.(OptSearchMoviesMetadataProvider)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchMoviesMetadataParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMoviesMetadataParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     100,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: year.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "year",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotYearVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotYearVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Year.SetTo(paramsDotYearVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Year.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1888,
+							MaxSet:        true,
+							Max:           2100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "year",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           50,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: provider.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "provider",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProviderVal SearchMoviesMetadataProvider
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProviderVal = SearchMoviesMetadataProvider(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Provider.SetTo(paramsDotProviderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Provider.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provider",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Language.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     0,
+							MaxLengthSet:  false,
+							Email:         false,
+							Hostname:      false,
+							Regex:         regexMap["^[a-z]{2}(-[A-Z]{2})?$"],
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchMultiParams is parameters of searchMulti operation.
+type SearchMultiParams struct {
+	// Search query.
+	Q string
+	// Maximum results per collection.
+	Limit OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackSearchMultiParams(packed middleware.Parameters) (params SearchMultiParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeSearchMultiParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMultiParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     200,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(5)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           20,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchPersonMetadataParams is parameters of searchPersonMetadata operation.
+type SearchPersonMetadataParams struct {
+	// Search query.
+	Q string
+	// Maximum number of results.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Metadata provider to search (default tmdb).
+	Provider OptSearchPersonMetadataProvider `json:",omitempty,omitzero"`
+	// ISO 639-1 language code (e.g., en, de, pt-BR).
+	Language OptString `json:",omitempty,omitzero"`
+}
+
+func unpackSearchPersonMetadataParams(packed middleware.Parameters) (params SearchPersonMetadataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "provider",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Provider = v.(OptSearchPersonMetadataProvider)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "language",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Language = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeSearchPersonMetadataParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchPersonMetadataParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: provider.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "provider",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProviderVal SearchPersonMetadataProvider
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProviderVal = SearchPersonMetadataProvider(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Provider.SetTo(paramsDotProviderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Provider.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provider",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: language.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "language",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLanguageVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLanguageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Language.SetTo(paramsDotLanguageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Language.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     0,
+							MaxLengthSet:  false,
+							Email:         false,
+							Hostname:      false,
+							Regex:         regexMap["^[a-z]{2}(-[A-Z]{2})?$"],
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchTVShowsParams is parameters of searchTVShows operation.
+type SearchTVShowsParams struct {
+	// Search query.
+	Query  string
+	Limit  OptInt `json:",omitempty,omitzero"`
+	Offset OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackSearchTVShowsParams(packed middleware.Parameters) (params SearchTVShowsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "query",
+			In:   "query",
+		}
+		params.Query = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeSearchTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchTVShowsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: query.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "query",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Query = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     0,
+					MaxLengthSet:  false,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Query)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "query",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
 	}
-	// Decode query: per_page.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "per_page",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPerPageVal int
+				var paramsDotLimitVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -11255,18 +28139,18 @@ func decodeSearchLibraryMoviesParams(args [0]string, argsEscaped bool, r *http.R
 						return err
 					}
 
-					paramsDotPerPageVal = c
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PerPage.SetTo(paramsDotPerPageVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.PerPage.Get(); ok {
+				if value, ok := params.Limit.Get(); ok {
 					if err := func() error {
 						if err := (validate.Int{
 							MinSet:        true,
@@ -11294,53 +28178,63 @@ func decodeSearchLibraryMoviesParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "per_page",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: sort_by.
+	// Set default value for query: offset.
 	{
-		val := SearchLibraryMoviesSortBy("popularity:desc")
-		params.SortBy.SetTo(val)
+		val := int(0)
+		params.Offset.SetTo(val)
 	}
-	// Decode query: sort_by.
+	// Decode query: offset.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort_by",
+			Name:    "offset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortByVal SearchLibraryMoviesSortBy
+				var paramsDotOffsetVal int
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSortByVal = SearchLibraryMoviesSortBy(c)
+					paramsDotOffsetVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SortBy.SetTo(paramsDotSortByVal)
+				params.Offset.SetTo(paramsDotOffsetVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.SortBy.Get(); ok {
+				if value, ok := params.Offset.Get(); ok {
 					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
 						}
 						return nil
 					}(); err != nil {
@@ -11355,48 +28249,7 @@ func decodeSearchLibraryMoviesParams(args [0]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort_by",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: filter_by.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "filter_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFilterByVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotFilterByVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.FilterBy.SetTo(paramsDotFilterByVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "filter_by",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
@@ -11404,24 +28257,22 @@ func decodeSearchLibraryMoviesParams(args [0]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// SearchLibraryTVShowsParams is parameters of searchLibraryTVShows operation.
-type SearchLibraryTVShowsParams struct {
-	// Search query (searches title, overview, cast, networks).
+// SearchTVShowsMetadataParams is parameters of searchTVShowsMetadata operation.
+type SearchTVShowsMetadataParams struct {
+	// Search query.
 	Q string
-	// Page number for pagination.
-	Page OptInt `json:",omitempty,omitzero"`
-	// Results per page.
-	PerPage OptInt `json:",omitempty,omitzero"`
-	// Sort field and direction.
-	SortBy OptSearchLibraryTVShowsSortBy `json:",omitempty,omitzero"`
-	// Typesense filter syntax. Examples:
-	// - `genres:=Drama`
-	// - `year:>=2020`
-	// - `has_file:=true && status:=Returning Series`.
-	FilterBy OptString `json:",omitempty,omitzero"`
+	// Filter by first air year.
+	Year OptInt `json:",omitempty,omitzero"`
+	// Maximum results to return.
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Metadata provider to search (default tmdb). Use 'sonarr' to search via the configured Sonarr
+	// instance.
+	Provider OptSearchTVShowsMetadataProvider `json:",omitempty,omitzero"`
+	// ISO 639-1 language code (e.g., en, de, pt-BR).
+	Language OptString `json:",omitempty,omitzero"`
 }
 
-func unpackSearchLibraryTVShowsParams(packed middleware.Parameters) (params SearchLibraryTVShowsParams) {
+func unpackSearchTVShowsMetadataParams(packed middleware.Parameters) (params SearchTVShowsMetadataParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "q",
@@ -11431,44 +28282,44 @@ func unpackSearchLibraryTVShowsParams(packed middleware.Parameters) (params Sear
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
+			Name: "year",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.Year = v.(OptInt)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "per_page",
+			Name: "limit",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.PerPage = v.(OptInt)
+			params.Limit = v.(OptInt)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "sort_by",
+			Name: "provider",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.SortBy = v.(OptSearchLibraryTVShowsSortBy)
+			params.Provider = v.(OptSearchTVShowsMetadataProvider)
 		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "filter_by",
+			Name: "language",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.FilterBy = v.(OptString)
+			params.Language = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeSearchLibraryTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchLibraryTVShowsParams, _ error) {
+func decodeSearchTVShowsMetadataParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchTVShowsMetadataParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode query: q.
 	if err := func() error {
@@ -11495,89 +28346,166 @@ func decodeSearchLibraryTVShowsParams(args [0]string, argsEscaped bool, r *http.
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: year.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "year",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotYearVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotYearVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Year.SetTo(paramsDotYearVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "year",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: limit.
+	{
+		val := int(20)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
 			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     200,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Q)); err != nil {
-					return errors.Wrap(err, "string")
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        false,
+							Min:           0,
+							MaxSet:        true,
+							Max:           100,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
+			Name: "limit",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: page.
-	{
-		val := int(1)
-		params.Page.SetTo(val)
-	}
-	// Decode query: page.
+	// Decode query: provider.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "page",
+			Name:    "provider",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPageVal int
+				var paramsDotProviderVal SearchTVShowsMetadataProvider
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPageVal = c
+					paramsDotProviderVal = SearchTVShowsMetadataProvider(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Page.SetTo(paramsDotPageVal)
+				params.Provider.SetTo(paramsDotProviderVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Page.Get(); ok {
+				if value, ok := params.Provider.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -11592,63 +28520,60 @@ func decodeSearchLibraryTVShowsParams(args [0]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "page",
+			Name: "provider",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: per_page.
-	{
-		val := int(20)
-		params.PerPage.SetTo(val)
-	}
-	// Decode query: per_page.
+	// Decode query: language.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "per_page",
+			Name:    "language",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotPerPageVal int
+				var paramsDotLanguageVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotPerPageVal = c
+					paramsDotLanguageVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.PerPage.SetTo(paramsDotPerPageVal)
+				params.Language.SetTo(paramsDotLanguageVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.PerPage.Get(); ok {
+				if value, ok := params.Language.Get(); ok {
 					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     0,
+							MaxLengthSet:  false,
+							Email:         false,
+							Hostname:      false,
+							Regex:         regexMap["^[a-z]{2}(-[A-Z]{2})?$"],
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
 						}
 						return nil
 					}(); err != nil {
@@ -11663,410 +28588,608 @@ func decodeSearchLibraryTVShowsParams(args [0]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "per_page",
-			In:   "query",
+			Name: "language",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SelectMovieArtworkParams is parameters of selectMovieArtwork operation.
+type SelectMovieArtworkParams struct {
+	// Movie ID.
+	ID uuid.UUID
+	// Artwork ID.
+	ArtworkId uuid.UUID
+}
+
+func unpackSelectMovieArtworkParams(packed middleware.Parameters) (params SelectMovieArtworkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "artworkId",
+			In:   "path",
+		}
+		params.ArtworkId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeSelectMovieArtworkParams(args [2]string, argsEscaped bool, r *http.Request) (params SelectMovieArtworkParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: artworkId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "artworkId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ArtworkId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "artworkId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SelectTVShowArtworkParams is parameters of selectTVShowArtwork operation.
+type SelectTVShowArtworkParams struct {
+	// Series ID.
+	ID uuid.UUID
+	// Artwork ID.
+	ArtworkId uuid.UUID
+}
+
+func unpackSelectTVShowArtworkParams(packed middleware.Parameters) (params SelectTVShowArtworkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "artworkId",
+			In:   "path",
+		}
+		params.ArtworkId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeSelectTVShowArtworkParams(args [2]string, argsEscaped bool, r *http.Request) (params SelectTVShowArtworkParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: sort_by.
-	{
-		val := SearchLibraryTVShowsSortBy("popularity:desc")
-		params.SortBy.SetTo(val)
-	}
-	// Decode query: sort_by.
+	// Decode path: artworkId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "artworkId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortByVal SearchLibraryTVShowsSortBy
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotSortByVal = SearchLibraryTVShowsSortBy(c)
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.SortBy.SetTo(paramsDotSortByVal)
+
+				params.ArtworkId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "artworkId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SharePhotoAlbumParams is parameters of sharePhotoAlbum operation.
+type SharePhotoAlbumParams struct {
+	// Album ID.
+	ID uuid.UUID
+	// User to share the album with.
+	UserId uuid.UUID
+}
+
+func unpackSharePhotoAlbumParams(packed middleware.Parameters) (params SharePhotoAlbumParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeSharePhotoAlbumParams(args [2]string, argsEscaped bool, r *http.Request) (params SharePhotoAlbumParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.SortBy.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort_by",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: filter_by.
+	// Decode path: userId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "filter_by",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotFilterByVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotFilterByVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.FilterBy.SetTo(paramsDotFilterByVal)
+
+				params.UserId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "filter_by",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SearchMoviesParams is parameters of searchMovies operation.
-type SearchMoviesParams struct {
-	// Search query.
-	Query  string
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// StopPlaybackSessionParams is parameters of stopPlaybackSession operation.
+type StopPlaybackSessionParams struct {
+	SessionId uuid.UUID
 }
 
-func unpackSearchMoviesParams(packed middleware.Parameters) (params SearchMoviesParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "query",
-			In:   "query",
-		}
-		params.Query = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackStopPlaybackSessionParams(packed middleware.Parameters) (params StopPlaybackSessionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Name: "sessionId",
+			In:   "path",
 		}
+		params.SessionId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeSearchMoviesParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMoviesParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: query.
+func decodeStopPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params StopPlaybackSessionParams, _ error) {
+	// Decode path: sessionId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "query",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "sessionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Query = c
+				params.SessionId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "query",
-			In:   "query",
+			Name: "sessionId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// SubscribePodcastParams is parameters of subscribePodcast operation.
+type SubscribePodcastParams struct {
+	// Podcast ID.
+	ID uuid.UUID
+}
+
+func unpackSubscribePodcastParams(packed middleware.Parameters) (params SubscribePodcastParams) {
 	{
-		val := int(20)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeSubscribePodcastParams(args [1]string, argsEscaped bool, r *http.Request) (params SubscribePodcastParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// TriggerLibraryScanParams is parameters of triggerLibraryScan operation.
+type TriggerLibraryScanParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+}
+
+func unpackTriggerLibraryScanParams(packed middleware.Parameters) (params TriggerLibraryScanParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "libraryId",
+			In:   "path",
+		}
+		params.LibraryId = packed[key].(uuid.UUID)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeTriggerLibraryScanParams(args [1]string, argsEscaped bool, r *http.Request) (params TriggerLibraryScanParams, _ error) {
+	// Decode path: libraryId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "libraryId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.LibraryId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "libraryId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// SearchMoviesMetadataParams is parameters of searchMoviesMetadata operation.
-type SearchMoviesMetadataParams struct {
-	// Search query (movie title).
-	Q string
-	// Filter by release year.
-	Year OptInt `json:",omitempty,omitzero"`
-	// Maximum results to return.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Metadata provider to search (default tmdb). Use 'radarr' to search via the configured Radarr
-	// instance.
-	Provider OptSearchMoviesMetadataProvider `json:",omitempty,omitzero"`
-	// ISO 639-1 language code (e.g., en, de, pt-BR).
-	Language OptString `json:",omitempty,omitzero"`
-}
-
-func unpackSearchMoviesMetadataParams(packed middleware.Parameters) (params SearchMoviesMetadataParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
-		}
-		params.Q = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "year",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Year = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "provider",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Provider = v.(OptSearchMoviesMetadataProvider)
-		}
-	}
+	return params, nil
+}
+
+// UnlinkOIDCProviderParams is parameters of unlinkOIDCProvider operation.
+type UnlinkOIDCProviderParams struct {
+	// Provider name to unlink.
+	Provider string
+}
+
+func unpackUnlinkOIDCProviderParams(packed middleware.Parameters) (params UnlinkOIDCProviderParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
+			Name: "provider",
+			In:   "path",
 		}
+		params.Provider = packed[key].(string)
 	}
 	return params
 }
 
-func decodeSearchMoviesMetadataParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMoviesMetadataParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
+func decodeUnlinkOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Request) (params UnlinkOIDCProviderParams, _ error) {
+	// Decode path: provider.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "provider",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -12077,1349 +29200,1270 @@ func decodeSearchMoviesMetadataParams(args [0]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				params.Q = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     100,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Q)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.Provider = c
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
-			In:   "query",
+			Name: "provider",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: year.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "year",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotYearVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// UnlockMovieMetadataFieldParams is parameters of unlockMovieMetadataField operation.
+type UnlockMovieMetadataFieldParams struct {
+	// Movie ID.
+	ID uuid.UUID
+	// Locked field name.
+	Field MetadataLockField
+}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+func unpackUnlockMovieMetadataFieldParams(packed middleware.Parameters) (params UnlockMovieMetadataFieldParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
+		}
+		params.Field = packed[key].(MetadataLockField)
+	}
+	return params
+}
 
-					paramsDotYearVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Year.SetTo(paramsDotYearVal)
-				return nil
-			}); err != nil {
-				return err
+func decodeUnlockMovieMetadataFieldParams(args [2]string, argsEscaped bool, r *http.Request) (params UnlockMovieMetadataFieldParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Year.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1888,
-							MaxSet:        true,
-							Max:           2100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "year",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: field.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Field = MetadataLockField(c)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           50,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := params.Field.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "field",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: provider.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "provider",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// UnlockTVEpisodeMetadataFieldParams is parameters of unlockTVEpisodeMetadataField operation.
+type UnlockTVEpisodeMetadataFieldParams struct {
+	// Episode ID.
+	ID uuid.UUID
+	// Locked field name.
+	Field MetadataLockField
+}
+
+func unpackUnlockTVEpisodeMetadataFieldParams(packed middleware.Parameters) (params UnlockTVEpisodeMetadataFieldParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "field",
+			In:   "path",
 		}
+		params.Field = packed[key].(MetadataLockField)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotProviderVal SearchMoviesMetadataProvider
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func decodeUnlockTVEpisodeMetadataFieldParams(args [2]string, argsEscaped bool, r *http.Request) (params UnlockTVEpisodeMetadataFieldParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					paramsDotProviderVal = SearchMoviesMetadataProvider(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Provider.SetTo(paramsDotProviderVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Provider.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: language.
+	// Decode path: field.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Language.SetTo(paramsDotLanguageVal)
+
+				params.Field = MetadataLockField(c)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Language.Get(); ok {
-					if err := func() error {
-						if err := (validate.String{
-							MinLength:     0,
-							MinLengthSet:  false,
-							MaxLength:     0,
-							MaxLengthSet:  false,
-							Email:         false,
-							Hostname:      false,
-							Regex:         regexMap["^[a-z]{2}(-[A-Z]{2})?$"],
-							MinNumeric:    0,
-							MinNumericSet: false,
-							MaxNumeric:    0,
-							MaxNumericSet: false,
-						}).Validate(string(value)); err != nil {
-							return errors.Wrap(err, "string")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := params.Field.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
+			Name: "field",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SearchMultiParams is parameters of searchMulti operation.
-type SearchMultiParams struct {
-	// Search query.
-	Q string
-	// Maximum results per collection.
-	Limit OptInt `json:",omitempty,omitzero"`
+// UnlockTVSeasonMetadataFieldParams is parameters of unlockTVSeasonMetadataField operation.
+type UnlockTVSeasonMetadataFieldParams struct {
+	// Season ID.
+	ID uuid.UUID
+	// Locked field name.
+	Field MetadataLockField
 }
 
-func unpackSearchMultiParams(packed middleware.Parameters) (params SearchMultiParams) {
+func unpackUnlockTVSeasonMetadataFieldParams(packed middleware.Parameters) (params UnlockTVSeasonMetadataFieldParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 		}
-		params.Q = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+			Name: "field",
+			In:   "path",
 		}
+		params.Field = packed[key].(MetadataLockField)
 	}
 	return params
 }
 
-func decodeSearchMultiParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchMultiParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
+func decodeUnlockTVSeasonMetadataFieldParams(args [2]string, argsEscaped bool, r *http.Request) (params UnlockTVSeasonMetadataFieldParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Q = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     200,
-					MaxLengthSet:  true,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Q)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(5)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: field.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Field = MetadataLockField(c)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           20,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := params.Field.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "field",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SearchPersonMetadataParams is parameters of searchPersonMetadata operation.
-type SearchPersonMetadataParams struct {
-	// Search query.
-	Q string
-	// Maximum number of results.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Metadata provider to search (default tmdb).
-	Provider OptSearchPersonMetadataProvider `json:",omitempty,omitzero"`
-	// ISO 639-1 language code (e.g., en, de, pt-BR).
-	Language OptString `json:",omitempty,omitzero"`
+// UnlockTVShowMetadataFieldParams is parameters of unlockTVShowMetadataField operation.
+type UnlockTVShowMetadataFieldParams struct {
+	// TV Show ID.
+	ID uuid.UUID
+	// Locked field name.
+	Field MetadataLockField
 }
 
-func unpackSearchPersonMetadataParams(packed middleware.Parameters) (params SearchPersonMetadataParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
-		}
-		params.Q = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackUnlockTVShowMetadataFieldParams(packed middleware.Parameters) (params UnlockTVShowMetadataFieldParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "provider",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Provider = v.(OptSearchPersonMetadataProvider)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
+			Name: "field",
+			In:   "path",
 		}
+		params.Field = packed[key].(MetadataLockField)
 	}
 	return params
 }
 
-func decodeSearchPersonMetadataParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchPersonMetadataParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
+func decodeUnlockTVShowMetadataFieldParams(args [2]string, argsEscaped bool, r *http.Request) (params UnlockTVShowMetadataFieldParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Q = c
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(20)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+	// Decode path: field.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "field",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Field = MetadataLockField(c)
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				if err := params.Field.Validate(); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "field",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: provider.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "provider",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotProviderVal SearchPersonMetadataProvider
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// UnsharePhotoAlbumParams is parameters of unsharePhotoAlbum operation.
+type UnsharePhotoAlbumParams struct {
+	// Album ID.
+	ID uuid.UUID
+	// User the album is shared with.
+	UserId uuid.UUID
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackUnsharePhotoAlbumParams(packed middleware.Parameters) (params UnsharePhotoAlbumParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "userId",
+			In:   "path",
+		}
+		params.UserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeUnsharePhotoAlbumParams(args [2]string, argsEscaped bool, r *http.Request) (params UnsharePhotoAlbumParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					paramsDotProviderVal = SearchPersonMetadataProvider(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Provider.SetTo(paramsDotProviderVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Provider.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: language.
+	// Decode path: userId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Language.SetTo(paramsDotLanguageVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Language.Get(); ok {
-					if err := func() error {
-						if err := (validate.String{
-							MinLength:     0,
-							MinLengthSet:  false,
-							MaxLength:     0,
-							MaxLengthSet:  false,
-							Email:         false,
-							Hostname:      false,
-							Regex:         regexMap["^[a-z]{2}(-[A-Z]{2})?$"],
-							MinNumeric:    0,
-							MinNumericSet: false,
-							MaxNumeric:    0,
-							MaxNumericSet: false,
-						}).Validate(string(value)); err != nil {
-							return errors.Wrap(err, "string")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.UserId = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
+			Name: "userId",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SearchTVShowsParams is parameters of searchTVShows operation.
-type SearchTVShowsParams struct {
-	// Search query.
-	Query  string
-	Limit  OptInt `json:",omitempty,omitzero"`
-	Offset OptInt `json:",omitempty,omitzero"`
+// UnsubscribePodcastParams is parameters of unsubscribePodcast operation.
+type UnsubscribePodcastParams struct {
+	// Podcast ID.
+	ID uuid.UUID
 }
 
-func unpackSearchTVShowsParams(packed middleware.Parameters) (params SearchTVShowsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "query",
-			In:   "query",
-		}
-		params.Query = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackUnsubscribePodcastParams(packed middleware.Parameters) (params UnsubscribePodcastParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeSearchTVShowsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchTVShowsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: query.
+func decodeUnsubscribePodcastParams(args [1]string, argsEscaped bool, r *http.Request) (params UnsubscribePodcastParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "query",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Query = c
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := (validate.String{
-					MinLength:     1,
-					MinLengthSet:  true,
-					MaxLength:     0,
-					MaxLengthSet:  false,
-					Email:         false,
-					Hostname:      false,
-					Regex:         nil,
-					MinNumeric:    0,
-					MinNumericSet: false,
-					MaxNumeric:    0,
-					MaxNumericSet: false,
-				}).Validate(string(params.Query)); err != nil {
-					return errors.Wrap(err, "string")
-				}
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "query",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// UpdateAudiobookProgressParams is parameters of updateAudiobookProgress operation.
+type UpdateAudiobookProgressParams struct {
+	// Audiobook ID.
+	ID uuid.UUID
+}
+
+func unpackUpdateAudiobookProgressParams(packed middleware.Parameters) (params UpdateAudiobookProgressParams) {
 	{
-		val := int(20)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeUpdateAudiobookProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateAudiobookProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           1,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: offset.
+	return params, nil
+}
+
+// UpdateBookProgressParams is parameters of updateBookProgress operation.
+type UpdateBookProgressParams struct {
+	// Book ID.
+	ID uuid.UUID
+}
+
+func unpackUpdateBookProgressParams(packed middleware.Parameters) (params UpdateBookProgressParams) {
 	{
-		val := int(0)
-		params.Offset.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: offset.
+	return params
+}
+
+func decodeUpdateBookProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBookProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Offset.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           0,
-							MaxSet:        false,
-							Max:           0,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// SearchTVShowsMetadataParams is parameters of searchTVShowsMetadata operation.
-type SearchTVShowsMetadataParams struct {
-	// Search query.
-	Q string
-	// Filter by first air year.
-	Year OptInt `json:",omitempty,omitzero"`
-	// Maximum results to return.
-	Limit OptInt `json:",omitempty,omitzero"`
-	// Metadata provider to search (default tmdb). Use 'sonarr' to search via the configured Sonarr
-	// instance.
-	Provider OptSearchTVShowsMetadataProvider `json:",omitempty,omitzero"`
-	// ISO 639-1 language code (e.g., en, de, pt-BR).
-	Language OptString `json:",omitempty,omitzero"`
+// UpdateHomeVideoProgressParams is parameters of updateHomeVideoProgress operation.
+type UpdateHomeVideoProgressParams struct {
+	// Home video ID.
+	ID uuid.UUID
 }
 
-func unpackSearchTVShowsMetadataParams(packed middleware.Parameters) (params SearchTVShowsMetadataParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "q",
-			In:   "query",
-		}
-		params.Q = packed[key].(string)
-	}
+func unpackUpdateHomeVideoProgressParams(packed middleware.Parameters) (params UpdateHomeVideoProgressParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "year",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Year = v.(OptInt)
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
+	return params
+}
+
+func decodeUpdateHomeVideoProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateHomeVideoProgressParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "provider",
-			In:   "query",
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
 		}
-		if v, ok := packed[key]; ok {
-			params.Provider = v.(OptSearchTVShowsMetadataProvider)
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
 		}
 	}
+	return params, nil
+}
+
+// UpdateLibraryParams is parameters of updateLibrary operation.
+type UpdateLibraryParams struct {
+	// Library ID.
+	LibraryId uuid.UUID
+}
+
+func unpackUpdateLibraryParams(packed middleware.Parameters) (params UpdateLibraryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "language",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Language = v.(OptString)
+			Name: "libraryId",
+			In:   "path",
 		}
+		params.LibraryId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeSearchTVShowsMetadataParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchTVShowsMetadataParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: q.
+func decodeUpdateLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateLibraryParams, _ error) {
+	// Decode path: libraryId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "q",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "libraryId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Q = c
+				params.LibraryId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "q",
-			In:   "query",
+			Name: "libraryId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: year.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "year",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// UpdateLiveTVChannelParams is parameters of updateLiveTVChannel operation.
+type UpdateLiveTVChannelParams struct {
+	// Channel ID.
+	ID uuid.UUID
+}
+
+func unpackUpdateLiveTVChannelParams(packed middleware.Parameters) (params UpdateLiveTVChannelParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
 		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotYearVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeUpdateLiveTVChannelParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateLiveTVChannelParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotYearVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToUUID(val)
+				if err != nil {
 					return err
 				}
-				params.Year.SetTo(paramsDotYearVal)
+
+				params.ID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "year",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
+	return params, nil
+}
+
+// UpdateMovieMetadataParams is parameters of updateMovieMetadata operation.
+type UpdateMovieMetadataParams struct {
+	// Movie ID.
+	ID uuid.UUID
+}
+
+func unpackUpdateMovieMetadataParams(packed middleware.Parameters) (params UpdateMovieMetadataParams) {
 	{
-		val := int(20)
-		params.Limit.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeUpdateMovieMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateMovieMetadataParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Limit.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        false,
-							Min:           0,
-							MaxSet:        true,
-							Max:           100,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: provider.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "provider",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotProviderVal SearchTVShowsMetadataProvider
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// UpdatePhotoAlbumParams is parameters of updatePhotoAlbum operation.
+type UpdatePhotoAlbumParams struct {
+	// Album ID.
+	ID uuid.UUID
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackUpdatePhotoAlbumParams(packed middleware.Parameters) (params UpdatePhotoAlbumParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-					paramsDotProviderVal = SearchTVShowsMetadataProvider(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Provider.SetTo(paramsDotProviderVal)
-				return nil
-			}); err != nil {
-				return err
+func decodeUpdatePhotoAlbumParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdatePhotoAlbumParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
-			if err := func() error {
-				if value, ok := params.Provider.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: language.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "language",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLanguageVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// UpdatePodcastEpisodeProgressParams is parameters of updatePodcastEpisodeProgress operation.
+type UpdatePodcastEpisodeProgressParams struct {
+	// Episode ID.
+	ID uuid.UUID
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackUpdatePodcastEpisodeProgressParams(packed middleware.Parameters) (params UpdatePodcastEpisodeProgressParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(uuid.UUID)
+	}
+	return params
+}
 
-					paramsDotLanguageVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Language.SetTo(paramsDotLanguageVal)
-				return nil
-			}); err != nil {
-				return err
+func decodeUpdatePodcastEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdatePodcastEpisodeProgressParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Language.Get(); ok {
-					if err := func() error {
-						if err := (validate.String{
-							MinLength:     0,
-							MinLengthSet:  false,
-							MaxLength:     0,
-							MaxLengthSet:  false,
-							Email:         false,
-							Hostname:      false,
-							Regex:         regexMap["^[a-z]{2}(-[A-Z]{2})?$"],
-							MinNumeric:    0,
-							MinNumericSet: false,
-							MaxNumeric:    0,
-							MaxNumericSet: false,
-						}).Validate(string(value)); err != nil {
-							return errors.Wrap(err, "string")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "language",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// StopPlaybackSessionParams is parameters of stopPlaybackSession operation.
-type StopPlaybackSessionParams struct {
-	SessionId uuid.UUID
+// UpdatePodcastSettingsParams is parameters of updatePodcastSettings operation.
+type UpdatePodcastSettingsParams struct {
+	// Podcast ID.
+	ID uuid.UUID
 }
 
-func unpackStopPlaybackSessionParams(packed middleware.Parameters) (params StopPlaybackSessionParams) {
+func unpackUpdatePodcastSettingsParams(packed middleware.Parameters) (params UpdatePodcastSettingsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "sessionId",
+			Name: "id",
 			In:   "path",
 		}
-		params.SessionId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeStopPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params StopPlaybackSessionParams, _ error) {
-	// Decode path: sessionId.
+func decodeUpdatePodcastSettingsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdatePodcastSettingsParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -13431,7 +30475,7 @@ func decodeStopPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "sessionId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -13448,7 +30492,7 @@ func decodeStopPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				params.SessionId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -13459,7 +30503,7 @@ func decodeStopPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sessionId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -13467,25 +30511,25 @@ func decodeStopPlaybackSessionParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// TriggerLibraryScanParams is parameters of triggerLibraryScan operation.
-type TriggerLibraryScanParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
+// UpdateRolePermissionsParams is parameters of updateRolePermissions operation.
+type UpdateRolePermissionsParams struct {
+	// Role name.
+	RoleName string
 }
 
-func unpackTriggerLibraryScanParams(packed middleware.Parameters) (params TriggerLibraryScanParams) {
+func unpackUpdateRolePermissionsParams(packed middleware.Parameters) (params UpdateRolePermissionsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "roleName",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.RoleName = packed[key].(string)
 	}
 	return params
 }
 
-func decodeTriggerLibraryScanParams(args [1]string, argsEscaped bool, r *http.Request) (params TriggerLibraryScanParams, _ error) {
-	// Decode path: libraryId.
+func decodeUpdateRolePermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateRolePermissionsParams, _ error) {
+	// Decode path: roleName.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -13497,7 +30541,7 @@ func decodeTriggerLibraryScanParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "roleName",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -13509,12 +30553,12 @@ func decodeTriggerLibraryScanParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.LibraryId = c
+				params.RoleName = c
 				return nil
 			}(); err != nil {
 				return err
@@ -13525,7 +30569,7 @@ func decodeTriggerLibraryScanParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "roleName",
 			In:   "path",
 			Err:  err,
 		}
@@ -13533,25 +30577,25 @@ func decodeTriggerLibraryScanParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// UnlinkOIDCProviderParams is parameters of unlinkOIDCProvider operation.
-type UnlinkOIDCProviderParams struct {
-	// Provider name to unlink.
-	Provider string
+// UpdateServerSettingParams is parameters of updateServerSetting operation.
+type UpdateServerSettingParams struct {
+	// Setting key.
+	Key string
 }
 
-func unpackUnlinkOIDCProviderParams(packed middleware.Parameters) (params UnlinkOIDCProviderParams) {
+func unpackUpdateServerSettingParams(packed middleware.Parameters) (params UpdateServerSettingParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "provider",
+			Name: "key",
 			In:   "path",
 		}
-		params.Provider = packed[key].(string)
+		params.Key = packed[key].(string)
 	}
 	return params
 }
 
-func decodeUnlinkOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Request) (params UnlinkOIDCProviderParams, _ error) {
-	// Decode path: provider.
+func decodeUpdateServerSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateServerSettingParams, _ error) {
+	// Decode path: key.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -13563,7 +30607,7 @@ func decodeUnlinkOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "provider",
+				Param:   "key",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -13580,7 +30624,7 @@ func decodeUnlinkOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.Provider = c
+				params.Key = c
 				return nil
 			}(); err != nil {
 				return err
@@ -13591,7 +30635,7 @@ func decodeUnlinkOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
+			Name: "key",
 			In:   "path",
 			Err:  err,
 		}
@@ -13599,25 +30643,25 @@ func decodeUnlinkOIDCProviderParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// UpdateLibraryParams is parameters of updateLibrary operation.
-type UpdateLibraryParams struct {
-	// Library ID.
-	LibraryId uuid.UUID
+// UpdateTVEpisodeMetadataParams is parameters of updateTVEpisodeMetadata operation.
+type UpdateTVEpisodeMetadataParams struct {
+	// Episode ID.
+	ID uuid.UUID
 }
 
-func unpackUpdateLibraryParams(packed middleware.Parameters) (params UpdateLibraryParams) {
+func unpackUpdateTVEpisodeMetadataParams(packed middleware.Parameters) (params UpdateTVEpisodeMetadataParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 		}
-		params.LibraryId = packed[key].(uuid.UUID)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateLibraryParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateLibraryParams, _ error) {
-	// Decode path: libraryId.
+func decodeUpdateTVEpisodeMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTVEpisodeMetadataParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -13629,7 +30673,7 @@ func decodeUpdateLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "libraryId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -13646,7 +30690,7 @@ func decodeUpdateLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.LibraryId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -13657,7 +30701,7 @@ func decodeUpdateLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "libraryId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -13665,25 +30709,25 @@ func decodeUpdateLibraryParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// UpdateRolePermissionsParams is parameters of updateRolePermissions operation.
-type UpdateRolePermissionsParams struct {
-	// Role name.
-	RoleName string
+// UpdateTVEpisodeProgressParams is parameters of updateTVEpisodeProgress operation.
+type UpdateTVEpisodeProgressParams struct {
+	// Episode ID.
+	ID uuid.UUID
 }
 
-func unpackUpdateRolePermissionsParams(packed middleware.Parameters) (params UpdateRolePermissionsParams) {
+func unpackUpdateTVEpisodeProgressParams(packed middleware.Parameters) (params UpdateTVEpisodeProgressParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "roleName",
+			Name: "id",
 			In:   "path",
 		}
-		params.RoleName = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateRolePermissionsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateRolePermissionsParams, _ error) {
-	// Decode path: roleName.
+func decodeUpdateTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTVEpisodeProgressParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -13695,7 +30739,7 @@ func decodeUpdateRolePermissionsParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "roleName",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -13707,12 +30751,12 @@ func decodeUpdateRolePermissionsParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.RoleName = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -13723,7 +30767,7 @@ func decodeUpdateRolePermissionsParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "roleName",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -13731,25 +30775,25 @@ func decodeUpdateRolePermissionsParams(args [1]string, argsEscaped bool, r *http
 	return params, nil
 }
 
-// UpdateServerSettingParams is parameters of updateServerSetting operation.
-type UpdateServerSettingParams struct {
-	// Setting key.
-	Key string
+// UpdateTVSeasonMetadataParams is parameters of updateTVSeasonMetadata operation.
+type UpdateTVSeasonMetadataParams struct {
+	// Season ID.
+	ID uuid.UUID
 }
 
-func unpackUpdateServerSettingParams(packed middleware.Parameters) (params UpdateServerSettingParams) {
+func unpackUpdateTVSeasonMetadataParams(packed middleware.Parameters) (params UpdateTVSeasonMetadataParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "key",
+			Name: "id",
 			In:   "path",
 		}
-		params.Key = packed[key].(string)
+		params.ID = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeUpdateServerSettingParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateServerSettingParams, _ error) {
-	// Decode path: key.
+func decodeUpdateTVSeasonMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTVSeasonMetadataParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -13761,7 +30805,7 @@ func decodeUpdateServerSettingParams(args [1]string, argsEscaped bool, r *http.R
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "key",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -13773,12 +30817,12 @@ func decodeUpdateServerSettingParams(args [1]string, argsEscaped bool, r *http.R
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToUUID(val)
 				if err != nil {
 					return err
 				}
 
-				params.Key = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -13789,7 +30833,7 @@ func decodeUpdateServerSettingParams(args [1]string, argsEscaped bool, r *http.R
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "key",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -13797,13 +30841,13 @@ func decodeUpdateServerSettingParams(args [1]string, argsEscaped bool, r *http.R
 	return params, nil
 }
 
-// UpdateTVEpisodeProgressParams is parameters of updateTVEpisodeProgress operation.
-type UpdateTVEpisodeProgressParams struct {
-	// Episode ID.
+// UpdateTVShowMetadataParams is parameters of updateTVShowMetadata operation.
+type UpdateTVShowMetadataParams struct {
+	// TV Show ID.
 	ID uuid.UUID
 }
 
-func unpackUpdateTVEpisodeProgressParams(packed middleware.Parameters) (params UpdateTVEpisodeProgressParams) {
+func unpackUpdateTVShowMetadataParams(packed middleware.Parameters) (params UpdateTVShowMetadataParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -13814,7 +30858,7 @@ func unpackUpdateTVEpisodeProgressParams(packed middleware.Parameters) (params U
 	return params
 }
 
-func decodeUpdateTVEpisodeProgressParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTVEpisodeProgressParams, _ error) {
+func decodeUpdateTVShowMetadataParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateTVShowMetadataParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -14,6 +14,20 @@ import (
 	"github.com/ogen-go/ogen/uri"
 )
 
+func encodeAddPhotoAlbumPhotosRequest(
+	req *PhotoAlbumPhotosAdd,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeAddPolicyRequest(
 	req *PolicyRequest,
 	r *http.Request,
@@ -42,6 +56,60 @@ func encodeAdminCreateOIDCProviderRequest(
 	return nil
 }
 
+func encodeAdminExportMovieNFORequest(
+	req OptNFOExportRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeAdminExportTVShowNFORequest(
+	req OptTVShowNFOExportRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeAdminIdentifyMatchQueueItemRequest(
+	req *IdentifyMatchRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeAdminUpdateOIDCProviderRequest(
 	req *UpdateOIDCProviderRequest,
 	r *http.Request,
@@ -56,6 +124,20 @@ func encodeAdminUpdateOIDCProviderRequest(
 	return nil
 }
 
+func encodeAdminUpdateParentalControlsRequest(
+	req *ParentalControlsUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeAssignRoleRequest(
 	req *AssignRoleRequest,
 	r *http.Request,
@@ -132,6 +214,62 @@ func encodeCreateLibraryRequest(
 	return nil
 }
 
+func encodeCreateLiveTVRecordingRequest(
+	req *LiveTVRecordingCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateLiveTVSeriesRuleRequest(
+	req *LiveTVSeriesRuleCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreateLiveTVSourceRequest(
+	req *LiveTVSourceCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeCreatePhotoAlbumRequest(
+	req *PhotoAlbumCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateRoleRequest(
 	req *CreateRoleRequest,
 	r *http.Request,
@@ -230,6 +368,26 @@ func encodeHandleSonarrWebhookRequest(
 	return nil
 }
 
+func encodeHeartbeatPlaybackSessionRequest(
+	req OptHeartbeatPlaybackSessionReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeLoginRequest(
 	req *LoginRequest,
 	r *http.Request,
@@ -376,6 +534,20 @@ func encodeResetPasswordRequest(
 	return nil
 }
 
+func encodeSetLegacyPINRequest(
+	req *LegacyPINRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeSetupTOTPRequest(
 	req *SetupTOTPReq,
 	r *http.Request,
@@ -390,6 +562,20 @@ func encodeSetupTOTPRequest(
 	return nil
 }
 
+func encodeSharePhotoAlbumRequest(
+	req *PhotoAlbumShareRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeStartPlaybackSessionRequest(
 	req *StartPlaybackRequest,
 	r *http.Request,
@@ -404,6 +590,20 @@ func encodeStartPlaybackSessionRequest(
 	return nil
 }
 
+func encodeSubscribePodcastFeedRequest(
+	req *PodcastSubscribeRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeTriggerLibraryScanRequest(
 	req *TriggerLibraryScanReq,
 	r *http.Request,
@@ -418,6 +618,62 @@ func encodeTriggerLibraryScanRequest(
 	return nil
 }
 
+func encodeUnlockLegacyRequest(
+	req *LegacyUnlockRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUnlockParentalControlsRequest(
+	req *ParentalControlsUnlockRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateAudiobookProgressRequest(
+	req *AudiobookProgressUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateBookProgressRequest(
+	req *BookProgressUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateCurrentUserRequest(
 	req *UserUpdate,
 	r *http.Request,
@@ -432,6 +688,20 @@ func encodeUpdateCurrentUserRequest(
 	return nil
 }
 
+func encodeUpdateHomeVideoProgressRequest(
+	req *HomeVideoProgressUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateLibraryRequest(
 	req *UpdateLibraryRequest,
 	r *http.Request,
@@ -446,6 +716,76 @@ func encodeUpdateLibraryRequest(
 	return nil
 }
 
+func encodeUpdateLiveTVChannelRequest(
+	req *LiveTVChannelUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateMovieMetadataRequest(
+	req *MovieMetadataEdit,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdatePhotoAlbumRequest(
+	req *PhotoAlbumUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdatePodcastEpisodeProgressRequest(
+	req *PodcastEpisodeProgressUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdatePodcastSettingsRequest(
+	req *PodcastSettingsUpdate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateRolePermissionsRequest(
 	req *UpdatePermissionsRequest,
 	r *http.Request,
@@ -474,6 +814,20 @@ func encodeUpdateServerSettingRequest(
 	return nil
 }
 
+func encodeUpdateTVEpisodeMetadataRequest(
+	req *TVEpisodeMetadataEdit,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateTVEpisodeProgressRequest(
 	req *UpdateEpisodeProgressRequest,
 	r *http.Request,
@@ -488,6 +842,34 @@ func encodeUpdateTVEpisodeProgressRequest(
 	return nil
 }
 
+func encodeUpdateTVSeasonMetadataRequest(
+	req *TVSeasonMetadataEdit,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeUpdateTVShowMetadataRequest(
+	req *TVSeriesMetadataEdit,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateUserPreferencesRequest(
 	req *UserPreferencesUpdate,
 	r *http.Request,
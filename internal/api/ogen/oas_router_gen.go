@@ -488,6 +488,210 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
+						case 'm': // Prefix: "m"
+
+							if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "atch-queue"
+
+								if l := len("atch-queue"); len(elem) >= l && elem[0:l] == "atch-queue" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleAdminListMatchQueueRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "itemId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleAdminGetMatchQueueItemRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/i"
+
+										if l := len("/i"); len(elem) >= l && elem[0:l] == "/i" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'd': // Prefix: "dentify"
+
+											if l := len("dentify"); len(elem) >= l && elem[0:l] == "dentify" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleAdminIdentifyMatchQueueItemRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										case 'g': // Prefix: "gnore"
+
+											if l := len("gnore"); len(elem) >= l && elem[0:l] == "gnore" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleAdminIgnoreMatchQueueItemRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								}
+
+							case 'o': // Prefix: "ovies/"
+
+								if l := len("ovies/"); len(elem) >= l && elem[0:l] == "ovies/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'f': // Prefix: "files/"
+
+									if l := len("files/"); len(elem) >= l && elem[0:l] == "files/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "fileId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/match-lock"
+
+										if l := len("/match-lock"); len(elem) >= l && elem[0:l] == "/match-lock" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleAdminUnlockMovieFileMatchRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								case 'n': // Prefix: "nfo-export"
+
+									if l := len("nfo-export"); len(elem) >= l && elem[0:l] == "nfo-export" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleAdminExportMovieNFORequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
 						case 'o': // Prefix: "oidc/providers"
 
 							if l := len("oidc/providers"); len(elem) >= l && elem[0:l] == "oidc/providers" {
@@ -645,6 +849,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
+						case 't': // Prefix: "tvshows/nfo-export"
+
+							if l := len("tvshows/nfo-export"); len(elem) >= l && elem[0:l] == "tvshows/nfo-export" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAdminExportTVShowNFORequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
 						case 'u': // Prefix: "users"
 
 							if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
@@ -673,16 +897,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								}
 
 								// Param: "userId"
-								// Leaf parameter, slashes are prohibited
+								// Match until "/"
 								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
+								if idx < 0 {
+									idx = len(elem)
 								}
-								args[0] = elem
-								elem = ""
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "DELETE":
 										s.handleAdminDeleteUserRequest([1]string{
@@ -694,6 +917,34 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/parental-controls"
+
+									if l := len("/parental-controls"); len(elem) >= l && elem[0:l] == "/parental-controls" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAdminGetParentalControlsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleAdminUpdateParentalControlsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,PUT")
+										}
+
+										return
+									}
+
+								}
 
 							}
 
@@ -757,9 +1008,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 'u': // Prefix: "uth/"
+					case 'u': // Prefix: "u"
 
-						if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
+						if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
 							elem = elem[l:]
 						} else {
 							break
@@ -769,103 +1020,252 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "change-password"
+						case 'd': // Prefix: "diobooks"
 
-							if l := len("change-password"); len(elem) >= l && elem[0:l] == "change-password" {
+							if l := len("diobooks"); len(elem) >= l && elem[0:l] == "diobooks" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleChangePasswordRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleListAudiobooksRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-						case 'f': // Prefix: "forgot-password"
-
-							if l := len("forgot-password"); len(elem) >= l && elem[0:l] == "forgot-password" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "POST":
-									s.handleForgotPasswordRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "POST")
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
-							}
-
-						case 'l': // Prefix: "log"
-
-							if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "authors"
+									origElem := elem
+									if l := len("authors"); len(elem) >= l && elem[0:l] == "authors" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'i': // Prefix: "in"
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListAudiobookAuthorsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-								if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
-									elem = elem[l:]
-								} else {
-									break
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/books"
+
+											if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetAudiobookAuthorBooksRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+									elem = origElem
+								case 'c': // Prefix: "continue-listening"
+									origElem := elem
+									if l := len("continue-listening"); len(elem) >= l && elem[0:l] == "continue-listening" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetContinueListeningRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 's': // Prefix: "series"
+									origElem := elem
+									if l := len("series"); len(elem) >= l && elem[0:l] == "series" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListAudiobookSeriesRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/books"
+
+											if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetAudiobookSeriesBooksRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
 								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
-									case "POST":
-										s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetAudiobookRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "POST")
+										s.notAllowed(w, r, "GET")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/progress"
 
-							case 'o': // Prefix: "out"
+									if l := len("/progress"); len(elem) >= l && elem[0:l] == "/progress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteAudiobookProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetAudiobookProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleUpdateAudiobookProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,POST")
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
+										return
 									}
 
-									return
 								}
 
 							}
 
-						case 'r': // Prefix: "re"
+						case 't': // Prefix: "th/"
 
-							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+							if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -875,9 +1275,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'f': // Prefix: "fresh"
+							case 'c': // Prefix: "change-password"
 
-								if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+								if l := len("change-password"); len(elem) >= l && elem[0:l] == "change-password" {
 									elem = elem[l:]
 								} else {
 									break
@@ -887,7 +1287,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleRefreshTokenRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleChangePasswordRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "POST")
 									}
@@ -895,9 +1295,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'g': // Prefix: "gister"
+							case 'f': // Prefix: "forgot-password"
 
-								if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+								if l := len("forgot-password"); len(elem) >= l && elem[0:l] == "forgot-password" {
 									elem = elem[l:]
 								} else {
 									break
@@ -907,7 +1307,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleRegisterRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleForgotPasswordRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "POST")
 									}
@@ -915,9 +1315,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 's': // Prefix: "se"
+							case 'l': // Prefix: "log"
 
-								if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+								if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
 									elem = elem[l:]
 								} else {
 									break
@@ -927,9 +1327,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'n': // Prefix: "nd-verification"
+								case 'i': // Prefix: "in"
 
-									if l := len("nd-verification"); len(elem) >= l && elem[0:l] == "nd-verification" {
+									if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
 										elem = elem[l:]
 									} else {
 										break
@@ -939,7 +1339,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleResendVerificationRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "POST")
 										}
@@ -947,9 +1347,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 't': // Prefix: "t-password"
+								case 'o': // Prefix: "out"
 
-									if l := len("t-password"); len(elem) >= l && elem[0:l] == "t-password" {
+									if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
 										elem = elem[l:]
 									} else {
 										break
@@ -959,7 +1359,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleResetPasswordRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "POST")
 										}
@@ -969,188 +1369,143 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							}
-
-						case 'v': // Prefix: "verify-email"
+							case 'r': // Prefix: "re"
 
-							if l := len("verify-email"); len(elem) >= l && elem[0:l] == "verify-email" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "POST":
-									s.handleVerifyEmailRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "POST")
+								if len(elem) == 0 {
+									break
 								}
+								switch elem[0] {
+								case 'f': // Prefix: "fresh"
 
-								return
-							}
+									if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRefreshTokenRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-					}
+										return
+									}
 
-				case 'c': // Prefix: "collections/"
+								case 'g': // Prefix: "gister"
 
-					if l := len("collections/"); len(elem) >= l && elem[0:l] == "collections/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					// Param: "id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRegisterRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetCollectionRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+										return
+									}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/movies"
-
-						if l := len("/movies"); len(elem) >= l && elem[0:l] == "/movies" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetCollectionMoviesRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
+								case 's': // Prefix: "se"
 
-					}
+									if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				case 'g': // Prefix: "genres"
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "nd-verification"
 
-					if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if l := len("nd-verification"); len(elem) >= l && elem[0:l] == "nd-verification" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleListGenresRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleResendVerificationRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-						return
-					}
+											return
+										}
 
-				case 'i': // Prefix: "images/"
+									case 't': // Prefix: "t-password"
 
-					if l := len("images/"); len(elem) >= l && elem[0:l] == "images/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if l := len("t-password"); len(elem) >= l && elem[0:l] == "t-password" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					// Param: "type"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleResetPasswordRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+											return
+										}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									}
 
-						// Param: "size"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[1] = elem[:idx]
-						elem = elem[idx:]
+								}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+							case 'v': // Prefix: "verify-email"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if l := len("verify-email"); len(elem) >= l && elem[0:l] == "verify-email" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							// Param: "path"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[2] = elem
-							elem = ""
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleVerifyEmailRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetProxiedImageRequest([3]string{
-										args[0],
-										args[1],
-										args[2],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+									return
 								}
 
-								return
 							}
 
 						}
 
 					}
 
-				case 'l': // Prefix: "libraries"
+				case 'b': // Prefix: "books"
 
-					if l := len("libraries"); len(elem) >= l && elem[0:l] == "libraries" {
+					if l := len("books"); len(elem) >= l && elem[0:l] == "books" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1159,11 +1514,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleListLibrariesRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleCreateLibraryRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleListBooksRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET,POST")
+							s.notAllowed(w, r, "GET")
 						}
 
 						return
@@ -1177,100 +1530,67 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
-						// Param: "libraryId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							switch r.Method {
-							case "DELETE":
-								s.handleDeleteLibraryRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "GET":
-								s.handleGetLibraryRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "PUT":
-								s.handleUpdateLibraryRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "DELETE,GET,PUT")
-							}
-
-							return
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
-
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						case 'a': // Prefix: "authors"
+							origElem := elem
+							if l := len("authors"); len(elem) >= l && elem[0:l] == "authors" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								break
+								switch r.Method {
+								case "GET":
+									s.handleListBookAuthorsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
 							}
 							switch elem[0] {
-							case 'p': // Prefix: "permissions"
+							case '/': // Prefix: "/"
 
-								if l := len("permissions"); len(elem) >= l && elem[0:l] == "permissions" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								if len(elem) == 0 {
-									switch r.Method {
-									case "GET":
-										s.handleListLibraryPermissionsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									case "POST":
-										s.handleGrantLibraryPermissionRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET,POST")
-									}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
-									return
+								if len(elem) == 0 {
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/"
+								case '/': // Prefix: "/books"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "userId"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
-									}
-									args[1] = elem
-									elem = ""
-
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "DELETE":
-											s.handleRevokeLibraryPermissionRequest([2]string{
+										case "GET":
+											s.handleGetBookAuthorBooksRequest([1]string{
 												args[0],
-												args[1],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
@@ -1278,30 +1598,73 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							case 's': // Prefix: "scan"
-
-								if l := len("scan"); len(elem) >= l && elem[0:l] == "scan" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+							}
 
-								if len(elem) == 0 {
-									switch r.Method {
-									case "POST":
-										s.handleTriggerLibraryScanRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
+							elem = origElem
+						case 'c': // Prefix: "continue-reading"
+							origElem := elem
+							if l := len("continue-reading"); len(elem) >= l && elem[0:l] == "continue-reading" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-									return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetContinueReadingRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
 								}
-								switch elem[0] {
-								case 's': // Prefix: "s"
 
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								return
+							}
+
+							elem = origElem
+						case 's': // Prefix: "series"
+							origElem := elem
+							if l := len("series"); len(elem) >= l && elem[0:l] == "series" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListBookSeriesRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/books"
+
+									if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1311,7 +1674,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleListLibraryScansRequest([1]string{
+											s.handleGetBookSeriesBooksRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
@@ -1325,98 +1688,89 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
+							elem = origElem
 						}
-
-					}
-
-				case 'm': // Prefix: "m"
-
-					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'e': // Prefix: "etadata/"
-
-						if l := len("etadata/"); len(elem) >= l && elem[0:l] == "etadata/" {
-							elem = elem[l:]
-						} else {
-							break
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
 						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "GET":
+								s.handleGetBookRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "collection/"
+						case '/': // Prefix: "/"
 
-							if l := len("collection/"); len(elem) >= l && elem[0:l] == "collection/" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "id"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
+							if len(elem) == 0 {
 								break
 							}
-							args[0] = elem
-							elem = ""
+							switch elem[0] {
+							case 'c': // Prefix: "cover"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetCollectionMetadataRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								if l := len("cover"); len(elem) >= l && elem[0:l] == "cover" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetBookCoverRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-						case 'm': // Prefix: "movie/"
+									return
+								}
 
-							if l := len("movie/"); len(elem) >= l && elem[0:l] == "movie/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+							case 'f': // Prefix: "file"
 
-							// Param: "id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
+								if l := len("file"); len(elem) >= l && elem[0:l] == "file" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleGetMovieMetadataRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleDownloadBookFileRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
 								}
 
-								return
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'p': // Prefix: "p"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1426,42 +1780,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "credits"
+								case 'a': // Prefix: "ages/"
 
-									if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
+									if l := len("ages/"); len(elem) >= l && elem[0:l] == "ages/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetMovieMetadataCreditsRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								case 'e': // Prefix: "external-ids"
-
-									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
-										elem = elem[l:]
-									} else {
+									// Param: "page"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
 										break
 									}
+									args[1] = elem
+									elem = ""
 
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetMovieExternalIDsRequest([1]string{
+											s.handleGetBookPageRequest([2]string{
 												args[0],
+												args[1],
 											}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
@@ -1470,9 +1812,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'i': // Prefix: "images"
+								case 'r': // Prefix: "rogress"
 
-									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+									if l := len("rogress"); len(elem) >= l && elem[0:l] == "rogress" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1481,56 +1823,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleGetMovieMetadataImagesRequest([1]string{
+										case "DELETE":
+											s.handleDeleteBookProgressRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								case 'r': // Prefix: "recommendations"
-
-									if l := len("recommendations"); len(elem) >= l && elem[0:l] == "recommendations" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
 										case "GET":
-											s.handleGetMovieRecommendationsMetadataRequest([1]string{
+											s.handleGetBookProgressRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								case 's': // Prefix: "similar"
-
-									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetSimilarMoviesMetadataRequest([1]string{
+										case "POST":
+											s.handleUpdateBookProgressRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "DELETE,GET,POST")
 										}
 
 										return
@@ -1540,133 +1846,156 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						case 'p': // Prefix: "p"
-
-							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+						}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'e': // Prefix: "erson/"
+					}
 
-								if l := len("erson/"); len(elem) >= l && elem[0:l] == "erson/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+				case 'c': // Prefix: "collections/"
 
-								// Param: "id"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[0] = elem[:idx]
-								elem = elem[idx:]
+					if l := len("collections/"); len(elem) >= l && elem[0:l] == "collections/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								if len(elem) == 0 {
-									switch r.Method {
-									case "GET":
-										s.handleGetPersonMetadataRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
-									return
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetCollectionRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/movies"
 
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 'c': // Prefix: "credits"
+						if l := len("/movies"); len(elem) >= l && elem[0:l] == "/movies" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-										if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetCollectionMoviesRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetPersonMetadataCreditsRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
+							return
+						}
 
-											return
-										}
+					}
 
-									case 'i': // Prefix: "images"
+				case 'g': // Prefix: "genres"
 
-										if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+					if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetPersonMetadataImagesRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListGenresRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-											return
-										}
+						return
+					}
 
-									}
+				case 'h': // Prefix: "home"
 
-								}
+					if l := len("home"); len(elem) >= l && elem[0:l] == "home" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-							case 'r': // Prefix: "roviders"
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetHomeFeedRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-								if l := len("roviders"); len(elem) >= l && elem[0:l] == "roviders" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/rows/"
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleListMetadataProvidersRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
+						if l := len("/rows/"); len(elem) >= l && elem[0:l] == "/rows/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-									return
-								}
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
 
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetHomeFeedRowRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
 							}
 
-						case 's': // Prefix: "search/"
+							return
+						}
 
-							if l := len("search/"); len(elem) >= l && elem[0:l] == "search/" {
+					case 'v': // Prefix: "videos"
+
+						if l := len("videos"); len(elem) >= l && elem[0:l] == "videos" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListHomeVideosRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1676,9 +2005,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'm': // Prefix: "movie"
-
-								if l := len("movie"); len(elem) >= l && elem[0:l] == "movie" {
+							case 'c': // Prefix: "continue-watching"
+								origElem := elem
+								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1688,7 +2017,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleSearchMoviesMetadataRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleListHomeVideoContinueWatchingRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
@@ -1696,56 +2025,61 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'p': // Prefix: "person"
-
-								if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+								elem = origElem
+							case 'f': // Prefix: "folders"
+								origElem := elem
+								if l := len("folders"); len(elem) >= l && elem[0:l] == "folders" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleSearchPersonMetadataRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleListHomeVideoFoldersRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-							case 't': // Prefix: "tv"
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if l := len("tv"); len(elem) >= l && elem[0:l] == "tv" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleSearchTVShowsMetadataRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetHomeVideoFolderRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
 									}
 
-									return
 								}
 
+								elem = origElem
 							}
-
-						case 't': // Prefix: "tv/"
-
-							if l := len("tv/"); len(elem) >= l && elem[0:l] == "tv/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
 							// Param: "id"
 							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
@@ -1758,7 +2092,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								switch r.Method {
 								case "GET":
-									s.handleGetTVShowMetadataRequest([1]string{
+									s.handleGetHomeVideoRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
@@ -1780,67 +2114,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "c"
-
-									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 'o': // Prefix: "ontent-ratings"
-
-										if l := len("ontent-ratings"); len(elem) >= l && elem[0:l] == "ontent-ratings" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetTVShowContentRatingsRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
-
-											return
-										}
-
-									case 'r': // Prefix: "redits"
-
-										if l := len("redits"); len(elem) >= l && elem[0:l] == "redits" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetTVShowMetadataCreditsRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
-
-											return
-										}
-
-									}
-
-								case 'e': // Prefix: "external-ids"
+								case 'p': // Prefix: "progress"
 
-									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
+									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1849,62 +2125,39 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleGetTVShowExternalIDsRequest([1]string{
+										case "DELETE":
+											s.handleDeleteHomeVideoProgressRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								case 'i': // Prefix: "images"
-
-									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
 										case "GET":
-											s.handleGetTVShowMetadataImagesRequest([1]string{
+											s.handleGetHomeVideoProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleUpdateHomeVideoProgressRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "DELETE,GET,POST")
 										}
 
 										return
 									}
 
-								case 's': // Prefix: "season/"
+								case 't': // Prefix: "thumbnail"
 
-									if l := len("season/"); len(elem) >= l && elem[0:l] == "season/" {
+									if l := len("thumbnail"); len(elem) >= l && elem[0:l] == "thumbnail" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "seasonNumber"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[1] = elem[:idx]
-									elem = elem[idx:]
-
 									if len(elem) == 0 {
+										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetSeasonMetadataRequest([2]string{
+											s.handleGetHomeVideoThumbnailRequest([1]string{
 												args[0],
-												args[1],
 											}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
@@ -1912,179 +2165,121 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										return
 									}
-									switch elem[0] {
-									case '/': // Prefix: "/"
-
-										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											break
-										}
-										switch elem[0] {
-										case 'e': // Prefix: "episode/"
-
-											if l := len("episode/"); len(elem) >= l && elem[0:l] == "episode/" {
-												elem = elem[l:]
-											} else {
-												break
-											}
-
-											// Param: "episodeNumber"
-											// Match until "/"
-											idx := strings.IndexByte(elem, '/')
-											if idx < 0 {
-												idx = len(elem)
-											}
-											args[2] = elem[:idx]
-											elem = elem[idx:]
-
-											if len(elem) == 0 {
-												switch r.Method {
-												case "GET":
-													s.handleGetEpisodeMetadataRequest([3]string{
-														args[0],
-														args[1],
-														args[2],
-													}, elemIsEscaped, w, r)
-												default:
-													s.notAllowed(w, r, "GET")
-												}
-
-												return
-											}
-											switch elem[0] {
-											case '/': // Prefix: "/images"
-
-												if l := len("/images"); len(elem) >= l && elem[0:l] == "/images" {
-													elem = elem[l:]
-												} else {
-													break
-												}
-
-												if len(elem) == 0 {
-													// Leaf node.
-													switch r.Method {
-													case "GET":
-														s.handleGetEpisodeMetadataImagesRequest([3]string{
-															args[0],
-															args[1],
-															args[2],
-														}, elemIsEscaped, w, r)
-													default:
-														s.notAllowed(w, r, "GET")
-													}
-
-													return
-												}
-
-											}
-
-										case 'i': // Prefix: "images"
 
-											if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-												elem = elem[l:]
-											} else {
-												break
-											}
-
-											if len(elem) == 0 {
-												// Leaf node.
-												switch r.Method {
-												case "GET":
-													s.handleGetSeasonMetadataImagesRequest([2]string{
-														args[0],
-														args[1],
-													}, elemIsEscaped, w, r)
-												default:
-													s.notAllowed(w, r, "GET")
-												}
+								}
 
-												return
-											}
+							}
 
-										}
+						}
 
-									}
+					}
 
-								}
+				case 'i': // Prefix: "images/"
 
-							}
+					if l := len("images/"); len(elem) >= l && elem[0:l] == "images/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-						}
+					// Param: "type"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
-					case 'f': // Prefix: "fa/"
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-						if l := len("fa/"); len(elem) >= l && elem[0:l] == "fa/" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "size"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[1] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
 							break
 						}
 						switch elem[0] {
-						case 'b': // Prefix: "backup-codes/"
+						case '/': // Prefix: "/"
 
-							if l := len("backup-codes/"); len(elem) >= l && elem[0:l] == "backup-codes/" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
+							// Param: "path"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
 								break
 							}
-							switch elem[0] {
-							case 'g': // Prefix: "generate"
+							args[2] = elem
+							elem = ""
 
-								if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
-									elem = elem[l:]
-								} else {
-									break
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetProxiedImageRequest([3]string{
+										args[0],
+										args[1],
+										args[2],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
 								}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleGenerateBackupCodesRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
+								return
+							}
 
-									return
-								}
+						}
 
-							case 'r': // Prefix: "regenerate"
+					}
 
-								if l := len("regenerate"); len(elem) >= l && elem[0:l] == "regenerate" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+				case 'l': // Prefix: "l"
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleRegenerateBackupCodesRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
+					if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-									return
-								}
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "egacy/"
 
-							}
+						if l := len("egacy/"); len(elem) >= l && elem[0:l] == "egacy/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-						case 'd': // Prefix: "disable"
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "access"
 
-							if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+							if l := len("access"); len(elem) >= l && elem[0:l] == "access" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2093,18 +2288,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleDisableMFARequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetLegacyAccessRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
 
-						case 'e': // Prefix: "enable"
+						case 'l': // Prefix: "lock"
 
-							if l := len("enable"); len(elem) >= l && elem[0:l] == "enable" {
+							if l := len("lock"); len(elem) >= l && elem[0:l] == "lock" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2114,7 +2309,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "POST":
-									s.handleEnableMFARequest([0]string{}, elemIsEscaped, w, r)
+									s.handleLockLegacyRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "POST")
 								}
@@ -2122,104 +2317,95 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 's': // Prefix: "status"
+						case 'p': // Prefix: "p"
 
-							if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetMFAStatusRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-						case 't': // Prefix: "totp"
-
-							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
-								elem = elem[l:]
-							} else {
 								break
 							}
-
-							if len(elem) == 0 {
-								switch r.Method {
-								case "DELETE":
-									s.handleDisableTOTPRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "DELETE")
-								}
-
-								return
-							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'e': // Prefix: "erformers"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("erformers"); len(elem) >= l && elem[0:l] == "erformers" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									break
+									switch r.Method {
+									case "GET":
+										s.handleListLegacyPerformersRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
 								}
 								switch elem[0] {
-								case 's': // Prefix: "setup"
+								case '/': // Prefix: "/"
 
-									if l := len("setup"); len(elem) >= l && elem[0:l] == "setup" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleSetupTOTPRequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetLegacyPerformerRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
 
-								case 'v': // Prefix: "verify"
+								}
 
-									if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							case 'i': // Prefix: "in"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "POST":
-											s.handleVerifyTOTPRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
-										}
+								if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-										return
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "PUT":
+										s.handleSetLegacyPINRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "PUT")
 									}
 
+									return
 								}
 
 							}
 
-						case 'w': // Prefix: "webauthn/"
+						case 's': // Prefix: "s"
 
-							if l := len("webauthn/"); len(elem) >= l && elem[0:l] == "webauthn/" {
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 								elem = elem[l:]
 							} else {
 								break
@@ -2229,9 +2415,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "credentials"
+							case 'c': // Prefix: "cenes"
 
-								if l := len("credentials"); len(elem) >= l && elem[0:l] == "credentials" {
+								if l := len("cenes"); len(elem) >= l && elem[0:l] == "cenes" {
 									elem = elem[l:]
 								} else {
 									break
@@ -2240,7 +2426,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									switch r.Method {
 									case "GET":
-										s.handleListWebAuthnCredentialsRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleListLegacyScenesRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
@@ -2256,82 +2442,99 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										break
 									}
 
-									// Param: "credentialId"
-									// Leaf parameter, slashes are prohibited
+									// Param: "id"
+									// Match until "/"
 									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
+									if idx < 0 {
+										idx = len(elem)
 									}
-									args[0] = elem
-									elem = ""
+									args[0] = elem[:idx]
+									elem = elem[idx:]
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "DELETE":
-											s.handleDeleteWebAuthnCredentialRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										case "PATCH":
-											s.handleRenameWebAuthnCredentialRequest([1]string{
+										case "GET":
+											s.handleGetLegacySceneRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE,PATCH")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/file"
+
+										if l := len("/file"); len(elem) >= l && elem[0:l] == "/file" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleDownloadLegacySceneFileRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
 
 								}
 
-							case 'l': // Prefix: "login/"
+							case 't': // Prefix: "tudios"
 
-								if l := len("login/"); len(elem) >= l && elem[0:l] == "login/" {
+								if l := len("tudios"); len(elem) >= l && elem[0:l] == "tudios" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									break
+									switch r.Method {
+									case "GET":
+										s.handleListLegacyStudiosRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
 								}
 								switch elem[0] {
-								case 'b': // Prefix: "begin"
+								case '/': // Prefix: "/"
 
-									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "POST":
-											s.handleBeginWebAuthnLoginRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
-										}
-
-										return
-									}
-
-								case 'f': // Prefix: "finish"
-
-									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
-										elem = elem[l:]
-									} else {
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
 										break
 									}
+									args[0] = elem
+									elem = ""
 
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleFinishWebAuthnLoginRequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetLegacyStudioRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
@@ -2339,396 +2542,698 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							case 'r': // Prefix: "register/"
-
-								if l := len("register/"); len(elem) >= l && elem[0:l] == "register/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'b': // Prefix: "begin"
-
-									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "POST":
-											s.handleBeginWebAuthnRegistrationRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
-										}
-
-										return
-									}
-
-								case 'f': // Prefix: "finish"
-
-									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "POST":
-											s.handleFinishWebAuthnRegistrationRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
-										}
+						case 'u': // Prefix: "unlock"
 
-										return
-									}
+							if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleUnlockLegacyRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
 								}
 
+								return
 							}
 
 						}
 
-					case 'o': // Prefix: "ovies"
+					case 'i': // Prefix: "i"
 
-						if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+						if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleListMoviesRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'b': // Prefix: "braries"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("braries"); len(elem) >= l && elem[0:l] == "braries" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								break
+								switch r.Method {
+								case "GET":
+									s.handleListLibrariesRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleCreateLibraryRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "continue-watching"
-								origElem := elem
-								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetContinueWatchingRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
-								}
-
-								elem = origElem
-							case 'r': // Prefix: "recently-added"
-								origElem := elem
-								if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
-									elem = elem[l:]
-								} else {
-									break
+								// Param: "libraryId"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
 								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
+									case "DELETE":
+										s.handleDeleteLibraryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									case "GET":
-										s.handleGetRecentlyAddedRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleGetLibraryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateLibraryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "GET")
+										s.notAllowed(w, r, "DELETE,GET,PUT")
 									}
 
 									return
 								}
-
-								elem = origElem
-							case 's': // Prefix: "s"
-								origElem := elem
-								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
 								switch elem[0] {
-								case 'e': // Prefix: "earch"
+								case '/': // Prefix: "/"
 
-									if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleSearchMoviesRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								case 't': // Prefix: "tats"
-
-									if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
-										elem = elem[l:]
-									} else {
 										break
 									}
+									switch elem[0] {
+									case 'p': // Prefix: "permissions"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetUserMovieStatsRequest([0]string{}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
+										if l := len("permissions"); len(elem) >= l && elem[0:l] == "permissions" {
+											elem = elem[l:]
+										} else {
+											break
 										}
 
-										return
-									}
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleListLibraryPermissionsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleGrantLibraryPermissionRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,POST")
+											}
 
-								}
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
 
-								elem = origElem
-							case 't': // Prefix: "top-rated"
-								origElem := elem
-								if l := len("top-rated"); len(elem) >= l && elem[0:l] == "top-rated" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetTopRatedRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
+											// Param: "userId"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
 
-									return
-								}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleRevokeLibraryPermissionRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE")
+												}
 
-								elem = origElem
-							case 'w': // Prefix: "watch-history"
-								origElem := elem
-								if l := len("watch-history"); len(elem) >= l && elem[0:l] == "watch-history" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												return
+											}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetWatchHistoryRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
+										}
 
-									return
-								}
+									case 's': // Prefix: "scan"
 
-								elem = origElem
-							}
-							// Param: "id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
+										if l := len("scan"); len(elem) >= l && elem[0:l] == "scan" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "POST":
+												s.handleTriggerLibraryScanRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleListLibraryScansRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "scanId"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[1] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/cancel"
+
+													if l := len("/cancel"); len(elem) >= l && elem[0:l] == "/cancel" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "POST":
+															s.handleCancelLibraryScanRequest([2]string{
+																args[0],
+																args[1],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "POST")
+														}
+
+														return
+													}
+
+												}
+
+											}
+
+										}
+
+									}
 
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleGetMovieRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
 								}
 
-								return
+							}
+
+						case 'v': // Prefix: "vetv/"
+
+							if l := len("vetv/"); len(elem) >= l && elem[0:l] == "vetv/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'c': // Prefix: "channels"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("channels"); len(elem) >= l && elem[0:l] == "channels" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									break
+									switch r.Method {
+									case "GET":
+										s.handleListLiveTVChannelsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "c"
+								case '/': // Prefix: "/"
 
-									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									if len(elem) == 0 {
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
 										break
 									}
-									switch elem[0] {
-									case 'a': // Prefix: "ast"
+									args[0] = elem
+									elem = ""
 
-										if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
-											elem = elem[l:]
-										} else {
-											break
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetLiveTVChannelRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PATCH":
+											s.handleUpdateLiveTVChannelRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,PATCH")
 										}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetMovieCastRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
+										return
+									}
 
-											return
-										}
+								}
 
-									case 'o': // Prefix: "ollection"
+							case 'g': // Prefix: "guide"
 
-										if l := len("ollection"); len(elem) >= l && elem[0:l] == "ollection" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+								if l := len("guide"); len(elem) >= l && elem[0:l] == "guide" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetMovieCollectionRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetLiveTVGuideRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-											return
-										}
+									return
+								}
 
-									case 'r': // Prefix: "rew"
+							case 'p': // Prefix: "programs/"
 
-										if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+								if l := len("programs/"); len(elem) >= l && elem[0:l] == "programs/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetMovieCrewRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
 
-											return
-										}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetLiveTVProgramRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'r': // Prefix: "recordings"
+
+								if l := len("recordings"); len(elem) >= l && elem[0:l] == "recordings" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListLiveTVRecordingsRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateLiveTVRecordingRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
 									}
 
-								case 'f': // Prefix: "files"
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-									if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
+										case "DELETE":
+											s.handleDeleteLiveTVRecordingRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										case "GET":
-											s.handleGetMovieFilesRequest([1]string{
+											s.handleGetLiveTVRecordingRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "DELETE,GET")
 										}
 
 										return
 									}
 
-								case 'g': // Prefix: "genres"
+								}
 
-									if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
+							case 's': // Prefix: "s"
+
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "eries-rules"
+
+									if l := len("eries-rules"); len(elem) >= l && elem[0:l] == "eries-rules" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetMovieGenresRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+											s.handleListLiveTVSeriesRulesRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateLiveTVSeriesRuleRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "GET,POST")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-								case 'p': // Prefix: "progress"
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteLiveTVSeriesRuleRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								case 'o': // Prefix: "ources"
+
+									if l := len("ources"); len(elem) >= l && elem[0:l] == "ources" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListLiveTVSourcesRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateLiveTVSourceRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteLiveTVSourceRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/refresh"
+
+											if l := len("/refresh"); len(elem) >= l && elem[0:l] == "/refresh" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleRefreshLiveTVSourceRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'm': // Prefix: "m"
+
+					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "etadata/"
+
+						if l := len("etadata/"); len(elem) >= l && elem[0:l] == "etadata/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "collection/"
+
+							if l := len("collection/"); len(elem) >= l && elem[0:l] == "collection/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetCollectionMetadataRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'm': // Prefix: "movie/"
+
+							if l := len("movie/"); len(elem) >= l && elem[0:l] == "movie/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetMovieMetadataRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "credits"
+
+									if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
 										elem = elem[l:]
 									} else {
 										break
@@ -2737,28 +3242,42 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "DELETE":
-											s.handleDeleteWatchProgressRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
 										case "GET":
-											s.handleGetWatchProgressRequest([1]string{
+											s.handleGetMovieMetadataCreditsRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
-										case "POST":
-											s.handleUpdateWatchProgressRequest([1]string{
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'e': // Prefix: "external-ids"
+
+									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetMovieExternalIDsRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE,GET,POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
 
-								case 'r': // Prefix: "refresh"
+								case 'i': // Prefix: "images"
 
-									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
 										elem = elem[l:]
 									} else {
 										break
@@ -2767,20 +3286,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleRefreshMovieMetadataRequest([1]string{
+										case "GET":
+											s.handleGetMovieMetadataImagesRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
 
-								case 's': // Prefix: "similar"
+								case 'r': // Prefix: "recommendations"
 
-									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
+									if l := len("recommendations"); len(elem) >= l && elem[0:l] == "recommendations" {
 										elem = elem[l:]
 									} else {
 										break
@@ -2790,7 +3309,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetSimilarMoviesRequest([1]string{
+											s.handleGetMovieRecommendationsMetadataRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
@@ -2800,9 +3319,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'w': // Prefix: "watched"
+								case 's': // Prefix: "similar"
 
-									if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
 										elem = elem[l:]
 									} else {
 										break
@@ -2811,12 +3330,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleMarkAsWatchedRequest([1]string{
+										case "GET":
+											s.handleGetSimilarMoviesMetadataRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
@@ -2826,290 +3345,145 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						}
-
-					}
-
-				case 'o': // Prefix: "oidc/"
+						case 'p': // Prefix: "p"
 
-					if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "auth/"
-
-						if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "provider"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleOidcAuthorizeRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
-						}
-
-					case 'c': // Prefix: "callback/"
-
-						if l := len("callback/"); len(elem) >= l && elem[0:l] == "callback/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "provider"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleOidcCallbackRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
+							if len(elem) == 0 {
+								break
 							}
+							switch elem[0] {
+							case 'e': // Prefix: "erson/"
 
-							return
-						}
+								if l := len("erson/"); len(elem) >= l && elem[0:l] == "erson/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					case 'p': // Prefix: "providers"
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
-						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetPersonMetadataRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleListOIDCProvidersRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-							return
-						}
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'c': // Prefix: "credits"
 
-				case 'p': // Prefix: "playback/sessions"
+										if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if l := len("playback/sessions"); len(elem) >= l && elem[0:l] == "playback/sessions" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetPersonMetadataCreditsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "POST":
-							s.handleStartPlaybackSessionRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
-						}
+											return
+										}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+									case 'i': // Prefix: "images"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						// Param: "sessionId"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetPersonMetadataImagesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "DELETE":
-								s.handleStopPlaybackSessionRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "GET":
-								s.handleGetPlaybackSessionRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "DELETE,GET")
-							}
+											return
+										}
 
-							return
-						}
+									}
 
-					}
+								}
 
-				case 'r': // Prefix: "rbac/"
+							case 'r': // Prefix: "roviders"
 
-					if l := len("rbac/"); len(elem) >= l && elem[0:l] == "rbac/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								if l := len("roviders"); len(elem) >= l && elem[0:l] == "roviders" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'p': // Prefix: "p"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleListMetadataProvidersRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-						if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									return
+								}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'e': // Prefix: "ermissions"
+							}
 
-							if l := len("ermissions"); len(elem) >= l && elem[0:l] == "ermissions" {
+						case 's': // Prefix: "search/"
+
+							if l := len("search/"); len(elem) >= l && elem[0:l] == "search/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleListPermissionsRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-						case 'o': // Prefix: "olicies"
-
-							if l := len("olicies"); len(elem) >= l && elem[0:l] == "olicies" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "DELETE":
-									s.handleRemovePolicyRequest([0]string{}, elemIsEscaped, w, r)
-								case "GET":
-									s.handleListPoliciesRequest([0]string{}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleAddPolicyRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "DELETE,GET,POST")
-								}
-
-								return
-							}
-
-						}
-
-					case 'r': // Prefix: "roles"
-
-						if l := len("roles"); len(elem) >= l && elem[0:l] == "roles" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleListRolesRequest([0]string{}, elemIsEscaped, w, r)
-							case "POST":
-								s.handleCreateRoleRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET,POST")
-							}
-
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
-
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							// Param: "roleName"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
-
-							if len(elem) == 0 {
-								switch r.Method {
-								case "DELETE":
-									s.handleDeleteRoleRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "GET":
-									s.handleGetRoleRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "DELETE,GET")
-								}
-
-								return
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/permissions"
+							case 'm': // Prefix: "movie"
 
-								if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+								if l := len("movie"); len(elem) >= l && elem[0:l] == "movie" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3118,94 +3492,50 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "PUT":
-										s.handleUpdateRolePermissionsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleSearchMoviesMetadataRequest([0]string{}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "PUT")
+										s.notAllowed(w, r, "GET")
 									}
 
 									return
 								}
 
-							}
-
-						}
-
-					case 'u': // Prefix: "users/"
-
-						if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "userId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+							case 'p': // Prefix: "person"
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/roles"
+								if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-							if l := len("/roles"); len(elem) >= l && elem[0:l] == "/roles" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleSearchPersonMetadataRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleGetUserRolesRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleAssignRoleRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET,POST")
+									return
 								}
 
-								return
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 't': // Prefix: "tv"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("tv"); len(elem) >= l && elem[0:l] == "tv" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "role"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[1] = elem
-								elem = ""
-
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "DELETE":
-										s.handleRemoveRoleRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleSearchTVShowsMetadataRequest([0]string{}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "DELETE")
+										s.notAllowed(w, r, "GET")
 									}
 
 									return
@@ -3213,68 +3543,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							}
 
-						}
-
-					}
-
-				case 's': // Prefix: "se"
-
-					if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "arch/"
-
-						if l := len("arch/"); len(elem) >= l && elem[0:l] == "arch/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'm': // Prefix: "m"
+						case 't': // Prefix: "tv/"
 
-							if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							if l := len("tv/"); len(elem) >= l && elem[0:l] == "tv/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
-								break
+								switch r.Method {
+								case "GET":
+									s.handleGetTVShowMetadataRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
 							}
 							switch elem[0] {
-							case 'o': // Prefix: "ovies"
+							case '/': // Prefix: "/"
 
-								if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									switch r.Method {
-									case "GET":
-										s.handleSearchLibraryMoviesRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/"
+								case 'c': // Prefix: "c"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3284,9 +3597,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										break
 									}
 									switch elem[0] {
-									case 'a': // Prefix: "autocomplete"
+									case 'o': // Prefix: "ontent-ratings"
 
-										if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
+										if l := len("ontent-ratings"); len(elem) >= l && elem[0:l] == "ontent-ratings" {
 											elem = elem[l:]
 										} else {
 											break
@@ -3296,7 +3609,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											// Leaf node.
 											switch r.Method {
 											case "GET":
-												s.handleAutocompleteMoviesRequest([0]string{}, elemIsEscaped, w, r)
+												s.handleGetTVShowContentRatingsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
 											default:
 												s.notAllowed(w, r, "GET")
 											}
@@ -3304,9 +3619,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											return
 										}
 
-									case 'f': // Prefix: "facets"
+									case 'r': // Prefix: "redits"
 
-										if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+										if l := len("redits"); len(elem) >= l && elem[0:l] == "redits" {
 											elem = elem[l:]
 										} else {
 											break
@@ -3316,7 +3631,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											// Leaf node.
 											switch r.Method {
 											case "GET":
-												s.handleGetSearchFacetsRequest([0]string{}, elemIsEscaped, w, r)
+												s.handleGetTVShowMetadataCreditsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
 											default:
 												s.notAllowed(w, r, "GET")
 											}
@@ -3326,84 +3643,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 									}
 
-								}
-
-							case 'u': // Prefix: "ulti"
-
-								if l := len("ulti"); len(elem) >= l && elem[0:l] == "ulti" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleSearchMultiRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
-								}
-
-							}
-
-						case 'r': // Prefix: "reindex"
-
-							if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "POST":
-									s.handleReindexSearchRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "POST")
-								}
-
-								return
-							}
-
-						case 't': // Prefix: "tvshows"
-
-							if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleSearchLibraryTVShowsRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'a': // Prefix: "autocomplete"
+								case 'e': // Prefix: "external-ids"
 
-									if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
+									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3413,7 +3655,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleAutocompleteTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleGetTVShowExternalIDsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
 										}
@@ -3421,9 +3665,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'f': // Prefix: "facets"
+								case 'i': // Prefix: "images"
 
-									if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3433,7 +3677,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetTVShowSearchFacetsRequest([0]string{}, elemIsEscaped, w, r)
+											s.handleGetTVShowMetadataImagesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
 										}
@@ -3441,25 +3687,133 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'r': // Prefix: "reindex"
+								case 's': // Prefix: "season/"
 
-									if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
+									if l := len("season/"); len(elem) >= l && elem[0:l] == "season/" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
+									// Param: "seasonNumber"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleReindexTVShowSearchRequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetSeasonMetadataRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "episode/"
+
+											if l := len("episode/"); len(elem) >= l && elem[0:l] == "episode/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "episodeNumber"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[2] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleGetEpisodeMetadataRequest([3]string{
+														args[0],
+														args[1],
+														args[2],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/images"
+
+												if l := len("/images"); len(elem) >= l && elem[0:l] == "/images" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetEpisodeMetadataImagesRequest([3]string{
+															args[0],
+															args[1],
+															args[2],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											}
+
+										case 'i': // Prefix: "images"
+
+											if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetSeasonMetadataImagesRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+										}
+
+									}
 
 								}
 
@@ -3467,30 +3821,21 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						}
 
-					case 's': // Prefix: "ssions"
+					case 'f': // Prefix: "fa/"
 
-						if l := len("ssions"); len(elem) >= l && elem[0:l] == "ssions" {
+						if l := len("fa/"); len(elem) >= l && elem[0:l] == "fa/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch r.Method {
-							case "DELETE":
-								s.handleLogoutAllRequest([0]string{}, elemIsEscaped, w, r)
-							case "GET":
-								s.handleListSessionsRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "DELETE,GET")
-							}
-
-							return
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'b': // Prefix: "backup-codes/"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("backup-codes/"); len(elem) >= l && elem[0:l] == "backup-codes/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3500,9 +3845,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "current"
-								origElem := elem
-								if l := len("current"); len(elem) >= l && elem[0:l] == "current" {
+							case 'g': // Prefix: "generate"
+
+								if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3511,21 +3856,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch r.Method {
-									case "DELETE":
-										s.handleLogoutCurrentRequest([0]string{}, elemIsEscaped, w, r)
-									case "GET":
-										s.handleGetCurrentSessionRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleGenerateBackupCodesRequest([0]string{}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "DELETE,GET")
+										s.notAllowed(w, r, "POST")
 									}
 
 									return
 								}
 
-								elem = origElem
-							case 'r': // Prefix: "refresh"
-								origElem := elem
-								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+							case 'r': // Prefix: "regenerate"
+
+								if l := len("regenerate"); len(elem) >= l && elem[0:l] == "regenerate" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3535,7 +3877,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleRefreshSessionRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleRegenerateBackupCodesRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "POST")
 									}
@@ -3543,104 +3885,71 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-								elem = origElem
 							}
-							// Param: "sessionId"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
+
+						case 'd': // Prefix: "disable"
+
+							if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+								elem = elem[l:]
+							} else {
 								break
 							}
-							args[0] = elem
-							elem = ""
 
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "DELETE":
-									s.handleRevokeSessionRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleDisableMFARequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "DELETE")
+									s.notAllowed(w, r, "POST")
 								}
 
 								return
 							}
 
-						}
-
-					case 't': // Prefix: "ttings/"
-
-						if l := len("ttings/"); len(elem) >= l && elem[0:l] == "ttings/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 's': // Prefix: "server"
+						case 'e': // Prefix: "enable"
 
-							if l := len("server"); len(elem) >= l && elem[0:l] == "server" {
+							if l := len("enable"); len(elem) >= l && elem[0:l] == "enable" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch r.Method {
-								case "GET":
-									s.handleListServerSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleEnableMFARequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "POST")
 								}
 
 								return
 							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
 
-								// Param: "key"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
+						case 's': // Prefix: "status"
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetServerSettingRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleUpdateServerSettingRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET,PUT")
-									}
+							if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-									return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetMFAStatusRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
 								}
 
+								return
 							}
 
-						case 'u': // Prefix: "user"
+						case 't': // Prefix: "totp"
 
-							if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
+							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3648,10 +3957,10 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							if len(elem) == 0 {
 								switch r.Method {
-								case "GET":
-									s.handleListUserSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								case "DELETE":
+									s.handleDisableTOTPRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "DELETE")
 								}
 
 								return
@@ -3665,98 +3974,57 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 
-								// Param: "key"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
 									break
 								}
-								args[0] = elem
-								elem = ""
+								switch elem[0] {
+								case 's': // Prefix: "setup"
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "DELETE":
-										s.handleDeleteUserSettingRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									case "GET":
-										s.handleGetUserSettingRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleUpdateUserSettingRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "DELETE,GET,PUT")
+									if l := len("setup"); len(elem) >= l && elem[0:l] == "setup" {
+										elem = elem[l:]
+									} else {
+										break
 									}
 
-									return
-								}
-
-							}
-
-						}
-
-					}
-
-				case 't': // Prefix: "tvshows"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleSetupTOTPRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-					if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										return
+									}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleListTVShowsRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+								case 'v': // Prefix: "verify"
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+									if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleVerifyTOTPRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'c': // Prefix: "continue-watching"
-							origElem := elem
-							if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										return
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetTVContinueWatchingRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
 								}
 
-								return
 							}
 
-							elem = origElem
-						case 'e': // Prefix: "episodes/"
-							origElem := elem
-							if l := len("episodes/"); len(elem) >= l && elem[0:l] == "episodes/" {
+						case 'w': // Prefix: "webauthn/"
+
+							if l := len("webauthn/"); len(elem) >= l && elem[0:l] == "webauthn/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3766,95 +4034,65 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'b': // Prefix: "bulk-watched"
-								origElem := elem
-								if l := len("bulk-watched"); len(elem) >= l && elem[0:l] == "bulk-watched" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleMarkTVEpisodesBulkWatchedRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
-
-									return
-								}
+							case 'c': // Prefix: "credentials"
 
-								elem = origElem
-							case 'r': // Prefix: "recent"
-								origElem := elem
-								if l := len("recent"); len(elem) >= l && elem[0:l] == "recent" {
+								if l := len("credentials"); len(elem) >= l && elem[0:l] == "credentials" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetRecentEpisodesRequest([0]string{}, elemIsEscaped, w, r)
+										s.handleListWebAuthnCredentialsRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-								elem = origElem
-							case 'u': // Prefix: "upcoming"
-								origElem := elem
-								if l := len("upcoming"); len(elem) >= l && elem[0:l] == "upcoming" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetUpcomingEpisodesRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
 									}
 
-									return
-								}
+									// Param: "credentialId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
 
-								elem = origElem
-							}
-							// Param: "id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteWebAuthnCredentialRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PATCH":
+											s.handleRenameWebAuthnCredentialRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,PATCH")
+										}
+
+										return
+									}
 
-							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleGetTVEpisodeRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
 								}
 
-								return
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'l': // Prefix: "login/"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("login/"); len(elem) >= l && elem[0:l] == "login/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3864,31 +4102,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'f': // Prefix: "files"
-
-									if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetTVEpisodeFilesRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
-									}
-
-								case 'p': // Prefix: "progress"
+								case 'b': // Prefix: "begin"
 
-									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3897,28 +4113,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "DELETE":
-											s.handleDeleteTVEpisodeProgressRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										case "GET":
-											s.handleGetTVEpisodeProgressRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										case "PUT":
-											s.handleUpdateTVEpisodeProgressRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleBeginWebAuthnLoginRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE,GET,PUT")
+											s.notAllowed(w, r, "POST")
 										}
 
 										return
 									}
 
-								case 'w': // Prefix: "watched"
+								case 'f': // Prefix: "finish"
 
-									if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3928,9 +4134,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "POST":
-											s.handleMarkTVEpisodeWatchedRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+											s.handleFinishWebAuthnLoginRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "POST")
 										}
@@ -3940,45 +4144,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							}
-
-							elem = origElem
-						case 'r': // Prefix: "recently-added"
-							origElem := elem
-							if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetRecentlyAddedTVShowsRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
-							}
-
-							elem = origElem
-						case 's': // Prefix: "s"
-							origElem := elem
-							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'e': // Prefix: "ea"
+							case 'r': // Prefix: "register/"
 
-								if l := len("ea"); len(elem) >= l && elem[0:l] == "ea" {
+								if l := len("register/"); len(elem) >= l && elem[0:l] == "register/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3988,9 +4156,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'r': // Prefix: "rch"
+								case 'b': // Prefix: "begin"
 
-									if l := len("rch"); len(elem) >= l && elem[0:l] == "rch" {
+									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3999,110 +4167,53 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleSearchTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleBeginWebAuthnRegistrationRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "POST")
 										}
 
 										return
 									}
 
-								case 's': // Prefix: "sons/"
+								case 'f': // Prefix: "finish"
 
-									if l := len("sons/"); len(elem) >= l && elem[0:l] == "sons/" {
+									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "id"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[0] = elem[:idx]
-									elem = elem[idx:]
-
 									if len(elem) == 0 {
+										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleGetTVSeasonRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleFinishWebAuthnRegistrationRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "POST")
 										}
 
 										return
 									}
-									switch elem[0] {
-									case '/': // Prefix: "/episodes"
-
-										if l := len("/episodes"); len(elem) >= l && elem[0:l] == "/episodes" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "GET":
-												s.handleGetTVSeasonEpisodesRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "GET")
-											}
-
-											return
-										}
-
-									}
-
-								}
-
-							case 't': // Prefix: "tats"
-
-								if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetUserTVStatsRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
 
-									return
 								}
 
 							}
 
-							elem = origElem
 						}
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+
+					case 'o': // Prefix: "ovies"
+
+						if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
 
 						if len(elem) == 0 {
 							switch r.Method {
 							case "GET":
-								s.handleGetTVShowRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleListMoviesRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "GET")
 							}
@@ -4122,9 +4233,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "c"
+							case 'c': // Prefix: "continue-watching"
+								origElem := elem
+								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetContinueWatchingRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "recently-added"
+								origElem := elem
+								if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRecentlyAddedRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
 
-								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+								elem = origElem
+							case 's': // Prefix: "s"
+								origElem := elem
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 									elem = elem[l:]
 								} else {
 									break
@@ -4134,9 +4287,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'a': // Prefix: "ast"
+								case 'e': // Prefix: "earch"
 
-									if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
+									if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
 										elem = elem[l:]
 									} else {
 										break
@@ -4146,9 +4299,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetTVShowCastRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+											s.handleSearchMoviesRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
 										}
@@ -4156,9 +4307,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								case 'r': // Prefix: "rew"
+								case 't': // Prefix: "tats"
 
-									if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+									if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
 										elem = elem[l:]
 									} else {
 										break
@@ -4168,9 +4319,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetTVShowCrewRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+											s.handleGetUserMovieStatsRequest([0]string{}, elemIsEscaped, w, r)
 										default:
 											s.notAllowed(w, r, "GET")
 										}
@@ -4180,9 +4329,10 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							case 'e': // Prefix: "episodes"
-
-								if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
+								elem = origElem
+							case 't': // Prefix: "top-rated"
+								origElem := elem
+								if l := len("top-rated"); len(elem) >= l && elem[0:l] == "top-rated" {
 									elem = elem[l:]
 								} else {
 									break
@@ -4192,9 +4342,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetTVShowEpisodesRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleGetTopRatedRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
@@ -4202,9 +4350,10 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'g': // Prefix: "genres"
-
-								if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
+								elem = origElem
+							case 'w': // Prefix: "watch-history"
+								origElem := elem
+								if l := len("watch-history"); len(elem) >= l && elem[0:l] == "watch-history" {
 									elem = elem[l:]
 								} else {
 									break
@@ -4214,9 +4363,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetTVShowGenresRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleGetWatchHistoryRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
@@ -4224,9 +4371,33 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'n': // Prefix: "ne"
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetMovieRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-								if l := len("ne"); len(elem) >= l && elem[0:l] == "ne" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -4236,19 +4407,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 't': // Prefix: "tworks"
+								case 'a': // Prefix: "artwork"
 
-									if l := len("tworks"); len(elem) >= l && elem[0:l] == "tworks" {
+									if l := len("artwork"); len(elem) >= l && elem[0:l] == "artwork" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetTVShowNetworksRequest([1]string{
+											s.handleListMovieArtworkRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
@@ -4257,626 +4427,552 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-								case 'x': // Prefix: "xt-episode"
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									if l := len("xt-episode"); len(elem) >= l && elem[0:l] == "xt-episode" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+										// Param: "artworkId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleGetTVShowNextEpisodeRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
+										if len(elem) == 0 {
+											break
 										}
+										switch elem[0] {
+										case '/': // Prefix: "/select"
 
-										return
-									}
+											if l := len("/select"); len(elem) >= l && elem[0:l] == "/select" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-								}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleSelectMovieArtworkRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
 
-							case 'r': // Prefix: "refresh"
+												return
+											}
 
-								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleRefreshTVShowMetadataRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
 									}
 
-									return
-								}
-
-							case 's': // Prefix: "seasons"
+								case 'c': // Prefix: "c"
 
-								if l := len("seasons"); len(elem) >= l && elem[0:l] == "seasons" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetTVShowSeasonsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									if len(elem) == 0 {
+										break
 									}
+									switch elem[0] {
+									case 'a': // Prefix: "ast"
 
-									return
-								}
+										if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							case 'w': // Prefix: "watch-stats"
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetMovieCastRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-								if l := len("watch-stats"); len(elem) >= l && elem[0:l] == "watch-stats" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+											return
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetTVShowWatchStatsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
+									case 'o': // Prefix: "ollection"
 
-									return
-								}
+										if l := len("ollection"); len(elem) >= l && elem[0:l] == "ollection" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetMovieCollectionRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-						}
+											return
+										}
 
-					}
+									case 'r': // Prefix: "rew"
 
-				case 'u': // Prefix: "users/"
+										if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetMovieCrewRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'm': // Prefix: "me"
-						origElem := elem
-						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+											return
+										}
 
-						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
-							case "PUT":
-								s.handleUpdateCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET,PUT")
-							}
+									}
 
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+								case 'e': // Prefix: "e"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'a': // Prefix: "avatar"
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "ditions"
 
-								if l := len("avatar"); len(elem) >= l && elem[0:l] == "avatar" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										if l := len("ditions"); len(elem) >= l && elem[0:l] == "ditions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetMovieEditionsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 'x': // Prefix: "xtras"
+
+										if l := len("xtras"); len(elem) >= l && elem[0:l] == "xtras" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetMovieExtrasRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleUploadAvatarRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
 									}
 
-									return
-								}
+								case 'f': // Prefix: "files"
 
-							case 'o': // Prefix: "oidc"
+									if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if l := len("oidc"); len(elem) >= l && elem[0:l] == "oidc" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetMovieFilesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-								if len(elem) == 0 {
-									switch r.Method {
-									case "GET":
-										s.handleListUserOIDCLinksRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+										return
 									}
 
-									return
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
+								case 'g': // Prefix: "genres"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "provider"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetMovieGenresRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'm': // Prefix: "metadata"
+
+									if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+										elem = elem[l:]
+									} else {
+										break
 									}
-									args[0] = elem[:idx]
-									elem = elem[idx:]
 
 									if len(elem) == 0 {
 										switch r.Method {
-										case "DELETE":
-											s.handleUnlinkOIDCProviderRequest([1]string{
+										case "PATCH":
+											s.handleUpdateMovieMetadataRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE")
+											s.notAllowed(w, r, "PATCH")
 										}
 
 										return
 									}
 									switch elem[0] {
-									case '/': // Prefix: "/link"
+									case '/': // Prefix: "/"
 
-										if l := len("/link"); len(elem) >= l && elem[0:l] == "/link" {
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
-											// Leaf node.
-											switch r.Method {
-											case "POST":
-												s.handleInitOIDCLinkRequest([1]string{
-													args[0],
-												}, elemIsEscaped, w, r)
-											default:
-												s.notAllowed(w, r, "POST")
-											}
-
-											return
+											break
 										}
+										switch elem[0] {
+										case 'h': // Prefix: "history"
 
-									}
+											if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-								}
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleListMovieMetadataHistoryRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
 
-							case 'p': // Prefix: "preferences"
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
 
-								if l := len("preferences"); len(elem) >= l && elem[0:l] == "preferences" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetUserPreferencesRequest([0]string{}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleUpdateUserPreferencesRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET,PUT")
-									}
+												// Param: "version"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[1] = elem[:idx]
+												elem = elem[idx:]
 
-									return
-								}
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/rollback"
 
-							}
+													if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+														elem = elem[l:]
+													} else {
+														break
+													}
 
-						}
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "POST":
+															s.handleRollbackMovieMetadataRequest([2]string{
+																args[0],
+																args[1],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "POST")
+														}
 
-						elem = origElem
-					}
-					// Param: "userId"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
+														return
+													}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetUserByIdRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
+												}
 
-						return
-					}
+											}
 
-				case 'w': // Prefix: "webhooks/"
+										case 'l': // Prefix: "locks/"
 
-					if l := len("webhooks/"); len(elem) >= l && elem[0:l] == "webhooks/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+											if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'r': // Prefix: "radarr"
+											// Param: "field"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
 
-						if l := len("radarr"); len(elem) >= l && elem[0:l] == "radarr" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleUnlockMovieMetadataFieldRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE")
+												}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleHandleRadarrWebhookRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
+												return
+											}
 
-							return
-						}
+										}
 
-					case 's': // Prefix: "sonarr"
+									}
 
-						if l := len("sonarr"); len(elem) >= l && elem[0:l] == "sonarr" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								case 'p': // Prefix: "progress"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleHandleSonarrWebhookRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
-							}
+									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-							return
-						}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteWatchProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetWatchProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleUpdateWatchProgressRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,POST")
+										}
 
-					}
+										return
+									}
 
-				}
+								case 'r': // Prefix: "refresh"
 
-			case 'h': // Prefix: "healthz"
+									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				if l := len("healthz"); len(elem) >= l && elem[0:l] == "healthz" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRefreshMovieMetadataRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetLivenessRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
+										return
+									}
 
-					return
-				}
+								case 's': // Prefix: "similar"
 
-			case 'r': // Prefix: "readyz"
+									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				if l := len("readyz"); len(elem) >= l && elem[0:l] == "readyz" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetSimilarMoviesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetReadinessRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
+										return
+									}
 
-					return
-				}
+								case 't': // Prefix: "tags"
 
-			case 's': // Prefix: "startupz"
+									if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				if l := len("startupz"); len(elem) >= l && elem[0:l] == "startupz" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetMovieTagsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetStartupRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
+										return
+									}
 
-					return
-				}
+								case 'w': // Prefix: "watched"
 
-			}
+									if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-		}
-	}
-	s.notFound(w, r)
-}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleMarkAsWatchedRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-// Route is route object.
-type Route struct {
-	name           string
-	summary        string
-	operationID    string
-	operationGroup string
-	pathPattern    string
-	count          int
-	args           [3]string
-}
+										return
+									}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+								}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+							}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+						}
 
-// OperationGroup returns the x-ogen-operation-group value.
-func (r Route) OperationGroup() string {
-	return r.operationGroup
-}
+					case 'u': // Prefix: "usic"
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+						if l := len("usic"); len(elem) >= l && elem[0:l] == "usic" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "a"
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+								if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lbums"
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
-
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "api/v1/"
-
-				if l := len("api/v1/"); len(elem) >= l && elem[0:l] == "api/v1/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "a"
-
-					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'd': // Prefix: "dmin/"
-
-						if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'a': // Prefix: "activity"
-
-							if l := len("activity"); len(elem) >= l && elem[0:l] == "activity" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = SearchActivityLogsOperation
-									r.summary = "Search activity logs (admin)"
-									r.operationID = "searchActivityLogs"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/admin/activity"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
-
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'a': // Prefix: "actions"
-
-									if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+									if l := len("lbums"); len(elem) >= l && elem[0:l] == "lbums" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
 									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
+										switch r.Method {
 										case "GET":
-											r.name = GetRecentActionsOperation
-											r.summary = "Get recent action types (admin)"
-											r.operationID = "getRecentActions"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/activity/actions"
-											r.args = args
-											r.count = 0
-											return r, true
+											s.handleListMusicAlbumsRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											return
+											s.notAllowed(w, r, "GET")
 										}
-									}
-
-								case 'r': // Prefix: "resources/"
-
-									if l := len("resources/"); len(elem) >= l && elem[0:l] == "resources/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									// Param: "resourceType"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[0] = elem[:idx]
-									elem = elem[idx:]
 
-									if len(elem) == 0 {
-										break
+										return
 									}
 									switch elem[0] {
 									case '/': // Prefix: "/"
@@ -4887,240 +4983,222 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 											break
 										}
 
-										// Param: "resourceId"
-										// Leaf parameter, slashes are prohibited
+										// Param: "id"
+										// Match until "/"
 										idx := strings.IndexByte(elem, '/')
-										if idx >= 0 {
-											break
+										if idx < 0 {
+											idx = len(elem)
 										}
-										args[1] = elem
-										elem = ""
+										args[0] = elem[:idx]
+										elem = elem[idx:]
 
 										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
+											switch r.Method {
 											case "GET":
-												r.name = GetResourceActivityLogsOperation
-												r.summary = "Get resource activity logs (admin)"
-												r.operationID = "getResourceActivityLogs"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/admin/activity/resources/{resourceType}/{resourceId}"
-												r.args = args
-												r.count = 2
-												return r, true
+												s.handleGetMusicAlbumRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
 											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/editions"
+
+											if l := len("/editions"); len(elem) >= l && elem[0:l] == "/editions" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetMusicAlbumEditionsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
 												return
 											}
+
 										}
 
 									}
 
-								case 's': // Prefix: "stats"
+								case 'r': // Prefix: "rtists"
 
-									if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+									if l := len("rtists"); len(elem) >= l && elem[0:l] == "rtists" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
+										switch r.Method {
 										case "GET":
-											r.name = GetActivityStatsOperation
-											r.summary = "Get activity statistics (admin)"
-											r.operationID = "getActivityStats"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/activity/stats"
-											r.args = args
-											r.count = 0
-											return r, true
+											s.handleListMusicArtistsRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											return
+											s.notAllowed(w, r, "GET")
 										}
+
+										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-								case 'u': // Prefix: "users/"
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
 
-									// Param: "userId"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
-									}
-									args[0] = elem
-									elem = ""
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetMusicArtistRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetUserActivityLogsOperation
-											r.summary = "Get user activity logs (admin)"
-											r.operationID = "getUserActivityLogs"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/activity/users/{userId}"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
 											return
 										}
-									}
+										switch elem[0] {
+										case '/': // Prefix: "/"
 
-								}
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-							}
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'a': // Prefix: "albums"
 
-						case 'i': // Prefix: "integrations/"
+												if l := len("albums"); len(elem) >= l && elem[0:l] == "albums" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-							if l := len("integrations/"); len(elem) >= l && elem[0:l] == "integrations/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetMusicArtistAlbumsRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'r': // Prefix: "radarr/"
-
-								if l := len("radarr/"); len(elem) >= l && elem[0:l] == "radarr/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+													return
+												}
 
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'q': // Prefix: "quality-profiles"
+											case 't': // Prefix: "tracks"
 
-									if l := len("quality-profiles"); len(elem) >= l && elem[0:l] == "quality-profiles" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+												if l := len("tracks"); len(elem) >= l && elem[0:l] == "tracks" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = AdminGetRadarrQualityProfilesOperation
-											r.summary = "Get Radarr quality profiles (admin)"
-											r.operationID = "adminGetRadarrQualityProfiles"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/integrations/radarr/quality-profiles"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetMusicArtistTracksRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
 
-								case 'r': // Prefix: "root-folders"
+													return
+												}
 
-									if l := len("root-folders"); len(elem) >= l && elem[0:l] == "root-folders" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+											}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = AdminGetRadarrRootFoldersOperation
-											r.summary = "Get Radarr root folders (admin)"
-											r.operationID = "adminGetRadarrRootFolders"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/integrations/radarr/root-folders"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
 										}
+
 									}
 
-								case 's': // Prefix: "s"
+								}
 
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							case 't': // Prefix: "tracks/"
 
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 't': // Prefix: "tatus"
+								if l := len("tracks/"); len(elem) >= l && elem[0:l] == "tracks/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-										if l := len("tatus"); len(elem) >= l && elem[0:l] == "tatus" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = AdminGetRadarrStatusOperation
-												r.summary = "Get Radarr integration status (admin)"
-												r.operationID = "adminGetRadarrStatus"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/admin/integrations/radarr/status"
-												r.args = args
-												r.count = 0
-												return r, true
-											default:
-												return
-											}
-										}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetMusicTrackRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-									case 'y': // Prefix: "ync"
+									return
+								}
 
-										if l := len("ync"); len(elem) >= l && elem[0:l] == "ync" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+							}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "POST":
-												r.name = AdminTriggerRadarrSyncOperation
-												r.summary = "Trigger Radarr library sync (admin)"
-												r.operationID = "adminTriggerRadarrSync"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/admin/integrations/radarr/sync"
-												r.args = args
-												r.count = 0
-												return r, true
-											default:
-												return
-											}
-										}
+						case 'v': // Prefix: "videos"
 
-									}
+							if l := len("videos"); len(elem) >= l && elem[0:l] == "videos" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListMusicVideosRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
 								}
 
-							case 's': // Prefix: "sonarr/"
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-								if l := len("sonarr/"); len(elem) >= l && elem[0:l] == "sonarr/" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -5130,223 +5208,380 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'q': // Prefix: "quality-profiles"
-
-									if l := len("quality-profiles"); len(elem) >= l && elem[0:l] == "quality-profiles" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = AdminGetSonarrQualityProfilesOperation
-											r.summary = "Get Sonarr quality profiles (admin)"
-											r.operationID = "adminGetSonarrQualityProfiles"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/integrations/sonarr/quality-profiles"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'r': // Prefix: "root-folders"
-
-									if l := len("root-folders"); len(elem) >= l && elem[0:l] == "root-folders" {
+								case 'a': // Prefix: "artists"
+									origElem := elem
+									if l := len("artists"); len(elem) >= l && elem[0:l] == "artists" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
+										switch r.Method {
 										case "GET":
-											r.name = AdminGetSonarrRootFoldersOperation
-											r.summary = "Get Sonarr root folders (admin)"
-											r.operationID = "adminGetSonarrRootFolders"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/admin/integrations/sonarr/root-folders"
-											r.args = args
-											r.count = 0
-											return r, true
+											s.handleListMusicVideoArtistsRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											return
+											s.notAllowed(w, r, "GET")
 										}
-									}
-
-								case 's': // Prefix: "s"
-
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-										elem = elem[l:]
-									} else {
-										break
-									}
 
-									if len(elem) == 0 {
-										break
+										return
 									}
 									switch elem[0] {
-									case 't': // Prefix: "tatus"
+									case '/': // Prefix: "/"
 
-										if l := len("tatus"); len(elem) >= l && elem[0:l] == "tatus" {
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
 										if len(elem) == 0 {
 											// Leaf node.
-											switch method {
+											switch r.Method {
 											case "GET":
-												r.name = AdminGetSonarrStatusOperation
-												r.summary = "Get Sonarr integration status (admin)"
-												r.operationID = "adminGetSonarrStatus"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/admin/integrations/sonarr/status"
-												r.args = args
-												r.count = 0
-												return r, true
+												s.handleGetMusicVideoArtistRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
 											default:
-												return
+												s.notAllowed(w, r, "GET")
 											}
-										}
-
-									case 'y': // Prefix: "ync"
 
-										if l := len("ync"); len(elem) >= l && elem[0:l] == "ync" {
-											elem = elem[l:]
-										} else {
-											break
+											return
 										}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "POST":
-												r.name = AdminTriggerSonarrSyncOperation
-												r.summary = "Trigger Sonarr library sync (admin)"
-												r.operationID = "adminTriggerSonarrSync"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/admin/integrations/sonarr/sync"
-												r.args = args
-												r.count = 0
-												return r, true
-											default:
-												return
-											}
-										}
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
 
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetMusicVideoRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
 									}
 
+									return
 								}
 
 							}
 
-						case 'o': // Prefix: "oidc/providers"
+						}
 
-							if l := len("oidc/providers"); len(elem) >= l && elem[0:l] == "oidc/providers" {
+					}
+
+				case 'o': // Prefix: "oidc/"
+
+					if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth/"
+
+						if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "provider"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleOidcAuthorizeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'c': // Prefix: "callback/"
+
+						if l := len("callback/"); len(elem) >= l && elem[0:l] == "callback/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "provider"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleOidcCallbackRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'p': // Prefix: "providers"
+
+						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleListOIDCProvidersRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'p': // Prefix: "p"
+
+					if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "eople/"
+
+						if l := len("eople/"); len(elem) >= l && elem[0:l] == "eople/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "personId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetPersonRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = AdminListOIDCProvidersOperation
-									r.summary = "List all OIDC providers (admin)"
-									r.operationID = "adminListOIDCProviders"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/admin/oidc/providers"
-									r.args = args
-									r.count = 0
-									return r, true
-								case "POST":
-									r.name = AdminCreateOIDCProviderOperation
-									r.summary = "Create OIDC provider (admin)"
-									r.operationID = "adminCreateOIDCProvider"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/admin/oidc/providers"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'f': // Prefix: "filmography"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("filmography"); len(elem) >= l && elem[0:l] == "filmography" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "providerId"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[0] = elem[:idx]
-								elem = elem[idx:]
-
 								if len(elem) == 0 {
-									switch method {
-									case "DELETE":
-										r.name = AdminDeleteOIDCProviderOperation
-										r.summary = "Delete OIDC provider (admin)"
-										r.operationID = "adminDeleteOIDCProvider"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}"
-										r.args = args
-										r.count = 1
-										return r, true
+									// Leaf node.
+									switch r.Method {
 									case "GET":
-										r.name = AdminGetOIDCProviderOperation
-										r.summary = "Get OIDC provider (admin)"
-										r.operationID = "adminGetOIDCProvider"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "PATCH":
-										r.name = AdminUpdateOIDCProviderOperation
-										r.summary = "Update OIDC provider (admin)"
-										r.operationID = "adminUpdateOIDCProvider"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}"
-										r.args = args
-										r.count = 1
-										return r, true
+										s.handleGetPersonFilmographyRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										return
+										s.notAllowed(w, r, "GET")
 									}
+
+									return
 								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							case 'r': // Prefix: "refresh"
 
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 'd': // Prefix: "d"
+								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-										if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRefreshPersonRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'h': // Prefix: "hotos"
+
+						if l := len("hotos"); len(elem) >= l && elem[0:l] == "hotos" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListPhotosRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "albums"
+								origElem := elem
+								if l := len("albums"); len(elem) >= l && elem[0:l] == "albums" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListPhotoAlbumsRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreatePhotoAlbumRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDeletePhotoAlbumRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetPhotoAlbumRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PATCH":
+											s.handleUpdatePhotoAlbumRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PATCH")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 											elem = elem[l:]
 										} else {
 											break
@@ -5356,111 +5591,174 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 											break
 										}
 										switch elem[0] {
-										case 'e': // Prefix: "efault"
+										case 'p': // Prefix: "photos"
 
-											if l := len("efault"); len(elem) >= l && elem[0:l] == "efault" {
+											if l := len("photos"); len(elem) >= l && elem[0:l] == "photos" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
 											if len(elem) == 0 {
-												// Leaf node.
-												switch method {
+												switch r.Method {
+												case "GET":
+													s.handleListPhotoAlbumPhotosRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
 												case "POST":
-													r.name = AdminSetDefaultOIDCProviderOperation
-													r.summary = "Set default OIDC provider (admin)"
-													r.operationID = "adminSetDefaultOIDCProvider"
-													r.operationGroup = ""
-													r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}/default"
-													r.args = args
-													r.count = 1
-													return r, true
+													s.handleAddPhotoAlbumPhotosRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
 												default:
+													s.notAllowed(w, r, "GET,POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "photoId"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleRemovePhotoAlbumPhotoRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE")
+													}
+
 													return
 												}
+
 											}
 
-										case 'i': // Prefix: "isable"
+										case 's': // Prefix: "shares"
 
-											if l := len("isable"); len(elem) >= l && elem[0:l] == "isable" {
+											if l := len("shares"); len(elem) >= l && elem[0:l] == "shares" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
 											if len(elem) == 0 {
-												// Leaf node.
-												switch method {
-												case "POST":
-													r.name = AdminDisableOIDCProviderOperation
-													r.summary = "Disable OIDC provider (admin)"
-													r.operationID = "adminDisableOIDCProvider"
-													r.operationGroup = ""
-													r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}/disable"
-													r.args = args
-													r.count = 1
-													return r, true
+												switch r.Method {
+												case "GET":
+													s.handleListPhotoAlbumSharesRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
 												default:
-													return
+													s.notAllowed(w, r, "GET")
 												}
+
+												return
 											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
 
-										}
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-									case 'e': // Prefix: "enable"
+												// Param: "userId"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
 
-										if l := len("enable"); len(elem) >= l && elem[0:l] == "enable" {
-											elem = elem[l:]
-										} else {
-											break
-										}
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleUnsharePhotoAlbumRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													case "PUT":
+														s.handleSharePhotoAlbumRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE,PUT")
+													}
+
+													return
+												}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "POST":
-												r.name = AdminEnableOIDCProviderOperation
-												r.summary = "Enable OIDC provider (admin)"
-												r.operationID = "adminEnableOIDCProvider"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}/enable"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
 											}
+
 										}
 
 									}
 
 								}
 
-							}
+								elem = origElem
+							case 't': // Prefix: "timeline"
+								origElem := elem
+								if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						case 'u': // Prefix: "users"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPhotoTimelineRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-							if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
-								elem = elem[l:]
-							} else {
-								break
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								switch method {
+								switch r.Method {
 								case "GET":
-									r.name = AdminListUsersOperation
-									r.summary = "List and search users (admin)"
-									r.operationID = "adminListUsers"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/admin/users"
-									r.args = args
-									r.count = 0
-									return r, true
+									s.handleGetPhotoRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									return
+									s.notAllowed(w, r, "GET")
 								}
+
+								return
 							}
 							switch elem[0] {
 							case '/': // Prefix: "/"
@@ -5471,67 +5769,77 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 
-								// Param: "userId"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
 									break
 								}
-								args[0] = elem
-								elem = ""
+								switch elem[0] {
+								case 'f': // Prefix: "file"
+
+									if l := len("file"); len(elem) >= l && elem[0:l] == "file" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleDownloadPhotoFileRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 't': // Prefix: "thumbnail"
+
+									if l := len("thumbnail"); len(elem) >= l && elem[0:l] == "thumbnail" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetPhotoThumbnailRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "DELETE":
-										r.name = AdminDeleteUserOperation
-										r.summary = "Delete a user (admin)"
-										r.operationID = "adminDeleteUser"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/admin/users/{userId}"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
 										return
 									}
+
 								}
 
 							}
 
 						}
 
-					case 'p': // Prefix: "pikeys"
+					case 'l': // Prefix: "layback/sessions"
 
-						if l := len("pikeys"); len(elem) >= l && elem[0:l] == "pikeys" {
+						if l := len("layback/sessions"); len(elem) >= l && elem[0:l] == "layback/sessions" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = ListAPIKeysOperation
-								r.summary = "List API keys"
-								r.operationID = "listAPIKeys"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/apikeys"
-								r.args = args
-								r.count = 0
-								return r, true
+							switch r.Method {
 							case "POST":
-								r.name = CreateAPIKeyOperation
-								r.summary = "Create API key"
-								r.operationID = "createAPIKey"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/apikeys"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleStartPlaybackSessionRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "POST")
 							}
+
+							return
 						}
 						switch elem[0] {
 						case '/': // Prefix: "/"
@@ -5542,108 +5850,82 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							// Param: "keyId"
-							// Leaf parameter, slashes are prohibited
+							// Param: "sessionId"
+							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
+							if idx < 0 {
+								idx = len(elem)
 							}
-							args[0] = elem
-							elem = ""
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
+								switch r.Method {
 								case "DELETE":
-									r.name = RevokeAPIKeyOperation
-									r.summary = "Revoke API key"
-									r.operationID = "revokeAPIKey"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/apikeys/{keyId}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleStopPlaybackSessionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								case "GET":
-									r.name = GetAPIKeyOperation
-									r.summary = "Get API key"
-									r.operationID = "getAPIKey"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/apikeys/{keyId}"
-									r.args = args
-									r.count = 1
-									return r, true
+									s.handleGetPlaybackSessionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
+									s.notAllowed(w, r, "DELETE,GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/heartbeat"
+
+								if l := len("/heartbeat"); len(elem) >= l && elem[0:l] == "/heartbeat" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleHeartbeatPlaybackSessionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
 									return
 								}
+
 							}
 
 						}
 
-					case 'u': // Prefix: "uth/"
+					case 'o': // Prefix: "odcasts"
 
-						if l := len("uth/"); len(elem) >= l && elem[0:l] == "uth/" {
+						if l := len("odcasts"); len(elem) >= l && elem[0:l] == "odcasts" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'c': // Prefix: "change-password"
-
-							if l := len("change-password"); len(elem) >= l && elem[0:l] == "change-password" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = ChangePasswordOperation
-									r.summary = "Change password"
-									r.operationID = "changePassword"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/auth/change-password"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 'f': // Prefix: "forgot-password"
-
-							if l := len("forgot-password"); len(elem) >= l && elem[0:l] == "forgot-password" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = ForgotPasswordOperation
-									r.summary = "Request password reset"
-									r.operationID = "forgotPassword"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/auth/forgot-password"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
+							switch r.Method {
+							case "GET":
+								s.handleListPodcastsRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleSubscribePodcastFeedRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
 							}
 
-						case 'l': // Prefix: "log"
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-							if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -5653,135 +5935,8263 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'i': // Prefix: "in"
-
-								if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+							case 'e': // Prefix: "episodes/"
+								origElem := elem
+								if l := len("episodes/"); len(elem) >= l && elem[0:l] == "episodes/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = LoginOperation
-										r.summary = "Login with credentials"
-										r.operationID = "login"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/auth/login"
-										r.args = args
-										r.count = 0
-										return r, true
+									switch r.Method {
+									case "GET":
+										s.handleGetPodcastEpisodeRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										return
+										s.notAllowed(w, r, "GET")
 									}
-								}
-
-							case 'o': // Prefix: "out"
 
-								if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
-									elem = elem[l:]
-								} else {
-									break
+									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = LogoutOperation
-										r.summary = "Logout"
-										r.operationID = "logout"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/auth/logout"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
 									}
-								}
 
-							}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "download"
 
-						case 'r': // Prefix: "re"
+										if l := len("download"); len(elem) >= l && elem[0:l] == "download" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleDeletePodcastEpisodeDownloadRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleDownloadPodcastEpisodeRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE,POST")
+											}
+
+											return
+										}
+
+									case 'p': // Prefix: "progress"
+
+										if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleDeletePodcastEpisodeProgressRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "GET":
+												s.handleGetPodcastEpisodeProgressRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleUpdatePodcastEpisodeProgressRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE,GET,POST")
+											}
+
+											return
+										}
+
+									case 't': // Prefix: "transcript"
+
+										if l := len("transcript"); len(elem) >= l && elem[0:l] == "transcript" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetPodcastEpisodeTranscriptRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							case 'n': // Prefix: "new"
+								origElem := elem
+								if l := len("new"); len(elem) >= l && elem[0:l] == "new" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleListNewPodcastEpisodesRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetPodcastRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUpdatePodcastSettingsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,PATCH")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "episodes"
+
+									if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleListPodcastEpisodesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "refresh"
+
+									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRefreshPodcastRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "subscription"
+
+									if l := len("subscription"); len(elem) >= l && elem[0:l] == "subscription" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleUnsubscribePodcastRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleSubscribePodcastRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,PUT")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "rbac/"
+
+					if l := len("rbac/"); len(elem) >= l && elem[0:l] == "rbac/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'p': // Prefix: "p"
+
+						if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "ermissions"
+
+							if l := len("ermissions"); len(elem) >= l && elem[0:l] == "ermissions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleListPermissionsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'o': // Prefix: "olicies"
+
+							if l := len("olicies"); len(elem) >= l && elem[0:l] == "olicies" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleRemovePolicyRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleListPoliciesRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleAddPolicyRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,POST")
+								}
+
+								return
+							}
+
+						}
+
+					case 'r': // Prefix: "roles"
+
+						if l := len("roles"); len(elem) >= l && elem[0:l] == "roles" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListRolesRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleCreateRoleRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "roleName"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteRoleRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetRoleRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/permissions"
+
+								if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "PUT":
+										s.handleUpdateRolePermissionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "PUT")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "users/"
+
+						if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "userId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/roles"
+
+							if l := len("/roles"); len(elem) >= l && elem[0:l] == "/roles" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetUserRolesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleAssignRoleRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "role"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRemoveRoleRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 's': // Prefix: "se"
+
+					if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "arch/"
+
+						if l := len("arch/"); len(elem) >= l && elem[0:l] == "arch/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'h': // Prefix: "homevideos"
+
+							if l := len("homevideos"); len(elem) >= l && elem[0:l] == "homevideos" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleSearchLibraryHomeVideosRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 'm': // Prefix: "m"
+
+							if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'o': // Prefix: "ovies"
+
+								if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleSearchLibraryMoviesRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "autocomplete"
+
+										if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleAutocompleteMoviesRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 'f': // Prefix: "facets"
+
+										if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetSearchFacetsRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 'u': // Prefix: "u"
+
+								if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lti"
+
+									if l := len("lti"); len(elem) >= l && elem[0:l] == "lti" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleSearchMultiRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "sic"
+
+									if l := len("sic"); len(elem) >= l && elem[0:l] == "sic" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleSearchLibraryMusicRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						case 'r': // Prefix: "reindex"
+
+							if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleReindexSearchRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+						case 't': // Prefix: "tvshows"
+
+							if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleSearchLibraryTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "autocomplete"
+
+									if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleAutocompleteTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'f': // Prefix: "facets"
+
+									if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTVShowSearchFacetsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "reindex"
+
+									if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleReindexTVShowSearchRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					case 's': // Prefix: "ssions"
+
+						if l := len("ssions"); len(elem) >= l && elem[0:l] == "ssions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleLogoutAllRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleListSessionsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "current"
+								origElem := elem
+								if l := len("current"); len(elem) >= l && elem[0:l] == "current" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleLogoutCurrentRequest([0]string{}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetCurrentSessionRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "refresh"
+								origElem := elem
+								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRefreshSessionRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "sessionId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleRevokeSessionRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+						}
+
+					case 't': // Prefix: "ttings/"
+
+						if l := len("ttings/"); len(elem) >= l && elem[0:l] == "ttings/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "server"
+
+							if l := len("server"); len(elem) >= l && elem[0:l] == "server" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListServerSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "key"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetServerSettingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateServerSettingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,PUT")
+									}
+
+									return
+								}
+
+							}
+
+						case 'u': // Prefix: "user"
+
+							if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListUserSettingsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "key"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleDeleteUserSettingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleGetUserSettingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "PUT":
+										s.handleUpdateUserSettingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET,PUT")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 't': // Prefix: "t"
+
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "ags"
+
+						if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListTagsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "slug"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'm': // Prefix: "movies"
+
+									if l := len("movies"); len(elem) >= l && elem[0:l] == "movies" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleListMoviesByTagRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 't': // Prefix: "tvshows"
+
+									if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleListTVShowsByTagRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'v': // Prefix: "vshows"
+
+						if l := len("vshows"); len(elem) >= l && elem[0:l] == "vshows" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "continue-watching"
+								origElem := elem
+								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetTVContinueWatchingRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 'e': // Prefix: "episodes/"
+								origElem := elem
+								if l := len("episodes/"); len(elem) >= l && elem[0:l] == "episodes/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "bulk-watched"
+									origElem := elem
+									if l := len("bulk-watched"); len(elem) >= l && elem[0:l] == "bulk-watched" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleMarkTVEpisodesBulkWatchedRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'r': // Prefix: "recent"
+									origElem := elem
+									if l := len("recent"); len(elem) >= l && elem[0:l] == "recent" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRecentEpisodesRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'u': // Prefix: "upcoming"
+									origElem := elem
+									if l := len("upcoming"); len(elem) >= l && elem[0:l] == "upcoming" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetUpcomingEpisodesRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleGetTVEpisodeRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'f': // Prefix: "files"
+
+										if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetTVEpisodeFilesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 'm': // Prefix: "metadata"
+
+										if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "PATCH":
+												s.handleUpdateTVEpisodeMetadataRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "PATCH")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'h': // Prefix: "history"
+
+												if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch r.Method {
+													case "GET":
+														s.handleListTVEpisodeMetadataHistoryRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "version"
+													// Match until "/"
+													idx := strings.IndexByte(elem, '/')
+													if idx < 0 {
+														idx = len(elem)
+													}
+													args[1] = elem[:idx]
+													elem = elem[idx:]
+
+													if len(elem) == 0 {
+														break
+													}
+													switch elem[0] {
+													case '/': // Prefix: "/rollback"
+
+														if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch r.Method {
+															case "POST":
+																s.handleRollbackTVEpisodeMetadataRequest([2]string{
+																	args[0],
+																	args[1],
+																}, elemIsEscaped, w, r)
+															default:
+																s.notAllowed(w, r, "POST")
+															}
+
+															return
+														}
+
+													}
+
+												}
+
+											case 'l': // Prefix: "locks/"
+
+												if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "field"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleUnlockTVEpisodeMetadataFieldRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE")
+													}
+
+													return
+												}
+
+											}
+
+										}
+
+									case 'p': // Prefix: "progress"
+
+										if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteTVEpisodeProgressRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "GET":
+												s.handleGetTVEpisodeProgressRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "PUT":
+												s.handleUpdateTVEpisodeProgressRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE,GET,PUT")
+											}
+
+											return
+										}
+
+									case 'w': // Prefix: "watched"
+
+										if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleMarkTVEpisodeWatchedRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "recently-added"
+								origElem := elem
+								if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRecentlyAddedTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							case 's': // Prefix: "s"
+								origElem := elem
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "ea"
+
+									if l := len("ea"); len(elem) >= l && elem[0:l] == "ea" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "rch"
+
+										if l := len("rch"); len(elem) >= l && elem[0:l] == "rch" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleSearchTVShowsRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 's': // Prefix: "sons/"
+
+										if l := len("sons/"); len(elem) >= l && elem[0:l] == "sons/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetTVSeasonRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'e': // Prefix: "episodes"
+
+												if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetTVSeasonEpisodesRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											case 'm': // Prefix: "metadata"
+
+												if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch r.Method {
+													case "PATCH":
+														s.handleUpdateTVSeasonMetadataRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "PATCH")
+													}
+
+													return
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														break
+													}
+													switch elem[0] {
+													case 'h': // Prefix: "history"
+
+														if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															switch r.Method {
+															case "GET":
+																s.handleListTVSeasonMetadataHistoryRequest([1]string{
+																	args[0],
+																}, elemIsEscaped, w, r)
+															default:
+																s.notAllowed(w, r, "GET")
+															}
+
+															return
+														}
+														switch elem[0] {
+														case '/': // Prefix: "/"
+
+															if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+																elem = elem[l:]
+															} else {
+																break
+															}
+
+															// Param: "version"
+															// Match until "/"
+															idx := strings.IndexByte(elem, '/')
+															if idx < 0 {
+																idx = len(elem)
+															}
+															args[1] = elem[:idx]
+															elem = elem[idx:]
+
+															if len(elem) == 0 {
+																break
+															}
+															switch elem[0] {
+															case '/': // Prefix: "/rollback"
+
+																if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+																	elem = elem[l:]
+																} else {
+																	break
+																}
+
+																if len(elem) == 0 {
+																	// Leaf node.
+																	switch r.Method {
+																	case "POST":
+																		s.handleRollbackTVSeasonMetadataRequest([2]string{
+																			args[0],
+																			args[1],
+																		}, elemIsEscaped, w, r)
+																	default:
+																		s.notAllowed(w, r, "POST")
+																	}
+
+																	return
+																}
+
+															}
+
+														}
+
+													case 'l': // Prefix: "locks/"
+
+														if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														// Param: "field"
+														// Leaf parameter, slashes are prohibited
+														idx := strings.IndexByte(elem, '/')
+														if idx >= 0 {
+															break
+														}
+														args[1] = elem
+														elem = ""
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch r.Method {
+															case "DELETE":
+																s.handleUnlockTVSeasonMetadataFieldRequest([2]string{
+																	args[0],
+																	args[1],
+																}, elemIsEscaped, w, r)
+															default:
+																s.notAllowed(w, r, "DELETE")
+															}
+
+															return
+														}
+
+													}
+
+												}
+
+											}
+
+										}
+
+									}
+
+								case 't': // Prefix: "tats"
+
+									if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetUserTVStatsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetTVShowRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "artwork"
+
+									if l := len("artwork"); len(elem) >= l && elem[0:l] == "artwork" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListTVShowArtworkRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "artworkId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/select"
+
+											if l := len("/select"); len(elem) >= l && elem[0:l] == "/select" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleSelectTVShowArtworkRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "ast"
+
+										if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetTVShowCastRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 'r': // Prefix: "rew"
+
+										if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetTVShowCrewRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								case 'e': // Prefix: "episodes"
+
+									if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTVShowEpisodesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'g': // Prefix: "genres"
+
+									if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTVShowGenresRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'm': // Prefix: "metadata"
+
+									if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "PATCH":
+											s.handleUpdateTVShowMetadataRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PATCH")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'h': // Prefix: "history"
+
+											if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleListTVShowMetadataHistoryRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "version"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[1] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/rollback"
+
+													if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "POST":
+															s.handleRollbackTVShowMetadataRequest([2]string{
+																args[0],
+																args[1],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "POST")
+														}
+
+														return
+													}
+
+												}
+
+											}
+
+										case 'l': // Prefix: "locks/"
+
+											if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "field"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleUnlockTVShowMetadataFieldRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								case 'n': // Prefix: "ne"
+
+									if l := len("ne"); len(elem) >= l && elem[0:l] == "ne" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 't': // Prefix: "tworks"
+
+										if l := len("tworks"); len(elem) >= l && elem[0:l] == "tworks" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetTVShowNetworksRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									case 'x': // Prefix: "xt-episode"
+
+										if l := len("xt-episode"); len(elem) >= l && elem[0:l] == "xt-episode" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetTVShowNextEpisodeRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
+
+								case 'r': // Prefix: "refresh"
+
+									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRefreshTVShowMetadataRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 's': // Prefix: "seasons"
+
+									if l := len("seasons"); len(elem) >= l && elem[0:l] == "seasons" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTVShowSeasonsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 't': // Prefix: "tags"
+
+									if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTVShowTagsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'w': // Prefix: "watch-stats"
+
+									if l := len("watch-stats"); len(elem) >= l && elem[0:l] == "watch-stats" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTVShowWatchStatsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "users/"
+
+					if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'm': // Prefix: "me"
+						origElem := elem
+						if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateCurrentUserRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "avatar"
+
+								if l := len("avatar"); len(elem) >= l && elem[0:l] == "avatar" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUploadAvatarRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'o': // Prefix: "oidc"
+
+								if l := len("oidc"); len(elem) >= l && elem[0:l] == "oidc" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListUserOIDCLinksRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "provider"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleUnlinkOIDCProviderRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/link"
+
+										if l := len("/link"); len(elem) >= l && elem[0:l] == "/link" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleInitOIDCLinkRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 'p': // Prefix: "p"
+
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "arental-controls"
+
+									if l := len("arental-controls"); len(elem) >= l && elem[0:l] == "arental-controls" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetMyParentalControlsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/unlock"
+
+										if l := len("/unlock"); len(elem) >= l && elem[0:l] == "/unlock" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleUnlockParentalControlsRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								case 'r': // Prefix: "references"
+
+									if l := len("references"); len(elem) >= l && elem[0:l] == "references" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetUserPreferencesRequest([0]string{}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUpdateUserPreferencesRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,PUT")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+						elem = origElem
+					}
+					// Param: "userId"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetUserByIdRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'w': // Prefix: "webhooks/"
+
+					if l := len("webhooks/"); len(elem) >= l && elem[0:l] == "webhooks/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "radarr"
+
+						if l := len("radarr"); len(elem) >= l && elem[0:l] == "radarr" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleHandleRadarrWebhookRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 's': // Prefix: "sonarr"
+
+						if l := len("sonarr"); len(elem) >= l && elem[0:l] == "sonarr" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleHandleSonarrWebhookRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'h': // Prefix: "healthz"
+
+				if l := len("healthz"); len(elem) >= l && elem[0:l] == "healthz" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetLivenessRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 'r': // Prefix: "readyz"
+
+				if l := len("readyz"); len(elem) >= l && elem[0:l] == "readyz" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetReadinessRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 's': // Prefix: "startupz"
+
+				if l := len("startupz"); len(elem) >= l && elem[0:l] == "startupz" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetStartupRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name           string
+	summary        string
+	operationID    string
+	operationGroup string
+	pathPattern    string
+	count          int
+	args           [3]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// OperationGroup returns the x-ogen-operation-group value.
+func (r Route) OperationGroup() string {
+	return r.operationGroup
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "api/v1/"
+
+				if l := len("api/v1/"); len(elem) >= l && elem[0:l] == "api/v1/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "a"
+
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "dmin/"
+
+						if l := len("dmin/"); len(elem) >= l && elem[0:l] == "dmin/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "activity"
+
+							if l := len("activity"); len(elem) >= l && elem[0:l] == "activity" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = SearchActivityLogsOperation
+									r.summary = "Search activity logs (admin)"
+									r.operationID = "searchActivityLogs"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/admin/activity"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "actions"
+
+									if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetRecentActionsOperation
+											r.summary = "Get recent action types (admin)"
+											r.operationID = "getRecentActions"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/activity/actions"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "resources/"
+
+									if l := len("resources/"); len(elem) >= l && elem[0:l] == "resources/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "resourceType"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "resourceId"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetResourceActivityLogsOperation
+												r.summary = "Get resource activity logs (admin)"
+												r.operationID = "getResourceActivityLogs"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/activity/resources/{resourceType}/{resourceId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 's': // Prefix: "stats"
+
+									if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetActivityStatsOperation
+											r.summary = "Get activity statistics (admin)"
+											r.operationID = "getActivityStats"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/activity/stats"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'u': // Prefix: "users/"
+
+									if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "userId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetUserActivityLogsOperation
+											r.summary = "Get user activity logs (admin)"
+											r.operationID = "getUserActivityLogs"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/activity/users/{userId}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'i': // Prefix: "integrations/"
+
+							if l := len("integrations/"); len(elem) >= l && elem[0:l] == "integrations/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'r': // Prefix: "radarr/"
+
+								if l := len("radarr/"); len(elem) >= l && elem[0:l] == "radarr/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'q': // Prefix: "quality-profiles"
+
+									if l := len("quality-profiles"); len(elem) >= l && elem[0:l] == "quality-profiles" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AdminGetRadarrQualityProfilesOperation
+											r.summary = "Get Radarr quality profiles (admin)"
+											r.operationID = "adminGetRadarrQualityProfiles"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/integrations/radarr/quality-profiles"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "root-folders"
+
+									if l := len("root-folders"); len(elem) >= l && elem[0:l] == "root-folders" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AdminGetRadarrRootFoldersOperation
+											r.summary = "Get Radarr root folders (admin)"
+											r.operationID = "adminGetRadarrRootFolders"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/integrations/radarr/root-folders"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 't': // Prefix: "tatus"
+
+										if l := len("tatus"); len(elem) >= l && elem[0:l] == "tatus" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = AdminGetRadarrStatusOperation
+												r.summary = "Get Radarr integration status (admin)"
+												r.operationID = "adminGetRadarrStatus"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/integrations/radarr/status"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'y': // Prefix: "ync"
+
+										if l := len("ync"); len(elem) >= l && elem[0:l] == "ync" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = AdminTriggerRadarrSyncOperation
+												r.summary = "Trigger Radarr library sync (admin)"
+												r.operationID = "adminTriggerRadarrSync"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/integrations/radarr/sync"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 's': // Prefix: "sonarr/"
+
+								if l := len("sonarr/"); len(elem) >= l && elem[0:l] == "sonarr/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'q': // Prefix: "quality-profiles"
+
+									if l := len("quality-profiles"); len(elem) >= l && elem[0:l] == "quality-profiles" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AdminGetSonarrQualityProfilesOperation
+											r.summary = "Get Sonarr quality profiles (admin)"
+											r.operationID = "adminGetSonarrQualityProfiles"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/integrations/sonarr/quality-profiles"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "root-folders"
+
+									if l := len("root-folders"); len(elem) >= l && elem[0:l] == "root-folders" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AdminGetSonarrRootFoldersOperation
+											r.summary = "Get Sonarr root folders (admin)"
+											r.operationID = "adminGetSonarrRootFolders"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/integrations/sonarr/root-folders"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "s"
+
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 't': // Prefix: "tatus"
+
+										if l := len("tatus"); len(elem) >= l && elem[0:l] == "tatus" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = AdminGetSonarrStatusOperation
+												r.summary = "Get Sonarr integration status (admin)"
+												r.operationID = "adminGetSonarrStatus"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/integrations/sonarr/status"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'y': // Prefix: "ync"
+
+										if l := len("ync"); len(elem) >= l && elem[0:l] == "ync" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = AdminTriggerSonarrSyncOperation
+												r.summary = "Trigger Sonarr library sync (admin)"
+												r.operationID = "adminTriggerSonarrSync"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/integrations/sonarr/sync"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						case 'm': // Prefix: "m"
+
+							if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "atch-queue"
+
+								if l := len("atch-queue"); len(elem) >= l && elem[0:l] == "atch-queue" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = AdminListMatchQueueOperation
+										r.summary = "List unmatched items (admin)"
+										r.operationID = "adminListMatchQueue"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/match-queue"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "itemId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = AdminGetMatchQueueItemOperation
+											r.summary = "Get unmatched item (admin)"
+											r.operationID = "adminGetMatchQueueItem"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/match-queue/{itemId}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/i"
+
+										if l := len("/i"); len(elem) >= l && elem[0:l] == "/i" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'd': // Prefix: "dentify"
+
+											if l := len("dentify"); len(elem) >= l && elem[0:l] == "dentify" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = AdminIdentifyMatchQueueItemOperation
+													r.summary = "Identify an unmatched item (admin)"
+													r.operationID = "adminIdentifyMatchQueueItem"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/admin/match-queue/{itemId}/identify"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										case 'g': // Prefix: "gnore"
+
+											if l := len("gnore"); len(elem) >= l && elem[0:l] == "gnore" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = AdminIgnoreMatchQueueItemOperation
+													r.summary = "Ignore an unmatched item (admin)"
+													r.operationID = "adminIgnoreMatchQueueItem"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/admin/match-queue/{itemId}/ignore"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								}
+
+							case 'o': // Prefix: "ovies/"
+
+								if l := len("ovies/"); len(elem) >= l && elem[0:l] == "ovies/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'f': // Prefix: "files/"
+
+									if l := len("files/"); len(elem) >= l && elem[0:l] == "files/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "fileId"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/match-lock"
+
+										if l := len("/match-lock"); len(elem) >= l && elem[0:l] == "/match-lock" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = AdminUnlockMovieFileMatchOperation
+												r.summary = "Unlock a manual movie match (admin)"
+												r.operationID = "adminUnlockMovieFileMatch"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/movies/files/{fileId}/match-lock"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'n': // Prefix: "nfo-export"
+
+									if l := len("nfo-export"); len(elem) >= l && elem[0:l] == "nfo-export" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = AdminExportMovieNFOOperation
+											r.summary = "Export movie NFO files (admin)"
+											r.operationID = "adminExportMovieNFO"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/movies/nfo-export"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'o': // Prefix: "oidc/providers"
+
+							if l := len("oidc/providers"); len(elem) >= l && elem[0:l] == "oidc/providers" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = AdminListOIDCProvidersOperation
+									r.summary = "List all OIDC providers (admin)"
+									r.operationID = "adminListOIDCProviders"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/admin/oidc/providers"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = AdminCreateOIDCProviderOperation
+									r.summary = "Create OIDC provider (admin)"
+									r.operationID = "adminCreateOIDCProvider"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/admin/oidc/providers"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "providerId"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = AdminDeleteOIDCProviderOperation
+										r.summary = "Delete OIDC provider (admin)"
+										r.operationID = "adminDeleteOIDCProvider"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = AdminGetOIDCProviderOperation
+										r.summary = "Get OIDC provider (admin)"
+										r.operationID = "adminGetOIDCProvider"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PATCH":
+										r.name = AdminUpdateOIDCProviderOperation
+										r.summary = "Update OIDC provider (admin)"
+										r.operationID = "adminUpdateOIDCProvider"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "d"
+
+										if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "efault"
+
+											if l := len("efault"); len(elem) >= l && elem[0:l] == "efault" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = AdminSetDefaultOIDCProviderOperation
+													r.summary = "Set default OIDC provider (admin)"
+													r.operationID = "adminSetDefaultOIDCProvider"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}/default"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										case 'i': // Prefix: "isable"
+
+											if l := len("isable"); len(elem) >= l && elem[0:l] == "isable" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = AdminDisableOIDCProviderOperation
+													r.summary = "Disable OIDC provider (admin)"
+													r.operationID = "adminDisableOIDCProvider"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}/disable"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									case 'e': // Prefix: "enable"
+
+										if l := len("enable"); len(elem) >= l && elem[0:l] == "enable" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = AdminEnableOIDCProviderOperation
+												r.summary = "Enable OIDC provider (admin)"
+												r.operationID = "adminEnableOIDCProvider"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/admin/oidc/providers/{providerId}/enable"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						case 't': // Prefix: "tvshows/nfo-export"
+
+							if l := len("tvshows/nfo-export"); len(elem) >= l && elem[0:l] == "tvshows/nfo-export" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = AdminExportTVShowNFOOperation
+									r.summary = "Export TV show NFO files (admin)"
+									r.operationID = "adminExportTVShowNFO"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/admin/tvshows/nfo-export"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'u': // Prefix: "users"
+
+							if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = AdminListUsersOperation
+									r.summary = "List and search users (admin)"
+									r.operationID = "adminListUsers"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/admin/users"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "userId"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = AdminDeleteUserOperation
+										r.summary = "Delete a user (admin)"
+										r.operationID = "adminDeleteUser"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/admin/users/{userId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/parental-controls"
+
+									if l := len("/parental-controls"); len(elem) >= l && elem[0:l] == "/parental-controls" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AdminGetParentalControlsOperation
+											r.summary = "Get parental controls of a user (admin)"
+											r.operationID = "adminGetParentalControls"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/users/{userId}/parental-controls"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PUT":
+											r.name = AdminUpdateParentalControlsOperation
+											r.summary = "Update parental controls of a user (admin)"
+											r.operationID = "adminUpdateParentalControls"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/admin/users/{userId}/parental-controls"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'p': // Prefix: "pikeys"
+
+						if l := len("pikeys"); len(elem) >= l && elem[0:l] == "pikeys" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListAPIKeysOperation
+								r.summary = "List API keys"
+								r.operationID = "listAPIKeys"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/apikeys"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateAPIKeyOperation
+								r.summary = "Create API key"
+								r.operationID = "createAPIKey"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/apikeys"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "keyId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RevokeAPIKeyOperation
+									r.summary = "Revoke API key"
+									r.operationID = "revokeAPIKey"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/apikeys/{keyId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = GetAPIKeyOperation
+									r.summary = "Get API key"
+									r.operationID = "getAPIKey"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/apikeys/{keyId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'u': // Prefix: "u"
+
+						if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'd': // Prefix: "diobooks"
+
+							if l := len("diobooks"); len(elem) >= l && elem[0:l] == "diobooks" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListAudiobooksOperation
+									r.summary = "List audiobooks"
+									r.operationID = "listAudiobooks"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/audiobooks"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "authors"
+									origElem := elem
+									if l := len("authors"); len(elem) >= l && elem[0:l] == "authors" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListAudiobookAuthorsOperation
+											r.summary = "List audiobook authors"
+											r.operationID = "listAudiobookAuthors"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/audiobooks/authors"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/books"
+
+											if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetAudiobookAuthorBooksOperation
+													r.summary = "Get author audiobooks"
+													r.operationID = "getAudiobookAuthorBooks"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/audiobooks/authors/{id}/books"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+									elem = origElem
+								case 'c': // Prefix: "continue-listening"
+									origElem := elem
+									if l := len("continue-listening"); len(elem) >= l && elem[0:l] == "continue-listening" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetContinueListeningOperation
+											r.summary = "Get continue listening list"
+											r.operationID = "getContinueListening"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/audiobooks/continue-listening"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 's': // Prefix: "series"
+									origElem := elem
+									if l := len("series"); len(elem) >= l && elem[0:l] == "series" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListAudiobookSeriesOperation
+											r.summary = "List audiobook series"
+											r.operationID = "listAudiobookSeries"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/audiobooks/series"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/books"
+
+											if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetAudiobookSeriesBooksOperation
+													r.summary = "Get series audiobooks"
+													r.operationID = "getAudiobookSeriesBooks"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/audiobooks/series/{id}/books"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetAudiobookOperation
+										r.summary = "Get audiobook"
+										r.operationID = "getAudiobook"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/audiobooks/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/progress"
+
+									if l := len("/progress"); len(elem) >= l && elem[0:l] == "/progress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteAudiobookProgressOperation
+											r.summary = "Delete listening progress"
+											r.operationID = "deleteAudiobookProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/audiobooks/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetAudiobookProgressOperation
+											r.summary = "Get listening progress"
+											r.operationID = "getAudiobookProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/audiobooks/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = UpdateAudiobookProgressOperation
+											r.summary = "Update listening progress"
+											r.operationID = "updateAudiobookProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/audiobooks/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 't': // Prefix: "th/"
+
+							if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "change-password"
+
+								if l := len("change-password"); len(elem) >= l && elem[0:l] == "change-password" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ChangePasswordOperation
+										r.summary = "Change password"
+										r.operationID = "changePassword"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/auth/change-password"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'f': // Prefix: "forgot-password"
+
+								if l := len("forgot-password"); len(elem) >= l && elem[0:l] == "forgot-password" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ForgotPasswordOperation
+										r.summary = "Request password reset"
+										r.operationID = "forgotPassword"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/auth/forgot-password"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'l': // Prefix: "log"
+
+								if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "in"
+
+									if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = LoginOperation
+											r.summary = "Login with credentials"
+											r.operationID = "login"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/auth/login"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'o': // Prefix: "out"
+
+									if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = LogoutOperation
+											r.summary = "Logout"
+											r.operationID = "logout"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/auth/logout"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'r': // Prefix: "re"
+
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'f': // Prefix: "fresh"
+
+									if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RefreshTokenOperation
+											r.summary = "Refresh access token"
+											r.operationID = "refreshToken"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/auth/refresh"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'g': // Prefix: "gister"
+
+									if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RegisterOperation
+											r.summary = "Register new user"
+											r.operationID = "register"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/auth/register"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "se"
+
+									if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "nd-verification"
+
+										if l := len("nd-verification"); len(elem) >= l && elem[0:l] == "nd-verification" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ResendVerificationOperation
+												r.summary = "Resend verification email"
+												r.operationID = "resendVerification"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/auth/resend-verification"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 't': // Prefix: "t-password"
+
+										if l := len("t-password"); len(elem) >= l && elem[0:l] == "t-password" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ResetPasswordOperation
+												r.summary = "Reset password"
+												r.operationID = "resetPassword"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/auth/reset-password"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'v': // Prefix: "verify-email"
+
+								if l := len("verify-email"); len(elem) >= l && elem[0:l] == "verify-email" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = VerifyEmailOperation
+										r.summary = "Verify email"
+										r.operationID = "verifyEmail"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/auth/verify-email"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'b': // Prefix: "books"
+
+					if l := len("books"); len(elem) >= l && elem[0:l] == "books" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListBooksOperation
+							r.summary = "List books"
+							r.operationID = "listBooks"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/books"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "authors"
+							origElem := elem
+							if l := len("authors"); len(elem) >= l && elem[0:l] == "authors" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListBookAuthorsOperation
+									r.summary = "List book authors"
+									r.operationID = "listBookAuthors"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/books/authors"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/books"
+
+									if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetBookAuthorBooksOperation
+											r.summary = "Get author books"
+											r.operationID = "getBookAuthorBooks"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/books/authors/{id}/books"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						case 'c': // Prefix: "continue-reading"
+							origElem := elem
+							if l := len("continue-reading"); len(elem) >= l && elem[0:l] == "continue-reading" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetContinueReadingOperation
+									r.summary = "Get continue reading list"
+									r.operationID = "getContinueReading"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/books/continue-reading"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 's': // Prefix: "series"
+							origElem := elem
+							if l := len("series"); len(elem) >= l && elem[0:l] == "series" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListBookSeriesOperation
+									r.summary = "List book series"
+									r.operationID = "listBookSeries"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/books/series"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/books"
+
+									if l := len("/books"); len(elem) >= l && elem[0:l] == "/books" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetBookSeriesBooksOperation
+											r.summary = "Get series books"
+											r.operationID = "getBookSeriesBooks"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/books/series/{id}/books"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+							elem = origElem
+						}
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetBookOperation
+								r.summary = "Get book"
+								r.operationID = "getBook"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/books/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cover"
+
+								if l := len("cover"); len(elem) >= l && elem[0:l] == "cover" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetBookCoverOperation
+										r.summary = "Get book cover"
+										r.operationID = "getBookCover"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/books/{id}/cover"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'f': // Prefix: "file"
+
+								if l := len("file"); len(elem) >= l && elem[0:l] == "file" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = DownloadBookFileOperation
+										r.summary = "Download book file"
+										r.operationID = "downloadBookFile"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/books/{id}/file"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "p"
+
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ages/"
+
+									if l := len("ages/"); len(elem) >= l && elem[0:l] == "ages/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "page"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetBookPageOperation
+											r.summary = "Get comic page"
+											r.operationID = "getBookPage"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/books/{id}/pages/{page}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "rogress"
+
+									if l := len("rogress"); len(elem) >= l && elem[0:l] == "rogress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteBookProgressOperation
+											r.summary = "Delete reading progress"
+											r.operationID = "deleteBookProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/books/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetBookProgressOperation
+											r.summary = "Get reading progress"
+											r.operationID = "getBookProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/books/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = UpdateBookProgressOperation
+											r.summary = "Update reading progress"
+											r.operationID = "updateBookProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/books/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'c': // Prefix: "collections/"
+
+					if l := len("collections/"); len(elem) >= l && elem[0:l] == "collections/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetCollectionOperation
+							r.summary = "Get collection details"
+							r.operationID = "getCollection"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/collections/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/movies"
+
+						if l := len("/movies"); len(elem) >= l && elem[0:l] == "/movies" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetCollectionMoviesOperation
+								r.summary = "Get collection movies"
+								r.operationID = "getCollectionMovies"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/collections/{id}/movies"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'g': // Prefix: "genres"
+
+					if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListGenresOperation
+							r.summary = "List all genres"
+							r.operationID = "listGenres"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/genres"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'h': // Prefix: "home"
+
+					if l := len("home"); len(elem) >= l && elem[0:l] == "home" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetHomeFeedOperation
+							r.summary = "Get the home feed"
+							r.operationID = "getHomeFeed"
+							r.operationGroup = ""
+							r.pathPattern = "/api/v1/home"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/rows/"
+
+						if l := len("/rows/"); len(elem) >= l && elem[0:l] == "/rows/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetHomeFeedRowOperation
+								r.summary = "Get a home feed row"
+								r.operationID = "getHomeFeedRow"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/home/rows/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'v': // Prefix: "videos"
+
+						if l := len("videos"); len(elem) >= l && elem[0:l] == "videos" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListHomeVideosOperation
+								r.summary = "List home videos"
+								r.operationID = "listHomeVideos"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/homevideos"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "continue-watching"
+								origElem := elem
+								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ListHomeVideoContinueWatchingOperation
+										r.summary = "Get home videos to continue watching"
+										r.operationID = "listHomeVideoContinueWatching"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/homevideos/continue-watching"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'f': // Prefix: "folders"
+								origElem := elem
+								if l := len("folders"); len(elem) >= l && elem[0:l] == "folders" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListHomeVideoFoldersOperation
+										r.summary = "List home video folders"
+										r.operationID = "listHomeVideoFolders"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/homevideos/folders"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetHomeVideoFolderOperation
+											r.summary = "Get home video folder"
+											r.operationID = "getHomeVideoFolder"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/homevideos/folders/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetHomeVideoOperation
+									r.summary = "Get home video"
+									r.operationID = "getHomeVideo"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/homevideos/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "progress"
+
+									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteHomeVideoProgressOperation
+											r.summary = "Delete home video watch progress"
+											r.operationID = "deleteHomeVideoProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/homevideos/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetHomeVideoProgressOperation
+											r.summary = "Get home video watch progress"
+											r.operationID = "getHomeVideoProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/homevideos/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = UpdateHomeVideoProgressOperation
+											r.summary = "Update home video watch progress"
+											r.operationID = "updateHomeVideoProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/homevideos/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 't': // Prefix: "thumbnail"
+
+									if l := len("thumbnail"); len(elem) >= l && elem[0:l] == "thumbnail" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetHomeVideoThumbnailOperation
+											r.summary = "Get home video thumbnail"
+											r.operationID = "getHomeVideoThumbnail"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/homevideos/{id}/thumbnail"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'i': // Prefix: "images/"
+
+					if l := len("images/"); len(elem) >= l && elem[0:l] == "images/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "type"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "size"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[1] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "path"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[2] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetProxiedImageOperation
+									r.summary = "Proxy image from TMDb"
+									r.operationID = "getProxiedImage"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/images/{type}/{size}/{path}"
+									r.args = args
+									r.count = 3
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'l': // Prefix: "l"
+
+					if l := len("l"); len(elem) >= l && elem[0:l] == "l" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "egacy/"
+
+						if l := len("egacy/"); len(elem) >= l && elem[0:l] == "egacy/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "access"
+
+							if l := len("access"); len(elem) >= l && elem[0:l] == "access" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetLegacyAccessOperation
+									r.summary = "Get QAR access status"
+									r.operationID = "getLegacyAccess"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/legacy/access"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'l': // Prefix: "lock"
+
+							if l := len("lock"); len(elem) >= l && elem[0:l] == "lock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = LockLegacyOperation
+									r.summary = "Lock QAR content"
+									r.operationID = "lockLegacy"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/legacy/lock"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'p': // Prefix: "p"
+
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "erformers"
+
+								if l := len("erformers"); len(elem) >= l && elem[0:l] == "erformers" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListLegacyPerformersOperation
+										r.summary = "List performers"
+										r.operationID = "listLegacyPerformers"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/legacy/performers"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetLegacyPerformerOperation
+											r.summary = "Get performer"
+											r.operationID = "getLegacyPerformer"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/legacy/performers/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'i': // Prefix: "in"
+
+								if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "PUT":
+										r.name = SetLegacyPINOperation
+										r.summary = "Set QAR PIN"
+										r.operationID = "setLegacyPIN"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/legacy/pin"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 's': // Prefix: "s"
+
+							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "cenes"
+
+								if l := len("cenes"); len(elem) >= l && elem[0:l] == "cenes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListLegacyScenesOperation
+										r.summary = "List scenes"
+										r.operationID = "listLegacyScenes"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/legacy/scenes"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetLegacySceneOperation
+											r.summary = "Get scene"
+											r.operationID = "getLegacyScene"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/legacy/scenes/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/file"
+
+										if l := len("/file"); len(elem) >= l && elem[0:l] == "/file" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = DownloadLegacySceneFileOperation
+												r.summary = "Download scene file"
+												r.operationID = "downloadLegacySceneFile"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/legacy/scenes/{id}/file"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 't': // Prefix: "tudios"
+
+								if l := len("tudios"); len(elem) >= l && elem[0:l] == "tudios" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListLegacyStudiosOperation
+										r.summary = "List studios"
+										r.operationID = "listLegacyStudios"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/legacy/studios"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetLegacyStudioOperation
+											r.summary = "Get studio"
+											r.operationID = "getLegacyStudio"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/legacy/studios/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'u': // Prefix: "unlock"
+
+							if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = UnlockLegacyOperation
+									r.summary = "Unlock QAR content"
+									r.operationID = "unlockLegacy"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/legacy/unlock"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 'i': // Prefix: "i"
+
+						if l := len("i"); len(elem) >= l && elem[0:l] == "i" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "braries"
+
+							if l := len("braries"); len(elem) >= l && elem[0:l] == "braries" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = ListLibrariesOperation
+									r.summary = "List accessible libraries"
+									r.operationID = "listLibraries"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/libraries"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = CreateLibraryOperation
+									r.summary = "Create a new library (admin)"
+									r.operationID = "createLibrary"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/libraries"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "libraryId"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = DeleteLibraryOperation
+										r.summary = "Delete a library (admin)"
+										r.operationID = "deleteLibrary"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/libraries/{libraryId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "GET":
+										r.name = GetLibraryOperation
+										r.summary = "Get library details"
+										r.operationID = "getLibrary"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/libraries/{libraryId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = UpdateLibraryOperation
+										r.summary = "Update a library (admin)"
+										r.operationID = "updateLibrary"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/libraries/{libraryId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'p': // Prefix: "permissions"
+
+										if l := len("permissions"); len(elem) >= l && elem[0:l] == "permissions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = ListLibraryPermissionsOperation
+												r.summary = "List library permissions (admin)"
+												r.operationID = "listLibraryPermissions"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/libraries/{libraryId}/permissions"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "POST":
+												r.name = GrantLibraryPermissionOperation
+												r.summary = "Grant library permission (admin)"
+												r.operationID = "grantLibraryPermission"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/libraries/{libraryId}/permissions"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "userId"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "DELETE":
+													r.name = RevokeLibraryPermissionOperation
+													r.summary = "Revoke library permission (admin)"
+													r.operationID = "revokeLibraryPermission"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/libraries/{libraryId}/permissions/{userId}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									case 's': // Prefix: "scan"
+
+										if l := len("scan"); len(elem) >= l && elem[0:l] == "scan" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "POST":
+												r.name = TriggerLibraryScanOperation
+												r.summary = "Trigger library scan (admin)"
+												r.operationID = "triggerLibraryScan"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/libraries/{libraryId}/scan"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = ListLibraryScansOperation
+													r.summary = "List library scans"
+													r.operationID = "listLibraryScans"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/libraries/{libraryId}/scans"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "scanId"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[1] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/cancel"
+
+													if l := len("/cancel"); len(elem) >= l && elem[0:l] == "/cancel" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "POST":
+															r.name = CancelLibraryScanOperation
+															r.summary = "Cancel library scan (admin)"
+															r.operationID = "cancelLibraryScan"
+															r.operationGroup = ""
+															r.pathPattern = "/api/v1/libraries/{libraryId}/scans/{scanId}/cancel"
+															r.args = args
+															r.count = 2
+															return r, true
+														default:
+															return
+														}
+													}
+
+												}
+
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+						case 'v': // Prefix: "vetv/"
+
+							if l := len("vetv/"); len(elem) >= l && elem[0:l] == "vetv/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "channels"
+
+								if l := len("channels"); len(elem) >= l && elem[0:l] == "channels" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListLiveTVChannelsOperation
+										r.summary = "List channels"
+										r.operationID = "listLiveTVChannels"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/livetv/channels"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetLiveTVChannelOperation
+											r.summary = "Get channel"
+											r.operationID = "getLiveTVChannel"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/channels/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PATCH":
+											r.name = UpdateLiveTVChannelOperation
+											r.summary = "Update channel"
+											r.operationID = "updateLiveTVChannel"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/channels/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'g': // Prefix: "guide"
+
+								if l := len("guide"); len(elem) >= l && elem[0:l] == "guide" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetLiveTVGuideOperation
+										r.summary = "Get program guide"
+										r.operationID = "getLiveTVGuide"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/livetv/guide"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "programs/"
+
+								if l := len("programs/"); len(elem) >= l && elem[0:l] == "programs/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetLiveTVProgramOperation
+										r.summary = "Get program"
+										r.operationID = "getLiveTVProgram"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/livetv/programs/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "recordings"
+
+								if l := len("recordings"); len(elem) >= l && elem[0:l] == "recordings" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListLiveTVRecordingsOperation
+										r.summary = "List recordings"
+										r.operationID = "listLiveTVRecordings"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/livetv/recordings"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = CreateLiveTVRecordingOperation
+										r.summary = "Schedule recording"
+										r.operationID = "createLiveTVRecording"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/livetv/recordings"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteLiveTVRecordingOperation
+											r.summary = "Delete recording"
+											r.operationID = "deleteLiveTVRecording"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/recordings/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetLiveTVRecordingOperation
+											r.summary = "Get recording"
+											r.operationID = "getLiveTVRecording"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/recordings/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 's': // Prefix: "s"
+
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "eries-rules"
+
+									if l := len("eries-rules"); len(elem) >= l && elem[0:l] == "eries-rules" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListLiveTVSeriesRulesOperation
+											r.summary = "List series rules"
+											r.operationID = "listLiveTVSeriesRules"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/series-rules"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = CreateLiveTVSeriesRuleOperation
+											r.summary = "Add series rule"
+											r.operationID = "createLiveTVSeriesRule"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/series-rules"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = DeleteLiveTVSeriesRuleOperation
+												r.summary = "Delete series rule"
+												r.operationID = "deleteLiveTVSeriesRule"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/livetv/series-rules/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'o': // Prefix: "ources"
+
+									if l := len("ources"); len(elem) >= l && elem[0:l] == "ources" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListLiveTVSourcesOperation
+											r.summary = "List sources"
+											r.operationID = "listLiveTVSources"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/sources"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = CreateLiveTVSourceOperation
+											r.summary = "Add source"
+											r.operationID = "createLiveTVSource"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/livetv/sources"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "DELETE":
+												r.name = DeleteLiveTVSourceOperation
+												r.summary = "Delete source"
+												r.operationID = "deleteLiveTVSource"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/livetv/sources/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/refresh"
+
+											if l := len("/refresh"); len(elem) >= l && elem[0:l] == "/refresh" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = RefreshLiveTVSourceOperation
+													r.summary = "Refresh source"
+													r.operationID = "refreshLiveTVSource"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/livetv/sources/{id}/refresh"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'm': // Prefix: "m"
+
+					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'e': // Prefix: "etadata/"
+
+						if l := len("etadata/"); len(elem) >= l && elem[0:l] == "etadata/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "collection/"
+
+							if l := len("collection/"); len(elem) >= l && elem[0:l] == "collection/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetCollectionMetadataOperation
+									r.summary = "Get collection details from TMDb"
+									r.operationID = "getCollectionMetadata"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/metadata/collection/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'm': // Prefix: "movie/"
+
+							if l := len("movie/"); len(elem) >= l && elem[0:l] == "movie/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetMovieMetadataOperation
+									r.summary = "Get movie details from TMDb"
+									r.operationID = "getMovieMetadata"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/metadata/movie/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "credits"
+
+									if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieMetadataCreditsOperation
+											r.summary = "Get movie credits from metadata provider"
+											r.operationID = "getMovieMetadataCredits"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/movie/{id}/credits"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'e': // Prefix: "external-ids"
+
+									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieExternalIDsOperation
+											r.summary = "Get movie external IDs"
+											r.operationID = "getMovieExternalIDs"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/movie/{id}/external-ids"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'i': // Prefix: "images"
+
+									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieMetadataImagesOperation
+											r.summary = "Get movie images from metadata provider"
+											r.operationID = "getMovieMetadataImages"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/movie/{id}/images"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "recommendations"
+
+									if l := len("recommendations"); len(elem) >= l && elem[0:l] == "recommendations" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieRecommendationsMetadataOperation
+											r.summary = "Get movie recommendations from metadata provider"
+											r.operationID = "getMovieRecommendationsMetadata"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/movie/{id}/recommendations"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "similar"
+
+									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetSimilarMoviesMetadataOperation
+											r.summary = "Get similar movies from metadata provider"
+											r.operationID = "getSimilarMoviesMetadata"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/movie/{id}/similar"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'p': // Prefix: "p"
+
+							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'e': // Prefix: "erson/"
+
+								if l := len("erson/"); len(elem) >= l && elem[0:l] == "erson/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetPersonMetadataOperation
+										r.summary = "Get person details from metadata provider"
+										r.operationID = "getPersonMetadata"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/metadata/person/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'c': // Prefix: "credits"
+
+										if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetPersonMetadataCreditsOperation
+												r.summary = "Get person credits from metadata provider"
+												r.operationID = "getPersonMetadataCredits"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/metadata/person/{id}/credits"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'i': // Prefix: "images"
+
+										if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetPersonMetadataImagesOperation
+												r.summary = "Get person images from metadata provider"
+												r.operationID = "getPersonMetadataImages"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/metadata/person/{id}/images"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'r': // Prefix: "roviders"
+
+								if l := len("roviders"); len(elem) >= l && elem[0:l] == "roviders" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = ListMetadataProvidersOperation
+										r.summary = "List available metadata providers"
+										r.operationID = "listMetadataProviders"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/metadata/providers"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 's': // Prefix: "search/"
+
+							if l := len("search/"); len(elem) >= l && elem[0:l] == "search/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "movie"
+
+								if l := len("movie"); len(elem) >= l && elem[0:l] == "movie" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = SearchMoviesMetadataOperation
+										r.summary = "Search movies via metadata provider"
+										r.operationID = "searchMoviesMetadata"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/metadata/search/movie"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "person"
+
+								if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = SearchPersonMetadataOperation
+										r.summary = "Search people via metadata provider"
+										r.operationID = "searchPersonMetadata"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/metadata/search/person"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 't': // Prefix: "tv"
+
+								if l := len("tv"); len(elem) >= l && elem[0:l] == "tv" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = SearchTVShowsMetadataOperation
+										r.summary = "Search TV shows via metadata provider"
+										r.operationID = "searchTVShowsMetadata"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/metadata/search/tv"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 't': // Prefix: "tv/"
+
+							if l := len("tv/"); len(elem) >= l && elem[0:l] == "tv/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetTVShowMetadataOperation
+									r.summary = "Get TV show details from TMDb"
+									r.operationID = "getTVShowMetadata"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/metadata/tv/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'o': // Prefix: "ontent-ratings"
+
+										if l := len("ontent-ratings"); len(elem) >= l && elem[0:l] == "ontent-ratings" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVShowContentRatingsOperation
+												r.summary = "Get TV show content ratings"
+												r.operationID = "getTVShowContentRatings"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/metadata/tv/{id}/content-ratings"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'r': // Prefix: "redits"
+
+										if l := len("redits"); len(elem) >= l && elem[0:l] == "redits" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVShowMetadataCreditsOperation
+												r.summary = "Get TV show credits from metadata provider"
+												r.operationID = "getTVShowMetadataCredits"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/metadata/tv/{id}/credits"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'e': // Prefix: "external-ids"
+
+									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetTVShowExternalIDsOperation
+											r.summary = "Get TV show external IDs"
+											r.operationID = "getTVShowExternalIDs"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/tv/{id}/external-ids"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'i': // Prefix: "images"
+
+									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetTVShowMetadataImagesOperation
+											r.summary = "Get TV show images from metadata provider"
+											r.operationID = "getTVShowMetadataImages"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/tv/{id}/images"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 's': // Prefix: "season/"
+
+									if l := len("season/"); len(elem) >= l && elem[0:l] == "season/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "seasonNumber"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetSeasonMetadataOperation
+											r.summary = "Get season details from TMDb"
+											r.operationID = "getSeasonMetadata"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "episode/"
+
+											if l := len("episode/"); len(elem) >= l && elem[0:l] == "episode/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "episodeNumber"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[2] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = GetEpisodeMetadataOperation
+													r.summary = "Get episode details from TMDb"
+													r.operationID = "getEpisodeMetadata"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}/episode/{episodeNumber}"
+													r.args = args
+													r.count = 3
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/images"
+
+												if l := len("/images"); len(elem) >= l && elem[0:l] == "/images" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetEpisodeMetadataImagesOperation
+														r.summary = "Get episode images from metadata provider"
+														r.operationID = "getEpisodeMetadataImages"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}/episode/{episodeNumber}/images"
+														r.args = args
+														r.count = 3
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										case 'i': // Prefix: "images"
+
+											if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetSeasonMetadataImagesOperation
+													r.summary = "Get season images from metadata provider"
+													r.operationID = "getSeasonMetadataImages"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}/images"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'f': // Prefix: "fa/"
+
+						if l := len("fa/"); len(elem) >= l && elem[0:l] == "fa/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "backup-codes/"
+
+							if l := len("backup-codes/"); len(elem) >= l && elem[0:l] == "backup-codes/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'g': // Prefix: "generate"
+
+								if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = GenerateBackupCodesOperation
+										r.summary = "Generate backup codes"
+										r.operationID = "generateBackupCodes"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/mfa/backup-codes/generate"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "regenerate"
+
+								if l := len("regenerate"); len(elem) >= l && elem[0:l] == "regenerate" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RegenerateBackupCodesOperation
+										r.summary = "Regenerate backup codes"
+										r.operationID = "regenerateBackupCodes"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/mfa/backup-codes/regenerate"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						case 'd': // Prefix: "disable"
+
+							if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = DisableMFAOperation
+									r.summary = "Disable MFA requirement"
+									r.operationID = "disableMFA"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/mfa/disable"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'e': // Prefix: "enable"
+
+							if l := len("enable"); len(elem) >= l && elem[0:l] == "enable" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = EnableMFAOperation
+									r.summary = "Enable MFA requirement"
+									r.operationID = "enableMFA"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/mfa/enable"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 's': // Prefix: "status"
+
+							if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetMFAStatusOperation
+									r.summary = "Get MFA status"
+									r.operationID = "getMFAStatus"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/mfa/status"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 't': // Prefix: "totp"
+
+							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = DisableTOTPOperation
+									r.summary = "Disable TOTP"
+									r.operationID = "disableTOTP"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/mfa/totp"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 's': // Prefix: "setup"
+
+									if l := len("setup"); len(elem) >= l && elem[0:l] == "setup" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = SetupTOTPOperation
+											r.summary = "Setup TOTP"
+											r.operationID = "setupTOTP"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/totp/setup"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'v': // Prefix: "verify"
+
+									if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = VerifyTOTPOperation
+											r.summary = "Verify and enable TOTP"
+											r.operationID = "verifyTOTP"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/totp/verify"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						case 'w': // Prefix: "webauthn/"
+
+							if l := len("webauthn/"); len(elem) >= l && elem[0:l] == "webauthn/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "credentials"
+
+								if l := len("credentials"); len(elem) >= l && elem[0:l] == "credentials" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListWebAuthnCredentialsOperation
+										r.summary = "List WebAuthn credentials"
+										r.operationID = "listWebAuthnCredentials"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/mfa/webauthn/credentials"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "credentialId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteWebAuthnCredentialOperation
+											r.summary = "Delete WebAuthn credential"
+											r.operationID = "deleteWebAuthnCredential"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/webauthn/credentials/{credentialId}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PATCH":
+											r.name = RenameWebAuthnCredentialOperation
+											r.summary = "Rename WebAuthn credential"
+											r.operationID = "renameWebAuthnCredential"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/webauthn/credentials/{credentialId}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'l': // Prefix: "login/"
+
+								if l := len("login/"); len(elem) >= l && elem[0:l] == "login/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "begin"
+
+									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = BeginWebAuthnLoginOperation
+											r.summary = "Begin WebAuthn login"
+											r.operationID = "beginWebAuthnLogin"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/webauthn/login/begin"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'f': // Prefix: "finish"
+
+									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = FinishWebAuthnLoginOperation
+											r.summary = "Finish WebAuthn login"
+											r.operationID = "finishWebAuthnLogin"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/webauthn/login/finish"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'r': // Prefix: "register/"
+
+								if l := len("register/"); len(elem) >= l && elem[0:l] == "register/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'b': // Prefix: "begin"
+
+									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = BeginWebAuthnRegistrationOperation
+											r.summary = "Begin WebAuthn registration"
+											r.operationID = "beginWebAuthnRegistration"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/webauthn/register/begin"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'f': // Prefix: "finish"
+
+									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = FinishWebAuthnRegistrationOperation
+											r.summary = "Finish WebAuthn registration"
+											r.operationID = "finishWebAuthnRegistration"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/mfa/webauthn/register/finish"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'o': // Prefix: "ovies"
+
+						if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListMoviesOperation
+								r.summary = "List movies"
+								r.operationID = "listMovies"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/movies"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "continue-watching"
+								origElem := elem
+								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetContinueWatchingOperation
+										r.summary = "Get continue watching list"
+										r.operationID = "getContinueWatching"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/movies/continue-watching"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "recently-added"
+								origElem := elem
+								if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetRecentlyAddedOperation
+										r.summary = "Get recently added movies"
+										r.operationID = "getRecentlyAdded"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/movies/recently-added"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 's': // Prefix: "s"
+								origElem := elem
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "earch"
+
+									if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = SearchMoviesOperation
+											r.summary = "Search movies"
+											r.operationID = "searchMovies"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/search"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 't': // Prefix: "tats"
+
+									if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetUserMovieStatsOperation
+											r.summary = "Get user movie statistics"
+											r.operationID = "getUserMovieStats"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/stats"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+								elem = origElem
+							case 't': // Prefix: "top-rated"
+								origElem := elem
+								if l := len("top-rated"); len(elem) >= l && elem[0:l] == "top-rated" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetTopRatedOperation
+										r.summary = "Get top-rated movies"
+										r.operationID = "getTopRated"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/movies/top-rated"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'w': // Prefix: "watch-history"
+								origElem := elem
+								if l := len("watch-history"); len(elem) >= l && elem[0:l] == "watch-history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetWatchHistoryOperation
+										r.summary = "Get watch history"
+										r.operationID = "getWatchHistory"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/movies/watch-history"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetMovieOperation
+									r.summary = "Get movie details"
+									r.operationID = "getMovie"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/movies/{id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "artwork"
+
+									if l := len("artwork"); len(elem) >= l && elem[0:l] == "artwork" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListMovieArtworkOperation
+											r.summary = "List movie artwork"
+											r.operationID = "listMovieArtwork"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/artwork"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "artworkId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/select"
+
+											if l := len("/select"); len(elem) >= l && elem[0:l] == "/select" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = SelectMovieArtworkOperation
+													r.summary = "Select movie artwork (admin)"
+													r.operationID = "selectMovieArtwork"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/movies/{id}/artwork/{artworkId}/select"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 'c': // Prefix: "c"
+
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "ast"
+
+										if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMovieCastOperation
+												r.summary = "Get movie cast"
+												r.operationID = "getMovieCast"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/movies/{id}/cast"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'o': // Prefix: "ollection"
+
+										if l := len("ollection"); len(elem) >= l && elem[0:l] == "ollection" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMovieCollectionOperation
+												r.summary = "Get movie collection"
+												r.operationID = "getMovieCollection"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/movies/{id}/collection"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'r': // Prefix: "rew"
+
+										if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMovieCrewOperation
+												r.summary = "Get movie crew"
+												r.operationID = "getMovieCrew"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/movies/{id}/crew"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'e': // Prefix: "e"
+
+									if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "ditions"
+
+										if l := len("ditions"); len(elem) >= l && elem[0:l] == "ditions" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMovieEditionsOperation
+												r.summary = "Get movie editions"
+												r.operationID = "getMovieEditions"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/movies/{id}/editions"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'x': // Prefix: "xtras"
+
+										if l := len("xtras"); len(elem) >= l && elem[0:l] == "xtras" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMovieExtrasOperation
+												r.summary = "Get movie extras"
+												r.operationID = "getMovieExtras"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/movies/{id}/extras"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'f': // Prefix: "files"
+
+									if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieFilesOperation
+											r.summary = "Get movie files"
+											r.operationID = "getMovieFiles"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/files"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'g': // Prefix: "genres"
+
+									if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieGenresOperation
+											r.summary = "Get movie genres"
+											r.operationID = "getMovieGenres"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/genres"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'm': // Prefix: "metadata"
+
+									if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "PATCH":
+											r.name = UpdateMovieMetadataOperation
+											r.summary = "Edit movie metadata (admin)"
+											r.operationID = "updateMovieMetadata"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/metadata"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'h': // Prefix: "history"
+
+											if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = ListMovieMetadataHistoryOperation
+													r.summary = "List movie metadata history (admin)"
+													r.operationID = "listMovieMetadataHistory"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/movies/{id}/metadata/history"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "version"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[1] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/rollback"
+
+													if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "POST":
+															r.name = RollbackMovieMetadataOperation
+															r.summary = "Roll back movie metadata (admin)"
+															r.operationID = "rollbackMovieMetadata"
+															r.operationGroup = ""
+															r.pathPattern = "/api/v1/movies/{id}/metadata/history/{version}/rollback"
+															r.args = args
+															r.count = 2
+															return r, true
+														default:
+															return
+														}
+													}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'f': // Prefix: "fresh"
+												}
 
-								if l := len("fresh"); len(elem) >= l && elem[0:l] == "fresh" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+											}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = RefreshTokenOperation
-										r.summary = "Refresh access token"
-										r.operationID = "refreshToken"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/auth/refresh"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
+										case 'l': // Prefix: "locks/"
 
-							case 'g': // Prefix: "gister"
+											if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-								if l := len("gister"); len(elem) >= l && elem[0:l] == "gister" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+											// Param: "field"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = RegisterOperation
-										r.summary = "Register new user"
-										r.operationID = "register"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/auth/register"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "DELETE":
+													r.name = UnlockMovieMetadataFieldOperation
+													r.summary = "Unlock movie metadata field (admin)"
+													r.operationID = "unlockMovieMetadataField"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/movies/{id}/metadata/locks/{field}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
 
-							case 's': // Prefix: "se"
+										}
 
-								if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									}
 
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'n': // Prefix: "nd-verification"
+								case 'p': // Prefix: "progress"
 
-									if l := len("nd-verification"); len(elem) >= l && elem[0:l] == "nd-verification" {
+									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
 										elem = elem[l:]
 									} else {
 										break
@@ -5790,23 +14200,41 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
+										case "DELETE":
+											r.name = DeleteWatchProgressOperation
+											r.summary = "Delete watch progress"
+											r.operationID = "deleteWatchProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetWatchProgressOperation
+											r.summary = "Get watch progress"
+											r.operationID = "getWatchProgress"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/progress"
+											r.args = args
+											r.count = 1
+											return r, true
 										case "POST":
-											r.name = ResendVerificationOperation
-											r.summary = "Resend verification email"
-											r.operationID = "resendVerification"
+											r.name = UpdateWatchProgressOperation
+											r.summary = "Update watch progress"
+											r.operationID = "updateWatchProgress"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/auth/resend-verification"
+											r.pathPattern = "/api/v1/movies/{id}/progress"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								case 't': // Prefix: "t-password"
+								case 'r': // Prefix: "refresh"
 
-									if l := len("t-password"); len(elem) >= l && elem[0:l] == "t-password" {
+									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
 										elem = elem[l:]
 									} else {
 										break
@@ -5816,175 +14244,108 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "POST":
-											r.name = ResetPasswordOperation
-											r.summary = "Reset password"
-											r.operationID = "resetPassword"
+											r.name = RefreshMovieMetadataOperation
+											r.summary = "Refresh movie metadata"
+											r.operationID = "refreshMovieMetadata"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/auth/reset-password"
+											r.pathPattern = "/api/v1/movies/{id}/refresh"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								}
-
-							}
-
-						case 'v': // Prefix: "verify-email"
-
-							if l := len("verify-email"); len(elem) >= l && elem[0:l] == "verify-email" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = VerifyEmailOperation
-									r.summary = "Verify email"
-									r.operationID = "verifyEmail"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/auth/verify-email"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						}
-
-					}
-
-				case 'c': // Prefix: "collections/"
-
-					if l := len("collections/"); len(elem) >= l && elem[0:l] == "collections/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								case 's': // Prefix: "similar"
 
-					// Param: "id"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = GetCollectionOperation
-							r.summary = "Get collection details"
-							r.operationID = "getCollection"
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/collections/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/movies"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetSimilarMoviesOperation
+											r.summary = "Get similar movies"
+											r.operationID = "getSimilarMovies"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/similar"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
 
-						if l := len("/movies"); len(elem) >= l && elem[0:l] == "/movies" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+								case 't': // Prefix: "tags"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = GetCollectionMoviesOperation
-								r.summary = "Get collection movies"
-								r.operationID = "getCollectionMovies"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/collections/{id}/movies"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
+									if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetMovieTagsOperation
+											r.summary = "Get movie tags"
+											r.operationID = "getMovieTags"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/tags"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
 
-				case 'g': // Prefix: "genres"
+								case 'w': // Prefix: "watched"
 
-					if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = ListGenresOperation
-							r.summary = "List all genres"
-							r.operationID = "listGenres"
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/genres"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = MarkAsWatchedOperation
+											r.summary = "Mark movie as watched"
+											r.operationID = "markAsWatched"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/movies/{id}/watched"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
 
-				case 'i': // Prefix: "images/"
+								}
 
-					if l := len("images/"); len(elem) >= l && elem[0:l] == "images/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							}
 
-					// Param: "type"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
+						}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+					case 'u': // Prefix: "usic"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("usic"); len(elem) >= l && elem[0:l] == "usic" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "size"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[1] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							break
 						}
@@ -5997,217 +14358,387 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							// Param: "path"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
+							if len(elem) == 0 {
 								break
 							}
-							args[2] = elem
-							elem = ""
+							switch elem[0] {
+							case 'a': // Prefix: "a"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetProxiedImageOperation
-									r.summary = "Proxy image from TMDb"
-									r.operationID = "getProxiedImage"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/images/{type}/{size}/{path}"
-									r.args = args
-									r.count = 3
-									return r, true
-								default:
-									return
+								if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+									elem = elem[l:]
+								} else {
+									break
 								}
-							}
 
-						}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lbums"
 
-					}
+									if l := len("lbums"); len(elem) >= l && elem[0:l] == "lbums" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				case 'l': // Prefix: "libraries"
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListMusicAlbumsOperation
+											r.summary = "List music albums"
+											r.operationID = "listMusicAlbums"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/music/albums"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-					if l := len("libraries"); len(elem) >= l && elem[0:l] == "libraries" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = ListLibrariesOperation
-							r.summary = "List accessible libraries"
-							r.operationID = "listLibraries"
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/libraries"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = CreateLibraryOperation
-							r.summary = "Create a new library (admin)"
-							r.operationID = "createLibrary"
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/libraries"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetMusicAlbumOperation
+												r.summary = "Get music album"
+												r.operationID = "getMusicAlbum"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/music/albums/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/editions"
+
+											if l := len("/editions"); len(elem) >= l && elem[0:l] == "/editions" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetMusicAlbumEditionsOperation
+													r.summary = "Get album editions"
+													r.operationID = "getMusicAlbumEditions"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/music/albums/{id}/editions"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 'r': // Prefix: "rtists"
+
+									if l := len("rtists"); len(elem) >= l && elem[0:l] == "rtists" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListMusicArtistsOperation
+											r.summary = "List music artists"
+											r.operationID = "listMusicArtists"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/music/artists"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetMusicArtistOperation
+												r.summary = "Get music artist"
+												r.operationID = "getMusicArtist"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/music/artists/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'a': // Prefix: "albums"
+
+												if l := len("albums"); len(elem) >= l && elem[0:l] == "albums" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetMusicArtistAlbumsOperation
+														r.summary = "Get artist albums"
+														r.operationID = "getMusicArtistAlbums"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/music/artists/{id}/albums"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 't': // Prefix: "tracks"
+
+												if l := len("tracks"); len(elem) >= l && elem[0:l] == "tracks" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetMusicArtistTracksOperation
+														r.summary = "Get artist tracks"
+														r.operationID = "getMusicArtistTracks"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/music/artists/{id}/tracks"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									}
+
+								}
+
+							case 't': // Prefix: "tracks/"
+
+								if l := len("tracks/"); len(elem) >= l && elem[0:l] == "tracks/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-						// Param: "libraryId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetMusicTrackOperation
+										r.summary = "Get music track"
+										r.operationID = "getMusicTrack"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/music/tracks/{id}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-						if len(elem) == 0 {
-							switch method {
-							case "DELETE":
-								r.name = DeleteLibraryOperation
-								r.summary = "Delete a library (admin)"
-								r.operationID = "deleteLibrary"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/libraries/{libraryId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "GET":
-								r.name = GetLibraryOperation
-								r.summary = "Get library details"
-								r.operationID = "getLibrary"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/libraries/{libraryId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "PUT":
-								r.name = UpdateLibraryOperation
-								r.summary = "Update a library (admin)"
-								r.operationID = "updateLibrary"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/libraries/{libraryId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
 							}
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						case 'v': // Prefix: "videos"
+
+							if l := len("videos"); len(elem) >= l && elem[0:l] == "videos" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								break
+								switch method {
+								case "GET":
+									r.name = ListMusicVideosOperation
+									r.summary = "List music videos"
+									r.operationID = "listMusicVideos"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/musicvideos"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
 							switch elem[0] {
-							case 'p': // Prefix: "permissions"
+							case '/': // Prefix: "/"
 
-								if l := len("permissions"); len(elem) >= l && elem[0:l] == "permissions" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										r.name = ListLibraryPermissionsOperation
-										r.summary = "List library permissions (admin)"
-										r.operationID = "listLibraryPermissions"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/libraries/{libraryId}/permissions"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "POST":
-										r.name = GrantLibraryPermissionOperation
-										r.summary = "Grant library permission (admin)"
-										r.operationID = "grantLibraryPermission"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/libraries/{libraryId}/permissions"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/"
-
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								case 'a': // Prefix: "artists"
+									origElem := elem
+									if l := len("artists"); len(elem) >= l && elem[0:l] == "artists" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "userId"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
-									}
-									args[1] = elem
-									elem = ""
-
 									if len(elem) == 0 {
-										// Leaf node.
 										switch method {
-										case "DELETE":
-											r.name = RevokeLibraryPermissionOperation
-											r.summary = "Revoke library permission (admin)"
-											r.operationID = "revokeLibraryPermission"
+										case "GET":
+											r.name = ListMusicVideoArtistsOperation
+											r.summary = "List music video artists"
+											r.operationID = "listMusicVideoArtists"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/libraries/{libraryId}/permissions/{userId}"
+											r.pathPattern = "/api/v1/musicvideos/artists"
 											r.args = args
-											r.count = 2
+											r.count = 0
 											return r, true
 										default:
 											return
 										}
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-								}
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							case 's': // Prefix: "scan"
+										// Param: "id"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
 
-								if l := len("scan"); len(elem) >= l && elem[0:l] == "scan" {
-									elem = elem[l:]
-								} else {
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMusicVideoArtistOperation
+												r.summary = "Get music video artist"
+												r.operationID = "getMusicVideoArtist"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/musicvideos/artists/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+									elem = origElem
+								}
+								// Param: "id"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
 									break
 								}
+								args[0] = elem
+								elem = ""
 
 								if len(elem) == 0 {
+									// Leaf node.
 									switch method {
-									case "POST":
-										r.name = TriggerLibraryScanOperation
-										r.summary = "Trigger library scan (admin)"
-										r.operationID = "triggerLibraryScan"
+									case "GET":
+										r.name = GetMusicVideoOperation
+										r.summary = "Get music video"
+										r.operationID = "getMusicVideo"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/libraries/{libraryId}/scan"
+										r.pathPattern = "/api/v1/musicvideos/{id}"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -6215,43 +14746,123 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
-								switch elem[0] {
-								case 's': // Prefix: "s"
 
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = ListLibraryScansOperation
-											r.summary = "List library scans"
-											r.operationID = "listLibraryScans"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/libraries/{libraryId}/scans"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+						}
 
-								}
+					}
+
+				case 'o': // Prefix: "oidc/"
+
+					if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "auth/"
+
+						if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "provider"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = OidcAuthorizeOperation
+								r.summary = "Initiate OIDC login"
+								r.operationID = "oidcAuthorize"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/oidc/auth/{provider}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'c': // Prefix: "callback/"
+
+						if l := len("callback/"); len(elem) >= l && elem[0:l] == "callback/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "provider"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
 
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = OidcCallbackOperation
+								r.summary = "Handle OIDC callback"
+								r.operationID = "oidcCallback"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/oidc/callback/{provider}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'p': // Prefix: "providers"
+
+						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListOIDCProvidersOperation
+								r.summary = "List enabled OIDC providers"
+								r.operationID = "listOIDCProviders"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/oidc/providers"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
 							}
-
 						}
 
 					}
 
-				case 'm': // Prefix: "m"
+				case 'p': // Prefix: "p"
 
-					if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+					if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 						elem = elem[l:]
 					} else {
 						break
@@ -6261,229 +14872,132 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'e': // Prefix: "etadata/"
+					case 'e': // Prefix: "eople/"
 
-						if l := len("etadata/"); len(elem) >= l && elem[0:l] == "etadata/" {
+						if l := len("eople/"); len(elem) >= l && elem[0:l] == "eople/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "personId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = GetPersonOperation
+								r.summary = "Get person details"
+								r.operationID = "getPerson"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/people/{personId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
-						case 'c': // Prefix: "collection/"
+						case '/': // Prefix: "/"
 
-							if l := len("collection/"); len(elem) >= l && elem[0:l] == "collection/" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "id"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[0] = elem
-							elem = ""
-
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetCollectionMetadataOperation
-									r.summary = "Get collection details from TMDb"
-									r.operationID = "getCollectionMetadata"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/metadata/collection/{id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 'm': // Prefix: "movie/"
-
-							if l := len("movie/"); len(elem) >= l && elem[0:l] == "movie/" {
-								elem = elem[l:]
-							} else {
 								break
 							}
-
-							// Param: "id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
-
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = GetMovieMetadataOperation
-									r.summary = "Get movie details from TMDb"
-									r.operationID = "getMovieMetadata"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/metadata/movie/{id}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'f': // Prefix: "filmography"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("filmography"); len(elem) >= l && elem[0:l] == "filmography" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'c': // Prefix: "credits"
-
-									if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetMovieMetadataCreditsOperation
-											r.summary = "Get movie credits from metadata provider"
-											r.operationID = "getMovieMetadataCredits"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/movie/{id}/credits"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'e': // Prefix: "external-ids"
-
-									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetMovieExternalIDsOperation
-											r.summary = "Get movie external IDs"
-											r.operationID = "getMovieExternalIDs"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/movie/{id}/external-ids"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'i': // Prefix: "images"
-
-									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetMovieMetadataImagesOperation
-											r.summary = "Get movie images from metadata provider"
-											r.operationID = "getMovieMetadataImages"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/movie/{id}/images"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetPersonFilmographyOperation
+										r.summary = "Get person filmography"
+										r.operationID = "getPersonFilmography"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/people/{personId}/filmography"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
 									}
+								}
 
-								case 'r': // Prefix: "recommendations"
+							case 'r': // Prefix: "refresh"
 
-									if l := len("recommendations"); len(elem) >= l && elem[0:l] == "recommendations" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetMovieRecommendationsMetadataOperation
-											r.summary = "Get movie recommendations from metadata provider"
-											r.operationID = "getMovieRecommendationsMetadata"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/movie/{id}/recommendations"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RefreshPersonOperation
+										r.summary = "Refresh person metadata (admin)"
+										r.operationID = "refreshPerson"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/people/{personId}/refresh"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
 									}
+								}
 
-								case 's': // Prefix: "similar"
-
-									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+							}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetSimilarMoviesMetadataOperation
-											r.summary = "Get similar movies from metadata provider"
-											r.operationID = "getSimilarMoviesMetadata"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/movie/{id}/similar"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+						}
 
-								}
+					case 'h': // Prefix: "hotos"
 
-							}
+						if l := len("hotos"); len(elem) >= l && elem[0:l] == "hotos" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-						case 'p': // Prefix: "p"
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListPhotosOperation
+								r.summary = "List photos"
+								r.operationID = "listPhotos"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/photos"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-							if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -6493,33 +15007,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'e': // Prefix: "erson/"
-
-								if l := len("erson/"); len(elem) >= l && elem[0:l] == "erson/" {
+							case 'a': // Prefix: "albums"
+								origElem := elem
+								if l := len("albums"); len(elem) >= l && elem[0:l] == "albums" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "id"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[0] = elem[:idx]
-								elem = elem[idx:]
-
 								if len(elem) == 0 {
 									switch method {
 									case "GET":
-										r.name = GetPersonMetadataOperation
-										r.summary = "Get person details from metadata provider"
-										r.operationID = "getPersonMetadata"
+										r.name = ListPhotoAlbumsOperation
+										r.summary = "List photo albums"
+										r.operationID = "listPhotoAlbums"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/metadata/person/{id}"
+										r.pathPattern = "/api/v1/photos/albums"
 										r.args = args
-										r.count = 1
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = CreatePhotoAlbumOperation
+										r.summary = "Create photo album"
+										r.operationID = "createPhotoAlbum"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/photos/albums"
+										r.args = args
+										r.count = 0
 										return r, true
 									default:
 										return
@@ -6534,156 +15048,209 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										break
 									}
 
+									// Param: "id"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
 									if len(elem) == 0 {
-										break
+										switch method {
+										case "DELETE":
+											r.name = DeletePhotoAlbumOperation
+											r.summary = "Delete photo album"
+											r.operationID = "deletePhotoAlbum"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/photos/albums/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "GET":
+											r.name = GetPhotoAlbumOperation
+											r.summary = "Get photo album"
+											r.operationID = "getPhotoAlbum"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/photos/albums/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "PATCH":
+											r.name = UpdatePhotoAlbumOperation
+											r.summary = "Update photo album"
+											r.operationID = "updatePhotoAlbum"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/photos/albums/{id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
 									}
 									switch elem[0] {
-									case 'c': // Prefix: "credits"
+									case '/': // Prefix: "/"
 
-										if l := len("credits"); len(elem) >= l && elem[0:l] == "credits" {
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetPersonMetadataCreditsOperation
-												r.summary = "Get person credits from metadata provider"
-												r.operationID = "getPersonMetadataCredits"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/metadata/person/{id}/credits"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
-										}
-
-									case 'i': // Prefix: "images"
-
-										if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-											elem = elem[l:]
-										} else {
 											break
 										}
+										switch elem[0] {
+										case 'p': // Prefix: "photos"
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetPersonMetadataImagesOperation
-												r.summary = "Get person images from metadata provider"
-												r.operationID = "getPersonMetadataImages"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/metadata/person/{id}/images"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
+											if l := len("photos"); len(elem) >= l && elem[0:l] == "photos" {
+												elem = elem[l:]
+											} else {
+												break
 											}
-										}
 
-									}
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = ListPhotoAlbumPhotosOperation
+													r.summary = "List album photos"
+													r.operationID = "listPhotoAlbumPhotos"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/photos/albums/{id}/photos"
+													r.args = args
+													r.count = 1
+													return r, true
+												case "POST":
+													r.name = AddPhotoAlbumPhotosOperation
+													r.summary = "Add photos to album"
+													r.operationID = "addPhotoAlbumPhotos"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/photos/albums/{id}/photos"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
 
-								}
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-							case 'r': // Prefix: "roviders"
+												// Param: "photoId"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
 
-								if l := len("roviders"); len(elem) >= l && elem[0:l] == "roviders" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "DELETE":
+														r.name = RemovePhotoAlbumPhotoOperation
+														r.summary = "Remove photo from album"
+														r.operationID = "removePhotoAlbumPhoto"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/photos/albums/{id}/photos/{photoId}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = ListMetadataProvidersOperation
-										r.summary = "List available metadata providers"
-										r.operationID = "listMetadataProviders"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/metadata/providers"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
+											}
 
-							}
+										case 's': // Prefix: "shares"
 
-						case 's': // Prefix: "search/"
+											if l := len("shares"); len(elem) >= l && elem[0:l] == "shares" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-							if l := len("search/"); len(elem) >= l && elem[0:l] == "search/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = ListPhotoAlbumSharesOperation
+													r.summary = "List album shares"
+													r.operationID = "listPhotoAlbumShares"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/photos/albums/{id}/shares"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'm': // Prefix: "movie"
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-								if l := len("movie"); len(elem) >= l && elem[0:l] == "movie" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												// Param: "userId"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = SearchMoviesMetadataOperation
-										r.summary = "Search movies via metadata provider"
-										r.operationID = "searchMoviesMetadata"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/metadata/search/movie"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "DELETE":
+														r.name = UnsharePhotoAlbumOperation
+														r.summary = "Unshare photo album"
+														r.operationID = "unsharePhotoAlbum"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/photos/albums/{id}/shares/{userId}"
+														r.args = args
+														r.count = 2
+														return r, true
+													case "PUT":
+														r.name = SharePhotoAlbumOperation
+														r.summary = "Share photo album"
+														r.operationID = "sharePhotoAlbum"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/photos/albums/{id}/shares/{userId}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
 
-							case 'p': // Prefix: "person"
+											}
 
-								if l := len("person"); len(elem) >= l && elem[0:l] == "person" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = SearchPersonMetadataOperation
-										r.summary = "Search people via metadata provider"
-										r.operationID = "searchPersonMetadata"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/metadata/search/person"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
 									}
-								}
 
-							case 't': // Prefix: "tv"
+								}
 
-								if l := len("tv"); len(elem) >= l && elem[0:l] == "tv" {
+								elem = origElem
+							case 't': // Prefix: "timeline"
+								origElem := elem
+								if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
 									elem = elem[l:]
 								} else {
 									break
@@ -6693,11 +15260,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									// Leaf node.
 									switch method {
 									case "GET":
-										r.name = SearchTVShowsMetadataOperation
-										r.summary = "Search TV shows via metadata provider"
-										r.operationID = "searchTVShowsMetadata"
+										r.name = GetPhotoTimelineOperation
+										r.summary = "Get photo timeline"
+										r.operationID = "getPhotoTimeline"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/metadata/search/tv"
+										r.pathPattern = "/api/v1/photos/timeline"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -6706,16 +15273,8 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
+								elem = origElem
 							}
-
-						case 't': // Prefix: "tv/"
-
-							if l := len("tv/"); len(elem) >= l && elem[0:l] == "tv/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
 							// Param: "id"
 							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
@@ -6728,11 +15287,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = GetTVShowMetadataOperation
-									r.summary = "Get TV show details from TMDb"
-									r.operationID = "getTVShowMetadata"
+									r.name = GetPhotoOperation
+									r.summary = "Get photo"
+									r.operationID = "getPhoto"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/metadata/tv/{id}"
+									r.pathPattern = "/api/v1/photos/{id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -6753,73 +15312,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "c"
-
-									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 'o': // Prefix: "ontent-ratings"
-
-										if l := len("ontent-ratings"); len(elem) >= l && elem[0:l] == "ontent-ratings" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetTVShowContentRatingsOperation
-												r.summary = "Get TV show content ratings"
-												r.operationID = "getTVShowContentRatings"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/metadata/tv/{id}/content-ratings"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
-										}
-
-									case 'r': // Prefix: "redits"
-
-										if l := len("redits"); len(elem) >= l && elem[0:l] == "redits" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetTVShowMetadataCreditsOperation
-												r.summary = "Get TV show credits from metadata provider"
-												r.operationID = "getTVShowMetadataCredits"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/metadata/tv/{id}/credits"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
-										}
+								case 'f': // Prefix: "file"
 
-									}
-
-								case 'e': // Prefix: "external-ids"
-
-									if l := len("external-ids"); len(elem) >= l && elem[0:l] == "external-ids" {
+									if l := len("file"); len(elem) >= l && elem[0:l] == "file" {
 										elem = elem[l:]
 									} else {
 										break
@@ -6829,11 +15324,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetTVShowExternalIDsOperation
-											r.summary = "Get TV show external IDs"
-											r.operationID = "getTVShowExternalIDs"
+											r.name = DownloadPhotoFileOperation
+											r.summary = "Download photo file"
+											r.operationID = "downloadPhotoFile"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/tv/{id}/external-ids"
+											r.pathPattern = "/api/v1/photos/{id}/file"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -6842,9 +15337,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								case 'i': // Prefix: "images"
+								case 't': // Prefix: "thumbnail"
 
-									if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+									if l := len("thumbnail"); len(elem) >= l && elem[0:l] == "thumbnail" {
 										elem = elem[l:]
 									} else {
 										break
@@ -6854,11 +15349,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetTVShowMetadataImagesOperation
-											r.summary = "Get TV show images from metadata provider"
-											r.operationID = "getTVShowMetadataImages"
+											r.name = GetPhotoThumbnailOperation
+											r.summary = "Get photo thumbnail"
+											r.operationID = "getPhotoThumbnail"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/tv/{id}/images"
+											r.pathPattern = "/api/v1/photos/{id}/thumbnail"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -6867,161 +15362,143 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								case 's': // Prefix: "season/"
-
-									if l := len("season/"); len(elem) >= l && elem[0:l] == "season/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									// Param: "seasonNumber"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[1] = elem[:idx]
-									elem = elem[idx:]
-
-									if len(elem) == 0 {
-										switch method {
-										case "GET":
-											r.name = GetSeasonMetadataOperation
-											r.summary = "Get season details from TMDb"
-											r.operationID = "getSeasonMetadata"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}"
-											r.args = args
-											r.count = 2
-											return r, true
-										default:
-											return
-										}
-									}
-									switch elem[0] {
-									case '/': // Prefix: "/"
-
-										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											break
-										}
-										switch elem[0] {
-										case 'e': // Prefix: "episode/"
-
-											if l := len("episode/"); len(elem) >= l && elem[0:l] == "episode/" {
-												elem = elem[l:]
-											} else {
-												break
-											}
+								}
 
-											// Param: "episodeNumber"
-											// Match until "/"
-											idx := strings.IndexByte(elem, '/')
-											if idx < 0 {
-												idx = len(elem)
-											}
-											args[2] = elem[:idx]
-											elem = elem[idx:]
+							}
 
-											if len(elem) == 0 {
-												switch method {
-												case "GET":
-													r.name = GetEpisodeMetadataOperation
-													r.summary = "Get episode details from TMDb"
-													r.operationID = "getEpisodeMetadata"
-													r.operationGroup = ""
-													r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}/episode/{episodeNumber}"
-													r.args = args
-													r.count = 3
-													return r, true
-												default:
-													return
-												}
-											}
-											switch elem[0] {
-											case '/': // Prefix: "/images"
+						}
 
-												if l := len("/images"); len(elem) >= l && elem[0:l] == "/images" {
-													elem = elem[l:]
-												} else {
-													break
-												}
+					case 'l': // Prefix: "layback/sessions"
 
-												if len(elem) == 0 {
-													// Leaf node.
-													switch method {
-													case "GET":
-														r.name = GetEpisodeMetadataImagesOperation
-														r.summary = "Get episode images from metadata provider"
-														r.operationID = "getEpisodeMetadataImages"
-														r.operationGroup = ""
-														r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}/episode/{episodeNumber}/images"
-														r.args = args
-														r.count = 3
-														return r, true
-													default:
-														return
-													}
-												}
+						if l := len("layback/sessions"); len(elem) >= l && elem[0:l] == "layback/sessions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-											}
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = StartPlaybackSessionOperation
+								r.summary = "Start a playback session"
+								r.operationID = "startPlaybackSession"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/playback/sessions"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-										case 'i': // Prefix: "images"
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-											if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-												elem = elem[l:]
-											} else {
-												break
-											}
+							// Param: "sessionId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
-											if len(elem) == 0 {
-												// Leaf node.
-												switch method {
-												case "GET":
-													r.name = GetSeasonMetadataImagesOperation
-													r.summary = "Get season images from metadata provider"
-													r.operationID = "getSeasonMetadataImages"
-													r.operationGroup = ""
-													r.pathPattern = "/api/v1/metadata/tv/{id}/season/{seasonNumber}/images"
-													r.args = args
-													r.count = 2
-													return r, true
-												default:
-													return
-												}
-											}
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = StopPlaybackSessionOperation
+									r.summary = "Stop a playback session"
+									r.operationID = "stopPlaybackSession"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/playback/sessions/{sessionId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = GetPlaybackSessionOperation
+									r.summary = "Get playback session info"
+									r.operationID = "getPlaybackSession"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/playback/sessions/{sessionId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/heartbeat"
 
-										}
+								if l := len("/heartbeat"); len(elem) >= l && elem[0:l] == "/heartbeat" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = HeartbeatPlaybackSessionOperation
+										r.summary = "Send playback heartbeat"
+										r.operationID = "heartbeatPlaybackSession"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/playback/sessions/{sessionId}/heartbeat"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
 									}
-
 								}
 
 							}
 
 						}
 
-					case 'f': // Prefix: "fa/"
+					case 'o': // Prefix: "odcasts"
 
-						if l := len("fa/"); len(elem) >= l && elem[0:l] == "fa/" {
+						if l := len("odcasts"); len(elem) >= l && elem[0:l] == "odcasts" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = ListPodcastsOperation
+								r.summary = "List podcasts"
+								r.operationID = "listPodcasts"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/podcasts"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = SubscribePodcastFeedOperation
+								r.summary = "Subscribe to a feed"
+								r.operationID = "subscribePodcastFeed"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/podcasts"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
-						case 'b': // Prefix: "backup-codes/"
+						case '/': // Prefix: "/"
 
-							if l := len("backup-codes/"); len(elem) >= l && elem[0:l] == "backup-codes/" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
@@ -7031,34 +15508,161 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'g': // Prefix: "generate"
-
-								if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+							case 'e': // Prefix: "episodes/"
+								origElem := elem
+								if l := len("episodes/"); len(elem) >= l && elem[0:l] == "episodes/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[0] = elem[:idx]
+								elem = elem[idx:]
+
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
-									case "POST":
-										r.name = GenerateBackupCodesOperation
-										r.summary = "Generate backup codes"
-										r.operationID = "generateBackupCodes"
+									case "GET":
+										r.name = GetPodcastEpisodeOperation
+										r.summary = "Get podcast episode"
+										r.operationID = "getPodcastEpisode"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/mfa/backup-codes/generate"
+										r.pathPattern = "/api/v1/podcasts/episodes/{id}"
 										r.args = args
-										r.count = 0
+										r.count = 1
 										return r, true
 									default:
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-							case 'r': // Prefix: "regenerate"
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if l := len("regenerate"); len(elem) >= l && elem[0:l] == "regenerate" {
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'd': // Prefix: "download"
+
+										if l := len("download"); len(elem) >= l && elem[0:l] == "download" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = DeletePodcastEpisodeDownloadOperation
+												r.summary = "Delete episode download"
+												r.operationID = "deletePodcastEpisodeDownload"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/podcasts/episodes/{id}/download"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "POST":
+												r.name = DownloadPodcastEpisodeOperation
+												r.summary = "Download episode"
+												r.operationID = "downloadPodcastEpisode"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/podcasts/episodes/{id}/download"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'p': // Prefix: "progress"
+
+										if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = DeletePodcastEpisodeProgressOperation
+												r.summary = "Delete episode listening state"
+												r.operationID = "deletePodcastEpisodeProgress"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/podcasts/episodes/{id}/progress"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "GET":
+												r.name = GetPodcastEpisodeProgressOperation
+												r.summary = "Get episode listening state"
+												r.operationID = "getPodcastEpisodeProgress"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/podcasts/episodes/{id}/progress"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "POST":
+												r.name = UpdatePodcastEpisodeProgressOperation
+												r.summary = "Update episode listening state"
+												r.operationID = "updatePodcastEpisodeProgress"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/podcasts/episodes/{id}/progress"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 't': // Prefix: "transcript"
+
+										if l := len("transcript"); len(elem) >= l && elem[0:l] == "transcript" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetPodcastEpisodeTranscriptOperation
+												r.summary = "Get episode transcript"
+												r.operationID = "getPodcastEpisodeTranscript"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/podcasts/episodes/{id}/transcript"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							case 'n': // Prefix: "new"
+								origElem := elem
+								if l := len("new"); len(elem) >= l && elem[0:l] == "new" {
 									elem = elem[l:]
 								} else {
 									break
@@ -7067,12 +15671,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "POST":
-										r.name = RegenerateBackupCodesOperation
-										r.summary = "Regenerate backup codes"
-										r.operationID = "regenerateBackupCodes"
+									case "GET":
+										r.name = ListNewPodcastEpisodesOperation
+										r.summary = "List new episodes"
+										r.operationID = "listNewPodcastEpisodes"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/mfa/backup-codes/regenerate"
+										r.pathPattern = "/api/v1/podcasts/new"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -7081,101 +15685,36 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
+								elem = origElem
 							}
-
-						case 'd': // Prefix: "disable"
-
-							if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = DisableMFAOperation
-									r.summary = "Disable MFA requirement"
-									r.operationID = "disableMFA"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/mfa/disable"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 'e': // Prefix: "enable"
-
-							if l := len("enable"); len(elem) >= l && elem[0:l] == "enable" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = EnableMFAOperation
-									r.summary = "Enable MFA requirement"
-									r.operationID = "enableMFA"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/mfa/enable"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 's': // Prefix: "status"
-
-							if l := len("status"); len(elem) >= l && elem[0:l] == "status" {
-								elem = elem[l:]
-							} else {
-								break
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetMFAStatusOperation
-									r.summary = "Get MFA status"
-									r.operationID = "getMFAStatus"
+									r.name = GetPodcastOperation
+									r.summary = "Get podcast"
+									r.operationID = "getPodcast"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/mfa/status"
+									r.pathPattern = "/api/v1/podcasts/{id}"
 									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 't': // Prefix: "totp"
-
-							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								switch method {
-								case "DELETE":
-									r.name = DisableTOTPOperation
-									r.summary = "Disable TOTP"
-									r.operationID = "disableTOTP"
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = UpdatePodcastSettingsOperation
+									r.summary = "Update podcast download settings"
+									r.operationID = "updatePodcastSettings"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/mfa/totp"
+									r.pathPattern = "/api/v1/podcasts/{id}"
 									r.args = args
-									r.count = 0
+									r.count = 1
 									return r, true
 								default:
 									return
@@ -7194,9 +15733,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 's': // Prefix: "setup"
+								case 'e': // Prefix: "episodes"
 
-									if l := len("setup"); len(elem) >= l && elem[0:l] == "setup" {
+									if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
 										elem = elem[l:]
 									} else {
 										break
@@ -7205,23 +15744,23 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "POST":
-											r.name = SetupTOTPOperation
-											r.summary = "Setup TOTP"
-											r.operationID = "setupTOTP"
+										case "GET":
+											r.name = ListPodcastEpisodesOperation
+											r.summary = "List podcast episodes"
+											r.operationID = "listPodcastEpisodes"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/totp/setup"
+											r.pathPattern = "/api/v1/podcasts/{id}/episodes"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								case 'v': // Prefix: "verify"
+								case 'r': // Prefix: "refresh"
 
-									if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
 										elem = elem[l:]
 									} else {
 										break
@@ -7231,94 +15770,45 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "POST":
-											r.name = VerifyTOTPOperation
-											r.summary = "Verify and enable TOTP"
-											r.operationID = "verifyTOTP"
+											r.name = RefreshPodcastOperation
+											r.summary = "Refresh podcast feed"
+											r.operationID = "refreshPodcast"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/totp/verify"
+											r.pathPattern = "/api/v1/podcasts/{id}/refresh"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								}
-
-							}
-
-						case 'w': // Prefix: "webauthn/"
-
-							if l := len("webauthn/"); len(elem) >= l && elem[0:l] == "webauthn/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'c': // Prefix: "credentials"
-
-								if l := len("credentials"); len(elem) >= l && elem[0:l] == "credentials" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										r.name = ListWebAuthnCredentialsOperation
-										r.summary = "List WebAuthn credentials"
-										r.operationID = "listWebAuthnCredentials"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/mfa/webauthn/credentials"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
+								case 's': // Prefix: "subscription"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("subscription"); len(elem) >= l && elem[0:l] == "subscription" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "credentialId"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
-									}
-									args[0] = elem
-									elem = ""
-
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
 										case "DELETE":
-											r.name = DeleteWebAuthnCredentialOperation
-											r.summary = "Delete WebAuthn credential"
-											r.operationID = "deleteWebAuthnCredential"
+											r.name = UnsubscribePodcastOperation
+											r.summary = "Unsubscribe from a podcast"
+											r.operationID = "unsubscribePodcast"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/webauthn/credentials/{credentialId}"
+											r.pathPattern = "/api/v1/podcasts/{id}/subscription"
 											r.args = args
 											r.count = 1
 											return r, true
-										case "PATCH":
-											r.name = RenameWebAuthnCredentialOperation
-											r.summary = "Rename WebAuthn credential"
-											r.operationID = "renameWebAuthnCredential"
+										case "PUT":
+											r.name = SubscribePodcastOperation
+											r.summary = "Subscribe to a podcast"
+											r.operationID = "subscribePodcast"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/webauthn/credentials/{credentialId}"
+											r.pathPattern = "/api/v1/podcasts/{id}/subscription"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -7329,141 +15819,109 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							case 'l': // Prefix: "login/"
-
-								if l := len("login/"); len(elem) >= l && elem[0:l] == "login/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'b': // Prefix: "begin"
+							}
 
-									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+						}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = BeginWebAuthnLoginOperation
-											r.summary = "Begin WebAuthn login"
-											r.operationID = "beginWebAuthnLogin"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/webauthn/login/begin"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+					}
 
-								case 'f': // Prefix: "finish"
+				case 'r': // Prefix: "rbac/"
 
-									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+					if l := len("rbac/"); len(elem) >= l && elem[0:l] == "rbac/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = FinishWebAuthnLoginOperation
-											r.summary = "Finish WebAuthn login"
-											r.operationID = "finishWebAuthnLogin"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/webauthn/login/finish"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'p': // Prefix: "p"
 
-								}
+						if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-							case 'r': // Prefix: "register/"
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "ermissions"
 
-								if l := len("register/"); len(elem) >= l && elem[0:l] == "register/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+							if l := len("ermissions"); len(elem) >= l && elem[0:l] == "ermissions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-								if len(elem) == 0 {
-									break
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ListPermissionsOperation
+									r.summary = "List available permissions"
+									r.operationID = "listPermissions"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/permissions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
 								}
-								switch elem[0] {
-								case 'b': // Prefix: "begin"
-
-									if l := len("begin"); len(elem) >= l && elem[0:l] == "begin" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = BeginWebAuthnRegistrationOperation
-											r.summary = "Begin WebAuthn registration"
-											r.operationID = "beginWebAuthnRegistration"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/webauthn/register/begin"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'f': // Prefix: "finish"
+							}
 
-									if l := len("finish"); len(elem) >= l && elem[0:l] == "finish" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+						case 'o': // Prefix: "olicies"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = FinishWebAuthnRegistrationOperation
-											r.summary = "Finish WebAuthn registration"
-											r.operationID = "finishWebAuthnRegistration"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/mfa/webauthn/register/finish"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+							if l := len("olicies"); len(elem) >= l && elem[0:l] == "olicies" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RemovePolicyOperation
+									r.summary = "Remove policy"
+									r.operationID = "removePolicy"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/policies"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "GET":
+									r.name = ListPoliciesOperation
+									r.summary = "List policies"
+									r.operationID = "listPolicies"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/policies"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = AddPolicyOperation
+									r.summary = "Add policy"
+									r.operationID = "addPolicy"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/policies"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
 								}
-
 							}
 
 						}
 
-					case 'o': // Prefix: "ovies"
+					case 'r': // Prefix: "roles"
 
-						if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+						if l := len("roles"); len(elem) >= l && elem[0:l] == "roles" {
 							elem = elem[l:]
 						} else {
 							break
@@ -7472,11 +15930,20 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								r.name = ListMoviesOperation
-								r.summary = "List movies"
-								r.operationID = "listMovies"
+								r.name = ListRolesOperation
+								r.summary = "List all roles"
+								r.operationID = "listRoles"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/movies"
+								r.pathPattern = "/api/v1/rbac/roles"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = CreateRoleOperation
+								r.summary = "Create role"
+								r.operationID = "createRole"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/rbac/roles"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -7493,39 +15960,43 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							if len(elem) == 0 {
-								break
+							// Param: "roleName"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
-							switch elem[0] {
-							case 'c': // Prefix: "continue-watching"
-								origElem := elem
-								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetContinueWatchingOperation
-										r.summary = "Get continue watching list"
-										r.operationID = "getContinueWatching"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/movies/continue-watching"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = DeleteRoleOperation
+									r.summary = "Delete role"
+									r.operationID = "deleteRole"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/roles/{roleName}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "GET":
+									r.name = GetRoleOperation
+									r.summary = "Get role details"
+									r.operationID = "getRole"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/roles/{roleName}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
 								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/permissions"
 
-								elem = origElem
-							case 'r': // Prefix: "recently-added"
-								origElem := elem
-								if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
+								if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
 									elem = elem[l:]
 								} else {
 									break
@@ -7534,179 +16005,206 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "GET":
-										r.name = GetRecentlyAddedOperation
-										r.summary = "Get recently added movies"
-										r.operationID = "getRecentlyAdded"
+									case "PUT":
+										r.name = UpdateRolePermissionsOperation
+										r.summary = "Update role permissions"
+										r.operationID = "updateRolePermissions"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/movies/recently-added"
+										r.pathPattern = "/api/v1/rbac/roles/{roleName}/permissions"
 										r.args = args
-										r.count = 0
+										r.count = 1
 										return r, true
 									default:
 										return
 									}
 								}
 
-								elem = origElem
-							case 's': // Prefix: "s"
-								origElem := elem
-								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+							}
 
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'e': // Prefix: "earch"
+						}
 
-									if l := len("earch"); len(elem) >= l && elem[0:l] == "earch" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+					case 'u': // Prefix: "users/"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = SearchMoviesOperation
-											r.summary = "Search movies"
-											r.operationID = "searchMovies"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/search"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+						if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-								case 't': // Prefix: "tats"
+						// Param: "userId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-									if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/roles"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetUserMovieStatsOperation
-											r.summary = "Get user movie statistics"
-											r.operationID = "getUserMovieStats"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/stats"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+							if l := len("/roles"); len(elem) >= l && elem[0:l] == "/roles" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetUserRolesOperation
+									r.summary = "Get user roles"
+									r.operationID = "getUserRoles"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/users/{userId}/roles"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = AssignRoleOperation
+									r.summary = "Assign role"
+									r.operationID = "assignRole"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/rbac/users/{userId}/roles"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
 								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-								elem = origElem
-							case 't': // Prefix: "top-rated"
-								origElem := elem
-								if l := len("top-rated"); len(elem) >= l && elem[0:l] == "top-rated" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetTopRatedOperation
-										r.summary = "Get top-rated movies"
-										r.operationID = "getTopRated"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/movies/top-rated"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
-
-								elem = origElem
-							case 'w': // Prefix: "watch-history"
-								origElem := elem
-								if l := len("watch-history"); len(elem) >= l && elem[0:l] == "watch-history" {
-									elem = elem[l:]
-								} else {
+								// Param: "role"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
 									break
 								}
+								args[1] = elem
+								elem = ""
 
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "GET":
-										r.name = GetWatchHistoryOperation
-										r.summary = "Get watch history"
-										r.operationID = "getWatchHistory"
+									case "DELETE":
+										r.name = RemoveRoleOperation
+										r.summary = "Remove role"
+										r.operationID = "removeRole"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/movies/watch-history"
+										r.pathPattern = "/api/v1/rbac/users/{userId}/roles/{role}"
 										r.args = args
-										r.count = 0
+										r.count = 2
 										return r, true
 									default:
 										return
 									}
 								}
 
-								elem = origElem
 							}
-							// Param: "id"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
+
+						}
+
+					}
+
+				case 's': // Prefix: "se"
+
+					if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "arch/"
+
+						if l := len("arch/"); len(elem) >= l && elem[0:l] == "arch/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'h': // Prefix: "homevideos"
+
+							if l := len("homevideos"); len(elem) >= l && elem[0:l] == "homevideos" {
+								elem = elem[l:]
+							} else {
+								break
 							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
 
 							if len(elem) == 0 {
+								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetMovieOperation
-									r.summary = "Get movie details"
-									r.operationID = "getMovie"
+									r.name = SearchLibraryHomeVideosOperation
+									r.summary = "Search home videos in library"
+									r.operationID = "searchLibraryHomeVideos"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/movies/{id}"
+									r.pathPattern = "/api/v1/search/homevideos"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
 								}
 							}
+
+						case 'm': // Prefix: "m"
+
+							if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'o': // Prefix: "ovies"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									break
+									switch method {
+									case "GET":
+										r.name = SearchLibraryMoviesOperation
+										r.summary = "Search movies in library"
+										r.operationID = "searchLibraryMovies"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/search/movies"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "c"
+								case '/': // Prefix: "/"
 
-									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 										elem = elem[l:]
 									} else {
 										break
@@ -7716,59 +16214,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										break
 									}
 									switch elem[0] {
-									case 'a': // Prefix: "ast"
-
-										if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetMovieCastOperation
-												r.summary = "Get movie cast"
-												r.operationID = "getMovieCast"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/movies/{id}/cast"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
-										}
-
-									case 'o': // Prefix: "ollection"
-
-										if l := len("ollection"); len(elem) >= l && elem[0:l] == "ollection" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetMovieCollectionOperation
-												r.summary = "Get movie collection"
-												r.operationID = "getMovieCollection"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/movies/{id}/collection"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
-										}
-
-									case 'r': // Prefix: "rew"
+									case 'a': // Prefix: "autocomplete"
 
-										if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+										if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
 											elem = elem[l:]
 										} else {
 											break
@@ -7778,142 +16226,63 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 											// Leaf node.
 											switch method {
 											case "GET":
-												r.name = GetMovieCrewOperation
-												r.summary = "Get movie crew"
-												r.operationID = "getMovieCrew"
+												r.name = AutocompleteMoviesOperation
+												r.summary = "Autocomplete movie titles"
+												r.operationID = "autocompleteMovies"
 												r.operationGroup = ""
-												r.pathPattern = "/api/v1/movies/{id}/crew"
+												r.pathPattern = "/api/v1/search/movies/autocomplete"
 												r.args = args
-												r.count = 1
+												r.count = 0
 												return r, true
 											default:
 												return
 											}
 										}
 
-									}
-
-								case 'f': // Prefix: "files"
-
-									if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetMovieFilesOperation
-											r.summary = "Get movie files"
-											r.operationID = "getMovieFiles"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/files"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'g': // Prefix: "genres"
-
-									if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetMovieGenresOperation
-											r.summary = "Get movie genres"
-											r.operationID = "getMovieGenres"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/genres"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'p': // Prefix: "progress"
-
-									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+									case 'f': // Prefix: "facets"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "DELETE":
-											r.name = DeleteWatchProgressOperation
-											r.summary = "Delete watch progress"
-											r.operationID = "deleteWatchProgress"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/progress"
-											r.args = args
-											r.count = 1
-											return r, true
-										case "GET":
-											r.name = GetWatchProgressOperation
-											r.summary = "Get watch progress"
-											r.operationID = "getWatchProgress"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/progress"
-											r.args = args
-											r.count = 1
-											return r, true
-										case "POST":
-											r.name = UpdateWatchProgressOperation
-											r.summary = "Update watch progress"
-											r.operationID = "updateWatchProgress"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/progress"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
+										if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+											elem = elem[l:]
+										} else {
+											break
 										}
-									}
 
-								case 'r': // Prefix: "refresh"
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetSearchFacetsOperation
+												r.summary = "Get search facets"
+												r.operationID = "getSearchFacets"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/search/movies/facets"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
 
-									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
-										elem = elem[l:]
-									} else {
-										break
 									}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = RefreshMovieMetadataOperation
-											r.summary = "Refresh movie metadata"
-											r.operationID = "refreshMovieMetadata"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/refresh"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+								}
 
-								case 's': // Prefix: "similar"
+							case 'u': // Prefix: "u"
 
-									if l := len("similar"); len(elem) >= l && elem[0:l] == "similar" {
+								if l := len("u"); len(elem) >= l && elem[0:l] == "u" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lti"
+
+									if l := len("lti"); len(elem) >= l && elem[0:l] == "lti" {
 										elem = elem[l:]
 									} else {
 										break
@@ -7923,22 +16292,22 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetSimilarMoviesOperation
-											r.summary = "Get similar movies"
-											r.operationID = "getSimilarMovies"
+											r.name = SearchMultiOperation
+											r.summary = "Search across all collections"
+											r.operationID = "searchMulti"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/similar"
+											r.pathPattern = "/api/v1/search/multi"
 											r.args = args
-											r.count = 1
+											r.count = 0
 											return r, true
 										default:
 											return
 										}
 									}
 
-								case 'w': // Prefix: "watched"
+								case 's': // Prefix: "sic"
 
-									if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+									if l := len("sic"); len(elem) >= l && elem[0:l] == "sic" {
 										elem = elem[l:]
 									} else {
 										break
@@ -7947,14 +16316,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "POST":
-											r.name = MarkAsWatchedOperation
-											r.summary = "Mark movie as watched"
-											r.operationID = "markAsWatched"
+										case "GET":
+											r.name = SearchLibraryMusicOperation
+											r.summary = "Search music in library"
+											r.operationID = "searchLibraryMusic"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/movies/{id}/watched"
+											r.pathPattern = "/api/v1/search/music"
 											r.args = args
-											r.count = 1
+											r.count = 0
 											return r, true
 										default:
 											return
@@ -7963,215 +16332,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							}
-
-						}
-
-					}
-
-				case 'o': // Prefix: "oidc/"
-
-					if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "auth/"
-
-						if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "provider"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = OidcAuthorizeOperation
-								r.summary = "Initiate OIDC login"
-								r.operationID = "oidcAuthorize"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/oidc/auth/{provider}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-
-					case 'c': // Prefix: "callback/"
-
-						if l := len("callback/"); len(elem) >= l && elem[0:l] == "callback/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "provider"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = OidcCallbackOperation
-								r.summary = "Handle OIDC callback"
-								r.operationID = "oidcCallback"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/oidc/callback/{provider}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-
-					case 'p': // Prefix: "providers"
-
-						if l := len("providers"); len(elem) >= l && elem[0:l] == "providers" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = ListOIDCProvidersOperation
-								r.summary = "List enabled OIDC providers"
-								r.operationID = "listOIDCProviders"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/oidc/providers"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
-
-					}
-
-				case 'p': // Prefix: "playback/sessions"
-
-					if l := len("playback/sessions"); len(elem) >= l && elem[0:l] == "playback/sessions" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							r.name = StartPlaybackSessionOperation
-							r.summary = "Start a playback session"
-							r.operationID = "startPlaybackSession"
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/playback/sessions"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
-
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						// Param: "sessionId"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "DELETE":
-								r.name = StopPlaybackSessionOperation
-								r.summary = "Stop a playback session"
-								r.operationID = "stopPlaybackSession"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/playback/sessions/{sessionId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "GET":
-								r.name = GetPlaybackSessionOperation
-								r.summary = "Get playback session info"
-								r.operationID = "getPlaybackSession"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/playback/sessions/{sessionId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-
-					}
-
-				case 'r': // Prefix: "rbac/"
-
-					if l := len("rbac/"); len(elem) >= l && elem[0:l] == "rbac/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'p': // Prefix: "p"
-
-						if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'e': // Prefix: "ermissions"
+							}
 
-							if l := len("ermissions"); len(elem) >= l && elem[0:l] == "ermissions" {
+						case 'r': // Prefix: "reindex"
+
+							if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
 								elem = elem[l:]
 							} else {
 								break
@@ -8180,12 +16345,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch method {
-								case "GET":
-									r.name = ListPermissionsOperation
-									r.summary = "List available permissions"
-									r.operationID = "listPermissions"
+								case "POST":
+									r.name = ReindexSearchOperation
+									r.summary = "Reindex all movies"
+									r.operationID = "reindexSearch"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/permissions"
+									r.pathPattern = "/api/v1/search/reindex"
 									r.args = args
 									r.count = 0
 									return r, true
@@ -8194,41 +16359,22 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
-						case 'o': // Prefix: "olicies"
+						case 't': // Prefix: "tvshows"
 
-							if l := len("olicies"); len(elem) >= l && elem[0:l] == "olicies" {
+							if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
-								case "DELETE":
-									r.name = RemovePolicyOperation
-									r.summary = "Remove policy"
-									r.operationID = "removePolicy"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/policies"
-									r.args = args
-									r.count = 0
-									return r, true
 								case "GET":
-									r.name = ListPoliciesOperation
-									r.summary = "List policies"
-									r.operationID = "listPolicies"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/policies"
-									r.args = args
-									r.count = 0
-									return r, true
-								case "POST":
-									r.name = AddPolicyOperation
-									r.summary = "Add policy"
-									r.operationID = "addPolicy"
+									r.name = SearchLibraryTVShowsOperation
+									r.summary = "Search TV shows in library"
+									r.operationID = "searchLibraryTVShows"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/policies"
+									r.pathPattern = "/api/v1/search/tvshows"
 									r.args = args
 									r.count = 0
 									return r, true
@@ -8236,12 +16382,103 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "autocomplete"
+
+									if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = AutocompleteTVShowsOperation
+											r.summary = "Autocomplete TV show titles"
+											r.operationID = "autocompleteTVShows"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/search/tvshows/autocomplete"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'f': // Prefix: "facets"
+
+									if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetTVShowSearchFacetsOperation
+											r.summary = "Get TV show search facets"
+											r.operationID = "getTVShowSearchFacets"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/search/tvshows/facets"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "reindex"
+
+									if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ReindexTVShowSearchOperation
+											r.summary = "Reindex all TV shows"
+											r.operationID = "reindexTVShowSearch"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/search/tvshows/reindex"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
 
 						}
 
-					case 'r': // Prefix: "roles"
+					case 's': // Prefix: "ssions"
 
-						if l := len("roles"); len(elem) >= l && elem[0:l] == "roles" {
+						if l := len("ssions"); len(elem) >= l && elem[0:l] == "ssions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -8249,21 +16486,21 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						if len(elem) == 0 {
 							switch method {
-							case "GET":
-								r.name = ListRolesOperation
-								r.summary = "List all roles"
-								r.operationID = "listRoles"
+							case "DELETE":
+								r.name = LogoutAllOperation
+								r.summary = "Logout all sessions"
+								r.operationID = "logoutAll"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/rbac/roles"
+								r.pathPattern = "/api/v1/sessions"
 								r.args = args
 								r.count = 0
 								return r, true
-							case "POST":
-								r.name = CreateRoleOperation
-								r.summary = "Create role"
-								r.operationID = "createRole"
+							case "GET":
+								r.name = ListSessionsOperation
+								r.summary = "List user sessions"
+								r.operationID = "listSessions"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/rbac/roles"
+								r.pathPattern = "/api/v1/sessions"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -8280,43 +16517,48 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 
-							// Param: "roleName"
-							// Match until "/"
-							idx := strings.IndexByte(elem, '/')
-							if idx < 0 {
-								idx = len(elem)
-							}
-							args[0] = elem[:idx]
-							elem = elem[idx:]
-
 							if len(elem) == 0 {
-								switch method {
-								case "DELETE":
-									r.name = DeleteRoleOperation
-									r.summary = "Delete role"
-									r.operationID = "deleteRole"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/roles/{roleName}"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "GET":
-									r.name = GetRoleOperation
-									r.summary = "Get role details"
-									r.operationID = "getRole"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/roles/{roleName}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/permissions"
+							case 'c': // Prefix: "current"
+								origElem := elem
+								if l := len("current"); len(elem) >= l && elem[0:l] == "current" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-								if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "DELETE":
+										r.name = LogoutCurrentOperation
+										r.summary = "Logout current session"
+										r.operationID = "logoutCurrent"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/sessions/current"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "GET":
+										r.name = GetCurrentSessionOperation
+										r.summary = "Get current session"
+										r.operationID = "getCurrentSession"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/sessions/current"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "refresh"
+								origElem := elem
+								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
 									elem = elem[l:]
 								} else {
 									break
@@ -8325,48 +16567,65 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "PUT":
-										r.name = UpdateRolePermissionsOperation
-										r.summary = "Update role permissions"
-										r.operationID = "updateRolePermissions"
+									case "POST":
+										r.name = RefreshSessionOperation
+										r.summary = "Refresh session"
+										r.operationID = "refreshSession"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/rbac/roles/{roleName}/permissions"
+										r.pathPattern = "/api/v1/sessions/refresh"
 										r.args = args
-										r.count = 1
+										r.count = 0
 										return r, true
 									default:
 										return
 									}
 								}
 
+								elem = origElem
+							}
+							// Param: "sessionId"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = RevokeSessionOperation
+									r.summary = "Revoke session"
+									r.operationID = "revokeSession"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/sessions/{sessionId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
 							}
 
 						}
 
-					case 'u': // Prefix: "users/"
+					case 't': // Prefix: "ttings/"
 
-						if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+						if l := len("ttings/"); len(elem) >= l && elem[0:l] == "ttings/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "userId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/roles"
+						case 's': // Prefix: "server"
 
-							if l := len("/roles"); len(elem) >= l && elem[0:l] == "/roles" {
+							if l := len("server"); len(elem) >= l && elem[0:l] == "server" {
 								elem = elem[l:]
 							} else {
 								break
@@ -8375,22 +16634,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = GetUserRolesOperation
-									r.summary = "Get user roles"
-									r.operationID = "getUserRoles"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/users/{userId}/roles"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "POST":
-									r.name = AssignRoleOperation
-									r.summary = "Assign role"
-									r.operationID = "assignRole"
+									r.name = ListServerSettingsOperation
+									r.summary = "List all server settings"
+									r.operationID = "listServerSettings"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/rbac/users/{userId}/roles"
+									r.pathPattern = "/api/v1/settings/server"
 									r.args = args
-									r.count = 1
+									r.count = 0
 									return r, true
 								default:
 									return
@@ -8405,26 +16655,35 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 
-								// Param: "role"
+								// Param: "key"
 								// Leaf parameter, slashes are prohibited
 								idx := strings.IndexByte(elem, '/')
 								if idx >= 0 {
 									break
 								}
-								args[1] = elem
+								args[0] = elem
 								elem = ""
 
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "DELETE":
-										r.name = RemoveRoleOperation
-										r.summary = "Remove role"
-										r.operationID = "removeRole"
+									case "GET":
+										r.name = GetServerSettingOperation
+										r.summary = "Get a server setting"
+										r.operationID = "getServerSetting"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/rbac/users/{userId}/roles/{role}"
+										r.pathPattern = "/api/v1/settings/server/{key}"
 										r.args = args
-										r.count = 2
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = UpdateServerSettingOperation
+										r.summary = "Update a server setting"
+										r.operationID = "updateServerSetting"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/server/{key}"
+										r.args = args
+										r.count = 1
 										return r, true
 									default:
 										return
@@ -8433,210 +16692,143 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							}
 
-						}
-
-					}
-
-				case 's': // Prefix: "se"
-
-					if l := len("se"); len(elem) >= l && elem[0:l] == "se" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "arch/"
-
-						if l := len("arch/"); len(elem) >= l && elem[0:l] == "arch/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'm': // Prefix: "m"
+						case 'u': // Prefix: "user"
 
-							if l := len("m"); len(elem) >= l && elem[0:l] == "m" {
+							if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								break
+								switch method {
+								case "GET":
+									r.name = ListUserSettingsOperation
+									r.summary = "List current user's settings"
+									r.operationID = "listUserSettings"
+									r.operationGroup = ""
+									r.pathPattern = "/api/v1/settings/user"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
 							}
 							switch elem[0] {
-							case 'o': // Prefix: "ovies"
+							case '/': // Prefix: "/"
 
-								if l := len("ovies"); len(elem) >= l && elem[0:l] == "ovies" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
+								// Param: "key"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
 								if len(elem) == 0 {
+									// Leaf node.
 									switch method {
+									case "DELETE":
+										r.name = DeleteUserSettingOperation
+										r.summary = "Delete a user setting"
+										r.operationID = "deleteUserSetting"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/user/{key}"
+										r.args = args
+										r.count = 1
+										return r, true
 									case "GET":
-										r.name = SearchLibraryMoviesOperation
-										r.summary = "Search movies in library"
-										r.operationID = "searchLibraryMovies"
+										r.name = GetUserSettingOperation
+										r.summary = "Get a user setting"
+										r.operationID = "getUserSetting"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/search/movies"
+										r.pathPattern = "/api/v1/settings/user/{key}"
 										r.args = args
-										r.count = 0
+										r.count = 1
+										return r, true
+									case "PUT":
+										r.name = UpdateUserSettingOperation
+										r.summary = "Update a user setting"
+										r.operationID = "updateUserSetting"
+										r.operationGroup = ""
+										r.pathPattern = "/api/v1/settings/user/{key}"
+										r.args = args
+										r.count = 1
 										return r, true
 									default:
 										return
 									}
 								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
-
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										break
-									}
-									switch elem[0] {
-									case 'a': // Prefix: "autocomplete"
-
-										if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
-											elem = elem[l:]
-										} else {
-											break
-										}
-
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = AutocompleteMoviesOperation
-												r.summary = "Autocomplete movie titles"
-												r.operationID = "autocompleteMovies"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/search/movies/autocomplete"
-												r.args = args
-												r.count = 0
-												return r, true
-											default:
-												return
-											}
-										}
-
-									case 'f': // Prefix: "facets"
-
-										if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
-											elem = elem[l:]
-										} else {
-											break
-										}
 
-										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetSearchFacetsOperation
-												r.summary = "Get search facets"
-												r.operationID = "getSearchFacets"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/search/movies/facets"
-												r.args = args
-												r.count = 0
-												return r, true
-											default:
-												return
-											}
-										}
+							}
 
-									}
+						}
 
-								}
+					}
 
-							case 'u': // Prefix: "ulti"
+				case 't': // Prefix: "t"
 
-								if l := len("ulti"); len(elem) >= l && elem[0:l] == "ulti" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = SearchMultiOperation
-										r.summary = "Search across all collections"
-										r.operationID = "searchMulti"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/search/multi"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "ags"
 
-							}
+						if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-						case 'r': // Prefix: "reindex"
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListTagsOperation
+								r.summary = "List tags"
+								r.operationID = "listTags"
+								r.operationGroup = ""
+								r.pathPattern = "/api/v1/tags"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
 
-							if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = ReindexSearchOperation
-									r.summary = "Reindex all movies"
-									r.operationID = "reindexSearch"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/search/reindex"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-						case 't': // Prefix: "tvshows"
-
-							if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
-								elem = elem[l:]
-							} else {
-								break
+							// Param: "slug"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
 							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = SearchLibraryTVShowsOperation
-									r.summary = "Search TV shows in library"
-									r.operationID = "searchLibraryTVShows"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/search/tvshows"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
+								break
 							}
 							switch elem[0] {
 							case '/': // Prefix: "/"
@@ -8651,9 +16843,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'a': // Prefix: "autocomplete"
+								case 'm': // Prefix: "movies"
 
-									if l := len("autocomplete"); len(elem) >= l && elem[0:l] == "autocomplete" {
+									if l := len("movies"); len(elem) >= l && elem[0:l] == "movies" {
 										elem = elem[l:]
 									} else {
 										break
@@ -8663,22 +16855,22 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = AutocompleteTVShowsOperation
-											r.summary = "Autocomplete TV show titles"
-											r.operationID = "autocompleteTVShows"
+											r.name = ListMoviesByTagOperation
+											r.summary = "List movies by tag"
+											r.operationID = "listMoviesByTag"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/search/tvshows/autocomplete"
+											r.pathPattern = "/api/v1/tags/{slug}/movies"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								case 'f': // Prefix: "facets"
+								case 't': // Prefix: "tvshows"
 
-									if l := len("facets"); len(elem) >= l && elem[0:l] == "facets" {
+									if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
 										elem = elem[l:]
 									} else {
 										break
@@ -8688,38 +16880,13 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetTVShowSearchFacetsOperation
-											r.summary = "Get TV show search facets"
-											r.operationID = "getTVShowSearchFacets"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/search/tvshows/facets"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
-
-								case 'r': // Prefix: "reindex"
-
-									if l := len("reindex"); len(elem) >= l && elem[0:l] == "reindex" {
-										elem = elem[l:]
-									} else {
-										break
-									}
-
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = ReindexTVShowSearchOperation
-											r.summary = "Reindex all TV shows"
-											r.operationID = "reindexTVShowSearch"
+											r.name = ListTVShowsByTagOperation
+											r.summary = "List TV shows by tag"
+											r.operationID = "listTVShowsByTag"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/search/tvshows/reindex"
+											r.pathPattern = "/api/v1/tags/{slug}/tvshows"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
@@ -8732,9 +16899,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						}
 
-					case 's': // Prefix: "ssions"
+					case 'v': // Prefix: "vshows"
 
-						if l := len("ssions"); len(elem) >= l && elem[0:l] == "ssions" {
+						if l := len("vshows"); len(elem) >= l && elem[0:l] == "vshows" {
 							elem = elem[l:]
 						} else {
 							break
@@ -8742,21 +16909,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						if len(elem) == 0 {
 							switch method {
-							case "DELETE":
-								r.name = LogoutAllOperation
-								r.summary = "Logout all sessions"
-								r.operationID = "logoutAll"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/sessions"
-								r.args = args
-								r.count = 0
-								return r, true
 							case "GET":
-								r.name = ListSessionsOperation
-								r.summary = "List user sessions"
-								r.operationID = "listSessions"
+								r.name = ListTVShowsOperation
+								r.summary = "List TV shows"
+								r.operationID = "listTVShows"
 								r.operationGroup = ""
-								r.pathPattern = "/api/v1/sessions"
+								r.pathPattern = "/api/v1/tvshows"
 								r.args = args
 								r.count = 0
 								return r, true
@@ -8777,9 +16935,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
-							case 'c': // Prefix: "current"
+							case 'c': // Prefix: "continue-watching"
 								origElem := elem
-								if l := len("current"); len(elem) >= l && elem[0:l] == "current" {
+								if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
 									elem = elem[l:]
 								} else {
 									break
@@ -8788,21 +16946,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "DELETE":
-										r.name = LogoutCurrentOperation
-										r.summary = "Logout current session"
-										r.operationID = "logoutCurrent"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/sessions/current"
-										r.args = args
-										r.count = 0
-										return r, true
 									case "GET":
-										r.name = GetCurrentSessionOperation
-										r.summary = "Get current session"
-										r.operationID = "getCurrentSession"
+										r.name = GetTVContinueWatchingOperation
+										r.summary = "Get continue watching list for TV shows"
+										r.operationID = "getTVContinueWatching"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/sessions/current"
+										r.pathPattern = "/api/v1/tvshows/continue-watching"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -8812,132 +16961,114 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 
 								elem = origElem
-							case 'r': // Prefix: "refresh"
+							case 'e': // Prefix: "episodes/"
 								origElem := elem
-								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+								if l := len("episodes/"); len(elem) >= l && elem[0:l] == "episodes/" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = RefreshSessionOperation
-										r.summary = "Refresh session"
-										r.operationID = "refreshSession"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/sessions/refresh"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
-
-								elem = origElem
-							}
-							// Param: "sessionId"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[0] = elem
-							elem = ""
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "DELETE":
-									r.name = RevokeSessionOperation
-									r.summary = "Revoke session"
-									r.operationID = "revokeSession"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/sessions/{sessionId}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+									break
 								}
-							}
-
-						}
+								switch elem[0] {
+								case 'b': // Prefix: "bulk-watched"
+									origElem := elem
+									if l := len("bulk-watched"); len(elem) >= l && elem[0:l] == "bulk-watched" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					case 't': // Prefix: "ttings/"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = MarkTVEpisodesBulkWatchedOperation
+											r.summary = "Mark multiple episodes as watched"
+											r.operationID = "markTVEpisodesBulkWatched"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/tvshows/episodes/bulk-watched"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
 
-						if l := len("ttings/"); len(elem) >= l && elem[0:l] == "ttings/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									elem = origElem
+								case 'r': // Prefix: "recent"
+									origElem := elem
+									if l := len("recent"); len(elem) >= l && elem[0:l] == "recent" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 's': // Prefix: "server"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetRecentEpisodesOperation
+											r.summary = "Get recent episodes"
+											r.operationID = "getRecentEpisodes"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/tvshows/episodes/recent"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
 
-							if l := len("server"); len(elem) >= l && elem[0:l] == "server" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									elem = origElem
+								case 'u': // Prefix: "upcoming"
+									origElem := elem
+									if l := len("upcoming"); len(elem) >= l && elem[0:l] == "upcoming" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = ListServerSettingsOperation
-									r.summary = "List all server settings"
-									r.operationID = "listServerSettings"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/settings/server"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetUpcomingEpisodesOperation
+											r.summary = "Get upcoming episodes"
+											r.operationID = "getUpcomingEpisodes"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/tvshows/episodes/upcoming"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
+									elem = origElem
 								}
-
-								// Param: "key"
-								// Leaf parameter, slashes are prohibited
+								// Param: "id"
+								// Match until "/"
 								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
+								if idx < 0 {
+									idx = len(elem)
 								}
-								args[0] = elem
-								elem = ""
+								args[0] = elem[:idx]
+								elem = elem[idx:]
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
 									case "GET":
-										r.name = GetServerSettingOperation
-										r.summary = "Get a server setting"
-										r.operationID = "getServerSetting"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/server/{key}"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "PUT":
-										r.name = UpdateServerSettingOperation
-										r.summary = "Update a server setting"
-										r.operationID = "updateServerSetting"
+										r.name = GetTVEpisodeOperation
+										r.summary = "Get episode details"
+										r.operationID = "getTVEpisode"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/server/{key}"
+										r.pathPattern = "/api/v1/tvshows/episodes/{id}"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -8945,194 +17076,268 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-							}
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						case 'u': // Prefix: "user"
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'f': // Prefix: "files"
 
-							if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = ListUserSettingsOperation
-									r.summary = "List current user's settings"
-									r.operationID = "listUserSettings"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/settings/user"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-							switch elem[0] {
-							case '/': // Prefix: "/"
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVEpisodeFilesOperation
+												r.summary = "Get episode files"
+												r.operationID = "getTVEpisodeFiles"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/episodes/{id}/files"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+									case 'm': // Prefix: "metadata"
 
-								// Param: "key"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
+										if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "DELETE":
-										r.name = DeleteUserSettingOperation
-										r.summary = "Delete a user setting"
-										r.operationID = "deleteUserSetting"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/user/{key}"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "GET":
-										r.name = GetUserSettingOperation
-										r.summary = "Get a user setting"
-										r.operationID = "getUserSetting"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/user/{key}"
-										r.args = args
-										r.count = 1
-										return r, true
-									case "PUT":
-										r.name = UpdateUserSettingOperation
-										r.summary = "Update a user setting"
-										r.operationID = "updateUserSetting"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/settings/user/{key}"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
+										if len(elem) == 0 {
+											switch method {
+											case "PATCH":
+												r.name = UpdateTVEpisodeMetadataOperation
+												r.summary = "Edit episode metadata (admin)"
+												r.operationID = "updateTVEpisodeMetadata"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/episodes/{id}/metadata"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
 
-							}
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-						}
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'h': // Prefix: "history"
 
-					}
+												if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-				case 't': // Prefix: "tvshows"
+												if len(elem) == 0 {
+													switch method {
+													case "GET":
+														r.name = ListTVEpisodeMetadataHistoryOperation
+														r.summary = "List episode metadata history (admin)"
+														r.operationID = "listTVEpisodeMetadataHistory"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/tvshows/episodes/{id}/metadata/history"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
 
-					if l := len("tvshows"); len(elem) >= l && elem[0:l] == "tvshows" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = ListTVShowsOperation
-							r.summary = "List TV shows"
-							r.operationID = "listTVShows"
-							r.operationGroup = ""
-							r.pathPattern = "/api/v1/tvshows"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+													// Param: "version"
+													// Match until "/"
+													idx := strings.IndexByte(elem, '/')
+													if idx < 0 {
+														idx = len(elem)
+													}
+													args[1] = elem[:idx]
+													elem = elem[idx:]
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+													if len(elem) == 0 {
+														break
+													}
+													switch elem[0] {
+													case '/': // Prefix: "/rollback"
+
+														if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch method {
+															case "POST":
+																r.name = RollbackTVEpisodeMetadataOperation
+																r.summary = "Roll back episode metadata (admin)"
+																r.operationID = "rollbackTVEpisodeMetadata"
+																r.operationGroup = ""
+																r.pathPattern = "/api/v1/tvshows/episodes/{id}/metadata/history/{version}/rollback"
+																r.args = args
+																r.count = 2
+																return r, true
+															default:
+																return
+															}
+														}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'c': // Prefix: "continue-watching"
-							origElem := elem
-							if l := len("continue-watching"); len(elem) >= l && elem[0:l] == "continue-watching" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+													}
+
+												}
+
+											case 'l': // Prefix: "locks/"
+
+												if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "field"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "DELETE":
+														r.name = UnlockTVEpisodeMetadataFieldOperation
+														r.summary = "Unlock episode metadata field (admin)"
+														r.operationID = "unlockTVEpisodeMetadataField"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/tvshows/episodes/{id}/metadata/locks/{field}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									case 'p': // Prefix: "progress"
+
+										if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = DeleteTVEpisodeProgressOperation
+												r.summary = "Delete episode watch progress"
+												r.operationID = "deleteTVEpisodeProgress"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/episodes/{id}/progress"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "GET":
+												r.name = GetTVEpisodeProgressOperation
+												r.summary = "Get episode watch progress"
+												r.operationID = "getTVEpisodeProgress"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/episodes/{id}/progress"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "PUT":
+												r.name = UpdateTVEpisodeProgressOperation
+												r.summary = "Update episode watch progress"
+												r.operationID = "updateTVEpisodeProgress"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/episodes/{id}/progress"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetTVContinueWatchingOperation
-									r.summary = "Get continue watching list for TV shows"
-									r.operationID = "getTVContinueWatching"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/tvshows/continue-watching"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
+									case 'w': // Prefix: "watched"
 
-							elem = origElem
-						case 'e': // Prefix: "episodes/"
-							origElem := elem
-							if l := len("episodes/"); len(elem) >= l && elem[0:l] == "episodes/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'b': // Prefix: "bulk-watched"
-								origElem := elem
-								if l := len("bulk-watched"); len(elem) >= l && elem[0:l] == "bulk-watched" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = MarkTVEpisodeWatchedOperation
+												r.summary = "Mark episode as watched"
+												r.operationID = "markTVEpisodeWatched"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/episodes/{id}/watched"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = MarkTVEpisodesBulkWatchedOperation
-										r.summary = "Mark multiple episodes as watched"
-										r.operationID = "markTVEpisodesBulkWatched"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/episodes/bulk-watched"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
 									}
+
 								}
 
 								elem = origElem
-							case 'r': // Prefix: "recent"
+							case 'r': // Prefix: "recently-added"
 								origElem := elem
-								if l := len("recent"); len(elem) >= l && elem[0:l] == "recent" {
+								if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
 									elem = elem[l:]
 								} else {
 									break
@@ -9142,11 +17347,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									// Leaf node.
 									switch method {
 									case "GET":
-										r.name = GetRecentEpisodesOperation
-										r.summary = "Get recent episodes"
-										r.operationID = "getRecentEpisodes"
+										r.name = GetRecentlyAddedTVShowsOperation
+										r.summary = "Get recently added TV shows"
+										r.operationID = "getRecentlyAddedTVShows"
 										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/episodes/recent"
+										r.pathPattern = "/api/v1/tvshows/recently-added"
 										r.args = args
 										r.count = 0
 										return r, true
@@ -9156,29 +17361,304 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 
 								elem = origElem
-							case 'u': // Prefix: "upcoming"
+							case 's': // Prefix: "s"
 								origElem := elem
-								if l := len("upcoming"); len(elem) >= l && elem[0:l] == "upcoming" {
+								if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetUpcomingEpisodesOperation
-										r.summary = "Get upcoming episodes"
-										r.operationID = "getUpcomingEpisodes"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/episodes/upcoming"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "ea"
+
+									if l := len("ea"); len(elem) >= l && elem[0:l] == "ea" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "rch"
+
+										if l := len("rch"); len(elem) >= l && elem[0:l] == "rch" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = SearchTVShowsOperation
+												r.summary = "Search TV shows"
+												r.operationID = "searchTVShows"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/search"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 's': // Prefix: "sons/"
+
+										if l := len("sons/"); len(elem) >= l && elem[0:l] == "sons/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "id"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetTVSeasonOperation
+												r.summary = "Get season details"
+												r.operationID = "getTVSeason"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/seasons/{id}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'e': // Prefix: "episodes"
+
+												if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetTVSeasonEpisodesOperation
+														r.summary = "Get season episodes"
+														r.operationID = "getTVSeasonEpisodes"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/tvshows/seasons/{id}/episodes"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 'm': // Prefix: "metadata"
+
+												if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch method {
+													case "PATCH":
+														r.name = UpdateTVSeasonMetadataOperation
+														r.summary = "Edit season metadata (admin)"
+														r.operationID = "updateTVSeasonMetadata"
+														r.operationGroup = ""
+														r.pathPattern = "/api/v1/tvshows/seasons/{id}/metadata"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														break
+													}
+													switch elem[0] {
+													case 'h': // Prefix: "history"
+
+														if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															switch method {
+															case "GET":
+																r.name = ListTVSeasonMetadataHistoryOperation
+																r.summary = "List season metadata history (admin)"
+																r.operationID = "listTVSeasonMetadataHistory"
+																r.operationGroup = ""
+																r.pathPattern = "/api/v1/tvshows/seasons/{id}/metadata/history"
+																r.args = args
+																r.count = 1
+																return r, true
+															default:
+																return
+															}
+														}
+														switch elem[0] {
+														case '/': // Prefix: "/"
+
+															if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+																elem = elem[l:]
+															} else {
+																break
+															}
+
+															// Param: "version"
+															// Match until "/"
+															idx := strings.IndexByte(elem, '/')
+															if idx < 0 {
+																idx = len(elem)
+															}
+															args[1] = elem[:idx]
+															elem = elem[idx:]
+
+															if len(elem) == 0 {
+																break
+															}
+															switch elem[0] {
+															case '/': // Prefix: "/rollback"
+
+																if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+																	elem = elem[l:]
+																} else {
+																	break
+																}
+
+																if len(elem) == 0 {
+																	// Leaf node.
+																	switch method {
+																	case "POST":
+																		r.name = RollbackTVSeasonMetadataOperation
+																		r.summary = "Roll back season metadata (admin)"
+																		r.operationID = "rollbackTVSeasonMetadata"
+																		r.operationGroup = ""
+																		r.pathPattern = "/api/v1/tvshows/seasons/{id}/metadata/history/{version}/rollback"
+																		r.args = args
+																		r.count = 2
+																		return r, true
+																	default:
+																		return
+																	}
+																}
+
+															}
+
+														}
+
+													case 'l': // Prefix: "locks/"
+
+														if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														// Param: "field"
+														// Leaf parameter, slashes are prohibited
+														idx := strings.IndexByte(elem, '/')
+														if idx >= 0 {
+															break
+														}
+														args[1] = elem
+														elem = ""
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch method {
+															case "DELETE":
+																r.name = UnlockTVSeasonMetadataFieldOperation
+																r.summary = "Unlock season metadata field (admin)"
+																r.operationID = "unlockTVSeasonMetadataField"
+																r.operationGroup = ""
+																r.pathPattern = "/api/v1/tvshows/seasons/{id}/metadata/locks/{field}"
+																r.args = args
+																r.count = 2
+																return r, true
+															default:
+																return
+															}
+														}
+
+													}
+
+												}
+
+											}
+
+										}
+
+									}
+
+								case 't': // Prefix: "tats"
+
+									if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetUserTVStatsOperation
+											r.summary = "Get user TV show statistics"
+											r.operationID = "getUserTVStats"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/tvshows/stats"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
 									}
+
 								}
 
 								elem = origElem
@@ -9195,11 +17675,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							if len(elem) == 0 {
 								switch method {
 								case "GET":
-									r.name = GetTVEpisodeOperation
-									r.summary = "Get episode details"
-									r.operationID = "getTVEpisode"
+									r.name = GetTVShowOperation
+									r.summary = "Get TV show details"
+									r.operationID = "getTVShow"
 									r.operationGroup = ""
-									r.pathPattern = "/api/v1/tvshows/episodes/{id}"
+									r.pathPattern = "/api/v1/tvshows/{id}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -9220,77 +17700,147 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'f': // Prefix: "files"
+								case 'a': // Prefix: "artwork"
 
-									if l := len("files"); len(elem) >= l && elem[0:l] == "files" {
+									if l := len("artwork"); len(elem) >= l && elem[0:l] == "artwork" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = GetTVEpisodeFilesOperation
-											r.summary = "Get episode files"
-											r.operationID = "getTVEpisodeFiles"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/episodes/{id}/files"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListTVShowArtworkOperation
+											r.summary = "List series artwork"
+											r.operationID = "listTVShowArtwork"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/tvshows/{id}/artwork"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "artworkId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/select"
+
+											if l := len("/select"); len(elem) >= l && elem[0:l] == "/select" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = SelectTVShowArtworkOperation
+													r.summary = "Select series artwork (admin)"
+													r.operationID = "selectTVShowArtwork"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/tvshows/{id}/artwork/{artworkId}/select"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
 										}
+
 									}
 
-								case 'p': // Prefix: "progress"
+								case 'c': // Prefix: "c"
 
-									if l := len("progress"); len(elem) >= l && elem[0:l] == "progress" {
+									if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "DELETE":
-											r.name = DeleteTVEpisodeProgressOperation
-											r.summary = "Delete episode watch progress"
-											r.operationID = "deleteTVEpisodeProgress"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/episodes/{id}/progress"
-											r.args = args
-											r.count = 1
-											return r, true
-										case "GET":
-											r.name = GetTVEpisodeProgressOperation
-											r.summary = "Get episode watch progress"
-											r.operationID = "getTVEpisodeProgress"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/episodes/{id}/progress"
-											r.args = args
-											r.count = 1
-											return r, true
-										case "PUT":
-											r.name = UpdateTVEpisodeProgressOperation
-											r.summary = "Update episode watch progress"
-											r.operationID = "updateTVEpisodeProgress"
-											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/episodes/{id}/progress"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
+										break
+									}
+									switch elem[0] {
+									case 'a': // Prefix: "ast"
+
+										if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVShowCastOperation
+												r.summary = "Get TV show cast"
+												r.operationID = "getTVShowCast"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/{id}/cast"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'r': // Prefix: "rew"
+
+										if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVShowCrewOperation
+												r.summary = "Get TV show crew"
+												r.operationID = "getTVShowCrew"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/{id}/crew"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
 										}
+
 									}
 
-								case 'w': // Prefix: "watched"
+								case 'e': // Prefix: "episodes"
 
-									if l := len("watched"); len(elem) >= l && elem[0:l] == "watched" {
+									if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
 										elem = elem[l:]
 									} else {
 										break
@@ -9299,12 +17849,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "POST":
-											r.name = MarkTVEpisodeWatchedOperation
-											r.summary = "Mark episode as watched"
-											r.operationID = "markTVEpisodeWatched"
+										case "GET":
+											r.name = GetTVShowEpisodesOperation
+											r.summary = "Get all episodes for a TV show"
+											r.operationID = "getTVShowEpisodes"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/episodes/{id}/watched"
+											r.pathPattern = "/api/v1/tvshows/{id}/episodes"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -9313,64 +17863,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								}
-
-							}
-
-							elem = origElem
-						case 'r': // Prefix: "recently-added"
-							origElem := elem
-							if l := len("recently-added"); len(elem) >= l && elem[0:l] == "recently-added" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetRecentlyAddedTVShowsOperation
-									r.summary = "Get recently added TV shows"
-									r.operationID = "getRecentlyAddedTVShows"
-									r.operationGroup = ""
-									r.pathPattern = "/api/v1/tvshows/recently-added"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-							elem = origElem
-						case 's': // Prefix: "s"
-							origElem := elem
-							if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'e': // Prefix: "ea"
-
-								if l := len("ea"); len(elem) >= l && elem[0:l] == "ea" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'r': // Prefix: "rch"
+								case 'g': // Prefix: "genres"
 
-									if l := len("rch"); len(elem) >= l && elem[0:l] == "rch" {
+									if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
 										elem = elem[l:]
 									} else {
 										break
@@ -9380,44 +17875,35 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = SearchTVShowsOperation
-											r.summary = "Search TV shows"
-											r.operationID = "searchTVShows"
+											r.name = GetTVShowGenresOperation
+											r.summary = "Get TV show genres"
+											r.operationID = "getTVShowGenres"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/search"
+											r.pathPattern = "/api/v1/tvshows/{id}/genres"
 											r.args = args
-											r.count = 0
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
 
-								case 's': // Prefix: "sons/"
+								case 'm': // Prefix: "metadata"
 
-									if l := len("sons/"); len(elem) >= l && elem[0:l] == "sons/" {
+									if l := len("metadata"); len(elem) >= l && elem[0:l] == "metadata" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "id"
-									// Match until "/"
-									idx := strings.IndexByte(elem, '/')
-									if idx < 0 {
-										idx = len(elem)
-									}
-									args[0] = elem[:idx]
-									elem = elem[idx:]
-
 									if len(elem) == 0 {
 										switch method {
-										case "GET":
-											r.name = GetTVSeasonOperation
-											r.summary = "Get season details"
-											r.operationID = "getTVSeason"
+										case "PATCH":
+											r.name = UpdateTVShowMetadataOperation
+											r.summary = "Edit TV show metadata (admin)"
+											r.operationID = "updateTVShowMetadata"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/seasons/{id}"
+											r.pathPattern = "/api/v1/tvshows/{id}/metadata"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -9426,116 +17912,197 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 									switch elem[0] {
-									case '/': // Prefix: "/episodes"
+									case '/': // Prefix: "/"
 
-										if l := len("/episodes"); len(elem) >= l && elem[0:l] == "/episodes" {
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
-											// Leaf node.
-											switch method {
-											case "GET":
-												r.name = GetTVSeasonEpisodesOperation
-												r.summary = "Get season episodes"
-												r.operationID = "getTVSeasonEpisodes"
-												r.operationGroup = ""
-												r.pathPattern = "/api/v1/tvshows/seasons/{id}/episodes"
-												r.args = args
-												r.count = 1
-												return r, true
-											default:
-												return
-											}
+											break
 										}
+										switch elem[0] {
+										case 'h': // Prefix: "history"
 
-									}
+											if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-								}
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = ListTVShowMetadataHistoryOperation
+													r.summary = "List series metadata history (admin)"
+													r.operationID = "listTVShowMetadataHistory"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/tvshows/{id}/metadata/history"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
 
-							case 't': // Prefix: "tats"
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-								if l := len("tats"); len(elem) >= l && elem[0:l] == "tats" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+												// Param: "version"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[1] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/rollback"
+
+													if l := len("/rollback"); len(elem) >= l && elem[0:l] == "/rollback" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "POST":
+															r.name = RollbackTVShowMetadataOperation
+															r.summary = "Roll back series metadata (admin)"
+															r.operationID = "rollbackTVShowMetadata"
+															r.operationGroup = ""
+															r.pathPattern = "/api/v1/tvshows/{id}/metadata/history/{version}/rollback"
+															r.args = args
+															r.count = 2
+															return r, true
+														default:
+															return
+														}
+													}
+
+												}
+
+											}
+
+										case 'l': // Prefix: "locks/"
+
+											if l := len("locks/"); len(elem) >= l && elem[0:l] == "locks/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "field"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "DELETE":
+													r.name = UnlockTVShowMetadataFieldOperation
+													r.summary = "Unlock TV show metadata field (admin)"
+													r.operationID = "unlockTVShowMetadataField"
+													r.operationGroup = ""
+													r.pathPattern = "/api/v1/tvshows/{id}/metadata/locks/{field}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetUserTVStatsOperation
-										r.summary = "Get user TV show statistics"
-										r.operationID = "getUserTVStats"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/stats"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
 									}
-								}
 
-							}
+								case 'n': // Prefix: "ne"
 
-							elem = origElem
-						}
-						// Param: "id"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+									if l := len("ne"); len(elem) >= l && elem[0:l] == "ne" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 't': // Prefix: "tworks"
+
+										if l := len("tworks"); len(elem) >= l && elem[0:l] == "tworks" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = GetTVShowOperation
-								r.summary = "Get TV show details"
-								r.operationID = "getTVShow"
-								r.operationGroup = ""
-								r.pathPattern = "/api/v1/tvshows/{id}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVShowNetworksOperation
+												r.summary = "Get TV show networks"
+												r.operationID = "getTVShowNetworks"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/{id}/networks"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									case 'x': // Prefix: "xt-episode"
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'c': // Prefix: "c"
+										if l := len("xt-episode"); len(elem) >= l && elem[0:l] == "xt-episode" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTVShowNextEpisodeOperation
+												r.summary = "Get next unwatched episode"
+												r.operationID = "getTVShowNextEpisode"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/tvshows/{id}/next-episode"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
 
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'a': // Prefix: "ast"
+									}
+
+								case 'r': // Prefix: "refresh"
 
-									if l := len("ast"); len(elem) >= l && elem[0:l] == "ast" {
+									if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
 										elem = elem[l:]
 									} else {
 										break
@@ -9544,12 +18111,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "GET":
-											r.name = GetTVShowCastOperation
-											r.summary = "Get TV show cast"
-											r.operationID = "getTVShowCast"
+										case "POST":
+											r.name = RefreshTVShowMetadataOperation
+											r.summary = "Refresh TV show metadata"
+											r.operationID = "refreshTVShowMetadata"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/{id}/cast"
+											r.pathPattern = "/api/v1/tvshows/{id}/refresh"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -9558,9 +18125,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								case 'r': // Prefix: "rew"
+								case 's': // Prefix: "seasons"
 
-									if l := len("rew"); len(elem) >= l && elem[0:l] == "rew" {
+									if l := len("seasons"); len(elem) >= l && elem[0:l] == "seasons" {
 										elem = elem[l:]
 									} else {
 										break
@@ -9570,11 +18137,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetTVShowCrewOperation
-											r.summary = "Get TV show crew"
-											r.operationID = "getTVShowCrew"
+											r.name = GetTVShowSeasonsOperation
+											r.summary = "Get TV show seasons"
+											r.operationID = "getTVShowSeasons"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/{id}/crew"
+											r.pathPattern = "/api/v1/tvshows/{id}/seasons"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -9583,73 +18150,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								}
-
-							case 'e': // Prefix: "episodes"
-
-								if l := len("episodes"); len(elem) >= l && elem[0:l] == "episodes" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetTVShowEpisodesOperation
-										r.summary = "Get all episodes for a TV show"
-										r.operationID = "getTVShowEpisodes"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/{id}/episodes"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-							case 'g': // Prefix: "genres"
-
-								if l := len("genres"); len(elem) >= l && elem[0:l] == "genres" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetTVShowGenresOperation
-										r.summary = "Get TV show genres"
-										r.operationID = "getTVShowGenres"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/{id}/genres"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-							case 'n': // Prefix: "ne"
-
-								if l := len("ne"); len(elem) >= l && elem[0:l] == "ne" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 't': // Prefix: "tworks"
+								case 't': // Prefix: "tags"
 
-									if l := len("tworks"); len(elem) >= l && elem[0:l] == "tworks" {
+									if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
 										elem = elem[l:]
 									} else {
 										break
@@ -9659,11 +18162,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetTVShowNetworksOperation
-											r.summary = "Get TV show networks"
-											r.operationID = "getTVShowNetworks"
+											r.name = GetTVShowTagsOperation
+											r.summary = "Get TV show tags"
+											r.operationID = "getTVShowTags"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/{id}/networks"
+											r.pathPattern = "/api/v1/tvshows/{id}/tags"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -9672,9 +18175,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								case 'x': // Prefix: "xt-episode"
+								case 'w': // Prefix: "watch-stats"
 
-									if l := len("xt-episode"); len(elem) >= l && elem[0:l] == "xt-episode" {
+									if l := len("watch-stats"); len(elem) >= l && elem[0:l] == "watch-stats" {
 										elem = elem[l:]
 									} else {
 										break
@@ -9684,11 +18187,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetTVShowNextEpisodeOperation
-											r.summary = "Get next unwatched episode"
-											r.operationID = "getTVShowNextEpisode"
+											r.name = GetTVShowWatchStatsOperation
+											r.summary = "Get watch stats for a TV show"
+											r.operationID = "getTVShowWatchStats"
 											r.operationGroup = ""
-											r.pathPattern = "/api/v1/tvshows/{id}/next-episode"
+											r.pathPattern = "/api/v1/tvshows/{id}/watch-stats"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -9699,81 +18202,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							case 'r': // Prefix: "refresh"
-
-								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = RefreshTVShowMetadataOperation
-										r.summary = "Refresh TV show metadata"
-										r.operationID = "refreshTVShowMetadata"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/{id}/refresh"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-							case 's': // Prefix: "seasons"
-
-								if l := len("seasons"); len(elem) >= l && elem[0:l] == "seasons" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetTVShowSeasonsOperation
-										r.summary = "Get TV show seasons"
-										r.operationID = "getTVShowSeasons"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/{id}/seasons"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-							case 'w': // Prefix: "watch-stats"
-
-								if l := len("watch-stats"); len(elem) >= l && elem[0:l] == "watch-stats" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetTVShowWatchStatsOperation
-										r.summary = "Get watch stats for a TV show"
-										r.operationID = "getTVShowWatchStats"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/tvshows/{id}/watch-stats"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
 							}
 
 						}
@@ -9948,38 +18376,103 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							case 'p': // Prefix: "preferences"
+							case 'p': // Prefix: "p"
 
-								if l := len("preferences"); len(elem) >= l && elem[0:l] == "preferences" {
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetUserPreferencesOperation
-										r.summary = "Get user preferences"
-										r.operationID = "getUserPreferences"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/users/me/preferences"
-										r.args = args
-										r.count = 0
-										return r, true
-									case "PUT":
-										r.name = UpdateUserPreferencesOperation
-										r.summary = "Update user preferences"
-										r.operationID = "updateUserPreferences"
-										r.operationGroup = ""
-										r.pathPattern = "/api/v1/users/me/preferences"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "arental-controls"
+
+									if l := len("arental-controls"); len(elem) >= l && elem[0:l] == "arental-controls" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetMyParentalControlsOperation
+											r.summary = "Get own parental controls"
+											r.operationID = "getMyParentalControls"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/users/me/parental-controls"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/unlock"
+
+										if l := len("/unlock"); len(elem) >= l && elem[0:l] == "/unlock" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = UnlockParentalControlsOperation
+												r.summary = "Lift parental controls"
+												r.operationID = "unlockParentalControls"
+												r.operationGroup = ""
+												r.pathPattern = "/api/v1/users/me/parental-controls/unlock"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'r': // Prefix: "references"
+
+									if l := len("references"); len(elem) >= l && elem[0:l] == "references" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetUserPreferencesOperation
+											r.summary = "Get user preferences"
+											r.operationID = "getUserPreferences"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/users/me/preferences"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "PUT":
+											r.name = UpdateUserPreferencesOperation
+											r.summary = "Update user preferences"
+											r.operationID = "updateUserPreferences"
+											r.operationGroup = ""
+											r.pathPattern = "/api/v1/users/me/preferences"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
 									}
+
 								}
 
 							}
@@ -42,35 +42,59 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesApiKeyAuth = map[string][]string{
+	AddPhotoAlbumPhotosOperation:             []string{},
 	AddPolicyOperation:                       []string{},
 	AdminCreateOIDCProviderOperation:         []string{},
 	AdminDeleteOIDCProviderOperation:         []string{},
 	AdminDeleteUserOperation:                 []string{},
 	AdminDisableOIDCProviderOperation:        []string{},
 	AdminEnableOIDCProviderOperation:         []string{},
+	AdminExportMovieNFOOperation:             []string{},
+	AdminExportTVShowNFOOperation:            []string{},
+	AdminGetMatchQueueItemOperation:          []string{},
 	AdminGetOIDCProviderOperation:            []string{},
+	AdminGetParentalControlsOperation:        []string{},
 	AdminGetRadarrQualityProfilesOperation:   []string{},
 	AdminGetRadarrRootFoldersOperation:       []string{},
 	AdminGetRadarrStatusOperation:            []string{},
 	AdminGetSonarrQualityProfilesOperation:   []string{},
 	AdminGetSonarrRootFoldersOperation:       []string{},
 	AdminGetSonarrStatusOperation:            []string{},
+	AdminIdentifyMatchQueueItemOperation:     []string{},
+	AdminIgnoreMatchQueueItemOperation:       []string{},
+	AdminListMatchQueueOperation:             []string{},
 	AdminListOIDCProvidersOperation:          []string{},
 	AdminListUsersOperation:                  []string{},
 	AdminSetDefaultOIDCProviderOperation:     []string{},
 	AdminTriggerRadarrSyncOperation:          []string{},
 	AdminTriggerSonarrSyncOperation:          []string{},
+	AdminUnlockMovieFileMatchOperation:       []string{},
 	AdminUpdateOIDCProviderOperation:         []string{},
+	AdminUpdateParentalControlsOperation:     []string{},
 	AssignRoleOperation:                      []string{},
 	AutocompleteMoviesOperation:              []string{},
 	AutocompleteTVShowsOperation:             []string{},
 	BeginWebAuthnLoginOperation:              []string{},
 	BeginWebAuthnRegistrationOperation:       []string{},
+	CancelLibraryScanOperation:               []string{},
 	ChangePasswordOperation:                  []string{},
 	CreateAPIKeyOperation:                    []string{},
 	CreateLibraryOperation:                   []string{},
+	CreateLiveTVRecordingOperation:           []string{},
+	CreateLiveTVSeriesRuleOperation:          []string{},
+	CreateLiveTVSourceOperation:              []string{},
+	CreatePhotoAlbumOperation:                []string{},
 	CreateRoleOperation:                      []string{},
+	DeleteAudiobookProgressOperation:         []string{},
+	DeleteBookProgressOperation:              []string{},
+	DeleteHomeVideoProgressOperation:         []string{},
 	DeleteLibraryOperation:                   []string{},
+	DeleteLiveTVRecordingOperation:           []string{},
+	DeleteLiveTVSeriesRuleOperation:          []string{},
+	DeleteLiveTVSourceOperation:              []string{},
+	DeletePhotoAlbumOperation:                []string{},
+	DeletePodcastEpisodeDownloadOperation:    []string{},
+	DeletePodcastEpisodeProgressOperation:    []string{},
 	DeleteRoleOperation:                      []string{},
 	DeleteTVEpisodeProgressOperation:         []string{},
 	DeleteUserSettingOperation:               []string{},
@@ -78,37 +102,89 @@ var operationRolesApiKeyAuth = map[string][]string{
 	DeleteWebAuthnCredentialOperation:        []string{},
 	DisableMFAOperation:                      []string{},
 	DisableTOTPOperation:                     []string{},
+	DownloadBookFileOperation:                []string{},
+	DownloadLegacySceneFileOperation:         []string{},
+	DownloadPhotoFileOperation:               []string{},
+	DownloadPodcastEpisodeOperation:          []string{},
 	EnableMFAOperation:                       []string{},
 	FinishWebAuthnLoginOperation:             []string{},
 	FinishWebAuthnRegistrationOperation:      []string{},
 	GenerateBackupCodesOperation:             []string{},
 	GetAPIKeyOperation:                       []string{},
 	GetActivityStatsOperation:                []string{},
+	GetAudiobookOperation:                    []string{},
+	GetAudiobookAuthorBooksOperation:         []string{},
+	GetAudiobookProgressOperation:            []string{},
+	GetAudiobookSeriesBooksOperation:         []string{},
+	GetBookOperation:                         []string{},
+	GetBookAuthorBooksOperation:              []string{},
+	GetBookCoverOperation:                    []string{},
+	GetBookPageOperation:                     []string{},
+	GetBookProgressOperation:                 []string{},
+	GetBookSeriesBooksOperation:              []string{},
 	GetCollectionOperation:                   []string{},
 	GetCollectionMetadataOperation:           []string{},
 	GetCollectionMoviesOperation:             []string{},
+	GetContinueListeningOperation:            []string{},
+	GetContinueReadingOperation:              []string{},
 	GetContinueWatchingOperation:             []string{},
 	GetCurrentSessionOperation:               []string{},
 	GetCurrentUserOperation:                  []string{},
 	GetEpisodeMetadataOperation:              []string{},
 	GetEpisodeMetadataImagesOperation:        []string{},
+	GetHomeFeedOperation:                     []string{},
+	GetHomeFeedRowOperation:                  []string{},
+	GetHomeVideoOperation:                    []string{},
+	GetHomeVideoFolderOperation:              []string{},
+	GetHomeVideoProgressOperation:            []string{},
+	GetHomeVideoThumbnailOperation:           []string{},
+	GetLegacyAccessOperation:                 []string{},
+	GetLegacyPerformerOperation:              []string{},
+	GetLegacySceneOperation:                  []string{},
+	GetLegacyStudioOperation:                 []string{},
 	GetLibraryOperation:                      []string{},
+	GetLiveTVChannelOperation:                []string{},
+	GetLiveTVGuideOperation:                  []string{},
+	GetLiveTVProgramOperation:                []string{},
+	GetLiveTVRecordingOperation:              []string{},
 	GetMFAStatusOperation:                    []string{},
 	GetMovieOperation:                        []string{},
 	GetMovieCastOperation:                    []string{},
 	GetMovieCollectionOperation:              []string{},
 	GetMovieCrewOperation:                    []string{},
+	GetMovieEditionsOperation:                []string{},
 	GetMovieExternalIDsOperation:             []string{},
+	GetMovieExtrasOperation:                  []string{},
 	GetMovieFilesOperation:                   []string{},
 	GetMovieGenresOperation:                  []string{},
 	GetMovieMetadataOperation:                []string{},
 	GetMovieMetadataCreditsOperation:         []string{},
 	GetMovieMetadataImagesOperation:          []string{},
 	GetMovieRecommendationsMetadataOperation: []string{},
+	GetMovieTagsOperation:                    []string{},
+	GetMusicAlbumOperation:                   []string{},
+	GetMusicAlbumEditionsOperation:           []string{},
+	GetMusicArtistOperation:                  []string{},
+	GetMusicArtistAlbumsOperation:            []string{},
+	GetMusicArtistTracksOperation:            []string{},
+	GetMusicTrackOperation:                   []string{},
+	GetMusicVideoOperation:                   []string{},
+	GetMusicVideoArtistOperation:             []string{},
+	GetMyParentalControlsOperation:           []string{},
+	GetPersonOperation:                       []string{},
+	GetPersonFilmographyOperation:            []string{},
 	GetPersonMetadataOperation:               []string{},
 	GetPersonMetadataCreditsOperation:        []string{},
 	GetPersonMetadataImagesOperation:         []string{},
+	GetPhotoOperation:                        []string{},
+	GetPhotoAlbumOperation:                   []string{},
+	GetPhotoThumbnailOperation:               []string{},
+	GetPhotoTimelineOperation:                []string{},
 	GetPlaybackSessionOperation:              []string{},
+	GetPodcastOperation:                      []string{},
+	GetPodcastEpisodeOperation:               []string{},
+	GetPodcastEpisodeProgressOperation:       []string{},
+	GetPodcastEpisodeTranscriptOperation:     []string{},
 	GetRecentActionsOperation:                []string{},
 	GetRecentEpisodesOperation:               []string{},
 	GetRecentlyAddedOperation:                []string{},
@@ -141,6 +217,7 @@ var operationRolesApiKeyAuth = map[string][]string{
 	GetTVShowNextEpisodeOperation:            []string{},
 	GetTVShowSearchFacetsOperation:           []string{},
 	GetTVShowSeasonsOperation:                []string{},
+	GetTVShowTagsOperation:                   []string{},
 	GetTVShowWatchStatsOperation:             []string{},
 	GetTopRatedOperation:                     []string{},
 	GetUpcomingEpisodesOperation:             []string{},
@@ -154,34 +231,76 @@ var operationRolesApiKeyAuth = map[string][]string{
 	GetWatchHistoryOperation:                 []string{},
 	GetWatchProgressOperation:                []string{},
 	GrantLibraryPermissionOperation:          []string{},
+	HeartbeatPlaybackSessionOperation:        []string{},
 	InitOIDCLinkOperation:                    []string{},
 	ListAPIKeysOperation:                     []string{},
+	ListAudiobookAuthorsOperation:            []string{},
+	ListAudiobookSeriesOperation:             []string{},
+	ListAudiobooksOperation:                  []string{},
+	ListBookAuthorsOperation:                 []string{},
+	ListBookSeriesOperation:                  []string{},
+	ListBooksOperation:                       []string{},
 	ListGenresOperation:                      []string{},
+	ListHomeVideoContinueWatchingOperation:   []string{},
+	ListHomeVideoFoldersOperation:            []string{},
+	ListHomeVideosOperation:                  []string{},
+	ListLegacyPerformersOperation:            []string{},
+	ListLegacyScenesOperation:                []string{},
+	ListLegacyStudiosOperation:               []string{},
 	ListLibrariesOperation:                   []string{},
 	ListLibraryPermissionsOperation:          []string{},
 	ListLibraryScansOperation:                []string{},
+	ListLiveTVChannelsOperation:              []string{},
+	ListLiveTVRecordingsOperation:            []string{},
+	ListLiveTVSeriesRulesOperation:           []string{},
+	ListLiveTVSourcesOperation:               []string{},
 	ListMetadataProvidersOperation:           []string{},
+	ListMovieArtworkOperation:                []string{},
+	ListMovieMetadataHistoryOperation:        []string{},
 	ListMoviesOperation:                      []string{},
+	ListMoviesByTagOperation:                 []string{},
+	ListMusicAlbumsOperation:                 []string{},
+	ListMusicArtistsOperation:                []string{},
+	ListMusicVideoArtistsOperation:           []string{},
+	ListMusicVideosOperation:                 []string{},
+	ListNewPodcastEpisodesOperation:          []string{},
 	ListPermissionsOperation:                 []string{},
+	ListPhotoAlbumPhotosOperation:            []string{},
+	ListPhotoAlbumSharesOperation:            []string{},
+	ListPhotoAlbumsOperation:                 []string{},
+	ListPhotosOperation:                      []string{},
+	ListPodcastEpisodesOperation:             []string{},
+	ListPodcastsOperation:                    []string{},
 	ListPoliciesOperation:                    []string{},
 	ListRolesOperation:                       []string{},
 	ListServerSettingsOperation:              []string{},
 	ListSessionsOperation:                    []string{},
+	ListTVEpisodeMetadataHistoryOperation:    []string{},
+	ListTVSeasonMetadataHistoryOperation:     []string{},
+	ListTVShowArtworkOperation:               []string{},
+	ListTVShowMetadataHistoryOperation:       []string{},
 	ListTVShowsOperation:                     []string{},
+	ListTVShowsByTagOperation:                []string{},
+	ListTagsOperation:                        []string{},
 	ListUserOIDCLinksOperation:               []string{},
 	ListUserSettingsOperation:                []string{},
 	ListWebAuthnCredentialsOperation:         []string{},
+	LockLegacyOperation:                      []string{},
 	LogoutOperation:                          []string{},
 	LogoutAllOperation:                       []string{},
 	LogoutCurrentOperation:                   []string{},
 	MarkAsWatchedOperation:                   []string{},
 	MarkTVEpisodeWatchedOperation:            []string{},
 	MarkTVEpisodesBulkWatchedOperation:       []string{},
+	RefreshLiveTVSourceOperation:             []string{},
 	RefreshMovieMetadataOperation:            []string{},
+	RefreshPersonOperation:                   []string{},
+	RefreshPodcastOperation:                  []string{},
 	RefreshTVShowMetadataOperation:           []string{},
 	RegenerateBackupCodesOperation:           []string{},
 	ReindexSearchOperation:                   []string{},
 	ReindexTVShowSearchOperation:             []string{},
+	RemovePhotoAlbumPhotoOperation:           []string{},
 	RemovePolicyOperation:                    []string{},
 	RemoveRoleOperation:                      []string{},
 	RenameWebAuthnCredentialOperation:        []string{},
@@ -189,8 +308,14 @@ var operationRolesApiKeyAuth = map[string][]string{
 	RevokeAPIKeyOperation:                    []string{},
 	RevokeLibraryPermissionOperation:         []string{},
 	RevokeSessionOperation:                   []string{},
+	RollbackMovieMetadataOperation:           []string{},
+	RollbackTVEpisodeMetadataOperation:       []string{},
+	RollbackTVSeasonMetadataOperation:        []string{},
+	RollbackTVShowMetadataOperation:          []string{},
 	SearchActivityLogsOperation:              []string{},
+	SearchLibraryHomeVideosOperation:         []string{},
 	SearchLibraryMoviesOperation:             []string{},
+	SearchLibraryMusicOperation:              []string{},
 	SearchLibraryTVShowsOperation:            []string{},
 	SearchMoviesOperation:                    []string{},
 	SearchMoviesMetadataOperation:            []string{},
@@ -198,16 +323,41 @@ var operationRolesApiKeyAuth = map[string][]string{
 	SearchPersonMetadataOperation:            []string{},
 	SearchTVShowsOperation:                   []string{},
 	SearchTVShowsMetadataOperation:           []string{},
+	SelectMovieArtworkOperation:              []string{},
+	SelectTVShowArtworkOperation:             []string{},
+	SetLegacyPINOperation:                    []string{},
 	SetupTOTPOperation:                       []string{},
+	SharePhotoAlbumOperation:                 []string{},
 	StartPlaybackSessionOperation:            []string{},
 	StopPlaybackSessionOperation:             []string{},
+	SubscribePodcastOperation:                []string{},
+	SubscribePodcastFeedOperation:            []string{},
 	TriggerLibraryScanOperation:              []string{},
 	UnlinkOIDCProviderOperation:              []string{},
+	UnlockLegacyOperation:                    []string{},
+	UnlockMovieMetadataFieldOperation:        []string{},
+	UnlockParentalControlsOperation:          []string{},
+	UnlockTVEpisodeMetadataFieldOperation:    []string{},
+	UnlockTVSeasonMetadataFieldOperation:     []string{},
+	UnlockTVShowMetadataFieldOperation:       []string{},
+	UnsharePhotoAlbumOperation:               []string{},
+	UnsubscribePodcastOperation:              []string{},
+	UpdateAudiobookProgressOperation:         []string{},
+	UpdateBookProgressOperation:              []string{},
 	UpdateCurrentUserOperation:               []string{},
+	UpdateHomeVideoProgressOperation:         []string{},
 	UpdateLibraryOperation:                   []string{},
+	UpdateLiveTVChannelOperation:             []string{},
+	UpdateMovieMetadataOperation:             []string{},
+	UpdatePhotoAlbumOperation:                []string{},
+	UpdatePodcastEpisodeProgressOperation:    []string{},
+	UpdatePodcastSettingsOperation:           []string{},
 	UpdateRolePermissionsOperation:           []string{},
 	UpdateServerSettingOperation:             []string{},
+	UpdateTVEpisodeMetadataOperation:         []string{},
 	UpdateTVEpisodeProgressOperation:         []string{},
+	UpdateTVSeasonMetadataOperation:          []string{},
+	UpdateTVShowMetadataOperation:            []string{},
 	UpdateUserPreferencesOperation:           []string{},
 	UpdateUserSettingOperation:               []string{},
 	UpdateWatchProgressOperation:             []string{},
@@ -234,35 +384,59 @@ func (s *Server) securityApiKeyAuth(ctx context.Context, operationName Operation
 }
 
 var operationRolesBearerAuth = map[string][]string{
+	AddPhotoAlbumPhotosOperation:             []string{},
 	AddPolicyOperation:                       []string{},
 	AdminCreateOIDCProviderOperation:         []string{},
 	AdminDeleteOIDCProviderOperation:         []string{},
 	AdminDeleteUserOperation:                 []string{},
 	AdminDisableOIDCProviderOperation:        []string{},
 	AdminEnableOIDCProviderOperation:         []string{},
+	AdminExportMovieNFOOperation:             []string{},
+	AdminExportTVShowNFOOperation:            []string{},
+	AdminGetMatchQueueItemOperation:          []string{},
 	AdminGetOIDCProviderOperation:            []string{},
+	AdminGetParentalControlsOperation:        []string{},
 	AdminGetRadarrQualityProfilesOperation:   []string{},
 	AdminGetRadarrRootFoldersOperation:       []string{},
 	AdminGetRadarrStatusOperation:            []string{},
 	AdminGetSonarrQualityProfilesOperation:   []string{},
 	AdminGetSonarrRootFoldersOperation:       []string{},
 	AdminGetSonarrStatusOperation:            []string{},
+	AdminIdentifyMatchQueueItemOperation:     []string{},
+	AdminIgnoreMatchQueueItemOperation:       []string{},
+	AdminListMatchQueueOperation:             []string{},
 	AdminListOIDCProvidersOperation:          []string{},
 	AdminListUsersOperation:                  []string{},
 	AdminSetDefaultOIDCProviderOperation:     []string{},
 	AdminTriggerRadarrSyncOperation:          []string{},
 	AdminTriggerSonarrSyncOperation:          []string{},
+	AdminUnlockMovieFileMatchOperation:       []string{},
 	AdminUpdateOIDCProviderOperation:         []string{},
+	AdminUpdateParentalControlsOperation:     []string{},
 	AssignRoleOperation:                      []string{},
 	AutocompleteMoviesOperation:              []string{},
 	AutocompleteTVShowsOperation:             []string{},
 	BeginWebAuthnLoginOperation:              []string{},
 	BeginWebAuthnRegistrationOperation:       []string{},
+	CancelLibraryScanOperation:               []string{},
 	ChangePasswordOperation:                  []string{},
 	CreateAPIKeyOperation:                    []string{},
 	CreateLibraryOperation:                   []string{},
+	CreateLiveTVRecordingOperation:           []string{},
+	CreateLiveTVSeriesRuleOperation:          []string{},
+	CreateLiveTVSourceOperation:              []string{},
+	CreatePhotoAlbumOperation:                []string{},
 	CreateRoleOperation:                      []string{},
+	DeleteAudiobookProgressOperation:         []string{},
+	DeleteBookProgressOperation:              []string{},
+	DeleteHomeVideoProgressOperation:         []string{},
 	DeleteLibraryOperation:                   []string{},
+	DeleteLiveTVRecordingOperation:           []string{},
+	DeleteLiveTVSeriesRuleOperation:          []string{},
+	DeleteLiveTVSourceOperation:              []string{},
+	DeletePhotoAlbumOperation:                []string{},
+	DeletePodcastEpisodeDownloadOperation:    []string{},
+	DeletePodcastEpisodeProgressOperation:    []string{},
 	DeleteRoleOperation:                      []string{},
 	DeleteTVEpisodeProgressOperation:         []string{},
 	DeleteUserSettingOperation:               []string{},
@@ -270,37 +444,89 @@ var operationRolesBearerAuth = map[string][]string{
 	DeleteWebAuthnCredentialOperation:        []string{},
 	DisableMFAOperation:                      []string{},
 	DisableTOTPOperation:                     []string{},
+	DownloadBookFileOperation:                []string{},
+	DownloadLegacySceneFileOperation:         []string{},
+	DownloadPhotoFileOperation:               []string{},
+	DownloadPodcastEpisodeOperation:          []string{},
 	EnableMFAOperation:                       []string{},
 	FinishWebAuthnLoginOperation:             []string{},
 	FinishWebAuthnRegistrationOperation:      []string{},
 	GenerateBackupCodesOperation:             []string{},
 	GetAPIKeyOperation:                       []string{},
 	GetActivityStatsOperation:                []string{},
+	GetAudiobookOperation:                    []string{},
+	GetAudiobookAuthorBooksOperation:         []string{},
+	GetAudiobookProgressOperation:            []string{},
+	GetAudiobookSeriesBooksOperation:         []string{},
+	GetBookOperation:                         []string{},
+	GetBookAuthorBooksOperation:              []string{},
+	GetBookCoverOperation:                    []string{},
+	GetBookPageOperation:                     []string{},
+	GetBookProgressOperation:                 []string{},
+	GetBookSeriesBooksOperation:              []string{},
 	GetCollectionOperation:                   []string{},
 	GetCollectionMetadataOperation:           []string{},
 	GetCollectionMoviesOperation:             []string{},
+	GetContinueListeningOperation:            []string{},
+	GetContinueReadingOperation:              []string{},
 	GetContinueWatchingOperation:             []string{},
 	GetCurrentSessionOperation:               []string{},
 	GetCurrentUserOperation:                  []string{},
 	GetEpisodeMetadataOperation:              []string{},
 	GetEpisodeMetadataImagesOperation:        []string{},
+	GetHomeFeedOperation:                     []string{},
+	GetHomeFeedRowOperation:                  []string{},
+	GetHomeVideoOperation:                    []string{},
+	GetHomeVideoFolderOperation:              []string{},
+	GetHomeVideoProgressOperation:            []string{},
+	GetHomeVideoThumbnailOperation:           []string{},
+	GetLegacyAccessOperation:                 []string{},
+	GetLegacyPerformerOperation:              []string{},
+	GetLegacySceneOperation:                  []string{},
+	GetLegacyStudioOperation:                 []string{},
 	GetLibraryOperation:                      []string{},
+	GetLiveTVChannelOperation:                []string{},
+	GetLiveTVGuideOperation:                  []string{},
+	GetLiveTVProgramOperation:                []string{},
+	GetLiveTVRecordingOperation:              []string{},
 	GetMFAStatusOperation:                    []string{},
 	GetMovieOperation:                        []string{},
 	GetMovieCastOperation:                    []string{},
 	GetMovieCollectionOperation:              []string{},
 	GetMovieCrewOperation:                    []string{},
+	GetMovieEditionsOperation:                []string{},
 	GetMovieExternalIDsOperation:             []string{},
+	GetMovieExtrasOperation:                  []string{},
 	GetMovieFilesOperation:                   []string{},
 	GetMovieGenresOperation:                  []string{},
 	GetMovieMetadataOperation:                []string{},
 	GetMovieMetadataCreditsOperation:         []string{},
 	GetMovieMetadataImagesOperation:          []string{},
 	GetMovieRecommendationsMetadataOperation: []string{},
+	GetMovieTagsOperation:                    []string{},
+	GetMusicAlbumOperation:                   []string{},
+	GetMusicAlbumEditionsOperation:           []string{},
+	GetMusicArtistOperation:                  []string{},
+	GetMusicArtistAlbumsOperation:            []string{},
+	GetMusicArtistTracksOperation:            []string{},
+	GetMusicTrackOperation:                   []string{},
+	GetMusicVideoOperation:                   []string{},
+	GetMusicVideoArtistOperation:             []string{},
+	GetMyParentalControlsOperation:           []string{},
+	GetPersonOperation:                       []string{},
+	GetPersonFilmographyOperation:            []string{},
 	GetPersonMetadataOperation:               []string{},
 	GetPersonMetadataCreditsOperation:        []string{},
 	GetPersonMetadataImagesOperation:         []string{},
+	GetPhotoOperation:                        []string{},
+	GetPhotoAlbumOperation:                   []string{},
+	GetPhotoThumbnailOperation:               []string{},
+	GetPhotoTimelineOperation:                []string{},
 	GetPlaybackSessionOperation:              []string{},
+	GetPodcastOperation:                      []string{},
+	GetPodcastEpisodeOperation:               []string{},
+	GetPodcastEpisodeProgressOperation:       []string{},
+	GetPodcastEpisodeTranscriptOperation:     []string{},
 	GetRecentActionsOperation:                []string{},
 	GetRecentEpisodesOperation:               []string{},
 	GetRecentlyAddedOperation:                []string{},
@@ -333,6 +559,7 @@ var operationRolesBearerAuth = map[string][]string{
 	GetTVShowNextEpisodeOperation:            []string{},
 	GetTVShowSearchFacetsOperation:           []string{},
 	GetTVShowSeasonsOperation:                []string{},
+	GetTVShowTagsOperation:                   []string{},
 	GetTVShowWatchStatsOperation:             []string{},
 	GetTopRatedOperation:                     []string{},
 	GetUpcomingEpisodesOperation:             []string{},
@@ -346,34 +573,76 @@ var operationRolesBearerAuth = map[string][]string{
 	GetWatchHistoryOperation:                 []string{},
 	GetWatchProgressOperation:                []string{},
 	GrantLibraryPermissionOperation:          []string{},
+	HeartbeatPlaybackSessionOperation:        []string{},
 	InitOIDCLinkOperation:                    []string{},
 	ListAPIKeysOperation:                     []string{},
+	ListAudiobookAuthorsOperation:            []string{},
+	ListAudiobookSeriesOperation:             []string{},
+	ListAudiobooksOperation:                  []string{},
+	ListBookAuthorsOperation:                 []string{},
+	ListBookSeriesOperation:                  []string{},
+	ListBooksOperation:                       []string{},
 	ListGenresOperation:                      []string{},
+	ListHomeVideoContinueWatchingOperation:   []string{},
+	ListHomeVideoFoldersOperation:            []string{},
+	ListHomeVideosOperation:                  []string{},
+	ListLegacyPerformersOperation:            []string{},
+	ListLegacyScenesOperation:                []string{},
+	ListLegacyStudiosOperation:               []string{},
 	ListLibrariesOperation:                   []string{},
 	ListLibraryPermissionsOperation:          []string{},
 	ListLibraryScansOperation:                []string{},
+	ListLiveTVChannelsOperation:              []string{},
+	ListLiveTVRecordingsOperation:            []string{},
+	ListLiveTVSeriesRulesOperation:           []string{},
+	ListLiveTVSourcesOperation:               []string{},
 	ListMetadataProvidersOperation:           []string{},
+	ListMovieArtworkOperation:                []string{},
+	ListMovieMetadataHistoryOperation:        []string{},
 	ListMoviesOperation:                      []string{},
+	ListMoviesByTagOperation:                 []string{},
+	ListMusicAlbumsOperation:                 []string{},
+	ListMusicArtistsOperation:                []string{},
+	ListMusicVideoArtistsOperation:           []string{},
+	ListMusicVideosOperation:                 []string{},
+	ListNewPodcastEpisodesOperation:          []string{},
 	ListPermissionsOperation:                 []string{},
+	ListPhotoAlbumPhotosOperation:            []string{},
+	ListPhotoAlbumSharesOperation:            []string{},
+	ListPhotoAlbumsOperation:                 []string{},
+	ListPhotosOperation:                      []string{},
+	ListPodcastEpisodesOperation:             []string{},
+	ListPodcastsOperation:                    []string{},
 	ListPoliciesOperation:                    []string{},
 	ListRolesOperation:                       []string{},
 	ListServerSettingsOperation:              []string{},
 	ListSessionsOperation:                    []string{},
+	ListTVEpisodeMetadataHistoryOperation:    []string{},
+	ListTVSeasonMetadataHistoryOperation:     []string{},
+	ListTVShowArtworkOperation:               []string{},
+	ListTVShowMetadataHistoryOperation:       []string{},
 	ListTVShowsOperation:                     []string{},
+	ListTVShowsByTagOperation:                []string{},
+	ListTagsOperation:                        []string{},
 	ListUserOIDCLinksOperation:               []string{},
 	ListUserSettingsOperation:                []string{},
 	ListWebAuthnCredentialsOperation:         []string{},
+	LockLegacyOperation:                      []string{},
 	LogoutOperation:                          []string{},
 	LogoutAllOperation:                       []string{},
 	LogoutCurrentOperation:                   []string{},
 	MarkAsWatchedOperation:                   []string{},
 	MarkTVEpisodeWatchedOperation:            []string{},
 	MarkTVEpisodesBulkWatchedOperation:       []string{},
+	RefreshLiveTVSourceOperation:             []string{},
 	RefreshMovieMetadataOperation:            []string{},
+	RefreshPersonOperation:                   []string{},
+	RefreshPodcastOperation:                  []string{},
 	RefreshTVShowMetadataOperation:           []string{},
 	RegenerateBackupCodesOperation:           []string{},
 	ReindexSearchOperation:                   []string{},
 	ReindexTVShowSearchOperation:             []string{},
+	RemovePhotoAlbumPhotoOperation:           []string{},
 	RemovePolicyOperation:                    []string{},
 	RemoveRoleOperation:                      []string{},
 	RenameWebAuthnCredentialOperation:        []string{},
@@ -381,8 +650,14 @@ var operationRolesBearerAuth = map[string][]string{
 	RevokeAPIKeyOperation:                    []string{},
 	RevokeLibraryPermissionOperation:         []string{},
 	RevokeSessionOperation:                   []string{},
+	RollbackMovieMetadataOperation:           []string{},
+	RollbackTVEpisodeMetadataOperation:       []string{},
+	RollbackTVSeasonMetadataOperation:        []string{},
+	RollbackTVShowMetadataOperation:          []string{},
 	SearchActivityLogsOperation:              []string{},
+	SearchLibraryHomeVideosOperation:         []string{},
 	SearchLibraryMoviesOperation:             []string{},
+	SearchLibraryMusicOperation:              []string{},
 	SearchLibraryTVShowsOperation:            []string{},
 	SearchMoviesOperation:                    []string{},
 	SearchMoviesMetadataOperation:            []string{},
@@ -390,16 +665,41 @@ var operationRolesBearerAuth = map[string][]string{
 	SearchPersonMetadataOperation:            []string{},
 	SearchTVShowsOperation:                   []string{},
 	SearchTVShowsMetadataOperation:           []string{},
+	SelectMovieArtworkOperation:              []string{},
+	SelectTVShowArtworkOperation:             []string{},
+	SetLegacyPINOperation:                    []string{},
 	SetupTOTPOperation:                       []string{},
+	SharePhotoAlbumOperation:                 []string{},
 	StartPlaybackSessionOperation:            []string{},
 	StopPlaybackSessionOperation:             []string{},
+	SubscribePodcastOperation:                []string{},
+	SubscribePodcastFeedOperation:            []string{},
 	TriggerLibraryScanOperation:              []string{},
 	UnlinkOIDCProviderOperation:              []string{},
+	UnlockLegacyOperation:                    []string{},
+	UnlockMovieMetadataFieldOperation:        []string{},
+	UnlockParentalControlsOperation:          []string{},
+	UnlockTVEpisodeMetadataFieldOperation:    []string{},
+	UnlockTVSeasonMetadataFieldOperation:     []string{},
+	UnlockTVShowMetadataFieldOperation:       []string{},
+	UnsharePhotoAlbumOperation:               []string{},
+	UnsubscribePodcastOperation:              []string{},
+	UpdateAudiobookProgressOperation:         []string{},
+	UpdateBookProgressOperation:              []string{},
 	UpdateCurrentUserOperation:               []string{},
+	UpdateHomeVideoProgressOperation:         []string{},
 	UpdateLibraryOperation:                   []string{},
+	UpdateLiveTVChannelOperation:             []string{},
+	UpdateMovieMetadataOperation:             []string{},
+	UpdatePhotoAlbumOperation:                []string{},
+	UpdatePodcastEpisodeProgressOperation:    []string{},
+	UpdatePodcastSettingsOperation:           []string{},
 	UpdateRolePermissionsOperation:           []string{},
 	UpdateServerSettingOperation:             []string{},
+	UpdateTVEpisodeMetadataOperation:         []string{},
 	UpdateTVEpisodeProgressOperation:         []string{},
+	UpdateTVSeasonMetadataOperation:          []string{},
+	UpdateTVShowMetadataOperation:            []string{},
 	UpdateUserPreferencesOperation:           []string{},
 	UpdateUserSettingOperation:               []string{},
 	UpdateWatchProgressOperation:             []string{},
@@ -8,6 +8,13 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddPhotoAlbumPhotos implements addPhotoAlbumPhotos operation.
+	//
+	// Append photos of the album's library to a user album. The owner and
+	// users it is shared with as contributors may add photos.
+	//
+	// POST /api/v1/photos/albums/{id}/photos
+	AddPhotoAlbumPhotos(ctx context.Context, req *PhotoAlbumPhotosAdd, params AddPhotoAlbumPhotosParams) (AddPhotoAlbumPhotosRes, error)
 	// AddPolicy implements addPolicy operation.
 	//
 	// Add a new authorization policy (admin only).
@@ -44,12 +51,40 @@ type Handler interface {
 	//
 	// POST /api/v1/admin/oidc/providers/{providerId}/enable
 	AdminEnableOIDCProvider(ctx context.Context, params AdminEnableOIDCProviderParams) (AdminEnableOIDCProviderRes, error)
+	// AdminExportMovieNFO implements adminExportMovieNFO operation.
+	//
+	// Queues a job that writes Kodi/Jellyfin compatible NFO files next to
+	// movie files, so other tools on the same share stay in sync. Without a
+	// movie ID the whole movie library is exported. Admin only.
+	//
+	// POST /api/v1/admin/movies/nfo-export
+	AdminExportMovieNFO(ctx context.Context, req OptNFOExportRequest) (AdminExportMovieNFORes, error)
+	// AdminExportTVShowNFO implements adminExportTVShowNFO operation.
+	//
+	// Queues a job that writes Kodi/Jellyfin compatible tvshow.nfo and
+	// episode NFO files next to episode files. Without a series ID the
+	// whole TV show library is exported. Admin only.
+	//
+	// POST /api/v1/admin/tvshows/nfo-export
+	AdminExportTVShowNFO(ctx context.Context, req OptTVShowNFOExportRequest) (AdminExportTVShowNFORes, error)
+	// AdminGetMatchQueueItem implements adminGetMatchQueueItem operation.
+	//
+	// Returns a single queued file with its candidates. Admin only.
+	//
+	// GET /api/v1/admin/match-queue/{itemId}
+	AdminGetMatchQueueItem(ctx context.Context, params AdminGetMatchQueueItemParams) (AdminGetMatchQueueItemRes, error)
 	// AdminGetOIDCProvider implements adminGetOIDCProvider operation.
 	//
 	// Returns detailed OIDC provider configuration.
 	//
 	// GET /api/v1/admin/oidc/providers/{providerId}
 	AdminGetOIDCProvider(ctx context.Context, params AdminGetOIDCProviderParams) (AdminGetOIDCProviderRes, error)
+	// AdminGetParentalControls implements adminGetParentalControls operation.
+	//
+	// Returns the content restrictions of a user profile.
+	//
+	// GET /api/v1/admin/users/{userId}/parental-controls
+	AdminGetParentalControls(ctx context.Context, params AdminGetParentalControlsParams) (AdminGetParentalControlsRes, error)
 	// AdminGetRadarrQualityProfiles implements adminGetRadarrQualityProfiles operation.
 	//
 	// Returns all quality profiles configured in Radarr.
@@ -96,6 +131,28 @@ type Handler interface {
 	//
 	// GET /api/v1/admin/integrations/sonarr/status
 	AdminGetSonarrStatus(ctx context.Context) (AdminGetSonarrStatusRes, error)
+	// AdminIdentifyMatchQueueItem implements adminIdentifyMatchQueueItem operation.
+	//
+	// Manually matches a queued file, either by picking one of its candidates
+	// or by entering a provider ID. The file's match is locked so later scans
+	// leave it alone. Admin only.
+	//
+	// POST /api/v1/admin/match-queue/{itemId}/identify
+	AdminIdentifyMatchQueueItem(ctx context.Context, req *IdentifyMatchRequest, params AdminIdentifyMatchQueueItemParams) (AdminIdentifyMatchQueueItemRes, error)
+	// AdminIgnoreMatchQueueItem implements adminIgnoreMatchQueueItem operation.
+	//
+	// Hides a queued file. Rescans keep it ignored. Admin only.
+	//
+	// POST /api/v1/admin/match-queue/{itemId}/ignore
+	AdminIgnoreMatchQueueItem(ctx context.Context, params AdminIgnoreMatchQueueItemParams) (AdminIgnoreMatchQueueItemRes, error)
+	// AdminListMatchQueue implements adminListMatchQueue operation.
+	//
+	// Lists scanned files the matcher could not identify, or matched below the
+	// confidence threshold, together with their top provider candidates.
+	// Admin only.
+	//
+	// GET /api/v1/admin/match-queue
+	AdminListMatchQueue(ctx context.Context, params AdminListMatchQueueParams) (AdminListMatchQueueRes, error)
 	// AdminListOIDCProviders implements adminListOIDCProviders operation.
 	//
 	// Returns all OIDC providers including disabled ones.
@@ -131,12 +188,26 @@ type Handler interface {
 	//
 	// POST /api/v1/admin/integrations/sonarr/sync
 	AdminTriggerSonarrSync(ctx context.Context) (AdminTriggerSonarrSyncRes, error)
+	// AdminUnlockMovieFileMatch implements adminUnlockMovieFileMatch operation.
+	//
+	// Clears the match lock set by manual identification so the next scan may
+	// rematch the file. Admin only.
+	//
+	// DELETE /api/v1/admin/movies/files/{fileId}/match-lock
+	AdminUnlockMovieFileMatch(ctx context.Context, params AdminUnlockMovieFileMatchParams) (AdminUnlockMovieFileMatchRes, error)
 	// AdminUpdateOIDCProvider implements adminUpdateOIDCProvider operation.
 	//
 	// Updates an OIDC provider configuration.
 	//
 	// PATCH /api/v1/admin/oidc/providers/{providerId}
 	AdminUpdateOIDCProvider(ctx context.Context, req *UpdateOIDCProviderRequest, params AdminUpdateOIDCProviderParams) (AdminUpdateOIDCProviderRes, error)
+	// AdminUpdateParentalControls implements adminUpdateParentalControls operation.
+	//
+	// Replaces the content restrictions of a user profile. Restrictions are
+	// enforced on lists, search, continue watching and playback start.
+	//
+	// PUT /api/v1/admin/users/{userId}/parental-controls
+	AdminUpdateParentalControls(ctx context.Context, req *ParentalControlsUpdate, params AdminUpdateParentalControlsParams) (AdminUpdateParentalControlsRes, error)
 	// AssignRole implements assignRole operation.
 	//
 	// Assign a role to a user (admin only).
@@ -169,6 +240,13 @@ type Handler interface {
 	//
 	// POST /api/v1/mfa/webauthn/register/begin
 	BeginWebAuthnRegistration(ctx context.Context, req OptBeginWebAuthnRegistrationReq) (BeginWebAuthnRegistrationRes, error)
+	// CancelLibraryScan implements cancelLibraryScan operation.
+	//
+	// Cancel a pending or running library scan. The scan is marked cancelled
+	// and its background job is stopped. Admin only.
+	//
+	// POST /api/v1/libraries/{libraryId}/scans/{scanId}/cancel
+	CancelLibraryScan(ctx context.Context, params CancelLibraryScanParams) (CancelLibraryScanRes, error)
 	// ChangePassword implements changePassword operation.
 	//
 	// Change password for authenticated user (requires old password).
@@ -187,18 +265,107 @@ type Handler interface {
 	//
 	// POST /api/v1/libraries
 	CreateLibrary(ctx context.Context, req *CreateLibraryRequest) (CreateLibraryRes, error)
+	// CreateLiveTVRecording implements createLiveTVRecording operation.
+	//
+	// Schedule a recording of a guide program, or of a channel between
+	// two times. Recordings start early and end late by their padding,
+	// which defaults to the server settings, and are imported into the
+	// given TV library, or the first one.
+	//
+	// POST /api/v1/livetv/recordings
+	CreateLiveTVRecording(ctx context.Context, req *LiveTVRecordingCreate) (CreateLiveTVRecordingRes, error)
+	// CreateLiveTVSeriesRule implements createLiveTVSeriesRule operation.
+	//
+	// Record every upcoming airing of a title, optionally on one channel
+	// and only when it is new. Matching airings are scheduled whenever
+	// the guide is refreshed.
+	//
+	// POST /api/v1/livetv/series-rules
+	CreateLiveTVSeriesRule(ctx context.Context, req *LiveTVSeriesRuleCreate) (CreateLiveTVSeriesRuleRes, error)
+	// CreateLiveTVSource implements createLiveTVSource operation.
+	//
+	// Add an M3U channel list (a network tuner's lineup or an IPTV
+	// playlist) or an XMLTV guide, by http(s) URL or absolute local path.
+	// The source is loaded by a refresh that is queued right away. Admin
+	// only.
+	//
+	// POST /api/v1/livetv/sources
+	CreateLiveTVSource(ctx context.Context, req *LiveTVSourceCreate) (CreateLiveTVSourceRes, error)
+	// CreatePhotoAlbum implements createPhotoAlbum operation.
+	//
+	// Create a user album in a photo library.
+	//
+	// POST /api/v1/photos/albums
+	CreatePhotoAlbum(ctx context.Context, req *PhotoAlbumCreate) (CreatePhotoAlbumRes, error)
 	// CreateRole implements createRole operation.
 	//
 	// Create a new custom role (admin only).
 	//
 	// POST /api/v1/rbac/roles
 	CreateRole(ctx context.Context, req *CreateRoleRequest) (CreateRoleRes, error)
+	// DeleteAudiobookProgress implements deleteAudiobookProgress operation.
+	//
+	// Remove the user's listening progress for an audiobook.
+	//
+	// DELETE /api/v1/audiobooks/{id}/progress
+	DeleteAudiobookProgress(ctx context.Context, params DeleteAudiobookProgressParams) (DeleteAudiobookProgressRes, error)
+	// DeleteBookProgress implements deleteBookProgress operation.
+	//
+	// Remove the user's reading progress for a book.
+	//
+	// DELETE /api/v1/books/{id}/progress
+	DeleteBookProgress(ctx context.Context, params DeleteBookProgressParams) (DeleteBookProgressRes, error)
+	// DeleteHomeVideoProgress implements deleteHomeVideoProgress operation.
+	//
+	// Remove the user's watch progress for a home video.
+	//
+	// DELETE /api/v1/homevideos/{id}/progress
+	DeleteHomeVideoProgress(ctx context.Context, params DeleteHomeVideoProgressParams) (DeleteHomeVideoProgressRes, error)
 	// DeleteLibrary implements deleteLibrary operation.
 	//
 	// Delete a library and all its content. Admin only.
 	//
 	// DELETE /api/v1/libraries/{libraryId}
 	DeleteLibrary(ctx context.Context, params DeleteLibraryParams) (DeleteLibraryRes, error)
+	// DeleteLiveTVRecording implements deleteLiveTVRecording operation.
+	//
+	// Cancel a scheduled recording, or remove a finished one from the
+	// list. Recorded files stay in the TV library. Running recordings
+	// can't be deleted.
+	//
+	// DELETE /api/v1/livetv/recordings/{id}
+	DeleteLiveTVRecording(ctx context.Context, params DeleteLiveTVRecordingParams) (DeleteLiveTVRecordingRes, error)
+	// DeleteLiveTVSeriesRule implements deleteLiveTVSeriesRule operation.
+	//
+	// Remove a series rule and cancel the recordings it scheduled that have not started.
+	//
+	// DELETE /api/v1/livetv/series-rules/{id}
+	DeleteLiveTVSeriesRule(ctx context.Context, params DeleteLiveTVSeriesRuleParams) (DeleteLiveTVSeriesRuleRes, error)
+	// DeleteLiveTVSource implements deleteLiveTVSource operation.
+	//
+	// Remove a source with its channels or programs. Recordings are kept.
+	// Admin only.
+	//
+	// DELETE /api/v1/livetv/sources/{id}
+	DeleteLiveTVSource(ctx context.Context, params DeleteLiveTVSourceParams) (DeleteLiveTVSourceRes, error)
+	// DeletePhotoAlbum implements deletePhotoAlbum operation.
+	//
+	// Delete a user album. The photos stay in the library.
+	//
+	// DELETE /api/v1/photos/albums/{id}
+	DeletePhotoAlbum(ctx context.Context, params DeletePhotoAlbumParams) (DeletePhotoAlbumRes, error)
+	// DeletePodcastEpisodeDownload implements deletePodcastEpisodeDownload operation.
+	//
+	// Remove the downloaded audio and transcript of an episode. Admin only.
+	//
+	// DELETE /api/v1/podcasts/episodes/{id}/download
+	DeletePodcastEpisodeDownload(ctx context.Context, params DeletePodcastEpisodeDownloadParams) (DeletePodcastEpisodeDownloadRes, error)
+	// DeletePodcastEpisodeProgress implements deletePodcastEpisodeProgress operation.
+	//
+	// Remove the user's listening state for an episode.
+	//
+	// DELETE /api/v1/podcasts/episodes/{id}/progress
+	DeletePodcastEpisodeProgress(ctx context.Context, params DeletePodcastEpisodeProgressParams) (DeletePodcastEpisodeProgressRes, error)
 	// DeleteRole implements deleteRole operation.
 	//
 	// Delete a custom role (admin only, cannot delete built-in roles).
@@ -241,6 +408,32 @@ type Handler interface {
 	//
 	// DELETE /api/v1/mfa/totp
 	DisableTOTP(ctx context.Context) (DisableTOTPRes, error)
+	// DownloadBookFile implements downloadBookFile operation.
+	//
+	// Download the original EPUB, PDF, CBZ or CBR file.
+	//
+	// GET /api/v1/books/{id}/file
+	DownloadBookFile(ctx context.Context, params DownloadBookFileParams) (DownloadBookFileRes, error)
+	// DownloadLegacySceneFile implements downloadLegacySceneFile operation.
+	//
+	// Download the video file of a scene.
+	//
+	// GET /api/v1/legacy/scenes/{id}/file
+	DownloadLegacySceneFile(ctx context.Context, params DownloadLegacySceneFileParams) (DownloadLegacySceneFileRes, error)
+	// DownloadPhotoFile implements downloadPhotoFile operation.
+	//
+	// Download the original photo or clip.
+	//
+	// GET /api/v1/photos/{id}/file
+	DownloadPhotoFile(ctx context.Context, params DownloadPhotoFileParams) (DownloadPhotoFileRes, error)
+	// DownloadPodcastEpisode implements downloadPodcastEpisode operation.
+	//
+	// Queue a download of the episode into the podcast library. Episodes
+	// downloaded on request are pinned and kept regardless of the
+	// podcast's retention setting.
+	//
+	// POST /api/v1/podcasts/episodes/{id}/download
+	DownloadPodcastEpisode(ctx context.Context, params DownloadPodcastEpisodeParams) (DownloadPodcastEpisodeRes, error)
 	// EnableMFA implements enableMFA operation.
 	//
 	// Require MFA for login (at least one method must be configured).
@@ -283,6 +476,67 @@ type Handler interface {
 	//
 	// GET /api/v1/admin/activity/stats
 	GetActivityStats(ctx context.Context) (GetActivityStatsRes, error)
+	// GetAudiobook implements getAudiobook operation.
+	//
+	// Get an audiobook with its authors, files, chapters and the user's progress.
+	//
+	// GET /api/v1/audiobooks/{id}
+	GetAudiobook(ctx context.Context, params GetAudiobookParams) (GetAudiobookRes, error)
+	// GetAudiobookAuthorBooks implements getAudiobookAuthorBooks operation.
+	//
+	// Get the audiobooks of an author.
+	//
+	// GET /api/v1/audiobooks/authors/{id}/books
+	GetAudiobookAuthorBooks(ctx context.Context, params GetAudiobookAuthorBooksParams) (GetAudiobookAuthorBooksRes, error)
+	// GetAudiobookProgress implements getAudiobookProgress operation.
+	//
+	// Get the user's listening position in an audiobook.
+	//
+	// GET /api/v1/audiobooks/{id}/progress
+	GetAudiobookProgress(ctx context.Context, params GetAudiobookProgressParams) (GetAudiobookProgressRes, error)
+	// GetAudiobookSeriesBooks implements getAudiobookSeriesBooks operation.
+	//
+	// Get the audiobooks of a series in series order.
+	//
+	// GET /api/v1/audiobooks/series/{id}/books
+	GetAudiobookSeriesBooks(ctx context.Context, params GetAudiobookSeriesBooksParams) (GetAudiobookSeriesBooksRes, error)
+	// GetBook implements getBook operation.
+	//
+	// Get a book with its authors, series and the user's reading progress.
+	//
+	// GET /api/v1/books/{id}
+	GetBook(ctx context.Context, params GetBookParams) (GetBookRes, error)
+	// GetBookAuthorBooks implements getBookAuthorBooks operation.
+	//
+	// Get the books of an author.
+	//
+	// GET /api/v1/books/authors/{id}/books
+	GetBookAuthorBooks(ctx context.Context, params GetBookAuthorBooksParams) (GetBookAuthorBooksRes, error)
+	// GetBookCover implements getBookCover operation.
+	//
+	// Get the cover of a book as a thumbnail. EPUB and comic covers are read
+	// from the file; PDF covers are rendered from the first page.
+	//
+	// GET /api/v1/books/{id}/cover
+	GetBookCover(ctx context.Context, params GetBookCoverParams) (GetBookCoverRes, error)
+	// GetBookPage implements getBookPage operation.
+	//
+	// Get a page image of a CBZ or CBR comic. Pages are numbered from 1 in reading order.
+	//
+	// GET /api/v1/books/{id}/pages/{page}
+	GetBookPage(ctx context.Context, params GetBookPageParams) (GetBookPageRes, error)
+	// GetBookProgress implements getBookProgress operation.
+	//
+	// Get the user's reading position in a book.
+	//
+	// GET /api/v1/books/{id}/progress
+	GetBookProgress(ctx context.Context, params GetBookProgressParams) (GetBookProgressRes, error)
+	// GetBookSeriesBooks implements getBookSeriesBooks operation.
+	//
+	// Get the books or issues of a series in series order.
+	//
+	// GET /api/v1/books/series/{id}/books
+	GetBookSeriesBooks(ctx context.Context, params GetBookSeriesBooksParams) (GetBookSeriesBooksRes, error)
 	// GetCollection implements getCollection operation.
 	//
 	// Get detailed information about a collection.
@@ -302,6 +556,18 @@ type Handler interface {
 	//
 	// GET /api/v1/collections/{id}/movies
 	GetCollectionMovies(ctx context.Context, params GetCollectionMoviesParams) (GetCollectionMoviesRes, error)
+	// GetContinueListening implements getContinueListening operation.
+	//
+	// Get audiobooks the user has started but not finished, most recently played first.
+	//
+	// GET /api/v1/audiobooks/continue-listening
+	GetContinueListening(ctx context.Context, params GetContinueListeningParams) (GetContinueListeningRes, error)
+	// GetContinueReading implements getContinueReading operation.
+	//
+	// Get books the user has started but not finished, most recently read first.
+	//
+	// GET /api/v1/books/continue-reading
+	GetContinueReading(ctx context.Context, params GetContinueReadingParams) (GetContinueReadingRes, error)
 	// GetContinueWatching implements getContinueWatching operation.
 	//
 	// Get movies the user is currently watching.
@@ -333,12 +599,103 @@ type Handler interface {
 	//
 	// GET /api/v1/metadata/tv/{id}/season/{seasonNumber}/episode/{episodeNumber}/images
 	GetEpisodeMetadataImages(ctx context.Context, params GetEpisodeMetadataImagesParams) (GetEpisodeMetadataImagesRes, error)
+	// GetHomeFeed implements getHomeFeed operation.
+	//
+	// Get the rows of the user's home screen across all enabled content
+	// modules: on deck, continue watching, next up, recently added per
+	// library and recommended. Only content of libraries the user can access
+	// is included, and empty rows are left out. Each row holds the first
+	// page of its items; page further with the row endpoint.
+	//
+	// GET /api/v1/home
+	GetHomeFeed(ctx context.Context, params GetHomeFeedParams) (GetHomeFeedRes, error)
+	// GetHomeFeedRow implements getHomeFeedRow operation.
+	//
+	// Get a page of a single home feed row.
+	//
+	// GET /api/v1/home/rows/{id}
+	GetHomeFeedRow(ctx context.Context, params GetHomeFeedRowParams) (GetHomeFeedRowRes, error)
+	// GetHomeVideo implements getHomeVideo operation.
+	//
+	// Get a home video with what its container and file name tell about it.
+	//
+	// GET /api/v1/homevideos/{id}
+	GetHomeVideo(ctx context.Context, params GetHomeVideoParams) (GetHomeVideoRes, error)
+	// GetHomeVideoFolder implements getHomeVideoFolder operation.
+	//
+	// Get a folder with its breadcrumb trail from the library root.
+	//
+	// GET /api/v1/homevideos/folders/{id}
+	GetHomeVideoFolder(ctx context.Context, params GetHomeVideoFolderParams) (GetHomeVideoFolderRes, error)
+	// GetHomeVideoProgress implements getHomeVideoProgress operation.
+	//
+	// Get the user's watch progress for a home video.
+	//
+	// GET /api/v1/homevideos/{id}/progress
+	GetHomeVideoProgress(ctx context.Context, params GetHomeVideoProgressParams) (GetHomeVideoProgressRes, error)
+	// GetHomeVideoThumbnail implements getHomeVideoThumbnail operation.
+	//
+	// Get the frame grabbed from the video during the library scan.
+	//
+	// GET /api/v1/homevideos/{id}/thumbnail
+	GetHomeVideoThumbnail(ctx context.Context, params GetHomeVideoThumbnailParams) (GetHomeVideoThumbnailRes, error)
+	// GetLegacyAccess implements getLegacyAccess operation.
+	//
+	// Get whether the user was granted QAR access, whether a PIN is required
+	// and set, and until when the content is unlocked.
+	//
+	// GET /api/v1/legacy/access
+	GetLegacyAccess(ctx context.Context) (GetLegacyAccessRes, error)
+	// GetLegacyPerformer implements getLegacyPerformer operation.
+	//
+	// Get a performer with their number of accessible scenes.
+	//
+	// GET /api/v1/legacy/performers/{id}
+	GetLegacyPerformer(ctx context.Context, params GetLegacyPerformerParams) (GetLegacyPerformerRes, error)
+	// GetLegacyScene implements getLegacyScene operation.
+	//
+	// Get a scene with its studio and performers.
+	//
+	// GET /api/v1/legacy/scenes/{id}
+	GetLegacyScene(ctx context.Context, params GetLegacySceneParams) (GetLegacySceneRes, error)
+	// GetLegacyStudio implements getLegacyStudio operation.
+	//
+	// Get a studio with its number of accessible scenes.
+	//
+	// GET /api/v1/legacy/studios/{id}
+	GetLegacyStudio(ctx context.Context, params GetLegacyStudioParams) (GetLegacyStudioRes, error)
 	// GetLibrary implements getLibrary operation.
 	//
 	// Get detailed information about a library.
 	//
 	// GET /api/v1/libraries/{libraryId}
 	GetLibrary(ctx context.Context, params GetLibraryParams) (GetLibraryRes, error)
+	// GetLiveTVChannel implements getLiveTVChannel operation.
+	//
+	// Get a live TV channel. Start watching it with a playback session of media type channel.
+	//
+	// GET /api/v1/livetv/channels/{id}
+	GetLiveTVChannel(ctx context.Context, params GetLiveTVChannelParams) (GetLiveTVChannelRes, error)
+	// GetLiveTVGuide implements getLiveTVGuide operation.
+	//
+	// Get the enabled channels, or one channel, with the programs airing
+	// in a time window of up to 14 days. The window defaults to the next
+	// six hours.
+	//
+	// GET /api/v1/livetv/guide
+	GetLiveTVGuide(ctx context.Context, params GetLiveTVGuideParams) (GetLiveTVGuideRes, error)
+	// GetLiveTVProgram implements getLiveTVProgram operation.
+	//
+	// Get a program of the guide.
+	//
+	// GET /api/v1/livetv/programs/{id}
+	GetLiveTVProgram(ctx context.Context, params GetLiveTVProgramParams) (GetLiveTVProgramRes, error)
+	// GetLiveTVRecording implements getLiveTVRecording operation.
+	//
+	// Get a recording of the user.
+	//
+	// GET /api/v1/livetv/recordings/{id}
+	GetLiveTVRecording(ctx context.Context, params GetLiveTVRecordingParams) (GetLiveTVRecordingRes, error)
 	// GetLiveness implements getLiveness operation.
 	//
 	// Checks if the service is alive and running.
@@ -377,12 +734,26 @@ type Handler interface {
 	//
 	// GET /api/v1/movies/{id}/crew
 	GetMovieCrew(ctx context.Context, params GetMovieCrewParams) (GetMovieCrewRes, error)
+	// GetMovieEditions implements getMovieEditions operation.
+	//
+	// Get the files of a movie grouped by edition (Director's Cut, Extended, ...). The default version
+	// comes first.
+	//
+	// GET /api/v1/movies/{id}/editions
+	GetMovieEditions(ctx context.Context, params GetMovieEditionsParams) (GetMovieEditionsRes, error)
 	// GetMovieExternalIDs implements getMovieExternalIDs operation.
 	//
 	// Fetch external database IDs for a movie (IMDb, TVDb, Wikidata, social media).
 	//
 	// GET /api/v1/metadata/movie/{id}/external-ids
 	GetMovieExternalIDs(ctx context.Context, params GetMovieExternalIDsParams) (GetMovieExternalIDsRes, error)
+	// GetMovieExtras implements getMovieExtras operation.
+	//
+	// Get trailers, featurettes, deleted scenes and other extras of a movie. Extras are played with
+	// media_type movie_extra.
+	//
+	// GET /api/v1/movies/{id}/extras
+	GetMovieExtras(ctx context.Context, params GetMovieExtrasParams) (GetMovieExtrasRes, error)
 	// GetMovieFiles implements getMovieFiles operation.
 	//
 	// Get physical files for a movie.
@@ -420,6 +791,80 @@ type Handler interface {
 	//
 	// GET /api/v1/metadata/movie/{id}/recommendations
 	GetMovieRecommendationsMetadata(ctx context.Context, params GetMovieRecommendationsMetadataParams) (GetMovieRecommendationsMetadataRes, error)
+	// GetMovieTags implements getMovieTags operation.
+	//
+	// Get keywords and tags for a movie. Spoiler tags are only included when the user has enabled
+	// show_spoilers in their preferences.
+	//
+	// GET /api/v1/movies/{id}/tags
+	GetMovieTags(ctx context.Context, params GetMovieTagsParams) (GetMovieTagsRes, error)
+	// GetMusicAlbum implements getMusicAlbum operation.
+	//
+	// Get an album with its artists and tracks grouped by disc.
+	//
+	// GET /api/v1/music/albums/{id}
+	GetMusicAlbum(ctx context.Context, params GetMusicAlbumParams) (GetMusicAlbumRes, error)
+	// GetMusicAlbumEditions implements getMusicAlbumEditions operation.
+	//
+	// Get all editions in the album's release group (original, remaster, deluxe, ...).
+	//
+	// GET /api/v1/music/albums/{id}/editions
+	GetMusicAlbumEditions(ctx context.Context, params GetMusicAlbumEditionsParams) (GetMusicAlbumEditionsRes, error)
+	// GetMusicArtist implements getMusicArtist operation.
+	//
+	// Get an artist by ID.
+	//
+	// GET /api/v1/music/artists/{id}
+	GetMusicArtist(ctx context.Context, params GetMusicArtistParams) (GetMusicArtistRes, error)
+	// GetMusicArtistAlbums implements getMusicArtistAlbums operation.
+	//
+	// Get the albums an artist is credited on, oldest first.
+	//
+	// GET /api/v1/music/artists/{id}/albums
+	GetMusicArtistAlbums(ctx context.Context, params GetMusicArtistAlbumsParams) (GetMusicArtistAlbumsRes, error)
+	// GetMusicArtistTracks implements getMusicArtistTracks operation.
+	//
+	// Get the tracks an artist performs on, including guest appearances.
+	//
+	// GET /api/v1/music/artists/{id}/tracks
+	GetMusicArtistTracks(ctx context.Context, params GetMusicArtistTracksParams) (GetMusicArtistTracksRes, error)
+	// GetMusicTrack implements getMusicTrack operation.
+	//
+	// Get a track with its performing artists.
+	//
+	// GET /api/v1/music/tracks/{id}
+	GetMusicTrack(ctx context.Context, params GetMusicTrackParams) (GetMusicTrackRes, error)
+	// GetMusicVideo implements getMusicVideo operation.
+	//
+	// Get a music video with its artist and MusicBrainz match.
+	//
+	// GET /api/v1/musicvideos/{id}
+	GetMusicVideo(ctx context.Context, params GetMusicVideoParams) (GetMusicVideoRes, error)
+	// GetMusicVideoArtist implements getMusicVideoArtist operation.
+	//
+	// Get an artist with its number of videos.
+	//
+	// GET /api/v1/musicvideos/artists/{id}
+	GetMusicVideoArtist(ctx context.Context, params GetMusicVideoArtistParams) (GetMusicVideoArtistRes, error)
+	// GetMyParentalControls implements getMyParentalControls operation.
+	//
+	// Get the content restrictions that apply to the authenticated user.
+	//
+	// GET /api/v1/users/me/parental-controls
+	GetMyParentalControls(ctx context.Context) (GetMyParentalControlsRes, error)
+	// GetPerson implements getPerson operation.
+	//
+	// Get a cast or crew member known from the library's credits.
+	//
+	// GET /api/v1/people/{personId}
+	GetPerson(ctx context.Context, params GetPersonParams) (GetPersonRes, error)
+	// GetPersonFilmography implements getPersonFilmography operation.
+	//
+	// List the person's credits on movies and TV series in the library.
+	// Episode credits are grouped per series with the episode count.
+	//
+	// GET /api/v1/people/{personId}/filmography
+	GetPersonFilmography(ctx context.Context, params GetPersonFilmographyParams) (GetPersonFilmographyRes, error)
 	// GetPersonMetadata implements getPersonMetadata operation.
 	//
 	// Fetch detailed information about a person (actor, director, etc.) from TMDb.
@@ -438,16 +883,69 @@ type Handler interface {
 	//
 	// GET /api/v1/metadata/person/{id}/images
 	GetPersonMetadataImages(ctx context.Context, params GetPersonMetadataImagesParams) (GetPersonMetadataImagesRes, error)
+	// GetPhoto implements getPhoto operation.
+	//
+	// Get a photo with its capture metadata.
+	//
+	// GET /api/v1/photos/{id}
+	GetPhoto(ctx context.Context, params GetPhotoParams) (GetPhotoRes, error)
+	// GetPhotoAlbum implements getPhotoAlbum operation.
+	//
+	// Get a folder album or a user album the user owns or that is shared with them.
+	//
+	// GET /api/v1/photos/albums/{id}
+	GetPhotoAlbum(ctx context.Context, params GetPhotoAlbumParams) (GetPhotoAlbumRes, error)
+	// GetPhotoThumbnail implements getPhotoThumbnail operation.
+	//
+	// Get a photo scaled down to the given width, rotated upright. Clips have
+	// no thumbnail.
+	//
+	// GET /api/v1/photos/{id}/thumbnail
+	GetPhotoThumbnail(ctx context.Context, params GetPhotoThumbnailParams) (GetPhotoThumbnailRes, error)
+	// GetPhotoTimeline implements getPhotoTimeline operation.
+	//
+	// Get the days or months that have photos, newest first, with the number
+	// of photos and a cover photo. Page through a bucket with listPhotos and
+	// its taken_after and taken_before filters.
+	//
+	// GET /api/v1/photos/timeline
+	GetPhotoTimeline(ctx context.Context, params GetPhotoTimelineParams) (GetPhotoTimelineRes, error)
 	// GetPlaybackSession implements getPlaybackSession operation.
 	//
 	// Returns metadata for an active playback session.
 	//
 	// GET /api/v1/playback/sessions/{sessionId}
 	GetPlaybackSession(ctx context.Context, params GetPlaybackSessionParams) (GetPlaybackSessionRes, error)
+	// GetPodcast implements getPodcast operation.
+	//
+	// Get a podcast and whether the user subscribes to it.
+	//
+	// GET /api/v1/podcasts/{id}
+	GetPodcast(ctx context.Context, params GetPodcastParams) (GetPodcastRes, error)
+	// GetPodcastEpisode implements getPodcastEpisode operation.
+	//
+	// Get an episode with its chapters, transcripts and the user's listening state.
+	//
+	// GET /api/v1/podcasts/episodes/{id}
+	GetPodcastEpisode(ctx context.Context, params GetPodcastEpisodeParams) (GetPodcastEpisodeRes, error)
+	// GetPodcastEpisodeProgress implements getPodcastEpisodeProgress operation.
+	//
+	// Get the user's position in an episode and whether it is played.
+	//
+	// GET /api/v1/podcasts/episodes/{id}/progress
+	GetPodcastEpisodeProgress(ctx context.Context, params GetPodcastEpisodeProgressParams) (GetPodcastEpisodeProgressRes, error)
+	// GetPodcastEpisodeTranscript implements getPodcastEpisodeTranscript operation.
+	//
+	// Get the downloaded transcript of an episode in its original format.
+	//
+	// GET /api/v1/podcasts/episodes/{id}/transcript
+	GetPodcastEpisodeTranscript(ctx context.Context, params GetPodcastEpisodeTranscriptParams) (GetPodcastEpisodeTranscriptRes, error)
 	// GetProxiedImage implements getProxiedImage operation.
 	//
 	// Proxy images from TMDb image server. This caches images locally
 	// and serves them to clients without exposing TMDb API keys.
+	// Paths of the form `local-{artwork id}` refer to local artwork found
+	// next to the media files; those are resized and cached the same way.
 	//
 	// GET /api/v1/images/{type}/{size}/{path}
 	GetProxiedImage(ctx context.Context, params GetProxiedImageParams) (GetProxiedImageRes, error)
@@ -663,6 +1161,13 @@ type Handler interface {
 	//
 	// GET /api/v1/tvshows/{id}/seasons
 	GetTVShowSeasons(ctx context.Context, params GetTVShowSeasonsParams) (GetTVShowSeasonsRes, error)
+	// GetTVShowTags implements getTVShowTags operation.
+	//
+	// Get keywords and tags for a TV show. Spoiler tags are only included when the user has enabled
+	// show_spoilers in their preferences.
+	//
+	// GET /api/v1/tvshows/{id}/tags
+	GetTVShowTags(ctx context.Context, params GetTVShowTagsParams) (GetTVShowTagsRes, error)
 	// GetTVShowWatchStats implements getTVShowWatchStats operation.
 	//
 	// Get user's watch statistics for a specific TV show.
@@ -759,6 +1264,14 @@ type Handler interface {
 	//
 	// POST /api/v1/webhooks/sonarr
 	HandleSonarrWebhook(ctx context.Context, req *SonarrWebhookPayload) (HandleSonarrWebhookRes, error)
+	// HeartbeatPlaybackSession implements heartbeatPlaybackSession operation.
+	//
+	// Sends a heartbeat to keep the playback session alive and optionally
+	// report the current playback position. Sessions expire after a timeout
+	// if no heartbeat is received.
+	//
+	// POST /api/v1/playback/sessions/{sessionId}/heartbeat
+	HeartbeatPlaybackSession(ctx context.Context, req OptHeartbeatPlaybackSessionReq, params HeartbeatPlaybackSessionParams) (HeartbeatPlaybackSessionRes, error)
 	// InitOIDCLink implements initOIDCLink operation.
 	//
 	// Initiates the flow to link an OIDC provider to the user's account.
@@ -771,6 +1284,42 @@ type Handler interface {
 	//
 	// GET /api/v1/apikeys
 	ListAPIKeys(ctx context.Context) (ListAPIKeysRes, error)
+	// ListAudiobookAuthors implements listAudiobookAuthors operation.
+	//
+	// Get a paginated list of authors sorted by sort name.
+	//
+	// GET /api/v1/audiobooks/authors
+	ListAudiobookAuthors(ctx context.Context, params ListAudiobookAuthorsParams) (ListAudiobookAuthorsRes, error)
+	// ListAudiobookSeries implements listAudiobookSeries operation.
+	//
+	// Get a paginated list of series sorted by sort name.
+	//
+	// GET /api/v1/audiobooks/series
+	ListAudiobookSeries(ctx context.Context, params ListAudiobookSeriesParams) (ListAudiobookSeriesRes, error)
+	// ListAudiobooks implements listAudiobooks operation.
+	//
+	// Get a paginated list of audiobooks.
+	//
+	// GET /api/v1/audiobooks
+	ListAudiobooks(ctx context.Context, params ListAudiobooksParams) (ListAudiobooksRes, error)
+	// ListBookAuthors implements listBookAuthors operation.
+	//
+	// Get a paginated list of authors sorted by sort name.
+	//
+	// GET /api/v1/books/authors
+	ListBookAuthors(ctx context.Context, params ListBookAuthorsParams) (ListBookAuthorsRes, error)
+	// ListBookSeries implements listBookSeries operation.
+	//
+	// Get a paginated list of book series and comic runs sorted by sort name.
+	//
+	// GET /api/v1/books/series
+	ListBookSeries(ctx context.Context, params ListBookSeriesParams) (ListBookSeriesRes, error)
+	// ListBooks implements listBooks operation.
+	//
+	// Get a paginated list of e-books and comics.
+	//
+	// GET /api/v1/books
+	ListBooks(ctx context.Context, params ListBooksParams) (ListBooksRes, error)
 	// ListGenres implements listGenres operation.
 	//
 	// Returns all distinct genres across movies and TV shows with per-content-type item counts. Useful
@@ -778,6 +1327,47 @@ type Handler interface {
 	//
 	// GET /api/v1/genres
 	ListGenres(ctx context.Context) (ListGenresRes, error)
+	// ListHomeVideoContinueWatching implements listHomeVideoContinueWatching operation.
+	//
+	// Get the home videos the user started but did not finish, last watched first.
+	//
+	// GET /api/v1/homevideos/continue-watching
+	ListHomeVideoContinueWatching(ctx context.Context, params ListHomeVideoContinueWatchingParams) (ListHomeVideoContinueWatchingRes, error)
+	// ListHomeVideoFolders implements listHomeVideoFolders operation.
+	//
+	// Get the subfolders of a folder, or the library roots of the home video
+	// libraries the user can access when parent_id is omitted.
+	//
+	// GET /api/v1/homevideos/folders
+	ListHomeVideoFolders(ctx context.Context, params ListHomeVideoFoldersParams) (ListHomeVideoFoldersRes, error)
+	// ListHomeVideos implements listHomeVideos operation.
+	//
+	// Get a paginated list of videos from the home video libraries the user
+	// can access. Pass folder_id to list the videos directly in a folder.
+	//
+	// GET /api/v1/homevideos
+	ListHomeVideos(ctx context.Context, params ListHomeVideosParams) (ListHomeVideosRes, error)
+	// ListLegacyPerformers implements listLegacyPerformers operation.
+	//
+	// Get the performers of the scenes in the adult libraries the user can
+	// access, by number of scenes.
+	//
+	// GET /api/v1/legacy/performers
+	ListLegacyPerformers(ctx context.Context, params ListLegacyPerformersParams) (ListLegacyPerformersRes, error)
+	// ListLegacyScenes implements listLegacyScenes operation.
+	//
+	// Get a paginated list of scenes from the adult libraries the user can
+	// access, newest first.
+	//
+	// GET /api/v1/legacy/scenes
+	ListLegacyScenes(ctx context.Context, params ListLegacyScenesParams) (ListLegacyScenesRes, error)
+	// ListLegacyStudios implements listLegacyStudios operation.
+	//
+	// Get the studios of the scenes in the adult libraries the user can
+	// access, by number of scenes.
+	//
+	// GET /api/v1/legacy/studios
+	ListLegacyStudios(ctx context.Context, params ListLegacyStudiosParams) (ListLegacyStudiosRes, error)
 	// ListLibraries implements listLibraries operation.
 	//
 	// List all libraries the authenticated user can access. Admins see all libraries.
@@ -796,18 +1386,98 @@ type Handler interface {
 	//
 	// GET /api/v1/libraries/{libraryId}/scans
 	ListLibraryScans(ctx context.Context, params ListLibraryScansParams) (ListLibraryScansRes, error)
+	// ListLiveTVChannels implements listLiveTVChannels operation.
+	//
+	// Get the live TV channels in playlist order. Disabled channels are
+	// only listed for admins that ask for them.
+	//
+	// GET /api/v1/livetv/channels
+	ListLiveTVChannels(ctx context.Context, params ListLiveTVChannelsParams) (ListLiveTVChannelsRes, error)
+	// ListLiveTVRecordings implements listLiveTVRecordings operation.
+	//
+	// Get the user's recordings, newest airing first.
+	//
+	// GET /api/v1/livetv/recordings
+	ListLiveTVRecordings(ctx context.Context, params ListLiveTVRecordingsParams) (ListLiveTVRecordingsRes, error)
+	// ListLiveTVSeriesRules implements listLiveTVSeriesRules operation.
+	//
+	// Get the user's series rules.
+	//
+	// GET /api/v1/livetv/series-rules
+	ListLiveTVSeriesRules(ctx context.Context) (ListLiveTVSeriesRulesRes, error)
+	// ListLiveTVSources implements listLiveTVSources operation.
+	//
+	// Get the configured M3U channel lists and XMLTV guides. Admin only.
+	//
+	// GET /api/v1/livetv/sources
+	ListLiveTVSources(ctx context.Context) (ListLiveTVSourcesRes, error)
 	// ListMetadataProviders implements listMetadataProviders operation.
 	//
 	// Returns the list of registered metadata providers and their capabilities.
 	//
 	// GET /api/v1/metadata/providers
 	ListMetadataProviders(ctx context.Context) (ListMetadataProvidersRes, error)
+	// ListMovieArtwork implements listMovieArtwork operation.
+	//
+	// List the artwork candidates of a movie from all image providers and
+	// local files. Candidates of each kind are ordered by preference for
+	// the user's metadata language; the selected image is flagged.
+	//
+	// GET /api/v1/movies/{id}/artwork
+	ListMovieArtwork(ctx context.Context, params ListMovieArtworkParams) (ListMovieArtworkRes, error)
+	// ListMovieMetadataHistory implements listMovieMetadataHistory operation.
+	//
+	// List the recorded metadata changes of a movie, newest first. Each
+	// version holds the changed fields with their old and new values, the
+	// source of the change and, for manual edits, the acting user. Admin only.
+	//
+	// GET /api/v1/movies/{id}/metadata/history
+	ListMovieMetadataHistory(ctx context.Context, params ListMovieMetadataHistoryParams) (ListMovieMetadataHistoryRes, error)
 	// ListMovies implements listMovies operation.
 	//
 	// Get a paginated list of movies with optional filtering and sorting.
 	//
 	// GET /api/v1/movies
 	ListMovies(ctx context.Context, params ListMoviesParams) (ListMoviesRes, error)
+	// ListMoviesByTag implements listMoviesByTag operation.
+	//
+	// Browse movies carrying a keyword or tag, ordered by rating. Parental controls and the user's
+	// hidden tags apply.
+	//
+	// GET /api/v1/tags/{slug}/movies
+	ListMoviesByTag(ctx context.Context, params ListMoviesByTagParams) (ListMoviesByTagRes, error)
+	// ListMusicAlbums implements listMusicAlbums operation.
+	//
+	// Get a paginated list of albums (releases).
+	//
+	// GET /api/v1/music/albums
+	ListMusicAlbums(ctx context.Context, params ListMusicAlbumsParams) (ListMusicAlbumsRes, error)
+	// ListMusicArtists implements listMusicArtists operation.
+	//
+	// Get a paginated list of artists sorted by sort name. With `album_artists`
+	// only artists credited on an album are returned, hiding featured guests.
+	//
+	// GET /api/v1/music/artists
+	ListMusicArtists(ctx context.Context, params ListMusicArtistsParams) (ListMusicArtistsRes, error)
+	// ListMusicVideoArtists implements listMusicVideoArtists operation.
+	//
+	// Get the artists of the music video libraries the user can access, by sort name.
+	//
+	// GET /api/v1/musicvideos/artists
+	ListMusicVideoArtists(ctx context.Context, params ListMusicVideoArtistsParams) (ListMusicVideoArtistsRes, error)
+	// ListMusicVideos implements listMusicVideos operation.
+	//
+	// Get a paginated list of videos from the music video libraries the user
+	// can access. Pass artist_id to list the videos of an artist.
+	//
+	// GET /api/v1/musicvideos
+	ListMusicVideos(ctx context.Context, params ListMusicVideosParams) (ListMusicVideosRes, error)
+	// ListNewPodcastEpisodes implements listNewPodcastEpisodes operation.
+	//
+	// Get unplayed episodes of the user's subscriptions, newest first.
+	//
+	// GET /api/v1/podcasts/new
+	ListNewPodcastEpisodes(ctx context.Context, params ListNewPodcastEpisodesParams) (ListNewPodcastEpisodesRes, error)
 	// ListOIDCProviders implements listOIDCProviders operation.
 	//
 	// Returns a list of enabled OIDC providers for login.
@@ -820,6 +1490,44 @@ type Handler interface {
 	//
 	// GET /api/v1/rbac/permissions
 	ListPermissions(ctx context.Context) (ListPermissionsRes, error)
+	// ListPhotoAlbumPhotos implements listPhotoAlbumPhotos operation.
+	//
+	// Get the photos of an album in album order.
+	//
+	// GET /api/v1/photos/albums/{id}/photos
+	ListPhotoAlbumPhotos(ctx context.Context, params ListPhotoAlbumPhotosParams) (ListPhotoAlbumPhotosRes, error)
+	// ListPhotoAlbumShares implements listPhotoAlbumShares operation.
+	//
+	// Get the users a user album is shared with.
+	//
+	// GET /api/v1/photos/albums/{id}/shares
+	ListPhotoAlbumShares(ctx context.Context, params ListPhotoAlbumSharesParams) (ListPhotoAlbumSharesRes, error)
+	// ListPhotoAlbums implements listPhotoAlbums operation.
+	//
+	// Get the folder albums of the accessible photo libraries and the user
+	// albums the user owns or that are shared with them.
+	//
+	// GET /api/v1/photos/albums
+	ListPhotoAlbums(ctx context.Context, params ListPhotoAlbumsParams) (ListPhotoAlbumsRes, error)
+	// ListPhotos implements listPhotos operation.
+	//
+	// Get a paginated list of photos and clips from the photo libraries the
+	// user can access, ordered by capture time.
+	//
+	// GET /api/v1/photos
+	ListPhotos(ctx context.Context, params ListPhotosParams) (ListPhotosRes, error)
+	// ListPodcastEpisodes implements listPodcastEpisodes operation.
+	//
+	// Get a podcast's episodes, newest first, with the user's listening state.
+	//
+	// GET /api/v1/podcasts/{id}/episodes
+	ListPodcastEpisodes(ctx context.Context, params ListPodcastEpisodesParams) (ListPodcastEpisodesRes, error)
+	// ListPodcasts implements listPodcasts operation.
+	//
+	// Get a paginated list of podcasts sorted by title.
+	//
+	// GET /api/v1/podcasts
+	ListPodcasts(ctx context.Context, params ListPodcastsParams) (ListPodcastsRes, error)
 	// ListPolicies implements listPolicies operation.
 	//
 	// Get all authorization policies (admin only).
@@ -844,12 +1552,58 @@ type Handler interface {
 	//
 	// GET /api/v1/sessions
 	ListSessions(ctx context.Context) (ListSessionsRes, error)
+	// ListTVEpisodeMetadataHistory implements listTVEpisodeMetadataHistory operation.
+	//
+	// List the recorded metadata changes of a episode, newest first. Each
+	// version holds the changed fields with their old and new values, the
+	// source of the change and, for manual edits, the acting user. Admin only.
+	//
+	// GET /api/v1/tvshows/episodes/{id}/metadata/history
+	ListTVEpisodeMetadataHistory(ctx context.Context, params ListTVEpisodeMetadataHistoryParams) (ListTVEpisodeMetadataHistoryRes, error)
+	// ListTVSeasonMetadataHistory implements listTVSeasonMetadataHistory operation.
+	//
+	// List the recorded metadata changes of a season, newest first. Each
+	// version holds the changed fields with their old and new values, the
+	// source of the change and, for manual edits, the acting user. Admin only.
+	//
+	// GET /api/v1/tvshows/seasons/{id}/metadata/history
+	ListTVSeasonMetadataHistory(ctx context.Context, params ListTVSeasonMetadataHistoryParams) (ListTVSeasonMetadataHistoryRes, error)
+	// ListTVShowArtwork implements listTVShowArtwork operation.
+	//
+	// List the artwork candidates of a series from all image providers and
+	// local files. Candidates of each kind are ordered by preference for
+	// the user's metadata language; the selected image is flagged.
+	//
+	// GET /api/v1/tvshows/{id}/artwork
+	ListTVShowArtwork(ctx context.Context, params ListTVShowArtworkParams) (ListTVShowArtworkRes, error)
+	// ListTVShowMetadataHistory implements listTVShowMetadataHistory operation.
+	//
+	// List the recorded metadata changes of a series, newest first. Each
+	// version holds the changed fields with their old and new values, the
+	// source of the change and, for manual edits, the acting user. Admin only.
+	//
+	// GET /api/v1/tvshows/{id}/metadata/history
+	ListTVShowMetadataHistory(ctx context.Context, params ListTVShowMetadataHistoryParams) (ListTVShowMetadataHistoryRes, error)
 	// ListTVShows implements listTVShows operation.
 	//
 	// Get a paginated list of TV shows with optional filtering and sorting.
 	//
 	// GET /api/v1/tvshows
 	ListTVShows(ctx context.Context, params ListTVShowsParams) (ListTVShowsRes, error)
+	// ListTVShowsByTag implements listTVShowsByTag operation.
+	//
+	// Browse TV shows carrying a keyword or tag, ordered by popularity. Parental controls and the user's
+	// hidden tags apply.
+	//
+	// GET /api/v1/tags/{slug}/tvshows
+	ListTVShowsByTag(ctx context.Context, params ListTVShowsByTagParams) (ListTVShowsByTagRes, error)
+	// ListTags implements listTags operation.
+	//
+	// Returns the most used keywords and tags across movies and TV shows with per-content-type item
+	// counts. Spoiler tags are never listed.
+	//
+	// GET /api/v1/tags
+	ListTags(ctx context.Context, params ListTagsParams) (ListTagsRes, error)
 	// ListUserOIDCLinks implements listUserOIDCLinks operation.
 	//
 	// Returns all OIDC providers linked to the current user.
@@ -868,6 +1622,12 @@ type Handler interface {
 	//
 	// GET /api/v1/mfa/webauthn/credentials
 	ListWebAuthnCredentials(ctx context.Context) (ListWebAuthnCredentialsRes, error)
+	// LockLegacy implements lockLegacy operation.
+	//
+	// Lock QAR content before the unlock expires.
+	//
+	// POST /api/v1/legacy/lock
+	LockLegacy(ctx context.Context) (LockLegacyRes, error)
 	// Login implements login operation.
 	//
 	// Authenticate user and return access token and refresh token.
@@ -924,12 +1684,31 @@ type Handler interface {
 	//
 	// GET /api/v1/oidc/callback/{provider}
 	OidcCallback(ctx context.Context, params OidcCallbackParams) (OidcCallbackRes, error)
+	// RefreshLiveTVSource implements refreshLiveTVSource operation.
+	//
+	// Queue a reload of a source. Admin only.
+	//
+	// POST /api/v1/livetv/sources/{id}/refresh
+	RefreshLiveTVSource(ctx context.Context, params RefreshLiveTVSourceParams) (RefreshLiveTVSourceRes, error)
 	// RefreshMovieMetadata implements refreshMovieMetadata operation.
 	//
 	// Trigger a metadata refresh from TMDb/Radarr.
 	//
 	// POST /api/v1/movies/{id}/refresh
 	RefreshMovieMetadata(ctx context.Context, params RefreshMovieMetadataParams) (RefreshMovieMetadataRes, error)
+	// RefreshPerson implements refreshPerson operation.
+	//
+	// Queue a job that fetches the person's biography, translations and
+	// profile images from the metadata providers. Admin only.
+	//
+	// POST /api/v1/people/{personId}/refresh
+	RefreshPerson(ctx context.Context, params RefreshPersonParams) (RefreshPersonRes, error)
+	// RefreshPodcast implements refreshPodcast operation.
+	//
+	// Queue a refresh of the podcast's feed.
+	//
+	// POST /api/v1/podcasts/{id}/refresh
+	RefreshPodcast(ctx context.Context, params RefreshPodcastParams) (RefreshPodcastRes, error)
 	// RefreshSession implements refreshSession operation.
 	//
 	// Refresh access token using refresh token.
@@ -974,6 +1753,12 @@ type Handler interface {
 	//
 	// POST /api/v1/search/tvshows/reindex
 	ReindexTVShowSearch(ctx context.Context) (ReindexTVShowSearchRes, error)
+	// RemovePhotoAlbumPhoto implements removePhotoAlbumPhoto operation.
+	//
+	// Remove a photo from a user album. The photo stays in the library.
+	//
+	// DELETE /api/v1/photos/albums/{id}/photos/{photoId}
+	RemovePhotoAlbumPhoto(ctx context.Context, params RemovePhotoAlbumPhotoParams) (RemovePhotoAlbumPhotoRes, error)
 	// RemovePolicy implements removePolicy operation.
 	//
 	// Remove an authorization policy (admin only).
@@ -1022,12 +1807,63 @@ type Handler interface {
 	//
 	// DELETE /api/v1/sessions/{sessionId}
 	RevokeSession(ctx context.Context, params RevokeSessionParams) (RevokeSessionRes, error)
+	// RollbackMovieMetadata implements rollbackMovieMetadata operation.
+	//
+	// Restore the metadata fields changed since a version to their values at
+	// that version. Version 0 is the state before the first recorded change.
+	// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+	// sync keep them. Fields cannot be cleared, so a rollback to a version
+	// where a field that is set now was empty is rejected with the fields
+	// listed. The rollback is recorded as a new version. Admin only.
+	//
+	// POST /api/v1/movies/{id}/metadata/history/{version}/rollback
+	RollbackMovieMetadata(ctx context.Context, params RollbackMovieMetadataParams) (RollbackMovieMetadataRes, error)
+	// RollbackTVEpisodeMetadata implements rollbackTVEpisodeMetadata operation.
+	//
+	// Restore the metadata fields changed since a version to their values at
+	// that version. Version 0 is the state before the first recorded change.
+	// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+	// sync keep them. Fields cannot be cleared, so a rollback to a version
+	// where a field that is set now was empty is rejected with the fields
+	// listed. The rollback is recorded as a new version. Admin only.
+	//
+	// POST /api/v1/tvshows/episodes/{id}/metadata/history/{version}/rollback
+	RollbackTVEpisodeMetadata(ctx context.Context, params RollbackTVEpisodeMetadataParams) (RollbackTVEpisodeMetadataRes, error)
+	// RollbackTVSeasonMetadata implements rollbackTVSeasonMetadata operation.
+	//
+	// Restore the metadata fields changed since a version to their values at
+	// that version. Version 0 is the state before the first recorded change.
+	// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+	// sync keep them. Fields cannot be cleared, so a rollback to a version
+	// where a field that is set now was empty is rejected with the fields
+	// listed. The rollback is recorded as a new version. Admin only.
+	//
+	// POST /api/v1/tvshows/seasons/{id}/metadata/history/{version}/rollback
+	RollbackTVSeasonMetadata(ctx context.Context, params RollbackTVSeasonMetadataParams) (RollbackTVSeasonMetadataRes, error)
+	// RollbackTVShowMetadata implements rollbackTVShowMetadata operation.
+	//
+	// Restore the metadata fields changed since a version to their values at
+	// that version. Version 0 is the state before the first recorded change.
+	// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+	// sync keep them. Fields cannot be cleared, so a rollback to a version
+	// where a field that is set now was empty is rejected with the fields
+	// listed. The rollback is recorded as a new version. Admin only.
+	//
+	// POST /api/v1/tvshows/{id}/metadata/history/{version}/rollback
+	RollbackTVShowMetadata(ctx context.Context, params RollbackTVShowMetadataParams) (RollbackTVShowMetadataRes, error)
 	// SearchActivityLogs implements searchActivityLogs operation.
 	//
 	// Search and filter activity logs with pagination.
 	//
 	// GET /api/v1/admin/activity
 	SearchActivityLogs(ctx context.Context, params SearchActivityLogsParams) (SearchActivityLogsRes, error)
+	// SearchLibraryHomeVideos implements searchLibraryHomeVideos operation.
+	//
+	// Full-text search across the home video libraries the user can access
+	// using Typesense. Matches titles, descriptions and folder names.
+	//
+	// GET /api/v1/search/homevideos
+	SearchLibraryHomeVideos(ctx context.Context, params SearchLibraryHomeVideosParams) (SearchLibraryHomeVideosRes, error)
 	// SearchLibraryMovies implements searchLibraryMovies operation.
 	//
 	// Full-text search across the movie library using Typesense.
@@ -1036,6 +1872,13 @@ type Handler interface {
 	//
 	// GET /api/v1/search/movies
 	SearchLibraryMovies(ctx context.Context, params SearchLibraryMoviesParams) (SearchLibraryMoviesRes, error)
+	// SearchLibraryMusic implements searchLibraryMusic operation.
+	//
+	// Full-text search across music albums using Typesense. Matches album
+	// titles, artists and track titles. Returns faceted results for filtering.
+	//
+	// GET /api/v1/search/music
+	SearchLibraryMusic(ctx context.Context, params SearchLibraryMusicParams) (SearchLibraryMusicRes, error)
 	// SearchLibraryTVShows implements searchLibraryTVShows operation.
 	//
 	// Full-text search across the TV show library using Typesense.
@@ -1060,7 +1903,7 @@ type Handler interface {
 	SearchMoviesMetadata(ctx context.Context, params SearchMoviesMetadataParams) (SearchMoviesMetadataRes, error)
 	// SearchMulti implements searchMulti operation.
 	//
-	// Unified search endpoint that queries movies, TV shows, episodes, seasons, and people
+	// Unified search endpoint that queries movies, TV shows, episodes, seasons, people, and music albums
 	// in parallel, returning the top results from each collection. Designed for global search bars.
 	//
 	// GET /api/v1/search/multi
@@ -1086,12 +1929,42 @@ type Handler interface {
 	//
 	// GET /api/v1/metadata/search/tv
 	SearchTVShowsMetadata(ctx context.Context, params SearchTVShowsMetadataParams) (SearchTVShowsMetadataRes, error)
+	// SelectMovieArtwork implements selectMovieArtwork operation.
+	//
+	// Make an artwork candidate the image used for its kind. Automatic
+	// selection keeps the choice; a chosen poster or backdrop is also
+	// locked on the movie. Admin only.
+	//
+	// POST /api/v1/movies/{id}/artwork/{artworkId}/select
+	SelectMovieArtwork(ctx context.Context, params SelectMovieArtworkParams) (SelectMovieArtworkRes, error)
+	// SelectTVShowArtwork implements selectTVShowArtwork operation.
+	//
+	// Make an artwork candidate the image used for its kind. Automatic
+	// selection keeps the choice; a chosen poster or backdrop is also
+	// locked on the series. Admin only.
+	//
+	// POST /api/v1/tvshows/{id}/artwork/{artworkId}/select
+	SelectTVShowArtwork(ctx context.Context, params SelectTVShowArtworkParams) (SelectTVShowArtworkRes, error)
+	// SetLegacyPIN implements setLegacyPIN operation.
+	//
+	// Set the PIN that unlocks QAR content. Changing an existing PIN requires
+	// the current one. Setting a PIN locks the content.
+	//
+	// PUT /api/v1/legacy/pin
+	SetLegacyPIN(ctx context.Context, req *LegacyPINRequest) (SetLegacyPINRes, error)
 	// SetupTOTP implements setupTOTP operation.
 	//
 	// Generate TOTP secret and QR code for enrollment.
 	//
 	// POST /api/v1/mfa/totp/setup
 	SetupTOTP(ctx context.Context, req *SetupTOTPReq) (SetupTOTPRes, error)
+	// SharePhotoAlbum implements sharePhotoAlbum operation.
+	//
+	// Share a user album with a user who can access its library, or change
+	// whether they may add and remove photos. Only the owner may share.
+	//
+	// PUT /api/v1/photos/albums/{id}/shares/{userId}
+	SharePhotoAlbum(ctx context.Context, req *PhotoAlbumShareRequest, params SharePhotoAlbumParams) (SharePhotoAlbumRes, error)
 	// StartPlaybackSession implements startPlaybackSession operation.
 	//
 	// Creates a new HLS playback session for a movie or episode.
@@ -1109,6 +1982,21 @@ type Handler interface {
 	//
 	// DELETE /api/v1/playback/sessions/{sessionId}
 	StopPlaybackSession(ctx context.Context, params StopPlaybackSessionParams) (StopPlaybackSessionRes, error)
+	// SubscribePodcast implements subscribePodcast operation.
+	//
+	// Subscribe to a podcast that is already in the library.
+	//
+	// PUT /api/v1/podcasts/{id}/subscription
+	SubscribePodcast(ctx context.Context, params SubscribePodcastParams) (SubscribePodcastRes, error)
+	// SubscribePodcastFeed implements subscribePodcastFeed operation.
+	//
+	// Subscribe to an RSS or Atom feed by URL. A feed that is not known yet
+	// is fetched and added to the given podcast library, or to the first
+	// podcast library the user can access. Feeds are shared: subscribing to
+	// a feed someone else added subscribes to the existing podcast.
+	//
+	// POST /api/v1/podcasts
+	SubscribePodcastFeed(ctx context.Context, req *PodcastSubscribeRequest) (SubscribePodcastFeedRes, error)
 	// TriggerLibraryScan implements triggerLibraryScan operation.
 	//
 	// Start a library scan job. Admin only.
@@ -1121,18 +2009,133 @@ type Handler interface {
 	//
 	// DELETE /api/v1/users/me/oidc/{provider}
 	UnlinkOIDCProvider(ctx context.Context, params UnlinkOIDCProviderParams) (UnlinkOIDCProviderRes, error)
+	// UnlockLegacy implements unlockLegacy operation.
+	//
+	// Unlock QAR content with the user's PIN for 30 minutes.
+	//
+	// POST /api/v1/legacy/unlock
+	UnlockLegacy(ctx context.Context, req *LegacyUnlockRequest) (UnlockLegacyRes, error)
+	// UnlockMovieMetadataField implements unlockMovieMetadataField operation.
+	//
+	// Clear the lock on a manually edited field so the next metadata
+	// refresh may update it again. The current value is kept. Admin only.
+	//
+	// DELETE /api/v1/movies/{id}/metadata/locks/{field}
+	UnlockMovieMetadataField(ctx context.Context, params UnlockMovieMetadataFieldParams) (UnlockMovieMetadataFieldRes, error)
+	// UnlockParentalControls implements unlockParentalControls operation.
+	//
+	// Lifts the content restrictions of the authenticated user for one hour
+	// when the override PIN set by an admin matches.
+	//
+	// POST /api/v1/users/me/parental-controls/unlock
+	UnlockParentalControls(ctx context.Context, req *ParentalControlsUnlockRequest) (UnlockParentalControlsRes, error)
+	// UnlockTVEpisodeMetadataField implements unlockTVEpisodeMetadataField operation.
+	//
+	// Clear the lock on a manually edited field so the next metadata
+	// refresh may update it again. The current value is kept. Admin only.
+	//
+	// DELETE /api/v1/tvshows/episodes/{id}/metadata/locks/{field}
+	UnlockTVEpisodeMetadataField(ctx context.Context, params UnlockTVEpisodeMetadataFieldParams) (UnlockTVEpisodeMetadataFieldRes, error)
+	// UnlockTVSeasonMetadataField implements unlockTVSeasonMetadataField operation.
+	//
+	// Clear the lock on a manually edited field so the next metadata
+	// refresh may update it again. The current value is kept. Admin only.
+	//
+	// DELETE /api/v1/tvshows/seasons/{id}/metadata/locks/{field}
+	UnlockTVSeasonMetadataField(ctx context.Context, params UnlockTVSeasonMetadataFieldParams) (UnlockTVSeasonMetadataFieldRes, error)
+	// UnlockTVShowMetadataField implements unlockTVShowMetadataField operation.
+	//
+	// Clear the lock on a manually edited field so the next metadata
+	// refresh may update it again. The current value is kept. Admin only.
+	//
+	// DELETE /api/v1/tvshows/{id}/metadata/locks/{field}
+	UnlockTVShowMetadataField(ctx context.Context, params UnlockTVShowMetadataFieldParams) (UnlockTVShowMetadataFieldRes, error)
+	// UnsharePhotoAlbum implements unsharePhotoAlbum operation.
+	//
+	// Remove a share. The owner may remove any share; users may leave albums
+	// shared with them.
+	//
+	// DELETE /api/v1/photos/albums/{id}/shares/{userId}
+	UnsharePhotoAlbum(ctx context.Context, params UnsharePhotoAlbumParams) (UnsharePhotoAlbumRes, error)
+	// UnsubscribePodcast implements unsubscribePodcast operation.
+	//
+	// Remove the user's subscription. The podcast and its downloads stay in
+	// the library; feeds without subscribers are no longer refreshed.
+	//
+	// DELETE /api/v1/podcasts/{id}/subscription
+	UnsubscribePodcast(ctx context.Context, params UnsubscribePodcastParams) (UnsubscribePodcastRes, error)
+	// UpdateAudiobookProgress implements updateAudiobookProgress operation.
+	//
+	// Report the playback position in an audiobook. The position is on the
+	// book timeline, or within a file when `file_id` is given. The book is
+	// marked finished once less than two minutes of listening are left at
+	// the reported playback speed.
+	//
+	// POST /api/v1/audiobooks/{id}/progress
+	UpdateAudiobookProgress(ctx context.Context, req *AudiobookProgressUpdate, params UpdateAudiobookProgressParams) (UpdateAudiobookProgressRes, error)
+	// UpdateBookProgress implements updateBookProgress operation.
+	//
+	// Report the reading position in a book. Paged readers send the current
+	// `page`, from which the percentage is derived. Reflowable EPUB readers
+	// send a `locator` (such as an EPUB CFI) with the `progress_percent` they
+	// computed. The book is marked finished on its last page or from 99%.
+	//
+	// POST /api/v1/books/{id}/progress
+	UpdateBookProgress(ctx context.Context, req *BookProgressUpdate, params UpdateBookProgressParams) (UpdateBookProgressRes, error)
 	// UpdateCurrentUser implements updateCurrentUser operation.
 	//
 	// Update the authenticated user's profile.
 	//
 	// PUT /api/v1/users/me
 	UpdateCurrentUser(ctx context.Context, req *UserUpdate) (UpdateCurrentUserRes, error)
+	// UpdateHomeVideoProgress implements updateHomeVideoProgress operation.
+	//
+	// Record the user's playback position. Like movies, a video counts as
+	// watched past 90%.
+	//
+	// POST /api/v1/homevideos/{id}/progress
+	UpdateHomeVideoProgress(ctx context.Context, req *HomeVideoProgressUpdate, params UpdateHomeVideoProgressParams) (UpdateHomeVideoProgressRes, error)
 	// UpdateLibrary implements updateLibrary operation.
 	//
 	// Update library settings. Admin only.
 	//
 	// PUT /api/v1/libraries/{libraryId}
 	UpdateLibrary(ctx context.Context, req *UpdateLibraryRequest, params UpdateLibraryParams) (UpdateLibraryRes, error)
+	// UpdateLiveTVChannel implements updateLiveTVChannel operation.
+	//
+	// Enable or disable a channel. Disabled channels are hidden and can't be watched. Admin only.
+	//
+	// PATCH /api/v1/livetv/channels/{id}
+	UpdateLiveTVChannel(ctx context.Context, req *LiveTVChannelUpdate, params UpdateLiveTVChannelParams) (UpdateLiveTVChannelRes, error)
+	// UpdateMovieMetadata implements updateMovieMetadata operation.
+	//
+	// Manually correct movie metadata. Only the fields present in the
+	// body are changed, and each one is locked so metadata refreshes and
+	// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+	//
+	// PATCH /api/v1/movies/{id}/metadata
+	UpdateMovieMetadata(ctx context.Context, req *MovieMetadataEdit, params UpdateMovieMetadataParams) (UpdateMovieMetadataRes, error)
+	// UpdatePhotoAlbum implements updatePhotoAlbum operation.
+	//
+	// Edit a user album. Only the owner may edit it.
+	//
+	// PATCH /api/v1/photos/albums/{id}
+	UpdatePhotoAlbum(ctx context.Context, req *PhotoAlbumUpdate, params UpdatePhotoAlbumParams) (UpdatePhotoAlbumRes, error)
+	// UpdatePodcastEpisodeProgress implements updatePodcastEpisodeProgress operation.
+	//
+	// Report the playback position in an episode. The episode is marked
+	// played once less than a minute is left, unless `played` is given to
+	// mark it played or unplayed explicitly.
+	//
+	// POST /api/v1/podcasts/episodes/{id}/progress
+	UpdatePodcastEpisodeProgress(ctx context.Context, req *PodcastEpisodeProgressUpdate, params UpdatePodcastEpisodeProgressParams) (UpdatePodcastEpisodeProgressRes, error)
+	// UpdatePodcastSettings implements updatePodcastSettings operation.
+	//
+	// Change whether new episodes are downloaded and how many of the newest
+	// episodes are kept. Settings are shared by all subscribers. Admin only.
+	//
+	// PATCH /api/v1/podcasts/{id}
+	UpdatePodcastSettings(ctx context.Context, req *PodcastSettingsUpdate, params UpdatePodcastSettingsParams) (UpdatePodcastSettingsRes, error)
 	// UpdateRolePermissions implements updateRolePermissions operation.
 	//
 	// Update all permissions for a role (admin only).
@@ -1145,12 +2148,36 @@ type Handler interface {
 	//
 	// PUT /api/v1/settings/server/{key}
 	UpdateServerSetting(ctx context.Context, req *SettingValue, params UpdateServerSettingParams) (UpdateServerSettingRes, error)
+	// UpdateTVEpisodeMetadata implements updateTVEpisodeMetadata operation.
+	//
+	// Manually correct episode metadata. Only the fields present in the
+	// body are changed, and each one is locked so metadata refreshes and
+	// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+	//
+	// PATCH /api/v1/tvshows/episodes/{id}/metadata
+	UpdateTVEpisodeMetadata(ctx context.Context, req *TVEpisodeMetadataEdit, params UpdateTVEpisodeMetadataParams) (UpdateTVEpisodeMetadataRes, error)
 	// UpdateTVEpisodeProgress implements updateTVEpisodeProgress operation.
 	//
 	// Update user's watch progress for an episode.
 	//
 	// PUT /api/v1/tvshows/episodes/{id}/progress
 	UpdateTVEpisodeProgress(ctx context.Context, req *UpdateEpisodeProgressRequest, params UpdateTVEpisodeProgressParams) (UpdateTVEpisodeProgressRes, error)
+	// UpdateTVSeasonMetadata implements updateTVSeasonMetadata operation.
+	//
+	// Manually correct season metadata. Only the fields present in the
+	// body are changed, and each one is locked so metadata refreshes and
+	// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+	//
+	// PATCH /api/v1/tvshows/seasons/{id}/metadata
+	UpdateTVSeasonMetadata(ctx context.Context, req *TVSeasonMetadataEdit, params UpdateTVSeasonMetadataParams) (UpdateTVSeasonMetadataRes, error)
+	// UpdateTVShowMetadata implements updateTVShowMetadata operation.
+	//
+	// Manually correct TV show metadata. Only the fields present in the
+	// body are changed, and each one is locked so metadata refreshes and
+	// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+	//
+	// PATCH /api/v1/tvshows/{id}/metadata
+	UpdateTVShowMetadata(ctx context.Context, req *TVSeriesMetadataEdit, params UpdateTVShowMetadataParams) (UpdateTVShowMetadataRes, error)
 	// UpdateUserPreferences implements updateUserPreferences operation.
 	//
 	// Update notification and display preferences.
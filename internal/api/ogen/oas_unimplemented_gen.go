@@ -13,6 +13,16 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddPhotoAlbumPhotos implements addPhotoAlbumPhotos operation.
+//
+// Append photos of the album's library to a user album. The owner and
+// users it is shared with as contributors may add photos.
+//
+// POST /api/v1/photos/albums/{id}/photos
+func (UnimplementedHandler) AddPhotoAlbumPhotos(ctx context.Context, req *PhotoAlbumPhotosAdd, params AddPhotoAlbumPhotosParams) (r AddPhotoAlbumPhotosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AddPolicy implements addPolicy operation.
 //
 // Add a new authorization policy (admin only).
@@ -67,6 +77,37 @@ func (UnimplementedHandler) AdminEnableOIDCProvider(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// AdminExportMovieNFO implements adminExportMovieNFO operation.
+//
+// Queues a job that writes Kodi/Jellyfin compatible NFO files next to
+// movie files, so other tools on the same share stay in sync. Without a
+// movie ID the whole movie library is exported. Admin only.
+//
+// POST /api/v1/admin/movies/nfo-export
+func (UnimplementedHandler) AdminExportMovieNFO(ctx context.Context, req OptNFOExportRequest) (r AdminExportMovieNFORes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminExportTVShowNFO implements adminExportTVShowNFO operation.
+//
+// Queues a job that writes Kodi/Jellyfin compatible tvshow.nfo and
+// episode NFO files next to episode files. Without a series ID the
+// whole TV show library is exported. Admin only.
+//
+// POST /api/v1/admin/tvshows/nfo-export
+func (UnimplementedHandler) AdminExportTVShowNFO(ctx context.Context, req OptTVShowNFOExportRequest) (r AdminExportTVShowNFORes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminGetMatchQueueItem implements adminGetMatchQueueItem operation.
+//
+// Returns a single queued file with its candidates. Admin only.
+//
+// GET /api/v1/admin/match-queue/{itemId}
+func (UnimplementedHandler) AdminGetMatchQueueItem(ctx context.Context, params AdminGetMatchQueueItemParams) (r AdminGetMatchQueueItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AdminGetOIDCProvider implements adminGetOIDCProvider operation.
 //
 // Returns detailed OIDC provider configuration.
@@ -76,6 +117,15 @@ func (UnimplementedHandler) AdminGetOIDCProvider(ctx context.Context, params Adm
 	return r, ht.ErrNotImplemented
 }
 
+// AdminGetParentalControls implements adminGetParentalControls operation.
+//
+// Returns the content restrictions of a user profile.
+//
+// GET /api/v1/admin/users/{userId}/parental-controls
+func (UnimplementedHandler) AdminGetParentalControls(ctx context.Context, params AdminGetParentalControlsParams) (r AdminGetParentalControlsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AdminGetRadarrQualityProfiles implements adminGetRadarrQualityProfiles operation.
 //
 // Returns all quality profiles configured in Radarr.
@@ -140,6 +190,37 @@ func (UnimplementedHandler) AdminGetSonarrStatus(ctx context.Context) (r AdminGe
 	return r, ht.ErrNotImplemented
 }
 
+// AdminIdentifyMatchQueueItem implements adminIdentifyMatchQueueItem operation.
+//
+// Manually matches a queued file, either by picking one of its candidates
+// or by entering a provider ID. The file's match is locked so later scans
+// leave it alone. Admin only.
+//
+// POST /api/v1/admin/match-queue/{itemId}/identify
+func (UnimplementedHandler) AdminIdentifyMatchQueueItem(ctx context.Context, req *IdentifyMatchRequest, params AdminIdentifyMatchQueueItemParams) (r AdminIdentifyMatchQueueItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminIgnoreMatchQueueItem implements adminIgnoreMatchQueueItem operation.
+//
+// Hides a queued file. Rescans keep it ignored. Admin only.
+//
+// POST /api/v1/admin/match-queue/{itemId}/ignore
+func (UnimplementedHandler) AdminIgnoreMatchQueueItem(ctx context.Context, params AdminIgnoreMatchQueueItemParams) (r AdminIgnoreMatchQueueItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AdminListMatchQueue implements adminListMatchQueue operation.
+//
+// Lists scanned files the matcher could not identify, or matched below the
+// confidence threshold, together with their top provider candidates.
+// Admin only.
+//
+// GET /api/v1/admin/match-queue
+func (UnimplementedHandler) AdminListMatchQueue(ctx context.Context, params AdminListMatchQueueParams) (r AdminListMatchQueueRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AdminListOIDCProviders implements adminListOIDCProviders operation.
 //
 // Returns all OIDC providers including disabled ones.
@@ -190,6 +271,16 @@ func (UnimplementedHandler) AdminTriggerSonarrSync(ctx context.Context) (r Admin
 	return r, ht.ErrNotImplemented
 }
 
+// AdminUnlockMovieFileMatch implements adminUnlockMovieFileMatch operation.
+//
+// Clears the match lock set by manual identification so the next scan may
+// rematch the file. Admin only.
+//
+// DELETE /api/v1/admin/movies/files/{fileId}/match-lock
+func (UnimplementedHandler) AdminUnlockMovieFileMatch(ctx context.Context, params AdminUnlockMovieFileMatchParams) (r AdminUnlockMovieFileMatchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AdminUpdateOIDCProvider implements adminUpdateOIDCProvider operation.
 //
 // Updates an OIDC provider configuration.
@@ -199,6 +290,16 @@ func (UnimplementedHandler) AdminUpdateOIDCProvider(ctx context.Context, req *Up
 	return r, ht.ErrNotImplemented
 }
 
+// AdminUpdateParentalControls implements adminUpdateParentalControls operation.
+//
+// Replaces the content restrictions of a user profile. Restrictions are
+// enforced on lists, search, continue watching and playback start.
+//
+// PUT /api/v1/admin/users/{userId}/parental-controls
+func (UnimplementedHandler) AdminUpdateParentalControls(ctx context.Context, req *ParentalControlsUpdate, params AdminUpdateParentalControlsParams) (r AdminUpdateParentalControlsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AssignRole implements assignRole operation.
 //
 // Assign a role to a user (admin only).
@@ -246,6 +347,16 @@ func (UnimplementedHandler) BeginWebAuthnRegistration(ctx context.Context, req O
 	return r, ht.ErrNotImplemented
 }
 
+// CancelLibraryScan implements cancelLibraryScan operation.
+//
+// Cancel a pending or running library scan. The scan is marked cancelled
+// and its background job is stopped. Admin only.
+//
+// POST /api/v1/libraries/{libraryId}/scans/{scanId}/cancel
+func (UnimplementedHandler) CancelLibraryScan(ctx context.Context, params CancelLibraryScanParams) (r CancelLibraryScanRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ChangePassword implements changePassword operation.
 //
 // Change password for authenticated user (requires old password).
@@ -273,6 +384,50 @@ func (UnimplementedHandler) CreateLibrary(ctx context.Context, req *CreateLibrar
 	return r, ht.ErrNotImplemented
 }
 
+// CreateLiveTVRecording implements createLiveTVRecording operation.
+//
+// Schedule a recording of a guide program, or of a channel between
+// two times. Recordings start early and end late by their padding,
+// which defaults to the server settings, and are imported into the
+// given TV library, or the first one.
+//
+// POST /api/v1/livetv/recordings
+func (UnimplementedHandler) CreateLiveTVRecording(ctx context.Context, req *LiveTVRecordingCreate) (r CreateLiveTVRecordingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateLiveTVSeriesRule implements createLiveTVSeriesRule operation.
+//
+// Record every upcoming airing of a title, optionally on one channel
+// and only when it is new. Matching airings are scheduled whenever
+// the guide is refreshed.
+//
+// POST /api/v1/livetv/series-rules
+func (UnimplementedHandler) CreateLiveTVSeriesRule(ctx context.Context, req *LiveTVSeriesRuleCreate) (r CreateLiveTVSeriesRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateLiveTVSource implements createLiveTVSource operation.
+//
+// Add an M3U channel list (a network tuner's lineup or an IPTV
+// playlist) or an XMLTV guide, by http(s) URL or absolute local path.
+// The source is loaded by a refresh that is queued right away. Admin
+// only.
+//
+// POST /api/v1/livetv/sources
+func (UnimplementedHandler) CreateLiveTVSource(ctx context.Context, req *LiveTVSourceCreate) (r CreateLiveTVSourceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreatePhotoAlbum implements createPhotoAlbum operation.
+//
+// Create a user album in a photo library.
+//
+// POST /api/v1/photos/albums
+func (UnimplementedHandler) CreatePhotoAlbum(ctx context.Context, req *PhotoAlbumCreate) (r CreatePhotoAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateRole implements createRole operation.
 //
 // Create a new custom role (admin only).
@@ -282,6 +437,33 @@ func (UnimplementedHandler) CreateRole(ctx context.Context, req *CreateRoleReque
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteAudiobookProgress implements deleteAudiobookProgress operation.
+//
+// Remove the user's listening progress for an audiobook.
+//
+// DELETE /api/v1/audiobooks/{id}/progress
+func (UnimplementedHandler) DeleteAudiobookProgress(ctx context.Context, params DeleteAudiobookProgressParams) (r DeleteAudiobookProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteBookProgress implements deleteBookProgress operation.
+//
+// Remove the user's reading progress for a book.
+//
+// DELETE /api/v1/books/{id}/progress
+func (UnimplementedHandler) DeleteBookProgress(ctx context.Context, params DeleteBookProgressParams) (r DeleteBookProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteHomeVideoProgress implements deleteHomeVideoProgress operation.
+//
+// Remove the user's watch progress for a home video.
+//
+// DELETE /api/v1/homevideos/{id}/progress
+func (UnimplementedHandler) DeleteHomeVideoProgress(ctx context.Context, params DeleteHomeVideoProgressParams) (r DeleteHomeVideoProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteLibrary implements deleteLibrary operation.
 //
 // Delete a library and all its content. Admin only.
@@ -291,6 +473,63 @@ func (UnimplementedHandler) DeleteLibrary(ctx context.Context, params DeleteLibr
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteLiveTVRecording implements deleteLiveTVRecording operation.
+//
+// Cancel a scheduled recording, or remove a finished one from the
+// list. Recorded files stay in the TV library. Running recordings
+// can't be deleted.
+//
+// DELETE /api/v1/livetv/recordings/{id}
+func (UnimplementedHandler) DeleteLiveTVRecording(ctx context.Context, params DeleteLiveTVRecordingParams) (r DeleteLiveTVRecordingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteLiveTVSeriesRule implements deleteLiveTVSeriesRule operation.
+//
+// Remove a series rule and cancel the recordings it scheduled that have not started.
+//
+// DELETE /api/v1/livetv/series-rules/{id}
+func (UnimplementedHandler) DeleteLiveTVSeriesRule(ctx context.Context, params DeleteLiveTVSeriesRuleParams) (r DeleteLiveTVSeriesRuleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteLiveTVSource implements deleteLiveTVSource operation.
+//
+// Remove a source with its channels or programs. Recordings are kept.
+// Admin only.
+//
+// DELETE /api/v1/livetv/sources/{id}
+func (UnimplementedHandler) DeleteLiveTVSource(ctx context.Context, params DeleteLiveTVSourceParams) (r DeleteLiveTVSourceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeletePhotoAlbum implements deletePhotoAlbum operation.
+//
+// Delete a user album. The photos stay in the library.
+//
+// DELETE /api/v1/photos/albums/{id}
+func (UnimplementedHandler) DeletePhotoAlbum(ctx context.Context, params DeletePhotoAlbumParams) (r DeletePhotoAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeletePodcastEpisodeDownload implements deletePodcastEpisodeDownload operation.
+//
+// Remove the downloaded audio and transcript of an episode. Admin only.
+//
+// DELETE /api/v1/podcasts/episodes/{id}/download
+func (UnimplementedHandler) DeletePodcastEpisodeDownload(ctx context.Context, params DeletePodcastEpisodeDownloadParams) (r DeletePodcastEpisodeDownloadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeletePodcastEpisodeProgress implements deletePodcastEpisodeProgress operation.
+//
+// Remove the user's listening state for an episode.
+//
+// DELETE /api/v1/podcasts/episodes/{id}/progress
+func (UnimplementedHandler) DeletePodcastEpisodeProgress(ctx context.Context, params DeletePodcastEpisodeProgressParams) (r DeletePodcastEpisodeProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteRole implements deleteRole operation.
 //
 // Delete a custom role (admin only, cannot delete built-in roles).
@@ -354,6 +593,44 @@ func (UnimplementedHandler) DisableTOTP(ctx context.Context) (r DisableTOTPRes,
 	return r, ht.ErrNotImplemented
 }
 
+// DownloadBookFile implements downloadBookFile operation.
+//
+// Download the original EPUB, PDF, CBZ or CBR file.
+//
+// GET /api/v1/books/{id}/file
+func (UnimplementedHandler) DownloadBookFile(ctx context.Context, params DownloadBookFileParams) (r DownloadBookFileRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DownloadLegacySceneFile implements downloadLegacySceneFile operation.
+//
+// Download the video file of a scene.
+//
+// GET /api/v1/legacy/scenes/{id}/file
+func (UnimplementedHandler) DownloadLegacySceneFile(ctx context.Context, params DownloadLegacySceneFileParams) (r DownloadLegacySceneFileRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DownloadPhotoFile implements downloadPhotoFile operation.
+//
+// Download the original photo or clip.
+//
+// GET /api/v1/photos/{id}/file
+func (UnimplementedHandler) DownloadPhotoFile(ctx context.Context, params DownloadPhotoFileParams) (r DownloadPhotoFileRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DownloadPodcastEpisode implements downloadPodcastEpisode operation.
+//
+// Queue a download of the episode into the podcast library. Episodes
+// downloaded on request are pinned and kept regardless of the
+// podcast's retention setting.
+//
+// POST /api/v1/podcasts/episodes/{id}/download
+func (UnimplementedHandler) DownloadPodcastEpisode(ctx context.Context, params DownloadPodcastEpisodeParams) (r DownloadPodcastEpisodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // EnableMFA implements enableMFA operation.
 //
 // Require MFA for login (at least one method must be configured).
@@ -417,6 +694,97 @@ func (UnimplementedHandler) GetActivityStats(ctx context.Context) (r GetActivity
 	return r, ht.ErrNotImplemented
 }
 
+// GetAudiobook implements getAudiobook operation.
+//
+// Get an audiobook with its authors, files, chapters and the user's progress.
+//
+// GET /api/v1/audiobooks/{id}
+func (UnimplementedHandler) GetAudiobook(ctx context.Context, params GetAudiobookParams) (r GetAudiobookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAudiobookAuthorBooks implements getAudiobookAuthorBooks operation.
+//
+// Get the audiobooks of an author.
+//
+// GET /api/v1/audiobooks/authors/{id}/books
+func (UnimplementedHandler) GetAudiobookAuthorBooks(ctx context.Context, params GetAudiobookAuthorBooksParams) (r GetAudiobookAuthorBooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAudiobookProgress implements getAudiobookProgress operation.
+//
+// Get the user's listening position in an audiobook.
+//
+// GET /api/v1/audiobooks/{id}/progress
+func (UnimplementedHandler) GetAudiobookProgress(ctx context.Context, params GetAudiobookProgressParams) (r GetAudiobookProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAudiobookSeriesBooks implements getAudiobookSeriesBooks operation.
+//
+// Get the audiobooks of a series in series order.
+//
+// GET /api/v1/audiobooks/series/{id}/books
+func (UnimplementedHandler) GetAudiobookSeriesBooks(ctx context.Context, params GetAudiobookSeriesBooksParams) (r GetAudiobookSeriesBooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBook implements getBook operation.
+//
+// Get a book with its authors, series and the user's reading progress.
+//
+// GET /api/v1/books/{id}
+func (UnimplementedHandler) GetBook(ctx context.Context, params GetBookParams) (r GetBookRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBookAuthorBooks implements getBookAuthorBooks operation.
+//
+// Get the books of an author.
+//
+// GET /api/v1/books/authors/{id}/books
+func (UnimplementedHandler) GetBookAuthorBooks(ctx context.Context, params GetBookAuthorBooksParams) (r GetBookAuthorBooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBookCover implements getBookCover operation.
+//
+// Get the cover of a book as a thumbnail. EPUB and comic covers are read
+// from the file; PDF covers are rendered from the first page.
+//
+// GET /api/v1/books/{id}/cover
+func (UnimplementedHandler) GetBookCover(ctx context.Context, params GetBookCoverParams) (r GetBookCoverRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBookPage implements getBookPage operation.
+//
+// Get a page image of a CBZ or CBR comic. Pages are numbered from 1 in reading order.
+//
+// GET /api/v1/books/{id}/pages/{page}
+func (UnimplementedHandler) GetBookPage(ctx context.Context, params GetBookPageParams) (r GetBookPageRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBookProgress implements getBookProgress operation.
+//
+// Get the user's reading position in a book.
+//
+// GET /api/v1/books/{id}/progress
+func (UnimplementedHandler) GetBookProgress(ctx context.Context, params GetBookProgressParams) (r GetBookProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBookSeriesBooks implements getBookSeriesBooks operation.
+//
+// Get the books or issues of a series in series order.
+//
+// GET /api/v1/books/series/{id}/books
+func (UnimplementedHandler) GetBookSeriesBooks(ctx context.Context, params GetBookSeriesBooksParams) (r GetBookSeriesBooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetCollection implements getCollection operation.
 //
 // Get detailed information about a collection.
@@ -445,6 +813,24 @@ func (UnimplementedHandler) GetCollectionMovies(ctx context.Context, params GetC
 	return r, ht.ErrNotImplemented
 }
 
+// GetContinueListening implements getContinueListening operation.
+//
+// Get audiobooks the user has started but not finished, most recently played first.
+//
+// GET /api/v1/audiobooks/continue-listening
+func (UnimplementedHandler) GetContinueListening(ctx context.Context, params GetContinueListeningParams) (r GetContinueListeningRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetContinueReading implements getContinueReading operation.
+//
+// Get books the user has started but not finished, most recently read first.
+//
+// GET /api/v1/books/continue-reading
+func (UnimplementedHandler) GetContinueReading(ctx context.Context, params GetContinueReadingParams) (r GetContinueReadingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetContinueWatching implements getContinueWatching operation.
 //
 // Get movies the user is currently watching.
@@ -491,113 +877,266 @@ func (UnimplementedHandler) GetEpisodeMetadataImages(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
-// GetLibrary implements getLibrary operation.
+// GetHomeFeed implements getHomeFeed operation.
 //
-// Get detailed information about a library.
+// Get the rows of the user's home screen across all enabled content
+// modules: on deck, continue watching, next up, recently added per
+// library and recommended. Only content of libraries the user can access
+// is included, and empty rows are left out. Each row holds the first
+// page of its items; page further with the row endpoint.
 //
-// GET /api/v1/libraries/{libraryId}
-func (UnimplementedHandler) GetLibrary(ctx context.Context, params GetLibraryParams) (r GetLibraryRes, _ error) {
+// GET /api/v1/home
+func (UnimplementedHandler) GetHomeFeed(ctx context.Context, params GetHomeFeedParams) (r GetHomeFeedRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetLiveness implements getLiveness operation.
+// GetHomeFeedRow implements getHomeFeedRow operation.
 //
-// Checks if the service is alive and running.
-// This should always return 200 unless the process is deadlocked.
-// Used by Kubernetes liveness probes.
+// Get a page of a single home feed row.
 //
-// GET /healthz
-func (UnimplementedHandler) GetLiveness(ctx context.Context) (r *HealthCheck, _ error) {
+// GET /api/v1/home/rows/{id}
+func (UnimplementedHandler) GetHomeFeedRow(ctx context.Context, params GetHomeFeedRowParams) (r GetHomeFeedRowRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMFAStatus implements getMFAStatus operation.
+// GetHomeVideo implements getHomeVideo operation.
 //
-// Get current MFA configuration status for authenticated user.
+// Get a home video with what its container and file name tell about it.
 //
-// GET /api/v1/mfa/status
-func (UnimplementedHandler) GetMFAStatus(ctx context.Context) (r GetMFAStatusRes, _ error) {
+// GET /api/v1/homevideos/{id}
+func (UnimplementedHandler) GetHomeVideo(ctx context.Context, params GetHomeVideoParams) (r GetHomeVideoRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovie implements getMovie operation.
+// GetHomeVideoFolder implements getHomeVideoFolder operation.
 //
-// Get detailed information about a movie.
+// Get a folder with its breadcrumb trail from the library root.
 //
-// GET /api/v1/movies/{id}
-func (UnimplementedHandler) GetMovie(ctx context.Context, params GetMovieParams) (r GetMovieRes, _ error) {
+// GET /api/v1/homevideos/folders/{id}
+func (UnimplementedHandler) GetHomeVideoFolder(ctx context.Context, params GetHomeVideoFolderParams) (r GetHomeVideoFolderRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieCast implements getMovieCast operation.
+// GetHomeVideoProgress implements getHomeVideoProgress operation.
 //
-// Get cast members for a movie.
+// Get the user's watch progress for a home video.
 //
-// GET /api/v1/movies/{id}/cast
-func (UnimplementedHandler) GetMovieCast(ctx context.Context, params GetMovieCastParams) (r GetMovieCastRes, _ error) {
+// GET /api/v1/homevideos/{id}/progress
+func (UnimplementedHandler) GetHomeVideoProgress(ctx context.Context, params GetHomeVideoProgressParams) (r GetHomeVideoProgressRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieCollection implements getMovieCollection operation.
+// GetHomeVideoThumbnail implements getHomeVideoThumbnail operation.
 //
-// Get the collection this movie belongs to.
+// Get the frame grabbed from the video during the library scan.
 //
-// GET /api/v1/movies/{id}/collection
-func (UnimplementedHandler) GetMovieCollection(ctx context.Context, params GetMovieCollectionParams) (r GetMovieCollectionRes, _ error) {
+// GET /api/v1/homevideos/{id}/thumbnail
+func (UnimplementedHandler) GetHomeVideoThumbnail(ctx context.Context, params GetHomeVideoThumbnailParams) (r GetHomeVideoThumbnailRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieCrew implements getMovieCrew operation.
+// GetLegacyAccess implements getLegacyAccess operation.
 //
-// Get crew members for a movie.
+// Get whether the user was granted QAR access, whether a PIN is required
+// and set, and until when the content is unlocked.
 //
-// GET /api/v1/movies/{id}/crew
-func (UnimplementedHandler) GetMovieCrew(ctx context.Context, params GetMovieCrewParams) (r GetMovieCrewRes, _ error) {
+// GET /api/v1/legacy/access
+func (UnimplementedHandler) GetLegacyAccess(ctx context.Context) (r GetLegacyAccessRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieExternalIDs implements getMovieExternalIDs operation.
+// GetLegacyPerformer implements getLegacyPerformer operation.
 //
-// Fetch external database IDs for a movie (IMDb, TVDb, Wikidata, social media).
+// Get a performer with their number of accessible scenes.
 //
-// GET /api/v1/metadata/movie/{id}/external-ids
-func (UnimplementedHandler) GetMovieExternalIDs(ctx context.Context, params GetMovieExternalIDsParams) (r GetMovieExternalIDsRes, _ error) {
+// GET /api/v1/legacy/performers/{id}
+func (UnimplementedHandler) GetLegacyPerformer(ctx context.Context, params GetLegacyPerformerParams) (r GetLegacyPerformerRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieFiles implements getMovieFiles operation.
+// GetLegacyScene implements getLegacyScene operation.
 //
-// Get physical files for a movie.
+// Get a scene with its studio and performers.
 //
-// GET /api/v1/movies/{id}/files
-func (UnimplementedHandler) GetMovieFiles(ctx context.Context, params GetMovieFilesParams) (r GetMovieFilesRes, _ error) {
+// GET /api/v1/legacy/scenes/{id}
+func (UnimplementedHandler) GetLegacyScene(ctx context.Context, params GetLegacySceneParams) (r GetLegacySceneRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieGenres implements getMovieGenres operation.
+// GetLegacyStudio implements getLegacyStudio operation.
 //
-// Get genres for a movie.
+// Get a studio with its number of accessible scenes.
 //
-// GET /api/v1/movies/{id}/genres
-func (UnimplementedHandler) GetMovieGenres(ctx context.Context, params GetMovieGenresParams) (r GetMovieGenresRes, _ error) {
+// GET /api/v1/legacy/studios/{id}
+func (UnimplementedHandler) GetLegacyStudio(ctx context.Context, params GetLegacyStudioParams) (r GetLegacyStudioRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieMetadata implements getMovieMetadata operation.
+// GetLibrary implements getLibrary operation.
 //
-// Fetch detailed movie information from TMDb by TMDb ID.
-// Returns full metadata including cast, crew, and images.
+// Get detailed information about a library.
 //
-// GET /api/v1/metadata/movie/{id}
-func (UnimplementedHandler) GetMovieMetadata(ctx context.Context, params GetMovieMetadataParams) (r GetMovieMetadataRes, _ error) {
+// GET /api/v1/libraries/{libraryId}
+func (UnimplementedHandler) GetLibrary(ctx context.Context, params GetLibraryParams) (r GetLibraryRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// GetMovieMetadataCredits implements getMovieMetadataCredits operation.
+// GetLiveTVChannel implements getLiveTVChannel operation.
 //
-// Fetch cast and crew credits for a movie from TMDb.
+// Get a live TV channel. Start watching it with a playback session of media type channel.
 //
-// GET /api/v1/metadata/movie/{id}/credits
+// GET /api/v1/livetv/channels/{id}
+func (UnimplementedHandler) GetLiveTVChannel(ctx context.Context, params GetLiveTVChannelParams) (r GetLiveTVChannelRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLiveTVGuide implements getLiveTVGuide operation.
+//
+// Get the enabled channels, or one channel, with the programs airing
+// in a time window of up to 14 days. The window defaults to the next
+// six hours.
+//
+// GET /api/v1/livetv/guide
+func (UnimplementedHandler) GetLiveTVGuide(ctx context.Context, params GetLiveTVGuideParams) (r GetLiveTVGuideRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLiveTVProgram implements getLiveTVProgram operation.
+//
+// Get a program of the guide.
+//
+// GET /api/v1/livetv/programs/{id}
+func (UnimplementedHandler) GetLiveTVProgram(ctx context.Context, params GetLiveTVProgramParams) (r GetLiveTVProgramRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLiveTVRecording implements getLiveTVRecording operation.
+//
+// Get a recording of the user.
+//
+// GET /api/v1/livetv/recordings/{id}
+func (UnimplementedHandler) GetLiveTVRecording(ctx context.Context, params GetLiveTVRecordingParams) (r GetLiveTVRecordingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLiveness implements getLiveness operation.
+//
+// Checks if the service is alive and running.
+// This should always return 200 unless the process is deadlocked.
+// Used by Kubernetes liveness probes.
+//
+// GET /healthz
+func (UnimplementedHandler) GetLiveness(ctx context.Context) (r *HealthCheck, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMFAStatus implements getMFAStatus operation.
+//
+// Get current MFA configuration status for authenticated user.
+//
+// GET /api/v1/mfa/status
+func (UnimplementedHandler) GetMFAStatus(ctx context.Context) (r GetMFAStatusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovie implements getMovie operation.
+//
+// Get detailed information about a movie.
+//
+// GET /api/v1/movies/{id}
+func (UnimplementedHandler) GetMovie(ctx context.Context, params GetMovieParams) (r GetMovieRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieCast implements getMovieCast operation.
+//
+// Get cast members for a movie.
+//
+// GET /api/v1/movies/{id}/cast
+func (UnimplementedHandler) GetMovieCast(ctx context.Context, params GetMovieCastParams) (r GetMovieCastRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieCollection implements getMovieCollection operation.
+//
+// Get the collection this movie belongs to.
+//
+// GET /api/v1/movies/{id}/collection
+func (UnimplementedHandler) GetMovieCollection(ctx context.Context, params GetMovieCollectionParams) (r GetMovieCollectionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieCrew implements getMovieCrew operation.
+//
+// Get crew members for a movie.
+//
+// GET /api/v1/movies/{id}/crew
+func (UnimplementedHandler) GetMovieCrew(ctx context.Context, params GetMovieCrewParams) (r GetMovieCrewRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieEditions implements getMovieEditions operation.
+//
+// Get the files of a movie grouped by edition (Director's Cut, Extended, ...). The default version
+// comes first.
+//
+// GET /api/v1/movies/{id}/editions
+func (UnimplementedHandler) GetMovieEditions(ctx context.Context, params GetMovieEditionsParams) (r GetMovieEditionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieExternalIDs implements getMovieExternalIDs operation.
+//
+// Fetch external database IDs for a movie (IMDb, TVDb, Wikidata, social media).
+//
+// GET /api/v1/metadata/movie/{id}/external-ids
+func (UnimplementedHandler) GetMovieExternalIDs(ctx context.Context, params GetMovieExternalIDsParams) (r GetMovieExternalIDsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieExtras implements getMovieExtras operation.
+//
+// Get trailers, featurettes, deleted scenes and other extras of a movie. Extras are played with
+// media_type movie_extra.
+//
+// GET /api/v1/movies/{id}/extras
+func (UnimplementedHandler) GetMovieExtras(ctx context.Context, params GetMovieExtrasParams) (r GetMovieExtrasRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieFiles implements getMovieFiles operation.
+//
+// Get physical files for a movie.
+//
+// GET /api/v1/movies/{id}/files
+func (UnimplementedHandler) GetMovieFiles(ctx context.Context, params GetMovieFilesParams) (r GetMovieFilesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieGenres implements getMovieGenres operation.
+//
+// Get genres for a movie.
+//
+// GET /api/v1/movies/{id}/genres
+func (UnimplementedHandler) GetMovieGenres(ctx context.Context, params GetMovieGenresParams) (r GetMovieGenresRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieMetadata implements getMovieMetadata operation.
+//
+// Fetch detailed movie information from TMDb by TMDb ID.
+// Returns full metadata including cast, crew, and images.
+//
+// GET /api/v1/metadata/movie/{id}
+func (UnimplementedHandler) GetMovieMetadata(ctx context.Context, params GetMovieMetadataParams) (r GetMovieMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMovieMetadataCredits implements getMovieMetadataCredits operation.
+//
+// Fetch cast and crew credits for a movie from TMDb.
+//
+// GET /api/v1/metadata/movie/{id}/credits
 func (UnimplementedHandler) GetMovieMetadataCredits(ctx context.Context, params GetMovieMetadataCreditsParams) (r GetMovieMetadataCreditsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
@@ -620,6 +1159,116 @@ func (UnimplementedHandler) GetMovieRecommendationsMetadata(ctx context.Context,
 	return r, ht.ErrNotImplemented
 }
 
+// GetMovieTags implements getMovieTags operation.
+//
+// Get keywords and tags for a movie. Spoiler tags are only included when the user has enabled
+// show_spoilers in their preferences.
+//
+// GET /api/v1/movies/{id}/tags
+func (UnimplementedHandler) GetMovieTags(ctx context.Context, params GetMovieTagsParams) (r GetMovieTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicAlbum implements getMusicAlbum operation.
+//
+// Get an album with its artists and tracks grouped by disc.
+//
+// GET /api/v1/music/albums/{id}
+func (UnimplementedHandler) GetMusicAlbum(ctx context.Context, params GetMusicAlbumParams) (r GetMusicAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicAlbumEditions implements getMusicAlbumEditions operation.
+//
+// Get all editions in the album's release group (original, remaster, deluxe, ...).
+//
+// GET /api/v1/music/albums/{id}/editions
+func (UnimplementedHandler) GetMusicAlbumEditions(ctx context.Context, params GetMusicAlbumEditionsParams) (r GetMusicAlbumEditionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicArtist implements getMusicArtist operation.
+//
+// Get an artist by ID.
+//
+// GET /api/v1/music/artists/{id}
+func (UnimplementedHandler) GetMusicArtist(ctx context.Context, params GetMusicArtistParams) (r GetMusicArtistRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicArtistAlbums implements getMusicArtistAlbums operation.
+//
+// Get the albums an artist is credited on, oldest first.
+//
+// GET /api/v1/music/artists/{id}/albums
+func (UnimplementedHandler) GetMusicArtistAlbums(ctx context.Context, params GetMusicArtistAlbumsParams) (r GetMusicArtistAlbumsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicArtistTracks implements getMusicArtistTracks operation.
+//
+// Get the tracks an artist performs on, including guest appearances.
+//
+// GET /api/v1/music/artists/{id}/tracks
+func (UnimplementedHandler) GetMusicArtistTracks(ctx context.Context, params GetMusicArtistTracksParams) (r GetMusicArtistTracksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicTrack implements getMusicTrack operation.
+//
+// Get a track with its performing artists.
+//
+// GET /api/v1/music/tracks/{id}
+func (UnimplementedHandler) GetMusicTrack(ctx context.Context, params GetMusicTrackParams) (r GetMusicTrackRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicVideo implements getMusicVideo operation.
+//
+// Get a music video with its artist and MusicBrainz match.
+//
+// GET /api/v1/musicvideos/{id}
+func (UnimplementedHandler) GetMusicVideo(ctx context.Context, params GetMusicVideoParams) (r GetMusicVideoRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMusicVideoArtist implements getMusicVideoArtist operation.
+//
+// Get an artist with its number of videos.
+//
+// GET /api/v1/musicvideos/artists/{id}
+func (UnimplementedHandler) GetMusicVideoArtist(ctx context.Context, params GetMusicVideoArtistParams) (r GetMusicVideoArtistRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMyParentalControls implements getMyParentalControls operation.
+//
+// Get the content restrictions that apply to the authenticated user.
+//
+// GET /api/v1/users/me/parental-controls
+func (UnimplementedHandler) GetMyParentalControls(ctx context.Context) (r GetMyParentalControlsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPerson implements getPerson operation.
+//
+// Get a cast or crew member known from the library's credits.
+//
+// GET /api/v1/people/{personId}
+func (UnimplementedHandler) GetPerson(ctx context.Context, params GetPersonParams) (r GetPersonRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPersonFilmography implements getPersonFilmography operation.
+//
+// List the person's credits on movies and TV series in the library.
+// Episode credits are grouped per series with the episode count.
+//
+// GET /api/v1/people/{personId}/filmography
+func (UnimplementedHandler) GetPersonFilmography(ctx context.Context, params GetPersonFilmographyParams) (r GetPersonFilmographyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPersonMetadata implements getPersonMetadata operation.
 //
 // Fetch detailed information about a person (actor, director, etc.) from TMDb.
@@ -647,6 +1296,45 @@ func (UnimplementedHandler) GetPersonMetadataImages(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// GetPhoto implements getPhoto operation.
+//
+// Get a photo with its capture metadata.
+//
+// GET /api/v1/photos/{id}
+func (UnimplementedHandler) GetPhoto(ctx context.Context, params GetPhotoParams) (r GetPhotoRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPhotoAlbum implements getPhotoAlbum operation.
+//
+// Get a folder album or a user album the user owns or that is shared with them.
+//
+// GET /api/v1/photos/albums/{id}
+func (UnimplementedHandler) GetPhotoAlbum(ctx context.Context, params GetPhotoAlbumParams) (r GetPhotoAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPhotoThumbnail implements getPhotoThumbnail operation.
+//
+// Get a photo scaled down to the given width, rotated upright. Clips have
+// no thumbnail.
+//
+// GET /api/v1/photos/{id}/thumbnail
+func (UnimplementedHandler) GetPhotoThumbnail(ctx context.Context, params GetPhotoThumbnailParams) (r GetPhotoThumbnailRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPhotoTimeline implements getPhotoTimeline operation.
+//
+// Get the days or months that have photos, newest first, with the number
+// of photos and a cover photo. Page through a bucket with listPhotos and
+// its taken_after and taken_before filters.
+//
+// GET /api/v1/photos/timeline
+func (UnimplementedHandler) GetPhotoTimeline(ctx context.Context, params GetPhotoTimelineParams) (r GetPhotoTimelineRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPlaybackSession implements getPlaybackSession operation.
 //
 // Returns metadata for an active playback session.
@@ -656,10 +1344,48 @@ func (UnimplementedHandler) GetPlaybackSession(ctx context.Context, params GetPl
 	return r, ht.ErrNotImplemented
 }
 
+// GetPodcast implements getPodcast operation.
+//
+// Get a podcast and whether the user subscribes to it.
+//
+// GET /api/v1/podcasts/{id}
+func (UnimplementedHandler) GetPodcast(ctx context.Context, params GetPodcastParams) (r GetPodcastRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPodcastEpisode implements getPodcastEpisode operation.
+//
+// Get an episode with its chapters, transcripts and the user's listening state.
+//
+// GET /api/v1/podcasts/episodes/{id}
+func (UnimplementedHandler) GetPodcastEpisode(ctx context.Context, params GetPodcastEpisodeParams) (r GetPodcastEpisodeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPodcastEpisodeProgress implements getPodcastEpisodeProgress operation.
+//
+// Get the user's position in an episode and whether it is played.
+//
+// GET /api/v1/podcasts/episodes/{id}/progress
+func (UnimplementedHandler) GetPodcastEpisodeProgress(ctx context.Context, params GetPodcastEpisodeProgressParams) (r GetPodcastEpisodeProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPodcastEpisodeTranscript implements getPodcastEpisodeTranscript operation.
+//
+// Get the downloaded transcript of an episode in its original format.
+//
+// GET /api/v1/podcasts/episodes/{id}/transcript
+func (UnimplementedHandler) GetPodcastEpisodeTranscript(ctx context.Context, params GetPodcastEpisodeTranscriptParams) (r GetPodcastEpisodeTranscriptRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetProxiedImage implements getProxiedImage operation.
 //
 // Proxy images from TMDb image server. This caches images locally
 // and serves them to clients without exposing TMDb API keys.
+// Paths of the form `local-{artwork id}` refer to local artwork found
+// next to the media files; those are resized and cached the same way.
 //
 // GET /api/v1/images/{type}/{size}/{path}
 func (UnimplementedHandler) GetProxiedImage(ctx context.Context, params GetProxiedImageParams) (r GetProxiedImageRes, _ error) {
@@ -980,6 +1706,16 @@ func (UnimplementedHandler) GetTVShowSeasons(ctx context.Context, params GetTVSh
 	return r, ht.ErrNotImplemented
 }
 
+// GetTVShowTags implements getTVShowTags operation.
+//
+// Get keywords and tags for a TV show. Spoiler tags are only included when the user has enabled
+// show_spoilers in their preferences.
+//
+// GET /api/v1/tvshows/{id}/tags
+func (UnimplementedHandler) GetTVShowTags(ctx context.Context, params GetTVShowTagsParams) (r GetTVShowTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTVShowWatchStats implements getTVShowWatchStats operation.
 //
 // Get user's watch statistics for a specific TV show.
@@ -1121,6 +1857,17 @@ func (UnimplementedHandler) HandleSonarrWebhook(ctx context.Context, req *Sonarr
 	return r, ht.ErrNotImplemented
 }
 
+// HeartbeatPlaybackSession implements heartbeatPlaybackSession operation.
+//
+// Sends a heartbeat to keep the playback session alive and optionally
+// report the current playback position. Sessions expire after a timeout
+// if no heartbeat is received.
+//
+// POST /api/v1/playback/sessions/{sessionId}/heartbeat
+func (UnimplementedHandler) HeartbeatPlaybackSession(ctx context.Context, req OptHeartbeatPlaybackSessionReq, params HeartbeatPlaybackSessionParams) (r HeartbeatPlaybackSessionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // InitOIDCLink implements initOIDCLink operation.
 //
 // Initiates the flow to link an OIDC provider to the user's account.
@@ -1139,52 +1886,224 @@ func (UnimplementedHandler) ListAPIKeys(ctx context.Context) (r ListAPIKeysRes,
 	return r, ht.ErrNotImplemented
 }
 
-// ListGenres implements listGenres operation.
+// ListAudiobookAuthors implements listAudiobookAuthors operation.
 //
-// Returns all distinct genres across movies and TV shows with per-content-type item counts. Useful
-// for building genre filter UIs. Genres are identified by their TMDb ID and name.
+// Get a paginated list of authors sorted by sort name.
 //
-// GET /api/v1/genres
-func (UnimplementedHandler) ListGenres(ctx context.Context) (r ListGenresRes, _ error) {
+// GET /api/v1/audiobooks/authors
+func (UnimplementedHandler) ListAudiobookAuthors(ctx context.Context, params ListAudiobookAuthorsParams) (r ListAudiobookAuthorsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// ListLibraries implements listLibraries operation.
+// ListAudiobookSeries implements listAudiobookSeries operation.
 //
-// List all libraries the authenticated user can access. Admins see all libraries.
+// Get a paginated list of series sorted by sort name.
 //
-// GET /api/v1/libraries
-func (UnimplementedHandler) ListLibraries(ctx context.Context) (r ListLibrariesRes, _ error) {
+// GET /api/v1/audiobooks/series
+func (UnimplementedHandler) ListAudiobookSeries(ctx context.Context, params ListAudiobookSeriesParams) (r ListAudiobookSeriesRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// ListLibraryPermissions implements listLibraryPermissions operation.
+// ListAudiobooks implements listAudiobooks operation.
 //
-// List all user permissions for a library. Admin only.
+// Get a paginated list of audiobooks.
 //
-// GET /api/v1/libraries/{libraryId}/permissions
-func (UnimplementedHandler) ListLibraryPermissions(ctx context.Context, params ListLibraryPermissionsParams) (r ListLibraryPermissionsRes, _ error) {
+// GET /api/v1/audiobooks
+func (UnimplementedHandler) ListAudiobooks(ctx context.Context, params ListAudiobooksParams) (r ListAudiobooksRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// ListLibraryScans implements listLibraryScans operation.
+// ListBookAuthors implements listBookAuthors operation.
 //
-// List scan history for a library.
+// Get a paginated list of authors sorted by sort name.
 //
-// GET /api/v1/libraries/{libraryId}/scans
-func (UnimplementedHandler) ListLibraryScans(ctx context.Context, params ListLibraryScansParams) (r ListLibraryScansRes, _ error) {
+// GET /api/v1/books/authors
+func (UnimplementedHandler) ListBookAuthors(ctx context.Context, params ListBookAuthorsParams) (r ListBookAuthorsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// ListMetadataProviders implements listMetadataProviders operation.
+// ListBookSeries implements listBookSeries operation.
 //
-// Returns the list of registered metadata providers and their capabilities.
+// Get a paginated list of book series and comic runs sorted by sort name.
 //
-// GET /api/v1/metadata/providers
+// GET /api/v1/books/series
+func (UnimplementedHandler) ListBookSeries(ctx context.Context, params ListBookSeriesParams) (r ListBookSeriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListBooks implements listBooks operation.
+//
+// Get a paginated list of e-books and comics.
+//
+// GET /api/v1/books
+func (UnimplementedHandler) ListBooks(ctx context.Context, params ListBooksParams) (r ListBooksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListGenres implements listGenres operation.
+//
+// Returns all distinct genres across movies and TV shows with per-content-type item counts. Useful
+// for building genre filter UIs. Genres are identified by their TMDb ID and name.
+//
+// GET /api/v1/genres
+func (UnimplementedHandler) ListGenres(ctx context.Context) (r ListGenresRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListHomeVideoContinueWatching implements listHomeVideoContinueWatching operation.
+//
+// Get the home videos the user started but did not finish, last watched first.
+//
+// GET /api/v1/homevideos/continue-watching
+func (UnimplementedHandler) ListHomeVideoContinueWatching(ctx context.Context, params ListHomeVideoContinueWatchingParams) (r ListHomeVideoContinueWatchingRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListHomeVideoFolders implements listHomeVideoFolders operation.
+//
+// Get the subfolders of a folder, or the library roots of the home video
+// libraries the user can access when parent_id is omitted.
+//
+// GET /api/v1/homevideos/folders
+func (UnimplementedHandler) ListHomeVideoFolders(ctx context.Context, params ListHomeVideoFoldersParams) (r ListHomeVideoFoldersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListHomeVideos implements listHomeVideos operation.
+//
+// Get a paginated list of videos from the home video libraries the user
+// can access. Pass folder_id to list the videos directly in a folder.
+//
+// GET /api/v1/homevideos
+func (UnimplementedHandler) ListHomeVideos(ctx context.Context, params ListHomeVideosParams) (r ListHomeVideosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLegacyPerformers implements listLegacyPerformers operation.
+//
+// Get the performers of the scenes in the adult libraries the user can
+// access, by number of scenes.
+//
+// GET /api/v1/legacy/performers
+func (UnimplementedHandler) ListLegacyPerformers(ctx context.Context, params ListLegacyPerformersParams) (r ListLegacyPerformersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLegacyScenes implements listLegacyScenes operation.
+//
+// Get a paginated list of scenes from the adult libraries the user can
+// access, newest first.
+//
+// GET /api/v1/legacy/scenes
+func (UnimplementedHandler) ListLegacyScenes(ctx context.Context, params ListLegacyScenesParams) (r ListLegacyScenesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLegacyStudios implements listLegacyStudios operation.
+//
+// Get the studios of the scenes in the adult libraries the user can
+// access, by number of scenes.
+//
+// GET /api/v1/legacy/studios
+func (UnimplementedHandler) ListLegacyStudios(ctx context.Context, params ListLegacyStudiosParams) (r ListLegacyStudiosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLibraries implements listLibraries operation.
+//
+// List all libraries the authenticated user can access. Admins see all libraries.
+//
+// GET /api/v1/libraries
+func (UnimplementedHandler) ListLibraries(ctx context.Context) (r ListLibrariesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLibraryPermissions implements listLibraryPermissions operation.
+//
+// List all user permissions for a library. Admin only.
+//
+// GET /api/v1/libraries/{libraryId}/permissions
+func (UnimplementedHandler) ListLibraryPermissions(ctx context.Context, params ListLibraryPermissionsParams) (r ListLibraryPermissionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLibraryScans implements listLibraryScans operation.
+//
+// List scan history for a library.
+//
+// GET /api/v1/libraries/{libraryId}/scans
+func (UnimplementedHandler) ListLibraryScans(ctx context.Context, params ListLibraryScansParams) (r ListLibraryScansRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLiveTVChannels implements listLiveTVChannels operation.
+//
+// Get the live TV channels in playlist order. Disabled channels are
+// only listed for admins that ask for them.
+//
+// GET /api/v1/livetv/channels
+func (UnimplementedHandler) ListLiveTVChannels(ctx context.Context, params ListLiveTVChannelsParams) (r ListLiveTVChannelsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLiveTVRecordings implements listLiveTVRecordings operation.
+//
+// Get the user's recordings, newest airing first.
+//
+// GET /api/v1/livetv/recordings
+func (UnimplementedHandler) ListLiveTVRecordings(ctx context.Context, params ListLiveTVRecordingsParams) (r ListLiveTVRecordingsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLiveTVSeriesRules implements listLiveTVSeriesRules operation.
+//
+// Get the user's series rules.
+//
+// GET /api/v1/livetv/series-rules
+func (UnimplementedHandler) ListLiveTVSeriesRules(ctx context.Context) (r ListLiveTVSeriesRulesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLiveTVSources implements listLiveTVSources operation.
+//
+// Get the configured M3U channel lists and XMLTV guides. Admin only.
+//
+// GET /api/v1/livetv/sources
+func (UnimplementedHandler) ListLiveTVSources(ctx context.Context) (r ListLiveTVSourcesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMetadataProviders implements listMetadataProviders operation.
+//
+// Returns the list of registered metadata providers and their capabilities.
+//
+// GET /api/v1/metadata/providers
 func (UnimplementedHandler) ListMetadataProviders(ctx context.Context) (r ListMetadataProvidersRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// ListMovieArtwork implements listMovieArtwork operation.
+//
+// List the artwork candidates of a movie from all image providers and
+// local files. Candidates of each kind are ordered by preference for
+// the user's metadata language; the selected image is flagged.
+//
+// GET /api/v1/movies/{id}/artwork
+func (UnimplementedHandler) ListMovieArtwork(ctx context.Context, params ListMovieArtworkParams) (r ListMovieArtworkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMovieMetadataHistory implements listMovieMetadataHistory operation.
+//
+// List the recorded metadata changes of a movie, newest first. Each
+// version holds the changed fields with their old and new values, the
+// source of the change and, for manual edits, the acting user. Admin only.
+//
+// GET /api/v1/movies/{id}/metadata/history
+func (UnimplementedHandler) ListMovieMetadataHistory(ctx context.Context, params ListMovieMetadataHistoryParams) (r ListMovieMetadataHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListMovies implements listMovies operation.
 //
 // Get a paginated list of movies with optional filtering and sorting.
@@ -1194,6 +2113,63 @@ func (UnimplementedHandler) ListMovies(ctx context.Context, params ListMoviesPar
 	return r, ht.ErrNotImplemented
 }
 
+// ListMoviesByTag implements listMoviesByTag operation.
+//
+// Browse movies carrying a keyword or tag, ordered by rating. Parental controls and the user's
+// hidden tags apply.
+//
+// GET /api/v1/tags/{slug}/movies
+func (UnimplementedHandler) ListMoviesByTag(ctx context.Context, params ListMoviesByTagParams) (r ListMoviesByTagRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMusicAlbums implements listMusicAlbums operation.
+//
+// Get a paginated list of albums (releases).
+//
+// GET /api/v1/music/albums
+func (UnimplementedHandler) ListMusicAlbums(ctx context.Context, params ListMusicAlbumsParams) (r ListMusicAlbumsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMusicArtists implements listMusicArtists operation.
+//
+// Get a paginated list of artists sorted by sort name. With `album_artists`
+// only artists credited on an album are returned, hiding featured guests.
+//
+// GET /api/v1/music/artists
+func (UnimplementedHandler) ListMusicArtists(ctx context.Context, params ListMusicArtistsParams) (r ListMusicArtistsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMusicVideoArtists implements listMusicVideoArtists operation.
+//
+// Get the artists of the music video libraries the user can access, by sort name.
+//
+// GET /api/v1/musicvideos/artists
+func (UnimplementedHandler) ListMusicVideoArtists(ctx context.Context, params ListMusicVideoArtistsParams) (r ListMusicVideoArtistsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMusicVideos implements listMusicVideos operation.
+//
+// Get a paginated list of videos from the music video libraries the user
+// can access. Pass artist_id to list the videos of an artist.
+//
+// GET /api/v1/musicvideos
+func (UnimplementedHandler) ListMusicVideos(ctx context.Context, params ListMusicVideosParams) (r ListMusicVideosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListNewPodcastEpisodes implements listNewPodcastEpisodes operation.
+//
+// Get unplayed episodes of the user's subscriptions, newest first.
+//
+// GET /api/v1/podcasts/new
+func (UnimplementedHandler) ListNewPodcastEpisodes(ctx context.Context, params ListNewPodcastEpisodesParams) (r ListNewPodcastEpisodesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListOIDCProviders implements listOIDCProviders operation.
 //
 // Returns a list of enabled OIDC providers for login.
@@ -1212,6 +2188,62 @@ func (UnimplementedHandler) ListPermissions(ctx context.Context) (r ListPermissi
 	return r, ht.ErrNotImplemented
 }
 
+// ListPhotoAlbumPhotos implements listPhotoAlbumPhotos operation.
+//
+// Get the photos of an album in album order.
+//
+// GET /api/v1/photos/albums/{id}/photos
+func (UnimplementedHandler) ListPhotoAlbumPhotos(ctx context.Context, params ListPhotoAlbumPhotosParams) (r ListPhotoAlbumPhotosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPhotoAlbumShares implements listPhotoAlbumShares operation.
+//
+// Get the users a user album is shared with.
+//
+// GET /api/v1/photos/albums/{id}/shares
+func (UnimplementedHandler) ListPhotoAlbumShares(ctx context.Context, params ListPhotoAlbumSharesParams) (r ListPhotoAlbumSharesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPhotoAlbums implements listPhotoAlbums operation.
+//
+// Get the folder albums of the accessible photo libraries and the user
+// albums the user owns or that are shared with them.
+//
+// GET /api/v1/photos/albums
+func (UnimplementedHandler) ListPhotoAlbums(ctx context.Context, params ListPhotoAlbumsParams) (r ListPhotoAlbumsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPhotos implements listPhotos operation.
+//
+// Get a paginated list of photos and clips from the photo libraries the
+// user can access, ordered by capture time.
+//
+// GET /api/v1/photos
+func (UnimplementedHandler) ListPhotos(ctx context.Context, params ListPhotosParams) (r ListPhotosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPodcastEpisodes implements listPodcastEpisodes operation.
+//
+// Get a podcast's episodes, newest first, with the user's listening state.
+//
+// GET /api/v1/podcasts/{id}/episodes
+func (UnimplementedHandler) ListPodcastEpisodes(ctx context.Context, params ListPodcastEpisodesParams) (r ListPodcastEpisodesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPodcasts implements listPodcasts operation.
+//
+// Get a paginated list of podcasts sorted by title.
+//
+// GET /api/v1/podcasts
+func (UnimplementedHandler) ListPodcasts(ctx context.Context, params ListPodcastsParams) (r ListPodcastsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListPolicies implements listPolicies operation.
 //
 // Get all authorization policies (admin only).
@@ -1248,6 +2280,50 @@ func (UnimplementedHandler) ListSessions(ctx context.Context) (r ListSessionsRes
 	return r, ht.ErrNotImplemented
 }
 
+// ListTVEpisodeMetadataHistory implements listTVEpisodeMetadataHistory operation.
+//
+// List the recorded metadata changes of a episode, newest first. Each
+// version holds the changed fields with their old and new values, the
+// source of the change and, for manual edits, the acting user. Admin only.
+//
+// GET /api/v1/tvshows/episodes/{id}/metadata/history
+func (UnimplementedHandler) ListTVEpisodeMetadataHistory(ctx context.Context, params ListTVEpisodeMetadataHistoryParams) (r ListTVEpisodeMetadataHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTVSeasonMetadataHistory implements listTVSeasonMetadataHistory operation.
+//
+// List the recorded metadata changes of a season, newest first. Each
+// version holds the changed fields with their old and new values, the
+// source of the change and, for manual edits, the acting user. Admin only.
+//
+// GET /api/v1/tvshows/seasons/{id}/metadata/history
+func (UnimplementedHandler) ListTVSeasonMetadataHistory(ctx context.Context, params ListTVSeasonMetadataHistoryParams) (r ListTVSeasonMetadataHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTVShowArtwork implements listTVShowArtwork operation.
+//
+// List the artwork candidates of a series from all image providers and
+// local files. Candidates of each kind are ordered by preference for
+// the user's metadata language; the selected image is flagged.
+//
+// GET /api/v1/tvshows/{id}/artwork
+func (UnimplementedHandler) ListTVShowArtwork(ctx context.Context, params ListTVShowArtworkParams) (r ListTVShowArtworkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTVShowMetadataHistory implements listTVShowMetadataHistory operation.
+//
+// List the recorded metadata changes of a series, newest first. Each
+// version holds the changed fields with their old and new values, the
+// source of the change and, for manual edits, the acting user. Admin only.
+//
+// GET /api/v1/tvshows/{id}/metadata/history
+func (UnimplementedHandler) ListTVShowMetadataHistory(ctx context.Context, params ListTVShowMetadataHistoryParams) (r ListTVShowMetadataHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListTVShows implements listTVShows operation.
 //
 // Get a paginated list of TV shows with optional filtering and sorting.
@@ -1257,6 +2333,26 @@ func (UnimplementedHandler) ListTVShows(ctx context.Context, params ListTVShowsP
 	return r, ht.ErrNotImplemented
 }
 
+// ListTVShowsByTag implements listTVShowsByTag operation.
+//
+// Browse TV shows carrying a keyword or tag, ordered by popularity. Parental controls and the user's
+// hidden tags apply.
+//
+// GET /api/v1/tags/{slug}/tvshows
+func (UnimplementedHandler) ListTVShowsByTag(ctx context.Context, params ListTVShowsByTagParams) (r ListTVShowsByTagRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTags implements listTags operation.
+//
+// Returns the most used keywords and tags across movies and TV shows with per-content-type item
+// counts. Spoiler tags are never listed.
+//
+// GET /api/v1/tags
+func (UnimplementedHandler) ListTags(ctx context.Context, params ListTagsParams) (r ListTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListUserOIDCLinks implements listUserOIDCLinks operation.
 //
 // Returns all OIDC providers linked to the current user.
@@ -1284,6 +2380,15 @@ func (UnimplementedHandler) ListWebAuthnCredentials(ctx context.Context) (r List
 	return r, ht.ErrNotImplemented
 }
 
+// LockLegacy implements lockLegacy operation.
+//
+// Lock QAR content before the unlock expires.
+//
+// POST /api/v1/legacy/lock
+func (UnimplementedHandler) LockLegacy(ctx context.Context) (r LockLegacyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Login implements login operation.
 //
 // Authenticate user and return access token and refresh token.
@@ -1367,6 +2472,15 @@ func (UnimplementedHandler) OidcCallback(ctx context.Context, params OidcCallbac
 	return r, ht.ErrNotImplemented
 }
 
+// RefreshLiveTVSource implements refreshLiveTVSource operation.
+//
+// Queue a reload of a source. Admin only.
+//
+// POST /api/v1/livetv/sources/{id}/refresh
+func (UnimplementedHandler) RefreshLiveTVSource(ctx context.Context, params RefreshLiveTVSourceParams) (r RefreshLiveTVSourceRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RefreshMovieMetadata implements refreshMovieMetadata operation.
 //
 // Trigger a metadata refresh from TMDb/Radarr.
@@ -1376,6 +2490,25 @@ func (UnimplementedHandler) RefreshMovieMetadata(ctx context.Context, params Ref
 	return r, ht.ErrNotImplemented
 }
 
+// RefreshPerson implements refreshPerson operation.
+//
+// Queue a job that fetches the person's biography, translations and
+// profile images from the metadata providers. Admin only.
+//
+// POST /api/v1/people/{personId}/refresh
+func (UnimplementedHandler) RefreshPerson(ctx context.Context, params RefreshPersonParams) (r RefreshPersonRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RefreshPodcast implements refreshPodcast operation.
+//
+// Queue a refresh of the podcast's feed.
+//
+// POST /api/v1/podcasts/{id}/refresh
+func (UnimplementedHandler) RefreshPodcast(ctx context.Context, params RefreshPodcastParams) (r RefreshPodcastRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RefreshSession implements refreshSession operation.
 //
 // Refresh access token using refresh token.
@@ -1441,6 +2574,15 @@ func (UnimplementedHandler) ReindexTVShowSearch(ctx context.Context) (r ReindexT
 	return r, ht.ErrNotImplemented
 }
 
+// RemovePhotoAlbumPhoto implements removePhotoAlbumPhoto operation.
+//
+// Remove a photo from a user album. The photo stays in the library.
+//
+// DELETE /api/v1/photos/albums/{id}/photos/{photoId}
+func (UnimplementedHandler) RemovePhotoAlbumPhoto(ctx context.Context, params RemovePhotoAlbumPhotoParams) (r RemovePhotoAlbumPhotoRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RemovePolicy implements removePolicy operation.
 //
 // Remove an authorization policy (admin only).
@@ -1513,6 +2655,62 @@ func (UnimplementedHandler) RevokeSession(ctx context.Context, params RevokeSess
 	return r, ht.ErrNotImplemented
 }
 
+// RollbackMovieMetadata implements rollbackMovieMetadata operation.
+//
+// Restore the metadata fields changed since a version to their values at
+// that version. Version 0 is the state before the first recorded change.
+// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+// sync keep them. Fields cannot be cleared, so a rollback to a version
+// where a field that is set now was empty is rejected with the fields
+// listed. The rollback is recorded as a new version. Admin only.
+//
+// POST /api/v1/movies/{id}/metadata/history/{version}/rollback
+func (UnimplementedHandler) RollbackMovieMetadata(ctx context.Context, params RollbackMovieMetadataParams) (r RollbackMovieMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RollbackTVEpisodeMetadata implements rollbackTVEpisodeMetadata operation.
+//
+// Restore the metadata fields changed since a version to their values at
+// that version. Version 0 is the state before the first recorded change.
+// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+// sync keep them. Fields cannot be cleared, so a rollback to a version
+// where a field that is set now was empty is rejected with the fields
+// listed. The rollback is recorded as a new version. Admin only.
+//
+// POST /api/v1/tvshows/episodes/{id}/metadata/history/{version}/rollback
+func (UnimplementedHandler) RollbackTVEpisodeMetadata(ctx context.Context, params RollbackTVEpisodeMetadataParams) (r RollbackTVEpisodeMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RollbackTVSeasonMetadata implements rollbackTVSeasonMetadata operation.
+//
+// Restore the metadata fields changed since a version to their values at
+// that version. Version 0 is the state before the first recorded change.
+// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+// sync keep them. Fields cannot be cleared, so a rollback to a version
+// where a field that is set now was empty is rejected with the fields
+// listed. The rollback is recorded as a new version. Admin only.
+//
+// POST /api/v1/tvshows/seasons/{id}/metadata/history/{version}/rollback
+func (UnimplementedHandler) RollbackTVSeasonMetadata(ctx context.Context, params RollbackTVSeasonMetadataParams) (r RollbackTVSeasonMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RollbackTVShowMetadata implements rollbackTVShowMetadata operation.
+//
+// Restore the metadata fields changed since a version to their values at
+// that version. Version 0 is the state before the first recorded change.
+// Restored fields are locked so metadata refreshes and the Radarr/Sonarr
+// sync keep them. Fields cannot be cleared, so a rollback to a version
+// where a field that is set now was empty is rejected with the fields
+// listed. The rollback is recorded as a new version. Admin only.
+//
+// POST /api/v1/tvshows/{id}/metadata/history/{version}/rollback
+func (UnimplementedHandler) RollbackTVShowMetadata(ctx context.Context, params RollbackTVShowMetadataParams) (r RollbackTVShowMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SearchActivityLogs implements searchActivityLogs operation.
 //
 // Search and filter activity logs with pagination.
@@ -1522,6 +2720,16 @@ func (UnimplementedHandler) SearchActivityLogs(ctx context.Context, params Searc
 	return r, ht.ErrNotImplemented
 }
 
+// SearchLibraryHomeVideos implements searchLibraryHomeVideos operation.
+//
+// Full-text search across the home video libraries the user can access
+// using Typesense. Matches titles, descriptions and folder names.
+//
+// GET /api/v1/search/homevideos
+func (UnimplementedHandler) SearchLibraryHomeVideos(ctx context.Context, params SearchLibraryHomeVideosParams) (r SearchLibraryHomeVideosRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SearchLibraryMovies implements searchLibraryMovies operation.
 //
 // Full-text search across the movie library using Typesense.
@@ -1533,6 +2741,16 @@ func (UnimplementedHandler) SearchLibraryMovies(ctx context.Context, params Sear
 	return r, ht.ErrNotImplemented
 }
 
+// SearchLibraryMusic implements searchLibraryMusic operation.
+//
+// Full-text search across music albums using Typesense. Matches album
+// titles, artists and track titles. Returns faceted results for filtering.
+//
+// GET /api/v1/search/music
+func (UnimplementedHandler) SearchLibraryMusic(ctx context.Context, params SearchLibraryMusicParams) (r SearchLibraryMusicRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SearchLibraryTVShows implements searchLibraryTVShows operation.
 //
 // Full-text search across the TV show library using Typesense.
@@ -1566,7 +2784,7 @@ func (UnimplementedHandler) SearchMoviesMetadata(ctx context.Context, params Sea
 
 // SearchMulti implements searchMulti operation.
 //
-// Unified search endpoint that queries movies, TV shows, episodes, seasons, and people
+// Unified search endpoint that queries movies, TV shows, episodes, seasons, people, and music albums
 // in parallel, returning the top results from each collection. Designed for global search bars.
 //
 // GET /api/v1/search/multi
@@ -1604,6 +2822,38 @@ func (UnimplementedHandler) SearchTVShowsMetadata(ctx context.Context, params Se
 	return r, ht.ErrNotImplemented
 }
 
+// SelectMovieArtwork implements selectMovieArtwork operation.
+//
+// Make an artwork candidate the image used for its kind. Automatic
+// selection keeps the choice; a chosen poster or backdrop is also
+// locked on the movie. Admin only.
+//
+// POST /api/v1/movies/{id}/artwork/{artworkId}/select
+func (UnimplementedHandler) SelectMovieArtwork(ctx context.Context, params SelectMovieArtworkParams) (r SelectMovieArtworkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SelectTVShowArtwork implements selectTVShowArtwork operation.
+//
+// Make an artwork candidate the image used for its kind. Automatic
+// selection keeps the choice; a chosen poster or backdrop is also
+// locked on the series. Admin only.
+//
+// POST /api/v1/tvshows/{id}/artwork/{artworkId}/select
+func (UnimplementedHandler) SelectTVShowArtwork(ctx context.Context, params SelectTVShowArtworkParams) (r SelectTVShowArtworkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetLegacyPIN implements setLegacyPIN operation.
+//
+// Set the PIN that unlocks QAR content. Changing an existing PIN requires
+// the current one. Setting a PIN locks the content.
+//
+// PUT /api/v1/legacy/pin
+func (UnimplementedHandler) SetLegacyPIN(ctx context.Context, req *LegacyPINRequest) (r SetLegacyPINRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SetupTOTP implements setupTOTP operation.
 //
 // Generate TOTP secret and QR code for enrollment.
@@ -1613,6 +2863,16 @@ func (UnimplementedHandler) SetupTOTP(ctx context.Context, req *SetupTOTPReq) (r
 	return r, ht.ErrNotImplemented
 }
 
+// SharePhotoAlbum implements sharePhotoAlbum operation.
+//
+// Share a user album with a user who can access its library, or change
+// whether they may add and remove photos. Only the owner may share.
+//
+// PUT /api/v1/photos/albums/{id}/shares/{userId}
+func (UnimplementedHandler) SharePhotoAlbum(ctx context.Context, req *PhotoAlbumShareRequest, params SharePhotoAlbumParams) (r SharePhotoAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // StartPlaybackSession implements startPlaybackSession operation.
 //
 // Creates a new HLS playback session for a movie or episode.
@@ -1636,6 +2896,27 @@ func (UnimplementedHandler) StopPlaybackSession(ctx context.Context, params Stop
 	return r, ht.ErrNotImplemented
 }
 
+// SubscribePodcast implements subscribePodcast operation.
+//
+// Subscribe to a podcast that is already in the library.
+//
+// PUT /api/v1/podcasts/{id}/subscription
+func (UnimplementedHandler) SubscribePodcast(ctx context.Context, params SubscribePodcastParams) (r SubscribePodcastRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SubscribePodcastFeed implements subscribePodcastFeed operation.
+//
+// Subscribe to an RSS or Atom feed by URL. A feed that is not known yet
+// is fetched and added to the given podcast library, or to the first
+// podcast library the user can access. Feeds are shared: subscribing to
+// a feed someone else added subscribes to the existing podcast.
+//
+// POST /api/v1/podcasts
+func (UnimplementedHandler) SubscribePodcastFeed(ctx context.Context, req *PodcastSubscribeRequest) (r SubscribePodcastFeedRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // TriggerLibraryScan implements triggerLibraryScan operation.
 //
 // Start a library scan job. Admin only.
@@ -1654,6 +2935,109 @@ func (UnimplementedHandler) UnlinkOIDCProvider(ctx context.Context, params Unlin
 	return r, ht.ErrNotImplemented
 }
 
+// UnlockLegacy implements unlockLegacy operation.
+//
+// Unlock QAR content with the user's PIN for 30 minutes.
+//
+// POST /api/v1/legacy/unlock
+func (UnimplementedHandler) UnlockLegacy(ctx context.Context, req *LegacyUnlockRequest) (r UnlockLegacyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockMovieMetadataField implements unlockMovieMetadataField operation.
+//
+// Clear the lock on a manually edited field so the next metadata
+// refresh may update it again. The current value is kept. Admin only.
+//
+// DELETE /api/v1/movies/{id}/metadata/locks/{field}
+func (UnimplementedHandler) UnlockMovieMetadataField(ctx context.Context, params UnlockMovieMetadataFieldParams) (r UnlockMovieMetadataFieldRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockParentalControls implements unlockParentalControls operation.
+//
+// Lifts the content restrictions of the authenticated user for one hour
+// when the override PIN set by an admin matches.
+//
+// POST /api/v1/users/me/parental-controls/unlock
+func (UnimplementedHandler) UnlockParentalControls(ctx context.Context, req *ParentalControlsUnlockRequest) (r UnlockParentalControlsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockTVEpisodeMetadataField implements unlockTVEpisodeMetadataField operation.
+//
+// Clear the lock on a manually edited field so the next metadata
+// refresh may update it again. The current value is kept. Admin only.
+//
+// DELETE /api/v1/tvshows/episodes/{id}/metadata/locks/{field}
+func (UnimplementedHandler) UnlockTVEpisodeMetadataField(ctx context.Context, params UnlockTVEpisodeMetadataFieldParams) (r UnlockTVEpisodeMetadataFieldRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockTVSeasonMetadataField implements unlockTVSeasonMetadataField operation.
+//
+// Clear the lock on a manually edited field so the next metadata
+// refresh may update it again. The current value is kept. Admin only.
+//
+// DELETE /api/v1/tvshows/seasons/{id}/metadata/locks/{field}
+func (UnimplementedHandler) UnlockTVSeasonMetadataField(ctx context.Context, params UnlockTVSeasonMetadataFieldParams) (r UnlockTVSeasonMetadataFieldRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockTVShowMetadataField implements unlockTVShowMetadataField operation.
+//
+// Clear the lock on a manually edited field so the next metadata
+// refresh may update it again. The current value is kept. Admin only.
+//
+// DELETE /api/v1/tvshows/{id}/metadata/locks/{field}
+func (UnimplementedHandler) UnlockTVShowMetadataField(ctx context.Context, params UnlockTVShowMetadataFieldParams) (r UnlockTVShowMetadataFieldRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnsharePhotoAlbum implements unsharePhotoAlbum operation.
+//
+// Remove a share. The owner may remove any share; users may leave albums
+// shared with them.
+//
+// DELETE /api/v1/photos/albums/{id}/shares/{userId}
+func (UnimplementedHandler) UnsharePhotoAlbum(ctx context.Context, params UnsharePhotoAlbumParams) (r UnsharePhotoAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnsubscribePodcast implements unsubscribePodcast operation.
+//
+// Remove the user's subscription. The podcast and its downloads stay in
+// the library; feeds without subscribers are no longer refreshed.
+//
+// DELETE /api/v1/podcasts/{id}/subscription
+func (UnimplementedHandler) UnsubscribePodcast(ctx context.Context, params UnsubscribePodcastParams) (r UnsubscribePodcastRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateAudiobookProgress implements updateAudiobookProgress operation.
+//
+// Report the playback position in an audiobook. The position is on the
+// book timeline, or within a file when `file_id` is given. The book is
+// marked finished once less than two minutes of listening are left at
+// the reported playback speed.
+//
+// POST /api/v1/audiobooks/{id}/progress
+func (UnimplementedHandler) UpdateAudiobookProgress(ctx context.Context, req *AudiobookProgressUpdate, params UpdateAudiobookProgressParams) (r UpdateAudiobookProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateBookProgress implements updateBookProgress operation.
+//
+// Report the reading position in a book. Paged readers send the current
+// `page`, from which the percentage is derived. Reflowable EPUB readers
+// send a `locator` (such as an EPUB CFI) with the `progress_percent` they
+// computed. The book is marked finished on its last page or from 99%.
+//
+// POST /api/v1/books/{id}/progress
+func (UnimplementedHandler) UpdateBookProgress(ctx context.Context, req *BookProgressUpdate, params UpdateBookProgressParams) (r UpdateBookProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateCurrentUser implements updateCurrentUser operation.
 //
 // Update the authenticated user's profile.
@@ -1663,6 +3047,16 @@ func (UnimplementedHandler) UpdateCurrentUser(ctx context.Context, req *UserUpda
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateHomeVideoProgress implements updateHomeVideoProgress operation.
+//
+// Record the user's playback position. Like movies, a video counts as
+// watched past 90%.
+//
+// POST /api/v1/homevideos/{id}/progress
+func (UnimplementedHandler) UpdateHomeVideoProgress(ctx context.Context, req *HomeVideoProgressUpdate, params UpdateHomeVideoProgressParams) (r UpdateHomeVideoProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateLibrary implements updateLibrary operation.
 //
 // Update library settings. Admin only.
@@ -1672,6 +3066,56 @@ func (UnimplementedHandler) UpdateLibrary(ctx context.Context, req *UpdateLibrar
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateLiveTVChannel implements updateLiveTVChannel operation.
+//
+// Enable or disable a channel. Disabled channels are hidden and can't be watched. Admin only.
+//
+// PATCH /api/v1/livetv/channels/{id}
+func (UnimplementedHandler) UpdateLiveTVChannel(ctx context.Context, req *LiveTVChannelUpdate, params UpdateLiveTVChannelParams) (r UpdateLiveTVChannelRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateMovieMetadata implements updateMovieMetadata operation.
+//
+// Manually correct movie metadata. Only the fields present in the
+// body are changed, and each one is locked so metadata refreshes and
+// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+//
+// PATCH /api/v1/movies/{id}/metadata
+func (UnimplementedHandler) UpdateMovieMetadata(ctx context.Context, req *MovieMetadataEdit, params UpdateMovieMetadataParams) (r UpdateMovieMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdatePhotoAlbum implements updatePhotoAlbum operation.
+//
+// Edit a user album. Only the owner may edit it.
+//
+// PATCH /api/v1/photos/albums/{id}
+func (UnimplementedHandler) UpdatePhotoAlbum(ctx context.Context, req *PhotoAlbumUpdate, params UpdatePhotoAlbumParams) (r UpdatePhotoAlbumRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdatePodcastEpisodeProgress implements updatePodcastEpisodeProgress operation.
+//
+// Report the playback position in an episode. The episode is marked
+// played once less than a minute is left, unless `played` is given to
+// mark it played or unplayed explicitly.
+//
+// POST /api/v1/podcasts/episodes/{id}/progress
+func (UnimplementedHandler) UpdatePodcastEpisodeProgress(ctx context.Context, req *PodcastEpisodeProgressUpdate, params UpdatePodcastEpisodeProgressParams) (r UpdatePodcastEpisodeProgressRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdatePodcastSettings implements updatePodcastSettings operation.
+//
+// Change whether new episodes are downloaded and how many of the newest
+// episodes are kept. Settings are shared by all subscribers. Admin only.
+//
+// PATCH /api/v1/podcasts/{id}
+func (UnimplementedHandler) UpdatePodcastSettings(ctx context.Context, req *PodcastSettingsUpdate, params UpdatePodcastSettingsParams) (r UpdatePodcastSettingsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateRolePermissions implements updateRolePermissions operation.
 //
 // Update all permissions for a role (admin only).
@@ -1690,6 +3134,17 @@ func (UnimplementedHandler) UpdateServerSetting(ctx context.Context, req *Settin
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateTVEpisodeMetadata implements updateTVEpisodeMetadata operation.
+//
+// Manually correct episode metadata. Only the fields present in the
+// body are changed, and each one is locked so metadata refreshes and
+// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+//
+// PATCH /api/v1/tvshows/episodes/{id}/metadata
+func (UnimplementedHandler) UpdateTVEpisodeMetadata(ctx context.Context, req *TVEpisodeMetadataEdit, params UpdateTVEpisodeMetadataParams) (r UpdateTVEpisodeMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateTVEpisodeProgress implements updateTVEpisodeProgress operation.
 //
 // Update user's watch progress for an episode.
@@ -1699,6 +3154,28 @@ func (UnimplementedHandler) UpdateTVEpisodeProgress(ctx context.Context, req *Up
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateTVSeasonMetadata implements updateTVSeasonMetadata operation.
+//
+// Manually correct season metadata. Only the fields present in the
+// body are changed, and each one is locked so metadata refreshes and
+// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+//
+// PATCH /api/v1/tvshows/seasons/{id}/metadata
+func (UnimplementedHandler) UpdateTVSeasonMetadata(ctx context.Context, req *TVSeasonMetadataEdit, params UpdateTVSeasonMetadataParams) (r UpdateTVSeasonMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateTVShowMetadata implements updateTVShowMetadata operation.
+//
+// Manually correct TV show metadata. Only the fields present in the
+// body are changed, and each one is locked so metadata refreshes and
+// the Radarr/Sonarr sync no longer overwrite it. Admin only.
+//
+// PATCH /api/v1/tvshows/{id}/metadata
+func (UnimplementedHandler) UpdateTVShowMetadata(ctx context.Context, req *TVSeriesMetadataEdit, params UpdateTVShowMetadataParams) (r UpdateTVShowMetadataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateUserPreferences implements updateUserPreferences operation.
 //
 // Update notification and display preferences.
@@ -0,0 +1,213 @@
+package opds
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Namespaces of the OPDS 1.2 Atom feeds.
+const (
+	atomNS       = "http://www.w3.org/2005/Atom"
+	dcNS         = "http://purl.org/dc/terms/"
+	opdsNS       = "http://opds-spec.org/2010/catalog"
+	openSearchNS = "http://a9.com/-/spec/opensearch/1.1/"
+	pseNS        = "http://vaemendis.net/opds-pse/ns"
+)
+
+type atomFeed struct {
+	XMLName      xml.Name    `xml:"feed"`
+	Xmlns        string      `xml:"xmlns,attr"`
+	XmlnsDC      string      `xml:"xmlns:dc,attr"`
+	XmlnsOPDS    string      `xml:"xmlns:opds,attr"`
+	XmlnsOS      string      `xml:"xmlns:opensearch,attr"`
+	XmlnsPSE     string      `xml:"xmlns:pse,attr"`
+	ID           string      `xml:"id"`
+	Title        string      `xml:"title"`
+	Updated      string      `xml:"updated"`
+	TotalResults *int64      `xml:"opensearch:totalResults"`
+	ItemsPerPage *int        `xml:"opensearch:itemsPerPage"`
+	StartIndex   *int        `xml:"opensearch:startIndex"`
+	Links        []atomLink  `xml:"link"`
+	Entries      []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel   string `xml:"rel,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+	Type  string `xml:"type,attr,omitempty"`
+	Title string `xml:"title,attr,omitempty"`
+	Count int    `xml:"pse:count,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Authors    []atomAuthor   `xml:"author"`
+	Language   string         `xml:"dc:language,omitempty"`
+	Publisher  string         `xml:"dc:publisher,omitempty"`
+	Issued     string         `xml:"dc:issued,omitempty"`
+	Identifier string         `xml:"dc:identifier,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    *atomText      `xml:"summary"`
+	Content    *atomText      `xml:"content"`
+	Links      []atomLink     `xml:"link"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+// WriteAtom encodes the feed as an OPDS 1.2 Atom document.
+func WriteAtom(w io.Writer, f *Feed) error {
+	feed := atomFeed{
+		Xmlns:     atomNS,
+		XmlnsDC:   dcNS,
+		XmlnsOPDS: opdsNS,
+		XmlnsOS:   openSearchNS,
+		XmlnsPSE:  pseNS,
+		ID:        f.ID,
+		Title:     f.Title,
+		Updated:   atomTime(f.Updated),
+		Links: []atomLink{
+			{Rel: "self", Href: f.Self, Type: f.atomType()},
+			{Rel: "start", Href: f.Start, Type: NavigationType},
+		},
+	}
+	if f.Search != "" {
+		feed.Links = append(feed.Links, atomLink{Rel: "search", Href: f.Search, Type: OpenSearchType})
+	}
+	if p := f.Pagination; p != nil {
+		start := p.StartIndex()
+		feed.TotalResults, feed.ItemsPerPage, feed.StartIndex = &p.Total, &p.PerPage, &start
+		if p.Next != "" {
+			feed.Links = append(feed.Links, atomLink{Rel: "next", Href: p.Next, Type: f.atomType()})
+		}
+		if p.Previous != "" {
+			feed.Links = append(feed.Links, atomLink{Rel: "previous", Href: p.Previous, Type: f.atomType()})
+		}
+	}
+
+	for _, n := range f.Navigation {
+		entry := atomEntry{
+			ID:      n.ID,
+			Title:   n.Title,
+			Updated: feed.Updated,
+			Links:   []atomLink{{Rel: "subsection", Href: n.Href, Type: NavigationType}},
+		}
+		if n.Acquisition {
+			entry.Links[0].Type = AcquisitionType
+		}
+		if n.Summary != "" {
+			entry.Content = &atomText{Type: "text", Text: n.Summary}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	for i := range f.Publications {
+		feed.Entries = append(feed.Entries, publicationToAtom(&f.Publications[i]))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(feed)
+}
+
+func publicationToAtom(p *Publication) atomEntry {
+	entry := atomEntry{
+		ID:        p.ID,
+		Title:     p.Title,
+		Updated:   atomTime(p.Updated),
+		Language:  p.Language,
+		Publisher: p.Publisher,
+		Links: []atomLink{
+			{Rel: relAcquisition, Href: p.Acquisition.Href, Type: p.Acquisition.Type},
+		},
+	}
+	for _, name := range p.Authors {
+		entry.Authors = append(entry.Authors, atomAuthor{Name: name})
+	}
+	if p.Year > 0 {
+		entry.Issued = strconv.Itoa(p.Year)
+	}
+	if p.ISBN != "" {
+		entry.Identifier = "urn:isbn:" + p.ISBN
+	}
+	for _, subject := range p.Subjects {
+		entry.Categories = append(entry.Categories, atomCategory{Term: subject, Label: subject})
+	}
+	if p.Summary != "" {
+		entry.Summary = &atomText{Type: "text", Text: p.Summary}
+	}
+	if p.Cover != nil {
+		entry.Links = append(entry.Links, atomLink{Rel: relImage, Href: p.Cover.Href, Type: p.Cover.Type})
+	}
+	if p.Thumbnail != nil {
+		entry.Links = append(entry.Links, atomLink{Rel: relThumbnail, Href: p.Thumbnail.Href, Type: p.Thumbnail.Type})
+	}
+	if p.PageStream != nil {
+		entry.Links = append(entry.Links, atomLink{
+			Rel:   relPageStream,
+			Href:  p.PageStream.Href,
+			Type:  p.PageStream.Type,
+			Count: p.PageCount,
+		})
+	}
+	return entry
+}
+
+func (f *Feed) atomType() string {
+	if f.Acquisition {
+		return AcquisitionType
+	}
+	return NavigationType
+}
+
+func atomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+type openSearchDescription struct {
+	XMLName     xml.Name      `xml:"OpenSearchDescription"`
+	Xmlns       string        `xml:"xmlns,attr"`
+	ShortName   string        `xml:"ShortName"`
+	Description string        `xml:"Description"`
+	URL         openSearchURL `xml:"Url"`
+}
+
+type openSearchURL struct {
+	Type     string `xml:"type,attr"`
+	Template string `xml:"template,attr"`
+}
+
+// WriteOpenSearch encodes the OpenSearch description that tells Atom
+// clients how to search the catalog. The template contains the
+// {searchTerms} placeholder.
+func WriteOpenSearch(w io.Writer, name, template string) error {
+	doc := openSearchDescription{
+		Xmlns:       openSearchNS,
+		ShortName:   name,
+		Description: "Search " + name,
+		URL:         openSearchURL{Type: AcquisitionType, Template: template},
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(doc)
+}
@@ -0,0 +1,87 @@
+// Package opds serves the book and comic libraries as OPDS catalogs, so
+// e-reader apps can browse, search and download books. OPDS 1.2 Atom feeds
+// are served under /api/v1/opds and OPDS 2.0 JSON feeds under
+// /api/v1/opds/v2. Comics also advertise OPDS Page Streaming (PSE) links.
+package opds
+
+import "time"
+
+// Media types of the catalog documents.
+const (
+	NavigationType  = "application/atom+xml;profile=opds-catalog;kind=navigation"
+	AcquisitionType = "application/atom+xml;profile=opds-catalog;kind=acquisition"
+	JSONType        = "application/opds+json"
+	OpenSearchType  = "application/opensearchdescription+xml"
+)
+
+// Link relations used by the feeds.
+const (
+	relAcquisition = "http://opds-spec.org/acquisition"
+	relImage       = "http://opds-spec.org/image"
+	relThumbnail   = "http://opds-spec.org/image/thumbnail"
+	relPageStream  = "http://vaemendis.net/opds-pse/stream"
+)
+
+// Feed is one catalog page. Navigation feeds lead to other feeds;
+// acquisition feeds list publications.
+type Feed struct {
+	ID           string
+	Title        string
+	Updated      time.Time
+	Self         string
+	Start        string
+	Search       string // OpenSearch description (Atom) or search template (JSON)
+	Acquisition  bool
+	Navigation   []Navigation
+	Publications []Publication
+	Pagination   *Pagination
+}
+
+// Navigation is an entry leading to another feed.
+type Navigation struct {
+	ID          string
+	Title       string
+	Summary     string
+	Href        string
+	Acquisition bool // the target feed lists publications
+}
+
+// Publication is a downloadable book.
+type Publication struct {
+	ID          string
+	Title       string
+	Authors     []string
+	Summary     string
+	Language    string
+	Publisher   string
+	Year        int
+	ISBN        string
+	Subjects    []string
+	PageCount   int
+	Updated     time.Time
+	Acquisition Link
+	Cover       *Link
+	Thumbnail   *Link
+	PageStream  *Link // PSE template with a {pageNumber} placeholder
+}
+
+// Link is a typed reference to a resource.
+type Link struct {
+	Href string
+	Type string
+}
+
+// Pagination describes the position of a feed page in a longer list.
+// Pages are numbered from 1.
+type Pagination struct {
+	Page     int
+	PerPage  int
+	Total    int64
+	Next     string
+	Previous string
+}
+
+// StartIndex returns the 1-based index of the first item on the page.
+func (p *Pagination) StartIndex() int {
+	return (p.Page-1)*p.PerPage + 1
+}
@@ -0,0 +1,155 @@
+package opds
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFeed() *Feed {
+	updated := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	return &Feed{
+		ID:          "urn:revenge:opds:books:comic",
+		Title:       "Comics",
+		Updated:     updated,
+		Self:        "/api/v1/opds/books?kind=comic&page=2",
+		Start:       "/api/v1/opds",
+		Search:      "/api/v1/opds/search.xml",
+		Acquisition: true,
+		Publications: []Publication{{
+			ID:          "urn:uuid:0b9c1c5e-0000-4000-8000-000000000001",
+			Title:       "Saga #1",
+			Authors:     []string{"Brian K. Vaughan"},
+			Summary:     "Star-crossed lovers & a war",
+			Language:    "en",
+			Year:        2012,
+			ISBN:        "9781607066019",
+			Subjects:    []string{"Science Fiction"},
+			PageCount:   24,
+			Updated:     updated,
+			Acquisition: Link{Href: "/api/v1/opds/books/1/file", Type: "application/vnd.comicbook+zip"},
+			Cover:       &Link{Href: "/api/v1/opds/books/1/cover"},
+			Thumbnail:   &Link{Href: "/api/v1/opds/books/1/cover"},
+			PageStream:  &Link{Href: "/api/v1/opds/books/1/pages/{pageNumber}", Type: "image/jpeg"},
+		}},
+		Pagination: &Pagination{Page: 2, PerPage: 50, Total: 120, Next: "/api/v1/opds/books?kind=comic&page=3", Previous: "/api/v1/opds/books?kind=comic"},
+	}
+}
+
+func TestWriteAtom_Acquisition(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteAtom(&buf, testFeed()))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, xml.Header))
+	assert.Contains(t, out, `<feed xmlns="http://www.w3.org/2005/Atom"`)
+	assert.Contains(t, out, `xmlns:pse="http://vaemendis.net/opds-pse/ns"`)
+	assert.Contains(t, out, `<updated>2026-03-01T12:00:00Z</updated>`)
+	assert.Contains(t, out, `<opensearch:totalResults>120</opensearch:totalResults>`)
+	assert.Contains(t, out, `<opensearch:startIndex>51</opensearch:startIndex>`)
+	assert.Contains(t, out, `<link rel="self" href="/api/v1/opds/books?kind=comic&amp;page=2" type="`+AcquisitionType+`"></link>`)
+	assert.Contains(t, out, `<link rel="next" href="/api/v1/opds/books?kind=comic&amp;page=3"`)
+	assert.Contains(t, out, `<link rel="search" href="/api/v1/opds/search.xml" type="`+OpenSearchType+`"></link>`)
+	assert.Contains(t, out, `<author>`+"\n"+`      <name>Brian K. Vaughan</name>`)
+	assert.Contains(t, out, `<dc:issued>2012</dc:issued>`)
+	assert.Contains(t, out, `<dc:identifier>urn:isbn:9781607066019</dc:identifier>`)
+	assert.Contains(t, out, `<summary type="text">Star-crossed lovers &amp; a war</summary>`)
+	assert.Contains(t, out, `<link rel="http://opds-spec.org/acquisition" href="/api/v1/opds/books/1/file" type="application/vnd.comicbook+zip"></link>`)
+	assert.Contains(t, out, `<link rel="http://opds-spec.org/image" href="/api/v1/opds/books/1/cover"></link>`)
+	assert.Contains(t, out, `<link rel="http://vaemendis.net/opds-pse/stream" href="/api/v1/opds/books/1/pages/{pageNumber}" type="image/jpeg" pse:count="24"></link>`)
+
+	// The document must stay well-formed
+	var parsed struct {
+		Entries []struct {
+			Title string `xml:"title"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &parsed))
+	require.Len(t, parsed.Entries, 1)
+	assert.Equal(t, "Saga #1", parsed.Entries[0].Title)
+}
+
+func TestWriteAtom_Navigation(t *testing.T) {
+	f := &Feed{
+		ID:    "urn:revenge:opds",
+		Title: "Revenge",
+		Self:  "/api/v1/opds",
+		Start: "/api/v1/opds",
+		Navigation: []Navigation{
+			{ID: "urn:revenge:opds:authors", Title: "Authors", Summary: "Books by author", Href: "/api/v1/opds/authors"},
+			{ID: "urn:revenge:opds:comics", Title: "Comics", Href: "/api/v1/opds/books?kind=comic", Acquisition: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteAtom(&buf, f))
+	out := buf.String()
+
+	assert.Contains(t, out, `<link rel="self" href="/api/v1/opds" type="`+NavigationType+`"></link>`)
+	assert.Contains(t, out, `<content type="text">Books by author</content>`)
+	assert.Contains(t, out, `<link rel="subsection" href="/api/v1/opds/authors" type="`+NavigationType+`"></link>`)
+	assert.Contains(t, out, `<link rel="subsection" href="/api/v1/opds/books?kind=comic" type="`+AcquisitionType+`"></link>`)
+	assert.NotContains(t, out, "opensearch:totalResults", "unpaginated feeds have no OpenSearch counts")
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSON(&buf, testFeed()))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	metadata := doc["metadata"].(map[string]any)
+	assert.Equal(t, "Comics", metadata["title"])
+	assert.EqualValues(t, 120, metadata["numberOfItems"])
+	assert.EqualValues(t, 2, metadata["currentPage"])
+	assert.NotContains(t, doc, "navigation")
+
+	links := doc["links"].([]any)
+	rels := make(map[string]map[string]any)
+	for _, l := range links {
+		link := l.(map[string]any)
+		rels[link["rel"].(string)] = link
+	}
+	assert.Equal(t, JSONType, rels["self"]["type"])
+	assert.Equal(t, true, rels["search"]["templated"])
+	assert.Equal(t, "/api/v1/opds/books?kind=comic&page=3", rels["next"]["href"])
+
+	pubs := doc["publications"].([]any)
+	require.Len(t, pubs, 1)
+	pub := pubs[0].(map[string]any)
+	pubMeta := pub["metadata"].(map[string]any)
+	assert.Equal(t, "http://schema.org/Book", pubMeta["@type"])
+	assert.Equal(t, "urn:isbn:9781607066019", pubMeta["identifier"])
+	assert.Equal(t, "2012", pubMeta["published"])
+	assert.EqualValues(t, 24, pubMeta["numberOfPages"])
+	assert.Equal(t, []any{map[string]any{"name": "Brian K. Vaughan"}}, pubMeta["author"])
+	assert.Equal(t, "http://opds-spec.org/acquisition", pub["links"].([]any)[0].(map[string]any)["rel"])
+	assert.Len(t, pub["images"], 1)
+}
+
+func TestWriteJSON_EmptyAcquisitionFeed(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSON(&buf, &Feed{Title: "Saga", Acquisition: true}))
+	assert.Contains(t, buf.String(), `"publications":[]`)
+
+	buf.Reset()
+	require.NoError(t, WriteJSON(&buf, &Feed{Title: "Authors", Navigation: []Navigation{}}))
+	assert.NotContains(t, buf.String(), "publications")
+}
+
+func TestWriteOpenSearch(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteOpenSearch(&buf, "Revenge", "/api/v1/opds/books?query={searchTerms}"))
+	out := buf.String()
+
+	assert.Contains(t, out, `<OpenSearchDescription xmlns="http://a9.com/-/spec/opensearch/1.1/">`)
+	assert.Contains(t, out, `<ShortName>Revenge</ShortName>`)
+	assert.Contains(t, out, `template="/api/v1/opds/books?query={searchTerms}"`)
+}
@@ -0,0 +1,650 @@
+package opds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/auth"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+const (
+	// PathPrefix is where the OPDS 1.2 catalog is served.
+	PathPrefix = "/api/v1/opds"
+
+	// PathPrefixV2 is where the OPDS 2.0 catalog is served.
+	PathPrefixV2 = PathPrefix + "/v2"
+
+	// PageSize is the number of entries per feed page.
+	PageSize = 50
+
+	catalogTitle = "Revenge"
+)
+
+// version is one of the two catalog flavours: the feeds are built the same
+// way and only the base path and encoding differ.
+type version struct {
+	base        string
+	search      string
+	contentType func(f *Feed) string
+	write       func(w io.Writer, f *Feed) error
+}
+
+var (
+	atomVersion = version{
+		base:   PathPrefix,
+		search: PathPrefix + "/search.xml",
+		contentType: func(f *Feed) string {
+			return f.atomType() + ";charset=utf-8"
+		},
+		write: WriteAtom,
+	}
+	jsonVersion = version{
+		base:        PathPrefixV2,
+		search:      PathPrefixV2 + "/books{?query}",
+		contentType: func(*Feed) string { return JSONType },
+		write:       WriteJSON,
+	}
+)
+
+type userIDKey struct{}
+
+// LibraryLister resolves the libraries a user may browse.
+type LibraryLister interface {
+	ListAccessible(ctx context.Context, userID uuid.UUID) ([]library.Library, error)
+}
+
+// RoleChecker reports whether a user has a role. Admins may browse every
+// library.
+type RoleChecker interface {
+	HasRole(ctx context.Context, userID uuid.UUID, role string) (bool, error)
+}
+
+// Handler serves the OPDS catalogs of the book and comic libraries.
+//
+// E-reader apps mostly support HTTP Basic authentication only, so besides
+// a Bearer token or an X-API-Key header the handler accepts Basic
+// credentials whose password is an API key; the username is not checked.
+type Handler struct {
+	books        book.Service
+	libraries    LibraryLister
+	roles        RoleChecker
+	tokenManager auth.TokenManager
+	apikeys      apikeys.Service
+	logger       *slog.Logger
+	mux          *http.ServeMux
+}
+
+// NewHandler creates a new OPDS handler. The catalog lists only books in
+// the book and comic libraries the user may browse.
+func NewHandler(
+	books book.Service,
+	libraries LibraryLister,
+	roles RoleChecker,
+	tokenManager auth.TokenManager,
+	apikeyService apikeys.Service,
+	logger *slog.Logger,
+) *Handler {
+	h := &Handler{
+		books:        books,
+		libraries:    libraries,
+		roles:        roles,
+		tokenManager: tokenManager,
+		apikeys:      apikeyService,
+		logger:       logger.With("component", "opds"),
+		mux:          http.NewServeMux(),
+	}
+	for _, v := range []version{atomVersion, jsonVersion} {
+		h.mux.Handle("GET "+v.base, h.feed(v, h.root))
+		h.mux.Handle("GET "+v.base+"/{$}", h.feed(v, h.root))
+		h.mux.Handle("GET "+v.base+"/books", h.feed(v, h.bookList))
+		h.mux.Handle("GET "+v.base+"/continue", h.feed(v, h.continueReading))
+		h.mux.Handle("GET "+v.base+"/authors", h.feed(v, h.authorList))
+		h.mux.Handle("GET "+v.base+"/authors/{id}", h.feed(v, h.authorBooks))
+		h.mux.Handle("GET "+v.base+"/series", h.feed(v, h.seriesList))
+		h.mux.Handle("GET "+v.base+"/series/{id}", h.feed(v, h.seriesBooks))
+	}
+	h.mux.HandleFunc("GET "+PathPrefix+"/search.xml", h.openSearch)
+	h.mux.HandleFunc("GET "+PathPrefix+"/books/{id}/file", h.file)
+	h.mux.HandleFunc("GET "+PathPrefix+"/books/{id}/cover", h.cover)
+	h.mux.HandleFunc("GET "+PathPrefix+"/books/{id}/pages/{page}", h.page)
+	return h
+}
+
+// ServeHTTP authenticates the request and serves the catalog.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID, err := h.authenticate(r)
+	if err != nil {
+		h.logger.Debug("OPDS authentication failed", slog.Any("error", err))
+		w.Header().Set("WWW-Authenticate", `Basic realm="`+catalogTitle+`", charset="UTF-8"`)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	h.mux.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userIDKey{}, userID)))
+}
+
+// authenticate resolves the user from a Bearer token, an X-API-Key header
+// or Basic credentials carrying an API key.
+func (h *Handler) authenticate(r *http.Request) (uuid.UUID, error) {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		claims, err := h.tokenManager.ValidateAccessToken(token)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return claims.UserID, nil
+	}
+
+	rawKey := r.Header.Get("X-API-Key")
+	if _, password, ok := r.BasicAuth(); ok {
+		rawKey = password
+	}
+	if rawKey == "" {
+		return uuid.Nil, errors.New("no credentials")
+	}
+	key, err := h.apikeys.ValidateKey(r.Context(), rawKey)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return key.UserID, nil
+}
+
+// feed serves a feed built by build in the encoding of the version.
+func (h *Handler) feed(v version, build func(r *http.Request, v version) (*Feed, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f, err := build(r, v)
+		if err != nil {
+			h.writeError(w, r, err)
+			return
+		}
+		f.Start = v.base
+		f.Search = v.search
+		if f.Updated.IsZero() {
+			f.Updated = time.Now()
+		}
+		w.Header().Set("Content-Type", v.contentType(f))
+		w.Header().Set("Cache-Control", "private, no-cache")
+		if err := v.write(w, f); err != nil {
+			h.logger.Warn("failed to write OPDS feed", slog.String("path", r.URL.Path), slog.Any("error", err))
+		}
+	})
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, book.ErrBookNotFound), errors.Is(err, book.ErrAuthorNotFound),
+		errors.Is(err, book.ErrSeriesNotFound), errors.Is(err, book.ErrCoverNotFound),
+		errors.Is(err, book.ErrPageNotFound), errors.Is(err, errInvalidID):
+		http.Error(w, "Not found", http.StatusNotFound)
+	default:
+		h.logger.Error("OPDS request failed", slog.String("path", r.URL.Path), slog.Any("error", err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// =============================================================================
+// Feeds
+// =============================================================================
+
+func (h *Handler) root(_ *http.Request, v version) (*Feed, error) {
+	return &Feed{
+		ID:    "urn:revenge:opds",
+		Title: catalogTitle,
+		Self:  v.base,
+		Navigation: []Navigation{
+			{ID: "urn:revenge:opds:continue", Title: "Continue Reading", Summary: "Books you have started", Href: v.base + "/continue", Acquisition: true},
+			{ID: "urn:revenge:opds:books", Title: "Books", Summary: "Recently added books", Href: v.base + "/books?kind=book", Acquisition: true},
+			{ID: "urn:revenge:opds:comics", Title: "Comics", Summary: "Recently added comics", Href: v.base + "/books?kind=comic", Acquisition: true},
+			{ID: "urn:revenge:opds:authors", Title: "Authors", Summary: "Books by author", Href: v.base + "/authors"},
+			{ID: "urn:revenge:opds:series", Title: "Series", Summary: "Books and comics by series", Href: v.base + "/series"},
+		},
+	}, nil
+}
+
+// bookList lists books and comics, newest first. ?kind= narrows the list to
+// books or comics and ?query= searches titles, authors and series.
+func (h *Handler) bookList(r *http.Request, v version) (*Feed, error) {
+	libraryPaths, err := h.libraryPaths(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	q := r.URL.Query()
+	page := pageParam(r)
+	filters := book.BookListFilters{
+		Kind:         book.Kind(q.Get("kind")),
+		Query:        strings.TrimSpace(q.Get("query")),
+		LibraryPaths: libraryPaths,
+		OrderBy:      "added",
+		Limit:        PageSize,
+		Offset:       int32((page - 1) * PageSize), // #nosec G115 -- page is bounded by pageParam
+	}
+	if !filters.Kind.Valid() {
+		filters.Kind = ""
+	}
+	books, total, err := h.books.ListBooks(r.Context(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	title := "Books and Comics"
+	switch {
+	case filters.Query != "":
+		title = fmt.Sprintf("Search: %s", filters.Query)
+	case filters.Kind == book.KindBook:
+		title = "Books"
+	case filters.Kind == book.KindComic:
+		title = "Comics"
+	}
+	f := &Feed{
+		ID:           bookListID(filters),
+		Title:        title,
+		Self:         pageHref(v.base+"/books", q, page),
+		Acquisition:  true,
+		Publications: publications(books),
+	}
+	f.Pagination = pagination(v.base+"/books", q, page, total)
+	return f, nil
+}
+
+func bookListID(filters book.BookListFilters) string {
+	id := "urn:revenge:opds:books"
+	if filters.Kind != "" {
+		id += ":" + string(filters.Kind)
+	}
+	if filters.Query != "" {
+		id += ":search:" + url.QueryEscape(filters.Query)
+	}
+	return id
+}
+
+func (h *Handler) continueReading(r *http.Request, v version) (*Feed, error) {
+	libraryPaths, err := h.libraryPaths(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	items, err := h.books.GetContinueReading(r.Context(), userIDFrom(r.Context()), "", libraryPaths, PageSize)
+	if err != nil {
+		return nil, err
+	}
+	books := make([]book.Book, len(items))
+	for i := range items {
+		books[i] = items[i].Book
+	}
+	return &Feed{
+		ID:           "urn:revenge:opds:continue",
+		Title:        "Continue Reading",
+		Self:         v.base + "/continue",
+		Acquisition:  true,
+		Publications: publications(books),
+	}, nil
+}
+
+func (h *Handler) authorList(r *http.Request, v version) (*Feed, error) {
+	libraryPaths, err := h.libraryPaths(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	page := pageParam(r)
+	authors, total, err := h.books.ListAuthors(r.Context(), book.AuthorListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        PageSize,
+		Offset:       int32((page - 1) * PageSize), // #nosec G115 -- page is bounded by pageParam
+	})
+	if err != nil {
+		return nil, err
+	}
+	f := &Feed{
+		ID:         "urn:revenge:opds:authors",
+		Title:      "Authors",
+		Self:       pageHref(v.base+"/authors", nil, page),
+		Navigation: make([]Navigation, 0, len(authors)),
+		Pagination: pagination(v.base+"/authors", nil, page, total),
+	}
+	for _, a := range authors {
+		f.Navigation = append(f.Navigation, Navigation{
+			ID:          "urn:uuid:" + a.ID.String(),
+			Title:       a.Name,
+			Href:        v.base + "/authors/" + a.ID.String(),
+			Acquisition: true,
+		})
+	}
+	return f, nil
+}
+
+func (h *Handler) authorBooks(r *http.Request, v version) (*Feed, error) {
+	id, err := pathID(r)
+	if err != nil {
+		return nil, err
+	}
+	author, err := h.books.GetAuthor(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	libraryPaths, err := h.libraryPaths(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	books, err := h.books.ListAuthorBooks(r.Context(), id, libraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	return &Feed{
+		ID:           "urn:uuid:" + author.ID.String(),
+		Title:        author.Name,
+		Self:         v.base + "/authors/" + author.ID.String(),
+		Acquisition:  true,
+		Publications: publications(books),
+	}, nil
+}
+
+func (h *Handler) seriesList(r *http.Request, v version) (*Feed, error) {
+	libraryPaths, err := h.libraryPaths(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	page := pageParam(r)
+	series, total, err := h.books.ListSeries(r.Context(), book.SeriesListFilters{
+		LibraryPaths: libraryPaths,
+		Limit:        PageSize,
+		Offset:       int32((page - 1) * PageSize), // #nosec G115 -- page is bounded by pageParam
+	})
+	if err != nil {
+		return nil, err
+	}
+	f := &Feed{
+		ID:         "urn:revenge:opds:series",
+		Title:      "Series",
+		Self:       pageHref(v.base+"/series", nil, page),
+		Navigation: make([]Navigation, 0, len(series)),
+		Pagination: pagination(v.base+"/series", nil, page, total),
+	}
+	for _, s := range series {
+		f.Navigation = append(f.Navigation, Navigation{
+			ID:          "urn:uuid:" + s.ID.String(),
+			Title:       s.Name,
+			Href:        v.base + "/series/" + s.ID.String(),
+			Acquisition: true,
+		})
+	}
+	return f, nil
+}
+
+func (h *Handler) seriesBooks(r *http.Request, v version) (*Feed, error) {
+	id, err := pathID(r)
+	if err != nil {
+		return nil, err
+	}
+	series, err := h.books.GetSeries(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	libraryPaths, err := h.libraryPaths(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	books, err := h.books.ListSeriesBooks(r.Context(), id, libraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	return &Feed{
+		ID:           "urn:uuid:" + series.ID.String(),
+		Title:        series.Name,
+		Self:         v.base + "/series/" + series.ID.String(),
+		Acquisition:  true,
+		Publications: publications(books),
+	}, nil
+}
+
+func (h *Handler) openSearch(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", OpenSearchType+";charset=utf-8")
+	if err := WriteOpenSearch(w, catalogTitle, PathPrefix+"/books?query={searchTerms}"); err != nil {
+		h.logger.Warn("failed to write OpenSearch description", slog.Any("error", err))
+	}
+}
+
+// =============================================================================
+// Content
+// =============================================================================
+
+// file serves the book file with range support, which e-readers use to
+// resume interrupted downloads.
+func (h *Handler) file(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if err := h.checkBook(r.Context(), id); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	b, f, err := h.books.OpenFile(r.Context(), id)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	name := filepath.Base(b.FilePath)
+	w.Header().Set("Content-Type", b.Format.MIMEType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+	http.ServeContent(w, r, name, info.ModTime(), f)
+}
+
+func (h *Handler) cover(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	if err := h.checkBook(r.Context(), id); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	cover, err := h.books.GetCover(r.Context(), id)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writeImage(w, cover)
+}
+
+// page serves a comic page for OPDS Page Streaming, which numbers pages
+// from 0.
+func (h *Handler) page(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	number, err := strconv.Atoi(r.PathValue("page"))
+	if err != nil || number < 0 {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	if err := h.checkBook(r.Context(), id); err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	img, err := h.books.GetPage(r.Context(), id, number+1)
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writeImage(w, img)
+}
+
+func writeImage(w http.ResponseWriter, img *book.Image) {
+	w.Header().Set("Content-Type", img.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(img.Data)))
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	_, _ = w.Write(img.Data)
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+var errInvalidID = errors.New("invalid id")
+
+func pathID(r *http.Request) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		return uuid.Nil, errInvalidID
+	}
+	return id, nil
+}
+
+func userIDFrom(ctx context.Context) uuid.UUID {
+	id, _ := ctx.Value(userIDKey{}).(uuid.UUID)
+	return id
+}
+
+// libraryPaths returns the path prefixes of the book and comic libraries
+// the user may browse, or nil when the user is an admin.
+func (h *Handler) libraryPaths(ctx context.Context) ([]string, error) {
+	userID := userIDFrom(ctx)
+	isAdmin, err := h.roles.HasRole(ctx, userID, "admin")
+	if err != nil || isAdmin {
+		return nil, err
+	}
+	libs, err := h.libraries.ListAccessible(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{}
+	for _, lib := range libs {
+		if lib.Type == library.LibraryTypeBook || lib.Type == library.LibraryTypeComic {
+			paths = append(paths, lib.PathPrefixes()...)
+		}
+	}
+	return paths, nil
+}
+
+// checkBook returns book.ErrBookNotFound unless the book's file is in a
+// library the user may browse.
+func (h *Handler) checkBook(ctx context.Context, id uuid.UUID) error {
+	b, err := h.books.GetBook(ctx, id)
+	if err != nil {
+		return err
+	}
+	libraryPaths, err := h.libraryPaths(ctx)
+	if err != nil {
+		return err
+	}
+	if libraryPaths == nil {
+		return nil
+	}
+	for _, prefix := range libraryPaths {
+		if strings.HasPrefix(b.FilePath, prefix) {
+			return nil
+		}
+	}
+	return book.ErrBookNotFound
+}
+
+// maxPage keeps offsets within int32.
+const maxPage = 100000
+
+// pageParam returns the requested feed page, numbered from 1.
+func pageParam(r *http.Request) int {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return min(page, maxPage)
+}
+
+// pageHref returns the link to a page of a list, keeping the other query
+// parameters.
+func pageHref(path string, query url.Values, page int) string {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Del("page")
+	if page > 1 {
+		q.Set("page", strconv.Itoa(page))
+	}
+	if len(q) == 0 {
+		return path
+	}
+	return path + "?" + q.Encode()
+}
+
+func pagination(path string, query url.Values, page int, total int64) *Pagination {
+	p := &Pagination{Page: page, PerPage: PageSize, Total: total}
+	if int64(page*PageSize) < total {
+		p.Next = pageHref(path, query, page+1)
+	}
+	if page > 1 {
+		p.Previous = pageHref(path, query, page-1)
+	}
+	return p
+}
+
+func publications(books []book.Book) []Publication {
+	result := make([]Publication, 0, len(books))
+	for i := range books {
+		result = append(result, publication(&books[i]))
+	}
+	return result
+}
+
+func publication(b *book.Book) Publication {
+	base := PathPrefix + "/books/" + b.ID.String()
+	p := Publication{
+		ID:          "urn:uuid:" + b.ID.String(),
+		Title:       b.Title,
+		Subjects:    b.Genres,
+		PageCount:   int(b.PageCount),
+		Updated:     b.UpdatedAt,
+		Acquisition: Link{Href: base + "/file", Type: b.Format.MIMEType()},
+	}
+	if b.AuthorCredit != "" {
+		p.Authors = []string{b.AuthorCredit}
+	}
+	if b.Description != nil {
+		p.Summary = *b.Description
+	}
+	if b.Language != nil {
+		p.Language = *b.Language
+	}
+	if b.Publisher != nil {
+		p.Publisher = *b.Publisher
+	}
+	if b.Year != nil {
+		p.Year = int(*b.Year)
+	}
+	if b.ISBN != nil {
+		p.ISBN = *b.ISBN
+	}
+	if b.HasCover() {
+		// The cover endpoint serves a scaled thumbnail that is usually, but
+		// not always, a JPEG, so no type is advertised
+		p.Cover = &Link{Href: base + "/cover"}
+		p.Thumbnail = p.Cover
+	}
+	if b.Format.IsComic() && len(b.Pages) > 0 {
+		p.PageStream = &Link{Href: base + "/pages/{pageNumber}", Type: "image/jpeg"}
+	}
+	return p
+}
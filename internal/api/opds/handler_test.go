@@ -0,0 +1,309 @@
+package opds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/auth"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+const testAPIKey = "rv_testkey"
+
+type mockBookService struct {
+	book.Service
+	books   []book.Book
+	total   int64
+	filters book.BookListFilters
+	kind    *book.Kind
+	pages   []int
+
+	continuePaths []string
+}
+
+func (m *mockBookService) ListBooks(_ context.Context, filters book.BookListFilters) ([]book.Book, int64, error) {
+	m.filters = filters
+	return m.books, m.total, nil
+}
+
+func (m *mockBookService) GetBook(_ context.Context, id uuid.UUID) (*book.Book, error) {
+	for i := range m.books {
+		if m.books[i].ID == id {
+			return &m.books[i], nil
+		}
+	}
+	return nil, book.ErrBookNotFound
+}
+
+func (m *mockBookService) GetContinueReading(_ context.Context, _ uuid.UUID, kind book.Kind, libraryPaths []string, _ int32) ([]book.ContinueReadingItem, error) {
+	m.kind = &kind
+	m.continuePaths = libraryPaths
+	return []book.ContinueReadingItem{{Book: m.books[0]}}, nil
+}
+
+func (m *mockBookService) GetAuthor(_ context.Context, id uuid.UUID) (*book.Author, error) {
+	return nil, book.ErrAuthorNotFound
+}
+
+func (m *mockBookService) OpenFile(_ context.Context, id uuid.UUID) (*book.Book, *os.File, error) {
+	for i := range m.books {
+		if m.books[i].ID == id {
+			f, err := os.Open(m.books[i].FilePath)
+			return &m.books[i], f, err
+		}
+	}
+	return nil, nil, book.ErrBookNotFound
+}
+
+func (m *mockBookService) GetPage(_ context.Context, _ uuid.UUID, page int) (*book.Image, error) {
+	m.pages = append(m.pages, page)
+	return &book.Image{Data: []byte("page"), ContentType: "image/png"}, nil
+}
+
+type mockTokenManager struct {
+	auth.TokenManager
+	userID uuid.UUID
+}
+
+func (m *mockTokenManager) ValidateAccessToken(token string) (*auth.Claims, error) {
+	if token != "valid-token" {
+		return nil, errors.New("invalid token")
+	}
+	return &auth.Claims{UserID: m.userID}, nil
+}
+
+type mockAPIKeys struct {
+	apikeys.Service
+	userID uuid.UUID
+}
+
+func (m *mockAPIKeys) ValidateKey(_ context.Context, rawKey string) (*apikeys.APIKey, error) {
+	if rawKey != testAPIKey {
+		return nil, apikeys.ErrInvalidKeyFormat
+	}
+	return &apikeys.APIKey{UserID: m.userID}, nil
+}
+
+// mockLibraries grants every user the same libraries.
+type mockLibraries struct {
+	libs []library.Library
+}
+
+func (m *mockLibraries) ListAccessible(context.Context, uuid.UUID) ([]library.Library, error) {
+	return m.libs, nil
+}
+
+type mockRoles struct {
+	admin bool
+}
+
+func (m *mockRoles) HasRole(context.Context, uuid.UUID, string) (bool, error) {
+	return m.admin, nil
+}
+
+// newTestHandler returns a handler whose user is an admin.
+func newTestHandler(t *testing.T, books *mockBookService) *Handler {
+	t.Helper()
+	return newTestHandlerWithAccess(t, books, &mockLibraries{}, &mockRoles{admin: true})
+}
+
+func newTestHandlerWithAccess(t *testing.T, books *mockBookService, libraries LibraryLister, roles RoleChecker) *Handler {
+	t.Helper()
+	userID := uuid.New()
+	return NewHandler(books, libraries, roles, &mockTokenManager{userID: userID}, &mockAPIKeys{userID: userID}, logging.NewTestLogger())
+}
+
+func newTestBooks(t *testing.T) *mockBookService {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "Dune.epub")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o600))
+	return &mockBookService{
+		books: []book.Book{{
+			ID: uuid.New(), Kind: book.KindBook, Format: ebook.FormatEPUB, Title: "Dune",
+			AuthorCredit: "Frank Herbert", FilePath: path, CoverEntry: new("cover.jpg"),
+		}},
+		total: 1,
+	}
+}
+
+func serve(h http.Handler, method, target string, setup func(r *http.Request)) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if setup != nil {
+		setup(req)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func withBasic(r *http.Request) { r.SetBasicAuth("reader", testAPIKey) }
+
+func TestHandler_Authentication(t *testing.T) {
+	h := newTestHandler(t, newTestBooks(t))
+
+	rec := serve(h, http.MethodGet, PathPrefix, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Basic realm=")
+
+	rec = serve(h, http.MethodGet, PathPrefix, func(r *http.Request) { r.SetBasicAuth("reader", "wrong") })
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = serve(h, http.MethodGet, PathPrefix, withBasic)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(h, http.MethodGet, PathPrefix, func(r *http.Request) { r.Header.Set("X-API-Key", testAPIKey) })
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(h, http.MethodGet, PathPrefix, func(r *http.Request) { r.Header.Set("Authorization", "Bearer valid-token") })
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(h, http.MethodGet, PathPrefix, func(r *http.Request) { r.Header.Set("Authorization", "Bearer expired") })
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandler_Root(t *testing.T) {
+	h := newTestHandler(t, newTestBooks(t))
+
+	rec := serve(h, http.MethodGet, PathPrefix+"/", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, NavigationType+";charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `href="/api/v1/opds/books?kind=comic"`)
+	assert.Contains(t, rec.Body.String(), `href="/api/v1/opds/search.xml"`)
+
+	rec = serve(h, http.MethodGet, PathPrefixV2, withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, JSONType, rec.Header().Get("Content-Type"))
+	var doc struct {
+		Navigation []struct {
+			Href string `json:"href"`
+		} `json:"navigation"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.NotEmpty(t, doc.Navigation)
+	assert.Equal(t, PathPrefixV2+"/continue", doc.Navigation[0].Href)
+}
+
+func TestHandler_BookList(t *testing.T) {
+	books := newTestBooks(t)
+	books.total = 120
+	h := newTestHandler(t, books)
+
+	rec := serve(h, http.MethodGet, PathPrefix+"/books?kind=comic&query=saga&page=2", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, AcquisitionType+";charset=utf-8", rec.Header().Get("Content-Type"))
+
+	assert.Equal(t, book.KindComic, books.filters.Kind)
+	assert.Equal(t, "saga", books.filters.Query)
+	assert.Equal(t, int32(PageSize), books.filters.Limit)
+	assert.Equal(t, int32(PageSize), books.filters.Offset)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `<title>Search: saga</title>`)
+	assert.Contains(t, body, `rel="next" href="/api/v1/opds/books?kind=comic&amp;page=3&amp;query=saga"`)
+	assert.Contains(t, body, `rel="previous" href="/api/v1/opds/books?kind=comic&amp;query=saga"`)
+	assert.Contains(t, body, `href="/api/v1/opds/books/`+books.books[0].ID.String()+`/file" type="application/epub+zip"`)
+
+	serve(h, http.MethodGet, PathPrefix+"/books?kind=audiobook", withBasic)
+	assert.Empty(t, books.filters.Kind, "unknown kinds list everything")
+}
+
+func TestHandler_ContinueReading(t *testing.T) {
+	books := newTestBooks(t)
+	h := newTestHandler(t, books)
+
+	rec := serve(h, http.MethodGet, PathPrefixV2+"/continue", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotNil(t, books.kind)
+	assert.Contains(t, rec.Body.String(), `"title":"Dune"`)
+}
+
+func TestHandler_NotFound(t *testing.T) {
+	h := newTestHandler(t, newTestBooks(t))
+
+	rec := serve(h, http.MethodGet, PathPrefix+"/authors/"+uuid.NewString(), withBasic)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(h, http.MethodGet, PathPrefix+"/authors/not-a-uuid", withBasic)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(h, http.MethodGet, PathPrefix+"/books/"+uuid.NewString()+"/file", withBasic)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_File(t *testing.T) {
+	books := newTestBooks(t)
+	h := newTestHandler(t, books)
+	target := PathPrefix + "/books/" + books.books[0].ID.String() + "/file"
+
+	rec := serve(h, http.MethodGet, target, withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/epub+zip", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=Dune.epub`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "0123456789", rec.Body.String())
+
+	rec = serve(h, http.MethodGet, target, func(r *http.Request) {
+		withBasic(r)
+		r.Header.Set("Range", "bytes=4-")
+	})
+	assert.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, "456789", rec.Body.String())
+}
+
+func TestHandler_PageStreamingIsZeroBased(t *testing.T) {
+	books := newTestBooks(t)
+	h := newTestHandler(t, books)
+
+	rec := serve(h, http.MethodGet, PathPrefix+"/books/"+books.books[0].ID.String()+"/pages/0", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, []int{1}, books.pages)
+
+	rec = serve(h, http.MethodGet, PathPrefix+"/books/"+books.books[0].ID.String()+"/pages/-1", withBasic)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_OpenSearch(t *testing.T) {
+	h := newTestHandler(t, newTestBooks(t))
+
+	rec := serve(h, http.MethodGet, PathPrefix+"/search.xml", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `template="/api/v1/opds/books?query={searchTerms}"`)
+}
+
+func TestHandler_LibraryAccess(t *testing.T) {
+	books := newTestBooks(t)
+	shared := library.Library{ID: uuid.New(), Type: library.LibraryTypeBook, Paths: []string{"/books/shared"}}
+	comics := library.Library{ID: uuid.New(), Type: library.LibraryTypeComic, Paths: []string{"/comics"}}
+	music := library.Library{ID: uuid.New(), Type: library.LibraryTypeMusic, Paths: []string{"/music"}}
+	h := newTestHandlerWithAccess(t, books, &mockLibraries{libs: []library.Library{shared, comics, music}}, &mockRoles{})
+
+	rec := serve(h, http.MethodGet, PathPrefix+"/books", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"/books/shared/", "/comics/"}, books.filters.LibraryPaths)
+
+	rec = serve(h, http.MethodGet, PathPrefix+"/continue", withBasic)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"/books/shared/", "/comics/"}, books.continuePaths)
+
+	// The test book lives in a temporary directory outside every library
+	base := PathPrefix + "/books/" + books.books[0].ID.String()
+	for _, target := range []string{base + "/file", base + "/cover", base + "/pages/0"} {
+		rec = serve(h, http.MethodGet, target, withBasic)
+		assert.Equal(t, http.StatusNotFound, rec.Code, target)
+	}
+	assert.Empty(t, books.pages, "pages of hidden books are not read")
+}
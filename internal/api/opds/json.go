@@ -0,0 +1,129 @@
+package opds
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+type jsonFeed struct {
+	Metadata     jsonFeedMetadata  `json:"metadata"`
+	Links        []jsonLink        `json:"links"`
+	Navigation   []jsonLink        `json:"navigation,omitzero"`
+	Publications []jsonPublication `json:"publications,omitzero"`
+}
+
+type jsonFeedMetadata struct {
+	Title         string `json:"title"`
+	Modified      string `json:"modified,omitempty"`
+	NumberOfItems *int64 `json:"numberOfItems,omitempty"`
+	ItemsPerPage  int    `json:"itemsPerPage,omitempty"`
+	CurrentPage   int    `json:"currentPage,omitempty"`
+}
+
+type jsonLink struct {
+	Rel       string `json:"rel,omitempty"`
+	Href      string `json:"href"`
+	Type      string `json:"type,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Templated bool   `json:"templated,omitempty"`
+}
+
+type jsonPublication struct {
+	Metadata jsonPublicationMetadata `json:"metadata"`
+	Links    []jsonLink              `json:"links"`
+	Images   []jsonLink              `json:"images,omitempty"`
+}
+
+type jsonPublicationMetadata struct {
+	Type          string            `json:"@type"`
+	Identifier    string            `json:"identifier"`
+	Title         string            `json:"title"`
+	Author        []jsonContributor `json:"author,omitempty"`
+	Language      string            `json:"language,omitempty"`
+	Publisher     string            `json:"publisher,omitempty"`
+	Published     string            `json:"published,omitempty"`
+	Modified      string            `json:"modified,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	Subject       []jsonContributor `json:"subject,omitempty"`
+	NumberOfPages int               `json:"numberOfPages,omitempty"`
+}
+
+type jsonContributor struct {
+	Name string `json:"name"`
+}
+
+// WriteJSON encodes the feed as an OPDS 2.0 JSON document.
+func WriteJSON(w io.Writer, f *Feed) error {
+	feed := jsonFeed{
+		Metadata: jsonFeedMetadata{Title: f.Title, Modified: atomTime(f.Updated)},
+		Links: []jsonLink{
+			{Rel: "self", Href: f.Self, Type: JSONType},
+			{Rel: "start", Href: f.Start, Type: JSONType},
+		},
+	}
+	if f.Search != "" {
+		feed.Links = append(feed.Links, jsonLink{Rel: "search", Href: f.Search, Type: JSONType, Templated: true})
+	}
+	if p := f.Pagination; p != nil {
+		feed.Metadata.NumberOfItems = &p.Total
+		feed.Metadata.ItemsPerPage = p.PerPage
+		feed.Metadata.CurrentPage = p.Page
+		if p.Next != "" {
+			feed.Links = append(feed.Links, jsonLink{Rel: "next", Href: p.Next, Type: JSONType})
+		}
+		if p.Previous != "" {
+			feed.Links = append(feed.Links, jsonLink{Rel: "previous", Href: p.Previous, Type: JSONType})
+		}
+	}
+
+	for _, n := range f.Navigation {
+		feed.Navigation = append(feed.Navigation, jsonLink{Href: n.Href, Type: JSONType, Title: n.Title})
+	}
+	// Acquisition feeds keep an empty publications collection, so clients
+	// can tell an empty shelf from a navigation feed
+	if f.Acquisition {
+		feed.Publications = make([]jsonPublication, 0, len(f.Publications))
+	}
+	for i := range f.Publications {
+		feed.Publications = append(feed.Publications, publicationToJSON(&f.Publications[i]))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(feed)
+}
+
+func publicationToJSON(p *Publication) jsonPublication {
+	pub := jsonPublication{
+		Metadata: jsonPublicationMetadata{
+			Type:          "http://schema.org/Book",
+			Identifier:    p.ID,
+			Title:         p.Title,
+			Language:      p.Language,
+			Publisher:     p.Publisher,
+			Modified:      atomTime(p.Updated),
+			Description:   p.Summary,
+			NumberOfPages: p.PageCount,
+		},
+		Links: []jsonLink{
+			{Rel: relAcquisition, Href: p.Acquisition.Href, Type: p.Acquisition.Type},
+		},
+	}
+	if p.ISBN != "" {
+		pub.Metadata.Identifier = "urn:isbn:" + p.ISBN
+	}
+	for _, name := range p.Authors {
+		pub.Metadata.Author = append(pub.Metadata.Author, jsonContributor{Name: name})
+	}
+	for _, subject := range p.Subjects {
+		pub.Metadata.Subject = append(pub.Metadata.Subject, jsonContributor{Name: subject})
+	}
+	if p.Year > 0 {
+		pub.Metadata.Published = strconv.Itoa(p.Year)
+	}
+	if p.Cover != nil {
+		pub.Images = append(pub.Images, jsonLink{Href: p.Cover.Href, Type: p.Cover.Type})
+	}
+	return pub
+}
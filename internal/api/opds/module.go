@@ -0,0 +1,30 @@
+package opds
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/auth"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/rbac"
+)
+
+// Module provides the OPDS catalog handler for dependency injection.
+var Module = fx.Module("opds",
+	fx.Provide(newHandler),
+)
+
+// newHandler creates the OPDS handler from the application services.
+func newHandler(
+	books book.Service,
+	libraries *library.CachedService,
+	roles *rbac.Service,
+	tokenManager auth.TokenManager,
+	apikeyService apikeys.Service,
+	logger *slog.Logger,
+) *Handler {
+	return NewHandler(books, libraries, roles, tokenManager, apikeyService, logger)
+}
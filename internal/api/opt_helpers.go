@@ -30,6 +30,19 @@ func setOptConv[S, T any](opt interface{ SetTo(T) }, v *S, conv func(S) T) {
 	}
 }
 
+// optPtr returns a pointer to the value of an ogen optional field, or nil if
+// the field is unset.
+//
+// Usage:
+//
+//	edit.Title = optPtr(req.Title) // OptString → *string
+func optPtr[T any](opt interface{ Get() (T, bool) }) *T {
+	if v, ok := opt.Get(); ok {
+		return &v
+	}
+	return nil
+}
+
 // setOptDecimalFloat32 sets an ogen OptFloat32 from a *decimal.Decimal.
 // This handles the common decimal → float32 conversion used for vote averages,
 // popularity scores, and other decimal fields.
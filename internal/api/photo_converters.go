@@ -0,0 +1,89 @@
+package api
+
+import (
+	"path/filepath"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/photo"
+)
+
+func photoToOgen(p *photo.Photo) ogen.Photo {
+	result := ogen.Photo{
+		ID:            p.ID,
+		LibraryID:     p.LibraryID,
+		Kind:          ogen.PhotoKind(p.Kind),
+		Format:        p.Format,
+		FileName:      filepath.Base(p.FilePath),
+		FileSize:      p.FileSize,
+		TakenAt:       p.TakenAt,
+		TakenAtSource: ogen.PhotoTakenAtSource(p.TakenAtSource),
+		Width:         int(p.Width),
+		Height:        int(p.Height),
+		CreatedAt:     p.CreatedAt,
+	}
+	setOpt(&result.CameraMake, p.CameraMake)
+	setOpt(&result.CameraModel, p.CameraModel)
+	setOpt(&result.LensModel, p.LensModel)
+	setOpt(&result.ExposureTime, p.ExposureTime)
+	setOpt(&result.FNumber, p.FNumber)
+	setOptConv(&result.Iso, p.ISO, int32ToInt)
+	setOpt(&result.FocalLength, p.FocalLength)
+	setOpt(&result.Latitude, p.Latitude)
+	setOpt(&result.Longitude, p.Longitude)
+	setOpt(&result.Altitude, p.Altitude)
+	if p.Kind == photo.KindVideo {
+		result.DurationSeconds = ogen.NewOptFloat64(p.DurationSeconds)
+	}
+	setOpt(&result.Blurhash, p.BlurHash)
+	setOpt(&result.DominantColor, p.DominantColor)
+	return result
+}
+
+func photosToOgen(photos []photo.Photo) []ogen.Photo {
+	result := make([]ogen.Photo, len(photos))
+	for i := range photos {
+		result[i] = photoToOgen(&photos[i])
+	}
+	return result
+}
+
+func photoAlbumToOgen(a *photo.Album) ogen.PhotoAlbum {
+	result := ogen.PhotoAlbum{
+		ID:         a.ID,
+		LibraryID:  a.LibraryID,
+		Kind:       ogen.PhotoAlbumKind(a.Kind),
+		Title:      a.Title,
+		PhotoCount: a.PhotoCount,
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+	setOpt(&result.Description, a.Description)
+	setOpt(&result.OwnerID, a.OwnerID)
+	setOpt(&result.CoverPhotoID, a.CoverPhotoID)
+	return result
+}
+
+func photoAlbumsToOgen(albums []photo.Album) []ogen.PhotoAlbum {
+	result := make([]ogen.PhotoAlbum, len(albums))
+	for i := range albums {
+		result[i] = photoAlbumToOgen(&albums[i])
+	}
+	return result
+}
+
+func photoAlbumShareToOgen(s *photo.AlbumShare) ogen.PhotoAlbumShare {
+	return ogen.PhotoAlbumShare{
+		AlbumID:       s.AlbumID,
+		UserID:        s.UserID,
+		CanContribute: s.CanContribute,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+func photoTimelineToOgen(buckets []photo.TimelineBucket) []ogen.PhotoTimelineBucket {
+	result := make([]ogen.PhotoTimelineBucket, len(buckets))
+	for i, b := range buckets {
+		result[i] = ogen.PhotoTimelineBucket{Date: b.Date, Count: b.Count, CoverPhotoID: b.CoverPhotoID}
+	}
+	return result
+}
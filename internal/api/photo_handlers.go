@@ -0,0 +1,570 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/photo"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ListPhotos returns a paginated list of photos from the caller's photo
+// libraries.
+func (h *Handler) ListPhotos(ctx context.Context, params ogen.ListPhotosParams) (ogen.ListPhotosRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return &ogen.PhotoListResponse{Items: []ogen.Photo{}}, nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypePhoto)
+	if err != nil {
+		return nil, err
+	}
+	photos, total, err := h.photoService.ListPhotos(ctx, photo.PhotoListFilters{
+		LibraryIDs:  libraryIDs,
+		LibraryID:   optPtr[uuid.UUID](params.LibraryID),
+		Kind:        photo.Kind(params.Kind.Or("")),
+		TakenAfter:  optPtr[time.Time](params.TakenAfter),
+		TakenBefore: optPtr[time.Time](params.TakenBefore),
+		OldestFirst: params.Order.Or(ogen.ListPhotosOrderNewest) == ogen.ListPhotosOrderOldest,
+		Limit:       util.SafeIntToInt32(params.Limit.Or(100)),
+		Offset:      util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.PhotoListResponse{
+		Items: photosToOgen(photos),
+		Total: total,
+	}, nil
+}
+
+// GetPhotoTimeline returns the days or months with photos, newest first.
+func (h *Handler) GetPhotoTimeline(ctx context.Context, params ogen.GetPhotoTimelineParams) (ogen.GetPhotoTimelineRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []ogen.PhotoTimelineBucket{}
+	if h.photoService == nil {
+		return (*ogen.GetPhotoTimelineOKApplicationJSON)(&result), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypePhoto)
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := h.photoService.GetTimeline(ctx, photo.TimelineFilters{
+		LibraryIDs:  libraryIDs,
+		LibraryID:   optPtr[uuid.UUID](params.LibraryID),
+		Kind:        photo.Kind(params.Kind.Or("")),
+		Granularity: photo.Granularity(params.Granularity.Or(ogen.GetPhotoTimelineGranularityDay)),
+		Limit:       util.SafeIntToInt32(params.Limit.Or(100)),
+		Offset:      util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = photoTimelineToOgen(buckets)
+	return (*ogen.GetPhotoTimelineOKApplicationJSON)(&result), nil
+}
+
+// GetPhoto returns a photo with its capture metadata.
+func (h *Handler) GetPhoto(ctx context.Context, params ogen.GetPhotoParams) (ogen.GetPhotoRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.GetPhotoNotFound)(OgenNotFound("Photo not found")), nil
+	}
+
+	p, err := h.accessiblePhoto(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, photo.ErrPhotoNotFound) {
+			return (*ogen.GetPhotoNotFound)(OgenNotFound("Photo not found")), nil
+		}
+		return nil, err
+	}
+	result := photoToOgen(p)
+	return &result, nil
+}
+
+// GetPhotoThumbnail returns a photo scaled down to the requested width.
+func (h *Handler) GetPhotoThumbnail(ctx context.Context, params ogen.GetPhotoThumbnailParams) (ogen.GetPhotoThumbnailRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.GetPhotoThumbnailNotFound)(OgenNotFound("Photo not found")), nil
+	}
+
+	if _, err := h.accessiblePhoto(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrPhotoNotFound) {
+			return (*ogen.GetPhotoThumbnailNotFound)(OgenNotFound("Photo not found")), nil
+		}
+		return nil, err
+	}
+
+	thumb, err := h.photoService.GetThumbnail(ctx, params.ID,
+		string(params.Size.Or(ogen.GetPhotoThumbnailSizeW320)))
+	switch {
+	case errors.Is(err, photo.ErrPhotoNotFound):
+		return (*ogen.GetPhotoThumbnailNotFound)(OgenNotFound("Photo not found")), nil
+	case errors.Is(err, photo.ErrNoThumbnail), errors.Is(err, photo.ErrThumbnailsDisabled):
+		return (*ogen.GetPhotoThumbnailNotFound)(OgenNotFound("Thumbnail not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	if thumb.ContentType == "image/png" {
+		return &ogen.GetPhotoThumbnailOKImagePNG{Data: bytes.NewReader(thumb.Data)}, nil
+	}
+	return &ogen.GetPhotoThumbnailOKImageJpeg{Data: bytes.NewReader(thumb.Data)}, nil
+}
+
+// DownloadPhotoFile streams the original photo or clip.
+func (h *Handler) DownloadPhotoFile(ctx context.Context, params ogen.DownloadPhotoFileParams) (ogen.DownloadPhotoFileRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.DownloadPhotoFileNotFound)(OgenNotFound("Photo not found")), nil
+	}
+
+	if _, err := h.accessiblePhoto(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrPhotoNotFound) {
+			return (*ogen.DownloadPhotoFileNotFound)(OgenNotFound("Photo not found")), nil
+		}
+		return nil, err
+	}
+
+	p, f, err := h.photoService.OpenFile(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, photo.ErrPhotoNotFound) {
+			return (*ogen.DownloadPhotoFileNotFound)(OgenNotFound("Photo not found")), nil
+		}
+		return nil, err
+	}
+
+	// The response encoder closes the file once it is sent
+	return &ogen.DownloadPhotoFileOKHeaders{
+		ContentDisposition: ogen.NewOptString(mime.FormatMediaType("attachment",
+			map[string]string{"filename": filepath.Base(p.FilePath)})),
+		Response: ogen.DownloadPhotoFileOK{Data: f},
+	}, nil
+}
+
+// ListPhotoAlbums returns the folder albums of the caller's photo libraries
+// and the user albums they own or that are shared with them.
+func (h *Handler) ListPhotoAlbums(ctx context.Context, params ogen.ListPhotoAlbumsParams) (ogen.ListPhotoAlbumsRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return &ogen.PhotoAlbumListResponse{Items: []ogen.PhotoAlbum{}}, nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, userID, library.LibraryTypePhoto)
+	if err != nil {
+		return nil, err
+	}
+	albums, total, err := h.photoService.ListAlbums(ctx, userID, photo.AlbumListFilters{
+		LibraryIDs: libraryIDs,
+		LibraryID:  optPtr[uuid.UUID](params.LibraryID),
+		Kind:       photo.AlbumKind(params.Kind.Or("")),
+		Limit:      util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ogen.PhotoAlbumListResponse{
+		Items: photoAlbumsToOgen(albums),
+		Total: total,
+	}, nil
+}
+
+// CreatePhotoAlbum creates a user album in a photo library the caller can
+// access.
+func (h *Handler) CreatePhotoAlbum(ctx context.Context, req *ogen.PhotoAlbumCreate) (ogen.CreatePhotoAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.CreatePhotoAlbumBadRequest)(OgenBadRequest("Invalid photo library")), nil
+	}
+
+	if err := h.checkLibrary(ctx, userID, req.LibraryID, library.LibraryTypePhoto); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return (*ogen.CreatePhotoAlbumBadRequest)(OgenBadRequest("Invalid photo library")), nil
+		}
+		return nil, err
+	}
+
+	album, err := h.photoService.CreateAlbum(ctx, photo.CreateAlbumParams{
+		LibraryID:   req.LibraryID,
+		OwnerID:     userID,
+		Title:       req.Title,
+		Description: optPtr[string](req.Description),
+	})
+	if err != nil {
+		if errors.Is(err, photo.ErrInvalidAlbum) {
+			return (*ogen.CreatePhotoAlbumBadRequest)(OgenBadRequest("Album title is required")), nil
+		}
+		return nil, err
+	}
+	result := photoAlbumToOgen(album)
+	return &result, nil
+}
+
+// GetPhotoAlbum returns an album the caller may see.
+func (h *Handler) GetPhotoAlbum(ctx context.Context, params ogen.GetPhotoAlbumParams) (ogen.GetPhotoAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.GetPhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	album, err := h.accessibleAlbum(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.GetPhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+	result := photoAlbumToOgen(album)
+	return &result, nil
+}
+
+// UpdatePhotoAlbum edits a user album owned by the caller.
+func (h *Handler) UpdatePhotoAlbum(ctx context.Context, req *ogen.PhotoAlbumUpdate, params ogen.UpdatePhotoAlbumParams) (ogen.UpdatePhotoAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.UpdatePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.UpdatePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	update := photo.UpdateAlbumParams{
+		Title:       optPtr[string](req.Title),
+		Description: optPtr[string](req.Description),
+	}
+	if req.CoverPhotoID.IsSet() {
+		cover := req.CoverPhotoID.Value
+		if req.CoverPhotoID.IsNull() {
+			cover = uuid.Nil
+		}
+		update.CoverPhotoID = &cover
+	}
+
+	album, err := h.photoService.UpdateAlbum(ctx, userID, params.ID, update)
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.UpdatePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrFolderAlbum), errors.Is(err, photo.ErrNotAlbumOwner):
+		return (*ogen.UpdatePhotoAlbumForbidden)(OgenForbidden(err.Error())), nil
+	case errors.Is(err, photo.ErrInvalidAlbum):
+		return (*ogen.UpdatePhotoAlbumBadRequest)(OgenBadRequest("Album title is required")), nil
+	case errors.Is(err, photo.ErrPhotoNotInLibrary):
+		return (*ogen.UpdatePhotoAlbumBadRequest)(OgenBadRequest("Cover photo is not in the album's library")), nil
+	case err != nil:
+		return nil, err
+	}
+	result := photoAlbumToOgen(album)
+	return &result, nil
+}
+
+// DeletePhotoAlbum deletes a user album owned by the caller.
+func (h *Handler) DeletePhotoAlbum(ctx context.Context, params ogen.DeletePhotoAlbumParams) (ogen.DeletePhotoAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.DeletePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.DeletePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	err = h.photoService.DeleteAlbum(ctx, userID, params.ID)
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.DeletePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrFolderAlbum), errors.Is(err, photo.ErrNotAlbumOwner):
+		return (*ogen.DeletePhotoAlbumForbidden)(OgenForbidden(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	return &ogen.DeletePhotoAlbumNoContent{}, nil
+}
+
+// ListPhotoAlbumPhotos returns the photos of an album in album order.
+func (h *Handler) ListPhotoAlbumPhotos(ctx context.Context, params ogen.ListPhotoAlbumPhotosParams) (ogen.ListPhotoAlbumPhotosRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.ListPhotoAlbumPhotosNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.ListPhotoAlbumPhotosNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	photos, total, err := h.photoService.ListAlbumPhotos(ctx, userID, params.ID,
+		util.SafeIntToInt32(params.Limit.Or(100)), util.SafeIntToInt32(params.Offset.Or(0)))
+	if err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.ListPhotoAlbumPhotosNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	return &ogen.PhotoListResponse{
+		Items: photosToOgen(photos),
+		Total: total,
+	}, nil
+}
+
+// AddPhotoAlbumPhotos appends photos to a user album the caller owns or
+// contributes to.
+func (h *Handler) AddPhotoAlbumPhotos(ctx context.Context, req *ogen.PhotoAlbumPhotosAdd, params ogen.AddPhotoAlbumPhotosParams) (ogen.AddPhotoAlbumPhotosRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.AddPhotoAlbumPhotosNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.AddPhotoAlbumPhotosNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	added, err := h.photoService.AddAlbumPhotos(ctx, userID, params.ID, req.PhotoIds)
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.AddPhotoAlbumPhotosNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrFolderAlbum), errors.Is(err, photo.ErrNotContributor):
+		return (*ogen.AddPhotoAlbumPhotosForbidden)(OgenForbidden(err.Error())), nil
+	case errors.Is(err, photo.ErrPhotoNotInLibrary):
+		return (*ogen.AddPhotoAlbumPhotosBadRequest)(OgenBadRequest("Photos must be in the album's library")), nil
+	case err != nil:
+		return nil, err
+	}
+	return &ogen.AddPhotoAlbumPhotosOK{Added: added}, nil
+}
+
+// RemovePhotoAlbumPhoto removes a photo from a user album the caller owns
+// or contributes to.
+func (h *Handler) RemovePhotoAlbumPhoto(ctx context.Context, params ogen.RemovePhotoAlbumPhotoParams) (ogen.RemovePhotoAlbumPhotoRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.RemovePhotoAlbumPhotoNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.RemovePhotoAlbumPhotoNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	err = h.photoService.RemoveAlbumPhoto(ctx, userID, params.ID, params.PhotoId)
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.RemovePhotoAlbumPhotoNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrPhotoNotFound):
+		return (*ogen.RemovePhotoAlbumPhotoNotFound)(OgenNotFound("Photo is not in the album")), nil
+	case errors.Is(err, photo.ErrFolderAlbum):
+		return (*ogen.RemovePhotoAlbumPhotoBadRequest)(OgenBadRequest(err.Error())), nil
+	case errors.Is(err, photo.ErrNotContributor):
+		return (*ogen.RemovePhotoAlbumPhotoForbidden)(OgenForbidden(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	return &ogen.RemovePhotoAlbumPhotoNoContent{}, nil
+}
+
+// ListPhotoAlbumShares returns the users a user album is shared with.
+func (h *Handler) ListPhotoAlbumShares(ctx context.Context, params ogen.ListPhotoAlbumSharesParams) (ogen.ListPhotoAlbumSharesRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.ListPhotoAlbumSharesNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.ListPhotoAlbumSharesNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	shares, err := h.photoService.ListAlbumShares(ctx, userID, params.ID)
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.ListPhotoAlbumSharesNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrFolderAlbum):
+		return (*ogen.ListPhotoAlbumSharesBadRequest)(OgenBadRequest(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+
+	result := make([]ogen.PhotoAlbumShare, len(shares))
+	for i := range shares {
+		result[i] = photoAlbumShareToOgen(&shares[i])
+	}
+	return (*ogen.ListPhotoAlbumSharesOKApplicationJSON)(&result), nil
+}
+
+// SharePhotoAlbum shares a user album owned by the caller with a user who
+// can access the album's library.
+func (h *Handler) SharePhotoAlbum(ctx context.Context, req *ogen.PhotoAlbumShareRequest, params ogen.SharePhotoAlbumParams) (ogen.SharePhotoAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.SharePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	album, err := h.accessibleAlbum(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.SharePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, params.UserId, album.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return (*ogen.SharePhotoAlbumBadRequest)(OgenBadRequest("User cannot access the album's library")), nil
+		}
+		return nil, err
+	}
+
+	share, err := h.photoService.ShareAlbum(ctx, userID, params.ID, params.UserId, req.CanContribute.Or(false))
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.SharePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrFolderAlbum), errors.Is(err, photo.ErrNotAlbumOwner):
+		return (*ogen.SharePhotoAlbumForbidden)(OgenForbidden(err.Error())), nil
+	case errors.Is(err, photo.ErrInvalidShare):
+		return (*ogen.SharePhotoAlbumBadRequest)(OgenBadRequest("Albums cannot be shared with their owner")), nil
+	case err != nil:
+		return nil, err
+	}
+	result := photoAlbumShareToOgen(share)
+	return &result, nil
+}
+
+// UnsharePhotoAlbum removes a share of a user album.
+func (h *Handler) UnsharePhotoAlbum(ctx context.Context, params ogen.UnsharePhotoAlbumParams) (ogen.UnsharePhotoAlbumRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.photoService == nil {
+		return (*ogen.UnsharePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	}
+
+	if _, err := h.accessibleAlbum(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, photo.ErrAlbumNotFound) {
+			return (*ogen.UnsharePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+		}
+		return nil, err
+	}
+
+	err = h.photoService.UnshareAlbum(ctx, userID, params.ID, params.UserId)
+	switch {
+	case errors.Is(err, photo.ErrAlbumNotFound):
+		return (*ogen.UnsharePhotoAlbumNotFound)(OgenNotFound("Album not found")), nil
+	case errors.Is(err, photo.ErrShareNotFound):
+		return (*ogen.UnsharePhotoAlbumNotFound)(OgenNotFound("Share not found")), nil
+	case errors.Is(err, photo.ErrFolderAlbum):
+		return (*ogen.UnsharePhotoAlbumBadRequest)(OgenBadRequest(err.Error())), nil
+	case errors.Is(err, photo.ErrNotAlbumOwner):
+		return (*ogen.UnsharePhotoAlbumForbidden)(OgenForbidden(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	return &ogen.UnsharePhotoAlbumNoContent{}, nil
+}
+
+// accessiblePhoto returns a photo of a library the user can access, else
+// photo.ErrPhotoNotFound.
+func (h *Handler) accessiblePhoto(ctx context.Context, userID, photoID uuid.UUID) (*photo.Photo, error) {
+	p, err := h.photoService.GetPhoto(ctx, photoID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, userID, p.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return nil, photo.ErrPhotoNotFound
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// accessibleAlbum returns an album the user may see in a library they can
+// access, else photo.ErrAlbumNotFound.
+func (h *Handler) accessibleAlbum(ctx context.Context, userID, albumID uuid.UUID) (*photo.Album, error) {
+	album, err := h.photoService.GetAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkLibraryAccess(ctx, userID, album.LibraryID); err != nil {
+		if errors.Is(err, errLibraryDenied) {
+			return nil, photo.ErrAlbumNotFound
+		}
+		return nil, err
+	}
+	return album, nil
+}
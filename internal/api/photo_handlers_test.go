@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/photo"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+// photoMockService is a minimal mock for photo.Service used by the photo handler tests.
+type photoMockService struct {
+	photo.Service
+	photos   map[uuid.UUID]*photo.Photo
+	albums   map[uuid.UUID]*photo.Album
+	filters  *photo.PhotoListFilters
+	update   *photo.UpdateAlbumParams
+	shared   *photo.AlbumShare
+	albumErr error
+}
+
+func (m *photoMockService) GetPhoto(_ context.Context, id uuid.UUID) (*photo.Photo, error) {
+	if p, ok := m.photos[id]; ok {
+		return p, nil
+	}
+	return nil, photo.ErrPhotoNotFound
+}
+
+func (m *photoMockService) ListPhotos(_ context.Context, filters photo.PhotoListFilters) ([]photo.Photo, int64, error) {
+	m.filters = &filters
+	var photos []photo.Photo
+	for _, p := range m.photos {
+		photos = append(photos, *p)
+	}
+	return photos, int64(len(photos)), nil
+}
+
+func (m *photoMockService) GetThumbnail(_ context.Context, photoID uuid.UUID, size string) (*photo.Image, error) {
+	p, ok := m.photos[photoID]
+	if !ok {
+		return nil, photo.ErrPhotoNotFound
+	}
+	if p.Kind == photo.KindVideo {
+		return nil, photo.ErrNoThumbnail
+	}
+	return &photo.Image{Data: []byte(size), ContentType: "image/jpeg"}, nil
+}
+
+func (m *photoMockService) GetAlbum(_ context.Context, _, albumID uuid.UUID) (*photo.Album, error) {
+	if a, ok := m.albums[albumID]; ok {
+		return a, nil
+	}
+	return nil, photo.ErrAlbumNotFound
+}
+
+func (m *photoMockService) UpdateAlbum(_ context.Context, _, albumID uuid.UUID, params photo.UpdateAlbumParams) (*photo.Album, error) {
+	m.update = &params
+	if m.albumErr != nil {
+		return nil, m.albumErr
+	}
+	return m.albums[albumID], nil
+}
+
+func (m *photoMockService) AddAlbumPhotos(_ context.Context, _, _ uuid.UUID, photoIDs []uuid.UUID) (int64, error) {
+	if m.albumErr != nil {
+		return 0, m.albumErr
+	}
+	return int64(len(photoIDs)), nil
+}
+
+func (m *photoMockService) ShareAlbum(_ context.Context, _, albumID, targetID uuid.UUID, canContribute bool) (*photo.AlbumShare, error) {
+	if m.albumErr != nil {
+		return nil, m.albumErr
+	}
+	m.shared = &photo.AlbumShare{AlbumID: albumID, UserID: targetID, CanContribute: canContribute, CreatedAt: time.Now()}
+	return m.shared, nil
+}
+
+// newPhotoMockService returns a mock with a photo, a clip and a user album.
+func newPhotoMockService(photoID, clipID, albumID uuid.UUID) *photoMockService {
+	libraryID, ownerID := uuid.New(), uuid.New()
+	return &photoMockService{
+		photos: map[uuid.UUID]*photo.Photo{
+			photoID: {
+				ID: photoID, LibraryID: libraryID, FilePath: "/photos/2024/IMG_0001.jpg", Kind: photo.KindImage,
+				Format: "jpeg", TakenAt: time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC), TakenAtSource: photo.TakenAtSourceExif,
+				Width: 4000, Height: 3000, CameraModel: new("Canon EOS R6"), ISO: new(int32(400)),
+				Latitude: new(38.7223), Longitude: new(-9.1393),
+			},
+			clipID: {
+				ID: clipID, LibraryID: libraryID, FilePath: "/photos/2024/MVI_0002.mov", Kind: photo.KindVideo,
+				Format: "mov", TakenAtSource: photo.TakenAtSourceFile, DurationSeconds: 12.5,
+			},
+		},
+		albums: map[uuid.UUID]*photo.Album{
+			albumID: {ID: albumID, LibraryID: libraryID, Kind: photo.AlbumKindUser, Title: "Lisbon", OwnerID: &ownerID, PhotoCount: 1},
+		},
+	}
+}
+
+func TestHandler_GetPhoto(t *testing.T) {
+	t.Parallel()
+
+	photoID, clipID := uuid.New(), uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), photoService: newPhotoMockService(photoID, clipID, uuid.New())}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetPhoto(ctx, ogen.GetPhotoParams{ID: photoID})
+	require.NoError(t, err)
+	p, ok := result.(*ogen.Photo)
+	require.True(t, ok, "expected *ogen.Photo, got %T", result)
+	assert.Equal(t, "IMG_0001.jpg", p.FileName)
+	assert.Equal(t, ogen.PhotoTakenAtSourceExif, p.TakenAtSource)
+	assert.Equal(t, "Canon EOS R6", p.CameraModel.Or(""))
+	assert.Equal(t, 400, p.Iso.Or(0))
+	assert.InDelta(t, 38.7223, p.Latitude.Or(0), 0.0001)
+	assert.False(t, p.DurationSeconds.IsSet(), "photos have no duration")
+
+	result, err = handler.GetPhoto(ctx, ogen.GetPhotoParams{ID: clipID})
+	require.NoError(t, err)
+	assert.InDelta(t, 12.5, result.(*ogen.Photo).DurationSeconds.Or(0), 0.001)
+
+	result, err = handler.GetPhoto(ctx, ogen.GetPhotoParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetPhotoNotFound)
+	assert.True(t, ok, "expected *ogen.GetPhotoNotFound, got %T", result)
+}
+
+func TestHandler_ListPhotos_Filters(t *testing.T) {
+	t.Parallel()
+
+	svc := newPhotoMockService(uuid.New(), uuid.New(), uuid.New())
+	handler := &Handler{logger: logging.NewTestLogger(), photoService: svc}
+	after := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	result, err := handler.ListPhotos(contextWithUserID(context.Background(), uuid.New()), ogen.ListPhotosParams{
+		Kind:       ogen.NewOptListPhotosKind(ogen.ListPhotosKindVideo),
+		TakenAfter: ogen.NewOptDateTime(after),
+		Order:      ogen.NewOptListPhotosOrder(ogen.ListPhotosOrderOldest),
+		Limit:      ogen.NewOptInt(20),
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.(*ogen.PhotoListResponse).Items, 2)
+
+	require.NotNil(t, svc.filters)
+	assert.Nil(t, svc.filters.LibraryIDs, "all libraries without library permissions")
+	assert.Equal(t, photo.KindVideo, svc.filters.Kind)
+	assert.Equal(t, &after, svc.filters.TakenAfter)
+	assert.Nil(t, svc.filters.TakenBefore)
+	assert.True(t, svc.filters.OldestFirst)
+	assert.Equal(t, int32(20), svc.filters.Limit)
+}
+
+func TestHandler_GetPhotoThumbnail(t *testing.T) {
+	t.Parallel()
+
+	photoID, clipID := uuid.New(), uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), photoService: newPhotoMockService(photoID, clipID, uuid.New())}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetPhotoThumbnail(ctx, ogen.GetPhotoThumbnailParams{
+		ID:   photoID,
+		Size: ogen.NewOptGetPhotoThumbnailSize(ogen.GetPhotoThumbnailSizeW720),
+	})
+	require.NoError(t, err)
+	jpeg, ok := result.(*ogen.GetPhotoThumbnailOKImageJpeg)
+	require.True(t, ok, "expected *ogen.GetPhotoThumbnailOKImageJpeg, got %T", result)
+	data, err := io.ReadAll(jpeg.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "w720", string(data))
+
+	result, err = handler.GetPhotoThumbnail(ctx, ogen.GetPhotoThumbnailParams{ID: clipID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetPhotoThumbnailNotFound)
+	assert.True(t, ok, "expected *ogen.GetPhotoThumbnailNotFound, got %T", result)
+}
+
+func TestHandler_UpdatePhotoAlbum(t *testing.T) {
+	t.Parallel()
+
+	albumID := uuid.New()
+	svc := newPhotoMockService(uuid.New(), uuid.New(), albumID)
+	handler := &Handler{logger: logging.NewTestLogger(), photoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	req := &ogen.PhotoAlbumUpdate{Title: ogen.NewOptString("Lisboa")}
+	req.CoverPhotoID.SetToNull()
+	result, err := handler.UpdatePhotoAlbum(ctx, req, ogen.UpdatePhotoAlbumParams{ID: albumID})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.PhotoAlbum)
+	require.True(t, ok, "expected *ogen.PhotoAlbum, got %T", result)
+	require.NotNil(t, svc.update)
+	assert.Equal(t, "Lisboa", *svc.update.Title)
+	assert.Nil(t, svc.update.Description, "unset fields are kept")
+	assert.Equal(t, &uuid.Nil, svc.update.CoverPhotoID, "null clears the cover")
+
+	svc.albumErr = photo.ErrNotAlbumOwner
+	result, err = handler.UpdatePhotoAlbum(ctx, req, ogen.UpdatePhotoAlbumParams{ID: albumID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UpdatePhotoAlbumForbidden)
+	assert.True(t, ok, "expected *ogen.UpdatePhotoAlbumForbidden, got %T", result)
+
+	result, err = handler.UpdatePhotoAlbum(ctx, req, ogen.UpdatePhotoAlbumParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UpdatePhotoAlbumNotFound)
+	assert.True(t, ok, "expected *ogen.UpdatePhotoAlbumNotFound, got %T", result)
+}
+
+func TestHandler_AddPhotoAlbumPhotos(t *testing.T) {
+	t.Parallel()
+
+	albumID := uuid.New()
+	svc := newPhotoMockService(uuid.New(), uuid.New(), albumID)
+	handler := &Handler{logger: logging.NewTestLogger(), photoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+	req := &ogen.PhotoAlbumPhotosAdd{PhotoIds: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	result, err := handler.AddPhotoAlbumPhotos(ctx, req, ogen.AddPhotoAlbumPhotosParams{ID: albumID})
+	require.NoError(t, err)
+	added, ok := result.(*ogen.AddPhotoAlbumPhotosOK)
+	require.True(t, ok, "expected *ogen.AddPhotoAlbumPhotosOK, got %T", result)
+	assert.Equal(t, int64(2), added.Added)
+
+	tests := []struct {
+		err  error
+		want any
+	}{
+		{photo.ErrPhotoNotInLibrary, &ogen.AddPhotoAlbumPhotosBadRequest{}},
+		{photo.ErrNotContributor, &ogen.AddPhotoAlbumPhotosForbidden{}},
+		{photo.ErrFolderAlbum, &ogen.AddPhotoAlbumPhotosForbidden{}},
+	}
+	for _, tt := range tests {
+		svc.albumErr = tt.err
+		result, err := handler.AddPhotoAlbumPhotos(ctx, req, ogen.AddPhotoAlbumPhotosParams{ID: albumID})
+		require.NoError(t, err)
+		assert.IsType(t, tt.want, result, "error %v", tt.err)
+	}
+}
+
+func TestHandler_SharePhotoAlbum(t *testing.T) {
+	t.Parallel()
+
+	albumID, targetID := uuid.New(), uuid.New()
+	svc := newPhotoMockService(uuid.New(), uuid.New(), albumID)
+	handler := &Handler{logger: logging.NewTestLogger(), photoService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.SharePhotoAlbum(ctx, &ogen.PhotoAlbumShareRequest{CanContribute: ogen.NewOptBool(true)},
+		ogen.SharePhotoAlbumParams{ID: albumID, UserId: targetID})
+	require.NoError(t, err)
+	share, ok := result.(*ogen.PhotoAlbumShare)
+	require.True(t, ok, "expected *ogen.PhotoAlbumShare, got %T", result)
+	assert.Equal(t, targetID, share.UserID)
+	assert.True(t, share.CanContribute)
+
+	svc.albumErr = photo.ErrInvalidShare
+	result, err = handler.SharePhotoAlbum(ctx, &ogen.PhotoAlbumShareRequest{},
+		ogen.SharePhotoAlbumParams{ID: albumID, UserId: targetID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.SharePhotoAlbumBadRequest)
+	assert.True(t, ok, "expected *ogen.SharePhotoAlbumBadRequest, got %T", result)
+}
+
+func TestHandler_Photo_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListPhotos(ctx, ogen.ListPhotosParams{})
+	require.NoError(t, err)
+	assert.Empty(t, list.(*ogen.PhotoListResponse).Items)
+
+	timeline, err := handler.GetPhotoTimeline(ctx, ogen.GetPhotoTimelineParams{})
+	require.NoError(t, err)
+	assert.Empty(t, *timeline.(*ogen.GetPhotoTimelineOKApplicationJSON))
+
+	result, err := handler.GetPhotoAlbum(ctx, ogen.GetPhotoAlbumParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetPhotoAlbumNotFound)
+	assert.True(t, ok)
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/podcast"
+)
+
+func podcastToOgen(p *podcast.Podcast) ogen.Podcast {
+	categories := p.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+	result := ogen.Podcast{
+		ID:           p.ID,
+		LibraryID:    p.LibraryID,
+		FeedURL:      p.FeedURL,
+		Title:        p.Title,
+		Explicit:     p.Explicit,
+		Categories:   categories,
+		AutoDownload: p.AutoDownload,
+		CreatedAt:    p.CreatedAt,
+	}
+	setOpt(&result.Author, p.Author)
+	setOpt(&result.Description, p.Description)
+	setOpt(&result.Link, p.Link)
+	setOpt(&result.ImageURL, p.ImageURL)
+	setOpt(&result.Language, p.Language)
+	setOpt(&result.LastCheckedAt, p.LastCheckedAt)
+	setOpt(&result.LastError, p.LastError)
+	setOptConv(&result.KeepEpisodes, p.KeepEpisodes, int32ToInt)
+	return result
+}
+
+func podcastsToOgen(podcasts []podcast.Podcast) []ogen.Podcast {
+	result := make([]ogen.Podcast, len(podcasts))
+	for i := range podcasts {
+		result[i] = podcastToOgen(&podcasts[i])
+	}
+	return result
+}
+
+// podcastEpisodeToOgen converts an episode with the caller's listening
+// state, if any.
+func podcastEpisodeToOgen(e *podcast.Episode, progress *podcast.Progress) ogen.PodcastEpisode {
+	result := ogen.PodcastEpisode{
+		ID:              e.ID,
+		PodcastID:       e.PodcastID,
+		Title:           e.Title,
+		DurationSeconds: int(e.DurationSeconds),
+		EpisodeType:     ogen.PodcastEpisodeEpisodeType(e.EpisodeType),
+		Explicit:        e.Explicit,
+		EnclosureURL:    e.EnclosureURL,
+		Downloaded:      e.Downloaded(),
+		Pinned:          e.Pinned,
+		Chapters:        make([]ogen.PodcastChapter, len(e.Chapters)),
+		Transcripts:     make([]ogen.PodcastTranscript, len(e.Transcripts)),
+		HasTranscript:   e.TranscriptPath != nil,
+	}
+	setOpt(&result.Description, e.Description)
+	setOpt(&result.Link, e.Link)
+	setOpt(&result.ImageURL, e.ImageURL)
+	setOpt(&result.PublishedAt, e.PublishedAt)
+	setOptConv(&result.Season, e.Season, int32ToInt)
+	setOptConv(&result.EpisodeNumber, e.EpisodeNumber, int32ToInt)
+	setOpt(&result.EnclosureType, e.EnclosureType)
+	setOpt(&result.DownloadedAt, e.DownloadedAt)
+	setOpt(&result.DownloadError, e.DownloadError)
+	if e.Downloaded() {
+		result.FileSize = ogen.NewOptInt64(e.FileSize)
+	}
+	for i, c := range e.Chapters {
+		result.Chapters[i] = ogen.PodcastChapter{StartSeconds: c.Start, Title: c.Title}
+		if c.URL != "" {
+			result.Chapters[i].URL = ogen.NewOptString(c.URL)
+		}
+		if c.ImageURL != "" {
+			result.Chapters[i].ImageURL = ogen.NewOptString(c.ImageURL)
+		}
+	}
+	for i, t := range e.Transcripts {
+		result.Transcripts[i] = ogen.PodcastTranscript{URL: t.URL, Type: t.Type}
+		if t.Language != "" {
+			result.Transcripts[i].Language = ogen.NewOptString(t.Language)
+		}
+		if t.Rel != "" {
+			result.Transcripts[i].Rel = ogen.NewOptString(t.Rel)
+		}
+	}
+	if progress != nil {
+		result.Progress = ogen.NewOptPodcastEpisodeProgress(podcastProgressToOgen(progress))
+	}
+	return result
+}
+
+func podcastProgressToOgen(p *podcast.Progress) ogen.PodcastEpisodeProgress {
+	result := ogen.PodcastEpisodeProgress{
+		EpisodeID:       p.EpisodeID,
+		PositionSeconds: int(p.PositionSeconds),
+		IsPlayed:        p.IsPlayed,
+		LastPlayedAt:    p.LastPlayedAt,
+	}
+	setOpt(&result.PlayedAt, p.PlayedAt)
+	return result
+}
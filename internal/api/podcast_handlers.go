@@ -0,0 +1,595 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	podcastjobs "github.com/lusoris/revenge/internal/content/podcast/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+var (
+	// errNoPodcastLibrary is returned when a new feed has no podcast
+	// library to go into.
+	errNoPodcastLibrary = errors.New("no podcast library available")
+
+	// errPodcastLibraryDenied is returned when the caller cannot access the
+	// podcast library of a feed.
+	errPodcastLibraryDenied = errors.New("access denied to this library")
+)
+
+// ListPodcasts returns a paginated list of podcasts.
+func (h *Handler) ListPodcasts(ctx context.Context, params ogen.ListPodcastsParams) (ogen.ListPodcastsRes, error) {
+	if h.podcastService == nil {
+		return &ogen.PodcastListResponse{Items: []ogen.Podcast{}}, nil
+	}
+
+	filters := podcast.PodcastListFilters{
+		Query:  params.Q.Or(""),
+		Limit:  util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset: util.SafeIntToInt32(params.Offset.Or(0)),
+	}
+	if params.Subscribed.Or(false) {
+		userID, err := GetUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		filters.SubscribedBy = &userID
+	}
+	if libraryID, ok := params.LibraryID.Get(); ok {
+		filters.LibraryID = &libraryID
+	}
+
+	podcasts, total, err := h.podcastService.ListPodcasts(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	return &ogen.PodcastListResponse{
+		Items: podcastsToOgen(podcasts),
+		Total: total,
+	}, nil
+}
+
+// SubscribePodcastFeed subscribes the caller to a feed, adding the feed to
+// a podcast library first when it is new.
+func (h *Handler) SubscribePodcastFeed(ctx context.Context, req *ogen.PodcastSubscribeRequest) (ogen.SubscribePodcastFeedRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.SubscribePodcastFeedBadRequest)(OgenBadRequest("Podcasts are not available")), nil
+	}
+
+	feedURL, err := podcast.NormalizeFeedURL(req.FeedURL)
+	if err != nil {
+		return (*ogen.SubscribePodcastFeedBadRequest)(OgenBadRequest(err.Error())), nil
+	}
+
+	libraryID, err := h.resolvePodcastLibrary(ctx, userID, req.LibraryID)
+	switch {
+	case errors.Is(err, errPodcastLibraryDenied):
+		return (*ogen.SubscribePodcastFeedForbidden)(OgenForbidden("Access denied to this library")), nil
+	case errors.Is(err, errNoPodcastLibrary), errors.Is(err, podcast.ErrInvalidLibrary):
+		return (*ogen.SubscribePodcastFeedBadRequest)(OgenBadRequest(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+
+	p, created, err := h.podcastService.AddPodcast(ctx, feedURL, libraryID)
+	switch {
+	case errors.Is(err, podcast.ErrFeedUnavailable), errors.Is(err, podcast.ErrInvalidFeedURL):
+		return (*ogen.SubscribePodcastFeedBadRequest)(OgenBadRequest(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+
+	// A known feed may live in another podcast library
+	if p.LibraryID != libraryID {
+		if err := h.checkPodcastLibraryAccess(ctx, userID, p.LibraryID); err != nil {
+			if errors.Is(err, errPodcastLibraryDenied) {
+				return (*ogen.SubscribePodcastFeedForbidden)(OgenForbidden("Access denied to this library")), nil
+			}
+			return nil, err
+		}
+	}
+
+	if err := h.podcastService.Subscribe(ctx, userID, p.ID); err != nil {
+		return nil, err
+	}
+	if created {
+		h.logger.Info("podcast added",
+			slog.String("podcast_id", p.ID.String()),
+			slog.String("feed_url", p.FeedURL),
+			slog.String("user_id", userID.String()),
+		)
+		h.queuePodcastRefresh(ctx, p.ID)
+	}
+
+	result := podcastToOgen(p)
+	return &result, nil
+}
+
+// ListNewPodcastEpisodes returns the unplayed episodes of the caller's
+// subscriptions.
+func (h *Handler) ListNewPodcastEpisodes(ctx context.Context, params ogen.ListNewPodcastEpisodesParams) (ogen.ListNewPodcastEpisodesRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []ogen.PodcastInboxItem{}
+	if h.podcastService == nil {
+		return (*ogen.ListNewPodcastEpisodesOKApplicationJSON)(&result), nil
+	}
+
+	items, err := h.podcastService.ListNewEpisodes(ctx, userID, util.SafeIntToInt32(params.Limit.Or(50)))
+	if err != nil {
+		return nil, err
+	}
+	episodeIDs := make([]uuid.UUID, len(items))
+	for i := range items {
+		episodeIDs[i] = items[i].Episode.ID
+	}
+	progress, err := h.podcastService.ListProgress(ctx, userID, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		result = append(result, ogen.PodcastInboxItem{
+			Episode: podcastEpisodeToOgen(&items[i].Episode, progressOf(progress, items[i].Episode.ID)),
+			Podcast: podcastToOgen(&items[i].Podcast),
+		})
+	}
+	return (*ogen.ListNewPodcastEpisodesOKApplicationJSON)(&result), nil
+}
+
+// GetPodcast returns a podcast and whether the caller subscribes to it.
+func (h *Handler) GetPodcast(ctx context.Context, params ogen.GetPodcastParams) (ogen.GetPodcastRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.GetPodcastNotFound)(OgenNotFound("Podcast not found")), nil
+	}
+
+	p, err := h.podcastService.GetPodcast(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, podcast.ErrPodcastNotFound) {
+			return (*ogen.GetPodcastNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+	subscribed, err := h.podcastService.IsSubscribed(ctx, userID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	_, total, err := h.podcastService.ListEpisodes(ctx, p.ID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &ogen.PodcastDetail{
+		Podcast:      podcastToOgen(p),
+		Subscribed:   subscribed,
+		EpisodeCount: total,
+	}, nil
+}
+
+// UpdatePodcastSettings changes a podcast's download settings. Admin only.
+func (h *Handler) UpdatePodcastSettings(ctx context.Context, req *ogen.PodcastSettingsUpdate, params ogen.UpdatePodcastSettingsParams) (ogen.UpdatePodcastSettingsRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.UpdatePodcastSettingsUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.UpdatePodcastSettingsForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.UpdatePodcastSettingsNotFound)(OgenNotFound("Podcast not found")), nil
+	}
+
+	update := podcast.SettingsUpdate{AutoDownload: optPtr(req.AutoDownload)}
+	if keep, ok := req.KeepEpisodes.Get(); ok {
+		k := util.SafeIntToInt32(keep)
+		update.KeepEpisodes = &k
+	}
+
+	p, err := h.podcastService.UpdateSettings(ctx, params.ID, update)
+	if err != nil {
+		if errors.Is(err, podcast.ErrPodcastNotFound) {
+			return (*ogen.UpdatePodcastSettingsNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+	// Apply the new retention rules right away
+	h.queuePodcastRefresh(ctx, p.ID)
+
+	result := podcastToOgen(p)
+	return &result, nil
+}
+
+// SubscribePodcast subscribes the caller to a podcast in the library.
+func (h *Handler) SubscribePodcast(ctx context.Context, params ogen.SubscribePodcastParams) (ogen.SubscribePodcastRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.SubscribePodcastNotFound)(OgenNotFound("Podcast not found")), nil
+	}
+
+	p, err := h.podcastService.GetPodcast(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, podcast.ErrPodcastNotFound) {
+			return (*ogen.SubscribePodcastNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+	// Podcasts in libraries the caller cannot access are not revealed
+	if err := h.checkPodcastLibraryAccess(ctx, userID, p.LibraryID); err != nil {
+		if errors.Is(err, errPodcastLibraryDenied) {
+			return (*ogen.SubscribePodcastNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+
+	if err := h.podcastService.Subscribe(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, podcast.ErrPodcastNotFound) {
+			return (*ogen.SubscribePodcastNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+	return &ogen.SubscribePodcastNoContent{}, nil
+}
+
+// UnsubscribePodcast removes the caller's subscription to a podcast.
+func (h *Handler) UnsubscribePodcast(ctx context.Context, params ogen.UnsubscribePodcastParams) (ogen.UnsubscribePodcastRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.UnsubscribePodcastNotFound)(OgenNotFound("Subscription not found")), nil
+	}
+
+	if err := h.podcastService.Unsubscribe(ctx, userID, params.ID); err != nil {
+		if errors.Is(err, podcast.ErrNotSubscribed) {
+			return (*ogen.UnsubscribePodcastNotFound)(OgenNotFound("Subscription not found")), nil
+		}
+		return nil, err
+	}
+	return &ogen.UnsubscribePodcastNoContent{}, nil
+}
+
+// RefreshPodcast queues a refresh of a podcast's feed.
+func (h *Handler) RefreshPodcast(ctx context.Context, params ogen.RefreshPodcastParams) (ogen.RefreshPodcastRes, error) {
+	if h.podcastService == nil {
+		return (*ogen.RefreshPodcastNotFound)(OgenNotFound("Podcast not found")), nil
+	}
+	if _, err := h.podcastService.GetPodcast(ctx, params.ID); err != nil {
+		if errors.Is(err, podcast.ErrPodcastNotFound) {
+			return (*ogen.RefreshPodcastNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+
+	if h.riverClient == nil {
+		return nil, errors.New("job queue not available")
+	}
+	result, err := h.riverClient.Insert(ctx, podcastjobs.RefreshArgs{PodcastID: params.ID}, nil)
+	if err != nil {
+		h.logger.Error("failed to enqueue podcast refresh", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &ogen.RefreshPodcastAccepted{
+		Message: ogen.NewOptString("Podcast refresh queued"),
+		JobID:   ogen.NewOptInt64(result.Job.ID),
+	}, nil
+}
+
+// ListPodcastEpisodes returns a podcast's episodes with the caller's
+// listening state.
+func (h *Handler) ListPodcastEpisodes(ctx context.Context, params ogen.ListPodcastEpisodesParams) (ogen.ListPodcastEpisodesRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.ListPodcastEpisodesNotFound)(OgenNotFound("Podcast not found")), nil
+	}
+
+	episodes, total, err := h.podcastService.ListEpisodes(ctx, params.ID,
+		util.SafeIntToInt32(params.Limit.Or(50)), util.SafeIntToInt32(params.Offset.Or(0)))
+	if err != nil {
+		if errors.Is(err, podcast.ErrPodcastNotFound) {
+			return (*ogen.ListPodcastEpisodesNotFound)(OgenNotFound("Podcast not found")), nil
+		}
+		return nil, err
+	}
+	episodeIDs := make([]uuid.UUID, len(episodes))
+	for i := range episodes {
+		episodeIDs[i] = episodes[i].ID
+	}
+	progress, err := h.podcastService.ListProgress(ctx, userID, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ogen.PodcastEpisode, len(episodes))
+	for i := range episodes {
+		items[i] = podcastEpisodeToOgen(&episodes[i], progressOf(progress, episodes[i].ID))
+	}
+	return &ogen.PodcastEpisodeListResponse{Items: items, Total: total}, nil
+}
+
+// GetPodcastEpisode returns an episode with the caller's listening state.
+func (h *Handler) GetPodcastEpisode(ctx context.Context, params ogen.GetPodcastEpisodeParams) (ogen.GetPodcastEpisodeRes, error) {
+	if h.podcastService == nil {
+		return (*ogen.GetPodcastEpisodeNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	ep, err := h.podcastService.GetEpisode(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, podcast.ErrEpisodeNotFound) {
+			return (*ogen.GetPodcastEpisodeNotFound)(OgenNotFound("Episode not found")), nil
+		}
+		return nil, err
+	}
+	var progress *podcast.Progress
+	if userID, err := GetUserID(ctx); err == nil {
+		if p, err := h.podcastService.GetProgress(ctx, userID, ep.ID); err == nil {
+			progress = p
+		}
+	}
+	result := podcastEpisodeToOgen(ep, progress)
+	return &result, nil
+}
+
+// DownloadPodcastEpisode pins an episode and queues its download.
+func (h *Handler) DownloadPodcastEpisode(ctx context.Context, params ogen.DownloadPodcastEpisodeParams) (ogen.DownloadPodcastEpisodeRes, error) {
+	if h.podcastService == nil {
+		return (*ogen.DownloadPodcastEpisodeNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	ep, err := h.podcastService.PinEpisode(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, podcast.ErrEpisodeNotFound) {
+			return (*ogen.DownloadPodcastEpisodeNotFound)(OgenNotFound("Episode not found")), nil
+		}
+		return nil, err
+	}
+	if ep.Downloaded() {
+		return &ogen.DownloadPodcastEpisodeAccepted{
+			Message: ogen.NewOptString("Episode already downloaded"),
+		}, nil
+	}
+
+	if h.riverClient == nil {
+		return nil, errors.New("job queue not available")
+	}
+	result, err := h.riverClient.Insert(ctx, podcastjobs.DownloadArgs{EpisodeID: ep.ID}, nil)
+	if err != nil {
+		h.logger.Error("failed to enqueue episode download", slog.Any("error", err))
+		return nil, err
+	}
+
+	return &ogen.DownloadPodcastEpisodeAccepted{
+		Message: ogen.NewOptString("Episode download queued"),
+		JobID:   ogen.NewOptInt64(result.Job.ID),
+	}, nil
+}
+
+// DeletePodcastEpisodeDownload removes an episode's downloaded files. Admin
+// only.
+func (h *Handler) DeletePodcastEpisodeDownload(ctx context.Context, params ogen.DeletePodcastEpisodeDownloadParams) (ogen.DeletePodcastEpisodeDownloadRes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.DeletePodcastEpisodeDownloadUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.DeletePodcastEpisodeDownloadForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.DeletePodcastEpisodeDownloadNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	err := h.podcastService.DeleteDownload(ctx, params.ID)
+	switch {
+	case errors.Is(err, podcast.ErrEpisodeNotFound):
+		return (*ogen.DeletePodcastEpisodeDownloadNotFound)(OgenNotFound("Episode not found")), nil
+	case errors.Is(err, podcast.ErrNotDownloaded):
+		return (*ogen.DeletePodcastEpisodeDownloadNotFound)(OgenNotFound("Episode is not downloaded")), nil
+	case err != nil:
+		return nil, err
+	}
+	return &ogen.DeletePodcastEpisodeDownloadNoContent{}, nil
+}
+
+// GetPodcastEpisodeTranscript streams the downloaded transcript of an
+// episode.
+func (h *Handler) GetPodcastEpisodeTranscript(ctx context.Context, params ogen.GetPodcastEpisodeTranscriptParams) (ogen.GetPodcastEpisodeTranscriptRes, error) {
+	if h.podcastService == nil {
+		return (*ogen.GetPodcastEpisodeTranscriptNotFound)(OgenNotFound("Transcript not found")), nil
+	}
+
+	ep, f, err := h.podcastService.OpenTranscript(ctx, params.ID)
+	switch {
+	case errors.Is(err, podcast.ErrEpisodeNotFound):
+		return (*ogen.GetPodcastEpisodeTranscriptNotFound)(OgenNotFound("Episode not found")), nil
+	case errors.Is(err, podcast.ErrTranscriptNotFound):
+		return (*ogen.GetPodcastEpisodeTranscriptNotFound)(OgenNotFound("Transcript not found")), nil
+	case err != nil:
+		return nil, err
+	}
+
+	// The response encoder closes the file once it is sent
+	var transcriptType string
+	if ep.TranscriptType != nil {
+		transcriptType = *ep.TranscriptType
+	}
+	switch transcriptType {
+	case "text/vtt":
+		return &ogen.GetPodcastEpisodeTranscriptOKTextVtt{Data: f}, nil
+	case "application/x-subrip", "application/srt":
+		return &ogen.GetPodcastEpisodeTranscriptOKApplicationXSubrip{Data: f}, nil
+	case "text/html":
+		return &ogen.GetPodcastEpisodeTranscriptOKTextHTML{Data: f}, nil
+	case "text/plain":
+		return &ogen.GetPodcastEpisodeTranscriptOKTextPlain{Data: f}, nil
+	default:
+		return &ogen.GetPodcastEpisodeTranscriptOKApplicationOctetStream{Data: f}, nil
+	}
+}
+
+// GetPodcastEpisodeProgress returns the caller's listening state of an
+// episode.
+func (h *Handler) GetPodcastEpisodeProgress(ctx context.Context, params ogen.GetPodcastEpisodeProgressParams) (ogen.GetPodcastEpisodeProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.GetPodcastEpisodeProgressNotFound)(OgenNotFound("Progress not found")), nil
+	}
+
+	progress, err := h.podcastService.GetProgress(ctx, userID, params.ID)
+	if err != nil {
+		if errors.Is(err, podcast.ErrProgressNotFound) {
+			return (*ogen.GetPodcastEpisodeProgressNotFound)(OgenNotFound("Progress not found")), nil
+		}
+		return nil, err
+	}
+	result := podcastProgressToOgen(progress)
+	return &result, nil
+}
+
+// UpdatePodcastEpisodeProgress records the caller's listening position.
+func (h *Handler) UpdatePodcastEpisodeProgress(ctx context.Context, req *ogen.PodcastEpisodeProgressUpdate, params ogen.UpdatePodcastEpisodeProgressParams) (ogen.UpdatePodcastEpisodeProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.UpdatePodcastEpisodeProgressNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	progress, err := h.podcastService.UpdateProgress(ctx, userID, params.ID, podcast.ProgressUpdate{
+		PositionSeconds: util.SafeIntToInt32(req.PositionSeconds),
+		Played:          optPtr(req.Played),
+	})
+	switch {
+	case errors.Is(err, podcast.ErrEpisodeNotFound):
+		return (*ogen.UpdatePodcastEpisodeProgressNotFound)(OgenNotFound("Episode not found")), nil
+	case errors.Is(err, podcast.ErrInvalidProgress):
+		return (*ogen.UpdatePodcastEpisodeProgressBadRequest)(OgenBadRequest(err.Error())), nil
+	case err != nil:
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	result := podcastProgressToOgen(progress)
+	return &result, nil
+}
+
+// DeletePodcastEpisodeProgress removes the caller's listening state.
+func (h *Handler) DeletePodcastEpisodeProgress(ctx context.Context, params ogen.DeletePodcastEpisodeProgressParams) (ogen.DeletePodcastEpisodeProgressRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.podcastService == nil {
+		return (*ogen.DeletePodcastEpisodeProgressNotFound)(OgenNotFound("Episode not found")), nil
+	}
+
+	if err := h.podcastService.DeleteProgress(ctx, userID, params.ID); err != nil {
+		return nil, err
+	}
+	h.invalidateHomeFeed(ctx, userID)
+	return &ogen.DeletePodcastEpisodeProgressNoContent{}, nil
+}
+
+// resolvePodcastLibrary returns the library a new feed goes into: the
+// requested one, or the first enabled podcast library the caller can access.
+func (h *Handler) resolvePodcastLibrary(ctx context.Context, userID uuid.UUID, requested ogen.OptUUID) (uuid.UUID, error) {
+	if h.libraryService == nil {
+		return uuid.Nil, errNoPodcastLibrary
+	}
+
+	if libraryID, ok := requested.Get(); ok {
+		lib, err := h.libraryService.Get(ctx, libraryID)
+		if err != nil {
+			if errors.Is(err, library.ErrNotFound) {
+				return uuid.Nil, podcast.ErrInvalidLibrary
+			}
+			return uuid.Nil, err
+		}
+		if lib.Type != library.LibraryTypePodcast {
+			return uuid.Nil, podcast.ErrInvalidLibrary
+		}
+		if err := h.checkPodcastLibraryAccess(ctx, userID, lib.ID); err != nil {
+			return uuid.Nil, err
+		}
+		return lib.ID, nil
+	}
+
+	libs, err := h.libraryService.ListByType(ctx, library.LibraryTypePodcast)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, lib := range libs {
+		if !lib.Enabled {
+			continue
+		}
+		err := h.checkPodcastLibraryAccess(ctx, userID, lib.ID)
+		if err == nil {
+			return lib.ID, nil
+		}
+		if !errors.Is(err, errPodcastLibraryDenied) {
+			return uuid.Nil, err
+		}
+	}
+	return uuid.Nil, errNoPodcastLibrary
+}
+
+// checkPodcastLibraryAccess returns errPodcastLibraryDenied unless the
+// caller can access the library.
+func (h *Handler) checkPodcastLibraryAccess(ctx context.Context, userID, libraryID uuid.UUID) error {
+	err := h.checkLibraryAccess(ctx, userID, libraryID)
+	if errors.Is(err, errLibraryDenied) {
+		return errPodcastLibraryDenied
+	}
+	return err
+}
+
+// queuePodcastRefresh queues a feed refresh, which also applies the
+// podcast's retention rules. Failures are logged; the scheduled refresh
+// catches up.
+func (h *Handler) queuePodcastRefresh(ctx context.Context, podcastID uuid.UUID) {
+	if h.riverClient == nil {
+		return
+	}
+	if _, err := h.riverClient.Insert(ctx, podcastjobs.RefreshArgs{PodcastID: podcastID}, nil); err != nil {
+		h.logger.Warn("failed to enqueue podcast refresh",
+			slog.String("podcast_id", podcastID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// progressOf returns the listening state of an episode, or nil.
+func progressOf(progress map[uuid.UUID]podcast.Progress, episodeID uuid.UUID) *podcast.Progress {
+	if p, ok := progress[episodeID]; ok {
+		return &p
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// podcastMockService is a minimal mock for podcast.Service used by the podcast handler tests.
+type podcastMockService struct {
+	podcast.Service
+	podcasts       map[uuid.UUID]*podcast.Podcast
+	episodes       map[uuid.UUID]*podcast.Episode
+	progress       map[uuid.UUID]podcast.Progress
+	transcriptPath string
+	subscribed     []uuid.UUID
+}
+
+func (m *podcastMockService) GetPodcast(_ context.Context, id uuid.UUID) (*podcast.Podcast, error) {
+	if p, ok := m.podcasts[id]; ok {
+		return p, nil
+	}
+	return nil, podcast.ErrPodcastNotFound
+}
+
+func (m *podcastMockService) ListPodcasts(_ context.Context, _ podcast.PodcastListFilters) ([]podcast.Podcast, int64, error) {
+	var result []podcast.Podcast
+	for _, p := range m.podcasts {
+		result = append(result, *p)
+	}
+	return result, int64(len(result)), nil
+}
+
+func (m *podcastMockService) IsSubscribed(_ context.Context, _, _ uuid.UUID) (bool, error) {
+	return true, nil
+}
+
+func (m *podcastMockService) Subscribe(_ context.Context, _, podcastID uuid.UUID) error {
+	m.subscribed = append(m.subscribed, podcastID)
+	return nil
+}
+
+func (m *podcastMockService) GetEpisode(_ context.Context, id uuid.UUID) (*podcast.Episode, error) {
+	if e, ok := m.episodes[id]; ok {
+		return e, nil
+	}
+	return nil, podcast.ErrEpisodeNotFound
+}
+
+func (m *podcastMockService) ListEpisodes(_ context.Context, podcastID uuid.UUID, _, _ int32) ([]podcast.Episode, int64, error) {
+	if _, ok := m.podcasts[podcastID]; !ok {
+		return nil, 0, podcast.ErrPodcastNotFound
+	}
+	var result []podcast.Episode
+	for _, e := range m.episodes {
+		if e.PodcastID == podcastID {
+			result = append(result, *e)
+		}
+	}
+	return result, int64(len(result)), nil
+}
+
+func (m *podcastMockService) ListNewEpisodes(_ context.Context, _ uuid.UUID, _ int32) ([]podcast.InboxItem, error) {
+	var items []podcast.InboxItem
+	for _, e := range m.episodes {
+		items = append(items, podcast.InboxItem{Episode: *e, Podcast: *m.podcasts[e.PodcastID]})
+	}
+	return items, nil
+}
+
+func (m *podcastMockService) ListProgress(_ context.Context, _ uuid.UUID, _ []uuid.UUID) (map[uuid.UUID]podcast.Progress, error) {
+	return m.progress, nil
+}
+
+func (m *podcastMockService) GetProgress(_ context.Context, _, episodeID uuid.UUID) (*podcast.Progress, error) {
+	if p, ok := m.progress[episodeID]; ok {
+		return &p, nil
+	}
+	return nil, podcast.ErrProgressNotFound
+}
+
+func (m *podcastMockService) UpdateProgress(_ context.Context, userID, episodeID uuid.UUID, update podcast.ProgressUpdate) (*podcast.Progress, error) {
+	if update.PositionSeconds < 0 {
+		return nil, fmt.Errorf("%w: position %d", podcast.ErrInvalidProgress, update.PositionSeconds)
+	}
+	if _, ok := m.episodes[episodeID]; !ok {
+		return nil, podcast.ErrEpisodeNotFound
+	}
+	return &podcast.Progress{
+		UserID:          userID,
+		EpisodeID:       episodeID,
+		PositionSeconds: update.PositionSeconds,
+		IsPlayed:        update.Played != nil && *update.Played,
+		LastPlayedAt:    time.Now(),
+	}, nil
+}
+
+func (m *podcastMockService) DeleteProgress(_ context.Context, _, episodeID uuid.UUID) error {
+	delete(m.progress, episodeID)
+	return nil
+}
+
+func (m *podcastMockService) OpenTranscript(_ context.Context, episodeID uuid.UUID) (*podcast.Episode, *os.File, error) {
+	ep, ok := m.episodes[episodeID]
+	if !ok {
+		return nil, nil, podcast.ErrEpisodeNotFound
+	}
+	if ep.TranscriptPath == nil {
+		return nil, nil, podcast.ErrTranscriptNotFound
+	}
+	f, err := os.Open(*ep.TranscriptPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ep, f, nil
+}
+
+// newPodcastMockService returns a podcast with two episodes, one of them
+// downloaded with a WebVTT transcript.
+func newPodcastMockService(t *testing.T) (*podcastMockService, *podcast.Podcast, []*podcast.Episode) {
+	t.Helper()
+
+	transcript := filepath.Join(t.TempDir(), "episode.vtt")
+	require.NoError(t, os.WriteFile(transcript, []byte("WEBVTT\n"), 0o600))
+
+	p := &podcast.Podcast{ID: uuid.New(), LibraryID: uuid.New(), FeedURL: "https://example.com/feed.xml", Title: "The Test Show"}
+	filePath := "/podcasts/the-test-show/episode-2.mp3"
+	vtt := "text/vtt"
+	episodes := []*podcast.Episode{
+		{ID: uuid.New(), PodcastID: p.ID, Title: "Episode 1", EpisodeType: "full", EnclosureURL: "https://example.com/ep1.mp3"},
+		{ID: uuid.New(), PodcastID: p.ID, Title: "Episode 2", EpisodeType: "full", EnclosureURL: "https://example.com/ep2.mp3",
+			FilePath: &filePath, FileSize: 1024, TranscriptPath: &transcript, TranscriptType: &vtt},
+	}
+	svc := &podcastMockService{
+		podcasts: map[uuid.UUID]*podcast.Podcast{p.ID: p},
+		episodes: map[uuid.UUID]*podcast.Episode{episodes[0].ID: episodes[0], episodes[1].ID: episodes[1]},
+		progress: map[uuid.UUID]podcast.Progress{
+			episodes[0].ID: {EpisodeID: episodes[0].ID, PositionSeconds: 120, LastPlayedAt: time.Now()},
+		},
+	}
+	return svc, p, episodes
+}
+
+func TestHandler_GetPodcast(t *testing.T) {
+	t.Parallel()
+
+	svc, p, _ := newPodcastMockService(t)
+	handler := &Handler{logger: logging.NewTestLogger(), podcastService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetPodcast(ctx, ogen.GetPodcastParams{ID: p.ID})
+	require.NoError(t, err)
+	detail, ok := result.(*ogen.PodcastDetail)
+	require.True(t, ok, "expected *ogen.PodcastDetail, got %T", result)
+	assert.Equal(t, "The Test Show", detail.Podcast.Title)
+	assert.True(t, detail.Subscribed)
+	assert.Equal(t, int64(2), detail.EpisodeCount)
+
+	result, err = handler.GetPodcast(ctx, ogen.GetPodcastParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetPodcastNotFound)
+	assert.True(t, ok, "expected *ogen.GetPodcastNotFound, got %T", result)
+}
+
+func TestHandler_ListPodcastEpisodes(t *testing.T) {
+	t.Parallel()
+
+	svc, p, episodes := newPodcastMockService(t)
+	handler := &Handler{logger: logging.NewTestLogger(), podcastService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.ListPodcastEpisodes(ctx, ogen.ListPodcastEpisodesParams{ID: p.ID})
+	require.NoError(t, err)
+	list, ok := result.(*ogen.PodcastEpisodeListResponse)
+	require.True(t, ok, "expected *ogen.PodcastEpisodeListResponse, got %T", result)
+	require.Len(t, list.Items, 2)
+
+	for _, item := range list.Items {
+		switch item.ID {
+		case episodes[0].ID:
+			assert.False(t, item.Downloaded)
+			progress, ok := item.Progress.Get()
+			require.True(t, ok, "listening state is attached")
+			assert.Equal(t, 120, progress.PositionSeconds)
+		case episodes[1].ID:
+			assert.True(t, item.Downloaded)
+			assert.True(t, item.HasTranscript)
+			assert.Equal(t, int64(1024), item.FileSize.Or(0))
+			assert.False(t, item.Progress.IsSet())
+		}
+	}
+
+	result, err = handler.ListPodcastEpisodes(ctx, ogen.ListPodcastEpisodesParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.ListPodcastEpisodesNotFound)
+	assert.True(t, ok, "expected *ogen.ListPodcastEpisodesNotFound, got %T", result)
+}
+
+func TestHandler_ListNewPodcastEpisodes(t *testing.T) {
+	t.Parallel()
+
+	svc, _, _ := newPodcastMockService(t)
+	handler := &Handler{logger: logging.NewTestLogger(), podcastService: svc}
+
+	result, err := handler.ListNewPodcastEpisodes(contextWithUserID(context.Background(), uuid.New()), ogen.ListNewPodcastEpisodesParams{})
+	require.NoError(t, err)
+	items, ok := result.(*ogen.ListNewPodcastEpisodesOKApplicationJSON)
+	require.True(t, ok, "expected *ogen.ListNewPodcastEpisodesOKApplicationJSON, got %T", result)
+	require.Len(t, *items, 2)
+	assert.Equal(t, "The Test Show", (*items)[0].Podcast.Title)
+}
+
+func TestHandler_UpdatePodcastEpisodeProgress(t *testing.T) {
+	t.Parallel()
+
+	svc, _, episodes := newPodcastMockService(t)
+	handler := &Handler{logger: logging.NewTestLogger(), podcastService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.UpdatePodcastEpisodeProgress(ctx, &ogen.PodcastEpisodeProgressUpdate{
+		PositionSeconds: 300,
+		Played:          ogen.NewOptBool(true),
+	}, ogen.UpdatePodcastEpisodeProgressParams{ID: episodes[1].ID})
+	require.NoError(t, err)
+	progress, ok := result.(*ogen.PodcastEpisodeProgress)
+	require.True(t, ok, "expected *ogen.PodcastEpisodeProgress, got %T", result)
+	assert.Equal(t, 300, progress.PositionSeconds)
+	assert.True(t, progress.IsPlayed)
+
+	result, err = handler.UpdatePodcastEpisodeProgress(ctx, &ogen.PodcastEpisodeProgressUpdate{PositionSeconds: -1},
+		ogen.UpdatePodcastEpisodeProgressParams{ID: episodes[1].ID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UpdatePodcastEpisodeProgressBadRequest)
+	assert.True(t, ok, "expected *ogen.UpdatePodcastEpisodeProgressBadRequest, got %T", result)
+
+	result, err = handler.UpdatePodcastEpisodeProgress(ctx, &ogen.PodcastEpisodeProgressUpdate{PositionSeconds: 10},
+		ogen.UpdatePodcastEpisodeProgressParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UpdatePodcastEpisodeProgressNotFound)
+	assert.True(t, ok, "expected *ogen.UpdatePodcastEpisodeProgressNotFound, got %T", result)
+}
+
+func TestHandler_GetPodcastEpisodeTranscript(t *testing.T) {
+	t.Parallel()
+
+	svc, _, episodes := newPodcastMockService(t)
+	handler := &Handler{logger: logging.NewTestLogger(), podcastService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetPodcastEpisodeTranscript(ctx, ogen.GetPodcastEpisodeTranscriptParams{ID: episodes[1].ID})
+	require.NoError(t, err)
+	vtt, ok := result.(*ogen.GetPodcastEpisodeTranscriptOKTextVtt)
+	require.True(t, ok, "expected *ogen.GetPodcastEpisodeTranscriptOKTextVtt, got %T", result)
+	data, err := io.ReadAll(vtt.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "WEBVTT\n", string(data))
+	require.NoError(t, vtt.Data.(io.Closer).Close())
+
+	result, err = handler.GetPodcastEpisodeTranscript(ctx, ogen.GetPodcastEpisodeTranscriptParams{ID: episodes[0].ID})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetPodcastEpisodeTranscriptNotFound)
+	assert.True(t, ok, "expected *ogen.GetPodcastEpisodeTranscriptNotFound, got %T", result)
+}
+
+func TestHandler_SubscribePodcastFeed_BadRequest(t *testing.T) {
+	t.Parallel()
+
+	svc, _, _ := newPodcastMockService(t)
+	handler := &Handler{logger: logging.NewTestLogger(), podcastService: svc}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	tests := []struct {
+		name    string
+		feedURL string
+	}{
+		{name: "invalid url", feedURL: "ftp://example.com/feed.xml"},
+		{name: "no podcast library", feedURL: "https://example.com/feed.xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler.SubscribePodcastFeed(ctx, &ogen.PodcastSubscribeRequest{FeedURL: tt.feedURL})
+			require.NoError(t, err)
+			_, ok := result.(*ogen.SubscribePodcastFeedBadRequest)
+			assert.True(t, ok, "expected *ogen.SubscribePodcastFeedBadRequest, got %T", result)
+		})
+	}
+}
+
+func TestHandler_SubscribePodcast_LibraryAccess(t *testing.T) {
+	t.Parallel()
+
+	svc, p, _ := newPodcastMockService(t)
+	userID := uuid.New()
+	granted := library.Library{ID: p.LibraryID, Name: "Podcasts", Type: library.LibraryTypePodcast}
+	hidden := library.Library{ID: uuid.New(), Name: "Private", Type: library.LibraryTypePodcast}
+	private := &podcast.Podcast{ID: uuid.New(), LibraryID: hidden.ID, FeedURL: "https://example.com/private.xml", Title: "Private Show"}
+	svc.podcasts[private.ID] = private
+	handler := &Handler{
+		logger:         logging.NewTestLogger(),
+		podcastService: svc,
+		libraryService: newStubLibraryService(
+			[]library.Library{granted, hidden},
+			map[uuid.UUID][]uuid.UUID{userID: {granted.ID}},
+		),
+	}
+	ctx := contextWithUserID(context.Background(), userID)
+
+	result, err := handler.SubscribePodcast(ctx, ogen.SubscribePodcastParams{ID: p.ID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.SubscribePodcastNoContent{}, result)
+
+	result, err = handler.SubscribePodcast(ctx, ogen.SubscribePodcastParams{ID: private.ID})
+	require.NoError(t, err)
+	assert.IsType(t, &ogen.SubscribePodcastNotFound{}, result)
+	assert.Equal(t, []uuid.UUID{p.ID}, svc.subscribed)
+}
+
+func TestHandler_Podcast_ServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	list, err := handler.ListPodcasts(ctx, ogen.ListPodcastsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, list.(*ogen.PodcastListResponse).Items)
+
+	result, err := handler.GetPodcastEpisode(ctx, ogen.GetPodcastEpisodeParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok := result.(*ogen.GetPodcastEpisodeNotFound)
+	assert.True(t, ok)
+
+	inbox, err := handler.ListNewPodcastEpisodes(ctx, ogen.ListNewPodcastEpisodesParams{})
+	require.NoError(t, err)
+	assert.Empty(t, *inbox.(*ogen.ListNewPodcastEpisodesOKApplicationJSON))
+}
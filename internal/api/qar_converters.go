@@ -0,0 +1,102 @@
+package api
+
+import (
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/qar"
+)
+
+func qarAccessToOgen(s *qar.AccessStatus) *ogen.LegacyAccessStatus {
+	result := &ogen.LegacyAccessStatus{
+		Granted:     s.Granted,
+		PinRequired: s.PINRequired,
+		PinSet:      s.PINSet,
+		Accessible:  s.Accessible(),
+	}
+	setOpt(&result.UnlockedUntil, s.UnlockedUntil)
+	return result
+}
+
+func qarSceneToOgen(s *qar.Scene) ogen.LegacyScene {
+	result := ogen.LegacyScene{
+		ID:          s.ID,
+		LibraryID:   s.LibraryID,
+		Title:       s.Title,
+		FileSize:    s.FileSize,
+		MatchStatus: ogen.LegacySceneMatchStatus(s.MatchStatus),
+		CreatedAt:   s.CreatedAt,
+	}
+	setOpt(&result.Details, s.Details)
+	setOpt(&result.ReleaseDate, s.ReleaseDate)
+	setOptConv(&result.DurationSeconds, s.DurationSeconds, int32ToInt)
+	setOpt(&result.ImageURL, s.ImageURL)
+	if s.OSHash != "" {
+		result.Oshash = ogen.NewOptString(s.OSHash)
+	}
+	setOpt(&result.StashdbID, s.StashDBID)
+	setOptConv(&result.MatchType, s.MatchType, func(t qar.MatchType) ogen.LegacySceneMatchType {
+		return ogen.LegacySceneMatchType(t)
+	})
+	setOpt(&result.MatchConfidence, s.MatchConfidence)
+	setOpt(&result.StudioID, s.StudioID)
+	if s.Studio != nil {
+		result.Studio = ogen.NewOptLegacyStudio(qarStudioToOgen(s.Studio))
+	}
+	if s.Performers != nil {
+		result.Performers = qarPerformersToOgen(s.Performers)
+	}
+	return result
+}
+
+func qarScenesToOgen(scenes []qar.Scene) []ogen.LegacyScene {
+	result := make([]ogen.LegacyScene, len(scenes))
+	for i := range scenes {
+		result[i] = qarSceneToOgen(&scenes[i])
+	}
+	return result
+}
+
+func qarPerformerToOgen(p *qar.Performer) ogen.LegacyPerformer {
+	result := ogen.LegacyPerformer{
+		ID:         p.ID,
+		Name:       p.Name,
+		Aliases:    p.Aliases,
+		SceneCount: p.SceneCount,
+	}
+	if result.Aliases == nil {
+		result.Aliases = []string{}
+	}
+	setOpt(&result.StashdbID, p.StashDBID)
+	setOpt(&result.Disambiguation, p.Disambiguation)
+	setOpt(&result.Gender, p.Gender)
+	setOpt(&result.Country, p.Country)
+	setOpt(&result.ImageURL, p.ImageURL)
+	return result
+}
+
+func qarPerformersToOgen(performers []qar.Performer) []ogen.LegacyPerformer {
+	result := make([]ogen.LegacyPerformer, len(performers))
+	for i := range performers {
+		result[i] = qarPerformerToOgen(&performers[i])
+	}
+	return result
+}
+
+func qarStudioToOgen(s *qar.Studio) ogen.LegacyStudio {
+	result := ogen.LegacyStudio{
+		ID:         s.ID,
+		Name:       s.Name,
+		SceneCount: s.SceneCount,
+	}
+	setOpt(&result.StashdbID, s.StashDBID)
+	setOpt(&result.ParentID, s.ParentID)
+	setOpt(&result.ImageURL, s.ImageURL)
+	return result
+}
+
+func qarStudiosToOgen(studios []qar.Studio) []ogen.LegacyStudio {
+	result := make([]ogen.LegacyStudio, len(studios))
+	for i := range studios {
+		result[i] = qarStudioToOgen(&studios[i])
+	}
+	return result
+}
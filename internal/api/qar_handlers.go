@@ -0,0 +1,358 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/api/middleware"
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/qar"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Handlers of the QAR module. While the module is disabled every endpoint
+// reports not found, as if it did not exist. Scenes outside the caller's
+// adult libraries are not found either.
+
+// GetLegacyAccess returns whether the caller may access QAR content.
+func (h *Handler) GetLegacyAccess(ctx context.Context) (ogen.GetLegacyAccessRes, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.GetLegacyAccessNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	status, err := h.qarService.GetAccess(ctx, userID)
+	if err != nil {
+		if errors.Is(err, qar.ErrUserNotFound) {
+			return (*ogen.GetLegacyAccessNotFound)(OgenNotFound("User not found")), nil
+		}
+		return nil, err
+	}
+	return qarAccessToOgen(status), nil
+}
+
+// SetLegacyPIN sets the caller's PIN.
+func (h *Handler) SetLegacyPIN(ctx context.Context, req *ogen.LegacyPINRequest) (ogen.SetLegacyPINRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.SetLegacyPINNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	status, err := h.qarService.SetPIN(ctx, viewer, req.CurrentPin.Or(""), req.NewPin)
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.SetLegacyPINForbidden)(forbidden), nil
+		}
+		if errors.Is(err, qar.ErrPINFormat) {
+			return (*ogen.SetLegacyPINBadRequest)(OgenBadRequest("PIN must be 4 to 8 digits")), nil
+		}
+		if errors.Is(err, qar.ErrUserNotFound) {
+			return (*ogen.SetLegacyPINNotFound)(OgenNotFound("User not found")), nil
+		}
+		return nil, err
+	}
+	return qarAccessToOgen(status), nil
+}
+
+// UnlockLegacy unlocks QAR content with the caller's PIN.
+func (h *Handler) UnlockLegacy(ctx context.Context, req *ogen.LegacyUnlockRequest) (ogen.UnlockLegacyRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.UnlockLegacyNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	status, err := h.qarService.Unlock(ctx, viewer, req.Pin)
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.UnlockLegacyForbidden)(forbidden), nil
+		}
+		if errors.Is(err, qar.ErrUserNotFound) {
+			return (*ogen.UnlockLegacyNotFound)(OgenNotFound("User not found")), nil
+		}
+		return nil, err
+	}
+	return qarAccessToOgen(status), nil
+}
+
+// LockLegacy locks QAR content before the unlock expires.
+func (h *Handler) LockLegacy(ctx context.Context) (ogen.LockLegacyRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.LockLegacyNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	status, err := h.qarService.Lock(ctx, viewer)
+	if err != nil {
+		if errors.Is(err, qar.ErrUserNotFound) {
+			return (*ogen.LockLegacyNotFound)(OgenNotFound("User not found")), nil
+		}
+		return nil, err
+	}
+	return qarAccessToOgen(status), nil
+}
+
+// ListLegacyScenes returns a paginated list of scenes from the caller's
+// adult libraries.
+func (h *Handler) ListLegacyScenes(ctx context.Context, params ogen.ListLegacyScenesParams) (ogen.ListLegacyScenesRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.ListLegacyScenesNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	scenes, total, err := h.qarService.ListScenes(ctx, viewer, qar.SceneListFilters{
+		LibraryIDs:  libraryIDs,
+		LibraryID:   optPtr[uuid.UUID](params.LibraryID),
+		StudioID:    optPtr[uuid.UUID](params.StudioID),
+		PerformerID: optPtr[uuid.UUID](params.PerformerID),
+		MatchStatus: qar.MatchStatus(params.MatchStatus.Or("")),
+		SortBy:      string(params.Sort.Or(ogen.ListLegacyScenesSortAdded)),
+		Limit:       util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:      util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.ListLegacyScenesForbidden)(forbidden), nil
+		}
+		return nil, err
+	}
+
+	return &ogen.LegacySceneListResponse{
+		Items: qarScenesToOgen(scenes),
+		Total: total,
+	}, nil
+}
+
+// GetLegacyScene returns a scene with its studio and performers.
+func (h *Handler) GetLegacyScene(ctx context.Context, params ogen.GetLegacySceneParams) (ogen.GetLegacySceneRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.GetLegacySceneNotFound)(OgenNotFound("Scene not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	scene, err := h.qarService.GetScene(ctx, viewer, params.ID, libraryIDs)
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.GetLegacySceneForbidden)(forbidden), nil
+		}
+		if errors.Is(err, qar.ErrSceneNotFound) {
+			return (*ogen.GetLegacySceneNotFound)(OgenNotFound("Scene not found")), nil
+		}
+		return nil, err
+	}
+	result := qarSceneToOgen(scene)
+	return &result, nil
+}
+
+// DownloadLegacySceneFile streams the video file of a scene. The download
+// is named after the scene, so the file name does not leak.
+func (h *Handler) DownloadLegacySceneFile(ctx context.Context, params ogen.DownloadLegacySceneFileParams) (ogen.DownloadLegacySceneFileRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.DownloadLegacySceneFileNotFound)(OgenNotFound("Scene not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	scene, f, err := h.qarService.OpenSceneFile(ctx, viewer, params.ID, libraryIDs)
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.DownloadLegacySceneFileForbidden)(forbidden), nil
+		}
+		if errors.Is(err, qar.ErrSceneNotFound) {
+			return (*ogen.DownloadLegacySceneFileNotFound)(OgenNotFound("Scene not found")), nil
+		}
+		return nil, err
+	}
+
+	// The response encoder closes the file once it is sent
+	return &ogen.DownloadLegacySceneFileOKHeaders{
+		ContentDisposition: ogen.NewOptString(mime.FormatMediaType("attachment",
+			map[string]string{"filename": scene.ID.String() + filepath.Ext(scene.FilePath)})),
+		Response: ogen.DownloadLegacySceneFileOK{Data: f},
+	}, nil
+}
+
+// ListLegacyPerformers returns the performers of the caller's adult
+// libraries.
+func (h *Handler) ListLegacyPerformers(ctx context.Context, params ogen.ListLegacyPerformersParams) (ogen.ListLegacyPerformersRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.ListLegacyPerformersNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	performers, total, err := h.qarService.ListPerformers(ctx, viewer, qar.ListFilters{
+		LibraryIDs: libraryIDs,
+		Limit:      util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.ListLegacyPerformersForbidden)(forbidden), nil
+		}
+		return nil, err
+	}
+
+	return &ogen.LegacyPerformerListResponse{
+		Items: qarPerformersToOgen(performers),
+		Total: total,
+	}, nil
+}
+
+// GetLegacyPerformer returns a performer of the caller's adult libraries.
+func (h *Handler) GetLegacyPerformer(ctx context.Context, params ogen.GetLegacyPerformerParams) (ogen.GetLegacyPerformerRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.GetLegacyPerformerNotFound)(OgenNotFound("Performer not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	performer, err := h.qarService.GetPerformer(ctx, viewer, params.ID, libraryIDs)
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.GetLegacyPerformerForbidden)(forbidden), nil
+		}
+		if errors.Is(err, qar.ErrPerformerNotFound) {
+			return (*ogen.GetLegacyPerformerNotFound)(OgenNotFound("Performer not found")), nil
+		}
+		return nil, err
+	}
+	result := qarPerformerToOgen(performer)
+	return &result, nil
+}
+
+// ListLegacyStudios returns the studios of the caller's adult libraries.
+func (h *Handler) ListLegacyStudios(ctx context.Context, params ogen.ListLegacyStudiosParams) (ogen.ListLegacyStudiosRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.ListLegacyStudiosNotFound)(OgenNotFound("Not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	studios, total, err := h.qarService.ListStudios(ctx, viewer, qar.ListFilters{
+		LibraryIDs: libraryIDs,
+		Limit:      util.SafeIntToInt32(params.Limit.Or(50)),
+		Offset:     util.SafeIntToInt32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.ListLegacyStudiosForbidden)(forbidden), nil
+		}
+		return nil, err
+	}
+
+	return &ogen.LegacyStudioListResponse{
+		Items: qarStudiosToOgen(studios),
+		Total: total,
+	}, nil
+}
+
+// GetLegacyStudio returns a studio of the caller's adult libraries.
+func (h *Handler) GetLegacyStudio(ctx context.Context, params ogen.GetLegacyStudioParams) (ogen.GetLegacyStudioRes, error) {
+	viewer, err := qarViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.qarService == nil {
+		return (*ogen.GetLegacyStudioNotFound)(OgenNotFound("Studio not found")), nil
+	}
+
+	libraryIDs, err := h.accessibleLibraryIDs(ctx, viewer.UserID, library.LibraryTypeAdult)
+	if err != nil {
+		return nil, err
+	}
+	studio, err := h.qarService.GetStudio(ctx, viewer, params.ID, libraryIDs)
+	if err != nil {
+		if forbidden := qarForbidden(err); forbidden != nil {
+			return (*ogen.GetLegacyStudioForbidden)(forbidden), nil
+		}
+		if errors.Is(err, qar.ErrStudioNotFound) {
+			return (*ogen.GetLegacyStudioNotFound)(OgenNotFound("Studio not found")), nil
+		}
+		return nil, err
+	}
+	result := qarStudioToOgen(studio)
+	return &result, nil
+}
+
+// qarViewer returns the caller with the request metadata for the audit log.
+func qarViewer(ctx context.Context) (qar.Viewer, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return qar.Viewer{}, err
+	}
+	meta := middleware.GetRequestMetadata(ctx)
+	return qar.Viewer{UserID: userID, IPAddress: meta.IPAddress, UserAgent: meta.UserAgent}, nil
+}
+
+// qarForbidden returns the response for errors of the QAR access checks,
+// or nil for other errors.
+func qarForbidden(err error) *ogen.Error {
+	switch {
+	case errors.Is(err, qar.ErrAccessDenied):
+		return OgenForbidden("QAR access has not been granted")
+	case errors.Is(err, qar.ErrPINNotSet):
+		return OgenForbidden("A PIN must be set to access this content")
+	case errors.Is(err, qar.ErrLocked):
+		return OgenForbidden("Content is locked")
+	case errors.Is(err, qar.ErrInvalidPIN):
+		return OgenForbidden("Invalid PIN")
+	case errors.Is(err, qar.ErrPINLockedOut):
+		return OgenForbidden("Too many invalid PINs, try again later")
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/content/qar"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+// qarMockService is a minimal mock for qar.Service used by the QAR handler tests.
+type qarMockService struct {
+	qar.Service
+	scenes    map[uuid.UUID]*qar.Scene
+	accessErr error
+	filters   *qar.SceneListFilters
+	viewer    *qar.Viewer
+	pin       string
+}
+
+func (m *qarMockService) GetAccess(_ context.Context, _ uuid.UUID) (*qar.AccessStatus, error) {
+	until := time.Date(2024, 6, 30, 12, 30, 0, 0, time.UTC)
+	return &qar.AccessStatus{Granted: true, PINRequired: true, PINSet: true, UnlockedUntil: &until}, nil
+}
+
+func (m *qarMockService) Unlock(_ context.Context, v qar.Viewer, pin string) (*qar.AccessStatus, error) {
+	m.viewer = &v
+	if pin != m.pin {
+		return nil, qar.ErrInvalidPIN
+	}
+	until := time.Now().Add(qar.UnlockDuration)
+	return &qar.AccessStatus{Granted: true, PINRequired: true, PINSet: true, UnlockedUntil: &until}, nil
+}
+
+func (m *qarMockService) ListScenes(_ context.Context, v qar.Viewer, filters qar.SceneListFilters) ([]qar.Scene, int64, error) {
+	m.viewer = &v
+	m.filters = &filters
+	if m.accessErr != nil {
+		return nil, 0, m.accessErr
+	}
+	var scenes []qar.Scene
+	for _, s := range m.scenes {
+		scenes = append(scenes, *s)
+	}
+	return scenes, int64(len(scenes)), nil
+}
+
+func (m *qarMockService) GetScene(_ context.Context, v qar.Viewer, id uuid.UUID, _ []uuid.UUID) (*qar.Scene, error) {
+	m.viewer = &v
+	if m.accessErr != nil {
+		return nil, m.accessErr
+	}
+	if s, ok := m.scenes[id]; ok {
+		return s, nil
+	}
+	return nil, qar.ErrSceneNotFound
+}
+
+func newQARMockService(sceneID uuid.UUID) *qarMockService {
+	studioID := uuid.New()
+	matchType := qar.MatchTypeFingerprint
+	return &qarMockService{
+		pin: "1234",
+		scenes: map[uuid.UUID]*qar.Scene{
+			sceneID: {
+				ID: sceneID, LibraryID: uuid.New(), FilePath: "/adult/Studio.Scene.mp4", FileSize: 1024,
+				OSHash: "0000000000020000", Title: "Scene Title", MatchStatus: qar.MatchStatusMatched,
+				MatchType: &matchType, StudioID: &studioID, DurationSeconds: new(int32(1800)),
+				Studio:     &qar.Studio{ID: studioID, Name: "Studio", SceneCount: 1},
+				Performers: []qar.Performer{{ID: uuid.New(), Name: "Jane Doe"}},
+			},
+		},
+	}
+}
+
+func TestHandler_GetLegacyScene(t *testing.T) {
+	t.Parallel()
+
+	sceneID := uuid.New()
+	handler := &Handler{logger: logging.NewTestLogger(), qarService: newQARMockService(sceneID)}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.GetLegacyScene(ctx, ogen.GetLegacySceneParams{ID: sceneID})
+	require.NoError(t, err)
+	s, ok := result.(*ogen.LegacyScene)
+	require.True(t, ok, "expected *ogen.LegacyScene, got %T", result)
+	assert.Equal(t, "Scene Title", s.Title)
+	assert.Equal(t, ogen.LegacySceneMatchTypeFingerprint, s.MatchType.Or(""))
+	assert.Equal(t, 1800, s.DurationSeconds.Or(0))
+	assert.Equal(t, "Studio", s.Studio.Value.Name)
+	require.Len(t, s.Performers, 1)
+	assert.Equal(t, []string{}, s.Performers[0].Aliases)
+
+	result, err = handler.GetLegacyScene(ctx, ogen.GetLegacySceneParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.GetLegacySceneNotFound)
+	assert.True(t, ok, "expected *ogen.GetLegacySceneNotFound, got %T", result)
+}
+
+func TestHandler_LegacyScenes_Forbidden(t *testing.T) {
+	t.Parallel()
+
+	for _, accessErr := range []error{qar.ErrAccessDenied, qar.ErrPINNotSet, qar.ErrLocked} {
+		svc := newQARMockService(uuid.New())
+		svc.accessErr = accessErr
+		handler := &Handler{logger: logging.NewTestLogger(), qarService: svc}
+		ctx := contextWithUserID(context.Background(), uuid.New())
+
+		list, err := handler.ListLegacyScenes(ctx, ogen.ListLegacyScenesParams{})
+		require.NoError(t, err)
+		forbidden, ok := list.(*ogen.ListLegacyScenesForbidden)
+		require.True(t, ok, "expected *ogen.ListLegacyScenesForbidden, got %T", list)
+		assert.Equal(t, 403, forbidden.Code)
+
+		scene, err := handler.GetLegacyScene(ctx, ogen.GetLegacySceneParams{ID: uuid.New()})
+		require.NoError(t, err)
+		_, ok = scene.(*ogen.GetLegacySceneForbidden)
+		assert.True(t, ok, "expected *ogen.GetLegacySceneForbidden, got %T", scene)
+	}
+}
+
+func TestHandler_ListLegacyScenes_Filters(t *testing.T) {
+	t.Parallel()
+
+	svc := newQARMockService(uuid.New())
+	handler := &Handler{logger: logging.NewTestLogger(), qarService: svc}
+	userID := uuid.New()
+	studioID := uuid.New()
+
+	result, err := handler.ListLegacyScenes(contextWithUserID(context.Background(), userID), ogen.ListLegacyScenesParams{
+		StudioID:    ogen.NewOptUUID(studioID),
+		MatchStatus: ogen.NewOptListLegacyScenesMatchStatus(ogen.ListLegacyScenesMatchStatusMatched),
+		Sort:        ogen.NewOptListLegacyScenesSort(ogen.ListLegacyScenesSortReleased),
+		Limit:       ogen.NewOptInt(20),
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.(*ogen.LegacySceneListResponse).Items, 1)
+
+	require.NotNil(t, svc.filters)
+	assert.Nil(t, svc.filters.LibraryIDs, "all libraries without library permissions")
+	assert.Equal(t, &studioID, svc.filters.StudioID)
+	assert.Equal(t, qar.MatchStatusMatched, svc.filters.MatchStatus)
+	assert.Equal(t, qar.SortByReleased, svc.filters.SortBy)
+	assert.Equal(t, int32(20), svc.filters.Limit)
+	assert.Equal(t, userID, svc.viewer.UserID)
+}
+
+func TestHandler_UnlockLegacy(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger(), qarService: newQARMockService(uuid.New())}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	result, err := handler.UnlockLegacy(ctx, &ogen.LegacyUnlockRequest{Pin: "1234"})
+	require.NoError(t, err)
+	status, ok := result.(*ogen.LegacyAccessStatus)
+	require.True(t, ok, "expected *ogen.LegacyAccessStatus, got %T", result)
+	assert.True(t, status.Accessible)
+	assert.True(t, status.UnlockedUntil.IsSet())
+
+	result, err = handler.UnlockLegacy(ctx, &ogen.LegacyUnlockRequest{Pin: "0000"})
+	require.NoError(t, err)
+	_, ok = result.(*ogen.UnlockLegacyForbidden)
+	assert.True(t, ok, "expected *ogen.UnlockLegacyForbidden, got %T", result)
+}
+
+func TestHandler_Legacy_Disabled(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+	ctx := contextWithUserID(context.Background(), uuid.New())
+
+	access, err := handler.GetLegacyAccess(ctx)
+	require.NoError(t, err)
+	_, ok := access.(*ogen.GetLegacyAccessNotFound)
+	assert.True(t, ok)
+
+	list, err := handler.ListLegacyScenes(ctx, ogen.ListLegacyScenesParams{})
+	require.NoError(t, err)
+	_, ok = list.(*ogen.ListLegacyScenesNotFound)
+	assert.True(t, ok)
+
+	file, err := handler.DownloadLegacySceneFile(ctx, ogen.DownloadLegacySceneFileParams{ID: uuid.New()})
+	require.NoError(t, err)
+	_, ok = file.(*ogen.DownloadLegacySceneFileNotFound)
+	assert.True(t, ok)
+}
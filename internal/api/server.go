@@ -12,9 +12,19 @@ import (
 	openapidoc "github.com/lusoris/revenge/api/openapi"
 	"github.com/lusoris/revenge/internal/api/middleware"
 	"github.com/lusoris/revenge/internal/api/ogen"
+	"github.com/lusoris/revenge/internal/api/opds"
 	"github.com/lusoris/revenge/internal/api/sse"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/livetv"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/photo"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/content/qar"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/infra/cache"
 	"github.com/lusoris/revenge/internal/infra/health"
@@ -27,12 +37,17 @@ import (
 	"github.com/lusoris/revenge/internal/playback/hls"
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/auth"
+	"github.com/lusoris/revenge/internal/service/homefeed"
 	"github.com/lusoris/revenge/internal/service/library"
 	"github.com/lusoris/revenge/internal/service/metadata"
+	"github.com/lusoris/revenge/internal/service/metadatahistory"
 	"github.com/lusoris/revenge/internal/service/mfa"
 	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/service/oidc"
+	"github.com/lusoris/revenge/internal/service/parental"
+	"github.com/lusoris/revenge/internal/service/people"
 	"github.com/lusoris/revenge/internal/service/rbac"
 	"github.com/lusoris/revenge/internal/service/search"
 	"github.com/lusoris/revenge/internal/service/session"
@@ -71,24 +86,27 @@ type Server struct {
 type ServerParams struct {
 	fx.In
 
-	Config               *config.Config
-	Logger               *slog.Logger
-	HealthService        *health.Service
-	SettingsService      settings.Service
-	UserService          *user.CachedService
-	AuthService          *auth.Service
-	SessionService       *session.Service
-	RBACService          *rbac.Service
-	APIKeyService        apikeys.Service
-	OIDCService          *oidc.Service
-	ActivityService      *activity.Service
-	LibraryService       *library.CachedService
-	SearchService        *search.MovieSearchService   `optional:"true"`
-	TVShowSearchService  *search.TVShowSearchService  `optional:"true"`
-	EpisodeSearchService *search.EpisodeSearchService `optional:"true"`
-	SeasonSearchService  *search.SeasonSearchService  `optional:"true"`
-	PersonSearchService  *search.PersonSearchService  `optional:"true"`
-	TokenManager         auth.TokenManager
+	Config                 *config.Config
+	Logger                 *slog.Logger
+	HealthService          *health.Service
+	SettingsService        settings.Service
+	UserService            *user.CachedService
+	AuthService            *auth.Service
+	SessionService         *session.Service
+	RBACService            *rbac.Service
+	APIKeyService          apikeys.Service
+	OIDCService            *oidc.Service
+	ActivityService        *activity.Service
+	LibraryService         *library.CachedService
+	MatchQueueService      *library.MatchQueueService
+	SearchService          *search.MovieSearchService     `optional:"true"`
+	TVShowSearchService    *search.TVShowSearchService    `optional:"true"`
+	EpisodeSearchService   *search.EpisodeSearchService   `optional:"true"`
+	SeasonSearchService    *search.SeasonSearchService    `optional:"true"`
+	PersonSearchService    *search.PersonSearchService    `optional:"true"`
+	MusicSearchService     *search.MusicSearchService     `optional:"true"`
+	HomeVideoSearchService *search.HomeVideoSearchService `optional:"true"`
+	TokenManager           auth.TokenManager
 	// Cache client for Redis-based rate limiting
 	CacheClient *cache.Client `optional:"true"`
 	// MFA services
@@ -97,15 +115,35 @@ type ServerParams struct {
 	MFAManager         *mfa.MFAManager
 	WebAuthnService    *mfa.WebAuthnService `optional:"true"`
 	// Content modules
-	MovieHandler    *movie.Handler
-	MetadataService metadata.Service `optional:"true"`
-	ImageService    *image.Service
-	TVShowService   tvshow.Service `optional:"true"`
+	MovieHandler      *movie.Handler
+	MovieLibrary      *movie.LibraryService
+	MetadataService   metadata.Service `optional:"true"`
+	ImageService      *image.Service
+	ArtworkService    *artwork.Service   `optional:"true"`
+	PeopleService     *people.Service    `optional:"true"`
+	TVShowService     tvshow.Service     `optional:"true"`
+	MusicService      music.Service      `optional:"true"`
+	AudiobookService  audiobook.Service  `optional:"true"`
+	BookService       book.Service       `optional:"true"`
+	PhotoService      photo.Service      `optional:"true"`
+	HomeVideoService  homevideo.Service  `optional:"true"`
+	MusicVideoService musicvideo.Service `optional:"true"`
+	PodcastService    podcast.Service    `optional:"true"`
+	QarService        qar.Service        `optional:"true"`
+	LiveTVService     livetv.Service     `optional:"true"`
+	// Home feed across the content modules (optional)
+	HomeFeedService *homefeed.Service `optional:"true"`
+	// Parental controls (optional)
+	ParentalService *parental.Service `optional:"true"`
+	// Metadata change history (optional)
+	MetadataHistory *metadatahistory.Service `optional:"true"`
 	// Playback / HLS streaming (optional)
 	PlaybackService *playback.Service  `optional:"true"`
 	StreamHandler   *hls.StreamHandler `optional:"true"`
 	// SSE real-time events (optional)
 	SSEHandler *sse.Handler `optional:"true"`
+	// OPDS catalog for e-reader apps (optional)
+	OPDSHandler *opds.Handler `optional:"true"`
 	// Integration services (optional)
 	RadarrService       *radarr.SyncService  `optional:"true"`
 	SonarrService       *sonarr.SyncService  `optional:"true"`
@@ -127,29 +165,51 @@ func NewServer(p ServerParams) (*Server, error) {
 
 	// Create the handler implementation
 	handler := &Handler{
-		logger:               p.Logger.With("component", "api"),
-		cfg:                  p.Config,
-		healthService:        p.HealthService,
-		userService:          p.UserService,
-		settingsService:      p.SettingsService,
-		authService:          p.AuthService,
-		sessionService:       p.SessionService,
-		rbacService:          p.RBACService,
-		apikeyService:        p.APIKeyService,
-		oidcService:          p.OIDCService,
-		activityService:      p.ActivityService,
-		libraryService:       p.LibraryService,
-		searchService:        p.SearchService,
-		tvshowSearchService:  p.TVShowSearchService,
-		episodeSearchService: p.EpisodeSearchService,
-		seasonSearchService:  p.SeasonSearchService,
-		personSearchService:  p.PersonSearchService,
-		tokenManager:         p.TokenManager,
-		mfaHandler:           mfaHandler,
-		movieHandler:         p.MovieHandler,
-		metadataService:      p.MetadataService,
-		imageService:         p.ImageService,
-		tvshowService:        p.TVShowService,
+		logger:                 p.Logger.With("component", "api"),
+		cfg:                    p.Config,
+		healthService:          p.HealthService,
+		userService:            p.UserService,
+		settingsService:        p.SettingsService,
+		authService:            p.AuthService,
+		sessionService:         p.SessionService,
+		rbacService:            p.RBACService,
+		apikeyService:          p.APIKeyService,
+		oidcService:            p.OIDCService,
+		activityService:        p.ActivityService,
+		libraryService:         p.LibraryService,
+		searchService:          p.SearchService,
+		tvshowSearchService:    p.TVShowSearchService,
+		episodeSearchService:   p.EpisodeSearchService,
+		seasonSearchService:    p.SeasonSearchService,
+		personSearchService:    p.PersonSearchService,
+		musicSearchService:     p.MusicSearchService,
+		homeVideoSearchService: p.HomeVideoSearchService,
+		tokenManager:           p.TokenManager,
+		mfaHandler:             mfaHandler,
+		movieHandler:           p.MovieHandler,
+		metadataService:        p.MetadataService,
+		imageService:           p.ImageService,
+		artworkService:         p.ArtworkService,
+		peopleService:          p.PeopleService,
+		parentalService:        p.ParentalService,
+		tvshowService:          p.TVShowService,
+		musicService:           p.MusicService,
+		audiobookService:       p.AudiobookService,
+		bookService:            p.BookService,
+		photoService:           p.PhotoService,
+		homeVideoService:       p.HomeVideoService,
+		musicVideoService:      p.MusicVideoService,
+		qarService:             p.QarService,
+		liveTVService:          p.LiveTVService,
+		homeFeedService:        p.HomeFeedService,
+		podcastService:         p.PodcastService,
+		matchQueueService:      p.MatchQueueService,
+		movieMatchService:      p.MovieLibrary,
+	}
+
+	// Wire up optional metadata history
+	if p.MetadataHistory != nil {
+		handler.metadataHistory = p.MetadataHistory
 	}
 
 	// Wire up optional playback service
@@ -321,6 +381,11 @@ func NewServer(p ServerParams) (*Server, error) {
 	if p.SSEHandler != nil {
 		mux.Handle("GET /api/v1/events", p.SSEHandler)
 	}
+	// OPDS feeds are Atom/JSON documents with their own auth (Basic for e-readers)
+	if p.OPDSHandler != nil {
+		mux.Handle(opds.PathPrefix, p.OPDSHandler)
+		mux.Handle(opds.PathPrefix+"/", p.OPDSHandler)
+	}
 	// SvelteKit SPA: serve embedded frontend assets for non-API paths.
 	// Only active when built with -tags frontend (otherwise web.NewSPAHandler returns nil).
 	if spaHandler := web.NewSPAHandler(); spaHandler != nil {
@@ -21,6 +21,7 @@ func seriesToOgen(s *tvshow.Series) *ogen.TVSeries {
 	setOpt(&o.ImdbID, s.IMDbID)
 	setOptConv(&o.SonarrID, s.SonarrID, int32ToInt)
 	setOpt(&o.OriginalTitle, s.OriginalTitle)
+	setOpt(&o.SortTitle, s.SortTitle)
 	if s.OriginalLanguage != "" {
 		o.OriginalLanguage.SetTo(s.OriginalLanguage)
 	}
@@ -35,11 +36,17 @@ func seriesToOgen(s *tvshow.Series) *ogen.TVSeries {
 	setOptDecimalFloat32(&o.Popularity, s.Popularity)
 	setOpt(&o.PosterPath, s.PosterPath)
 	setOpt(&o.BackdropPath, s.BackdropPath)
+	setOpt(&o.PosterPlaceholder, imagePlaceholderToOgen(s.ImagePlaceholders.For(s.PosterPath)))
+	setOpt(&o.BackdropPlaceholder, imagePlaceholderToOgen(s.ImagePlaceholders.For(s.BackdropPath)))
 	setOpt(&o.TrailerURL, s.TrailerURL)
 	setOpt(&o.Homepage, s.Homepage)
 	setOpt(&o.MetadataUpdatedAt, s.MetadataUpdatedAt)
 
 	o.ExternalRatings = externalRatingsToOgen(s.ExternalRatings)
+	o.LockedFields = s.LockedFields
+	if len(s.MetadataSources) > 0 {
+		o.MetadataSources.SetTo(ogen.TVSeriesMetadataSources(s.MetadataSources))
+	}
 
 	return o
 }
@@ -59,8 +66,13 @@ func seasonToOgen(s *tvshow.Season) *ogen.TVSeason {
 	setOptConv(&o.TmdbID, s.TMDbID, int32ToInt)
 	setOpt(&o.Overview, s.Overview)
 	setOpt(&o.PosterPath, s.PosterPath)
+	setOpt(&o.PosterPlaceholder, imagePlaceholderToOgen(s.ImagePlaceholders.For(s.PosterPath)))
 	setOpt(&o.AirDate, s.AirDate)
 	setOptDecimalFloat32(&o.VoteAverage, s.VoteAverage)
+	o.LockedFields = s.LockedFields
+	if len(s.MetadataSources) > 0 {
+		o.MetadataSources.SetTo(ogen.TVSeasonMetadataSources(s.MetadataSources))
+	}
 
 	return o
 }
@@ -87,7 +99,12 @@ func episodeToOgen(e *tvshow.Episode) *ogen.TVEpisode {
 	setOptDecimalFloat32(&o.VoteAverage, e.VoteAverage)
 	setOptConv(&o.VoteCount, e.VoteCount, int32ToInt)
 	setOpt(&o.StillPath, e.StillPath)
+	setOpt(&o.StillPlaceholder, imagePlaceholderToOgen(e.ImagePlaceholders.For(e.StillPath)))
 	setOpt(&o.ProductionCode, e.ProductionCode)
+	o.LockedFields = e.LockedFields
+	if len(e.MetadataSources) > 0 {
+		o.MetadataSources.SetTo(ogen.TVEpisodeMetadataSources(e.MetadataSources))
+	}
 
 	return o
 }
@@ -142,6 +159,7 @@ func seriesCreditToOgen(c *tvshow.SeriesCredit) *ogen.TVSeriesCredit {
 	setOpt(&o.Department, c.Department)
 	setOptConv(&o.CastOrder, c.CastOrder, int32ToInt)
 	setOpt(&o.ProfilePath, c.ProfilePath)
+	setOpt(&o.PersonID, c.PersonID)
 
 	return o
 }
@@ -3,10 +3,13 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/api/ogen"
 	"github.com/lusoris/revenge/internal/content/tvshow"
+	tvshowjobs "github.com/lusoris/revenge/internal/content/tvshow/jobs"
 	"github.com/lusoris/revenge/internal/util"
 )
 
@@ -19,6 +22,11 @@ var (
 
 // ListTVShows returns a paginated list of TV shows.
 func (h *Handler) ListTVShows(ctx context.Context, params ogen.ListTVShowsParams) (ogen.ListTVShowsRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	filters := tvshow.SeriesListFilters{
 		OrderBy: string(params.OrderBy.Or("created_at")),
 		Limit:   util.SafeIntToInt32(params.Limit.Or(20)),
@@ -36,8 +44,8 @@ func (h *Handler) ListTVShows(ctx context.Context, params ogen.ListTVShowsParams
 		total = int64(len(series))
 	}
 
-	lang := h.GetMetadataLanguage(ctx)
-	localized := LocalizeSeriesList(series, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localized := LocalizeSeriesList(series, chain)
 
 	result := make([]ogen.TVSeries, len(localized))
 	for i, s := range localized {
@@ -52,13 +60,18 @@ func (h *Handler) ListTVShows(ctx context.Context, params ogen.ListTVShowsParams
 
 // SearchTVShows searches TV shows by title.
 func (h *Handler) SearchTVShows(ctx context.Context, params ogen.SearchTVShowsParams) (ogen.SearchTVShowsRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	series, err := h.tvshowService.SearchSeries(ctx, params.Query, util.SafeIntToInt32(params.Limit.Or(20)), util.SafeIntToInt32(params.Offset.Or(0)))
 	if err != nil {
 		return nil, err
 	}
 
-	lang := h.GetMetadataLanguage(ctx)
-	localized := LocalizeSeriesList(series, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localized := LocalizeSeriesList(series, chain)
 
 	result := make([]ogen.TVSeries, len(localized))
 	for i, s := range localized {
@@ -70,16 +83,21 @@ func (h *Handler) SearchTVShows(ctx context.Context, params ogen.SearchTVShowsPa
 
 // GetRecentlyAddedTVShows returns recently added TV shows.
 func (h *Handler) GetRecentlyAddedTVShows(ctx context.Context, params ogen.GetRecentlyAddedTVShowsParams) (ogen.GetRecentlyAddedTVShowsRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	limit := util.SafeIntToInt32(params.Limit.Or(20))
 	offset := util.SafeIntToInt32(params.Offset.Or(0))
 
-	series, total, err := h.tvshowService.ListRecentlyAdded(ctx, limit, offset)
+	series, total, err := h.tvshowService.ListRecentlyAdded(ctx, nil, limit, offset)
 	if err != nil {
 		return nil, err
 	}
 
-	lang := h.GetMetadataLanguage(ctx)
-	localized := LocalizeSeriesList(series, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localized := LocalizeSeriesList(series, chain)
 
 	items := make([]ogen.TVSeries, len(localized))
 	for i, s := range localized {
@@ -97,20 +115,25 @@ func (h *Handler) GetRecentlyAddedTVShows(ctx context.Context, params ogen.GetRe
 
 // GetTVContinueWatching returns the continue watching list for TV shows.
 func (h *Handler) GetTVContinueWatching(ctx context.Context, params ogen.GetTVContinueWatchingParams) (ogen.GetTVContinueWatchingRes, error) {
+	ctx, err := h.restrictContent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	userID, err := GetUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	items, err := h.tvshowService.GetContinueWatching(ctx, userID, util.SafeIntToInt32(params.Limit.Or(20)))
+	items, err := h.tvshowService.GetContinueWatching(ctx, userID, nil, util.SafeIntToInt32(params.Limit.Or(20)))
 	if err != nil {
 		return nil, err
 	}
 
-	lang := h.GetMetadataLanguage(ctx)
+	chain := h.GetMetadataLanguageChain(ctx)
 	for i := range items {
 		if items[i].Series != nil {
-			items[i].Series = LocalizeSeries(items[i].Series, lang)
+			items[i].Series = LocalizeSeries(items[i].Series, chain)
 		}
 	}
 
@@ -179,8 +202,8 @@ func (h *Handler) GetTVShow(ctx context.Context, params ogen.GetTVShowParams) (o
 		return (*ogen.GetTVShowNotFound)(OgenNotFound("TV show not found")), nil
 	}
 
-	lang := h.GetMetadataLanguage(ctx)
-	localized := LocalizeSeries(series, lang)
+	chain := h.GetMetadataLanguageChain(ctx)
+	localized := LocalizeSeries(series, chain)
 
 	return seriesToOgen(localized), nil
 }
@@ -197,8 +220,9 @@ func (h *Handler) GetTVShowSeasons(ctx context.Context, params ogen.GetTVShowSea
 		return (*ogen.GetTVShowSeasonsNotFound)(OgenNotFound("TV show not found")), nil
 	}
 
-	result := make([]ogen.TVSeason, len(seasons))
-	for i, s := range seasons {
+	localized := LocalizeSeasons(seasons, h.GetMetadataLanguageChain(ctx))
+	result := make([]ogen.TVSeason, len(localized))
+	for i, s := range localized {
 		result[i] = *seasonToOgen(&s)
 	}
 
@@ -217,8 +241,9 @@ func (h *Handler) GetTVShowEpisodes(ctx context.Context, params ogen.GetTVShowEp
 		return (*ogen.GetTVShowEpisodesNotFound)(OgenNotFound("TV show not found")), nil
 	}
 
-	result := make([]ogen.TVEpisode, len(episodes))
-	for i, e := range episodes {
+	localized := LocalizeEpisodes(episodes, h.GetMetadataLanguageChain(ctx))
+	result := make([]ogen.TVEpisode, len(localized))
+	for i, e := range localized {
 		result[i] = *episodeToOgen(&e)
 	}
 
@@ -352,7 +377,7 @@ func (h *Handler) GetTVShowNextEpisode(ctx context.Context, params ogen.GetTVSho
 		return (*ogen.GetTVShowNextEpisodeNotFound)(OgenNotFound("TV show not found")), nil
 	}
 
-	return episodeToOgen(episode), nil
+	return episodeToOgen(LocalizeEpisode(episode, h.GetMetadataLanguageChain(ctx))), nil
 }
 
 // RefreshTVShowMetadata triggers a metadata refresh for a TV show.
@@ -382,7 +407,7 @@ func (h *Handler) GetTVSeason(ctx context.Context, params ogen.GetTVSeasonParams
 		return (*ogen.GetTVSeasonNotFound)(OgenNotFound("Season not found")), nil
 	}
 
-	return seasonToOgen(season), nil
+	return seasonToOgen(LocalizeSeason(season, h.GetMetadataLanguageChain(ctx))), nil
 }
 
 // GetTVSeasonEpisodes returns all episodes for a season.
@@ -397,8 +422,9 @@ func (h *Handler) GetTVSeasonEpisodes(ctx context.Context, params ogen.GetTVSeas
 		return (*ogen.GetTVSeasonEpisodesNotFound)(OgenNotFound("Season not found")), nil
 	}
 
-	result := make([]ogen.TVEpisode, len(episodes))
-	for i, e := range episodes {
+	localized := LocalizeEpisodes(episodes, h.GetMetadataLanguageChain(ctx))
+	result := make([]ogen.TVEpisode, len(localized))
+	for i, e := range localized {
 		result[i] = *episodeToOgen(&e)
 	}
 
@@ -417,7 +443,7 @@ func (h *Handler) GetTVEpisode(ctx context.Context, params ogen.GetTVEpisodePara
 		return (*ogen.GetTVEpisodeNotFound)(OgenNotFound("Episode not found")), nil
 	}
 
-	return episodeToOgen(episode), nil
+	return episodeToOgen(LocalizeEpisode(episode, h.GetMetadataLanguageChain(ctx))), nil
 }
 
 // GetTVEpisodeFiles returns files for an episode.
@@ -477,6 +503,7 @@ func (h *Handler) UpdateTVEpisodeProgress(ctx context.Context, req *ogen.UpdateE
 		return (*ogen.UpdateTVEpisodeProgressNotFound)(OgenNotFound("Episode not found")), nil
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return episodeWatchProgressToOgen(progress), nil
 }
 
@@ -497,6 +524,7 @@ func (h *Handler) DeleteTVEpisodeProgress(ctx context.Context, params ogen.Delet
 		return (*ogen.DeleteTVEpisodeProgressNotFound)(OgenNotFound("Episode not found")), nil
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return &ogen.DeleteTVEpisodeProgressNoContent{}, nil
 }
 
@@ -517,6 +545,7 @@ func (h *Handler) MarkTVEpisodeWatched(ctx context.Context, req ogen.OptMarkTVEp
 		return (*ogen.MarkTVEpisodeWatchedNotFound)(OgenNotFound("Episode not found")), nil
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return &ogen.MarkTVEpisodeWatchedNoContent{}, nil
 }
 
@@ -535,7 +564,45 @@ func (h *Handler) MarkTVEpisodesBulkWatched(ctx context.Context, req *ogen.BulkE
 		}, nil
 	}
 
+	h.invalidateHomeFeed(ctx, userID)
 	return &ogen.BulkEpisodesWatchedResponse{
 		MarkedCount: affected,
 	}, nil
 }
+
+// AdminExportTVShowNFO queues an NFO export for one series or the whole TV
+// show library. Admin only.
+// POST /api/v1/admin/tvshows/nfo-export
+func (h *Handler) AdminExportTVShowNFO(ctx context.Context, req ogen.OptTVShowNFOExportRequest) (ogen.AdminExportTVShowNFORes, error) {
+	if _, err := h.requireAdmin(ctx); err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return &ogen.AdminExportTVShowNFOUnauthorized{Code: 401, Message: "Authentication required"}, nil
+		}
+		if errors.Is(err, errNotAdmin) {
+			return &ogen.AdminExportTVShowNFOForbidden{Code: 403, Message: "Admin access required"}, nil
+		}
+		return nil, err
+	}
+
+	if h.riverClient == nil {
+		return nil, fmt.Errorf("job queue not available")
+	}
+
+	args := tvshowjobs.NFOExportArgs{}
+	if seriesID, ok := req.Value.SeriesID.Get(); req.Set && ok {
+		args.SeriesID = &seriesID
+	}
+
+	result, err := h.riverClient.Insert(ctx, args, nil)
+	if err != nil {
+		h.logger.Error("failed to enqueue tvshow nfo export job", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to enqueue tvshow nfo export job: %w", err)
+	}
+
+	h.logger.Info("tvshow nfo export job enqueued", slog.Int64("job_id", result.Job.ID))
+
+	return &ogen.NFOExportResponse{
+		Message: "NFO export job queued",
+		JobID:   result.Job.ID,
+	}, nil
+}
@@ -102,3 +102,16 @@ func TestHandler_MarkTVEpisodesBulkWatched_ServiceError(t *testing.T) {
 	require.True(t, ok, "expected *ogen.Error, got %T", result)
 	assert.Equal(t, 500, errResp.Code)
 }
+
+func TestHandler_AdminExportTVShowNFO_NoAuth(t *testing.T) {
+	t.Parallel()
+
+	handler := &Handler{logger: logging.NewTestLogger()}
+
+	result, err := handler.AdminExportTVShowNFO(context.Background(), ogen.OptTVShowNFOExportRequest{})
+	require.NoError(t, err)
+
+	unauthorized, ok := result.(*ogen.AdminExportTVShowNFOUnauthorized)
+	require.True(t, ok, "expected *ogen.AdminExportTVShowNFOUnauthorized, got %T", result)
+	assert.Equal(t, 401, unauthorized.Code)
+}
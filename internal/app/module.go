@@ -3,10 +3,29 @@ package app
 
 import (
 	"github.com/lusoris/revenge/internal/api"
+	"github.com/lusoris/revenge/internal/api/opds"
 	"github.com/lusoris/revenge/internal/api/sse"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	audiobookjobs "github.com/lusoris/revenge/internal/content/audiobook/jobs"
+	"github.com/lusoris/revenge/internal/content/book"
+	bookjobs "github.com/lusoris/revenge/internal/content/book/jobs"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	homevideojobs "github.com/lusoris/revenge/internal/content/homevideo/jobs"
+	"github.com/lusoris/revenge/internal/content/livetv"
+	livetvjobs "github.com/lusoris/revenge/internal/content/livetv/jobs"
 	"github.com/lusoris/revenge/internal/content/movie"
 	"github.com/lusoris/revenge/internal/content/movie/moviejobs"
+	"github.com/lusoris/revenge/internal/content/music"
+	musicjobs "github.com/lusoris/revenge/internal/content/music/jobs"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	musicvideojobs "github.com/lusoris/revenge/internal/content/musicvideo/jobs"
+	"github.com/lusoris/revenge/internal/content/photo"
+	photojobs "github.com/lusoris/revenge/internal/content/photo/jobs"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	podcastjobs "github.com/lusoris/revenge/internal/content/podcast/jobs"
+	"github.com/lusoris/revenge/internal/content/qar"
+	qarjobs "github.com/lusoris/revenge/internal/content/qar/jobs"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	tvshowjobs "github.com/lusoris/revenge/internal/content/tvshow/jobs"
 	appcrypto "github.com/lusoris/revenge/internal/crypto"
@@ -25,14 +44,20 @@ import (
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/analytics"
 	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/auth"
 	"github.com/lusoris/revenge/internal/service/email"
+	"github.com/lusoris/revenge/internal/service/homefeed"
 	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/metadata"
 	metadatajobs "github.com/lusoris/revenge/internal/service/metadata/jobs"
 	"github.com/lusoris/revenge/internal/service/metadata/metadatafx"
+	"github.com/lusoris/revenge/internal/service/metadatahistory"
 	"github.com/lusoris/revenge/internal/service/mfa"
 	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/service/oidc"
+	"github.com/lusoris/revenge/internal/service/parental"
+	"github.com/lusoris/revenge/internal/service/people"
 	"github.com/lusoris/revenge/internal/service/rbac"
 	searchsvc "github.com/lusoris/revenge/internal/service/search"
 	"github.com/lusoris/revenge/internal/service/session"
@@ -81,11 +106,35 @@ var Module = fx.Module("app",
 	fx.Invoke(registerNotificationAgents),
 	storage.Module,
 	library.Module,
+	artwork.Module,
+	people.Module,
+	parental.Module,
 	searchsvc.Module,
+	metadatahistory.Module,
 
 	// Content Modules
 	movie.Module,
 	tvshow.Module,
+	music.Module,
+	audiobook.Module,
+	book.Module,
+	photo.Module,
+	homevideo.Module,
+	musicvideo.Module,
+	podcast.Module,
+	qar.Module,
+	livetv.Module,
+
+	// Home feed across the content modules
+	homefeed.Module,
+
+	// Bridge: record every movie and TV show metadata write in the change history
+	fx.Decorate(func(repo movie.Repository, history *metadatahistory.Service) movie.Repository {
+		return movie.NewHistoryRepository(repo, history)
+	}),
+	fx.Decorate(func(repo tvshow.Repository, history *metadatahistory.Service) tvshow.Repository {
+		return tvshow.NewHistoryRepository(repo, history)
+	}),
 
 	// Playback / HLS Streaming
 	playbackfx.Module,
@@ -93,6 +142,15 @@ var Module = fx.Module("app",
 	// Job Workers
 	moviejobs.Module,
 	tvshowjobs.Module,
+	musicjobs.Module,
+	audiobookjobs.Module,
+	bookjobs.Module,
+	photojobs.Module,
+	homevideojobs.Module,
+	musicvideojobs.Module,
+	podcastjobs.Module,
+	qarjobs.Module,
+	livetvjobs.Module,
 	metadatajobs.Module,
 
 	// Integrations
@@ -125,16 +183,24 @@ var Module = fx.Module("app",
 			LetterboxdEnabled:      cfg.Metadata.Letterboxd.Enabled,
 			LetterboxdAPIKey:       cfg.Metadata.Letterboxd.APIKey,
 			LetterboxdAPISecret:    cfg.Metadata.Letterboxd.APISecret,
+			MusicBrainzEnabled:     cfg.Metadata.MusicBrainz.Enabled,
+			MusicBrainzContact:     cfg.Metadata.MusicBrainz.Contact,
 		}
 	}),
 	metadatafx.Module,
 
+	// Bridge: user preferences → languages fetched on metadata refresh
+	fx.Invoke(func(svc metadata.Service, users *user.Service) { svc.SetLanguageSource(users) }),
+
 	// Observability (metrics, pprof)
 	observability.Module,
 
 	// SSE Real-Time Events
 	sse.Module,
 
+	// OPDS catalog for e-reader apps
+	opds.Module,
+
 	// HTTP API Server (ogen-generated)
 	api.Module,
 )
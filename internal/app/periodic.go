@@ -7,11 +7,15 @@ import (
 	"github.com/riverqueue/river"
 
 	"github.com/lusoris/revenge/internal/config"
+	livetvjobs "github.com/lusoris/revenge/internal/content/livetv/jobs"
+	podcastjobs "github.com/lusoris/revenge/internal/content/podcast/jobs"
 	"github.com/lusoris/revenge/internal/infra/jobs"
 	playbackjobs "github.com/lusoris/revenge/internal/playback/jobs"
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/analytics"
 	"github.com/lusoris/revenge/internal/service/library"
+	metadatajobs "github.com/lusoris/revenge/internal/service/metadata/jobs"
+	"github.com/lusoris/revenge/internal/service/metadatahistory"
 	"github.com/lusoris/revenge/internal/service/session"
 )
 
@@ -46,6 +50,18 @@ func providePeriodicJobs(cfg *config.Config) []*river.PeriodicJob {
 			&river.PeriodicJobOpts{ID: "activity_cleanup_daily"},
 		),
 
+		// Metadata history cleanup: remove old metadata changes (daily),
+		// retained as long as activity logs
+		river.NewPeriodicJob(
+			river.PeriodicInterval(24*time.Hour),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return metadatahistory.CleanupArgs{
+					RetentionDays: retentionDays,
+				}, nil
+			},
+			&river.PeriodicJobOpts{ID: "metadata_history_cleanup_daily"},
+		),
+
 		// Library scan cleanup: remove old scan history (daily)
 		river.NewPeriodicJob(
 			river.PeriodicInterval(24*time.Hour),
@@ -97,6 +113,50 @@ func providePeriodicJobs(cfg *config.Config) []*river.PeriodicJob {
 		))
 	}
 
+	// Scheduled metadata refresh: queue stale movies and series (hourly by default).
+	if cfg.Metadata.Refresh.Enabled && cfg.Metadata.Refresh.Interval > 0 {
+		periodicJobs = append(periodicJobs, river.NewPeriodicJob(
+			river.PeriodicInterval(cfg.Metadata.Refresh.Interval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return metadatajobs.ScheduledRefreshArgs{}, nil
+			},
+			&river.PeriodicJobOpts{ID: "metadata_scheduled_refresh"},
+		))
+	}
+
+	// Tag backfill: import keywords and tags of movies and series added before
+	// tags were supported (daily, a no-op once none remain).
+	periodicJobs = append(periodicJobs, river.NewPeriodicJob(
+		river.PeriodicInterval(24*time.Hour),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return metadatajobs.TagBackfillArgs{}, nil
+		},
+		&river.PeriodicJobOpts{ID: "metadata_tag_backfill_daily", RunOnStart: true},
+	))
+
+	// Podcast feed refresh: poll subscribed feeds (hourly by default).
+	if cfg.Podcast.RefreshInterval > 0 {
+		periodicJobs = append(periodicJobs, river.NewPeriodicJob(
+			river.PeriodicInterval(cfg.Podcast.RefreshInterval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return podcastjobs.RefreshAllArgs{}, nil
+			},
+			&river.PeriodicJobOpts{ID: "podcast_refresh_all", RunOnStart: true},
+		))
+	}
+
+	// Live TV: reload channel lists and guides, then schedule series rule
+	// recordings (every 12 hours by default).
+	if cfg.LiveTV.Enabled && cfg.LiveTV.GuideRefreshInterval > 0 {
+		periodicJobs = append(periodicJobs, river.NewPeriodicJob(
+			river.PeriodicInterval(cfg.LiveTV.GuideRefreshInterval),
+			func() (river.JobArgs, *river.InsertOpts) {
+				return livetvjobs.RefreshAllArgs{}, nil
+			},
+			&river.PeriodicJobOpts{ID: "livetv_refresh_all", RunOnStart: true},
+		))
+	}
+
 	return periodicJobs
 }
 
@@ -66,6 +66,12 @@ type Config struct {
 
 	// Notifications configuration
 	Notifications NotificationsConfig `koanf:"notifications"`
+
+	// Podcast module configuration
+	Podcast PodcastConfig `koanf:"podcast"`
+
+	// Live TV and DVR configuration
+	LiveTV LiveTVConfig `koanf:"livetv"`
 }
 
 // ActivityConfig holds activity log configuration.
@@ -306,11 +312,26 @@ type LegacyConfig struct {
 	// Enabled indicates if the QAR module is enabled.
 	Enabled bool `koanf:"enabled"`
 
-	// EncryptionKey is the encryption key for QAR data.
+	// EncryptionKey is the encryption key for QAR data (hex-encoded
+	// 32-byte key).
 	EncryptionKey string `koanf:"encryption_key"`
 
 	// Privacy settings
 	Privacy LegacyPrivacyConfig `koanf:"privacy"`
+
+	// StashDB configures scene metadata matching.
+	StashDB LegacyStashDBConfig `koanf:"stashdb"`
+}
+
+// LegacyStashDBConfig holds the StashDB-compatible metadata server used
+// to match QAR scenes.
+type LegacyStashDBConfig struct {
+	// URL is the GraphQL endpoint of a stash-box server
+	// (default: https://stashdb.org/graphql).
+	URL string `koanf:"url"`
+
+	// APIKey is the stash-box API key. Matching is disabled without one.
+	APIKey string `koanf:"api_key"`
 }
 
 // LegacyPrivacyConfig holds QAR privacy settings.
@@ -377,6 +398,92 @@ type MetadataConfig struct {
 
 	// Letterboxd configuration for movie metadata.
 	Letterboxd LetterboxdConfig `koanf:"letterboxd"`
+
+	// MusicBrainz configuration for music video matching (no API key required).
+	MusicBrainz MusicBrainzConfig `koanf:"musicbrainz"`
+
+	// LocalArtwork configures artwork discovered next to media files.
+	LocalArtwork LocalArtworkConfig `koanf:"local_artwork"`
+
+	// Refresh configures the scheduled metadata refresh.
+	Refresh MetadataRefreshConfig `koanf:"refresh"`
+}
+
+// MetadataRefreshConfig holds scheduled metadata refresh configuration.
+// Each run queues the items whose metadata is older than the interval of
+// their class, least recently refreshed first.
+type MetadataRefreshConfig struct {
+	// Enabled turns on the scheduled refresh.
+	Enabled bool `koanf:"enabled"`
+
+	// Interval is how often the scheduler runs (default: 1h).
+	Interval time.Duration `koanf:"interval"`
+
+	// BatchSize caps the movies and series queued per run, keeping provider
+	// requests well inside their rate limits (default: 50).
+	BatchSize int `koanf:"batch_size"`
+
+	// Continuing is the refresh interval for continuing or recently aired
+	// series and recently released movies (default: 24h).
+	Continuing time.Duration `koanf:"continuing"`
+
+	// Ended is the refresh interval for ended series and older movies
+	// (default: 720h).
+	Ended time.Duration `koanf:"ended"`
+
+	// Incomplete is the refresh interval for items missing an overview or
+	// poster (default: 12h).
+	Incomplete time.Duration `koanf:"incomplete"`
+
+	// RecentWindow is how long after release or last air date an item
+	// counts as recent (default: 2160h).
+	RecentWindow time.Duration `koanf:"recent_window"`
+}
+
+// PodcastConfig holds podcast feed refresh and download configuration.
+type PodcastConfig struct {
+	// RefreshInterval is how often subscribed feeds are checked for new
+	// episodes (default: 1h, 0 = disabled).
+	RefreshInterval time.Duration `koanf:"refresh_interval"`
+
+	// KeepEpisodes is how many of a podcast's newest episodes are kept
+	// downloaded unless the podcast sets its own limit (default: 10,
+	// 0 = keep all).
+	KeepEpisodes int `koanf:"keep_episodes"`
+
+	// MaxDownloadSize is the largest episode file downloaded, in bytes
+	// (default: 2 GiB).
+	MaxDownloadSize int64 `koanf:"max_download_size"`
+
+	// DownloadTimeout bounds a single episode download (default: 1h).
+	DownloadTimeout time.Duration `koanf:"download_timeout"`
+}
+
+// LiveTVConfig holds live TV and DVR configuration.
+type LiveTVConfig struct {
+	// Enabled turns on live TV channels, the program guide and
+	// recordings.
+	Enabled bool `koanf:"enabled"`
+
+	// GuideRefreshInterval is how often channel lists and guides are
+	// reloaded and series rules applied (default: 12h, 0 = disabled).
+	GuideRefreshInterval time.Duration `koanf:"guide_refresh_interval"`
+
+	// DefaultPaddingBefore and DefaultPaddingAfter extend recordings
+	// that don't set their own padding (default: 1m and 5m).
+	DefaultPaddingBefore time.Duration `koanf:"default_padding_before"`
+	DefaultPaddingAfter  time.Duration `koanf:"default_padding_after"`
+}
+
+// LocalArtworkConfig holds local artwork discovery configuration.
+type LocalArtworkConfig struct {
+	// Enabled picks up poster.jpg, fanart.jpg and similar images during
+	// library scans.
+	Enabled bool `koanf:"enabled"`
+
+	// PreferLocal uses local posters and backdrops instead of provider
+	// images. Metadata refreshes keep them.
+	PreferLocal bool `koanf:"prefer_local"`
 }
 
 // TVmazeConfig holds TVmaze configuration.
@@ -385,6 +492,16 @@ type TVmazeConfig struct {
 	Enabled bool `koanf:"enabled"`
 }
 
+// MusicBrainzConfig holds MusicBrainz configuration.
+type MusicBrainzConfig struct {
+	// Enabled activates MusicBrainz as a metadata provider.
+	Enabled bool `koanf:"enabled"`
+
+	// Contact is an email address or URL sent in the User-Agent, as
+	// MusicBrainz asks of API clients (optional).
+	Contact string `koanf:"contact"`
+}
+
 // AniListConfig holds AniList configuration.
 type AniListConfig struct {
 	// Enabled activates AniList as a metadata provider.
@@ -474,6 +591,29 @@ type LibraryConfig struct {
 
 	// ScanInterval is how often to automatically scan libraries (0 = disabled).
 	ScanInterval time.Duration `koanf:"scan_interval"`
+
+	// MinMatchConfidence is the score a provider search result needs to be
+	// matched without review; weaker files go to the match queue
+	// (default: 0.4).
+	MinMatchConfidence float64 `koanf:"min_match_confidence" validate:"gte=0,lte=1"`
+
+	// NFO configures Kodi/Jellyfin NFO sidecar handling.
+	NFO NFOConfig `koanf:"nfo"`
+}
+
+// NFOConfig holds NFO sidecar configuration. It applies to movie NFOs and
+// to the tvshow.nfo of TV shows.
+type NFOConfig struct {
+	// Import reads NFO files next to media during scans. Provider IDs found
+	// in an NFO short-circuit title matching (default: true).
+	Import bool `koanf:"import"`
+
+	// OverrideFields lists fields where NFO values replace provider
+	// metadata, both on import and after metadata refreshes. Supported:
+	// title, original_title, year, runtime, overview, tagline; shows only
+	// use title, original_title and overview.
+	// The NFO sort title is always imported since providers have none.
+	OverrideFields []string `koanf:"override_fields" validate:"dive,oneof=title original_title year runtime overview tagline"`
 }
 
 // IntegrationsConfig holds all external integrations configuration.
@@ -819,18 +959,43 @@ func Defaults() map[string]any {
 		"server.rate_limit.auth.burst":                 10,
 
 		// Movie defaults
-		"movie.tmdb.api_key":          "",
-		"movie.tmdb.rate_limit":       40,
-		"movie.tmdb.cache_ttl":        "5m",
-		"movie.tmdb.proxy_url":        "",
-		"movie.library.paths":         []string{},
-		"movie.library.scan_interval": "0s", // Disabled by default
+		"movie.tmdb.api_key":                 "",
+		"movie.tmdb.rate_limit":              40,
+		"movie.tmdb.cache_ttl":               "5m",
+		"movie.tmdb.proxy_url":               "",
+		"movie.library.paths":                []string{},
+		"movie.library.scan_interval":        "0s", // Disabled by default
+		"movie.library.min_match_confidence": 0.4,
+		"movie.library.nfo.import":           true,
 
 		// Metadata provider defaults
-		"metadata.fanarttv.api_key":    "",
-		"metadata.fanarttv.client_key": "",
-		"metadata.omdb.api_key":        "",
-		"metadata.tvmaze.enabled":      false,
+		"metadata.fanarttv.api_key":           "",
+		"metadata.fanarttv.client_key":        "",
+		"metadata.omdb.api_key":               "",
+		"metadata.tvmaze.enabled":             false,
+		"metadata.musicbrainz.enabled":        false,
+		"metadata.musicbrainz.contact":        "",
+		"metadata.local_artwork.enabled":      true,
+		"metadata.local_artwork.prefer_local": false,
+		"metadata.refresh.enabled":            true,
+		"metadata.refresh.interval":           "1h",
+		"metadata.refresh.batch_size":         50,
+		"metadata.refresh.continuing":         "24h",
+		"metadata.refresh.ended":              "720h",
+		"metadata.refresh.incomplete":         "12h",
+		"metadata.refresh.recent_window":      "2160h",
+
+		// Podcast defaults
+		"podcast.refresh_interval":  "1h",
+		"podcast.keep_episodes":     10,
+		"podcast.max_download_size": 2 << 30, // 2 GiB
+		"podcast.download_timeout":  "1h",
+
+		// Live TV defaults
+		"livetv.enabled":                false,
+		"livetv.guide_refresh_interval": "12h",
+		"livetv.default_padding_before": "1m",
+		"livetv.default_padding_after":  "5m",
 
 		// Search defaults
 		"search.url":     "",
@@ -873,6 +1038,8 @@ func Defaults() map[string]any {
 		"legacy.encryption_key":           "",
 		"legacy.privacy.require_pin":      true,
 		"legacy.privacy.audit_all_access": true,
+		"legacy.stashdb.url":              "https://stashdb.org/graphql",
+		"legacy.stashdb.api_key":          "",
 
 		// Integrations defaults
 		"integrations.radarr.enabled":       false,
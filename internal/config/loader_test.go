@@ -290,6 +290,12 @@ func TestDefaults_AllKeysExist(t *testing.T) {
 	assert.Contains(t, defaults, "legacy.encryption_key")
 	assert.Contains(t, defaults, "legacy.privacy.require_pin")
 	assert.Contains(t, defaults, "legacy.privacy.audit_all_access")
+
+	// Live TV
+	assert.Contains(t, defaults, "livetv.enabled")
+	assert.Contains(t, defaults, "livetv.guide_refresh_interval")
+	assert.Contains(t, defaults, "livetv.default_padding_before")
+	assert.Contains(t, defaults, "livetv.default_padding_after")
 }
 
 func TestDefaults_ValuesAreCorrectTypes(t *testing.T) {
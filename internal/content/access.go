@@ -0,0 +1,67 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// ErrParentalRestriction is returned when parental controls block an item.
+var ErrParentalRestriction = errors.New("content blocked by parental controls")
+
+// AccessFilter restricts the content a user can list, search and play.
+type AccessFilter struct {
+	MaxAge       *int32   // Highest allowed minimum viewer age, nil for no age limit
+	AllowUnrated bool     // Whether unrated content passes an age limit
+	BlockedTags  []string // Genre slugs that are never shown
+}
+
+// Allows reports whether an item with the given minimum age and genre slugs
+// passes the filter. A nil filter allows everything.
+func (f *AccessFilter) Allows(minAge *int32, tags []string) bool {
+	if f == nil {
+		return true
+	}
+	if f.MaxAge != nil {
+		if minAge == nil {
+			if !f.AllowUnrated {
+				return false
+			}
+		} else if *minAge > *f.MaxAge {
+			return false
+		}
+	}
+	for _, tag := range tags {
+		if slices.Contains(f.BlockedTags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// QueryParams returns the filter as parameters of the list queries. A nil
+// filter yields parameters that match every item.
+func (f *AccessFilter) QueryParams() (maxAge *int32, allowUnrated bool, blockedTags []string) {
+	if f == nil {
+		return nil, true, []string{}
+	}
+	blockedTags = f.BlockedTags
+	if blockedTags == nil {
+		blockedTags = []string{}
+	}
+	return f.MaxAge, f.AllowUnrated, blockedTags
+}
+
+type accessFilterKey struct{}
+
+// WithAccessFilter returns a context carrying the filter. Repositories and
+// the search service apply it to the lists they return.
+func WithAccessFilter(ctx context.Context, filter *AccessFilter) context.Context {
+	return context.WithValue(ctx, accessFilterKey{}, filter)
+}
+
+// AccessFilterFromContext returns the filter attached to ctx, or nil.
+func AccessFilterFromContext(ctx context.Context) *AccessFilter {
+	filter, _ := ctx.Value(accessFilterKey{}).(*AccessFilter)
+	return filter
+}
@@ -0,0 +1,52 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessFilter_Allows(t *testing.T) {
+	t.Parallel()
+
+	twelve, sixteen := int32(12), int32(16)
+	filter := &AccessFilter{MaxAge: &twelve, AllowUnrated: true, BlockedTags: []string{"horror"}}
+
+	assert.True(t, filter.Allows(&twelve, []string{"comedy"}))
+	assert.False(t, filter.Allows(&sixteen, nil))
+	assert.False(t, filter.Allows(&twelve, []string{"comedy", "horror"}))
+	assert.True(t, filter.Allows(nil, nil))
+
+	filter.AllowUnrated = false
+	assert.False(t, filter.Allows(nil, nil))
+
+	var unrestricted *AccessFilter
+	assert.True(t, unrestricted.Allows(&sixteen, []string{"horror"}))
+}
+
+func TestAccessFilter_QueryParams(t *testing.T) {
+	t.Parallel()
+
+	var unrestricted *AccessFilter
+	maxAge, allowUnrated, blockedTags := unrestricted.QueryParams()
+	assert.Nil(t, maxAge)
+	assert.True(t, allowUnrated)
+	assert.Equal(t, []string{}, blockedTags)
+
+	twelve := int32(12)
+	maxAge, allowUnrated, blockedTags = (&AccessFilter{MaxAge: &twelve}).QueryParams()
+	assert.Equal(t, &twelve, maxAge)
+	assert.False(t, allowUnrated)
+	assert.Equal(t, []string{}, blockedTags)
+}
+
+func TestAccessFilterContext(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, AccessFilterFromContext(context.Background()))
+
+	filter := &AccessFilter{BlockedTags: []string{"horror"}}
+	ctx := WithAccessFilter(context.Background(), filter)
+	assert.Same(t, filter, AccessFilterFromContext(ctx))
+}
@@ -0,0 +1,75 @@
+package content
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Certifications that do not carry an age in their name, per country. Each
+// maps to the minimum viewer age on a common scale. Systems of a country
+// (film and TV) share a table; their ratings do not collide.
+var namedRatings = map[string]map[string]int{
+	"US": {
+		"G": 0, "PG": 10, "PG-13": 13, "R": 17, "NC-17": 18,
+		"TV-Y": 0, "TV-Y7": 7, "TV-Y7-FV": 7, "TV-G": 0, "TV-PG": 10, "TV-14": 14, "TV-MA": 17,
+	},
+	"GB": {"U": 0, "UC": 0, "PG": 8, "R18": 18},
+	"FR": {"U": 0, "TP": 0, "TOUS PUBLICS": 0},
+	"JP": {"G": 0, "PG12": 12, "R15+": 15, "R18+": 18},
+	"KR": {"ALL": 0, "RESTRICTED SCREENING": 18},
+	"BR": {"L": 0, "ER": 10},
+	"AU": {"E": 0, "G": 0, "P": 0, "C": 0, "PG": 8, "M": 15, "MA15+": 15, "AV15+": 15, "R18+": 18, "X18+": 18, "RC": 18},
+	"CA": {"E": 0, "G": 0, "C": 0, "C8": 8, "PG": 8, "14A": 14, "14+": 14, "18A": 18, "18+": 18, "R": 18, "A": 18},
+	"NL": {"AL": 0},
+	"ES": {"A": 0, "APTA": 0, "TP": 0, "X": 18},
+	"IT": {"T": 0},
+	"IN": {"U": 0, "UA": 12, "A": 18, "S": 18},
+}
+
+// maxRatedAge is the highest age accepted from a numeric certification.
+const maxRatedAge = 21
+
+// NormalizeAgeRating maps a certification of a country to the minimum viewer
+// age it implies. Named certifications are looked up per country; others are
+// read from the age they contain ("FSK 12", "-16", "MA15+"). It returns false
+// for certifications that carry no age, such as "NR" or "Unrated".
+func NormalizeAgeRating(country, rating string) (int, bool) {
+	rating = strings.ToUpper(strings.TrimSpace(rating))
+	if rating == "" {
+		return 0, false
+	}
+	if age, ok := namedRatings[strings.ToUpper(country)][rating]; ok {
+		return age, true
+	}
+
+	start := strings.IndexFunc(rating, unicode.IsDigit)
+	if start < 0 {
+		return 0, false
+	}
+	end := start
+	for end < len(rating) && rating[end] >= '0' && rating[end] <= '9' {
+		end++
+	}
+	age, err := strconv.Atoi(rating[start:end])
+	if err != nil || age > maxRatedAge {
+		return 0, false
+	}
+	return age, true
+}
+
+// MinimumAge derives the minimum viewer age of an item from its age ratings
+// keyed by country and rating system. Countries disagree; parental controls
+// must not let a title through that any country restricts, so the strictest
+// recognized rating is used. It is nil when none is recognized.
+func MinimumAge(ratings map[string]map[string]string) *int32 {
+	var minAge *int32
+	for country, systems := range ratings {
+		for _, rating := range systems {
+			if age, ok := NormalizeAgeRating(country, rating); ok && (minAge == nil || int32(age) > *minAge) {
+				minAge = new(int32(age))
+			}
+		}
+	}
+	return minAge
+}
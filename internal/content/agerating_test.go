@@ -0,0 +1,54 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAgeRating(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		country string
+		rating  string
+		age     int
+		ok      bool
+	}{
+		{"US", "PG-13", 13, true},
+		{"US", "tv-ma", 17, true},
+		{"GB", "15", 15, true},
+		{"GB", "PG", 8, true},
+		{"DE", "FSK 12", 12, true},
+		{"DE", "16", 16, true},
+		{"FR", "-16", 16, true},
+		{"AU", "MA15+", 15, true},
+		{"JP", "PG12", 12, true},
+		{"US", "NR", 0, false},
+		{"US", "Unrated", 0, false},
+		{"US", "", 0, false},
+		{"XX", "1999", 0, false},
+	}
+	for _, tt := range tests {
+		age, ok := NormalizeAgeRating(tt.country, tt.rating)
+		assert.Equal(t, tt.ok, ok, "%s %q", tt.country, tt.rating)
+		assert.Equal(t, tt.age, age, "%s %q", tt.country, tt.rating)
+	}
+}
+
+func TestMinimumAge(t *testing.T) {
+	t.Parallel()
+
+	age := MinimumAge(map[string]map[string]string{
+		"US": {"MPAA": "R"},
+		"DE": {"FSK": "16"},
+		"GB": {"BBFC": "18"},
+		"FR": {"CNC": "NR"},
+	})
+	if assert.NotNil(t, age) {
+		assert.Equal(t, int32(18), *age, "the strictest country wins")
+	}
+
+	assert.Nil(t, MinimumAge(map[string]map[string]string{"US": {"MPAA": "NR"}}))
+	assert.Nil(t, MinimumAge(nil))
+}
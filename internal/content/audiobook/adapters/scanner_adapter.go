@@ -0,0 +1,276 @@
+// Package adapters provides audiobook-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"cmp"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/shared/audiotags"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// AudiobookFileParser implements scanner.FileParser for audiobook files.
+// A single M4B is a book on its own; any other audio files in a folder
+// (including "CD 1"/"Disc 2" subfolders) make up one book together.
+// Filename patterns understood:
+// - "01 - Chapter One.mp3" -> Part: 1, Title: "Chapter One"
+// - "Book Title - 03.mp3" -> Part: 3, Title: "Book Title"
+type AudiobookFileParser struct{}
+
+// NewAudiobookFileParser creates a new audiobook file parser
+func NewAudiobookFileParser() *AudiobookFileParser {
+	return &AudiobookFileParser{}
+}
+
+var (
+	// Leading part number: "01 - ", "01. ", "01 "
+	leadingPartPattern = regexp.MustCompile(`^(\d{1,4})(?:\s*[-.]\s*|\s+)(.+)$`)
+
+	// Trailing part number: " - 03", " Part 3", " (3)"
+	trailingPartPattern = regexp.MustCompile(`^(.+?)[\s._-]+(?:(?i:part|pt\.?|track)\s*)?\(?(\d{1,4})\)?$`)
+
+	// Separators between several authors or narrators in one tag
+	nameSeparatorPattern = regexp.MustCompile(`\s*(?:;|,|&|\band\b)\s*`)
+)
+
+// Parse extracts the part number and title from an audiobook filename
+func (p *AudiobookFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSpace(strings.TrimSuffix(filename, filepath.Ext(filename)))
+
+	if m := leadingPartPattern.FindStringSubmatch(name); m != nil {
+		part, _ := strconv.Atoi(m[1])
+		metadata["part"] = part
+		name = m[2]
+	} else if m := trailingPartPattern.FindStringSubmatch(name); m != nil {
+		part, _ := strconv.Atoi(m[2])
+		metadata["part"] = part
+		name = m[1]
+	}
+	return strings.TrimSpace(name), metadata
+}
+
+// GetExtensions returns audiobook file extensions
+func (p *AudiobookFileParser) GetExtensions() []string {
+	return scanner.ExtensionsToSlice(scanner.AudiobookExtensions)
+}
+
+// ContentType returns "audiobook"
+func (p *AudiobookFileParser) ContentType() string {
+	return "audiobook"
+}
+
+// BookPath returns the path identifying the book a file belongs to: the
+// file itself for M4B, otherwise its folder.
+func BookPath(filePath string) string {
+	if strings.EqualFold(filepath.Ext(filePath), ".m4b") {
+		return filePath
+	}
+	return scanner.AlbumDir(filePath)
+}
+
+// bookFile is a file of a book with the tags needed to order it.
+type bookFile struct {
+	scanned audiobook.ScannedFile
+	tags    *audiotags.Tags
+	disc    int
+	part    int
+}
+
+// ReadBook reads the tags of a book's files and builds the book from them.
+// Files play in disc, track and filename order; book metadata comes from
+// the first file that carries it, falling back to the folder names.
+func (p *AudiobookFileParser) ReadBook(bookPath string, filePaths []string) (*audiobook.ScannedBook, error) {
+	files := make([]bookFile, 0, len(filePaths))
+	for _, path := range filePaths {
+		f, err := p.readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	slices.SortStableFunc(files, func(a, b bookFile) int {
+		return cmp.Or(
+			cmp.Compare(a.disc, b.disc),
+			cmp.Compare(a.part, b.part),
+			scanner.NaturalCompare(filepath.Base(a.scanned.FilePath), filepath.Base(b.scanned.FilePath)),
+		)
+	})
+
+	book := &audiobook.ScannedBook{Path: bookPath}
+	for _, f := range files {
+		book.Files = append(book.Files, f.scanned)
+		applyTags(book, f.tags)
+	}
+	if len(files) > 0 {
+		book.CoverPath = scanner.FindAlbumCover(files[0].scanned.FilePath)
+	}
+
+	if book.Title == "" {
+		name := filepath.Base(bookPath)
+		book.Title, _ = p.Parse(name)
+		if book.Title == "" {
+			book.Title = strings.TrimSuffix(name, filepath.Ext(name))
+		}
+	}
+	if len(book.Authors) == 0 {
+		if author := folderAuthor(bookPath); author != "" {
+			book.Authors = []string{author}
+		}
+	}
+	return book, nil
+}
+
+// readFile reads one file's tags. Files without readable tags are still
+// used; MP3s without a length tag are timed from their audio frames.
+func (p *AudiobookFileParser) readFile(path string) (bookFile, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return bookFile{}, err
+	}
+	t, err := audiotags.ReadFile(path)
+	if err != nil {
+		t = &audiotags.Tags{}
+	}
+	if t.Duration == 0 && strings.EqualFold(filepath.Ext(path), ".mp3") {
+		if f, err := os.Open(path); err == nil { // #nosec G304 -- path comes from the library scan
+			t.Duration = audiotags.MPEGDuration(f)
+			_ = f.Close()
+		}
+	}
+
+	_, metadata := p.Parse(filepath.Base(path))
+	part := t.TrackNumber
+	if part == 0 {
+		part, _ = metadata["part"].(int)
+	}
+	// Untagged files are named after the file, keeping trailing numbers
+	// so "Chapter 12.mp3" stays "Chapter 12".
+	fileTitle := t.Title
+	if fileTitle == "" {
+		fileTitle = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if m := leadingPartPattern.FindStringSubmatch(fileTitle); m != nil {
+			fileTitle = m[2]
+		}
+	}
+
+	chapters := make([]audiobook.ScannedChapter, len(t.Chapters))
+	for i, c := range t.Chapters {
+		chapters[i] = audiobook.ScannedChapter{Title: c.Title, Start: c.Start, End: c.End}
+	}
+
+	return bookFile{
+		scanned: audiobook.ScannedFile{
+			FilePath:  path,
+			FileSize:  info.Size(),
+			Container: strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), "."),
+			Title:     fileTitle,
+			Duration:  t.Duration,
+			Chapters:  chapters,
+		},
+		tags: t,
+		disc: t.DiscNumber,
+		part: part,
+	}, nil
+}
+
+// applyTags fills the book fields that are still empty from a file's tags.
+// The album is the book title; the title tag only names the book when
+// there is no album, as with most single-file books.
+func applyTags(book *audiobook.ScannedBook, t *audiotags.Tags) {
+	if book.Title == "" {
+		book.Title = t.Album
+		if book.Title == "" && len(book.Files) == 1 && strings.EqualFold(filepath.Ext(book.Path), ".m4b") {
+			book.Title = t.Title
+		}
+		book.SortTitle = t.AlbumSort
+	}
+	if len(book.Authors) == 0 {
+		switch {
+		case len(t.AlbumArtists) > 0:
+			book.Authors = t.AlbumArtists
+		case t.AlbumArtist != "":
+			book.Authors = splitNames(t.AlbumArtist)
+			book.AuthorSort = t.AlbumArtistSort
+		case len(t.Artists) > 0:
+			book.Authors = t.Artists
+		case t.Artist != "":
+			book.Authors = splitNames(t.Artist)
+			book.AuthorSort = t.ArtistSort
+		}
+	}
+	if len(book.Narrators) == 0 {
+		if t.Narrator != "" {
+			book.Narrators = splitNames(t.Narrator)
+		} else if t.Composer != "" {
+			book.Narrators = splitNames(t.Composer)
+		}
+	}
+	if book.Series == "" && t.Series != "" {
+		book.Series = t.Series
+		book.SeriesIndex = parseSeriesIndex(t.SeriesPart)
+	}
+	book.Subtitle = cmp.Or(book.Subtitle, t.Subtitle)
+	book.Description = cmp.Or(book.Description, t.Description)
+	book.Publisher = cmp.Or(book.Publisher, t.Label)
+	book.Year = cmp.Or(book.Year, t.Year)
+	book.Language = cmp.Or(book.Language, t.Language)
+	book.ASIN = cmp.Or(book.ASIN, t.ASIN)
+	if len(book.Genres) == 0 {
+		book.Genres = t.Genres
+	}
+}
+
+// folderAuthor guesses the author from an "Author/Book" layout. An M4B in
+// a folder of its own name ("Author/Book/Book.m4b") looks one level up.
+func folderAuthor(bookPath string) string {
+	dir := filepath.Dir(bookPath)
+	if strings.EqualFold(filepath.Ext(bookPath), ".m4b") {
+		stem := strings.TrimSuffix(filepath.Base(bookPath), filepath.Ext(bookPath))
+		if !strings.EqualFold(filepath.Base(dir), stem) {
+			return cleanFolderName(filepath.Base(dir))
+		}
+		dir = filepath.Dir(dir)
+	}
+	return cleanFolderName(filepath.Base(dir))
+}
+
+func cleanFolderName(name string) string {
+	if name == "." || name == string(filepath.Separator) {
+		return ""
+	}
+	return name
+}
+
+// splitNames splits "A, B & C" into its names.
+func splitNames(s string) []string {
+	var names []string
+	for _, name := range nameSeparatorPattern.Split(s, -1) {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// parseSeriesIndex parses "3", "2.5" or "Book 3".
+func parseSeriesIndex(s string) *float64 {
+	s = strings.TrimSpace(s)
+	if i := strings.LastIndexByte(s, ' '); i >= 0 {
+		s = s[i+1:]
+	}
+	if s == "" {
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,174 @@
+package adapters
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/shared/audiotags"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+func TestAudiobookFileParser_Parse(t *testing.T) {
+	parser := NewAudiobookFileParser()
+
+	tests := []struct {
+		filename  string
+		wantTitle string
+		wantPart  int
+	}{
+		{"01 - Chapter One.mp3", "Chapter One", 1},
+		{"003. Prologue.mp3", "Prologue", 3},
+		{"The Hobbit - 07.mp3", "The Hobbit", 7},
+		{"The Hobbit Part 2.mp3", "The Hobbit", 2},
+		{"Dune (12).mp3", "Dune", 12},
+		{"Dune.m4b", "Dune", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			title, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantTitle, title)
+			if tt.wantPart == 0 {
+				assert.NotContains(t, metadata, "part")
+			} else {
+				assert.Equal(t, tt.wantPart, metadata["part"])
+			}
+		})
+	}
+}
+
+func TestAudiobookFileParser_Interface(t *testing.T) {
+	var _ scanner.FileParser = (*AudiobookFileParser)(nil)
+
+	parser := NewAudiobookFileParser()
+	assert.Equal(t, "audiobook", parser.ContentType())
+	assert.Contains(t, parser.GetExtensions(), ".m4b")
+	assert.Contains(t, parser.GetExtensions(), ".mp3")
+}
+
+func TestBookPath(t *testing.T) {
+	assert.Equal(t, "/books/Author/Book.m4b", BookPath("/books/Author/Book.m4b"))
+	assert.Equal(t, "/books/Author/Book.M4B", BookPath("/books/Author/Book.M4B"))
+	assert.Equal(t, "/books/Author/Book", BookPath("/books/Author/Book/01.mp3"))
+	assert.Equal(t, "/books/Author/Book", BookPath("/books/Author/Book/CD 2/01.mp3"))
+}
+
+func TestAudiobookFileParser_ReadBook_UntaggedFallsBackToPath(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Frank Herbert", "Dune")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	var paths []string
+	for _, name := range []string{"Dune Part 10.mp3", "Dune Part 2.mp3", "Dune Part 1.mp3"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte("not really audio"), 0o644))
+		paths = append(paths, path)
+	}
+
+	book, err := NewAudiobookFileParser().ReadBook(dir, paths)
+	require.NoError(t, err)
+
+	assert.Equal(t, dir, book.Path)
+	assert.Equal(t, "Dune", book.Title)
+	assert.Equal(t, []string{"Frank Herbert"}, book.Authors)
+	require.Len(t, book.Files, 3)
+	assert.Equal(t, "Dune Part 1", book.Files[0].Title)
+	assert.Equal(t, "Dune Part 2", book.Files[1].Title)
+	assert.Equal(t, "Dune Part 10", book.Files[2].Title)
+	assert.Equal(t, "mp3", book.Files[0].Container)
+	assert.Equal(t, int64(3*len("not really audio")), book.TotalSize())
+}
+
+func TestAudiobookFileParser_ReadBook_MissingFile(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewAudiobookFileParser().ReadBook(dir, []string{filepath.Join(dir, "missing.mp3")})
+	assert.Error(t, err)
+}
+
+func TestApplyTags(t *testing.T) {
+	book := &audiobook.ScannedBook{Path: "/books/Author/Book"}
+	book.Files = []audiobook.ScannedFile{{}}
+
+	applyTags(book, &audiotags.Tags{
+		Album:       "The Final Empire",
+		AlbumSort:   "Final Empire, The",
+		Title:       "Chapter 1",
+		AlbumArtist: "Brandon Sanderson",
+		Composer:    "Michael Kramer",
+		Series:      "Mistborn",
+		SeriesPart:  "Book 1",
+		Label:       "Macmillan Audio",
+		Year:        2006,
+		ASIN:        "B002UZMLXM",
+	})
+	// Later files only fill what is still empty
+	applyTags(book, &audiotags.Tags{
+		Album:       "Something Else",
+		Artist:      "Someone Else",
+		Narrator:    "Another Narrator",
+		Description: "Mistborn is a fantasy novel.",
+	})
+
+	assert.Equal(t, "The Final Empire", book.Title)
+	assert.Equal(t, "Final Empire, The", book.SortTitle)
+	assert.Equal(t, []string{"Brandon Sanderson"}, book.Authors)
+	assert.Equal(t, []string{"Michael Kramer"}, book.Narrators)
+	assert.Equal(t, "Mistborn", book.Series)
+	require.NotNil(t, book.SeriesIndex)
+	assert.InDelta(t, 1.0, *book.SeriesIndex, 0.001)
+	assert.Equal(t, "Macmillan Audio", book.Publisher)
+	assert.Equal(t, 2006, book.Year)
+	assert.Equal(t, "B002UZMLXM", book.ASIN)
+	assert.Equal(t, "Mistborn is a fantasy novel.", book.Description)
+}
+
+func TestApplyTags_SingleM4BUsesTitle(t *testing.T) {
+	book := &audiobook.ScannedBook{Path: "/books/Author/Book.m4b"}
+	book.Files = []audiobook.ScannedFile{{}}
+
+	applyTags(book, &audiotags.Tags{Title: "Project Hail Mary", Artist: "Andy Weir", Narrator: "Ray Porter"})
+
+	assert.Equal(t, "Project Hail Mary", book.Title)
+	assert.Equal(t, []string{"Andy Weir"}, book.Authors)
+	assert.Equal(t, []string{"Ray Porter"}, book.Narrators)
+}
+
+func TestFolderAuthor(t *testing.T) {
+	assert.Equal(t, "Andy Weir", folderAuthor("/books/Andy Weir/Project Hail Mary"))
+	assert.Equal(t, "Andy Weir", folderAuthor("/books/Andy Weir/Project Hail Mary.m4b"))
+	assert.Equal(t, "Andy Weir", folderAuthor("/books/Andy Weir/Project Hail Mary/Project Hail Mary.m4b"))
+}
+
+func TestSplitNames(t *testing.T) {
+	assert.Equal(t, []string{"Terry Pratchett", "Neil Gaiman"}, splitNames("Terry Pratchett & Neil Gaiman"))
+	assert.Equal(t, []string{"A", "B", "C"}, splitNames("A, B and C"))
+	assert.Equal(t, []string{"Kate Reading", "Michael Kramer"}, splitNames("Kate Reading; Michael Kramer"))
+	assert.Equal(t, []string{"Andrew Sandbrook"}, splitNames("Andrew Sandbrook"), "names containing 'and' are kept")
+	assert.Empty(t, splitNames(" "))
+}
+
+func TestParseSeriesIndex(t *testing.T) {
+	tests := []struct {
+		in   string
+		want *float64
+	}{
+		{"3", new(3.0)},
+		{"2.5", new(2.5)},
+		{"Book 4", new(4.0)},
+		{"", nil},
+		{"first", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := parseSeriesIndex(tt.in)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.InDelta(t, *tt.want, *got, 0.001)
+		})
+	}
+}
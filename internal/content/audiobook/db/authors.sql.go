@@ -0,0 +1,405 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: authors.sql
+
+package audiobookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const addBookAuthor = `-- name: AddBookAuthor :exec
+INSERT INTO
+    audiobook.book_authors (book_id, author_id, position)
+VALUES ($1, $2, $3) ON CONFLICT (book_id, author_id) DO NOTHING
+`
+
+type AddBookAuthorParams struct {
+	BookID   uuid.UUID `json:"bookId"`
+	AuthorID uuid.UUID `json:"authorId"`
+	Position int32     `json:"position"`
+}
+
+func (q *Queries) AddBookAuthor(ctx context.Context, arg AddBookAuthorParams) error {
+	_, err := q.db.Exec(ctx, addBookAuthor, arg.BookID, arg.AuthorID, arg.Position)
+	return err
+}
+
+const countAuthors = `-- name: CountAuthors :one
+SELECT COUNT(*)
+FROM audiobook.authors a
+WHERE (
+        $1::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.book_authors ba
+                JOIN audiobook.books b ON b.id = ba.book_id
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE ba.author_id = a.id AND starts_with(b.path, lp.path)
+        )
+    )
+`
+
+type CountAuthorsParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+func (q *Queries) CountAuthors(ctx context.Context, arg CountAuthorsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuthors, arg.Restrict, arg.LibraryPaths)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSeries = `-- name: CountSeries :one
+SELECT COUNT(*)
+FROM audiobook.series s
+WHERE (
+        $1::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.books b
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE b.series_id = s.id AND starts_with(b.path, lp.path)
+        )
+    )
+`
+
+type CountSeriesParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+func (q *Queries) CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeries, arg.Restrict, arg.LibraryPaths)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuthor = `-- name: CreateAuthor :one
+INSERT INTO
+    audiobook.authors (name, sort_name)
+VALUES ($1, $2) RETURNING id, name, sort_name, created_at, updated_at
+`
+
+type CreateAuthorParams struct {
+	Name     string `json:"name"`
+	SortName string `json:"sortName"`
+}
+
+func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (AudiobookAuthor, error) {
+	row := q.db.QueryRow(ctx, createAuthor, arg.Name, arg.SortName)
+	var i AudiobookAuthor
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SortName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createSeries = `-- name: CreateSeries :one
+INSERT INTO
+    audiobook.series (name, sort_name)
+VALUES ($1, $2) RETURNING id, name, sort_name, created_at, updated_at
+`
+
+type CreateSeriesParams struct {
+	Name     string `json:"name"`
+	SortName string `json:"sortName"`
+}
+
+func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (AudiobookSeries, error) {
+	row := q.db.QueryRow(ctx, createSeries, arg.Name, arg.SortName)
+	var i AudiobookSeries
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SortName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteBookAuthors = `-- name: DeleteBookAuthors :exec
+DELETE FROM audiobook.book_authors WHERE book_id = $1
+`
+
+func (q *Queries) DeleteBookAuthors(ctx context.Context, bookID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBookAuthors, bookID)
+	return err
+}
+
+const deleteEmptySeries = `-- name: DeleteEmptySeries :execrows
+DELETE FROM audiobook.series s
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM audiobook.books b
+        WHERE
+            b.series_id = s.id
+    )
+`
+
+func (q *Queries) DeleteEmptySeries(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmptySeries)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOrphanAuthors = `-- name: DeleteOrphanAuthors :execrows
+DELETE FROM audiobook.authors a
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM audiobook.book_authors ba
+        WHERE
+            ba.author_id = a.id
+    )
+`
+
+func (q *Queries) DeleteOrphanAuthors(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrphanAuthors)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name, sort_name, created_at, updated_at FROM audiobook.authors WHERE id = $1
+`
+
+func (q *Queries) GetAuthor(ctx context.Context, id uuid.UUID) (AudiobookAuthor, error) {
+	row := q.db.QueryRow(ctx, getAuthor, id)
+	var i AudiobookAuthor
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SortName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAuthorByName = `-- name: GetAuthorByName :one
+SELECT id, name, sort_name, created_at, updated_at
+FROM audiobook.authors
+WHERE
+    lower(name) = lower($1)
+ORDER BY created_at ASC
+LIMIT 1
+`
+
+func (q *Queries) GetAuthorByName(ctx context.Context, name string) (AudiobookAuthor, error) {
+	row := q.db.QueryRow(ctx, getAuthorByName, name)
+	var i AudiobookAuthor
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SortName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSeries = `-- name: GetSeries :one
+SELECT id, name, sort_name, created_at, updated_at FROM audiobook.series WHERE id = $1
+`
+
+func (q *Queries) GetSeries(ctx context.Context, id uuid.UUID) (AudiobookSeries, error) {
+	row := q.db.QueryRow(ctx, getSeries, id)
+	var i AudiobookSeries
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SortName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSeriesByName = `-- name: GetSeriesByName :one
+SELECT id, name, sort_name, created_at, updated_at
+FROM audiobook.series
+WHERE
+    lower(name) = lower($1)
+ORDER BY created_at ASC
+LIMIT 1
+`
+
+func (q *Queries) GetSeriesByName(ctx context.Context, name string) (AudiobookSeries, error) {
+	row := q.db.QueryRow(ctx, getSeriesByName, name)
+	var i AudiobookSeries
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.SortName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT a.id, a.name, a.sort_name, a.created_at, a.updated_at
+FROM audiobook.authors a
+WHERE (
+        $3::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.book_authors ba
+                JOIN audiobook.books b ON b.id = ba.book_id
+                CROSS JOIN unnest($4::text[]) AS lp(path)
+            WHERE ba.author_id = a.id AND starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY a.sort_name ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListAuthorsParams struct {
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+// restrict limits the result to authors of a book under library_paths.
+func (q *Queries) ListAuthors(ctx context.Context, arg ListAuthorsParams) ([]AudiobookAuthor, error) {
+	rows, err := q.db.Query(ctx, listAuthors,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookAuthor{}
+	for rows.Next() {
+		var i AudiobookAuthor
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.SortName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBookAuthors = `-- name: ListBookAuthors :many
+SELECT a.id, a.name, a.sort_name, a.created_at, a.updated_at
+FROM audiobook.authors a
+    JOIN audiobook.book_authors ba ON ba.author_id = a.id
+WHERE
+    ba.book_id = $1
+ORDER BY ba.position ASC
+`
+
+func (q *Queries) ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]AudiobookAuthor, error) {
+	rows, err := q.db.Query(ctx, listBookAuthors, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookAuthor{}
+	for rows.Next() {
+		var i AudiobookAuthor
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.SortName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeries = `-- name: ListSeries :many
+SELECT s.id, s.name, s.sort_name, s.created_at, s.updated_at
+FROM audiobook.series s
+WHERE (
+        $3::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.books b
+                CROSS JOIN unnest($4::text[]) AS lp(path)
+            WHERE b.series_id = s.id AND starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY s.sort_name ASC, s.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListSeriesParams struct {
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+// restrict limits the result to series with a book under library_paths.
+func (q *Queries) ListSeries(ctx context.Context, arg ListSeriesParams) ([]AudiobookSeries, error) {
+	rows, err := q.db.Query(ctx, listSeries,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookSeries{}
+	for rows.Next() {
+		var i AudiobookSeries
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.SortName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
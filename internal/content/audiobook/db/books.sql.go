@@ -0,0 +1,542 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: books.sql
+
+package audiobookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countBooks = `-- name: CountBooks :one
+SELECT COUNT(*)
+FROM audiobook.books b
+WHERE (
+        $1::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($2::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+`
+
+type CountBooksParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+func (q *Queries) CountBooks(ctx context.Context, arg CountBooksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countBooks, arg.Restrict, arg.LibraryPaths)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteBook = `-- name: DeleteBook :exec
+DELETE FROM audiobook.books WHERE id = $1
+`
+
+func (q *Queries) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBook, id)
+	return err
+}
+
+const getBook = `-- name: GetBook :one
+SELECT id, path, title, sort_title, subtitle, author_credit, narrators, series_id, series_index, description, publisher, year, language, genres, asin, duration_ms, file_count, chapter_count, total_size, cover_path, created_at, updated_at FROM audiobook.books WHERE id = $1
+`
+
+func (q *Queries) GetBook(ctx context.Context, id uuid.UUID) (AudiobookBook, error) {
+	row := q.db.QueryRow(ctx, getBook, id)
+	var i AudiobookBook
+	err := row.Scan(
+		&i.ID,
+		&i.Path,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.Narrators,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Genres,
+		&i.Asin,
+		&i.DurationMs,
+		&i.FileCount,
+		&i.ChapterCount,
+		&i.TotalSize,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getBookByPath = `-- name: GetBookByPath :one
+SELECT id, path, title, sort_title, subtitle, author_credit, narrators, series_id, series_index, description, publisher, year, language, genres, asin, duration_ms, file_count, chapter_count, total_size, cover_path, created_at, updated_at FROM audiobook.books WHERE path = $1
+`
+
+func (q *Queries) GetBookByPath(ctx context.Context, path string) (AudiobookBook, error) {
+	row := q.db.QueryRow(ctx, getBookByPath, path)
+	var i AudiobookBook
+	err := row.Scan(
+		&i.ID,
+		&i.Path,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.Narrators,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Genres,
+		&i.Asin,
+		&i.DurationMs,
+		&i.FileCount,
+		&i.ChapterCount,
+		&i.TotalSize,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listBookPaths = `-- name: ListBookPaths :many
+SELECT id, path FROM audiobook.books
+`
+
+type ListBookPathsRow struct {
+	ID   uuid.UUID `json:"id"`
+	Path string    `json:"path"`
+}
+
+func (q *Queries) ListBookPaths(ctx context.Context) ([]ListBookPathsRow, error) {
+	rows, err := q.db.Query(ctx, listBookPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBookPathsRow{}
+	for rows.Next() {
+		var i ListBookPathsRow
+		if err := rows.Scan(&i.ID, &i.Path); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooks = `-- name: ListBooks :many
+SELECT b.id, b.path, b.title, b.sort_title, b.subtitle, b.author_credit, b.narrators, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.genres, b.asin, b.duration_ms, b.file_count, b.chapter_count, b.total_size, b.cover_path, b.created_at, b.updated_at
+FROM audiobook.books b
+WHERE (
+        $3::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($4::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY
+    CASE
+        WHEN $5::text = 'title' THEN b.sort_title
+    END ASC,
+    CASE
+        WHEN $5::text = 'year' THEN b.year
+    END DESC NULLS LAST,
+    b.created_at DESC,
+    b.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListBooksParams struct {
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	OrderBy      string   `json:"orderBy"`
+}
+
+// restrict limits the result to books under library_paths.
+func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]AudiobookBook, error) {
+	rows, err := q.db.Query(ctx, listBooks,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.OrderBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookBook{}
+	for rows.Next() {
+		var i AudiobookBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Path,
+			&i.Title,
+			&i.SortTitle,
+			&i.Subtitle,
+			&i.AuthorCredit,
+			&i.Narrators,
+			&i.SeriesID,
+			&i.SeriesIndex,
+			&i.Description,
+			&i.Publisher,
+			&i.Year,
+			&i.Language,
+			&i.Genres,
+			&i.Asin,
+			&i.DurationMs,
+			&i.FileCount,
+			&i.ChapterCount,
+			&i.TotalSize,
+			&i.CoverPath,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksByAuthor = `-- name: ListBooksByAuthor :many
+SELECT b.id, b.path, b.title, b.sort_title, b.subtitle, b.author_credit, b.narrators, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.genres, b.asin, b.duration_ms, b.file_count, b.chapter_count, b.total_size, b.cover_path, b.created_at, b.updated_at
+FROM audiobook.books b
+    JOIN audiobook.book_authors ba ON ba.book_id = b.id
+WHERE
+    ba.author_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($3::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY b.year ASC NULLS LAST, b.sort_title ASC
+`
+
+type ListBooksByAuthorParams struct {
+	AuthorID     uuid.UUID `json:"authorId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+}
+
+// restrict limits the result to books under library_paths.
+func (q *Queries) ListBooksByAuthor(ctx context.Context, arg ListBooksByAuthorParams) ([]AudiobookBook, error) {
+	rows, err := q.db.Query(ctx, listBooksByAuthor, arg.AuthorID, arg.Restrict, arg.LibraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookBook{}
+	for rows.Next() {
+		var i AudiobookBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Path,
+			&i.Title,
+			&i.SortTitle,
+			&i.Subtitle,
+			&i.AuthorCredit,
+			&i.Narrators,
+			&i.SeriesID,
+			&i.SeriesIndex,
+			&i.Description,
+			&i.Publisher,
+			&i.Year,
+			&i.Language,
+			&i.Genres,
+			&i.Asin,
+			&i.DurationMs,
+			&i.FileCount,
+			&i.ChapterCount,
+			&i.TotalSize,
+			&i.CoverPath,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksBySeries = `-- name: ListBooksBySeries :many
+SELECT b.id, b.path, b.title, b.sort_title, b.subtitle, b.author_credit, b.narrators, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.genres, b.asin, b.duration_ms, b.file_count, b.chapter_count, b.total_size, b.cover_path, b.created_at, b.updated_at
+FROM audiobook.books b
+WHERE
+    b.series_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($3::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY b.series_index ASC NULLS LAST, b.year ASC NULLS LAST, b.sort_title ASC
+`
+
+type ListBooksBySeriesParams struct {
+	SeriesID     pgtype.UUID `json:"seriesId"`
+	Restrict     bool        `json:"restrict"`
+	LibraryPaths []string    `json:"libraryPaths"`
+}
+
+// restrict limits the result to books under library_paths.
+func (q *Queries) ListBooksBySeries(ctx context.Context, arg ListBooksBySeriesParams) ([]AudiobookBook, error) {
+	rows, err := q.db.Query(ctx, listBooksBySeries, arg.SeriesID, arg.Restrict, arg.LibraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookBook{}
+	for rows.Next() {
+		var i AudiobookBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Path,
+			&i.Title,
+			&i.SortTitle,
+			&i.Subtitle,
+			&i.AuthorCredit,
+			&i.Narrators,
+			&i.SeriesID,
+			&i.SeriesIndex,
+			&i.Description,
+			&i.Publisher,
+			&i.Year,
+			&i.Language,
+			&i.Genres,
+			&i.Asin,
+			&i.DurationMs,
+			&i.FileCount,
+			&i.ChapterCount,
+			&i.TotalSize,
+			&i.CoverPath,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshBookStats = `-- name: RefreshBookStats :one
+UPDATE audiobook.books b
+SET
+    duration_ms = (
+        SELECT COALESCE(SUM(f.duration_ms), 0)::BIGINT
+        FROM audiobook.files f
+        WHERE
+            f.book_id = b.id
+    ),
+    file_count = (
+        SELECT COUNT(*)
+        FROM audiobook.files f
+        WHERE
+            f.book_id = b.id
+    ),
+    total_size = (
+        SELECT COALESCE(SUM(f.file_size), 0)::BIGINT
+        FROM audiobook.files f
+        WHERE
+            f.book_id = b.id
+    ),
+    chapter_count = (
+        SELECT COUNT(*)
+        FROM audiobook.chapters c
+        WHERE
+            c.book_id = b.id
+    )
+WHERE
+    b.id = $1 RETURNING b.id, b.path, b.title, b.sort_title, b.subtitle, b.author_credit, b.narrators, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.genres, b.asin, b.duration_ms, b.file_count, b.chapter_count, b.total_size, b.cover_path, b.created_at, b.updated_at
+`
+
+// Recomputes the denormalized duration, file and chapter totals.
+func (q *Queries) RefreshBookStats(ctx context.Context, id uuid.UUID) (AudiobookBook, error) {
+	row := q.db.QueryRow(ctx, refreshBookStats, id)
+	var i AudiobookBook
+	err := row.Scan(
+		&i.ID,
+		&i.Path,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.Narrators,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Genres,
+		&i.Asin,
+		&i.DurationMs,
+		&i.FileCount,
+		&i.ChapterCount,
+		&i.TotalSize,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertBook = `-- name: UpsertBook :one
+INSERT INTO
+    audiobook.books (
+        path,
+        title,
+        sort_title,
+        subtitle,
+        author_credit,
+        narrators,
+        series_id,
+        series_index,
+        description,
+        publisher,
+        year,
+        language,
+        genres,
+        asin,
+        cover_path
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    )
+ON CONFLICT (path) DO
+UPDATE
+SET
+    title = EXCLUDED.title,
+    sort_title = EXCLUDED.sort_title,
+    subtitle = EXCLUDED.subtitle,
+    author_credit = EXCLUDED.author_credit,
+    narrators = EXCLUDED.narrators,
+    series_id = EXCLUDED.series_id,
+    series_index = EXCLUDED.series_index,
+    description = EXCLUDED.description,
+    publisher = EXCLUDED.publisher,
+    year = EXCLUDED.year,
+    language = EXCLUDED.language,
+    genres = EXCLUDED.genres,
+    asin = EXCLUDED.asin,
+    cover_path = EXCLUDED.cover_path RETURNING id, path, title, sort_title, subtitle, author_credit, narrators, series_id, series_index, description, publisher, year, language, genres, asin, duration_ms, file_count, chapter_count, total_size, cover_path, created_at, updated_at
+`
+
+type UpsertBookParams struct {
+	Path         string      `json:"path"`
+	Title        string      `json:"title"`
+	SortTitle    string      `json:"sortTitle"`
+	Subtitle     *string     `json:"subtitle"`
+	AuthorCredit string      `json:"authorCredit"`
+	Narrators    []string    `json:"narrators"`
+	SeriesID     pgtype.UUID `json:"seriesId"`
+	SeriesIndex  *float32    `json:"seriesIndex"`
+	Description  *string     `json:"description"`
+	Publisher    *string     `json:"publisher"`
+	Year         *int32      `json:"year"`
+	Language     *string     `json:"language"`
+	Genres       []string    `json:"genres"`
+	Asin         *string     `json:"asin"`
+	CoverPath    *string     `json:"coverPath"`
+}
+
+// Books are keyed by their folder (or file) so rescans update them in place.
+func (q *Queries) UpsertBook(ctx context.Context, arg UpsertBookParams) (AudiobookBook, error) {
+	row := q.db.QueryRow(ctx, upsertBook,
+		arg.Path,
+		arg.Title,
+		arg.SortTitle,
+		arg.Subtitle,
+		arg.AuthorCredit,
+		arg.Narrators,
+		arg.SeriesID,
+		arg.SeriesIndex,
+		arg.Description,
+		arg.Publisher,
+		arg.Year,
+		arg.Language,
+		arg.Genres,
+		arg.Asin,
+		arg.CoverPath,
+	)
+	var i AudiobookBook
+	err := row.Scan(
+		&i.ID,
+		&i.Path,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.Narrators,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Genres,
+		&i.Asin,
+		&i.DurationMs,
+		&i.FileCount,
+		&i.ChapterCount,
+		&i.TotalSize,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
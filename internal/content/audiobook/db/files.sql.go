@@ -0,0 +1,205 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: files.sql
+
+package audiobookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createBookChapter = `-- name: CreateBookChapter :one
+INSERT INTO
+    audiobook.chapters (
+        book_id,
+        position,
+        title,
+        start_ms,
+        end_ms
+    )
+VALUES ($1, $2, $3, $4, $5) RETURNING id, book_id, position, title, start_ms, end_ms
+`
+
+type CreateBookChapterParams struct {
+	BookID   uuid.UUID `json:"bookId"`
+	Position int32     `json:"position"`
+	Title    string    `json:"title"`
+	StartMs  int64     `json:"startMs"`
+	EndMs    int64     `json:"endMs"`
+}
+
+func (q *Queries) CreateBookChapter(ctx context.Context, arg CreateBookChapterParams) (AudiobookChapter, error) {
+	row := q.db.QueryRow(ctx, createBookChapter,
+		arg.BookID,
+		arg.Position,
+		arg.Title,
+		arg.StartMs,
+		arg.EndMs,
+	)
+	var i AudiobookChapter
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Position,
+		&i.Title,
+		&i.StartMs,
+		&i.EndMs,
+	)
+	return i, err
+}
+
+const createBookFile = `-- name: CreateBookFile :one
+INSERT INTO
+    audiobook.files (
+        book_id,
+        position,
+        file_path,
+        file_size,
+        container,
+        duration_ms,
+        offset_ms
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, book_id, position, file_path, file_size, container, duration_ms, offset_ms, created_at
+`
+
+type CreateBookFileParams struct {
+	BookID     uuid.UUID `json:"bookId"`
+	Position   int32     `json:"position"`
+	FilePath   string    `json:"filePath"`
+	FileSize   int64     `json:"fileSize"`
+	Container  *string   `json:"container"`
+	DurationMs int64     `json:"durationMs"`
+	OffsetMs   int64     `json:"offsetMs"`
+}
+
+func (q *Queries) CreateBookFile(ctx context.Context, arg CreateBookFileParams) (AudiobookFile, error) {
+	row := q.db.QueryRow(ctx, createBookFile,
+		arg.BookID,
+		arg.Position,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Container,
+		arg.DurationMs,
+		arg.OffsetMs,
+	)
+	var i AudiobookFile
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Position,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.DurationMs,
+		&i.OffsetMs,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteBookChapters = `-- name: DeleteBookChapters :exec
+DELETE FROM audiobook.chapters WHERE book_id = $1
+`
+
+func (q *Queries) DeleteBookChapters(ctx context.Context, bookID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBookChapters, bookID)
+	return err
+}
+
+const deleteBookFiles = `-- name: DeleteBookFiles :exec
+DELETE FROM audiobook.files WHERE book_id = $1
+`
+
+func (q *Queries) DeleteBookFiles(ctx context.Context, bookID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBookFiles, bookID)
+	return err
+}
+
+const getBookFile = `-- name: GetBookFile :one
+SELECT id, book_id, position, file_path, file_size, container, duration_ms, offset_ms, created_at FROM audiobook.files WHERE id = $1
+`
+
+func (q *Queries) GetBookFile(ctx context.Context, id uuid.UUID) (AudiobookFile, error) {
+	row := q.db.QueryRow(ctx, getBookFile, id)
+	var i AudiobookFile
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Position,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.DurationMs,
+		&i.OffsetMs,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listBookChapters = `-- name: ListBookChapters :many
+SELECT id, book_id, position, title, start_ms, end_ms FROM audiobook.chapters WHERE book_id = $1 ORDER BY position ASC
+`
+
+func (q *Queries) ListBookChapters(ctx context.Context, bookID uuid.UUID) ([]AudiobookChapter, error) {
+	rows, err := q.db.Query(ctx, listBookChapters, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookChapter{}
+	for rows.Next() {
+		var i AudiobookChapter
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Position,
+			&i.Title,
+			&i.StartMs,
+			&i.EndMs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBookFiles = `-- name: ListBookFiles :many
+SELECT id, book_id, position, file_path, file_size, container, duration_ms, offset_ms, created_at FROM audiobook.files WHERE book_id = $1 ORDER BY position ASC
+`
+
+func (q *Queries) ListBookFiles(ctx context.Context, bookID uuid.UUID) ([]AudiobookFile, error) {
+	rows, err := q.db.Query(ctx, listBookFiles, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AudiobookFile{}
+	for rows.Next() {
+		var i AudiobookFile
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Position,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Container,
+			&i.DurationMs,
+			&i.OffsetMs,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
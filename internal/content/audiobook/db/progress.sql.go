@@ -0,0 +1,237 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: progress.sql
+
+package audiobookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteBookProgress = `-- name: DeleteBookProgress :exec
+DELETE FROM audiobook.book_progress
+WHERE
+    user_id = $1
+    AND book_id = $2
+`
+
+type DeleteBookProgressParams struct {
+	UserID uuid.UUID `json:"userId"`
+	BookID uuid.UUID `json:"bookId"`
+}
+
+func (q *Queries) DeleteBookProgress(ctx context.Context, arg DeleteBookProgressParams) error {
+	_, err := q.db.Exec(ctx, deleteBookProgress, arg.UserID, arg.BookID)
+	return err
+}
+
+const getBookProgress = `-- name: GetBookProgress :one
+SELECT id, user_id, book_id, position_ms, duration_ms, playback_speed, progress_percent, is_completed, listen_count, last_listened_at, completed_at, created_at, updated_at
+FROM audiobook.book_progress
+WHERE
+    user_id = $1
+    AND book_id = $2
+`
+
+type GetBookProgressParams struct {
+	UserID uuid.UUID `json:"userId"`
+	BookID uuid.UUID `json:"bookId"`
+}
+
+func (q *Queries) GetBookProgress(ctx context.Context, arg GetBookProgressParams) (AudiobookBookProgress, error) {
+	row := q.db.QueryRow(ctx, getBookProgress, arg.UserID, arg.BookID)
+	var i AudiobookBookProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.BookID,
+		&i.PositionMs,
+		&i.DurationMs,
+		&i.PlaybackSpeed,
+		&i.ProgressPercent,
+		&i.IsCompleted,
+		&i.ListenCount,
+		&i.LastListenedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listContinueListening = `-- name: ListContinueListening :many
+SELECT
+    b.id, b.path, b.title, b.sort_title, b.subtitle, b.author_credit, b.narrators, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.genres, b.asin, b.duration_ms, b.file_count, b.chapter_count, b.total_size, b.cover_path, b.created_at, b.updated_at,
+    p.id, p.user_id, p.book_id, p.position_ms, p.duration_ms, p.playback_speed, p.progress_percent, p.is_completed, p.listen_count, p.last_listened_at, p.completed_at, p.created_at, p.updated_at
+FROM audiobook.books b
+    JOIN audiobook.book_progress p ON p.book_id = b.id
+WHERE
+    p.user_id = $1
+    AND p.is_completed = FALSE
+    AND p.position_ms > 0
+    AND (
+        $3::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($4::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY p.last_listened_at DESC
+LIMIT $2
+`
+
+type ListContinueListeningParams struct {
+	UserID       uuid.UUID `json:"userId"`
+	Limit        int32     `json:"limit"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+}
+
+type ListContinueListeningRow struct {
+	AudiobookBook         AudiobookBook         `json:"audiobookBook"`
+	AudiobookBookProgress AudiobookBookProgress `json:"audiobookBookProgress"`
+}
+
+// restrict limits the result to books under library_paths.
+func (q *Queries) ListContinueListening(ctx context.Context, arg ListContinueListeningParams) ([]ListContinueListeningRow, error) {
+	rows, err := q.db.Query(ctx, listContinueListening,
+		arg.UserID,
+		arg.Limit,
+		arg.Restrict,
+		arg.LibraryPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListContinueListeningRow{}
+	for rows.Next() {
+		var i ListContinueListeningRow
+		if err := rows.Scan(
+			&i.AudiobookBook.ID,
+			&i.AudiobookBook.Path,
+			&i.AudiobookBook.Title,
+			&i.AudiobookBook.SortTitle,
+			&i.AudiobookBook.Subtitle,
+			&i.AudiobookBook.AuthorCredit,
+			&i.AudiobookBook.Narrators,
+			&i.AudiobookBook.SeriesID,
+			&i.AudiobookBook.SeriesIndex,
+			&i.AudiobookBook.Description,
+			&i.AudiobookBook.Publisher,
+			&i.AudiobookBook.Year,
+			&i.AudiobookBook.Language,
+			&i.AudiobookBook.Genres,
+			&i.AudiobookBook.Asin,
+			&i.AudiobookBook.DurationMs,
+			&i.AudiobookBook.FileCount,
+			&i.AudiobookBook.ChapterCount,
+			&i.AudiobookBook.TotalSize,
+			&i.AudiobookBook.CoverPath,
+			&i.AudiobookBook.CreatedAt,
+			&i.AudiobookBook.UpdatedAt,
+			&i.AudiobookBookProgress.ID,
+			&i.AudiobookBookProgress.UserID,
+			&i.AudiobookBookProgress.BookID,
+			&i.AudiobookBookProgress.PositionMs,
+			&i.AudiobookBookProgress.DurationMs,
+			&i.AudiobookBookProgress.PlaybackSpeed,
+			&i.AudiobookBookProgress.ProgressPercent,
+			&i.AudiobookBookProgress.IsCompleted,
+			&i.AudiobookBookProgress.ListenCount,
+			&i.AudiobookBookProgress.LastListenedAt,
+			&i.AudiobookBookProgress.CompletedAt,
+			&i.AudiobookBookProgress.CreatedAt,
+			&i.AudiobookBookProgress.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertBookProgress = `-- name: UpsertBookProgress :one
+INSERT INTO
+    audiobook.book_progress (
+        user_id,
+        book_id,
+        position_ms,
+        duration_ms,
+        playback_speed,
+        is_completed,
+        listen_count,
+        completed_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6::boolean,
+        CASE WHEN $6::boolean THEN 1 ELSE 0 END,
+        CASE WHEN $6::boolean THEN NOW() END
+    ) ON CONFLICT (user_id, book_id) DO
+UPDATE
+SET
+    position_ms = EXCLUDED.position_ms,
+    duration_ms = EXCLUDED.duration_ms,
+    playback_speed = EXCLUDED.playback_speed,
+    is_completed = EXCLUDED.is_completed,
+    listen_count = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT book_progress.is_completed THEN book_progress.listen_count + 1
+        ELSE book_progress.listen_count
+    END,
+    completed_at = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT book_progress.is_completed THEN NOW()
+        WHEN EXCLUDED.is_completed THEN book_progress.completed_at
+    END,
+    last_listened_at = NOW() RETURNING id, user_id, book_id, position_ms, duration_ms, playback_speed, progress_percent, is_completed, listen_count, last_listened_at, completed_at, created_at, updated_at
+`
+
+type UpsertBookProgressParams struct {
+	UserID        uuid.UUID `json:"userId"`
+	BookID        uuid.UUID `json:"bookId"`
+	PositionMs    int64     `json:"positionMs"`
+	DurationMs    int64     `json:"durationMs"`
+	PlaybackSpeed float32   `json:"playbackSpeed"`
+	IsCompleted   bool      `json:"isCompleted"`
+}
+
+func (q *Queries) UpsertBookProgress(ctx context.Context, arg UpsertBookProgressParams) (AudiobookBookProgress, error) {
+	row := q.db.QueryRow(ctx, upsertBookProgress,
+		arg.UserID,
+		arg.BookID,
+		arg.PositionMs,
+		arg.DurationMs,
+		arg.PlaybackSpeed,
+		arg.IsCompleted,
+	)
+	var i AudiobookBookProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.BookID,
+		&i.PositionMs,
+		&i.DurationMs,
+		&i.PlaybackSpeed,
+		&i.ProgressPercent,
+		&i.IsCompleted,
+		&i.ListenCount,
+		&i.LastListenedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
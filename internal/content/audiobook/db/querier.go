@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package audiobookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Querier interface {
+	AddBookAuthor(ctx context.Context, arg AddBookAuthorParams) error
+	CountAuthors(ctx context.Context, arg CountAuthorsParams) (int64, error)
+	CountBooks(ctx context.Context, arg CountBooksParams) (int64, error)
+	CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error)
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (AudiobookAuthor, error)
+	CreateBookChapter(ctx context.Context, arg CreateBookChapterParams) (AudiobookChapter, error)
+	CreateBookFile(ctx context.Context, arg CreateBookFileParams) (AudiobookFile, error)
+	CreateSeries(ctx context.Context, arg CreateSeriesParams) (AudiobookSeries, error)
+	DeleteBook(ctx context.Context, id uuid.UUID) error
+	DeleteBookAuthors(ctx context.Context, bookID uuid.UUID) error
+	DeleteBookChapters(ctx context.Context, bookID uuid.UUID) error
+	DeleteBookFiles(ctx context.Context, bookID uuid.UUID) error
+	DeleteBookProgress(ctx context.Context, arg DeleteBookProgressParams) error
+	DeleteEmptySeries(ctx context.Context) (int64, error)
+	DeleteOrphanAuthors(ctx context.Context) (int64, error)
+	GetAuthor(ctx context.Context, id uuid.UUID) (AudiobookAuthor, error)
+	GetAuthorByName(ctx context.Context, name string) (AudiobookAuthor, error)
+	GetBook(ctx context.Context, id uuid.UUID) (AudiobookBook, error)
+	GetBookByPath(ctx context.Context, path string) (AudiobookBook, error)
+	GetBookFile(ctx context.Context, id uuid.UUID) (AudiobookFile, error)
+	GetBookProgress(ctx context.Context, arg GetBookProgressParams) (AudiobookBookProgress, error)
+	GetSeries(ctx context.Context, id uuid.UUID) (AudiobookSeries, error)
+	GetSeriesByName(ctx context.Context, name string) (AudiobookSeries, error)
+	// restrict limits the result to authors of a book under library_paths.
+	ListAuthors(ctx context.Context, arg ListAuthorsParams) ([]AudiobookAuthor, error)
+	ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]AudiobookAuthor, error)
+	ListBookChapters(ctx context.Context, bookID uuid.UUID) ([]AudiobookChapter, error)
+	ListBookFiles(ctx context.Context, bookID uuid.UUID) ([]AudiobookFile, error)
+	ListBookPaths(ctx context.Context) ([]ListBookPathsRow, error)
+	// restrict limits the result to books under library_paths.
+	ListBooks(ctx context.Context, arg ListBooksParams) ([]AudiobookBook, error)
+	// restrict limits the result to books under library_paths.
+	ListBooksByAuthor(ctx context.Context, arg ListBooksByAuthorParams) ([]AudiobookBook, error)
+	// restrict limits the result to books under library_paths.
+	ListBooksBySeries(ctx context.Context, arg ListBooksBySeriesParams) ([]AudiobookBook, error)
+	// restrict limits the result to books under library_paths.
+	ListContinueListening(ctx context.Context, arg ListContinueListeningParams) ([]ListContinueListeningRow, error)
+	// restrict limits the result to series with a book under library_paths.
+	ListSeries(ctx context.Context, arg ListSeriesParams) ([]AudiobookSeries, error)
+	// Recomputes the denormalized duration, file and chapter totals.
+	RefreshBookStats(ctx context.Context, id uuid.UUID) (AudiobookBook, error)
+	// Books are keyed by their folder (or file) so rescans update them in place.
+	UpsertBook(ctx context.Context, arg UpsertBookParams) (AudiobookBook, error)
+	UpsertBookProgress(ctx context.Context, arg UpsertBookProgressParams) (AudiobookBookProgress, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,12 @@
+package audiobook
+
+import "errors"
+
+var (
+	ErrBookNotFound         = errors.New("book not found")
+	ErrAuthorNotFound       = errors.New("author not found")
+	ErrSeriesNotFound       = errors.New("series not found")
+	ErrBookFileNotFound     = errors.New("book file not found")
+	ErrProgressNotFound     = errors.New("progress not found")
+	ErrInvalidPlaybackSpeed = errors.New("invalid playback speed")
+)
@@ -0,0 +1,269 @@
+// Package jobs provides background job workers for audiobook content.
+// Library scans group audio files into books, read their tags and chapters
+// and import them; no metadata provider lookup is needed.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/audiobook/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// KindLibraryScan is the job kind of audiobook library scans.
+const KindLibraryScan = "audiobook_library_scan"
+
+// LibraryScanArgs defines arguments for audiobook library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for audiobooks.
+	Paths []string `json:"paths"`
+
+	// Force re-reads the tags of books that are already imported.
+	Force bool `json:"force"`
+
+	// LibraryID is the optional library ID to scan.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for audiobook library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans audiobook library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             audiobook.Service
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service audiobook.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "audiobook_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Any("paths", job.Args.Paths),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, "audiobook", job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", "audiobook"))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+
+	status.Start(ctx)
+
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+
+	parser := adapters.NewAudiobookFileParser()
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, "audiobook", libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	books := groupBooks(scanResults)
+	present := make(map[string]bool, len(books))
+	processed := 0
+
+	for _, b := range books {
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(books),
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    b.path,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+		present[b.path] = true
+
+		// Books whose files are unchanged keep their tags unless a full
+		// rescan is forced
+		existing, err := w.service.GetBookByPath(ctx, b.path)
+		if err == nil && existing != nil && !job.Args.Force &&
+			existing.TotalSize == b.size && int(existing.FileCount) == len(b.files) {
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadBook(b.path, b.files)
+		if err != nil {
+			w.logger.Warn("failed to read book",
+				slog.String("path", b.path),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("read %s: %w", b.path, err))
+			continue
+		}
+		if _, err := w.service.ImportBook(ctx, scanned); err != nil {
+			w.logger.Warn("failed to import book",
+				slog.String("path", b.path),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("import %s: %w", b.path, err))
+			continue
+		}
+		result.ItemsProcessed++
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(books),
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	// An empty result usually means an unmounted library, not deleted books
+	itemsRemoved := 0
+	if len(books) > 0 {
+		itemsRemoved, err = w.service.RemoveMissing(ctx, job.Args.Paths, present)
+		if err != nil {
+			w.logger.Warn("failed to remove missing books", slog.Any("error", err))
+		}
+	}
+	if result.ItemsProcessed > 0 || itemsRemoved > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty series and authors", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_skipped", itemsSkipped),
+		slog.Int("items_removed", itemsRemoved),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ItemsRemoved: util.SafeIntToInt32(itemsRemoved),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", "audiobook").
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	return nil
+}
+
+// scannedBook is the files found for one book.
+type scannedBook struct {
+	path  string
+	files []string
+	size  int64
+}
+
+// groupBooks groups the media files of a scan into books, keeping the
+// order in which the books were first found.
+func groupBooks(results []scanner.ScanResult) []scannedBook {
+	var books []scannedBook
+	index := make(map[string]int)
+	for _, sr := range results {
+		if !sr.IsMedia {
+			continue
+		}
+		path := adapters.BookPath(sr.FilePath)
+		i, ok := index[path]
+		if !ok {
+			i = len(books)
+			index[path] = i
+			books = append(books, scannedBook{path: path})
+		}
+		books[i].files = append(books[i].files, sr.FilePath)
+		books[i].size += sr.FileSize
+	}
+	return books
+}
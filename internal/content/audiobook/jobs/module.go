@@ -0,0 +1,43 @@
+package jobs
+
+import (
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// Module provides the audiobook jobs workers.
+var Module = fx.Module("audiobookjobs",
+	fx.Provide(
+		provideLibraryScanWorker,
+	),
+	fx.Invoke(RegisterWorkers),
+)
+
+// WorkerProviderParams holds common dependencies for worker providers.
+type WorkerProviderParams struct {
+	fx.In
+
+	Service             audiobook.Service
+	JobClient           *infrajobs.Client
+	NotificationService notification.Service
+	ScanStatusService   *library.Service `optional:"true"`
+	Logger              *slog.Logger
+}
+
+// provideLibraryScanWorker creates a library scan worker.
+func provideLibraryScanWorker(p WorkerProviderParams) *LibraryScanWorker {
+	return NewLibraryScanWorker(p.Service, p.JobClient, p.NotificationService, p.ScanStatusService, p.Logger)
+}
+
+// RegisterWorkers registers all audiobook job workers with the River workers registry.
+func RegisterWorkers(workers *river.Workers, libraryScanWorker *LibraryScanWorker) error {
+	river.AddWorker(workers, libraryScanWorker)
+	return nil
+}
@@ -0,0 +1,11 @@
+package audiobook
+
+import "go.uber.org/fx"
+
+// Module provides the audiobook content module.
+var Module = fx.Module("audiobook",
+	fx.Provide(
+		NewPostgresRepository,
+		NewService,
+	),
+)
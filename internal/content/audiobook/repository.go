@@ -0,0 +1,103 @@
+package audiobook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for audiobooks
+type Repository interface {
+	// Authors
+	GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error)
+	GetAuthorByName(ctx context.Context, name string) (*Author, error)
+	CreateAuthor(ctx context.Context, name, sortName string) (*Author, error)
+	ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, error)
+	CountAuthors(ctx context.Context, filters AuthorListFilters) (int64, error)
+	ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error)
+	DeleteOrphanAuthors(ctx context.Context) (int64, error)
+
+	// Series
+	GetSeries(ctx context.Context, id uuid.UUID) (*Series, error)
+	GetSeriesByName(ctx context.Context, name string) (*Series, error)
+	CreateSeries(ctx context.Context, name, sortName string) (*Series, error)
+	ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error)
+	CountSeries(ctx context.Context, filters SeriesListFilters) (int64, error)
+	DeleteEmptySeries(ctx context.Context) (int64, error)
+
+	// Books
+	GetBook(ctx context.Context, id uuid.UUID) (*Book, error)
+	GetBookByPath(ctx context.Context, path string) (*Book, error)
+	UpsertBook(ctx context.Context, params UpsertBookParams) (*Book, error)
+	SetBookContents(ctx context.Context, bookID uuid.UUID, contents BookContents) (*Book, error)
+	ListBooks(ctx context.Context, filters BookListFilters) ([]Book, error)
+	CountBooks(ctx context.Context, filters BookListFilters) (int64, error)
+	ListBooksByAuthor(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error)
+	ListBooksBySeries(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error)
+	ListBookPaths(ctx context.Context) (map[string]uuid.UUID, error)
+	DeleteBook(ctx context.Context, id uuid.UUID) error
+
+	// Files and chapters
+	GetBookFile(ctx context.Context, id uuid.UUID) (*BookFile, error)
+	ListBookFiles(ctx context.Context, bookID uuid.UUID) ([]BookFile, error)
+	ListBookChapters(ctx context.Context, bookID uuid.UUID) ([]Chapter, error)
+
+	// Listening progress
+	UpsertProgress(ctx context.Context, params UpsertProgressParams) (*Progress, error)
+	GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error)
+	DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error
+	ListContinueListening(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueListeningItem, error)
+}
+
+// UpsertBookParams contains parameters for creating or updating a book
+type UpsertBookParams struct {
+	Path         string
+	Title        string
+	SortTitle    string
+	Subtitle     *string
+	AuthorCredit string
+	Narrators    []string
+	SeriesID     *uuid.UUID
+	SeriesIndex  *float32
+	Description  *string
+	Publisher    *string
+	Year         *int32
+	Language     *string
+	Genres       []string
+	ASIN         *string
+	CoverPath    *string
+}
+
+// BookContents replaces the authors, files and chapters of a book in one
+// transaction.
+type BookContents struct {
+	AuthorIDs []uuid.UUID
+	Files     []CreateBookFileParams
+	Chapters  []CreateChapterParams
+}
+
+// CreateBookFileParams contains parameters for adding a file to a book
+type CreateBookFileParams struct {
+	FilePath   string
+	FileSize   int64
+	Container  *string
+	DurationMs int64
+	OffsetMs   int64
+}
+
+// CreateChapterParams contains parameters for adding a chapter to a book
+type CreateChapterParams struct {
+	Title   string
+	StartMs int64
+	EndMs   int64
+}
+
+// UpsertProgressParams contains parameters for saving listening progress
+type UpsertProgressParams struct {
+	UserID        uuid.UUID
+	BookID        uuid.UUID
+	PositionMs    int64
+	DurationMs    int64
+	PlaybackSpeed float32
+	IsCompleted   bool
+}
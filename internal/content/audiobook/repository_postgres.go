@@ -0,0 +1,555 @@
+package audiobook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	audiobookdb "github.com/lusoris/revenge/internal/content/audiobook/db"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *audiobookdb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: audiobookdb.New(pool),
+	}
+}
+
+// =============================================================================
+// Authors
+// =============================================================================
+
+func (r *postgresRepository) GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error) {
+	author, err := r.queries.GetAuthor(ctx, id)
+	if err != nil {
+		return nil, authorError(err)
+	}
+	return dbAuthorToAuthor(author), nil
+}
+
+func (r *postgresRepository) GetAuthorByName(ctx context.Context, name string) (*Author, error) {
+	author, err := r.queries.GetAuthorByName(ctx, name)
+	if err != nil {
+		return nil, authorError(err)
+	}
+	return dbAuthorToAuthor(author), nil
+}
+
+func (r *postgresRepository) CreateAuthor(ctx context.Context, name, sortName string) (*Author, error) {
+	author, err := r.queries.CreateAuthor(ctx, audiobookdb.CreateAuthorParams{
+		Name:     name,
+		SortName: sortName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create author: %w", err)
+	}
+	return dbAuthorToAuthor(author), nil
+}
+
+func (r *postgresRepository) ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, error) {
+	authors, err := r.queries.ListAuthors(ctx, audiobookdb.ListAuthorsParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authors: %w", err)
+	}
+	return dbAuthorsToAuthors(authors), nil
+}
+
+func (r *postgresRepository) CountAuthors(ctx context.Context, filters AuthorListFilters) (int64, error) {
+	return r.queries.CountAuthors(ctx, audiobookdb.CountAuthorsParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error) {
+	authors, err := r.queries.ListBookAuthors(ctx, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book authors: %w", err)
+	}
+	return dbAuthorsToAuthors(authors), nil
+}
+
+func (r *postgresRepository) DeleteOrphanAuthors(ctx context.Context) (int64, error) {
+	return r.queries.DeleteOrphanAuthors(ctx)
+}
+
+// =============================================================================
+// Series
+// =============================================================================
+
+func (r *postgresRepository) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	series, err := r.queries.GetSeries(ctx, id)
+	if err != nil {
+		return nil, seriesError(err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+func (r *postgresRepository) GetSeriesByName(ctx context.Context, name string) (*Series, error) {
+	series, err := r.queries.GetSeriesByName(ctx, name)
+	if err != nil {
+		return nil, seriesError(err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+func (r *postgresRepository) CreateSeries(ctx context.Context, name, sortName string) (*Series, error) {
+	series, err := r.queries.CreateSeries(ctx, audiobookdb.CreateSeriesParams{
+		Name:     name,
+		SortName: sortName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create series: %w", err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+func (r *postgresRepository) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error) {
+	rows, err := r.queries.ListSeries(ctx, audiobookdb.ListSeriesParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+	series := make([]Series, len(rows))
+	for i, s := range rows {
+		series[i] = *dbSeriesToSeries(s)
+	}
+	return series, nil
+}
+
+func (r *postgresRepository) CountSeries(ctx context.Context, filters SeriesListFilters) (int64, error) {
+	return r.queries.CountSeries(ctx, audiobookdb.CountSeriesParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) DeleteEmptySeries(ctx context.Context) (int64, error) {
+	return r.queries.DeleteEmptySeries(ctx)
+}
+
+// =============================================================================
+// Books
+// =============================================================================
+
+func (r *postgresRepository) GetBook(ctx context.Context, id uuid.UUID) (*Book, error) {
+	book, err := r.queries.GetBook(ctx, id)
+	if err != nil {
+		return nil, bookError(err)
+	}
+	return dbBookToBook(book), nil
+}
+
+func (r *postgresRepository) GetBookByPath(ctx context.Context, path string) (*Book, error) {
+	book, err := r.queries.GetBookByPath(ctx, path)
+	if err != nil {
+		return nil, bookError(err)
+	}
+	return dbBookToBook(book), nil
+}
+
+func (r *postgresRepository) UpsertBook(ctx context.Context, params UpsertBookParams) (*Book, error) {
+	book, err := r.queries.UpsertBook(ctx, audiobookdb.UpsertBookParams{
+		Path:         params.Path,
+		Title:        params.Title,
+		SortTitle:    params.SortTitle,
+		Subtitle:     params.Subtitle,
+		AuthorCredit: params.AuthorCredit,
+		Narrators:    params.Narrators,
+		SeriesID:     uuidToPg(params.SeriesID),
+		SeriesIndex:  params.SeriesIndex,
+		Description:  params.Description,
+		Publisher:    params.Publisher,
+		Year:         params.Year,
+		Language:     params.Language,
+		Genres:       params.Genres,
+		Asin:         params.ASIN,
+		CoverPath:    params.CoverPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert book: %w", err)
+	}
+	return dbBookToBook(book), nil
+}
+
+// SetBookContents replaces the authors, files and chapters of a book and
+// refreshes its totals.
+func (r *postgresRepository) SetBookContents(ctx context.Context, bookID uuid.UUID, contents BookContents) (*Book, error) {
+	var book audiobookdb.AudiobookBook
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.queries.WithTx(tx)
+		if err := q.DeleteBookAuthors(ctx, bookID); err != nil {
+			return fmt.Errorf("failed to clear book authors: %w", err)
+		}
+		if err := q.DeleteBookFiles(ctx, bookID); err != nil {
+			return fmt.Errorf("failed to clear book files: %w", err)
+		}
+		if err := q.DeleteBookChapters(ctx, bookID); err != nil {
+			return fmt.Errorf("failed to clear book chapters: %w", err)
+		}
+		for i, authorID := range contents.AuthorIDs {
+			if err := q.AddBookAuthor(ctx, audiobookdb.AddBookAuthorParams{
+				BookID:   bookID,
+				AuthorID: authorID,
+				Position: util.SafeIntToInt32(i),
+			}); err != nil {
+				return fmt.Errorf("failed to add book author: %w", err)
+			}
+		}
+		for i, f := range contents.Files {
+			if _, err := q.CreateBookFile(ctx, audiobookdb.CreateBookFileParams{
+				BookID:     bookID,
+				Position:   util.SafeIntToInt32(i),
+				FilePath:   f.FilePath,
+				FileSize:   f.FileSize,
+				Container:  f.Container,
+				DurationMs: f.DurationMs,
+				OffsetMs:   f.OffsetMs,
+			}); err != nil {
+				return fmt.Errorf("failed to add book file: %w", err)
+			}
+		}
+		for i, c := range contents.Chapters {
+			if _, err := q.CreateBookChapter(ctx, audiobookdb.CreateBookChapterParams{
+				BookID:   bookID,
+				Position: util.SafeIntToInt32(i),
+				Title:    c.Title,
+				StartMs:  c.StartMs,
+				EndMs:    c.EndMs,
+			}); err != nil {
+				return fmt.Errorf("failed to add book chapter: %w", err)
+			}
+		}
+		var err error
+		book, err = q.RefreshBookStats(ctx, bookID)
+		if err != nil {
+			return fmt.Errorf("failed to refresh book stats: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbBookToBook(book), nil
+}
+
+func (r *postgresRepository) ListBooks(ctx context.Context, filters BookListFilters) ([]Book, error) {
+	books, err := r.queries.ListBooks(ctx, audiobookdb.ListBooksParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+		OrderBy:      filters.OrderBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books: %w", err)
+	}
+	return dbBooksToBooks(books), nil
+}
+
+func (r *postgresRepository) CountBooks(ctx context.Context, filters BookListFilters) (int64, error) {
+	return r.queries.CountBooks(ctx, audiobookdb.CountBooksParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListBooksByAuthor(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	books, err := r.queries.ListBooksByAuthor(ctx, audiobookdb.ListBooksByAuthorParams{
+		AuthorID:     authorID,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books by author: %w", err)
+	}
+	return dbBooksToBooks(books), nil
+}
+
+func (r *postgresRepository) ListBooksBySeries(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	books, err := r.queries.ListBooksBySeries(ctx, audiobookdb.ListBooksBySeriesParams{
+		SeriesID:     uuidToPg(&seriesID),
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books by series: %w", err)
+	}
+	return dbBooksToBooks(books), nil
+}
+
+// ListBookPaths returns the IDs of all books keyed by their path.
+func (r *postgresRepository) ListBookPaths(ctx context.Context) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ListBookPaths(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book paths: %w", err)
+	}
+	paths := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		paths[row.Path] = row.ID
+	}
+	return paths, nil
+}
+
+func (r *postgresRepository) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteBook(ctx, id)
+}
+
+// =============================================================================
+// Files and chapters
+// =============================================================================
+
+func (r *postgresRepository) GetBookFile(ctx context.Context, id uuid.UUID) (*BookFile, error) {
+	f, err := r.queries.GetBookFile(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBookFileNotFound
+		}
+		return nil, fmt.Errorf("failed to get book file: %w", err)
+	}
+	return dbFileToFile(f), nil
+}
+
+func (r *postgresRepository) ListBookFiles(ctx context.Context, bookID uuid.UUID) ([]BookFile, error) {
+	rows, err := r.queries.ListBookFiles(ctx, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book files: %w", err)
+	}
+	files := make([]BookFile, len(rows))
+	for i, f := range rows {
+		files[i] = *dbFileToFile(f)
+	}
+	return files, nil
+}
+
+func (r *postgresRepository) ListBookChapters(ctx context.Context, bookID uuid.UUID) ([]Chapter, error) {
+	rows, err := r.queries.ListBookChapters(ctx, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book chapters: %w", err)
+	}
+	chapters := make([]Chapter, len(rows))
+	for i, c := range rows {
+		chapters[i] = Chapter{
+			ID:       c.ID,
+			BookID:   c.BookID,
+			Position: c.Position,
+			Title:    c.Title,
+			StartMs:  c.StartMs,
+			EndMs:    c.EndMs,
+		}
+	}
+	return chapters, nil
+}
+
+// =============================================================================
+// Listening progress
+// =============================================================================
+
+func (r *postgresRepository) UpsertProgress(ctx context.Context, params UpsertProgressParams) (*Progress, error) {
+	p, err := r.queries.UpsertBookProgress(ctx, audiobookdb.UpsertBookProgressParams{
+		UserID:        params.UserID,
+		BookID:        params.BookID,
+		PositionMs:    params.PositionMs,
+		DurationMs:    params.DurationMs,
+		PlaybackSpeed: params.PlaybackSpeed,
+		IsCompleted:   params.IsCompleted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error) {
+	p, err := r.queries.GetBookProgress(ctx, audiobookdb.GetBookProgressParams{UserID: userID, BookID: bookID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProgressNotFound
+		}
+		return nil, fmt.Errorf("failed to get progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error {
+	return r.queries.DeleteBookProgress(ctx, audiobookdb.DeleteBookProgressParams{UserID: userID, BookID: bookID})
+}
+
+func (r *postgresRepository) ListContinueListening(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueListeningItem, error) {
+	rows, err := r.queries.ListContinueListening(ctx, audiobookdb.ListContinueListeningParams{
+		UserID:       userID,
+		Limit:        limit,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list continue listening: %w", err)
+	}
+	items := make([]ContinueListeningItem, len(rows))
+	for i, row := range rows {
+		items[i] = ContinueListeningItem{
+			Book:     *dbBookToBook(row.AudiobookBook),
+			Progress: *dbProgressToProgress(row.AudiobookBookProgress),
+		}
+	}
+	return items, nil
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func authorError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAuthorNotFound
+	}
+	return fmt.Errorf("failed to get author: %w", err)
+}
+
+func seriesError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrSeriesNotFound
+	}
+	return fmt.Errorf("failed to get series: %w", err)
+}
+
+func bookError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrBookNotFound
+	}
+	return fmt.Errorf("failed to get book: %w", err)
+}
+
+func dbAuthorToAuthor(a audiobookdb.AudiobookAuthor) *Author {
+	return &Author{
+		ID:        a.ID,
+		Name:      a.Name,
+		SortName:  a.SortName,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+func dbAuthorsToAuthors(rows []audiobookdb.AudiobookAuthor) []Author {
+	authors := make([]Author, len(rows))
+	for i, a := range rows {
+		authors[i] = *dbAuthorToAuthor(a)
+	}
+	return authors
+}
+
+func dbSeriesToSeries(s audiobookdb.AudiobookSeries) *Series {
+	return &Series{
+		ID:        s.ID,
+		Name:      s.Name,
+		SortName:  s.SortName,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func dbBookToBook(b audiobookdb.AudiobookBook) *Book {
+	return &Book{
+		ID:           b.ID,
+		Path:         b.Path,
+		Title:        b.Title,
+		SortTitle:    b.SortTitle,
+		Subtitle:     b.Subtitle,
+		AuthorCredit: b.AuthorCredit,
+		Narrators:    b.Narrators,
+		SeriesID:     pgToUUID(b.SeriesID),
+		SeriesIndex:  b.SeriesIndex,
+		Description:  b.Description,
+		Publisher:    b.Publisher,
+		Year:         b.Year,
+		Language:     b.Language,
+		Genres:       b.Genres,
+		ASIN:         b.Asin,
+		DurationMs:   b.DurationMs,
+		FileCount:    b.FileCount,
+		ChapterCount: b.ChapterCount,
+		TotalSize:    b.TotalSize,
+		CoverPath:    b.CoverPath,
+		CreatedAt:    b.CreatedAt,
+		UpdatedAt:    b.UpdatedAt,
+	}
+}
+
+func dbBooksToBooks(rows []audiobookdb.AudiobookBook) []Book {
+	books := make([]Book, len(rows))
+	for i, b := range rows {
+		books[i] = *dbBookToBook(b)
+	}
+	return books
+}
+
+func dbFileToFile(f audiobookdb.AudiobookFile) *BookFile {
+	return &BookFile{
+		ID:         f.ID,
+		BookID:     f.BookID,
+		Position:   f.Position,
+		FilePath:   f.FilePath,
+		FileSize:   f.FileSize,
+		Container:  f.Container,
+		DurationMs: f.DurationMs,
+		OffsetMs:   f.OffsetMs,
+		CreatedAt:  f.CreatedAt,
+	}
+}
+
+func dbProgressToProgress(p audiobookdb.AudiobookBookProgress) *Progress {
+	progress := &Progress{
+		ID:             p.ID,
+		UserID:         p.UserID,
+		BookID:         p.BookID,
+		PositionMs:     p.PositionMs,
+		DurationMs:     p.DurationMs,
+		PlaybackSpeed:  p.PlaybackSpeed,
+		IsCompleted:    p.IsCompleted,
+		ListenCount:    p.ListenCount,
+		LastListenedAt: p.LastListenedAt,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+	if p.CompletedAt.Valid {
+		progress.CompletedAt = &p.CompletedAt.Time
+	}
+	return progress
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
@@ -0,0 +1,442 @@
+package audiobook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Service defines business logic for audiobooks
+type Service interface {
+	// Author operations
+	GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error)
+	ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, int64, error)
+	ListAuthorBooks(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error)
+
+	// Series operations
+	GetSeries(ctx context.Context, id uuid.UUID) (*Series, error)
+	ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, int64, error)
+	ListSeriesBooks(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error)
+
+	// Book operations
+	GetBook(ctx context.Context, id uuid.UUID) (*Book, error)
+	GetBookByPath(ctx context.Context, path string) (*Book, error)
+	ListBooks(ctx context.Context, filters BookListFilters) ([]Book, int64, error)
+	GetBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error)
+	GetBookFiles(ctx context.Context, bookID uuid.UUID) ([]BookFile, error)
+	GetBookFile(ctx context.Context, id uuid.UUID) (*BookFile, error)
+	GetBookChapters(ctx context.Context, bookID uuid.UUID) ([]Chapter, error)
+
+	// Listening progress
+	UpdateProgress(ctx context.Context, userID, bookID uuid.UUID, update ProgressUpdate) (*Progress, error)
+	GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error)
+	DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error
+	GetContinueListening(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueListeningItem, error)
+
+	// Library import
+	ImportBook(ctx context.Context, scanned *ScannedBook) (*Book, error)
+	RemoveMissing(ctx context.Context, roots []string, present map[string]bool) (int, error)
+	Prune(ctx context.Context) error
+}
+
+// audiobookService implements the Service interface
+type audiobookService struct {
+	repo Repository
+}
+
+// NewService creates a new audiobook service
+func NewService(repo Repository) Service {
+	return &audiobookService{repo: repo}
+}
+
+// =============================================================================
+// Author and Series Operations
+// =============================================================================
+
+func (s *audiobookService) GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error) {
+	return s.repo.GetAuthor(ctx, id)
+}
+
+func (s *audiobookService) ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, int64, error) {
+	authors, err := s.repo.ListAuthors(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountAuthors(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return authors, count, nil
+}
+
+func (s *audiobookService) ListAuthorBooks(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	if _, err := s.repo.GetAuthor(ctx, authorID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListBooksByAuthor(ctx, authorID, libraryPaths)
+}
+
+func (s *audiobookService) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	return s.repo.GetSeries(ctx, id)
+}
+
+func (s *audiobookService) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, int64, error) {
+	series, err := s.repo.ListSeries(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountSeries(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return series, count, nil
+}
+
+// ListSeriesBooks returns the books of a series ordered by series index.
+func (s *audiobookService) ListSeriesBooks(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	if _, err := s.repo.GetSeries(ctx, seriesID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListBooksBySeries(ctx, seriesID, libraryPaths)
+}
+
+// =============================================================================
+// Book Operations
+// =============================================================================
+
+func (s *audiobookService) GetBook(ctx context.Context, id uuid.UUID) (*Book, error) {
+	return s.repo.GetBook(ctx, id)
+}
+
+func (s *audiobookService) GetBookByPath(ctx context.Context, path string) (*Book, error) {
+	return s.repo.GetBookByPath(ctx, path)
+}
+
+func (s *audiobookService) ListBooks(ctx context.Context, filters BookListFilters) ([]Book, int64, error) {
+	books, err := s.repo.ListBooks(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountBooks(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return books, count, nil
+}
+
+func (s *audiobookService) GetBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error) {
+	return s.repo.ListBookAuthors(ctx, bookID)
+}
+
+func (s *audiobookService) GetBookFiles(ctx context.Context, bookID uuid.UUID) ([]BookFile, error) {
+	return s.repo.ListBookFiles(ctx, bookID)
+}
+
+func (s *audiobookService) GetBookFile(ctx context.Context, id uuid.UUID) (*BookFile, error) {
+	return s.repo.GetBookFile(ctx, id)
+}
+
+func (s *audiobookService) GetBookChapters(ctx context.Context, bookID uuid.UUID) ([]Chapter, error) {
+	return s.repo.ListBookChapters(ctx, bookID)
+}
+
+// =============================================================================
+// Listening Progress
+// =============================================================================
+
+// UpdateProgress stores a position report. File-relative positions are
+// mapped onto the book timeline, so players that play the files of a
+// multi-file book one by one still resume at the right place. The book
+// counts as finished once the listening time left at the reported speed is
+// within CompletedRemaining.
+func (s *audiobookService) UpdateProgress(ctx context.Context, userID, bookID uuid.UUID, update ProgressUpdate) (*Progress, error) {
+	speed := update.PlaybackSpeed
+	if speed == 0 {
+		speed = DefaultPlaybackSpeed
+	}
+	if speed < MinPlaybackSpeed || speed > MaxPlaybackSpeed {
+		return nil, fmt.Errorf("%w: %.2f (allowed %.1f-%.1f)", ErrInvalidPlaybackSpeed, speed, MinPlaybackSpeed, MaxPlaybackSpeed)
+	}
+
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	position := max(update.PositionMs, 0)
+	if update.FileID != nil {
+		file, err := s.repo.GetBookFile(ctx, *update.FileID)
+		if err != nil {
+			return nil, err
+		}
+		if file.BookID != bookID {
+			return nil, ErrBookFileNotFound
+		}
+		position = file.OffsetMs + min(position, file.DurationMs)
+	}
+	if book.DurationMs > 0 {
+		position = min(position, book.DurationMs)
+	}
+
+	isCompleted := book.DurationMs > 0 && position > 0 &&
+		remainingAt(book.DurationMs-position, speed) <= CompletedRemaining
+
+	return s.repo.UpsertProgress(ctx, UpsertProgressParams{
+		UserID:        userID,
+		BookID:        bookID,
+		PositionMs:    position,
+		DurationMs:    book.DurationMs,
+		PlaybackSpeed: speed,
+		IsCompleted:   isCompleted,
+	})
+}
+
+func (s *audiobookService) GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error) {
+	return s.repo.GetProgress(ctx, userID, bookID)
+}
+
+func (s *audiobookService) DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error {
+	return s.repo.DeleteProgress(ctx, userID, bookID)
+}
+
+// GetContinueListening returns the user's started, unfinished books, most
+// recently played first. A non-nil libraryPaths limits them to books under
+// one of the path prefixes.
+func (s *audiobookService) GetContinueListening(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueListeningItem, error) {
+	return s.repo.ListContinueListening(ctx, userID, libraryPaths, limit)
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+// ImportBook stores a scanned book, creating its authors and series on
+// first sight. Files are laid out back to back on the book timeline; files
+// without embedded chapters become one chapter each. Rescanning the same
+// path replaces the files and chapters but keeps the book ID, so listening
+// progress survives.
+func (s *audiobookService) ImportBook(ctx context.Context, scanned *ScannedBook) (*Book, error) {
+	if scanned == nil || scanned.Path == "" {
+		return nil, fmt.Errorf("book path is required")
+	}
+	if len(scanned.Files) == 0 {
+		return nil, fmt.Errorf("book %s has no files", scanned.Path)
+	}
+
+	authors, err := s.resolveAuthors(ctx, scanned.Authors, scanned.AuthorSort)
+	if err != nil {
+		return nil, err
+	}
+	authorIDs := make([]uuid.UUID, len(authors))
+	for i, a := range authors {
+		authorIDs[i] = a.ID
+	}
+
+	var seriesID *uuid.UUID
+	if name := strings.TrimSpace(scanned.Series); name != "" {
+		series, err := s.findOrCreateSeries(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		seriesID = &series.ID
+	}
+
+	title := strings.TrimSpace(scanned.Title)
+	if title == "" {
+		name := filepath.Base(scanned.Path)
+		title = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	sortTitle := scanned.SortTitle
+	if sortTitle == "" {
+		sortTitle = SortTitle(title)
+	}
+
+	book, err := s.repo.UpsertBook(ctx, UpsertBookParams{
+		Path:         scanned.Path,
+		Title:        title,
+		SortTitle:    sortTitle,
+		Subtitle:     optionalString(scanned.Subtitle),
+		AuthorCredit: strings.Join(scanned.Authors, ", "),
+		Narrators:    scanned.Narrators,
+		SeriesID:     seriesID,
+		SeriesIndex:  optionalFloat32(scanned.SeriesIndex),
+		Description:  optionalString(scanned.Description),
+		Publisher:    optionalString(scanned.Publisher),
+		Year:         optionalInt32(scanned.Year),
+		Language:     optionalString(scanned.Language),
+		Genres:       scanned.Genres,
+		ASIN:         optionalString(scanned.ASIN),
+		CoverPath:    optionalString(scanned.CoverPath),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contents := layoutBook(scanned.Files)
+	contents.AuthorIDs = authorIDs
+	return s.repo.SetBookContents(ctx, book.ID, contents)
+}
+
+// RemoveMissing deletes the books under the given library roots whose path
+// was not found by the last scan. It returns the number of books removed.
+func (s *audiobookService) RemoveMissing(ctx context.Context, roots []string, present map[string]bool) (int, error) {
+	paths, err := s.repo.ListBookPaths(ctx)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for path, id := range paths {
+		if present[path] || !underAny(path, roots) {
+			continue
+		}
+		if err := s.repo.DeleteBook(ctx, id); err != nil {
+			return removed, fmt.Errorf("failed to delete book %s: %w", path, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes series and authors left without books after books were
+// retagged or removed.
+func (s *audiobookService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteEmptySeries(ctx); err != nil {
+		return fmt.Errorf("failed to delete empty series: %w", err)
+	}
+	if _, err := s.repo.DeleteOrphanAuthors(ctx); err != nil {
+		return fmt.Errorf("failed to delete orphan authors: %w", err)
+	}
+	return nil
+}
+
+// resolveAuthors finds or creates the named authors, skipping blanks and
+// duplicates. The sort name only applies to a single author.
+func (s *audiobookService) resolveAuthors(ctx context.Context, names []string, sortName string) ([]Author, error) {
+	authors := make([]Author, 0, len(names))
+	seen := make(map[uuid.UUID]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		sort := AuthorSortName(name)
+		if len(names) == 1 && sortName != "" {
+			sort = sortName
+		}
+		author, err := s.repo.GetAuthorByName(ctx, name)
+		if errors.Is(err, ErrAuthorNotFound) {
+			author, err = s.repo.CreateAuthor(ctx, name, sort)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if seen[author.ID] {
+			continue
+		}
+		seen[author.ID] = true
+		authors = append(authors, *author)
+	}
+	return authors, nil
+}
+
+func (s *audiobookService) findOrCreateSeries(ctx context.Context, name string) (*Series, error) {
+	series, err := s.repo.GetSeriesByName(ctx, name)
+	if errors.Is(err, ErrSeriesNotFound) {
+		return s.repo.CreateSeries(ctx, name, SortTitle(name))
+	}
+	return series, err
+}
+
+// layoutBook places the files back to back on the book timeline and
+// shifts their chapters accordingly.
+func layoutBook(files []ScannedFile) BookContents {
+	var (
+		contents BookContents
+		offset   int64
+	)
+	for _, f := range files {
+		duration := f.Duration.Milliseconds()
+		contents.Files = append(contents.Files, CreateBookFileParams{
+			FilePath:   f.FilePath,
+			FileSize:   f.FileSize,
+			Container:  optionalString(f.Container),
+			DurationMs: duration,
+			OffsetMs:   offset,
+		})
+
+		if len(f.Chapters) == 0 {
+			title := f.Title
+			if title == "" {
+				name := filepath.Base(f.FilePath)
+				title = strings.TrimSuffix(name, filepath.Ext(name))
+			}
+			contents.Chapters = append(contents.Chapters, CreateChapterParams{
+				Title:   title,
+				StartMs: offset,
+				EndMs:   offset + duration,
+			})
+		}
+		for _, c := range f.Chapters {
+			start := c.Start.Milliseconds()
+			end := c.End.Milliseconds()
+			if end <= start {
+				end = duration
+			}
+			if duration > 0 {
+				start, end = min(start, duration), min(end, duration)
+			}
+			title := c.Title
+			if title == "" {
+				title = fmt.Sprintf("Chapter %d", len(contents.Chapters)+1)
+			}
+			contents.Chapters = append(contents.Chapters, CreateChapterParams{
+				Title:   title,
+				StartMs: offset + start,
+				EndMs:   offset + end,
+			})
+		}
+		offset += duration
+	}
+	return contents
+}
+
+// underAny reports whether path lies within one of the roots.
+func underAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalInt32(n int) *int32 {
+	if n <= 0 {
+		return nil
+	}
+	v := util.SafeIntToInt32(n)
+	return &v
+}
+
+func optionalFloat32(f *float64) *float32 {
+	if f == nil {
+		return nil
+	}
+	v := float32(*f)
+	return &v
+}
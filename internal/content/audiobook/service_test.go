@@ -0,0 +1,538 @@
+package audiobook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Author operations
+func (m *MockRepository) GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Author), args.Error(1)
+}
+
+func (m *MockRepository) GetAuthorByName(ctx context.Context, name string) (*Author, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Author), args.Error(1)
+}
+
+func (m *MockRepository) CreateAuthor(ctx context.Context, name, sortName string) (*Author, error) {
+	args := m.Called(ctx, name, sortName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Author), args.Error(1)
+}
+
+func (m *MockRepository) ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Author), args.Error(1)
+}
+
+func (m *MockRepository) CountAuthors(ctx context.Context, filters AuthorListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error) {
+	args := m.Called(ctx, bookID)
+	return args.Get(0).([]Author), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrphanAuthors(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Series operations
+func (m *MockRepository) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) GetSeriesByName(ctx context.Context, name string) (*Series, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) CreateSeries(ctx context.Context, name, sortName string) (*Series, error) {
+	args := m.Called(ctx, name, sortName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Series), args.Error(1)
+}
+
+func (m *MockRepository) CountSeries(ctx context.Context, filters SeriesListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteEmptySeries(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Book operations
+func (m *MockRepository) GetBook(ctx context.Context, id uuid.UUID) (*Book, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) GetBookByPath(ctx context.Context, path string) (*Book, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) UpsertBook(ctx context.Context, params UpsertBookParams) (*Book, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) SetBookContents(ctx context.Context, bookID uuid.UUID, contents BookContents) (*Book, error) {
+	args := m.Called(ctx, bookID, contents)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) ListBooks(ctx context.Context, filters BookListFilters) ([]Book, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Book), args.Error(1)
+}
+
+func (m *MockRepository) CountBooks(ctx context.Context, filters BookListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListBooksByAuthor(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	args := m.Called(ctx, authorID, libraryPaths)
+	return args.Get(0).([]Book), args.Error(1)
+}
+
+func (m *MockRepository) ListBooksBySeries(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	args := m.Called(ctx, seriesID, libraryPaths)
+	return args.Get(0).([]Book), args.Error(1)
+}
+
+func (m *MockRepository) ListBookPaths(ctx context.Context) (map[string]uuid.UUID, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// File and chapter operations
+func (m *MockRepository) GetBookFile(ctx context.Context, id uuid.UUID) (*BookFile, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BookFile), args.Error(1)
+}
+
+func (m *MockRepository) ListBookFiles(ctx context.Context, bookID uuid.UUID) ([]BookFile, error) {
+	args := m.Called(ctx, bookID)
+	return args.Get(0).([]BookFile), args.Error(1)
+}
+
+func (m *MockRepository) ListBookChapters(ctx context.Context, bookID uuid.UUID) ([]Chapter, error) {
+	args := m.Called(ctx, bookID)
+	return args.Get(0).([]Chapter), args.Error(1)
+}
+
+// Progress operations
+func (m *MockRepository) UpsertProgress(ctx context.Context, params UpsertProgressParams) (*Progress, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Progress), args.Error(1)
+}
+
+func (m *MockRepository) GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error) {
+	args := m.Called(ctx, userID, bookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Progress), args.Error(1)
+}
+
+func (m *MockRepository) DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error {
+	args := m.Called(ctx, userID, bookID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListContinueListening(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueListeningItem, error) {
+	args := m.Called(ctx, userID, libraryPaths, limit)
+	return args.Get(0).([]ContinueListeningItem), args.Error(1)
+}
+
+// =============================================================================
+// ImportBook
+// =============================================================================
+
+func TestImportBook_MultiFileBook(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	authorID := uuid.New()
+	seriesID := uuid.New()
+	bookID := uuid.New()
+
+	repo.On("GetAuthorByName", ctx, "Brandon Sanderson").Return(nil, ErrAuthorNotFound)
+	repo.On("CreateAuthor", ctx, "Brandon Sanderson", "Sanderson, Brandon").
+		Return(&Author{ID: authorID, Name: "Brandon Sanderson"}, nil)
+	repo.On("GetSeriesByName", ctx, "The Stormlight Archive").Return(nil, ErrSeriesNotFound)
+	repo.On("CreateSeries", ctx, "The Stormlight Archive", "Stormlight Archive, The").
+		Return(&Series{ID: seriesID, Name: "The Stormlight Archive"}, nil)
+	repo.On("UpsertBook", ctx, mock.MatchedBy(func(p UpsertBookParams) bool {
+		return p.Path == "/books/Brandon Sanderson/The Way of Kings" &&
+			p.Title == "The Way of Kings" &&
+			p.SortTitle == "Way of Kings, The" &&
+			p.AuthorCredit == "Brandon Sanderson" &&
+			p.SeriesID != nil && *p.SeriesID == seriesID &&
+			p.SeriesIndex != nil && *p.SeriesIndex == 1 &&
+			p.Year != nil && *p.Year == 2010 &&
+			p.Subtitle == nil
+	})).Return(&Book{ID: bookID, Title: "The Way of Kings"}, nil)
+
+	var contents BookContents
+	repo.On("SetBookContents", ctx, bookID, mock.AnythingOfType("audiobook.BookContents")).
+		Run(func(args mock.Arguments) { contents = args.Get(2).(BookContents) }).
+		Return(&Book{ID: bookID, FileCount: 2}, nil)
+
+	index := 1.0
+	book, err := svc.ImportBook(ctx, &ScannedBook{
+		Path:        "/books/Brandon Sanderson/The Way of Kings",
+		Title:       "The Way of Kings",
+		Authors:     []string{"Brandon Sanderson"},
+		Narrators:   []string{"Michael Kramer", "Kate Reading"},
+		Series:      "The Stormlight Archive",
+		SeriesIndex: &index,
+		Year:        2010,
+		Files: []ScannedFile{
+			{FilePath: "/books/Brandon Sanderson/The Way of Kings/01.mp3", FileSize: 100, Container: "mp3", Title: "Prelude", Duration: 10 * time.Minute},
+			{
+				FilePath: "/books/Brandon Sanderson/The Way of Kings/02.mp3", FileSize: 200, Container: "mp3", Duration: 20 * time.Minute,
+				Chapters: []ScannedChapter{
+					{Title: "Chapter 1", Start: 0, End: 12 * time.Minute},
+					{Title: "", Start: 12 * time.Minute, End: 0},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bookID, book.ID)
+	repo.AssertExpectations(t)
+
+	assert.Equal(t, []uuid.UUID{authorID}, contents.AuthorIDs)
+	require.Len(t, contents.Files, 2)
+	assert.Equal(t, int64(0), contents.Files[0].OffsetMs)
+	assert.Equal(t, int64(600_000), contents.Files[1].OffsetMs)
+	assert.Equal(t, int64(1_200_000), contents.Files[1].DurationMs)
+
+	require.Len(t, contents.Chapters, 3)
+	assert.Equal(t, CreateChapterParams{Title: "Prelude", StartMs: 0, EndMs: 600_000}, contents.Chapters[0])
+	assert.Equal(t, CreateChapterParams{Title: "Chapter 1", StartMs: 600_000, EndMs: 1_320_000}, contents.Chapters[1])
+	assert.Equal(t, CreateChapterParams{Title: "Chapter 3", StartMs: 1_320_000, EndMs: 1_800_000}, contents.Chapters[2],
+		"untitled chapters are numbered and open-ended chapters run to the end of the file")
+}
+
+func TestImportBook_SingleAuthorSortNameFromTags(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	authorID := uuid.New()
+	bookID := uuid.New()
+
+	repo.On("GetAuthorByName", ctx, "Ursula K. Le Guin").Return(nil, ErrAuthorNotFound)
+	repo.On("CreateAuthor", ctx, "Ursula K. Le Guin", "Le Guin, Ursula K.").
+		Return(&Author{ID: authorID, Name: "Ursula K. Le Guin"}, nil)
+	repo.On("UpsertBook", ctx, mock.MatchedBy(func(p UpsertBookParams) bool {
+		return p.Title == "Earthsea" && p.SeriesID == nil
+	})).Return(&Book{ID: bookID}, nil)
+	repo.On("SetBookContents", ctx, bookID, mock.MatchedBy(func(c BookContents) bool {
+		return len(c.Files) == 1 && len(c.Chapters) == 1 && c.Chapters[0].Title == "Earthsea"
+	})).Return(&Book{ID: bookID}, nil)
+
+	_, err := svc.ImportBook(ctx, &ScannedBook{
+		Path:       "/books/Earthsea.m4b",
+		Authors:    []string{"Ursula K. Le Guin"},
+		AuthorSort: "Le Guin, Ursula K.",
+		Files:      []ScannedFile{{FilePath: "/books/Earthsea.m4b", Duration: time.Hour}},
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestImportBook_ReusesExistingAuthors(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	first := &Author{ID: uuid.New(), Name: "Terry Pratchett"}
+	second := &Author{ID: uuid.New(), Name: "Neil Gaiman"}
+	bookID := uuid.New()
+
+	repo.On("GetAuthorByName", ctx, "Terry Pratchett").Return(first, nil)
+	repo.On("GetAuthorByName", ctx, "Neil Gaiman").Return(second, nil)
+	repo.On("UpsertBook", ctx, mock.MatchedBy(func(p UpsertBookParams) bool {
+		return p.AuthorCredit == "Terry Pratchett, Neil Gaiman, Terry Pratchett"
+	})).Return(&Book{ID: bookID}, nil)
+	repo.On("SetBookContents", ctx, bookID, mock.MatchedBy(func(c BookContents) bool {
+		return len(c.AuthorIDs) == 2 && c.AuthorIDs[0] == first.ID && c.AuthorIDs[1] == second.ID
+	})).Return(&Book{ID: bookID}, nil)
+
+	_, err := svc.ImportBook(ctx, &ScannedBook{
+		Path:    "/books/Good Omens",
+		Title:   "Good Omens",
+		Authors: []string{"Terry Pratchett", "Neil Gaiman", "Terry Pratchett"},
+		Files:   []ScannedFile{{FilePath: "/books/Good Omens/01.mp3", Duration: time.Hour}},
+	})
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "CreateAuthor", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestImportBook_Validation(t *testing.T) {
+	svc := NewService(new(MockRepository))
+
+	_, err := svc.ImportBook(context.Background(), &ScannedBook{})
+	assert.Error(t, err)
+
+	_, err = svc.ImportBook(context.Background(), &ScannedBook{Path: "/books/Empty"})
+	assert.Error(t, err)
+}
+
+// =============================================================================
+// UpdateProgress
+// =============================================================================
+
+func TestUpdateProgress_BookPosition(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, DurationMs: 3_600_000}, nil)
+	repo.On("UpsertProgress", ctx, UpsertProgressParams{
+		UserID:        userID,
+		BookID:        bookID,
+		PositionMs:    1_000_000,
+		DurationMs:    3_600_000,
+		PlaybackSpeed: 1.0,
+	}).Return(&Progress{BookID: bookID, PositionMs: 1_000_000}, nil)
+
+	progress, err := svc.UpdateProgress(ctx, userID, bookID, ProgressUpdate{PositionMs: 1_000_000})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_000_000), progress.PositionMs)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateProgress_FilePositionMapsToBookTimeline(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	fileID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, DurationMs: 3_600_000}, nil)
+	repo.On("GetBookFile", ctx, fileID).
+		Return(&BookFile{ID: fileID, BookID: bookID, OffsetMs: 1_800_000, DurationMs: 900_000}, nil)
+	repo.On("UpsertProgress", ctx, mock.MatchedBy(func(p UpsertProgressParams) bool {
+		return p.PositionMs == 1_830_000 && p.PlaybackSpeed == 1.5 && !p.IsCompleted
+	})).Return(&Progress{BookID: bookID}, nil)
+
+	_, err := svc.UpdateProgress(ctx, userID, bookID, ProgressUpdate{
+		PositionMs:    30_000,
+		FileID:        &fileID,
+		PlaybackSpeed: 1.5,
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateProgress_FileOfAnotherBook(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	bookID := uuid.New()
+	fileID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, DurationMs: 3_600_000}, nil)
+	repo.On("GetBookFile", ctx, fileID).Return(&BookFile{ID: fileID, BookID: uuid.New()}, nil)
+
+	_, err := svc.UpdateProgress(ctx, uuid.New(), bookID, ProgressUpdate{PositionMs: 1000, FileID: &fileID})
+	assert.ErrorIs(t, err, ErrBookFileNotFound)
+	repo.AssertNotCalled(t, "UpsertProgress", mock.Anything, mock.Anything)
+}
+
+func TestUpdateProgress_CompletionDependsOnSpeed(t *testing.T) {
+	tests := []struct {
+		name          string
+		positionMs    int64
+		speed         float32
+		wantCompleted bool
+	}{
+		{"three minutes left at normal speed", 3_420_000, 1.0, false},
+		{"three minutes left at double speed", 3_420_000, 2.0, true},
+		{"one minute left", 3_540_000, 1.0, true},
+		{"past the end is clamped", 4_000_000, 1.0, true},
+		{"not started", 0, 4.0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := new(MockRepository)
+			svc := NewService(repo)
+
+			bookID := uuid.New()
+			repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, DurationMs: 3_600_000}, nil)
+			repo.On("UpsertProgress", ctx, mock.MatchedBy(func(p UpsertProgressParams) bool {
+				return p.IsCompleted == tt.wantCompleted && p.PositionMs <= 3_600_000
+			})).Return(&Progress{BookID: bookID}, nil)
+
+			_, err := svc.UpdateProgress(ctx, uuid.New(), bookID, ProgressUpdate{PositionMs: tt.positionMs, PlaybackSpeed: tt.speed})
+			require.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateProgress_InvalidSpeed(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	for _, speed := range []float32{0.25, 4.5, -1} {
+		_, err := svc.UpdateProgress(context.Background(), uuid.New(), uuid.New(), ProgressUpdate{PlaybackSpeed: speed})
+		assert.ErrorIs(t, err, ErrInvalidPlaybackSpeed)
+	}
+	repo.AssertNotCalled(t, "GetBook", mock.Anything, mock.Anything)
+}
+
+func TestUpdateProgress_BookNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(nil, ErrBookNotFound)
+
+	_, err := svc.UpdateProgress(ctx, uuid.New(), bookID, ProgressUpdate{PositionMs: 1000})
+	assert.ErrorIs(t, err, ErrBookNotFound)
+}
+
+// =============================================================================
+// Library maintenance
+// =============================================================================
+
+func TestRemoveMissing(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	kept := uuid.New()
+	missing := uuid.New()
+	otherLibrary := uuid.New()
+	repo.On("ListBookPaths", ctx).Return(map[string]uuid.UUID{
+		"/books/Author/Kept":       kept,
+		"/books/Author/Missing":    missing,
+		"/books-other/Author/Book": otherLibrary,
+	}, nil)
+	repo.On("DeleteBook", ctx, missing).Return(nil)
+
+	removed, err := svc.RemoveMissing(ctx, []string{"/books"}, map[string]bool{"/books/Author/Kept": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteBook", ctx, otherLibrary)
+}
+
+func TestRemoveMissing_DeleteError(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	id := uuid.New()
+	repo.On("ListBookPaths", ctx).Return(map[string]uuid.UUID{"/books/Gone": id}, nil)
+	repo.On("DeleteBook", ctx, id).Return(errors.New("db down"))
+
+	removed, err := svc.RemoveMissing(ctx, []string{"/books"}, nil)
+	assert.Error(t, err)
+	assert.Zero(t, removed)
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	repo.On("DeleteEmptySeries", ctx).Return(int64(1), nil)
+	repo.On("DeleteOrphanAuthors", ctx).Return(int64(2), nil)
+
+	require.NoError(t, svc.Prune(ctx))
+	repo.AssertExpectations(t)
+}
+
+func TestListBooks_ReturnsTotal(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	filters := BookListFilters{LibraryPaths: []string{"/audiobooks/"}, OrderBy: "title", Limit: 10}
+	repo.On("ListBooks", ctx, filters).Return([]Book{{ID: uuid.New()}}, nil)
+	repo.On("CountBooks", ctx, filters).Return(int64(7), nil)
+
+	books, total, err := svc.ListBooks(ctx, filters)
+	require.NoError(t, err)
+	assert.Len(t, books, 1)
+	assert.Equal(t, int64(7), total)
+}
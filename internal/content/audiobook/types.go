@@ -0,0 +1,275 @@
+// Package audiobook provides types, adapters, and services for audiobooks.
+// A book is either a single chapterized M4B/M4A file or a folder of audio
+// files played back to back; chapters, narrators and series come from the
+// embedded tags. Listening progress is tracked per user on the book
+// timeline, across file boundaries.
+package audiobook
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Playback speed limits accepted for progress reports.
+const (
+	DefaultPlaybackSpeed float32 = 1.0
+	MinPlaybackSpeed     float32 = 0.5
+	MaxPlaybackSpeed     float32 = 4.0
+)
+
+// CompletedRemaining is the listening time left, at the reported playback
+// speed, below which a book counts as finished. Most books end with
+// closing credits or publisher announcements.
+const CompletedRemaining = 2 * time.Minute
+
+// Author is a writer credited on books.
+type Author struct {
+	ID        uuid.UUID
+	Name      string
+	SortName  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Series groups the books of a series, ordered by series index.
+type Series struct {
+	ID        uuid.UUID
+	Name      string
+	SortName  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Book is one audiobook, made of one or more files.
+type Book struct {
+	ID           uuid.UUID
+	Path         string // folder for multi-file books, file for M4B
+	Title        string
+	SortTitle    string
+	Subtitle     *string
+	AuthorCredit string
+	Narrators    []string
+	SeriesID     *uuid.UUID
+	SeriesIndex  *float32
+	Description  *string
+	Publisher    *string
+	Year         *int32
+	Language     *string
+	Genres       []string
+	ASIN         *string
+	DurationMs   int64
+	FileCount    int32
+	ChapterCount int32
+	TotalSize    int64
+	CoverPath    *string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BookFile is an audio file of a book. OffsetMs is where the file starts on
+// the book timeline.
+type BookFile struct {
+	ID         uuid.UUID
+	BookID     uuid.UUID
+	Position   int32
+	FilePath   string
+	FileSize   int64
+	Container  *string
+	DurationMs int64
+	OffsetMs   int64
+	CreatedAt  time.Time
+}
+
+// Chapter is a chapter on the book timeline.
+type Chapter struct {
+	ID       uuid.UUID
+	BookID   uuid.UUID
+	Position int32
+	Title    string
+	StartMs  int64
+	EndMs    int64
+}
+
+// Progress is a user's listening position in a book. Positions are on the
+// book timeline and independent of the playback speed.
+type Progress struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	BookID         uuid.UUID
+	PositionMs     int64
+	DurationMs     int64
+	PlaybackSpeed  float32
+	IsCompleted    bool
+	ListenCount    int32
+	LastListenedAt time.Time
+	CompletedAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Percent returns the listened share of the book.
+func (p *Progress) Percent() float64 {
+	if p.DurationMs <= 0 {
+		return 0
+	}
+	return min(float64(p.PositionMs)/float64(p.DurationMs)*100, 100)
+}
+
+// Remaining returns the real listening time left at the user's playback
+// speed.
+func (p *Progress) Remaining() time.Duration {
+	return remainingAt(p.DurationMs-p.PositionMs, p.PlaybackSpeed)
+}
+
+// ContinueListeningItem is a started but unfinished book with the user's
+// progress.
+type ContinueListeningItem struct {
+	Book     Book
+	Progress Progress
+}
+
+// ProgressUpdate is a position report from a player. Without FileID the
+// position is on the book timeline; with FileID it is within that file.
+type ProgressUpdate struct {
+	PositionMs    int64
+	FileID        *uuid.UUID
+	PlaybackSpeed float32
+}
+
+// BookListFilters contains filters for listing books.
+type BookListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	OrderBy      string   // "added", "title", "year"
+	Limit        int32
+	Offset       int32
+}
+
+// AuthorListFilters contains filters for listing authors.
+type AuthorListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	Limit        int32
+	Offset       int32
+}
+
+// SeriesListFilters contains filters for listing series.
+type SeriesListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	Limit        int32
+	Offset       int32
+}
+
+// ScannedChapter is a chapter read from a file, relative to the file start.
+type ScannedChapter struct {
+	Title string
+	Start time.Duration
+	End   time.Duration
+}
+
+// ScannedFile is an audio file of a scanned book.
+type ScannedFile struct {
+	FilePath  string
+	FileSize  int64
+	Container string
+	Title     string
+	Duration  time.Duration
+	Chapters  []ScannedChapter
+}
+
+// ScannedBook is a book with the metadata read by the library scanner.
+// Files are in playback order.
+type ScannedBook struct {
+	Path        string
+	Title       string
+	SortTitle   string
+	Subtitle    string
+	Authors     []string
+	AuthorSort  string
+	Narrators   []string
+	Series      string
+	SeriesIndex *float64
+	Description string
+	Publisher   string
+	Year        int
+	Language    string
+	Genres      []string
+	ASIN        string
+	CoverPath   string
+	Files       []ScannedFile
+}
+
+// TotalSize returns the combined size of the book's files.
+func (b *ScannedBook) TotalSize() int64 {
+	var size int64
+	for _, f := range b.Files {
+		size += f.FileSize
+	}
+	return size
+}
+
+// FileAt returns the file playing at a book position and the position
+// within that file. Positions past the end map to the end of the last file.
+func FileAt(files []BookFile, positionMs int64) (*BookFile, int64) {
+	if len(files) == 0 {
+		return nil, 0
+	}
+	for i := range files {
+		f := &files[i]
+		if positionMs < f.OffsetMs+f.DurationMs || i == len(files)-1 {
+			return f, min(max(positionMs-f.OffsetMs, 0), f.DurationMs)
+		}
+	}
+	return nil, 0
+}
+
+// ChapterAt returns the chapter playing at a book position.
+func ChapterAt(chapters []Chapter, positionMs int64) *Chapter {
+	for i := len(chapters) - 1; i >= 0; i-- {
+		if positionMs >= chapters[i].StartMs {
+			return &chapters[i]
+		}
+	}
+	return nil
+}
+
+// remainingAt converts a remaining book duration into listening time at
+// the given speed.
+func remainingAt(remainingMs int64, speed float32) time.Duration {
+	if remainingMs <= 0 {
+		return 0
+	}
+	if speed <= 0 {
+		speed = DefaultPlaybackSpeed
+	}
+	return time.Duration(float64(remainingMs)/float64(speed)) * time.Millisecond
+}
+
+// leadingArticles are moved to the end of sort titles.
+var leadingArticles = []string{"The ", "A ", "An "}
+
+// SortTitle returns the sort form of a title: "The Hobbit" sorts as
+// "Hobbit, The".
+func SortTitle(title string) string {
+	for _, article := range leadingArticles {
+		if len(title) > len(article) && strings.EqualFold(title[:len(article)], article) {
+			return title[len(article):] + ", " + strings.TrimSpace(title[:len(article)])
+		}
+	}
+	return title
+}
+
+// AuthorSortName returns the sort form of an author name: "Ursula K. Le
+// Guin" sorts as "Guin, Ursula K. Le". Names already in "Last, First" form
+// are kept.
+func AuthorSortName(name string) string {
+	name = strings.TrimSpace(name)
+	if strings.Contains(name, ",") {
+		return name
+	}
+	i := strings.LastIndexByte(name, ' ')
+	if i < 0 {
+		return name
+	}
+	return name[i+1:] + ", " + name[:i]
+}
@@ -0,0 +1,117 @@
+package audiobook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"The Hobbit", "Hobbit, The"},
+		{"a Wizard of Earthsea", "Wizard of Earthsea, a"},
+		{"An Echo of Things to Come", "Echo of Things to Come, An"},
+		{"Theft of Swords", "Theft of Swords"},
+		{"The", "The"},
+		{"Dune", "Dune"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, SortTitle(tt.title))
+		})
+	}
+}
+
+func TestAuthorSortName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Brandon Sanderson", "Sanderson, Brandon"},
+		{"J. R. R. Tolkien", "Tolkien, J. R. R."},
+		{"Le Guin, Ursula K.", "Le Guin, Ursula K."},
+		{"Homer", "Homer"},
+		{"  Terry Pratchett ", "Pratchett, Terry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AuthorSortName(tt.name))
+		})
+	}
+}
+
+func TestFileAt(t *testing.T) {
+	files := []BookFile{
+		{Position: 1, OffsetMs: 0, DurationMs: 60_000},
+		{Position: 2, OffsetMs: 60_000, DurationMs: 30_000},
+		{Position: 3, OffsetMs: 90_000, DurationMs: 45_000},
+	}
+
+	tests := []struct {
+		name         string
+		positionMs   int64
+		wantPosition int32
+		wantOffset   int64
+	}{
+		{"start", 0, 1, 0},
+		{"inside first file", 59_999, 1, 59_999},
+		{"file boundary", 60_000, 2, 0},
+		{"inside last file", 100_000, 3, 10_000},
+		{"past the end", 500_000, 3, 45_000},
+		{"negative", -5, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, offset := FileAt(files, tt.positionMs)
+			require.NotNil(t, file)
+			assert.Equal(t, tt.wantPosition, file.Position)
+			assert.Equal(t, tt.wantOffset, offset)
+		})
+	}
+
+	t.Run("no files", func(t *testing.T) {
+		file, offset := FileAt(nil, 1000)
+		assert.Nil(t, file)
+		assert.Zero(t, offset)
+	})
+}
+
+func TestChapterAt(t *testing.T) {
+	chapters := []Chapter{
+		{Position: 1, Title: "Opening Credits", StartMs: 0, EndMs: 20_000},
+		{Position: 2, Title: "Chapter 1", StartMs: 20_000, EndMs: 600_000},
+		{Position: 3, Title: "Chapter 2", StartMs: 600_000, EndMs: 1_200_000},
+	}
+
+	assert.Equal(t, "Opening Credits", ChapterAt(chapters, 0).Title)
+	assert.Equal(t, "Chapter 1", ChapterAt(chapters, 20_000).Title)
+	assert.Equal(t, "Chapter 1", ChapterAt(chapters, 599_999).Title)
+	assert.Equal(t, "Chapter 2", ChapterAt(chapters, 2_000_000).Title)
+	assert.Nil(t, ChapterAt(chapters, -1))
+	assert.Nil(t, ChapterAt(nil, 0))
+}
+
+func TestProgress_Percent(t *testing.T) {
+	assert.InDelta(t, 25.0, (&Progress{PositionMs: 250, DurationMs: 1000}).Percent(), 0.001)
+	assert.InDelta(t, 100.0, (&Progress{PositionMs: 1200, DurationMs: 1000}).Percent(), 0.001)
+	assert.Zero(t, (&Progress{PositionMs: 100}).Percent())
+}
+
+func TestProgress_Remaining(t *testing.T) {
+	p := &Progress{PositionMs: 0, DurationMs: int64(time.Hour / time.Millisecond), PlaybackSpeed: 1}
+	assert.Equal(t, time.Hour, p.Remaining())
+
+	p.PlaybackSpeed = 2
+	assert.Equal(t, 30*time.Minute, p.Remaining())
+
+	p.PlaybackSpeed = 0
+	assert.Equal(t, time.Hour, p.Remaining(), "unset speed counts as normal speed")
+
+	p.PositionMs = p.DurationMs + 1
+	assert.Zero(t, p.Remaining())
+}
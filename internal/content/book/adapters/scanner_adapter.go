@@ -0,0 +1,151 @@
+// Package adapters provides book-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// BookFileParser implements scanner.FileParser for e-book and comic files.
+// Metadata embedded in the file wins; the filename fills the gaps.
+// Filename patterns understood:
+// - "Frank Herbert - Dune (1965).epub" -> Author: "Frank Herbert", Title: "Dune", Year: 1965
+// - "Saga 003 (2012).cbz" -> Series: "Saga", Number: 3, Year: 2012
+// - "Saga #3.cbz", "Saga v02 012.cbr" -> Series: "Saga", Number: 3 or 12
+type BookFileParser struct {
+	kind book.Kind
+}
+
+// NewBookFileParser creates a parser for the files of a book or comic
+// library.
+func NewBookFileParser(kind book.Kind) *BookFileParser {
+	if !kind.Valid() {
+		kind = book.KindBook
+	}
+	return &BookFileParser{kind: kind}
+}
+
+var (
+	// Trailing bracketed groups: the year "(1965)" and tags such as
+	// "(Digital)" or "[Scan Group]"
+	tagPattern  = regexp.MustCompile(`\s*[(\[]([^)\]]*)[)\]]\s*$`)
+	yearPattern = regexp.MustCompile(`^(?:1[5-9]|20)\d{2}$`)
+
+	// Comic issue number: "Saga 003", "Saga #3", "Saga v02 012", "Saga - 003"
+	issuePattern = regexp.MustCompile(`^(.+?)(?:\s+v\d+)?(?:\s*[-#]\s*|\s+#?)(\d{1,4}(?:\.\d+)?)$`)
+)
+
+// Parse extracts the title, author, series, issue number and year from a
+// filename.
+func (p *BookFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSpace(strings.TrimSuffix(filename, filepath.Ext(filename)))
+	name = strings.ReplaceAll(name, "_", " ")
+
+	for {
+		m := tagPattern.FindStringSubmatchIndex(name)
+		if m == nil || m[0] == 0 {
+			break
+		}
+		if group := name[m[2]:m[3]]; yearPattern.MatchString(group) {
+			year, _ := strconv.Atoi(group)
+			metadata["year"] = year
+		}
+		name = name[:m[0]]
+	}
+
+	if p.kind == book.KindComic {
+		if m := issuePattern.FindStringSubmatch(name); m != nil {
+			if number, err := strconv.ParseFloat(m[2], 64); err == nil {
+				metadata["series"] = strings.TrimSpace(m[1])
+				metadata["number"] = number
+			}
+		}
+		return strings.TrimSpace(name), metadata
+	}
+
+	if author, rest, ok := strings.Cut(name, " - "); ok && strings.TrimSpace(author) != "" && strings.TrimSpace(rest) != "" {
+		metadata["author"] = strings.TrimSpace(author)
+		name = rest
+	}
+	return strings.TrimSpace(name), metadata
+}
+
+// GetExtensions returns the e-book or comic extensions
+func (p *BookFileParser) GetExtensions() []string {
+	if p.kind == book.KindComic {
+		return scanner.ExtensionsToSlice(scanner.ComicExtensions)
+	}
+	return scanner.ExtensionsToSlice(scanner.BookExtensions)
+}
+
+// ContentType returns "book" or "comic"
+func (p *BookFileParser) ContentType() string {
+	return string(p.kind)
+}
+
+// ReadBook reads the metadata of a book file and completes it from the
+// filename.
+func (p *BookFileParser) ReadBook(filePath string) (*book.ScannedBook, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ebook.Read(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", filePath, err)
+	}
+
+	scanned := &book.ScannedBook{
+		FilePath:    filePath,
+		FileSize:    info.Size(),
+		Kind:        p.kind,
+		Format:      m.Format,
+		Title:       m.Title,
+		SortTitle:   m.SortTitle,
+		Subtitle:    m.Subtitle,
+		Authors:     m.Authors,
+		Series:      m.Series,
+		SeriesIndex: m.SeriesIndex,
+		Description: m.Description,
+		Publisher:   m.Publisher,
+		Year:        m.Year,
+		Language:    m.Language,
+		ISBN:        m.ISBN,
+		Genres:      m.Genres,
+		PageCount:   m.PageCount,
+		Pages:       m.Pages,
+		RightToLeft: m.RightToLeft,
+		CoverEntry:  m.CoverEntry,
+	}
+
+	name, metadata := p.Parse(filepath.Base(filePath))
+	if year, ok := metadata["year"].(int); ok {
+		scanned.Year = cmp.Or(scanned.Year, year)
+	}
+	if author, ok := metadata["author"].(string); ok && len(scanned.Authors) == 0 {
+		scanned.Authors = []ebook.Author{{Name: author}}
+	}
+	if series, ok := metadata["series"].(string); ok && scanned.Series == "" {
+		scanned.Series = series
+		if number, ok := metadata["number"].(float64); ok {
+			scanned.SeriesIndex = &number
+			if scanned.Title == "" {
+				scanned.Title = fmt.Sprintf("%s #%s", series, strconv.FormatFloat(number, 'f', -1, 64))
+			}
+		}
+	}
+	if scanned.Title == "" {
+		scanned.Title = name
+	}
+	return scanned, nil
+}
@@ -0,0 +1,129 @@
+package adapters
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+)
+
+func TestBookFileParser_ParseBook(t *testing.T) {
+	parser := NewBookFileParser(book.KindBook)
+
+	tests := []struct {
+		filename   string
+		wantTitle  string
+		wantAuthor string
+		wantYear   int
+	}{
+		{"Frank Herbert - Dune (1965).epub", "Dune", "Frank Herbert", 1965},
+		{"Dune.epub", "Dune", "", 0},
+		{"The_Left_Hand_of_Darkness [1969].pdf", "The Left Hand of Darkness", "", 1969},
+		{"Ursula K. Le Guin - The Dispossessed (Retail).epub", "The Dispossessed", "Ursula K. Le Guin", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			title, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantTitle, title)
+			if tt.wantAuthor != "" {
+				assert.Equal(t, tt.wantAuthor, metadata["author"])
+			} else {
+				assert.NotContains(t, metadata, "author")
+			}
+			if tt.wantYear != 0 {
+				assert.Equal(t, tt.wantYear, metadata["year"])
+			}
+		})
+	}
+}
+
+func TestBookFileParser_ParseComic(t *testing.T) {
+	parser := NewBookFileParser(book.KindComic)
+
+	tests := []struct {
+		filename   string
+		wantSeries string
+		wantNumber float64
+		wantYear   int
+	}{
+		{"Saga 003 (2012).cbz", "Saga", 3, 2012},
+		{"Saga #3.cbz", "Saga", 3, 0},
+		{"Saga v02 012 (2013) (Digital).cbr", "Saga", 12, 2013},
+		{"Invincible - 100.cbz", "Invincible", 100, 0},
+		{"Deadly Class 007.5.cbz", "Deadly Class", 7.5, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			_, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantSeries, metadata["series"])
+			assert.InDelta(t, tt.wantNumber, metadata["number"], 0.001)
+			if tt.wantYear != 0 {
+				assert.Equal(t, tt.wantYear, metadata["year"])
+			}
+		})
+	}
+
+	_, metadata := parser.Parse("Maus.cbz")
+	assert.NotContains(t, metadata, "series")
+}
+
+func TestBookFileParser_Extensions(t *testing.T) {
+	assert.ElementsMatch(t, []string{".epub", ".pdf"}, NewBookFileParser(book.KindBook).GetExtensions())
+	assert.ElementsMatch(t, []string{".cbz", ".cbr", ".pdf"}, NewBookFileParser(book.KindComic).GetExtensions())
+	assert.Equal(t, "comic", NewBookFileParser(book.KindComic).ContentType())
+	assert.Equal(t, "book", NewBookFileParser("").ContentType())
+}
+
+func TestBookFileParser_ReadBookFallsBackToFilename(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Saga 003 (2012).cbz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	for _, name := range []string{"02.jpg", "01.jpg"} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(name))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+
+	scanned, err := NewBookFileParser(book.KindComic).ReadBook(path)
+	require.NoError(t, err)
+	assert.Equal(t, book.KindComic, scanned.Kind)
+	assert.Equal(t, ebook.FormatCBZ, scanned.Format)
+	assert.Equal(t, "Saga #3", scanned.Title)
+	assert.Equal(t, "Saga", scanned.Series)
+	require.NotNil(t, scanned.SeriesIndex)
+	assert.InDelta(t, 3.0, *scanned.SeriesIndex, 0.001)
+	assert.Equal(t, 2012, scanned.Year)
+	assert.Equal(t, []string{"01.jpg", "02.jpg"}, scanned.Pages)
+	assert.Equal(t, "01.jpg", scanned.CoverEntry)
+	assert.Positive(t, scanned.FileSize)
+}
+
+func TestBookFileParser_ReadBookPDFAuthorFromFilename(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Ada Lovelace - Notes.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF-1.4\n1 0 obj << /Type /Pages /Count 4 >> endobj\n%%EOF\n"), 0o600))
+
+	scanned, err := NewBookFileParser(book.KindBook).ReadBook(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Notes", scanned.Title)
+	assert.Equal(t, []ebook.Author{{Name: "Ada Lovelace"}}, scanned.Authors)
+	assert.Equal(t, 4, scanned.PageCount)
+}
+
+func TestBookFileParser_ReadBookUnreadable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.epub")
+	require.NoError(t, os.WriteFile(path, []byte("nope"), 0o600))
+
+	_, err := NewBookFileParser(book.KindBook).ReadBook(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,546 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: books.sql
+
+package bookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countBooks = `-- name: CountBooks :one
+SELECT COUNT(*)
+FROM book.books b
+    LEFT JOIN book.series s ON s.id = b.series_id
+WHERE (
+        $1::text = ''
+        OR b.kind = $1::text
+    )
+    AND (
+        $2::text = ''
+        OR b.title ILIKE '%' || $2::text || '%'
+        OR b.author_credit ILIKE '%' || $2::text || '%'
+        OR s.name ILIKE '%' || $2::text || '%'
+    )
+    AND (
+        $3::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($4::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+`
+
+type CountBooksParams struct {
+	Kind         string   `json:"kind"`
+	Query        string   `json:"query"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+func (q *Queries) CountBooks(ctx context.Context, arg CountBooksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countBooks,
+		arg.Kind,
+		arg.Query,
+		arg.Restrict,
+		arg.LibraryPaths,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteBook = `-- name: DeleteBook :exec
+DELETE FROM book.books WHERE id = $1
+`
+
+func (q *Queries) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBook, id)
+	return err
+}
+
+const getBook = `-- name: GetBook :one
+SELECT id, file_path, file_size, kind, format, title, sort_title, subtitle, author_credit, series_id, series_index, description, publisher, year, language, isbn, genres, page_count, pages, right_to_left, cover_entry, created_at, updated_at FROM book.books WHERE id = $1
+`
+
+func (q *Queries) GetBook(ctx context.Context, id uuid.UUID) (BookBook, error) {
+	row := q.db.QueryRow(ctx, getBook, id)
+	var i BookBook
+	err := row.Scan(
+		&i.ID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Kind,
+		&i.Format,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Isbn,
+		&i.Genres,
+		&i.PageCount,
+		&i.Pages,
+		&i.RightToLeft,
+		&i.CoverEntry,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getBookByPath = `-- name: GetBookByPath :one
+SELECT id, file_path, file_size, kind, format, title, sort_title, subtitle, author_credit, series_id, series_index, description, publisher, year, language, isbn, genres, page_count, pages, right_to_left, cover_entry, created_at, updated_at FROM book.books WHERE file_path = $1
+`
+
+func (q *Queries) GetBookByPath(ctx context.Context, filePath string) (BookBook, error) {
+	row := q.db.QueryRow(ctx, getBookByPath, filePath)
+	var i BookBook
+	err := row.Scan(
+		&i.ID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Kind,
+		&i.Format,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Isbn,
+		&i.Genres,
+		&i.PageCount,
+		&i.Pages,
+		&i.RightToLeft,
+		&i.CoverEntry,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listBookPaths = `-- name: ListBookPaths :many
+SELECT id, file_path FROM book.books
+`
+
+type ListBookPathsRow struct {
+	ID       uuid.UUID `json:"id"`
+	FilePath string    `json:"filePath"`
+}
+
+func (q *Queries) ListBookPaths(ctx context.Context) ([]ListBookPathsRow, error) {
+	rows, err := q.db.Query(ctx, listBookPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListBookPathsRow{}
+	for rows.Next() {
+		var i ListBookPathsRow
+		if err := rows.Scan(&i.ID, &i.FilePath); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooks = `-- name: ListBooks :many
+SELECT b.id, b.file_path, b.file_size, b.kind, b.format, b.title, b.sort_title, b.subtitle, b.author_credit, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.isbn, b.genres, b.page_count, b.pages, b.right_to_left, b.cover_entry, b.created_at, b.updated_at
+FROM book.books b
+    LEFT JOIN book.series s ON s.id = b.series_id
+WHERE (
+        $3::text = ''
+        OR b.kind = $3::text
+    )
+    AND (
+        $4::text = ''
+        OR b.title ILIKE '%' || $4::text || '%'
+        OR b.author_credit ILIKE '%' || $4::text || '%'
+        OR s.name ILIKE '%' || $4::text || '%'
+    )
+    AND (
+        $5::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($6::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY
+    CASE
+        WHEN $7::text = 'title' THEN b.sort_title
+    END ASC,
+    CASE
+        WHEN $7::text = 'year' THEN b.year
+    END DESC NULLS LAST,
+    b.created_at DESC,
+    b.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListBooksParams struct {
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	Kind         string   `json:"kind"`
+	Query        string   `json:"query"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	OrderBy      string   `json:"orderBy"`
+}
+
+// An empty kind lists books and comics; the query matches titles, authors
+// and series names. restrict limits the result to books under
+// library_paths.
+func (q *Queries) ListBooks(ctx context.Context, arg ListBooksParams) ([]BookBook, error) {
+	rows, err := q.db.Query(ctx, listBooks,
+		arg.Limit,
+		arg.Offset,
+		arg.Kind,
+		arg.Query,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.OrderBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BookBook{}
+	for rows.Next() {
+		var i BookBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Kind,
+			&i.Format,
+			&i.Title,
+			&i.SortTitle,
+			&i.Subtitle,
+			&i.AuthorCredit,
+			&i.SeriesID,
+			&i.SeriesIndex,
+			&i.Description,
+			&i.Publisher,
+			&i.Year,
+			&i.Language,
+			&i.Isbn,
+			&i.Genres,
+			&i.PageCount,
+			&i.Pages,
+			&i.RightToLeft,
+			&i.CoverEntry,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksByAuthor = `-- name: ListBooksByAuthor :many
+SELECT b.id, b.file_path, b.file_size, b.kind, b.format, b.title, b.sort_title, b.subtitle, b.author_credit, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.isbn, b.genres, b.page_count, b.pages, b.right_to_left, b.cover_entry, b.created_at, b.updated_at
+FROM book.books b
+    JOIN book.book_authors ba ON ba.book_id = b.id
+WHERE
+    ba.author_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($3::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY b.year ASC NULLS LAST, b.sort_title ASC
+`
+
+type ListBooksByAuthorParams struct {
+	AuthorID     uuid.UUID `json:"authorId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+}
+
+// restrict limits the result to books under library_paths.
+func (q *Queries) ListBooksByAuthor(ctx context.Context, arg ListBooksByAuthorParams) ([]BookBook, error) {
+	rows, err := q.db.Query(ctx, listBooksByAuthor, arg.AuthorID, arg.Restrict, arg.LibraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BookBook{}
+	for rows.Next() {
+		var i BookBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Kind,
+			&i.Format,
+			&i.Title,
+			&i.SortTitle,
+			&i.Subtitle,
+			&i.AuthorCredit,
+			&i.SeriesID,
+			&i.SeriesIndex,
+			&i.Description,
+			&i.Publisher,
+			&i.Year,
+			&i.Language,
+			&i.Isbn,
+			&i.Genres,
+			&i.PageCount,
+			&i.Pages,
+			&i.RightToLeft,
+			&i.CoverEntry,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBooksBySeries = `-- name: ListBooksBySeries :many
+SELECT b.id, b.file_path, b.file_size, b.kind, b.format, b.title, b.sort_title, b.subtitle, b.author_credit, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.isbn, b.genres, b.page_count, b.pages, b.right_to_left, b.cover_entry, b.created_at, b.updated_at
+FROM book.books b
+WHERE
+    b.series_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($3::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY b.series_index ASC NULLS LAST, b.year ASC NULLS LAST, b.sort_title ASC
+`
+
+type ListBooksBySeriesParams struct {
+	SeriesID     pgtype.UUID `json:"seriesId"`
+	Restrict     bool        `json:"restrict"`
+	LibraryPaths []string    `json:"libraryPaths"`
+}
+
+// restrict limits the result to books under library_paths.
+func (q *Queries) ListBooksBySeries(ctx context.Context, arg ListBooksBySeriesParams) ([]BookBook, error) {
+	rows, err := q.db.Query(ctx, listBooksBySeries, arg.SeriesID, arg.Restrict, arg.LibraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BookBook{}
+	for rows.Next() {
+		var i BookBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Kind,
+			&i.Format,
+			&i.Title,
+			&i.SortTitle,
+			&i.Subtitle,
+			&i.AuthorCredit,
+			&i.SeriesID,
+			&i.SeriesIndex,
+			&i.Description,
+			&i.Publisher,
+			&i.Year,
+			&i.Language,
+			&i.Isbn,
+			&i.Genres,
+			&i.PageCount,
+			&i.Pages,
+			&i.RightToLeft,
+			&i.CoverEntry,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertBook = `-- name: UpsertBook :one
+INSERT INTO
+    book.books (
+        file_path,
+        file_size,
+        kind,
+        format,
+        title,
+        sort_title,
+        subtitle,
+        author_credit,
+        series_id,
+        series_index,
+        description,
+        publisher,
+        year,
+        language,
+        isbn,
+        genres,
+        page_count,
+        pages,
+        right_to_left,
+        cover_entry
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18,
+        $19,
+        $20
+    )
+ON CONFLICT (file_path) DO
+UPDATE
+SET
+    file_size = EXCLUDED.file_size,
+    kind = EXCLUDED.kind,
+    format = EXCLUDED.format,
+    title = EXCLUDED.title,
+    sort_title = EXCLUDED.sort_title,
+    subtitle = EXCLUDED.subtitle,
+    author_credit = EXCLUDED.author_credit,
+    series_id = EXCLUDED.series_id,
+    series_index = EXCLUDED.series_index,
+    description = EXCLUDED.description,
+    publisher = EXCLUDED.publisher,
+    year = EXCLUDED.year,
+    language = EXCLUDED.language,
+    isbn = EXCLUDED.isbn,
+    genres = EXCLUDED.genres,
+    page_count = EXCLUDED.page_count,
+    pages = EXCLUDED.pages,
+    right_to_left = EXCLUDED.right_to_left,
+    cover_entry = EXCLUDED.cover_entry RETURNING id, file_path, file_size, kind, format, title, sort_title, subtitle, author_credit, series_id, series_index, description, publisher, year, language, isbn, genres, page_count, pages, right_to_left, cover_entry, created_at, updated_at
+`
+
+type UpsertBookParams struct {
+	FilePath     string      `json:"filePath"`
+	FileSize     int64       `json:"fileSize"`
+	Kind         string      `json:"kind"`
+	Format       string      `json:"format"`
+	Title        string      `json:"title"`
+	SortTitle    string      `json:"sortTitle"`
+	Subtitle     *string     `json:"subtitle"`
+	AuthorCredit string      `json:"authorCredit"`
+	SeriesID     pgtype.UUID `json:"seriesId"`
+	SeriesIndex  *float32    `json:"seriesIndex"`
+	Description  *string     `json:"description"`
+	Publisher    *string     `json:"publisher"`
+	Year         *int32      `json:"year"`
+	Language     *string     `json:"language"`
+	Isbn         *string     `json:"isbn"`
+	Genres       []string    `json:"genres"`
+	PageCount    int32       `json:"pageCount"`
+	Pages        []string    `json:"pages"`
+	RightToLeft  bool        `json:"rightToLeft"`
+	CoverEntry   *string     `json:"coverEntry"`
+}
+
+// Books are keyed by their file so rescans update them in place.
+func (q *Queries) UpsertBook(ctx context.Context, arg UpsertBookParams) (BookBook, error) {
+	row := q.db.QueryRow(ctx, upsertBook,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Kind,
+		arg.Format,
+		arg.Title,
+		arg.SortTitle,
+		arg.Subtitle,
+		arg.AuthorCredit,
+		arg.SeriesID,
+		arg.SeriesIndex,
+		arg.Description,
+		arg.Publisher,
+		arg.Year,
+		arg.Language,
+		arg.Isbn,
+		arg.Genres,
+		arg.PageCount,
+		arg.Pages,
+		arg.RightToLeft,
+		arg.CoverEntry,
+	)
+	var i BookBook
+	err := row.Scan(
+		&i.ID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Kind,
+		&i.Format,
+		&i.Title,
+		&i.SortTitle,
+		&i.Subtitle,
+		&i.AuthorCredit,
+		&i.SeriesID,
+		&i.SeriesIndex,
+		&i.Description,
+		&i.Publisher,
+		&i.Year,
+		&i.Language,
+		&i.Isbn,
+		&i.Genres,
+		&i.PageCount,
+		&i.Pages,
+		&i.RightToLeft,
+		&i.CoverEntry,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,236 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: progress.sql
+
+package bookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteReadingProgress = `-- name: DeleteReadingProgress :exec
+DELETE FROM book.reading_progress
+WHERE
+    user_id = $1
+    AND book_id = $2
+`
+
+type DeleteReadingProgressParams struct {
+	UserID uuid.UUID `json:"userId"`
+	BookID uuid.UUID `json:"bookId"`
+}
+
+func (q *Queries) DeleteReadingProgress(ctx context.Context, arg DeleteReadingProgressParams) error {
+	_, err := q.db.Exec(ctx, deleteReadingProgress, arg.UserID, arg.BookID)
+	return err
+}
+
+const getReadingProgress = `-- name: GetReadingProgress :one
+SELECT id, user_id, book_id, page, locator, progress_percent, is_completed, read_count, last_read_at, completed_at, created_at, updated_at
+FROM book.reading_progress
+WHERE
+    user_id = $1
+    AND book_id = $2
+`
+
+type GetReadingProgressParams struct {
+	UserID uuid.UUID `json:"userId"`
+	BookID uuid.UUID `json:"bookId"`
+}
+
+func (q *Queries) GetReadingProgress(ctx context.Context, arg GetReadingProgressParams) (BookReadingProgress, error) {
+	row := q.db.QueryRow(ctx, getReadingProgress, arg.UserID, arg.BookID)
+	var i BookReadingProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.BookID,
+		&i.Page,
+		&i.Locator,
+		&i.ProgressPercent,
+		&i.IsCompleted,
+		&i.ReadCount,
+		&i.LastReadAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listContinueReading = `-- name: ListContinueReading :many
+SELECT
+    b.id, b.file_path, b.file_size, b.kind, b.format, b.title, b.sort_title, b.subtitle, b.author_credit, b.series_id, b.series_index, b.description, b.publisher, b.year, b.language, b.isbn, b.genres, b.page_count, b.pages, b.right_to_left, b.cover_entry, b.created_at, b.updated_at,
+    p.id, p.user_id, p.book_id, p.page, p.locator, p.progress_percent, p.is_completed, p.read_count, p.last_read_at, p.completed_at, p.created_at, p.updated_at
+FROM book.books b
+    JOIN book.reading_progress p ON p.book_id = b.id
+WHERE
+    p.user_id = $1
+    AND p.is_completed = FALSE
+    AND ($3::text = '' OR b.kind = $3::text)
+    AND (
+        $4::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($5::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY p.last_read_at DESC
+LIMIT $2
+`
+
+type ListContinueReadingParams struct {
+	UserID       uuid.UUID `json:"userId"`
+	Limit        int32     `json:"limit"`
+	Kind         string    `json:"kind"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+}
+
+type ListContinueReadingRow struct {
+	BookBook            BookBook            `json:"bookBook"`
+	BookReadingProgress BookReadingProgress `json:"bookReadingProgress"`
+}
+
+func (q *Queries) ListContinueReading(ctx context.Context, arg ListContinueReadingParams) ([]ListContinueReadingRow, error) {
+	rows, err := q.db.Query(ctx, listContinueReading,
+		arg.UserID,
+		arg.Limit,
+		arg.Kind,
+		arg.Restrict,
+		arg.LibraryPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListContinueReadingRow{}
+	for rows.Next() {
+		var i ListContinueReadingRow
+		if err := rows.Scan(
+			&i.BookBook.ID,
+			&i.BookBook.FilePath,
+			&i.BookBook.FileSize,
+			&i.BookBook.Kind,
+			&i.BookBook.Format,
+			&i.BookBook.Title,
+			&i.BookBook.SortTitle,
+			&i.BookBook.Subtitle,
+			&i.BookBook.AuthorCredit,
+			&i.BookBook.SeriesID,
+			&i.BookBook.SeriesIndex,
+			&i.BookBook.Description,
+			&i.BookBook.Publisher,
+			&i.BookBook.Year,
+			&i.BookBook.Language,
+			&i.BookBook.Isbn,
+			&i.BookBook.Genres,
+			&i.BookBook.PageCount,
+			&i.BookBook.Pages,
+			&i.BookBook.RightToLeft,
+			&i.BookBook.CoverEntry,
+			&i.BookBook.CreatedAt,
+			&i.BookBook.UpdatedAt,
+			&i.BookReadingProgress.ID,
+			&i.BookReadingProgress.UserID,
+			&i.BookReadingProgress.BookID,
+			&i.BookReadingProgress.Page,
+			&i.BookReadingProgress.Locator,
+			&i.BookReadingProgress.ProgressPercent,
+			&i.BookReadingProgress.IsCompleted,
+			&i.BookReadingProgress.ReadCount,
+			&i.BookReadingProgress.LastReadAt,
+			&i.BookReadingProgress.CompletedAt,
+			&i.BookReadingProgress.CreatedAt,
+			&i.BookReadingProgress.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertReadingProgress = `-- name: UpsertReadingProgress :one
+INSERT INTO
+    book.reading_progress (
+        user_id,
+        book_id,
+        page,
+        locator,
+        progress_percent,
+        is_completed,
+        read_count,
+        completed_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6::boolean,
+        CASE WHEN $6::boolean THEN 1 ELSE 0 END,
+        CASE WHEN $6::boolean THEN NOW() END
+    ) ON CONFLICT (user_id, book_id) DO
+UPDATE
+SET
+    page = EXCLUDED.page,
+    locator = EXCLUDED.locator,
+    progress_percent = EXCLUDED.progress_percent,
+    is_completed = EXCLUDED.is_completed,
+    read_count = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT reading_progress.is_completed THEN reading_progress.read_count + 1
+        ELSE reading_progress.read_count
+    END,
+    completed_at = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT reading_progress.is_completed THEN NOW()
+        WHEN EXCLUDED.is_completed THEN reading_progress.completed_at
+    END,
+    last_read_at = NOW() RETURNING id, user_id, book_id, page, locator, progress_percent, is_completed, read_count, last_read_at, completed_at, created_at, updated_at
+`
+
+type UpsertReadingProgressParams struct {
+	UserID          uuid.UUID `json:"userId"`
+	BookID          uuid.UUID `json:"bookId"`
+	Page            int32     `json:"page"`
+	Locator         *string   `json:"locator"`
+	ProgressPercent float32   `json:"progressPercent"`
+	IsCompleted     bool      `json:"isCompleted"`
+}
+
+func (q *Queries) UpsertReadingProgress(ctx context.Context, arg UpsertReadingProgressParams) (BookReadingProgress, error) {
+	row := q.db.QueryRow(ctx, upsertReadingProgress,
+		arg.UserID,
+		arg.BookID,
+		arg.Page,
+		arg.Locator,
+		arg.ProgressPercent,
+		arg.IsCompleted,
+	)
+	var i BookReadingProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.BookID,
+		&i.Page,
+		&i.Locator,
+		&i.ProgressPercent,
+		&i.IsCompleted,
+		&i.ReadCount,
+		&i.LastReadAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,52 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package bookdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Querier interface {
+	AddBookAuthor(ctx context.Context, arg AddBookAuthorParams) error
+	CountAuthors(ctx context.Context, arg CountAuthorsParams) (int64, error)
+	CountBooks(ctx context.Context, arg CountBooksParams) (int64, error)
+	CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error)
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (BookAuthor, error)
+	CreateSeries(ctx context.Context, arg CreateSeriesParams) (BookSeries, error)
+	DeleteBook(ctx context.Context, id uuid.UUID) error
+	DeleteBookAuthors(ctx context.Context, bookID uuid.UUID) error
+	DeleteEmptySeries(ctx context.Context) (int64, error)
+	DeleteOrphanAuthors(ctx context.Context) (int64, error)
+	DeleteReadingProgress(ctx context.Context, arg DeleteReadingProgressParams) error
+	GetAuthor(ctx context.Context, id uuid.UUID) (BookAuthor, error)
+	GetAuthorByName(ctx context.Context, name string) (BookAuthor, error)
+	GetBook(ctx context.Context, id uuid.UUID) (BookBook, error)
+	GetBookByPath(ctx context.Context, filePath string) (BookBook, error)
+	GetReadingProgress(ctx context.Context, arg GetReadingProgressParams) (BookReadingProgress, error)
+	GetSeries(ctx context.Context, id uuid.UUID) (BookSeries, error)
+	GetSeriesByName(ctx context.Context, name string) (BookSeries, error)
+	// restrict limits the result to authors of a book under library_paths.
+	ListAuthors(ctx context.Context, arg ListAuthorsParams) ([]BookAuthor, error)
+	ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]BookAuthor, error)
+	ListBookPaths(ctx context.Context) ([]ListBookPathsRow, error)
+	// An empty kind lists books and comics; the query matches titles, authors
+	// and series names. restrict limits the result to books under
+	// library_paths.
+	ListBooks(ctx context.Context, arg ListBooksParams) ([]BookBook, error)
+	// restrict limits the result to books under library_paths.
+	ListBooksByAuthor(ctx context.Context, arg ListBooksByAuthorParams) ([]BookBook, error)
+	// restrict limits the result to books under library_paths.
+	ListBooksBySeries(ctx context.Context, arg ListBooksBySeriesParams) ([]BookBook, error)
+	ListContinueReading(ctx context.Context, arg ListContinueReadingParams) ([]ListContinueReadingRow, error)
+	// restrict limits the result to series with a book under library_paths.
+	ListSeries(ctx context.Context, arg ListSeriesParams) ([]BookSeries, error)
+	// Books are keyed by their file so rescans update them in place.
+	UpsertBook(ctx context.Context, arg UpsertBookParams) (BookBook, error)
+	UpsertReadingProgress(ctx context.Context, arg UpsertReadingProgressParams) (BookReadingProgress, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,155 @@
+package ebook
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nwaples/rardecode/v2"
+)
+
+// Archive gives random access to the entries of a ZIP or RAR container.
+// EPUB and CBZ files are ZIP archives; CBR files are RAR archives, although
+// many are ZIPs with a .cbr extension, so the container is detected from
+// the file contents.
+type Archive interface {
+	// Names returns the file entries in archive order.
+	Names() []string
+	// Open opens an entry for reading.
+	Open(name string) (io.ReadCloser, error)
+	Close() error
+}
+
+var (
+	zipMagic      = []byte("PK\x03\x04")
+	zipEmptyMagic = []byte("PK\x05\x06")
+	rarMagic      = []byte("Rar!\x1a\x07")
+)
+
+// OpenArchive opens a ZIP or RAR archive.
+func OpenArchive(path string) (Archive, error) {
+	f, err := os.Open(path) // #nosec G304 -- path comes from the library scan
+	if err != nil {
+		return nil, err
+	}
+	magic := make([]byte, 8)
+	n, _ := io.ReadFull(f, magic)
+	_ = f.Close()
+	magic = magic[:n]
+
+	switch {
+	case bytes.HasPrefix(magic, zipMagic), bytes.HasPrefix(magic, zipEmptyMagic):
+		return openZip(path)
+	case bytes.HasPrefix(magic, rarMagic):
+		return openRar(path)
+	default:
+		return nil, fmt.Errorf("%w: %s is not a ZIP or RAR archive", ErrUnsupported, path)
+	}
+}
+
+type zipArchive struct {
+	rc    *zip.ReadCloser
+	names []string
+	files map[string]*zip.File
+}
+
+func openZip(path string) (*zipArchive, error) {
+	rc, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %w", err)
+	}
+	a := &zipArchive{rc: rc, files: make(map[string]*zip.File, len(rc.File))}
+	for _, f := range rc.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		a.names = append(a.names, f.Name)
+		a.files[f.Name] = f
+	}
+	return a, nil
+}
+
+func (a *zipArchive) Names() []string { return a.names }
+
+func (a *zipArchive) Open(name string) (io.ReadCloser, error) {
+	f, ok := a.files[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEntryNotFound, name)
+	}
+	return f.Open()
+}
+
+func (a *zipArchive) Close() error { return a.rc.Close() }
+
+type rarArchive struct {
+	path  string
+	names []string
+	files map[string]*rardecode.File
+}
+
+func openRar(path string) (*rarArchive, error) {
+	files, err := rardecode.List(path)
+	if err != nil {
+		return nil, fmt.Errorf("open rar: %w", err)
+	}
+	a := &rarArchive{path: path, files: make(map[string]*rardecode.File, len(files))}
+	for _, f := range files {
+		if f.IsDir {
+			continue
+		}
+		a.names = append(a.names, f.Name)
+		a.files[f.Name] = f
+	}
+	return a, nil
+}
+
+func (a *rarArchive) Names() []string { return a.names }
+
+func (a *rarArchive) Open(name string) (io.ReadCloser, error) {
+	f, ok := a.files[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEntryNotFound, name)
+	}
+	if !f.Solid {
+		return f.Open()
+	}
+
+	// Solid entries can only be decoded in order from the start
+	rc, err := rardecode.OpenReader(a.path)
+	if err != nil {
+		return nil, fmt.Errorf("open rar: %w", err)
+	}
+	for {
+		h, err := rc.Next()
+		if err != nil {
+			_ = rc.Close()
+			return nil, fmt.Errorf("read rar %s: %w", name, err)
+		}
+		if h.Name == name {
+			return rc, nil
+		}
+	}
+}
+
+func (a *rarArchive) Close() error { return nil }
+
+// ReadEntry reads an entry into memory, failing for entries larger than
+// limit bytes.
+func ReadEntry(a Archive, name string, limit int64) ([]byte, error) {
+	rc, err := a.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", name, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrEntryTooLarge, name, limit)
+	}
+	return data, nil
+}
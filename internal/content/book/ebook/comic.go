@@ -0,0 +1,101 @@
+package ebook
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// comicInfo is the ComicInfo.xml schema used by ComicRack and most comic
+// taggers.
+type comicInfo struct {
+	Title       string `xml:"Title"`
+	Series      string `xml:"Series"`
+	Number      string `xml:"Number"`
+	Summary     string `xml:"Summary"`
+	Year        int    `xml:"Year"`
+	Writer      string `xml:"Writer"`
+	Publisher   string `xml:"Publisher"`
+	Genre       string `xml:"Genre"`
+	LanguageISO string `xml:"LanguageISO"`
+	GTIN        string `xml:"GTIN"`
+	Manga       string `xml:"Manga"`
+	Pages       []struct {
+		Image int    `xml:"Image,attr"`
+		Type  string `xml:"Type,attr"`
+	} `xml:"Pages>Page"`
+}
+
+// readComic reads a CBZ or CBR archive: the page images in name order and
+// ComicInfo.xml when present.
+func readComic(filePath string, format Format) (*Metadata, error) {
+	a, err := OpenArchive(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = a.Close() }()
+
+	m := &Metadata{Format: format, Pages: imageEntries(a.Names())}
+	m.PageCount = len(m.Pages)
+	if len(m.Pages) > 0 {
+		m.CoverEntry = m.Pages[0]
+	}
+
+	for _, name := range a.Names() {
+		if !strings.EqualFold(path.Base(name), "ComicInfo.xml") {
+			continue
+		}
+		data, err := ReadEntry(a, name, maxMetadataSize)
+		if err != nil {
+			return nil, err
+		}
+		var info comicInfo
+		if err := xml.Unmarshal(data, &info); err != nil {
+			return nil, fmt.Errorf("parse ComicInfo.xml: %w", err)
+		}
+		applyComicInfo(m, &info)
+		break
+	}
+	return m, nil
+}
+
+// applyComicInfo fills the metadata from ComicInfo.xml. Issues without a
+// story title are named after their series and number.
+func applyComicInfo(m *Metadata, info *comicInfo) {
+	m.Series = cleanText(info.Series)
+	number := cleanText(info.Number)
+	m.SeriesIndex = parseIndex(number)
+
+	m.Title = cleanText(info.Title)
+	if m.Title == "" && m.Series != "" {
+		m.Title = m.Series
+		if number != "" {
+			m.Title += " #" + number
+		}
+	}
+
+	for _, name := range strings.Split(info.Writer, ",") {
+		if name = cleanText(name); name != "" {
+			m.Authors = append(m.Authors, Author{Name: name})
+		}
+	}
+	for _, genre := range strings.Split(info.Genre, ",") {
+		if genre = cleanText(genre); genre != "" {
+			m.Genres = append(m.Genres, genre)
+		}
+	}
+	m.Description = strings.TrimSpace(info.Summary)
+	m.Publisher = cleanText(info.Publisher)
+	m.Language = cleanText(info.LanguageISO)
+	m.ISBN = cleanText(info.GTIN)
+	m.Year = info.Year
+	m.RightToLeft = info.Manga == "YesAndRightToLeft"
+
+	for _, p := range info.Pages {
+		if p.Type == "FrontCover" && p.Image >= 0 && p.Image < len(m.Pages) {
+			m.CoverEntry = m.Pages[p.Image]
+			break
+		}
+	}
+}
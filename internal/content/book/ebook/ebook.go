@@ -0,0 +1,177 @@
+// Package ebook reads metadata, covers and pages from e-book and comic
+// files: EPUB (OPF package document), PDF (document information
+// dictionary) and CBZ/CBR comic archives (ComicInfo.xml and the page
+// images). Only the standard library and a RAR decoder are used, so
+// scanning needs no external tools.
+package ebook
+
+import (
+	"errors"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// Format is the file format of a book.
+type Format string
+
+const (
+	FormatEPUB Format = "epub"
+	FormatPDF  Format = "pdf"
+	FormatCBZ  Format = "cbz"
+	FormatCBR  Format = "cbr"
+)
+
+// IsArchive reports whether books of the format are read from a ZIP or RAR
+// archive.
+func (f Format) IsArchive() bool {
+	return f == FormatEPUB || f == FormatCBZ || f == FormatCBR
+}
+
+// IsComic reports whether the format is a comic archive with page images.
+func (f Format) IsComic() bool {
+	return f == FormatCBZ || f == FormatCBR
+}
+
+// MIMEType returns the media type served for files of the format.
+func (f Format) MIMEType() string {
+	switch f {
+	case FormatEPUB:
+		return "application/epub+zip"
+	case FormatPDF:
+		return "application/pdf"
+	case FormatCBZ:
+		return "application/vnd.comicbook+zip"
+	case FormatCBR:
+		return "application/vnd.comicbook-rar"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// FormatOf returns the format of a file from its extension.
+func FormatOf(filePath string) (Format, bool) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".epub":
+		return FormatEPUB, true
+	case ".pdf":
+		return FormatPDF, true
+	case ".cbz":
+		return FormatCBZ, true
+	case ".cbr":
+		return FormatCBR, true
+	default:
+		return "", false
+	}
+}
+
+var (
+	// ErrUnsupported is returned for files that are not a supported format.
+	ErrUnsupported = errors.New("unsupported book format")
+	// ErrEntryNotFound is returned for archive entries that do not exist.
+	ErrEntryNotFound = errors.New("archive entry not found")
+	// ErrEntryTooLarge is returned for archive entries over the read limit.
+	ErrEntryTooLarge = errors.New("archive entry too large")
+)
+
+// Size limits for archive entries read into memory.
+const (
+	maxMetadataSize = 4 << 20  // OPF, container.xml, ComicInfo.xml
+	MaxImageSize    = 64 << 20 // covers and comic pages
+)
+
+// Author is a credited author with an optional sort form of the name.
+type Author struct {
+	Name     string
+	SortName string
+}
+
+// Metadata is what could be read from a book file. Strings are empty and
+// numbers zero when the file does not carry the field.
+type Metadata struct {
+	Format      Format
+	Title       string
+	SortTitle   string
+	Subtitle    string
+	Authors     []Author
+	Series      string
+	SeriesIndex *float64
+	Description string
+	Publisher   string
+	Language    string
+	ISBN        string
+	Year        int
+	Genres      []string
+
+	// PageCount is the number of pages of PDFs and comics.
+	PageCount int
+	// Pages are the page image entries of comics in reading order.
+	Pages []string
+	// CoverEntry is the archive entry of the cover image, if any.
+	CoverEntry string
+	// RightToLeft is set for manga and other right-to-left books.
+	RightToLeft bool
+}
+
+// Read reads the metadata of a book file.
+func Read(filePath string) (*Metadata, error) {
+	format, ok := FormatOf(filePath)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	switch format {
+	case FormatPDF:
+		return readPDF(filePath)
+	case FormatEPUB:
+		return readEPUB(filePath)
+	default:
+		return readComic(filePath, format)
+	}
+}
+
+// imageEntries returns the image entries of an archive in natural name
+// order, skipping hidden files and macOS resource forks.
+func imageEntries(names []string) []string {
+	var images []string
+	for _, name := range names {
+		base := path.Base(name)
+		if strings.HasPrefix(base, ".") || strings.HasPrefix(name, "__MACOSX/") {
+			continue
+		}
+		if scanner.IsImageFile(base) && !strings.EqualFold(path.Ext(base), ".svg") {
+			images = append(images, name)
+		}
+	}
+	slices.SortStableFunc(images, scanner.NaturalCompare)
+	return images
+}
+
+// ImageContentType returns the content type of an image entry.
+func ImageContentType(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".jpg", ".jpeg":
+		return "image/jpeg"
+	case ".png":
+		return "image/png"
+	case ".gif":
+		return "image/gif"
+	case ".webp":
+		return "image/webp"
+	case ".bmp":
+		return "image/bmp"
+	case ".tiff":
+		return "image/tiff"
+	case ".svg":
+		return "image/svg+xml"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// cleanText trims a metadata value and collapses its whitespace.
+func cleanText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,313 @@
+package ebook
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeZip(t *testing.T, name string, entries map[string]string, order ...string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	f, err := os.Create(p)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	for _, entry := range order {
+		w, err := zw.Create(entry)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, entries[entry])
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+	return p
+}
+
+func TestFormatOf(t *testing.T) {
+	tests := []struct {
+		path   string
+		want   Format
+		wantOK bool
+	}{
+		{"/books/Dune.epub", FormatEPUB, true},
+		{"/books/Manual.PDF", FormatPDF, true},
+		{"/comics/Saga 001.cbz", FormatCBZ, true},
+		{"/comics/Saga 002.cbr", FormatCBR, true},
+		{"/books/Dune.mobi", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, ok := FormatOf(tt.path)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.True(t, FormatCBR.IsComic())
+	assert.False(t, FormatPDF.IsArchive())
+	assert.Equal(t, "application/epub+zip", FormatEPUB.MIMEType())
+}
+
+const testOPF = `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="2.0">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:opf="http://www.idpf.org/2007/opf">
+    <dc:title>Dune</dc:title>
+    <dc:creator opf:role="aut" opf:file-as="Herbert, Frank">Frank Herbert</dc:creator>
+    <dc:creator opf:role="ill">Someone Else</dc:creator>
+    <dc:description>&lt;p&gt;A desert &lt;b&gt;planet&lt;/b&gt;.&lt;/p&gt;&lt;p&gt;Spice.&lt;/p&gt;</dc:description>
+    <dc:publisher>Chilton</dc:publisher>
+    <dc:language>en</dc:language>
+    <dc:date>1965-08-01</dc:date>
+    <dc:identifier opf:scheme="uuid">0b3c2a1e-0000-0000-0000-000000000000</dc:identifier>
+    <dc:identifier opf:scheme="ISBN">978-0-441-17271-9</dc:identifier>
+    <dc:subject>Science Fiction</dc:subject>
+    <meta name="calibre:series" content="Dune"/>
+    <meta name="calibre:series_index" content="1.0"/>
+    <meta name="calibre:title_sort" content="Dune"/>
+    <meta name="cover" content="cover-img"/>
+  </metadata>
+  <manifest>
+    <item id="cover-img" href="images/cover%20art.jpg" media-type="image/jpeg"/>
+    <item id="ch1" href="text/ch1.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine/>
+</package>`
+
+func TestRead_EPUB(t *testing.T) {
+	p := writeZip(t, "dune.epub", map[string]string{
+		"mimetype":                   "application/epub+zip",
+		"META-INF/container.xml":     `<container><rootfiles><rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/></rootfiles></container>`,
+		"OEBPS/content.opf":          testOPF,
+		"OEBPS/images/cover art.jpg": "jpeg",
+	}, "mimetype", "META-INF/container.xml", "OEBPS/content.opf", "OEBPS/images/cover art.jpg")
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, FormatEPUB, m.Format)
+	assert.Equal(t, "Dune", m.Title)
+	assert.Equal(t, []Author{{Name: "Frank Herbert", SortName: "Herbert, Frank"}}, m.Authors)
+	assert.Equal(t, "A desert planet.\n\nSpice.", m.Description)
+	assert.Equal(t, "Chilton", m.Publisher)
+	assert.Equal(t, "en", m.Language)
+	assert.Equal(t, 1965, m.Year)
+	assert.Equal(t, "9780441172719", m.ISBN)
+	assert.Equal(t, []string{"Science Fiction"}, m.Genres)
+	assert.Equal(t, "Dune", m.Series)
+	require.NotNil(t, m.SeriesIndex)
+	assert.InDelta(t, 1.0, *m.SeriesIndex, 0.001)
+	assert.Equal(t, "OEBPS/images/cover art.jpg", m.CoverEntry)
+}
+
+func TestRead_EPUBMissingCover(t *testing.T) {
+	p := writeZip(t, "dune.epub", map[string]string{
+		"META-INF/container.xml": `<container><rootfiles><rootfile full-path="content.opf"/></rootfiles></container>`,
+		"content.opf":            testOPF,
+	}, "META-INF/container.xml", "content.opf")
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Empty(t, m.CoverEntry)
+}
+
+func TestParseOPF_EPUB3(t *testing.T) {
+	const opf = `<package xmlns="http://www.idpf.org/2007/opf" version="3.0">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:title id="t1">The Fifth Season</dc:title>
+    <dc:title id="t2">Broken Earth</dc:title>
+    <meta refines="#t2" property="title-type">subtitle</meta>
+    <dc:creator id="c1">N. K. Jemisin</dc:creator>
+    <meta refines="#c1" property="role" scheme="marc:relators">aut</meta>
+    <meta refines="#c1" property="file-as">Jemisin, N. K.</meta>
+    <dc:creator id="c2">A Translator</dc:creator>
+    <meta refines="#c2" property="role" scheme="marc:relators">trl</meta>
+    <dc:identifier>urn:isbn:9780316229296</dc:identifier>
+    <meta property="belongs-to-collection" id="s1">The Broken Earth</meta>
+    <meta refines="#s1" property="collection-type">series</meta>
+    <meta refines="#s1" property="group-position">1</meta>
+  </metadata>
+  <manifest>
+    <item id="c" href="../img/c.png" media-type="image/png" properties="cover-image"/>
+  </manifest>
+  <spine page-progression-direction="rtl"/>
+</package>`
+	var pkg opfPackage
+	require.NoError(t, xml.Unmarshal([]byte(opf), &pkg))
+
+	m := parseOPF(&pkg, "OPS/content")
+	assert.Equal(t, "The Fifth Season", m.Title)
+	assert.Equal(t, "Broken Earth", m.Subtitle)
+	assert.Equal(t, []Author{{Name: "N. K. Jemisin", SortName: "Jemisin, N. K."}}, m.Authors)
+	assert.Equal(t, "9780316229296", m.ISBN)
+	assert.Equal(t, "The Broken Earth", m.Series)
+	require.NotNil(t, m.SeriesIndex)
+	assert.InDelta(t, 1.0, *m.SeriesIndex, 0.001)
+	assert.True(t, m.RightToLeft)
+	assert.Equal(t, "OPS/img/c.png", m.CoverEntry)
+}
+
+func TestParseISBN(t *testing.T) {
+	assert.Equal(t, "9780441172719", parseISBN("urn:isbn:978-0-441-17271-9"))
+	assert.Equal(t, "044117271X", parseISBN("0-441-17271-x"))
+	assert.Empty(t, parseISBN("urn:uuid:0b3c2a1e-0000-0000-0000-000000000000"))
+	assert.Empty(t, parseISBN("B00B7NPRY8"))
+}
+
+func TestRead_CBZ(t *testing.T) {
+	const info = `<?xml version="1.0"?>
+<ComicInfo>
+  <Series>Saga</Series>
+  <Number>3</Number>
+  <Summary>Issue three.</Summary>
+  <Year>2012</Year>
+  <Writer>Brian K. Vaughan, Fiona Staples</Writer>
+  <Publisher>Image</Publisher>
+  <Genre>Fantasy, Sci-Fi</Genre>
+  <Manga>YesAndRightToLeft</Manga>
+  <Pages>
+    <Page Image="0" Type="InnerCover"/>
+    <Page Image="1" Type="FrontCover"/>
+  </Pages>
+</ComicInfo>`
+	p := writeZip(t, "Saga 003.cbz", map[string]string{
+		"ComicInfo.xml":        info,
+		"Saga/page10.jpg":      "10",
+		"Saga/page2.jpg":       "2",
+		"Saga/page1.jpg":       "1",
+		"Saga/.thumbs.jpg":     "x",
+		"__MACOSX/._page1.jpg": "x",
+	}, "ComicInfo.xml", "Saga/page10.jpg", "Saga/page2.jpg", "Saga/page1.jpg", "Saga/.thumbs.jpg", "__MACOSX/._page1.jpg")
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, FormatCBZ, m.Format)
+	assert.Equal(t, []string{"Saga/page1.jpg", "Saga/page2.jpg", "Saga/page10.jpg"}, m.Pages)
+	assert.Equal(t, 3, m.PageCount)
+	assert.Equal(t, "Saga/page2.jpg", m.CoverEntry)
+	assert.Equal(t, "Saga #3", m.Title)
+	assert.Equal(t, "Saga", m.Series)
+	require.NotNil(t, m.SeriesIndex)
+	assert.InDelta(t, 3.0, *m.SeriesIndex, 0.001)
+	assert.Equal(t, []Author{{Name: "Brian K. Vaughan"}, {Name: "Fiona Staples"}}, m.Authors)
+	assert.Equal(t, []string{"Fantasy", "Sci-Fi"}, m.Genres)
+	assert.Equal(t, "Issue three.", m.Description)
+	assert.Equal(t, 2012, m.Year)
+	assert.True(t, m.RightToLeft)
+}
+
+func TestRead_CBRThatIsAZip(t *testing.T) {
+	p := writeZip(t, "comic.cbr", map[string]string{"01.png": "1", "02.png": "2"}, "01.png", "02.png")
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, FormatCBR, m.Format)
+	assert.Equal(t, []string{"01.png", "02.png"}, m.Pages)
+	assert.Equal(t, "01.png", m.CoverEntry)
+}
+
+func TestOpenArchive_Unsupported(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "bad.cbz")
+	require.NoError(t, os.WriteFile(p, []byte("not an archive"), 0o600))
+
+	_, err := OpenArchive(p)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestReadEntry(t *testing.T) {
+	p := writeZip(t, "a.cbz", map[string]string{"big.jpg": "0123456789"}, "big.jpg")
+	a, err := OpenArchive(p)
+	require.NoError(t, err)
+	defer func() { _ = a.Close() }()
+
+	data, err := ReadEntry(a, "big.jpg", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(data))
+
+	_, err = ReadEntry(a, "big.jpg", 9)
+	assert.ErrorIs(t, err, ErrEntryTooLarge)
+
+	_, err = ReadEntry(a, "missing.jpg", 10)
+	assert.ErrorIs(t, err, ErrEntryNotFound)
+}
+
+func writePDF(t *testing.T, body string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "doc.pdf")
+	require.NoError(t, os.WriteFile(p, []byte("%PDF-1.4\n"+body+"\n%%EOF\n"), 0o600))
+	return p
+}
+
+func TestRead_PDF(t *testing.T) {
+	p := writePDF(t, `1 0 obj << /Type /Catalog /Pages 2 0 R >> endobj
+2 0 obj << /Type /Pages /Kids [3 0 R 4 0 R] /Count 12 >> endobj
+3 0 obj << /Type /Pages /Parent 2 0 R /Count 5 >> endobj
+5 0 obj << /Title (The \(Annotated\) Guide) /Author (Ada Lovelace; Charles Babbage) /Subject 6 0 R /Keywords (math, engines) /CreationDate (D:18430101120000Z) >> endobj
+6 0 obj <FEFF004E006F007400650073> endobj
+trailer << /Root 1 0 R /Info 5 0 R >>`)
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, FormatPDF, m.Format)
+	assert.Equal(t, 12, m.PageCount)
+	assert.Equal(t, "The (Annotated) Guide", m.Title)
+	assert.Equal(t, []Author{{Name: "Ada Lovelace"}, {Name: "Charles Babbage"}}, m.Authors)
+	assert.Equal(t, "Notes", m.Description)
+	assert.Equal(t, []string{"math", "engines"}, m.Genres)
+	assert.Equal(t, 1843, m.Year)
+}
+
+func TestRead_PDFObjectStream(t *testing.T) {
+	objects := "<< /Type /Pages /Count 3 >> << /Title (Packed) /Author (Someone) >>"
+	header := "2 0 9 28 "
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	_, _ = io.WriteString(zw, header+objects)
+	require.NoError(t, zw.Close())
+
+	p := writePDF(t, fmt.Sprintf("1 0 obj << /Type /ObjStm /N 2 /First %d /Filter /FlateDecode /Length %d >>\nstream\n%s\nendstream\nendobj\ntrailer << /Info 9 0 R >>",
+		len(header), compressed.Len(), compressed.String()))
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, 3, m.PageCount)
+	assert.Equal(t, "Packed", m.Title)
+	assert.Equal(t, []Author{{Name: "Someone"}}, m.Authors)
+}
+
+func TestRead_PDFJunkTitle(t *testing.T) {
+	p := writePDF(t, `1 0 obj << /Title (Microsoft Word - draft.docx) >> endobj
+trailer << /Info 1 0 R >>`)
+
+	m, err := Read(p)
+	require.NoError(t, err)
+	assert.Empty(t, m.Title)
+	assert.Zero(t, m.PageCount)
+}
+
+func TestRead_NotAPDF(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "fake.pdf")
+	require.NoError(t, os.WriteFile(p, []byte("hello"), 0o600))
+
+	_, err := Read(p)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestDecodePDFText(t *testing.T) {
+	assert.Equal(t, "Café", decodePDFText([]byte{'C', 'a', 'f', 0xe9}))
+	assert.Equal(t, "Hé", decodePDFText([]byte{0xfe, 0xff, 0x00, 'H', 0x00, 0xe9}))
+	assert.Equal(t, "ok", decodePDFText([]byte("\xef\xbb\xbfok")))
+}
+
+func TestImageContentType(t *testing.T) {
+	assert.Equal(t, "image/jpeg", ImageContentType("a/b/PAGE.JPG"))
+	assert.Equal(t, "image/webp", ImageContentType("x.webp"))
+	assert.Equal(t, "application/octet-stream", ImageContentType("x.xml"))
+}
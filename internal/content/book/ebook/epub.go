@@ -0,0 +1,299 @@
+package ebook
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// epubContainer is META-INF/container.xml, which points to the package
+// document.
+type epubContainer struct {
+	Rootfiles []struct {
+		FullPath  string `xml:"full-path,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// opfPackage is the subset of the OPF package document that is read. The
+// same fields cover EPUB 2 attributes (opf:role, opf:file-as) and EPUB 3
+// refinements (<meta refines="#id" property="role">).
+type opfPackage struct {
+	Metadata struct {
+		Titles      []opfElement `xml:"title"`
+		Creators    []opfElement `xml:"creator"`
+		Description string       `xml:"description"`
+		Publisher   string       `xml:"publisher"`
+		Languages   []string     `xml:"language"`
+		Dates       []string     `xml:"date"`
+		Identifiers []opfElement `xml:"identifier"`
+		Subjects    []string     `xml:"subject"`
+		Metas       []opfMeta    `xml:"meta"`
+	} `xml:"metadata"`
+	Manifest []struct {
+		ID         string `xml:"id,attr"`
+		Href       string `xml:"href,attr"`
+		MediaType  string `xml:"media-type,attr"`
+		Properties string `xml:"properties,attr"`
+	} `xml:"manifest>item"`
+	Spine struct {
+		Direction string `xml:"page-progression-direction,attr"`
+	} `xml:"spine"`
+}
+
+type opfElement struct {
+	ID     string `xml:"id,attr"`
+	Role   string `xml:"role,attr"`
+	FileAs string `xml:"file-as,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type opfMeta struct {
+	ID       string `xml:"id,attr"`
+	Name     string `xml:"name,attr"`
+	Content  string `xml:"content,attr"`
+	Property string `xml:"property,attr"`
+	Refines  string `xml:"refines,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// readEPUB reads the package document of an EPUB.
+func readEPUB(filePath string) (*Metadata, error) {
+	a, err := OpenArchive(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = a.Close() }()
+
+	data, err := ReadEntry(a, "META-INF/container.xml", maxMetadataSize)
+	if err != nil {
+		return nil, fmt.Errorf("read container.xml: %w", err)
+	}
+	var container epubContainer
+	if err := xml.Unmarshal(data, &container); err != nil {
+		return nil, fmt.Errorf("parse container.xml: %w", err)
+	}
+	opfPath := ""
+	for _, rf := range container.Rootfiles {
+		if rf.MediaType == "" || rf.MediaType == "application/oebps-package+xml" {
+			opfPath = rf.FullPath
+			break
+		}
+	}
+	if opfPath == "" {
+		return nil, fmt.Errorf("container.xml has no package document")
+	}
+
+	data, err = ReadEntry(a, opfPath, maxMetadataSize)
+	if err != nil {
+		return nil, fmt.Errorf("read package document: %w", err)
+	}
+	var pkg opfPackage
+	if err := xml.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("parse package document: %w", err)
+	}
+
+	m := parseOPF(&pkg, path.Dir(opfPath))
+	if !slices.Contains(a.Names(), m.CoverEntry) {
+		m.CoverEntry = ""
+	}
+	return m, nil
+}
+
+// parseOPF maps a package document to metadata. Manifest hrefs are
+// relative to the package document's folder.
+func parseOPF(pkg *opfPackage, baseDir string) *Metadata {
+	md := &pkg.Metadata
+	m := &Metadata{Format: FormatEPUB}
+
+	// EPUB 3 refinements by target ID, and EPUB 2 <meta name content>
+	refines := make(map[string]map[string]string)
+	named := make(map[string]string)
+	for _, meta := range md.Metas {
+		switch {
+		case meta.Refines != "":
+			id := strings.TrimPrefix(meta.Refines, "#")
+			if refines[id] == nil {
+				refines[id] = make(map[string]string)
+			}
+			refines[id][meta.Property] = cleanText(meta.Value)
+		case meta.Name != "":
+			named[meta.Name] = cleanText(meta.Content)
+		}
+	}
+
+	for _, t := range md.Titles {
+		value := cleanText(t.Value)
+		if value == "" {
+			continue
+		}
+		switch refines[t.ID]["title-type"] {
+		case "subtitle":
+			if m.Subtitle == "" {
+				m.Subtitle = value
+			}
+		case "", "main":
+			if m.Title == "" {
+				m.Title = value
+			}
+		}
+	}
+	m.SortTitle = named["calibre:title_sort"]
+
+	for _, c := range md.Creators {
+		name := cleanText(c.Value)
+		role := c.Role
+		if role == "" {
+			role = refines[c.ID]["role"]
+		}
+		if name == "" || (role != "" && role != "aut") {
+			continue
+		}
+		sortName := cleanText(c.FileAs)
+		if sortName == "" {
+			sortName = refines[c.ID]["file-as"]
+		}
+		m.Authors = append(m.Authors, Author{Name: name, SortName: sortName})
+	}
+
+	// Series: Calibre's meta tags, or an EPUB 3 collection
+	m.Series = named["calibre:series"]
+	m.SeriesIndex = parseIndex(named["calibre:series_index"])
+	if m.Series == "" {
+		for _, meta := range md.Metas {
+			if meta.Property != "belongs-to-collection" || meta.Refines != "" {
+				continue
+			}
+			if t := refines[meta.ID]["collection-type"]; t != "" && t != "series" {
+				continue
+			}
+			m.Series = cleanText(meta.Value)
+			m.SeriesIndex = parseIndex(refines[meta.ID]["group-position"])
+			break
+		}
+	}
+
+	m.Description = stripHTML(md.Description)
+	m.Publisher = cleanText(md.Publisher)
+	if len(md.Languages) > 0 {
+		m.Language = cleanText(md.Languages[0])
+	}
+	for _, d := range md.Dates {
+		if year := parseYear(d); year > 0 {
+			m.Year = year
+			break
+		}
+	}
+	for _, id := range md.Identifiers {
+		if isbn := parseISBN(id.Value); isbn != "" {
+			m.ISBN = isbn
+			break
+		}
+	}
+	for _, s := range md.Subjects {
+		if s = cleanText(s); s != "" {
+			m.Genres = append(m.Genres, s)
+		}
+	}
+	m.RightToLeft = pkg.Spine.Direction == "rtl"
+	m.CoverEntry = epubCover(pkg, named["cover"], baseDir)
+	return m
+}
+
+// epubCover finds the cover image: the EPUB 3 cover-image item, the item
+// named by EPUB 2 <meta name="cover">, or an image item called "cover".
+func epubCover(pkg *opfPackage, coverID, baseDir string) string {
+	href := ""
+	for _, item := range pkg.Manifest {
+		if strings.Contains(" "+item.Properties+" ", " cover-image ") {
+			href = item.Href
+			break
+		}
+	}
+	if href == "" && coverID != "" {
+		for _, item := range pkg.Manifest {
+			if item.ID == coverID {
+				href = item.Href
+				break
+			}
+		}
+	}
+	if href == "" {
+		for _, item := range pkg.Manifest {
+			if strings.HasPrefix(item.MediaType, "image/") &&
+				(strings.Contains(strings.ToLower(item.ID), "cover") || strings.Contains(strings.ToLower(item.Href), "cover")) {
+				href = item.Href
+				break
+			}
+		}
+	}
+	if href == "" {
+		return ""
+	}
+	if unescaped, err := url.PathUnescape(href); err == nil {
+		href = unescaped
+	}
+	return path.Clean(path.Join(baseDir, href))
+}
+
+var (
+	htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+	yearPattern    = regexp.MustCompile(`^\s*(\d{4})`)
+	isbnPattern    = regexp.MustCompile(`^(?:97[89])?\d{9}[\dX]$`)
+)
+
+// stripHTML turns an HTML description into plain text, keeping paragraph
+// breaks.
+func stripHTML(s string) string {
+	s = strings.NewReplacer("</p>", "\n\n", "<br>", "\n", "<br/>", "\n", "<br />", "\n").Replace(s)
+	s = html.UnescapeString(htmlTagPattern.ReplaceAllString(s, ""))
+	var paragraphs []string
+	for _, p := range strings.Split(s, "\n\n") {
+		if p = cleanText(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func parseYear(s string) int {
+	m := yearPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0
+	}
+	year, _ := strconv.Atoi(m[1])
+	return year
+}
+
+// parseISBN returns the ISBN of an identifier such as "urn:isbn:...",
+// "978-0-00-000000-0" or a bare 10 or 13 digit ISBN. Other identifiers
+// (UUIDs, ASINs) are ignored.
+func parseISBN(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) > len("urn:isbn:") && strings.EqualFold(value[:len("urn:isbn:")], "urn:isbn:") {
+		value = value[len("urn:isbn:"):]
+	}
+	value = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(value))
+	if !isbnPattern.MatchString(value) {
+		return ""
+	}
+	return value
+}
+
+func parseIndex(s string) *float64 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
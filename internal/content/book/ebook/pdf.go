@@ -0,0 +1,408 @@
+package ebook
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// PDFs are scanned for objects rather than parsed through their
+// cross-reference table, which also copes with damaged files. Large files
+// are only read at both ends, where the document information dictionary
+// and page tree usually are.
+const (
+	maxPDFRead      = 128 << 20
+	pdfEdgeRead     = 16 << 20
+	maxObjStreamLen = 16 << 20
+)
+
+var (
+	pdfObjPattern      = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	pdfInfoRefPattern  = regexp.MustCompile(`/Info\s+(\d+)\s+\d+\s+R`)
+	pdfPagesPattern    = regexp.MustCompile(`/Type\s*/Pages\b`)
+	pdfCountPattern    = regexp.MustCompile(`/Count\s+(\d+)`)
+	pdfObjStmPattern   = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	pdfIntEntryPattern = regexp.MustCompile(`/(N|First)\s+(\d+)(\s+\d+\s+R)?`)
+	pdfRefPattern      = regexp.MustCompile(`^(\d+)\s+\d+\s+R`)
+)
+
+// readPDF reads the document information dictionary and page count of a
+// PDF. The cover is the first page, rendered on request.
+func readPDF(filePath string) (*Metadata, error) {
+	data, err := readPDFData(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(bytes.TrimLeft(data[:min(len(data), 1024)], "\x00\r\n\t "), []byte("%PDF-")) {
+		return nil, fmt.Errorf("%w: %s is not a PDF", ErrUnsupported, filePath)
+	}
+
+	doc := parsePDFObjects(data)
+	m := &Metadata{Format: FormatPDF, PageCount: doc.pageCount()}
+
+	// The last /Info reference belongs to the newest revision
+	refs := pdfInfoRefPattern.FindAllSubmatch(data, -1)
+	if len(refs) == 0 {
+		return m, nil
+	}
+	num, _ := strconv.Atoi(string(refs[len(refs)-1][1]))
+	info := doc.objects[num]
+	if info == nil {
+		return m, nil
+	}
+
+	m.Title = pdfTitle(doc.stringEntry(info, "Title"))
+	for _, name := range splitPDFAuthors(doc.stringEntry(info, "Author")) {
+		m.Authors = append(m.Authors, Author{Name: name})
+	}
+	m.Description = cleanText(doc.stringEntry(info, "Subject"))
+	for _, keyword := range strings.FieldsFunc(doc.stringEntry(info, "Keywords"), func(r rune) bool { return r == ',' || r == ';' }) {
+		if keyword = cleanText(keyword); keyword != "" {
+			m.Genres = append(m.Genres, keyword)
+		}
+	}
+	// Dates look like "D:20100831120000+02'00'"
+	m.Year = parseYear(strings.TrimPrefix(doc.stringEntry(info, "CreationDate"), "D:"))
+	return m, nil
+}
+
+// readPDFData reads a PDF, or both ends of a large one.
+func readPDFData(filePath string) ([]byte, error) {
+	f, err := os.Open(filePath) // #nosec G304 -- path comes from the library scan
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() <= maxPDFRead {
+		return io.ReadAll(f)
+	}
+	head := make([]byte, pdfEdgeRead)
+	if _, err := io.ReadFull(f, head); err != nil {
+		return nil, err
+	}
+	tail := make([]byte, pdfEdgeRead)
+	if _, err := f.ReadAt(tail, info.Size()-pdfEdgeRead); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return append(append(head, '\n'), tail...), nil
+}
+
+// pdfDocument holds the object bodies of a PDF by object number,
+// including objects packed into object streams.
+type pdfDocument struct {
+	objects map[int][]byte
+}
+
+func parsePDFObjects(data []byte) *pdfDocument {
+	doc := &pdfDocument{objects: make(map[int][]byte)}
+	matches := pdfObjPattern.FindAllSubmatchIndex(data, -1)
+	for i, match := range matches {
+		num, err := strconv.Atoi(string(data[match[2]:match[3]]))
+		if err != nil {
+			continue
+		}
+		end := len(data)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		body := data[match[1]:end]
+		if j := bytes.Index(body, []byte("endobj")); j >= 0 {
+			body = body[:j]
+		}
+		// Later revisions of an object replace earlier ones
+		doc.objects[num] = body
+	}
+
+	var streams [][]byte
+	for _, body := range doc.objects {
+		if pdfObjStmPattern.Match(pdfDict(body)) {
+			streams = append(streams, body)
+		}
+	}
+	for _, body := range streams {
+		doc.unpackObjectStream(body)
+	}
+	return doc
+}
+
+// unpackObjectStream adds the objects of a compressed object stream.
+// Objects already found uncompressed are kept.
+func (d *pdfDocument) unpackObjectStream(body []byte) {
+	dict := pdfDict(body)
+	var n, first int
+	for _, m := range pdfIntEntryPattern.FindAllSubmatch(dict, -1) {
+		if len(m[3]) > 0 {
+			continue
+		}
+		v, _ := strconv.Atoi(string(m[2]))
+		switch string(m[1]) {
+		case "N":
+			n = v
+		case "First":
+			first = v
+		}
+	}
+	stream := pdfStream(body)
+	if stream == nil || n <= 0 || !bytes.Contains(dict, []byte("/FlateDecode")) {
+		return
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(stream))
+	if err != nil {
+		return
+	}
+	content, _ := io.ReadAll(io.LimitReader(zr, maxObjStreamLen))
+	if first > len(content) {
+		return
+	}
+
+	header := strings.Fields(string(content[:first]))
+	for i := 0; i+1 < len(header) && i/2 < n; i += 2 {
+		num, err1 := strconv.Atoi(header[i])
+		offset, err2 := strconv.Atoi(header[i+1])
+		if err1 != nil || err2 != nil || first+offset > len(content) {
+			continue
+		}
+		end := len(content)
+		if i+3 < len(header) {
+			if next, err := strconv.Atoi(header[i+3]); err == nil && first+next <= len(content) && next >= offset {
+				end = first + next
+			}
+		}
+		if _, ok := d.objects[num]; !ok {
+			d.objects[num] = content[first+offset : end]
+		}
+	}
+}
+
+// pageCount returns the page count of the page tree root, the /Pages
+// node with the largest count.
+func (d *pdfDocument) pageCount() int {
+	count := 0
+	for _, body := range d.objects {
+		dict := pdfDict(body)
+		if !pdfPagesPattern.Match(dict) {
+			continue
+		}
+		if m := pdfCountPattern.FindSubmatch(dict); m != nil {
+			if v, err := strconv.Atoi(string(m[1])); err == nil {
+				count = max(count, v)
+			}
+		}
+	}
+	return count
+}
+
+// stringEntry returns a text string entry of a dictionary, following an
+// indirect reference if needed.
+func (d *pdfDocument) stringEntry(body []byte, key string) string {
+	dict := pdfDict(body)
+	i := indexPDFKey(dict, key)
+	if i < 0 {
+		return ""
+	}
+	value := bytes.TrimLeft(dict[i+len(key)+1:], " \t\r\n")
+	if m := pdfRefPattern.FindSubmatch(value); m != nil {
+		num, _ := strconv.Atoi(string(m[1]))
+		value = bytes.TrimLeft(d.objects[num], " \t\r\n")
+	}
+	s, ok := parsePDFString(value)
+	if !ok {
+		return ""
+	}
+	return decodePDFText(s)
+}
+
+// indexPDFKey finds "/Key" as a whole name in a dictionary.
+func indexPDFKey(dict []byte, key string) int {
+	name := []byte("/" + key)
+	for offset := 0; ; {
+		i := bytes.Index(dict[offset:], name)
+		if i < 0 {
+			return -1
+		}
+		i += offset
+		end := i + len(name)
+		if end >= len(dict) || !isPDFNameChar(dict[end]) {
+			return i
+		}
+		offset = end
+	}
+}
+
+func isPDFNameChar(c byte) bool {
+	return c > ' ' && c < 0x7f && !strings.ContainsRune("/<>[]()%{}", rune(c))
+}
+
+// pdfDict returns the dictionary of an object body without its stream.
+func pdfDict(body []byte) []byte {
+	if i := bytes.Index(body, []byte("stream")); i >= 0 {
+		return body[:i]
+	}
+	return body
+}
+
+// pdfStream returns the raw stream data of an object body.
+func pdfStream(body []byte) []byte {
+	i := bytes.Index(body, []byte("stream"))
+	if i < 0 {
+		return nil
+	}
+	data := body[i+len("stream"):]
+	data = bytes.TrimPrefix(data, []byte("\r"))
+	data = bytes.TrimPrefix(data, []byte("\n"))
+	if j := bytes.LastIndex(data, []byte("endstream")); j >= 0 {
+		data = data[:j]
+	}
+	return data
+}
+
+// parsePDFString parses a literal "(...)" or hex "<...>" string at the
+// start of value.
+func parsePDFString(value []byte) ([]byte, bool) {
+	if len(value) == 0 {
+		return nil, false
+	}
+	switch value[0] {
+	case '(':
+		return parsePDFLiteral(value[1:]), true
+	case '<':
+		end := bytes.IndexByte(value, '>')
+		if end < 0 {
+			return nil, false
+		}
+		hex := bytes.Map(func(r rune) rune {
+			if strings.ContainsRune(" \t\r\n", r) {
+				return -1
+			}
+			return r
+		}, value[1:end])
+		if len(hex)%2 == 1 {
+			hex = append(hex, '0')
+		}
+		out := make([]byte, 0, len(hex)/2)
+		for i := 0; i < len(hex); i += 2 {
+			v, err := strconv.ParseUint(string(hex[i:i+2]), 16, 8)
+			if err != nil {
+				return nil, false
+			}
+			out = append(out, byte(v))
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// parsePDFLiteral decodes the body of a literal string up to its closing
+// parenthesis.
+func parsePDFLiteral(s []byte) []byte {
+	var out []byte
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch e := s[i]; e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r', '\n':
+				// Line continuation
+				if e == '\r' && i+1 < len(s) && s[i+1] == '\n' {
+					i++
+				}
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for k := 0; k < 2 && i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '7'; k++ {
+						i++
+						v = v*8 + int(s[i]-'0')
+					}
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+		case c == '(':
+			depth++
+			out = append(out, c)
+		case c == ')':
+			if depth == 0 {
+				return out
+			}
+			depth--
+			out = append(out, c)
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// decodePDFText decodes a PDF text string: UTF-16BE or UTF-8 with a byte
+// order mark, otherwise PDFDocEncoding (treated as Latin-1).
+func decodePDFText(b []byte) string {
+	switch {
+	case bytes.HasPrefix(b, []byte{0xfe, 0xff}):
+		b = b[2:]
+		units := make([]uint16, 0, len(b)/2)
+		for i := 0; i+1 < len(b); i += 2 {
+			units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		return string(utf16.Decode(units))
+	case bytes.HasPrefix(b, []byte{0xef, 0xbb, 0xbf}):
+		return string(b[3:])
+	default:
+		runes := make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+		return string(runes)
+	}
+}
+
+// pdfTitle drops the file names many authoring tools store as the title.
+func pdfTitle(title string) string {
+	title = cleanText(title)
+	lower := strings.ToLower(title)
+	for _, junk := range []string{".doc", ".docx", ".pdf", ".indd", ".tex", ".odt", ".rtf"} {
+		if strings.HasSuffix(lower, junk) {
+			return ""
+		}
+	}
+	if lower == "untitled" || strings.HasPrefix(lower, "microsoft word - ") {
+		return ""
+	}
+	return title
+}
+
+// splitPDFAuthors splits an Author entry such as "A; B" or "A & B".
+// Commas are kept since they often separate last and first names.
+func splitPDFAuthors(s string) []string {
+	var names []string
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == '&' }) {
+		if name = cleanText(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
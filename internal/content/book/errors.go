@@ -0,0 +1,13 @@
+package book
+
+import "errors"
+
+var (
+	ErrBookNotFound     = errors.New("book not found")
+	ErrAuthorNotFound   = errors.New("author not found")
+	ErrSeriesNotFound   = errors.New("series not found")
+	ErrProgressNotFound = errors.New("progress not found")
+	ErrPageNotFound     = errors.New("page not found")
+	ErrCoverNotFound    = errors.New("cover not found")
+	ErrInvalidProgress  = errors.New("invalid reading progress")
+)
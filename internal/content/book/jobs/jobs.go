@@ -0,0 +1,259 @@
+// Package jobs provides background job workers for book and comic content.
+// Library scans read the metadata embedded in each EPUB, PDF, CBZ and CBR
+// file and import it; no metadata provider lookup is needed.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/book/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// KindLibraryScan is the job kind of book and comic library scans.
+const KindLibraryScan = "book_library_scan"
+
+// LibraryScanArgs defines arguments for book library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for books.
+	Paths []string `json:"paths"`
+
+	// BookKind is "book" or "comic", after the library type. Empty means
+	// book.
+	BookKind book.Kind `json:"book_kind,omitempty"`
+
+	// Force re-reads the metadata of books that are already imported.
+	Force bool `json:"force"`
+
+	// LibraryID is the optional library ID to scan.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for book library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans book and comic library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             book.Service
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service book.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "book_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	kind := job.Args.kind()
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Any("paths", job.Args.Paths),
+		slog.String("kind", string(kind)),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, string(kind), job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", string(kind)))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+
+	status.Start(ctx)
+
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+
+	parser := adapters.NewBookFileParser(kind)
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, string(kind), libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	var files []scanner.ScanResult
+	for _, sr := range scanResults {
+		if sr.IsMedia {
+			files = append(files, sr)
+		}
+	}
+	present := make(map[string]bool, len(files))
+	processed := 0
+
+	for _, f := range files {
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(files),
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    f.FilePath,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+		present[f.FilePath] = true
+
+		// Unchanged files keep their metadata unless a full rescan is
+		// forced; a library type change still updates the kind
+		existing, err := w.service.GetBookByPath(ctx, f.FilePath)
+		if err == nil && existing != nil && !job.Args.Force &&
+			existing.FileSize == f.FileSize && existing.Kind == kind {
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadBook(f.FilePath)
+		if err != nil {
+			w.logger.Warn("failed to read book",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(err)
+			continue
+		}
+		if _, err := w.service.ImportBook(ctx, scanned); err != nil {
+			w.logger.Warn("failed to import book",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("import %s: %w", f.FilePath, err))
+			continue
+		}
+		result.ItemsProcessed++
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(files),
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	// An empty result usually means an unmounted library, not deleted books
+	itemsRemoved := 0
+	if len(files) > 0 {
+		itemsRemoved, err = w.service.RemoveMissing(ctx, job.Args.Paths, present)
+		if err != nil {
+			w.logger.Warn("failed to remove missing books", slog.Any("error", err))
+		}
+	}
+	if result.ItemsProcessed > 0 || itemsRemoved > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty series and authors", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_skipped", itemsSkipped),
+		slog.Int("items_removed", itemsRemoved),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ItemsRemoved: util.SafeIntToInt32(itemsRemoved),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", string(kind)).
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	return nil
+}
+
+// kind returns the kind of books in the scanned library.
+func (a LibraryScanArgs) kind() book.Kind {
+	if a.BookKind.Valid() {
+		return a.BookKind
+	}
+	return book.KindBook
+}
@@ -0,0 +1,11 @@
+package book
+
+import "go.uber.org/fx"
+
+// Module provides the book and comic content module.
+var Module = fx.Module("book",
+	fx.Provide(
+		NewPostgresRepository,
+		NewService,
+	),
+)
@@ -0,0 +1,81 @@
+package book
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+)
+
+// Repository defines database operations for books and comics
+type Repository interface {
+	// Authors
+	GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error)
+	GetAuthorByName(ctx context.Context, name string) (*Author, error)
+	CreateAuthor(ctx context.Context, name, sortName string) (*Author, error)
+	ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, error)
+	CountAuthors(ctx context.Context, filters AuthorListFilters) (int64, error)
+	ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error)
+	DeleteOrphanAuthors(ctx context.Context) (int64, error)
+
+	// Series
+	GetSeries(ctx context.Context, id uuid.UUID) (*Series, error)
+	GetSeriesByName(ctx context.Context, name string) (*Series, error)
+	CreateSeries(ctx context.Context, name, sortName string) (*Series, error)
+	ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error)
+	CountSeries(ctx context.Context, filters SeriesListFilters) (int64, error)
+	DeleteEmptySeries(ctx context.Context) (int64, error)
+
+	// Books
+	GetBook(ctx context.Context, id uuid.UUID) (*Book, error)
+	GetBookByPath(ctx context.Context, filePath string) (*Book, error)
+	UpsertBook(ctx context.Context, params UpsertBookParams) (*Book, error)
+	SetBookAuthors(ctx context.Context, bookID uuid.UUID, authorIDs []uuid.UUID) error
+	ListBooks(ctx context.Context, filters BookListFilters) ([]Book, error)
+	CountBooks(ctx context.Context, filters BookListFilters) (int64, error)
+	ListBooksByAuthor(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error)
+	ListBooksBySeries(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error)
+	ListBookPaths(ctx context.Context) (map[string]uuid.UUID, error)
+	DeleteBook(ctx context.Context, id uuid.UUID) error
+
+	// Reading progress
+	UpsertProgress(ctx context.Context, params UpsertProgressParams) (*Progress, error)
+	GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error)
+	DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error
+	ListContinueReading(ctx context.Context, userID uuid.UUID, kind Kind, libraryPaths []string, limit int32) ([]ContinueReadingItem, error)
+}
+
+// UpsertBookParams contains parameters for creating or updating a book
+type UpsertBookParams struct {
+	FilePath     string
+	FileSize     int64
+	Kind         Kind
+	Format       ebook.Format
+	Title        string
+	SortTitle    string
+	Subtitle     *string
+	AuthorCredit string
+	SeriesID     *uuid.UUID
+	SeriesIndex  *float32
+	Description  *string
+	Publisher    *string
+	Year         *int32
+	Language     *string
+	ISBN         *string
+	Genres       []string
+	PageCount    int32
+	Pages        []string
+	RightToLeft  bool
+	CoverEntry   *string
+}
+
+// UpsertProgressParams contains parameters for saving reading progress
+type UpsertProgressParams struct {
+	UserID      uuid.UUID
+	BookID      uuid.UUID
+	Page        int32
+	Locator     *string
+	Percent     float32
+	IsCompleted bool
+}
@@ -0,0 +1,466 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	bookdb "github.com/lusoris/revenge/internal/content/book/db"
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *bookdb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: bookdb.New(pool),
+	}
+}
+
+// =============================================================================
+// Authors
+// =============================================================================
+
+func (r *postgresRepository) GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error) {
+	author, err := r.queries.GetAuthor(ctx, id)
+	if err != nil {
+		return nil, authorError(err)
+	}
+	return dbAuthorToAuthor(author), nil
+}
+
+func (r *postgresRepository) GetAuthorByName(ctx context.Context, name string) (*Author, error) {
+	author, err := r.queries.GetAuthorByName(ctx, name)
+	if err != nil {
+		return nil, authorError(err)
+	}
+	return dbAuthorToAuthor(author), nil
+}
+
+func (r *postgresRepository) CreateAuthor(ctx context.Context, name, sortName string) (*Author, error) {
+	author, err := r.queries.CreateAuthor(ctx, bookdb.CreateAuthorParams{
+		Name:     name,
+		SortName: sortName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create author: %w", err)
+	}
+	return dbAuthorToAuthor(author), nil
+}
+
+func (r *postgresRepository) ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, error) {
+	authors, err := r.queries.ListAuthors(ctx, bookdb.ListAuthorsParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authors: %w", err)
+	}
+	return dbAuthorsToAuthors(authors), nil
+}
+
+func (r *postgresRepository) CountAuthors(ctx context.Context, filters AuthorListFilters) (int64, error) {
+	return r.queries.CountAuthors(ctx, bookdb.CountAuthorsParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error) {
+	authors, err := r.queries.ListBookAuthors(ctx, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book authors: %w", err)
+	}
+	return dbAuthorsToAuthors(authors), nil
+}
+
+func (r *postgresRepository) DeleteOrphanAuthors(ctx context.Context) (int64, error) {
+	return r.queries.DeleteOrphanAuthors(ctx)
+}
+
+// =============================================================================
+// Series
+// =============================================================================
+
+func (r *postgresRepository) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	series, err := r.queries.GetSeries(ctx, id)
+	if err != nil {
+		return nil, seriesError(err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+func (r *postgresRepository) GetSeriesByName(ctx context.Context, name string) (*Series, error) {
+	series, err := r.queries.GetSeriesByName(ctx, name)
+	if err != nil {
+		return nil, seriesError(err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+func (r *postgresRepository) CreateSeries(ctx context.Context, name, sortName string) (*Series, error) {
+	series, err := r.queries.CreateSeries(ctx, bookdb.CreateSeriesParams{
+		Name:     name,
+		SortName: sortName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create series: %w", err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+func (r *postgresRepository) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error) {
+	rows, err := r.queries.ListSeries(ctx, bookdb.ListSeriesParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+	series := make([]Series, len(rows))
+	for i, s := range rows {
+		series[i] = *dbSeriesToSeries(s)
+	}
+	return series, nil
+}
+
+func (r *postgresRepository) CountSeries(ctx context.Context, filters SeriesListFilters) (int64, error) {
+	return r.queries.CountSeries(ctx, bookdb.CountSeriesParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) DeleteEmptySeries(ctx context.Context) (int64, error) {
+	return r.queries.DeleteEmptySeries(ctx)
+}
+
+// =============================================================================
+// Books
+// =============================================================================
+
+func (r *postgresRepository) GetBook(ctx context.Context, id uuid.UUID) (*Book, error) {
+	book, err := r.queries.GetBook(ctx, id)
+	if err != nil {
+		return nil, bookError(err)
+	}
+	return dbBookToBook(book), nil
+}
+
+func (r *postgresRepository) GetBookByPath(ctx context.Context, filePath string) (*Book, error) {
+	book, err := r.queries.GetBookByPath(ctx, filePath)
+	if err != nil {
+		return nil, bookError(err)
+	}
+	return dbBookToBook(book), nil
+}
+
+func (r *postgresRepository) UpsertBook(ctx context.Context, params UpsertBookParams) (*Book, error) {
+	book, err := r.queries.UpsertBook(ctx, bookdb.UpsertBookParams{
+		FilePath:     params.FilePath,
+		FileSize:     params.FileSize,
+		Kind:         string(params.Kind),
+		Format:       string(params.Format),
+		Title:        params.Title,
+		SortTitle:    params.SortTitle,
+		Subtitle:     params.Subtitle,
+		AuthorCredit: params.AuthorCredit,
+		SeriesID:     uuidToPg(params.SeriesID),
+		SeriesIndex:  params.SeriesIndex,
+		Description:  params.Description,
+		Publisher:    params.Publisher,
+		Year:         params.Year,
+		Language:     params.Language,
+		Isbn:         params.ISBN,
+		Genres:       params.Genres,
+		PageCount:    params.PageCount,
+		Pages:        params.Pages,
+		RightToLeft:  params.RightToLeft,
+		CoverEntry:   params.CoverEntry,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert book: %w", err)
+	}
+	return dbBookToBook(book), nil
+}
+
+// SetBookAuthors replaces the authors of a book in credit order.
+func (r *postgresRepository) SetBookAuthors(ctx context.Context, bookID uuid.UUID, authorIDs []uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.queries.WithTx(tx)
+		if err := q.DeleteBookAuthors(ctx, bookID); err != nil {
+			return fmt.Errorf("failed to clear book authors: %w", err)
+		}
+		for i, authorID := range authorIDs {
+			if err := q.AddBookAuthor(ctx, bookdb.AddBookAuthorParams{
+				BookID:   bookID,
+				AuthorID: authorID,
+				Position: util.SafeIntToInt32(i),
+			}); err != nil {
+				return fmt.Errorf("failed to add book author: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *postgresRepository) ListBooks(ctx context.Context, filters BookListFilters) ([]Book, error) {
+	books, err := r.queries.ListBooks(ctx, bookdb.ListBooksParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Kind:         string(filters.Kind),
+		Query:        filters.Query,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+		OrderBy:      filters.OrderBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books: %w", err)
+	}
+	return dbBooksToBooks(books), nil
+}
+
+func (r *postgresRepository) CountBooks(ctx context.Context, filters BookListFilters) (int64, error) {
+	return r.queries.CountBooks(ctx, bookdb.CountBooksParams{
+		Kind:         string(filters.Kind),
+		Query:        filters.Query,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListBooksByAuthor(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	books, err := r.queries.ListBooksByAuthor(ctx, bookdb.ListBooksByAuthorParams{
+		AuthorID:     authorID,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books by author: %w", err)
+	}
+	return dbBooksToBooks(books), nil
+}
+
+func (r *postgresRepository) ListBooksBySeries(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	books, err := r.queries.ListBooksBySeries(ctx, bookdb.ListBooksBySeriesParams{
+		SeriesID:     uuidToPg(&seriesID),
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list books by series: %w", err)
+	}
+	return dbBooksToBooks(books), nil
+}
+
+// ListBookPaths returns the IDs of all books keyed by their file path.
+func (r *postgresRepository) ListBookPaths(ctx context.Context) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ListBookPaths(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list book paths: %w", err)
+	}
+	paths := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		paths[row.FilePath] = row.ID
+	}
+	return paths, nil
+}
+
+func (r *postgresRepository) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteBook(ctx, id)
+}
+
+// =============================================================================
+// Reading progress
+// =============================================================================
+
+func (r *postgresRepository) UpsertProgress(ctx context.Context, params UpsertProgressParams) (*Progress, error) {
+	p, err := r.queries.UpsertReadingProgress(ctx, bookdb.UpsertReadingProgressParams{
+		UserID:          params.UserID,
+		BookID:          params.BookID,
+		Page:            params.Page,
+		Locator:         params.Locator,
+		ProgressPercent: params.Percent,
+		IsCompleted:     params.IsCompleted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error) {
+	p, err := r.queries.GetReadingProgress(ctx, bookdb.GetReadingProgressParams{UserID: userID, BookID: bookID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProgressNotFound
+		}
+		return nil, fmt.Errorf("failed to get progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error {
+	return r.queries.DeleteReadingProgress(ctx, bookdb.DeleteReadingProgressParams{UserID: userID, BookID: bookID})
+}
+
+func (r *postgresRepository) ListContinueReading(ctx context.Context, userID uuid.UUID, kind Kind, libraryPaths []string, limit int32) ([]ContinueReadingItem, error) {
+	rows, err := r.queries.ListContinueReading(ctx, bookdb.ListContinueReadingParams{
+		UserID:       userID,
+		Kind:         string(kind),
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list continue reading: %w", err)
+	}
+	items := make([]ContinueReadingItem, len(rows))
+	for i, row := range rows {
+		items[i] = ContinueReadingItem{
+			Book:     *dbBookToBook(row.BookBook),
+			Progress: *dbProgressToProgress(row.BookReadingProgress),
+		}
+	}
+	return items, nil
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func authorError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAuthorNotFound
+	}
+	return fmt.Errorf("failed to get author: %w", err)
+}
+
+func seriesError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrSeriesNotFound
+	}
+	return fmt.Errorf("failed to get series: %w", err)
+}
+
+func bookError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrBookNotFound
+	}
+	return fmt.Errorf("failed to get book: %w", err)
+}
+
+func dbAuthorToAuthor(a bookdb.BookAuthor) *Author {
+	return &Author{
+		ID:        a.ID,
+		Name:      a.Name,
+		SortName:  a.SortName,
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+func dbAuthorsToAuthors(rows []bookdb.BookAuthor) []Author {
+	authors := make([]Author, len(rows))
+	for i, a := range rows {
+		authors[i] = *dbAuthorToAuthor(a)
+	}
+	return authors
+}
+
+func dbSeriesToSeries(s bookdb.BookSeries) *Series {
+	return &Series{
+		ID:        s.ID,
+		Name:      s.Name,
+		SortName:  s.SortName,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func dbBookToBook(b bookdb.BookBook) *Book {
+	return &Book{
+		ID:           b.ID,
+		FilePath:     b.FilePath,
+		FileSize:     b.FileSize,
+		Kind:         Kind(b.Kind),
+		Format:       ebook.Format(b.Format),
+		Title:        b.Title,
+		SortTitle:    b.SortTitle,
+		Subtitle:     b.Subtitle,
+		AuthorCredit: b.AuthorCredit,
+		SeriesID:     pgToUUID(b.SeriesID),
+		SeriesIndex:  b.SeriesIndex,
+		Description:  b.Description,
+		Publisher:    b.Publisher,
+		Year:         b.Year,
+		Language:     b.Language,
+		ISBN:         b.Isbn,
+		Genres:       b.Genres,
+		PageCount:    b.PageCount,
+		Pages:        b.Pages,
+		RightToLeft:  b.RightToLeft,
+		CoverEntry:   b.CoverEntry,
+		CreatedAt:    b.CreatedAt,
+		UpdatedAt:    b.UpdatedAt,
+	}
+}
+
+func dbBooksToBooks(rows []bookdb.BookBook) []Book {
+	books := make([]Book, len(rows))
+	for i, b := range rows {
+		books[i] = *dbBookToBook(b)
+	}
+	return books
+}
+
+func dbProgressToProgress(p bookdb.BookReadingProgress) *Progress {
+	progress := &Progress{
+		ID:          p.ID,
+		UserID:      p.UserID,
+		BookID:      p.BookID,
+		Page:        p.Page,
+		Locator:     p.Locator,
+		Percent:     p.ProgressPercent,
+		IsCompleted: p.IsCompleted,
+		ReadCount:   p.ReadCount,
+		LastReadAt:  p.LastReadAt,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+	if p.CompletedAt.Valid {
+		progress.CompletedAt = &p.CompletedAt.Time
+	}
+	return progress
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
@@ -0,0 +1,528 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/image"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Cover images are scaled to fit CoverWidth x CoverHeight and kept in
+// memory, since extracting them means opening the archive or rendering a
+// PDF page.
+const (
+	CoverWidth     = 600
+	CoverHeight    = 900
+	coverCacheSize = 500
+	coverCacheTTL  = 30 * time.Minute
+
+	// MaxPageSize bounds comic pages that have to be converted for display.
+	MaxPageSize = 4096
+)
+
+// Image is an encoded cover or page image.
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// Service defines business logic for books and comics
+type Service interface {
+	// Author operations
+	GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error)
+	ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, int64, error)
+	ListAuthorBooks(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error)
+
+	// Series operations
+	GetSeries(ctx context.Context, id uuid.UUID) (*Series, error)
+	ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, int64, error)
+	ListSeriesBooks(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error)
+
+	// Book operations
+	GetBook(ctx context.Context, id uuid.UUID) (*Book, error)
+	GetBookByPath(ctx context.Context, filePath string) (*Book, error)
+	ListBooks(ctx context.Context, filters BookListFilters) ([]Book, int64, error)
+	GetBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error)
+
+	// Content
+	GetCover(ctx context.Context, bookID uuid.UUID) (*Image, error)
+	GetPage(ctx context.Context, bookID uuid.UUID, page int) (*Image, error)
+	OpenFile(ctx context.Context, bookID uuid.UUID) (*Book, *os.File, error)
+
+	// Reading progress
+	UpdateProgress(ctx context.Context, userID, bookID uuid.UUID, update ProgressUpdate) (*Progress, error)
+	GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error)
+	DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error
+	GetContinueReading(ctx context.Context, userID uuid.UUID, kind Kind, libraryPaths []string, limit int32) ([]ContinueReadingItem, error)
+
+	// Library import
+	ImportBook(ctx context.Context, scanned *ScannedBook) (*Book, error)
+	RemoveMissing(ctx context.Context, roots []string, present map[string]bool) (int, error)
+	Prune(ctx context.Context) error
+}
+
+// bookService implements the Service interface
+type bookService struct {
+	repo   Repository
+	covers *cache.L1Cache[string, *Image]
+
+	// render scales cover images and renders the first page of PDFs
+	render func(data []byte, width, height int) ([]byte, string, error)
+}
+
+// NewService creates a new book service
+func NewService(repo Repository) Service {
+	covers, _ := cache.NewL1Cache[string, *Image](coverCacheSize, coverCacheTTL)
+	return &bookService{
+		repo:   repo,
+		covers: covers,
+		render: image.RenderThumbnail,
+	}
+}
+
+// =============================================================================
+// Author and Series Operations
+// =============================================================================
+
+func (s *bookService) GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error) {
+	return s.repo.GetAuthor(ctx, id)
+}
+
+func (s *bookService) ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, int64, error) {
+	authors, err := s.repo.ListAuthors(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountAuthors(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return authors, count, nil
+}
+
+func (s *bookService) ListAuthorBooks(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	if _, err := s.repo.GetAuthor(ctx, authorID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListBooksByAuthor(ctx, authorID, libraryPaths)
+}
+
+func (s *bookService) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	return s.repo.GetSeries(ctx, id)
+}
+
+func (s *bookService) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, int64, error) {
+	series, err := s.repo.ListSeries(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountSeries(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return series, count, nil
+}
+
+// ListSeriesBooks returns the books of a series ordered by series index.
+func (s *bookService) ListSeriesBooks(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	if _, err := s.repo.GetSeries(ctx, seriesID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListBooksBySeries(ctx, seriesID, libraryPaths)
+}
+
+// =============================================================================
+// Book Operations
+// =============================================================================
+
+func (s *bookService) GetBook(ctx context.Context, id uuid.UUID) (*Book, error) {
+	return s.repo.GetBook(ctx, id)
+}
+
+func (s *bookService) GetBookByPath(ctx context.Context, filePath string) (*Book, error) {
+	return s.repo.GetBookByPath(ctx, filePath)
+}
+
+func (s *bookService) ListBooks(ctx context.Context, filters BookListFilters) ([]Book, int64, error) {
+	filters.Query = strings.TrimSpace(filters.Query)
+	books, err := s.repo.ListBooks(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountBooks(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return books, count, nil
+}
+
+func (s *bookService) GetBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error) {
+	return s.repo.ListBookAuthors(ctx, bookID)
+}
+
+// =============================================================================
+// Content
+// =============================================================================
+
+// GetCover returns the book's cover scaled to fit CoverWidth x CoverHeight.
+// Archive covers are extracted from the file; PDF covers are the rendered
+// first page.
+func (s *bookService) GetCover(ctx context.Context, bookID uuid.UUID) (*Image, error) {
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if !book.HasCover() {
+		return nil, ErrCoverNotFound
+	}
+
+	// Rescans bump updated_at, so replaced files get a fresh cover
+	key := fmt.Sprintf("%s:%d", book.ID, book.UpdatedAt.UnixNano())
+	if s.covers != nil {
+		if cover, ok := s.covers.Get(key); ok {
+			return cover, nil
+		}
+	}
+
+	var data []byte
+	if book.CoverEntry != nil {
+		data, err = readArchiveEntry(book.FilePath, *book.CoverEntry)
+	} else {
+		// #nosec G304 -- file path comes from the library scan
+		data, err = os.ReadFile(book.FilePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read cover of %s: %w", book.FilePath, err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	out, contentType, err := s.render(data, CoverWidth, CoverHeight)
+	if err != nil {
+		return nil, fmt.Errorf("render cover of %s: %w", book.FilePath, err)
+	}
+	cover := &Image{Data: out, ContentType: contentType}
+	if s.covers != nil {
+		s.covers.Set(key, cover)
+	}
+	return cover, nil
+}
+
+// GetPage returns a comic page image as stored in the archive. Pages are
+// numbered from 1; only comic archives have page images. Pages in formats
+// browsers cannot show are converted.
+func (s *bookService) GetPage(ctx context.Context, bookID uuid.UUID, page int) (*Image, error) {
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+	entry, ok := book.PageEntry(page)
+	if !ok {
+		return nil, ErrPageNotFound
+	}
+	data, err := readArchiveEntry(book.FilePath, entry)
+	if err != nil {
+		return nil, fmt.Errorf("read page %d of %s: %w", page, book.FilePath, err)
+	}
+	contentType := ebook.ImageContentType(entry)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return &Image{Data: data, ContentType: contentType}, nil
+	}
+
+	// BMP and TIFF scans are converted, since browsers cannot show them
+	data, contentType, err = s.render(data, MaxPageSize, MaxPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("convert page %d of %s: %w", page, book.FilePath, err)
+	}
+	return &Image{Data: data, ContentType: contentType}, nil
+}
+
+// OpenFile opens the book file for download. The caller closes the file.
+func (s *bookService) OpenFile(ctx context.Context, bookID uuid.UUID) (*Book, *os.File, error) {
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(book.FilePath) // #nosec G304 -- file path comes from the library scan
+	if err != nil {
+		return nil, nil, fmt.Errorf("open %s: %w", book.FilePath, err)
+	}
+	return book, f, nil
+}
+
+func readArchiveEntry(filePath, entry string) ([]byte, error) {
+	a, err := ebook.OpenArchive(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = a.Close() }()
+	return ebook.ReadEntry(a, entry, ebook.MaxImageSize)
+}
+
+// =============================================================================
+// Reading Progress
+// =============================================================================
+
+// UpdateProgress stores a position report. For paged books the page is
+// capped at the page count and, unless the reader sent one, the percentage
+// is derived from it. A book counts as finished on its last page or from
+// CompletedPercent on.
+func (s *bookService) UpdateProgress(ctx context.Context, userID, bookID uuid.UUID, update ProgressUpdate) (*Progress, error) {
+	locator := strings.TrimSpace(update.Locator)
+	if update.Page < 0 {
+		return nil, fmt.Errorf("%w: page %d", ErrInvalidProgress, update.Page)
+	}
+	if update.Percent != nil && (*update.Percent < 0 || *update.Percent > 100) {
+		return nil, fmt.Errorf("%w: percent %.2f (allowed 0-100)", ErrInvalidProgress, *update.Percent)
+	}
+	if update.Page == 0 && locator == "" && update.Percent == nil {
+		return nil, fmt.Errorf("%w: page, locator or percent is required", ErrInvalidProgress)
+	}
+
+	book, err := s.repo.GetBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := update.Page
+	if book.PageCount > 0 {
+		page = min(page, book.PageCount)
+	}
+	var percent float32
+	switch {
+	case update.Percent != nil:
+		percent = *update.Percent
+	case page > 0 && book.PageCount > 0:
+		percent = float32(page) / float32(book.PageCount) * 100
+	}
+
+	isCompleted := percent >= CompletedPercent || (book.PageCount > 0 && page >= book.PageCount)
+
+	return s.repo.UpsertProgress(ctx, UpsertProgressParams{
+		UserID:      userID,
+		BookID:      bookID,
+		Page:        page,
+		Locator:     optionalString(locator),
+		Percent:     percent,
+		IsCompleted: isCompleted,
+	})
+}
+
+func (s *bookService) GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error) {
+	return s.repo.GetProgress(ctx, userID, bookID)
+}
+
+func (s *bookService) DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error {
+	return s.repo.DeleteProgress(ctx, userID, bookID)
+}
+
+// GetContinueReading returns the user's started, unfinished books, most
+// recently read first. An empty kind includes books and comics.
+func (s *bookService) GetContinueReading(ctx context.Context, userID uuid.UUID, kind Kind, libraryPaths []string, limit int32) ([]ContinueReadingItem, error) {
+	return s.repo.ListContinueReading(ctx, userID, kind, libraryPaths, limit)
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+// ImportBook stores a scanned book, creating its authors and series on
+// first sight. Rescanning the same file updates the book in place, so
+// reading progress survives.
+func (s *bookService) ImportBook(ctx context.Context, scanned *ScannedBook) (*Book, error) {
+	if scanned == nil || scanned.FilePath == "" {
+		return nil, fmt.Errorf("book file path is required")
+	}
+	kind := scanned.Kind
+	if kind == "" {
+		kind = KindBook
+	}
+	if !kind.Valid() {
+		return nil, fmt.Errorf("invalid book kind %q", kind)
+	}
+	format := scanned.Format
+	if format == "" {
+		f, ok := ebook.FormatOf(scanned.FilePath)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ebook.ErrUnsupported, scanned.FilePath)
+		}
+		format = f
+	}
+
+	authors, err := s.resolveAuthors(ctx, scanned.Authors)
+	if err != nil {
+		return nil, err
+	}
+	authorIDs := make([]uuid.UUID, len(authors))
+	names := make([]string, len(authors))
+	for i, a := range authors {
+		authorIDs[i] = a.ID
+		names[i] = a.Name
+	}
+
+	var seriesID *uuid.UUID
+	if name := strings.TrimSpace(scanned.Series); name != "" {
+		series, err := s.findOrCreateSeries(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		seriesID = &series.ID
+	}
+
+	title := strings.TrimSpace(scanned.Title)
+	if title == "" {
+		name := filepath.Base(scanned.FilePath)
+		title = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	sortTitle := scanned.SortTitle
+	if sortTitle == "" {
+		sortTitle = SortTitle(title)
+	}
+
+	book, err := s.repo.UpsertBook(ctx, UpsertBookParams{
+		FilePath:     scanned.FilePath,
+		FileSize:     scanned.FileSize,
+		Kind:         kind,
+		Format:       format,
+		Title:        title,
+		SortTitle:    sortTitle,
+		Subtitle:     optionalString(scanned.Subtitle),
+		AuthorCredit: strings.Join(names, ", "),
+		SeriesID:     seriesID,
+		SeriesIndex:  optionalFloat32(scanned.SeriesIndex),
+		Description:  optionalString(scanned.Description),
+		Publisher:    optionalString(scanned.Publisher),
+		Year:         optionalInt32(scanned.Year),
+		Language:     optionalString(scanned.Language),
+		ISBN:         optionalString(scanned.ISBN),
+		Genres:       scanned.Genres,
+		PageCount:    util.SafeIntToInt32(scanned.PageCount),
+		Pages:        scanned.Pages,
+		RightToLeft:  scanned.RightToLeft,
+		CoverEntry:   optionalString(scanned.CoverEntry),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetBookAuthors(ctx, book.ID, authorIDs); err != nil {
+		return nil, err
+	}
+	return book, nil
+}
+
+// RemoveMissing deletes the books under the given library roots whose file
+// was not found by the last scan. It returns the number of books removed.
+func (s *bookService) RemoveMissing(ctx context.Context, roots []string, present map[string]bool) (int, error) {
+	paths, err := s.repo.ListBookPaths(ctx)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for path, id := range paths {
+		if present[path] || !underAny(path, roots) {
+			continue
+		}
+		if err := s.repo.DeleteBook(ctx, id); err != nil {
+			return removed, fmt.Errorf("failed to delete book %s: %w", path, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes series and authors left without books after books were
+// retagged or removed.
+func (s *bookService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteEmptySeries(ctx); err != nil {
+		return fmt.Errorf("failed to delete empty series: %w", err)
+	}
+	if _, err := s.repo.DeleteOrphanAuthors(ctx); err != nil {
+		return fmt.Errorf("failed to delete orphan authors: %w", err)
+	}
+	return nil
+}
+
+// resolveAuthors finds or creates the credited authors, skipping blanks
+// and duplicates. Sort names from the file win over derived ones.
+func (s *bookService) resolveAuthors(ctx context.Context, credits []ebook.Author) ([]Author, error) {
+	authors := make([]Author, 0, len(credits))
+	seen := make(map[uuid.UUID]bool, len(credits))
+	for _, credit := range credits {
+		name := strings.TrimSpace(credit.Name)
+		if name == "" {
+			continue
+		}
+		sortName := strings.TrimSpace(credit.SortName)
+		if sortName == "" {
+			sortName = AuthorSortName(name)
+		}
+		author, err := s.repo.GetAuthorByName(ctx, name)
+		if errors.Is(err, ErrAuthorNotFound) {
+			author, err = s.repo.CreateAuthor(ctx, name, sortName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if seen[author.ID] {
+			continue
+		}
+		seen[author.ID] = true
+		authors = append(authors, *author)
+	}
+	return authors, nil
+}
+
+func (s *bookService) findOrCreateSeries(ctx context.Context, name string) (*Series, error) {
+	series, err := s.repo.GetSeriesByName(ctx, name)
+	if errors.Is(err, ErrSeriesNotFound) {
+		return s.repo.CreateSeries(ctx, name, SortTitle(name))
+	}
+	return series, err
+}
+
+// underAny reports whether path lies within one of the roots.
+func underAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalInt32(n int) *int32 {
+	if n <= 0 {
+		return nil
+	}
+	v := util.SafeIntToInt32(n)
+	return &v
+}
+
+func optionalFloat32(f *float64) *float32 {
+	if f == nil {
+		return nil
+	}
+	v := float32(*f)
+	return &v
+}
@@ -0,0 +1,600 @@
+package book
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Author operations
+func (m *MockRepository) GetAuthor(ctx context.Context, id uuid.UUID) (*Author, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Author), args.Error(1)
+}
+
+func (m *MockRepository) GetAuthorByName(ctx context.Context, name string) (*Author, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Author), args.Error(1)
+}
+
+func (m *MockRepository) CreateAuthor(ctx context.Context, name, sortName string) (*Author, error) {
+	args := m.Called(ctx, name, sortName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Author), args.Error(1)
+}
+
+func (m *MockRepository) ListAuthors(ctx context.Context, filters AuthorListFilters) ([]Author, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Author), args.Error(1)
+}
+
+func (m *MockRepository) CountAuthors(ctx context.Context, filters AuthorListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListBookAuthors(ctx context.Context, bookID uuid.UUID) ([]Author, error) {
+	args := m.Called(ctx, bookID)
+	return args.Get(0).([]Author), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrphanAuthors(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Series operations
+func (m *MockRepository) GetSeries(ctx context.Context, id uuid.UUID) (*Series, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) GetSeriesByName(ctx context.Context, name string) (*Series, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) CreateSeries(ctx context.Context, name, sortName string) (*Series, error) {
+	args := m.Called(ctx, name, sortName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Series), args.Error(1)
+}
+
+func (m *MockRepository) CountSeries(ctx context.Context, filters SeriesListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteEmptySeries(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Book operations
+func (m *MockRepository) GetBook(ctx context.Context, id uuid.UUID) (*Book, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) GetBookByPath(ctx context.Context, filePath string) (*Book, error) {
+	args := m.Called(ctx, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) UpsertBook(ctx context.Context, params UpsertBookParams) (*Book, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Book), args.Error(1)
+}
+
+func (m *MockRepository) SetBookAuthors(ctx context.Context, bookID uuid.UUID, authorIDs []uuid.UUID) error {
+	args := m.Called(ctx, bookID, authorIDs)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListBooks(ctx context.Context, filters BookListFilters) ([]Book, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Book), args.Error(1)
+}
+
+func (m *MockRepository) CountBooks(ctx context.Context, filters BookListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListBooksByAuthor(ctx context.Context, authorID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	args := m.Called(ctx, authorID, libraryPaths)
+	return args.Get(0).([]Book), args.Error(1)
+}
+
+func (m *MockRepository) ListBooksBySeries(ctx context.Context, seriesID uuid.UUID, libraryPaths []string) ([]Book, error) {
+	args := m.Called(ctx, seriesID, libraryPaths)
+	return args.Get(0).([]Book), args.Error(1)
+}
+
+func (m *MockRepository) ListBookPaths(ctx context.Context) (map[string]uuid.UUID, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// Progress operations
+func (m *MockRepository) UpsertProgress(ctx context.Context, params UpsertProgressParams) (*Progress, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Progress), args.Error(1)
+}
+
+func (m *MockRepository) GetProgress(ctx context.Context, userID, bookID uuid.UUID) (*Progress, error) {
+	args := m.Called(ctx, userID, bookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Progress), args.Error(1)
+}
+
+func (m *MockRepository) DeleteProgress(ctx context.Context, userID, bookID uuid.UUID) error {
+	args := m.Called(ctx, userID, bookID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListContinueReading(ctx context.Context, userID uuid.UUID, kind Kind, libraryPaths []string, limit int32) ([]ContinueReadingItem, error) {
+	args := m.Called(ctx, userID, kind, libraryPaths, limit)
+	return args.Get(0).([]ContinueReadingItem), args.Error(1)
+}
+
+func writeComic(t *testing.T, pages map[string]string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "comic.cbz")
+	f, err := os.Create(p)
+	require.NoError(t, err)
+	zw := zip.NewWriter(f)
+	for name, data := range pages {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, f.Close())
+	return p
+}
+
+// =============================================================================
+// ImportBook
+// =============================================================================
+
+func TestImportBook_Comic(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	authorID := uuid.New()
+	seriesID := uuid.New()
+	bookID := uuid.New()
+
+	repo.On("GetAuthorByName", ctx, "Brian K. Vaughan").Return(nil, ErrAuthorNotFound)
+	repo.On("CreateAuthor", ctx, "Brian K. Vaughan", "Vaughan, Brian K.").
+		Return(&Author{ID: authorID, Name: "Brian K. Vaughan"}, nil)
+	repo.On("GetSeriesByName", ctx, "Saga").Return(nil, ErrSeriesNotFound)
+	repo.On("CreateSeries", ctx, "Saga", "Saga").Return(&Series{ID: seriesID, Name: "Saga"}, nil)
+	repo.On("UpsertBook", ctx, mock.MatchedBy(func(p UpsertBookParams) bool {
+		return p.FilePath == "/comics/Saga/Saga 003.cbz" &&
+			p.Kind == KindComic &&
+			p.Format == ebook.FormatCBZ &&
+			p.Title == "Saga #3" &&
+			p.AuthorCredit == "Brian K. Vaughan" &&
+			p.SeriesID != nil && *p.SeriesID == seriesID &&
+			p.SeriesIndex != nil && *p.SeriesIndex == 3 &&
+			p.PageCount == 2 && len(p.Pages) == 2 &&
+			p.CoverEntry != nil && *p.CoverEntry == "01.jpg" &&
+			p.ISBN == nil
+	})).Return(&Book{ID: bookID, Title: "Saga #3"}, nil)
+	repo.On("SetBookAuthors", ctx, bookID, []uuid.UUID{authorID}).Return(nil)
+
+	index := 3.0
+	book, err := svc.ImportBook(ctx, &ScannedBook{
+		FilePath:    "/comics/Saga/Saga 003.cbz",
+		Kind:        KindComic,
+		Format:      ebook.FormatCBZ,
+		Title:       "Saga #3",
+		Authors:     []ebook.Author{{Name: "Brian K. Vaughan"}},
+		Series:      "Saga",
+		SeriesIndex: &index,
+		PageCount:   2,
+		Pages:       []string{"01.jpg", "02.jpg"},
+		CoverEntry:  "01.jpg",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bookID, book.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestImportBook_DefaultsFromFile(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	authorID := uuid.New()
+	bookID := uuid.New()
+
+	repo.On("GetAuthorByName", ctx, "Ursula K. Le Guin").Return(nil, ErrAuthorNotFound)
+	repo.On("CreateAuthor", ctx, "Ursula K. Le Guin", "Le Guin, Ursula K.").
+		Return(&Author{ID: authorID, Name: "Ursula K. Le Guin"}, nil)
+	repo.On("UpsertBook", ctx, mock.MatchedBy(func(p UpsertBookParams) bool {
+		return p.Kind == KindBook && p.Format == ebook.FormatEPUB &&
+			p.Title == "The Dispossessed" && p.SortTitle == "Dispossessed, The" && p.SeriesID == nil
+	})).Return(&Book{ID: bookID}, nil)
+	repo.On("SetBookAuthors", ctx, bookID, []uuid.UUID{authorID}).Return(nil)
+
+	_, err := svc.ImportBook(ctx, &ScannedBook{
+		FilePath: "/books/The Dispossessed.epub",
+		Authors:  []ebook.Author{{Name: "Ursula K. Le Guin", SortName: "Le Guin, Ursula K."}},
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestImportBook_ReusesExistingAuthors(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	first := &Author{ID: uuid.New(), Name: "Terry Pratchett"}
+	second := &Author{ID: uuid.New(), Name: "Neil Gaiman"}
+	bookID := uuid.New()
+
+	repo.On("GetAuthorByName", ctx, "Terry Pratchett").Return(first, nil)
+	repo.On("GetAuthorByName", ctx, "Neil Gaiman").Return(second, nil)
+	repo.On("UpsertBook", ctx, mock.MatchedBy(func(p UpsertBookParams) bool {
+		return p.AuthorCredit == "Terry Pratchett, Neil Gaiman"
+	})).Return(&Book{ID: bookID}, nil)
+	repo.On("SetBookAuthors", ctx, bookID, []uuid.UUID{first.ID, second.ID}).Return(nil)
+
+	_, err := svc.ImportBook(ctx, &ScannedBook{
+		FilePath: "/books/Good Omens.epub",
+		Title:    "Good Omens",
+		Authors:  []ebook.Author{{Name: "Terry Pratchett"}, {Name: "Neil Gaiman"}, {Name: "Terry Pratchett"}},
+	})
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "CreateAuthor", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestImportBook_Validation(t *testing.T) {
+	svc := NewService(new(MockRepository))
+
+	_, err := svc.ImportBook(context.Background(), &ScannedBook{})
+	assert.Error(t, err)
+
+	_, err = svc.ImportBook(context.Background(), &ScannedBook{FilePath: "/books/a.epub", Kind: "magazine"})
+	assert.Error(t, err)
+
+	_, err = svc.ImportBook(context.Background(), &ScannedBook{FilePath: "/books/a.mobi"})
+	assert.ErrorIs(t, err, ebook.ErrUnsupported)
+}
+
+// =============================================================================
+// UpdateProgress
+// =============================================================================
+
+func TestUpdateProgress_PageDerivesPercent(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, PageCount: 40}, nil)
+	repo.On("UpsertProgress", ctx, UpsertProgressParams{
+		UserID:  userID,
+		BookID:  bookID,
+		Page:    10,
+		Percent: 25,
+	}).Return(&Progress{BookID: bookID, Page: 10, Percent: 25}, nil)
+
+	progress, err := svc.UpdateProgress(ctx, userID, bookID, ProgressUpdate{Page: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), progress.Page)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateProgress_LocatorWithPercent(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	userID := uuid.New()
+	bookID := uuid.New()
+	cfi := "epubcfi(/6/14!/4/2/1:0)"
+	percent := float32(42.5)
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, Format: ebook.FormatEPUB}, nil)
+	repo.On("UpsertProgress", ctx, mock.MatchedBy(func(p UpsertProgressParams) bool {
+		return p.Page == 0 && p.Locator != nil && *p.Locator == cfi && p.Percent == percent && !p.IsCompleted
+	})).Return(&Progress{BookID: bookID}, nil)
+
+	_, err := svc.UpdateProgress(ctx, userID, bookID, ProgressUpdate{Locator: " " + cfi + " ", Percent: &percent})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateProgress_Completion(t *testing.T) {
+	tests := []struct {
+		name          string
+		update        ProgressUpdate
+		pageCount     int32
+		wantCompleted bool
+	}{
+		{"last page", ProgressUpdate{Page: 24}, 24, true},
+		{"past the last page is clamped", ProgressUpdate{Page: 30}, 24, true},
+		{"second to last page", ProgressUpdate{Page: 23}, 24, false},
+		{"reflowable at 99 percent", ProgressUpdate{Locator: "x", Percent: new(float32(99.2))}, 0, true},
+		{"reflowable at 90 percent", ProgressUpdate{Locator: "x", Percent: new(float32(90))}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := new(MockRepository)
+			svc := NewService(repo)
+
+			bookID := uuid.New()
+			repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, PageCount: tt.pageCount}, nil)
+			repo.On("UpsertProgress", ctx, mock.MatchedBy(func(p UpsertProgressParams) bool {
+				return p.IsCompleted == tt.wantCompleted && (tt.pageCount == 0 || p.Page <= tt.pageCount)
+			})).Return(&Progress{BookID: bookID}, nil)
+
+			_, err := svc.UpdateProgress(ctx, uuid.New(), bookID, tt.update)
+			require.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateProgress_Invalid(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	for _, update := range []ProgressUpdate{
+		{},
+		{Page: -1},
+		{Locator: "x", Percent: new(float32(101))},
+		{Percent: new(float32(-5))},
+	} {
+		_, err := svc.UpdateProgress(context.Background(), uuid.New(), uuid.New(), update)
+		assert.ErrorIs(t, err, ErrInvalidProgress)
+	}
+	repo.AssertNotCalled(t, "GetBook", mock.Anything, mock.Anything)
+}
+
+func TestUpdateProgress_BookNotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(nil, ErrBookNotFound)
+
+	_, err := svc.UpdateProgress(ctx, uuid.New(), bookID, ProgressUpdate{Page: 1})
+	assert.ErrorIs(t, err, ErrBookNotFound)
+}
+
+// =============================================================================
+// Content
+// =============================================================================
+
+func TestGetPage(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	path := writeComic(t, map[string]string{"p1.png": "one", "p2.jpg": "two"})
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{
+		ID:       bookID,
+		FilePath: path,
+		Format:   ebook.FormatCBZ,
+		Pages:    []string{"p1.png", "p2.jpg"},
+	}, nil)
+
+	page, err := svc.GetPage(ctx, bookID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "two", string(page.Data))
+	assert.Equal(t, "image/jpeg", page.ContentType)
+
+	_, err = svc.GetPage(ctx, bookID, 0)
+	assert.ErrorIs(t, err, ErrPageNotFound)
+	_, err = svc.GetPage(ctx, bookID, 3)
+	assert.ErrorIs(t, err, ErrPageNotFound)
+}
+
+func TestGetPage_ConvertsUnsupportedFormats(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo).(*bookService)
+	svc.render = func(data []byte, width, height int) ([]byte, string, error) {
+		assert.Equal(t, MaxPageSize, width)
+		return append([]byte("jpeg:"), data...), "image/jpeg", nil
+	}
+
+	path := writeComic(t, map[string]string{"p1.bmp": "one"})
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{
+		ID:       bookID,
+		FilePath: path,
+		Format:   ebook.FormatCBZ,
+		Pages:    []string{"p1.bmp"},
+	}, nil)
+
+	page, err := svc.GetPage(ctx, bookID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg:one", string(page.Data))
+	assert.Equal(t, "image/jpeg", page.ContentType)
+}
+
+func TestGetCover_ExtractsAndCaches(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo).(*bookService)
+
+	renders := 0
+	svc.render = func(data []byte, width, height int) ([]byte, string, error) {
+		renders++
+		assert.Equal(t, CoverWidth, width)
+		assert.Equal(t, CoverHeight, height)
+		return append([]byte("scaled:"), data...), "image/jpeg", nil
+	}
+
+	path := writeComic(t, map[string]string{"cover.jpg": "cover", "p1.jpg": "one"})
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{
+		ID:         bookID,
+		FilePath:   path,
+		Format:     ebook.FormatCBZ,
+		CoverEntry: new("cover.jpg"),
+		UpdatedAt:  time.Now(),
+	}, nil)
+
+	cover, err := svc.GetCover(ctx, bookID)
+	require.NoError(t, err)
+	assert.Equal(t, "scaled:cover", string(cover.Data))
+
+	_, err = svc.GetCover(ctx, bookID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, renders)
+}
+
+func TestGetCover_NoCover(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, Format: ebook.FormatEPUB}, nil)
+
+	_, err := svc.GetCover(ctx, bookID)
+	assert.ErrorIs(t, err, ErrCoverNotFound)
+}
+
+func TestOpenFile(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	path := filepath.Join(t.TempDir(), "book.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF-1.7"), 0o600))
+	bookID := uuid.New()
+	repo.On("GetBook", ctx, bookID).Return(&Book{ID: bookID, FilePath: path}, nil)
+
+	book, f, err := svc.OpenFile(ctx, bookID)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	assert.Equal(t, bookID, book.ID)
+
+	missing := uuid.New()
+	repo.On("GetBook", ctx, missing).Return(&Book{ID: missing, FilePath: path + ".gone"}, nil)
+	_, _, err = svc.OpenFile(ctx, missing)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// =============================================================================
+// Library maintenance
+// =============================================================================
+
+func TestRemoveMissing(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	kept := uuid.New()
+	missing := uuid.New()
+	otherLibrary := uuid.New()
+	repo.On("ListBookPaths", ctx).Return(map[string]uuid.UUID{
+		"/books/Kept.epub":    kept,
+		"/books/Missing.epub": missing,
+		"/comics/Saga 01.cbz": otherLibrary,
+	}, nil)
+	repo.On("DeleteBook", ctx, missing).Return(nil)
+
+	removed, err := svc.RemoveMissing(ctx, []string{"/books"}, map[string]bool{"/books/Kept.epub": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "DeleteBook", ctx, otherLibrary)
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	repo.On("DeleteEmptySeries", ctx).Return(int64(1), nil)
+	repo.On("DeleteOrphanAuthors", ctx).Return(int64(2), nil)
+
+	require.NoError(t, svc.Prune(ctx))
+	repo.AssertExpectations(t)
+}
+
+func TestListBooks_FiltersCount(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	filters := BookListFilters{Kind: KindComic, Query: "saga", OrderBy: "title", Limit: 10}
+	repo.On("ListBooks", ctx, filters).Return([]Book{{ID: uuid.New()}}, nil)
+	repo.On("CountBooks", ctx, filters).Return(int64(7), nil)
+
+	books, total, err := svc.ListBooks(ctx, BookListFilters{Kind: KindComic, Query: "  saga ", OrderBy: "title", Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, books, 1)
+	assert.Equal(t, int64(7), total)
+}
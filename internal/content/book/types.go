@@ -0,0 +1,206 @@
+// Package book provides types, adapters, and services for e-books and
+// comics. Every book is a single EPUB, PDF, CBZ or CBR file; metadata,
+// covers and comic pages are read from the file itself. The library type
+// decides whether a file is shelved as a book or a comic. Reading progress
+// is tracked per user as a page or, for reflowable EPUBs, a reader locator
+// such as an EPUB CFI.
+package book
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+)
+
+// Kind tells books and comics apart. It follows the type of the library a
+// file was found in.
+type Kind string
+
+const (
+	KindBook  Kind = "book"
+	KindComic Kind = "comic"
+)
+
+// Valid reports whether k is a known kind.
+func (k Kind) Valid() bool {
+	return k == KindBook || k == KindComic
+}
+
+// CompletedPercent is the reading progress from which a book counts as
+// finished. Reflowable books rarely report exactly 100%.
+const CompletedPercent float32 = 99
+
+// Author is a writer credited on books.
+type Author struct {
+	ID        uuid.UUID
+	Name      string
+	SortName  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Series groups the books of a series or the issues of a comic run,
+// ordered by series index.
+type Series struct {
+	ID        uuid.UUID
+	Name      string
+	SortName  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Book is one e-book or comic file.
+type Book struct {
+	ID           uuid.UUID
+	FilePath     string
+	FileSize     int64
+	Kind         Kind
+	Format       ebook.Format
+	Title        string
+	SortTitle    string
+	Subtitle     *string
+	AuthorCredit string
+	SeriesID     *uuid.UUID
+	SeriesIndex  *float32
+	Description  *string
+	Publisher    *string
+	Year         *int32
+	Language     *string
+	ISBN         *string
+	Genres       []string
+	PageCount    int32
+	Pages        []string // image entries of comic archives in reading order
+	RightToLeft  bool
+	CoverEntry   *string // archive entry of the cover image
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// HasCover reports whether a cover can be served for the book. PDF covers
+// are rendered from the first page.
+func (b *Book) HasCover() bool {
+	return b.CoverEntry != nil || b.Format == ebook.FormatPDF
+}
+
+// PageEntry returns the archive entry of a comic page. Pages are numbered
+// from 1.
+func (b *Book) PageEntry(page int) (string, bool) {
+	if page < 1 || page > len(b.Pages) {
+		return "", false
+	}
+	return b.Pages[page-1], true
+}
+
+// Progress is a user's reading position in a book. Page is set for paged
+// books (PDFs and comics), Locator for reflowable ones.
+type Progress struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	BookID      uuid.UUID
+	Page        int32
+	Locator     *string
+	Percent     float32
+	IsCompleted bool
+	ReadCount   int32
+	LastReadAt  time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ContinueReadingItem is a started but unfinished book with the user's
+// progress.
+type ContinueReadingItem struct {
+	Book     Book
+	Progress Progress
+}
+
+// ProgressUpdate is a position report from a reader. Paged readers send
+// the current page, from which the percentage is derived; reflowable
+// readers send a locator and the percentage they computed.
+type ProgressUpdate struct {
+	Page    int32
+	Locator string
+	Percent *float32
+}
+
+// BookListFilters contains filters for listing books.
+type BookListFilters struct {
+	Kind         Kind     // empty lists books and comics
+	Query        string   // matches title, authors and series
+	LibraryPaths []string // nil means unrestricted
+	OrderBy      string   // "added", "title", "year"
+	Limit        int32
+	Offset       int32
+}
+
+// AuthorListFilters contains filters for listing authors.
+type AuthorListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	Limit        int32
+	Offset       int32
+}
+
+// SeriesListFilters contains filters for listing series.
+type SeriesListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	Limit        int32
+	Offset       int32
+}
+
+// ScannedBook is a book file with the metadata read by the library
+// scanner.
+type ScannedBook struct {
+	FilePath    string
+	FileSize    int64
+	Kind        Kind
+	Format      ebook.Format
+	Title       string
+	SortTitle   string
+	Subtitle    string
+	Authors     []ebook.Author
+	Series      string
+	SeriesIndex *float64
+	Description string
+	Publisher   string
+	Year        int
+	Language    string
+	ISBN        string
+	Genres      []string
+	PageCount   int
+	Pages       []string
+	RightToLeft bool
+	CoverEntry  string
+}
+
+// leadingArticles are moved to the end of sort titles.
+var leadingArticles = []string{"The ", "A ", "An "}
+
+// SortTitle returns the sort form of a title: "The Hobbit" sorts as
+// "Hobbit, The".
+func SortTitle(title string) string {
+	for _, article := range leadingArticles {
+		if len(title) > len(article) && strings.EqualFold(title[:len(article)], article) {
+			return title[len(article):] + ", " + strings.TrimSpace(title[:len(article)])
+		}
+	}
+	return title
+}
+
+// AuthorSortName returns the sort form of an author name: "Ursula K. Le
+// Guin" sorts as "Guin, Ursula K. Le". Names already in "Last, First" form
+// are kept.
+func AuthorSortName(name string) string {
+	name = strings.TrimSpace(name)
+	if strings.Contains(name, ",") {
+		return name
+	}
+	i := strings.LastIndexByte(name, ' ')
+	if i < 0 {
+		return name
+	}
+	return name[i+1:] + ", " + name[:i]
+}
@@ -0,0 +1,58 @@
+package book
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lusoris/revenge/internal/content/book/ebook"
+)
+
+func TestSortTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"The Hobbit", "Hobbit, The"},
+		{"a Wizard of Earthsea", "Wizard of Earthsea, a"},
+		{"An Echo of Things to Come", "Echo of Things to Come, An"},
+		{"Theft of Swords", "Theft of Swords"},
+		{"The", "The"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, SortTitle(tt.title))
+		})
+	}
+}
+
+func TestAuthorSortName(t *testing.T) {
+	assert.Equal(t, "Sanderson, Brandon", AuthorSortName("Brandon Sanderson"))
+	assert.Equal(t, "Le Guin, Ursula K.", AuthorSortName("Le Guin, Ursula K."))
+	assert.Equal(t, "Moebius", AuthorSortName(" Moebius "))
+}
+
+func TestBook_PageEntry(t *testing.T) {
+	b := &Book{Pages: []string{"01.jpg", "02.jpg"}}
+
+	entry, ok := b.PageEntry(1)
+	assert.True(t, ok)
+	assert.Equal(t, "01.jpg", entry)
+
+	_, ok = b.PageEntry(0)
+	assert.False(t, ok)
+	_, ok = b.PageEntry(3)
+	assert.False(t, ok)
+}
+
+func TestBook_HasCover(t *testing.T) {
+	assert.True(t, (&Book{Format: ebook.FormatPDF}).HasCover(), "PDF covers are rendered")
+	assert.True(t, (&Book{Format: ebook.FormatEPUB, CoverEntry: new("cover.jpg")}).HasCover())
+	assert.False(t, (&Book{Format: ebook.FormatEPUB}).HasCover())
+}
+
+func TestKind_Valid(t *testing.T) {
+	assert.True(t, KindBook.Valid())
+	assert.True(t, KindComic.Valid())
+	assert.False(t, Kind("magazine").Valid())
+}
@@ -0,0 +1,128 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Sources of metadata changes recorded in the change history.
+const (
+	ChangeSourceSystem   = "system"   // Library scans and other untagged writes
+	ChangeSourceRefresh  = "refresh"  // Metadata refresh workers
+	ChangeSourceRadarr   = "radarr"   // Radarr sync
+	ChangeSourceSonarr   = "sonarr"   // Sonarr sync
+	ChangeSourceManual   = "manual"   // Admin edits
+	ChangeSourceRollback = "rollback" // Rollback to a previous version
+)
+
+// Content types with a change history.
+const (
+	ContentTypeMovie   = "movie"
+	ContentTypeSeries  = "series"
+	ContentTypeSeason  = "season"
+	ContentTypeEpisode = "episode"
+)
+
+// Fields recorded in the change history besides the lockable fields.
+const (
+	FieldSeasonNumber  = "season_number"
+	FieldEpisodeNumber = "episode_number"
+)
+
+// ErrCannotClearFields is returned when restoring a snapshot would have to
+// clear fields, which metadata updates cannot do.
+var ErrCannotClearFields = errors.New("cannot clear fields")
+
+// FieldChange is the old and new value of a changed field. Values are the
+// stored representation of the field; nil means the field was empty.
+type FieldChange struct {
+	Old *string `json:"old"`
+	New *string `json:"new"`
+}
+
+// Snapshot holds the values of the history-tracked fields of an item.
+type Snapshot map[string]*string
+
+// Diff returns the fields whose value differs between s and after.
+func (s Snapshot) Diff(after Snapshot) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+	for field, newValue := range after {
+		if oldValue := s[field]; !equalValues(oldValue, newValue) {
+			changes[field] = FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+	for field, oldValue := range s {
+		if _, ok := after[field]; !ok && oldValue != nil {
+			changes[field] = FieldChange{Old: oldValue}
+		}
+	}
+	return changes
+}
+
+// Fields returns the snapshot's field names, sorted.
+func (s Snapshot) Fields() []string {
+	fields := make([]string, 0, len(s))
+	for field := range s {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+// CheckRestorable returns an error wrapping ErrCannotClearFields and listing
+// the fields that are empty in s but set in current, since writing s back
+// cannot clear them.
+func (s Snapshot) CheckRestorable(current Snapshot) error {
+	var cleared []string
+	for _, field := range s.Fields() {
+		if s[field] == nil && current[field] != nil {
+			cleared = append(cleared, field)
+		}
+	}
+	if len(cleared) > 0 {
+		return fmt.Errorf("%w: %s", ErrCannotClearFields, strings.Join(cleared, ", "))
+	}
+	return nil
+}
+
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// ChangeRecorder records metadata changes in the change history.
+type ChangeRecorder interface {
+	// RecordChange stores a new version of an item with the changed fields.
+	// The source and actor are taken from the context. Recording is best
+	// effort: failures are logged and never fail the metadata write.
+	RecordChange(ctx context.Context, contentType string, contentID uuid.UUID, changes map[string]FieldChange)
+}
+
+type changeSourceKey struct{}
+
+type changeSource struct {
+	source  string
+	actorID *uuid.UUID
+}
+
+// WithChangeSource tags metadata writes made with the returned context with
+// a change source and, for manual changes, the acting user.
+func WithChangeSource(ctx context.Context, source string, actorID *uuid.UUID) context.Context {
+	return context.WithValue(ctx, changeSourceKey{}, changeSource{source: source, actorID: actorID})
+}
+
+// ChangeSourceFromContext returns the change source and actor of the
+// context. Untagged contexts report ChangeSourceSystem.
+func ChangeSourceFromContext(ctx context.Context) (string, *uuid.UUID) {
+	if cs, ok := ctx.Value(changeSourceKey{}).(changeSource); ok {
+		return cs.source, cs.actorID
+	}
+	return ChangeSourceSystem, nil
+}
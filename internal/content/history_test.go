@@ -0,0 +1,65 @@
+package content
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot_Diff(t *testing.T) {
+	t.Parallel()
+
+	before := Snapshot{
+		FieldTitle:    new("Alien"),
+		FieldOverview: new("In space"),
+		FieldPoster:   nil,
+		FieldTagline:  new("No one can hear you scream"),
+	}
+	after := Snapshot{
+		FieldTitle:    new("Alien (Director's Cut)"),
+		FieldOverview: new("In space"),
+		FieldPoster:   new("/poster.jpg"),
+	}
+
+	changes := before.Diff(after)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, FieldChange{Old: new("Alien"), New: new("Alien (Director's Cut)")}, changes[FieldTitle])
+	assert.Equal(t, FieldChange{New: new("/poster.jpg")}, changes[FieldPoster])
+	assert.Equal(t, FieldChange{Old: new("No one can hear you scream")}, changes[FieldTagline])
+
+	assert.Empty(t, after.Diff(after))
+	assert.Equal(t, []string{FieldOverview, FieldPoster, FieldTitle}, after.Fields())
+}
+
+func TestSnapshot_CheckRestorable(t *testing.T) {
+	t.Parallel()
+
+	current := Snapshot{
+		FieldTitle:    new("Alien"),
+		FieldOverview: new("In space"),
+		FieldTagline:  new("No one can hear you scream"),
+		FieldPoster:   nil,
+	}
+
+	assert.NoError(t, Snapshot{FieldTitle: new("Alien 2"), FieldPoster: nil}.CheckRestorable(current))
+
+	err := Snapshot{FieldTitle: new("Alien 2"), FieldTagline: nil, FieldOverview: nil}.CheckRestorable(current)
+	assert.ErrorIs(t, err, ErrCannotClearFields)
+	assert.EqualError(t, err, "cannot clear fields: overview, tagline")
+}
+
+func TestChangeSourceFromContext(t *testing.T) {
+	t.Parallel()
+
+	source, actor := ChangeSourceFromContext(context.Background())
+	assert.Equal(t, ChangeSourceSystem, source)
+	assert.Nil(t, actor)
+
+	userID := uuid.New()
+	ctx := WithChangeSource(context.Background(), ChangeSourceManual, &userID)
+	source, actor = ChangeSourceFromContext(ctx)
+	assert.Equal(t, ChangeSourceManual, source)
+	assert.Equal(t, &userID, actor)
+}
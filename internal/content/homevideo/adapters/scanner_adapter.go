@@ -0,0 +1,165 @@
+// Package adapters provides home video-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/homevideo/media"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// HomeVideoFileParser implements scanner.FileParser for home videos. No
+// metadata provider is asked: the title comes from the container's title
+// tag, else from the file name; the recording time from the container's
+// creation time, else from a date in the file name, else from the file's
+// modification time.
+// File names understood:
+// - "2024-06-30 Beach Day.mp4" -> "Beach Day", 2024-06-30
+// - "Birthday_Party.20231224.mkv" -> "Birthday Party", 2023-12-24
+// - "VID_20240630_140509.mp4" -> "2024-06-30 14:05", 2024-06-30 14:05:09
+// - "Grandma's 80th.mov" -> "Grandma's 80th"
+type HomeVideoFileParser struct {
+	probe func(filePath string) (*media.Info, error)
+}
+
+// NewHomeVideoFileParser creates a parser for the files of a home video
+// library.
+func NewHomeVideoFileParser() *HomeVideoFileParser {
+	return &HomeVideoFileParser{probe: media.Probe}
+}
+
+// datePattern matches a date, optionally followed by a time, with or
+// without separators.
+var datePattern = regexp.MustCompile(`(?:^|\D)((?:19|20)\d{2})[-_.]?(\d{2})[-_.]?(\d{2})(?:[-_ .T]?(\d{2})[-_.:]?(\d{2})[-_.:]?(\d{2}))?`)
+
+// cameraName matches what is left of camera and phone file names once
+// the date is removed, e.g. "VID", "MVI 0042", "GOPR0042", "IMG- -WA0001".
+var cameraName = regexp.MustCompile(`(?i)^(?:vid|img|mvi|mov|dsc|pxl|gopr|gx|gh|dji|mah|clip|video)?[\s\d-]*(?:wa\d*)?$`)
+
+// separators are replaced by spaces in titles.
+var separators = strings.NewReplacer("_", " ", ".", " ")
+
+// Parse returns the cleaned file name as the title and the date in the
+// name, if any, as "recorded_at" (time.Time).
+func (p *HomeVideoFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSuffix(filename, filepath.Ext(filename))
+
+	rest := name
+	recorded, loc, ok := parseFilenameDate(name)
+	if ok {
+		metadata["recorded_at"] = recorded
+		rest = name[:loc[0]] + " " + name[loc[1]:]
+	}
+
+	title = cleanTitle(rest)
+	if cameraName.MatchString(title) {
+		switch {
+		case ok && (recorded.Hour() != 0 || recorded.Minute() != 0):
+			title = recorded.Format("2006-01-02 15:04")
+		case ok:
+			title = recorded.Format("2006-01-02")
+		default:
+			title = cleanTitle(name)
+		}
+	}
+	if title == "" {
+		title = name
+	}
+	return title, metadata
+}
+
+// GetExtensions returns the video and camera clip extensions
+func (p *HomeVideoFileParser) GetExtensions() []string {
+	return scanner.ExtensionsToSlice(scanner.MergeExtensions(scanner.VideoExtensions, scanner.ClipExtensions))
+}
+
+// ContentType returns "homevideo"
+func (p *HomeVideoFileParser) ContentType() string {
+	return "homevideo"
+}
+
+// ReadVideo reads a video's container and name. Files FFmpeg cannot read
+// are not imported.
+func (p *HomeVideoFileParser) ReadVideo(filePath string) (*homevideo.ScannedVideo, error) {
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.probe(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	scanned := &homevideo.ScannedVideo{
+		FilePath:        filePath,
+		FileSize:        stat.Size(),
+		Container:       info.Container,
+		Description:     info.Description,
+		DurationSeconds: info.DurationSeconds,
+		Width:           info.Width,
+		Height:          info.Height,
+		VideoCodec:      info.VideoCodec,
+		AudioCodec:      info.AudioCodec,
+	}
+
+	title, metadata := p.Parse(filepath.Base(filePath))
+	if info.Title != "" {
+		scanned.Title, scanned.TitleSource = info.Title, homevideo.TitleSourceTag
+	} else {
+		scanned.Title, scanned.TitleSource = title, homevideo.TitleSourceFilename
+	}
+
+	switch recorded, ok := metadata["recorded_at"].(time.Time); {
+	case info.CreationTime != nil:
+		scanned.RecordedAt, scanned.RecordedAtSource = *info.CreationTime, homevideo.RecordedAtSourceTag
+	case ok:
+		scanned.RecordedAt, scanned.RecordedAtSource = recorded, homevideo.RecordedAtSourceFilename
+	default:
+		scanned.RecordedAt, scanned.RecordedAtSource = stat.ModTime().UTC(), homevideo.RecordedAtSourceFile
+	}
+	return scanned, nil
+}
+
+// cleanTitle turns separators into spaces and trims dashes left around a
+// removed date.
+func cleanTitle(s string) string {
+	s = strings.Join(strings.Fields(separators.Replace(s)), " ")
+	return strings.TrimSpace(strings.Trim(s, "-–, "))
+}
+
+// parseFilenameDate finds the first valid date in a file name and returns
+// the byte range it spans. Like container times without an offset, the
+// result is a wall-clock time in UTC.
+func parseFilenameDate(name string) (time.Time, [2]int, bool) {
+	for _, m := range datePattern.FindAllStringSubmatchIndex(name, -1) {
+		n := make([]int, 6)
+		for i := range n {
+			if start := m[2+2*i]; start >= 0 {
+				n[i], _ = strconv.Atoi(name[start:m[3+2*i]])
+			}
+		}
+		year, month, day, hour, minute, sec := n[0], n[1], n[2], n[3], n[4], n[5]
+		if month < 1 || month > 12 || day < 1 || day > 31 || hour > 23 || minute > 59 || sec > 59 {
+			continue
+		}
+		t := time.Date(year, time.Month(month), day, hour, minute, sec, 0, time.UTC)
+		// time.Date normalizes February 30 into March
+		if t.Day() != day || t.After(time.Now()) {
+			continue
+		}
+		// The match ends with the seconds, else with the day
+		end := m[7]
+		if m[13] >= 0 {
+			end = m[13]
+		}
+		return t, [2]int{m[2], end}, true
+	}
+	return time.Time{}, [2]int{}, false
+}
@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/homevideo/media"
+)
+
+func TestHomeVideoFileParser_Parse(t *testing.T) {
+	parser := NewHomeVideoFileParser()
+
+	tests := []struct {
+		filename     string
+		wantTitle    string
+		wantRecorded time.Time
+	}{
+		{"2024-06-30 Beach Day.mp4", "Beach Day", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{"Birthday_Party.20231224.mkv", "Birthday Party", time.Date(2023, 12, 24, 0, 0, 0, 0, time.UTC)},
+		{"2023-12-24 - Christmas Eve.mov", "Christmas Eve", time.Date(2023, 12, 24, 0, 0, 0, 0, time.UTC)},
+		{"VID_20240630_140509.mp4", "2024-06-30 14:05", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC)},
+		{"PXL_20240630_140509123.mp4", "2024-06-30 14:05", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC)},
+		{"IMG-20240630-WA0001.mp4", "2024-06-30", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{"Grandma's 80th.mov", "Grandma's 80th", time.Time{}},
+		{"MVI_0042.MOV", "MVI 0042", time.Time{}},
+		{"Wedding 20240231.mp4", "Wedding 20240231", time.Time{}}, // February 31
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			title, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantTitle, title)
+			if tt.wantRecorded.IsZero() {
+				assert.NotContains(t, metadata, "recorded_at")
+			} else {
+				assert.Equal(t, tt.wantRecorded, metadata["recorded_at"])
+			}
+		})
+	}
+}
+
+func TestHomeVideoFileParser_GetExtensions(t *testing.T) {
+	parser := NewHomeVideoFileParser()
+	exts := parser.GetExtensions()
+	assert.Contains(t, exts, ".mkv")
+	assert.Contains(t, exts, ".mts")
+	assert.NotContains(t, exts, ".jpg")
+	assert.Equal(t, "homevideo", parser.ContentType())
+}
+
+func TestHomeVideoFileParser_ReadVideo(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "2024-06-30 Beach Day.mp4")
+	require.NoError(t, os.WriteFile(path, []byte("video"), 0o600))
+	modTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	created := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	t.Run("container tags win", func(t *testing.T) {
+		parser := &HomeVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{
+				Container:       "mp4",
+				Title:           "Sandcastles",
+				Description:     "Filmed by Dad",
+				CreationTime:    &created,
+				DurationSeconds: 95.5,
+				Width:           1920,
+				Height:          1080,
+				VideoCodec:      "h264",
+				AudioCodec:      "aac",
+			}, nil
+		}}
+		scanned, err := parser.ReadVideo(path)
+		require.NoError(t, err)
+		assert.Equal(t, "Sandcastles", scanned.Title)
+		assert.Equal(t, homevideo.TitleSourceTag, scanned.TitleSource)
+		assert.Equal(t, "Filmed by Dad", scanned.Description)
+		assert.Equal(t, created, scanned.RecordedAt)
+		assert.Equal(t, homevideo.RecordedAtSourceTag, scanned.RecordedAtSource)
+		assert.Equal(t, int64(5), scanned.FileSize)
+		assert.Equal(t, 1920, scanned.Width)
+		assert.Equal(t, "h264", scanned.VideoCodec)
+	})
+
+	t.Run("file name fallback", func(t *testing.T) {
+		parser := &HomeVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{Container: "mp4", VideoCodec: "h264"}, nil
+		}}
+		scanned, err := parser.ReadVideo(path)
+		require.NoError(t, err)
+		assert.Equal(t, "Beach Day", scanned.Title)
+		assert.Equal(t, homevideo.TitleSourceFilename, scanned.TitleSource)
+		assert.Equal(t, time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), scanned.RecordedAt)
+		assert.Equal(t, homevideo.RecordedAtSourceFilename, scanned.RecordedAtSource)
+	})
+
+	t.Run("modification time fallback", func(t *testing.T) {
+		plain := filepath.Join(dir, "Sledding.mkv")
+		require.NoError(t, os.WriteFile(plain, []byte("video"), 0o600))
+		require.NoError(t, os.Chtimes(plain, modTime, modTime))
+		parser := &HomeVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{Container: "mkv", VideoCodec: "hevc"}, nil
+		}}
+		scanned, err := parser.ReadVideo(plain)
+		require.NoError(t, err)
+		assert.Equal(t, "Sledding", scanned.Title)
+		assert.Equal(t, modTime, scanned.RecordedAt)
+		assert.Equal(t, homevideo.RecordedAtSourceFile, scanned.RecordedAtSource)
+	})
+
+	t.Run("unreadable file", func(t *testing.T) {
+		parser := &HomeVideoFileParser{probe: func(string) (*media.Info, error) {
+			return nil, media.ErrNoVideoStream
+		}}
+		_, err := parser.ReadVideo(path)
+		assert.True(t, errors.Is(err, media.ErrNoVideoStream))
+
+		_, err = parser.ReadVideo(filepath.Join(dir, "missing.mp4"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,313 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: folders.sql
+
+package homevideodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countFolders = `-- name: CountFolders :one
+SELECT COUNT(*)
+FROM homevideo.folders f
+WHERE (
+        $1::bool = FALSE
+        OR f.library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR f.library_id = $3::uuid
+    )
+    AND f.parent_id IS NOT DISTINCT FROM $4::uuid
+`
+
+type CountFoldersParams struct {
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	ParentID   pgtype.UUID `json:"parentId"`
+}
+
+func (q *Queries) CountFolders(ctx context.Context, arg CountFoldersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countFolders,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.ParentID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteEmptyFolders = `-- name: DeleteEmptyFolders :execrows
+DELETE FROM homevideo.folders f
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+    )
+`
+
+// Removes folders left without videos, including in their subfolders.
+func (q *Queries) DeleteEmptyFolders(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmptyFolders)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getFolder = `-- name: GetFolder :one
+SELECT
+    f.id, f.library_id, f.parent_id, f.path, f.title, f.created_at, f.updated_at,
+    (
+        SELECT COUNT(*)
+        FROM homevideo.folders c
+        WHERE
+            c.parent_id = f.id
+    ) AS folder_count,
+    (
+        SELECT COUNT(*)
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+    ) AS video_count,
+    cover.id AS cover_video_id
+FROM homevideo.folders f
+    LEFT JOIN LATERAL (
+        SELECT v.id
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+            AND v.thumbnail_key IS NOT NULL
+        ORDER BY v.recorded_at DESC
+        LIMIT 1
+    ) cover ON TRUE
+WHERE
+    f.id = $1
+`
+
+type GetFolderRow struct {
+	HomevideoFolder HomevideoFolder `json:"homevideoFolder"`
+	FolderCount     int64           `json:"folderCount"`
+	VideoCount      int64           `json:"videoCount"`
+	CoverVideoID    uuid.UUID       `json:"coverVideoId"`
+}
+
+func (q *Queries) GetFolder(ctx context.Context, id uuid.UUID) (GetFolderRow, error) {
+	row := q.db.QueryRow(ctx, getFolder, id)
+	var i GetFolderRow
+	err := row.Scan(
+		&i.HomevideoFolder.ID,
+		&i.HomevideoFolder.LibraryID,
+		&i.HomevideoFolder.ParentID,
+		&i.HomevideoFolder.Path,
+		&i.HomevideoFolder.Title,
+		&i.HomevideoFolder.CreatedAt,
+		&i.HomevideoFolder.UpdatedAt,
+		&i.FolderCount,
+		&i.VideoCount,
+		&i.CoverVideoID,
+	)
+	return i, err
+}
+
+const listFolderAncestors = `-- name: ListFolderAncestors :many
+WITH RECURSIVE
+    ancestors AS (
+        SELECT f.id, f.parent_id, f.title, 0 AS depth
+        FROM homevideo.folders f
+        WHERE
+            f.id = $1
+        UNION ALL
+        SELECT p.id, p.parent_id, p.title, a.depth + 1
+        FROM homevideo.folders p
+            JOIN ancestors a ON p.id = a.parent_id
+    )
+SELECT id, title
+FROM ancestors
+WHERE
+    depth > 0
+ORDER BY depth DESC
+`
+
+type ListFolderAncestorsRow struct {
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+}
+
+// Returns the folders above a folder, the library root first.
+func (q *Queries) ListFolderAncestors(ctx context.Context, id uuid.UUID) ([]ListFolderAncestorsRow, error) {
+	rows, err := q.db.Query(ctx, listFolderAncestors, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListFolderAncestorsRow{}
+	for rows.Next() {
+		var i ListFolderAncestorsRow
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFolders = `-- name: ListFolders :many
+SELECT
+    f.id, f.library_id, f.parent_id, f.path, f.title, f.created_at, f.updated_at,
+    (
+        SELECT COUNT(*)
+        FROM homevideo.folders c
+        WHERE
+            c.parent_id = f.id
+    ) AS folder_count,
+    (
+        SELECT COUNT(*)
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+    ) AS video_count,
+    cover.id AS cover_video_id
+FROM homevideo.folders f
+    LEFT JOIN LATERAL (
+        SELECT v.id
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+            AND v.thumbnail_key IS NOT NULL
+        ORDER BY v.recorded_at DESC
+        LIMIT 1
+    ) cover ON TRUE
+WHERE (
+        $3::bool = FALSE
+        OR f.library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR f.library_id = $5::uuid
+    )
+    AND f.parent_id IS NOT DISTINCT FROM $6::uuid
+ORDER BY lower(f.title) ASC, f.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListFoldersParams struct {
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	ParentID   pgtype.UUID `json:"parentId"`
+}
+
+type ListFoldersRow struct {
+	HomevideoFolder HomevideoFolder `json:"homevideoFolder"`
+	FolderCount     int64           `json:"folderCount"`
+	VideoCount      int64           `json:"videoCount"`
+	CoverVideoID    uuid.UUID       `json:"coverVideoId"`
+}
+
+// Lists the subfolders of a folder, or the library roots when parent_id
+// is NULL, by title. Video counts include subfolders; the cover is the
+// latest recorded video with a thumbnail. restrict limits the result to
+// library_ids, the libraries the user may access.
+func (q *Queries) ListFolders(ctx context.Context, arg ListFoldersParams) ([]ListFoldersRow, error) {
+	rows, err := q.db.Query(ctx, listFolders,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.ParentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListFoldersRow{}
+	for rows.Next() {
+		var i ListFoldersRow
+		if err := rows.Scan(
+			&i.HomevideoFolder.ID,
+			&i.HomevideoFolder.LibraryID,
+			&i.HomevideoFolder.ParentID,
+			&i.HomevideoFolder.Path,
+			&i.HomevideoFolder.Title,
+			&i.HomevideoFolder.CreatedAt,
+			&i.HomevideoFolder.UpdatedAt,
+			&i.FolderCount,
+			&i.VideoCount,
+			&i.CoverVideoID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFolder = `-- name: UpsertFolder :one
+INSERT INTO
+    homevideo.folders (
+        library_id,
+        parent_id,
+        path,
+        title
+    )
+VALUES ($1, $2, $3, $4) ON CONFLICT (library_id, path) DO
+UPDATE
+SET
+    parent_id = EXCLUDED.parent_id,
+    title = EXCLUDED.title RETURNING id, library_id, parent_id, path, title, created_at, updated_at
+`
+
+type UpsertFolderParams struct {
+	LibraryID uuid.UUID   `json:"libraryId"`
+	ParentID  pgtype.UUID `json:"parentId"`
+	Path      string      `json:"path"`
+	Title     string      `json:"title"`
+}
+
+// Folders are keyed by their path so rescans keep their IDs.
+func (q *Queries) UpsertFolder(ctx context.Context, arg UpsertFolderParams) (HomevideoFolder, error) {
+	row := q.db.QueryRow(ctx, upsertFolder,
+		arg.LibraryID,
+		arg.ParentID,
+		arg.Path,
+		arg.Title,
+	)
+	var i HomevideoFolder
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ParentID,
+		&i.Path,
+		&i.Title,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,214 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: progress.sql
+
+package homevideodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteWatchProgress = `-- name: DeleteWatchProgress :execrows
+DELETE FROM homevideo.watch_progress
+WHERE
+    user_id = $1
+    AND video_id = $2
+`
+
+type DeleteWatchProgressParams struct {
+	UserID  uuid.UUID `json:"userId"`
+	VideoID uuid.UUID `json:"videoId"`
+}
+
+func (q *Queries) DeleteWatchProgress(ctx context.Context, arg DeleteWatchProgressParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWatchProgress, arg.UserID, arg.VideoID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getWatchProgress = `-- name: GetWatchProgress :one
+SELECT user_id, video_id, progress_seconds, duration_seconds, is_completed, watch_count, last_watched_at, created_at, updated_at
+FROM homevideo.watch_progress
+WHERE
+    user_id = $1
+    AND video_id = $2
+`
+
+type GetWatchProgressParams struct {
+	UserID  uuid.UUID `json:"userId"`
+	VideoID uuid.UUID `json:"videoId"`
+}
+
+func (q *Queries) GetWatchProgress(ctx context.Context, arg GetWatchProgressParams) (HomevideoWatchProgress, error) {
+	row := q.db.QueryRow(ctx, getWatchProgress, arg.UserID, arg.VideoID)
+	var i HomevideoWatchProgress
+	err := row.Scan(
+		&i.UserID,
+		&i.VideoID,
+		&i.ProgressSeconds,
+		&i.DurationSeconds,
+		&i.IsCompleted,
+		&i.WatchCount,
+		&i.LastWatchedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listContinueWatching = `-- name: ListContinueWatching :many
+SELECT
+    v.id, v.library_id, v.folder_id, v.file_path, v.file_size, v.container, v.title, v.title_source, v.sort_title, v.description, v.recorded_at, v.recorded_at_source, v.duration_seconds, v.width, v.height, v.video_codec, v.audio_codec, v.thumbnail_key, v.created_at, v.updated_at,
+    p.progress_seconds,
+    p.duration_seconds AS progress_duration_seconds,
+    p.last_watched_at
+FROM homevideo.watch_progress p
+    JOIN homevideo.videos v ON v.id = p.video_id
+WHERE
+    p.user_id = $2
+    AND NOT p.is_completed
+    AND p.progress_seconds > 0
+    AND (
+        $3::bool = FALSE
+        OR v.library_id = ANY($4::uuid[])
+    )
+ORDER BY p.last_watched_at DESC
+LIMIT $1
+`
+
+type ListContinueWatchingParams struct {
+	Limit      int32       `json:"limit"`
+	UserID     uuid.UUID   `json:"userId"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+}
+
+type ListContinueWatchingRow struct {
+	HomevideoVideo          HomevideoVideo `json:"homevideoVideo"`
+	ProgressSeconds         int32          `json:"progressSeconds"`
+	ProgressDurationSeconds int32          `json:"progressDurationSeconds"`
+	LastWatchedAt           time.Time      `json:"lastWatchedAt"`
+}
+
+// Returns the videos a user started but did not finish, most recently
+// watched first. restrict limits the result to library_ids.
+func (q *Queries) ListContinueWatching(ctx context.Context, arg ListContinueWatchingParams) ([]ListContinueWatchingRow, error) {
+	rows, err := q.db.Query(ctx, listContinueWatching,
+		arg.Limit,
+		arg.UserID,
+		arg.Restrict,
+		arg.LibraryIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListContinueWatchingRow{}
+	for rows.Next() {
+		var i ListContinueWatchingRow
+		if err := rows.Scan(
+			&i.HomevideoVideo.ID,
+			&i.HomevideoVideo.LibraryID,
+			&i.HomevideoVideo.FolderID,
+			&i.HomevideoVideo.FilePath,
+			&i.HomevideoVideo.FileSize,
+			&i.HomevideoVideo.Container,
+			&i.HomevideoVideo.Title,
+			&i.HomevideoVideo.TitleSource,
+			&i.HomevideoVideo.SortTitle,
+			&i.HomevideoVideo.Description,
+			&i.HomevideoVideo.RecordedAt,
+			&i.HomevideoVideo.RecordedAtSource,
+			&i.HomevideoVideo.DurationSeconds,
+			&i.HomevideoVideo.Width,
+			&i.HomevideoVideo.Height,
+			&i.HomevideoVideo.VideoCodec,
+			&i.HomevideoVideo.AudioCodec,
+			&i.HomevideoVideo.ThumbnailKey,
+			&i.HomevideoVideo.CreatedAt,
+			&i.HomevideoVideo.UpdatedAt,
+			&i.ProgressSeconds,
+			&i.ProgressDurationSeconds,
+			&i.LastWatchedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertWatchProgress = `-- name: UpsertWatchProgress :one
+INSERT INTO
+    homevideo.watch_progress (
+        user_id,
+        video_id,
+        progress_seconds,
+        duration_seconds,
+        is_completed,
+        watch_count
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5::boolean,
+        CASE
+            WHEN $5::boolean THEN 1
+            ELSE 0
+        END
+    ) ON CONFLICT (user_id, video_id) DO
+UPDATE
+SET
+    progress_seconds = EXCLUDED.progress_seconds,
+    duration_seconds = EXCLUDED.duration_seconds,
+    is_completed = EXCLUDED.is_completed,
+    watch_count = watch_progress.watch_count + CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT watch_progress.is_completed THEN 1
+        ELSE 0
+    END,
+    last_watched_at = NOW() RETURNING user_id, video_id, progress_seconds, duration_seconds, is_completed, watch_count, last_watched_at, created_at, updated_at
+`
+
+type UpsertWatchProgressParams struct {
+	UserID          uuid.UUID `json:"userId"`
+	VideoID         uuid.UUID `json:"videoId"`
+	ProgressSeconds int32     `json:"progressSeconds"`
+	DurationSeconds int32     `json:"durationSeconds"`
+	IsCompleted     bool      `json:"isCompleted"`
+}
+
+// Records the playback position. Finishing a video counts as a watch.
+func (q *Queries) UpsertWatchProgress(ctx context.Context, arg UpsertWatchProgressParams) (HomevideoWatchProgress, error) {
+	row := q.db.QueryRow(ctx, upsertWatchProgress,
+		arg.UserID,
+		arg.VideoID,
+		arg.ProgressSeconds,
+		arg.DurationSeconds,
+		arg.IsCompleted,
+	)
+	var i HomevideoWatchProgress
+	err := row.Scan(
+		&i.UserID,
+		&i.VideoID,
+		&i.ProgressSeconds,
+		&i.DurationSeconds,
+		&i.IsCompleted,
+		&i.WatchCount,
+		&i.LastWatchedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,49 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package homevideodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Querier interface {
+	CountFolders(ctx context.Context, arg CountFoldersParams) (int64, error)
+	CountVideos(ctx context.Context, arg CountVideosParams) (int64, error)
+	// Removes folders left without videos, including in their subfolders.
+	DeleteEmptyFolders(ctx context.Context) (int64, error)
+	DeleteVideo(ctx context.Context, id uuid.UUID) error
+	DeleteWatchProgress(ctx context.Context, arg DeleteWatchProgressParams) (int64, error)
+	GetFolder(ctx context.Context, id uuid.UUID) (GetFolderRow, error)
+	GetVideo(ctx context.Context, id uuid.UUID) (HomevideoVideo, error)
+	GetVideoByPath(ctx context.Context, filePath string) (HomevideoVideo, error)
+	GetWatchProgress(ctx context.Context, arg GetWatchProgressParams) (HomevideoWatchProgress, error)
+	// Returns the videos a user started but did not finish, most recently
+	// watched first. restrict limits the result to library_ids.
+	ListContinueWatching(ctx context.Context, arg ListContinueWatchingParams) ([]ListContinueWatchingRow, error)
+	// Returns the folders above a folder, the library root first.
+	ListFolderAncestors(ctx context.Context, id uuid.UUID) ([]ListFolderAncestorsRow, error)
+	// Lists the subfolders of a folder, or the library roots when parent_id
+	// is NULL, by title. Video counts include subfolders; the cover is the
+	// latest recorded video with a thumbnail. restrict limits the result to
+	// library_ids, the libraries the user may access.
+	ListFolders(ctx context.Context, arg ListFoldersParams) ([]ListFoldersRow, error)
+	ListVideoPaths(ctx context.Context, libraryID uuid.UUID) ([]ListVideoPathsRow, error)
+	// Lists videos by recording time, newest first, by title or by when they
+	// were added. restrict limits the result to library_ids, the libraries
+	// the user may access.
+	ListVideos(ctx context.Context, arg ListVideosParams) ([]HomevideoVideo, error)
+	SetVideoThumbnail(ctx context.Context, arg SetVideoThumbnailParams) error
+	// Folders are keyed by their path so rescans keep their IDs.
+	UpsertFolder(ctx context.Context, arg UpsertFolderParams) (HomevideoFolder, error)
+	// Videos are keyed by their file so rescans update them in place. The
+	// thumbnail is kept unless the file changed.
+	UpsertVideo(ctx context.Context, arg UpsertVideoParams) (HomevideoVideo, error)
+	// Records the playback position. Finishing a video counts as a watch.
+	UpsertWatchProgress(ctx context.Context, arg UpsertWatchProgressParams) (HomevideoWatchProgress, error)
+}
+
+var _ Querier = (*Queries)(nil)
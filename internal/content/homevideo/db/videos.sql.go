@@ -0,0 +1,383 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: videos.sql
+
+package homevideodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countVideos = `-- name: CountVideos :one
+SELECT COUNT(*)
+FROM homevideo.videos
+WHERE (
+        $1::bool = FALSE
+        OR library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR library_id = $3::uuid
+    )
+    AND (
+        $4::uuid IS NULL
+        OR folder_id = $4::uuid
+    )
+`
+
+type CountVideosParams struct {
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	FolderID   pgtype.UUID `json:"folderId"`
+}
+
+func (q *Queries) CountVideos(ctx context.Context, arg CountVideosParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countVideos,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.FolderID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteVideo = `-- name: DeleteVideo :exec
+DELETE FROM homevideo.videos WHERE id = $1
+`
+
+func (q *Queries) DeleteVideo(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteVideo, id)
+	return err
+}
+
+const getVideo = `-- name: GetVideo :one
+SELECT id, library_id, folder_id, file_path, file_size, container, title, title_source, sort_title, description, recorded_at, recorded_at_source, duration_seconds, width, height, video_codec, audio_codec, thumbnail_key, created_at, updated_at FROM homevideo.videos WHERE id = $1
+`
+
+func (q *Queries) GetVideo(ctx context.Context, id uuid.UUID) (HomevideoVideo, error) {
+	row := q.db.QueryRow(ctx, getVideo, id)
+	var i HomevideoVideo
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FolderID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.Title,
+		&i.TitleSource,
+		&i.SortTitle,
+		&i.Description,
+		&i.RecordedAt,
+		&i.RecordedAtSource,
+		&i.DurationSeconds,
+		&i.Width,
+		&i.Height,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.ThumbnailKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getVideoByPath = `-- name: GetVideoByPath :one
+SELECT id, library_id, folder_id, file_path, file_size, container, title, title_source, sort_title, description, recorded_at, recorded_at_source, duration_seconds, width, height, video_codec, audio_codec, thumbnail_key, created_at, updated_at FROM homevideo.videos WHERE file_path = $1
+`
+
+func (q *Queries) GetVideoByPath(ctx context.Context, filePath string) (HomevideoVideo, error) {
+	row := q.db.QueryRow(ctx, getVideoByPath, filePath)
+	var i HomevideoVideo
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FolderID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.Title,
+		&i.TitleSource,
+		&i.SortTitle,
+		&i.Description,
+		&i.RecordedAt,
+		&i.RecordedAtSource,
+		&i.DurationSeconds,
+		&i.Width,
+		&i.Height,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.ThumbnailKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listVideoPaths = `-- name: ListVideoPaths :many
+SELECT id, file_path FROM homevideo.videos WHERE library_id = $1
+`
+
+type ListVideoPathsRow struct {
+	ID       uuid.UUID `json:"id"`
+	FilePath string    `json:"filePath"`
+}
+
+func (q *Queries) ListVideoPaths(ctx context.Context, libraryID uuid.UUID) ([]ListVideoPathsRow, error) {
+	rows, err := q.db.Query(ctx, listVideoPaths, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListVideoPathsRow{}
+	for rows.Next() {
+		var i ListVideoPathsRow
+		if err := rows.Scan(&i.ID, &i.FilePath); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVideos = `-- name: ListVideos :many
+SELECT id, library_id, folder_id, file_path, file_size, container, title, title_source, sort_title, description, recorded_at, recorded_at_source, duration_seconds, width, height, video_codec, audio_codec, thumbnail_key, created_at, updated_at
+FROM homevideo.videos
+WHERE (
+        $3::bool = FALSE
+        OR library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR library_id = $5::uuid
+    )
+    AND (
+        $6::uuid IS NULL
+        OR folder_id = $6::uuid
+    )
+ORDER BY
+    CASE
+        WHEN $7::text = 'title' THEN sort_title
+    END ASC,
+    CASE
+        WHEN $7::text = 'added' THEN created_at
+    END DESC,
+    recorded_at DESC,
+    id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListVideosParams struct {
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	FolderID   pgtype.UUID `json:"folderId"`
+	Sort       string      `json:"sort"`
+}
+
+// Lists videos by recording time, newest first, by title or by when they
+// were added. restrict limits the result to library_ids, the libraries
+// the user may access.
+func (q *Queries) ListVideos(ctx context.Context, arg ListVideosParams) ([]HomevideoVideo, error) {
+	rows, err := q.db.Query(ctx, listVideos,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.FolderID,
+		arg.Sort,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []HomevideoVideo{}
+	for rows.Next() {
+		var i HomevideoVideo
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.FolderID,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Container,
+			&i.Title,
+			&i.TitleSource,
+			&i.SortTitle,
+			&i.Description,
+			&i.RecordedAt,
+			&i.RecordedAtSource,
+			&i.DurationSeconds,
+			&i.Width,
+			&i.Height,
+			&i.VideoCodec,
+			&i.AudioCodec,
+			&i.ThumbnailKey,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setVideoThumbnail = `-- name: SetVideoThumbnail :exec
+UPDATE homevideo.videos SET thumbnail_key = $2 WHERE id = $1
+`
+
+type SetVideoThumbnailParams struct {
+	ID           uuid.UUID `json:"id"`
+	ThumbnailKey *string   `json:"thumbnailKey"`
+}
+
+func (q *Queries) SetVideoThumbnail(ctx context.Context, arg SetVideoThumbnailParams) error {
+	_, err := q.db.Exec(ctx, setVideoThumbnail, arg.ID, arg.ThumbnailKey)
+	return err
+}
+
+const upsertVideo = `-- name: UpsertVideo :one
+INSERT INTO
+    homevideo.videos (
+        library_id,
+        folder_id,
+        file_path,
+        file_size,
+        container,
+        title,
+        title_source,
+        sort_title,
+        description,
+        recorded_at,
+        recorded_at_source,
+        duration_seconds,
+        width,
+        height,
+        video_codec,
+        audio_codec
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16
+    ) ON CONFLICT (file_path) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    folder_id = EXCLUDED.folder_id,
+    container = EXCLUDED.container,
+    title = EXCLUDED.title,
+    title_source = EXCLUDED.title_source,
+    sort_title = EXCLUDED.sort_title,
+    description = EXCLUDED.description,
+    recorded_at = EXCLUDED.recorded_at,
+    recorded_at_source = EXCLUDED.recorded_at_source,
+    duration_seconds = EXCLUDED.duration_seconds,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    video_codec = EXCLUDED.video_codec,
+    audio_codec = EXCLUDED.audio_codec,
+    thumbnail_key = CASE
+        WHEN homevideo.videos.file_size = EXCLUDED.file_size THEN homevideo.videos.thumbnail_key
+    END,
+    file_size = EXCLUDED.file_size RETURNING id, library_id, folder_id, file_path, file_size, container, title, title_source, sort_title, description, recorded_at, recorded_at_source, duration_seconds, width, height, video_codec, audio_codec, thumbnail_key, created_at, updated_at
+`
+
+type UpsertVideoParams struct {
+	LibraryID        uuid.UUID `json:"libraryId"`
+	FolderID         uuid.UUID `json:"folderId"`
+	FilePath         string    `json:"filePath"`
+	FileSize         int64     `json:"fileSize"`
+	Container        *string   `json:"container"`
+	Title            string    `json:"title"`
+	TitleSource      string    `json:"titleSource"`
+	SortTitle        string    `json:"sortTitle"`
+	Description      *string   `json:"description"`
+	RecordedAt       time.Time `json:"recordedAt"`
+	RecordedAtSource string    `json:"recordedAtSource"`
+	DurationSeconds  float64   `json:"durationSeconds"`
+	Width            int32     `json:"width"`
+	Height           int32     `json:"height"`
+	VideoCodec       *string   `json:"videoCodec"`
+	AudioCodec       *string   `json:"audioCodec"`
+}
+
+// Videos are keyed by their file so rescans update them in place. The
+// thumbnail is kept unless the file changed.
+func (q *Queries) UpsertVideo(ctx context.Context, arg UpsertVideoParams) (HomevideoVideo, error) {
+	row := q.db.QueryRow(ctx, upsertVideo,
+		arg.LibraryID,
+		arg.FolderID,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Container,
+		arg.Title,
+		arg.TitleSource,
+		arg.SortTitle,
+		arg.Description,
+		arg.RecordedAt,
+		arg.RecordedAtSource,
+		arg.DurationSeconds,
+		arg.Width,
+		arg.Height,
+		arg.VideoCodec,
+		arg.AudioCodec,
+	)
+	var i HomevideoVideo
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FolderID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.Title,
+		&i.TitleSource,
+		&i.SortTitle,
+		&i.Description,
+		&i.RecordedAt,
+		&i.RecordedAtSource,
+		&i.DurationSeconds,
+		&i.Width,
+		&i.Height,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.ThumbnailKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
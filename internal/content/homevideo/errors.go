@@ -0,0 +1,12 @@
+package homevideo
+
+import "errors"
+
+var (
+	ErrVideoNotFound      = errors.New("home video not found")
+	ErrFolderNotFound     = errors.New("folder not found")
+	ErrProgressNotFound   = errors.New("watch progress not found")
+	ErrInvalidProgress    = errors.New("invalid watch progress")
+	ErrNoThumbnail        = errors.New("no thumbnail for this video")
+	ErrThumbnailsDisabled = errors.New("thumbnails are not available")
+)
@@ -0,0 +1,427 @@
+// Package jobs provides background job workers for home video content.
+// Library scans skip metadata matching: they read the container tags of
+// each video, file it under the folder of its directory and grab a frame
+// as its thumbnail.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/homevideo/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/service/search"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Job kind constants for home video jobs
+const (
+	KindLibraryScan = "homevideo_library_scan"
+	KindSearchIndex = "homevideo_search_index"
+)
+
+// =============================================================================
+// Library Scan Job
+// =============================================================================
+
+// libraryType is the library type reported in scan events.
+const libraryType = "homevideo"
+
+// LibraryScanArgs defines arguments for home video library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for videos.
+	Paths []string `json:"paths"`
+
+	// Force re-reads the container tags of videos that are already
+	// imported.
+	Force bool `json:"force"`
+
+	// LibraryID is the library the videos belong to. Videos carry their
+	// library for permission checks, so scans without one are rejected.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for home video library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans home video library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             homevideo.Service
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service homevideo.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "homevideo_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Any("paths", job.Args.Paths),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, libraryType, job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+
+	status.Start(ctx)
+
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	if job.Args.LibraryID == nil {
+		w.logger.Warn("no library provided for home video library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	libraryID := *job.Args.LibraryID
+
+	parser := adapters.NewHomeVideoFileParser()
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, libraryType, libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	var files []scanner.ScanResult
+	for _, sr := range scanResults {
+		if sr.IsMedia {
+			files = append(files, sr)
+		}
+	}
+	present := make(map[string]bool, len(files))
+	processed := 0
+
+	for _, f := range files {
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(files),
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    f.FilePath,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+		present[f.FilePath] = true
+
+		// Unchanged files keep their tags unless a full rescan is forced;
+		// a thumbnail that failed last time is retried
+		existing, err := w.service.GetVideoByPath(ctx, f.FilePath)
+		if err == nil && existing != nil && !job.Args.Force &&
+			existing.FileSize == f.FileSize && existing.LibraryID == libraryID {
+			if !existing.HasThumbnail() {
+				w.generateThumbnail(ctx, existing)
+			}
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadVideo(f.FilePath)
+		if err != nil {
+			w.logger.Warn("failed to read video",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(err)
+			continue
+		}
+		scanned.LibraryID = libraryID
+		imported, err := w.service.ImportVideo(ctx, scanned, libraryRoot(job.Args.Paths, f.FilePath))
+		if err != nil {
+			w.logger.Warn("failed to import video",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("import %s: %w", f.FilePath, err))
+			continue
+		}
+		if !imported.HasThumbnail() {
+			w.generateThumbnail(ctx, imported)
+		}
+		result.ItemsProcessed++
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(files),
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	// An empty result usually means an unmounted library, not deleted videos
+	itemsRemoved := 0
+	if len(files) > 0 {
+		itemsRemoved, err = w.service.RemoveMissing(ctx, libraryID, job.Args.Paths, present)
+		if err != nil {
+			w.logger.Warn("failed to remove missing videos", slog.Any("error", err))
+		}
+	}
+	if result.ItemsProcessed > 0 || itemsRemoved > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty folders", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_skipped", itemsSkipped),
+		slog.Int("items_removed", itemsRemoved),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ItemsRemoved: util.SafeIntToInt32(itemsRemoved),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType).
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	// Enqueue a search reindex job so imported videos are searchable and
+	// removed ones are not.
+	if w.jobClient != nil && (result.ItemsProcessed > 0 || itemsRemoved > 0) {
+		if _, err := w.jobClient.Insert(ctx, SearchIndexArgs{
+			FullReindex: true,
+		}, nil); err != nil {
+			w.logger.Warn("failed to enqueue search reindex after library scan",
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// generateThumbnail grabs the thumbnail of a video. A failed thumbnail
+// does not fail the import; the next scan retries it.
+func (w *LibraryScanWorker) generateThumbnail(ctx context.Context, v *homevideo.Video) {
+	if err := w.service.GenerateThumbnail(ctx, v); err != nil {
+		w.logger.Warn("failed to generate video thumbnail",
+			slog.String("path", v.FilePath),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// libraryRoot returns the library path containing filePath, the innermost
+// one if library paths are nested.
+func libraryRoot(paths []string, filePath string) string {
+	root := ""
+	for _, p := range paths {
+		p = filepath.Clean(p)
+		if (filePath == p || strings.HasPrefix(filePath, p+string(filepath.Separator))) && len(p) > len(root) {
+			root = p
+		}
+	}
+	return root
+}
+
+// =============================================================================
+// Search Index Job
+// =============================================================================
+
+// SearchIndexArgs defines arguments for home video search indexing jobs.
+type SearchIndexArgs struct {
+	// VideoID is the specific video to index. If nil, indexes all.
+	VideoID *uuid.UUID `json:"video_id,omitempty"`
+
+	// FullReindex indicates whether to do a full reindex.
+	FullReindex bool `json:"full_reindex"`
+}
+
+// Kind returns the job kind identifier.
+func (SearchIndexArgs) Kind() string {
+	return KindSearchIndex
+}
+
+// InsertOpts returns the default insert options for home video search index jobs.
+// Search indexing runs on the bulk queue since it's batch-heavy.
+func (SearchIndexArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 5,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// SearchIndexWorker indexes home videos for search.
+type SearchIndexWorker struct {
+	river.WorkerDefaults[SearchIndexArgs]
+	service       homevideo.Service
+	searchService *search.HomeVideoSearchService
+	logger        *slog.Logger
+}
+
+// NewSearchIndexWorker creates a new search index worker.
+func NewSearchIndexWorker(service homevideo.Service, searchService *search.HomeVideoSearchService, logger *slog.Logger) *SearchIndexWorker {
+	return &SearchIndexWorker{
+		service:       service,
+		searchService: searchService,
+		logger:        logger.With("component", "homevideo_search_index"),
+	}
+}
+
+// Timeout returns the maximum execution time for search index jobs.
+func (w *SearchIndexWorker) Timeout(job *river.Job[SearchIndexArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+// Work executes the search index job.
+func (w *SearchIndexWorker) Work(ctx context.Context, job *river.Job[SearchIndexArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindSearchIndex)
+	args := job.Args
+
+	jctx.LogStart(
+		slog.Any("video_id", args.VideoID),
+		slog.Bool("full_reindex", args.FullReindex),
+	)
+
+	if w.searchService == nil || !w.searchService.IsEnabled() {
+		w.logger.Debug("search is disabled, skipping index operation")
+		return nil
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+
+	if args.VideoID != nil {
+		if err := w.indexVideo(ctx, *args.VideoID); err != nil {
+			result.AddError(fmt.Errorf("index video %s: %w", args.VideoID, err))
+		} else {
+			result.ItemsProcessed++
+		}
+	} else if args.FullReindex {
+		if err := w.searchService.ReindexAll(ctx, w.service); err != nil {
+			result.AddError(fmt.Errorf("full home video reindex: %w", err))
+		} else {
+			w.logger.Info("full home video reindex completed")
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindSearchIndex)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+		return fmt.Errorf("search indexing completed with errors: %d failed", result.ItemsFailed)
+	}
+
+	jctx.LogComplete(slog.Int("items_indexed", result.ItemsProcessed))
+	return nil
+}
+
+// indexVideo indexes a single video, or removes it when it is gone.
+func (w *SearchIndexWorker) indexVideo(ctx context.Context, videoID uuid.UUID) error {
+	video, err := w.service.GetVideo(ctx, videoID)
+	if errors.Is(err, homevideo.ErrVideoNotFound) {
+		return w.searchService.RemoveVideo(ctx, videoID)
+	}
+	if err != nil {
+		return err
+	}
+
+	doc, err := search.LoadHomeVideo(ctx, w.service, video)
+	if err != nil {
+		return err
+	}
+	return w.searchService.IndexVideo(ctx, doc)
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibraryRoot(t *testing.T) {
+	paths := []string{"/videos", "/videos/phone/", "/camcorder"}
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"/videos/2024/Beach Day.mp4", "/videos"},
+		{"/videos/phone/DCIM/VID_20240630_140509.mp4", "/videos/phone"},
+		{"/camcorder/00001.MTS", "/camcorder"},
+		{"/camcorders/00002.MTS", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			assert.Equal(t, tt.want, libraryRoot(paths, tt.file))
+		})
+	}
+}
+
+func TestLibraryScanArgs_Kind(t *testing.T) {
+	assert.Equal(t, "homevideo_library_scan", LibraryScanArgs{}.Kind())
+	assert.Equal(t, "homevideo_search_index", SearchIndexArgs{}.Kind())
+}
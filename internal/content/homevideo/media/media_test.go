@@ -0,0 +1,68 @@
+package media
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTagTime(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Time
+		wantOK bool
+	}{
+		{"2024-06-30T14:05:09.000000Z", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC), true},
+		{"2024-06-30T14:05:09+0200", time.Date(2024, 6, 30, 12, 5, 9, 0, time.UTC), true},
+		{"2024-06-30T14:05:09+02:00", time.Date(2024, 6, 30, 12, 5, 9, 0, time.UTC), true},
+		{"2024-06-30 14:05:09", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC), true},
+		{"2024-06-30", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), true},
+		{"1904-01-01T00:00:00.000000Z", time.Time{}, false}, // QuickTime epoch
+		{"1970-01-01T00:00:00.000000Z", time.Time{}, false},
+		{"yesterday", time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := ParseTagTime(tt.value)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.True(t, tt.want.Equal(got), "got %v", got)
+		})
+	}
+}
+
+func TestScaledSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		maxWidth      int
+		wantW, wantH  int
+	}{
+		{"full HD", 1920, 1080, 640, 640, 360},
+		{"portrait", 1080, 1920, 640, 640, 1136},
+		{"smaller than bound", 320, 240, 640, 320, 240},
+		{"odd sizes", 721, 481, 0, 720, 480},
+		{"no bound", 1920, 1080, 0, 1920, 1080},
+		{"invalid", 0, 1080, 640, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := ScaledSize(tt.width, tt.height, tt.maxWidth)
+			assert.Equal(t, tt.wantW, w)
+			assert.Equal(t, tt.wantH, h)
+		})
+	}
+}
+
+func TestThumbnailPosition(t *testing.T) {
+	assert.Equal(t, 0.0, ThumbnailPosition(0))
+	assert.Equal(t, 3.0, ThumbnailPosition(30))
+	assert.Equal(t, 60.0, ThumbnailPosition(7200))
+}
+
+func TestProbe_MissingFile(t *testing.T) {
+	_, err := Probe(filepath.Join(t.TempDir(), "missing.mp4"))
+	assert.Error(t, err)
+}
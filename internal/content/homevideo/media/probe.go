@@ -0,0 +1,153 @@
+// Package media reads home video files with FFmpeg: the container tags
+// and streams that title and describe a video, and single frames that
+// serve as its thumbnail.
+package media
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/asticode/go-astiav"
+)
+
+// ErrNoVideoStream is returned for files without a video stream.
+var ErrNoVideoStream = errors.New("no video stream")
+
+// Info is what a home video's container tells about it.
+type Info struct {
+	// Container is the file extension, e.g. "mp4".
+	Container string
+
+	// Title and Description come from the container tags; empty when the
+	// file has none.
+	Title       string
+	Description string
+
+	// CreationTime is the recording time written by the camera or phone.
+	CreationTime *time.Time
+
+	DurationSeconds float64
+	Width           int
+	Height          int
+	VideoCodec      string
+	AudioCodec      string
+}
+
+// Tags read for the description, in order of preference.
+var descriptionTags = []string{"description", "comment", "synopsis"}
+
+// Tags read for the recording time, in order of preference. Apple devices
+// write the local time with its offset to the QuickTime creation date.
+var creationTimeTags = []string{"com.apple.quicktime.creationdate", "creation_time", "date"}
+
+// Probe reads the tags and streams of a video file.
+func Probe(filePath string) (*Info, error) {
+	formatCtx := astiav.AllocFormatContext()
+	if formatCtx == nil {
+		return nil, fmt.Errorf("failed to allocate format context")
+	}
+	defer formatCtx.Free()
+
+	if err := formatCtx.OpenInput(filePath, nil, nil); err != nil {
+		return nil, fmt.Errorf("failed to open input: %w", err)
+	}
+	defer formatCtx.CloseInput()
+
+	if err := formatCtx.FindStreamInfo(nil); err != nil {
+		return nil, fmt.Errorf("failed to find stream info: %w", err)
+	}
+
+	info := &Info{
+		Container:       strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), "."),
+		DurationSeconds: float64(formatCtx.Duration()) / float64(astiav.TimeBase),
+	}
+	if info.DurationSeconds < 0 {
+		info.DurationSeconds = 0
+	}
+
+	tags := formatCtx.Metadata()
+	info.Title = tag(tags, "title")
+	for _, key := range descriptionTags {
+		if info.Description = tag(tags, key); info.Description != "" {
+			break
+		}
+	}
+	for _, key := range creationTimeTags {
+		if t, ok := ParseTagTime(tag(tags, key)); ok {
+			info.CreationTime = &t
+			break
+		}
+	}
+
+	for _, stream := range formatCtx.Streams() {
+		params := stream.CodecParameters()
+		switch params.MediaType() {
+		case astiav.MediaTypeVideo:
+			if info.VideoCodec != "" {
+				continue
+			}
+			info.VideoCodec = params.CodecID().Name()
+			info.Width = params.Width()
+			info.Height = params.Height()
+			// Some cameras only tag the streams
+			if info.CreationTime == nil {
+				if t, ok := ParseTagTime(tag(stream.Metadata(), "creation_time")); ok {
+					info.CreationTime = &t
+				}
+			}
+		case astiav.MediaTypeAudio:
+			if info.AudioCodec == "" {
+				info.AudioCodec = params.CodecID().Name()
+			}
+		}
+	}
+
+	if info.VideoCodec == "" {
+		return nil, ErrNoVideoStream
+	}
+	return info, nil
+}
+
+// tag returns the trimmed value of a tag, matched case-insensitively.
+func tag(d *astiav.Dictionary, key string) string {
+	if d == nil {
+		return ""
+	}
+	entry := d.Get(key, nil, astiav.NewDictionaryFlags())
+	if entry == nil {
+		return ""
+	}
+	return strings.TrimSpace(entry.Value())
+}
+
+// tagTimeLayouts are the time formats found in creation time tags.
+var tagTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05.000000Z",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// ParseTagTime parses a creation time tag. Cameras with an unset clock
+// write the container epoch, so times before 1971 are rejected.
+func ParseTagTime(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range tagTimeLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		if t.Year() < 1971 {
+			return time.Time{}, false
+		}
+		return t.UTC(), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,184 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/jpeg"
+
+	"github.com/asticode/go-astiav"
+)
+
+// ThumbnailWidth bounds the width of grabbed frames.
+const ThumbnailWidth = 640
+
+// thumbnailQuality is the JPEG quality of grabbed frames.
+const thumbnailQuality = 85
+
+// maxThumbnailPackets bounds how far past the seek point a frame is
+// searched for, so broken files cannot stall a scan.
+const maxThumbnailPackets = 2000
+
+// ThumbnailPosition returns where to grab the thumbnail of a video: a
+// tenth into it, past black intro frames, but no later than a minute.
+func ThumbnailPosition(durationSeconds float64) float64 {
+	return min(max(durationSeconds*0.1, 0), 60)
+}
+
+// Thumbnail decodes the first frame at or after the keyframe before at
+// seconds, scales it down to maxWidth and encodes it as a JPEG.
+func Thumbnail(filePath string, at float64, maxWidth int) ([]byte, error) {
+	formatCtx := astiav.AllocFormatContext()
+	if formatCtx == nil {
+		return nil, fmt.Errorf("failed to allocate format context")
+	}
+	defer formatCtx.Free()
+
+	if err := formatCtx.OpenInput(filePath, nil, nil); err != nil {
+		return nil, fmt.Errorf("failed to open input: %w", err)
+	}
+	defer formatCtx.CloseInput()
+
+	if err := formatCtx.FindStreamInfo(nil); err != nil {
+		return nil, fmt.Errorf("failed to find stream info: %w", err)
+	}
+
+	stream, codec, err := formatCtx.FindBestStream(astiav.MediaTypeVideo, -1, -1)
+	if err != nil || stream == nil || codec == nil {
+		return nil, ErrNoVideoStream
+	}
+
+	decoder := astiav.AllocCodecContext(codec)
+	if decoder == nil {
+		return nil, fmt.Errorf("failed to allocate decoder")
+	}
+	defer decoder.Free()
+	if err := stream.CodecParameters().ToCodecContext(decoder); err != nil {
+		return nil, fmt.Errorf("failed to configure decoder: %w", err)
+	}
+	if err := decoder.Open(codec, nil); err != nil {
+		return nil, fmt.Errorf("failed to open decoder: %w", err)
+	}
+
+	if at > 0 {
+		ts := astiav.RescaleQ(int64(at*float64(astiav.TimeBase)), astiav.NewRational(1, astiav.TimeBase), stream.TimeBase())
+		// Files that cannot seek are read from the start
+		_ = formatCtx.SeekFrame(stream.Index(), ts, astiav.NewSeekFlags(astiav.SeekFlagBackward))
+	}
+
+	frame, err := decodeFrame(formatCtx, decoder, stream.Index())
+	if err != nil {
+		return nil, err
+	}
+	defer frame.Free()
+
+	return encodeFrame(frame, maxWidth)
+}
+
+// decodeFrame returns the next decoded frame of the stream.
+func decodeFrame(formatCtx *astiav.FormatContext, decoder *astiav.CodecContext, streamIndex int) (*astiav.Frame, error) {
+	pkt := astiav.AllocPacket()
+	defer pkt.Free()
+	frame := astiav.AllocFrame()
+
+	for range maxThumbnailPackets {
+		err := formatCtx.ReadFrame(pkt)
+		switch {
+		case errors.Is(err, astiav.ErrEof):
+			// Drain the frames the decoder still holds
+			if err := decoder.SendPacket(nil); err != nil && !errors.Is(err, astiav.ErrEof) {
+				frame.Free()
+				return nil, fmt.Errorf("failed to flush decoder: %w", err)
+			}
+			if err := decoder.ReceiveFrame(frame); err != nil {
+				frame.Free()
+				return nil, fmt.Errorf("no frame decoded: %w", err)
+			}
+			return frame, nil
+		case err != nil:
+			frame.Free()
+			return nil, fmt.Errorf("failed to read packet: %w", err)
+		}
+
+		if pkt.StreamIndex() != streamIndex {
+			pkt.Unref()
+			continue
+		}
+		err = decoder.SendPacket(pkt)
+		pkt.Unref()
+		if err != nil && !errors.Is(err, astiav.ErrEagain) {
+			frame.Free()
+			return nil, fmt.Errorf("failed to decode packet: %w", err)
+		}
+
+		err = decoder.ReceiveFrame(frame)
+		if errors.Is(err, astiav.ErrEagain) {
+			continue
+		}
+		if err != nil {
+			frame.Free()
+			return nil, fmt.Errorf("failed to decode frame: %w", err)
+		}
+		return frame, nil
+	}
+
+	frame.Free()
+	return nil, fmt.Errorf("no frame decoded in %d packets", maxThumbnailPackets)
+}
+
+// encodeFrame scales a decoded frame to RGBA and encodes it as a JPEG.
+func encodeFrame(src *astiav.Frame, maxWidth int) ([]byte, error) {
+	width, height := ScaledSize(src.Width(), src.Height(), maxWidth)
+	if width == 0 || height == 0 {
+		return nil, fmt.Errorf("invalid frame size %dx%d", src.Width(), src.Height())
+	}
+
+	scaler, err := astiav.CreateSoftwareScaleContext(
+		src.Width(), src.Height(), src.PixelFormat(),
+		width, height, astiav.PixelFormatRgba,
+		astiav.NewSoftwareScaleContextFlags(astiav.SoftwareScaleContextFlagBilinear),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scaler: %w", err)
+	}
+	defer scaler.Free()
+
+	dst := astiav.AllocFrame()
+	defer dst.Free()
+	dst.SetWidth(width)
+	dst.SetHeight(height)
+	dst.SetPixelFormat(astiav.PixelFormatRgba)
+	if err := dst.AllocBuffer(1); err != nil {
+		return nil, fmt.Errorf("failed to allocate frame: %w", err)
+	}
+	if err := scaler.ScaleFrame(src, dst); err != nil {
+		return nil, fmt.Errorf("failed to scale frame: %w", err)
+	}
+
+	img, err := dst.Data().GuessImageFormat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image: %w", err)
+	}
+	if err := dst.Data().ToImage(img); err != nil {
+		return nil, fmt.Errorf("failed to copy frame: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ScaledSize fits width and height into maxWidth, keeping the aspect
+// ratio. Sizes are even, as most pixel formats require.
+func ScaledSize(width, height, maxWidth int) (int, int) {
+	if width <= 0 || height <= 0 {
+		return 0, 0
+	}
+	if maxWidth > 0 && width > maxWidth {
+		height = height * maxWidth / width
+		width = maxWidth
+	}
+	return max(width&^1, 2), max(height&^1, 2)
+}
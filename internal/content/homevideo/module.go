@@ -0,0 +1,11 @@
+package homevideo
+
+import "go.uber.org/fx"
+
+// Module provides the home video content module.
+var Module = fx.Module("homevideo",
+	fx.Provide(
+		NewPostgresRepository,
+		NewService,
+	),
+)
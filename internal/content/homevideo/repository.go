@@ -0,0 +1,66 @@
+package homevideo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for home videos
+type Repository interface {
+	// Videos
+	GetVideo(ctx context.Context, id uuid.UUID) (*Video, error)
+	GetVideoByPath(ctx context.Context, filePath string) (*Video, error)
+	UpsertVideo(ctx context.Context, params UpsertVideoParams) (*Video, error)
+	SetVideoThumbnail(ctx context.Context, id uuid.UUID, key string) error
+	ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, error)
+	CountVideos(ctx context.Context, filters VideoListFilters) (int64, error)
+	ListVideoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error)
+	DeleteVideo(ctx context.Context, id uuid.UUID) error
+
+	// Folders
+	GetFolder(ctx context.Context, id uuid.UUID) (*Folder, error)
+	UpsertFolder(ctx context.Context, libraryID uuid.UUID, parentID *uuid.UUID, path, title string) (*Folder, error)
+	ListFolders(ctx context.Context, filters FolderListFilters) ([]Folder, error)
+	CountFolders(ctx context.Context, filters FolderListFilters) (int64, error)
+	ListFolderAncestors(ctx context.Context, id uuid.UUID) ([]FolderRef, error)
+	DeleteEmptyFolders(ctx context.Context) (int64, error)
+
+	// Watch progress
+	UpsertWatchProgress(ctx context.Context, params UpsertWatchProgressParams) (*WatchProgress, error)
+	GetWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (*WatchProgress, error)
+	DeleteWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (bool, error)
+	ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueWatchingItem, error)
+}
+
+// UpsertVideoParams contains parameters for creating or updating a video
+// from its file
+type UpsertVideoParams struct {
+	LibraryID        uuid.UUID
+	FolderID         uuid.UUID
+	FilePath         string
+	FileSize         int64
+	Container        *string
+	Title            string
+	TitleSource      string
+	SortTitle        string
+	Description      *string
+	RecordedAt       time.Time
+	RecordedAtSource string
+	DurationSeconds  float64
+	Width            int32
+	Height           int32
+	VideoCodec       *string
+	AudioCodec       *string
+}
+
+// UpsertWatchProgressParams contains parameters for recording a user's
+// playback position
+type UpsertWatchProgressParams struct {
+	UserID          uuid.UUID
+	VideoID         uuid.UUID
+	ProgressSeconds int32
+	DurationSeconds int32
+	IsCompleted     bool
+}
@@ -0,0 +1,347 @@
+package homevideo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	homevideodb "github.com/lusoris/revenge/internal/content/homevideo/db"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *homevideodb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: homevideodb.New(pool),
+	}
+}
+
+// =============================================================================
+// Videos
+// =============================================================================
+
+func (r *postgresRepository) GetVideo(ctx context.Context, id uuid.UUID) (*Video, error) {
+	v, err := r.queries.GetVideo(ctx, id)
+	if err != nil {
+		return nil, videoError(err)
+	}
+	return dbVideoToVideo(v), nil
+}
+
+func (r *postgresRepository) GetVideoByPath(ctx context.Context, filePath string) (*Video, error) {
+	v, err := r.queries.GetVideoByPath(ctx, filePath)
+	if err != nil {
+		return nil, videoError(err)
+	}
+	return dbVideoToVideo(v), nil
+}
+
+func (r *postgresRepository) UpsertVideo(ctx context.Context, params UpsertVideoParams) (*Video, error) {
+	v, err := r.queries.UpsertVideo(ctx, homevideodb.UpsertVideoParams{
+		LibraryID:        params.LibraryID,
+		FolderID:         params.FolderID,
+		FilePath:         params.FilePath,
+		FileSize:         params.FileSize,
+		Container:        params.Container,
+		Title:            params.Title,
+		TitleSource:      params.TitleSource,
+		SortTitle:        params.SortTitle,
+		Description:      params.Description,
+		RecordedAt:       params.RecordedAt,
+		RecordedAtSource: params.RecordedAtSource,
+		DurationSeconds:  params.DurationSeconds,
+		Width:            params.Width,
+		Height:           params.Height,
+		VideoCodec:       params.VideoCodec,
+		AudioCodec:       params.AudioCodec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert home video: %w", err)
+	}
+	return dbVideoToVideo(v), nil
+}
+
+func (r *postgresRepository) SetVideoThumbnail(ctx context.Context, id uuid.UUID, key string) error {
+	return r.queries.SetVideoThumbnail(ctx, homevideodb.SetVideoThumbnailParams{
+		ID:           id,
+		ThumbnailKey: &key,
+	})
+}
+
+func (r *postgresRepository) ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, error) {
+	rows, err := r.queries.ListVideos(ctx, homevideodb.ListVideosParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		FolderID:   uuidToPg(filters.FolderID),
+		Sort:       string(filters.Sort),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list home videos: %w", err)
+	}
+	videos := make([]Video, len(rows))
+	for i, v := range rows {
+		videos[i] = *dbVideoToVideo(v)
+	}
+	return videos, nil
+}
+
+func (r *postgresRepository) CountVideos(ctx context.Context, filters VideoListFilters) (int64, error) {
+	return r.queries.CountVideos(ctx, homevideodb.CountVideosParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		FolderID:   uuidToPg(filters.FolderID),
+	})
+}
+
+func (r *postgresRepository) ListVideoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ListVideoPaths(ctx, libraryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list home video paths: %w", err)
+	}
+	paths := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		paths[row.FilePath] = row.ID
+	}
+	return paths, nil
+}
+
+func (r *postgresRepository) DeleteVideo(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteVideo(ctx, id)
+}
+
+// =============================================================================
+// Folders
+// =============================================================================
+
+func (r *postgresRepository) GetFolder(ctx context.Context, id uuid.UUID) (*Folder, error) {
+	row, err := r.queries.GetFolder(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrFolderNotFound
+		}
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+	return dbFolderToFolder(row.HomevideoFolder, row.FolderCount, row.VideoCount, row.CoverVideoID), nil
+}
+
+func (r *postgresRepository) UpsertFolder(ctx context.Context, libraryID uuid.UUID, parentID *uuid.UUID, path, title string) (*Folder, error) {
+	f, err := r.queries.UpsertFolder(ctx, homevideodb.UpsertFolderParams{
+		LibraryID: libraryID,
+		ParentID:  uuidToPg(parentID),
+		Path:      path,
+		Title:     title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert folder: %w", err)
+	}
+	return dbFolderToFolder(f, 0, 0, uuid.Nil), nil
+}
+
+func (r *postgresRepository) ListFolders(ctx context.Context, filters FolderListFilters) ([]Folder, error) {
+	rows, err := r.queries.ListFolders(ctx, homevideodb.ListFoldersParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		ParentID:   uuidToPg(filters.ParentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folders: %w", err)
+	}
+	folders := make([]Folder, len(rows))
+	for i, row := range rows {
+		folders[i] = *dbFolderToFolder(row.HomevideoFolder, row.FolderCount, row.VideoCount, row.CoverVideoID)
+	}
+	return folders, nil
+}
+
+func (r *postgresRepository) CountFolders(ctx context.Context, filters FolderListFilters) (int64, error) {
+	return r.queries.CountFolders(ctx, homevideodb.CountFoldersParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		ParentID:   uuidToPg(filters.ParentID),
+	})
+}
+
+func (r *postgresRepository) ListFolderAncestors(ctx context.Context, id uuid.UUID) ([]FolderRef, error) {
+	rows, err := r.queries.ListFolderAncestors(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folder ancestors: %w", err)
+	}
+	refs := make([]FolderRef, len(rows))
+	for i, row := range rows {
+		refs[i] = FolderRef{ID: row.ID, Title: row.Title}
+	}
+	return refs, nil
+}
+
+func (r *postgresRepository) DeleteEmptyFolders(ctx context.Context) (int64, error) {
+	return r.queries.DeleteEmptyFolders(ctx)
+}
+
+// =============================================================================
+// Watch Progress
+// =============================================================================
+
+func (r *postgresRepository) UpsertWatchProgress(ctx context.Context, params UpsertWatchProgressParams) (*WatchProgress, error) {
+	p, err := r.queries.UpsertWatchProgress(ctx, homevideodb.UpsertWatchProgressParams{
+		UserID:          params.UserID,
+		VideoID:         params.VideoID,
+		ProgressSeconds: params.ProgressSeconds,
+		DurationSeconds: params.DurationSeconds,
+		IsCompleted:     params.IsCompleted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert watch progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) GetWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (*WatchProgress, error) {
+	p, err := r.queries.GetWatchProgress(ctx, homevideodb.GetWatchProgressParams{
+		UserID:  userID,
+		VideoID: videoID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProgressNotFound
+		}
+		return nil, fmt.Errorf("failed to get watch progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) DeleteWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (bool, error) {
+	n, err := r.queries.DeleteWatchProgress(ctx, homevideodb.DeleteWatchProgressParams{
+		UserID:  userID,
+		VideoID: videoID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete watch progress: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *postgresRepository) ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+	rows, err := r.queries.ListContinueWatching(ctx, homevideodb.ListContinueWatchingParams{
+		Limit:      limit,
+		UserID:     userID,
+		Restrict:   libraryIDs != nil,
+		LibraryIds: libraryIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list continue watching: %w", err)
+	}
+	items := make([]ContinueWatchingItem, len(rows))
+	for i, row := range rows {
+		items[i] = ContinueWatchingItem{
+			Video:           *dbVideoToVideo(row.HomevideoVideo),
+			ProgressSeconds: row.ProgressSeconds,
+			DurationSeconds: row.ProgressDurationSeconds,
+			LastWatchedAt:   row.LastWatchedAt,
+		}
+	}
+	return items, nil
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func videoError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrVideoNotFound
+	}
+	return fmt.Errorf("failed to get home video: %w", err)
+}
+
+func dbVideoToVideo(v homevideodb.HomevideoVideo) *Video {
+	return &Video{
+		ID:               v.ID,
+		LibraryID:        v.LibraryID,
+		FolderID:         v.FolderID,
+		FilePath:         v.FilePath,
+		FileSize:         v.FileSize,
+		Container:        v.Container,
+		Title:            v.Title,
+		TitleSource:      v.TitleSource,
+		SortTitle:        v.SortTitle,
+		Description:      v.Description,
+		RecordedAt:       v.RecordedAt,
+		RecordedAtSource: v.RecordedAtSource,
+		DurationSeconds:  v.DurationSeconds,
+		Width:            v.Width,
+		Height:           v.Height,
+		VideoCodec:       v.VideoCodec,
+		AudioCodec:       v.AudioCodec,
+		ThumbnailKey:     v.ThumbnailKey,
+		CreatedAt:        v.CreatedAt,
+		UpdatedAt:        v.UpdatedAt,
+	}
+}
+
+// dbFolderToFolder converts a folder row; coverVideoID is the zero UUID
+// when no video below the folder has a thumbnail.
+func dbFolderToFolder(f homevideodb.HomevideoFolder, folderCount, videoCount int64, coverVideoID uuid.UUID) *Folder {
+	folder := &Folder{
+		ID:          f.ID,
+		LibraryID:   f.LibraryID,
+		ParentID:    pgToUUID(f.ParentID),
+		Path:        f.Path,
+		Title:       f.Title,
+		FolderCount: folderCount,
+		VideoCount:  videoCount,
+		CreatedAt:   f.CreatedAt,
+		UpdatedAt:   f.UpdatedAt,
+	}
+	if coverVideoID != uuid.Nil {
+		folder.CoverVideoID = &coverVideoID
+	}
+	return folder
+}
+
+func dbProgressToProgress(p homevideodb.HomevideoWatchProgress) *WatchProgress {
+	return &WatchProgress{
+		UserID:          p.UserID,
+		VideoID:         p.VideoID,
+		ProgressSeconds: p.ProgressSeconds,
+		DurationSeconds: p.DurationSeconds,
+		IsCompleted:     p.IsCompleted,
+		WatchCount:      p.WatchCount,
+		LastWatchedAt:   p.LastWatchedAt,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil || *id == uuid.Nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
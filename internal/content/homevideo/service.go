@@ -0,0 +1,343 @@
+package homevideo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/homevideo/media"
+	"github.com/lusoris/revenge/internal/service/storage"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Service defines business logic for home videos. Library permissions are
+// checked by the caller.
+type Service interface {
+	// Video operations
+	GetVideo(ctx context.Context, id uuid.UUID) (*Video, error)
+	ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, int64, error)
+	GetThumbnail(ctx context.Context, videoID uuid.UUID) (io.ReadCloser, error)
+
+	// Folder operations
+	GetFolder(ctx context.Context, id uuid.UUID) (*Folder, error)
+	ListFolders(ctx context.Context, filters FolderListFilters) ([]Folder, int64, error)
+	GetFolderPath(ctx context.Context, id uuid.UUID) ([]FolderRef, error)
+
+	// Watch progress
+	UpdateWatchProgress(ctx context.Context, userID, videoID uuid.UUID, progressSeconds, durationSeconds int32) (*WatchProgress, error)
+	GetWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (*WatchProgress, error)
+	DeleteWatchProgress(ctx context.Context, userID, videoID uuid.UUID) error
+	ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueWatchingItem, error)
+
+	// Library import
+	GetVideoByPath(ctx context.Context, filePath string) (*Video, error)
+	ImportVideo(ctx context.Context, scanned *ScannedVideo, root string) (*Video, error)
+	GenerateThumbnail(ctx context.Context, video *Video) error
+	RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error)
+	Prune(ctx context.Context) error
+}
+
+// homeVideoService implements the Service interface
+type homeVideoService struct {
+	repo Repository
+
+	// store keeps the grabbed thumbnails; nil when storage is unavailable
+	store storage.Storage
+
+	// thumbnail grabs a frame of a video file as a JPEG
+	thumbnail func(filePath string, at float64, maxWidth int) ([]byte, error)
+}
+
+// NewService creates a new home video service. Thumbnails are kept in the
+// file storage, which may be nil.
+func NewService(repo Repository, store storage.Storage) Service {
+	return &homeVideoService{
+		repo:      repo,
+		store:     store,
+		thumbnail: media.Thumbnail,
+	}
+}
+
+// ThumbnailKey is the storage key of a video's thumbnail.
+func ThumbnailKey(videoID uuid.UUID) string {
+	return fmt.Sprintf("homevideo/thumbnails/%s.jpg", videoID)
+}
+
+// =============================================================================
+// Video Operations
+// =============================================================================
+
+func (s *homeVideoService) GetVideo(ctx context.Context, id uuid.UUID) (*Video, error) {
+	return s.repo.GetVideo(ctx, id)
+}
+
+func (s *homeVideoService) GetVideoByPath(ctx context.Context, filePath string) (*Video, error) {
+	return s.repo.GetVideoByPath(ctx, filePath)
+}
+
+func (s *homeVideoService) ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, int64, error) {
+	if !filters.Sort.Valid() {
+		filters.Sort = VideoSortRecorded
+	}
+	videos, err := s.repo.ListVideos(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountVideos(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return videos, count, nil
+}
+
+// GetThumbnail opens the stored thumbnail of a video.
+func (s *homeVideoService) GetThumbnail(ctx context.Context, videoID uuid.UUID) (io.ReadCloser, error) {
+	video, err := s.repo.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if s.store == nil {
+		return nil, ErrThumbnailsDisabled
+	}
+	if !video.HasThumbnail() {
+		return nil, ErrNoThumbnail
+	}
+	rc, err := s.store.Get(ctx, *video.ThumbnailKey)
+	if err != nil {
+		return nil, fmt.Errorf("open thumbnail of %s: %w", video.FilePath, err)
+	}
+	return rc, nil
+}
+
+// =============================================================================
+// Folder Operations
+// =============================================================================
+
+func (s *homeVideoService) GetFolder(ctx context.Context, id uuid.UUID) (*Folder, error) {
+	return s.repo.GetFolder(ctx, id)
+}
+
+func (s *homeVideoService) ListFolders(ctx context.Context, filters FolderListFilters) ([]Folder, int64, error) {
+	folders, err := s.repo.ListFolders(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountFolders(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return folders, count, nil
+}
+
+// GetFolderPath returns the breadcrumb trail of a folder, from its library
+// root down to the folder itself.
+func (s *homeVideoService) GetFolderPath(ctx context.Context, id uuid.UUID) ([]FolderRef, error) {
+	refs, err := s.repo.ListFolderAncestors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(refs) == 0 {
+		return nil, ErrFolderNotFound
+	}
+	return refs, nil
+}
+
+// =============================================================================
+// Watch Progress
+// =============================================================================
+
+// UpdateWatchProgress records a user's playback position. Like movies, a
+// video counts as watched past 90%.
+func (s *homeVideoService) UpdateWatchProgress(ctx context.Context, userID, videoID uuid.UUID, progressSeconds, durationSeconds int32) (*WatchProgress, error) {
+	if progressSeconds < 0 || durationSeconds < 0 {
+		return nil, ErrInvalidProgress
+	}
+	if _, err := s.repo.GetVideo(ctx, videoID); err != nil {
+		return nil, err
+	}
+
+	isCompleted := false
+	if durationSeconds > 0 {
+		isCompleted = float64(progressSeconds)/float64(durationSeconds) > CompletedThreshold
+	}
+
+	return s.repo.UpsertWatchProgress(ctx, UpsertWatchProgressParams{
+		UserID:          userID,
+		VideoID:         videoID,
+		ProgressSeconds: progressSeconds,
+		DurationSeconds: durationSeconds,
+		IsCompleted:     isCompleted,
+	})
+}
+
+func (s *homeVideoService) GetWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (*WatchProgress, error) {
+	return s.repo.GetWatchProgress(ctx, userID, videoID)
+}
+
+func (s *homeVideoService) DeleteWatchProgress(ctx context.Context, userID, videoID uuid.UUID) error {
+	deleted, err := s.repo.DeleteWatchProgress(ctx, userID, videoID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrProgressNotFound
+	}
+	return nil
+}
+
+func (s *homeVideoService) ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	return s.repo.ListContinueWatching(ctx, userID, libraryIDs, limit)
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+// ImportVideo stores a scanned video in the folder of its directory,
+// creating the folders between the library root and the directory.
+func (s *homeVideoService) ImportVideo(ctx context.Context, scanned *ScannedVideo, root string) (*Video, error) {
+	folder, err := s.ensureFolder(ctx, scanned.LibraryID, root, filepath.Dir(scanned.FilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(scanned.Title)
+	if title == "" {
+		title = filepath.Base(scanned.FilePath)
+	}
+
+	return s.repo.UpsertVideo(ctx, UpsertVideoParams{
+		LibraryID:        scanned.LibraryID,
+		FolderID:         folder.ID,
+		FilePath:         scanned.FilePath,
+		FileSize:         scanned.FileSize,
+		Container:        optionalString(scanned.Container),
+		Title:            title,
+		TitleSource:      scanned.TitleSource,
+		SortTitle:        strings.ToLower(title),
+		Description:      optionalString(scanned.Description),
+		RecordedAt:       scanned.RecordedAt,
+		RecordedAtSource: scanned.RecordedAtSource,
+		DurationSeconds:  scanned.DurationSeconds,
+		Width:            util.SafeIntToInt32(scanned.Width),
+		Height:           util.SafeIntToInt32(scanned.Height),
+		VideoCodec:       optionalString(scanned.VideoCodec),
+		AudioCodec:       optionalString(scanned.AudioCodec),
+	})
+}
+
+// ensureFolder upserts the folder of dir and its parents up to the library
+// root. Directories outside the root become root folders of their own.
+func (s *homeVideoService) ensureFolder(ctx context.Context, libraryID uuid.UUID, root, dir string) (*Folder, error) {
+	rel, err := filepath.Rel(root, dir)
+	if root == "" || err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		root, rel = dir, "."
+	}
+
+	folder, err := s.repo.UpsertFolder(ctx, libraryID, nil, root, folderTitle(root))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert folder %s: %w", root, err)
+	}
+	if rel == "." {
+		return folder, nil
+	}
+
+	path := root
+	for _, name := range strings.Split(rel, string(filepath.Separator)) {
+		path = filepath.Join(path, name)
+		parentID := folder.ID
+		folder, err = s.repo.UpsertFolder(ctx, libraryID, &parentID, path, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upsert folder %s: %w", path, err)
+		}
+	}
+	return folder, nil
+}
+
+// GenerateThumbnail grabs a frame of the video into the file storage.
+// Without storage no thumbnails are kept.
+func (s *homeVideoService) GenerateThumbnail(ctx context.Context, video *Video) error {
+	if s.store == nil {
+		return nil
+	}
+	data, err := s.thumbnail(video.FilePath, media.ThumbnailPosition(video.DurationSeconds), media.ThumbnailWidth)
+	if err != nil {
+		return fmt.Errorf("grab thumbnail of %s: %w", video.FilePath, err)
+	}
+	key, err := s.store.Store(ctx, ThumbnailKey(video.ID), bytes.NewReader(data), "image/jpeg")
+	if err != nil {
+		return fmt.Errorf("store thumbnail of %s: %w", video.FilePath, err)
+	}
+	if err := s.repo.SetVideoThumbnail(ctx, video.ID, key); err != nil {
+		return err
+	}
+	video.ThumbnailKey = &key
+	return nil
+}
+
+// RemoveMissing deletes the videos of a library under the given roots whose
+// file was not found by the last scan, along with their thumbnails. It
+// returns the number of videos removed.
+func (s *homeVideoService) RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error) {
+	paths, err := s.repo.ListVideoPaths(ctx, libraryID)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for path, id := range paths {
+		if present[path] || !underAny(path, roots) {
+			continue
+		}
+		if err := s.repo.DeleteVideo(ctx, id); err != nil {
+			return removed, fmt.Errorf("failed to delete video %s: %w", path, err)
+		}
+		if s.store != nil {
+			_ = s.store.Delete(ctx, ThumbnailKey(id))
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes folders left without videos.
+func (s *homeVideoService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteEmptyFolders(ctx); err != nil {
+		return fmt.Errorf("failed to delete empty folders: %w", err)
+	}
+	return nil
+}
+
+// folderTitle names a root folder after its directory.
+func folderTitle(path string) string {
+	name := filepath.Base(path)
+	if name == "." || name == string(filepath.Separator) {
+		return path
+	}
+	return name
+}
+
+func underAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s string) *string {
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,391 @@
+package homevideo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/service/storage"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Videos
+func (m *MockRepository) GetVideo(ctx context.Context, id uuid.UUID) (*Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) GetVideoByPath(ctx context.Context, filePath string) (*Video, error) {
+	args := m.Called(ctx, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) UpsertVideo(ctx context.Context, params UpsertVideoParams) (*Video, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) SetVideoThumbnail(ctx context.Context, id uuid.UUID, key string) error {
+	return m.Called(ctx, id, key).Error(0)
+}
+
+func (m *MockRepository) ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Video), args.Error(1)
+}
+
+func (m *MockRepository) CountVideos(ctx context.Context, filters VideoListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListVideoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	args := m.Called(ctx, libraryID)
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) DeleteVideo(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// Folders
+func (m *MockRepository) GetFolder(ctx context.Context, id uuid.UUID) (*Folder, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Folder), args.Error(1)
+}
+
+func (m *MockRepository) UpsertFolder(ctx context.Context, libraryID uuid.UUID, parentID *uuid.UUID, path, title string) (*Folder, error) {
+	args := m.Called(ctx, libraryID, parentID, path, title)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Folder), args.Error(1)
+}
+
+func (m *MockRepository) ListFolders(ctx context.Context, filters FolderListFilters) ([]Folder, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Folder), args.Error(1)
+}
+
+func (m *MockRepository) CountFolders(ctx context.Context, filters FolderListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListFolderAncestors(ctx context.Context, id uuid.UUID) ([]FolderRef, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]FolderRef), args.Error(1)
+}
+
+func (m *MockRepository) DeleteEmptyFolders(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Watch progress
+func (m *MockRepository) UpsertWatchProgress(ctx context.Context, params UpsertWatchProgressParams) (*WatchProgress, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*WatchProgress), args.Error(1)
+}
+
+func (m *MockRepository) GetWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (*WatchProgress, error) {
+	args := m.Called(ctx, userID, videoID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*WatchProgress), args.Error(1)
+}
+
+func (m *MockRepository) DeleteWatchProgress(ctx context.Context, userID, videoID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, videoID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+	args := m.Called(ctx, userID, libraryIDs, limit)
+	return args.Get(0).([]ContinueWatchingItem), args.Error(1)
+}
+
+// newTestService returns a service whose thumbnails are the file path and
+// position, so tests can check what was grabbed.
+func newTestService(repo *MockRepository, store storage.Storage) *homeVideoService {
+	return &homeVideoService{
+		repo:  repo,
+		store: store,
+		thumbnail: func(filePath string, at float64, maxWidth int) ([]byte, error) {
+			return []byte(filePath), nil
+		},
+	}
+}
+
+func folderAt(libraryID uuid.UUID, path string) *Folder {
+	return &Folder{ID: uuid.Must(uuid.NewV7()), LibraryID: libraryID, Path: path, Title: filepath.Base(path)}
+}
+
+func TestService_ListVideos_DefaultSort(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+
+	want := VideoListFilters{Sort: VideoSortRecorded, Limit: 10}
+	repo.On("ListVideos", ctx, want).Return([]Video{{Title: "Beach Day"}}, nil)
+	repo.On("CountVideos", ctx, want).Return(int64(1), nil)
+
+	videos, total, err := svc.ListVideos(ctx, VideoListFilters{Sort: "bogus", Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, videos, 1)
+	assert.Equal(t, int64(1), total)
+	repo.AssertExpectations(t)
+}
+
+func TestService_UpdateWatchProgress(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+	videoID := uuid.Must(uuid.NewV7())
+
+	tests := []struct {
+		name          string
+		progress      int32
+		duration      int32
+		wantCompleted bool
+	}{
+		{"started", 30, 600, false},
+		{"at threshold", 540, 600, false},
+		{"past threshold", 541, 600, true},
+		{"unknown duration", 30, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			svc := newTestService(repo, nil)
+			repo.On("GetVideo", ctx, videoID).Return(&Video{ID: videoID}, nil)
+			repo.On("UpsertWatchProgress", ctx, UpsertWatchProgressParams{
+				UserID:          userID,
+				VideoID:         videoID,
+				ProgressSeconds: tt.progress,
+				DurationSeconds: tt.duration,
+				IsCompleted:     tt.wantCompleted,
+			}).Return(&WatchProgress{IsCompleted: tt.wantCompleted}, nil)
+
+			wp, err := svc.UpdateWatchProgress(ctx, userID, videoID, tt.progress, tt.duration)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCompleted, wp.IsCompleted)
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), nil)
+		_, err := svc.UpdateWatchProgress(ctx, userID, videoID, -1, 600)
+		assert.ErrorIs(t, err, ErrInvalidProgress)
+	})
+
+	t.Run("unknown video", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, nil)
+		repo.On("GetVideo", ctx, videoID).Return(nil, ErrVideoNotFound)
+		_, err := svc.UpdateWatchProgress(ctx, userID, videoID, 30, 600)
+		assert.ErrorIs(t, err, ErrVideoNotFound)
+	})
+}
+
+func TestService_DeleteWatchProgress(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+	videoID := uuid.Must(uuid.NewV7())
+
+	repo.On("DeleteWatchProgress", ctx, userID, videoID).Return(false, nil).Once()
+	assert.ErrorIs(t, svc.DeleteWatchProgress(ctx, userID, videoID), ErrProgressNotFound)
+
+	repo.On("DeleteWatchProgress", ctx, userID, videoID).Return(true, nil).Once()
+	assert.NoError(t, svc.DeleteWatchProgress(ctx, userID, videoID))
+}
+
+func TestService_GetFolderPath(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	id := uuid.Must(uuid.NewV7())
+	missing := uuid.Must(uuid.NewV7())
+
+	trail := []FolderRef{{ID: uuid.Must(uuid.NewV7()), Title: "Family"}, {ID: id, Title: "2024"}}
+	repo.On("ListFolderAncestors", ctx, id).Return(trail, nil)
+	repo.On("ListFolderAncestors", ctx, missing).Return([]FolderRef{}, nil)
+
+	refs, err := svc.GetFolderPath(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, trail, refs)
+
+	_, err = svc.GetFolderPath(ctx, missing)
+	assert.ErrorIs(t, err, ErrFolderNotFound)
+}
+
+func TestService_ImportVideo(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	libraryID := uuid.Must(uuid.NewV7())
+
+	root := filepath.Join("/media", "family")
+	rootFolder := folderAt(libraryID, root)
+	yearFolder := folderAt(libraryID, filepath.Join(root, "2024"))
+	summerFolder := folderAt(libraryID, filepath.Join(root, "2024", "Summer"))
+
+	repo.On("UpsertFolder", ctx, libraryID, (*uuid.UUID)(nil), root, "family").Return(rootFolder, nil)
+	repo.On("UpsertFolder", ctx, libraryID, &rootFolder.ID, yearFolder.Path, "2024").Return(yearFolder, nil)
+	repo.On("UpsertFolder", ctx, libraryID, &yearFolder.ID, summerFolder.Path, "Summer").Return(summerFolder, nil)
+
+	scanned := &ScannedVideo{
+		LibraryID:        libraryID,
+		FilePath:         filepath.Join(summerFolder.Path, "2024-06-30 Beach Day.mp4"),
+		FileSize:         1024,
+		Container:        "mp4",
+		Title:            "Beach Day",
+		TitleSource:      TitleSourceFilename,
+		RecordedAtSource: RecordedAtSourceFilename,
+		DurationSeconds:  95,
+		Width:            1920,
+		Height:           1080,
+		VideoCodec:       "h264",
+	}
+	repo.On("UpsertVideo", ctx, mock.MatchedBy(func(p UpsertVideoParams) bool {
+		return p.FolderID == summerFolder.ID &&
+			p.Title == "Beach Day" &&
+			p.SortTitle == "beach day" &&
+			*p.Container == "mp4" &&
+			p.Description == nil &&
+			p.AudioCodec == nil &&
+			p.Width == 1920
+	})).Return(&Video{ID: uuid.Must(uuid.NewV7())}, nil)
+
+	_, err := svc.ImportVideo(ctx, scanned, root)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ImportVideo_OutsideRoot(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	libraryID := uuid.Must(uuid.NewV7())
+
+	dir := filepath.Join("/other", "clips")
+	folder := folderAt(libraryID, dir)
+	repo.On("UpsertFolder", ctx, libraryID, (*uuid.UUID)(nil), dir, "clips").Return(folder, nil)
+	repo.On("UpsertVideo", ctx, mock.MatchedBy(func(p UpsertVideoParams) bool {
+		return p.FolderID == folder.ID && p.Title == "clip.mp4"
+	})).Return(&Video{}, nil)
+
+	_, err := svc.ImportVideo(ctx, &ScannedVideo{LibraryID: libraryID, FilePath: filepath.Join(dir, "clip.mp4")}, filepath.Join("/media", "family"))
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_Thumbnails(t *testing.T) {
+	ctx := context.Background()
+	video := &Video{ID: uuid.Must(uuid.NewV7()), FilePath: "/media/family/beach.mp4", DurationSeconds: 300}
+
+	t.Run("generate and read", func(t *testing.T) {
+		repo := new(MockRepository)
+		store := storage.NewMockStorage()
+		svc := newTestService(repo, store)
+		key := ThumbnailKey(video.ID)
+		repo.On("SetVideoThumbnail", ctx, video.ID, key).Return(nil)
+
+		v := *video
+		require.NoError(t, svc.GenerateThumbnail(ctx, &v))
+		require.True(t, v.HasThumbnail())
+
+		repo.On("GetVideo", ctx, video.ID).Return(&v, nil)
+		rc, err := svc.GetThumbnail(ctx, video.ID)
+		require.NoError(t, err)
+		defer func() { _ = rc.Close() }()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, video.FilePath, string(data))
+	})
+
+	t.Run("not generated", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, storage.NewMockStorage())
+		repo.On("GetVideo", ctx, video.ID).Return(video, nil)
+		_, err := svc.GetThumbnail(ctx, video.ID)
+		assert.ErrorIs(t, err, ErrNoThumbnail)
+	})
+
+	t.Run("without storage", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, nil)
+		repo.On("GetVideo", ctx, video.ID).Return(video, nil)
+		assert.NoError(t, svc.GenerateThumbnail(ctx, video))
+		_, err := svc.GetThumbnail(ctx, video.ID)
+		assert.ErrorIs(t, err, ErrThumbnailsDisabled)
+		repo.AssertNotCalled(t, "SetVideoThumbnail", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("grab fails", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, storage.NewMockStorage())
+		svc.thumbnail = func(string, float64, int) ([]byte, error) {
+			return nil, errors.New("no frame decoded")
+		}
+		assert.Error(t, svc.GenerateThumbnail(ctx, video))
+	})
+}
+
+func TestService_RemoveMissing(t *testing.T) {
+	repo := new(MockRepository)
+	store := storage.NewMockStorage()
+	svc := newTestService(repo, store)
+	ctx := context.Background()
+	libraryID := uuid.Must(uuid.NewV7())
+
+	kept := uuid.Must(uuid.NewV7())
+	gone := uuid.Must(uuid.NewV7())
+	elsewhere := uuid.Must(uuid.NewV7())
+	_, err := store.Store(ctx, ThumbnailKey(gone), nil, "image/jpeg")
+	require.NoError(t, err)
+
+	repo.On("ListVideoPaths", ctx, libraryID).Return(map[string]uuid.UUID{
+		"/media/family/a.mp4": kept,
+		"/media/family/b.mp4": gone,
+		"/media/other/c.mp4":  elsewhere,
+	}, nil)
+	repo.On("DeleteVideo", ctx, gone).Return(nil)
+
+	removed, err := svc.RemoveMissing(ctx, libraryID, []string{"/media/family"}, map[string]bool{"/media/family/a.mp4": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	exists, err := store.Exists(ctx, ThumbnailKey(gone))
+	require.NoError(t, err)
+	assert.False(t, exists)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,171 @@
+// Package homevideo provides types and services for home video libraries.
+// Home videos are personal recordings no metadata provider knows: the
+// library scan skips matching, mirrors the directory tree as folders,
+// titles each video from its container tags or file name and grabs a
+// frame of the video as its thumbnail.
+package homevideo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sources of the title.
+const (
+	TitleSourceTag      = "tag"      // container title tag
+	TitleSourceFilename = "filename" // cleaned file name
+)
+
+// Sources of the recording time.
+const (
+	RecordedAtSourceTag      = "tag"      // container creation time
+	RecordedAtSourceFilename = "filename" // date in the file name, e.g. 2024-06-30 Beach
+	RecordedAtSourceFile     = "file"     // file modification time
+)
+
+// VideoSort is the order of video lists.
+type VideoSort string
+
+const (
+	// VideoSortRecorded lists the latest recordings first.
+	VideoSortRecorded VideoSort = "recorded"
+	// VideoSortTitle lists videos by title.
+	VideoSortTitle VideoSort = "title"
+	// VideoSortAdded lists the videos added last first.
+	VideoSortAdded VideoSort = "added"
+)
+
+// Valid reports whether s is a known sort order.
+func (s VideoSort) Valid() bool {
+	return s == VideoSortRecorded || s == VideoSortTitle || s == VideoSortAdded
+}
+
+// CompletedThreshold is the share of a video that must be watched for it
+// to count as watched.
+const CompletedThreshold = 0.90
+
+// Video is a video in a home video library.
+type Video struct {
+	ID        uuid.UUID
+	LibraryID uuid.UUID
+	FolderID  uuid.UUID
+	FilePath  string
+	FileSize  int64
+	Container *string
+
+	Title       string
+	TitleSource string
+	SortTitle   string
+	Description *string
+
+	RecordedAt       time.Time
+	RecordedAtSource string
+
+	DurationSeconds float64
+	Width           int32
+	Height          int32
+	VideoCodec      *string
+	AudioCodec      *string
+
+	// ThumbnailKey is the storage key of the grabbed frame; nil until it
+	// was generated.
+	ThumbnailKey *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// HasThumbnail reports whether a thumbnail was generated for the video.
+func (v *Video) HasThumbnail() bool {
+	return v.ThumbnailKey != nil
+}
+
+// Folder is a directory of a home video library. Library paths are root
+// folders without a parent.
+type Folder struct {
+	ID        uuid.UUID
+	LibraryID uuid.UUID
+	ParentID  *uuid.UUID
+	Path      string
+	Title     string
+
+	// FolderCount is the number of direct subfolders; VideoCount includes
+	// the videos of all subfolders.
+	FolderCount int64
+	VideoCount  int64
+
+	// CoverVideoID is the latest recorded video with a thumbnail below the
+	// folder; nil when there is none.
+	CoverVideoID *uuid.UUID
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FolderRef names a folder in a breadcrumb trail.
+type FolderRef struct {
+	ID    uuid.UUID
+	Title string
+}
+
+// WatchProgress is a user's playback state of a video.
+type WatchProgress struct {
+	UserID          uuid.UUID
+	VideoID         uuid.UUID
+	ProgressSeconds int32
+	DurationSeconds int32
+	IsCompleted     bool
+	WatchCount      int32
+	LastWatchedAt   time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ContinueWatchingItem is a started video with the user's position.
+type ContinueWatchingItem struct {
+	Video           Video
+	ProgressSeconds int32
+	DurationSeconds int32
+	LastWatchedAt   time.Time
+}
+
+// VideoListFilters contains filters for listing videos.
+type VideoListFilters struct {
+	// LibraryIDs restricts the videos to these libraries when non-nil,
+	// the libraries the user may access.
+	LibraryIDs []uuid.UUID
+	LibraryID  *uuid.UUID
+	FolderID   *uuid.UUID // direct videos of the folder
+	Sort       VideoSort
+	Limit      int32
+	Offset     int32
+}
+
+// FolderListFilters contains filters for listing folders.
+type FolderListFilters struct {
+	LibraryIDs []uuid.UUID // nil means unrestricted
+	LibraryID  *uuid.UUID
+	ParentID   *uuid.UUID // nil lists the library roots
+	Limit      int32
+	Offset     int32
+}
+
+// ScannedVideo is a video file with what the library scanner read from
+// its container and name.
+type ScannedVideo struct {
+	LibraryID        uuid.UUID
+	FilePath         string
+	FileSize         int64
+	Container        string
+	Title            string
+	TitleSource      string
+	Description      string
+	RecordedAt       time.Time
+	RecordedAtSource string
+	DurationSeconds  float64
+	Width            int
+	Height           int
+	VideoCodec       string
+	AudioCodec       string
+}
@@ -0,0 +1,141 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// LanguageOriginal stands for the item's original language in a language
+// chain. For titles it also matches the stored original title.
+const LanguageOriginal = "original"
+
+// MaxLanguageChainLength is the maximum number of entries in a language chain.
+const MaxLanguageChainLength = 10
+
+// ErrInvalidLanguage is returned for a language chain entry that is neither a
+// language tag nor LanguageOriginal.
+var ErrInvalidLanguage = errors.New("invalid language")
+
+// languageTagPattern matches language tags of the form language[-Script][-REGION],
+// e.g. "de", "de-AT", "zh-Hant-TW" or "es-419".
+var languageTagPattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z][a-z]{3})?(-([A-Z]{2}|[0-9]{3}))?$`)
+
+// LanguageChain is an ordered list of languages to try when resolving a
+// localized field, e.g. de-AT → de → original → en.
+type LanguageChain []string
+
+// DefaultLanguageChain returns the chain used for a single preferred
+// language: the language itself, then English, then the original language.
+func DefaultLanguageChain(lang string) LanguageChain {
+	chain := make(LanguageChain, 0, 3)
+	if lang != "" && lang != "en" {
+		chain = append(chain, lang)
+	}
+	return append(chain, "en", LanguageOriginal)
+}
+
+// NormalizeLanguageTag canonicalizes the casing of a language tag
+// ("DE-at" → "de-AT") and validates it.
+func NormalizeLanguageTag(tag string) (string, error) {
+	tag = strings.TrimSpace(strings.ReplaceAll(tag, "_", "-"))
+	if strings.EqualFold(tag, LanguageOriginal) {
+		return LanguageOriginal, nil
+	}
+
+	parts := strings.Split(tag, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 4 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + strings.ToLower(parts[i][1:])
+		} else {
+			parts[i] = strings.ToUpper(parts[i])
+		}
+	}
+	normalized := strings.Join(parts, "-")
+
+	if !languageTagPattern.MatchString(normalized) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidLanguage, tag)
+	}
+	return normalized, nil
+}
+
+// NormalizeLanguageChain normalizes every entry of a chain and drops
+// duplicates, keeping the first occurrence.
+func NormalizeLanguageChain(chain []string) (LanguageChain, error) {
+	if len(chain) > MaxLanguageChainLength {
+		return nil, fmt.Errorf("language chain too long: at most %d entries allowed", MaxLanguageChainLength)
+	}
+
+	out := make(LanguageChain, 0, len(chain))
+	for _, tag := range chain {
+		normalized, err := NormalizeLanguageTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(out, normalized) {
+			out = append(out, normalized)
+		}
+	}
+	return out, nil
+}
+
+// Languages returns the concrete languages of the chain, without
+// LanguageOriginal. These are the languages metadata has to be fetched in.
+func (c LanguageChain) Languages() []string {
+	out := make([]string, 0, len(c))
+	for _, lang := range c {
+		if lang != LanguageOriginal {
+			out = append(out, lang)
+		}
+	}
+	return out
+}
+
+// Primary returns the first concrete language of the chain, or "en".
+func (c LanguageChain) Primary() string {
+	if langs := c.Languages(); len(langs) > 0 {
+		return langs[0]
+	}
+	return "en"
+}
+
+// Resolve returns the first non-empty value of a per-language map following
+// the chain. LanguageOriginal looks up originalLanguage.
+func (c LanguageChain) Resolve(values map[string]string, originalLanguage string) (string, bool) {
+	if len(values) == 0 {
+		return "", false
+	}
+	for _, lang := range c {
+		if lang == LanguageOriginal {
+			lang = originalLanguage
+		}
+		if v := values[lang]; lang != "" && v != "" {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// ResolveTitle follows the chain like Resolve, additionally returning
+// originalTitle when the chain reaches LanguageOriginal without a translated
+// title in that language.
+func (c LanguageChain) ResolveTitle(values map[string]string, originalLanguage, originalTitle string) (string, bool) {
+	for _, lang := range c {
+		if lang == LanguageOriginal {
+			if v := values[originalLanguage]; originalLanguage != "" && v != "" {
+				return v, true
+			}
+			if originalTitle != "" {
+				return originalTitle, true
+			}
+			continue
+		}
+		if v := values[lang]; v != "" {
+			return v, true
+		}
+	}
+	return "", false
+}
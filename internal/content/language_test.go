@@ -0,0 +1,70 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultLanguageChain(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, LanguageChain{"de", "en", LanguageOriginal}, DefaultLanguageChain("de"))
+	assert.Equal(t, LanguageChain{"en", LanguageOriginal}, DefaultLanguageChain("en"))
+	assert.Equal(t, LanguageChain{"en", LanguageOriginal}, DefaultLanguageChain(""))
+}
+
+func TestNormalizeLanguageChain(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NormalizeLanguageChain([]string{"de_at", "DE", "Original", "en", "de-AT", "zh-hant-tw", "es-419"})
+	require.NoError(t, err)
+	assert.Equal(t, LanguageChain{"de-AT", "de", LanguageOriginal, "en", "zh-Hant-TW", "es-419"}, chain)
+
+	_, err = NormalizeLanguageChain([]string{"de", "german"})
+	assert.ErrorIs(t, err, ErrInvalidLanguage)
+
+	_, err = NormalizeLanguageChain(make([]string, MaxLanguageChainLength+1))
+	assert.Error(t, err)
+}
+
+func TestLanguageChain_Resolve(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{"de": "Die Reise", "en": "The Journey", "ja": "旅"}
+	chain := LanguageChain{"de-AT", "de", LanguageOriginal, "en"}
+
+	v, ok := chain.Resolve(values, "ja")
+	assert.True(t, ok)
+	assert.Equal(t, "Die Reise", v)
+
+	v, ok = LanguageChain{"de-AT", LanguageOriginal, "en"}.Resolve(values, "ja")
+	assert.True(t, ok)
+	assert.Equal(t, "旅", v)
+
+	_, ok = LanguageChain{"fr", LanguageOriginal}.Resolve(values, "")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"de-AT", "de", "en"}, chain.Languages())
+	assert.Equal(t, "de-AT", chain.Primary())
+	assert.Equal(t, "en", LanguageChain{LanguageOriginal}.Primary())
+}
+
+func TestLanguageChain_ResolveTitle(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{"en": "Spirited Away"}
+	chain := LanguageChain{"de", LanguageOriginal, "en"}
+
+	v, ok := chain.ResolveTitle(values, "ja", "千と千尋の神隠し")
+	assert.True(t, ok)
+	assert.Equal(t, "千と千尋の神隠し", v)
+
+	v, ok = LanguageChain{"de", "en"}.ResolveTitle(values, "ja", "千と千尋の神隠し")
+	assert.True(t, ok)
+	assert.Equal(t, "Spirited Away", v)
+
+	_, ok = LanguageChain{"de"}.ResolveTitle(values, "ja", "")
+	assert.False(t, ok)
+}
@@ -0,0 +1,302 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: programs.sql
+
+package livetvdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteEndedPrograms = `-- name: DeleteEndedPrograms :execrows
+DELETE FROM livetv.programs WHERE ends_at < $1
+`
+
+func (q *Queries) DeleteEndedPrograms(ctx context.Context, endsAt time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEndedPrograms, endsAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteStalePrograms = `-- name: DeleteStalePrograms :execrows
+DELETE FROM livetv.programs
+WHERE
+    source_id = $1
+    AND refreshed_at < $2
+`
+
+type DeleteStaleProgramsParams struct {
+	SourceID    uuid.UUID `json:"sourceId"`
+	RefreshedAt time.Time `json:"refreshedAt"`
+}
+
+// Removes the programs of a source an earlier refresh saw last.
+func (q *Queries) DeleteStalePrograms(ctx context.Context, arg DeleteStaleProgramsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteStalePrograms, arg.SourceID, arg.RefreshedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getProgram = `-- name: GetProgram :one
+SELECT id, source_id, guide_channel_id, starts_at, ends_at, title, subtitle, description, categories, season_number, episode_number, episode_label, icon_url, air_date, is_new, refreshed_at, created_at, updated_at FROM livetv.programs WHERE id = $1
+`
+
+func (q *Queries) GetProgram(ctx context.Context, id uuid.UUID) (LivetvProgram, error) {
+	row := q.db.QueryRow(ctx, getProgram, id)
+	var i LivetvProgram
+	err := row.Scan(
+		&i.ID,
+		&i.SourceID,
+		&i.GuideChannelID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.Categories,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.EpisodeLabel,
+		&i.IconUrl,
+		&i.AirDate,
+		&i.IsNew,
+		&i.RefreshedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listGuide = `-- name: ListGuide :many
+SELECT id, source_id, guide_channel_id, starts_at, ends_at, title, subtitle, description, categories, season_number, episode_number, episode_label, icon_url, air_date, is_new, refreshed_at, created_at, updated_at
+FROM livetv.programs
+WHERE
+    guide_channel_id = ANY ($1::text[])
+    AND ends_at > $2
+    AND starts_at < $3
+ORDER BY guide_channel_id ASC, starts_at ASC
+`
+
+type ListGuideParams struct {
+	GuideIds    []string  `json:"guideIds"`
+	StartsAfter time.Time `json:"startsAfter"`
+	EndsBefore  time.Time `json:"endsBefore"`
+}
+
+// Programs of the given guide channels overlapping a time window.
+func (q *Queries) ListGuide(ctx context.Context, arg ListGuideParams) ([]LivetvProgram, error) {
+	rows, err := q.db.Query(ctx, listGuide, arg.GuideIds, arg.StartsAfter, arg.EndsBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvProgram{}
+	for rows.Next() {
+		var i LivetvProgram
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceID,
+			&i.GuideChannelID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Title,
+			&i.Subtitle,
+			&i.Description,
+			&i.Categories,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.EpisodeLabel,
+			&i.IconUrl,
+			&i.AirDate,
+			&i.IsNew,
+			&i.RefreshedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUpcomingAirings = `-- name: ListUpcomingAirings :many
+SELECT
+    p.id, p.source_id, p.guide_channel_id, p.starts_at, p.ends_at, p.title, p.subtitle, p.description, p.categories, p.season_number, p.episode_number, p.episode_label, p.icon_url, p.air_date, p.is_new, p.refreshed_at, p.created_at, p.updated_at,
+    c.id AS channel_id,
+    c.name AS channel_name
+FROM livetv.programs p
+    JOIN livetv.channels c ON c.guide_id = p.guide_channel_id
+    AND c.enabled
+WHERE
+    lower(p.title) = lower($1)
+    AND p.starts_at >= $2
+    AND (
+        $3::uuid IS NULL
+        OR c.id = $3::uuid
+    )
+ORDER BY p.starts_at ASC, c.position ASC
+`
+
+type ListUpcomingAiringsParams struct {
+	Title       string      `json:"title"`
+	StartsAfter time.Time   `json:"startsAfter"`
+	ChannelID   pgtype.UUID `json:"channelId"`
+}
+
+type ListUpcomingAiringsRow struct {
+	LivetvProgram LivetvProgram `json:"livetvProgram"`
+	ChannelID     uuid.UUID     `json:"channelId"`
+	ChannelName   string        `json:"channelName"`
+}
+
+// Future airings of a title on enabled channels, optionally of one
+// channel. A program carried by several channels is listed per channel.
+func (q *Queries) ListUpcomingAirings(ctx context.Context, arg ListUpcomingAiringsParams) ([]ListUpcomingAiringsRow, error) {
+	rows, err := q.db.Query(ctx, listUpcomingAirings, arg.Title, arg.StartsAfter, arg.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUpcomingAiringsRow{}
+	for rows.Next() {
+		var i ListUpcomingAiringsRow
+		if err := rows.Scan(
+			&i.LivetvProgram.ID,
+			&i.LivetvProgram.SourceID,
+			&i.LivetvProgram.GuideChannelID,
+			&i.LivetvProgram.StartsAt,
+			&i.LivetvProgram.EndsAt,
+			&i.LivetvProgram.Title,
+			&i.LivetvProgram.Subtitle,
+			&i.LivetvProgram.Description,
+			&i.LivetvProgram.Categories,
+			&i.LivetvProgram.SeasonNumber,
+			&i.LivetvProgram.EpisodeNumber,
+			&i.LivetvProgram.EpisodeLabel,
+			&i.LivetvProgram.IconUrl,
+			&i.LivetvProgram.AirDate,
+			&i.LivetvProgram.IsNew,
+			&i.LivetvProgram.RefreshedAt,
+			&i.LivetvProgram.CreatedAt,
+			&i.LivetvProgram.UpdatedAt,
+			&i.ChannelID,
+			&i.ChannelName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertProgram = `-- name: UpsertProgram :exec
+INSERT INTO
+    livetv.programs (
+        source_id,
+        guide_channel_id,
+        starts_at,
+        ends_at,
+        title,
+        subtitle,
+        description,
+        categories,
+        season_number,
+        episode_number,
+        episode_label,
+        icon_url,
+        air_date,
+        is_new,
+        refreshed_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    )
+ON CONFLICT (
+    source_id,
+    guide_channel_id,
+    starts_at
+) DO
+UPDATE
+SET
+    ends_at = EXCLUDED.ends_at,
+    title = EXCLUDED.title,
+    subtitle = EXCLUDED.subtitle,
+    description = EXCLUDED.description,
+    categories = EXCLUDED.categories,
+    season_number = EXCLUDED.season_number,
+    episode_number = EXCLUDED.episode_number,
+    episode_label = EXCLUDED.episode_label,
+    icon_url = EXCLUDED.icon_url,
+    air_date = EXCLUDED.air_date,
+    is_new = EXCLUDED.is_new,
+    refreshed_at = EXCLUDED.refreshed_at
+`
+
+type UpsertProgramParams struct {
+	SourceID       uuid.UUID   `json:"sourceId"`
+	GuideChannelID string      `json:"guideChannelId"`
+	StartsAt       time.Time   `json:"startsAt"`
+	EndsAt         time.Time   `json:"endsAt"`
+	Title          string      `json:"title"`
+	Subtitle       *string     `json:"subtitle"`
+	Description    *string     `json:"description"`
+	Categories     []string    `json:"categories"`
+	SeasonNumber   *int32      `json:"seasonNumber"`
+	EpisodeNumber  *int32      `json:"episodeNumber"`
+	EpisodeLabel   *string     `json:"episodeLabel"`
+	IconUrl        *string     `json:"iconUrl"`
+	AirDate        pgtype.Date `json:"airDate"`
+	IsNew          bool        `json:"isNew"`
+	RefreshedAt    time.Time   `json:"refreshedAt"`
+}
+
+func (q *Queries) UpsertProgram(ctx context.Context, arg UpsertProgramParams) error {
+	_, err := q.db.Exec(ctx, upsertProgram,
+		arg.SourceID,
+		arg.GuideChannelID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Title,
+		arg.Subtitle,
+		arg.Description,
+		arg.Categories,
+		arg.SeasonNumber,
+		arg.EpisodeNumber,
+		arg.EpisodeLabel,
+		arg.IconUrl,
+		arg.AirDate,
+		arg.IsNew,
+		arg.RefreshedAt,
+	)
+	return err
+}
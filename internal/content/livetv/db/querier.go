@@ -0,0 +1,67 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package livetvdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Querier interface {
+	// Links channels without a tvg-id to the guide channel of the same name.
+	AssignChannelGuideID(ctx context.Context, arg AssignChannelGuideIDParams) (int64, error)
+	CancelRecording(ctx context.Context, id uuid.UUID) (LivetvRecording, error)
+	// Cancels the recordings a series rule scheduled that have not started.
+	CancelRuleRecordings(ctx context.Context, seriesRuleID pgtype.UUID) (int64, error)
+	CountRecordings(ctx context.Context, arg CountRecordingsParams) (int64, error)
+	CreateRecording(ctx context.Context, arg CreateRecordingParams) (LivetvRecording, error)
+	CreateSeriesRule(ctx context.Context, arg CreateSeriesRuleParams) (LivetvSeriesRule, error)
+	CreateSource(ctx context.Context, arg CreateSourceParams) (LivetvSource, error)
+	// Removes the channels a playlist no longer lists.
+	DeleteChannelsNotIn(ctx context.Context, arg DeleteChannelsNotInParams) (int64, error)
+	DeleteEndedPrograms(ctx context.Context, endsAt time.Time) (int64, error)
+	DeleteRecording(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteSeriesRule(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteSource(ctx context.Context, id uuid.UUID) (int64, error)
+	// Removes the programs of a source an earlier refresh saw last.
+	DeleteStalePrograms(ctx context.Context, arg DeleteStaleProgramsParams) (int64, error)
+	FinishRecording(ctx context.Context, arg FinishRecordingParams) (LivetvRecording, error)
+	GetChannel(ctx context.Context, id uuid.UUID) (LivetvChannel, error)
+	GetProgram(ctx context.Context, id uuid.UUID) (LivetvProgram, error)
+	GetRecording(ctx context.Context, id uuid.UUID) (LivetvRecording, error)
+	GetRecordingByProgram(ctx context.Context, programID pgtype.UUID) (LivetvRecording, error)
+	GetSeriesRule(ctx context.Context, id uuid.UUID) (LivetvSeriesRule, error)
+	GetSource(ctx context.Context, id uuid.UUID) (LivetvSource, error)
+	// Lists channels in playlist order, optionally including disabled ones.
+	ListChannels(ctx context.Context, includeDisabled bool) ([]LivetvChannel, error)
+	ListEnabledSeriesRules(ctx context.Context) ([]LivetvSeriesRule, error)
+	ListEnabledSources(ctx context.Context) ([]LivetvSource, error)
+	// Programs of the given guide channels overlapping a time window.
+	ListGuide(ctx context.Context, arg ListGuideParams) ([]LivetvProgram, error)
+	// Lists a user's recordings, newest first, optionally of one status.
+	ListRecordings(ctx context.Context, arg ListRecordingsParams) ([]LivetvRecording, error)
+	// Recordings waiting for their start, including those that should have
+	// started already.
+	ListScheduledRecordings(ctx context.Context, endsAt time.Time) ([]LivetvRecording, error)
+	ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]LivetvSeriesRule, error)
+	ListSources(ctx context.Context) ([]LivetvSource, error)
+	// Future airings of a title on enabled channels, optionally of one
+	// channel. A program carried by several channels is listed per channel.
+	ListUpcomingAirings(ctx context.Context, arg ListUpcomingAiringsParams) ([]ListUpcomingAiringsRow, error)
+	// Records a refresh, with last_error set when it failed.
+	MarkSourceRefreshed(ctx context.Context, arg MarkSourceRefreshedParams) error
+	SetChannelEnabled(ctx context.Context, arg SetChannelEnabledParams) (LivetvChannel, error)
+	// Marks a scheduled recording as running. Returns no row for recordings
+	// that were cancelled or finished meanwhile.
+	StartRecording(ctx context.Context, id uuid.UUID) (LivetvRecording, error)
+	UpdateSource(ctx context.Context, arg UpdateSourceParams) (LivetvSource, error)
+	UpsertChannel(ctx context.Context, arg UpsertChannelParams) (LivetvChannel, error)
+	UpsertProgram(ctx context.Context, arg UpsertProgramParams) error
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,664 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: recordings.sql
+
+package livetvdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const cancelRecording = `-- name: CancelRecording :one
+UPDATE livetv.recordings
+SET
+    status = 'cancelled'
+WHERE
+    id = $1
+    AND status = 'scheduled' RETURNING id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+`
+
+func (q *Queries) CancelRecording(ctx context.Context, id uuid.UUID) (LivetvRecording, error) {
+	row := q.db.QueryRow(ctx, cancelRecording, id)
+	var i LivetvRecording
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.ChannelID,
+		&i.ProgramID,
+		&i.SeriesRuleID,
+		&i.ChannelName,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Status,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const cancelRuleRecordings = `-- name: CancelRuleRecordings :execrows
+UPDATE livetv.recordings
+SET
+    status = 'cancelled'
+WHERE
+    series_rule_id = $1
+    AND status = 'scheduled'
+`
+
+// Cancels the recordings a series rule scheduled that have not started.
+func (q *Queries) CancelRuleRecordings(ctx context.Context, seriesRuleID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelRuleRecordings, seriesRuleID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countRecordings = `-- name: CountRecordings :one
+SELECT COUNT(*)
+FROM livetv.recordings
+WHERE
+    user_id = $1
+    AND (
+        $2::text IS NULL
+        OR status = $2::text
+    )
+`
+
+type CountRecordingsParams struct {
+	UserID uuid.UUID `json:"userId"`
+	Status *string   `json:"status"`
+}
+
+func (q *Queries) CountRecordings(ctx context.Context, arg CountRecordingsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecordings, arg.UserID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createRecording = `-- name: CreateRecording :one
+INSERT INTO
+    livetv.recordings (
+        user_id,
+        library_id,
+        channel_id,
+        program_id,
+        series_rule_id,
+        channel_name,
+        title,
+        subtitle,
+        description,
+        season_number,
+        episode_number,
+        starts_at,
+        ends_at,
+        padding_before_seconds,
+        padding_after_seconds
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    ) RETURNING id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+`
+
+type CreateRecordingParams struct {
+	UserID               uuid.UUID   `json:"userId"`
+	LibraryID            uuid.UUID   `json:"libraryId"`
+	ChannelID            pgtype.UUID `json:"channelId"`
+	ProgramID            pgtype.UUID `json:"programId"`
+	SeriesRuleID         pgtype.UUID `json:"seriesRuleId"`
+	ChannelName          string      `json:"channelName"`
+	Title                string      `json:"title"`
+	Subtitle             *string     `json:"subtitle"`
+	Description          *string     `json:"description"`
+	SeasonNumber         *int32      `json:"seasonNumber"`
+	EpisodeNumber        *int32      `json:"episodeNumber"`
+	StartsAt             time.Time   `json:"startsAt"`
+	EndsAt               time.Time   `json:"endsAt"`
+	PaddingBeforeSeconds int32       `json:"paddingBeforeSeconds"`
+	PaddingAfterSeconds  int32       `json:"paddingAfterSeconds"`
+}
+
+func (q *Queries) CreateRecording(ctx context.Context, arg CreateRecordingParams) (LivetvRecording, error) {
+	row := q.db.QueryRow(ctx, createRecording,
+		arg.UserID,
+		arg.LibraryID,
+		arg.ChannelID,
+		arg.ProgramID,
+		arg.SeriesRuleID,
+		arg.ChannelName,
+		arg.Title,
+		arg.Subtitle,
+		arg.Description,
+		arg.SeasonNumber,
+		arg.EpisodeNumber,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.PaddingBeforeSeconds,
+		arg.PaddingAfterSeconds,
+	)
+	var i LivetvRecording
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.ChannelID,
+		&i.ProgramID,
+		&i.SeriesRuleID,
+		&i.ChannelName,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Status,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createSeriesRule = `-- name: CreateSeriesRule :one
+INSERT INTO
+    livetv.series_rules (
+        user_id,
+        library_id,
+        title,
+        channel_id,
+        new_only,
+        padding_before_seconds,
+        padding_after_seconds
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, user_id, library_id, title, channel_id, new_only, padding_before_seconds, padding_after_seconds, enabled, created_at, updated_at
+`
+
+type CreateSeriesRuleParams struct {
+	UserID               uuid.UUID   `json:"userId"`
+	LibraryID            uuid.UUID   `json:"libraryId"`
+	Title                string      `json:"title"`
+	ChannelID            pgtype.UUID `json:"channelId"`
+	NewOnly              bool        `json:"newOnly"`
+	PaddingBeforeSeconds int32       `json:"paddingBeforeSeconds"`
+	PaddingAfterSeconds  int32       `json:"paddingAfterSeconds"`
+}
+
+func (q *Queries) CreateSeriesRule(ctx context.Context, arg CreateSeriesRuleParams) (LivetvSeriesRule, error) {
+	row := q.db.QueryRow(ctx, createSeriesRule,
+		arg.UserID,
+		arg.LibraryID,
+		arg.Title,
+		arg.ChannelID,
+		arg.NewOnly,
+		arg.PaddingBeforeSeconds,
+		arg.PaddingAfterSeconds,
+	)
+	var i LivetvSeriesRule
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.Title,
+		&i.ChannelID,
+		&i.NewOnly,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteRecording = `-- name: DeleteRecording :execrows
+DELETE FROM livetv.recordings WHERE id = $1
+`
+
+func (q *Queries) DeleteRecording(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRecording, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSeriesRule = `-- name: DeleteSeriesRule :execrows
+DELETE FROM livetv.series_rules WHERE id = $1
+`
+
+func (q *Queries) DeleteSeriesRule(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSeriesRule, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const finishRecording = `-- name: FinishRecording :one
+UPDATE livetv.recordings
+SET
+    status = $2,
+    file_path = $3,
+    file_size = $4,
+    error = $5
+WHERE
+    id = $1 RETURNING id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+`
+
+type FinishRecordingParams struct {
+	ID       uuid.UUID `json:"id"`
+	Status   string    `json:"status"`
+	FilePath *string   `json:"filePath"`
+	FileSize int64     `json:"fileSize"`
+	Error    *string   `json:"error"`
+}
+
+func (q *Queries) FinishRecording(ctx context.Context, arg FinishRecordingParams) (LivetvRecording, error) {
+	row := q.db.QueryRow(ctx, finishRecording,
+		arg.ID,
+		arg.Status,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Error,
+	)
+	var i LivetvRecording
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.ChannelID,
+		&i.ProgramID,
+		&i.SeriesRuleID,
+		&i.ChannelName,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Status,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRecording = `-- name: GetRecording :one
+SELECT id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at FROM livetv.recordings WHERE id = $1
+`
+
+func (q *Queries) GetRecording(ctx context.Context, id uuid.UUID) (LivetvRecording, error) {
+	row := q.db.QueryRow(ctx, getRecording, id)
+	var i LivetvRecording
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.ChannelID,
+		&i.ProgramID,
+		&i.SeriesRuleID,
+		&i.ChannelName,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Status,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRecordingByProgram = `-- name: GetRecordingByProgram :one
+SELECT id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+FROM livetv.recordings
+WHERE
+    program_id = $1
+    AND status <> 'cancelled'
+`
+
+func (q *Queries) GetRecordingByProgram(ctx context.Context, programID pgtype.UUID) (LivetvRecording, error) {
+	row := q.db.QueryRow(ctx, getRecordingByProgram, programID)
+	var i LivetvRecording
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.ChannelID,
+		&i.ProgramID,
+		&i.SeriesRuleID,
+		&i.ChannelName,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Status,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSeriesRule = `-- name: GetSeriesRule :one
+SELECT id, user_id, library_id, title, channel_id, new_only, padding_before_seconds, padding_after_seconds, enabled, created_at, updated_at FROM livetv.series_rules WHERE id = $1
+`
+
+func (q *Queries) GetSeriesRule(ctx context.Context, id uuid.UUID) (LivetvSeriesRule, error) {
+	row := q.db.QueryRow(ctx, getSeriesRule, id)
+	var i LivetvSeriesRule
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.Title,
+		&i.ChannelID,
+		&i.NewOnly,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listEnabledSeriesRules = `-- name: ListEnabledSeriesRules :many
+SELECT id, user_id, library_id, title, channel_id, new_only, padding_before_seconds, padding_after_seconds, enabled, created_at, updated_at FROM livetv.series_rules WHERE enabled = TRUE ORDER BY created_at ASC
+`
+
+func (q *Queries) ListEnabledSeriesRules(ctx context.Context) ([]LivetvSeriesRule, error) {
+	rows, err := q.db.Query(ctx, listEnabledSeriesRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvSeriesRule{}
+	for rows.Next() {
+		var i LivetvSeriesRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LibraryID,
+			&i.Title,
+			&i.ChannelID,
+			&i.NewOnly,
+			&i.PaddingBeforeSeconds,
+			&i.PaddingAfterSeconds,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecordings = `-- name: ListRecordings :many
+SELECT id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+FROM livetv.recordings
+WHERE
+    user_id = $1
+    AND (
+        $4::text IS NULL
+        OR status = $4::text
+    )
+ORDER BY starts_at DESC, id ASC
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListRecordingsParams struct {
+	UserID uuid.UUID `json:"userId"`
+	Limit  int32     `json:"limit"`
+	Offset int32     `json:"offset"`
+	Status *string   `json:"status"`
+}
+
+// Lists a user's recordings, newest first, optionally of one status.
+func (q *Queries) ListRecordings(ctx context.Context, arg ListRecordingsParams) ([]LivetvRecording, error) {
+	rows, err := q.db.Query(ctx, listRecordings,
+		arg.UserID,
+		arg.Limit,
+		arg.Offset,
+		arg.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvRecording{}
+	for rows.Next() {
+		var i LivetvRecording
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LibraryID,
+			&i.ChannelID,
+			&i.ProgramID,
+			&i.SeriesRuleID,
+			&i.ChannelName,
+			&i.Title,
+			&i.Subtitle,
+			&i.Description,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.PaddingBeforeSeconds,
+			&i.PaddingAfterSeconds,
+			&i.Status,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledRecordings = `-- name: ListScheduledRecordings :many
+SELECT id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+FROM livetv.recordings
+WHERE
+    status = 'scheduled'
+    AND ends_at > $1
+ORDER BY starts_at ASC
+`
+
+// Recordings waiting for their start, including those that should have
+// started already.
+func (q *Queries) ListScheduledRecordings(ctx context.Context, endsAt time.Time) ([]LivetvRecording, error) {
+	rows, err := q.db.Query(ctx, listScheduledRecordings, endsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvRecording{}
+	for rows.Next() {
+		var i LivetvRecording
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LibraryID,
+			&i.ChannelID,
+			&i.ProgramID,
+			&i.SeriesRuleID,
+			&i.ChannelName,
+			&i.Title,
+			&i.Subtitle,
+			&i.Description,
+			&i.SeasonNumber,
+			&i.EpisodeNumber,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.PaddingBeforeSeconds,
+			&i.PaddingAfterSeconds,
+			&i.Status,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Error,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesRules = `-- name: ListSeriesRules :many
+SELECT id, user_id, library_id, title, channel_id, new_only, padding_before_seconds, padding_after_seconds, enabled, created_at, updated_at
+FROM livetv.series_rules
+WHERE
+    user_id = $1
+ORDER BY lower(title) ASC, id ASC
+`
+
+func (q *Queries) ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]LivetvSeriesRule, error) {
+	rows, err := q.db.Query(ctx, listSeriesRules, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvSeriesRule{}
+	for rows.Next() {
+		var i LivetvSeriesRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LibraryID,
+			&i.Title,
+			&i.ChannelID,
+			&i.NewOnly,
+			&i.PaddingBeforeSeconds,
+			&i.PaddingAfterSeconds,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startRecording = `-- name: StartRecording :one
+UPDATE livetv.recordings
+SET
+    status = 'recording',
+    error = NULL
+WHERE
+    id = $1
+    AND status IN ('scheduled', 'recording') RETURNING id, user_id, library_id, channel_id, program_id, series_rule_id, channel_name, title, subtitle, description, season_number, episode_number, starts_at, ends_at, padding_before_seconds, padding_after_seconds, status, file_path, file_size, error, created_at, updated_at
+`
+
+// Marks a scheduled recording as running. Returns no row for recordings
+// that were cancelled or finished meanwhile.
+func (q *Queries) StartRecording(ctx context.Context, id uuid.UUID) (LivetvRecording, error) {
+	row := q.db.QueryRow(ctx, startRecording, id)
+	var i LivetvRecording
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LibraryID,
+		&i.ChannelID,
+		&i.ProgramID,
+		&i.SeriesRuleID,
+		&i.ChannelName,
+		&i.Title,
+		&i.Subtitle,
+		&i.Description,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.PaddingBeforeSeconds,
+		&i.PaddingAfterSeconds,
+		&i.Status,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Error,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
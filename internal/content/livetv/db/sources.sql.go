@@ -0,0 +1,207 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: sources.sql
+
+package livetvdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createSource = `-- name: CreateSource :one
+INSERT INTO
+    livetv.sources (name, kind, location, enabled)
+VALUES ($1, $2, $3, $4) RETURNING id, name, kind, location, enabled, last_refreshed_at, last_error, created_at, updated_at
+`
+
+type CreateSourceParams struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Location string `json:"location"`
+	Enabled  bool   `json:"enabled"`
+}
+
+func (q *Queries) CreateSource(ctx context.Context, arg CreateSourceParams) (LivetvSource, error) {
+	row := q.db.QueryRow(ctx, createSource,
+		arg.Name,
+		arg.Kind,
+		arg.Location,
+		arg.Enabled,
+	)
+	var i LivetvSource
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Kind,
+		&i.Location,
+		&i.Enabled,
+		&i.LastRefreshedAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteSource = `-- name: DeleteSource :execrows
+DELETE FROM livetv.sources WHERE id = $1
+`
+
+func (q *Queries) DeleteSource(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSource, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getSource = `-- name: GetSource :one
+SELECT id, name, kind, location, enabled, last_refreshed_at, last_error, created_at, updated_at FROM livetv.sources WHERE id = $1
+`
+
+func (q *Queries) GetSource(ctx context.Context, id uuid.UUID) (LivetvSource, error) {
+	row := q.db.QueryRow(ctx, getSource, id)
+	var i LivetvSource
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Kind,
+		&i.Location,
+		&i.Enabled,
+		&i.LastRefreshedAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listEnabledSources = `-- name: ListEnabledSources :many
+SELECT id, name, kind, location, enabled, last_refreshed_at, last_error, created_at, updated_at FROM livetv.sources WHERE enabled = TRUE ORDER BY kind ASC, name ASC
+`
+
+func (q *Queries) ListEnabledSources(ctx context.Context) ([]LivetvSource, error) {
+	rows, err := q.db.Query(ctx, listEnabledSources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvSource{}
+	for rows.Next() {
+		var i LivetvSource
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Kind,
+			&i.Location,
+			&i.Enabled,
+			&i.LastRefreshedAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSources = `-- name: ListSources :many
+SELECT id, name, kind, location, enabled, last_refreshed_at, last_error, created_at, updated_at FROM livetv.sources ORDER BY kind ASC, name ASC, id ASC
+`
+
+func (q *Queries) ListSources(ctx context.Context) ([]LivetvSource, error) {
+	rows, err := q.db.Query(ctx, listSources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LivetvSource{}
+	for rows.Next() {
+		var i LivetvSource
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Kind,
+			&i.Location,
+			&i.Enabled,
+			&i.LastRefreshedAt,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markSourceRefreshed = `-- name: MarkSourceRefreshed :exec
+UPDATE livetv.sources
+SET
+    last_refreshed_at = NOW(),
+    last_error = $2
+WHERE
+    id = $1
+`
+
+type MarkSourceRefreshedParams struct {
+	ID        uuid.UUID `json:"id"`
+	LastError *string   `json:"lastError"`
+}
+
+// Records a refresh, with last_error set when it failed.
+func (q *Queries) MarkSourceRefreshed(ctx context.Context, arg MarkSourceRefreshedParams) error {
+	_, err := q.db.Exec(ctx, markSourceRefreshed, arg.ID, arg.LastError)
+	return err
+}
+
+const updateSource = `-- name: UpdateSource :one
+UPDATE livetv.sources
+SET
+    name = $2,
+    location = $3,
+    enabled = $4
+WHERE
+    id = $1 RETURNING id, name, kind, location, enabled, last_refreshed_at, last_error, created_at, updated_at
+`
+
+type UpdateSourceParams struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Location string    `json:"location"`
+	Enabled  bool      `json:"enabled"`
+}
+
+func (q *Queries) UpdateSource(ctx context.Context, arg UpdateSourceParams) (LivetvSource, error) {
+	row := q.db.QueryRow(ctx, updateSource,
+		arg.ID,
+		arg.Name,
+		arg.Location,
+		arg.Enabled,
+	)
+	var i LivetvSource
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Kind,
+		&i.Location,
+		&i.Enabled,
+		&i.LastRefreshedAt,
+		&i.LastError,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,22 @@
+package livetv
+
+import "errors"
+
+var (
+	ErrSourceNotFound       = errors.New("live tv source not found")
+	ErrChannelNotFound      = errors.New("channel not found")
+	ErrProgramNotFound      = errors.New("program not found")
+	ErrRecordingNotFound    = errors.New("recording not found")
+	ErrSeriesRuleNotFound   = errors.New("series rule not found")
+	ErrSourceExists         = errors.New("live tv source already exists")
+	ErrInvalidSource        = errors.New("invalid live tv source")
+	ErrEmptyPlaylist        = errors.New("playlist lists no channels")
+	ErrInvalidLibrary       = errors.New("not a tv show library")
+	ErrNoLibrary            = errors.New("no tv show library to record into")
+	ErrNoRecordingDirectory = errors.New("tv show library has no path")
+	ErrInvalidRecording     = errors.New("invalid recording")
+	ErrAlreadyScheduled     = errors.New("program is already scheduled")
+	ErrRecordingInProgress  = errors.New("recording in progress")
+	ErrRecordingMissed      = errors.New("recording missed its airing")
+	ErrUnsupportedStream    = errors.New("stream cannot be recorded")
+)
@@ -0,0 +1,209 @@
+package guide
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Size limits of loaded documents. Guides covering a week of a few
+// hundred channels run to tens of megabytes.
+const (
+	MaxPlaylistSize = 8 << 20
+	MaxGuideSize    = 512 << 20
+
+	// DefaultTimeout bounds playlist and guide requests.
+	DefaultTimeout = 5 * time.Minute
+
+	userAgent = "Revenge/1.0 (live TV guide fetcher)"
+)
+
+// ErrTooLarge is returned when a document exceeds its size limit.
+var ErrTooLarge = errors.New("document too large")
+
+// StatusError is returned for unsuccessful HTTP responses.
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("GET %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Client loads playlists and guides from local paths or HTTP URLs.
+type Client struct {
+	http    *http.Client
+	timeout time.Duration
+}
+
+// NewClient creates a guide client. A nil http client uses a default one.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &Client{http: httpClient, timeout: DefaultTimeout}
+}
+
+// Playlist loads an M3U playlist. Relative stream URLs are resolved
+// against the playlist location, so a playlist next to local MPEG-TS
+// files can list them by name.
+func (c *Client) Playlist(ctx context.Context, location string) ([]Channel, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rc, err := c.open(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	data, err := readLimited(rc, MaxPlaylistSize)
+	if err != nil {
+		return nil, fmt.Errorf("read playlist: %w", err)
+	}
+	channels, err := ParseM3U(data)
+	if err != nil {
+		return nil, err
+	}
+	for i := range channels {
+		channels[i].StreamURL = ResolveLocation(location, channels[i].StreamURL)
+	}
+	return channels, nil
+}
+
+// Guide loads an XMLTV guide, which may be gzip-compressed.
+func (c *Client) Guide(ctx context.Context, location string) (*Guide, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	rc, err := c.open(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	return ParseXMLTV(&limitReader{r: rc, n: MaxGuideSize})
+}
+
+// open returns the decompressed content at a path or URL.
+func (c *Client) open(ctx context.Context, location string) (io.ReadCloser, error) {
+	var rc io.ReadCloser
+	if IsURL(location) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid url %q: %w", location, err)
+		}
+		req.Header.Set("User-Agent", userAgent)
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetch %s: %w", location, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+			return nil, &StatusError{URL: location, StatusCode: resp.StatusCode}
+		}
+		rc = resp.Body
+	} else {
+		f, err := os.Open(LocalPath(location)) // #nosec G304 -- sources are configured by admins
+		if err != nil {
+			return nil, err
+		}
+		rc = f
+	}
+
+	br := bufio.NewReader(rc)
+	if magic, err := br.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			_ = rc.Close()
+			return nil, fmt.Errorf("open gzip: %w", err)
+		}
+		return readCloser{Reader: zr, close: rc.Close}, nil
+	}
+	return readCloser{Reader: br, close: rc.Close}, nil
+}
+
+// IsURL reports whether a source location is an HTTP URL rather than a
+// local path.
+func IsURL(location string) bool {
+	lower := strings.ToLower(location)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// LocalPath returns the file path of a local location, which may be a
+// file:// URL.
+func LocalPath(location string) string {
+	if u, err := url.Parse(location); err == nil && u.Scheme == "file" {
+		return u.Path
+	}
+	return location
+}
+
+// ResolveLocation resolves a stream location relative to the playlist
+// that lists it. Absolute URLs and paths are returned unchanged.
+func ResolveLocation(base, ref string) string {
+	if IsURL(ref) || strings.Contains(ref, "://") || filepath.IsAbs(ref) {
+		return ref
+	}
+	if IsURL(base) {
+		b, err := url.Parse(base)
+		if err != nil {
+			return ref
+		}
+		r, err := url.Parse(ref)
+		if err != nil {
+			return ref
+		}
+		return b.ResolveReference(r).String()
+	}
+	return filepath.Join(filepath.Dir(LocalPath(base)), ref)
+}
+
+type readCloser struct {
+	io.Reader
+	close func() error
+}
+
+func (r readCloser) Close() error { return r.close() }
+
+// limitReader fails with ErrTooLarge once more than n bytes were read.
+type limitReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.n < 0 {
+		return 0, ErrTooLarge
+	}
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, ErrTooLarge
+	}
+	return n, err
+}
+
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
@@ -0,0 +1,235 @@
+package guide
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const m3uFixture = `#EXTM3U url-tvg="http://example.com/guide.xml"
+#EXTINF:-1 channel-id="5.1" channel-number="5.1" tvg-id="wxyz.us" tvg-name="WXYZ" tvg-logo="http://example.com/wxyz.png" group-title="Local",WXYZ HD
+http://192.168.1.10:5004/auto/v5.1
+#EXTINF:-1 tvg-chno="7" tvg-name="News, Now" group-title="News",
+#EXTVLCOPT:http-user-agent=Revenge
+http://iptv.example.com/news.ts
+#EXTINF:-1,Movies
+#EXTGRP:Films
+movies.ts
+http://example.com/bare.ts
+`
+
+const xmltvFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE tv SYSTEM "xmltv.dtd">
+<tv generator-info-name="test">
+	<channel id="wxyz.us">
+		<display-name>WXYZ HD</display-name>
+		<display-name>5.1</display-name>
+		<icon src="http://example.com/wxyz.png"/>
+	</channel>
+	<programme start="20240630200000 +0200" stop="20240630210000 +0200" channel="wxyz.us">
+		<title lang="en">The Show</title>
+		<sub-title lang="en">Pilot</sub-title>
+		<desc lang="en">It begins.</desc>
+		<category lang="en">Drama</category>
+		<category lang="en">Series</category>
+		<episode-num system="xmltv_ns">0.4/10.</episode-num>
+		<episode-num system="onscreen">S01E05</episode-num>
+		<date>20240630</date>
+		<new/>
+	</programme>
+	<programme start="20240630210000 +0200" channel="wxyz.us">
+		<title>Late News</title>
+		<episode-num system="onscreen">S12E40</episode-num>
+		<previously-shown/>
+	</programme>
+	<programme start="20240630220000 +0200" channel="wxyz.us">
+		<title>Open End</title>
+	</programme>
+	<programme start="20240630200000" stop="20240630203000" channel="other">
+		<title>UTC Program</title>
+	</programme>
+	<programme start="garbage" channel="other">
+		<title>Broken</title>
+	</programme>
+</tv>
+`
+
+func TestParseM3U(t *testing.T) {
+	channels, err := ParseM3U([]byte("\uFEFF" + m3uFixture))
+	require.NoError(t, err)
+	require.Len(t, channels, 4)
+
+	assert.Equal(t, Channel{
+		Name:      "WXYZ HD",
+		Number:    "5.1",
+		GuideID:   "wxyz.us",
+		LogoURL:   "http://example.com/wxyz.png",
+		Group:     "Local",
+		StreamURL: "http://192.168.1.10:5004/auto/v5.1",
+	}, channels[0])
+
+	// A quoted comma is part of the attribute; the empty name falls back
+	// to tvg-name
+	assert.Equal(t, "News, Now", channels[1].Name)
+	assert.Equal(t, "7", channels[1].Number)
+	assert.Equal(t, "News", channels[1].Group)
+	assert.Equal(t, "http://iptv.example.com/news.ts", channels[1].StreamURL)
+
+	assert.Equal(t, "Movies", channels[2].Name)
+	assert.Equal(t, "Films", channels[2].Group)
+	assert.Equal(t, "movies.ts", channels[2].StreamURL)
+
+	assert.Equal(t, "http://example.com/bare.ts", channels[3].Name)
+}
+
+func TestParseM3U_NotAPlaylist(t *testing.T) {
+	_, err := ParseM3U([]byte("<html></html>"))
+	assert.ErrorIs(t, err, ErrNotM3U)
+
+	_, err = ParseM3U(nil)
+	assert.ErrorIs(t, err, ErrNotM3U)
+}
+
+func TestParseXMLTV(t *testing.T) {
+	g, err := ParseXMLTV(strings.NewReader(xmltvFixture))
+	require.NoError(t, err)
+
+	require.Len(t, g.Channels, 1)
+	assert.Equal(t, GuideChannel{
+		ID:           "wxyz.us",
+		DisplayNames: []string{"WXYZ HD", "5.1"},
+		IconURL:      "http://example.com/wxyz.png",
+	}, g.Channels[0])
+
+	// The open-ended last programme of wxyz.us and the broken one are dropped
+	require.Len(t, g.Programs, 3)
+	byTitle := make(map[string]Program)
+	for _, p := range g.Programs {
+		byTitle[p.Title] = p
+	}
+
+	show := byTitle["The Show"]
+	assert.Equal(t, "wxyz.us", show.ChannelID)
+	assert.True(t, show.Start.Equal(time.Date(2024, 6, 30, 18, 0, 0, 0, time.UTC)))
+	assert.True(t, show.Stop.Equal(time.Date(2024, 6, 30, 19, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "Pilot", show.Subtitle)
+	assert.Equal(t, "It begins.", show.Description)
+	assert.Equal(t, []string{"Drama", "Series"}, show.Categories)
+	assert.Equal(t, 1, show.Season)
+	assert.Equal(t, 5, show.Episode)
+	assert.Equal(t, "S01E05", show.EpisodeNum)
+	assert.Equal(t, time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), show.AirDate)
+	assert.True(t, show.New)
+	assert.False(t, show.PreviouslyShown)
+
+	// Without a stop time the programme ends when the next one starts
+	news := byTitle["Late News"]
+	assert.True(t, news.Stop.Equal(time.Date(2024, 6, 30, 20, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 12, news.Season)
+	assert.Equal(t, 40, news.Episode)
+	assert.True(t, news.PreviouslyShown)
+
+	utc := byTitle["UTC Program"]
+	assert.Equal(t, time.Date(2024, 6, 30, 20, 0, 0, 0, time.UTC), utc.Start)
+}
+
+func TestParseXMLTV_NotAGuide(t *testing.T) {
+	_, err := ParseXMLTV(strings.NewReader(`<rss version="2.0"><channel/></rss>`))
+	assert.ErrorIs(t, err, ErrNotXMLTV)
+}
+
+func TestParseXMLTVNS(t *testing.T) {
+	tests := []struct {
+		in              string
+		season, episode int
+	}{
+		{"0.4/10.", 1, 5},
+		{"2.0.0/1", 3, 1},
+		{".11.", 0, 12},
+		{"1", 0, 0},
+	}
+	for _, tt := range tests {
+		season, episode := parseXMLTVNS(tt.in)
+		assert.Equal(t, tt.season, season, tt.in)
+		assert.Equal(t, tt.episode, episode, tt.in)
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	assert.Equal(t, "http://example.com/live/news.ts", ResolveLocation("http://example.com/live/list.m3u", "news.ts"))
+	assert.Equal(t, "http://other.example.com/a.ts", ResolveLocation("http://example.com/list.m3u", "http://other.example.com/a.ts"))
+	assert.Equal(t, filepath.Join("/srv/tuner", "news.ts"), ResolveLocation("/srv/tuner/list.m3u", "news.ts"))
+	assert.Equal(t, filepath.Join("/srv/tuner", "news.ts"), ResolveLocation("file:///srv/tuner/list.m3u", "news.ts"))
+	assert.Equal(t, "/abs/news.ts", ResolveLocation("http://example.com/list.m3u", "/abs/news.ts"))
+	assert.Equal(t, "udp://239.0.0.1:1234", ResolveLocation("/srv/list.m3u", "udp://239.0.0.1:1234"))
+}
+
+func TestClient_LocalFiles(t *testing.T) {
+	dir := t.TempDir()
+	playlist := filepath.Join(dir, "tuner.m3u")
+	require.NoError(t, os.WriteFile(playlist, []byte(m3uFixture), 0o600))
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, err := zw.Write([]byte(xmltvFixture))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	guidePath := filepath.Join(dir, "guide.xml.gz")
+	require.NoError(t, os.WriteFile(guidePath, gz.Bytes(), 0o600))
+
+	client := NewClient(nil)
+	channels, err := client.Playlist(context.Background(), playlist)
+	require.NoError(t, err)
+	require.Len(t, channels, 4)
+	assert.Equal(t, filepath.Join(dir, "movies.ts"), channels[2].StreamURL)
+
+	g, err := client.Guide(context.Background(), guidePath)
+	require.NoError(t, err)
+	assert.Len(t, g.Programs, 3)
+
+	_, err = client.Playlist(context.Background(), filepath.Join(dir, "missing.m3u"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestClient_HTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/lineup.m3u":
+			_, _ = w.Write([]byte(m3uFixture))
+		case "/guide.xml":
+			_, _ = w.Write([]byte(xmltvFixture))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.Client())
+	channels, err := client.Playlist(context.Background(), srv.URL+"/lineup.m3u")
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/movies.ts", channels[2].StreamURL)
+
+	g, err := client.Guide(context.Background(), srv.URL+"/guide.xml")
+	require.NoError(t, err)
+	assert.Len(t, g.Channels, 1)
+
+	_, err = client.Guide(context.Background(), srv.URL+"/missing.xml")
+	var statusErr *StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+}
+
+func TestLimitReader(t *testing.T) {
+	_, err := ParseXMLTV(&limitReader{r: strings.NewReader(xmltvFixture), n: 100})
+	assert.ErrorIs(t, err, ErrTooLarge)
+}
@@ -0,0 +1,154 @@
+// Package guide reads the channel lists and program guides of live TV
+// sources: M3U playlists of HDHomeRun-style network tuners and IPTV
+// providers, and XMLTV guide files. Both are loaded from local paths or
+// HTTP URLs.
+package guide
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"strings"
+)
+
+// ErrNotM3U is returned for documents that don't start with #EXTM3U.
+var ErrNotM3U = errors.New("not an M3U playlist")
+
+// Channel is a channel of an M3U playlist.
+type Channel struct {
+	// Name is the display name after the comma of the #EXTINF line,
+	// falling back to tvg-name.
+	Name string
+	// Number is the tuner channel number (tvg-chno or channel-number),
+	// such as "5.1". Empty when the playlist has none.
+	Number string
+	// GuideID is the tvg-id attribute: the id of the channel in the
+	// XMLTV guide.
+	GuideID   string
+	LogoURL   string
+	Group     string
+	StreamURL string
+}
+
+// ParseM3U parses an extended M3U playlist. Entries without an #EXTINF
+// line are named after their URL; stream URLs are returned as written.
+func ParseM3U(data []byte) ([]Channel, error) {
+	data = bytes.TrimPrefix(data, []byte("\uFEFF"))
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+
+	var channels []Channel
+	var pending *Channel
+	header := false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !header {
+			if !strings.HasPrefix(line, "#EXTM3U") {
+				return nil, ErrNotM3U
+			}
+			header = true
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "#EXTINF:"):
+			ch := parseExtInf(strings.TrimPrefix(line, "#EXTINF:"))
+			pending = &ch
+		case strings.HasPrefix(line, "#EXTGRP:"):
+			if pending != nil && pending.Group == "" {
+				pending.Group = strings.TrimSpace(strings.TrimPrefix(line, "#EXTGRP:"))
+			}
+		case strings.HasPrefix(line, "#"):
+			// #EXTVLCOPT and other directives
+		default:
+			ch := Channel{Name: line}
+			if pending != nil {
+				ch = *pending
+			}
+			ch.StreamURL = line
+			channels = append(channels, ch)
+			pending = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !header {
+		return nil, ErrNotM3U
+	}
+	return channels, nil
+}
+
+// parseExtInf parses the duration, attributes and name of an #EXTINF line:
+//
+//	-1 tvg-id="wxyz.us" tvg-chno="5.1" tvg-logo="http://…",WXYZ HD
+func parseExtInf(s string) Channel {
+	attrs, name := splitExtInf(s)
+	ch := Channel{
+		Name:    strings.TrimSpace(name),
+		GuideID: attrs["tvg-id"],
+		LogoURL: attrs["tvg-logo"],
+		Group:   attrs["group-title"],
+		Number:  attrs["tvg-chno"],
+	}
+	if ch.Number == "" {
+		ch.Number = attrs["channel-number"]
+	}
+	if ch.Name == "" {
+		ch.Name = attrs["tvg-name"]
+	}
+	return ch
+}
+
+// splitExtInf returns the key="value" attributes of an #EXTINF line and
+// the text after the first comma outside quotes.
+func splitExtInf(s string) (map[string]string, string) {
+	attrs := make(map[string]string)
+	i := 0
+	// Skip the duration
+	for i < len(s) && s[i] != ' ' && s[i] != ',' {
+		i++
+	}
+	for i < len(s) {
+		switch s[i] {
+		case ' ', '\t':
+			i++
+			continue
+		case ',':
+			return attrs, s[i+1:]
+		}
+
+		eq := strings.IndexByte(s[i:], '=')
+		comma := strings.IndexByte(s[i:], ',')
+		if eq < 0 || (comma >= 0 && comma < eq) {
+			// Not an attribute: the name follows the next comma
+			if comma < 0 {
+				return attrs, ""
+			}
+			return attrs, s[i+comma+1:]
+		}
+		key := strings.ToLower(strings.TrimSpace(s[i : i+eq]))
+		i += eq + 1
+
+		var value string
+		if i < len(s) && s[i] == '"' {
+			end := strings.IndexByte(s[i+1:], '"')
+			if end < 0 {
+				value, i = s[i+1:], len(s)
+			} else {
+				value, i = s[i+1:i+1+end], i+end+2
+			}
+		} else {
+			end := i
+			for end < len(s) && s[end] != ' ' && s[end] != ',' {
+				end++
+			}
+			value, i = s[i:end], end
+		}
+		attrs[key] = strings.TrimSpace(value)
+	}
+	return attrs, ""
+}
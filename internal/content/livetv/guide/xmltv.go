@@ -0,0 +1,282 @@
+package guide
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// ErrNotXMLTV is returned for documents without a <tv> root element.
+var ErrNotXMLTV = errors.New("not an XMLTV document")
+
+// Guide is a parsed XMLTV document.
+type Guide struct {
+	Channels []GuideChannel
+	Programs []Program
+}
+
+// GuideChannel is a <channel> of an XMLTV guide.
+type GuideChannel struct {
+	ID           string
+	DisplayNames []string
+	IconURL      string
+}
+
+// Program is a <programme> of an XMLTV guide.
+type Program struct {
+	ChannelID   string
+	Start       time.Time
+	Stop        time.Time
+	Title       string
+	Subtitle    string
+	Description string
+	Categories  []string
+	// Season and Episode are one-based; 0 when the guide doesn't say.
+	Season     int
+	Episode    int
+	EpisodeNum string // on-screen episode number, such as "S01E05"
+	IconURL    string
+	// AirDate is the original air date, zero when unknown.
+	AirDate         time.Time
+	New             bool
+	PreviouslyShown bool
+}
+
+// xmltvTime layouts, longest first. Times without a zone are UTC.
+var xmltvTimeLayouts = []string{"20060102150405 -0700", "20060102150405", "200601021504", "2006010215", "20060102"}
+
+// onscreenPattern matches on-screen episode numbers like "S01E05".
+var onscreenPattern = regexp.MustCompile(`(?i)S(\d{1,3})\s*E(\d{1,4})`)
+
+type xmlChannel struct {
+	ID           string    `xml:"id,attr"`
+	DisplayNames []string  `xml:"display-name"`
+	Icon         []xmlIcon `xml:"icon"`
+}
+
+type xmlIcon struct {
+	Src string `xml:"src,attr"`
+}
+
+type xmlLangText struct {
+	Lang string `xml:"lang,attr"`
+	Text string `xml:",chardata"`
+}
+
+type xmlEpisodeNum struct {
+	System string `xml:"system,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type xmlProgramme struct {
+	Start           string          `xml:"start,attr"`
+	Stop            string          `xml:"stop,attr"`
+	Channel         string          `xml:"channel,attr"`
+	Titles          []xmlLangText   `xml:"title"`
+	SubTitles       []xmlLangText   `xml:"sub-title"`
+	Descs           []xmlLangText   `xml:"desc"`
+	Categories      []xmlLangText   `xml:"category"`
+	EpisodeNums     []xmlEpisodeNum `xml:"episode-num"`
+	Date            string          `xml:"date"`
+	Icon            []xmlIcon       `xml:"icon"`
+	New             *struct{}       `xml:"new"`
+	PreviouslyShown *struct{}       `xml:"previously-shown"`
+}
+
+// ParseXMLTV reads an XMLTV guide. The document is decoded as a stream,
+// so large guides are not held in memory twice. Programmes without a stop
+// time end when the next programme of their channel starts; the last one
+// of a channel without a stop time is dropped.
+func ParseXMLTV(r io.Reader) (*Guide, error) {
+	dec := xml.NewDecoder(r)
+	dec.CharsetReader = charset.NewReaderLabel
+	dec.Strict = false
+
+	g := &Guide{}
+	root := false
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse xmltv: %w", err)
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch se.Name.Local {
+		case "tv":
+			root = true
+		case "channel":
+			var c xmlChannel
+			if err := dec.DecodeElement(&c, &se); err != nil {
+				return nil, fmt.Errorf("parse xmltv channel: %w", err)
+			}
+			g.Channels = append(g.Channels, convertChannel(c))
+		case "programme":
+			var p xmlProgramme
+			if err := dec.DecodeElement(&p, &se); err != nil {
+				return nil, fmt.Errorf("parse xmltv programme: %w", err)
+			}
+			if prog, ok := convertProgramme(p); ok {
+				g.Programs = append(g.Programs, prog)
+			}
+		default:
+			if !root {
+				return nil, ErrNotXMLTV
+			}
+		}
+	}
+	if !root {
+		return nil, ErrNotXMLTV
+	}
+	g.Programs = fillStopTimes(g.Programs)
+	return g, nil
+}
+
+func convertChannel(c xmlChannel) GuideChannel {
+	ch := GuideChannel{ID: strings.TrimSpace(c.ID)}
+	for _, name := range c.DisplayNames {
+		if name = strings.TrimSpace(name); name != "" {
+			ch.DisplayNames = append(ch.DisplayNames, name)
+		}
+	}
+	if len(c.Icon) > 0 {
+		ch.IconURL = c.Icon[0].Src
+	}
+	return ch
+}
+
+func convertProgramme(p xmlProgramme) (Program, bool) {
+	start, ok := ParseTime(p.Start)
+	if !ok || p.Channel == "" {
+		return Program{}, false
+	}
+	prog := Program{
+		ChannelID:       strings.TrimSpace(p.Channel),
+		Start:           start,
+		Title:           firstText(p.Titles),
+		Subtitle:        firstText(p.SubTitles),
+		Description:     firstText(p.Descs),
+		New:             p.New != nil,
+		PreviouslyShown: p.PreviouslyShown != nil,
+	}
+	if prog.Title == "" {
+		return Program{}, false
+	}
+	if stop, ok := ParseTime(p.Stop); ok && stop.After(start) {
+		prog.Stop = stop
+	}
+	for _, c := range p.Categories {
+		if text := strings.TrimSpace(c.Text); text != "" {
+			prog.Categories = append(prog.Categories, text)
+		}
+	}
+	if len(p.Icon) > 0 {
+		prog.IconURL = p.Icon[0].Src
+	}
+	if date, ok := ParseTime(p.Date); ok {
+		prog.AirDate = date
+	}
+	for _, num := range p.EpisodeNums {
+		value := strings.TrimSpace(num.Value)
+		switch strings.ToLower(num.System) {
+		case "xmltv_ns":
+			if prog.Season == 0 && prog.Episode == 0 {
+				prog.Season, prog.Episode = parseXMLTVNS(value)
+			}
+		case "onscreen", "sxxexx":
+			prog.EpisodeNum = value
+			if m := onscreenPattern.FindStringSubmatch(value); m != nil && prog.Season == 0 && prog.Episode == 0 {
+				prog.Season, _ = strconv.Atoi(m[1])
+				prog.Episode, _ = strconv.Atoi(m[2])
+			}
+		}
+	}
+	return prog, true
+}
+
+// parseXMLTVNS parses a zero-based "season.episode.part" number, where
+// each part may carry a "/total" suffix: "0.4/10." is S01E05.
+func parseXMLTVNS(s string) (season, episode int) {
+	parts := strings.Split(strings.ReplaceAll(s, " ", ""), ".")
+	if len(parts) < 2 {
+		return 0, 0
+	}
+	number := func(part string) int {
+		part, _, _ = strings.Cut(part, "/")
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0
+		}
+		return n + 1
+	}
+	return number(parts[0]), number(parts[1])
+}
+
+// ParseTime parses an XMLTV timestamp such as "20240630200000 +0200".
+// The precision may be cut down to the day; times without a zone are UTC.
+func ParseTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range xmltvTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// fillStopTimes ends programmes without a stop time at the start of the
+// next programme of the same channel.
+func fillStopTimes(programs []Program) []Program {
+	missing := false
+	for i := range programs {
+		if programs[i].Stop.IsZero() {
+			missing = true
+			break
+		}
+	}
+	if !missing {
+		return programs
+	}
+
+	sort.SliceStable(programs, func(i, j int) bool {
+		if programs[i].ChannelID != programs[j].ChannelID {
+			return programs[i].ChannelID < programs[j].ChannelID
+		}
+		return programs[i].Start.Before(programs[j].Start)
+	})
+	out := programs[:0]
+	for i, p := range programs {
+		if p.Stop.IsZero() {
+			if i+1 >= len(programs) || programs[i+1].ChannelID != p.ChannelID || !programs[i+1].Start.After(p.Start) {
+				continue
+			}
+			p.Stop = programs[i+1].Start
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func firstText(texts []xmlLangText) string {
+	for _, t := range texts {
+		if text := strings.TrimSpace(t.Text); text != "" {
+			return text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,374 @@
+// Package jobs provides background job workers for live TV. Channel lists
+// and guides are reloaded on a schedule, series rules are applied to the
+// new guide, and every scheduled recording gets a job that runs when its
+// capture is due. Finished recordings are imported by a TV library scan.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/lusoris/revenge/internal/content/livetv"
+	tvshowjobs "github.com/lusoris/revenge/internal/content/tvshow/jobs"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+)
+
+// Job kinds of the live TV workers.
+const (
+	KindSourceRefresh = "livetv_source_refresh"
+	KindRefreshAll    = "livetv_refresh_all"
+	KindSchedule      = "livetv_schedule"
+	KindRecord        = "livetv_record"
+)
+
+// errDisabled cancels jobs queued while live TV was enabled.
+var errDisabled = errors.New("live tv is disabled")
+
+// jobInserter enqueues jobs in batches. It is satisfied by *infrajobs.Client.
+type jobInserter interface {
+	InsertMany(ctx context.Context, params []river.InsertManyParams) ([]*rivertype.JobInsertResult, error)
+}
+
+// SourceRefreshArgs defines arguments for reloading one source.
+type SourceRefreshArgs struct {
+	SourceID uuid.UUID `json:"source_id"`
+}
+
+// Kind returns the job kind identifier.
+func (SourceRefreshArgs) Kind() string {
+	return KindSourceRefresh
+}
+
+// InsertOpts returns the default insert options for source refreshes.
+func (SourceRefreshArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueDefault,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// RefreshAllArgs triggers a reload of every enabled source.
+type RefreshAllArgs struct{}
+
+// Kind returns the job kind identifier.
+func (RefreshAllArgs) Kind() string {
+	return KindRefreshAll
+}
+
+// InsertOpts returns the default insert options for scheduled refresh runs.
+func (RefreshAllArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueLow,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// ScheduleArgs triggers applying the series rules and queueing a record
+// job for every scheduled recording.
+type ScheduleArgs struct{}
+
+// Kind returns the job kind identifier.
+func (ScheduleArgs) Kind() string {
+	return KindSchedule
+}
+
+// InsertOpts returns the default insert options for schedule runs.
+func (ScheduleArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueDefault,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: time.Minute,
+		},
+	}
+}
+
+// RecordArgs defines arguments for capturing a recording. The job is
+// queued to run when the capture starts.
+type RecordArgs struct {
+	RecordingID uuid.UUID `json:"recording_id"`
+}
+
+// Kind returns the job kind identifier.
+func (RecordArgs) Kind() string {
+	return KindRecord
+}
+
+// InsertOpts returns the default insert options for recordings. They run
+// on the high priority queue so that a busy queue doesn't delay the start
+// of a broadcast, and one job exists per recording.
+func (RecordArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueHigh,
+		MaxAttempts: 5,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	}
+}
+
+// SourceRefreshWorker reloads a source and applies the series rules to
+// its channels or guide.
+type SourceRefreshWorker struct {
+	river.WorkerDefaults[SourceRefreshArgs]
+	service livetv.Service
+	jobs    jobInserter
+	logger  *slog.Logger
+}
+
+// NewSourceRefreshWorker creates a new source refresh worker.
+func NewSourceRefreshWorker(service livetv.Service, jobClient *infrajobs.Client, logger *slog.Logger) *SourceRefreshWorker {
+	return &SourceRefreshWorker{
+		service: service,
+		jobs:    jobClient,
+		logger:  logger.With("component", "livetv_source_refresh"),
+	}
+}
+
+// Timeout returns the maximum execution time for source refreshes. Guides
+// of a few hundred channels hold tens of thousands of programs.
+func (w *SourceRefreshWorker) Timeout(job *river.Job[SourceRefreshArgs]) time.Duration {
+	return 15 * time.Minute
+}
+
+// Work executes the source refresh job. Load errors are recorded on the
+// source by the service and retried by River.
+func (w *SourceRefreshWorker) Work(ctx context.Context, job *river.Job[SourceRefreshArgs]) error {
+	if w.service == nil {
+		return river.JobCancel(errDisabled)
+	}
+	res, err := w.service.RefreshSource(ctx, job.Args.SourceID)
+	if errors.Is(err, livetv.ErrSourceNotFound) {
+		return river.JobCancel(err)
+	}
+	if err != nil {
+		return err
+	}
+	w.logger.Info("live tv source refreshed",
+		slog.String("source_id", res.Source.ID.String()),
+		slog.String("kind", res.Source.Kind),
+		slog.Int("channels", res.Channels),
+		slog.Int("programs", res.Programs),
+		slog.Int64("removed", res.Removed),
+	)
+	return queueSchedule(ctx, w.jobs)
+}
+
+// RefreshAllWorker reloads every enabled source, playlists before guides,
+// then applies the series rules.
+type RefreshAllWorker struct {
+	river.WorkerDefaults[RefreshAllArgs]
+	service livetv.Service
+	jobs    jobInserter
+	logger  *slog.Logger
+}
+
+// NewRefreshAllWorker creates a new scheduled refresh worker.
+func NewRefreshAllWorker(service livetv.Service, jobClient *infrajobs.Client, logger *slog.Logger) *RefreshAllWorker {
+	return &RefreshAllWorker{
+		service: service,
+		jobs:    jobClient,
+		logger:  logger.With("component", "livetv_refresh_all"),
+	}
+}
+
+// Timeout returns the maximum execution time for a scheduled refresh run.
+func (w *RefreshAllWorker) Timeout(job *river.Job[RefreshAllArgs]) time.Duration {
+	return time.Hour
+}
+
+// Work reloads the sources in order, since a guide links the channels
+// that playlists added by name. A failing source is logged and the others
+// are still reloaded.
+func (w *RefreshAllWorker) Work(ctx context.Context, job *river.Job[RefreshAllArgs]) error {
+	if w.service == nil {
+		return nil
+	}
+	sources, err := w.service.ListEnabledSources(ctx)
+	if err != nil {
+		return fmt.Errorf("list live tv sources: %w", err)
+	}
+	failed := 0
+	for _, src := range sources {
+		if _, err := w.service.RefreshSource(ctx, src.ID); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			failed++
+			w.logger.Warn("live tv source refresh failed",
+				slog.String("source_id", src.ID.String()),
+				slog.String("name", src.Name),
+				slog.Any("error", err),
+			)
+		}
+	}
+	w.logger.Info("refreshed live tv sources",
+		slog.Int("count", len(sources)),
+		slog.Int("failed", failed),
+	)
+	return queueSchedule(ctx, w.jobs)
+}
+
+// ScheduleWorker applies the series rules and queues a record job for
+// every scheduled recording, to run when its capture starts.
+type ScheduleWorker struct {
+	river.WorkerDefaults[ScheduleArgs]
+	service livetv.Service
+	jobs    jobInserter
+	logger  *slog.Logger
+}
+
+// NewScheduleWorker creates a new schedule worker.
+func NewScheduleWorker(service livetv.Service, jobClient *infrajobs.Client, logger *slog.Logger) *ScheduleWorker {
+	return &ScheduleWorker{
+		service: service,
+		jobs:    jobClient,
+		logger:  logger.With("component", "livetv_schedule"),
+	}
+}
+
+// Timeout returns the maximum execution time for schedule runs.
+func (w *ScheduleWorker) Timeout(job *river.Job[ScheduleArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+// Work executes the schedule job. Record jobs are unique per recording,
+// so queueing the pending recordings again is harmless.
+func (w *ScheduleWorker) Work(ctx context.Context, job *river.Job[ScheduleArgs]) error {
+	if w.service == nil {
+		return nil
+	}
+	scheduled, err := w.service.ApplySeriesRules(ctx)
+	if err != nil {
+		return fmt.Errorf("apply series rules: %w", err)
+	}
+	if len(scheduled) > 0 {
+		w.logger.Info("series rules scheduled recordings", slog.Int("count", len(scheduled)))
+	}
+
+	pending, err := w.service.ListPendingRecordings(ctx)
+	if err != nil {
+		return fmt.Errorf("list pending recordings: %w", err)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	params := make([]river.InsertManyParams, 0, len(pending))
+	for i := range pending {
+		params = append(params, RecordJob(&pending[i]))
+	}
+	if _, err := w.jobs.InsertMany(ctx, params); err != nil {
+		return fmt.Errorf("queue recordings: %w", err)
+	}
+	return nil
+}
+
+// RecordJob returns the insert parameters of a recording's record job,
+// scheduled for the start of its capture.
+func RecordJob(rec *livetv.Recording) river.InsertManyParams {
+	return river.InsertManyParams{
+		Args:       RecordArgs{RecordingID: rec.ID},
+		InsertOpts: &river.InsertOpts{ScheduledAt: rec.CaptureStart()},
+	}
+}
+
+// RecordWorker captures a recording and queues the import of the
+// recorded file into its TV library.
+type RecordWorker struct {
+	river.WorkerDefaults[RecordArgs]
+	service livetv.Service
+	jobs    jobInserter
+	logger  *slog.Logger
+}
+
+// NewRecordWorker creates a new record worker.
+func NewRecordWorker(service livetv.Service, jobClient *infrajobs.Client, logger *slog.Logger) *RecordWorker {
+	return &RecordWorker{
+		service: service,
+		jobs:    jobClient,
+		logger:  logger.With("component", "livetv_record"),
+	}
+}
+
+// Timeout returns the maximum execution time for recordings: the longest
+// recording with both paddings. The capture itself stops at the end of
+// the recording.
+func (w *RecordWorker) Timeout(job *river.Job[RecordArgs]) time.Duration {
+	return livetv.MaxRecordingLength + 2*livetv.MaxPadding + 10*time.Minute
+}
+
+// Work executes the record job. Jobs that run early, such as ones queued
+// before the recording was moved, are snoozed until the capture starts.
+func (w *RecordWorker) Work(ctx context.Context, job *river.Job[RecordArgs]) error {
+	if w.service == nil {
+		return river.JobCancel(errDisabled)
+	}
+	rec, err := w.service.GetRecording(ctx, job.Args.RecordingID)
+	if errors.Is(err, livetv.ErrRecordingNotFound) {
+		return river.JobCancel(err)
+	}
+	if err != nil {
+		return err
+	}
+	if rec.Status != livetv.RecordingStatusScheduled && rec.Status != livetv.RecordingStatusRecording {
+		return nil
+	}
+	if wait := time.Until(rec.CaptureStart()); wait > time.Second {
+		return river.JobSnooze(wait)
+	}
+
+	rec, err = w.service.Record(ctx, rec.ID)
+	if err != nil {
+		// Failed recordings were marked by the service; retrying them
+		// can't bring back the missed airing
+		if rec != nil || errors.Is(err, livetv.ErrRecordingNotFound) {
+			return river.JobCancel(err)
+		}
+		return err
+	}
+	if rec.Status != livetv.RecordingStatusCompleted || rec.FilePath == nil {
+		return nil
+	}
+
+	w.logger.Info("recording completed",
+		slog.String("recording_id", rec.ID.String()),
+		slog.String("path", *rec.FilePath),
+		slog.Int64("size", rec.FileSize),
+	)
+	libraryID := rec.LibraryID
+	if _, err := w.jobs.InsertMany(ctx, []river.InsertManyParams{{Args: tvshowjobs.LibraryScanArgs{
+		Paths:      []string{filepath.Dir(*rec.FilePath)},
+		LibraryID:  &libraryID,
+		AutoCreate: true,
+	}}}); err != nil {
+		// The next library scan picks the file up
+		w.logger.Warn("failed to queue recording import",
+			slog.String("recording_id", rec.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+	return nil
+}
+
+// queueSchedule queues a schedule run.
+func queueSchedule(ctx context.Context, jobs jobInserter) error {
+	if _, err := jobs.InsertMany(ctx, []river.InsertManyParams{{Args: ScheduleArgs{}}}); err != nil {
+		return fmt.Errorf("queue live tv schedule: %w", err)
+	}
+	return nil
+}
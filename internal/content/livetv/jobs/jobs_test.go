@@ -0,0 +1,207 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/livetv"
+	tvshowjobs "github.com/lusoris/revenge/internal/content/tvshow/jobs"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+// fakeService serves canned sources and recordings.
+type fakeService struct {
+	livetv.Service
+	sources    []livetv.Source
+	refreshErr map[uuid.UUID]error
+	refreshed  []uuid.UUID
+	scheduled  []livetv.Recording
+	pending    []livetv.Recording
+	recording  *livetv.Recording
+	getErr     error
+	recorded   *livetv.Recording
+	recordErr  error
+	recordIDs  []uuid.UUID
+}
+
+func (s *fakeService) ListEnabledSources(context.Context) ([]livetv.Source, error) {
+	return s.sources, nil
+}
+
+func (s *fakeService) RefreshSource(_ context.Context, id uuid.UUID) (*livetv.RefreshResult, error) {
+	s.refreshed = append(s.refreshed, id)
+	if err := s.refreshErr[id]; err != nil {
+		return nil, err
+	}
+	return &livetv.RefreshResult{Source: &livetv.Source{ID: id}}, nil
+}
+
+func (s *fakeService) ApplySeriesRules(context.Context) ([]livetv.Recording, error) {
+	return s.scheduled, nil
+}
+
+func (s *fakeService) ListPendingRecordings(context.Context) ([]livetv.Recording, error) {
+	return s.pending, nil
+}
+
+func (s *fakeService) GetRecording(context.Context, uuid.UUID) (*livetv.Recording, error) {
+	return s.recording, s.getErr
+}
+
+func (s *fakeService) Record(_ context.Context, id uuid.UUID) (*livetv.Recording, error) {
+	s.recordIDs = append(s.recordIDs, id)
+	return s.recorded, s.recordErr
+}
+
+// recordingInserter records inserted jobs.
+type recordingInserter struct {
+	params []river.InsertManyParams
+}
+
+func (r *recordingInserter) InsertMany(_ context.Context, params []river.InsertManyParams) ([]*rivertype.JobInsertResult, error) {
+	r.params = append(r.params, params...)
+	return nil, nil
+}
+
+func (r *recordingInserter) args() []river.JobArgs {
+	out := make([]river.JobArgs, len(r.params))
+	for i, p := range r.params {
+		out[i] = p.Args
+	}
+	return out
+}
+
+func recordJob(id uuid.UUID) *river.Job[RecordArgs] {
+	return &river.Job[RecordArgs]{JobRow: &rivertype.JobRow{}, Args: RecordArgs{RecordingID: id}}
+}
+
+func TestSourceRefreshWorker_QueuesSchedule(t *testing.T) {
+	inserter := &recordingInserter{}
+	w := &SourceRefreshWorker{service: &fakeService{}, jobs: inserter, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), &river.Job[SourceRefreshArgs]{JobRow: &rivertype.JobRow{}, Args: SourceRefreshArgs{SourceID: uuid.New()}})
+	require.NoError(t, err)
+	assert.Equal(t, []river.JobArgs{ScheduleArgs{}}, inserter.args())
+}
+
+func TestSourceRefreshWorker_DeletedSourceCancels(t *testing.T) {
+	id := uuid.New()
+	svc := &fakeService{refreshErr: map[uuid.UUID]error{id: livetv.ErrSourceNotFound}}
+	w := &SourceRefreshWorker{service: svc, jobs: &recordingInserter{}, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), &river.Job[SourceRefreshArgs]{JobRow: &rivertype.JobRow{}, Args: SourceRefreshArgs{SourceID: id}})
+	var cancel *river.JobCancelError
+	assert.True(t, errors.As(err, &cancel))
+}
+
+func TestRefreshAllWorker_ContinuesAfterFailure(t *testing.T) {
+	a, b := livetv.Source{ID: uuid.New()}, livetv.Source{ID: uuid.New()}
+	svc := &fakeService{
+		sources:    []livetv.Source{a, b},
+		refreshErr: map[uuid.UUID]error{a.ID: errors.New("tuner offline")},
+	}
+	inserter := &recordingInserter{}
+	w := &RefreshAllWorker{service: svc, jobs: inserter, logger: logging.NewTestLogger()}
+
+	require.NoError(t, w.Work(context.Background(), &river.Job[RefreshAllArgs]{JobRow: &rivertype.JobRow{}}))
+	assert.Equal(t, []uuid.UUID{a.ID, b.ID}, svc.refreshed)
+	assert.Equal(t, []river.JobArgs{ScheduleArgs{}}, inserter.args())
+}
+
+func TestScheduleWorker_QueuesPendingRecordings(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	rec := livetv.Recording{ID: uuid.New(), StartsAt: start, EndsAt: start.Add(time.Hour), PaddingBefore: 2 * time.Minute}
+	inserter := &recordingInserter{}
+	w := &ScheduleWorker{service: &fakeService{pending: []livetv.Recording{rec}}, jobs: inserter, logger: logging.NewTestLogger()}
+
+	require.NoError(t, w.Work(context.Background(), &river.Job[ScheduleArgs]{JobRow: &rivertype.JobRow{}}))
+	require.Len(t, inserter.params, 1)
+	assert.Equal(t, RecordArgs{RecordingID: rec.ID}, inserter.params[0].Args)
+	assert.True(t, inserter.params[0].InsertOpts.ScheduledAt.Equal(start.Add(-2*time.Minute)))
+}
+
+func TestRecordWorker_SnoozesEarlyJobs(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	rec := &livetv.Recording{ID: uuid.New(), StartsAt: start, EndsAt: start.Add(time.Hour), Status: livetv.RecordingStatusScheduled}
+	svc := &fakeService{recording: rec}
+	w := &RecordWorker{service: svc, jobs: &recordingInserter{}, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), recordJob(rec.ID))
+	var snooze *river.JobSnoozeError
+	require.True(t, errors.As(err, &snooze))
+	assert.InDelta(t, time.Hour.Seconds(), snooze.Duration.Seconds(), 5)
+	assert.Empty(t, svc.recordIDs)
+}
+
+func TestRecordWorker_QueuesImport(t *testing.T) {
+	libraryID := uuid.New()
+	path := "/srv/tv/The Show/Season 01/The Show - S01E05.ts"
+	rec := &livetv.Recording{ID: uuid.New(), LibraryID: libraryID, StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour), Status: livetv.RecordingStatusScheduled}
+	svc := &fakeService{
+		recording: rec,
+		recorded:  &livetv.Recording{ID: rec.ID, LibraryID: libraryID, Status: livetv.RecordingStatusCompleted, FilePath: &path},
+	}
+	inserter := &recordingInserter{}
+	w := &RecordWorker{service: svc, jobs: inserter, logger: logging.NewTestLogger()}
+
+	require.NoError(t, w.Work(context.Background(), recordJob(rec.ID)))
+	assert.Equal(t, []uuid.UUID{rec.ID}, svc.recordIDs)
+	assert.Equal(t, []river.JobArgs{tvshowjobs.LibraryScanArgs{
+		Paths:      []string{"/srv/tv/The Show/Season 01"},
+		LibraryID:  &libraryID,
+		AutoCreate: true,
+	}}, inserter.args())
+}
+
+func TestRecordWorker_FailedRecordingCancels(t *testing.T) {
+	rec := &livetv.Recording{ID: uuid.New(), StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour), Status: livetv.RecordingStatusRecording}
+	svc := &fakeService{
+		recording: rec,
+		recorded:  &livetv.Recording{ID: rec.ID, Status: livetv.RecordingStatusFailed},
+		recordErr: livetv.ErrRecordingMissed,
+	}
+	inserter := &recordingInserter{}
+	w := &RecordWorker{service: svc, jobs: inserter, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), recordJob(rec.ID))
+	var cancel *river.JobCancelError
+	assert.True(t, errors.As(err, &cancel))
+	assert.Empty(t, inserter.params)
+}
+
+func TestRecordWorker_InterruptedCaptureRetries(t *testing.T) {
+	rec := &livetv.Recording{ID: uuid.New(), StartsAt: time.Now(), EndsAt: time.Now().Add(time.Hour), Status: livetv.RecordingStatusRecording}
+	svc := &fakeService{recording: rec, recordErr: context.Canceled}
+	w := &RecordWorker{service: svc, jobs: &recordingInserter{}, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), recordJob(rec.ID))
+	require.ErrorIs(t, err, context.Canceled)
+	var cancel *river.JobCancelError
+	assert.False(t, errors.As(err, &cancel))
+}
+
+func TestRecordWorker_SkipsFinishedAndDeleted(t *testing.T) {
+	svc := &fakeService{recording: &livetv.Recording{Status: livetv.RecordingStatusCancelled}}
+	w := &RecordWorker{service: svc, jobs: &recordingInserter{}, logger: logging.NewTestLogger()}
+	require.NoError(t, w.Work(context.Background(), recordJob(uuid.New())))
+	assert.Empty(t, svc.recordIDs)
+
+	svc = &fakeService{getErr: livetv.ErrRecordingNotFound}
+	w = &RecordWorker{service: svc, jobs: &recordingInserter{}, logger: logging.NewTestLogger()}
+	var cancel *river.JobCancelError
+	assert.True(t, errors.As(w.Work(context.Background(), recordJob(uuid.New())), &cancel))
+}
+
+func TestRecordWorker_DisabledCancels(t *testing.T) {
+	w := &RecordWorker{logger: logging.NewTestLogger()}
+	var cancel *river.JobCancelError
+	assert.True(t, errors.As(w.Work(context.Background(), recordJob(uuid.New())), &cancel))
+}
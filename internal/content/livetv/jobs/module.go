@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/livetv"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+)
+
+// Module provides the live TV jobs workers.
+var Module = fx.Module("livetvjobs",
+	fx.Provide(
+		provideSourceRefreshWorker,
+		provideRefreshAllWorker,
+		provideScheduleWorker,
+		provideRecordWorker,
+	),
+	fx.Invoke(RegisterWorkers),
+)
+
+// WorkerProviderParams holds common dependencies for worker providers.
+type WorkerProviderParams struct {
+	fx.In
+
+	Service   livetv.Service
+	JobClient *infrajobs.Client
+	Logger    *slog.Logger
+}
+
+// provideSourceRefreshWorker creates a source refresh worker.
+func provideSourceRefreshWorker(p WorkerProviderParams) *SourceRefreshWorker {
+	return NewSourceRefreshWorker(p.Service, p.JobClient, p.Logger)
+}
+
+// provideRefreshAllWorker creates a scheduled refresh worker.
+func provideRefreshAllWorker(p WorkerProviderParams) *RefreshAllWorker {
+	return NewRefreshAllWorker(p.Service, p.JobClient, p.Logger)
+}
+
+// provideScheduleWorker creates a schedule worker.
+func provideScheduleWorker(p WorkerProviderParams) *ScheduleWorker {
+	return NewScheduleWorker(p.Service, p.JobClient, p.Logger)
+}
+
+// provideRecordWorker creates a record worker.
+func provideRecordWorker(p WorkerProviderParams) *RecordWorker {
+	return NewRecordWorker(p.Service, p.JobClient, p.Logger)
+}
+
+// RegisterWorkers registers all live TV job workers with the River workers registry.
+func RegisterWorkers(
+	workers *river.Workers,
+	sourceRefreshWorker *SourceRefreshWorker,
+	refreshAllWorker *RefreshAllWorker,
+	scheduleWorker *ScheduleWorker,
+	recordWorker *RecordWorker,
+) error {
+	river.AddWorker(workers, sourceRefreshWorker)
+	river.AddWorker(workers, refreshAllWorker)
+	river.AddWorker(workers, scheduleWorker)
+	river.AddWorker(workers, recordWorker)
+	return nil
+}
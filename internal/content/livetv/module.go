@@ -0,0 +1,33 @@
+package livetv
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/livetv/guide"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// Module provides the live TV content module. The service is nil unless
+// livetv.enabled is set.
+var Module = fx.Module("livetv",
+	fx.Provide(
+		NewPostgresRepository,
+		provideService,
+	),
+)
+
+// provideService creates the live TV service when the module is enabled.
+// Recordings are stored in the TV libraries managed by the library
+// service.
+func provideService(repo Repository, libraries *library.Service, cfg *config.Config, logger *slog.Logger) Service {
+	if !cfg.LiveTV.Enabled {
+		return nil
+	}
+	return NewService(repo, libraries, guide.NewClient(nil), NewRecorder(nil), Config{
+		DefaultPaddingBefore: cfg.LiveTV.DefaultPaddingBefore,
+		DefaultPaddingAfter:  cfg.LiveTV.DefaultPaddingAfter,
+	}, logger)
+}
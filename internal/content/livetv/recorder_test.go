@@ -0,0 +1,102 @@
+package livetv
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/livetv/guide"
+)
+
+func TestRecorder_CaptureFile(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "channel.ts", "mpeg-ts")
+	var buf bytes.Buffer
+
+	n, err := NewRecorder(nil).Capture(context.Background(), "file://"+path, time.Now().Add(time.Minute), &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+	assert.Equal(t, "mpeg-ts", buf.String())
+
+	_, err = NewRecorder(nil).Capture(context.Background(), filepath.Join(t.TempDir(), "missing.ts"), time.Now().Add(time.Minute), &buf)
+	assert.Error(t, err)
+
+	_, err = NewRecorder(nil).Capture(context.Background(), "udp://239.0.0.1:1234", time.Now().Add(time.Minute), &buf)
+	assert.ErrorIs(t, err, ErrUnsupportedStream)
+}
+
+// TestRecorder_CaptureHTTPReconnects serves a stream that drops after
+// each chunk; the recorder reopens it until the deadline.
+func TestRecorder_CaptureHTTPReconnects(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, recorderUserAgent, r.Header.Get("User-Agent"))
+		if requests.Add(1) == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("chunk"))
+	}))
+	t.Cleanup(srv.Close)
+
+	r := NewRecorder(srv.Client())
+	r.reconnectDelay = 20 * time.Millisecond
+	var buf bytes.Buffer
+
+	n, err := r.Capture(context.Background(), srv.URL+"/auto/v5.1", time.Now().Add(150*time.Millisecond), &buf)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, requests.Load(), int32(3))
+	assert.Equal(t, int64(buf.Len()), n)
+	assert.Equal(t, 0, buf.Len()%len("chunk"))
+	assert.GreaterOrEqual(t, n, int64(2*len("chunk")))
+}
+
+func TestRecorder_CaptureHTTPNoData(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+
+	r := NewRecorder(srv.Client())
+	r.reconnectDelay = 10 * time.Millisecond
+
+	_, err := r.Capture(context.Background(), srv.URL, time.Now().Add(50*time.Millisecond), &bytes.Buffer{})
+	var statusErr *guide.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+}
+
+func TestRecorder_CaptureCancelled(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "channel.ts", "mpeg-ts")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewRecorder(nil).Capture(ctx, path, time.Now().Add(time.Minute), &bytes.Buffer{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestRecorder_WriteErrorEndsCapture(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte("chunk"))
+	}))
+	t.Cleanup(srv.Close)
+
+	r := NewRecorder(srv.Client())
+	r.reconnectDelay = time.Millisecond
+
+	_, err := r.Capture(context.Background(), srv.URL, time.Now().Add(time.Second), failingWriter{})
+	assert.ErrorContains(t, err, "disk full")
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,133 @@
+package livetv
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for live TV
+type Repository interface {
+	// Sources
+	GetSource(ctx context.Context, id uuid.UUID) (*Source, error)
+	ListSources(ctx context.Context) ([]Source, error)
+	ListEnabledSources(ctx context.Context) ([]Source, error)
+	CreateSource(ctx context.Context, params CreateSourceParams) (*Source, error)
+	MarkSourceRefreshed(ctx context.Context, id uuid.UUID, lastError *string) error
+	DeleteSource(ctx context.Context, id uuid.UUID) error
+
+	// Channels
+	GetChannel(ctx context.Context, id uuid.UUID) (*Channel, error)
+	ListChannels(ctx context.Context, includeDisabled bool) ([]Channel, error)
+	UpsertChannel(ctx context.Context, params UpsertChannelParams) (*Channel, error)
+	DeleteChannelsNotIn(ctx context.Context, sourceID uuid.UUID, streamURLs []string) (int64, error)
+	SetChannelEnabled(ctx context.Context, id uuid.UUID, enabled bool) (*Channel, error)
+	AssignChannelGuideID(ctx context.Context, guideID, name string) (int64, error)
+
+	// Programs
+	GetProgram(ctx context.Context, id uuid.UUID) (*Program, error)
+	UpsertProgram(ctx context.Context, params UpsertProgramParams) error
+	DeleteStalePrograms(ctx context.Context, sourceID uuid.UUID, refreshedBefore time.Time) (int64, error)
+	DeleteEndedPrograms(ctx context.Context, endedBefore time.Time) (int64, error)
+	ListGuide(ctx context.Context, guideIDs []string, start, end time.Time) ([]Program, error)
+	ListUpcomingAirings(ctx context.Context, title string, startsAfter time.Time, channelID *uuid.UUID) ([]Airing, error)
+
+	// Recordings
+	GetRecording(ctx context.Context, id uuid.UUID) (*Recording, error)
+	GetRecordingByProgram(ctx context.Context, programID uuid.UUID) (*Recording, error)
+	CreateRecording(ctx context.Context, params CreateRecordingParams) (*Recording, error)
+	ListRecordings(ctx context.Context, userID uuid.UUID, filters RecordingListFilters) ([]Recording, error)
+	CountRecordings(ctx context.Context, userID uuid.UUID, status *string) (int64, error)
+	ListScheduledRecordings(ctx context.Context, endsAfter time.Time) ([]Recording, error)
+	StartRecording(ctx context.Context, id uuid.UUID) (*Recording, error)
+	FinishRecording(ctx context.Context, params FinishRecordingParams) (*Recording, error)
+	CancelRecording(ctx context.Context, id uuid.UUID) (*Recording, error)
+	DeleteRecording(ctx context.Context, id uuid.UUID) error
+
+	// Series rules
+	GetSeriesRule(ctx context.Context, id uuid.UUID) (*SeriesRule, error)
+	ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]SeriesRule, error)
+	ListEnabledSeriesRules(ctx context.Context) ([]SeriesRule, error)
+	CreateSeriesRule(ctx context.Context, params CreateSeriesRuleParams) (*SeriesRule, error)
+	DeleteSeriesRule(ctx context.Context, id uuid.UUID) error
+	CancelRuleRecordings(ctx context.Context, ruleID uuid.UUID) (int64, error)
+}
+
+// CreateSourceParams contains parameters for adding a source
+type CreateSourceParams struct {
+	Name     string
+	Kind     string
+	Location string
+	Enabled  bool
+}
+
+// UpsertChannelParams contains parameters for storing a playlist entry
+type UpsertChannelParams struct {
+	SourceID   uuid.UUID
+	StreamURL  string
+	Name       string
+	Number     *string
+	GuideID    *string
+	LogoURL    *string
+	GroupTitle *string
+	Position   int32
+}
+
+// UpsertProgramParams contains parameters for storing a guide entry
+type UpsertProgramParams struct {
+	SourceID       uuid.UUID
+	GuideChannelID string
+	StartsAt       time.Time
+	EndsAt         time.Time
+	Title          string
+	Subtitle       *string
+	Description    *string
+	Categories     []string
+	SeasonNumber   *int32
+	EpisodeNumber  *int32
+	EpisodeLabel   *string
+	IconURL        *string
+	AirDate        *time.Time
+	IsNew          bool
+	RefreshedAt    time.Time
+}
+
+// CreateRecordingParams contains parameters for scheduling a recording
+type CreateRecordingParams struct {
+	UserID        uuid.UUID
+	LibraryID     uuid.UUID
+	ChannelID     *uuid.UUID
+	ProgramID     *uuid.UUID
+	SeriesRuleID  *uuid.UUID
+	ChannelName   string
+	Title         string
+	Subtitle      *string
+	Description   *string
+	SeasonNumber  *int32
+	EpisodeNumber *int32
+	StartsAt      time.Time
+	EndsAt        time.Time
+	PaddingBefore time.Duration
+	PaddingAfter  time.Duration
+}
+
+// FinishRecordingParams contains the outcome of a capture
+type FinishRecordingParams struct {
+	ID       uuid.UUID
+	Status   string
+	FilePath *string
+	FileSize int64
+	Error    *string
+}
+
+// CreateSeriesRuleParams contains parameters for adding a series rule
+type CreateSeriesRuleParams struct {
+	UserID        uuid.UUID
+	LibraryID     uuid.UUID
+	Title         string
+	ChannelID     *uuid.UUID
+	NewOnly       bool
+	PaddingBefore time.Duration
+	PaddingAfter  time.Duration
+}
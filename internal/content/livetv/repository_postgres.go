@@ -0,0 +1,639 @@
+package livetv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	livetvdb "github.com/lusoris/revenge/internal/content/livetv/db"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// uniqueViolation is the SQLSTATE of unique constraint violations.
+const uniqueViolation = "23505"
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *livetvdb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: livetvdb.New(pool),
+	}
+}
+
+// =============================================================================
+// Sources
+// =============================================================================
+
+func (r *postgresRepository) GetSource(ctx context.Context, id uuid.UUID) (*Source, error) {
+	s, err := r.queries.GetSource(ctx, id)
+	if err != nil {
+		return nil, sourceError(err)
+	}
+	return dbSourceToSource(s), nil
+}
+
+func (r *postgresRepository) ListSources(ctx context.Context) ([]Source, error) {
+	rows, err := r.queries.ListSources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sources: %w", err)
+	}
+	return dbSourcesToSources(rows), nil
+}
+
+func (r *postgresRepository) ListEnabledSources(ctx context.Context) ([]Source, error) {
+	rows, err := r.queries.ListEnabledSources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sources: %w", err)
+	}
+	return dbSourcesToSources(rows), nil
+}
+
+func (r *postgresRepository) CreateSource(ctx context.Context, params CreateSourceParams) (*Source, error) {
+	s, err := r.queries.CreateSource(ctx, livetvdb.CreateSourceParams{
+		Name:     params.Name,
+		Kind:     params.Kind,
+		Location: params.Location,
+		Enabled:  params.Enabled,
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrSourceExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source: %w", err)
+	}
+	return dbSourceToSource(s), nil
+}
+
+func (r *postgresRepository) MarkSourceRefreshed(ctx context.Context, id uuid.UUID, lastError *string) error {
+	return r.queries.MarkSourceRefreshed(ctx, livetvdb.MarkSourceRefreshedParams{ID: id, LastError: lastError})
+}
+
+func (r *postgresRepository) DeleteSource(ctx context.Context, id uuid.UUID) error {
+	n, err := r.queries.DeleteSource(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete source: %w", err)
+	}
+	if n == 0 {
+		return ErrSourceNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Channels
+// =============================================================================
+
+func (r *postgresRepository) GetChannel(ctx context.Context, id uuid.UUID) (*Channel, error) {
+	c, err := r.queries.GetChannel(ctx, id)
+	if err != nil {
+		return nil, channelError(err)
+	}
+	return dbChannelToChannel(c), nil
+}
+
+func (r *postgresRepository) ListChannels(ctx context.Context, includeDisabled bool) ([]Channel, error) {
+	rows, err := r.queries.ListChannels(ctx, includeDisabled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list channels: %w", err)
+	}
+	channels := make([]Channel, len(rows))
+	for i, c := range rows {
+		channels[i] = *dbChannelToChannel(c)
+	}
+	return channels, nil
+}
+
+func (r *postgresRepository) UpsertChannel(ctx context.Context, params UpsertChannelParams) (*Channel, error) {
+	c, err := r.queries.UpsertChannel(ctx, livetvdb.UpsertChannelParams{
+		SourceID:   params.SourceID,
+		StreamUrl:  params.StreamURL,
+		Name:       params.Name,
+		Number:     params.Number,
+		GuideID:    params.GuideID,
+		LogoUrl:    params.LogoURL,
+		GroupTitle: params.GroupTitle,
+		Position:   params.Position,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store channel: %w", err)
+	}
+	return dbChannelToChannel(c), nil
+}
+
+func (r *postgresRepository) DeleteChannelsNotIn(ctx context.Context, sourceID uuid.UUID, streamURLs []string) (int64, error) {
+	n, err := r.queries.DeleteChannelsNotIn(ctx, livetvdb.DeleteChannelsNotInParams{
+		SourceID:   sourceID,
+		StreamUrls: nonNilStrings(streamURLs),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete channels: %w", err)
+	}
+	return n, nil
+}
+
+func (r *postgresRepository) SetChannelEnabled(ctx context.Context, id uuid.UUID, enabled bool) (*Channel, error) {
+	c, err := r.queries.SetChannelEnabled(ctx, livetvdb.SetChannelEnabledParams{ID: id, Enabled: enabled})
+	if err != nil {
+		return nil, channelError(err)
+	}
+	return dbChannelToChannel(c), nil
+}
+
+func (r *postgresRepository) AssignChannelGuideID(ctx context.Context, guideID, name string) (int64, error) {
+	n, err := r.queries.AssignChannelGuideID(ctx, livetvdb.AssignChannelGuideIDParams{GuideID: &guideID, Name: name})
+	if err != nil {
+		return 0, fmt.Errorf("failed to assign guide id: %w", err)
+	}
+	return n, nil
+}
+
+// =============================================================================
+// Programs
+// =============================================================================
+
+func (r *postgresRepository) GetProgram(ctx context.Context, id uuid.UUID) (*Program, error) {
+	p, err := r.queries.GetProgram(ctx, id)
+	if err != nil {
+		return nil, programError(err)
+	}
+	return dbProgramToProgram(p), nil
+}
+
+func (r *postgresRepository) UpsertProgram(ctx context.Context, params UpsertProgramParams) error {
+	err := r.queries.UpsertProgram(ctx, livetvdb.UpsertProgramParams{
+		SourceID:       params.SourceID,
+		GuideChannelID: params.GuideChannelID,
+		StartsAt:       params.StartsAt,
+		EndsAt:         params.EndsAt,
+		Title:          params.Title,
+		Subtitle:       params.Subtitle,
+		Description:    params.Description,
+		Categories:     nonNilStrings(params.Categories),
+		SeasonNumber:   params.SeasonNumber,
+		EpisodeNumber:  params.EpisodeNumber,
+		EpisodeLabel:   params.EpisodeLabel,
+		IconUrl:        params.IconURL,
+		AirDate:        dateToPg(params.AirDate),
+		IsNew:          params.IsNew,
+		RefreshedAt:    params.RefreshedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store program: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresRepository) DeleteStalePrograms(ctx context.Context, sourceID uuid.UUID, refreshedBefore time.Time) (int64, error) {
+	n, err := r.queries.DeleteStalePrograms(ctx, livetvdb.DeleteStaleProgramsParams{
+		SourceID:    sourceID,
+		RefreshedAt: refreshedBefore,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale programs: %w", err)
+	}
+	return n, nil
+}
+
+func (r *postgresRepository) DeleteEndedPrograms(ctx context.Context, endedBefore time.Time) (int64, error) {
+	n, err := r.queries.DeleteEndedPrograms(ctx, endedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ended programs: %w", err)
+	}
+	return n, nil
+}
+
+func (r *postgresRepository) ListGuide(ctx context.Context, guideIDs []string, start, end time.Time) ([]Program, error) {
+	rows, err := r.queries.ListGuide(ctx, livetvdb.ListGuideParams{
+		GuideIds:    nonNilStrings(guideIDs),
+		StartsAfter: start,
+		EndsBefore:  end,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guide: %w", err)
+	}
+	programs := make([]Program, len(rows))
+	for i, p := range rows {
+		programs[i] = *dbProgramToProgram(p)
+	}
+	return programs, nil
+}
+
+func (r *postgresRepository) ListUpcomingAirings(ctx context.Context, title string, startsAfter time.Time, channelID *uuid.UUID) ([]Airing, error) {
+	rows, err := r.queries.ListUpcomingAirings(ctx, livetvdb.ListUpcomingAiringsParams{
+		Title:       title,
+		StartsAfter: startsAfter,
+		ChannelID:   uuidToPg(channelID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list airings: %w", err)
+	}
+	airings := make([]Airing, len(rows))
+	for i, row := range rows {
+		airings[i] = Airing{
+			Program:     *dbProgramToProgram(row.LivetvProgram),
+			ChannelID:   row.ChannelID,
+			ChannelName: row.ChannelName,
+		}
+	}
+	return airings, nil
+}
+
+// =============================================================================
+// Recordings
+// =============================================================================
+
+func (r *postgresRepository) GetRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	rec, err := r.queries.GetRecording(ctx, id)
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return dbRecordingToRecording(rec), nil
+}
+
+func (r *postgresRepository) GetRecordingByProgram(ctx context.Context, programID uuid.UUID) (*Recording, error) {
+	rec, err := r.queries.GetRecordingByProgram(ctx, uuidToPg(&programID))
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return dbRecordingToRecording(rec), nil
+}
+
+func (r *postgresRepository) CreateRecording(ctx context.Context, params CreateRecordingParams) (*Recording, error) {
+	rec, err := r.queries.CreateRecording(ctx, livetvdb.CreateRecordingParams{
+		UserID:               params.UserID,
+		LibraryID:            params.LibraryID,
+		ChannelID:            uuidToPg(params.ChannelID),
+		ProgramID:            uuidToPg(params.ProgramID),
+		SeriesRuleID:         uuidToPg(params.SeriesRuleID),
+		ChannelName:          params.ChannelName,
+		Title:                params.Title,
+		Subtitle:             params.Subtitle,
+		Description:          params.Description,
+		SeasonNumber:         params.SeasonNumber,
+		EpisodeNumber:        params.EpisodeNumber,
+		StartsAt:             params.StartsAt,
+		EndsAt:               params.EndsAt,
+		PaddingBeforeSeconds: durationToSeconds(params.PaddingBefore),
+		PaddingAfterSeconds:  durationToSeconds(params.PaddingAfter),
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyScheduled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+	return dbRecordingToRecording(rec), nil
+}
+
+func (r *postgresRepository) ListRecordings(ctx context.Context, userID uuid.UUID, filters RecordingListFilters) ([]Recording, error) {
+	rows, err := r.queries.ListRecordings(ctx, livetvdb.ListRecordingsParams{
+		UserID: userID,
+		Limit:  filters.Limit,
+		Offset: filters.Offset,
+		Status: filters.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recordings: %w", err)
+	}
+	return dbRecordingsToRecordings(rows), nil
+}
+
+func (r *postgresRepository) CountRecordings(ctx context.Context, userID uuid.UUID, status *string) (int64, error) {
+	return r.queries.CountRecordings(ctx, livetvdb.CountRecordingsParams{UserID: userID, Status: status})
+}
+
+func (r *postgresRepository) ListScheduledRecordings(ctx context.Context, endsAfter time.Time) ([]Recording, error) {
+	rows, err := r.queries.ListScheduledRecordings(ctx, endsAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled recordings: %w", err)
+	}
+	return dbRecordingsToRecordings(rows), nil
+}
+
+func (r *postgresRepository) StartRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	rec, err := r.queries.StartRecording(ctx, id)
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return dbRecordingToRecording(rec), nil
+}
+
+func (r *postgresRepository) FinishRecording(ctx context.Context, params FinishRecordingParams) (*Recording, error) {
+	rec, err := r.queries.FinishRecording(ctx, livetvdb.FinishRecordingParams{
+		ID:       params.ID,
+		Status:   params.Status,
+		FilePath: params.FilePath,
+		FileSize: params.FileSize,
+		Error:    params.Error,
+	})
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return dbRecordingToRecording(rec), nil
+}
+
+func (r *postgresRepository) CancelRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	rec, err := r.queries.CancelRecording(ctx, id)
+	if err != nil {
+		return nil, recordingError(err)
+	}
+	return dbRecordingToRecording(rec), nil
+}
+
+func (r *postgresRepository) DeleteRecording(ctx context.Context, id uuid.UUID) error {
+	n, err := r.queries.DeleteRecording(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete recording: %w", err)
+	}
+	if n == 0 {
+		return ErrRecordingNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Series Rules
+// =============================================================================
+
+func (r *postgresRepository) GetSeriesRule(ctx context.Context, id uuid.UUID) (*SeriesRule, error) {
+	rule, err := r.queries.GetSeriesRule(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSeriesRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get series rule: %w", err)
+	}
+	return dbSeriesRuleToSeriesRule(rule), nil
+}
+
+func (r *postgresRepository) ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]SeriesRule, error) {
+	rows, err := r.queries.ListSeriesRules(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series rules: %w", err)
+	}
+	return dbSeriesRulesToSeriesRules(rows), nil
+}
+
+func (r *postgresRepository) ListEnabledSeriesRules(ctx context.Context) ([]SeriesRule, error) {
+	rows, err := r.queries.ListEnabledSeriesRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series rules: %w", err)
+	}
+	return dbSeriesRulesToSeriesRules(rows), nil
+}
+
+func (r *postgresRepository) CreateSeriesRule(ctx context.Context, params CreateSeriesRuleParams) (*SeriesRule, error) {
+	rule, err := r.queries.CreateSeriesRule(ctx, livetvdb.CreateSeriesRuleParams{
+		UserID:               params.UserID,
+		LibraryID:            params.LibraryID,
+		Title:                params.Title,
+		ChannelID:            uuidToPg(params.ChannelID),
+		NewOnly:              params.NewOnly,
+		PaddingBeforeSeconds: durationToSeconds(params.PaddingBefore),
+		PaddingAfterSeconds:  durationToSeconds(params.PaddingAfter),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create series rule: %w", err)
+	}
+	return dbSeriesRuleToSeriesRule(rule), nil
+}
+
+func (r *postgresRepository) DeleteSeriesRule(ctx context.Context, id uuid.UUID) error {
+	n, err := r.queries.DeleteSeriesRule(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete series rule: %w", err)
+	}
+	if n == 0 {
+		return ErrSeriesRuleNotFound
+	}
+	return nil
+}
+
+func (r *postgresRepository) CancelRuleRecordings(ctx context.Context, ruleID uuid.UUID) (int64, error) {
+	n, err := r.queries.CancelRuleRecordings(ctx, uuidToPg(&ruleID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel recordings: %w", err)
+	}
+	return n, nil
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func sourceError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrSourceNotFound
+	}
+	return fmt.Errorf("failed to get source: %w", err)
+}
+
+func channelError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrChannelNotFound
+	}
+	return fmt.Errorf("failed to get channel: %w", err)
+}
+
+func programError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrProgramNotFound
+	}
+	return fmt.Errorf("failed to get program: %w", err)
+}
+
+func recordingError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrRecordingNotFound
+	}
+	return fmt.Errorf("failed to get recording: %w", err)
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+func dbSourceToSource(s livetvdb.LivetvSource) *Source {
+	return &Source{
+		ID:              s.ID,
+		Name:            s.Name,
+		Kind:            s.Kind,
+		Location:        s.Location,
+		Enabled:         s.Enabled,
+		LastRefreshedAt: pgToTime(s.LastRefreshedAt),
+		LastError:       s.LastError,
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
+	}
+}
+
+func dbSourcesToSources(rows []livetvdb.LivetvSource) []Source {
+	sources := make([]Source, len(rows))
+	for i, s := range rows {
+		sources[i] = *dbSourceToSource(s)
+	}
+	return sources
+}
+
+func dbChannelToChannel(c livetvdb.LivetvChannel) *Channel {
+	return &Channel{
+		ID:         c.ID,
+		SourceID:   c.SourceID,
+		StreamURL:  c.StreamUrl,
+		Name:       c.Name,
+		Number:     c.Number,
+		GuideID:    c.GuideID,
+		LogoURL:    c.LogoUrl,
+		GroupTitle: c.GroupTitle,
+		Position:   c.Position,
+		Enabled:    c.Enabled,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func dbProgramToProgram(p livetvdb.LivetvProgram) *Program {
+	return &Program{
+		ID:             p.ID,
+		SourceID:       p.SourceID,
+		GuideChannelID: p.GuideChannelID,
+		StartsAt:       p.StartsAt,
+		EndsAt:         p.EndsAt,
+		Title:          p.Title,
+		Subtitle:       p.Subtitle,
+		Description:    p.Description,
+		Categories:     p.Categories,
+		SeasonNumber:   p.SeasonNumber,
+		EpisodeNumber:  p.EpisodeNumber,
+		EpisodeLabel:   p.EpisodeLabel,
+		IconURL:        p.IconUrl,
+		AirDate:        pgToDate(p.AirDate),
+		IsNew:          p.IsNew,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
+
+func dbRecordingToRecording(r livetvdb.LivetvRecording) *Recording {
+	return &Recording{
+		ID:            r.ID,
+		UserID:        r.UserID,
+		LibraryID:     r.LibraryID,
+		ChannelID:     pgToUUID(r.ChannelID),
+		ProgramID:     pgToUUID(r.ProgramID),
+		SeriesRuleID:  pgToUUID(r.SeriesRuleID),
+		ChannelName:   r.ChannelName,
+		Title:         r.Title,
+		Subtitle:      r.Subtitle,
+		Description:   r.Description,
+		SeasonNumber:  r.SeasonNumber,
+		EpisodeNumber: r.EpisodeNumber,
+		StartsAt:      r.StartsAt,
+		EndsAt:        r.EndsAt,
+		PaddingBefore: secondsToDuration(r.PaddingBeforeSeconds),
+		PaddingAfter:  secondsToDuration(r.PaddingAfterSeconds),
+		Status:        r.Status,
+		FilePath:      r.FilePath,
+		FileSize:      r.FileSize,
+		Error:         r.Error,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+func dbRecordingsToRecordings(rows []livetvdb.LivetvRecording) []Recording {
+	recordings := make([]Recording, len(rows))
+	for i, r := range rows {
+		recordings[i] = *dbRecordingToRecording(r)
+	}
+	return recordings
+}
+
+func dbSeriesRuleToSeriesRule(r livetvdb.LivetvSeriesRule) *SeriesRule {
+	return &SeriesRule{
+		ID:            r.ID,
+		UserID:        r.UserID,
+		LibraryID:     r.LibraryID,
+		Title:         r.Title,
+		ChannelID:     pgToUUID(r.ChannelID),
+		NewOnly:       r.NewOnly,
+		PaddingBefore: secondsToDuration(r.PaddingBeforeSeconds),
+		PaddingAfter:  secondsToDuration(r.PaddingAfterSeconds),
+		Enabled:       r.Enabled,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+func dbSeriesRulesToSeriesRules(rows []livetvdb.LivetvSeriesRule) []SeriesRule {
+	rules := make([]SeriesRule, len(rows))
+	for i, r := range rows {
+		rules[i] = *dbSeriesRuleToSeriesRule(r)
+	}
+	return rules
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func pgToTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func dateToPg(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *t, Valid: true}
+}
+
+func pgToDate(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+func durationToSeconds(d time.Duration) int32 {
+	return util.SafeInt64ToInt32(int64(max(d, 0) / time.Second))
+}
+
+func secondsToDuration(seconds int32) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,871 @@
+package livetv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/livetv/guide"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Limits of scheduled recordings and guide queries.
+const (
+	MaxPadding         = 2 * time.Hour
+	MaxRecordingLength = 12 * time.Hour
+	MaxGuideWindow     = 14 * 24 * time.Hour
+
+	// endedProgramRetention is how long aired programs stay in the guide.
+	endedProgramRetention = 24 * time.Hour
+
+	maxFileNameBytes = 200
+)
+
+// ErrInvalidGuideWindow is returned for guide queries whose end is not
+// after their start or that span more than MaxGuideWindow.
+var ErrInvalidGuideWindow = errors.New("invalid guide window")
+
+// LibraryProvider looks up the TV libraries recordings are stored in.
+// *library.Service implements it.
+type LibraryProvider interface {
+	Get(ctx context.Context, id uuid.UUID) (*library.Library, error)
+	ListByType(ctx context.Context, libType string) ([]library.Library, error)
+}
+
+// Config holds live TV service settings.
+type Config struct {
+	// DefaultPaddingBefore and DefaultPaddingAfter extend recordings that
+	// don't set their own padding, since broadcasts rarely keep to the
+	// guide's minute.
+	DefaultPaddingBefore time.Duration
+	DefaultPaddingAfter  time.Duration
+}
+
+// RecordingRequest describes a recording to schedule: a guide program, or
+// a channel and time window.
+type RecordingRequest struct {
+	ProgramID *uuid.UUID
+	// ChannelID is required without a program. With one, it picks among
+	// the channels carrying the program's guide channel.
+	ChannelID *uuid.UUID
+	Title     string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	// Nil paddings use the configured defaults.
+	PaddingBefore *time.Duration
+	PaddingAfter  *time.Duration
+	// Nil records into the first TV library.
+	LibraryID *uuid.UUID
+}
+
+// SeriesRuleRequest describes a series rule to add.
+type SeriesRuleRequest struct {
+	Title         string
+	ChannelID     *uuid.UUID // nil matches any channel
+	NewOnly       bool
+	PaddingBefore *time.Duration
+	PaddingAfter  *time.Duration
+	LibraryID     *uuid.UUID
+}
+
+// Service defines business logic for live TV
+type Service interface {
+	// Sources
+	ListSources(ctx context.Context) ([]Source, error)
+	ListEnabledSources(ctx context.Context) ([]Source, error)
+	GetSource(ctx context.Context, id uuid.UUID) (*Source, error)
+	AddSource(ctx context.Context, params CreateSourceParams) (*Source, error)
+	DeleteSource(ctx context.Context, id uuid.UUID) error
+	RefreshSource(ctx context.Context, id uuid.UUID) (*RefreshResult, error)
+
+	// Channels and guide
+	ListChannels(ctx context.Context, includeDisabled bool) ([]Channel, error)
+	GetChannel(ctx context.Context, id uuid.UUID) (*Channel, error)
+	SetChannelEnabled(ctx context.Context, id uuid.UUID, enabled bool) (*Channel, error)
+	GetProgram(ctx context.Context, id uuid.UUID) (*Program, error)
+	Guide(ctx context.Context, start, end time.Time, channelID *uuid.UUID) ([]ChannelGuide, error)
+
+	// Recordings
+	ScheduleRecording(ctx context.Context, userID uuid.UUID, req RecordingRequest) (*Recording, error)
+	GetRecording(ctx context.Context, id uuid.UUID) (*Recording, error)
+	ListRecordings(ctx context.Context, userID uuid.UUID, filters RecordingListFilters) ([]Recording, int64, error)
+	ListPendingRecordings(ctx context.Context) ([]Recording, error)
+	DeleteRecording(ctx context.Context, id uuid.UUID) error
+	Record(ctx context.Context, id uuid.UUID) (*Recording, error)
+
+	// Series rules
+	ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]SeriesRule, error)
+	GetSeriesRule(ctx context.Context, id uuid.UUID) (*SeriesRule, error)
+	CreateSeriesRule(ctx context.Context, userID uuid.UUID, req SeriesRuleRequest) (*SeriesRule, error)
+	DeleteSeriesRule(ctx context.Context, id uuid.UUID) error
+	ApplySeriesRules(ctx context.Context) ([]Recording, error)
+}
+
+// liveTVService implements the Service interface
+type liveTVService struct {
+	repo      Repository
+	libraries LibraryProvider
+	client    *guide.Client
+	recorder  *Recorder
+	cfg       Config
+	logger    *slog.Logger
+}
+
+// NewService creates a new live TV service
+func NewService(repo Repository, libraries LibraryProvider, client *guide.Client, recorder *Recorder, cfg Config, logger *slog.Logger) Service {
+	if client == nil {
+		client = guide.NewClient(nil)
+	}
+	if recorder == nil {
+		recorder = NewRecorder(nil)
+	}
+	return &liveTVService{
+		repo:      repo,
+		libraries: libraries,
+		client:    client,
+		recorder:  recorder,
+		cfg:       cfg,
+		logger:    logger.With("component", "livetv"),
+	}
+}
+
+// =============================================================================
+// Sources
+// =============================================================================
+
+func (s *liveTVService) ListSources(ctx context.Context) ([]Source, error) {
+	return s.repo.ListSources(ctx)
+}
+
+// ListEnabledSources returns the enabled sources, playlists before guides,
+// so that a refresh of all sources links new channels to the guide.
+func (s *liveTVService) ListEnabledSources(ctx context.Context) ([]Source, error) {
+	sources, err := s.repo.ListEnabledSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ordered := make([]Source, 0, len(sources))
+	for _, kind := range []string{SourceKindM3U, SourceKindXMLTV} {
+		for _, src := range sources {
+			if src.Kind == kind {
+				ordered = append(ordered, src)
+			}
+		}
+	}
+	return ordered, nil
+}
+
+func (s *liveTVService) GetSource(ctx context.Context, id uuid.UUID) (*Source, error) {
+	return s.repo.GetSource(ctx, id)
+}
+
+// AddSource adds a playlist or guide. Locations are http(s) URLs or
+// absolute local paths. The source is loaded by its first refresh.
+func (s *liveTVService) AddSource(ctx context.Context, params CreateSourceParams) (*Source, error) {
+	params.Name = strings.TrimSpace(params.Name)
+	params.Location = strings.TrimSpace(params.Location)
+	if params.Kind != SourceKindM3U && params.Kind != SourceKindXMLTV {
+		return nil, fmt.Errorf("%w: unknown kind %q", ErrInvalidSource, params.Kind)
+	}
+	if !guide.IsURL(params.Location) && !filepath.IsAbs(guide.LocalPath(params.Location)) {
+		return nil, fmt.Errorf("%w: location must be an http(s) URL or an absolute path", ErrInvalidSource)
+	}
+	if params.Name == "" {
+		params.Name = params.Location
+	}
+	return s.repo.CreateSource(ctx, params)
+}
+
+// DeleteSource removes a source with its channels or programs. Recordings
+// of its channels are kept.
+func (s *liveTVService) DeleteSource(ctx context.Context, id uuid.UUID) error {
+	return s.repo.DeleteSource(ctx, id)
+}
+
+// RefreshSource loads a source and stores its channels or programs.
+// Failed refreshes are recorded on the source and leave its channels and
+// programs as they were.
+func (s *liveTVService) RefreshSource(ctx context.Context, id uuid.UUID) (*RefreshResult, error) {
+	src, err := s.repo.GetSource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stored timestamps have microsecond precision
+	started := time.Now().Truncate(time.Microsecond)
+	var res *RefreshResult
+	switch src.Kind {
+	case SourceKindM3U:
+		res, err = s.refreshPlaylist(ctx, src)
+	case SourceKindXMLTV:
+		res, err = s.refreshGuide(ctx, src, started)
+	default:
+		err = fmt.Errorf("%w: unknown kind %q", ErrInvalidSource, src.Kind)
+	}
+	if err != nil {
+		msg := err.Error()
+		if markErr := s.repo.MarkSourceRefreshed(context.WithoutCancel(ctx), src.ID, &msg); markErr != nil {
+			s.logger.Warn("failed to record refresh error", slog.String("source_id", src.ID.String()), slog.Any("error", markErr))
+		}
+		return nil, fmt.Errorf("refresh %s: %w", src.Name, err)
+	}
+	if err := s.repo.MarkSourceRefreshed(ctx, src.ID, nil); err != nil {
+		return nil, err
+	}
+	src.LastRefreshedAt = &started
+	src.LastError = nil
+	res.Source = src
+	return res, nil
+}
+
+// refreshPlaylist stores the channels of a playlist and removes the ones
+// it no longer lists. An empty playlist is an error rather than a reason
+// to drop every channel, since tuners answer with one while rebooting.
+func (s *liveTVService) refreshPlaylist(ctx context.Context, src *Source) (*RefreshResult, error) {
+	entries, err := s.client.Playlist(ctx, src.Location)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, ErrEmptyPlaylist
+	}
+
+	seen := make(map[string]bool, len(entries))
+	streamURLs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if seen[e.StreamURL] {
+			continue
+		}
+		seen[e.StreamURL] = true
+		if _, err := s.repo.UpsertChannel(ctx, UpsertChannelParams{
+			SourceID:   src.ID,
+			StreamURL:  e.StreamURL,
+			Name:       e.Name,
+			Number:     optionalString(e.Number),
+			GuideID:    optionalString(e.GuideID),
+			LogoURL:    optionalString(e.LogoURL),
+			GroupTitle: optionalString(e.Group),
+			Position:   util.SafeIntToInt32(len(streamURLs)),
+		}); err != nil {
+			return nil, err
+		}
+		streamURLs = append(streamURLs, e.StreamURL)
+	}
+	removed, err := s.repo.DeleteChannelsNotIn(ctx, src.ID, streamURLs)
+	if err != nil {
+		return nil, err
+	}
+	return &RefreshResult{Channels: len(streamURLs), Removed: removed}, nil
+}
+
+// refreshGuide stores the programs of a guide and removes the ones an
+// earlier refresh stored but this one didn't see. Channels without a
+// tvg-id are linked to the guide channel with their name.
+func (s *liveTVService) refreshGuide(ctx context.Context, src *Source, started time.Time) (*RefreshResult, error) {
+	g, err := s.client.Guide(ctx, src.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range g.Programs {
+		if err := s.repo.UpsertProgram(ctx, programParams(src.ID, p, started)); err != nil {
+			return nil, err
+		}
+	}
+	for _, ch := range g.Channels {
+		for _, name := range ch.DisplayNames {
+			if _, err := s.repo.AssignChannelGuideID(ctx, ch.ID, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	removed, err := s.repo.DeleteStalePrograms(ctx, src.ID, started)
+	if err != nil {
+		return nil, err
+	}
+	ended, err := s.repo.DeleteEndedPrograms(ctx, started.Add(-endedProgramRetention))
+	if err != nil {
+		return nil, err
+	}
+	return &RefreshResult{Programs: len(g.Programs), Removed: removed + ended}, nil
+}
+
+// =============================================================================
+// Channels and Guide
+// =============================================================================
+
+func (s *liveTVService) ListChannels(ctx context.Context, includeDisabled bool) ([]Channel, error) {
+	return s.repo.ListChannels(ctx, includeDisabled)
+}
+
+func (s *liveTVService) GetChannel(ctx context.Context, id uuid.UUID) (*Channel, error) {
+	return s.repo.GetChannel(ctx, id)
+}
+
+func (s *liveTVService) SetChannelEnabled(ctx context.Context, id uuid.UUID, enabled bool) (*Channel, error) {
+	return s.repo.SetChannelEnabled(ctx, id, enabled)
+}
+
+func (s *liveTVService) GetProgram(ctx context.Context, id uuid.UUID) (*Program, error) {
+	return s.repo.GetProgram(ctx, id)
+}
+
+// Guide returns the enabled channels, or one channel, with the programs
+// overlapping [start, end).
+func (s *liveTVService) Guide(ctx context.Context, start, end time.Time, channelID *uuid.UUID) ([]ChannelGuide, error) {
+	if !end.After(start) || end.Sub(start) > MaxGuideWindow {
+		return nil, ErrInvalidGuideWindow
+	}
+
+	var channels []Channel
+	if channelID != nil {
+		ch, err := s.repo.GetChannel(ctx, *channelID)
+		if err != nil {
+			return nil, err
+		}
+		channels = []Channel{*ch}
+	} else {
+		var err error
+		channels, err = s.repo.ListChannels(ctx, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	guideIDs := make([]string, 0, len(channels))
+	for _, ch := range channels {
+		if ch.GuideID != nil {
+			guideIDs = append(guideIDs, *ch.GuideID)
+		}
+	}
+	byGuideID := make(map[string][]Program)
+	if len(guideIDs) > 0 {
+		programs, err := s.repo.ListGuide(ctx, guideIDs, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range programs {
+			byGuideID[p.GuideChannelID] = append(byGuideID[p.GuideChannelID], p)
+		}
+	}
+
+	out := make([]ChannelGuide, len(channels))
+	for i, ch := range channels {
+		out[i] = ChannelGuide{Channel: ch, Programs: []Program{}}
+		if ch.GuideID != nil && byGuideID[*ch.GuideID] != nil {
+			out[i].Programs = byGuideID[*ch.GuideID]
+		}
+	}
+	return out, nil
+}
+
+// =============================================================================
+// Recordings
+// =============================================================================
+
+// ScheduleRecording schedules a recording of a guide program or of a
+// channel's time window. A program is recorded once; scheduling it again
+// fails with ErrAlreadyScheduled.
+func (s *liveTVService) ScheduleRecording(ctx context.Context, userID uuid.UUID, req RecordingRequest) (*Recording, error) {
+	lib, err := s.recordingLibrary(ctx, req.LibraryID)
+	if err != nil {
+		return nil, err
+	}
+	before, after, err := s.paddings(req.PaddingBefore, req.PaddingAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	params := CreateRecordingParams{
+		UserID:        userID,
+		LibraryID:     lib.ID,
+		PaddingBefore: before,
+		PaddingAfter:  after,
+	}
+	if req.ProgramID != nil {
+		p, err := s.repo.GetProgram(ctx, *req.ProgramID)
+		if err != nil {
+			return nil, err
+		}
+		ch, err := s.programChannel(ctx, p, req.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+		fillFromProgram(&params, p, ch.ID, ch.Name)
+	} else {
+		if req.ChannelID == nil {
+			return nil, fmt.Errorf("%w: a program or channel is required", ErrInvalidRecording)
+		}
+		ch, err := s.repo.GetChannel(ctx, *req.ChannelID)
+		if err != nil {
+			return nil, err
+		}
+		params.ChannelID = &ch.ID
+		params.ChannelName = ch.Name
+		params.Title = strings.TrimSpace(req.Title)
+		params.StartsAt = req.StartsAt
+		params.EndsAt = req.EndsAt
+		if params.Title == "" {
+			return nil, fmt.Errorf("%w: title is required", ErrInvalidRecording)
+		}
+	}
+
+	if !params.EndsAt.After(params.StartsAt) || params.EndsAt.Sub(params.StartsAt) > MaxRecordingLength {
+		return nil, fmt.Errorf("%w: recordings last up to %s", ErrInvalidRecording, MaxRecordingLength)
+	}
+	if !params.EndsAt.Add(after).After(time.Now()) {
+		return nil, fmt.Errorf("%w: the airing has ended", ErrInvalidRecording)
+	}
+	return s.repo.CreateRecording(ctx, params)
+}
+
+func (s *liveTVService) GetRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	return s.repo.GetRecording(ctx, id)
+}
+
+func (s *liveTVService) ListRecordings(ctx context.Context, userID uuid.UUID, filters RecordingListFilters) ([]Recording, int64, error) {
+	recordings, err := s.repo.ListRecordings(ctx, userID, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountRecordings(ctx, userID, filters.Status)
+	if err != nil {
+		return nil, 0, err
+	}
+	return recordings, count, nil
+}
+
+// ListPendingRecordings returns the scheduled recordings whose airing has
+// not ended, soonest first.
+func (s *liveTVService) ListPendingRecordings(ctx context.Context) ([]Recording, error) {
+	return s.repo.ListScheduledRecordings(ctx, time.Now())
+}
+
+// DeleteRecording cancels a scheduled recording, or removes a finished or
+// cancelled one from the list. Recorded files stay in the TV library.
+// Running recordings can't be deleted.
+func (s *liveTVService) DeleteRecording(ctx context.Context, id uuid.UUID) error {
+	rec, err := s.repo.GetRecording(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch rec.Status {
+	case RecordingStatusScheduled:
+		_, err := s.repo.CancelRecording(ctx, id)
+		if errors.Is(err, ErrRecordingNotFound) {
+			// Started meanwhile
+			return ErrRecordingInProgress
+		}
+		return err
+	case RecordingStatusRecording:
+		return ErrRecordingInProgress
+	default:
+		return s.repo.DeleteRecording(ctx, id)
+	}
+}
+
+// Record captures a recording's channel into its TV library. It is called
+// when the capture is due and returns when it ended. Recordings that
+// finished or were cancelled are returned as they are; those whose airing
+// has ended fail with ErrRecordingMissed. An interrupted capture returns
+// the context error and leaves the recording running, so that calling
+// Record again appends to its file.
+func (s *liveTVService) Record(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	rec, err := s.repo.GetRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Status != RecordingStatusScheduled && rec.Status != RecordingStatusRecording {
+		return rec, nil
+	}
+	if !time.Now().Before(rec.CaptureEnd()) {
+		return s.failRecording(ctx, rec, ErrRecordingMissed)
+	}
+	if rec.ChannelID == nil {
+		return s.failRecording(ctx, rec, ErrChannelNotFound)
+	}
+	ch, err := s.repo.GetChannel(ctx, *rec.ChannelID)
+	if errors.Is(err, ErrChannelNotFound) {
+		return s.failRecording(ctx, rec, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	lib, err := s.libraries.Get(ctx, rec.LibraryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lib.Paths) == 0 {
+		return s.failRecording(ctx, rec, ErrNoRecordingDirectory)
+	}
+
+	started, err := s.repo.StartRecording(ctx, rec.ID)
+	if errors.Is(err, ErrRecordingNotFound) {
+		// Cancelled meanwhile
+		return s.repo.GetRecording(ctx, rec.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rec = started
+
+	target := RecordingPath(lib.Paths[0], rec)
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return s.failRecording(ctx, rec, fmt.Errorf("create recording directory: %w", err))
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640) // #nosec G304 -- path is built from the library path
+	if err != nil {
+		return s.failRecording(ctx, rec, fmt.Errorf("create recording file: %w", err))
+	}
+
+	s.logger.Info("recording started",
+		slog.String("recording_id", rec.ID.String()),
+		slog.String("channel", ch.Name),
+		slog.String("path", target),
+		slog.Time("until", rec.CaptureEnd()),
+	)
+	_, captureErr := s.recorder.Capture(ctx, ch.StreamURL, rec.CaptureEnd(), f)
+	if closeErr := f.Close(); captureErr == nil {
+		captureErr = closeErr
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	var size int64
+	if info, err := os.Stat(target); err == nil {
+		size = info.Size()
+	}
+	if size == 0 {
+		removeFile(target)
+		return s.failRecording(ctx, rec, captureErr)
+	}
+	params := FinishRecordingParams{
+		ID:       rec.ID,
+		Status:   RecordingStatusCompleted,
+		FilePath: &target,
+		FileSize: size,
+	}
+	if captureErr != nil {
+		// Keep what was captured before the failure
+		msg := captureErr.Error()
+		params.Error = &msg
+	}
+	return s.repo.FinishRecording(ctx, params)
+}
+
+// failRecording marks a recording failed with the given error.
+func (s *liveTVService) failRecording(ctx context.Context, rec *Recording, cause error) (*Recording, error) {
+	if cause == nil {
+		cause = errors.New("recording failed")
+	}
+	msg := cause.Error()
+	failed, err := s.repo.FinishRecording(context.WithoutCancel(ctx), FinishRecordingParams{
+		ID:     rec.ID,
+		Status: RecordingStatusFailed,
+		Error:  &msg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Warn("recording failed",
+		slog.String("recording_id", rec.ID.String()),
+		slog.String("title", rec.Title),
+		slog.Any("error", cause),
+	)
+	return failed, cause
+}
+
+// programChannel returns the channel to record a program from: the given
+// channel if it carries the program's guide channel, else the first
+// enabled one that does.
+func (s *liveTVService) programChannel(ctx context.Context, p *Program, channelID *uuid.UUID) (*Channel, error) {
+	if channelID != nil {
+		ch, err := s.repo.GetChannel(ctx, *channelID)
+		if err != nil {
+			return nil, err
+		}
+		if ch.GuideID == nil || *ch.GuideID != p.GuideChannelID {
+			return nil, fmt.Errorf("%w: the channel doesn't carry the program", ErrInvalidRecording)
+		}
+		return ch, nil
+	}
+	channels, err := s.repo.ListChannels(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, ch := range channels {
+		if ch.GuideID != nil && *ch.GuideID == p.GuideChannelID {
+			return &ch, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no channel carries the program", ErrChannelNotFound)
+}
+
+// recordingLibrary returns the TV library to record into: the given one,
+// else the first TV library.
+func (s *liveTVService) recordingLibrary(ctx context.Context, libraryID *uuid.UUID) (*library.Library, error) {
+	var lib *library.Library
+	if libraryID != nil {
+		var err error
+		lib, err = s.libraries.Get(ctx, *libraryID)
+		if err != nil {
+			return nil, err
+		}
+		if lib.Type != library.LibraryTypeTVShow {
+			return nil, fmt.Errorf("%w: %s is a %s library", ErrInvalidLibrary, lib.Name, lib.Type)
+		}
+	} else {
+		libs, err := s.libraries.ListByType(ctx, library.LibraryTypeTVShow)
+		if err != nil {
+			return nil, err
+		}
+		if len(libs) == 0 {
+			return nil, ErrNoLibrary
+		}
+		lib = &libs[0]
+	}
+	if len(lib.Paths) == 0 {
+		return nil, ErrNoRecordingDirectory
+	}
+	return lib, nil
+}
+
+// paddings applies the defaults to requested paddings and validates them.
+func (s *liveTVService) paddings(before, after *time.Duration) (time.Duration, time.Duration, error) {
+	b, a := s.cfg.DefaultPaddingBefore, s.cfg.DefaultPaddingAfter
+	if before != nil {
+		b = *before
+	}
+	if after != nil {
+		a = *after
+	}
+	if b < 0 || a < 0 || b > MaxPadding || a > MaxPadding {
+		return 0, 0, fmt.Errorf("%w: padding must be between 0 and %s", ErrInvalidRecording, MaxPadding)
+	}
+	return b.Truncate(time.Second), a.Truncate(time.Second), nil
+}
+
+// =============================================================================
+// Series Rules
+// =============================================================================
+
+func (s *liveTVService) ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]SeriesRule, error) {
+	return s.repo.ListSeriesRules(ctx, userID)
+}
+
+func (s *liveTVService) GetSeriesRule(ctx context.Context, id uuid.UUID) (*SeriesRule, error) {
+	return s.repo.GetSeriesRule(ctx, id)
+}
+
+// CreateSeriesRule adds a rule recording every upcoming airing of a title.
+// Its recordings are scheduled by ApplySeriesRules.
+func (s *liveTVService) CreateSeriesRule(ctx context.Context, userID uuid.UUID, req SeriesRuleRequest) (*SeriesRule, error) {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalidRecording)
+	}
+	lib, err := s.recordingLibrary(ctx, req.LibraryID)
+	if err != nil {
+		return nil, err
+	}
+	before, after, err := s.paddings(req.PaddingBefore, req.PaddingAfter)
+	if err != nil {
+		return nil, err
+	}
+	if req.ChannelID != nil {
+		if _, err := s.repo.GetChannel(ctx, *req.ChannelID); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.CreateSeriesRule(ctx, CreateSeriesRuleParams{
+		UserID:        userID,
+		LibraryID:     lib.ID,
+		Title:         title,
+		ChannelID:     req.ChannelID,
+		NewOnly:       req.NewOnly,
+		PaddingBefore: before,
+		PaddingAfter:  after,
+	})
+}
+
+// DeleteSeriesRule removes a rule and cancels the recordings it scheduled
+// that have not started.
+func (s *liveTVService) DeleteSeriesRule(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.repo.CancelRuleRecordings(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.DeleteSeriesRule(ctx, id)
+}
+
+// ApplySeriesRules schedules the upcoming airings matched by the enabled
+// series rules and returns the new recordings. Programs that are already
+// scheduled, by a rule or by hand, are skipped.
+func (s *liveTVService) ApplySeriesRules(ctx context.Context) ([]Recording, error) {
+	rules, err := s.repo.ListEnabledSeriesRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var scheduled []Recording
+	for _, rule := range rules {
+		airings, err := s.repo.ListUpcomingAirings(ctx, rule.Title, now, rule.ChannelID)
+		if err != nil {
+			return scheduled, err
+		}
+		seen := make(map[uuid.UUID]bool, len(airings))
+		for _, a := range airings {
+			if seen[a.ID] || (rule.NewOnly && !a.IsNew) {
+				continue
+			}
+			seen[a.ID] = true
+
+			_, err := s.repo.GetRecordingByProgram(ctx, a.ID)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, ErrRecordingNotFound) {
+				return scheduled, err
+			}
+
+			params := CreateRecordingParams{
+				UserID:        rule.UserID,
+				LibraryID:     rule.LibraryID,
+				SeriesRuleID:  &rule.ID,
+				PaddingBefore: rule.PaddingBefore,
+				PaddingAfter:  rule.PaddingAfter,
+			}
+			fillFromProgram(&params, &a.Program, a.ChannelID, a.ChannelName)
+			rec, err := s.repo.CreateRecording(ctx, params)
+			if errors.Is(err, ErrAlreadyScheduled) {
+				continue
+			}
+			if err != nil {
+				return scheduled, err
+			}
+			scheduled = append(scheduled, *rec)
+		}
+	}
+	return scheduled, nil
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// RecordingPath returns where a recording is stored below a TV library
+// path, named so that the TV scanner matches it: "Show/Season 01/Show -
+// S01E05 - Pilot.ts" for numbered episodes, "Show/Show - 2024-06-30.ts"
+// by air date otherwise.
+func RecordingPath(root string, rec *Recording) string {
+	show := SanitizeFileName(rec.Title)
+	dir := filepath.Join(root, show)
+	var name string
+	if rec.SeasonNumber != nil && rec.EpisodeNumber != nil {
+		dir = filepath.Join(dir, fmt.Sprintf("Season %02d", *rec.SeasonNumber))
+		name = fmt.Sprintf("%s - S%02dE%02d", show, *rec.SeasonNumber, *rec.EpisodeNumber)
+	} else {
+		name = fmt.Sprintf("%s - %s", show, rec.StartsAt.Local().Format("2006-01-02"))
+	}
+	if rec.Subtitle != nil && strings.TrimSpace(*rec.Subtitle) != "" {
+		name += " - " + SanitizeFileName(*rec.Subtitle)
+	}
+	return filepath.Join(dir, name+".ts")
+}
+
+// SanitizeFileName makes a title safe to use as a file or folder name.
+func SanitizeFileName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			b.WriteRune('_')
+		case unicode.IsControl(r):
+			b.WriteRune(' ')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	out := strings.Join(strings.Fields(b.String()), " ")
+	for len(out) > maxFileNameBytes {
+		_, size := utf8.DecodeLastRuneInString(out)
+		out = out[:len(out)-size]
+	}
+	out = strings.Trim(out, ". ")
+	if out == "" {
+		return "Untitled"
+	}
+	return out
+}
+
+// fillFromProgram copies a program's details into recording parameters.
+func fillFromProgram(params *CreateRecordingParams, p *Program, channelID uuid.UUID, channelName string) {
+	params.ChannelID = &channelID
+	params.ProgramID = &p.ID
+	params.ChannelName = channelName
+	params.Title = p.Title
+	params.Subtitle = p.Subtitle
+	params.Description = p.Description
+	params.SeasonNumber = p.SeasonNumber
+	params.EpisodeNumber = p.EpisodeNumber
+	params.StartsAt = p.StartsAt
+	params.EndsAt = p.EndsAt
+}
+
+func programParams(sourceID uuid.UUID, p guide.Program, refreshedAt time.Time) UpsertProgramParams {
+	params := UpsertProgramParams{
+		SourceID:       sourceID,
+		GuideChannelID: p.ChannelID,
+		StartsAt:       p.Start,
+		EndsAt:         p.Stop,
+		Title:          p.Title,
+		Subtitle:       optionalString(p.Subtitle),
+		Description:    optionalString(p.Description),
+		Categories:     p.Categories,
+		EpisodeLabel:   optionalString(p.EpisodeNum),
+		IconURL:        optionalString(p.IconURL),
+		IsNew:          p.New,
+		RefreshedAt:    refreshedAt,
+	}
+	// An episode number without a season is ambiguous for the scanner
+	if p.Season > 0 && p.Episode > 0 {
+		params.SeasonNumber = optionalInt32(p.Season)
+		params.EpisodeNumber = optionalInt32(p.Episode)
+	}
+	if !p.AirDate.IsZero() {
+		airDate := p.AirDate
+		params.AirDate = &airDate
+	}
+	return params
+}
+
+func removeFile(path string) {
+	_ = os.Remove(path)
+}
+
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalInt32(n int) *int32 {
+	if n <= 0 {
+		return nil
+	}
+	v := util.SafeIntToInt32(n)
+	return &v
+}
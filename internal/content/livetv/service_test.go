@@ -0,0 +1,768 @@
+package livetv
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Sources
+func (m *MockRepository) GetSource(ctx context.Context, id uuid.UUID) (*Source, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Source), args.Error(1)
+}
+
+func (m *MockRepository) ListSources(ctx context.Context) ([]Source, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]Source), args.Error(1)
+}
+
+func (m *MockRepository) ListEnabledSources(ctx context.Context) ([]Source, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]Source), args.Error(1)
+}
+
+func (m *MockRepository) CreateSource(ctx context.Context, params CreateSourceParams) (*Source, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Source), args.Error(1)
+}
+
+func (m *MockRepository) MarkSourceRefreshed(ctx context.Context, id uuid.UUID, lastError *string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteSource(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// Channels
+func (m *MockRepository) GetChannel(ctx context.Context, id uuid.UUID) (*Channel, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Channel), args.Error(1)
+}
+
+func (m *MockRepository) ListChannels(ctx context.Context, includeDisabled bool) ([]Channel, error) {
+	args := m.Called(ctx, includeDisabled)
+	return args.Get(0).([]Channel), args.Error(1)
+}
+
+func (m *MockRepository) UpsertChannel(ctx context.Context, params UpsertChannelParams) (*Channel, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Channel), args.Error(1)
+}
+
+func (m *MockRepository) DeleteChannelsNotIn(ctx context.Context, sourceID uuid.UUID, streamURLs []string) (int64, error) {
+	args := m.Called(ctx, sourceID, streamURLs)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) SetChannelEnabled(ctx context.Context, id uuid.UUID, enabled bool) (*Channel, error) {
+	args := m.Called(ctx, id, enabled)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Channel), args.Error(1)
+}
+
+func (m *MockRepository) AssignChannelGuideID(ctx context.Context, guideID, name string) (int64, error) {
+	args := m.Called(ctx, guideID, name)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Programs
+func (m *MockRepository) GetProgram(ctx context.Context, id uuid.UUID) (*Program, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Program), args.Error(1)
+}
+
+func (m *MockRepository) UpsertProgram(ctx context.Context, params UpsertProgramParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteStalePrograms(ctx context.Context, sourceID uuid.UUID, refreshedBefore time.Time) (int64, error) {
+	args := m.Called(ctx, sourceID, refreshedBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteEndedPrograms(ctx context.Context, endedBefore time.Time) (int64, error) {
+	args := m.Called(ctx, endedBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListGuide(ctx context.Context, guideIDs []string, start, end time.Time) ([]Program, error) {
+	args := m.Called(ctx, guideIDs, start, end)
+	return args.Get(0).([]Program), args.Error(1)
+}
+
+func (m *MockRepository) ListUpcomingAirings(ctx context.Context, title string, startsAfter time.Time, channelID *uuid.UUID) ([]Airing, error) {
+	args := m.Called(ctx, title, startsAfter, channelID)
+	return args.Get(0).([]Airing), args.Error(1)
+}
+
+// Recordings
+func (m *MockRepository) GetRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recording), args.Error(1)
+}
+
+func (m *MockRepository) GetRecordingByProgram(ctx context.Context, programID uuid.UUID) (*Recording, error) {
+	args := m.Called(ctx, programID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recording), args.Error(1)
+}
+
+func (m *MockRepository) CreateRecording(ctx context.Context, params CreateRecordingParams) (*Recording, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recording), args.Error(1)
+}
+
+func (m *MockRepository) ListRecordings(ctx context.Context, userID uuid.UUID, filters RecordingListFilters) ([]Recording, error) {
+	args := m.Called(ctx, userID, filters)
+	return args.Get(0).([]Recording), args.Error(1)
+}
+
+func (m *MockRepository) CountRecordings(ctx context.Context, userID uuid.UUID, status *string) (int64, error) {
+	args := m.Called(ctx, userID, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListScheduledRecordings(ctx context.Context, endsAfter time.Time) ([]Recording, error) {
+	args := m.Called(ctx, endsAfter)
+	return args.Get(0).([]Recording), args.Error(1)
+}
+
+func (m *MockRepository) StartRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recording), args.Error(1)
+}
+
+func (m *MockRepository) FinishRecording(ctx context.Context, params FinishRecordingParams) (*Recording, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recording), args.Error(1)
+}
+
+func (m *MockRepository) CancelRecording(ctx context.Context, id uuid.UUID) (*Recording, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Recording), args.Error(1)
+}
+
+func (m *MockRepository) DeleteRecording(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// Series rules
+func (m *MockRepository) GetSeriesRule(ctx context.Context, id uuid.UUID) (*SeriesRule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SeriesRule), args.Error(1)
+}
+
+func (m *MockRepository) ListSeriesRules(ctx context.Context, userID uuid.UUID) ([]SeriesRule, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]SeriesRule), args.Error(1)
+}
+
+func (m *MockRepository) ListEnabledSeriesRules(ctx context.Context) ([]SeriesRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]SeriesRule), args.Error(1)
+}
+
+func (m *MockRepository) CreateSeriesRule(ctx context.Context, params CreateSeriesRuleParams) (*SeriesRule, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SeriesRule), args.Error(1)
+}
+
+func (m *MockRepository) DeleteSeriesRule(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CancelRuleRecordings(ctx context.Context, ruleID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// fakeLibraries serves libraries by ID.
+type fakeLibraries map[uuid.UUID]*library.Library
+
+func (f fakeLibraries) Get(_ context.Context, id uuid.UUID) (*library.Library, error) {
+	if lib, ok := f[id]; ok {
+		return lib, nil
+	}
+	return nil, library.ErrNotFound
+}
+
+func (f fakeLibraries) ListByType(_ context.Context, libType string) ([]library.Library, error) {
+	var out []library.Library
+	for _, lib := range f {
+		if lib.Type == libType {
+			out = append(out, *lib)
+		}
+	}
+	return out, nil
+}
+
+func newTestService(repo *MockRepository, libs fakeLibraries) *liveTVService {
+	recorder := NewRecorder(nil)
+	recorder.reconnectDelay = 10 * time.Millisecond
+	return NewService(repo, libs, nil, recorder, Config{
+		DefaultPaddingBefore: time.Minute,
+		DefaultPaddingAfter:  5 * time.Minute,
+	}, logging.NewTestLogger()).(*liveTVService)
+}
+
+// tvLibrary returns a TV library rooted in a temporary directory.
+func tvLibrary(t *testing.T) *library.Library {
+	t.Helper()
+	return &library.Library{ID: uuid.New(), Name: "TV", Type: library.LibraryTypeTVShow, Paths: []string{t.TempDir()}}
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestAddSource_Validation(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+
+	_, err := svc.AddSource(ctx, CreateSourceParams{Kind: "hdhr", Location: "/srv/tv.m3u"})
+	assert.ErrorIs(t, err, ErrInvalidSource)
+
+	_, err = svc.AddSource(ctx, CreateSourceParams{Kind: SourceKindM3U, Location: "tv.m3u"})
+	assert.ErrorIs(t, err, ErrInvalidSource)
+
+	repo.On("CreateSource", ctx, CreateSourceParams{
+		Name:     "http://192.168.1.10/lineup.m3u",
+		Kind:     SourceKindM3U,
+		Location: "http://192.168.1.10/lineup.m3u",
+		Enabled:  true,
+	}).Return(&Source{ID: uuid.New()}, nil)
+	_, err = svc.AddSource(ctx, CreateSourceParams{Kind: SourceKindM3U, Location: " http://192.168.1.10/lineup.m3u ", Enabled: true})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestListEnabledSources_PlaylistsFirst(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+
+	repo.On("ListEnabledSources", ctx).Return([]Source{
+		{Name: "guide", Kind: SourceKindXMLTV},
+		{Name: "tuner", Kind: SourceKindM3U},
+	}, nil)
+
+	sources, err := svc.ListEnabledSources(ctx)
+	require.NoError(t, err)
+	require.Len(t, sources, 2)
+	assert.Equal(t, "tuner", sources[0].Name)
+	assert.Equal(t, "guide", sources[1].Name)
+}
+
+func TestRefreshSource_Playlist(t *testing.T) {
+	dir := t.TempDir()
+	location := writeFile(t, dir, "lineup.m3u", `#EXTM3U
+#EXTINF:-1 tvg-id="wxyz.us" tvg-chno="5.1" group-title="Local",WXYZ HD
+http://192.168.1.10:5004/auto/v5.1
+#EXTINF:-1,WXYZ HD (again)
+http://192.168.1.10:5004/auto/v5.1
+#EXTINF:-1,Movies
+movies.ts
+`)
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	src := &Source{ID: uuid.New(), Name: "Tuner", Kind: SourceKindM3U, Location: location}
+
+	repo.On("GetSource", ctx, src.ID).Return(src, nil)
+	repo.On("UpsertChannel", ctx, mock.MatchedBy(func(p UpsertChannelParams) bool {
+		return p.StreamURL == "http://192.168.1.10:5004/auto/v5.1" && p.Name == "WXYZ HD" &&
+			*p.GuideID == "wxyz.us" && *p.Number == "5.1" && *p.GroupTitle == "Local" && p.Position == 0
+	})).Return(&Channel{}, nil).Once()
+	repo.On("UpsertChannel", ctx, mock.MatchedBy(func(p UpsertChannelParams) bool {
+		return p.StreamURL == filepath.Join(dir, "movies.ts") && p.GuideID == nil && p.Position == 1
+	})).Return(&Channel{}, nil).Once()
+	repo.On("DeleteChannelsNotIn", ctx, src.ID, []string{
+		"http://192.168.1.10:5004/auto/v5.1",
+		filepath.Join(dir, "movies.ts"),
+	}).Return(int64(3), nil)
+	repo.On("MarkSourceRefreshed", ctx, src.ID, (*string)(nil)).Return(nil)
+
+	res, err := svc.RefreshSource(ctx, src.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Channels)
+	assert.Equal(t, int64(3), res.Removed)
+	assert.NotNil(t, res.Source.LastRefreshedAt)
+	repo.AssertExpectations(t)
+}
+
+func TestRefreshSource_RecordsError(t *testing.T) {
+	location := writeFile(t, t.TempDir(), "empty.m3u", "#EXTM3U\n")
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	src := &Source{ID: uuid.New(), Name: "Tuner", Kind: SourceKindM3U, Location: location}
+
+	repo.On("GetSource", ctx, src.ID).Return(src, nil)
+	repo.On("MarkSourceRefreshed", mock.Anything, src.ID, mock.MatchedBy(func(msg *string) bool {
+		return msg != nil && strings.Contains(*msg, "no channels")
+	})).Return(nil)
+
+	_, err := svc.RefreshSource(ctx, src.ID)
+	assert.ErrorIs(t, err, ErrEmptyPlaylist)
+	repo.AssertNotCalled(t, "DeleteChannelsNotIn", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestRefreshSource_Guide(t *testing.T) {
+	location := writeFile(t, t.TempDir(), "guide.xml", `<?xml version="1.0" encoding="UTF-8"?>
+<tv>
+	<channel id="wxyz.us">
+		<display-name>WXYZ HD</display-name>
+	</channel>
+	<programme start="20240630200000 +0000" stop="20240630210000 +0000" channel="wxyz.us">
+		<title>The Show</title>
+		<sub-title>Pilot</sub-title>
+		<episode-num system="xmltv_ns">0.4/10.</episode-num>
+		<new/>
+	</programme>
+	<programme start="20240630210000 +0000" stop="20240630213000 +0000" channel="wxyz.us">
+		<title>Late News</title>
+		<episode-num system="xmltv_ns">.39.</episode-num>
+	</programme>
+</tv>`)
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	src := &Source{ID: uuid.New(), Name: "Guide", Kind: SourceKindXMLTV, Location: location}
+
+	repo.On("GetSource", ctx, src.ID).Return(src, nil)
+	repo.On("UpsertProgram", ctx, mock.MatchedBy(func(p UpsertProgramParams) bool {
+		return p.SourceID == src.ID && p.GuideChannelID == "wxyz.us" && p.Title == "The Show" &&
+			*p.Subtitle == "Pilot" && *p.SeasonNumber == 1 && *p.EpisodeNumber == 5 && p.IsNew
+	})).Return(nil).Once()
+	repo.On("UpsertProgram", ctx, mock.MatchedBy(func(p UpsertProgramParams) bool {
+		// An episode without a season is not numbered
+		return p.Title == "Late News" && p.SeasonNumber == nil && p.EpisodeNumber == nil
+	})).Return(nil).Once()
+	repo.On("AssignChannelGuideID", ctx, "wxyz.us", "WXYZ HD").Return(int64(1), nil)
+	repo.On("DeleteStalePrograms", ctx, src.ID, mock.AnythingOfType("time.Time")).Return(int64(2), nil)
+	repo.On("DeleteEndedPrograms", ctx, mock.AnythingOfType("time.Time")).Return(int64(1), nil)
+	repo.On("MarkSourceRefreshed", ctx, src.ID, (*string)(nil)).Return(nil)
+
+	res, err := svc.RefreshSource(ctx, src.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Programs)
+	assert.Equal(t, int64(3), res.Removed)
+	repo.AssertExpectations(t)
+}
+
+func TestGuide(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	start := time.Date(2024, 6, 30, 20, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+
+	_, err := svc.Guide(ctx, end, start, nil)
+	assert.ErrorIs(t, err, ErrInvalidGuideWindow)
+	_, err = svc.Guide(ctx, start, start.Add(MaxGuideWindow+time.Hour), nil)
+	assert.ErrorIs(t, err, ErrInvalidGuideWindow)
+
+	withGuide := Channel{ID: uuid.New(), Name: "WXYZ", GuideID: ptr("wxyz.us")}
+	noGuide := Channel{ID: uuid.New(), Name: "Camera"}
+	repo.On("ListChannels", ctx, false).Return([]Channel{withGuide, noGuide}, nil)
+	repo.On("ListGuide", ctx, []string{"wxyz.us"}, start, end).Return([]Program{
+		{ID: uuid.New(), GuideChannelID: "wxyz.us", Title: "The Show"},
+	}, nil)
+
+	g, err := svc.Guide(ctx, start, end, nil)
+	require.NoError(t, err)
+	require.Len(t, g, 2)
+	require.Len(t, g[0].Programs, 1)
+	assert.Equal(t, "The Show", g[0].Programs[0].Title)
+	assert.NotNil(t, g[1].Programs)
+	assert.Empty(t, g[1].Programs)
+}
+
+func TestScheduleRecording_Program(t *testing.T) {
+	repo := new(MockRepository)
+	lib := tvLibrary(t)
+	svc := newTestService(repo, fakeLibraries{lib.ID: lib})
+	ctx := context.Background()
+	userID := uuid.New()
+	start := time.Now().Add(time.Hour).Truncate(time.Minute)
+	program := &Program{
+		ID:             uuid.New(),
+		GuideChannelID: "wxyz.us",
+		Title:          "The Show",
+		SeasonNumber:   ptr(int32(1)),
+		EpisodeNumber:  ptr(int32(5)),
+		StartsAt:       start,
+		EndsAt:         start.Add(time.Hour),
+	}
+	channel := Channel{ID: uuid.New(), Name: "WXYZ", GuideID: ptr("wxyz.us")}
+
+	repo.On("GetProgram", ctx, program.ID).Return(program, nil)
+	repo.On("ListChannels", ctx, false).Return([]Channel{{ID: uuid.New(), Name: "Other"}, channel}, nil)
+	repo.On("CreateRecording", ctx, mock.MatchedBy(func(p CreateRecordingParams) bool {
+		return p.UserID == userID && p.LibraryID == lib.ID && *p.ChannelID == channel.ID &&
+			*p.ProgramID == program.ID && p.ChannelName == "WXYZ" && p.Title == "The Show" &&
+			*p.EpisodeNumber == 5 && p.StartsAt.Equal(start) &&
+			p.PaddingBefore == time.Minute && p.PaddingAfter == 90*time.Second
+	})).Return(&Recording{ID: uuid.New()}, nil)
+
+	_, err := svc.ScheduleRecording(ctx, userID, RecordingRequest{
+		ProgramID:    &program.ID,
+		PaddingAfter: ptr(90*time.Second + 500*time.Millisecond),
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestScheduleRecording_Validation(t *testing.T) {
+	repo := new(MockRepository)
+	lib := tvLibrary(t)
+	movies := &library.Library{ID: uuid.New(), Name: "Movies", Type: library.LibraryTypeMovie, Paths: []string{"/srv/movies"}}
+	svc := newTestService(repo, fakeLibraries{lib.ID: lib, movies.ID: movies})
+	ctx := context.Background()
+	channel := &Channel{ID: uuid.New(), Name: "WXYZ"}
+	repo.On("GetChannel", ctx, channel.ID).Return(channel, nil)
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		req  RecordingRequest
+		err  error
+	}{
+		{"no program or channel", RecordingRequest{Title: "News"}, ErrInvalidRecording},
+		{"movie library", RecordingRequest{ChannelID: &channel.ID, LibraryID: &movies.ID}, ErrInvalidLibrary},
+		{"negative padding", RecordingRequest{ChannelID: &channel.ID, PaddingBefore: ptr(-time.Minute)}, ErrInvalidRecording},
+		{"padding too long", RecordingRequest{ChannelID: &channel.ID, PaddingAfter: ptr(3 * time.Hour)}, ErrInvalidRecording},
+		{"no title", RecordingRequest{ChannelID: &channel.ID, StartsAt: now, EndsAt: now.Add(time.Hour)}, ErrInvalidRecording},
+		{"ends before start", RecordingRequest{ChannelID: &channel.ID, Title: "News", StartsAt: now, EndsAt: now.Add(-time.Hour)}, ErrInvalidRecording},
+		{"too long", RecordingRequest{ChannelID: &channel.ID, Title: "News", StartsAt: now, EndsAt: now.Add(13 * time.Hour)}, ErrInvalidRecording},
+		{"ended", RecordingRequest{ChannelID: &channel.ID, Title: "News", StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}, ErrInvalidRecording},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ScheduleRecording(ctx, uuid.New(), tt.req)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+	repo.AssertNotCalled(t, "CreateRecording", mock.Anything, mock.Anything)
+
+	noLibs := newTestService(repo, fakeLibraries{})
+	_, err := noLibs.ScheduleRecording(ctx, uuid.New(), RecordingRequest{ChannelID: &channel.ID})
+	assert.ErrorIs(t, err, ErrNoLibrary)
+}
+
+func TestScheduleRecording_ChannelMustCarryProgram(t *testing.T) {
+	repo := new(MockRepository)
+	lib := tvLibrary(t)
+	svc := newTestService(repo, fakeLibraries{lib.ID: lib})
+	ctx := context.Background()
+	program := &Program{ID: uuid.New(), GuideChannelID: "wxyz.us", Title: "The Show"}
+	channel := &Channel{ID: uuid.New(), Name: "Other", GuideID: ptr("other.us")}
+
+	repo.On("GetProgram", ctx, program.ID).Return(program, nil)
+	repo.On("GetChannel", ctx, channel.ID).Return(channel, nil)
+
+	_, err := svc.ScheduleRecording(ctx, uuid.New(), RecordingRequest{ProgramID: &program.ID, ChannelID: &channel.ID})
+	assert.ErrorIs(t, err, ErrInvalidRecording)
+}
+
+func TestDeleteRecording(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("scheduled is cancelled", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, nil)
+		id := uuid.New()
+		repo.On("GetRecording", ctx, id).Return(&Recording{ID: id, Status: RecordingStatusScheduled}, nil)
+		repo.On("CancelRecording", ctx, id).Return(&Recording{ID: id, Status: RecordingStatusCancelled}, nil)
+		require.NoError(t, svc.DeleteRecording(ctx, id))
+		repo.AssertNotCalled(t, "DeleteRecording", ctx, id)
+	})
+
+	t.Run("started meanwhile", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, nil)
+		id := uuid.New()
+		repo.On("GetRecording", ctx, id).Return(&Recording{ID: id, Status: RecordingStatusScheduled}, nil)
+		repo.On("CancelRecording", ctx, id).Return(nil, ErrRecordingNotFound)
+		assert.ErrorIs(t, svc.DeleteRecording(ctx, id), ErrRecordingInProgress)
+	})
+
+	t.Run("running", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, nil)
+		id := uuid.New()
+		repo.On("GetRecording", ctx, id).Return(&Recording{ID: id, Status: RecordingStatusRecording}, nil)
+		assert.ErrorIs(t, svc.DeleteRecording(ctx, id), ErrRecordingInProgress)
+	})
+
+	t.Run("finished is removed", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo, nil)
+		id := uuid.New()
+		repo.On("GetRecording", ctx, id).Return(&Recording{ID: id, Status: RecordingStatusCompleted}, nil)
+		repo.On("DeleteRecording", ctx, id).Return(nil)
+		require.NoError(t, svc.DeleteRecording(ctx, id))
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestRecord_LocalStream(t *testing.T) {
+	repo := new(MockRepository)
+	lib := tvLibrary(t)
+	svc := newTestService(repo, fakeLibraries{lib.ID: lib})
+	ctx := context.Background()
+	stream := writeFile(t, t.TempDir(), "channel.ts", "mpeg-ts payload")
+	channel := &Channel{ID: uuid.New(), Name: "WXYZ", StreamURL: stream}
+	rec := &Recording{
+		ID:            uuid.New(),
+		LibraryID:     lib.ID,
+		ChannelID:     &channel.ID,
+		Title:         "The Show",
+		Subtitle:      ptr("Pilot"),
+		SeasonNumber:  ptr(int32(1)),
+		EpisodeNumber: ptr(int32(5)),
+		StartsAt:      time.Now().Add(-time.Minute),
+		EndsAt:        time.Now().Add(time.Hour),
+		Status:        RecordingStatusScheduled,
+	}
+	running := *rec
+	running.Status = RecordingStatusRecording
+	target := filepath.Join(lib.Paths[0], "The Show", "Season 01", "The Show - S01E05 - Pilot.ts")
+
+	repo.On("GetRecording", ctx, rec.ID).Return(rec, nil)
+	repo.On("GetChannel", ctx, channel.ID).Return(channel, nil)
+	repo.On("StartRecording", ctx, rec.ID).Return(&running, nil)
+	repo.On("FinishRecording", ctx, FinishRecordingParams{
+		ID:       rec.ID,
+		Status:   RecordingStatusCompleted,
+		FilePath: &target,
+		FileSize: int64(len("mpeg-ts payload")),
+	}).Return(&Recording{ID: rec.ID, Status: RecordingStatusCompleted}, nil)
+
+	done, err := svc.Record(ctx, rec.ID)
+	require.NoError(t, err)
+	assert.Equal(t, RecordingStatusCompleted, done.Status)
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, "mpeg-ts payload", string(data))
+	repo.AssertExpectations(t)
+}
+
+func TestRecord_FailsWithoutData(t *testing.T) {
+	repo := new(MockRepository)
+	lib := tvLibrary(t)
+	svc := newTestService(repo, fakeLibraries{lib.ID: lib})
+	ctx := context.Background()
+	channel := &Channel{ID: uuid.New(), Name: "WXYZ", StreamURL: filepath.Join(t.TempDir(), "missing.ts")}
+	rec := &Recording{
+		ID:        uuid.New(),
+		LibraryID: lib.ID,
+		ChannelID: &channel.ID,
+		Title:     "News",
+		StartsAt:  time.Now(),
+		EndsAt:    time.Now().Add(time.Hour),
+		Status:    RecordingStatusScheduled,
+	}
+
+	repo.On("GetRecording", ctx, rec.ID).Return(rec, nil)
+	repo.On("GetChannel", ctx, channel.ID).Return(channel, nil)
+	repo.On("StartRecording", ctx, rec.ID).Return(rec, nil)
+	repo.On("FinishRecording", mock.Anything, mock.MatchedBy(func(p FinishRecordingParams) bool {
+		return p.Status == RecordingStatusFailed && p.FilePath == nil && p.Error != nil
+	})).Return(&Recording{ID: rec.ID, Status: RecordingStatusFailed}, nil)
+
+	_, err := svc.Record(ctx, rec.ID)
+	require.Error(t, err)
+	entries, err := os.ReadDir(filepath.Join(lib.Paths[0], "News"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	repo.AssertExpectations(t)
+}
+
+func TestRecord_Missed(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	rec := &Recording{
+		ID:           uuid.New(),
+		StartsAt:     time.Now().Add(-2 * time.Hour),
+		EndsAt:       time.Now().Add(-time.Hour),
+		PaddingAfter: 5 * time.Minute,
+		Status:       RecordingStatusScheduled,
+	}
+
+	repo.On("GetRecording", ctx, rec.ID).Return(rec, nil)
+	repo.On("FinishRecording", mock.Anything, mock.MatchedBy(func(p FinishRecordingParams) bool {
+		return p.Status == RecordingStatusFailed && *p.Error == ErrRecordingMissed.Error()
+	})).Return(&Recording{ID: rec.ID, Status: RecordingStatusFailed}, nil)
+
+	_, err := svc.Record(ctx, rec.ID)
+	assert.ErrorIs(t, err, ErrRecordingMissed)
+	repo.AssertNotCalled(t, "StartRecording", mock.Anything, mock.Anything)
+}
+
+func TestRecord_Finished(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	rec := &Recording{ID: uuid.New(), Status: RecordingStatusCancelled}
+	repo.On("GetRecording", ctx, rec.ID).Return(rec, nil)
+
+	got, err := svc.Record(ctx, rec.ID)
+	require.NoError(t, err)
+	assert.Same(t, rec, got)
+}
+
+func TestApplySeriesRules(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	rule := SeriesRule{ID: uuid.New(), UserID: uuid.New(), LibraryID: uuid.New(), Title: "The Show", NewOnly: true, PaddingAfter: time.Minute}
+	channelID := uuid.New()
+	start := time.Now().Add(time.Hour)
+	airing := func(isNew bool) Airing {
+		return Airing{
+			Program:     Program{ID: uuid.New(), Title: "The Show", StartsAt: start, EndsAt: start.Add(time.Hour), IsNew: isNew},
+			ChannelID:   channelID,
+			ChannelName: "WXYZ",
+		}
+	}
+	fresh, rerun, scheduled, raced := airing(true), airing(false), airing(true), airing(true)
+
+	repo.On("ListEnabledSeriesRules", ctx).Return([]SeriesRule{rule}, nil)
+	repo.On("ListUpcomingAirings", ctx, "The Show", mock.AnythingOfType("time.Time"), (*uuid.UUID)(nil)).
+		Return([]Airing{fresh, rerun, scheduled, raced, fresh}, nil)
+	repo.On("GetRecordingByProgram", ctx, fresh.ID).Return(nil, ErrRecordingNotFound)
+	repo.On("GetRecordingByProgram", ctx, scheduled.ID).Return(&Recording{}, nil)
+	repo.On("GetRecordingByProgram", ctx, raced.ID).Return(nil, ErrRecordingNotFound)
+	repo.On("CreateRecording", ctx, mock.MatchedBy(func(p CreateRecordingParams) bool {
+		return *p.ProgramID == fresh.ID && *p.SeriesRuleID == rule.ID && p.UserID == rule.UserID &&
+			p.LibraryID == rule.LibraryID && *p.ChannelID == channelID && p.PaddingAfter == time.Minute
+	})).Return(&Recording{ID: uuid.New(), Title: "The Show"}, nil).Once()
+	repo.On("CreateRecording", ctx, mock.MatchedBy(func(p CreateRecordingParams) bool {
+		return *p.ProgramID == raced.ID
+	})).Return(nil, ErrAlreadyScheduled).Once()
+
+	recordings, err := svc.ApplySeriesRules(ctx)
+	require.NoError(t, err)
+	assert.Len(t, recordings, 1)
+	repo.AssertNotCalled(t, "GetRecordingByProgram", ctx, rerun.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestDeleteSeriesRule(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, nil)
+	ctx := context.Background()
+	id := uuid.New()
+
+	repo.On("CancelRuleRecordings", ctx, id).Return(int64(0), errors.New("db down")).Once()
+	require.Error(t, svc.DeleteSeriesRule(ctx, id))
+	repo.AssertNotCalled(t, "DeleteSeriesRule", ctx, id)
+
+	repo.On("CancelRuleRecordings", ctx, id).Return(int64(2), nil)
+	repo.On("DeleteSeriesRule", ctx, id).Return(nil)
+	require.NoError(t, svc.DeleteSeriesRule(ctx, id))
+}
+
+func TestRecordingPath(t *testing.T) {
+	rec := &Recording{
+		Title:         "Law & Order: SVU",
+		SeasonNumber:  ptr(int32(25)),
+		EpisodeNumber: ptr(int32(3)),
+		Subtitle:      ptr("Pilot?"),
+	}
+	assert.Equal(t,
+		filepath.Join("/tv", "Law & Order_ SVU", "Season 25", "Law & Order_ SVU - S25E03 - Pilot_.ts"),
+		RecordingPath("/tv", rec))
+
+	news := &Recording{Title: "Evening News", StartsAt: time.Date(2024, 6, 30, 20, 0, 0, 0, time.Local)}
+	assert.Equal(t, filepath.Join("/tv", "Evening News", "Evening News - 2024-06-30.ts"), RecordingPath("/tv", news))
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	tests := map[string]string{
+		"The Show":               "The Show",
+		"A/B\\C":                 "A_B_C",
+		"  spaced \t out  ":      "spaced out",
+		"Trailing dots...":       "Trailing dots",
+		"":                       "Untitled",
+		"...":                    "Untitled",
+		"line\nbreak":            "line break",
+		strings.Repeat("é", 150): strings.Repeat("é", 100),
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, SanitizeFileName(in), "input %q", in)
+	}
+}
@@ -0,0 +1,158 @@
+// Package livetv provides live TV and DVR. Channels come from M3U
+// playlists of network tuners or IPTV providers and the program guide from
+// XMLTV files, both read from local paths or URLs. Channels are restreamed
+// through the HLS pipeline; recordings capture a channel's MPEG-TS stream
+// into a TV library, scheduled one by one or by series rules.
+package livetv
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Source kinds.
+const (
+	SourceKindM3U   = "m3u"   // channel list
+	SourceKindXMLTV = "xmltv" // program guide
+)
+
+// Recording statuses.
+const (
+	RecordingStatusScheduled = "scheduled"
+	RecordingStatusRecording = "recording"
+	RecordingStatusCompleted = "completed"
+	RecordingStatusFailed    = "failed"
+	RecordingStatusCancelled = "cancelled"
+)
+
+// Source is a configured channel list or program guide.
+type Source struct {
+	ID              uuid.UUID
+	Name            string
+	Kind            string
+	Location        string
+	Enabled         bool
+	LastRefreshedAt *time.Time
+	LastError       *string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Channel is an entry of an M3U source.
+type Channel struct {
+	ID         uuid.UUID
+	SourceID   uuid.UUID
+	StreamURL  string
+	Name       string
+	Number     *string
+	GuideID    *string // XMLTV channel id
+	LogoURL    *string
+	GroupTitle *string
+	Position   int32
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Program is a guide entry of an XMLTV source.
+type Program struct {
+	ID             uuid.UUID
+	SourceID       uuid.UUID
+	GuideChannelID string
+	StartsAt       time.Time
+	EndsAt         time.Time
+	Title          string
+	Subtitle       *string
+	Description    *string
+	Categories     []string
+	SeasonNumber   *int32
+	EpisodeNumber  *int32
+	EpisodeLabel   *string
+	IconURL        *string
+	AirDate        *time.Time
+	IsNew          bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Airing is a program on one of the channels carrying its guide channel.
+type Airing struct {
+	Program
+	ChannelID   uuid.UUID
+	ChannelName string
+}
+
+// ChannelGuide is a channel with its programs in a time window.
+type ChannelGuide struct {
+	Channel  Channel
+	Programs []Program
+}
+
+// Recording is a scheduled or finished capture of a channel.
+type Recording struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	LibraryID     uuid.UUID
+	ChannelID     *uuid.UUID
+	ProgramID     *uuid.UUID
+	SeriesRuleID  *uuid.UUID
+	ChannelName   string
+	Title         string
+	Subtitle      *string
+	Description   *string
+	SeasonNumber  *int32
+	EpisodeNumber *int32
+	StartsAt      time.Time
+	EndsAt        time.Time
+	PaddingBefore time.Duration
+	PaddingAfter  time.Duration
+	Status        string
+	FilePath      *string
+	FileSize      int64
+	Error         *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CaptureStart returns when capturing starts, the padding before included.
+func (r *Recording) CaptureStart() time.Time {
+	return r.StartsAt.Add(-r.PaddingBefore)
+}
+
+// CaptureEnd returns when capturing stops, the padding after included.
+func (r *Recording) CaptureEnd() time.Time {
+	return r.EndsAt.Add(r.PaddingAfter)
+}
+
+// SeriesRule records every upcoming airing of a title.
+type SeriesRule struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	LibraryID     uuid.UUID
+	Title         string
+	ChannelID     *uuid.UUID // nil matches any channel
+	NewOnly       bool
+	PaddingBefore time.Duration
+	PaddingAfter  time.Duration
+	Enabled       bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RefreshResult summarizes a source refresh.
+type RefreshResult struct {
+	Source *Source
+	// Channels is the number of channels of a playlist; Programs the
+	// number of programs of a guide.
+	Channels int
+	Programs int
+	Removed  int64
+}
+
+// RecordingListFilters contains filters for listing recordings
+type RecordingListFilters struct {
+	Status *string
+	Limit  int32
+	Offset int32
+}
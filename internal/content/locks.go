@@ -0,0 +1,84 @@
+package content
+
+import (
+	"errors"
+	"slices"
+)
+
+// ErrUnknownLockField is returned for a field name that cannot be locked.
+var ErrUnknownLockField = errors.New("unknown metadata field")
+
+// Metadata fields that can be locked against automatic updates. Locks are
+// set when an admin edits a field; metadata refreshes and the Radarr/Sonarr
+// sync then leave the field alone until the lock is cleared.
+const (
+	FieldTitle         = "title" // Title, or name for seasons
+	FieldSortTitle     = "sort_title"
+	FieldOriginalTitle = "original_title"
+	FieldOverview      = "overview"
+	FieldTagline       = "tagline"
+	FieldReleaseDate   = "release_date" // Release, first air or air date
+	FieldGenres        = "genres"
+	FieldRatings       = "ratings" // Vote average, vote count and external ratings
+	FieldPoster        = "poster"
+	FieldBackdrop      = "backdrop"
+	FieldStill         = "still"
+)
+
+// LockableFields lists all fields that can be locked.
+var LockableFields = []string{
+	FieldTitle, FieldSortTitle, FieldOriginalTitle, FieldOverview, FieldTagline,
+	FieldReleaseDate, FieldGenres, FieldRatings, FieldPoster, FieldBackdrop, FieldStill,
+}
+
+// LockedFields is the set of locked metadata fields of an item.
+type LockedFields []string
+
+// Has reports whether the field is locked.
+func (l LockedFields) Has(field string) bool {
+	return slices.Contains(l, field)
+}
+
+// With returns the locks with the given fields added, sorted and without
+// duplicates.
+func (l LockedFields) With(fields ...string) LockedFields {
+	out := make(LockedFields, 0, len(l)+len(fields))
+	out = append(out, l...)
+	out = append(out, fields...)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// Without returns the locks with the given fields removed.
+func (l LockedFields) Without(fields ...string) LockedFields {
+	out := make(LockedFields, 0, len(l))
+	for _, f := range l {
+		if !slices.Contains(fields, f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// FilterSources returns a copy of per-field metadata sources without the
+// locked fields, so a refresh keeps the source recorded for edited fields.
+func (l LockedFields) FilterSources(sources map[string]string) map[string]string {
+	if sources == nil {
+		return nil
+	}
+	out := make(map[string]string, len(sources))
+	for field, source := range sources {
+		if !l.Has(field) {
+			out[field] = source
+		}
+	}
+	return out
+}
+
+// ValidateLockField returns ErrUnknownLockField if the field cannot be locked.
+func ValidateLockField(field string) error {
+	if !slices.Contains(LockableFields, field) {
+		return ErrUnknownLockField
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockedFields(t *testing.T) {
+	t.Parallel()
+
+	locks := LockedFields{FieldTitle}
+	locks = locks.With(FieldOverview, FieldTitle)
+	assert.Equal(t, LockedFields{FieldOverview, FieldTitle}, locks)
+	assert.True(t, locks.Has(FieldOverview))
+	assert.False(t, locks.Has(FieldGenres))
+
+	locks = locks.Without(FieldTitle)
+	assert.Equal(t, LockedFields{FieldOverview}, locks)
+}
+
+func TestValidateLockField(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateLockField(FieldPoster))
+	assert.ErrorIs(t, ValidateLockField("runtime"), ErrUnknownLockField)
+}
+
+func TestLockedFields_FilterSources(t *testing.T) {
+	t.Parallel()
+
+	locks := LockedFields{FieldTitle}
+	sources := map[string]string{FieldTitle: "anilist", FieldOverview: "tmdb"}
+	assert.Equal(t, map[string]string{FieldOverview: "tmdb"}, locks.FilterSources(sources))
+	assert.Len(t, sources, 2)
+	assert.Nil(t, locks.FilterSources(nil))
+}
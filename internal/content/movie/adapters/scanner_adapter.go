@@ -13,6 +13,7 @@ import (
 // It extracts movie title and year from filenames like:
 // - "The Matrix (1999).mkv" -> Title: "The Matrix", Year: 1999
 // - "Inception.2010.1080p.BluRay.mkv" -> Title: "Inception", Year: 2010
+// - "Alien (1979) {edition-Director's Cut}.mkv" -> also Edition: "Director's Cut"
 type MovieFileParser struct{}
 
 // NewMovieFileParser creates a new movie file parser
@@ -20,10 +21,15 @@ func NewMovieFileParser() *MovieFileParser {
 	return &MovieFileParser{}
 }
 
-// Parse extracts title and year from a movie filename
+// Parse extracts title, year and edition from a movie filename
 func (p *MovieFileParser) Parse(filename string) (title string, metadata map[string]any) {
 	metadata = make(map[string]any)
 
+	filename, edition := scanner.ParseEdition(filename)
+	if edition != "" {
+		metadata["edition"] = edition
+	}
+
 	// Remove extension
 	nameWithoutExt := strings.TrimSuffix(filename, filepath.Ext(filename))
 
@@ -104,6 +104,18 @@ func TestMovieFileParser_Parse(t *testing.T) {
 	}
 }
 
+func TestMovieFileParser_ParseEdition(t *testing.T) {
+	parser := NewMovieFileParser()
+
+	title, metadata := parser.Parse("Blade Runner (1982) {edition-Final Cut}.mkv")
+	assert.Equal(t, "Blade Runner", title)
+	assert.Equal(t, 1982, metadata["year"])
+	assert.Equal(t, "Final Cut", metadata["edition"])
+
+	_, metadata = parser.Parse("Blade Runner (1982).mkv")
+	assert.NotContains(t, metadata, "edition")
+}
+
 func TestMovieFileParser_GetExtensions(t *testing.T) {
 	parser := NewMovieFileParser()
 	extensions := parser.GetExtensions()
@@ -13,6 +13,7 @@ import (
 
 	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // CachedService wraps the movie service with caching.
@@ -40,16 +41,24 @@ func (s *CachedService) GetMovie(ctx context.Context, id uuid.UUID) (*Movie, err
 
 // ListMovies returns a paginated list of movies with caching (1 min TTL).
 func (s *CachedService) ListMovies(ctx context.Context, filters ListFilters) ([]Movie, error) {
+	// Lists restricted by parental controls differ per user and bypass the cache.
+	if content.AccessFilterFromContext(ctx) != nil {
+		return s.Service.ListMovies(ctx, filters)
+	}
 	return cache.Get(ctx, s.cache, s.listMoviesCacheKey(filters), time.Minute, func(ctx context.Context) ([]Movie, error) {
 		return s.Service.ListMovies(ctx, filters)
 	})
 }
 
 // ListRecentlyAdded returns recently added movies with caching (2 min TTL).
-func (s *CachedService) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Movie, int64, error) {
+// Lists restricted to library paths are not cached.
+func (s *CachedService) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Movie, int64, error) {
+	if content.AccessFilterFromContext(ctx) != nil || libraryPaths != nil {
+		return s.Service.ListRecentlyAdded(ctx, libraryPaths, limit, offset)
+	}
 	key := fmt.Sprintf("%srecently-added:%d:%d", cache.KeyPrefixMovie, limit, offset)
 	result, err := cache.Get(ctx, s.cache, key, cache.RecentlyAddedTTL, func(ctx context.Context) (cache.Pair[[]Movie], error) {
-		items, total, err := s.Service.ListRecentlyAdded(ctx, limit, offset)
+		items, total, err := s.Service.ListRecentlyAdded(ctx, nil, limit, offset)
 		return cache.Pair[[]Movie]{Items: items, Total: total}, err
 	})
 	if err != nil {
@@ -59,10 +68,14 @@ func (s *CachedService) ListRecentlyAdded(ctx context.Context, limit, offset int
 }
 
 // ListTopRated returns top-rated movies with caching (5 min TTL).
-func (s *CachedService) ListTopRated(ctx context.Context, minVotes int32, limit, offset int32) ([]Movie, int64, error) {
+// Lists restricted to library paths are not cached.
+func (s *CachedService) ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit, offset int32) ([]Movie, int64, error) {
+	if content.AccessFilterFromContext(ctx) != nil || libraryPaths != nil {
+		return s.Service.ListTopRated(ctx, minVotes, libraryPaths, limit, offset)
+	}
 	key := fmt.Sprintf("%stop-rated:%d:%d:%d", cache.KeyPrefixMovie, minVotes, limit, offset)
 	result, err := cache.Get(ctx, s.cache, key, cache.TopRatedTTL, func(ctx context.Context) (cache.Pair[[]Movie], error) {
-		items, total, err := s.Service.ListTopRated(ctx, minVotes, limit, offset)
+		items, total, err := s.Service.ListTopRated(ctx, minVotes, nil, limit, offset)
 		return cache.Pair[[]Movie]{Items: items, Total: total}, err
 	})
 	if err != nil {
@@ -105,6 +118,14 @@ func (s *CachedService) GetMovieGenres(ctx context.Context, movieID uuid.UUID) (
 	})
 }
 
+// GetMovieTags returns keywords and tags for a movie with caching (10 min TTL).
+func (s *CachedService) GetMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error) {
+	key := fmt.Sprintf("%s%s:tags", cache.KeyPrefixMovie, movieID.String())
+	return cache.Get(ctx, s.cache, key, cache.MovieMetaTTL, func(ctx context.Context) ([]MovieTag, error) {
+		return s.Service.GetMovieTags(ctx, movieID)
+	})
+}
+
 // GetMovieCollection retrieves a collection by ID with caching (10 min TTL).
 func (s *CachedService) GetMovieCollection(ctx context.Context, id uuid.UUID) (*MovieCollection, error) {
 	key := fmt.Sprintf("%scollection:%s", cache.KeyPrefixMovie, id.String())
@@ -114,10 +135,14 @@ func (s *CachedService) GetMovieCollection(ctx context.Context, id uuid.UUID) (*
 }
 
 // GetContinueWatching returns movies the user is currently watching with caching (1 min TTL).
-func (s *CachedService) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+// Lists restricted to library paths are not cached.
+func (s *CachedService) GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	if content.AccessFilterFromContext(ctx) != nil || libraryPaths != nil {
+		return s.Service.GetContinueWatching(ctx, userID, libraryPaths, limit)
+	}
 	key := fmt.Sprintf("%scontinue-watching:%s:%d", cache.KeyPrefixMovie, userID.String(), limit)
 	return cache.Get(ctx, s.cache, key, cache.ContinueWatchingTTL, func(ctx context.Context) ([]ContinueWatchingItem, error) {
-		return s.Service.GetContinueWatching(ctx, userID, limit)
+		return s.Service.GetContinueWatching(ctx, userID, nil, limit)
 	})
 }
 
@@ -135,6 +160,61 @@ func (s *CachedService) UpdateMovie(ctx context.Context, params UpdateMovieParam
 	return result, nil
 }
 
+// UpdateMovieMetadata applies a manual edit and invalidates cache.
+func (s *CachedService) UpdateMovieMetadata(ctx context.Context, id uuid.UUID, edit MetadataEdit) (*Movie, error) {
+	result, err := s.Service.UpdateMovieMetadata(ctx, id, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateMovie(ctx, id)
+	}
+
+	return result, nil
+}
+
+// RestoreMovieMetadata restores previous field values and invalidates cache.
+func (s *CachedService) RestoreMovieMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Movie, error) {
+	result, err := s.Service.RestoreMovieMetadata(ctx, id, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateMovie(ctx, id)
+	}
+
+	return result, nil
+}
+
+// SetImagePlaceholder stores an image placeholder and invalidates cache.
+func (s *CachedService) SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	if err := s.Service.SetImagePlaceholder(ctx, id, path, placeholder); err != nil {
+		return err
+	}
+
+	if s.cache != nil {
+		s.invalidateMovie(ctx, id)
+	}
+
+	return nil
+}
+
+// UnlockMovieFields clears field locks and invalidates cache.
+func (s *CachedService) UnlockMovieFields(ctx context.Context, id uuid.UUID, fields ...string) (*Movie, error) {
+	result, err := s.Service.UnlockMovieFields(ctx, id, fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateMovie(ctx, id)
+	}
+
+	return result, nil
+}
+
 // DeleteMovie soft-deletes a movie and invalidates cache.
 func (s *CachedService) DeleteMovie(ctx context.Context, id uuid.UUID) error {
 	if err := s.Service.DeleteMovie(ctx, id); err != nil {
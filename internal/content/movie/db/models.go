@@ -33,6 +33,229 @@ type ActivityLog struct {
 	CreatedAt    time.Time  `json:"createdAt"`
 }
 
+// Artwork for movies, series and seasons from local files and providers
+type Artwork struct {
+	ID          uuid.UUID `json:"id"`
+	ContentType string    `json:"contentType"`
+	ContentID   uuid.UUID `json:"contentId"`
+	Kind        string    `json:"kind"`
+	// Where the image came from: local or a provider name
+	Source string `json:"source"`
+	// Absolute file path for local artwork, provider path or URL otherwise
+	Path      string    `json:"path"`
+	Width     *int32    `json:"width"`
+	Height    *int32    `json:"height"`
+	FileSize  *int64    `json:"fileSize"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ISO 639-1 language of text in the image; NULL for textless images
+	Language    *string `json:"language"`
+	VoteAverage float64 `json:"voteAverage"`
+	VoteCount   int32   `json:"voteCount"`
+	// Whether this is the image used for its kind
+	Selected bool `json:"selected"`
+	// Selected by an admin; automatic selection keeps it
+	UserSelected bool `json:"userSelected"`
+	// When the selected image was stored in the local image cache
+	CachedAt pgtype.Timestamptz `json:"cachedAt"`
+	// BlurHash of the image, computed when it is cached
+	Blurhash *string `json:"blurhash"`
+	// Dominant color of the image as #rrggbb
+	DominantColor *string `json:"dominantColor"`
+}
+
+type AudiobookAuthor struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SortName  string    `json:"sortName"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type AudiobookBook struct {
+	ID           uuid.UUID   `json:"id"`
+	Path         string      `json:"path"`
+	Title        string      `json:"title"`
+	SortTitle    string      `json:"sortTitle"`
+	Subtitle     *string     `json:"subtitle"`
+	AuthorCredit string      `json:"authorCredit"`
+	Narrators    []string    `json:"narrators"`
+	SeriesID     pgtype.UUID `json:"seriesId"`
+	SeriesIndex  *float32    `json:"seriesIndex"`
+	Description  *string     `json:"description"`
+	Publisher    *string     `json:"publisher"`
+	Year         *int32      `json:"year"`
+	Language     *string     `json:"language"`
+	Genres       []string    `json:"genres"`
+	Asin         *string     `json:"asin"`
+	DurationMs   int64       `json:"durationMs"`
+	FileCount    int32       `json:"fileCount"`
+	ChapterCount int32       `json:"chapterCount"`
+	TotalSize    int64       `json:"totalSize"`
+	CoverPath    *string     `json:"coverPath"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+type AudiobookBookAuthor struct {
+	BookID   uuid.UUID `json:"bookId"`
+	AuthorID uuid.UUID `json:"authorId"`
+	Position int32     `json:"position"`
+}
+
+type AudiobookBookProgress struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"userId"`
+	BookID          uuid.UUID          `json:"bookId"`
+	PositionMs      int64              `json:"positionMs"`
+	DurationMs      int64              `json:"durationMs"`
+	PlaybackSpeed   float32            `json:"playbackSpeed"`
+	ProgressPercent pgtype.Numeric     `json:"progressPercent"`
+	IsCompleted     bool               `json:"isCompleted"`
+	ListenCount     int32              `json:"listenCount"`
+	LastListenedAt  time.Time          `json:"lastListenedAt"`
+	CompletedAt     pgtype.Timestamptz `json:"completedAt"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+type AudiobookChapter struct {
+	ID       uuid.UUID `json:"id"`
+	BookID   uuid.UUID `json:"bookId"`
+	Position int32     `json:"position"`
+	Title    string    `json:"title"`
+	StartMs  int64     `json:"startMs"`
+	EndMs    int64     `json:"endMs"`
+}
+
+type AudiobookFile struct {
+	ID         uuid.UUID `json:"id"`
+	BookID     uuid.UUID `json:"bookId"`
+	Position   int32     `json:"position"`
+	FilePath   string    `json:"filePath"`
+	FileSize   int64     `json:"fileSize"`
+	Container  *string   `json:"container"`
+	DurationMs int64     `json:"durationMs"`
+	OffsetMs   int64     `json:"offsetMs"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type AudiobookSeries struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SortName  string    `json:"sortName"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type BookAuthor struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SortName  string    `json:"sortName"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type BookBook struct {
+	ID           uuid.UUID   `json:"id"`
+	FilePath     string      `json:"filePath"`
+	FileSize     int64       `json:"fileSize"`
+	Kind         string      `json:"kind"`
+	Format       string      `json:"format"`
+	Title        string      `json:"title"`
+	SortTitle    string      `json:"sortTitle"`
+	Subtitle     *string     `json:"subtitle"`
+	AuthorCredit string      `json:"authorCredit"`
+	SeriesID     pgtype.UUID `json:"seriesId"`
+	SeriesIndex  *float32    `json:"seriesIndex"`
+	Description  *string     `json:"description"`
+	Publisher    *string     `json:"publisher"`
+	Year         *int32      `json:"year"`
+	Language     *string     `json:"language"`
+	Isbn         *string     `json:"isbn"`
+	Genres       []string    `json:"genres"`
+	PageCount    int32       `json:"pageCount"`
+	Pages        []string    `json:"pages"`
+	RightToLeft  bool        `json:"rightToLeft"`
+	CoverEntry   *string     `json:"coverEntry"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+type BookBookAuthor struct {
+	BookID   uuid.UUID `json:"bookId"`
+	AuthorID uuid.UUID `json:"authorId"`
+	Position int32     `json:"position"`
+}
+
+type BookReadingProgress struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"userId"`
+	BookID          uuid.UUID          `json:"bookId"`
+	Page            int32              `json:"page"`
+	Locator         *string            `json:"locator"`
+	ProgressPercent float32            `json:"progressPercent"`
+	IsCompleted     bool               `json:"isCompleted"`
+	ReadCount       int32              `json:"readCount"`
+	LastReadAt      time.Time          `json:"lastReadAt"`
+	CompletedAt     pgtype.Timestamptz `json:"completedAt"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+type BookSeries struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SortName  string    `json:"sortName"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type HomevideoFolder struct {
+	ID        uuid.UUID   `json:"id"`
+	LibraryID uuid.UUID   `json:"libraryId"`
+	ParentID  pgtype.UUID `json:"parentId"`
+	Path      string      `json:"path"`
+	Title     string      `json:"title"`
+	CreatedAt time.Time   `json:"createdAt"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
+
+type HomevideoVideo struct {
+	ID               uuid.UUID `json:"id"`
+	LibraryID        uuid.UUID `json:"libraryId"`
+	FolderID         uuid.UUID `json:"folderId"`
+	FilePath         string    `json:"filePath"`
+	FileSize         int64     `json:"fileSize"`
+	Container        *string   `json:"container"`
+	Title            string    `json:"title"`
+	TitleSource      string    `json:"titleSource"`
+	SortTitle        string    `json:"sortTitle"`
+	Description      *string   `json:"description"`
+	RecordedAt       time.Time `json:"recordedAt"`
+	RecordedAtSource string    `json:"recordedAtSource"`
+	DurationSeconds  float64   `json:"durationSeconds"`
+	Width            int32     `json:"width"`
+	Height           int32     `json:"height"`
+	VideoCodec       *string   `json:"videoCodec"`
+	AudioCodec       *string   `json:"audioCodec"`
+	ThumbnailKey     *string   `json:"thumbnailKey"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+type HomevideoWatchProgress struct {
+	UserID          uuid.UUID `json:"userId"`
+	VideoID         uuid.UUID `json:"videoId"`
+	ProgressSeconds int32     `json:"progressSeconds"`
+	DurationSeconds int32     `json:"durationSeconds"`
+	IsCompleted     bool      `json:"isCompleted"`
+	WatchCount      int32     `json:"watchCount"`
+	LastWatchedAt   time.Time `json:"lastWatchedAt"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
 // Media libraries organizing content by type and location
 type Library struct {
 	ID   uuid.UUID `json:"id"`
@@ -51,6 +274,29 @@ type Library struct {
 	ScannerConfig []byte    `json:"scannerConfig"`
 	CreatedAt     time.Time `json:"createdAt"`
 	UpdatedAt     time.Time `json:"updatedAt"`
+	// Provider chains per metadata field: {"providers": [...], "fields": {"title": [...]}, "enable_provider_fallback": bool, "enable_enrichment": bool}
+	MetadataPolicy json.RawMessage `json:"metadataPolicy"`
+}
+
+// Scanned files awaiting manual identification
+type LibraryMatchQueue struct {
+	ID          uuid.UUID   `json:"id"`
+	LibraryID   pgtype.UUID `json:"libraryId"`
+	ContentType string      `json:"contentType"`
+	FilePath    string      `json:"filePath"`
+	ParsedTitle *string     `json:"parsedTitle"`
+	ParsedYear  *int32      `json:"parsedYear"`
+	// Queue status: unmatched, low_confidence, resolved, ignored
+	Status string  `json:"status"`
+	Reason *string `json:"reason"`
+	// Top provider candidates with confidence scores, best first
+	Candidates        json.RawMessage    `json:"candidates"`
+	BestConfidence    *float64           `json:"bestConfidence"`
+	ResolvedContentID pgtype.UUID        `json:"resolvedContentId"`
+	ResolvedBy        pgtype.UUID        `json:"resolvedBy"`
+	ResolvedAt        pgtype.Timestamptz `json:"resolvedAt"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
 }
 
 // Per-user access permissions to libraries
@@ -81,6 +327,110 @@ type LibraryScan struct {
 	CompletedAt     pgtype.Timestamptz `json:"completedAt"`
 	DurationSeconds *int32             `json:"durationSeconds"`
 	CreatedAt       time.Time          `json:"createdAt"`
+	// River job performing the scan; used to cancel running scans
+	JobID *int64 `json:"jobId"`
+}
+
+type LivetvChannel struct {
+	ID         uuid.UUID `json:"id"`
+	SourceID   uuid.UUID `json:"sourceId"`
+	StreamUrl  string    `json:"streamUrl"`
+	Name       string    `json:"name"`
+	Number     *string   `json:"number"`
+	GuideID    *string   `json:"guideId"`
+	LogoUrl    *string   `json:"logoUrl"`
+	GroupTitle *string   `json:"groupTitle"`
+	Position   int32     `json:"position"`
+	Enabled    bool      `json:"enabled"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type LivetvProgram struct {
+	ID             uuid.UUID   `json:"id"`
+	SourceID       uuid.UUID   `json:"sourceId"`
+	GuideChannelID string      `json:"guideChannelId"`
+	StartsAt       time.Time   `json:"startsAt"`
+	EndsAt         time.Time   `json:"endsAt"`
+	Title          string      `json:"title"`
+	Subtitle       *string     `json:"subtitle"`
+	Description    *string     `json:"description"`
+	Categories     []string    `json:"categories"`
+	SeasonNumber   *int32      `json:"seasonNumber"`
+	EpisodeNumber  *int32      `json:"episodeNumber"`
+	EpisodeLabel   *string     `json:"episodeLabel"`
+	IconUrl        *string     `json:"iconUrl"`
+	AirDate        pgtype.Date `json:"airDate"`
+	IsNew          bool        `json:"isNew"`
+	RefreshedAt    time.Time   `json:"refreshedAt"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+}
+
+type LivetvRecording struct {
+	ID                   uuid.UUID   `json:"id"`
+	UserID               uuid.UUID   `json:"userId"`
+	LibraryID            uuid.UUID   `json:"libraryId"`
+	ChannelID            pgtype.UUID `json:"channelId"`
+	ProgramID            pgtype.UUID `json:"programId"`
+	SeriesRuleID         pgtype.UUID `json:"seriesRuleId"`
+	ChannelName          string      `json:"channelName"`
+	Title                string      `json:"title"`
+	Subtitle             *string     `json:"subtitle"`
+	Description          *string     `json:"description"`
+	SeasonNumber         *int32      `json:"seasonNumber"`
+	EpisodeNumber        *int32      `json:"episodeNumber"`
+	StartsAt             time.Time   `json:"startsAt"`
+	EndsAt               time.Time   `json:"endsAt"`
+	PaddingBeforeSeconds int32       `json:"paddingBeforeSeconds"`
+	PaddingAfterSeconds  int32       `json:"paddingAfterSeconds"`
+	Status               string      `json:"status"`
+	FilePath             *string     `json:"filePath"`
+	FileSize             int64       `json:"fileSize"`
+	Error                *string     `json:"error"`
+	CreatedAt            time.Time   `json:"createdAt"`
+	UpdatedAt            time.Time   `json:"updatedAt"`
+}
+
+type LivetvSeriesRule struct {
+	ID                   uuid.UUID   `json:"id"`
+	UserID               uuid.UUID   `json:"userId"`
+	LibraryID            uuid.UUID   `json:"libraryId"`
+	Title                string      `json:"title"`
+	ChannelID            pgtype.UUID `json:"channelId"`
+	NewOnly              bool        `json:"newOnly"`
+	PaddingBeforeSeconds int32       `json:"paddingBeforeSeconds"`
+	PaddingAfterSeconds  int32       `json:"paddingAfterSeconds"`
+	Enabled              bool        `json:"enabled"`
+	CreatedAt            time.Time   `json:"createdAt"`
+	UpdatedAt            time.Time   `json:"updatedAt"`
+}
+
+type LivetvSource struct {
+	ID              uuid.UUID          `json:"id"`
+	Name            string             `json:"name"`
+	Kind            string             `json:"kind"`
+	Location        string             `json:"location"`
+	Enabled         bool               `json:"enabled"`
+	LastRefreshedAt pgtype.Timestamptz `json:"lastRefreshedAt"`
+	LastError       *string            `json:"lastError"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+// Versioned metadata changes of movies, series, seasons and episodes
+type MetadataChange struct {
+	ID          uuid.UUID `json:"id"`
+	ContentType string    `json:"contentType"`
+	ContentID   uuid.UUID `json:"contentId"`
+	// Version of the item after the change, counting from 1
+	Version int32 `json:"version"`
+	// system, refresh, radarr, sonarr, manual or rollback
+	Source  string      `json:"source"`
+	ActorID pgtype.UUID `json:"actorId"`
+	// Changed fields: {"title": {"old": "...", "new": "..."}}
+	Changes   json.RawMessage `json:"changes"`
+	CreatedAt time.Time       `json:"createdAt"`
 }
 
 // One-time backup codes for MFA account recovery
@@ -145,6 +495,20 @@ type Movie struct {
 	DeletedAt  pgtype.Timestamptz `json:"deletedAt"`
 	// External ratings from various providers (IMDb, RT, Metacritic, etc.) as JSON array
 	ExternalRatings json.RawMessage `json:"externalRatings"`
+	// Title used for alphabetical sorting; falls back to title when NULL
+	SortTitle *string `json:"sortTitle"`
+	// Manually edited fields that automatic metadata updates must not overwrite
+	LockedFields []string `json:"lockedFields"`
+	// Provider that supplied each metadata field: {"title": "tmdb", ...}
+	MetadataSources json.RawMessage `json:"metadataSources"`
+	// IDs of the movie at metadata providers without a dedicated column: {"anilist": "21", ...}
+	ProviderIds json.RawMessage `json:"providerIds"`
+	// Placeholders by image path: {"/abc.jpg": {"blurhash": "...", "color": "#1a2b3c"}}
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
+	// Minimum viewer age normalized from age_ratings, NULL when unrated
+	MinAge *int32 `json:"minAge"`
+	// When keywords and tags were first imported; NULL until backfilled
+	TagsImportedAt pgtype.Timestamptz `json:"tagsImportedAt"`
 }
 
 // Movie collections from TMDb (e.g., MCU, Star Wars)
@@ -192,6 +556,8 @@ type MovieCredit struct {
 	CreatedAt  time.Time          `json:"createdAt"`
 	UpdatedAt  time.Time          `json:"updatedAt"`
 	DeletedAt  pgtype.Timestamptz `json:"deletedAt"`
+	// Person this credit belongs to
+	PersonID pgtype.UUID `json:"personId"`
 }
 
 // Physical media files associated with movies
@@ -226,6 +592,10 @@ type MovieFile struct {
 	CreatedAt     time.Time          `json:"createdAt"`
 	UpdatedAt     time.Time          `json:"updatedAt"`
 	DeletedAt     pgtype.Timestamptz `json:"deletedAt"`
+	// Set when an admin identified the file manually; scanners skip locked files
+	MatchLocked bool `json:"matchLocked"`
+	// Edition name parsed from {edition-...} in the file name; NULL for the default version
+	Edition *string `json:"edition"`
 }
 
 // Junction table linking movies to TMDb genres
@@ -238,6 +608,32 @@ type MovieGenre struct {
 	Slug      string    `json:"slug"`
 }
 
+// Trailers, featurettes and other extras found next to movie files
+type MovieMovieExtra struct {
+	ID      uuid.UUID `json:"id"`
+	MovieID uuid.UUID `json:"movieId"`
+	// Kind of extra, from the folder or file name suffix
+	ExtraType       string    `json:"extraType"`
+	Title           string    `json:"title"`
+	FilePath        string    `json:"filePath"`
+	FileSize        int64     `json:"fileSize"`
+	Container       *string   `json:"container"`
+	DurationSeconds *int32    `json:"durationSeconds"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// Keywords and tags of movies from metadata providers
+type MovieMovieTag struct {
+	ID      uuid.UUID `json:"id"`
+	MovieID uuid.UUID `json:"movieId"`
+	Slug    string    `json:"slug"`
+	Name    string    `json:"name"`
+	// Tag reveals plot details
+	Spoiler   bool      `json:"spoiler"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // User watch history and progress tracking for movies
 type MovieWatched struct {
 	ID      uuid.UUID `json:"id"`
@@ -260,6 +656,338 @@ type MovieWatched struct {
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
+type MusicArtist struct {
+	ID            uuid.UUID   `json:"id"`
+	MusicbrainzID pgtype.UUID `json:"musicbrainzId"`
+	Name          string      `json:"name"`
+	SortName      string      `json:"sortName"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	UpdatedAt     time.Time   `json:"updatedAt"`
+}
+
+type MusicRelease struct {
+	ID             uuid.UUID   `json:"id"`
+	ReleaseGroupID uuid.UUID   `json:"releaseGroupId"`
+	MusicbrainzID  pgtype.UUID `json:"musicbrainzId"`
+	Title          string      `json:"title"`
+	SortTitle      string      `json:"sortTitle"`
+	ArtistCredit   string      `json:"artistCredit"`
+	IsCompilation  bool        `json:"isCompilation"`
+	Year           *int32      `json:"year"`
+	ReleaseDate    pgtype.Date `json:"releaseDate"`
+	Label          *string     `json:"label"`
+	CatalogNumber  *string     `json:"catalogNumber"`
+	Country        *string     `json:"country"`
+	Genres         []string    `json:"genres"`
+	DiscCount      int32       `json:"discCount"`
+	TrackCount     int32       `json:"trackCount"`
+	DurationMs     int64       `json:"durationMs"`
+	ReplaygainGain *float32    `json:"replaygainGain"`
+	ReplaygainPeak *float32    `json:"replaygainPeak"`
+	CoverPath      *string     `json:"coverPath"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+}
+
+type MusicReleaseArtist struct {
+	ReleaseID uuid.UUID `json:"releaseId"`
+	ArtistID  uuid.UUID `json:"artistId"`
+	Position  int32     `json:"position"`
+}
+
+type MusicReleaseGroup struct {
+	ID               uuid.UUID   `json:"id"`
+	MusicbrainzID    pgtype.UUID `json:"musicbrainzId"`
+	Title            string      `json:"title"`
+	ArtistCredit     string      `json:"artistCredit"`
+	PrimaryType      *string     `json:"primaryType"`
+	FirstReleaseYear *int32      `json:"firstReleaseYear"`
+	CreatedAt        time.Time   `json:"createdAt"`
+	UpdatedAt        time.Time   `json:"updatedAt"`
+}
+
+type MusicTrack struct {
+	ID                     uuid.UUID   `json:"id"`
+	ReleaseID              uuid.UUID   `json:"releaseId"`
+	MusicbrainzRecordingID pgtype.UUID `json:"musicbrainzRecordingId"`
+	MusicbrainzTrackID     pgtype.UUID `json:"musicbrainzTrackId"`
+	Isrc                   *string     `json:"isrc"`
+	Title                  string      `json:"title"`
+	ArtistCredit           string      `json:"artistCredit"`
+	DiscNumber             int32       `json:"discNumber"`
+	DiscSubtitle           *string     `json:"discSubtitle"`
+	TrackNumber            *int32      `json:"trackNumber"`
+	DurationMs             *int32      `json:"durationMs"`
+	ReplaygainGain         *float32    `json:"replaygainGain"`
+	ReplaygainPeak         *float32    `json:"replaygainPeak"`
+	FilePath               string      `json:"filePath"`
+	FileSize               int64       `json:"fileSize"`
+	Container              *string     `json:"container"`
+	CreatedAt              time.Time   `json:"createdAt"`
+	UpdatedAt              time.Time   `json:"updatedAt"`
+}
+
+type MusicTrackArtist struct {
+	TrackID  uuid.UUID `json:"trackId"`
+	ArtistID uuid.UUID `json:"artistId"`
+	Position int32     `json:"position"`
+}
+
+type MusicvideoArtist struct {
+	ID                  uuid.UUID   `json:"id"`
+	LibraryID           uuid.UUID   `json:"libraryId"`
+	Name                string      `json:"name"`
+	NameKey             string      `json:"nameKey"`
+	SortName            string      `json:"sortName"`
+	Disambiguation      *string     `json:"disambiguation"`
+	MusicbrainzArtistID pgtype.UUID `json:"musicbrainzArtistId"`
+	CreatedAt           time.Time   `json:"createdAt"`
+	UpdatedAt           time.Time   `json:"updatedAt"`
+}
+
+type MusicvideoVideo struct {
+	ID                     uuid.UUID          `json:"id"`
+	LibraryID              uuid.UUID          `json:"libraryId"`
+	ArtistID               uuid.UUID          `json:"artistId"`
+	FilePath               string             `json:"filePath"`
+	FileSize               int64              `json:"fileSize"`
+	Container              *string            `json:"container"`
+	Title                  string             `json:"title"`
+	TitleSource            string             `json:"titleSource"`
+	SortTitle              string             `json:"sortTitle"`
+	ArtistCredit           string             `json:"artistCredit"`
+	Year                   *int32             `json:"year"`
+	DurationSeconds        float64            `json:"durationSeconds"`
+	Width                  int32              `json:"width"`
+	Height                 int32              `json:"height"`
+	VideoCodec             *string            `json:"videoCodec"`
+	AudioCodec             *string            `json:"audioCodec"`
+	MusicbrainzRecordingID pgtype.UUID        `json:"musicbrainzRecordingId"`
+	MatchStatus            string             `json:"matchStatus"`
+	MatchedAt              pgtype.Timestamptz `json:"matchedAt"`
+	CreatedAt              time.Time          `json:"createdAt"`
+	UpdatedAt              time.Time          `json:"updatedAt"`
+}
+
+// Cast and crew members shared by movie and TV credits
+type Person struct {
+	ID                 uuid.UUID   `json:"id"`
+	TmdbID             *int32      `json:"tmdbId"`
+	TvdbID             *int32      `json:"tvdbId"`
+	ImdbID             *string     `json:"imdbId"`
+	Name               string      `json:"name"`
+	AlsoKnownAs        []string    `json:"alsoKnownAs"`
+	Biography          *string     `json:"biography"`
+	Birthday           pgtype.Date `json:"birthday"`
+	Deathday           pgtype.Date `json:"deathday"`
+	Gender             int32       `json:"gender"`
+	PlaceOfBirth       *string     `json:"placeOfBirth"`
+	ProfilePath        *string     `json:"profilePath"`
+	Homepage           *string     `json:"homepage"`
+	KnownForDepartment *string     `json:"knownForDepartment"`
+	Popularity         *float64    `json:"popularity"`
+	// Biographies in other languages keyed by ISO 639-1 code
+	BiographiesI18n json.RawMessage `json:"biographiesI18n"`
+	// Last time details were fetched from a metadata provider; NULL for people only known from credits
+	MetadataUpdatedAt pgtype.Timestamptz `json:"metadataUpdatedAt"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+	// Placeholders by image path
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
+}
+
+type PhotoAlbum struct {
+	ID           uuid.UUID   `json:"id"`
+	LibraryID    uuid.UUID   `json:"libraryId"`
+	Kind         string      `json:"kind"`
+	Title        string      `json:"title"`
+	Description  *string     `json:"description"`
+	FolderPath   *string     `json:"folderPath"`
+	OwnerID      pgtype.UUID `json:"ownerId"`
+	CoverPhotoID pgtype.UUID `json:"coverPhotoId"`
+	CreatedAt    time.Time   `json:"createdAt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+type PhotoAlbumPhoto struct {
+	AlbumID  uuid.UUID   `json:"albumId"`
+	PhotoID  uuid.UUID   `json:"photoId"`
+	Position int32       `json:"position"`
+	AddedBy  pgtype.UUID `json:"addedBy"`
+	AddedAt  time.Time   `json:"addedAt"`
+}
+
+type PhotoAlbumShare struct {
+	AlbumID       uuid.UUID `json:"albumId"`
+	UserID        uuid.UUID `json:"userId"`
+	CanContribute bool      `json:"canContribute"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type PhotoPhoto struct {
+	ID              uuid.UUID `json:"id"`
+	LibraryID       uuid.UUID `json:"libraryId"`
+	FilePath        string    `json:"filePath"`
+	FileSize        int64     `json:"fileSize"`
+	Folder          string    `json:"folder"`
+	Kind            string    `json:"kind"`
+	Format          string    `json:"format"`
+	TakenAt         time.Time `json:"takenAt"`
+	TakenAtSource   string    `json:"takenAtSource"`
+	Width           int32     `json:"width"`
+	Height          int32     `json:"height"`
+	Orientation     int32     `json:"orientation"`
+	CameraMake      *string   `json:"cameraMake"`
+	CameraModel     *string   `json:"cameraModel"`
+	LensModel       *string   `json:"lensModel"`
+	ExposureTime    *string   `json:"exposureTime"`
+	FNumber         *float64  `json:"fNumber"`
+	Iso             *int32    `json:"iso"`
+	FocalLength     *float64  `json:"focalLength"`
+	Latitude        *float64  `json:"latitude"`
+	Longitude       *float64  `json:"longitude"`
+	Altitude        *float64  `json:"altitude"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	Blurhash        *string   `json:"blurhash"`
+	DominantColor   *string   `json:"dominantColor"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type PodcastEpisode struct {
+	ID              uuid.UUID          `json:"id"`
+	PodcastID       uuid.UUID          `json:"podcastId"`
+	Guid            string             `json:"guid"`
+	Title           string             `json:"title"`
+	Description     *string            `json:"description"`
+	Link            *string            `json:"link"`
+	ImageUrl        *string            `json:"imageUrl"`
+	PublishedAt     pgtype.Timestamptz `json:"publishedAt"`
+	DurationSeconds int32              `json:"durationSeconds"`
+	Season          *int32             `json:"season"`
+	EpisodeNumber   *int32             `json:"episodeNumber"`
+	EpisodeType     string             `json:"episodeType"`
+	Explicit        bool               `json:"explicit"`
+	EnclosureUrl    string             `json:"enclosureUrl"`
+	EnclosureType   *string            `json:"enclosureType"`
+	EnclosureLength int64              `json:"enclosureLength"`
+	ChaptersUrl     *string            `json:"chaptersUrl"`
+	Chapters        []byte             `json:"chapters"`
+	Transcripts     json.RawMessage    `json:"transcripts"`
+	TranscriptPath  *string            `json:"transcriptPath"`
+	TranscriptType  *string            `json:"transcriptType"`
+	FilePath        *string            `json:"filePath"`
+	FileSize        int64              `json:"fileSize"`
+	DownloadedAt    pgtype.Timestamptz `json:"downloadedAt"`
+	DownloadError   *string            `json:"downloadError"`
+	Pinned          bool               `json:"pinned"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+type PodcastEpisodeProgress struct {
+	ID              uuid.UUID          `json:"id"`
+	UserID          uuid.UUID          `json:"userId"`
+	EpisodeID       uuid.UUID          `json:"episodeId"`
+	PositionSeconds int32              `json:"positionSeconds"`
+	IsPlayed        bool               `json:"isPlayed"`
+	PlayedAt        pgtype.Timestamptz `json:"playedAt"`
+	LastPlayedAt    time.Time          `json:"lastPlayedAt"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+type PodcastPodcast struct {
+	ID            uuid.UUID          `json:"id"`
+	LibraryID     uuid.UUID          `json:"libraryId"`
+	FeedUrl       string             `json:"feedUrl"`
+	Title         string             `json:"title"`
+	SortTitle     string             `json:"sortTitle"`
+	Author        *string            `json:"author"`
+	Description   *string            `json:"description"`
+	Link          *string            `json:"link"`
+	ImageUrl      *string            `json:"imageUrl"`
+	Language      *string            `json:"language"`
+	Explicit      bool               `json:"explicit"`
+	Categories    []string           `json:"categories"`
+	PodcastGuid   *string            `json:"podcastGuid"`
+	Etag          *string            `json:"etag"`
+	LastModified  *string            `json:"lastModified"`
+	LastCheckedAt pgtype.Timestamptz `json:"lastCheckedAt"`
+	LastError     *string            `json:"lastError"`
+	AutoDownload  bool               `json:"autoDownload"`
+	KeepEpisodes  *int32             `json:"keepEpisodes"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+}
+
+type PodcastSubscription struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"userId"`
+	PodcastID uuid.UUID `json:"podcastId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type QarPerformer struct {
+	ID                uuid.UUID `json:"id"`
+	StashdbID         *string   `json:"stashdbId"`
+	Gender            *string   `json:"gender"`
+	Country           *string   `json:"country"`
+	NameEnc           []byte    `json:"nameEnc"`
+	DisambiguationEnc []byte    `json:"disambiguationEnc"`
+	AliasesEnc        []byte    `json:"aliasesEnc"`
+	ImageUrlEnc       []byte    `json:"imageUrlEnc"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+type QarScene struct {
+	ID              uuid.UUID          `json:"id"`
+	LibraryID       uuid.UUID          `json:"libraryId"`
+	FilePathHash    string             `json:"filePathHash"`
+	FileSize        int64              `json:"fileSize"`
+	Oshash          string             `json:"oshash"`
+	StashdbID       *string            `json:"stashdbId"`
+	StudioID        pgtype.UUID        `json:"studioId"`
+	ReleaseDate     pgtype.Date        `json:"releaseDate"`
+	DurationSeconds *int32             `json:"durationSeconds"`
+	MatchStatus     string             `json:"matchStatus"`
+	MatchType       *string            `json:"matchType"`
+	MatchConfidence *float64           `json:"matchConfidence"`
+	MatchedAt       pgtype.Timestamptz `json:"matchedAt"`
+	FilePathEnc     []byte             `json:"filePathEnc"`
+	TitleEnc        []byte             `json:"titleEnc"`
+	DetailsEnc      []byte             `json:"detailsEnc"`
+	ImageUrlEnc     []byte             `json:"imageUrlEnc"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+type QarScenePerformer struct {
+	SceneID     uuid.UUID `json:"sceneId"`
+	PerformerID uuid.UUID `json:"performerId"`
+}
+
+type QarStudio struct {
+	ID          uuid.UUID   `json:"id"`
+	StashdbID   *string     `json:"stashdbId"`
+	ParentID    pgtype.UUID `json:"parentId"`
+	NameEnc     []byte      `json:"nameEnc"`
+	ImageUrlEnc []byte      `json:"imageUrlEnc"`
+	CreatedAt   time.Time   `json:"createdAt"`
+	UpdatedAt   time.Time   `json:"updatedAt"`
+}
+
+type QarUserPin struct {
+	UserID            uuid.UUID          `json:"userId"`
+	PinHash           string             `json:"pinHash"`
+	UnlockedUntil     pgtype.Timestamptz `json:"unlockedUntil"`
+	PinFailedAttempts int32              `json:"pinFailedAttempts"`
+	PinLockedUntil    pgtype.Timestamptz `json:"pinLockedUntil"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+}
+
 // API keys for programmatic access with scope-based permissions
 type SharedApiKey struct {
 	ID          uuid.UUID `json:"id"`
@@ -299,6 +1027,8 @@ type SharedAuthToken struct {
 	LastUsedAt pgtype.Timestamptz `json:"lastUsedAt"`
 	CreatedAt  time.Time          `json:"createdAt"`
 	UpdatedAt  time.Time          `json:"updatedAt"`
+	// Links this refresh token to the session record it was created with
+	SessionID pgtype.UUID `json:"sessionId"`
 }
 
 // RBAC policies with fine-grained permissions (v0.3.0)
@@ -515,6 +1245,27 @@ type SharedUserAvatar struct {
 	DeletedAt             pgtype.Timestamptz `json:"deletedAt"`
 }
 
+// Per-user content restrictions enforced on list, search, continue watching and playback
+type SharedUserParentalControl struct {
+	UserID uuid.UUID `json:"userId"`
+	// Highest allowed minimum viewer age, NULL for no age limit
+	MaxAge *int32 `json:"maxAge"`
+	// Genre slugs that are never shown to the user
+	BlockedTags []string `json:"blockedTags"`
+	// Whether unrated content is allowed when an age limit is set
+	UnratedPolicy string `json:"unratedPolicy"`
+	// Argon2id hash of the override PIN
+	PinHash *string `json:"pinHash"`
+	// Restrictions are lifted until this time after the PIN was entered
+	OverrideUntil pgtype.Timestamptz `json:"overrideUntil"`
+	// Consecutive wrong override PINs since the last unlock or lockout
+	PinFailedAttempts int32 `json:"pinFailedAttempts"`
+	// PIN entry is refused until this time after too many wrong PINs
+	PinLockedUntil pgtype.Timestamptz `json:"pinLockedUntil"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
 // User preferences for notifications, privacy, and display settings
 type SharedUserPreference struct {
 	UserID uuid.UUID `json:"userId"`
@@ -538,6 +1289,10 @@ type SharedUserPreference struct {
 	UpdatedAt         time.Time `json:"updatedAt"`
 	// Language for metadata display (titles, overviews, taglines). ISO 639-1 code.
 	MetadataLanguage *string `json:"metadataLanguage"`
+	// Genre and tag slugs whose content is hidden from listings
+	HiddenTags []string `json:"hiddenTags"`
+	// Ordered translation fallback chain for localized metadata, e.g. {de-AT,de,original,en}
+	MetadataLanguageChain []string `json:"metadataLanguageChain"`
 }
 
 // User-specific configuration settings
@@ -576,6 +1331,12 @@ type TvshowEpisode struct {
 	ProductionCode *string         `json:"productionCode"`
 	CreatedAt      time.Time       `json:"createdAt"`
 	UpdatedAt      time.Time       `json:"updatedAt"`
+	// Manually edited fields that automatic metadata updates must not overwrite
+	LockedFields []string `json:"lockedFields"`
+	// Provider that supplied each metadata field
+	MetadataSources json.RawMessage `json:"metadataSources"`
+	// Placeholders by image path
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
 }
 
 type TvshowEpisodeCredit struct {
@@ -591,6 +1352,8 @@ type TvshowEpisodeCredit struct {
 	ProfilePath  *string   `json:"profilePath"`
 	CreatedAt    time.Time `json:"createdAt"`
 	UpdatedAt    time.Time `json:"updatedAt"`
+	// Person this credit belongs to
+	PersonID pgtype.UUID `json:"personId"`
 }
 
 type TvshowEpisodeFile struct {
@@ -650,6 +1413,12 @@ type TvshowSeason struct {
 	VoteAverage   pgtype.Numeric  `json:"voteAverage"`
 	CreatedAt     time.Time       `json:"createdAt"`
 	UpdatedAt     time.Time       `json:"updatedAt"`
+	// Manually edited fields that automatic metadata updates must not overwrite
+	LockedFields []string `json:"lockedFields"`
+	// Provider that supplied each metadata field
+	MetadataSources json.RawMessage `json:"metadataSources"`
+	// Placeholders by image path
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
 }
 
 type TvshowSeries struct {
@@ -685,6 +1454,20 @@ type TvshowSeries struct {
 	UpdatedAt         time.Time          `json:"updatedAt"`
 	// External ratings from various providers (IMDb, RT, Metacritic, etc.) as JSON array
 	ExternalRatings json.RawMessage `json:"externalRatings"`
+	// Title used for alphabetical sorting; falls back to title when NULL
+	SortTitle *string `json:"sortTitle"`
+	// Manually edited fields that automatic metadata updates must not overwrite
+	LockedFields []string `json:"lockedFields"`
+	// Provider that supplied each metadata field: {"title": "anilist", ...}
+	MetadataSources json.RawMessage `json:"metadataSources"`
+	// IDs of the series at metadata providers without a dedicated column: {"anilist": "21", ...}
+	ProviderIds json.RawMessage `json:"providerIds"`
+	// Placeholders by image path: {"/abc.jpg": {"blurhash": "...", "color": "#1a2b3c"}}
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
+	// Minimum viewer age normalized from age_ratings, NULL when unrated
+	MinAge *int32 `json:"minAge"`
+	// When keywords and tags were first imported; NULL until backfilled
+	TagsImportedAt pgtype.Timestamptz `json:"tagsImportedAt"`
 }
 
 type TvshowSeriesCredit struct {
@@ -700,6 +1483,8 @@ type TvshowSeriesCredit struct {
 	ProfilePath  *string   `json:"profilePath"`
 	CreatedAt    time.Time `json:"createdAt"`
 	UpdatedAt    time.Time `json:"updatedAt"`
+	// Person this credit belongs to
+	PersonID pgtype.UUID `json:"personId"`
 }
 
 type TvshowSeriesGenre struct {
@@ -715,6 +1500,17 @@ type TvshowSeriesNetwork struct {
 	NetworkID uuid.UUID `json:"networkId"`
 }
 
+// Keywords and tags of series from metadata providers
+type TvshowSeriesTag struct {
+	ID       uuid.UUID `json:"id"`
+	SeriesID uuid.UUID `json:"seriesId"`
+	Slug     string    `json:"slug"`
+	Name     string    `json:"name"`
+	// Tag reveals plot details
+	Spoiler   bool      `json:"spoiler"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // Per-user MFA configuration and enforcement settings
 type UserMfaSetting struct {
 	UserID uuid.UUID `json:"userId"`
@@ -32,6 +32,30 @@ func (q *Queries) AddMovieGenre(ctx context.Context, arg AddMovieGenreParams) er
 	return err
 }
 
+const addMovieTag = `-- name: AddMovieTag :exec
+INSERT INTO
+    movie.movie_tags (movie_id, slug, name, spoiler)
+VALUES ($1, $2, $3, $4) ON CONFLICT (movie_id, slug) DO NOTHING
+`
+
+type AddMovieTagParams struct {
+	MovieID uuid.UUID `json:"movieId"`
+	Slug    string    `json:"slug"`
+	Name    string    `json:"name"`
+	Spoiler bool      `json:"spoiler"`
+}
+
+// Movie Tags Operations
+func (q *Queries) AddMovieTag(ctx context.Context, arg AddMovieTagParams) error {
+	_, err := q.db.Exec(ctx, addMovieTag,
+		arg.MovieID,
+		arg.Slug,
+		arg.Name,
+		arg.Spoiler,
+	)
+	return err
+}
+
 const addMovieToCollection = `-- name: AddMovieToCollection :exec
 INSERT INTO
     movie.movie_collection_members (
@@ -56,6 +80,56 @@ func (q *Queries) AddMovieToCollection(ctx context.Context, arg AddMovieToCollec
 	return err
 }
 
+const assignMovieFileMatch = `-- name: AssignMovieFileMatch :one
+UPDATE movie.movie_files
+SET
+    movie_id = $1,
+    match_locked = $2
+WHERE
+    id = $3
+    AND deleted_at IS NULL RETURNING id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
+`
+
+type AssignMovieFileMatchParams struct {
+	MovieID     uuid.UUID `json:"movieId"`
+	MatchLocked bool      `json:"matchLocked"`
+	ID          uuid.UUID `json:"id"`
+}
+
+func (q *Queries) AssignMovieFileMatch(ctx context.Context, arg AssignMovieFileMatchParams) (MovieFile, error) {
+	row := q.db.QueryRow(ctx, assignMovieFileMatch, arg.MovieID, arg.MatchLocked, arg.ID)
+	var i MovieFile
+	err := row.Scan(
+		&i.ID,
+		&i.MovieID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.FileName,
+		&i.Resolution,
+		&i.QualityProfile,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.BitrateKbps,
+		&i.Framerate,
+		&i.DynamicRange,
+		&i.ColorSpace,
+		&i.AudioChannels,
+		&i.AudioLanguages,
+		&i.SubtitleLanguages,
+		&i.RadarrFileID,
+		&i.LastScannedAt,
+		&i.IsMonitored,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
+	)
+	return i, err
+}
+
 const countMovieCast = `-- name: CountMovieCast :one
 SELECT COUNT(*)
 FROM movie.movie_credits
@@ -89,11 +163,49 @@ func (q *Queries) CountMovieCrew(ctx context.Context, movieID uuid.UUID) (int64,
 }
 
 const countMovies = `-- name: CountMovies :one
-SELECT COUNT(*) FROM movie.movies WHERE deleted_at IS NULL
+SELECT COUNT(*) FROM movie.movies
+WHERE deleted_at IS NULL
+    AND (
+        $1::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $3::integer IS NULL
+        OR min_age <= $3::integer
+        OR (min_age IS NULL AND $4::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($5::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($5::text[])
+    )
 `
 
-func (q *Queries) CountMovies(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countMovies)
+type CountMoviesParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+}
+
+// restrict limits the result to movies with a file under library_paths.
+func (q *Queries) CountMovies(ctx context.Context, arg CountMoviesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countMovies,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -106,10 +218,49 @@ WHERE
     deleted_at IS NULL
     AND vote_average IS NOT NULL
     AND vote_count > $1
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR min_age <= $4::integer
+        OR (min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($6::text[])
+    )
 `
 
-func (q *Queries) CountTopRated(ctx context.Context, voteCount *int32) (int64, error) {
-	row := q.db.QueryRow(ctx, countTopRated, voteCount)
+type CountTopRatedParams struct {
+	VoteCount    *int32   `json:"voteCount"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+}
+
+// restrict limits the result to movies with a file under library_paths.
+func (q *Queries) CountTopRated(ctx context.Context, arg CountTopRatedParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTopRated,
+		arg.VoteCount,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -143,7 +294,9 @@ INSERT INTO
         budget,
         revenue,
         radarr_id,
-        metadata_updated_at
+        metadata_updated_at,
+        sort_title,
+        min_age
     )
 VALUES (
         $1,
@@ -171,8 +324,10 @@ VALUES (
         $23,
         $24,
         $25,
-        $26
-    ) RETURNING id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+        $26,
+        $27,
+        $28
+    ) RETURNING id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 `
 
 type CreateMovieParams struct {
@@ -202,6 +357,8 @@ type CreateMovieParams struct {
 	Revenue           *int64             `json:"revenue"`
 	RadarrID          *int32             `json:"radarrId"`
 	MetadataUpdatedAt pgtype.Timestamptz `json:"metadataUpdatedAt"`
+	SortTitle         *string            `json:"sortTitle"`
+	MinAge            *int32             `json:"minAge"`
 }
 
 // Movie CRUD Operations
@@ -233,6 +390,8 @@ func (q *Queries) CreateMovie(ctx context.Context, arg CreateMovieParams) (Movie
 		arg.Revenue,
 		arg.RadarrID,
 		arg.MetadataUpdatedAt,
+		arg.SortTitle,
+		arg.MinAge,
 	)
 	var i Movie
 	err := row.Scan(
@@ -267,6 +426,13 @@ func (q *Queries) CreateMovie(ctx context.Context, arg CreateMovieParams) (Movie
 		&i.AgeRatings,
 		&i.DeletedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
@@ -316,6 +482,54 @@ func (q *Queries) CreateMovieCollection(ctx context.Context, arg CreateMovieColl
 }
 
 const createMovieCredit = `-- name: CreateMovieCredit :one
+WITH ids AS (
+    SELECT
+        NULLIF($2::integer, 0) AS tmdb_id,
+        $10::integer AS tvdb_id,
+        NULLIF($11::text, '') AS imdb_id
+),
+existing AS (
+    SELECT p.id
+    FROM public.people p, ids
+    WHERE
+        p.tmdb_id = ids.tmdb_id
+        OR p.tvdb_id = ids.tvdb_id
+        OR p.imdb_id = ids.imdb_id
+    ORDER BY
+        (p.tmdb_id = ids.tmdb_id) IS TRUE DESC,
+        (p.tvdb_id = ids.tvdb_id) IS TRUE DESC
+    LIMIT 1
+),
+linked AS (
+    UPDATE public.people p
+    SET
+        tmdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tmdb_id = ids.tmdb_id) THEN p.tmdb_id
+            ELSE COALESCE(p.tmdb_id, ids.tmdb_id)
+        END,
+        tvdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tvdb_id = ids.tvdb_id) THEN p.tvdb_id
+            ELSE COALESCE(p.tvdb_id, ids.tvdb_id)
+        END,
+        imdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.imdb_id = ids.imdb_id) THEN p.imdb_id
+            ELSE COALESCE(p.imdb_id, ids.imdb_id)
+        END,
+        profile_path = COALESCE(p.profile_path, $9::text)
+    FROM existing, ids
+    WHERE p.id = existing.id
+),
+created AS (
+    INSERT INTO
+        public.people (tmdb_id, tvdb_id, imdb_id, name, profile_path)
+    SELECT ids.tmdb_id, ids.tvdb_id, ids.imdb_id, $3::text, $9::text
+    FROM ids
+    WHERE
+        NOT EXISTS (SELECT 1 FROM existing)
+        AND (ids.tmdb_id IS NOT NULL OR ids.tvdb_id IS NOT NULL OR ids.imdb_id IS NOT NULL)
+    ON CONFLICT DO NOTHING
+    RETURNING id
+)
 INSERT INTO
     movie.movie_credits (
         movie_id,
@@ -326,7 +540,8 @@ INSERT INTO
         job,
         department,
         cast_order,
-        profile_path
+        profile_path,
+        person_id
     )
 VALUES (
         $1,
@@ -337,8 +552,9 @@ VALUES (
         $6,
         $7,
         $8,
-        $9
-    ) RETURNING id, movie_id, tmdb_person_id, name, profile_path, credit_type, character, cast_order, job, department, created_at, updated_at, deleted_at
+        $9,
+        COALESCE((SELECT id FROM existing), (SELECT id FROM created))
+    ) RETURNING id, movie_id, tmdb_person_id, name, profile_path, credit_type, character, cast_order, job, department, created_at, updated_at, deleted_at, person_id
 `
 
 type CreateMovieCreditParams struct {
@@ -351,9 +567,15 @@ type CreateMovieCreditParams struct {
 	Department   *string   `json:"department"`
 	CastOrder    *int32    `json:"castOrder"`
 	ProfilePath  *string   `json:"profilePath"`
+	TvdbPersonID *int32    `json:"tvdbPersonId"`
+	ImdbPersonID *string   `json:"imdbPersonId"`
 }
 
 // Movie Credits Operations
+// Creates a credit and links it to the person with the same TMDb, TVDb or
+// IMDb ID, creating the person if none matches. Provider IDs the person is
+// missing are filled in unless another person already has them. Credits
+// without any provider ID are not linked.
 func (q *Queries) CreateMovieCredit(ctx context.Context, arg CreateMovieCreditParams) (MovieCredit, error) {
 	row := q.db.QueryRow(ctx, createMovieCredit,
 		arg.MovieID,
@@ -365,6 +587,8 @@ func (q *Queries) CreateMovieCredit(ctx context.Context, arg CreateMovieCreditPa
 		arg.Department,
 		arg.CastOrder,
 		arg.ProfilePath,
+		arg.TvdbPersonID,
+		arg.ImdbPersonID,
 	)
 	var i MovieCredit
 	err := row.Scan(
@@ -381,6 +605,7 @@ func (q *Queries) CreateMovieCredit(ctx context.Context, arg CreateMovieCreditPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.PersonID,
 	)
 	return i, err
 }
@@ -400,7 +625,8 @@ INSERT INTO
         bitrate_kbps,
         audio_languages,
         subtitle_languages,
-        radarr_file_id
+        radarr_file_id,
+        edition
     )
 VALUES (
         $1,
@@ -415,8 +641,9 @@ VALUES (
         $10,
         $11,
         $12,
-        $13
-    ) RETURNING id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at
+        $13,
+        $14
+    ) RETURNING id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
 `
 
 type CreateMovieFileParams struct {
@@ -433,6 +660,7 @@ type CreateMovieFileParams struct {
 	AudioLanguages    []string  `json:"audioLanguages"`
 	SubtitleLanguages []string  `json:"subtitleLanguages"`
 	RadarrFileID      *int32    `json:"radarrFileId"`
+	Edition           *string   `json:"edition"`
 }
 
 // Movie Files Operations
@@ -451,6 +679,7 @@ func (q *Queries) CreateMovieFile(ctx context.Context, arg CreateMovieFileParams
 		arg.AudioLanguages,
 		arg.SubtitleLanguages,
 		arg.RadarrFileID,
+		arg.Edition,
 	)
 	var i MovieFile
 	err := row.Scan(
@@ -478,6 +707,8 @@ func (q *Queries) CreateMovieFile(ctx context.Context, arg CreateMovieFileParams
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
 	)
 	return i, err
 }
@@ -580,6 +811,15 @@ func (q *Queries) DeleteMovieGenres(ctx context.Context, movieID uuid.UUID) erro
 	return err
 }
 
+const deleteMovieTags = `-- name: DeleteMovieTags :exec
+DELETE FROM movie.movie_tags WHERE movie_id = $1
+`
+
+func (q *Queries) DeleteMovieTags(ctx context.Context, movieID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMovieTags, movieID)
+	return err
+}
+
 const deleteWatchProgress = `-- name: DeleteWatchProgress :exec
 DELETE FROM movie.movie_watched
 WHERE
@@ -625,7 +865,7 @@ func (q *Queries) GetCollectionForMovie(ctx context.Context, movieID uuid.UUID)
 }
 
 const getMovie = `-- name: GetMovie :one
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings FROM movie.movies WHERE id = $1 AND deleted_at IS NULL
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM movie.movies WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetMovie(ctx context.Context, id uuid.UUID) (Movie, error) {
@@ -663,12 +903,19 @@ func (q *Queries) GetMovie(ctx context.Context, id uuid.UUID) (Movie, error) {
 		&i.AgeRatings,
 		&i.DeletedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const getMovieByIMDbID = `-- name: GetMovieByIMDbID :one
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     imdb_id = $1
@@ -710,12 +957,19 @@ func (q *Queries) GetMovieByIMDbID(ctx context.Context, imdbID *string) (Movie,
 		&i.AgeRatings,
 		&i.DeletedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const getMovieByRadarrID = `-- name: GetMovieByRadarrID :one
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     radarr_id = $1
@@ -757,12 +1011,19 @@ func (q *Queries) GetMovieByRadarrID(ctx context.Context, radarrID *int32) (Movi
 		&i.AgeRatings,
 		&i.DeletedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const getMovieByTMDbID = `-- name: GetMovieByTMDbID :one
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     tmdb_id = $1
@@ -804,6 +1065,13 @@ func (q *Queries) GetMovieByTMDbID(ctx context.Context, tmdbID *int32) (Movie, e
 		&i.AgeRatings,
 		&i.DeletedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
@@ -858,8 +1126,30 @@ func (q *Queries) GetMovieCollectionByTMDbID(ctx context.Context, tmdbCollection
 	return i, err
 }
 
+const getMovieExtra = `-- name: GetMovieExtra :one
+SELECT id, movie_id, extra_type, title, file_path, file_size, container, duration_seconds, created_at, updated_at FROM movie.movie_extras WHERE id = $1
+`
+
+func (q *Queries) GetMovieExtra(ctx context.Context, id uuid.UUID) (MovieMovieExtra, error) {
+	row := q.db.QueryRow(ctx, getMovieExtra, id)
+	var i MovieMovieExtra
+	err := row.Scan(
+		&i.ID,
+		&i.MovieID,
+		&i.ExtraType,
+		&i.Title,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getMovieFile = `-- name: GetMovieFile :one
-SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at
+SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
 FROM movie.movie_files
 WHERE
     id = $1
@@ -894,12 +1184,14 @@ func (q *Queries) GetMovieFile(ctx context.Context, id uuid.UUID) (MovieFile, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
 	)
 	return i, err
 }
 
 const getMovieFileByPath = `-- name: GetMovieFileByPath :one
-SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at
+SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
 FROM movie.movie_files
 WHERE
     file_path = $1
@@ -934,12 +1226,14 @@ func (q *Queries) GetMovieFileByPath(ctx context.Context, filePath string) (Movi
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
 	)
 	return i, err
 }
 
 const getMovieFileByRadarrID = `-- name: GetMovieFileByRadarrID :one
-SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at
+SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
 FROM movie.movie_files
 WHERE
     radarr_file_id = $1
@@ -974,6 +1268,8 @@ func (q *Queries) GetMovieFileByRadarrID(ctx context.Context, radarrFileID *int3
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
 	)
 	return i, err
 }
@@ -1034,7 +1330,7 @@ func (q *Queries) GetWatchProgress(ctx context.Context, arg GetWatchProgressPara
 }
 
 const listContinueWatching = `-- name: ListContinueWatching :many
-SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, mw.progress_seconds, mw.duration_seconds, mw.progress_percent, mw.last_watched_at
+SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, m.sort_title, m.locked_fields, m.metadata_sources, m.provider_ids, m.image_placeholders, m.min_age, m.tags_imported_at, mw.progress_seconds, mw.duration_seconds, mw.progress_percent, mw.last_watched_at
 FROM movie.movies m
     JOIN movie.movie_watched mw ON m.id = mw.movie_id
 WHERE
@@ -1042,13 +1338,40 @@ WHERE
     AND mw.is_completed = FALSE
     AND mw.progress_percent > 5
     AND m.deleted_at IS NULL
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE f.movie_id = m.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR m.min_age <= $4::integer
+        OR (m.min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = m.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = m.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY mw.last_watched_at DESC
-LIMIT $2
+LIMIT $7
 `
 
 type ListContinueWatchingParams struct {
-	UserID uuid.UUID `json:"userId"`
-	Limit  int32     `json:"limit"`
+	UserID       uuid.UUID `json:"userId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+	MaxAge       *int32    `json:"maxAge"`
+	AllowUnrated bool      `json:"allowUnrated"`
+	BlockedTags  []string  `json:"blockedTags"`
+	Limit        int32     `json:"limit"`
 }
 
 type ListContinueWatchingRow struct {
@@ -1083,14 +1406,30 @@ type ListContinueWatchingRow struct {
 	AgeRatings        []byte             `json:"ageRatings"`
 	DeletedAt         pgtype.Timestamptz `json:"deletedAt"`
 	ExternalRatings   json.RawMessage    `json:"externalRatings"`
+	SortTitle         *string            `json:"sortTitle"`
+	LockedFields      []string           `json:"lockedFields"`
+	MetadataSources   json.RawMessage    `json:"metadataSources"`
+	ProviderIds       json.RawMessage    `json:"providerIds"`
+	ImagePlaceholders json.RawMessage    `json:"imagePlaceholders"`
+	MinAge            *int32             `json:"minAge"`
+	TagsImportedAt    pgtype.Timestamptz `json:"tagsImportedAt"`
 	ProgressSeconds   int32              `json:"progressSeconds"`
 	DurationSeconds   *int32             `json:"durationSeconds"`
 	ProgressPercent   pgtype.Numeric     `json:"progressPercent"`
 	LastWatchedAt     time.Time          `json:"lastWatchedAt"`
 }
 
+// restrict limits the result to movies with a file under library_paths.
 func (q *Queries) ListContinueWatching(ctx context.Context, arg ListContinueWatchingParams) ([]ListContinueWatchingRow, error) {
-	rows, err := q.db.Query(ctx, listContinueWatching, arg.UserID, arg.Limit)
+	rows, err := q.db.Query(ctx, listContinueWatching,
+		arg.UserID,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1130,6 +1469,13 @@ func (q *Queries) ListContinueWatching(ctx context.Context, arg ListContinueWatc
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 			&i.ProgressSeconds,
 			&i.DurationSeconds,
 			&i.ProgressPercent,
@@ -1178,8 +1524,44 @@ func (q *Queries) ListDistinctMovieGenres(ctx context.Context) ([]ListDistinctMo
 	return items, nil
 }
 
+const listDistinctMovieTags = `-- name: ListDistinctMovieTags :many
+SELECT slug, name, COUNT(DISTINCT movie_id)::bigint AS item_count
+FROM movie.movie_tags
+WHERE
+    NOT spoiler
+GROUP BY slug, name
+ORDER BY item_count DESC, name ASC
+LIMIT $1
+`
+
+type ListDistinctMovieTagsRow struct {
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	ItemCount int64  `json:"itemCount"`
+}
+
+func (q *Queries) ListDistinctMovieTags(ctx context.Context, limit int32) ([]ListDistinctMovieTagsRow, error) {
+	rows, err := q.db.Query(ctx, listDistinctMovieTags, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDistinctMovieTagsRow{}
+	for rows.Next() {
+		var i ListDistinctMovieTagsRow
+		if err := rows.Scan(&i.Slug, &i.Name, &i.ItemCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMovieCast = `-- name: ListMovieCast :many
-SELECT id, movie_id, tmdb_person_id, name, profile_path, credit_type, character, cast_order, job, department, created_at, updated_at, deleted_at
+SELECT id, movie_id, tmdb_person_id, name, profile_path, credit_type, character, cast_order, job, department, created_at, updated_at, deleted_at, person_id
 FROM movie.movie_credits
 WHERE
     movie_id = $1
@@ -1220,6 +1602,7 @@ func (q *Queries) ListMovieCast(ctx context.Context, arg ListMovieCastParams) ([
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.PersonID,
 		); err != nil {
 			return nil, err
 		}
@@ -1232,7 +1615,7 @@ func (q *Queries) ListMovieCast(ctx context.Context, arg ListMovieCastParams) ([
 }
 
 const listMovieCrew = `-- name: ListMovieCrew :many
-SELECT id, movie_id, tmdb_person_id, name, profile_path, credit_type, character, cast_order, job, department, created_at, updated_at, deleted_at
+SELECT id, movie_id, tmdb_person_id, name, profile_path, credit_type, character, cast_order, job, department, created_at, updated_at, deleted_at, person_id
 FROM movie.movie_credits
 WHERE
     movie_id = $1
@@ -1280,6 +1663,46 @@ func (q *Queries) ListMovieCrew(ctx context.Context, arg ListMovieCrewParams) ([
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.PersonID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMovieExtras = `-- name: ListMovieExtras :many
+SELECT id, movie_id, extra_type, title, file_path, file_size, container, duration_seconds, created_at, updated_at
+FROM movie.movie_extras
+WHERE
+    movie_id = $1
+ORDER BY extra_type, title
+`
+
+func (q *Queries) ListMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieMovieExtra, error) {
+	rows, err := q.db.Query(ctx, listMovieExtras, movieID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MovieMovieExtra{}
+	for rows.Next() {
+		var i MovieMovieExtra
+		if err := rows.Scan(
+			&i.ID,
+			&i.MovieID,
+			&i.ExtraType,
+			&i.Title,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Container,
+			&i.DurationSeconds,
+			&i.CreatedAt,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1292,7 +1715,7 @@ func (q *Queries) ListMovieCrew(ctx context.Context, arg ListMovieCrewParams) ([
 }
 
 const listMovieFilesByMovieID = `-- name: ListMovieFilesByMovieID :many
-SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at
+SELECT id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
 FROM movie.movie_files
 WHERE
     movie_id = $1
@@ -1334,6 +1757,8 @@ func (q *Queries) ListMovieFilesByMovieID(ctx context.Context, movieID uuid.UUID
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.MatchLocked,
+			&i.Edition,
 		); err != nil {
 			return nil, err
 		}
@@ -1379,26 +1804,84 @@ func (q *Queries) ListMovieGenres(ctx context.Context, movieID uuid.UUID) ([]Mov
 	return items, nil
 }
 
+const listMovieTags = `-- name: ListMovieTags :many
+SELECT id, movie_id, slug, name, spoiler, created_at
+FROM movie.movie_tags
+WHERE
+    movie_id = $1
+ORDER BY name ASC
+`
+
+func (q *Queries) ListMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieMovieTag, error) {
+	rows, err := q.db.Query(ctx, listMovieTags, movieID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MovieMovieTag{}
+	for rows.Next() {
+		var i MovieMovieTag
+		if err := rows.Scan(
+			&i.ID,
+			&i.MovieID,
+			&i.Slug,
+			&i.Name,
+			&i.Spoiler,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMovies = `-- name: ListMovies :many
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings FROM movie.movies
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM movie.movies
 WHERE deleted_at IS NULL
+    AND (
+        $1::integer IS NULL
+        OR min_age <= $1::integer
+        OR (min_age IS NULL AND $2::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($3::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($3::text[])
+    )
 ORDER BY
-    CASE WHEN $3::text = 'title' THEN title END ASC,
-    CASE WHEN $3::text = 'year' THEN year END DESC,
-    CASE WHEN $3::text = 'added' THEN library_added_at END DESC,
-    CASE WHEN $3::text = 'rating' THEN vote_average END DESC,
+    CASE WHEN $4::text = 'title' THEN COALESCE(sort_title, title) END ASC,
+    CASE WHEN $4::text = 'year' THEN year END DESC,
+    CASE WHEN $4::text = 'added' THEN library_added_at END DESC,
+    CASE WHEN $4::text = 'rating' THEN vote_average END DESC,
     library_added_at DESC
-LIMIT $1 OFFSET $2
+LIMIT $6 OFFSET $5
 `
 
 type ListMoviesParams struct {
-	Limit   int32   `json:"limit"`
-	Offset  int32   `json:"offset"`
-	OrderBy *string `json:"orderBy"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	OrderBy      *string  `json:"orderBy"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
 }
 
 func (q *Queries) ListMovies(ctx context.Context, arg ListMoviesParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, listMovies, arg.Limit, arg.Offset, arg.OrderBy)
+	rows, err := q.db.Query(ctx, listMovies,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.OrderBy,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1438,6 +1921,13 @@ func (q *Queries) ListMovies(ctx context.Context, arg ListMoviesParams) ([]Movie
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1450,7 +1940,7 @@ func (q *Queries) ListMovies(ctx context.Context, arg ListMoviesParams) ([]Movie
 }
 
 const listMoviesByCollection = `-- name: ListMoviesByCollection :many
-SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings
+SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, m.sort_title, m.locked_fields, m.metadata_sources, m.provider_ids, m.image_placeholders, m.min_age, m.tags_imported_at
 FROM movie.movies m
     JOIN movie.movie_collection_members mcm ON m.id = mcm.movie_id
 WHERE
@@ -1500,6 +1990,13 @@ func (q *Queries) ListMoviesByCollection(ctx context.Context, collectionID uuid.
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1512,26 +2009,63 @@ func (q *Queries) ListMoviesByCollection(ctx context.Context, collectionID uuid.
 }
 
 const listMoviesByGenre = `-- name: ListMoviesByGenre :many
-SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings
+SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, m.sort_title, m.locked_fields, m.metadata_sources, m.provider_ids, m.image_placeholders, m.min_age, m.tags_imported_at
 FROM movie.movies m
     JOIN movie.movie_genres mg ON m.id = mg.movie_id
 WHERE
     mg.slug = $1
     AND m.deleted_at IS NULL
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE f.movie_id = m.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR m.min_age <= $4::integer
+        OR (m.min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = m.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = m.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY m.vote_average DESC NULLS LAST, m.title ASC
-LIMIT $2
+LIMIT $8
 OFFSET
-    $3
+    $7
 `
 
 type ListMoviesByGenreParams struct {
-	Slug   string `json:"slug"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Slug         string   `json:"slug"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
 }
 
+// restrict limits the result to movies with a file under library_paths.
 func (q *Queries) ListMoviesByGenre(ctx context.Context, arg ListMoviesByGenreParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, listMoviesByGenre, arg.Slug, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listMoviesByGenre,
+		arg.Slug,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1571,6 +2105,13 @@ func (q *Queries) ListMoviesByGenre(ctx context.Context, arg ListMoviesByGenrePa
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1582,26 +2123,50 @@ func (q *Queries) ListMoviesByGenre(ctx context.Context, arg ListMoviesByGenrePa
 	return items, nil
 }
 
-const listMoviesByYear = `-- name: ListMoviesByYear :many
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
-FROM movie.movies
+const listMoviesByTag = `-- name: ListMoviesByTag :many
+SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, m.sort_title, m.locked_fields, m.metadata_sources, m.provider_ids, m.image_placeholders, m.min_age, m.tags_imported_at
+FROM movie.movies m
+    JOIN movie.movie_tags mt ON m.id = mt.movie_id
 WHERE
-    deleted_at IS NULL
-    AND year = $1
-ORDER BY vote_average DESC NULLS LAST, title ASC
-LIMIT $2
+    mt.slug = $1
+    AND m.deleted_at IS NULL
+    AND (
+        $2::integer IS NULL
+        OR m.min_age <= $2::integer
+        OR (m.min_age IS NULL AND $3::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = m.id AND bg.slug = ANY($4::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = m.id AND bt.slug = ANY($4::text[])
+    )
+ORDER BY m.vote_average DESC NULLS LAST, m.title ASC
+LIMIT $6
 OFFSET
-    $3
+    $5
 `
 
-type ListMoviesByYearParams struct {
-	Year   *int32 `json:"year"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
-}
-
-func (q *Queries) ListMoviesByYear(ctx context.Context, arg ListMoviesByYearParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, listMoviesByYear, arg.Year, arg.Limit, arg.Offset)
+type ListMoviesByTagParams struct {
+	Slug         string   `json:"slug"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
+}
+
+func (q *Queries) ListMoviesByTag(ctx context.Context, arg ListMoviesByTagParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listMoviesByTag,
+		arg.Slug,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1641,6 +2206,13 @@ func (q *Queries) ListMoviesByYear(ctx context.Context, arg ListMoviesByYearPara
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1652,24 +2224,193 @@ func (q *Queries) ListMoviesByYear(ctx context.Context, arg ListMoviesByYearPara
 	return items, nil
 }
 
-const listRecentlyAdded = `-- name: ListRecentlyAdded :many
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+const listMoviesByYear = `-- name: ListMoviesByYear :many
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     deleted_at IS NULL
-ORDER BY library_added_at DESC
-LIMIT $1
+    AND year = $1
+ORDER BY vote_average DESC NULLS LAST, title ASC
+LIMIT $2
 OFFSET
-    $2
+    $3
 `
 
-type ListRecentlyAddedParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+type ListMoviesByYearParams struct {
+	Year   *int32 `json:"year"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
 }
 
-func (q *Queries) ListRecentlyAdded(ctx context.Context, arg ListRecentlyAddedParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, listRecentlyAdded, arg.Limit, arg.Offset)
+func (q *Queries) ListMoviesByYear(ctx context.Context, arg ListMoviesByYearParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listMoviesByYear, arg.Year, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Movie{}
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Title,
+			&i.OriginalTitle,
+			&i.Year,
+			&i.ReleaseDate,
+			&i.Runtime,
+			&i.Overview,
+			&i.Tagline,
+			&i.Status,
+			&i.OriginalLanguage,
+			&i.PosterPath,
+			&i.BackdropPath,
+			&i.TrailerUrl,
+			&i.VoteAverage,
+			&i.VoteCount,
+			&i.Popularity,
+			&i.Budget,
+			&i.Revenue,
+			&i.LibraryAddedAt,
+			&i.MetadataUpdatedAt,
+			&i.RadarrID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TitlesI18n,
+			&i.TaglinesI18n,
+			&i.OverviewsI18n,
+			&i.AgeRatings,
+			&i.DeletedAt,
+			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMoviesDueForRefresh = `-- name: ListMoviesDueForRefresh :many
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
+FROM movie.movies
+WHERE
+    deleted_at IS NULL
+    AND tmdb_id IS NOT NULL
+    AND (
+        metadata_updated_at IS NULL
+        OR metadata_updated_at < CASE
+            WHEN overview IS NULL OR poster_path IS NULL THEN $1::timestamptz
+            WHEN release_date IS NULL OR release_date >= $2::date THEN $3::timestamptz
+            ELSE $4::timestamptz
+        END
+    )
+ORDER BY metadata_updated_at ASC NULLS FIRST
+LIMIT $5
+`
+
+type ListMoviesDueForRefreshParams struct {
+	IncompleteBefore time.Time   `json:"incompleteBefore"`
+	ActiveSince      pgtype.Date `json:"activeSince"`
+	ActiveBefore     time.Time   `json:"activeBefore"`
+	SettledBefore    time.Time   `json:"settledBefore"`
+	Limit            int32       `json:"limit"`
+}
+
+func (q *Queries) ListMoviesDueForRefresh(ctx context.Context, arg ListMoviesDueForRefreshParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listMoviesDueForRefresh,
+		arg.IncompleteBefore,
+		arg.ActiveSince,
+		arg.ActiveBefore,
+		arg.SettledBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Movie{}
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Title,
+			&i.OriginalTitle,
+			&i.Year,
+			&i.ReleaseDate,
+			&i.Runtime,
+			&i.Overview,
+			&i.Tagline,
+			&i.Status,
+			&i.OriginalLanguage,
+			&i.PosterPath,
+			&i.BackdropPath,
+			&i.TrailerUrl,
+			&i.VoteAverage,
+			&i.VoteCount,
+			&i.Popularity,
+			&i.Budget,
+			&i.Revenue,
+			&i.LibraryAddedAt,
+			&i.MetadataUpdatedAt,
+			&i.RadarrID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TitlesI18n,
+			&i.TaglinesI18n,
+			&i.OverviewsI18n,
+			&i.AgeRatings,
+			&i.DeletedAt,
+			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMoviesPendingTagImport = `-- name: ListMoviesPendingTagImport :many
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
+FROM movie.movies
+WHERE
+    deleted_at IS NULL
+    AND tmdb_id IS NOT NULL
+    AND tags_imported_at IS NULL
+    AND id > $1
+ORDER BY id ASC
+LIMIT $2
+`
+
+type ListMoviesPendingTagImportParams struct {
+	After uuid.UUID `json:"after"`
+	Limit int32     `json:"limit"`
+}
+
+func (q *Queries) ListMoviesPendingTagImport(ctx context.Context, arg ListMoviesPendingTagImportParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listMoviesPendingTagImport, arg.After, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1709,6 +2450,124 @@ func (q *Queries) ListRecentlyAdded(ctx context.Context, arg ListRecentlyAddedPa
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentlyAdded = `-- name: ListRecentlyAdded :many
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
+FROM movie.movies
+WHERE
+    deleted_at IS NULL
+    AND (
+        $1::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $3::integer IS NULL
+        OR min_age <= $3::integer
+        OR (min_age IS NULL AND $4::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($5::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($5::text[])
+    )
+ORDER BY library_added_at DESC
+LIMIT $7
+OFFSET
+    $6
+`
+
+type ListRecentlyAddedParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
+}
+
+// restrict limits the result to movies with a file under library_paths.
+func (q *Queries) ListRecentlyAdded(ctx context.Context, arg ListRecentlyAddedParams) ([]Movie, error) {
+	rows, err := q.db.Query(ctx, listRecentlyAdded,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Movie{}
+	for rows.Next() {
+		var i Movie
+		if err := rows.Scan(
+			&i.ID,
+			&i.TmdbID,
+			&i.ImdbID,
+			&i.Title,
+			&i.OriginalTitle,
+			&i.Year,
+			&i.ReleaseDate,
+			&i.Runtime,
+			&i.Overview,
+			&i.Tagline,
+			&i.Status,
+			&i.OriginalLanguage,
+			&i.PosterPath,
+			&i.BackdropPath,
+			&i.TrailerUrl,
+			&i.VoteAverage,
+			&i.VoteCount,
+			&i.Popularity,
+			&i.Budget,
+			&i.Revenue,
+			&i.LibraryAddedAt,
+			&i.MetadataUpdatedAt,
+			&i.RadarrID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TitlesI18n,
+			&i.TaglinesI18n,
+			&i.OverviewsI18n,
+			&i.AgeRatings,
+			&i.DeletedAt,
+			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1721,26 +2580,63 @@ func (q *Queries) ListRecentlyAdded(ctx context.Context, arg ListRecentlyAddedPa
 }
 
 const listTopRated = `-- name: ListTopRated :many
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     deleted_at IS NULL
     AND vote_average IS NOT NULL
     AND vote_count > $1
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR min_age <= $4::integer
+        OR (min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY vote_average DESC, vote_count DESC
-LIMIT $2
+LIMIT $8
 OFFSET
-    $3
+    $7
 `
 
 type ListTopRatedParams struct {
-	VoteCount *int32 `json:"voteCount"`
-	Limit     int32  `json:"limit"`
-	Offset    int32  `json:"offset"`
+	VoteCount    *int32   `json:"voteCount"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
 }
 
+// restrict limits the result to movies with a file under library_paths.
 func (q *Queries) ListTopRated(ctx context.Context, arg ListTopRatedParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, listTopRated, arg.VoteCount, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listTopRated,
+		arg.VoteCount,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1780,6 +2676,13 @@ func (q *Queries) ListTopRated(ctx context.Context, arg ListTopRatedParams) ([]M
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1792,7 +2695,7 @@ func (q *Queries) ListTopRated(ctx context.Context, arg ListTopRatedParams) ([]M
 }
 
 const listWatchedMovies = `-- name: ListWatchedMovies :many
-SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, mw.watch_count, mw.last_watched_at
+SELECT m.id, m.tmdb_id, m.imdb_id, m.title, m.original_title, m.year, m.release_date, m.runtime, m.overview, m.tagline, m.status, m.original_language, m.poster_path, m.backdrop_path, m.trailer_url, m.vote_average, m.vote_count, m.popularity, m.budget, m.revenue, m.library_added_at, m.metadata_updated_at, m.radarr_id, m.created_at, m.updated_at, m.titles_i18n, m.taglines_i18n, m.overviews_i18n, m.age_ratings, m.deleted_at, m.external_ratings, m.sort_title, m.locked_fields, m.metadata_sources, m.provider_ids, m.image_placeholders, m.min_age, m.tags_imported_at, mw.watch_count, mw.last_watched_at
 FROM movie.movies m
     JOIN movie.movie_watched mw ON m.id = mw.movie_id
 WHERE
@@ -1843,6 +2746,13 @@ type ListWatchedMoviesRow struct {
 	AgeRatings        []byte             `json:"ageRatings"`
 	DeletedAt         pgtype.Timestamptz `json:"deletedAt"`
 	ExternalRatings   json.RawMessage    `json:"externalRatings"`
+	SortTitle         *string            `json:"sortTitle"`
+	LockedFields      []string           `json:"lockedFields"`
+	MetadataSources   json.RawMessage    `json:"metadataSources"`
+	ProviderIds       json.RawMessage    `json:"providerIds"`
+	ImagePlaceholders json.RawMessage    `json:"imagePlaceholders"`
+	MinAge            *int32             `json:"minAge"`
+	TagsImportedAt    pgtype.Timestamptz `json:"tagsImportedAt"`
 	WatchCount        *int32             `json:"watchCount"`
 	LastWatchedAt     time.Time          `json:"lastWatchedAt"`
 }
@@ -1888,6 +2798,13 @@ func (q *Queries) ListWatchedMovies(ctx context.Context, arg ListWatchedMoviesPa
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 			&i.WatchCount,
 			&i.LastWatchedAt,
 		); err != nil {
@@ -1919,7 +2836,7 @@ func (q *Queries) RemoveMovieFromCollection(ctx context.Context, arg RemoveMovie
 }
 
 const searchMoviesByTitle = `-- name: SearchMoviesByTitle :many
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     deleted_at IS NULL
@@ -1927,6 +2844,19 @@ WHERE
         title % $1
         OR original_title % $1
     )
+    AND (
+        $4::integer IS NULL
+        OR min_age <= $4::integer
+        OR (min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY similarity (title, $1) DESC, similarity (original_title, $1) DESC
 LIMIT $2
 OFFSET
@@ -1934,13 +2864,23 @@ OFFSET
 `
 
 type SearchMoviesByTitleParams struct {
-	Title  string `json:"title"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Title        string   `json:"title"`
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
 }
 
 func (q *Queries) SearchMoviesByTitle(ctx context.Context, arg SearchMoviesByTitleParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, searchMoviesByTitle, arg.Title, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, searchMoviesByTitle,
+		arg.Title,
+		arg.Limit,
+		arg.Offset,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1980,6 +2920,13 @@ func (q *Queries) SearchMoviesByTitle(ctx context.Context, arg SearchMoviesByTit
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1992,7 +2939,7 @@ func (q *Queries) SearchMoviesByTitle(ctx context.Context, arg SearchMoviesByTit
 }
 
 const searchMoviesByTitleAnyLanguage = `-- name: SearchMoviesByTitleAnyLanguage :many
-SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+SELECT id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM movie.movies
 WHERE
     deleted_at IS NULL
@@ -2006,6 +2953,19 @@ WHERE
                 value ILIKE '%' || $1 || '%'
         )
     )
+    AND (
+        $4::integer IS NULL
+        OR min_age <= $4::integer
+        OR (min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY
     CASE
         WHEN title ILIKE $1 THEN 1
@@ -2020,13 +2980,23 @@ OFFSET
 `
 
 type SearchMoviesByTitleAnyLanguageParams struct {
-	Column1 *string `json:"column1"`
-	Limit   int32   `json:"limit"`
-	Offset  int32   `json:"offset"`
+	Column1      *string  `json:"column1"`
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
 }
 
 func (q *Queries) SearchMoviesByTitleAnyLanguage(ctx context.Context, arg SearchMoviesByTitleAnyLanguageParams) ([]Movie, error) {
-	rows, err := q.db.Query(ctx, searchMoviesByTitleAnyLanguage, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, searchMoviesByTitleAnyLanguage,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2066,6 +3036,13 @@ func (q *Queries) SearchMoviesByTitleAnyLanguage(ctx context.Context, arg Search
 			&i.AgeRatings,
 			&i.DeletedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -2077,6 +3054,158 @@ func (q *Queries) SearchMoviesByTitleAnyLanguage(ctx context.Context, arg Search
 	return items, nil
 }
 
+const setMovieFileMatchLocked = `-- name: SetMovieFileMatchLocked :one
+UPDATE movie.movie_files
+SET
+    match_locked = $1
+WHERE
+    id = $2
+    AND deleted_at IS NULL RETURNING id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
+`
+
+type SetMovieFileMatchLockedParams struct {
+	MatchLocked bool      `json:"matchLocked"`
+	ID          uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetMovieFileMatchLocked(ctx context.Context, arg SetMovieFileMatchLockedParams) (MovieFile, error) {
+	row := q.db.QueryRow(ctx, setMovieFileMatchLocked, arg.MatchLocked, arg.ID)
+	var i MovieFile
+	err := row.Scan(
+		&i.ID,
+		&i.MovieID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.FileName,
+		&i.Resolution,
+		&i.QualityProfile,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.BitrateKbps,
+		&i.Framerate,
+		&i.DynamicRange,
+		&i.ColorSpace,
+		&i.AudioChannels,
+		&i.AudioLanguages,
+		&i.SubtitleLanguages,
+		&i.RadarrFileID,
+		&i.LastScannedAt,
+		&i.IsMonitored,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
+	)
+	return i, err
+}
+
+const setMovieImagePlaceholder = `-- name: SetMovieImagePlaceholder :execrows
+UPDATE movie.movies
+SET
+    image_placeholders = (
+        SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+        FROM jsonb_each(
+                image_placeholders || jsonb_build_object(
+                    $1::text, $2::jsonb
+                )
+            ) AS p
+        WHERE
+            p.key IN (poster_path, backdrop_path)
+    )
+WHERE
+    id = $3
+    AND deleted_at IS NULL
+`
+
+type SetMovieImagePlaceholderParams struct {
+	Path        string          `json:"path"`
+	Placeholder json.RawMessage `json:"placeholder"`
+	ID          uuid.UUID       `json:"id"`
+}
+
+// Stores the placeholder of one of the movie's images. Placeholders of
+// images the movie no longer uses are dropped.
+func (q *Queries) SetMovieImagePlaceholder(ctx context.Context, arg SetMovieImagePlaceholderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setMovieImagePlaceholder, arg.Path, arg.Placeholder, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setMovieLockedFields = `-- name: SetMovieLockedFields :one
+UPDATE movie.movies
+SET
+    locked_fields = $1::text[],
+    updated_at = NOW()
+WHERE
+    id = $2
+    AND deleted_at IS NULL RETURNING id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
+`
+
+type SetMovieLockedFieldsParams struct {
+	LockedFields []string  `json:"lockedFields"`
+	ID           uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetMovieLockedFields(ctx context.Context, arg SetMovieLockedFieldsParams) (Movie, error) {
+	row := q.db.QueryRow(ctx, setMovieLockedFields, arg.LockedFields, arg.ID)
+	var i Movie
+	err := row.Scan(
+		&i.ID,
+		&i.TmdbID,
+		&i.ImdbID,
+		&i.Title,
+		&i.OriginalTitle,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Runtime,
+		&i.Overview,
+		&i.Tagline,
+		&i.Status,
+		&i.OriginalLanguage,
+		&i.PosterPath,
+		&i.BackdropPath,
+		&i.TrailerUrl,
+		&i.VoteAverage,
+		&i.VoteCount,
+		&i.Popularity,
+		&i.Budget,
+		&i.Revenue,
+		&i.LibraryAddedAt,
+		&i.MetadataUpdatedAt,
+		&i.RadarrID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TitlesI18n,
+		&i.TaglinesI18n,
+		&i.OverviewsI18n,
+		&i.AgeRatings,
+		&i.DeletedAt,
+		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
+	)
+	return i, err
+}
+
+const setMovieTagsImported = `-- name: SetMovieTagsImported :exec
+UPDATE movie.movies SET tags_imported_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) SetMovieTagsImported(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setMovieTagsImported, id)
+	return err
+}
+
 const updateMovie = `-- name: UpdateMovie :one
 UPDATE movie.movies
 SET
@@ -2131,50 +3260,66 @@ SET
         $15,
         age_ratings
     ),
+    min_age = CASE
+        WHEN $15 IS NULL THEN min_age
+        ELSE $16::integer
+    END,
     external_ratings = COALESCE(
-        $16,
+        $17,
         external_ratings
     ),
+    metadata_sources = metadata_sources || COALESCE(
+        $18::jsonb,
+        '{}'::jsonb
+    ),
+    provider_ids = provider_ids || COALESCE(
+        $19::jsonb,
+        '{}'::jsonb
+    ),
     poster_path = COALESCE(
-        $17,
+        $20,
         poster_path
     ),
     backdrop_path = COALESCE(
-        $18,
+        $21,
         backdrop_path
     ),
     trailer_url = COALESCE(
-        $19,
+        $22,
         trailer_url
     ),
     vote_average = COALESCE(
-        $20,
+        $23,
         vote_average
     ),
     vote_count = COALESCE(
-        $21,
+        $24,
         vote_count
     ),
     popularity = COALESCE(
-        $22,
+        $25,
         popularity
     ),
-    budget = COALESCE($23, budget),
+    budget = COALESCE($26, budget),
     revenue = COALESCE(
-        $24,
+        $27,
         revenue
     ),
     radarr_id = COALESCE(
-        $25,
+        $28,
         radarr_id
     ),
     metadata_updated_at = COALESCE(
-        $26,
+        $29,
         metadata_updated_at
+    ),
+    sort_title = COALESCE(
+        $30,
+        sort_title
     )
 WHERE
-    id = $27
-    AND deleted_at IS NULL RETURNING id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings
+    id = $31
+    AND deleted_at IS NULL RETURNING id, tmdb_id, imdb_id, title, original_title, year, release_date, runtime, overview, tagline, status, original_language, poster_path, backdrop_path, trailer_url, vote_average, vote_count, popularity, budget, revenue, library_added_at, metadata_updated_at, radarr_id, created_at, updated_at, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, deleted_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 `
 
 type UpdateMovieParams struct {
@@ -2193,7 +3338,10 @@ type UpdateMovieParams struct {
 	TaglinesI18n      []byte             `json:"taglinesI18n"`
 	OverviewsI18n     []byte             `json:"overviewsI18n"`
 	AgeRatings        []byte             `json:"ageRatings"`
+	MinAge            *int32             `json:"minAge"`
 	ExternalRatings   []byte             `json:"externalRatings"`
+	MetadataSources   []byte             `json:"metadataSources"`
+	ProviderIds       []byte             `json:"providerIds"`
 	PosterPath        *string            `json:"posterPath"`
 	BackdropPath      *string            `json:"backdropPath"`
 	TrailerUrl        *string            `json:"trailerUrl"`
@@ -2204,6 +3352,7 @@ type UpdateMovieParams struct {
 	Revenue           *int64             `json:"revenue"`
 	RadarrID          *int32             `json:"radarrId"`
 	MetadataUpdatedAt pgtype.Timestamptz `json:"metadataUpdatedAt"`
+	SortTitle         *string            `json:"sortTitle"`
 	ID                uuid.UUID          `json:"id"`
 }
 
@@ -2224,7 +3373,10 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie
 		arg.TaglinesI18n,
 		arg.OverviewsI18n,
 		arg.AgeRatings,
+		arg.MinAge,
 		arg.ExternalRatings,
+		arg.MetadataSources,
+		arg.ProviderIds,
 		arg.PosterPath,
 		arg.BackdropPath,
 		arg.TrailerUrl,
@@ -2235,6 +3387,7 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie
 		arg.Revenue,
 		arg.RadarrID,
 		arg.MetadataUpdatedAt,
+		arg.SortTitle,
 		arg.ID,
 	)
 	var i Movie
@@ -2270,6 +3423,13 @@ func (q *Queries) UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie
 		&i.AgeRatings,
 		&i.DeletedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
@@ -2381,7 +3541,7 @@ SET
     )
 WHERE
     id = $12
-    AND deleted_at IS NULL RETURNING id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at
+    AND deleted_at IS NULL RETURNING id, movie_id, file_path, file_size, file_name, resolution, quality_profile, video_codec, audio_codec, container, duration_seconds, bitrate_kbps, framerate, dynamic_range, color_space, audio_channels, audio_languages, subtitle_languages, radarr_file_id, last_scanned_at, is_monitored, created_at, updated_at, deleted_at, match_locked, edition
 `
 
 type UpdateMovieFileParams struct {
@@ -2440,6 +3600,68 @@ func (q *Queries) UpdateMovieFile(ctx context.Context, arg UpdateMovieFileParams
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.MatchLocked,
+		&i.Edition,
+	)
+	return i, err
+}
+
+const upsertMovieExtra = `-- name: UpsertMovieExtra :one
+INSERT INTO
+    movie.movie_extras (
+        movie_id,
+        extra_type,
+        title,
+        file_path,
+        file_size,
+        container,
+        duration_seconds
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (file_path) DO UPDATE
+SET
+    movie_id = EXCLUDED.movie_id,
+    extra_type = EXCLUDED.extra_type,
+    title = EXCLUDED.title,
+    file_size = EXCLUDED.file_size,
+    container = COALESCE(EXCLUDED.container, movie.movie_extras.container),
+    duration_seconds = COALESCE(EXCLUDED.duration_seconds, movie.movie_extras.duration_seconds)
+RETURNING id, movie_id, extra_type, title, file_path, file_size, container, duration_seconds, created_at, updated_at
+`
+
+type UpsertMovieExtraParams struct {
+	MovieID         uuid.UUID `json:"movieId"`
+	ExtraType       string    `json:"extraType"`
+	Title           string    `json:"title"`
+	FilePath        string    `json:"filePath"`
+	FileSize        int64     `json:"fileSize"`
+	Container       *string   `json:"container"`
+	DurationSeconds *int32    `json:"durationSeconds"`
+}
+
+// Movie Extras Operations
+func (q *Queries) UpsertMovieExtra(ctx context.Context, arg UpsertMovieExtraParams) (MovieMovieExtra, error) {
+	row := q.db.QueryRow(ctx, upsertMovieExtra,
+		arg.MovieID,
+		arg.ExtraType,
+		arg.Title,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Container,
+		arg.DurationSeconds,
+	)
+	var i MovieMovieExtra
+	err := row.Scan(
+		&i.ID,
+		&i.MovieID,
+		&i.ExtraType,
+		&i.Title,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -13,16 +13,25 @@ import (
 type Querier interface {
 	// Movie Genres Operations
 	AddMovieGenre(ctx context.Context, arg AddMovieGenreParams) error
+	// Movie Tags Operations
+	AddMovieTag(ctx context.Context, arg AddMovieTagParams) error
 	AddMovieToCollection(ctx context.Context, arg AddMovieToCollectionParams) error
+	AssignMovieFileMatch(ctx context.Context, arg AssignMovieFileMatchParams) (MovieFile, error)
 	CountMovieCast(ctx context.Context, movieID uuid.UUID) (int64, error)
 	CountMovieCrew(ctx context.Context, movieID uuid.UUID) (int64, error)
-	CountMovies(ctx context.Context) (int64, error)
-	CountTopRated(ctx context.Context, voteCount *int32) (int64, error)
+	// restrict limits the result to movies with a file under library_paths.
+	CountMovies(ctx context.Context, arg CountMoviesParams) (int64, error)
+	// restrict limits the result to movies with a file under library_paths.
+	CountTopRated(ctx context.Context, arg CountTopRatedParams) (int64, error)
 	// Movie CRUD Operations
 	CreateMovie(ctx context.Context, arg CreateMovieParams) (Movie, error)
 	// Movie Collections Operations
 	CreateMovieCollection(ctx context.Context, arg CreateMovieCollectionParams) (MovieCollection, error)
 	// Movie Credits Operations
+	// Creates a credit and links it to the person with the same TMDb, TVDb or
+	// IMDb ID, creating the person if none matches. Provider IDs the person is
+	// missing are filled in unless another person already has them. Credits
+	// without any provider ID are not linked.
 	CreateMovieCredit(ctx context.Context, arg CreateMovieCreditParams) (MovieCredit, error)
 	// Movie Files Operations
 	CreateMovieFile(ctx context.Context, arg CreateMovieFileParams) (MovieFile, error)
@@ -32,6 +41,7 @@ type Querier interface {
 	DeleteMovieCredits(ctx context.Context, movieID uuid.UUID) error
 	DeleteMovieFile(ctx context.Context, id uuid.UUID) error
 	DeleteMovieGenres(ctx context.Context, movieID uuid.UUID) error
+	DeleteMovieTags(ctx context.Context, movieID uuid.UUID) error
 	DeleteWatchProgress(ctx context.Context, arg DeleteWatchProgressParams) error
 	GetCollectionForMovie(ctx context.Context, movieID uuid.UUID) (MovieCollection, error)
 	GetMovie(ctx context.Context, id uuid.UUID) (Movie, error)
@@ -40,30 +50,49 @@ type Querier interface {
 	GetMovieByTMDbID(ctx context.Context, tmdbID *int32) (Movie, error)
 	GetMovieCollection(ctx context.Context, id uuid.UUID) (MovieCollection, error)
 	GetMovieCollectionByTMDbID(ctx context.Context, tmdbCollectionID *int32) (MovieCollection, error)
+	GetMovieExtra(ctx context.Context, id uuid.UUID) (MovieMovieExtra, error)
 	GetMovieFile(ctx context.Context, id uuid.UUID) (MovieFile, error)
 	GetMovieFileByPath(ctx context.Context, filePath string) (MovieFile, error)
 	GetMovieFileByRadarrID(ctx context.Context, radarrFileID *int32) (MovieFile, error)
 	GetUserMovieStats(ctx context.Context, userID uuid.UUID) (GetUserMovieStatsRow, error)
 	GetWatchProgress(ctx context.Context, arg GetWatchProgressParams) (MovieWatched, error)
+	// restrict limits the result to movies with a file under library_paths.
 	ListContinueWatching(ctx context.Context, arg ListContinueWatchingParams) ([]ListContinueWatchingRow, error)
 	ListDistinctMovieGenres(ctx context.Context) ([]ListDistinctMovieGenresRow, error)
+	ListDistinctMovieTags(ctx context.Context, limit int32) ([]ListDistinctMovieTagsRow, error)
 	ListMovieCast(ctx context.Context, arg ListMovieCastParams) ([]MovieCredit, error)
 	ListMovieCrew(ctx context.Context, arg ListMovieCrewParams) ([]MovieCredit, error)
+	ListMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieMovieExtra, error)
 	ListMovieFilesByMovieID(ctx context.Context, movieID uuid.UUID) ([]MovieFile, error)
 	ListMovieGenres(ctx context.Context, movieID uuid.UUID) ([]MovieGenre, error)
+	ListMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieMovieTag, error)
 	ListMovies(ctx context.Context, arg ListMoviesParams) ([]Movie, error)
 	ListMoviesByCollection(ctx context.Context, collectionID uuid.UUID) ([]Movie, error)
+	// restrict limits the result to movies with a file under library_paths.
 	ListMoviesByGenre(ctx context.Context, arg ListMoviesByGenreParams) ([]Movie, error)
+	ListMoviesByTag(ctx context.Context, arg ListMoviesByTagParams) ([]Movie, error)
 	ListMoviesByYear(ctx context.Context, arg ListMoviesByYearParams) ([]Movie, error)
+	ListMoviesDueForRefresh(ctx context.Context, arg ListMoviesDueForRefreshParams) ([]Movie, error)
+	ListMoviesPendingTagImport(ctx context.Context, arg ListMoviesPendingTagImportParams) ([]Movie, error)
+	// restrict limits the result to movies with a file under library_paths.
 	ListRecentlyAdded(ctx context.Context, arg ListRecentlyAddedParams) ([]Movie, error)
+	// restrict limits the result to movies with a file under library_paths.
 	ListTopRated(ctx context.Context, arg ListTopRatedParams) ([]Movie, error)
 	ListWatchedMovies(ctx context.Context, arg ListWatchedMoviesParams) ([]ListWatchedMoviesRow, error)
 	RemoveMovieFromCollection(ctx context.Context, arg RemoveMovieFromCollectionParams) error
 	SearchMoviesByTitle(ctx context.Context, arg SearchMoviesByTitleParams) ([]Movie, error)
 	SearchMoviesByTitleAnyLanguage(ctx context.Context, arg SearchMoviesByTitleAnyLanguageParams) ([]Movie, error)
+	SetMovieFileMatchLocked(ctx context.Context, arg SetMovieFileMatchLockedParams) (MovieFile, error)
+	// Stores the placeholder of one of the movie's images. Placeholders of
+	// images the movie no longer uses are dropped.
+	SetMovieImagePlaceholder(ctx context.Context, arg SetMovieImagePlaceholderParams) (int64, error)
+	SetMovieLockedFields(ctx context.Context, arg SetMovieLockedFieldsParams) (Movie, error)
+	SetMovieTagsImported(ctx context.Context, id uuid.UUID) error
 	UpdateMovie(ctx context.Context, arg UpdateMovieParams) (Movie, error)
 	UpdateMovieCollection(ctx context.Context, arg UpdateMovieCollectionParams) (MovieCollection, error)
 	UpdateMovieFile(ctx context.Context, arg UpdateMovieFileParams) (MovieFile, error)
+	// Movie Extras Operations
+	UpsertMovieExtra(ctx context.Context, arg UpsertMovieExtraParams) (MovieMovieExtra, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -9,4 +9,6 @@ var (
 	ErrProgressNotFound   = errors.New("watch progress not found")
 	ErrNotInCollection    = errors.New("movie is not in a collection")
 	ErrCollectionNotFound = errors.New("collection not found")
+	ErrNoProviderID       = errors.New("manual match needs a TMDb or IMDb ID")
+	ErrMovieExtraNotFound = errors.New("movie extra not found")
 )
@@ -62,7 +62,7 @@ func (h *Handler) SearchMovies(ctx context.Context, params SearchMoviesParams) (
 
 // GetRecentlyAdded handles GET /api/v1/movies/recently-added
 func (h *Handler) GetRecentlyAdded(ctx context.Context, params PaginationParams) ([]Movie, int64, error) {
-	return h.service.ListRecentlyAdded(ctx, params.Limit, params.Offset)
+	return h.service.ListRecentlyAdded(ctx, nil, params.Limit, params.Offset)
 }
 
 // GetTopRated handles GET /api/v1/movies/top-rated
@@ -72,7 +72,7 @@ func (h *Handler) GetTopRated(ctx context.Context, params TopRatedParams) ([]Mov
 		minVotes = *params.MinVotes
 	}
 
-	return h.service.ListTopRated(ctx, minVotes, params.Limit, params.Offset)
+	return h.service.ListTopRated(ctx, minVotes, nil, params.Limit, params.Offset)
 }
 
 // GetMovieFiles handles GET /api/v1/movies/:id/files
@@ -85,6 +85,26 @@ func (h *Handler) GetMovieFiles(ctx context.Context, id string) ([]MovieFile, er
 	return h.service.GetMovieFiles(ctx, movieID)
 }
 
+// GetMovieEditions handles GET /api/v1/movies/:id/editions
+func (h *Handler) GetMovieEditions(ctx context.Context, id string) ([]Edition, error) {
+	movieID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	return h.service.GetMovieEditions(ctx, movieID)
+}
+
+// GetMovieExtras handles GET /api/v1/movies/:id/extras
+func (h *Handler) GetMovieExtras(ctx context.Context, id string) ([]MovieExtra, error) {
+	movieID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	return h.service.GetMovieExtras(ctx, movieID)
+}
+
 // CreditPaginationParams contains pagination params for credit queries
 type CreditPaginationParams struct {
 	Limit  int32
@@ -123,7 +143,7 @@ func (h *Handler) GetMovieGenres(ctx context.Context, id string) ([]MovieGenre,
 
 // GetMoviesByGenre handles GET /api/v1/movies/genre/:slug
 func (h *Handler) GetMoviesByGenre(ctx context.Context, slug string, params PaginationParams) ([]Movie, error) {
-	return h.service.GetMoviesByGenre(ctx, slug, params.Limit, params.Offset)
+	return h.service.GetMoviesByGenre(ctx, slug, nil, params.Limit, params.Offset)
 }
 
 // ListDistinctGenres returns all distinct movie genres with item counts.
@@ -131,6 +151,26 @@ func (h *Handler) ListDistinctGenres(ctx context.Context) ([]content.GenreSummar
 	return h.service.ListDistinctGenres(ctx)
 }
 
+// GetMovieTags handles GET /api/v1/movies/:id/tags
+func (h *Handler) GetMovieTags(ctx context.Context, id string) ([]MovieTag, error) {
+	movieID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	return h.service.GetMovieTags(ctx, movieID)
+}
+
+// GetMoviesByTag handles GET /api/v1/tags/:slug/movies
+func (h *Handler) GetMoviesByTag(ctx context.Context, slug string, params PaginationParams) ([]Movie, error) {
+	return h.service.GetMoviesByTag(ctx, slug, params.Limit, params.Offset)
+}
+
+// ListDistinctTags returns the most used non-spoiler movie tags with item counts.
+func (h *Handler) ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	return h.service.ListDistinctTags(ctx, limit)
+}
+
 // GetMovieCollection handles GET /api/v1/movies/:id/collection
 func (h *Handler) GetMovieCollection(ctx context.Context, id string) (*MovieCollection, error) {
 	movieID, err := uuid.Parse(id)
@@ -203,7 +243,7 @@ func (h *Handler) MarkAsWatched(ctx context.Context, userID uuid.UUID, id string
 
 // GetContinueWatching handles GET /api/v1/movies/continue-watching
 func (h *Handler) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
-	return h.service.GetContinueWatching(ctx, userID, limit)
+	return h.service.GetContinueWatching(ctx, userID, nil, limit)
 }
 
 // GetWatchHistory handles GET /api/v1/movies/watch-history
@@ -233,6 +273,37 @@ func (h *Handler) RefreshMetadata(ctx context.Context, id string) error {
 	return h.metadataQueue.EnqueueRefreshMovie(ctx, movieID, true, nil)
 }
 
+// UpdateMetadata handles PATCH /api/v1/movies/:id/metadata.
+func (h *Handler) UpdateMetadata(ctx context.Context, id string, edit MetadataEdit) (*Movie, error) {
+	movieID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	return h.service.UpdateMovieMetadata(ctx, movieID, edit)
+}
+
+// UnlockMetadataField handles DELETE /api/v1/movies/:id/metadata/locks/:field.
+func (h *Handler) UnlockMetadataField(ctx context.Context, id, field string) (*Movie, error) {
+	movieID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	return h.service.UnlockMovieFields(ctx, movieID, field)
+}
+
+// RestoreMetadata handles POST /api/v1/movies/:id/metadata/history/:version/rollback
+// once the values at the version are known.
+func (h *Handler) RestoreMetadata(ctx context.Context, id string, values content.Snapshot) (*Movie, error) {
+	movieID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid movie ID: %w", err)
+	}
+
+	return h.service.RestoreMovieMetadata(ctx, movieID, values)
+}
+
 // Request/Response parameter types
 
 // ListMoviesParams contains parameters for listing movies
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // MockService implements Service for testing handlers
@@ -63,16 +64,16 @@ func (m *MockService) SearchMovies(ctx context.Context, query string, filters Se
 	return args.Get(0).([]Movie), args.Error(1)
 }
 
-func (m *MockService) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Movie, int64, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockService) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Movie, int64, error) {
+	args := m.Called(ctx, libraryPaths, limit, offset)
 	if args.Get(0) == nil {
 		return nil, 0, args.Error(2)
 	}
 	return args.Get(0).([]Movie), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockService) ListTopRated(ctx context.Context, minVotes int32, limit, offset int32) ([]Movie, int64, error) {
-	args := m.Called(ctx, minVotes, limit, offset)
+func (m *MockService) ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit, offset int32) ([]Movie, int64, error) {
+	args := m.Called(ctx, minVotes, libraryPaths, limit, offset)
 	if args.Get(0) == nil {
 		return nil, 0, args.Error(2)
 	}
@@ -108,6 +109,30 @@ func (m *MockService) GetMovieFiles(ctx context.Context, movieID uuid.UUID) ([]M
 	return args.Get(0).([]MovieFile), args.Error(1)
 }
 
+func (m *MockService) GetMovieEditions(ctx context.Context, movieID uuid.UUID) ([]Edition, error) {
+	args := m.Called(ctx, movieID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Edition), args.Error(1)
+}
+
+func (m *MockService) GetMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieExtra, error) {
+	args := m.Called(ctx, movieID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]MovieExtra), args.Error(1)
+}
+
+func (m *MockService) GetMovieExtra(ctx context.Context, id uuid.UUID) (*MovieExtra, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MovieExtra), args.Error(1)
+}
+
 func (m *MockService) CreateMovieFile(ctx context.Context, params CreateMovieFileParams) (*MovieFile, error) {
 	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
@@ -169,7 +194,15 @@ func (m *MockService) GetMovieGenres(ctx context.Context, movieID uuid.UUID) ([]
 	return args.Get(0).([]MovieGenre), args.Error(1)
 }
 
-func (m *MockService) GetMoviesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Movie, error) {
+func (m *MockService) GetMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error) {
+	args := m.Called(ctx, movieID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]MovieTag), args.Error(1)
+}
+
+func (m *MockService) GetMoviesByTag(ctx context.Context, slug string, limit, offset int32) ([]Movie, error) {
 	args := m.Called(ctx, slug, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -177,6 +210,22 @@ func (m *MockService) GetMoviesByGenre(ctx context.Context, slug string, limit,
 	return args.Get(0).([]Movie), args.Error(1)
 }
 
+func (m *MockService) ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]content.TagSummary), args.Error(1)
+}
+
+func (m *MockService) GetMoviesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Movie, error) {
+	args := m.Called(ctx, slug, libraryPaths, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Movie), args.Error(1)
+}
+
 func (m *MockService) ListDistinctGenres(ctx context.Context) ([]content.GenreSummary, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -211,8 +260,8 @@ func (m *MockService) RemoveWatchProgress(ctx context.Context, userID, movieID u
 	return args.Error(0)
 }
 
-func (m *MockService) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
-	args := m.Called(ctx, userID, limit)
+func (m *MockService) GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	args := m.Called(ctx, userID, libraryPaths, limit)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -240,6 +289,55 @@ func (m *MockService) RefreshMovieMetadata(ctx context.Context, id uuid.UUID, op
 	return args.Error(0)
 }
 
+func (m *MockService) ListMoviesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Movie, error) {
+	args := m.Called(ctx, cutoffs, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Movie), args.Error(1)
+}
+
+func (m *MockService) ListMoviesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Movie, error) {
+	args := m.Called(ctx, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Movie), args.Error(1)
+}
+
+func (m *MockService) ImportMovieTags(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockService) UpdateMovieMetadata(ctx context.Context, id uuid.UUID, edit MetadataEdit) (*Movie, error) {
+	args := m.Called(ctx, id, edit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Movie), args.Error(1)
+}
+
+func (m *MockService) UnlockMovieFields(ctx context.Context, id uuid.UUID, fields ...string) (*Movie, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Movie), args.Error(1)
+}
+
+func (m *MockService) RestoreMovieMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Movie, error) {
+	args := m.Called(ctx, id, values)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Movie), args.Error(1)
+}
+
+func (m *MockService) SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
 // MockMetadataQueue implements MetadataQueue for testing.
 type MockMetadataQueue struct {
 	mock.Mock
@@ -390,7 +488,7 @@ func TestHandler_GetRecentlyAdded(t *testing.T) {
 		Offset: 0,
 	}
 
-	svc.On("ListRecentlyAdded", ctx, int32(10), int32(0)).Return(movies, int64(1), nil)
+	svc.On("ListRecentlyAdded", ctx, []string(nil), int32(10), int32(0)).Return(movies, int64(1), nil)
 
 	result, total, err := h.GetRecentlyAdded(ctx, params)
 	require.NoError(t, err)
@@ -411,7 +509,7 @@ func TestHandler_GetTopRated(t *testing.T) {
 			MinVotes: nil, // Default to 100
 		}
 
-		svc.On("ListTopRated", ctx, int32(100), int32(10), int32(0)).Return(movies, int64(1), nil)
+		svc.On("ListTopRated", ctx, int32(100), []string(nil), int32(10), int32(0)).Return(movies, int64(1), nil)
 
 		result, total, err := h.GetTopRated(ctx, params)
 		require.NoError(t, err)
@@ -432,7 +530,7 @@ func TestHandler_GetTopRated(t *testing.T) {
 			MinVotes: &minVotes,
 		}
 
-		svc.On("ListTopRated", ctx, int32(500), int32(10), int32(0)).Return(movies, int64(1), nil)
+		svc.On("ListTopRated", ctx, int32(500), []string(nil), int32(10), int32(0)).Return(movies, int64(1), nil)
 
 		result, total, err := h.GetTopRated(ctx, params)
 		require.NoError(t, err)
@@ -469,6 +567,31 @@ func TestHandler_GetMovieFiles(t *testing.T) {
 	})
 }
 
+func TestHandler_GetMovieExtras(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		svc := new(MockService)
+		h := newTestHandler(svc)
+		ctx := context.Background()
+		movieID := uuid.Must(uuid.NewV7())
+		extras := []MovieExtra{{ID: uuid.Must(uuid.NewV7()), MovieID: movieID, ExtraType: "trailer", Title: "Teaser"}}
+
+		svc.On("GetMovieExtras", ctx, movieID).Return(extras, nil)
+
+		result, err := h.GetMovieExtras(ctx, movieID.String())
+		require.NoError(t, err)
+		assert.Equal(t, extras, result)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Invalid UUID", func(t *testing.T) {
+		h := newTestHandler(new(MockService))
+
+		result, err := h.GetMovieExtras(context.Background(), "invalid")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
 func TestHandler_GetMovieCast(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		svc := new(MockService)
@@ -571,7 +694,7 @@ func TestHandler_GetMoviesByGenre(t *testing.T) {
 		Offset: 0,
 	}
 
-	svc.On("GetMoviesByGenre", ctx, "action", int32(20), int32(0)).Return(movies, nil)
+	svc.On("GetMoviesByGenre", ctx, "action", []string(nil), int32(20), int32(0)).Return(movies, nil)
 
 	result, err := h.GetMoviesByGenre(ctx, "action", params)
 	require.NoError(t, err)
@@ -838,7 +961,7 @@ func TestHandler_GetContinueWatching(t *testing.T) {
 		{Movie: *newTestMovie(), ProgressSeconds: 3000},
 	}
 
-	svc.On("GetContinueWatching", ctx, userID, int32(10)).Return(items, nil)
+	svc.On("GetContinueWatching", ctx, userID, []string(nil), int32(10)).Return(items, nil)
 
 	result, err := h.GetContinueWatching(ctx, userID, 10)
 	require.NoError(t, err)
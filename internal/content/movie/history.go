@@ -0,0 +1,129 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+// Snapshot returns the values of the fields tracked in the metadata change
+// history.
+func (m *Movie) Snapshot() content.Snapshot {
+	title := m.Title
+	return content.Snapshot{
+		content.FieldTitle:         &title,
+		content.FieldSortTitle:     m.SortTitle,
+		content.FieldOriginalTitle: m.OriginalTitle,
+		content.FieldOverview:      m.Overview,
+		content.FieldTagline:       m.Tagline,
+		content.FieldReleaseDate:   formatDate(m.ReleaseDate),
+		content.FieldRatings:       formatDecimal(m.VoteAverage),
+		content.FieldPoster:        m.PosterPath,
+		content.FieldBackdrop:      m.BackdropPath,
+	}
+}
+
+// historyRepository records the changes made by every movie update in the
+// metadata change history.
+type historyRepository struct {
+	Repository
+	recorder content.ChangeRecorder
+}
+
+// NewHistoryRepository wraps a repository so movie updates are recorded in
+// the metadata change history.
+func NewHistoryRepository(repo Repository, recorder content.ChangeRecorder) Repository {
+	return &historyRepository{Repository: repo, recorder: recorder}
+}
+
+// UpdateMovie updates the movie and records the changed fields.
+func (r *historyRepository) UpdateMovie(ctx context.Context, params UpdateMovieParams) (*Movie, error) {
+	before, _ := r.Repository.GetMovie(ctx, params.ID)
+
+	updated, err := r.Repository.UpdateMovie(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		if changes := before.Snapshot().Diff(updated.Snapshot()); len(changes) > 0 {
+			r.recorder.RecordChange(ctx, content.ContentTypeMovie, params.ID, changes)
+		}
+	}
+	return updated, nil
+}
+
+// restoreParams converts snapshot values to update params and returns the
+// lockable fields they touch. Empty values are skipped since updates cannot
+// clear a field; callers reject snapshots that would have to.
+func restoreParams(id uuid.UUID, values content.Snapshot) (UpdateMovieParams, []string, error) {
+	params := UpdateMovieParams{ID: id}
+	var fields []string
+
+	for _, field := range values.Fields() {
+		value := values[field]
+		if value == nil {
+			continue
+		}
+		switch field {
+		case content.FieldTitle:
+			params.Title = value
+		case content.FieldSortTitle:
+			params.SortTitle = value
+		case content.FieldOriginalTitle:
+			params.OriginalTitle = value
+		case content.FieldOverview:
+			params.Overview = value
+		case content.FieldTagline:
+			params.Tagline = value
+		case content.FieldReleaseDate:
+			date, err := time.Parse("2006-01-02", *value)
+			if err != nil {
+				return params, nil, fmt.Errorf("invalid release date %q: %w", *value, err)
+			}
+			params.ReleaseDate = value
+			params.Year = extractYear(&date)
+		case content.FieldRatings:
+			params.VoteAverage = value
+		case content.FieldPoster:
+			params.PosterPath = value
+		case content.FieldBackdrop:
+			params.BackdropPath = value
+		default:
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return params, fields, nil
+}
+
+// RestoreMovieMetadata writes previous field values back, e.g. when rolling
+// back to an earlier version, and locks the restored fields so the next
+// refresh keeps them. It fails with content.ErrCannotClearFields when a
+// field set now was empty in values.
+func (s *movieService) RestoreMovieMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Movie, error) {
+	mov, err := s.repo.GetMovie(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := values.CheckRestorable(mov.Snapshot()); err != nil {
+		return nil, err
+	}
+
+	params, fields, err := restoreParams(id, values)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return mov, nil
+	}
+
+	if _, err := s.repo.UpdateMovie(ctx, params); err != nil {
+		return nil, fmt.Errorf("update movie: %w", err)
+	}
+	return s.repo.SetMovieLockedFields(ctx, id, mov.LockedFields.With(fields...))
+}
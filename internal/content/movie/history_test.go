@@ -0,0 +1,101 @@
+package movie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+type recordedChange struct {
+	contentType string
+	contentID   uuid.UUID
+	changes     map[string]content.FieldChange
+}
+
+type fakeRecorder struct {
+	recorded []recordedChange
+}
+
+func (f *fakeRecorder) RecordChange(_ context.Context, contentType string, contentID uuid.UUID, changes map[string]content.FieldChange) {
+	f.recorded = append(f.recorded, recordedChange{contentType, contentID, changes})
+}
+
+func TestHistoryRepository_UpdateMovie(t *testing.T) {
+	repo := new(MockMovieRepository)
+	recorder := &fakeRecorder{}
+	history := NewHistoryRepository(repo, recorder)
+	ctx := context.Background()
+
+	before := newTestMovie()
+	after := *before
+	after.Title = "Fight Club (Director's Cut)"
+	after.PosterPath = new("/new.jpg")
+	params := UpdateMovieParams{ID: before.ID, Title: &after.Title, PosterPath: after.PosterPath}
+
+	repo.On("GetMovie", ctx, before.ID).Return(before, nil)
+	repo.On("UpdateMovie", ctx, params).Return(&after, nil)
+
+	_, err := history.UpdateMovie(ctx, params)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.recorded, 1)
+	assert.Equal(t, content.ContentTypeMovie, recorder.recorded[0].contentType)
+	assert.Equal(t, before.ID, recorder.recorded[0].contentID)
+	assert.Equal(t, content.FieldChange{Old: &before.Title, New: &after.Title}, recorder.recorded[0].changes[content.FieldTitle])
+	assert.Equal(t, content.FieldChange{Old: before.PosterPath, New: new("/new.jpg")}, recorder.recorded[0].changes[content.FieldPoster])
+	assert.Len(t, recorder.recorded[0].changes, 2)
+
+	// An update that changes nothing tracked records nothing.
+	repo.On("UpdateMovie", ctx, UpdateMovieParams{ID: before.ID}).Return(before, nil)
+	_, err = history.UpdateMovie(ctx, UpdateMovieParams{ID: before.ID})
+	require.NoError(t, err)
+	assert.Len(t, recorder.recorded, 1)
+}
+
+func TestService_RestoreMovieMetadata(t *testing.T) {
+	repo := new(MockMovieRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	mov := newTestMovie()
+
+	repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+	repo.On("UpdateMovie", ctx, mock.MatchedBy(func(p UpdateMovieParams) bool {
+		return *p.Title == "Fight Club" && *p.ReleaseDate == "1999-10-15" && *p.Year == 1999 &&
+			p.Tagline == nil && p.Overview == nil
+	})).Return(mov, nil)
+	repo.On("SetMovieLockedFields", ctx, mov.ID, []string{"release_date", "title"}).Return(mov, nil)
+
+	_, err := svc.RestoreMovieMetadata(ctx, mov.ID, content.Snapshot{
+		content.FieldTitle:       new("Fight Club"),
+		content.FieldReleaseDate: new("1999-10-15"),
+		content.FieldTagline:     nil, // already empty
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+
+	_, err = svc.RestoreMovieMetadata(ctx, mov.ID, content.Snapshot{content.FieldReleaseDate: new("15.10.1999")})
+	assert.Error(t, err)
+}
+
+func TestService_RestoreMovieMetadata_CannotClear(t *testing.T) {
+	repo := new(MockMovieRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	mov := newTestMovie()
+
+	repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+
+	_, err := svc.RestoreMovieMetadata(ctx, mov.ID, content.Snapshot{
+		content.FieldTitle:    new("Fight Club"),
+		content.FieldOverview: nil,
+	})
+	require.ErrorIs(t, err, content.ErrCannotClearFields)
+	assert.Contains(t, err.Error(), content.FieldOverview)
+	repo.AssertNotCalled(t, "UpdateMovie", mock.Anything, mock.Anything)
+}
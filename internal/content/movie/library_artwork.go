@@ -0,0 +1,51 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+// UseLocalArtwork points a movie's poster and backdrop at local artwork.
+// Nil paths leave the current image in place. The movie is only updated
+// when a path actually changes.
+func (s *LibraryService) UseLocalArtwork(ctx context.Context, movieID uuid.UUID, posterPath, backdropPath *string) error {
+	if posterPath == nil && backdropPath == nil {
+		return nil
+	}
+
+	mov, err := s.repo.GetMovie(ctx, movieID)
+	if err != nil {
+		return fmt.Errorf("failed to get movie: %w", err)
+	}
+
+	params := UpdateMovieParams{ID: movieID}
+	changed := false
+	if posterPath != nil && !strPtrEquals(mov.PosterPath, *posterPath) {
+		params.PosterPath = posterPath
+		changed = true
+	}
+	if backdropPath != nil && !strPtrEquals(mov.BackdropPath, *backdropPath) {
+		params.BackdropPath = backdropPath
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err := s.repo.UpdateMovie(ctx, params); err != nil {
+		return fmt.Errorf("failed to set local artwork: %w", err)
+	}
+	return nil
+}
+
+// keepLocalImage restores a local artwork path that a provider refresh
+// replaced, so preferred local artwork survives metadata refreshes.
+func keepLocalImage(dst **string, previous *string) {
+	if previous != nil && artwork.IsLocalPath(*previous) {
+		*dst = previous
+	}
+}
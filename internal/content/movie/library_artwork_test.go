@@ -0,0 +1,46 @@
+package movie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+func TestLibraryService_UseLocalArtwork(t *testing.T) {
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+	poster := artwork.LocalPath(uuid.Must(uuid.NewV7()))
+	backdrop := artwork.LocalPath(uuid.Must(uuid.NewV7()))
+
+	repo := new(MockMovieRepository)
+	svc := NewLibraryService(repo, new(MockMetadataProvider), config.LibraryConfig{}, new(MockProber))
+
+	repo.On("GetMovie", ctx, movieID).Return(&Movie{ID: movieID, PosterPath: &poster, BackdropPath: new("/tmdb.jpg")}, nil)
+	repo.On("UpdateMovie", ctx, mock.MatchedBy(func(p UpdateMovieParams) bool {
+		return p.ID == movieID && p.PosterPath == nil && *p.BackdropPath == backdrop
+	})).Return(&Movie{ID: movieID}, nil)
+
+	require.NoError(t, svc.UseLocalArtwork(ctx, movieID, &poster, &backdrop))
+	require.NoError(t, svc.UseLocalArtwork(ctx, movieID, nil, nil))
+	repo.AssertNumberOfCalls(t, "GetMovie", 1)
+	repo.AssertExpectations(t)
+}
+
+func TestKeepLocalImage(t *testing.T) {
+	local := artwork.LocalPath(uuid.Must(uuid.NewV7()))
+
+	refreshed := new("/tmdb.jpg")
+	keepLocalImage(&refreshed, &local)
+	assert.Equal(t, local, *refreshed)
+
+	refreshed = new("/new.jpg")
+	keepLocalImage(&refreshed, new("/old.jpg"))
+	assert.Equal(t, "/new.jpg", *refreshed)
+}
@@ -0,0 +1,105 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// scannedExtra is a scanned file classified as an extra.
+type scannedExtra struct {
+	ScanResult
+	scanner.Extra
+}
+
+// detectExtras returns the scanned files that are extras, keyed by path. A
+// file only counts as an extra when its movie folder holds a main file, so
+// movies kept in a folder named e.g. "Shorts" or "Other" are scanned as
+// movies.
+func detectExtras(results []ScanResult) map[string]scanner.Extra {
+	candidates := make(map[string]scanner.Extra)
+	movieDirs := make(map[string]bool)
+	for _, result := range results {
+		if extra, ok := scanner.DetectExtra(result.FilePath); ok {
+			candidates[result.FilePath] = extra
+			continue
+		}
+		movieDirs[filepath.Dir(result.FilePath)] = true
+	}
+
+	for path, extra := range candidates {
+		if !movieDirs[extra.MovieDir] {
+			delete(candidates, path)
+		}
+	}
+	return candidates
+}
+
+// linkExtras records the scanned extras against the movies matched in the
+// same scan. Extras whose movie cannot be told apart are skipped.
+func (s *LibraryService) linkExtras(ctx context.Context, extras []scannedExtra, summary *ScanSummary) {
+	if len(extras) == 0 {
+		return
+	}
+
+	byDir := make(map[string][]FileMatch)
+	for _, m := range summary.Matches {
+		dir := filepath.Dir(m.FilePath)
+		byDir[dir] = append(byDir[dir], m)
+	}
+
+	for _, extra := range extras {
+		movieID, ok := extraOwner(extra.Extra, byDir[extra.MovieDir])
+		if !ok {
+			continue
+		}
+
+		params := UpsertMovieExtraParams{
+			MovieID:   movieID,
+			ExtraType: extra.Type,
+			Title:     extra.Title,
+			FilePath:  extra.FilePath,
+			FileSize:  extra.FileSize,
+			Container: parseOptionalString(strings.TrimPrefix(strings.ToLower(filepath.Ext(extra.FilePath)), ".")),
+		}
+		if _, err := s.repo.UpsertMovieExtra(ctx, params); err != nil {
+			summary.Errors = append(summary.Errors, fmt.Errorf("failed to record extra %s: %w", extra.FilePath, err))
+			continue
+		}
+		summary.Extras++
+	}
+}
+
+// extraOwner picks the movie an extra belongs to from the files matched in
+// its movie folder. A folder with one movie owns all its extras; in a flat
+// folder of several movies the extra's title must equal a movie file name.
+func extraOwner(extra scanner.Extra, matches []FileMatch) (uuid.UUID, bool) {
+	if len(matches) == 0 {
+		return uuid.Nil, false
+	}
+
+	single := true
+	for _, m := range matches[1:] {
+		if m.MovieID != matches[0].MovieID {
+			single = false
+			break
+		}
+	}
+	if single {
+		return matches[0].MovieID, true
+	}
+
+	for _, m := range matches {
+		name, _ := scanner.ParseEdition(filepath.Base(m.FilePath))
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+		if strings.EqualFold(name, extra.Title) {
+			return m.MovieID, true
+		}
+	}
+	return uuid.Nil, false
+}
@@ -0,0 +1,112 @@
+package movie
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+func TestLibraryService_ScanLibrary_Extras(t *testing.T) {
+	root := t.TempDir()
+	movieDir := filepath.Join(root, "Heat (1995)")
+	require.NoError(t, os.MkdirAll(filepath.Join(movieDir, "Trailers"), 0o755))
+
+	movieFile := filepath.Join(movieDir, "Heat (1995).mkv")
+	trailer := filepath.Join(movieDir, "Trailers", "Teaser.mp4")
+	deleted := filepath.Join(movieDir, "Bank Job-deleted.mkv")
+	for _, path := range []string{movieFile, trailer, deleted} {
+		require.NoError(t, os.WriteFile(path, []byte("dummy content"), 0o644))
+	}
+
+	repo := new(MockMovieRepository)
+	svc := NewLibraryService(repo, new(MockMetadataProvider), config.LibraryConfig{Paths: []string{root}}, new(MockProber))
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+
+	repo.On("GetMovieFileByPath", ctx, movieFile).Return(&MovieFile{MovieID: movieID, FilePath: movieFile, MatchLocked: true}, nil)
+	repo.On("UpsertMovieExtra", ctx, mock.MatchedBy(func(p UpsertMovieExtraParams) bool {
+		return p.MovieID == movieID && p.FilePath == trailer &&
+			p.ExtraType == scanner.ExtraTrailer && p.Title == "Teaser" && *p.Container == "mp4"
+	})).Return(&MovieExtra{}, nil)
+	repo.On("UpsertMovieExtra", ctx, mock.MatchedBy(func(p UpsertMovieExtraParams) bool {
+		return p.MovieID == movieID && p.FilePath == deleted &&
+			p.ExtraType == scanner.ExtraDeletedScene && p.Title == "Bank Job"
+	})).Return(&MovieExtra{}, nil)
+
+	summary, err := svc.ScanLibrary(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.TotalFiles)
+	assert.Equal(t, 1, summary.MatchedFiles)
+	assert.Equal(t, 2, summary.Extras)
+	assert.Empty(t, summary.Errors)
+	repo.AssertExpectations(t)
+}
+
+func TestLibraryService_ScanLibrary_ExtraFolderWithoutMovie(t *testing.T) {
+	root := t.TempDir()
+	shorts := filepath.Join(root, "Shorts")
+	require.NoError(t, os.MkdirAll(shorts, 0o755))
+
+	// No main file next to the Shorts folder, so it holds movies, not extras.
+	short := filepath.Join(shorts, "Paperman (2012).mkv")
+	require.NoError(t, os.WriteFile(short, []byte("dummy content"), 0o644))
+
+	repo := new(MockMovieRepository)
+	svc := NewLibraryService(repo, new(MockMetadataProvider), config.LibraryConfig{Paths: []string{root}}, new(MockProber))
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+
+	repo.On("GetMovieFileByPath", ctx, short).Return(&MovieFile{MovieID: movieID, FilePath: short, MatchLocked: true}, nil)
+
+	summary, err := svc.ScanLibrary(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.MatchedFiles)
+	assert.Zero(t, summary.Extras)
+	assert.Equal(t, []FileMatch{{FilePath: short, MovieID: movieID}}, summary.Matches)
+	repo.AssertExpectations(t)
+}
+
+func TestDetectExtras(t *testing.T) {
+	results := []ScanResult{
+		{FilePath: "/movies/Heat (1995)/Heat (1995).mkv"},
+		{FilePath: "/movies/Heat (1995)/Other/Making Of.mkv"},
+		{FilePath: "/movies/Shorts/Paperman (2012).mkv"},
+		{FilePath: "/movies/Other/Ronin (1998).mkv"},
+	}
+
+	extras := detectExtras(results)
+	assert.Len(t, extras, 1)
+	assert.Equal(t, scanner.ExtraOther, extras["/movies/Heat (1995)/Other/Making Of.mkv"].Type)
+}
+
+func TestExtraOwner(t *testing.T) {
+	heat := uuid.Must(uuid.NewV7())
+	ronin := uuid.Must(uuid.NewV7())
+	flat := []FileMatch{
+		{FilePath: "/movies/Heat (1995) {edition-Director's Cut}.mkv", MovieID: heat},
+		{FilePath: "/movies/Ronin (1998).mkv", MovieID: ronin},
+	}
+
+	id, ok := extraOwner(scanner.Extra{Type: scanner.ExtraTrailer, Title: "heat (1995)"}, flat)
+	assert.True(t, ok)
+	assert.Equal(t, heat, id)
+
+	_, ok = extraOwner(scanner.Extra{Type: scanner.ExtraTrailer, Title: "Teaser"}, flat)
+	assert.False(t, ok, "ambiguous folder extras are skipped")
+
+	id, ok = extraOwner(scanner.Extra{Type: scanner.ExtraOther, Title: "Teaser"}, flat[:1])
+	assert.True(t, ok)
+	assert.Equal(t, heat, id)
+
+	_, ok = extraOwner(scanner.Extra{Type: scanner.ExtraOther, Title: "Teaser"}, nil)
+	assert.False(t, ok)
+}
@@ -1,8 +1,11 @@
 package movie
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/govalues/decimal"
@@ -10,10 +13,20 @@ import (
 	"github.com/lusoris/revenge/internal/util"
 )
 
+// DefaultMinMatchConfidence is the score a provider result needs before the
+// matcher accepts it without review. An exact title match without a year in
+// the filename scores 0.45 and still passes; fuzzy titles without a year
+// go to review.
+const DefaultMinMatchConfidence = 0.4
+
+// maxMatchCandidates caps how many provider results are scored per file.
+const maxMatchCandidates = 5
+
 // Matcher handles matching scanned files to movies
 type Matcher struct {
 	repo            Repository
 	metadataService MetadataProvider
+	minConfidence   float64
 }
 
 // MatchResult represents the result of matching a file
@@ -24,6 +37,18 @@ type MatchResult struct {
 	Confidence      float64
 	Error           error
 	CreatedNewMovie bool
+	// Candidates holds the scored provider results, best first. It is set
+	// whenever a provider search ran, so unmatched files can be reviewed.
+	Candidates []MatchCandidate
+	// MinConfidence is the score the best candidate fell short of when a
+	// file is left for review.
+	MinConfidence float64
+}
+
+// MatchCandidate is a provider search result scored against a scanned file.
+type MatchCandidate struct {
+	Movie      *Movie
+	Confidence float64
 }
 
 // MatchType indicates how a file was matched
@@ -42,6 +67,7 @@ func NewMatcher(repo Repository, metadataService MetadataProvider) *Matcher {
 	return &Matcher{
 		repo:            repo,
 		metadataService: metadataService,
+		minConfidence:   DefaultMinMatchConfidence,
 	}
 }
 
@@ -59,6 +85,21 @@ func (m *Matcher) MatchFiles(ctx context.Context, results []ScanResult) ([]Match
 
 // MatchFile attempts to match a single file (public for use by jobs)
 func (m *Matcher) MatchFile(ctx context.Context, result ScanResult) MatchResult {
+	// Provider IDs from an NFO sidecar identify the movie without a search.
+	// Fall back to title matching if they cannot be resolved.
+	if result.NFO != nil && result.NFO.HasProviderID() {
+		mov, created, err := m.findOrCreateByID(ctx, result.NFO.TMDbID(), result.NFO.IMDbID())
+		if err == nil {
+			return MatchResult{
+				ScanResult:      result,
+				Movie:           mov,
+				MatchType:       MatchTypeExact,
+				Confidence:      1.0,
+				CreatedNewMovie: created,
+			}
+		}
+	}
+
 	// Try to find existing movie in DB first
 	existingMovie, err := m.findExistingMovie(ctx, result)
 	if err == nil && existingMovie != nil {
@@ -88,19 +129,28 @@ func (m *Matcher) MatchFile(ctx context.Context, result ScanResult) MatchResult
 		}
 	}
 
-	// Use the first result (highest TMDb relevance)
-	tmdbMovie := tmdbMovies[0]
+	candidates := m.scoreCandidates(result, tmdbMovies)
+	best := candidates[0]
 
-	// Calculate confidence
-	confidence := m.calculateConfidence(result, tmdbMovie)
+	// Leave low-confidence files for manual review instead of guessing
+	if best.Confidence < m.minConfidence {
+		return MatchResult{
+			ScanResult:    result,
+			MatchType:     MatchTypeUnmatched,
+			Confidence:    best.Confidence,
+			Candidates:    candidates,
+			MinConfidence: m.minConfidence,
+		}
+	}
 
 	// Create new movie in DB
-	newMovie, err := m.createMovieFromTMDb(ctx, tmdbMovie)
+	newMovie, err := m.createMovieFromTMDb(ctx, best.Movie)
 	if err != nil {
 		return MatchResult{
 			ScanResult: result,
 			MatchType:  MatchTypeUnmatched,
 			Error:      fmt.Errorf("failed to create movie: %w", err),
+			Candidates: candidates,
 		}
 	}
 
@@ -108,11 +158,68 @@ func (m *Matcher) MatchFile(ctx context.Context, result ScanResult) MatchResult
 		ScanResult:      result,
 		Movie:           newMovie,
 		MatchType:       MatchTypeTitle,
-		Confidence:      confidence,
+		Confidence:      best.Confidence,
 		CreatedNewMovie: true,
+		Candidates:      candidates,
 	}
 }
 
+// scoreCandidates scores the top provider results against the scanned file
+// and returns them best first. Ties keep the provider's relevance order.
+func (m *Matcher) scoreCandidates(result ScanResult, tmdbMovies []*Movie) []MatchCandidate {
+	if len(tmdbMovies) > maxMatchCandidates {
+		tmdbMovies = tmdbMovies[:maxMatchCandidates]
+	}
+
+	candidates := make([]MatchCandidate, len(tmdbMovies))
+	for i, tmdbMovie := range tmdbMovies {
+		candidates[i] = MatchCandidate{
+			Movie:      tmdbMovie,
+			Confidence: m.calculateConfidence(result, tmdbMovie),
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b MatchCandidate) int {
+		return cmp.Compare(b.Confidence, a.Confidence)
+	})
+
+	return candidates
+}
+
+// findOrCreateByID returns the library movie with the given provider IDs,
+// fetching it from the metadata provider when it does not exist yet.
+func (m *Matcher) findOrCreateByID(ctx context.Context, tmdbID *int32, imdbID *string) (*Movie, bool, error) {
+	if tmdbID == nil && (imdbID == nil || *imdbID == "") {
+		return nil, false, ErrNoProviderID
+	}
+
+	if tmdbID != nil {
+		if mov, err := m.repo.GetMovieByTMDbID(ctx, *tmdbID); err == nil {
+			return mov, false, nil
+		} else if !errors.Is(err, ErrMovieNotFound) {
+			return nil, false, fmt.Errorf("failed to get movie: %w", err)
+		}
+	}
+	if imdbID != nil && *imdbID != "" {
+		if mov, err := m.repo.GetMovieByIMDbID(ctx, *imdbID); err == nil {
+			return mov, false, nil
+		} else if !errors.Is(err, ErrMovieNotFound) {
+			return nil, false, fmt.Errorf("failed to get movie: %w", err)
+		}
+	}
+
+	mov := &Movie{TMDbID: tmdbID, IMDbID: imdbID}
+	if err := m.metadataService.EnrichMovie(ctx, mov); err != nil {
+		return nil, false, fmt.Errorf("failed to fetch movie metadata: %w", err)
+	}
+
+	created, err := m.saveMovie(ctx, mov)
+	if err != nil {
+		return nil, false, err
+	}
+	return created, true, nil
+}
+
 // findExistingMovie searches for an existing movie in the database
 func (m *Matcher) findExistingMovie(ctx context.Context, result ScanResult) (*Movie, error) {
 	if result.ParsedTitle == "" {
@@ -179,6 +286,11 @@ func (m *Matcher) createMovieFromTMDb(ctx context.Context, tmdbMovie *Movie) (*M
 		}
 	}
 
+	return m.saveMovie(ctx, tmdbMovie)
+}
+
+// saveMovie stores an already enriched movie along with its credits and genres
+func (m *Matcher) saveMovie(ctx context.Context, tmdbMovie *Movie) (*Movie, error) {
 	// Save to database
 	params := CreateMovieParams{
 		TMDbID:        tmdbMovie.TMDbID,
@@ -211,6 +323,8 @@ func (m *Matcher) createMovieFromTMDb(ctx context.Context, tmdbMovie *Movie) (*M
 				creditParams := CreateMovieCreditParams{
 					MovieID:      newMovie.ID,
 					TMDbPersonID: credit.TMDbPersonID,
+					TVDbPersonID: credit.TVDbPersonID,
+					IMDbPersonID: credit.IMDbPersonID,
 					Name:         credit.Name,
 					CreditType:   credit.CreditType,
 					Character:    credit.Character,
@@ -234,6 +348,13 @@ func (m *Matcher) createMovieFromTMDb(ctx context.Context, tmdbMovie *Movie) (*M
 				_ = m.repo.AddMovieGenre(ctx, newMovie.ID, genre.Slug, genre.Name)
 			}
 		}
+
+		tags, err := m.metadataService.GetMovieTags(ctx, newMovie.ID, fmt.Sprintf("%d", *tmdbMovie.TMDbID))
+		if err == nil {
+			for _, tag := range tags {
+				_ = m.repo.AddMovieTag(ctx, newMovie.ID, tag.Slug, tag.Name, tag.Spoiler)
+			}
+		}
 	}
 
 	return newMovie, nil
@@ -1,11 +1,17 @@
 package movie
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/govalues/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
 )
 
 func TestCalculateConfidence(t *testing.T) {
@@ -45,6 +51,7 @@ func TestCalculateConfidence(t *testing.T) {
 
 		// 0.5 (exact title) - 0.05 (no year penalty) = 0.45
 		assert.InDelta(t, 0.45, confidence, 0.001)
+		assert.GreaterOrEqual(t, confidence, DefaultMinMatchConfidence, "exact titles without a year are matched")
 	})
 
 	t.Run("Partial title match, exact year", func(t *testing.T) {
@@ -298,3 +305,45 @@ func decimalPtr(s string) *decimal.Decimal {
 	d, _ := decimal.Parse(s)
 	return &d
 }
+
+func TestMatcher_MatchFile_ExactTitleWithoutYear(t *testing.T) {
+	ctx := context.Background()
+	releaseDate := time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC)
+	scan := ScanResult{FilePath: "/movies/The Matrix.mkv", ParsedTitle: "The Matrix"}
+
+	t.Run("matched with the default threshold", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		repo.On("SearchMoviesByTitle", ctx, "The Matrix", int32(10), int32(0)).Return([]Movie{}, nil)
+		metadata.On("SearchMovies", ctx, "The Matrix", (*int)(nil)).Return([]*Movie{
+			{Title: "The Matrix", ReleaseDate: &releaseDate},
+		}, nil)
+		created := &Movie{ID: uuid.Must(uuid.NewV7()), Title: "The Matrix"}
+		repo.On("CreateMovie", ctx, mock.AnythingOfType("movie.CreateMovieParams")).Return(created, nil)
+
+		result := NewMatcher(repo, metadata).MatchFile(ctx, scan)
+
+		require.NoError(t, result.Error)
+		assert.Equal(t, MatchTypeTitle, result.MatchType)
+		assert.Equal(t, created, result.Movie)
+		assert.True(t, result.CreatedNewMovie)
+		assert.InDelta(t, 0.45, result.Confidence, 0.001)
+	})
+
+	t.Run("left for review above a configured threshold", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		repo.On("SearchMoviesByTitle", ctx, "The Matrix", int32(10), int32(0)).Return([]Movie{}, nil)
+		metadata.On("SearchMovies", ctx, "The Matrix", (*int)(nil)).Return([]*Movie{
+			{Title: "The Matrix", ReleaseDate: &releaseDate},
+		}, nil)
+
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{MinMatchConfidence: 0.6}, new(MockProber))
+		result := svc.matcher.MatchFile(ctx, scan)
+
+		assert.Equal(t, MatchTypeUnmatched, result.MatchType)
+		assert.InDelta(t, 0.6, result.MinConfidence, 0.001)
+		require.Len(t, result.Candidates, 1)
+		repo.AssertNotCalled(t, "CreateMovie", mock.Anything, mock.Anything)
+	})
+}
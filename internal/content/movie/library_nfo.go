@@ -0,0 +1,287 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/shared/nfo"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// nfoExportPageSize is how many movies ExportLibraryNFO loads per page.
+const nfoExportPageSize = 100
+
+// nfoExportCastLimit caps the actors written to an exported NFO.
+const nfoExportCastLimit = 50
+
+// NFOExportSummary contains statistics from an NFO export.
+type NFOExportSummary struct {
+	Movies int
+	Files  int
+	Errors []error
+}
+
+// loadNFO attaches the NFO sidecar of a scanned file when NFO import is
+// enabled. Curated NFO titles replace the title parsed from the filename.
+func (s *LibraryService) loadNFO(result *ScanResult) {
+	if !s.nfoConfig.Import {
+		return
+	}
+
+	result.NFO = readMovieNFO(result.FilePath)
+	if result.NFO == nil || result.NFO.Title == "" {
+		return
+	}
+
+	result.ParsedTitle = result.NFO.Title
+	if result.NFO.Year > 0 {
+		year := result.NFO.Year
+		result.ParsedYear = &year
+	}
+}
+
+// applyNFO writes the NFO sort title and the configured override fields to
+// the movie. The movie is only updated when a value actually differs.
+func (s *LibraryService) applyNFO(ctx context.Context, mov *Movie, doc *nfo.Movie) (*Movie, error) {
+	params := UpdateMovieParams{ID: mov.ID}
+	changed := false
+
+	if doc.SortTitle != "" && !strPtrEquals(mov.SortTitle, doc.SortTitle) {
+		params.SortTitle = &doc.SortTitle
+		changed = true
+	}
+
+	for _, field := range s.nfoConfig.OverrideFields {
+		switch field {
+		case "title":
+			if doc.Title != "" && doc.Title != mov.Title {
+				params.Title = &doc.Title
+				changed = true
+			}
+		case "original_title":
+			if doc.OriginalTitle != "" && !strPtrEquals(mov.OriginalTitle, doc.OriginalTitle) {
+				params.OriginalTitle = &doc.OriginalTitle
+				changed = true
+			}
+		case "year":
+			if year := util.SafeIntToInt32(doc.Year); year > 0 && (mov.Year == nil || *mov.Year != year) {
+				params.Year = &year
+				changed = true
+			}
+		case "runtime":
+			if runtime := util.SafeIntToInt32(doc.Runtime); runtime > 0 && (mov.Runtime == nil || *mov.Runtime != runtime) {
+				params.Runtime = &runtime
+				changed = true
+			}
+		case "overview":
+			if doc.Plot != "" && !strPtrEquals(mov.Overview, doc.Plot) {
+				params.Overview = &doc.Plot
+				changed = true
+			}
+		case "tagline":
+			if doc.Tagline != "" && !strPtrEquals(mov.Tagline, doc.Tagline) {
+				params.Tagline = &doc.Tagline
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return mov, nil
+	}
+
+	updated, err := s.repo.UpdateMovie(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply nfo: %w", err)
+	}
+	return updated, nil
+}
+
+// ApplyNFOOverrides re-applies NFO values to a movie from the sidecar of its
+// first file that has one. Metadata refreshes call this so configured NFO
+// fields keep winning over provider data.
+func (s *LibraryService) ApplyNFOOverrides(ctx context.Context, movieID uuid.UUID) error {
+	if !s.nfoConfig.Import {
+		return nil
+	}
+
+	files, err := s.repo.ListMovieFilesByMovieID(ctx, movieID)
+	if err != nil {
+		return fmt.Errorf("failed to list movie files: %w", err)
+	}
+
+	for _, file := range files {
+		doc := readMovieNFO(file.FilePath)
+		if doc == nil {
+			continue
+		}
+
+		mov, err := s.repo.GetMovie(ctx, movieID)
+		if err != nil {
+			return fmt.Errorf("failed to get movie: %w", err)
+		}
+		_, err = s.applyNFO(ctx, mov, doc)
+		return err
+	}
+	return nil
+}
+
+// ExportNFO writes an NFO next to every file of a movie and returns the
+// number of files written.
+func (s *LibraryService) ExportNFO(ctx context.Context, movieID uuid.UUID) (int, error) {
+	mov, err := s.repo.GetMovie(ctx, movieID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get movie: %w", err)
+	}
+
+	files, err := s.repo.ListMovieFilesByMovieID(ctx, movieID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list movie files: %w", err)
+	}
+	if len(files) == 0 {
+		return 0, nil
+	}
+
+	doc, err := s.movieToNFO(ctx, mov)
+	if err != nil {
+		return 0, err
+	}
+
+	written := 0
+	for _, file := range files {
+		if err := nfo.WriteFile(nfo.MoviePath(file.FilePath), doc); err != nil {
+			return written, fmt.Errorf("failed to write nfo for %s: %w", file.FilePath, err)
+		}
+		written++
+	}
+	return written, nil
+}
+
+// ExportLibraryNFO writes NFOs for every movie in the library. Failures for
+// single movies are collected and do not stop the export.
+func (s *LibraryService) ExportLibraryNFO(ctx context.Context) (*NFOExportSummary, error) {
+	summary := &NFOExportSummary{}
+
+	for offset := int32(0); ; offset += nfoExportPageSize {
+		movies, err := s.repo.ListMovies(ctx, ListFilters{Limit: nfoExportPageSize, Offset: offset})
+		if err != nil {
+			return summary, fmt.Errorf("failed to list movies: %w", err)
+		}
+
+		for _, mov := range movies {
+			if err := ctx.Err(); err != nil {
+				return summary, err
+			}
+
+			written, err := s.ExportNFO(ctx, mov.ID)
+			if err != nil {
+				summary.Errors = append(summary.Errors, err)
+			}
+			if written > 0 {
+				summary.Movies++
+				summary.Files += written
+			}
+		}
+
+		if len(movies) < nfoExportPageSize {
+			return summary, nil
+		}
+	}
+}
+
+// movieToNFO builds the NFO document for a movie.
+func (s *LibraryService) movieToNFO(ctx context.Context, mov *Movie) (*nfo.Movie, error) {
+	doc := &nfo.Movie{
+		Title:   mov.Title,
+		Plot:    derefString(mov.Overview),
+		Tagline: derefString(mov.Tagline),
+	}
+	if mov.OriginalTitle != nil {
+		doc.OriginalTitle = *mov.OriginalTitle
+	}
+	if mov.SortTitle != nil {
+		doc.SortTitle = *mov.SortTitle
+	}
+	if mov.Year != nil {
+		doc.Year = int(*mov.Year)
+	}
+	if mov.ReleaseDate != nil {
+		doc.Premiered = mov.ReleaseDate.Format("2006-01-02")
+	}
+	if mov.Runtime != nil {
+		doc.Runtime = int(*mov.Runtime)
+	}
+	if rating, ok := mov.AgeRatings["US"]["MPAA"]; ok {
+		doc.MPAA = rating
+	}
+	if mov.TMDbID != nil {
+		doc.SetUniqueID(nfo.ProviderTMDb, fmt.Sprintf("%d", *mov.TMDbID))
+	}
+	if mov.IMDbID != nil && *mov.IMDbID != "" {
+		doc.SetUniqueID(nfo.ProviderIMDb, *mov.IMDbID)
+	}
+
+	genres, err := s.repo.ListMovieGenres(ctx, mov.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list genres: %w", err)
+	}
+	for _, genre := range genres {
+		doc.Genres = append(doc.Genres, genre.Name)
+	}
+
+	cast, err := s.repo.ListMovieCast(ctx, mov.ID, nfoExportCastLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cast: %w", err)
+	}
+	for _, credit := range cast {
+		actor := nfo.Actor{Name: credit.Name, Role: derefString(credit.Character)}
+		if credit.CastOrder != nil {
+			order := int(*credit.CastOrder)
+			actor.Order = &order
+		}
+		doc.Actors = append(doc.Actors, actor)
+	}
+
+	crew, err := s.repo.ListMovieCrew(ctx, mov.ID, 100, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crew: %w", err)
+	}
+	for _, credit := range crew {
+		switch {
+		case credit.Job != nil && *credit.Job == "Director":
+			doc.Directors = append(doc.Directors, credit.Name)
+		case credit.Department != nil && strings.EqualFold(*credit.Department, "Writing"):
+			doc.Credits = append(doc.Credits, credit.Name)
+		}
+	}
+
+	return doc, nil
+}
+
+// readMovieNFO returns the parsed NFO for a video file, or nil if there is
+// none or it cannot be read.
+func readMovieNFO(videoPath string) *nfo.Movie {
+	path := nfo.FindMovie(videoPath)
+	if path == "" {
+		return nil
+	}
+	doc, err := nfo.ReadMovie(path)
+	if err != nil {
+		return nil
+	}
+	return doc
+}
+
+func strPtrEquals(p *string, s string) bool {
+	return p != nil && *p == s
+}
+
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
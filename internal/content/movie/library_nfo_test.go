@@ -0,0 +1,152 @@
+package movie
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/nfo"
+)
+
+const matrixNFO = `<?xml version="1.0" encoding="UTF-8" standalone="yes" ?>
+<movie>
+  <title>Matrix, The</title>
+  <sorttitle>Matrix 1</sorttitle>
+  <year>1999</year>
+  <plot>Curated plot.</plot>
+  <uniqueid type="tmdb" default="true">603</uniqueid>
+</movie>
+`
+
+func TestLibraryService_ScanLibrary_NFO(t *testing.T) {
+	tempDir := t.TempDir()
+	movieFile := filepath.Join(tempDir, "matrix.rip.mkv")
+	require.NoError(t, os.WriteFile(movieFile, []byte("dummy content"), 0644))
+	require.NoError(t, os.WriteFile(nfo.MoviePath(movieFile), []byte(matrixNFO), 0644))
+
+	t.Run("NFO ID short-circuits title matching", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		libConfig := config.LibraryConfig{
+			Paths: []string{tempDir},
+			NFO:   config.NFOConfig{Import: true, OverrideFields: []string{"overview"}},
+		}
+		prober := new(MockProber)
+		svc := NewLibraryService(repo, metadata, libConfig, prober)
+		ctx := context.Background()
+
+		existing := &Movie{ID: uuid.Must(uuid.NewV7()), Title: "The Matrix", TMDbID: new(int32(603))}
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+		repo.On("GetMovieByTMDbID", ctx, int32(603)).Return(existing, nil)
+		repo.On("UpdateMovie", ctx, mock.MatchedBy(func(p UpdateMovieParams) bool {
+			return p.ID == existing.ID &&
+				*p.SortTitle == "Matrix 1" &&
+				*p.Overview == "Curated plot." &&
+				p.Title == nil
+		})).Return(existing, nil)
+		prober.On("Probe", movieFile).Return(&MediaInfo{FilePath: movieFile, Container: "mkv"}, nil)
+		repo.On("CreateMovieFile", ctx, mock.MatchedBy(func(p CreateMovieFileParams) bool {
+			return p.MovieID == existing.ID && p.FilePath == movieFile
+		})).Return(&MovieFile{}, nil)
+
+		summary, err := svc.ScanLibrary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.MatchedFiles)
+		assert.Equal(t, 1, summary.ExistingMovies)
+
+		metadata.AssertNotCalled(t, "SearchMovies", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("NFO is ignored when import is disabled", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{Paths: []string{tempDir}}, new(MockProber))
+		ctx := context.Background()
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+		repo.On("SearchMoviesByTitle", ctx, mock.Anything, int32(10), int32(0)).Return([]Movie{}, nil)
+		metadata.On("SearchMovies", ctx, mock.Anything, mock.Anything).Return([]*Movie{}, nil)
+
+		summary, err := svc.ScanLibrary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.UnmatchedFiles)
+		repo.AssertNotCalled(t, "GetMovieByTMDbID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestLibraryService_ApplyNFO(t *testing.T) {
+	ctx := context.Background()
+	doc := &nfo.Movie{Title: "Custom", SortTitle: "Sort", Tagline: "Same"}
+	mov := &Movie{ID: uuid.Must(uuid.NewV7()), Title: "Custom", SortTitle: new("Sort"), Tagline: new("Same")}
+
+	repo := new(MockMovieRepository)
+	svc := NewLibraryService(repo, new(MockMetadataProvider), config.LibraryConfig{
+		NFO: config.NFOConfig{Import: true, OverrideFields: []string{"title", "tagline"}},
+	}, new(MockProber))
+
+	updated, err := svc.applyNFO(ctx, mov, doc)
+	require.NoError(t, err)
+	assert.Same(t, mov, updated)
+	repo.AssertNotCalled(t, "UpdateMovie", mock.Anything, mock.Anything)
+}
+
+func TestLibraryService_ExportNFO(t *testing.T) {
+	tempDir := t.TempDir()
+	movieFile := filepath.Join(tempDir, "The Matrix (1999).mkv")
+	ctx := context.Background()
+
+	movieID := uuid.Must(uuid.NewV7())
+	release := time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC)
+	mov := &Movie{
+		ID:          movieID,
+		Title:       "The Matrix",
+		SortTitle:   new("Matrix 1"),
+		Year:        new(int32(1999)),
+		ReleaseDate: &release,
+		TMDbID:      new(int32(603)),
+		IMDbID:      new("tt0133093"),
+		AgeRatings:  map[string]map[string]string{"US": {"MPAA": "R"}},
+	}
+
+	repo := new(MockMovieRepository)
+	repo.On("GetMovie", ctx, movieID).Return(mov, nil)
+	repo.On("ListMovieFilesByMovieID", ctx, movieID).Return([]MovieFile{{FilePath: movieFile}}, nil)
+	repo.On("ListMovieGenres", ctx, movieID).Return([]MovieGenre{{Name: "Action"}}, nil)
+	repo.On("ListMovieCast", ctx, movieID, int32(nfoExportCastLimit), int32(0)).Return([]MovieCredit{
+		{Name: "Keanu Reeves", Character: new("Neo"), CastOrder: new(int32(0))},
+	}, nil)
+	repo.On("ListMovieCrew", ctx, movieID, int32(100), int32(0)).Return([]MovieCredit{
+		{Name: "Lana Wachowski", Job: new("Director"), Department: new("Directing")},
+		{Name: "Lilly Wachowski", Job: new("Writer"), Department: new("Writing")},
+	}, nil)
+
+	svc := NewLibraryService(repo, new(MockMetadataProvider), config.LibraryConfig{}, new(MockProber))
+
+	written, err := svc.ExportNFO(ctx, movieID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, written)
+
+	doc, err := nfo.ReadMovie(filepath.Join(tempDir, "The Matrix (1999).nfo"))
+	require.NoError(t, err)
+	assert.Equal(t, "The Matrix", doc.Title)
+	assert.Equal(t, "Matrix 1", doc.SortTitle)
+	assert.Equal(t, "1999-03-31", doc.Premiered)
+	assert.Equal(t, "R", doc.MPAA)
+	assert.Equal(t, int32(603), *doc.TMDbID())
+	assert.Equal(t, "tt0133093", *doc.IMDbID())
+	assert.Equal(t, []string{"Action"}, doc.Genres)
+	assert.Equal(t, []string{"Lana Wachowski"}, doc.Directors)
+	assert.Equal(t, []string{"Lilly Wachowski"}, doc.Credits)
+	require.Len(t, doc.Actors, 1)
+	assert.Equal(t, "Neo", doc.Actors[0].Role)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/content/movie/adapters"
+	"github.com/lusoris/revenge/internal/content/shared/nfo"
 	"github.com/lusoris/revenge/internal/content/shared/scanner"
 	"github.com/lusoris/revenge/internal/util"
 )
@@ -38,6 +39,10 @@ type ScanResult struct {
 	FileSize    int64
 	IsVideo     bool
 	Error       error
+	// Edition is the named version parsed from {edition-...}, if any
+	Edition string
+	// NFO is the parsed NFO sidecar, set when NFO import is enabled
+	NFO *nfo.Movie
 }
 
 // NewScanner creates a new library scanner using the shared scanner framework
@@ -78,6 +83,7 @@ func convertScanResult(sr scanner.ScanResult) ScanResult {
 		FileSize:    sr.FileSize,
 		IsVideo:     sr.IsMedia,
 		Error:       sr.Error,
+		Edition:     sr.GetString("edition"),
 	}
 }
 
@@ -172,6 +178,7 @@ func ExtractFileInfo(filePath string) (*MovieFileInfo, error) {
 
 // CreateMovieFile creates a domain MovieFile from file info
 func CreateMovieFile(movieID uuid.UUID, info *MovieFileInfo) *MovieFile {
+	_, edition := scanner.ParseEdition(filepath.Base(info.Path))
 	return &MovieFile{
 		ID:         uuid.Must(uuid.NewV7()),
 		MovieID:    movieID,
@@ -182,6 +189,7 @@ func CreateMovieFile(movieID uuid.UUID, info *MovieFileInfo) *MovieFile {
 		Resolution: parseOptionalString(info.Resolution),
 		VideoCodec: parseOptionalString(info.VideoCodec),
 		AudioCodec: parseOptionalString(info.AudioCodec),
+		Edition:    parseOptionalString(edition),
 	}
 }
 
@@ -2,13 +2,15 @@ package movie
 
 import (
 	"context"
+	"errors"
 	"fmt"
-
 	"os"
+	"path/filepath"
 
 	"github.com/google/uuid"
 
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
 )
 
 // LibraryService manages movie library operations
@@ -18,6 +20,7 @@ type LibraryService struct {
 	scanner         *Scanner
 	matcher         *Matcher
 	prober          Prober
+	nfoConfig       config.NFOConfig
 }
 
 // ScanSummary contains statistics from a library scan
@@ -25,9 +28,31 @@ type ScanSummary struct {
 	TotalFiles     int
 	MatchedFiles   int
 	UnmatchedFiles int
+	LockedFiles    int
 	NewMovies      int
 	ExistingMovies int
-	Errors         []error
+	// Extras counts trailers, featurettes and other extras linked to a movie.
+	Extras int
+	Errors []error
+	// Unmatched holds the results that need manual review, including
+	// scored candidates when the provider returned any.
+	Unmatched []MatchResult
+	// MatchedPaths lists files that are matched after this scan.
+	MatchedPaths []string
+	// Matches links every matched file to its movie.
+	Matches []FileMatch
+}
+
+// FileMatch links a scanned file to the movie it belongs to.
+type FileMatch struct {
+	FilePath string
+	MovieID  uuid.UUID
+}
+
+// ManualMatch identifies a file by provider ID during manual review.
+type ManualMatch struct {
+	TMDbID *int32
+	IMDbID *string
 }
 
 // NewLibraryService creates a new library service
@@ -39,6 +64,9 @@ func NewLibraryService(
 ) *LibraryService {
 	scanner := NewScanner(libConfig.Paths)
 	matcher := NewMatcher(repo, metadataService)
+	if libConfig.MinMatchConfidence > 0 {
+		matcher.minConfidence = libConfig.MinMatchConfidence
+	}
 
 	if prober == nil {
 		prober = NewMediaInfoProber()
@@ -50,12 +78,13 @@ func NewLibraryService(
 		scanner:         scanner,
 		matcher:         matcher,
 		prober:          prober,
+		nfoConfig:       libConfig.NFO,
 	}
 }
 
 // ScanLibrary scans the library paths configured at startup.
 func (s *LibraryService) ScanLibrary(ctx context.Context) (*ScanSummary, error) {
-	return s.scanPaths(ctx, s.scanner)
+	return s.scanPaths(ctx, s.scanner, nil)
 }
 
 // ScanLibraryWithPaths scans the given paths instead of the default config paths.
@@ -65,13 +94,29 @@ func (s *LibraryService) ScanLibraryWithPaths(ctx context.Context, paths []strin
 		return &ScanSummary{}, nil
 	}
 	scanner := NewScanner(paths)
-	return s.scanPaths(ctx, scanner)
+	return s.scanPaths(ctx, scanner, nil)
+}
+
+// ScanLibraryWithProgress scans the given paths, or the configured paths if
+// none are given, and reports progress while files are matched. The scan
+// stops with the context's error when ctx is cancelled.
+func (s *LibraryService) ScanLibraryWithProgress(ctx context.Context, paths []string, onProgress scanner.ProgressFunc) (*ScanSummary, error) {
+	fileScanner := s.scanner
+	if len(paths) > 0 {
+		fileScanner = NewScanner(paths)
+	}
+	return s.scanPaths(ctx, fileScanner, onProgress)
 }
 
 // scanPaths runs a scan using the provided scanner and processes results.
-func (s *LibraryService) scanPaths(ctx context.Context, scanner *Scanner) (*ScanSummary, error) {
+func (s *LibraryService) scanPaths(ctx context.Context, fileScanner *Scanner, onProgress scanner.ProgressFunc) (*ScanSummary, error) {
+	if onProgress == nil {
+		onProgress = func(scanner.Progress) {}
+	}
+	onProgress(scanner.Progress{Phase: scanner.PhaseDiscovering})
+
 	// Scan file system
-	scanResults, err := scanner.Scan(ctx)
+	scanResults, err := fileScanner.Scan(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("scan failed: %w", err)
 	}
@@ -80,47 +125,111 @@ func (s *LibraryService) scanPaths(ctx context.Context, scanner *Scanner) (*Scan
 		TotalFiles: len(scanResults),
 	}
 
-	// Match files to movies
-	matchResults, err := s.matcher.MatchFiles(ctx, scanResults)
-	if err != nil {
-		return nil, fmt.Errorf("match failed: %w", err)
-	}
-
-	// Process match results
-	for _, result := range matchResults {
-		if result.Error != nil {
-			summary.Errors = append(summary.Errors, result.Error)
-			summary.UnmatchedFiles++
+	// Extras are linked to their movie once the main files are matched.
+	// Manually identified files keep their match.
+	detected := detectExtras(scanResults)
+	toMatch := make([]ScanResult, 0, len(scanResults))
+	var extras []scannedExtra
+	hasRecord := make(map[string]bool)
+	for _, result := range scanResults {
+		if extra, ok := detected[result.FilePath]; ok {
+			extras = append(extras, scannedExtra{ScanResult: result, Extra: extra})
 			continue
 		}
 
-		if result.Movie != nil {
-			summary.MatchedFiles++
-			if result.CreatedNewMovie {
-				summary.NewMovies++
-
-				// Create movie file record
-				fileInfo, err := s.extractFileInfo(result.ScanResult.FilePath)
-				if err != nil {
-					summary.Errors = append(summary.Errors, fmt.Errorf("failed to extract file info: %w", err))
-					continue
-				}
-
-				movieFile := CreateMovieFile(result.Movie.ID, fileInfo)
-				if err := s.createMovieFile(ctx, movieFile); err != nil {
-					summary.Errors = append(summary.Errors, fmt.Errorf("failed to create movie file: %w", err))
-				}
-			} else {
-				summary.ExistingMovies++
+		existing, err := s.repo.GetMovieFileByPath(ctx, result.FilePath)
+		if err == nil && existing != nil {
+			if existing.MatchLocked {
+				summary.LockedFiles++
+				summary.MatchedFiles++
+				summary.MatchedPaths = append(summary.MatchedPaths, result.FilePath)
+				summary.Matches = append(summary.Matches, FileMatch{FilePath: result.FilePath, MovieID: existing.MovieID})
+				continue
 			}
-		} else {
-			summary.UnmatchedFiles++
+			hasRecord[result.FilePath] = true
 		}
+		s.loadNFO(&result)
+		toMatch = append(toMatch, result)
 	}
 
+	// Match files to movies one at a time so the scan can be cancelled
+	// between files.
+	for i, file := range toMatch {
+		onProgress(scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(toMatch),
+			FilesProcessed: i,
+			FilesMatched:   summary.MatchedFiles,
+			CurrentPath:    file.FilePath,
+		})
+		if err := ctx.Err(); err != nil {
+			return summary, fmt.Errorf("scan cancelled: %w", err)
+		}
+
+		s.processMatch(ctx, s.matcher.MatchFile(ctx, file), hasRecord[file.FilePath], summary)
+	}
+	onProgress(scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(toMatch),
+		FilesProcessed: len(toMatch),
+		FilesMatched:   summary.MatchedFiles,
+	})
+
+	s.linkExtras(ctx, extras, summary)
+
 	return summary, nil
 }
 
+// processMatch records a match result in the summary and creates the file
+// record for matched files that have none yet.
+func (s *LibraryService) processMatch(ctx context.Context, result MatchResult, hasRecord bool, summary *ScanSummary) {
+	if result.Error != nil {
+		summary.Errors = append(summary.Errors, result.Error)
+		summary.UnmatchedFiles++
+		summary.Unmatched = append(summary.Unmatched, result)
+		return
+	}
+
+	if result.Movie != nil && result.ScanResult.NFO != nil {
+		if updated, err := s.applyNFO(ctx, result.Movie, result.ScanResult.NFO); err != nil {
+			summary.Errors = append(summary.Errors, err)
+		} else {
+			result.Movie = updated
+		}
+	}
+
+	if result.Movie == nil {
+		summary.UnmatchedFiles++
+		summary.Unmatched = append(summary.Unmatched, result)
+		return
+	}
+
+	summary.MatchedFiles++
+	summary.MatchedPaths = append(summary.MatchedPaths, result.ScanResult.FilePath)
+	summary.Matches = append(summary.Matches, FileMatch{FilePath: result.ScanResult.FilePath, MovieID: result.Movie.ID})
+	if result.CreatedNewMovie {
+		summary.NewMovies++
+	} else {
+		summary.ExistingMovies++
+	}
+
+	// Create the file record; further editions of a known movie
+	// get their own record as well.
+	if hasRecord {
+		return
+	}
+	fileInfo, err := s.extractFileInfo(result.ScanResult.FilePath)
+	if err != nil {
+		summary.Errors = append(summary.Errors, fmt.Errorf("failed to extract file info: %w", err))
+		return
+	}
+
+	movieFile := CreateMovieFile(result.Movie.ID, fileInfo)
+	if _, err := s.createMovieFile(ctx, movieFile); err != nil {
+		summary.Errors = append(summary.Errors, fmt.Errorf("failed to create movie file: %w", err))
+	}
+}
+
 // extractFileInfo extracts file info using the configured prober
 func (s *LibraryService) extractFileInfo(filePath string) (*MovieFileInfo, error) {
 	mediaInfo, err := s.prober.Probe(filePath)
@@ -140,7 +249,7 @@ func (s *LibraryService) extractFileInfo(filePath string) (*MovieFileInfo, error
 }
 
 // createMovieFile creates a movie file record
-func (s *LibraryService) createMovieFile(ctx context.Context, movieFile *MovieFile) error {
+func (s *LibraryService) createMovieFile(ctx context.Context, movieFile *MovieFile) (*MovieFile, error) {
 	params := CreateMovieFileParams{
 		MovieID:     movieFile.MovieID,
 		FilePath:    movieFile.FilePath,
@@ -151,10 +260,10 @@ func (s *LibraryService) createMovieFile(ctx context.Context, movieFile *MovieFi
 		VideoCodec:  movieFile.VideoCodec,
 		AudioCodec:  movieFile.AudioCodec,
 		BitrateKbps: movieFile.BitrateKbps,
+		Edition:     movieFile.Edition,
 	}
 
-	_, err := s.repo.CreateMovieFile(ctx, params)
-	return err
+	return s.repo.CreateMovieFile(ctx, params)
 }
 
 // RefreshMovie updates a movie's metadata from TMDb
@@ -172,9 +281,12 @@ func (s *LibraryService) RefreshMovie(ctx context.Context, movieID uuid.UUID) er
 	tmdbID := fmt.Sprintf("%d", *existingMovie.TMDbID)
 
 	// Fetch fresh metadata from TMDb
+	posterPath, backdropPath := existingMovie.PosterPath, existingMovie.BackdropPath
 	if err := s.metadataService.EnrichMovie(ctx, existingMovie); err != nil {
 		return fmt.Errorf("failed to enrich movie: %w", err)
 	}
+	keepLocalImage(&existingMovie.PosterPath, posterPath)
+	keepLocalImage(&existingMovie.BackdropPath, backdropPath)
 
 	// Update movie in database
 	params := UpdateMovieParams{
@@ -216,6 +328,8 @@ func (s *LibraryService) RefreshMovie(ctx context.Context, movieID uuid.UUID) er
 			creditParams := CreateMovieCreditParams{
 				MovieID:      movieID,
 				TMDbPersonID: credit.TMDbPersonID,
+				TVDbPersonID: credit.TVDbPersonID,
+				IMDbPersonID: credit.IMDbPersonID,
 				Name:         credit.Name,
 				CreditType:   credit.CreditType,
 				Character:    credit.Character,
@@ -248,6 +362,21 @@ func (s *LibraryService) RefreshMovie(ctx context.Context, movieID uuid.UUID) er
 		}
 	}
 
+	// Refresh keywords and tags
+	tags, err := s.metadataService.GetMovieTags(ctx, movieID, tmdbID)
+	if err == nil && len(tags) > 0 {
+		if err := s.repo.DeleteMovieTags(ctx, movieID); err != nil {
+			return fmt.Errorf("failed to delete old tags: %w", err)
+		}
+
+		for _, tag := range tags {
+			if err := s.repo.AddMovieTag(ctx, movieID, tag.Slug, tag.Name, tag.Spoiler); err != nil {
+				// Log but continue with other tags
+				continue
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -257,7 +386,7 @@ func (s *LibraryService) GetLibraryStats(ctx context.Context) (map[string]int, e
 		"total_movies": 0,
 	}
 
-	count, err := s.repo.CountMovies(ctx)
+	count, err := s.repo.CountMovies(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("count movies: %w", err)
 	}
@@ -280,25 +409,28 @@ func (s *LibraryService) MatchFile(ctx context.Context, filePath string, forceRe
 		return nil, fmt.Errorf("not a video file: %s", filePath)
 	}
 
-	// Check if file is already matched (unless force rematch)
-	if !forceRematch {
-		existingFile, err := s.repo.GetMovieFileByPath(ctx, filePath)
-		if err == nil && existingFile != nil {
-			// File already matched
-			movie, err := s.repo.GetMovie(ctx, existingFile.MovieID)
-			if err == nil {
-				return &MatchResult{
-					ScanResult: ScanResult{
-						FilePath: filePath,
-						FileName: fileInfo.Name(),
-						FileSize: fileInfo.Size(),
-						IsVideo:  true,
-					},
-					Movie:      movie,
-					MatchType:  MatchTypeExact,
-					Confidence: 1.0,
-				}, nil
+	// Check if file is already matched (unless force rematch).
+	// Locked matches are kept even when a rematch is forced.
+	existingFile, err := s.repo.GetMovieFileByPath(ctx, filePath)
+	if err == nil && existingFile != nil && (!forceRematch || existingFile.MatchLocked) {
+		// File already matched
+		movie, err := s.repo.GetMovie(ctx, existingFile.MovieID)
+		if err == nil {
+			matchType := MatchTypeExact
+			if existingFile.MatchLocked {
+				matchType = MatchTypeManual
 			}
+			return &MatchResult{
+				ScanResult: ScanResult{
+					FilePath: filePath,
+					FileName: fileInfo.Name(),
+					FileSize: fileInfo.Size(),
+					IsVideo:  true,
+				},
+				Movie:      movie,
+				MatchType:  matchType,
+				Confidence: 1.0,
+			}, nil
 		}
 	}
 
@@ -315,16 +447,23 @@ func (s *LibraryService) MatchFile(ctx context.Context, filePath string, forceRe
 		FileSize:    fileInfo.Size(),
 		IsVideo:     true,
 	}
+	s.loadNFO(&scanResult)
 
 	// Use matcher to match the file
 	matchResult := s.matcher.MatchFile(ctx, scanResult)
 
+	if matchResult.Movie != nil && scanResult.NFO != nil {
+		if updated, err := s.applyNFO(ctx, matchResult.Movie, scanResult.NFO); err == nil {
+			matchResult.Movie = updated
+		}
+	}
+
 	// If match was successful and created a new movie, create the file record
 	if matchResult.Movie != nil && matchResult.Error == nil {
 		fileInfoExtracted, err := s.extractFileInfo(filePath)
 		if err == nil {
 			movieFile := CreateMovieFile(matchResult.Movie.ID, fileInfoExtracted)
-			if err := s.createMovieFile(ctx, movieFile); err != nil {
+			if _, err := s.createMovieFile(ctx, movieFile); err != nil {
 				// Log error but don't fail the match
 				matchResult.Error = fmt.Errorf("matched but failed to create file record: %w", err)
 			}
@@ -333,3 +472,57 @@ func (s *LibraryService) MatchFile(ctx context.Context, filePath string, forceRe
 
 	return &matchResult, nil
 }
+
+// IdentifyFile manually matches a file to the movie with the given provider
+// ID. The movie is created from provider metadata if it is not in the
+// library yet. The file's match is locked so later scans keep it.
+func (s *LibraryService) IdentifyFile(ctx context.Context, filePath string, match ManualMatch) (*MatchResult, error) {
+	if match.TMDbID == nil && (match.IMDbID == nil || *match.IMDbID == "") {
+		return nil, ErrNoProviderID
+	}
+
+	mov, created, err := s.matcher.findOrCreateByID(ctx, match.TMDbID, match.IMDbID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingFile, err := s.repo.GetMovieFileByPath(ctx, filePath)
+	switch {
+	case err == nil:
+		if _, err := s.repo.AssignMovieFileMatch(ctx, existingFile.ID, mov.ID, true); err != nil {
+			return nil, fmt.Errorf("failed to assign movie file: %w", err)
+		}
+	case errors.Is(err, ErrMovieFileNotFound):
+		fileInfo, err := s.extractFileInfo(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract file info: %w", err)
+		}
+		file, err := s.createMovieFile(ctx, CreateMovieFile(mov.ID, fileInfo))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create movie file: %w", err)
+		}
+		if _, err := s.repo.SetMovieFileMatchLocked(ctx, file.ID, true); err != nil {
+			return nil, fmt.Errorf("failed to lock movie file match: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("failed to get movie file: %w", err)
+	}
+
+	return &MatchResult{
+		ScanResult: ScanResult{
+			FilePath: filePath,
+			FileName: filepath.Base(filePath),
+			IsVideo:  true,
+		},
+		Movie:           mov,
+		MatchType:       MatchTypeManual,
+		Confidence:      1.0,
+		CreatedNewMovie: created,
+	}, nil
+}
+
+// UnlockFileMatch clears the manual match lock so the next scan may rematch
+// the file.
+func (s *LibraryService) UnlockFileMatch(ctx context.Context, fileID uuid.UUID) (*MovieFile, error) {
+	return s.repo.SetMovieFileMatchLocked(ctx, fileID, false)
+}
@@ -2,12 +2,14 @@ package movie
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -47,6 +49,14 @@ func (m *MockMetadataProvider) GetMovieGenres(ctx context.Context, movieID uuid.
 	return args.Get(0).([]MovieGenre), args.Error(1)
 }
 
+func (m *MockMetadataProvider) GetMovieTags(ctx context.Context, movieID uuid.UUID, providerID string) ([]MovieTag, error) {
+	args := m.Called(ctx, movieID, providerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]MovieTag), args.Error(1)
+}
+
 func (m *MockMetadataProvider) ClearCache() {
 	m.Called()
 }
@@ -81,6 +91,9 @@ func TestLibraryService_ScanLibrary(t *testing.T) {
 		}
 		prober.On("Probe", movieFile).Return(mediaInfo, nil)
 
+		// Mock Repository - file is not known yet
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+
 		// Mock Repository - first search for existing movies (returns empty)
 		repo.On("SearchMoviesByTitle", ctx, "The Matrix", int32(10), int32(0)).Return([]Movie{}, nil)
 
@@ -97,6 +110,7 @@ func TestLibraryService_ScanLibrary(t *testing.T) {
 		metadata.On("EnrichMovie", ctx, mock.AnythingOfType("*movie.Movie")).Return(nil)
 		metadata.On("GetMovieCredits", ctx, mock.Anything, "603").Return([]MovieCredit{}, nil)
 		metadata.On("GetMovieGenres", ctx, mock.Anything, "603").Return([]MovieGenre{}, nil)
+		metadata.On("GetMovieTags", ctx, mock.Anything, "603").Return([]MovieTag{}, nil)
 
 		// Mock Repository
 		// 1. Create Movie
@@ -134,6 +148,9 @@ func TestLibraryService_ScanLibrary(t *testing.T) {
 		svc := NewLibraryService(repo, metadata, libConfig, prober)
 		ctx := context.Background()
 
+		// Mock Repository - file is not known yet
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+
 		// Mock Repository - first search for existing movies (returns empty)
 		repo.On("SearchMoviesByTitle", ctx, "The Matrix", int32(10), int32(0)).Return([]Movie{}, nil)
 
@@ -145,7 +162,134 @@ func TestLibraryService_ScanLibrary(t *testing.T) {
 		assert.Equal(t, 1, summary.TotalFiles)
 		assert.Equal(t, 0, summary.MatchedFiles)
 		assert.Equal(t, 1, summary.UnmatchedFiles)
+		require.Len(t, summary.Unmatched, 1)
+		assert.Equal(t, movieFile, summary.Unmatched[0].ScanResult.FilePath)
+
+		repo.AssertExpectations(t)
+		metadata.AssertExpectations(t)
+	})
+
+	t.Run("Low confidence match is left for review", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{Paths: []string{tempDir}}, new(MockProber))
+		ctx := context.Background()
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+		repo.On("SearchMoviesByTitle", ctx, "The Matrix", int32(10), int32(0)).Return([]Movie{}, nil)
+
+		// Nothing close to the parsed title
+		metadata.On("SearchMovies", ctx, "The Matrix", new(1999)).Return([]*Movie{
+			{Title: "Completely Different", TMDbID: new(int32(1))},
+			{Title: "Another Film", TMDbID: new(int32(2))},
+		}, nil)
+
+		summary, err := svc.ScanLibrary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, summary.MatchedFiles)
+		assert.Equal(t, 1, summary.UnmatchedFiles)
+		assert.Empty(t, summary.Errors)
+		require.Len(t, summary.Unmatched, 1)
+		assert.Equal(t, MatchTypeUnmatched, summary.Unmatched[0].MatchType)
+		assert.Len(t, summary.Unmatched[0].Candidates, 2)
 
+		repo.AssertNotCalled(t, "CreateMovie", mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+		metadata.AssertExpectations(t)
+	})
+
+	t.Run("Locked file is skipped", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{Paths: []string{tempDir}}, new(MockProber))
+		ctx := context.Background()
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(&MovieFile{
+			ID:          uuid.Must(uuid.NewV7()),
+			FilePath:    movieFile,
+			MatchLocked: true,
+		}, nil)
+
+		summary, err := svc.ScanLibrary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.MatchedFiles)
+		assert.Equal(t, 1, summary.LockedFiles)
+		assert.Equal(t, []string{movieFile}, summary.MatchedPaths)
+
+		metadata.AssertNotCalled(t, "SearchMovies", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestLibraryService_IdentifyFile(t *testing.T) {
+	tempDir := t.TempDir()
+	movieFile := filepath.Join(tempDir, "Unknown Rip.mkv")
+	require.NoError(t, os.WriteFile(movieFile, []byte("dummy content"), 0644))
+
+	t.Run("requires a provider ID", func(t *testing.T) {
+		svc := NewLibraryService(new(MockMovieRepository), new(MockMetadataProvider), config.LibraryConfig{}, new(MockProber))
+
+		_, err := svc.IdentifyFile(context.Background(), movieFile, ManualMatch{})
+		assert.ErrorIs(t, err, ErrNoProviderID)
+	})
+
+	t.Run("links existing movie and locks the file", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		svc := NewLibraryService(repo, new(MockMetadataProvider), config.LibraryConfig{}, new(MockProber))
+		ctx := context.Background()
+
+		existing := &Movie{ID: uuid.Must(uuid.NewV7()), Title: "The Matrix", TMDbID: new(int32(603))}
+		fileID := uuid.Must(uuid.NewV7())
+
+		repo.On("GetMovieByTMDbID", ctx, int32(603)).Return(existing, nil)
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(&MovieFile{ID: fileID, FilePath: movieFile}, nil)
+		repo.On("AssignMovieFileMatch", ctx, fileID, existing.ID, true).Return(&MovieFile{ID: fileID, MatchLocked: true}, nil)
+
+		result, err := svc.IdentifyFile(ctx, movieFile, ManualMatch{TMDbID: new(int32(603))})
+		require.NoError(t, err)
+		assert.Equal(t, existing, result.Movie)
+		assert.Equal(t, MatchTypeManual, result.MatchType)
+		assert.False(t, result.CreatedNewMovie)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("creates movie from IMDb ID and new file record", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		prober := new(MockProber)
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{}, prober)
+		ctx := context.Background()
+
+		imdbID := "tt0133093"
+		movieID := uuid.Must(uuid.NewV7())
+		fileID := uuid.Must(uuid.NewV7())
+
+		repo.On("GetMovieByIMDbID", ctx, imdbID).Return(nil, ErrMovieNotFound)
+		metadata.On("EnrichMovie", ctx, mock.AnythingOfType("*movie.Movie")).Run(func(args mock.Arguments) {
+			m := args.Get(1).(*Movie)
+			m.Title = "The Matrix"
+			m.TMDbID = new(int32(603))
+		}).Return(nil)
+		repo.On("CreateMovie", ctx, mock.MatchedBy(func(p CreateMovieParams) bool {
+			return p.Title == "The Matrix" && *p.IMDbID == imdbID
+		})).Return(&Movie{ID: movieID, Title: "The Matrix"}, nil)
+		metadata.On("GetMovieCredits", ctx, movieID, "603").Return([]MovieCredit{}, nil)
+		metadata.On("GetMovieGenres", ctx, movieID, "603").Return([]MovieGenre{}, nil)
+		metadata.On("GetMovieTags", ctx, movieID, "603").Return([]MovieTag{}, nil)
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+		prober.On("Probe", movieFile).Return(&MediaInfo{FilePath: movieFile, Container: "mkv"}, nil)
+		repo.On("CreateMovieFile", ctx, mock.MatchedBy(func(p CreateMovieFileParams) bool {
+			return p.MovieID == movieID && p.FilePath == movieFile
+		})).Return(&MovieFile{ID: fileID}, nil)
+		repo.On("SetMovieFileMatchLocked", ctx, fileID, true).Return(&MovieFile{ID: fileID, MatchLocked: true}, nil)
+
+		result, err := svc.IdentifyFile(ctx, movieFile, ManualMatch{IMDbID: &imdbID})
+		require.NoError(t, err)
+		assert.Equal(t, movieID, result.Movie.ID)
+		assert.True(t, result.CreatedNewMovie)
 		repo.AssertExpectations(t)
 		metadata.AssertExpectations(t)
 	})
@@ -156,7 +300,7 @@ func TestLibraryService_GetLibraryStats(t *testing.T) {
 		repo := new(MockMovieRepository)
 		svc := NewLibraryService(repo, nil, config.LibraryConfig{}, nil)
 
-		repo.On("CountMovies", mock.Anything).Return(int64(42), nil)
+		repo.On("CountMovies", mock.Anything, mock.Anything).Return(int64(42), nil)
 
 		stats, err := svc.GetLibraryStats(context.Background())
 		require.NoError(t, err)
@@ -168,7 +312,7 @@ func TestLibraryService_GetLibraryStats(t *testing.T) {
 		repo := new(MockMovieRepository)
 		svc := NewLibraryService(repo, nil, config.LibraryConfig{}, nil)
 
-		repo.On("CountMovies", mock.Anything).Return(int64(0), assert.AnError)
+		repo.On("CountMovies", mock.Anything, mock.Anything).Return(int64(0), assert.AnError)
 
 		stats, err := svc.GetLibraryStats(context.Background())
 		assert.Error(t, err)
@@ -191,6 +335,11 @@ func TestLibraryService_RefreshMovie(t *testing.T) {
 	metadata.On("EnrichMovie", ctx, movie).Return(nil)
 	metadata.On("GetMovieCredits", ctx, movieID, "603").Return([]MovieCredit{}, nil)
 	metadata.On("GetMovieGenres", ctx, movieID, "603").Return([]MovieGenre{}, nil)
+	metadata.On("GetMovieTags", ctx, movieID, "603").Return([]MovieTag{
+		{MovieID: movieID, Slug: "simulated-reality", Name: "simulated reality", Spoiler: true},
+	}, nil)
+	repo.On("DeleteMovieTags", ctx, movieID).Return(nil)
+	repo.On("AddMovieTag", ctx, movieID, "simulated-reality", "simulated reality", true).Return(nil)
 	repo.On("UpdateMovie", ctx, mock.AnythingOfType("UpdateMovieParams")).Return(movie, nil)
 
 	err := svc.RefreshMovie(ctx, movieID)
@@ -199,3 +348,51 @@ func TestLibraryService_RefreshMovie(t *testing.T) {
 	repo.AssertExpectations(t)
 	metadata.AssertExpectations(t)
 }
+
+func TestLibraryService_ScanLibraryWithProgress(t *testing.T) {
+	tempDir := t.TempDir()
+	movieFile := filepath.Join(tempDir, "The Matrix (1999).mkv")
+	require.NoError(t, os.WriteFile(movieFile, []byte("dummy content"), 0644))
+
+	t.Run("Reports progress", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{}, new(MockProber))
+		ctx := context.Background()
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+		repo.On("SearchMoviesByTitle", ctx, "The Matrix", int32(10), int32(0)).Return([]Movie{}, nil)
+		metadata.On("SearchMovies", ctx, "The Matrix", new(1999)).Return([]*Movie{}, nil)
+
+		var updates []scanner.Progress
+		summary, err := svc.ScanLibraryWithProgress(ctx, []string{tempDir}, func(p scanner.Progress) {
+			updates = append(updates, p)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.UnmatchedFiles)
+		assert.Equal(t, []scanner.Progress{
+			{Phase: scanner.PhaseDiscovering},
+			{Phase: scanner.PhaseMatching, FilesTotal: 1, CurrentPath: movieFile},
+			{Phase: scanner.PhaseMatching, FilesTotal: 1, FilesProcessed: 1},
+		}, updates)
+	})
+
+	t.Run("Stops when cancelled", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		metadata := new(MockMetadataProvider)
+		svc := NewLibraryService(repo, metadata, config.LibraryConfig{}, new(MockProber))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		repo.On("GetMovieFileByPath", ctx, movieFile).Return(nil, ErrMovieFileNotFound)
+
+		_, err := svc.ScanLibraryWithProgress(ctx, []string{tempDir}, func(p scanner.Progress) {
+			if p.Phase == scanner.PhaseMatching {
+				cancel()
+			}
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+		metadata.AssertNotCalled(t, "SearchMovies", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
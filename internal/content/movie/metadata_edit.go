@@ -0,0 +1,166 @@
+package movie
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// MetadataEdit is a manual metadata correction. Nil fields are left
+// unchanged; every set field is locked against automatic updates.
+type MetadataEdit struct {
+	Title         *string
+	SortTitle     *string
+	OriginalTitle *string
+	Overview      *string
+	Tagline       *string
+	ReleaseDate   *time.Time
+	Genres        []string // Genre names; nil leaves genres unchanged
+	VoteAverage   *float64
+	PosterPath    *string
+	BackdropPath  *string
+}
+
+// updateParams converts the edit to update params and returns the fields it
+// touches.
+func (e MetadataEdit) updateParams(id uuid.UUID) (UpdateMovieParams, []string) {
+	params := UpdateMovieParams{ID: id}
+	var fields []string
+
+	if e.Title != nil {
+		params.Title = e.Title
+		fields = append(fields, content.FieldTitle)
+	}
+	if e.SortTitle != nil {
+		params.SortTitle = e.SortTitle
+		fields = append(fields, content.FieldSortTitle)
+	}
+	if e.OriginalTitle != nil {
+		params.OriginalTitle = e.OriginalTitle
+		fields = append(fields, content.FieldOriginalTitle)
+	}
+	if e.Overview != nil {
+		params.Overview = e.Overview
+		fields = append(fields, content.FieldOverview)
+	}
+	if e.Tagline != nil {
+		params.Tagline = e.Tagline
+		fields = append(fields, content.FieldTagline)
+	}
+	if e.ReleaseDate != nil {
+		d := e.ReleaseDate.Format("2006-01-02")
+		year := int32(e.ReleaseDate.Year()) //nolint:gosec // calendar years fit in int32
+		params.ReleaseDate = &d
+		params.Year = &year
+		fields = append(fields, content.FieldReleaseDate)
+	}
+	if e.Genres != nil {
+		fields = append(fields, content.FieldGenres)
+	}
+	if e.VoteAverage != nil {
+		v := strconv.FormatFloat(*e.VoteAverage, 'f', -1, 64)
+		params.VoteAverage = &v
+		fields = append(fields, content.FieldRatings)
+	}
+	if e.PosterPath != nil {
+		params.PosterPath = e.PosterPath
+		fields = append(fields, content.FieldPoster)
+	}
+	if e.BackdropPath != nil {
+		params.BackdropPath = e.BackdropPath
+		fields = append(fields, content.FieldBackdrop)
+	}
+	return params, fields
+}
+
+// ApplyLocks clears the params of locked fields so an update keeps the
+// stored values.
+func (p *UpdateMovieParams) ApplyLocks(locked content.LockedFields) {
+	if locked.Has(content.FieldTitle) {
+		p.Title = nil
+		p.TitlesI18n = nil
+	}
+	if locked.Has(content.FieldSortTitle) {
+		p.SortTitle = nil
+	}
+	if locked.Has(content.FieldOriginalTitle) {
+		p.OriginalTitle = nil
+	}
+	if locked.Has(content.FieldOverview) {
+		p.Overview = nil
+		p.OverviewsI18n = nil
+	}
+	if locked.Has(content.FieldTagline) {
+		p.Tagline = nil
+		p.TaglinesI18n = nil
+	}
+	if locked.Has(content.FieldReleaseDate) {
+		p.ReleaseDate = nil
+		p.Year = nil
+	}
+	if locked.Has(content.FieldRatings) {
+		p.VoteAverage = nil
+		p.VoteCount = nil
+		p.ExternalRatings = nil
+	}
+	if locked.Has(content.FieldPoster) {
+		p.PosterPath = nil
+	}
+	if locked.Has(content.FieldBackdrop) {
+		p.BackdropPath = nil
+	}
+	p.MetadataSources = locked.FilterSources(p.MetadataSources)
+}
+
+// UpdateMovieMetadata applies a manual metadata edit and locks the edited
+// fields.
+func (s *movieService) UpdateMovieMetadata(ctx context.Context, id uuid.UUID, edit MetadataEdit) (*Movie, error) {
+	mov, err := s.repo.GetMovie(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, fields := edit.updateParams(id)
+	if len(fields) == 0 {
+		return mov, nil
+	}
+
+	if _, err := s.repo.UpdateMovie(ctx, params); err != nil {
+		return nil, fmt.Errorf("update movie: %w", err)
+	}
+
+	if edit.Genres != nil {
+		if err := s.repo.DeleteMovieGenres(ctx, id); err != nil {
+			return nil, fmt.Errorf("clear genres: %w", err)
+		}
+		for _, name := range edit.Genres {
+			if err := s.repo.AddMovieGenre(ctx, id, util.Slugify(name), name); err != nil {
+				return nil, fmt.Errorf("add genre %q: %w", name, err)
+			}
+		}
+	}
+
+	return s.repo.SetMovieLockedFields(ctx, id, mov.LockedFields.With(fields...))
+}
+
+// UnlockMovieFields clears field locks so automatic updates may change the
+// fields again. The current values are kept until the next refresh.
+func (s *movieService) UnlockMovieFields(ctx context.Context, id uuid.UUID, fields ...string) (*Movie, error) {
+	for _, f := range fields {
+		if err := content.ValidateLockField(f); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, f)
+		}
+	}
+
+	mov, err := s.repo.GetMovie(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.SetMovieLockedFields(ctx, id, mov.LockedFields.Without(fields...))
+}
@@ -0,0 +1,103 @@
+package movie
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+func TestService_UpdateMovieMetadata(t *testing.T) {
+	t.Run("updates and locks edited fields", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		svc := NewService(repo, nil)
+		ctx := context.Background()
+		mov := newTestMovie()
+		mov.LockedFields = content.LockedFields{content.FieldPoster}
+		release := time.Date(1999, 10, 15, 0, 0, 0, 0, time.UTC)
+
+		repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+		repo.On("UpdateMovie", ctx, mock.MatchedBy(func(p UpdateMovieParams) bool {
+			return p.ID == mov.ID && *p.Title == "Fight Club (Director's Cut)" &&
+				*p.ReleaseDate == "1999-10-15" && *p.Year == 1999 && p.Overview == nil
+		})).Return(mov, nil)
+		repo.On("DeleteMovieGenres", ctx, mov.ID).Return(nil)
+		repo.On("AddMovieGenre", ctx, mov.ID, "science-fiction", "Science Fiction").Return(nil)
+		repo.On("SetMovieLockedFields", ctx, mov.ID, []string{"genres", "poster", "release_date", "title"}).Return(mov, nil)
+
+		_, err := svc.UpdateMovieMetadata(ctx, mov.ID, MetadataEdit{
+			Title:       new("Fight Club (Director's Cut)"),
+			ReleaseDate: &release,
+			Genres:      []string{"Science Fiction"},
+		})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("empty edit is a no-op", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		svc := NewService(repo, nil)
+		ctx := context.Background()
+		mov := newTestMovie()
+
+		repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+
+		result, err := svc.UpdateMovieMetadata(ctx, mov.ID, MetadataEdit{})
+		require.NoError(t, err)
+		assert.Equal(t, mov, result)
+		repo.AssertNotCalled(t, "UpdateMovie", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_UnlockMovieFields(t *testing.T) {
+	t.Run("removes locks", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		svc := NewService(repo, nil)
+		ctx := context.Background()
+		mov := newTestMovie()
+		mov.LockedFields = content.LockedFields{content.FieldOverview, content.FieldTitle}
+
+		repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+		repo.On("SetMovieLockedFields", ctx, mov.ID, []string{"overview"}).Return(mov, nil)
+
+		_, err := svc.UnlockMovieFields(ctx, mov.ID, content.FieldTitle)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown field", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		svc := NewService(repo, nil)
+
+		_, err := svc.UnlockMovieFields(context.Background(), uuid.Must(uuid.NewV7()), "budget")
+		assert.ErrorIs(t, err, content.ErrUnknownLockField)
+	})
+}
+
+func TestUpdateMovieParams_ApplyLocks(t *testing.T) {
+	params := UpdateMovieParams{
+		Title:        new("Provider Title"),
+		TitlesI18n:   map[string]string{"de": "Titel"},
+		Overview:     new("Provider overview"),
+		VoteAverage:  new("8.4"),
+		VoteCount:    new(int32(1000)),
+		PosterPath:   new("/poster.jpg"),
+		BackdropPath: new("/backdrop.jpg"),
+	}
+
+	params.ApplyLocks(content.LockedFields{content.FieldTitle, content.FieldRatings, content.FieldPoster})
+
+	assert.Nil(t, params.Title)
+	assert.Nil(t, params.TitlesI18n)
+	assert.Nil(t, params.VoteAverage)
+	assert.Nil(t, params.VoteCount)
+	assert.Nil(t, params.PosterPath)
+	assert.Equal(t, "Provider overview", *params.Overview)
+	assert.Equal(t, "/backdrop.jpg", *params.BackdropPath)
+}
@@ -22,5 +22,6 @@ type MetadataProvider interface {
 	EnrichMovie(ctx context.Context, mov *Movie, opts ...MetadataRefreshOptions) error
 	GetMovieCredits(ctx context.Context, movieID uuid.UUID, providerID string) ([]MovieCredit, error)
 	GetMovieGenres(ctx context.Context, movieID uuid.UUID, providerID string) ([]MovieGenre, error)
+	GetMovieTags(ctx context.Context, movieID uuid.UUID, providerID string) ([]MovieTag, error)
 	ClearCache()
 }
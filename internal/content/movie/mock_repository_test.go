@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	content "github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -74,6 +75,56 @@ func (_c *MockMovieRepository_AddMovieGenre_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// AddMovieTag provides a mock function with given fields: ctx, movieID, slug, name, spoiler
+func (_m *MockMovieRepository) AddMovieTag(ctx context.Context, movieID uuid.UUID, slug string, name string, spoiler bool) error {
+	ret := _m.Called(ctx, movieID, slug, name, spoiler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMovieTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool) error); ok {
+		r0 = rf(ctx, movieID, slug, name, spoiler)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMovieRepository_AddMovieTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMovieTag'
+type MockMovieRepository_AddMovieTag_Call struct {
+	*mock.Call
+}
+
+// AddMovieTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movieID uuid.UUID
+//   - slug string
+//   - name string
+//   - spoiler bool
+func (_e *MockMovieRepository_Expecter) AddMovieTag(ctx interface{}, movieID interface{}, slug interface{}, name interface{}, spoiler interface{}) *MockMovieRepository_AddMovieTag_Call {
+	return &MockMovieRepository_AddMovieTag_Call{Call: _e.mock.On("AddMovieTag", ctx, movieID, slug, name, spoiler)}
+}
+
+func (_c *MockMovieRepository_AddMovieTag_Call) Run(run func(ctx context.Context, movieID uuid.UUID, slug string, name string, spoiler bool)) *MockMovieRepository_AddMovieTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_AddMovieTag_Call) Return(_a0 error) *MockMovieRepository_AddMovieTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMovieRepository_AddMovieTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, bool) error) *MockMovieRepository_AddMovieTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddMovieToCollection provides a mock function with given fields: ctx, collectionID, movieID, collectionOrder
 func (_m *MockMovieRepository) AddMovieToCollection(ctx context.Context, collectionID uuid.UUID, movieID uuid.UUID, collectionOrder *int32) error {
 	ret := _m.Called(ctx, collectionID, movieID, collectionOrder)
@@ -123,9 +174,70 @@ func (_c *MockMovieRepository_AddMovieToCollection_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// CountMovies provides a mock function with given fields: ctx
-func (_m *MockMovieRepository) CountMovies(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// AssignMovieFileMatch provides a mock function with given fields: ctx, fileID, movieID, locked
+func (_m *MockMovieRepository) AssignMovieFileMatch(ctx context.Context, fileID uuid.UUID, movieID uuid.UUID, locked bool) (*MovieFile, error) {
+	ret := _m.Called(ctx, fileID, movieID, locked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignMovieFileMatch")
+	}
+
+	var r0 *MovieFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) (*MovieFile, error)); ok {
+		return rf(ctx, fileID, movieID, locked)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, bool) *MovieFile); ok {
+		r0 = rf(ctx, fileID, movieID, locked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MovieFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, fileID, movieID, locked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_AssignMovieFileMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignMovieFileMatch'
+type MockMovieRepository_AssignMovieFileMatch_Call struct {
+	*mock.Call
+}
+
+// AssignMovieFileMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileID uuid.UUID
+//   - movieID uuid.UUID
+//   - locked bool
+func (_e *MockMovieRepository_Expecter) AssignMovieFileMatch(ctx interface{}, fileID interface{}, movieID interface{}, locked interface{}) *MockMovieRepository_AssignMovieFileMatch_Call {
+	return &MockMovieRepository_AssignMovieFileMatch_Call{Call: _e.mock.On("AssignMovieFileMatch", ctx, fileID, movieID, locked)}
+}
+
+func (_c *MockMovieRepository_AssignMovieFileMatch_Call) Run(run func(ctx context.Context, fileID uuid.UUID, movieID uuid.UUID, locked bool)) *MockMovieRepository_AssignMovieFileMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_AssignMovieFileMatch_Call) Return(_a0 *MovieFile, _a1 error) *MockMovieRepository_AssignMovieFileMatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_AssignMovieFileMatch_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, bool) (*MovieFile, error)) *MockMovieRepository_AssignMovieFileMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountMovies provides a mock function with given fields: ctx, libraryPaths
+func (_m *MockMovieRepository) CountMovies(ctx context.Context, libraryPaths []string) (int64, error) {
+	ret := _m.Called(ctx, libraryPaths)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountMovies")
@@ -133,17 +245,17 @@ func (_m *MockMovieRepository) CountMovies(ctx context.Context) (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (int64, error)); ok {
+		return rf(ctx, libraryPaths)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) int64); ok {
+		r0 = rf(ctx, libraryPaths)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, libraryPaths)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,13 +270,14 @@ type MockMovieRepository_CountMovies_Call struct {
 
 // CountMovies is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockMovieRepository_Expecter) CountMovies(ctx interface{}) *MockMovieRepository_CountMovies_Call {
-	return &MockMovieRepository_CountMovies_Call{Call: _e.mock.On("CountMovies", ctx)}
+//   - libraryPaths []string
+func (_e *MockMovieRepository_Expecter) CountMovies(ctx interface{}, libraryPaths interface{}) *MockMovieRepository_CountMovies_Call {
+	return &MockMovieRepository_CountMovies_Call{Call: _e.mock.On("CountMovies", ctx, libraryPaths)}
 }
 
-func (_c *MockMovieRepository_CountMovies_Call) Run(run func(ctx context.Context)) *MockMovieRepository_CountMovies_Call {
+func (_c *MockMovieRepository_CountMovies_Call) Run(run func(ctx context.Context, libraryPaths []string)) *MockMovieRepository_CountMovies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
@@ -174,7 +287,7 @@ func (_c *MockMovieRepository_CountMovies_Call) Return(_a0 int64, _a1 error) *Mo
 	return _c
 }
 
-func (_c *MockMovieRepository_CountMovies_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockMovieRepository_CountMovies_Call {
+func (_c *MockMovieRepository_CountMovies_Call) RunAndReturn(run func(context.Context, []string) (int64, error)) *MockMovieRepository_CountMovies_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -293,9 +406,9 @@ func (_c *MockMovieRepository_CountMovieCrew_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// CountTopRated provides a mock function with given fields: ctx, minVotes
-func (_m *MockMovieRepository) CountTopRated(ctx context.Context, minVotes int32) (int64, error) {
-	ret := _m.Called(ctx, minVotes)
+// CountTopRated provides a mock function with given fields: ctx, minVotes, libraryPaths
+func (_m *MockMovieRepository) CountTopRated(ctx context.Context, minVotes int32, libraryPaths []string) (int64, error) {
+	ret := _m.Called(ctx, minVotes, libraryPaths)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountTopRated")
@@ -303,17 +416,17 @@ func (_m *MockMovieRepository) CountTopRated(ctx context.Context, minVotes int32
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (int64, error)); ok {
-		return rf(ctx, minVotes)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, []string) (int64, error)); ok {
+		return rf(ctx, minVotes, libraryPaths)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) int64); ok {
-		r0 = rf(ctx, minVotes)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, []string) int64); ok {
+		r0 = rf(ctx, minVotes, libraryPaths)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, minVotes)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, []string) error); ok {
+		r1 = rf(ctx, minVotes, libraryPaths)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -329,13 +442,14 @@ type MockMovieRepository_CountTopRated_Call struct {
 // CountTopRated is a helper method to define mock.On call
 //   - ctx context.Context
 //   - minVotes int32
-func (_e *MockMovieRepository_Expecter) CountTopRated(ctx interface{}, minVotes interface{}) *MockMovieRepository_CountTopRated_Call {
-	return &MockMovieRepository_CountTopRated_Call{Call: _e.mock.On("CountTopRated", ctx, minVotes)}
+//   - libraryPaths []string
+func (_e *MockMovieRepository_Expecter) CountTopRated(ctx interface{}, minVotes interface{}, libraryPaths interface{}) *MockMovieRepository_CountTopRated_Call {
+	return &MockMovieRepository_CountTopRated_Call{Call: _e.mock.On("CountTopRated", ctx, minVotes, libraryPaths)}
 }
 
-func (_c *MockMovieRepository_CountTopRated_Call) Run(run func(ctx context.Context, minVotes int32)) *MockMovieRepository_CountTopRated_Call {
+func (_c *MockMovieRepository_CountTopRated_Call) Run(run func(ctx context.Context, minVotes int32, libraryPaths []string)) *MockMovieRepository_CountTopRated_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(int32), args[2].([]string))
 	})
 	return _c
 }
@@ -345,7 +459,7 @@ func (_c *MockMovieRepository_CountTopRated_Call) Return(_a0 int64, _a1 error) *
 	return _c
 }
 
-func (_c *MockMovieRepository_CountTopRated_Call) RunAndReturn(run func(context.Context, int32) (int64, error)) *MockMovieRepository_CountTopRated_Call {
+func (_c *MockMovieRepository_CountTopRated_Call) RunAndReturn(run func(context.Context, int32, []string) (int64, error)) *MockMovieRepository_CountTopRated_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -833,6 +947,53 @@ func (_c *MockMovieRepository_DeleteMovieGenres_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// DeleteMovieTags provides a mock function with given fields: ctx, movieID
+func (_m *MockMovieRepository) DeleteMovieTags(ctx context.Context, movieID uuid.UUID) error {
+	ret := _m.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMovieTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, movieID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMovieRepository_DeleteMovieTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMovieTags'
+type MockMovieRepository_DeleteMovieTags_Call struct {
+	*mock.Call
+}
+
+// DeleteMovieTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movieID uuid.UUID
+func (_e *MockMovieRepository_Expecter) DeleteMovieTags(ctx interface{}, movieID interface{}) *MockMovieRepository_DeleteMovieTags_Call {
+	return &MockMovieRepository_DeleteMovieTags_Call{Call: _e.mock.On("DeleteMovieTags", ctx, movieID)}
+}
+
+func (_c *MockMovieRepository_DeleteMovieTags_Call) Run(run func(ctx context.Context, movieID uuid.UUID)) *MockMovieRepository_DeleteMovieTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_DeleteMovieTags_Call) Return(_a0 error) *MockMovieRepository_DeleteMovieTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMovieRepository_DeleteMovieTags_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockMovieRepository_DeleteMovieTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteWatchProgress provides a mock function with given fields: ctx, userID, movieID
 func (_m *MockMovieRepository) DeleteWatchProgress(ctx context.Context, userID uuid.UUID, movieID uuid.UUID) error {
 	ret := _m.Called(ctx, userID, movieID)
@@ -1294,6 +1455,65 @@ func (_c *MockMovieRepository_GetMovieCollectionByTMDbID_Call) RunAndReturn(run
 	return _c
 }
 
+// GetMovieExtra provides a mock function with given fields: ctx, id
+func (_m *MockMovieRepository) GetMovieExtra(ctx context.Context, id uuid.UUID) (*MovieExtra, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMovieExtra")
+	}
+
+	var r0 *MovieExtra
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*MovieExtra, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *MovieExtra); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MovieExtra)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_GetMovieExtra_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMovieExtra'
+type MockMovieRepository_GetMovieExtra_Call struct {
+	*mock.Call
+}
+
+// GetMovieExtra is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMovieRepository_Expecter) GetMovieExtra(ctx interface{}, id interface{}) *MockMovieRepository_GetMovieExtra_Call {
+	return &MockMovieRepository_GetMovieExtra_Call{Call: _e.mock.On("GetMovieExtra", ctx, id)}
+}
+
+func (_c *MockMovieRepository_GetMovieExtra_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMovieRepository_GetMovieExtra_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_GetMovieExtra_Call) Return(_a0 *MovieExtra, _a1 error) *MockMovieRepository_GetMovieExtra_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_GetMovieExtra_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*MovieExtra, error)) *MockMovieRepository_GetMovieExtra_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMovieFile provides a mock function with given fields: ctx, id
 func (_m *MockMovieRepository) GetMovieFile(ctx context.Context, id uuid.UUID) (*MovieFile, error) {
 	ret := _m.Called(ctx, id)
@@ -1590,9 +1810,9 @@ func (_c *MockMovieRepository_GetWatchProgress_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// ListContinueWatching provides a mock function with given fields: ctx, userID, limit
-func (_m *MockMovieRepository) ListContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
-	ret := _m.Called(ctx, userID, limit)
+// ListContinueWatching provides a mock function with given fields: ctx, userID, libraryPaths, limit
+func (_m *MockMovieRepository) ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	ret := _m.Called(ctx, userID, libraryPaths, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListContinueWatching")
@@ -1600,19 +1820,19 @@ func (_m *MockMovieRepository) ListContinueWatching(ctx context.Context, userID
 
 	var r0 []ContinueWatchingItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) ([]ContinueWatchingItem, error)); ok {
-		return rf(ctx, userID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string, int32) ([]ContinueWatchingItem, error)); ok {
+		return rf(ctx, userID, libraryPaths, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) []ContinueWatchingItem); ok {
-		r0 = rf(ctx, userID, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string, int32) []ContinueWatchingItem); ok {
+		r0 = rf(ctx, userID, libraryPaths, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]ContinueWatchingItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
-		r1 = rf(ctx, userID, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string, int32) error); ok {
+		r1 = rf(ctx, userID, libraryPaths, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1628,14 +1848,15 @@ type MockMovieRepository_ListContinueWatching_Call struct {
 // ListContinueWatching is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID uuid.UUID
+//   - libraryPaths []string
 //   - limit int32
-func (_e *MockMovieRepository_Expecter) ListContinueWatching(ctx interface{}, userID interface{}, limit interface{}) *MockMovieRepository_ListContinueWatching_Call {
-	return &MockMovieRepository_ListContinueWatching_Call{Call: _e.mock.On("ListContinueWatching", ctx, userID, limit)}
+func (_e *MockMovieRepository_Expecter) ListContinueWatching(ctx interface{}, userID interface{}, libraryPaths interface{}, limit interface{}) *MockMovieRepository_ListContinueWatching_Call {
+	return &MockMovieRepository_ListContinueWatching_Call{Call: _e.mock.On("ListContinueWatching", ctx, userID, libraryPaths, limit)}
 }
 
-func (_c *MockMovieRepository_ListContinueWatching_Call) Run(run func(ctx context.Context, userID uuid.UUID, limit int32)) *MockMovieRepository_ListContinueWatching_Call {
+func (_c *MockMovieRepository_ListContinueWatching_Call) Run(run func(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32)) *MockMovieRepository_ListContinueWatching_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int32))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]string), args[3].(int32))
 	})
 	return _c
 }
@@ -1645,7 +1866,66 @@ func (_c *MockMovieRepository_ListContinueWatching_Call) Return(_a0 []ContinueWa
 	return _c
 }
 
-func (_c *MockMovieRepository_ListContinueWatching_Call) RunAndReturn(run func(context.Context, uuid.UUID, int32) ([]ContinueWatchingItem, error)) *MockMovieRepository_ListContinueWatching_Call {
+func (_c *MockMovieRepository_ListContinueWatching_Call) RunAndReturn(run func(context.Context, uuid.UUID, []string, int32) ([]ContinueWatchingItem, error)) *MockMovieRepository_ListContinueWatching_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDistinctMovieTags provides a mock function with given fields: ctx, limit
+func (_m *MockMovieRepository) ListDistinctMovieTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDistinctMovieTags")
+	}
+
+	var r0 []content.TagSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]content.TagSummary, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []content.TagSummary); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]content.TagSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_ListDistinctMovieTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDistinctMovieTags'
+type MockMovieRepository_ListDistinctMovieTags_Call struct {
+	*mock.Call
+}
+
+// ListDistinctMovieTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int32
+func (_e *MockMovieRepository_Expecter) ListDistinctMovieTags(ctx interface{}, limit interface{}) *MockMovieRepository_ListDistinctMovieTags_Call {
+	return &MockMovieRepository_ListDistinctMovieTags_Call{Call: _e.mock.On("ListDistinctMovieTags", ctx, limit)}
+}
+
+func (_c *MockMovieRepository_ListDistinctMovieTags_Call) Run(run func(ctx context.Context, limit int32)) *MockMovieRepository_ListDistinctMovieTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_ListDistinctMovieTags_Call) Return(_a0 []content.TagSummary, _a1 error) *MockMovieRepository_ListDistinctMovieTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_ListDistinctMovieTags_Call) RunAndReturn(run func(context.Context, int32) ([]content.TagSummary, error)) *MockMovieRepository_ListDistinctMovieTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1772,6 +2052,65 @@ func (_c *MockMovieRepository_ListMovieCrew_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ListMovieExtras provides a mock function with given fields: ctx, movieID
+func (_m *MockMovieRepository) ListMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieExtra, error) {
+	ret := _m.Called(ctx, movieID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMovieExtras")
+	}
+
+	var r0 []MovieExtra
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]MovieExtra, error)); ok {
+		return rf(ctx, movieID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []MovieExtra); ok {
+		r0 = rf(ctx, movieID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MovieExtra)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, movieID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_ListMovieExtras_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMovieExtras'
+type MockMovieRepository_ListMovieExtras_Call struct {
+	*mock.Call
+}
+
+// ListMovieExtras is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movieID uuid.UUID
+func (_e *MockMovieRepository_Expecter) ListMovieExtras(ctx interface{}, movieID interface{}) *MockMovieRepository_ListMovieExtras_Call {
+	return &MockMovieRepository_ListMovieExtras_Call{Call: _e.mock.On("ListMovieExtras", ctx, movieID)}
+}
+
+func (_c *MockMovieRepository_ListMovieExtras_Call) Run(run func(ctx context.Context, movieID uuid.UUID)) *MockMovieRepository_ListMovieExtras_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMovieExtras_Call) Return(_a0 []MovieExtra, _a1 error) *MockMovieRepository_ListMovieExtras_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMovieExtras_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]MovieExtra, error)) *MockMovieRepository_ListMovieExtras_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListMovieFilesByMovieID provides a mock function with given fields: ctx, movieID
 func (_m *MockMovieRepository) ListMovieFilesByMovieID(ctx context.Context, movieID uuid.UUID) ([]MovieFile, error) {
 	ret := _m.Called(ctx, movieID)
@@ -1948,18 +2287,77 @@ func (_c *MockMovieRepository_ListDistinctMovieGenres_Call) RunAndReturn(run fun
 	return _c
 }
 
-// ListMovies provides a mock function with given fields: ctx, filters
-func (_m *MockMovieRepository) ListMovies(ctx context.Context, filters ListFilters) ([]Movie, error) {
-	ret := _m.Called(ctx, filters)
+// ListMovieTags provides a mock function with given fields: ctx, movieID
+func (_m *MockMovieRepository) ListMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error) {
+	ret := _m.Called(ctx, movieID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListMovies")
+		panic("no return value specified for ListMovieTags")
 	}
 
-	var r0 []Movie
+	var r0 []MovieTag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ListFilters) ([]Movie, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]MovieTag, error)); ok {
+		return rf(ctx, movieID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []MovieTag); ok {
+		r0 = rf(ctx, movieID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]MovieTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, movieID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_ListMovieTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMovieTags'
+type MockMovieRepository_ListMovieTags_Call struct {
+	*mock.Call
+}
+
+// ListMovieTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movieID uuid.UUID
+func (_e *MockMovieRepository_Expecter) ListMovieTags(ctx interface{}, movieID interface{}) *MockMovieRepository_ListMovieTags_Call {
+	return &MockMovieRepository_ListMovieTags_Call{Call: _e.mock.On("ListMovieTags", ctx, movieID)}
+}
+
+func (_c *MockMovieRepository_ListMovieTags_Call) Run(run func(ctx context.Context, movieID uuid.UUID)) *MockMovieRepository_ListMovieTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMovieTags_Call) Return(_a0 []MovieTag, _a1 error) *MockMovieRepository_ListMovieTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMovieTags_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]MovieTag, error)) *MockMovieRepository_ListMovieTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMovies provides a mock function with given fields: ctx, filters
+func (_m *MockMovieRepository) ListMovies(ctx context.Context, filters ListFilters) ([]Movie, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMovies")
+	}
+
+	var r0 []Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ListFilters) ([]Movie, error)); ok {
+		return rf(ctx, filters)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, ListFilters) []Movie); ok {
 		r0 = rf(ctx, filters)
@@ -2066,14 +2464,76 @@ func (_c *MockMovieRepository_ListMoviesByCollection_Call) RunAndReturn(run func
 	return _c
 }
 
-// ListMoviesByGenre provides a mock function with given fields: ctx, slug, limit, offset
-func (_m *MockMovieRepository) ListMoviesByGenre(ctx context.Context, slug string, limit int32, offset int32) ([]Movie, error) {
-	ret := _m.Called(ctx, slug, limit, offset)
+// ListMoviesByGenre provides a mock function with given fields: ctx, slug, libraryPaths, limit, offset
+func (_m *MockMovieRepository) ListMoviesByGenre(ctx context.Context, slug string, libraryPaths []string, limit int32, offset int32) ([]Movie, error) {
+	ret := _m.Called(ctx, slug, libraryPaths, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListMoviesByGenre")
 	}
 
+	var r0 []Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int32, int32) ([]Movie, error)); ok {
+		return rf(ctx, slug, libraryPaths, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int32, int32) []Movie); ok {
+		r0 = rf(ctx, slug, libraryPaths, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int32, int32) error); ok {
+		r1 = rf(ctx, slug, libraryPaths, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_ListMoviesByGenre_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMoviesByGenre'
+type MockMovieRepository_ListMoviesByGenre_Call struct {
+	*mock.Call
+}
+
+// ListMoviesByGenre is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - libraryPaths []string
+//   - limit int32
+//   - offset int32
+func (_e *MockMovieRepository_Expecter) ListMoviesByGenre(ctx interface{}, slug interface{}, libraryPaths interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListMoviesByGenre_Call {
+	return &MockMovieRepository_ListMoviesByGenre_Call{Call: _e.mock.On("ListMoviesByGenre", ctx, slug, libraryPaths, limit, offset)}
+}
+
+func (_c *MockMovieRepository_ListMoviesByGenre_Call) Run(run func(ctx context.Context, slug string, libraryPaths []string, limit int32, offset int32)) *MockMovieRepository_ListMoviesByGenre_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMoviesByGenre_Call) Return(_a0 []Movie, _a1 error) *MockMovieRepository_ListMoviesByGenre_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMoviesByGenre_Call) RunAndReturn(run func(context.Context, string, []string, int32, int32) ([]Movie, error)) *MockMovieRepository_ListMoviesByGenre_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMoviesByTag provides a mock function with given fields: ctx, slug, limit, offset
+func (_m *MockMovieRepository) ListMoviesByTag(ctx context.Context, slug string, limit int32, offset int32) ([]Movie, error) {
+	ret := _m.Called(ctx, slug, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMoviesByTag")
+	}
+
 	var r0 []Movie
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]Movie, error)); ok {
@@ -2096,33 +2556,33 @@ func (_m *MockMovieRepository) ListMoviesByGenre(ctx context.Context, slug strin
 	return r0, r1
 }
 
-// MockMovieRepository_ListMoviesByGenre_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMoviesByGenre'
-type MockMovieRepository_ListMoviesByGenre_Call struct {
+// MockMovieRepository_ListMoviesByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMoviesByTag'
+type MockMovieRepository_ListMoviesByTag_Call struct {
 	*mock.Call
 }
 
-// ListMoviesByGenre is a helper method to define mock.On call
+// ListMoviesByTag is a helper method to define mock.On call
 //   - ctx context.Context
 //   - slug string
 //   - limit int32
 //   - offset int32
-func (_e *MockMovieRepository_Expecter) ListMoviesByGenre(ctx interface{}, slug interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListMoviesByGenre_Call {
-	return &MockMovieRepository_ListMoviesByGenre_Call{Call: _e.mock.On("ListMoviesByGenre", ctx, slug, limit, offset)}
+func (_e *MockMovieRepository_Expecter) ListMoviesByTag(ctx interface{}, slug interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListMoviesByTag_Call {
+	return &MockMovieRepository_ListMoviesByTag_Call{Call: _e.mock.On("ListMoviesByTag", ctx, slug, limit, offset)}
 }
 
-func (_c *MockMovieRepository_ListMoviesByGenre_Call) Run(run func(ctx context.Context, slug string, limit int32, offset int32)) *MockMovieRepository_ListMoviesByGenre_Call {
+func (_c *MockMovieRepository_ListMoviesByTag_Call) Run(run func(ctx context.Context, slug string, limit int32, offset int32)) *MockMovieRepository_ListMoviesByTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
 	})
 	return _c
 }
 
-func (_c *MockMovieRepository_ListMoviesByGenre_Call) Return(_a0 []Movie, _a1 error) *MockMovieRepository_ListMoviesByGenre_Call {
+func (_c *MockMovieRepository_ListMoviesByTag_Call) Return(_a0 []Movie, _a1 error) *MockMovieRepository_ListMoviesByTag_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockMovieRepository_ListMoviesByGenre_Call) RunAndReturn(run func(context.Context, int32, int32, int32) ([]Movie, error)) *MockMovieRepository_ListMoviesByGenre_Call {
+func (_c *MockMovieRepository_ListMoviesByTag_Call) RunAndReturn(run func(context.Context, string, int32, int32) ([]Movie, error)) *MockMovieRepository_ListMoviesByTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2188,9 +2648,129 @@ func (_c *MockMovieRepository_ListMoviesByYear_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// ListRecentlyAdded provides a mock function with given fields: ctx, limit, offset
-func (_m *MockMovieRepository) ListRecentlyAdded(ctx context.Context, limit int32, offset int32) ([]Movie, error) {
-	ret := _m.Called(ctx, limit, offset)
+// ListMoviesDueForRefresh provides a mock function with given fields: ctx, cutoffs, limit
+func (_m *MockMovieRepository) ListMoviesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Movie, error) {
+	ret := _m.Called(ctx, cutoffs, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMoviesDueForRefresh")
+	}
+
+	var r0 []Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, content.RefreshCutoffs, int32) ([]Movie, error)); ok {
+		return rf(ctx, cutoffs, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, content.RefreshCutoffs, int32) []Movie); ok {
+		r0 = rf(ctx, cutoffs, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, content.RefreshCutoffs, int32) error); ok {
+		r1 = rf(ctx, cutoffs, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_ListMoviesDueForRefresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMoviesDueForRefresh'
+type MockMovieRepository_ListMoviesDueForRefresh_Call struct {
+	*mock.Call
+}
+
+// ListMoviesDueForRefresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cutoffs content.RefreshCutoffs
+//   - limit int32
+func (_e *MockMovieRepository_Expecter) ListMoviesDueForRefresh(ctx interface{}, cutoffs interface{}, limit interface{}) *MockMovieRepository_ListMoviesDueForRefresh_Call {
+	return &MockMovieRepository_ListMoviesDueForRefresh_Call{Call: _e.mock.On("ListMoviesDueForRefresh", ctx, cutoffs, limit)}
+}
+
+func (_c *MockMovieRepository_ListMoviesDueForRefresh_Call) Run(run func(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32)) *MockMovieRepository_ListMoviesDueForRefresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(content.RefreshCutoffs), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMoviesDueForRefresh_Call) Return(_a0 []Movie, _a1 error) *MockMovieRepository_ListMoviesDueForRefresh_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMoviesDueForRefresh_Call) RunAndReturn(run func(context.Context, content.RefreshCutoffs, int32) ([]Movie, error)) *MockMovieRepository_ListMoviesDueForRefresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMoviesPendingTagImport provides a mock function with given fields: ctx, after, limit
+func (_m *MockMovieRepository) ListMoviesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Movie, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMoviesPendingTagImport")
+	}
+
+	var r0 []Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) ([]Movie, error)); ok {
+		return rf(ctx, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) []Movie); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_ListMoviesPendingTagImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMoviesPendingTagImport'
+type MockMovieRepository_ListMoviesPendingTagImport_Call struct {
+	*mock.Call
+}
+
+// ListMoviesPendingTagImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after uuid.UUID
+//   - limit int32
+func (_e *MockMovieRepository_Expecter) ListMoviesPendingTagImport(ctx interface{}, after interface{}, limit interface{}) *MockMovieRepository_ListMoviesPendingTagImport_Call {
+	return &MockMovieRepository_ListMoviesPendingTagImport_Call{Call: _e.mock.On("ListMoviesPendingTagImport", ctx, after, limit)}
+}
+
+func (_c *MockMovieRepository_ListMoviesPendingTagImport_Call) Run(run func(ctx context.Context, after uuid.UUID, limit int32)) *MockMovieRepository_ListMoviesPendingTagImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMoviesPendingTagImport_Call) Return(_a0 []Movie, _a1 error) *MockMovieRepository_ListMoviesPendingTagImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_ListMoviesPendingTagImport_Call) RunAndReturn(run func(context.Context, uuid.UUID, int32) ([]Movie, error)) *MockMovieRepository_ListMoviesPendingTagImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRecentlyAdded provides a mock function with given fields: ctx, libraryPaths, limit, offset
+func (_m *MockMovieRepository) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit int32, offset int32) ([]Movie, error) {
+	ret := _m.Called(ctx, libraryPaths, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListRecentlyAdded")
@@ -2198,19 +2778,19 @@ func (_m *MockMovieRepository) ListRecentlyAdded(ctx context.Context, limit int3
 
 	var r0 []Movie
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) ([]Movie, error)); ok {
-		return rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int32, int32) ([]Movie, error)); ok {
+		return rf(ctx, libraryPaths, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) []Movie); ok {
-		r0 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int32, int32) []Movie); ok {
+		r0 = rf(ctx, libraryPaths, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]Movie)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
-		r1 = rf(ctx, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int32, int32) error); ok {
+		r1 = rf(ctx, libraryPaths, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2225,15 +2805,16 @@ type MockMovieRepository_ListRecentlyAdded_Call struct {
 
 // ListRecentlyAdded is a helper method to define mock.On call
 //   - ctx context.Context
+//   - libraryPaths []string
 //   - limit int32
 //   - offset int32
-func (_e *MockMovieRepository_Expecter) ListRecentlyAdded(ctx interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListRecentlyAdded_Call {
-	return &MockMovieRepository_ListRecentlyAdded_Call{Call: _e.mock.On("ListRecentlyAdded", ctx, limit, offset)}
+func (_e *MockMovieRepository_Expecter) ListRecentlyAdded(ctx interface{}, libraryPaths interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListRecentlyAdded_Call {
+	return &MockMovieRepository_ListRecentlyAdded_Call{Call: _e.mock.On("ListRecentlyAdded", ctx, libraryPaths, limit, offset)}
 }
 
-func (_c *MockMovieRepository_ListRecentlyAdded_Call) Run(run func(ctx context.Context, limit int32, offset int32)) *MockMovieRepository_ListRecentlyAdded_Call {
+func (_c *MockMovieRepository_ListRecentlyAdded_Call) Run(run func(ctx context.Context, libraryPaths []string, limit int32, offset int32)) *MockMovieRepository_ListRecentlyAdded_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32), args[2].(int32))
+		run(args[0].(context.Context), args[1].([]string), args[2].(int32), args[3].(int32))
 	})
 	return _c
 }
@@ -2243,14 +2824,14 @@ func (_c *MockMovieRepository_ListRecentlyAdded_Call) Return(_a0 []Movie, _a1 er
 	return _c
 }
 
-func (_c *MockMovieRepository_ListRecentlyAdded_Call) RunAndReturn(run func(context.Context, int32, int32) ([]Movie, error)) *MockMovieRepository_ListRecentlyAdded_Call {
+func (_c *MockMovieRepository_ListRecentlyAdded_Call) RunAndReturn(run func(context.Context, []string, int32, int32) ([]Movie, error)) *MockMovieRepository_ListRecentlyAdded_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTopRated provides a mock function with given fields: ctx, minVotes, limit, offset
-func (_m *MockMovieRepository) ListTopRated(ctx context.Context, minVotes int32, limit int32, offset int32) ([]Movie, error) {
-	ret := _m.Called(ctx, minVotes, limit, offset)
+// ListTopRated provides a mock function with given fields: ctx, minVotes, libraryPaths, limit, offset
+func (_m *MockMovieRepository) ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit int32, offset int32) ([]Movie, error) {
+	ret := _m.Called(ctx, minVotes, libraryPaths, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListTopRated")
@@ -2258,19 +2839,19 @@ func (_m *MockMovieRepository) ListTopRated(ctx context.Context, minVotes int32,
 
 	var r0 []Movie
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, int32) ([]Movie, error)); ok {
-		return rf(ctx, minVotes, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, []string, int32, int32) ([]Movie, error)); ok {
+		return rf(ctx, minVotes, libraryPaths, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, int32) []Movie); ok {
-		r0 = rf(ctx, minVotes, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, []string, int32, int32) []Movie); ok {
+		r0 = rf(ctx, minVotes, libraryPaths, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]Movie)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, int32) error); ok {
-		r1 = rf(ctx, minVotes, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, []string, int32, int32) error); ok {
+		r1 = rf(ctx, minVotes, libraryPaths, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2286,15 +2867,16 @@ type MockMovieRepository_ListTopRated_Call struct {
 // ListTopRated is a helper method to define mock.On call
 //   - ctx context.Context
 //   - minVotes int32
+//   - libraryPaths []string
 //   - limit int32
 //   - offset int32
-func (_e *MockMovieRepository_Expecter) ListTopRated(ctx interface{}, minVotes interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListTopRated_Call {
-	return &MockMovieRepository_ListTopRated_Call{Call: _e.mock.On("ListTopRated", ctx, minVotes, limit, offset)}
+func (_e *MockMovieRepository_Expecter) ListTopRated(ctx interface{}, minVotes interface{}, libraryPaths interface{}, limit interface{}, offset interface{}) *MockMovieRepository_ListTopRated_Call {
+	return &MockMovieRepository_ListTopRated_Call{Call: _e.mock.On("ListTopRated", ctx, minVotes, libraryPaths, limit, offset)}
 }
 
-func (_c *MockMovieRepository_ListTopRated_Call) Run(run func(ctx context.Context, minVotes int32, limit int32, offset int32)) *MockMovieRepository_ListTopRated_Call {
+func (_c *MockMovieRepository_ListTopRated_Call) Run(run func(ctx context.Context, minVotes int32, libraryPaths []string, limit int32, offset int32)) *MockMovieRepository_ListTopRated_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(int32), args[2].([]string), args[3].(int32), args[4].(int32))
 	})
 	return _c
 }
@@ -2304,7 +2886,7 @@ func (_c *MockMovieRepository_ListTopRated_Call) Return(_a0 []Movie, _a1 error)
 	return _c
 }
 
-func (_c *MockMovieRepository_ListTopRated_Call) RunAndReturn(run func(context.Context, int32, int32, int32) ([]Movie, error)) *MockMovieRepository_ListTopRated_Call {
+func (_c *MockMovieRepository_ListTopRated_Call) RunAndReturn(run func(context.Context, int32, []string, int32, int32) ([]Movie, error)) *MockMovieRepository_ListTopRated_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2540,6 +3122,222 @@ func (_c *MockMovieRepository_SearchMoviesByTitleAnyLanguage_Call) RunAndReturn(
 	return _c
 }
 
+// SetMovieFileMatchLocked provides a mock function with given fields: ctx, fileID, locked
+func (_m *MockMovieRepository) SetMovieFileMatchLocked(ctx context.Context, fileID uuid.UUID, locked bool) (*MovieFile, error) {
+	ret := _m.Called(ctx, fileID, locked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMovieFileMatchLocked")
+	}
+
+	var r0 *MovieFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) (*MovieFile, error)); ok {
+		return rf(ctx, fileID, locked)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool) *MovieFile); ok {
+		r0 = rf(ctx, fileID, locked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MovieFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, fileID, locked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_SetMovieFileMatchLocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMovieFileMatchLocked'
+type MockMovieRepository_SetMovieFileMatchLocked_Call struct {
+	*mock.Call
+}
+
+// SetMovieFileMatchLocked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileID uuid.UUID
+//   - locked bool
+func (_e *MockMovieRepository_Expecter) SetMovieFileMatchLocked(ctx interface{}, fileID interface{}, locked interface{}) *MockMovieRepository_SetMovieFileMatchLocked_Call {
+	return &MockMovieRepository_SetMovieFileMatchLocked_Call{Call: _e.mock.On("SetMovieFileMatchLocked", ctx, fileID, locked)}
+}
+
+func (_c *MockMovieRepository_SetMovieFileMatchLocked_Call) Run(run func(ctx context.Context, fileID uuid.UUID, locked bool)) *MockMovieRepository_SetMovieFileMatchLocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieFileMatchLocked_Call) Return(_a0 *MovieFile, _a1 error) *MockMovieRepository_SetMovieFileMatchLocked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieFileMatchLocked_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool) (*MovieFile, error)) *MockMovieRepository_SetMovieFileMatchLocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMovieImagePlaceholder provides a mock function with given fields: ctx, id, path, placeholder
+func (_m *MockMovieRepository) SetMovieImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	ret := _m.Called(ctx, id, path, placeholder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMovieImagePlaceholder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, blurhash.Placeholder) error); ok {
+		r0 = rf(ctx, id, path, placeholder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMovieRepository_SetMovieImagePlaceholder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMovieImagePlaceholder'
+type MockMovieRepository_SetMovieImagePlaceholder_Call struct {
+	*mock.Call
+}
+
+// SetMovieImagePlaceholder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - path string
+//   - placeholder blurhash.Placeholder
+func (_e *MockMovieRepository_Expecter) SetMovieImagePlaceholder(ctx interface{}, id interface{}, path interface{}, placeholder interface{}) *MockMovieRepository_SetMovieImagePlaceholder_Call {
+	return &MockMovieRepository_SetMovieImagePlaceholder_Call{Call: _e.mock.On("SetMovieImagePlaceholder", ctx, id, path, placeholder)}
+}
+
+func (_c *MockMovieRepository_SetMovieImagePlaceholder_Call) Run(run func(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder)) *MockMovieRepository_SetMovieImagePlaceholder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(blurhash.Placeholder))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieImagePlaceholder_Call) Return(_a0 error) *MockMovieRepository_SetMovieImagePlaceholder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieImagePlaceholder_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, blurhash.Placeholder) error) *MockMovieRepository_SetMovieImagePlaceholder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMovieLockedFields provides a mock function with given fields: ctx, id, fields
+func (_m *MockMovieRepository) SetMovieLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Movie, error) {
+	ret := _m.Called(ctx, id, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMovieLockedFields")
+	}
+
+	var r0 *Movie
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) (*Movie, error)); ok {
+		return rf(ctx, id, fields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []string) *Movie); ok {
+		r0 = rf(ctx, id, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Movie)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, id, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_SetMovieLockedFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMovieLockedFields'
+type MockMovieRepository_SetMovieLockedFields_Call struct {
+	*mock.Call
+}
+
+// SetMovieLockedFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - fields []string
+func (_e *MockMovieRepository_Expecter) SetMovieLockedFields(ctx interface{}, id interface{}, fields interface{}) *MockMovieRepository_SetMovieLockedFields_Call {
+	return &MockMovieRepository_SetMovieLockedFields_Call{Call: _e.mock.On("SetMovieLockedFields", ctx, id, fields)}
+}
+
+func (_c *MockMovieRepository_SetMovieLockedFields_Call) Run(run func(ctx context.Context, id uuid.UUID, fields []string)) *MockMovieRepository_SetMovieLockedFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieLockedFields_Call) Return(_a0 *Movie, _a1 error) *MockMovieRepository_SetMovieLockedFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieLockedFields_Call) RunAndReturn(run func(context.Context, uuid.UUID, []string) (*Movie, error)) *MockMovieRepository_SetMovieLockedFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMovieTagsImported provides a mock function with given fields: ctx, id
+func (_m *MockMovieRepository) SetMovieTagsImported(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMovieTagsImported")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMovieRepository_SetMovieTagsImported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMovieTagsImported'
+type MockMovieRepository_SetMovieTagsImported_Call struct {
+	*mock.Call
+}
+
+// SetMovieTagsImported is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockMovieRepository_Expecter) SetMovieTagsImported(ctx interface{}, id interface{}) *MockMovieRepository_SetMovieTagsImported_Call {
+	return &MockMovieRepository_SetMovieTagsImported_Call{Call: _e.mock.On("SetMovieTagsImported", ctx, id)}
+}
+
+func (_c *MockMovieRepository_SetMovieTagsImported_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockMovieRepository_SetMovieTagsImported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieTagsImported_Call) Return(_a0 error) *MockMovieRepository_SetMovieTagsImported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMovieRepository_SetMovieTagsImported_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockMovieRepository_SetMovieTagsImported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateMovie provides a mock function with given fields: ctx, params
 func (_m *MockMovieRepository) UpdateMovie(ctx context.Context, params UpdateMovieParams) (*Movie, error) {
 	ret := _m.Called(ctx, params)
@@ -2717,6 +3515,65 @@ func (_c *MockMovieRepository_UpdateMovieFile_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// UpsertMovieExtra provides a mock function with given fields: ctx, params
+func (_m *MockMovieRepository) UpsertMovieExtra(ctx context.Context, params UpsertMovieExtraParams) (*MovieExtra, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertMovieExtra")
+	}
+
+	var r0 *MovieExtra
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertMovieExtraParams) (*MovieExtra, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertMovieExtraParams) *MovieExtra); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MovieExtra)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpsertMovieExtraParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMovieRepository_UpsertMovieExtra_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertMovieExtra'
+type MockMovieRepository_UpsertMovieExtra_Call struct {
+	*mock.Call
+}
+
+// UpsertMovieExtra is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params UpsertMovieExtraParams
+func (_e *MockMovieRepository_Expecter) UpsertMovieExtra(ctx interface{}, params interface{}) *MockMovieRepository_UpsertMovieExtra_Call {
+	return &MockMovieRepository_UpsertMovieExtra_Call{Call: _e.mock.On("UpsertMovieExtra", ctx, params)}
+}
+
+func (_c *MockMovieRepository_UpsertMovieExtra_Call) Run(run func(ctx context.Context, params UpsertMovieExtraParams)) *MockMovieRepository_UpsertMovieExtra_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpsertMovieExtraParams))
+	})
+	return _c
+}
+
+func (_c *MockMovieRepository_UpsertMovieExtra_Call) Return(_a0 *MovieExtra, _a1 error) *MockMovieRepository_UpsertMovieExtra_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMovieRepository_UpsertMovieExtra_Call) RunAndReturn(run func(context.Context, UpsertMovieExtraParams) (*MovieExtra, error)) *MockMovieRepository_UpsertMovieExtra_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockMovieRepository creates a new instance of MockMovieRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMovieRepository(t interface {
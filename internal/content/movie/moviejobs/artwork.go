@@ -0,0 +1,82 @@
+package moviejobs
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+// syncLocalArtwork records the artwork found next to matched movie files.
+// When local artwork is preferred, the movies' poster and backdrop are
+// pointed at it.
+func syncLocalArtwork(ctx context.Context, artworkService *artwork.Service, libraryService *movie.LibraryService, matches []movie.FileMatch, logger *slog.Logger) {
+	if artworkService == nil || !artworkService.LocalEnabled() {
+		return
+	}
+
+	// A movie with several files (e.g. multi-part rips) merges the artwork
+	// of all of them; the first file wins per kind.
+	order := make([]uuid.UUID, 0, len(matches))
+	found := make(map[uuid.UUID][]scanner.LocalArtwork, len(matches))
+	for _, match := range matches {
+		files, seen := found[match.MovieID]
+		if !seen {
+			order = append(order, match.MovieID)
+		}
+		for _, art := range scanner.FindMovieArtwork(match.FilePath) {
+			if !hasArtworkKind(files, art.Kind) {
+				files = append(files, art)
+			}
+		}
+		found[match.MovieID] = files
+	}
+
+	for _, movieID := range order {
+		synced, err := artworkService.SyncLocal(ctx, artwork.ContentTypeMovie, movieID, found[movieID])
+		if err != nil {
+			logger.Warn("failed to sync local artwork",
+				slog.String("movie_id", movieID.String()),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		if !artworkService.PreferLocal() {
+			continue
+		}
+		if err := libraryService.UseLocalArtwork(ctx, movieID,
+			localImagePath(synced, scanner.ArtworkPoster),
+			localImagePath(synced, scanner.ArtworkBackdrop),
+		); err != nil {
+			logger.Warn("failed to apply local artwork",
+				slog.String("movie_id", movieID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// localImagePath returns the image path of the synced artwork of a kind,
+// or nil if there is none.
+func localImagePath(synced []artwork.Artwork, kind string) *string {
+	art := artwork.Select(synced, kind)
+	if art == nil {
+		return nil
+	}
+	path := artwork.LocalPath(art.ID)
+	return &path
+}
+
+func hasArtworkKind(files []scanner.LocalArtwork, kind string) bool {
+	for _, f := range files {
+		if f.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
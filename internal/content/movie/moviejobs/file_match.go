@@ -10,6 +10,8 @@ import (
 
 	"github.com/lusoris/revenge/internal/content/movie"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/library"
 )
 
 const MovieFileMatchJobKind = "movie_file_match"
@@ -38,16 +40,22 @@ func (MovieFileMatchArgs) InsertOpts() river.InsertOpts {
 type MovieFileMatchWorker struct {
 	river.WorkerDefaults[MovieFileMatchArgs]
 	libraryService *movie.LibraryService
+	matchQueue     *library.MatchQueueService
+	artwork        *artwork.Service
 	logger         *slog.Logger
 }
 
 // NewMovieFileMatchWorker creates a new movie file match worker.
 func NewMovieFileMatchWorker(
 	libraryService *movie.LibraryService,
+	matchQueue *library.MatchQueueService,
+	artworkService *artwork.Service,
 	logger *slog.Logger,
 ) *MovieFileMatchWorker {
 	return &MovieFileMatchWorker{
 		libraryService: libraryService,
+		matchQueue:     matchQueue,
+		artwork:        artworkService,
 		logger:         logger,
 	}
 }
@@ -99,7 +107,13 @@ func (w *MovieFileMatchWorker) Work(ctx context.Context, job *river.Job[MovieFil
 			slog.Float64("confidence", result.Confidence),
 			slog.Bool("created_new_movie", result.CreatedNewMovie),
 		)
+		clearMatched(ctx, w.matchQueue, []string{args.FilePath}, w.logger)
+		syncLocalArtwork(ctx, w.artwork, w.libraryService, []movie.FileMatch{
+			{FilePath: args.FilePath, MovieID: result.Movie.ID},
+		}, w.logger)
 	} else {
+		recordUnmatched(ctx, w.matchQueue, nil, []movie.MatchResult{*result}, w.logger)
+
 		w.logger.Warn("file could not be matched — skipping (not a retryable error)",
 			slog.String("file_path", args.FilePath),
 			slog.String("match_type", string(result.MatchType)),
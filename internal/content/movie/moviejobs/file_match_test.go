@@ -92,7 +92,7 @@ func TestNewMovieFileMatchWorker(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMovieFileMatchWorker(nil, logger)
+	worker := NewMovieFileMatchWorker(nil, nil, nil, logger)
 
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.libraryService)
@@ -102,7 +102,7 @@ func TestNewMovieFileMatchWorker(t *testing.T) {
 func TestNewMovieFileMatchWorker_NilLogger(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieFileMatchWorker(nil, nil)
+	worker := NewMovieFileMatchWorker(nil, nil, nil, nil)
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.libraryService)
 	assert.Nil(t, worker.logger)
@@ -116,7 +116,7 @@ func TestMovieFileMatchWorker_Kind(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMovieFileMatchWorker(nil, logger)
+	worker := NewMovieFileMatchWorker(nil, nil, nil, logger)
 
 	assert.Equal(t, MovieFileMatchJobKind, worker.Kind())
 	assert.Equal(t, "movie_file_match", worker.Kind())
@@ -125,7 +125,7 @@ func TestMovieFileMatchWorker_Kind(t *testing.T) {
 func TestMovieFileMatchWorker_Kind_MatchesArgs(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieFileMatchWorker(nil, logging.NewTestLogger())
+	worker := NewMovieFileMatchWorker(nil, nil, nil, logging.NewTestLogger())
 	args := MovieFileMatchArgs{}
 
 	// Worker kind and args kind must match for River to route jobs correctly.
@@ -139,7 +139,7 @@ func TestMovieFileMatchWorker_Kind_MatchesArgs(t *testing.T) {
 func TestMovieFileMatchWorker_Timeout(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieFileMatchWorker(nil, logging.NewTestLogger())
+	worker := NewMovieFileMatchWorker(nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[MovieFileMatchArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieFileMatchJobKind},
@@ -159,7 +159,7 @@ func TestMovieFileMatchWorker_Timeout(t *testing.T) {
 func TestMovieFileMatchWorker_Work_NilLibraryService_NonexistentFile(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieFileMatchWorker(nil, logging.NewTestLogger())
+	worker := NewMovieFileMatchWorker(nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[MovieFileMatchArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieFileMatchJobKind},
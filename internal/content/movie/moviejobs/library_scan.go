@@ -11,8 +11,11 @@ import (
 	"github.com/riverqueue/river"
 
 	"github.com/lusoris/revenge/internal/content/movie"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
 	"github.com/lusoris/revenge/internal/infra/observability"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/library"
 	"github.com/lusoris/revenge/internal/service/notification"
 )
@@ -50,6 +53,8 @@ type MovieLibraryScanWorker struct {
 	river.WorkerDefaults[MovieLibraryScanArgs]
 	libraryService      *movie.LibraryService
 	scanStatusService   *library.Service
+	matchQueue          *library.MatchQueueService
+	artwork             *artwork.Service
 	jobClient           *infrajobs.Client
 	notificationService notification.Service
 	logger              *slog.Logger
@@ -59,6 +64,8 @@ type MovieLibraryScanWorker struct {
 func NewMovieLibraryScanWorker(
 	libraryService *movie.LibraryService,
 	scanStatusService *library.Service,
+	matchQueue *library.MatchQueueService,
+	artworkService *artwork.Service,
 	jobClient *infrajobs.Client,
 	notificationService notification.Service,
 	logger *slog.Logger,
@@ -66,6 +73,8 @@ func NewMovieLibraryScanWorker(
 	return &MovieLibraryScanWorker{
 		libraryService:      libraryService,
 		scanStatusService:   scanStatusService,
+		matchQueue:          matchQueue,
+		artwork:             artworkService,
 		jobClient:           jobClient,
 		notificationService: notificationService,
 		logger:              logger,
@@ -103,29 +112,24 @@ func (w *MovieLibraryScanWorker) Work(ctx context.Context, job *river.Job[MovieL
 	}
 
 	// Mark scan as running if we have a scan ID and status service.
-	var scanID uuid.UUID
-	hasScanID := false
-	if args.ScanID != "" && w.scanStatusService != nil {
-		var err error
-		scanID, err = uuid.Parse(args.ScanID)
-		if err == nil {
-			hasScanID = true
-			if _, err := w.scanStatusService.StartScan(ctx, scanID); err != nil {
-				w.logger.Warn("failed to mark scan as running",
-					slog.String("scan_id", args.ScanID),
-					slog.Any("error", err),
-				)
-			}
-		}
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, "movie", parseID(args.LibraryID), parseID(args.ScanID))
+	status.Start(ctx)
+
+	// Metadata lookups during the scan follow the library's provider policy.
+	scanCtx := ctx
+	if libraryID, err := uuid.Parse(args.LibraryID); err == nil && w.scanStatusService != nil {
+		scanCtx = w.scanStatusService.MetadataContext(ctx, libraryID)
 	}
 
 	// Use paths from the job args (from the library record), not from startup config.
-	var summary *movie.ScanSummary
-	var scanErr error
-	if len(args.Paths) > 0 {
-		summary, scanErr = w.libraryService.ScanLibraryWithPaths(ctx, args.Paths)
-	} else {
-		summary, scanErr = w.libraryService.ScanLibrary(ctx)
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, "movie", args.LibraryID, args.ScanID)
+	summary, scanErr := w.libraryService.ScanLibraryWithProgress(scanCtx, args.Paths, func(p scanner.Progress) {
+		reporter.Report(ctx, p)
+	})
+
+	// A cancel request, the job timeout or a shutdown stops the scan between files.
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
 	}
 
 	if scanErr != nil {
@@ -135,14 +139,7 @@ func (w *MovieLibraryScanWorker) Work(ctx context.Context, job *river.Job[MovieL
 		observability.LibraryScanErrorsTotal.WithLabelValues("movies", "fatal").Inc()
 
 		// Mark scan as failed.
-		if hasScanID {
-			if _, err := w.scanStatusService.FailScan(ctx, scanID, scanErr.Error()); err != nil {
-				w.logger.Warn("failed to mark scan as failed",
-					slog.String("scan_id", args.ScanID),
-					slog.Any("error", err),
-				)
-			}
-		}
+		status.Fail(ctx, scanErr)
 		return fmt.Errorf("library scan failed: %w", scanErr)
 	}
 
@@ -152,21 +149,18 @@ func (w *MovieLibraryScanWorker) Work(ctx context.Context, job *river.Job[MovieL
 		observability.LibraryScanErrorsTotal.WithLabelValues("movies", "scan").Add(float64(len(summary.Errors)))
 	}
 
+	// Queue unmatched files for manual review and drop entries that matched.
+	recordUnmatched(ctx, w.matchQueue, parseID(args.LibraryID), summary.Unmatched, w.logger)
+	clearMatched(ctx, w.matchQueue, summary.MatchedPaths, w.logger)
+	syncLocalArtwork(ctx, w.artwork, w.libraryService, summary.Matches, w.logger)
+
 	// Mark scan as completed.
-	if hasScanID {
-		progress := &library.ScanProgress{
-			ItemsScanned: int32(summary.TotalFiles),
-			ItemsAdded:   int32(summary.NewMovies),
-			ItemsUpdated: int32(summary.ExistingMovies),
-			ErrorsCount:  int32(len(summary.Errors)),
-		}
-		if _, err := w.scanStatusService.CompleteScan(ctx, scanID, progress); err != nil {
-			w.logger.Warn("failed to mark scan as completed",
-				slog.String("scan_id", args.ScanID),
-				slog.Any("error", err),
-			)
-		}
-	}
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: int32(summary.TotalFiles),
+		ItemsAdded:   int32(summary.NewMovies),
+		ItemsUpdated: int32(summary.ExistingMovies),
+		ErrorsCount:  int32(len(summary.Errors)),
+	})
 
 	// Dispatch library scan completed notification.
 	if w.notificationService != nil {
@@ -198,6 +192,7 @@ func (w *MovieLibraryScanWorker) Work(ctx context.Context, job *river.Job[MovieL
 		slog.Int("unmatched_files", summary.UnmatchedFiles),
 		slog.Int("new_movies", summary.NewMovies),
 		slog.Int("existing_movies", summary.ExistingMovies),
+		slog.Int("extras", summary.Extras),
 		slog.Int("errors", len(summary.Errors)),
 	)
 
@@ -216,3 +211,13 @@ func (w *MovieLibraryScanWorker) Work(ctx context.Context, job *river.Job[MovieL
 
 	return nil
 }
+
+// parseID returns the ID a job argument holds, or nil when it is empty or
+// malformed.
+func parseID(s string) *uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -2,6 +2,7 @@ package moviejobs
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -112,7 +113,7 @@ func TestNewMovieLibraryScanWorker(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logger)
 
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.libraryService)
@@ -124,7 +125,7 @@ func TestNewMovieLibraryScanWorker_WithNilLogger(t *testing.T) {
 	t.Parallel()
 
 	// Passing nil logger should still create worker (won't panic on construction).
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil)
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil)
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.libraryService)
 	assert.Nil(t, worker.scanStatusService)
@@ -139,7 +140,7 @@ func TestMovieLibraryScanWorker_Kind(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logger)
 
 	assert.Equal(t, MovieLibraryScanJobKind, worker.Kind())
 	assert.Equal(t, "movie_library_scan", worker.Kind())
@@ -148,7 +149,7 @@ func TestMovieLibraryScanWorker_Kind(t *testing.T) {
 func TestMovieLibraryScanWorker_Kind_MatchesArgs(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logging.NewTestLogger())
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 	args := MovieLibraryScanArgs{}
 
 	// Worker kind and args kind must match for River to route jobs correctly.
@@ -162,7 +163,7 @@ func TestMovieLibraryScanWorker_Kind_MatchesArgs(t *testing.T) {
 func TestMovieLibraryScanWorker_Timeout(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logging.NewTestLogger())
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[MovieLibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieLibraryScanJobKind},
@@ -178,7 +179,7 @@ func TestMovieLibraryScanWorker_Timeout(t *testing.T) {
 func TestMovieLibraryScanWorker_Timeout_ConsistentAcrossCalls(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logging.NewTestLogger())
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 
 	job1 := &river.Job[MovieLibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieLibraryScanJobKind},
@@ -200,7 +201,7 @@ func TestMovieLibraryScanWorker_Timeout_ConsistentAcrossCalls(t *testing.T) {
 func TestMovieLibraryScanWorker_Work_NilLibraryService(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logging.NewTestLogger())
+	worker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[MovieLibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieLibraryScanJobKind},
@@ -230,7 +231,7 @@ func TestMovieLibraryScanWorker_Work_EmptyLibrary(t *testing.T) {
 		Paths: []string{tempDir},
 	}
 	libSvc := movie.NewLibraryService(nil, nil, libConfig, nil)
-	worker := NewMovieLibraryScanWorker(libSvc, nil, nil, nil, logging.NewTestLogger())
+	worker := NewMovieLibraryScanWorker(libSvc, nil, nil, nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[MovieLibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieLibraryScanJobKind},
@@ -243,3 +244,45 @@ func TestMovieLibraryScanWorker_Work_EmptyLibrary(t *testing.T) {
 	err := worker.Work(context.Background(), job)
 	require.NoError(t, err)
 }
+
+func TestMovieLibraryScanWorker_Work_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	libSvc := movie.NewLibraryService(nil, nil, config.LibraryConfig{}, nil)
+	worker := NewMovieLibraryScanWorker(libSvc, nil, nil, nil, nil, nil, logging.NewTestLogger())
+
+	job := &river.Job[MovieLibraryScanArgs]{
+		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieLibraryScanJobKind},
+		Args:   MovieLibraryScanArgs{Paths: []string{tempDir}},
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(river.ErrJobCancelledRemotely)
+
+	err := worker.Work(ctx, job)
+	var cancelErr *river.JobCancelError
+	assert.True(t, errors.As(err, &cancelErr), "cancelled scans cancel the job instead of retrying it")
+}
+
+func TestMovieLibraryScanWorker_Work_TimedOut(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	libSvc := movie.NewLibraryService(nil, nil, config.LibraryConfig{}, nil)
+	worker := NewMovieLibraryScanWorker(libSvc, nil, nil, nil, nil, nil, logging.NewTestLogger())
+
+	job := &river.Job[MovieLibraryScanArgs]{
+		JobRow: &rivertype.JobRow{ID: 1, Kind: MovieLibraryScanJobKind},
+		Args:   MovieLibraryScanArgs{Paths: []string{tempDir}},
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	err := worker.Work(ctx, job)
+	require.Error(t, err)
+	var cancelErr *river.JobCancelError
+	assert.False(t, errors.As(err, &cancelErr), "timed out scans fail so the job is retried")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
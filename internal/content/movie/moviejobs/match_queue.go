@@ -0,0 +1,107 @@
+package moviejobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// matchQueueContentType is the content type movie files are queued under.
+const matchQueueContentType = "movie"
+
+// recordUnmatched parks files the matcher could not identify in the match
+// queue so an admin can pick a candidate or enter an ID by hand.
+func recordUnmatched(ctx context.Context, queue *library.MatchQueueService, libraryID *uuid.UUID, results []movie.MatchResult, logger *slog.Logger) {
+	if queue == nil {
+		return
+	}
+
+	for _, result := range results {
+		if err := queue.Record(ctx, newMatchQueueItem(libraryID, result)); err != nil {
+			logger.Warn("failed to queue unmatched file",
+				slog.String("file_path", result.ScanResult.FilePath),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// clearMatched removes queue entries for files that are now matched.
+func clearMatched(ctx context.Context, queue *library.MatchQueueService, paths []string, logger *slog.Logger) {
+	if queue == nil {
+		return
+	}
+
+	if err := queue.ClearMatched(ctx, matchQueueContentType, paths); err != nil {
+		logger.Warn("failed to clear matched files from queue",
+			slog.Any("error", err),
+		)
+	}
+}
+
+// newMatchQueueItem converts an unmatched result into a queue item.
+func newMatchQueueItem(libraryID *uuid.UUID, result movie.MatchResult) *library.MatchQueueItem {
+	item := &library.MatchQueueItem{
+		LibraryID:   libraryID,
+		ContentType: matchQueueContentType,
+		FilePath:    result.ScanResult.FilePath,
+		ParsedYear:  toInt32Ptr(result.ScanResult.ParsedYear),
+		Candidates:  make([]library.MatchCandidate, 0, len(result.Candidates)),
+	}
+	if result.ScanResult.ParsedTitle != "" {
+		item.ParsedTitle = &result.ScanResult.ParsedTitle
+	}
+
+	switch {
+	case result.Error != nil:
+		reason := result.Error.Error()
+		item.Reason = &reason
+	case len(result.Candidates) > 0:
+		reason := fmt.Sprintf("best candidate scored %.2f, below the %.2f threshold",
+			result.Candidates[0].Confidence, result.MinConfidence)
+		item.Reason = &reason
+	}
+
+	for _, c := range result.Candidates {
+		if c.Movie == nil || c.Movie.TMDbID == nil {
+			continue
+		}
+		item.Candidates = append(item.Candidates, library.MatchCandidate{
+			Provider:   "tmdb",
+			ProviderID: fmt.Sprintf("%d", *c.Movie.TMDbID),
+			IMDbID:     c.Movie.IMDbID,
+			Title:      c.Movie.Title,
+			Year:       candidateYear(c.Movie),
+			Overview:   c.Movie.Overview,
+			PosterPath: c.Movie.PosterPath,
+			Confidence: c.Confidence,
+		})
+	}
+
+	return item
+}
+
+func candidateYear(m *movie.Movie) *int32 {
+	if m.Year != nil {
+		return m.Year
+	}
+	if m.ReleaseDate != nil {
+		year := util.SafeIntToInt32(m.ReleaseDate.Year())
+		return &year
+	}
+	return nil
+}
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := util.SafeIntToInt32(*v)
+	return &i
+}
@@ -0,0 +1,64 @@
+package moviejobs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/movie"
+)
+
+func TestNewMatchQueueItem_LowConfidence(t *testing.T) {
+	t.Parallel()
+
+	libraryID := uuid.New()
+	year := 1999
+	release := time.Date(1999, 3, 31, 0, 0, 0, 0, time.UTC)
+	tmdbID := int32(603)
+
+	item := newMatchQueueItem(&libraryID, movie.MatchResult{
+		ScanResult: movie.ScanResult{
+			FilePath:    "/movies/matrix.mkv",
+			ParsedTitle: "matrix",
+			ParsedYear:  &year,
+		},
+		MatchType: movie.MatchTypeUnmatched,
+		Candidates: []movie.MatchCandidate{
+			{Movie: &movie.Movie{Title: "The Matrix", TMDbID: &tmdbID, ReleaseDate: &release}, Confidence: 0.45},
+			{Movie: &movie.Movie{Title: "No Provider ID"}, Confidence: 0.1},
+		},
+		MinConfidence: 0.5,
+	})
+
+	assert.Equal(t, &libraryID, item.LibraryID)
+	assert.Equal(t, "movie", item.ContentType)
+	assert.Equal(t, "matrix", *item.ParsedTitle)
+	assert.Equal(t, int32(1999), *item.ParsedYear)
+	require.NotNil(t, item.Reason)
+	assert.Equal(t, "best candidate scored 0.45, below the 0.50 threshold", *item.Reason)
+
+	require.Len(t, item.Candidates, 1)
+	assert.Equal(t, "tmdb", item.Candidates[0].Provider)
+	assert.Equal(t, "603", item.Candidates[0].ProviderID)
+	assert.Equal(t, int32(1999), *item.Candidates[0].Year)
+}
+
+func TestNewMatchQueueItem_Error(t *testing.T) {
+	t.Parallel()
+
+	item := newMatchQueueItem(nil, movie.MatchResult{
+		ScanResult: movie.ScanResult{FilePath: "/movies/unknown.mkv"},
+		MatchType:  movie.MatchTypeUnmatched,
+		Error:      errors.New("no TMDb results for: unknown"),
+	})
+
+	assert.Nil(t, item.LibraryID)
+	assert.Nil(t, item.ParsedTitle)
+	assert.Empty(t, item.Candidates)
+	require.NotNil(t, item.Reason)
+	assert.Equal(t, "no TMDb results for: unknown", *item.Reason)
+}
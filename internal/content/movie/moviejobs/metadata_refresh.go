@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/riverqueue/river"
 	"log/slog"
 
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/library"
 	metadatajobs "github.com/lusoris/revenge/internal/service/metadata/jobs"
 )
 
@@ -17,21 +21,27 @@ import (
 // It handles jobs of type metadatajobs.RefreshMovieArgs (kind: "metadata_refresh_movie").
 type MovieMetadataRefreshWorker struct {
 	river.WorkerDefaults[metadatajobs.RefreshMovieArgs]
-	service   movie.Service
-	jobClient *infrajobs.Client
-	logger    *slog.Logger
+	service        movie.Service
+	libraryService *movie.LibraryService
+	libraries      *library.Service
+	jobClient      *infrajobs.Client
+	logger         *slog.Logger
 }
 
 // NewMovieMetadataRefreshWorker creates a new metadata refresh worker.
 func NewMovieMetadataRefreshWorker(
 	service movie.Service,
+	libraryService *movie.LibraryService,
+	libraries *library.Service,
 	jobClient *infrajobs.Client,
 	logger *slog.Logger,
 ) *MovieMetadataRefreshWorker {
 	return &MovieMetadataRefreshWorker{
-		service:   service,
-		jobClient: jobClient,
-		logger:    logger,
+		service:        service,
+		libraryService: libraryService,
+		libraries:      libraries,
+		jobClient:      jobClient,
+		logger:         logger,
 	}
 }
 
@@ -42,6 +52,7 @@ func (w *MovieMetadataRefreshWorker) Timeout(job *river.Job[metadatajobs.Refresh
 
 // Work executes the metadata refresh job by delegating to the movie service.
 func (w *MovieMetadataRefreshWorker) Work(ctx context.Context, job *river.Job[metadatajobs.RefreshMovieArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	args := job.Args
 
 	w.logger.Info("starting movie metadata refresh",
@@ -63,7 +74,7 @@ func (w *MovieMetadataRefreshWorker) Work(ctx context.Context, job *river.Job[me
 		Languages: args.Languages,
 	}
 
-	if err := w.service.RefreshMovieMetadata(ctx, args.MovieID, opts); err != nil {
+	if err := w.service.RefreshMovieMetadata(w.metadataContext(ctx, args.MovieID), args.MovieID, opts); err != nil {
 		w.logger.Error("movie metadata refresh failed",
 			slog.String("movie_id", args.MovieID.String()),
 			slog.Any("error", err),
@@ -71,6 +82,28 @@ func (w *MovieMetadataRefreshWorker) Work(ctx context.Context, job *river.Job[me
 		return fmt.Errorf("movie metadata refresh failed: %w", err)
 	}
 
+	// Keep configured NFO values ahead of the refreshed provider data
+	if w.libraryService != nil {
+		if err := w.libraryService.ApplyNFOOverrides(ctx, args.MovieID); err != nil {
+			w.logger.Warn("failed to apply nfo overrides",
+				slog.String("movie_id", args.MovieID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	// Collect artwork candidates and select the images for the refreshed movie
+	if _, err := w.jobClient.Insert(ctx, metadatajobs.SyncArtworkArgs{
+		ContentType: artwork.ContentTypeMovie,
+		ContentID:   args.MovieID,
+		Languages:   args.Languages,
+	}, nil); err != nil {
+		w.logger.Warn("failed to queue artwork sync",
+			slog.String("movie_id", args.MovieID.String()),
+			slog.Any("error", err),
+		)
+	}
+
 	_ = w.jobClient.ReportProgress(ctx, job.ID, &infrajobs.JobProgress{
 		Phase:   "completed",
 		Current: 1,
@@ -85,3 +118,16 @@ func (w *MovieMetadataRefreshWorker) Work(ctx context.Context, job *river.Job[me
 
 	return nil
 }
+
+// metadataContext applies the provider policy of the library holding the
+// movie's files.
+func (w *MovieMetadataRefreshWorker) metadataContext(ctx context.Context, movieID uuid.UUID) context.Context {
+	if w.libraries == nil {
+		return ctx
+	}
+	files, err := w.service.GetMovieFiles(ctx, movieID)
+	if err != nil || len(files) == 0 {
+		return ctx
+	}
+	return w.libraries.MetadataContextForPath(ctx, library.LibraryTypeMovie, files[0].FilePath)
+}
@@ -116,7 +116,7 @@ func TestNewMovieMetadataRefreshWorker(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMovieMetadataRefreshWorker(nil, nil, logger)
+	worker := NewMovieMetadataRefreshWorker(nil, nil, nil, nil, logger)
 
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.service)
@@ -127,7 +127,7 @@ func TestNewMovieMetadataRefreshWorker(t *testing.T) {
 func TestNewMovieMetadataRefreshWorker_NilLogger(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieMetadataRefreshWorker(nil, nil, nil)
+	worker := NewMovieMetadataRefreshWorker(nil, nil, nil, nil, nil)
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.service)
 	assert.Nil(t, worker.jobClient)
@@ -141,7 +141,7 @@ func TestNewMovieMetadataRefreshWorker_NilLogger(t *testing.T) {
 func TestMovieMetadataRefreshWorker_Timeout(t *testing.T) {
 	t.Parallel()
 
-	worker := NewMovieMetadataRefreshWorker(nil, nil, logging.NewTestLogger())
+	worker := NewMovieMetadataRefreshWorker(nil, nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[metadatajobs.RefreshMovieArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: "metadata_refresh_movie"},
@@ -163,7 +163,7 @@ func TestMovieMetadataRefreshWorker_Work_NilService(t *testing.T) {
 
 	// Create worker with nil service and nil jobClient.
 	// The nil jobClient will panic on w.jobClient.ReportProgress().
-	worker := NewMovieMetadataRefreshWorker(nil, nil, logging.NewTestLogger())
+	worker := NewMovieMetadataRefreshWorker(nil, nil, nil, nil, logging.NewTestLogger())
 
 	job := &river.Job[metadatajobs.RefreshMovieArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: "metadata_refresh_movie"},
@@ -199,7 +199,7 @@ func TestMovieMetadataRefreshWorker_Work_Success(t *testing.T) {
 
 	// Use a zero-value infrajobs.Client where ReportProgress returns nil (c.client == nil).
 	jobClient := &infrajobs.Client{}
-	worker := NewMovieMetadataRefreshWorker(svc, jobClient, logging.NewTestLogger())
+	worker := NewMovieMetadataRefreshWorker(svc, nil, nil, jobClient, logging.NewTestLogger())
 
 	job := &river.Job[metadatajobs.RefreshMovieArgs]{
 		JobRow: &rivertype.JobRow{ID: 42, Kind: "metadata_refresh_movie"},
@@ -229,7 +229,7 @@ func TestMovieMetadataRefreshWorker_Work_ServiceError(t *testing.T) {
 	}
 
 	jobClient := &infrajobs.Client{}
-	worker := NewMovieMetadataRefreshWorker(svc, jobClient, logging.NewTestLogger())
+	worker := NewMovieMetadataRefreshWorker(svc, nil, nil, jobClient, logging.NewTestLogger())
 
 	job := &river.Job[metadatajobs.RefreshMovieArgs]{
 		JobRow: &rivertype.JobRow{ID: 42, Kind: "metadata_refresh_movie"},
@@ -261,7 +261,7 @@ func TestMovieMetadataRefreshWorker_Work_NoLanguages(t *testing.T) {
 	}
 
 	jobClient := &infrajobs.Client{}
-	worker := NewMovieMetadataRefreshWorker(svc, jobClient, logging.NewTestLogger())
+	worker := NewMovieMetadataRefreshWorker(svc, nil, nil, jobClient, logging.NewTestLogger())
 
 	job := &river.Job[metadatajobs.RefreshMovieArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: "metadata_refresh_movie"},
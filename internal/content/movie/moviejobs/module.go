@@ -16,6 +16,7 @@ var Module = fx.Module("moviejobs",
 		NewMovieLibraryScanWorker,
 		NewMovieFileMatchWorker,
 		NewMovieSearchIndexWorker,
+		NewMovieNFOExportWorker,
 	),
 	fx.Invoke(RegisterWorkers),
 )
@@ -27,11 +28,13 @@ func RegisterWorkers(
 	libraryScanWorker *MovieLibraryScanWorker,
 	fileMatchWorker *MovieFileMatchWorker,
 	searchIndexWorker *MovieSearchIndexWorker,
+	nfoExportWorker *MovieNFOExportWorker,
 ) error {
 	river.AddWorker(workers, metadataRefreshWorker)
 	river.AddWorker(workers, libraryScanWorker)
 	river.AddWorker(workers, fileMatchWorker)
 	river.AddWorker(workers, searchIndexWorker)
+	river.AddWorker(workers, nfoExportWorker)
 	return nil
 }
 
@@ -44,6 +47,7 @@ type RegisterWorkersParams struct {
 	LibraryScanWorker     *MovieLibraryScanWorker
 	FileMatchWorker       *MovieFileMatchWorker
 	SearchIndexWorker     *MovieSearchIndexWorker `optional:"true"`
+	NFOExportWorker       *MovieNFOExportWorker
 	MovieService          movie.Service
 	LibraryService        *movie.LibraryService
 	SearchService         *search.MovieSearchService `optional:"true"`
@@ -15,12 +15,13 @@ func TestRegisterWorkers(t *testing.T) {
 	logger := logging.NewTestLogger()
 	workers := river.NewWorkers()
 
-	metadataRefreshWorker := NewMovieMetadataRefreshWorker(nil, nil, logger)
-	libraryScanWorker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logger)
-	fileMatchWorker := NewMovieFileMatchWorker(nil, logger)
+	metadataRefreshWorker := NewMovieMetadataRefreshWorker(nil, nil, nil, nil, logger)
+	libraryScanWorker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logger)
+	fileMatchWorker := NewMovieFileMatchWorker(nil, nil, nil, logger)
 	searchIndexWorker := NewMovieSearchIndexWorker(nil, nil, logger)
+	nfoExportWorker := NewMovieNFOExportWorker(nil, logger)
 
-	err := RegisterWorkers(workers, metadataRefreshWorker, libraryScanWorker, fileMatchWorker, searchIndexWorker)
+	err := RegisterWorkers(workers, metadataRefreshWorker, libraryScanWorker, fileMatchWorker, searchIndexWorker, nfoExportWorker)
 	require.NoError(t, err)
 }
 
@@ -30,13 +31,14 @@ func TestRegisterWorkers_ReturnsNil(t *testing.T) {
 	logger := logging.NewTestLogger()
 	workers := river.NewWorkers()
 
-	metadataRefreshWorker := NewMovieMetadataRefreshWorker(nil, nil, logger)
-	libraryScanWorker := NewMovieLibraryScanWorker(nil, nil, nil, nil, logger)
-	fileMatchWorker := NewMovieFileMatchWorker(nil, logger)
+	metadataRefreshWorker := NewMovieMetadataRefreshWorker(nil, nil, nil, nil, logger)
+	libraryScanWorker := NewMovieLibraryScanWorker(nil, nil, nil, nil, nil, nil, logger)
+	fileMatchWorker := NewMovieFileMatchWorker(nil, nil, nil, logger)
 	searchIndexWorker := NewMovieSearchIndexWorker(nil, nil, logger)
+	nfoExportWorker := NewMovieNFOExportWorker(nil, logger)
 
 	// RegisterWorkers always returns nil.
-	err := RegisterWorkers(workers, metadataRefreshWorker, libraryScanWorker, fileMatchWorker, searchIndexWorker)
+	err := RegisterWorkers(workers, metadataRefreshWorker, libraryScanWorker, fileMatchWorker, searchIndexWorker, nfoExportWorker)
 	assert.NoError(t, err)
 }
 
@@ -57,6 +59,7 @@ func TestRegisterWorkersParams_Fields(t *testing.T) {
 	assert.Nil(t, params.LibraryScanWorker)
 	assert.Nil(t, params.FileMatchWorker)
 	assert.Nil(t, params.SearchIndexWorker)
+	assert.Nil(t, params.NFOExportWorker)
 	assert.Nil(t, params.MovieService)
 	assert.Nil(t, params.LibraryService)
 	assert.Nil(t, params.SearchService)
@@ -73,6 +76,7 @@ func TestAllJobKinds_AreUnique(t *testing.T) {
 	kinds := []string{
 		MovieLibraryScanJobKind,
 		MovieFileMatchJobKind,
+		MovieNFOExportJobKind,
 		"movie_search_index",     // From MovieSearchIndexArgs.Kind()
 		"metadata_refresh_movie", // From metadatajobs.RefreshMovieArgs.Kind()
 	}
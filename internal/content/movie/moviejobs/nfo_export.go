@@ -0,0 +1,106 @@
+package moviejobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/movie"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+)
+
+const MovieNFOExportJobKind = "movie_nfo_export"
+
+// MovieNFOExportArgs are the arguments for the movie NFO export job.
+type MovieNFOExportArgs struct {
+	// MovieID limits the export to one movie. Nil exports the whole library.
+	MovieID *uuid.UUID `json:"movie_id,omitempty"`
+}
+
+// Kind returns the job kind for the movie NFO export job.
+func (MovieNFOExportArgs) Kind() string {
+	return MovieNFOExportJobKind
+}
+
+// InsertOpts returns the default insert options for movie NFO export jobs.
+// Full exports touch every movie folder, so they run on the bulk queue.
+func (MovieNFOExportArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// MovieNFOExportWorker writes Kodi-style NFO files next to movie files.
+type MovieNFOExportWorker struct {
+	river.WorkerDefaults[MovieNFOExportArgs]
+	libraryService *movie.LibraryService
+	logger         *slog.Logger
+}
+
+// NewMovieNFOExportWorker creates a new movie NFO export worker.
+func NewMovieNFOExportWorker(
+	libraryService *movie.LibraryService,
+	logger *slog.Logger,
+) *MovieNFOExportWorker {
+	return &MovieNFOExportWorker{
+		libraryService: libraryService,
+		logger:         logger,
+	}
+}
+
+// Timeout returns the maximum execution time for movie NFO export jobs.
+func (w *MovieNFOExportWorker) Timeout(job *river.Job[MovieNFOExportArgs]) time.Duration {
+	if job.Args.MovieID != nil {
+		return time.Minute
+	}
+	return 30 * time.Minute
+}
+
+// Work performs the movie NFO export job.
+func (w *MovieNFOExportWorker) Work(ctx context.Context, job *river.Job[MovieNFOExportArgs]) error {
+	if movieID := job.Args.MovieID; movieID != nil {
+		written, err := w.libraryService.ExportNFO(ctx, *movieID)
+		if err != nil {
+			w.logger.Error("movie nfo export failed",
+				slog.String("movie_id", movieID.String()),
+				slog.Any("error", err),
+			)
+			return fmt.Errorf("movie nfo export failed: %w", err)
+		}
+
+		w.logger.Info("movie nfo export completed",
+			slog.String("movie_id", movieID.String()),
+			slog.Int("files", written),
+		)
+		return nil
+	}
+
+	w.logger.Info("starting movie library nfo export", slog.Int64("job_id", job.ID))
+
+	summary, err := w.libraryService.ExportLibraryNFO(ctx)
+	if err != nil {
+		return fmt.Errorf("movie library nfo export failed: %w", err)
+	}
+
+	for _, exportErr := range summary.Errors {
+		w.logger.Warn("movie nfo export error", slog.Any("error", exportErr))
+	}
+
+	w.logger.Info("movie library nfo export completed",
+		slog.Int("movies", summary.Movies),
+		slog.Int("files", summary.Files),
+		slog.Int("errors", len(summary.Errors)),
+	)
+
+	return nil
+}
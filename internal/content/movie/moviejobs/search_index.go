@@ -127,6 +127,12 @@ func (w *MovieSearchIndexWorker) indexMovie(ctx context.Context, movieID uuid.UU
 		genres = nil
 	}
 
+	tags, err := w.movieRepo.ListMovieTags(ctx, movieID)
+	if err != nil {
+		w.logger.Warn("failed to get tags", slog.Any("error", err))
+		tags = nil
+	}
+
 	cast, err := w.movieRepo.ListMovieCast(ctx, movieID, 1000, 0)
 	if err != nil {
 		w.logger.Warn("failed to get cast", slog.Any("error", err))
@@ -152,7 +158,7 @@ func (w *MovieSearchIndexWorker) indexMovie(ctx context.Context, movieID uuid.UU
 	}
 
 	// Index the movie
-	if err := w.searchService.UpdateMovie(ctx, m, genres, credits, file); err != nil {
+	if err := w.searchService.UpdateMovie(ctx, m, genres, tags, credits, file); err != nil {
 		return fmt.Errorf("failed to index movie: %w", err)
 	}
 
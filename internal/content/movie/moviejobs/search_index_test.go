@@ -30,6 +30,7 @@ type mockMovieRepo struct {
 
 	getMovieFunc            func(ctx context.Context, id uuid.UUID) (*movie.Movie, error)
 	listMovieGenresFunc     func(ctx context.Context, movieID uuid.UUID) ([]movie.MovieGenre, error)
+	listMovieTagsFunc       func(ctx context.Context, movieID uuid.UUID) ([]movie.MovieTag, error)
 	listMovieCastFunc       func(ctx context.Context, movieID uuid.UUID) ([]movie.MovieCredit, error)
 	listMovieCrewFunc       func(ctx context.Context, movieID uuid.UUID) ([]movie.MovieCredit, error)
 	listMovieFilesByMovieID func(ctx context.Context, movieID uuid.UUID) ([]movie.MovieFile, error)
@@ -51,6 +52,13 @@ func (m *mockMovieRepo) ListMovieGenres(ctx context.Context, movieID uuid.UUID)
 	return nil, nil
 }
 
+func (m *mockMovieRepo) ListMovieTags(ctx context.Context, movieID uuid.UUID) ([]movie.MovieTag, error) {
+	if m.listMovieTagsFunc != nil {
+		return m.listMovieTagsFunc(ctx, movieID)
+	}
+	return nil, nil
+}
+
 func (m *mockMovieRepo) ListMovieCast(ctx context.Context, movieID uuid.UUID, limit, offset int32) ([]movie.MovieCredit, error) {
 	if m.listMovieCastFunc != nil {
 		return m.listMovieCastFunc(ctx, movieID)
@@ -73,7 +81,7 @@ func (m *mockMovieRepo) CountMovieCrew(ctx context.Context, movieID uuid.UUID) (
 	return 0, nil
 }
 
-func (m *mockMovieRepo) CountTopRated(ctx context.Context, minVotes int32) (int64, error) {
+func (m *mockMovieRepo) CountTopRated(ctx context.Context, minVotes int32, libraryPaths []string) (int64, error) {
 	return 0, nil
 }
 
@@ -91,7 +99,7 @@ func (m *mockMovieRepo) ListMovies(ctx context.Context, filters movie.ListFilter
 	return nil, nil
 }
 
-func (m *mockMovieRepo) CountMovies(ctx context.Context) (int64, error) {
+func (m *mockMovieRepo) CountMovies(ctx context.Context, libraryPaths []string) (int64, error) {
 	if m.countMoviesFunc != nil {
 		return m.countMoviesFunc(ctx)
 	}
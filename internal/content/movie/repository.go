@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // Repository defines database operations for movies
@@ -16,15 +17,18 @@ type Repository interface {
 	GetMovieByIMDbID(ctx context.Context, imdbID string) (*Movie, error)
 	GetMovieByRadarrID(ctx context.Context, radarrID int32) (*Movie, error)
 	ListMovies(ctx context.Context, filters ListFilters) ([]Movie, error)
-	CountMovies(ctx context.Context) (int64, error)
+	CountMovies(ctx context.Context, libraryPaths []string) (int64, error)
 	SearchMoviesByTitle(ctx context.Context, query string, limit, offset int32) ([]Movie, error)
 	SearchMoviesByTitleAnyLanguage(ctx context.Context, query string, limit, offset int32) ([]Movie, error)
 	ListMoviesByYear(ctx context.Context, year int32, limit, offset int32) ([]Movie, error)
-	ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Movie, error)
-	ListTopRated(ctx context.Context, minVotes int32, limit, offset int32) ([]Movie, error)
-	CountTopRated(ctx context.Context, minVotes int32) (int64, error)
+	ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Movie, error)
+	ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit, offset int32) ([]Movie, error)
+	CountTopRated(ctx context.Context, minVotes int32, libraryPaths []string) (int64, error)
+	ListMoviesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Movie, error)
 	CreateMovie(ctx context.Context, params CreateMovieParams) (*Movie, error)
 	UpdateMovie(ctx context.Context, params UpdateMovieParams) (*Movie, error)
+	SetMovieLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Movie, error)
+	SetMovieImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
 	DeleteMovie(ctx context.Context, id uuid.UUID) error
 
 	// Movie Files
@@ -35,6 +39,13 @@ type Repository interface {
 	ListMovieFilesByMovieID(ctx context.Context, movieID uuid.UUID) ([]MovieFile, error)
 	UpdateMovieFile(ctx context.Context, params UpdateMovieFileParams) (*MovieFile, error)
 	DeleteMovieFile(ctx context.Context, id uuid.UUID) error
+	AssignMovieFileMatch(ctx context.Context, fileID, movieID uuid.UUID, locked bool) (*MovieFile, error)
+	SetMovieFileMatchLocked(ctx context.Context, fileID uuid.UUID, locked bool) (*MovieFile, error)
+
+	// Extras
+	UpsertMovieExtra(ctx context.Context, params UpsertMovieExtraParams) (*MovieExtra, error)
+	GetMovieExtra(ctx context.Context, id uuid.UUID) (*MovieExtra, error)
+	ListMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieExtra, error)
 
 	// Credits
 	CreateMovieCredit(ctx context.Context, params CreateMovieCreditParams) (*MovieCredit, error)
@@ -59,13 +70,22 @@ type Repository interface {
 	ListMovieGenres(ctx context.Context, movieID uuid.UUID) ([]MovieGenre, error)
 	ListDistinctMovieGenres(ctx context.Context) ([]content.GenreSummary, error)
 	DeleteMovieGenres(ctx context.Context, movieID uuid.UUID) error
-	ListMoviesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Movie, error)
+	ListMoviesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Movie, error)
+
+	// Tags
+	AddMovieTag(ctx context.Context, movieID uuid.UUID, slug, name string, spoiler bool) error
+	ListMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error)
+	ListDistinctMovieTags(ctx context.Context, limit int32) ([]content.TagSummary, error)
+	DeleteMovieTags(ctx context.Context, movieID uuid.UUID) error
+	ListMoviesByTag(ctx context.Context, slug string, limit, offset int32) ([]Movie, error)
+	ListMoviesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Movie, error)
+	SetMovieTagsImported(ctx context.Context, id uuid.UUID) error
 
 	// Watch Progress
 	CreateOrUpdateWatchProgress(ctx context.Context, params CreateWatchProgressParams) (*MovieWatched, error)
 	GetWatchProgress(ctx context.Context, userID, movieID uuid.UUID) (*MovieWatched, error)
 	DeleteWatchProgress(ctx context.Context, userID, movieID uuid.UUID) error
-	ListContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error)
+	ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error)
 	ListWatchedMovies(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]WatchedMovieItem, error)
 	GetUserMovieStats(ctx context.Context, userID uuid.UUID) (*UserMovieStats, error)
 }
@@ -76,6 +96,7 @@ type CreateMovieParams struct {
 	IMDbID            *string
 	Title             string
 	OriginalTitle     *string
+	SortTitle         *string
 	Year              *int32
 	ReleaseDate       *string
 	Runtime           *int32
@@ -107,6 +128,7 @@ type UpdateMovieParams struct {
 	IMDbID            *string
 	Title             *string
 	OriginalTitle     *string
+	SortTitle         *string
 	Year              *int32
 	ReleaseDate       *string
 	Runtime           *int32
@@ -129,6 +151,8 @@ type UpdateMovieParams struct {
 	Revenue           *int64
 	RadarrID          *int32
 	MetadataUpdatedAt *string
+	MetadataSources   map[string]string // Merged into the stored sources
+	ProviderIDs       map[string]string // Merged into the stored provider IDs
 }
 
 // CreateMovieFileParams contains parameters for creating a movie file
@@ -146,6 +170,18 @@ type CreateMovieFileParams struct {
 	AudioLanguages    []string
 	SubtitleLanguages []string
 	RadarrFileID      *int32
+	Edition           *string
+}
+
+// UpsertMovieExtraParams contains parameters for recording a movie extra
+type UpsertMovieExtraParams struct {
+	MovieID         uuid.UUID
+	ExtraType       string
+	Title           string
+	FilePath        string
+	FileSize        int64
+	Container       *string
+	DurationSeconds *int32
 }
 
 // UpdateMovieFileParams contains parameters for updating a movie file
@@ -168,6 +204,8 @@ type UpdateMovieFileParams struct {
 type CreateMovieCreditParams struct {
 	MovieID      uuid.UUID
 	TMDbPersonID int32
+	TVDbPersonID *int32
+	IMDbPersonID *string
 	Name         string
 	CreditType   string
 	Character    *string
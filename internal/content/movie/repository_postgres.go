@@ -15,6 +15,7 @@ import (
 
 	"github.com/lusoris/revenge/internal/content"
 	moviedb "github.com/lusoris/revenge/internal/content/movie/db"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 	"github.com/lusoris/revenge/internal/util"
 )
 
@@ -88,6 +89,7 @@ func dbMovieToMovie(dbMovie moviedb.Movie) *Movie {
 		IMDbID:            dbMovie.ImdbID,
 		Title:             dbMovie.Title,
 		OriginalTitle:     dbMovie.OriginalTitle,
+		SortTitle:         dbMovie.SortTitle,
 		Year:              dbMovie.Year,
 		ReleaseDate:       pgDateToTimePtr(dbMovie.ReleaseDate),
 		Runtime:           dbMovie.Runtime,
@@ -99,6 +101,7 @@ func dbMovieToMovie(dbMovie moviedb.Movie) *Movie {
 		TaglinesI18n:      unmarshalStringMap(dbMovie.TaglinesI18n),
 		OverviewsI18n:     unmarshalStringMap(dbMovie.OverviewsI18n),
 		AgeRatings:        unmarshalNestedStringMap(dbMovie.AgeRatings),
+		MinAge:            dbMovie.MinAge,
 		ExternalRatings:   unmarshalExternalRatings(dbMovie.ExternalRatings),
 		PosterPath:        dbMovie.PosterPath,
 		BackdropPath:      dbMovie.BackdropPath,
@@ -111,6 +114,10 @@ func dbMovieToMovie(dbMovie moviedb.Movie) *Movie {
 		LibraryAddedAt:    dbMovie.LibraryAddedAt,
 		MetadataUpdatedAt: pgTimestamptzToTimePtr(dbMovie.MetadataUpdatedAt),
 		RadarrID:          dbMovie.RadarrID,
+		LockedFields:      dbMovie.LockedFields,
+		MetadataSources:   unmarshalStringMap(dbMovie.MetadataSources),
+		ProviderIDs:       unmarshalStringMap(dbMovie.ProviderIds),
+		ImagePlaceholders: content.ParseImagePlaceholders(dbMovie.ImagePlaceholders),
 		CreatedAt:         dbMovie.CreatedAt,
 		UpdatedAt:         dbMovie.UpdatedAt,
 	}
@@ -131,6 +138,14 @@ func pgTimestamptzToTimePtr(t pgtype.Timestamptz) *time.Time {
 	return &t.Time
 }
 
+func pgUUIDToUUIDPtr(u pgtype.UUID) *uuid.UUID {
+	if !u.Valid {
+		return nil
+	}
+	id := uuid.UUID(u.Bytes)
+	return &id
+}
+
 func pgNumericToDecimalPtr(n pgtype.Numeric) *decimal.Decimal {
 	if !n.Valid {
 		return nil
@@ -203,6 +218,7 @@ func dbContinueWatchingRowToMovie(row moviedb.ListContinueWatchingRow) *Movie {
 		IMDbID:            row.ImdbID,
 		Title:             row.Title,
 		OriginalTitle:     row.OriginalTitle,
+		SortTitle:         row.SortTitle,
 		Year:              row.Year,
 		ReleaseDate:       pgDateToTimePtr(row.ReleaseDate),
 		Runtime:           row.Runtime,
@@ -214,8 +230,10 @@ func dbContinueWatchingRowToMovie(row moviedb.ListContinueWatchingRow) *Movie {
 		TaglinesI18n:      unmarshalStringMap(row.TaglinesI18n),
 		OverviewsI18n:     unmarshalStringMap(row.OverviewsI18n),
 		AgeRatings:        unmarshalNestedStringMap(row.AgeRatings),
+		MinAge:            row.MinAge,
 		PosterPath:        row.PosterPath,
 		BackdropPath:      row.BackdropPath,
+		ImagePlaceholders: content.ParseImagePlaceholders(row.ImagePlaceholders),
 		TrailerURL:        row.TrailerUrl,
 		VoteAverage:       pgNumericToDecimalPtr(row.VoteAverage),
 		VoteCount:         row.VoteCount,
@@ -238,6 +256,7 @@ func dbWatchedMovieRowToMovie(row moviedb.ListWatchedMoviesRow) *Movie {
 		IMDbID:            row.ImdbID,
 		Title:             row.Title,
 		OriginalTitle:     row.OriginalTitle,
+		SortTitle:         row.SortTitle,
 		Year:              row.Year,
 		ReleaseDate:       pgDateToTimePtr(row.ReleaseDate),
 		Runtime:           row.Runtime,
@@ -249,8 +268,10 @@ func dbWatchedMovieRowToMovie(row moviedb.ListWatchedMoviesRow) *Movie {
 		TaglinesI18n:      unmarshalStringMap(row.TaglinesI18n),
 		OverviewsI18n:     unmarshalStringMap(row.OverviewsI18n),
 		AgeRatings:        unmarshalNestedStringMap(row.AgeRatings),
+		MinAge:            row.MinAge,
 		PosterPath:        row.PosterPath,
 		BackdropPath:      row.BackdropPath,
+		ImagePlaceholders: content.ParseImagePlaceholders(row.ImagePlaceholders),
 		TrailerURL:        row.TrailerUrl,
 		VoteAverage:       pgNumericToDecimalPtr(row.VoteAverage),
 		VoteCount:         row.VoteCount,
@@ -368,10 +389,14 @@ func unmarshalExternalRatings(data []byte) []ExternalRating {
 }
 
 func (r *postgresRepository) ListMovies(ctx context.Context, filters ListFilters) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbMovies, err := r.queries.ListMovies(ctx, moviedb.ListMoviesParams{
-		Limit:   filters.Limit,
-		Offset:  filters.Offset,
-		OrderBy: &filters.OrderBy,
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		OrderBy:      &filters.OrderBy,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list movies: %w", err)
@@ -383,15 +408,26 @@ func (r *postgresRepository) ListMovies(ctx context.Context, filters ListFilters
 	return movies, nil
 }
 
-func (r *postgresRepository) CountMovies(ctx context.Context) (int64, error) {
-	return r.queries.CountMovies(ctx)
+func (r *postgresRepository) CountMovies(ctx context.Context, libraryPaths []string) (int64, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	return r.queries.CountMovies(ctx, moviedb.CountMoviesParams{
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
 }
 
 func (r *postgresRepository) SearchMoviesByTitle(ctx context.Context, query string, limit, offset int32) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbMovies, err := r.queries.SearchMoviesByTitle(ctx, moviedb.SearchMoviesByTitleParams{
-		Title:  query,
-		Limit:  limit,
-		Offset: offset,
+		Title:        query,
+		Limit:        limit,
+		Offset:       offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to search movies: %w", err)
@@ -404,10 +440,14 @@ func (r *postgresRepository) SearchMoviesByTitle(ctx context.Context, query stri
 }
 
 func (r *postgresRepository) SearchMoviesByTitleAnyLanguage(ctx context.Context, query string, limit, offset int32) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbMovies, err := r.queries.SearchMoviesByTitleAnyLanguage(ctx, moviedb.SearchMoviesByTitleAnyLanguageParams{
-		Column1: &query,
-		Limit:   limit,
-		Offset:  offset,
+		Column1:      &query,
+		Limit:        limit,
+		Offset:       offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to search movies in any language: %w", err)
@@ -435,10 +475,16 @@ func (r *postgresRepository) ListMoviesByYear(ctx context.Context, year int32, l
 	return movies, nil
 }
 
-func (r *postgresRepository) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Movie, error) {
+func (r *postgresRepository) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbMovies, err := r.queries.ListRecentlyAdded(ctx, moviedb.ListRecentlyAddedParams{
-		Limit:  limit,
-		Offset: offset,
+		Limit:        limit,
+		Offset:       offset,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list recently added movies: %w", err)
@@ -450,11 +496,17 @@ func (r *postgresRepository) ListRecentlyAdded(ctx context.Context, limit, offse
 	return movies, nil
 }
 
-func (r *postgresRepository) ListTopRated(ctx context.Context, minVotes int32, limit, offset int32) ([]Movie, error) {
+func (r *postgresRepository) ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit, offset int32) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbMovies, err := r.queries.ListTopRated(ctx, moviedb.ListTopRatedParams{
-		VoteCount: &minVotes,
-		Limit:     limit,
-		Offset:    offset,
+		VoteCount:    &minVotes,
+		Limit:        limit,
+		Offset:       offset,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list top rated movies: %w", err)
@@ -466,8 +518,34 @@ func (r *postgresRepository) ListTopRated(ctx context.Context, minVotes int32, l
 	return movies, nil
 }
 
-func (r *postgresRepository) CountTopRated(ctx context.Context, minVotes int32) (int64, error) {
-	return r.queries.CountTopRated(ctx, &minVotes)
+func (r *postgresRepository) ListMoviesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Movie, error) {
+	dbMovies, err := r.queries.ListMoviesDueForRefresh(ctx, moviedb.ListMoviesDueForRefreshParams{
+		IncompleteBefore: cutoffs.IncompleteBefore,
+		ActiveSince:      pgtype.Date{Time: cutoffs.ActiveSince, Valid: true},
+		ActiveBefore:     cutoffs.ActiveBefore,
+		SettledBefore:    cutoffs.SettledBefore,
+		Limit:            limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movies due for refresh: %w", err)
+	}
+	movies := make([]Movie, len(dbMovies))
+	for i, m := range dbMovies {
+		movies[i] = *dbMovieToMovie(m)
+	}
+	return movies, nil
+}
+
+func (r *postgresRepository) CountTopRated(ctx context.Context, minVotes int32, libraryPaths []string) (int64, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	return r.queries.CountTopRated(ctx, moviedb.CountTopRatedParams{
+		VoteCount:    &minVotes,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
 }
 
 func (r *postgresRepository) CreateMovie(ctx context.Context, params CreateMovieParams) (*Movie, error) {
@@ -498,6 +576,8 @@ func (r *postgresRepository) CreateMovie(ctx context.Context, params CreateMovie
 		Revenue:           params.Revenue,
 		RadarrID:          params.RadarrID,
 		MetadataUpdatedAt: stringToPgTimestamptz(params.MetadataUpdatedAt),
+		SortTitle:         params.SortTitle,
+		MinAge:            content.MinimumAge(params.AgeRatings),
 	}
 	movie, err := r.queries.CreateMovie(ctx, dbParams)
 	if err != nil {
@@ -510,7 +590,9 @@ func (r *postgresRepository) UpdateMovie(ctx context.Context, params UpdateMovie
 	// Convert maps to []byte for JSONB fields (only if not nil)
 	var titlesI18n, taglinesI18n, overviewsI18n []byte
 	var ageRatings []byte
+	var minAge *int32
 	var externalRatings []byte
+	var metadataSources, providerIDs []byte
 
 	if params.TitlesI18n != nil {
 		titlesI18n = marshalStringMap(params.TitlesI18n)
@@ -523,10 +605,17 @@ func (r *postgresRepository) UpdateMovie(ctx context.Context, params UpdateMovie
 	}
 	if params.AgeRatings != nil {
 		ageRatings = marshalNestedStringMap(params.AgeRatings)
+		minAge = content.MinimumAge(params.AgeRatings)
 	}
 	if params.ExternalRatings != nil {
 		externalRatings = marshalExternalRatings(params.ExternalRatings)
 	}
+	if params.MetadataSources != nil {
+		metadataSources = marshalStringMap(params.MetadataSources)
+	}
+	if params.ProviderIDs != nil {
+		providerIDs = marshalStringMap(params.ProviderIDs)
+	}
 
 	dbParams := moviedb.UpdateMovieParams{
 		ID:                params.ID,
@@ -545,6 +634,7 @@ func (r *postgresRepository) UpdateMovie(ctx context.Context, params UpdateMovie
 		TaglinesI18n:      taglinesI18n,
 		OverviewsI18n:     overviewsI18n,
 		AgeRatings:        ageRatings,
+		MinAge:            minAge,
 		ExternalRatings:   externalRatings,
 		PosterPath:        params.PosterPath,
 		BackdropPath:      params.BackdropPath,
@@ -556,6 +646,9 @@ func (r *postgresRepository) UpdateMovie(ctx context.Context, params UpdateMovie
 		Revenue:           params.Revenue,
 		RadarrID:          params.RadarrID,
 		MetadataUpdatedAt: stringToPgTimestamptz(params.MetadataUpdatedAt),
+		SortTitle:         params.SortTitle,
+		MetadataSources:   metadataSources,
+		ProviderIds:       providerIDs,
 	}
 	movie, err := r.queries.UpdateMovie(ctx, dbParams)
 	if err != nil {
@@ -567,6 +660,44 @@ func (r *postgresRepository) UpdateMovie(ctx context.Context, params UpdateMovie
 	return dbMovieToMovie(movie), nil
 }
 
+// SetMovieLockedFields replaces the set of locked metadata fields of a movie.
+func (r *postgresRepository) SetMovieLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Movie, error) {
+	if fields == nil {
+		fields = []string{}
+	}
+	movie, err := r.queries.SetMovieLockedFields(ctx, moviedb.SetMovieLockedFieldsParams{
+		ID:           id,
+		LockedFields: fields,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMovieNotFound
+		}
+		return nil, fmt.Errorf("failed to set locked fields: %w", err)
+	}
+	return dbMovieToMovie(movie), nil
+}
+
+// SetMovieImagePlaceholder stores the placeholder of one of a movie's images.
+func (r *postgresRepository) SetMovieImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	data, err := json.Marshal(placeholder)
+	if err != nil {
+		return fmt.Errorf("failed to marshal placeholder: %w", err)
+	}
+	rows, err := r.queries.SetMovieImagePlaceholder(ctx, moviedb.SetMovieImagePlaceholderParams{
+		ID:          id,
+		Path:        path,
+		Placeholder: data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set image placeholder: %w", err)
+	}
+	if rows == 0 {
+		return ErrMovieNotFound
+	}
+	return nil
+}
+
 func (r *postgresRepository) DeleteMovie(ctx context.Context, id uuid.UUID) error {
 	return r.queries.DeleteMovie(ctx, id)
 }
@@ -586,6 +717,7 @@ func (r *postgresRepository) CreateMovieFile(ctx context.Context, params CreateM
 		AudioLanguages:    params.AudioLanguages,
 		SubtitleLanguages: params.SubtitleLanguages,
 		RadarrFileID:      params.RadarrFileID,
+		Edition:           params.Edition,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create movie file: %w", err)
@@ -666,10 +798,80 @@ func (r *postgresRepository) DeleteMovieFile(ctx context.Context, id uuid.UUID)
 	return r.queries.DeleteMovieFile(ctx, id)
 }
 
+func (r *postgresRepository) AssignMovieFileMatch(ctx context.Context, fileID, movieID uuid.UUID, locked bool) (*MovieFile, error) {
+	file, err := r.queries.AssignMovieFileMatch(ctx, moviedb.AssignMovieFileMatchParams{
+		ID:          fileID,
+		MovieID:     movieID,
+		MatchLocked: locked,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMovieFileNotFound
+		}
+		return nil, fmt.Errorf("failed to assign movie file match: %w", err)
+	}
+	return dbMovieFileToMovieFile(file), nil
+}
+
+func (r *postgresRepository) SetMovieFileMatchLocked(ctx context.Context, fileID uuid.UUID, locked bool) (*MovieFile, error) {
+	file, err := r.queries.SetMovieFileMatchLocked(ctx, moviedb.SetMovieFileMatchLockedParams{
+		ID:          fileID,
+		MatchLocked: locked,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMovieFileNotFound
+		}
+		return nil, fmt.Errorf("failed to set movie file match lock: %w", err)
+	}
+	return dbMovieFileToMovieFile(file), nil
+}
+
+func (r *postgresRepository) UpsertMovieExtra(ctx context.Context, params UpsertMovieExtraParams) (*MovieExtra, error) {
+	extra, err := r.queries.UpsertMovieExtra(ctx, moviedb.UpsertMovieExtraParams{
+		MovieID:         params.MovieID,
+		ExtraType:       params.ExtraType,
+		Title:           params.Title,
+		FilePath:        params.FilePath,
+		FileSize:        params.FileSize,
+		Container:       params.Container,
+		DurationSeconds: params.DurationSeconds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert movie extra: %w", err)
+	}
+	return dbMovieExtraToMovieExtra(extra), nil
+}
+
+func (r *postgresRepository) GetMovieExtra(ctx context.Context, id uuid.UUID) (*MovieExtra, error) {
+	extra, err := r.queries.GetMovieExtra(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMovieExtraNotFound
+		}
+		return nil, fmt.Errorf("failed to get movie extra: %w", err)
+	}
+	return dbMovieExtraToMovieExtra(extra), nil
+}
+
+func (r *postgresRepository) ListMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieExtra, error) {
+	dbExtras, err := r.queries.ListMovieExtras(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movie extras: %w", err)
+	}
+	extras := make([]MovieExtra, len(dbExtras))
+	for i, e := range dbExtras {
+		extras[i] = *dbMovieExtraToMovieExtra(e)
+	}
+	return extras, nil
+}
+
 func (r *postgresRepository) CreateMovieCredit(ctx context.Context, params CreateMovieCreditParams) (*MovieCredit, error) {
 	credit, err := r.queries.CreateMovieCredit(ctx, moviedb.CreateMovieCreditParams{
 		MovieID:      params.MovieID,
 		TmdbPersonID: params.TMDbPersonID,
+		TvdbPersonID: params.TVDbPersonID,
+		ImdbPersonID: params.IMDbPersonID,
 		Name:         params.Name,
 		CreditType:   params.CreditType,
 		Character:    params.Character,
@@ -847,6 +1049,7 @@ func dbCreditToCredit(dbCredit moviedb.MovieCredit) *MovieCredit {
 		Department:   dbCredit.Department,
 		CastOrder:    dbCredit.CastOrder,
 		ProfilePath:  dbCredit.ProfilePath,
+		PersonID:     pgUUIDToUUIDPtr(dbCredit.PersonID),
 		CreatedAt:    dbCredit.CreatedAt,
 		UpdatedAt:    dbCredit.UpdatedAt,
 	}
@@ -879,11 +1082,29 @@ func dbMovieFileToMovieFile(dbFile moviedb.MovieFile) *MovieFile {
 		AudioLanguages:    dbFile.AudioLanguages,
 		SubtitleLanguages: dbFile.SubtitleLanguages,
 		RadarrFileID:      dbFile.RadarrFileID,
+		MatchLocked:       dbFile.MatchLocked,
+		Edition:           dbFile.Edition,
 		CreatedAt:         dbFile.CreatedAt,
 		UpdatedAt:         dbFile.UpdatedAt,
 	}
 }
 
+// dbMovieExtraToMovieExtra converts a database movie extra to a domain movie extra
+func dbMovieExtraToMovieExtra(dbExtra moviedb.MovieMovieExtra) *MovieExtra {
+	return &MovieExtra{
+		ID:              dbExtra.ID,
+		MovieID:         dbExtra.MovieID,
+		ExtraType:       dbExtra.ExtraType,
+		Title:           dbExtra.Title,
+		FilePath:        dbExtra.FilePath,
+		FileSize:        dbExtra.FileSize,
+		Container:       dbExtra.Container,
+		DurationSeconds: dbExtra.DurationSeconds,
+		CreatedAt:       dbExtra.CreatedAt,
+		UpdatedAt:       dbExtra.UpdatedAt,
+	}
+}
+
 func (r *postgresRepository) AddMovieGenre(ctx context.Context, movieID uuid.UUID, slug, name string) error {
 	return r.queries.AddMovieGenre(ctx, moviedb.AddMovieGenreParams{
 		MovieID: movieID,
@@ -924,11 +1145,17 @@ func (r *postgresRepository) DeleteMovieGenres(ctx context.Context, movieID uuid
 	return r.queries.DeleteMovieGenres(ctx, movieID)
 }
 
-func (r *postgresRepository) ListMoviesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Movie, error) {
+func (r *postgresRepository) ListMoviesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbMovies, err := r.queries.ListMoviesByGenre(ctx, moviedb.ListMoviesByGenreParams{
-		Slug:   slug,
-		Limit:  limit,
-		Offset: offset,
+		Slug:         slug,
+		Limit:        limit,
+		Offset:       offset,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list movies by genre: %w", err)
@@ -940,6 +1167,93 @@ func (r *postgresRepository) ListMoviesByGenre(ctx context.Context, slug string,
 	return movies, nil
 }
 
+func (r *postgresRepository) AddMovieTag(ctx context.Context, movieID uuid.UUID, slug, name string, spoiler bool) error {
+	return r.queries.AddMovieTag(ctx, moviedb.AddMovieTagParams{
+		MovieID: movieID,
+		Slug:    slug,
+		Name:    name,
+		Spoiler: spoiler,
+	})
+}
+
+func (r *postgresRepository) ListMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error) {
+	dbTags, err := r.queries.ListMovieTags(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movie tags: %w", err)
+	}
+	tags := make([]MovieTag, len(dbTags))
+	for i, t := range dbTags {
+		tags[i] = MovieTag{
+			ID:        t.ID,
+			MovieID:   t.MovieID,
+			Slug:      t.Slug,
+			Name:      t.Name,
+			Spoiler:   t.Spoiler,
+			CreatedAt: t.CreatedAt,
+		}
+	}
+	return tags, nil
+}
+
+func (r *postgresRepository) ListDistinctMovieTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	rows, err := r.queries.ListDistinctMovieTags(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list distinct movie tags: %w", err)
+	}
+	tags := make([]content.TagSummary, len(rows))
+	for i, r := range rows {
+		tags[i] = content.TagSummary{
+			Slug:      r.Slug,
+			Name:      r.Name,
+			ItemCount: r.ItemCount,
+		}
+	}
+	return tags, nil
+}
+
+func (r *postgresRepository) DeleteMovieTags(ctx context.Context, movieID uuid.UUID) error {
+	return r.queries.DeleteMovieTags(ctx, movieID)
+}
+
+func (r *postgresRepository) ListMoviesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Movie, error) {
+	dbMovies, err := r.queries.ListMoviesPendingTagImport(ctx, moviedb.ListMoviesPendingTagImportParams{
+		After: after,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movies pending tag import: %w", err)
+	}
+	movies := make([]Movie, len(dbMovies))
+	for i, m := range dbMovies {
+		movies[i] = *dbMovieToMovie(m)
+	}
+	return movies, nil
+}
+
+func (r *postgresRepository) SetMovieTagsImported(ctx context.Context, id uuid.UUID) error {
+	return r.queries.SetMovieTagsImported(ctx, id)
+}
+
+func (r *postgresRepository) ListMoviesByTag(ctx context.Context, slug string, limit, offset int32) ([]Movie, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	dbMovies, err := r.queries.ListMoviesByTag(ctx, moviedb.ListMoviesByTagParams{
+		Slug:         slug,
+		Limit:        limit,
+		Offset:       offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list movies by tag: %w", err)
+	}
+	movies := make([]Movie, len(dbMovies))
+	for i, m := range dbMovies {
+		movies[i] = *dbMovieToMovie(m)
+	}
+	return movies, nil
+}
+
 func (r *postgresRepository) CreateOrUpdateWatchProgress(ctx context.Context, params CreateWatchProgressParams) (*MovieWatched, error) {
 	watched, err := r.queries.CreateOrUpdateWatchProgress(ctx, moviedb.CreateOrUpdateWatchProgressParams{
 		UserID:          params.UserID,
@@ -975,10 +1289,16 @@ func (r *postgresRepository) DeleteWatchProgress(ctx context.Context, userID, mo
 	})
 }
 
-func (r *postgresRepository) ListContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+func (r *postgresRepository) ListContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	rows, err := r.queries.ListContinueWatching(ctx, moviedb.ListContinueWatchingParams{
-		UserID: userID,
-		Limit:  limit,
+		UserID:       userID,
+		Limit:        limit,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list continue watching: %w", err)
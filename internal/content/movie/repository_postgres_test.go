@@ -4,12 +4,15 @@ import (
 	"context"
 	"math/rand/v2"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/testutil"
 )
 
@@ -176,6 +179,35 @@ func TestRepo_UpdateMovie(t *testing.T) {
 	assert.Equal(t, "Updated Title", got.Title)
 }
 
+func TestRepo_ListMoviesDueForRefresh(t *testing.T) {
+	t.Parallel()
+	repo, _ := setupTestRepo(t)
+	ctx := context.Background()
+
+	m := createTestMovie(t, repo, "Due For Refresh")
+	hourAgo := time.Now().Add(-time.Hour)
+	cutoffs := content.RefreshCutoffs{
+		IncompleteBefore: hourAgo,
+		ActiveBefore:     hourAgo,
+		SettledBefore:    hourAgo,
+		ActiveSince:      time.Now().AddDate(-1, 0, 0),
+	}
+
+	due, err := repo.ListMoviesDueForRefresh(ctx, cutoffs, 50)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(due, func(d Movie) bool { return d.ID == m.ID }), "never refreshed movie is due")
+
+	_, err = repo.UpdateMovie(ctx, UpdateMovieParams{
+		ID:                m.ID,
+		MetadataUpdatedAt: new(time.Now().UTC().Format(time.RFC3339)),
+	})
+	require.NoError(t, err)
+
+	due, err = repo.ListMoviesDueForRefresh(ctx, cutoffs, 50)
+	require.NoError(t, err)
+	assert.False(t, slices.ContainsFunc(due, func(d Movie) bool { return d.ID == m.ID }), "refreshed movie leaves the due list")
+}
+
 func TestRepo_DeleteMovie(t *testing.T) {
 	t.Parallel()
 	repo, _ := setupTestRepo(t)
@@ -241,7 +273,7 @@ func TestRepo_CountMovies(t *testing.T) {
 	createTestMovie(t, repo, "Count1")
 	createTestMovie(t, repo, "Count2")
 
-	count, err := repo.CountMovies(ctx)
+	count, err := repo.CountMovies(ctx, nil)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, count, int64(2))
 }
@@ -267,7 +299,7 @@ func TestRepo_ListRecentlyAdded(t *testing.T) {
 	createTestMovie(t, repo, "Recent Film One")
 	createTestMovie(t, repo, "Recent Film Two")
 
-	movies, err := repo.ListRecentlyAdded(ctx, 5, 0)
+	movies, err := repo.ListRecentlyAdded(ctx, nil, 5, 0)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, len(movies), 2)
 }
@@ -286,7 +318,7 @@ func TestRepo_ListTopRated(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	movies, err := repo.ListTopRated(ctx, 100, 10, 0)
+	movies, err := repo.ListTopRated(ctx, 100, nil, 10, 0)
 	require.NoError(t, err)
 	// At least our movie should be in top rated with 500 votes > 100 min
 	found := false
@@ -516,7 +548,7 @@ func TestRepo_Genres(t *testing.T) {
 	assert.GreaterOrEqual(t, len(distinct), 2)
 
 	// List movies by genre
-	genreMovies, err := repo.ListMoviesByGenre(ctx, "action", 10, 0)
+	genreMovies, err := repo.ListMoviesByGenre(ctx, "action", nil, 10, 0)
 	require.NoError(t, err)
 	require.GreaterOrEqual(t, len(genreMovies), 1)
 	assert.Equal(t, m.ID, genreMovies[0].ID)
@@ -612,7 +644,7 @@ func TestRepo_ContinueWatching(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	items, err := repo.ListContinueWatching(ctx, user.ID, 10)
+	items, err := repo.ListContinueWatching(ctx, user.ID, nil, 10)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, len(items), 2)
 
@@ -622,6 +654,11 @@ func TestRepo_ContinueWatching(t *testing.T) {
 		assert.Greater(t, item.ProgressSeconds, int32(0))
 		assert.Greater(t, item.DurationSeconds, int32(0))
 	}
+
+	// Movies without a file under the library paths are left out.
+	items, err = repo.ListContinueWatching(ctx, user.ID, []string{"/nonexistent/"}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, items)
 }
 
 func TestRepo_WatchHistory(t *testing.T) {
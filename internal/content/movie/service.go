@@ -3,9 +3,11 @@ package movie
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // Service defines business logic for movies
@@ -17,8 +19,8 @@ type Service interface {
 	ListMovies(ctx context.Context, filters ListFilters) ([]Movie, error)
 	CountMovies(ctx context.Context) (int64, error)
 	SearchMovies(ctx context.Context, query string, filters SearchFilters) ([]Movie, error)
-	ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Movie, int64, error)
-	ListTopRated(ctx context.Context, minVotes int32, limit, offset int32) ([]Movie, int64, error)
+	ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Movie, int64, error)
+	ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit, offset int32) ([]Movie, int64, error)
 	CreateMovie(ctx context.Context, params CreateMovieParams) (*Movie, error)
 	UpdateMovie(ctx context.Context, params UpdateMovieParams) (*Movie, error)
 	DeleteMovie(ctx context.Context, id uuid.UUID) error
@@ -27,6 +29,11 @@ type Service interface {
 	GetMovieFiles(ctx context.Context, movieID uuid.UUID) ([]MovieFile, error)
 	CreateMovieFile(ctx context.Context, params CreateMovieFileParams) (*MovieFile, error)
 	DeleteMovieFile(ctx context.Context, id uuid.UUID) error
+	GetMovieEditions(ctx context.Context, movieID uuid.UUID) ([]Edition, error)
+
+	// Extras
+	GetMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieExtra, error)
+	GetMovieExtra(ctx context.Context, id uuid.UUID) (*MovieExtra, error)
 
 	// Credits
 	GetMovieCast(ctx context.Context, movieID uuid.UUID, limit, offset int32) ([]MovieCredit, int64, error)
@@ -39,20 +46,36 @@ type Service interface {
 
 	// Genres
 	GetMovieGenres(ctx context.Context, movieID uuid.UUID) ([]MovieGenre, error)
-	GetMoviesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Movie, error)
+	GetMoviesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Movie, error)
 	ListDistinctGenres(ctx context.Context) ([]content.GenreSummary, error)
 
+	// Tags
+	GetMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error)
+	GetMoviesByTag(ctx context.Context, slug string, limit, offset int32) ([]Movie, error)
+	ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error)
+
 	// Watch progress
 	UpdateWatchProgress(ctx context.Context, userID, movieID uuid.UUID, progressSeconds, durationSeconds int32) (*MovieWatched, error)
 	GetWatchProgress(ctx context.Context, userID, movieID uuid.UUID) (*MovieWatched, error)
 	MarkAsWatched(ctx context.Context, userID, movieID uuid.UUID) error
 	RemoveWatchProgress(ctx context.Context, userID, movieID uuid.UUID) error
-	GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error)
+	GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error)
 	GetWatchHistory(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]WatchedMovieItem, error)
 	GetUserStats(ctx context.Context, userID uuid.UUID) (*UserMovieStats, error)
 
 	// Metadata refresh
 	RefreshMovieMetadata(ctx context.Context, id uuid.UUID, opts ...MetadataRefreshOptions) error
+	ListMoviesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Movie, error)
+	ListMoviesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Movie, error)
+	ImportMovieTags(ctx context.Context, id uuid.UUID) error
+
+	// Manual metadata editing
+	UpdateMovieMetadata(ctx context.Context, id uuid.UUID, edit MetadataEdit) (*Movie, error)
+	UnlockMovieFields(ctx context.Context, id uuid.UUID, fields ...string) (*Movie, error)
+	RestoreMovieMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Movie, error)
+
+	// Image placeholders
+	SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
 }
 
 // movieService implements the Service interface
@@ -91,7 +114,7 @@ func (s *movieService) ListMovies(ctx context.Context, filters ListFilters) ([]M
 
 // CountMovies returns the total number of movies
 func (s *movieService) CountMovies(ctx context.Context) (int64, error) {
-	return s.repo.CountMovies(ctx)
+	return s.repo.CountMovies(ctx, nil)
 }
 
 // SearchMovies searches for movies by title
@@ -99,26 +122,30 @@ func (s *movieService) SearchMovies(ctx context.Context, query string, filters S
 	return s.repo.SearchMoviesByTitle(ctx, query, filters.Limit, filters.Offset)
 }
 
-// ListRecentlyAdded returns recently added movies with total count
-func (s *movieService) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Movie, int64, error) {
-	movies, err := s.repo.ListRecentlyAdded(ctx, limit, offset)
+// ListRecentlyAdded returns recently added movies with total count. A
+// non-nil libraryPaths limits them to movies with a file under one of the
+// path prefixes.
+func (s *movieService) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Movie, int64, error) {
+	movies, err := s.repo.ListRecentlyAdded(ctx, libraryPaths, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
-	count, err := s.repo.CountMovies(ctx)
+	count, err := s.repo.CountMovies(ctx, libraryPaths)
 	if err != nil {
 		return nil, 0, err
 	}
 	return movies, count, nil
 }
 
-// ListTopRated returns top-rated movies with total count
-func (s *movieService) ListTopRated(ctx context.Context, minVotes int32, limit, offset int32) ([]Movie, int64, error) {
-	movies, err := s.repo.ListTopRated(ctx, minVotes, limit, offset)
+// ListTopRated returns top-rated movies with total count. A non-nil
+// libraryPaths limits them to movies with a file under one of the path
+// prefixes.
+func (s *movieService) ListTopRated(ctx context.Context, minVotes int32, libraryPaths []string, limit, offset int32) ([]Movie, int64, error) {
+	movies, err := s.repo.ListTopRated(ctx, minVotes, libraryPaths, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
-	count, err := s.repo.CountTopRated(ctx, minVotes)
+	count, err := s.repo.CountTopRated(ctx, minVotes, libraryPaths)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -162,6 +189,25 @@ func (s *movieService) GetMovieFiles(ctx context.Context, movieID uuid.UUID) ([]
 	return s.repo.ListMovieFilesByMovieID(ctx, movieID)
 }
 
+// GetMovieEditions returns a movie's files grouped by edition
+func (s *movieService) GetMovieEditions(ctx context.Context, movieID uuid.UUID) ([]Edition, error) {
+	files, err := s.repo.ListMovieFilesByMovieID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+	return GroupEditions(files), nil
+}
+
+// GetMovieExtras returns the trailers, featurettes and other extras of a movie
+func (s *movieService) GetMovieExtras(ctx context.Context, movieID uuid.UUID) ([]MovieExtra, error) {
+	return s.repo.ListMovieExtras(ctx, movieID)
+}
+
+// GetMovieExtra retrieves a single extra by ID
+func (s *movieService) GetMovieExtra(ctx context.Context, id uuid.UUID) (*MovieExtra, error) {
+	return s.repo.GetMovieExtra(ctx, id)
+}
+
 // CreateMovieFile creates a new movie file
 func (s *movieService) CreateMovieFile(ctx context.Context, params CreateMovieFileParams) (*MovieFile, error) {
 	// Verify movie exists
@@ -230,9 +276,10 @@ func (s *movieService) GetMovieGenres(ctx context.Context, movieID uuid.UUID) ([
 	return s.repo.ListMovieGenres(ctx, movieID)
 }
 
-// GetMoviesByGenre returns movies filtered by genre
-func (s *movieService) GetMoviesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Movie, error) {
-	return s.repo.ListMoviesByGenre(ctx, slug, limit, offset)
+// GetMoviesByGenre returns movies filtered by genre. A non-nil libraryPaths
+// limits them to movies with a file under one of the path prefixes.
+func (s *movieService) GetMoviesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Movie, error) {
+	return s.repo.ListMoviesByGenre(ctx, slug, libraryPaths, limit, offset)
 }
 
 // ListDistinctGenres returns all distinct movie genres with item counts.
@@ -240,6 +287,21 @@ func (s *movieService) ListDistinctGenres(ctx context.Context) ([]content.GenreS
 	return s.repo.ListDistinctMovieGenres(ctx)
 }
 
+// GetMovieTags returns keywords and tags for a movie, including spoiler tags
+func (s *movieService) GetMovieTags(ctx context.Context, movieID uuid.UUID) ([]MovieTag, error) {
+	return s.repo.ListMovieTags(ctx, movieID)
+}
+
+// GetMoviesByTag returns movies carrying a tag
+func (s *movieService) GetMoviesByTag(ctx context.Context, slug string, limit, offset int32) ([]Movie, error) {
+	return s.repo.ListMoviesByTag(ctx, slug, limit, offset)
+}
+
+// ListDistinctTags returns the most used non-spoiler movie tags with item counts.
+func (s *movieService) ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	return s.repo.ListDistinctMovieTags(ctx, limit)
+}
+
 // UpdateWatchProgress updates or creates watch progress for a user
 func (s *movieService) UpdateWatchProgress(ctx context.Context, userID, movieID uuid.UUID, progressSeconds, durationSeconds int32) (*MovieWatched, error) {
 	// Verify movie exists
@@ -302,9 +364,11 @@ func (s *movieService) RemoveWatchProgress(ctx context.Context, userID, movieID
 	return s.repo.DeleteWatchProgress(ctx, userID, movieID)
 }
 
-// GetContinueWatching returns movies the user is currently watching
-func (s *movieService) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
-	return s.repo.ListContinueWatching(ctx, userID, limit)
+// GetContinueWatching returns movies the user is currently watching. A
+// non-nil libraryPaths limits them to movies with a file under one of the
+// path prefixes.
+func (s *movieService) GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	return s.repo.ListContinueWatching(ctx, userID, libraryPaths, limit)
 }
 
 // GetWatchHistory returns the user's watch history
@@ -317,6 +381,60 @@ func (s *movieService) GetUserStats(ctx context.Context, userID uuid.UUID) (*Use
 	return s.repo.GetUserMovieStats(ctx, userID)
 }
 
+// ListMoviesDueForRefresh returns movies whose metadata is older than the
+// cutoff of their class, least recently refreshed first.
+func (s *movieService) ListMoviesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Movie, error) {
+	return s.repo.ListMoviesDueForRefresh(ctx, cutoffs, limit)
+}
+
+// ListMoviesPendingTagImport returns movies added before tags were supported
+// whose tags have not been imported yet, ordered by ID after the given one.
+func (s *movieService) ListMoviesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Movie, error) {
+	return s.repo.ListMoviesPendingTagImport(ctx, after, limit)
+}
+
+// ImportMovieTags imports the keywords and tags of a movie from the metadata
+// provider and marks them as imported.
+func (s *movieService) ImportMovieTags(ctx context.Context, id uuid.UUID) error {
+	if s.metadataProvider == nil {
+		return fmt.Errorf("metadata provider not configured")
+	}
+
+	mov, err := s.repo.GetMovie(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get movie: %w", err)
+	}
+
+	if mov.TMDbID != nil {
+		tags, err := s.metadataProvider.GetMovieTags(ctx, mov.ID, fmt.Sprintf("%d", *mov.TMDbID))
+		if err != nil {
+			return fmt.Errorf("get movie tags: %w", err)
+		}
+		if len(tags) > 0 {
+			s.replaceMovieTags(ctx, mov.ID, tags)
+		}
+	}
+
+	if err := s.repo.SetMovieTagsImported(ctx, mov.ID); err != nil {
+		return fmt.Errorf("mark movie tags imported: %w", err)
+	}
+	return nil
+}
+
+// replaceMovieTags replaces the keywords and tags of a movie.
+func (s *movieService) replaceMovieTags(ctx context.Context, movieID uuid.UUID, tags []MovieTag) {
+	_ = s.repo.DeleteMovieTags(ctx, movieID)
+	for _, tag := range tags {
+		_ = s.repo.AddMovieTag(ctx, movieID, tag.Slug, tag.Name, tag.Spoiler)
+	}
+}
+
+// SetImagePlaceholder stores the placeholder of the movie's poster or
+// backdrop at path.
+func (s *movieService) SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return s.repo.SetMovieImagePlaceholder(ctx, id, path, placeholder)
+}
+
 // RefreshMovieMetadata triggers a metadata refresh for a movie.
 // Options allow specifying force refresh and language overrides.
 func (s *movieService) RefreshMovieMetadata(ctx context.Context, id uuid.UUID, opts ...MetadataRefreshOptions) error {
@@ -332,12 +450,18 @@ func (s *movieService) RefreshMovieMetadata(ctx context.Context, id uuid.UUID, o
 	}
 
 	// Enrich with latest metadata from TMDb, passing options through
+	posterPath, backdropPath := mov.PosterPath, mov.BackdropPath
 	if err := s.metadataProvider.EnrichMovie(ctx, mov, opts...); err != nil {
 		return fmt.Errorf("enrich movie: %w", err)
 	}
+	keepLocalImage(&mov.PosterPath, posterPath)
+	keepLocalImage(&mov.BackdropPath, backdropPath)
 
-	// Build update params from enriched movie
+	// Build update params from enriched movie, keeping manually edited fields
 	params := movieToUpdateParams(mov)
+	params.ApplyLocks(mov.LockedFields)
+	// Record the refresh so the scheduled refresh moves on to other movies
+	params.MetadataUpdatedAt = new(time.Now().UTC().Format(time.RFC3339))
 
 	// Update the movie
 	if _, err := s.repo.UpdateMovie(ctx, params); err != nil {
@@ -354,6 +478,8 @@ func (s *movieService) RefreshMovieMetadata(ctx context.Context, id uuid.UUID, o
 				_, _ = s.repo.CreateMovieCredit(ctx, CreateMovieCreditParams{
 					MovieID:      credit.MovieID,
 					TMDbPersonID: credit.TMDbPersonID,
+					TVDbPersonID: credit.TVDbPersonID,
+					IMDbPersonID: credit.IMDbPersonID,
 					Name:         credit.Name,
 					CreditType:   credit.CreditType,
 					Character:    credit.Character,
@@ -366,13 +492,21 @@ func (s *movieService) RefreshMovieMetadata(ctx context.Context, id uuid.UUID, o
 		}
 
 		// Update genres
-		genres, err := s.metadataProvider.GetMovieGenres(ctx, mov.ID, fmt.Sprintf("%d", *mov.TMDbID))
-		if err == nil && len(genres) > 0 {
-			_ = s.repo.DeleteMovieGenres(ctx, mov.ID)
-			for _, genre := range genres {
-				_ = s.repo.AddMovieGenre(ctx, mov.ID, genre.Slug, genre.Name)
+		if !mov.LockedFields.Has(content.FieldGenres) {
+			genres, err := s.metadataProvider.GetMovieGenres(ctx, mov.ID, fmt.Sprintf("%d", *mov.TMDbID))
+			if err == nil && len(genres) > 0 {
+				_ = s.repo.DeleteMovieGenres(ctx, mov.ID)
+				for _, genre := range genres {
+					_ = s.repo.AddMovieGenre(ctx, mov.ID, genre.Slug, genre.Name)
+				}
 			}
 		}
+
+		// Update keywords and tags
+		tags, err := s.metadataProvider.GetMovieTags(ctx, mov.ID, fmt.Sprintf("%d", *mov.TMDbID))
+		if err == nil && len(tags) > 0 {
+			s.replaceMovieTags(ctx, mov.ID, tags)
+		}
 	}
 
 	return nil
@@ -404,6 +538,8 @@ func movieToUpdateParams(m *Movie) UpdateMovieParams {
 		Budget:           m.Budget,
 		Revenue:          m.Revenue,
 		RadarrID:         m.RadarrID,
+		MetadataSources:  m.MetadataSources,
+		ProviderIDs:      m.ProviderIDs,
 	}
 	if m.ReleaseDate != nil {
 		d := m.ReleaseDate.Format("2006-01-02")
@@ -129,10 +129,10 @@ func TestService_ListRecentlyAdded(t *testing.T) {
 	ctx := context.Background()
 	movies := []Movie{*newTestMovie()}
 
-	repo.On("ListRecentlyAdded", ctx, int32(10), int32(0)).Return(movies, nil)
-	repo.On("CountMovies", ctx).Return(int64(1), nil)
+	repo.On("ListRecentlyAdded", ctx, []string(nil), int32(10), int32(0)).Return(movies, nil)
+	repo.On("CountMovies", ctx, []string(nil)).Return(int64(1), nil)
 
-	result, total, err := svc.ListRecentlyAdded(ctx, 10, 0)
+	result, total, err := svc.ListRecentlyAdded(ctx, nil, 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, int64(1), total)
@@ -145,10 +145,10 @@ func TestService_ListTopRated(t *testing.T) {
 	ctx := context.Background()
 	movies := []Movie{*newTestMovie()}
 
-	repo.On("ListTopRated", ctx, int32(100), int32(10), int32(0)).Return(movies, nil)
-	repo.On("CountTopRated", ctx, int32(100)).Return(int64(1), nil)
+	repo.On("ListTopRated", ctx, int32(100), []string(nil), int32(10), int32(0)).Return(movies, nil)
+	repo.On("CountTopRated", ctx, int32(100), []string(nil)).Return(int64(1), nil)
 
-	result, total, err := svc.ListTopRated(ctx, 100, 10, 0)
+	result, total, err := svc.ListTopRated(ctx, 100, nil, 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, int64(1), total)
@@ -276,6 +276,30 @@ func TestService_GetMovieFiles(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestService_GetMovieEditions(t *testing.T) {
+	repo := new(MockMovieRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+	files := []MovieFile{
+		{ID: uuid.Must(uuid.NewV7()), FilePath: "/movies/Alien {edition-Theatrical}.mkv", Edition: new("Theatrical")},
+		{ID: uuid.Must(uuid.NewV7()), FilePath: "/movies/Alien {edition-Director's Cut}.mkv", Edition: new("Director's Cut")},
+		{ID: uuid.Must(uuid.NewV7()), FilePath: "/movies/Alien 2160p.mkv"},
+		{ID: uuid.Must(uuid.NewV7()), FilePath: "/movies/Alien 1080p.mkv"},
+	}
+
+	repo.On("ListMovieFilesByMovieID", ctx, movieID).Return(files, nil)
+
+	result, err := svc.GetMovieEditions(ctx, movieID)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.Empty(t, result[0].Name)
+	assert.Equal(t, []MovieFile{files[2], files[3]}, result[0].Files)
+	assert.Equal(t, "Director's Cut", result[1].Name)
+	assert.Equal(t, "Theatrical", result[2].Name)
+	repo.AssertExpectations(t)
+}
+
 func TestService_CreateMovieFile(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		repo := new(MockMovieRepository)
@@ -473,9 +497,9 @@ func TestService_GetMoviesByGenre(t *testing.T) {
 	ctx := context.Background()
 	movies := []Movie{*newTestMovie()}
 
-	repo.On("ListMoviesByGenre", ctx, "drama", int32(10), int32(0)).Return(movies, nil)
+	repo.On("ListMoviesByGenre", ctx, "drama", []string(nil), int32(10), int32(0)).Return(movies, nil)
 
-	result, err := svc.GetMoviesByGenre(ctx, "drama", 10, 0)
+	result, err := svc.GetMoviesByGenre(ctx, "drama", nil, 10, 0)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	repo.AssertExpectations(t)
@@ -664,9 +688,10 @@ func TestService_GetContinueWatching(t *testing.T) {
 		},
 	}
 
-	repo.On("ListContinueWatching", ctx, userID, int32(10)).Return(items, nil)
+	libraryPaths := []string{"/media/movies/"}
+	repo.On("ListContinueWatching", ctx, userID, libraryPaths, int32(10)).Return(items, nil)
 
-	result, err := svc.GetContinueWatching(ctx, userID, 10)
+	result, err := svc.GetContinueWatching(ctx, userID, libraryPaths, 10)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	repo.AssertExpectations(t)
@@ -723,3 +748,62 @@ func TestService_RefreshMovieMetadata(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "metadata provider not configured")
 }
+
+func TestService_RefreshMovieMetadata_RecordsRefresh(t *testing.T) {
+	repo := new(MockMovieRepository)
+	provider := new(MockMetadataProvider)
+	svc := NewService(repo, provider)
+	ctx := context.Background()
+	mov := &Movie{ID: uuid.Must(uuid.NewV7()), Title: "Fight Club"}
+
+	repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+	provider.On("EnrichMovie", ctx, mov).Return(nil)
+	repo.On("UpdateMovie", ctx, mock.MatchedBy(func(p UpdateMovieParams) bool {
+		if p.MetadataUpdatedAt == nil {
+			return false
+		}
+		refreshed, err := time.Parse(time.RFC3339, *p.MetadataUpdatedAt)
+		return err == nil && time.Since(refreshed) < time.Minute
+	})).Return(mov, nil)
+
+	require.NoError(t, svc.RefreshMovieMetadata(ctx, mov.ID))
+	repo.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
+
+func TestService_ImportMovieTags(t *testing.T) {
+	t.Run("replaces tags and marks them imported", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		provider := new(MockMetadataProvider)
+		svc := NewService(repo, provider)
+		ctx := context.Background()
+		mov := &Movie{ID: uuid.Must(uuid.NewV7()), TMDbID: new(int32(550)), Title: "Fight Club"}
+
+		repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+		provider.On("GetMovieTags", ctx, mov.ID, "550").Return([]MovieTag{
+			{MovieID: mov.ID, Slug: "dual-identity", Name: "dual identity", Spoiler: true},
+		}, nil)
+		repo.On("DeleteMovieTags", ctx, mov.ID).Return(nil)
+		repo.On("AddMovieTag", ctx, mov.ID, "dual-identity", "dual identity", true).Return(nil)
+		repo.On("SetMovieTagsImported", ctx, mov.ID).Return(nil)
+
+		require.NoError(t, svc.ImportMovieTags(ctx, mov.ID))
+		repo.AssertExpectations(t)
+		provider.AssertExpectations(t)
+	})
+
+	t.Run("leaves the movie pending when the provider fails", func(t *testing.T) {
+		repo := new(MockMovieRepository)
+		provider := new(MockMetadataProvider)
+		svc := NewService(repo, provider)
+		ctx := context.Background()
+		mov := &Movie{ID: uuid.Must(uuid.NewV7()), TMDbID: new(int32(550)), Title: "Fight Club"}
+
+		repo.On("GetMovie", ctx, mov.ID).Return(mov, nil)
+		provider.On("GetMovieTags", ctx, mov.ID, "550").Return(nil, errors.New("rate limited"))
+
+		err := svc.ImportMovieTags(ctx, mov.ID)
+		require.Error(t, err)
+		repo.AssertNotCalled(t, "SetMovieTagsImported", mock.Anything, mock.Anything)
+	})
+}
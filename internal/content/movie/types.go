@@ -1,6 +1,8 @@
 package movie
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,6 +17,7 @@ type Movie struct {
 	IMDbID            *string
 	Title             string
 	OriginalTitle     *string
+	SortTitle         *string
 	Year              *int32
 	ReleaseDate       *time.Time
 	Runtime           *int32
@@ -33,6 +36,10 @@ type Movie struct {
 	LibraryAddedAt    time.Time
 	MetadataUpdatedAt *time.Time
 	RadarrID          *int32
+	LockedFields      content.LockedFields      // Manually edited fields kept on refresh
+	MetadataSources   map[string]string         // {"title": "tmdb", "images": "fanarttv"}
+	ProviderIDs       map[string]string         // {"tmdb": "550", "anilist": "1234"}
+	ImagePlaceholders content.ImagePlaceholders // Placeholders of the poster and backdrop
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 
@@ -42,6 +49,7 @@ type Movie struct {
 	TaglinesI18n  map[string]string            // {"en": "Fear can hold you prisoner...", "de": "Angst kann dich gefangen halten..."}
 	OverviewsI18n map[string]string            // {"en": "Imprisoned in the 1940s...", "de": "In den 1940er Jahren eingesperrt..."}
 	AgeRatings    map[string]map[string]string // {"US": {"MPAA": "R"}, "DE": {"FSK": "12"}, "GB": {"BBFC": "15"}}
+	MinAge        *int32                       // Minimum viewer age normalized from AgeRatings, nil when unrated
 
 	// External ratings from various providers (IMDb, Rotten Tomatoes, Metacritic, TMDb, etc.)
 	ExternalRatings []ExternalRating
@@ -57,34 +65,7 @@ type ExternalRating = content.ExternalRating
 // 4. OriginalTitle field
 // 5. Default Title field
 func (m *Movie) GetTitle(lang string) string {
-	// Try requested language
-	if m.TitlesI18n != nil {
-		if title, ok := m.TitlesI18n[lang]; ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to English
-	if m.TitlesI18n != nil {
-		if title, ok := m.TitlesI18n["en"]; ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to original language
-	if m.OriginalLanguage != nil && *m.OriginalLanguage != "" && m.TitlesI18n != nil {
-		if title, ok := m.TitlesI18n[*m.OriginalLanguage]; ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to OriginalTitle
-	if m.OriginalTitle != nil && *m.OriginalTitle != "" {
-		return *m.OriginalTitle
-	}
-
-	// Final fallback to default field
-	return m.Title
+	return m.TitleIn(content.DefaultLanguageChain(lang))
 }
 
 // GetTagline returns the movie tagline in the preferred language with fallback chain:
@@ -93,33 +74,7 @@ func (m *Movie) GetTitle(lang string) string {
 // 3. Original language from TaglinesI18n
 // 4. Default Tagline field
 func (m *Movie) GetTagline(lang string) string {
-	// Try requested language
-	if m.TaglinesI18n != nil {
-		if tagline, ok := m.TaglinesI18n[lang]; ok && tagline != "" {
-			return tagline
-		}
-	}
-
-	// Fallback to English
-	if m.TaglinesI18n != nil {
-		if tagline, ok := m.TaglinesI18n["en"]; ok && tagline != "" {
-			return tagline
-		}
-	}
-
-	// Fallback to original language
-	if m.OriginalLanguage != nil && *m.OriginalLanguage != "" && m.TaglinesI18n != nil {
-		if tagline, ok := m.TaglinesI18n[*m.OriginalLanguage]; ok && tagline != "" {
-			return tagline
-		}
-	}
-
-	// Final fallback to default field
-	if m.Tagline != nil {
-		return *m.Tagline
-	}
-
-	return ""
+	return m.TaglineIn(content.DefaultLanguageChain(lang))
 }
 
 // GetOverview returns the movie overview in the preferred language with fallback chain:
@@ -128,33 +83,38 @@ func (m *Movie) GetTagline(lang string) string {
 // 3. Original language from OverviewsI18n
 // 4. Default Overview field
 func (m *Movie) GetOverview(lang string) string {
-	// Try requested language
-	if m.OverviewsI18n != nil {
-		if overview, ok := m.OverviewsI18n[lang]; ok && overview != "" {
-			return overview
-		}
-	}
+	return m.OverviewIn(content.DefaultLanguageChain(lang))
+}
 
-	// Fallback to English
-	if m.OverviewsI18n != nil {
-		if overview, ok := m.OverviewsI18n["en"]; ok && overview != "" {
-			return overview
-		}
+// TitleIn returns the first title found along the language chain, falling
+// back to the default Title field.
+func (m *Movie) TitleIn(chain content.LanguageChain) string {
+	if title, ok := chain.ResolveTitle(m.TitlesI18n, m.originalLanguage(), derefString(m.OriginalTitle)); ok {
+		return title
 	}
+	return m.Title
+}
 
-	// Fallback to original language
-	if m.OriginalLanguage != nil && *m.OriginalLanguage != "" && m.OverviewsI18n != nil {
-		if overview, ok := m.OverviewsI18n[*m.OriginalLanguage]; ok && overview != "" {
-			return overview
-		}
+// TaglineIn returns the first tagline found along the language chain,
+// falling back to the default Tagline field.
+func (m *Movie) TaglineIn(chain content.LanguageChain) string {
+	if tagline, ok := chain.Resolve(m.TaglinesI18n, m.originalLanguage()); ok {
+		return tagline
 	}
+	return derefString(m.Tagline)
+}
 
-	// Final fallback to default field
-	if m.Overview != nil {
-		return *m.Overview
+// OverviewIn returns the first overview found along the language chain,
+// falling back to the default Overview field.
+func (m *Movie) OverviewIn(chain content.LanguageChain) string {
+	if overview, ok := chain.Resolve(m.OverviewsI18n, m.originalLanguage()); ok {
+		return overview
 	}
+	return derefString(m.Overview)
+}
 
-	return ""
+func (m *Movie) originalLanguage() string {
+	return derefString(m.OriginalLanguage)
 }
 
 // GetAgeRating returns the age rating for a specific country and rating system.
@@ -235,15 +195,64 @@ type MovieFile struct {
 	RadarrFileID      *int32
 	LastScannedAt     *time.Time
 	IsMonitored       *bool
+	MatchLocked       bool    // Manually identified; scans leave the match alone
+	Edition           *string // Named version such as "Director's Cut"; nil for the default
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 }
 
+// Edition is a named version of a movie and the files that hold it
+type Edition struct {
+	Name  string // Empty for the default version
+	Files []MovieFile
+}
+
+// GroupEditions groups movie files by edition. The default version comes
+// first, named editions follow in alphabetical order.
+func GroupEditions(files []MovieFile) []Edition {
+	index := make(map[string]int)
+	var editions []Edition
+	for _, f := range files {
+		name := ""
+		if f.Edition != nil {
+			name = *f.Edition
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(editions)
+			index[name] = i
+			editions = append(editions, Edition{Name: name})
+		}
+		editions[i].Files = append(editions[i].Files, f)
+	}
+
+	slices.SortStableFunc(editions, func(a, b Edition) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return editions
+}
+
+// MovieExtra is a trailer, featurette or other extra that belongs to a movie
+type MovieExtra struct {
+	ID              uuid.UUID
+	MovieID         uuid.UUID
+	ExtraType       string // One of scanner.ExtraTypes
+	Title           string
+	FilePath        string
+	FileSize        int64
+	Container       *string
+	DurationSeconds *int32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
 // MovieCredit represents cast or crew member for a movie
 type MovieCredit struct {
 	ID           uuid.UUID
 	MovieID      uuid.UUID
 	TMDbPersonID int32
+	TVDbPersonID *int32  // Set from metadata to link the person; not stored
+	IMDbPersonID *string // Set from metadata to link the person; not stored
 	Name         string
 	CreditType   string // 'cast' or 'crew'
 	Character    *string
@@ -251,6 +260,7 @@ type MovieCredit struct {
 	Department   *string
 	CastOrder    *int32
 	ProfilePath  *string
+	PersonID     *uuid.UUID // Linked person record
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -276,6 +286,17 @@ type MovieGenre struct {
 	CreatedAt time.Time
 }
 
+// MovieTag represents a keyword or tag associated with a movie.
+// Spoiler tags reveal plot details and are hidden unless the user opts in.
+type MovieTag struct {
+	ID        uuid.UUID
+	MovieID   uuid.UUID
+	Slug      string
+	Name      string
+	Spoiler   bool
+	CreatedAt time.Time
+}
+
 // MovieWatched represents watch progress for a user
 type MovieWatched struct {
 	ID              uuid.UUID
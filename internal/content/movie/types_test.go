@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lusoris/revenge/internal/content"
 )
 
 func TestMovie_GetTitle(t *testing.T) {
@@ -89,6 +91,36 @@ func TestMovie_GetTitle(t *testing.T) {
 	})
 }
 
+func TestMovie_TitleIn(t *testing.T) {
+	originalLang := "ja"
+	originalTitle := "千と千尋の神隠し"
+	overview := "A girl wanders into a world of spirits."
+
+	movie := &Movie{
+		Title:            "Spirited Away",
+		OriginalTitle:    &originalTitle,
+		OriginalLanguage: &originalLang,
+		Overview:         &overview,
+		TitlesI18n: map[string]string{
+			"en": "Spirited Away",
+			"de": "Chihiros Reise ins Zauberland",
+		},
+		OverviewsI18n: map[string]string{
+			"de-AT": "Ein Mädchen gerät in eine Geisterwelt.",
+		},
+	}
+
+	chain := content.LanguageChain{"de-AT", "de", content.LanguageOriginal, "en"}
+	assert.Equal(t, "Chihiros Reise ins Zauberland", movie.TitleIn(chain))
+	assert.Equal(t, "Ein Mädchen gerät in eine Geisterwelt.", movie.OverviewIn(chain))
+
+	// The original title wins over English when it comes first in the chain.
+	chain = content.LanguageChain{"fr", content.LanguageOriginal, "en"}
+	assert.Equal(t, originalTitle, movie.TitleIn(chain))
+	assert.Equal(t, overview, movie.OverviewIn(chain))
+	assert.Empty(t, movie.TaglineIn(chain))
+}
+
 func TestMovie_GetTagline(t *testing.T) {
 	tagline := "Fear can hold you prisoner. Hope can set you free."
 
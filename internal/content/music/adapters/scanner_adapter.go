@@ -0,0 +1,221 @@
+// Package adapters provides music-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/shared/audiotags"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// MusicFileParser implements scanner.FileParser for audio files.
+// Filenames only provide a fallback; ReadTrack prefers the embedded tags.
+// Filename patterns understood:
+// - "01 - Come Together.flac" -> Track: 1, Title: "Come Together"
+// - "1-01 Come Together.mp3" -> Disc: 1, Track: 1, Title: "Come Together"
+// - "01. Come Together.m4a" -> Track: 1, Title: "Come Together"
+// - "The Beatles - Come Together.ogg" -> Artist: "The Beatles", Title: "Come Together"
+type MusicFileParser struct{}
+
+// NewMusicFileParser creates a new music file parser
+func NewMusicFileParser() *MusicFileParser {
+	return &MusicFileParser{}
+}
+
+var (
+	// Disc-track prefix: "1-01", "2.03"
+	discTrackPattern = regexp.MustCompile(`^(\d{1,2})[-.](\d{1,3})[\s._-]+(.+)$`)
+
+	// Track prefix: "01 - ", "01. ", "01 "
+	trackPattern = regexp.MustCompile(`^(\d{1,3})(?:\s*[-.]\s*|\s+)(.+)$`)
+
+	// Artist prefix: "Artist - Title"
+	artistTitlePattern = regexp.MustCompile(`^(.+?)\s+-\s+(.+)$`)
+)
+
+// Parse extracts disc, track number and title from an audio filename
+func (p *MusicFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSpace(strings.TrimSuffix(filename, filepath.Ext(filename)))
+
+	if m := discTrackPattern.FindStringSubmatch(name); m != nil {
+		disc, _ := strconv.Atoi(m[1])
+		track, _ := strconv.Atoi(m[2])
+		metadata["disc"] = disc
+		metadata["track"] = track
+		name = m[3]
+	} else if m := trackPattern.FindStringSubmatch(name); m != nil {
+		track, _ := strconv.Atoi(m[1])
+		metadata["track"] = track
+		name = m[2]
+	}
+
+	if m := artistTitlePattern.FindStringSubmatch(name); m != nil {
+		metadata["artist"] = strings.TrimSpace(m[1])
+		name = m[2]
+	}
+
+	return strings.TrimSpace(name), metadata
+}
+
+// GetExtensions returns audio file extensions
+func (p *MusicFileParser) GetExtensions() []string {
+	return scanner.ExtensionsToSlice(scanner.AudioExtensions)
+}
+
+// ContentType returns "music"
+func (p *MusicFileParser) ContentType() string {
+	return "music"
+}
+
+// ParseFromPath extends Parse with the folder layout: "Artist/Album/01 - Title.flac"
+// or "Artist/Album/CD1/01 - Title.flac" adds the album and album artist.
+func (p *MusicFileParser) ParseFromPath(filePath string) (title string, metadata map[string]any) {
+	title, metadata = p.Parse(filepath.Base(filePath))
+
+	albumDir := scanner.AlbumDir(filePath)
+	if album := filepath.Base(albumDir); album != "." && album != string(filepath.Separator) {
+		metadata["album"] = album
+	}
+	if artist := filepath.Base(filepath.Dir(albumDir)); artist != "." && artist != string(filepath.Separator) {
+		metadata["album_artist"] = artist
+	}
+	return title, metadata
+}
+
+// ReadTrack reads the tags of an audio file and fills missing fields from
+// the file and folder names. Files without readable tags are still imported
+// from their path.
+func (p *MusicFileParser) ReadTrack(filePath string) (*music.ScannedTrack, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := audiotags.ReadFile(filePath)
+	if err != nil {
+		// Untagged or damaged tags: fall back to the path alone.
+		t = &audiotags.Tags{}
+	}
+
+	title, metadata := p.ParseFromPath(filePath)
+	st := TagsToScannedTrack(t)
+	st.FilePath = filePath
+	st.FileSize = info.Size()
+	st.Container = strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), ".")
+	st.CoverPath = scanner.FindAlbumCover(filePath)
+
+	if st.Title == "" {
+		st.Title = title
+	}
+	if st.TrackNumber == 0 {
+		st.TrackNumber, _ = metadata["track"].(int)
+	}
+	if st.DiscNumber == 0 {
+		st.DiscNumber, _ = metadata["disc"].(int)
+	}
+	if st.Album == "" {
+		st.Album, _ = metadata["album"].(string)
+	}
+	if len(st.Artists) == 0 {
+		if artist, _ := metadata["artist"].(string); artist != "" {
+			st.Artists = []music.ArtistCredit{{Name: artist}}
+		} else if artist, _ := metadata["album_artist"].(string); artist != "" {
+			st.Artists = []music.ArtistCredit{{Name: artist}}
+		}
+	}
+	if len(st.AlbumArtists) == 0 && !st.Compilation {
+		if artist, _ := metadata["album_artist"].(string); artist != "" {
+			st.AlbumArtists = []music.ArtistCredit{{Name: artist}}
+		}
+	}
+	return st, nil
+}
+
+// TagsToScannedTrack converts embedded tags to a scanned track. File and
+// path fields are left empty.
+func TagsToScannedTrack(t *audiotags.Tags) *music.ScannedTrack {
+	return &music.ScannedTrack{
+		Title:                     t.Title,
+		ArtistCredit:              t.Artist,
+		Artists:                   credits(t.Artists, t.Artist, t.ArtistSort, t.MusicBrainzArtistIDs),
+		ISRC:                      t.ISRC,
+		DiscNumber:                t.DiscNumber,
+		DiscSubtitle:              t.DiscSubtitle,
+		TrackNumber:               t.TrackNumber,
+		Duration:                  t.Duration,
+		TrackGain:                 t.TrackGain,
+		TrackPeak:                 t.TrackPeak,
+		Album:                     t.Album,
+		AlbumSort:                 t.AlbumSort,
+		AlbumArtistCredit:         t.AlbumArtist,
+		AlbumArtists:              credits(t.AlbumArtists, t.AlbumArtist, t.AlbumArtistSort, t.MusicBrainzAlbumArtistIDs),
+		Compilation:               t.Compilation,
+		ReleaseType:               t.ReleaseType,
+		Year:                      t.Year,
+		OriginalYear:              t.OriginalYear,
+		ReleaseDate:               parseDate(t.Date),
+		Label:                     t.Label,
+		CatalogNumber:             t.CatalogNumber,
+		Country:                   t.Country,
+		Genres:                    t.Genres,
+		AlbumGain:                 t.AlbumGain,
+		AlbumPeak:                 t.AlbumPeak,
+		MusicBrainzRecordingID:    parseUUID(t.MusicBrainzRecordingID),
+		MusicBrainzTrackID:        parseUUID(t.MusicBrainzTrackID),
+		MusicBrainzReleaseID:      parseUUID(t.MusicBrainzReleaseID),
+		MusicBrainzReleaseGroupID: parseUUID(t.MusicBrainzReleaseGroupID),
+	}
+}
+
+// credits pairs artist names with their MusicBrainz IDs. The IDs are only
+// trusted when there is one per name; the sort name only applies to a
+// single artist.
+func credits(names []string, credit, sortName string, mbids []string) []music.ArtistCredit {
+	if len(names) == 0 && credit != "" {
+		names = []string{credit}
+	}
+	out := make([]music.ArtistCredit, 0, len(names))
+	for i, name := range names {
+		c := music.ArtistCredit{Name: name}
+		if len(mbids) == len(names) {
+			c.MusicBrainzID = parseUUID(mbids[i])
+		}
+		if len(names) == 1 {
+			c.SortName = sortName
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func parseUUID(s string) *uuid.UUID {
+	if s == "" {
+		return nil
+	}
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// parseDate parses a full release date; partial dates only carry the year.
+func parseDate(s string) *time.Time {
+	if len(s) < len("2006-01-02") {
+		return nil
+	}
+	t, err := time.Parse("2006-01-02", s[:10])
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,199 @@
+package adapters
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/shared/audiotags"
+)
+
+func TestMusicFileParser_Parse(t *testing.T) {
+	parser := NewMusicFileParser()
+
+	tests := []struct {
+		name          string
+		filename      string
+		expectedTitle string
+		expectedMeta  map[string]any
+	}{
+		{
+			name:          "Track number with dash",
+			filename:      "01 - Come Together.flac",
+			expectedTitle: "Come Together",
+			expectedMeta:  map[string]any{"track": 1},
+		},
+		{
+			name:          "Track number with dot",
+			filename:      "01. Come Together.m4a",
+			expectedTitle: "Come Together",
+			expectedMeta:  map[string]any{"track": 1},
+		},
+		{
+			name:          "Track number with space",
+			filename:      "07 Here Comes the Sun.mp3",
+			expectedTitle: "Here Comes the Sun",
+			expectedMeta:  map[string]any{"track": 7},
+		},
+		{
+			name:          "Disc and track",
+			filename:      "2-03 Jóga.flac",
+			expectedTitle: "Jóga",
+			expectedMeta:  map[string]any{"disc": 2, "track": 3},
+		},
+		{
+			name:          "Artist and title",
+			filename:      "The Beatles - Come Together.ogg",
+			expectedTitle: "Come Together",
+			expectedMeta:  map[string]any{"artist": "The Beatles"},
+		},
+		{
+			name:          "Track, artist and title",
+			filename:      "03 - Nina Simone - Feeling Good.mp3",
+			expectedTitle: "Feeling Good",
+			expectedMeta:  map[string]any{"track": 3, "artist": "Nina Simone"},
+		},
+		{
+			name:          "Title only",
+			filename:      "Intro.opus",
+			expectedTitle: "Intro",
+			expectedMeta:  map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, meta := parser.Parse(tt.filename)
+			assert.Equal(t, tt.expectedTitle, title)
+			assert.Equal(t, tt.expectedMeta, meta)
+		})
+	}
+}
+
+func TestMusicFileParser_ParseFromPath(t *testing.T) {
+	parser := NewMusicFileParser()
+
+	t.Run("artist and album folders", func(t *testing.T) {
+		title, meta := parser.ParseFromPath("/music/The Beatles/Abbey Road/01 - Come Together.flac")
+		assert.Equal(t, "Come Together", title)
+		assert.Equal(t, "Abbey Road", meta["album"])
+		assert.Equal(t, "The Beatles", meta["album_artist"])
+	})
+
+	t.Run("disc subfolder is skipped", func(t *testing.T) {
+		_, meta := parser.ParseFromPath("/music/Björk/Homogenic/CD1/01 - Hunter.flac")
+		assert.Equal(t, "Homogenic", meta["album"])
+		assert.Equal(t, "Björk", meta["album_artist"])
+	})
+}
+
+func TestMusicFileParser_Interface(t *testing.T) {
+	parser := NewMusicFileParser()
+
+	assert.Equal(t, "music", parser.ContentType())
+	assert.Contains(t, parser.GetExtensions(), ".flac")
+	assert.Contains(t, parser.GetExtensions(), ".mp3")
+}
+
+func TestMusicFileParser_ReadTrack_UntaggedFallsBackToPath(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Radiohead", "OK Computer")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	path := filepath.Join(dir, "02 - Paranoid Android.mp3")
+	require.NoError(t, os.WriteFile(path, []byte("not really audio"), 0o644))
+
+	st, err := NewMusicFileParser().ReadTrack(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, path, st.FilePath)
+	assert.Equal(t, int64(16), st.FileSize)
+	assert.Equal(t, "mp3", st.Container)
+	assert.Equal(t, "Paranoid Android", st.Title)
+	assert.Equal(t, 2, st.TrackNumber)
+	assert.Equal(t, "OK Computer", st.Album)
+	require.Len(t, st.Artists, 1)
+	assert.Equal(t, "Radiohead", st.Artists[0].Name)
+	require.Len(t, st.AlbumArtists, 1)
+	assert.Equal(t, "Radiohead", st.AlbumArtists[0].Name)
+}
+
+func TestMusicFileParser_ReadTrack_MissingFile(t *testing.T) {
+	_, err := NewMusicFileParser().ReadTrack(filepath.Join(t.TempDir(), "missing.flac"))
+	assert.Error(t, err)
+}
+
+func TestTagsToScannedTrack(t *testing.T) {
+	artistMBID := uuid.New()
+	releaseMBID := uuid.New()
+	gain := -7.2
+
+	st := TagsToScannedTrack(&audiotags.Tags{
+		Title:                "Come Together",
+		Artist:               "The Beatles",
+		ArtistSort:           "Beatles, The",
+		Album:                "Abbey Road",
+		AlbumArtist:          "The Beatles",
+		Date:                 "1969-09-26",
+		Year:                 1969,
+		TrackNumber:          1,
+		DiscNumber:           1,
+		MusicBrainzReleaseID: releaseMBID.String(),
+		MusicBrainzArtistIDs: []string{artistMBID.String()},
+		TrackGain:            &gain,
+	})
+
+	assert.Equal(t, "Come Together", st.Title)
+	require.Len(t, st.Artists, 1)
+	assert.Equal(t, "The Beatles", st.Artists[0].Name)
+	assert.Equal(t, "Beatles, The", st.Artists[0].SortName)
+	require.NotNil(t, st.Artists[0].MusicBrainzID)
+	assert.Equal(t, artistMBID, *st.Artists[0].MusicBrainzID)
+	require.NotNil(t, st.MusicBrainzReleaseID)
+	assert.Equal(t, releaseMBID, *st.MusicBrainzReleaseID)
+	require.NotNil(t, st.ReleaseDate)
+	assert.Equal(t, 1969, st.ReleaseDate.Year())
+	assert.Equal(t, &gain, st.TrackGain)
+	assert.Nil(t, st.MusicBrainzRecordingID)
+}
+
+func TestCredits(t *testing.T) {
+	id1, id2 := uuid.New(), uuid.New()
+
+	t.Run("credit only", func(t *testing.T) {
+		c := credits(nil, "Daft Punk", "Daft Punk", nil)
+		require.Len(t, c, 1)
+		assert.Equal(t, "Daft Punk", c[0].Name)
+		assert.Equal(t, "Daft Punk", c[0].SortName)
+	})
+
+	t.Run("multiple artists with matching ids", func(t *testing.T) {
+		c := credits([]string{"Jay-Z", "Kanye West"}, "Jay-Z & Kanye West", "Jay-Z", []string{id1.String(), id2.String()})
+		require.Len(t, c, 2)
+		assert.Equal(t, id1, *c[0].MusicBrainzID)
+		assert.Equal(t, id2, *c[1].MusicBrainzID)
+		assert.Empty(t, c[0].SortName)
+	})
+
+	t.Run("mismatched ids are dropped", func(t *testing.T) {
+		c := credits([]string{"Jay-Z", "Kanye West"}, "", "", []string{id1.String()})
+		require.Len(t, c, 2)
+		assert.Nil(t, c[0].MusicBrainzID)
+		assert.Nil(t, c[1].MusicBrainzID)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, credits(nil, "", "", nil))
+	})
+}
+
+func TestParseDate(t *testing.T) {
+	assert.Nil(t, parseDate(""))
+	assert.Nil(t, parseDate("1969"))
+	assert.Nil(t, parseDate("1969-09"))
+	d := parseDate("1969-09-26T00:00:00")
+	require.NotNil(t, d)
+	assert.Equal(t, 26, d.Day())
+}
@@ -0,0 +1,741 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: releases.sql
+
+package musicdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addReleaseArtist = `-- name: AddReleaseArtist :exec
+INSERT INTO
+    music.release_artists (
+        release_id,
+        artist_id,
+        position
+    )
+VALUES ($1, $2, $3) ON CONFLICT (release_id, artist_id) DO NOTHING
+`
+
+type AddReleaseArtistParams struct {
+	ReleaseID uuid.UUID `json:"releaseId"`
+	ArtistID  uuid.UUID `json:"artistId"`
+	Position  int32     `json:"position"`
+}
+
+func (q *Queries) AddReleaseArtist(ctx context.Context, arg AddReleaseArtistParams) error {
+	_, err := q.db.Exec(ctx, addReleaseArtist, arg.ReleaseID, arg.ArtistID, arg.Position)
+	return err
+}
+
+const countReleases = `-- name: CountReleases :one
+SELECT COUNT(*)
+FROM music.releases r
+WHERE (
+        $1::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+`
+
+type CountReleasesParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+}
+
+func (q *Queries) CountReleases(ctx context.Context, arg CountReleasesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countReleases, arg.Restrict, arg.LibraryPaths)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createRelease = `-- name: CreateRelease :one
+INSERT INTO
+    music.releases (
+        release_group_id,
+        musicbrainz_id,
+        title,
+        sort_title,
+        artist_credit,
+        is_compilation,
+        year,
+        release_date,
+        label,
+        catalog_number,
+        country,
+        genres,
+        replaygain_gain,
+        replaygain_peak,
+        cover_path
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    ) RETURNING id, release_group_id, musicbrainz_id, title, sort_title, artist_credit, is_compilation, year, release_date, label, catalog_number, country, genres, disc_count, track_count, duration_ms, replaygain_gain, replaygain_peak, cover_path, created_at, updated_at
+`
+
+type CreateReleaseParams struct {
+	ReleaseGroupID uuid.UUID   `json:"releaseGroupId"`
+	MusicbrainzID  pgtype.UUID `json:"musicbrainzId"`
+	Title          string      `json:"title"`
+	SortTitle      string      `json:"sortTitle"`
+	ArtistCredit   string      `json:"artistCredit"`
+	IsCompilation  bool        `json:"isCompilation"`
+	Year           *int32      `json:"year"`
+	ReleaseDate    pgtype.Date `json:"releaseDate"`
+	Label          *string     `json:"label"`
+	CatalogNumber  *string     `json:"catalogNumber"`
+	Country        *string     `json:"country"`
+	Genres         []string    `json:"genres"`
+	ReplaygainGain *float32    `json:"replaygainGain"`
+	ReplaygainPeak *float32    `json:"replaygainPeak"`
+	CoverPath      *string     `json:"coverPath"`
+}
+
+func (q *Queries) CreateRelease(ctx context.Context, arg CreateReleaseParams) (MusicRelease, error) {
+	row := q.db.QueryRow(ctx, createRelease,
+		arg.ReleaseGroupID,
+		arg.MusicbrainzID,
+		arg.Title,
+		arg.SortTitle,
+		arg.ArtistCredit,
+		arg.IsCompilation,
+		arg.Year,
+		arg.ReleaseDate,
+		arg.Label,
+		arg.CatalogNumber,
+		arg.Country,
+		arg.Genres,
+		arg.ReplaygainGain,
+		arg.ReplaygainPeak,
+		arg.CoverPath,
+	)
+	var i MusicRelease
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseGroupID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.IsCompilation,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Label,
+		&i.CatalogNumber,
+		&i.Country,
+		&i.Genres,
+		&i.DiscCount,
+		&i.TrackCount,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createReleaseGroup = `-- name: CreateReleaseGroup :one
+INSERT INTO
+    music.release_groups (
+        musicbrainz_id,
+        title,
+        artist_credit,
+        primary_type,
+        first_release_year
+    )
+VALUES ($1, $2, $3, $4, $5) RETURNING id, musicbrainz_id, title, artist_credit, primary_type, first_release_year, created_at, updated_at
+`
+
+type CreateReleaseGroupParams struct {
+	MusicbrainzID    pgtype.UUID `json:"musicbrainzId"`
+	Title            string      `json:"title"`
+	ArtistCredit     string      `json:"artistCredit"`
+	PrimaryType      *string     `json:"primaryType"`
+	FirstReleaseYear *int32      `json:"firstReleaseYear"`
+}
+
+func (q *Queries) CreateReleaseGroup(ctx context.Context, arg CreateReleaseGroupParams) (MusicReleaseGroup, error) {
+	row := q.db.QueryRow(ctx, createReleaseGroup,
+		arg.MusicbrainzID,
+		arg.Title,
+		arg.ArtistCredit,
+		arg.PrimaryType,
+		arg.FirstReleaseYear,
+	)
+	var i MusicReleaseGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.PrimaryType,
+		&i.FirstReleaseYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteEmptyReleaseGroups = `-- name: DeleteEmptyReleaseGroups :execrows
+DELETE FROM music.release_groups g
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM music.releases r
+        WHERE
+            r.release_group_id = g.id
+    )
+`
+
+func (q *Queries) DeleteEmptyReleaseGroups(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmptyReleaseGroups)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteEmptyReleases = `-- name: DeleteEmptyReleases :execrows
+DELETE FROM music.releases r
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM music.tracks t
+        WHERE
+            t.release_id = r.id
+    )
+`
+
+func (q *Queries) DeleteEmptyReleases(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmptyReleases)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findRelease = `-- name: FindRelease :one
+SELECT id, release_group_id, musicbrainz_id, title, sort_title, artist_credit, is_compilation, year, release_date, label, catalog_number, country, genres, disc_count, track_count, duration_ms, replaygain_gain, replaygain_peak, cover_path, created_at, updated_at
+FROM music.releases
+WHERE
+    lower(title) = lower($1)
+    AND lower(artist_credit) = lower($2)
+    AND musicbrainz_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1
+`
+
+type FindReleaseParams struct {
+	Title        string `json:"title"`
+	ArtistCredit string `json:"artistCredit"`
+}
+
+// Releases without a MusicBrainz ID are matched by title and album artist.
+func (q *Queries) FindRelease(ctx context.Context, arg FindReleaseParams) (MusicRelease, error) {
+	row := q.db.QueryRow(ctx, findRelease, arg.Title, arg.ArtistCredit)
+	var i MusicRelease
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseGroupID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.IsCompilation,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Label,
+		&i.CatalogNumber,
+		&i.Country,
+		&i.Genres,
+		&i.DiscCount,
+		&i.TrackCount,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findReleaseGroup = `-- name: FindReleaseGroup :one
+SELECT id, musicbrainz_id, title, artist_credit, primary_type, first_release_year, created_at, updated_at
+FROM music.release_groups
+WHERE
+    lower(title) = lower($1)
+    AND lower(artist_credit) = lower($2)
+    AND musicbrainz_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1
+`
+
+type FindReleaseGroupParams struct {
+	Title        string `json:"title"`
+	ArtistCredit string `json:"artistCredit"`
+}
+
+// Release groups without a MusicBrainz ID are matched by title and artist.
+func (q *Queries) FindReleaseGroup(ctx context.Context, arg FindReleaseGroupParams) (MusicReleaseGroup, error) {
+	row := q.db.QueryRow(ctx, findReleaseGroup, arg.Title, arg.ArtistCredit)
+	var i MusicReleaseGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.PrimaryType,
+		&i.FirstReleaseYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRelease = `-- name: GetRelease :one
+SELECT id, release_group_id, musicbrainz_id, title, sort_title, artist_credit, is_compilation, year, release_date, label, catalog_number, country, genres, disc_count, track_count, duration_ms, replaygain_gain, replaygain_peak, cover_path, created_at, updated_at FROM music.releases WHERE id = $1
+`
+
+func (q *Queries) GetRelease(ctx context.Context, id uuid.UUID) (MusicRelease, error) {
+	row := q.db.QueryRow(ctx, getRelease, id)
+	var i MusicRelease
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseGroupID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.IsCompilation,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Label,
+		&i.CatalogNumber,
+		&i.Country,
+		&i.Genres,
+		&i.DiscCount,
+		&i.TrackCount,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReleaseByMusicBrainzID = `-- name: GetReleaseByMusicBrainzID :one
+SELECT id, release_group_id, musicbrainz_id, title, sort_title, artist_credit, is_compilation, year, release_date, label, catalog_number, country, genres, disc_count, track_count, duration_ms, replaygain_gain, replaygain_peak, cover_path, created_at, updated_at FROM music.releases WHERE musicbrainz_id = $1
+`
+
+func (q *Queries) GetReleaseByMusicBrainzID(ctx context.Context, musicbrainzID pgtype.UUID) (MusicRelease, error) {
+	row := q.db.QueryRow(ctx, getReleaseByMusicBrainzID, musicbrainzID)
+	var i MusicRelease
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseGroupID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.IsCompilation,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Label,
+		&i.CatalogNumber,
+		&i.Country,
+		&i.Genres,
+		&i.DiscCount,
+		&i.TrackCount,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReleaseGroup = `-- name: GetReleaseGroup :one
+SELECT id, musicbrainz_id, title, artist_credit, primary_type, first_release_year, created_at, updated_at FROM music.release_groups WHERE id = $1
+`
+
+func (q *Queries) GetReleaseGroup(ctx context.Context, id uuid.UUID) (MusicReleaseGroup, error) {
+	row := q.db.QueryRow(ctx, getReleaseGroup, id)
+	var i MusicReleaseGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.PrimaryType,
+		&i.FirstReleaseYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReleaseGroupByMusicBrainzID = `-- name: GetReleaseGroupByMusicBrainzID :one
+SELECT id, musicbrainz_id, title, artist_credit, primary_type, first_release_year, created_at, updated_at FROM music.release_groups WHERE musicbrainz_id = $1
+`
+
+func (q *Queries) GetReleaseGroupByMusicBrainzID(ctx context.Context, musicbrainzID pgtype.UUID) (MusicReleaseGroup, error) {
+	row := q.db.QueryRow(ctx, getReleaseGroupByMusicBrainzID, musicbrainzID)
+	var i MusicReleaseGroup
+	err := row.Scan(
+		&i.ID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.PrimaryType,
+		&i.FirstReleaseYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listReleases = `-- name: ListReleases :many
+SELECT r.id, r.release_group_id, r.musicbrainz_id, r.title, r.sort_title, r.artist_credit, r.is_compilation, r.year, r.release_date, r.label, r.catalog_number, r.country, r.genres, r.disc_count, r.track_count, r.duration_ms, r.replaygain_gain, r.replaygain_peak, r.cover_path, r.created_at, r.updated_at
+FROM music.releases r
+WHERE (
+        $3::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest($4::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY
+    CASE
+        WHEN $5::text = 'title' THEN r.sort_title
+    END ASC,
+    CASE
+        WHEN $5::text = 'year' THEN r.year
+    END DESC NULLS LAST,
+    r.created_at DESC,
+    r.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListReleasesParams struct {
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	OrderBy      string   `json:"orderBy"`
+}
+
+// restrict limits the result to releases with a track under library_paths.
+func (q *Queries) ListReleases(ctx context.Context, arg ListReleasesParams) ([]MusicRelease, error) {
+	rows, err := q.db.Query(ctx, listReleases,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.OrderBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MusicRelease{}
+	for rows.Next() {
+		var i MusicRelease
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseGroupID,
+			&i.MusicbrainzID,
+			&i.Title,
+			&i.SortTitle,
+			&i.ArtistCredit,
+			&i.IsCompilation,
+			&i.Year,
+			&i.ReleaseDate,
+			&i.Label,
+			&i.CatalogNumber,
+			&i.Country,
+			&i.Genres,
+			&i.DiscCount,
+			&i.TrackCount,
+			&i.DurationMs,
+			&i.ReplaygainGain,
+			&i.ReplaygainPeak,
+			&i.CoverPath,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReleasesByArtist = `-- name: ListReleasesByArtist :many
+SELECT r.id, r.release_group_id, r.musicbrainz_id, r.title, r.sort_title, r.artist_credit, r.is_compilation, r.year, r.release_date, r.label, r.catalog_number, r.country, r.genres, r.disc_count, r.track_count, r.duration_ms, r.replaygain_gain, r.replaygain_peak, r.cover_path, r.created_at, r.updated_at
+FROM music.releases r
+    JOIN music.release_artists ra ON ra.release_id = r.id
+WHERE
+    ra.artist_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY r.year ASC NULLS LAST, r.sort_title ASC
+`
+
+type ListReleasesByArtistParams struct {
+	ArtistID     uuid.UUID `json:"artistId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+}
+
+// restrict limits the result to releases with a track under library_paths.
+func (q *Queries) ListReleasesByArtist(ctx context.Context, arg ListReleasesByArtistParams) ([]MusicRelease, error) {
+	rows, err := q.db.Query(ctx, listReleasesByArtist, arg.ArtistID, arg.Restrict, arg.LibraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MusicRelease{}
+	for rows.Next() {
+		var i MusicRelease
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseGroupID,
+			&i.MusicbrainzID,
+			&i.Title,
+			&i.SortTitle,
+			&i.ArtistCredit,
+			&i.IsCompilation,
+			&i.Year,
+			&i.ReleaseDate,
+			&i.Label,
+			&i.CatalogNumber,
+			&i.Country,
+			&i.Genres,
+			&i.DiscCount,
+			&i.TrackCount,
+			&i.DurationMs,
+			&i.ReplaygainGain,
+			&i.ReplaygainPeak,
+			&i.CoverPath,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReleasesByReleaseGroup = `-- name: ListReleasesByReleaseGroup :many
+SELECT r.id, r.release_group_id, r.musicbrainz_id, r.title, r.sort_title, r.artist_credit, r.is_compilation, r.year, r.release_date, r.label, r.catalog_number, r.country, r.genres, r.disc_count, r.track_count, r.duration_ms, r.replaygain_gain, r.replaygain_peak, r.cover_path, r.created_at, r.updated_at
+FROM music.releases r
+WHERE
+    r.release_group_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY r.year ASC NULLS LAST, r.created_at ASC
+`
+
+type ListReleasesByReleaseGroupParams struct {
+	ReleaseGroupID uuid.UUID `json:"releaseGroupId"`
+	Restrict       bool      `json:"restrict"`
+	LibraryPaths   []string  `json:"libraryPaths"`
+}
+
+// restrict limits the result to releases with a track under library_paths.
+func (q *Queries) ListReleasesByReleaseGroup(ctx context.Context, arg ListReleasesByReleaseGroupParams) ([]MusicRelease, error) {
+	rows, err := q.db.Query(ctx, listReleasesByReleaseGroup, arg.ReleaseGroupID, arg.Restrict, arg.LibraryPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MusicRelease{}
+	for rows.Next() {
+		var i MusicRelease
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseGroupID,
+			&i.MusicbrainzID,
+			&i.Title,
+			&i.SortTitle,
+			&i.ArtistCredit,
+			&i.IsCompilation,
+			&i.Year,
+			&i.ReleaseDate,
+			&i.Label,
+			&i.CatalogNumber,
+			&i.Country,
+			&i.Genres,
+			&i.DiscCount,
+			&i.TrackCount,
+			&i.DurationMs,
+			&i.ReplaygainGain,
+			&i.ReplaygainPeak,
+			&i.CoverPath,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshReleaseStats = `-- name: RefreshReleaseStats :one
+UPDATE music.releases r
+SET
+    disc_count = GREATEST(
+        COALESCE(
+            (
+                SELECT MAX(t.disc_number)
+                FROM music.tracks t
+                WHERE
+                    t.release_id = r.id
+            ),
+            1
+        ),
+        1
+    ),
+    track_count = (
+        SELECT COUNT(*)
+        FROM music.tracks t
+        WHERE
+            t.release_id = r.id
+    ),
+    duration_ms = (
+        SELECT COALESCE(SUM(t.duration_ms), 0)::BIGINT
+        FROM music.tracks t
+        WHERE
+            t.release_id = r.id
+    )
+WHERE
+    r.id = $1 RETURNING r.id, r.release_group_id, r.musicbrainz_id, r.title, r.sort_title, r.artist_credit, r.is_compilation, r.year, r.release_date, r.label, r.catalog_number, r.country, r.genres, r.disc_count, r.track_count, r.duration_ms, r.replaygain_gain, r.replaygain_peak, r.cover_path, r.created_at, r.updated_at
+`
+
+// Recomputes the denormalized disc, track and duration totals.
+func (q *Queries) RefreshReleaseStats(ctx context.Context, id uuid.UUID) (MusicRelease, error) {
+	row := q.db.QueryRow(ctx, refreshReleaseStats, id)
+	var i MusicRelease
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseGroupID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.IsCompilation,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Label,
+		&i.CatalogNumber,
+		&i.Country,
+		&i.Genres,
+		&i.DiscCount,
+		&i.TrackCount,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateReleaseCover = `-- name: UpdateReleaseCover :one
+UPDATE music.releases SET cover_path = $2 WHERE id = $1 RETURNING id, release_group_id, musicbrainz_id, title, sort_title, artist_credit, is_compilation, year, release_date, label, catalog_number, country, genres, disc_count, track_count, duration_ms, replaygain_gain, replaygain_peak, cover_path, created_at, updated_at
+`
+
+type UpdateReleaseCoverParams struct {
+	ID        uuid.UUID `json:"id"`
+	CoverPath *string   `json:"coverPath"`
+}
+
+func (q *Queries) UpdateReleaseCover(ctx context.Context, arg UpdateReleaseCoverParams) (MusicRelease, error) {
+	row := q.db.QueryRow(ctx, updateReleaseCover, arg.ID, arg.CoverPath)
+	var i MusicRelease
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseGroupID,
+		&i.MusicbrainzID,
+		&i.Title,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.IsCompilation,
+		&i.Year,
+		&i.ReleaseDate,
+		&i.Label,
+		&i.CatalogNumber,
+		&i.Country,
+		&i.Genres,
+		&i.DiscCount,
+		&i.TrackCount,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.CoverPath,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,359 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: tracks.sql
+
+package musicdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addTrackArtist = `-- name: AddTrackArtist :exec
+INSERT INTO
+    music.track_artists (track_id, artist_id, position)
+VALUES ($1, $2, $3) ON CONFLICT (track_id, artist_id) DO NOTHING
+`
+
+type AddTrackArtistParams struct {
+	TrackID  uuid.UUID `json:"trackId"`
+	ArtistID uuid.UUID `json:"artistId"`
+	Position int32     `json:"position"`
+}
+
+func (q *Queries) AddTrackArtist(ctx context.Context, arg AddTrackArtistParams) error {
+	_, err := q.db.Exec(ctx, addTrackArtist, arg.TrackID, arg.ArtistID, arg.Position)
+	return err
+}
+
+const countTracks = `-- name: CountTracks :one
+SELECT COUNT(*) FROM music.tracks
+`
+
+func (q *Queries) CountTracks(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countTracks)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteTrack = `-- name: DeleteTrack :exec
+DELETE FROM music.tracks WHERE id = $1
+`
+
+func (q *Queries) DeleteTrack(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTrack, id)
+	return err
+}
+
+const deleteTrackArtists = `-- name: DeleteTrackArtists :exec
+DELETE FROM music.track_artists WHERE track_id = $1
+`
+
+func (q *Queries) DeleteTrackArtists(ctx context.Context, trackID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTrackArtists, trackID)
+	return err
+}
+
+const getTrack = `-- name: GetTrack :one
+SELECT id, release_id, musicbrainz_recording_id, musicbrainz_track_id, isrc, title, artist_credit, disc_number, disc_subtitle, track_number, duration_ms, replaygain_gain, replaygain_peak, file_path, file_size, container, created_at, updated_at FROM music.tracks WHERE id = $1
+`
+
+func (q *Queries) GetTrack(ctx context.Context, id uuid.UUID) (MusicTrack, error) {
+	row := q.db.QueryRow(ctx, getTrack, id)
+	var i MusicTrack
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseID,
+		&i.MusicbrainzRecordingID,
+		&i.MusicbrainzTrackID,
+		&i.Isrc,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.DiscNumber,
+		&i.DiscSubtitle,
+		&i.TrackNumber,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTrackByPath = `-- name: GetTrackByPath :one
+SELECT id, release_id, musicbrainz_recording_id, musicbrainz_track_id, isrc, title, artist_credit, disc_number, disc_subtitle, track_number, duration_ms, replaygain_gain, replaygain_peak, file_path, file_size, container, created_at, updated_at FROM music.tracks WHERE file_path = $1
+`
+
+func (q *Queries) GetTrackByPath(ctx context.Context, filePath string) (MusicTrack, error) {
+	row := q.db.QueryRow(ctx, getTrackByPath, filePath)
+	var i MusicTrack
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseID,
+		&i.MusicbrainzRecordingID,
+		&i.MusicbrainzTrackID,
+		&i.Isrc,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.DiscNumber,
+		&i.DiscSubtitle,
+		&i.TrackNumber,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTracksByArtist = `-- name: ListTracksByArtist :many
+SELECT t.id, t.release_id, t.musicbrainz_recording_id, t.musicbrainz_track_id, t.isrc, t.title, t.artist_credit, t.disc_number, t.disc_subtitle, t.track_number, t.duration_ms, t.replaygain_gain, t.replaygain_peak, t.file_path, t.file_size, t.container, t.created_at, t.updated_at
+FROM music.tracks t
+    JOIN music.track_artists ta ON ta.track_id = t.id
+WHERE
+    ta.artist_id = $1
+    AND (
+        $2::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest($3::text[]) AS lp(path)
+            WHERE starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY t.title ASC
+LIMIT $5
+OFFSET
+    $4
+`
+
+type ListTracksByArtistParams struct {
+	ArtistID     uuid.UUID `json:"artistId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+	Offset       int32     `json:"offset"`
+	Limit        int32     `json:"limit"`
+}
+
+// restrict limits the result to tracks under library_paths.
+func (q *Queries) ListTracksByArtist(ctx context.Context, arg ListTracksByArtistParams) ([]MusicTrack, error) {
+	rows, err := q.db.Query(ctx, listTracksByArtist,
+		arg.ArtistID,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MusicTrack{}
+	for rows.Next() {
+		var i MusicTrack
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseID,
+			&i.MusicbrainzRecordingID,
+			&i.MusicbrainzTrackID,
+			&i.Isrc,
+			&i.Title,
+			&i.ArtistCredit,
+			&i.DiscNumber,
+			&i.DiscSubtitle,
+			&i.TrackNumber,
+			&i.DurationMs,
+			&i.ReplaygainGain,
+			&i.ReplaygainPeak,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Container,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTracksByRelease = `-- name: ListTracksByRelease :many
+SELECT id, release_id, musicbrainz_recording_id, musicbrainz_track_id, isrc, title, artist_credit, disc_number, disc_subtitle, track_number, duration_ms, replaygain_gain, replaygain_peak, file_path, file_size, container, created_at, updated_at
+FROM music.tracks
+WHERE
+    release_id = $1
+ORDER BY
+    disc_number ASC,
+    track_number ASC NULLS LAST,
+    title ASC
+`
+
+func (q *Queries) ListTracksByRelease(ctx context.Context, releaseID uuid.UUID) ([]MusicTrack, error) {
+	rows, err := q.db.Query(ctx, listTracksByRelease, releaseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MusicTrack{}
+	for rows.Next() {
+		var i MusicTrack
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReleaseID,
+			&i.MusicbrainzRecordingID,
+			&i.MusicbrainzTrackID,
+			&i.Isrc,
+			&i.Title,
+			&i.ArtistCredit,
+			&i.DiscNumber,
+			&i.DiscSubtitle,
+			&i.TrackNumber,
+			&i.DurationMs,
+			&i.ReplaygainGain,
+			&i.ReplaygainPeak,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Container,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertTrack = `-- name: UpsertTrack :one
+INSERT INTO
+    music.tracks (
+        release_id,
+        musicbrainz_recording_id,
+        musicbrainz_track_id,
+        isrc,
+        title,
+        artist_credit,
+        disc_number,
+        disc_subtitle,
+        track_number,
+        duration_ms,
+        replaygain_gain,
+        replaygain_peak,
+        file_path,
+        file_size,
+        container
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    )
+ON CONFLICT (file_path) DO
+UPDATE
+SET
+    release_id = EXCLUDED.release_id,
+    musicbrainz_recording_id = EXCLUDED.musicbrainz_recording_id,
+    musicbrainz_track_id = EXCLUDED.musicbrainz_track_id,
+    isrc = EXCLUDED.isrc,
+    title = EXCLUDED.title,
+    artist_credit = EXCLUDED.artist_credit,
+    disc_number = EXCLUDED.disc_number,
+    disc_subtitle = EXCLUDED.disc_subtitle,
+    track_number = EXCLUDED.track_number,
+    duration_ms = EXCLUDED.duration_ms,
+    replaygain_gain = EXCLUDED.replaygain_gain,
+    replaygain_peak = EXCLUDED.replaygain_peak,
+    file_size = EXCLUDED.file_size,
+    container = EXCLUDED.container RETURNING id, release_id, musicbrainz_recording_id, musicbrainz_track_id, isrc, title, artist_credit, disc_number, disc_subtitle, track_number, duration_ms, replaygain_gain, replaygain_peak, file_path, file_size, container, created_at, updated_at
+`
+
+type UpsertTrackParams struct {
+	ReleaseID              uuid.UUID   `json:"releaseId"`
+	MusicbrainzRecordingID pgtype.UUID `json:"musicbrainzRecordingId"`
+	MusicbrainzTrackID     pgtype.UUID `json:"musicbrainzTrackId"`
+	Isrc                   *string     `json:"isrc"`
+	Title                  string      `json:"title"`
+	ArtistCredit           string      `json:"artistCredit"`
+	DiscNumber             int32       `json:"discNumber"`
+	DiscSubtitle           *string     `json:"discSubtitle"`
+	TrackNumber            *int32      `json:"trackNumber"`
+	DurationMs             *int32      `json:"durationMs"`
+	ReplaygainGain         *float32    `json:"replaygainGain"`
+	ReplaygainPeak         *float32    `json:"replaygainPeak"`
+	FilePath               string      `json:"filePath"`
+	FileSize               int64       `json:"fileSize"`
+	Container              *string     `json:"container"`
+}
+
+// Tracks are keyed by file path so rescans update tags in place.
+func (q *Queries) UpsertTrack(ctx context.Context, arg UpsertTrackParams) (MusicTrack, error) {
+	row := q.db.QueryRow(ctx, upsertTrack,
+		arg.ReleaseID,
+		arg.MusicbrainzRecordingID,
+		arg.MusicbrainzTrackID,
+		arg.Isrc,
+		arg.Title,
+		arg.ArtistCredit,
+		arg.DiscNumber,
+		arg.DiscSubtitle,
+		arg.TrackNumber,
+		arg.DurationMs,
+		arg.ReplaygainGain,
+		arg.ReplaygainPeak,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Container,
+	)
+	var i MusicTrack
+	err := row.Scan(
+		&i.ID,
+		&i.ReleaseID,
+		&i.MusicbrainzRecordingID,
+		&i.MusicbrainzTrackID,
+		&i.Isrc,
+		&i.Title,
+		&i.ArtistCredit,
+		&i.DiscNumber,
+		&i.DiscSubtitle,
+		&i.TrackNumber,
+		&i.DurationMs,
+		&i.ReplaygainGain,
+		&i.ReplaygainPeak,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,9 @@
+package music
+
+import "errors"
+
+var (
+	ErrArtistNotFound  = errors.New("artist not found")
+	ErrReleaseNotFound = errors.New("release not found")
+	ErrTrackNotFound   = errors.New("track not found")
+)
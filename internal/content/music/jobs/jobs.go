@@ -0,0 +1,375 @@
+// Package jobs provides background job workers for music content processing.
+// Library scans read the tags of audio files and build artists, releases and
+// tracks from them; no metadata provider lookup is needed.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/music/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/service/search"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Job kind constants for music jobs
+const (
+	KindLibraryScan = "music_library_scan"
+	KindSearchIndex = "music_search_index"
+)
+
+// =============================================================================
+// Library Scan Job
+// =============================================================================
+
+// LibraryScanArgs defines arguments for music library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for audio files.
+	Paths []string `json:"paths"`
+
+	// Force re-reads the tags of files that are already imported.
+	Force bool `json:"force"`
+
+	// LibraryID is the optional library ID to scan.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for music library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans music library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             music.Service
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service music.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "music_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+// Every new file is opened to read its tags, so music scans get longer than
+// video scans.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Any("paths", job.Args.Paths),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, "music", job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	// Dispatch library scan started notification.
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", "music"))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+
+	status.Start(ctx)
+
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+
+	parser := adapters.NewMusicFileParser()
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, "music", libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	mediaFiles := 0
+	for _, sr := range scanResults {
+		if sr.IsMedia {
+			mediaFiles++
+		}
+	}
+	processed := 0
+
+	for _, sr := range scanResults {
+		if !sr.IsMedia {
+			continue
+		}
+
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     mediaFiles,
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    sr.FilePath,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+
+		// Unchanged files keep their tags unless a full rescan is forced
+		existing, err := w.service.GetTrackByPath(ctx, sr.FilePath)
+		if err == nil && existing != nil && existing.FileSize == sr.FileSize && !job.Args.Force {
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadTrack(sr.FilePath)
+		if err != nil {
+			w.logger.Warn("failed to read track",
+				slog.String("file_path", sr.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("read %s: %w", sr.FilePath, err))
+			continue
+		}
+		if _, err := w.service.ImportTrack(ctx, scanned); err != nil {
+			w.logger.Warn("failed to import track",
+				slog.String("file_path", sr.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("import %s: %w", sr.FilePath, err))
+			continue
+		}
+		result.ItemsProcessed++
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     mediaFiles,
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	// Retagged files may leave releases and artists behind
+	if result.ItemsProcessed > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty releases", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_skipped", itemsSkipped),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	// Dispatch library scan completed notification.
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", "music").
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	// Enqueue a search reindex job so newly imported albums are searchable.
+	if w.jobClient != nil && result.ItemsProcessed > 0 {
+		if _, err := w.jobClient.Insert(ctx, SearchIndexArgs{
+			FullReindex: true,
+		}, nil); err != nil {
+			w.logger.Warn("failed to enqueue search reindex after library scan",
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// =============================================================================
+// Search Index Job
+// =============================================================================
+
+// SearchIndexArgs defines arguments for music search indexing jobs.
+type SearchIndexArgs struct {
+	// ReleaseID is the specific release to index. If nil, indexes all.
+	ReleaseID *uuid.UUID `json:"release_id,omitempty"`
+
+	// FullReindex indicates whether to do a full reindex.
+	FullReindex bool `json:"full_reindex"`
+}
+
+// Kind returns the job kind identifier.
+func (SearchIndexArgs) Kind() string {
+	return KindSearchIndex
+}
+
+// InsertOpts returns the default insert options for music search index jobs.
+// Search indexing runs on the bulk queue since it's batch-heavy.
+func (SearchIndexArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 5,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// SearchIndexWorker indexes music albums for search.
+type SearchIndexWorker struct {
+	river.WorkerDefaults[SearchIndexArgs]
+	service       music.Service
+	searchService *search.MusicSearchService
+	logger        *slog.Logger
+}
+
+// NewSearchIndexWorker creates a new search index worker.
+func NewSearchIndexWorker(service music.Service, searchService *search.MusicSearchService, logger *slog.Logger) *SearchIndexWorker {
+	return &SearchIndexWorker{
+		service:       service,
+		searchService: searchService,
+		logger:        logger.With("component", "music_search_index"),
+	}
+}
+
+// Timeout returns the maximum execution time for search index jobs.
+func (w *SearchIndexWorker) Timeout(job *river.Job[SearchIndexArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+// Work executes the search index job.
+func (w *SearchIndexWorker) Work(ctx context.Context, job *river.Job[SearchIndexArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindSearchIndex)
+	args := job.Args
+
+	jctx.LogStart(
+		slog.Any("release_id", args.ReleaseID),
+		slog.Bool("full_reindex", args.FullReindex),
+	)
+
+	if w.searchService == nil || !w.searchService.IsEnabled() {
+		w.logger.Debug("search is disabled, skipping index operation")
+		return nil
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+
+	if args.ReleaseID != nil {
+		if err := w.indexRelease(ctx, *args.ReleaseID); err != nil {
+			result.AddError(fmt.Errorf("index release %s: %w", args.ReleaseID, err))
+		} else {
+			result.ItemsProcessed++
+		}
+	} else if args.FullReindex {
+		if err := w.searchService.ReindexAll(ctx, w.service); err != nil {
+			result.AddError(fmt.Errorf("full music reindex: %w", err))
+		} else {
+			w.logger.Info("full music reindex completed")
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindSearchIndex)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+		return fmt.Errorf("search indexing completed with errors: %d failed", result.ItemsFailed)
+	}
+
+	jctx.LogComplete(slog.Int("items_indexed", result.ItemsProcessed))
+	return nil
+}
+
+// indexRelease indexes a single release, or removes it when it is gone.
+func (w *SearchIndexWorker) indexRelease(ctx context.Context, releaseID uuid.UUID) error {
+	release, err := w.service.GetRelease(ctx, releaseID)
+	if errors.Is(err, music.ErrReleaseNotFound) {
+		return w.searchService.RemoveAlbum(ctx, releaseID)
+	}
+	if err != nil {
+		return err
+	}
+
+	album, err := search.LoadAlbum(ctx, w.service, release)
+	if err != nil {
+		return err
+	}
+	return w.searchService.IndexAlbum(ctx, album)
+}
@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/music"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/service/search"
+)
+
+// Module provides the music jobs workers.
+var Module = fx.Module("musicjobs",
+	fx.Provide(
+		provideLibraryScanWorker,
+		provideSearchIndexWorker,
+	),
+	fx.Invoke(RegisterWorkers),
+)
+
+// WorkerProviderParams holds common dependencies for worker providers.
+type WorkerProviderParams struct {
+	fx.In
+
+	Service             music.Service
+	SearchService       *search.MusicSearchService `optional:"true"`
+	JobClient           *infrajobs.Client
+	NotificationService notification.Service
+	ScanStatusService   *library.Service `optional:"true"`
+	Logger              *slog.Logger
+}
+
+// provideLibraryScanWorker creates a library scan worker.
+func provideLibraryScanWorker(p WorkerProviderParams) *LibraryScanWorker {
+	return NewLibraryScanWorker(p.Service, p.JobClient, p.NotificationService, p.ScanStatusService, p.Logger)
+}
+
+// provideSearchIndexWorker creates a search index worker.
+func provideSearchIndexWorker(p WorkerProviderParams) *SearchIndexWorker {
+	return NewSearchIndexWorker(p.Service, p.SearchService, p.Logger)
+}
+
+// RegisterWorkers registers all music job workers with the River workers registry.
+func RegisterWorkers(
+	workers *river.Workers,
+	libraryScanWorker *LibraryScanWorker,
+	searchIndexWorker *SearchIndexWorker,
+) error {
+	river.AddWorker(workers, libraryScanWorker)
+	river.AddWorker(workers, searchIndexWorker)
+	return nil
+}
@@ -0,0 +1,11 @@
+package music
+
+import "go.uber.org/fx"
+
+// Module provides the music content module.
+var Module = fx.Module("music",
+	fx.Provide(
+		NewPostgresRepository,
+		NewService,
+	),
+)
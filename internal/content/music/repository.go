@@ -0,0 +1,110 @@
+package music
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for music
+type Repository interface {
+	// Artists
+	GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error)
+	GetArtistByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*Artist, error)
+	GetArtistByName(ctx context.Context, name string) (*Artist, error)
+	CreateArtist(ctx context.Context, params CreateArtistParams) (*Artist, error)
+	ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error)
+	CountArtists(ctx context.Context, filters ArtistListFilters) (int64, error)
+	ListAlbumArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error)
+	CountAlbumArtists(ctx context.Context, filters ArtistListFilters) (int64, error)
+	ListReleaseArtists(ctx context.Context, releaseID uuid.UUID) ([]Artist, error)
+	ListTrackArtists(ctx context.Context, trackID uuid.UUID) ([]Artist, error)
+	DeleteOrphanArtists(ctx context.Context) (int64, error)
+
+	// Release groups
+	GetReleaseGroup(ctx context.Context, id uuid.UUID) (*ReleaseGroup, error)
+	GetReleaseGroupByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*ReleaseGroup, error)
+	FindReleaseGroup(ctx context.Context, title, artistCredit string) (*ReleaseGroup, error)
+	CreateReleaseGroup(ctx context.Context, params CreateReleaseGroupParams) (*ReleaseGroup, error)
+	DeleteEmptyReleaseGroups(ctx context.Context) (int64, error)
+
+	// Releases
+	GetRelease(ctx context.Context, id uuid.UUID) (*Release, error)
+	GetReleaseByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*Release, error)
+	FindRelease(ctx context.Context, title, artistCredit string) (*Release, error)
+	CreateRelease(ctx context.Context, params CreateReleaseParams) (*Release, error)
+	UpdateReleaseCover(ctx context.Context, id uuid.UUID, coverPath *string) (*Release, error)
+	RefreshReleaseStats(ctx context.Context, id uuid.UUID) (*Release, error)
+	ListReleases(ctx context.Context, filters ReleaseListFilters) ([]Release, error)
+	CountReleases(ctx context.Context, filters ReleaseListFilters) (int64, error)
+	ListReleasesByArtist(ctx context.Context, artistID uuid.UUID, libraryPaths []string) ([]Release, error)
+	ListReleasesByReleaseGroup(ctx context.Context, releaseGroupID uuid.UUID, libraryPaths []string) ([]Release, error)
+	AddReleaseArtist(ctx context.Context, releaseID, artistID uuid.UUID, position int32) error
+	DeleteEmptyReleases(ctx context.Context) (int64, error)
+
+	// Tracks
+	GetTrack(ctx context.Context, id uuid.UUID) (*Track, error)
+	GetTrackByPath(ctx context.Context, filePath string) (*Track, error)
+	UpsertTrack(ctx context.Context, params UpsertTrackParams) (*Track, error)
+	ListTracksByRelease(ctx context.Context, releaseID uuid.UUID) ([]Track, error)
+	ListTracksByArtist(ctx context.Context, artistID uuid.UUID, libraryPaths []string, limit, offset int32) ([]Track, error)
+	CountTracks(ctx context.Context) (int64, error)
+	SetTrackArtists(ctx context.Context, trackID uuid.UUID, artistIDs []uuid.UUID) error
+	DeleteTrack(ctx context.Context, id uuid.UUID) error
+}
+
+// CreateArtistParams contains parameters for creating an artist
+type CreateArtistParams struct {
+	MusicBrainzID *uuid.UUID
+	Name          string
+	SortName      string
+}
+
+// CreateReleaseGroupParams contains parameters for creating a release group
+type CreateReleaseGroupParams struct {
+	MusicBrainzID    *uuid.UUID
+	Title            string
+	ArtistCredit     string
+	PrimaryType      *string
+	FirstReleaseYear *int32
+}
+
+// CreateReleaseParams contains parameters for creating a release
+type CreateReleaseParams struct {
+	ReleaseGroupID uuid.UUID
+	MusicBrainzID  *uuid.UUID
+	Title          string
+	SortTitle      string
+	ArtistCredit   string
+	IsCompilation  bool
+	Year           *int32
+	ReleaseDate    *time.Time
+	Label          *string
+	CatalogNumber  *string
+	Country        *string
+	Genres         []string
+	ReplayGainGain *float32
+	ReplayGainPeak *float32
+	CoverPath      *string
+}
+
+// UpsertTrackParams contains parameters for creating or updating a track
+// by file path
+type UpsertTrackParams struct {
+	ReleaseID              uuid.UUID
+	MusicBrainzRecordingID *uuid.UUID
+	MusicBrainzTrackID     *uuid.UUID
+	ISRC                   *string
+	Title                  string
+	ArtistCredit           string
+	DiscNumber             int32
+	DiscSubtitle           *string
+	TrackNumber            *int32
+	DurationMs             *int32
+	ReplayGainGain         *float32
+	ReplayGainPeak         *float32
+	FilePath               string
+	FileSize               int64
+	Container              *string
+}
@@ -0,0 +1,550 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	musicdb "github.com/lusoris/revenge/internal/content/music/db"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *musicdb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: musicdb.New(pool),
+	}
+}
+
+// =============================================================================
+// Artists
+// =============================================================================
+
+func (r *postgresRepository) GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error) {
+	artist, err := r.queries.GetArtist(ctx, id)
+	if err != nil {
+		return nil, artistError(err)
+	}
+	return dbArtistToArtist(artist), nil
+}
+
+func (r *postgresRepository) GetArtistByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*Artist, error) {
+	artist, err := r.queries.GetArtistByMusicBrainzID(ctx, uuidToPg(&mbid))
+	if err != nil {
+		return nil, artistError(err)
+	}
+	return dbArtistToArtist(artist), nil
+}
+
+func (r *postgresRepository) GetArtistByName(ctx context.Context, name string) (*Artist, error) {
+	artist, err := r.queries.GetArtistByName(ctx, name)
+	if err != nil {
+		return nil, artistError(err)
+	}
+	return dbArtistToArtist(artist), nil
+}
+
+func (r *postgresRepository) CreateArtist(ctx context.Context, params CreateArtistParams) (*Artist, error) {
+	artist, err := r.queries.CreateArtist(ctx, musicdb.CreateArtistParams{
+		MusicbrainzID: uuidToPg(params.MusicBrainzID),
+		Name:          params.Name,
+		SortName:      params.SortName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create artist: %w", err)
+	}
+	return dbArtistToArtist(artist), nil
+}
+
+func (r *postgresRepository) ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error) {
+	artists, err := r.queries.ListArtists(ctx, musicdb.ListArtistsParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artists: %w", err)
+	}
+	return dbArtistsToArtists(artists), nil
+}
+
+func (r *postgresRepository) CountArtists(ctx context.Context, filters ArtistListFilters) (int64, error) {
+	return r.queries.CountArtists(ctx, musicdb.CountArtistsParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListAlbumArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error) {
+	artists, err := r.queries.ListAlbumArtists(ctx, musicdb.ListAlbumArtistsParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list album artists: %w", err)
+	}
+	return dbArtistsToArtists(artists), nil
+}
+
+func (r *postgresRepository) CountAlbumArtists(ctx context.Context, filters ArtistListFilters) (int64, error) {
+	return r.queries.CountAlbumArtists(ctx, musicdb.CountAlbumArtistsParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListReleaseArtists(ctx context.Context, releaseID uuid.UUID) ([]Artist, error) {
+	artists, err := r.queries.ListReleaseArtists(ctx, releaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release artists: %w", err)
+	}
+	return dbArtistsToArtists(artists), nil
+}
+
+func (r *postgresRepository) ListTrackArtists(ctx context.Context, trackID uuid.UUID) ([]Artist, error) {
+	artists, err := r.queries.ListTrackArtists(ctx, trackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list track artists: %w", err)
+	}
+	return dbArtistsToArtists(artists), nil
+}
+
+func (r *postgresRepository) DeleteOrphanArtists(ctx context.Context) (int64, error) {
+	return r.queries.DeleteOrphanArtists(ctx)
+}
+
+// =============================================================================
+// Release groups
+// =============================================================================
+
+func (r *postgresRepository) GetReleaseGroup(ctx context.Context, id uuid.UUID) (*ReleaseGroup, error) {
+	group, err := r.queries.GetReleaseGroup(ctx, id)
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseGroupToReleaseGroup(group), nil
+}
+
+func (r *postgresRepository) GetReleaseGroupByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*ReleaseGroup, error) {
+	group, err := r.queries.GetReleaseGroupByMusicBrainzID(ctx, uuidToPg(&mbid))
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseGroupToReleaseGroup(group), nil
+}
+
+func (r *postgresRepository) FindReleaseGroup(ctx context.Context, title, artistCredit string) (*ReleaseGroup, error) {
+	group, err := r.queries.FindReleaseGroup(ctx, musicdb.FindReleaseGroupParams{Title: title, ArtistCredit: artistCredit})
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseGroupToReleaseGroup(group), nil
+}
+
+func (r *postgresRepository) CreateReleaseGroup(ctx context.Context, params CreateReleaseGroupParams) (*ReleaseGroup, error) {
+	group, err := r.queries.CreateReleaseGroup(ctx, musicdb.CreateReleaseGroupParams{
+		MusicbrainzID:    uuidToPg(params.MusicBrainzID),
+		Title:            params.Title,
+		ArtistCredit:     params.ArtistCredit,
+		PrimaryType:      params.PrimaryType,
+		FirstReleaseYear: params.FirstReleaseYear,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release group: %w", err)
+	}
+	return dbReleaseGroupToReleaseGroup(group), nil
+}
+
+func (r *postgresRepository) DeleteEmptyReleaseGroups(ctx context.Context) (int64, error) {
+	return r.queries.DeleteEmptyReleaseGroups(ctx)
+}
+
+// =============================================================================
+// Releases
+// =============================================================================
+
+func (r *postgresRepository) GetRelease(ctx context.Context, id uuid.UUID) (*Release, error) {
+	release, err := r.queries.GetRelease(ctx, id)
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseToRelease(release), nil
+}
+
+func (r *postgresRepository) GetReleaseByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*Release, error) {
+	release, err := r.queries.GetReleaseByMusicBrainzID(ctx, uuidToPg(&mbid))
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseToRelease(release), nil
+}
+
+func (r *postgresRepository) FindRelease(ctx context.Context, title, artistCredit string) (*Release, error) {
+	release, err := r.queries.FindRelease(ctx, musicdb.FindReleaseParams{Title: title, ArtistCredit: artistCredit})
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseToRelease(release), nil
+}
+
+func (r *postgresRepository) CreateRelease(ctx context.Context, params CreateReleaseParams) (*Release, error) {
+	genres := params.Genres
+	if genres == nil {
+		genres = []string{}
+	}
+	release, err := r.queries.CreateRelease(ctx, musicdb.CreateReleaseParams{
+		ReleaseGroupID: params.ReleaseGroupID,
+		MusicbrainzID:  uuidToPg(params.MusicBrainzID),
+		Title:          params.Title,
+		SortTitle:      params.SortTitle,
+		ArtistCredit:   params.ArtistCredit,
+		IsCompilation:  params.IsCompilation,
+		Year:           params.Year,
+		ReleaseDate:    timeToPgDate(params.ReleaseDate),
+		Label:          params.Label,
+		CatalogNumber:  params.CatalogNumber,
+		Country:        params.Country,
+		Genres:         genres,
+		ReplaygainGain: params.ReplayGainGain,
+		ReplaygainPeak: params.ReplayGainPeak,
+		CoverPath:      params.CoverPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release: %w", err)
+	}
+	return dbReleaseToRelease(release), nil
+}
+
+func (r *postgresRepository) UpdateReleaseCover(ctx context.Context, id uuid.UUID, coverPath *string) (*Release, error) {
+	release, err := r.queries.UpdateReleaseCover(ctx, musicdb.UpdateReleaseCoverParams{ID: id, CoverPath: coverPath})
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseToRelease(release), nil
+}
+
+func (r *postgresRepository) RefreshReleaseStats(ctx context.Context, id uuid.UUID) (*Release, error) {
+	release, err := r.queries.RefreshReleaseStats(ctx, id)
+	if err != nil {
+		return nil, releaseError(err)
+	}
+	return dbReleaseToRelease(release), nil
+}
+
+func (r *postgresRepository) ListReleases(ctx context.Context, filters ReleaseListFilters) ([]Release, error) {
+	releases, err := r.queries.ListReleases(ctx, musicdb.ListReleasesParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+		OrderBy:      filters.OrderBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases: %w", err)
+	}
+	return dbReleasesToReleases(releases), nil
+}
+
+func (r *postgresRepository) CountReleases(ctx context.Context, filters ReleaseListFilters) (int64, error) {
+	return r.queries.CountReleases(ctx, musicdb.CountReleasesParams{
+		Restrict:     filters.LibraryPaths != nil,
+		LibraryPaths: filters.LibraryPaths,
+	})
+}
+
+func (r *postgresRepository) ListReleasesByArtist(ctx context.Context, artistID uuid.UUID, libraryPaths []string) ([]Release, error) {
+	releases, err := r.queries.ListReleasesByArtist(ctx, musicdb.ListReleasesByArtistParams{
+		ArtistID:     artistID,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases by artist: %w", err)
+	}
+	return dbReleasesToReleases(releases), nil
+}
+
+func (r *postgresRepository) ListReleasesByReleaseGroup(ctx context.Context, releaseGroupID uuid.UUID, libraryPaths []string) ([]Release, error) {
+	releases, err := r.queries.ListReleasesByReleaseGroup(ctx, musicdb.ListReleasesByReleaseGroupParams{
+		ReleaseGroupID: releaseGroupID,
+		Restrict:       libraryPaths != nil,
+		LibraryPaths:   libraryPaths,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases by release group: %w", err)
+	}
+	return dbReleasesToReleases(releases), nil
+}
+
+func (r *postgresRepository) AddReleaseArtist(ctx context.Context, releaseID, artistID uuid.UUID, position int32) error {
+	return r.queries.AddReleaseArtist(ctx, musicdb.AddReleaseArtistParams{
+		ReleaseID: releaseID,
+		ArtistID:  artistID,
+		Position:  position,
+	})
+}
+
+func (r *postgresRepository) DeleteEmptyReleases(ctx context.Context) (int64, error) {
+	return r.queries.DeleteEmptyReleases(ctx)
+}
+
+// =============================================================================
+// Tracks
+// =============================================================================
+
+func (r *postgresRepository) GetTrack(ctx context.Context, id uuid.UUID) (*Track, error) {
+	track, err := r.queries.GetTrack(ctx, id)
+	if err != nil {
+		return nil, trackError(err)
+	}
+	return dbTrackToTrack(track), nil
+}
+
+func (r *postgresRepository) GetTrackByPath(ctx context.Context, filePath string) (*Track, error) {
+	track, err := r.queries.GetTrackByPath(ctx, filePath)
+	if err != nil {
+		return nil, trackError(err)
+	}
+	return dbTrackToTrack(track), nil
+}
+
+func (r *postgresRepository) UpsertTrack(ctx context.Context, params UpsertTrackParams) (*Track, error) {
+	track, err := r.queries.UpsertTrack(ctx, musicdb.UpsertTrackParams{
+		ReleaseID:              params.ReleaseID,
+		MusicbrainzRecordingID: uuidToPg(params.MusicBrainzRecordingID),
+		MusicbrainzTrackID:     uuidToPg(params.MusicBrainzTrackID),
+		Isrc:                   params.ISRC,
+		Title:                  params.Title,
+		ArtistCredit:           params.ArtistCredit,
+		DiscNumber:             params.DiscNumber,
+		DiscSubtitle:           params.DiscSubtitle,
+		TrackNumber:            params.TrackNumber,
+		DurationMs:             params.DurationMs,
+		ReplaygainGain:         params.ReplayGainGain,
+		ReplaygainPeak:         params.ReplayGainPeak,
+		FilePath:               params.FilePath,
+		FileSize:               params.FileSize,
+		Container:              params.Container,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert track: %w", err)
+	}
+	return dbTrackToTrack(track), nil
+}
+
+func (r *postgresRepository) ListTracksByRelease(ctx context.Context, releaseID uuid.UUID) ([]Track, error) {
+	tracks, err := r.queries.ListTracksByRelease(ctx, releaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks: %w", err)
+	}
+	return dbTracksToTracks(tracks), nil
+}
+
+func (r *postgresRepository) ListTracksByArtist(ctx context.Context, artistID uuid.UUID, libraryPaths []string, limit, offset int32) ([]Track, error) {
+	tracks, err := r.queries.ListTracksByArtist(ctx, musicdb.ListTracksByArtistParams{
+		ArtistID:     artistID,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tracks by artist: %w", err)
+	}
+	return dbTracksToTracks(tracks), nil
+}
+
+func (r *postgresRepository) CountTracks(ctx context.Context) (int64, error) {
+	return r.queries.CountTracks(ctx)
+}
+
+// SetTrackArtists replaces the performing artists of a track.
+func (r *postgresRepository) SetTrackArtists(ctx context.Context, trackID uuid.UUID, artistIDs []uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.queries.WithTx(tx)
+		if err := q.DeleteTrackArtists(ctx, trackID); err != nil {
+			return fmt.Errorf("failed to clear track artists: %w", err)
+		}
+		for i, artistID := range artistIDs {
+			if err := q.AddTrackArtist(ctx, musicdb.AddTrackArtistParams{
+				TrackID:  trackID,
+				ArtistID: artistID,
+				Position: util.SafeIntToInt32(i),
+			}); err != nil {
+				return fmt.Errorf("failed to add track artist: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *postgresRepository) DeleteTrack(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteTrack(ctx, id)
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func artistError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrArtistNotFound
+	}
+	return fmt.Errorf("failed to get artist: %w", err)
+}
+
+func releaseError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrReleaseNotFound
+	}
+	return fmt.Errorf("failed to get release: %w", err)
+}
+
+func trackError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTrackNotFound
+	}
+	return fmt.Errorf("failed to get track: %w", err)
+}
+
+func dbArtistToArtist(a musicdb.MusicArtist) *Artist {
+	return &Artist{
+		ID:            a.ID,
+		MusicBrainzID: pgToUUID(a.MusicbrainzID),
+		Name:          a.Name,
+		SortName:      a.SortName,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+}
+
+func dbArtistsToArtists(rows []musicdb.MusicArtist) []Artist {
+	artists := make([]Artist, len(rows))
+	for i, a := range rows {
+		artists[i] = *dbArtistToArtist(a)
+	}
+	return artists
+}
+
+func dbReleaseGroupToReleaseGroup(g musicdb.MusicReleaseGroup) *ReleaseGroup {
+	return &ReleaseGroup{
+		ID:               g.ID,
+		MusicBrainzID:    pgToUUID(g.MusicbrainzID),
+		Title:            g.Title,
+		ArtistCredit:     g.ArtistCredit,
+		PrimaryType:      g.PrimaryType,
+		FirstReleaseYear: g.FirstReleaseYear,
+		CreatedAt:        g.CreatedAt,
+		UpdatedAt:        g.UpdatedAt,
+	}
+}
+
+func dbReleaseToRelease(r musicdb.MusicRelease) *Release {
+	return &Release{
+		ID:             r.ID,
+		ReleaseGroupID: r.ReleaseGroupID,
+		MusicBrainzID:  pgToUUID(r.MusicbrainzID),
+		Title:          r.Title,
+		SortTitle:      r.SortTitle,
+		ArtistCredit:   r.ArtistCredit,
+		IsCompilation:  r.IsCompilation,
+		Year:           r.Year,
+		ReleaseDate:    pgDateToTimePtr(r.ReleaseDate),
+		Label:          r.Label,
+		CatalogNumber:  r.CatalogNumber,
+		Country:        r.Country,
+		Genres:         r.Genres,
+		DiscCount:      r.DiscCount,
+		TrackCount:     r.TrackCount,
+		DurationMs:     r.DurationMs,
+		ReplayGainGain: r.ReplaygainGain,
+		ReplayGainPeak: r.ReplaygainPeak,
+		CoverPath:      r.CoverPath,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
+
+func dbReleasesToReleases(rows []musicdb.MusicRelease) []Release {
+	releases := make([]Release, len(rows))
+	for i, r := range rows {
+		releases[i] = *dbReleaseToRelease(r)
+	}
+	return releases
+}
+
+func dbTrackToTrack(t musicdb.MusicTrack) *Track {
+	return &Track{
+		ID:                     t.ID,
+		ReleaseID:              t.ReleaseID,
+		MusicBrainzRecordingID: pgToUUID(t.MusicbrainzRecordingID),
+		MusicBrainzTrackID:     pgToUUID(t.MusicbrainzTrackID),
+		ISRC:                   t.Isrc,
+		Title:                  t.Title,
+		ArtistCredit:           t.ArtistCredit,
+		DiscNumber:             t.DiscNumber,
+		DiscSubtitle:           t.DiscSubtitle,
+		TrackNumber:            t.TrackNumber,
+		DurationMs:             t.DurationMs,
+		ReplayGainGain:         t.ReplaygainGain,
+		ReplayGainPeak:         t.ReplaygainPeak,
+		FilePath:               t.FilePath,
+		FileSize:               t.FileSize,
+		Container:              t.Container,
+		CreatedAt:              t.CreatedAt,
+		UpdatedAt:              t.UpdatedAt,
+	}
+}
+
+func dbTracksToTracks(rows []musicdb.MusicTrack) []Track {
+	tracks := make([]Track, len(rows))
+	for i, t := range rows {
+		tracks[i] = *dbTrackToTrack(t)
+	}
+	return tracks
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func timeToPgDate(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *t, Valid: true}
+}
+
+func pgDateToTimePtr(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
@@ -0,0 +1,432 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// UnknownAlbum is the title of releases built from tracks without an album
+// tag.
+const UnknownAlbum = "Unknown Album"
+
+// Service defines business logic for music
+type Service interface {
+	// Artist operations
+	GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error)
+	ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, int64, error)
+	ListAlbumArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, int64, error)
+	ListArtistReleases(ctx context.Context, artistID uuid.UUID, libraryPaths []string) ([]Release, error)
+	ListArtistTracks(ctx context.Context, artistID uuid.UUID, libraryPaths []string, limit, offset int32) ([]Track, error)
+
+	// Release operations
+	GetRelease(ctx context.Context, id uuid.UUID) (*Release, error)
+	ListReleases(ctx context.Context, filters ReleaseListFilters) ([]Release, int64, error)
+	ListReleaseEditions(ctx context.Context, releaseGroupID uuid.UUID, libraryPaths []string) ([]Release, error)
+	GetReleaseArtists(ctx context.Context, releaseID uuid.UUID) ([]Artist, error)
+	GetReleaseDiscs(ctx context.Context, releaseID uuid.UUID) ([]Disc, error)
+
+	// Track operations
+	GetTrack(ctx context.Context, id uuid.UUID) (*Track, error)
+	GetTrackByPath(ctx context.Context, filePath string) (*Track, error)
+	GetTrackArtists(ctx context.Context, trackID uuid.UUID) ([]Artist, error)
+
+	// Library import
+	ImportTrack(ctx context.Context, scanned *ScannedTrack) (*Track, error)
+	Prune(ctx context.Context) error
+}
+
+// musicService implements the Service interface
+type musicService struct {
+	repo Repository
+}
+
+// NewService creates a new music service
+func NewService(repo Repository) Service {
+	return &musicService{repo: repo}
+}
+
+// =============================================================================
+// Artist Operations
+// =============================================================================
+
+func (s *musicService) GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error) {
+	return s.repo.GetArtist(ctx, id)
+}
+
+func (s *musicService) ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, int64, error) {
+	artists, err := s.repo.ListArtists(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountArtists(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return artists, count, nil
+}
+
+func (s *musicService) ListAlbumArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, int64, error) {
+	artists, err := s.repo.ListAlbumArtists(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountAlbumArtists(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return artists, count, nil
+}
+
+func (s *musicService) ListArtistReleases(ctx context.Context, artistID uuid.UUID, libraryPaths []string) ([]Release, error) {
+	if _, err := s.repo.GetArtist(ctx, artistID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListReleasesByArtist(ctx, artistID, libraryPaths)
+}
+
+func (s *musicService) ListArtistTracks(ctx context.Context, artistID uuid.UUID, libraryPaths []string, limit, offset int32) ([]Track, error) {
+	if _, err := s.repo.GetArtist(ctx, artistID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListTracksByArtist(ctx, artistID, libraryPaths, limit, offset)
+}
+
+// =============================================================================
+// Release Operations
+// =============================================================================
+
+func (s *musicService) GetRelease(ctx context.Context, id uuid.UUID) (*Release, error) {
+	return s.repo.GetRelease(ctx, id)
+}
+
+func (s *musicService) ListReleases(ctx context.Context, filters ReleaseListFilters) ([]Release, int64, error) {
+	releases, err := s.repo.ListReleases(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountReleases(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return releases, count, nil
+}
+
+func (s *musicService) ListReleaseEditions(ctx context.Context, releaseGroupID uuid.UUID, libraryPaths []string) ([]Release, error) {
+	return s.repo.ListReleasesByReleaseGroup(ctx, releaseGroupID, libraryPaths)
+}
+
+func (s *musicService) GetReleaseArtists(ctx context.Context, releaseID uuid.UUID) ([]Artist, error) {
+	return s.repo.ListReleaseArtists(ctx, releaseID)
+}
+
+func (s *musicService) GetReleaseDiscs(ctx context.Context, releaseID uuid.UUID) ([]Disc, error) {
+	tracks, err := s.repo.ListTracksByRelease(ctx, releaseID)
+	if err != nil {
+		return nil, err
+	}
+	return GroupByDisc(tracks), nil
+}
+
+// =============================================================================
+// Track Operations
+// =============================================================================
+
+func (s *musicService) GetTrack(ctx context.Context, id uuid.UUID) (*Track, error) {
+	return s.repo.GetTrack(ctx, id)
+}
+
+func (s *musicService) GetTrackByPath(ctx context.Context, filePath string) (*Track, error) {
+	return s.repo.GetTrackByPath(ctx, filePath)
+}
+
+func (s *musicService) GetTrackArtists(ctx context.Context, trackID uuid.UUID) ([]Artist, error) {
+	return s.repo.ListTrackArtists(ctx, trackID)
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+// ImportTrack stores a scanned track, creating its artists, release group
+// and release on first sight. Rescanning the same file updates the track in
+// place.
+func (s *musicService) ImportTrack(ctx context.Context, scanned *ScannedTrack) (*Track, error) {
+	if scanned == nil || scanned.FilePath == "" {
+		return nil, fmt.Errorf("file path is required")
+	}
+
+	trackArtists, err := s.resolveArtists(ctx, scanned.Artists)
+	if err != nil {
+		return nil, err
+	}
+
+	albumCredits := scanned.AlbumArtists
+	if len(albumCredits) == 0 {
+		if scanned.Compilation {
+			albumCredits = []ArtistCredit{{Name: VariousArtists}}
+		} else {
+			albumCredits = scanned.Artists
+		}
+	}
+	albumArtists, err := s.resolveArtists(ctx, albumCredits)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.findOrCreateRelease(ctx, scanned, albumCredits)
+	if err != nil {
+		return nil, err
+	}
+	for i, artist := range albumArtists {
+		if err := s.repo.AddReleaseArtist(ctx, release.ID, artist.ID, util.SafeIntToInt32(i)); err != nil {
+			return nil, fmt.Errorf("failed to add release artist: %w", err)
+		}
+	}
+	if release.CoverPath == nil && scanned.CoverPath != "" {
+		if _, err := s.repo.UpdateReleaseCover(ctx, release.ID, &scanned.CoverPath); err != nil {
+			return nil, err
+		}
+	}
+
+	title := scanned.Title
+	if title == "" {
+		name := filepath.Base(scanned.FilePath)
+		title = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	discNumber := scanned.DiscNumber
+	if discNumber < 1 {
+		discNumber = 1
+	}
+
+	track, err := s.repo.UpsertTrack(ctx, UpsertTrackParams{
+		ReleaseID:              release.ID,
+		MusicBrainzRecordingID: scanned.MusicBrainzRecordingID,
+		MusicBrainzTrackID:     scanned.MusicBrainzTrackID,
+		ISRC:                   optionalString(scanned.ISRC),
+		Title:                  title,
+		ArtistCredit:           creditString(scanned.ArtistCredit, scanned.Artists),
+		DiscNumber:             util.SafeIntToInt32(discNumber),
+		DiscSubtitle:           optionalString(scanned.DiscSubtitle),
+		TrackNumber:            optionalInt32(scanned.TrackNumber),
+		DurationMs:             optionalInt32(int(scanned.Duration.Milliseconds())),
+		ReplayGainGain:         optionalFloat32(scanned.TrackGain),
+		ReplayGainPeak:         optionalFloat32(scanned.TrackPeak),
+		FilePath:               scanned.FilePath,
+		FileSize:               scanned.FileSize,
+		Container:              optionalString(scanned.Container),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	artistIDs := make([]uuid.UUID, len(trackArtists))
+	for i, artist := range trackArtists {
+		artistIDs[i] = artist.ID
+	}
+	if err := s.repo.SetTrackArtists(ctx, track.ID, artistIDs); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.RefreshReleaseStats(ctx, release.ID); err != nil {
+		return nil, err
+	}
+	return track, nil
+}
+
+// Prune removes releases, release groups and artists left without tracks
+// after files were moved or retagged.
+func (s *musicService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteEmptyReleases(ctx); err != nil {
+		return fmt.Errorf("failed to delete empty releases: %w", err)
+	}
+	if _, err := s.repo.DeleteEmptyReleaseGroups(ctx); err != nil {
+		return fmt.Errorf("failed to delete empty release groups: %w", err)
+	}
+	if _, err := s.repo.DeleteOrphanArtists(ctx); err != nil {
+		return fmt.Errorf("failed to delete orphan artists: %w", err)
+	}
+	return nil
+}
+
+// resolveArtists finds or creates the artists of a credit list, skipping
+// unnamed entries.
+func (s *musicService) resolveArtists(ctx context.Context, credits []ArtistCredit) ([]Artist, error) {
+	artists := make([]Artist, 0, len(credits))
+	seen := make(map[uuid.UUID]bool, len(credits))
+	for _, credit := range credits {
+		if strings.TrimSpace(credit.Name) == "" {
+			continue
+		}
+		artist, err := s.findOrCreateArtist(ctx, credit)
+		if err != nil {
+			return nil, err
+		}
+		if seen[artist.ID] {
+			continue
+		}
+		seen[artist.ID] = true
+		artists = append(artists, *artist)
+	}
+	return artists, nil
+}
+
+// findOrCreateArtist matches by MusicBrainz ID first. Name matches are only
+// reused when they don't carry a conflicting MusicBrainz ID, so two artists
+// sharing a name stay apart once tagged.
+func (s *musicService) findOrCreateArtist(ctx context.Context, credit ArtistCredit) (*Artist, error) {
+	if credit.MusicBrainzID != nil {
+		artist, err := s.repo.GetArtistByMusicBrainzID(ctx, *credit.MusicBrainzID)
+		if err == nil {
+			return artist, nil
+		}
+		if !errors.Is(err, ErrArtistNotFound) {
+			return nil, err
+		}
+	}
+
+	artist, err := s.repo.GetArtistByName(ctx, credit.Name)
+	switch {
+	case err == nil && (credit.MusicBrainzID == nil || artist.MusicBrainzID == nil):
+		return artist, nil
+	case err != nil && !errors.Is(err, ErrArtistNotFound):
+		return nil, err
+	}
+
+	sortName := credit.SortName
+	if sortName == "" {
+		sortName = SortName(credit.Name)
+	}
+	return s.repo.CreateArtist(ctx, CreateArtistParams{
+		MusicBrainzID: credit.MusicBrainzID,
+		Name:          credit.Name,
+		SortName:      sortName,
+	})
+}
+
+func (s *musicService) findOrCreateRelease(ctx context.Context, scanned *ScannedTrack, albumCredits []ArtistCredit) (*Release, error) {
+	title := scanned.Album
+	if title == "" {
+		title = UnknownAlbum
+	}
+	credit := creditString(scanned.AlbumArtistCredit, albumCredits)
+	if credit == "" && scanned.Compilation {
+		credit = VariousArtists
+	}
+
+	var (
+		release *Release
+		err     error
+	)
+	if scanned.MusicBrainzReleaseID != nil {
+		release, err = s.repo.GetReleaseByMusicBrainzID(ctx, *scanned.MusicBrainzReleaseID)
+	} else {
+		release, err = s.repo.FindRelease(ctx, title, credit)
+	}
+	if err == nil {
+		return release, nil
+	}
+	if !errors.Is(err, ErrReleaseNotFound) {
+		return nil, err
+	}
+
+	group, err := s.findOrCreateReleaseGroup(ctx, scanned, title, credit)
+	if err != nil {
+		return nil, err
+	}
+
+	sortTitle := scanned.AlbumSort
+	if sortTitle == "" {
+		sortTitle = SortName(title)
+	}
+	return s.repo.CreateRelease(ctx, CreateReleaseParams{
+		ReleaseGroupID: group.ID,
+		MusicBrainzID:  scanned.MusicBrainzReleaseID,
+		Title:          title,
+		SortTitle:      sortTitle,
+		ArtistCredit:   credit,
+		IsCompilation:  scanned.Compilation,
+		Year:           optionalInt32(scanned.Year),
+		ReleaseDate:    scanned.ReleaseDate,
+		Label:          optionalString(scanned.Label),
+		CatalogNumber:  optionalString(scanned.CatalogNumber),
+		Country:        optionalString(scanned.Country),
+		Genres:         scanned.Genres,
+		ReplayGainGain: optionalFloat32(scanned.AlbumGain),
+		ReplayGainPeak: optionalFloat32(scanned.AlbumPeak),
+		CoverPath:      optionalString(scanned.CoverPath),
+	})
+}
+
+func (s *musicService) findOrCreateReleaseGroup(ctx context.Context, scanned *ScannedTrack, title, credit string) (*ReleaseGroup, error) {
+	var (
+		group *ReleaseGroup
+		err   error
+	)
+	if scanned.MusicBrainzReleaseGroupID != nil {
+		group, err = s.repo.GetReleaseGroupByMusicBrainzID(ctx, *scanned.MusicBrainzReleaseGroupID)
+	} else {
+		group, err = s.repo.FindReleaseGroup(ctx, title, credit)
+	}
+	if err == nil {
+		return group, nil
+	}
+	if !errors.Is(err, ErrReleaseNotFound) {
+		return nil, err
+	}
+
+	firstYear := scanned.OriginalYear
+	if firstYear == 0 {
+		firstYear = scanned.Year
+	}
+	return s.repo.CreateReleaseGroup(ctx, CreateReleaseGroupParams{
+		MusicBrainzID:    scanned.MusicBrainzReleaseGroupID,
+		Title:            title,
+		ArtistCredit:     credit,
+		PrimaryType:      optionalString(strings.ToLower(scanned.ReleaseType)),
+		FirstReleaseYear: optionalInt32(firstYear),
+	})
+}
+
+// creditString returns the credit as tagged, or the artist names joined
+// when the file only carries the individual artists.
+func creditString(credit string, artists []ArtistCredit) string {
+	if credit != "" {
+		return credit
+	}
+	names := make([]string, 0, len(artists))
+	for _, a := range artists {
+		if a.Name != "" {
+			names = append(names, a.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalInt32(n int) *int32 {
+	if n <= 0 {
+		return nil
+	}
+	v := util.SafeIntToInt32(n)
+	return &v
+}
+
+func optionalFloat32(f *float64) *float32 {
+	if f == nil || math.IsNaN(*f) || math.IsInf(*f, 0) {
+		return nil
+	}
+	v := float32(*f)
+	return &v
+}
@@ -0,0 +1,480 @@
+package music
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Artist operations
+func (m *MockRepository) GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) GetArtistByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*Artist, error) {
+	args := m.Called(ctx, mbid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) GetArtistByName(ctx context.Context, name string) (*Artist, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) CreateArtist(ctx context.Context, params CreateArtistParams) (*Artist, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Artist), args.Error(1)
+}
+
+func (m *MockRepository) CountArtists(ctx context.Context, filters ArtistListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListAlbumArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Artist), args.Error(1)
+}
+
+func (m *MockRepository) CountAlbumArtists(ctx context.Context, filters ArtistListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListReleaseArtists(ctx context.Context, releaseID uuid.UUID) ([]Artist, error) {
+	args := m.Called(ctx, releaseID)
+	return args.Get(0).([]Artist), args.Error(1)
+}
+
+func (m *MockRepository) ListTrackArtists(ctx context.Context, trackID uuid.UUID) ([]Artist, error) {
+	args := m.Called(ctx, trackID)
+	return args.Get(0).([]Artist), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrphanArtists(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Release group operations
+func (m *MockRepository) GetReleaseGroup(ctx context.Context, id uuid.UUID) (*ReleaseGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReleaseGroup), args.Error(1)
+}
+
+func (m *MockRepository) GetReleaseGroupByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*ReleaseGroup, error) {
+	args := m.Called(ctx, mbid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReleaseGroup), args.Error(1)
+}
+
+func (m *MockRepository) FindReleaseGroup(ctx context.Context, title, artistCredit string) (*ReleaseGroup, error) {
+	args := m.Called(ctx, title, artistCredit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReleaseGroup), args.Error(1)
+}
+
+func (m *MockRepository) CreateReleaseGroup(ctx context.Context, params CreateReleaseGroupParams) (*ReleaseGroup, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReleaseGroup), args.Error(1)
+}
+
+func (m *MockRepository) DeleteEmptyReleaseGroups(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Release operations
+func (m *MockRepository) GetRelease(ctx context.Context, id uuid.UUID) (*Release, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Release), args.Error(1)
+}
+
+func (m *MockRepository) GetReleaseByMusicBrainzID(ctx context.Context, mbid uuid.UUID) (*Release, error) {
+	args := m.Called(ctx, mbid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Release), args.Error(1)
+}
+
+func (m *MockRepository) FindRelease(ctx context.Context, title, artistCredit string) (*Release, error) {
+	args := m.Called(ctx, title, artistCredit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Release), args.Error(1)
+}
+
+func (m *MockRepository) CreateRelease(ctx context.Context, params CreateReleaseParams) (*Release, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Release), args.Error(1)
+}
+
+func (m *MockRepository) UpdateReleaseCover(ctx context.Context, id uuid.UUID, coverPath *string) (*Release, error) {
+	args := m.Called(ctx, id, coverPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Release), args.Error(1)
+}
+
+func (m *MockRepository) RefreshReleaseStats(ctx context.Context, id uuid.UUID) (*Release, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Release), args.Error(1)
+}
+
+func (m *MockRepository) ListReleases(ctx context.Context, filters ReleaseListFilters) ([]Release, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Release), args.Error(1)
+}
+
+func (m *MockRepository) CountReleases(ctx context.Context, filters ReleaseListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListReleasesByArtist(ctx context.Context, artistID uuid.UUID, libraryPaths []string) ([]Release, error) {
+	args := m.Called(ctx, artistID, libraryPaths)
+	return args.Get(0).([]Release), args.Error(1)
+}
+
+func (m *MockRepository) ListReleasesByReleaseGroup(ctx context.Context, releaseGroupID uuid.UUID, libraryPaths []string) ([]Release, error) {
+	args := m.Called(ctx, releaseGroupID, libraryPaths)
+	return args.Get(0).([]Release), args.Error(1)
+}
+
+func (m *MockRepository) AddReleaseArtist(ctx context.Context, releaseID, artistID uuid.UUID, position int32) error {
+	args := m.Called(ctx, releaseID, artistID, position)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteEmptyReleases(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Track operations
+func (m *MockRepository) GetTrack(ctx context.Context, id uuid.UUID) (*Track, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Track), args.Error(1)
+}
+
+func (m *MockRepository) GetTrackByPath(ctx context.Context, filePath string) (*Track, error) {
+	args := m.Called(ctx, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Track), args.Error(1)
+}
+
+func (m *MockRepository) UpsertTrack(ctx context.Context, params UpsertTrackParams) (*Track, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Track), args.Error(1)
+}
+
+func (m *MockRepository) ListTracksByRelease(ctx context.Context, releaseID uuid.UUID) ([]Track, error) {
+	args := m.Called(ctx, releaseID)
+	return args.Get(0).([]Track), args.Error(1)
+}
+
+func (m *MockRepository) ListTracksByArtist(ctx context.Context, artistID uuid.UUID, libraryPaths []string, limit, offset int32) ([]Track, error) {
+	args := m.Called(ctx, artistID, libraryPaths, limit, offset)
+	return args.Get(0).([]Track), args.Error(1)
+}
+
+func (m *MockRepository) CountTracks(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) SetTrackArtists(ctx context.Context, trackID uuid.UUID, artistIDs []uuid.UUID) error {
+	args := m.Called(ctx, trackID, artistIDs)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteTrack(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// =============================================================================
+// ImportTrack
+// =============================================================================
+
+func TestImportTrack_CreatesArtistReleaseAndTrack(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	artistID := uuid.New()
+	groupID := uuid.New()
+	releaseID := uuid.New()
+	trackID := uuid.New()
+
+	artist := &Artist{ID: artistID, Name: "The Beatles", SortName: "Beatles, The"}
+	repo.On("GetArtistByName", ctx, "The Beatles").Return(nil, ErrArtistNotFound).Once()
+	repo.On("CreateArtist", ctx, CreateArtistParams{Name: "The Beatles", SortName: "Beatles, The"}).Return(artist, nil).Once()
+	repo.On("GetArtistByName", ctx, "The Beatles").Return(artist, nil)
+
+	repo.On("FindRelease", ctx, "Abbey Road", "The Beatles").Return(nil, ErrReleaseNotFound)
+	repo.On("FindReleaseGroup", ctx, "Abbey Road", "The Beatles").Return(nil, ErrReleaseNotFound)
+	repo.On("CreateReleaseGroup", ctx, mock.MatchedBy(func(p CreateReleaseGroupParams) bool {
+		return p.Title == "Abbey Road" && p.FirstReleaseYear != nil && *p.FirstReleaseYear == 1969
+	})).Return(&ReleaseGroup{ID: groupID, Title: "Abbey Road"}, nil)
+	repo.On("CreateRelease", ctx, mock.MatchedBy(func(p CreateReleaseParams) bool {
+		return p.ReleaseGroupID == groupID && p.SortTitle == "Abbey Road" && p.ArtistCredit == "The Beatles"
+	})).Return(&Release{ID: releaseID, ReleaseGroupID: groupID, Title: "Abbey Road"}, nil)
+	repo.On("AddReleaseArtist", ctx, releaseID, artistID, int32(0)).Return(nil)
+	repo.On("UpsertTrack", ctx, mock.MatchedBy(func(p UpsertTrackParams) bool {
+		return p.ReleaseID == releaseID &&
+			p.Title == "Come Together" &&
+			p.DiscNumber == 1 &&
+			p.TrackNumber != nil && *p.TrackNumber == 1 &&
+			p.DurationMs != nil && *p.DurationMs == 259000 &&
+			p.ReplayGainGain != nil && *p.ReplayGainGain == float32(-6.5)
+	})).Return(&Track{ID: trackID, ReleaseID: releaseID, Title: "Come Together"}, nil)
+	repo.On("SetTrackArtists", ctx, trackID, []uuid.UUID{artistID}).Return(nil)
+	repo.On("RefreshReleaseStats", ctx, releaseID).Return(&Release{ID: releaseID}, nil)
+
+	gain := -6.5
+	track, err := svc.ImportTrack(ctx, &ScannedTrack{
+		FilePath:    "/music/The Beatles/Abbey Road/01 - Come Together.flac",
+		Title:       "Come Together",
+		Artists:     []ArtistCredit{{Name: "The Beatles"}},
+		TrackNumber: 1,
+		Duration:    259 * time.Second,
+		TrackGain:   &gain,
+		Album:       "Abbey Road",
+		Year:        1969,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, trackID, track.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestImportTrack_MatchesReleaseByMusicBrainzID(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	artistMBID := uuid.New()
+	releaseMBID := uuid.New()
+	artist := &Artist{ID: uuid.New(), MusicBrainzID: &artistMBID, Name: "Björk"}
+	cover := "/music/Björk/Homogenic/cover.jpg"
+	release := &Release{ID: uuid.New(), MusicBrainzID: &releaseMBID, Title: "Homogenic", CoverPath: &cover}
+	trackID := uuid.New()
+
+	repo.On("GetArtistByMusicBrainzID", ctx, artistMBID).Return(artist, nil)
+	repo.On("GetReleaseByMusicBrainzID", ctx, releaseMBID).Return(release, nil)
+	repo.On("AddReleaseArtist", ctx, release.ID, artist.ID, int32(0)).Return(nil)
+	repo.On("UpsertTrack", ctx, mock.MatchedBy(func(p UpsertTrackParams) bool {
+		return p.ReleaseID == release.ID && p.DiscNumber == 2
+	})).Return(&Track{ID: trackID}, nil)
+	repo.On("SetTrackArtists", ctx, trackID, []uuid.UUID{artist.ID}).Return(nil)
+	repo.On("RefreshReleaseStats", ctx, release.ID).Return(release, nil)
+
+	_, err := svc.ImportTrack(ctx, &ScannedTrack{
+		FilePath:             "/music/Björk/Homogenic/2-01 Jóga.flac",
+		Title:                "Jóga",
+		Artists:              []ArtistCredit{{Name: "Björk", MusicBrainzID: &artistMBID}},
+		DiscNumber:           2,
+		Album:                "Homogenic",
+		CoverPath:            "/music/Björk/Homogenic/folder.jpg",
+		MusicBrainzReleaseID: &releaseMBID,
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateReleaseCover", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "GetArtistByName", mock.Anything, mock.Anything)
+}
+
+func TestImportTrack_CompilationUsesVariousArtists(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	trackArtist := &Artist{ID: uuid.New(), Name: "Nina Simone"}
+	various := &Artist{ID: uuid.New(), Name: VariousArtists}
+	release := &Release{ID: uuid.New(), Title: "Jazz Classics"}
+	trackID := uuid.New()
+
+	repo.On("GetArtistByName", ctx, "Nina Simone").Return(trackArtist, nil)
+	repo.On("GetArtistByName", ctx, VariousArtists).Return(various, nil)
+	repo.On("FindRelease", ctx, "Jazz Classics", VariousArtists).Return(release, nil)
+	repo.On("AddReleaseArtist", ctx, release.ID, various.ID, int32(0)).Return(nil)
+	repo.On("UpsertTrack", ctx, mock.Anything).Return(&Track{ID: trackID}, nil)
+	repo.On("SetTrackArtists", ctx, trackID, []uuid.UUID{trackArtist.ID}).Return(nil)
+	repo.On("RefreshReleaseStats", ctx, release.ID).Return(release, nil)
+
+	_, err := svc.ImportTrack(ctx, &ScannedTrack{
+		FilePath:    "/music/Compilations/Jazz Classics/03 - Feeling Good.mp3",
+		Title:       "Feeling Good",
+		Artists:     []ArtistCredit{{Name: "Nina Simone"}},
+		Album:       "Jazz Classics",
+		Compilation: true,
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestImportTrack_RequiresFilePath(t *testing.T) {
+	svc := NewService(new(MockRepository))
+
+	_, err := svc.ImportTrack(context.Background(), &ScannedTrack{Title: "No file"})
+	assert.Error(t, err)
+}
+
+func TestImportTrack_PropagatesRepositoryErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	dbErr := errors.New("connection refused")
+	repo.On("GetArtistByName", ctx, "Artist").Return(nil, dbErr)
+
+	_, err := svc.ImportTrack(ctx, &ScannedTrack{
+		FilePath: "/music/a.flac",
+		Artists:  []ArtistCredit{{Name: "Artist"}},
+	})
+	assert.ErrorIs(t, err, dbErr)
+}
+
+// =============================================================================
+// findOrCreateArtist
+// =============================================================================
+
+func TestFindOrCreateArtist_NameMatchWithConflictingMBID(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo).(*musicService)
+
+	existingMBID := uuid.New()
+	newMBID := uuid.New()
+	existing := &Artist{ID: uuid.New(), MusicBrainzID: &existingMBID, Name: "Nirvana"}
+	created := &Artist{ID: uuid.New(), MusicBrainzID: &newMBID, Name: "Nirvana"}
+
+	repo.On("GetArtistByMusicBrainzID", ctx, newMBID).Return(nil, ErrArtistNotFound)
+	repo.On("GetArtistByName", ctx, "Nirvana").Return(existing, nil)
+	repo.On("CreateArtist", ctx, CreateArtistParams{MusicBrainzID: &newMBID, Name: "Nirvana", SortName: "Nirvana"}).Return(created, nil)
+
+	artist, err := svc.findOrCreateArtist(ctx, ArtistCredit{Name: "Nirvana", MusicBrainzID: &newMBID})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, artist.ID)
+	repo.AssertExpectations(t)
+}
+
+// =============================================================================
+// Read operations
+// =============================================================================
+
+func TestGetReleaseDiscs(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	releaseID := uuid.New()
+	repo.On("ListTracksByRelease", ctx, releaseID).Return([]Track{
+		{Title: "A", DiscNumber: 1},
+		{Title: "B", DiscNumber: 1},
+		{Title: "C", DiscNumber: 2},
+	}, nil)
+
+	discs, err := svc.GetReleaseDiscs(ctx, releaseID)
+	require.NoError(t, err)
+	require.Len(t, discs, 2)
+	assert.Len(t, discs[0].Tracks, 2)
+	assert.Equal(t, int32(2), discs[1].Number)
+}
+
+func TestListReleases_ReturnsTotal(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	filters := ReleaseListFilters{OrderBy: "title", Limit: 10}
+	repo.On("ListReleases", ctx, filters).Return([]Release{{Title: "Abbey Road"}}, nil)
+	repo.On("CountReleases", ctx, filters).Return(int64(42), nil)
+
+	releases, total, err := svc.ListReleases(ctx, filters)
+	require.NoError(t, err)
+	assert.Len(t, releases, 1)
+	assert.Equal(t, int64(42), total)
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo)
+
+	repo.On("DeleteEmptyReleases", ctx).Return(int64(1), nil)
+	repo.On("DeleteEmptyReleaseGroups", ctx).Return(int64(1), nil)
+	repo.On("DeleteOrphanArtists", ctx).Return(int64(0), nil)
+
+	require.NoError(t, svc.Prune(ctx))
+	repo.AssertExpectations(t)
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+func TestCreditString(t *testing.T) {
+	assert.Equal(t, "Simon & Garfunkel", creditString("Simon & Garfunkel", []ArtistCredit{{Name: "Paul Simon"}}))
+	assert.Equal(t, "Paul Simon, Art Garfunkel", creditString("", []ArtistCredit{{Name: "Paul Simon"}, {Name: ""}, {Name: "Art Garfunkel"}}))
+	assert.Empty(t, creditString("", nil))
+}
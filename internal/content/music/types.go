@@ -0,0 +1,185 @@
+// Package music provides types, adapters, and services for music content.
+// Artists, release groups, releases (albums) and tracks are built from the
+// tags embedded in audio files; MusicBrainz IDs are used to tell apart
+// artists and albums that share a name.
+package music
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Artist is a performer credited on tracks or releases.
+type Artist struct {
+	ID            uuid.UUID
+	MusicBrainzID *uuid.UUID
+	Name          string
+	SortName      string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ReleaseGroup groups the editions of an album (original, remaster,
+// deluxe, ...).
+type ReleaseGroup struct {
+	ID               uuid.UUID
+	MusicBrainzID    *uuid.UUID
+	Title            string
+	ArtistCredit     string
+	PrimaryType      *string // "album", "single", "ep", "broadcast", "other"
+	FirstReleaseYear *int32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// Release is a concrete edition of an album.
+type Release struct {
+	ID             uuid.UUID
+	ReleaseGroupID uuid.UUID
+	MusicBrainzID  *uuid.UUID
+	Title          string
+	SortTitle      string
+	ArtistCredit   string // album artist as credited
+	IsCompilation  bool
+	Year           *int32
+	ReleaseDate    *time.Time
+	Label          *string
+	CatalogNumber  *string
+	Country        *string
+	Genres         []string
+	DiscCount      int32
+	TrackCount     int32
+	DurationMs     int64
+	ReplayGainGain *float32 // album gain in dB
+	ReplayGainPeak *float32
+	CoverPath      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Track is an audio file on a release.
+type Track struct {
+	ID                     uuid.UUID
+	ReleaseID              uuid.UUID
+	MusicBrainzRecordingID *uuid.UUID
+	MusicBrainzTrackID     *uuid.UUID
+	ISRC                   *string
+	Title                  string
+	ArtistCredit           string
+	DiscNumber             int32
+	DiscSubtitle           *string
+	TrackNumber            *int32
+	DurationMs             *int32
+	ReplayGainGain         *float32 // track gain in dB
+	ReplayGainPeak         *float32
+	FilePath               string
+	FileSize               int64
+	Container              *string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+// Disc groups the tracks of one disc of a release.
+type Disc struct {
+	Number   int32
+	Subtitle *string
+	Tracks   []Track
+}
+
+// GroupByDisc splits tracks ordered by disc and track number into discs.
+func GroupByDisc(tracks []Track) []Disc {
+	var discs []Disc
+	for _, t := range tracks {
+		if len(discs) == 0 || discs[len(discs)-1].Number != t.DiscNumber {
+			discs = append(discs, Disc{Number: t.DiscNumber, Subtitle: t.DiscSubtitle})
+		}
+		d := &discs[len(discs)-1]
+		if d.Subtitle == nil {
+			d.Subtitle = t.DiscSubtitle
+		}
+		d.Tracks = append(d.Tracks, t)
+	}
+	return discs
+}
+
+// ReleaseListFilters contains filters for listing releases.
+type ReleaseListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	OrderBy      string   // "added", "title", "year"
+	Limit        int32
+	Offset       int32
+}
+
+// ArtistListFilters contains filters for listing artists.
+type ArtistListFilters struct {
+	LibraryPaths []string // nil means unrestricted
+	Limit        int32
+	Offset       int32
+}
+
+// ArtistCredit identifies an artist credited on a scanned track.
+type ArtistCredit struct {
+	Name          string
+	SortName      string
+	MusicBrainzID *uuid.UUID
+}
+
+// ScannedTrack is a track file with the metadata read by the library
+// scanner.
+type ScannedTrack struct {
+	FilePath  string
+	FileSize  int64
+	Container string
+
+	Title        string
+	ArtistCredit string
+	Artists      []ArtistCredit
+	ISRC         string
+	DiscNumber   int
+	DiscSubtitle string
+	TrackNumber  int
+	Duration     time.Duration
+	TrackGain    *float64
+	TrackPeak    *float64
+
+	Album             string
+	AlbumSort         string
+	AlbumArtistCredit string
+	AlbumArtists      []ArtistCredit
+	Compilation       bool
+	ReleaseType       string
+	Year              int
+	OriginalYear      int
+	ReleaseDate       *time.Time
+	Label             string
+	CatalogNumber     string
+	Country           string
+	Genres            []string
+	AlbumGain         *float64
+	AlbumPeak         *float64
+	CoverPath         string
+
+	MusicBrainzRecordingID    *uuid.UUID
+	MusicBrainzTrackID        *uuid.UUID
+	MusicBrainzReleaseID      *uuid.UUID
+	MusicBrainzReleaseGroupID *uuid.UUID
+}
+
+// VariousArtists is the album artist of compilations without one.
+const VariousArtists = "Various Artists"
+
+// leadingArticles are moved to the end of sort names.
+var leadingArticles = []string{"The ", "A ", "An "}
+
+// SortName returns the sort form of a name: "The Beatles" sorts as
+// "Beatles, The".
+func SortName(name string) string {
+	for _, article := range leadingArticles {
+		if len(name) > len(article) && strings.EqualFold(name[:len(article)], article) {
+			return name[len(article):] + ", " + strings.TrimSpace(name[:len(article)])
+		}
+	}
+	return name
+}
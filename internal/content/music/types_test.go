@@ -0,0 +1,54 @@
+package music
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"The Beatles", "Beatles, The"},
+		{"the xx", "xx, the"},
+		{"A Tribe Called Quest", "Tribe Called Quest, A"},
+		{"An Horse", "Horse, An"},
+		{"Theory of a Deadman", "Theory of a Deadman"},
+		{"The", "The"},
+		{"Radiohead", "Radiohead"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SortName(tt.name))
+		})
+	}
+}
+
+func TestGroupByDisc(t *testing.T) {
+	sub := "Live"
+	tracks := []Track{
+		{Title: "One", DiscNumber: 1},
+		{Title: "Two", DiscNumber: 1},
+		{Title: "Three", DiscNumber: 2},
+		{Title: "Four", DiscNumber: 2, DiscSubtitle: &sub},
+	}
+
+	discs := GroupByDisc(tracks)
+	require.Len(t, discs, 2)
+
+	assert.Equal(t, int32(1), discs[0].Number)
+	assert.Nil(t, discs[0].Subtitle)
+	assert.Len(t, discs[0].Tracks, 2)
+
+	assert.Equal(t, int32(2), discs[1].Number)
+	require.NotNil(t, discs[1].Subtitle)
+	assert.Equal(t, "Live", *discs[1].Subtitle)
+	assert.Equal(t, "Four", discs[1].Tracks[1].Title)
+}
+
+func TestGroupByDisc_Empty(t *testing.T) {
+	assert.Empty(t, GroupByDisc(nil))
+}
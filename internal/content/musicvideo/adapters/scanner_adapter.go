@@ -0,0 +1,228 @@
+// Package adapters provides music video-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/musicvideo/media"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// MusicVideoFileParser implements scanner.FileParser for music videos.
+// Artist and title come from the container's artist and title tags, else
+// from an "Artist - Title" file name, else the file name is the title and
+// the directory names the artist. Upload noise such as "(Official Video)"
+// is dropped and featured artists move from the title to the credit.
+// File names understood:
+// - "a-ha - Take On Me (Official Video).mp4" -> "a-ha", "Take On Me"
+// - "Rihanna - Umbrella (feat. JAY-Z) [1080p].mkv" -> "Rihanna", "Umbrella", credit "Rihanna feat. JAY-Z"
+// - "03 - Daft Punk - Around the World (1997).mkv" -> "Daft Punk", "Around the World", 1997
+// - "Queen/Bohemian Rhapsody.mkv" -> "Queen", "Bohemian Rhapsody"
+type MusicVideoFileParser struct {
+	probe func(filePath string) (*media.Info, error)
+}
+
+// NewMusicVideoFileParser creates a parser for the files of a music video
+// library.
+func NewMusicVideoFileParser() *MusicVideoFileParser {
+	return &MusicVideoFileParser{probe: media.Probe}
+}
+
+// artistSeparator splits "Artist - Title"; en and em dashes count too.
+var artistSeparator = regexp.MustCompile(`\s+[-–—]\s+`)
+
+// bracketPattern matches a parenthesized or bracketed part of a name.
+var bracketPattern = regexp.MustCompile(`\s*[(\[]([^)\]]*)[)\]]`)
+
+// featPattern matches a featured-artist clause, e.g. "feat. JAY-Z".
+var featPattern = regexp.MustCompile(`(?i)^(?:feat\.?|ft\.?|featuring)\s+(.+)$`)
+
+// artistFeatPattern splits featured artists off an artist credit.
+var artistFeatPattern = regexp.MustCompile(`(?i)\s+(?:feat\.?|ft\.?|featuring)\s+`)
+
+// yearPattern matches a bracketed release year.
+var yearPattern = regexp.MustCompile(`^(?:19|20)\d{2}$`)
+
+// noiseWords make up the bracketed upload labels dropped from titles,
+// e.g. "Official Music Video", "HD", "Lyric Video", "Remastered 4K".
+var noiseWords = map[string]bool{
+	"official": true, "music": true, "video": true, "videoclip": true, "clip": true,
+	"lyric": true, "lyrics": true, "visualizer": true, "visualiser": true, "audio": true,
+	"hd": true, "hq": true, "4k": true, "uhd": true, "remastered": true, "remaster": true,
+	"version": true, "full": true, "explicit": true, "clean": true,
+}
+
+// resolutionWord matches resolution labels such as "1080p".
+var resolutionWord = regexp.MustCompile(`^\d{3,4}[pi]$`)
+
+// separators are replaced by spaces in names.
+var separators = strings.NewReplacer("_", " ")
+
+// Parse returns the title of an "Artist - Title" file name. The metadata
+// holds "artist" and "artist_credit" (string) when the name has an artist,
+// and "year" (int) for a bracketed release year.
+func (p *MusicVideoFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSuffix(filename, filepath.Ext(filename))
+	name = strings.Join(strings.Fields(separators.Replace(name)), " ")
+
+	artist, title := splitArtist(name)
+	title, featured, year := cleanTitle(title)
+	if year > 0 {
+		metadata["year"] = year
+	}
+	if title == "" {
+		title = name
+	}
+
+	if artist != "" {
+		primary, credit := splitCredit(artist)
+		if featured != "" {
+			credit += " feat. " + featured
+		}
+		metadata["artist"] = primary
+		metadata["artist_credit"] = credit
+	}
+	return title, metadata
+}
+
+// GetExtensions returns the video extensions
+func (p *MusicVideoFileParser) GetExtensions() []string {
+	return scanner.ExtensionsToSlice(scanner.VideoExtensions)
+}
+
+// ContentType returns "musicvideo"
+func (p *MusicVideoFileParser) ContentType() string {
+	return "musicvideo"
+}
+
+// ReadVideo reads a video's container and name. root is the library path
+// the file was found in; a file in a subdirectory without an artist in its
+// tags or name is credited to the directory. Files FFmpeg cannot read are
+// not imported.
+func (p *MusicVideoFileParser) ReadVideo(filePath, root string) (*musicvideo.ScannedVideo, error) {
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.probe(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	scanned := &musicvideo.ScannedVideo{
+		FilePath:               filePath,
+		FileSize:               stat.Size(),
+		Container:              info.Container,
+		Year:                   info.Year,
+		MusicBrainzRecordingID: info.RecordingID,
+		DurationSeconds:        info.DurationSeconds,
+		Width:                  info.Width,
+		Height:                 info.Height,
+		VideoCodec:             info.VideoCodec,
+		AudioCodec:             info.AudioCodec,
+	}
+
+	switch {
+	case info.Artist != "" && info.Title != "":
+		scanned.Artist, scanned.ArtistCredit = splitCredit(info.Artist)
+		scanned.Title, scanned.TitleSource = info.Title, musicvideo.TitleSourceTag
+		return scanned, nil
+	case info.Title != "":
+		// A title tag alone often is "Artist - Title" as uploaded
+		if title, metadata := p.Parse(info.Title); metadata["artist"] != nil {
+			applyParsed(scanned, title, metadata)
+			scanned.TitleSource = musicvideo.TitleSourceTag
+			return scanned, nil
+		}
+	}
+
+	title, metadata := p.Parse(filepath.Base(filePath))
+	applyParsed(scanned, title, metadata)
+	scanned.TitleSource = musicvideo.TitleSourceFilename
+	if scanned.Artist == "" {
+		if dir := filepath.Dir(filePath); root != "" && filepath.Clean(dir) != filepath.Clean(root) {
+			scanned.Artist = filepath.Base(dir)
+			scanned.ArtistCredit = scanned.Artist
+		}
+	}
+	return scanned, nil
+}
+
+// applyParsed copies what Parse found to a scanned video. A year from the
+// tags wins.
+func applyParsed(scanned *musicvideo.ScannedVideo, title string, metadata map[string]any) {
+	scanned.Title = title
+	scanned.Artist, _ = metadata["artist"].(string)
+	scanned.ArtistCredit, _ = metadata["artist_credit"].(string)
+	if year, ok := metadata["year"].(int); ok && scanned.Year == 0 {
+		scanned.Year = year
+	}
+}
+
+// splitArtist splits "Artist - Title", dropping a leading track number
+// such as "03 - Artist - Title". Names without a separator are all title.
+func splitArtist(name string) (artist, title string) {
+	parts := artistSeparator.Split(name, 3)
+	if len(parts) == 3 {
+		if _, err := strconv.Atoi(parts[0]); err == nil {
+			parts = []string{parts[1], parts[2]}
+		} else {
+			parts = artistSeparator.Split(name, 2)
+		}
+	}
+	if len(parts) < 2 || strings.TrimSpace(parts[0]) == "" {
+		return "", name
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// splitCredit returns the artist credited first and the whole credit.
+func splitCredit(credit string) (primary, full string) {
+	full = strings.TrimSpace(credit)
+	primary = strings.TrimSpace(artistFeatPattern.Split(full, 2)[0])
+	return primary, full
+}
+
+// cleanTitle drops upload noise from a title and pulls out a featured
+// artist clause and a release year.
+func cleanTitle(title string) (clean, featured string, year int) {
+	clean = bracketPattern.ReplaceAllStringFunc(title, func(m string) string {
+		inner := strings.TrimSpace(bracketPattern.FindStringSubmatch(m)[1])
+		switch {
+		case yearPattern.MatchString(inner):
+			year, _ = strconv.Atoi(inner)
+			return ""
+		case featPattern.MatchString(inner):
+			featured = featPattern.FindStringSubmatch(inner)[1]
+			return ""
+		case isNoise(inner):
+			return ""
+		}
+		return m
+	})
+	// Unbracketed "Title feat. Artist"
+	if parts := artistFeatPattern.Split(clean, 2); len(parts) == 2 && featured == "" {
+		clean, featured = parts[0], strings.TrimSpace(parts[1])
+	}
+	return strings.TrimSpace(clean), featured, year
+}
+
+// isNoise reports whether a bracketed part is an upload label.
+func isNoise(s string) bool {
+	words := strings.Fields(strings.ToLower(s))
+	if len(words) == 0 {
+		return true
+	}
+	for _, w := range words {
+		if !noiseWords[w] && !resolutionWord.MatchString(w) && !yearPattern.MatchString(w) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+package adapters
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/musicvideo/media"
+)
+
+func TestMusicVideoFileParser_Parse(t *testing.T) {
+	parser := NewMusicVideoFileParser()
+
+	tests := []struct {
+		filename   string
+		wantTitle  string
+		wantArtist string
+		wantCredit string
+		wantYear   int
+	}{
+		{"a-ha - Take On Me (Official Video).mp4", "Take On Me", "a-ha", "a-ha", 0},
+		{"Rihanna - Umbrella (feat. JAY-Z) [1080p].mkv", "Umbrella", "Rihanna", "Rihanna feat. JAY-Z", 0},
+		{"Rihanna ft. JAY-Z - Umbrella.mkv", "Umbrella", "Rihanna", "Rihanna ft. JAY-Z", 0},
+		{"03 - Daft Punk - Around the World (1997).mkv", "Around the World", "Daft Punk", "Daft Punk", 1997},
+		{"Nirvana_-_Smells_Like_Teen_Spirit_[Official_Music_Video_4K].webm", "Smells Like Teen Spirit", "Nirvana", "Nirvana", 0},
+		{"Queen – Bohemian Rhapsody (Remastered 2011).mp4", "Bohemian Rhapsody", "Queen", "Queen", 0},
+		{"Metallica - One (Live).mkv", "One (Live)", "Metallica", "Metallica", 0},
+		{"Blink-182 - All the Small Things.mp4", "All the Small Things", "Blink-182", "Blink-182", 0},
+		{"Bohemian Rhapsody.mkv", "Bohemian Rhapsody", "", "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			title, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantTitle, title)
+			if tt.wantArtist == "" {
+				assert.NotContains(t, metadata, "artist")
+			} else {
+				assert.Equal(t, tt.wantArtist, metadata["artist"])
+				assert.Equal(t, tt.wantCredit, metadata["artist_credit"])
+			}
+			if tt.wantYear == 0 {
+				assert.NotContains(t, metadata, "year")
+			} else {
+				assert.Equal(t, tt.wantYear, metadata["year"])
+			}
+		})
+	}
+}
+
+func TestMusicVideoFileParser_Extensions(t *testing.T) {
+	parser := NewMusicVideoFileParser()
+	assert.Equal(t, "musicvideo", parser.ContentType())
+	assert.Contains(t, parser.GetExtensions(), ".mkv")
+	assert.Contains(t, parser.GetExtensions(), ".mp4")
+}
+
+func TestMusicVideoFileParser_ReadVideo(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "a-ha - Take On Me (Official Video).mp4")
+	require.NoError(t, os.WriteFile(path, []byte("video"), 0o600))
+	recordingID := uuid.MustParse("b1a9c0e9-d987-4042-ae91-78d6a3267d69")
+
+	t.Run("container tags win", func(t *testing.T) {
+		parser := &MusicVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{
+				Container:       "mp4",
+				Artist:          "A-Ha feat. Nobody",
+				Title:           "Take On Me (1985 Version)",
+				Year:            1985,
+				RecordingID:     &recordingID,
+				DurationSeconds: 225,
+				Width:           1440,
+				Height:          1080,
+				VideoCodec:      "h264",
+				AudioCodec:      "aac",
+			}, nil
+		}}
+		scanned, err := parser.ReadVideo(path, root)
+		require.NoError(t, err)
+		assert.Equal(t, "A-Ha", scanned.Artist)
+		assert.Equal(t, "A-Ha feat. Nobody", scanned.ArtistCredit)
+		assert.Equal(t, "Take On Me (1985 Version)", scanned.Title)
+		assert.Equal(t, musicvideo.TitleSourceTag, scanned.TitleSource)
+		assert.Equal(t, 1985, scanned.Year)
+		assert.Equal(t, &recordingID, scanned.MusicBrainzRecordingID)
+		assert.Equal(t, int64(5), scanned.FileSize)
+		assert.Equal(t, 1440, scanned.Width)
+	})
+
+	t.Run("title tag with artist", func(t *testing.T) {
+		parser := &MusicVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{Container: "mp4", Title: "Toto - Africa (Official HD Video)", VideoCodec: "h264"}, nil
+		}}
+		scanned, err := parser.ReadVideo(path, root)
+		require.NoError(t, err)
+		assert.Equal(t, "Toto", scanned.Artist)
+		assert.Equal(t, "Africa", scanned.Title)
+		assert.Equal(t, musicvideo.TitleSourceTag, scanned.TitleSource)
+	})
+
+	t.Run("file name fallback", func(t *testing.T) {
+		parser := &MusicVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{Container: "mp4", Title: "Take On Me", VideoCodec: "h264"}, nil
+		}}
+		scanned, err := parser.ReadVideo(path, root)
+		require.NoError(t, err)
+		assert.Equal(t, "a-ha", scanned.Artist)
+		assert.Equal(t, "Take On Me", scanned.Title)
+		assert.Equal(t, musicvideo.TitleSourceFilename, scanned.TitleSource)
+	})
+
+	t.Run("artist directory fallback", func(t *testing.T) {
+		dir := filepath.Join(root, "Queen")
+		require.NoError(t, os.Mkdir(dir, 0o700))
+		plain := filepath.Join(dir, "Bohemian Rhapsody.mkv")
+		require.NoError(t, os.WriteFile(plain, []byte("video"), 0o600))
+		parser := &MusicVideoFileParser{probe: func(string) (*media.Info, error) {
+			return &media.Info{Container: "mkv", VideoCodec: "hevc"}, nil
+		}}
+		scanned, err := parser.ReadVideo(plain, root)
+		require.NoError(t, err)
+		assert.Equal(t, "Queen", scanned.Artist)
+		assert.Equal(t, "Bohemian Rhapsody", scanned.Title)
+
+		// Files in the library root have no artist directory
+		loose := filepath.Join(root, "Sandstorm.mkv")
+		require.NoError(t, os.WriteFile(loose, []byte("video"), 0o600))
+		scanned, err = parser.ReadVideo(loose, root)
+		require.NoError(t, err)
+		assert.Empty(t, scanned.Artist)
+	})
+
+	t.Run("unreadable file", func(t *testing.T) {
+		parser := &MusicVideoFileParser{probe: func(string) (*media.Info, error) {
+			return nil, media.ErrNoVideoStream
+		}}
+		_, err := parser.ReadVideo(path, root)
+		assert.True(t, errors.Is(err, media.ErrNoVideoStream))
+
+		_, err = parser.ReadVideo(filepath.Join(root, "missing.mp4"), root)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,286 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: artists.sql
+
+package musicvideodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countArtists = `-- name: CountArtists :one
+SELECT COUNT(*)
+FROM musicvideo.artists a
+WHERE (
+        $1::bool = FALSE
+        OR a.library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR a.library_id = $3::uuid
+    )
+`
+
+type CountArtistsParams struct {
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+}
+
+func (q *Queries) CountArtists(ctx context.Context, arg CountArtistsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countArtists, arg.Restrict, arg.LibraryIds, arg.LibraryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteOrphanArtists = `-- name: DeleteOrphanArtists :execrows
+DELETE FROM musicvideo.artists a
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM musicvideo.videos v
+        WHERE
+            v.artist_id = a.id
+    )
+`
+
+// Removes artists left without videos after rescans and matches.
+func (q *Queries) DeleteOrphanArtists(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrphanArtists)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getArtist = `-- name: GetArtist :one
+SELECT a.id, a.library_id, a.name, a.name_key, a.sort_name, a.disambiguation, a.musicbrainz_artist_id, a.created_at, a.updated_at, (
+        SELECT COUNT(*)
+        FROM musicvideo.videos v
+        WHERE
+            v.artist_id = a.id
+    ) AS video_count
+FROM musicvideo.artists a
+WHERE
+    a.id = $1
+`
+
+type GetArtistRow struct {
+	MusicvideoArtist MusicvideoArtist `json:"musicvideoArtist"`
+	VideoCount       int64            `json:"videoCount"`
+}
+
+func (q *Queries) GetArtist(ctx context.Context, id uuid.UUID) (GetArtistRow, error) {
+	row := q.db.QueryRow(ctx, getArtist, id)
+	var i GetArtistRow
+	err := row.Scan(
+		&i.MusicvideoArtist.ID,
+		&i.MusicvideoArtist.LibraryID,
+		&i.MusicvideoArtist.Name,
+		&i.MusicvideoArtist.NameKey,
+		&i.MusicvideoArtist.SortName,
+		&i.MusicvideoArtist.Disambiguation,
+		&i.MusicvideoArtist.MusicbrainzArtistID,
+		&i.MusicvideoArtist.CreatedAt,
+		&i.MusicvideoArtist.UpdatedAt,
+		&i.VideoCount,
+	)
+	return i, err
+}
+
+const getArtistByMusicBrainzID = `-- name: GetArtistByMusicBrainzID :one
+SELECT id, library_id, name, name_key, sort_name, disambiguation, musicbrainz_artist_id, created_at, updated_at
+FROM musicvideo.artists
+WHERE
+    library_id = $1
+    AND musicbrainz_artist_id = $2
+`
+
+type GetArtistByMusicBrainzIDParams struct {
+	LibraryID           uuid.UUID   `json:"libraryId"`
+	MusicbrainzArtistID pgtype.UUID `json:"musicbrainzArtistId"`
+}
+
+func (q *Queries) GetArtistByMusicBrainzID(ctx context.Context, arg GetArtistByMusicBrainzIDParams) (MusicvideoArtist, error) {
+	row := q.db.QueryRow(ctx, getArtistByMusicBrainzID, arg.LibraryID, arg.MusicbrainzArtistID)
+	var i MusicvideoArtist
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.Name,
+		&i.NameKey,
+		&i.SortName,
+		&i.Disambiguation,
+		&i.MusicbrainzArtistID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listArtists = `-- name: ListArtists :many
+SELECT a.id, a.library_id, a.name, a.name_key, a.sort_name, a.disambiguation, a.musicbrainz_artist_id, a.created_at, a.updated_at, (
+        SELECT COUNT(*)
+        FROM musicvideo.videos v
+        WHERE
+            v.artist_id = a.id
+    ) AS video_count
+FROM musicvideo.artists a
+WHERE (
+        $3::bool = FALSE
+        OR a.library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR a.library_id = $5::uuid
+    )
+ORDER BY LOWER(a.sort_name) ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListArtistsParams struct {
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+}
+
+type ListArtistsRow struct {
+	MusicvideoArtist MusicvideoArtist `json:"musicvideoArtist"`
+	VideoCount       int64            `json:"videoCount"`
+}
+
+// Lists artists by sort name with the number of their videos. restrict
+// limits the result to library_ids, the libraries the user may access.
+func (q *Queries) ListArtists(ctx context.Context, arg ListArtistsParams) ([]ListArtistsRow, error) {
+	rows, err := q.db.Query(ctx, listArtists,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListArtistsRow{}
+	for rows.Next() {
+		var i ListArtistsRow
+		if err := rows.Scan(
+			&i.MusicvideoArtist.ID,
+			&i.MusicvideoArtist.LibraryID,
+			&i.MusicvideoArtist.Name,
+			&i.MusicvideoArtist.NameKey,
+			&i.MusicvideoArtist.SortName,
+			&i.MusicvideoArtist.Disambiguation,
+			&i.MusicvideoArtist.MusicbrainzArtistID,
+			&i.MusicvideoArtist.CreatedAt,
+			&i.MusicvideoArtist.UpdatedAt,
+			&i.VideoCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setArtistMusicBrainz = `-- name: SetArtistMusicBrainz :one
+UPDATE musicvideo.artists
+SET
+    musicbrainz_artist_id = $2,
+    name = $3,
+    sort_name = $4,
+    disambiguation = $5
+WHERE
+    id = $1 RETURNING id, library_id, name, name_key, sort_name, disambiguation, musicbrainz_artist_id, created_at, updated_at
+`
+
+type SetArtistMusicBrainzParams struct {
+	ID                  uuid.UUID   `json:"id"`
+	MusicbrainzArtistID pgtype.UUID `json:"musicbrainzArtistId"`
+	Name                string      `json:"name"`
+	SortName            string      `json:"sortName"`
+	Disambiguation      *string     `json:"disambiguation"`
+}
+
+func (q *Queries) SetArtistMusicBrainz(ctx context.Context, arg SetArtistMusicBrainzParams) (MusicvideoArtist, error) {
+	row := q.db.QueryRow(ctx, setArtistMusicBrainz,
+		arg.ID,
+		arg.MusicbrainzArtistID,
+		arg.Name,
+		arg.SortName,
+		arg.Disambiguation,
+	)
+	var i MusicvideoArtist
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.Name,
+		&i.NameKey,
+		&i.SortName,
+		&i.Disambiguation,
+		&i.MusicbrainzArtistID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertArtist = `-- name: UpsertArtist :one
+INSERT INTO
+    musicvideo.artists (
+        library_id,
+        name,
+        name_key,
+        sort_name
+    )
+VALUES ($1, $2, $3, $4) ON CONFLICT (library_id, name_key) DO
+UPDATE
+SET
+    name_key = EXCLUDED.name_key RETURNING id, library_id, name, name_key, sort_name, disambiguation, musicbrainz_artist_id, created_at, updated_at
+`
+
+type UpsertArtistParams struct {
+	LibraryID uuid.UUID `json:"libraryId"`
+	Name      string    `json:"name"`
+	NameKey   string    `json:"nameKey"`
+	SortName  string    `json:"sortName"`
+}
+
+// Artists are keyed by their normalized name so every spelling of a name
+// in tags and file names files under one artist. An existing artist keeps
+// its name, which may come from MusicBrainz.
+func (q *Queries) UpsertArtist(ctx context.Context, arg UpsertArtistParams) (MusicvideoArtist, error) {
+	row := q.db.QueryRow(ctx, upsertArtist,
+		arg.LibraryID,
+		arg.Name,
+		arg.NameKey,
+		arg.SortName,
+	)
+	var i MusicvideoArtist
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.Name,
+		&i.NameKey,
+		&i.SortName,
+		&i.Disambiguation,
+		&i.MusicbrainzArtistID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
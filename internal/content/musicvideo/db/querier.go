@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package musicvideodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Querier interface {
+	CountArtists(ctx context.Context, arg CountArtistsParams) (int64, error)
+	CountVideos(ctx context.Context, arg CountVideosParams) (int64, error)
+	// Removes artists left without videos after rescans and matches.
+	DeleteOrphanArtists(ctx context.Context) (int64, error)
+	DeleteVideo(ctx context.Context, id uuid.UUID) error
+	GetArtist(ctx context.Context, id uuid.UUID) (GetArtistRow, error)
+	GetArtistByMusicBrainzID(ctx context.Context, arg GetArtistByMusicBrainzIDParams) (MusicvideoArtist, error)
+	GetVideo(ctx context.Context, id uuid.UUID) (GetVideoRow, error)
+	GetVideoByPath(ctx context.Context, filePath string) (GetVideoByPathRow, error)
+	// Lists artists by sort name with the number of their videos. restrict
+	// limits the result to library_ids, the libraries the user may access.
+	ListArtists(ctx context.Context, arg ListArtistsParams) ([]ListArtistsRow, error)
+	// Lists videos waiting for a MusicBrainz match, oldest first.
+	ListPendingVideos(ctx context.Context, limit int32) ([]ListPendingVideosRow, error)
+	ListVideoPaths(ctx context.Context, libraryID uuid.UUID) ([]ListVideoPathsRow, error)
+	// Lists videos by artist, by title, newest added first or newest released
+	// first; videos of an artist follow by release year. restrict limits the
+	// result to library_ids, the libraries the user may access.
+	ListVideos(ctx context.Context, arg ListVideosParams) ([]ListVideosRow, error)
+	SetArtistMusicBrainz(ctx context.Context, arg SetArtistMusicBrainzParams) (MusicvideoArtist, error)
+	// Stores a MusicBrainz match; its title and credit replace what the scan
+	// read.
+	SetVideoMatch(ctx context.Context, arg SetVideoMatchParams) (MusicvideoVideo, error)
+	SetVideoUnmatched(ctx context.Context, id uuid.UUID) error
+	// Artists are keyed by their normalized name so every spelling of a name
+	// in tags and file names files under one artist. An existing artist keeps
+	// its name, which may come from MusicBrainz.
+	UpsertArtist(ctx context.Context, arg UpsertArtistParams) (MusicvideoArtist, error)
+	// Videos are keyed by their file so rescans update them in place.
+	UpsertVideo(ctx context.Context, arg UpsertVideoParams) (MusicvideoVideo, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,549 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: videos.sql
+
+package musicvideodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countVideos = `-- name: CountVideos :one
+SELECT COUNT(*)
+FROM musicvideo.videos v
+WHERE (
+        $1::bool = FALSE
+        OR v.library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR v.library_id = $3::uuid
+    )
+    AND (
+        $4::uuid IS NULL
+        OR v.artist_id = $4::uuid
+    )
+`
+
+type CountVideosParams struct {
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	ArtistID   pgtype.UUID `json:"artistId"`
+}
+
+func (q *Queries) CountVideos(ctx context.Context, arg CountVideosParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countVideos,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.ArtistID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteVideo = `-- name: DeleteVideo :exec
+DELETE FROM musicvideo.videos WHERE id = $1
+`
+
+func (q *Queries) DeleteVideo(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteVideo, id)
+	return err
+}
+
+const getVideo = `-- name: GetVideo :one
+SELECT v.id, v.library_id, v.artist_id, v.file_path, v.file_size, v.container, v.title, v.title_source, v.sort_title, v.artist_credit, v.year, v.duration_seconds, v.width, v.height, v.video_codec, v.audio_codec, v.musicbrainz_recording_id, v.match_status, v.matched_at, v.created_at, v.updated_at, a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE
+    v.id = $1
+`
+
+type GetVideoRow struct {
+	MusicvideoVideo MusicvideoVideo `json:"musicvideoVideo"`
+	ArtistName      string          `json:"artistName"`
+}
+
+func (q *Queries) GetVideo(ctx context.Context, id uuid.UUID) (GetVideoRow, error) {
+	row := q.db.QueryRow(ctx, getVideo, id)
+	var i GetVideoRow
+	err := row.Scan(
+		&i.MusicvideoVideo.ID,
+		&i.MusicvideoVideo.LibraryID,
+		&i.MusicvideoVideo.ArtistID,
+		&i.MusicvideoVideo.FilePath,
+		&i.MusicvideoVideo.FileSize,
+		&i.MusicvideoVideo.Container,
+		&i.MusicvideoVideo.Title,
+		&i.MusicvideoVideo.TitleSource,
+		&i.MusicvideoVideo.SortTitle,
+		&i.MusicvideoVideo.ArtistCredit,
+		&i.MusicvideoVideo.Year,
+		&i.MusicvideoVideo.DurationSeconds,
+		&i.MusicvideoVideo.Width,
+		&i.MusicvideoVideo.Height,
+		&i.MusicvideoVideo.VideoCodec,
+		&i.MusicvideoVideo.AudioCodec,
+		&i.MusicvideoVideo.MusicbrainzRecordingID,
+		&i.MusicvideoVideo.MatchStatus,
+		&i.MusicvideoVideo.MatchedAt,
+		&i.MusicvideoVideo.CreatedAt,
+		&i.MusicvideoVideo.UpdatedAt,
+		&i.ArtistName,
+	)
+	return i, err
+}
+
+const getVideoByPath = `-- name: GetVideoByPath :one
+SELECT v.id, v.library_id, v.artist_id, v.file_path, v.file_size, v.container, v.title, v.title_source, v.sort_title, v.artist_credit, v.year, v.duration_seconds, v.width, v.height, v.video_codec, v.audio_codec, v.musicbrainz_recording_id, v.match_status, v.matched_at, v.created_at, v.updated_at, a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE
+    v.file_path = $1
+`
+
+type GetVideoByPathRow struct {
+	MusicvideoVideo MusicvideoVideo `json:"musicvideoVideo"`
+	ArtistName      string          `json:"artistName"`
+}
+
+func (q *Queries) GetVideoByPath(ctx context.Context, filePath string) (GetVideoByPathRow, error) {
+	row := q.db.QueryRow(ctx, getVideoByPath, filePath)
+	var i GetVideoByPathRow
+	err := row.Scan(
+		&i.MusicvideoVideo.ID,
+		&i.MusicvideoVideo.LibraryID,
+		&i.MusicvideoVideo.ArtistID,
+		&i.MusicvideoVideo.FilePath,
+		&i.MusicvideoVideo.FileSize,
+		&i.MusicvideoVideo.Container,
+		&i.MusicvideoVideo.Title,
+		&i.MusicvideoVideo.TitleSource,
+		&i.MusicvideoVideo.SortTitle,
+		&i.MusicvideoVideo.ArtistCredit,
+		&i.MusicvideoVideo.Year,
+		&i.MusicvideoVideo.DurationSeconds,
+		&i.MusicvideoVideo.Width,
+		&i.MusicvideoVideo.Height,
+		&i.MusicvideoVideo.VideoCodec,
+		&i.MusicvideoVideo.AudioCodec,
+		&i.MusicvideoVideo.MusicbrainzRecordingID,
+		&i.MusicvideoVideo.MatchStatus,
+		&i.MusicvideoVideo.MatchedAt,
+		&i.MusicvideoVideo.CreatedAt,
+		&i.MusicvideoVideo.UpdatedAt,
+		&i.ArtistName,
+	)
+	return i, err
+}
+
+const listPendingVideos = `-- name: ListPendingVideos :many
+SELECT v.id, v.library_id, v.artist_id, v.file_path, v.file_size, v.container, v.title, v.title_source, v.sort_title, v.artist_credit, v.year, v.duration_seconds, v.width, v.height, v.video_codec, v.audio_codec, v.musicbrainz_recording_id, v.match_status, v.matched_at, v.created_at, v.updated_at, a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE
+    v.match_status = 'pending'
+ORDER BY v.created_at ASC, v.id ASC
+LIMIT $1
+`
+
+type ListPendingVideosRow struct {
+	MusicvideoVideo MusicvideoVideo `json:"musicvideoVideo"`
+	ArtistName      string          `json:"artistName"`
+}
+
+// Lists videos waiting for a MusicBrainz match, oldest first.
+func (q *Queries) ListPendingVideos(ctx context.Context, limit int32) ([]ListPendingVideosRow, error) {
+	rows, err := q.db.Query(ctx, listPendingVideos, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPendingVideosRow{}
+	for rows.Next() {
+		var i ListPendingVideosRow
+		if err := rows.Scan(
+			&i.MusicvideoVideo.ID,
+			&i.MusicvideoVideo.LibraryID,
+			&i.MusicvideoVideo.ArtistID,
+			&i.MusicvideoVideo.FilePath,
+			&i.MusicvideoVideo.FileSize,
+			&i.MusicvideoVideo.Container,
+			&i.MusicvideoVideo.Title,
+			&i.MusicvideoVideo.TitleSource,
+			&i.MusicvideoVideo.SortTitle,
+			&i.MusicvideoVideo.ArtistCredit,
+			&i.MusicvideoVideo.Year,
+			&i.MusicvideoVideo.DurationSeconds,
+			&i.MusicvideoVideo.Width,
+			&i.MusicvideoVideo.Height,
+			&i.MusicvideoVideo.VideoCodec,
+			&i.MusicvideoVideo.AudioCodec,
+			&i.MusicvideoVideo.MusicbrainzRecordingID,
+			&i.MusicvideoVideo.MatchStatus,
+			&i.MusicvideoVideo.MatchedAt,
+			&i.MusicvideoVideo.CreatedAt,
+			&i.MusicvideoVideo.UpdatedAt,
+			&i.ArtistName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVideoPaths = `-- name: ListVideoPaths :many
+SELECT id, file_path FROM musicvideo.videos WHERE library_id = $1
+`
+
+type ListVideoPathsRow struct {
+	ID       uuid.UUID `json:"id"`
+	FilePath string    `json:"filePath"`
+}
+
+func (q *Queries) ListVideoPaths(ctx context.Context, libraryID uuid.UUID) ([]ListVideoPathsRow, error) {
+	rows, err := q.db.Query(ctx, listVideoPaths, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListVideoPathsRow{}
+	for rows.Next() {
+		var i ListVideoPathsRow
+		if err := rows.Scan(&i.ID, &i.FilePath); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVideos = `-- name: ListVideos :many
+SELECT v.id, v.library_id, v.artist_id, v.file_path, v.file_size, v.container, v.title, v.title_source, v.sort_title, v.artist_credit, v.year, v.duration_seconds, v.width, v.height, v.video_codec, v.audio_codec, v.musicbrainz_recording_id, v.match_status, v.matched_at, v.created_at, v.updated_at, a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE (
+        $3::bool = FALSE
+        OR v.library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR v.library_id = $5::uuid
+    )
+    AND (
+        $6::uuid IS NULL
+        OR v.artist_id = $6::uuid
+    )
+ORDER BY
+    CASE
+        WHEN $7::text = 'artist' THEN LOWER(a.sort_name)
+    END ASC,
+    CASE
+        WHEN $7::text = 'added' THEN v.created_at
+    END DESC,
+    CASE
+        WHEN $7::text = 'year' THEN v.year
+    END DESC NULLS LAST,
+    CASE
+        WHEN $7::text = 'artist' THEN v.year
+    END ASC NULLS LAST,
+    v.sort_title ASC,
+    v.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListVideosParams struct {
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	ArtistID   pgtype.UUID `json:"artistId"`
+	Sort       string      `json:"sort"`
+}
+
+type ListVideosRow struct {
+	MusicvideoVideo MusicvideoVideo `json:"musicvideoVideo"`
+	ArtistName      string          `json:"artistName"`
+}
+
+// Lists videos by artist, by title, newest added first or newest released
+// first; videos of an artist follow by release year. restrict limits the
+// result to library_ids, the libraries the user may access.
+func (q *Queries) ListVideos(ctx context.Context, arg ListVideosParams) ([]ListVideosRow, error) {
+	rows, err := q.db.Query(ctx, listVideos,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.ArtistID,
+		arg.Sort,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListVideosRow{}
+	for rows.Next() {
+		var i ListVideosRow
+		if err := rows.Scan(
+			&i.MusicvideoVideo.ID,
+			&i.MusicvideoVideo.LibraryID,
+			&i.MusicvideoVideo.ArtistID,
+			&i.MusicvideoVideo.FilePath,
+			&i.MusicvideoVideo.FileSize,
+			&i.MusicvideoVideo.Container,
+			&i.MusicvideoVideo.Title,
+			&i.MusicvideoVideo.TitleSource,
+			&i.MusicvideoVideo.SortTitle,
+			&i.MusicvideoVideo.ArtistCredit,
+			&i.MusicvideoVideo.Year,
+			&i.MusicvideoVideo.DurationSeconds,
+			&i.MusicvideoVideo.Width,
+			&i.MusicvideoVideo.Height,
+			&i.MusicvideoVideo.VideoCodec,
+			&i.MusicvideoVideo.AudioCodec,
+			&i.MusicvideoVideo.MusicbrainzRecordingID,
+			&i.MusicvideoVideo.MatchStatus,
+			&i.MusicvideoVideo.MatchedAt,
+			&i.MusicvideoVideo.CreatedAt,
+			&i.MusicvideoVideo.UpdatedAt,
+			&i.ArtistName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setVideoMatch = `-- name: SetVideoMatch :one
+UPDATE musicvideo.videos
+SET
+    artist_id = $2,
+    title = $3,
+    title_source = 'musicbrainz',
+    sort_title = $4,
+    artist_credit = $5,
+    year = $6,
+    musicbrainz_recording_id = $7,
+    match_status = 'matched',
+    matched_at = NOW()
+WHERE
+    id = $1 RETURNING id, library_id, artist_id, file_path, file_size, container, title, title_source, sort_title, artist_credit, year, duration_seconds, width, height, video_codec, audio_codec, musicbrainz_recording_id, match_status, matched_at, created_at, updated_at
+`
+
+type SetVideoMatchParams struct {
+	ID                     uuid.UUID   `json:"id"`
+	ArtistID               uuid.UUID   `json:"artistId"`
+	Title                  string      `json:"title"`
+	SortTitle              string      `json:"sortTitle"`
+	ArtistCredit           string      `json:"artistCredit"`
+	Year                   *int32      `json:"year"`
+	MusicbrainzRecordingID pgtype.UUID `json:"musicbrainzRecordingId"`
+}
+
+// Stores a MusicBrainz match; its title and credit replace what the scan
+// read.
+func (q *Queries) SetVideoMatch(ctx context.Context, arg SetVideoMatchParams) (MusicvideoVideo, error) {
+	row := q.db.QueryRow(ctx, setVideoMatch,
+		arg.ID,
+		arg.ArtistID,
+		arg.Title,
+		arg.SortTitle,
+		arg.ArtistCredit,
+		arg.Year,
+		arg.MusicbrainzRecordingID,
+	)
+	var i MusicvideoVideo
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ArtistID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.Title,
+		&i.TitleSource,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.Year,
+		&i.DurationSeconds,
+		&i.Width,
+		&i.Height,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.MusicbrainzRecordingID,
+		&i.MatchStatus,
+		&i.MatchedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setVideoUnmatched = `-- name: SetVideoUnmatched :exec
+UPDATE musicvideo.videos
+SET
+    match_status = 'unmatched',
+    matched_at = NOW()
+WHERE
+    id = $1
+`
+
+func (q *Queries) SetVideoUnmatched(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, setVideoUnmatched, id)
+	return err
+}
+
+const upsertVideo = `-- name: UpsertVideo :one
+INSERT INTO
+    musicvideo.videos (
+        library_id,
+        artist_id,
+        file_path,
+        file_size,
+        container,
+        title,
+        title_source,
+        sort_title,
+        artist_credit,
+        year,
+        duration_seconds,
+        width,
+        height,
+        video_codec,
+        audio_codec,
+        musicbrainz_recording_id,
+        match_status,
+        matched_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18
+    ) ON CONFLICT (file_path) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    artist_id = EXCLUDED.artist_id,
+    file_size = EXCLUDED.file_size,
+    container = EXCLUDED.container,
+    title = EXCLUDED.title,
+    title_source = EXCLUDED.title_source,
+    sort_title = EXCLUDED.sort_title,
+    artist_credit = EXCLUDED.artist_credit,
+    year = EXCLUDED.year,
+    duration_seconds = EXCLUDED.duration_seconds,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    video_codec = EXCLUDED.video_codec,
+    audio_codec = EXCLUDED.audio_codec,
+    musicbrainz_recording_id = EXCLUDED.musicbrainz_recording_id,
+    match_status = EXCLUDED.match_status,
+    matched_at = EXCLUDED.matched_at RETURNING id, library_id, artist_id, file_path, file_size, container, title, title_source, sort_title, artist_credit, year, duration_seconds, width, height, video_codec, audio_codec, musicbrainz_recording_id, match_status, matched_at, created_at, updated_at
+`
+
+type UpsertVideoParams struct {
+	LibraryID              uuid.UUID          `json:"libraryId"`
+	ArtistID               uuid.UUID          `json:"artistId"`
+	FilePath               string             `json:"filePath"`
+	FileSize               int64              `json:"fileSize"`
+	Container              *string            `json:"container"`
+	Title                  string             `json:"title"`
+	TitleSource            string             `json:"titleSource"`
+	SortTitle              string             `json:"sortTitle"`
+	ArtistCredit           string             `json:"artistCredit"`
+	Year                   *int32             `json:"year"`
+	DurationSeconds        float64            `json:"durationSeconds"`
+	Width                  int32              `json:"width"`
+	Height                 int32              `json:"height"`
+	VideoCodec             *string            `json:"videoCodec"`
+	AudioCodec             *string            `json:"audioCodec"`
+	MusicbrainzRecordingID pgtype.UUID        `json:"musicbrainzRecordingId"`
+	MatchStatus            string             `json:"matchStatus"`
+	MatchedAt              pgtype.Timestamptz `json:"matchedAt"`
+}
+
+// Videos are keyed by their file so rescans update them in place.
+func (q *Queries) UpsertVideo(ctx context.Context, arg UpsertVideoParams) (MusicvideoVideo, error) {
+	row := q.db.QueryRow(ctx, upsertVideo,
+		arg.LibraryID,
+		arg.ArtistID,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Container,
+		arg.Title,
+		arg.TitleSource,
+		arg.SortTitle,
+		arg.ArtistCredit,
+		arg.Year,
+		arg.DurationSeconds,
+		arg.Width,
+		arg.Height,
+		arg.VideoCodec,
+		arg.AudioCodec,
+		arg.MusicbrainzRecordingID,
+		arg.MatchStatus,
+		arg.MatchedAt,
+	)
+	var i MusicvideoVideo
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ArtistID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Container,
+		&i.Title,
+		&i.TitleSource,
+		&i.SortTitle,
+		&i.ArtistCredit,
+		&i.Year,
+		&i.DurationSeconds,
+		&i.Width,
+		&i.Height,
+		&i.VideoCodec,
+		&i.AudioCodec,
+		&i.MusicbrainzRecordingID,
+		&i.MatchStatus,
+		&i.MatchedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,9 @@
+package musicvideo
+
+import "errors"
+
+var (
+	ErrVideoNotFound       = errors.New("music video not found")
+	ErrArtistNotFound      = errors.New("music video artist not found")
+	ErrMatchingUnavailable = errors.New("music video matching is not available")
+)
@@ -0,0 +1,428 @@
+// Package jobs provides background job workers for music video content.
+// Library scans file each video under the artist its tags or file name
+// tell; a match job then identifies the videos on MusicBrainz.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/musicvideo/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Job kind constants for music video jobs
+const (
+	KindLibraryScan = "musicvideo_library_scan"
+	KindMatch       = "musicvideo_match"
+)
+
+// =============================================================================
+// Library Scan Job
+// =============================================================================
+
+// libraryType is the library type reported in scan events.
+const libraryType = "musicvideo"
+
+// LibraryScanArgs defines arguments for music video library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for videos.
+	Paths []string `json:"paths"`
+
+	// Force re-reads the tags and names of videos that are already
+	// imported. Matched videos keep their match.
+	Force bool `json:"force"`
+
+	// LibraryID is the library the videos belong to. Videos carry their
+	// library for permission checks, so scans without one are rejected.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for music video library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans music video library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             musicvideo.Service
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service musicvideo.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "musicvideo_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Any("paths", job.Args.Paths),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, libraryType, job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+
+	status.Start(ctx)
+
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	if job.Args.LibraryID == nil {
+		w.logger.Warn("no library provided for music video library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	libraryID := *job.Args.LibraryID
+
+	parser := adapters.NewMusicVideoFileParser()
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, libraryType, libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	var files []scanner.ScanResult
+	for _, sr := range scanResults {
+		if sr.IsMedia {
+			files = append(files, sr)
+		}
+	}
+	present := make(map[string]bool, len(files))
+	processed := 0
+
+	for _, f := range files {
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(files),
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    f.FilePath,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+		present[f.FilePath] = true
+
+		// Unchanged files keep their artist and title unless a full
+		// rescan is forced
+		existing, err := w.service.GetVideoByPath(ctx, f.FilePath)
+		if err == nil && existing != nil && !job.Args.Force &&
+			existing.FileSize == f.FileSize && existing.LibraryID == libraryID {
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadVideo(f.FilePath, libraryRoot(job.Args.Paths, f.FilePath))
+		if err != nil {
+			w.logger.Warn("failed to read video",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(err)
+			continue
+		}
+		scanned.LibraryID = libraryID
+		if _, err := w.service.ImportVideo(ctx, scanned); err != nil {
+			w.logger.Warn("failed to import video",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("import %s: %w", f.FilePath, err))
+			continue
+		}
+		result.ItemsProcessed++
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(files),
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	// An empty result usually means an unmounted library, not deleted videos
+	itemsRemoved := 0
+	if len(files) > 0 {
+		itemsRemoved, err = w.service.RemoveMissing(ctx, libraryID, job.Args.Paths, present)
+		if err != nil {
+			w.logger.Warn("failed to remove missing videos", slog.Any("error", err))
+		}
+	}
+	if result.ItemsProcessed > 0 || itemsRemoved > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty artists", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_skipped", itemsSkipped),
+		slog.Int("items_removed", itemsRemoved),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ItemsRemoved: util.SafeIntToInt32(itemsRemoved),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType).
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	// Enqueue matching for the imported videos, and for videos a previous
+	// match job did not get to.
+	if w.jobClient != nil {
+		if _, err := w.jobClient.Insert(ctx, MatchArgs{}, nil); err != nil {
+			w.logger.Warn("failed to enqueue music video matching after library scan",
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// libraryRoot returns the library path containing filePath, the innermost
+// one if library paths are nested.
+func libraryRoot(paths []string, filePath string) string {
+	root := ""
+	for _, p := range paths {
+		p = filepath.Clean(p)
+		if (filePath == p || strings.HasPrefix(filePath, p+string(filepath.Separator))) && len(p) > len(root) {
+			root = p
+		}
+	}
+	return root
+}
+
+// =============================================================================
+// Match Job
+// =============================================================================
+
+// matchBatchSize is the number of pending videos fetched at a time.
+const matchBatchSize = 50
+
+// maxConsecutiveMatchErrors stops a match job once MusicBrainz keeps
+// failing; the videos stay pending for the next scan.
+const maxConsecutiveMatchErrors = 5
+
+// MatchArgs defines arguments for music video match jobs. A match job
+// works through all pending videos.
+type MatchArgs struct{}
+
+// Kind returns the job kind identifier.
+func (MatchArgs) Kind() string {
+	return KindMatch
+}
+
+// InsertOpts returns the default insert options for music video match jobs.
+// Matching runs on the bulk queue; MusicBrainz allows one request a second.
+func (MatchArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// MatchWorker matches pending music videos to MusicBrainz recordings.
+type MatchWorker struct {
+	river.WorkerDefaults[MatchArgs]
+	service musicvideo.Service
+	logger  *slog.Logger
+}
+
+// NewMatchWorker creates a new match worker.
+func NewMatchWorker(service musicvideo.Service, logger *slog.Logger) *MatchWorker {
+	return &MatchWorker{
+		service: service,
+		logger:  logger.With("component", "musicvideo_match"),
+	}
+}
+
+// Timeout returns the maximum execution time for match jobs.
+func (w *MatchWorker) Timeout(job *river.Job[MatchArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the match job. Videos that fail to match for a provider
+// error stay pending and are skipped for the rest of the job.
+func (w *MatchWorker) Work(ctx context.Context, job *river.Job[MatchArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindMatch)
+	jctx.LogStart()
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	matched, unmatched := 0, 0
+	failed := make(map[uuid.UUID]bool)
+	consecutiveErrors := 0
+
+loop:
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pending, err := w.service.ListPendingMatches(ctx, matchBatchSize+util.SafeIntToInt32(len(failed)))
+		if err != nil {
+			return fmt.Errorf("list pending music videos: %w", err)
+		}
+
+		progressed := false
+		for i := range pending {
+			video := &pending[i]
+			if failed[video.ID] {
+				continue
+			}
+			progressed = true
+
+			got, err := w.service.MatchVideo(ctx, video)
+			switch {
+			case errors.Is(err, musicvideo.ErrMatchingUnavailable):
+				w.logger.Info("no music metadata provider configured, skipping matching")
+				break loop
+			case err != nil:
+				w.logger.Warn("failed to match music video",
+					slog.String("path", video.FilePath),
+					slog.Any("error", err),
+				)
+				result.AddError(fmt.Errorf("match %s: %w", video.FilePath, err))
+				failed[video.ID] = true
+				consecutiveErrors++
+				if consecutiveErrors >= maxConsecutiveMatchErrors {
+					w.logger.Warn("music metadata provider keeps failing, stopping matching")
+					break loop
+				}
+				continue
+			}
+			consecutiveErrors = 0
+			result.ItemsProcessed++
+			if got.IsMatched() {
+				matched++
+			} else {
+				unmatched++
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	// Matching may have moved videos to another artist
+	if matched > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty artists", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindMatch)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("matched", matched),
+		slog.Int("unmatched", unmatched),
+		slog.Int("failed", len(failed)),
+	)
+	return nil
+}
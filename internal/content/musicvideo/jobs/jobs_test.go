@@ -0,0 +1,132 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+)
+
+func TestLibraryRoot(t *testing.T) {
+	paths := []string{"/music-videos", "/music-videos/live/", "/concerts"}
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"/music-videos/a-ha - Take On Me.mp4", "/music-videos"},
+		{"/music-videos/live/Queen/Bohemian Rhapsody.mkv", "/music-videos/live"},
+		{"/concerts/Daft Punk - Around the World.mkv", "/concerts"},
+		{"/concertsX/Sandstorm.mkv", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			assert.Equal(t, tt.want, libraryRoot(paths, tt.file))
+		})
+	}
+}
+
+func TestLibraryScanArgs_Kind(t *testing.T) {
+	assert.Equal(t, "musicvideo_library_scan", LibraryScanArgs{}.Kind())
+	assert.Equal(t, "musicvideo_match", MatchArgs{}.Kind())
+}
+
+// matchService serves pending videos and matches them from a script; other
+// methods are not called.
+type matchService struct {
+	musicvideo.Service
+
+	pending []musicvideo.Video
+	errs    map[uuid.UUID]error
+	calls   int
+	pruned  bool
+}
+
+func (s *matchService) ListPendingMatches(_ context.Context, limit int32) ([]musicvideo.Video, error) {
+	if int(limit) < len(s.pending) {
+		return slices.Clone(s.pending[:limit]), nil
+	}
+	return slices.Clone(s.pending), nil
+}
+
+func (s *matchService) MatchVideo(_ context.Context, video *musicvideo.Video) (*musicvideo.Video, error) {
+	s.calls++
+	if err := s.errs[video.ID]; err != nil {
+		return nil, err
+	}
+	for i := range s.pending {
+		if s.pending[i].ID == video.ID {
+			s.pending = append(s.pending[:i], s.pending[i+1:]...)
+			break
+		}
+	}
+	matched := *video
+	matched.MatchStatus = musicvideo.MatchStatusMatched
+	return &matched, nil
+}
+
+func (s *matchService) Prune(context.Context) error {
+	s.pruned = true
+	return nil
+}
+
+func pendingVideos(n int) []musicvideo.Video {
+	videos := make([]musicvideo.Video, n)
+	for i := range videos {
+		videos[i] = musicvideo.Video{ID: uuid.New(), MatchStatus: musicvideo.MatchStatusPending}
+	}
+	return videos
+}
+
+func TestMatchWorker_Work(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	job := &river.Job[MatchArgs]{JobRow: &rivertype.JobRow{ID: 1, Kind: KindMatch}}
+
+	t.Run("matches all pending videos", func(t *testing.T) {
+		svc := &matchService{pending: pendingVideos(matchBatchSize + 3)}
+		require.NoError(t, NewMatchWorker(svc, logger).Work(context.Background(), job))
+		assert.Empty(t, svc.pending)
+		assert.Equal(t, matchBatchSize+3, svc.calls)
+		assert.True(t, svc.pruned)
+	})
+
+	t.Run("skips videos that failed", func(t *testing.T) {
+		svc := &matchService{pending: pendingVideos(3), errs: map[uuid.UUID]error{}}
+		failing := svc.pending[0].ID
+		svc.errs[failing] = errors.New("timeout")
+
+		require.NoError(t, NewMatchWorker(svc, logger).Work(context.Background(), job))
+		require.Len(t, svc.pending, 1)
+		assert.Equal(t, failing, svc.pending[0].ID)
+		assert.Equal(t, 3, svc.calls)
+	})
+
+	t.Run("stops when the provider keeps failing", func(t *testing.T) {
+		svc := &matchService{pending: pendingVideos(20), errs: map[uuid.UUID]error{}}
+		for _, v := range svc.pending {
+			svc.errs[v.ID] = errors.New("503")
+		}
+		require.NoError(t, NewMatchWorker(svc, logger).Work(context.Background(), job))
+		assert.Equal(t, maxConsecutiveMatchErrors, svc.calls)
+		assert.False(t, svc.pruned)
+	})
+
+	t.Run("stops without a provider", func(t *testing.T) {
+		svc := &matchService{pending: pendingVideos(5), errs: map[uuid.UUID]error{}}
+		for _, v := range svc.pending {
+			svc.errs[v.ID] = musicvideo.ErrMatchingUnavailable
+		}
+		require.NoError(t, NewMatchWorker(svc, logger).Work(context.Background(), job))
+		assert.Equal(t, 1, svc.calls)
+	})
+}
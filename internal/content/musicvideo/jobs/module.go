@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// Module provides the music video jobs workers.
+var Module = fx.Module("musicvideojobs",
+	fx.Provide(
+		provideLibraryScanWorker,
+		provideMatchWorker,
+	),
+	fx.Invoke(RegisterWorkers),
+)
+
+// WorkerProviderParams holds common dependencies for worker providers.
+type WorkerProviderParams struct {
+	fx.In
+
+	Service             musicvideo.Service
+	JobClient           *infrajobs.Client
+	NotificationService notification.Service
+	ScanStatusService   *library.Service `optional:"true"`
+	Logger              *slog.Logger
+}
+
+// provideLibraryScanWorker creates a library scan worker.
+func provideLibraryScanWorker(p WorkerProviderParams) *LibraryScanWorker {
+	return NewLibraryScanWorker(p.Service, p.JobClient, p.NotificationService, p.ScanStatusService, p.Logger)
+}
+
+// provideMatchWorker creates a match worker.
+func provideMatchWorker(p WorkerProviderParams) *MatchWorker {
+	return NewMatchWorker(p.Service, p.Logger)
+}
+
+// RegisterWorkers registers all music video job workers with the River workers registry.
+func RegisterWorkers(
+	workers *river.Workers,
+	libraryScanWorker *LibraryScanWorker,
+	matchWorker *MatchWorker,
+) error {
+	river.AddWorker(workers, libraryScanWorker)
+	river.AddWorker(workers, matchWorker)
+	return nil
+}
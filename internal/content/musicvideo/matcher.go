@@ -0,0 +1,76 @@
+package musicvideo
+
+import (
+	"math"
+
+	"github.com/lusoris/revenge/internal/content/shared/matcher"
+)
+
+// DefaultMinMatchConfidence is the score a recording needs before it is
+// accepted as the match of a video.
+const DefaultMinMatchConfidence = 0.6
+
+// BestRecording scores recordings against a video and returns the best
+// one reaching minConfidence, or nil. On equal scores the provider's order
+// decides.
+func BestRecording(video *Video, recordings []Recording, minConfidence float64) *Recording {
+	var best *Recording
+	bestScore := minConfidence
+	for i := range recordings {
+		score := ScoreRecording(video, &recordings[i])
+		if score > bestScore || (best == nil && score == bestScore) {
+			best, bestScore = &recordings[i], score
+		}
+	}
+	return best
+}
+
+// ScoreRecording rates how well a recording matches a video, 0-1. Title
+// and artist similarity weigh most, followed by the provider's own score.
+// Recordings flagged as videos and recordings as long as the file get a
+// bonus; recordings much longer or shorter than the file, like album
+// versions of a radio edit video, a penalty.
+func ScoreRecording(video *Video, rec *Recording) float64 {
+	score := matcher.NewConfidenceScore().
+		Add(matcher.TitleSimilarity(video.Title, rec.Title), 0.5).
+		Add(artistSimilarity(video, rec), 0.3).
+		Add(rec.Score, 0.2)
+
+	if rec.Video {
+		score.AddBonus(0.05)
+	}
+	if video.DurationSeconds > 0 && rec.Length > 0 {
+		diff := math.Abs(video.DurationSeconds - rec.Length.Seconds())
+		switch {
+		case diff <= 10:
+			score.AddBonus(0.05)
+		case diff > 60:
+			score.AddBonus(-0.1)
+		}
+	}
+	return score.Calculate()
+}
+
+// artistSimilarity compares the video's artist with the recording's
+// credit and each credited artist, keeping the best.
+func artistSimilarity(video *Video, rec *Recording) float64 {
+	names := []string{video.ArtistCredit, video.ArtistName}
+	candidates := []string{rec.ArtistCredit}
+	for _, a := range rec.Artists {
+		candidates = append(candidates, a.Name)
+	}
+
+	best := 0.0
+	for _, name := range names {
+		if name == "" || name == UnknownArtist {
+			continue
+		}
+		for _, c := range candidates {
+			if c == "" {
+				continue
+			}
+			best = max(best, matcher.TitleSimilarity(name, c))
+		}
+	}
+	return best
+}
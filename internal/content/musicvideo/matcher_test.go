@@ -0,0 +1,50 @@
+package musicvideo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBestRecording(t *testing.T) {
+	video := &Video{
+		ArtistName:      "a-ha",
+		ArtistCredit:    "a-ha",
+		Title:           "Take On Me",
+		DurationSeconds: 228,
+	}
+
+	albumVersion := Recording{MusicBrainzID: uuid.New(), Title: "Take On Me", ArtistCredit: "a-ha", Length: 290 * time.Second, Score: 1}
+	musicVideo := Recording{MusicBrainzID: uuid.New(), Title: "Take On Me", ArtistCredit: "a-ha", Length: 226 * time.Second, Video: true, Score: 0.95}
+	cover := Recording{MusicBrainzID: uuid.New(), Title: "Take On Me", ArtistCredit: "Weezer", Score: 1}
+
+	t.Run("prefers the video recording of the right length", func(t *testing.T) {
+		best := BestRecording(video, []Recording{cover, albumVersion, musicVideo}, DefaultMinMatchConfidence)
+		require.NotNil(t, best)
+		assert.Equal(t, musicVideo.MusicBrainzID, best.MusicBrainzID)
+	})
+
+	t.Run("cover by another artist scores lower", func(t *testing.T) {
+		assert.Less(t, ScoreRecording(video, &cover), ScoreRecording(video, &albumVersion))
+	})
+
+	t.Run("nothing above the threshold", func(t *testing.T) {
+		other := Recording{MusicBrainzID: uuid.New(), Title: "The Sun Always Shines on T.V.", ArtistCredit: "Weezer", Score: 0.3}
+		assert.Nil(t, BestRecording(video, []Recording{other}, DefaultMinMatchConfidence))
+		assert.Nil(t, BestRecording(video, nil, DefaultMinMatchConfidence))
+	})
+
+	t.Run("featured artists match by credited name", func(t *testing.T) {
+		feat := &Video{ArtistName: "JAY-Z", ArtistCredit: "JAY-Z", Title: "Umbrella"}
+		rec := Recording{
+			Title:        "Umbrella",
+			ArtistCredit: "Rihanna feat. JAY‐Z",
+			Artists:      []RecordingArtist{{Name: "Rihanna"}, {Name: "JAY-Z"}},
+			Score:        0.9,
+		}
+		assert.GreaterOrEqual(t, ScoreRecording(feat, &rec), DefaultMinMatchConfidence)
+	})
+}
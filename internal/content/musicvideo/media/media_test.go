@@ -0,0 +1,29 @@
+package media
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTagYear(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{"1985", 1985},
+		{"1985-10-19", 1985},
+		{"2011-05-03T00:00:00Z", 2011},
+		{"2011/05/03", 2011},
+		{"19851019", 0},
+		{"0000", 0},
+		{"85", 0},
+		{"unknown", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseTagYear(tt.value))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Package media reads music video files with FFmpeg: the container tags
+// naming artist and title, and the streams describing the video.
+package media
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/asticode/go-astiav"
+	"github.com/google/uuid"
+)
+
+// ErrNoVideoStream is returned for files without a video stream.
+var ErrNoVideoStream = errors.New("no video stream")
+
+// Info is what a music video's container tells about it.
+type Info struct {
+	// Container is the file extension, e.g. "mkv".
+	Container string
+
+	// Artist and Title come from the container tags; empty when the
+	// file has none.
+	Artist string
+	Title  string
+	Year   int
+
+	// RecordingID is the MusicBrainz recording written by a tagger such
+	// as Picard; nil when the file has none.
+	RecordingID *uuid.UUID
+
+	DurationSeconds float64
+	Width           int
+	Height          int
+	VideoCodec      string
+	AudioCodec      string
+}
+
+// Tags read for the artist, in order of preference.
+var artistTags = []string{"artist", "album_artist", "performer"}
+
+// Tags read for the release date, in order of preference.
+var dateTags = []string{"date", "year", "originaldate", "date_released"}
+
+// Tags read for the MusicBrainz recording. Picard stores the recording
+// under the historical "track ID" name.
+var recordingTags = []string{"musicbrainz_trackid", "musicbrainz track id", "musicbrainz_recordingid"}
+
+// Probe reads the tags and streams of a video file.
+func Probe(filePath string) (*Info, error) {
+	formatCtx := astiav.AllocFormatContext()
+	if formatCtx == nil {
+		return nil, fmt.Errorf("failed to allocate format context")
+	}
+	defer formatCtx.Free()
+
+	if err := formatCtx.OpenInput(filePath, nil, nil); err != nil {
+		return nil, fmt.Errorf("failed to open input: %w", err)
+	}
+	defer formatCtx.CloseInput()
+
+	if err := formatCtx.FindStreamInfo(nil); err != nil {
+		return nil, fmt.Errorf("failed to find stream info: %w", err)
+	}
+
+	info := &Info{
+		Container:       strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), "."),
+		DurationSeconds: float64(formatCtx.Duration()) / float64(astiav.TimeBase),
+	}
+	if info.DurationSeconds < 0 {
+		info.DurationSeconds = 0
+	}
+
+	tags := formatCtx.Metadata()
+	info.Title = tag(tags, "title")
+	info.Artist = firstTag(tags, artistTags)
+	info.Year = ParseTagYear(firstTag(tags, dateTags))
+	if id, err := uuid.Parse(firstTag(tags, recordingTags)); err == nil {
+		info.RecordingID = &id
+	}
+
+	for _, stream := range formatCtx.Streams() {
+		params := stream.CodecParameters()
+		switch params.MediaType() {
+		case astiav.MediaTypeVideo:
+			if info.VideoCodec != "" {
+				continue
+			}
+			info.VideoCodec = params.CodecID().Name()
+			info.Width = params.Width()
+			info.Height = params.Height()
+		case astiav.MediaTypeAudio:
+			if info.AudioCodec == "" {
+				info.AudioCodec = params.CodecID().Name()
+			}
+		}
+	}
+
+	if info.VideoCodec == "" {
+		return nil, ErrNoVideoStream
+	}
+	return info, nil
+}
+
+// firstTag returns the first of keys with a value.
+func firstTag(d *astiav.Dictionary, keys []string) string {
+	for _, key := range keys {
+		if v := tag(d, key); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// tag returns the trimmed value of a tag, matched case-insensitively.
+func tag(d *astiav.Dictionary, key string) string {
+	if d == nil {
+		return ""
+	}
+	entry := d.Get(key, nil, astiav.NewDictionaryFlags())
+	if entry == nil {
+		return ""
+	}
+	return strings.TrimSpace(entry.Value())
+}
+
+// ParseTagYear reads the year of a date tag: "1985", "1985-10-19" or
+// "1985-10-19T00:00:00Z". It returns 0 for anything else.
+func ParseTagYear(s string) int {
+	if len(s) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(s[:4])
+	if err != nil || year < 1900 || year > 2100 {
+		return 0
+	}
+	if len(s) > 4 && s[4] != '-' && s[4] != 'T' && s[4] != '/' {
+		return 0
+	}
+	return year
+}
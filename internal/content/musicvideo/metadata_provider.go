@@ -0,0 +1,20 @@
+package musicvideo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// MetadataProvider looks up recordings for music videos. Implementations
+// include the shared metadata service adapter, backed by MusicBrainz.
+type MetadataProvider interface {
+	// SearchRecordings searches recordings by artist and title, best first.
+	// It returns no recordings and no error when nothing was found, and
+	// ErrMatchingUnavailable when no provider is configured.
+	SearchRecordings(ctx context.Context, artist, title string) ([]Recording, error)
+
+	// GetRecording fetches a recording by its MusicBrainz ID; nil when it
+	// does not exist.
+	GetRecording(ctx context.Context, id uuid.UUID) (*Recording, error)
+}
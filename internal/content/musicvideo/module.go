@@ -0,0 +1,12 @@
+package musicvideo
+
+import "go.uber.org/fx"
+
+// Module provides the music video content module. The MetadataProvider is
+// injected from the metadatafx module (MusicVideoMetadataAdapter).
+var Module = fx.Module("musicvideo",
+	fx.Provide(
+		NewPostgresRepository,
+		NewService,
+	),
+)
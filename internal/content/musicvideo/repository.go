@@ -0,0 +1,75 @@
+package musicvideo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for music videos
+type Repository interface {
+	// Videos
+	GetVideo(ctx context.Context, id uuid.UUID) (*Video, error)
+	GetVideoByPath(ctx context.Context, filePath string) (*Video, error)
+	UpsertVideo(ctx context.Context, params UpsertVideoParams) (*Video, error)
+	SetVideoMatch(ctx context.Context, params SetVideoMatchParams) (*Video, error)
+	SetVideoUnmatched(ctx context.Context, id uuid.UUID) error
+	ListPendingVideos(ctx context.Context, limit int32) ([]Video, error)
+	ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, error)
+	CountVideos(ctx context.Context, filters VideoListFilters) (int64, error)
+	ListVideoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error)
+	DeleteVideo(ctx context.Context, id uuid.UUID) error
+
+	// Artists
+	GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error)
+	GetArtistByMusicBrainzID(ctx context.Context, libraryID, musicBrainzID uuid.UUID) (*Artist, error)
+	UpsertArtist(ctx context.Context, libraryID uuid.UUID, name, nameKey, sortName string) (*Artist, error)
+	SetArtistMusicBrainz(ctx context.Context, params SetArtistMusicBrainzParams) (*Artist, error)
+	ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error)
+	CountArtists(ctx context.Context, filters ArtistListFilters) (int64, error)
+	DeleteOrphanArtists(ctx context.Context) (int64, error)
+}
+
+// UpsertVideoParams contains parameters for creating or updating a video
+// from its file
+type UpsertVideoParams struct {
+	LibraryID              uuid.UUID
+	ArtistID               uuid.UUID
+	FilePath               string
+	FileSize               int64
+	Container              *string
+	Title                  string
+	TitleSource            string
+	SortTitle              string
+	ArtistCredit           string
+	Year                   *int32
+	DurationSeconds        float64
+	Width                  int32
+	Height                 int32
+	VideoCodec             *string
+	AudioCodec             *string
+	MusicBrainzRecordingID *uuid.UUID
+	MatchStatus            string
+	MatchedAt              *time.Time
+}
+
+// SetVideoMatchParams contains the MusicBrainz match of a video
+type SetVideoMatchParams struct {
+	ID                     uuid.UUID
+	ArtistID               uuid.UUID
+	Title                  string
+	SortTitle              string
+	ArtistCredit           string
+	Year                   *int32
+	MusicBrainzRecordingID uuid.UUID
+}
+
+// SetArtistMusicBrainzParams contains the MusicBrainz identity of an artist
+type SetArtistMusicBrainzParams struct {
+	ID             uuid.UUID
+	MusicBrainzID  uuid.UUID
+	Name           string
+	SortName       string
+	Disambiguation *string
+}
@@ -0,0 +1,323 @@
+package musicvideo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	musicvideodb "github.com/lusoris/revenge/internal/content/musicvideo/db"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *musicvideodb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: musicvideodb.New(pool),
+	}
+}
+
+// =============================================================================
+// Videos
+// =============================================================================
+
+func (r *postgresRepository) GetVideo(ctx context.Context, id uuid.UUID) (*Video, error) {
+	row, err := r.queries.GetVideo(ctx, id)
+	if err != nil {
+		return nil, videoError(err)
+	}
+	return dbVideoToVideo(row.MusicvideoVideo, row.ArtistName), nil
+}
+
+func (r *postgresRepository) GetVideoByPath(ctx context.Context, filePath string) (*Video, error) {
+	row, err := r.queries.GetVideoByPath(ctx, filePath)
+	if err != nil {
+		return nil, videoError(err)
+	}
+	return dbVideoToVideo(row.MusicvideoVideo, row.ArtistName), nil
+}
+
+func (r *postgresRepository) UpsertVideo(ctx context.Context, params UpsertVideoParams) (*Video, error) {
+	v, err := r.queries.UpsertVideo(ctx, musicvideodb.UpsertVideoParams{
+		LibraryID:              params.LibraryID,
+		ArtistID:               params.ArtistID,
+		FilePath:               params.FilePath,
+		FileSize:               params.FileSize,
+		Container:              params.Container,
+		Title:                  params.Title,
+		TitleSource:            params.TitleSource,
+		SortTitle:              params.SortTitle,
+		ArtistCredit:           params.ArtistCredit,
+		Year:                   params.Year,
+		DurationSeconds:        params.DurationSeconds,
+		Width:                  params.Width,
+		Height:                 params.Height,
+		VideoCodec:             params.VideoCodec,
+		AudioCodec:             params.AudioCodec,
+		MusicbrainzRecordingID: uuidToPg(params.MusicBrainzRecordingID),
+		MatchStatus:            params.MatchStatus,
+		MatchedAt:              timeToPg(params.MatchedAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert music video: %w", err)
+	}
+	return dbVideoToVideo(v, ""), nil
+}
+
+func (r *postgresRepository) SetVideoMatch(ctx context.Context, params SetVideoMatchParams) (*Video, error) {
+	v, err := r.queries.SetVideoMatch(ctx, musicvideodb.SetVideoMatchParams{
+		ID:                     params.ID,
+		ArtistID:               params.ArtistID,
+		Title:                  params.Title,
+		SortTitle:              params.SortTitle,
+		ArtistCredit:           params.ArtistCredit,
+		Year:                   params.Year,
+		MusicbrainzRecordingID: uuidToPg(&params.MusicBrainzRecordingID),
+	})
+	if err != nil {
+		return nil, videoError(err)
+	}
+	return dbVideoToVideo(v, ""), nil
+}
+
+func (r *postgresRepository) SetVideoUnmatched(ctx context.Context, id uuid.UUID) error {
+	return r.queries.SetVideoUnmatched(ctx, id)
+}
+
+func (r *postgresRepository) ListPendingVideos(ctx context.Context, limit int32) ([]Video, error) {
+	rows, err := r.queries.ListPendingVideos(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending music videos: %w", err)
+	}
+	videos := make([]Video, len(rows))
+	for i, row := range rows {
+		videos[i] = *dbVideoToVideo(row.MusicvideoVideo, row.ArtistName)
+	}
+	return videos, nil
+}
+
+func (r *postgresRepository) ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, error) {
+	rows, err := r.queries.ListVideos(ctx, musicvideodb.ListVideosParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		ArtistID:   uuidToPg(filters.ArtistID),
+		Sort:       string(filters.Sort),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list music videos: %w", err)
+	}
+	videos := make([]Video, len(rows))
+	for i, row := range rows {
+		videos[i] = *dbVideoToVideo(row.MusicvideoVideo, row.ArtistName)
+	}
+	return videos, nil
+}
+
+func (r *postgresRepository) CountVideos(ctx context.Context, filters VideoListFilters) (int64, error) {
+	return r.queries.CountVideos(ctx, musicvideodb.CountVideosParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		ArtistID:   uuidToPg(filters.ArtistID),
+	})
+}
+
+func (r *postgresRepository) ListVideoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ListVideoPaths(ctx, libraryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list music video paths: %w", err)
+	}
+	paths := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		paths[row.FilePath] = row.ID
+	}
+	return paths, nil
+}
+
+func (r *postgresRepository) DeleteVideo(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteVideo(ctx, id)
+}
+
+// =============================================================================
+// Artists
+// =============================================================================
+
+func (r *postgresRepository) GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error) {
+	row, err := r.queries.GetArtist(ctx, id)
+	if err != nil {
+		return nil, artistError(err)
+	}
+	return dbArtistToArtist(row.MusicvideoArtist, row.VideoCount), nil
+}
+
+func (r *postgresRepository) GetArtistByMusicBrainzID(ctx context.Context, libraryID, musicBrainzID uuid.UUID) (*Artist, error) {
+	a, err := r.queries.GetArtistByMusicBrainzID(ctx, musicvideodb.GetArtistByMusicBrainzIDParams{
+		LibraryID:           libraryID,
+		MusicbrainzArtistID: uuidToPg(&musicBrainzID),
+	})
+	if err != nil {
+		return nil, artistError(err)
+	}
+	return dbArtistToArtist(a, 0), nil
+}
+
+func (r *postgresRepository) UpsertArtist(ctx context.Context, libraryID uuid.UUID, name, nameKey, sortName string) (*Artist, error) {
+	a, err := r.queries.UpsertArtist(ctx, musicvideodb.UpsertArtistParams{
+		LibraryID: libraryID,
+		Name:      name,
+		NameKey:   nameKey,
+		SortName:  sortName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert music video artist: %w", err)
+	}
+	return dbArtistToArtist(a, 0), nil
+}
+
+func (r *postgresRepository) SetArtistMusicBrainz(ctx context.Context, params SetArtistMusicBrainzParams) (*Artist, error) {
+	a, err := r.queries.SetArtistMusicBrainz(ctx, musicvideodb.SetArtistMusicBrainzParams{
+		ID:                  params.ID,
+		MusicbrainzArtistID: uuidToPg(&params.MusicBrainzID),
+		Name:                params.Name,
+		SortName:            params.SortName,
+		Disambiguation:      params.Disambiguation,
+	})
+	if err != nil {
+		return nil, artistError(err)
+	}
+	return dbArtistToArtist(a, 0), nil
+}
+
+func (r *postgresRepository) ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error) {
+	rows, err := r.queries.ListArtists(ctx, musicvideodb.ListArtistsParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list music video artists: %w", err)
+	}
+	artists := make([]Artist, len(rows))
+	for i, row := range rows {
+		artists[i] = *dbArtistToArtist(row.MusicvideoArtist, row.VideoCount)
+	}
+	return artists, nil
+}
+
+func (r *postgresRepository) CountArtists(ctx context.Context, filters ArtistListFilters) (int64, error) {
+	return r.queries.CountArtists(ctx, musicvideodb.CountArtistsParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+	})
+}
+
+func (r *postgresRepository) DeleteOrphanArtists(ctx context.Context) (int64, error) {
+	return r.queries.DeleteOrphanArtists(ctx)
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func videoError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrVideoNotFound
+	}
+	return fmt.Errorf("failed to get music video: %w", err)
+}
+
+func artistError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrArtistNotFound
+	}
+	return fmt.Errorf("failed to get music video artist: %w", err)
+}
+
+// dbVideoToVideo converts a video row; artistName is empty for rows
+// written without joining the artist.
+func dbVideoToVideo(v musicvideodb.MusicvideoVideo, artistName string) *Video {
+	return &Video{
+		ID:                     v.ID,
+		LibraryID:              v.LibraryID,
+		ArtistID:               v.ArtistID,
+		FilePath:               v.FilePath,
+		FileSize:               v.FileSize,
+		Container:              v.Container,
+		Title:                  v.Title,
+		TitleSource:            v.TitleSource,
+		SortTitle:              v.SortTitle,
+		ArtistName:             artistName,
+		ArtistCredit:           v.ArtistCredit,
+		Year:                   v.Year,
+		DurationSeconds:        v.DurationSeconds,
+		Width:                  v.Width,
+		Height:                 v.Height,
+		VideoCodec:             v.VideoCodec,
+		AudioCodec:             v.AudioCodec,
+		MusicBrainzRecordingID: pgToUUID(v.MusicbrainzRecordingID),
+		MatchStatus:            v.MatchStatus,
+		MatchedAt:              pgToTime(v.MatchedAt),
+		CreatedAt:              v.CreatedAt,
+		UpdatedAt:              v.UpdatedAt,
+	}
+}
+
+func dbArtistToArtist(a musicvideodb.MusicvideoArtist, videoCount int64) *Artist {
+	return &Artist{
+		ID:             a.ID,
+		LibraryID:      a.LibraryID,
+		Name:           a.Name,
+		SortName:       a.SortName,
+		Disambiguation: a.Disambiguation,
+		MusicBrainzID:  pgToUUID(a.MusicbrainzArtistID),
+		VideoCount:     videoCount,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil || *id == uuid.Nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func timeToPg(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func pgToTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,383 @@
+package musicvideo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// UnknownArtist groups videos whose artist neither the tags nor the file
+// name tell.
+const UnknownArtist = "Unknown Artist"
+
+// Service defines business logic for music videos. Library permissions are
+// checked by the caller.
+type Service interface {
+	// Video operations
+	GetVideo(ctx context.Context, id uuid.UUID) (*Video, error)
+	ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, int64, error)
+
+	// Artist operations
+	GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error)
+	ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, int64, error)
+
+	// Library import
+	GetVideoByPath(ctx context.Context, filePath string) (*Video, error)
+	ImportVideo(ctx context.Context, scanned *ScannedVideo) (*Video, error)
+	RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error)
+	Prune(ctx context.Context) error
+
+	// Matching
+	ListPendingMatches(ctx context.Context, limit int32) ([]Video, error)
+	MatchVideo(ctx context.Context, video *Video) (*Video, error)
+}
+
+// musicVideoService implements the Service interface
+type musicVideoService struct {
+	repo Repository
+
+	// provider looks up recordings; nil when matching is unavailable
+	provider MetadataProvider
+
+	minConfidence float64
+}
+
+// NewService creates a new music video service. Recordings are looked up
+// through the metadata provider, which may be nil.
+func NewService(repo Repository, provider MetadataProvider) Service {
+	return &musicVideoService{
+		repo:          repo,
+		provider:      provider,
+		minConfidence: DefaultMinMatchConfidence,
+	}
+}
+
+// =============================================================================
+// Video Operations
+// =============================================================================
+
+func (s *musicVideoService) GetVideo(ctx context.Context, id uuid.UUID) (*Video, error) {
+	return s.repo.GetVideo(ctx, id)
+}
+
+func (s *musicVideoService) GetVideoByPath(ctx context.Context, filePath string) (*Video, error) {
+	return s.repo.GetVideoByPath(ctx, filePath)
+}
+
+func (s *musicVideoService) ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, int64, error) {
+	if !filters.Sort.Valid() {
+		filters.Sort = VideoSortArtist
+	}
+	videos, err := s.repo.ListVideos(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountVideos(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return videos, count, nil
+}
+
+// =============================================================================
+// Artist Operations
+// =============================================================================
+
+func (s *musicVideoService) GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error) {
+	return s.repo.GetArtist(ctx, id)
+}
+
+func (s *musicVideoService) ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, int64, error) {
+	artists, err := s.repo.ListArtists(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountArtists(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return artists, count, nil
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+// ImportVideo stores a scanned video under its artist. A matched video
+// whose file did not change keeps its match; any other video waits for
+// the match job.
+func (s *musicVideoService) ImportVideo(ctx context.Context, scanned *ScannedVideo) (*Video, error) {
+	params := UpsertVideoParams{
+		LibraryID:       scanned.LibraryID,
+		FilePath:        scanned.FilePath,
+		FileSize:        scanned.FileSize,
+		Container:       optionalString(scanned.Container),
+		DurationSeconds: scanned.DurationSeconds,
+		Width:           util.SafeIntToInt32(scanned.Width),
+		Height:          util.SafeIntToInt32(scanned.Height),
+		VideoCodec:      optionalString(scanned.VideoCodec),
+		AudioCodec:      optionalString(scanned.AudioCodec),
+	}
+
+	existing, err := s.repo.GetVideoByPath(ctx, scanned.FilePath)
+	if err != nil && !errors.Is(err, ErrVideoNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.IsMatched() &&
+		existing.LibraryID == scanned.LibraryID && existing.FileSize == scanned.FileSize {
+		params.ArtistID = existing.ArtistID
+		params.Title = existing.Title
+		params.TitleSource = existing.TitleSource
+		params.SortTitle = existing.SortTitle
+		params.ArtistCredit = existing.ArtistCredit
+		params.Year = existing.Year
+		params.MusicBrainzRecordingID = existing.MusicBrainzRecordingID
+		params.MatchStatus = existing.MatchStatus
+		params.MatchedAt = existing.MatchedAt
+		return s.repo.UpsertVideo(ctx, params)
+	}
+
+	name := strings.TrimSpace(scanned.Artist)
+	if name == "" {
+		name = UnknownArtist
+	}
+	artist, err := s.repo.UpsertArtist(ctx, scanned.LibraryID, name, NameKey(name), SortName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(scanned.Title)
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(scanned.FilePath), filepath.Ext(scanned.FilePath))
+	}
+	credit := strings.TrimSpace(scanned.ArtistCredit)
+	if credit == "" {
+		credit = name
+	}
+
+	params.ArtistID = artist.ID
+	params.Title = title
+	params.TitleSource = scanned.TitleSource
+	params.SortTitle = strings.ToLower(title)
+	params.ArtistCredit = credit
+	if scanned.Year > 0 {
+		year := util.SafeIntToInt32(scanned.Year)
+		params.Year = &year
+	}
+	params.MusicBrainzRecordingID = scanned.MusicBrainzRecordingID
+	params.MatchStatus = MatchStatusPending
+
+	video, err := s.repo.UpsertVideo(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	video.ArtistName = artist.Name
+	return video, nil
+}
+
+// RemoveMissing deletes the videos of a library under the given roots whose
+// file was not found by the last scan. It returns the number of videos
+// removed.
+func (s *musicVideoService) RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error) {
+	paths, err := s.repo.ListVideoPaths(ctx, libraryID)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for path, id := range paths {
+		if present[path] || !underAny(path, roots) {
+			continue
+		}
+		if err := s.repo.DeleteVideo(ctx, id); err != nil {
+			return removed, fmt.Errorf("failed to delete video %s: %w", path, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes artists left without videos.
+func (s *musicVideoService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteOrphanArtists(ctx); err != nil {
+		return fmt.Errorf("failed to delete orphan artists: %w", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// Matching
+// =============================================================================
+
+func (s *musicVideoService) ListPendingMatches(ctx context.Context, limit int32) ([]Video, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	return s.repo.ListPendingVideos(ctx, limit)
+}
+
+// MatchVideo identifies a video's recording on MusicBrainz. A recording ID
+// from the tags is looked up directly; otherwise the best search result
+// above the confidence threshold is taken. The matched title, credit and
+// year replace what the scan read, and the video moves to the artist
+// credited first. Videos without a good enough result are marked
+// unmatched.
+func (s *musicVideoService) MatchVideo(ctx context.Context, video *Video) (*Video, error) {
+	if s.provider == nil {
+		return nil, ErrMatchingUnavailable
+	}
+
+	var best *Recording
+	if video.MusicBrainzRecordingID != nil {
+		rec, err := s.provider.GetRecording(ctx, *video.MusicBrainzRecordingID)
+		if err != nil {
+			return nil, fmt.Errorf("look up recording of %s: %w", video.FilePath, err)
+		}
+		best = rec
+	}
+	if best == nil {
+		artist := video.ArtistCredit
+		if artist == UnknownArtist {
+			artist = ""
+		}
+		recs, err := s.provider.SearchRecordings(ctx, artist, video.Title)
+		if err != nil {
+			return nil, fmt.Errorf("search recording of %s: %w", video.FilePath, err)
+		}
+		best = BestRecording(video, recs, s.minConfidence)
+	}
+
+	if best == nil {
+		if err := s.repo.SetVideoUnmatched(ctx, video.ID); err != nil {
+			return nil, err
+		}
+		unmatched := *video
+		unmatched.MatchStatus = MatchStatusUnmatched
+		return &unmatched, nil
+	}
+	return s.applyMatch(ctx, video, best)
+}
+
+// applyMatch stores a recording as the match of a video.
+func (s *musicVideoService) applyMatch(ctx context.Context, video *Video, rec *Recording) (*Video, error) {
+	artistID, artistName := video.ArtistID, video.ArtistName
+	if len(rec.Artists) > 0 {
+		artist, err := s.ensureMusicBrainzArtist(ctx, video.LibraryID, rec.Artists[0])
+		if err != nil {
+			return nil, err
+		}
+		artistID, artistName = artist.ID, artist.Name
+	}
+
+	credit := rec.ArtistCredit
+	if credit == "" {
+		credit = video.ArtistCredit
+	}
+	year := video.Year
+	if rec.Year != nil {
+		y := util.SafeIntToInt32(*rec.Year)
+		year = &y
+	}
+
+	matched, err := s.repo.SetVideoMatch(ctx, SetVideoMatchParams{
+		ID:                     video.ID,
+		ArtistID:               artistID,
+		Title:                  rec.Title,
+		SortTitle:              strings.ToLower(rec.Title),
+		ArtistCredit:           credit,
+		Year:                   year,
+		MusicBrainzRecordingID: rec.MusicBrainzID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	matched.ArtistName = artistName
+	return matched, nil
+}
+
+// ensureMusicBrainzArtist returns the library's artist with a MusicBrainz
+// ID. An artist known by the same name takes the ID, unless a namesake
+// already claimed it; otherwise the artist is created.
+func (s *musicVideoService) ensureMusicBrainzArtist(ctx context.Context, libraryID uuid.UUID, ra RecordingArtist) (*Artist, error) {
+	artist, err := s.repo.GetArtistByMusicBrainzID(ctx, libraryID, ra.MusicBrainzID)
+	if err == nil {
+		return artist, nil
+	}
+	if !errors.Is(err, ErrArtistNotFound) {
+		return nil, err
+	}
+
+	sortName := ra.SortName
+	if sortName == "" {
+		sortName = SortName(ra.Name)
+	}
+	artist, err = s.repo.UpsertArtist(ctx, libraryID, ra.Name, NameKey(ra.Name), sortName)
+	if err != nil {
+		return nil, err
+	}
+	if artist.MusicBrainzID != nil {
+		return artist, nil
+	}
+	return s.repo.SetArtistMusicBrainz(ctx, SetArtistMusicBrainzParams{
+		ID:             artist.ID,
+		MusicBrainzID:  ra.MusicBrainzID,
+		Name:           ra.Name,
+		SortName:       sortName,
+		Disambiguation: optionalString(ra.Disambiguation),
+	})
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// NameKey normalizes an artist name for grouping: lowercase letters and
+// digits, single spaces.
+func NameKey(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case unicode.IsSpace(r) || r == '_':
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// SortName moves a leading article behind the name, as MusicBrainz sort
+// names do: "The Beatles" sorts as "Beatles, The".
+func SortName(name string) string {
+	name = strings.TrimSpace(name)
+	for _, article := range []string{"The ", "A ", "An "} {
+		if len(name) > len(article) && strings.EqualFold(name[:len(article)], article) {
+			return name[len(article):] + ", " + strings.TrimSpace(name[:len(article)])
+		}
+	}
+	return name
+}
+
+func underAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s string) *string {
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
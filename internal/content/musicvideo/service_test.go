@@ -0,0 +1,430 @@
+package musicvideo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Videos
+func (m *MockRepository) GetVideo(ctx context.Context, id uuid.UUID) (*Video, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) GetVideoByPath(ctx context.Context, filePath string) (*Video, error) {
+	args := m.Called(ctx, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) UpsertVideo(ctx context.Context, params UpsertVideoParams) (*Video, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) SetVideoMatch(ctx context.Context, params SetVideoMatchParams) (*Video, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Video), args.Error(1)
+}
+
+func (m *MockRepository) SetVideoUnmatched(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockRepository) ListPendingVideos(ctx context.Context, limit int32) ([]Video, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]Video), args.Error(1)
+}
+
+func (m *MockRepository) ListVideos(ctx context.Context, filters VideoListFilters) ([]Video, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Video), args.Error(1)
+}
+
+func (m *MockRepository) CountVideos(ctx context.Context, filters VideoListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListVideoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	args := m.Called(ctx, libraryID)
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) DeleteVideo(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// Artists
+func (m *MockRepository) GetArtist(ctx context.Context, id uuid.UUID) (*Artist, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) GetArtistByMusicBrainzID(ctx context.Context, libraryID, musicBrainzID uuid.UUID) (*Artist, error) {
+	args := m.Called(ctx, libraryID, musicBrainzID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) UpsertArtist(ctx context.Context, libraryID uuid.UUID, name, nameKey, sortName string) (*Artist, error) {
+	args := m.Called(ctx, libraryID, name, nameKey, sortName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) SetArtistMusicBrainz(ctx context.Context, params SetArtistMusicBrainzParams) (*Artist, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Artist), args.Error(1)
+}
+
+func (m *MockRepository) ListArtists(ctx context.Context, filters ArtistListFilters) ([]Artist, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Artist), args.Error(1)
+}
+
+func (m *MockRepository) CountArtists(ctx context.Context, filters ArtistListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrphanArtists(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// stubProvider returns fixed recordings.
+type stubProvider struct {
+	results   []Recording
+	byID      map[uuid.UUID]*Recording
+	err       error
+	searchedA string
+	searchedT string
+}
+
+func (p *stubProvider) SearchRecordings(_ context.Context, artist, title string) ([]Recording, error) {
+	p.searchedA, p.searchedT = artist, title
+	return p.results, p.err
+}
+
+func (p *stubProvider) GetRecording(_ context.Context, id uuid.UUID) (*Recording, error) {
+	return p.byID[id], p.err
+}
+
+var (
+	ahaID       = uuid.MustParse("7364dea6-ca9a-48e3-be01-b44ad0d19897")
+	takeOnMeID  = uuid.MustParse("b1a9c0e9-d987-4042-ae91-78d6a3267d69")
+	coverBandID = uuid.MustParse("0e8f4f36-cd2b-4d47-a6a4-6c1f1ad0b1c2")
+)
+
+func takeOnMe() Recording {
+	year := 1985
+	return Recording{
+		MusicBrainzID: takeOnMeID,
+		Title:         "Take On Me",
+		ArtistCredit:  "a-ha",
+		Artists:       []RecordingArtist{{MusicBrainzID: ahaID, Name: "a-ha", SortName: "a-ha", Disambiguation: "Norwegian band"}},
+		Length:        225 * time.Second,
+		Year:          &year,
+		Video:         true,
+		Score:         1,
+	}
+}
+
+func TestService_ListVideos_DefaultSort(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+
+	want := VideoListFilters{Sort: VideoSortArtist, Limit: 20}
+	repo.On("ListVideos", ctx, want).Return([]Video{{Title: "Take On Me"}}, nil)
+	repo.On("CountVideos", ctx, want).Return(int64(1), nil)
+
+	videos, total, err := svc.ListVideos(ctx, VideoListFilters{Sort: "bogus", Limit: 20})
+	require.NoError(t, err)
+	assert.Len(t, videos, 1)
+	assert.Equal(t, int64(1), total)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ImportVideo(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	libraryID := uuid.New()
+	artist := &Artist{ID: uuid.New(), LibraryID: libraryID, Name: "a-ha"}
+
+	scanned := &ScannedVideo{
+		LibraryID:    libraryID,
+		FilePath:     "/music-videos/a-ha - Take On Me.mp4",
+		FileSize:     1000,
+		Container:    "mp4",
+		Artist:       "a-ha",
+		ArtistCredit: "a-ha",
+		Title:        "Take On Me",
+		TitleSource:  TitleSourceFilename,
+		Year:         1985,
+		Width:        1440,
+		Height:       1080,
+		VideoCodec:   "h264",
+	}
+
+	repo.On("GetVideoByPath", ctx, scanned.FilePath).Return(nil, ErrVideoNotFound)
+	repo.On("UpsertArtist", ctx, libraryID, "a-ha", "aha", "a-ha").Return(artist, nil)
+	repo.On("UpsertVideo", ctx, mock.MatchedBy(func(p UpsertVideoParams) bool {
+		return p.ArtistID == artist.ID &&
+			p.Title == "Take On Me" &&
+			p.SortTitle == "take on me" &&
+			p.ArtistCredit == "a-ha" &&
+			p.Year != nil && *p.Year == 1985 &&
+			p.MatchStatus == MatchStatusPending &&
+			p.Width == 1440 &&
+			*p.Container == "mp4" &&
+			p.AudioCodec == nil
+	})).Return(&Video{ID: uuid.New(), ArtistID: artist.ID, Title: "Take On Me"}, nil)
+
+	video, err := svc.ImportVideo(ctx, scanned)
+	require.NoError(t, err)
+	assert.Equal(t, "a-ha", video.ArtistName)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ImportVideo_UnknownArtist(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	libraryID := uuid.New()
+
+	scanned := &ScannedVideo{LibraryID: libraryID, FilePath: "/mv/Sandstorm.mkv", TitleSource: TitleSourceFilename}
+	repo.On("GetVideoByPath", ctx, scanned.FilePath).Return(nil, ErrVideoNotFound)
+	repo.On("UpsertArtist", ctx, libraryID, UnknownArtist, "unknown artist", UnknownArtist).
+		Return(&Artist{ID: uuid.New(), Name: UnknownArtist}, nil)
+	repo.On("UpsertVideo", ctx, mock.MatchedBy(func(p UpsertVideoParams) bool {
+		return p.Title == "Sandstorm" && p.ArtistCredit == UnknownArtist && p.Year == nil
+	})).Return(&Video{ID: uuid.New()}, nil)
+
+	_, err := svc.ImportVideo(ctx, scanned)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ImportVideo_KeepsMatch(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	libraryID := uuid.New()
+	matchedAt := time.Now()
+	year := int32(1985)
+
+	existing := &Video{
+		ID:                     uuid.New(),
+		LibraryID:              libraryID,
+		ArtistID:               uuid.New(),
+		FilePath:               "/mv/aha.mp4",
+		FileSize:               1000,
+		Title:                  "Take On Me",
+		TitleSource:            TitleSourceMusicBrainz,
+		SortTitle:              "take on me",
+		ArtistCredit:           "a-ha",
+		Year:                   &year,
+		MusicBrainzRecordingID: &takeOnMeID,
+		MatchStatus:            MatchStatusMatched,
+		MatchedAt:              &matchedAt,
+	}
+	scanned := &ScannedVideo{LibraryID: libraryID, FilePath: existing.FilePath, FileSize: 1000, Artist: "aha", Title: "take on me (hd)"}
+
+	repo.On("GetVideoByPath", ctx, existing.FilePath).Return(existing, nil)
+	repo.On("UpsertVideo", ctx, mock.MatchedBy(func(p UpsertVideoParams) bool {
+		return p.ArtistID == existing.ArtistID &&
+			p.Title == "Take On Me" &&
+			p.TitleSource == TitleSourceMusicBrainz &&
+			p.MatchStatus == MatchStatusMatched &&
+			*p.MusicBrainzRecordingID == takeOnMeID
+	})).Return(existing, nil)
+
+	_, err := svc.ImportVideo(ctx, scanned)
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "UpsertArtist", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestService_MatchVideo_Search(t *testing.T) {
+	repo := new(MockRepository)
+	cover := Recording{
+		MusicBrainzID: uuid.New(),
+		Title:         "Take On Me",
+		ArtistCredit:  "Cover Band",
+		Artists:       []RecordingArtist{{MusicBrainzID: coverBandID, Name: "Cover Band"}},
+		Length:        300 * time.Second,
+		Score:         1,
+	}
+	provider := &stubProvider{results: []Recording{cover, takeOnMe()}}
+	svc := NewService(repo, provider)
+	ctx := context.Background()
+	libraryID := uuid.New()
+	ahaArtist := &Artist{ID: uuid.New(), LibraryID: libraryID, Name: "a-ha"}
+
+	video := &Video{
+		ID:              uuid.New(),
+		LibraryID:       libraryID,
+		ArtistID:        ahaArtist.ID,
+		ArtistName:      "a-ha",
+		ArtistCredit:    "a-ha",
+		Title:           "Take On Me",
+		DurationSeconds: 228,
+		MatchStatus:     MatchStatusPending,
+	}
+
+	repo.On("GetArtistByMusicBrainzID", ctx, libraryID, ahaID).Return(nil, ErrArtistNotFound)
+	repo.On("UpsertArtist", ctx, libraryID, "a-ha", "aha", "a-ha").Return(ahaArtist, nil)
+	repo.On("SetArtistMusicBrainz", ctx, mock.MatchedBy(func(p SetArtistMusicBrainzParams) bool {
+		return p.ID == ahaArtist.ID && p.MusicBrainzID == ahaID && *p.Disambiguation == "Norwegian band"
+	})).Return(ahaArtist, nil)
+	repo.On("SetVideoMatch", ctx, mock.MatchedBy(func(p SetVideoMatchParams) bool {
+		return p.ID == video.ID &&
+			p.ArtistID == ahaArtist.ID &&
+			p.MusicBrainzRecordingID == takeOnMeID &&
+			*p.Year == 1985
+	})).Return(&Video{ID: video.ID, MatchStatus: MatchStatusMatched}, nil)
+
+	matched, err := svc.MatchVideo(ctx, video)
+	require.NoError(t, err)
+	assert.True(t, matched.IsMatched())
+	assert.Equal(t, "a-ha", matched.ArtistName)
+	assert.Equal(t, "a-ha", provider.searchedA)
+	assert.Equal(t, "Take On Me", provider.searchedT)
+	repo.AssertExpectations(t)
+}
+
+func TestService_MatchVideo_TaggedRecording(t *testing.T) {
+	repo := new(MockRepository)
+	rec := takeOnMe()
+	provider := &stubProvider{byID: map[uuid.UUID]*Recording{takeOnMeID: &rec}}
+	svc := NewService(repo, provider)
+	ctx := context.Background()
+	libraryID := uuid.New()
+	ahaArtist := &Artist{ID: uuid.New(), LibraryID: libraryID, Name: "a-ha", MusicBrainzID: &ahaID}
+
+	// The tags name a recording; its title and artist win over the file's
+	video := &Video{
+		ID:                     uuid.New(),
+		LibraryID:              libraryID,
+		ArtistID:               uuid.New(),
+		ArtistCredit:           UnknownArtist,
+		Title:                  "VID_0042",
+		MusicBrainzRecordingID: &takeOnMeID,
+	}
+
+	repo.On("GetArtistByMusicBrainzID", ctx, libraryID, ahaID).Return(ahaArtist, nil)
+	repo.On("SetVideoMatch", ctx, mock.MatchedBy(func(p SetVideoMatchParams) bool {
+		return p.ArtistID == ahaArtist.ID && p.Title == "Take On Me" && p.ArtistCredit == "a-ha"
+	})).Return(&Video{ID: video.ID, MatchStatus: MatchStatusMatched}, nil)
+
+	_, err := svc.MatchVideo(ctx, video)
+	require.NoError(t, err)
+	assert.Empty(t, provider.searchedT, "no search for tagged recordings")
+	repo.AssertExpectations(t)
+}
+
+func TestService_MatchVideo_NoGoodResult(t *testing.T) {
+	repo := new(MockRepository)
+	provider := &stubProvider{results: []Recording{{
+		MusicBrainzID: uuid.New(),
+		Title:         "Something Else Entirely",
+		ArtistCredit:  "Other Artist",
+		Score:         0.4,
+	}}}
+	svc := NewService(repo, provider)
+	ctx := context.Background()
+
+	video := &Video{ID: uuid.New(), ArtistName: "a-ha", ArtistCredit: "a-ha", Title: "Take On Me"}
+	repo.On("SetVideoUnmatched", ctx, video.ID).Return(nil)
+
+	result, err := svc.MatchVideo(ctx, video)
+	require.NoError(t, err)
+	assert.Equal(t, MatchStatusUnmatched, result.MatchStatus)
+	repo.AssertExpectations(t)
+}
+
+func TestService_MatchVideo_Unavailable(t *testing.T) {
+	repo := new(MockRepository)
+	ctx := context.Background()
+	video := &Video{ID: uuid.New(), Title: "Take On Me"}
+
+	_, err := NewService(repo, nil).MatchVideo(ctx, video)
+	assert.ErrorIs(t, err, ErrMatchingUnavailable)
+
+	// Provider errors leave the video pending for the next run
+	_, err = NewService(repo, &stubProvider{err: errors.New("timeout")}).MatchVideo(ctx, video)
+	assert.Error(t, err)
+	repo.AssertNotCalled(t, "SetVideoUnmatched", mock.Anything, mock.Anything)
+}
+
+func TestService_RemoveMissing(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	libraryID := uuid.New()
+	gone, kept, elsewhere := uuid.New(), uuid.New(), uuid.New()
+
+	repo.On("ListVideoPaths", ctx, libraryID).Return(map[string]uuid.UUID{
+		"/mv/gone.mp4":       gone,
+		"/mv/kept.mp4":       kept,
+		"/other/outside.mp4": elsewhere,
+	}, nil)
+	repo.On("DeleteVideo", ctx, gone).Return(nil)
+
+	removed, err := svc.RemoveMissing(ctx, libraryID, []string{"/mv"}, map[string]bool{"/mv/kept.mp4": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	repo.AssertExpectations(t)
+}
+
+func TestNameKey(t *testing.T) {
+	assert.Equal(t, "aha", NameKey("a-ha"))
+	assert.Equal(t, "aha", NameKey("A-Ha"))
+	assert.Equal(t, "guns n roses", NameKey("Guns N' Roses"))
+	assert.Equal(t, "beyoncé", NameKey("  Beyoncé "))
+	assert.Equal(t, "acdc", NameKey("AC/DC "))
+}
+
+func TestSortName(t *testing.T) {
+	assert.Equal(t, "Beatles, The", SortName("The Beatles"))
+	assert.Equal(t, "Perfect Circle, A", SortName("A Perfect Circle"))
+	assert.Equal(t, "a-ha", SortName("a-ha"))
+	assert.Equal(t, "Theory of a Deadman", SortName("Theory of a Deadman"))
+	assert.Equal(t, "The", SortName("The"))
+}
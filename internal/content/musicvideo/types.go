@@ -0,0 +1,170 @@
+// Package musicvideo provides types and services for music video
+// libraries. Music videos are grouped by artist: the library scan reads
+// artist and title from the container tags or an "Artist - Title" file
+// name, and a match job identifies the recording and its artists on
+// MusicBrainz.
+package musicvideo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sources of the title and artist.
+const (
+	TitleSourceTag         = "tag"         // container artist and title tags
+	TitleSourceFilename    = "filename"    // "Artist - Title" file name
+	TitleSourceMusicBrainz = "musicbrainz" // matched recording
+)
+
+// Match states of a video.
+const (
+	MatchStatusPending   = "pending"   // waiting for the match job
+	MatchStatusMatched   = "matched"   // identified on MusicBrainz
+	MatchStatusUnmatched = "unmatched" // no recording scored high enough
+)
+
+// VideoSort is the order of video lists.
+type VideoSort string
+
+const (
+	// VideoSortArtist lists videos by artist, then by release year.
+	VideoSortArtist VideoSort = "artist"
+	// VideoSortTitle lists videos by title.
+	VideoSortTitle VideoSort = "title"
+	// VideoSortYear lists the latest releases first.
+	VideoSortYear VideoSort = "year"
+	// VideoSortAdded lists the videos added last first.
+	VideoSortAdded VideoSort = "added"
+)
+
+// Valid reports whether s is a known sort order.
+func (s VideoSort) Valid() bool {
+	return s == VideoSortArtist || s == VideoSortTitle || s == VideoSortYear || s == VideoSortAdded
+}
+
+// Artist is a performer of a music video library. Artists belong to a
+// library; all videos crediting an artist first are grouped under it.
+type Artist struct {
+	ID             uuid.UUID
+	LibraryID      uuid.UUID
+	Name           string
+	SortName       string
+	Disambiguation *string
+
+	// MusicBrainzID is set once a video of the artist was matched.
+	MusicBrainzID *uuid.UUID
+
+	VideoCount int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Video is a video in a music video library.
+type Video struct {
+	ID        uuid.UUID
+	LibraryID uuid.UUID
+	ArtistID  uuid.UUID
+	FilePath  string
+	FileSize  int64
+	Container *string
+
+	Title       string
+	TitleSource string
+	SortTitle   string
+
+	// ArtistName is the name of the artist the video is grouped under;
+	// ArtistCredit names all credited artists, e.g. "Rihanna feat. JAY-Z".
+	ArtistName   string
+	ArtistCredit string
+	Year         *int32
+
+	DurationSeconds float64
+	Width           int32
+	Height          int32
+	VideoCodec      *string
+	AudioCodec      *string
+
+	MusicBrainzRecordingID *uuid.UUID
+	MatchStatus            string
+	MatchedAt              *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsMatched reports whether the video was identified on MusicBrainz.
+func (v *Video) IsMatched() bool {
+	return v.MatchStatus == MatchStatusMatched
+}
+
+// VideoListFilters contains filters for listing videos.
+type VideoListFilters struct {
+	// LibraryIDs restricts the videos to these libraries when non-nil,
+	// the libraries the user may access.
+	LibraryIDs []uuid.UUID
+	LibraryID  *uuid.UUID
+	ArtistID   *uuid.UUID
+	Sort       VideoSort
+	Limit      int32
+	Offset     int32
+}
+
+// ArtistListFilters contains filters for listing artists.
+type ArtistListFilters struct {
+	LibraryIDs []uuid.UUID // nil means unrestricted
+	LibraryID  *uuid.UUID
+	Limit      int32
+	Offset     int32
+}
+
+// ScannedVideo is a video file with what the library scanner read from
+// its container and name.
+type ScannedVideo struct {
+	LibraryID   uuid.UUID
+	FilePath    string
+	FileSize    int64
+	Container   string
+	TitleSource string
+	Title       string
+
+	// Artist is the artist credited first, the one the video is grouped
+	// under; ArtistCredit names all credited artists.
+	Artist       string
+	ArtistCredit string
+	Year         int
+
+	// MusicBrainzRecordingID comes from a tag written by a tagger such as
+	// Picard; nil when the file has none.
+	MusicBrainzRecordingID *uuid.UUID
+
+	DurationSeconds float64
+	Width           int
+	Height          int
+	VideoCodec      string
+	AudioCodec      string
+}
+
+// Recording is a MusicBrainz recording offered as a match.
+type Recording struct {
+	MusicBrainzID uuid.UUID
+	Title         string
+	ArtistCredit  string
+	Artists       []RecordingArtist
+	Length        time.Duration
+	Year          *int
+	Video         bool
+
+	// Score is the provider's relevance, 0-1.
+	Score float64
+}
+
+// RecordingArtist is a credited artist of a recording.
+type RecordingArtist struct {
+	MusicBrainzID  uuid.UUID
+	Name           string
+	SortName       string
+	Disambiguation string
+}
@@ -0,0 +1,135 @@
+// Package adapters provides photo-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lusoris/revenge/internal/content/photo"
+	"github.com/lusoris/revenge/internal/content/photo/exif"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// PhotoFileParser implements scanner.FileParser for photos and camera
+// clips. The capture time comes from EXIF, else from a date in the file
+// name, else from the file's modification time.
+// Filename dates understood:
+// - "IMG_20240630_140509.jpg", "PXL_20240630_140509123.jpg" -> 2024-06-30 14:05:09
+// - "2024-06-30 14.05.09.jpg" -> 2024-06-30 14:05:09
+// - "IMG-20240630-WA0001.jpg" -> 2024-06-30
+type PhotoFileParser struct{}
+
+// NewPhotoFileParser creates a parser for the files of a photo library.
+func NewPhotoFileParser() *PhotoFileParser {
+	return &PhotoFileParser{}
+}
+
+// datePattern matches a date, optionally followed by a time, with or
+// without separators.
+var datePattern = regexp.MustCompile(`(?:^|\D)((?:19|20)\d{2})[-_.]?(\d{2})[-_.]?(\d{2})(?:[-_ .T]?(\d{2})[-_.:]?(\d{2})[-_.:]?(\d{2}))?`)
+
+// Parse returns the file name without extension as the title and the date
+// in the name, if any, as "taken_at" (time.Time).
+func (p *PhotoFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSuffix(filename, filepath.Ext(filename))
+	if t, ok := parseFilenameDate(name); ok {
+		metadata["taken_at"] = t
+	}
+	return name, metadata
+}
+
+// GetExtensions returns the photo and camera clip extensions
+func (p *PhotoFileParser) GetExtensions() []string {
+	return scanner.ExtensionsToSlice(scanner.MergeExtensions(scanner.PhotoExtensions, scanner.ClipExtensions))
+}
+
+// ContentType returns "photo"
+func (p *PhotoFileParser) ContentType() string {
+	return "photo"
+}
+
+// ReadPhoto reads the capture metadata of a photo or clip. Files whose
+// metadata cannot be read are still imported with what the file name and
+// file system tell.
+func (p *PhotoFileParser) ReadPhoto(filePath string) (*photo.ScannedPhoto, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(filepath.Ext(filePath))
+
+	m, err := exif.Read(filePath)
+	if err != nil {
+		if !errors.Is(err, exif.ErrUnsupported) && !scanner.ClipExtensions[ext] {
+			return nil, fmt.Errorf("read %s: %w", filePath, err)
+		}
+		m = &exif.Metadata{Kind: exif.KindImage, Format: strings.TrimPrefix(ext, ".")}
+		if scanner.ClipExtensions[ext] {
+			m.Kind = exif.KindVideo
+		}
+	}
+
+	scanned := &photo.ScannedPhoto{
+		FilePath:        filePath,
+		FileSize:        info.Size(),
+		Kind:            photo.Kind(m.Kind),
+		Format:          m.Format,
+		Width:           m.Width,
+		Height:          m.Height,
+		Orientation:     m.Orientation,
+		CameraMake:      m.Make,
+		CameraModel:     m.Model,
+		LensModel:       m.LensModel,
+		ExposureTime:    m.ExposureTime,
+		FNumber:         m.FNumber,
+		ISO:             m.ISO,
+		FocalLength:     m.FocalLength,
+		Latitude:        m.Latitude,
+		Longitude:       m.Longitude,
+		Altitude:        m.Altitude,
+		DurationSeconds: m.DurationSeconds,
+	}
+	if m.Rotated() {
+		scanned.Width, scanned.Height = m.Height, m.Width
+	}
+
+	_, metadata := p.Parse(filepath.Base(filePath))
+	switch taken, ok := metadata["taken_at"].(time.Time); {
+	case m.TakenAt != nil:
+		scanned.TakenAt, scanned.TakenAtSource = *m.TakenAt, photo.TakenAtSourceExif
+	case ok:
+		scanned.TakenAt, scanned.TakenAtSource = taken, photo.TakenAtSourceFilename
+	default:
+		scanned.TakenAt, scanned.TakenAtSource = info.ModTime().UTC(), photo.TakenAtSourceFile
+	}
+	return scanned, nil
+}
+
+// parseFilenameDate finds the first valid date in a file name. Like EXIF
+// times without an offset, the result is a wall-clock time in UTC.
+func parseFilenameDate(name string) (time.Time, bool) {
+	for _, m := range datePattern.FindAllStringSubmatch(name, -1) {
+		n := make([]int, 6)
+		for i, s := range m[1:] {
+			n[i], _ = strconv.Atoi(s)
+		}
+		year, month, day, hour, minute, sec := n[0], n[1], n[2], n[3], n[4], n[5]
+		if month < 1 || month > 12 || day < 1 || day > 31 || hour > 23 || minute > 59 || sec > 59 {
+			continue
+		}
+		t := time.Date(year, time.Month(month), day, hour, minute, sec, 0, time.UTC)
+		// time.Date normalizes February 30 into March
+		if t.Day() != day || t.After(time.Now()) {
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
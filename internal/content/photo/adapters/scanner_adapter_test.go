@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/photo"
+)
+
+func TestPhotoFileParser_Parse(t *testing.T) {
+	parser := NewPhotoFileParser()
+
+	tests := []struct {
+		filename  string
+		wantTitle string
+		wantTaken time.Time
+	}{
+		{"IMG_20240630_140509.jpg", "IMG_20240630_140509", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC)},
+		{"PXL_20240630_140509123.jpg", "PXL_20240630_140509123", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC)},
+		{"2024-06-30 14.05.09.heic", "2024-06-30 14.05.09", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC)},
+		{"IMG-20240630-WA0001.jpg", "IMG-20240630-WA0001", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{"DSC_0042.NEF", "DSC_0042", time.Time{}},
+		{"IMG_20240231_120000.jpg", "IMG_20240231_120000", time.Time{}}, // February 31
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			title, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantTitle, title)
+			if tt.wantTaken.IsZero() {
+				assert.NotContains(t, metadata, "taken_at")
+			} else {
+				assert.Equal(t, tt.wantTaken, metadata["taken_at"])
+			}
+		})
+	}
+}
+
+func TestPhotoFileParser_GetExtensions(t *testing.T) {
+	exts := NewPhotoFileParser().GetExtensions()
+	assert.Contains(t, exts, ".heic")
+	assert.Contains(t, exts, ".jpg")
+	assert.Contains(t, exts, ".mov")
+	assert.NotContains(t, exts, ".svg")
+	assert.NotContains(t, exts, ".mkv")
+	assert.Equal(t, "photo", NewPhotoFileParser().ContentType())
+}
+
+func TestPhotoFileParser_ReadPhoto(t *testing.T) {
+	parser := NewPhotoFileParser()
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 6))))
+
+	t.Run("filename date", func(t *testing.T) {
+		path := filepath.Join(dir, "Screenshot_20230102-030405.png")
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+		scanned, err := parser.ReadPhoto(path)
+		require.NoError(t, err)
+		assert.Equal(t, photo.KindImage, scanned.Kind)
+		assert.Equal(t, "png", scanned.Format)
+		assert.Equal(t, 8, scanned.Width)
+		assert.Equal(t, 6, scanned.Height)
+		assert.Equal(t, photo.TakenAtSourceFilename, scanned.TakenAtSource)
+		assert.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), scanned.TakenAt)
+	})
+
+	t.Run("file time", func(t *testing.T) {
+		path := filepath.Join(dir, "scan.png")
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+		mtime := time.Date(2020, 5, 6, 7, 8, 9, 0, time.UTC)
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+
+		scanned, err := parser.ReadPhoto(path)
+		require.NoError(t, err)
+		assert.Equal(t, photo.TakenAtSourceFile, scanned.TakenAtSource)
+		assert.True(t, scanned.TakenAt.Equal(mtime))
+	})
+
+	t.Run("unreadable clip", func(t *testing.T) {
+		path := filepath.Join(dir, "00001.mts")
+		require.NoError(t, os.WriteFile(path, []byte{0x47, 0x40, 0x00, 0x10}, 0o644))
+
+		scanned, err := parser.ReadPhoto(path)
+		require.NoError(t, err, "clips without readable metadata are still imported")
+		assert.Equal(t, photo.KindVideo, scanned.Kind)
+		assert.Equal(t, "mts", scanned.Format)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := parser.ReadPhoto(filepath.Join(dir, "missing.jpg"))
+		assert.Error(t, err)
+	})
+}
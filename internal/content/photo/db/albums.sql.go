@@ -0,0 +1,619 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: albums.sql
+
+package photodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addAlbumPhotos = `-- name: AddAlbumPhotos :execrows
+INSERT INTO
+    photo.album_photos (
+        album_id,
+        photo_id,
+        position,
+        added_by
+    )
+SELECT $1::uuid, photo_ids.id, (
+        SELECT COALESCE(MAX(position), 0)
+        FROM photo.album_photos
+        WHERE
+            album_id = $1::uuid
+    ) + photo_ids.ord::integer, $2::uuid
+FROM unnest($3::uuid[]) WITH ORDINALITY AS photo_ids (id, ord) ON CONFLICT (album_id, photo_id) DO NOTHING
+`
+
+type AddAlbumPhotosParams struct {
+	AlbumID  uuid.UUID   `json:"albumId"`
+	AddedBy  pgtype.UUID `json:"addedBy"`
+	PhotoIds []uuid.UUID `json:"photoIds"`
+}
+
+// Appends photos to an album in the given order; photos already in the
+// album keep their position.
+func (q *Queries) AddAlbumPhotos(ctx context.Context, arg AddAlbumPhotosParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addAlbumPhotos, arg.AlbumID, arg.AddedBy, arg.PhotoIds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const addFolderAlbumPhoto = `-- name: AddFolderAlbumPhoto :exec
+WITH
+    moved AS (
+        DELETE FROM photo.album_photos ap USING photo.albums a
+        WHERE
+            ap.album_id = a.id
+            AND a.kind = 'folder'
+            AND ap.photo_id = $2::uuid
+            AND ap.album_id <> $1::uuid
+    )
+INSERT INTO
+    photo.album_photos (album_id, photo_id)
+VALUES ($1::uuid, $2::uuid) ON CONFLICT (album_id, photo_id) DO NOTHING
+`
+
+type AddFolderAlbumPhotoParams struct {
+	AlbumID uuid.UUID `json:"albumId"`
+	PhotoID uuid.UUID `json:"photoId"`
+}
+
+// Files a photo under its folder album and takes it out of the album of
+// the folder it was in before.
+func (q *Queries) AddFolderAlbumPhoto(ctx context.Context, arg AddFolderAlbumPhotoParams) error {
+	_, err := q.db.Exec(ctx, addFolderAlbumPhoto, arg.AlbumID, arg.PhotoID)
+	return err
+}
+
+const countAlbums = `-- name: CountAlbums :one
+SELECT COUNT(*)
+FROM photo.albums a
+WHERE (
+        $1::bool = FALSE
+        OR a.library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR a.library_id = $3::uuid
+    )
+    AND (
+        $4::text = ''
+        OR a.kind = $4::text
+    )
+    AND (
+        a.kind = 'folder'
+        OR a.owner_id = $5::uuid
+        OR EXISTS (
+            SELECT 1
+            FROM photo.album_shares s
+            WHERE
+                s.album_id = a.id
+                AND s.user_id = $5::uuid
+        )
+    )
+`
+
+type CountAlbumsParams struct {
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	Kind       string      `json:"kind"`
+	UserID     uuid.UUID   `json:"userId"`
+}
+
+func (q *Queries) CountAlbums(ctx context.Context, arg CountAlbumsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAlbums,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.Kind,
+		arg.UserID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAlbum = `-- name: CreateAlbum :one
+INSERT INTO
+    photo.albums (
+        library_id,
+        kind,
+        title,
+        description,
+        owner_id
+    )
+VALUES ($1, 'user', $2, $3, $4) RETURNING id, library_id, kind, title, description, folder_path, owner_id, cover_photo_id, created_at, updated_at
+`
+
+type CreateAlbumParams struct {
+	LibraryID   uuid.UUID   `json:"libraryId"`
+	Title       string      `json:"title"`
+	Description *string     `json:"description"`
+	OwnerID     pgtype.UUID `json:"ownerId"`
+}
+
+func (q *Queries) CreateAlbum(ctx context.Context, arg CreateAlbumParams) (PhotoAlbum, error) {
+	row := q.db.QueryRow(ctx, createAlbum,
+		arg.LibraryID,
+		arg.Title,
+		arg.Description,
+		arg.OwnerID,
+	)
+	var i PhotoAlbum
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.Kind,
+		&i.Title,
+		&i.Description,
+		&i.FolderPath,
+		&i.OwnerID,
+		&i.CoverPhotoID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteAlbum = `-- name: DeleteAlbum :exec
+DELETE FROM photo.albums WHERE id = $1
+`
+
+func (q *Queries) DeleteAlbum(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAlbum, id)
+	return err
+}
+
+const deleteAlbumShare = `-- name: DeleteAlbumShare :execrows
+DELETE FROM photo.album_shares WHERE album_id = $1 AND user_id = $2
+`
+
+type DeleteAlbumShareParams struct {
+	AlbumID uuid.UUID `json:"albumId"`
+	UserID  uuid.UUID `json:"userId"`
+}
+
+func (q *Queries) DeleteAlbumShare(ctx context.Context, arg DeleteAlbumShareParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAlbumShare, arg.AlbumID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteEmptyFolderAlbums = `-- name: DeleteEmptyFolderAlbums :execrows
+DELETE FROM photo.albums a
+WHERE
+    a.kind = 'folder'
+    AND NOT EXISTS (
+        SELECT 1
+        FROM photo.album_photos ap
+        WHERE
+            ap.album_id = a.id
+    )
+`
+
+// Removes folder albums whose folder no longer holds photos.
+func (q *Queries) DeleteEmptyFolderAlbums(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEmptyFolderAlbums)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAlbum = `-- name: GetAlbum :one
+SELECT
+    a.id, a.library_id, a.kind, a.title, a.description, a.folder_path, a.owner_id, a.cover_photo_id, a.created_at, a.updated_at,
+    (
+        SELECT COUNT(*)
+        FROM photo.album_photos ap
+        WHERE
+            ap.album_id = a.id
+    ) AS photo_count,
+    first_photo.photo_id AS first_photo_id
+FROM photo.albums a
+    LEFT JOIN LATERAL (
+        SELECT ap.photo_id
+        FROM photo.album_photos ap
+            JOIN photo.photos p ON p.id = ap.photo_id
+        WHERE
+            ap.album_id = a.id
+        ORDER BY ap.position ASC, p.taken_at DESC
+        LIMIT 1
+    ) first_photo ON TRUE
+WHERE
+    a.id = $1
+`
+
+type GetAlbumRow struct {
+	PhotoAlbum   PhotoAlbum `json:"photoAlbum"`
+	PhotoCount   int64      `json:"photoCount"`
+	FirstPhotoID uuid.UUID  `json:"firstPhotoId"`
+}
+
+func (q *Queries) GetAlbum(ctx context.Context, id uuid.UUID) (GetAlbumRow, error) {
+	row := q.db.QueryRow(ctx, getAlbum, id)
+	var i GetAlbumRow
+	err := row.Scan(
+		&i.PhotoAlbum.ID,
+		&i.PhotoAlbum.LibraryID,
+		&i.PhotoAlbum.Kind,
+		&i.PhotoAlbum.Title,
+		&i.PhotoAlbum.Description,
+		&i.PhotoAlbum.FolderPath,
+		&i.PhotoAlbum.OwnerID,
+		&i.PhotoAlbum.CoverPhotoID,
+		&i.PhotoAlbum.CreatedAt,
+		&i.PhotoAlbum.UpdatedAt,
+		&i.PhotoCount,
+		&i.FirstPhotoID,
+	)
+	return i, err
+}
+
+const getAlbumShare = `-- name: GetAlbumShare :one
+SELECT album_id, user_id, can_contribute, created_at FROM photo.album_shares WHERE album_id = $1 AND user_id = $2
+`
+
+type GetAlbumShareParams struct {
+	AlbumID uuid.UUID `json:"albumId"`
+	UserID  uuid.UUID `json:"userId"`
+}
+
+func (q *Queries) GetAlbumShare(ctx context.Context, arg GetAlbumShareParams) (PhotoAlbumShare, error) {
+	row := q.db.QueryRow(ctx, getAlbumShare, arg.AlbumID, arg.UserID)
+	var i PhotoAlbumShare
+	err := row.Scan(
+		&i.AlbumID,
+		&i.UserID,
+		&i.CanContribute,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAlbumPhotos = `-- name: ListAlbumPhotos :many
+SELECT p.id, p.library_id, p.file_path, p.file_size, p.folder, p.kind, p.format, p.taken_at, p.taken_at_source, p.width, p.height, p.orientation, p.camera_make, p.camera_model, p.lens_model, p.exposure_time, p.f_number, p.iso, p.focal_length, p.latitude, p.longitude, p.altitude, p.duration_seconds, p.blurhash, p.dominant_color, p.created_at, p.updated_at
+FROM photo.photos p
+    JOIN photo.album_photos ap ON ap.photo_id = p.id
+WHERE
+    ap.album_id = $1
+ORDER BY ap.position ASC, p.taken_at DESC, p.id ASC
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListAlbumPhotosParams struct {
+	AlbumID uuid.UUID `json:"albumId"`
+	Limit   int32     `json:"limit"`
+	Offset  int32     `json:"offset"`
+}
+
+func (q *Queries) ListAlbumPhotos(ctx context.Context, arg ListAlbumPhotosParams) ([]PhotoPhoto, error) {
+	rows, err := q.db.Query(ctx, listAlbumPhotos, arg.AlbumID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PhotoPhoto{}
+	for rows.Next() {
+		var i PhotoPhoto
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Folder,
+			&i.Kind,
+			&i.Format,
+			&i.TakenAt,
+			&i.TakenAtSource,
+			&i.Width,
+			&i.Height,
+			&i.Orientation,
+			&i.CameraMake,
+			&i.CameraModel,
+			&i.LensModel,
+			&i.ExposureTime,
+			&i.FNumber,
+			&i.Iso,
+			&i.FocalLength,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Altitude,
+			&i.DurationSeconds,
+			&i.Blurhash,
+			&i.DominantColor,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAlbumShares = `-- name: ListAlbumShares :many
+SELECT album_id, user_id, can_contribute, created_at FROM photo.album_shares WHERE album_id = $1 ORDER BY created_at ASC
+`
+
+func (q *Queries) ListAlbumShares(ctx context.Context, albumID uuid.UUID) ([]PhotoAlbumShare, error) {
+	rows, err := q.db.Query(ctx, listAlbumShares, albumID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PhotoAlbumShare{}
+	for rows.Next() {
+		var i PhotoAlbumShare
+		if err := rows.Scan(
+			&i.AlbumID,
+			&i.UserID,
+			&i.CanContribute,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAlbums = `-- name: ListAlbums :many
+SELECT
+    a.id, a.library_id, a.kind, a.title, a.description, a.folder_path, a.owner_id, a.cover_photo_id, a.created_at, a.updated_at,
+    (
+        SELECT COUNT(*)
+        FROM photo.album_photos ap
+        WHERE
+            ap.album_id = a.id
+    ) AS photo_count,
+    first_photo.photo_id AS first_photo_id
+FROM photo.albums a
+    LEFT JOIN LATERAL (
+        SELECT ap.photo_id
+        FROM photo.album_photos ap
+            JOIN photo.photos p ON p.id = ap.photo_id
+        WHERE
+            ap.album_id = a.id
+        ORDER BY ap.position ASC, p.taken_at DESC
+        LIMIT 1
+    ) first_photo ON TRUE
+WHERE (
+        $3::bool = FALSE
+        OR a.library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR a.library_id = $5::uuid
+    )
+    AND (
+        $6::text = ''
+        OR a.kind = $6::text
+    )
+    AND (
+        a.kind = 'folder'
+        OR a.owner_id = $7::uuid
+        OR EXISTS (
+            SELECT 1
+            FROM photo.album_shares s
+            WHERE
+                s.album_id = a.id
+                AND s.user_id = $7::uuid
+        )
+    )
+ORDER BY
+    CASE
+        WHEN a.kind = 'user' THEN 0
+        ELSE 1
+    END ASC,
+    lower(a.title) ASC,
+    a.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListAlbumsParams struct {
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+	LibraryID  pgtype.UUID `json:"libraryId"`
+	Kind       string      `json:"kind"`
+	UserID     uuid.UUID   `json:"userId"`
+}
+
+type ListAlbumsRow struct {
+	PhotoAlbum   PhotoAlbum `json:"photoAlbum"`
+	PhotoCount   int64      `json:"photoCount"`
+	FirstPhotoID uuid.UUID  `json:"firstPhotoId"`
+}
+
+// Lists the folder albums and the user albums the user owns or was shared,
+// user albums first. restrict limits the result to library_ids.
+func (q *Queries) ListAlbums(ctx context.Context, arg ListAlbumsParams) ([]ListAlbumsRow, error) {
+	rows, err := q.db.Query(ctx, listAlbums,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.Kind,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAlbumsRow{}
+	for rows.Next() {
+		var i ListAlbumsRow
+		if err := rows.Scan(
+			&i.PhotoAlbum.ID,
+			&i.PhotoAlbum.LibraryID,
+			&i.PhotoAlbum.Kind,
+			&i.PhotoAlbum.Title,
+			&i.PhotoAlbum.Description,
+			&i.PhotoAlbum.FolderPath,
+			&i.PhotoAlbum.OwnerID,
+			&i.PhotoAlbum.CoverPhotoID,
+			&i.PhotoAlbum.CreatedAt,
+			&i.PhotoAlbum.UpdatedAt,
+			&i.PhotoCount,
+			&i.FirstPhotoID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeAlbumPhoto = `-- name: RemoveAlbumPhoto :execrows
+DELETE FROM photo.album_photos WHERE album_id = $1 AND photo_id = $2
+`
+
+type RemoveAlbumPhotoParams struct {
+	AlbumID uuid.UUID `json:"albumId"`
+	PhotoID uuid.UUID `json:"photoId"`
+}
+
+func (q *Queries) RemoveAlbumPhoto(ctx context.Context, arg RemoveAlbumPhotoParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeAlbumPhoto, arg.AlbumID, arg.PhotoID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateAlbum = `-- name: UpdateAlbum :one
+UPDATE photo.albums
+SET
+    title = $2,
+    description = $3,
+    cover_photo_id = $4
+WHERE
+    id = $1 RETURNING id, library_id, kind, title, description, folder_path, owner_id, cover_photo_id, created_at, updated_at
+`
+
+type UpdateAlbumParams struct {
+	ID           uuid.UUID   `json:"id"`
+	Title        string      `json:"title"`
+	Description  *string     `json:"description"`
+	CoverPhotoID pgtype.UUID `json:"coverPhotoId"`
+}
+
+func (q *Queries) UpdateAlbum(ctx context.Context, arg UpdateAlbumParams) (PhotoAlbum, error) {
+	row := q.db.QueryRow(ctx, updateAlbum,
+		arg.ID,
+		arg.Title,
+		arg.Description,
+		arg.CoverPhotoID,
+	)
+	var i PhotoAlbum
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.Kind,
+		&i.Title,
+		&i.Description,
+		&i.FolderPath,
+		&i.OwnerID,
+		&i.CoverPhotoID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertAlbumShare = `-- name: UpsertAlbumShare :one
+INSERT INTO
+    photo.album_shares (
+        album_id,
+        user_id,
+        can_contribute
+    )
+VALUES ($1, $2, $3) ON CONFLICT (album_id, user_id) DO
+UPDATE
+SET
+    can_contribute = EXCLUDED.can_contribute RETURNING album_id, user_id, can_contribute, created_at
+`
+
+type UpsertAlbumShareParams struct {
+	AlbumID       uuid.UUID `json:"albumId"`
+	UserID        uuid.UUID `json:"userId"`
+	CanContribute bool      `json:"canContribute"`
+}
+
+func (q *Queries) UpsertAlbumShare(ctx context.Context, arg UpsertAlbumShareParams) (PhotoAlbumShare, error) {
+	row := q.db.QueryRow(ctx, upsertAlbumShare, arg.AlbumID, arg.UserID, arg.CanContribute)
+	var i PhotoAlbumShare
+	err := row.Scan(
+		&i.AlbumID,
+		&i.UserID,
+		&i.CanContribute,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertFolderAlbum = `-- name: UpsertFolderAlbum :one
+INSERT INTO
+    photo.albums (
+        library_id,
+        kind,
+        title,
+        folder_path
+    )
+VALUES ($1, 'folder', $2, $3) ON CONFLICT (library_id, folder_path)
+WHERE
+    folder_path IS NOT NULL DO
+UPDATE
+SET
+    title = EXCLUDED.title RETURNING id, library_id, kind, title, description, folder_path, owner_id, cover_photo_id, created_at, updated_at
+`
+
+type UpsertFolderAlbumParams struct {
+	LibraryID  uuid.UUID `json:"libraryId"`
+	Title      string    `json:"title"`
+	FolderPath *string   `json:"folderPath"`
+}
+
+func (q *Queries) UpsertFolderAlbum(ctx context.Context, arg UpsertFolderAlbumParams) (PhotoAlbum, error) {
+	row := q.db.QueryRow(ctx, upsertFolderAlbum, arg.LibraryID, arg.Title, arg.FolderPath)
+	var i PhotoAlbum
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.Kind,
+		&i.Title,
+		&i.Description,
+		&i.FolderPath,
+		&i.OwnerID,
+		&i.CoverPhotoID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
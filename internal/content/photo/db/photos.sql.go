@@ -0,0 +1,568 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: photos.sql
+
+package photodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countPhotos = `-- name: CountPhotos :one
+SELECT COUNT(*)
+FROM photo.photos
+WHERE (
+        $1::bool = FALSE
+        OR library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR library_id = $3::uuid
+    )
+    AND (
+        $4::text = ''
+        OR kind = $4::text
+    )
+    AND (
+        $5::timestamptz IS NULL
+        OR taken_at >= $5::timestamptz
+    )
+    AND (
+        $6::timestamptz IS NULL
+        OR taken_at < $6::timestamptz
+    )
+`
+
+type CountPhotosParams struct {
+	Restrict    bool               `json:"restrict"`
+	LibraryIds  []uuid.UUID        `json:"libraryIds"`
+	LibraryID   pgtype.UUID        `json:"libraryId"`
+	Kind        string             `json:"kind"`
+	TakenAfter  pgtype.Timestamptz `json:"takenAfter"`
+	TakenBefore pgtype.Timestamptz `json:"takenBefore"`
+}
+
+func (q *Queries) CountPhotos(ctx context.Context, arg CountPhotosParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPhotos,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.Kind,
+		arg.TakenAfter,
+		arg.TakenBefore,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPhotosInLibrary = `-- name: CountPhotosInLibrary :one
+SELECT COUNT(*)
+FROM photo.photos
+WHERE
+    library_id = $1
+    AND id = ANY($2::uuid[])
+`
+
+type CountPhotosInLibraryParams struct {
+	LibraryID uuid.UUID   `json:"libraryId"`
+	PhotoIds  []uuid.UUID `json:"photoIds"`
+}
+
+// Counts the given photos that belong to a library.
+func (q *Queries) CountPhotosInLibrary(ctx context.Context, arg CountPhotosInLibraryParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPhotosInLibrary, arg.LibraryID, arg.PhotoIds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deletePhoto = `-- name: DeletePhoto :exec
+DELETE FROM photo.photos WHERE id = $1
+`
+
+func (q *Queries) DeletePhoto(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePhoto, id)
+	return err
+}
+
+const getPhoto = `-- name: GetPhoto :one
+SELECT id, library_id, file_path, file_size, folder, kind, format, taken_at, taken_at_source, width, height, orientation, camera_make, camera_model, lens_model, exposure_time, f_number, iso, focal_length, latitude, longitude, altitude, duration_seconds, blurhash, dominant_color, created_at, updated_at FROM photo.photos WHERE id = $1
+`
+
+func (q *Queries) GetPhoto(ctx context.Context, id uuid.UUID) (PhotoPhoto, error) {
+	row := q.db.QueryRow(ctx, getPhoto, id)
+	var i PhotoPhoto
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Folder,
+		&i.Kind,
+		&i.Format,
+		&i.TakenAt,
+		&i.TakenAtSource,
+		&i.Width,
+		&i.Height,
+		&i.Orientation,
+		&i.CameraMake,
+		&i.CameraModel,
+		&i.LensModel,
+		&i.ExposureTime,
+		&i.FNumber,
+		&i.Iso,
+		&i.FocalLength,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Altitude,
+		&i.DurationSeconds,
+		&i.Blurhash,
+		&i.DominantColor,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPhotoByPath = `-- name: GetPhotoByPath :one
+SELECT id, library_id, file_path, file_size, folder, kind, format, taken_at, taken_at_source, width, height, orientation, camera_make, camera_model, lens_model, exposure_time, f_number, iso, focal_length, latitude, longitude, altitude, duration_seconds, blurhash, dominant_color, created_at, updated_at FROM photo.photos WHERE file_path = $1
+`
+
+func (q *Queries) GetPhotoByPath(ctx context.Context, filePath string) (PhotoPhoto, error) {
+	row := q.db.QueryRow(ctx, getPhotoByPath, filePath)
+	var i PhotoPhoto
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Folder,
+		&i.Kind,
+		&i.Format,
+		&i.TakenAt,
+		&i.TakenAtSource,
+		&i.Width,
+		&i.Height,
+		&i.Orientation,
+		&i.CameraMake,
+		&i.CameraModel,
+		&i.LensModel,
+		&i.ExposureTime,
+		&i.FNumber,
+		&i.Iso,
+		&i.FocalLength,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Altitude,
+		&i.DurationSeconds,
+		&i.Blurhash,
+		&i.DominantColor,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listPhotoPaths = `-- name: ListPhotoPaths :many
+SELECT id, file_path FROM photo.photos WHERE library_id = $1
+`
+
+type ListPhotoPathsRow struct {
+	ID       uuid.UUID `json:"id"`
+	FilePath string    `json:"filePath"`
+}
+
+func (q *Queries) ListPhotoPaths(ctx context.Context, libraryID uuid.UUID) ([]ListPhotoPathsRow, error) {
+	rows, err := q.db.Query(ctx, listPhotoPaths, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPhotoPathsRow{}
+	for rows.Next() {
+		var i ListPhotoPathsRow
+		if err := rows.Scan(&i.ID, &i.FilePath); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPhotos = `-- name: ListPhotos :many
+SELECT id, library_id, file_path, file_size, folder, kind, format, taken_at, taken_at_source, width, height, orientation, camera_make, camera_model, lens_model, exposure_time, f_number, iso, focal_length, latitude, longitude, altitude, duration_seconds, blurhash, dominant_color, created_at, updated_at
+FROM photo.photos
+WHERE (
+        $3::bool = FALSE
+        OR library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR library_id = $5::uuid
+    )
+    AND (
+        $6::text = ''
+        OR kind = $6::text
+    )
+    AND (
+        $7::timestamptz IS NULL
+        OR taken_at >= $7::timestamptz
+    )
+    AND (
+        $8::timestamptz IS NULL
+        OR taken_at < $8::timestamptz
+    )
+ORDER BY
+    CASE
+        WHEN $9::bool THEN taken_at
+    END ASC,
+    taken_at DESC,
+    id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListPhotosParams struct {
+	Limit       int32              `json:"limit"`
+	Offset      int32              `json:"offset"`
+	Restrict    bool               `json:"restrict"`
+	LibraryIds  []uuid.UUID        `json:"libraryIds"`
+	LibraryID   pgtype.UUID        `json:"libraryId"`
+	Kind        string             `json:"kind"`
+	TakenAfter  pgtype.Timestamptz `json:"takenAfter"`
+	TakenBefore pgtype.Timestamptz `json:"takenBefore"`
+	OldestFirst bool               `json:"oldestFirst"`
+}
+
+// Lists photos newest first, or oldest first. restrict limits the result
+// to library_ids, the libraries the user may access.
+func (q *Queries) ListPhotos(ctx context.Context, arg ListPhotosParams) ([]PhotoPhoto, error) {
+	rows, err := q.db.Query(ctx, listPhotos,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.Kind,
+		arg.TakenAfter,
+		arg.TakenBefore,
+		arg.OldestFirst,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PhotoPhoto{}
+	for rows.Next() {
+		var i PhotoPhoto
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.FilePath,
+			&i.FileSize,
+			&i.Folder,
+			&i.Kind,
+			&i.Format,
+			&i.TakenAt,
+			&i.TakenAtSource,
+			&i.Width,
+			&i.Height,
+			&i.Orientation,
+			&i.CameraMake,
+			&i.CameraModel,
+			&i.LensModel,
+			&i.ExposureTime,
+			&i.FNumber,
+			&i.Iso,
+			&i.FocalLength,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Altitude,
+			&i.DurationSeconds,
+			&i.Blurhash,
+			&i.DominantColor,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTimeline = `-- name: ListTimeline :many
+SELECT
+    date_trunc($3::text, taken_at)::timestamptz AS bucket,
+    COUNT(*) AS photo_count,
+    (
+        array_agg(
+            id
+            ORDER BY taken_at DESC
+        )
+    ) [1]::uuid AS cover_photo_id
+FROM photo.photos
+WHERE (
+        $4::bool = FALSE
+        OR library_id = ANY($5::uuid[])
+    )
+    AND (
+        $6::uuid IS NULL
+        OR library_id = $6::uuid
+    )
+    AND (
+        $7::text = ''
+        OR kind = $7::text
+    )
+GROUP BY
+    bucket
+ORDER BY bucket DESC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListTimelineParams struct {
+	Limit       int32       `json:"limit"`
+	Offset      int32       `json:"offset"`
+	Granularity string      `json:"granularity"`
+	Restrict    bool        `json:"restrict"`
+	LibraryIds  []uuid.UUID `json:"libraryIds"`
+	LibraryID   pgtype.UUID `json:"libraryId"`
+	Kind        string      `json:"kind"`
+}
+
+type ListTimelineRow struct {
+	Bucket       time.Time `json:"bucket"`
+	PhotoCount   int64     `json:"photoCount"`
+	CoverPhotoID uuid.UUID `json:"coverPhotoId"`
+}
+
+// Groups photos by day or month of capture, newest first, with the
+// latest photo of each group as its cover.
+func (q *Queries) ListTimeline(ctx context.Context, arg ListTimelineParams) ([]ListTimelineRow, error) {
+	rows, err := q.db.Query(ctx, listTimeline,
+		arg.Limit,
+		arg.Offset,
+		arg.Granularity,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.Kind,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTimelineRow{}
+	for rows.Next() {
+		var i ListTimelineRow
+		if err := rows.Scan(&i.Bucket, &i.PhotoCount, &i.CoverPhotoID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setPhotoPlaceholder = `-- name: SetPhotoPlaceholder :exec
+UPDATE photo.photos
+SET
+    blurhash = $2,
+    dominant_color = $3
+WHERE
+    id = $1
+`
+
+type SetPhotoPlaceholderParams struct {
+	ID            uuid.UUID `json:"id"`
+	Blurhash      *string   `json:"blurhash"`
+	DominantColor *string   `json:"dominantColor"`
+}
+
+func (q *Queries) SetPhotoPlaceholder(ctx context.Context, arg SetPhotoPlaceholderParams) error {
+	_, err := q.db.Exec(ctx, setPhotoPlaceholder, arg.ID, arg.Blurhash, arg.DominantColor)
+	return err
+}
+
+const upsertPhoto = `-- name: UpsertPhoto :one
+INSERT INTO
+    photo.photos (
+        library_id,
+        file_path,
+        file_size,
+        folder,
+        kind,
+        format,
+        taken_at,
+        taken_at_source,
+        width,
+        height,
+        orientation,
+        camera_make,
+        camera_model,
+        lens_model,
+        exposure_time,
+        f_number,
+        iso,
+        focal_length,
+        latitude,
+        longitude,
+        altitude,
+        duration_seconds
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18,
+        $19,
+        $20,
+        $21,
+        $22
+    ) ON CONFLICT (file_path) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    folder = EXCLUDED.folder,
+    kind = EXCLUDED.kind,
+    format = EXCLUDED.format,
+    taken_at = EXCLUDED.taken_at,
+    taken_at_source = EXCLUDED.taken_at_source,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    orientation = EXCLUDED.orientation,
+    camera_make = EXCLUDED.camera_make,
+    camera_model = EXCLUDED.camera_model,
+    lens_model = EXCLUDED.lens_model,
+    exposure_time = EXCLUDED.exposure_time,
+    f_number = EXCLUDED.f_number,
+    iso = EXCLUDED.iso,
+    focal_length = EXCLUDED.focal_length,
+    latitude = EXCLUDED.latitude,
+    longitude = EXCLUDED.longitude,
+    altitude = EXCLUDED.altitude,
+    duration_seconds = EXCLUDED.duration_seconds,
+    blurhash = CASE
+        WHEN photo.photos.file_size = EXCLUDED.file_size THEN photo.photos.blurhash
+    END,
+    dominant_color = CASE
+        WHEN photo.photos.file_size = EXCLUDED.file_size THEN photo.photos.dominant_color
+    END,
+    file_size = EXCLUDED.file_size RETURNING id, library_id, file_path, file_size, folder, kind, format, taken_at, taken_at_source, width, height, orientation, camera_make, camera_model, lens_model, exposure_time, f_number, iso, focal_length, latitude, longitude, altitude, duration_seconds, blurhash, dominant_color, created_at, updated_at
+`
+
+type UpsertPhotoParams struct {
+	LibraryID       uuid.UUID `json:"libraryId"`
+	FilePath        string    `json:"filePath"`
+	FileSize        int64     `json:"fileSize"`
+	Folder          string    `json:"folder"`
+	Kind            string    `json:"kind"`
+	Format          string    `json:"format"`
+	TakenAt         time.Time `json:"takenAt"`
+	TakenAtSource   string    `json:"takenAtSource"`
+	Width           int32     `json:"width"`
+	Height          int32     `json:"height"`
+	Orientation     int32     `json:"orientation"`
+	CameraMake      *string   `json:"cameraMake"`
+	CameraModel     *string   `json:"cameraModel"`
+	LensModel       *string   `json:"lensModel"`
+	ExposureTime    *string   `json:"exposureTime"`
+	FNumber         *float64  `json:"fNumber"`
+	Iso             *int32    `json:"iso"`
+	FocalLength     *float64  `json:"focalLength"`
+	Latitude        *float64  `json:"latitude"`
+	Longitude       *float64  `json:"longitude"`
+	Altitude        *float64  `json:"altitude"`
+	DurationSeconds float64   `json:"durationSeconds"`
+}
+
+// Photos are keyed by their file so rescans update them in place. The
+// placeholder is kept unless the file changed.
+func (q *Queries) UpsertPhoto(ctx context.Context, arg UpsertPhotoParams) (PhotoPhoto, error) {
+	row := q.db.QueryRow(ctx, upsertPhoto,
+		arg.LibraryID,
+		arg.FilePath,
+		arg.FileSize,
+		arg.Folder,
+		arg.Kind,
+		arg.Format,
+		arg.TakenAt,
+		arg.TakenAtSource,
+		arg.Width,
+		arg.Height,
+		arg.Orientation,
+		arg.CameraMake,
+		arg.CameraModel,
+		arg.LensModel,
+		arg.ExposureTime,
+		arg.FNumber,
+		arg.Iso,
+		arg.FocalLength,
+		arg.Latitude,
+		arg.Longitude,
+		arg.Altitude,
+		arg.DurationSeconds,
+	)
+	var i PhotoPhoto
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FilePath,
+		&i.FileSize,
+		&i.Folder,
+		&i.Kind,
+		&i.Format,
+		&i.TakenAt,
+		&i.TakenAtSource,
+		&i.Width,
+		&i.Height,
+		&i.Orientation,
+		&i.CameraMake,
+		&i.CameraModel,
+		&i.LensModel,
+		&i.ExposureTime,
+		&i.FNumber,
+		&i.Iso,
+		&i.FocalLength,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Altitude,
+		&i.DurationSeconds,
+		&i.Blurhash,
+		&i.DominantColor,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,56 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package photodb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Querier interface {
+	// Appends photos to an album in the given order; photos already in the
+	// album keep their position.
+	AddAlbumPhotos(ctx context.Context, arg AddAlbumPhotosParams) (int64, error)
+	// Files a photo under its folder album and takes it out of the album of
+	// the folder it was in before.
+	AddFolderAlbumPhoto(ctx context.Context, arg AddFolderAlbumPhotoParams) error
+	CountAlbums(ctx context.Context, arg CountAlbumsParams) (int64, error)
+	CountPhotos(ctx context.Context, arg CountPhotosParams) (int64, error)
+	// Counts the given photos that belong to a library.
+	CountPhotosInLibrary(ctx context.Context, arg CountPhotosInLibraryParams) (int64, error)
+	CreateAlbum(ctx context.Context, arg CreateAlbumParams) (PhotoAlbum, error)
+	DeleteAlbum(ctx context.Context, id uuid.UUID) error
+	DeleteAlbumShare(ctx context.Context, arg DeleteAlbumShareParams) (int64, error)
+	// Removes folder albums whose folder no longer holds photos.
+	DeleteEmptyFolderAlbums(ctx context.Context) (int64, error)
+	DeletePhoto(ctx context.Context, id uuid.UUID) error
+	GetAlbum(ctx context.Context, id uuid.UUID) (GetAlbumRow, error)
+	GetAlbumShare(ctx context.Context, arg GetAlbumShareParams) (PhotoAlbumShare, error)
+	GetPhoto(ctx context.Context, id uuid.UUID) (PhotoPhoto, error)
+	GetPhotoByPath(ctx context.Context, filePath string) (PhotoPhoto, error)
+	ListAlbumPhotos(ctx context.Context, arg ListAlbumPhotosParams) ([]PhotoPhoto, error)
+	ListAlbumShares(ctx context.Context, albumID uuid.UUID) ([]PhotoAlbumShare, error)
+	// Lists the folder albums and the user albums the user owns or was shared,
+	// user albums first. restrict limits the result to library_ids.
+	ListAlbums(ctx context.Context, arg ListAlbumsParams) ([]ListAlbumsRow, error)
+	ListPhotoPaths(ctx context.Context, libraryID uuid.UUID) ([]ListPhotoPathsRow, error)
+	// Lists photos newest first, or oldest first. restrict limits the result
+	// to library_ids, the libraries the user may access.
+	ListPhotos(ctx context.Context, arg ListPhotosParams) ([]PhotoPhoto, error)
+	// Groups photos by day or month of capture, newest first, with the
+	// latest photo of each group as its cover.
+	ListTimeline(ctx context.Context, arg ListTimelineParams) ([]ListTimelineRow, error)
+	RemoveAlbumPhoto(ctx context.Context, arg RemoveAlbumPhotoParams) (int64, error)
+	SetPhotoPlaceholder(ctx context.Context, arg SetPhotoPlaceholderParams) error
+	UpdateAlbum(ctx context.Context, arg UpdateAlbumParams) (PhotoAlbum, error)
+	UpsertAlbumShare(ctx context.Context, arg UpsertAlbumShareParams) (PhotoAlbumShare, error)
+	UpsertFolderAlbum(ctx context.Context, arg UpsertFolderAlbumParams) (PhotoAlbum, error)
+	// Photos are keyed by their file so rescans update them in place. The
+	// placeholder is kept unless the file changed.
+	UpsertPhoto(ctx context.Context, arg UpsertPhotoParams) (PhotoPhoto, error)
+}
+
+var _ Querier = (*Queries)(nil)
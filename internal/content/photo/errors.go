@@ -0,0 +1,18 @@
+package photo
+
+import "errors"
+
+var (
+	ErrPhotoNotFound      = errors.New("photo not found")
+	ErrAlbumNotFound      = errors.New("album not found")
+	ErrShareNotFound      = errors.New("album share not found")
+	ErrNotAlbumOwner      = errors.New("only the album owner may do this")
+	ErrNotContributor     = errors.New("not allowed to change the album's photos")
+	ErrFolderAlbum        = errors.New("folder albums are managed by the library scan")
+	ErrPhotoNotInLibrary  = errors.New("photo is not in the album's library")
+	ErrInvalidAlbum       = errors.New("invalid album")
+	ErrInvalidShare       = errors.New("invalid album share")
+	ErrNoThumbnail        = errors.New("no thumbnail for this photo")
+	ErrInvalidThumbnail   = errors.New("invalid thumbnail size")
+	ErrThumbnailsDisabled = errors.New("thumbnails are not available")
+)
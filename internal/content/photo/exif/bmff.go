@@ -0,0 +1,445 @@
+package exif
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// heifBrands are the ftyp brands of HEIF still images (HEIC, AVIF).
+var heifBrands = map[string]bool{
+	"heic": true, "heix": true, "heim": true, "heis": true,
+	"mif1": true, "msf1": true, "avif": true, "avis": true,
+}
+
+// maxBoxDepth bounds the nesting of boxes walked.
+const maxBoxDepth = 8
+
+// box is an ISO BMFF box header. The payload spans [start, end) in the file.
+type box struct {
+	typ        string
+	start, end int64
+}
+
+// bmffReader walks the boxes of an ISO BMFF file.
+type bmffReader struct {
+	r    io.ReadSeeker
+	size int64
+}
+
+// decodeBMFF reads HEIF images and MP4/QuickTime video clips.
+func decodeBMFF(r io.ReadSeeker, brand string, m *Metadata) error {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	b := &bmffReader{r: r, size: size}
+	if heifBrands[brand] {
+		m.Format = "heif"
+		return b.decodeHEIF(m)
+	}
+
+	m.Kind = KindVideo
+	m.Format = "mp4"
+	if brand == "qt  " {
+		m.Format = "mov"
+	}
+	return b.decodeMovie(m)
+}
+
+// children returns the boxes within [start, end).
+func (b *bmffReader) children(start, end int64) ([]box, error) {
+	var boxes []box
+	for pos := start; pos+8 <= end; {
+		var header [8]byte
+		if _, err := b.readAt(header[:], pos); err != nil {
+			return boxes, nil
+		}
+		size := int64(binary.BigEndian.Uint32(header[0:4]))
+		typ := string(header[4:8])
+		headerLen := int64(8)
+		switch size {
+		case 0:
+			size = end - pos
+		case 1:
+			var large [8]byte
+			if _, err := b.readAt(large[:], pos+8); err != nil {
+				return boxes, nil
+			}
+			size = int64(binary.BigEndian.Uint64(large[:])) // #nosec G115 -- bounds checked below
+			headerLen = 16
+		}
+		if size < headerLen || pos+size > end {
+			return boxes, errMalformed
+		}
+		boxes = append(boxes, box{typ: typ, start: pos + headerLen, end: pos + size})
+		pos += size
+	}
+	return boxes, nil
+}
+
+// find returns the first box of the given type in a path below [start,
+// end), e.g. find(0, size, "moov", "mvhd").
+func (b *bmffReader) find(start, end int64, path ...string) (box, bool) {
+	if len(path) > maxBoxDepth {
+		return box{}, false
+	}
+	boxes, _ := b.children(start, end)
+	for _, bx := range boxes {
+		if bx.typ != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return bx, true
+		}
+		return b.find(bx.start, bx.end, path[1:]...)
+	}
+	return box{}, false
+}
+
+func (b *bmffReader) readAt(p []byte, off int64) (int, error) {
+	if _, err := b.r.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(b.r, p)
+}
+
+// payload reads a box's payload, refusing oversized boxes.
+func (b *bmffReader) payload(bx box) ([]byte, error) {
+	if bx.end-bx.start > maxExifSize {
+		return nil, errMalformed
+	}
+	data := make([]byte, bx.end-bx.start)
+	if _, err := b.readAt(data, bx.start); err != nil {
+		return nil, errMalformed
+	}
+	return data, nil
+}
+
+// decodeHEIF reads the Exif item and the image size of a HEIF file. The
+// Exif item is located through the item info (iinf) and item location
+// (iloc) boxes of the meta box.
+func (b *bmffReader) decodeHEIF(m *Metadata) error {
+	meta, ok := b.find(0, b.size, "meta")
+	if !ok {
+		return nil
+	}
+	// meta is a full box: skip version and flags
+	boxes, _ := b.children(meta.start+4, meta.end)
+
+	var exifID uint32
+	var iloc []byte
+	for _, bx := range boxes {
+		switch bx.typ {
+		case "iinf":
+			if data, err := b.payload(bx); err == nil {
+				exifID = heifExifItem(b, bx, data)
+			}
+		case "iloc":
+			iloc, _ = b.payload(bx)
+		case "iprp":
+			b.heifImageSize(bx, m)
+		}
+	}
+	if exifID == 0 || iloc == nil {
+		return nil
+	}
+
+	offset, length, ok := heifItemExtent(iloc, exifID)
+	if !ok || length < 8 || length > maxExifSize {
+		return nil
+	}
+	data := make([]byte, length)
+	if _, err := b.readAt(data, offset); err != nil {
+		return nil
+	}
+	// The item starts with the offset of the TIFF header past this field
+	skip := int64(binary.BigEndian.Uint32(data[0:4])) + 4
+	if skip >= length {
+		return nil
+	}
+	width, height := m.Width, m.Height
+	if err := parseTIFFBytes(data[skip:], m); err != nil && !errors.Is(err, errMalformed) {
+		return err
+	}
+	if width > 0 && height > 0 {
+		m.Width, m.Height = width, height
+	}
+	return nil
+}
+
+// heifExifItem returns the ID of the Exif item listed in an iinf box.
+func heifExifItem(b *bmffReader, iinf box, data []byte) uint32 {
+	if len(data) < 4 {
+		return 0
+	}
+	// Full box header, then a 16-bit (version 0) or 32-bit entry count
+	start := iinf.start + 6
+	if data[0] != 0 {
+		start = iinf.start + 8
+	}
+	entries, _ := b.children(start, iinf.end)
+	for _, e := range entries {
+		if e.typ != "infe" {
+			continue
+		}
+		infe, err := b.payload(e)
+		if err != nil || len(infe) < 4 {
+			continue
+		}
+		// Item info entries of version 2 and 3 carry the item type
+		var id uint32
+		var itemType string
+		switch infe[0] {
+		case 2:
+			if len(infe) < 12 {
+				continue
+			}
+			id = uint32(binary.BigEndian.Uint16(infe[4:6]))
+			itemType = string(infe[8:12])
+		case 3:
+			if len(infe) < 14 {
+				continue
+			}
+			id = binary.BigEndian.Uint32(infe[4:8])
+			itemType = string(infe[10:14])
+		default:
+			continue
+		}
+		if itemType == "Exif" {
+			return id
+		}
+	}
+	return 0
+}
+
+// heifItemExtent returns the file offset and length of an item's first
+// extent from an iloc box.
+func heifItemExtent(iloc []byte, itemID uint32) (offset, length int64, ok bool) {
+	p := &byteParser{data: iloc}
+	version := p.u8()
+	p.skip(3)
+	sizes := p.u16()
+	offsetSize := int(sizes >> 12)
+	lengthSize := int(sizes >> 8 & 0xf)
+	baseOffsetSize := int(sizes >> 4 & 0xf)
+	indexSize := 0
+	if version == 1 || version == 2 {
+		indexSize = int(sizes & 0xf)
+	}
+	var count uint32
+	if version < 2 {
+		count = uint32(p.u16())
+	} else {
+		count = p.u32()
+	}
+
+	for range count {
+		var id uint32
+		if version < 2 {
+			id = uint32(p.u16())
+		} else {
+			id = p.u32()
+		}
+		constructionMethod := uint16(0)
+		if version == 1 || version == 2 {
+			constructionMethod = p.u16() & 0xf
+		}
+		p.skip(2) // data reference index
+		base := p.uintN(baseOffsetSize)
+		extents := p.u16()
+		for e := range extents {
+			if indexSize > 0 {
+				p.uintN(indexSize)
+			}
+			extOffset := p.uintN(offsetSize)
+			extLength := p.uintN(lengthSize)
+			// Only items stored in the file itself are supported, not
+			// those in the idat box
+			if e == 0 && id == itemID && constructionMethod == 0 && !p.err {
+				return int64(base + extOffset), int64(extLength), true // #nosec G115 -- checked against the file by the reader
+			}
+		}
+		if p.err {
+			return 0, 0, false
+		}
+	}
+	return 0, 0, false
+}
+
+// heifImageSize reads the largest image spatial extent (ispe) property,
+// which is the primary image; the others describe tiles and thumbnails.
+func (b *bmffReader) heifImageSize(iprp box, m *Metadata) {
+	ipco, ok := b.find(iprp.start, iprp.end, "ipco")
+	if !ok {
+		return
+	}
+	props, _ := b.children(ipco.start, ipco.end)
+	for _, prop := range props {
+		if prop.typ != "ispe" {
+			continue
+		}
+		data, err := b.payload(prop)
+		if err != nil || len(data) < 12 {
+			continue
+		}
+		w := int(binary.BigEndian.Uint32(data[4:8]))
+		h := int(binary.BigEndian.Uint32(data[8:12]))
+		if w*h > m.Width*m.Height {
+			m.Width, m.Height = w, h
+		}
+	}
+}
+
+// movieEpoch is the origin of QuickTime and MP4 timestamps.
+var movieEpoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// iso6709Pattern matches the "+37.3349-122.0090+010.000/" locations that
+// phones write into the ©xyz user data box.
+var iso6709Pattern = regexp.MustCompile(`^([+-]\d+(?:\.\d+)?)([+-]\d+(?:\.\d+)?)([+-]\d+(?:\.\d+)?)?`)
+
+// decodeMovie reads the creation time and duration from the movie header,
+// the frame size from the track headers and the location from the user
+// data of MP4 and QuickTime files.
+func (b *bmffReader) decodeMovie(m *Metadata) error {
+	moov, ok := b.find(0, b.size, "moov")
+	if !ok {
+		return nil
+	}
+	boxes, _ := b.children(moov.start, moov.end)
+	for _, bx := range boxes {
+		switch bx.typ {
+		case "mvhd":
+			data, err := b.payload(bx)
+			if err != nil {
+				continue
+			}
+			readMovieHeader(data, m)
+		case "trak":
+			if tkhd, ok := b.find(bx.start, bx.end, "tkhd"); ok {
+				if data, err := b.payload(tkhd); err == nil {
+					readTrackSize(data, m)
+				}
+			}
+		case "udta":
+			if xyz, ok := b.find(bx.start, bx.end, "\xa9xyz"); ok {
+				if data, err := b.payload(xyz); err == nil && len(data) > 4 {
+					// 16-bit string length and language code, then the text
+					readISO6709(string(data[4:]), m)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// readMovieHeader reads an mvhd box. Cameras write creation times in UTC;
+// a zero time means unset.
+func readMovieHeader(data []byte, m *Metadata) {
+	p := &byteParser{data: data}
+	version := p.u8()
+	p.skip(3)
+	var created, duration uint64
+	var timescale uint32
+	if version == 1 {
+		created = p.u64()
+		p.skip(8)
+		timescale = p.u32()
+		duration = p.u64()
+	} else {
+		created = uint64(p.u32())
+		p.skip(4)
+		timescale = p.u32()
+		duration = uint64(p.u32())
+	}
+	if p.err {
+		return
+	}
+	if created > 0 && created < math.MaxInt64/uint64(time.Second) {
+		t := movieEpoch.Add(time.Duration(created) * time.Second) // #nosec G115 -- bounded above
+		m.TakenAt = &t
+	}
+	if timescale > 0 && duration != math.MaxUint32 && duration != math.MaxUint64 {
+		m.DurationSeconds = float64(duration) / float64(timescale)
+	}
+}
+
+// readTrackSize reads the presentation size of a track header; audio
+// tracks have none. The largest track wins.
+func readTrackSize(data []byte, m *Metadata) {
+	p := &byteParser{data: data}
+	version := p.u8()
+	p.skip(3)
+	if version == 1 {
+		p.skip(8 + 8 + 4 + 4 + 8)
+	} else {
+		p.skip(4 + 4 + 4 + 4 + 4)
+	}
+	p.skip(8 + 2 + 2 + 2 + 2 + 36) // reserved, layer, group, volume, reserved, matrix
+	w := int(p.u32() >> 16)        // 16.16 fixed point
+	h := int(p.u32() >> 16)
+	if !p.err && w*h > m.Width*m.Height {
+		m.Width, m.Height = w, h
+	}
+}
+
+// readISO6709 parses an ISO 6709 location string.
+func readISO6709(s string, m *Metadata) {
+	match := iso6709Pattern.FindStringSubmatch(s)
+	if match == nil {
+		return
+	}
+	lat, err1 := strconv.ParseFloat(match[1], 64)
+	lon, err2 := strconv.ParseFloat(match[2], 64)
+	if err1 != nil || err2 != nil || math.Abs(lat) > 90 || math.Abs(lon) > 180 {
+		return
+	}
+	m.Latitude, m.Longitude = &lat, &lon
+	if match[3] != "" {
+		if alt, err := strconv.ParseFloat(match[3], 64); err == nil {
+			m.Altitude = &alt
+		}
+	}
+}
+
+// byteParser reads big-endian fields from a buffer, recording overruns
+// instead of panicking.
+type byteParser struct {
+	data []byte
+	pos  int
+	err  bool
+}
+
+func (p *byteParser) next(n int) []byte {
+	if p.err || n < 0 || p.pos+n > len(p.data) {
+		p.err = true
+		return make([]byte, max(n, 0))
+	}
+	b := p.data[p.pos : p.pos+n]
+	p.pos += n
+	return b
+}
+
+func (p *byteParser) skip(n int)  { p.next(n) }
+func (p *byteParser) u8() uint8   { return p.next(1)[0] }
+func (p *byteParser) u16() uint16 { return binary.BigEndian.Uint16(p.next(2)) }
+func (p *byteParser) u32() uint32 { return binary.BigEndian.Uint32(p.next(4)) }
+func (p *byteParser) u64() uint64 { return binary.BigEndian.Uint64(p.next(8)) }
+
+// uintN reads an unsigned field of 0, 4 or 8 bytes, as used by iloc.
+func (p *byteParser) uintN(size int) uint64 {
+	switch size {
+	case 0:
+		return 0
+	case 4:
+		return uint64(p.u32())
+	case 8:
+		return p.u64()
+	}
+	p.err = true
+	return 0
+}
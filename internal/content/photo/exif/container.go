@@ -0,0 +1,200 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// decodeJPEG walks the JPEG segments up to the image data, reading the
+// EXIF APP1 segment and the frame dimensions.
+func decodeJPEG(r io.Reader, m *Metadata) error {
+	br := bufio.NewReader(r)
+	if _, err := br.Discard(2); err != nil { // SOI
+		return errMalformed
+	}
+	for {
+		// Markers may be preceded by fill bytes
+		b, err := br.ReadByte()
+		if err != nil {
+			return nil
+		}
+		if b != 0xFF {
+			return nil
+		}
+		marker, err := br.ReadByte()
+		for err == nil && marker == 0xFF {
+			marker, err = br.ReadByte()
+		}
+		if err != nil {
+			return nil
+		}
+		switch {
+		case marker == 0xD8 || (marker >= 0xD0 && marker <= 0xD7) || marker == 0x01:
+			continue // no payload
+		case marker == 0xDA || marker == 0xD9:
+			return nil // start of scan or end of image
+		}
+
+		var lenBuf [2]byte
+		if _, err := io.ReadFull(br, lenBuf[:]); err != nil {
+			return nil
+		}
+		length := int(binary.BigEndian.Uint16(lenBuf[:])) - 2
+		if length < 0 {
+			return errMalformed
+		}
+
+		switch {
+		case marker == 0xE1:
+			data := make([]byte, length)
+			if _, err := io.ReadFull(br, data); err != nil {
+				return nil
+			}
+			// XMP shares APP1; only the first EXIF segment counts
+			if bytes.HasPrefix(data, []byte("Exif\x00\x00")) && m.Make == "" && m.TakenAt == nil {
+				if err := parseTIFFBytes(data, m); err != nil && !errors.Is(err, errMalformed) {
+					return err
+				}
+			}
+		case isSOF(marker) && length >= 5:
+			data := make([]byte, length)
+			if _, err := io.ReadFull(br, data); err != nil {
+				return nil
+			}
+			// The frame header is authoritative over EXIF dimensions,
+			// which editors often leave stale
+			m.Height = int(binary.BigEndian.Uint16(data[1:3]))
+			m.Width = int(binary.BigEndian.Uint16(data[3:5]))
+		default:
+			if _, err := br.Discard(length); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// isSOF reports whether marker starts a frame. DHT, JPG and DAC share the
+// range.
+func isSOF(marker byte) bool {
+	return marker >= 0xC0 && marker <= 0xCF && marker != 0xC4 && marker != 0xC8 && marker != 0xCC
+}
+
+// decodePNG reads the IHDR and eXIf chunks.
+func decodePNG(r io.ReadSeeker, m *Metadata) error {
+	if _, err := r.Seek(8, io.SeekStart); err != nil {
+		return err
+	}
+	var header [8]byte
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil
+		}
+		length := int64(binary.BigEndian.Uint32(header[0:4]))
+		switch string(header[4:8]) {
+		case "IHDR":
+			var ihdr [8]byte
+			if length < 8 {
+				return errMalformed
+			}
+			if _, err := io.ReadFull(r, ihdr[:]); err != nil {
+				return errMalformed
+			}
+			m.Width = int(binary.BigEndian.Uint32(ihdr[0:4]))
+			m.Height = int(binary.BigEndian.Uint32(ihdr[4:8]))
+			length -= 8
+		case "eXIf":
+			if length > maxExifSize {
+				return errMalformed
+			}
+			data := make([]byte, length)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return errMalformed
+			}
+			width, height := m.Width, m.Height
+			if err := parseTIFFBytes(data, m); err != nil && !errors.Is(err, errMalformed) {
+				return err
+			}
+			// IHDR is authoritative for the dimensions
+			m.Width, m.Height = width, height
+			length = 0
+		case "IEND":
+			return nil
+		}
+		// Skip the rest of the chunk and its CRC
+		if _, err := r.Seek(length+4, io.SeekCurrent); err != nil {
+			return nil
+		}
+	}
+}
+
+// decodeWebP reads the RIFF chunks of a WebP file: the dimensions from the
+// VP8X, VP8 or VP8L chunk and the EXIF chunk of extended files.
+func decodeWebP(r io.ReadSeeker, m *Metadata) error {
+	if _, err := r.Seek(12, io.SeekStart); err != nil {
+		return err
+	}
+	var header [8]byte
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil
+		}
+		length := int64(binary.LittleEndian.Uint32(header[4:8]))
+		if length > maxExifSize && string(header[0:4]) == "EXIF" {
+			return errMalformed
+		}
+		read := int64(0)
+		switch string(header[0:4]) {
+		case "VP8X":
+			var b [10]byte
+			if _, err := io.ReadFull(r, b[:]); err != nil {
+				return errMalformed
+			}
+			m.Width = int(uint24(b[4:7])) + 1
+			m.Height = int(uint24(b[7:10])) + 1
+			read = 10
+		case "VP8 ":
+			var b [10]byte
+			if _, err := io.ReadFull(r, b[:]); err != nil {
+				return errMalformed
+			}
+			if m.Width == 0 && b[3] == 0x9d && b[4] == 0x01 && b[5] == 0x2a {
+				m.Width = int(binary.LittleEndian.Uint16(b[6:8]) & 0x3fff)
+				m.Height = int(binary.LittleEndian.Uint16(b[8:10]) & 0x3fff)
+			}
+			read = 10
+		case "VP8L":
+			var b [5]byte
+			if _, err := io.ReadFull(r, b[:]); err != nil {
+				return errMalformed
+			}
+			if m.Width == 0 && b[0] == 0x2f {
+				bits := binary.LittleEndian.Uint32(b[1:5])
+				m.Width = int(bits&0x3fff) + 1
+				m.Height = int((bits>>14)&0x3fff) + 1
+			}
+			read = 5
+		case "EXIF":
+			data := make([]byte, length)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return errMalformed
+			}
+			width, height := m.Width, m.Height
+			if err := parseTIFFBytes(data, m); err != nil && !errors.Is(err, errMalformed) {
+				return err
+			}
+			m.Width, m.Height = width, height
+			read = length
+		}
+		// Chunks are padded to an even size
+		if _, err := r.Seek(length-read+length%2, io.SeekCurrent); err != nil {
+			return nil
+		}
+	}
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
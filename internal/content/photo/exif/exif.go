@@ -0,0 +1,199 @@
+// Package exif reads capture metadata from photo and video files: the
+// capture time, camera, lens, exposure, GPS position and orientation.
+// EXIF is found in JPEG APP1 segments, TIFF-based raw files (DNG, NEF,
+// CR2, ARW), PNG eXIf chunks, WebP EXIF chunks and HEIF/HEIC Exif items.
+// Camera clips (MP4, MOV, 3GP) carry a creation time, a duration and, when
+// recorded on a phone, an ISO 6709 location.
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	// Registered for image.DecodeConfig, which fills in the dimensions of
+	// files without EXIF.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+var (
+	// ErrUnsupported is returned for files that are neither a known image
+	// nor a known video container.
+	ErrUnsupported = errors.New("unsupported file format")
+
+	// errMalformed is returned for structures that point outside their data.
+	errMalformed = errors.New("malformed metadata")
+)
+
+// maxExifSize bounds the EXIF blocks read into memory. JPEG limits APP1 to
+// 64 KiB; other containers are given more room for maker notes.
+const maxExifSize = 4 << 20
+
+// Kind tells photos and video clips apart.
+type Kind string
+
+const (
+	KindImage Kind = "image"
+	KindVideo Kind = "video"
+)
+
+// Metadata is the capture metadata of a photo or clip. Fields the file does
+// not record are left zero.
+type Metadata struct {
+	Kind   Kind
+	Format string // "jpeg", "tiff", "png", "webp", "heif", "gif", "bmp", "mp4", "mov"
+
+	// TakenAt is the capture time. EXIF times without an offset are local
+	// wall-clock times and are returned as UTC.
+	TakenAt *time.Time
+
+	// Orientation is the EXIF orientation, 1 (upright) to 8.
+	Orientation int
+
+	Width  int
+	Height int
+
+	Make      string
+	Model     string
+	LensModel string
+
+	ExposureTime string // e.g. "1/250" or "2"
+	FNumber      *float64
+	ISO          int
+	FocalLength  *float64 // millimeters
+
+	Latitude  *float64
+	Longitude *float64
+	Altitude  *float64 // meters above sea level
+
+	// DurationSeconds is the length of video clips.
+	DurationSeconds float64
+}
+
+// HasLocation reports whether the file records a GPS position.
+func (m *Metadata) HasLocation() bool {
+	return m.Latitude != nil && m.Longitude != nil
+}
+
+// Rotated reports whether the orientation swaps width and height, so the
+// displayed image is Height x Width.
+func (m *Metadata) Rotated() bool {
+	return m.Orientation >= 5 && m.Orientation <= 8
+}
+
+// Read reads the metadata of a photo or video file.
+func Read(path string) (*Metadata, error) {
+	f, err := os.Open(path) // #nosec G304 -- path comes from the library scan
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	m, err := Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Decode reads the metadata of a photo or video from r.
+func Decode(r io.ReadSeeker) (*Metadata, error) {
+	var header [32]byte
+	n, err := io.ReadFull(r, header[:])
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	h := header[:n]
+
+	m := &Metadata{Kind: KindImage}
+	switch {
+	case bytes.HasPrefix(h, []byte{0xFF, 0xD8}):
+		m.Format = "jpeg"
+		err = decodeJPEG(r, m)
+	case bytes.HasPrefix(h, []byte("II*\x00")), bytes.HasPrefix(h, []byte("MM\x00*")):
+		m.Format = "tiff"
+		err = decodeTIFFFile(r, m)
+	case bytes.HasPrefix(h, []byte("\x89PNG\r\n\x1a\n")):
+		m.Format = "png"
+		err = decodePNG(r, m)
+	case len(h) >= 12 && string(h[:4]) == "RIFF" && string(h[8:12]) == "WEBP":
+		m.Format = "webp"
+		err = decodeWebP(r, m)
+	case len(h) >= 12 && string(h[4:8]) == "ftyp":
+		err = decodeBMFF(r, string(h[8:12]), m)
+	case bytes.HasPrefix(h, []byte("GIF8")):
+		m.Format = "gif"
+	case bytes.HasPrefix(h, []byte("BM")):
+		m.Format = "bmp"
+		err = decodeBMP(h, m)
+	default:
+		return nil, ErrUnsupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Kind == KindImage && (m.Width == 0 || m.Height == 0) {
+		if _, err := r.Seek(0, io.SeekStart); err == nil {
+			if cfg, _, err := image.DecodeConfig(r); err == nil {
+				m.Width, m.Height = cfg.Width, cfg.Height
+			}
+		}
+	}
+	return m, nil
+}
+
+// decodeBMP reads the dimensions from the info header that follows the
+// 14-byte file header. Bottom-up bitmaps have a negative height.
+func decodeBMP(h []byte, m *Metadata) error {
+	if len(h) < 26 {
+		return errMalformed
+	}
+	width := int32(binary.LittleEndian.Uint32(h[18:22]))  // #nosec G115 -- signed field
+	height := int32(binary.LittleEndian.Uint32(h[22:26])) // #nosec G115 -- signed field
+	m.Width = int(width)
+	m.Height = int(max(height, -height))
+	return nil
+}
+
+// parseExifTime parses an EXIF "2006:01:02 15:04:05" time with an optional
+// "+02:00" offset. Times without an offset are taken as UTC.
+func parseExifTime(value, offset string) (time.Time, bool) {
+	if len(value) < 19 || value[:4] == "0000" {
+		return time.Time{}, false
+	}
+	value = value[:19]
+	if offset != "" {
+		if t, err := time.Parse("2006:01:02 15:04:05-07:00", value+offset); err == nil {
+			return t, true
+		}
+	}
+	t, err := time.Parse("2006:01:02 15:04:05", value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// formatExposure formats an exposure time in seconds the way cameras show
+// it: fractions of a second as "1/250", longer exposures as "2" or "1.5".
+func formatExposure(seconds float64) string {
+	if seconds <= 0 {
+		return ""
+	}
+	if seconds >= 1 {
+		return strconv.FormatFloat(seconds, 'f', -1, 64)
+	}
+	return "1/" + strconv.FormatFloat(float64(int(1/seconds+0.5)), 'f', -1, 64)
+}
@@ -0,0 +1,332 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tiffEntry is an IFD entry for building test EXIF blocks.
+type tiffEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	data  []byte
+}
+
+func asciiEntry(tag uint16, s string) tiffEntry {
+	return tiffEntry{tag: tag, typ: typeASCII, count: uint32(len(s) + 1), data: append([]byte(s), 0)}
+}
+
+func shortEntry(order binary.ByteOrder, tag uint16, v uint16) tiffEntry {
+	data := make([]byte, 2)
+	order.PutUint16(data, v)
+	return tiffEntry{tag: tag, typ: typeShort, count: 1, data: data}
+}
+
+func rationalEntry(order binary.ByteOrder, tag uint16, values ...[2]uint32) tiffEntry {
+	data := make([]byte, 0, 8*len(values))
+	for _, v := range values {
+		var b [8]byte
+		order.PutUint32(b[0:], v[0])
+		order.PutUint32(b[4:], v[1])
+		data = append(data, b[:]...)
+	}
+	return tiffEntry{tag: tag, typ: typeRational, count: uint32(len(values)), data: data}
+}
+
+// buildTIFF lays out IFD0, the Exif IFD and the GPS IFD one after the other
+// and links them from IFD0.
+func buildTIFF(order binary.ByteOrder, ifd0, exifIFD, gps []tiffEntry) []byte {
+	size := func(entries []tiffEntry) int {
+		n := 2 + 12*len(entries) + 4
+		for _, e := range entries {
+			if len(e.data) > 4 {
+				n += len(e.data)
+			}
+		}
+		return n
+	}
+	ifd0 = append(ifd0,
+		tiffEntry{tag: tagExifIFD, typ: typeLong, count: 1, data: make([]byte, 4)},
+		tiffEntry{tag: tagGPSIFD, typ: typeLong, count: 1, data: make([]byte, 4)},
+	)
+	offExif := 8 + size(ifd0)
+	offGPS := offExif + size(exifIFD)
+	order.PutUint32(ifd0[len(ifd0)-2].data, uint32(offExif))
+	order.PutUint32(ifd0[len(ifd0)-1].data, uint32(offGPS))
+
+	buf := make([]byte, offGPS+size(gps))
+	if order == binary.LittleEndian {
+		copy(buf, "II*\x00")
+	} else {
+		copy(buf, "MM\x00*")
+	}
+	order.PutUint32(buf[4:], 8)
+
+	write := func(offset int, entries []tiffEntry) {
+		order.PutUint16(buf[offset:], uint16(len(entries)))
+		dataOff := offset + 2 + 12*len(entries) + 4
+		for i, e := range entries {
+			p := buf[offset+2+12*i:]
+			order.PutUint16(p[0:], e.tag)
+			order.PutUint16(p[2:], e.typ)
+			order.PutUint32(p[4:], e.count)
+			if len(e.data) <= 4 {
+				copy(p[8:12], e.data)
+				continue
+			}
+			order.PutUint32(p[8:], uint32(dataOff))
+			copy(buf[dataOff:], e.data)
+			dataOff += len(e.data)
+		}
+	}
+	write(8, ifd0)
+	write(offExif, exifIFD)
+	write(offGPS, gps)
+	return buf
+}
+
+// cameraEXIF returns the EXIF block of a phone photo taken in Berlin.
+func cameraEXIF(order binary.ByteOrder) []byte {
+	return buildTIFF(order,
+		[]tiffEntry{
+			asciiEntry(tagMake, "Apple"),
+			asciiEntry(tagModel, "iPhone 15 Pro"),
+			shortEntry(order, tagOrientation, 6),
+			asciiEntry(tagDateTime, "2024:07:01 18:00:00"),
+		},
+		[]tiffEntry{
+			asciiEntry(tagDateTimeOriginal, "2024:06:30 14:05:09"),
+			asciiEntry(tagOffsetTimeOriginal, "+02:00"),
+			rationalEntry(order, tagExposureTime, [2]uint32{1, 250}),
+			rationalEntry(order, tagFNumber, [2]uint32{178, 100}),
+			shortEntry(order, tagISO, 80),
+			rationalEntry(order, tagFocalLength, [2]uint32{6860, 1000}),
+			asciiEntry(tagLensModel, "iPhone 15 Pro back triple camera 6.86mm f/1.78"),
+		},
+		[]tiffEntry{
+			asciiEntry(tagGPSLatitudeRef, "N"),
+			rationalEntry(order, tagGPSLatitude, [2]uint32{52, 1}, [2]uint32{31, 1}, [2]uint32{1200, 100}),
+			asciiEntry(tagGPSLongitudeRef, "E"),
+			rationalEntry(order, tagGPSLongitude, [2]uint32{13, 1}, [2]uint32{24, 1}, [2]uint32{0, 1}),
+			{tag: tagGPSAltitudeRef, typ: typeByte, count: 1, data: []byte{0}},
+			rationalEntry(order, tagGPSAltitude, [2]uint32{345, 10}),
+		},
+	)
+}
+
+func assertCameraMetadata(t *testing.T, m *Metadata) {
+	t.Helper()
+	assert.Equal(t, "Apple", m.Make)
+	assert.Equal(t, "iPhone 15 Pro", m.Model)
+	assert.Equal(t, "iPhone 15 Pro back triple camera 6.86mm f/1.78", m.LensModel)
+	assert.Equal(t, 6, m.Orientation)
+	assert.True(t, m.Rotated())
+
+	require.NotNil(t, m.TakenAt)
+	assert.True(t, m.TakenAt.Equal(time.Date(2024, 6, 30, 12, 5, 9, 0, time.UTC)), "offset is applied: %s", m.TakenAt)
+
+	assert.Equal(t, "1/250", m.ExposureTime)
+	require.NotNil(t, m.FNumber)
+	assert.InDelta(t, 1.78, *m.FNumber, 0.001)
+	assert.Equal(t, 80, m.ISO)
+	require.NotNil(t, m.FocalLength)
+	assert.InDelta(t, 6.86, *m.FocalLength, 0.001)
+
+	require.True(t, m.HasLocation())
+	assert.InDelta(t, 52.52, *m.Latitude, 0.0001)
+	assert.InDelta(t, 13.4, *m.Longitude, 0.0001)
+	require.NotNil(t, m.Altitude)
+	assert.InDelta(t, 34.5, *m.Altitude, 0.001)
+}
+
+// encodeJPEG returns a baseline JPEG with the EXIF block in an APP1 segment
+// right after SOI, where cameras put it.
+func encodeJPEG(t *testing.T, width, height int, exifBlock []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil))
+	if exifBlock == nil {
+		return buf.Bytes()
+	}
+	payload := append([]byte("Exif\x00\x00"), exifBlock...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(payload)+2))
+	app1 = append(app1, payload...)
+
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func TestDecode_JPEG(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			m, err := Decode(bytes.NewReader(encodeJPEG(t, 40, 30, cameraEXIF(order))))
+			require.NoError(t, err)
+			assert.Equal(t, KindImage, m.Kind)
+			assert.Equal(t, "jpeg", m.Format)
+			assert.Equal(t, 40, m.Width, "frame header gives the size")
+			assert.Equal(t, 30, m.Height)
+			assertCameraMetadata(t, m)
+		})
+	}
+}
+
+func TestDecode_JPEGWithoutEXIF(t *testing.T) {
+	m, err := Decode(bytes.NewReader(encodeJPEG(t, 16, 8, nil)))
+	require.NoError(t, err)
+	assert.Equal(t, 16, m.Width)
+	assert.Equal(t, 8, m.Height)
+	assert.Nil(t, m.TakenAt)
+	assert.False(t, m.HasLocation())
+	assert.Zero(t, m.Orientation)
+}
+
+func TestDecode_TIFF(t *testing.T) {
+	m, err := Decode(bytes.NewReader(cameraEXIF(binary.LittleEndian)))
+	require.NoError(t, err)
+	assert.Equal(t, "tiff", m.Format)
+	assertCameraMetadata(t, m)
+}
+
+func TestDecode_PNG(t *testing.T) {
+	var buf bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, 12, 7))
+	img.Set(0, 0, color.White)
+	require.NoError(t, png.Encode(&buf, img))
+	data := buf.Bytes()
+
+	// Insert an eXIf chunk after IHDR (8 byte signature + 25 byte IHDR)
+	exifBlock := cameraEXIF(binary.BigEndian)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(exifBlock)))
+	chunk = append(chunk, "eXIf"...)
+	chunk = append(chunk, exifBlock...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+	data = append(append(append([]byte{}, data[:33]...), chunk...), data[33:]...)
+
+	m, err := Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "png", m.Format)
+	assert.Equal(t, 12, m.Width)
+	assert.Equal(t, 7, m.Height)
+	assertCameraMetadata(t, m)
+}
+
+// bmffBox encodes an ISO BMFF box.
+func bmffBox(typ string, payload ...[]byte) []byte {
+	body := bytes.Join(payload, nil)
+	b := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	return append(append(b, typ...), body...)
+}
+
+func u16(v uint16) []byte { return binary.BigEndian.AppendUint16(nil, v) }
+func u32(v uint32) []byte { return binary.BigEndian.AppendUint32(nil, v) }
+
+func TestDecode_HEIF(t *testing.T) {
+	exifBlock := cameraEXIF(binary.BigEndian)
+	// Exif item: offset to the TIFF header, "Exif\0\0", TIFF
+	item := append(u32(6), append([]byte("Exif\x00\x00"), exifBlock...)...)
+
+	ftyp := bmffBox("ftyp", []byte("heic"), u32(0), []byte("mif1heic"))
+	infe := func(id uint16, typ string) []byte {
+		return bmffBox("infe", []byte{2, 0, 0, 0}, u16(id), u16(0), []byte(typ), []byte{0})
+	}
+	iinf := bmffBox("iinf", []byte{0, 0, 0, 0}, u16(2), infe(1, "hvc1"), infe(2, "Exif"))
+	ispe := func(w, h uint32) []byte { return bmffBox("ispe", []byte{0, 0, 0, 0}, u32(w), u32(h)) }
+	iprp := bmffBox("iprp", bmffBox("ipco", ispe(512, 512), ispe(4032, 3024)))
+
+	// iloc version 0 with 4-byte offsets and lengths; the Exif offset is
+	// patched in once the layout is known
+	ilocFor := func(exifOffset uint32) []byte {
+		return bmffBox("iloc", []byte{0, 0, 0, 0}, u16(0x4400), u16(1),
+			u16(2), u16(0), u16(1), u32(exifOffset), u32(uint32(len(item))))
+	}
+	meta := func(exifOffset uint32) []byte {
+		return bmffBox("meta", []byte{0, 0, 0, 0}, iinf, ilocFor(exifOffset), iprp)
+	}
+	head := append(ftyp, meta(0)...)
+	mdat := bmffBox("mdat", item)
+	file := append(append(ftyp, meta(uint32(len(head)+8))...), mdat...)
+
+	m, err := Decode(bytes.NewReader(file))
+	require.NoError(t, err)
+	assert.Equal(t, KindImage, m.Kind)
+	assert.Equal(t, "heif", m.Format)
+	assert.Equal(t, 4032, m.Width, "the primary image is the largest extent")
+	assert.Equal(t, 3024, m.Height)
+	assertCameraMetadata(t, m)
+}
+
+func TestDecode_MP4(t *testing.T) {
+	created := time.Date(2023, 12, 24, 17, 30, 0, 0, time.UTC)
+	mvhd := bmffBox("mvhd", []byte{0, 0, 0, 0},
+		u32(uint32(created.Sub(movieEpoch)/time.Second)), u32(0), u32(600), u32(600*12+300))
+	tkhd := bmffBox("tkhd", []byte{0, 0, 0, 0}, make([]byte, 20), make([]byte, 52), u32(1920<<16), u32(1080<<16))
+	xyz := bmffBox("\xa9xyz", u16(18), u16(0x15c7), []byte("+48.8584+002.2945/"))
+	moov := bmffBox("moov", mvhd, bmffBox("trak", tkhd), bmffBox("udta", xyz))
+	file := append(bmffBox("ftyp", []byte("mp42"), u32(0), []byte("isommp42")), moov...)
+
+	m, err := Decode(bytes.NewReader(file))
+	require.NoError(t, err)
+	assert.Equal(t, KindVideo, m.Kind)
+	assert.Equal(t, "mp4", m.Format)
+	require.NotNil(t, m.TakenAt)
+	assert.True(t, m.TakenAt.Equal(created))
+	assert.InDelta(t, 12.5, m.DurationSeconds, 0.001)
+	assert.Equal(t, 1920, m.Width)
+	assert.Equal(t, 1080, m.Height)
+	require.True(t, m.HasLocation())
+	assert.InDelta(t, 48.8584, *m.Latitude, 0.00001)
+	assert.InDelta(t, 2.2945, *m.Longitude, 0.00001)
+}
+
+func TestDecode_Unsupported(t *testing.T) {
+	_, err := Decode(bytes.NewReader([]byte("just some text, not a photo")))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestDecode_TruncatedEXIF(t *testing.T) {
+	exifBlock := cameraEXIF(binary.LittleEndian)
+	m, err := Decode(bytes.NewReader(encodeJPEG(t, 10, 10, exifBlock[:40])))
+	require.NoError(t, err, "broken EXIF is skipped")
+	assert.Equal(t, 10, m.Width)
+}
+
+func TestParseExifTime(t *testing.T) {
+	tests := []struct {
+		value, offset string
+		want          time.Time
+		ok            bool
+	}{
+		{"2024:06:30 14:05:09", "", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC), true},
+		{"2024:06:30 14:05:09", "-05:00", time.Date(2024, 6, 30, 19, 5, 9, 0, time.UTC), true},
+		{"2024:06:30 14:05:09", "garbage", time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC), true},
+		{"0000:00:00 00:00:00", "", time.Time{}, false},
+		{"", "", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseExifTime(tt.value, tt.offset)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		if tt.ok {
+			assert.True(t, got.Equal(tt.want), "%s %s: got %s", tt.value, tt.offset, got)
+		}
+	}
+}
+
+func TestFormatExposure(t *testing.T) {
+	assert.Equal(t, "1/250", formatExposure(0.004))
+	assert.Equal(t, "1/3", formatExposure(1.0/3))
+	assert.Equal(t, "2", formatExposure(2))
+	assert.Equal(t, "1.5", formatExposure(1.5))
+	assert.Empty(t, formatExposure(0))
+}
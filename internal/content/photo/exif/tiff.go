@@ -0,0 +1,318 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"time"
+)
+
+// TIFF tags read from IFD0, the Exif IFD and the GPS IFD.
+const (
+	tagImageWidth  = 0x0100
+	tagImageLength = 0x0101
+	tagMake        = 0x010F
+	tagModel       = 0x0110
+	tagOrientation = 0x0112
+	tagDateTime    = 0x0132
+	tagExifIFD     = 0x8769
+	tagGPSIFD      = 0x8825
+
+	tagExposureTime        = 0x829A
+	tagFNumber             = 0x829D
+	tagISO                 = 0x8827
+	tagDateTimeOriginal    = 0x9003
+	tagDateTimeDigitized   = 0x9004
+	tagOffsetTime          = 0x9010
+	tagOffsetTimeOriginal  = 0x9011
+	tagOffsetTimeDigitized = 0x9012
+	tagFocalLength         = 0x920A
+	tagPixelXDimension     = 0xA002
+	tagPixelYDimension     = 0xA003
+	tagLensModel           = 0xA434
+
+	tagGPSLatitudeRef  = 0x0001
+	tagGPSLatitude     = 0x0002
+	tagGPSLongitudeRef = 0x0003
+	tagGPSLongitude    = 0x0004
+	tagGPSAltitudeRef  = 0x0005
+	tagGPSAltitude     = 0x0006
+)
+
+// TIFF field types.
+const (
+	typeByte      = 1
+	typeASCII     = 2
+	typeShort     = 3
+	typeLong      = 4
+	typeRational  = 5
+	typeUndefined = 7
+	typeSLong     = 9
+	typeSRational = 10
+)
+
+// maxIFDEntries bounds the entries read from one IFD.
+const maxIFDEntries = 1000
+
+// typeSizes holds the size in bytes of one value of each field type.
+var typeSizes = map[uint16]int64{
+	typeByte:      1,
+	typeASCII:     1,
+	typeShort:     2,
+	typeLong:      4,
+	typeRational:  8,
+	typeUndefined: 1,
+	typeSLong:     4,
+	typeSRational: 8,
+}
+
+// field is a decoded IFD entry.
+type field struct {
+	typ   uint16
+	count uint32
+	data  []byte
+}
+
+// tiffReader reads a TIFF structure starting at base within r. Offsets in
+// the structure are relative to base.
+type tiffReader struct {
+	r     io.ReaderAt
+	base  int64
+	order binary.ByteOrder
+}
+
+// decodeTIFFFile reads the EXIF of a TIFF-based file, raw formats
+// included. IFD0 describes the main image in plain TIFFs and a preview in
+// most raw files, so the dimensions are taken from the Exif IFD first.
+func decodeTIFFFile(r io.ReadSeeker, m *Metadata) error {
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		data, err := io.ReadAll(io.LimitReader(r, maxExifSize))
+		if err != nil {
+			return err
+		}
+		ra = bytes.NewReader(data)
+	}
+	return parseTIFF(ra, 0, m)
+}
+
+// parseTIFFBytes reads an EXIF block, which is a TIFF structure, optionally
+// preceded by the "Exif\0\0" identifier.
+func parseTIFFBytes(data []byte, m *Metadata) error {
+	data = bytes.TrimPrefix(data, []byte("Exif\x00\x00"))
+	return parseTIFF(bytes.NewReader(data), 0, m)
+}
+
+// parseTIFF reads the TIFF header at base and the IFDs it links to.
+func parseTIFF(r io.ReaderAt, base int64, m *Metadata) error {
+	var header [8]byte
+	if _, err := r.ReadAt(header[:], base); err != nil {
+		return errMalformed
+	}
+	t := &tiffReader{r: r, base: base}
+	switch string(header[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return errMalformed
+	}
+
+	ifd0, err := t.readIFD(int64(t.order.Uint32(header[4:])))
+	if err != nil {
+		return err
+	}
+	m.Make = t.string(ifd0[tagMake])
+	m.Model = t.string(ifd0[tagModel])
+	if o, ok := t.uint(ifd0[tagOrientation]); ok && o >= 1 && o <= 8 {
+		m.Orientation = int(o)
+	}
+	if w, ok := t.uint(ifd0[tagImageWidth]); ok {
+		m.Width = int(w)
+	}
+	if h, ok := t.uint(ifd0[tagImageLength]); ok {
+		m.Height = int(h)
+	}
+
+	var exifIFD map[uint16]field
+	if off, ok := t.uint(ifd0[tagExifIFD]); ok {
+		// A broken Exif IFD still leaves IFD0 and GPS usable
+		exifIFD, _ = t.readIFD(int64(off))
+	}
+	m.TakenAt = takenAt(t, ifd0, exifIFD)
+	if exifIFD != nil {
+		t.readExifIFD(exifIFD, m)
+	}
+
+	if off, ok := t.uint(ifd0[tagGPSIFD]); ok {
+		if gps, err := t.readIFD(int64(off)); err == nil {
+			t.readGPSIFD(gps, m)
+		}
+	}
+	return nil
+}
+
+// takenAt picks the capture time: the original time, else the digitized
+// time, else the file's modification time as written by the camera.
+func takenAt(t *tiffReader, ifd0, exifIFD map[uint16]field) *time.Time {
+	candidates := []struct {
+		value, offset field
+	}{
+		{exifIFD[tagDateTimeOriginal], exifIFD[tagOffsetTimeOriginal]},
+		{exifIFD[tagDateTimeDigitized], exifIFD[tagOffsetTimeDigitized]},
+		{ifd0[tagDateTime], exifIFD[tagOffsetTime]},
+	}
+	for _, c := range candidates {
+		if ts, ok := parseExifTime(t.string(c.value), t.string(c.offset)); ok {
+			return &ts
+		}
+	}
+	return nil
+}
+
+func (t *tiffReader) readExifIFD(ifd map[uint16]field, m *Metadata) {
+	if v, ok := t.rational(ifd[tagExposureTime], 0); ok {
+		m.ExposureTime = formatExposure(v)
+	}
+	if v, ok := t.rational(ifd[tagFNumber], 0); ok && v > 0 {
+		m.FNumber = &v
+	}
+	if v, ok := t.uint(ifd[tagISO]); ok {
+		m.ISO = int(v)
+	}
+	if v, ok := t.rational(ifd[tagFocalLength], 0); ok && v > 0 {
+		m.FocalLength = &v
+	}
+	m.LensModel = t.string(ifd[tagLensModel])
+
+	// The Exif IFD describes the main image; IFD0 of raw files describes
+	// an embedded preview
+	if w, ok := t.uint(ifd[tagPixelXDimension]); ok && w > 0 {
+		m.Width = int(w)
+	}
+	if h, ok := t.uint(ifd[tagPixelYDimension]); ok && h > 0 {
+		m.Height = int(h)
+	}
+}
+
+func (t *tiffReader) readGPSIFD(ifd map[uint16]field, m *Metadata) {
+	lat, okLat := t.coordinate(ifd[tagGPSLatitude])
+	lon, okLon := t.coordinate(ifd[tagGPSLongitude])
+	// 0,0 is what cameras without a fix write
+	if okLat && okLon && (lat != 0 || lon != 0) && math.Abs(lat) <= 90 && math.Abs(lon) <= 180 {
+		if strings.HasPrefix(t.string(ifd[tagGPSLatitudeRef]), "S") {
+			lat = -lat
+		}
+		if strings.HasPrefix(t.string(ifd[tagGPSLongitudeRef]), "W") {
+			lon = -lon
+		}
+		m.Latitude, m.Longitude = &lat, &lon
+	}
+	if alt, ok := t.rational(ifd[tagGPSAltitude], 0); ok {
+		if ref := ifd[tagGPSAltitudeRef]; len(ref.data) > 0 && ref.data[0] == 1 {
+			alt = -alt
+		}
+		m.Altitude = &alt
+	}
+}
+
+// readIFD reads the entries of the IFD at offset.
+func (t *tiffReader) readIFD(offset int64) (map[uint16]field, error) {
+	if offset <= 0 {
+		return nil, errMalformed
+	}
+	var countBuf [2]byte
+	if _, err := t.r.ReadAt(countBuf[:], t.base+offset); err != nil {
+		return nil, errMalformed
+	}
+	count := int(t.order.Uint16(countBuf[:]))
+	if count > maxIFDEntries {
+		return nil, errMalformed
+	}
+	entries := make([]byte, count*12)
+	if _, err := t.r.ReadAt(entries, t.base+offset+2); err != nil {
+		return nil, errMalformed
+	}
+
+	fields := make(map[uint16]field, count)
+	for i := range count {
+		e := entries[i*12 : i*12+12]
+		tag := t.order.Uint16(e[0:2])
+		typ := t.order.Uint16(e[2:4])
+		n := t.order.Uint32(e[4:8])
+		size, ok := typeSizes[typ]
+		if !ok {
+			continue
+		}
+		total := size * int64(n)
+		if total > maxExifSize {
+			continue
+		}
+		var data []byte
+		if total <= 4 {
+			data = append([]byte(nil), e[8:8+total]...)
+		} else {
+			data = make([]byte, total)
+			if _, err := t.r.ReadAt(data, t.base+int64(t.order.Uint32(e[8:12]))); err != nil {
+				continue
+			}
+		}
+		fields[tag] = field{typ: typ, count: n, data: data}
+	}
+	return fields, nil
+}
+
+// string returns an ASCII field without its NUL terminator and padding.
+func (t *tiffReader) string(f field) string {
+	if f.typ != typeASCII && f.typ != typeUndefined {
+		return ""
+	}
+	s, _, _ := strings.Cut(string(f.data), "\x00")
+	return strings.TrimSpace(s)
+}
+
+// uint returns the first value of a BYTE, SHORT or LONG field.
+func (t *tiffReader) uint(f field) (uint32, bool) {
+	if f.count == 0 {
+		return 0, false
+	}
+	switch f.typ {
+	case typeByte:
+		return uint32(f.data[0]), true
+	case typeShort:
+		return uint32(t.order.Uint16(f.data)), true
+	case typeLong:
+		return t.order.Uint32(f.data), true
+	}
+	return 0, false
+}
+
+// rational returns the i-th value of a RATIONAL or SRATIONAL field.
+func (t *tiffReader) rational(f field, i int) (float64, bool) {
+	if (f.typ != typeRational && f.typ != typeSRational) || uint32(i) >= f.count { // #nosec G115 -- i is a small index
+		return 0, false
+	}
+	b := f.data[i*8 : i*8+8]
+	num, den := t.order.Uint32(b[0:4]), t.order.Uint32(b[4:8])
+	if den == 0 {
+		return 0, false
+	}
+	if f.typ == typeSRational {
+		return float64(int32(num)) / float64(int32(den)), true // #nosec G115 -- signed field
+	}
+	return float64(num) / float64(den), true
+}
+
+// coordinate converts a degrees, minutes, seconds GPS field to degrees.
+func (t *tiffReader) coordinate(f field) (float64, bool) {
+	deg, ok1 := t.rational(f, 0)
+	minutes, ok2 := t.rational(f, 1)
+	sec, ok3 := t.rational(f, 2)
+	if !ok1 || !ok2 || !ok3 {
+		return 0, false
+	}
+	return deg + minutes/60 + sec/3600, true
+}
@@ -0,0 +1,288 @@
+// Package jobs provides background job workers for photo content. Library
+// scans read the EXIF capture metadata of each photo and clip, file photos
+// under the folder album of their directory and render the small thumbnail
+// with its placeholder.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/photo"
+	"github.com/lusoris/revenge/internal/content/photo/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// KindLibraryScan is the job kind of photo library scans.
+const KindLibraryScan = "photo_library_scan"
+
+// libraryType is the library type reported in scan events.
+const libraryType = "photo"
+
+// LibraryScanArgs defines arguments for photo library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for photos.
+	Paths []string `json:"paths"`
+
+	// Force re-reads the metadata of photos that are already imported.
+	Force bool `json:"force"`
+
+	// LibraryID is the library the photos belong to. Photos carry their
+	// library for permission checks, so scans without one are rejected.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for photo library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans photo library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             photo.Service
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service photo.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "photo_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Any("paths", job.Args.Paths),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, libraryType, job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+
+	status.Start(ctx)
+
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	if job.Args.LibraryID == nil {
+		w.logger.Warn("no library provided for photo library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	libraryID := *job.Args.LibraryID
+
+	parser := adapters.NewPhotoFileParser()
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, libraryType, libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	var files []scanner.ScanResult
+	for _, sr := range scanResults {
+		if sr.IsMedia {
+			files = append(files, sr)
+		}
+	}
+	present := make(map[string]bool, len(files))
+	processed := 0
+
+	for _, f := range files {
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(files),
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    f.FilePath,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+		present[f.FilePath] = true
+
+		// Unchanged files keep their metadata unless a full rescan is
+		// forced; a preview that failed last time is retried
+		existing, err := w.service.GetPhotoByPath(ctx, f.FilePath)
+		if err == nil && existing != nil && !job.Args.Force &&
+			existing.FileSize == f.FileSize && existing.LibraryID == libraryID {
+			if existing.Kind == photo.KindImage && existing.BlurHash == nil {
+				w.generatePreview(ctx, existing)
+			}
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadPhoto(f.FilePath)
+		if err != nil {
+			w.logger.Warn("failed to read photo",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(err)
+			continue
+		}
+		scanned.LibraryID = libraryID
+		imported, err := w.service.ImportPhoto(ctx, scanned, libraryRoot(job.Args.Paths, f.FilePath))
+		if err != nil {
+			w.logger.Warn("failed to import photo",
+				slog.String("path", f.FilePath),
+				slog.Any("error", err),
+			)
+			result.AddError(fmt.Errorf("import %s: %w", f.FilePath, err))
+			continue
+		}
+		w.generatePreview(ctx, imported)
+		result.ItemsProcessed++
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(files),
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	// An empty result usually means an unmounted library, not deleted photos
+	itemsRemoved := 0
+	if len(files) > 0 {
+		itemsRemoved, err = w.service.RemoveMissing(ctx, libraryID, job.Args.Paths, present)
+		if err != nil {
+			w.logger.Warn("failed to remove missing photos", slog.Any("error", err))
+		}
+	}
+	if result.ItemsProcessed > 0 || itemsRemoved > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune empty folder albums", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_skipped", itemsSkipped),
+		slog.Int("items_removed", itemsRemoved),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ItemsRemoved: util.SafeIntToInt32(itemsRemoved),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType).
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	return nil
+}
+
+// generatePreview renders the small thumbnail and placeholder of a photo.
+// A failed preview does not fail the import; the next scan retries it.
+func (w *LibraryScanWorker) generatePreview(ctx context.Context, p *photo.Photo) {
+	if err := w.service.GeneratePreview(ctx, p); err != nil {
+		w.logger.Warn("failed to generate photo preview",
+			slog.String("path", p.FilePath),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// libraryRoot returns the library path containing filePath, the innermost
+// one if library paths are nested.
+func libraryRoot(paths []string, filePath string) string {
+	root := ""
+	for _, p := range paths {
+		p = filepath.Clean(p)
+		if (filePath == p || strings.HasPrefix(filePath, p+string(filepath.Separator))) && len(p) > len(root) {
+			root = p
+		}
+	}
+	return root
+}
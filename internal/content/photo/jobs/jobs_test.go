@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibraryRoot(t *testing.T) {
+	paths := []string{"/photos", "/photos/phone/", "/camera"}
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"/photos/2024/IMG_0001.jpg", "/photos"},
+		{"/photos/phone/DCIM/IMG_0002.jpg", "/photos/phone"},
+		{"/camera/DSC_0003.NEF", "/camera"},
+		{"/cameraroll/IMG_0004.jpg", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			assert.Equal(t, tt.want, libraryRoot(paths, tt.file))
+		})
+	}
+}
+
+func TestLibraryScanArgs_Kind(t *testing.T) {
+	assert.Equal(t, "photo_library_scan", LibraryScanArgs{}.Kind())
+}
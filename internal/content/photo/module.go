@@ -0,0 +1,11 @@
+package photo
+
+import "go.uber.org/fx"
+
+// Module provides the photo content module.
+var Module = fx.Module("photo",
+	fx.Provide(
+		NewPostgresRepository,
+		NewService,
+	),
+)
@@ -0,0 +1,89 @@
+package photo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for photos and albums
+type Repository interface {
+	// Photos
+	GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error)
+	GetPhotoByPath(ctx context.Context, filePath string) (*Photo, error)
+	UpsertPhoto(ctx context.Context, params UpsertPhotoParams) (*Photo, error)
+	SetPhotoPlaceholder(ctx context.Context, id uuid.UUID, blurHash, dominantColor string) error
+	ListPhotos(ctx context.Context, filters PhotoListFilters) ([]Photo, error)
+	CountPhotos(ctx context.Context, filters PhotoListFilters) (int64, error)
+	ListTimeline(ctx context.Context, filters TimelineFilters) ([]TimelineBucket, error)
+	ListPhotoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error)
+	CountPhotosInLibrary(ctx context.Context, libraryID uuid.UUID, photoIDs []uuid.UUID) (int64, error)
+	DeletePhoto(ctx context.Context, id uuid.UUID) error
+
+	// Albums
+	GetAlbum(ctx context.Context, id uuid.UUID) (*Album, error)
+	UpsertFolderAlbum(ctx context.Context, libraryID uuid.UUID, folderPath, title string) (*Album, error)
+	CreateAlbum(ctx context.Context, params CreateAlbumParams) (*Album, error)
+	UpdateAlbum(ctx context.Context, id uuid.UUID, title string, description *string, coverPhotoID *uuid.UUID) error
+	DeleteAlbum(ctx context.Context, id uuid.UUID) error
+	ListAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) ([]Album, error)
+	CountAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) (int64, error)
+	DeleteEmptyFolderAlbums(ctx context.Context) (int64, error)
+
+	// Album photos
+	ListAlbumPhotos(ctx context.Context, albumID uuid.UUID, limit, offset int32) ([]Photo, error)
+	AddAlbumPhotos(ctx context.Context, albumID uuid.UUID, photoIDs []uuid.UUID, addedBy uuid.UUID) (int64, error)
+	AddFolderAlbumPhoto(ctx context.Context, albumID, photoID uuid.UUID) error
+	RemoveAlbumPhoto(ctx context.Context, albumID, photoID uuid.UUID) (bool, error)
+
+	// Album shares
+	ListAlbumShares(ctx context.Context, albumID uuid.UUID) ([]AlbumShare, error)
+	GetAlbumShare(ctx context.Context, albumID, userID uuid.UUID) (*AlbumShare, error)
+	UpsertAlbumShare(ctx context.Context, albumID, userID uuid.UUID, canContribute bool) (*AlbumShare, error)
+	DeleteAlbumShare(ctx context.Context, albumID, userID uuid.UUID) (bool, error)
+}
+
+// UpsertPhotoParams contains parameters for creating or updating a photo
+// from its file
+type UpsertPhotoParams struct {
+	LibraryID       uuid.UUID
+	FilePath        string
+	FileSize        int64
+	Folder          string
+	Kind            Kind
+	Format          string
+	TakenAt         time.Time
+	TakenAtSource   string
+	Width           int32
+	Height          int32
+	Orientation     int32
+	CameraMake      *string
+	CameraModel     *string
+	LensModel       *string
+	ExposureTime    *string
+	FNumber         *float64
+	ISO             *int32
+	FocalLength     *float64
+	Latitude        *float64
+	Longitude       *float64
+	Altitude        *float64
+	DurationSeconds float64
+}
+
+// CreateAlbumParams contains parameters for creating a user album
+type CreateAlbumParams struct {
+	LibraryID   uuid.UUID
+	OwnerID     uuid.UUID
+	Title       string
+	Description *string
+}
+
+// UpdateAlbumParams contains the editable fields of a user album. Nil
+// fields keep their value; an empty description and a nil UUID cover clear
+// them.
+type UpdateAlbumParams struct {
+	Title        *string
+	Description  *string
+	CoverPhotoID *uuid.UUID
+}
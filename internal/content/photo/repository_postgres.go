@@ -0,0 +1,455 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	photodb "github.com/lusoris/revenge/internal/content/photo/db"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *photodb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: photodb.New(pool),
+	}
+}
+
+// =============================================================================
+// Photos
+// =============================================================================
+
+func (r *postgresRepository) GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error) {
+	p, err := r.queries.GetPhoto(ctx, id)
+	if err != nil {
+		return nil, photoError(err)
+	}
+	return dbPhotoToPhoto(p), nil
+}
+
+func (r *postgresRepository) GetPhotoByPath(ctx context.Context, filePath string) (*Photo, error) {
+	p, err := r.queries.GetPhotoByPath(ctx, filePath)
+	if err != nil {
+		return nil, photoError(err)
+	}
+	return dbPhotoToPhoto(p), nil
+}
+
+func (r *postgresRepository) UpsertPhoto(ctx context.Context, params UpsertPhotoParams) (*Photo, error) {
+	p, err := r.queries.UpsertPhoto(ctx, photodb.UpsertPhotoParams{
+		LibraryID:       params.LibraryID,
+		FilePath:        params.FilePath,
+		FileSize:        params.FileSize,
+		Folder:          params.Folder,
+		Kind:            string(params.Kind),
+		Format:          params.Format,
+		TakenAt:         params.TakenAt,
+		TakenAtSource:   params.TakenAtSource,
+		Width:           params.Width,
+		Height:          params.Height,
+		Orientation:     params.Orientation,
+		CameraMake:      params.CameraMake,
+		CameraModel:     params.CameraModel,
+		LensModel:       params.LensModel,
+		ExposureTime:    params.ExposureTime,
+		FNumber:         params.FNumber,
+		Iso:             params.ISO,
+		FocalLength:     params.FocalLength,
+		Latitude:        params.Latitude,
+		Longitude:       params.Longitude,
+		Altitude:        params.Altitude,
+		DurationSeconds: params.DurationSeconds,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert photo: %w", err)
+	}
+	return dbPhotoToPhoto(p), nil
+}
+
+func (r *postgresRepository) SetPhotoPlaceholder(ctx context.Context, id uuid.UUID, blurHash, dominantColor string) error {
+	return r.queries.SetPhotoPlaceholder(ctx, photodb.SetPhotoPlaceholderParams{
+		ID:            id,
+		Blurhash:      &blurHash,
+		DominantColor: &dominantColor,
+	})
+}
+
+func (r *postgresRepository) ListPhotos(ctx context.Context, filters PhotoListFilters) ([]Photo, error) {
+	rows, err := r.queries.ListPhotos(ctx, photodb.ListPhotosParams{
+		Limit:       filters.Limit,
+		Offset:      filters.Offset,
+		Restrict:    filters.LibraryIDs != nil,
+		LibraryIds:  filters.LibraryIDs,
+		LibraryID:   uuidToPg(filters.LibraryID),
+		Kind:        string(filters.Kind),
+		TakenAfter:  timeToPg(filters.TakenAfter),
+		TakenBefore: timeToPg(filters.TakenBefore),
+		OldestFirst: filters.OldestFirst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+	return dbPhotosToPhotos(rows), nil
+}
+
+func (r *postgresRepository) CountPhotos(ctx context.Context, filters PhotoListFilters) (int64, error) {
+	return r.queries.CountPhotos(ctx, photodb.CountPhotosParams{
+		Restrict:    filters.LibraryIDs != nil,
+		LibraryIds:  filters.LibraryIDs,
+		LibraryID:   uuidToPg(filters.LibraryID),
+		Kind:        string(filters.Kind),
+		TakenAfter:  timeToPg(filters.TakenAfter),
+		TakenBefore: timeToPg(filters.TakenBefore),
+	})
+}
+
+func (r *postgresRepository) ListTimeline(ctx context.Context, filters TimelineFilters) ([]TimelineBucket, error) {
+	rows, err := r.queries.ListTimeline(ctx, photodb.ListTimelineParams{
+		Limit:       filters.Limit,
+		Offset:      filters.Offset,
+		Granularity: string(filters.Granularity),
+		Restrict:    filters.LibraryIDs != nil,
+		LibraryIds:  filters.LibraryIDs,
+		LibraryID:   uuidToPg(filters.LibraryID),
+		Kind:        string(filters.Kind),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list timeline: %w", err)
+	}
+	buckets := make([]TimelineBucket, len(rows))
+	for i, row := range rows {
+		buckets[i] = TimelineBucket{
+			Date:         row.Bucket,
+			Count:        row.PhotoCount,
+			CoverPhotoID: row.CoverPhotoID,
+		}
+	}
+	return buckets, nil
+}
+
+// ListPhotoPaths returns the IDs of a library's photos keyed by their file
+// path.
+func (r *postgresRepository) ListPhotoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ListPhotoPaths(ctx, libraryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photo paths: %w", err)
+	}
+	paths := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		paths[row.FilePath] = row.ID
+	}
+	return paths, nil
+}
+
+func (r *postgresRepository) CountPhotosInLibrary(ctx context.Context, libraryID uuid.UUID, photoIDs []uuid.UUID) (int64, error) {
+	return r.queries.CountPhotosInLibrary(ctx, photodb.CountPhotosInLibraryParams{
+		LibraryID: libraryID,
+		PhotoIds:  photoIDs,
+	})
+}
+
+func (r *postgresRepository) DeletePhoto(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeletePhoto(ctx, id)
+}
+
+// =============================================================================
+// Albums
+// =============================================================================
+
+func (r *postgresRepository) GetAlbum(ctx context.Context, id uuid.UUID) (*Album, error) {
+	row, err := r.queries.GetAlbum(ctx, id)
+	if err != nil {
+		return nil, albumError(err)
+	}
+	return dbAlbumToAlbum(row.PhotoAlbum, row.PhotoCount, row.FirstPhotoID), nil
+}
+
+func (r *postgresRepository) UpsertFolderAlbum(ctx context.Context, libraryID uuid.UUID, folderPath, title string) (*Album, error) {
+	a, err := r.queries.UpsertFolderAlbum(ctx, photodb.UpsertFolderAlbumParams{
+		LibraryID:  libraryID,
+		Title:      title,
+		FolderPath: &folderPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert folder album: %w", err)
+	}
+	return dbAlbumToAlbum(a, 0, uuid.Nil), nil
+}
+
+func (r *postgresRepository) CreateAlbum(ctx context.Context, params CreateAlbumParams) (*Album, error) {
+	a, err := r.queries.CreateAlbum(ctx, photodb.CreateAlbumParams{
+		LibraryID:   params.LibraryID,
+		Title:       params.Title,
+		Description: params.Description,
+		OwnerID:     uuidToPg(&params.OwnerID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create album: %w", err)
+	}
+	return dbAlbumToAlbum(a, 0, uuid.Nil), nil
+}
+
+func (r *postgresRepository) UpdateAlbum(ctx context.Context, id uuid.UUID, title string, description *string, coverPhotoID *uuid.UUID) error {
+	_, err := r.queries.UpdateAlbum(ctx, photodb.UpdateAlbumParams{
+		ID:           id,
+		Title:        title,
+		Description:  description,
+		CoverPhotoID: uuidToPg(coverPhotoID),
+	})
+	if err != nil {
+		return albumError(err)
+	}
+	return nil
+}
+
+func (r *postgresRepository) DeleteAlbum(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteAlbum(ctx, id)
+}
+
+func (r *postgresRepository) ListAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) ([]Album, error) {
+	rows, err := r.queries.ListAlbums(ctx, photodb.ListAlbumsParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		Kind:       string(filters.Kind),
+		UserID:     userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list albums: %w", err)
+	}
+	albums := make([]Album, len(rows))
+	for i, row := range rows {
+		albums[i] = *dbAlbumToAlbum(row.PhotoAlbum, row.PhotoCount, row.FirstPhotoID)
+	}
+	return albums, nil
+}
+
+func (r *postgresRepository) CountAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) (int64, error) {
+	return r.queries.CountAlbums(ctx, photodb.CountAlbumsParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+		LibraryID:  uuidToPg(filters.LibraryID),
+		Kind:       string(filters.Kind),
+		UserID:     userID,
+	})
+}
+
+func (r *postgresRepository) DeleteEmptyFolderAlbums(ctx context.Context) (int64, error) {
+	return r.queries.DeleteEmptyFolderAlbums(ctx)
+}
+
+// =============================================================================
+// Album Photos
+// =============================================================================
+
+func (r *postgresRepository) ListAlbumPhotos(ctx context.Context, albumID uuid.UUID, limit, offset int32) ([]Photo, error) {
+	rows, err := r.queries.ListAlbumPhotos(ctx, photodb.ListAlbumPhotosParams{
+		AlbumID: albumID,
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list album photos: %w", err)
+	}
+	return dbPhotosToPhotos(rows), nil
+}
+
+func (r *postgresRepository) AddAlbumPhotos(ctx context.Context, albumID uuid.UUID, photoIDs []uuid.UUID, addedBy uuid.UUID) (int64, error) {
+	n, err := r.queries.AddAlbumPhotos(ctx, photodb.AddAlbumPhotosParams{
+		AlbumID:  albumID,
+		AddedBy:  uuidToPg(&addedBy),
+		PhotoIds: photoIDs,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to add album photos: %w", err)
+	}
+	return n, nil
+}
+
+func (r *postgresRepository) AddFolderAlbumPhoto(ctx context.Context, albumID, photoID uuid.UUID) error {
+	return r.queries.AddFolderAlbumPhoto(ctx, photodb.AddFolderAlbumPhotoParams{
+		AlbumID: albumID,
+		PhotoID: photoID,
+	})
+}
+
+func (r *postgresRepository) RemoveAlbumPhoto(ctx context.Context, albumID, photoID uuid.UUID) (bool, error) {
+	n, err := r.queries.RemoveAlbumPhoto(ctx, photodb.RemoveAlbumPhotoParams{AlbumID: albumID, PhotoID: photoID})
+	if err != nil {
+		return false, fmt.Errorf("failed to remove album photo: %w", err)
+	}
+	return n > 0, nil
+}
+
+// =============================================================================
+// Album Shares
+// =============================================================================
+
+func (r *postgresRepository) ListAlbumShares(ctx context.Context, albumID uuid.UUID) ([]AlbumShare, error) {
+	rows, err := r.queries.ListAlbumShares(ctx, albumID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list album shares: %w", err)
+	}
+	shares := make([]AlbumShare, len(rows))
+	for i, s := range rows {
+		shares[i] = *dbShareToShare(s)
+	}
+	return shares, nil
+}
+
+func (r *postgresRepository) GetAlbumShare(ctx context.Context, albumID, userID uuid.UUID) (*AlbumShare, error) {
+	s, err := r.queries.GetAlbumShare(ctx, photodb.GetAlbumShareParams{AlbumID: albumID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrShareNotFound
+		}
+		return nil, fmt.Errorf("failed to get album share: %w", err)
+	}
+	return dbShareToShare(s), nil
+}
+
+func (r *postgresRepository) UpsertAlbumShare(ctx context.Context, albumID, userID uuid.UUID, canContribute bool) (*AlbumShare, error) {
+	s, err := r.queries.UpsertAlbumShare(ctx, photodb.UpsertAlbumShareParams{
+		AlbumID:       albumID,
+		UserID:        userID,
+		CanContribute: canContribute,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to share album: %w", err)
+	}
+	return dbShareToShare(s), nil
+}
+
+func (r *postgresRepository) DeleteAlbumShare(ctx context.Context, albumID, userID uuid.UUID) (bool, error) {
+	n, err := r.queries.DeleteAlbumShare(ctx, photodb.DeleteAlbumShareParams{AlbumID: albumID, UserID: userID})
+	if err != nil {
+		return false, fmt.Errorf("failed to unshare album: %w", err)
+	}
+	return n > 0, nil
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func photoError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrPhotoNotFound
+	}
+	return fmt.Errorf("failed to get photo: %w", err)
+}
+
+func albumError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrAlbumNotFound
+	}
+	return fmt.Errorf("failed to get album: %w", err)
+}
+
+func dbPhotoToPhoto(p photodb.PhotoPhoto) *Photo {
+	return &Photo{
+		ID:              p.ID,
+		LibraryID:       p.LibraryID,
+		FilePath:        p.FilePath,
+		FileSize:        p.FileSize,
+		Folder:          p.Folder,
+		Kind:            Kind(p.Kind),
+		Format:          p.Format,
+		TakenAt:         p.TakenAt,
+		TakenAtSource:   p.TakenAtSource,
+		Width:           p.Width,
+		Height:          p.Height,
+		Orientation:     p.Orientation,
+		CameraMake:      p.CameraMake,
+		CameraModel:     p.CameraModel,
+		LensModel:       p.LensModel,
+		ExposureTime:    p.ExposureTime,
+		FNumber:         p.FNumber,
+		ISO:             p.Iso,
+		FocalLength:     p.FocalLength,
+		Latitude:        p.Latitude,
+		Longitude:       p.Longitude,
+		Altitude:        p.Altitude,
+		DurationSeconds: p.DurationSeconds,
+		BlurHash:        p.Blurhash,
+		DominantColor:   p.DominantColor,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+func dbPhotosToPhotos(rows []photodb.PhotoPhoto) []Photo {
+	photos := make([]Photo, len(rows))
+	for i, p := range rows {
+		photos[i] = *dbPhotoToPhoto(p)
+	}
+	return photos
+}
+
+// dbAlbumToAlbum converts an album row. The explicit cover wins over the
+// first photo; firstPhotoID is the zero UUID for empty albums.
+func dbAlbumToAlbum(a photodb.PhotoAlbum, photoCount int64, firstPhotoID uuid.UUID) *Album {
+	album := &Album{
+		ID:           a.ID,
+		LibraryID:    a.LibraryID,
+		Kind:         AlbumKind(a.Kind),
+		Title:        a.Title,
+		Description:  a.Description,
+		FolderPath:   a.FolderPath,
+		OwnerID:      pgToUUID(a.OwnerID),
+		CoverPhotoID: pgToUUID(a.CoverPhotoID),
+		PhotoCount:   photoCount,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+	if album.CoverPhotoID == nil && firstPhotoID != uuid.Nil {
+		album.CoverPhotoID = &firstPhotoID
+	}
+	return album
+}
+
+func dbShareToShare(s photodb.PhotoAlbumShare) *AlbumShare {
+	return &AlbumShare{
+		AlbumID:       s.AlbumID,
+		UserID:        s.UserID,
+		CanContribute: s.CanContribute,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil || *id == uuid.Nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func timeToPg(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
@@ -0,0 +1,540 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/infra/image"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Image is an encoded thumbnail.
+type Image struct {
+	Data        []byte
+	ContentType string
+}
+
+// Service defines business logic for photos and albums. Album methods take
+// the acting user: folder albums are visible to everyone, user albums to
+// their owner and the users they are shared with. Library permissions are
+// checked by the caller.
+type Service interface {
+	// Photo operations
+	GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error)
+	ListPhotos(ctx context.Context, filters PhotoListFilters) ([]Photo, int64, error)
+	GetTimeline(ctx context.Context, filters TimelineFilters) ([]TimelineBucket, error)
+	GetThumbnail(ctx context.Context, photoID uuid.UUID, size string) (*Image, error)
+	OpenFile(ctx context.Context, photoID uuid.UUID) (*Photo, *os.File, error)
+
+	// Album operations
+	ListAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) ([]Album, int64, error)
+	GetAlbum(ctx context.Context, userID, albumID uuid.UUID) (*Album, error)
+	CreateAlbum(ctx context.Context, params CreateAlbumParams) (*Album, error)
+	UpdateAlbum(ctx context.Context, userID, albumID uuid.UUID, params UpdateAlbumParams) (*Album, error)
+	DeleteAlbum(ctx context.Context, userID, albumID uuid.UUID) error
+
+	// Album photos
+	ListAlbumPhotos(ctx context.Context, userID, albumID uuid.UUID, limit, offset int32) ([]Photo, int64, error)
+	AddAlbumPhotos(ctx context.Context, userID, albumID uuid.UUID, photoIDs []uuid.UUID) (int64, error)
+	RemoveAlbumPhoto(ctx context.Context, userID, albumID, photoID uuid.UUID) error
+
+	// Album sharing
+	ListAlbumShares(ctx context.Context, userID, albumID uuid.UUID) ([]AlbumShare, error)
+	ShareAlbum(ctx context.Context, userID, albumID, targetID uuid.UUID, canContribute bool) (*AlbumShare, error)
+	UnshareAlbum(ctx context.Context, userID, albumID, targetID uuid.UUID) error
+
+	// Library import
+	GetPhotoByPath(ctx context.Context, filePath string) (*Photo, error)
+	ImportPhoto(ctx context.Context, scanned *ScannedPhoto, root string) (*Photo, error)
+	GeneratePreview(ctx context.Context, photo *Photo) error
+	RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error)
+	Prune(ctx context.Context) error
+}
+
+// photoService implements the Service interface
+type photoService struct {
+	repo Repository
+
+	// thumbnail scales a photo file down and caches the result on disk;
+	// nil when the image service is unavailable
+	thumbnail func(ctx context.Context, filePath, size string) ([]byte, string, error)
+
+	// placeholder computes the BlurHash of an image
+	placeholder func(data []byte) (*blurhash.Placeholder, error)
+}
+
+// NewService creates a new photo service. Thumbnails are rendered by the
+// image service, which may be nil.
+func NewService(repo Repository, images *image.Service) Service {
+	s := &photoService{
+		repo:        repo,
+		placeholder: image.ComputePlaceholder,
+	}
+	if images != nil {
+		s.thumbnail = func(ctx context.Context, filePath, size string) ([]byte, string, error) {
+			return images.FetchLocalImage(ctx, image.TypePhoto, filePath, size)
+		}
+	}
+	return s
+}
+
+// =============================================================================
+// Photo Operations
+// =============================================================================
+
+func (s *photoService) GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error) {
+	return s.repo.GetPhoto(ctx, id)
+}
+
+func (s *photoService) GetPhotoByPath(ctx context.Context, filePath string) (*Photo, error) {
+	return s.repo.GetPhotoByPath(ctx, filePath)
+}
+
+func (s *photoService) ListPhotos(ctx context.Context, filters PhotoListFilters) ([]Photo, int64, error) {
+	photos, err := s.repo.ListPhotos(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountPhotos(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return photos, count, nil
+}
+
+// GetTimeline groups the photos by day or month of capture.
+func (s *photoService) GetTimeline(ctx context.Context, filters TimelineFilters) ([]TimelineBucket, error) {
+	if !filters.Granularity.Valid() {
+		filters.Granularity = GranularityDay
+	}
+	return s.repo.ListTimeline(ctx, filters)
+}
+
+// GetThumbnail renders a thumbnail of a photo. Video clips have none.
+func (s *photoService) GetThumbnail(ctx context.Context, photoID uuid.UUID, size string) (*Image, error) {
+	photo, err := s.repo.GetPhoto(ctx, photoID)
+	if err != nil {
+		return nil, err
+	}
+	return s.renderThumbnail(ctx, photo, size)
+}
+
+func (s *photoService) renderThumbnail(ctx context.Context, photo *Photo, size string) (*Image, error) {
+	if !ValidThumbnailSize(size) {
+		return nil, ErrInvalidThumbnail
+	}
+	if photo.Kind != KindImage {
+		return nil, ErrNoThumbnail
+	}
+	if s.thumbnail == nil {
+		return nil, ErrThumbnailsDisabled
+	}
+	data, contentType, err := s.thumbnail(ctx, photo.FilePath, size)
+	if err != nil {
+		return nil, fmt.Errorf("render thumbnail of %s: %w", photo.FilePath, err)
+	}
+	return &Image{Data: data, ContentType: contentType}, nil
+}
+
+func (s *photoService) OpenFile(ctx context.Context, photoID uuid.UUID) (*Photo, *os.File, error) {
+	photo, err := s.repo.GetPhoto(ctx, photoID)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(photo.FilePath) // #nosec G304 -- file path comes from the library scan
+	if err != nil {
+		return nil, nil, fmt.Errorf("open %s: %w", photo.FilePath, err)
+	}
+	return photo, f, nil
+}
+
+// =============================================================================
+// Album Operations
+// =============================================================================
+
+func (s *photoService) ListAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) ([]Album, int64, error) {
+	albums, err := s.repo.ListAlbums(ctx, userID, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountAlbums(ctx, userID, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return albums, count, nil
+}
+
+// GetAlbum returns an album the user may see. Albums the user may not see
+// are reported as not found.
+func (s *photoService) GetAlbum(ctx context.Context, userID, albumID uuid.UUID) (*Album, error) {
+	album, _, err := s.visibleAlbum(ctx, userID, albumID)
+	return album, err
+}
+
+func (s *photoService) CreateAlbum(ctx context.Context, params CreateAlbumParams) (*Album, error) {
+	params.Title = strings.TrimSpace(params.Title)
+	if params.Title == "" || params.OwnerID == uuid.Nil {
+		return nil, ErrInvalidAlbum
+	}
+	params.Description = optionalString(params.Description)
+	return s.repo.CreateAlbum(ctx, params)
+}
+
+// UpdateAlbum edits a user album. Only the owner may edit it; the cover
+// must be a photo of the album's library.
+func (s *photoService) UpdateAlbum(ctx context.Context, userID, albumID uuid.UUID, params UpdateAlbumParams) (*Album, error) {
+	album, err := s.ownedAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	title := album.Title
+	if params.Title != nil {
+		title = strings.TrimSpace(*params.Title)
+		if title == "" {
+			return nil, ErrInvalidAlbum
+		}
+	}
+	description := album.Description
+	if params.Description != nil {
+		description = optionalString(params.Description)
+	}
+	cover := album.CoverPhotoID
+	if params.CoverPhotoID != nil {
+		cover = params.CoverPhotoID
+		if *cover != uuid.Nil {
+			if err := s.checkLibraryPhotos(ctx, album.LibraryID, []uuid.UUID{*cover}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := s.repo.UpdateAlbum(ctx, albumID, title, description, cover); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAlbum(ctx, albumID)
+}
+
+func (s *photoService) DeleteAlbum(ctx context.Context, userID, albumID uuid.UUID) error {
+	if _, err := s.ownedAlbum(ctx, userID, albumID); err != nil {
+		return err
+	}
+	return s.repo.DeleteAlbum(ctx, albumID)
+}
+
+// =============================================================================
+// Album Photos
+// =============================================================================
+
+func (s *photoService) ListAlbumPhotos(ctx context.Context, userID, albumID uuid.UUID, limit, offset int32) ([]Photo, int64, error) {
+	album, _, err := s.visibleAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, 0, err
+	}
+	photos, err := s.repo.ListAlbumPhotos(ctx, albumID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return photos, album.PhotoCount, nil
+}
+
+// AddAlbumPhotos appends photos to a user album. The owner and
+// contributors may add photos of the album's library. It returns the
+// number of photos that were not in the album yet.
+func (s *photoService) AddAlbumPhotos(ctx context.Context, userID, albumID uuid.UUID, photoIDs []uuid.UUID) (int64, error) {
+	album, err := s.contributableAlbum(ctx, userID, albumID)
+	if err != nil {
+		return 0, err
+	}
+
+	unique := make([]uuid.UUID, 0, len(photoIDs))
+	seen := make(map[uuid.UUID]bool, len(photoIDs))
+	for _, id := range photoIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 {
+		return 0, nil
+	}
+	if err := s.checkLibraryPhotos(ctx, album.LibraryID, unique); err != nil {
+		return 0, err
+	}
+	return s.repo.AddAlbumPhotos(ctx, albumID, unique, userID)
+}
+
+func (s *photoService) RemoveAlbumPhoto(ctx context.Context, userID, albumID, photoID uuid.UUID) error {
+	if _, err := s.contributableAlbum(ctx, userID, albumID); err != nil {
+		return err
+	}
+	removed, err := s.repo.RemoveAlbumPhoto(ctx, albumID, photoID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrPhotoNotFound
+	}
+	return nil
+}
+
+// =============================================================================
+// Album Sharing
+// =============================================================================
+
+// ListAlbumShares returns who a user album is shared with. The owner and
+// the users it is shared with may see the list.
+func (s *photoService) ListAlbumShares(ctx context.Context, userID, albumID uuid.UUID) ([]AlbumShare, error) {
+	album, _, err := s.visibleAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if album.Kind == AlbumKindFolder {
+		return nil, ErrFolderAlbum
+	}
+	return s.repo.ListAlbumShares(ctx, albumID)
+}
+
+// ShareAlbum shares a user album with another user, or changes whether
+// they may contribute. Only the owner may share; the caller checks that
+// the target can access the album's library.
+func (s *photoService) ShareAlbum(ctx context.Context, userID, albumID, targetID uuid.UUID, canContribute bool) (*AlbumShare, error) {
+	album, err := s.ownedAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if targetID == uuid.Nil || album.IsOwner(targetID) {
+		return nil, ErrInvalidShare
+	}
+	return s.repo.UpsertAlbumShare(ctx, albumID, targetID, canContribute)
+}
+
+// UnshareAlbum removes a share. The owner may remove any share; users may
+// leave albums shared with them.
+func (s *photoService) UnshareAlbum(ctx context.Context, userID, albumID, targetID uuid.UUID) error {
+	album, _, err := s.visibleAlbum(ctx, userID, albumID)
+	if err != nil {
+		return err
+	}
+	if album.Kind == AlbumKindFolder {
+		return ErrFolderAlbum
+	}
+	if !album.IsOwner(userID) && userID != targetID {
+		return ErrNotAlbumOwner
+	}
+	removed, err := s.repo.DeleteAlbumShare(ctx, albumID, targetID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrShareNotFound
+	}
+	return nil
+}
+
+// visibleAlbum returns an album the user may see and, for shared user
+// albums, the user's share.
+func (s *photoService) visibleAlbum(ctx context.Context, userID, albumID uuid.UUID) (*Album, *AlbumShare, error) {
+	album, err := s.repo.GetAlbum(ctx, albumID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if album.Kind == AlbumKindFolder || album.IsOwner(userID) {
+		return album, nil, nil
+	}
+	share, err := s.repo.GetAlbumShare(ctx, albumID, userID)
+	if errors.Is(err, ErrShareNotFound) {
+		return nil, nil, ErrAlbumNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return album, share, nil
+}
+
+// ownedAlbum returns a user album owned by the user.
+func (s *photoService) ownedAlbum(ctx context.Context, userID, albumID uuid.UUID) (*Album, error) {
+	album, _, err := s.visibleAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if album.Kind == AlbumKindFolder {
+		return nil, ErrFolderAlbum
+	}
+	if !album.IsOwner(userID) {
+		return nil, ErrNotAlbumOwner
+	}
+	return album, nil
+}
+
+// contributableAlbum returns a user album whose photos the user may change.
+func (s *photoService) contributableAlbum(ctx context.Context, userID, albumID uuid.UUID) (*Album, error) {
+	album, share, err := s.visibleAlbum(ctx, userID, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if album.Kind == AlbumKindFolder {
+		return nil, ErrFolderAlbum
+	}
+	if !album.IsOwner(userID) && (share == nil || !share.CanContribute) {
+		return nil, ErrNotContributor
+	}
+	return album, nil
+}
+
+// checkLibraryPhotos verifies that all photos exist in the library.
+func (s *photoService) checkLibraryPhotos(ctx context.Context, libraryID uuid.UUID, photoIDs []uuid.UUID) error {
+	n, err := s.repo.CountPhotosInLibrary(ctx, libraryID, photoIDs)
+	if err != nil {
+		return err
+	}
+	if n != int64(len(photoIDs)) {
+		return ErrPhotoNotInLibrary
+	}
+	return nil
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+// ImportPhoto stores a scanned photo and files it under the folder album of
+// its directory. Photos directly in the library root get no folder album.
+func (s *photoService) ImportPhoto(ctx context.Context, scanned *ScannedPhoto, root string) (*Photo, error) {
+	folder := filepath.Dir(scanned.FilePath)
+	photo, err := s.repo.UpsertPhoto(ctx, UpsertPhotoParams{
+		LibraryID:       scanned.LibraryID,
+		FilePath:        scanned.FilePath,
+		FileSize:        scanned.FileSize,
+		Folder:          folder,
+		Kind:            scanned.Kind,
+		Format:          scanned.Format,
+		TakenAt:         scanned.TakenAt,
+		TakenAtSource:   scanned.TakenAtSource,
+		Width:           util.SafeIntToInt32(scanned.Width),
+		Height:          util.SafeIntToInt32(scanned.Height),
+		Orientation:     util.SafeIntToInt32(max(scanned.Orientation, 1)),
+		CameraMake:      optionalString(&scanned.CameraMake),
+		CameraModel:     optionalString(&scanned.CameraModel),
+		LensModel:       optionalString(&scanned.LensModel),
+		ExposureTime:    optionalString(&scanned.ExposureTime),
+		FNumber:         scanned.FNumber,
+		ISO:             optionalInt32(scanned.ISO),
+		FocalLength:     scanned.FocalLength,
+		Latitude:        scanned.Latitude,
+		Longitude:       scanned.Longitude,
+		Altitude:        scanned.Altitude,
+		DurationSeconds: scanned.DurationSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	title, ok := folderAlbumTitle(root, folder)
+	if !ok {
+		return photo, nil
+	}
+	album, err := s.repo.UpsertFolderAlbum(ctx, scanned.LibraryID, folder, title)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.AddFolderAlbumPhoto(ctx, album.ID, photo.ID); err != nil {
+		return nil, fmt.Errorf("failed to add photo to folder album: %w", err)
+	}
+	return photo, nil
+}
+
+// GeneratePreview renders the small thumbnail into the image cache and
+// stores the photo's placeholder.
+func (s *photoService) GeneratePreview(ctx context.Context, photo *Photo) error {
+	thumb, err := s.renderThumbnail(ctx, photo, ThumbnailSmall)
+	if errors.Is(err, ErrNoThumbnail) || errors.Is(err, ErrThumbnailsDisabled) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	placeholder, err := s.placeholder(thumb.Data)
+	if err != nil {
+		return fmt.Errorf("compute placeholder of %s: %w", photo.FilePath, err)
+	}
+	return s.repo.SetPhotoPlaceholder(ctx, photo.ID, placeholder.BlurHash, placeholder.Color)
+}
+
+// RemoveMissing deletes the photos of a library under the given roots whose
+// file was not found by the last scan. It returns the number of photos
+// removed.
+func (s *photoService) RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error) {
+	paths, err := s.repo.ListPhotoPaths(ctx, libraryID)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for path, id := range paths {
+		if present[path] || !underAny(path, roots) {
+			continue
+		}
+		if err := s.repo.DeletePhoto(ctx, id); err != nil {
+			return removed, fmt.Errorf("failed to delete photo %s: %w", path, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes folder albums left without photos.
+func (s *photoService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteEmptyFolderAlbums(ctx); err != nil {
+		return fmt.Errorf("failed to delete empty folder albums: %w", err)
+	}
+	return nil
+}
+
+// folderAlbumTitle names the folder album of a directory after its path
+// below the library root, e.g. "2024/Summer".
+func folderAlbumTitle(root, folder string) (string, bool) {
+	if root == "" {
+		return filepath.Base(folder), folder != "" && folder != "." && folder != string(filepath.Separator)
+	}
+	rel, err := filepath.Rel(root, folder)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+func underAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := strings.TrimSpace(*s)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func optionalInt32(n int) *int32 {
+	if n <= 0 {
+		return nil
+	}
+	v := util.SafeIntToInt32(n)
+	return &v
+}
@@ -0,0 +1,550 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Photo operations
+func (m *MockRepository) GetPhoto(ctx context.Context, id uuid.UUID) (*Photo, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Photo), args.Error(1)
+}
+
+func (m *MockRepository) GetPhotoByPath(ctx context.Context, filePath string) (*Photo, error) {
+	args := m.Called(ctx, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Photo), args.Error(1)
+}
+
+func (m *MockRepository) UpsertPhoto(ctx context.Context, params UpsertPhotoParams) (*Photo, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Photo), args.Error(1)
+}
+
+func (m *MockRepository) SetPhotoPlaceholder(ctx context.Context, id uuid.UUID, blurHash, dominantColor string) error {
+	return m.Called(ctx, id, blurHash, dominantColor).Error(0)
+}
+
+func (m *MockRepository) ListPhotos(ctx context.Context, filters PhotoListFilters) ([]Photo, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Photo), args.Error(1)
+}
+
+func (m *MockRepository) CountPhotos(ctx context.Context, filters PhotoListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListTimeline(ctx context.Context, filters TimelineFilters) ([]TimelineBucket, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]TimelineBucket), args.Error(1)
+}
+
+func (m *MockRepository) ListPhotoPaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	args := m.Called(ctx, libraryID)
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) CountPhotosInLibrary(ctx context.Context, libraryID uuid.UUID, photoIDs []uuid.UUID) (int64, error) {
+	args := m.Called(ctx, libraryID, photoIDs)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeletePhoto(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// Album operations
+func (m *MockRepository) GetAlbum(ctx context.Context, id uuid.UUID) (*Album, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Album), args.Error(1)
+}
+
+func (m *MockRepository) UpsertFolderAlbum(ctx context.Context, libraryID uuid.UUID, folderPath, title string) (*Album, error) {
+	args := m.Called(ctx, libraryID, folderPath, title)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Album), args.Error(1)
+}
+
+func (m *MockRepository) CreateAlbum(ctx context.Context, params CreateAlbumParams) (*Album, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Album), args.Error(1)
+}
+
+func (m *MockRepository) UpdateAlbum(ctx context.Context, id uuid.UUID, title string, description *string, coverPhotoID *uuid.UUID) error {
+	return m.Called(ctx, id, title, description, coverPhotoID).Error(0)
+}
+
+func (m *MockRepository) DeleteAlbum(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockRepository) ListAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) ([]Album, error) {
+	args := m.Called(ctx, userID, filters)
+	return args.Get(0).([]Album), args.Error(1)
+}
+
+func (m *MockRepository) CountAlbums(ctx context.Context, userID uuid.UUID, filters AlbumListFilters) (int64, error) {
+	args := m.Called(ctx, userID, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteEmptyFolderAlbums(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Album photos
+func (m *MockRepository) ListAlbumPhotos(ctx context.Context, albumID uuid.UUID, limit, offset int32) ([]Photo, error) {
+	args := m.Called(ctx, albumID, limit, offset)
+	return args.Get(0).([]Photo), args.Error(1)
+}
+
+func (m *MockRepository) AddAlbumPhotos(ctx context.Context, albumID uuid.UUID, photoIDs []uuid.UUID, addedBy uuid.UUID) (int64, error) {
+	args := m.Called(ctx, albumID, photoIDs, addedBy)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) AddFolderAlbumPhoto(ctx context.Context, albumID, photoID uuid.UUID) error {
+	return m.Called(ctx, albumID, photoID).Error(0)
+}
+
+func (m *MockRepository) RemoveAlbumPhoto(ctx context.Context, albumID, photoID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, albumID, photoID)
+	return args.Bool(0), args.Error(1)
+}
+
+// Album shares
+func (m *MockRepository) ListAlbumShares(ctx context.Context, albumID uuid.UUID) ([]AlbumShare, error) {
+	args := m.Called(ctx, albumID)
+	return args.Get(0).([]AlbumShare), args.Error(1)
+}
+
+func (m *MockRepository) GetAlbumShare(ctx context.Context, albumID, userID uuid.UUID) (*AlbumShare, error) {
+	args := m.Called(ctx, albumID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AlbumShare), args.Error(1)
+}
+
+func (m *MockRepository) UpsertAlbumShare(ctx context.Context, albumID, userID uuid.UUID, canContribute bool) (*AlbumShare, error) {
+	args := m.Called(ctx, albumID, userID, canContribute)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AlbumShare), args.Error(1)
+}
+
+func (m *MockRepository) DeleteAlbumShare(ctx context.Context, albumID, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, albumID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// newTestService returns a service whose thumbnails are the file path and
+// size, so tests can check what was rendered.
+func newTestService(repo *MockRepository) *photoService {
+	return &photoService{
+		repo: repo,
+		thumbnail: func(_ context.Context, filePath, size string) ([]byte, string, error) {
+			return []byte(filePath + "@" + size), "image/jpeg", nil
+		},
+		placeholder: func(data []byte) (*blurhash.Placeholder, error) {
+			return &blurhash.Placeholder{BlurHash: "LEHV6nWB2yk8", Color: "#336699"}, nil
+		},
+	}
+}
+
+func userAlbum(owner uuid.UUID) *Album {
+	return &Album{
+		ID:        uuid.Must(uuid.NewV7()),
+		LibraryID: uuid.Must(uuid.NewV7()),
+		Kind:      AlbumKindUser,
+		Title:     "Holidays",
+		OwnerID:   &owner,
+	}
+}
+
+func TestService_GetTimeline_DefaultsToDays(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	buckets := []TimelineBucket{{Date: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), Count: 3}}
+	repo.On("ListTimeline", ctx, TimelineFilters{Granularity: GranularityDay, Limit: 10}).Return(buckets, nil)
+
+	got, err := svc.GetTimeline(ctx, TimelineFilters{Granularity: "week", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, buckets, got)
+	repo.AssertExpectations(t)
+}
+
+func TestService_GetThumbnail(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	img := &Photo{ID: uuid.Must(uuid.NewV7()), Kind: KindImage, FilePath: "/photos/a.heic"}
+	clip := &Photo{ID: uuid.Must(uuid.NewV7()), Kind: KindVideo, FilePath: "/photos/a.mov"}
+	repo.On("GetPhoto", ctx, img.ID).Return(img, nil)
+	repo.On("GetPhoto", ctx, clip.ID).Return(clip, nil)
+
+	thumb, err := svc.GetThumbnail(ctx, img.ID, ThumbnailMedium)
+	require.NoError(t, err)
+	assert.Equal(t, "/photos/a.heic@w720", string(thumb.Data))
+	assert.Equal(t, "image/jpeg", thumb.ContentType)
+
+	_, err = svc.GetThumbnail(ctx, img.ID, "w342")
+	assert.ErrorIs(t, err, ErrInvalidThumbnail)
+
+	_, err = svc.GetThumbnail(ctx, clip.ID, ThumbnailSmall)
+	assert.ErrorIs(t, err, ErrNoThumbnail)
+
+	svc.thumbnail = nil
+	_, err = svc.GetThumbnail(ctx, img.ID, ThumbnailSmall)
+	assert.ErrorIs(t, err, ErrThumbnailsDisabled)
+}
+
+func TestService_GetAlbum_Visibility(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	owner := uuid.Must(uuid.NewV7())
+	guest := uuid.Must(uuid.NewV7())
+	stranger := uuid.Must(uuid.NewV7())
+	album := userAlbum(owner)
+	folder := &Album{ID: uuid.Must(uuid.NewV7()), Kind: AlbumKindFolder, Title: "2024/Summer"}
+
+	repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+	repo.On("GetAlbum", ctx, folder.ID).Return(folder, nil)
+	repo.On("GetAlbumShare", ctx, album.ID, guest).Return(&AlbumShare{AlbumID: album.ID, UserID: guest}, nil)
+	repo.On("GetAlbumShare", ctx, album.ID, stranger).Return(nil, ErrShareNotFound)
+
+	got, err := svc.GetAlbum(ctx, owner, album.ID)
+	require.NoError(t, err)
+	assert.Equal(t, album, got)
+
+	_, err = svc.GetAlbum(ctx, guest, album.ID)
+	assert.NoError(t, err, "shared albums are visible")
+
+	_, err = svc.GetAlbum(ctx, stranger, album.ID)
+	assert.ErrorIs(t, err, ErrAlbumNotFound, "private albums are hidden")
+
+	_, err = svc.GetAlbum(ctx, stranger, folder.ID)
+	assert.NoError(t, err, "folder albums are visible to everyone")
+}
+
+func TestService_CreateAlbum(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	owner := uuid.Must(uuid.NewV7())
+	libraryID := uuid.Must(uuid.NewV7())
+	blank := "  "
+	created := userAlbum(owner)
+	repo.On("CreateAlbum", ctx, CreateAlbumParams{LibraryID: libraryID, OwnerID: owner, Title: "Holidays"}).Return(created, nil)
+
+	got, err := svc.CreateAlbum(ctx, CreateAlbumParams{LibraryID: libraryID, OwnerID: owner, Title: " Holidays ", Description: &blank})
+	require.NoError(t, err)
+	assert.Equal(t, created, got)
+
+	_, err = svc.CreateAlbum(ctx, CreateAlbumParams{LibraryID: libraryID, OwnerID: owner, Title: " "})
+	assert.ErrorIs(t, err, ErrInvalidAlbum)
+}
+
+func TestService_UpdateAlbum(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.Must(uuid.NewV7())
+
+	t.Run("owner sets the cover", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+		cover := uuid.Must(uuid.NewV7())
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("CountPhotosInLibrary", ctx, album.LibraryID, []uuid.UUID{cover}).Return(int64(1), nil)
+		repo.On("UpdateAlbum", ctx, album.ID, "Holidays", (*string)(nil), &cover).Return(nil)
+
+		_, err := svc.UpdateAlbum(ctx, owner, album.ID, UpdateAlbumParams{CoverPhotoID: &cover})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("cover from another library", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+		cover := uuid.Must(uuid.NewV7())
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("CountPhotosInLibrary", ctx, album.LibraryID, []uuid.UUID{cover}).Return(int64(0), nil)
+
+		_, err := svc.UpdateAlbum(ctx, owner, album.ID, UpdateAlbumParams{CoverPhotoID: &cover})
+		assert.ErrorIs(t, err, ErrPhotoNotInLibrary)
+	})
+
+	t.Run("contributors cannot edit", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+		guest := uuid.Must(uuid.NewV7())
+		title := "Mine now"
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("GetAlbumShare", ctx, album.ID, guest).Return(&AlbumShare{CanContribute: true}, nil)
+
+		_, err := svc.UpdateAlbum(ctx, guest, album.ID, UpdateAlbumParams{Title: &title})
+		assert.ErrorIs(t, err, ErrNotAlbumOwner)
+	})
+
+	t.Run("folder albums are read-only", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		folder := &Album{ID: uuid.Must(uuid.NewV7()), Kind: AlbumKindFolder}
+
+		repo.On("GetAlbum", ctx, folder.ID).Return(folder, nil)
+
+		err := svc.DeleteAlbum(ctx, owner, folder.ID)
+		assert.ErrorIs(t, err, ErrFolderAlbum)
+	})
+}
+
+func TestService_AddAlbumPhotos(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.Must(uuid.NewV7())
+	a, b := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+
+	t.Run("deduplicates and checks the library", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("CountPhotosInLibrary", ctx, album.LibraryID, []uuid.UUID{a, b}).Return(int64(2), nil)
+		repo.On("AddAlbumPhotos", ctx, album.ID, []uuid.UUID{a, b}, owner).Return(int64(2), nil)
+
+		added, err := svc.AddAlbumPhotos(ctx, owner, album.ID, []uuid.UUID{a, b, a})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), added)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("photos of other libraries", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("CountPhotosInLibrary", ctx, album.LibraryID, []uuid.UUID{a, b}).Return(int64(1), nil)
+
+		_, err := svc.AddAlbumPhotos(ctx, owner, album.ID, []uuid.UUID{a, b})
+		assert.ErrorIs(t, err, ErrPhotoNotInLibrary)
+		repo.AssertNotCalled(t, "AddAlbumPhotos", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("contributor", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+		guest := uuid.Must(uuid.NewV7())
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("GetAlbumShare", ctx, album.ID, guest).Return(&AlbumShare{CanContribute: true}, nil)
+		repo.On("CountPhotosInLibrary", ctx, album.LibraryID, []uuid.UUID{a}).Return(int64(1), nil)
+		repo.On("AddAlbumPhotos", ctx, album.ID, []uuid.UUID{a}, guest).Return(int64(1), nil)
+
+		_, err := svc.AddAlbumPhotos(ctx, guest, album.ID, []uuid.UUID{a})
+		require.NoError(t, err)
+	})
+
+	t.Run("viewer", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		album := userAlbum(owner)
+		guest := uuid.Must(uuid.NewV7())
+
+		repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+		repo.On("GetAlbumShare", ctx, album.ID, guest).Return(&AlbumShare{CanContribute: false}, nil)
+
+		_, err := svc.AddAlbumPhotos(ctx, guest, album.ID, []uuid.UUID{a})
+		assert.ErrorIs(t, err, ErrNotContributor)
+	})
+}
+
+func TestService_ShareAlbum(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	owner := uuid.Must(uuid.NewV7())
+	guest := uuid.Must(uuid.NewV7())
+	album := userAlbum(owner)
+	share := &AlbumShare{AlbumID: album.ID, UserID: guest, CanContribute: true}
+
+	repo.On("GetAlbum", ctx, album.ID).Return(album, nil)
+	repo.On("UpsertAlbumShare", ctx, album.ID, guest, true).Return(share, nil)
+	repo.On("GetAlbumShare", ctx, album.ID, guest).Return(share, nil)
+	repo.On("DeleteAlbumShare", ctx, album.ID, guest).Return(true, nil)
+
+	got, err := svc.ShareAlbum(ctx, owner, album.ID, guest, true)
+	require.NoError(t, err)
+	assert.Equal(t, share, got)
+
+	_, err = svc.ShareAlbum(ctx, owner, album.ID, owner, false)
+	assert.ErrorIs(t, err, ErrInvalidShare, "owners cannot share with themselves")
+
+	_, err = svc.ShareAlbum(ctx, guest, album.ID, uuid.Must(uuid.NewV7()), false)
+	assert.ErrorIs(t, err, ErrNotAlbumOwner, "only the owner shares")
+
+	assert.NoError(t, svc.UnshareAlbum(ctx, guest, album.ID, guest), "guests may leave")
+}
+
+func TestService_ImportPhoto(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	root := filepath.FromSlash("/photos")
+	libraryID := uuid.Must(uuid.NewV7())
+	takenAt := time.Date(2024, 6, 30, 12, 5, 9, 0, time.UTC)
+	scanned := &ScannedPhoto{
+		LibraryID:     libraryID,
+		FilePath:      filepath.FromSlash("/photos/2024/Summer/IMG_0001.HEIC"),
+		FileSize:      2048,
+		Kind:          KindImage,
+		Format:        "heif",
+		TakenAt:       takenAt,
+		TakenAtSource: TakenAtSourceExif,
+		Width:         3024,
+		Height:        4032,
+		CameraMake:    "Apple",
+		ISO:           80,
+	}
+	photo := &Photo{ID: uuid.Must(uuid.NewV7()), LibraryID: libraryID, FilePath: scanned.FilePath, Kind: KindImage}
+	album := &Album{ID: uuid.Must(uuid.NewV7()), Kind: AlbumKindFolder}
+	folder := filepath.FromSlash("/photos/2024/Summer")
+
+	repo.On("UpsertPhoto", ctx, mock.MatchedBy(func(p UpsertPhotoParams) bool {
+		return p.Folder == folder && p.Orientation == 1 && *p.CameraMake == "Apple" &&
+			p.CameraModel == nil && *p.ISO == 80 && p.TakenAt.Equal(takenAt)
+	})).Return(photo, nil)
+	repo.On("UpsertFolderAlbum", ctx, libraryID, folder, "2024/Summer").Return(album, nil)
+	repo.On("AddFolderAlbumPhoto", ctx, album.ID, photo.ID).Return(nil)
+
+	got, err := svc.ImportPhoto(ctx, scanned, root)
+	require.NoError(t, err)
+	assert.Equal(t, photo, got)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ImportPhoto_LibraryRoot(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	photo := &Photo{ID: uuid.Must(uuid.NewV7())}
+	repo.On("UpsertPhoto", ctx, mock.Anything).Return(photo, nil)
+
+	_, err := svc.ImportPhoto(ctx, &ScannedPhoto{FilePath: filepath.FromSlash("/photos/IMG_0001.jpg"), Kind: KindImage}, filepath.FromSlash("/photos"))
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "UpsertFolderAlbum", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_GeneratePreview(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	photo := &Photo{ID: uuid.Must(uuid.NewV7()), Kind: KindImage, FilePath: "/photos/a.jpg"}
+	var rendered []byte
+	svc.placeholder = func(data []byte) (*blurhash.Placeholder, error) {
+		rendered = data
+		return &blurhash.Placeholder{BlurHash: "LEHV6nWB2yk8", Color: "#336699"}, nil
+	}
+	repo.On("SetPhotoPlaceholder", ctx, photo.ID, "LEHV6nWB2yk8", "#336699").Return(nil)
+
+	require.NoError(t, svc.GeneratePreview(ctx, photo))
+	assert.Equal(t, "/photos/a.jpg@"+ThumbnailSmall, string(rendered), "the placeholder is computed from the small thumbnail")
+	repo.AssertExpectations(t)
+
+	assert.NoError(t, svc.GeneratePreview(ctx, &Photo{Kind: KindVideo}), "clips have no preview")
+
+	svc.thumbnail = func(context.Context, string, string) ([]byte, string, error) {
+		return nil, "", errors.New("vips: unsupported format")
+	}
+	assert.Error(t, svc.GeneratePreview(ctx, photo))
+}
+
+func TestService_RemoveMissing(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := context.Background()
+
+	libraryID := uuid.Must(uuid.NewV7())
+	kept, gone, elsewhere := uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7()), uuid.Must(uuid.NewV7())
+	repo.On("ListPhotoPaths", ctx, libraryID).Return(map[string]uuid.UUID{
+		"/photos/a.jpg":   kept,
+		"/photos/b.jpg":   gone,
+		"/old-root/c.jpg": elsewhere,
+	}, nil)
+	repo.On("DeletePhoto", ctx, gone).Return(nil)
+
+	removed, err := svc.RemoveMissing(ctx, libraryID, []string{"/photos"}, map[string]bool{"/photos/a.jpg": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	repo.AssertExpectations(t)
+}
+
+func TestFolderAlbumTitle(t *testing.T) {
+	tests := []struct {
+		root, folder string
+		want         string
+		ok           bool
+	}{
+		{"/photos", "/photos/2024", "2024", true},
+		{"/photos", "/photos/2024/Summer", "2024/Summer", true},
+		{"/photos", "/photos", "", false},
+		{"/photos", "/elsewhere", "", false},
+		{"", "/photos/Trip", "Trip", true},
+	}
+	for _, tt := range tests {
+		got, ok := folderAlbumTitle(filepath.FromSlash(tt.root), filepath.FromSlash(tt.folder))
+		assert.Equal(t, tt.ok, ok, tt.folder)
+		assert.Equal(t, tt.want, got, tt.folder)
+	}
+}
@@ -0,0 +1,226 @@
+// Package photo provides types and services for photo libraries. Images
+// and short camera clips are scanned with their EXIF capture metadata,
+// browsed on a timeline grouped by capture date, and organized into albums:
+// folder albums mirror the library's directories, user albums are created
+// by users and shared with others who can access the library.
+package photo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/infra/image"
+)
+
+// Kind tells photos and video clips apart.
+type Kind string
+
+const (
+	KindImage Kind = "image"
+	KindVideo Kind = "video"
+)
+
+// Valid reports whether k is a known kind.
+func (k Kind) Valid() bool {
+	return k == KindImage || k == KindVideo
+}
+
+// Sources of the capture time.
+const (
+	TakenAtSourceExif     = "exif"     // EXIF or container creation time
+	TakenAtSourceFilename = "filename" // date in the file name, e.g. IMG_20240630_140509
+	TakenAtSourceFile     = "file"     // file modification time
+)
+
+// AlbumKind tells folder albums and user albums apart.
+type AlbumKind string
+
+const (
+	// AlbumKindFolder albums mirror a library directory and are maintained
+	// by the library scan.
+	AlbumKindFolder AlbumKind = "folder"
+	// AlbumKindUser albums are created by a user and may be shared.
+	AlbumKindUser AlbumKind = "user"
+)
+
+// Valid reports whether k is a known album kind.
+func (k AlbumKind) Valid() bool {
+	return k == AlbumKindFolder || k == AlbumKindUser
+}
+
+// Granularity is the grouping of the timeline.
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "day"
+	GranularityMonth Granularity = "month"
+)
+
+// Valid reports whether g is a known granularity.
+func (g Granularity) Valid() bool {
+	return g == GranularityDay || g == GranularityMonth
+}
+
+// Thumbnail sizes, bounding the width. The small size is rendered during
+// the library scan for timeline grids.
+const (
+	ThumbnailSmall  = image.SizePhotoSmall
+	ThumbnailMedium = image.SizePhotoMedium
+	ThumbnailLarge  = image.SizePhotoLarge
+)
+
+// ValidThumbnailSize reports whether size is a thumbnail size.
+func ValidThumbnailSize(size string) bool {
+	switch size {
+	case ThumbnailSmall, ThumbnailMedium, ThumbnailLarge:
+		return true
+	}
+	return false
+}
+
+// Photo is an image or video clip in a photo library.
+type Photo struct {
+	ID            uuid.UUID
+	LibraryID     uuid.UUID
+	FilePath      string
+	FileSize      int64
+	Folder        string
+	Kind          Kind
+	Format        string
+	TakenAt       time.Time
+	TakenAtSource string
+
+	// Width and Height are the displayed dimensions, with the orientation
+	// applied.
+	Width       int32
+	Height      int32
+	Orientation int32
+
+	CameraMake   *string
+	CameraModel  *string
+	LensModel    *string
+	ExposureTime *string
+	FNumber      *float64
+	ISO          *int32
+	FocalLength  *float64
+
+	Latitude  *float64
+	Longitude *float64
+	Altitude  *float64
+
+	DurationSeconds float64
+
+	BlurHash      *string
+	DominantColor *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// HasLocation reports whether the photo records where it was taken.
+func (p *Photo) HasLocation() bool {
+	return p.Latitude != nil && p.Longitude != nil
+}
+
+// Album is a folder or user album.
+type Album struct {
+	ID          uuid.UUID
+	LibraryID   uuid.UUID
+	Kind        AlbumKind
+	Title       string
+	Description *string
+	FolderPath  *string
+	OwnerID     *uuid.UUID
+
+	// CoverPhotoID is the explicit cover, else the first photo; nil for
+	// empty albums.
+	CoverPhotoID *uuid.UUID
+	PhotoCount   int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsOwner reports whether userID owns the album. Folder albums have no
+// owner.
+func (a *Album) IsOwner(userID uuid.UUID) bool {
+	return a.OwnerID != nil && *a.OwnerID == userID
+}
+
+// AlbumShare grants a user access to a user album.
+type AlbumShare struct {
+	AlbumID       uuid.UUID
+	UserID        uuid.UUID
+	CanContribute bool
+	CreatedAt     time.Time
+}
+
+// TimelineBucket is a day or month of the timeline.
+type TimelineBucket struct {
+	Date         time.Time
+	Count        int64
+	CoverPhotoID uuid.UUID
+}
+
+// PhotoListFilters contains filters for listing photos.
+type PhotoListFilters struct {
+	// LibraryIDs restricts the photos to these libraries when non-nil,
+	// the libraries the user may access.
+	LibraryIDs  []uuid.UUID
+	LibraryID   *uuid.UUID
+	Kind        Kind // empty lists images and clips
+	TakenAfter  *time.Time
+	TakenBefore *time.Time
+	OldestFirst bool
+	Limit       int32
+	Offset      int32
+}
+
+// TimelineFilters contains filters for the timeline.
+type TimelineFilters struct {
+	LibraryIDs  []uuid.UUID // nil means unrestricted
+	LibraryID   *uuid.UUID
+	Kind        Kind
+	Granularity Granularity
+	Limit       int32
+	Offset      int32
+}
+
+// AlbumListFilters contains filters for listing albums.
+type AlbumListFilters struct {
+	LibraryIDs []uuid.UUID // nil means unrestricted
+	LibraryID  *uuid.UUID
+	Kind       AlbumKind // empty lists folder and user albums
+	Limit      int32
+	Offset     int32
+}
+
+// ScannedPhoto is a photo or clip with the metadata read by the library
+// scanner.
+type ScannedPhoto struct {
+	LibraryID     uuid.UUID
+	FilePath      string
+	FileSize      int64
+	Kind          Kind
+	Format        string
+	TakenAt       time.Time
+	TakenAtSource string
+	Width         int
+	Height        int
+	Orientation   int
+
+	CameraMake   string
+	CameraModel  string
+	LensModel    string
+	ExposureTime string
+	FNumber      *float64
+	ISO          int
+	FocalLength  *float64
+
+	Latitude  *float64
+	Longitude *float64
+	Altitude  *float64
+
+	DurationSeconds float64
+}
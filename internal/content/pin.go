@@ -0,0 +1,35 @@
+package content
+
+import "time"
+
+// PIN rules shared by the parental controls override PIN and the QAR PIN.
+const (
+	// MinPINLength and MaxPINLength bound the digits of a PIN.
+	MinPINLength = 4
+	MaxPINLength = 8
+	// MaxPINAttempts is the number of consecutive wrong PINs after which
+	// PIN entry is locked for PINLockoutDuration.
+	MaxPINAttempts = 5
+	// PINLockoutDuration is how long PIN entry stays locked.
+	PINLockoutDuration = 15 * time.Minute
+)
+
+// ValidPIN reports whether pin is a string of allowed length made of ASCII
+// digits.
+func ValidPIN(pin string) bool {
+	if len(pin) < MinPINLength || len(pin) > MaxPINLength {
+		return false
+	}
+	for _, r := range pin {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// PINLockedOut reports whether PIN entry locked until lockedUntil is still
+// locked at now.
+func PINLockedOut(lockedUntil *time.Time, now time.Time) bool {
+	return lockedUntil != nil && now.Before(*lockedUntil)
+}
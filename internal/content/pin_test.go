@@ -0,0 +1,30 @@
+package content
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidPIN(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ValidPIN("1234"))
+	assert.True(t, ValidPIN("12345678"))
+	assert.False(t, ValidPIN("123"))
+	assert.False(t, ValidPIN("123456789"))
+	assert.False(t, ValidPIN("12ab"))
+	// Non-ASCII digits such as Arabic-Indic or fullwidth ones are rejected.
+	assert.False(t, ValidPIN("١٢٣٤"))
+	assert.False(t, ValidPIN("１２３４"))
+}
+
+func TestPINLockedOut(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	assert.False(t, PINLockedOut(nil, now))
+	assert.True(t, PINLockedOut(new(now.Add(time.Minute)), now))
+	assert.False(t, PINLockedOut(new(now.Add(-time.Minute)), now))
+}
@@ -0,0 +1,37 @@
+package content
+
+import (
+	"encoding/json"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// ImagePlaceholders holds the placeholders of an item's images keyed by
+// image path. Only images the item currently uses are kept.
+type ImagePlaceholders map[string]blurhash.Placeholder
+
+// For returns the placeholder of the image at path, or nil when none has
+// been computed yet.
+func (p ImagePlaceholders) For(path *string) *blurhash.Placeholder {
+	if path == nil || *path == "" {
+		return nil
+	}
+	ph, ok := p[*path]
+	if !ok {
+		return nil
+	}
+	return &ph
+}
+
+// ParseImagePlaceholders decodes stored placeholders. Invalid data yields
+// no placeholders.
+func ParseImagePlaceholders(data []byte) ImagePlaceholders {
+	if len(data) == 0 {
+		return nil
+	}
+	var p ImagePlaceholders
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil
+	}
+	return p
+}
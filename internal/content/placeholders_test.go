@@ -0,0 +1,24 @@
+package content
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+func TestImagePlaceholders(t *testing.T) {
+	t.Parallel()
+
+	placeholders := ParseImagePlaceholders([]byte(`{"/poster.jpg": {"blurhash": "LEHV6nWB2yk8pyo0adR*.7kCMdnj", "color": "#1a2b3c"}}`))
+	ph := placeholders.For(new("/poster.jpg"))
+	require.NotNil(t, ph)
+	assert.Equal(t, blurhash.Placeholder{BlurHash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj", Color: "#1a2b3c"}, *ph)
+
+	assert.Nil(t, placeholders.For(new("/other.jpg")))
+	assert.Nil(t, placeholders.For(nil))
+	assert.Nil(t, ParseImagePlaceholders([]byte(`not json`)))
+	assert.Nil(t, ParseImagePlaceholders(nil).For(new("/poster.jpg")))
+}
@@ -0,0 +1,658 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: episodes.sql
+
+package podcastdb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const clearEpisodeDownload = `-- name: ClearEpisodeDownload :exec
+UPDATE podcast.episodes
+SET
+    file_path = NULL,
+    file_size = 0,
+    transcript_path = NULL,
+    transcript_type = NULL,
+    downloaded_at = NULL,
+    pinned = FALSE
+WHERE
+    id = $1
+`
+
+func (q *Queries) ClearEpisodeDownload(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, clearEpisodeDownload, id)
+	return err
+}
+
+const countEpisodes = `-- name: CountEpisodes :one
+SELECT COUNT(*) FROM podcast.episodes WHERE podcast_id = $1
+`
+
+func (q *Queries) CountEpisodes(ctx context.Context, podcastID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countEpisodes, podcastID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getEpisode = `-- name: GetEpisode :one
+SELECT id, podcast_id, guid, title, description, link, image_url, published_at, duration_seconds, season, episode_number, episode_type, explicit, enclosure_url, enclosure_type, enclosure_length, chapters_url, chapters, transcripts, transcript_path, transcript_type, file_path, file_size, downloaded_at, download_error, pinned, created_at, updated_at FROM podcast.episodes WHERE id = $1
+`
+
+func (q *Queries) GetEpisode(ctx context.Context, id uuid.UUID) (PodcastEpisode, error) {
+	row := q.db.QueryRow(ctx, getEpisode, id)
+	var i PodcastEpisode
+	err := row.Scan(
+		&i.ID,
+		&i.PodcastID,
+		&i.Guid,
+		&i.Title,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.PublishedAt,
+		&i.DurationSeconds,
+		&i.Season,
+		&i.EpisodeNumber,
+		&i.EpisodeType,
+		&i.Explicit,
+		&i.EnclosureUrl,
+		&i.EnclosureType,
+		&i.EnclosureLength,
+		&i.ChaptersUrl,
+		&i.Chapters,
+		&i.Transcripts,
+		&i.TranscriptPath,
+		&i.TranscriptType,
+		&i.FilePath,
+		&i.FileSize,
+		&i.DownloadedAt,
+		&i.DownloadError,
+		&i.Pinned,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listDownloadedEpisodes = `-- name: ListDownloadedEpisodes :many
+SELECT id, podcast_id, guid, title, description, link, image_url, published_at, duration_seconds, season, episode_number, episode_type, explicit, enclosure_url, enclosure_type, enclosure_length, chapters_url, chapters, transcripts, transcript_path, transcript_type, file_path, file_size, downloaded_at, download_error, pinned, created_at, updated_at
+FROM podcast.episodes
+WHERE
+    podcast_id = $1
+    AND file_path IS NOT NULL
+ORDER BY published_at DESC NULLS LAST, created_at DESC
+`
+
+func (q *Queries) ListDownloadedEpisodes(ctx context.Context, podcastID uuid.UUID) ([]PodcastEpisode, error) {
+	rows, err := q.db.Query(ctx, listDownloadedEpisodes, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PodcastEpisode{}
+	for rows.Next() {
+		var i PodcastEpisode
+		if err := rows.Scan(
+			&i.ID,
+			&i.PodcastID,
+			&i.Guid,
+			&i.Title,
+			&i.Description,
+			&i.Link,
+			&i.ImageUrl,
+			&i.PublishedAt,
+			&i.DurationSeconds,
+			&i.Season,
+			&i.EpisodeNumber,
+			&i.EpisodeType,
+			&i.Explicit,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
+			&i.ChaptersUrl,
+			&i.Chapters,
+			&i.Transcripts,
+			&i.TranscriptPath,
+			&i.TranscriptType,
+			&i.FilePath,
+			&i.FileSize,
+			&i.DownloadedAt,
+			&i.DownloadError,
+			&i.Pinned,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEpisodeGUIDs = `-- name: ListEpisodeGUIDs :many
+SELECT guid FROM podcast.episodes WHERE podcast_id = $1
+`
+
+func (q *Queries) ListEpisodeGUIDs(ctx context.Context, podcastID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listEpisodeGUIDs, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var guid string
+		if err := rows.Scan(&guid); err != nil {
+			return nil, err
+		}
+		items = append(items, guid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEpisodes = `-- name: ListEpisodes :many
+SELECT id, podcast_id, guid, title, description, link, image_url, published_at, duration_seconds, season, episode_number, episode_type, explicit, enclosure_url, enclosure_type, enclosure_length, chapters_url, chapters, transcripts, transcript_path, transcript_type, file_path, file_size, downloaded_at, download_error, pinned, created_at, updated_at
+FROM podcast.episodes
+WHERE
+    podcast_id = $1
+ORDER BY published_at DESC NULLS LAST, created_at DESC, id ASC
+LIMIT $2
+OFFSET
+    $3
+`
+
+type ListEpisodesParams struct {
+	PodcastID uuid.UUID `json:"podcastId"`
+	Limit     int32     `json:"limit"`
+	Offset    int32     `json:"offset"`
+}
+
+// Lists a podcast's episodes, newest first.
+func (q *Queries) ListEpisodes(ctx context.Context, arg ListEpisodesParams) ([]PodcastEpisode, error) {
+	rows, err := q.db.Query(ctx, listEpisodes, arg.PodcastID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PodcastEpisode{}
+	for rows.Next() {
+		var i PodcastEpisode
+		if err := rows.Scan(
+			&i.ID,
+			&i.PodcastID,
+			&i.Guid,
+			&i.Title,
+			&i.Description,
+			&i.Link,
+			&i.ImageUrl,
+			&i.PublishedAt,
+			&i.DurationSeconds,
+			&i.Season,
+			&i.EpisodeNumber,
+			&i.EpisodeType,
+			&i.Explicit,
+			&i.EnclosureUrl,
+			&i.EnclosureType,
+			&i.EnclosureLength,
+			&i.ChaptersUrl,
+			&i.Chapters,
+			&i.Transcripts,
+			&i.TranscriptPath,
+			&i.TranscriptType,
+			&i.FilePath,
+			&i.FileSize,
+			&i.DownloadedAt,
+			&i.DownloadError,
+			&i.Pinned,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLatestEpisodes = `-- name: ListLatestEpisodes :many
+SELECT
+    e.id, e.podcast_id, e.guid, e.title, e.description, e.link, e.image_url, e.published_at, e.duration_seconds, e.season, e.episode_number, e.episode_type, e.explicit, e.enclosure_url, e.enclosure_type, e.enclosure_length, e.chapters_url, e.chapters, e.transcripts, e.transcript_path, e.transcript_type, e.file_path, e.file_size, e.downloaded_at, e.download_error, e.pinned, e.created_at, e.updated_at,
+    p.id, p.library_id, p.feed_url, p.title, p.sort_title, p.author, p.description, p.link, p.image_url, p.language, p.explicit, p.categories, p.podcast_guid, p.etag, p.last_modified, p.last_checked_at, p.last_error, p.auto_download, p.keep_episodes, p.created_at, p.updated_at
+FROM podcast.episodes e
+    JOIN podcast.podcasts p ON p.id = e.podcast_id
+WHERE
+    p.library_id = $1
+ORDER BY e.published_at DESC NULLS LAST, e.created_at DESC
+LIMIT $2
+`
+
+type ListLatestEpisodesParams struct {
+	LibraryID uuid.UUID `json:"libraryId"`
+	Limit     int32     `json:"limit"`
+}
+
+type ListLatestEpisodesRow struct {
+	PodcastEpisode PodcastEpisode `json:"podcastEpisode"`
+	PodcastPodcast PodcastPodcast `json:"podcastPodcast"`
+}
+
+// Episodes of the podcasts in a library, newest first.
+func (q *Queries) ListLatestEpisodes(ctx context.Context, arg ListLatestEpisodesParams) ([]ListLatestEpisodesRow, error) {
+	rows, err := q.db.Query(ctx, listLatestEpisodes, arg.LibraryID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestEpisodesRow{}
+	for rows.Next() {
+		var i ListLatestEpisodesRow
+		if err := rows.Scan(
+			&i.PodcastEpisode.ID,
+			&i.PodcastEpisode.PodcastID,
+			&i.PodcastEpisode.Guid,
+			&i.PodcastEpisode.Title,
+			&i.PodcastEpisode.Description,
+			&i.PodcastEpisode.Link,
+			&i.PodcastEpisode.ImageUrl,
+			&i.PodcastEpisode.PublishedAt,
+			&i.PodcastEpisode.DurationSeconds,
+			&i.PodcastEpisode.Season,
+			&i.PodcastEpisode.EpisodeNumber,
+			&i.PodcastEpisode.EpisodeType,
+			&i.PodcastEpisode.Explicit,
+			&i.PodcastEpisode.EnclosureUrl,
+			&i.PodcastEpisode.EnclosureType,
+			&i.PodcastEpisode.EnclosureLength,
+			&i.PodcastEpisode.ChaptersUrl,
+			&i.PodcastEpisode.Chapters,
+			&i.PodcastEpisode.Transcripts,
+			&i.PodcastEpisode.TranscriptPath,
+			&i.PodcastEpisode.TranscriptType,
+			&i.PodcastEpisode.FilePath,
+			&i.PodcastEpisode.FileSize,
+			&i.PodcastEpisode.DownloadedAt,
+			&i.PodcastEpisode.DownloadError,
+			&i.PodcastEpisode.Pinned,
+			&i.PodcastEpisode.CreatedAt,
+			&i.PodcastEpisode.UpdatedAt,
+			&i.PodcastPodcast.ID,
+			&i.PodcastPodcast.LibraryID,
+			&i.PodcastPodcast.FeedUrl,
+			&i.PodcastPodcast.Title,
+			&i.PodcastPodcast.SortTitle,
+			&i.PodcastPodcast.Author,
+			&i.PodcastPodcast.Description,
+			&i.PodcastPodcast.Link,
+			&i.PodcastPodcast.ImageUrl,
+			&i.PodcastPodcast.Language,
+			&i.PodcastPodcast.Explicit,
+			&i.PodcastPodcast.Categories,
+			&i.PodcastPodcast.PodcastGuid,
+			&i.PodcastPodcast.Etag,
+			&i.PodcastPodcast.LastModified,
+			&i.PodcastPodcast.LastCheckedAt,
+			&i.PodcastPodcast.LastError,
+			&i.PodcastPodcast.AutoDownload,
+			&i.PodcastPodcast.KeepEpisodes,
+			&i.PodcastPodcast.CreatedAt,
+			&i.PodcastPodcast.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNewEpisodes = `-- name: ListNewEpisodes :many
+SELECT
+    e.id, e.podcast_id, e.guid, e.title, e.description, e.link, e.image_url, e.published_at, e.duration_seconds, e.season, e.episode_number, e.episode_type, e.explicit, e.enclosure_url, e.enclosure_type, e.enclosure_length, e.chapters_url, e.chapters, e.transcripts, e.transcript_path, e.transcript_type, e.file_path, e.file_size, e.downloaded_at, e.download_error, e.pinned, e.created_at, e.updated_at,
+    p.id, p.library_id, p.feed_url, p.title, p.sort_title, p.author, p.description, p.link, p.image_url, p.language, p.explicit, p.categories, p.podcast_guid, p.etag, p.last_modified, p.last_checked_at, p.last_error, p.auto_download, p.keep_episodes, p.created_at, p.updated_at
+FROM podcast.episodes e
+    JOIN podcast.podcasts p ON p.id = e.podcast_id
+    JOIN podcast.subscriptions s ON s.podcast_id = e.podcast_id
+    AND s.user_id = $1
+    LEFT JOIN podcast.episode_progress ep ON ep.episode_id = e.id
+    AND ep.user_id = $1
+WHERE
+    COALESCE(ep.is_played, FALSE) = FALSE
+ORDER BY e.published_at DESC NULLS LAST, e.created_at DESC
+LIMIT $2
+`
+
+type ListNewEpisodesParams struct {
+	UserID uuid.UUID `json:"userId"`
+	Limit  int32     `json:"limit"`
+}
+
+type ListNewEpisodesRow struct {
+	PodcastEpisode PodcastEpisode `json:"podcastEpisode"`
+	PodcastPodcast PodcastPodcast `json:"podcastPodcast"`
+}
+
+// Unplayed episodes of the podcasts a user subscribes to, newest first.
+func (q *Queries) ListNewEpisodes(ctx context.Context, arg ListNewEpisodesParams) ([]ListNewEpisodesRow, error) {
+	rows, err := q.db.Query(ctx, listNewEpisodes, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListNewEpisodesRow{}
+	for rows.Next() {
+		var i ListNewEpisodesRow
+		if err := rows.Scan(
+			&i.PodcastEpisode.ID,
+			&i.PodcastEpisode.PodcastID,
+			&i.PodcastEpisode.Guid,
+			&i.PodcastEpisode.Title,
+			&i.PodcastEpisode.Description,
+			&i.PodcastEpisode.Link,
+			&i.PodcastEpisode.ImageUrl,
+			&i.PodcastEpisode.PublishedAt,
+			&i.PodcastEpisode.DurationSeconds,
+			&i.PodcastEpisode.Season,
+			&i.PodcastEpisode.EpisodeNumber,
+			&i.PodcastEpisode.EpisodeType,
+			&i.PodcastEpisode.Explicit,
+			&i.PodcastEpisode.EnclosureUrl,
+			&i.PodcastEpisode.EnclosureType,
+			&i.PodcastEpisode.EnclosureLength,
+			&i.PodcastEpisode.ChaptersUrl,
+			&i.PodcastEpisode.Chapters,
+			&i.PodcastEpisode.Transcripts,
+			&i.PodcastEpisode.TranscriptPath,
+			&i.PodcastEpisode.TranscriptType,
+			&i.PodcastEpisode.FilePath,
+			&i.PodcastEpisode.FileSize,
+			&i.PodcastEpisode.DownloadedAt,
+			&i.PodcastEpisode.DownloadError,
+			&i.PodcastEpisode.Pinned,
+			&i.PodcastEpisode.CreatedAt,
+			&i.PodcastEpisode.UpdatedAt,
+			&i.PodcastPodcast.ID,
+			&i.PodcastPodcast.LibraryID,
+			&i.PodcastPodcast.FeedUrl,
+			&i.PodcastPodcast.Title,
+			&i.PodcastPodcast.SortTitle,
+			&i.PodcastPodcast.Author,
+			&i.PodcastPodcast.Description,
+			&i.PodcastPodcast.Link,
+			&i.PodcastPodcast.ImageUrl,
+			&i.PodcastPodcast.Language,
+			&i.PodcastPodcast.Explicit,
+			&i.PodcastPodcast.Categories,
+			&i.PodcastPodcast.PodcastGuid,
+			&i.PodcastPodcast.Etag,
+			&i.PodcastPodcast.LastModified,
+			&i.PodcastPodcast.LastCheckedAt,
+			&i.PodcastPodcast.LastError,
+			&i.PodcastPodcast.AutoDownload,
+			&i.PodcastPodcast.KeepEpisodes,
+			&i.PodcastPodcast.CreatedAt,
+			&i.PodcastPodcast.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setEpisodeDownloadError = `-- name: SetEpisodeDownloadError :exec
+UPDATE podcast.episodes SET download_error = $2 WHERE id = $1
+`
+
+type SetEpisodeDownloadErrorParams struct {
+	ID            uuid.UUID `json:"id"`
+	DownloadError *string   `json:"downloadError"`
+}
+
+func (q *Queries) SetEpisodeDownloadError(ctx context.Context, arg SetEpisodeDownloadErrorParams) error {
+	_, err := q.db.Exec(ctx, setEpisodeDownloadError, arg.ID, arg.DownloadError)
+	return err
+}
+
+const setEpisodeDownloaded = `-- name: SetEpisodeDownloaded :one
+UPDATE podcast.episodes
+SET
+    file_path = $2,
+    file_size = $3,
+    chapters = COALESCE($6, chapters),
+    transcript_path = $4,
+    transcript_type = $5,
+    downloaded_at = NOW(),
+    download_error = NULL
+WHERE
+    id = $1 RETURNING id, podcast_id, guid, title, description, link, image_url, published_at, duration_seconds, season, episode_number, episode_type, explicit, enclosure_url, enclosure_type, enclosure_length, chapters_url, chapters, transcripts, transcript_path, transcript_type, file_path, file_size, downloaded_at, download_error, pinned, created_at, updated_at
+`
+
+type SetEpisodeDownloadedParams struct {
+	ID             uuid.UUID `json:"id"`
+	FilePath       *string   `json:"filePath"`
+	FileSize       int64     `json:"fileSize"`
+	TranscriptPath *string   `json:"transcriptPath"`
+	TranscriptType *string   `json:"transcriptType"`
+	Chapters       []byte    `json:"chapters"`
+}
+
+func (q *Queries) SetEpisodeDownloaded(ctx context.Context, arg SetEpisodeDownloadedParams) (PodcastEpisode, error) {
+	row := q.db.QueryRow(ctx, setEpisodeDownloaded,
+		arg.ID,
+		arg.FilePath,
+		arg.FileSize,
+		arg.TranscriptPath,
+		arg.TranscriptType,
+		arg.Chapters,
+	)
+	var i PodcastEpisode
+	err := row.Scan(
+		&i.ID,
+		&i.PodcastID,
+		&i.Guid,
+		&i.Title,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.PublishedAt,
+		&i.DurationSeconds,
+		&i.Season,
+		&i.EpisodeNumber,
+		&i.EpisodeType,
+		&i.Explicit,
+		&i.EnclosureUrl,
+		&i.EnclosureType,
+		&i.EnclosureLength,
+		&i.ChaptersUrl,
+		&i.Chapters,
+		&i.Transcripts,
+		&i.TranscriptPath,
+		&i.TranscriptType,
+		&i.FilePath,
+		&i.FileSize,
+		&i.DownloadedAt,
+		&i.DownloadError,
+		&i.Pinned,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setEpisodePinned = `-- name: SetEpisodePinned :exec
+UPDATE podcast.episodes SET pinned = $2 WHERE id = $1
+`
+
+type SetEpisodePinnedParams struct {
+	ID     uuid.UUID `json:"id"`
+	Pinned bool      `json:"pinned"`
+}
+
+func (q *Queries) SetEpisodePinned(ctx context.Context, arg SetEpisodePinnedParams) error {
+	_, err := q.db.Exec(ctx, setEpisodePinned, arg.ID, arg.Pinned)
+	return err
+}
+
+const upsertEpisode = `-- name: UpsertEpisode :one
+INSERT INTO
+    podcast.episodes (
+        podcast_id,
+        guid,
+        title,
+        description,
+        link,
+        image_url,
+        published_at,
+        duration_seconds,
+        season,
+        episode_number,
+        episode_type,
+        explicit,
+        enclosure_url,
+        enclosure_type,
+        enclosure_length,
+        chapters_url,
+        chapters,
+        transcripts
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18
+    )
+ON CONFLICT (podcast_id, guid) DO
+UPDATE
+SET
+    title = EXCLUDED.title,
+    description = EXCLUDED.description,
+    link = EXCLUDED.link,
+    image_url = EXCLUDED.image_url,
+    published_at = EXCLUDED.published_at,
+    duration_seconds = EXCLUDED.duration_seconds,
+    season = EXCLUDED.season,
+    episode_number = EXCLUDED.episode_number,
+    episode_type = EXCLUDED.episode_type,
+    explicit = EXCLUDED.explicit,
+    enclosure_url = EXCLUDED.enclosure_url,
+    enclosure_type = EXCLUDED.enclosure_type,
+    enclosure_length = EXCLUDED.enclosure_length,
+    chapters_url = EXCLUDED.chapters_url,
+    chapters = COALESCE(EXCLUDED.chapters, episodes.chapters),
+    transcripts = EXCLUDED.transcripts RETURNING id, podcast_id, guid, title, description, link, image_url, published_at, duration_seconds, season, episode_number, episode_type, explicit, enclosure_url, enclosure_type, enclosure_length, chapters_url, chapters, transcripts, transcript_path, transcript_type, file_path, file_size, downloaded_at, download_error, pinned, created_at, updated_at
+`
+
+type UpsertEpisodeParams struct {
+	PodcastID       uuid.UUID          `json:"podcastId"`
+	Guid            string             `json:"guid"`
+	Title           string             `json:"title"`
+	Description     *string            `json:"description"`
+	Link            *string            `json:"link"`
+	ImageUrl        *string            `json:"imageUrl"`
+	PublishedAt     pgtype.Timestamptz `json:"publishedAt"`
+	DurationSeconds int32              `json:"durationSeconds"`
+	Season          *int32             `json:"season"`
+	EpisodeNumber   *int32             `json:"episodeNumber"`
+	EpisodeType     string             `json:"episodeType"`
+	Explicit        bool               `json:"explicit"`
+	EnclosureUrl    string             `json:"enclosureUrl"`
+	EnclosureType   *string            `json:"enclosureType"`
+	EnclosureLength int64              `json:"enclosureLength"`
+	ChaptersUrl     *string            `json:"chaptersUrl"`
+	Chapters        []byte             `json:"chapters"`
+	Transcripts     json.RawMessage    `json:"transcripts"`
+}
+
+// Episodes are keyed by their feed guid so refreshes update them in place.
+// Download state is left alone; a changed enclosure is picked up by the
+// next download.
+func (q *Queries) UpsertEpisode(ctx context.Context, arg UpsertEpisodeParams) (PodcastEpisode, error) {
+	row := q.db.QueryRow(ctx, upsertEpisode,
+		arg.PodcastID,
+		arg.Guid,
+		arg.Title,
+		arg.Description,
+		arg.Link,
+		arg.ImageUrl,
+		arg.PublishedAt,
+		arg.DurationSeconds,
+		arg.Season,
+		arg.EpisodeNumber,
+		arg.EpisodeType,
+		arg.Explicit,
+		arg.EnclosureUrl,
+		arg.EnclosureType,
+		arg.EnclosureLength,
+		arg.ChaptersUrl,
+		arg.Chapters,
+		arg.Transcripts,
+	)
+	var i PodcastEpisode
+	err := row.Scan(
+		&i.ID,
+		&i.PodcastID,
+		&i.Guid,
+		&i.Title,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.PublishedAt,
+		&i.DurationSeconds,
+		&i.Season,
+		&i.EpisodeNumber,
+		&i.EpisodeType,
+		&i.Explicit,
+		&i.EnclosureUrl,
+		&i.EnclosureType,
+		&i.EnclosureLength,
+		&i.ChaptersUrl,
+		&i.Chapters,
+		&i.Transcripts,
+		&i.TranscriptPath,
+		&i.TranscriptType,
+		&i.FilePath,
+		&i.FileSize,
+		&i.DownloadedAt,
+		&i.DownloadError,
+		&i.Pinned,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,599 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: podcasts.sql
+
+package podcastdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countPodcasts = `-- name: CountPodcasts :one
+SELECT COUNT(*)
+FROM podcast.podcasts p
+WHERE (
+        $1::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM podcast.subscriptions s
+            WHERE
+                s.podcast_id = p.id
+                AND s.user_id = $1::uuid
+        )
+    )
+    AND (
+        $2::uuid IS NULL
+        OR p.library_id = $2::uuid
+    )
+    AND (
+        $3::text = ''
+        OR p.title ILIKE '%' || $3::text || '%'
+        OR p.author ILIKE '%' || $3::text || '%'
+    )
+`
+
+type CountPodcastsParams struct {
+	SubscribedBy pgtype.UUID `json:"subscribedBy"`
+	LibraryID    pgtype.UUID `json:"libraryId"`
+	Query        string      `json:"query"`
+}
+
+func (q *Queries) CountPodcasts(ctx context.Context, arg CountPodcastsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPodcasts, arg.SubscribedBy, arg.LibraryID, arg.Query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createPodcast = `-- name: CreatePodcast :one
+INSERT INTO
+    podcast.podcasts (
+        library_id,
+        feed_url,
+        title,
+        sort_title,
+        author,
+        description,
+        link,
+        image_url,
+        language,
+        explicit,
+        categories,
+        podcast_guid,
+        etag,
+        last_modified,
+        last_checked_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        NOW()
+    ) RETURNING id, library_id, feed_url, title, sort_title, author, description, link, image_url, language, explicit, categories, podcast_guid, etag, last_modified, last_checked_at, last_error, auto_download, keep_episodes, created_at, updated_at
+`
+
+type CreatePodcastParams struct {
+	LibraryID    uuid.UUID `json:"libraryId"`
+	FeedUrl      string    `json:"feedUrl"`
+	Title        string    `json:"title"`
+	SortTitle    string    `json:"sortTitle"`
+	Author       *string   `json:"author"`
+	Description  *string   `json:"description"`
+	Link         *string   `json:"link"`
+	ImageUrl     *string   `json:"imageUrl"`
+	Language     *string   `json:"language"`
+	Explicit     bool      `json:"explicit"`
+	Categories   []string  `json:"categories"`
+	PodcastGuid  *string   `json:"podcastGuid"`
+	Etag         *string   `json:"etag"`
+	LastModified *string   `json:"lastModified"`
+}
+
+func (q *Queries) CreatePodcast(ctx context.Context, arg CreatePodcastParams) (PodcastPodcast, error) {
+	row := q.db.QueryRow(ctx, createPodcast,
+		arg.LibraryID,
+		arg.FeedUrl,
+		arg.Title,
+		arg.SortTitle,
+		arg.Author,
+		arg.Description,
+		arg.Link,
+		arg.ImageUrl,
+		arg.Language,
+		arg.Explicit,
+		arg.Categories,
+		arg.PodcastGuid,
+		arg.Etag,
+		arg.LastModified,
+	)
+	var i PodcastPodcast
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FeedUrl,
+		&i.Title,
+		&i.SortTitle,
+		&i.Author,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.Language,
+		&i.Explicit,
+		&i.Categories,
+		&i.PodcastGuid,
+		&i.Etag,
+		&i.LastModified,
+		&i.LastCheckedAt,
+		&i.LastError,
+		&i.AutoDownload,
+		&i.KeepEpisodes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deletePodcast = `-- name: DeletePodcast :exec
+DELETE FROM podcast.podcasts WHERE id = $1
+`
+
+func (q *Queries) DeletePodcast(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deletePodcast, id)
+	return err
+}
+
+const getPodcast = `-- name: GetPodcast :one
+SELECT id, library_id, feed_url, title, sort_title, author, description, link, image_url, language, explicit, categories, podcast_guid, etag, last_modified, last_checked_at, last_error, auto_download, keep_episodes, created_at, updated_at FROM podcast.podcasts WHERE id = $1
+`
+
+func (q *Queries) GetPodcast(ctx context.Context, id uuid.UUID) (PodcastPodcast, error) {
+	row := q.db.QueryRow(ctx, getPodcast, id)
+	var i PodcastPodcast
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FeedUrl,
+		&i.Title,
+		&i.SortTitle,
+		&i.Author,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.Language,
+		&i.Explicit,
+		&i.Categories,
+		&i.PodcastGuid,
+		&i.Etag,
+		&i.LastModified,
+		&i.LastCheckedAt,
+		&i.LastError,
+		&i.AutoDownload,
+		&i.KeepEpisodes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPodcastByFeedURL = `-- name: GetPodcastByFeedURL :one
+SELECT id, library_id, feed_url, title, sort_title, author, description, link, image_url, language, explicit, categories, podcast_guid, etag, last_modified, last_checked_at, last_error, auto_download, keep_episodes, created_at, updated_at FROM podcast.podcasts WHERE feed_url = $1
+`
+
+func (q *Queries) GetPodcastByFeedURL(ctx context.Context, feedUrl string) (PodcastPodcast, error) {
+	row := q.db.QueryRow(ctx, getPodcastByFeedURL, feedUrl)
+	var i PodcastPodcast
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FeedUrl,
+		&i.Title,
+		&i.SortTitle,
+		&i.Author,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.Language,
+		&i.Explicit,
+		&i.Categories,
+		&i.PodcastGuid,
+		&i.Etag,
+		&i.LastModified,
+		&i.LastCheckedAt,
+		&i.LastError,
+		&i.AutoDownload,
+		&i.KeepEpisodes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const isSubscribed = `-- name: IsSubscribed :one
+SELECT EXISTS (
+        SELECT 1
+        FROM podcast.subscriptions
+        WHERE
+            user_id = $1
+            AND podcast_id = $2
+    )
+`
+
+type IsSubscribedParams struct {
+	UserID    uuid.UUID `json:"userId"`
+	PodcastID uuid.UUID `json:"podcastId"`
+}
+
+func (q *Queries) IsSubscribed(ctx context.Context, arg IsSubscribedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isSubscribed, arg.UserID, arg.PodcastID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listPodcasts = `-- name: ListPodcasts :many
+SELECT p.id, p.library_id, p.feed_url, p.title, p.sort_title, p.author, p.description, p.link, p.image_url, p.language, p.explicit, p.categories, p.podcast_guid, p.etag, p.last_modified, p.last_checked_at, p.last_error, p.auto_download, p.keep_episodes, p.created_at, p.updated_at
+FROM podcast.podcasts p
+WHERE (
+        $3::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM podcast.subscriptions s
+            WHERE
+                s.podcast_id = p.id
+                AND s.user_id = $3::uuid
+        )
+    )
+    AND (
+        $4::uuid IS NULL
+        OR p.library_id = $4::uuid
+    )
+    AND (
+        $5::text = ''
+        OR p.title ILIKE '%' || $5::text || '%'
+        OR p.author ILIKE '%' || $5::text || '%'
+    )
+ORDER BY p.sort_title ASC, p.id ASC
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListPodcastsParams struct {
+	Limit        int32       `json:"limit"`
+	Offset       int32       `json:"offset"`
+	SubscribedBy pgtype.UUID `json:"subscribedBy"`
+	LibraryID    pgtype.UUID `json:"libraryId"`
+	Query        string      `json:"query"`
+}
+
+// Lists podcasts by title, optionally only those a user subscribes to or
+// those of a library.
+func (q *Queries) ListPodcasts(ctx context.Context, arg ListPodcastsParams) ([]PodcastPodcast, error) {
+	rows, err := q.db.Query(ctx, listPodcasts,
+		arg.Limit,
+		arg.Offset,
+		arg.SubscribedBy,
+		arg.LibraryID,
+		arg.Query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PodcastPodcast{}
+	for rows.Next() {
+		var i PodcastPodcast
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.FeedUrl,
+			&i.Title,
+			&i.SortTitle,
+			&i.Author,
+			&i.Description,
+			&i.Link,
+			&i.ImageUrl,
+			&i.Language,
+			&i.Explicit,
+			&i.Categories,
+			&i.PodcastGuid,
+			&i.Etag,
+			&i.LastModified,
+			&i.LastCheckedAt,
+			&i.LastError,
+			&i.AutoDownload,
+			&i.KeepEpisodes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSubscribedPodcasts = `-- name: ListSubscribedPodcasts :many
+SELECT p.id, p.library_id, p.feed_url, p.title, p.sort_title, p.author, p.description, p.link, p.image_url, p.language, p.explicit, p.categories, p.podcast_guid, p.etag, p.last_modified, p.last_checked_at, p.last_error, p.auto_download, p.keep_episodes, p.created_at, p.updated_at
+FROM podcast.podcasts p
+WHERE
+    EXISTS (
+        SELECT 1
+        FROM podcast.subscriptions s
+        WHERE
+            s.podcast_id = p.id
+    )
+    AND (
+        $1::uuid IS NULL
+        OR p.library_id = $1::uuid
+    )
+ORDER BY p.last_checked_at ASC NULLS FIRST
+`
+
+// Podcasts with at least one subscriber, optionally of one library. These
+// are the feeds that get refreshed.
+func (q *Queries) ListSubscribedPodcasts(ctx context.Context, libraryID pgtype.UUID) ([]PodcastPodcast, error) {
+	rows, err := q.db.Query(ctx, listSubscribedPodcasts, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PodcastPodcast{}
+	for rows.Next() {
+		var i PodcastPodcast
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.FeedUrl,
+			&i.Title,
+			&i.SortTitle,
+			&i.Author,
+			&i.Description,
+			&i.Link,
+			&i.ImageUrl,
+			&i.Language,
+			&i.Explicit,
+			&i.Categories,
+			&i.PodcastGuid,
+			&i.Etag,
+			&i.LastModified,
+			&i.LastCheckedAt,
+			&i.LastError,
+			&i.AutoDownload,
+			&i.KeepEpisodes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSubscriberIDs = `-- name: ListSubscriberIDs :many
+SELECT user_id FROM podcast.subscriptions WHERE podcast_id = $1
+`
+
+func (q *Queries) ListSubscriberIDs(ctx context.Context, podcastID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listSubscriberIDs, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var user_id uuid.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markPodcastChecked = `-- name: MarkPodcastChecked :exec
+UPDATE podcast.podcasts
+SET
+    last_checked_at = NOW(),
+    last_error = $2
+WHERE
+    id = $1
+`
+
+type MarkPodcastCheckedParams struct {
+	ID        uuid.UUID `json:"id"`
+	LastError *string   `json:"lastError"`
+}
+
+// Records a fetch that returned no new feed: unchanged, or failed with
+// last_error set.
+func (q *Queries) MarkPodcastChecked(ctx context.Context, arg MarkPodcastCheckedParams) error {
+	_, err := q.db.Exec(ctx, markPodcastChecked, arg.ID, arg.LastError)
+	return err
+}
+
+const subscribe = `-- name: Subscribe :exec
+INSERT INTO
+    podcast.subscriptions (user_id, podcast_id)
+VALUES ($1, $2) ON CONFLICT (user_id, podcast_id) DO NOTHING
+`
+
+type SubscribeParams struct {
+	UserID    uuid.UUID `json:"userId"`
+	PodcastID uuid.UUID `json:"podcastId"`
+}
+
+func (q *Queries) Subscribe(ctx context.Context, arg SubscribeParams) error {
+	_, err := q.db.Exec(ctx, subscribe, arg.UserID, arg.PodcastID)
+	return err
+}
+
+const unsubscribe = `-- name: Unsubscribe :execrows
+DELETE FROM podcast.subscriptions
+WHERE
+    user_id = $1
+    AND podcast_id = $2
+`
+
+type UnsubscribeParams struct {
+	UserID    uuid.UUID `json:"userId"`
+	PodcastID uuid.UUID `json:"podcastId"`
+}
+
+func (q *Queries) Unsubscribe(ctx context.Context, arg UnsubscribeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unsubscribe, arg.UserID, arg.PodcastID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePodcastFeed = `-- name: UpdatePodcastFeed :one
+UPDATE podcast.podcasts
+SET
+    title = $2,
+    sort_title = $3,
+    author = $4,
+    description = $5,
+    link = $6,
+    image_url = $7,
+    language = $8,
+    explicit = $9,
+    categories = $10,
+    podcast_guid = $11,
+    etag = $12,
+    last_modified = $13,
+    last_checked_at = NOW(),
+    last_error = NULL
+WHERE
+    id = $1 RETURNING id, library_id, feed_url, title, sort_title, author, description, link, image_url, language, explicit, categories, podcast_guid, etag, last_modified, last_checked_at, last_error, auto_download, keep_episodes, created_at, updated_at
+`
+
+type UpdatePodcastFeedParams struct {
+	ID           uuid.UUID `json:"id"`
+	Title        string    `json:"title"`
+	SortTitle    string    `json:"sortTitle"`
+	Author       *string   `json:"author"`
+	Description  *string   `json:"description"`
+	Link         *string   `json:"link"`
+	ImageUrl     *string   `json:"imageUrl"`
+	Language     *string   `json:"language"`
+	Explicit     bool      `json:"explicit"`
+	Categories   []string  `json:"categories"`
+	PodcastGuid  *string   `json:"podcastGuid"`
+	Etag         *string   `json:"etag"`
+	LastModified *string   `json:"lastModified"`
+}
+
+// Stores the feed metadata and validators of a successful fetch.
+func (q *Queries) UpdatePodcastFeed(ctx context.Context, arg UpdatePodcastFeedParams) (PodcastPodcast, error) {
+	row := q.db.QueryRow(ctx, updatePodcastFeed,
+		arg.ID,
+		arg.Title,
+		arg.SortTitle,
+		arg.Author,
+		arg.Description,
+		arg.Link,
+		arg.ImageUrl,
+		arg.Language,
+		arg.Explicit,
+		arg.Categories,
+		arg.PodcastGuid,
+		arg.Etag,
+		arg.LastModified,
+	)
+	var i PodcastPodcast
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FeedUrl,
+		&i.Title,
+		&i.SortTitle,
+		&i.Author,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.Language,
+		&i.Explicit,
+		&i.Categories,
+		&i.PodcastGuid,
+		&i.Etag,
+		&i.LastModified,
+		&i.LastCheckedAt,
+		&i.LastError,
+		&i.AutoDownload,
+		&i.KeepEpisodes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updatePodcastSettings = `-- name: UpdatePodcastSettings :one
+UPDATE podcast.podcasts
+SET
+    auto_download = $2,
+    keep_episodes = $3
+WHERE
+    id = $1 RETURNING id, library_id, feed_url, title, sort_title, author, description, link, image_url, language, explicit, categories, podcast_guid, etag, last_modified, last_checked_at, last_error, auto_download, keep_episodes, created_at, updated_at
+`
+
+type UpdatePodcastSettingsParams struct {
+	ID           uuid.UUID `json:"id"`
+	AutoDownload bool      `json:"autoDownload"`
+	KeepEpisodes *int32    `json:"keepEpisodes"`
+}
+
+func (q *Queries) UpdatePodcastSettings(ctx context.Context, arg UpdatePodcastSettingsParams) (PodcastPodcast, error) {
+	row := q.db.QueryRow(ctx, updatePodcastSettings, arg.ID, arg.AutoDownload, arg.KeepEpisodes)
+	var i PodcastPodcast
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FeedUrl,
+		&i.Title,
+		&i.SortTitle,
+		&i.Author,
+		&i.Description,
+		&i.Link,
+		&i.ImageUrl,
+		&i.Language,
+		&i.Explicit,
+		&i.Categories,
+		&i.PodcastGuid,
+		&i.Etag,
+		&i.LastModified,
+		&i.LastCheckedAt,
+		&i.LastError,
+		&i.AutoDownload,
+		&i.KeepEpisodes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,279 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: progress.sql
+
+package podcastdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteEpisodeProgress = `-- name: DeleteEpisodeProgress :exec
+DELETE FROM podcast.episode_progress
+WHERE
+    user_id = $1
+    AND episode_id = $2
+`
+
+type DeleteEpisodeProgressParams struct {
+	UserID    uuid.UUID `json:"userId"`
+	EpisodeID uuid.UUID `json:"episodeId"`
+}
+
+func (q *Queries) DeleteEpisodeProgress(ctx context.Context, arg DeleteEpisodeProgressParams) error {
+	_, err := q.db.Exec(ctx, deleteEpisodeProgress, arg.UserID, arg.EpisodeID)
+	return err
+}
+
+const getEpisodeProgress = `-- name: GetEpisodeProgress :one
+SELECT id, user_id, episode_id, position_seconds, is_played, played_at, last_played_at, created_at, updated_at
+FROM podcast.episode_progress
+WHERE
+    user_id = $1
+    AND episode_id = $2
+`
+
+type GetEpisodeProgressParams struct {
+	UserID    uuid.UUID `json:"userId"`
+	EpisodeID uuid.UUID `json:"episodeId"`
+}
+
+func (q *Queries) GetEpisodeProgress(ctx context.Context, arg GetEpisodeProgressParams) (PodcastEpisodeProgress, error) {
+	row := q.db.QueryRow(ctx, getEpisodeProgress, arg.UserID, arg.EpisodeID)
+	var i PodcastEpisodeProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.EpisodeID,
+		&i.PositionSeconds,
+		&i.IsPlayed,
+		&i.PlayedAt,
+		&i.LastPlayedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listContinueListening = `-- name: ListContinueListening :many
+SELECT
+    e.id, e.podcast_id, e.guid, e.title, e.description, e.link, e.image_url, e.published_at, e.duration_seconds, e.season, e.episode_number, e.episode_type, e.explicit, e.enclosure_url, e.enclosure_type, e.enclosure_length, e.chapters_url, e.chapters, e.transcripts, e.transcript_path, e.transcript_type, e.file_path, e.file_size, e.downloaded_at, e.download_error, e.pinned, e.created_at, e.updated_at,
+    p.id, p.library_id, p.feed_url, p.title, p.sort_title, p.author, p.description, p.link, p.image_url, p.language, p.explicit, p.categories, p.podcast_guid, p.etag, p.last_modified, p.last_checked_at, p.last_error, p.auto_download, p.keep_episodes, p.created_at, p.updated_at,
+    ep.id, ep.user_id, ep.episode_id, ep.position_seconds, ep.is_played, ep.played_at, ep.last_played_at, ep.created_at, ep.updated_at
+FROM podcast.episode_progress ep
+    JOIN podcast.episodes e ON e.id = ep.episode_id
+    JOIN podcast.podcasts p ON p.id = e.podcast_id
+WHERE
+    ep.user_id = $2
+    AND NOT ep.is_played
+    AND ep.position_seconds > 0
+    AND (
+        $3::bool = FALSE
+        OR p.library_id = ANY($4::uuid[])
+    )
+ORDER BY ep.last_played_at DESC
+LIMIT $1
+`
+
+type ListContinueListeningParams struct {
+	Limit      int32       `json:"limit"`
+	UserID     uuid.UUID   `json:"userId"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+}
+
+type ListContinueListeningRow struct {
+	PodcastEpisode         PodcastEpisode         `json:"podcastEpisode"`
+	PodcastPodcast         PodcastPodcast         `json:"podcastPodcast"`
+	PodcastEpisodeProgress PodcastEpisodeProgress `json:"podcastEpisodeProgress"`
+}
+
+// Returns the episodes a user started but did not finish, most recently
+// played first. restrict limits the result to library_ids.
+func (q *Queries) ListContinueListening(ctx context.Context, arg ListContinueListeningParams) ([]ListContinueListeningRow, error) {
+	rows, err := q.db.Query(ctx, listContinueListening,
+		arg.Limit,
+		arg.UserID,
+		arg.Restrict,
+		arg.LibraryIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListContinueListeningRow{}
+	for rows.Next() {
+		var i ListContinueListeningRow
+		if err := rows.Scan(
+			&i.PodcastEpisode.ID,
+			&i.PodcastEpisode.PodcastID,
+			&i.PodcastEpisode.Guid,
+			&i.PodcastEpisode.Title,
+			&i.PodcastEpisode.Description,
+			&i.PodcastEpisode.Link,
+			&i.PodcastEpisode.ImageUrl,
+			&i.PodcastEpisode.PublishedAt,
+			&i.PodcastEpisode.DurationSeconds,
+			&i.PodcastEpisode.Season,
+			&i.PodcastEpisode.EpisodeNumber,
+			&i.PodcastEpisode.EpisodeType,
+			&i.PodcastEpisode.Explicit,
+			&i.PodcastEpisode.EnclosureUrl,
+			&i.PodcastEpisode.EnclosureType,
+			&i.PodcastEpisode.EnclosureLength,
+			&i.PodcastEpisode.ChaptersUrl,
+			&i.PodcastEpisode.Chapters,
+			&i.PodcastEpisode.Transcripts,
+			&i.PodcastEpisode.TranscriptPath,
+			&i.PodcastEpisode.TranscriptType,
+			&i.PodcastEpisode.FilePath,
+			&i.PodcastEpisode.FileSize,
+			&i.PodcastEpisode.DownloadedAt,
+			&i.PodcastEpisode.DownloadError,
+			&i.PodcastEpisode.Pinned,
+			&i.PodcastEpisode.CreatedAt,
+			&i.PodcastEpisode.UpdatedAt,
+			&i.PodcastPodcast.ID,
+			&i.PodcastPodcast.LibraryID,
+			&i.PodcastPodcast.FeedUrl,
+			&i.PodcastPodcast.Title,
+			&i.PodcastPodcast.SortTitle,
+			&i.PodcastPodcast.Author,
+			&i.PodcastPodcast.Description,
+			&i.PodcastPodcast.Link,
+			&i.PodcastPodcast.ImageUrl,
+			&i.PodcastPodcast.Language,
+			&i.PodcastPodcast.Explicit,
+			&i.PodcastPodcast.Categories,
+			&i.PodcastPodcast.PodcastGuid,
+			&i.PodcastPodcast.Etag,
+			&i.PodcastPodcast.LastModified,
+			&i.PodcastPodcast.LastCheckedAt,
+			&i.PodcastPodcast.LastError,
+			&i.PodcastPodcast.AutoDownload,
+			&i.PodcastPodcast.KeepEpisodes,
+			&i.PodcastPodcast.CreatedAt,
+			&i.PodcastPodcast.UpdatedAt,
+			&i.PodcastEpisodeProgress.ID,
+			&i.PodcastEpisodeProgress.UserID,
+			&i.PodcastEpisodeProgress.EpisodeID,
+			&i.PodcastEpisodeProgress.PositionSeconds,
+			&i.PodcastEpisodeProgress.IsPlayed,
+			&i.PodcastEpisodeProgress.PlayedAt,
+			&i.PodcastEpisodeProgress.LastPlayedAt,
+			&i.PodcastEpisodeProgress.CreatedAt,
+			&i.PodcastEpisodeProgress.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEpisodeProgress = `-- name: ListEpisodeProgress :many
+SELECT id, user_id, episode_id, position_seconds, is_played, played_at, last_played_at, created_at, updated_at
+FROM podcast.episode_progress
+WHERE
+    user_id = $1
+    AND episode_id = ANY ($2::uuid[])
+`
+
+type ListEpisodeProgressParams struct {
+	UserID     uuid.UUID   `json:"userId"`
+	EpisodeIds []uuid.UUID `json:"episodeIds"`
+}
+
+// Returns a user's listening state for a page of episodes.
+func (q *Queries) ListEpisodeProgress(ctx context.Context, arg ListEpisodeProgressParams) ([]PodcastEpisodeProgress, error) {
+	rows, err := q.db.Query(ctx, listEpisodeProgress, arg.UserID, arg.EpisodeIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PodcastEpisodeProgress{}
+	for rows.Next() {
+		var i PodcastEpisodeProgress
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.EpisodeID,
+			&i.PositionSeconds,
+			&i.IsPlayed,
+			&i.PlayedAt,
+			&i.LastPlayedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertEpisodeProgress = `-- name: UpsertEpisodeProgress :one
+INSERT INTO
+    podcast.episode_progress (
+        user_id,
+        episode_id,
+        position_seconds,
+        is_played,
+        played_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4::boolean,
+        CASE WHEN $4::boolean THEN NOW() END
+    ) ON CONFLICT (user_id, episode_id) DO
+UPDATE
+SET
+    position_seconds = EXCLUDED.position_seconds,
+    is_played = EXCLUDED.is_played,
+    played_at = CASE
+        WHEN EXCLUDED.is_played
+        AND NOT episode_progress.is_played THEN NOW()
+        WHEN EXCLUDED.is_played THEN episode_progress.played_at
+    END,
+    last_played_at = NOW() RETURNING id, user_id, episode_id, position_seconds, is_played, played_at, last_played_at, created_at, updated_at
+`
+
+type UpsertEpisodeProgressParams struct {
+	UserID          uuid.UUID `json:"userId"`
+	EpisodeID       uuid.UUID `json:"episodeId"`
+	PositionSeconds int32     `json:"positionSeconds"`
+	IsPlayed        bool      `json:"isPlayed"`
+}
+
+func (q *Queries) UpsertEpisodeProgress(ctx context.Context, arg UpsertEpisodeProgressParams) (PodcastEpisodeProgress, error) {
+	row := q.db.QueryRow(ctx, upsertEpisodeProgress,
+		arg.UserID,
+		arg.EpisodeID,
+		arg.PositionSeconds,
+		arg.IsPlayed,
+	)
+	var i PodcastEpisodeProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.EpisodeID,
+		&i.PositionSeconds,
+		&i.IsPlayed,
+		&i.PlayedAt,
+		&i.LastPlayedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
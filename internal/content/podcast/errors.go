@@ -0,0 +1,16 @@
+package podcast
+
+import "errors"
+
+var (
+	ErrPodcastNotFound     = errors.New("podcast not found")
+	ErrEpisodeNotFound     = errors.New("episode not found")
+	ErrProgressNotFound    = errors.New("progress not found")
+	ErrNotSubscribed       = errors.New("not subscribed")
+	ErrNotDownloaded       = errors.New("episode not downloaded")
+	ErrTranscriptNotFound  = errors.New("transcript not found")
+	ErrInvalidFeedURL      = errors.New("invalid feed url")
+	ErrInvalidLibrary      = errors.New("not a podcast library")
+	ErrInvalidProgress     = errors.New("invalid listening progress")
+	ErrNoDownloadDirectory = errors.New("podcast library has no path")
+)
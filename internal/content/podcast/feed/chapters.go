@@ -0,0 +1,47 @@
+package feed
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// jsonChapters is the Podcasting 2.0 JSON chapters format.
+// https://github.com/Podcastindex-org/podcast-namespace/blob/main/chapters/jsonChapters.md
+type jsonChapters struct {
+	Version  string `json:"version"`
+	Chapters []struct {
+		StartTime float64 `json:"startTime"`
+		Title     string  `json:"title"`
+		Img       string  `json:"img"`
+		URL       string  `json:"url"`
+		TOC       *bool   `json:"toc"`
+	} `json:"chapters"`
+}
+
+// ParseChapters parses a Podcasting 2.0 JSON chapters file. Chapters hidden
+// from the table of contents (toc: false) only carry artwork or links for a
+// part of a chapter and are dropped.
+func ParseChapters(data []byte) ([]Chapter, error) {
+	var doc jsonChapters
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse chapters: %w", err)
+	}
+	chapters := make([]Chapter, 0, len(doc.Chapters))
+	for _, c := range doc.Chapters {
+		if c.TOC != nil && !*c.TOC {
+			continue
+		}
+		chapters = append(chapters, Chapter{
+			Start:    max(c.StartTime, 0),
+			Title:    c.Title,
+			URL:      c.URL,
+			ImageURL: c.Img,
+		})
+	}
+	slices.SortStableFunc(chapters, func(a, b Chapter) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	return chapters, nil
+}
@@ -0,0 +1,60 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when a feed, chapter, transcript or
+// episode URL resolves to a loopback, private or otherwise non-public
+// address. Users subscribe to arbitrary URLs, which must not reach the
+// server's own network.
+var ErrNonPublicAddress = errors.New("address is not public")
+
+// publicTransport returns a transport that only connects to public
+// addresses. The check runs on every connection after name resolution, so
+// redirects and DNS names pointing inwards are caught as well. Proxies are
+// not used, since the proxy would connect on the client's behalf.
+func publicTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   publicOnly,
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = dialer.DialContext
+	return t
+}
+
+// publicOnly is a net.Dialer control function rejecting non-public
+// addresses.
+func publicOnly(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("dial %s: %w", address, err)
+	}
+	if !isPublic(addrPort.Addr()) {
+		return fmt.Errorf("dial %s: %w", address, ErrNonPublicAddress)
+	}
+	return nil
+}
+
+// isPublic reports whether an address is routable on the internet.
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is
+// not reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
@@ -0,0 +1,364 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rssFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"
+	xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"
+	xmlns:podcast="https://podcastindex.org/namespace/1.0"
+	xmlns:content="http://purl.org/rss/1.0/modules/content/"
+	xmlns:psc="http://podlove.org/simple-chapters"
+	xmlns:atom="http://www.w3.org/2005/Atom">
+<channel>
+	<title>Test Show</title>
+	<atom:link href="https://example.com/feed.xml" rel="self" type="application/rss+xml"/>
+	<link>https://example.com</link>
+	<description>A show about tests</description>
+	<language>en-us</language>
+	<itunes:author>Jane Host</itunes:author>
+	<itunes:explicit>yes</itunes:explicit>
+	<itunes:image href="https://example.com/cover.jpg"/>
+	<itunes:category text="Technology">
+		<itunes:category text="Software How-To"/>
+	</itunes:category>
+	<podcast:guid>917393e3-1b1e-5cef-ace4-edaa54e1f810</podcast:guid>
+	<item>
+		<itunes:title>Short Title</itunes:title>
+		<title>Episode 2: Fixtures</title>
+		<guid isPermaLink="false">ep-2</guid>
+		<pubDate>Tue, 02 Jan 2024 10:00:00 +0000</pubDate>
+		<description>Plain notes</description>
+		<content:encoded><![CDATA[<p>Rich notes</p>]]></content:encoded>
+		<enclosure url="https://example.com/ep2.mp3" length="1234" type="audio/mpeg"/>
+		<itunes:duration>1:02:03</itunes:duration>
+		<itunes:season>1</itunes:season>
+		<itunes:episode>2</itunes:episode>
+		<itunes:episodeType>Full</itunes:episodeType>
+		<podcast:chapters url="https://example.com/ep2.json" type="application/json+chapters"/>
+		<podcast:transcript url="https://example.com/ep2.vtt" type="text/vtt" language="en" rel="captions"/>
+		<podcast:transcript url="https://example.com/ep2.srt" type="application/srt"/>
+		<psc:chapters version="1.2">
+			<psc:chapter start="00:00:00.000" title="Intro"/>
+			<psc:chapter start="05:30" title="Main" href="https://example.com/main"/>
+		</psc:chapters>
+	</item>
+	<item>
+		<title>Episode 1</title>
+		<pubDate>1 Jan 2024 08:00:00 GMT</pubDate>
+		<enclosure url="https://example.com/ep1.mp3" type="audio/mpeg"/>
+		<itunes:duration>125</itunes:duration>
+	</item>
+	<item>
+		<title>Blog post without audio</title>
+	</item>
+</channel>
+</rss>`
+
+const atomFixture = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xml:lang="de">
+	<title>Atom Show</title>
+	<subtitle>An Atom podcast</subtitle>
+	<link href="https://example.org/"/>
+	<link rel="self" href="https://example.org/feed.atom"/>
+	<logo>https://example.org/logo.png</logo>
+	<author><name>Atom Author</name></author>
+	<entry>
+		<id>urn:uuid:1225c695</id>
+		<title>First</title>
+		<published>2024-03-01T12:00:00Z</published>
+		<summary>Summary</summary>
+		<link rel="alternate" href="https://example.org/first"/>
+		<link rel="enclosure" href="https://example.org/first.ogg" type="audio/ogg" length="99"/>
+		<itunes:duration>3600</itunes:duration>
+	</entry>
+	<entry>
+		<id>urn:uuid:text-only</id>
+		<title>No media</title>
+	</entry>
+</feed>`
+
+func TestParse_RSS(t *testing.T) {
+	f, err := Parse([]byte(rssFixture))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Test Show", f.Title)
+	assert.Equal(t, "https://example.com", f.Link)
+	assert.Equal(t, "A show about tests", f.Description)
+	assert.Equal(t, "Jane Host", f.Author)
+	assert.Equal(t, "en-us", f.Language)
+	assert.True(t, f.Explicit)
+	assert.Equal(t, "https://example.com/cover.jpg", f.ImageURL)
+	assert.Equal(t, []string{"Technology", "Software How-To"}, f.Categories)
+	assert.Equal(t, "917393e3-1b1e-5cef-ace4-edaa54e1f810", f.GUID)
+
+	require.Len(t, f.Episodes, 2, "items without enclosure are skipped")
+
+	ep := f.Episodes[0]
+	assert.Equal(t, "ep-2", ep.GUID)
+	assert.Equal(t, "Episode 2: Fixtures", ep.Title, "the RSS title wins over itunes:title")
+	assert.Equal(t, "<p>Rich notes</p>", ep.Description)
+	assert.Equal(t, time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), ep.PublishedAt)
+	assert.Equal(t, time.Hour+2*time.Minute+3*time.Second, ep.Duration)
+	assert.Equal(t, 1, ep.Season)
+	assert.Equal(t, 2, ep.Number)
+	assert.Equal(t, "full", ep.EpisodeType)
+	assert.Equal(t, Enclosure{URL: "https://example.com/ep2.mp3", Type: "audio/mpeg", Length: 1234}, ep.Enclosure)
+	assert.Equal(t, "https://example.com/ep2.json", ep.ChaptersURL)
+	assert.Equal(t, "application/json+chapters", ep.ChaptersType)
+	assert.Equal(t, []Chapter{
+		{Start: 0, Title: "Intro"},
+		{Start: 330, Title: "Main", URL: "https://example.com/main"},
+	}, ep.Chapters)
+	assert.Equal(t, []Transcript{
+		{URL: "https://example.com/ep2.vtt", Type: "text/vtt", Language: "en", Rel: "captions"},
+		{URL: "https://example.com/ep2.srt", Type: "application/srt"},
+	}, ep.Transcripts)
+
+	ep = f.Episodes[1]
+	assert.Equal(t, "https://example.com/ep1.mp3", ep.GUID, "the enclosure URL identifies items without guid")
+	assert.Equal(t, time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), ep.PublishedAt)
+	assert.Equal(t, 125*time.Second, ep.Duration)
+}
+
+func TestParse_Atom(t *testing.T) {
+	f, err := Parse([]byte(atomFixture))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Atom Show", f.Title)
+	assert.Equal(t, "An Atom podcast", f.Description)
+	assert.Equal(t, "https://example.org/", f.Link)
+	assert.Equal(t, "https://example.org/logo.png", f.ImageURL)
+	assert.Equal(t, "Atom Author", f.Author)
+	assert.Equal(t, "de", f.Language)
+
+	require.Len(t, f.Episodes, 1)
+	ep := f.Episodes[0]
+	assert.Equal(t, "urn:uuid:1225c695", ep.GUID)
+	assert.Equal(t, "First", ep.Title)
+	assert.Equal(t, "Summary", ep.Description)
+	assert.Equal(t, "https://example.org/first", ep.Link)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), ep.PublishedAt)
+	assert.Equal(t, time.Hour, ep.Duration)
+	assert.Equal(t, Enclosure{URL: "https://example.org/first.ogg", Type: "audio/ogg", Length: 99}, ep.Enclosure)
+}
+
+func TestParse_Latin1(t *testing.T) {
+	var doc bytes.Buffer
+	doc.WriteString(`<?xml version="1.0" encoding="ISO-8859-1"?><rss><channel><title>Caf`)
+	doc.WriteByte(0xe9) // é in Latin-1
+	doc.WriteString(`</title></channel></rss>`)
+
+	f, err := Parse(doc.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "Café", f.Title)
+}
+
+func TestParse_NotAFeed(t *testing.T) {
+	for name, doc := range map[string]string{
+		"html":  `<html><body>hi</body></html>`,
+		"empty": ``,
+		"json":  `{"title":"x"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(doc))
+			assert.ErrorIs(t, err, ErrNotAFeed)
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	want := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	for _, s := range []string{
+		"Tue, 02 Jan 2024 10:00:00 +0000",
+		"Tue, 2 Jan 2024 10:00:00 GMT",
+		"Tue, 2 Jan 2024 10:00:00 UT",
+		"Tue,  2 Jan 2024 11:00:00 +0100",
+		"2 Jan 2024 10:00:00 +0000",
+		"Tue, 2 Jan 2024 10:00 +0000",
+		"2024-01-02T10:00:00Z",
+		"2024-01-02T12:00:00+02:00",
+	} {
+		assert.True(t, want.Equal(ParseDate(s)), s)
+	}
+	assert.True(t, ParseDate("yesterday").IsZero())
+	assert.True(t, ParseDate("").IsZero())
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"3600":         time.Hour,
+		"90.5":         90*time.Second + 500*time.Millisecond,
+		"62:03":        62*time.Minute + 3*time.Second,
+		"1:02:03":      time.Hour + 2*time.Minute + 3*time.Second,
+		"00:01:02.500": time.Minute + 2*time.Second + 500*time.Millisecond,
+		"":             0,
+		"about an hr":  0,
+		"-5":           0,
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, ParseDuration(in), in)
+	}
+}
+
+func TestParseChapters(t *testing.T) {
+	chapters, err := ParseChapters([]byte(`{
+		"version": "1.2.0",
+		"chapters": [
+			{"startTime": 120.5, "title": "Second", "img": "https://example.com/2.jpg"},
+			{"startTime": 0, "title": "First", "url": "https://example.com"},
+			{"startTime": 60, "title": "Artwork only", "toc": false}
+		]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, []Chapter{
+		{Start: 0, Title: "First", URL: "https://example.com"},
+		{Start: 120.5, Title: "Second", ImageURL: "https://example.com/2.jpg"},
+	}, chapters)
+
+	_, err = ParseChapters([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestClient_Fetch_Conditional(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Tue, 02 Jan 2024 10:00:00 GMT")
+		w.Header().Set("Content-Type", "application/rss+xml")
+		_, _ = w.Write([]byte(rssFixture))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.Client())
+
+	res, err := c.Fetch(context.Background(), srv.URL, "", "")
+	require.NoError(t, err)
+	assert.False(t, res.NotModified)
+	require.NotNil(t, res.Feed)
+	assert.Equal(t, "Test Show", res.Feed.Title)
+	assert.Equal(t, `"v1"`, res.ETag)
+	assert.Equal(t, "Tue, 02 Jan 2024 10:00:00 GMT", res.LastModified)
+
+	res, err = c.Fetch(context.Background(), srv.URL, res.ETag, res.LastModified)
+	require.NoError(t, err)
+	assert.True(t, res.NotModified)
+	assert.Nil(t, res.Feed)
+	assert.Equal(t, `"v1"`, res.ETag, "validators are kept")
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestClient_Fetch_Errors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+	mux.HandleFunc("/huge", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<rss><channel><title>"))
+		_, _ = w.Write(bytes.Repeat([]byte("x"), MaxFeedSize))
+	})
+	mux.HandleFunc("/html", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html></html>"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	c := NewClient(srv.Client())
+
+	_, err := c.Fetch(context.Background(), srv.URL+"/gone", "", "")
+	var statusErr *StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusGone, statusErr.StatusCode)
+
+	_, err = c.Fetch(context.Background(), srv.URL+"/huge", "", "")
+	assert.ErrorIs(t, err, ErrTooLarge)
+
+	_, err = c.Fetch(context.Background(), srv.URL+"/html", "", "")
+	assert.ErrorIs(t, err, ErrNotAFeed)
+}
+
+func TestClient_Download(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("ID3 audio"))
+	}))
+	defer srv.Close()
+
+	var buf strings.Builder
+	n, contentType, err := NewClient(srv.Client()).Download(context.Background(), srv.URL, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), n)
+	assert.Equal(t, "audio/mpeg", contentType)
+	assert.Equal(t, "ID3 audio", buf.String())
+}
+
+func TestClient_Download_TooLarge(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Chunked, so the size is only known while reading
+		w.(http.Flusher).Flush()
+		_, _ = w.Write(bytes.Repeat([]byte("x"), 64))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.Client())
+	c.SetDownloadLimits(DownloadLimits{MaxSize: 16})
+
+	var buf bytes.Buffer
+	_, _, err := c.Download(context.Background(), srv.URL, &buf)
+	assert.ErrorIs(t, err, ErrTooLarge)
+	assert.LessOrEqual(t, buf.Len(), 17, "reading stops at the limit")
+}
+
+func TestClient_RejectsNonPublicAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(rssFixture))
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(nil).Fetch(context.Background(), srv.URL, "", "")
+	assert.ErrorIs(t, err, ErrNonPublicAddress)
+
+	_, _, err = NewClient(nil).Download(context.Background(), srv.URL, io.Discard)
+	assert.ErrorIs(t, err, ErrNonPublicAddress)
+}
+
+func TestIsPublic(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":       true,
+		"2606:2800:220:1::1":  true,
+		"127.0.0.1":           false,
+		"::1":                 false,
+		"10.0.0.5":            false,
+		"172.16.0.1":          false,
+		"192.168.1.10":        false,
+		"169.254.169.254":     false,
+		"100.64.0.1":          false,
+		"0.0.0.0":             false,
+		"fd00::1":             false,
+		"fe80::1":             false,
+		"::ffff:192.168.1.10": false,
+		"224.0.0.1":           false,
+	}
+	for addr, want := range tests {
+		assert.Equal(t, want, isPublic(netip.MustParseAddr(addr)), addr)
+	}
+}
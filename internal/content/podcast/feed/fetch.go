@@ -0,0 +1,217 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Size limits of fetched documents. Long-running shows publish feeds of
+// several megabytes.
+const (
+	MaxFeedSize       = 32 << 20
+	MaxAttachmentSize = 8 << 20 // chapters and transcripts
+
+	// DefaultTimeout bounds feed, chapter and transcript requests.
+	DefaultTimeout = 30 * time.Second
+
+	// DefaultMaxDownloadSize and DefaultDownloadTimeout bound episode
+	// downloads unless the client is given other limits.
+	DefaultMaxDownloadSize = 2 << 30
+	DefaultDownloadTimeout = time.Hour
+
+	userAgent = "Revenge/1.0 (podcast feed fetcher)"
+)
+
+// ErrTooLarge is returned when a document exceeds its size limit.
+var ErrTooLarge = errors.New("response too large")
+
+// StatusError is returned for unsuccessful HTTP responses.
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("GET %s: %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// FetchResult is the outcome of a conditional feed request.
+type FetchResult struct {
+	// Feed is nil when the feed was not modified.
+	Feed         *Feed
+	NotModified  bool
+	ETag         string
+	LastModified string
+}
+
+// DownloadLimits bound episode downloads. Zero values use the defaults.
+type DownloadLimits struct {
+	MaxSize int64
+	Timeout time.Duration
+}
+
+// Client fetches feeds, chapters, transcripts and episode media.
+type Client struct {
+	http    *http.Client
+	timeout time.Duration
+	limits  DownloadLimits
+}
+
+// NewClient creates a feed client. A nil http client uses one that only
+// connects to public addresses; see ErrNonPublicAddress.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Transport: publicTransport()}
+	}
+	c := &Client{http: httpClient, timeout: DefaultTimeout}
+	c.SetDownloadLimits(DownloadLimits{})
+	return c
+}
+
+// SetDownloadLimits sets the size and time limits of episode downloads.
+func (c *Client) SetDownloadLimits(limits DownloadLimits) {
+	if limits.MaxSize <= 0 {
+		limits.MaxSize = DefaultMaxDownloadSize
+	}
+	if limits.Timeout <= 0 {
+		limits.Timeout = DefaultDownloadTimeout
+	}
+	c.limits = limits
+}
+
+// Fetch requests a feed. The ETag and Last-Modified validators of the
+// previous fetch make the request conditional; an unchanged feed returns a
+// result with NotModified set and the old validators.
+func (c *Client) Fetch(ctx context.Context, url, etag, lastModified string) (*FetchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := c.newRequest(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.9, */*;q=0.1")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch feed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return &FetchResult{NotModified: true, ETag: etag, LastModified: lastModified}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+
+	data, err := readLimited(resp.Body, MaxFeedSize)
+	if err != nil {
+		return nil, fmt.Errorf("read feed: %w", err)
+	}
+	f, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &FetchResult{
+		Feed:         f,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// FetchChapters downloads and parses a JSON chapters file.
+func (c *Client) FetchChapters(ctx context.Context, url string) ([]Chapter, error) {
+	data, _, err := c.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChapters(data)
+}
+
+// Get downloads a small document such as a transcript, up to
+// MaxAttachmentSize. It returns the body and its content type.
+func (c *Client) Get(ctx context.Context, url string) ([]byte, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := c.newRequest(ctx, url)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", &StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+	data, err := readLimited(resp.Body, MaxAttachmentSize)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, resp.Header.Get("Content-Type"), nil
+}
+
+// Download streams an episode's media file to w. It returns the number of
+// bytes written and the content type reported by the server. Files larger
+// than the download size limit fail with ErrTooLarge.
+func (c *Client) Download(ctx context.Context, url string, w io.Writer) (int64, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.limits.Timeout)
+	defer cancel()
+
+	req, err := c.newRequest(ctx, url)
+	if err != nil {
+		return 0, "", err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, "", fmt.Errorf("download: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, "", &StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+	if resp.ContentLength > c.limits.MaxSize {
+		return 0, "", fmt.Errorf("download: %w", ErrTooLarge)
+	}
+	n, err := io.Copy(w, io.LimitReader(resp.Body, c.limits.MaxSize+1))
+	if err != nil {
+		return n, "", fmt.Errorf("download: %w", err)
+	}
+	if n > c.limits.MaxSize {
+		return n, "", fmt.Errorf("download: %w", ErrTooLarge)
+	}
+	return n, resp.Header.Get("Content-Type"), nil
+}
+
+func (c *Client) newRequest(ctx context.Context, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url %q: %w", url, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	return req, nil
+}
+
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
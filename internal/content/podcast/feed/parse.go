@@ -0,0 +1,499 @@
+// Package feed parses podcast feeds and fetches them over HTTP. RSS 2.0
+// feeds with the iTunes, Podcasting 2.0 and Podlove Simple Chapters
+// extensions are understood, as well as Atom feeds with enclosure links.
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// XML namespaces of the feed extensions.
+const (
+	nsAtom    = "http://www.w3.org/2005/Atom"
+	nsITunes  = "http://www.itunes.com/dtds/podcast-1.0.dtd"
+	nsPodcast = "https://podcastindex.org/namespace/1.0"
+	nsContent = "http://purl.org/rss/1.0/modules/content/"
+	nsPSC     = "http://podlove.org/simple-chapters"
+)
+
+// ErrNotAFeed is returned for documents that are neither RSS nor Atom.
+var ErrNotAFeed = errors.New("not an RSS or Atom feed")
+
+// Feed is a parsed podcast feed.
+type Feed struct {
+	Title       string
+	Description string
+	Link        string
+	ImageURL    string
+	Author      string
+	Language    string
+	Explicit    bool
+	Categories  []string
+	GUID        string // podcast:guid, stable across feed moves
+	Episodes    []Episode
+}
+
+// Episode is a feed item with a media enclosure. Items without one are not
+// podcast episodes and are skipped.
+type Episode struct {
+	GUID        string
+	Title       string
+	Description string
+	Link        string
+	ImageURL    string
+	PublishedAt time.Time // zero when the feed has no usable date
+	Duration    time.Duration
+	Season      int
+	Number      int
+	EpisodeType string // "full", "trailer" or "bonus"
+	Explicit    bool
+	Enclosure   Enclosure
+
+	// ChaptersURL points to Podcasting 2.0 JSON chapters; Chapters holds
+	// chapters embedded in the feed as Podlove Simple Chapters.
+	ChaptersURL  string
+	ChaptersType string
+	Chapters     []Chapter
+	Transcripts  []Transcript
+}
+
+// Enclosure is the media file of an episode.
+type Enclosure struct {
+	URL    string
+	Type   string
+	Length int64
+}
+
+// Chapter is a chapter mark within an episode.
+type Chapter struct {
+	Start    float64 `json:"start"` // seconds
+	Title    string  `json:"title"`
+	URL      string  `json:"url,omitempty"`
+	ImageURL string  `json:"imageUrl,omitempty"`
+}
+
+// Transcript is a Podcasting 2.0 transcript of an episode.
+type Transcript struct {
+	URL      string `json:"url"`
+	Type     string `json:"type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"` // "captions" for timed captions
+}
+
+// Parse parses an RSS or Atom feed. Declared non-UTF-8 encodings are
+// converted.
+func Parse(data []byte) (*Feed, error) {
+	root, err := parseTree(data)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case root.Name.Local == "rss":
+		channel := root.child("", "channel")
+		if channel == nil {
+			return nil, fmt.Errorf("%w: rss without channel", ErrNotAFeed)
+		}
+		return parseRSS(channel), nil
+	case root.Name.Local == "feed" && root.Name.Space == nsAtom:
+		return parseAtom(root), nil
+	default:
+		return nil, fmt.Errorf("%w: root element <%s>", ErrNotAFeed, root.Name.Local)
+	}
+}
+
+// =============================================================================
+// RSS
+// =============================================================================
+
+func parseRSS(ch *node) *Feed {
+	f := &Feed{
+		Title:       ch.text("", "title"),
+		Description: firstNonEmpty(ch.text("", "description"), ch.text(nsITunes, "summary")),
+		Link:        ch.text("", "link"),
+		Author:      firstNonEmpty(ch.text(nsITunes, "author"), ch.text("", "managingEditor")),
+		Language:    ch.text("", "language"),
+		Explicit:    parseExplicit(ch.text(nsITunes, "explicit")),
+		GUID:        ch.text(nsPodcast, "guid"),
+	}
+	if img := ch.child(nsITunes, "image"); img != nil {
+		f.ImageURL = img.attr("href")
+	}
+	if f.ImageURL == "" {
+		if img := ch.child("", "image"); img != nil {
+			f.ImageURL = img.text("", "url")
+		}
+	}
+	f.Categories = itunesCategories(ch)
+
+	for _, item := range ch.children("", "item") {
+		if ep, ok := parseRSSItem(item); ok {
+			f.Episodes = append(f.Episodes, ep)
+		}
+	}
+	return f
+}
+
+func parseRSSItem(item *node) (Episode, bool) {
+	enc := item.child("", "enclosure")
+	if enc == nil || enc.attr("url") == "" {
+		return Episode{}, false
+	}
+	length, _ := strconv.ParseInt(enc.attr("length"), 10, 64)
+	ep := Episode{
+		Title: firstNonEmpty(item.text("", "title"), item.text(nsITunes, "title")),
+		Description: firstNonEmpty(
+			item.text(nsContent, "encoded"),
+			item.text("", "description"),
+			item.text(nsITunes, "summary"),
+		),
+		Link:        item.text("", "link"),
+		PublishedAt: ParseDate(item.text("", "pubDate")),
+		Duration:    ParseDuration(item.text(nsITunes, "duration")),
+		Season:      firstPositive(item.text(nsPodcast, "season"), item.text(nsITunes, "season")),
+		Number:      firstPositive(item.text(nsPodcast, "episode"), item.text(nsITunes, "episode")),
+		EpisodeType: strings.ToLower(item.text(nsITunes, "episodeType")),
+		Explicit:    parseExplicit(item.text(nsITunes, "explicit")),
+		Enclosure: Enclosure{
+			URL:    enc.attr("url"),
+			Type:   enc.attr("type"),
+			Length: max(length, 0),
+		},
+	}
+	if img := item.child(nsITunes, "image"); img != nil {
+		ep.ImageURL = img.attr("href")
+	}
+	if ch := item.child(nsPodcast, "chapters"); ch != nil {
+		ep.ChaptersURL = ch.attr("url")
+		ep.ChaptersType = ch.attr("type")
+	}
+	if psc := item.child(nsPSC, "chapters"); psc != nil {
+		for _, c := range psc.children(nsPSC, "chapter") {
+			ep.Chapters = append(ep.Chapters, Chapter{
+				Start:    ParseDuration(c.attr("start")).Seconds(),
+				Title:    c.attr("title"),
+				URL:      c.attr("href"),
+				ImageURL: c.attr("image"),
+			})
+		}
+	}
+	for _, t := range item.children(nsPodcast, "transcript") {
+		if t.attr("url") == "" {
+			continue
+		}
+		ep.Transcripts = append(ep.Transcripts, Transcript{
+			URL:      t.attr("url"),
+			Type:     t.attr("type"),
+			Language: t.attr("language"),
+			Rel:      t.attr("rel"),
+		})
+	}
+
+	// Items without a guid are identified by their media file
+	ep.GUID = firstNonEmpty(item.text("", "guid"), ep.Enclosure.URL)
+	return ep, true
+}
+
+// itunesCategories returns the iTunes categories of a channel, top-level
+// categories before their subcategories.
+func itunesCategories(ch *node) []string {
+	var categories []string
+	for _, c := range ch.children(nsITunes, "category") {
+		if name := strings.TrimSpace(c.attr("text")); name != "" {
+			categories = append(categories, name)
+		}
+		for _, sub := range c.children(nsITunes, "category") {
+			if name := strings.TrimSpace(sub.attr("text")); name != "" {
+				categories = append(categories, name)
+			}
+		}
+	}
+	return categories
+}
+
+// =============================================================================
+// Atom
+// =============================================================================
+
+func parseAtom(root *node) *Feed {
+	f := &Feed{
+		Title:       root.text(nsAtom, "title"),
+		Description: root.text(nsAtom, "subtitle"),
+		Link:        atomLink(root, "alternate"),
+		ImageURL:    firstNonEmpty(root.text(nsAtom, "logo"), root.text(nsAtom, "icon")),
+		Language:    root.attr("lang"),
+		Explicit:    parseExplicit(root.text(nsITunes, "explicit")),
+		GUID:        root.text(nsPodcast, "guid"),
+	}
+	if author := root.child(nsAtom, "author"); author != nil {
+		f.Author = author.text(nsAtom, "name")
+	}
+	if img := root.child(nsITunes, "image"); img != nil && f.ImageURL == "" {
+		f.ImageURL = img.attr("href")
+	}
+	for _, c := range root.children(nsAtom, "category") {
+		if term := firstNonEmpty(c.attr("label"), c.attr("term")); term != "" {
+			f.Categories = append(f.Categories, term)
+		}
+	}
+	f.Categories = append(f.Categories, itunesCategories(root)...)
+
+	for _, entry := range root.children(nsAtom, "entry") {
+		if ep, ok := parseAtomEntry(entry); ok {
+			f.Episodes = append(f.Episodes, ep)
+		}
+	}
+	return f
+}
+
+func parseAtomEntry(entry *node) (Episode, bool) {
+	var enc *node
+	for _, l := range entry.children(nsAtom, "link") {
+		if l.attr("rel") == "enclosure" && l.attr("href") != "" {
+			enc = l
+			break
+		}
+	}
+	if enc == nil {
+		return Episode{}, false
+	}
+	length, _ := strconv.ParseInt(enc.attr("length"), 10, 64)
+	ep := Episode{
+		GUID:        firstNonEmpty(entry.text(nsAtom, "id"), enc.attr("href")),
+		Title:       entry.text(nsAtom, "title"),
+		Description: firstNonEmpty(entry.text(nsAtom, "content"), entry.text(nsAtom, "summary")),
+		Link:        atomLink(entry, "alternate"),
+		PublishedAt: ParseDate(firstNonEmpty(entry.text(nsAtom, "published"), entry.text(nsAtom, "updated"))),
+		Duration:    ParseDuration(entry.text(nsITunes, "duration")),
+		Season:      firstPositive(entry.text(nsPodcast, "season"), entry.text(nsITunes, "season")),
+		Number:      firstPositive(entry.text(nsPodcast, "episode"), entry.text(nsITunes, "episode")),
+		EpisodeType: strings.ToLower(entry.text(nsITunes, "episodeType")),
+		Explicit:    parseExplicit(entry.text(nsITunes, "explicit")),
+		Enclosure: Enclosure{
+			URL:    enc.attr("href"),
+			Type:   enc.attr("type"),
+			Length: max(length, 0),
+		},
+	}
+	if img := entry.child(nsITunes, "image"); img != nil {
+		ep.ImageURL = img.attr("href")
+	}
+	if ch := entry.child(nsPodcast, "chapters"); ch != nil {
+		ep.ChaptersURL = ch.attr("url")
+		ep.ChaptersType = ch.attr("type")
+	}
+	for _, t := range entry.children(nsPodcast, "transcript") {
+		if t.attr("url") != "" {
+			ep.Transcripts = append(ep.Transcripts, Transcript{
+				URL:      t.attr("url"),
+				Type:     t.attr("type"),
+				Language: t.attr("language"),
+				Rel:      t.attr("rel"),
+			})
+		}
+	}
+	return ep, true
+}
+
+// atomLink returns the href of the first link with the given relation.
+// Links without rel are alternate links.
+func atomLink(n *node, rel string) string {
+	for _, l := range n.children(nsAtom, "link") {
+		if r := l.attr("rel"); r == rel || (r == "" && rel == "alternate") {
+			return l.attr("href")
+		}
+	}
+	return ""
+}
+
+// =============================================================================
+// Values
+// =============================================================================
+
+// dateLayouts are the publication date formats seen in feeds: RFC 822
+// variants for RSS (with and without weekday or seconds), RFC 3339 for Atom.
+var dateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	"Mon, 2 Jan 2006 15:04 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 MST",
+	"Mon, 2 January 2006 15:04:05 -0700",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// ParseDate parses a feed date. It returns the zero time for dates it
+// cannot read.
+func ParseDate(s string) time.Time {
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return time.Time{}
+	}
+	// "UT" is valid RFC 822 but unknown to the time package
+	if rest, ok := strings.CutSuffix(s, " UT"); ok {
+		s = rest + " UTC"
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+// ParseDuration parses an episode duration or chapter start: plain seconds
+// ("3600", "90.5") or a clock time ("1:02:03", "62:03", "00:01:02.500").
+func ParseDuration(s string) time.Duration {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	var seconds float64
+	for part := range strings.SplitSeq(s, ":") {
+		v, err := strconv.ParseFloat(part, 64)
+		if err != nil || v < 0 {
+			return 0
+		}
+		seconds = seconds*60 + v
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func parseExplicit(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "true", "explicit":
+		return true
+	}
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func firstPositive(values ...string) int {
+	for _, v := range values {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+// =============================================================================
+// XML tree
+// =============================================================================
+
+// node is an element of a parsed feed document. Feeds mix elements of
+// several namespaces with the same local name (title, itunes:title), which
+// struct tags cannot tell apart from unprefixed RSS elements.
+type node struct {
+	Name     xml.Name
+	Attrs    []xml.Attr
+	Text     string
+	Children []*node
+}
+
+// parseTree reads an XML document into a tree of elements.
+func parseTree(data []byte) (*node, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = charset.NewReaderLabel
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+
+	var stack []*node
+	var root *node
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if root != nil && len(stack) == 0 {
+				return root, nil
+			}
+			if root == nil {
+				return nil, fmt.Errorf("%w: %w", ErrNotAFeed, err)
+			}
+			return nil, fmt.Errorf("parse feed: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{Name: t.Name, Attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, n)
+			} else if root == nil {
+				root = n
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			if len(stack) > 0 {
+				n := stack[len(stack)-1]
+				n.Text = strings.TrimSpace(n.Text)
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 && root != nil {
+				return root, nil
+			}
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += string(t)
+			}
+		}
+	}
+}
+
+// child returns the first child element with the given namespace and
+// local name. An empty namespace matches unprefixed elements only.
+func (n *node) child(space, local string) *node {
+	for _, c := range n.Children {
+		if c.Name.Space == space && c.Name.Local == local {
+			return c
+		}
+	}
+	return nil
+}
+
+// children returns all child elements with the given name.
+func (n *node) children(space, local string) []*node {
+	var out []*node
+	for _, c := range n.Children {
+		if c.Name.Space == space && c.Name.Local == local {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// text returns the text of the first matching child element.
+func (n *node) text(space, local string) string {
+	if c := n.child(space, local); c != nil {
+		return c.Text
+	}
+	return ""
+}
+
+// attr returns an attribute value by local name.
+func (n *node) attr(local string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == local {
+			return strings.TrimSpace(a.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,388 @@
+// Package jobs provides background job workers for podcasts. Feeds are
+// refreshed on a schedule; each refresh notifies subscribers of new
+// episodes and queues the downloads its retention rules ask for.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/lusoris/revenge/internal/content/podcast"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Job kinds of the podcast workers.
+const (
+	KindRefresh     = "podcast_refresh"
+	KindRefreshAll  = "podcast_refresh_all"
+	KindDownload    = "podcast_download"
+	KindLibraryScan = "podcast_library_scan"
+)
+
+// jobInserter enqueues jobs in batches. It is satisfied by *infrajobs.Client.
+type jobInserter interface {
+	InsertMany(ctx context.Context, params []river.InsertManyParams) ([]*rivertype.JobInsertResult, error)
+}
+
+// RefreshArgs defines arguments for refreshing one podcast feed.
+type RefreshArgs struct {
+	PodcastID uuid.UUID `json:"podcast_id"`
+}
+
+// Kind returns the job kind identifier.
+func (RefreshArgs) Kind() string {
+	return KindRefresh
+}
+
+// InsertOpts returns the default insert options for feed refreshes.
+func (RefreshArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueDefault,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// RefreshAllArgs triggers a refresh of every subscribed podcast.
+type RefreshAllArgs struct{}
+
+// Kind returns the job kind identifier.
+func (RefreshAllArgs) Kind() string {
+	return KindRefreshAll
+}
+
+// InsertOpts returns the default insert options for scheduled refresh runs.
+func (RefreshAllArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueLow,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// DownloadArgs defines arguments for downloading an episode.
+type DownloadArgs struct {
+	EpisodeID uuid.UUID `json:"episode_id"`
+}
+
+// Kind returns the job kind identifier.
+func (DownloadArgs) Kind() string {
+	return KindDownload
+}
+
+// InsertOpts returns the default insert options for episode downloads.
+// Downloads run on the bulk queue so large files don't hold up other work.
+func (DownloadArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: time.Hour,
+		},
+	}
+}
+
+// LibraryScanArgs defines arguments for podcast library scans. A podcast
+// library holds downloaded episodes only, so a scan refreshes the feeds of
+// the library's subscribed podcasts.
+type LibraryScanArgs struct {
+	// Paths are the library paths. They are recorded for logging only.
+	Paths []string `json:"paths"`
+
+	// LibraryID is the library to scan. Empty scans all podcast libraries.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for podcast library scans.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueDefault,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// RefreshWorker refreshes a podcast feed, notifies subscribers of new
+// episodes and queues downloads under the podcast's retention rules.
+type RefreshWorker struct {
+	river.WorkerDefaults[RefreshArgs]
+	service             podcast.Service
+	jobs                jobInserter
+	notificationService notification.Service
+	logger              *slog.Logger
+}
+
+// NewRefreshWorker creates a new feed refresh worker.
+func NewRefreshWorker(service podcast.Service, jobClient *infrajobs.Client, notificationService notification.Service, logger *slog.Logger) *RefreshWorker {
+	return &RefreshWorker{
+		service:             service,
+		jobs:                jobClient,
+		notificationService: notificationService,
+		logger:              logger.With("component", "podcast_refresh"),
+	}
+}
+
+// Timeout returns the maximum execution time for feed refreshes.
+func (w *RefreshWorker) Timeout(job *river.Job[RefreshArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+// Work executes the feed refresh job. Fetch errors are recorded on the
+// podcast by the service and retried by River.
+func (w *RefreshWorker) Work(ctx context.Context, job *river.Job[RefreshArgs]) error {
+	podcastID := job.Args.PodcastID
+	res, err := w.service.Refresh(ctx, podcastID)
+	if errors.Is(err, podcast.ErrPodcastNotFound) {
+		return river.JobCancel(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(res.NewEpisodes) > 0 {
+		w.logger.Info("new podcast episodes",
+			slog.String("podcast_id", podcastID.String()),
+			slog.Int("count", len(res.NewEpisodes)),
+		)
+		w.notifyNewEpisodes(ctx, res.Podcast, res.NewEpisodes)
+	}
+
+	retention, err := w.service.ApplyRetention(ctx, podcastID)
+	if err != nil {
+		return fmt.Errorf("apply retention: %w", err)
+	}
+	if len(retention.Download) == 0 {
+		return nil
+	}
+	params := make([]river.InsertManyParams, 0, len(retention.Download))
+	for _, ep := range retention.Download {
+		params = append(params, river.InsertManyParams{Args: DownloadArgs{EpisodeID: ep.ID}})
+	}
+	if _, err := w.jobs.InsertMany(ctx, params); err != nil {
+		return fmt.Errorf("queue episode downloads: %w", err)
+	}
+	return nil
+}
+
+// notifyNewEpisodes raises one event for all new episodes of a podcast,
+// listing the subscribers it concerns.
+func (w *RefreshWorker) notifyNewEpisodes(ctx context.Context, p *podcast.Podcast, episodes []podcast.Episode) {
+	if w.notificationService == nil {
+		return
+	}
+	subscribers, err := w.service.ListSubscriberIDs(ctx, p.ID)
+	if err != nil {
+		w.logger.Warn("failed to list podcast subscribers",
+			slog.String("podcast_id", p.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+	if len(subscribers) == 0 {
+		return
+	}
+
+	subscriberIDs := make([]string, len(subscribers))
+	for i, id := range subscribers {
+		subscriberIDs[i] = id.String()
+	}
+	items := make([]map[string]any, len(episodes))
+	for i, ep := range episodes {
+		items[i] = map[string]any{"id": ep.ID.String(), "title": ep.Title}
+	}
+	_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventPodcastEpisodeAdded).
+		WithTarget(p.ID).
+		WithData("podcast_id", p.ID.String()).
+		WithData("podcast_title", p.Title).
+		WithData("episode_count", len(episodes)).
+		WithData("episodes", items).
+		WithData("subscriber_ids", subscriberIDs))
+}
+
+// RefreshAllWorker queues a refresh of every podcast with a subscriber.
+type RefreshAllWorker struct {
+	river.WorkerDefaults[RefreshAllArgs]
+	service podcast.Service
+	jobs    jobInserter
+	logger  *slog.Logger
+}
+
+// NewRefreshAllWorker creates a new scheduled refresh worker.
+func NewRefreshAllWorker(service podcast.Service, jobClient *infrajobs.Client, logger *slog.Logger) *RefreshAllWorker {
+	return &RefreshAllWorker{
+		service: service,
+		jobs:    jobClient,
+		logger:  logger.With("component", "podcast_refresh_all"),
+	}
+}
+
+// Timeout returns the maximum execution time for a scheduled refresh run.
+func (w *RefreshAllWorker) Timeout(job *river.Job[RefreshAllArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+// Work queues the feed refreshes.
+func (w *RefreshAllWorker) Work(ctx context.Context, job *river.Job[RefreshAllArgs]) error {
+	queued, err := queueRefreshes(ctx, w.service, w.jobs, nil)
+	if err != nil {
+		return err
+	}
+	w.logger.Info("queued podcast refreshes", slog.Int("count", queued))
+	return nil
+}
+
+// queueRefreshes queues a refresh of the subscribed podcasts of a library,
+// or of all libraries, and returns how many were queued.
+func queueRefreshes(ctx context.Context, service podcast.Service, jobs jobInserter, libraryID *uuid.UUID) (int, error) {
+	podcasts, err := service.ListSubscribedPodcasts(ctx, libraryID)
+	if err != nil {
+		return 0, fmt.Errorf("list subscribed podcasts: %w", err)
+	}
+	if len(podcasts) == 0 {
+		return 0, nil
+	}
+	params := make([]river.InsertManyParams, 0, len(podcasts))
+	for _, p := range podcasts {
+		params = append(params, river.InsertManyParams{Args: RefreshArgs{PodcastID: p.ID}})
+	}
+	if _, err := jobs.InsertMany(ctx, params); err != nil {
+		return 0, fmt.Errorf("queue podcast refreshes: %w", err)
+	}
+	return len(podcasts), nil
+}
+
+// DownloadWorker downloads an episode into the podcast library.
+type DownloadWorker struct {
+	river.WorkerDefaults[DownloadArgs]
+	service podcast.Service
+	logger  *slog.Logger
+}
+
+// NewDownloadWorker creates a new episode download worker.
+func NewDownloadWorker(service podcast.Service, logger *slog.Logger) *DownloadWorker {
+	return &DownloadWorker{
+		service: service,
+		logger:  logger.With("component", "podcast_download"),
+	}
+}
+
+// Timeout returns the maximum execution time for episode downloads.
+func (w *DownloadWorker) Timeout(job *river.Job[DownloadArgs]) time.Duration {
+	return 2 * time.Hour
+}
+
+// Work executes the episode download job.
+func (w *DownloadWorker) Work(ctx context.Context, job *river.Job[DownloadArgs]) error {
+	ep, err := w.service.DownloadEpisode(ctx, job.Args.EpisodeID)
+	switch {
+	case errors.Is(err, podcast.ErrEpisodeNotFound), errors.Is(err, podcast.ErrPodcastNotFound),
+		errors.Is(err, podcast.ErrNoDownloadDirectory):
+		return river.JobCancel(err)
+	case err != nil:
+		return err
+	}
+	w.logger.Info("downloaded podcast episode",
+		slog.String("episode_id", ep.ID.String()),
+		slog.String("path", *ep.FilePath),
+	)
+	return nil
+}
+
+// LibraryScanWorker scans podcast libraries by refreshing their feeds.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             podcast.Service
+	jobs                jobInserter
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service podcast.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobs:                jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "podcast_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 5 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(slog.Any("paths", job.Args.Paths))
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, library.LibraryTypePodcast, job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+	w.dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+		WithData("library_id", libIDStr).
+		WithData("scan_id", scanIDStr).
+		WithData("library_type", library.LibraryTypePodcast))
+
+	start := time.Now()
+	status.Start(ctx)
+
+	queued, err := queueRefreshes(ctx, w.service, w.jobs, job.Args.LibraryID)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		w.logger.Error("podcast library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	jctx.LogComplete(slog.Int("refreshes_queued", queued))
+
+	status.Complete(ctx, &library.ScanProgress{ItemsScanned: util.SafeIntToInt32(queued)})
+
+	w.dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+		WithData("library_id", libIDStr).
+		WithData("scan_id", scanIDStr).
+		WithData("library_type", library.LibraryTypePodcast).
+		WithData("items_processed", queued).
+		WithData("scan_duration", time.Since(start).String()))
+
+	return nil
+}
+
+// dispatch sends an event when notifications are configured.
+func (w *LibraryScanWorker) dispatch(ctx context.Context, event *notification.Event) {
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, event)
+	}
+}
@@ -0,0 +1,132 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// fakeService serves canned refresh and retention results.
+type fakeService struct {
+	podcast.Service
+	refresh     *podcast.RefreshResult
+	refreshErr  error
+	retention   *podcast.RetentionResult
+	subscribers []uuid.UUID
+	subscribed  []podcast.Podcast
+}
+
+func (s *fakeService) Refresh(context.Context, uuid.UUID) (*podcast.RefreshResult, error) {
+	return s.refresh, s.refreshErr
+}
+
+func (s *fakeService) ApplyRetention(context.Context, uuid.UUID) (*podcast.RetentionResult, error) {
+	return s.retention, nil
+}
+
+func (s *fakeService) ListSubscriberIDs(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	return s.subscribers, nil
+}
+
+func (s *fakeService) ListSubscribedPodcasts(context.Context, *uuid.UUID) ([]podcast.Podcast, error) {
+	return s.subscribed, nil
+}
+
+// recordingInserter records inserted job args.
+type recordingInserter struct {
+	args []river.JobArgs
+}
+
+func (r *recordingInserter) InsertMany(_ context.Context, params []river.InsertManyParams) ([]*rivertype.JobInsertResult, error) {
+	for _, p := range params {
+		r.args = append(r.args, p.Args)
+	}
+	return nil, nil
+}
+
+// recordingNotifier records dispatched events.
+type recordingNotifier struct {
+	notification.Service
+	events []*notification.Event
+}
+
+func (n *recordingNotifier) Dispatch(_ context.Context, event *notification.Event) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+func TestRefreshWorker_NewEpisodes(t *testing.T) {
+	p := &podcast.Podcast{ID: uuid.New(), Title: "The Test Show"}
+	newEp := podcast.Episode{ID: uuid.New(), Title: "Episode 3"}
+	download := podcast.Episode{ID: uuid.New()}
+	subscriber := uuid.New()
+
+	svc := &fakeService{
+		refresh:     &podcast.RefreshResult{Podcast: p, NewEpisodes: []podcast.Episode{newEp}},
+		retention:   &podcast.RetentionResult{Download: []podcast.Episode{download}},
+		subscribers: []uuid.UUID{subscriber},
+	}
+	inserter := &recordingInserter{}
+	notifier := &recordingNotifier{}
+	w := &RefreshWorker{service: svc, jobs: inserter, notificationService: notifier, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), &river.Job[RefreshArgs]{JobRow: &rivertype.JobRow{}, Args: RefreshArgs{PodcastID: p.ID}})
+	require.NoError(t, err)
+
+	require.Len(t, notifier.events, 1)
+	event := notifier.events[0]
+	assert.Equal(t, notification.EventPodcastEpisodeAdded, event.Type)
+	assert.Equal(t, p.ID, *event.TargetID)
+	assert.Equal(t, 1, event.Data["episode_count"])
+	assert.Equal(t, []string{subscriber.String()}, event.Data["subscriber_ids"])
+
+	assert.Equal(t, []river.JobArgs{DownloadArgs{EpisodeID: download.ID}}, inserter.args)
+}
+
+func TestRefreshWorker_NoSubscribersNoEvent(t *testing.T) {
+	p := &podcast.Podcast{ID: uuid.New()}
+	svc := &fakeService{
+		refresh:   &podcast.RefreshResult{Podcast: p, NewEpisodes: []podcast.Episode{{ID: uuid.New()}}},
+		retention: &podcast.RetentionResult{},
+	}
+	inserter := &recordingInserter{}
+	notifier := &recordingNotifier{}
+	w := &RefreshWorker{service: svc, jobs: inserter, notificationService: notifier, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), &river.Job[RefreshArgs]{JobRow: &rivertype.JobRow{}, Args: RefreshArgs{PodcastID: p.ID}})
+	require.NoError(t, err)
+	assert.Empty(t, notifier.events)
+	assert.Empty(t, inserter.args)
+}
+
+func TestRefreshWorker_DeletedPodcastCancels(t *testing.T) {
+	svc := &fakeService{refreshErr: podcast.ErrPodcastNotFound}
+	w := &RefreshWorker{service: svc, jobs: &recordingInserter{}, logger: logging.NewTestLogger()}
+
+	err := w.Work(context.Background(), &river.Job[RefreshArgs]{JobRow: &rivertype.JobRow{}, Args: RefreshArgs{PodcastID: uuid.New()}})
+	var cancel *river.JobCancelError
+	assert.True(t, errors.As(err, &cancel))
+}
+
+func TestRefreshAllWorker(t *testing.T) {
+	a, b := podcast.Podcast{ID: uuid.New()}, podcast.Podcast{ID: uuid.New()}
+	inserter := &recordingInserter{}
+	w := &RefreshAllWorker{
+		service: &fakeService{subscribed: []podcast.Podcast{a, b}},
+		jobs:    inserter,
+		logger:  logging.NewTestLogger(),
+	}
+
+	require.NoError(t, w.Work(context.Background(), &river.Job[RefreshAllArgs]{JobRow: &rivertype.JobRow{}}))
+	assert.Equal(t, []river.JobArgs{RefreshArgs{PodcastID: a.ID}, RefreshArgs{PodcastID: b.ID}}, inserter.args)
+}
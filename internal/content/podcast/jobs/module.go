@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"log/slog"
+
+	"github.com/riverqueue/river"
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/podcast"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// Module provides the podcast jobs workers.
+var Module = fx.Module("podcastjobs",
+	fx.Provide(
+		provideRefreshWorker,
+		provideRefreshAllWorker,
+		provideDownloadWorker,
+		provideLibraryScanWorker,
+	),
+	fx.Invoke(RegisterWorkers),
+)
+
+// WorkerProviderParams holds common dependencies for worker providers.
+type WorkerProviderParams struct {
+	fx.In
+
+	Service             podcast.Service
+	JobClient           *infrajobs.Client
+	NotificationService notification.Service
+	ScanStatusService   *library.Service `optional:"true"`
+	Logger              *slog.Logger
+}
+
+// provideRefreshWorker creates a feed refresh worker.
+func provideRefreshWorker(p WorkerProviderParams) *RefreshWorker {
+	return NewRefreshWorker(p.Service, p.JobClient, p.NotificationService, p.Logger)
+}
+
+// provideRefreshAllWorker creates a scheduled refresh worker.
+func provideRefreshAllWorker(p WorkerProviderParams) *RefreshAllWorker {
+	return NewRefreshAllWorker(p.Service, p.JobClient, p.Logger)
+}
+
+// provideDownloadWorker creates an episode download worker.
+func provideDownloadWorker(p WorkerProviderParams) *DownloadWorker {
+	return NewDownloadWorker(p.Service, p.Logger)
+}
+
+// provideLibraryScanWorker creates a library scan worker.
+func provideLibraryScanWorker(p WorkerProviderParams) *LibraryScanWorker {
+	return NewLibraryScanWorker(p.Service, p.JobClient, p.NotificationService, p.ScanStatusService, p.Logger)
+}
+
+// RegisterWorkers registers all podcast job workers with the River workers registry.
+func RegisterWorkers(
+	workers *river.Workers,
+	refreshWorker *RefreshWorker,
+	refreshAllWorker *RefreshAllWorker,
+	downloadWorker *DownloadWorker,
+	libraryScanWorker *LibraryScanWorker,
+) error {
+	river.AddWorker(workers, refreshWorker)
+	river.AddWorker(workers, refreshAllWorker)
+	river.AddWorker(workers, downloadWorker)
+	river.AddWorker(workers, libraryScanWorker)
+	return nil
+}
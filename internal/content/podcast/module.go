@@ -0,0 +1,32 @@
+package podcast
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/podcast/feed"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// Module provides the podcast content module.
+var Module = fx.Module("podcast",
+	fx.Provide(
+		NewPostgresRepository,
+		provideService,
+	),
+)
+
+// provideService creates the podcast service. Episodes are downloaded into
+// the podcast libraries managed by the library service.
+func provideService(repo Repository, libraries *library.Service, cfg *config.Config, logger *slog.Logger) Service {
+	client := feed.NewClient(nil)
+	client.SetDownloadLimits(feed.DownloadLimits{
+		MaxSize: cfg.Podcast.MaxDownloadSize,
+		Timeout: cfg.Podcast.DownloadTimeout,
+	})
+	return NewService(repo, libraries, client, Config{
+		KeepEpisodes: cfg.Podcast.KeepEpisodes,
+	}, logger)
+}
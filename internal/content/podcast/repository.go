@@ -0,0 +1,107 @@
+package podcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/podcast/feed"
+)
+
+// Repository defines database operations for podcasts
+type Repository interface {
+	// Podcasts
+	GetPodcast(ctx context.Context, id uuid.UUID) (*Podcast, error)
+	GetPodcastByFeedURL(ctx context.Context, feedURL string) (*Podcast, error)
+	CreatePodcast(ctx context.Context, params CreatePodcastParams) (*Podcast, error)
+	UpdatePodcastFeed(ctx context.Context, id uuid.UUID, params FeedParams) (*Podcast, error)
+	MarkPodcastChecked(ctx context.Context, id uuid.UUID, lastError *string) error
+	UpdatePodcastSettings(ctx context.Context, id uuid.UUID, autoDownload bool, keepEpisodes *int32) (*Podcast, error)
+	ListPodcasts(ctx context.Context, filters PodcastListFilters) ([]Podcast, error)
+	CountPodcasts(ctx context.Context, filters PodcastListFilters) (int64, error)
+	ListSubscribedPodcasts(ctx context.Context, libraryID *uuid.UUID) ([]Podcast, error)
+
+	// Subscriptions
+	Subscribe(ctx context.Context, userID, podcastID uuid.UUID) error
+	Unsubscribe(ctx context.Context, userID, podcastID uuid.UUID) (bool, error)
+	IsSubscribed(ctx context.Context, userID, podcastID uuid.UUID) (bool, error)
+	ListSubscriberIDs(ctx context.Context, podcastID uuid.UUID) ([]uuid.UUID, error)
+
+	// Episodes
+	GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
+	UpsertEpisode(ctx context.Context, params UpsertEpisodeParams) (*Episode, error)
+	ListEpisodeGUIDs(ctx context.Context, podcastID uuid.UUID) (map[string]bool, error)
+	ListEpisodes(ctx context.Context, podcastID uuid.UUID, limit, offset int32) ([]Episode, error)
+	CountEpisodes(ctx context.Context, podcastID uuid.UUID) (int64, error)
+	ListDownloadedEpisodes(ctx context.Context, podcastID uuid.UUID) ([]Episode, error)
+	ListNewEpisodes(ctx context.Context, userID uuid.UUID, limit int32) ([]InboxItem, error)
+	ListLatestEpisodes(ctx context.Context, libraryID uuid.UUID, limit int32) ([]LatestEpisode, error)
+	SetEpisodeDownloaded(ctx context.Context, params SetDownloadedParams) (*Episode, error)
+	SetEpisodeDownloadError(ctx context.Context, id uuid.UUID, downloadError string) error
+	ClearEpisodeDownload(ctx context.Context, id uuid.UUID) error
+	SetEpisodePinned(ctx context.Context, id uuid.UUID, pinned bool) error
+
+	// Listening progress
+	UpsertProgress(ctx context.Context, userID, episodeID uuid.UUID, positionSeconds int32, isPlayed bool) (*Progress, error)
+	GetProgress(ctx context.Context, userID, episodeID uuid.UUID) (*Progress, error)
+	ListProgress(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) ([]Progress, error)
+	ListContinueListening(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueListeningItem, error)
+	DeleteProgress(ctx context.Context, userID, episodeID uuid.UUID) error
+}
+
+// FeedParams contains the feed metadata and validators stored by a fetch
+type FeedParams struct {
+	Title        string
+	SortTitle    string
+	Author       *string
+	Description  *string
+	Link         *string
+	ImageURL     *string
+	Language     *string
+	Explicit     bool
+	Categories   []string
+	PodcastGUID  *string
+	ETag         *string
+	LastModified *string
+}
+
+// CreatePodcastParams contains parameters for creating a podcast
+type CreatePodcastParams struct {
+	FeedParams
+	LibraryID uuid.UUID
+	FeedURL   string
+}
+
+// UpsertEpisodeParams contains parameters for creating or updating an
+// episode from its feed item
+type UpsertEpisodeParams struct {
+	PodcastID       uuid.UUID
+	GUID            string
+	Title           string
+	Description     *string
+	Link            *string
+	ImageURL        *string
+	PublishedAt     *time.Time
+	DurationSeconds int32
+	Season          *int32
+	EpisodeNumber   *int32
+	EpisodeType     string
+	Explicit        bool
+	EnclosureURL    string
+	EnclosureType   *string
+	EnclosureLength int64
+	ChaptersURL     *string
+	Chapters        []feed.Chapter // nil keeps the stored chapters
+	Transcripts     []feed.Transcript
+}
+
+// SetDownloadedParams contains parameters for recording a finished download
+type SetDownloadedParams struct {
+	ID             uuid.UUID
+	FilePath       string
+	FileSize       int64
+	Chapters       []feed.Chapter // nil keeps the stored chapters
+	TranscriptPath *string
+	TranscriptType *string
+}
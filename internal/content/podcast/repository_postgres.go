@@ -0,0 +1,537 @@
+package podcast
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	podcastdb "github.com/lusoris/revenge/internal/content/podcast/db"
+	"github.com/lusoris/revenge/internal/content/podcast/feed"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *podcastdb.Queries
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: podcastdb.New(pool),
+	}
+}
+
+// =============================================================================
+// Podcasts
+// =============================================================================
+
+func (r *postgresRepository) GetPodcast(ctx context.Context, id uuid.UUID) (*Podcast, error) {
+	p, err := r.queries.GetPodcast(ctx, id)
+	if err != nil {
+		return nil, podcastError(err)
+	}
+	return dbPodcastToPodcast(p), nil
+}
+
+func (r *postgresRepository) GetPodcastByFeedURL(ctx context.Context, feedURL string) (*Podcast, error) {
+	p, err := r.queries.GetPodcastByFeedURL(ctx, feedURL)
+	if err != nil {
+		return nil, podcastError(err)
+	}
+	return dbPodcastToPodcast(p), nil
+}
+
+func (r *postgresRepository) CreatePodcast(ctx context.Context, params CreatePodcastParams) (*Podcast, error) {
+	p, err := r.queries.CreatePodcast(ctx, podcastdb.CreatePodcastParams{
+		LibraryID:    params.LibraryID,
+		FeedUrl:      params.FeedURL,
+		Title:        params.Title,
+		SortTitle:    params.SortTitle,
+		Author:       params.Author,
+		Description:  params.Description,
+		Link:         params.Link,
+		ImageUrl:     params.ImageURL,
+		Language:     params.Language,
+		Explicit:     params.Explicit,
+		Categories:   nonNilStrings(params.Categories),
+		PodcastGuid:  params.PodcastGUID,
+		Etag:         params.ETag,
+		LastModified: params.LastModified,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create podcast: %w", err)
+	}
+	return dbPodcastToPodcast(p), nil
+}
+
+func (r *postgresRepository) UpdatePodcastFeed(ctx context.Context, id uuid.UUID, params FeedParams) (*Podcast, error) {
+	p, err := r.queries.UpdatePodcastFeed(ctx, podcastdb.UpdatePodcastFeedParams{
+		ID:           id,
+		Title:        params.Title,
+		SortTitle:    params.SortTitle,
+		Author:       params.Author,
+		Description:  params.Description,
+		Link:         params.Link,
+		ImageUrl:     params.ImageURL,
+		Language:     params.Language,
+		Explicit:     params.Explicit,
+		Categories:   nonNilStrings(params.Categories),
+		PodcastGuid:  params.PodcastGUID,
+		Etag:         params.ETag,
+		LastModified: params.LastModified,
+	})
+	if err != nil {
+		return nil, podcastError(err)
+	}
+	return dbPodcastToPodcast(p), nil
+}
+
+func (r *postgresRepository) MarkPodcastChecked(ctx context.Context, id uuid.UUID, lastError *string) error {
+	return r.queries.MarkPodcastChecked(ctx, podcastdb.MarkPodcastCheckedParams{ID: id, LastError: lastError})
+}
+
+func (r *postgresRepository) UpdatePodcastSettings(ctx context.Context, id uuid.UUID, autoDownload bool, keepEpisodes *int32) (*Podcast, error) {
+	p, err := r.queries.UpdatePodcastSettings(ctx, podcastdb.UpdatePodcastSettingsParams{
+		ID:           id,
+		AutoDownload: autoDownload,
+		KeepEpisodes: keepEpisodes,
+	})
+	if err != nil {
+		return nil, podcastError(err)
+	}
+	return dbPodcastToPodcast(p), nil
+}
+
+func (r *postgresRepository) ListPodcasts(ctx context.Context, filters PodcastListFilters) ([]Podcast, error) {
+	rows, err := r.queries.ListPodcasts(ctx, podcastdb.ListPodcastsParams{
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		SubscribedBy: uuidToPg(filters.SubscribedBy),
+		LibraryID:    uuidToPg(filters.LibraryID),
+		Query:        filters.Query,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list podcasts: %w", err)
+	}
+	return dbPodcastsToPodcasts(rows), nil
+}
+
+func (r *postgresRepository) CountPodcasts(ctx context.Context, filters PodcastListFilters) (int64, error) {
+	return r.queries.CountPodcasts(ctx, podcastdb.CountPodcastsParams{
+		SubscribedBy: uuidToPg(filters.SubscribedBy),
+		LibraryID:    uuidToPg(filters.LibraryID),
+		Query:        filters.Query,
+	})
+}
+
+func (r *postgresRepository) ListSubscribedPodcasts(ctx context.Context, libraryID *uuid.UUID) ([]Podcast, error) {
+	rows, err := r.queries.ListSubscribedPodcasts(ctx, uuidToPg(libraryID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscribed podcasts: %w", err)
+	}
+	return dbPodcastsToPodcasts(rows), nil
+}
+
+// =============================================================================
+// Subscriptions
+// =============================================================================
+
+func (r *postgresRepository) Subscribe(ctx context.Context, userID, podcastID uuid.UUID) error {
+	return r.queries.Subscribe(ctx, podcastdb.SubscribeParams{UserID: userID, PodcastID: podcastID})
+}
+
+func (r *postgresRepository) Unsubscribe(ctx context.Context, userID, podcastID uuid.UUID) (bool, error) {
+	n, err := r.queries.Unsubscribe(ctx, podcastdb.UnsubscribeParams{UserID: userID, PodcastID: podcastID})
+	if err != nil {
+		return false, fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *postgresRepository) IsSubscribed(ctx context.Context, userID, podcastID uuid.UUID) (bool, error) {
+	return r.queries.IsSubscribed(ctx, podcastdb.IsSubscribedParams{UserID: userID, PodcastID: podcastID})
+}
+
+func (r *postgresRepository) ListSubscriberIDs(ctx context.Context, podcastID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListSubscriberIDs(ctx, podcastID)
+}
+
+// =============================================================================
+// Episodes
+// =============================================================================
+
+func (r *postgresRepository) GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error) {
+	e, err := r.queries.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, episodeError(err)
+	}
+	return dbEpisodeToEpisode(e), nil
+}
+
+func (r *postgresRepository) UpsertEpisode(ctx context.Context, params UpsertEpisodeParams) (*Episode, error) {
+	chapters, err := marshalChapters(params.Chapters)
+	if err != nil {
+		return nil, err
+	}
+	transcripts, err := json.Marshal(nonNilTranscripts(params.Transcripts))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transcripts: %w", err)
+	}
+	e, err := r.queries.UpsertEpisode(ctx, podcastdb.UpsertEpisodeParams{
+		PodcastID:       params.PodcastID,
+		Guid:            params.GUID,
+		Title:           params.Title,
+		Description:     params.Description,
+		Link:            params.Link,
+		ImageUrl:        params.ImageURL,
+		PublishedAt:     timeToPg(params.PublishedAt),
+		DurationSeconds: params.DurationSeconds,
+		Season:          params.Season,
+		EpisodeNumber:   params.EpisodeNumber,
+		EpisodeType:     params.EpisodeType,
+		Explicit:        params.Explicit,
+		EnclosureUrl:    params.EnclosureURL,
+		EnclosureType:   params.EnclosureType,
+		EnclosureLength: params.EnclosureLength,
+		ChaptersUrl:     params.ChaptersURL,
+		Chapters:        chapters,
+		Transcripts:     transcripts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert episode: %w", err)
+	}
+	return dbEpisodeToEpisode(e), nil
+}
+
+// ListEpisodeGUIDs returns the guids of a podcast's stored episodes.
+func (r *postgresRepository) ListEpisodeGUIDs(ctx context.Context, podcastID uuid.UUID) (map[string]bool, error) {
+	guids, err := r.queries.ListEpisodeGUIDs(ctx, podcastID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list episode guids: %w", err)
+	}
+	known := make(map[string]bool, len(guids))
+	for _, guid := range guids {
+		known[guid] = true
+	}
+	return known, nil
+}
+
+func (r *postgresRepository) ListEpisodes(ctx context.Context, podcastID uuid.UUID, limit, offset int32) ([]Episode, error) {
+	rows, err := r.queries.ListEpisodes(ctx, podcastdb.ListEpisodesParams{
+		PodcastID: podcastID,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list episodes: %w", err)
+	}
+	return dbEpisodesToEpisodes(rows), nil
+}
+
+func (r *postgresRepository) CountEpisodes(ctx context.Context, podcastID uuid.UUID) (int64, error) {
+	return r.queries.CountEpisodes(ctx, podcastID)
+}
+
+func (r *postgresRepository) ListDownloadedEpisodes(ctx context.Context, podcastID uuid.UUID) ([]Episode, error) {
+	rows, err := r.queries.ListDownloadedEpisodes(ctx, podcastID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list downloaded episodes: %w", err)
+	}
+	return dbEpisodesToEpisodes(rows), nil
+}
+
+func (r *postgresRepository) ListNewEpisodes(ctx context.Context, userID uuid.UUID, limit int32) ([]InboxItem, error) {
+	rows, err := r.queries.ListNewEpisodes(ctx, podcastdb.ListNewEpisodesParams{UserID: userID, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list new episodes: %w", err)
+	}
+	items := make([]InboxItem, len(rows))
+	for i, row := range rows {
+		items[i] = InboxItem{
+			Episode: *dbEpisodeToEpisode(row.PodcastEpisode),
+			Podcast: *dbPodcastToPodcast(row.PodcastPodcast),
+		}
+	}
+	return items, nil
+}
+
+func (r *postgresRepository) ListLatestEpisodes(ctx context.Context, libraryID uuid.UUID, limit int32) ([]LatestEpisode, error) {
+	rows, err := r.queries.ListLatestEpisodes(ctx, podcastdb.ListLatestEpisodesParams{LibraryID: libraryID, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list latest episodes: %w", err)
+	}
+	items := make([]LatestEpisode, len(rows))
+	for i, row := range rows {
+		items[i] = LatestEpisode{
+			Episode: *dbEpisodeToEpisode(row.PodcastEpisode),
+			Podcast: *dbPodcastToPodcast(row.PodcastPodcast),
+		}
+	}
+	return items, nil
+}
+
+func (r *postgresRepository) SetEpisodeDownloaded(ctx context.Context, params SetDownloadedParams) (*Episode, error) {
+	chapters, err := marshalChapters(params.Chapters)
+	if err != nil {
+		return nil, err
+	}
+	e, err := r.queries.SetEpisodeDownloaded(ctx, podcastdb.SetEpisodeDownloadedParams{
+		ID:             params.ID,
+		FilePath:       &params.FilePath,
+		FileSize:       params.FileSize,
+		TranscriptPath: params.TranscriptPath,
+		TranscriptType: params.TranscriptType,
+		Chapters:       chapters,
+	})
+	if err != nil {
+		return nil, episodeError(err)
+	}
+	return dbEpisodeToEpisode(e), nil
+}
+
+func (r *postgresRepository) SetEpisodeDownloadError(ctx context.Context, id uuid.UUID, downloadError string) error {
+	return r.queries.SetEpisodeDownloadError(ctx, podcastdb.SetEpisodeDownloadErrorParams{ID: id, DownloadError: &downloadError})
+}
+
+func (r *postgresRepository) ClearEpisodeDownload(ctx context.Context, id uuid.UUID) error {
+	return r.queries.ClearEpisodeDownload(ctx, id)
+}
+
+func (r *postgresRepository) SetEpisodePinned(ctx context.Context, id uuid.UUID, pinned bool) error {
+	return r.queries.SetEpisodePinned(ctx, podcastdb.SetEpisodePinnedParams{ID: id, Pinned: pinned})
+}
+
+// =============================================================================
+// Listening progress
+// =============================================================================
+
+func (r *postgresRepository) UpsertProgress(ctx context.Context, userID, episodeID uuid.UUID, positionSeconds int32, isPlayed bool) (*Progress, error) {
+	p, err := r.queries.UpsertEpisodeProgress(ctx, podcastdb.UpsertEpisodeProgressParams{
+		UserID:          userID,
+		EpisodeID:       episodeID,
+		PositionSeconds: positionSeconds,
+		IsPlayed:        isPlayed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) GetProgress(ctx context.Context, userID, episodeID uuid.UUID) (*Progress, error) {
+	p, err := r.queries.GetEpisodeProgress(ctx, podcastdb.GetEpisodeProgressParams{UserID: userID, EpisodeID: episodeID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProgressNotFound
+		}
+		return nil, fmt.Errorf("failed to get progress: %w", err)
+	}
+	return dbProgressToProgress(p), nil
+}
+
+func (r *postgresRepository) ListProgress(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) ([]Progress, error) {
+	rows, err := r.queries.ListEpisodeProgress(ctx, podcastdb.ListEpisodeProgressParams{UserID: userID, EpisodeIds: episodeIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list progress: %w", err)
+	}
+	progress := make([]Progress, len(rows))
+	for i, p := range rows {
+		progress[i] = *dbProgressToProgress(p)
+	}
+	return progress, nil
+}
+
+func (r *postgresRepository) ListContinueListening(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueListeningItem, error) {
+	rows, err := r.queries.ListContinueListening(ctx, podcastdb.ListContinueListeningParams{
+		Limit:      limit,
+		UserID:     userID,
+		Restrict:   libraryIDs != nil,
+		LibraryIds: libraryIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list continue listening: %w", err)
+	}
+	items := make([]ContinueListeningItem, len(rows))
+	for i, row := range rows {
+		items[i] = ContinueListeningItem{
+			Episode:  *dbEpisodeToEpisode(row.PodcastEpisode),
+			Podcast:  *dbPodcastToPodcast(row.PodcastPodcast),
+			Progress: *dbProgressToProgress(row.PodcastEpisodeProgress),
+		}
+	}
+	return items, nil
+}
+
+func (r *postgresRepository) DeleteProgress(ctx context.Context, userID, episodeID uuid.UUID) error {
+	return r.queries.DeleteEpisodeProgress(ctx, podcastdb.DeleteEpisodeProgressParams{UserID: userID, EpisodeID: episodeID})
+}
+
+// =============================================================================
+// Conversions
+// =============================================================================
+
+func podcastError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrPodcastNotFound
+	}
+	return fmt.Errorf("failed to get podcast: %w", err)
+}
+
+func episodeError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrEpisodeNotFound
+	}
+	return fmt.Errorf("failed to get episode: %w", err)
+}
+
+func dbPodcastToPodcast(p podcastdb.PodcastPodcast) *Podcast {
+	return &Podcast{
+		ID:            p.ID,
+		LibraryID:     p.LibraryID,
+		FeedURL:       p.FeedUrl,
+		Title:         p.Title,
+		SortTitle:     p.SortTitle,
+		Author:        p.Author,
+		Description:   p.Description,
+		Link:          p.Link,
+		ImageURL:      p.ImageUrl,
+		Language:      p.Language,
+		Explicit:      p.Explicit,
+		Categories:    p.Categories,
+		PodcastGUID:   p.PodcastGuid,
+		ETag:          p.Etag,
+		LastModified:  p.LastModified,
+		LastCheckedAt: pgToTime(p.LastCheckedAt),
+		LastError:     p.LastError,
+		AutoDownload:  p.AutoDownload,
+		KeepEpisodes:  p.KeepEpisodes,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+func dbPodcastsToPodcasts(rows []podcastdb.PodcastPodcast) []Podcast {
+	podcasts := make([]Podcast, len(rows))
+	for i, p := range rows {
+		podcasts[i] = *dbPodcastToPodcast(p)
+	}
+	return podcasts
+}
+
+func dbEpisodeToEpisode(e podcastdb.PodcastEpisode) *Episode {
+	episode := &Episode{
+		ID:              e.ID,
+		PodcastID:       e.PodcastID,
+		GUID:            e.Guid,
+		Title:           e.Title,
+		Description:     e.Description,
+		Link:            e.Link,
+		ImageURL:        e.ImageUrl,
+		PublishedAt:     pgToTime(e.PublishedAt),
+		DurationSeconds: e.DurationSeconds,
+		Season:          e.Season,
+		EpisodeNumber:   e.EpisodeNumber,
+		EpisodeType:     e.EpisodeType,
+		Explicit:        e.Explicit,
+		EnclosureURL:    e.EnclosureUrl,
+		EnclosureType:   e.EnclosureType,
+		EnclosureLength: e.EnclosureLength,
+		ChaptersURL:     e.ChaptersUrl,
+		TranscriptPath:  e.TranscriptPath,
+		TranscriptType:  e.TranscriptType,
+		FilePath:        e.FilePath,
+		FileSize:        e.FileSize,
+		DownloadedAt:    pgToTime(e.DownloadedAt),
+		DownloadError:   e.DownloadError,
+		Pinned:          e.Pinned,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+	// Malformed JSON is treated as absent; it was written by this package
+	if len(e.Chapters) > 0 {
+		_ = json.Unmarshal(e.Chapters, &episode.Chapters)
+	}
+	if len(e.Transcripts) > 0 {
+		_ = json.Unmarshal(e.Transcripts, &episode.Transcripts)
+	}
+	return episode
+}
+
+func dbEpisodesToEpisodes(rows []podcastdb.PodcastEpisode) []Episode {
+	episodes := make([]Episode, len(rows))
+	for i, e := range rows {
+		episodes[i] = *dbEpisodeToEpisode(e)
+	}
+	return episodes
+}
+
+func dbProgressToProgress(p podcastdb.PodcastEpisodeProgress) *Progress {
+	return &Progress{
+		ID:              p.ID,
+		UserID:          p.UserID,
+		EpisodeID:       p.EpisodeID,
+		PositionSeconds: p.PositionSeconds,
+		IsPlayed:        p.IsPlayed,
+		PlayedAt:        pgToTime(p.PlayedAt),
+		LastPlayedAt:    p.LastPlayedAt,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+// marshalChapters encodes chapters for storage. Nil stays NULL so that the
+// stored chapters are kept.
+func marshalChapters(chapters []feed.Chapter) ([]byte, error) {
+	if chapters == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(chapters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode chapters: %w", err)
+	}
+	return data, nil
+}
+
+func nonNilTranscripts(t []feed.Transcript) []feed.Transcript {
+	if t == nil {
+		return []feed.Transcript{}
+	}
+	return t
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func timeToPg(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func pgToTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
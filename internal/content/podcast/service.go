@@ -0,0 +1,839 @@
+package podcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/podcast/feed"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// ErrFeedUnavailable is returned when a new feed cannot be fetched or
+// parsed.
+var ErrFeedUnavailable = errors.New("feed unavailable")
+
+// LibraryProvider looks up the library episodes are downloaded into.
+// *library.Service implements it.
+type LibraryProvider interface {
+	Get(ctx context.Context, id uuid.UUID) (*library.Library, error)
+}
+
+// Config holds podcast service settings.
+type Config struct {
+	// KeepEpisodes is the default number of newest episodes kept
+	// downloaded per podcast; 0 keeps all.
+	KeepEpisodes int
+}
+
+// Service defines business logic for podcasts
+type Service interface {
+	// Podcast operations
+	GetPodcast(ctx context.Context, id uuid.UUID) (*Podcast, error)
+	ListPodcasts(ctx context.Context, filters PodcastListFilters) ([]Podcast, int64, error)
+	ListSubscribedPodcasts(ctx context.Context, libraryID *uuid.UUID) ([]Podcast, error)
+	AddPodcast(ctx context.Context, feedURL string, libraryID uuid.UUID) (*Podcast, bool, error)
+	UpdateSettings(ctx context.Context, podcastID uuid.UUID, update SettingsUpdate) (*Podcast, error)
+
+	// Subscriptions
+	Subscribe(ctx context.Context, userID, podcastID uuid.UUID) error
+	Unsubscribe(ctx context.Context, userID, podcastID uuid.UUID) error
+	IsSubscribed(ctx context.Context, userID, podcastID uuid.UUID) (bool, error)
+	ListSubscriberIDs(ctx context.Context, podcastID uuid.UUID) ([]uuid.UUID, error)
+
+	// Episode operations
+	GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
+	ListEpisodes(ctx context.Context, podcastID uuid.UUID, limit, offset int32) ([]Episode, int64, error)
+	ListNewEpisodes(ctx context.Context, userID uuid.UUID, limit int32) ([]InboxItem, error)
+	ListLatestEpisodes(ctx context.Context, libraryID uuid.UUID, limit int32) ([]LatestEpisode, error)
+	OpenTranscript(ctx context.Context, episodeID uuid.UUID) (*Episode, *os.File, error)
+
+	// Feed refresh and downloads
+	Refresh(ctx context.Context, podcastID uuid.UUID) (*RefreshResult, error)
+	DownloadEpisode(ctx context.Context, episodeID uuid.UUID) (*Episode, error)
+	PinEpisode(ctx context.Context, episodeID uuid.UUID) (*Episode, error)
+	DeleteDownload(ctx context.Context, episodeID uuid.UUID) error
+	ApplyRetention(ctx context.Context, podcastID uuid.UUID) (*RetentionResult, error)
+
+	// Listening progress
+	UpdateProgress(ctx context.Context, userID, episodeID uuid.UUID, update ProgressUpdate) (*Progress, error)
+	GetProgress(ctx context.Context, userID, episodeID uuid.UUID) (*Progress, error)
+	ListProgress(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) (map[uuid.UUID]Progress, error)
+	DeleteProgress(ctx context.Context, userID, episodeID uuid.UUID) error
+	ListContinueListening(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueListeningItem, error)
+}
+
+// podcastService implements the Service interface
+type podcastService struct {
+	repo      Repository
+	libraries LibraryProvider
+	client    *feed.Client
+	cfg       Config
+	logger    *slog.Logger
+}
+
+// NewService creates a new podcast service
+func NewService(repo Repository, libraries LibraryProvider, client *feed.Client, cfg Config, logger *slog.Logger) Service {
+	if client == nil {
+		client = feed.NewClient(nil)
+	}
+	return &podcastService{
+		repo:      repo,
+		libraries: libraries,
+		client:    client,
+		cfg:       cfg,
+		logger:    logger.With("component", "podcast"),
+	}
+}
+
+// =============================================================================
+// Podcast Operations
+// =============================================================================
+
+func (s *podcastService) GetPodcast(ctx context.Context, id uuid.UUID) (*Podcast, error) {
+	return s.repo.GetPodcast(ctx, id)
+}
+
+func (s *podcastService) ListPodcasts(ctx context.Context, filters PodcastListFilters) ([]Podcast, int64, error) {
+	filters.Query = strings.TrimSpace(filters.Query)
+	podcasts, err := s.repo.ListPodcasts(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountPodcasts(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return podcasts, count, nil
+}
+
+// ListSubscribedPodcasts returns the podcasts with at least one subscriber,
+// least recently checked first. A nil library lists all of them.
+func (s *podcastService) ListSubscribedPodcasts(ctx context.Context, libraryID *uuid.UUID) ([]Podcast, error) {
+	return s.repo.ListSubscribedPodcasts(ctx, libraryID)
+}
+
+// AddPodcast returns the podcast of a feed, fetching the feed and adding it
+// to the given podcast library when it is new. The back catalog is stored
+// without counting as new episodes. It reports whether the podcast was
+// created.
+func (s *podcastService) AddPodcast(ctx context.Context, feedURL string, libraryID uuid.UUID) (*Podcast, bool, error) {
+	feedURL, err := NormalizeFeedURL(feedURL)
+	if err != nil {
+		return nil, false, err
+	}
+	existing, err := s.repo.GetPodcastByFeedURL(ctx, feedURL)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !errors.Is(err, ErrPodcastNotFound) {
+		return nil, false, err
+	}
+
+	lib, err := s.libraries.Get(ctx, libraryID)
+	if err != nil {
+		return nil, false, err
+	}
+	if lib.Type != library.LibraryTypePodcast {
+		return nil, false, fmt.Errorf("%w: %s is a %s library", ErrInvalidLibrary, lib.Name, lib.Type)
+	}
+
+	res, err := s.client.Fetch(ctx, feedURL, "", "")
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %w", ErrFeedUnavailable, err)
+	}
+	if res.Feed == nil {
+		return nil, false, fmt.Errorf("%w: empty response", ErrFeedUnavailable)
+	}
+
+	params := feedParams(res, feedURL)
+	p, err := s.repo.CreatePodcast(ctx, CreatePodcastParams{
+		FeedParams: params,
+		LibraryID:  lib.ID,
+		FeedURL:    feedURL,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if _, err := s.storeEpisodes(ctx, p.ID, res.Feed.Episodes); err != nil {
+		return nil, false, err
+	}
+	return p, true, nil
+}
+
+// UpdateSettings changes whether new episodes are downloaded and how many
+// are kept.
+func (s *podcastService) UpdateSettings(ctx context.Context, podcastID uuid.UUID, update SettingsUpdate) (*Podcast, error) {
+	p, err := s.repo.GetPodcast(ctx, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	autoDownload := p.AutoDownload
+	if update.AutoDownload != nil {
+		autoDownload = *update.AutoDownload
+	}
+	keep := p.KeepEpisodes
+	if update.KeepEpisodes != nil {
+		keep = update.KeepEpisodes
+		if *keep < 0 {
+			keep = nil
+		}
+	}
+	return s.repo.UpdatePodcastSettings(ctx, podcastID, autoDownload, keep)
+}
+
+// =============================================================================
+// Subscriptions
+// =============================================================================
+
+func (s *podcastService) Subscribe(ctx context.Context, userID, podcastID uuid.UUID) error {
+	if _, err := s.repo.GetPodcast(ctx, podcastID); err != nil {
+		return err
+	}
+	return s.repo.Subscribe(ctx, userID, podcastID)
+}
+
+// Unsubscribe removes a subscription. The podcast and its downloads stay
+// for the other subscribers; feeds without subscribers are no longer
+// refreshed.
+func (s *podcastService) Unsubscribe(ctx context.Context, userID, podcastID uuid.UUID) error {
+	removed, err := s.repo.Unsubscribe(ctx, userID, podcastID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrNotSubscribed
+	}
+	return nil
+}
+
+func (s *podcastService) IsSubscribed(ctx context.Context, userID, podcastID uuid.UUID) (bool, error) {
+	return s.repo.IsSubscribed(ctx, userID, podcastID)
+}
+
+func (s *podcastService) ListSubscriberIDs(ctx context.Context, podcastID uuid.UUID) ([]uuid.UUID, error) {
+	return s.repo.ListSubscriberIDs(ctx, podcastID)
+}
+
+// =============================================================================
+// Episode Operations
+// =============================================================================
+
+func (s *podcastService) GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error) {
+	return s.repo.GetEpisode(ctx, id)
+}
+
+// ListEpisodes returns a podcast's episodes, newest first.
+func (s *podcastService) ListEpisodes(ctx context.Context, podcastID uuid.UUID, limit, offset int32) ([]Episode, int64, error) {
+	if _, err := s.repo.GetPodcast(ctx, podcastID); err != nil {
+		return nil, 0, err
+	}
+	episodes, err := s.repo.ListEpisodes(ctx, podcastID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountEpisodes(ctx, podcastID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return episodes, count, nil
+}
+
+// ListNewEpisodes returns the unplayed episodes of the user's
+// subscriptions, newest first.
+func (s *podcastService) ListNewEpisodes(ctx context.Context, userID uuid.UUID, limit int32) ([]InboxItem, error) {
+	return s.repo.ListNewEpisodes(ctx, userID, limit)
+}
+
+// ListLatestEpisodes returns the episodes of the podcasts in a library,
+// newest first.
+func (s *podcastService) ListLatestEpisodes(ctx context.Context, libraryID uuid.UUID, limit int32) ([]LatestEpisode, error) {
+	return s.repo.ListLatestEpisodes(ctx, libraryID, limit)
+}
+
+// OpenTranscript opens the downloaded transcript of an episode. The caller
+// closes the file.
+func (s *podcastService) OpenTranscript(ctx context.Context, episodeID uuid.UUID) (*Episode, *os.File, error) {
+	ep, err := s.repo.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ep.TranscriptPath == nil {
+		return nil, nil, ErrTranscriptNotFound
+	}
+	f, err := os.Open(*ep.TranscriptPath) // #nosec G304 -- path written by DownloadEpisode
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, ErrTranscriptNotFound
+		}
+		return nil, nil, fmt.Errorf("open transcript: %w", err)
+	}
+	return ep, f, nil
+}
+
+// =============================================================================
+// Feed Refresh and Downloads
+// =============================================================================
+
+// Refresh fetches a podcast's feed with the validators of the last fetch
+// and stores its episodes. Episodes whose guid was not seen before are
+// returned as new. Failed fetches are recorded on the podcast.
+func (s *podcastService) Refresh(ctx context.Context, podcastID uuid.UUID) (*RefreshResult, error) {
+	p, err := s.repo.GetPodcast(ctx, podcastID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Fetch(ctx, p.FeedURL, deref(p.ETag), deref(p.LastModified))
+	if err != nil {
+		msg := err.Error()
+		if markErr := s.repo.MarkPodcastChecked(context.WithoutCancel(ctx), p.ID, &msg); markErr != nil {
+			s.logger.Warn("failed to record feed error", slog.String("podcast_id", p.ID.String()), slog.Any("error", markErr))
+		}
+		return nil, fmt.Errorf("refresh %s: %w", p.FeedURL, err)
+	}
+	if res.NotModified {
+		if err := s.repo.MarkPodcastChecked(ctx, p.ID, nil); err != nil {
+			return nil, err
+		}
+		return &RefreshResult{Podcast: p, NotModified: true}, nil
+	}
+
+	updated, err := s.repo.UpdatePodcastFeed(ctx, p.ID, feedParams(res, p.FeedURL))
+	if err != nil {
+		return nil, err
+	}
+	newEpisodes, err := s.storeEpisodes(ctx, p.ID, res.Feed.Episodes)
+	if err != nil {
+		return nil, err
+	}
+	return &RefreshResult{Podcast: updated, NewEpisodes: newEpisodes}, nil
+}
+
+// DownloadEpisode downloads an episode's audio into the podcast's folder
+// of the library, together with its JSON chapters and preferred
+// transcript. Files are written to a temporary name first, so a failed
+// download never leaves a partial file behind.
+func (s *podcastService) DownloadEpisode(ctx context.Context, episodeID uuid.UUID) (*Episode, error) {
+	ep, err := s.repo.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.repo.GetPodcast(ctx, ep.PodcastID)
+	if err != nil {
+		return nil, err
+	}
+	lib, err := s.libraries.Get(ctx, p.LibraryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lib.Paths) == 0 {
+		return nil, ErrNoDownloadDirectory
+	}
+
+	dir := filepath.Join(lib.Paths[0], SanitizeFileName(p.Title))
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create podcast directory: %w", err)
+	}
+	base := episodeBaseName(ep)
+	target := filepath.Join(dir, base+audioExtension(ep))
+	if target != deref(ep.FilePath) {
+		if _, err := os.Stat(target); err == nil {
+			// Another episode with the same date and title
+			base += " (" + ep.ID.String()[:8] + ")"
+			target = filepath.Join(dir, base+audioExtension(ep))
+		}
+	}
+
+	size, err := s.downloadFile(ctx, ep.EnclosureURL, dir, target)
+	if err != nil {
+		if ctx.Err() == nil {
+			if recErr := s.repo.SetEpisodeDownloadError(context.WithoutCancel(ctx), ep.ID, err.Error()); recErr != nil {
+				s.logger.Warn("failed to record download error", slog.String("episode_id", ep.ID.String()), slog.Any("error", recErr))
+			}
+		}
+		return nil, fmt.Errorf("download episode %s: %w", ep.ID, err)
+	}
+
+	var chapters []feed.Chapter
+	if ep.ChaptersURL != nil {
+		chapters, err = s.client.FetchChapters(ctx, *ep.ChaptersURL)
+		if err != nil {
+			s.logger.Warn("failed to fetch chapters",
+				slog.String("episode_id", ep.ID.String()),
+				slog.String("url", *ep.ChaptersURL),
+				slog.Any("error", err),
+			)
+			chapters = nil
+		}
+	}
+	transcriptPath, transcriptType := s.downloadTranscript(ctx, ep, filepath.Join(dir, base))
+
+	// A renamed episode leaves its old files behind
+	if old := deref(ep.FilePath); old != "" && old != target {
+		removeFile(old)
+	}
+	if old := deref(ep.TranscriptPath); old != "" && old != deref(transcriptPath) {
+		removeFile(old)
+	}
+
+	return s.repo.SetEpisodeDownloaded(ctx, SetDownloadedParams{
+		ID:             ep.ID,
+		FilePath:       target,
+		FileSize:       size,
+		Chapters:       chapters,
+		TranscriptPath: transcriptPath,
+		TranscriptType: transcriptType,
+	})
+}
+
+// PinEpisode marks an episode as requested by a user, which keeps its
+// download regardless of the retention rules. The caller queues the
+// download.
+func (s *podcastService) PinEpisode(ctx context.Context, episodeID uuid.UUID) (*Episode, error) {
+	ep, err := s.repo.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetEpisodePinned(ctx, episodeID, true); err != nil {
+		return nil, fmt.Errorf("failed to pin episode: %w", err)
+	}
+	ep.Pinned = true
+	return ep, nil
+}
+
+// DeleteDownload removes an episode's downloaded files and unpins it.
+func (s *podcastService) DeleteDownload(ctx context.Context, episodeID uuid.UUID) error {
+	ep, err := s.repo.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return err
+	}
+	if !ep.Downloaded() {
+		return ErrNotDownloaded
+	}
+	return s.removeDownload(ctx, ep)
+}
+
+// ApplyRetention enforces a podcast's download rules. With auto download
+// on, the newest episodes within the keep limit are returned for download,
+// except those whose last download failed. Downloads outside the limit are
+// deleted unless the episode was pinned.
+func (s *podcastService) ApplyRetention(ctx context.Context, podcastID uuid.UUID) (*RetentionResult, error) {
+	p, err := s.repo.GetPodcast(ctx, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	keep := p.Keep(s.cfg.KeepEpisodes)
+	limit := int32(math.MaxInt32)
+	if keep > 0 {
+		limit = util.SafeIntToInt32(keep)
+	}
+
+	newest, err := s.repo.ListEpisodes(ctx, podcastID, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := &RetentionResult{}
+	inWindow := make(map[uuid.UUID]bool, len(newest))
+	for _, ep := range newest {
+		inWindow[ep.ID] = true
+		if p.AutoDownload && !ep.Downloaded() && ep.DownloadError == nil {
+			result.Download = append(result.Download, ep)
+		}
+	}
+	if keep == 0 {
+		return result, nil
+	}
+
+	downloaded, err := s.repo.ListDownloadedEpisodes(ctx, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range downloaded {
+		ep := &downloaded[i]
+		if inWindow[ep.ID] || ep.Pinned {
+			continue
+		}
+		if err := s.removeDownload(ctx, ep); err != nil {
+			return result, err
+		}
+		result.Removed++
+	}
+	return result, nil
+}
+
+// downloadFile streams url into target through a temporary file in dir.
+func (s *podcastService) downloadFile(ctx context.Context, url, dir, target string) (int64, error) {
+	tmp, err := os.CreateTemp(dir, ".download-*")
+	if err != nil {
+		return 0, fmt.Errorf("create temporary file: %w", err)
+	}
+	size, _, err := s.client.Download(ctx, url, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), target)
+	}
+	if err != nil {
+		removeFile(tmp.Name())
+		return 0, err
+	}
+	return size, nil
+}
+
+// transcriptPreference ranks transcript formats: timed captions first,
+// since players can show them in sync.
+var transcriptPreference = []struct {
+	mimeTypes []string
+	ext       string
+}{
+	{[]string{"text/vtt"}, ".vtt"},
+	{[]string{"application/x-subrip", "application/srt", "text/srt"}, ".srt"},
+	{[]string{"application/json"}, ".json"},
+	{[]string{"text/html"}, ".html"},
+	{[]string{"text/plain"}, ".txt"},
+}
+
+// downloadTranscript saves the preferred transcript next to the audio
+// file as base plus the format's extension. Failures are logged; an
+// episode without transcript is still playable.
+func (s *podcastService) downloadTranscript(ctx context.Context, ep *Episode, base string) (*string, *string) {
+	for _, pref := range transcriptPreference {
+		for _, t := range ep.Transcripts {
+			mimeType := strings.ToLower(strings.TrimSpace(strings.Split(t.Type, ";")[0]))
+			if !slices.Contains(pref.mimeTypes, mimeType) {
+				continue
+			}
+			data, _, err := s.client.Get(ctx, t.URL)
+			if err != nil {
+				s.logger.Warn("failed to fetch transcript",
+					slog.String("episode_id", ep.ID.String()),
+					slog.String("url", t.URL),
+					slog.Any("error", err),
+				)
+				continue
+			}
+			transcriptPath := base + pref.ext
+			if err := os.WriteFile(transcriptPath, data, 0o640); err != nil {
+				s.logger.Warn("failed to save transcript", slog.String("path", transcriptPath), slog.Any("error", err))
+				return nil, nil
+			}
+			return &transcriptPath, &mimeType
+		}
+	}
+	return nil, nil
+}
+
+func (s *podcastService) removeDownload(ctx context.Context, ep *Episode) error {
+	if ep.FilePath != nil {
+		removeFile(*ep.FilePath)
+	}
+	if ep.TranscriptPath != nil {
+		removeFile(*ep.TranscriptPath)
+	}
+	if err := s.repo.ClearEpisodeDownload(ctx, ep.ID); err != nil {
+		return fmt.Errorf("failed to clear download of episode %s: %w", ep.ID, err)
+	}
+	return nil
+}
+
+// storeEpisodes upserts the episodes of a fetched feed and returns those
+// not stored before. Feeds occasionally repeat a guid; the first item wins.
+func (s *podcastService) storeEpisodes(ctx context.Context, podcastID uuid.UUID, episodes []feed.Episode) ([]Episode, error) {
+	known, err := s.repo.ListEpisodeGUIDs(ctx, podcastID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(episodes))
+	var added []Episode
+	for _, fe := range episodes {
+		if fe.GUID == "" || seen[fe.GUID] {
+			continue
+		}
+		seen[fe.GUID] = true
+		ep, err := s.repo.UpsertEpisode(ctx, episodeParams(podcastID, fe))
+		if err != nil {
+			return nil, err
+		}
+		if !known[fe.GUID] {
+			added = append(added, *ep)
+		}
+	}
+	return added, nil
+}
+
+// =============================================================================
+// Listening Progress
+// =============================================================================
+
+// UpdateProgress stores a position report. The position is capped at the
+// episode duration. Unless the player marks the episode explicitly, it
+// counts as played within PlayedRemaining of the end.
+func (s *podcastService) UpdateProgress(ctx context.Context, userID, episodeID uuid.UUID, update ProgressUpdate) (*Progress, error) {
+	if update.PositionSeconds < 0 {
+		return nil, fmt.Errorf("%w: position %d", ErrInvalidProgress, update.PositionSeconds)
+	}
+	ep, err := s.repo.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	position := update.PositionSeconds
+	if ep.DurationSeconds > 0 {
+		position = min(position, ep.DurationSeconds)
+	}
+	played := ep.DurationSeconds > 0 && position > 0 &&
+		secondsToDuration(ep.DurationSeconds-position) <= PlayedRemaining
+	if update.Played != nil {
+		played = *update.Played
+	}
+	return s.repo.UpsertProgress(ctx, userID, episodeID, position, played)
+}
+
+func (s *podcastService) GetProgress(ctx context.Context, userID, episodeID uuid.UUID) (*Progress, error) {
+	return s.repo.GetProgress(ctx, userID, episodeID)
+}
+
+// ListProgress returns the user's listening state of the given episodes,
+// keyed by episode. Episodes never played are absent.
+func (s *podcastService) ListProgress(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) (map[uuid.UUID]Progress, error) {
+	progress := make(map[uuid.UUID]Progress, len(episodeIDs))
+	if len(episodeIDs) == 0 {
+		return progress, nil
+	}
+	rows, err := s.repo.ListProgress(ctx, userID, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range rows {
+		progress[p.EpisodeID] = p
+	}
+	return progress, nil
+}
+
+func (s *podcastService) DeleteProgress(ctx context.Context, userID, episodeID uuid.UUID) error {
+	return s.repo.DeleteProgress(ctx, userID, episodeID)
+}
+
+// ListContinueListening returns the episodes the user started and has not
+// played, most recently played first. A nil libraryIDs lists all of them.
+func (s *podcastService) ListContinueListening(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueListeningItem, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	return s.repo.ListContinueListening(ctx, userID, libraryIDs, limit)
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// NormalizeFeedURL validates a feed URL. The feed: pseudo-scheme used by
+// subscribe links is resolved, and fragments are dropped.
+func NormalizeFeedURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if rest, ok := strings.CutPrefix(raw, "feed:"); ok {
+		raw = strings.TrimPrefix(rest, "//")
+		if !strings.Contains(raw, "://") {
+			raw = "https://" + raw
+		}
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidFeedURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("%w: scheme must be http or https", ErrInvalidFeedURL)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%w: missing host", ErrInvalidFeedURL)
+	}
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// feedParams maps a fetched feed onto the stored podcast metadata. Feeds
+// without title are named after their host.
+func feedParams(res *feed.FetchResult, feedURL string) FeedParams {
+	f := res.Feed
+	title := strings.TrimSpace(f.Title)
+	if title == "" {
+		if u, err := url.Parse(feedURL); err == nil {
+			title = u.Host
+		}
+	}
+	return FeedParams{
+		Title:        title,
+		SortTitle:    SortTitle(title),
+		Author:       optionalString(f.Author),
+		Description:  optionalString(f.Description),
+		Link:         optionalString(f.Link),
+		ImageURL:     optionalString(f.ImageURL),
+		Language:     optionalString(f.Language),
+		Explicit:     f.Explicit,
+		Categories:   f.Categories,
+		PodcastGUID:  optionalString(f.GUID),
+		ETag:         optionalString(res.ETag),
+		LastModified: optionalString(res.LastModified),
+	}
+}
+
+// episodeParams maps a feed item onto the stored episode.
+func episodeParams(podcastID uuid.UUID, fe feed.Episode) UpsertEpisodeParams {
+	title := strings.TrimSpace(fe.Title)
+	if title == "" {
+		title = strings.TrimSuffix(path.Base(fe.Enclosure.URL), path.Ext(fe.Enclosure.URL))
+	}
+	episodeType := fe.EpisodeType
+	switch episodeType {
+	case EpisodeTypeFull, EpisodeTypeTrailer, EpisodeTypeBonus:
+	default:
+		episodeType = EpisodeTypeFull
+	}
+	params := UpsertEpisodeParams{
+		PodcastID:       podcastID,
+		GUID:            fe.GUID,
+		Title:           title,
+		Description:     optionalString(fe.Description),
+		Link:            optionalString(fe.Link),
+		ImageURL:        optionalString(fe.ImageURL),
+		DurationSeconds: util.SafeIntToInt32(int(fe.Duration.Seconds())),
+		Season:          optionalInt32(fe.Season),
+		EpisodeNumber:   optionalInt32(fe.Number),
+		EpisodeType:     episodeType,
+		Explicit:        fe.Explicit,
+		EnclosureURL:    fe.Enclosure.URL,
+		EnclosureType:   optionalString(fe.Enclosure.Type),
+		EnclosureLength: fe.Enclosure.Length,
+		ChaptersURL:     optionalString(fe.ChaptersURL),
+		Transcripts:     fe.Transcripts,
+	}
+	if !fe.PublishedAt.IsZero() {
+		params.PublishedAt = &fe.PublishedAt
+	}
+	if len(fe.Chapters) > 0 {
+		params.Chapters = fe.Chapters
+	}
+	return params
+}
+
+// episodeBaseName returns the file name of an episode without extension:
+// "2024-01-02 Episode Title", dated so files sort by publication.
+func episodeBaseName(ep *Episode) string {
+	name := SanitizeFileName(ep.Title)
+	if ep.PublishedAt != nil {
+		name = ep.PublishedAt.Format("2006-01-02") + " " + name
+	}
+	return name
+}
+
+// audioTypeExtensions maps enclosure media types to file extensions.
+var audioTypeExtensions = map[string]string{
+	"audio/mpeg":  ".mp3",
+	"audio/mp3":   ".mp3",
+	"audio/mp4":   ".m4a",
+	"audio/x-m4a": ".m4a",
+	"audio/aac":   ".aac",
+	"audio/ogg":   ".ogg",
+	"audio/opus":  ".opus",
+	"audio/flac":  ".flac",
+	"audio/wav":   ".wav",
+	"audio/x-wav": ".wav",
+	"video/mp4":   ".mp4",
+	"video/webm":  ".webm",
+}
+
+// audioExtension returns the file extension of an episode's media: the
+// one in the enclosure URL when it is a known media extension, else the
+// one of the enclosure type.
+func audioExtension(ep *Episode) string {
+	if u, err := url.Parse(ep.EnclosureURL); err == nil {
+		ext := strings.ToLower(path.Ext(u.Path))
+		if scanner.IsAudioFile("x"+ext) || scanner.IsVideoFile("x"+ext) {
+			return ext
+		}
+	}
+	mimeType := strings.ToLower(strings.TrimSpace(strings.Split(deref(ep.EnclosureType), ";")[0]))
+	if ext, ok := audioTypeExtensions[mimeType]; ok {
+		return ext
+	}
+	return ".mp3"
+}
+
+// maxFileNameBytes keeps generated names well below the 255 byte limit of
+// common filesystems, leaving room for date prefixes and extensions.
+const maxFileNameBytes = 180
+
+// SanitizeFileName turns a feed or episode title into a safe file name:
+// path separators, characters reserved on Windows and control characters
+// are replaced, and leading or trailing dots and spaces trimmed.
+func SanitizeFileName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			b.WriteRune('_')
+		case unicode.IsControl(r):
+			b.WriteRune(' ')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	out := strings.Join(strings.Fields(b.String()), " ")
+	for len(out) > maxFileNameBytes {
+		_, size := utf8.DecodeLastRuneInString(out)
+		out = out[:len(out)-size]
+	}
+	out = strings.Trim(out, ". ")
+	if out == "" {
+		return "Untitled"
+	}
+	return out
+}
+
+func removeFile(path string) {
+	_ = os.Remove(path)
+}
+
+func secondsToDuration(seconds int32) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalInt32(n int) *int32 {
+	if n <= 0 {
+		return nil
+	}
+	v := util.SafeIntToInt32(n)
+	return &v
+}
@@ -0,0 +1,691 @@
+package podcast
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/podcast/feed"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Podcast operations
+func (m *MockRepository) GetPodcast(ctx context.Context, id uuid.UUID) (*Podcast, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Podcast), args.Error(1)
+}
+
+func (m *MockRepository) GetPodcastByFeedURL(ctx context.Context, feedURL string) (*Podcast, error) {
+	args := m.Called(ctx, feedURL)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Podcast), args.Error(1)
+}
+
+func (m *MockRepository) CreatePodcast(ctx context.Context, params CreatePodcastParams) (*Podcast, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Podcast), args.Error(1)
+}
+
+func (m *MockRepository) UpdatePodcastFeed(ctx context.Context, id uuid.UUID, params FeedParams) (*Podcast, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Podcast), args.Error(1)
+}
+
+func (m *MockRepository) MarkPodcastChecked(ctx context.Context, id uuid.UUID, lastError *string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpdatePodcastSettings(ctx context.Context, id uuid.UUID, autoDownload bool, keepEpisodes *int32) (*Podcast, error) {
+	args := m.Called(ctx, id, autoDownload, keepEpisodes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Podcast), args.Error(1)
+}
+
+func (m *MockRepository) ListPodcasts(ctx context.Context, filters PodcastListFilters) ([]Podcast, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Podcast), args.Error(1)
+}
+
+func (m *MockRepository) CountPodcasts(ctx context.Context, filters PodcastListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListSubscribedPodcasts(ctx context.Context, libraryID *uuid.UUID) ([]Podcast, error) {
+	args := m.Called(ctx, libraryID)
+	return args.Get(0).([]Podcast), args.Error(1)
+}
+
+// Subscriptions
+func (m *MockRepository) Subscribe(ctx context.Context, userID, podcastID uuid.UUID) error {
+	args := m.Called(ctx, userID, podcastID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Unsubscribe(ctx context.Context, userID, podcastID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, podcastID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) IsSubscribed(ctx context.Context, userID, podcastID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, podcastID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ListSubscriberIDs(ctx context.Context, podcastID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, podcastID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+// Episode operations
+func (m *MockRepository) GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Episode), args.Error(1)
+}
+
+func (m *MockRepository) UpsertEpisode(ctx context.Context, params UpsertEpisodeParams) (*Episode, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Episode), args.Error(1)
+}
+
+func (m *MockRepository) ListEpisodeGUIDs(ctx context.Context, podcastID uuid.UUID) (map[string]bool, error) {
+	args := m.Called(ctx, podcastID)
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockRepository) ListEpisodes(ctx context.Context, podcastID uuid.UUID, limit, offset int32) ([]Episode, error) {
+	args := m.Called(ctx, podcastID, limit, offset)
+	return args.Get(0).([]Episode), args.Error(1)
+}
+
+func (m *MockRepository) CountEpisodes(ctx context.Context, podcastID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, podcastID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListDownloadedEpisodes(ctx context.Context, podcastID uuid.UUID) ([]Episode, error) {
+	args := m.Called(ctx, podcastID)
+	return args.Get(0).([]Episode), args.Error(1)
+}
+
+func (m *MockRepository) ListNewEpisodes(ctx context.Context, userID uuid.UUID, limit int32) ([]InboxItem, error) {
+	args := m.Called(ctx, userID, limit)
+	return args.Get(0).([]InboxItem), args.Error(1)
+}
+
+func (m *MockRepository) ListLatestEpisodes(ctx context.Context, libraryID uuid.UUID, limit int32) ([]LatestEpisode, error) {
+	args := m.Called(ctx, libraryID, limit)
+	return args.Get(0).([]LatestEpisode), args.Error(1)
+}
+
+func (m *MockRepository) SetEpisodeDownloaded(ctx context.Context, params SetDownloadedParams) (*Episode, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Episode), args.Error(1)
+}
+
+func (m *MockRepository) SetEpisodeDownloadError(ctx context.Context, id uuid.UUID, downloadError string) error {
+	args := m.Called(ctx, id, downloadError)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ClearEpisodeDownload(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SetEpisodePinned(ctx context.Context, id uuid.UUID, pinned bool) error {
+	args := m.Called(ctx, id, pinned)
+	return args.Error(0)
+}
+
+// Listening progress
+func (m *MockRepository) UpsertProgress(ctx context.Context, userID, episodeID uuid.UUID, positionSeconds int32, isPlayed bool) (*Progress, error) {
+	args := m.Called(ctx, userID, episodeID, positionSeconds, isPlayed)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Progress), args.Error(1)
+}
+
+func (m *MockRepository) GetProgress(ctx context.Context, userID, episodeID uuid.UUID) (*Progress, error) {
+	args := m.Called(ctx, userID, episodeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Progress), args.Error(1)
+}
+
+func (m *MockRepository) ListProgress(ctx context.Context, userID uuid.UUID, episodeIDs []uuid.UUID) ([]Progress, error) {
+	args := m.Called(ctx, userID, episodeIDs)
+	return args.Get(0).([]Progress), args.Error(1)
+}
+
+func (m *MockRepository) DeleteProgress(ctx context.Context, userID, episodeID uuid.UUID) error {
+	args := m.Called(ctx, userID, episodeID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListContinueListening(ctx context.Context, userID uuid.UUID, libraryIDs []uuid.UUID, limit int32) ([]ContinueListeningItem, error) {
+	args := m.Called(ctx, userID, libraryIDs, limit)
+	return args.Get(0).([]ContinueListeningItem), args.Error(1)
+}
+
+// fakeLibraries serves libraries by ID.
+type fakeLibraries map[uuid.UUID]*library.Library
+
+func (f fakeLibraries) Get(_ context.Context, id uuid.UUID) (*library.Library, error) {
+	if lib, ok := f[id]; ok {
+		return lib, nil
+	}
+	return nil, library.ErrNotFound
+}
+
+const testFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+<channel>
+	<title>The Test Show</title>
+	<itunes:author>Jane Host</itunes:author>
+	<item>
+		<title>Episode 2</title>
+		<guid>ep-2</guid>
+		<pubDate>Tue, 02 Jan 2024 10:00:00 +0000</pubDate>
+		<enclosure url="{{base}}/ep2.mp3" type="audio/mpeg" length="5"/>
+		<itunes:duration>600</itunes:duration>
+	</item>
+	<item>
+		<title>Episode 1</title>
+		<guid>ep-1</guid>
+		<pubDate>Mon, 01 Jan 2024 10:00:00 +0000</pubDate>
+		<enclosure url="{{base}}/ep1.mp3" type="audio/mpeg" length="5"/>
+		<itunes:trailer>no</itunes:trailer>
+	</item>
+</channel>
+</rss>`
+
+// newFeedServer serves the test feed with an ETag, episode audio, JSON
+// chapters and a VTT transcript.
+func newFeedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/feed.xml", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(strings.ReplaceAll(testFeed, "{{base}}", srv.URL)))
+	})
+	mux.HandleFunc("/ep2.mp3", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	})
+	mux.HandleFunc("/ep2.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"1.2.0","chapters":[{"startTime":0,"title":"Intro"}]}`))
+	})
+	mux.HandleFunc("/ep2.vtt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("WEBVTT\n"))
+	})
+	mux.HandleFunc("/missing.mp3", http.NotFound)
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestService(repo *MockRepository, libs fakeLibraries, srv *httptest.Server) *podcastService {
+	var client *feed.Client
+	if srv != nil {
+		client = feed.NewClient(srv.Client())
+	}
+	return NewService(repo, libs, client, Config{KeepEpisodes: 10}, logging.NewTestLogger()).(*podcastService)
+}
+
+func TestAddPodcast_NewFeed(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	libID := uuid.New()
+	svc := newTestService(repo, fakeLibraries{libID: {ID: libID, Name: "Podcasts", Type: library.LibraryTypePodcast}}, srv)
+	ctx := context.Background()
+	feedURL := srv.URL + "/feed.xml"
+	podcastID := uuid.New()
+
+	repo.On("GetPodcastByFeedURL", ctx, feedURL).Return(nil, ErrPodcastNotFound)
+	repo.On("CreatePodcast", ctx, mock.MatchedBy(func(p CreatePodcastParams) bool {
+		return p.LibraryID == libID && p.FeedURL == feedURL &&
+			p.Title == "The Test Show" && p.SortTitle == "Test Show, The" &&
+			*p.Author == "Jane Host" && *p.ETag == `"v1"`
+	})).Return(&Podcast{ID: podcastID, LibraryID: libID, FeedURL: feedURL, Title: "The Test Show"}, nil)
+	repo.On("ListEpisodeGUIDs", ctx, podcastID).Return(map[string]bool{}, nil)
+	repo.On("UpsertEpisode", ctx, mock.MatchedBy(func(p UpsertEpisodeParams) bool {
+		return p.PodcastID == podcastID && p.GUID == "ep-2" && p.DurationSeconds == 600 &&
+			p.EpisodeType == EpisodeTypeFull && p.PublishedAt != nil
+	})).Return(&Episode{ID: uuid.New(), GUID: "ep-2"}, nil)
+	repo.On("UpsertEpisode", ctx, mock.MatchedBy(func(p UpsertEpisodeParams) bool {
+		return p.GUID == "ep-1"
+	})).Return(&Episode{ID: uuid.New(), GUID: "ep-1"}, nil)
+
+	p, created, err := svc.AddPodcast(ctx, "  "+feedURL+"#top ", libID)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, podcastID, p.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestAddPodcast_ExistingFeed(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+	existing := &Podcast{ID: uuid.New(), FeedURL: "https://example.com/feed.xml"}
+
+	repo.On("GetPodcastByFeedURL", ctx, existing.FeedURL).Return(existing, nil)
+
+	p, created, err := svc.AddPodcast(ctx, "feed://example.com/feed.xml", uuid.New())
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, existing, p)
+}
+
+func TestAddPodcast_Validation(t *testing.T) {
+	repo := new(MockRepository)
+	libID := uuid.New()
+	svc := newTestService(repo, fakeLibraries{libID: {ID: libID, Name: "Books", Type: library.LibraryTypeBook}}, nil)
+	ctx := context.Background()
+
+	_, _, err := svc.AddPodcast(ctx, "file:///etc/passwd", libID)
+	assert.ErrorIs(t, err, ErrInvalidFeedURL)
+
+	repo.On("GetPodcastByFeedURL", ctx, "https://example.com/feed.xml").Return(nil, ErrPodcastNotFound)
+	_, _, err = svc.AddPodcast(ctx, "https://example.com/feed.xml", libID)
+	assert.ErrorIs(t, err, ErrInvalidLibrary)
+}
+
+func TestAddPodcast_FeedUnavailable(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	libID := uuid.New()
+	svc := newTestService(repo, fakeLibraries{libID: {ID: libID, Type: library.LibraryTypePodcast}}, srv)
+	ctx := context.Background()
+	feedURL := srv.URL + "/missing.xml"
+
+	repo.On("GetPodcastByFeedURL", ctx, feedURL).Return(nil, ErrPodcastNotFound)
+
+	_, _, err := svc.AddPodcast(ctx, feedURL, libID)
+	assert.ErrorIs(t, err, ErrFeedUnavailable)
+	repo.AssertNotCalled(t, "CreatePodcast", mock.Anything, mock.Anything)
+}
+
+func TestRefresh_NewEpisodes(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, srv)
+	ctx := context.Background()
+	p := &Podcast{ID: uuid.New(), FeedURL: srv.URL + "/feed.xml", Title: "The Test Show"}
+	ep1 := &Episode{ID: uuid.New(), GUID: "ep-1"}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("UpdatePodcastFeed", ctx, p.ID, mock.MatchedBy(func(f FeedParams) bool {
+		return *f.ETag == `"v1"`
+	})).Return(p, nil)
+	repo.On("ListEpisodeGUIDs", ctx, p.ID).Return(map[string]bool{"ep-2": true}, nil)
+	repo.On("UpsertEpisode", ctx, mock.MatchedBy(func(e UpsertEpisodeParams) bool { return e.GUID == "ep-2" })).
+		Return(&Episode{ID: uuid.New(), GUID: "ep-2"}, nil)
+	repo.On("UpsertEpisode", ctx, mock.MatchedBy(func(e UpsertEpisodeParams) bool { return e.GUID == "ep-1" })).
+		Return(ep1, nil)
+
+	res, err := svc.Refresh(ctx, p.ID)
+	require.NoError(t, err)
+	assert.False(t, res.NotModified)
+	require.Len(t, res.NewEpisodes, 1)
+	assert.Equal(t, ep1.ID, res.NewEpisodes[0].ID)
+}
+
+func TestRefresh_NotModified(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, srv)
+	ctx := context.Background()
+	etag := `"v1"`
+	p := &Podcast{ID: uuid.New(), FeedURL: srv.URL + "/feed.xml", ETag: &etag}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("MarkPodcastChecked", ctx, p.ID, (*string)(nil)).Return(nil)
+
+	res, err := svc.Refresh(ctx, p.ID)
+	require.NoError(t, err)
+	assert.True(t, res.NotModified)
+	assert.Empty(t, res.NewEpisodes)
+	repo.AssertNotCalled(t, "UpdatePodcastFeed", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRefresh_RecordsFetchError(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, srv)
+	ctx := context.Background()
+	p := &Podcast{ID: uuid.New(), FeedURL: srv.URL + "/gone.xml"}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("MarkPodcastChecked", mock.Anything, p.ID, mock.MatchedBy(func(msg *string) bool {
+		return msg != nil && *msg != ""
+	})).Return(nil)
+
+	_, err := svc.Refresh(ctx, p.ID)
+	var statusErr *feed.StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	repo.AssertExpectations(t)
+}
+
+func TestDownloadEpisode(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	root := t.TempDir()
+	libID := uuid.New()
+	svc := newTestService(repo, fakeLibraries{libID: {ID: libID, Type: library.LibraryTypePodcast, Paths: []string{root}}}, srv)
+	ctx := context.Background()
+
+	published := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	p := &Podcast{ID: uuid.New(), LibraryID: libID, Title: "The Test Show: Live"}
+	chaptersURL := srv.URL + "/ep2.json"
+	ep := &Episode{
+		ID:           uuid.New(),
+		PodcastID:    p.ID,
+		Title:        "Episode 2/3",
+		PublishedAt:  &published,
+		EnclosureURL: srv.URL + "/ep2.mp3?token=x",
+		ChaptersURL:  &chaptersURL,
+		Transcripts: []feed.Transcript{
+			{URL: srv.URL + "/ep2.txt", Type: "text/plain"},
+			{URL: srv.URL + "/ep2.vtt", Type: "text/vtt"},
+		},
+	}
+	wantAudio := filepath.Join(root, "The Test Show_ Live", "2024-01-02 Episode 2_3.mp3")
+	wantTranscript := filepath.Join(root, "The Test Show_ Live", "2024-01-02 Episode 2_3.vtt")
+
+	repo.On("GetEpisode", ctx, ep.ID).Return(ep, nil)
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("SetEpisodeDownloaded", ctx, mock.MatchedBy(func(d SetDownloadedParams) bool {
+		return d.ID == ep.ID && d.FilePath == wantAudio && d.FileSize == 5 &&
+			len(d.Chapters) == 1 && d.Chapters[0].Title == "Intro" &&
+			d.TranscriptPath != nil && *d.TranscriptPath == wantTranscript &&
+			*d.TranscriptType == "text/vtt"
+	})).Return(&Episode{ID: ep.ID, FilePath: &wantAudio}, nil)
+
+	got, err := svc.DownloadEpisode(ctx, ep.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Downloaded())
+
+	data, err := os.ReadFile(wantAudio)
+	require.NoError(t, err)
+	assert.Equal(t, "audio", string(data))
+	data, err = os.ReadFile(wantTranscript)
+	require.NoError(t, err)
+	assert.Equal(t, "WEBVTT\n", string(data))
+
+	entries, err := os.ReadDir(filepath.Dir(wantAudio))
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "no temporary files are left behind")
+}
+
+func TestDownloadEpisode_RecordsFailure(t *testing.T) {
+	srv := newFeedServer(t)
+	repo := new(MockRepository)
+	root := t.TempDir()
+	libID := uuid.New()
+	svc := newTestService(repo, fakeLibraries{libID: {ID: libID, Type: library.LibraryTypePodcast, Paths: []string{root}}}, srv)
+	ctx := context.Background()
+
+	p := &Podcast{ID: uuid.New(), LibraryID: libID, Title: "Show"}
+	ep := &Episode{ID: uuid.New(), PodcastID: p.ID, Title: "Gone", EnclosureURL: srv.URL + "/missing.mp3"}
+
+	repo.On("GetEpisode", ctx, ep.ID).Return(ep, nil)
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("SetEpisodeDownloadError", mock.Anything, ep.ID, mock.AnythingOfType("string")).Return(nil)
+
+	_, err := svc.DownloadEpisode(ctx, ep.ID)
+	require.Error(t, err)
+	repo.AssertExpectations(t)
+
+	entries, err := os.ReadDir(filepath.Join(root, "Show"))
+	require.NoError(t, err)
+	assert.Empty(t, entries, "the partial download is removed")
+}
+
+func TestApplyRetention(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	keep := int32(2)
+	p := &Podcast{ID: uuid.New(), AutoDownload: true, KeepEpisodes: &keep}
+	path := func(name string) *string {
+		full := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(full, []byte("x"), 0o600))
+		return &full
+	}
+	newest := Episode{ID: uuid.New(), FilePath: path("newest.mp3")}
+	second := Episode{ID: uuid.New()}
+	old := Episode{ID: uuid.New(), FilePath: path("old.mp3"), TranscriptPath: path("old.vtt")}
+	pinned := Episode{ID: uuid.New(), FilePath: path("pinned.mp3"), Pinned: true}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("ListEpisodes", ctx, p.ID, int32(2), int32(0)).Return([]Episode{newest, second}, nil)
+	repo.On("ListDownloadedEpisodes", ctx, p.ID).Return([]Episode{newest, old, pinned}, nil)
+	repo.On("ClearEpisodeDownload", ctx, old.ID).Return(nil)
+
+	res, err := svc.ApplyRetention(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, res.Download, 1)
+	assert.Equal(t, second.ID, res.Download[0].ID)
+	assert.Equal(t, 1, res.Removed)
+
+	assert.NoFileExists(t, *old.FilePath)
+	assert.NoFileExists(t, *old.TranscriptPath)
+	assert.FileExists(t, *pinned.FilePath)
+	assert.FileExists(t, *newest.FilePath)
+	repo.AssertExpectations(t)
+}
+
+func TestApplyRetention_SkipsFailedAndKeepsAll(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+
+	keepAll := int32(0)
+	failed := "404"
+	p := &Podcast{ID: uuid.New(), AutoDownload: true, KeepEpisodes: &keepAll}
+	ok := Episode{ID: uuid.New()}
+	broken := Episode{ID: uuid.New(), DownloadError: &failed}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("ListEpisodes", ctx, p.ID, int32(2147483647), int32(0)).Return([]Episode{ok, broken}, nil)
+
+	res, err := svc.ApplyRetention(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, res.Download, 1)
+	assert.Equal(t, ok.ID, res.Download[0].ID)
+	repo.AssertNotCalled(t, "ListDownloadedEpisodes", mock.Anything, mock.Anything)
+}
+
+func TestApplyRetention_AutoDownloadOff(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+
+	p := &Podcast{ID: uuid.New(), AutoDownload: false}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("ListEpisodes", ctx, p.ID, int32(10), int32(0)).Return([]Episode{{ID: uuid.New()}}, nil)
+	repo.On("ListDownloadedEpisodes", ctx, p.ID).Return([]Episode{}, nil)
+
+	res, err := svc.ApplyRetention(ctx, p.ID)
+	require.NoError(t, err)
+	assert.Empty(t, res.Download)
+}
+
+func TestUpdateProgress(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	ep := &Episode{ID: uuid.New(), DurationSeconds: 600}
+	played, unplayed := true, false
+
+	tests := []struct {
+		name         string
+		update       ProgressUpdate
+		wantPosition int32
+		wantPlayed   bool
+	}{
+		{"midway", ProgressUpdate{PositionSeconds: 120}, 120, false},
+		{"in the outro", ProgressUpdate{PositionSeconds: 560}, 560, true},
+		{"past the end", ProgressUpdate{PositionSeconds: 900}, 600, true},
+		{"marked played", ProgressUpdate{Played: &played}, 0, true},
+		{"marked unplayed at the end", ProgressUpdate{PositionSeconds: 600, Played: &unplayed}, 600, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			svc := newTestService(repo, fakeLibraries{}, nil)
+			repo.On("GetEpisode", ctx, ep.ID).Return(ep, nil)
+			repo.On("UpsertProgress", ctx, userID, ep.ID, tt.wantPosition, tt.wantPlayed).
+				Return(&Progress{PositionSeconds: tt.wantPosition, IsPlayed: tt.wantPlayed}, nil)
+
+			_, err := svc.UpdateProgress(ctx, userID, ep.ID, tt.update)
+			require.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateProgress_Invalid(t *testing.T) {
+	svc := newTestService(new(MockRepository), fakeLibraries{}, nil)
+	_, err := svc.UpdateProgress(context.Background(), uuid.New(), uuid.New(), ProgressUpdate{PositionSeconds: -1})
+	assert.ErrorIs(t, err, ErrInvalidProgress)
+}
+
+func TestListContinueListening_DefaultLimit(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+	userID := uuid.New()
+	libraryIDs := []uuid.UUID{uuid.New()}
+	item := ContinueListeningItem{Episode: Episode{ID: uuid.New()}, Progress: Progress{PositionSeconds: 90}}
+
+	repo.On("ListContinueListening", ctx, userID, libraryIDs, int32(20)).Return([]ContinueListeningItem{item}, nil)
+
+	items, err := svc.ListContinueListening(ctx, userID, libraryIDs, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []ContinueListeningItem{item}, items)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateSettings(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+	keep := int32(5)
+	p := &Podcast{ID: uuid.New(), AutoDownload: true, KeepEpisodes: &keep}
+
+	repo.On("GetPodcast", ctx, p.ID).Return(p, nil)
+	repo.On("UpdatePodcastSettings", ctx, p.ID, false, &keep).Return(p, nil).Once()
+	reset := int32(-1)
+	repo.On("UpdatePodcastSettings", ctx, p.ID, true, (*int32)(nil)).Return(p, nil).Once()
+
+	off := false
+	_, err := svc.UpdateSettings(ctx, p.ID, SettingsUpdate{AutoDownload: &off})
+	require.NoError(t, err)
+	_, err = svc.UpdateSettings(ctx, p.ID, SettingsUpdate{KeepEpisodes: &reset})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUnsubscribe_NotSubscribed(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo, fakeLibraries{}, nil)
+	ctx := context.Background()
+	userID, podcastID := uuid.New(), uuid.New()
+
+	repo.On("Unsubscribe", ctx, userID, podcastID).Return(false, nil)
+
+	assert.ErrorIs(t, svc.Unsubscribe(ctx, userID, podcastID), ErrNotSubscribed)
+}
+
+func TestNormalizeFeedURL(t *testing.T) {
+	tests := map[string]string{
+		"https://example.com/feed.xml":        "https://example.com/feed.xml",
+		" http://example.com/rss#latest ":     "http://example.com/rss",
+		"feed://example.com/rss":              "https://example.com/rss",
+		"feed:https://example.com/rss":        "https://example.com/rss",
+		"https://example.com/feed?format=rss": "https://example.com/feed?format=rss",
+	}
+	for in, want := range tests {
+		got, err := NormalizeFeedURL(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "ftp://example.com/feed", "file:///etc/passwd", "https://", "not a url"} {
+		_, err := NormalizeFeedURL(in)
+		assert.ErrorIs(t, err, ErrInvalidFeedURL, in)
+	}
+}
+
+func TestSanitizeFileName(t *testing.T) {
+	tests := map[string]string{
+		"Episode 1: Hello/World?": "Episode 1_ Hello_World_",
+		"  ..hidden.. ":           "hidden",
+		"tab\there":               "tab here",
+		"":                        "Untitled",
+		"...":                     "Untitled",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, SanitizeFileName(in), in)
+	}
+	long := SanitizeFileName(strings.Repeat("ä", 200))
+	assert.LessOrEqual(t, len(long), maxFileNameBytes)
+}
+
+func TestAudioExtension(t *testing.T) {
+	mp4 := "audio/mp4"
+	assert.Equal(t, ".m4a", audioExtension(&Episode{EnclosureURL: "https://cdn.example.com/ep?id=3", EnclosureType: &mp4}))
+	assert.Equal(t, ".ogg", audioExtension(&Episode{EnclosureURL: "https://cdn.example.com/ep.ogg?x=1"}))
+	assert.Equal(t, ".mp3", audioExtension(&Episode{EnclosureURL: "https://cdn.example.com/track"}))
+}
@@ -0,0 +1,182 @@
+// Package podcast provides types and services for podcasts. Users
+// subscribe to RSS or Atom feeds; each feed is stored once and shared by
+// its subscribers. Feeds are polled with conditional requests, new
+// episodes are downloaded into the podcast library by retention rules, and
+// listening state is tracked per user.
+package podcast
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/podcast/feed"
+)
+
+// Episode types of the iTunes namespace.
+const (
+	EpisodeTypeFull    = "full"
+	EpisodeTypeTrailer = "trailer"
+	EpisodeTypeBonus   = "bonus"
+)
+
+// PlayedRemaining is the listening time left below which an episode counts
+// as played. Most episodes end with an outro that listeners skip.
+const PlayedRemaining = time.Minute
+
+// Podcast is a subscribed feed.
+type Podcast struct {
+	ID            uuid.UUID
+	LibraryID     uuid.UUID
+	FeedURL       string
+	Title         string
+	SortTitle     string
+	Author        *string
+	Description   *string
+	Link          *string
+	ImageURL      *string
+	Language      *string
+	Explicit      bool
+	Categories    []string
+	PodcastGUID   *string
+	ETag          *string
+	LastModified  *string
+	LastCheckedAt *time.Time
+	LastError     *string
+	AutoDownload  bool
+	KeepEpisodes  *int32 // nil uses the server default, 0 keeps all
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Keep returns how many of the newest episodes are kept downloaded, given
+// the server default. Zero means all.
+func (p *Podcast) Keep(defaultKeep int) int {
+	if p.KeepEpisodes != nil {
+		return int(*p.KeepEpisodes)
+	}
+	return max(defaultKeep, 0)
+}
+
+// Episode is an episode of a podcast.
+type Episode struct {
+	ID              uuid.UUID
+	PodcastID       uuid.UUID
+	GUID            string
+	Title           string
+	Description     *string
+	Link            *string
+	ImageURL        *string
+	PublishedAt     *time.Time
+	DurationSeconds int32
+	Season          *int32
+	EpisodeNumber   *int32
+	EpisodeType     string
+	Explicit        bool
+	EnclosureURL    string
+	EnclosureType   *string
+	EnclosureLength int64
+	ChaptersURL     *string
+	Chapters        []feed.Chapter
+	Transcripts     []feed.Transcript
+	TranscriptPath  *string // downloaded transcript next to the audio file
+	TranscriptType  *string
+	FilePath        *string
+	FileSize        int64
+	DownloadedAt    *time.Time
+	DownloadError   *string
+	Pinned          bool // downloaded on request, kept regardless of retention
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Downloaded reports whether the episode's audio is in the library.
+func (e *Episode) Downloaded() bool {
+	return e.FilePath != nil && *e.FilePath != ""
+}
+
+// Progress is a user's listening state of an episode.
+type Progress struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	EpisodeID       uuid.UUID
+	PositionSeconds int32
+	IsPlayed        bool
+	PlayedAt        *time.Time
+	LastPlayedAt    time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ProgressUpdate is a position report from a player. Played marks the
+// episode played or unplayed explicitly; otherwise it counts as played
+// within PlayedRemaining of its end.
+type ProgressUpdate struct {
+	PositionSeconds int32
+	Played          *bool
+}
+
+// InboxItem is an unplayed episode of a subscribed podcast.
+type InboxItem struct {
+	Episode Episode
+	Podcast Podcast
+}
+
+// ContinueListeningItem is a started, unplayed episode with the user's
+// progress.
+type ContinueListeningItem struct {
+	Episode  Episode
+	Podcast  Podcast
+	Progress Progress
+}
+
+// LatestEpisode is an episode of a podcast in a library.
+type LatestEpisode struct {
+	Episode Episode
+	Podcast Podcast
+}
+
+// PodcastListFilters contains filters for listing podcasts.
+type PodcastListFilters struct {
+	SubscribedBy *uuid.UUID // only podcasts the user subscribes to
+	LibraryID    *uuid.UUID
+	Query        string // matches title and author
+	Limit        int32
+	Offset       int32
+}
+
+// SettingsUpdate changes a podcast's download settings. Nil fields are
+// left unchanged; a negative KeepEpisodes returns to the server default.
+type SettingsUpdate struct {
+	AutoDownload *bool
+	KeepEpisodes *int32
+}
+
+// RefreshResult is the outcome of a feed refresh.
+type RefreshResult struct {
+	Podcast     *Podcast
+	NotModified bool
+	NewEpisodes []Episode
+}
+
+// RetentionResult lists the episodes a retention pass wants downloaded and
+// how many downloads it removed.
+type RetentionResult struct {
+	Download []Episode
+	Removed  int
+}
+
+// leadingArticles are moved to the end of sort titles.
+var leadingArticles = []string{"The ", "A ", "An "}
+
+// SortTitle returns the sort form of a title: "The Daily" sorts as
+// "Daily, The".
+func SortTitle(title string) string {
+	for _, article := range leadingArticles {
+		if len(title) > len(article) && strings.EqualFold(title[:len(article)], article) {
+			return title[len(article):] + ", " + strings.TrimSpace(title[:len(article)])
+		}
+	}
+	return title
+}
@@ -0,0 +1,151 @@
+// Package adapters provides QAR-specific implementations of shared interfaces.
+package adapters
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lusoris/revenge/internal/content/qar"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+)
+
+// oshashChunkSize is the size of the head and tail hashed by OSHash.
+const oshashChunkSize = 64 * 1024
+
+// errEmptyFile is returned for files that cannot be fingerprinted.
+var errEmptyFile = errors.New("empty file")
+
+// SceneFileParser implements scanner.FileParser for scene files. Scene
+// releases are usually named after the studio, release date, performers
+// and title:
+//   - "Studio.24.06.30.Jane.Doe.Scene.Title.XXX.1080p.MP4-GRP.mp4"
+//     -> "Studio Jane Doe Scene Title", 2024-06-30
+//   - "Studio - 2024-06-30 - Scene Title.mkv" -> "Studio - Scene Title", 2024-06-30
+type SceneFileParser struct{}
+
+// NewSceneFileParser creates a parser for the files of an adult library.
+func NewSceneFileParser() *SceneFileParser {
+	return &SceneFileParser{}
+}
+
+// datePattern matches a release date with a two- or four-digit year.
+var datePattern = regexp.MustCompile(`(?:^|[\s._-])((?:19|20)?\d{2})[\s._-](\d{2})[\s._-](\d{2})(?:[\s._-]|$)`)
+
+// xxxMarker matches the XXX tag that precedes the quality markers.
+var xxxMarker = regexp.MustCompile(`(?i)(?:^|[\s._-])XXX(?:[\s._-]|$)`)
+
+// Parse returns the cleaned up file name as the title and the release date
+// in the name, if any, as "date" (time.Time).
+func (p *SceneFileParser) Parse(filename string) (title string, metadata map[string]any) {
+	metadata = make(map[string]any)
+	name := strings.TrimSuffix(filename, filepath.Ext(filename))
+
+	if loc := xxxMarker.FindStringIndex(name); loc != nil {
+		name = name[:loc[0]]
+	}
+	if m := datePattern.FindStringSubmatchIndex(name); m != nil {
+		if date, ok := parseDate(name[m[2]:m[3]], name[m[4]:m[5]], name[m[6]:m[7]]); ok {
+			metadata["date"] = date
+			name = name[:m[0]] + " " + name[m[1]:]
+		}
+	}
+
+	title = scanner.CleanTitle(name)
+	title = strings.Trim(strings.ReplaceAll(title, " - - ", " - "), " -")
+	return title, metadata
+}
+
+// GetExtensions returns the video extensions
+func (p *SceneFileParser) GetExtensions() []string {
+	return scanner.ExtensionsToSlice(scanner.VideoExtensions)
+}
+
+// ContentType returns "adult"
+func (p *SceneFileParser) ContentType() string {
+	return "adult"
+}
+
+// ReadScene fingerprints a scene file and parses its name.
+func (p *SceneFileParser) ReadScene(filePath string) (*qar.ScannedScene, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := OSHash(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	title, metadata := p.Parse(filepath.Base(filePath))
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	}
+	scanned := &qar.ScannedScene{
+		FilePath: filePath,
+		FileSize: info.Size(),
+		OSHash:   hash,
+		Title:    title,
+	}
+	if date, ok := metadata["date"].(time.Time); ok {
+		scanned.Date = &date
+	}
+	return scanned, nil
+}
+
+// OSHash computes the OpenSubtitles hash of a file: its size plus the sum
+// of the little-endian 64-bit words of its first and last 64 KiB. Stash
+// and stash-box identify scene files by it.
+func OSHash(filePath string) (string, error) {
+	f, err := os.Open(filePath) // #nosec G304 -- file path comes from the library scan
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	size := info.Size()
+	if size == 0 {
+		return "", errEmptyFile
+	}
+
+	hash := uint64(size) // #nosec G115 -- file sizes are positive
+	buf := make([]byte, min(oshashChunkSize, size))
+	for _, offset := range []int64{0, size - int64(len(buf))} {
+		if _, err := f.ReadAt(buf, offset); err != nil {
+			return "", fmt.Errorf("read at %d: %w", offset, err)
+		}
+		for i := 0; i+8 <= len(buf); i += 8 {
+			hash += binary.LittleEndian.Uint64(buf[i:])
+		}
+	}
+	return fmt.Sprintf("%016x", hash), nil
+}
+
+// parseDate validates a release date. Two-digit years are in this century.
+func parseDate(y, m, d string) (time.Time, bool) {
+	year, _ := strconv.Atoi(y)
+	month, _ := strconv.Atoi(m)
+	day, _ := strconv.Atoi(d)
+	if len(y) == 2 {
+		year += 2000
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return time.Time{}, false
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	// time.Date normalizes February 30 into March
+	if t.Day() != day || t.After(time.Now()) {
+		return time.Time{}, false
+	}
+	return t, true
+}
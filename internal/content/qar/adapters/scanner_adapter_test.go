@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSceneFileParser_Parse(t *testing.T) {
+	parser := NewSceneFileParser()
+
+	tests := []struct {
+		filename  string
+		wantTitle string
+		wantDate  time.Time
+	}{
+		{"Studio.24.06.30.Jane.Doe.Scene.Title.XXX.1080p.MP4-GRP.mp4", "Studio Jane Doe Scene Title", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{"Studio - 2024-06-30 - Scene Title.mkv", "Studio - Scene Title", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)},
+		{"Studio.Scene.Title.XXX.720p.mp4", "Studio Scene Title", time.Time{}},
+		{"Scene Title.mp4", "Scene Title", time.Time{}},
+		{"Studio.24.02.30.Scene.mp4", "Studio 24 02 30 Scene", time.Time{}}, // February 30
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			title, metadata := parser.Parse(tt.filename)
+			assert.Equal(t, tt.wantTitle, title)
+			if tt.wantDate.IsZero() {
+				assert.NotContains(t, metadata, "date")
+			} else {
+				assert.Equal(t, tt.wantDate, metadata["date"])
+			}
+		})
+	}
+}
+
+func TestSceneFileParser_GetExtensions(t *testing.T) {
+	exts := NewSceneFileParser().GetExtensions()
+	assert.Contains(t, exts, ".mp4")
+	assert.Contains(t, exts, ".mkv")
+	assert.NotContains(t, exts, ".jpg")
+}
+
+func TestOSHash(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("zero file", func(t *testing.T) {
+		path := filepath.Join(dir, "zero.mp4")
+		require.NoError(t, os.WriteFile(path, make([]byte, 128*1024), 0o600))
+
+		hash, err := OSHash(path)
+		require.NoError(t, err)
+		assert.Equal(t, "0000000000020000", hash)
+	})
+
+	t.Run("head and tail words", func(t *testing.T) {
+		data := make([]byte, 256*1024)
+		binary.LittleEndian.PutUint64(data[0:], 1)
+		binary.LittleEndian.PutUint64(data[100*1024:], 1<<40) // outside both chunks
+		binary.LittleEndian.PutUint64(data[len(data)-8:], 2)
+		path := filepath.Join(dir, "words.mp4")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		hash, err := OSHash(path)
+		require.NoError(t, err)
+		assert.Equal(t, "0000000000040003", hash)
+	})
+
+	t.Run("small file", func(t *testing.T) {
+		data := make([]byte, 16)
+		binary.LittleEndian.PutUint64(data[8:], 5)
+		path := filepath.Join(dir, "small.mp4")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+
+		hash, err := OSHash(path)
+		require.NoError(t, err)
+		// size 16 plus the word counted in both the head and the tail
+		assert.Equal(t, "000000000000001a", hash)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		path := filepath.Join(dir, "empty.mp4")
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+
+		_, err := OSHash(path)
+		assert.ErrorIs(t, err, errEmptyFile)
+	})
+}
+
+func TestSceneFileParser_ReadScene(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Studio.24.06.30.Scene.Title.XXX.mp4")
+	require.NoError(t, os.WriteFile(path, make([]byte, 128*1024), 0o600))
+
+	scene, err := NewSceneFileParser().ReadScene(path)
+	require.NoError(t, err)
+	assert.Equal(t, path, scene.FilePath)
+	assert.Equal(t, int64(128*1024), scene.FileSize)
+	assert.Equal(t, "0000000000020000", scene.OSHash)
+	assert.Equal(t, "Studio Scene Title", scene.Title)
+	require.NotNil(t, scene.Date)
+	assert.Equal(t, time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), *scene.Date)
+}
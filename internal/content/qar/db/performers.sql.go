@@ -0,0 +1,238 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: performers.sql
+
+package adultdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countPerformerScenes = `-- name: CountPerformerScenes :one
+SELECT COUNT(*)
+FROM qar.scene_performers sp
+    JOIN qar.scenes s ON s.id = sp.scene_id
+WHERE
+    sp.performer_id = $1
+    AND (
+        $2::bool = FALSE
+        OR s.library_id = ANY($3::uuid[])
+    )
+`
+
+type CountPerformerScenesParams struct {
+	PerformerID uuid.UUID   `json:"performerId"`
+	Restrict    bool        `json:"restrict"`
+	LibraryIds  []uuid.UUID `json:"libraryIds"`
+}
+
+func (q *Queries) CountPerformerScenes(ctx context.Context, arg CountPerformerScenesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPerformerScenes, arg.PerformerID, arg.Restrict, arg.LibraryIds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPerformers = `-- name: CountPerformers :one
+SELECT COUNT(DISTINCT sp.performer_id)
+FROM qar.scene_performers sp
+    JOIN qar.scenes s ON s.id = sp.scene_id
+WHERE (
+        $1::bool = FALSE
+        OR s.library_id = ANY($2::uuid[])
+    )
+`
+
+type CountPerformersParams struct {
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+}
+
+func (q *Queries) CountPerformers(ctx context.Context, arg CountPerformersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPerformers, arg.Restrict, arg.LibraryIds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteOrphanPerformers = `-- name: DeleteOrphanPerformers :execrows
+DELETE FROM qar.performers p
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM qar.scene_performers sp
+        WHERE
+            sp.performer_id = p.id
+    )
+`
+
+func (q *Queries) DeleteOrphanPerformers(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrphanPerformers)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getPerformer = `-- name: GetPerformer :one
+SELECT id, stashdb_id, gender, country, name_enc, disambiguation_enc, aliases_enc, image_url_enc, created_at, updated_at FROM qar.performers WHERE id = $1
+`
+
+func (q *Queries) GetPerformer(ctx context.Context, id uuid.UUID) (QarPerformer, error) {
+	row := q.db.QueryRow(ctx, getPerformer, id)
+	var i QarPerformer
+	err := row.Scan(
+		&i.ID,
+		&i.StashdbID,
+		&i.Gender,
+		&i.Country,
+		&i.NameEnc,
+		&i.DisambiguationEnc,
+		&i.AliasesEnc,
+		&i.ImageUrlEnc,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listPerformers = `-- name: ListPerformers :many
+SELECT p.id, p.stashdb_id, p.gender, p.country, p.name_enc, p.disambiguation_enc, p.aliases_enc, p.image_url_enc, p.created_at, p.updated_at, COUNT(s.id) AS scene_count
+FROM
+    qar.performers p
+    JOIN qar.scene_performers sp ON sp.performer_id = p.id
+    JOIN qar.scenes s ON s.id = sp.scene_id
+WHERE (
+        $3::bool = FALSE
+        OR s.library_id = ANY($4::uuid[])
+    )
+GROUP BY
+    p.id
+ORDER BY scene_count DESC, p.id
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListPerformersParams struct {
+	Limit      int32       `json:"limit"`
+	Offset     int32       `json:"offset"`
+	Restrict   bool        `json:"restrict"`
+	LibraryIds []uuid.UUID `json:"libraryIds"`
+}
+
+type ListPerformersRow struct {
+	ID                uuid.UUID `json:"id"`
+	StashdbID         *string   `json:"stashdbId"`
+	Gender            *string   `json:"gender"`
+	Country           *string   `json:"country"`
+	NameEnc           []byte    `json:"nameEnc"`
+	DisambiguationEnc []byte    `json:"disambiguationEnc"`
+	AliasesEnc        []byte    `json:"aliasesEnc"`
+	ImageUrlEnc       []byte    `json:"imageUrlEnc"`
+	CreatedAt         time.Time `json:"createdAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	SceneCount        int64     `json:"sceneCount"`
+}
+
+// Lists performers with scenes in the accessible libraries, those with
+// most scenes first. Names are encrypted, so they cannot be sorted here.
+func (q *Queries) ListPerformers(ctx context.Context, arg ListPerformersParams) ([]ListPerformersRow, error) {
+	rows, err := q.db.Query(ctx, listPerformers,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPerformersRow{}
+	for rows.Next() {
+		var i ListPerformersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StashdbID,
+			&i.Gender,
+			&i.Country,
+			&i.NameEnc,
+			&i.DisambiguationEnc,
+			&i.AliasesEnc,
+			&i.ImageUrlEnc,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SceneCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertPerformer = `-- name: UpsertPerformer :one
+INSERT INTO
+    qar.performers (
+        stashdb_id,
+        gender,
+        country,
+        name_enc,
+        disambiguation_enc,
+        aliases_enc,
+        image_url_enc
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (stashdb_id) DO
+UPDATE
+SET
+    gender = EXCLUDED.gender,
+    country = EXCLUDED.country,
+    name_enc = EXCLUDED.name_enc,
+    disambiguation_enc = EXCLUDED.disambiguation_enc,
+    aliases_enc = EXCLUDED.aliases_enc,
+    image_url_enc = EXCLUDED.image_url_enc RETURNING id, stashdb_id, gender, country, name_enc, disambiguation_enc, aliases_enc, image_url_enc, created_at, updated_at
+`
+
+type UpsertPerformerParams struct {
+	StashdbID         *string `json:"stashdbId"`
+	Gender            *string `json:"gender"`
+	Country           *string `json:"country"`
+	NameEnc           []byte  `json:"nameEnc"`
+	DisambiguationEnc []byte  `json:"disambiguationEnc"`
+	AliasesEnc        []byte  `json:"aliasesEnc"`
+	ImageUrlEnc       []byte  `json:"imageUrlEnc"`
+}
+
+// Performers are keyed by their StashDB ID and refreshed on every match.
+func (q *Queries) UpsertPerformer(ctx context.Context, arg UpsertPerformerParams) (QarPerformer, error) {
+	row := q.db.QueryRow(ctx, upsertPerformer,
+		arg.StashdbID,
+		arg.Gender,
+		arg.Country,
+		arg.NameEnc,
+		arg.DisambiguationEnc,
+		arg.AliasesEnc,
+		arg.ImageUrlEnc,
+	)
+	var i QarPerformer
+	err := row.Scan(
+		&i.ID,
+		&i.StashdbID,
+		&i.Gender,
+		&i.Country,
+		&i.NameEnc,
+		&i.DisambiguationEnc,
+		&i.AliasesEnc,
+		&i.ImageUrlEnc,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -1,25 +0,0 @@
-// Code generated by sqlc. DO NOT EDIT.
-// versions:
-//   sqlc v1.30.0
-// source: placeholder.sql
-
-package adultdb
-
-import (
-	"context"
-)
-
-const qarPlaceholder = `-- name: QarPlaceholder :one
-
-SELECT 1 AS placeholder
-`
-
-// Placeholder query for adult content module (v0.3.0+)
-// This minimal query is here to satisfy sqlc's requirement for non-empty query directories
-// Real adult content queries will be implemented in v0.3.0
-func (q *Queries) QarPlaceholder(ctx context.Context) (int32, error) {
-	row := q.db.QueryRow(ctx, qarPlaceholder)
-	var placeholder int32
-	err := row.Scan(&placeholder)
-	return placeholder, err
-}
@@ -6,13 +6,61 @@ package adultdb
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
 type Querier interface {
-	// Placeholder query for adult content module (v0.3.0+)
-	// This minimal query is here to satisfy sqlc's requirement for non-empty query directories
-	// Real adult content queries will be implemented in v0.3.0
-	QarPlaceholder(ctx context.Context) (int32, error)
+	AddScenePerformer(ctx context.Context, arg AddScenePerformerParams) error
+	ClearUserPINFailures(ctx context.Context, userID uuid.UUID) error
+	CountPerformerScenes(ctx context.Context, arg CountPerformerScenesParams) (int64, error)
+	CountPerformers(ctx context.Context, arg CountPerformersParams) (int64, error)
+	CountScenes(ctx context.Context, arg CountScenesParams) (int64, error)
+	CountStudioScenes(ctx context.Context, arg CountStudioScenesParams) (int64, error)
+	CountStudios(ctx context.Context, arg CountStudiosParams) (int64, error)
+	DeleteOrphanPerformers(ctx context.Context) (int64, error)
+	// Deletes studios without scenes that are not the parent of a studio
+	// with scenes.
+	DeleteOrphanStudios(ctx context.Context) (int64, error)
+	DeleteScene(ctx context.Context, id uuid.UUID) error
+	DeleteScenePerformers(ctx context.Context, sceneID uuid.UUID) error
+	GetPerformer(ctx context.Context, id uuid.UUID) (QarPerformer, error)
+	GetScene(ctx context.Context, id uuid.UUID) (QarScene, error)
+	GetSceneByPathHash(ctx context.Context, filePathHash string) (QarScene, error)
+	GetStudio(ctx context.Context, id uuid.UUID) (QarStudio, error)
+	// Returns whether a user was granted QAR access (legacy:read scope).
+	GetUserAccess(ctx context.Context, id uuid.UUID) (GetUserAccessRow, error)
+	GetUserPIN(ctx context.Context, userID uuid.UUID) (QarUserPin, error)
+	// Lists performers with scenes in the accessible libraries, those with
+	// most scenes first. Names are encrypted, so they cannot be sorted here.
+	ListPerformers(ctx context.Context, arg ListPerformersParams) ([]ListPerformersRow, error)
+	// Lists the encrypted file paths of a library's scenes for removing
+	// scenes whose files are gone.
+	ListScenePaths(ctx context.Context, libraryID uuid.UUID) ([]ListScenePathsRow, error)
+	ListScenePerformers(ctx context.Context, sceneID uuid.UUID) ([]QarPerformer, error)
+	// Lists scenes recently added first, or by release date. restrict limits
+	// the result to library_ids, the libraries the user may access.
+	ListScenes(ctx context.Context, arg ListScenesParams) ([]QarScene, error)
+	// Lists studios with scenes in the accessible libraries, those with most
+	// scenes first. Names are encrypted, so they cannot be sorted here.
+	ListStudios(ctx context.Context, arg ListStudiosParams) ([]ListStudiosRow, error)
+	// Counts a wrong PIN. Reaching max_attempts locks PIN entry until
+	// locked_until and starts a new count.
+	RecordUserPINFailure(ctx context.Context, arg RecordUserPINFailureParams) (QarUserPin, error)
+	// Forgets the metadata of a scene whose file was replaced.
+	ResetSceneMatch(ctx context.Context, arg ResetSceneMatchParams) error
+	SetSceneMetadata(ctx context.Context, arg SetSceneMetadataParams) error
+	SetUserPINUnlockedUntil(ctx context.Context, arg SetUserPINUnlockedUntilParams) (QarUserPin, error)
+	// Performers are keyed by their StashDB ID and refreshed on every match.
+	UpsertPerformer(ctx context.Context, arg UpsertPerformerParams) (QarPerformer, error)
+	// Scenes are keyed by the hash of their file path so rescans update the
+	// file in place. The title is only set for new scenes; matched metadata is
+	// kept.
+	UpsertScene(ctx context.Context, arg UpsertSceneParams) (QarScene, error)
+	// Studios are keyed by their StashDB ID and refreshed on every match.
+	UpsertStudio(ctx context.Context, arg UpsertStudioParams) (QarStudio, error)
+	// Setting a new PIN locks the content again and clears failed attempts.
+	UpsertUserPIN(ctx context.Context, arg UpsertUserPINParams) (QarUserPin, error)
 }
 
 var _ Querier = (*Queries)(nil)
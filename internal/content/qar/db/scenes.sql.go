@@ -0,0 +1,479 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: scenes.sql
+
+package adultdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addScenePerformer = `-- name: AddScenePerformer :exec
+INSERT INTO
+    qar.scene_performers (scene_id, performer_id)
+VALUES ($1, $2) ON CONFLICT DO NOTHING
+`
+
+type AddScenePerformerParams struct {
+	SceneID     uuid.UUID `json:"sceneId"`
+	PerformerID uuid.UUID `json:"performerId"`
+}
+
+func (q *Queries) AddScenePerformer(ctx context.Context, arg AddScenePerformerParams) error {
+	_, err := q.db.Exec(ctx, addScenePerformer, arg.SceneID, arg.PerformerID)
+	return err
+}
+
+const countScenes = `-- name: CountScenes :one
+SELECT COUNT(*)
+FROM qar.scenes s
+WHERE (
+        $1::bool = FALSE
+        OR s.library_id = ANY($2::uuid[])
+    )
+    AND (
+        $3::uuid IS NULL
+        OR s.library_id = $3::uuid
+    )
+    AND (
+        $4::uuid IS NULL
+        OR s.studio_id = $4::uuid
+    )
+    AND (
+        $5::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM qar.scene_performers sp
+            WHERE
+                sp.scene_id = s.id
+                AND sp.performer_id = $5::uuid
+        )
+    )
+    AND (
+        $6::text = ''
+        OR s.match_status = $6::text
+    )
+`
+
+type CountScenesParams struct {
+	Restrict    bool        `json:"restrict"`
+	LibraryIds  []uuid.UUID `json:"libraryIds"`
+	LibraryID   pgtype.UUID `json:"libraryId"`
+	StudioID    pgtype.UUID `json:"studioId"`
+	PerformerID pgtype.UUID `json:"performerId"`
+	MatchStatus string      `json:"matchStatus"`
+}
+
+func (q *Queries) CountScenes(ctx context.Context, arg CountScenesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countScenes,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.StudioID,
+		arg.PerformerID,
+		arg.MatchStatus,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteScene = `-- name: DeleteScene :exec
+DELETE FROM qar.scenes WHERE id = $1
+`
+
+func (q *Queries) DeleteScene(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteScene, id)
+	return err
+}
+
+const deleteScenePerformers = `-- name: DeleteScenePerformers :exec
+DELETE FROM qar.scene_performers WHERE scene_id = $1
+`
+
+func (q *Queries) DeleteScenePerformers(ctx context.Context, sceneID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteScenePerformers, sceneID)
+	return err
+}
+
+const getScene = `-- name: GetScene :one
+SELECT id, library_id, file_path_hash, file_size, oshash, stashdb_id, studio_id, release_date, duration_seconds, match_status, match_type, match_confidence, matched_at, file_path_enc, title_enc, details_enc, image_url_enc, created_at, updated_at FROM qar.scenes WHERE id = $1
+`
+
+func (q *Queries) GetScene(ctx context.Context, id uuid.UUID) (QarScene, error) {
+	row := q.db.QueryRow(ctx, getScene, id)
+	var i QarScene
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FilePathHash,
+		&i.FileSize,
+		&i.Oshash,
+		&i.StashdbID,
+		&i.StudioID,
+		&i.ReleaseDate,
+		&i.DurationSeconds,
+		&i.MatchStatus,
+		&i.MatchType,
+		&i.MatchConfidence,
+		&i.MatchedAt,
+		&i.FilePathEnc,
+		&i.TitleEnc,
+		&i.DetailsEnc,
+		&i.ImageUrlEnc,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSceneByPathHash = `-- name: GetSceneByPathHash :one
+SELECT id, library_id, file_path_hash, file_size, oshash, stashdb_id, studio_id, release_date, duration_seconds, match_status, match_type, match_confidence, matched_at, file_path_enc, title_enc, details_enc, image_url_enc, created_at, updated_at FROM qar.scenes WHERE file_path_hash = $1
+`
+
+func (q *Queries) GetSceneByPathHash(ctx context.Context, filePathHash string) (QarScene, error) {
+	row := q.db.QueryRow(ctx, getSceneByPathHash, filePathHash)
+	var i QarScene
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FilePathHash,
+		&i.FileSize,
+		&i.Oshash,
+		&i.StashdbID,
+		&i.StudioID,
+		&i.ReleaseDate,
+		&i.DurationSeconds,
+		&i.MatchStatus,
+		&i.MatchType,
+		&i.MatchConfidence,
+		&i.MatchedAt,
+		&i.FilePathEnc,
+		&i.TitleEnc,
+		&i.DetailsEnc,
+		&i.ImageUrlEnc,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listScenePaths = `-- name: ListScenePaths :many
+SELECT id, file_path_enc FROM qar.scenes WHERE library_id = $1
+`
+
+type ListScenePathsRow struct {
+	ID          uuid.UUID `json:"id"`
+	FilePathEnc []byte    `json:"filePathEnc"`
+}
+
+// Lists the encrypted file paths of a library's scenes for removing
+// scenes whose files are gone.
+func (q *Queries) ListScenePaths(ctx context.Context, libraryID uuid.UUID) ([]ListScenePathsRow, error) {
+	rows, err := q.db.Query(ctx, listScenePaths, libraryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListScenePathsRow{}
+	for rows.Next() {
+		var i ListScenePathsRow
+		if err := rows.Scan(&i.ID, &i.FilePathEnc); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScenePerformers = `-- name: ListScenePerformers :many
+SELECT p.id, p.stashdb_id, p.gender, p.country, p.name_enc, p.disambiguation_enc, p.aliases_enc, p.image_url_enc, p.created_at, p.updated_at
+FROM qar.performers p
+    JOIN qar.scene_performers sp ON sp.performer_id = p.id
+WHERE
+    sp.scene_id = $1
+ORDER BY p.created_at, p.id
+`
+
+func (q *Queries) ListScenePerformers(ctx context.Context, sceneID uuid.UUID) ([]QarPerformer, error) {
+	rows, err := q.db.Query(ctx, listScenePerformers, sceneID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QarPerformer{}
+	for rows.Next() {
+		var i QarPerformer
+		if err := rows.Scan(
+			&i.ID,
+			&i.StashdbID,
+			&i.Gender,
+			&i.Country,
+			&i.NameEnc,
+			&i.DisambiguationEnc,
+			&i.AliasesEnc,
+			&i.ImageUrlEnc,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScenes = `-- name: ListScenes :many
+SELECT id, library_id, file_path_hash, file_size, oshash, stashdb_id, studio_id, release_date, duration_seconds, match_status, match_type, match_confidence, matched_at, file_path_enc, title_enc, details_enc, image_url_enc, created_at, updated_at
+FROM qar.scenes s
+WHERE (
+        $3::bool = FALSE
+        OR s.library_id = ANY($4::uuid[])
+    )
+    AND (
+        $5::uuid IS NULL
+        OR s.library_id = $5::uuid
+    )
+    AND (
+        $6::uuid IS NULL
+        OR s.studio_id = $6::uuid
+    )
+    AND (
+        $7::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM qar.scene_performers sp
+            WHERE
+                sp.scene_id = s.id
+                AND sp.performer_id = $7::uuid
+        )
+    )
+    AND (
+        $8::text = ''
+        OR s.match_status = $8::text
+    )
+ORDER BY
+    CASE
+        WHEN $9::text = 'released' THEN s.release_date
+    END DESC NULLS LAST,
+    s.created_at DESC,
+    s.id
+LIMIT $1
+OFFSET
+    $2
+`
+
+type ListScenesParams struct {
+	Limit       int32       `json:"limit"`
+	Offset      int32       `json:"offset"`
+	Restrict    bool        `json:"restrict"`
+	LibraryIds  []uuid.UUID `json:"libraryIds"`
+	LibraryID   pgtype.UUID `json:"libraryId"`
+	StudioID    pgtype.UUID `json:"studioId"`
+	PerformerID pgtype.UUID `json:"performerId"`
+	MatchStatus string      `json:"matchStatus"`
+	SortBy      string      `json:"sortBy"`
+}
+
+// Lists scenes recently added first, or by release date. restrict limits
+// the result to library_ids, the libraries the user may access.
+func (q *Queries) ListScenes(ctx context.Context, arg ListScenesParams) ([]QarScene, error) {
+	rows, err := q.db.Query(ctx, listScenes,
+		arg.Limit,
+		arg.Offset,
+		arg.Restrict,
+		arg.LibraryIds,
+		arg.LibraryID,
+		arg.StudioID,
+		arg.PerformerID,
+		arg.MatchStatus,
+		arg.SortBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []QarScene{}
+	for rows.Next() {
+		var i QarScene
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.FilePathHash,
+			&i.FileSize,
+			&i.Oshash,
+			&i.StashdbID,
+			&i.StudioID,
+			&i.ReleaseDate,
+			&i.DurationSeconds,
+			&i.MatchStatus,
+			&i.MatchType,
+			&i.MatchConfidence,
+			&i.MatchedAt,
+			&i.FilePathEnc,
+			&i.TitleEnc,
+			&i.DetailsEnc,
+			&i.ImageUrlEnc,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resetSceneMatch = `-- name: ResetSceneMatch :exec
+UPDATE qar.scenes
+SET
+    stashdb_id = NULL,
+    studio_id = NULL,
+    release_date = NULL,
+    duration_seconds = NULL,
+    title_enc = $2,
+    details_enc = NULL,
+    image_url_enc = NULL,
+    match_status = 'unmatched',
+    match_type = NULL,
+    match_confidence = NULL,
+    matched_at = NULL
+WHERE
+    id = $1
+`
+
+type ResetSceneMatchParams struct {
+	ID       uuid.UUID `json:"id"`
+	TitleEnc []byte    `json:"titleEnc"`
+}
+
+// Forgets the metadata of a scene whose file was replaced.
+func (q *Queries) ResetSceneMatch(ctx context.Context, arg ResetSceneMatchParams) error {
+	_, err := q.db.Exec(ctx, resetSceneMatch, arg.ID, arg.TitleEnc)
+	return err
+}
+
+const setSceneMetadata = `-- name: SetSceneMetadata :exec
+UPDATE qar.scenes
+SET
+    stashdb_id = $2,
+    studio_id = $3,
+    release_date = $4,
+    duration_seconds = $5,
+    title_enc = $6,
+    details_enc = $7,
+    image_url_enc = $8,
+    match_status = 'matched',
+    match_type = $9,
+    match_confidence = $10,
+    matched_at = NOW()
+WHERE
+    id = $1
+`
+
+type SetSceneMetadataParams struct {
+	ID              uuid.UUID   `json:"id"`
+	StashdbID       *string     `json:"stashdbId"`
+	StudioID        pgtype.UUID `json:"studioId"`
+	ReleaseDate     pgtype.Date `json:"releaseDate"`
+	DurationSeconds *int32      `json:"durationSeconds"`
+	TitleEnc        []byte      `json:"titleEnc"`
+	DetailsEnc      []byte      `json:"detailsEnc"`
+	ImageUrlEnc     []byte      `json:"imageUrlEnc"`
+	MatchType       *string     `json:"matchType"`
+	MatchConfidence *float64    `json:"matchConfidence"`
+}
+
+func (q *Queries) SetSceneMetadata(ctx context.Context, arg SetSceneMetadataParams) error {
+	_, err := q.db.Exec(ctx, setSceneMetadata,
+		arg.ID,
+		arg.StashdbID,
+		arg.StudioID,
+		arg.ReleaseDate,
+		arg.DurationSeconds,
+		arg.TitleEnc,
+		arg.DetailsEnc,
+		arg.ImageUrlEnc,
+		arg.MatchType,
+		arg.MatchConfidence,
+	)
+	return err
+}
+
+const upsertScene = `-- name: UpsertScene :one
+INSERT INTO
+    qar.scenes (
+        library_id,
+        file_path_hash,
+        file_path_enc,
+        file_size,
+        oshash,
+        title_enc
+    )
+VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (file_path_hash) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    file_path_enc = EXCLUDED.file_path_enc,
+    file_size = EXCLUDED.file_size,
+    oshash = EXCLUDED.oshash RETURNING id, library_id, file_path_hash, file_size, oshash, stashdb_id, studio_id, release_date, duration_seconds, match_status, match_type, match_confidence, matched_at, file_path_enc, title_enc, details_enc, image_url_enc, created_at, updated_at
+`
+
+type UpsertSceneParams struct {
+	LibraryID    uuid.UUID `json:"libraryId"`
+	FilePathHash string    `json:"filePathHash"`
+	FilePathEnc  []byte    `json:"filePathEnc"`
+	FileSize     int64     `json:"fileSize"`
+	Oshash       string    `json:"oshash"`
+	TitleEnc     []byte    `json:"titleEnc"`
+}
+
+// Scenes are keyed by the hash of their file path so rescans update the
+// file in place. The title is only set for new scenes; matched metadata is
+// kept.
+func (q *Queries) UpsertScene(ctx context.Context, arg UpsertSceneParams) (QarScene, error) {
+	row := q.db.QueryRow(ctx, upsertScene,
+		arg.LibraryID,
+		arg.FilePathHash,
+		arg.FilePathEnc,
+		arg.FileSize,
+		arg.Oshash,
+		arg.TitleEnc,
+	)
+	var i QarScene
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.FilePathHash,
+		&i.FileSize,
+		&i.Oshash,
+		&i.StashdbID,
+		&i.StudioID,
+		&i.ReleaseDate,
+		&i.DurationSeconds,
+		&i.MatchStatus,
+		&i.MatchType,
+		&i.MatchConfidence,
+		&i.MatchedAt,
+		&i.FilePathEnc,
+		&i.TitleEnc,
+		&i.DetailsEnc,
+		&i.ImageUrlEnc,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,19 @@
+package qar
+
+import "errors"
+
+var (
+	ErrSceneNotFound     = errors.New("scene not found")
+	ErrPerformerNotFound = errors.New("performer not found")
+	ErrStudioNotFound    = errors.New("studio not found")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrPINNotFound       = errors.New("no PIN stored")
+	ErrAccessDenied      = errors.New("QAR access not granted")
+	ErrPINNotSet         = errors.New("a PIN must be set first")
+	ErrLocked            = errors.New("QAR content is locked")
+	ErrInvalidPIN        = errors.New("invalid PIN")
+	ErrPINFormat         = errors.New("PIN must be 4 to 8 digits")
+	ErrPINLockedOut      = errors.New("too many invalid PINs, try again later")
+	ErrNoMatch           = errors.New("no matching scene found")
+	ErrMatchingDisabled  = errors.New("metadata matching is not configured")
+)
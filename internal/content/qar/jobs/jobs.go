@@ -0,0 +1,290 @@
+// Package jobs provides background job workers for QAR content. Library
+// scans fingerprint each scene file and match new scenes against the
+// configured stash-box server. File paths stay out of logs and events.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/qar"
+	"github.com/lusoris/revenge/internal/content/qar/adapters"
+	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// KindLibraryScan is the job kind of adult library scans.
+const KindLibraryScan = "qar_library_scan"
+
+// libraryType is the library type reported in scan events.
+const libraryType = "adult"
+
+// LibraryScanArgs defines arguments for adult library scan jobs.
+type LibraryScanArgs struct {
+	// Paths are the library paths to scan for scenes.
+	Paths []string `json:"paths"`
+
+	// Force re-imports unchanged files and retries matching unmatched scenes.
+	Force bool `json:"force"`
+
+	// LibraryID is the library the scenes belong to. Scenes carry their
+	// library for permission checks, so scans without one are rejected.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
+}
+
+// Kind returns the job kind identifier.
+func (LibraryScanArgs) Kind() string {
+	return KindLibraryScan
+}
+
+// InsertOpts returns the default insert options for adult library scan jobs.
+// Library scans run on the bulk queue since they're resource-intensive batch operations.
+func (LibraryScanArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
+
+// LibraryScanWorker scans adult library directories.
+type LibraryScanWorker struct {
+	river.WorkerDefaults[LibraryScanArgs]
+	service             qar.Service // nil when the module is disabled
+	jobClient           *infrajobs.Client
+	notificationService notification.Service
+	scanStatusService   *library.Service
+	logger              *slog.Logger
+}
+
+// NewLibraryScanWorker creates a new library scan worker.
+func NewLibraryScanWorker(service qar.Service, jobClient *infrajobs.Client, notificationService notification.Service, scanStatusService *library.Service, logger *slog.Logger) *LibraryScanWorker {
+	return &LibraryScanWorker{
+		service:             service,
+		jobClient:           jobClient,
+		notificationService: notificationService,
+		scanStatusService:   scanStatusService,
+		logger:              logger.With("component", "qar_library_scan"),
+	}
+}
+
+// Timeout returns the maximum execution time for library scan jobs.
+func (w *LibraryScanWorker) Timeout(job *river.Job[LibraryScanArgs]) time.Duration {
+	return 60 * time.Minute
+}
+
+// Work executes the library scan job.
+func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScanArgs]) error {
+	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindLibraryScan)
+	jctx.LogStart(
+		slog.Int("paths", len(job.Args.Paths)),
+		slog.Bool("force", job.Args.Force),
+	)
+
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, libraryType, job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType))
+	}
+
+	result := &sharedjobs.JobResult{Success: true}
+	start := time.Now()
+	itemsSkipped := 0
+	itemsMatched := 0
+
+	status.Start(ctx)
+
+	if w.service == nil {
+		w.logger.Warn("QAR module is disabled, skipping adult library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	if len(job.Args.Paths) == 0 {
+		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	if job.Args.LibraryID == nil {
+		w.logger.Warn("no library provided for adult library scan")
+		status.Complete(ctx, &library.ScanProgress{})
+		return nil
+	}
+	libraryID := *job.Args.LibraryID
+
+	parser := adapters.NewSceneFileParser()
+	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
+
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, libraryType, libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
+	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
+	if err != nil {
+		result.AddError(fmt.Errorf("scan failed: %w", err))
+		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
+		return err
+	}
+
+	w.logger.Info("scan completed",
+		slog.Int("total_files", summary.TotalFiles),
+		slog.Int("media_files", summary.MediaFiles),
+		slog.Int("parsed_files", summary.ParsedFiles),
+	)
+
+	var files []scanner.ScanResult
+	for _, sr := range scanResults {
+		if sr.IsMedia {
+			files = append(files, sr)
+		}
+	}
+	present := make(map[string]bool, len(files))
+	processed := 0
+
+	for _, f := range files {
+		// Progress events go to every admin session, so they carry counts only
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     len(files),
+			FilesProcessed: processed,
+			FilesMatched:   itemsMatched,
+		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
+		present[f.FilePath] = true
+
+		// Unchanged files keep their scene unless a full rescan is forced
+		existing, err := w.service.GetSceneByPath(ctx, f.FilePath)
+		if err == nil && existing != nil && !job.Args.Force &&
+			existing.FileSize == f.FileSize && existing.LibraryID == libraryID {
+			itemsSkipped++
+			continue
+		}
+
+		scanned, err := parser.ReadScene(f.FilePath)
+		if err != nil {
+			err = withoutPath(err)
+			w.logger.Warn("failed to read scene file", slog.Any("error", err))
+			result.AddError(fmt.Errorf("read scene file: %w", err))
+			continue
+		}
+		scanned.LibraryID = libraryID
+		scene, err := w.service.ImportScene(ctx, scanned)
+		if err != nil {
+			w.logger.Warn("failed to import scene", slog.Any("error", err))
+			result.AddError(fmt.Errorf("import scene: %w", err))
+			continue
+		}
+		result.ItemsProcessed++
+
+		if scene.MatchStatus == qar.MatchStatusUnmatched && w.matchScene(ctx, scene, scanned.Date) {
+			itemsMatched++
+		}
+	}
+
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     len(files),
+		FilesProcessed: processed,
+		FilesMatched:   itemsMatched,
+	})
+
+	// An empty result usually means an unmounted library, not deleted scenes
+	itemsRemoved := 0
+	if len(files) > 0 {
+		itemsRemoved, err = w.service.RemoveMissing(ctx, libraryID, job.Args.Paths, present)
+		if err != nil {
+			w.logger.Warn("failed to remove missing scenes", slog.Any("error", err))
+		}
+	}
+	if result.ItemsProcessed > 0 || itemsRemoved > 0 {
+		if err := w.service.Prune(ctx); err != nil {
+			w.logger.Warn("failed to prune performers and studios", slog.Any("error", err))
+		}
+	}
+
+	result.Duration = time.Since(start)
+	result.Success = !result.HasErrors()
+	result.LogSummary(w.logger, KindLibraryScan)
+
+	if result.HasErrors() {
+		result.LogErrors(w.logger, 10)
+	}
+
+	jctx.LogComplete(
+		slog.Int("paths_scanned", len(job.Args.Paths)),
+		slog.Int("items_processed", result.ItemsProcessed),
+		slog.Int("items_matched", itemsMatched),
+		slog.Int("items_skipped", itemsSkipped),
+		slog.Int("items_removed", itemsRemoved),
+	)
+
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: util.SafeIntToInt32(processed),
+		ItemsAdded:   util.SafeIntToInt32(result.ItemsProcessed),
+		ItemsRemoved: util.SafeIntToInt32(itemsRemoved),
+		ErrorsCount:  util.SafeIntToInt32(len(result.Errors)),
+	})
+
+	if w.notificationService != nil {
+		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
+			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
+			WithData("library_type", libraryType).
+			WithData("items_processed", result.ItemsProcessed).
+			WithData("scan_duration", result.Duration.String()))
+	}
+
+	return nil
+}
+
+// matchScene matches an imported scene and reports whether it was matched.
+// A failed match does not fail the import; a forced rescan retries it.
+func (w *LibraryScanWorker) matchScene(ctx context.Context, scene *qar.Scene, date *time.Time) bool {
+	_, err := w.service.MatchScene(ctx, scene, date)
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, qar.ErrNoMatch), errors.Is(err, qar.ErrMatchingDisabled):
+	default:
+		w.logger.Warn("failed to match scene",
+			slog.String("scene_id", scene.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+	return false
+}
+
+// withoutPath strips the file path from file system errors.
+func withoutPath(err error) error {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Err
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+package jobs
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/qar"
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+// fakeService records the scenes imported by a scan.
+type fakeService struct {
+	qar.Service
+	imported []*qar.ScannedScene
+	matched  int
+	removed  map[string]bool
+}
+
+func (f *fakeService) GetSceneByPath(ctx context.Context, filePath string) (*qar.Scene, error) {
+	return nil, qar.ErrSceneNotFound
+}
+
+func (f *fakeService) ImportScene(ctx context.Context, scanned *qar.ScannedScene) (*qar.Scene, error) {
+	f.imported = append(f.imported, scanned)
+	return &qar.Scene{ID: uuid.New(), Title: scanned.Title, MatchStatus: qar.MatchStatusUnmatched}, nil
+}
+
+func (f *fakeService) MatchScene(ctx context.Context, scene *qar.Scene, date *time.Time) (*qar.MatchResult, error) {
+	f.matched++
+	return nil, qar.ErrNoMatch
+}
+
+func (f *fakeService) RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error) {
+	f.removed = present
+	return 0, nil
+}
+
+func (f *fakeService) Prune(ctx context.Context) error {
+	return nil
+}
+
+func newJob(args LibraryScanArgs) *river.Job[LibraryScanArgs] {
+	return &river.Job[LibraryScanArgs]{JobRow: &rivertype.JobRow{ID: 1}, Args: args}
+}
+
+func TestLibraryScanWorker_Work(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Studio.24.06.30.Scene.Title.XXX.mp4"), make([]byte, 1024), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cover.jpg"), make([]byte, 1024), 0o600))
+
+	svc := &fakeService{}
+	worker := NewLibraryScanWorker(svc, nil, nil, nil, logging.NewTestLogger())
+	libraryID := uuid.New()
+
+	err := worker.Work(context.Background(), newJob(LibraryScanArgs{Paths: []string{dir}, LibraryID: &libraryID}))
+	require.NoError(t, err)
+
+	require.Len(t, svc.imported, 1)
+	assert.Equal(t, libraryID, svc.imported[0].LibraryID)
+	assert.Equal(t, "Studio Scene Title", svc.imported[0].Title)
+	assert.NotEmpty(t, svc.imported[0].OSHash)
+	assert.Equal(t, 1, svc.matched)
+	assert.Len(t, svc.removed, 1)
+}
+
+func TestLibraryScanWorker_Work_Disabled(t *testing.T) {
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, logging.NewTestLogger())
+	libraryID := uuid.New()
+
+	err := worker.Work(context.Background(), newJob(LibraryScanArgs{Paths: []string{t.TempDir()}, LibraryID: &libraryID}))
+	assert.NoError(t, err)
+}
+
+func TestWithoutPath(t *testing.T) {
+	_, err := os.Stat("/nonexistent/Studio.Scene.mp4")
+	require.Error(t, err)
+	assert.NotContains(t, withoutPath(err).Error(), "Studio")
+	assert.ErrorIs(t, withoutPath(err), fs.ErrNotExist)
+}
+
+func TestLibraryScanArgs_Kind(t *testing.T) {
+	assert.Equal(t, "qar_library_scan", LibraryScanArgs{}.Kind())
+}
@@ -0,0 +1,152 @@
+package qar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lusoris/revenge/internal/content/qar/stashdb"
+	"github.com/lusoris/revenge/internal/content/shared/matcher"
+)
+
+// DefaultMinMatchConfidence is the minimum confidence of a title match.
+// Fingerprint matches are always accepted.
+const DefaultMinMatchConfidence = 0.75
+
+// fingerprintConfidence is the confidence of a fingerprint match the
+// server returned several scenes for.
+const fingerprintConfidence = 0.9
+
+// SceneProvider looks up scenes on a stash-box compatible server.
+type SceneProvider interface {
+	FindScenesByFingerprint(ctx context.Context, algorithm stashdb.FingerprintAlgorithm, hash string) ([]stashdb.Scene, error)
+	SearchScenes(ctx context.Context, term string) ([]stashdb.Scene, error)
+}
+
+// MatchResult is the scene a file was matched to.
+type MatchResult struct {
+	Scene      *stashdb.Scene
+	MatchType  MatchType
+	Confidence float64
+}
+
+// Matcher matches scene files to stash-box scenes, first by the OSHASH
+// fingerprint of the file, then by searching for the file name.
+type Matcher struct {
+	provider      SceneProvider
+	minConfidence float64
+}
+
+// NewMatcher creates a scene matcher.
+func NewMatcher(provider SceneProvider) *Matcher {
+	return &Matcher{provider: provider, minConfidence: DefaultMinMatchConfidence}
+}
+
+// Match finds the scene of a file. It returns ErrNoMatch when neither the
+// fingerprint nor a search found a scene confidently.
+func (m *Matcher) Match(ctx context.Context, oshash, title string, date *time.Time) (*MatchResult, error) {
+	if oshash != "" {
+		scenes, err := m.provider.FindScenesByFingerprint(ctx, stashdb.AlgorithmOSHash, oshash)
+		if err != nil {
+			return nil, fmt.Errorf("fingerprint lookup: %w", err)
+		}
+		if scene := mostSubmitted(scenes, oshash); scene != nil {
+			confidence := 1.0
+			if len(scenes) > 1 {
+				confidence = fingerprintConfidence
+			}
+			return &MatchResult{Scene: scene, MatchType: MatchTypeFingerprint, Confidence: confidence}, nil
+		}
+	}
+
+	if strings.TrimSpace(title) == "" {
+		return nil, ErrNoMatch
+	}
+	scenes, err := m.provider.SearchScenes(ctx, title)
+	if err != nil {
+		return nil, fmt.Errorf("scene search: %w", err)
+	}
+	var best *MatchResult
+	for i := range scenes {
+		confidence := titleConfidence(title, date, &scenes[i])
+		if best == nil || confidence > best.Confidence {
+			best = &MatchResult{Scene: &scenes[i], MatchType: MatchTypeTitle, Confidence: confidence}
+		}
+	}
+	if best == nil || best.Confidence < m.minConfidence {
+		return nil, ErrNoMatch
+	}
+	return best, nil
+}
+
+// mostSubmitted returns the scene the fingerprint was submitted for most
+// often; stash-box lists a scene once per distinct submission.
+func mostSubmitted(scenes []stashdb.Scene, hash string) *stashdb.Scene {
+	var best *stashdb.Scene
+	bestCount := -1
+	for i := range scenes {
+		count := 0
+		for _, fp := range scenes[i].Fingerprints {
+			if fp.Algorithm == stashdb.AlgorithmOSHash && strings.EqualFold(fp.Hash, hash) {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = &scenes[i], count
+		}
+	}
+	return best
+}
+
+// titleConfidence scores a search result against the file name. File
+// names usually carry the studio and performers besides the title, so the
+// best of several renderings of the candidate is used; a matching date is
+// a strong hint.
+func titleConfidence(title string, date *time.Time, scene *stashdb.Scene) float64 {
+	renderings := []string{scene.Title}
+	var performers []string
+	for _, p := range scene.Performers {
+		name := p.Performer.Name
+		if p.As != "" {
+			name = p.As
+		}
+		performers = append(performers, name)
+	}
+	if len(performers) > 0 {
+		renderings = append(renderings, strings.Join(performers, " ")+" "+scene.Title)
+	}
+	if scene.Studio != nil {
+		renderings = append(renderings, scene.Studio.Name+" "+scene.Title)
+		if len(performers) > 0 {
+			renderings = append(renderings, scene.Studio.Name+" "+strings.Join(performers, " ")+" "+scene.Title)
+		}
+	}
+
+	similarity := 0.0
+	for _, r := range renderings {
+		similarity = max(similarity, matcher.TitleSimilarity(title, r))
+	}
+
+	score := matcher.NewConfidenceScore().Add(similarity, 1.0)
+	if date != nil {
+		if released, ok := ParseDate(scene.Date); ok {
+			if released.Equal(*date) {
+				score.AddBonus(0.2)
+			} else {
+				score.AddBonus(-0.1)
+			}
+		}
+	}
+	return score.Calculate()
+}
+
+// ParseDate parses a stash-box date, which may lack the day or month.
+func ParseDate(s string) (time.Time, bool) {
+	for _, layout := range []string{time.DateOnly, "2006-01", "2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,75 @@
+package qar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/qar/stashdb"
+)
+
+func TestMatcher_Fingerprint(t *testing.T) {
+	provider := &fakeProvider{byFingerprint: []stashdb.Scene{
+		{ID: "a", Fingerprints: []stashdb.Fingerprint{{Algorithm: stashdb.AlgorithmOSHash, Hash: "abc"}}},
+		{ID: "b", Fingerprints: []stashdb.Fingerprint{
+			{Algorithm: stashdb.AlgorithmOSHash, Hash: "abc"},
+			{Algorithm: stashdb.AlgorithmOSHash, Hash: "ABC"},
+		}},
+	}}
+
+	result, err := NewMatcher(provider).Match(context.Background(), "abc", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "b", result.Scene.ID)
+	assert.Equal(t, MatchTypeFingerprint, result.MatchType)
+	assert.InDelta(t, fingerprintConfidence, result.Confidence, 0.001)
+}
+
+func TestMatcher_Title(t *testing.T) {
+	date := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	provider := &fakeProvider{bySearch: []stashdb.Scene{
+		{ID: "other", Title: "Something Else Entirely"},
+		{
+			ID:         "match",
+			Title:      "Scene Title",
+			Date:       "2024-06-30",
+			Studio:     &stashdb.Studio{Name: "Studio"},
+			Performers: []stashdb.PerformerAppears{{Performer: stashdb.Performer{Name: "Jane Doe"}}},
+		},
+	}}
+
+	result, err := NewMatcher(provider).Match(context.Background(), "", "Studio Jane Doe Scene Title", &date)
+	require.NoError(t, err)
+	assert.Equal(t, "match", result.Scene.ID)
+	assert.Equal(t, MatchTypeTitle, result.MatchType)
+}
+
+func TestMatcher_NoMatch(t *testing.T) {
+	provider := &fakeProvider{bySearch: []stashdb.Scene{{ID: "other", Title: "Something Else Entirely"}}}
+
+	_, err := NewMatcher(provider).Match(context.Background(), "abc", "Scene Title", nil)
+	assert.ErrorIs(t, err, ErrNoMatch)
+
+	_, err = NewMatcher(provider).Match(context.Background(), "", " ", nil)
+	assert.ErrorIs(t, err, ErrNoMatch)
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+		ok   bool
+	}{
+		{"2024-06-30", time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), true},
+		{"2024-06", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), true},
+		{"2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseDate(tt.in)
+		assert.Equal(t, tt.ok, ok, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
@@ -0,0 +1,83 @@
+package qar
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/qar/stashdb"
+	"github.com/lusoris/revenge/internal/service/activity"
+)
+
+// Module provides the QAR content module. The service is nil unless
+// legacy.enabled is set.
+var Module = fx.Module("qar",
+	fx.Provide(
+		provideVault,
+		NewPostgresRepository,
+		provideService,
+	),
+)
+
+// provideVault creates the vault for QAR data.
+//
+// Priority:
+//  1. legacy.encryption_key (hex-encoded 32-byte key) — recommended
+//  2. Derived from the auth secrets, so QAR data does not share their key
+func provideVault(cfg *config.Config, logger *slog.Logger) (*Vault, error) {
+	if cfg.Legacy.EncryptionKey != "" {
+		key, err := hex.DecodeString(cfg.Legacy.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("legacy.encryption_key must be hex-encoded: %w", err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("legacy.encryption_key must be exactly 32 bytes (64 hex chars), got %d bytes", len(key))
+		}
+		return NewVault(key)
+	}
+
+	secret := cfg.Auth.EncryptionKey
+	if secret == "" {
+		secret = cfg.Auth.JWTSecret
+	}
+	if secret == "" {
+		secret = "revenge-dev-encryption-key-do-not-use-in-production"
+	}
+	if cfg.Legacy.Enabled {
+		logger.Warn("legacy.encryption_key not set, deriving the QAR key from the auth secrets (set a dedicated key for production)")
+	}
+	key := sha256.Sum256([]byte("revenge-qar:" + secret))
+	return NewVault(key[:])
+}
+
+// provideService creates the QAR service when the module is enabled.
+// Scenes are matched against the configured stash-box server if an API key
+// is set.
+func provideService(repo Repository, activityLogger activity.Logger, cfg *config.Config, logger *slog.Logger) (Service, error) {
+	if !cfg.Legacy.Enabled {
+		return nil, nil
+	}
+
+	var provider SceneProvider
+	if cfg.Legacy.StashDB.APIKey != "" {
+		client, err := stashdb.NewClient(stashdb.Config{
+			URL:    cfg.Legacy.StashDB.URL,
+			APIKey: cfg.Legacy.StashDB.APIKey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create stashdb client: %w", err)
+		}
+		provider = client
+	} else {
+		logger.Info("legacy.stashdb.api_key not set, QAR scenes will not be matched")
+	}
+
+	return NewService(repo, provider, activityLogger, Config{
+		RequirePIN:     cfg.Legacy.Privacy.RequirePIN,
+		AuditAllAccess: cfg.Legacy.Privacy.AuditAllAccess,
+	}, logger), nil
+}
@@ -0,0 +1,72 @@
+package qar
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines database operations for QAR content. Implementations
+// store the sensitive fields encrypted; callers see plain values.
+type Repository interface {
+	// Access
+	GetUserAccess(ctx context.Context, userID uuid.UUID) (*UserAccess, error)
+	GetUserPIN(ctx context.Context, userID uuid.UUID) (*UserPIN, error)
+	UpsertUserPIN(ctx context.Context, userID uuid.UUID, pinHash string) (*UserPIN, error)
+	SetUnlockedUntil(ctx context.Context, userID uuid.UUID, until *time.Time) (*UserPIN, error)
+	RecordPINFailure(ctx context.Context, userID uuid.UUID, maxAttempts int32, lockedUntil time.Time) (*UserPIN, error)
+	ClearPINFailures(ctx context.Context, userID uuid.UUID) error
+
+	// Scenes
+	GetScene(ctx context.Context, id uuid.UUID) (*Scene, error)
+	GetSceneByPath(ctx context.Context, filePath string) (*Scene, error)
+	UpsertScene(ctx context.Context, scanned *ScannedScene) (*Scene, error)
+	SetSceneMetadata(ctx context.Context, id uuid.UUID, metadata SceneMetadata) error
+	ResetSceneMatch(ctx context.Context, id uuid.UUID, title string) error
+	ListScenes(ctx context.Context, filters SceneListFilters) ([]Scene, error)
+	CountScenes(ctx context.Context, filters SceneListFilters) (int64, error)
+	ListScenePaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error)
+	DeleteScene(ctx context.Context, id uuid.UUID) error
+
+	// Scene performers
+	ListScenePerformers(ctx context.Context, sceneID uuid.UUID) ([]Performer, error)
+	SetScenePerformers(ctx context.Context, sceneID uuid.UUID, performerIDs []uuid.UUID) error
+
+	// Performers
+	GetPerformer(ctx context.Context, id uuid.UUID) (*Performer, error)
+	UpsertPerformer(ctx context.Context, params UpsertPerformerParams) (*Performer, error)
+	ListPerformers(ctx context.Context, filters ListFilters) ([]Performer, error)
+	CountPerformers(ctx context.Context, filters ListFilters) (int64, error)
+	CountPerformerScenes(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (int64, error)
+	DeleteOrphanPerformers(ctx context.Context) (int64, error)
+
+	// Studios
+	GetStudio(ctx context.Context, id uuid.UUID) (*Studio, error)
+	UpsertStudio(ctx context.Context, params UpsertStudioParams) (*Studio, error)
+	ListStudios(ctx context.Context, filters ListFilters) ([]Studio, error)
+	CountStudios(ctx context.Context, filters ListFilters) (int64, error)
+	CountStudioScenes(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (int64, error)
+	DeleteOrphanStudios(ctx context.Context) (int64, error)
+}
+
+// UpsertPerformerParams contains the metadata of a performer keyed by its
+// StashDB ID.
+type UpsertPerformerParams struct {
+	StashDBID      string
+	Name           string
+	Disambiguation *string
+	Aliases        []string
+	Gender         *string
+	Country        *string
+	ImageURL       *string
+}
+
+// UpsertStudioParams contains the metadata of a studio keyed by its
+// StashDB ID.
+type UpsertStudioParams struct {
+	StashDBID string
+	ParentID  *uuid.UUID
+	Name      string
+	ImageURL  *string
+}
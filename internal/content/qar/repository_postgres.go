@@ -0,0 +1,629 @@
+package qar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	adultdb "github.com/lusoris/revenge/internal/content/qar/db"
+)
+
+// postgresRepository implements the Repository interface using PostgreSQL.
+// Sensitive fields are sealed by the vault before they are written and
+// opened after they are read.
+type postgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *adultdb.Queries
+	vault   *Vault
+}
+
+// NewPostgresRepository creates a new PostgreSQL repository
+func NewPostgresRepository(pool *pgxpool.Pool, vault *Vault) Repository {
+	return &postgresRepository{
+		pool:    pool,
+		queries: adultdb.New(pool),
+		vault:   vault,
+	}
+}
+
+// =============================================================================
+// Access
+// =============================================================================
+
+func (r *postgresRepository) GetUserAccess(ctx context.Context, userID uuid.UUID) (*UserAccess, error) {
+	row, err := r.queries.GetUserAccess(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user access: %w", err)
+	}
+	return &UserAccess{Username: row.Username, Enabled: row.QarEnabled}, nil
+}
+
+func (r *postgresRepository) GetUserPIN(ctx context.Context, userID uuid.UUID) (*UserPIN, error) {
+	pin, err := r.queries.GetUserPIN(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPINNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user PIN: %w", err)
+	}
+	return dbPINToPIN(pin), nil
+}
+
+func (r *postgresRepository) UpsertUserPIN(ctx context.Context, userID uuid.UUID, pinHash string) (*UserPIN, error) {
+	pin, err := r.queries.UpsertUserPIN(ctx, adultdb.UpsertUserPINParams{UserID: userID, PinHash: pinHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set user PIN: %w", err)
+	}
+	return dbPINToPIN(pin), nil
+}
+
+func (r *postgresRepository) SetUnlockedUntil(ctx context.Context, userID uuid.UUID, until *time.Time) (*UserPIN, error) {
+	pin, err := r.queries.SetUserPINUnlockedUntil(ctx, adultdb.SetUserPINUnlockedUntilParams{
+		UserID:        userID,
+		UnlockedUntil: timeToPg(until),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPINNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set PIN unlock: %w", err)
+	}
+	return dbPINToPIN(pin), nil
+}
+
+func (r *postgresRepository) RecordPINFailure(ctx context.Context, userID uuid.UUID, maxAttempts int32, lockedUntil time.Time) (*UserPIN, error) {
+	pin, err := r.queries.RecordUserPINFailure(ctx, adultdb.RecordUserPINFailureParams{
+		UserID:      userID,
+		MaxAttempts: maxAttempts,
+		LockedUntil: lockedUntil,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPINNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to record PIN failure: %w", err)
+	}
+	return dbPINToPIN(pin), nil
+}
+
+func (r *postgresRepository) ClearPINFailures(ctx context.Context, userID uuid.UUID) error {
+	if err := r.queries.ClearUserPINFailures(ctx, userID); err != nil {
+		return fmt.Errorf("failed to clear PIN failures: %w", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// Scenes
+// =============================================================================
+
+func (r *postgresRepository) GetScene(ctx context.Context, id uuid.UUID) (*Scene, error) {
+	s, err := r.queries.GetScene(ctx, id)
+	if err != nil {
+		return nil, sceneError(err)
+	}
+	return r.openScene(s)
+}
+
+func (r *postgresRepository) GetSceneByPath(ctx context.Context, filePath string) (*Scene, error) {
+	s, err := r.queries.GetSceneByPathHash(ctx, r.vault.Index(filePath))
+	if err != nil {
+		return nil, sceneError(err)
+	}
+	return r.openScene(s)
+}
+
+func (r *postgresRepository) UpsertScene(ctx context.Context, scanned *ScannedScene) (*Scene, error) {
+	filePathEnc, err := r.vault.Seal(scanned.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt scene: %w", err)
+	}
+	titleEnc, err := r.vault.Seal(scanned.Title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt scene: %w", err)
+	}
+	s, err := r.queries.UpsertScene(ctx, adultdb.UpsertSceneParams{
+		LibraryID:    scanned.LibraryID,
+		FilePathHash: r.vault.Index(scanned.FilePath),
+		FilePathEnc:  filePathEnc,
+		FileSize:     scanned.FileSize,
+		Oshash:       scanned.OSHash,
+		TitleEnc:     titleEnc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert scene: %w", err)
+	}
+	return r.openScene(s)
+}
+
+func (r *postgresRepository) SetSceneMetadata(ctx context.Context, id uuid.UUID, metadata SceneMetadata) error {
+	titleEnc, err := r.vault.Seal(metadata.Title)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt scene: %w", err)
+	}
+	detailsEnc, err := r.vault.SealOptional(metadata.Details)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt scene: %w", err)
+	}
+	imageURLEnc, err := r.vault.SealOptional(metadata.ImageURL)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt scene: %w", err)
+	}
+	matchType := string(metadata.MatchType)
+	if err := r.queries.SetSceneMetadata(ctx, adultdb.SetSceneMetadataParams{
+		ID:              id,
+		StashdbID:       &metadata.StashDBID,
+		StudioID:        uuidToPg(metadata.StudioID),
+		ReleaseDate:     timeToPgDate(metadata.ReleaseDate),
+		DurationSeconds: metadata.DurationSeconds,
+		TitleEnc:        titleEnc,
+		DetailsEnc:      detailsEnc,
+		ImageUrlEnc:     imageURLEnc,
+		MatchType:       &matchType,
+		MatchConfidence: &metadata.Confidence,
+	}); err != nil {
+		return fmt.Errorf("failed to set scene metadata: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresRepository) ResetSceneMatch(ctx context.Context, id uuid.UUID, title string) error {
+	titleEnc, err := r.vault.Seal(title)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt scene: %w", err)
+	}
+	if err := r.queries.ResetSceneMatch(ctx, adultdb.ResetSceneMatchParams{ID: id, TitleEnc: titleEnc}); err != nil {
+		return fmt.Errorf("failed to reset scene match: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresRepository) ListScenes(ctx context.Context, filters SceneListFilters) ([]Scene, error) {
+	rows, err := r.queries.ListScenes(ctx, adultdb.ListScenesParams{
+		Limit:       filters.Limit,
+		Offset:      filters.Offset,
+		Restrict:    filters.LibraryIDs != nil,
+		LibraryIds:  filters.LibraryIDs,
+		LibraryID:   uuidToPg(filters.LibraryID),
+		StudioID:    uuidToPg(filters.StudioID),
+		PerformerID: uuidToPg(filters.PerformerID),
+		MatchStatus: string(filters.MatchStatus),
+		SortBy:      filters.SortBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scenes: %w", err)
+	}
+	scenes := make([]Scene, len(rows))
+	for i, row := range rows {
+		s, err := r.openScene(row)
+		if err != nil {
+			return nil, err
+		}
+		scenes[i] = *s
+	}
+	return scenes, nil
+}
+
+func (r *postgresRepository) CountScenes(ctx context.Context, filters SceneListFilters) (int64, error) {
+	return r.queries.CountScenes(ctx, adultdb.CountScenesParams{
+		Restrict:    filters.LibraryIDs != nil,
+		LibraryIds:  filters.LibraryIDs,
+		LibraryID:   uuidToPg(filters.LibraryID),
+		StudioID:    uuidToPg(filters.StudioID),
+		PerformerID: uuidToPg(filters.PerformerID),
+		MatchStatus: string(filters.MatchStatus),
+	})
+}
+
+func (r *postgresRepository) ListScenePaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	rows, err := r.queries.ListScenePaths(ctx, libraryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scene paths: %w", err)
+	}
+	paths := make(map[string]uuid.UUID, len(rows))
+	for _, row := range rows {
+		path, err := r.vault.Open(row.FilePathEnc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt scene path: %w", err)
+		}
+		paths[path] = row.ID
+	}
+	return paths, nil
+}
+
+func (r *postgresRepository) DeleteScene(ctx context.Context, id uuid.UUID) error {
+	return r.queries.DeleteScene(ctx, id)
+}
+
+// =============================================================================
+// Scene performers
+// =============================================================================
+
+func (r *postgresRepository) ListScenePerformers(ctx context.Context, sceneID uuid.UUID) ([]Performer, error) {
+	rows, err := r.queries.ListScenePerformers(ctx, sceneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scene performers: %w", err)
+	}
+	performers := make([]Performer, len(rows))
+	for i, row := range rows {
+		p, err := r.openPerformer(row, 0)
+		if err != nil {
+			return nil, err
+		}
+		performers[i] = *p
+	}
+	return performers, nil
+}
+
+// SetScenePerformers replaces the performers of a scene.
+func (r *postgresRepository) SetScenePerformers(ctx context.Context, sceneID uuid.UUID, performerIDs []uuid.UUID) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		q := r.queries.WithTx(tx)
+		if err := q.DeleteScenePerformers(ctx, sceneID); err != nil {
+			return fmt.Errorf("failed to clear scene performers: %w", err)
+		}
+		for _, performerID := range performerIDs {
+			if err := q.AddScenePerformer(ctx, adultdb.AddScenePerformerParams{
+				SceneID:     sceneID,
+				PerformerID: performerID,
+			}); err != nil {
+				return fmt.Errorf("failed to add scene performer: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// =============================================================================
+// Performers
+// =============================================================================
+
+func (r *postgresRepository) GetPerformer(ctx context.Context, id uuid.UUID) (*Performer, error) {
+	p, err := r.queries.GetPerformer(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrPerformerNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get performer: %w", err)
+	}
+	return r.openPerformer(p, 0)
+}
+
+func (r *postgresRepository) UpsertPerformer(ctx context.Context, params UpsertPerformerParams) (*Performer, error) {
+	nameEnc, err := r.vault.Seal(params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt performer: %w", err)
+	}
+	disambiguationEnc, err := r.vault.SealOptional(params.Disambiguation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt performer: %w", err)
+	}
+	aliasesEnc, err := r.vault.SealStrings(params.Aliases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt performer: %w", err)
+	}
+	imageURLEnc, err := r.vault.SealOptional(params.ImageURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt performer: %w", err)
+	}
+	p, err := r.queries.UpsertPerformer(ctx, adultdb.UpsertPerformerParams{
+		StashdbID:         &params.StashDBID,
+		Gender:            params.Gender,
+		Country:           params.Country,
+		NameEnc:           nameEnc,
+		DisambiguationEnc: disambiguationEnc,
+		AliasesEnc:        aliasesEnc,
+		ImageUrlEnc:       imageURLEnc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert performer: %w", err)
+	}
+	return r.openPerformer(p, 0)
+}
+
+func (r *postgresRepository) ListPerformers(ctx context.Context, filters ListFilters) ([]Performer, error) {
+	rows, err := r.queries.ListPerformers(ctx, adultdb.ListPerformersParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list performers: %w", err)
+	}
+	performers := make([]Performer, len(rows))
+	for i, row := range rows {
+		p, err := r.openPerformer(adultdb.QarPerformer{
+			ID:                row.ID,
+			StashdbID:         row.StashdbID,
+			Gender:            row.Gender,
+			Country:           row.Country,
+			NameEnc:           row.NameEnc,
+			DisambiguationEnc: row.DisambiguationEnc,
+			AliasesEnc:        row.AliasesEnc,
+			ImageUrlEnc:       row.ImageUrlEnc,
+			CreatedAt:         row.CreatedAt,
+			UpdatedAt:         row.UpdatedAt,
+		}, row.SceneCount)
+		if err != nil {
+			return nil, err
+		}
+		performers[i] = *p
+	}
+	return performers, nil
+}
+
+func (r *postgresRepository) CountPerformers(ctx context.Context, filters ListFilters) (int64, error) {
+	return r.queries.CountPerformers(ctx, adultdb.CountPerformersParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+	})
+}
+
+func (r *postgresRepository) CountPerformerScenes(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (int64, error) {
+	return r.queries.CountPerformerScenes(ctx, adultdb.CountPerformerScenesParams{
+		PerformerID: id,
+		Restrict:    libraryIDs != nil,
+		LibraryIds:  libraryIDs,
+	})
+}
+
+func (r *postgresRepository) DeleteOrphanPerformers(ctx context.Context) (int64, error) {
+	return r.queries.DeleteOrphanPerformers(ctx)
+}
+
+// =============================================================================
+// Studios
+// =============================================================================
+
+func (r *postgresRepository) GetStudio(ctx context.Context, id uuid.UUID) (*Studio, error) {
+	s, err := r.queries.GetStudio(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrStudioNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get studio: %w", err)
+	}
+	return r.openStudio(s, 0)
+}
+
+func (r *postgresRepository) UpsertStudio(ctx context.Context, params UpsertStudioParams) (*Studio, error) {
+	nameEnc, err := r.vault.Seal(params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt studio: %w", err)
+	}
+	imageURLEnc, err := r.vault.SealOptional(params.ImageURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt studio: %w", err)
+	}
+	s, err := r.queries.UpsertStudio(ctx, adultdb.UpsertStudioParams{
+		StashdbID:   &params.StashDBID,
+		ParentID:    uuidToPg(params.ParentID),
+		NameEnc:     nameEnc,
+		ImageUrlEnc: imageURLEnc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert studio: %w", err)
+	}
+	return r.openStudio(s, 0)
+}
+
+func (r *postgresRepository) ListStudios(ctx context.Context, filters ListFilters) ([]Studio, error) {
+	rows, err := r.queries.ListStudios(ctx, adultdb.ListStudiosParams{
+		Limit:      filters.Limit,
+		Offset:     filters.Offset,
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list studios: %w", err)
+	}
+	studios := make([]Studio, len(rows))
+	for i, row := range rows {
+		s, err := r.openStudio(adultdb.QarStudio{
+			ID:          row.ID,
+			StashdbID:   row.StashdbID,
+			ParentID:    row.ParentID,
+			NameEnc:     row.NameEnc,
+			ImageUrlEnc: row.ImageUrlEnc,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		}, row.SceneCount)
+		if err != nil {
+			return nil, err
+		}
+		studios[i] = *s
+	}
+	return studios, nil
+}
+
+func (r *postgresRepository) CountStudios(ctx context.Context, filters ListFilters) (int64, error) {
+	return r.queries.CountStudios(ctx, adultdb.CountStudiosParams{
+		Restrict:   filters.LibraryIDs != nil,
+		LibraryIds: filters.LibraryIDs,
+	})
+}
+
+func (r *postgresRepository) CountStudioScenes(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (int64, error) {
+	return r.queries.CountStudioScenes(ctx, adultdb.CountStudioScenesParams{
+		StudioID:   pgtype.UUID{Bytes: id, Valid: true},
+		Restrict:   libraryIDs != nil,
+		LibraryIds: libraryIDs,
+	})
+}
+
+func (r *postgresRepository) DeleteOrphanStudios(ctx context.Context) (int64, error) {
+	return r.queries.DeleteOrphanStudios(ctx)
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+func sceneError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrSceneNotFound
+	}
+	return fmt.Errorf("failed to get scene: %w", err)
+}
+
+// openScene converts a scene row, decrypting its sensitive fields.
+func (r *postgresRepository) openScene(s adultdb.QarScene) (*Scene, error) {
+	filePath, err := r.vault.Open(s.FilePathEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt scene: %w", err)
+	}
+	title, err := r.vault.Open(s.TitleEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt scene: %w", err)
+	}
+	details, err := r.vault.OpenOptional(s.DetailsEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt scene: %w", err)
+	}
+	imageURL, err := r.vault.OpenOptional(s.ImageUrlEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt scene: %w", err)
+	}
+	scene := &Scene{
+		ID:              s.ID,
+		LibraryID:       s.LibraryID,
+		FilePath:        filePath,
+		FileSize:        s.FileSize,
+		OSHash:          s.Oshash,
+		StashDBID:       s.StashdbID,
+		StudioID:        pgToUUID(s.StudioID),
+		Title:           title,
+		Details:         details,
+		ReleaseDate:     pgDateToTimePtr(s.ReleaseDate),
+		DurationSeconds: s.DurationSeconds,
+		ImageURL:        imageURL,
+		MatchStatus:     MatchStatus(s.MatchStatus),
+		MatchConfidence: s.MatchConfidence,
+		MatchedAt:       pgToTimePtr(s.MatchedAt),
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
+	}
+	if s.MatchType != nil {
+		t := MatchType(*s.MatchType)
+		scene.MatchType = &t
+	}
+	return scene, nil
+}
+
+// openPerformer converts a performer row, decrypting its sensitive fields.
+func (r *postgresRepository) openPerformer(p adultdb.QarPerformer, sceneCount int64) (*Performer, error) {
+	name, err := r.vault.Open(p.NameEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt performer: %w", err)
+	}
+	disambiguation, err := r.vault.OpenOptional(p.DisambiguationEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt performer: %w", err)
+	}
+	aliases, err := r.vault.OpenStrings(p.AliasesEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt performer: %w", err)
+	}
+	imageURL, err := r.vault.OpenOptional(p.ImageUrlEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt performer: %w", err)
+	}
+	return &Performer{
+		ID:             p.ID,
+		StashDBID:      p.StashdbID,
+		Name:           name,
+		Disambiguation: disambiguation,
+		Aliases:        aliases,
+		Gender:         p.Gender,
+		Country:        p.Country,
+		ImageURL:       imageURL,
+		SceneCount:     sceneCount,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}, nil
+}
+
+// openStudio converts a studio row, decrypting its sensitive fields.
+func (r *postgresRepository) openStudio(s adultdb.QarStudio, sceneCount int64) (*Studio, error) {
+	name, err := r.vault.Open(s.NameEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt studio: %w", err)
+	}
+	imageURL, err := r.vault.OpenOptional(s.ImageUrlEnc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt studio: %w", err)
+	}
+	return &Studio{
+		ID:         s.ID,
+		StashDBID:  s.StashdbID,
+		ParentID:   pgToUUID(s.ParentID),
+		Name:       name,
+		ImageURL:   imageURL,
+		SceneCount: sceneCount,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}, nil
+}
+
+func dbPINToPIN(p adultdb.QarUserPin) *UserPIN {
+	return &UserPIN{
+		UserID:         p.UserID,
+		PINHash:        p.PinHash,
+		UnlockedUntil:  pgToTimePtr(p.UnlockedUntil),
+		FailedAttempts: p.PinFailedAttempts,
+		LockedUntil:    pgToTimePtr(p.PinLockedUntil),
+	}
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil || *id == uuid.Nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
+
+func timeToPg(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func pgToTimePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func timeToPgDate(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *t, Valid: true}
+}
+
+func pgDateToTimePtr(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
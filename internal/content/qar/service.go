@@ -0,0 +1,635 @@
+package qar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/qar/stashdb"
+	"github.com/lusoris/revenge/internal/crypto"
+	"github.com/lusoris/revenge/internal/service/activity"
+)
+
+const (
+	// UnlockDuration is how long content stays unlocked after the PIN was
+	// entered.
+	UnlockDuration = 30 * time.Minute
+)
+
+// Config holds the privacy settings of the QAR module.
+type Config struct {
+	// RequirePIN requires content to be unlocked with the user's PIN.
+	RequirePIN bool
+	// AuditAllAccess records every read in the activity log.
+	AuditAllAccess bool
+}
+
+// Service defines business logic for QAR content. Every read takes the
+// viewer and fails with ErrAccessDenied, ErrPINNotSet or ErrLocked unless
+// the viewer was granted QAR access and, when required, has unlocked the
+// content with their PIN. Library permissions are checked by the caller.
+type Service interface {
+	// Access
+	GetAccess(ctx context.Context, userID uuid.UUID) (*AccessStatus, error)
+	SetPIN(ctx context.Context, v Viewer, currentPIN, newPIN string) (*AccessStatus, error)
+	Unlock(ctx context.Context, v Viewer, pin string) (*AccessStatus, error)
+	Lock(ctx context.Context, v Viewer) (*AccessStatus, error)
+
+	// Scenes
+	ListScenes(ctx context.Context, v Viewer, filters SceneListFilters) ([]Scene, int64, error)
+	GetScene(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Scene, error)
+	OpenSceneFile(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Scene, *os.File, error)
+
+	// Performers and studios
+	ListPerformers(ctx context.Context, v Viewer, filters ListFilters) ([]Performer, int64, error)
+	GetPerformer(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Performer, error)
+	ListStudios(ctx context.Context, v Viewer, filters ListFilters) ([]Studio, int64, error)
+	GetStudio(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Studio, error)
+
+	// Library import
+	GetSceneByPath(ctx context.Context, filePath string) (*Scene, error)
+	ImportScene(ctx context.Context, scanned *ScannedScene) (*Scene, error)
+	MatchScene(ctx context.Context, scene *Scene, date *time.Time) (*MatchResult, error)
+	RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error)
+	Prune(ctx context.Context) error
+}
+
+// qarService implements the Service interface
+type qarService struct {
+	repo           Repository
+	matcher        *Matcher // nil when no metadata provider is configured
+	activityLogger activity.Logger
+	hasher         *crypto.PasswordHasher
+	cfg            Config
+	logger         *slog.Logger
+	now            func() time.Time
+}
+
+// NewService creates a new QAR service. Scenes are matched with provider,
+// which may be nil.
+func NewService(repo Repository, provider SceneProvider, activityLogger activity.Logger, cfg Config, logger *slog.Logger) Service {
+	s := &qarService{
+		repo:           repo,
+		activityLogger: activityLogger,
+		hasher:         crypto.NewPasswordHasher(),
+		cfg:            cfg,
+		logger:         logger.With("component", "qar"),
+		now:            time.Now,
+	}
+	if provider != nil {
+		s.matcher = NewMatcher(provider)
+	}
+	return s
+}
+
+// =============================================================================
+// Access
+// =============================================================================
+
+func (s *qarService) GetAccess(ctx context.Context, userID uuid.UUID) (*AccessStatus, error) {
+	status, _, err := s.access(ctx, userID)
+	return status, err
+}
+
+// access returns the access status and username of a user.
+func (s *qarService) access(ctx context.Context, userID uuid.UUID) (*AccessStatus, string, error) {
+	user, err := s.repo.GetUserAccess(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	status := &AccessStatus{Granted: user.Enabled, PINRequired: s.cfg.RequirePIN}
+	pin, err := s.repo.GetUserPIN(ctx, userID)
+	switch {
+	case errors.Is(err, ErrPINNotFound):
+	case err != nil:
+		return nil, "", err
+	default:
+		status.PINSet = true
+		if pin.UnlockedUntil != nil && pin.UnlockedUntil.After(s.now()) {
+			status.UnlockedUntil = pin.UnlockedUntil
+		}
+	}
+	return status, user.Username, nil
+}
+
+// authorize checks that the viewer may read content and returns their
+// username for the audit log.
+func (s *qarService) authorize(ctx context.Context, v Viewer) (string, error) {
+	status, username, err := s.access(ctx, v.UserID)
+	if errors.Is(err, ErrUserNotFound) {
+		return "", ErrAccessDenied
+	}
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case !status.Granted:
+		return "", ErrAccessDenied
+	case status.PINRequired && !status.PINSet:
+		return "", ErrPINNotSet
+	case !status.Accessible():
+		return "", ErrLocked
+	}
+	return username, nil
+}
+
+// SetPIN sets the viewer's PIN. Changing an existing PIN requires the
+// current one. A new PIN locks the content.
+func (s *qarService) SetPIN(ctx context.Context, v Viewer, currentPIN, newPIN string) (*AccessStatus, error) {
+	status, username, err := s.access(ctx, v.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Granted {
+		return nil, ErrAccessDenied
+	}
+	if !content.ValidPIN(newPIN) {
+		return nil, ErrPINFormat
+	}
+	if status.PINSet {
+		if err := s.verifyPIN(ctx, v, username, currentPIN, activity.ActionQARPINSet); err != nil {
+			return nil, err
+		}
+	}
+
+	hash, err := s.hasher.HashPasswordContext(ctx, newPIN)
+	if err != nil {
+		return nil, fmt.Errorf("hash PIN: %w", err)
+	}
+	if _, err := s.repo.UpsertUserPIN(ctx, v.UserID, hash); err != nil {
+		return nil, err
+	}
+	s.logAction(ctx, v, username, activity.ActionQARPINSet, "", uuid.Nil, nil)
+
+	status.PINSet = true
+	status.UnlockedUntil = nil
+	return status, nil
+}
+
+// Unlock unlocks the content for UnlockDuration when the PIN matches.
+func (s *qarService) Unlock(ctx context.Context, v Viewer, pin string) (*AccessStatus, error) {
+	status, username, err := s.access(ctx, v.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Granted {
+		return nil, ErrAccessDenied
+	}
+	if !status.PINSet {
+		return nil, ErrPINNotSet
+	}
+	if err := s.verifyPIN(ctx, v, username, pin, activity.ActionQARUnlock); err != nil {
+		return nil, err
+	}
+
+	until := s.now().Add(UnlockDuration)
+	if _, err := s.repo.SetUnlockedUntil(ctx, v.UserID, &until); err != nil {
+		return nil, err
+	}
+	s.logAction(ctx, v, username, activity.ActionQARUnlock, "", uuid.Nil, map[string]any{
+		"unlocked_until": until,
+	})
+
+	status.UnlockedUntil = &until
+	return status, nil
+}
+
+// Lock ends the viewer's unlock before it expires.
+func (s *qarService) Lock(ctx context.Context, v Viewer) (*AccessStatus, error) {
+	status, username, err := s.access(ctx, v.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if status.PINSet {
+		if _, err := s.repo.SetUnlockedUntil(ctx, v.UserID, nil); err != nil {
+			return nil, err
+		}
+		s.logAction(ctx, v, username, activity.ActionQARLock, "", uuid.Nil, nil)
+	}
+	status.UnlockedUntil = nil
+	return status, nil
+}
+
+// verifyPIN checks the viewer's PIN. Mismatches are always recorded in
+// the activity log. After content.MaxPINAttempts mismatches in a row, PIN
+// entry is refused for content.PINLockoutDuration.
+func (s *qarService) verifyPIN(ctx context.Context, v Viewer, username, pin, action string) error {
+	stored, err := s.repo.GetUserPIN(ctx, v.UserID)
+	if err != nil {
+		return err
+	}
+	if content.PINLockedOut(stored.LockedUntil, s.now()) {
+		return ErrPINLockedOut
+	}
+	ok, err := s.hasher.VerifyPasswordContext(ctx, pin, stored.PINHash)
+	if err != nil {
+		return fmt.Errorf("verify PIN: %w", err)
+	}
+	if ok {
+		if stored.FailedAttempts > 0 || stored.LockedUntil != nil {
+			if err := s.repo.ClearPINFailures(ctx, v.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	s.logger.Warn("invalid QAR PIN", slog.String("user_id", v.UserID.String()))
+	if _, err := s.repo.RecordPINFailure(ctx, v.UserID, content.MaxPINAttempts, s.now().Add(content.PINLockoutDuration)); err != nil {
+		return err
+	}
+	req := activity.LogFailureRequest{
+		UserID:       &v.UserID,
+		Username:     &username,
+		Action:       action,
+		ErrorMessage: ErrInvalidPIN.Error(),
+	}
+	if ip := net.ParseIP(v.IPAddress); ip != nil {
+		req.IPAddress = &ip
+	}
+	if v.UserAgent != "" {
+		req.UserAgent = &v.UserAgent
+	}
+	_ = s.activityLogger.LogFailure(ctx, req)
+	return ErrInvalidPIN
+}
+
+// =============================================================================
+// Scenes
+// =============================================================================
+
+func (s *qarService) ListScenes(ctx context.Context, v Viewer, filters SceneListFilters) ([]Scene, int64, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, 0, err
+	}
+	scenes, err := s.repo.ListScenes(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.CountScenes(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count scenes: %w", err)
+	}
+	s.audit(ctx, v, username, activity.ActionQARSceneList, activity.ResourceTypeQARScene, uuid.Nil, sceneFilterMetadata(filters))
+	return scenes, total, nil
+}
+
+// GetScene returns a scene with its studio and performers. Scenes outside
+// the given libraries are not found.
+func (s *qarService) GetScene(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Scene, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	scene, err := s.sceneIn(ctx, id, libraryIDs)
+	if err != nil {
+		return nil, err
+	}
+	if scene.StudioID != nil {
+		studio, err := s.repo.GetStudio(ctx, *scene.StudioID)
+		if err != nil && !errors.Is(err, ErrStudioNotFound) {
+			return nil, err
+		}
+		scene.Studio = studio
+	}
+	if scene.Performers, err = s.repo.ListScenePerformers(ctx, id); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, v, username, activity.ActionQARSceneView, activity.ResourceTypeQARScene, id, nil)
+	return scene, nil
+}
+
+// OpenSceneFile opens the file of a scene in the given libraries.
+func (s *qarService) OpenSceneFile(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Scene, *os.File, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, nil, err
+	}
+	scene, err := s.sceneIn(ctx, id, libraryIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The error names the scene, not the file, to keep paths out of logs
+	f, err := os.Open(scene.FilePath) // #nosec G304 -- file path comes from the library scan
+	if err != nil {
+		return nil, nil, fmt.Errorf("open file of scene %s: %w", id, errors.Unwrap(err))
+	}
+	s.audit(ctx, v, username, activity.ActionQARScenePlay, activity.ResourceTypeQARScene, id, nil)
+	return scene, f, nil
+}
+
+// sceneIn returns a scene if it belongs to one of the libraries; nil
+// libraryIDs allow all of them.
+func (s *qarService) sceneIn(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (*Scene, error) {
+	scene, err := s.repo.GetScene(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if libraryIDs != nil && !slices.Contains(libraryIDs, scene.LibraryID) {
+		return nil, ErrSceneNotFound
+	}
+	return scene, nil
+}
+
+// =============================================================================
+// Performers and Studios
+// =============================================================================
+
+func (s *qarService) ListPerformers(ctx context.Context, v Viewer, filters ListFilters) ([]Performer, int64, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, 0, err
+	}
+	performers, err := s.repo.ListPerformers(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.CountPerformers(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count performers: %w", err)
+	}
+	s.audit(ctx, v, username, activity.ActionQARPerformerList, activity.ResourceTypeQARPerformer, uuid.Nil, nil)
+	return performers, total, nil
+}
+
+// GetPerformer returns a performer with their number of scenes in the
+// given libraries. Performers without scenes there are not found.
+func (s *qarService) GetPerformer(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Performer, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	performer, err := s.repo.GetPerformer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if performer.SceneCount, err = s.repo.CountPerformerScenes(ctx, id, libraryIDs); err != nil {
+		return nil, fmt.Errorf("failed to count performer scenes: %w", err)
+	}
+	if performer.SceneCount == 0 && libraryIDs != nil {
+		return nil, ErrPerformerNotFound
+	}
+	s.audit(ctx, v, username, activity.ActionQARPerformerView, activity.ResourceTypeQARPerformer, id, nil)
+	return performer, nil
+}
+
+func (s *qarService) ListStudios(ctx context.Context, v Viewer, filters ListFilters) ([]Studio, int64, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, 0, err
+	}
+	studios, err := s.repo.ListStudios(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.CountStudios(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count studios: %w", err)
+	}
+	s.audit(ctx, v, username, activity.ActionQARStudioList, activity.ResourceTypeQARStudio, uuid.Nil, nil)
+	return studios, total, nil
+}
+
+// GetStudio returns a studio with its number of scenes in the given
+// libraries. Studios without scenes there are not found.
+func (s *qarService) GetStudio(ctx context.Context, v Viewer, id uuid.UUID, libraryIDs []uuid.UUID) (*Studio, error) {
+	username, err := s.authorize(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	studio, err := s.repo.GetStudio(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if studio.SceneCount, err = s.repo.CountStudioScenes(ctx, id, libraryIDs); err != nil {
+		return nil, fmt.Errorf("failed to count studio scenes: %w", err)
+	}
+	if studio.SceneCount == 0 && libraryIDs != nil {
+		return nil, ErrStudioNotFound
+	}
+	s.audit(ctx, v, username, activity.ActionQARStudioView, activity.ResourceTypeQARStudio, id, nil)
+	return studio, nil
+}
+
+// =============================================================================
+// Library Import
+// =============================================================================
+
+func (s *qarService) GetSceneByPath(ctx context.Context, filePath string) (*Scene, error) {
+	return s.repo.GetSceneByPath(ctx, filePath)
+}
+
+// ImportScene creates or updates the scene of a file. A file whose content
+// changed loses the metadata of its previous match.
+func (s *qarService) ImportScene(ctx context.Context, scanned *ScannedScene) (*Scene, error) {
+	existing, err := s.repo.GetSceneByPath(ctx, scanned.FilePath)
+	if err != nil && !errors.Is(err, ErrSceneNotFound) {
+		return nil, err
+	}
+	scene, err := s.repo.UpsertScene(ctx, scanned)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.OSHash == scanned.OSHash || existing.MatchStatus == MatchStatusUnmatched {
+		return scene, nil
+	}
+	if err := s.repo.ResetSceneMatch(ctx, scene.ID, scanned.Title); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetScenePerformers(ctx, scene.ID, nil); err != nil {
+		return nil, err
+	}
+	return s.repo.GetScene(ctx, scene.ID)
+}
+
+// MatchScene matches an unmatched scene by its fingerprint or title and
+// stores the metadata, studio and performers of the match. It returns
+// ErrNoMatch when no scene was found.
+func (s *qarService) MatchScene(ctx context.Context, scene *Scene, date *time.Time) (*MatchResult, error) {
+	if s.matcher == nil {
+		return nil, ErrMatchingDisabled
+	}
+	result, err := s.matcher.Match(ctx, scene.OSHash, scene.Title, date)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyMatch(ctx, scene.ID, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// applyMatch stores a matched stash-box scene.
+func (s *qarService) applyMatch(ctx context.Context, sceneID uuid.UUID, result *MatchResult) error {
+	match := result.Scene
+
+	var studioID *uuid.UUID
+	if match.Studio != nil {
+		studio, err := s.upsertStudio(ctx, match.Studio)
+		if err != nil {
+			return err
+		}
+		studioID = &studio.ID
+	}
+
+	performerIDs := make([]uuid.UUID, 0, len(match.Performers))
+	for _, appearance := range match.Performers {
+		p := appearance.Performer
+		performer, err := s.repo.UpsertPerformer(ctx, UpsertPerformerParams{
+			StashDBID:      p.ID,
+			Name:           p.Name,
+			Disambiguation: optional(p.Disambiguation),
+			Aliases:        p.Aliases,
+			Gender:         optional(p.Gender),
+			Country:        optional(p.Country),
+			ImageURL:       optional(stashdb.PrimaryImage(p.Images)),
+		})
+		if err != nil {
+			return err
+		}
+		performerIDs = append(performerIDs, performer.ID)
+	}
+
+	metadata := SceneMetadata{
+		StashDBID:  match.ID,
+		StudioID:   studioID,
+		Title:      match.Title,
+		Details:    optional(match.Details),
+		ImageURL:   optional(stashdb.PrimaryImage(match.Images)),
+		MatchType:  result.MatchType,
+		Confidence: result.Confidence,
+	}
+	if released, ok := ParseDate(match.Date); ok {
+		metadata.ReleaseDate = &released
+	}
+	if match.Duration > 0 {
+		duration := int32(min(match.Duration, 1<<31-1)) // #nosec G115 -- clamped above
+		metadata.DurationSeconds = &duration
+	}
+	if err := s.repo.SetSceneMetadata(ctx, sceneID, metadata); err != nil {
+		return err
+	}
+	return s.repo.SetScenePerformers(ctx, sceneID, performerIDs)
+}
+
+// upsertStudio stores a studio and its parent network.
+func (s *qarService) upsertStudio(ctx context.Context, studio *stashdb.Studio) (*Studio, error) {
+	params := UpsertStudioParams{
+		StashDBID: studio.ID,
+		Name:      studio.Name,
+		ImageURL:  optional(stashdb.PrimaryImage(studio.Images)),
+	}
+	if studio.Parent != nil {
+		parent, err := s.upsertStudio(ctx, studio.Parent)
+		if err != nil {
+			return nil, err
+		}
+		params.ParentID = &parent.ID
+	}
+	return s.repo.UpsertStudio(ctx, params)
+}
+
+// RemoveMissing deletes the scenes of a library whose files were not found
+// below the scanned roots.
+func (s *qarService) RemoveMissing(ctx context.Context, libraryID uuid.UUID, roots []string, present map[string]bool) (int, error) {
+	paths, err := s.repo.ListScenePaths(ctx, libraryID)
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for path, id := range paths {
+		if present[path] || !underAny(path, roots) {
+			continue
+		}
+		if err := s.repo.DeleteScene(ctx, id); err != nil {
+			return removed, fmt.Errorf("failed to delete scene %s: %w", id, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// Prune removes performers and studios left without scenes.
+func (s *qarService) Prune(ctx context.Context) error {
+	if _, err := s.repo.DeleteOrphanPerformers(ctx); err != nil {
+		return fmt.Errorf("failed to delete orphan performers: %w", err)
+	}
+	if _, err := s.repo.DeleteOrphanStudios(ctx); err != nil {
+		return fmt.Errorf("failed to delete orphan studios: %w", err)
+	}
+	return nil
+}
+
+// =============================================================================
+// Audit
+// =============================================================================
+
+// audit records a read when all access is audited. Entries carry IDs and
+// filters only; titles and names stay out of the unencrypted activity log.
+func (s *qarService) audit(ctx context.Context, v Viewer, username, action, resourceType string, resourceID uuid.UUID, metadata map[string]any) {
+	if !s.cfg.AuditAllAccess {
+		return
+	}
+	s.logAction(ctx, v, username, action, resourceType, resourceID, metadata)
+}
+
+// logAction records an action in the activity log.
+func (s *qarService) logAction(ctx context.Context, v Viewer, username, action, resourceType string, resourceID uuid.UUID, metadata map[string]any) {
+	_ = s.activityLogger.LogAction(ctx, activity.LogActionRequest{
+		UserID:       v.UserID,
+		Username:     username,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Metadata:     metadata,
+		IPAddress:    net.ParseIP(v.IPAddress),
+		UserAgent:    v.UserAgent,
+	})
+}
+
+// sceneFilterMetadata describes a scene listing for the audit log.
+func sceneFilterMetadata(filters SceneListFilters) map[string]any {
+	metadata := map[string]any{"limit": filters.Limit, "offset": filters.Offset}
+	if filters.LibraryID != nil {
+		metadata["library_id"] = filters.LibraryID.String()
+	}
+	if filters.StudioID != nil {
+		metadata["studio_id"] = filters.StudioID.String()
+	}
+	if filters.PerformerID != nil {
+		metadata["performer_id"] = filters.PerformerID.String()
+	}
+	return metadata
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func underAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
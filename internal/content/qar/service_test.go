@@ -0,0 +1,600 @@
+package qar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/qar/stashdb"
+	"github.com/lusoris/revenge/internal/crypto"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/activity"
+)
+
+// MockRepository is a mock implementation of Repository for testing
+type MockRepository struct {
+	mock.Mock
+}
+
+// Access operations
+func (m *MockRepository) GetUserAccess(ctx context.Context, userID uuid.UUID) (*UserAccess, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserAccess), args.Error(1)
+}
+
+func (m *MockRepository) GetUserPIN(ctx context.Context, userID uuid.UUID) (*UserPIN, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserPIN), args.Error(1)
+}
+
+func (m *MockRepository) UpsertUserPIN(ctx context.Context, userID uuid.UUID, pinHash string) (*UserPIN, error) {
+	args := m.Called(ctx, userID, pinHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserPIN), args.Error(1)
+}
+
+func (m *MockRepository) SetUnlockedUntil(ctx context.Context, userID uuid.UUID, until *time.Time) (*UserPIN, error) {
+	args := m.Called(ctx, userID, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserPIN), args.Error(1)
+}
+
+func (m *MockRepository) RecordPINFailure(ctx context.Context, userID uuid.UUID, maxAttempts int32, lockedUntil time.Time) (*UserPIN, error) {
+	args := m.Called(ctx, userID, maxAttempts, lockedUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserPIN), args.Error(1)
+}
+
+func (m *MockRepository) ClearPINFailures(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// Scene operations
+func (m *MockRepository) GetScene(ctx context.Context, id uuid.UUID) (*Scene, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Scene), args.Error(1)
+}
+
+func (m *MockRepository) GetSceneByPath(ctx context.Context, filePath string) (*Scene, error) {
+	args := m.Called(ctx, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Scene), args.Error(1)
+}
+
+func (m *MockRepository) UpsertScene(ctx context.Context, scanned *ScannedScene) (*Scene, error) {
+	args := m.Called(ctx, scanned)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Scene), args.Error(1)
+}
+
+func (m *MockRepository) SetSceneMetadata(ctx context.Context, id uuid.UUID, metadata SceneMetadata) error {
+	return m.Called(ctx, id, metadata).Error(0)
+}
+
+func (m *MockRepository) ResetSceneMatch(ctx context.Context, id uuid.UUID, title string) error {
+	return m.Called(ctx, id, title).Error(0)
+}
+
+func (m *MockRepository) ListScenes(ctx context.Context, filters SceneListFilters) ([]Scene, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Scene), args.Error(1)
+}
+
+func (m *MockRepository) CountScenes(ctx context.Context, filters SceneListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListScenePaths(ctx context.Context, libraryID uuid.UUID) (map[string]uuid.UUID, error) {
+	args := m.Called(ctx, libraryID)
+	return args.Get(0).(map[string]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRepository) DeleteScene(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+// Scene performer operations
+func (m *MockRepository) ListScenePerformers(ctx context.Context, sceneID uuid.UUID) ([]Performer, error) {
+	args := m.Called(ctx, sceneID)
+	return args.Get(0).([]Performer), args.Error(1)
+}
+
+func (m *MockRepository) SetScenePerformers(ctx context.Context, sceneID uuid.UUID, performerIDs []uuid.UUID) error {
+	return m.Called(ctx, sceneID, performerIDs).Error(0)
+}
+
+// Performer operations
+func (m *MockRepository) GetPerformer(ctx context.Context, id uuid.UUID) (*Performer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Performer), args.Error(1)
+}
+
+func (m *MockRepository) UpsertPerformer(ctx context.Context, params UpsertPerformerParams) (*Performer, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Performer), args.Error(1)
+}
+
+func (m *MockRepository) ListPerformers(ctx context.Context, filters ListFilters) ([]Performer, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Performer), args.Error(1)
+}
+
+func (m *MockRepository) CountPerformers(ctx context.Context, filters ListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) CountPerformerScenes(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (int64, error) {
+	args := m.Called(ctx, id, libraryIDs)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrphanPerformers(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Studio operations
+func (m *MockRepository) GetStudio(ctx context.Context, id uuid.UUID) (*Studio, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Studio), args.Error(1)
+}
+
+func (m *MockRepository) UpsertStudio(ctx context.Context, params UpsertStudioParams) (*Studio, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Studio), args.Error(1)
+}
+
+func (m *MockRepository) ListStudios(ctx context.Context, filters ListFilters) ([]Studio, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]Studio), args.Error(1)
+}
+
+func (m *MockRepository) CountStudios(ctx context.Context, filters ListFilters) (int64, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) CountStudioScenes(ctx context.Context, id uuid.UUID, libraryIDs []uuid.UUID) (int64, error) {
+	args := m.Called(ctx, id, libraryIDs)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrphanStudios(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// recordingLogger records the activity log entries.
+type recordingLogger struct {
+	actions  []activity.LogActionRequest
+	failures []activity.LogFailureRequest
+}
+
+func (l *recordingLogger) LogAction(ctx context.Context, req activity.LogActionRequest) error {
+	l.actions = append(l.actions, req)
+	return nil
+}
+
+func (l *recordingLogger) LogFailure(ctx context.Context, req activity.LogFailureRequest) error {
+	l.failures = append(l.failures, req)
+	return nil
+}
+
+// fakeProvider returns fixed stash-box results.
+type fakeProvider struct {
+	byFingerprint []stashdb.Scene
+	bySearch      []stashdb.Scene
+}
+
+func (p *fakeProvider) FindScenesByFingerprint(ctx context.Context, algorithm stashdb.FingerprintAlgorithm, hash string) ([]stashdb.Scene, error) {
+	return p.byFingerprint, nil
+}
+
+func (p *fakeProvider) SearchScenes(ctx context.Context, term string) ([]stashdb.Scene, error) {
+	return p.bySearch, nil
+}
+
+func newTestService(repo *MockRepository, provider SceneProvider, cfg Config) (*qarService, *recordingLogger) {
+	audit := &recordingLogger{}
+	svc := NewService(repo, provider, audit, cfg, logging.NewTestLogger()).(*qarService)
+	return svc, audit
+}
+
+func hashPIN(t *testing.T, pin string) string {
+	t.Helper()
+	hash, err := crypto.NewPasswordHasher().HashPassword(pin)
+	require.NoError(t, err)
+	return hash
+}
+
+func TestService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	viewer := Viewer{UserID: userID, IPAddress: "192.0.2.1"}
+	future := time.Now().Add(time.Minute)
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name       string
+		access     *UserAccess
+		accessErr  error
+		pin        *UserPIN
+		requirePIN bool
+		wantErr    error
+	}{
+		{name: "unknown user", accessErr: ErrUserNotFound, wantErr: ErrAccessDenied},
+		{name: "not granted", access: &UserAccess{Username: "u"}, wantErr: ErrAccessDenied},
+		{name: "granted without PIN gate", access: &UserAccess{Username: "u", Enabled: true}},
+		{name: "PIN not set", access: &UserAccess{Username: "u", Enabled: true}, requirePIN: true, wantErr: ErrPINNotSet},
+		{name: "locked", access: &UserAccess{Username: "u", Enabled: true}, pin: &UserPIN{PINHash: "x"}, requirePIN: true, wantErr: ErrLocked},
+		{name: "unlock expired", access: &UserAccess{Username: "u", Enabled: true}, pin: &UserPIN{PINHash: "x", UnlockedUntil: &past}, requirePIN: true, wantErr: ErrLocked},
+		{name: "unlocked", access: &UserAccess{Username: "u", Enabled: true}, pin: &UserPIN{PINHash: "x", UnlockedUntil: &future}, requirePIN: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			repo.On("GetUserAccess", ctx, userID).Return(tt.access, tt.accessErr)
+			if tt.pin != nil {
+				repo.On("GetUserPIN", ctx, userID).Return(tt.pin, nil)
+			} else {
+				repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+			}
+			svc, _ := newTestService(repo, nil, Config{RequirePIN: tt.requirePIN})
+
+			_, err := svc.authorize(ctx, viewer)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestService_GetScene_Audit(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	sceneID := uuid.New()
+
+	for _, auditAll := range []bool{false, true} {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "alice", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+		repo.On("GetScene", ctx, sceneID).Return(&Scene{ID: sceneID, Title: "Secret Title"}, nil)
+		repo.On("ListScenePerformers", ctx, sceneID).Return([]Performer{}, nil)
+		svc, audit := newTestService(repo, nil, Config{AuditAllAccess: auditAll})
+
+		scene, err := svc.GetScene(ctx, Viewer{UserID: userID, IPAddress: "192.0.2.1", UserAgent: "test"}, sceneID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Secret Title", scene.Title)
+
+		if !auditAll {
+			assert.Empty(t, audit.actions)
+			continue
+		}
+		require.Len(t, audit.actions, 1)
+		entry := audit.actions[0]
+		assert.Equal(t, activity.ActionQARSceneView, entry.Action)
+		assert.Equal(t, activity.ResourceTypeQARScene, entry.ResourceType)
+		assert.Equal(t, sceneID, entry.ResourceID)
+		assert.Equal(t, "alice", entry.Username)
+		assert.Equal(t, "192.0.2.1", entry.IPAddress.String())
+		assert.Nil(t, entry.Metadata)
+	}
+}
+
+func TestService_GetScene_OutsideLibraries(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	sceneID := uuid.New()
+
+	repo := new(MockRepository)
+	repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+	repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+	repo.On("GetScene", ctx, sceneID).Return(&Scene{ID: sceneID, LibraryID: uuid.New()}, nil)
+	svc, audit := newTestService(repo, nil, Config{AuditAllAccess: true})
+
+	_, err := svc.GetScene(ctx, Viewer{UserID: userID}, sceneID, []uuid.UUID{uuid.New()})
+	assert.ErrorIs(t, err, ErrSceneNotFound)
+	assert.Empty(t, audit.actions)
+}
+
+func TestService_ListScenes_Denied(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := new(MockRepository)
+	repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "bob"}, nil)
+	repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+	svc, audit := newTestService(repo, nil, Config{AuditAllAccess: true})
+
+	_, _, err := svc.ListScenes(ctx, Viewer{UserID: userID}, SceneListFilters{Limit: 10})
+	assert.ErrorIs(t, err, ErrAccessDenied)
+	assert.Empty(t, audit.actions)
+	repo.AssertNotCalled(t, "ListScenes", mock.Anything, mock.Anything)
+}
+
+func TestService_SetPIN(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	viewer := Viewer{UserID: userID}
+
+	t.Run("first PIN", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+		repo.On("UpsertUserPIN", ctx, userID, mock.AnythingOfType("string")).Return(&UserPIN{UserID: userID}, nil)
+		svc, audit := newTestService(repo, nil, Config{RequirePIN: true})
+
+		status, err := svc.SetPIN(ctx, viewer, "", "1234")
+		require.NoError(t, err)
+		assert.True(t, status.PINSet)
+		assert.False(t, status.Accessible())
+		require.Len(t, audit.actions, 1)
+		assert.Equal(t, activity.ActionQARPINSet, audit.actions[0].Action)
+
+		hash := repo.Calls[2].Arguments.String(2)
+		assert.NotEqual(t, "1234", hash)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+		svc, _ := newTestService(repo, nil, Config{RequirePIN: true})
+
+		for _, pin := range []string{"123", "123456789", "12a4", "١٢٣٤"} {
+			_, err := svc.SetPIN(ctx, viewer, "", pin)
+			assert.ErrorIs(t, err, ErrPINFormat, pin)
+		}
+	})
+
+	t.Run("change requires current PIN", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(&UserPIN{UserID: userID, PINHash: hashPIN(t, "1234")}, nil)
+		repo.On("RecordPINFailure", ctx, userID, int32(content.MaxPINAttempts), mock.AnythingOfType("time.Time")).Return(&UserPIN{UserID: userID}, nil)
+		svc, audit := newTestService(repo, nil, Config{RequirePIN: true})
+
+		_, err := svc.SetPIN(ctx, viewer, "9999", "5678")
+		assert.ErrorIs(t, err, ErrInvalidPIN)
+		require.Len(t, audit.failures, 1)
+		assert.Equal(t, activity.ActionQARPINSet, audit.failures[0].Action)
+		repo.AssertNotCalled(t, "UpsertUserPIN", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Unlock(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	viewer := Viewer{UserID: userID, IPAddress: "192.0.2.1"}
+	pin := &UserPIN{UserID: userID, PINHash: hashPIN(t, "1234")}
+
+	t.Run("valid PIN", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(pin, nil)
+		repo.On("SetUnlockedUntil", ctx, userID, mock.AnythingOfType("*time.Time")).Return(pin, nil)
+		svc, audit := newTestService(repo, nil, Config{RequirePIN: true})
+		now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+		svc.now = func() time.Time { return now }
+
+		status, err := svc.Unlock(ctx, viewer, "1234")
+		require.NoError(t, err)
+		require.NotNil(t, status.UnlockedUntil)
+		assert.Equal(t, now.Add(UnlockDuration), *status.UnlockedUntil)
+		assert.True(t, status.Accessible())
+		require.Len(t, audit.actions, 1)
+		assert.Equal(t, activity.ActionQARUnlock, audit.actions[0].Action)
+	})
+
+	t.Run("wrong PIN", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(pin, nil)
+		svc, audit := newTestService(repo, nil, Config{RequirePIN: true})
+		now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+		svc.now = func() time.Time { return now }
+		repo.On("RecordPINFailure", ctx, userID, int32(content.MaxPINAttempts), now.Add(content.PINLockoutDuration)).Return(pin, nil)
+
+		_, err := svc.Unlock(ctx, viewer, "0000")
+		assert.ErrorIs(t, err, ErrInvalidPIN)
+		assert.Empty(t, audit.actions)
+		require.Len(t, audit.failures, 1)
+		assert.Equal(t, "192.0.2.1", audit.failures[0].IPAddress.String())
+		repo.AssertNotCalled(t, "SetUnlockedUntil", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("valid PIN clears failed attempts", func(t *testing.T) {
+		failed := &UserPIN{UserID: userID, PINHash: pin.PINHash, FailedAttempts: 3}
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(failed, nil)
+		repo.On("ClearPINFailures", ctx, userID).Return(nil)
+		repo.On("SetUnlockedUntil", ctx, userID, mock.AnythingOfType("*time.Time")).Return(failed, nil)
+		svc, _ := newTestService(repo, nil, Config{RequirePIN: true})
+
+		_, err := svc.Unlock(ctx, viewer, "1234")
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("locked out", func(t *testing.T) {
+		now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+		locked := &UserPIN{UserID: userID, PINHash: pin.PINHash, LockedUntil: new(now.Add(time.Minute))}
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(locked, nil)
+		svc, _ := newTestService(repo, nil, Config{RequirePIN: true})
+		svc.now = func() time.Time { return now }
+
+		// The right PIN is refused too while PIN entry is locked.
+		_, err := svc.Unlock(ctx, viewer, "1234")
+		assert.ErrorIs(t, err, ErrPINLockedOut)
+		repo.AssertNotCalled(t, "SetUnlockedUntil", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "RecordPINFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no PIN", func(t *testing.T) {
+		repo := new(MockRepository)
+		repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+		repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+		svc, _ := newTestService(repo, nil, Config{RequirePIN: true})
+
+		_, err := svc.Unlock(ctx, viewer, "1234")
+		assert.ErrorIs(t, err, ErrPINNotSet)
+	})
+}
+
+func TestService_ImportScene_ResetsChangedFile(t *testing.T) {
+	ctx := context.Background()
+	sceneID := uuid.New()
+	scanned := &ScannedScene{FilePath: "/adult/a.mp4", OSHash: "new", Title: "A"}
+
+	repo := new(MockRepository)
+	repo.On("GetSceneByPath", ctx, scanned.FilePath).Return(&Scene{ID: sceneID, OSHash: "old", MatchStatus: MatchStatusMatched}, nil)
+	repo.On("UpsertScene", ctx, scanned).Return(&Scene{ID: sceneID, OSHash: "new", MatchStatus: MatchStatusMatched}, nil)
+	repo.On("ResetSceneMatch", ctx, sceneID, "A").Return(nil)
+	repo.On("SetScenePerformers", ctx, sceneID, []uuid.UUID(nil)).Return(nil)
+	repo.On("GetScene", ctx, sceneID).Return(&Scene{ID: sceneID, OSHash: "new", MatchStatus: MatchStatusUnmatched}, nil)
+	svc, _ := newTestService(repo, nil, Config{})
+
+	scene, err := svc.ImportScene(ctx, scanned)
+	require.NoError(t, err)
+	assert.Equal(t, MatchStatusUnmatched, scene.MatchStatus)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ImportScene_Unchanged(t *testing.T) {
+	ctx := context.Background()
+	sceneID := uuid.New()
+	scanned := &ScannedScene{FilePath: "/adult/a.mp4", OSHash: "same", Title: "A"}
+
+	repo := new(MockRepository)
+	repo.On("GetSceneByPath", ctx, scanned.FilePath).Return(&Scene{ID: sceneID, OSHash: "same", MatchStatus: MatchStatusMatched}, nil)
+	repo.On("UpsertScene", ctx, scanned).Return(&Scene{ID: sceneID, OSHash: "same", MatchStatus: MatchStatusMatched}, nil)
+	svc, _ := newTestService(repo, nil, Config{})
+
+	_, err := svc.ImportScene(ctx, scanned)
+	require.NoError(t, err)
+	repo.AssertNotCalled(t, "ResetSceneMatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_MatchScene(t *testing.T) {
+	ctx := context.Background()
+	sceneID := uuid.New()
+	studioID := uuid.New()
+	performerID := uuid.New()
+
+	provider := &fakeProvider{byFingerprint: []stashdb.Scene{{
+		ID:       "stash-scene",
+		Title:    "Scene Title",
+		Date:     "2024-06-30",
+		Duration: 1800,
+		Studio:   &stashdb.Studio{ID: "stash-studio", Name: "Studio"},
+		Performers: []stashdb.PerformerAppears{
+			{Performer: stashdb.Performer{ID: "stash-performer", Name: "Jane Doe", Aliases: []string{"JD"}}},
+		},
+	}}}
+
+	repo := new(MockRepository)
+	repo.On("UpsertStudio", ctx, UpsertStudioParams{StashDBID: "stash-studio", Name: "Studio"}).
+		Return(&Studio{ID: studioID}, nil)
+	repo.On("UpsertPerformer", ctx, UpsertPerformerParams{StashDBID: "stash-performer", Name: "Jane Doe", Aliases: []string{"JD"}}).
+		Return(&Performer{ID: performerID}, nil)
+	repo.On("SetSceneMetadata", ctx, sceneID, mock.MatchedBy(func(m SceneMetadata) bool {
+		return m.StashDBID == "stash-scene" && m.Title == "Scene Title" && *m.StudioID == studioID &&
+			m.MatchType == MatchTypeFingerprint && m.Confidence == 1.0 &&
+			*m.DurationSeconds == 1800 && m.ReleaseDate.Equal(time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC))
+	})).Return(nil)
+	repo.On("SetScenePerformers", ctx, sceneID, []uuid.UUID{performerID}).Return(nil)
+	svc, _ := newTestService(repo, provider, Config{})
+
+	result, err := svc.MatchScene(ctx, &Scene{ID: sceneID, OSHash: "abc", Title: "whatever"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, MatchTypeFingerprint, result.MatchType)
+	repo.AssertExpectations(t)
+}
+
+func TestService_MatchScene_Disabled(t *testing.T) {
+	svc, _ := newTestService(new(MockRepository), nil, Config{})
+	_, err := svc.MatchScene(context.Background(), &Scene{ID: uuid.New()}, nil)
+	assert.ErrorIs(t, err, ErrMatchingDisabled)
+}
+
+func TestService_RemoveMissing(t *testing.T) {
+	ctx := context.Background()
+	libraryID := uuid.New()
+	kept, missing, elsewhere := uuid.New(), uuid.New(), uuid.New()
+
+	repo := new(MockRepository)
+	repo.On("ListScenePaths", ctx, libraryID).Return(map[string]uuid.UUID{
+		"/adult/kept.mp4":    kept,
+		"/adult/missing.mp4": missing,
+		"/other/file.mp4":    elsewhere,
+	}, nil)
+	repo.On("DeleteScene", ctx, missing).Return(nil)
+	svc, _ := newTestService(repo, nil, Config{})
+
+	removed, err := svc.RemoveMissing(ctx, libraryID, []string{"/adult"}, map[string]bool{"/adult/kept.mp4": true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	repo.AssertExpectations(t)
+}
+
+func TestService_GetPerformer_OutsideLibraries(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	performerID := uuid.New()
+	libs := []uuid.UUID{uuid.New()}
+
+	repo := new(MockRepository)
+	repo.On("GetUserAccess", ctx, userID).Return(&UserAccess{Username: "u", Enabled: true}, nil)
+	repo.On("GetUserPIN", ctx, userID).Return(nil, ErrPINNotFound)
+	repo.On("GetPerformer", ctx, performerID).Return(&Performer{ID: performerID}, nil)
+	repo.On("CountPerformerScenes", ctx, performerID, libs).Return(int64(0), nil)
+	svc, _ := newTestService(repo, nil, Config{})
+
+	_, err := svc.GetPerformer(ctx, Viewer{UserID: userID}, performerID, libs)
+	assert.True(t, errors.Is(err, ErrPerformerNotFound))
+}
@@ -0,0 +1,342 @@
+// Package stashdb provides a client for StashDB and other stash-box
+// compatible GraphQL metadata servers.
+package stashdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/imroc/req/v3"
+	"golang.org/x/time/rate"
+
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/circuitbreaker"
+)
+
+const (
+	// DefaultURL is the StashDB GraphQL endpoint.
+	DefaultURL = "https://stashdb.org/graphql"
+
+	// DefaultRateLimit is 2 requests per second.
+	DefaultRateLimit = rate.Limit(2)
+
+	// DefaultBurst is the burst capacity.
+	DefaultBurst = 5
+
+	// DefaultCacheTTL is the cache duration.
+	DefaultCacheTTL = 1 * time.Hour
+
+	// searchLimit is the number of scenes requested per search.
+	searchLimit = 10
+)
+
+// ErrNoAPIKey is returned when the client is created without an API key.
+// stash-box servers reject anonymous queries.
+var ErrNoAPIKey = errors.New("stashdb: api key is required")
+
+// Config configures the stash-box client.
+type Config struct {
+	// URL is the GraphQL endpoint (default: StashDB).
+	URL string
+
+	// APIKey is sent in the ApiKey header.
+	APIKey string
+
+	// RateLimit is requests per second (default: 2).
+	RateLimit rate.Limit
+
+	// Burst is the burst capacity (default: 5).
+	Burst int
+
+	// CacheTTL is the cache duration (default: 1h).
+	CacheTTL time.Duration
+
+	// Timeout is the request timeout (default: 15s).
+	Timeout time.Duration
+}
+
+// Client is the stash-box GraphQL API client with rate limiting and caching.
+type Client struct {
+	httpClient  *req.Client
+	rateLimiter *rate.Limiter
+	cache       *cache.L1Cache[string, any]
+}
+
+// NewClient creates a new stash-box client.
+func NewClient(config Config) (*Client, error) {
+	if config.APIKey == "" {
+		return nil, ErrNoAPIKey
+	}
+	if config.URL == "" {
+		config.URL = DefaultURL
+	}
+	if config.RateLimit == 0 {
+		config.RateLimit = DefaultRateLimit
+	}
+	if config.Burst == 0 {
+		config.Burst = DefaultBurst
+	}
+	if config.CacheTTL == 0 {
+		config.CacheTTL = DefaultCacheTTL
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 15 * time.Second
+	}
+
+	l1, err := cache.NewL1Cache[string, any](10000, config.CacheTTL, cache.WithExpiryAccessing[string, any]())
+	if err != nil {
+		return nil, fmt.Errorf("create stashdb cache: %w", err)
+	}
+
+	client := req.C().
+		SetBaseURL(config.URL).
+		SetTimeout(config.Timeout).
+		SetCommonRetryCount(2).
+		SetCommonRetryBackoffInterval(1*time.Second, 5*time.Second).
+		SetCommonContentType("application/json").
+		SetCommonHeader("Accept", "application/json").
+		SetCommonHeader("ApiKey", config.APIKey).
+		SetCommonRetryCondition(func(resp *req.Response, err error) bool {
+			if err != nil {
+				return true
+			}
+			return resp.StatusCode >= 500
+		})
+
+	// Circuit breaker
+	circuitbreaker.WrapReqClient(client, "stashdb", circuitbreaker.TierExternal)
+
+	return &Client{
+		httpClient:  client,
+		rateLimiter: rate.NewLimiter(config.RateLimit, config.Burst),
+		cache:       l1,
+	}, nil
+}
+
+// graphql executes a GraphQL query against the stash-box API.
+func (c *Client) graphql(ctx context.Context, query string, variables map[string]any, result any) error {
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.R().SetContext(ctx).
+		SetBody(GraphQLRequest{Query: query, Variables: variables}).
+		SetSuccessResult(result).
+		Post("")
+	if err != nil {
+		return fmt.Errorf("stashdb graphql: %w", err)
+	}
+	if resp.IsErrorState() {
+		return fmt.Errorf("stashdb graphql: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// graphqlError returns the first error of a GraphQL response, if any.
+func graphqlError(errs []GraphQLError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("stashdb: %s", errs[0].Message)
+}
+
+// cached returns a cached value of type T.
+func cached[T any](c *Client, key string) (T, bool) {
+	var zero T
+	val, ok := c.cache.Get(key)
+	if !ok {
+		return zero, false
+	}
+	v, ok := val.(T)
+	return v, ok
+}
+
+// FindScene fetches a scene by its stash-box ID. It returns nil when the
+// scene does not exist.
+func (c *Client) FindScene(ctx context.Context, id string) (*Scene, error) {
+	key := "scene:" + id
+	if v, ok := cached[*Scene](c, key); ok {
+		return v, nil
+	}
+
+	var resp GraphQLResponse[findSceneData]
+	if err := c.graphql(ctx, findSceneQuery, map[string]any{"id": id}, &resp); err != nil {
+		return nil, err
+	}
+	if err := graphqlError(resp.Errors); err != nil {
+		return nil, err
+	}
+	if resp.Data.FindScene != nil {
+		c.cache.Set(key, resp.Data.FindScene)
+	}
+	return resp.Data.FindScene, nil
+}
+
+// FindScenesByFingerprint returns the scenes a file fingerprint was
+// submitted for.
+func (c *Client) FindScenesByFingerprint(ctx context.Context, algorithm FingerprintAlgorithm, hash string) ([]Scene, error) {
+	key := fmt.Sprintf("fingerprint:%s:%s", algorithm, hash)
+	if v, ok := cached[[]Scene](c, key); ok {
+		return v, nil
+	}
+
+	variables := map[string]any{
+		"fingerprint": map[string]any{"hash": hash, "algorithm": algorithm},
+	}
+	var resp GraphQLResponse[findSceneByFingerprintData]
+	if err := c.graphql(ctx, findSceneByFingerprintQuery, variables, &resp); err != nil {
+		return nil, err
+	}
+	if err := graphqlError(resp.Errors); err != nil {
+		return nil, err
+	}
+	c.cache.Set(key, resp.Data.FindSceneByFingerprint)
+	return resp.Data.FindSceneByFingerprint, nil
+}
+
+// SearchScenes searches scenes by a free-text term such as a cleaned up
+// file name.
+func (c *Client) SearchScenes(ctx context.Context, term string) ([]Scene, error) {
+	key := "search:" + term
+	if v, ok := cached[[]Scene](c, key); ok {
+		return v, nil
+	}
+
+	variables := map[string]any{"term": term, "limit": searchLimit}
+	var resp GraphQLResponse[searchSceneData]
+	if err := c.graphql(ctx, searchSceneQuery, variables, &resp); err != nil {
+		return nil, err
+	}
+	if err := graphqlError(resp.Errors); err != nil {
+		return nil, err
+	}
+	c.cache.Set(key, resp.Data.SearchScene)
+	return resp.Data.SearchScene, nil
+}
+
+// FindPerformer fetches a performer by its stash-box ID. It returns nil
+// when the performer does not exist.
+func (c *Client) FindPerformer(ctx context.Context, id string) (*Performer, error) {
+	key := "performer:" + id
+	if v, ok := cached[*Performer](c, key); ok {
+		return v, nil
+	}
+
+	var resp GraphQLResponse[findPerformerData]
+	if err := c.graphql(ctx, findPerformerQuery, map[string]any{"id": id}, &resp); err != nil {
+		return nil, err
+	}
+	if err := graphqlError(resp.Errors); err != nil {
+		return nil, err
+	}
+	if resp.Data.FindPerformer != nil {
+		c.cache.Set(key, resp.Data.FindPerformer)
+	}
+	return resp.Data.FindPerformer, nil
+}
+
+// FindStudio fetches a studio by its stash-box ID. It returns nil when the
+// studio does not exist.
+func (c *Client) FindStudio(ctx context.Context, id string) (*Studio, error) {
+	key := "studio:" + id
+	if v, ok := cached[*Studio](c, key); ok {
+		return v, nil
+	}
+
+	var resp GraphQLResponse[findStudioData]
+	if err := c.graphql(ctx, findStudioQuery, map[string]any{"id": id}, &resp); err != nil {
+		return nil, err
+	}
+	if err := graphqlError(resp.Errors); err != nil {
+		return nil, err
+	}
+	if resp.Data.FindStudio != nil {
+		c.cache.Set(key, resp.Data.FindStudio)
+	}
+	return resp.Data.FindStudio, nil
+}
+
+// Close stops the cache's background goroutines.
+func (c *Client) Close() {
+	if c.cache != nil {
+		c.cache.Close()
+	}
+}
+
+// GraphQL query strings.
+
+const sceneFragment = `
+fragment SceneFields on Scene {
+  id
+  title
+  details
+  date
+  duration
+  code
+  images { url width height }
+  studio {
+    id
+    name
+    images { url width height }
+    parent { id name }
+  }
+  performers {
+    as
+    performer {
+      id
+      name
+      disambiguation
+      aliases
+      gender
+      country
+      images { url width height }
+    }
+  }
+  fingerprints { hash algorithm duration }
+}
+`
+
+const findSceneQuery = `
+query FindScene($id: ID!) {
+  findScene(id: $id) { ...SceneFields }
+}
+` + sceneFragment
+
+const findSceneByFingerprintQuery = `
+query FindSceneByFingerprint($fingerprint: FingerprintQueryInput!) {
+  findSceneByFingerprint(fingerprint: $fingerprint) { ...SceneFields }
+}
+` + sceneFragment
+
+const searchSceneQuery = `
+query SearchScene($term: String!, $limit: Int) {
+  searchScene(term: $term, limit: $limit) { ...SceneFields }
+}
+` + sceneFragment
+
+const findPerformerQuery = `
+query FindPerformer($id: ID!) {
+  findPerformer(id: $id) {
+    id
+    name
+    disambiguation
+    aliases
+    gender
+    country
+    images { url width height }
+  }
+}
+`
+
+const findStudioQuery = `
+query FindStudio($id: ID!) {
+  findStudio(id: $id) {
+    id
+    name
+    images { url width height }
+    parent { id name }
+  }
+}
+`
@@ -0,0 +1,154 @@
+package stashdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sceneFixture = `{
+	"id": "5b3d0a5c-0000-4000-8000-000000000001",
+	"title": "Test Scene",
+	"details": "A scene for tests",
+	"date": "2023-05-17",
+	"duration": 1830,
+	"images": [{"url": "https://cdn.example.com/scene.jpg", "width": 1280, "height": 720}],
+	"studio": {
+		"id": "studio-1",
+		"name": "Test Studio",
+		"images": [],
+		"parent": {"id": "network-1", "name": "Test Network"}
+	},
+	"performers": [
+		{"as": "", "performer": {"id": "performer-1", "name": "Jane Doe", "aliases": ["J. Doe"], "gender": "FEMALE", "country": "US", "images": []}}
+	],
+	"fingerprints": [{"hash": "8e245d9679d31e12", "algorithm": "OSHASH", "duration": 1830}]
+}`
+
+// stubServer answers stash-box queries by operation name.
+func stubServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("ApiKey") != "test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(body.Query, "findSceneByFingerprint("):
+			fp := body.Variables["fingerprint"].(map[string]any)
+			if fp["hash"] == "8e245d9679d31e12" && fp["algorithm"] == "OSHASH" {
+				_, _ = w.Write([]byte(`{"data":{"findSceneByFingerprint":[` + sceneFixture + `]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"findSceneByFingerprint":[]}}`))
+		case strings.Contains(body.Query, "searchScene("):
+			assert.Equal(t, "test scene", body.Variables["term"])
+			_, _ = w.Write([]byte(`{"data":{"searchScene":[` + sceneFixture + `]}}`))
+		case strings.Contains(body.Query, "findScene("):
+			if body.Variables["id"] == "missing" {
+				_, _ = w.Write([]byte(`{"data":{"findScene":null}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"findScene":` + sceneFixture + `}}`))
+		case strings.Contains(body.Query, "findPerformer("):
+			_, _ = w.Write([]byte(`{"data":{"findPerformer":null},"errors":[{"message":"not authorized"}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func newTestClient(t *testing.T, url string) *Client {
+	t.Helper()
+	c, err := NewClient(Config{URL: url, APIKey: "test-key", RateLimit: 100})
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestNewClient_RequiresAPIKey(t *testing.T) {
+	_, err := NewClient(Config{})
+	assert.ErrorIs(t, err, ErrNoAPIKey)
+}
+
+func TestClient_FindScenesByFingerprint(t *testing.T) {
+	var requests atomic.Int32
+	srv := stubServer(t, &requests)
+	defer srv.Close()
+	c := newTestClient(t, srv.URL)
+	ctx := context.Background()
+
+	scenes, err := c.FindScenesByFingerprint(ctx, AlgorithmOSHash, "8e245d9679d31e12")
+	require.NoError(t, err)
+	require.Len(t, scenes, 1)
+	s := scenes[0]
+	assert.Equal(t, "Test Scene", s.Title)
+	assert.Equal(t, "2023-05-17", s.Date)
+	assert.Equal(t, 1830, s.Duration)
+	assert.Equal(t, "https://cdn.example.com/scene.jpg", PrimaryImage(s.Images))
+	require.NotNil(t, s.Studio)
+	assert.Equal(t, "Test Studio", s.Studio.Name)
+	require.NotNil(t, s.Studio.Parent)
+	assert.Equal(t, "network-1", s.Studio.Parent.ID)
+	require.Len(t, s.Performers, 1)
+	assert.Equal(t, "Jane Doe", s.Performers[0].Performer.Name)
+	assert.Equal(t, []string{"J. Doe"}, s.Performers[0].Performer.Aliases)
+
+	// Cached
+	_, err = c.FindScenesByFingerprint(ctx, AlgorithmOSHash, "8e245d9679d31e12")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+
+	scenes, err = c.FindScenesByFingerprint(ctx, AlgorithmOSHash, "0000000000000000")
+	require.NoError(t, err)
+	assert.Empty(t, scenes)
+}
+
+func TestClient_SearchAndFindScene(t *testing.T) {
+	var requests atomic.Int32
+	srv := stubServer(t, &requests)
+	defer srv.Close()
+	c := newTestClient(t, srv.URL)
+	ctx := context.Background()
+
+	scenes, err := c.SearchScenes(ctx, "test scene")
+	require.NoError(t, err)
+	require.Len(t, scenes, 1)
+
+	scene, err := c.FindScene(ctx, scenes[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, scene)
+	assert.Equal(t, "A scene for tests", scene.Details)
+
+	scene, err = c.FindScene(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, scene)
+}
+
+func TestClient_Errors(t *testing.T) {
+	var requests atomic.Int32
+	srv := stubServer(t, &requests)
+	defer srv.Close()
+	ctx := context.Background()
+
+	_, err := newTestClient(t, srv.URL).FindPerformer(ctx, "performer-1")
+	assert.EqualError(t, err, "stashdb: not authorized")
+
+	c, err := NewClient(Config{URL: srv.URL, APIKey: "wrong-key", RateLimit: 100})
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = c.FindScene(ctx, "scene-1")
+	assert.ErrorContains(t, err, "status 401")
+}
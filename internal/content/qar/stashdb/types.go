@@ -0,0 +1,114 @@
+package stashdb
+
+// GraphQLRequest is the request body of a stash-box GraphQL query.
+type GraphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLResponse wraps the top-level stash-box response.
+type GraphQLResponse[T any] struct {
+	Data   T              `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLError is a stash-box API error.
+type GraphQLError struct {
+	Message string   `json:"message"`
+	Path    []string `json:"path,omitempty"`
+}
+
+// FingerprintAlgorithm identifies how a file fingerprint was computed.
+type FingerprintAlgorithm string
+
+const (
+	// AlgorithmOSHash is the OpenSubtitles hash of the file's size and its
+	// first and last 64 KiB.
+	AlgorithmOSHash FingerprintAlgorithm = "OSHASH"
+	// AlgorithmMD5 is the MD5 checksum of the whole file.
+	AlgorithmMD5 FingerprintAlgorithm = "MD5"
+	// AlgorithmPHash is a perceptual hash of the video frames.
+	AlgorithmPHash FingerprintAlgorithm = "PHASH"
+)
+
+// Scene is a scene as returned by stash-box.
+type Scene struct {
+	ID           string             `json:"id"`
+	Title        string             `json:"title"`
+	Details      string             `json:"details"`
+	Date         string             `json:"date"` // YYYY, YYYY-MM or YYYY-MM-DD
+	Duration     int                `json:"duration"`
+	Code         string             `json:"code"`
+	Images       []Image            `json:"images"`
+	Studio       *Studio            `json:"studio"`
+	Performers   []PerformerAppears `json:"performers"`
+	Fingerprints []Fingerprint      `json:"fingerprints"`
+}
+
+// Image is an image hosted by stash-box.
+type Image struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Studio is a studio or network as returned by stash-box.
+type Studio struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Parent *Studio `json:"parent"`
+	Images []Image `json:"images"`
+}
+
+// PerformerAppears is a performer's appearance in a scene. As is the name
+// the performer was credited with, if it differs.
+type PerformerAppears struct {
+	As        string    `json:"as"`
+	Performer Performer `json:"performer"`
+}
+
+// Performer is a performer as returned by stash-box.
+type Performer struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Disambiguation string   `json:"disambiguation"`
+	Aliases        []string `json:"aliases"`
+	Gender         string   `json:"gender"`
+	Country        string   `json:"country"`
+	Images         []Image  `json:"images"`
+}
+
+// Fingerprint is a file fingerprint submitted for a scene.
+type Fingerprint struct {
+	Hash      string               `json:"hash"`
+	Algorithm FingerprintAlgorithm `json:"algorithm"`
+	Duration  int                  `json:"duration"`
+}
+
+// PrimaryImage returns the URL of the first image, or "".
+func PrimaryImage(images []Image) string {
+	if len(images) == 0 {
+		return ""
+	}
+	return images[0].URL
+}
+
+type findSceneData struct {
+	FindScene *Scene `json:"findScene"`
+}
+
+type findSceneByFingerprintData struct {
+	FindSceneByFingerprint []Scene `json:"findSceneByFingerprint"`
+}
+
+type searchSceneData struct {
+	SearchScene []Scene `json:"searchScene"`
+}
+
+type findPerformerData struct {
+	FindPerformer *Performer `json:"findPerformer"`
+}
+
+type findStudioData struct {
+	FindStudio *Studio `json:"findStudio"`
+}
@@ -0,0 +1,178 @@
+// Package qar implements the adult content module. Its data lives in the
+// separate qar schema and is served under /api/v1/legacy; titles, names,
+// file paths and image URLs are stored encrypted. Access requires the
+// user's QAR grant, an unlocked PIN when configured, and may be audited.
+package qar
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchStatus tells whether a scene was matched to metadata.
+type MatchStatus string
+
+const (
+	MatchStatusUnmatched MatchStatus = "unmatched"
+	MatchStatusMatched   MatchStatus = "matched"
+)
+
+// MatchType tells how a scene was matched.
+type MatchType string
+
+const (
+	// MatchTypeFingerprint is a match on the file's OSHASH.
+	MatchTypeFingerprint MatchType = "fingerprint"
+	// MatchTypeTitle is a match on a search for the file name.
+	MatchTypeTitle MatchType = "title"
+)
+
+// Scene sort orders.
+const (
+	SortByAdded    = "added"
+	SortByReleased = "released"
+)
+
+// Scene is one video file of an adult library.
+type Scene struct {
+	ID              uuid.UUID
+	LibraryID       uuid.UUID
+	FilePath        string
+	FileSize        int64
+	OSHash          string
+	StashDBID       *string
+	StudioID        *uuid.UUID
+	Title           string
+	Details         *string
+	ReleaseDate     *time.Time
+	DurationSeconds *int32
+	ImageURL        *string
+	MatchStatus     MatchStatus
+	MatchType       *MatchType
+	MatchConfidence *float64
+	MatchedAt       *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+
+	// Studio and Performers are loaded for single scenes only.
+	Studio     *Studio
+	Performers []Performer
+}
+
+// Performer is a performer appearing in scenes.
+type Performer struct {
+	ID             uuid.UUID
+	StashDBID      *string
+	Name           string
+	Disambiguation *string
+	Aliases        []string
+	Gender         *string
+	Country        *string
+	ImageURL       *string
+	SceneCount     int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Studio is a studio or network publishing scenes.
+type Studio struct {
+	ID         uuid.UUID
+	StashDBID  *string
+	ParentID   *uuid.UUID
+	Name       string
+	ImageURL   *string
+	SceneCount int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ScannedScene is a scene file found by a library scan.
+type ScannedScene struct {
+	LibraryID uuid.UUID
+	FilePath  string
+	FileSize  int64
+	OSHash    string
+
+	// Title is the cleaned up file name, used until the scene is matched
+	// and as the search term for matching.
+	Title string
+
+	// Date is a release date found in the file name.
+	Date *time.Time
+}
+
+// SceneMetadata is the metadata a matched scene is updated with.
+type SceneMetadata struct {
+	StashDBID       string
+	StudioID        *uuid.UUID
+	Title           string
+	Details         *string
+	ReleaseDate     *time.Time
+	DurationSeconds *int32
+	ImageURL        *string
+	MatchType       MatchType
+	Confidence      float64
+}
+
+// SceneListFilters contains filters for listing scenes.
+type SceneListFilters struct {
+	// LibraryIDs restricts the scenes to these libraries when non-nil,
+	// the libraries the user may access.
+	LibraryIDs  []uuid.UUID
+	LibraryID   *uuid.UUID
+	StudioID    *uuid.UUID
+	PerformerID *uuid.UUID
+	MatchStatus MatchStatus // empty lists all scenes
+	SortBy      string      // SortByAdded (default) or SortByReleased
+	Limit       int32
+	Offset      int32
+}
+
+// ListFilters contains filters for listing performers and studios. Only
+// those with scenes in the given libraries are listed.
+type ListFilters struct {
+	LibraryIDs []uuid.UUID // nil means unrestricted
+	Limit      int32
+	Offset     int32
+}
+
+// Viewer identifies who accesses QAR content, for the access checks and
+// the audit log.
+type Viewer struct {
+	UserID    uuid.UUID
+	IPAddress string
+	UserAgent string
+}
+
+// UserAccess is a user's QAR grant.
+type UserAccess struct {
+	Username string
+	Enabled  bool
+}
+
+// UserPIN is a user's QAR PIN and unlock state.
+type UserPIN struct {
+	UserID         uuid.UUID
+	PINHash        string
+	UnlockedUntil  *time.Time
+	FailedAttempts int32      // Wrong PINs since the last success or lockout
+	LockedUntil    *time.Time // PIN entry is refused until then
+}
+
+// AccessStatus describes whether a user can access QAR content now.
+type AccessStatus struct {
+	// Granted is the user's QAR grant (legacy:read).
+	Granted bool
+	// PINRequired is set when content must be unlocked with a PIN.
+	PINRequired bool
+	// PINSet tells whether the user has chosen a PIN.
+	PINSet bool
+	// UnlockedUntil is when the PIN unlock expires, if unlocked.
+	UnlockedUntil *time.Time
+}
+
+// Accessible reports whether content can be read.
+func (a *AccessStatus) Accessible() bool {
+	return a.Granted && (!a.PINRequired || a.UnlockedUntil != nil)
+}
@@ -0,0 +1,100 @@
+package qar
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lusoris/revenge/internal/crypto"
+)
+
+// Vault encrypts the sensitive fields of QAR content with AES-256-GCM and
+// derives blind indexes for the encrypted values that must be looked up.
+type Vault struct {
+	enc      *crypto.Encryptor
+	indexKey []byte
+}
+
+// NewVault creates a vault from a 32-byte key. The index key is derived
+// from it, so both change together.
+func NewVault(key []byte) (*Vault, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("qar key must be 32 bytes, got %d", len(key))
+	}
+	enc, err := crypto.NewEncryptor(key)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("qar-index"))
+	return &Vault{enc: enc, indexKey: mac.Sum(nil)}, nil
+}
+
+// Seal encrypts a string.
+func (v *Vault) Seal(s string) ([]byte, error) {
+	return v.enc.EncryptString(s)
+}
+
+// SealOptional encrypts an optional string; nil stays nil.
+func (v *Vault) SealOptional(s *string) ([]byte, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return v.Seal(*s)
+}
+
+// SealStrings encrypts a list of strings as a JSON array; an empty list
+// is stored as nil.
+func (v *Vault) SealStrings(values []string) ([]byte, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	return v.enc.Encrypt(data)
+}
+
+// Open decrypts a string sealed by Seal.
+func (v *Vault) Open(data []byte) (string, error) {
+	return v.enc.DecryptString(data)
+}
+
+// OpenOptional decrypts an optional string; nil stays nil.
+func (v *Vault) OpenOptional(data []byte) (*string, error) {
+	if data == nil {
+		return nil, nil
+	}
+	s, err := v.Open(data)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// OpenStrings decrypts a list sealed by SealStrings.
+func (v *Vault) OpenStrings(data []byte) ([]string, error) {
+	if data == nil {
+		return []string{}, nil
+	}
+	plain, err := v.enc.Decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	if err := json.Unmarshal(plain, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// Index returns the keyed hash of a value. Equal values have equal
+// indexes, which cannot be reversed or guessed without the key.
+func (v *Vault) Index(s string) string {
+	mac := hmac.New(sha256.New, v.indexKey)
+	mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil))
+}
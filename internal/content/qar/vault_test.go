@@ -0,0 +1,52 @@
+package qar
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault(t *testing.T) {
+	vault, err := NewVault(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	sealed, err := vault.Seal("/adult/Studio.Scene.mp4")
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "Studio")
+	opened, err := vault.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "/adult/Studio.Scene.mp4", opened)
+
+	none, err := vault.SealOptional(nil)
+	require.NoError(t, err)
+	assert.Nil(t, none)
+	optional, err := vault.OpenOptional(none)
+	require.NoError(t, err)
+	assert.Nil(t, optional)
+
+	aliases, err := vault.SealStrings([]string{"A", "B"})
+	require.NoError(t, err)
+	values, err := vault.OpenStrings(aliases)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A", "B"}, values)
+	empty, err := vault.OpenStrings(nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	assert.Equal(t, vault.Index("/a.mp4"), vault.Index("/a.mp4"))
+	assert.NotEqual(t, vault.Index("/a.mp4"), vault.Index("/b.mp4"))
+	assert.Len(t, vault.Index("/a.mp4"), 64)
+
+	other, err := NewVault(bytes.Repeat([]byte{2}, 32))
+	require.NoError(t, err)
+	assert.NotEqual(t, vault.Index("/a.mp4"), other.Index("/a.mp4"))
+	_, err = other.Open(sealed)
+	assert.Error(t, err)
+}
+
+func TestNewVault_KeyLength(t *testing.T) {
+	_, err := NewVault(make([]byte, 16))
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+package content
+
+import "time"
+
+// RefreshCutoffs selects items due for a scheduled metadata refresh. An item
+// is due when its metadata was last refreshed before the cutoff of its class,
+// or never.
+type RefreshCutoffs struct {
+	// IncompleteBefore applies to items missing an overview or poster.
+	IncompleteBefore time.Time
+	// ActiveBefore applies to active items: continuing series, series that
+	// aired after ActiveSince, and movies released after ActiveSince.
+	ActiveBefore time.Time
+	// SettledBefore applies to everything else.
+	SettledBefore time.Time
+	// ActiveSince is the start of the window in which items count as active.
+	ActiveSince time.Time
+}
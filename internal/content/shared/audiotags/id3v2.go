@@ -0,0 +1,376 @@
+package audiotags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// id3Frames maps ID3v2.3/2.4 text frames to normalized field names.
+var id3Frames = map[string]string{
+	"TIT2": fieldTitle,
+	"TPE1": fieldArtist,
+	"TPE2": fieldAlbumArtist,
+	"TALB": fieldAlbum,
+	"TCON": fieldGenre,
+	"TRCK": fieldTrackNumber,
+	"TPOS": fieldDiscNumber,
+	"TDRC": fieldDate,
+	"TYER": fieldDate,
+	"TDOR": fieldOriginalDate,
+	"TORY": fieldOriginalDate,
+	"TSOP": fieldArtistSort,
+	"TSO2": fieldAlbumArtistSort,
+	"TSOA": fieldAlbumSort,
+	"TCMP": fieldCompilation,
+	"TPUB": fieldLabel,
+	"TSRC": fieldISRC,
+	"TSST": fieldDiscSubtitle,
+	"TLEN": fieldLength,
+	"TCOM": fieldComposer,
+	"TIT3": fieldSubtitle,
+	"TLAN": fieldLanguage,
+	"MVNM": fieldMovementName,
+	"MVIN": fieldMovement,
+}
+
+// id3v22Frames maps the three-letter ID3v2.2 frames.
+var id3v22Frames = map[string]string{
+	"TT2": fieldTitle,
+	"TP1": fieldArtist,
+	"TP2": fieldAlbumArtist,
+	"TAL": fieldAlbum,
+	"TCO": fieldGenre,
+	"TRK": fieldTrackNumber,
+	"TPA": fieldDiscNumber,
+	"TYE": fieldDate,
+	"TOR": fieldOriginalDate,
+	"TCP": fieldCompilation,
+	"TPB": fieldLabel,
+	"TRC": fieldISRC,
+	"TLE": fieldLength,
+	"TCM": fieldComposer,
+	"TT3": fieldSubtitle,
+	"TLA": fieldLanguage,
+}
+
+// musicBrainzOwner is the UFID owner under which Picard stores the
+// recording ID.
+const musicBrainzOwner = "http://musicbrainz.org"
+
+// readID3Header reads the 10-byte tag header and returns the major version,
+// flags and tag size (excluding the header).
+func readID3Header(r io.Reader) (version, flags byte, size int, err error) {
+	var header [10]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, 0, 0, fmt.Errorf("read id3 header: %w", err)
+	}
+	if string(header[:3]) != "ID3" {
+		return 0, 0, 0, ErrNoTags
+	}
+	return header[3], header[5], synchsafe(header[6:10]), nil
+}
+
+// skipID3v2 positions r after a leading ID3v2 tag.
+func skipID3v2(r io.ReadSeeker) error {
+	_, flags, size, err := readID3Header(r)
+	if err != nil {
+		return err
+	}
+	if flags&0x10 != 0 { // footer present
+		size += 10
+	}
+	_, err = r.Seek(int64(size), io.SeekCurrent)
+	return err
+}
+
+func readID3v2(r io.ReadSeeker) (*Tags, error) {
+	version, flags, size, err := readID3Header(r)
+	if err != nil {
+		return nil, err
+	}
+	if version < 2 || version > 4 {
+		return nil, fmt.Errorf("unsupported id3v2 version 2.%d", version)
+	}
+	if size > maxBlockSize {
+		return nil, fmt.Errorf("id3v2 tag too large: %d bytes", size)
+	}
+
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, fmt.Errorf("read id3v2 tag: %w", err)
+	}
+
+	// Whole-tag unsynchronisation (v2.2 and v2.3; v2.4 flags it per frame).
+	if flags&0x80 != 0 && version < 4 {
+		body = removeUnsync(body)
+	}
+	// Skip the extended header.
+	if flags&0x40 != 0 && version > 2 && len(body) >= 4 {
+		extSize := int(binary.BigEndian.Uint32(body[:4]))
+		if version == 4 {
+			extSize = synchsafe(body[:4])
+		} else {
+			extSize += 4 // v2.3 excludes the size field itself
+		}
+		if extSize > len(body) {
+			return nil, fmt.Errorf("invalid id3v2 extended header")
+		}
+		body = body[extSize:]
+	}
+
+	f := make(fields)
+	var chapters []Chapter
+	if version == 2 {
+		parseID3v22Frames(body, f)
+	} else {
+		parseID3Frames(body, version, f, &chapters)
+	}
+	if len(f) == 0 && len(chapters) == 0 {
+		return nil, ErrNoTags
+	}
+
+	t := f.build(fmt.Sprintf("id3v2.%d", version))
+	t.Genres = resolveID3Genres(t.Genres)
+	if len(chapters) > 0 {
+		t.Chapters = chapters
+	}
+	// Without a TLEN frame, time the audio frames that follow the tag.
+	if t.Duration == 0 {
+		t.Duration = MPEGDuration(r)
+	}
+	return t, nil
+}
+
+// parseID3Frames parses ID3v2.3/2.4 frames. CHAP frames are collected into
+// chapters when it is non-nil.
+func parseID3Frames(body []byte, version byte, f fields, chapters *[]Chapter) {
+	for len(body) >= 10 {
+		id := string(body[:4])
+		if body[0] == 0 { // padding
+			return
+		}
+		var size int
+		if version == 4 {
+			size = synchsafe(body[4:8])
+		} else {
+			size = int(binary.BigEndian.Uint32(body[4:8]))
+		}
+		formatFlags := body[9]
+		if size <= 0 || 10+size > len(body) {
+			return
+		}
+		data := body[10 : 10+size]
+		body = body[10+size:]
+
+		if version == 4 {
+			if formatFlags&0x02 != 0 {
+				data = removeUnsync(data)
+			}
+			if formatFlags&0x01 != 0 { // data length indicator
+				if len(data) < 4 {
+					continue
+				}
+				data = data[4:]
+			}
+			if formatFlags&0x0C != 0 { // compressed or encrypted
+				continue
+			}
+		} else if formatFlags&0xC0 != 0 { // v2.3 compressed or encrypted
+			continue
+		}
+
+		if id == "CHAP" {
+			if chapters != nil {
+				if ch, ok := parseID3Chapter(data, version); ok {
+					*chapters = append(*chapters, ch)
+				}
+			}
+			continue
+		}
+		addID3Frame(f, id, data, id3Frames)
+	}
+}
+
+// parseID3Chapter parses a CHAP frame (ID3v2 Chapter Frame Addendum): an
+// element ID, start and end times in milliseconds, byte offsets and
+// embedded frames carrying the chapter title.
+func parseID3Chapter(data []byte, version byte) (Chapter, bool) {
+	_, rest, ok := bytes.Cut(data, []byte{0})
+	if !ok || len(rest) < 16 {
+		return Chapter{}, false
+	}
+	ch := Chapter{
+		Start: time.Duration(binary.BigEndian.Uint32(rest[0:4])) * time.Millisecond,
+		End:   time.Duration(binary.BigEndian.Uint32(rest[4:8])) * time.Millisecond,
+	}
+	sub := make(fields)
+	parseID3Frames(rest[16:], version, sub, nil)
+	ch.Title = sub.first(fieldTitle)
+	return ch, true
+}
+
+func parseID3v22Frames(body []byte, f fields) {
+	for len(body) >= 6 {
+		if body[0] == 0 {
+			return
+		}
+		id := string(body[:3])
+		size := int(body[3])<<16 | int(body[4])<<8 | int(body[5])
+		if size <= 0 || 6+size > len(body) {
+			return
+		}
+		data := body[6 : 6+size]
+		body = body[6+size:]
+
+		switch id {
+		case "TXX":
+			id = "TXXX"
+		case "UFI":
+			id = "UFID"
+		}
+		addID3Frame(f, id, data, id3v22Frames)
+	}
+}
+
+func addID3Frame(f fields, id string, data []byte, names map[string]string) {
+	switch {
+	case id == "TXXX":
+		values := decodeID3Text(data)
+		if len(values) < 2 {
+			return
+		}
+		f.add(userField(values[0]), values[1:]...)
+	case id == "UFID":
+		owner, identifier, ok := bytes.Cut(data, []byte{0})
+		if ok && string(owner) == musicBrainzOwner {
+			f.add(fieldMBRecordingID, string(identifier))
+		}
+	case id == "COMM":
+		// Encoding, language, short description and the comment itself.
+		// Only the plain comment (empty description) is kept.
+		if len(data) < 4 {
+			return
+		}
+		values := decodeID3Text(append([]byte{data[0]}, data[4:]...))
+		if len(values) >= 2 && values[0] == "" {
+			f.add(fieldComment, values[1:]...)
+		}
+	default:
+		// Text frames, including the iTunes MVNM/MVIN movement frames.
+		if name, ok := names[id]; ok {
+			f.add(name, decodeID3Text(data)...)
+		}
+	}
+}
+
+// decodeID3Text decodes a text frame body into its null-separated values.
+func decodeID3Text(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	encoding, data := data[0], data[1:]
+
+	var text string
+	switch encoding {
+	case 0: // ISO-8859-1
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		text = string(runes)
+	case 1: // UTF-16 with BOM
+		text = decodeUTF16(data, true)
+	case 2: // UTF-16BE
+		text = decodeUTF16(data, false)
+	default: // UTF-8
+		text = string(data)
+	}
+
+	text = strings.TrimRight(text, "\x00")
+	return strings.Split(text, "\x00")
+}
+
+// decodeUTF16 decodes UTF-16 text. With bom set, each null-separated value
+// may start with its own byte order mark.
+func decodeUTF16(data []byte, bom bool) string {
+	order := binary.ByteOrder(binary.BigEndian)
+	units := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		if bom {
+			switch {
+			case data[i] == 0xFF && data[i+1] == 0xFE:
+				order = binary.LittleEndian
+				continue
+			case data[i] == 0xFE && data[i+1] == 0xFF:
+				order = binary.BigEndian
+				continue
+			}
+		}
+		units = append(units, order.Uint16(data[i:i+2]))
+	}
+	return string(utf16.Decode(units))
+}
+
+// removeUnsync reverses ID3 unsynchronisation (0xFF 0x00 → 0xFF).
+func removeUnsync(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		out = append(out, data[i])
+		if data[i] == 0xFF && i+1 < len(data) && data[i+1] == 0x00 {
+			i++
+		}
+	}
+	return out
+}
+
+func synchsafe(b []byte) int {
+	return int(b[0]&0x7F)<<21 | int(b[1]&0x7F)<<14 | int(b[2]&0x7F)<<7 | int(b[3]&0x7F)
+}
+
+// resolveID3Genres replaces ID3v1 genre references such as "(17)" or "17"
+// with their names.
+func resolveID3Genres(genres []string) []string {
+	out := make([]string, 0, len(genres))
+	for _, g := range genres {
+		if strings.HasPrefix(g, "(") {
+			ref, rest, ok := strings.Cut(g[1:], ")")
+			if ok && rest != "" {
+				g = rest // "(17)Rock" — the refinement wins
+			} else if ok {
+				g = ref
+			}
+		}
+		if n, err := strconv.Atoi(g); err == nil {
+			if n < 0 || n >= len(id3v1Genres) {
+				continue
+			}
+			g = id3v1Genres[n]
+		}
+		out = append(out, g)
+	}
+	return out
+}
+
+// id3v1Genres lists the standard ID3v1 genres by index.
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge",
+	"Hip-Hop", "Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B",
+	"Rap", "Reggae", "Rock", "Techno", "Industrial", "Alternative", "Ska",
+	"Death Metal", "Pranks", "Soundtrack", "Euro-Techno", "Ambient",
+	"Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance", "Classical",
+	"Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"Alternative Rock", "Bass", "Soul", "Punk", "Space", "Meditative",
+	"Instrumental Pop", "Instrumental Rock", "Ethnic", "Gothic", "Darkwave",
+	"Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream",
+	"Southern Rock", "Comedy", "Cult", "Gangsta", "Top 40", "Christian Rap",
+	"Pop/Funk", "Jungle", "Native American", "Cabaret", "New Wave",
+	"Psychedelic", "Rave", "Showtunes", "Trailer", "Lo-Fi", "Tribal",
+	"Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll",
+	"Hard Rock",
+}
@@ -0,0 +1,312 @@
+package audiotags
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// mp4Items maps iTunes item list atoms to normalized field names.
+var mp4Items = map[string]string{
+	"\xa9nam": fieldTitle,
+	"\xa9ART": fieldArtist,
+	"aART":    fieldAlbumArtist,
+	"\xa9alb": fieldAlbum,
+	"\xa9gen": fieldGenre,
+	"\xa9day": fieldDate,
+	"soar":    fieldArtistSort,
+	"soaa":    fieldAlbumArtistSort,
+	"soal":    fieldAlbumSort,
+	"\xa9wrt": fieldComposer,
+	"\xa9nrt": fieldNarrator,
+	"\xa9pub": fieldLabel,
+	"\xa9mvn": fieldMovementName,
+	"\xa9cmt": fieldComment,
+	"desc":    fieldDescription,
+	"ldes":    fieldDescription,
+}
+
+// mp4Atom is an atom header within the file.
+type mp4Atom struct {
+	typ    string
+	offset int64 // start of the atom body
+	size   int64 // body size
+}
+
+func readMP4(r io.ReadSeeker) (*Tags, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	moov, ok, err := findAtom(r, 0, end, "moov")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoTags
+	}
+
+	f := make(fields)
+	var duration time.Duration
+	if mvhd, ok, err := findAtom(r, moov.offset, moov.offset+moov.size, "mvhd"); err == nil && ok {
+		duration = mp4Duration(r, mvhd)
+	}
+
+	ilst, ok, err := findPath(r, moov, "udta", "meta", "ilst")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if ilst.size > maxBlockSize {
+			return nil, fmt.Errorf("mp4 item list too large: %d bytes", ilst.size)
+		}
+		data := make([]byte, ilst.size)
+		if _, err := r.Seek(ilst.offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("read mp4 item list: %w", err)
+		}
+		parseMP4Items(data, f)
+	}
+
+	var chapters []Chapter
+	if chpl, ok, err := findPath(r, moov, "udta", "chpl"); err == nil && ok && chpl.size <= maxBlockSize {
+		data := make([]byte, chpl.size)
+		if _, err := r.Seek(chpl.offset, io.SeekStart); err == nil {
+			if _, err := io.ReadFull(r, data); err == nil {
+				chapters = parseNeroChapters(data)
+			}
+		}
+	}
+	if len(f) == 0 && duration == 0 && len(chapters) == 0 {
+		return nil, ErrNoTags
+	}
+
+	t := f.build("mp4")
+	t.Duration = duration
+	if len(chapters) > 0 {
+		t.Chapters = chapters
+	}
+	return t, nil
+}
+
+// parseNeroChapters parses a Nero "chpl" atom, the chapter list ffmpeg and
+// most audiobook tools write into M4B files. Start times are in 100ns units.
+func parseNeroChapters(data []byte) []Chapter {
+	if len(data) < 5 {
+		return nil
+	}
+	version := data[0]
+	data = data[4:]
+	if version > 0 {
+		if len(data) < 4 {
+			return nil
+		}
+		data = data[4:] // reserved
+	}
+	if len(data) < 1 {
+		return nil
+	}
+	count := int(data[0])
+	data = data[1:]
+
+	chapters := make([]Chapter, 0, count)
+	for range count {
+		if len(data) < 9 {
+			break
+		}
+		start := binary.BigEndian.Uint64(data[:8])
+		titleLen := int(data[8])
+		data = data[9:]
+		if titleLen > len(data) {
+			break
+		}
+		chapters = append(chapters, Chapter{
+			Title: string(data[:titleLen]),
+			Start: time.Duration(start * 100), // #nosec G115 -- chapter offsets fit in int64
+		})
+		data = data[titleLen:]
+	}
+	return chapters
+}
+
+// findPath descends through nested atoms. "meta" is a full atom whose body
+// starts with four bytes of version and flags.
+func findPath(r io.ReadSeeker, parent mp4Atom, path ...string) (mp4Atom, bool, error) {
+	current := parent
+	for _, typ := range path {
+		atom, ok, err := findAtom(r, current.offset, current.offset+current.size, typ)
+		if err != nil || !ok {
+			return mp4Atom{}, ok, err
+		}
+		if typ == "meta" {
+			atom.offset += 4
+			atom.size -= 4
+		}
+		current = atom
+	}
+	return current, true, nil
+}
+
+// findAtom scans the atoms between start and end for the given type.
+func findAtom(r io.ReadSeeker, start, end int64, typ string) (mp4Atom, bool, error) {
+	pos := start
+	for pos+8 <= end {
+		if _, err := r.Seek(pos, io.SeekStart); err != nil {
+			return mp4Atom{}, false, err
+		}
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return mp4Atom{}, false, fmt.Errorf("read mp4 atom: %w", err)
+		}
+		size := int64(binary.BigEndian.Uint32(header[:4]))
+		headerSize := int64(8)
+		switch size {
+		case 0: // extends to the end of the file
+			size = end - pos
+		case 1: // 64-bit size follows
+			var ext [8]byte
+			if _, err := io.ReadFull(r, ext[:]); err != nil {
+				return mp4Atom{}, false, fmt.Errorf("read mp4 atom size: %w", err)
+			}
+			size = int64(binary.BigEndian.Uint64(ext[:])) // #nosec G115 -- bounded below
+			headerSize = 16
+		}
+		if size < headerSize || pos+size > end {
+			return mp4Atom{}, false, fmt.Errorf("invalid mp4 atom %q", header[4:8])
+		}
+		if string(header[4:8]) == typ {
+			return mp4Atom{typ: typ, offset: pos + headerSize, size: size - headerSize}, true, nil
+		}
+		pos += size
+	}
+	return mp4Atom{}, false, nil
+}
+
+// mp4Duration reads the movie duration from an mvhd atom.
+func mp4Duration(r io.ReadSeeker, mvhd mp4Atom) time.Duration {
+	if _, err := r.Seek(mvhd.offset, io.SeekStart); err != nil {
+		return 0
+	}
+	var buf [32]byte
+	n, _ := io.ReadFull(r, buf[:])
+	b := buf[:n]
+	if len(b) < 20 {
+		return 0
+	}
+
+	var timescale, duration uint64
+	if b[0] == 1 { // version 1: 64-bit times
+		if len(b) < 32 {
+			return 0
+		}
+		timescale = uint64(binary.BigEndian.Uint32(b[20:24]))
+		duration = binary.BigEndian.Uint64(b[24:32])
+	} else {
+		timescale = uint64(binary.BigEndian.Uint32(b[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(b[16:20]))
+	}
+	if timescale == 0 {
+		return 0
+	}
+	return time.Duration(duration * uint64(time.Second) / timescale)
+}
+
+// parseMP4Items parses the children of an ilst atom.
+func parseMP4Items(data []byte, f fields) {
+	for len(data) >= 8 {
+		size := int(binary.BigEndian.Uint32(data[:4]))
+		if size < 8 || size > len(data) {
+			return
+		}
+		typ := string(data[4:8])
+		body := data[8:size]
+		data = data[size:]
+
+		children := mp4Children(body)
+		switch typ {
+		case "----":
+			name := string(mp4Payload(children["name"]))
+			for _, v := range children["data"] {
+				f.add(userField(name), string(mp4Value(v)))
+			}
+		case "trkn", "disk":
+			key := fieldTrackNumber
+			if typ == "disk" {
+				key = fieldDiscNumber
+			}
+			if v := mp4Value(first(children["data"])); len(v) >= 6 {
+				n := binary.BigEndian.Uint16(v[2:4])
+				total := binary.BigEndian.Uint16(v[4:6])
+				value := strconv.Itoa(int(n))
+				if total > 0 {
+					value += "/" + strconv.Itoa(int(total))
+				}
+				f.add(key, value)
+			}
+		case "cpil":
+			if v := mp4Value(first(children["data"])); len(v) > 0 && v[0] != 0 {
+				f.add(fieldCompilation, "1")
+			}
+		case "\xa9mvi":
+			if v := mp4Value(first(children["data"])); len(v) >= 2 {
+				f.add(fieldMovement, strconv.Itoa(int(binary.BigEndian.Uint16(v[len(v)-2:]))))
+			}
+		case "gnre":
+			// ID3v1 genre index plus one.
+			if v := mp4Value(first(children["data"])); len(v) >= 2 {
+				if n := int(binary.BigEndian.Uint16(v[:2])) - 1; n >= 0 && n < len(id3v1Genres) {
+					f.add(fieldGenre, id3v1Genres[n])
+				}
+			}
+		default:
+			if name, ok := mp4Items[typ]; ok {
+				for _, v := range children["data"] {
+					f.add(name, string(mp4Value(v)))
+				}
+			}
+		}
+	}
+}
+
+// mp4Children splits an item atom into its child atoms by type.
+func mp4Children(data []byte) map[string][][]byte {
+	children := make(map[string][][]byte)
+	for len(data) >= 8 {
+		size := int(binary.BigEndian.Uint32(data[:4]))
+		if size < 8 || size > len(data) {
+			break
+		}
+		typ := string(data[4:8])
+		children[typ] = append(children[typ], data[8:size])
+		data = data[size:]
+	}
+	return children
+}
+
+// mp4Payload strips the version and flags from a "mean" or "name" atom.
+func mp4Payload(values [][]byte) []byte {
+	if v := first(values); len(v) >= 4 {
+		return v[4:]
+	}
+	return nil
+}
+
+// mp4Value strips the type and locale from a "data" atom.
+func mp4Value(data []byte) []byte {
+	if len(data) < 8 {
+		return nil
+	}
+	return data[8:]
+}
+
+func first(values [][]byte) []byte {
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
@@ -0,0 +1,176 @@
+package audiotags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// mpegSyncWindow bounds the search for the first MPEG audio frame after
+// the tag. Encoders rarely leave more than a few bytes of junk.
+const mpegSyncWindow = 64 << 10
+
+// MPEG audio bitrates in kbit/s, indexed by [version is MPEG-1][layer-1][index].
+var mpegBitrates = [2][3][16]int{
+	{ // MPEG-2 and 2.5
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+	},
+	{ // MPEG-1
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+	},
+}
+
+// mpegSampleRates in Hz, indexed by the version bits and the rate index.
+var mpegSampleRates = map[byte][3]int{
+	0: {11025, 12000, 8000},  // MPEG-2.5
+	2: {22050, 24000, 16000}, // MPEG-2
+	3: {44100, 48000, 32000}, // MPEG-1
+}
+
+// mpegFrame is a decoded MPEG audio frame header.
+type mpegFrame struct {
+	mpeg1           bool
+	layer           int // 1-3
+	bitrate         int // bit/s
+	sampleRate      int
+	mono            bool
+	samplesPerFrame int
+}
+
+// parseMPEGHeader decodes a 4-byte frame header.
+func parseMPEGHeader(h []byte) (mpegFrame, bool) {
+	if len(h) < 4 || h[0] != 0xFF || h[1]&0xE0 != 0xE0 {
+		return mpegFrame{}, false
+	}
+	version := (h[1] >> 3) & 0x03
+	layerBits := (h[1] >> 1) & 0x03
+	bitrateIndex := h[2] >> 4
+	rateIndex := (h[2] >> 2) & 0x03
+	rates, ok := mpegSampleRates[version]
+	if !ok || layerBits == 0 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return mpegFrame{}, false
+	}
+
+	f := mpegFrame{
+		mpeg1:      version == 3,
+		layer:      int(4 - layerBits),
+		sampleRate: rates[rateIndex],
+		mono:       h[3]>>6 == 3,
+	}
+	v := 0
+	if f.mpeg1 {
+		v = 1
+	}
+	f.bitrate = mpegBitrates[v][f.layer-1][bitrateIndex] * 1000
+	switch {
+	case f.layer == 1:
+		f.samplesPerFrame = 384
+	case f.layer == 3 && !f.mpeg1:
+		f.samplesPerFrame = 576
+	default:
+		f.samplesPerFrame = 1152
+	}
+	return f, true
+}
+
+// MPEGDuration estimates the length of MPEG audio (MP3) starting at the
+// reader's current position, skipping a leading ID3v2 tag. VBR files are
+// timed from their Xing/Info or VBRI frame count, CBR files from their
+// size and bitrate. It returns 0 when no frame is found.
+func MPEGDuration(r io.ReadSeeker) time.Duration {
+	start, err := r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0
+	}
+	var magic [3]byte
+	if _, err := io.ReadFull(r, magic[:]); err == nil && string(magic[:]) == "ID3" {
+		if _, err := r.Seek(start, io.SeekStart); err != nil {
+			return 0
+		}
+		if err := skipID3v2(r); err != nil {
+			return 0
+		}
+		if start, err = r.Seek(0, io.SeekCurrent); err != nil {
+			return 0
+		}
+	} else if _, err := r.Seek(start, io.SeekStart); err != nil {
+		return 0
+	}
+
+	buf := make([]byte, mpegSyncWindow)
+	n, _ := io.ReadFull(r, buf)
+	buf = buf[:n]
+
+	for i := 0; i+4 <= len(buf); i++ {
+		frame, ok := parseMPEGHeader(buf[i:])
+		if !ok {
+			continue
+		}
+		if frames := mpegVBRFrames(buf[i:], frame); frames > 0 {
+			samples := uint64(frames) * uint64(frame.samplesPerFrame)
+			return time.Duration(samples * uint64(time.Second) / uint64(frame.sampleRate)) // #nosec G115 -- sample rates are positive
+		}
+
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0
+		}
+		end -= id3v1Size(r, end)
+		audioBytes := end - start - int64(i)
+		if audioBytes <= 0 {
+			return 0
+		}
+		return time.Duration(audioBytes * 8 * int64(time.Second) / int64(frame.bitrate))
+	}
+	return 0
+}
+
+// mpegVBRFrames returns the frame count of a Xing/Info or VBRI header in
+// the first frame, or 0.
+func mpegVBRFrames(frame []byte, f mpegFrame) uint32 {
+	// Xing/Info follows the side information, whose size depends on the
+	// version and channel mode.
+	xing := 4 + 32
+	switch {
+	case f.mpeg1 && f.mono, !f.mpeg1 && !f.mono:
+		xing = 4 + 17
+	case !f.mpeg1 && f.mono:
+		xing = 4 + 9
+	}
+	if len(frame) >= xing+12 {
+		tag := frame[xing : xing+4]
+		if bytes.Equal(tag, []byte("Xing")) || bytes.Equal(tag, []byte("Info")) {
+			flags := binary.BigEndian.Uint32(frame[xing+4:])
+			if flags&0x01 != 0 {
+				return binary.BigEndian.Uint32(frame[xing+8:])
+			}
+		}
+	}
+
+	// VBRI (Fraunhofer) sits at a fixed offset.
+	const vbri = 4 + 32
+	if len(frame) >= vbri+18 && bytes.Equal(frame[vbri:vbri+4], []byte("VBRI")) {
+		return binary.BigEndian.Uint32(frame[vbri+14:])
+	}
+	return 0
+}
+
+// id3v1Size returns 128 when the file ends with an ID3v1 tag.
+func id3v1Size(r io.ReadSeeker, end int64) int64 {
+	if end < 128 {
+		return 0
+	}
+	if _, err := r.Seek(end-128, io.SeekStart); err != nil {
+		return 0
+	}
+	var tag [3]byte
+	if _, err := io.ReadFull(r, tag[:]); err != nil || string(tag[:]) != "TAG" {
+		return 0
+	}
+	return 128
+}
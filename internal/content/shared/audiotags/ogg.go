@@ -0,0 +1,152 @@
+package audiotags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// oggTailSize is how much of the end of the file is searched for the last
+// page, whose granule position gives the duration.
+const oggTailSize = 64 << 10
+
+// opusSampleRate is the rate Opus granule positions are expressed in.
+const opusSampleRate = 48000
+
+func readOgg(r io.ReadSeeker) (*Tags, error) {
+	reader := &oggReader{r: r}
+
+	ident, err := reader.nextPacket()
+	if err != nil {
+		return nil, err
+	}
+	comment, err := reader.nextPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		format     string
+		sampleRate uint64
+		preSkip    uint64
+		body       []byte
+	)
+	switch {
+	case bytes.HasPrefix(ident, []byte("\x01vorbis")) && len(ident) >= 16:
+		format = "vorbis"
+		sampleRate = uint64(binary.LittleEndian.Uint32(ident[12:16]))
+		if !bytes.HasPrefix(comment, []byte("\x03vorbis")) {
+			return nil, ErrNoTags
+		}
+		body = comment[7:]
+	case bytes.HasPrefix(ident, []byte("OpusHead")) && len(ident) >= 12:
+		format = "opus"
+		sampleRate = opusSampleRate
+		preSkip = uint64(binary.LittleEndian.Uint16(ident[10:12]))
+		if !bytes.HasPrefix(comment, []byte("OpusTags")) {
+			return nil, ErrNoTags
+		}
+		body = comment[8:]
+	default:
+		return nil, ErrNoTags
+	}
+
+	f := make(fields)
+	if err := parseVorbisComment(body, f); err != nil {
+		return nil, err
+	}
+
+	t := f.build(format)
+	if granule, ok := lastGranule(r); ok && sampleRate > 0 && granule > preSkip {
+		t.Duration = time.Duration((granule - preSkip) * uint64(time.Second) / sampleRate)
+	}
+	return t, nil
+}
+
+// oggReader reassembles packets of the first logical bitstream.
+type oggReader struct {
+	r       io.Reader
+	serial  uint32
+	started bool
+	pending []byte // segments of a packet continued on the next page
+	packets [][]byte
+}
+
+func (o *oggReader) nextPacket() ([]byte, error) {
+	for len(o.packets) == 0 {
+		if err := o.readPage(); err != nil {
+			return nil, err
+		}
+	}
+	p := o.packets[0]
+	o.packets = o.packets[1:]
+	return p, nil
+}
+
+func (o *oggReader) readPage() error {
+	var header [27]byte
+	if _, err := io.ReadFull(o.r, header[:]); err != nil {
+		return fmt.Errorf("read ogg page: %w", err)
+	}
+	if string(header[:4]) != "OggS" {
+		return fmt.Errorf("invalid ogg page")
+	}
+	serial := binary.LittleEndian.Uint32(header[14:18])
+
+	lacing := make([]byte, header[26])
+	if _, err := io.ReadFull(o.r, lacing); err != nil {
+		return fmt.Errorf("read ogg segment table: %w", err)
+	}
+	var size int
+	for _, l := range lacing {
+		size += int(l)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(o.r, data); err != nil {
+		return fmt.Errorf("read ogg page data: %w", err)
+	}
+
+	if !o.started {
+		o.serial, o.started = serial, true
+	}
+	if serial != o.serial { // multiplexed stream
+		return nil
+	}
+
+	for _, l := range lacing {
+		o.pending = append(o.pending, data[:l]...)
+		data = data[l:]
+		if len(o.pending) > maxBlockSize {
+			return fmt.Errorf("ogg packet too large")
+		}
+		if l < 255 {
+			o.packets = append(o.packets, o.pending)
+			o.pending = nil
+		}
+	}
+	return nil
+}
+
+// lastGranule returns the granule position of the last page in the file.
+func lastGranule(r io.ReadSeeker) (uint64, bool) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, false
+	}
+	start := max(end-oggTailSize, 0)
+	if _, err := r.Seek(start, io.SeekStart); err != nil {
+		return 0, false
+	}
+	tail, err := io.ReadAll(r)
+	if err != nil {
+		return 0, false
+	}
+
+	i := bytes.LastIndex(tail, []byte("OggS"))
+	if i < 0 || i+14 > len(tail) {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint64(tail[i+6 : i+14]), true
+}
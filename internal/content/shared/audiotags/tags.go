@@ -0,0 +1,474 @@
+// Package audiotags reads embedded metadata from audio files.
+//
+// ID3v2 (MP3), Vorbis comments (FLAC, Ogg Vorbis, Opus) and MP4 item lists
+// (M4A/M4B/ALAC) are supported, including chapter markers. Every format is
+// normalized to Vorbis comment field names, so MusicBrainz IDs and
+// ReplayGain values written by Picard or foobar2000 are found regardless of
+// the container.
+package audiotags
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoTags is returned when a file has no supported tag block.
+var ErrNoTags = errors.New("no supported tags found")
+
+// maxBlockSize bounds a single tag block or atom read into memory. Embedded
+// cover art is the only thing that gets close.
+const maxBlockSize = 32 << 20
+
+// Normalized field names (Vorbis comment conventions, as written by Picard).
+const (
+	fieldTitle            = "TITLE"
+	fieldArtist           = "ARTIST"
+	fieldArtists          = "ARTISTS"
+	fieldArtistSort       = "ARTISTSORT"
+	fieldAlbum            = "ALBUM"
+	fieldAlbumSort        = "ALBUMSORT"
+	fieldAlbumArtist      = "ALBUMARTIST"
+	fieldAlbumArtistSort  = "ALBUMARTISTSORT"
+	fieldGenre            = "GENRE"
+	fieldDate             = "DATE"
+	fieldOriginalDate     = "ORIGINALDATE"
+	fieldTrackNumber      = "TRACKNUMBER"
+	fieldTrackTotal       = "TRACKTOTAL"
+	fieldTotalTracks      = "TOTALTRACKS"
+	fieldDiscNumber       = "DISCNUMBER"
+	fieldDiscTotal        = "DISCTOTAL"
+	fieldTotalDiscs       = "TOTALDISCS"
+	fieldDiscSubtitle     = "DISCSUBTITLE"
+	fieldCompilation      = "COMPILATION"
+	fieldLabel            = "LABEL"
+	fieldCatalogNumber    = "CATALOGNUMBER"
+	fieldReleaseCountry   = "RELEASECOUNTRY"
+	fieldReleaseType      = "RELEASETYPE"
+	fieldISRC             = "ISRC"
+	fieldLength           = "LENGTH" // milliseconds (ID3 TLEN)
+	fieldMBRecordingID    = "MUSICBRAINZ_TRACKID"
+	fieldMBTrackID        = "MUSICBRAINZ_RELEASETRACKID"
+	fieldMBReleaseID      = "MUSICBRAINZ_ALBUMID"
+	fieldMBReleaseGroupID = "MUSICBRAINZ_RELEASEGROUPID"
+	fieldMBArtistID       = "MUSICBRAINZ_ARTISTID"
+	fieldMBAlbumArtistID  = "MUSICBRAINZ_ALBUMARTISTID"
+	fieldRGTrackGain      = "REPLAYGAIN_TRACK_GAIN"
+	fieldRGTrackPeak      = "REPLAYGAIN_TRACK_PEAK"
+	fieldRGAlbumGain      = "REPLAYGAIN_ALBUM_GAIN"
+	fieldRGAlbumPeak      = "REPLAYGAIN_ALBUM_PEAK"
+
+	// Audiobook fields (Audiobookshelf and iTunes conventions)
+	fieldComposer     = "COMPOSER"
+	fieldNarrator     = "NARRATOR"
+	fieldSeries       = "SERIES"
+	fieldSeriesPart   = "SERIES-PART"
+	fieldMovementName = "MOVEMENTNAME" // iTunes movement, used for series
+	fieldMovement     = "MOVEMENT"
+	fieldSubtitle     = "SUBTITLE"
+	fieldDescription  = "DESCRIPTION"
+	fieldComment      = "COMMENT"
+	fieldLanguage     = "LANGUAGE"
+	fieldASIN         = "ASIN"
+)
+
+// userFieldNames maps the descriptions Picard uses for ID3 TXXX frames and
+// MP4 freeform atoms to their Vorbis comment names.
+var userFieldNames = map[string]string{
+	"MUSICBRAINZ ALBUM ID":              fieldMBReleaseID,
+	"MUSICBRAINZ ARTIST ID":             fieldMBArtistID,
+	"MUSICBRAINZ ALBUM ARTIST ID":       fieldMBAlbumArtistID,
+	"MUSICBRAINZ RELEASE GROUP ID":      fieldMBReleaseGroupID,
+	"MUSICBRAINZ RELEASE TRACK ID":      fieldMBTrackID,
+	"MUSICBRAINZ TRACK ID":              fieldMBRecordingID,
+	"MUSICBRAINZ ALBUM TYPE":            fieldReleaseType,
+	"MUSICBRAINZ ALBUM RELEASE COUNTRY": fieldReleaseCountry,
+	"ORIGINALYEAR":                      fieldOriginalDate,
+	"SERIES_PART":                       fieldSeriesPart,
+}
+
+// Tags holds the metadata read from an audio file.
+type Tags struct {
+	// Format names the tag format: "id3v2.3", "id3v2.4", "flac", "vorbis",
+	// "opus" or "mp4".
+	Format string
+
+	Title           string
+	Artist          string   // track artist as credited
+	Artists         []string // individual track artists
+	ArtistSort      string
+	Album           string
+	AlbumSort       string
+	AlbumArtist     string   // album artist as credited
+	AlbumArtists    []string // individual album artists
+	AlbumArtistSort string
+	Genres          []string
+
+	Year         int
+	Date         string // release date as tagged (YYYY, YYYY-MM or YYYY-MM-DD)
+	OriginalYear int
+
+	TrackNumber  int
+	TrackTotal   int
+	DiscNumber   int
+	DiscTotal    int
+	DiscSubtitle string
+	Compilation  bool
+
+	Label         string
+	CatalogNumber string
+	Country       string
+	ReleaseType   string
+	ISRC          string
+
+	// MusicBrainz identifiers
+	MusicBrainzRecordingID    string
+	MusicBrainzTrackID        string
+	MusicBrainzReleaseID      string
+	MusicBrainzReleaseGroupID string
+	MusicBrainzArtistIDs      []string
+	MusicBrainzAlbumArtistIDs []string
+
+	// ReplayGain values (gain in dB, peak as a linear amplitude)
+	TrackGain *float64
+	TrackPeak *float64
+	AlbumGain *float64
+	AlbumPeak *float64
+
+	// Audiobook metadata
+	Composer    string
+	Narrator    string
+	Series      string
+	SeriesPart  string // position in the series as tagged ("1", "2.5")
+	Subtitle    string
+	Description string
+	Language    string
+	ASIN        string
+
+	// Chapters are the embedded chapter marks, ordered by start time.
+	Chapters []Chapter
+
+	// Duration is the playback length when the container records it.
+	Duration time.Duration
+}
+
+// Chapter is a chapter mark within a single file.
+type Chapter struct {
+	Title string
+	Start time.Duration
+	End   time.Duration // zero when the file doesn't record it
+}
+
+// fields collects normalized tag values. Keys are upper case.
+type fields map[string][]string
+
+func (f fields) add(key string, values ...string) {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	if key == "" {
+		return
+	}
+	for _, v := range values {
+		v = strings.TrimRight(strings.TrimSpace(v), "\x00")
+		if v != "" {
+			f[key] = append(f[key], v)
+		}
+	}
+}
+
+func (f fields) first(key string) string {
+	if v := f[key]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// userField returns the normalized name of a user-defined field.
+func userField(description string) string {
+	name := strings.ToUpper(strings.TrimSpace(description))
+	if mapped, ok := userFieldNames[name]; ok {
+		return mapped
+	}
+	return strings.ReplaceAll(name, " ", "_")
+}
+
+// ReadFile reads the tags of the audio file at path.
+func ReadFile(path string) (*Tags, error) {
+	f, err := os.Open(path) // #nosec G304 -- path comes from the library scanner
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	return Read(f, filepath.Ext(path))
+}
+
+// Read reads tags from r. ext (e.g. ".mp3") is a hint used when the content
+// does not identify the format itself.
+func Read(r io.ReadSeeker, ext string) (*Tags, error) {
+	var magic [12]byte
+	n, err := io.ReadFull(r, magic[:])
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	head := magic[:n]
+
+	var t *Tags
+	switch {
+	case hasPrefix(head, "ID3"):
+		// FLAC files occasionally carry an ID3v2 tag in front of "fLaC".
+		if strings.EqualFold(ext, ".flac") {
+			if err := skipID3v2(r); err != nil {
+				return nil, err
+			}
+			t, err = readFLAC(r)
+		} else {
+			t, err = readID3v2(r)
+		}
+	case hasPrefix(head, "fLaC"):
+		t, err = readFLAC(r)
+	case hasPrefix(head, "OggS"):
+		t, err = readOgg(r)
+	case len(head) >= 8 && string(head[4:8]) == "ftyp":
+		t, err = readMP4(r)
+	default:
+		return nil, ErrNoTags
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The last chapter runs to the end of the file.
+	closeChapters(t.Chapters, t.Duration)
+	return t, nil
+}
+
+func hasPrefix(b []byte, prefix string) bool {
+	return len(b) >= len(prefix) && string(b[:len(prefix)]) == prefix
+}
+
+// build converts normalized fields into Tags.
+func (f fields) build(format string) *Tags {
+	t := &Tags{
+		Format:          format,
+		Title:           f.first(fieldTitle),
+		Artist:          f.first(fieldArtist),
+		Artists:         f[fieldArtists],
+		ArtistSort:      f.first(fieldArtistSort),
+		Album:           f.first(fieldAlbum),
+		AlbumSort:       f.first(fieldAlbumSort),
+		AlbumArtist:     f.first(fieldAlbumArtist),
+		AlbumArtistSort: f.first(fieldAlbumArtistSort),
+		Date:            f.first(fieldDate),
+		DiscSubtitle:    f.first(fieldDiscSubtitle),
+		Label:           f.first(fieldLabel),
+		CatalogNumber:   f.first(fieldCatalogNumber),
+		Country:         f.first(fieldReleaseCountry),
+		ReleaseType:     strings.ToLower(f.first(fieldReleaseType)),
+		ISRC:            f.first(fieldISRC),
+
+		MusicBrainzRecordingID:    f.first(fieldMBRecordingID),
+		MusicBrainzTrackID:        f.first(fieldMBTrackID),
+		MusicBrainzReleaseID:      f.first(fieldMBReleaseID),
+		MusicBrainzReleaseGroupID: f.first(fieldMBReleaseGroupID),
+		MusicBrainzArtistIDs:      splitValues(f[fieldMBArtistID]),
+		MusicBrainzAlbumArtistIDs: splitValues(f[fieldMBAlbumArtistID]),
+
+		TrackGain: parseGain(f.first(fieldRGTrackGain)),
+		TrackPeak: parseGain(f.first(fieldRGTrackPeak)),
+		AlbumGain: parseGain(f.first(fieldRGAlbumGain)),
+		AlbumPeak: parseGain(f.first(fieldRGAlbumPeak)),
+
+		Composer:    f.first(fieldComposer),
+		Narrator:    f.first(fieldNarrator),
+		Series:      f.first(fieldSeries),
+		SeriesPart:  f.first(fieldSeriesPart),
+		Subtitle:    f.first(fieldSubtitle),
+		Description: f.first(fieldDescription),
+		Language:    f.first(fieldLanguage),
+		ASIN:        f.first(fieldASIN),
+	}
+
+	if len(f[fieldArtist]) > 1 && len(t.Artists) == 0 {
+		t.Artists = f[fieldArtist]
+	}
+	if len(t.Artists) == 0 && t.Artist != "" {
+		t.Artists = []string{t.Artist}
+	}
+	if len(f[fieldAlbumArtist]) > 1 {
+		t.AlbumArtists = f[fieldAlbumArtist]
+	} else if t.AlbumArtist != "" {
+		t.AlbumArtists = []string{t.AlbumArtist}
+	}
+
+	for _, g := range f[fieldGenre] {
+		t.Genres = append(t.Genres, splitGenre(g)...)
+	}
+
+	t.Year = parseYear(t.Date)
+	t.OriginalYear = parseYear(f.first(fieldOriginalDate))
+
+	t.TrackNumber, t.TrackTotal = parsePosition(f.first(fieldTrackNumber))
+	if total := firstInt(f.first(fieldTrackTotal), f.first(fieldTotalTracks)); total > 0 {
+		t.TrackTotal = total
+	}
+	t.DiscNumber, t.DiscTotal = parsePosition(f.first(fieldDiscNumber))
+	if total := firstInt(f.first(fieldDiscTotal), f.first(fieldTotalDiscs)); total > 0 {
+		t.DiscTotal = total
+	}
+
+	switch strings.ToLower(f.first(fieldCompilation)) {
+	case "1", "true", "yes":
+		t.Compilation = true
+	}
+
+	if ms, err := strconv.Atoi(f.first(fieldLength)); err == nil && ms > 0 {
+		t.Duration = time.Duration(ms) * time.Millisecond
+	}
+
+	if t.Series == "" {
+		t.Series = f.first(fieldMovementName)
+	}
+	if t.SeriesPart == "" {
+		if n, _ := parsePosition(f.first(fieldMovement)); n > 0 {
+			t.SeriesPart = strconv.Itoa(n)
+		}
+	}
+	if t.Description == "" {
+		t.Description = f.first(fieldComment)
+	}
+	t.Chapters = vorbisChapters(f)
+
+	return t
+}
+
+// vorbisChapters reads CHAPTERxxx / CHAPTERxxxNAME comments as written by
+// mp3tag and ffmpeg.
+func vorbisChapters(f fields) []Chapter {
+	var chapters []Chapter
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("CHAPTER%03d", i)
+		start, ok := parseChapterTime(f.first(key))
+		if !ok {
+			if i == 0 {
+				continue // numbering starts at 000 or 001
+			}
+			break
+		}
+		chapters = append(chapters, Chapter{Title: f.first(key + "NAME"), Start: start})
+	}
+	closeChapters(chapters, 0)
+	return chapters
+}
+
+// parseChapterTime parses an "HH:MM:SS.mmm" chapter offset.
+func parseChapterTime(s string) (time.Duration, bool) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	hours, err1 := strconv.Atoi(parts[0])
+	minutes, err2 := strconv.Atoi(parts[1])
+	seconds, err3 := strconv.ParseFloat(parts[2], 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, false
+	}
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), true
+}
+
+// closeChapters sorts chapters and fills missing end times from the next
+// chapter's start, ending the last one at duration when it is known.
+func closeChapters(chapters []Chapter, duration time.Duration) {
+	slices.SortStableFunc(chapters, func(a, b Chapter) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	for i := range chapters {
+		if chapters[i].End > chapters[i].Start {
+			continue
+		}
+		if i+1 < len(chapters) {
+			chapters[i].End = chapters[i+1].Start
+		} else if duration > chapters[i].Start {
+			chapters[i].End = duration
+		}
+	}
+}
+
+// splitValues splits values that were joined into one string, as some
+// taggers do for multi-valued ID3v2.3 frames.
+func splitValues(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for part := range strings.SplitSeq(v, "/") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
+
+// splitGenre splits "Rock; Pop" style genre lists.
+func splitGenre(g string) []string {
+	var out []string
+	for part := range strings.SplitSeq(g, ";") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// parsePosition parses "3" or "3/12".
+func parsePosition(s string) (n, total int) {
+	num, tot, _ := strings.Cut(s, "/")
+	n, _ = strconv.Atoi(strings.TrimSpace(num))
+	total, _ = strconv.Atoi(strings.TrimSpace(tot))
+	return n, total
+}
+
+func firstInt(values ...string) int {
+	for _, v := range values {
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+// parseYear returns the year of a "YYYY", "YYYY-MM-DD" or ISO timestamp date.
+func parseYear(s string) int {
+	if len(s) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(s[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// parseGain parses ReplayGain values such as "-7.23 dB" or "0.988251".
+func parseGain(s string) *float64 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(s, "dB"), "db"))
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
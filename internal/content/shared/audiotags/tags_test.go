@@ -0,0 +1,440 @@
+package audiotags
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRecordingID = "7b1a1ab5-0b3c-4e45-8a2b-6d1d7c3c5e21"
+	testReleaseID   = "0d3bd4a0-8c9a-4c0e-9b1d-59b7c4d8a6f2"
+	testArtistID    = "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d"
+)
+
+// id3Frame builds an ID3v2.3/2.4 frame with a UTF-8 or Latin-1 text body.
+func id3Frame(id string, version byte, body []byte) []byte {
+	var b bytes.Buffer
+	b.WriteString(id)
+	size := len(body)
+	if version == 4 {
+		b.Write(synchsafeBytes(size))
+	} else {
+		_ = binary.Write(&b, binary.BigEndian, uint32(size))
+	}
+	b.Write([]byte{0, 0})
+	b.Write(body)
+	return b.Bytes()
+}
+
+func synchsafeBytes(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7F), byte(n >> 14 & 0x7F), byte(n >> 7 & 0x7F), byte(n & 0x7F)}
+}
+
+func id3Tag(version byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	body = append(body, make([]byte, 16)...) // padding
+	var b bytes.Buffer
+	b.WriteString("ID3")
+	b.Write([]byte{version, 0, 0})
+	b.Write(synchsafeBytes(len(body)))
+	b.Write(body)
+	return b.Bytes()
+}
+
+func utf8Text(values ...string) []byte {
+	var b bytes.Buffer
+	b.WriteByte(3)
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(0)
+		}
+		b.WriteString(v)
+	}
+	return b.Bytes()
+}
+
+func utf16Text(s string) []byte {
+	b := []byte{1, 0xFF, 0xFE}
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, u)
+	}
+	return b
+}
+
+func TestRead_ID3v24(t *testing.T) {
+	data := id3Tag(4,
+		id3Frame("TIT2", 4, utf8Text("Paranoid Android")),
+		id3Frame("TPE1", 4, utf8Text("Radiohead")),
+		id3Frame("TPE2", 4, utf8Text("Radiohead")),
+		id3Frame("TALB", 4, utf8Text("OK Computer")),
+		id3Frame("TRCK", 4, utf8Text("2/12")),
+		id3Frame("TPOS", 4, utf8Text("1/1")),
+		id3Frame("TDRC", 4, utf8Text("1997-05-21")),
+		id3Frame("TCON", 4, utf8Text("Alternative Rock", "(17)")),
+		id3Frame("TLEN", 4, utf8Text("383000")),
+		id3Frame("TXXX", 4, utf8Text("MusicBrainz Album Id", testReleaseID)),
+		id3Frame("TXXX", 4, utf8Text("MusicBrainz Artist Id", testArtistID)),
+		id3Frame("TXXX", 4, utf8Text("REPLAYGAIN_TRACK_GAIN", "-7.23 dB")),
+		id3Frame("TXXX", 4, utf8Text("replaygain_album_peak", "0.988251")),
+		id3Frame("UFID", 4, append([]byte(musicBrainzOwner+"\x00"), testRecordingID...)),
+	)
+	data = append(data, 0xFF, 0xFB, 0x90, 0x00) // start of the audio stream
+
+	tags, err := Read(bytes.NewReader(data), ".mp3")
+	require.NoError(t, err)
+
+	assert.Equal(t, "id3v2.4", tags.Format)
+	assert.Equal(t, "Paranoid Android", tags.Title)
+	assert.Equal(t, []string{"Radiohead"}, tags.Artists)
+	assert.Equal(t, "OK Computer", tags.Album)
+	assert.Equal(t, []string{"Radiohead"}, tags.AlbumArtists)
+	assert.Equal(t, 2, tags.TrackNumber)
+	assert.Equal(t, 12, tags.TrackTotal)
+	assert.Equal(t, 1, tags.DiscNumber)
+	assert.Equal(t, 1997, tags.Year)
+	assert.Equal(t, []string{"Alternative Rock", "Rock"}, tags.Genres)
+	assert.Equal(t, 383*time.Second, tags.Duration)
+	assert.Equal(t, testReleaseID, tags.MusicBrainzReleaseID)
+	assert.Equal(t, []string{testArtistID}, tags.MusicBrainzArtistIDs)
+	assert.Equal(t, testRecordingID, tags.MusicBrainzRecordingID)
+	require.NotNil(t, tags.TrackGain)
+	assert.InDelta(t, -7.23, *tags.TrackGain, 0.0001)
+	require.NotNil(t, tags.AlbumPeak)
+	assert.InDelta(t, 0.988251, *tags.AlbumPeak, 0.000001)
+	assert.Nil(t, tags.AlbumGain)
+}
+
+func TestRead_ID3v23UTF16(t *testing.T) {
+	data := id3Tag(3,
+		id3Frame("TIT2", 3, utf16Text("Björk Song")),
+		id3Frame("TPE1", 3, utf16Text("Björk")),
+		id3Frame("TCMP", 3, []byte("\x001")),
+	)
+
+	tags, err := Read(bytes.NewReader(data), ".mp3")
+	require.NoError(t, err)
+	assert.Equal(t, "id3v2.3", tags.Format)
+	assert.Equal(t, "Björk Song", tags.Title)
+	assert.Equal(t, "Björk", tags.Artist)
+	assert.True(t, tags.Compilation)
+}
+
+func vorbisComment(comments ...string) []byte {
+	var b bytes.Buffer
+	vendor := "reference libFLAC 1.4.3"
+	_ = binary.Write(&b, binary.LittleEndian, uint32(len(vendor)))
+	b.WriteString(vendor)
+	_ = binary.Write(&b, binary.LittleEndian, uint32(len(comments)))
+	for _, c := range comments {
+		_ = binary.Write(&b, binary.LittleEndian, uint32(len(c)))
+		b.WriteString(c)
+	}
+	return b.Bytes()
+}
+
+func flacBlock(blockType byte, last bool, body []byte) []byte {
+	if last {
+		blockType |= 0x80
+	}
+	n := len(body)
+	return append([]byte{blockType, byte(n >> 16), byte(n >> 8), byte(n)}, body...)
+}
+
+func TestRead_FLAC(t *testing.T) {
+	// STREAMINFO: 44.1 kHz, 2 channels, 16 bit, 10 seconds.
+	streamInfo := make([]byte, 34)
+	v := uint64(44100)<<44 | uint64(1)<<41 | uint64(15)<<36 | 441000
+	binary.BigEndian.PutUint64(streamInfo[10:18], v)
+
+	var data []byte
+	data = append(data, "fLaC"...)
+	data = append(data, flacBlock(flacStreamInfo, false, streamInfo)...)
+	data = append(data, flacBlock(1, false, make([]byte, 8))...) // padding
+	data = append(data, flacBlock(flacVorbisComment, true, vorbisComment(
+		"TITLE=So What",
+		"ARTIST=Miles Davis",
+		"ALBUMARTIST=Miles Davis",
+		"ALBUM=Kind of Blue",
+		"TRACKNUMBER=1",
+		"TRACKTOTAL=5",
+		"DISCNUMBER=2",
+		"DISCTOTAL=2",
+		"DATE=1959",
+		"ORIGINALDATE=1959-08-17",
+		"GENRE=Jazz; Modal Jazz",
+		"MUSICBRAINZ_RELEASEGROUPID=6f2a9b3e-3f84-3b4f-9a6b-0d2b8c3e1a11",
+		"musicbrainz_trackid="+testRecordingID,
+		"REPLAYGAIN_ALBUM_GAIN=-3.10 dB",
+	))...)
+
+	tags, err := Read(bytes.NewReader(data), ".flac")
+	require.NoError(t, err)
+	assert.Equal(t, "flac", tags.Format)
+	assert.Equal(t, "So What", tags.Title)
+	assert.Equal(t, "Kind of Blue", tags.Album)
+	assert.Equal(t, 1, tags.TrackNumber)
+	assert.Equal(t, 5, tags.TrackTotal)
+	assert.Equal(t, 2, tags.DiscNumber)
+	assert.Equal(t, 2, tags.DiscTotal)
+	assert.Equal(t, 1959, tags.OriginalYear)
+	assert.Equal(t, []string{"Jazz", "Modal Jazz"}, tags.Genres)
+	assert.Equal(t, "6f2a9b3e-3f84-3b4f-9a6b-0d2b8c3e1a11", tags.MusicBrainzReleaseGroupID)
+	assert.Equal(t, testRecordingID, tags.MusicBrainzRecordingID)
+	assert.Equal(t, 10*time.Second, tags.Duration)
+	require.NotNil(t, tags.AlbumGain)
+	assert.InDelta(t, -3.1, *tags.AlbumGain, 0.0001)
+
+	// An ID3v2 tag in front of the FLAC stream is skipped.
+	prefixed := append(id3Tag(3, id3Frame("TIT2", 3, utf8Text("ignored"))), data...)
+	tags, err = Read(bytes.NewReader(prefixed), ".flac")
+	require.NoError(t, err)
+	assert.Equal(t, "So What", tags.Title)
+}
+
+func oggPage(serial uint32, granule uint64, packets ...[]byte) []byte {
+	var lacing, body []byte
+	for _, p := range packets {
+		n := len(p)
+		for n >= 255 {
+			lacing = append(lacing, 255)
+			n -= 255
+		}
+		lacing = append(lacing, byte(n))
+		body = append(body, p...)
+	}
+	var b bytes.Buffer
+	b.WriteString("OggS")
+	b.Write([]byte{0, 0})
+	_ = binary.Write(&b, binary.LittleEndian, granule)
+	_ = binary.Write(&b, binary.LittleEndian, serial)
+	b.Write(make([]byte, 8)) // sequence and CRC (not verified)
+	b.WriteByte(byte(len(lacing)))
+	b.Write(lacing)
+	b.Write(body)
+	return b.Bytes()
+}
+
+func TestRead_OggOpus(t *testing.T) {
+	head := []byte("OpusHead\x01\x02")
+	head = binary.LittleEndian.AppendUint16(head, 312) // pre-skip
+	head = append(head, make([]byte, 7)...)
+
+	// A large comment packet spans several lacing values.
+	comment := append([]byte("OpusTags"), vorbisComment(
+		"TITLE=Teardrop",
+		"ARTIST=Massive Attack",
+		"ALBUM=Mezzanine",
+		"DESCRIPTION="+string(bytes.Repeat([]byte("x"), 600)),
+	)...)
+
+	var data []byte
+	data = append(data, oggPage(7, 0, head)...)
+	data = append(data, oggPage(7, 0, comment)...)
+	data = append(data, oggPage(7, 48000*4+312, []byte{0xFC})...)
+
+	tags, err := Read(bytes.NewReader(data), ".opus")
+	require.NoError(t, err)
+	assert.Equal(t, "opus", tags.Format)
+	assert.Equal(t, "Teardrop", tags.Title)
+	assert.Equal(t, "Mezzanine", tags.Album)
+	assert.Equal(t, 4*time.Second, tags.Duration)
+}
+
+func mp4Box(typ string, children ...[]byte) []byte {
+	body := bytes.Join(children, nil)
+	b := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	b = append(b, typ...)
+	return append(b, body...)
+}
+
+func mp4Data(value []byte) []byte {
+	return mp4Box("data", append(make([]byte, 8), value...))
+}
+
+func TestRead_MP4(t *testing.T) {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:16], 1000)   // timescale
+	binary.BigEndian.PutUint32(mvhd[16:20], 215500) // duration
+
+	ilst := mp4Box("ilst",
+		mp4Box("\xa9nam", mp4Data([]byte("Hey Jude"))),
+		mp4Box("\xa9ART", mp4Data([]byte("The Beatles"))),
+		mp4Box("aART", mp4Data([]byte("The Beatles"))),
+		mp4Box("\xa9alb", mp4Data([]byte("1"))),
+		mp4Box("trkn", mp4Data([]byte{0, 0, 0, 21, 0, 27, 0, 0})),
+		mp4Box("disk", mp4Data([]byte{0, 0, 0, 1, 0, 1})),
+		mp4Box("gnre", mp4Data([]byte{0, 18})),
+		mp4Box("cpil", mp4Data([]byte{0})),
+		mp4Box("----",
+			mp4Box("mean", append(make([]byte, 4), "com.apple.iTunes"...)),
+			mp4Box("name", append(make([]byte, 4), "MusicBrainz Track Id"...)),
+			mp4Data([]byte(testRecordingID)),
+		),
+		mp4Box("----",
+			mp4Box("mean", append(make([]byte, 4), "com.apple.iTunes"...)),
+			mp4Box("name", append(make([]byte, 4), "replaygain_track_peak"...)),
+			mp4Data([]byte("0.75")),
+		),
+	)
+	meta := mp4Box("meta", append(make([]byte, 4), mp4Box("hdlr", make([]byte, 25))...), ilst)
+	moov := mp4Box("moov", mp4Box("mvhd", mvhd), mp4Box("udta", meta))
+
+	var data []byte
+	data = append(data, mp4Box("ftyp", []byte("M4A \x00\x00\x00\x00"))...)
+	data = append(data, moov...)
+	data = append(data, mp4Box("mdat", make([]byte, 32))...)
+
+	tags, err := Read(bytes.NewReader(data), ".m4a")
+	require.NoError(t, err)
+	assert.Equal(t, "mp4", tags.Format)
+	assert.Equal(t, "Hey Jude", tags.Title)
+	assert.Equal(t, "The Beatles", tags.AlbumArtist)
+	assert.Equal(t, "1", tags.Album)
+	assert.Equal(t, 21, tags.TrackNumber)
+	assert.Equal(t, 27, tags.TrackTotal)
+	assert.Equal(t, 1, tags.DiscNumber)
+	assert.Equal(t, []string{"Rock"}, tags.Genres)
+	assert.False(t, tags.Compilation)
+	assert.Equal(t, testRecordingID, tags.MusicBrainzRecordingID)
+	require.NotNil(t, tags.TrackPeak)
+	assert.InDelta(t, 0.75, *tags.TrackPeak, 0.0001)
+	assert.Equal(t, 215500*time.Millisecond, tags.Duration)
+}
+
+func id3Chapter(version byte, id string, start, end uint32, title string) []byte {
+	body := append([]byte(id), 0)
+	body = binary.BigEndian.AppendUint32(body, start)
+	body = binary.BigEndian.AppendUint32(body, end)
+	body = append(body, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+	body = append(body, id3Frame("TIT2", version, utf8Text(title))...)
+	return id3Frame("CHAP", version, body)
+}
+
+func TestRead_ID3Audiobook(t *testing.T) {
+	data := id3Tag(4,
+		id3Frame("TIT2", 4, utf8Text("The Hobbit")),
+		id3Frame("TCOM", 4, utf8Text("Andy Serkis")),
+		id3Frame("TXXX", 4, utf8Text("SERIES", "Middle-earth")),
+		id3Frame("TXXX", 4, utf8Text("SERIES-PART", "0.5")),
+		id3Frame("COMM", 4, append([]byte{3}, "eng\x00In a hole in the ground..."...)),
+		id3Chapter(4, "ch1", 60000, 0, "An Unexpected Party"),
+		id3Chapter(4, "ch0", 0, 60000, "Opening Credits"),
+	)
+
+	tags, err := Read(bytes.NewReader(data), ".mp3")
+	require.NoError(t, err)
+	assert.Equal(t, "Andy Serkis", tags.Composer)
+	assert.Equal(t, "Middle-earth", tags.Series)
+	assert.Equal(t, "0.5", tags.SeriesPart)
+	assert.Equal(t, "In a hole in the ground...", tags.Description)
+	require.Len(t, tags.Chapters, 2)
+	assert.Equal(t, Chapter{Title: "Opening Credits", Start: 0, End: time.Minute}, tags.Chapters[0])
+	assert.Equal(t, "An Unexpected Party", tags.Chapters[1].Title)
+	assert.Equal(t, time.Minute, tags.Chapters[1].Start)
+}
+
+func TestRead_MP4Audiobook(t *testing.T) {
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:16], 1000)    // timescale
+	binary.BigEndian.PutUint32(mvhd[16:20], 3600000) // one hour
+
+	ilst := mp4Box("ilst",
+		mp4Box("\xa9nam", mp4Data([]byte("Dune"))),
+		mp4Box("\xa9ART", mp4Data([]byte("Frank Herbert"))),
+		mp4Box("\xa9nrt", mp4Data([]byte("Scott Brick"))),
+		mp4Box("\xa9mvn", mp4Data([]byte("Dune Chronicles"))),
+		mp4Box("\xa9mvi", mp4Data([]byte{0, 1})),
+		mp4Box("desc", mp4Data([]byte("Set on the desert planet Arrakis"))),
+	)
+	// Nero chapter list: version 1, reserved, two chapters.
+	chpl := []byte{1, 0, 0, 0, 0, 0, 0, 0, 2}
+	chpl = binary.BigEndian.AppendUint64(chpl, 0)
+	chpl = append(chpl, byte(len("Book One")))
+	chpl = append(chpl, "Book One"...)
+	chpl = binary.BigEndian.AppendUint64(chpl, uint64(20*time.Minute/100))
+	chpl = append(chpl, byte(len("Book Two")))
+	chpl = append(chpl, "Book Two"...)
+
+	meta := mp4Box("meta", append(make([]byte, 4), mp4Box("hdlr", make([]byte, 25))...), ilst)
+	moov := mp4Box("moov", mp4Box("mvhd", mvhd), mp4Box("udta", meta, mp4Box("chpl", chpl)))
+
+	var data []byte
+	data = append(data, mp4Box("ftyp", []byte("M4B \x00\x00\x00\x00"))...)
+	data = append(data, moov...)
+
+	tags, err := Read(bytes.NewReader(data), ".m4b")
+	require.NoError(t, err)
+	assert.Equal(t, "Scott Brick", tags.Narrator)
+	assert.Equal(t, "Dune Chronicles", tags.Series)
+	assert.Equal(t, "1", tags.SeriesPart)
+	assert.Equal(t, "Set on the desert planet Arrakis", tags.Description)
+	require.Len(t, tags.Chapters, 2)
+	assert.Equal(t, Chapter{Title: "Book One", Start: 0, End: 20 * time.Minute}, tags.Chapters[0])
+	assert.Equal(t, Chapter{Title: "Book Two", Start: 20 * time.Minute, End: time.Hour}, tags.Chapters[1])
+}
+
+func TestVorbisChapters(t *testing.T) {
+	f := make(fields)
+	f.add("CHAPTER001", "00:00:00.000")
+	f.add("CHAPTER001NAME", "Prologue")
+	f.add("CHAPTER002", "00:12:30.500")
+	f.add("CHAPTER002NAME", "Chapter 1")
+
+	chapters := vorbisChapters(f)
+	require.Len(t, chapters, 2)
+	assert.Equal(t, "Prologue", chapters[0].Title)
+	assert.Equal(t, 12*time.Minute+30500*time.Millisecond, chapters[0].End)
+	assert.Equal(t, time.Duration(0), chapters[1].End)
+
+	closeChapters(chapters, time.Hour)
+	assert.Equal(t, time.Hour, chapters[1].End)
+}
+
+func TestRead_Unsupported(t *testing.T) {
+	_, err := Read(bytes.NewReader([]byte("RIFF\x00\x00\x00\x00WAVE")), ".wav")
+	assert.ErrorIs(t, err, ErrNoTags)
+
+	_, err = Read(bytes.NewReader(nil), ".mp3")
+	assert.ErrorIs(t, err, ErrNoTags)
+}
+
+func TestResolveID3Genres(t *testing.T) {
+	assert.Equal(t, []string{"Rock", "Jazz", "Trip-Hop", "Blues"},
+		resolveID3Genres([]string{"17", "(8)", "(27)Trip-Hop", "Blues", "(999)"}))
+}
+
+func TestMPEGDuration(t *testing.T) {
+	// MPEG-1 Layer III, 128 kbit/s, 44.1 kHz, stereo
+	header := []byte{0xFF, 0xFB, 0x90, 0x00}
+
+	t.Run("cbr from size", func(t *testing.T) {
+		audio := make([]byte, 16000) // one second at 128 kbit/s
+		copy(audio, header)
+		data := append(id3Tag(3, id3Frame("TIT2", 3, []byte("Chapter 1"))), audio...)
+
+		tags, err := Read(bytes.NewReader(data), ".mp3")
+		require.NoError(t, err)
+		assert.Equal(t, time.Second, tags.Duration)
+	})
+
+	t.Run("vbr from xing frame count", func(t *testing.T) {
+		frame := make([]byte, 417)
+		copy(frame, header)
+		copy(frame[36:], "Xing")
+		binary.BigEndian.PutUint32(frame[40:], 0x01)
+		binary.BigEndian.PutUint32(frame[44:], 441) // 441 * 1152 / 44100 = 11.52s
+		data := append([]byte{0, 0}, frame...)      // junk before the first frame
+
+		assert.Equal(t, 11520*time.Millisecond, MPEGDuration(bytes.NewReader(data)))
+	})
+
+	t.Run("no frames", func(t *testing.T) {
+		assert.Zero(t, MPEGDuration(bytes.NewReader([]byte("not audio"))))
+	})
+}
@@ -0,0 +1,118 @@
+package audiotags
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// FLAC metadata block types.
+const (
+	flacStreamInfo    = 0
+	flacVorbisComment = 4
+)
+
+func readFLAC(r io.ReadSeeker) (*Tags, error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, fmt.Errorf("read flac marker: %w", err)
+	}
+	if string(magic[:]) != "fLaC" {
+		return nil, ErrNoTags
+	}
+
+	f := make(fields)
+	var duration time.Duration
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, fmt.Errorf("read flac block header: %w", err)
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		size := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+
+		switch blockType {
+		case flacStreamInfo, flacVorbisComment:
+			block := make([]byte, size)
+			if _, err := io.ReadFull(r, block); err != nil {
+				return nil, fmt.Errorf("read flac block: %w", err)
+			}
+			if blockType == flacStreamInfo {
+				duration = flacDuration(block)
+			} else if err := parseVorbisComment(block, f); err != nil {
+				return nil, err
+			}
+		default:
+			if _, err := r.Seek(int64(size), io.SeekCurrent); err != nil {
+				return nil, err
+			}
+		}
+		if last {
+			break
+		}
+	}
+
+	t := f.build("flac")
+	t.Duration = duration
+	return t, nil
+}
+
+// flacDuration computes the duration from a STREAMINFO block.
+func flacDuration(block []byte) time.Duration {
+	if len(block) < 18 {
+		return 0
+	}
+	// Bytes 10-17: 20 bits sample rate, 3 bits channels, 5 bits bits per
+	// sample, 36 bits total samples.
+	v := binary.BigEndian.Uint64(block[10:18])
+	sampleRate := v >> 44
+	totalSamples := v & 0xFFFFFFFFF
+	if sampleRate == 0 {
+		return 0
+	}
+	return time.Duration(totalSamples * uint64(time.Second) / sampleRate)
+}
+
+// parseVorbisComment parses a Vorbis comment block (without framing bit).
+func parseVorbisComment(data []byte, f fields) error {
+	errTruncated := errors.New("truncated vorbis comment")
+
+	readString := func() (string, bool) {
+		if len(data) < 4 {
+			return "", false
+		}
+		n := int(binary.LittleEndian.Uint32(data[:4]))
+		if n < 0 || 4+n > len(data) {
+			return "", false
+		}
+		s := string(data[4 : 4+n])
+		data = data[4+n:]
+		return s, true
+	}
+
+	if _, ok := readString(); !ok { // vendor string
+		return errTruncated
+	}
+	if len(data) < 4 {
+		return errTruncated
+	}
+	count := int(binary.LittleEndian.Uint32(data[:4]))
+	data = data[4:]
+
+	for range count {
+		comment, ok := readString()
+		if !ok {
+			return errTruncated
+		}
+		key, value, ok := strings.Cut(comment, "=")
+		if !ok {
+			continue
+		}
+		f.add(key, value)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// DefaultScanProgressInterval is the minimum time between two progress updates.
+const DefaultScanProgressInterval = time.Second
+
+// ScanReporter publishes library scan progress to the job row and as
+// EventLibraryScanProgress events for live clients. Updates are throttled so
+// large libraries don't flood the database or the event stream.
+type ScanReporter struct {
+	jobClient     *infrajobs.Client
+	notifications notification.Service
+	jobID         int64
+	libraryType   string
+	libraryID     string
+	scanID        string
+
+	// Interval is the minimum time between two updates.
+	Interval time.Duration
+
+	now   func() time.Time
+	start time.Time
+	last  time.Time
+}
+
+// NewScanReporter creates a reporter for a scan job. The job client and the
+// notification service may be nil.
+func NewScanReporter(
+	jobClient *infrajobs.Client,
+	notifications notification.Service,
+	jobID int64,
+	libraryType, libraryID, scanID string,
+) *ScanReporter {
+	return &ScanReporter{
+		jobClient:     jobClient,
+		notifications: notifications,
+		jobID:         jobID,
+		libraryType:   libraryType,
+		libraryID:     libraryID,
+		scanID:        scanID,
+		Interval:      DefaultScanProgressInterval,
+		now:           time.Now,
+		start:         time.Now(),
+	}
+}
+
+// Report publishes the progress unless an update was sent within the
+// interval. The last file of a scan is always reported.
+func (r *ScanReporter) Report(ctx context.Context, p scanner.Progress) {
+	if r.now().Sub(r.last) < r.Interval && p.FilesProcessed < p.FilesTotal {
+		return
+	}
+	r.Flush(ctx, p)
+}
+
+// Flush publishes the progress immediately.
+func (r *ScanReporter) Flush(ctx context.Context, p scanner.Progress) {
+	now := r.now()
+	r.last = now
+
+	if r.jobClient != nil {
+		_ = r.jobClient.ReportProgress(ctx, r.jobID, &infrajobs.JobProgress{
+			Phase:   p.Phase,
+			Current: p.FilesProcessed,
+			Total:   p.FilesTotal,
+			Message: p.CurrentPath,
+		})
+	}
+
+	if r.notifications == nil {
+		return
+	}
+	evt := notification.NewEvent(notification.EventLibraryScanProgress).
+		WithData("library_id", r.libraryID).
+		WithData("scan_id", r.scanID).
+		WithData("library_type", r.libraryType).
+		WithData("phase", p.Phase).
+		WithData("files_total", p.FilesTotal).
+		WithData("files_processed", p.FilesProcessed).
+		WithData("files_matched", p.FilesMatched).
+		WithData("current_path", p.CurrentPath).
+		WithData("percent", progressPercent(p))
+	if eta, ok := r.eta(now, p); ok {
+		evt.WithData("eta_seconds", int(eta.Seconds()))
+	}
+	_ = r.notifications.Dispatch(ctx, evt)
+}
+
+// eta estimates the remaining time from the average time per processed file.
+func (r *ScanReporter) eta(now time.Time, p scanner.Progress) (time.Duration, bool) {
+	if p.FilesProcessed == 0 || p.FilesTotal <= p.FilesProcessed {
+		return 0, false
+	}
+	perFile := now.Sub(r.start) / time.Duration(p.FilesProcessed)
+	return perFile * time.Duration(p.FilesTotal-p.FilesProcessed), true
+}
+
+func progressPercent(p scanner.Progress) int {
+	if p.FilesTotal <= 0 {
+		return 0
+	}
+	return p.FilesProcessed * 100 / p.FilesTotal
+}
@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// recordingNotifier records dispatched events.
+type recordingNotifier struct {
+	notification.Service
+	events []*notification.Event
+}
+
+func (n *recordingNotifier) Dispatch(_ context.Context, event *notification.Event) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+func newTestReporter(n notification.Service, clock *time.Time) *ScanReporter {
+	r := NewScanReporter(nil, n, 7, "movie", "lib-1", "scan-1")
+	r.now = func() time.Time { return *clock }
+	r.start = *clock
+	return r
+}
+
+func TestScanReporter_Report(t *testing.T) {
+	t.Parallel()
+
+	notifier := &recordingNotifier{}
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := newTestReporter(notifier, &clock)
+
+	clock = clock.Add(2 * time.Second)
+	r.Report(context.Background(), scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     10,
+		FilesProcessed: 2,
+		FilesMatched:   1,
+		CurrentPath:    "/movies/Heat (1995)/Heat (1995).mkv",
+	})
+	require.Len(t, notifier.events, 1)
+
+	evt := notifier.events[0]
+	assert.Equal(t, notification.EventLibraryScanProgress, evt.Type)
+	assert.Equal(t, "lib-1", evt.Data["library_id"])
+	assert.Equal(t, "scan-1", evt.Data["scan_id"])
+	assert.Equal(t, "movie", evt.Data["library_type"])
+	assert.Equal(t, scanner.PhaseMatching, evt.Data["phase"])
+	assert.Equal(t, 10, evt.Data["files_total"])
+	assert.Equal(t, 2, evt.Data["files_processed"])
+	assert.Equal(t, 1, evt.Data["files_matched"])
+	assert.Equal(t, "/movies/Heat (1995)/Heat (1995).mkv", evt.Data["current_path"])
+	assert.Equal(t, 20, evt.Data["percent"])
+	// One second per file, eight files left.
+	assert.Equal(t, 8, evt.Data["eta_seconds"])
+}
+
+func TestScanReporter_Throttle(t *testing.T) {
+	t.Parallel()
+
+	notifier := &recordingNotifier{}
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := newTestReporter(notifier, &clock)
+	ctx := context.Background()
+
+	r.Report(ctx, scanner.Progress{FilesTotal: 3, FilesProcessed: 1})
+	clock = clock.Add(100 * time.Millisecond)
+	r.Report(ctx, scanner.Progress{FilesTotal: 3, FilesProcessed: 2})
+	assert.Len(t, notifier.events, 1, "update within the interval is dropped")
+
+	clock = clock.Add(100 * time.Millisecond)
+	r.Report(ctx, scanner.Progress{FilesTotal: 3, FilesProcessed: 3})
+	require.Len(t, notifier.events, 2, "last file is always reported")
+	_, hasETA := notifier.events[1].Data["eta_seconds"]
+	assert.False(t, hasETA)
+
+	r.Flush(ctx, scanner.Progress{FilesTotal: 3, FilesProcessed: 3})
+	assert.Len(t, notifier.events, 3)
+}
+
+func TestScanReporter_NilDependencies(t *testing.T) {
+	t.Parallel()
+
+	r := NewScanReporter(nil, nil, 1, "tvshow", "", "")
+	assert.NotPanics(t, func() {
+		r.Flush(context.Background(), scanner.Progress{FilesTotal: 1})
+	})
+}
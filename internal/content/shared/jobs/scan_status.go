@@ -0,0 +1,153 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/notification"
+)
+
+// ScanStatus keeps the library scan record of a scan job up to date. A job
+// without a scan record, or a worker without the library service, makes
+// every update a no-op.
+type ScanStatus struct {
+	service       *library.Service
+	notifications notification.Service
+	logger        *slog.Logger
+	libraryType   string
+	scanID        *uuid.UUID
+
+	// LibraryID and ScanID are the job's IDs as strings for events and
+	// logs, empty when unset.
+	LibraryID string
+	ScanID    string
+}
+
+// NewScanStatus creates the scan status of a job. The library service, the
+// notification service and both IDs may be nil.
+func NewScanStatus(
+	service *library.Service,
+	notifications notification.Service,
+	logger *slog.Logger,
+	libraryType string,
+	libraryID, scanID *uuid.UUID,
+) *ScanStatus {
+	s := &ScanStatus{
+		service:       service,
+		notifications: notifications,
+		logger:        logger,
+		libraryType:   libraryType,
+		scanID:        scanID,
+	}
+	if libraryID != nil {
+		s.LibraryID = libraryID.String()
+	}
+	if scanID != nil {
+		s.ScanID = scanID.String()
+	}
+	return s
+}
+
+// Tracked reports whether the job updates a library scan record.
+func (s *ScanStatus) Tracked() bool {
+	return s.scanID != nil && s.service != nil
+}
+
+// Start marks the scan record running.
+func (s *ScanStatus) Start(ctx context.Context) {
+	if !s.Tracked() {
+		return
+	}
+	if _, err := s.service.StartScan(ctx, *s.scanID); err != nil {
+		s.logger.Warn("failed to mark scan as running",
+			slog.String("scan_id", s.ScanID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// Complete marks the scan record completed.
+func (s *ScanStatus) Complete(ctx context.Context, progress *library.ScanProgress) {
+	if !s.Tracked() {
+		return
+	}
+	if _, err := s.service.CompleteScan(ctx, *s.scanID, progress); err != nil {
+		s.logger.Warn("failed to mark scan as completed",
+			slog.String("scan_id", s.ScanID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// Fail marks the scan record failed with the scan error.
+func (s *ScanStatus) Fail(ctx context.Context, scanErr error) {
+	if !s.Tracked() {
+		return
+	}
+	if _, err := s.service.FailScan(ctx, *s.scanID, scanErr.Error()); err != nil {
+		s.logger.Warn("failed to mark scan as failed",
+			slog.String("scan_id", s.ScanID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// Interrupted records a scan whose job context is done. A cancel request
+// cancels the job; a timeout or a worker shutdown fails the scan and
+// returns the error so River retries the job.
+func (s *ScanStatus) Interrupted(ctx context.Context) error {
+	if Cancelled(ctx) {
+		return s.Cancel(ctx)
+	}
+	err := fmt.Errorf("library scan interrupted: %w", context.Cause(ctx))
+	s.logger.Warn("library scan interrupted",
+		slog.String("scan_id", s.ScanID),
+		slog.String("library_id", s.LibraryID),
+		slog.Any("error", err),
+	)
+	s.Fail(context.WithoutCancel(ctx), err)
+	return err
+}
+
+// Cancel records a scan stopped by a cancel request. The scan is usually
+// marked cancelled by whoever cancelled the job; otherwise it is done here.
+func (s *ScanStatus) Cancel(ctx context.Context) error {
+	ctx = context.WithoutCancel(ctx)
+	s.logger.Info("library scan cancelled",
+		slog.String("library_type", s.libraryType),
+		slog.String("scan_id", s.ScanID),
+		slog.String("library_id", s.LibraryID),
+	)
+
+	if s.Tracked() {
+		_, err := s.service.CancelActiveScan(ctx, *s.scanID)
+		switch {
+		case err == nil:
+			if s.notifications != nil {
+				_ = s.notifications.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanCancelled).
+					WithData("library_id", s.LibraryID).
+					WithData("scan_id", s.ScanID).
+					WithData("library_type", s.libraryType))
+			}
+		case !errors.Is(err, library.ErrScanNotActive):
+			s.logger.Warn("failed to mark scan as cancelled",
+				slog.String("scan_id", s.ScanID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	return river.JobCancel(errors.New("library scan cancelled"))
+}
+
+// Cancelled reports whether the job was stopped by a cancel request rather
+// than by its timeout or a worker shutdown.
+func Cancelled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), river.ErrJobCancelledRemotely)
+}
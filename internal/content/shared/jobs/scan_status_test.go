@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/infra/logging"
+)
+
+func TestCancelled(t *testing.T) {
+	t.Parallel()
+
+	cancelled, cancel := context.WithCancelCause(context.Background())
+	cancel(river.ErrJobCancelledRemotely)
+	assert.True(t, Cancelled(cancelled))
+
+	stopped, stop := context.WithCancel(context.Background())
+	stop()
+	assert.False(t, Cancelled(stopped), "a worker shutdown is not a cancel request")
+
+	timedOut, cancelTimeout := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelTimeout()
+	assert.False(t, Cancelled(timedOut), "a timeout is not a cancel request")
+}
+
+func TestScanStatus_Interrupted(t *testing.T) {
+	t.Parallel()
+
+	scanID := uuid.New()
+	status := NewScanStatus(nil, nil, logging.NewTestLogger(), "movie", nil, &scanID)
+	assert.Equal(t, scanID.String(), status.ScanID)
+	assert.Empty(t, status.LibraryID)
+	assert.False(t, status.Tracked(), "no library service to update")
+
+	cancelled, cancel := context.WithCancelCause(context.Background())
+	cancel(river.ErrJobCancelledRemotely)
+	var cancelErr *river.JobCancelError
+	assert.True(t, errors.As(status.Interrupted(cancelled), &cancelErr))
+
+	timedOut, cancelTimeout := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelTimeout()
+	err := status.Interrupted(timedOut)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &cancelErr), "a timed out scan is retried")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
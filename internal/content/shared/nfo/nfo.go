@@ -0,0 +1,233 @@
+package nfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ErrNoMetadata is returned when an NFO holds neither XML metadata nor a
+// recognizable provider URL.
+var ErrNoMetadata = errors.New("nfo contains no metadata")
+
+// Well-known NFO file names.
+const (
+	MovieFileName  = "movie.nfo"
+	TVShowFileName = "tvshow.nfo"
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes" ?>` + "\n"
+
+var (
+	seasonFolder = regexp.MustCompile(`(?i)^(season[ ._-]*\d+|s\d+|specials)$`)
+
+	tmdbMovieURL = regexp.MustCompile(`themoviedb\.org/movie/(\d+)`)
+	tmdbTVURL    = regexp.MustCompile(`themoviedb\.org/tv/(\d+)`)
+	tvdbURL      = regexp.MustCompile(`thetvdb\.com/.*?(?:[?&]id=|/series/)(\d+)`)
+	imdbURL      = regexp.MustCompile(`imdb\.com/title/(tt\d+)`)
+)
+
+// ParseMovie parses a movie NFO. Besides full XML documents it accepts the
+// URL-only and hybrid (XML followed by a URL) variants Kodi supports.
+func ParseMovie(data []byte) (*Movie, error) {
+	var m Movie
+	if err := decodeRoot(data, &m); err != nil {
+		m = Movie{}
+	}
+
+	if m.TMDbID() == nil {
+		if id := firstMatch(tmdbMovieURL, data); id != "" {
+			m.SetUniqueID(ProviderTMDb, id)
+		}
+	}
+	if m.IMDbID() == nil {
+		if id := firstMatch(imdbURL, data); id != "" {
+			m.SetUniqueID(ProviderIMDb, id)
+		}
+	}
+
+	if m.Title == "" && !m.HasProviderID() {
+		return nil, ErrNoMetadata
+	}
+	return &m, nil
+}
+
+// ParseTVShow parses a tvshow.nfo, including URL-only and hybrid variants.
+func ParseTVShow(data []byte) (*TVShow, error) {
+	var s TVShow
+	if err := decodeRoot(data, &s); err != nil {
+		s = TVShow{}
+	}
+
+	if s.TMDbID() == nil {
+		if id := firstMatch(tmdbTVURL, data); id != "" {
+			s.SetUniqueID(ProviderTMDb, id)
+		}
+	}
+	if s.TVDbID() == nil {
+		if id := firstMatch(tvdbURL, data); id != "" {
+			s.SetUniqueID(ProviderTVDb, id)
+		}
+	}
+	if s.IMDbID() == nil {
+		if id := firstMatch(imdbURL, data); id != "" {
+			s.SetUniqueID(ProviderIMDb, id)
+		}
+	}
+
+	if s.Title == "" && !s.HasProviderID() {
+		return nil, ErrNoMetadata
+	}
+	return &s, nil
+}
+
+// ReadMovie reads and parses a movie NFO file.
+func ReadMovie(path string) (*Movie, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ParseMovie(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// ReadTVShow reads and parses a tvshow.nfo file.
+func ReadTVShow(path string) (*TVShow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := ParseTVShow(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// MoviePath returns the per-file NFO path for a video, e.g.
+// "/movies/The Matrix (1999).mkv" -> "/movies/The Matrix (1999).nfo".
+func MoviePath(videoPath string) string {
+	return strings.TrimSuffix(videoPath, filepath.Ext(videoPath)) + ".nfo"
+}
+
+// FindMovie returns the NFO that belongs to a video file, preferring the
+// per-file NFO over a folder-level movie.nfo. It returns "" if none exists.
+func FindMovie(videoPath string) string {
+	for _, p := range []string{MoviePath(videoPath), filepath.Join(filepath.Dir(videoPath), MovieFileName)} {
+		if isFile(p) {
+			return p
+		}
+	}
+	return ""
+}
+
+// FindTVShow returns the tvshow.nfo for an episode file. It looks in the
+// episode's folder and its parent, covering both flat show folders and
+// "Season NN" subfolders. It returns "" if none exists.
+func FindTVShow(episodePath string) string {
+	dir := filepath.Dir(episodePath)
+	for range 2 {
+		if p := filepath.Join(dir, TVShowFileName); isFile(p) {
+			return p
+		}
+		dir = filepath.Dir(dir)
+	}
+	return ""
+}
+
+// EpisodePath returns the NFO path for an episode file, which like a movie
+// NFO is named after the video.
+func EpisodePath(videoPath string) string {
+	return MoviePath(videoPath)
+}
+
+// TVShowPath returns where the tvshow.nfo of an episode file belongs: the
+// existing one if there is any, otherwise the show folder, which is the
+// parent of a "Season NN" or "Specials" folder.
+func TVShowPath(episodePath string) string {
+	if p := FindTVShow(episodePath); p != "" {
+		return p
+	}
+	dir := filepath.Dir(episodePath)
+	if seasonFolder.MatchString(filepath.Base(dir)) {
+		dir = filepath.Dir(dir)
+	}
+	return filepath.Join(dir, TVShowFileName)
+}
+
+// Marshal encodes an NFO document with the XML header Kodi writes.
+func Marshal(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xmlHeader)
+	buf.Write(body)
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// WriteFile writes an NFO document atomically, so readers on the same share
+// never see a partially written file.
+func WriteFile(path string, v any) error {
+	data, err := Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal nfo: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".nfo-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write nfo: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close nfo: %w", err)
+	}
+	if err := os.Chmod(tmpPath, 0o644); err != nil {
+		return fmt.Errorf("chmod nfo: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename nfo: %w", err)
+	}
+	return nil
+}
+
+// decodeRoot decodes the first XML element into v and ignores anything
+// after it, such as the provider URL of a hybrid NFO.
+func decodeRoot(data []byte, v any) error {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = false
+	dec.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	return dec.Decode(v)
+}
+
+func firstMatch(re *regexp.Regexp, data []byte) string {
+	if m := re.FindSubmatch(data); m != nil {
+		return string(m[1])
+	}
+	return ""
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,192 @@
+package nfo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const kodiMovieNFO = `<?xml version="1.0" encoding="UTF-8" standalone="yes" ?>
+<movie>
+  <title>The Matrix</title>
+  <originaltitle>The Matrix</originaltitle>
+  <sorttitle>Matrix 1</sorttitle>
+  <year>1999</year>
+  <plot>A hacker learns the truth.</plot>
+  <uniqueid type="imdb">tt0133093</uniqueid>
+  <uniqueid type="tmdb" default="true">603</uniqueid>
+  <genre>Action</genre>
+  <genre>Science Fiction</genre>
+  <actor>
+    <name>Keanu Reeves</name>
+    <role>Neo</role>
+    <order>0</order>
+  </actor>
+</movie>
+`
+
+func TestParseMovie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("kodi xml", func(t *testing.T) {
+		m, err := ParseMovie([]byte(kodiMovieNFO))
+		require.NoError(t, err)
+		assert.Equal(t, "The Matrix", m.Title)
+		assert.Equal(t, "Matrix 1", m.SortTitle)
+		assert.Equal(t, 1999, m.Year)
+		assert.Equal(t, []string{"Action", "Science Fiction"}, m.Genres)
+		require.Len(t, m.Actors, 1)
+		assert.Equal(t, "Neo", m.Actors[0].Role)
+
+		require.NotNil(t, m.TMDbID())
+		assert.Equal(t, int32(603), *m.TMDbID())
+		require.NotNil(t, m.IMDbID())
+		assert.Equal(t, "tt0133093", *m.IMDbID())
+	})
+
+	t.Run("legacy id elements", func(t *testing.T) {
+		m, err := ParseMovie([]byte(`<movie><title>Heat</title><id>tt0113277</id><tmdbid>949</tmdbid></movie>`))
+		require.NoError(t, err)
+		assert.Equal(t, int32(949), *m.TMDbID())
+		assert.Equal(t, "tt0113277", *m.IMDbID())
+	})
+
+	t.Run("hybrid nfo", func(t *testing.T) {
+		m, err := ParseMovie([]byte("<movie><title>Heat</title></movie>\nhttps://www.themoviedb.org/movie/949-heat\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "Heat", m.Title)
+		assert.Equal(t, int32(949), *m.TMDbID())
+	})
+
+	t.Run("url only", func(t *testing.T) {
+		m, err := ParseMovie([]byte("https://www.imdb.com/title/tt0113277/\n"))
+		require.NoError(t, err)
+		assert.Nil(t, m.TMDbID())
+		assert.Equal(t, "tt0113277", *m.IMDbID())
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		_, err := ParseMovie([]byte("ripped by someone\n"))
+		assert.ErrorIs(t, err, ErrNoMetadata)
+	})
+}
+
+func TestParseTVShow(t *testing.T) {
+	t.Parallel()
+
+	s, err := ParseTVShow([]byte(`<tvshow>
+  <title>Breaking Bad</title>
+  <uniqueid type="tvdb" default="true">81189</uniqueid>
+  <uniqueid type="tmdb">1396</uniqueid>
+  <uniqueid type="imdb">tt0903747</uniqueid>
+</tvshow>`))
+	require.NoError(t, err)
+	assert.Equal(t, "Breaking Bad", s.Title)
+	assert.Equal(t, int32(1396), *s.TMDbID())
+	assert.Equal(t, int32(81189), *s.TVDbID())
+	assert.Equal(t, "tt0903747", *s.IMDbID())
+
+	s, err = ParseTVShow([]byte("https://thetvdb.com/?tab=series&id=81189\n"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(81189), *s.TVDbID())
+}
+
+func TestFindMovie(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	video := filepath.Join(dir, "The Matrix (1999).mkv")
+
+	assert.Empty(t, FindMovie(video))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, MovieFileName), []byte(kodiMovieNFO), 0o644))
+	assert.Equal(t, filepath.Join(dir, MovieFileName), FindMovie(video))
+
+	require.NoError(t, os.WriteFile(MoviePath(video), []byte(kodiMovieNFO), 0o644))
+	assert.Equal(t, filepath.Join(dir, "The Matrix (1999).nfo"), FindMovie(video))
+}
+
+func TestFindTVShow(t *testing.T) {
+	t.Parallel()
+
+	show := t.TempDir()
+	season := filepath.Join(show, "Season 01")
+	require.NoError(t, os.MkdirAll(season, 0o755))
+	episode := filepath.Join(season, "Show S01E01.mkv")
+
+	assert.Empty(t, FindTVShow(episode))
+
+	require.NoError(t, os.WriteFile(filepath.Join(show, TVShowFileName), []byte("<tvshow><title>Show</title></tvshow>"), 0o644))
+	assert.Equal(t, filepath.Join(show, TVShowFileName), FindTVShow(episode))
+}
+
+func TestTVShowPath(t *testing.T) {
+	t.Parallel()
+
+	show := t.TempDir()
+	season := filepath.Join(show, "Season 01")
+	specials := filepath.Join(show, "Specials")
+	require.NoError(t, os.MkdirAll(season, 0o755))
+	require.NoError(t, os.MkdirAll(specials, 0o755))
+
+	want := filepath.Join(show, TVShowFileName)
+	assert.Equal(t, want, TVShowPath(filepath.Join(season, "Show S01E01.mkv")))
+	assert.Equal(t, want, TVShowPath(filepath.Join(specials, "Show S00E01.mkv")))
+	assert.Equal(t, want, TVShowPath(filepath.Join(show, "Show S01E01.mkv")), "flat show folder")
+	assert.Equal(t, filepath.Join(season, "Show S01E01.nfo"), EpisodePath(filepath.Join(season, "Show S01E01.mkv")))
+}
+
+func TestWriteFile_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	order := 0
+	in := &Movie{
+		Title:     "The Matrix",
+		SortTitle: "Matrix 1",
+		Year:      1999,
+		Genres:    []string{"Action"},
+		Actors:    []Actor{{Name: "Keanu Reeves", Role: "Neo", Order: &order}},
+	}
+	in.SetUniqueID(ProviderTMDb, "603")
+	in.SetUniqueID(ProviderIMDb, "tt0133093")
+
+	path := filepath.Join(t.TempDir(), "The Matrix (1999).nfo")
+	require.NoError(t, WriteFile(path, in))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<?xml version="1.0" encoding="UTF-8" standalone="yes" ?>`)
+	assert.Contains(t, string(data), `<uniqueid type="tmdb" default="true">603</uniqueid>`)
+
+	out, err := ReadMovie(path)
+	require.NoError(t, err)
+	assert.Equal(t, in.Title, out.Title)
+	assert.Equal(t, in.SortTitle, out.SortTitle)
+	assert.Equal(t, int32(603), *out.TMDbID())
+	assert.Equal(t, "tt0133093", *out.IMDbID())
+	assert.Equal(t, in.Actors, out.Actors)
+}
+
+func TestMarshal_Episode(t *testing.T) {
+	t.Parallel()
+
+	ep := &Episode{
+		Title:     "Pilot",
+		ShowTitle: "Breaking Bad",
+		Season:    1,
+		Episode:   1,
+		Aired:     "2008-01-20",
+		Directors: []string{"Vince Gilligan"},
+	}
+	ep.SetUniqueID(ProviderTVDb, "349232")
+
+	data, err := Marshal(ep)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "<episodedetails>")
+	assert.Contains(t, string(data), "<season>1</season>")
+	assert.Contains(t, string(data), `<uniqueid type="tvdb" default="true">349232</uniqueid>`)
+	assert.Contains(t, string(data), "<director>Vince Gilligan</director>")
+}
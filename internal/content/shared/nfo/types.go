@@ -0,0 +1,214 @@
+// Package nfo reads and writes Kodi-style NFO sidecar files.
+// The format is shared by Kodi, Jellyfin, Emby and most *arr tools: an XML
+// document named after the media file (or movie.nfo / tvshow.nfo) that
+// carries curated titles and provider IDs.
+package nfo
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// Provider names used in <uniqueid type="..."> elements.
+const (
+	ProviderTMDb = "tmdb"
+	ProviderIMDb = "imdb"
+	ProviderTVDb = "tvdb"
+)
+
+// UniqueID is a provider ID, e.g. <uniqueid type="tmdb" default="true">603</uniqueid>.
+type UniqueID struct {
+	Type    string `xml:"type,attr"`
+	Default bool   `xml:"default,attr,omitempty"`
+	Value   string `xml:",chardata"`
+}
+
+// Actor is a cast member entry.
+type Actor struct {
+	Name  string `xml:"name"`
+	Role  string `xml:"role,omitempty"`
+	Order *int   `xml:"order,omitempty"`
+	Thumb string `xml:"thumb,omitempty"`
+}
+
+// Movie is the <movie> root element of a movie NFO.
+type Movie struct {
+	XMLName       xml.Name   `xml:"movie"`
+	Title         string     `xml:"title,omitempty"`
+	OriginalTitle string     `xml:"originaltitle,omitempty"`
+	SortTitle     string     `xml:"sorttitle,omitempty"`
+	Year          int        `xml:"year,omitempty"`
+	Premiered     string     `xml:"premiered,omitempty"`
+	Runtime       int        `xml:"runtime,omitempty"`
+	Plot          string     `xml:"plot,omitempty"`
+	Outline       string     `xml:"outline,omitempty"`
+	Tagline       string     `xml:"tagline,omitempty"`
+	MPAA          string     `xml:"mpaa,omitempty"`
+	UniqueIDs     []UniqueID `xml:"uniqueid"`
+	Genres        []string   `xml:"genre"`
+	Studios       []string   `xml:"studio"`
+	Countries     []string   `xml:"country"`
+	Directors     []string   `xml:"director"`
+	Credits       []string   `xml:"credits"`
+	Actors        []Actor    `xml:"actor"`
+
+	// Legacy ID elements written by older Kodi versions and scrapers.
+	LegacyID     string `xml:"id,omitempty"`
+	LegacyIMDbID string `xml:"imdbid,omitempty"`
+	LegacyTMDbID string `xml:"tmdbid,omitempty"`
+}
+
+// TVShow is the <tvshow> root element of a tvshow.nfo.
+type TVShow struct {
+	XMLName       xml.Name   `xml:"tvshow"`
+	Title         string     `xml:"title,omitempty"`
+	OriginalTitle string     `xml:"originaltitle,omitempty"`
+	SortTitle     string     `xml:"sorttitle,omitempty"`
+	Year          int        `xml:"year,omitempty"`
+	Premiered     string     `xml:"premiered,omitempty"`
+	Plot          string     `xml:"plot,omitempty"`
+	Status        string     `xml:"status,omitempty"`
+	MPAA          string     `xml:"mpaa,omitempty"`
+	UniqueIDs     []UniqueID `xml:"uniqueid"`
+	Genres        []string   `xml:"genre"`
+	Studios       []string   `xml:"studio"`
+	Actors        []Actor    `xml:"actor"`
+
+	// Legacy ID elements written by older Kodi versions and scrapers.
+	LegacyID     string `xml:"id,omitempty"`
+	LegacyIMDbID string `xml:"imdb_id,omitempty"`
+	LegacyTMDbID string `xml:"tmdbid,omitempty"`
+	LegacyTVDbID string `xml:"tvdbid,omitempty"`
+}
+
+// Episode is the <episodedetails> root element of an episode NFO.
+type Episode struct {
+	XMLName   xml.Name   `xml:"episodedetails"`
+	Title     string     `xml:"title,omitempty"`
+	ShowTitle string     `xml:"showtitle,omitempty"`
+	Season    int        `xml:"season"`
+	Episode   int        `xml:"episode"`
+	Aired     string     `xml:"aired,omitempty"`
+	Runtime   int        `xml:"runtime,omitempty"`
+	Plot      string     `xml:"plot,omitempty"`
+	UniqueIDs []UniqueID `xml:"uniqueid"`
+	Directors []string   `xml:"director"`
+	Credits   []string   `xml:"credits"`
+	Actors    []Actor    `xml:"actor"`
+}
+
+// TMDbID returns the TMDb ID from the NFO, if any.
+func (m *Movie) TMDbID() *int32 {
+	if id := uniqueID(m.UniqueIDs, ProviderTMDb); id != "" {
+		return parseNumericID(id)
+	}
+	return parseNumericID(m.LegacyTMDbID)
+}
+
+// IMDbID returns the IMDb ID from the NFO, if any.
+func (m *Movie) IMDbID() *string {
+	for _, id := range []string{uniqueID(m.UniqueIDs, ProviderIMDb), m.LegacyIMDbID, m.LegacyID} {
+		if isIMDbID(id) {
+			return &id
+		}
+	}
+	return nil
+}
+
+// HasProviderID reports whether the NFO identifies the movie by ID.
+func (m *Movie) HasProviderID() bool {
+	return m.TMDbID() != nil || m.IMDbID() != nil
+}
+
+// SetUniqueID sets or replaces the ID for a provider. The first ID set
+// becomes the default.
+func (m *Movie) SetUniqueID(provider, value string) {
+	m.UniqueIDs = setUniqueID(m.UniqueIDs, provider, value)
+}
+
+// TMDbID returns the TMDb ID from the NFO, if any.
+func (s *TVShow) TMDbID() *int32 {
+	if id := uniqueID(s.UniqueIDs, ProviderTMDb); id != "" {
+		return parseNumericID(id)
+	}
+	return parseNumericID(s.LegacyTMDbID)
+}
+
+// TVDbID returns the TVDb ID from the NFO, if any. Kodi's legacy <id>
+// element holds the TVDb ID for shows.
+func (s *TVShow) TVDbID() *int32 {
+	for _, id := range []string{uniqueID(s.UniqueIDs, ProviderTVDb), s.LegacyTVDbID, s.LegacyID} {
+		if v := parseNumericID(id); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// IMDbID returns the IMDb ID from the NFO, if any.
+func (s *TVShow) IMDbID() *string {
+	for _, id := range []string{uniqueID(s.UniqueIDs, ProviderIMDb), s.LegacyIMDbID} {
+		if isIMDbID(id) {
+			return &id
+		}
+	}
+	return nil
+}
+
+// HasProviderID reports whether the NFO identifies the show by ID.
+func (s *TVShow) HasProviderID() bool {
+	return s.TMDbID() != nil || s.TVDbID() != nil || s.IMDbID() != nil
+}
+
+// SetUniqueID sets or replaces the ID for a provider. The first ID set
+// becomes the default.
+func (s *TVShow) SetUniqueID(provider, value string) {
+	s.UniqueIDs = setUniqueID(s.UniqueIDs, provider, value)
+}
+
+// SetUniqueID sets or replaces the ID for a provider. The first ID set
+// becomes the default.
+func (e *Episode) SetUniqueID(provider, value string) {
+	e.UniqueIDs = setUniqueID(e.UniqueIDs, provider, value)
+}
+
+func uniqueID(ids []UniqueID, provider string) string {
+	for _, id := range ids {
+		if strings.EqualFold(id.Type, provider) {
+			return strings.TrimSpace(id.Value)
+		}
+	}
+	return ""
+}
+
+func setUniqueID(ids []UniqueID, provider, value string) []UniqueID {
+	for i := range ids {
+		if strings.EqualFold(ids[i].Type, provider) {
+			ids[i].Value = value
+			return ids
+		}
+	}
+	return append(ids, UniqueID{Type: provider, Default: len(ids) == 0, Value: value})
+}
+
+func parseNumericID(s string) *int32 {
+	v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+	if err != nil || v <= 0 {
+		return nil
+	}
+	id := int32(v)
+	return &id
+}
+
+func isIMDbID(s string) bool {
+	if len(s) < 3 || !strings.HasPrefix(s, "tt") {
+		return false
+	}
+	for _, r := range s[2:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
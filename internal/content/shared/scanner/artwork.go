@@ -0,0 +1,212 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Artwork kinds recognized next to media files.
+const (
+	ArtworkPoster   = "poster"
+	ArtworkBackdrop = "backdrop"
+	ArtworkLogo     = "logo"
+	ArtworkClearArt = "clearart"
+)
+
+// LocalArtwork is an image file found next to media files.
+type LocalArtwork struct {
+	Kind string
+	Path string
+	// SeasonNumber is set for season artwork such as season01-poster.jpg.
+	// Season 0 holds specials.
+	SeasonNumber *int
+}
+
+// artworkNames maps folder-level base names to artwork kinds, in order of
+// preference when several names of the same kind exist.
+var artworkNames = []struct {
+	name string
+	kind string
+}{
+	{"poster", ArtworkPoster},
+	{"folder", ArtworkPoster},
+	{"fanart", ArtworkBackdrop},
+	{"backdrop", ArtworkBackdrop},
+	{"logo", ArtworkLogo},
+	{"clearlogo", ArtworkLogo},
+	{"clearart", ArtworkClearArt},
+}
+
+var (
+	seasonPosterPattern = regexp.MustCompile(`^season(\d{1,3}|-specials)-poster$`)
+	seasonFolderPattern = regexp.MustCompile(`(?i)^(season\s*\d+|s\d+|specials)$`)
+	discFolderPattern   = regexp.MustCompile(`(?i)^(cd|disc|disk)\s*\d+$`)
+)
+
+// albumCoverNames are the folder-level cover image names of an album, in
+// order of preference.
+var albumCoverNames = []string{"cover", "folder", "front", "album"}
+
+// FindMovieArtwork returns the local artwork for a movie file. Per-file
+// names like "<video>-poster.jpg" always apply; folder-level names like
+// poster.jpg only when the folder holds a single video, so a flat folder
+// of movies does not share one poster. At most one image per kind is
+// returned.
+func FindMovieArtwork(videoPath string) []LocalArtwork {
+	dir := filepath.Dir(videoPath)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	videoBase := strings.ToLower(strings.TrimSuffix(filepath.Base(videoPath), filepath.Ext(videoPath)))
+	images := imageFiles(entries)
+
+	found := map[string]LocalArtwork{}
+	for _, entry := range artworkNames {
+		if _, ok := found[entry.kind]; ok {
+			continue
+		}
+		if path, ok := images[videoBase+"-"+entry.name]; ok {
+			found[entry.kind] = LocalArtwork{Kind: entry.kind, Path: filepath.Join(dir, path)}
+		}
+	}
+
+	if countVideos(entries) == 1 {
+		for _, entry := range artworkNames {
+			if _, ok := found[entry.kind]; ok {
+				continue
+			}
+			if path, ok := images[entry.name]; ok {
+				found[entry.kind] = LocalArtwork{Kind: entry.kind, Path: filepath.Join(dir, path)}
+			}
+		}
+	}
+
+	return sortArtwork(found)
+}
+
+// FindSeriesArtwork returns the local artwork in a series folder, including
+// season posters named seasonNN-poster or season-specials-poster. At most
+// one image per kind and season is returned.
+func FindSeriesArtwork(seriesDir string) []LocalArtwork {
+	entries, err := os.ReadDir(seriesDir)
+	if err != nil {
+		return nil
+	}
+
+	images := imageFiles(entries)
+	found := map[string]LocalArtwork{}
+	for _, entry := range artworkNames {
+		if _, ok := found[entry.kind]; ok {
+			continue
+		}
+		if path, ok := images[entry.name]; ok {
+			found[entry.kind] = LocalArtwork{Kind: entry.kind, Path: filepath.Join(seriesDir, path)}
+		}
+	}
+
+	for base, path := range images {
+		m := seasonPosterPattern.FindStringSubmatch(base)
+		if m == nil {
+			continue
+		}
+		season := 0
+		if m[1] != "-specials" {
+			season, _ = strconv.Atoi(m[1])
+		}
+		found["season:"+strconv.Itoa(season)] = LocalArtwork{
+			Kind:         ArtworkPoster,
+			Path:         filepath.Join(seriesDir, path),
+			SeasonNumber: &season,
+		}
+	}
+
+	return sortArtwork(found)
+}
+
+// SeriesDir returns the series folder for an episode file, skipping a
+// "Season NN" or "Specials" subfolder.
+func SeriesDir(episodePath string) string {
+	dir := filepath.Dir(episodePath)
+	if seasonFolderPattern.MatchString(filepath.Base(dir)) {
+		return filepath.Dir(dir)
+	}
+	return dir
+}
+
+// AlbumDir returns the album folder for a track file, skipping a "CD 2" or
+// "Disc 2" subfolder.
+func AlbumDir(trackPath string) string {
+	dir := filepath.Dir(trackPath)
+	if discFolderPattern.MatchString(filepath.Base(dir)) {
+		return filepath.Dir(dir)
+	}
+	return dir
+}
+
+// FindAlbumCover returns the cover image of the album a track belongs to,
+// looking in the track's folder first and then in the album folder. It
+// returns "" when there is none.
+func FindAlbumCover(trackPath string) string {
+	dirs := []string{filepath.Dir(trackPath)}
+	if albumDir := AlbumDir(trackPath); albumDir != dirs[0] {
+		dirs = append(dirs, albumDir)
+	}
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		images := imageFiles(entries)
+		for _, name := range albumCoverNames {
+			if path, ok := images[name]; ok {
+				return filepath.Join(dir, path)
+			}
+		}
+	}
+	return ""
+}
+
+// imageFiles maps lower-case base names without extension to the file
+// names of the images in a directory.
+func imageFiles(entries []os.DirEntry) map[string]string {
+	images := make(map[string]string)
+	for _, e := range entries {
+		if e.IsDir() || !IsImageFile(e.Name()) {
+			continue
+		}
+		base := strings.ToLower(strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())))
+		if _, ok := images[base]; !ok {
+			images[base] = e.Name()
+		}
+	}
+	return images
+}
+
+func countVideos(entries []os.DirEntry) int {
+	n := 0
+	for _, e := range entries {
+		if !e.IsDir() && IsVideoFile(e.Name()) {
+			n++
+		}
+	}
+	return n
+}
+
+// sortArtwork returns the artwork ordered by path for stable results.
+func sortArtwork(found map[string]LocalArtwork) []LocalArtwork {
+	result := make([]LocalArtwork, 0, len(found))
+	for _, art := range found {
+		result = append(result, art)
+	}
+	slices.SortFunc(result, func(a, b LocalArtwork) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return result
+}
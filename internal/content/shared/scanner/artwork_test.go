@@ -0,0 +1,96 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func touch(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0o644))
+	}
+}
+
+func TestFindMovieArtwork(t *testing.T) {
+	t.Parallel()
+
+	t.Run("movie folder", func(t *testing.T) {
+		dir := t.TempDir()
+		touch(t, dir, "The Matrix (1999).mkv", "folder.jpg", "poster.jpg", "fanart.png", "clearart.png", "notes.txt")
+
+		art := FindMovieArtwork(filepath.Join(dir, "The Matrix (1999).mkv"))
+		assert.Equal(t, []LocalArtwork{
+			{Kind: ArtworkClearArt, Path: filepath.Join(dir, "clearart.png")},
+			{Kind: ArtworkBackdrop, Path: filepath.Join(dir, "fanart.png")},
+			{Kind: ArtworkPoster, Path: filepath.Join(dir, "poster.jpg")},
+		}, art)
+	})
+
+	t.Run("per-file names win", func(t *testing.T) {
+		dir := t.TempDir()
+		touch(t, dir, "Heat.mkv", "poster.jpg", "Heat-poster.JPG")
+
+		art := FindMovieArtwork(filepath.Join(dir, "Heat.mkv"))
+		assert.Equal(t, []LocalArtwork{{Kind: ArtworkPoster, Path: filepath.Join(dir, "Heat-poster.JPG")}}, art)
+	})
+
+	t.Run("flat folder ignores folder-level names", func(t *testing.T) {
+		dir := t.TempDir()
+		touch(t, dir, "Heat.mkv", "Ronin.mkv", "poster.jpg", "Ronin-fanart.jpg")
+
+		assert.Empty(t, FindMovieArtwork(filepath.Join(dir, "Heat.mkv")))
+		assert.Equal(t, []LocalArtwork{{Kind: ArtworkBackdrop, Path: filepath.Join(dir, "Ronin-fanart.jpg")}},
+			FindMovieArtwork(filepath.Join(dir, "Ronin.mkv")))
+	})
+}
+
+func TestFindSeriesArtwork(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	touch(t, dir, "poster.jpg", "logo.png", "season01-poster.jpg", "season-specials-poster.jpg", "season02-banner.jpg")
+
+	art := FindSeriesArtwork(dir)
+	require.Len(t, art, 4)
+
+	bySeason := map[int]string{}
+	for _, a := range art {
+		if a.SeasonNumber != nil {
+			assert.Equal(t, ArtworkPoster, a.Kind)
+			bySeason[*a.SeasonNumber] = filepath.Base(a.Path)
+		}
+	}
+	assert.Equal(t, map[int]string{0: "season-specials-poster.jpg", 1: "season01-poster.jpg"}, bySeason)
+}
+
+func TestSeriesDir(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "/tv/Show", SeriesDir("/tv/Show/Season 01/Show S01E01.mkv"))
+	assert.Equal(t, "/tv/Show", SeriesDir("/tv/Show/Specials/Show S00E01.mkv"))
+	assert.Equal(t, "/tv/Show", SeriesDir("/tv/Show/Show S01E01.mkv"))
+}
+
+func TestFindAlbumCover(t *testing.T) {
+	t.Parallel()
+
+	album := t.TempDir()
+	disc := filepath.Join(album, "CD 2")
+	require.NoError(t, os.Mkdir(disc, 0o755))
+	touch(t, album, "01 Intro.flac", "Folder.jpg", "back.jpg")
+	touch(t, disc, "01 Outro.flac")
+
+	assert.Equal(t, filepath.Join(album, "Folder.jpg"), FindAlbumCover(filepath.Join(album, "01 Intro.flac")))
+	assert.Equal(t, filepath.Join(album, "Folder.jpg"), FindAlbumCover(filepath.Join(disc, "01 Outro.flac")))
+
+	touch(t, disc, "cover.png")
+	assert.Equal(t, filepath.Join(disc, "cover.png"), FindAlbumCover(filepath.Join(disc, "01 Outro.flac")))
+
+	assert.Empty(t, FindAlbumCover(filepath.Join(t.TempDir(), "track.mp3")))
+	assert.Equal(t, album, AlbumDir(filepath.Join(disc, "01 Outro.flac")))
+}
@@ -41,6 +41,33 @@ var AudioExtensions = map[string]bool{
 	".dff":  true, // DSD
 }
 
+// AudiobookExtensions contains the audio extensions of audiobook files,
+// including the chapterized M4B container.
+var AudiobookExtensions = map[string]bool{
+	".mp3":  true,
+	".m4b":  true,
+	".m4a":  true,
+	".aac":  true,
+	".ogg":  true,
+	".opus": true,
+	".flac": true,
+	".wma":  true,
+}
+
+// BookExtensions contains e-book file extensions.
+var BookExtensions = map[string]bool{
+	".epub": true,
+	".pdf":  true,
+}
+
+// ComicExtensions contains comic archive extensions. PDFs are common for
+// comics as well.
+var ComicExtensions = map[string]bool{
+	".cbz": true,
+	".cbr": true,
+	".pdf": true,
+}
+
 // ImageExtensions contains common image extensions for artwork and photos.
 var ImageExtensions = map[string]bool{
 	".jpg":  true,
@@ -50,9 +77,43 @@ var ImageExtensions = map[string]bool{
 	".webp": true,
 	".bmp":  true,
 	".tiff": true,
+	".tif":  true,
 	".svg":  true,
 }
 
+// PhotoExtensions contains the extensions of photo library images: the
+// raster formats of ImageExtensions plus the formats cameras and phones
+// write, HEIF and raw files.
+var PhotoExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+	".webp": true,
+	".bmp":  true,
+	".tiff": true,
+	".tif":  true,
+	".heic": true,
+	".heif": true,
+	".avif": true,
+	".dng":  true, // Adobe raw
+	".nef":  true, // Nikon raw
+	".cr2":  true, // Canon raw
+	".arw":  true, // Sony raw
+}
+
+// ClipExtensions contains the extensions of short videos recorded by
+// cameras and phones, kept alongside photos.
+var ClipExtensions = map[string]bool{
+	".mp4":  true,
+	".mov":  true,
+	".m4v":  true,
+	".3gp":  true,
+	".mts":  true, // AVCHD
+	".m2ts": true, // AVCHD
+	".avi":  true,
+}
+
 // SubtitleExtensions contains subtitle file extensions.
 var SubtitleExtensions = map[string]bool{
 	".srt": true,
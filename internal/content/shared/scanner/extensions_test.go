@@ -86,6 +86,15 @@ func TestIsImageFile(t *testing.T) {
 	}
 }
 
+func TestPhotoExtensions(t *testing.T) {
+	for _, ext := range []string{".jpg", ".heic", ".avif", ".tif", ".dng"} {
+		assert.True(t, PhotoExtensions[ext], "Missing photo extension: %s", ext)
+	}
+	assert.False(t, PhotoExtensions[".svg"], "vector artwork is not a photo")
+	assert.True(t, ClipExtensions[".mov"])
+	assert.True(t, ClipExtensions[".mts"])
+}
+
 func TestIsSubtitleFile(t *testing.T) {
 	tests := []struct {
 		filename string
@@ -184,3 +193,20 @@ func TestAudioExtensionsCompleteness(t *testing.T) {
 		assert.True(t, AudioExtensions[ext], "Missing audio extension: %s", ext)
 	}
 }
+
+func TestAudiobookExtensionsCompleteness(t *testing.T) {
+	expectedFormats := []string{".mp3", ".m4b", ".m4a", ".ogg", ".opus", ".flac"}
+
+	for _, ext := range expectedFormats {
+		assert.True(t, AudiobookExtensions[ext], "Missing audiobook extension: %s", ext)
+	}
+}
+
+func TestBookExtensionsCompleteness(t *testing.T) {
+	for _, ext := range []string{".epub", ".pdf"} {
+		assert.True(t, BookExtensions[ext], "Missing book extension: %s", ext)
+	}
+	for _, ext := range []string{".cbz", ".cbr", ".pdf"} {
+		assert.True(t, ComicExtensions[ext], "Missing comic extension: %s", ext)
+	}
+}
@@ -0,0 +1,100 @@
+package scanner
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Extra types recognized next to movie files.
+const (
+	ExtraTrailer         = "trailer"
+	ExtraFeaturette      = "featurette"
+	ExtraBehindTheScenes = "behind_the_scenes"
+	ExtraDeletedScene    = "deleted_scene"
+	ExtraInterview       = "interview"
+	ExtraScene           = "scene"
+	ExtraShort           = "short"
+	ExtraOther           = "other"
+)
+
+// ExtraTypes lists all extra types.
+var ExtraTypes = []string{
+	ExtraTrailer, ExtraFeaturette, ExtraBehindTheScenes, ExtraDeletedScene,
+	ExtraInterview, ExtraScene, ExtraShort, ExtraOther,
+}
+
+// extraFolders maps lower-cased folder names to extra types.
+var extraFolders = map[string]string{
+	"trailers":          ExtraTrailer,
+	"featurettes":       ExtraFeaturette,
+	"behind the scenes": ExtraBehindTheScenes,
+	"deleted scenes":    ExtraDeletedScene,
+	"interviews":        ExtraInterview,
+	"scenes":            ExtraScene,
+	"shorts":            ExtraShort,
+	"extras":            ExtraOther,
+	"other":             ExtraOther,
+}
+
+// extraSuffixes maps lower-cased file name suffixes to extra types.
+var extraSuffixes = []struct {
+	suffix    string
+	extraType string
+}{
+	{"-trailer", ExtraTrailer},
+	{"-featurette", ExtraFeaturette},
+	{"-behindthescenes", ExtraBehindTheScenes},
+	{"-deleted", ExtraDeletedScene},
+	{"-interview", ExtraInterview},
+	{"-scene", ExtraScene},
+	{"-short", ExtraShort},
+	{"-other", ExtraOther},
+}
+
+var editionPattern = regexp.MustCompile(`(?i)\s*\{edition-([^}]+)\}`)
+
+// Extra is a video file classified as an extra rather than a main feature.
+type Extra struct {
+	Type string
+	// Title is the file name without extension and type suffix.
+	Title string
+	// MovieDir is the folder of the movie the extra belongs to.
+	MovieDir string
+}
+
+// DetectExtra reports whether a video file is an extra. Files inside an
+// extras folder (Trailers/, Featurettes/, Extras/, ...) take the folder's
+// type; files next to the movie are recognized by a suffix such as
+// "-trailer" or "-deleted".
+func DetectExtra(path string) (Extra, bool) {
+	dir := filepath.Dir(path)
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	if extraType, ok := extraFolders[strings.ToLower(filepath.Base(dir))]; ok {
+		return Extra{Type: extraType, Title: name, MovieDir: filepath.Dir(dir)}, true
+	}
+
+	lower := strings.ToLower(name)
+	for _, s := range extraSuffixes {
+		if strings.HasSuffix(lower, s.suffix) && len(name) > len(s.suffix) {
+			return Extra{
+				Type:     s.extraType,
+				Title:    strings.TrimSpace(name[:len(name)-len(s.suffix)]),
+				MovieDir: dir,
+			}, true
+		}
+	}
+	return Extra{}, false
+}
+
+// ParseEdition extracts the edition from a file name using the
+// "{edition-Director's Cut}" convention. It returns the name with the tag
+// removed and the edition, which is empty when there is none.
+func ParseEdition(filename string) (name, edition string) {
+	matches := editionPattern.FindStringSubmatch(filename)
+	if matches == nil {
+		return filename, ""
+	}
+	return editionPattern.ReplaceAllString(filename, ""), strings.TrimSpace(matches[1])
+}
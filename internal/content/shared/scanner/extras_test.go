@@ -0,0 +1,84 @@
+package scanner
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectExtra(t *testing.T) {
+	t.Parallel()
+
+	movieDir := filepath.Join("/movies", "Heat (1995)")
+
+	tests := []struct {
+		name  string
+		path  string
+		want  Extra
+		extra bool
+	}{
+		{
+			name:  "trailers folder",
+			path:  filepath.Join(movieDir, "Trailers", "Teaser.mkv"),
+			want:  Extra{Type: ExtraTrailer, Title: "Teaser", MovieDir: movieDir},
+			extra: true,
+		},
+		{
+			name:  "behind the scenes folder",
+			path:  filepath.Join(movieDir, "Behind The Scenes", "Making Of.mp4"),
+			want:  Extra{Type: ExtraBehindTheScenes, Title: "Making Of", MovieDir: movieDir},
+			extra: true,
+		},
+		{
+			name:  "extras folder",
+			path:  filepath.Join(movieDir, "extras", "Gag Reel.mkv"),
+			want:  Extra{Type: ExtraOther, Title: "Gag Reel", MovieDir: movieDir},
+			extra: true,
+		},
+		{
+			name:  "suffix next to movie",
+			path:  filepath.Join(movieDir, "Bank Job-deleted.mkv"),
+			want:  Extra{Type: ExtraDeletedScene, Title: "Bank Job", MovieDir: movieDir},
+			extra: true,
+		},
+		{
+			name:  "suffix is case-insensitive",
+			path:  filepath.Join(movieDir, "Heat-Trailer.mp4"),
+			want:  Extra{Type: ExtraTrailer, Title: "Heat", MovieDir: movieDir},
+			extra: true,
+		},
+		{
+			name: "main feature",
+			path: filepath.Join(movieDir, "Heat (1995).mkv"),
+		},
+		{
+			name: "bare suffix is not an extra",
+			path: filepath.Join(movieDir, "-trailer.mkv"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DetectExtra(tt.path)
+			assert.Equal(t, tt.extra, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseEdition(t *testing.T) {
+	t.Parallel()
+
+	name, edition := ParseEdition("Blade Runner (1982) {edition-Final Cut}.mkv")
+	assert.Equal(t, "Blade Runner (1982).mkv", name)
+	assert.Equal(t, "Final Cut", edition)
+
+	name, edition = ParseEdition("Aliens {Edition-Director's Cut} (1986).mkv")
+	assert.Equal(t, "Aliens (1986).mkv", name)
+	assert.Equal(t, "Director's Cut", edition)
+
+	name, edition = ParseEdition("Heat (1995).mkv")
+	assert.Equal(t, "Heat (1995).mkv", name)
+	assert.Empty(t, edition)
+}
@@ -311,3 +311,12 @@ func TestExtractSource(t *testing.T) {
 		})
 	}
 }
+
+func TestNaturalCompare(t *testing.T) {
+	assert.Negative(t, NaturalCompare("Part 2.mp3", "Part 10.mp3"))
+	assert.Positive(t, NaturalCompare("Part 10.mp3", "Part 9.mp3"))
+	assert.Negative(t, NaturalCompare("chapter a", "Chapter B"))
+	assert.Zero(t, NaturalCompare("Disc 1", "Disc 01"))
+	assert.Negative(t, NaturalCompare("Book", "Book 1"))
+	assert.Negative(t, NaturalCompare("page9.jpg", "page10.jpg"))
+}
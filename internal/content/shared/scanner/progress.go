@@ -0,0 +1,21 @@
+package scanner
+
+// Scan phases reported through a ProgressFunc.
+const (
+	PhaseDiscovering = "discovering"
+	PhaseMatching    = "matching"
+)
+
+// Progress is a snapshot of a running library scan.
+type Progress struct {
+	Phase          string
+	FilesTotal     int
+	FilesProcessed int
+	FilesMatched   int
+	// CurrentPath is the file being processed.
+	CurrentPath string
+}
+
+// ProgressFunc receives progress updates while a scan runs. It is called on
+// the scanning goroutine and should return quickly.
+type ProgressFunc func(Progress)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // CachedService wraps the TV show service with caching.
@@ -44,16 +45,24 @@ func (s *CachedService) GetSeries(ctx context.Context, id uuid.UUID) (*Series, e
 
 // ListSeries returns a paginated list of series with caching (1 min TTL).
 func (s *CachedService) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error) {
+	// Lists restricted by parental controls differ per user and bypass the cache.
+	if content.AccessFilterFromContext(ctx) != nil {
+		return s.Service.ListSeries(ctx, filters)
+	}
 	return cache.Get(ctx, s.cache, s.listSeriesCacheKey(filters), time.Minute, func(ctx context.Context) ([]Series, error) {
 		return s.Service.ListSeries(ctx, filters)
 	})
 }
 
 // ListRecentlyAdded returns recently added series with caching (2 min TTL).
-func (s *CachedService) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Series, int64, error) {
+// Lists restricted to library paths are not cached.
+func (s *CachedService) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Series, int64, error) {
+	if content.AccessFilterFromContext(ctx) != nil || libraryPaths != nil {
+		return s.Service.ListRecentlyAdded(ctx, libraryPaths, limit, offset)
+	}
 	key := fmt.Sprintf("%srecently-added:%d:%d", cache.KeyPrefixTVShow, limit, offset)
 	result, err := cache.Get(ctx, s.cache, key, cache.RecentlyAddedTTL, func(ctx context.Context) (cache.Pair[[]Series], error) {
-		items, total, err := s.Service.ListRecentlyAdded(ctx, limit, offset)
+		items, total, err := s.Service.ListRecentlyAdded(ctx, nil, limit, offset)
 		return cache.Pair[[]Series]{Items: items, Total: total}, err
 	})
 	if err != nil {
@@ -117,6 +126,13 @@ func (s *CachedService) GetSeriesGenres(ctx context.Context, seriesID uuid.UUID)
 	})
 }
 
+// GetSeriesTags returns keywords and tags for a series with caching (10 min TTL).
+func (s *CachedService) GetSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]SeriesTag, error) {
+	return cache.Get(ctx, s.cache, cache.TVShowTagsKey(seriesID.String()), cache.TVShowMetaTTL, func(ctx context.Context) ([]SeriesTag, error) {
+		return s.Service.GetSeriesTags(ctx, seriesID)
+	})
+}
+
 // GetSeriesNetworks returns networks for a series with caching (10 min TTL).
 func (s *CachedService) GetSeriesNetworks(ctx context.Context, seriesID uuid.UUID) ([]Network, error) {
 	return cache.Get(ctx, s.cache, cache.TVShowNetworksKey(seriesID.String()), cache.TVShowMetaTTL, func(ctx context.Context) ([]Network, error) {
@@ -129,10 +145,14 @@ func (s *CachedService) GetSeriesNetworks(ctx context.Context, seriesID uuid.UUI
 // =============================================================================
 
 // GetContinueWatching returns series the user is currently watching with caching (1 min TTL).
-func (s *CachedService) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+// Lists restricted to library paths are not cached.
+func (s *CachedService) GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	if content.AccessFilterFromContext(ctx) != nil || libraryPaths != nil {
+		return s.Service.GetContinueWatching(ctx, userID, libraryPaths, limit)
+	}
 	key := fmt.Sprintf("%scontinue-watching:%s:%d", cache.KeyPrefixTVShow, userID.String(), limit)
 	return cache.Get(ctx, s.cache, key, cache.ContinueWatchingTTL, func(ctx context.Context) ([]ContinueWatchingItem, error) {
-		return s.Service.GetContinueWatching(ctx, userID, limit)
+		return s.Service.GetContinueWatching(ctx, userID, nil, limit)
 	})
 }
 
@@ -154,6 +174,177 @@ func (s *CachedService) UpdateSeries(ctx context.Context, params UpdateSeriesPar
 	return result, nil
 }
 
+// UpdateSeriesMetadata applies a manual series edit and invalidates cache.
+func (s *CachedService) UpdateSeriesMetadata(ctx context.Context, id uuid.UUID, edit SeriesMetadataEdit) (*Series, error) {
+	result, err := s.Service.UpdateSeriesMetadata(ctx, id, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, id)
+	}
+
+	return result, nil
+}
+
+// UpdateSeasonMetadata applies a manual season edit and invalidates cache.
+func (s *CachedService) UpdateSeasonMetadata(ctx context.Context, id uuid.UUID, edit SeasonMetadataEdit) (*Season, error) {
+	result, err := s.Service.UpdateSeasonMetadata(ctx, id, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, result.SeriesID)
+	}
+
+	return result, nil
+}
+
+// UpdateEpisodeMetadata applies a manual episode edit and invalidates cache.
+func (s *CachedService) UpdateEpisodeMetadata(ctx context.Context, id uuid.UUID, edit EpisodeMetadataEdit) (*Episode, error) {
+	result, err := s.Service.UpdateEpisodeMetadata(ctx, id, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, result.SeriesID)
+	}
+
+	return result, nil
+}
+
+// SetSeriesImagePlaceholder stores an image placeholder and invalidates cache.
+func (s *CachedService) SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	if err := s.Service.SetSeriesImagePlaceholder(ctx, id, path, placeholder); err != nil {
+		return err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, id)
+	}
+
+	return nil
+}
+
+// SetSeasonImagePlaceholder stores an image placeholder and invalidates the
+// cache of the season's series.
+func (s *CachedService) SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	if err := s.Service.SetSeasonImagePlaceholder(ctx, id, path, placeholder); err != nil {
+		return err
+	}
+
+	if s.cache != nil {
+		if season, err := s.Service.GetSeason(ctx, id); err == nil {
+			s.invalidateSeries(ctx, season.SeriesID)
+		}
+	}
+
+	return nil
+}
+
+// SetEpisodeImagePlaceholder stores an image placeholder and invalidates the
+// cache of the episode's series.
+func (s *CachedService) SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	if err := s.Service.SetEpisodeImagePlaceholder(ctx, id, path, placeholder); err != nil {
+		return err
+	}
+
+	if s.cache != nil {
+		if episode, err := s.Service.GetEpisode(ctx, id); err == nil {
+			s.invalidateSeries(ctx, episode.SeriesID)
+		}
+	}
+
+	return nil
+}
+
+// UnlockSeriesFields clears series field locks and invalidates cache.
+func (s *CachedService) UnlockSeriesFields(ctx context.Context, id uuid.UUID, fields ...string) (*Series, error) {
+	result, err := s.Service.UnlockSeriesFields(ctx, id, fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, id)
+	}
+
+	return result, nil
+}
+
+// UnlockSeasonFields clears season field locks and invalidates cache.
+func (s *CachedService) UnlockSeasonFields(ctx context.Context, id uuid.UUID, fields ...string) (*Season, error) {
+	result, err := s.Service.UnlockSeasonFields(ctx, id, fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, result.SeriesID)
+	}
+
+	return result, nil
+}
+
+// UnlockEpisodeFields clears episode field locks and invalidates cache.
+func (s *CachedService) UnlockEpisodeFields(ctx context.Context, id uuid.UUID, fields ...string) (*Episode, error) {
+	result, err := s.Service.UnlockEpisodeFields(ctx, id, fields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, result.SeriesID)
+	}
+
+	return result, nil
+}
+
+// RestoreSeriesMetadata restores previous series field values and invalidates cache.
+func (s *CachedService) RestoreSeriesMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Series, error) {
+	result, err := s.Service.RestoreSeriesMetadata(ctx, id, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, id)
+	}
+
+	return result, nil
+}
+
+// RestoreSeasonMetadata restores previous season field values and invalidates cache.
+func (s *CachedService) RestoreSeasonMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Season, error) {
+	result, err := s.Service.RestoreSeasonMetadata(ctx, id, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, result.SeriesID)
+	}
+
+	return result, nil
+}
+
+// RestoreEpisodeMetadata restores previous episode field values and invalidates cache.
+func (s *CachedService) RestoreEpisodeMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Episode, error) {
+	result, err := s.Service.RestoreEpisodeMetadata(ctx, id, values)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		s.invalidateSeries(ctx, result.SeriesID)
+	}
+
+	return result, nil
+}
+
 // DeleteSeries deletes a series and invalidates cache.
 func (s *CachedService) DeleteSeries(ctx context.Context, id uuid.UUID) error {
 	if err := s.Service.DeleteSeries(ctx, id); err != nil {
@@ -276,6 +467,7 @@ func (s *CachedService) invalidateSeries(ctx context.Context, seriesID uuid.UUID
 		cache.TVShowCastKey(id),
 		cache.TVShowCrewKey(id),
 		cache.TVShowGenresKey(id),
+		cache.TVShowTagsKey(id),
 		cache.TVShowNetworksKey(id),
 		cache.TVShowSeasonsKey(id),
 	}
@@ -42,6 +42,54 @@ func (q *Queries) CountSeriesCrew(ctx context.Context, seriesID uuid.UUID) (int6
 }
 
 const createEpisodeCredit = `-- name: CreateEpisodeCredit :one
+WITH ids AS (
+    SELECT
+        NULLIF($2::integer, 0) AS tmdb_id,
+        $10::integer AS tvdb_id,
+        NULLIF($11::text, '') AS imdb_id
+),
+existing AS (
+    SELECT p.id
+    FROM public.people p, ids
+    WHERE
+        p.tmdb_id = ids.tmdb_id
+        OR p.tvdb_id = ids.tvdb_id
+        OR p.imdb_id = ids.imdb_id
+    ORDER BY
+        (p.tmdb_id = ids.tmdb_id) IS TRUE DESC,
+        (p.tvdb_id = ids.tvdb_id) IS TRUE DESC
+    LIMIT 1
+),
+linked AS (
+    UPDATE public.people p
+    SET
+        tmdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tmdb_id = ids.tmdb_id) THEN p.tmdb_id
+            ELSE COALESCE(p.tmdb_id, ids.tmdb_id)
+        END,
+        tvdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tvdb_id = ids.tvdb_id) THEN p.tvdb_id
+            ELSE COALESCE(p.tvdb_id, ids.tvdb_id)
+        END,
+        imdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.imdb_id = ids.imdb_id) THEN p.imdb_id
+            ELSE COALESCE(p.imdb_id, ids.imdb_id)
+        END,
+        profile_path = COALESCE(p.profile_path, $9::text)
+    FROM existing, ids
+    WHERE p.id = existing.id
+),
+created AS (
+    INSERT INTO
+        public.people (tmdb_id, tvdb_id, imdb_id, name, profile_path)
+    SELECT ids.tmdb_id, ids.tvdb_id, ids.imdb_id, $3::text, $9::text
+    FROM ids
+    WHERE
+        NOT EXISTS (SELECT 1 FROM existing)
+        AND (ids.tmdb_id IS NOT NULL OR ids.tvdb_id IS NOT NULL OR ids.imdb_id IS NOT NULL)
+    ON CONFLICT DO NOTHING
+    RETURNING id
+)
 INSERT INTO
     tvshow.episode_credits (
         episode_id,
@@ -52,7 +100,8 @@ INSERT INTO
         cast_order,
         job,
         department,
-        profile_path
+        profile_path,
+        person_id
     )
 VALUES (
         $1,
@@ -63,8 +112,9 @@ VALUES (
         $6,
         $7,
         $8,
-        $9
-    ) RETURNING id, episode_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at
+        $9,
+        COALESCE((SELECT id FROM existing), (SELECT id FROM created))
+    ) RETURNING id, episode_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at, person_id
 `
 
 type CreateEpisodeCreditParams struct {
@@ -77,8 +127,14 @@ type CreateEpisodeCreditParams struct {
 	Job          *string   `json:"job"`
 	Department   *string   `json:"department"`
 	ProfilePath  *string   `json:"profilePath"`
+	TvdbPersonID *int32    `json:"tvdbPersonId"`
+	ImdbPersonID *string   `json:"imdbPersonId"`
 }
 
+// Creates a credit and links it to the person with the same TMDb, TVDb or
+// IMDb ID, creating the person if none matches. Provider IDs the person is
+// missing are filled in unless another person already has them. Credits
+// without any provider ID are not linked.
 func (q *Queries) CreateEpisodeCredit(ctx context.Context, arg CreateEpisodeCreditParams) (TvshowEpisodeCredit, error) {
 	row := q.db.QueryRow(ctx, createEpisodeCredit,
 		arg.EpisodeID,
@@ -90,6 +146,8 @@ func (q *Queries) CreateEpisodeCredit(ctx context.Context, arg CreateEpisodeCred
 		arg.Job,
 		arg.Department,
 		arg.ProfilePath,
+		arg.TvdbPersonID,
+		arg.ImdbPersonID,
 	)
 	var i TvshowEpisodeCredit
 	err := row.Scan(
@@ -105,11 +163,60 @@ func (q *Queries) CreateEpisodeCredit(ctx context.Context, arg CreateEpisodeCred
 		&i.ProfilePath,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PersonID,
 	)
 	return i, err
 }
 
 const createSeriesCredit = `-- name: CreateSeriesCredit :one
+WITH ids AS (
+    SELECT
+        NULLIF($2::integer, 0) AS tmdb_id,
+        $10::integer AS tvdb_id,
+        NULLIF($11::text, '') AS imdb_id
+),
+existing AS (
+    SELECT p.id
+    FROM public.people p, ids
+    WHERE
+        p.tmdb_id = ids.tmdb_id
+        OR p.tvdb_id = ids.tvdb_id
+        OR p.imdb_id = ids.imdb_id
+    ORDER BY
+        (p.tmdb_id = ids.tmdb_id) IS TRUE DESC,
+        (p.tvdb_id = ids.tvdb_id) IS TRUE DESC
+    LIMIT 1
+),
+linked AS (
+    UPDATE public.people p
+    SET
+        tmdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tmdb_id = ids.tmdb_id) THEN p.tmdb_id
+            ELSE COALESCE(p.tmdb_id, ids.tmdb_id)
+        END,
+        tvdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tvdb_id = ids.tvdb_id) THEN p.tvdb_id
+            ELSE COALESCE(p.tvdb_id, ids.tvdb_id)
+        END,
+        imdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.imdb_id = ids.imdb_id) THEN p.imdb_id
+            ELSE COALESCE(p.imdb_id, ids.imdb_id)
+        END,
+        profile_path = COALESCE(p.profile_path, $9::text)
+    FROM existing, ids
+    WHERE p.id = existing.id
+),
+created AS (
+    INSERT INTO
+        public.people (tmdb_id, tvdb_id, imdb_id, name, profile_path)
+    SELECT ids.tmdb_id, ids.tvdb_id, ids.imdb_id, $3::text, $9::text
+    FROM ids
+    WHERE
+        NOT EXISTS (SELECT 1 FROM existing)
+        AND (ids.tmdb_id IS NOT NULL OR ids.tvdb_id IS NOT NULL OR ids.imdb_id IS NOT NULL)
+    ON CONFLICT DO NOTHING
+    RETURNING id
+)
 INSERT INTO
     tvshow.series_credits (
         series_id,
@@ -120,7 +227,8 @@ INSERT INTO
         cast_order,
         job,
         department,
-        profile_path
+        profile_path,
+        person_id
     )
 VALUES (
         $1,
@@ -131,8 +239,9 @@ VALUES (
         $6,
         $7,
         $8,
-        $9
-    ) RETURNING id, series_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at
+        $9,
+        COALESCE((SELECT id FROM existing), (SELECT id FROM created))
+    ) RETURNING id, series_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at, person_id
 `
 
 type CreateSeriesCreditParams struct {
@@ -145,8 +254,14 @@ type CreateSeriesCreditParams struct {
 	Job          *string   `json:"job"`
 	Department   *string   `json:"department"`
 	ProfilePath  *string   `json:"profilePath"`
+	TvdbPersonID *int32    `json:"tvdbPersonId"`
+	ImdbPersonID *string   `json:"imdbPersonId"`
 }
 
+// Creates a credit and links it to the person with the same TMDb, TVDb or
+// IMDb ID, creating the person if none matches. Provider IDs the person is
+// missing are filled in unless another person already has them. Credits
+// without any provider ID are not linked.
 func (q *Queries) CreateSeriesCredit(ctx context.Context, arg CreateSeriesCreditParams) (TvshowSeriesCredit, error) {
 	row := q.db.QueryRow(ctx, createSeriesCredit,
 		arg.SeriesID,
@@ -158,6 +273,8 @@ func (q *Queries) CreateSeriesCredit(ctx context.Context, arg CreateSeriesCredit
 		arg.Job,
 		arg.Department,
 		arg.ProfilePath,
+		arg.TvdbPersonID,
+		arg.ImdbPersonID,
 	)
 	var i TvshowSeriesCredit
 	err := row.Scan(
@@ -173,6 +290,7 @@ func (q *Queries) CreateSeriesCredit(ctx context.Context, arg CreateSeriesCredit
 		&i.ProfilePath,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PersonID,
 	)
 	return i, err
 }
@@ -196,7 +314,7 @@ func (q *Queries) DeleteSeriesCredits(ctx context.Context, seriesID uuid.UUID) e
 }
 
 const listEpisodeCrew = `-- name: ListEpisodeCrew :many
-SELECT id, episode_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at
+SELECT id, episode_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at, person_id
 FROM tvshow.episode_credits
 WHERE
     episode_id = $1
@@ -226,6 +344,7 @@ func (q *Queries) ListEpisodeCrew(ctx context.Context, episodeID uuid.UUID) ([]T
 			&i.ProfilePath,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PersonID,
 		); err != nil {
 			return nil, err
 		}
@@ -239,7 +358,7 @@ func (q *Queries) ListEpisodeCrew(ctx context.Context, episodeID uuid.UUID) ([]T
 
 const listEpisodeGuestStars = `-- name: ListEpisodeGuestStars :many
 
-SELECT id, episode_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at
+SELECT id, episode_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at, person_id
 FROM tvshow.episode_credits
 WHERE
     episode_id = $1
@@ -270,6 +389,7 @@ func (q *Queries) ListEpisodeGuestStars(ctx context.Context, episodeID uuid.UUID
 			&i.ProfilePath,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PersonID,
 		); err != nil {
 			return nil, err
 		}
@@ -283,7 +403,7 @@ func (q *Queries) ListEpisodeGuestStars(ctx context.Context, episodeID uuid.UUID
 
 const listSeriesCast = `-- name: ListSeriesCast :many
 
-SELECT id, series_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at
+SELECT id, series_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at, person_id
 FROM tvshow.series_credits
 WHERE
     series_id = $1
@@ -323,6 +443,7 @@ func (q *Queries) ListSeriesCast(ctx context.Context, arg ListSeriesCastParams)
 			&i.ProfilePath,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PersonID,
 		); err != nil {
 			return nil, err
 		}
@@ -335,7 +456,7 @@ func (q *Queries) ListSeriesCast(ctx context.Context, arg ListSeriesCastParams)
 }
 
 const listSeriesCrew = `-- name: ListSeriesCrew :many
-SELECT id, series_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at
+SELECT id, series_id, tmdb_person_id, name, credit_type, character, cast_order, job, department, profile_path, created_at, updated_at, person_id
 FROM tvshow.series_credits
 WHERE
     series_id = $1
@@ -374,6 +495,7 @@ func (q *Queries) ListSeriesCrew(ctx context.Context, arg ListSeriesCrewParams)
 			&i.ProfilePath,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.PersonID,
 		); err != nil {
 			return nil, err
 		}
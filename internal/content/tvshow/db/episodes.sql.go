@@ -54,7 +54,7 @@ INSERT INTO tvshow.episodes (
     $14, $15,
     $16, $17
 )
-RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at
+RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
 `
 
 type CreateEpisodeParams struct {
@@ -119,6 +119,9 @@ func (q *Queries) CreateEpisode(ctx context.Context, arg CreateEpisodeParams) (T
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -151,7 +154,7 @@ func (q *Queries) DeleteEpisodesBySeries(ctx context.Context, seriesID uuid.UUID
 }
 
 const getEpisode = `-- name: GetEpisode :one
-SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at FROM tvshow.episodes WHERE id = $1
+SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.episodes WHERE id = $1
 `
 
 func (q *Queries) GetEpisode(ctx context.Context, id uuid.UUID) (TvshowEpisode, error) {
@@ -178,12 +181,15 @@ func (q *Queries) GetEpisode(ctx context.Context, id uuid.UUID) (TvshowEpisode,
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
 
 const getEpisodeByNumber = `-- name: GetEpisodeByNumber :one
-SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at FROM tvshow.episodes
+SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.episodes
 WHERE series_id = $1 AND season_number = $2 AND episode_number = $3
 `
 
@@ -217,12 +223,15 @@ func (q *Queries) GetEpisodeByNumber(ctx context.Context, arg GetEpisodeByNumber
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
 
 const getEpisodeByTMDbID = `-- name: GetEpisodeByTMDbID :one
-SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at FROM tvshow.episodes WHERE tmdb_id = $1
+SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.episodes WHERE tmdb_id = $1
 `
 
 func (q *Queries) GetEpisodeByTMDbID(ctx context.Context, tmdbID *int32) (TvshowEpisode, error) {
@@ -249,12 +258,15 @@ func (q *Queries) GetEpisodeByTMDbID(ctx context.Context, tmdbID *int32) (Tvshow
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
 
 const listEpisodesBySeason = `-- name: ListEpisodesBySeason :many
-SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at FROM tvshow.episodes
+SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.episodes
 WHERE season_id = $1
 ORDER BY episode_number ASC
 `
@@ -289,6 +301,9 @@ func (q *Queries) ListEpisodesBySeason(ctx context.Context, seasonID uuid.UUID)
 			&i.ProductionCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 		); err != nil {
 			return nil, err
 		}
@@ -301,7 +316,7 @@ func (q *Queries) ListEpisodesBySeason(ctx context.Context, seasonID uuid.UUID)
 }
 
 const listEpisodesBySeasonNumber = `-- name: ListEpisodesBySeasonNumber :many
-SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at FROM tvshow.episodes
+SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.episodes
 WHERE series_id = $1 AND season_number = $2
 ORDER BY episode_number ASC
 `
@@ -341,6 +356,9 @@ func (q *Queries) ListEpisodesBySeasonNumber(ctx context.Context, arg ListEpisod
 			&i.ProductionCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 		); err != nil {
 			return nil, err
 		}
@@ -353,7 +371,7 @@ func (q *Queries) ListEpisodesBySeasonNumber(ctx context.Context, arg ListEpisod
 }
 
 const listEpisodesBySeries = `-- name: ListEpisodesBySeries :many
-SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at FROM tvshow.episodes
+SELECT id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.episodes
 WHERE series_id = $1
 ORDER BY season_number ASC, episode_number ASC
 `
@@ -388,6 +406,9 @@ func (q *Queries) ListEpisodesBySeries(ctx context.Context, seriesID uuid.UUID)
 			&i.ProductionCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 		); err != nil {
 			return nil, err
 		}
@@ -400,7 +421,7 @@ func (q *Queries) ListEpisodesBySeries(ctx context.Context, seriesID uuid.UUID)
 }
 
 const listRecentEpisodes = `-- name: ListRecentEpisodes :many
-SELECT e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at, s.title as series_title, s.poster_path as series_poster_path
+SELECT e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at, e.locked_fields, e.metadata_sources, e.image_placeholders, s.title as series_title, s.poster_path as series_poster_path
 FROM tvshow.episodes e
 JOIN tvshow.series s ON e.series_id = s.id
 WHERE e.air_date IS NOT NULL AND e.air_date <= CURRENT_DATE
@@ -414,28 +435,31 @@ type ListRecentEpisodesParams struct {
 }
 
 type ListRecentEpisodesRow struct {
-	ID               uuid.UUID       `json:"id"`
-	SeriesID         uuid.UUID       `json:"seriesId"`
-	SeasonID         uuid.UUID       `json:"seasonId"`
-	TmdbID           *int32          `json:"tmdbId"`
-	TvdbID           *int32          `json:"tvdbId"`
-	ImdbID           *string         `json:"imdbId"`
-	SeasonNumber     int32           `json:"seasonNumber"`
-	EpisodeNumber    int32           `json:"episodeNumber"`
-	Title            string          `json:"title"`
-	Overview         *string         `json:"overview"`
-	TitlesI18n       json.RawMessage `json:"titlesI18n"`
-	OverviewsI18n    json.RawMessage `json:"overviewsI18n"`
-	AirDate          pgtype.Date     `json:"airDate"`
-	Runtime          *int32          `json:"runtime"`
-	VoteAverage      pgtype.Numeric  `json:"voteAverage"`
-	VoteCount        *int32          `json:"voteCount"`
-	StillPath        *string         `json:"stillPath"`
-	ProductionCode   *string         `json:"productionCode"`
-	CreatedAt        time.Time       `json:"createdAt"`
-	UpdatedAt        time.Time       `json:"updatedAt"`
-	SeriesTitle      string          `json:"seriesTitle"`
-	SeriesPosterPath *string         `json:"seriesPosterPath"`
+	ID                uuid.UUID       `json:"id"`
+	SeriesID          uuid.UUID       `json:"seriesId"`
+	SeasonID          uuid.UUID       `json:"seasonId"`
+	TmdbID            *int32          `json:"tmdbId"`
+	TvdbID            *int32          `json:"tvdbId"`
+	ImdbID            *string         `json:"imdbId"`
+	SeasonNumber      int32           `json:"seasonNumber"`
+	EpisodeNumber     int32           `json:"episodeNumber"`
+	Title             string          `json:"title"`
+	Overview          *string         `json:"overview"`
+	TitlesI18n        json.RawMessage `json:"titlesI18n"`
+	OverviewsI18n     json.RawMessage `json:"overviewsI18n"`
+	AirDate           pgtype.Date     `json:"airDate"`
+	Runtime           *int32          `json:"runtime"`
+	VoteAverage       pgtype.Numeric  `json:"voteAverage"`
+	VoteCount         *int32          `json:"voteCount"`
+	StillPath         *string         `json:"stillPath"`
+	ProductionCode    *string         `json:"productionCode"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+	LockedFields      []string        `json:"lockedFields"`
+	MetadataSources   json.RawMessage `json:"metadataSources"`
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
+	SeriesTitle       string          `json:"seriesTitle"`
+	SeriesPosterPath  *string         `json:"seriesPosterPath"`
 }
 
 func (q *Queries) ListRecentEpisodes(ctx context.Context, arg ListRecentEpisodesParams) ([]ListRecentEpisodesRow, error) {
@@ -468,6 +492,9 @@ func (q *Queries) ListRecentEpisodes(ctx context.Context, arg ListRecentEpisodes
 			&i.ProductionCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 			&i.SeriesTitle,
 			&i.SeriesPosterPath,
 		); err != nil {
@@ -482,7 +509,7 @@ func (q *Queries) ListRecentEpisodes(ctx context.Context, arg ListRecentEpisodes
 }
 
 const listUpcomingEpisodes = `-- name: ListUpcomingEpisodes :many
-SELECT e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at, s.title as series_title, s.poster_path as series_poster_path
+SELECT e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at, e.locked_fields, e.metadata_sources, e.image_placeholders, s.title as series_title, s.poster_path as series_poster_path
 FROM tvshow.episodes e
 JOIN tvshow.series s ON e.series_id = s.id
 WHERE e.air_date IS NOT NULL AND e.air_date > CURRENT_DATE
@@ -496,28 +523,31 @@ type ListUpcomingEpisodesParams struct {
 }
 
 type ListUpcomingEpisodesRow struct {
-	ID               uuid.UUID       `json:"id"`
-	SeriesID         uuid.UUID       `json:"seriesId"`
-	SeasonID         uuid.UUID       `json:"seasonId"`
-	TmdbID           *int32          `json:"tmdbId"`
-	TvdbID           *int32          `json:"tvdbId"`
-	ImdbID           *string         `json:"imdbId"`
-	SeasonNumber     int32           `json:"seasonNumber"`
-	EpisodeNumber    int32           `json:"episodeNumber"`
-	Title            string          `json:"title"`
-	Overview         *string         `json:"overview"`
-	TitlesI18n       json.RawMessage `json:"titlesI18n"`
-	OverviewsI18n    json.RawMessage `json:"overviewsI18n"`
-	AirDate          pgtype.Date     `json:"airDate"`
-	Runtime          *int32          `json:"runtime"`
-	VoteAverage      pgtype.Numeric  `json:"voteAverage"`
-	VoteCount        *int32          `json:"voteCount"`
-	StillPath        *string         `json:"stillPath"`
-	ProductionCode   *string         `json:"productionCode"`
-	CreatedAt        time.Time       `json:"createdAt"`
-	UpdatedAt        time.Time       `json:"updatedAt"`
-	SeriesTitle      string          `json:"seriesTitle"`
-	SeriesPosterPath *string         `json:"seriesPosterPath"`
+	ID                uuid.UUID       `json:"id"`
+	SeriesID          uuid.UUID       `json:"seriesId"`
+	SeasonID          uuid.UUID       `json:"seasonId"`
+	TmdbID            *int32          `json:"tmdbId"`
+	TvdbID            *int32          `json:"tvdbId"`
+	ImdbID            *string         `json:"imdbId"`
+	SeasonNumber      int32           `json:"seasonNumber"`
+	EpisodeNumber     int32           `json:"episodeNumber"`
+	Title             string          `json:"title"`
+	Overview          *string         `json:"overview"`
+	TitlesI18n        json.RawMessage `json:"titlesI18n"`
+	OverviewsI18n     json.RawMessage `json:"overviewsI18n"`
+	AirDate           pgtype.Date     `json:"airDate"`
+	Runtime           *int32          `json:"runtime"`
+	VoteAverage       pgtype.Numeric  `json:"voteAverage"`
+	VoteCount         *int32          `json:"voteCount"`
+	StillPath         *string         `json:"stillPath"`
+	ProductionCode    *string         `json:"productionCode"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+	LockedFields      []string        `json:"lockedFields"`
+	MetadataSources   json.RawMessage `json:"metadataSources"`
+	ImagePlaceholders json.RawMessage `json:"imagePlaceholders"`
+	SeriesTitle       string          `json:"seriesTitle"`
+	SeriesPosterPath  *string         `json:"seriesPosterPath"`
 }
 
 func (q *Queries) ListUpcomingEpisodes(ctx context.Context, arg ListUpcomingEpisodesParams) ([]ListUpcomingEpisodesRow, error) {
@@ -550,6 +580,9 @@ func (q *Queries) ListUpcomingEpisodes(ctx context.Context, arg ListUpcomingEpis
 			&i.ProductionCode,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 			&i.SeriesTitle,
 			&i.SeriesPosterPath,
 		); err != nil {
@@ -563,6 +596,76 @@ func (q *Queries) ListUpcomingEpisodes(ctx context.Context, arg ListUpcomingEpis
 	return items, nil
 }
 
+const setEpisodeImagePlaceholder = `-- name: SetEpisodeImagePlaceholder :execrows
+UPDATE tvshow.episodes
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object($1::text, $2::jsonb)) AS p
+    WHERE p.key = still_path
+)
+WHERE id = $3
+`
+
+type SetEpisodeImagePlaceholderParams struct {
+	Path        string          `json:"path"`
+	Placeholder json.RawMessage `json:"placeholder"`
+	ID          uuid.UUID       `json:"id"`
+}
+
+// Stores the placeholder of one of the episode's images. Placeholders of
+// images the episode no longer uses are dropped.
+func (q *Queries) SetEpisodeImagePlaceholder(ctx context.Context, arg SetEpisodeImagePlaceholderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setEpisodeImagePlaceholder, arg.Path, arg.Placeholder, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setEpisodeLockedFields = `-- name: SetEpisodeLockedFields :one
+UPDATE tvshow.episodes SET
+    locked_fields = $1::text[],
+    updated_at = NOW()
+WHERE id = $2
+RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
+`
+
+type SetEpisodeLockedFieldsParams struct {
+	LockedFields []string  `json:"lockedFields"`
+	ID           uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetEpisodeLockedFields(ctx context.Context, arg SetEpisodeLockedFieldsParams) (TvshowEpisode, error) {
+	row := q.db.QueryRow(ctx, setEpisodeLockedFields, arg.LockedFields, arg.ID)
+	var i TvshowEpisode
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.SeasonID,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.ImdbID,
+		&i.SeasonNumber,
+		&i.EpisodeNumber,
+		&i.Title,
+		&i.Overview,
+		&i.TitlesI18n,
+		&i.OverviewsI18n,
+		&i.AirDate,
+		&i.Runtime,
+		&i.VoteAverage,
+		&i.VoteCount,
+		&i.StillPath,
+		&i.ProductionCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
+	)
+	return i, err
+}
+
 const updateEpisode = `-- name: UpdateEpisode :one
 UPDATE tvshow.episodes SET
     tmdb_id = COALESCE($1, tmdb_id),
@@ -577,26 +680,28 @@ UPDATE tvshow.episodes SET
     vote_average = COALESCE($10, vote_average),
     vote_count = COALESCE($11, vote_count),
     still_path = COALESCE($12, still_path),
-    production_code = COALESCE($13, production_code)
-WHERE id = $14
-RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at
+    production_code = COALESCE($13, production_code),
+    metadata_sources = metadata_sources || COALESCE($14::jsonb, '{}'::jsonb)
+WHERE id = $15
+RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
 `
 
 type UpdateEpisodeParams struct {
-	TmdbID         *int32         `json:"tmdbId"`
-	TvdbID         *int32         `json:"tvdbId"`
-	ImdbID         *string        `json:"imdbId"`
-	Title          *string        `json:"title"`
-	Overview       *string        `json:"overview"`
-	TitlesI18n     []byte         `json:"titlesI18n"`
-	OverviewsI18n  []byte         `json:"overviewsI18n"`
-	AirDate        pgtype.Date    `json:"airDate"`
-	Runtime        *int32         `json:"runtime"`
-	VoteAverage    pgtype.Numeric `json:"voteAverage"`
-	VoteCount      *int32         `json:"voteCount"`
-	StillPath      *string        `json:"stillPath"`
-	ProductionCode *string        `json:"productionCode"`
-	ID             uuid.UUID      `json:"id"`
+	TmdbID          *int32         `json:"tmdbId"`
+	TvdbID          *int32         `json:"tvdbId"`
+	ImdbID          *string        `json:"imdbId"`
+	Title           *string        `json:"title"`
+	Overview        *string        `json:"overview"`
+	TitlesI18n      []byte         `json:"titlesI18n"`
+	OverviewsI18n   []byte         `json:"overviewsI18n"`
+	AirDate         pgtype.Date    `json:"airDate"`
+	Runtime         *int32         `json:"runtime"`
+	VoteAverage     pgtype.Numeric `json:"voteAverage"`
+	VoteCount       *int32         `json:"voteCount"`
+	StillPath       *string        `json:"stillPath"`
+	ProductionCode  *string        `json:"productionCode"`
+	MetadataSources []byte         `json:"metadataSources"`
+	ID              uuid.UUID      `json:"id"`
 }
 
 func (q *Queries) UpdateEpisode(ctx context.Context, arg UpdateEpisodeParams) (TvshowEpisode, error) {
@@ -614,6 +719,7 @@ func (q *Queries) UpdateEpisode(ctx context.Context, arg UpdateEpisodeParams) (T
 		arg.VoteCount,
 		arg.StillPath,
 		arg.ProductionCode,
+		arg.MetadataSources,
 		arg.ID,
 	)
 	var i TvshowEpisode
@@ -638,6 +744,9 @@ func (q *Queries) UpdateEpisode(ctx context.Context, arg UpdateEpisodeParams) (T
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -674,7 +783,7 @@ ON CONFLICT (series_id, season_number, episode_number) DO UPDATE SET
     vote_count = EXCLUDED.vote_count,
     still_path = EXCLUDED.still_path,
     production_code = EXCLUDED.production_code
-RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at
+RETURNING id, series_id, season_id, tmdb_id, tvdb_id, imdb_id, season_number, episode_number, title, overview, titles_i18n, overviews_i18n, air_date, runtime, vote_average, vote_count, still_path, production_code, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
 `
 
 type UpsertEpisodeParams struct {
@@ -739,6 +848,9 @@ func (q *Queries) UpsertEpisode(ctx context.Context, arg UpsertEpisodeParams) (T
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -71,25 +71,64 @@ func (q *Queries) ListDistinctSeriesGenres(ctx context.Context) ([]ListDistinctS
 }
 
 const listSeriesByGenre = `-- name: ListSeriesByGenre :many
-SELECT s.id, s.tmdb_id, s.tvdb_id, s.imdb_id, s.sonarr_id, s.title, s.tagline, s.overview, s.titles_i18n, s.taglines_i18n, s.overviews_i18n, s.age_ratings, s.original_language, s.original_title, s.status, s.type, s.first_air_date, s.last_air_date, s.vote_average, s.vote_count, s.popularity, s.poster_path, s.backdrop_path, s.total_seasons, s.total_episodes, s.trailer_url, s.homepage, s.metadata_updated_at, s.created_at, s.updated_at, s.external_ratings
+SELECT s.id, s.tmdb_id, s.tvdb_id, s.imdb_id, s.sonarr_id, s.title, s.tagline, s.overview, s.titles_i18n, s.taglines_i18n, s.overviews_i18n, s.age_ratings, s.original_language, s.original_title, s.status, s.type, s.first_air_date, s.last_air_date, s.vote_average, s.vote_count, s.popularity, s.poster_path, s.backdrop_path, s.total_seasons, s.total_episodes, s.trailer_url, s.homepage, s.metadata_updated_at, s.created_at, s.updated_at, s.external_ratings, s.sort_title, s.locked_fields, s.metadata_sources, s.provider_ids, s.image_placeholders, s.min_age, s.tags_imported_at
 FROM tvshow.series s
     JOIN tvshow.series_genres sg ON s.id = sg.series_id
 WHERE
     sg.slug = $1
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE re.series_id = s.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR s.min_age <= $4::integer
+        OR (s.min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY s.popularity DESC NULLS LAST
-LIMIT $2
+LIMIT $8
 OFFSET
-    $3
+    $7
 `
 
 type ListSeriesByGenreParams struct {
-	Slug   string `json:"slug"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Slug         string   `json:"slug"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
 }
 
+// restrict limits the result to series with an episode file under
+// library_paths.
 func (q *Queries) ListSeriesByGenre(ctx context.Context, arg ListSeriesByGenreParams) ([]TvshowSeries, error) {
-	rows, err := q.db.Query(ctx, listSeriesByGenre, arg.Slug, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listSeriesByGenre,
+		arg.Slug,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +168,13 @@ func (q *Queries) ListSeriesByGenre(ctx context.Context, arg ListSeriesByGenrePa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -13,18 +13,29 @@ import (
 type Querier interface {
 	AddSeriesGenre(ctx context.Context, arg AddSeriesGenreParams) error
 	AddSeriesNetwork(ctx context.Context, arg AddSeriesNetworkParams) error
+	AddSeriesTag(ctx context.Context, arg AddSeriesTagParams) error
 	CountEpisodesBySeason(ctx context.Context, seasonID uuid.UUID) (int64, error)
 	CountEpisodesBySeries(ctx context.Context, seriesID uuid.UUID) (int64, error)
-	CountSeries(ctx context.Context) (int64, error)
+	// restrict limits the result to series with an episode file under
+	// library_paths.
+	CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error)
 	CountSeriesCast(ctx context.Context, seriesID uuid.UUID) (int64, error)
 	CountSeriesCrew(ctx context.Context, seriesID uuid.UUID) (int64, error)
 	CreateEpisode(ctx context.Context, arg CreateEpisodeParams) (TvshowEpisode, error)
+	// Creates a credit and links it to the person with the same TMDb, TVDb or
+	// IMDb ID, creating the person if none matches. Provider IDs the person is
+	// missing are filled in unless another person already has them. Credits
+	// without any provider ID are not linked.
 	CreateEpisodeCredit(ctx context.Context, arg CreateEpisodeCreditParams) (TvshowEpisodeCredit, error)
 	CreateEpisodeFile(ctx context.Context, arg CreateEpisodeFileParams) (TvshowEpisodeFile, error)
 	CreateNetwork(ctx context.Context, arg CreateNetworkParams) (TvshowNetwork, error)
 	CreateOrUpdateWatchProgress(ctx context.Context, arg CreateOrUpdateWatchProgressParams) (TvshowEpisodeWatched, error)
 	CreateSeason(ctx context.Context, arg CreateSeasonParams) (TvshowSeason, error)
 	CreateSeries(ctx context.Context, arg CreateSeriesParams) (TvshowSeries, error)
+	// Creates a credit and links it to the person with the same TMDb, TVDb or
+	// IMDb ID, creating the person if none matches. Provider IDs the person is
+	// missing are filled in unless another person already has them. Credits
+	// without any provider ID are not linked.
 	CreateSeriesCredit(ctx context.Context, arg CreateSeriesCreditParams) (TvshowSeriesCredit, error)
 	DeleteEpisode(ctx context.Context, id uuid.UUID) error
 	DeleteEpisodeCredits(ctx context.Context, episodeID uuid.UUID) error
@@ -38,6 +49,7 @@ type Querier interface {
 	DeleteSeriesCredits(ctx context.Context, seriesID uuid.UUID) error
 	DeleteSeriesGenres(ctx context.Context, seriesID uuid.UUID) error
 	DeleteSeriesNetworks(ctx context.Context, seriesID uuid.UUID) error
+	DeleteSeriesTags(ctx context.Context, seriesID uuid.UUID) error
 	DeleteSeriesWatchProgress(ctx context.Context, arg DeleteSeriesWatchProgressParams) error
 	DeleteWatchProgress(ctx context.Context, arg DeleteWatchProgressParams) error
 	GetEpisode(ctx context.Context, id uuid.UUID) (TvshowEpisode, error)
@@ -53,13 +65,16 @@ type Querier interface {
 	GetSeason(ctx context.Context, id uuid.UUID) (TvshowSeason, error)
 	GetSeasonByNumber(ctx context.Context, arg GetSeasonByNumberParams) (TvshowSeason, error)
 	GetSeries(ctx context.Context, id uuid.UUID) (TvshowSeries, error)
+	GetSeriesByIMDbID(ctx context.Context, imdbID *string) (TvshowSeries, error)
 	GetSeriesBySonarrID(ctx context.Context, sonarrID *int32) (TvshowSeries, error)
 	GetSeriesByTMDbID(ctx context.Context, tmdbID *int32) (TvshowSeries, error)
 	GetSeriesByTVDbID(ctx context.Context, tvdbID *int32) (TvshowSeries, error)
 	GetSeriesWatchStats(ctx context.Context, arg GetSeriesWatchStatsParams) (GetSeriesWatchStatsRow, error)
 	GetUserTVStats(ctx context.Context, userID uuid.UUID) (GetUserTVStatsRow, error)
+	// restrict limits the result to episodes with a file under library_paths.
 	ListContinueWatchingSeries(ctx context.Context, arg ListContinueWatchingSeriesParams) ([]ListContinueWatchingSeriesRow, error)
 	ListDistinctSeriesGenres(ctx context.Context) ([]ListDistinctSeriesGenresRow, error)
+	ListDistinctSeriesTags(ctx context.Context, limit int32) ([]ListDistinctSeriesTagsRow, error)
 	ListEpisodeCrew(ctx context.Context, episodeID uuid.UUID) ([]TvshowEpisodeCredit, error)
 	ListEpisodeFilesByEpisode(ctx context.Context, episodeID uuid.UUID) ([]TvshowEpisodeFile, error)
 	// Episode Credits (Guest Stars)
@@ -68,18 +83,32 @@ type Querier interface {
 	ListEpisodesBySeasonNumber(ctx context.Context, arg ListEpisodesBySeasonNumberParams) ([]TvshowEpisode, error)
 	ListEpisodesBySeries(ctx context.Context, seriesID uuid.UUID) ([]TvshowEpisode, error)
 	ListNetworksBySeries(ctx context.Context, seriesID uuid.UUID) ([]TvshowNetwork, error)
+	// Returns the episode after the last one the user finished of each series,
+	// series watched most recently first. Series with an episode in progress
+	// are left to continue watching, and specials and unaired episodes are
+	// skipped. restrict limits the result to series with an episode file
+	// under library_paths.
+	ListNextUpEpisodes(ctx context.Context, arg ListNextUpEpisodesParams) ([]ListNextUpEpisodesRow, error)
 	ListRecentEpisodes(ctx context.Context, arg ListRecentEpisodesParams) ([]ListRecentEpisodesRow, error)
+	// restrict limits the result to series with an episode file under
+	// library_paths.
 	ListRecentlyAddedSeries(ctx context.Context, arg ListRecentlyAddedSeriesParams) ([]TvshowSeries, error)
 	ListSeasonsBySeries(ctx context.Context, seriesID uuid.UUID) ([]TvshowSeason, error)
 	ListSeasonsBySeriesWithEpisodeCount(ctx context.Context, seriesID uuid.UUID) ([]ListSeasonsBySeriesWithEpisodeCountRow, error)
 	ListSeries(ctx context.Context, arg ListSeriesParams) ([]TvshowSeries, error)
+	// restrict limits the result to series with an episode file under
+	// library_paths.
 	ListSeriesByGenre(ctx context.Context, arg ListSeriesByGenreParams) ([]TvshowSeries, error)
 	ListSeriesByNetwork(ctx context.Context, arg ListSeriesByNetworkParams) ([]TvshowSeries, error)
 	ListSeriesByStatus(ctx context.Context, arg ListSeriesByStatusParams) ([]TvshowSeries, error)
+	ListSeriesByTag(ctx context.Context, arg ListSeriesByTagParams) ([]TvshowSeries, error)
 	// Series Credits
 	ListSeriesCast(ctx context.Context, arg ListSeriesCastParams) ([]TvshowSeriesCredit, error)
 	ListSeriesCrew(ctx context.Context, arg ListSeriesCrewParams) ([]TvshowSeriesCredit, error)
+	ListSeriesDueForRefresh(ctx context.Context, arg ListSeriesDueForRefreshParams) ([]TvshowSeries, error)
 	ListSeriesGenres(ctx context.Context, seriesID uuid.UUID) ([]TvshowSeriesGenre, error)
+	ListSeriesPendingTagImport(ctx context.Context, arg ListSeriesPendingTagImportParams) ([]TvshowSeries, error)
+	ListSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]TvshowSeriesTag, error)
 	ListUpcomingEpisodes(ctx context.Context, arg ListUpcomingEpisodesParams) ([]ListUpcomingEpisodesRow, error)
 	ListWatchedEpisodesBySeries(ctx context.Context, arg ListWatchedEpisodesBySeriesParams) ([]ListWatchedEpisodesBySeriesRow, error)
 	ListWatchedEpisodesByUser(ctx context.Context, arg ListWatchedEpisodesByUserParams) ([]ListWatchedEpisodesByUserRow, error)
@@ -87,6 +116,19 @@ type Querier interface {
 	MarkEpisodesWatchedBulk(ctx context.Context, arg MarkEpisodesWatchedBulkParams) (int64, error)
 	SearchSeriesByTitle(ctx context.Context, arg SearchSeriesByTitleParams) ([]TvshowSeries, error)
 	SearchSeriesByTitleAnyLanguage(ctx context.Context, arg SearchSeriesByTitleAnyLanguageParams) ([]TvshowSeries, error)
+	// Stores the placeholder of one of the episode's images. Placeholders of
+	// images the episode no longer uses are dropped.
+	SetEpisodeImagePlaceholder(ctx context.Context, arg SetEpisodeImagePlaceholderParams) (int64, error)
+	SetEpisodeLockedFields(ctx context.Context, arg SetEpisodeLockedFieldsParams) (TvshowEpisode, error)
+	// Stores the placeholder of one of the season's images. Placeholders of
+	// images the season no longer uses are dropped.
+	SetSeasonImagePlaceholder(ctx context.Context, arg SetSeasonImagePlaceholderParams) (int64, error)
+	SetSeasonLockedFields(ctx context.Context, arg SetSeasonLockedFieldsParams) (TvshowSeason, error)
+	// Stores the placeholder of one of the series's images. Placeholders of
+	// images the series no longer uses are dropped.
+	SetSeriesImagePlaceholder(ctx context.Context, arg SetSeriesImagePlaceholderParams) (int64, error)
+	SetSeriesLockedFields(ctx context.Context, arg SetSeriesLockedFieldsParams) (TvshowSeries, error)
+	SetSeriesTagsImported(ctx context.Context, id uuid.UUID) error
 	UpdateEpisode(ctx context.Context, arg UpdateEpisodeParams) (TvshowEpisode, error)
 	UpdateEpisodeFile(ctx context.Context, arg UpdateEpisodeFileParams) (TvshowEpisodeFile, error)
 	UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (TvshowSeason, error)
@@ -26,7 +26,7 @@ INSERT INTO tvshow.seasons (
     $6, $7,
     $8, $9, $10, $11
 )
-RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at
+RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
 `
 
 type CreateSeasonParams struct {
@@ -73,6 +73,9 @@ func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Tvs
 		&i.VoteAverage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -96,7 +99,7 @@ func (q *Queries) DeleteSeasonsBySeries(ctx context.Context, seriesID uuid.UUID)
 }
 
 const getSeason = `-- name: GetSeason :one
-SELECT id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at FROM tvshow.seasons WHERE id = $1
+SELECT id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.seasons WHERE id = $1
 `
 
 func (q *Queries) GetSeason(ctx context.Context, id uuid.UUID) (TvshowSeason, error) {
@@ -117,12 +120,15 @@ func (q *Queries) GetSeason(ctx context.Context, id uuid.UUID) (TvshowSeason, er
 		&i.VoteAverage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
 
 const getSeasonByNumber = `-- name: GetSeasonByNumber :one
-SELECT id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at FROM tvshow.seasons
+SELECT id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.seasons
 WHERE series_id = $1 AND season_number = $2
 `
 
@@ -149,12 +155,15 @@ func (q *Queries) GetSeasonByNumber(ctx context.Context, arg GetSeasonByNumberPa
 		&i.VoteAverage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
 
 const listSeasonsBySeries = `-- name: ListSeasonsBySeries :many
-SELECT id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at FROM tvshow.seasons
+SELECT id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders FROM tvshow.seasons
 WHERE series_id = $1
 ORDER BY season_number ASC
 `
@@ -183,6 +192,9 @@ func (q *Queries) ListSeasonsBySeries(ctx context.Context, seriesID uuid.UUID) (
 			&i.VoteAverage,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 		); err != nil {
 			return nil, err
 		}
@@ -196,7 +208,7 @@ func (q *Queries) ListSeasonsBySeries(ctx context.Context, seriesID uuid.UUID) (
 
 const listSeasonsBySeriesWithEpisodeCount = `-- name: ListSeasonsBySeriesWithEpisodeCount :many
 SELECT
-    s.id, s.series_id, s.tmdb_id, s.season_number, s.name, s.overview, s.names_i18n, s.overviews_i18n, s.poster_path, s.episode_count, s.air_date, s.vote_average, s.created_at, s.updated_at,
+    s.id, s.series_id, s.tmdb_id, s.season_number, s.name, s.overview, s.names_i18n, s.overviews_i18n, s.poster_path, s.episode_count, s.air_date, s.vote_average, s.created_at, s.updated_at, s.locked_fields, s.metadata_sources, s.image_placeholders,
     (SELECT COUNT(*) FROM tvshow.episodes e WHERE e.season_id = s.id) as actual_episode_count
 FROM tvshow.seasons s
 WHERE s.series_id = $1
@@ -218,6 +230,9 @@ type ListSeasonsBySeriesWithEpisodeCountRow struct {
 	VoteAverage        pgtype.Numeric  `json:"voteAverage"`
 	CreatedAt          time.Time       `json:"createdAt"`
 	UpdatedAt          time.Time       `json:"updatedAt"`
+	LockedFields       []string        `json:"lockedFields"`
+	MetadataSources    json.RawMessage `json:"metadataSources"`
+	ImagePlaceholders  json.RawMessage `json:"imagePlaceholders"`
 	ActualEpisodeCount int64           `json:"actualEpisodeCount"`
 }
 
@@ -245,6 +260,9 @@ func (q *Queries) ListSeasonsBySeriesWithEpisodeCount(ctx context.Context, serie
 			&i.VoteAverage,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ImagePlaceholders,
 			&i.ActualEpisodeCount,
 		); err != nil {
 			return nil, err
@@ -257,6 +275,70 @@ func (q *Queries) ListSeasonsBySeriesWithEpisodeCount(ctx context.Context, serie
 	return items, nil
 }
 
+const setSeasonImagePlaceholder = `-- name: SetSeasonImagePlaceholder :execrows
+UPDATE tvshow.seasons
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object($1::text, $2::jsonb)) AS p
+    WHERE p.key = poster_path
+)
+WHERE id = $3
+`
+
+type SetSeasonImagePlaceholderParams struct {
+	Path        string          `json:"path"`
+	Placeholder json.RawMessage `json:"placeholder"`
+	ID          uuid.UUID       `json:"id"`
+}
+
+// Stores the placeholder of one of the season's images. Placeholders of
+// images the season no longer uses are dropped.
+func (q *Queries) SetSeasonImagePlaceholder(ctx context.Context, arg SetSeasonImagePlaceholderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setSeasonImagePlaceholder, arg.Path, arg.Placeholder, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setSeasonLockedFields = `-- name: SetSeasonLockedFields :one
+UPDATE tvshow.seasons SET
+    locked_fields = $1::text[],
+    updated_at = NOW()
+WHERE id = $2
+RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
+`
+
+type SetSeasonLockedFieldsParams struct {
+	LockedFields []string  `json:"lockedFields"`
+	ID           uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetSeasonLockedFields(ctx context.Context, arg SetSeasonLockedFieldsParams) (TvshowSeason, error) {
+	row := q.db.QueryRow(ctx, setSeasonLockedFields, arg.LockedFields, arg.ID)
+	var i TvshowSeason
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesID,
+		&i.TmdbID,
+		&i.SeasonNumber,
+		&i.Name,
+		&i.Overview,
+		&i.NamesI18n,
+		&i.OverviewsI18n,
+		&i.PosterPath,
+		&i.EpisodeCount,
+		&i.AirDate,
+		&i.VoteAverage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
+	)
+	return i, err
+}
+
 const updateSeason = `-- name: UpdateSeason :one
 UPDATE tvshow.seasons SET
     tmdb_id = COALESCE($1, tmdb_id),
@@ -267,22 +349,24 @@ UPDATE tvshow.seasons SET
     poster_path = COALESCE($6, poster_path),
     episode_count = COALESCE($7, episode_count),
     air_date = COALESCE($8, air_date),
-    vote_average = COALESCE($9, vote_average)
-WHERE id = $10
-RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at
+    vote_average = COALESCE($9, vote_average),
+    metadata_sources = metadata_sources || COALESCE($10::jsonb, '{}'::jsonb)
+WHERE id = $11
+RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
 `
 
 type UpdateSeasonParams struct {
-	TmdbID        *int32         `json:"tmdbId"`
-	Name          *string        `json:"name"`
-	Overview      *string        `json:"overview"`
-	NamesI18n     []byte         `json:"namesI18n"`
-	OverviewsI18n []byte         `json:"overviewsI18n"`
-	PosterPath    *string        `json:"posterPath"`
-	EpisodeCount  *int32         `json:"episodeCount"`
-	AirDate       pgtype.Date    `json:"airDate"`
-	VoteAverage   pgtype.Numeric `json:"voteAverage"`
-	ID            uuid.UUID      `json:"id"`
+	TmdbID          *int32         `json:"tmdbId"`
+	Name            *string        `json:"name"`
+	Overview        *string        `json:"overview"`
+	NamesI18n       []byte         `json:"namesI18n"`
+	OverviewsI18n   []byte         `json:"overviewsI18n"`
+	PosterPath      *string        `json:"posterPath"`
+	EpisodeCount    *int32         `json:"episodeCount"`
+	AirDate         pgtype.Date    `json:"airDate"`
+	VoteAverage     pgtype.Numeric `json:"voteAverage"`
+	MetadataSources []byte         `json:"metadataSources"`
+	ID              uuid.UUID      `json:"id"`
 }
 
 func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (TvshowSeason, error) {
@@ -296,6 +380,7 @@ func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (Tvs
 		arg.EpisodeCount,
 		arg.AirDate,
 		arg.VoteAverage,
+		arg.MetadataSources,
 		arg.ID,
 	)
 	var i TvshowSeason
@@ -314,6 +399,9 @@ func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (Tvs
 		&i.VoteAverage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -340,7 +428,7 @@ ON CONFLICT (series_id, season_number) DO UPDATE SET
     episode_count = EXCLUDED.episode_count,
     air_date = EXCLUDED.air_date,
     vote_average = EXCLUDED.vote_average
-RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at
+RETURNING id, series_id, tmdb_id, season_number, name, overview, names_i18n, overviews_i18n, poster_path, episode_count, air_date, vote_average, created_at, updated_at, locked_fields, metadata_sources, image_placeholders
 `
 
 type UpsertSeasonParams struct {
@@ -387,6 +475,9 @@ func (q *Queries) UpsertSeason(ctx context.Context, arg UpsertSeasonParams) (Tvs
 		&i.VoteAverage,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -8,6 +8,7 @@ package tvshowdb
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -15,10 +16,50 @@ import (
 
 const countSeries = `-- name: CountSeries :one
 SELECT COUNT(*) FROM tvshow.series
+WHERE
+    (
+        $1::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE re.series_id = series.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $3::integer IS NULL
+        OR min_age <= $3::integer
+        OR (min_age IS NULL AND $4::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY($5::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY($5::text[])
+    )
 `
 
-func (q *Queries) CountSeries(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countSeries)
+type CountSeriesParams struct {
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+}
+
+// restrict limits the result to series with an episode file under
+// library_paths.
+func (q *Queries) CountSeries(ctx context.Context, arg CountSeriesParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeries,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -54,7 +95,8 @@ INSERT INTO
         total_episodes,
         trailer_url,
         homepage,
-        metadata_updated_at
+        metadata_updated_at,
+        min_age
     )
 VALUES (
         $1,
@@ -84,8 +126,9 @@ VALUES (
         $25,
         $26,
         $27,
-        $28
-    ) RETURNING id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings
+        $28,
+        $29
+    ) RETURNING id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 `
 
 type CreateSeriesParams struct {
@@ -117,6 +160,7 @@ type CreateSeriesParams struct {
 	TrailerUrl        *string            `json:"trailerUrl"`
 	Homepage          *string            `json:"homepage"`
 	MetadataUpdatedAt pgtype.Timestamptz `json:"metadataUpdatedAt"`
+	MinAge            *int32             `json:"minAge"`
 }
 
 func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (TvshowSeries, error) {
@@ -149,6 +193,7 @@ func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (Tvs
 		arg.TrailerUrl,
 		arg.Homepage,
 		arg.MetadataUpdatedAt,
+		arg.MinAge,
 	)
 	var i TvshowSeries
 	err := row.Scan(
@@ -183,6 +228,13 @@ func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (Tvs
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
@@ -197,7 +249,7 @@ func (q *Queries) DeleteSeries(ctx context.Context, id uuid.UUID) error {
 }
 
 const getSeries = `-- name: GetSeries :one
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings FROM tvshow.series WHERE id = $1
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series WHERE id = $1
 `
 
 func (q *Queries) GetSeries(ctx context.Context, id uuid.UUID) (TvshowSeries, error) {
@@ -235,12 +287,69 @@ func (q *Queries) GetSeries(ctx context.Context, id uuid.UUID) (TvshowSeries, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
+	)
+	return i, err
+}
+
+const getSeriesByIMDbID = `-- name: GetSeriesByIMDbID :one
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series WHERE imdb_id = $1
+`
+
+func (q *Queries) GetSeriesByIMDbID(ctx context.Context, imdbID *string) (TvshowSeries, error) {
+	row := q.db.QueryRow(ctx, getSeriesByIMDbID, imdbID)
+	var i TvshowSeries
+	err := row.Scan(
+		&i.ID,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.ImdbID,
+		&i.SonarrID,
+		&i.Title,
+		&i.Tagline,
+		&i.Overview,
+		&i.TitlesI18n,
+		&i.TaglinesI18n,
+		&i.OverviewsI18n,
+		&i.AgeRatings,
+		&i.OriginalLanguage,
+		&i.OriginalTitle,
+		&i.Status,
+		&i.Type,
+		&i.FirstAirDate,
+		&i.LastAirDate,
+		&i.VoteAverage,
+		&i.VoteCount,
+		&i.Popularity,
+		&i.PosterPath,
+		&i.BackdropPath,
+		&i.TotalSeasons,
+		&i.TotalEpisodes,
+		&i.TrailerUrl,
+		&i.Homepage,
+		&i.MetadataUpdatedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const getSeriesBySonarrID = `-- name: GetSeriesBySonarrID :one
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings FROM tvshow.series WHERE sonarr_id = $1
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series WHERE sonarr_id = $1
 `
 
 func (q *Queries) GetSeriesBySonarrID(ctx context.Context, sonarrID *int32) (TvshowSeries, error) {
@@ -278,12 +387,19 @@ func (q *Queries) GetSeriesBySonarrID(ctx context.Context, sonarrID *int32) (Tvs
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const getSeriesByTMDbID = `-- name: GetSeriesByTMDbID :one
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings FROM tvshow.series WHERE tmdb_id = $1
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series WHERE tmdb_id = $1
 `
 
 func (q *Queries) GetSeriesByTMDbID(ctx context.Context, tmdbID *int32) (TvshowSeries, error) {
@@ -321,12 +437,19 @@ func (q *Queries) GetSeriesByTMDbID(ctx context.Context, tmdbID *int32) (TvshowS
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const getSeriesByTVDbID = `-- name: GetSeriesByTVDbID :one
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings FROM tvshow.series WHERE tvdb_id = $1
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series WHERE tvdb_id = $1
 `
 
 func (q *Queries) GetSeriesByTVDbID(ctx context.Context, tvdbID *int32) (TvshowSeries, error) {
@@ -364,26 +487,72 @@ func (q *Queries) GetSeriesByTVDbID(ctx context.Context, tvdbID *int32) (TvshowS
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
 
 const listRecentlyAddedSeries = `-- name: ListRecentlyAddedSeries :many
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM tvshow.series
+WHERE
+    (
+        $1::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest($2::text[]) AS lp(path)
+            WHERE re.series_id = series.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $3::integer IS NULL
+        OR min_age <= $3::integer
+        OR (min_age IS NULL AND $4::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY($5::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY($5::text[])
+    )
 ORDER BY created_at DESC
-LIMIT $1
+LIMIT $7
 OFFSET
-    $2
+    $6
 `
 
 type ListRecentlyAddedSeriesParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Restrict     bool     `json:"restrict"`
+	LibraryPaths []string `json:"libraryPaths"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
 }
 
+// restrict limits the result to series with an episode file under
+// library_paths.
 func (q *Queries) ListRecentlyAddedSeries(ctx context.Context, arg ListRecentlyAddedSeriesParams) ([]TvshowSeries, error) {
-	rows, err := q.db.Query(ctx, listRecentlyAddedSeries, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listRecentlyAddedSeries,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +592,13 @@ func (q *Queries) ListRecentlyAddedSeries(ctx context.Context, arg ListRecentlyA
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -435,31 +611,51 @@ func (q *Queries) ListRecentlyAddedSeries(ctx context.Context, arg ListRecentlyA
 }
 
 const listSeries = `-- name: ListSeries :many
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings FROM tvshow.series
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series
+WHERE
+    (
+        $1::integer IS NULL
+        OR min_age <= $1::integer
+        OR (min_age IS NULL AND $2::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY($3::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY($3::text[])
+    )
 ORDER BY
-    CASE WHEN $3::text = 'title' AND $4::text = 'asc' THEN title END ASC,
-    CASE WHEN $3::text = 'title' AND $4::text = 'desc' THEN title END DESC,
-    CASE WHEN $3::text = 'first_air_date' AND $4::text = 'asc' THEN first_air_date END ASC,
-    CASE WHEN $3::text = 'first_air_date' AND $4::text = 'desc' THEN first_air_date END DESC,
-    CASE WHEN $3::text = 'created_at' AND $4::text = 'asc' THEN created_at END ASC,
-    CASE WHEN $3::text = 'created_at' AND $4::text = 'desc' THEN created_at END DESC,
+    CASE WHEN $4::text = 'title' AND $5::text = 'asc' THEN title END ASC,
+    CASE WHEN $4::text = 'title' AND $5::text = 'desc' THEN title END DESC,
+    CASE WHEN $4::text = 'first_air_date' AND $5::text = 'asc' THEN first_air_date END ASC,
+    CASE WHEN $4::text = 'first_air_date' AND $5::text = 'desc' THEN first_air_date END DESC,
+    CASE WHEN $4::text = 'created_at' AND $5::text = 'asc' THEN created_at END ASC,
+    CASE WHEN $4::text = 'created_at' AND $5::text = 'desc' THEN created_at END DESC,
     created_at DESC
-LIMIT $1 OFFSET $2
+LIMIT $7 OFFSET $6
 `
 
 type ListSeriesParams struct {
-	Limit     int32  `json:"limit"`
-	Offset    int32  `json:"offset"`
-	SortBy    string `json:"sortBy"`
-	SortOrder string `json:"sortOrder"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
+	SortBy       string   `json:"sortBy"`
+	SortOrder    string   `json:"sortOrder"`
+	Offset       int32    `json:"offset"`
+	Limit        int32    `json:"limit"`
 }
 
 func (q *Queries) ListSeries(ctx context.Context, arg ListSeriesParams) ([]TvshowSeries, error) {
 	rows, err := q.db.Query(ctx, listSeries,
-		arg.Limit,
-		arg.Offset,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
 		arg.SortBy,
 		arg.SortOrder,
+		arg.Offset,
+		arg.Limit,
 	)
 	if err != nil {
 		return nil, err
@@ -500,6 +696,13 @@ func (q *Queries) ListSeries(ctx context.Context, arg ListSeriesParams) ([]Tvsho
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -512,7 +715,7 @@ func (q *Queries) ListSeries(ctx context.Context, arg ListSeriesParams) ([]Tvsho
 }
 
 const listSeriesByStatus = `-- name: ListSeriesByStatus :many
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM tvshow.series
 WHERE
     status = $1
@@ -569,6 +772,110 @@ func (q *Queries) ListSeriesByStatus(ctx context.Context, arg ListSeriesByStatus
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSeriesDueForRefresh = `-- name: ListSeriesDueForRefresh :many
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
+FROM tvshow.series
+WHERE
+    (
+        tmdb_id IS NOT NULL
+        OR tvdb_id IS NOT NULL
+        OR imdb_id IS NOT NULL
+        OR provider_ids <> '{}'::jsonb
+    )
+    AND (
+        metadata_updated_at IS NULL
+        OR metadata_updated_at < CASE
+            WHEN overview IS NULL OR poster_path IS NULL THEN $1::timestamptz
+            WHEN status IS NULL
+                OR status NOT IN ('Ended', 'Canceled')
+                OR last_air_date >= $2::date THEN $3::timestamptz
+            ELSE $4::timestamptz
+        END
+    )
+ORDER BY metadata_updated_at ASC NULLS FIRST
+LIMIT $5
+`
+
+type ListSeriesDueForRefreshParams struct {
+	IncompleteBefore time.Time   `json:"incompleteBefore"`
+	ActiveSince      pgtype.Date `json:"activeSince"`
+	ActiveBefore     time.Time   `json:"activeBefore"`
+	SettledBefore    time.Time   `json:"settledBefore"`
+	Limit            int32       `json:"limit"`
+}
+
+func (q *Queries) ListSeriesDueForRefresh(ctx context.Context, arg ListSeriesDueForRefreshParams) ([]TvshowSeries, error) {
+	rows, err := q.db.Query(ctx, listSeriesDueForRefresh,
+		arg.IncompleteBefore,
+		arg.ActiveSince,
+		arg.ActiveBefore,
+		arg.SettledBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TvshowSeries{}
+	for rows.Next() {
+		var i TvshowSeries
+		if err := rows.Scan(
+			&i.ID,
+			&i.TmdbID,
+			&i.TvdbID,
+			&i.ImdbID,
+			&i.SonarrID,
+			&i.Title,
+			&i.Tagline,
+			&i.Overview,
+			&i.TitlesI18n,
+			&i.TaglinesI18n,
+			&i.OverviewsI18n,
+			&i.AgeRatings,
+			&i.OriginalLanguage,
+			&i.OriginalTitle,
+			&i.Status,
+			&i.Type,
+			&i.FirstAirDate,
+			&i.LastAirDate,
+			&i.VoteAverage,
+			&i.VoteCount,
+			&i.Popularity,
+			&i.PosterPath,
+			&i.BackdropPath,
+			&i.TotalSeasons,
+			&i.TotalEpisodes,
+			&i.TrailerUrl,
+			&i.Homepage,
+			&i.MetadataUpdatedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -581,11 +888,26 @@ func (q *Queries) ListSeriesByStatus(ctx context.Context, arg ListSeriesByStatus
 }
 
 const searchSeriesByTitle = `-- name: SearchSeriesByTitle :many
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 FROM tvshow.series
 WHERE
-    title ILIKE '%' || $1 || '%'
-    OR original_title ILIKE '%' || $1 || '%'
+    (
+        title ILIKE '%' || $1 || '%'
+        OR original_title ILIKE '%' || $1 || '%'
+    )
+    AND (
+        $4::integer IS NULL
+        OR min_age <= $4::integer
+        OR (min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY
     CASE
         WHEN LOWER(title) = LOWER($1) THEN 0
@@ -599,13 +921,23 @@ OFFSET
 `
 
 type SearchSeriesByTitleParams struct {
-	Column1 *string `json:"column1"`
-	Limit   int32   `json:"limit"`
-	Offset  int32   `json:"offset"`
+	Column1      *string  `json:"column1"`
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
 }
 
 func (q *Queries) SearchSeriesByTitle(ctx context.Context, arg SearchSeriesByTitleParams) ([]TvshowSeries, error) {
-	rows, err := q.db.Query(ctx, searchSeriesByTitle, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, searchSeriesByTitle,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -645,6 +977,13 @@ func (q *Queries) SearchSeriesByTitle(ctx context.Context, arg SearchSeriesByTit
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -657,10 +996,25 @@ func (q *Queries) SearchSeriesByTitle(ctx context.Context, arg SearchSeriesByTit
 }
 
 const searchSeriesByTitleAnyLanguage = `-- name: SearchSeriesByTitleAnyLanguage :many
-SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings FROM tvshow.series
-WHERE title ILIKE '%' || $1 || '%'
-   OR original_title ILIKE '%' || $1 || '%'
-   OR titles_i18n::text ILIKE '%' || $1 || '%'
+SELECT id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at FROM tvshow.series
+WHERE (
+        title ILIKE '%' || $1 || '%'
+        OR original_title ILIKE '%' || $1 || '%'
+        OR titles_i18n::text ILIKE '%' || $1 || '%'
+    )
+    AND (
+        $4::integer IS NULL
+        OR min_age <= $4::integer
+        OR (min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY
     CASE
         WHEN LOWER(title) = LOWER($1) THEN 0
@@ -672,13 +1026,23 @@ LIMIT $2 OFFSET $3
 `
 
 type SearchSeriesByTitleAnyLanguageParams struct {
-	Column1 *string `json:"column1"`
-	Limit   int32   `json:"limit"`
-	Offset  int32   `json:"offset"`
+	Column1      *string  `json:"column1"`
+	Limit        int32    `json:"limit"`
+	Offset       int32    `json:"offset"`
+	MaxAge       *int32   `json:"maxAge"`
+	AllowUnrated bool     `json:"allowUnrated"`
+	BlockedTags  []string `json:"blockedTags"`
 }
 
 func (q *Queries) SearchSeriesByTitleAnyLanguage(ctx context.Context, arg SearchSeriesByTitleAnyLanguageParams) ([]TvshowSeries, error) {
-	rows, err := q.db.Query(ctx, searchSeriesByTitleAnyLanguage, arg.Column1, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, searchSeriesByTitleAnyLanguage,
+		arg.Column1,
+		arg.Limit,
+		arg.Offset,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -718,6 +1082,13 @@ func (q *Queries) SearchSeriesByTitleAnyLanguage(ctx context.Context, arg Search
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -729,6 +1100,92 @@ func (q *Queries) SearchSeriesByTitleAnyLanguage(ctx context.Context, arg Search
 	return items, nil
 }
 
+const setSeriesImagePlaceholder = `-- name: SetSeriesImagePlaceholder :execrows
+UPDATE tvshow.series
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object($1::text, $2::jsonb)) AS p
+    WHERE p.key IN (poster_path, backdrop_path)
+)
+WHERE id = $3
+`
+
+type SetSeriesImagePlaceholderParams struct {
+	Path        string          `json:"path"`
+	Placeholder json.RawMessage `json:"placeholder"`
+	ID          uuid.UUID       `json:"id"`
+}
+
+// Stores the placeholder of one of the series's images. Placeholders of
+// images the series no longer uses are dropped.
+func (q *Queries) SetSeriesImagePlaceholder(ctx context.Context, arg SetSeriesImagePlaceholderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setSeriesImagePlaceholder, arg.Path, arg.Placeholder, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setSeriesLockedFields = `-- name: SetSeriesLockedFields :one
+UPDATE tvshow.series
+SET
+    locked_fields = $1::text[],
+    updated_at = NOW()
+WHERE
+    id = $2 RETURNING id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
+`
+
+type SetSeriesLockedFieldsParams struct {
+	LockedFields []string  `json:"lockedFields"`
+	ID           uuid.UUID `json:"id"`
+}
+
+func (q *Queries) SetSeriesLockedFields(ctx context.Context, arg SetSeriesLockedFieldsParams) (TvshowSeries, error) {
+	row := q.db.QueryRow(ctx, setSeriesLockedFields, arg.LockedFields, arg.ID)
+	var i TvshowSeries
+	err := row.Scan(
+		&i.ID,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.ImdbID,
+		&i.SonarrID,
+		&i.Title,
+		&i.Tagline,
+		&i.Overview,
+		&i.TitlesI18n,
+		&i.TaglinesI18n,
+		&i.OverviewsI18n,
+		&i.AgeRatings,
+		&i.OriginalLanguage,
+		&i.OriginalTitle,
+		&i.Status,
+		&i.Type,
+		&i.FirstAirDate,
+		&i.LastAirDate,
+		&i.VoteAverage,
+		&i.VoteCount,
+		&i.Popularity,
+		&i.PosterPath,
+		&i.BackdropPath,
+		&i.TotalSeasons,
+		&i.TotalEpisodes,
+		&i.TrailerUrl,
+		&i.Homepage,
+		&i.MetadataUpdatedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
+	)
+	return i, err
+}
+
 const updateSeries = `-- name: UpdateSeries :one
 UPDATE tvshow.series
 SET
@@ -773,70 +1230,86 @@ SET
         $11,
         age_ratings
     ),
+    min_age = CASE
+        WHEN $11 IS NULL THEN min_age
+        ELSE $12::integer
+    END,
     external_ratings = COALESCE(
-        $12,
+        $13,
         external_ratings
     ),
+    metadata_sources = metadata_sources || COALESCE(
+        $14::jsonb,
+        '{}'::jsonb
+    ),
+    provider_ids = provider_ids || COALESCE(
+        $15::jsonb,
+        '{}'::jsonb
+    ),
     original_language = COALESCE(
-        $13,
+        $16,
         original_language
     ),
     original_title = COALESCE(
-        $14,
+        $17,
         original_title
     ),
-    status = COALESCE($15, status),
-    type = COALESCE($16, type),
+    status = COALESCE($18, status),
+    type = COALESCE($19, type),
     first_air_date = COALESCE(
-        $17,
+        $20,
         first_air_date
     ),
     last_air_date = COALESCE(
-        $18,
+        $21,
         last_air_date
     ),
     vote_average = COALESCE(
-        $19,
+        $22,
         vote_average
     ),
     vote_count = COALESCE(
-        $20,
+        $23,
         vote_count
     ),
     popularity = COALESCE(
-        $21,
+        $24,
         popularity
     ),
     poster_path = COALESCE(
-        $22,
+        $25,
         poster_path
     ),
     backdrop_path = COALESCE(
-        $23,
+        $26,
         backdrop_path
     ),
     total_seasons = COALESCE(
-        $24,
+        $27,
         total_seasons
     ),
     total_episodes = COALESCE(
-        $25,
+        $28,
         total_episodes
     ),
     trailer_url = COALESCE(
-        $26,
+        $29,
         trailer_url
     ),
     homepage = COALESCE(
-        $27,
+        $30,
         homepage
     ),
     metadata_updated_at = COALESCE(
-        $28,
+        $31,
         metadata_updated_at
+    ),
+    sort_title = COALESCE(
+        $32,
+        sort_title
     )
 WHERE
-    id = $29 RETURNING id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings
+    id = $33 RETURNING id, tmdb_id, tvdb_id, imdb_id, sonarr_id, title, tagline, overview, titles_i18n, taglines_i18n, overviews_i18n, age_ratings, original_language, original_title, status, type, first_air_date, last_air_date, vote_average, vote_count, popularity, poster_path, backdrop_path, total_seasons, total_episodes, trailer_url, homepage, metadata_updated_at, created_at, updated_at, external_ratings, sort_title, locked_fields, metadata_sources, provider_ids, image_placeholders, min_age, tags_imported_at
 `
 
 type UpdateSeriesParams struct {
@@ -851,7 +1324,10 @@ type UpdateSeriesParams struct {
 	TaglinesI18n      []byte             `json:"taglinesI18n"`
 	OverviewsI18n     []byte             `json:"overviewsI18n"`
 	AgeRatings        []byte             `json:"ageRatings"`
+	MinAge            *int32             `json:"minAge"`
 	ExternalRatings   []byte             `json:"externalRatings"`
+	MetadataSources   []byte             `json:"metadataSources"`
+	ProviderIds       []byte             `json:"providerIds"`
 	OriginalLanguage  *string            `json:"originalLanguage"`
 	OriginalTitle     *string            `json:"originalTitle"`
 	Status            *string            `json:"status"`
@@ -868,6 +1344,7 @@ type UpdateSeriesParams struct {
 	TrailerUrl        *string            `json:"trailerUrl"`
 	Homepage          *string            `json:"homepage"`
 	MetadataUpdatedAt pgtype.Timestamptz `json:"metadataUpdatedAt"`
+	SortTitle         *string            `json:"sortTitle"`
 	ID                uuid.UUID          `json:"id"`
 }
 
@@ -884,7 +1361,10 @@ func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (Tvs
 		arg.TaglinesI18n,
 		arg.OverviewsI18n,
 		arg.AgeRatings,
+		arg.MinAge,
 		arg.ExternalRatings,
+		arg.MetadataSources,
+		arg.ProviderIds,
 		arg.OriginalLanguage,
 		arg.OriginalTitle,
 		arg.Status,
@@ -901,6 +1381,7 @@ func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (Tvs
 		arg.TrailerUrl,
 		arg.Homepage,
 		arg.MetadataUpdatedAt,
+		arg.SortTitle,
 		arg.ID,
 	)
 	var i TvshowSeries
@@ -936,6 +1417,13 @@ func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (Tvs
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExternalRatings,
+		&i.SortTitle,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ProviderIds,
+		&i.ImagePlaceholders,
+		&i.MinAge,
+		&i.TagsImportedAt,
 	)
 	return i, err
 }
@@ -142,7 +142,7 @@ func (q *Queries) GetEpisodeWatchProgress(ctx context.Context, arg GetEpisodeWat
 }
 
 const getNextUnwatchedEpisode = `-- name: GetNextUnwatchedEpisode :one
-SELECT e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at
+SELECT e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at, e.locked_fields, e.metadata_sources, e.image_placeholders
 FROM tvshow.episodes e
     LEFT JOIN tvshow.episode_watched ew ON e.id = ew.episode_id
     AND ew.user_id = $1
@@ -185,6 +185,9 @@ func (q *Queries) GetNextUnwatchedEpisode(ctx context.Context, arg GetNextUnwatc
 		&i.ProductionCode,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LockedFields,
+		&i.MetadataSources,
+		&i.ImagePlaceholders,
 	)
 	return i, err
 }
@@ -278,7 +281,7 @@ func (q *Queries) GetUserTVStats(ctx context.Context, userID uuid.UUID) (GetUser
 
 const listContinueWatchingSeries = `-- name: ListContinueWatchingSeries :many
 SELECT DISTINCT
-    ON (s.id) s.id, s.tmdb_id, s.tvdb_id, s.imdb_id, s.sonarr_id, s.title, s.tagline, s.overview, s.titles_i18n, s.taglines_i18n, s.overviews_i18n, s.age_ratings, s.original_language, s.original_title, s.status, s.type, s.first_air_date, s.last_air_date, s.vote_average, s.vote_count, s.popularity, s.poster_path, s.backdrop_path, s.total_seasons, s.total_episodes, s.trailer_url, s.homepage, s.metadata_updated_at, s.created_at, s.updated_at, s.external_ratings,
+    ON (s.id) s.id, s.tmdb_id, s.tvdb_id, s.imdb_id, s.sonarr_id, s.title, s.tagline, s.overview, s.titles_i18n, s.taglines_i18n, s.overviews_i18n, s.age_ratings, s.original_language, s.original_title, s.status, s.type, s.first_air_date, s.last_air_date, s.vote_average, s.vote_count, s.popularity, s.poster_path, s.backdrop_path, s.total_seasons, s.total_episodes, s.trailer_url, s.homepage, s.metadata_updated_at, s.created_at, s.updated_at, s.external_ratings, s.sort_title, s.locked_fields, s.metadata_sources, s.provider_ids, s.image_placeholders, s.min_age, s.tags_imported_at,
     e.id as last_episode_id,
     e.season_number as last_season_number,
     e.episode_number as last_episode_number,
@@ -293,13 +296,40 @@ WHERE
     ew.user_id = $1
     AND NOT ew.is_completed
     AND ew.progress_seconds > 0
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episode_files f
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE f.episode_id = e.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR s.min_age <= $4::integer
+        OR (s.min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY($6::text[])
+    )
 ORDER BY s.id, ew.last_watched_at DESC
-LIMIT $2
+LIMIT $7
 `
 
 type ListContinueWatchingSeriesParams struct {
-	UserID uuid.UUID `json:"userId"`
-	Limit  int32     `json:"limit"`
+	UserID       uuid.UUID `json:"userId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+	MaxAge       *int32    `json:"maxAge"`
+	AllowUnrated bool      `json:"allowUnrated"`
+	BlockedTags  []string  `json:"blockedTags"`
+	Limit        int32     `json:"limit"`
 }
 
 type ListContinueWatchingSeriesRow struct {
@@ -334,6 +364,13 @@ type ListContinueWatchingSeriesRow struct {
 	CreatedAt         time.Time          `json:"createdAt"`
 	UpdatedAt         time.Time          `json:"updatedAt"`
 	ExternalRatings   json.RawMessage    `json:"externalRatings"`
+	SortTitle         *string            `json:"sortTitle"`
+	LockedFields      []string           `json:"lockedFields"`
+	MetadataSources   json.RawMessage    `json:"metadataSources"`
+	ProviderIds       json.RawMessage    `json:"providerIds"`
+	ImagePlaceholders json.RawMessage    `json:"imagePlaceholders"`
+	MinAge            *int32             `json:"minAge"`
+	TagsImportedAt    pgtype.Timestamptz `json:"tagsImportedAt"`
 	LastEpisodeID     uuid.UUID          `json:"lastEpisodeId"`
 	LastSeasonNumber  int32              `json:"lastSeasonNumber"`
 	LastEpisodeNumber int32              `json:"lastEpisodeNumber"`
@@ -343,8 +380,17 @@ type ListContinueWatchingSeriesRow struct {
 	LastWatchedAt     pgtype.Timestamptz `json:"lastWatchedAt"`
 }
 
+// restrict limits the result to episodes with a file under library_paths.
 func (q *Queries) ListContinueWatchingSeries(ctx context.Context, arg ListContinueWatchingSeriesParams) ([]ListContinueWatchingSeriesRow, error) {
-	rows, err := q.db.Query(ctx, listContinueWatchingSeries, arg.UserID, arg.Limit)
+	rows, err := q.db.Query(ctx, listContinueWatchingSeries,
+		arg.UserID,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -384,6 +430,13 @@ func (q *Queries) ListContinueWatchingSeries(ctx context.Context, arg ListContin
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExternalRatings,
+			&i.SortTitle,
+			&i.LockedFields,
+			&i.MetadataSources,
+			&i.ProviderIds,
+			&i.ImagePlaceholders,
+			&i.MinAge,
+			&i.TagsImportedAt,
 			&i.LastEpisodeID,
 			&i.LastSeasonNumber,
 			&i.LastEpisodeNumber,
@@ -402,6 +455,165 @@ func (q *Queries) ListContinueWatchingSeries(ctx context.Context, arg ListContin
 	return items, nil
 }
 
+const listNextUpEpisodes = `-- name: ListNextUpEpisodes :many
+WITH last_completed AS (
+    SELECT DISTINCT
+        ON (le.series_id) le.series_id,
+        le.season_number,
+        le.episode_number,
+        lw.last_watched_at
+    FROM tvshow.episode_watched lw
+        JOIN tvshow.episodes le ON le.id = lw.episode_id
+    WHERE
+        lw.user_id = $1
+        AND lw.is_completed
+    ORDER BY le.series_id, lw.last_watched_at DESC
+)
+SELECT
+    e.id, e.series_id, e.season_id, e.tmdb_id, e.tvdb_id, e.imdb_id, e.season_number, e.episode_number, e.title, e.overview, e.titles_i18n, e.overviews_i18n, e.air_date, e.runtime, e.vote_average, e.vote_count, e.still_path, e.production_code, e.created_at, e.updated_at, e.locked_fields, e.metadata_sources, e.image_placeholders,
+    s.title AS series_title,
+    s.poster_path AS series_poster_path,
+    lc.last_watched_at
+FROM last_completed lc
+    JOIN tvshow.series s ON s.id = lc.series_id
+    JOIN LATERAL (
+        SELECT ne.id
+        FROM tvshow.episodes ne
+        WHERE
+            ne.series_id = lc.series_id
+            AND ne.season_number > 0
+            AND (ne.season_number, ne.episode_number) > (lc.season_number, lc.episode_number)
+            AND (
+                ne.air_date IS NULL
+                OR ne.air_date <= CURRENT_DATE
+            )
+            AND NOT EXISTS (
+                SELECT 1
+                FROM tvshow.episode_watched nw
+                WHERE
+                    nw.episode_id = ne.id
+                    AND nw.user_id = $1
+                    AND nw.is_completed
+            )
+        ORDER BY ne.season_number ASC, ne.episode_number ASC
+        LIMIT 1
+    ) nx ON TRUE
+    JOIN tvshow.episodes e ON e.id = nx.id
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM tvshow.episode_watched pw
+            JOIN tvshow.episodes pe ON pe.id = pw.episode_id
+        WHERE
+            pw.user_id = $1
+            AND pe.series_id = s.id
+            AND NOT pw.is_completed
+            AND pw.progress_seconds > 0
+    )
+    AND (
+        $2::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest($3::text[]) AS lp(path)
+            WHERE re.series_id = s.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        $4::integer IS NULL
+        OR s.min_age <= $4::integer
+        OR (s.min_age IS NULL AND $5::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY($6::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY($6::text[])
+    )
+ORDER BY lc.last_watched_at DESC
+LIMIT $7
+`
+
+type ListNextUpEpisodesParams struct {
+	UserID       uuid.UUID `json:"userId"`
+	Restrict     bool      `json:"restrict"`
+	LibraryPaths []string  `json:"libraryPaths"`
+	MaxAge       *int32    `json:"maxAge"`
+	AllowUnrated bool      `json:"allowUnrated"`
+	BlockedTags  []string  `json:"blockedTags"`
+	Limit        int32     `json:"limit"`
+}
+
+type ListNextUpEpisodesRow struct {
+	TvshowEpisode    TvshowEpisode      `json:"tvshowEpisode"`
+	SeriesTitle      string             `json:"seriesTitle"`
+	SeriesPosterPath *string            `json:"seriesPosterPath"`
+	LastWatchedAt    pgtype.Timestamptz `json:"lastWatchedAt"`
+}
+
+// Returns the episode after the last one the user finished of each series,
+// series watched most recently first. Series with an episode in progress
+// are left to continue watching, and specials and unaired episodes are
+// skipped. restrict limits the result to series with an episode file
+// under library_paths.
+func (q *Queries) ListNextUpEpisodes(ctx context.Context, arg ListNextUpEpisodesParams) ([]ListNextUpEpisodesRow, error) {
+	rows, err := q.db.Query(ctx, listNextUpEpisodes,
+		arg.UserID,
+		arg.Restrict,
+		arg.LibraryPaths,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListNextUpEpisodesRow{}
+	for rows.Next() {
+		var i ListNextUpEpisodesRow
+		if err := rows.Scan(
+			&i.TvshowEpisode.ID,
+			&i.TvshowEpisode.SeriesID,
+			&i.TvshowEpisode.SeasonID,
+			&i.TvshowEpisode.TmdbID,
+			&i.TvshowEpisode.TvdbID,
+			&i.TvshowEpisode.ImdbID,
+			&i.TvshowEpisode.SeasonNumber,
+			&i.TvshowEpisode.EpisodeNumber,
+			&i.TvshowEpisode.Title,
+			&i.TvshowEpisode.Overview,
+			&i.TvshowEpisode.TitlesI18n,
+			&i.TvshowEpisode.OverviewsI18n,
+			&i.TvshowEpisode.AirDate,
+			&i.TvshowEpisode.Runtime,
+			&i.TvshowEpisode.VoteAverage,
+			&i.TvshowEpisode.VoteCount,
+			&i.TvshowEpisode.StillPath,
+			&i.TvshowEpisode.ProductionCode,
+			&i.TvshowEpisode.CreatedAt,
+			&i.TvshowEpisode.UpdatedAt,
+			&i.TvshowEpisode.LockedFields,
+			&i.TvshowEpisode.MetadataSources,
+			&i.TvshowEpisode.ImagePlaceholders,
+			&i.SeriesTitle,
+			&i.SeriesPosterPath,
+			&i.LastWatchedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWatchedEpisodesBySeries = `-- name: ListWatchedEpisodesBySeries :many
 SELECT ew.id, ew.user_id, ew.episode_id, ew.progress_seconds, ew.duration_seconds, ew.is_completed, ew.watch_count, ew.last_watched_at, ew.created_at, ew.updated_at, e.season_number, e.episode_number, e.title as episode_title
 FROM tvshow.episode_watched ew
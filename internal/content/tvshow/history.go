@@ -0,0 +1,359 @@
+package tvshow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+func snapshotDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return formatDate(t)
+}
+
+func snapshotDecimal(d *decimal.Decimal) *string {
+	if d == nil || d.IsZero() {
+		return nil
+	}
+	s := d.String()
+	return &s
+}
+
+func snapshotNumber(n int32) *string {
+	s := strconv.FormatInt(int64(n), 10)
+	return &s
+}
+
+// Snapshot returns the values of the fields tracked in the metadata change
+// history.
+func (s *Series) Snapshot() content.Snapshot {
+	title := s.Title
+	return content.Snapshot{
+		content.FieldTitle:         &title,
+		content.FieldSortTitle:     s.SortTitle,
+		content.FieldOriginalTitle: s.OriginalTitle,
+		content.FieldOverview:      s.Overview,
+		content.FieldTagline:       s.Tagline,
+		content.FieldReleaseDate:   snapshotDate(s.FirstAirDate),
+		content.FieldRatings:       snapshotDecimal(s.VoteAverage),
+		content.FieldPoster:        s.PosterPath,
+		content.FieldBackdrop:      s.BackdropPath,
+	}
+}
+
+// Snapshot returns the values of the fields tracked in the metadata change
+// history.
+func (s *Season) Snapshot() content.Snapshot {
+	name := s.Name
+	return content.Snapshot{
+		content.FieldTitle:        &name,
+		content.FieldSeasonNumber: snapshotNumber(s.SeasonNumber),
+		content.FieldOverview:     s.Overview,
+		content.FieldReleaseDate:  snapshotDate(s.AirDate),
+		content.FieldRatings:      snapshotDecimal(s.VoteAverage),
+		content.FieldPoster:       s.PosterPath,
+	}
+}
+
+// Snapshot returns the values of the fields tracked in the metadata change
+// history.
+func (e *Episode) Snapshot() content.Snapshot {
+	title := e.Title
+	return content.Snapshot{
+		content.FieldTitle:         &title,
+		content.FieldSeasonNumber:  snapshotNumber(e.SeasonNumber),
+		content.FieldEpisodeNumber: snapshotNumber(e.EpisodeNumber),
+		content.FieldOverview:      e.Overview,
+		content.FieldReleaseDate:   snapshotDate(e.AirDate),
+		content.FieldRatings:       snapshotDecimal(e.VoteAverage),
+		content.FieldStill:         e.StillPath,
+	}
+}
+
+// historyRepository records the changes made by every series, season and
+// episode update in the metadata change history.
+type historyRepository struct {
+	Repository
+	recorder content.ChangeRecorder
+}
+
+// NewHistoryRepository wraps a repository so series, season and episode
+// updates are recorded in the metadata change history.
+func NewHistoryRepository(repo Repository, recorder content.ChangeRecorder) Repository {
+	return &historyRepository{Repository: repo, recorder: recorder}
+}
+
+func (r *historyRepository) record(ctx context.Context, contentType string, id uuid.UUID, before, after content.Snapshot) {
+	if changes := before.Diff(after); len(changes) > 0 {
+		r.recorder.RecordChange(ctx, contentType, id, changes)
+	}
+}
+
+// UpdateSeries updates the series and records the changed fields.
+func (r *historyRepository) UpdateSeries(ctx context.Context, params UpdateSeriesParams) (*Series, error) {
+	before, _ := r.Repository.GetSeries(ctx, params.ID)
+
+	updated, err := r.Repository.UpdateSeries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		r.record(ctx, content.ContentTypeSeries, params.ID, before.Snapshot(), updated.Snapshot())
+	}
+	return updated, nil
+}
+
+// UpdateSeason updates the season and records the changed fields.
+func (r *historyRepository) UpdateSeason(ctx context.Context, params UpdateSeasonParams) (*Season, error) {
+	before, _ := r.Repository.GetSeason(ctx, params.ID)
+
+	updated, err := r.Repository.UpdateSeason(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		r.record(ctx, content.ContentTypeSeason, params.ID, before.Snapshot(), updated.Snapshot())
+	}
+	return updated, nil
+}
+
+// UpdateEpisode updates the episode and records the changed fields.
+func (r *historyRepository) UpdateEpisode(ctx context.Context, params UpdateEpisodeParams) (*Episode, error) {
+	before, _ := r.Repository.GetEpisode(ctx, params.ID)
+
+	updated, err := r.Repository.UpdateEpisode(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		r.record(ctx, content.ContentTypeEpisode, params.ID, before.Snapshot(), updated.Snapshot())
+	}
+	return updated, nil
+}
+
+// restoreValues walks the non-empty snapshot values in field order. Empty
+// values are skipped since updates cannot clear a field; callers reject
+// snapshots that would have to.
+func restoreValues(values content.Snapshot, apply func(field, value string) (bool, error)) ([]string, error) {
+	var fields []string
+	for _, field := range values.Fields() {
+		value := values[field]
+		if value == nil {
+			continue
+		}
+		ok, err := apply(field, *value)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+func parseRestoreDate(value string) (*string, error) {
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return nil, fmt.Errorf("invalid date %q: %w", value, err)
+	}
+	return &value, nil
+}
+
+func parseRestoreNumber(field, value string) (*int32, error) {
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", field, value, err)
+	}
+	v := int32(n)
+	return &v, nil
+}
+
+// lockableFields returns the fields that can be locked, dropping history-only
+// fields such as episode numbering.
+func lockableFields(fields []string) []string {
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if content.ValidateLockField(f) == nil {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// RestoreSeriesMetadata writes previous field values back, e.g. when rolling
+// back to an earlier version, and locks the restored fields so the next
+// refresh keeps them. It fails with content.ErrCannotClearFields when a
+// field set now was empty in values.
+func (s *tvService) RestoreSeriesMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Series, error) {
+	series, err := s.repo.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := values.CheckRestorable(series.Snapshot()); err != nil {
+		return nil, err
+	}
+
+	params := UpdateSeriesParams{ID: id}
+	fields, err := restoreValues(values, func(field, value string) (bool, error) {
+		switch field {
+		case content.FieldTitle:
+			params.Title = &value
+		case content.FieldSortTitle:
+			params.SortTitle = &value
+		case content.FieldOriginalTitle:
+			params.OriginalTitle = &value
+		case content.FieldOverview:
+			params.Overview = &value
+		case content.FieldTagline:
+			params.Tagline = &value
+		case content.FieldReleaseDate:
+			date, err := parseRestoreDate(value)
+			if err != nil {
+				return false, err
+			}
+			params.FirstAirDate = date
+		case content.FieldRatings:
+			params.VoteAverage = &value
+		case content.FieldPoster:
+			params.PosterPath = &value
+		case content.FieldBackdrop:
+			params.BackdropPath = &value
+		default:
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return series, nil
+	}
+
+	if _, err := s.repo.UpdateSeries(ctx, params); err != nil {
+		return nil, fmt.Errorf("update series: %w", err)
+	}
+	return s.repo.SetSeriesLockedFields(ctx, id, series.LockedFields.With(fields...))
+}
+
+// RestoreSeasonMetadata writes previous season field values back and locks
+// the restored fields. Like RestoreSeriesMetadata it cannot clear fields.
+func (s *tvService) RestoreSeasonMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Season, error) {
+	season, err := s.repo.GetSeason(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := values.CheckRestorable(season.Snapshot()); err != nil {
+		return nil, err
+	}
+
+	params := UpdateSeasonParams{ID: id}
+	fields, err := restoreValues(values, func(field, value string) (bool, error) {
+		switch field {
+		case content.FieldTitle:
+			params.Name = &value
+		case content.FieldSeasonNumber:
+			n, err := parseRestoreNumber(field, value)
+			if err != nil {
+				return false, err
+			}
+			params.SeasonNumber = n
+		case content.FieldOverview:
+			params.Overview = &value
+		case content.FieldReleaseDate:
+			date, err := parseRestoreDate(value)
+			if err != nil {
+				return false, err
+			}
+			params.AirDate = date
+		case content.FieldRatings:
+			params.VoteAverage = &value
+		case content.FieldPoster:
+			params.PosterPath = &value
+		default:
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return season, nil
+	}
+
+	if _, err := s.repo.UpdateSeason(ctx, params); err != nil {
+		return nil, fmt.Errorf("update season: %w", err)
+	}
+	return s.repo.SetSeasonLockedFields(ctx, id, season.LockedFields.With(lockableFields(fields)...))
+}
+
+// RestoreEpisodeMetadata writes previous episode field values back, including
+// the season and episode number, and locks the restored lockable fields.
+// Like RestoreSeriesMetadata it cannot clear fields.
+func (s *tvService) RestoreEpisodeMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Episode, error) {
+	episode, err := s.repo.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := values.CheckRestorable(episode.Snapshot()); err != nil {
+		return nil, err
+	}
+
+	params := UpdateEpisodeParams{ID: id}
+	fields, err := restoreValues(values, func(field, value string) (bool, error) {
+		switch field {
+		case content.FieldTitle:
+			params.Title = &value
+		case content.FieldSeasonNumber:
+			n, err := parseRestoreNumber(field, value)
+			if err != nil {
+				return false, err
+			}
+			params.SeasonNumber = n
+		case content.FieldEpisodeNumber:
+			n, err := parseRestoreNumber(field, value)
+			if err != nil {
+				return false, err
+			}
+			params.EpisodeNumber = n
+		case content.FieldOverview:
+			params.Overview = &value
+		case content.FieldReleaseDate:
+			date, err := parseRestoreDate(value)
+			if err != nil {
+				return false, err
+			}
+			params.AirDate = date
+		case content.FieldRatings:
+			params.VoteAverage = &value
+		case content.FieldStill:
+			params.StillPath = &value
+		default:
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return episode, nil
+	}
+
+	if _, err := s.repo.UpdateEpisode(ctx, params); err != nil {
+		return nil, fmt.Errorf("update episode: %w", err)
+	}
+	return s.repo.SetEpisodeLockedFields(ctx, id, episode.LockedFields.With(lockableFields(fields)...))
+}
@@ -0,0 +1,89 @@
+package tvshow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+type fakeRecorder struct {
+	contentTypes []string
+	changes      []map[string]content.FieldChange
+}
+
+func (f *fakeRecorder) RecordChange(_ context.Context, contentType string, _ uuid.UUID, changes map[string]content.FieldChange) {
+	f.contentTypes = append(f.contentTypes, contentType)
+	f.changes = append(f.changes, changes)
+}
+
+func TestHistoryRepository_UpdateEpisode(t *testing.T) {
+	repo := new(MockRepository)
+	recorder := &fakeRecorder{}
+	history := NewHistoryRepository(repo, recorder)
+	ctx := context.Background()
+
+	before := &Episode{ID: uuid.Must(uuid.NewV7()), Title: "Secrets", SeasonNumber: 1, EpisodeNumber: 1}
+	after := *before
+	after.SeasonNumber = 0
+	after.EpisodeNumber = 3
+	params := UpdateEpisodeParams{ID: before.ID, SeasonNumber: new(int32(0)), EpisodeNumber: new(int32(3))}
+
+	repo.On("GetEpisode", ctx, before.ID).Return(before, nil)
+	repo.On("UpdateEpisode", ctx, params).Return(&after, nil)
+
+	_, err := history.UpdateEpisode(ctx, params)
+	require.NoError(t, err)
+
+	require.Len(t, recorder.changes, 1)
+	assert.Equal(t, content.ContentTypeEpisode, recorder.contentTypes[0])
+	assert.Equal(t, map[string]content.FieldChange{
+		content.FieldSeasonNumber:  {Old: new("1"), New: new("0")},
+		content.FieldEpisodeNumber: {Old: new("1"), New: new("3")},
+	}, recorder.changes[0])
+}
+
+func TestService_RestoreEpisodeMetadata(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	episode := &Episode{ID: uuid.Must(uuid.NewV7()), Title: "Secrets", SeasonNumber: 0, EpisodeNumber: 3}
+
+	repo.On("GetEpisode", ctx, episode.ID).Return(episode, nil)
+	repo.On("UpdateEpisode", ctx, mock.MatchedBy(func(p UpdateEpisodeParams) bool {
+		return *p.SeasonNumber == 1 && *p.EpisodeNumber == 1 && *p.Title == "Secrets" && p.Overview == nil
+	})).Return(episode, nil)
+	// Numbering is restored but cannot be locked.
+	repo.On("SetEpisodeLockedFields", ctx, episode.ID, []string{"title"}).Return(episode, nil)
+
+	_, err := svc.RestoreEpisodeMetadata(ctx, episode.ID, content.Snapshot{
+		content.FieldSeasonNumber:  new("1"),
+		content.FieldEpisodeNumber: new("1"),
+		content.FieldTitle:         new("Secrets"),
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_RestoreSeriesMetadata_CannotClear(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	series := &Series{ID: uuid.Must(uuid.NewV7()), Title: "Severance", Tagline: new("Work-life balance"), PosterPath: new("/poster.jpg")}
+
+	repo.On("GetSeries", ctx, series.ID).Return(series, nil)
+
+	_, err := svc.RestoreSeriesMetadata(ctx, series.ID, content.Snapshot{
+		content.FieldTitle:   new("Severance"),
+		content.FieldTagline: nil,
+		content.FieldPoster:  nil,
+	})
+	require.ErrorIs(t, err, content.ErrCannotClearFields)
+	assert.EqualError(t, err, "cannot clear fields: poster, tagline")
+	repo.AssertNotCalled(t, "UpdateSeries", mock.Anything, mock.Anything)
+}
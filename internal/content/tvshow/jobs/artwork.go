@@ -0,0 +1,158 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+// trackSeriesDir remembers the series folder of an already matched episode
+// file so its local artwork is refreshed even when the file is skipped.
+func (w *LibraryScanWorker) trackSeriesDir(ctx context.Context, seriesDirs map[string]uuid.UUID, filePath string, episodeID uuid.UUID) {
+	if w.artwork == nil || !w.artwork.LocalEnabled() {
+		return
+	}
+
+	dir := scanner.SeriesDir(filePath)
+	if _, ok := seriesDirs[dir]; ok {
+		return
+	}
+
+	episode, err := w.service.GetEpisode(ctx, episodeID)
+	if err != nil {
+		w.logger.Debug("failed to look up episode for artwork",
+			slog.String("file_path", filePath),
+			slog.Any("error", err),
+		)
+		return
+	}
+	seriesDirs[dir] = episode.SeriesID
+}
+
+// syncLocalArtwork records the artwork found in each series folder. Series
+// artwork belongs to the series, seasonNN-poster images to their season.
+// When local artwork is preferred, series and season images are pointed
+// at it.
+func (w *LibraryScanWorker) syncLocalArtwork(ctx context.Context, seriesDirs map[string]uuid.UUID) {
+	if w.artwork == nil || !w.artwork.LocalEnabled() {
+		return
+	}
+
+	for dir, seriesID := range seriesDirs {
+		var seriesFiles []scanner.LocalArtwork
+		seasonFiles := make(map[int32][]scanner.LocalArtwork)
+		for _, art := range scanner.FindSeriesArtwork(dir) {
+			if art.SeasonNumber != nil {
+				n := int32(*art.SeasonNumber) // #nosec G115 -- season numbers are at most three digits
+				seasonFiles[n] = append(seasonFiles[n], art)
+				continue
+			}
+			seriesFiles = append(seriesFiles, art)
+		}
+
+		synced, err := w.artwork.SyncLocal(ctx, artwork.ContentTypeSeries, seriesID, seriesFiles)
+		if err != nil {
+			w.logger.Warn("failed to sync local series artwork",
+				slog.String("series_id", seriesID.String()),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		if w.artwork.PreferLocal() {
+			w.useLocalSeriesArtwork(ctx, seriesID, synced)
+		}
+
+		seasons, err := w.service.ListSeasons(ctx, seriesID)
+		if err != nil {
+			w.logger.Warn("failed to list seasons for artwork",
+				slog.String("series_id", seriesID.String()),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		for _, season := range seasons {
+			synced, err := w.artwork.SyncLocal(ctx, artwork.ContentTypeSeason, season.ID, seasonFiles[season.SeasonNumber])
+			if err != nil {
+				w.logger.Warn("failed to sync local season artwork",
+					slog.String("season_id", season.ID.String()),
+					slog.Any("error", err),
+				)
+				continue
+			}
+			if w.artwork.PreferLocal() {
+				w.useLocalSeasonArtwork(ctx, &season, synced)
+			}
+		}
+	}
+}
+
+// useLocalSeriesArtwork points a series' poster and backdrop at local artwork.
+func (w *LibraryScanWorker) useLocalSeriesArtwork(ctx context.Context, seriesID uuid.UUID, synced []artwork.Artwork) {
+	poster := localImagePath(synced, scanner.ArtworkPoster)
+	backdrop := localImagePath(synced, scanner.ArtworkBackdrop)
+	if poster == nil && backdrop == nil {
+		return
+	}
+
+	series, err := w.service.GetSeries(ctx, seriesID)
+	if err != nil {
+		w.logger.Warn("failed to get series for artwork", slog.Any("error", err))
+		return
+	}
+
+	params := tvshow.UpdateSeriesParams{ID: seriesID}
+	changed := false
+	if poster != nil && !strPtrEquals(series.PosterPath, *poster) {
+		params.PosterPath = poster
+		changed = true
+	}
+	if backdrop != nil && !strPtrEquals(series.BackdropPath, *backdrop) {
+		params.BackdropPath = backdrop
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	if _, err := w.service.UpdateSeries(ctx, params); err != nil {
+		w.logger.Warn("failed to apply local series artwork",
+			slog.String("series_id", seriesID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// useLocalSeasonArtwork points a season's poster at local artwork.
+func (w *LibraryScanWorker) useLocalSeasonArtwork(ctx context.Context, season *tvshow.Season, synced []artwork.Artwork) {
+	poster := localImagePath(synced, scanner.ArtworkPoster)
+	if poster == nil || strPtrEquals(season.PosterPath, *poster) {
+		return
+	}
+
+	if _, err := w.service.UpdateSeason(ctx, tvshow.UpdateSeasonParams{ID: season.ID, PosterPath: poster}); err != nil {
+		w.logger.Warn("failed to apply local season artwork",
+			slog.String("season_id", season.ID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// localImagePath returns the image path of the synced artwork of a kind,
+// or nil if there is none.
+func localImagePath(synced []artwork.Artwork, kind string) *string {
+	art := artwork.Select(synced, kind)
+	if art == nil {
+		return nil
+	}
+	path := artwork.LocalPath(art.ID)
+	return &path
+}
+
+func strPtrEquals(p *string, s string) bool {
+	return p != nil && *p == s
+}
@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+// mockArtworkRepository implements artwork.Repository for testing.
+type mockArtworkRepository struct {
+	mock.Mock
+}
+
+func (m *mockArtworkRepository) Upsert(ctx context.Context, art *artwork.Artwork) error {
+	return m.Called(ctx, art).Error(0)
+}
+
+func (m *mockArtworkRepository) Get(ctx context.Context, id uuid.UUID) (*artwork.Artwork, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*artwork.Artwork), args.Error(1)
+}
+
+func (m *mockArtworkRepository) ListByContent(ctx context.Context, contentType string, contentID uuid.UUID) ([]artwork.Artwork, error) {
+	args := m.Called(ctx, contentType, contentID)
+	return args.Get(0).([]artwork.Artwork), args.Error(1)
+}
+
+func (m *mockArtworkRepository) DeleteStale(ctx context.Context, contentType string, contentID uuid.UUID, source string, keep []uuid.UUID) (int64, error) {
+	args := m.Called(ctx, contentType, contentID, source, keep)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockArtworkRepository) Select(ctx context.Context, art *artwork.Artwork, userSelected bool) error {
+	return m.Called(ctx, art, userSelected).Error(0)
+}
+
+func (m *mockArtworkRepository) MarkCached(ctx context.Context, id uuid.UUID, fileSize int64) error {
+	return m.Called(ctx, id, fileSize).Error(0)
+}
+
+func (m *mockArtworkRepository) SetPlaceholder(ctx context.Context, id uuid.UUID, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, placeholder).Error(0)
+}
+
+func TestLibraryScanWorker_SyncLocalArtwork(t *testing.T) {
+	ctx := context.Background()
+	showDir := t.TempDir()
+	for _, name := range []string{"poster.jpg", "fanart.jpg", "season01-poster.jpg"} {
+		require.NoError(t, os.WriteFile(filepath.Join(showDir, name), []byte("x"), 0o644))
+	}
+
+	seriesID := uuid.Must(uuid.NewV7())
+	season1 := tvshow.Season{ID: uuid.Must(uuid.NewV7()), SeriesID: seriesID, SeasonNumber: 1}
+	season2 := tvshow.Season{ID: uuid.Must(uuid.NewV7()), SeriesID: seriesID, SeasonNumber: 2}
+
+	// Each upsert gets a fresh ID so the image paths can be checked.
+	ids := map[string]uuid.UUID{}
+	repo := new(mockArtworkRepository)
+	repo.On("Upsert", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		art := args.Get(1).(*artwork.Artwork)
+		art.ID = uuid.Must(uuid.NewV7())
+		ids[filepath.Base(art.Path)] = art.ID
+	}).Return(nil)
+	repo.On("DeleteStale", ctx, mock.Anything, mock.Anything, artwork.SourceLocal, mock.Anything).Return(int64(0), nil)
+
+	svc := new(mockService)
+	svc.On("GetSeries", ctx, seriesID).Return(&tvshow.Series{ID: seriesID}, nil)
+	svc.On("ListSeasons", ctx, seriesID).Return([]tvshow.Season{season1, season2}, nil)
+	svc.On("UpdateSeries", ctx, mock.MatchedBy(func(p tvshow.UpdateSeriesParams) bool {
+		return p.ID == seriesID &&
+			*p.PosterPath == artwork.LocalPath(ids["poster.jpg"]) &&
+			*p.BackdropPath == artwork.LocalPath(ids["fanart.jpg"])
+	})).Return(&tvshow.Series{ID: seriesID}, nil)
+	svc.On("UpdateSeason", ctx, mock.MatchedBy(func(p tvshow.UpdateSeasonParams) bool {
+		return p.ID == season1.ID && *p.PosterPath == artwork.LocalPath(ids["season01-poster.jpg"])
+	})).Return(&season1, nil)
+
+	artworkService := artwork.NewService(repo, config.LocalArtworkConfig{Enabled: true, PreferLocal: true}, logging.NewTestLogger())
+	worker := NewLibraryScanWorker(svc, nil, nil, nil, artworkService, nil, nil, logging.NewTestLogger())
+
+	worker.syncLocalArtwork(ctx, map[string]uuid.UUID{showDir: seriesID})
+
+	svc.AssertExpectations(t)
+	repo.AssertCalled(t, "DeleteStale", ctx, artwork.ContentTypeSeason, season2.ID, artwork.SourceLocal, []uuid.UUID{})
+	svc.AssertNumberOfCalls(t, "UpdateSeason", 1)
+}
@@ -13,11 +13,15 @@ import (
 	"log/slog"
 
 	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content"
 	sharedjobs "github.com/lusoris/revenge/internal/content/shared/jobs"
+	"github.com/lusoris/revenge/internal/content/shared/nfo"
 	"github.com/lusoris/revenge/internal/content/shared/scanner"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/content/tvshow/adapters"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/library"
 	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/service/search"
 	"github.com/lusoris/revenge/internal/util"
@@ -33,6 +37,7 @@ const (
 	KindSeriesRefresh   = "tvshow_series_refresh"
 	KindSeasonRefresh   = "tvshow_season_refresh"
 	KindEpisodeRefresh  = "tvshow_episode_refresh"
+	KindNFOExport       = "tvshow_nfo_export"
 )
 
 // =============================================================================
@@ -52,6 +57,9 @@ type LibraryScanArgs struct {
 
 	// AutoCreate indicates whether to auto-create series/seasons/episodes for discovered files.
 	AutoCreate bool `json:"auto_create"`
+
+	// ScanID is the optional library scan record tracking this job.
+	ScanID *uuid.UUID `json:"scan_id,omitempty"`
 }
 
 // Kind returns the job kind identifier.
@@ -79,16 +87,22 @@ type LibraryScanWorker struct {
 	metadataProvider    tvshow.MetadataProvider
 	jobClient           *infrajobs.Client
 	notificationService notification.Service
+	artwork             *artwork.Service
+	scanStatusService   *library.Service
+	nfo                 *tvshow.NFOService
 	logger              *slog.Logger
 }
 
 // NewLibraryScanWorker creates a new library scan worker.
-func NewLibraryScanWorker(service tvshow.Service, metadataProvider tvshow.MetadataProvider, jobClient *infrajobs.Client, notificationService notification.Service, logger *slog.Logger) *LibraryScanWorker {
+func NewLibraryScanWorker(service tvshow.Service, metadataProvider tvshow.MetadataProvider, jobClient *infrajobs.Client, notificationService notification.Service, artworkService *artwork.Service, scanStatusService *library.Service, nfoService *tvshow.NFOService, logger *slog.Logger) *LibraryScanWorker {
 	return &LibraryScanWorker{
 		service:             service,
 		metadataProvider:    metadataProvider,
 		jobClient:           jobClient,
 		notificationService: notificationService,
+		artwork:             artworkService,
+		scanStatusService:   scanStatusService,
+		nfo:                 nfoService,
 		logger:              logger.With("component", "tvshow_library_scan"),
 	}
 }
@@ -107,14 +121,14 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 		slog.Bool("auto_create", job.Args.AutoCreate),
 	)
 
+	status := sharedjobs.NewScanStatus(w.scanStatusService, w.notificationService, w.logger, "tvshow", job.Args.LibraryID, job.Args.ScanID)
+	libIDStr, scanIDStr := status.LibraryID, status.ScanID
+
 	// Dispatch library scan started notification.
 	if w.notificationService != nil {
-		var libIDStr string
-		if job.Args.LibraryID != nil {
-			libIDStr = job.Args.LibraryID.String()
-		}
 		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanStarted).
 			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
 			WithData("library_type", "tvshow"))
 	}
 
@@ -122,8 +136,11 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 	start := time.Now()
 	itemsSkipped := 0
 
+	status.Start(ctx)
+
 	if len(job.Args.Paths) == 0 {
 		w.logger.Warn("no paths provided for library scan")
+		status.Complete(ctx, &library.ScanProgress{})
 		return nil
 	}
 
@@ -132,10 +149,17 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 	fsScanner := scanner.NewFilesystemScanner(job.Args.Paths, parser)
 
 	// Scan all paths
+	reporter := sharedjobs.NewScanReporter(w.jobClient, w.notificationService, job.ID, "tvshow", libIDStr, scanIDStr)
+	reporter.Flush(ctx, scanner.Progress{Phase: scanner.PhaseDiscovering})
+
 	scanResults, summary, err := fsScanner.ScanWithSummary(ctx)
+	if ctx.Err() != nil {
+		return status.Interrupted(ctx)
+	}
 	if err != nil {
 		result.AddError(fmt.Errorf("scan failed: %w", err))
 		w.logger.Error("library scan failed", slog.Any("error", err))
+		status.Fail(ctx, err)
 		return err
 	}
 
@@ -154,20 +178,32 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 	}
 	processed := 0
 
+	// Series folders seen during the scan, for local artwork discovery
+	seriesDirs := make(map[string]uuid.UUID)
+
 	// Process each discovered file
+	// Metadata lookups for new files follow the library's provider policy.
+	metadataCtx := ctx
+	if job.Args.LibraryID != nil && w.scanStatusService != nil {
+		metadataCtx = w.scanStatusService.MetadataContext(ctx, *job.Args.LibraryID)
+	}
+
 	for _, sr := range scanResults {
 		if !sr.IsMedia {
 			continue
 		}
-		processed++
 
-		// Report progress
-		_ = w.jobClient.ReportProgress(ctx, job.ID, &infrajobs.JobProgress{
-			Phase:   "processing",
-			Current: processed,
-			Total:   mediaFiles,
-			Message: sr.ParsedTitle,
+		reporter.Report(ctx, scanner.Progress{
+			Phase:          scanner.PhaseMatching,
+			FilesTotal:     mediaFiles,
+			FilesProcessed: processed,
+			FilesMatched:   result.ItemsProcessed + itemsSkipped,
+			CurrentPath:    sr.FilePath,
 		})
+		if ctx.Err() != nil {
+			return status.Interrupted(ctx)
+		}
+		processed++
 
 		// Check if file is already matched
 		existingFile, err := w.service.GetEpisodeFileByPath(ctx, sr.FilePath)
@@ -176,12 +212,14 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 				slog.String("file_path", sr.FilePath),
 			)
 			itemsSkipped++
+			w.trackSeriesDir(ctx, seriesDirs, sr.FilePath, existingFile.EpisodeID)
 			continue
 		}
 
 		// Process the file with auto-create if enabled
 		if job.Args.AutoCreate && w.metadataProvider != nil {
-			if err := w.processFile(ctx, sr); err != nil {
+			series, err := w.processFile(metadataCtx, sr)
+			if err != nil {
 				w.logger.Warn("failed to process file",
 					slog.String("file_path", sr.FilePath),
 					slog.Any("error", err),
@@ -189,6 +227,7 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 				result.AddError(fmt.Errorf("process %s: %w", sr.FilePath, err))
 				continue
 			}
+			seriesDirs[scanner.SeriesDir(sr.FilePath)] = series.ID
 			result.ItemsProcessed++
 		} else {
 			// Just log discovered files when auto-create is disabled
@@ -202,6 +241,15 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 		}
 	}
 
+	reporter.Flush(ctx, scanner.Progress{
+		Phase:          scanner.PhaseMatching,
+		FilesTotal:     mediaFiles,
+		FilesProcessed: processed,
+		FilesMatched:   result.ItemsProcessed + itemsSkipped,
+	})
+
+	w.syncLocalArtwork(ctx, seriesDirs)
+
 	result.Duration = time.Since(start)
 	result.Success = !result.HasErrors()
 	result.LogSummary(w.logger, KindLibraryScan)
@@ -216,14 +264,17 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 		slog.Int("items_skipped", itemsSkipped),
 	)
 
+	status.Complete(ctx, &library.ScanProgress{
+		ItemsScanned: int32(processed),
+		ItemsAdded:   int32(result.ItemsProcessed),
+		ErrorsCount:  int32(len(result.Errors)),
+	})
+
 	// Dispatch library scan completed notification.
 	if w.notificationService != nil {
-		var libIDStr string
-		if job.Args.LibraryID != nil {
-			libIDStr = job.Args.LibraryID.String()
-		}
 		_ = w.notificationService.Dispatch(ctx, notification.NewEvent(notification.EventLibraryScanDone).
 			WithData("library_id", libIDStr).
+			WithData("scan_id", scanIDStr).
 			WithData("library_type", "tvshow").
 			WithData("items_processed", result.ItemsProcessed).
 			WithData("scan_duration", result.Duration.String()))
@@ -244,44 +295,63 @@ func (w *LibraryScanWorker) Work(ctx context.Context, job *river.Job[LibraryScan
 }
 
 // processFile processes a single scanned file, creating series/season/episode as needed.
-func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResult) error {
+// It returns the series the file belongs to.
+func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResult) (*tvshow.Series, error) {
 	// Extract metadata from scan result
 	seriesTitle := sr.ParsedTitle
 	if seriesTitle == "" {
-		return fmt.Errorf("could not parse series title from filename")
+		return nil, fmt.Errorf("could not parse series title from filename")
 	}
 
 	seasonNum := sr.GetSeason()
 	episodeNum := sr.GetEpisode()
 	if seasonNum == nil || episodeNum == nil {
-		return fmt.Errorf("could not parse season/episode from filename")
+		return nil, fmt.Errorf("could not parse season/episode from filename")
 	}
 
-	// Search for existing series by title
-	seriesList, err := w.service.SearchSeries(ctx, seriesTitle, 5, 0)
-	if err != nil {
-		return fmt.Errorf("search series: %w", err)
+	var series *tvshow.Series
+
+	// A tvshow.nfo with provider IDs identifies the series without a search,
+	// and its title replaces the one parsed from the path
+	nfoDoc := w.nfo.ReadSeries(sr.FilePath)
+	if nfoDoc != nil && nfoDoc.Title != "" {
+		seriesTitle = nfoDoc.Title
+	}
+	nfoSeries := seriesFromNFO(nfoDoc, seriesTitle)
+	if nfoSeries != nil {
+		series = w.findExistingByExternalID(ctx, nfoSeries)
 	}
 
-	var series *tvshow.Series
+	if series == nil {
+		// Search for existing series by title
+		seriesList, err := w.service.SearchSeries(ctx, seriesTitle, 5, 0)
+		if err != nil {
+			return nil, fmt.Errorf("search series: %w", err)
+		}
 
-	// Check for exact match
-	for _, s := range seriesList {
-		if normalizeTitle(s.Title) == normalizeTitle(seriesTitle) {
-			series = &s
-			break
+		// Check for exact match
+		for _, s := range seriesList {
+			if normalizeTitle(s.Title) == normalizeTitle(seriesTitle) {
+				series = &s
+				break
+			}
 		}
 	}
 
 	// If no match found, search TMDb and create series
 	if series == nil {
-		searchResults, err := w.metadataProvider.SearchSeries(ctx, seriesTitle, nil)
-		if err != nil || len(searchResults) == 0 {
-			return fmt.Errorf("series not found: %s", seriesTitle)
+		newSeries := nfoSeries
+		if newSeries == nil {
+			searchResults, err := w.metadataProvider.SearchSeries(ctx, seriesTitle, nil)
+			if err != nil || len(searchResults) == 0 {
+				return nil, fmt.Errorf("series not found: %s", seriesTitle)
+			}
+
+			// Use first result
+			newSeries = searchResults[0]
 		}
 
-		// Use first result and enrich it
-		newSeries := searchResults[0]
+		// Enrich it
 		if err := w.metadataProvider.EnrichSeries(ctx, newSeries); err != nil {
 			w.logger.Warn("failed to enrich series", slog.Any("error", err))
 		}
@@ -305,12 +375,23 @@ func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResu
 			params := seriesToCreateParams(newSeries)
 			created, err := w.service.CreateSeries(ctx, params)
 			if err != nil {
-				return fmt.Errorf("create series: %w", err)
+				return nil, fmt.Errorf("create series: %w", err)
 			}
 			series = created
 		}
 	}
 
+	if nfoDoc != nil {
+		if updated, err := w.nfo.Apply(ctx, series, nfoDoc); err != nil {
+			w.logger.Warn("failed to apply tvshow.nfo",
+				slog.String("series_id", series.ID.String()),
+				slog.Any("error", err),
+			)
+		} else {
+			series = updated
+		}
+	}
+
 	// Find or create season
 	season, err := w.service.GetSeasonByNumber(ctx, series.ID, util.SafeIntToInt32(*seasonNum))
 	if err != nil {
@@ -343,7 +424,7 @@ func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResu
 
 		season, err = w.service.CreateSeason(ctx, seasonParams)
 		if err != nil {
-			return fmt.Errorf("create season: %w", err)
+			return nil, fmt.Errorf("create season: %w", err)
 		}
 	}
 
@@ -387,7 +468,7 @@ func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResu
 
 		episode, err = w.service.CreateEpisode(ctx, episodeParams)
 		if err != nil {
-			return fmt.Errorf("create episode: %w", err)
+			return nil, fmt.Errorf("create episode: %w", err)
 		}
 	}
 
@@ -405,7 +486,7 @@ func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResu
 
 	_, err = w.service.CreateEpisodeFile(ctx, fileParams)
 	if err != nil {
-		return fmt.Errorf("create episode file: %w", err)
+		return nil, fmt.Errorf("create episode file: %w", err)
 	}
 
 	w.logger.Info("processed tv show file",
@@ -415,7 +496,7 @@ func (w *LibraryScanWorker) processFile(ctx context.Context, sr scanner.ScanResu
 		slog.Any("episode", util.SafeIntToInt32(*episodeNum)),
 	)
 
-	return nil
+	return series, nil
 }
 
 // =============================================================================
@@ -466,14 +547,18 @@ type MetadataRefreshWorker struct {
 	river.WorkerDefaults[MetadataRefreshArgs]
 	service   tvshow.Service
 	jobClient *infrajobs.Client
+	libraries *library.Service
+	nfo       *tvshow.NFOService
 	logger    *slog.Logger
 }
 
 // NewMetadataRefreshWorker creates a new metadata refresh worker.
-func NewMetadataRefreshWorker(service tvshow.Service, jobClient *infrajobs.Client, logger *slog.Logger) *MetadataRefreshWorker {
+func NewMetadataRefreshWorker(service tvshow.Service, jobClient *infrajobs.Client, libraries *library.Service, nfoService *tvshow.NFOService, logger *slog.Logger) *MetadataRefreshWorker {
 	return &MetadataRefreshWorker{
 		service:   service,
 		jobClient: jobClient,
+		libraries: libraries,
+		nfo:       nfoService,
 		logger:    logger.With("component", "tvshow_metadata_refresh"),
 	}
 }
@@ -483,8 +568,30 @@ func (w *MetadataRefreshWorker) Timeout(job *river.Job[MetadataRefreshArgs]) tim
 	return 15 * time.Minute
 }
 
+// metadataContext applies the provider policy of the library holding the
+// refreshed series, season or episode.
+func (w *MetadataRefreshWorker) metadataContext(ctx context.Context, args MetadataRefreshArgs) context.Context {
+	if w.libraries == nil {
+		return ctx
+	}
+	switch {
+	case args.EpisodeID != nil:
+		if ep, err := w.service.GetEpisode(ctx, *args.EpisodeID); err == nil {
+			return seriesMetadataContext(ctx, w.libraries, w.service, ep.SeriesID)
+		}
+	case args.SeasonID != nil:
+		if season, err := w.service.GetSeason(ctx, *args.SeasonID); err == nil {
+			return seriesMetadataContext(ctx, w.libraries, w.service, season.SeriesID)
+		}
+	case args.SeriesID != nil:
+		return seriesMetadataContext(ctx, w.libraries, w.service, *args.SeriesID)
+	}
+	return ctx
+}
+
 // Work executes the metadata refresh job.
 func (w *MetadataRefreshWorker) Work(ctx context.Context, job *river.Job[MetadataRefreshArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindMetadataRefresh)
 	args := job.Args
 
@@ -507,24 +614,25 @@ func (w *MetadataRefreshWorker) Work(ctx context.Context, job *river.Job[Metadat
 	// Determine what to refresh
 	switch {
 	case args.EpisodeID != nil:
-		if err := w.service.RefreshEpisodeMetadata(ctx, *args.EpisodeID, opts); err != nil {
+		if err := w.service.RefreshEpisodeMetadata(w.metadataContext(ctx, args), *args.EpisodeID, opts); err != nil {
 			result.AddError(fmt.Errorf("refresh episode %s: %w", args.EpisodeID, err))
 		} else {
 			result.ItemsProcessed++
 		}
 
 	case args.SeasonID != nil:
-		if err := w.service.RefreshSeasonMetadata(ctx, *args.SeasonID, opts); err != nil {
+		if err := w.service.RefreshSeasonMetadata(w.metadataContext(ctx, args), *args.SeasonID, opts); err != nil {
 			result.AddError(fmt.Errorf("refresh season %s: %w", args.SeasonID, err))
 		} else {
 			result.ItemsProcessed++
 		}
 
 	case args.SeriesID != nil:
-		if err := w.service.RefreshSeriesMetadata(ctx, *args.SeriesID, opts); err != nil {
+		if err := w.service.RefreshSeriesMetadata(w.metadataContext(ctx, args), *args.SeriesID, opts); err != nil {
 			result.AddError(fmt.Errorf("refresh series %s: %w", args.SeriesID, err))
 		} else {
 			result.ItemsProcessed++
+			applyNFOOverrides(ctx, w.nfo, w.logger, *args.SeriesID)
 		}
 
 	default:
@@ -552,10 +660,12 @@ func (w *MetadataRefreshWorker) Work(ctx context.Context, job *river.Job[Metadat
 
 			// Refresh each series in the batch
 			for _, s := range seriesList {
-				if err := w.service.RefreshSeriesMetadata(ctx, s.ID, opts); err != nil {
+				seriesCtx := seriesMetadataContext(ctx, w.libraries, w.service, s.ID)
+				if err := w.service.RefreshSeriesMetadata(seriesCtx, s.ID, opts); err != nil {
 					result.AddError(fmt.Errorf("refresh series %s (%s): %w", s.ID, s.Title, err))
 				} else {
 					result.ItemsProcessed++
+					applyNFOOverrides(ctx, w.nfo, w.logger, s.ID)
 				}
 
 				_ = w.jobClient.ReportProgress(ctx, job.ID, &infrajobs.JobProgress{
@@ -963,6 +1073,12 @@ func (w *SearchIndexWorker) indexSeries(ctx context.Context, seriesID uuid.UUID)
 		genres = nil
 	}
 
+	tags, err := w.service.GetSeriesTags(ctx, seriesID)
+	if err != nil {
+		w.logger.Warn("failed to get tags", slog.Any("error", err))
+		tags = nil
+	}
+
 	// Fetch all credits (use high limit for indexing)
 	cast, _, err := w.service.GetSeriesCast(ctx, seriesID, 1000, 0)
 	if err != nil {
@@ -997,7 +1113,7 @@ func (w *SearchIndexWorker) indexSeries(ctx context.Context, seriesID uuid.UUID)
 		}
 	}
 
-	if err := w.searchService.UpdateSeries(ctx, series, genres, credits, networks, hasFile); err != nil {
+	if err := w.searchService.UpdateSeries(ctx, series, genres, tags, credits, networks, hasFile); err != nil {
 		return fmt.Errorf("failed to index series: %w", err)
 	}
 
@@ -1149,14 +1265,18 @@ type SeriesRefreshWorker struct {
 	river.WorkerDefaults[SeriesRefreshArgs]
 	service   tvshow.Service
 	jobClient *infrajobs.Client
+	libraries *library.Service
+	nfo       *tvshow.NFOService
 	logger    *slog.Logger
 }
 
 // NewSeriesRefreshWorker creates a new series refresh worker.
-func NewSeriesRefreshWorker(service tvshow.Service, jobClient *infrajobs.Client, logger *slog.Logger) *SeriesRefreshWorker {
+func NewSeriesRefreshWorker(service tvshow.Service, jobClient *infrajobs.Client, libraries *library.Service, nfoService *tvshow.NFOService, logger *slog.Logger) *SeriesRefreshWorker {
 	return &SeriesRefreshWorker{
 		service:   service,
 		jobClient: jobClient,
+		libraries: libraries,
+		nfo:       nfoService,
 		logger:    logger.With("component", "tvshow_series_refresh"),
 	}
 }
@@ -1168,6 +1288,7 @@ func (w *SeriesRefreshWorker) Timeout(job *river.Job[SeriesRefreshArgs]) time.Du
 
 // Work executes the series refresh job.
 func (w *SeriesRefreshWorker) Work(ctx context.Context, job *river.Job[SeriesRefreshArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	jctx := sharedjobs.NewJobContext(ctx, w.logger, job.ID, KindSeriesRefresh)
 	args := job.Args
 
@@ -1186,6 +1307,9 @@ func (w *SeriesRefreshWorker) Work(ctx context.Context, job *river.Job[SeriesRef
 		Languages: args.Languages,
 	}
 
+	// Refreshes follow the provider policy of the series' library.
+	ctx = seriesMetadataContext(ctx, w.libraries, w.service, args.SeriesID)
+
 	// Refresh series metadata using the service
 	if err := w.service.RefreshSeriesMetadata(ctx, args.SeriesID, opts); err != nil {
 		result.AddError(fmt.Errorf("refresh series %s: %w", args.SeriesID, err))
@@ -1195,6 +1319,7 @@ func (w *SeriesRefreshWorker) Work(ctx context.Context, job *river.Job[SeriesRef
 		)
 	} else {
 		result.ItemsProcessed++
+		applyNFOOverrides(ctx, w.nfo, w.logger, args.SeriesID)
 		w.logger.Info("refreshed series metadata",
 			slog.String("series_id", args.SeriesID.String()),
 		)
@@ -1341,6 +1466,32 @@ func seriesToCreateParams(s *tvshow.Series) tvshow.CreateSeriesParams {
 	return params
 }
 
+// seriesFromNFO returns a series stub carrying the provider IDs from a
+// tvshow.nfo, or nil if there is no NFO or it has no TMDb, TVDb or IMDb ID.
+func seriesFromNFO(doc *nfo.TVShow, title string) *tvshow.Series {
+	if doc == nil || !doc.HasProviderID() {
+		return nil
+	}
+
+	return &tvshow.Series{
+		TMDbID: doc.TMDbID(),
+		TVDbID: doc.TVDbID(),
+		IMDbID: doc.IMDbID(),
+		Title:  title,
+	}
+}
+
+// applyNFOOverrides re-applies the series' tvshow.nfo after a metadata
+// refresh so the configured NFO fields are not replaced by provider data.
+func applyNFOOverrides(ctx context.Context, nfoService *tvshow.NFOService, logger *slog.Logger, seriesID uuid.UUID) {
+	if err := nfoService.ApplyOverrides(ctx, seriesID); err != nil {
+		logger.Warn("failed to apply tvshow.nfo overrides",
+			slog.String("series_id", seriesID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
 // findExistingByExternalID looks up a series by its external IDs (TVDB, TMDB, IMDB)
 // to detect entries created by other sync paths (e.g. Sonarr) that represent
 // the same show but were keyed differently.
@@ -1359,6 +1510,13 @@ func (w *LibraryScanWorker) findExistingByExternalID(ctx context.Context, newSer
 		}
 	}
 
+	// Try IMDB ID
+	if newSeries.IMDbID != nil && *newSeries.IMDbID != "" {
+		if existing, err := w.service.GetSeriesByIMDbID(ctx, *newSeries.IMDbID); err == nil {
+			return existing
+		}
+	}
+
 	return nil
 }
 
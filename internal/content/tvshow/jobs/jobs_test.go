@@ -5,14 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
+	"github.com/lusoris/revenge/internal/config"
 	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/shared/scanner"
 	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
 	"github.com/lusoris/revenge/internal/infra/logging"
 	"github.com/lusoris/revenge/internal/service/search"
@@ -366,7 +369,7 @@ func TestNewLibraryScanWorker(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
 
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.service)
@@ -379,7 +382,7 @@ func TestNewMetadataRefreshWorker(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMetadataRefreshWorker(nil, nil, logger)
+	worker := NewMetadataRefreshWorker(nil, nil, nil, nil, logger)
 
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.service)
@@ -417,7 +420,7 @@ func TestNewSeriesRefreshWorker(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewSeriesRefreshWorker(nil, nil, logger)
+	worker := NewSeriesRefreshWorker(nil, nil, nil, nil, logger)
 
 	assert.NotNil(t, worker)
 	assert.Nil(t, worker.service)
@@ -433,7 +436,7 @@ func TestLibraryScanWorker_Timeout(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
 
 	timeout := worker.Timeout(&river.Job[LibraryScanArgs]{})
 	assert.Equal(t, 30*time.Minute, timeout)
@@ -443,7 +446,7 @@ func TestMetadataRefreshWorker_Timeout(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewMetadataRefreshWorker(nil, nil, logger)
+	worker := NewMetadataRefreshWorker(nil, nil, nil, nil, logger)
 
 	timeout := worker.Timeout(&river.Job[MetadataRefreshArgs]{})
 	assert.Equal(t, 15*time.Minute, timeout)
@@ -473,7 +476,7 @@ func TestSeriesRefreshWorker_Timeout(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewSeriesRefreshWorker(nil, nil, logger)
+	worker := NewSeriesRefreshWorker(nil, nil, nil, nil, logger)
 
 	timeout := worker.Timeout(&river.Job[SeriesRefreshArgs]{})
 	assert.Equal(t, 10*time.Minute, timeout)
@@ -487,7 +490,7 @@ func TestLibraryScanWorker_Work_EmptyPaths(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
 
 	job := &river.Job[LibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 1, Kind: KindLibraryScan},
@@ -506,7 +509,7 @@ func TestLibraryScanWorker_Work_NilPaths(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
 
 	job := &river.Job[LibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 2, Kind: KindLibraryScan},
@@ -521,11 +524,33 @@ func TestLibraryScanWorker_Work_NilPaths(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestLibraryScanWorker_Work_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	logger := logging.NewTestLogger()
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
+
+	job := &river.Job[LibraryScanArgs]{
+		JobRow: &rivertype.JobRow{ID: 4, Kind: KindLibraryScan},
+		Args: LibraryScanArgs{
+			Paths: []string{t.TempDir()},
+		},
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(river.ErrJobCancelledRemotely)
+
+	// A cancelled scan cancels the job instead of failing it for a retry.
+	err := worker.Work(ctx, job)
+	var cancelErr *river.JobCancelError
+	assert.True(t, errors.As(err, &cancelErr))
+}
+
 func TestLibraryScanWorker_Work_NonexistentPaths(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
-	worker := NewLibraryScanWorker(nil, nil, nil, nil, logger)
+	worker := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
 
 	job := &river.Job[LibraryScanArgs]{
 		JobRow: &rivertype.JobRow{ID: 3, Kind: KindLibraryScan},
@@ -853,6 +878,14 @@ func (m *mockService) GetSeriesByTVDbID(ctx context.Context, tvdbID int32) (*tvs
 	return args.Get(0).(*tvshow.Series), args.Error(1)
 }
 
+func (m *mockService) GetSeriesByIMDbID(ctx context.Context, imdbID string) (*tvshow.Series, error) {
+	args := m.Called(ctx, imdbID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Series), args.Error(1)
+}
+
 func (m *mockService) GetSeriesBySonarrID(ctx context.Context, sonarrID int32) (*tvshow.Series, error) {
 	args := m.Called(ctx, sonarrID)
 	if args.Get(0) == nil {
@@ -871,17 +904,37 @@ func (m *mockService) CountSeries(ctx context.Context) (int64, error) {
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *mockService) ListSeriesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]tvshow.Series, error) {
+	args := m.Called(ctx, cutoffs, limit)
+	return args.Get(0).([]tvshow.Series), args.Error(1)
+}
+
+func (m *mockService) ListSeriesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]tvshow.Series, error) {
+	args := m.Called(ctx, after, limit)
+	return args.Get(0).([]tvshow.Series), args.Error(1)
+}
+
+func (m *mockService) ImportSeriesTags(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func (m *mockService) SearchSeries(ctx context.Context, query string, limit, offset int32) ([]tvshow.Series, error) {
 	args := m.Called(ctx, query, limit, offset)
 	return args.Get(0).([]tvshow.Series), args.Error(1)
 }
 
-func (m *mockService) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]tvshow.Series, int64, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *mockService) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]tvshow.Series, int64, error) {
+	args := m.Called(ctx, libraryPaths, limit, offset)
 	return args.Get(0).([]tvshow.Series), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *mockService) ListByGenre(ctx context.Context, slug string, limit, offset int32) ([]tvshow.Series, error) {
+func (m *mockService) ListByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]tvshow.Series, error) {
+	args := m.Called(ctx, slug, libraryPaths, limit, offset)
+	return args.Get(0).([]tvshow.Series), args.Error(1)
+}
+
+func (m *mockService) ListByTag(ctx context.Context, slug string, limit, offset int32) ([]tvshow.Series, error) {
 	args := m.Called(ctx, slug, limit, offset)
 	return args.Get(0).([]tvshow.Series), args.Error(1)
 }
@@ -1133,6 +1186,22 @@ func (m *mockService) GetSeriesGenres(ctx context.Context, seriesID uuid.UUID) (
 	return args.Get(0).([]tvshow.SeriesGenre), args.Error(1)
 }
 
+func (m *mockService) GetSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]tvshow.SeriesTag, error) {
+	args := m.Called(ctx, seriesID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]tvshow.SeriesTag), args.Error(1)
+}
+
+func (m *mockService) ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]content.TagSummary), args.Error(1)
+}
+
 func (m *mockService) ListDistinctGenres(ctx context.Context) ([]content.GenreSummary, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -1192,8 +1261,8 @@ func (m *mockService) RemoveSeriesProgress(ctx context.Context, userID, seriesID
 	return args.Error(0)
 }
 
-func (m *mockService) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]tvshow.ContinueWatchingItem, error) {
-	args := m.Called(ctx, userID, limit)
+func (m *mockService) GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]tvshow.ContinueWatchingItem, error) {
+	args := m.Called(ctx, userID, libraryPaths, limit)
 	return args.Get(0).([]tvshow.ContinueWatchingItem), args.Error(1)
 }
 
@@ -1205,6 +1274,11 @@ func (m *mockService) GetNextEpisode(ctx context.Context, userID, seriesID uuid.
 	return args.Get(0).(*tvshow.Episode), args.Error(1)
 }
 
+func (m *mockService) ListNextUp(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]tvshow.NextUpItem, error) {
+	args := m.Called(ctx, userID, libraryPaths, limit)
+	return args.Get(0).([]tvshow.NextUpItem), args.Error(1)
+}
+
 func (m *mockService) GetSeriesWatchStats(ctx context.Context, userID, seriesID uuid.UUID) (*tvshow.SeriesWatchStats, error) {
 	args := m.Called(ctx, userID, seriesID)
 	if args.Get(0) == nil {
@@ -1236,6 +1310,90 @@ func (m *mockService) RefreshEpisodeMetadata(ctx context.Context, id uuid.UUID,
 	return args.Error(0)
 }
 
+func (m *mockService) UpdateSeriesMetadata(ctx context.Context, id uuid.UUID, edit tvshow.SeriesMetadataEdit) (*tvshow.Series, error) {
+	args := m.Called(ctx, id, edit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Series), args.Error(1)
+}
+
+func (m *mockService) UpdateSeasonMetadata(ctx context.Context, id uuid.UUID, edit tvshow.SeasonMetadataEdit) (*tvshow.Season, error) {
+	args := m.Called(ctx, id, edit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Season), args.Error(1)
+}
+
+func (m *mockService) UpdateEpisodeMetadata(ctx context.Context, id uuid.UUID, edit tvshow.EpisodeMetadataEdit) (*tvshow.Episode, error) {
+	args := m.Called(ctx, id, edit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Episode), args.Error(1)
+}
+
+func (m *mockService) UnlockSeriesFields(ctx context.Context, id uuid.UUID, fields ...string) (*tvshow.Series, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Series), args.Error(1)
+}
+
+func (m *mockService) UnlockSeasonFields(ctx context.Context, id uuid.UUID, fields ...string) (*tvshow.Season, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Season), args.Error(1)
+}
+
+func (m *mockService) UnlockEpisodeFields(ctx context.Context, id uuid.UUID, fields ...string) (*tvshow.Episode, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Episode), args.Error(1)
+}
+
+func (m *mockService) RestoreSeriesMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*tvshow.Series, error) {
+	args := m.Called(ctx, id, values)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Series), args.Error(1)
+}
+
+func (m *mockService) RestoreSeasonMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*tvshow.Season, error) {
+	args := m.Called(ctx, id, values)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Season), args.Error(1)
+}
+
+func (m *mockService) RestoreEpisodeMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*tvshow.Episode, error) {
+	args := m.Called(ctx, id, values)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tvshow.Episode), args.Error(1)
+}
+
+func (m *mockService) SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
+func (m *mockService) SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
+func (m *mockService) SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
 // Verify mock implements interface
 var _ tvshow.Service = (*mockService)(nil)
 
@@ -1286,6 +1444,14 @@ func (m *mockMetadataProvider) GetSeriesGenres(ctx context.Context, seriesID uui
 	return args.Get(0).([]tvshow.SeriesGenre), args.Error(1)
 }
 
+func (m *mockMetadataProvider) GetSeriesTags(ctx context.Context, seriesID uuid.UUID, providerID string) ([]tvshow.SeriesTag, error) {
+	args := m.Called(ctx, seriesID, providerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]tvshow.SeriesTag), args.Error(1)
+}
+
 func (m *mockMetadataProvider) GetSeriesNetworks(ctx context.Context, providerID string) ([]tvshow.Network, error) {
 	args := m.Called(ctx, providerID)
 	if args.Get(0) == nil {
@@ -1310,7 +1476,7 @@ func TestMetadataRefreshWorker_Work_RefreshEpisode(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	episodeID := uuid.Must(uuid.NewV7())
 	job := &river.Job[MetadataRefreshArgs]{
@@ -1335,7 +1501,7 @@ func TestMetadataRefreshWorker_Work_RefreshEpisode_Error(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	episodeID := uuid.Must(uuid.NewV7())
 	job := &river.Job[MetadataRefreshArgs]{
@@ -1360,7 +1526,7 @@ func TestMetadataRefreshWorker_Work_RefreshSeason(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seasonID := uuid.Must(uuid.NewV7())
 	job := &river.Job[MetadataRefreshArgs]{
@@ -1385,7 +1551,7 @@ func TestMetadataRefreshWorker_Work_RefreshSeason_Error(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seasonID := uuid.Must(uuid.NewV7())
 	job := &river.Job[MetadataRefreshArgs]{
@@ -1410,7 +1576,7 @@ func TestMetadataRefreshWorker_Work_RefreshSeries(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	job := &river.Job[MetadataRefreshArgs]{
@@ -1435,7 +1601,7 @@ func TestMetadataRefreshWorker_Work_RefreshSeries_Error(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	job := &river.Job[MetadataRefreshArgs]{
@@ -1460,7 +1626,7 @@ func TestMetadataRefreshWorker_Work_RefreshAll_EmptyList(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	job := &river.Job[MetadataRefreshArgs]{
 		JobRow: &rivertype.JobRow{ID: 4, Kind: KindMetadataRefresh},
@@ -1487,7 +1653,7 @@ func TestMetadataRefreshWorker_Work_RefreshAll_DefaultBatchSize(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	job := &river.Job[MetadataRefreshArgs]{
 		JobRow: &rivertype.JobRow{ID: 5, Kind: KindMetadataRefresh},
@@ -1511,7 +1677,7 @@ func TestMetadataRefreshWorker_Work_RefreshAll_WithSeries(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID1 := uuid.Must(uuid.NewV7())
 	seriesID2 := uuid.Must(uuid.NewV7())
@@ -1550,7 +1716,7 @@ func TestMetadataRefreshWorker_Work_RefreshAll_ListError(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	job := &river.Job[MetadataRefreshArgs]{
 		JobRow: &rivertype.JobRow{ID: 7, Kind: KindMetadataRefresh},
@@ -1575,7 +1741,7 @@ func TestMetadataRefreshWorker_Work_RefreshAll_PartialErrors(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewMetadataRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID1 := uuid.Must(uuid.NewV7())
 	seriesID2 := uuid.Must(uuid.NewV7())
@@ -1618,7 +1784,7 @@ func TestSeriesRefreshWorker_Work_Success(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	job := &river.Job[SeriesRefreshArgs]{
@@ -1638,12 +1804,45 @@ func TestSeriesRefreshWorker_Work_Success(t *testing.T) {
 	svc.AssertExpectations(t)
 }
 
+func TestSeriesRefreshWorker_Work_AppliesNFOOverrides(t *testing.T) {
+	t.Parallel()
+
+	logger := logging.NewTestLogger()
+	svc := new(mockService)
+	nfoService := tvshow.NewNFOService(svc, config.NFOConfig{Import: true, OverrideFields: []string{"overview"}})
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nfoService, logger)
+
+	seriesID := uuid.Must(uuid.NewV7())
+	episodeID := uuid.Must(uuid.NewV7())
+	tmpFile := createTempFileWithName(t, "show.s01e01.mkv")
+	nfoPath := filepath.Join(filepath.Dir(tmpFile), "tvshow.nfo")
+	require.NoError(t, os.WriteFile(nfoPath, []byte(`<tvshow><title>Show</title><plot>Curated plot</plot></tvshow>`), 0o644))
+
+	job := &river.Job[SeriesRefreshArgs]{
+		JobRow: &rivertype.JobRow{ID: 1, Kind: KindSeriesRefresh},
+		Args:   SeriesRefreshArgs{SeriesID: seriesID},
+	}
+
+	provider := "Provider plot"
+	svc.On("RefreshSeriesMetadata", mock.Anything, seriesID, []tvshow.MetadataRefreshOptions{{}}).Return(nil)
+	svc.On("ListEpisodesBySeries", mock.Anything, seriesID).Return([]tvshow.Episode{{ID: episodeID, SeriesID: seriesID}}, nil)
+	svc.On("ListEpisodeFiles", mock.Anything, episodeID).Return([]tvshow.EpisodeFile{{EpisodeID: episodeID, FilePath: tmpFile}}, nil)
+	svc.On("GetSeries", mock.Anything, seriesID).Return(&tvshow.Series{ID: seriesID, Title: "Show", Overview: &provider}, nil)
+	svc.On("UpdateSeries", mock.Anything, mock.MatchedBy(func(p tvshow.UpdateSeriesParams) bool {
+		return p.ID == seriesID && p.Title == nil && p.Overview != nil && *p.Overview == "Curated plot"
+	})).Return(&tvshow.Series{ID: seriesID}, nil)
+
+	err := worker.Work(context.Background(), job)
+	require.NoError(t, err)
+	svc.AssertExpectations(t)
+}
+
 func TestSeriesRefreshWorker_Work_RefreshError(t *testing.T) {
 	t.Parallel()
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	job := &river.Job[SeriesRefreshArgs]{
@@ -1668,7 +1867,7 @@ func TestSeriesRefreshWorker_Work_WithSeasons(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID1 := uuid.Must(uuid.NewV7())
@@ -1701,7 +1900,7 @@ func TestSeriesRefreshWorker_Work_WithEpisodes(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -1738,7 +1937,7 @@ func TestSeriesRefreshWorker_Work_WithSeasonsAndEpisodes(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -1774,7 +1973,7 @@ func TestSeriesRefreshWorker_Work_ListSeasonsError(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	job := &river.Job[SeriesRefreshArgs]{
@@ -1800,7 +1999,7 @@ func TestSeriesRefreshWorker_Work_ListEpisodesError(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, logger)
+	worker := NewSeriesRefreshWorker(svc, &infrajobs.Client{}, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -2549,7 +2748,7 @@ func TestProcessFile_EmptyTitle(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	sr := scanner.ScanResult{
 		FilePath:    "/tmp/test.mkv",
@@ -2561,7 +2760,7 @@ func TestProcessFile_EmptyTitle(t *testing.T) {
 		IsMedia: true,
 	}
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "could not parse series title")
 }
@@ -2571,7 +2770,7 @@ func TestProcessFile_NoSeasonEpisode(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	sr := scanner.ScanResult{
 		FilePath:    "/tmp/test.mkv",
@@ -2580,7 +2779,7 @@ func TestProcessFile_NoSeasonEpisode(t *testing.T) {
 		IsMedia:     true,
 	}
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "could not parse season/episode")
 }
@@ -2590,7 +2789,7 @@ func TestProcessFile_SearchSeriesError(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	sr := scanner.ScanResult{
 		FilePath:    "/tmp/test.mkv",
@@ -2604,7 +2803,7 @@ func TestProcessFile_SearchSeriesError(t *testing.T) {
 
 	svc.On("SearchSeries", mock.Anything, "Some Show", int32(5), int32(0)).Return([]tvshow.Series{}, errors.New("db error"))
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "search series")
 	svc.AssertExpectations(t)
@@ -2616,7 +2815,7 @@ func TestProcessFile_ExactMatch_ExistingEpisode(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -2653,8 +2852,141 @@ func TestProcessFile_ExactMatch_ExistingEpisode(t *testing.T) {
 		FilePath:  tmpFile,
 	}, nil)
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
+	require.NoError(t, err)
+	svc.AssertExpectations(t)
+}
+
+func TestProcessFile_NFOIdentifiesExistingSeries(t *testing.T) {
+	t.Parallel()
+
+	logger := logging.NewTestLogger()
+	svc := new(mockService)
+	mdp := new(mockMetadataProvider)
+	nfoService := tvshow.NewNFOService(svc, config.NFOConfig{Import: true})
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nfoService, logger)
+
+	seriesID := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	episodeID := uuid.Must(uuid.NewV7())
+
+	tmpFile := createTempFileWithName(t, "bb.s02e03.mkv")
+	nfoPath := filepath.Join(filepath.Dir(tmpFile), "tvshow.nfo")
+	require.NoError(t, os.WriteFile(nfoPath, []byte(`<tvshow><title>Breaking Bad</title><uniqueid type="tmdb">1396</uniqueid></tvshow>`), 0o644))
+
+	sr := scanner.ScanResult{
+		FilePath:    tmpFile,
+		ParsedTitle: "bb",
+		Metadata: map[string]any{
+			"season":  2,
+			"episode": 3,
+		},
+		IsMedia: true,
+	}
+
+	svc.On("GetSeriesByTMDbID", mock.Anything, int32(1396)).Return(&tvshow.Series{ID: seriesID, Title: "Breaking Bad"}, nil)
+	svc.On("GetSeasonByNumber", mock.Anything, seriesID, int32(2)).Return(&tvshow.Season{ID: seasonID, SeriesID: seriesID}, nil)
+	svc.On("GetEpisodeByNumber", mock.Anything, seriesID, int32(2), int32(3)).Return(&tvshow.Episode{ID: episodeID, SeasonID: seasonID}, nil)
+	svc.On("CreateEpisodeFile", mock.Anything, mock.Anything).Return(&tvshow.EpisodeFile{
+		ID:        uuid.Must(uuid.NewV7()),
+		EpisodeID: episodeID,
+		FilePath:  tmpFile,
+	}, nil)
+
+	_, err := worker.processFile(context.Background(), sr)
+	require.NoError(t, err)
+	svc.AssertNotCalled(t, "SearchSeries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mdp.AssertNotCalled(t, "SearchSeries", mock.Anything, mock.Anything, mock.Anything)
+	svc.AssertExpectations(t)
+}
+
+func TestProcessFile_NFOIdentifiesSeriesByIMDbID(t *testing.T) {
+	t.Parallel()
+
+	logger := logging.NewTestLogger()
+	svc := new(mockService)
+	mdp := new(mockMetadataProvider)
+	nfoService := tvshow.NewNFOService(svc, config.NFOConfig{Import: true, OverrideFields: []string{"title"}})
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nfoService, logger)
+
+	seriesID := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	episodeID := uuid.Must(uuid.NewV7())
+
+	tmpFile := createTempFileWithName(t, "bb.s02e03.mkv")
+	nfoPath := filepath.Join(filepath.Dir(tmpFile), "tvshow.nfo")
+	require.NoError(t, os.WriteFile(nfoPath, []byte(`<tvshow><title>Breaking Bad (Director's Cut)</title><uniqueid type="tvdb">81189</uniqueid><uniqueid type="imdb">tt0903747</uniqueid></tvshow>`), 0o644))
+
+	sr := scanner.ScanResult{
+		FilePath:    tmpFile,
+		ParsedTitle: "bb",
+		Metadata: map[string]any{
+			"season":  2,
+			"episode": 3,
+		},
+		IsMedia: true,
+	}
+
+	series := &tvshow.Series{ID: seriesID, Title: "Breaking Bad"}
+	svc.On("GetSeriesByTVDbID", mock.Anything, int32(81189)).Return(nil, errors.New("not found"))
+	svc.On("GetSeriesByIMDbID", mock.Anything, "tt0903747").Return(series, nil)
+	svc.On("UpdateSeries", mock.Anything, mock.MatchedBy(func(p tvshow.UpdateSeriesParams) bool {
+		return p.ID == seriesID && p.Title != nil && *p.Title == "Breaking Bad (Director's Cut)"
+	})).Return(&tvshow.Series{ID: seriesID, Title: "Breaking Bad (Director's Cut)"}, nil)
+	svc.On("GetSeasonByNumber", mock.Anything, seriesID, int32(2)).Return(&tvshow.Season{ID: seasonID, SeriesID: seriesID}, nil)
+	svc.On("GetEpisodeByNumber", mock.Anything, seriesID, int32(2), int32(3)).Return(&tvshow.Episode{ID: episodeID, SeasonID: seasonID}, nil)
+	svc.On("CreateEpisodeFile", mock.Anything, mock.Anything).Return(&tvshow.EpisodeFile{
+		ID:        uuid.Must(uuid.NewV7()),
+		EpisodeID: episodeID,
+		FilePath:  tmpFile,
+	}, nil)
+
+	result, err := worker.processFile(context.Background(), sr)
 	require.NoError(t, err)
+	assert.Equal(t, "Breaking Bad (Director's Cut)", result.Title)
+	svc.AssertNotCalled(t, "SearchSeries", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	svc.AssertExpectations(t)
+}
+
+func TestProcessFile_NFOImportDisabled(t *testing.T) {
+	t.Parallel()
+
+	logger := logging.NewTestLogger()
+	svc := new(mockService)
+	mdp := new(mockMetadataProvider)
+	nfoService := tvshow.NewNFOService(svc, config.NFOConfig{Import: false})
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nfoService, logger)
+
+	seriesID := uuid.Must(uuid.NewV7())
+	seasonID := uuid.Must(uuid.NewV7())
+	episodeID := uuid.Must(uuid.NewV7())
+
+	tmpFile := createTempFileWithName(t, "bb.s02e03.mkv")
+	nfoPath := filepath.Join(filepath.Dir(tmpFile), "tvshow.nfo")
+	require.NoError(t, os.WriteFile(nfoPath, []byte(`<tvshow><title>Breaking Bad</title><uniqueid type="tmdb">1396</uniqueid></tvshow>`), 0o644))
+
+	sr := scanner.ScanResult{
+		FilePath:    tmpFile,
+		ParsedTitle: "bb",
+		Metadata: map[string]any{
+			"season":  2,
+			"episode": 3,
+		},
+		IsMedia: true,
+	}
+
+	svc.On("SearchSeries", mock.Anything, "bb", int32(5), int32(0)).Return([]tvshow.Series{{ID: seriesID, Title: "BB"}}, nil)
+	svc.On("GetSeasonByNumber", mock.Anything, seriesID, int32(2)).Return(&tvshow.Season{ID: seasonID, SeriesID: seriesID}, nil)
+	svc.On("GetEpisodeByNumber", mock.Anything, seriesID, int32(2), int32(3)).Return(&tvshow.Episode{ID: episodeID, SeasonID: seasonID}, nil)
+	svc.On("CreateEpisodeFile", mock.Anything, mock.Anything).Return(&tvshow.EpisodeFile{
+		ID:        uuid.Must(uuid.NewV7()),
+		EpisodeID: episodeID,
+		FilePath:  tmpFile,
+	}, nil)
+
+	_, err := worker.processFile(context.Background(), sr)
+	require.NoError(t, err)
+	svc.AssertNotCalled(t, "GetSeriesByTMDbID", mock.Anything, mock.Anything)
 	svc.AssertExpectations(t)
 }
 
@@ -2664,7 +2996,7 @@ func TestProcessFile_NoMatch_CreateFromTMDb(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -2711,7 +3043,7 @@ func TestProcessFile_NoMatch_CreateFromTMDb(t *testing.T) {
 		FilePath:  tmpFile,
 	}, nil)
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.NoError(t, err)
 	svc.AssertExpectations(t)
 	mdp.AssertExpectations(t)
@@ -2723,7 +3055,7 @@ func TestProcessFile_TMDbNotFound(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	sr := scanner.ScanResult{
 		FilePath:    "/tmp/test.mkv",
@@ -2738,7 +3070,7 @@ func TestProcessFile_TMDbNotFound(t *testing.T) {
 	svc.On("SearchSeries", mock.Anything, "Unknown Series", int32(5), int32(0)).Return([]tvshow.Series{}, nil)
 	mdp.On("SearchSeries", mock.Anything, "Unknown Series", (*int)(nil)).Return([]*tvshow.Series{}, nil)
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "series not found")
 	svc.AssertExpectations(t)
@@ -2751,7 +3083,7 @@ func TestProcessFile_CreateSeriesFails(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	tmdbID := int32(500)
 
@@ -2772,7 +3104,7 @@ func TestProcessFile_CreateSeriesFails(t *testing.T) {
 	mdp.On("EnrichSeries", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 	svc.On("CreateSeries", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "create series")
 	svc.AssertExpectations(t)
@@ -2785,7 +3117,7 @@ func TestProcessFile_CreateSeasonFails(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 
@@ -2806,7 +3138,7 @@ func TestProcessFile_CreateSeasonFails(t *testing.T) {
 	mdp.On("EnrichSeason", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("enrich failed"))
 	svc.On("CreateSeason", mock.Anything, mock.Anything).Return(nil, errors.New("create season failed"))
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "create season")
 	svc.AssertExpectations(t)
@@ -2817,7 +3149,7 @@ func TestProcessFile_CreateEpisodeFails(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -2842,7 +3174,7 @@ func TestProcessFile_CreateEpisodeFails(t *testing.T) {
 	svc.On("GetEpisodeByNumber", mock.Anything, seriesID, int32(1), int32(1)).Return(nil, errors.New("not found"))
 	svc.On("CreateEpisode", mock.Anything, mock.Anything).Return(nil, errors.New("create episode failed"))
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "create episode")
 	svc.AssertExpectations(t)
@@ -2853,7 +3185,7 @@ func TestProcessFile_CreateEpisodeFileFails(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -2884,7 +3216,7 @@ func TestProcessFile_CreateEpisodeFileFails(t *testing.T) {
 	}, nil)
 	svc.On("CreateEpisodeFile", mock.Anything, mock.Anything).Return(nil, errors.New("create file failed"))
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "create episode file")
 	svc.AssertExpectations(t)
@@ -2895,7 +3227,7 @@ func TestProcessFile_WithEpisodeTitle(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	seriesID := uuid.Must(uuid.NewV7())
 	seasonID := uuid.Must(uuid.NewV7())
@@ -2934,7 +3266,7 @@ func TestProcessFile_WithEpisodeTitle(t *testing.T) {
 		FilePath:  tmpFile,
 	}, nil)
 
-	err := worker.processFile(context.Background(), sr)
+	_, err := worker.processFile(context.Background(), sr)
 	require.NoError(t, err)
 	svc.AssertExpectations(t)
 }
@@ -2948,7 +3280,7 @@ func TestLibraryScanWorker_Work_ScanError(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	// Use a non-existent path to trigger scan error
 	job := &river.Job[LibraryScanArgs]{
@@ -2969,7 +3301,7 @@ func TestLibraryScanWorker_Work_WithMediaFiles_NoAutoCreate(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	// Create a temp dir with a parseable media file
 	dir := t.TempDir()
@@ -3002,7 +3334,7 @@ func TestLibraryScanWorker_Work_WithAutoCreate_AlreadyMatched(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	dir := t.TempDir()
 	filePath := dir + "/Show.S01E01.mkv"
@@ -3038,7 +3370,7 @@ func TestLibraryScanWorker_Work_WithAutoCreate_ProcessFile(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	dir := t.TempDir()
 	filePath := dir + "/Good.Show.S01E01.mkv"
@@ -3091,7 +3423,7 @@ func TestLibraryScanWorker_Work_WithAutoCreate_ProcessFileFails(t *testing.T) {
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
 	mdp := new(mockMetadataProvider)
-	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, mdp, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	dir := t.TempDir()
 	filePath := dir + "/Bad.Show.S01E01.mkv"
@@ -3125,7 +3457,7 @@ func TestLibraryScanWorker_Work_ForceRescan(t *testing.T) {
 
 	logger := logging.NewTestLogger()
 	svc := new(mockService)
-	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, logger)
+	worker := NewLibraryScanWorker(svc, nil, &infrajobs.Client{}, nil, nil, nil, nil, logger)
 
 	dir := t.TempDir()
 	filePath := dir + "/Force.Show.S01E01.mkv"
@@ -3167,13 +3499,14 @@ func TestRegisterWorkers(t *testing.T) {
 	logger := logging.NewTestLogger()
 	workers := river.NewWorkers()
 
-	libraryScan := NewLibraryScanWorker(nil, nil, nil, nil, logger)
-	metadataRefresh := NewMetadataRefreshWorker(nil, nil, logger)
+	libraryScan := NewLibraryScanWorker(nil, nil, nil, nil, nil, nil, nil, logger)
+	metadataRefresh := NewMetadataRefreshWorker(nil, nil, nil, nil, logger)
 	fileMatch := NewFileMatchWorker(nil, nil, logger)
 	searchIndex := NewSearchIndexWorker(nil, nil, nil, nil, logger)
-	seriesRefresh := NewSeriesRefreshWorker(nil, nil, logger)
+	seriesRefresh := NewSeriesRefreshWorker(nil, nil, nil, nil, logger)
+	nfoExport := NewNFOExportWorker(nil, logger)
 
-	err := RegisterWorkers(workers, libraryScan, metadataRefresh, fileMatch, searchIndex, seriesRefresh)
+	err := RegisterWorkers(workers, libraryScan, metadataRefresh, fileMatch, searchIndex, seriesRefresh, nfoExport)
 	assert.NoError(t, err)
 }
 
@@ -3206,6 +3539,9 @@ func TestProviderFunctions(t *testing.T) {
 
 	srWorker := provideSeriesRefreshWorker(params)
 	assert.NotNil(t, srWorker)
+
+	nfoWorker := provideNFOExportWorker(params)
+	assert.NotNil(t, nfoWorker)
 }
 
 func TestProviderFunctions_NilOptionalDeps(t *testing.T) {
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// seriesMetadataContext attaches the provider policy of the library holding
+// the series' files to ctx. The library is found through the first episode
+// that has a file; without one ctx is returned unchanged.
+func seriesMetadataContext(ctx context.Context, libraries *library.Service, service tvshow.Service, seriesID uuid.UUID) context.Context {
+	if libraries == nil {
+		return ctx
+	}
+	episodes, err := service.ListEpisodesBySeries(ctx, seriesID)
+	if err != nil {
+		return ctx
+	}
+	for _, ep := range episodes {
+		files, err := service.ListEpisodeFiles(ctx, ep.ID)
+		if err != nil || len(files) == 0 {
+			continue
+		}
+		return libraries.MetadataContextForPath(ctx, library.LibraryTypeTVShow, files[0].FilePath)
+	}
+	return ctx
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/library"
 	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/service/search"
 )
@@ -20,6 +22,7 @@ var Module = fx.Module("tvshowjobs",
 		provideFileMatchWorker,
 		provideSearchIndexWorker,
 		provideSeriesRefreshWorker,
+		provideNFOExportWorker,
 	),
 	fx.Invoke(RegisterWorkers),
 )
@@ -35,17 +38,20 @@ type WorkerProviderParams struct {
 	SeasonSearchService  *search.SeasonSearchService  `optional:"true"`
 	JobClient            *infrajobs.Client
 	NotificationService  notification.Service
+	ArtworkService       *artwork.Service `optional:"true"`
+	ScanStatusService    *library.Service `optional:"true"`
+	NFOService           *tvshow.NFOService
 	Logger               *slog.Logger
 }
 
 // provideLibraryScanWorker creates a library scan worker with optional metadata provider.
 func provideLibraryScanWorker(p WorkerProviderParams) *LibraryScanWorker {
-	return NewLibraryScanWorker(p.Service, p.MetadataProvider, p.JobClient, p.NotificationService, p.Logger)
+	return NewLibraryScanWorker(p.Service, p.MetadataProvider, p.JobClient, p.NotificationService, p.ArtworkService, p.ScanStatusService, p.NFOService, p.Logger)
 }
 
 // provideMetadataRefreshWorker creates a metadata refresh worker.
 func provideMetadataRefreshWorker(p WorkerProviderParams) *MetadataRefreshWorker {
-	return NewMetadataRefreshWorker(p.Service, p.JobClient, p.Logger)
+	return NewMetadataRefreshWorker(p.Service, p.JobClient, p.ScanStatusService, p.NFOService, p.Logger)
 }
 
 // provideFileMatchWorker creates a file match worker with optional metadata provider.
@@ -60,7 +66,12 @@ func provideSearchIndexWorker(p WorkerProviderParams) *SearchIndexWorker {
 
 // provideSeriesRefreshWorker creates a series refresh worker.
 func provideSeriesRefreshWorker(p WorkerProviderParams) *SeriesRefreshWorker {
-	return NewSeriesRefreshWorker(p.Service, p.JobClient, p.Logger)
+	return NewSeriesRefreshWorker(p.Service, p.JobClient, p.ScanStatusService, p.NFOService, p.Logger)
+}
+
+// provideNFOExportWorker creates an NFO export worker.
+func provideNFOExportWorker(p WorkerProviderParams) *NFOExportWorker {
+	return NewNFOExportWorker(p.NFOService, p.Logger)
 }
 
 // RegisterWorkers registers all TV show job workers with the River workers registry.
@@ -71,12 +82,14 @@ func RegisterWorkers(
 	fileMatchWorker *FileMatchWorker,
 	searchIndexWorker *SearchIndexWorker,
 	seriesRefreshWorker *SeriesRefreshWorker,
+	nfoExportWorker *NFOExportWorker,
 ) error {
 	river.AddWorker(workers, libraryScanWorker)
 	river.AddWorker(workers, metadataRefreshWorker)
 	river.AddWorker(workers, fileMatchWorker)
 	river.AddWorker(workers, searchIndexWorker)
 	river.AddWorker(workers, seriesRefreshWorker)
+	river.AddWorker(workers, nfoExportWorker)
 	return nil
 }
 
@@ -90,6 +103,7 @@ type RegisterWorkersParams struct {
 	FileMatchWorker       *FileMatchWorker
 	SearchIndexWorker     *SearchIndexWorker `optional:"true"`
 	SeriesRefreshWorker   *SeriesRefreshWorker
+	NFOExportWorker       *NFOExportWorker
 	TVShowService         tvshow.Service
 	MetadataProvider      tvshow.MetadataProvider `optional:"true"`
 	Logger                *slog.Logger
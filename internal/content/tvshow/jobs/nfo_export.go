@@ -0,0 +1,100 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+)
+
+// NFOExportArgs defines arguments for TV show NFO export jobs.
+type NFOExportArgs struct {
+	// SeriesID limits the export to one series. Nil exports the whole library.
+	SeriesID *uuid.UUID `json:"series_id,omitempty"`
+}
+
+// Kind returns the unique job kind identifier.
+func (NFOExportArgs) Kind() string {
+	return KindNFOExport
+}
+
+// InsertOpts returns the default insert options. Full exports touch every
+// show folder, so they run on the bulk queue.
+func (NFOExportArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueBulk,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// NFOExportWorker writes tvshow.nfo and episode NFO files next to episode files.
+type NFOExportWorker struct {
+	river.WorkerDefaults[NFOExportArgs]
+	nfo    *tvshow.NFOService
+	logger *slog.Logger
+}
+
+// NewNFOExportWorker creates a new NFO export worker.
+func NewNFOExportWorker(nfoService *tvshow.NFOService, logger *slog.Logger) *NFOExportWorker {
+	return &NFOExportWorker{
+		nfo:    nfoService,
+		logger: logger.With("component", "tvshow_nfo_export"),
+	}
+}
+
+// Timeout returns the maximum execution time for NFO export jobs.
+func (w *NFOExportWorker) Timeout(job *river.Job[NFOExportArgs]) time.Duration {
+	if job.Args.SeriesID != nil {
+		return 5 * time.Minute
+	}
+	return 30 * time.Minute
+}
+
+// Work executes the NFO export job.
+func (w *NFOExportWorker) Work(ctx context.Context, job *river.Job[NFOExportArgs]) error {
+	if seriesID := job.Args.SeriesID; seriesID != nil {
+		written, err := w.nfo.ExportSeries(ctx, *seriesID)
+		if err != nil {
+			w.logger.Error("tvshow nfo export failed",
+				slog.String("series_id", seriesID.String()),
+				slog.Any("error", err),
+			)
+			return fmt.Errorf("tvshow nfo export failed: %w", err)
+		}
+
+		w.logger.Info("tvshow nfo export completed",
+			slog.String("series_id", seriesID.String()),
+			slog.Int("files", written),
+		)
+		return nil
+	}
+
+	w.logger.Info("starting tvshow library nfo export", slog.Int64("job_id", job.ID))
+
+	summary, err := w.nfo.ExportLibrary(ctx)
+	if err != nil {
+		return fmt.Errorf("tvshow library nfo export failed: %w", err)
+	}
+
+	for _, exportErr := range summary.Errors {
+		w.logger.Warn("tvshow nfo export error", slog.Any("error", exportErr))
+	}
+
+	w.logger.Info("tvshow library nfo export completed",
+		slog.Int("series", summary.Series),
+		slog.Int("files", summary.Files),
+		slog.Int("errors", len(summary.Errors)),
+	)
+
+	return nil
+}
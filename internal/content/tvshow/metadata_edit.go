@@ -0,0 +1,358 @@
+package tvshow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// SeriesMetadataEdit is a manual series metadata correction. Nil fields are
+// left unchanged; every set field is locked against automatic updates.
+type SeriesMetadataEdit struct {
+	Title         *string
+	SortTitle     *string
+	OriginalTitle *string
+	Overview      *string
+	Tagline       *string
+	FirstAirDate  *time.Time
+	Genres        []string // Genre names; nil leaves genres unchanged
+	VoteAverage   *float64
+	PosterPath    *string
+	BackdropPath  *string
+}
+
+// SeasonMetadataEdit is a manual season metadata correction.
+type SeasonMetadataEdit struct {
+	Name        *string
+	Overview    *string
+	AirDate     *time.Time
+	VoteAverage *float64
+	PosterPath  *string
+}
+
+// EpisodeMetadataEdit is a manual episode metadata correction.
+type EpisodeMetadataEdit struct {
+	Title       *string
+	Overview    *string
+	AirDate     *time.Time
+	VoteAverage *float64
+	StillPath   *string
+}
+
+func formatDate(t *time.Time) *string {
+	d := t.Format("2006-01-02")
+	return &d
+}
+
+func formatVote(v *float64) *string {
+	s := strconv.FormatFloat(*v, 'f', -1, 64)
+	return &s
+}
+
+// updateParams converts the edit to update params and returns the fields it
+// touches.
+func (e SeriesMetadataEdit) updateParams(id uuid.UUID) (UpdateSeriesParams, []string) {
+	params := UpdateSeriesParams{ID: id}
+	var fields []string
+
+	if e.Title != nil {
+		params.Title = e.Title
+		fields = append(fields, content.FieldTitle)
+	}
+	if e.SortTitle != nil {
+		params.SortTitle = e.SortTitle
+		fields = append(fields, content.FieldSortTitle)
+	}
+	if e.OriginalTitle != nil {
+		params.OriginalTitle = e.OriginalTitle
+		fields = append(fields, content.FieldOriginalTitle)
+	}
+	if e.Overview != nil {
+		params.Overview = e.Overview
+		fields = append(fields, content.FieldOverview)
+	}
+	if e.Tagline != nil {
+		params.Tagline = e.Tagline
+		fields = append(fields, content.FieldTagline)
+	}
+	if e.FirstAirDate != nil {
+		params.FirstAirDate = formatDate(e.FirstAirDate)
+		fields = append(fields, content.FieldReleaseDate)
+	}
+	if e.Genres != nil {
+		fields = append(fields, content.FieldGenres)
+	}
+	if e.VoteAverage != nil {
+		params.VoteAverage = formatVote(e.VoteAverage)
+		fields = append(fields, content.FieldRatings)
+	}
+	if e.PosterPath != nil {
+		params.PosterPath = e.PosterPath
+		fields = append(fields, content.FieldPoster)
+	}
+	if e.BackdropPath != nil {
+		params.BackdropPath = e.BackdropPath
+		fields = append(fields, content.FieldBackdrop)
+	}
+	return params, fields
+}
+
+func (e SeasonMetadataEdit) updateParams(id uuid.UUID) (UpdateSeasonParams, []string) {
+	params := UpdateSeasonParams{ID: id}
+	var fields []string
+
+	if e.Name != nil {
+		params.Name = e.Name
+		fields = append(fields, content.FieldTitle)
+	}
+	if e.Overview != nil {
+		params.Overview = e.Overview
+		fields = append(fields, content.FieldOverview)
+	}
+	if e.AirDate != nil {
+		params.AirDate = formatDate(e.AirDate)
+		fields = append(fields, content.FieldReleaseDate)
+	}
+	if e.VoteAverage != nil {
+		params.VoteAverage = formatVote(e.VoteAverage)
+		fields = append(fields, content.FieldRatings)
+	}
+	if e.PosterPath != nil {
+		params.PosterPath = e.PosterPath
+		fields = append(fields, content.FieldPoster)
+	}
+	return params, fields
+}
+
+func (e EpisodeMetadataEdit) updateParams(id uuid.UUID) (UpdateEpisodeParams, []string) {
+	params := UpdateEpisodeParams{ID: id}
+	var fields []string
+
+	if e.Title != nil {
+		params.Title = e.Title
+		fields = append(fields, content.FieldTitle)
+	}
+	if e.Overview != nil {
+		params.Overview = e.Overview
+		fields = append(fields, content.FieldOverview)
+	}
+	if e.AirDate != nil {
+		params.AirDate = formatDate(e.AirDate)
+		fields = append(fields, content.FieldReleaseDate)
+	}
+	if e.VoteAverage != nil {
+		params.VoteAverage = formatVote(e.VoteAverage)
+		fields = append(fields, content.FieldRatings)
+	}
+	if e.StillPath != nil {
+		params.StillPath = e.StillPath
+		fields = append(fields, content.FieldStill)
+	}
+	return params, fields
+}
+
+// ApplyLocks clears the params of locked fields so an update keeps the
+// stored values.
+func (p *UpdateSeriesParams) ApplyLocks(locked content.LockedFields) {
+	if locked.Has(content.FieldTitle) {
+		p.Title = nil
+		p.TitlesI18n = nil
+	}
+	if locked.Has(content.FieldSortTitle) {
+		p.SortTitle = nil
+	}
+	if locked.Has(content.FieldOriginalTitle) {
+		p.OriginalTitle = nil
+	}
+	if locked.Has(content.FieldOverview) {
+		p.Overview = nil
+		p.OverviewsI18n = nil
+	}
+	if locked.Has(content.FieldTagline) {
+		p.Tagline = nil
+		p.TaglinesI18n = nil
+	}
+	if locked.Has(content.FieldReleaseDate) {
+		p.FirstAirDate = nil
+	}
+	if locked.Has(content.FieldRatings) {
+		p.VoteAverage = nil
+		p.VoteCount = nil
+		p.ExternalRatings = nil
+	}
+	if locked.Has(content.FieldPoster) {
+		p.PosterPath = nil
+	}
+	if locked.Has(content.FieldBackdrop) {
+		p.BackdropPath = nil
+	}
+	p.MetadataSources = locked.FilterSources(p.MetadataSources)
+}
+
+// ApplyLocks clears the params of locked fields so an update keeps the
+// stored values.
+func (p *UpdateSeasonParams) ApplyLocks(locked content.LockedFields) {
+	if locked.Has(content.FieldTitle) {
+		p.Name = nil
+		p.NamesI18n = nil
+	}
+	if locked.Has(content.FieldOverview) {
+		p.Overview = nil
+		p.OverviewsI18n = nil
+	}
+	if locked.Has(content.FieldReleaseDate) {
+		p.AirDate = nil
+	}
+	if locked.Has(content.FieldRatings) {
+		p.VoteAverage = nil
+	}
+	if locked.Has(content.FieldPoster) {
+		p.PosterPath = nil
+	}
+	p.MetadataSources = locked.FilterSources(p.MetadataSources)
+}
+
+// ApplyLocks clears the params of locked fields so an update keeps the
+// stored values.
+func (p *UpdateEpisodeParams) ApplyLocks(locked content.LockedFields) {
+	if locked.Has(content.FieldTitle) {
+		p.Title = nil
+		p.TitlesI18n = nil
+	}
+	if locked.Has(content.FieldOverview) {
+		p.Overview = nil
+		p.OverviewsI18n = nil
+	}
+	if locked.Has(content.FieldReleaseDate) {
+		p.AirDate = nil
+	}
+	if locked.Has(content.FieldRatings) {
+		p.VoteAverage = nil
+		p.VoteCount = nil
+	}
+	if locked.Has(content.FieldStill) {
+		p.StillPath = nil
+	}
+	p.MetadataSources = locked.FilterSources(p.MetadataSources)
+}
+
+func validateLockFields(fields []string) error {
+	for _, f := range fields {
+		if err := content.ValidateLockField(f); err != nil {
+			return fmt.Errorf("%w: %s", err, f)
+		}
+	}
+	return nil
+}
+
+// UpdateSeriesMetadata applies a manual metadata edit and locks the edited
+// fields.
+func (s *tvService) UpdateSeriesMetadata(ctx context.Context, id uuid.UUID, edit SeriesMetadataEdit) (*Series, error) {
+	series, err := s.repo.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, fields := edit.updateParams(id)
+	if len(fields) == 0 {
+		return series, nil
+	}
+
+	if _, err := s.repo.UpdateSeries(ctx, params); err != nil {
+		return nil, fmt.Errorf("update series: %w", err)
+	}
+
+	if edit.Genres != nil {
+		if err := s.repo.DeleteSeriesGenres(ctx, id); err != nil {
+			return nil, fmt.Errorf("clear genres: %w", err)
+		}
+		for _, name := range edit.Genres {
+			if err := s.repo.AddSeriesGenre(ctx, id, util.Slugify(name), name); err != nil {
+				return nil, fmt.Errorf("add genre %q: %w", name, err)
+			}
+		}
+	}
+
+	return s.repo.SetSeriesLockedFields(ctx, id, series.LockedFields.With(fields...))
+}
+
+// UpdateSeasonMetadata applies a manual metadata edit and locks the edited
+// fields.
+func (s *tvService) UpdateSeasonMetadata(ctx context.Context, id uuid.UUID, edit SeasonMetadataEdit) (*Season, error) {
+	season, err := s.repo.GetSeason(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, fields := edit.updateParams(id)
+	if len(fields) == 0 {
+		return season, nil
+	}
+
+	if _, err := s.repo.UpdateSeason(ctx, params); err != nil {
+		return nil, fmt.Errorf("update season: %w", err)
+	}
+	return s.repo.SetSeasonLockedFields(ctx, id, season.LockedFields.With(fields...))
+}
+
+// UpdateEpisodeMetadata applies a manual metadata edit and locks the edited
+// fields.
+func (s *tvService) UpdateEpisodeMetadata(ctx context.Context, id uuid.UUID, edit EpisodeMetadataEdit) (*Episode, error) {
+	episode, err := s.repo.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, fields := edit.updateParams(id)
+	if len(fields) == 0 {
+		return episode, nil
+	}
+
+	if _, err := s.repo.UpdateEpisode(ctx, params); err != nil {
+		return nil, fmt.Errorf("update episode: %w", err)
+	}
+	return s.repo.SetEpisodeLockedFields(ctx, id, episode.LockedFields.With(fields...))
+}
+
+// UnlockSeriesFields clears field locks so automatic updates may change the
+// fields again. The current values are kept until the next refresh.
+func (s *tvService) UnlockSeriesFields(ctx context.Context, id uuid.UUID, fields ...string) (*Series, error) {
+	if err := validateLockFields(fields); err != nil {
+		return nil, err
+	}
+	series, err := s.repo.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.SetSeriesLockedFields(ctx, id, series.LockedFields.Without(fields...))
+}
+
+// UnlockSeasonFields clears field locks on a season.
+func (s *tvService) UnlockSeasonFields(ctx context.Context, id uuid.UUID, fields ...string) (*Season, error) {
+	if err := validateLockFields(fields); err != nil {
+		return nil, err
+	}
+	season, err := s.repo.GetSeason(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.SetSeasonLockedFields(ctx, id, season.LockedFields.Without(fields...))
+}
+
+// UnlockEpisodeFields clears field locks on an episode.
+func (s *tvService) UnlockEpisodeFields(ctx context.Context, id uuid.UUID, fields ...string) (*Episode, error) {
+	if err := validateLockFields(fields); err != nil {
+		return nil, err
+	}
+	episode, err := s.repo.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.SetEpisodeLockedFields(ctx, id, episode.LockedFields.Without(fields...))
+}
@@ -0,0 +1,93 @@
+package tvshow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+func TestService_UpdateSeriesMetadata(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	series := &Series{ID: uuid.Must(uuid.NewV7()), Title: "Dark"}
+
+	repo.On("GetSeries", ctx, series.ID).Return(series, nil)
+	repo.On("UpdateSeries", ctx, mock.MatchedBy(func(p UpdateSeriesParams) bool {
+		return p.ID == series.ID && *p.SortTitle == "Dark (2017)" && p.Title == nil
+	})).Return(series, nil)
+	repo.On("SetSeriesLockedFields", ctx, series.ID, []string{"sort_title"}).Return(series, nil)
+
+	_, err := svc.UpdateSeriesMetadata(ctx, series.ID, SeriesMetadataEdit{SortTitle: new("Dark (2017)")})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_UpdateEpisodeMetadata(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+	ctx := context.Background()
+	episode := &Episode{ID: uuid.Must(uuid.NewV7()), Title: "Secrets", LockedFields: content.LockedFields{"title"}}
+	airDate := time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	repo.On("GetEpisode", ctx, episode.ID).Return(episode, nil)
+	repo.On("UpdateEpisode", ctx, mock.MatchedBy(func(p UpdateEpisodeParams) bool {
+		return p.ID == episode.ID && *p.AirDate == "2017-12-01" && *p.StillPath == "/still.jpg"
+	})).Return(episode, nil)
+	repo.On("SetEpisodeLockedFields", ctx, episode.ID, []string{"release_date", "still", "title"}).Return(episode, nil)
+
+	_, err := svc.UpdateEpisodeMetadata(ctx, episode.ID, EpisodeMetadataEdit{
+		AirDate:   &airDate,
+		StillPath: new("/still.jpg"),
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_UnlockSeasonFields(t *testing.T) {
+	t.Run("removes locks", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo, nil)
+		ctx := context.Background()
+		season := &Season{ID: uuid.Must(uuid.NewV7()), LockedFields: content.LockedFields{"overview", "poster"}}
+
+		repo.On("GetSeason", ctx, season.ID).Return(season, nil)
+		repo.On("SetSeasonLockedFields", ctx, season.ID, []string{"overview"}).Return(season, nil)
+
+		_, err := svc.UnlockSeasonFields(ctx, season.ID, content.FieldPoster)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects unknown field", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil)
+
+		_, err := svc.UnlockSeasonFields(context.Background(), uuid.Must(uuid.NewV7()), "episode_count")
+		assert.ErrorIs(t, err, content.ErrUnknownLockField)
+	})
+}
+
+func TestUpdateSeriesParams_ApplyLocks(t *testing.T) {
+	params := UpdateSeriesParams{
+		Title:         new("Provider Title"),
+		Overview:      new("Provider overview"),
+		OverviewsI18n: map[string]string{"de": "Übersicht"},
+		FirstAirDate:  new("2017-12-01"),
+		BackdropPath:  new("/backdrop.jpg"),
+	}
+
+	params.ApplyLocks(content.LockedFields{content.FieldOverview, content.FieldReleaseDate})
+
+	assert.Nil(t, params.Overview)
+	assert.Nil(t, params.OverviewsI18n)
+	assert.Nil(t, params.FirstAirDate)
+	assert.Equal(t, "Provider Title", *params.Title)
+	assert.Equal(t, "/backdrop.jpg", *params.BackdropPath)
+}
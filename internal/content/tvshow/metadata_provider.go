@@ -13,6 +13,9 @@ type MetadataRefreshOptions struct {
 	// Languages overrides the default languages for this refresh.
 	// If empty, uses the adapter's configured default languages.
 	Languages []string
+	// SeriesProviderIDs holds the parent series' IDs keyed by provider, so
+	// season and episode refreshes can query providers other than TMDb.
+	SeriesProviderIDs map[string]string
 }
 
 // MetadataProvider defines the interface for TV show metadata operations.
@@ -36,6 +39,9 @@ type MetadataProvider interface {
 	// GetSeriesGenres retrieves series genres.
 	GetSeriesGenres(ctx context.Context, seriesID uuid.UUID, providerID string) ([]SeriesGenre, error)
 
+	// GetSeriesTags retrieves series keywords and tags.
+	GetSeriesTags(ctx context.Context, seriesID uuid.UUID, providerID string) ([]SeriesTag, error)
+
 	// GetSeriesNetworks retrieves series networks.
 	GetSeriesNetworks(ctx context.Context, providerID string) ([]Network, error)
 
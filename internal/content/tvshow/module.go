@@ -3,6 +3,7 @@ package tvshow
 import (
 	"log/slog"
 
+	"github.com/lusoris/revenge/internal/config"
 	"github.com/lusoris/revenge/internal/infra/cache"
 	"go.uber.org/fx"
 )
@@ -13,6 +14,7 @@ var Module = fx.Module("tvshow",
 		// Core TV show services
 		NewPostgresRepository,
 		provideService,
+		provideNFOService,
 	),
 )
 
@@ -23,3 +25,9 @@ func provideService(repo Repository, metadataProvider MetadataProvider, c *cache
 	base := NewService(repo, metadataProvider)
 	return NewCachedService(base, c, logger)
 }
+
+// provideNFOService creates the NFO service. Shows share the NFO settings
+// of the movie library.
+func provideNFOService(service Service, cfg *config.Config) *NFOService {
+	return NewNFOService(service, cfg.Movie.Library.NFO)
+}
@@ -0,0 +1,343 @@
+package tvshow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/nfo"
+)
+
+// nfoExportPageSize is how many series ExportLibrary loads per page.
+const nfoExportPageSize = 100
+
+// nfoExportCastLimit caps the actors written to an exported tvshow.nfo.
+const nfoExportCastLimit = 50
+
+// NFOExportSummary contains statistics from an NFO export.
+type NFOExportSummary struct {
+	Series int
+	Files  int
+	Errors []error
+}
+
+// NFOService reads tvshow.nfo sidecars during scans and writes tvshow.nfo
+// and episode NFOs from the database. A nil NFOService imports nothing.
+type NFOService struct {
+	service Service
+	config  config.NFOConfig
+}
+
+// NewNFOService creates an NFO service.
+func NewNFOService(service Service, cfg config.NFOConfig) *NFOService {
+	return &NFOService{service: service, config: cfg}
+}
+
+// ReadSeries returns the tvshow.nfo of an episode file when NFO import is
+// enabled, or nil if there is none or it cannot be read.
+func (s *NFOService) ReadSeries(episodePath string) *nfo.TVShow {
+	if s == nil || !s.config.Import {
+		return nil
+	}
+	return readTVShowNFO(episodePath)
+}
+
+// Apply writes the NFO sort title and the configured override fields to the
+// series. The series is only updated when a value actually differs.
+func (s *NFOService) Apply(ctx context.Context, series *Series, doc *nfo.TVShow) (*Series, error) {
+	params := UpdateSeriesParams{ID: series.ID}
+	changed := false
+
+	if doc.SortTitle != "" && !strPtrEquals(series.SortTitle, doc.SortTitle) {
+		params.SortTitle = &doc.SortTitle
+		changed = true
+	}
+
+	// Year, runtime and tagline only exist on movies
+	for _, field := range s.config.OverrideFields {
+		switch field {
+		case "title":
+			if doc.Title != "" && doc.Title != series.Title {
+				params.Title = &doc.Title
+				changed = true
+			}
+		case "original_title":
+			if doc.OriginalTitle != "" && !strPtrEquals(series.OriginalTitle, doc.OriginalTitle) {
+				params.OriginalTitle = &doc.OriginalTitle
+				changed = true
+			}
+		case "overview":
+			if doc.Plot != "" && !strPtrEquals(series.Overview, doc.Plot) {
+				params.Overview = &doc.Plot
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return series, nil
+	}
+
+	updated, err := s.service.UpdateSeries(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply nfo: %w", err)
+	}
+	return updated, nil
+}
+
+// ApplyOverrides re-applies NFO values to a series from the tvshow.nfo of
+// its first episode file that has one. Metadata refreshes call this so
+// configured NFO fields keep winning over provider data.
+func (s *NFOService) ApplyOverrides(ctx context.Context, seriesID uuid.UUID) error {
+	if s == nil || !s.config.Import {
+		return nil
+	}
+
+	episodes, err := s.service.ListEpisodesBySeries(ctx, seriesID)
+	if err != nil {
+		return fmt.Errorf("failed to list episodes: %w", err)
+	}
+
+	for _, ep := range episodes {
+		files, err := s.service.ListEpisodeFiles(ctx, ep.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list episode files: %w", err)
+		}
+		for _, file := range files {
+			doc := readTVShowNFO(file.FilePath)
+			if doc == nil {
+				continue
+			}
+
+			series, err := s.service.GetSeries(ctx, seriesID)
+			if err != nil {
+				return fmt.Errorf("failed to get series: %w", err)
+			}
+			_, err = s.Apply(ctx, series, doc)
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportSeries writes an NFO next to every episode file of a series and a
+// tvshow.nfo into each show folder, and returns the number of files written.
+func (s *NFOService) ExportSeries(ctx context.Context, seriesID uuid.UUID) (int, error) {
+	series, err := s.service.GetSeries(ctx, seriesID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get series: %w", err)
+	}
+
+	episodes, err := s.service.ListEpisodesBySeries(ctx, seriesID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list episodes: %w", err)
+	}
+
+	written := 0
+	var showPaths []string
+	for i := range episodes {
+		ep := &episodes[i]
+		files, err := s.service.ListEpisodeFiles(ctx, ep.ID)
+		if err != nil {
+			return written, fmt.Errorf("failed to list episode files: %w", err)
+		}
+		if len(files) == 0 {
+			continue
+		}
+
+		doc, err := s.episodeToNFO(ctx, series, ep)
+		if err != nil {
+			return written, err
+		}
+		for _, file := range files {
+			if err := nfo.WriteFile(nfo.EpisodePath(file.FilePath), doc); err != nil {
+				return written, fmt.Errorf("failed to write nfo for %s: %w", file.FilePath, err)
+			}
+			written++
+
+			if path := nfo.TVShowPath(file.FilePath); !slices.Contains(showPaths, path) {
+				showPaths = append(showPaths, path)
+			}
+		}
+	}
+	if len(showPaths) == 0 {
+		return written, nil
+	}
+
+	doc, err := s.seriesToNFO(ctx, series)
+	if err != nil {
+		return written, err
+	}
+	for _, path := range showPaths {
+		if err := nfo.WriteFile(path, doc); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		written++
+	}
+	return written, nil
+}
+
+// ExportLibrary writes NFOs for every series in the library. Failures for
+// single series are collected and do not stop the export.
+func (s *NFOService) ExportLibrary(ctx context.Context) (*NFOExportSummary, error) {
+	summary := &NFOExportSummary{}
+
+	for offset := int32(0); ; offset += nfoExportPageSize {
+		series, err := s.service.ListSeries(ctx, SeriesListFilters{Limit: nfoExportPageSize, Offset: offset})
+		if err != nil {
+			return summary, fmt.Errorf("failed to list series: %w", err)
+		}
+
+		for _, sr := range series {
+			if err := ctx.Err(); err != nil {
+				return summary, err
+			}
+
+			written, err := s.ExportSeries(ctx, sr.ID)
+			if err != nil {
+				summary.Errors = append(summary.Errors, err)
+			}
+			if written > 0 {
+				summary.Series++
+				summary.Files += written
+			}
+		}
+
+		if len(series) < nfoExportPageSize {
+			return summary, nil
+		}
+	}
+}
+
+// seriesToNFO builds the tvshow.nfo document for a series.
+func (s *NFOService) seriesToNFO(ctx context.Context, series *Series) (*nfo.TVShow, error) {
+	doc := &nfo.TVShow{
+		Title:         series.Title,
+		OriginalTitle: derefString(series.OriginalTitle),
+		SortTitle:     derefString(series.SortTitle),
+		Plot:          derefString(series.Overview),
+		Status:        derefString(series.Status),
+	}
+	if series.FirstAirDate != nil {
+		doc.Year = series.FirstAirDate.Year()
+		doc.Premiered = series.FirstAirDate.Format("2006-01-02")
+	}
+	if rating, ok := series.AgeRatings["US"]["TV"]; ok {
+		doc.MPAA = rating
+	}
+	if series.TVDbID != nil {
+		doc.SetUniqueID(nfo.ProviderTVDb, fmt.Sprintf("%d", *series.TVDbID))
+	}
+	if series.TMDbID != nil {
+		doc.SetUniqueID(nfo.ProviderTMDb, fmt.Sprintf("%d", *series.TMDbID))
+	}
+	if series.IMDbID != nil && *series.IMDbID != "" {
+		doc.SetUniqueID(nfo.ProviderIMDb, *series.IMDbID)
+	}
+
+	genres, err := s.service.GetSeriesGenres(ctx, series.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list genres: %w", err)
+	}
+	for _, genre := range genres {
+		doc.Genres = append(doc.Genres, genre.Name)
+	}
+
+	networks, err := s.service.GetSeriesNetworks(ctx, series.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+	for _, network := range networks {
+		doc.Studios = append(doc.Studios, network.Name)
+	}
+
+	cast, _, err := s.service.GetSeriesCast(ctx, series.ID, nfoExportCastLimit, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cast: %w", err)
+	}
+	for _, credit := range cast {
+		doc.Actors = append(doc.Actors, nfoActor(credit.Name, credit.Character, credit.CastOrder))
+	}
+
+	return doc, nil
+}
+
+// episodeToNFO builds the NFO document for an episode.
+func (s *NFOService) episodeToNFO(ctx context.Context, series *Series, ep *Episode) (*nfo.Episode, error) {
+	doc := &nfo.Episode{
+		Title:     ep.Title,
+		ShowTitle: series.Title,
+		Season:    int(ep.SeasonNumber),
+		Episode:   int(ep.EpisodeNumber),
+		Plot:      derefString(ep.Overview),
+	}
+	if ep.AirDate != nil {
+		doc.Aired = ep.AirDate.Format("2006-01-02")
+	}
+	if ep.Runtime != nil {
+		doc.Runtime = int(*ep.Runtime)
+	}
+	if ep.TVDbID != nil {
+		doc.SetUniqueID(nfo.ProviderTVDb, fmt.Sprintf("%d", *ep.TVDbID))
+	}
+	if ep.TMDbID != nil {
+		doc.SetUniqueID(nfo.ProviderTMDb, fmt.Sprintf("%d", *ep.TMDbID))
+	}
+	if ep.IMDbID != nil && *ep.IMDbID != "" {
+		doc.SetUniqueID(nfo.ProviderIMDb, *ep.IMDbID)
+	}
+
+	guests, err := s.service.GetEpisodeGuestStars(ctx, ep.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list guest stars: %w", err)
+	}
+	for _, credit := range guests {
+		doc.Actors = append(doc.Actors, nfoActor(credit.Name, credit.Character, credit.CastOrder))
+	}
+
+	crew, err := s.service.GetEpisodeCrew(ctx, ep.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crew: %w", err)
+	}
+	for _, credit := range crew {
+		switch {
+		case credit.Job != nil && *credit.Job == "Director":
+			doc.Directors = append(doc.Directors, credit.Name)
+		case credit.Department != nil && strings.EqualFold(*credit.Department, "Writing"):
+			doc.Credits = append(doc.Credits, credit.Name)
+		}
+	}
+
+	return doc, nil
+}
+
+func nfoActor(name string, character *string, castOrder *int32) nfo.Actor {
+	actor := nfo.Actor{Name: name, Role: derefString(character)}
+	if castOrder != nil {
+		order := int(*castOrder)
+		actor.Order = &order
+	}
+	return actor
+}
+
+// readTVShowNFO returns the parsed tvshow.nfo for an episode file, or nil if
+// there is none or it cannot be read.
+func readTVShowNFO(episodePath string) *nfo.TVShow {
+	path := nfo.FindTVShow(episodePath)
+	if path == "" {
+		return nil
+	}
+	doc, err := nfo.ReadTVShow(path)
+	if err != nil {
+		return nil
+	}
+	return doc
+}
+
+func strPtrEquals(p *string, s string) bool {
+	return p != nil && *p == s
+}
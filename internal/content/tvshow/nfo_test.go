@@ -0,0 +1,95 @@
+package tvshow
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/nfo"
+)
+
+func TestNFOService_ExportSeries(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewNFOService(NewService(repo, nil), config.NFOConfig{})
+	ctx := context.Background()
+
+	showDir := t.TempDir()
+	seasonDir := filepath.Join(showDir, "Season 01")
+	require.NoError(t, os.Mkdir(seasonDir, 0o755))
+	videoPath := filepath.Join(seasonDir, "Dark S01E01.mkv")
+
+	tmdbID := int32(70523)
+	series := &Series{ID: uuid.Must(uuid.NewV7()), Title: "Dark", TMDbID: &tmdbID}
+	episode := Episode{ID: uuid.Must(uuid.NewV7()), SeriesID: series.ID, Title: "Secrets", SeasonNumber: 1, EpisodeNumber: 1}
+	director := "Director"
+
+	repo.On("GetSeries", ctx, series.ID).Return(series, nil)
+	repo.On("ListEpisodesBySeries", ctx, series.ID).Return([]Episode{episode}, nil)
+	repo.On("ListEpisodeFilesByEpisode", ctx, episode.ID).Return([]EpisodeFile{{EpisodeID: episode.ID, FilePath: videoPath}}, nil)
+	repo.On("ListEpisodeGuestStars", ctx, episode.ID).Return([]EpisodeCredit{}, nil)
+	repo.On("ListEpisodeCrew", ctx, episode.ID).Return([]EpisodeCredit{{Name: "Baran bo Odar", Job: &director}}, nil)
+	repo.On("ListSeriesGenres", ctx, series.ID).Return([]SeriesGenre{}, nil)
+	repo.On("ListNetworksBySeries", ctx, series.ID).Return([]Network{}, nil)
+	repo.On("ListSeriesCast", ctx, series.ID, int32(nfoExportCastLimit), int32(0)).Return([]SeriesCredit{}, nil)
+	repo.On("CountSeriesCast", ctx, series.ID).Return(int64(0), nil)
+
+	written, err := svc.ExportSeries(ctx, series.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, written)
+
+	episodeNFO, err := os.ReadFile(filepath.Join(seasonDir, "Dark S01E01.nfo"))
+	require.NoError(t, err)
+	assert.Contains(t, string(episodeNFO), "<episodedetails>")
+	assert.Contains(t, string(episodeNFO), "<director>Baran bo Odar</director>")
+
+	show, err := nfo.ReadTVShow(filepath.Join(showDir, "tvshow.nfo"))
+	require.NoError(t, err)
+	assert.Equal(t, "Dark", show.Title)
+	assert.Equal(t, &tmdbID, show.TMDbID())
+	repo.AssertExpectations(t)
+}
+
+func TestNFOService_Import(t *testing.T) {
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "Dark S01E01.mkv")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tvshow.nfo"),
+		[]byte(`<tvshow><title>Dark (NFO)</title><sorttitle>Dark 2017</sorttitle><plot>NFO plot</plot></tvshow>`), 0o644))
+
+	t.Run("disabled", func(t *testing.T) {
+		svc := NewNFOService(nil, config.NFOConfig{Import: false})
+		assert.Nil(t, svc.ReadSeries(videoPath))
+		assert.NoError(t, svc.ApplyOverrides(context.Background(), uuid.Must(uuid.NewV7())))
+	})
+
+	t.Run("nil service", func(t *testing.T) {
+		var svc *NFOService
+		assert.Nil(t, svc.ReadSeries(videoPath))
+	})
+
+	t.Run("applies sort title and override fields", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewNFOService(NewService(repo, nil), config.NFOConfig{Import: true, OverrideFields: []string{"overview"}})
+		ctx := context.Background()
+		series := &Series{ID: uuid.Must(uuid.NewV7()), Title: "Dark"}
+
+		doc := svc.ReadSeries(videoPath)
+		require.NotNil(t, doc)
+
+		repo.On("GetSeries", ctx, series.ID).Return(series, nil)
+		repo.On("UpdateSeries", ctx, mock.MatchedBy(func(p UpdateSeriesParams) bool {
+			return p.ID == series.ID && p.Title == nil &&
+				*p.SortTitle == "Dark 2017" && *p.Overview == "NFO plot"
+		})).Return(series, nil)
+
+		_, err := svc.Apply(ctx, series, doc)
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // Repository defines database operations for TV shows
@@ -14,16 +15,20 @@ type Repository interface {
 	GetSeries(ctx context.Context, id uuid.UUID) (*Series, error)
 	GetSeriesByTMDbID(ctx context.Context, tmdbID int32) (*Series, error)
 	GetSeriesByTVDbID(ctx context.Context, tvdbID int32) (*Series, error)
+	GetSeriesByIMDbID(ctx context.Context, imdbID string) (*Series, error)
 	GetSeriesBySonarrID(ctx context.Context, sonarrID int32) (*Series, error)
 	ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error)
-	CountSeries(ctx context.Context) (int64, error)
+	CountSeries(ctx context.Context, libraryPaths []string) (int64, error)
+	ListSeriesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Series, error)
 	SearchSeriesByTitle(ctx context.Context, query string, limit, offset int32) ([]Series, error)
 	SearchSeriesByTitleAnyLanguage(ctx context.Context, query string, limit, offset int32) ([]Series, error)
-	ListRecentlyAddedSeries(ctx context.Context, limit, offset int32) ([]Series, error)
+	ListRecentlyAddedSeries(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Series, error)
 	ListSeriesByNetwork(ctx context.Context, networkID uuid.UUID, limit, offset int32) ([]Series, error)
 	ListSeriesByStatus(ctx context.Context, status string, limit, offset int32) ([]Series, error)
 	CreateSeries(ctx context.Context, params CreateSeriesParams) (*Series, error)
 	UpdateSeries(ctx context.Context, params UpdateSeriesParams) (*Series, error)
+	SetSeriesLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Series, error)
+	SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
 	UpdateSeriesStats(ctx context.Context, seriesID uuid.UUID) error
 	DeleteSeries(ctx context.Context, id uuid.UUID) error
 
@@ -35,6 +40,8 @@ type Repository interface {
 	CreateSeason(ctx context.Context, params CreateSeasonParams) (*Season, error)
 	UpsertSeason(ctx context.Context, params CreateSeasonParams) (*Season, error)
 	UpdateSeason(ctx context.Context, params UpdateSeasonParams) (*Season, error)
+	SetSeasonLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Season, error)
+	SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
 	DeleteSeason(ctx context.Context, id uuid.UUID) error
 	DeleteSeasonsBySeries(ctx context.Context, seriesID uuid.UUID) error
 
@@ -52,6 +59,8 @@ type Repository interface {
 	CreateEpisode(ctx context.Context, params CreateEpisodeParams) (*Episode, error)
 	UpsertEpisode(ctx context.Context, params CreateEpisodeParams) (*Episode, error)
 	UpdateEpisode(ctx context.Context, params UpdateEpisodeParams) (*Episode, error)
+	SetEpisodeLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Episode, error)
+	SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
 	DeleteEpisode(ctx context.Context, id uuid.UUID) error
 	DeleteEpisodesBySeason(ctx context.Context, seasonID uuid.UUID) error
 	DeleteEpisodesBySeries(ctx context.Context, seriesID uuid.UUID) error
@@ -85,7 +94,16 @@ type Repository interface {
 	ListSeriesGenres(ctx context.Context, seriesID uuid.UUID) ([]SeriesGenre, error)
 	ListDistinctSeriesGenres(ctx context.Context) ([]content.GenreSummary, error)
 	DeleteSeriesGenres(ctx context.Context, seriesID uuid.UUID) error
-	ListSeriesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Series, error)
+	ListSeriesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Series, error)
+
+	// Tags
+	AddSeriesTag(ctx context.Context, seriesID uuid.UUID, slug, name string, spoiler bool) error
+	ListSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]SeriesTag, error)
+	ListDistinctSeriesTags(ctx context.Context, limit int32) ([]content.TagSummary, error)
+	DeleteSeriesTags(ctx context.Context, seriesID uuid.UUID) error
+	ListSeriesByTag(ctx context.Context, slug string, limit, offset int32) ([]Series, error)
+	ListSeriesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Series, error)
+	SetSeriesTagsImported(ctx context.Context, id uuid.UUID) error
 
 	// Networks
 	CreateNetwork(ctx context.Context, params CreateNetworkParams) (*Network, error)
@@ -102,12 +120,13 @@ type Repository interface {
 	GetWatchProgress(ctx context.Context, userID, episodeID uuid.UUID) (*EpisodeWatched, error)
 	DeleteWatchProgress(ctx context.Context, userID, episodeID uuid.UUID) error
 	DeleteSeriesWatchProgress(ctx context.Context, userID, seriesID uuid.UUID) error
-	ListContinueWatchingSeries(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error)
+	ListContinueWatchingSeries(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error)
 	ListWatchedEpisodesBySeries(ctx context.Context, userID, seriesID uuid.UUID) ([]WatchedEpisodeItem, error)
 	ListWatchedEpisodesByUser(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]WatchedEpisodeItem, error)
 	GetSeriesWatchStats(ctx context.Context, userID, seriesID uuid.UUID) (*SeriesWatchStats, error)
 	GetUserTVStats(ctx context.Context, userID uuid.UUID) (*UserTVStats, error)
 	GetNextUnwatchedEpisode(ctx context.Context, userID, seriesID uuid.UUID) (*Episode, error)
+	ListNextUpEpisodes(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]NextUpItem, error)
 }
 
 // CreateSeriesParams contains parameters for creating a series
@@ -151,6 +170,7 @@ type UpdateSeriesParams struct {
 	SonarrID          *int32
 	Title             *string
 	OriginalTitle     *string
+	SortTitle         *string
 	OriginalLanguage  *string
 	Tagline           *string
 	Overview          *string
@@ -173,6 +193,8 @@ type UpdateSeriesParams struct {
 	AgeRatings        map[string]map[string]string
 	ExternalRatings   []ExternalRating
 	MetadataUpdatedAt *string
+	MetadataSources   map[string]string // Merged into the stored sources
+	ProviderIDs       map[string]string // Merged into the stored provider IDs
 }
 
 // CreateSeasonParams contains parameters for creating a season
@@ -192,17 +214,18 @@ type CreateSeasonParams struct {
 
 // UpdateSeasonParams contains parameters for updating a season
 type UpdateSeasonParams struct {
-	ID            uuid.UUID
-	TMDbID        *int32
-	SeasonNumber  *int32
-	Name          *string
-	Overview      *string
-	PosterPath    *string
-	EpisodeCount  *int32
-	AirDate       *string
-	VoteAverage   *string
-	NamesI18n     map[string]string
-	OverviewsI18n map[string]string
+	ID              uuid.UUID
+	TMDbID          *int32
+	SeasonNumber    *int32
+	Name            *string
+	Overview        *string
+	PosterPath      *string
+	EpisodeCount    *int32
+	AirDate         *string
+	VoteAverage     *string
+	NamesI18n       map[string]string
+	OverviewsI18n   map[string]string
+	MetadataSources map[string]string // Merged into the stored sources
 }
 
 // CreateEpisodeParams contains parameters for creating an episode
@@ -228,22 +251,23 @@ type CreateEpisodeParams struct {
 
 // UpdateEpisodeParams contains parameters for updating an episode
 type UpdateEpisodeParams struct {
-	ID             uuid.UUID
-	TMDbID         *int32
-	TVDbID         *int32
-	IMDbID         *string
-	SeasonNumber   *int32
-	EpisodeNumber  *int32
-	Title          *string
-	Overview       *string
-	AirDate        *string
-	Runtime        *int32
-	VoteAverage    *string
-	VoteCount      *int32
-	StillPath      *string
-	ProductionCode *string
-	TitlesI18n     map[string]string
-	OverviewsI18n  map[string]string
+	ID              uuid.UUID
+	TMDbID          *int32
+	TVDbID          *int32
+	IMDbID          *string
+	SeasonNumber    *int32
+	EpisodeNumber   *int32
+	Title           *string
+	Overview        *string
+	AirDate         *string
+	Runtime         *int32
+	VoteAverage     *string
+	VoteCount       *int32
+	StillPath       *string
+	ProductionCode  *string
+	TitlesI18n      map[string]string
+	OverviewsI18n   map[string]string
+	MetadataSources map[string]string // Merged into the stored sources
 }
 
 // CreateEpisodeFileParams contains parameters for creating an episode file
@@ -286,6 +310,8 @@ type UpdateEpisodeFileParams struct {
 type CreateSeriesCreditParams struct {
 	SeriesID     uuid.UUID
 	TMDbPersonID int32
+	TVDbPersonID *int32
+	IMDbPersonID *string
 	Name         string
 	CreditType   string
 	Character    *string
@@ -299,6 +325,8 @@ type CreateSeriesCreditParams struct {
 type CreateEpisodeCreditParams struct {
 	EpisodeID    uuid.UUID
 	TMDbPersonID int32
+	TVDbPersonID *int32
+	IMDbPersonID *string
 	Name         string
 	CreditType   string
 	Character    *string
@@ -14,6 +14,7 @@ import (
 
 	"github.com/lusoris/revenge/internal/content"
 	tvshowdb "github.com/lusoris/revenge/internal/content/tvshow/db"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 )
 
 // postgresRepository implements the Repository interface using PostgreSQL
@@ -67,6 +68,17 @@ func (r *postgresRepository) GetSeriesByTVDbID(ctx context.Context, tvdbID int32
 	return dbSeriesToSeries(series), nil
 }
 
+func (r *postgresRepository) GetSeriesByIMDbID(ctx context.Context, imdbID string) (*Series, error) {
+	series, err := r.queries.GetSeriesByIMDbID(ctx, &imdbID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("series not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to get series by IMDb ID: %w", err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
 func (r *postgresRepository) GetSeriesBySonarrID(ctx context.Context, sonarrID int32) (*Series, error) {
 	series, err := r.queries.GetSeriesBySonarrID(ctx, &sonarrID)
 	if err != nil {
@@ -79,9 +91,13 @@ func (r *postgresRepository) GetSeriesBySonarrID(ctx context.Context, sonarrID i
 }
 
 func (r *postgresRepository) ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbSeries, err := r.queries.ListSeries(ctx, tvshowdb.ListSeriesParams{
-		Limit:  filters.Limit,
-		Offset: filters.Offset,
+		Limit:        filters.Limit,
+		Offset:       filters.Offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list series: %w", err)
@@ -94,16 +110,46 @@ func (r *postgresRepository) ListSeries(ctx context.Context, filters SeriesListF
 	return result, nil
 }
 
-func (r *postgresRepository) CountSeries(ctx context.Context) (int64, error) {
-	return r.queries.CountSeries(ctx)
+func (r *postgresRepository) ListSeriesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Series, error) {
+	dbSeries, err := r.queries.ListSeriesDueForRefresh(ctx, tvshowdb.ListSeriesDueForRefreshParams{
+		IncompleteBefore: cutoffs.IncompleteBefore,
+		ActiveSince:      pgtype.Date{Time: cutoffs.ActiveSince, Valid: true},
+		ActiveBefore:     cutoffs.ActiveBefore,
+		SettledBefore:    cutoffs.SettledBefore,
+		Limit:            limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series due for refresh: %w", err)
+	}
+
+	result := make([]Series, len(dbSeries))
+	for i, s := range dbSeries {
+		result[i] = *dbSeriesToSeries(s)
+	}
+	return result, nil
+}
+
+func (r *postgresRepository) CountSeries(ctx context.Context, libraryPaths []string) (int64, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	return r.queries.CountSeries(ctx, tvshowdb.CountSeriesParams{
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
 }
 
 func (r *postgresRepository) SearchSeriesByTitle(ctx context.Context, query string, limit, offset int32) ([]Series, error) {
 	searchPattern := "%" + query + "%"
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbSeries, err := r.queries.SearchSeriesByTitle(ctx, tvshowdb.SearchSeriesByTitleParams{
-		Column1: &searchPattern,
-		Limit:   limit,
-		Offset:  offset,
+		Column1:      &searchPattern,
+		Limit:        limit,
+		Offset:       offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to search series: %w", err)
@@ -118,10 +164,14 @@ func (r *postgresRepository) SearchSeriesByTitle(ctx context.Context, query stri
 
 func (r *postgresRepository) SearchSeriesByTitleAnyLanguage(ctx context.Context, query string, limit, offset int32) ([]Series, error) {
 	searchPattern := "%" + query + "%"
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbSeries, err := r.queries.SearchSeriesByTitleAnyLanguage(ctx, tvshowdb.SearchSeriesByTitleAnyLanguageParams{
-		Column1: &searchPattern,
-		Limit:   limit,
-		Offset:  offset,
+		Column1:      &searchPattern,
+		Limit:        limit,
+		Offset:       offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to search series: %w", err)
@@ -134,10 +184,16 @@ func (r *postgresRepository) SearchSeriesByTitleAnyLanguage(ctx context.Context,
 	return result, nil
 }
 
-func (r *postgresRepository) ListRecentlyAddedSeries(ctx context.Context, limit, offset int32) ([]Series, error) {
+func (r *postgresRepository) ListRecentlyAddedSeries(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Series, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbSeries, err := r.queries.ListRecentlyAddedSeries(ctx, tvshowdb.ListRecentlyAddedSeriesParams{
-		Limit:  limit,
-		Offset: offset,
+		Limit:        limit,
+		Offset:       offset,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list recently added series: %w", err)
@@ -150,11 +206,17 @@ func (r *postgresRepository) ListRecentlyAddedSeries(ctx context.Context, limit,
 	return result, nil
 }
 
-func (r *postgresRepository) ListSeriesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Series, error) {
+func (r *postgresRepository) ListSeriesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Series, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbSeries, err := r.queries.ListSeriesByGenre(ctx, tvshowdb.ListSeriesByGenreParams{
-		Slug:   slug,
-		Limit:  limit,
-		Offset: offset,
+		Slug:         slug,
+		Limit:        limit,
+		Offset:       offset,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list series by genre: %w", err)
@@ -231,6 +293,7 @@ func (r *postgresRepository) CreateSeries(ctx context.Context, params CreateSeri
 		TrailerUrl:        params.TrailerURL,
 		Homepage:          params.Homepage,
 		MetadataUpdatedAt: stringToPgTimestamptz(params.MetadataUpdatedAt),
+		MinAge:            content.MinimumAge(params.AgeRatings),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create series: %w", err)
@@ -247,11 +310,13 @@ func (r *postgresRepository) UpdateSeries(ctx context.Context, params UpdateSeri
 		SonarrID:          params.SonarrID,
 		Title:             params.Title,
 		Tagline:           params.Tagline,
+		SortTitle:         params.SortTitle,
 		Overview:          params.Overview,
 		TitlesI18n:        marshalStringMapToBytes(params.TitlesI18n),
 		TaglinesI18n:      marshalStringMapToBytes(params.TaglinesI18n),
 		OverviewsI18n:     marshalStringMapToBytes(params.OverviewsI18n),
 		AgeRatings:        marshalNestedStringMapToBytes(params.AgeRatings),
+		MinAge:            content.MinimumAge(params.AgeRatings),
 		ExternalRatings:   marshalExternalRatingsToBytes(params.ExternalRatings),
 		OriginalLanguage:  params.OriginalLanguage,
 		OriginalTitle:     params.OriginalTitle,
@@ -269,6 +334,8 @@ func (r *postgresRepository) UpdateSeries(ctx context.Context, params UpdateSeri
 		TrailerUrl:        params.TrailerURL,
 		Homepage:          params.Homepage,
 		MetadataUpdatedAt: stringToPgTimestamptz(params.MetadataUpdatedAt),
+		MetadataSources:   marshalStringMapToBytes(params.MetadataSources),
+		ProviderIds:       marshalStringMapToBytes(params.ProviderIDs),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update series: %w", err)
@@ -276,6 +343,41 @@ func (r *postgresRepository) UpdateSeries(ctx context.Context, params UpdateSeri
 	return dbSeriesToSeries(dbSeries), nil
 }
 
+// SetSeriesLockedFields replaces the set of locked metadata fields of a series.
+func (r *postgresRepository) SetSeriesLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Series, error) {
+	if fields == nil {
+		fields = []string{}
+	}
+	series, err := r.queries.SetSeriesLockedFields(ctx, tvshowdb.SetSeriesLockedFieldsParams{
+		ID:           id,
+		LockedFields: fields,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set series locked fields: %w", err)
+	}
+	return dbSeriesToSeries(series), nil
+}
+
+// SetSeriesImagePlaceholder stores the placeholder of one of a series's images.
+func (r *postgresRepository) SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	data, err := json.Marshal(placeholder)
+	if err != nil {
+		return fmt.Errorf("failed to marshal placeholder: %w", err)
+	}
+	rows, err := r.queries.SetSeriesImagePlaceholder(ctx, tvshowdb.SetSeriesImagePlaceholderParams{
+		ID:          id,
+		Path:        path,
+		Placeholder: data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set series image placeholder: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("series not found: %s", id)
+	}
+	return nil
+}
+
 func (r *postgresRepository) UpdateSeriesStats(ctx context.Context, seriesID uuid.UUID) error {
 	return r.queries.UpdateSeriesStats(ctx, seriesID)
 }
@@ -399,16 +501,17 @@ func (r *postgresRepository) UpsertSeason(ctx context.Context, params CreateSeas
 
 func (r *postgresRepository) UpdateSeason(ctx context.Context, params UpdateSeasonParams) (*Season, error) {
 	dbSeason, err := r.queries.UpdateSeason(ctx, tvshowdb.UpdateSeasonParams{
-		ID:            params.ID,
-		TmdbID:        params.TMDbID,
-		Name:          params.Name,
-		Overview:      params.Overview,
-		PosterPath:    params.PosterPath,
-		EpisodeCount:  params.EpisodeCount,
-		AirDate:       stringToPgDate(params.AirDate),
-		VoteAverage:   stringToPgNumeric(params.VoteAverage),
-		NamesI18n:     marshalStringMapToBytes(params.NamesI18n),
-		OverviewsI18n: marshalStringMapToBytes(params.OverviewsI18n),
+		ID:              params.ID,
+		TmdbID:          params.TMDbID,
+		Name:            params.Name,
+		Overview:        params.Overview,
+		PosterPath:      params.PosterPath,
+		EpisodeCount:    params.EpisodeCount,
+		AirDate:         stringToPgDate(params.AirDate),
+		VoteAverage:     stringToPgNumeric(params.VoteAverage),
+		NamesI18n:       marshalStringMapToBytes(params.NamesI18n),
+		OverviewsI18n:   marshalStringMapToBytes(params.OverviewsI18n),
+		MetadataSources: marshalStringMapToBytes(params.MetadataSources),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update season: %w", err)
@@ -416,6 +519,41 @@ func (r *postgresRepository) UpdateSeason(ctx context.Context, params UpdateSeas
 	return dbSeasonToSeason(dbSeason), nil
 }
 
+// SetSeasonLockedFields replaces the set of locked metadata fields of a season.
+func (r *postgresRepository) SetSeasonLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Season, error) {
+	if fields == nil {
+		fields = []string{}
+	}
+	season, err := r.queries.SetSeasonLockedFields(ctx, tvshowdb.SetSeasonLockedFieldsParams{
+		ID:           id,
+		LockedFields: fields,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set season locked fields: %w", err)
+	}
+	return dbSeasonToSeason(season), nil
+}
+
+// SetSeasonImagePlaceholder stores the placeholder of one of a season's images.
+func (r *postgresRepository) SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	data, err := json.Marshal(placeholder)
+	if err != nil {
+		return fmt.Errorf("failed to marshal placeholder: %w", err)
+	}
+	rows, err := r.queries.SetSeasonImagePlaceholder(ctx, tvshowdb.SetSeasonImagePlaceholderParams{
+		ID:          id,
+		Path:        path,
+		Placeholder: data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set season image placeholder: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("season not found: %s", id)
+	}
+	return nil
+}
+
 func (r *postgresRepository) DeleteSeason(ctx context.Context, id uuid.UUID) error {
 	return r.queries.DeleteSeason(ctx, id)
 }
@@ -653,20 +791,21 @@ func (r *postgresRepository) UpsertEpisode(ctx context.Context, params CreateEpi
 
 func (r *postgresRepository) UpdateEpisode(ctx context.Context, params UpdateEpisodeParams) (*Episode, error) {
 	dbEpisode, err := r.queries.UpdateEpisode(ctx, tvshowdb.UpdateEpisodeParams{
-		ID:             params.ID,
-		TmdbID:         params.TMDbID,
-		TvdbID:         params.TVDbID,
-		ImdbID:         params.IMDbID,
-		Title:          params.Title,
-		Overview:       params.Overview,
-		AirDate:        stringToPgDate(params.AirDate),
-		Runtime:        params.Runtime,
-		VoteAverage:    stringToPgNumeric(params.VoteAverage),
-		VoteCount:      params.VoteCount,
-		StillPath:      params.StillPath,
-		ProductionCode: params.ProductionCode,
-		TitlesI18n:     marshalStringMapToBytes(params.TitlesI18n),
-		OverviewsI18n:  marshalStringMapToBytes(params.OverviewsI18n),
+		ID:              params.ID,
+		TmdbID:          params.TMDbID,
+		TvdbID:          params.TVDbID,
+		ImdbID:          params.IMDbID,
+		Title:           params.Title,
+		Overview:        params.Overview,
+		AirDate:         stringToPgDate(params.AirDate),
+		Runtime:         params.Runtime,
+		VoteAverage:     stringToPgNumeric(params.VoteAverage),
+		VoteCount:       params.VoteCount,
+		StillPath:       params.StillPath,
+		ProductionCode:  params.ProductionCode,
+		TitlesI18n:      marshalStringMapToBytes(params.TitlesI18n),
+		OverviewsI18n:   marshalStringMapToBytes(params.OverviewsI18n),
+		MetadataSources: marshalStringMapToBytes(params.MetadataSources),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update episode: %w", err)
@@ -674,6 +813,41 @@ func (r *postgresRepository) UpdateEpisode(ctx context.Context, params UpdateEpi
 	return dbEpisodeToEpisode(dbEpisode), nil
 }
 
+// SetEpisodeLockedFields replaces the set of locked metadata fields of an episode.
+func (r *postgresRepository) SetEpisodeLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Episode, error) {
+	if fields == nil {
+		fields = []string{}
+	}
+	episode, err := r.queries.SetEpisodeLockedFields(ctx, tvshowdb.SetEpisodeLockedFieldsParams{
+		ID:           id,
+		LockedFields: fields,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set episode locked fields: %w", err)
+	}
+	return dbEpisodeToEpisode(episode), nil
+}
+
+// SetEpisodeImagePlaceholder stores the placeholder of one of a episode's images.
+func (r *postgresRepository) SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	data, err := json.Marshal(placeholder)
+	if err != nil {
+		return fmt.Errorf("failed to marshal placeholder: %w", err)
+	}
+	rows, err := r.queries.SetEpisodeImagePlaceholder(ctx, tvshowdb.SetEpisodeImagePlaceholderParams{
+		ID:          id,
+		Path:        path,
+		Placeholder: data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set episode image placeholder: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("episode not found: %s", id)
+	}
+	return nil
+}
+
 func (r *postgresRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) error {
 	return r.queries.DeleteEpisode(ctx, id)
 }
@@ -806,6 +980,8 @@ func (r *postgresRepository) CreateSeriesCredit(ctx context.Context, params Crea
 	dbCredit, err := r.queries.CreateSeriesCredit(ctx, tvshowdb.CreateSeriesCreditParams{
 		SeriesID:     params.SeriesID,
 		TmdbPersonID: params.TMDbPersonID,
+		TvdbPersonID: params.TVDbPersonID,
+		ImdbPersonID: params.IMDbPersonID,
 		Name:         params.Name,
 		CreditType:   params.CreditType,
 		Character:    params.Character,
@@ -870,6 +1046,8 @@ func (r *postgresRepository) CreateEpisodeCredit(ctx context.Context, params Cre
 	dbCredit, err := r.queries.CreateEpisodeCredit(ctx, tvshowdb.CreateEpisodeCreditParams{
 		EpisodeID:    params.EpisodeID,
 		TmdbPersonID: params.TMDbPersonID,
+		TvdbPersonID: params.TVDbPersonID,
+		ImdbPersonID: params.IMDbPersonID,
 		Name:         params.Name,
 		CreditType:   params.CreditType,
 		Character:    params.Character,
@@ -965,6 +1143,100 @@ func (r *postgresRepository) DeleteSeriesGenres(ctx context.Context, seriesID uu
 	return r.queries.DeleteSeriesGenres(ctx, seriesID)
 }
 
+// =============================================================================
+// Tag Operations
+// =============================================================================
+
+func (r *postgresRepository) AddSeriesTag(ctx context.Context, seriesID uuid.UUID, slug, name string, spoiler bool) error {
+	return r.queries.AddSeriesTag(ctx, tvshowdb.AddSeriesTagParams{
+		SeriesID: seriesID,
+		Slug:     slug,
+		Name:     name,
+		Spoiler:  spoiler,
+	})
+}
+
+func (r *postgresRepository) ListSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]SeriesTag, error) {
+	dbTags, err := r.queries.ListSeriesTags(ctx, seriesID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series tags: %w", err)
+	}
+
+	result := make([]SeriesTag, len(dbTags))
+	for i, t := range dbTags {
+		result[i] = SeriesTag{
+			ID:        t.ID,
+			SeriesID:  t.SeriesID,
+			Slug:      t.Slug,
+			Name:      t.Name,
+			Spoiler:   t.Spoiler,
+			CreatedAt: t.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *postgresRepository) ListDistinctSeriesTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	rows, err := r.queries.ListDistinctSeriesTags(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list distinct series tags: %w", err)
+	}
+	tags := make([]content.TagSummary, len(rows))
+	for i, row := range rows {
+		tags[i] = content.TagSummary{
+			Slug:      row.Slug,
+			Name:      row.Name,
+			ItemCount: row.ItemCount,
+		}
+	}
+	return tags, nil
+}
+
+func (r *postgresRepository) DeleteSeriesTags(ctx context.Context, seriesID uuid.UUID) error {
+	return r.queries.DeleteSeriesTags(ctx, seriesID)
+}
+
+func (r *postgresRepository) ListSeriesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Series, error) {
+	dbSeries, err := r.queries.ListSeriesPendingTagImport(ctx, tvshowdb.ListSeriesPendingTagImportParams{
+		After: after,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series pending tag import: %w", err)
+	}
+
+	result := make([]Series, len(dbSeries))
+	for i, s := range dbSeries {
+		result[i] = *dbSeriesToSeries(s)
+	}
+	return result, nil
+}
+
+func (r *postgresRepository) SetSeriesTagsImported(ctx context.Context, id uuid.UUID) error {
+	return r.queries.SetSeriesTagsImported(ctx, id)
+}
+
+func (r *postgresRepository) ListSeriesByTag(ctx context.Context, slug string, limit, offset int32) ([]Series, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	dbSeries, err := r.queries.ListSeriesByTag(ctx, tvshowdb.ListSeriesByTagParams{
+		Slug:         slug,
+		Limit:        limit,
+		Offset:       offset,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series by tag: %w", err)
+	}
+
+	result := make([]Series, len(dbSeries))
+	for i, s := range dbSeries {
+		result[i] = *dbSeriesToSeries(s)
+	}
+	return result, nil
+}
+
 // =============================================================================
 // Networks Operations
 // =============================================================================
@@ -1125,10 +1397,16 @@ func (r *postgresRepository) DeleteSeriesWatchProgress(ctx context.Context, user
 	})
 }
 
-func (r *postgresRepository) ListContinueWatchingSeries(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
+func (r *postgresRepository) ListContinueWatchingSeries(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
 	dbRows, err := r.queries.ListContinueWatchingSeries(ctx, tvshowdb.ListContinueWatchingSeriesParams{
-		UserID: userID,
-		Limit:  limit,
+		UserID:       userID,
+		Limit:        limit,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list continue watching: %w", err)
@@ -1165,6 +1443,7 @@ func (r *postgresRepository) ListContinueWatchingSeries(ctx context.Context, use
 				TaglinesI18n:      unmarshalStringMap(row.TaglinesI18n),
 				OverviewsI18n:     unmarshalStringMap(row.OverviewsI18n),
 				AgeRatings:        unmarshalNestedStringMap(row.AgeRatings),
+				MinAge:            row.MinAge,
 				MetadataUpdatedAt: pgTimestamptzToTimePtr(row.MetadataUpdatedAt),
 				CreatedAt:         row.CreatedAt,
 				UpdatedAt:         row.UpdatedAt,
@@ -1298,6 +1577,33 @@ func (r *postgresRepository) GetNextUnwatchedEpisode(ctx context.Context, userID
 	return dbEpisodeToEpisode(episode), nil
 }
 
+func (r *postgresRepository) ListNextUpEpisodes(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]NextUpItem, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	dbRows, err := r.queries.ListNextUpEpisodes(ctx, tvshowdb.ListNextUpEpisodesParams{
+		UserID:       userID,
+		Limit:        limit,
+		Restrict:     libraryPaths != nil,
+		LibraryPaths: libraryPaths,
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list next up episodes: %w", err)
+	}
+
+	result := make([]NextUpItem, len(dbRows))
+	for i, row := range dbRows {
+		result[i] = NextUpItem{
+			Episode:          *dbEpisodeToEpisode(row.TvshowEpisode),
+			SeriesTitle:      row.SeriesTitle,
+			SeriesPosterPath: row.SeriesPosterPath,
+			LastWatchedAt:    pgTimestamptzToTime(row.LastWatchedAt),
+		}
+	}
+	return result, nil
+}
+
 // =============================================================================
 // Conversion Helpers
 // =============================================================================
@@ -1311,6 +1617,7 @@ func dbSeriesToSeries(s tvshowdb.TvshowSeries) *Series {
 		SonarrID:          s.SonarrID,
 		Title:             s.Title,
 		OriginalTitle:     s.OriginalTitle,
+		SortTitle:         s.SortTitle,
 		OriginalLanguage:  s.OriginalLanguage,
 		Tagline:           s.Tagline,
 		Overview:          s.Overview,
@@ -1331,8 +1638,13 @@ func dbSeriesToSeries(s tvshowdb.TvshowSeries) *Series {
 		TaglinesI18n:      unmarshalStringMap(s.TaglinesI18n),
 		OverviewsI18n:     unmarshalStringMap(s.OverviewsI18n),
 		AgeRatings:        unmarshalNestedStringMap(s.AgeRatings),
+		MinAge:            s.MinAge,
 		ExternalRatings:   unmarshalExternalRatings(s.ExternalRatings),
 		MetadataUpdatedAt: pgTimestamptzToTimePtr(s.MetadataUpdatedAt),
+		LockedFields:      s.LockedFields,
+		MetadataSources:   unmarshalStringMap(s.MetadataSources),
+		ProviderIDs:       unmarshalStringMap(s.ProviderIds),
+		ImagePlaceholders: content.ParseImagePlaceholders(s.ImagePlaceholders),
 		CreatedAt:         s.CreatedAt,
 		UpdatedAt:         s.UpdatedAt,
 	}
@@ -1340,45 +1652,51 @@ func dbSeriesToSeries(s tvshowdb.TvshowSeries) *Series {
 
 func dbSeasonToSeason(s tvshowdb.TvshowSeason) *Season {
 	return &Season{
-		ID:            s.ID,
-		SeriesID:      s.SeriesID,
-		TMDbID:        s.TmdbID,
-		SeasonNumber:  s.SeasonNumber,
-		Name:          s.Name,
-		Overview:      s.Overview,
-		PosterPath:    s.PosterPath,
-		EpisodeCount:  s.EpisodeCount,
-		AirDate:       pgDateToTimePtr(s.AirDate),
-		VoteAverage:   pgNumericToDecimalPtr(s.VoteAverage),
-		NamesI18n:     unmarshalStringMap(s.NamesI18n),
-		OverviewsI18n: unmarshalStringMap(s.OverviewsI18n),
-		CreatedAt:     s.CreatedAt,
-		UpdatedAt:     s.UpdatedAt,
+		ID:                s.ID,
+		SeriesID:          s.SeriesID,
+		TMDbID:            s.TmdbID,
+		SeasonNumber:      s.SeasonNumber,
+		Name:              s.Name,
+		Overview:          s.Overview,
+		PosterPath:        s.PosterPath,
+		EpisodeCount:      s.EpisodeCount,
+		AirDate:           pgDateToTimePtr(s.AirDate),
+		VoteAverage:       pgNumericToDecimalPtr(s.VoteAverage),
+		NamesI18n:         unmarshalStringMap(s.NamesI18n),
+		OverviewsI18n:     unmarshalStringMap(s.OverviewsI18n),
+		LockedFields:      s.LockedFields,
+		MetadataSources:   unmarshalStringMap(s.MetadataSources),
+		ImagePlaceholders: content.ParseImagePlaceholders(s.ImagePlaceholders),
+		CreatedAt:         s.CreatedAt,
+		UpdatedAt:         s.UpdatedAt,
 	}
 }
 
 func dbEpisodeToEpisode(e tvshowdb.TvshowEpisode) *Episode {
 	return &Episode{
-		ID:             e.ID,
-		SeriesID:       e.SeriesID,
-		SeasonID:       e.SeasonID,
-		TMDbID:         e.TmdbID,
-		TVDbID:         e.TvdbID,
-		IMDbID:         e.ImdbID,
-		SeasonNumber:   e.SeasonNumber,
-		EpisodeNumber:  e.EpisodeNumber,
-		Title:          e.Title,
-		Overview:       e.Overview,
-		AirDate:        pgDateToTimePtr(e.AirDate),
-		Runtime:        e.Runtime,
-		VoteAverage:    pgNumericToDecimalPtr(e.VoteAverage),
-		VoteCount:      e.VoteCount,
-		StillPath:      e.StillPath,
-		ProductionCode: e.ProductionCode,
-		TitlesI18n:     unmarshalStringMap(e.TitlesI18n),
-		OverviewsI18n:  unmarshalStringMap(e.OverviewsI18n),
-		CreatedAt:      e.CreatedAt,
-		UpdatedAt:      e.UpdatedAt,
+		ID:                e.ID,
+		SeriesID:          e.SeriesID,
+		SeasonID:          e.SeasonID,
+		TMDbID:            e.TmdbID,
+		TVDbID:            e.TvdbID,
+		IMDbID:            e.ImdbID,
+		SeasonNumber:      e.SeasonNumber,
+		EpisodeNumber:     e.EpisodeNumber,
+		Title:             e.Title,
+		Overview:          e.Overview,
+		AirDate:           pgDateToTimePtr(e.AirDate),
+		Runtime:           e.Runtime,
+		VoteAverage:       pgNumericToDecimalPtr(e.VoteAverage),
+		VoteCount:         e.VoteCount,
+		StillPath:         e.StillPath,
+		ProductionCode:    e.ProductionCode,
+		TitlesI18n:        unmarshalStringMap(e.TitlesI18n),
+		OverviewsI18n:     unmarshalStringMap(e.OverviewsI18n),
+		LockedFields:      e.LockedFields,
+		MetadataSources:   unmarshalStringMap(e.MetadataSources),
+		ImagePlaceholders: content.ParseImagePlaceholders(e.ImagePlaceholders),
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
 	}
 }
 
@@ -1416,6 +1734,7 @@ func dbSeriesCreditToSeriesCredit(c tvshowdb.TvshowSeriesCredit) *SeriesCredit {
 		Department:   c.Department,
 		CastOrder:    c.CastOrder,
 		ProfilePath:  c.ProfilePath,
+		PersonID:     pgUUIDToUUIDPtr(c.PersonID),
 		CreatedAt:    c.CreatedAt,
 		UpdatedAt:    c.UpdatedAt,
 	}
@@ -1433,6 +1752,7 @@ func dbEpisodeCreditToEpisodeCredit(c tvshowdb.TvshowEpisodeCredit) *EpisodeCred
 		Department:   c.Department,
 		CastOrder:    c.CastOrder,
 		ProfilePath:  c.ProfilePath,
+		PersonID:     pgUUIDToUUIDPtr(c.PersonID),
 		CreatedAt:    c.CreatedAt,
 		UpdatedAt:    c.UpdatedAt,
 	}
@@ -1471,6 +1791,14 @@ func pgTimestamptzToTimePtr(t pgtype.Timestamptz) *time.Time {
 	return &t.Time
 }
 
+func pgUUIDToUUIDPtr(u pgtype.UUID) *uuid.UUID {
+	if !u.Valid {
+		return nil
+	}
+	id := uuid.UUID(u.Bytes)
+	return &id
+}
+
 func pgTimestamptzToTime(t pgtype.Timestamptz) time.Time {
 	if !t.Valid {
 		return time.Time{}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"math/rand/v2"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -232,6 +234,35 @@ func TestRepo_UpdateSeries(t *testing.T) {
 	assert.Equal(t, "trakt", updated.ExternalRatings[0].Source)
 }
 
+func TestRepo_ListSeriesDueForRefresh(t *testing.T) {
+	t.Parallel()
+	repo, _ := setupTestRepo(t)
+	ctx := context.Background()
+
+	s := createTestSeries(t, repo, "Due For Refresh")
+	hourAgo := time.Now().Add(-time.Hour)
+	cutoffs := content.RefreshCutoffs{
+		IncompleteBefore: hourAgo,
+		ActiveBefore:     hourAgo,
+		SettledBefore:    hourAgo,
+		ActiveSince:      time.Now().AddDate(-1, 0, 0),
+	}
+
+	due, err := repo.ListSeriesDueForRefresh(ctx, cutoffs, 50)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(due, func(d Series) bool { return d.ID == s.ID }), "never refreshed series is due")
+
+	_, err = repo.UpdateSeries(ctx, UpdateSeriesParams{
+		ID:                s.ID,
+		MetadataUpdatedAt: new(time.Now().UTC().Format(time.RFC3339)),
+	})
+	require.NoError(t, err)
+
+	due, err = repo.ListSeriesDueForRefresh(ctx, cutoffs, 50)
+	require.NoError(t, err)
+	assert.False(t, slices.ContainsFunc(due, func(d Series) bool { return d.ID == s.ID }), "refreshed series leaves the due list")
+}
+
 func TestRepo_DeleteSeries(t *testing.T) {
 	t.Parallel()
 	repo, _ := setupTestRepo(t)
@@ -287,7 +318,7 @@ func TestRepo_CountSeries(t *testing.T) {
 	createTestSeries(t, repo, "Count Series 1")
 	createTestSeries(t, repo, "Count Series 2")
 
-	count, err := repo.CountSeries(ctx)
+	count, err := repo.CountSeries(ctx, nil)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, count, int64(2))
 }
@@ -312,7 +343,7 @@ func TestRepo_ListRecentlyAdded(t *testing.T) {
 
 	createTestSeries(t, repo, "Recent Addition")
 
-	recent, err := repo.ListRecentlyAddedSeries(ctx, 5, 0)
+	recent, err := repo.ListRecentlyAddedSeries(ctx, nil, 5, 0)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, len(recent), 1)
 }
@@ -828,6 +859,59 @@ func TestRepo_SeriesCredits(t *testing.T) {
 	assert.Empty(t, castList)
 }
 
+func TestRepo_SeriesCredits_People(t *testing.T) {
+	t.Parallel()
+	repo, _ := setupTestRepo(t)
+	ctx := context.Background()
+
+	series := createTestSeries(t, repo, "People Show")
+
+	// A person only known to TVDb
+	first, err := repo.CreateSeriesCredit(ctx, CreateSeriesCreditParams{
+		SeriesID:     series.ID,
+		TVDbPersonID: i32Ptr(256154),
+		Name:         "Bryan Cranston",
+		CreditType:   "cast",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, first.PersonID)
+
+	// The same person with a TMDb and IMDb ID is matched by the TVDb ID
+	second, err := repo.CreateSeriesCredit(ctx, CreateSeriesCreditParams{
+		SeriesID:     series.ID,
+		TMDbPersonID: 17419,
+		TVDbPersonID: i32Ptr(256154),
+		IMDbPersonID: new("nm0186505"),
+		Name:         "Bryan Cranston",
+		CreditType:   "crew",
+		Job:          new("Producer"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, second.PersonID)
+	assert.Equal(t, *first.PersonID, *second.PersonID)
+
+	// ... and from then on by the IMDb ID alone
+	third, err := repo.CreateSeriesCredit(ctx, CreateSeriesCreditParams{
+		SeriesID:     series.ID,
+		IMDbPersonID: new("nm0186505"),
+		Name:         "Bryan Cranston",
+		CreditType:   "crew",
+		Job:          new("Director"),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, third.PersonID)
+	assert.Equal(t, *first.PersonID, *third.PersonID)
+
+	// Credits without provider IDs are not linked
+	unknown, err := repo.CreateSeriesCredit(ctx, CreateSeriesCreditParams{
+		SeriesID:   series.ID,
+		Name:       "Unknown",
+		CreditType: "cast",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, unknown.PersonID)
+}
+
 // ============================================================================
 // Episode Credits
 // ============================================================================
@@ -934,7 +1018,7 @@ func TestRepo_ListSeriesByGenre(t *testing.T) {
 	_ = repo.AddSeriesGenre(ctx, s1.ID, "drama", "Drama")
 	_ = repo.AddSeriesGenre(ctx, s2.ID, "drama", "Drama")
 
-	list, err := repo.ListSeriesByGenre(ctx, "drama", 10, 0)
+	list, err := repo.ListSeriesByGenre(ctx, "drama", nil, 10, 0)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, len(list), 2)
 }
@@ -1134,9 +1218,14 @@ func TestRepo_ContinueWatching(t *testing.T) {
 	require.NoError(t, err)
 
 	// Should appear in continue watching
-	cwList, err := repo.ListContinueWatchingSeries(ctx, user.ID, 10)
+	cwList, err := repo.ListContinueWatchingSeries(ctx, user.ID, nil, 10)
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, len(cwList), 1)
+
+	// Episodes without a file under the library paths are left out.
+	cwList, err = repo.ListContinueWatchingSeries(ctx, user.ID, []string{"/nonexistent/"}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, cwList)
 }
 
 func TestRepo_NextUnwatchedEpisode(t *testing.T) {
@@ -3,9 +3,12 @@ package tvshow
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/service/artwork"
 )
 
 // Service defines business logic for TV shows
@@ -14,12 +17,14 @@ type Service interface {
 	GetSeries(ctx context.Context, id uuid.UUID) (*Series, error)
 	GetSeriesByTMDbID(ctx context.Context, tmdbID int32) (*Series, error)
 	GetSeriesByTVDbID(ctx context.Context, tvdbID int32) (*Series, error)
+	GetSeriesByIMDbID(ctx context.Context, imdbID string) (*Series, error)
 	GetSeriesBySonarrID(ctx context.Context, sonarrID int32) (*Series, error)
 	ListSeries(ctx context.Context, filters SeriesListFilters) ([]Series, error)
 	CountSeries(ctx context.Context) (int64, error)
 	SearchSeries(ctx context.Context, query string, limit, offset int32) ([]Series, error)
-	ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Series, int64, error)
-	ListByGenre(ctx context.Context, slug string, limit, offset int32) ([]Series, error)
+	ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Series, int64, error)
+	ListByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Series, error)
+	ListByTag(ctx context.Context, slug string, limit, offset int32) ([]Series, error)
 	ListByNetwork(ctx context.Context, networkID uuid.UUID, limit, offset int32) ([]Series, error)
 	ListByStatus(ctx context.Context, status string, limit, offset int32) ([]Series, error)
 	CreateSeries(ctx context.Context, params CreateSeriesParams) (*Series, error)
@@ -69,6 +74,8 @@ type Service interface {
 	// Genres & Networks
 	GetSeriesGenres(ctx context.Context, seriesID uuid.UUID) ([]SeriesGenre, error)
 	ListDistinctGenres(ctx context.Context) ([]content.GenreSummary, error)
+	GetSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]SeriesTag, error)
+	ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error)
 	GetSeriesNetworks(ctx context.Context, seriesID uuid.UUID) ([]Network, error)
 
 	// Watch progress
@@ -80,8 +87,9 @@ type Service interface {
 	MarkSeriesWatched(ctx context.Context, userID, seriesID uuid.UUID) error
 	RemoveEpisodeProgress(ctx context.Context, userID, episodeID uuid.UUID) error
 	RemoveSeriesProgress(ctx context.Context, userID, seriesID uuid.UUID) error
-	GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error)
+	GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error)
 	GetNextEpisode(ctx context.Context, userID, seriesID uuid.UUID) (*Episode, error)
+	ListNextUp(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]NextUpItem, error)
 	GetSeriesWatchStats(ctx context.Context, userID, seriesID uuid.UUID) (*SeriesWatchStats, error)
 	GetUserStats(ctx context.Context, userID uuid.UUID) (*UserTVStats, error)
 
@@ -89,6 +97,25 @@ type Service interface {
 	RefreshSeriesMetadata(ctx context.Context, id uuid.UUID, opts ...MetadataRefreshOptions) error
 	RefreshSeasonMetadata(ctx context.Context, id uuid.UUID, opts ...MetadataRefreshOptions) error
 	RefreshEpisodeMetadata(ctx context.Context, id uuid.UUID, opts ...MetadataRefreshOptions) error
+	ListSeriesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Series, error)
+	ListSeriesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Series, error)
+	ImportSeriesTags(ctx context.Context, id uuid.UUID) error
+
+	// Manual metadata editing
+	UpdateSeriesMetadata(ctx context.Context, id uuid.UUID, edit SeriesMetadataEdit) (*Series, error)
+	UpdateSeasonMetadata(ctx context.Context, id uuid.UUID, edit SeasonMetadataEdit) (*Season, error)
+	UpdateEpisodeMetadata(ctx context.Context, id uuid.UUID, edit EpisodeMetadataEdit) (*Episode, error)
+	UnlockSeriesFields(ctx context.Context, id uuid.UUID, fields ...string) (*Series, error)
+	UnlockSeasonFields(ctx context.Context, id uuid.UUID, fields ...string) (*Season, error)
+	UnlockEpisodeFields(ctx context.Context, id uuid.UUID, fields ...string) (*Episode, error)
+	RestoreSeriesMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Series, error)
+	RestoreSeasonMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Season, error)
+	RestoreEpisodeMetadata(ctx context.Context, id uuid.UUID, values content.Snapshot) (*Episode, error)
+
+	// Image placeholders
+	SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
+	SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
+	SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
 }
 
 // tvService implements the Service interface
@@ -121,6 +148,10 @@ func (s *tvService) GetSeriesByTVDbID(ctx context.Context, tvdbID int32) (*Serie
 	return s.repo.GetSeriesByTVDbID(ctx, tvdbID)
 }
 
+func (s *tvService) GetSeriesByIMDbID(ctx context.Context, imdbID string) (*Series, error) {
+	return s.repo.GetSeriesByIMDbID(ctx, imdbID)
+}
+
 func (s *tvService) GetSeriesBySonarrID(ctx context.Context, sonarrID int32) (*Series, error) {
 	return s.repo.GetSeriesBySonarrID(ctx, sonarrID)
 }
@@ -130,27 +161,31 @@ func (s *tvService) ListSeries(ctx context.Context, filters SeriesListFilters) (
 }
 
 func (s *tvService) CountSeries(ctx context.Context) (int64, error) {
-	return s.repo.CountSeries(ctx)
+	return s.repo.CountSeries(ctx, nil)
 }
 
 func (s *tvService) SearchSeries(ctx context.Context, query string, limit, offset int32) ([]Series, error) {
 	return s.repo.SearchSeriesByTitle(ctx, query, limit, offset)
 }
 
-func (s *tvService) ListRecentlyAdded(ctx context.Context, limit, offset int32) ([]Series, int64, error) {
-	series, err := s.repo.ListRecentlyAddedSeries(ctx, limit, offset)
+func (s *tvService) ListRecentlyAdded(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Series, int64, error) {
+	series, err := s.repo.ListRecentlyAddedSeries(ctx, libraryPaths, limit, offset)
 	if err != nil {
 		return nil, 0, err
 	}
-	count, err := s.repo.CountSeries(ctx)
+	count, err := s.repo.CountSeries(ctx, libraryPaths)
 	if err != nil {
 		return nil, 0, err
 	}
 	return series, count, nil
 }
 
-func (s *tvService) ListByGenre(ctx context.Context, slug string, limit, offset int32) ([]Series, error) {
-	return s.repo.ListSeriesByGenre(ctx, slug, limit, offset)
+func (s *tvService) ListByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Series, error) {
+	return s.repo.ListSeriesByGenre(ctx, slug, libraryPaths, limit, offset)
+}
+
+func (s *tvService) ListByTag(ctx context.Context, slug string, limit, offset int32) ([]Series, error) {
+	return s.repo.ListSeriesByTag(ctx, slug, limit, offset)
 }
 
 func (s *tvService) ListByNetwork(ctx context.Context, networkID uuid.UUID, limit, offset int32) ([]Series, error) {
@@ -448,6 +483,16 @@ func (s *tvService) ListDistinctGenres(ctx context.Context) ([]content.GenreSumm
 	return s.repo.ListDistinctSeriesGenres(ctx)
 }
 
+// GetSeriesTags returns keywords and tags for a series, including spoiler tags.
+func (s *tvService) GetSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]SeriesTag, error) {
+	return s.repo.ListSeriesTags(ctx, seriesID)
+}
+
+// ListDistinctTags returns the most used non-spoiler TV show tags with item counts.
+func (s *tvService) ListDistinctTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	return s.repo.ListDistinctSeriesTags(ctx, limit)
+}
+
 func (s *tvService) GetSeriesNetworks(ctx context.Context, seriesID uuid.UUID) ([]Network, error) {
 	return s.repo.ListNetworksBySeries(ctx, seriesID)
 }
@@ -551,14 +596,25 @@ func (s *tvService) RemoveSeriesProgress(ctx context.Context, userID, seriesID u
 	return s.repo.DeleteSeriesWatchProgress(ctx, userID, seriesID)
 }
 
-func (s *tvService) GetContinueWatching(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
-	return s.repo.ListContinueWatchingSeries(ctx, userID, limit)
+// GetContinueWatching returns the series the user is watching with the
+// episode in progress. A non-nil libraryPaths limits them to episodes with
+// a file under one of the path prefixes.
+func (s *tvService) GetContinueWatching(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	return s.repo.ListContinueWatchingSeries(ctx, userID, libraryPaths, limit)
 }
 
 func (s *tvService) GetNextEpisode(ctx context.Context, userID, seriesID uuid.UUID) (*Episode, error) {
 	return s.repo.GetNextUnwatchedEpisode(ctx, userID, seriesID)
 }
 
+// ListNextUp returns the next episode of each series the user is watching
+// and has no episode in progress of, most recently watched first. A non-nil
+// libraryPaths limits them to series with an episode file under one of the
+// path prefixes.
+func (s *tvService) ListNextUp(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]NextUpItem, error) {
+	return s.repo.ListNextUpEpisodes(ctx, userID, libraryPaths, limit)
+}
+
 func (s *tvService) GetSeriesWatchStats(ctx context.Context, userID, seriesID uuid.UUID) (*SeriesWatchStats, error) {
 	return s.repo.GetSeriesWatchStats(ctx, userID, seriesID)
 }
@@ -571,6 +627,72 @@ func (s *tvService) GetUserStats(ctx context.Context, userID uuid.UUID) (*UserTV
 // Metadata Operations
 // =============================================================================
 
+// ListSeriesDueForRefresh returns series whose metadata is older than the
+// cutoff of their class, least recently refreshed first.
+func (s *tvService) ListSeriesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Series, error) {
+	return s.repo.ListSeriesDueForRefresh(ctx, cutoffs, limit)
+}
+
+// ListSeriesPendingTagImport returns series added before tags were supported
+// whose tags have not been imported yet, ordered by ID after the given one.
+func (s *tvService) ListSeriesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Series, error) {
+	return s.repo.ListSeriesPendingTagImport(ctx, after, limit)
+}
+
+// ImportSeriesTags imports the keywords and tags of a series from the
+// metadata provider and marks them as imported.
+func (s *tvService) ImportSeriesTags(ctx context.Context, id uuid.UUID) error {
+	if s.metadataProvider == nil {
+		return fmt.Errorf("metadata provider not configured")
+	}
+
+	series, err := s.repo.GetSeries(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get series: %w", err)
+	}
+
+	if series.TMDbID != nil {
+		tags, err := s.metadataProvider.GetSeriesTags(ctx, series.ID, fmt.Sprintf("%d", *series.TMDbID))
+		if err != nil {
+			return fmt.Errorf("get series tags: %w", err)
+		}
+		if len(tags) > 0 {
+			s.replaceSeriesTags(ctx, series.ID, tags)
+		}
+	}
+
+	if err := s.repo.SetSeriesTagsImported(ctx, series.ID); err != nil {
+		return fmt.Errorf("mark series tags imported: %w", err)
+	}
+	return nil
+}
+
+// replaceSeriesTags replaces the keywords and tags of a series.
+func (s *tvService) replaceSeriesTags(ctx context.Context, seriesID uuid.UUID, tags []SeriesTag) {
+	_ = s.repo.DeleteSeriesTags(ctx, seriesID)
+	for _, tag := range tags {
+		_ = s.repo.AddSeriesTag(ctx, seriesID, tag.Slug, tag.Name, tag.Spoiler)
+	}
+}
+
+// SetSeriesImagePlaceholder stores the placeholder of the series' poster or
+// backdrop at path.
+func (s *tvService) SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return s.repo.SetSeriesImagePlaceholder(ctx, id, path, placeholder)
+}
+
+// SetSeasonImagePlaceholder stores the placeholder of the season poster at
+// path.
+func (s *tvService) SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return s.repo.SetSeasonImagePlaceholder(ctx, id, path, placeholder)
+}
+
+// SetEpisodeImagePlaceholder stores the placeholder of the episode still at
+// path.
+func (s *tvService) SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return s.repo.SetEpisodeImagePlaceholder(ctx, id, path, placeholder)
+}
+
 func (s *tvService) RefreshSeriesMetadata(ctx context.Context, id uuid.UUID, opts ...MetadataRefreshOptions) error {
 	// Check if metadata provider is available
 	if s.metadataProvider == nil {
@@ -584,12 +706,19 @@ func (s *tvService) RefreshSeriesMetadata(ctx context.Context, id uuid.UUID, opt
 	}
 
 	// Enrich with latest metadata from TMDb, passing options through
+	locked := series.LockedFields
+	posterPath, backdropPath := series.PosterPath, series.BackdropPath
 	if err := s.metadataProvider.EnrichSeries(ctx, series, opts...); err != nil {
 		return fmt.Errorf("enrich series: %w", err)
 	}
+	keepLocalImage(&series.PosterPath, posterPath)
+	keepLocalImage(&series.BackdropPath, backdropPath)
 
 	// Build update params from enriched series
 	params := seriesToUpdateParams(series)
+	params.ApplyLocks(locked)
+	// Record the refresh so the scheduled refresh moves on to other series
+	params.MetadataUpdatedAt = new(time.Now().UTC().Format(time.RFC3339))
 
 	// Update the series
 	if _, err := s.repo.UpdateSeries(ctx, params); err != nil {
@@ -605,6 +734,8 @@ func (s *tvService) RefreshSeriesMetadata(ctx context.Context, id uuid.UUID, opt
 				_, _ = s.repo.CreateSeriesCredit(ctx, CreateSeriesCreditParams{
 					SeriesID:     credit.SeriesID,
 					TMDbPersonID: credit.TMDbPersonID,
+					TVDbPersonID: credit.TVDbPersonID,
+					IMDbPersonID: credit.IMDbPersonID,
 					Name:         credit.Name,
 					CreditType:   credit.CreditType,
 					Character:    credit.Character,
@@ -616,14 +747,22 @@ func (s *tvService) RefreshSeriesMetadata(ctx context.Context, id uuid.UUID, opt
 			}
 		}
 
-		// Update genres
-		genres, err := s.metadataProvider.GetSeriesGenres(ctx, series.ID, fmt.Sprintf("%d", *series.TMDbID))
-		if err == nil && len(genres) > 0 {
-			_ = s.repo.DeleteSeriesGenres(ctx, series.ID)
-			for _, genre := range genres {
-				_ = s.repo.AddSeriesGenre(ctx, series.ID, genre.Slug, genre.Name)
+		// Update genres unless they were edited manually
+		if !locked.Has(content.FieldGenres) {
+			genres, err := s.metadataProvider.GetSeriesGenres(ctx, series.ID, fmt.Sprintf("%d", *series.TMDbID))
+			if err == nil && len(genres) > 0 {
+				_ = s.repo.DeleteSeriesGenres(ctx, series.ID)
+				for _, genre := range genres {
+					_ = s.repo.AddSeriesGenre(ctx, series.ID, genre.Slug, genre.Name)
+				}
 			}
 		}
+
+		// Update keywords and tags
+		tags, err := s.metadataProvider.GetSeriesTags(ctx, series.ID, fmt.Sprintf("%d", *series.TMDbID))
+		if err == nil && len(tags) > 0 {
+			s.replaceSeriesTags(ctx, series.ID, tags)
+		}
 	}
 
 	return nil
@@ -652,12 +791,16 @@ func (s *tvService) RefreshSeasonMetadata(ctx context.Context, id uuid.UUID, opt
 	}
 
 	// Enrich with latest metadata from TMDb, passing options through
-	if err := s.metadataProvider.EnrichSeason(ctx, season, fmt.Sprintf("%d", *series.TMDbID), opts...); err != nil {
+	locked := season.LockedFields
+	posterPath := season.PosterPath
+	if err := s.metadataProvider.EnrichSeason(ctx, season, fmt.Sprintf("%d", *series.TMDbID), withSeriesProviderIDs(opts, series)...); err != nil {
 		return fmt.Errorf("enrich season: %w", err)
 	}
+	keepLocalImage(&season.PosterPath, posterPath)
 
 	// Build update params from enriched season
 	params := seasonToUpdateParams(season)
+	params.ApplyLocks(locked)
 
 	// Update the season
 	if _, err := s.repo.UpdateSeason(ctx, params); err != nil {
@@ -690,12 +833,14 @@ func (s *tvService) RefreshEpisodeMetadata(ctx context.Context, id uuid.UUID, op
 	}
 
 	// Enrich with latest metadata from TMDb, passing options through
-	if err := s.metadataProvider.EnrichEpisode(ctx, episode, fmt.Sprintf("%d", *series.TMDbID), opts...); err != nil {
+	locked := episode.LockedFields
+	if err := s.metadataProvider.EnrichEpisode(ctx, episode, fmt.Sprintf("%d", *series.TMDbID), withSeriesProviderIDs(opts, series)...); err != nil {
 		return fmt.Errorf("enrich episode: %w", err)
 	}
 
 	// Build update params from enriched episode
 	params := episodeToUpdateParams(episode)
+	params.ApplyLocks(locked)
 
 	// Update the episode
 	if _, err := s.repo.UpdateEpisode(ctx, params); err != nil {
@@ -705,6 +850,25 @@ func (s *tvService) RefreshEpisodeMetadata(ctx context.Context, id uuid.UUID, op
 	return nil
 }
 
+// withSeriesProviderIDs adds the series' provider IDs to season and episode
+// refresh options.
+func withSeriesProviderIDs(opts []MetadataRefreshOptions, series *Series) []MetadataRefreshOptions {
+	var o MetadataRefreshOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	o.SeriesProviderIDs = series.AllProviderIDs()
+	return []MetadataRefreshOptions{o}
+}
+
+// keepLocalImage restores a local artwork path that a provider refresh
+// replaced, so preferred local artwork survives metadata refreshes.
+func keepLocalImage(dst **string, previous *string) {
+	if previous != nil && artwork.IsLocalPath(*previous) {
+		*dst = previous
+	}
+}
+
 // seriesToUpdateParams converts a Series to UpdateSeriesParams
 func seriesToUpdateParams(s *Series) UpdateSeriesParams {
 	params := UpdateSeriesParams{
@@ -732,6 +896,8 @@ func seriesToUpdateParams(s *Series) UpdateSeriesParams {
 		OverviewsI18n:    s.OverviewsI18n,
 		AgeRatings:       s.AgeRatings,
 		ExternalRatings:  s.ExternalRatings,
+		MetadataSources:  s.MetadataSources,
+		ProviderIDs:      s.ProviderIDs,
 	}
 	if s.FirstAirDate != nil {
 		d := s.FirstAirDate.Format("2006-01-02")
@@ -755,15 +921,16 @@ func seriesToUpdateParams(s *Series) UpdateSeriesParams {
 // seasonToUpdateParams converts a Season to UpdateSeasonParams
 func seasonToUpdateParams(s *Season) UpdateSeasonParams {
 	params := UpdateSeasonParams{
-		ID:            s.ID,
-		TMDbID:        s.TMDbID,
-		SeasonNumber:  &s.SeasonNumber,
-		Name:          &s.Name,
-		Overview:      s.Overview,
-		PosterPath:    s.PosterPath,
-		EpisodeCount:  &s.EpisodeCount,
-		NamesI18n:     s.NamesI18n,
-		OverviewsI18n: s.OverviewsI18n,
+		ID:              s.ID,
+		TMDbID:          s.TMDbID,
+		SeasonNumber:    &s.SeasonNumber,
+		Name:            &s.Name,
+		Overview:        s.Overview,
+		PosterPath:      s.PosterPath,
+		EpisodeCount:    &s.EpisodeCount,
+		NamesI18n:       s.NamesI18n,
+		OverviewsI18n:   s.OverviewsI18n,
+		MetadataSources: s.MetadataSources,
 	}
 	if s.AirDate != nil {
 		d := s.AirDate.Format("2006-01-02")
@@ -779,20 +946,21 @@ func seasonToUpdateParams(s *Season) UpdateSeasonParams {
 // episodeToUpdateParams converts an Episode to UpdateEpisodeParams
 func episodeToUpdateParams(e *Episode) UpdateEpisodeParams {
 	params := UpdateEpisodeParams{
-		ID:             e.ID,
-		TMDbID:         e.TMDbID,
-		TVDbID:         e.TVDbID,
-		IMDbID:         e.IMDbID,
-		SeasonNumber:   &e.SeasonNumber,
-		EpisodeNumber:  &e.EpisodeNumber,
-		Title:          &e.Title,
-		Overview:       e.Overview,
-		Runtime:        e.Runtime,
-		VoteCount:      e.VoteCount,
-		StillPath:      e.StillPath,
-		ProductionCode: e.ProductionCode,
-		TitlesI18n:     e.TitlesI18n,
-		OverviewsI18n:  e.OverviewsI18n,
+		ID:              e.ID,
+		TMDbID:          e.TMDbID,
+		TVDbID:          e.TVDbID,
+		IMDbID:          e.IMDbID,
+		SeasonNumber:    &e.SeasonNumber,
+		EpisodeNumber:   &e.EpisodeNumber,
+		Title:           &e.Title,
+		Overview:        e.Overview,
+		Runtime:         e.Runtime,
+		VoteCount:       e.VoteCount,
+		StillPath:       e.StillPath,
+		ProductionCode:  e.ProductionCode,
+		TitlesI18n:      e.TitlesI18n,
+		OverviewsI18n:   e.OverviewsI18n,
+		MetadataSources: e.MetadataSources,
 	}
 	if e.AirDate != nil {
 		d := e.AirDate.Format("2006-01-02")
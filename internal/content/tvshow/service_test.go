@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
 	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -45,6 +46,14 @@ func (m *MockRepository) GetSeriesByTVDbID(ctx context.Context, tvdbID int32) (*
 	return args.Get(0).(*Series), args.Error(1)
 }
 
+func (m *MockRepository) GetSeriesByIMDbID(ctx context.Context, imdbID string) (*Series, error) {
+	args := m.Called(ctx, imdbID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
 func (m *MockRepository) GetSeriesBySonarrID(ctx context.Context, sonarrID int32) (*Series, error) {
 	args := m.Called(ctx, sonarrID)
 	if args.Get(0) == nil {
@@ -58,11 +67,16 @@ func (m *MockRepository) ListSeries(ctx context.Context, filters SeriesListFilte
 	return args.Get(0).([]Series), args.Error(1)
 }
 
-func (m *MockRepository) CountSeries(ctx context.Context) (int64, error) {
-	args := m.Called(ctx)
+func (m *MockRepository) CountSeries(ctx context.Context, libraryPaths []string) (int64, error) {
+	args := m.Called(ctx, libraryPaths)
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockRepository) ListSeriesDueForRefresh(ctx context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]Series, error) {
+	args := m.Called(ctx, cutoffs, limit)
+	return args.Get(0).([]Series), args.Error(1)
+}
+
 func (m *MockRepository) SearchSeriesByTitle(ctx context.Context, query string, limit, offset int32) ([]Series, error) {
 	args := m.Called(ctx, query, limit, offset)
 	return args.Get(0).([]Series), args.Error(1)
@@ -73,13 +87,13 @@ func (m *MockRepository) SearchSeriesByTitleAnyLanguage(ctx context.Context, que
 	return args.Get(0).([]Series), args.Error(1)
 }
 
-func (m *MockRepository) ListRecentlyAddedSeries(ctx context.Context, limit, offset int32) ([]Series, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockRepository) ListRecentlyAddedSeries(ctx context.Context, libraryPaths []string, limit, offset int32) ([]Series, error) {
+	args := m.Called(ctx, libraryPaths, limit, offset)
 	return args.Get(0).([]Series), args.Error(1)
 }
 
-func (m *MockRepository) ListSeriesByGenre(ctx context.Context, slug string, limit, offset int32) ([]Series, error) {
-	args := m.Called(ctx, slug, limit, offset)
+func (m *MockRepository) ListSeriesByGenre(ctx context.Context, slug string, libraryPaths []string, limit, offset int32) ([]Series, error) {
+	args := m.Called(ctx, slug, libraryPaths, limit, offset)
 	return args.Get(0).([]Series), args.Error(1)
 }
 
@@ -170,6 +184,42 @@ func (m *MockRepository) UpdateSeason(ctx context.Context, params UpdateSeasonPa
 	return args.Get(0).(*Season), args.Error(1)
 }
 
+func (m *MockRepository) SetSeriesLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Series, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Series), args.Error(1)
+}
+
+func (m *MockRepository) SetSeasonLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Season, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Season), args.Error(1)
+}
+
+func (m *MockRepository) SetEpisodeLockedFields(ctx context.Context, id uuid.UUID, fields []string) (*Episode, error) {
+	args := m.Called(ctx, id, fields)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Episode), args.Error(1)
+}
+
+func (m *MockRepository) SetSeriesImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
+func (m *MockRepository) SetSeasonImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
+func (m *MockRepository) SetEpisodeImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
 func (m *MockRepository) DeleteSeason(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -416,6 +466,51 @@ func (m *MockRepository) ListDistinctSeriesGenres(ctx context.Context) ([]conten
 	return args.Get(0).([]content.GenreSummary), args.Error(1)
 }
 
+// Tag operations
+func (m *MockRepository) AddSeriesTag(ctx context.Context, seriesID uuid.UUID, slug string, name string, spoiler bool) error {
+	args := m.Called(ctx, seriesID, slug, name, spoiler)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListSeriesTags(ctx context.Context, seriesID uuid.UUID) ([]SeriesTag, error) {
+	args := m.Called(ctx, seriesID)
+	return args.Get(0).([]SeriesTag), args.Error(1)
+}
+
+func (m *MockRepository) DeleteSeriesTags(ctx context.Context, seriesID uuid.UUID) error {
+	args := m.Called(ctx, seriesID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListSeriesPendingTagImport(ctx context.Context, after uuid.UUID, limit int32) ([]Series, error) {
+	args := m.Called(ctx, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Series), args.Error(1)
+}
+
+func (m *MockRepository) SetSeriesTagsImported(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListDistinctSeriesTags(ctx context.Context, limit int32) ([]content.TagSummary, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]content.TagSummary), args.Error(1)
+}
+
+func (m *MockRepository) ListSeriesByTag(ctx context.Context, slug string, limit, offset int32) ([]Series, error) {
+	args := m.Called(ctx, slug, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Series), args.Error(1)
+}
+
 // Network operations
 func (m *MockRepository) CreateNetwork(ctx context.Context, params CreateNetworkParams) (*Network, error) {
 	args := m.Called(ctx, params)
@@ -496,8 +591,8 @@ func (m *MockRepository) DeleteSeriesWatchProgress(ctx context.Context, userID,
 	return args.Error(0)
 }
 
-func (m *MockRepository) ListContinueWatchingSeries(ctx context.Context, userID uuid.UUID, limit int32) ([]ContinueWatchingItem, error) {
-	args := m.Called(ctx, userID, limit)
+func (m *MockRepository) ListContinueWatchingSeries(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]ContinueWatchingItem, error) {
+	args := m.Called(ctx, userID, libraryPaths, limit)
 	return args.Get(0).([]ContinueWatchingItem), args.Error(1)
 }
 
@@ -535,6 +630,11 @@ func (m *MockRepository) GetNextUnwatchedEpisode(ctx context.Context, userID, se
 	return args.Get(0).(*Episode), args.Error(1)
 }
 
+func (m *MockRepository) ListNextUpEpisodes(ctx context.Context, userID uuid.UUID, libraryPaths []string, limit int32) ([]NextUpItem, error) {
+	args := m.Called(ctx, userID, libraryPaths, limit)
+	return args.Get(0).([]NextUpItem), args.Error(1)
+}
+
 // Ensure MockRepository implements Repository
 var _ Repository = (*MockRepository)(nil)
 
@@ -895,9 +995,10 @@ func TestGetContinueWatching(t *testing.T) {
 		},
 	}
 
-	repo.On("ListContinueWatchingSeries", ctx, userID, int32(10)).Return(expected, nil)
+	libraryPaths := []string{"/media/tv/"}
+	repo.On("ListContinueWatchingSeries", ctx, userID, libraryPaths, int32(10)).Return(expected, nil)
 
-	result, err := svc.GetContinueWatching(ctx, userID, 10)
+	result, err := svc.GetContinueWatching(ctx, userID, libraryPaths, 10)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, result)
 	repo.AssertExpectations(t)
@@ -920,6 +1021,27 @@ func TestGetNextEpisode(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestListNextUp(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	svc := NewService(repo, nil)
+
+	userID := uuid.Must(uuid.NewV7())
+	expected := []NextUpItem{
+		{
+			Episode:     Episode{ID: uuid.Must(uuid.NewV7()), SeasonNumber: 1, EpisodeNumber: 3, Title: "...And the Bag's in the River"},
+			SeriesTitle: "Breaking Bad",
+		},
+	}
+
+	repo.On("ListNextUpEpisodes", ctx, userID, []string(nil), int32(20)).Return(expected, nil)
+
+	result, err := svc.ListNextUp(ctx, userID, nil, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	repo.AssertExpectations(t)
+}
+
 func TestDeleteEpisode_DeletesFiles(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockRepository)
@@ -1007,6 +1129,14 @@ func (m *MockMetadataProvider) GetSeriesGenres(ctx context.Context, seriesID uui
 	return args.Get(0).([]SeriesGenre), args.Error(1)
 }
 
+func (m *MockMetadataProvider) GetSeriesTags(ctx context.Context, seriesID uuid.UUID, providerID string) ([]SeriesTag, error) {
+	args := m.Called(ctx, seriesID, providerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]SeriesTag), args.Error(1)
+}
+
 func (m *MockMetadataProvider) GetSeriesNetworks(ctx context.Context, providerID string) ([]Network, error) {
 	args := m.Called(ctx, providerID)
 	if args.Get(0) == nil {
@@ -1063,7 +1193,7 @@ func TestCountSeries(t *testing.T) {
 	repo := new(MockRepository)
 	svc := NewService(repo, nil)
 
-	repo.On("CountSeries", ctx).Return(int64(42), nil)
+	repo.On("CountSeries", ctx, []string(nil)).Return(int64(42), nil)
 
 	result, err := svc.CountSeries(ctx)
 	assert.NoError(t, err)
@@ -1076,7 +1206,7 @@ func TestCountSeries_Error(t *testing.T) {
 	repo := new(MockRepository)
 	svc := NewService(repo, nil)
 
-	repo.On("CountSeries", ctx).Return(int64(0), errors.New("db error"))
+	repo.On("CountSeries", ctx, []string(nil)).Return(int64(0), errors.New("db error"))
 
 	result, err := svc.CountSeries(ctx)
 	assert.Error(t, err)
@@ -1210,10 +1340,10 @@ func TestListRecentlyAdded(t *testing.T) {
 		{ID: uuid.Must(uuid.NewV7()), Title: "New Show"},
 	}
 
-	repo.On("ListRecentlyAddedSeries", ctx, int32(10), int32(0)).Return(expected, nil)
-	repo.On("CountSeries", ctx).Return(int64(1), nil)
+	repo.On("ListRecentlyAddedSeries", ctx, []string(nil), int32(10), int32(0)).Return(expected, nil)
+	repo.On("CountSeries", ctx, []string(nil)).Return(int64(1), nil)
 
-	result, total, err := svc.ListRecentlyAdded(ctx, 10, 0)
+	result, total, err := svc.ListRecentlyAdded(ctx, nil, 10, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, result)
 	assert.Equal(t, int64(1), total)
@@ -1229,9 +1359,9 @@ func TestListByGenre(t *testing.T) {
 		{ID: uuid.Must(uuid.NewV7()), Title: "Drama Show"},
 	}
 
-	repo.On("ListSeriesByGenre", ctx, "drama", int32(10), int32(0)).Return(expected, nil)
+	repo.On("ListSeriesByGenre", ctx, "drama", []string(nil), int32(10), int32(0)).Return(expected, nil)
 
-	result, err := svc.ListByGenre(ctx, "drama", 10, 0)
+	result, err := svc.ListByGenre(ctx, "drama", nil, 10, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, result)
 	repo.AssertExpectations(t)
@@ -2606,12 +2736,42 @@ func TestRefreshSeriesMetadata_Success(t *testing.T) {
 	repo.On("DeleteSeriesGenres", ctx, seriesID).Return(nil)
 	repo.On("AddSeriesGenre", ctx, seriesID, "drama", "Drama").Return(nil)
 
+	// Tags
+	tags := []SeriesTag{
+		{SeriesID: seriesID, Slug: "drug-dealer", Name: "drug dealer"},
+	}
+	provider.On("GetSeriesTags", ctx, seriesID, "1396").Return(tags, nil)
+	repo.On("DeleteSeriesTags", ctx, seriesID).Return(nil)
+	repo.On("AddSeriesTag", ctx, seriesID, "drug-dealer", "drug dealer", false).Return(nil)
+
 	err := svc.RefreshSeriesMetadata(ctx, seriesID)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 	provider.AssertExpectations(t)
 }
 
+func TestRefreshSeriesMetadata_RecordsRefresh(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	provider := new(MockMetadataProvider)
+	svc := NewService(repo, provider)
+
+	series := &Series{ID: uuid.Must(uuid.NewV7()), Title: "Severance", OriginalLanguage: "en"}
+	repo.On("GetSeries", ctx, series.ID).Return(series, nil)
+	provider.On("EnrichSeries", ctx, series, []MetadataRefreshOptions(nil)).Return(nil)
+	repo.On("UpdateSeries", ctx, mock.MatchedBy(func(p UpdateSeriesParams) bool {
+		if p.MetadataUpdatedAt == nil {
+			return false
+		}
+		refreshed, err := time.Parse(time.RFC3339, *p.MetadataUpdatedAt)
+		return err == nil && time.Since(refreshed) < time.Minute
+	})).Return(series, nil)
+
+	assert.NoError(t, svc.RefreshSeriesMetadata(ctx, series.ID))
+	repo.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
+
 func TestRefreshSeriesMetadata_SeriesNotFound(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockRepository)
@@ -2688,6 +2848,46 @@ func TestRefreshSeriesMetadata_UpdateFails(t *testing.T) {
 	provider.AssertExpectations(t)
 }
 
+func TestImportSeriesTags_Success(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	provider := new(MockMetadataProvider)
+	svc := NewService(repo, provider)
+
+	seriesID := uuid.Must(uuid.NewV7())
+	tmdbID := int32(1396)
+	series := &Series{ID: seriesID, Title: "Breaking Bad", TMDbID: &tmdbID}
+
+	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
+	provider.On("GetSeriesTags", ctx, seriesID, "1396").Return([]SeriesTag{
+		{SeriesID: seriesID, Slug: "drug-dealer", Name: "drug dealer"},
+	}, nil)
+	repo.On("DeleteSeriesTags", ctx, seriesID).Return(nil)
+	repo.On("AddSeriesTag", ctx, seriesID, "drug-dealer", "drug dealer", false).Return(nil)
+	repo.On("SetSeriesTagsImported", ctx, seriesID).Return(nil)
+
+	assert.NoError(t, svc.ImportSeriesTags(ctx, seriesID))
+	repo.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
+
+func TestImportSeriesTags_ProviderFails(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRepository)
+	provider := new(MockMetadataProvider)
+	svc := NewService(repo, provider)
+
+	seriesID := uuid.Must(uuid.NewV7())
+	tmdbID := int32(1396)
+	series := &Series{ID: seriesID, Title: "Breaking Bad", TMDbID: &tmdbID}
+
+	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
+	provider.On("GetSeriesTags", ctx, seriesID, "1396").Return(nil, errors.New("rate limited"))
+
+	assert.Error(t, svc.ImportSeriesTags(ctx, seriesID))
+	repo.AssertNotCalled(t, "SetSeriesTagsImported", mock.Anything, mock.Anything)
+}
+
 func TestRefreshSeasonMetadata_NoProvider(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockRepository)
@@ -2712,7 +2912,7 @@ func TestRefreshSeasonMetadata_Success(t *testing.T) {
 
 	repo.On("GetSeason", ctx, seasonID).Return(season, nil)
 	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
-	provider.On("EnrichSeason", ctx, season, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions(nil)).Return(nil)
+	provider.On("EnrichSeason", ctx, season, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions{{SeriesProviderIDs: map[string]string{"tmdb": fmt.Sprintf("%d", tmdbID)}}}).Return(nil)
 	repo.On("UpdateSeason", ctx, mock.AnythingOfType("UpdateSeasonParams")).Return(season, nil)
 
 	err := svc.RefreshSeasonMetadata(ctx, seasonID)
@@ -2789,7 +2989,7 @@ func TestRefreshSeasonMetadata_EnrichFails(t *testing.T) {
 
 	repo.On("GetSeason", ctx, seasonID).Return(season, nil)
 	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
-	provider.On("EnrichSeason", ctx, season, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions(nil)).Return(errors.New("API error"))
+	provider.On("EnrichSeason", ctx, season, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions{{SeriesProviderIDs: map[string]string{"tmdb": fmt.Sprintf("%d", tmdbID)}}}).Return(errors.New("API error"))
 
 	err := svc.RefreshSeasonMetadata(ctx, seasonID)
 	assert.Error(t, err)
@@ -2822,7 +3022,7 @@ func TestRefreshEpisodeMetadata_Success(t *testing.T) {
 
 	repo.On("GetEpisode", ctx, episodeID).Return(episode, nil)
 	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
-	provider.On("EnrichEpisode", ctx, episode, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions(nil)).Return(nil)
+	provider.On("EnrichEpisode", ctx, episode, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions{{SeriesProviderIDs: map[string]string{"tmdb": fmt.Sprintf("%d", tmdbID)}}}).Return(nil)
 	repo.On("UpdateEpisode", ctx, mock.AnythingOfType("UpdateEpisodeParams")).Return(episode, nil)
 
 	err := svc.RefreshEpisodeMetadata(ctx, episodeID)
@@ -2899,7 +3099,7 @@ func TestRefreshEpisodeMetadata_EnrichFails(t *testing.T) {
 
 	repo.On("GetEpisode", ctx, episodeID).Return(episode, nil)
 	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
-	provider.On("EnrichEpisode", ctx, episode, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions(nil)).Return(errors.New("API error"))
+	provider.On("EnrichEpisode", ctx, episode, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions{{SeriesProviderIDs: map[string]string{"tmdb": fmt.Sprintf("%d", tmdbID)}}}).Return(errors.New("API error"))
 
 	err := svc.RefreshEpisodeMetadata(ctx, episodeID)
 	assert.Error(t, err)
@@ -2922,7 +3122,7 @@ func TestRefreshEpisodeMetadata_UpdateFails(t *testing.T) {
 
 	repo.On("GetEpisode", ctx, episodeID).Return(episode, nil)
 	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
-	provider.On("EnrichEpisode", ctx, episode, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions(nil)).Return(nil)
+	provider.On("EnrichEpisode", ctx, episode, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions{{SeriesProviderIDs: map[string]string{"tmdb": fmt.Sprintf("%d", tmdbID)}}}).Return(nil)
 	repo.On("UpdateEpisode", ctx, mock.AnythingOfType("UpdateEpisodeParams")).Return(nil, errors.New("update failed"))
 
 	err := svc.RefreshEpisodeMetadata(ctx, episodeID)
@@ -3076,7 +3276,7 @@ func TestRefreshSeasonMetadata_UpdateFails(t *testing.T) {
 
 	repo.On("GetSeason", ctx, seasonID).Return(season, nil)
 	repo.On("GetSeries", ctx, seriesID).Return(series, nil)
-	provider.On("EnrichSeason", ctx, season, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions(nil)).Return(nil)
+	provider.On("EnrichSeason", ctx, season, fmt.Sprintf("%d", tmdbID), []MetadataRefreshOptions{{SeriesProviderIDs: map[string]string{"tmdb": fmt.Sprintf("%d", tmdbID)}}}).Return(nil)
 	repo.On("UpdateSeason", ctx, mock.AnythingOfType("UpdateSeasonParams")).Return(nil, errors.New("update failed"))
 
 	err := svc.RefreshSeasonMetadata(ctx, seasonID)
@@ -4,6 +4,8 @@ package tvshow
 
 import (
 	"fmt"
+	"maps"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,6 +22,7 @@ type Series struct {
 	SonarrID          *int32
 	Title             string
 	OriginalTitle     *string
+	SortTitle         *string
 	OriginalLanguage  string
 	Tagline           *string
 	Overview          *string
@@ -37,6 +40,10 @@ type Series struct {
 	TrailerURL        *string
 	Homepage          *string
 	MetadataUpdatedAt *time.Time
+	LockedFields      content.LockedFields      // Manually edited fields kept on refresh
+	MetadataSources   map[string]string         // {"title": "anilist", "images": "tmdb"}
+	ProviderIDs       map[string]string         // {"tmdb": "1399", "anilist": "5114"}
+	ImagePlaceholders content.ImagePlaceholders // Placeholders of the poster and backdrop
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 
@@ -45,6 +52,7 @@ type Series struct {
 	TaglinesI18n  map[string]string            // {"en": "All Hail the King", "de": "Heil dem König"}
 	OverviewsI18n map[string]string            // {"en": "A chemistry teacher...", "de": "Ein Chemielehrer..."}
 	AgeRatings    map[string]map[string]string // {"US": {"TV": "TV-MA"}, "DE": {"FSK": "16"}}
+	MinAge        *int32                       // Minimum viewer age normalized from AgeRatings, nil when unrated
 
 	// External ratings from various providers (IMDb, Rotten Tomatoes, Metacritic, TMDb, etc.)
 	ExternalRatings []ExternalRating
@@ -66,34 +74,7 @@ type ExternalRating = content.ExternalRating
 // 4. OriginalTitle field
 // 5. Default Title field
 func (s *Series) GetTitle(lang string) string {
-	// Try requested language
-	if s.TitlesI18n != nil {
-		if title, ok := s.TitlesI18n[lang]; ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to English
-	if s.TitlesI18n != nil {
-		if title, ok := s.TitlesI18n["en"]; ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to original language
-	if s.OriginalLanguage != "" && s.TitlesI18n != nil {
-		if title, ok := s.TitlesI18n[s.OriginalLanguage]; ok && title != "" {
-			return title
-		}
-	}
-
-	// Fallback to OriginalTitle
-	if s.OriginalTitle != nil && *s.OriginalTitle != "" {
-		return *s.OriginalTitle
-	}
-
-	// Final fallback to default field
-	return s.Title
+	return s.TitleIn(content.DefaultLanguageChain(lang))
 }
 
 // GetTagline returns the series tagline in the preferred language with fallback chain:
@@ -102,33 +83,7 @@ func (s *Series) GetTitle(lang string) string {
 // 3. Original language from TaglinesI18n
 // 4. Default Tagline field
 func (s *Series) GetTagline(lang string) string {
-	// Try requested language
-	if s.TaglinesI18n != nil {
-		if tagline, ok := s.TaglinesI18n[lang]; ok && tagline != "" {
-			return tagline
-		}
-	}
-
-	// Fallback to English
-	if s.TaglinesI18n != nil {
-		if tagline, ok := s.TaglinesI18n["en"]; ok && tagline != "" {
-			return tagline
-		}
-	}
-
-	// Fallback to original language
-	if s.OriginalLanguage != "" && s.TaglinesI18n != nil {
-		if tagline, ok := s.TaglinesI18n[s.OriginalLanguage]; ok && tagline != "" {
-			return tagline
-		}
-	}
-
-	// Final fallback to default field
-	if s.Tagline != nil {
-		return *s.Tagline
-	}
-
-	return ""
+	return s.TaglineIn(content.DefaultLanguageChain(lang))
 }
 
 // GetOverview returns the series overview in the preferred language with fallback chain:
@@ -137,33 +92,34 @@ func (s *Series) GetTagline(lang string) string {
 // 3. Original language from OverviewsI18n
 // 4. Default Overview field
 func (s *Series) GetOverview(lang string) string {
-	// Try requested language
-	if s.OverviewsI18n != nil {
-		if overview, ok := s.OverviewsI18n[lang]; ok && overview != "" {
-			return overview
-		}
-	}
+	return s.OverviewIn(content.DefaultLanguageChain(lang))
+}
 
-	// Fallback to English
-	if s.OverviewsI18n != nil {
-		if overview, ok := s.OverviewsI18n["en"]; ok && overview != "" {
-			return overview
-		}
+// TitleIn returns the first title found along the language chain, falling
+// back to the default Title field.
+func (s *Series) TitleIn(chain content.LanguageChain) string {
+	if title, ok := chain.ResolveTitle(s.TitlesI18n, s.OriginalLanguage, derefString(s.OriginalTitle)); ok {
+		return title
 	}
+	return s.Title
+}
 
-	// Fallback to original language
-	if s.OriginalLanguage != "" && s.OverviewsI18n != nil {
-		if overview, ok := s.OverviewsI18n[s.OriginalLanguage]; ok && overview != "" {
-			return overview
-		}
+// TaglineIn returns the first tagline found along the language chain,
+// falling back to the default Tagline field.
+func (s *Series) TaglineIn(chain content.LanguageChain) string {
+	if tagline, ok := chain.Resolve(s.TaglinesI18n, s.OriginalLanguage); ok {
+		return tagline
 	}
+	return derefString(s.Tagline)
+}
 
-	// Final fallback to default field
-	if s.Overview != nil {
-		return *s.Overview
+// OverviewIn returns the first overview found along the language chain,
+// falling back to the default Overview field.
+func (s *Series) OverviewIn(chain content.LanguageChain) string {
+	if overview, ok := chain.Resolve(s.OverviewsI18n, s.OriginalLanguage); ok {
+		return overview
 	}
-
-	return ""
+	return derefString(s.Overview)
 }
 
 // GetAgeRating returns the age rating for a specific country and rating system.
@@ -221,6 +177,20 @@ func (s *Series) GetAvailableAgeRatingCountries() []string {
 	return countries
 }
 
+// AllProviderIDs returns the series' IDs keyed by metadata provider,
+// including the TMDb and TVDb IDs stored in their own columns.
+func (s *Series) AllProviderIDs() map[string]string {
+	ids := make(map[string]string, len(s.ProviderIDs)+2)
+	maps.Copy(ids, s.ProviderIDs)
+	if s.TMDbID != nil {
+		ids["tmdb"] = strconv.Itoa(int(*s.TMDbID))
+	}
+	if s.TVDbID != nil {
+		ids["tvdb"] = strconv.Itoa(int(*s.TVDbID))
+	}
+	return ids
+}
+
 // IsEnded returns true if the series has ended (no more episodes coming).
 func (s *Series) IsEnded() bool {
 	if s.Status == nil {
@@ -232,18 +202,21 @@ func (s *Series) IsEnded() bool {
 
 // Season represents a season within a TV series.
 type Season struct {
-	ID           uuid.UUID
-	SeriesID     uuid.UUID
-	TMDbID       *int32
-	SeasonNumber int32
-	Name         string
-	Overview     *string
-	PosterPath   *string
-	EpisodeCount int32
-	AirDate      *time.Time
-	VoteAverage  *decimal.Decimal
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID                uuid.UUID
+	SeriesID          uuid.UUID
+	TMDbID            *int32
+	SeasonNumber      int32
+	Name              string
+	Overview          *string
+	PosterPath        *string
+	EpisodeCount      int32
+	AirDate           *time.Time
+	VoteAverage       *decimal.Decimal
+	LockedFields      content.LockedFields      // Manually edited fields kept on refresh
+	MetadataSources   map[string]string         // Provider each field was taken from
+	ImagePlaceholders content.ImagePlaceholders // Placeholder of the poster
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 
 	// Multi-language support
 	NamesI18n     map[string]string // {"en": "Season 1", "de": "Staffel 1"}
@@ -255,31 +228,28 @@ type Season struct {
 
 // GetName returns the season name in the preferred language.
 func (s *Season) GetName(lang string) string {
-	if s.NamesI18n != nil {
-		if name, ok := s.NamesI18n[lang]; ok && name != "" {
-			return name
-		}
-		if name, ok := s.NamesI18n["en"]; ok && name != "" {
-			return name
-		}
-	}
-	return s.Name
+	return s.NameIn(content.DefaultLanguageChain(lang))
 }
 
 // GetOverview returns the season overview in the preferred language.
 func (s *Season) GetOverview(lang string) string {
-	if s.OverviewsI18n != nil {
-		if overview, ok := s.OverviewsI18n[lang]; ok && overview != "" {
-			return overview
-		}
-		if overview, ok := s.OverviewsI18n["en"]; ok && overview != "" {
-			return overview
-		}
+	return s.OverviewIn(content.DefaultLanguageChain(lang))
+}
+
+// NameIn returns the first season name found along the language chain.
+func (s *Season) NameIn(chain content.LanguageChain) string {
+	if name, ok := chain.Resolve(s.NamesI18n, ""); ok {
+		return name
 	}
-	if s.Overview != nil {
-		return *s.Overview
+	return s.Name
+}
+
+// OverviewIn returns the first season overview found along the language chain.
+func (s *Season) OverviewIn(chain content.LanguageChain) string {
+	if overview, ok := chain.Resolve(s.OverviewsI18n, ""); ok {
+		return overview
 	}
-	return ""
+	return derefString(s.Overview)
 }
 
 // IsSpecials returns true if this is a specials season (season 0).
@@ -289,24 +259,27 @@ func (s *Season) IsSpecials() bool {
 
 // Episode represents an episode within a season.
 type Episode struct {
-	ID             uuid.UUID
-	SeriesID       uuid.UUID
-	SeasonID       uuid.UUID
-	TMDbID         *int32
-	TVDbID         *int32
-	IMDbID         *string
-	SeasonNumber   int32
-	EpisodeNumber  int32
-	Title          string
-	Overview       *string
-	AirDate        *time.Time
-	Runtime        *int32 // minutes
-	VoteAverage    *decimal.Decimal
-	VoteCount      *int32
-	StillPath      *string
-	ProductionCode *string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID                uuid.UUID
+	SeriesID          uuid.UUID
+	SeasonID          uuid.UUID
+	TMDbID            *int32
+	TVDbID            *int32
+	IMDbID            *string
+	SeasonNumber      int32
+	EpisodeNumber     int32
+	Title             string
+	Overview          *string
+	AirDate           *time.Time
+	Runtime           *int32 // minutes
+	VoteAverage       *decimal.Decimal
+	VoteCount         *int32
+	StillPath         *string
+	ProductionCode    *string
+	LockedFields      content.LockedFields      // Manually edited fields kept on refresh
+	MetadataSources   map[string]string         // Provider each field was taken from
+	ImagePlaceholders content.ImagePlaceholders // Placeholder of the still
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 
 	// Multi-language support
 	TitlesI18n    map[string]string
@@ -319,31 +292,28 @@ type Episode struct {
 
 // GetTitle returns the episode title in the preferred language.
 func (e *Episode) GetTitle(lang string) string {
-	if e.TitlesI18n != nil {
-		if title, ok := e.TitlesI18n[lang]; ok && title != "" {
-			return title
-		}
-		if title, ok := e.TitlesI18n["en"]; ok && title != "" {
-			return title
-		}
-	}
-	return e.Title
+	return e.TitleIn(content.DefaultLanguageChain(lang))
 }
 
 // GetOverview returns the episode overview in the preferred language.
 func (e *Episode) GetOverview(lang string) string {
-	if e.OverviewsI18n != nil {
-		if overview, ok := e.OverviewsI18n[lang]; ok && overview != "" {
-			return overview
-		}
-		if overview, ok := e.OverviewsI18n["en"]; ok && overview != "" {
-			return overview
-		}
+	return e.OverviewIn(content.DefaultLanguageChain(lang))
+}
+
+// TitleIn returns the first episode title found along the language chain.
+func (e *Episode) TitleIn(chain content.LanguageChain) string {
+	if title, ok := chain.Resolve(e.TitlesI18n, ""); ok {
+		return title
 	}
-	if e.Overview != nil {
-		return *e.Overview
+	return e.Title
+}
+
+// OverviewIn returns the first episode overview found along the language chain.
+func (e *Episode) OverviewIn(chain content.LanguageChain) string {
+	if overview, ok := chain.Resolve(e.OverviewsI18n, ""); ok {
+		return overview
 	}
-	return ""
+	return derefString(e.Overview)
 }
 
 // EpisodeCode returns the episode code in SxxExx format.
@@ -385,6 +355,8 @@ type SeriesCredit struct {
 	ID           uuid.UUID
 	SeriesID     uuid.UUID
 	TMDbPersonID int32
+	TVDbPersonID *int32  // Set from metadata to link the person; not stored
+	IMDbPersonID *string // Set from metadata to link the person; not stored
 	Name         string
 	CreditType   string // "cast" or "crew"
 	Character    *string
@@ -392,6 +364,7 @@ type SeriesCredit struct {
 	Department   *string
 	CastOrder    *int32
 	ProfilePath  *string
+	PersonID     *uuid.UUID // Linked person record
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -411,6 +384,8 @@ type EpisodeCredit struct {
 	ID           uuid.UUID
 	EpisodeID    uuid.UUID
 	TMDbPersonID int32
+	TVDbPersonID *int32  // Set from metadata to link the person; not stored
+	IMDbPersonID *string // Set from metadata to link the person; not stored
 	Name         string
 	CreditType   string // "cast" or "crew"
 	Character    *string
@@ -418,6 +393,7 @@ type EpisodeCredit struct {
 	Department   *string
 	CastOrder    *int32
 	ProfilePath  *string
+	PersonID     *uuid.UUID // Linked person record
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -441,6 +417,17 @@ type SeriesGenre struct {
 	CreatedAt time.Time
 }
 
+// SeriesTag represents a keyword or tag associated with a series.
+// Spoiler tags reveal plot details and are hidden unless the user opts in.
+type SeriesTag struct {
+	ID        uuid.UUID
+	SeriesID  uuid.UUID
+	Slug      string
+	Name      string
+	Spoiler   bool
+	CreatedAt time.Time
+}
+
 // Network represents a TV network or streaming service.
 type Network struct {
 	ID            uuid.UUID
@@ -524,6 +511,15 @@ type ContinueWatchingItem struct {
 	LastWatchedAt     time.Time
 }
 
+// NextUpItem is the next episode of a series the user finished an episode
+// of.
+type NextUpItem struct {
+	Episode          Episode
+	SeriesTitle      string
+	SeriesPosterPath *string
+	LastWatchedAt    time.Time // when the previous episode was watched
+}
+
 // NextEpisode represents the next episode to watch in a series.
 type NextEpisode struct {
 	Episode        *Episode
@@ -531,6 +527,14 @@ type NextEpisode struct {
 	IsSeriesFinale bool
 }
 
+// derefString returns the value of a string pointer, or "" when nil.
+func derefString(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
 // formatEpisodeCode formats season and episode numbers as SxxExx.
 func formatEpisodeCode(season, episode int32) string {
 	return fmt.Sprintf("S%02dE%02d", season, episode)
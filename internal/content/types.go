@@ -16,3 +16,11 @@ type GenreSummary struct {
 	Name      string `json:"name"`
 	ItemCount int64  `json:"item_count"`
 }
+
+// TagSummary represents a distinct keyword or tag with the count of items
+// carrying it. Spoiler tags are never aggregated.
+type TagSummary struct {
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	ItemCount int64  `json:"item_count"`
+}
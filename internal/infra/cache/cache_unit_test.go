@@ -608,6 +608,7 @@ func TestCache_InvalidateUser_Unit(t *testing.T) {
 	userID := "u1"
 	c.l1.Set(UserKey(userID), []byte("user"))
 	c.l1.Set(ContinueWatchingKey(userID, 10), []byte("watch"))
+	c.l1.Set(HomeFeedKey(userID, "on_deck"), []byte("feed"))
 
 	err := c.InvalidateUser(ctx, userID)
 	require.NoError(t, err)
@@ -628,6 +629,22 @@ func TestCache_InvalidateContinueWatching_Unit(t *testing.T) {
 	assert.Equal(t, 0, c.l1.Size())
 }
 
+// TestCache_InvalidateHomeFeed_Unit verifies home feed invalidation only
+// drops the user's rows.
+func TestCache_InvalidateHomeFeed_Unit(t *testing.T) {
+	c := newL1OnlyCache(t)
+	ctx := context.Background()
+
+	c.l1.Set(HomeFeedKey("u1", "on_deck"), []byte("deck"))
+	c.l1.Set(HomeFeedKey("u1", "recently_added:movie"), []byte("movies"))
+	c.l1.Set(HomeFeedKey("u2", "on_deck"), []byte("other"))
+
+	err := c.InvalidateHomeFeed(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, c.l1.Size())
+	assert.True(t, c.l1.Has(HomeFeedKey("u2", "on_deck")))
+}
+
 // TestClient_Ping_NilRueidis verifies Ping returns error with nil rueidis.
 func TestClient_Ping_NilRueidis(t *testing.T) {
 	client := &Client{
@@ -45,6 +45,7 @@ const (
 	KeyPrefixTVShowCast     = "tvshow:cast:"
 	KeyPrefixTVShowCrew     = "tvshow:crew:"
 	KeyPrefixTVShowGenres   = "tvshow:genres:"
+	KeyPrefixTVShowTags     = "tvshow:tags:"
 	KeyPrefixTVShowNetworks = "tvshow:networks:"
 	KeyPrefixTVShowList     = "tvshow:list:"
 	KeyPrefixTVShowRecent   = "tvshow:recent"
@@ -59,6 +60,7 @@ const (
 	KeyPrefixSearchAutocomplete = "search:autocomplete:"
 	KeyPrefixImage              = "image:"
 	KeyPrefixContinueWatching   = "user:continue:"
+	KeyPrefixHomeFeed           = "user:home:"
 
 	// API key cache keys
 	KeyPrefixAPIKey       = "apikey:"
@@ -115,6 +117,11 @@ const (
 	// Homepage hot path, short TTL for freshness.
 	RecentlyAddedTTL = 2 * time.Minute
 
+	// HomeFeedTTL is the TTL for a row of a user's home feed.
+	// Progress updates invalidate the feed, so this bounds how long newly
+	// added content takes to show up.
+	HomeFeedTTL = 2 * time.Minute
+
 	// TopRatedTTL is the TTL for top rated movies list.
 	TopRatedTTL = 5 * time.Minute
 
@@ -253,6 +260,11 @@ func TVShowGenresKey(seriesID string) string {
 	return KeyPrefixTVShowGenres + seriesID
 }
 
+// TVShowTagsKey returns the cache key for a series' keywords and tags.
+func TVShowTagsKey(seriesID string) string {
+	return KeyPrefixTVShowTags + seriesID
+}
+
 // TVShowNetworksKey returns the cache key for a series' networks.
 func TVShowNetworksKey(seriesID string) string {
 	return KeyPrefixTVShowNetworks + seriesID
@@ -313,6 +325,11 @@ func ContinueWatchingKey(userID string, limit int32) string {
 	return fmt.Sprintf("%s%s:%d", KeyPrefixContinueWatching, userID, limit)
 }
 
+// HomeFeedKey returns the cache key for a row of a user's home feed.
+func HomeFeedKey(userID, rowID string) string {
+	return KeyPrefixHomeFeed + userID + ":" + rowID
+}
+
 // CacheAside is a helper that implements the cache-aside pattern.
 // It first checks the cache, and on miss, calls the loader function.
 func (c *Cache) CacheAside(ctx context.Context, key string, ttl time.Duration, loader func() (any, error), dest any) error {
@@ -454,6 +471,7 @@ func (c *Cache) InvalidateTVShow(ctx context.Context, seriesID string) error {
 		KeyPrefixTVShowCast + seriesID,
 		KeyPrefixTVShowCrew + seriesID,
 		KeyPrefixTVShowGenres + seriesID,
+		KeyPrefixTVShowTags + seriesID,
 		KeyPrefixTVShowNetworks + seriesID,
 		KeyPrefixTVShowSeasons + seriesID,
 	}
@@ -505,6 +523,7 @@ func (c *Cache) InvalidateUser(ctx context.Context, userID string) error {
 	patterns := []string{
 		KeyPrefixUser + userID,
 		KeyPrefixContinueWatching + userID + ":*",
+		KeyPrefixHomeFeed + userID + ":*",
 	}
 
 	for _, pattern := range patterns {
@@ -520,3 +539,8 @@ func (c *Cache) InvalidateUser(ctx context.Context, userID string) error {
 func (c *Cache) InvalidateContinueWatching(ctx context.Context, userID string) error {
 	return c.Invalidate(ctx, KeyPrefixContinueWatching+userID+":*")
 }
+
+// InvalidateHomeFeed invalidates all rows of a user's home feed.
+func (c *Cache) InvalidateHomeFeed(ctx context.Context, userID string) error {
+	return c.Invalidate(ctx, KeyPrefixHomeFeed+userID+":*")
+}
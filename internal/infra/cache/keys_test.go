@@ -161,6 +161,11 @@ func TestContinueWatchingKey(t *testing.T) {
 	assert.Equal(t, "user:continue:user-uuid:10", key)
 }
 
+func TestHomeFeedKey(t *testing.T) {
+	key := HomeFeedKey("user-uuid", "recently_added:movie")
+	assert.Equal(t, "user:home:user-uuid:recently_added:movie", key)
+}
+
 func TestAdditionalKeyPrefixes(t *testing.T) {
 	// Verify additional key prefixes
 	assert.Equal(t, "movie:cast:", KeyPrefixMovieCast)
@@ -177,6 +182,7 @@ func TestAdditionalKeyPrefixes(t *testing.T) {
 	assert.Equal(t, "search:autocomplete:", KeyPrefixSearchAutocomplete)
 	assert.Equal(t, "image:", KeyPrefixImage)
 	assert.Equal(t, "user:continue:", KeyPrefixContinueWatching)
+	assert.Equal(t, "user:home:", KeyPrefixHomeFeed)
 }
 
 func TestAdditionalTTLs(t *testing.T) {
@@ -187,6 +193,7 @@ func TestAdditionalTTLs(t *testing.T) {
 	assert.Equal(t, 24*time.Hour, ImageMetaTTL)
 	assert.Equal(t, 1*time.Minute, ContinueWatchingTTL)
 	assert.Equal(t, 2*time.Minute, RecentlyAddedTTL)
+	assert.Equal(t, 2*time.Minute, HomeFeedTTL)
 	assert.Equal(t, 5*time.Minute, TopRatedTTL)
 }
 
@@ -195,6 +202,7 @@ func TestTVShowKeyPrefixes(t *testing.T) {
 	assert.Equal(t, "tvshow:cast:", KeyPrefixTVShowCast)
 	assert.Equal(t, "tvshow:crew:", KeyPrefixTVShowCrew)
 	assert.Equal(t, "tvshow:genres:", KeyPrefixTVShowGenres)
+	assert.Equal(t, "tvshow:tags:", KeyPrefixTVShowTags)
 	assert.Equal(t, "tvshow:networks:", KeyPrefixTVShowNetworks)
 	assert.Equal(t, "tvshow:list:", KeyPrefixTVShowList)
 	assert.Equal(t, "tvshow:recent", KeyPrefixTVShowRecent)
@@ -207,6 +215,7 @@ func TestTVShowKeys(t *testing.T) {
 	assert.Equal(t, "tvshow:cast:series-uuid", TVShowCastKey("series-uuid"))
 	assert.Equal(t, "tvshow:crew:series-uuid", TVShowCrewKey("series-uuid"))
 	assert.Equal(t, "tvshow:genres:series-uuid", TVShowGenresKey("series-uuid"))
+	assert.Equal(t, "tvshow:tags:series-uuid", TVShowTagsKey("series-uuid"))
 	assert.Equal(t, "tvshow:networks:series-uuid", TVShowNetworksKey("series-uuid"))
 	assert.Equal(t, "tvshow:list:filter-hash", TVShowListKey("filter-hash"))
 	assert.Equal(t, "tvshow:recent:10:0", TVShowRecentKey(10, 0))
@@ -12,6 +12,7 @@ package circuitbreaker
 
 import (
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/imroc/req/v3"
@@ -98,11 +99,33 @@ type Breaker struct {
 	cb *gobreaker.TwoStepCircuitBreaker
 }
 
+// registry holds the most recently created breaker per service name so that
+// schedulers can check upstream health before queueing work.
+var registry sync.Map
+
 // New creates a circuit breaker for the given service name and tier.
 func New(name string, tier Tier) *Breaker {
-	return &Breaker{
+	b := &Breaker{
 		cb: gobreaker.NewTwoStepCircuitBreaker(settings(name, tier)),
 	}
+	registry.Store(name, b)
+	return b
+}
+
+// Lookup returns the breaker registered for a service name.
+func Lookup(name string) (*Breaker, bool) {
+	b, ok := registry.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return b.(*Breaker), true
+}
+
+// IsOpen reports whether the breaker for a service name is open. Services
+// without a breaker are never open.
+func IsOpen(name string) bool {
+	b, ok := Lookup(name)
+	return ok && b.State() == gobreaker.StateOpen
 }
 
 // State returns the current circuit breaker state (Closed, HalfOpen, or Open).
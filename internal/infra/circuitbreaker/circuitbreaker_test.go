@@ -190,3 +190,31 @@ func TestWrapReqClient_Recovery(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 	assert.Equal(t, gobreaker.StateClosed, b.State())
 }
+
+func TestIsOpen(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsOpen("test-registry-unknown"))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := req.C().
+		SetBaseURL(srv.URL).
+		DisableAutoReadResponse().
+		SetCommonRetryCount(0)
+	b := WrapReqClient(client, "test-registry", TierLocal)
+
+	found, ok := Lookup("test-registry")
+	require.True(t, ok)
+	assert.Same(t, b, found)
+	assert.False(t, IsOpen("test-registry"))
+
+	for i := 0; i < 3; i++ {
+		_, err := client.R().Get("/fail")
+		require.NoError(t, err)
+	}
+	assert.True(t, IsOpen("test-registry"))
+}
@@ -0,0 +1,288 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: artwork.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteStaleArtwork = `-- name: DeleteStaleArtwork :execrows
+DELETE FROM public.artwork
+WHERE content_type = $1
+  AND content_id = $2
+  AND source = $3
+  AND NOT (id = ANY($4::uuid[]))
+`
+
+type DeleteStaleArtworkParams struct {
+	ContentType string      `json:"contentType"`
+	ContentID   uuid.UUID   `json:"contentId"`
+	Source      string      `json:"source"`
+	KeepIds     []uuid.UUID `json:"keepIds"`
+}
+
+// Removes artwork of one source that is no longer present, e.g. local
+// images that were deleted from disk
+func (q *Queries) DeleteStaleArtwork(ctx context.Context, arg DeleteStaleArtworkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteStaleArtwork,
+		arg.ContentType,
+		arg.ContentID,
+		arg.Source,
+		arg.KeepIds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getArtwork = `-- name: GetArtwork :one
+SELECT id, content_type, content_id, kind, source, path, width, height, file_size, created_at, updated_at, language, vote_average, vote_count, selected, user_selected, cached_at, blurhash, dominant_color FROM public.artwork
+WHERE id = $1
+`
+
+// Gets an artwork record by ID
+func (q *Queries) GetArtwork(ctx context.Context, id uuid.UUID) (Artwork, error) {
+	row := q.db.QueryRow(ctx, getArtwork, id)
+	var i Artwork
+	err := row.Scan(
+		&i.ID,
+		&i.ContentType,
+		&i.ContentID,
+		&i.Kind,
+		&i.Source,
+		&i.Path,
+		&i.Width,
+		&i.Height,
+		&i.FileSize,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Language,
+		&i.VoteAverage,
+		&i.VoteCount,
+		&i.Selected,
+		&i.UserSelected,
+		&i.CachedAt,
+		&i.Blurhash,
+		&i.DominantColor,
+	)
+	return i, err
+}
+
+const listArtworkByContent = `-- name: ListArtworkByContent :many
+SELECT id, content_type, content_id, kind, source, path, width, height, file_size, created_at, updated_at, language, vote_average, vote_count, selected, user_selected, cached_at, blurhash, dominant_color FROM public.artwork
+WHERE content_type = $1
+  AND content_id = $2
+ORDER BY kind, source, created_at
+`
+
+type ListArtworkByContentParams struct {
+	ContentType string    `json:"contentType"`
+	ContentID   uuid.UUID `json:"contentId"`
+}
+
+// Lists the artwork of a movie, series or season
+func (q *Queries) ListArtworkByContent(ctx context.Context, arg ListArtworkByContentParams) ([]Artwork, error) {
+	rows, err := q.db.Query(ctx, listArtworkByContent, arg.ContentType, arg.ContentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Artwork{}
+	for rows.Next() {
+		var i Artwork
+		if err := rows.Scan(
+			&i.ID,
+			&i.ContentType,
+			&i.ContentID,
+			&i.Kind,
+			&i.Source,
+			&i.Path,
+			&i.Width,
+			&i.Height,
+			&i.FileSize,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Language,
+			&i.VoteAverage,
+			&i.VoteCount,
+			&i.Selected,
+			&i.UserSelected,
+			&i.CachedAt,
+			&i.Blurhash,
+			&i.DominantColor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markArtworkCached = `-- name: MarkArtworkCached :exec
+UPDATE public.artwork
+SET cached_at = NOW(),
+    file_size = $1
+WHERE id = $2
+`
+
+type MarkArtworkCachedParams struct {
+	FileSize *int64    `json:"fileSize"`
+	ID       uuid.UUID `json:"id"`
+}
+
+// Records that an image was stored in the local image cache
+func (q *Queries) MarkArtworkCached(ctx context.Context, arg MarkArtworkCachedParams) error {
+	_, err := q.db.Exec(ctx, markArtworkCached, arg.FileSize, arg.ID)
+	return err
+}
+
+const selectArtwork = `-- name: SelectArtwork :execrows
+UPDATE public.artwork
+SET selected = (id = $1),
+    user_selected = (id = $1 AND $2::boolean)
+WHERE content_type = $3
+  AND content_id = $4
+  AND kind = $5
+`
+
+type SelectArtworkParams struct {
+	ID           uuid.UUID `json:"id"`
+	UserSelected bool      `json:"userSelected"`
+	ContentType  string    `json:"contentType"`
+	ContentID    uuid.UUID `json:"contentId"`
+	Kind         string    `json:"kind"`
+}
+
+// Makes one image the selected image of its kind and deselects the others
+func (q *Queries) SelectArtwork(ctx context.Context, arg SelectArtworkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, selectArtwork,
+		arg.ID,
+		arg.UserSelected,
+		arg.ContentType,
+		arg.ContentID,
+		arg.Kind,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setArtworkPlaceholder = `-- name: SetArtworkPlaceholder :exec
+UPDATE public.artwork
+SET blurhash = $1,
+    dominant_color = $2
+WHERE id = $3
+`
+
+type SetArtworkPlaceholderParams struct {
+	Blurhash      *string   `json:"blurhash"`
+	DominantColor *string   `json:"dominantColor"`
+	ID            uuid.UUID `json:"id"`
+}
+
+// Stores the BlurHash and dominant color computed for an image
+func (q *Queries) SetArtworkPlaceholder(ctx context.Context, arg SetArtworkPlaceholderParams) error {
+	_, err := q.db.Exec(ctx, setArtworkPlaceholder, arg.Blurhash, arg.DominantColor, arg.ID)
+	return err
+}
+
+const upsertArtwork = `-- name: UpsertArtwork :one
+INSERT INTO public.artwork (
+    content_type,
+    content_id,
+    kind,
+    source,
+    path,
+    width,
+    height,
+    file_size,
+    language,
+    vote_average,
+    vote_count
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11
+)
+ON CONFLICT (content_type, content_id, kind, source, path) DO UPDATE SET
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    file_size = COALESCE(EXCLUDED.file_size, public.artwork.file_size),
+    language = EXCLUDED.language,
+    vote_average = EXCLUDED.vote_average,
+    vote_count = EXCLUDED.vote_count
+RETURNING id, content_type, content_id, kind, source, path, width, height, file_size, created_at, updated_at, language, vote_average, vote_count, selected, user_selected, cached_at, blurhash, dominant_color
+`
+
+type UpsertArtworkParams struct {
+	ContentType string    `json:"contentType"`
+	ContentID   uuid.UUID `json:"contentId"`
+	Kind        string    `json:"kind"`
+	Source      string    `json:"source"`
+	Path        string    `json:"path"`
+	Width       *int32    `json:"width"`
+	Height      *int32    `json:"height"`
+	FileSize    *int64    `json:"fileSize"`
+	Language    *string   `json:"language"`
+	VoteAverage float64   `json:"voteAverage"`
+	VoteCount   int32     `json:"voteCount"`
+}
+
+// Records an artwork image, refreshing its dimensions, language and votes
+// if it already exists.
+func (q *Queries) UpsertArtwork(ctx context.Context, arg UpsertArtworkParams) (Artwork, error) {
+	row := q.db.QueryRow(ctx, upsertArtwork,
+		arg.ContentType,
+		arg.ContentID,
+		arg.Kind,
+		arg.Source,
+		arg.Path,
+		arg.Width,
+		arg.Height,
+		arg.FileSize,
+		arg.Language,
+		arg.VoteAverage,
+		arg.VoteCount,
+	)
+	var i Artwork
+	err := row.Scan(
+		&i.ID,
+		&i.ContentType,
+		&i.ContentID,
+		&i.Kind,
+		&i.Source,
+		&i.Path,
+		&i.Width,
+		&i.Height,
+		&i.FileSize,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Language,
+		&i.VoteAverage,
+		&i.VoteCount,
+		&i.Selected,
+		&i.UserSelected,
+		&i.CachedAt,
+		&i.Blurhash,
+		&i.DominantColor,
+	)
+	return i, err
+}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -97,7 +98,8 @@ INSERT INTO public.libraries (
     realtime_monitoring,
     metadata_provider,
     preferred_language,
-    scanner_config
+    scanner_config,
+    metadata_policy
 ) VALUES (
     $1,
     $2,
@@ -107,21 +109,23 @@ INSERT INTO public.libraries (
     $6,
     $7,
     $8,
-    $9
+    $9,
+    $10
 )
-RETURNING id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at
+RETURNING id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy
 `
 
 type CreateLibraryParams struct {
-	Name               string   `json:"name"`
-	Type               string   `json:"type"`
-	Paths              []string `json:"paths"`
-	Enabled            bool     `json:"enabled"`
-	ScanOnStartup      bool     `json:"scanOnStartup"`
-	RealtimeMonitoring bool     `json:"realtimeMonitoring"`
-	MetadataProvider   *string  `json:"metadataProvider"`
-	PreferredLanguage  string   `json:"preferredLanguage"`
-	ScannerConfig      []byte   `json:"scannerConfig"`
+	Name               string          `json:"name"`
+	Type               string          `json:"type"`
+	Paths              []string        `json:"paths"`
+	Enabled            bool            `json:"enabled"`
+	ScanOnStartup      bool            `json:"scanOnStartup"`
+	RealtimeMonitoring bool            `json:"realtimeMonitoring"`
+	MetadataProvider   *string         `json:"metadataProvider"`
+	PreferredLanguage  string          `json:"preferredLanguage"`
+	ScannerConfig      []byte          `json:"scannerConfig"`
+	MetadataPolicy     json.RawMessage `json:"metadataPolicy"`
 }
 
 // Creates a new library
@@ -136,6 +140,7 @@ func (q *Queries) CreateLibrary(ctx context.Context, arg CreateLibraryParams) (L
 		arg.MetadataProvider,
 		arg.PreferredLanguage,
 		arg.ScannerConfig,
+		arg.MetadataPolicy,
 	)
 	var i Library
 	err := row.Scan(
@@ -151,6 +156,7 @@ func (q *Queries) CreateLibrary(ctx context.Context, arg CreateLibraryParams) (L
 		&i.ScannerConfig,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MetadataPolicy,
 	)
 	return i, err
 }
@@ -204,7 +210,7 @@ INSERT INTO public.library_scans (
     $2,
     $3
 )
-RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at
+RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id
 `
 
 type CreateLibraryScanParams struct {
@@ -235,6 +241,7 @@ func (q *Queries) CreateLibraryScan(ctx context.Context, arg CreateLibraryScanPa
 		&i.CompletedAt,
 		&i.DurationSeconds,
 		&i.CreatedAt,
+		&i.JobID,
 	)
 	return i, err
 }
@@ -307,7 +314,7 @@ func (q *Queries) DeleteUserLibraryPermissions(ctx context.Context, userID uuid.
 }
 
 const getLatestLibraryScan = `-- name: GetLatestLibraryScan :one
-SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at FROM public.library_scans
+SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id FROM public.library_scans
 WHERE library_id = $1
 ORDER BY created_at DESC
 LIMIT 1
@@ -332,12 +339,13 @@ func (q *Queries) GetLatestLibraryScan(ctx context.Context, libraryID uuid.UUID)
 		&i.CompletedAt,
 		&i.DurationSeconds,
 		&i.CreatedAt,
+		&i.JobID,
 	)
 	return i, err
 }
 
 const getLibrary = `-- name: GetLibrary :one
-SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at FROM public.libraries
+SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy FROM public.libraries
 WHERE id = $1
 `
 
@@ -358,12 +366,13 @@ func (q *Queries) GetLibrary(ctx context.Context, id uuid.UUID) (Library, error)
 		&i.ScannerConfig,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MetadataPolicy,
 	)
 	return i, err
 }
 
 const getLibraryByName = `-- name: GetLibraryByName :one
-SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at FROM public.libraries
+SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy FROM public.libraries
 WHERE name = $1
 `
 
@@ -384,6 +393,7 @@ func (q *Queries) GetLibraryByName(ctx context.Context, name string) (Library, e
 		&i.ScannerConfig,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MetadataPolicy,
 	)
 	return i, err
 }
@@ -416,7 +426,7 @@ func (q *Queries) GetLibraryPermission(ctx context.Context, arg GetLibraryPermis
 }
 
 const getLibraryScan = `-- name: GetLibraryScan :one
-SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at FROM public.library_scans
+SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id FROM public.library_scans
 WHERE id = $1
 `
 
@@ -439,12 +449,13 @@ func (q *Queries) GetLibraryScan(ctx context.Context, id uuid.UUID) (LibraryScan
 		&i.CompletedAt,
 		&i.DurationSeconds,
 		&i.CreatedAt,
+		&i.JobID,
 	)
 	return i, err
 }
 
 const getRunningScans = `-- name: GetRunningScans :many
-SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at FROM public.library_scans
+SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id FROM public.library_scans
 WHERE status = 'running'
 ORDER BY started_at ASC
 `
@@ -474,6 +485,7 @@ func (q *Queries) GetRunningScans(ctx context.Context) ([]LibraryScan, error) {
 			&i.CompletedAt,
 			&i.DurationSeconds,
 			&i.CreatedAt,
+			&i.JobID,
 		); err != nil {
 			return nil, err
 		}
@@ -486,7 +498,7 @@ func (q *Queries) GetRunningScans(ctx context.Context) ([]LibraryScan, error) {
 }
 
 const getUserAccessibleLibraries = `-- name: GetUserAccessibleLibraries :many
-SELECT DISTINCT l.id, l.name, l.type, l.paths, l.enabled, l.scan_on_startup, l.realtime_monitoring, l.metadata_provider, l.preferred_language, l.scanner_config, l.created_at, l.updated_at FROM public.libraries l
+SELECT DISTINCT l.id, l.name, l.type, l.paths, l.enabled, l.scan_on_startup, l.realtime_monitoring, l.metadata_provider, l.preferred_language, l.scanner_config, l.created_at, l.updated_at, l.metadata_policy FROM public.libraries l
 JOIN public.library_permissions lp ON l.id = lp.library_id
 WHERE lp.user_id = $1
 AND lp.permission = 'view'
@@ -517,6 +529,7 @@ func (q *Queries) GetUserAccessibleLibraries(ctx context.Context, userID uuid.UU
 			&i.ScannerConfig,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MetadataPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -529,7 +542,7 @@ func (q *Queries) GetUserAccessibleLibraries(ctx context.Context, userID uuid.UU
 }
 
 const listEnabledLibraries = `-- name: ListEnabledLibraries :many
-SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at FROM public.libraries
+SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy FROM public.libraries
 WHERE enabled = true
 ORDER BY name ASC
 `
@@ -557,6 +570,7 @@ func (q *Queries) ListEnabledLibraries(ctx context.Context) ([]Library, error) {
 			&i.ScannerConfig,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MetadataPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -569,7 +583,7 @@ func (q *Queries) ListEnabledLibraries(ctx context.Context) ([]Library, error) {
 }
 
 const listLibraries = `-- name: ListLibraries :many
-SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at FROM public.libraries
+SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy FROM public.libraries
 ORDER BY name ASC
 `
 
@@ -596,6 +610,7 @@ func (q *Queries) ListLibraries(ctx context.Context) ([]Library, error) {
 			&i.ScannerConfig,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MetadataPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -608,7 +623,7 @@ func (q *Queries) ListLibraries(ctx context.Context) ([]Library, error) {
 }
 
 const listLibrariesByType = `-- name: ListLibrariesByType :many
-SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at FROM public.libraries
+SELECT id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy FROM public.libraries
 WHERE type = $1
 ORDER BY name ASC
 `
@@ -636,6 +651,7 @@ func (q *Queries) ListLibrariesByType(ctx context.Context, type_ string) ([]Libr
 			&i.ScannerConfig,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MetadataPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -681,7 +697,7 @@ func (q *Queries) ListLibraryPermissions(ctx context.Context, libraryID uuid.UUI
 }
 
 const listLibraryScans = `-- name: ListLibraryScans :many
-SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at FROM public.library_scans
+SELECT id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id FROM public.library_scans
 WHERE library_id = $1
 ORDER BY created_at DESC
 LIMIT $3 OFFSET $2
@@ -718,6 +734,7 @@ func (q *Queries) ListLibraryScans(ctx context.Context, arg ListLibraryScansPara
 			&i.CompletedAt,
 			&i.DurationSeconds,
 			&i.CreatedAt,
+			&i.JobID,
 		); err != nil {
 			return nil, err
 		}
@@ -762,6 +779,42 @@ func (q *Queries) ListUserLibraryPermissions(ctx context.Context, userID uuid.UU
 	return items, nil
 }
 
+const setLibraryScanJobID = `-- name: SetLibraryScanJobID :one
+UPDATE public.library_scans SET
+    job_id = $1
+WHERE id = $2
+RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id
+`
+
+type SetLibraryScanJobIDParams struct {
+	JobID *int64    `json:"jobId"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// Links a scan to the River job performing it
+func (q *Queries) SetLibraryScanJobID(ctx context.Context, arg SetLibraryScanJobIDParams) (LibraryScan, error) {
+	row := q.db.QueryRow(ctx, setLibraryScanJobID, arg.JobID, arg.ID)
+	var i LibraryScan
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ScanType,
+		&i.Status,
+		&i.ItemsScanned,
+		&i.ItemsAdded,
+		&i.ItemsUpdated,
+		&i.ItemsRemoved,
+		&i.ErrorsCount,
+		&i.ErrorMessage,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.DurationSeconds,
+		&i.CreatedAt,
+		&i.JobID,
+	)
+	return i, err
+}
+
 const updateLibrary = `-- name: UpdateLibrary :one
 UPDATE public.libraries SET
     name = COALESCE($1, name),
@@ -772,9 +825,10 @@ UPDATE public.libraries SET
     realtime_monitoring = COALESCE($6, realtime_monitoring),
     metadata_provider = COALESCE($7, metadata_provider),
     preferred_language = COALESCE($8, preferred_language),
-    scanner_config = COALESCE($9, scanner_config)
-WHERE id = $10
-RETURNING id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at
+    scanner_config = COALESCE($9, scanner_config),
+    metadata_policy = COALESCE($10, metadata_policy)
+WHERE id = $11
+RETURNING id, name, type, paths, enabled, scan_on_startup, realtime_monitoring, metadata_provider, preferred_language, scanner_config, created_at, updated_at, metadata_policy
 `
 
 type UpdateLibraryParams struct {
@@ -787,6 +841,7 @@ type UpdateLibraryParams struct {
 	MetadataProvider   *string   `json:"metadataProvider"`
 	PreferredLanguage  *string   `json:"preferredLanguage"`
 	ScannerConfig      []byte    `json:"scannerConfig"`
+	MetadataPolicy     []byte    `json:"metadataPolicy"`
 	ID                 uuid.UUID `json:"id"`
 }
 
@@ -802,6 +857,7 @@ func (q *Queries) UpdateLibrary(ctx context.Context, arg UpdateLibraryParams) (L
 		arg.MetadataProvider,
 		arg.PreferredLanguage,
 		arg.ScannerConfig,
+		arg.MetadataPolicy,
 		arg.ID,
 	)
 	var i Library
@@ -818,6 +874,7 @@ func (q *Queries) UpdateLibrary(ctx context.Context, arg UpdateLibraryParams) (L
 		&i.ScannerConfig,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MetadataPolicy,
 	)
 	return i, err
 }
@@ -830,7 +887,7 @@ UPDATE public.library_scans SET
     items_removed = $4,
     errors_count = $5
 WHERE id = $6
-RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at
+RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id
 `
 
 type UpdateLibraryScanProgressParams struct {
@@ -868,6 +925,7 @@ func (q *Queries) UpdateLibraryScanProgress(ctx context.Context, arg UpdateLibra
 		&i.CompletedAt,
 		&i.DurationSeconds,
 		&i.CreatedAt,
+		&i.JobID,
 	)
 	return i, err
 }
@@ -880,7 +938,7 @@ UPDATE public.library_scans SET
     duration_seconds = $4,
     error_message = $5
 WHERE id = $6
-RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at
+RETURNING id, library_id, scan_type, status, items_scanned, items_added, items_updated, items_removed, errors_count, error_message, started_at, completed_at, duration_seconds, created_at, job_id
 `
 
 type UpdateLibraryScanStatusParams struct {
@@ -918,6 +976,7 @@ func (q *Queries) UpdateLibraryScanStatus(ctx context.Context, arg UpdateLibrary
 		&i.CompletedAt,
 		&i.DurationSeconds,
 		&i.CreatedAt,
+		&i.JobID,
 	)
 	return i, err
 }
@@ -0,0 +1,307 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: match_queue.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countMatchQueueItems = `-- name: CountMatchQueueItems :one
+SELECT COUNT(*) FROM public.library_match_queue
+WHERE ($1::varchar IS NULL OR status = $1)
+  AND ($2::varchar IS NULL OR content_type = $2)
+  AND ($3::uuid IS NULL OR library_id = $3)
+`
+
+type CountMatchQueueItemsParams struct {
+	Status      *string     `json:"status"`
+	ContentType *string     `json:"contentType"`
+	LibraryID   pgtype.UUID `json:"libraryId"`
+}
+
+// Counts queued items with the same optional filters as ListMatchQueueItems
+func (q *Queries) CountMatchQueueItems(ctx context.Context, arg CountMatchQueueItemsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countMatchQueueItems, arg.Status, arg.ContentType, arg.LibraryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteMatchQueueItemsByPaths = `-- name: DeleteMatchQueueItemsByPaths :execrows
+DELETE FROM public.library_match_queue
+WHERE content_type = $1
+  AND file_path = ANY($2::text[])
+  AND status IN ('unmatched', 'low_confidence')
+`
+
+type DeleteMatchQueueItemsByPathsParams struct {
+	ContentType string   `json:"contentType"`
+	FilePaths   []string `json:"filePaths"`
+}
+
+// Removes open queue entries for files that have since been matched
+func (q *Queries) DeleteMatchQueueItemsByPaths(ctx context.Context, arg DeleteMatchQueueItemsByPathsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMatchQueueItemsByPaths, arg.ContentType, arg.FilePaths)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getMatchQueueItem = `-- name: GetMatchQueueItem :one
+SELECT id, library_id, content_type, file_path, parsed_title, parsed_year, status, reason, candidates, best_confidence, resolved_content_id, resolved_by, resolved_at, created_at, updated_at FROM public.library_match_queue
+WHERE id = $1
+`
+
+// Gets a queued item by ID
+func (q *Queries) GetMatchQueueItem(ctx context.Context, id uuid.UUID) (LibraryMatchQueue, error) {
+	row := q.db.QueryRow(ctx, getMatchQueueItem, id)
+	var i LibraryMatchQueue
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ContentType,
+		&i.FilePath,
+		&i.ParsedTitle,
+		&i.ParsedYear,
+		&i.Status,
+		&i.Reason,
+		&i.Candidates,
+		&i.BestConfidence,
+		&i.ResolvedContentID,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listMatchQueueItems = `-- name: ListMatchQueueItems :many
+SELECT id, library_id, content_type, file_path, parsed_title, parsed_year, status, reason, candidates, best_confidence, resolved_content_id, resolved_by, resolved_at, created_at, updated_at FROM public.library_match_queue
+WHERE ($1::varchar IS NULL OR status = $1)
+  AND ($2::varchar IS NULL OR content_type = $2)
+  AND ($3::uuid IS NULL OR library_id = $3)
+ORDER BY created_at DESC
+LIMIT $5 OFFSET $4
+`
+
+type ListMatchQueueItemsParams struct {
+	Status      *string     `json:"status"`
+	ContentType *string     `json:"contentType"`
+	LibraryID   pgtype.UUID `json:"libraryId"`
+	OffsetCount int32       `json:"offsetCount"`
+	LimitCount  int32       `json:"limitCount"`
+}
+
+// Lists queued items, newest first, with optional filters
+func (q *Queries) ListMatchQueueItems(ctx context.Context, arg ListMatchQueueItemsParams) ([]LibraryMatchQueue, error) {
+	rows, err := q.db.Query(ctx, listMatchQueueItems,
+		arg.Status,
+		arg.ContentType,
+		arg.LibraryID,
+		arg.OffsetCount,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LibraryMatchQueue{}
+	for rows.Next() {
+		var i LibraryMatchQueue
+		if err := rows.Scan(
+			&i.ID,
+			&i.LibraryID,
+			&i.ContentType,
+			&i.FilePath,
+			&i.ParsedTitle,
+			&i.ParsedYear,
+			&i.Status,
+			&i.Reason,
+			&i.Candidates,
+			&i.BestConfidence,
+			&i.ResolvedContentID,
+			&i.ResolvedBy,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveMatchQueueItem = `-- name: ResolveMatchQueueItem :one
+UPDATE public.library_match_queue SET
+    status = 'resolved',
+    resolved_content_id = $1,
+    resolved_by = $2,
+    resolved_at = NOW()
+WHERE id = $3
+RETURNING id, library_id, content_type, file_path, parsed_title, parsed_year, status, reason, candidates, best_confidence, resolved_content_id, resolved_by, resolved_at, created_at, updated_at
+`
+
+type ResolveMatchQueueItemParams struct {
+	ResolvedContentID pgtype.UUID `json:"resolvedContentId"`
+	ResolvedBy        pgtype.UUID `json:"resolvedBy"`
+	ID                uuid.UUID   `json:"id"`
+}
+
+// Marks an item as manually identified
+func (q *Queries) ResolveMatchQueueItem(ctx context.Context, arg ResolveMatchQueueItemParams) (LibraryMatchQueue, error) {
+	row := q.db.QueryRow(ctx, resolveMatchQueueItem, arg.ResolvedContentID, arg.ResolvedBy, arg.ID)
+	var i LibraryMatchQueue
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ContentType,
+		&i.FilePath,
+		&i.ParsedTitle,
+		&i.ParsedYear,
+		&i.Status,
+		&i.Reason,
+		&i.Candidates,
+		&i.BestConfidence,
+		&i.ResolvedContentID,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setMatchQueueItemStatus = `-- name: SetMatchQueueItemStatus :one
+UPDATE public.library_match_queue SET
+    status = $1
+WHERE id = $2
+RETURNING id, library_id, content_type, file_path, parsed_title, parsed_year, status, reason, candidates, best_confidence, resolved_content_id, resolved_by, resolved_at, created_at, updated_at
+`
+
+type SetMatchQueueItemStatusParams struct {
+	Status string    `json:"status"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// Updates the status of a queued item (e.g. ignore or re-open)
+func (q *Queries) SetMatchQueueItemStatus(ctx context.Context, arg SetMatchQueueItemStatusParams) (LibraryMatchQueue, error) {
+	row := q.db.QueryRow(ctx, setMatchQueueItemStatus, arg.Status, arg.ID)
+	var i LibraryMatchQueue
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ContentType,
+		&i.FilePath,
+		&i.ParsedTitle,
+		&i.ParsedYear,
+		&i.Status,
+		&i.Reason,
+		&i.Candidates,
+		&i.BestConfidence,
+		&i.ResolvedContentID,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertMatchQueueItem = `-- name: UpsertMatchQueueItem :one
+INSERT INTO public.library_match_queue (
+    library_id,
+    content_type,
+    file_path,
+    parsed_title,
+    parsed_year,
+    status,
+    reason,
+    candidates,
+    best_confidence
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+)
+ON CONFLICT (content_type, file_path) DO UPDATE SET
+    library_id = COALESCE(EXCLUDED.library_id, library_match_queue.library_id),
+    parsed_title = EXCLUDED.parsed_title,
+    parsed_year = EXCLUDED.parsed_year,
+    status = CASE
+        WHEN library_match_queue.status = 'ignored' THEN library_match_queue.status
+        ELSE EXCLUDED.status
+    END,
+    reason = EXCLUDED.reason,
+    candidates = EXCLUDED.candidates,
+    best_confidence = EXCLUDED.best_confidence,
+    resolved_content_id = NULL,
+    resolved_by = NULL,
+    resolved_at = NULL
+RETURNING id, library_id, content_type, file_path, parsed_title, parsed_year, status, reason, candidates, best_confidence, resolved_content_id, resolved_by, resolved_at, created_at, updated_at
+`
+
+type UpsertMatchQueueItemParams struct {
+	LibraryID      pgtype.UUID     `json:"libraryId"`
+	ContentType    string          `json:"contentType"`
+	FilePath       string          `json:"filePath"`
+	ParsedTitle    *string         `json:"parsedTitle"`
+	ParsedYear     *int32          `json:"parsedYear"`
+	Status         string          `json:"status"`
+	Reason         *string         `json:"reason"`
+	Candidates     json.RawMessage `json:"candidates"`
+	BestConfidence *float64        `json:"bestConfidence"`
+}
+
+// Records an unmatched file, refreshing candidates if it is already queued.
+// Items an admin ignored stay ignored.
+func (q *Queries) UpsertMatchQueueItem(ctx context.Context, arg UpsertMatchQueueItemParams) (LibraryMatchQueue, error) {
+	row := q.db.QueryRow(ctx, upsertMatchQueueItem,
+		arg.LibraryID,
+		arg.ContentType,
+		arg.FilePath,
+		arg.ParsedTitle,
+		arg.ParsedYear,
+		arg.Status,
+		arg.Reason,
+		arg.Candidates,
+		arg.BestConfidence,
+	)
+	var i LibraryMatchQueue
+	err := row.Scan(
+		&i.ID,
+		&i.LibraryID,
+		&i.ContentType,
+		&i.FilePath,
+		&i.ParsedTitle,
+		&i.ParsedYear,
+		&i.Status,
+		&i.Reason,
+		&i.Candidates,
+		&i.BestConfidence,
+		&i.ResolvedContentID,
+		&i.ResolvedBy,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
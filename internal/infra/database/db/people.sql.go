@@ -0,0 +1,412 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: people.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getPerson = `-- name: GetPerson :one
+SELECT id, tmdb_id, tvdb_id, imdb_id, name, also_known_as, biography, birthday, deathday, gender, place_of_birth, profile_path, homepage, known_for_department, popularity, biographies_i18n, metadata_updated_at, created_at, updated_at, image_placeholders FROM public.people
+WHERE id = $1
+`
+
+// Gets a person by ID
+func (q *Queries) GetPerson(ctx context.Context, id uuid.UUID) (Person, error) {
+	row := q.db.QueryRow(ctx, getPerson, id)
+	var i Person
+	err := row.Scan(
+		&i.ID,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.ImdbID,
+		&i.Name,
+		&i.AlsoKnownAs,
+		&i.Biography,
+		&i.Birthday,
+		&i.Deathday,
+		&i.Gender,
+		&i.PlaceOfBirth,
+		&i.ProfilePath,
+		&i.Homepage,
+		&i.KnownForDepartment,
+		&i.Popularity,
+		&i.BiographiesI18n,
+		&i.MetadataUpdatedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ImagePlaceholders,
+	)
+	return i, err
+}
+
+const getPersonByTMDbID = `-- name: GetPersonByTMDbID :one
+SELECT id, tmdb_id, tvdb_id, imdb_id, name, also_known_as, biography, birthday, deathday, gender, place_of_birth, profile_path, homepage, known_for_department, popularity, biographies_i18n, metadata_updated_at, created_at, updated_at, image_placeholders FROM public.people
+WHERE tmdb_id = $1
+`
+
+// Gets a person by TMDb person ID
+func (q *Queries) GetPersonByTMDbID(ctx context.Context, tmdbID *int32) (Person, error) {
+	row := q.db.QueryRow(ctx, getPersonByTMDbID, tmdbID)
+	var i Person
+	err := row.Scan(
+		&i.ID,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.ImdbID,
+		&i.Name,
+		&i.AlsoKnownAs,
+		&i.Biography,
+		&i.Birthday,
+		&i.Deathday,
+		&i.Gender,
+		&i.PlaceOfBirth,
+		&i.ProfilePath,
+		&i.Homepage,
+		&i.KnownForDepartment,
+		&i.Popularity,
+		&i.BiographiesI18n,
+		&i.MetadataUpdatedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ImagePlaceholders,
+	)
+	return i, err
+}
+
+const listPersonMovieCredits = `-- name: ListPersonMovieCredits :many
+SELECT
+    c.id,
+    c.movie_id,
+    c.credit_type,
+    c.character,
+    c.job,
+    c.department,
+    c.cast_order,
+    m.title,
+    m.year,
+    m.poster_path
+FROM movie.movie_credits c
+JOIN movie.movies m ON m.id = c.movie_id
+WHERE c.person_id = $1
+  AND c.deleted_at IS NULL
+  AND m.deleted_at IS NULL
+  AND (
+      $2::integer IS NULL
+      OR m.min_age <= $2::integer
+      OR (m.min_age IS NULL AND $3::boolean)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM movie.movie_genres bg
+      WHERE bg.movie_id = m.id AND bg.slug = ANY($4::text[])
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM movie.movie_tags bt
+      WHERE bt.movie_id = m.id AND bt.slug = ANY($4::text[])
+  )
+ORDER BY m.year DESC NULLS LAST, m.title
+`
+
+type ListPersonMovieCreditsParams struct {
+	PersonID     pgtype.UUID `json:"personId"`
+	MaxAge       *int32      `json:"maxAge"`
+	AllowUnrated bool        `json:"allowUnrated"`
+	BlockedTags  []string    `json:"blockedTags"`
+}
+
+type ListPersonMovieCreditsRow struct {
+	ID         uuid.UUID `json:"id"`
+	MovieID    uuid.UUID `json:"movieId"`
+	CreditType string    `json:"creditType"`
+	Character  *string   `json:"character"`
+	Job        *string   `json:"job"`
+	Department *string   `json:"department"`
+	CastOrder  *int32    `json:"castOrder"`
+	Title      string    `json:"title"`
+	Year       *int32    `json:"year"`
+	PosterPath *string   `json:"posterPath"`
+}
+
+// Lists a person's credits on movies in the library the viewer may see
+func (q *Queries) ListPersonMovieCredits(ctx context.Context, arg ListPersonMovieCreditsParams) ([]ListPersonMovieCreditsRow, error) {
+	rows, err := q.db.Query(ctx, listPersonMovieCredits,
+		arg.PersonID,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPersonMovieCreditsRow{}
+	for rows.Next() {
+		var i ListPersonMovieCreditsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MovieID,
+			&i.CreditType,
+			&i.Character,
+			&i.Job,
+			&i.Department,
+			&i.CastOrder,
+			&i.Title,
+			&i.Year,
+			&i.PosterPath,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPersonSeriesCredits = `-- name: ListPersonSeriesCredits :many
+SELECT
+    s.id AS series_id,
+    s.title,
+    s.first_air_date,
+    s.poster_path,
+    c.credit_type,
+    c.character,
+    c.job,
+    c.department,
+    0::BIGINT AS episode_count
+FROM tvshow.series_credits c
+JOIN tvshow.series s ON s.id = c.series_id
+WHERE c.person_id = $1
+  AND (
+      $2::integer IS NULL
+      OR s.min_age <= $2::integer
+      OR (s.min_age IS NULL AND $3::boolean)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_genres bg
+      WHERE bg.series_id = s.id AND bg.slug = ANY($4::text[])
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_tags bt
+      WHERE bt.series_id = s.id AND bt.slug = ANY($4::text[])
+  )
+UNION ALL
+SELECT
+    s.id AS series_id,
+    s.title,
+    s.first_air_date,
+    s.poster_path,
+    ec.credit_type,
+    ec.character,
+    ec.job,
+    ec.department,
+    COUNT(*)::BIGINT AS episode_count
+FROM tvshow.episode_credits ec
+JOIN tvshow.episodes e ON e.id = ec.episode_id
+JOIN tvshow.series s ON s.id = e.series_id
+WHERE ec.person_id = $1
+  AND (
+      $2::integer IS NULL
+      OR s.min_age <= $2::integer
+      OR (s.min_age IS NULL AND $3::boolean)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_genres bg
+      WHERE bg.series_id = s.id AND bg.slug = ANY($4::text[])
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_tags bt
+      WHERE bt.series_id = s.id AND bt.slug = ANY($4::text[])
+  )
+GROUP BY s.id, s.title, s.first_air_date, s.poster_path, ec.credit_type, ec.character, ec.job, ec.department
+ORDER BY first_air_date DESC NULLS LAST, title
+`
+
+type ListPersonSeriesCreditsParams struct {
+	PersonID     pgtype.UUID `json:"personId"`
+	MaxAge       *int32      `json:"maxAge"`
+	AllowUnrated bool        `json:"allowUnrated"`
+	BlockedTags  []string    `json:"blockedTags"`
+}
+
+type ListPersonSeriesCreditsRow struct {
+	SeriesID     uuid.UUID   `json:"seriesId"`
+	Title        string      `json:"title"`
+	FirstAirDate pgtype.Date `json:"firstAirDate"`
+	PosterPath   *string     `json:"posterPath"`
+	CreditType   string      `json:"creditType"`
+	Character    *string     `json:"character"`
+	Job          *string     `json:"job"`
+	Department   *string     `json:"department"`
+	EpisodeCount int64       `json:"episodeCount"`
+}
+
+// Lists a person's credits on series in the library the viewer may see,
+// including guest appearances counted per series
+func (q *Queries) ListPersonSeriesCredits(ctx context.Context, arg ListPersonSeriesCreditsParams) ([]ListPersonSeriesCreditsRow, error) {
+	rows, err := q.db.Query(ctx, listPersonSeriesCredits,
+		arg.PersonID,
+		arg.MaxAge,
+		arg.AllowUnrated,
+		arg.BlockedTags,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPersonSeriesCreditsRow{}
+	for rows.Next() {
+		var i ListPersonSeriesCreditsRow
+		if err := rows.Scan(
+			&i.SeriesID,
+			&i.Title,
+			&i.FirstAirDate,
+			&i.PosterPath,
+			&i.CreditType,
+			&i.Character,
+			&i.Job,
+			&i.Department,
+			&i.EpisodeCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setPersonImagePlaceholder = `-- name: SetPersonImagePlaceholder :execrows
+UPDATE public.people
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object($1::text, $2::jsonb)) AS p
+    WHERE p.key = profile_path
+)
+WHERE id = $3
+`
+
+type SetPersonImagePlaceholderParams struct {
+	Path        string          `json:"path"`
+	Placeholder json.RawMessage `json:"placeholder"`
+	ID          uuid.UUID       `json:"id"`
+}
+
+// Stores the placeholder of the person's profile image. Placeholders of
+// images the person no longer uses are dropped.
+func (q *Queries) SetPersonImagePlaceholder(ctx context.Context, arg SetPersonImagePlaceholderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPersonImagePlaceholder, arg.Path, arg.Placeholder, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePersonMetadata = `-- name: UpdatePersonMetadata :one
+UPDATE public.people
+SET
+    tvdb_id = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM public.people o
+            WHERE o.tvdb_id = $1::integer AND o.id <> $2
+        ) THEN tvdb_id
+        ELSE COALESCE($1::integer, tvdb_id)
+    END,
+    imdb_id = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM public.people o
+            WHERE o.imdb_id = $3::text AND o.id <> $2
+        ) THEN imdb_id
+        ELSE COALESCE(NULLIF($3::text, ''), imdb_id)
+    END,
+    name = $4,
+    also_known_as = $5,
+    biography = $6,
+    birthday = $7,
+    deathday = $8,
+    gender = $9,
+    place_of_birth = $10,
+    profile_path = COALESCE($11, profile_path),
+    homepage = $12,
+    known_for_department = $13,
+    popularity = $14,
+    biographies_i18n = $15,
+    metadata_updated_at = NOW()
+WHERE id = $2
+RETURNING id, tmdb_id, tvdb_id, imdb_id, name, also_known_as, biography, birthday, deathday, gender, place_of_birth, profile_path, homepage, known_for_department, popularity, biographies_i18n, metadata_updated_at, created_at, updated_at, image_placeholders
+`
+
+type UpdatePersonMetadataParams struct {
+	TvdbID             *int32          `json:"tvdbId"`
+	ID                 uuid.UUID       `json:"id"`
+	ImdbID             *string         `json:"imdbId"`
+	Name               string          `json:"name"`
+	AlsoKnownAs        []string        `json:"alsoKnownAs"`
+	Biography          *string         `json:"biography"`
+	Birthday           pgtype.Date     `json:"birthday"`
+	Deathday           pgtype.Date     `json:"deathday"`
+	Gender             int32           `json:"gender"`
+	PlaceOfBirth       *string         `json:"placeOfBirth"`
+	ProfilePath        *string         `json:"profilePath"`
+	Homepage           *string         `json:"homepage"`
+	KnownForDepartment *string         `json:"knownForDepartment"`
+	Popularity         *float64        `json:"popularity"`
+	BiographiesI18n    json.RawMessage `json:"biographiesI18n"`
+}
+
+// Stores details fetched from a metadata provider. Provider IDs are only
+// filled in, never cleared, and are left alone when another person already
+// has them.
+func (q *Queries) UpdatePersonMetadata(ctx context.Context, arg UpdatePersonMetadataParams) (Person, error) {
+	row := q.db.QueryRow(ctx, updatePersonMetadata,
+		arg.TvdbID,
+		arg.ID,
+		arg.ImdbID,
+		arg.Name,
+		arg.AlsoKnownAs,
+		arg.Biography,
+		arg.Birthday,
+		arg.Deathday,
+		arg.Gender,
+		arg.PlaceOfBirth,
+		arg.ProfilePath,
+		arg.Homepage,
+		arg.KnownForDepartment,
+		arg.Popularity,
+		arg.BiographiesI18n,
+	)
+	var i Person
+	err := row.Scan(
+		&i.ID,
+		&i.TmdbID,
+		&i.TvdbID,
+		&i.ImdbID,
+		&i.Name,
+		&i.AlsoKnownAs,
+		&i.Biography,
+		&i.Birthday,
+		&i.Deathday,
+		&i.Gender,
+		&i.PlaceOfBirth,
+		&i.ProfilePath,
+		&i.Homepage,
+		&i.KnownForDepartment,
+		&i.Popularity,
+		&i.BiographiesI18n,
+		&i.MetadataUpdatedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ImagePlaceholders,
+	)
+	return i, err
+}
@@ -44,6 +44,9 @@ type Querier interface {
 	CountLibraryPermissions(ctx context.Context, libraryID uuid.UUID) (int64, error)
 	// Counts scans for a library
 	CountLibraryScans(ctx context.Context, libraryID uuid.UUID) (int64, error)
+	// Counts queued items with the same optional filters as ListMatchQueueItems
+	CountMatchQueueItems(ctx context.Context, arg CountMatchQueueItemsParams) (int64, error)
+	CountMetadataChanges(ctx context.Context, arg CountMetadataChangesParams) (int64, error)
 	// Count activity logs for a specific resource
 	CountResourceActivityLogs(ctx context.Context, arg CountResourceActivityLogsParams) (int64, error)
 	// Count activity logs matching search filters
@@ -96,6 +99,8 @@ type Querier interface {
 	// ============================================================================
 	// Creates a new library scan record
 	CreateLibraryScan(ctx context.Context, arg CreateLibraryScanParams) (LibraryScan, error)
+	// Records a metadata change as the next version of the item.
+	CreateMetadataChange(ctx context.Context, arg CreateMetadataChangeParams) (MetadataChange, error)
 	// Creates a new OIDC provider configuration
 	CreateOIDCProvider(ctx context.Context, arg CreateOIDCProviderParams) (SharedOidcProvider, error)
 	// ============================================================================
@@ -145,6 +150,8 @@ type Querier interface {
 	DeleteLibrary(ctx context.Context, id uuid.UUID) error
 	// Revokes a permission from a user for a library
 	DeleteLibraryPermission(ctx context.Context, arg DeleteLibraryPermissionParams) error
+	// Removes open queue entries for files that have since been matched
+	DeleteMatchQueueItemsByPaths(ctx context.Context, arg DeleteMatchQueueItemsByPathsParams) (int64, error)
 	// Deletes an OIDC provider
 	DeleteOIDCProvider(ctx context.Context, id uuid.UUID) error
 	// Deletes an OAuth2 state (after use or expiration)
@@ -160,10 +167,15 @@ type Querier interface {
 	DeleteOldFailedLoginAttempts(ctx context.Context) error
 	// Deletes library scans older than a given time
 	DeleteOldLibraryScans(ctx context.Context, olderThan time.Time) (int64, error)
+	// Deletes changes older than a date (for the cleanup job).
+	DeleteOldMetadataChanges(ctx context.Context, createdAt time.Time) (int64, error)
 	DeleteRevokedAuthTokens(ctx context.Context) error
 	DeleteRevokedSessions(ctx context.Context) (int64, error)
 	// Delete a server setting
 	DeleteServerSetting(ctx context.Context, key string) error
+	// Removes artwork of one source that is no longer present, e.g. local
+	// images that were deleted from disk
+	DeleteStaleArtwork(ctx context.Context, arg DeleteStaleArtworkParams) (int64, error)
 	// Delete TOTP secret for a user
 	DeleteTOTPSecret(ctx context.Context, userID uuid.UUID) error
 	DeleteUsedPasswordResetTokens(ctx context.Context) error
@@ -202,6 +214,8 @@ type Querier interface {
 	GetActivityLogsByIP(ctx context.Context, arg GetActivityLogsByIPParams) ([]ActivityLog, error)
 	// Get all server statistics
 	GetAllServerStats(ctx context.Context) ([]SharedServerStat, error)
+	// Gets an artwork record by ID
+	GetArtwork(ctx context.Context, id uuid.UUID) (Artwork, error)
 	GetAuthTokenByHash(ctx context.Context, tokenHash string) (SharedAuthToken, error)
 	GetAuthTokensByDeviceFingerprint(ctx context.Context, arg GetAuthTokensByDeviceFingerprintParams) ([]SharedAuthToken, error)
 	GetAuthTokensByUserID(ctx context.Context, userID uuid.UUID) ([]SharedAuthToken, error)
@@ -225,6 +239,8 @@ type Querier interface {
 	GetLatestAvatarVersion(ctx context.Context, userID uuid.UUID) (int32, error)
 	// Gets the most recent scan for a library
 	GetLatestLibraryScan(ctx context.Context, libraryID uuid.UUID) (LibraryScan, error)
+	// Returns the current version of an item, 0 if it has no changes.
+	GetLatestMetadataVersion(ctx context.Context, arg GetLatestMetadataVersionParams) (int32, error)
 	// Gets a library by ID
 	GetLibrary(ctx context.Context, id uuid.UUID) (Library, error)
 	// Gets a library by name
@@ -234,6 +250,8 @@ type Querier interface {
 	// Gets a library scan by ID
 	GetLibraryScan(ctx context.Context, id uuid.UUID) (LibraryScan, error)
 	GetMFAVerifiedSessions(ctx context.Context, userID uuid.UUID) ([]SharedSession, error)
+	// Gets a queued item by ID
+	GetMatchQueueItem(ctx context.Context, id uuid.UUID) (LibraryMatchQueue, error)
 	// Gets an OIDC provider by ID
 	GetOIDCProvider(ctx context.Context, id uuid.UUID) (SharedOidcProvider, error)
 	// Gets an OIDC provider by name
@@ -249,6 +267,10 @@ type Querier interface {
 	// Count activity logs older than a specific date (for dry-run)
 	GetOldActivityLogsCount(ctx context.Context, createdAt time.Time) (int64, error)
 	GetPasswordResetToken(ctx context.Context, tokenHash string) (SharedPasswordResetToken, error)
+	// Gets a person by ID
+	GetPerson(ctx context.Context, id uuid.UUID) (Person, error)
+	// Gets a person by TMDb person ID
+	GetPersonByTMDbID(ctx context.Context, tmdbID *int32) (Person, error)
 	// Get recent distinct actions (for autocomplete/filtering)
 	GetRecentActions(ctx context.Context, limit int32) ([]GetRecentActionsRow, error)
 	// Get activity logs for a specific resource
@@ -285,6 +307,8 @@ type Querier interface {
 	// Get comprehensive MFA status for a user
 	// Note: COALESCE is used for require_mfa to handle the case when user_mfa_settings doesn't exist
 	GetUserMFAStatus(ctx context.Context, userID uuid.UUID) (GetUserMFAStatusRow, error)
+	// Gets the parental controls of a user
+	GetUserParentalControls(ctx context.Context, userID uuid.UUID) (SharedUserParentalControl, error)
 	// ============================================================================
 	// User Preferences Queries
 	// ============================================================================
@@ -318,6 +342,8 @@ type Querier interface {
 	ListActivityLogs(ctx context.Context, arg ListActivityLogsParams) ([]ActivityLog, error)
 	// Includes expired but not revoked sessions (for user to see full history)
 	ListAllUserSessions(ctx context.Context, userID uuid.UUID) ([]SharedSession, error)
+	// Lists the artwork of a movie, series or season
+	ListArtworkByContent(ctx context.Context, arg ListArtworkByContentParams) ([]Artwork, error)
 	// Lists all enabled libraries
 	ListEnabledLibraries(ctx context.Context) ([]Library, error)
 	// Lists all enabled OIDC providers
@@ -330,8 +356,19 @@ type Querier interface {
 	ListLibraryPermissions(ctx context.Context, libraryID uuid.UUID) ([]LibraryPermission, error)
 	// Lists scans for a library
 	ListLibraryScans(ctx context.Context, arg ListLibraryScansParams) ([]LibraryScan, error)
+	// Lists queued items, newest first, with optional filters
+	ListMatchQueueItems(ctx context.Context, arg ListMatchQueueItemsParams) ([]LibraryMatchQueue, error)
+	// Lists the changes of an item, newest first.
+	ListMetadataChanges(ctx context.Context, arg ListMetadataChangesParams) ([]MetadataChange, error)
+	// Lists the changes of an item made after a version, oldest first.
+	ListMetadataChangesAfter(ctx context.Context, arg ListMetadataChangesAfterParams) ([]MetadataChange, error)
 	// Lists all OIDC providers
 	ListOIDCProviders(ctx context.Context) ([]SharedOidcProvider, error)
+	// Lists a person's credits on movies in the library the viewer may see
+	ListPersonMovieCredits(ctx context.Context, arg ListPersonMovieCreditsParams) ([]ListPersonMovieCreditsRow, error)
+	// Lists a person's credits on series in the library the viewer may see,
+	// including guest appearances counted per series
+	ListPersonSeriesCredits(ctx context.Context, arg ListPersonSeriesCreditsParams) ([]ListPersonSeriesCreditsRow, error)
 	// Get public settings (exposed in API)
 	ListPublicServerSettings(ctx context.Context) ([]SharedServerSetting, error)
 	// Get all server settings
@@ -343,6 +380,8 @@ type Querier interface {
 	ListUserAvatars(ctx context.Context, arg ListUserAvatarsParams) ([]SharedUserAvatar, error)
 	// Lists all library permissions for a user
 	ListUserLibraryPermissions(ctx context.Context, userID uuid.UUID) ([]LibraryPermission, error)
+	// Languages referenced by any user's metadata language or fallback chain
+	ListUserMetadataLanguages(ctx context.Context) ([]string, error)
 	// Lists all OIDC links for a user
 	ListUserOIDCLinks(ctx context.Context, userID uuid.UUID) ([]ListUserOIDCLinksRow, error)
 	ListUserSessions(ctx context.Context, userID uuid.UUID) ([]SharedSession, error)
@@ -358,6 +397,8 @@ type Querier interface {
 	// ============================================================================
 	// List all WebAuthn credentials for a user
 	ListWebAuthnCredentials(ctx context.Context, userID uuid.UUID) ([]WebauthnCredential, error)
+	// Records that an image was stored in the local image cache
+	MarkArtworkCached(ctx context.Context, arg MarkArtworkCachedParams) error
 	MarkEmailVerificationTokenUsed(ctx context.Context, id uuid.UUID) error
 	MarkPasswordResetTokenUsed(ctx context.Context, id uuid.UUID) error
 	// MFA Session Tracking
@@ -367,6 +408,11 @@ type Querier interface {
 	MarkWebAuthnCloneDetected(ctx context.Context, credentialID []byte) error
 	// Failed Login Attempts (Account Lockout / Rate Limiting)
 	RecordFailedLoginAttempt(ctx context.Context, arg RecordFailedLoginAttemptParams) error
+	// Counts a wrong override PIN. Reaching max_attempts locks PIN entry until
+	// locked_until and starts a new count.
+	RecordUserParentalPINFailure(ctx context.Context, arg RecordUserParentalPINFailureParams) (SharedUserParentalControl, error)
+	// Marks an item as manually identified
+	ResolveMatchQueueItem(ctx context.Context, arg ResolveMatchQueueItemParams) (LibraryMatchQueue, error)
 	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
 	RevokeAllUserAuthTokens(ctx context.Context, userID uuid.UUID) error
 	RevokeAllUserAuthTokensExcept(ctx context.Context, arg RevokeAllUserAuthTokensExceptParams) error
@@ -379,10 +425,24 @@ type Querier interface {
 	RevokeSessionByTokenHash(ctx context.Context, arg RevokeSessionByTokenHashParams) error
 	// Search activity logs with optional filters
 	SearchActivityLogs(ctx context.Context, arg SearchActivityLogsParams) ([]ActivityLog, error)
+	// Makes one image the selected image of its kind and deselects the others
+	SelectArtwork(ctx context.Context, arg SelectArtworkParams) (int64, error)
+	// Stores the BlurHash and dominant color computed for an image
+	SetArtworkPlaceholder(ctx context.Context, arg SetArtworkPlaceholderParams) error
 	// Set an existing avatar as current
 	SetCurrentAvatar(ctx context.Context, id uuid.UUID) error
 	// Sets a provider as default (clears other defaults first)
 	SetDefaultOIDCProvider(ctx context.Context) error
+	// Links a scan to the River job performing it
+	SetLibraryScanJobID(ctx context.Context, arg SetLibraryScanJobIDParams) (LibraryScan, error)
+	// Updates the status of a queued item (e.g. ignore or re-open)
+	SetMatchQueueItemStatus(ctx context.Context, arg SetMatchQueueItemStatusParams) (LibraryMatchQueue, error)
+	// Stores the placeholder of the person's profile image. Placeholders of
+	// images the person no longer uses are dropped.
+	SetPersonImagePlaceholder(ctx context.Context, arg SetPersonImagePlaceholderParams) (int64, error)
+	// Lifts the restrictions of a user until the given time and clears failed
+	// PIN attempts
+	SetUserParentalOverride(ctx context.Context, arg SetUserParentalOverrideParams) (SharedUserParentalControl, error)
 	// Sum total episode watch duration in seconds
 	SumEpisodeWatchDurationSeconds(ctx context.Context) (int64, error)
 	// Sum total movie watch duration in seconds
@@ -418,6 +478,10 @@ type Querier interface {
 	UpdateOIDCUserLinkLastLogin(ctx context.Context, id uuid.UUID) error
 	// Update user password hash
 	UpdatePassword(ctx context.Context, arg UpdatePasswordParams) error
+	// Stores details fetched from a metadata provider. Provider IDs are only
+	// filled in, never cleared, and are left alone when another person already
+	// has them.
+	UpdatePersonMetadata(ctx context.Context, arg UpdatePersonMetadataParams) (Person, error)
 	// Update a server setting value
 	UpdateServerSetting(ctx context.Context, arg UpdateServerSettingParams) (SharedServerSetting, error)
 	UpdateSessionActivity(ctx context.Context, id uuid.UUID) error
@@ -434,10 +498,19 @@ type Querier interface {
 	UpdateWebAuthnCounter(ctx context.Context, arg UpdateWebAuthnCounterParams) error
 	// Update the user-facing name of a credential
 	UpdateWebAuthnCredentialName(ctx context.Context, arg UpdateWebAuthnCredentialNameParams) error
+	// Records an artwork image, refreshing its dimensions, language and votes
+	// if it already exists.
+	UpsertArtwork(ctx context.Context, arg UpsertArtworkParams) (Artwork, error)
+	// Records an unmatched file, refreshing candidates if it is already queued.
+	// Items an admin ignored stay ignored.
+	UpsertMatchQueueItem(ctx context.Context, arg UpsertMatchQueueItemParams) (LibraryMatchQueue, error)
 	// Insert or update a server setting
 	UpsertServerSetting(ctx context.Context, arg UpsertServerSettingParams) (SharedServerSetting, error)
 	// Upsert a single server statistic
 	UpsertServerStat(ctx context.Context, arg UpsertServerStatParams) error
+	// Creates or replaces the restrictions and PIN of a user. An active
+	// override is kept; setting a new PIN clears failed PIN attempts.
+	UpsertUserParentalControls(ctx context.Context, arg UpsertUserParentalControlsParams) (SharedUserParentalControl, error)
 	// Create or update user preferences
 	UpsertUserPreferences(ctx context.Context, arg UpsertUserPreferencesParams) (SharedUserPreference, error)
 	// Insert or update a user setting
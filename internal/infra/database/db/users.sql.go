@@ -410,7 +410,7 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (Share
 
 const getUserPreferences = `-- name: GetUserPreferences :one
 
-SELECT user_id, email_notifications, push_notifications, digest_notifications, profile_visibility, show_email, show_activity, theme, display_language, content_language, show_adult_content, show_spoilers, auto_play_videos, created_at, updated_at, metadata_language FROM shared.user_preferences WHERE user_id = $1
+SELECT user_id, email_notifications, push_notifications, digest_notifications, profile_visibility, show_email, show_activity, theme, display_language, content_language, show_adult_content, show_spoilers, auto_play_videos, created_at, updated_at, metadata_language, hidden_tags, metadata_language_chain FROM shared.user_preferences WHERE user_id = $1
 `
 
 // ============================================================================
@@ -437,6 +437,8 @@ func (q *Queries) GetUserPreferences(ctx context.Context, userID uuid.UUID) (Sha
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetadataLanguage,
+		&i.HiddenTags,
+		&i.MetadataLanguageChain,
 	)
 	return i, err
 }
@@ -517,6 +519,43 @@ func (q *Queries) ListUserAvatars(ctx context.Context, arg ListUserAvatarsParams
 	return items, nil
 }
 
+const listUserMetadataLanguages = `-- name: ListUserMetadataLanguages :many
+SELECT DISTINCT lang::text AS lang
+FROM (
+        SELECT unnest(metadata_language_chain) AS lang
+        FROM shared.user_preferences
+        UNION
+        SELECT metadata_language
+        FROM shared.user_preferences
+    ) AS l
+WHERE
+    lang IS NOT NULL
+    AND lang <> ''
+    AND lang <> 'original'
+ORDER BY lang
+`
+
+// Languages referenced by any user's metadata language or fallback chain
+func (q *Queries) ListUserMetadataLanguages(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserMetadataLanguages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var lang string
+		if err := rows.Scan(&lang); err != nil {
+			return nil, err
+		}
+		items = append(items, lang)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
 SELECT id, username, email, password_hash, display_name, avatar_url, locale, timezone, qar_enabled, is_active, is_admin, email_verified, email_verified_at, created_at, updated_at, last_login_at, deleted_at FROM shared.users
 WHERE deleted_at IS NULL
@@ -751,7 +790,9 @@ INSERT INTO
         metadata_language,
         show_adult_content,
         show_spoilers,
-        auto_play_videos
+        auto_play_videos,
+        hidden_tags,
+        metadata_language_chain
     )
 VALUES (
         $1,
@@ -767,7 +808,9 @@ VALUES (
         $11,
         $12,
         $13,
-        $14
+        $14,
+        $15,
+        $16
     ) ON CONFLICT (user_id) DO
 UPDATE
 SET
@@ -823,24 +866,34 @@ SET
         EXCLUDED.auto_play_videos,
         user_preferences.auto_play_videos
     ),
-    updated_at = NOW() RETURNING user_id, email_notifications, push_notifications, digest_notifications, profile_visibility, show_email, show_activity, theme, display_language, content_language, show_adult_content, show_spoilers, auto_play_videos, created_at, updated_at, metadata_language
+    hidden_tags = COALESCE(
+        EXCLUDED.hidden_tags,
+        user_preferences.hidden_tags
+    ),
+    metadata_language_chain = COALESCE(
+        EXCLUDED.metadata_language_chain,
+        user_preferences.metadata_language_chain
+    ),
+    updated_at = NOW() RETURNING user_id, email_notifications, push_notifications, digest_notifications, profile_visibility, show_email, show_activity, theme, display_language, content_language, show_adult_content, show_spoilers, auto_play_videos, created_at, updated_at, metadata_language, hidden_tags, metadata_language_chain
 `
 
 type UpsertUserPreferencesParams struct {
-	UserID              uuid.UUID `json:"userId"`
-	EmailNotifications  []byte    `json:"emailNotifications"`
-	PushNotifications   []byte    `json:"pushNotifications"`
-	DigestNotifications []byte    `json:"digestNotifications"`
-	ProfileVisibility   *string   `json:"profileVisibility"`
-	ShowEmail           *bool     `json:"showEmail"`
-	ShowActivity        *bool     `json:"showActivity"`
-	Theme               *string   `json:"theme"`
-	DisplayLanguage     *string   `json:"displayLanguage"`
-	ContentLanguage     *string   `json:"contentLanguage"`
-	MetadataLanguage    *string   `json:"metadataLanguage"`
-	ShowAdultContent    *bool     `json:"showAdultContent"`
-	ShowSpoilers        *bool     `json:"showSpoilers"`
-	AutoPlayVideos      *bool     `json:"autoPlayVideos"`
+	UserID                uuid.UUID `json:"userId"`
+	EmailNotifications    []byte    `json:"emailNotifications"`
+	PushNotifications     []byte    `json:"pushNotifications"`
+	DigestNotifications   []byte    `json:"digestNotifications"`
+	ProfileVisibility     *string   `json:"profileVisibility"`
+	ShowEmail             *bool     `json:"showEmail"`
+	ShowActivity          *bool     `json:"showActivity"`
+	Theme                 *string   `json:"theme"`
+	DisplayLanguage       *string   `json:"displayLanguage"`
+	ContentLanguage       *string   `json:"contentLanguage"`
+	MetadataLanguage      *string   `json:"metadataLanguage"`
+	ShowAdultContent      *bool     `json:"showAdultContent"`
+	ShowSpoilers          *bool     `json:"showSpoilers"`
+	AutoPlayVideos        *bool     `json:"autoPlayVideos"`
+	HiddenTags            []string  `json:"hiddenTags"`
+	MetadataLanguageChain []string  `json:"metadataLanguageChain"`
 }
 
 // Create or update user preferences
@@ -860,6 +913,8 @@ func (q *Queries) UpsertUserPreferences(ctx context.Context, arg UpsertUserPrefe
 		arg.ShowAdultContent,
 		arg.ShowSpoilers,
 		arg.AutoPlayVideos,
+		arg.HiddenTags,
+		arg.MetadataLanguageChain,
 	)
 	var i SharedUserPreference
 	err := row.Scan(
@@ -879,6 +934,8 @@ func (q *Queries) UpsertUserPreferences(ctx context.Context, arg UpsertUserPrefe
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetadataLanguage,
+		&i.HiddenTags,
+		&i.MetadataLanguageChain,
 	)
 	return i, err
 }
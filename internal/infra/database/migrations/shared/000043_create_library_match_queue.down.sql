@@ -0,0 +1,3 @@
+ALTER TABLE movie.movie_files DROP COLUMN IF EXISTS match_locked;
+
+DROP TABLE IF EXISTS public.library_match_queue;
@@ -0,0 +1,53 @@
+-- Migration 000043: Unmatched-items queue and per-file match locks
+-- Files the scanner could not match (or matched below the confidence
+-- threshold) are parked here with their top provider candidates so an
+-- admin can identify them manually.
+
+CREATE TABLE IF NOT EXISTS public.library_match_queue (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    library_id UUID REFERENCES public.libraries (id) ON DELETE CASCADE,
+
+    -- What was scanned
+    content_type VARCHAR(20) NOT NULL, -- 'movie', 'tvshow'
+    file_path TEXT NOT NULL,
+    parsed_title TEXT,
+    parsed_year INTEGER,
+
+    -- Queue state
+    status VARCHAR(20) NOT NULL DEFAULT 'unmatched', -- 'unmatched', 'low_confidence', 'resolved', 'ignored'
+    reason TEXT,
+    candidates JSONB NOT NULL DEFAULT '[]',
+    best_confidence DOUBLE PRECISION,
+
+    -- Resolution
+    resolved_content_id UUID,
+    resolved_by UUID REFERENCES shared.users (id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_library_match_queue_path UNIQUE (content_type, file_path)
+);
+
+CREATE INDEX idx_library_match_queue_status ON public.library_match_queue (status, created_at DESC);
+
+CREATE INDEX idx_library_match_queue_library ON public.library_match_queue (library_id)
+WHERE
+    library_id IS NOT NULL;
+
+CREATE TRIGGER update_library_match_queue_updated_at
+    BEFORE UPDATE ON public.library_match_queue
+    FOR EACH ROW
+    EXECUTE FUNCTION shared.update_updated_at_column();
+
+COMMENT ON TABLE public.library_match_queue IS 'Scanned files awaiting manual identification';
+COMMENT ON COLUMN public.library_match_queue.status IS 'Queue status: unmatched, low_confidence, resolved, ignored';
+COMMENT ON COLUMN public.library_match_queue.candidates IS 'Top provider candidates with confidence scores, best first';
+
+-- Manual matches are locked so rescans leave them alone
+ALTER TABLE movie.movie_files
+ADD COLUMN match_locked BOOLEAN NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN movie.movie_files.match_locked IS 'Set when an admin identified the file manually; scanners skip locked files';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS movie.idx_movies_sort_title;
+
+ALTER TABLE movie.movies DROP COLUMN IF EXISTS sort_title;
@@ -0,0 +1,6 @@
+-- Sort title imported from NFO sidecars (e.g. "Matrix 1" for "The Matrix")
+ALTER TABLE movie.movies ADD COLUMN sort_title TEXT;
+
+CREATE INDEX idx_movies_sort_title ON movie.movies (COALESCE(sort_title, title));
+
+COMMENT ON COLUMN movie.movies.sort_title IS 'Title used for alphabetical sorting; falls back to title when NULL';
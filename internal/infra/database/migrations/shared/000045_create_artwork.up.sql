@@ -0,0 +1,37 @@
+-- Migration 000045: Artwork records
+-- Images that belong to movies, series and seasons. Local artwork is found
+-- next to the media files during library scans; provider artwork may be
+-- recorded alongside it.
+
+CREATE TABLE IF NOT EXISTS public.artwork (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Owner
+    content_type VARCHAR(20) NOT NULL, -- 'movie', 'series', 'season'
+    content_id UUID NOT NULL,
+
+    -- Image
+    kind VARCHAR(20) NOT NULL, -- 'poster', 'backdrop', 'logo', 'clearart'
+    source VARCHAR(20) NOT NULL, -- 'local', 'tmdb', 'fanarttv', ...
+    path TEXT NOT NULL, -- file path for local artwork, provider path or URL otherwise
+    width INTEGER,
+    height INTEGER,
+    file_size BIGINT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_artwork_content_kind_source_path UNIQUE (content_type, content_id, kind, source, path)
+);
+
+CREATE INDEX idx_artwork_content ON public.artwork (content_type, content_id);
+
+CREATE TRIGGER update_artwork_updated_at
+    BEFORE UPDATE ON public.artwork
+    FOR EACH ROW
+    EXECUTE FUNCTION shared.update_updated_at_column();
+
+COMMENT ON TABLE public.artwork IS 'Artwork for movies, series and seasons from local files and providers';
+COMMENT ON COLUMN public.artwork.source IS 'Where the image came from: local or a provider name';
+COMMENT ON COLUMN public.artwork.path IS 'Absolute file path for local artwork, provider path or URL otherwise';
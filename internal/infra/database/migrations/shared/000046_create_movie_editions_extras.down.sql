@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS movie.movie_extras;
+
+ALTER TABLE movie.movie_files DROP COLUMN IF EXISTS edition;
@@ -0,0 +1,40 @@
+-- Migration 000046: Movie editions and extras
+-- Files of the same movie can be named editions (Director's Cut, Extended,
+-- ...). Extras such as trailers and featurettes are stored as typed child
+-- items of their movie.
+
+ALTER TABLE movie.movie_files
+ADD COLUMN edition TEXT;
+
+COMMENT ON COLUMN movie.movie_files.edition IS 'Edition name parsed from {edition-...} in the file name; NULL for the default version';
+
+CREATE TABLE IF NOT EXISTS movie.movie_extras (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    movie_id UUID NOT NULL REFERENCES movie.movies(id) ON DELETE CASCADE,
+
+    -- Classification
+    extra_type VARCHAR(30) NOT NULL, -- 'trailer', 'featurette', 'behind_the_scenes', 'deleted_scene', 'interview', 'scene', 'short', 'other'
+    title TEXT NOT NULL,
+
+    -- File
+    file_path TEXT NOT NULL,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    container VARCHAR(20),
+    duration_seconds INTEGER,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_movie_extras_file_path UNIQUE (file_path)
+);
+
+CREATE INDEX idx_movie_extras_movie_id ON movie.movie_extras (movie_id);
+
+CREATE TRIGGER update_movie_extras_updated_at
+    BEFORE UPDATE ON movie.movie_extras
+    FOR EACH ROW
+    EXECUTE FUNCTION shared.update_updated_at_column();
+
+COMMENT ON TABLE movie.movie_extras IS 'Trailers, featurettes and other extras found next to movie files';
+COMMENT ON COLUMN movie.movie_extras.extra_type IS 'Kind of extra, from the folder or file name suffix';
@@ -0,0 +1 @@
+ALTER TABLE public.library_scans DROP COLUMN IF EXISTS job_id;
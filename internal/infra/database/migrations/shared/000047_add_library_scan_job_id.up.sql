@@ -0,0 +1,8 @@
+-- Migration 000047: Link library scans to their job
+-- Stores the River job that performs a scan so running scans can be
+-- cancelled.
+
+ALTER TABLE public.library_scans
+ADD COLUMN job_id BIGINT;
+
+COMMENT ON COLUMN public.library_scans.job_id IS 'River job performing the scan; used to cancel running scans';
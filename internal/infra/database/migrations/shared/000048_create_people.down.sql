@@ -0,0 +1,5 @@
+ALTER TABLE tvshow.episode_credits DROP COLUMN IF EXISTS person_id;
+ALTER TABLE tvshow.series_credits DROP COLUMN IF EXISTS person_id;
+ALTER TABLE movie.movie_credits DROP COLUMN IF EXISTS person_id;
+
+DROP TABLE IF EXISTS public.people;
@@ -0,0 +1,93 @@
+-- Migration 000048: People
+-- One record per person shared by movie and TV credits, deduplicated by
+-- provider IDs. Credits keep their denormalized name and photo and link to
+-- the person through person_id.
+
+CREATE TABLE IF NOT EXISTS public.people (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Provider IDs
+    tmdb_id INTEGER,
+    tvdb_id INTEGER,
+    imdb_id VARCHAR(20),
+
+    -- Details
+    name TEXT NOT NULL,
+    also_known_as TEXT[] NOT NULL DEFAULT '{}',
+    biography TEXT,
+    birthday DATE,
+    deathday DATE,
+    gender INTEGER NOT NULL DEFAULT 0, -- 0=not specified, 1=female, 2=male, 3=non-binary
+    place_of_birth TEXT,
+    profile_path TEXT,
+    homepage TEXT,
+    known_for_department TEXT,
+    popularity DOUBLE PRECISION,
+
+    -- Localized biographies keyed by language: {"de": "...", "fr": "..."}
+    biographies_i18n JSONB NOT NULL DEFAULT '{}',
+
+    -- Set when details were last fetched from a provider
+    metadata_updated_at TIMESTAMPTZ,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A person is matched by any provider ID it has
+CREATE UNIQUE INDEX uq_people_tmdb_id ON public.people (tmdb_id) WHERE tmdb_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_people_tvdb_id ON public.people (tvdb_id) WHERE tvdb_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_people_imdb_id ON public.people (imdb_id) WHERE imdb_id IS NOT NULL;
+CREATE INDEX idx_people_name ON public.people (name);
+
+CREATE TRIGGER update_people_updated_at
+    BEFORE UPDATE ON public.people
+    FOR EACH ROW
+    EXECUTE FUNCTION shared.update_updated_at_column();
+
+COMMENT ON TABLE public.people IS 'Cast and crew members shared by movie and TV credits';
+COMMENT ON COLUMN public.people.biographies_i18n IS 'Biographies in other languages keyed by ISO 639-1 code';
+COMMENT ON COLUMN public.people.metadata_updated_at IS 'Last time details were fetched from a metadata provider; NULL for people only known from credits';
+
+-- Link credits to people
+ALTER TABLE movie.movie_credits
+ADD COLUMN person_id UUID REFERENCES public.people(id) ON DELETE SET NULL;
+
+ALTER TABLE tvshow.series_credits
+ADD COLUMN person_id UUID REFERENCES public.people(id) ON DELETE SET NULL;
+
+ALTER TABLE tvshow.episode_credits
+ADD COLUMN person_id UUID REFERENCES public.people(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_movie_credits_person ON movie.movie_credits (person_id);
+CREATE INDEX idx_series_credits_person_id ON tvshow.series_credits (person_id);
+CREATE INDEX idx_episode_credits_person_id ON tvshow.episode_credits (person_id);
+
+-- Backfill people from existing credits. Credits only store the TMDb ID;
+-- 0 means the provider had none, so those credits stay unlinked.
+INSERT INTO public.people (tmdb_id, name, profile_path)
+SELECT DISTINCT ON (tmdb_person_id) tmdb_person_id, name, profile_path
+FROM (
+    SELECT tmdb_person_id, name, profile_path FROM movie.movie_credits
+    UNION ALL
+    SELECT tmdb_person_id, name, profile_path FROM tvshow.series_credits
+    UNION ALL
+    SELECT tmdb_person_id, name, profile_path FROM tvshow.episode_credits
+) credits
+WHERE tmdb_person_id > 0
+ORDER BY tmdb_person_id, profile_path NULLS LAST
+ON CONFLICT (tmdb_id) WHERE tmdb_id IS NOT NULL DO NOTHING;
+
+UPDATE movie.movie_credits c SET person_id = p.id
+FROM public.people p WHERE p.tmdb_id = c.tmdb_person_id;
+
+UPDATE tvshow.series_credits c SET person_id = p.id
+FROM public.people p WHERE p.tmdb_id = c.tmdb_person_id;
+
+UPDATE tvshow.episode_credits c SET person_id = p.id
+FROM public.people p WHERE p.tmdb_id = c.tmdb_person_id;
+
+COMMENT ON COLUMN movie.movie_credits.person_id IS 'Person this credit belongs to';
+COMMENT ON COLUMN tvshow.series_credits.person_id IS 'Person this credit belongs to';
+COMMENT ON COLUMN tvshow.episode_credits.person_id IS 'Person this credit belongs to';
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS tvshow.idx_series_sort_title;
+
+ALTER TABLE tvshow.episodes DROP COLUMN IF EXISTS locked_fields;
+
+ALTER TABLE tvshow.seasons DROP COLUMN IF EXISTS locked_fields;
+
+ALTER TABLE tvshow.series
+DROP COLUMN IF EXISTS locked_fields,
+DROP COLUMN IF EXISTS sort_title;
+
+ALTER TABLE movie.movies DROP COLUMN IF EXISTS locked_fields;
@@ -0,0 +1,24 @@
+-- Migration 000049: Field-level metadata locks
+-- Fields edited by an admin are recorded in locked_fields so metadata
+-- refreshes and the Radarr/Sonarr sync leave them alone.
+
+ALTER TABLE movie.movies
+ADD COLUMN locked_fields TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.series
+ADD COLUMN sort_title TEXT,
+ADD COLUMN locked_fields TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.seasons
+ADD COLUMN locked_fields TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.episodes
+ADD COLUMN locked_fields TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_series_sort_title ON tvshow.series (COALESCE(sort_title, title));
+
+COMMENT ON COLUMN movie.movies.locked_fields IS 'Manually edited fields that automatic metadata updates must not overwrite';
+COMMENT ON COLUMN tvshow.series.sort_title IS 'Title used for alphabetical sorting; falls back to title when NULL';
+COMMENT ON COLUMN tvshow.series.locked_fields IS 'Manually edited fields that automatic metadata updates must not overwrite';
+COMMENT ON COLUMN tvshow.seasons.locked_fields IS 'Manually edited fields that automatic metadata updates must not overwrite';
+COMMENT ON COLUMN tvshow.episodes.locked_fields IS 'Manually edited fields that automatic metadata updates must not overwrite';
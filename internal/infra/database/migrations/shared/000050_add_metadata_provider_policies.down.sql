@@ -0,0 +1,13 @@
+ALTER TABLE tvshow.episodes DROP COLUMN IF EXISTS metadata_sources;
+
+ALTER TABLE tvshow.seasons DROP COLUMN IF EXISTS metadata_sources;
+
+ALTER TABLE tvshow.series
+DROP COLUMN IF EXISTS provider_ids,
+DROP COLUMN IF EXISTS metadata_sources;
+
+ALTER TABLE movie.movies
+DROP COLUMN IF EXISTS provider_ids,
+DROP COLUMN IF EXISTS metadata_sources;
+
+ALTER TABLE public.libraries DROP COLUMN IF EXISTS metadata_policy;
@@ -0,0 +1,28 @@
+-- Migration 000050: Per-library metadata provider policies
+-- Libraries carry an ordered provider chain per metadata field. Items record
+-- which provider supplied each field and the IDs resolved at each provider.
+
+ALTER TABLE public.libraries
+ADD COLUMN metadata_policy JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE movie.movies
+ADD COLUMN metadata_sources JSONB NOT NULL DEFAULT '{}',
+ADD COLUMN provider_ids JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.series
+ADD COLUMN metadata_sources JSONB NOT NULL DEFAULT '{}',
+ADD COLUMN provider_ids JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.seasons
+ADD COLUMN metadata_sources JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.episodes
+ADD COLUMN metadata_sources JSONB NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN public.libraries.metadata_policy IS 'Provider chains per metadata field: {"providers": [...], "fields": {"title": [...]}, "enable_provider_fallback": bool, "enable_enrichment": bool}';
+COMMENT ON COLUMN movie.movies.metadata_sources IS 'Provider that supplied each metadata field: {"title": "tmdb", ...}';
+COMMENT ON COLUMN movie.movies.provider_ids IS 'IDs of the movie at metadata providers without a dedicated column: {"anilist": "21", ...}';
+COMMENT ON COLUMN tvshow.series.metadata_sources IS 'Provider that supplied each metadata field: {"title": "anilist", ...}';
+COMMENT ON COLUMN tvshow.series.provider_ids IS 'IDs of the series at metadata providers without a dedicated column: {"anilist": "21", ...}';
+COMMENT ON COLUMN tvshow.seasons.metadata_sources IS 'Provider that supplied each metadata field';
+COMMENT ON COLUMN tvshow.episodes.metadata_sources IS 'Provider that supplied each metadata field';
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS public.idx_artwork_selected;
+
+ALTER TABLE public.artwork
+DROP COLUMN IF EXISTS cached_at,
+DROP COLUMN IF EXISTS user_selected,
+DROP COLUMN IF EXISTS selected,
+DROP COLUMN IF EXISTS vote_count,
+DROP COLUMN IF EXISTS vote_average,
+DROP COLUMN IF EXISTS language;
@@ -0,0 +1,19 @@
+-- Migration 000051: Artwork candidates and selection
+-- Every image a provider offers for an item is kept as a candidate with its
+-- language and votes. One candidate per kind is selected, either
+-- automatically or by an admin, and the selected images are cached locally.
+
+ALTER TABLE public.artwork
+ADD COLUMN language VARCHAR(10),
+ADD COLUMN vote_average DOUBLE PRECISION NOT NULL DEFAULT 0,
+ADD COLUMN vote_count INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN selected BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN user_selected BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN cached_at TIMESTAMPTZ;
+
+CREATE INDEX idx_artwork_selected ON public.artwork (content_type, content_id, kind) WHERE selected;
+
+COMMENT ON COLUMN public.artwork.language IS 'ISO 639-1 language of text in the image; NULL for textless images';
+COMMENT ON COLUMN public.artwork.selected IS 'Whether this is the image used for its kind';
+COMMENT ON COLUMN public.artwork.user_selected IS 'Selected by an admin; automatic selection keeps it';
+COMMENT ON COLUMN public.artwork.cached_at IS 'When the selected image was stored in the local image cache';
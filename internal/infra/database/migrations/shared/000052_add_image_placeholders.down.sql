@@ -0,0 +1,13 @@
+ALTER TABLE public.people DROP COLUMN IF EXISTS image_placeholders;
+
+ALTER TABLE tvshow.episodes DROP COLUMN IF EXISTS image_placeholders;
+
+ALTER TABLE tvshow.seasons DROP COLUMN IF EXISTS image_placeholders;
+
+ALTER TABLE tvshow.series DROP COLUMN IF EXISTS image_placeholders;
+
+ALTER TABLE movie.movies DROP COLUMN IF EXISTS image_placeholders;
+
+ALTER TABLE public.artwork
+DROP COLUMN IF EXISTS dominant_color,
+DROP COLUMN IF EXISTS blurhash;
@@ -0,0 +1,32 @@
+-- Migration 000052: Image placeholders
+-- A BlurHash and dominant color are computed for each image when it is
+-- cached, so clients can render a placeholder before the image loads.
+-- Items keep the placeholders of their images keyed by image path; entries
+-- for paths the item no longer uses are dropped when a new one is stored.
+
+ALTER TABLE public.artwork
+ADD COLUMN blurhash TEXT,
+ADD COLUMN dominant_color VARCHAR(7);
+
+ALTER TABLE movie.movies
+ADD COLUMN image_placeholders JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.series
+ADD COLUMN image_placeholders JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.seasons
+ADD COLUMN image_placeholders JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE tvshow.episodes
+ADD COLUMN image_placeholders JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE public.people
+ADD COLUMN image_placeholders JSONB NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN public.artwork.blurhash IS 'BlurHash of the image, computed when it is cached';
+COMMENT ON COLUMN public.artwork.dominant_color IS 'Dominant color of the image as #rrggbb';
+COMMENT ON COLUMN movie.movies.image_placeholders IS 'Placeholders by image path: {"/abc.jpg": {"blurhash": "...", "color": "#1a2b3c"}}';
+COMMENT ON COLUMN tvshow.series.image_placeholders IS 'Placeholders by image path: {"/abc.jpg": {"blurhash": "...", "color": "#1a2b3c"}}';
+COMMENT ON COLUMN tvshow.seasons.image_placeholders IS 'Placeholders by image path';
+COMMENT ON COLUMN tvshow.episodes.image_placeholders IS 'Placeholders by image path';
+COMMENT ON COLUMN public.people.image_placeholders IS 'Placeholders by image path';
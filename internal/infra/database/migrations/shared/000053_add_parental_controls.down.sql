@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS shared.user_parental_controls;
+
+DROP INDEX IF EXISTS tvshow.idx_series_min_age;
+
+DROP INDEX IF EXISTS movie.idx_movies_min_age;
+
+ALTER TABLE tvshow.series DROP COLUMN IF EXISTS min_age;
+
+ALTER TABLE movie.movies DROP COLUMN IF EXISTS min_age;
@@ -0,0 +1,89 @@
+-- Migration 000053: Content rating normalization and parental controls
+-- Movies and series store the minimum viewer age derived from their age
+-- ratings across all countries (NULL when unrated). Per-user parental
+-- controls limit what a profile can list, search and play.
+
+ALTER TABLE movie.movies ADD COLUMN min_age INTEGER;
+
+ALTER TABLE tvshow.series ADD COLUMN min_age INTEGER;
+
+CREATE INDEX idx_movies_min_age ON movie.movies (min_age);
+
+CREATE INDEX idx_series_min_age ON tvshow.series (min_age);
+
+COMMENT ON COLUMN movie.movies.min_age IS 'Minimum viewer age normalized from age_ratings, NULL when unrated';
+COMMENT ON COLUMN tvshow.series.min_age IS 'Minimum viewer age normalized from age_ratings, NULL when unrated';
+
+-- min_age is derived when metadata is written. Existing items get it from
+-- their stored age ratings the same way (content.MinimumAge): named
+-- certifications are looked up per country, others carry the age in their
+-- name ("FSK 12", "-16"), and the strictest recognized rating wins.
+CREATE FUNCTION pg_temp.min_age_from_ratings(ratings JSONB) RETURNS INTEGER
+LANGUAGE sql IMMUTABLE STRICT AS $$
+    WITH named_ratings (country, rating, age) AS (
+        VALUES
+            ('US', 'G', 0), ('US', 'PG', 10), ('US', 'PG-13', 13), ('US', 'R', 17), ('US', 'NC-17', 18),
+            ('US', 'TV-Y', 0), ('US', 'TV-Y7', 7), ('US', 'TV-Y7-FV', 7), ('US', 'TV-G', 0), ('US', 'TV-PG', 10), ('US', 'TV-14', 14), ('US', 'TV-MA', 17),
+            ('GB', 'U', 0), ('GB', 'UC', 0), ('GB', 'PG', 8), ('GB', 'R18', 18),
+            ('FR', 'U', 0), ('FR', 'TP', 0), ('FR', 'TOUS PUBLICS', 0),
+            ('JP', 'G', 0), ('JP', 'PG12', 12), ('JP', 'R15+', 15), ('JP', 'R18+', 18),
+            ('KR', 'ALL', 0), ('KR', 'RESTRICTED SCREENING', 18),
+            ('BR', 'L', 0), ('BR', 'ER', 10),
+            ('AU', 'E', 0), ('AU', 'G', 0), ('AU', 'P', 0), ('AU', 'C', 0), ('AU', 'PG', 8), ('AU', 'M', 15), ('AU', 'MA15+', 15), ('AU', 'AV15+', 15), ('AU', 'R18+', 18), ('AU', 'X18+', 18), ('AU', 'RC', 18),
+            ('CA', 'E', 0), ('CA', 'G', 0), ('CA', 'C', 0), ('CA', 'C8', 8), ('CA', 'PG', 8), ('CA', '14A', 14), ('CA', '14+', 14), ('CA', '18A', 18), ('CA', '18+', 18), ('CA', 'R', 18), ('CA', 'A', 18),
+            ('NL', 'AL', 0),
+            ('ES', 'A', 0), ('ES', 'APTA', 0), ('ES', 'TP', 0), ('ES', 'X', 18),
+            ('IT', 'T', 0),
+            ('IN', 'U', 0), ('IN', 'UA', 12), ('IN', 'A', 18), ('IN', 'S', 18)
+    ),
+    certifications AS (
+        SELECT upper(c.country) AS country, upper(btrim(r.rating)) AS rating
+        FROM jsonb_each(ratings) AS c (country, systems)
+        CROSS JOIN LATERAL jsonb_each_text(
+            CASE WHEN jsonb_typeof(c.systems) = 'object' THEN c.systems ELSE '{}'::jsonb END
+        ) AS r (system, rating)
+    ),
+    ages AS (
+        SELECT COALESCE(
+            n.age,
+            CASE WHEN substring(r.rating FROM '[0-9]+')::numeric <= 21
+                THEN substring(r.rating FROM '[0-9]+')::integer
+            END
+        ) AS age
+        FROM certifications r
+        LEFT JOIN named_ratings n ON n.country = r.country AND n.rating = r.rating
+    )
+    SELECT max(age) FROM ages
+$$;
+
+UPDATE movie.movies
+SET min_age = pg_temp.min_age_from_ratings(age_ratings)
+WHERE jsonb_typeof(age_ratings) = 'object' AND age_ratings <> '{}'::jsonb;
+
+UPDATE tvshow.series
+SET min_age = pg_temp.min_age_from_ratings(age_ratings)
+WHERE jsonb_typeof(age_ratings) = 'object' AND age_ratings <> '{}'::jsonb;
+
+DROP FUNCTION pg_temp.min_age_from_ratings(JSONB);
+
+CREATE TABLE shared.user_parental_controls (
+    user_id UUID PRIMARY KEY REFERENCES shared.users (id) ON DELETE CASCADE,
+    max_age INTEGER CHECK (max_age >= 0),
+    blocked_tags TEXT[] NOT NULL DEFAULT '{}',
+    unrated_policy VARCHAR(10) NOT NULL DEFAULT 'allow' CHECK (unrated_policy IN ('allow', 'block')),
+    pin_hash TEXT,
+    override_until TIMESTAMPTZ,
+    pin_failed_attempts INTEGER NOT NULL DEFAULT 0,
+    pin_locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE shared.user_parental_controls IS 'Per-user content restrictions enforced on list, search, continue watching and playback';
+COMMENT ON COLUMN shared.user_parental_controls.max_age IS 'Highest allowed minimum viewer age, NULL for no age limit';
+COMMENT ON COLUMN shared.user_parental_controls.blocked_tags IS 'Genre slugs that are never shown to the user';
+COMMENT ON COLUMN shared.user_parental_controls.unrated_policy IS 'Whether unrated content is allowed when an age limit is set';
+COMMENT ON COLUMN shared.user_parental_controls.pin_hash IS 'Argon2id hash of the override PIN';
+COMMENT ON COLUMN shared.user_parental_controls.override_until IS 'Restrictions are lifted until this time after the PIN was entered';
+COMMENT ON COLUMN shared.user_parental_controls.pin_failed_attempts IS 'Consecutive wrong override PINs since the last unlock or lockout';
+COMMENT ON COLUMN shared.user_parental_controls.pin_locked_until IS 'PIN entry is refused until this time after too many wrong PINs';
@@ -0,0 +1,9 @@
+ALTER TABLE tvshow.series DROP COLUMN IF EXISTS tags_imported_at;
+
+ALTER TABLE movie.movies DROP COLUMN IF EXISTS tags_imported_at;
+
+ALTER TABLE shared.user_preferences DROP COLUMN IF EXISTS hidden_tags;
+
+DROP TABLE IF EXISTS tvshow.series_tags;
+
+DROP TABLE IF EXISTS movie.movie_tags;
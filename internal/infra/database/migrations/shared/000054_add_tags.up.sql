@@ -0,0 +1,50 @@
+-- Migration 000054: Keywords and tags
+-- Movies and series store keywords (TMDb) and tags (AniList, AniDB) next to
+-- their genres. Spoiler tags are hidden unless a user opts in. Users can hide
+-- content carrying any of a list of tags from their listings.
+
+CREATE TABLE movie.movie_tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    movie_id UUID NOT NULL REFERENCES movie.movies (id) ON DELETE CASCADE,
+    slug TEXT NOT NULL,
+    name TEXT NOT NULL,
+    spoiler BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_movie_tag UNIQUE (movie_id, slug)
+);
+
+CREATE INDEX idx_movie_tags_slug ON movie.movie_tags (slug);
+
+CREATE TABLE tvshow.series_tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    series_id UUID NOT NULL REFERENCES tvshow.series (id) ON DELETE CASCADE,
+    slug TEXT NOT NULL,
+    name TEXT NOT NULL,
+    spoiler BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_series_tag UNIQUE (series_id, slug)
+);
+
+CREATE INDEX idx_series_tags_slug ON tvshow.series_tags (slug);
+
+COMMENT ON TABLE movie.movie_tags IS 'Keywords and tags of movies from metadata providers';
+COMMENT ON TABLE tvshow.series_tags IS 'Keywords and tags of series from metadata providers';
+COMMENT ON COLUMN movie.movie_tags.spoiler IS 'Tag reveals plot details';
+COMMENT ON COLUMN tvshow.series_tags.spoiler IS 'Tag reveals plot details';
+
+ALTER TABLE shared.user_preferences ADD COLUMN hidden_tags TEXT[];
+
+COMMENT ON COLUMN shared.user_preferences.hidden_tags IS 'Genre and tag slugs whose content is hidden from listings';
+
+-- Items added from now on import their tags on creation. Existing items keep
+-- a NULL import time until the tag backfill job has imported their tags.
+ALTER TABLE movie.movies ADD COLUMN tags_imported_at TIMESTAMPTZ;
+
+ALTER TABLE movie.movies ALTER COLUMN tags_imported_at SET DEFAULT NOW();
+
+ALTER TABLE tvshow.series ADD COLUMN tags_imported_at TIMESTAMPTZ;
+
+ALTER TABLE tvshow.series ALTER COLUMN tags_imported_at SET DEFAULT NOW();
+
+COMMENT ON COLUMN movie.movies.tags_imported_at IS 'When keywords and tags were first imported; NULL until backfilled';
+COMMENT ON COLUMN tvshow.series.tags_imported_at IS 'When keywords and tags were first imported; NULL until backfilled';
@@ -0,0 +1 @@
+ALTER TABLE shared.user_preferences DROP COLUMN IF EXISTS metadata_language_chain;
@@ -0,0 +1,3 @@
+ALTER TABLE shared.user_preferences ADD COLUMN metadata_language_chain TEXT[];
+
+COMMENT ON COLUMN shared.user_preferences.metadata_language_chain IS 'Ordered translation fallback chain for localized metadata, e.g. {de-AT,de,original,en}';
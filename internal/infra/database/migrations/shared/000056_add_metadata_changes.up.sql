@@ -0,0 +1,23 @@
+-- Migration 000056: Metadata change history
+-- Every metadata write to a movie, series, season or episode is recorded as
+-- a numbered version holding the changed fields with their old and new
+-- values, the source of the change and, for manual edits, the acting user.
+
+CREATE TABLE public.metadata_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    content_type VARCHAR(20) NOT NULL,
+    content_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    actor_id UUID REFERENCES shared.users (id) ON DELETE SET NULL,
+    changes JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_metadata_change_version UNIQUE (content_type, content_id, version)
+);
+
+CREATE INDEX idx_metadata_changes_created_at ON public.metadata_changes (created_at);
+
+COMMENT ON TABLE public.metadata_changes IS 'Versioned metadata changes of movies, series, seasons and episodes';
+COMMENT ON COLUMN public.metadata_changes.version IS 'Version of the item after the change, counting from 1';
+COMMENT ON COLUMN public.metadata_changes.source IS 'system, refresh, radarr, sonarr, manual or rollback';
+COMMENT ON COLUMN public.metadata_changes.changes IS 'Changed fields: {"title": {"old": "...", "new": "..."}}';
@@ -0,0 +1,19 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_tracks_updated_at ON music.tracks;
+DROP TRIGGER IF EXISTS update_releases_updated_at ON music.releases;
+DROP TRIGGER IF EXISTS update_release_groups_updated_at ON music.release_groups;
+DROP TRIGGER IF EXISTS update_artists_updated_at ON music.artists;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS music.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS music.track_artists;
+DROP TABLE IF EXISTS music.tracks;
+DROP TABLE IF EXISTS music.release_artists;
+DROP TABLE IF EXISTS music.releases;
+DROP TABLE IF EXISTS music.release_groups;
+DROP TABLE IF EXISTS music.artists;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS music;
@@ -0,0 +1,166 @@
+-- Create music schema
+-- Artists, release groups, releases (albums) and tracks read from embedded tags
+CREATE SCHEMA IF NOT EXISTS music;
+
+-- Artists table (track and album artists share one table)
+CREATE TABLE music.artists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- External IDs
+    musicbrainz_id UUID UNIQUE,
+
+    name TEXT NOT NULL,
+    sort_name TEXT NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Release groups table (all editions of an album)
+CREATE TABLE music.release_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- External IDs
+    musicbrainz_id UUID UNIQUE,
+
+    title TEXT NOT NULL,
+    artist_credit TEXT NOT NULL DEFAULT '',
+    primary_type TEXT, -- 'album', 'single', 'ep', 'broadcast', 'other'
+    first_release_year INTEGER,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Releases table (a concrete edition of an album)
+CREATE TABLE music.releases (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    release_group_id UUID NOT NULL REFERENCES music.release_groups(id) ON DELETE CASCADE,
+
+    -- External IDs
+    musicbrainz_id UUID UNIQUE,
+
+    title TEXT NOT NULL,
+    sort_title TEXT NOT NULL,
+    artist_credit TEXT NOT NULL DEFAULT '', -- album artist as credited
+    is_compilation BOOLEAN NOT NULL DEFAULT false,
+
+    -- Release metadata
+    year INTEGER,
+    release_date DATE,
+    label TEXT,
+    catalog_number TEXT,
+    country TEXT,
+    genres TEXT[] NOT NULL DEFAULT '{}',
+
+    -- Stats (denormalized from tracks)
+    disc_count INTEGER NOT NULL DEFAULT 1,
+    track_count INTEGER NOT NULL DEFAULT 0,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+
+    -- Album ReplayGain
+    replaygain_gain REAL,
+    replaygain_peak REAL,
+
+    -- Media
+    cover_path TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Album artists of a release
+CREATE TABLE music.release_artists (
+    release_id UUID NOT NULL REFERENCES music.releases(id) ON DELETE CASCADE,
+    artist_id UUID NOT NULL REFERENCES music.artists(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (release_id, artist_id)
+);
+
+-- Tracks table (one row per audio file)
+CREATE TABLE music.tracks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    release_id UUID NOT NULL REFERENCES music.releases(id) ON DELETE CASCADE,
+
+    -- External IDs
+    musicbrainz_recording_id UUID,
+    musicbrainz_track_id UUID,
+    isrc TEXT,
+
+    title TEXT NOT NULL,
+    artist_credit TEXT NOT NULL DEFAULT '',
+
+    -- Position on the release
+    disc_number INTEGER NOT NULL DEFAULT 1,
+    disc_subtitle TEXT,
+    track_number INTEGER,
+
+    duration_ms INTEGER,
+
+    -- Track ReplayGain
+    replaygain_gain REAL,
+    replaygain_peak REAL,
+
+    -- File
+    file_path TEXT NOT NULL UNIQUE,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    container TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Performing artists of a track
+CREATE TABLE music.track_artists (
+    track_id UUID NOT NULL REFERENCES music.tracks(id) ON DELETE CASCADE,
+    artist_id UUID NOT NULL REFERENCES music.artists(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (track_id, artist_id)
+);
+
+-- Indexes
+CREATE INDEX idx_artists_name ON music.artists(lower(name));
+CREATE INDEX idx_artists_sort_name ON music.artists(sort_name);
+
+CREATE INDEX idx_release_groups_title ON music.release_groups(lower(title), lower(artist_credit));
+
+CREATE INDEX idx_releases_release_group ON music.releases(release_group_id);
+CREATE INDEX idx_releases_title ON music.releases(lower(title), lower(artist_credit));
+CREATE INDEX idx_releases_sort_title ON music.releases(sort_title);
+CREATE INDEX idx_releases_created_at ON music.releases(created_at DESC);
+
+CREATE INDEX idx_release_artists_artist ON music.release_artists(artist_id);
+
+CREATE INDEX idx_tracks_release ON music.tracks(release_id, disc_number, track_number);
+CREATE INDEX idx_tracks_recording ON music.tracks(musicbrainz_recording_id) WHERE musicbrainz_recording_id IS NOT NULL;
+
+CREATE INDEX idx_track_artists_artist ON music.track_artists(artist_id);
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION music.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_artists_updated_at
+    BEFORE UPDATE ON music.artists
+    FOR EACH ROW EXECUTE FUNCTION music.update_updated_at_column();
+
+CREATE TRIGGER update_release_groups_updated_at
+    BEFORE UPDATE ON music.release_groups
+    FOR EACH ROW EXECUTE FUNCTION music.update_updated_at_column();
+
+CREATE TRIGGER update_releases_updated_at
+    BEFORE UPDATE ON music.releases
+    FOR EACH ROW EXECUTE FUNCTION music.update_updated_at_column();
+
+CREATE TRIGGER update_tracks_updated_at
+    BEFORE UPDATE ON music.tracks
+    FOR EACH ROW EXECUTE FUNCTION music.update_updated_at_column();
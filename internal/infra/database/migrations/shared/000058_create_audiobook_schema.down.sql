@@ -0,0 +1,20 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_book_progress_updated_at ON audiobook.book_progress;
+DROP TRIGGER IF EXISTS update_books_updated_at ON audiobook.books;
+DROP TRIGGER IF EXISTS update_series_updated_at ON audiobook.series;
+DROP TRIGGER IF EXISTS update_authors_updated_at ON audiobook.authors;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS audiobook.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS audiobook.book_progress;
+DROP TABLE IF EXISTS audiobook.chapters;
+DROP TABLE IF EXISTS audiobook.files;
+DROP TABLE IF EXISTS audiobook.book_authors;
+DROP TABLE IF EXISTS audiobook.books;
+DROP TABLE IF EXISTS audiobook.series;
+DROP TABLE IF EXISTS audiobook.authors;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS audiobook;
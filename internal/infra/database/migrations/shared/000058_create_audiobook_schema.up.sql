@@ -0,0 +1,181 @@
+-- Create audiobook schema
+-- Books are a single M4B/M4A or a folder of audio files; positions are
+-- stored on the book timeline so progress survives across files.
+CREATE SCHEMA IF NOT EXISTS audiobook;
+
+-- Authors table
+CREATE TABLE audiobook.authors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    name TEXT NOT NULL,
+    sort_name TEXT NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Series table
+CREATE TABLE audiobook.series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    name TEXT NOT NULL,
+    sort_name TEXT NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Books table
+CREATE TABLE audiobook.books (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Book folder, or the file itself for single-file books
+    path TEXT NOT NULL UNIQUE,
+
+    title TEXT NOT NULL,
+    sort_title TEXT NOT NULL,
+    subtitle TEXT,
+    author_credit TEXT NOT NULL DEFAULT '',
+    narrators TEXT[] NOT NULL DEFAULT '{}',
+
+    -- Series
+    series_id UUID REFERENCES audiobook.series(id) ON DELETE SET NULL,
+    series_index REAL, -- position in the series, may be fractional (2.5)
+
+    -- Book metadata
+    description TEXT,
+    publisher TEXT,
+    year INTEGER,
+    language TEXT,
+    genres TEXT[] NOT NULL DEFAULT '{}',
+    asin TEXT,
+
+    -- Stats (denormalized from files and chapters)
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    file_count INTEGER NOT NULL DEFAULT 0,
+    chapter_count INTEGER NOT NULL DEFAULT 0,
+    total_size BIGINT NOT NULL DEFAULT 0,
+
+    -- Media
+    cover_path TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Authors of a book
+CREATE TABLE audiobook.book_authors (
+    book_id UUID NOT NULL REFERENCES audiobook.books(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES audiobook.authors(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (book_id, author_id)
+);
+
+-- Files of a book in playback order
+CREATE TABLE audiobook.files (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    book_id UUID NOT NULL REFERENCES audiobook.books(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL,
+    file_path TEXT NOT NULL UNIQUE,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    container TEXT,
+
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    offset_ms BIGINT NOT NULL DEFAULT 0, -- start of the file on the book timeline
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_audiobook_file_position UNIQUE (book_id, position)
+);
+
+-- Chapters on the book timeline
+CREATE TABLE audiobook.chapters (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    book_id UUID NOT NULL REFERENCES audiobook.books(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    start_ms BIGINT NOT NULL,
+    end_ms BIGINT NOT NULL,
+
+    CONSTRAINT uq_audiobook_chapter_position UNIQUE (book_id, position)
+);
+
+-- Per-user listening position
+CREATE TABLE audiobook.book_progress (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Foreign keys
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+    book_id UUID NOT NULL REFERENCES audiobook.books(id) ON DELETE CASCADE,
+
+    -- Listening progress
+    position_ms BIGINT NOT NULL DEFAULT 0, -- position on the book timeline
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    playback_speed REAL NOT NULL DEFAULT 1.0,
+    progress_percent NUMERIC(5, 2) GENERATED ALWAYS AS (
+        CASE
+            WHEN duration_ms > 0
+            THEN LEAST(position_ms::NUMERIC / duration_ms * 100, 100)
+            ELSE 0
+        END
+    ) STORED,
+
+    -- Listening status
+    is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    listen_count INTEGER NOT NULL DEFAULT 0, -- Number of times finished
+    last_listened_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_audiobook_user_book_progress UNIQUE (user_id, book_id)
+);
+
+-- Indexes
+CREATE INDEX idx_audiobook_authors_name ON audiobook.authors(lower(name));
+CREATE INDEX idx_audiobook_authors_sort_name ON audiobook.authors(sort_name);
+
+CREATE INDEX idx_audiobook_series_name ON audiobook.series(lower(name));
+
+CREATE INDEX idx_audiobook_books_sort_title ON audiobook.books(sort_title);
+CREATE INDEX idx_audiobook_books_series ON audiobook.books(series_id, series_index) WHERE series_id IS NOT NULL;
+CREATE INDEX idx_audiobook_books_created_at ON audiobook.books(created_at DESC);
+
+CREATE INDEX idx_audiobook_book_authors_author ON audiobook.book_authors(author_id);
+
+CREATE INDEX idx_audiobook_progress_user_last_listened ON audiobook.book_progress(user_id, last_listened_at DESC);
+CREATE INDEX idx_audiobook_progress_in_progress ON audiobook.book_progress(user_id, last_listened_at DESC)
+    WHERE is_completed = FALSE AND position_ms > 0;
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION audiobook.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_authors_updated_at
+    BEFORE UPDATE ON audiobook.authors
+    FOR EACH ROW EXECUTE FUNCTION audiobook.update_updated_at_column();
+
+CREATE TRIGGER update_series_updated_at
+    BEFORE UPDATE ON audiobook.series
+    FOR EACH ROW EXECUTE FUNCTION audiobook.update_updated_at_column();
+
+CREATE TRIGGER update_books_updated_at
+    BEFORE UPDATE ON audiobook.books
+    FOR EACH ROW EXECUTE FUNCTION audiobook.update_updated_at_column();
+
+CREATE TRIGGER update_book_progress_updated_at
+    BEFORE UPDATE ON audiobook.book_progress
+    FOR EACH ROW EXECUTE FUNCTION audiobook.update_updated_at_column();
@@ -0,0 +1,18 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_reading_progress_updated_at ON book.reading_progress;
+DROP TRIGGER IF EXISTS update_books_updated_at ON book.books;
+DROP TRIGGER IF EXISTS update_series_updated_at ON book.series;
+DROP TRIGGER IF EXISTS update_authors_updated_at ON book.authors;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS book.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS book.reading_progress;
+DROP TABLE IF EXISTS book.book_authors;
+DROP TABLE IF EXISTS book.books;
+DROP TABLE IF EXISTS book.series;
+DROP TABLE IF EXISTS book.authors;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS book;
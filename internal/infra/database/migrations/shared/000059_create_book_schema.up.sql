@@ -0,0 +1,146 @@
+-- Create book schema
+-- E-books (EPUB, PDF) and comics (CBZ, CBR, PDF) are one file each; the
+-- library type decides whether a file is shelved as a book or a comic.
+CREATE SCHEMA IF NOT EXISTS book;
+
+-- Authors table (writers of books and comics)
+CREATE TABLE book.authors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    name TEXT NOT NULL,
+    sort_name TEXT NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Series table (book series and comic runs)
+CREATE TABLE book.series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    name TEXT NOT NULL,
+    sort_name TEXT NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Books table
+CREATE TABLE book.books (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    file_path TEXT NOT NULL UNIQUE,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    kind TEXT NOT NULL DEFAULT 'book', -- 'book' or 'comic'
+    format TEXT NOT NULL, -- 'epub', 'pdf', 'cbz', 'cbr'
+
+    title TEXT NOT NULL,
+    sort_title TEXT NOT NULL,
+    subtitle TEXT,
+    author_credit TEXT NOT NULL DEFAULT '',
+
+    -- Series
+    series_id UUID REFERENCES book.series(id) ON DELETE SET NULL,
+    series_index REAL, -- volume or issue number, may be fractional (2.5)
+
+    -- Book metadata
+    description TEXT,
+    publisher TEXT,
+    year INTEGER,
+    language TEXT,
+    isbn TEXT,
+    genres TEXT[] NOT NULL DEFAULT '{}',
+
+    -- Pages (PDFs and comics); pages holds the image entries of comic archives
+    page_count INTEGER NOT NULL DEFAULT 0,
+    pages TEXT[] NOT NULL DEFAULT '{}',
+    right_to_left BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- Media
+    cover_entry TEXT, -- archive entry of the cover image
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_book_kind CHECK (kind IN ('book', 'comic'))
+);
+
+-- Authors of a book
+CREATE TABLE book.book_authors (
+    book_id UUID NOT NULL REFERENCES book.books(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES book.authors(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (book_id, author_id)
+);
+
+-- Per-user reading position
+CREATE TABLE book.reading_progress (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Foreign keys
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+    book_id UUID NOT NULL REFERENCES book.books(id) ON DELETE CASCADE,
+
+    -- Reading position: a page for PDFs and comics, an EPUB CFI (or other
+    -- reader locator) for reflowable books
+    page INTEGER NOT NULL DEFAULT 0,
+    locator TEXT,
+    progress_percent REAL NOT NULL DEFAULT 0,
+
+    -- Reading status
+    is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    read_count INTEGER NOT NULL DEFAULT 0, -- Number of times finished
+    last_read_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_book_user_reading_progress UNIQUE (user_id, book_id)
+);
+
+-- Indexes
+CREATE INDEX idx_book_authors_name ON book.authors(lower(name));
+CREATE INDEX idx_book_authors_sort_name ON book.authors(sort_name);
+
+CREATE INDEX idx_book_series_name ON book.series(lower(name));
+
+CREATE INDEX idx_book_books_kind_sort_title ON book.books(kind, sort_title);
+CREATE INDEX idx_book_books_series ON book.books(series_id, series_index) WHERE series_id IS NOT NULL;
+CREATE INDEX idx_book_books_created_at ON book.books(created_at DESC);
+CREATE INDEX idx_book_books_isbn ON book.books(isbn) WHERE isbn IS NOT NULL;
+
+CREATE INDEX idx_book_book_authors_author ON book.book_authors(author_id);
+
+CREATE INDEX idx_book_progress_user_last_read ON book.reading_progress(user_id, last_read_at DESC);
+CREATE INDEX idx_book_progress_in_progress ON book.reading_progress(user_id, last_read_at DESC)
+    WHERE is_completed = FALSE;
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION book.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_authors_updated_at
+    BEFORE UPDATE ON book.authors
+    FOR EACH ROW EXECUTE FUNCTION book.update_updated_at_column();
+
+CREATE TRIGGER update_series_updated_at
+    BEFORE UPDATE ON book.series
+    FOR EACH ROW EXECUTE FUNCTION book.update_updated_at_column();
+
+CREATE TRIGGER update_books_updated_at
+    BEFORE UPDATE ON book.books
+    FOR EACH ROW EXECUTE FUNCTION book.update_updated_at_column();
+
+CREATE TRIGGER update_reading_progress_updated_at
+    BEFORE UPDATE ON book.reading_progress
+    FOR EACH ROW EXECUTE FUNCTION book.update_updated_at_column();
@@ -0,0 +1,16 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_episode_progress_updated_at ON podcast.episode_progress;
+DROP TRIGGER IF EXISTS update_episodes_updated_at ON podcast.episodes;
+DROP TRIGGER IF EXISTS update_podcasts_updated_at ON podcast.podcasts;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS podcast.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS podcast.episode_progress;
+DROP TABLE IF EXISTS podcast.subscriptions;
+DROP TABLE IF EXISTS podcast.episodes;
+DROP TABLE IF EXISTS podcast.podcasts;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS podcast;
@@ -0,0 +1,156 @@
+-- Create podcast schema
+-- Podcasts are RSS or Atom feeds that users subscribe to. Episodes are
+-- downloaded into the podcast library per the show's retention rules;
+-- listening state is tracked per user.
+CREATE SCHEMA IF NOT EXISTS podcast;
+
+-- Podcasts table (one row per feed, shared by all subscribers)
+CREATE TABLE podcast.podcasts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Library the episodes are downloaded into
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    feed_url TEXT NOT NULL UNIQUE,
+
+    -- Feed metadata
+    title TEXT NOT NULL,
+    sort_title TEXT NOT NULL,
+    author TEXT,
+    description TEXT,
+    link TEXT,
+    image_url TEXT,
+    language TEXT,
+    explicit BOOLEAN NOT NULL DEFAULT FALSE,
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    podcast_guid TEXT, -- Podcasting 2.0 podcast:guid
+
+    -- Conditional fetch state
+    etag TEXT,
+    last_modified TEXT,
+    last_checked_at TIMESTAMPTZ,
+    last_error TEXT,
+
+    -- Retention: keep the newest keep_episodes episodes downloaded.
+    -- NULL uses the server default, 0 keeps every episode.
+    auto_download BOOLEAN NOT NULL DEFAULT TRUE,
+    keep_episodes INTEGER,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_podcast_keep_episodes CHECK (keep_episodes IS NULL OR keep_episodes >= 0)
+);
+
+-- Episodes table
+CREATE TABLE podcast.episodes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    podcast_id UUID NOT NULL REFERENCES podcast.podcasts(id) ON DELETE CASCADE,
+
+    guid TEXT NOT NULL,
+
+    -- Episode metadata
+    title TEXT NOT NULL,
+    description TEXT,
+    link TEXT,
+    image_url TEXT,
+    published_at TIMESTAMPTZ,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    season INTEGER,
+    episode_number INTEGER,
+    episode_type TEXT NOT NULL DEFAULT 'full', -- 'full', 'trailer', 'bonus'
+    explicit BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- Media file in the feed
+    enclosure_url TEXT NOT NULL,
+    enclosure_type TEXT,
+    enclosure_length BIGINT NOT NULL DEFAULT 0,
+
+    -- Podcasting 2.0 chapters and transcripts
+    chapters_url TEXT,
+    chapters JSONB, -- [{start, title, url, imageUrl}]
+    transcripts JSONB NOT NULL DEFAULT '[]', -- [{url, type, language, rel}]
+    transcript_path TEXT,
+    transcript_type TEXT,
+
+    -- Download state
+    file_path TEXT,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    downloaded_at TIMESTAMPTZ,
+    download_error TEXT,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE, -- downloaded on request, exempt from retention
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_podcast_episode_guid UNIQUE (podcast_id, guid)
+);
+
+-- Subscriptions table
+CREATE TABLE podcast.subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+    podcast_id UUID NOT NULL REFERENCES podcast.podcasts(id) ON DELETE CASCADE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_podcast_subscription UNIQUE (user_id, podcast_id)
+);
+
+-- Per-user listening state
+CREATE TABLE podcast.episode_progress (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Foreign keys
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+    episode_id UUID NOT NULL REFERENCES podcast.episodes(id) ON DELETE CASCADE,
+
+    -- Listening position
+    position_seconds INTEGER NOT NULL DEFAULT 0,
+
+    -- Listening status
+    is_played BOOLEAN NOT NULL DEFAULT FALSE,
+    played_at TIMESTAMPTZ,
+    last_played_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_podcast_user_episode_progress UNIQUE (user_id, episode_id)
+);
+
+-- Indexes
+CREATE INDEX idx_podcast_podcasts_library ON podcast.podcasts(library_id);
+CREATE INDEX idx_podcast_podcasts_sort_title ON podcast.podcasts(sort_title);
+
+CREATE INDEX idx_podcast_episodes_podcast_published ON podcast.episodes(podcast_id, published_at DESC NULLS LAST);
+CREATE INDEX idx_podcast_episodes_published ON podcast.episodes(published_at DESC NULLS LAST);
+CREATE INDEX idx_podcast_episodes_downloaded ON podcast.episodes(podcast_id) WHERE file_path IS NOT NULL;
+
+CREATE INDEX idx_podcast_subscriptions_podcast ON podcast.subscriptions(podcast_id);
+
+CREATE INDEX idx_podcast_progress_user_last_played ON podcast.episode_progress(user_id, last_played_at DESC);
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION podcast.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_podcasts_updated_at
+    BEFORE UPDATE ON podcast.podcasts
+    FOR EACH ROW EXECUTE FUNCTION podcast.update_updated_at_column();
+
+CREATE TRIGGER update_episodes_updated_at
+    BEFORE UPDATE ON podcast.episodes
+    FOR EACH ROW EXECUTE FUNCTION podcast.update_updated_at_column();
+
+CREATE TRIGGER update_episode_progress_updated_at
+    BEFORE UPDATE ON podcast.episode_progress
+    FOR EACH ROW EXECUTE FUNCTION podcast.update_updated_at_column();
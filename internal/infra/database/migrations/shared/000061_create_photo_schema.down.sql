@@ -0,0 +1,15 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_albums_updated_at ON photo.albums;
+DROP TRIGGER IF EXISTS update_photos_updated_at ON photo.photos;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS photo.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS photo.album_shares;
+DROP TABLE IF EXISTS photo.album_photos;
+DROP TABLE IF EXISTS photo.albums;
+DROP TABLE IF EXISTS photo.photos;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS photo;
@@ -0,0 +1,138 @@
+-- Create photo schema
+-- Photos and short camera clips are scanned from photo libraries. EXIF
+-- capture metadata drives the timeline; albums are either derived from the
+-- library's folders or created and shared by users.
+CREATE SCHEMA IF NOT EXISTS photo;
+
+-- Photos table (images and video clips)
+CREATE TABLE photo.photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    -- File
+    file_path TEXT NOT NULL UNIQUE,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    folder TEXT NOT NULL, -- Directory of the file
+    kind TEXT NOT NULL DEFAULT 'image', -- 'image', 'video'
+    format TEXT NOT NULL, -- 'jpeg', 'heif', 'png', 'mp4', 'mov', ...
+
+    -- Capture time: EXIF or container creation time, else a date in the
+    -- file name, else the file's modification time
+    taken_at TIMESTAMPTZ NOT NULL,
+    taken_at_source TEXT NOT NULL DEFAULT 'exif', -- 'exif', 'filename', 'file'
+
+    -- Dimensions as displayed, after applying the orientation
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    orientation INTEGER NOT NULL DEFAULT 1, -- EXIF orientation 1-8
+
+    -- Camera
+    camera_make TEXT,
+    camera_model TEXT,
+    lens_model TEXT,
+    exposure_time TEXT, -- e.g. '1/250'
+    f_number DOUBLE PRECISION,
+    iso INTEGER,
+    focal_length DOUBLE PRECISION, -- millimeters
+
+    -- Location
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    altitude DOUBLE PRECISION, -- meters above sea level
+
+    -- Video clips
+    duration_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    -- Placeholder shown while the thumbnail loads
+    blurhash TEXT,
+    dominant_color TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_photo_kind CHECK (kind IN ('image', 'video')),
+    CONSTRAINT chk_photo_orientation CHECK (orientation BETWEEN 1 AND 8)
+);
+
+-- Albums table. Folder albums mirror a library directory and are
+-- maintained by the scan; user albums belong to their owner.
+CREATE TABLE photo.albums (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    kind TEXT NOT NULL, -- 'folder', 'user'
+    title TEXT NOT NULL,
+    description TEXT,
+
+    folder_path TEXT, -- Folder albums
+    owner_id UUID REFERENCES shared.users(id) ON DELETE CASCADE, -- User albums
+
+    -- Explicit cover; defaults to the first photo
+    cover_photo_id UUID REFERENCES photo.photos(id) ON DELETE SET NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_photo_album_kind CHECK (
+        (kind = 'folder' AND folder_path IS NOT NULL AND owner_id IS NULL)
+        OR (kind = 'user' AND folder_path IS NULL AND owner_id IS NOT NULL)
+    )
+);
+
+-- Album membership. Positions order user albums; folder albums keep
+-- position 0 and are ordered by capture time.
+CREATE TABLE photo.album_photos (
+    album_id UUID NOT NULL REFERENCES photo.albums(id) ON DELETE CASCADE,
+    photo_id UUID NOT NULL REFERENCES photo.photos(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL DEFAULT 0,
+    added_by UUID REFERENCES shared.users(id) ON DELETE SET NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (album_id, photo_id)
+);
+
+-- Users a user album is shared with. Contributors may add and remove
+-- photos; only the owner edits, shares or deletes the album.
+CREATE TABLE photo.album_shares (
+    album_id UUID NOT NULL REFERENCES photo.albums(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+
+    can_contribute BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (album_id, user_id)
+);
+
+-- Indexes
+CREATE INDEX idx_photo_photos_library_taken ON photo.photos(library_id, taken_at DESC);
+CREATE INDEX idx_photo_photos_taken ON photo.photos(taken_at DESC);
+CREATE INDEX idx_photo_photos_folder ON photo.photos(library_id, folder);
+
+CREATE UNIQUE INDEX uq_photo_albums_folder ON photo.albums(library_id, folder_path) WHERE folder_path IS NOT NULL;
+CREATE INDEX idx_photo_albums_owner ON photo.albums(owner_id) WHERE owner_id IS NOT NULL;
+
+CREATE INDEX idx_photo_album_photos_photo ON photo.album_photos(photo_id);
+
+CREATE INDEX idx_photo_album_shares_user ON photo.album_shares(user_id);
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION photo.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_photos_updated_at
+    BEFORE UPDATE ON photo.photos
+    FOR EACH ROW EXECUTE FUNCTION photo.update_updated_at_column();
+
+CREATE TRIGGER update_albums_updated_at
+    BEFORE UPDATE ON photo.albums
+    FOR EACH ROW EXECUTE FUNCTION photo.update_updated_at_column();
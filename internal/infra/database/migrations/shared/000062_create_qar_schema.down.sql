@@ -0,0 +1,16 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_user_pins_updated_at ON qar.user_pins;
+DROP TRIGGER IF EXISTS update_scenes_updated_at ON qar.scenes;
+DROP TRIGGER IF EXISTS update_performers_updated_at ON qar.performers;
+DROP TRIGGER IF EXISTS update_studios_updated_at ON qar.studios;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS qar.update_updated_at_column();
+
+-- Drop tables in reverse dependency order. The qar schema itself belongs
+-- to 000001_create_schemas.
+DROP TABLE IF EXISTS qar.user_pins;
+DROP TABLE IF EXISTS qar.scene_performers;
+DROP TABLE IF EXISTS qar.scenes;
+DROP TABLE IF EXISTS qar.performers;
+DROP TABLE IF EXISTS qar.studios;
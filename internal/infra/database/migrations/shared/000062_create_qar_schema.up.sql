@@ -0,0 +1,141 @@
+-- Create QAR content tables
+-- Scenes are scanned from adult libraries and matched against a
+-- StashDB-compatible metadata server. Text that identifies content or
+-- people (titles, names, file paths, image URLs) is stored encrypted with
+-- the legacy encryption key; file paths are looked up through a keyed hash.
+-- The qar schema itself is created by 000001_create_schemas.
+
+-- Studios table
+CREATE TABLE qar.studios (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    stashdb_id TEXT UNIQUE,
+    parent_id UUID REFERENCES qar.studios(id) ON DELETE SET NULL,
+
+    -- Encrypted
+    name_enc BYTEA NOT NULL,
+    image_url_enc BYTEA,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Performers table
+CREATE TABLE qar.performers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    stashdb_id TEXT UNIQUE,
+    gender TEXT,
+    country TEXT, -- ISO 3166-1 alpha-2
+
+    -- Encrypted
+    name_enc BYTEA NOT NULL,
+    disambiguation_enc BYTEA,
+    aliases_enc BYTEA, -- JSON array of strings
+    image_url_enc BYTEA,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Scenes table. Every scene is one file; unmatched scenes are titled after
+-- their file name.
+CREATE TABLE qar.scenes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    -- File
+    file_path_hash TEXT NOT NULL UNIQUE, -- HMAC-SHA256 of the path
+    file_size BIGINT NOT NULL DEFAULT 0,
+    oshash TEXT NOT NULL, -- OpenSubtitles hash, used for fingerprint matching
+
+    -- Metadata
+    stashdb_id TEXT,
+    studio_id UUID REFERENCES qar.studios(id) ON DELETE SET NULL,
+    release_date DATE,
+    duration_seconds INTEGER,
+
+    -- Match state
+    match_status TEXT NOT NULL DEFAULT 'unmatched', -- 'unmatched', 'matched'
+    match_type TEXT, -- 'fingerprint', 'title'
+    match_confidence DOUBLE PRECISION,
+    matched_at TIMESTAMPTZ,
+
+    -- Encrypted
+    file_path_enc BYTEA NOT NULL,
+    title_enc BYTEA NOT NULL,
+    details_enc BYTEA,
+    image_url_enc BYTEA,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_qar_scene_match_status CHECK (match_status IN ('unmatched', 'matched')),
+    CONSTRAINT chk_qar_scene_match_type CHECK (match_type IS NULL OR match_type IN ('fingerprint', 'title'))
+);
+
+-- Performers appearing in a scene
+CREATE TABLE qar.scene_performers (
+    scene_id UUID NOT NULL REFERENCES qar.scenes(id) ON DELETE CASCADE,
+    performer_id UUID NOT NULL REFERENCES qar.performers(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (scene_id, performer_id)
+);
+
+-- Per-user PIN guarding QAR content. Entering the PIN unlocks the content
+-- until unlocked_until.
+CREATE TABLE qar.user_pins (
+    user_id UUID PRIMARY KEY REFERENCES shared.users(id) ON DELETE CASCADE,
+
+    pin_hash TEXT NOT NULL, -- Argon2id
+    unlocked_until TIMESTAMPTZ,
+
+    -- Wrong PINs in a row; reaching the limit refuses PIN entry until
+    -- pin_locked_until
+    pin_failed_attempts INTEGER NOT NULL DEFAULT 0,
+    pin_locked_until TIMESTAMPTZ,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Indexes
+CREATE INDEX idx_qar_scenes_library ON qar.scenes(library_id, created_at DESC);
+CREATE INDEX idx_qar_scenes_release ON qar.scenes(release_date DESC NULLS LAST);
+CREATE INDEX idx_qar_scenes_studio ON qar.scenes(studio_id) WHERE studio_id IS NOT NULL;
+CREATE INDEX idx_qar_scenes_stashdb ON qar.scenes(stashdb_id) WHERE stashdb_id IS NOT NULL;
+CREATE INDEX idx_qar_scenes_unmatched ON qar.scenes(library_id) WHERE match_status = 'unmatched';
+
+CREATE INDEX idx_qar_scene_performers_performer ON qar.scene_performers(performer_id);
+
+CREATE INDEX idx_qar_studios_parent ON qar.studios(parent_id) WHERE parent_id IS NOT NULL;
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION qar.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_studios_updated_at
+    BEFORE UPDATE ON qar.studios
+    FOR EACH ROW EXECUTE FUNCTION qar.update_updated_at_column();
+
+CREATE TRIGGER update_performers_updated_at
+    BEFORE UPDATE ON qar.performers
+    FOR EACH ROW EXECUTE FUNCTION qar.update_updated_at_column();
+
+CREATE TRIGGER update_scenes_updated_at
+    BEFORE UPDATE ON qar.scenes
+    FOR EACH ROW EXECUTE FUNCTION qar.update_updated_at_column();
+
+CREATE TRIGGER update_user_pins_updated_at
+    BEFORE UPDATE ON qar.user_pins
+    FOR EACH ROW EXECUTE FUNCTION qar.update_updated_at_column();
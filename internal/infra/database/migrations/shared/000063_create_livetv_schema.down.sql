@@ -0,0 +1,19 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_recordings_updated_at ON livetv.recordings;
+DROP TRIGGER IF EXISTS update_series_rules_updated_at ON livetv.series_rules;
+DROP TRIGGER IF EXISTS update_programs_updated_at ON livetv.programs;
+DROP TRIGGER IF EXISTS update_channels_updated_at ON livetv.channels;
+DROP TRIGGER IF EXISTS update_sources_updated_at ON livetv.sources;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS livetv.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS livetv.recordings;
+DROP TABLE IF EXISTS livetv.series_rules;
+DROP TABLE IF EXISTS livetv.programs;
+DROP TABLE IF EXISTS livetv.channels;
+DROP TABLE IF EXISTS livetv.sources;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS livetv;
@@ -0,0 +1,183 @@
+-- Create livetv schema
+-- Live TV sources are M3U channel lists of network tuners or IPTV
+-- providers and XMLTV program guides, read from local paths or URLs.
+-- Programs are matched to channels by their XMLTV channel id (tvg-id).
+-- Recordings capture a channel's stream into a TV library.
+CREATE SCHEMA IF NOT EXISTS livetv;
+
+-- Sources table
+CREATE TABLE livetv.sources (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL, -- 'm3u' (channels), 'xmltv' (guide)
+    location TEXT NOT NULL, -- local path or http(s) URL
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Refresh state
+    last_refreshed_at TIMESTAMPTZ,
+    last_error TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_livetv_source_kind CHECK (kind IN ('m3u', 'xmltv')),
+    CONSTRAINT uq_livetv_source_location UNIQUE (kind, location)
+);
+
+-- Channels table (one row per playlist entry of an M3U source)
+CREATE TABLE livetv.channels (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_id UUID NOT NULL REFERENCES livetv.sources(id) ON DELETE CASCADE,
+
+    stream_url TEXT NOT NULL,
+    name TEXT NOT NULL,
+    number TEXT, -- tuner channel number, such as '5.1'
+    guide_id TEXT, -- XMLTV channel id (tvg-id)
+    logo_url TEXT,
+    group_title TEXT,
+    position INTEGER NOT NULL DEFAULT 0, -- order in the playlist
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_livetv_channel_stream UNIQUE (source_id, stream_url)
+);
+
+-- Programs table (guide entries of an XMLTV source)
+CREATE TABLE livetv.programs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_id UUID NOT NULL REFERENCES livetv.sources(id) ON DELETE CASCADE,
+
+    guide_channel_id TEXT NOT NULL, -- XMLTV channel id
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+
+    title TEXT NOT NULL,
+    subtitle TEXT, -- episode title
+    description TEXT,
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    season_number INTEGER,
+    episode_number INTEGER,
+    episode_label TEXT, -- on-screen episode number
+    icon_url TEXT,
+    air_date DATE, -- original air date
+    is_new BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- Refresh that last saw the program; older rows are removed
+    refreshed_at TIMESTAMPTZ NOT NULL,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_livetv_program_slot UNIQUE (source_id, guide_channel_id, starts_at),
+    CONSTRAINT chk_livetv_program_times CHECK (ends_at > starts_at)
+);
+
+-- Series rules: record every airing of a title
+CREATE TABLE livetv.series_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+
+    -- TV library the recordings are imported into
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    title TEXT NOT NULL, -- matched case-insensitively
+    channel_id UUID REFERENCES livetv.channels(id) ON DELETE CASCADE, -- NULL = any channel
+    new_only BOOLEAN NOT NULL DEFAULT FALSE,
+    padding_before_seconds INTEGER NOT NULL DEFAULT 0,
+    padding_after_seconds INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_livetv_rule_padding CHECK (padding_before_seconds >= 0 AND padding_after_seconds >= 0)
+);
+
+-- Recordings table
+CREATE TABLE livetv.recordings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    channel_id UUID REFERENCES livetv.channels(id) ON DELETE SET NULL,
+    program_id UUID REFERENCES livetv.programs(id) ON DELETE SET NULL,
+    series_rule_id UUID REFERENCES livetv.series_rules(id) ON DELETE SET NULL,
+
+    -- Copied from the program, which is pruned once it aired
+    channel_name TEXT NOT NULL,
+    title TEXT NOT NULL,
+    subtitle TEXT,
+    description TEXT,
+    season_number INTEGER,
+    episode_number INTEGER,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    padding_before_seconds INTEGER NOT NULL DEFAULT 0,
+    padding_after_seconds INTEGER NOT NULL DEFAULT 0,
+
+    -- 'scheduled', 'recording', 'completed', 'failed', 'cancelled'
+    status TEXT NOT NULL DEFAULT 'scheduled',
+    file_path TEXT,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    error TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_livetv_recording_status CHECK (status IN ('scheduled', 'recording', 'completed', 'failed', 'cancelled')),
+    CONSTRAINT chk_livetv_recording_times CHECK (ends_at > starts_at),
+    CONSTRAINT chk_livetv_recording_padding CHECK (padding_before_seconds >= 0 AND padding_after_seconds >= 0)
+);
+
+-- Indexes
+CREATE INDEX idx_livetv_channels_source ON livetv.channels(source_id, position);
+CREATE INDEX idx_livetv_channels_guide_id ON livetv.channels(guide_id) WHERE guide_id IS NOT NULL;
+
+CREATE INDEX idx_livetv_programs_channel_time ON livetv.programs(guide_channel_id, starts_at);
+CREATE INDEX idx_livetv_programs_ends_at ON livetv.programs(ends_at);
+CREATE INDEX idx_livetv_programs_title ON livetv.programs(lower(title));
+
+CREATE INDEX idx_livetv_series_rules_user ON livetv.series_rules(user_id);
+
+CREATE INDEX idx_livetv_recordings_user_starts ON livetv.recordings(user_id, starts_at DESC);
+CREATE INDEX idx_livetv_recordings_scheduled ON livetv.recordings(starts_at) WHERE status = 'scheduled';
+-- A program is recorded once
+CREATE UNIQUE INDEX uq_livetv_recordings_program ON livetv.recordings(program_id)
+    WHERE program_id IS NOT NULL AND status <> 'cancelled';
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION livetv.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_sources_updated_at
+    BEFORE UPDATE ON livetv.sources
+    FOR EACH ROW EXECUTE FUNCTION livetv.update_updated_at_column();
+
+CREATE TRIGGER update_channels_updated_at
+    BEFORE UPDATE ON livetv.channels
+    FOR EACH ROW EXECUTE FUNCTION livetv.update_updated_at_column();
+
+CREATE TRIGGER update_programs_updated_at
+    BEFORE UPDATE ON livetv.programs
+    FOR EACH ROW EXECUTE FUNCTION livetv.update_updated_at_column();
+
+CREATE TRIGGER update_series_rules_updated_at
+    BEFORE UPDATE ON livetv.series_rules
+    FOR EACH ROW EXECUTE FUNCTION livetv.update_updated_at_column();
+
+CREATE TRIGGER update_recordings_updated_at
+    BEFORE UPDATE ON livetv.recordings
+    FOR EACH ROW EXECUTE FUNCTION livetv.update_updated_at_column();
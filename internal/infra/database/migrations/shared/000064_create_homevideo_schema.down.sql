@@ -0,0 +1,15 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_watch_progress_updated_at ON homevideo.watch_progress;
+DROP TRIGGER IF EXISTS update_videos_updated_at ON homevideo.videos;
+DROP TRIGGER IF EXISTS update_folders_updated_at ON homevideo.folders;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS homevideo.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS homevideo.watch_progress;
+DROP TABLE IF EXISTS homevideo.videos;
+DROP TABLE IF EXISTS homevideo.folders;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS homevideo;
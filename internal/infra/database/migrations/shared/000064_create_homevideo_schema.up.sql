@@ -0,0 +1,111 @@
+-- Create homevideo schema
+-- Home videos are personal recordings that no metadata provider knows.
+-- The library scan mirrors the directory tree as folders and titles each
+-- video from its container tags or file name.
+CREATE SCHEMA IF NOT EXISTS homevideo;
+
+-- Folders table. Every library path is a root folder; subdirectories
+-- holding videos hang below it.
+CREATE TABLE homevideo.folders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES homevideo.folders(id) ON DELETE CASCADE, -- NULL for library roots
+
+    path TEXT NOT NULL, -- Absolute directory path
+    title TEXT NOT NULL, -- Directory name
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Videos table
+CREATE TABLE homevideo.videos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+    folder_id UUID NOT NULL REFERENCES homevideo.folders(id) ON DELETE CASCADE,
+
+    -- File
+    file_path TEXT NOT NULL UNIQUE,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    container TEXT,
+
+    -- Title: the container's title tag, else the cleaned file name
+    title TEXT NOT NULL,
+    title_source TEXT NOT NULL DEFAULT 'filename', -- 'tag', 'filename'
+    sort_title TEXT NOT NULL,
+    description TEXT, -- Container comment or description tag
+
+    -- Recording time: container creation time, else a date in the file
+    -- name, else the file's modification time
+    recorded_at TIMESTAMPTZ NOT NULL,
+    recorded_at_source TEXT NOT NULL DEFAULT 'tag', -- 'tag', 'filename', 'file'
+
+    -- Streams
+    duration_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    video_codec TEXT,
+    audio_codec TEXT,
+
+    -- Frame grabbed from the video, in file storage
+    thumbnail_key TEXT,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_homevideo_title_source CHECK (title_source IN ('tag', 'filename')),
+    CONSTRAINT chk_homevideo_recorded_at_source CHECK (recorded_at_source IN ('tag', 'filename', 'file'))
+);
+
+-- Per-user watch progress
+CREATE TABLE homevideo.watch_progress (
+    user_id UUID NOT NULL REFERENCES shared.users(id) ON DELETE CASCADE,
+    video_id UUID NOT NULL REFERENCES homevideo.videos(id) ON DELETE CASCADE,
+
+    progress_seconds INTEGER NOT NULL DEFAULT 0,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    watch_count INTEGER NOT NULL DEFAULT 0,
+    last_watched_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, video_id)
+);
+
+-- Indexes
+CREATE UNIQUE INDEX uq_homevideo_folders_path ON homevideo.folders(library_id, path);
+CREATE INDEX idx_homevideo_folders_parent ON homevideo.folders(parent_id);
+
+CREATE INDEX idx_homevideo_videos_folder ON homevideo.videos(folder_id, sort_title);
+CREATE INDEX idx_homevideo_videos_library_recorded ON homevideo.videos(library_id, recorded_at DESC);
+CREATE INDEX idx_homevideo_videos_created ON homevideo.videos(created_at DESC);
+
+CREATE INDEX idx_homevideo_progress_user_watched ON homevideo.watch_progress(user_id, last_watched_at DESC);
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION homevideo.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_folders_updated_at
+    BEFORE UPDATE ON homevideo.folders
+    FOR EACH ROW EXECUTE FUNCTION homevideo.update_updated_at_column();
+
+CREATE TRIGGER update_videos_updated_at
+    BEFORE UPDATE ON homevideo.videos
+    FOR EACH ROW EXECUTE FUNCTION homevideo.update_updated_at_column();
+
+CREATE TRIGGER update_watch_progress_updated_at
+    BEFORE UPDATE ON homevideo.watch_progress
+    FOR EACH ROW EXECUTE FUNCTION homevideo.update_updated_at_column();
@@ -0,0 +1,13 @@
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_videos_updated_at ON musicvideo.videos;
+DROP TRIGGER IF EXISTS update_artists_updated_at ON musicvideo.artists;
+
+-- Drop trigger function
+DROP FUNCTION IF EXISTS musicvideo.update_updated_at_column();
+
+-- Drop tables in reverse dependency order
+DROP TABLE IF EXISTS musicvideo.videos;
+DROP TABLE IF EXISTS musicvideo.artists;
+
+-- Drop schema
+DROP SCHEMA IF EXISTS musicvideo;
@@ -0,0 +1,93 @@
+-- Create musicvideo schema
+-- Music videos are grouped by artist. The library scan reads artist and
+-- title from the container tags or an "Artist - Title" file name; a match
+-- job then looks the recording up on MusicBrainz.
+CREATE SCHEMA IF NOT EXISTS musicvideo;
+
+-- Artists table. Artists belong to a library and are keyed by their
+-- normalized name until MusicBrainz identifies them.
+CREATE TABLE musicvideo.artists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+
+    name TEXT NOT NULL,
+    name_key TEXT NOT NULL, -- Lowercased name without punctuation
+    sort_name TEXT NOT NULL,
+    disambiguation TEXT, -- MusicBrainz comment, e.g. "US rapper"
+
+    musicbrainz_artist_id UUID,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Videos table
+CREATE TABLE musicvideo.videos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    library_id UUID NOT NULL REFERENCES public.libraries(id) ON DELETE CASCADE,
+    artist_id UUID NOT NULL REFERENCES musicvideo.artists(id) ON DELETE CASCADE,
+
+    -- File
+    file_path TEXT NOT NULL UNIQUE,
+    file_size BIGINT NOT NULL DEFAULT 0,
+    container TEXT,
+
+    -- Title and credited artists: the container tags, else the file
+    -- name, replaced by MusicBrainz once matched
+    title TEXT NOT NULL,
+    title_source TEXT NOT NULL DEFAULT 'filename', -- 'tag', 'filename', 'musicbrainz'
+    sort_title TEXT NOT NULL,
+    artist_credit TEXT NOT NULL, -- e.g. "Rihanna feat. JAY-Z"
+    year INTEGER,
+
+    -- Streams
+    duration_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    video_codec TEXT,
+    audio_codec TEXT,
+
+    -- MusicBrainz match
+    musicbrainz_recording_id UUID,
+    match_status TEXT NOT NULL DEFAULT 'pending', -- 'pending', 'matched', 'unmatched'
+    matched_at TIMESTAMPTZ,
+
+    -- Timestamps
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_musicvideo_title_source CHECK (title_source IN ('tag', 'filename', 'musicbrainz')),
+    CONSTRAINT chk_musicvideo_match_status CHECK (match_status IN ('pending', 'matched', 'unmatched'))
+);
+
+-- Indexes
+CREATE UNIQUE INDEX uq_musicvideo_artists_name ON musicvideo.artists(library_id, name_key);
+CREATE UNIQUE INDEX uq_musicvideo_artists_mbid ON musicvideo.artists(library_id, musicbrainz_artist_id)
+    WHERE musicbrainz_artist_id IS NOT NULL;
+CREATE INDEX idx_musicvideo_artists_sort ON musicvideo.artists(library_id, LOWER(sort_name));
+
+CREATE INDEX idx_musicvideo_videos_artist ON musicvideo.videos(artist_id, sort_title);
+CREATE INDEX idx_musicvideo_videos_library ON musicvideo.videos(library_id, sort_title);
+CREATE INDEX idx_musicvideo_videos_created ON musicvideo.videos(created_at DESC);
+CREATE INDEX idx_musicvideo_videos_pending ON musicvideo.videos(created_at)
+    WHERE match_status = 'pending';
+
+-- Trigger for updated_at timestamps
+CREATE OR REPLACE FUNCTION musicvideo.update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_artists_updated_at
+    BEFORE UPDATE ON musicvideo.artists
+    FOR EACH ROW EXECUTE FUNCTION musicvideo.update_updated_at_column();
+
+CREATE TRIGGER update_videos_updated_at
+    BEFORE UPDATE ON musicvideo.videos
+    FOR EACH ROW EXECUTE FUNCTION musicvideo.update_updated_at_column();
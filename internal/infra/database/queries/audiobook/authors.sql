@@ -0,0 +1,131 @@
+-- name: GetAuthor :one
+SELECT * FROM audiobook.authors WHERE id = $1;
+
+-- name: GetAuthorByName :one
+SELECT *
+FROM audiobook.authors
+WHERE
+    lower(name) = lower(@name)
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateAuthor :one
+INSERT INTO
+    audiobook.authors (name, sort_name)
+VALUES ($1, $2) RETURNING *;
+
+-- name: ListAuthors :many
+-- restrict limits the result to authors of a book under library_paths.
+SELECT a.*
+FROM audiobook.authors a
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.book_authors ba
+                JOIN audiobook.books b ON b.id = ba.book_id
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE ba.author_id = a.id AND starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY a.sort_name ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountAuthors :one
+SELECT COUNT(*)
+FROM audiobook.authors a
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.book_authors ba
+                JOIN audiobook.books b ON b.id = ba.book_id
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE ba.author_id = a.id AND starts_with(b.path, lp.path)
+        )
+    );
+
+-- name: ListBookAuthors :many
+SELECT a.*
+FROM audiobook.authors a
+    JOIN audiobook.book_authors ba ON ba.author_id = a.id
+WHERE
+    ba.book_id = $1
+ORDER BY ba.position ASC;
+
+-- name: DeleteBookAuthors :exec
+DELETE FROM audiobook.book_authors WHERE book_id = $1;
+
+-- name: AddBookAuthor :exec
+INSERT INTO
+    audiobook.book_authors (book_id, author_id, position)
+VALUES ($1, $2, $3) ON CONFLICT (book_id, author_id) DO NOTHING;
+
+-- name: DeleteOrphanAuthors :execrows
+DELETE FROM audiobook.authors a
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM audiobook.book_authors ba
+        WHERE
+            ba.author_id = a.id
+    );
+
+-- name: GetSeries :one
+SELECT * FROM audiobook.series WHERE id = $1;
+
+-- name: GetSeriesByName :one
+SELECT *
+FROM audiobook.series
+WHERE
+    lower(name) = lower(@name)
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateSeries :one
+INSERT INTO
+    audiobook.series (name, sort_name)
+VALUES ($1, $2) RETURNING *;
+
+-- name: ListSeries :many
+-- restrict limits the result to series with a book under library_paths.
+SELECT s.*
+FROM audiobook.series s
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.books b
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE b.series_id = s.id AND starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY s.sort_name ASC, s.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountSeries :one
+SELECT COUNT(*)
+FROM audiobook.series s
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM audiobook.books b
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE b.series_id = s.id AND starts_with(b.path, lp.path)
+        )
+    );
+
+-- name: DeleteEmptySeries :execrows
+DELETE FROM audiobook.series s
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM audiobook.books b
+        WHERE
+            b.series_id = s.id
+    );
@@ -0,0 +1,167 @@
+-- name: GetBook :one
+SELECT * FROM audiobook.books WHERE id = $1;
+
+-- name: GetBookByPath :one
+SELECT * FROM audiobook.books WHERE path = $1;
+
+-- name: UpsertBook :one
+-- Books are keyed by their folder (or file) so rescans update them in place.
+INSERT INTO
+    audiobook.books (
+        path,
+        title,
+        sort_title,
+        subtitle,
+        author_credit,
+        narrators,
+        series_id,
+        series_index,
+        description,
+        publisher,
+        year,
+        language,
+        genres,
+        asin,
+        cover_path
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    )
+ON CONFLICT (path) DO
+UPDATE
+SET
+    title = EXCLUDED.title,
+    sort_title = EXCLUDED.sort_title,
+    subtitle = EXCLUDED.subtitle,
+    author_credit = EXCLUDED.author_credit,
+    narrators = EXCLUDED.narrators,
+    series_id = EXCLUDED.series_id,
+    series_index = EXCLUDED.series_index,
+    description = EXCLUDED.description,
+    publisher = EXCLUDED.publisher,
+    year = EXCLUDED.year,
+    language = EXCLUDED.language,
+    genres = EXCLUDED.genres,
+    asin = EXCLUDED.asin,
+    cover_path = EXCLUDED.cover_path RETURNING *;
+
+-- name: RefreshBookStats :one
+-- Recomputes the denormalized duration, file and chapter totals.
+UPDATE audiobook.books b
+SET
+    duration_ms = (
+        SELECT COALESCE(SUM(f.duration_ms), 0)::BIGINT
+        FROM audiobook.files f
+        WHERE
+            f.book_id = b.id
+    ),
+    file_count = (
+        SELECT COUNT(*)
+        FROM audiobook.files f
+        WHERE
+            f.book_id = b.id
+    ),
+    total_size = (
+        SELECT COALESCE(SUM(f.file_size), 0)::BIGINT
+        FROM audiobook.files f
+        WHERE
+            f.book_id = b.id
+    ),
+    chapter_count = (
+        SELECT COUNT(*)
+        FROM audiobook.chapters c
+        WHERE
+            c.book_id = b.id
+    )
+WHERE
+    b.id = $1 RETURNING b.*;
+
+-- name: ListBooks :many
+-- restrict limits the result to books under library_paths.
+SELECT b.*
+FROM audiobook.books b
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY
+    CASE
+        WHEN @order_by::text = 'title' THEN b.sort_title
+    END ASC,
+    CASE
+        WHEN @order_by::text = 'year' THEN b.year
+    END DESC NULLS LAST,
+    b.created_at DESC,
+    b.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountBooks :one
+SELECT COUNT(*)
+FROM audiobook.books b
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    );
+
+-- name: ListBooksByAuthor :many
+-- restrict limits the result to books under library_paths.
+SELECT b.*
+FROM audiobook.books b
+    JOIN audiobook.book_authors ba ON ba.book_id = b.id
+WHERE
+    ba.author_id = @author_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY b.year ASC NULLS LAST, b.sort_title ASC;
+
+-- name: ListBooksBySeries :many
+-- restrict limits the result to books under library_paths.
+SELECT b.*
+FROM audiobook.books b
+WHERE
+    b.series_id = @series_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY b.series_index ASC NULLS LAST, b.year ASC NULLS LAST, b.sort_title ASC;
+
+-- name: ListBookPaths :many
+SELECT id, path FROM audiobook.books;
+
+-- name: DeleteBook :exec
+DELETE FROM audiobook.books WHERE id = $1;
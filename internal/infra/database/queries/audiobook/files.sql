@@ -0,0 +1,38 @@
+-- name: GetBookFile :one
+SELECT * FROM audiobook.files WHERE id = $1;
+
+-- name: ListBookFiles :many
+SELECT * FROM audiobook.files WHERE book_id = $1 ORDER BY position ASC;
+
+-- name: DeleteBookFiles :exec
+DELETE FROM audiobook.files WHERE book_id = $1;
+
+-- name: CreateBookFile :one
+INSERT INTO
+    audiobook.files (
+        book_id,
+        position,
+        file_path,
+        file_size,
+        container,
+        duration_ms,
+        offset_ms
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
+-- name: ListBookChapters :many
+SELECT * FROM audiobook.chapters WHERE book_id = $1 ORDER BY position ASC;
+
+-- name: DeleteBookChapters :exec
+DELETE FROM audiobook.chapters WHERE book_id = $1;
+
+-- name: CreateBookChapter :one
+INSERT INTO
+    audiobook.chapters (
+        book_id,
+        position,
+        title,
+        start_ms,
+        end_ms
+    )
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
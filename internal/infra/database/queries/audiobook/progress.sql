@@ -0,0 +1,74 @@
+-- name: UpsertBookProgress :one
+INSERT INTO
+    audiobook.book_progress (
+        user_id,
+        book_id,
+        position_ms,
+        duration_ms,
+        playback_speed,
+        is_completed,
+        listen_count,
+        completed_at
+    )
+VALUES (
+        @user_id,
+        @book_id,
+        @position_ms,
+        @duration_ms,
+        @playback_speed,
+        @is_completed::boolean,
+        CASE WHEN @is_completed::boolean THEN 1 ELSE 0 END,
+        CASE WHEN @is_completed::boolean THEN NOW() END
+    ) ON CONFLICT (user_id, book_id) DO
+UPDATE
+SET
+    position_ms = EXCLUDED.position_ms,
+    duration_ms = EXCLUDED.duration_ms,
+    playback_speed = EXCLUDED.playback_speed,
+    is_completed = EXCLUDED.is_completed,
+    listen_count = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT book_progress.is_completed THEN book_progress.listen_count + 1
+        ELSE book_progress.listen_count
+    END,
+    completed_at = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT book_progress.is_completed THEN NOW()
+        WHEN EXCLUDED.is_completed THEN book_progress.completed_at
+    END,
+    last_listened_at = NOW() RETURNING *;
+
+-- name: GetBookProgress :one
+SELECT *
+FROM audiobook.book_progress
+WHERE
+    user_id = $1
+    AND book_id = $2;
+
+-- name: DeleteBookProgress :exec
+DELETE FROM audiobook.book_progress
+WHERE
+    user_id = $1
+    AND book_id = $2;
+
+-- name: ListContinueListening :many
+-- restrict limits the result to books under library_paths.
+SELECT
+    sqlc.embed(b),
+    sqlc.embed(p)
+FROM audiobook.books b
+    JOIN audiobook.book_progress p ON p.book_id = b.id
+WHERE
+    p.user_id = $1
+    AND p.is_completed = FALSE
+    AND p.position_ms > 0
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.path, lp.path)
+        )
+    )
+ORDER BY p.last_listened_at DESC
+LIMIT $2;
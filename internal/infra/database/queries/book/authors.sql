@@ -0,0 +1,131 @@
+-- name: GetAuthor :one
+SELECT * FROM book.authors WHERE id = $1;
+
+-- name: GetAuthorByName :one
+SELECT *
+FROM book.authors
+WHERE
+    lower(name) = lower(@name)
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateAuthor :one
+INSERT INTO
+    book.authors (name, sort_name)
+VALUES ($1, $2) RETURNING *;
+
+-- name: ListAuthors :many
+-- restrict limits the result to authors of a book under library_paths.
+SELECT a.*
+FROM book.authors a
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM book.book_authors ba
+                JOIN book.books b ON b.id = ba.book_id
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE ba.author_id = a.id AND starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY a.sort_name ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountAuthors :one
+SELECT COUNT(*)
+FROM book.authors a
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM book.book_authors ba
+                JOIN book.books b ON b.id = ba.book_id
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE ba.author_id = a.id AND starts_with(b.file_path, lp.path)
+        )
+    );
+
+-- name: ListBookAuthors :many
+SELECT a.*
+FROM book.authors a
+    JOIN book.book_authors ba ON ba.author_id = a.id
+WHERE
+    ba.book_id = $1
+ORDER BY ba.position ASC;
+
+-- name: DeleteBookAuthors :exec
+DELETE FROM book.book_authors WHERE book_id = $1;
+
+-- name: AddBookAuthor :exec
+INSERT INTO
+    book.book_authors (book_id, author_id, position)
+VALUES ($1, $2, $3) ON CONFLICT (book_id, author_id) DO NOTHING;
+
+-- name: DeleteOrphanAuthors :execrows
+DELETE FROM book.authors a
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM book.book_authors ba
+        WHERE
+            ba.author_id = a.id
+    );
+
+-- name: GetSeries :one
+SELECT * FROM book.series WHERE id = $1;
+
+-- name: GetSeriesByName :one
+SELECT *
+FROM book.series
+WHERE
+    lower(name) = lower(@name)
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateSeries :one
+INSERT INTO
+    book.series (name, sort_name)
+VALUES ($1, $2) RETURNING *;
+
+-- name: ListSeries :many
+-- restrict limits the result to series with a book under library_paths.
+SELECT s.*
+FROM book.series s
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM book.books b
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE b.series_id = s.id AND starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY s.sort_name ASC, s.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountSeries :one
+SELECT COUNT(*)
+FROM book.series s
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM book.books b
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE b.series_id = s.id AND starts_with(b.file_path, lp.path)
+        )
+    );
+
+-- name: DeleteEmptySeries :execrows
+DELETE FROM book.series s
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM book.books b
+        WHERE
+            b.series_id = s.id
+    );
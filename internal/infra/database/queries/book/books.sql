@@ -0,0 +1,175 @@
+-- name: GetBook :one
+SELECT * FROM book.books WHERE id = $1;
+
+-- name: GetBookByPath :one
+SELECT * FROM book.books WHERE file_path = $1;
+
+-- name: UpsertBook :one
+-- Books are keyed by their file so rescans update them in place.
+INSERT INTO
+    book.books (
+        file_path,
+        file_size,
+        kind,
+        format,
+        title,
+        sort_title,
+        subtitle,
+        author_credit,
+        series_id,
+        series_index,
+        description,
+        publisher,
+        year,
+        language,
+        isbn,
+        genres,
+        page_count,
+        pages,
+        right_to_left,
+        cover_entry
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18,
+        $19,
+        $20
+    )
+ON CONFLICT (file_path) DO
+UPDATE
+SET
+    file_size = EXCLUDED.file_size,
+    kind = EXCLUDED.kind,
+    format = EXCLUDED.format,
+    title = EXCLUDED.title,
+    sort_title = EXCLUDED.sort_title,
+    subtitle = EXCLUDED.subtitle,
+    author_credit = EXCLUDED.author_credit,
+    series_id = EXCLUDED.series_id,
+    series_index = EXCLUDED.series_index,
+    description = EXCLUDED.description,
+    publisher = EXCLUDED.publisher,
+    year = EXCLUDED.year,
+    language = EXCLUDED.language,
+    isbn = EXCLUDED.isbn,
+    genres = EXCLUDED.genres,
+    page_count = EXCLUDED.page_count,
+    pages = EXCLUDED.pages,
+    right_to_left = EXCLUDED.right_to_left,
+    cover_entry = EXCLUDED.cover_entry RETURNING *;
+
+-- name: ListBooks :many
+-- An empty kind lists books and comics; the query matches titles, authors
+-- and series names. restrict limits the result to books under
+-- library_paths.
+SELECT b.*
+FROM book.books b
+    LEFT JOIN book.series s ON s.id = b.series_id
+WHERE (
+        @kind::text = ''
+        OR b.kind = @kind::text
+    )
+    AND (
+        @query::text = ''
+        OR b.title ILIKE '%' || @query::text || '%'
+        OR b.author_credit ILIKE '%' || @query::text || '%'
+        OR s.name ILIKE '%' || @query::text || '%'
+    )
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY
+    CASE
+        WHEN @order_by::text = 'title' THEN b.sort_title
+    END ASC,
+    CASE
+        WHEN @order_by::text = 'year' THEN b.year
+    END DESC NULLS LAST,
+    b.created_at DESC,
+    b.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountBooks :one
+SELECT COUNT(*)
+FROM book.books b
+    LEFT JOIN book.series s ON s.id = b.series_id
+WHERE (
+        @kind::text = ''
+        OR b.kind = @kind::text
+    )
+    AND (
+        @query::text = ''
+        OR b.title ILIKE '%' || @query::text || '%'
+        OR b.author_credit ILIKE '%' || @query::text || '%'
+        OR s.name ILIKE '%' || @query::text || '%'
+    )
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    );
+
+-- name: ListBooksByAuthor :many
+-- restrict limits the result to books under library_paths.
+SELECT b.*
+FROM book.books b
+    JOIN book.book_authors ba ON ba.book_id = b.id
+WHERE
+    ba.author_id = @author_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY b.year ASC NULLS LAST, b.sort_title ASC;
+
+-- name: ListBooksBySeries :many
+-- restrict limits the result to books under library_paths.
+SELECT b.*
+FROM book.books b
+WHERE
+    b.series_id = @series_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY b.series_index ASC NULLS LAST, b.year ASC NULLS LAST, b.sort_title ASC;
+
+-- name: ListBookPaths :many
+SELECT id, file_path FROM book.books;
+
+-- name: DeleteBook :exec
+DELETE FROM book.books WHERE id = $1;
@@ -0,0 +1,73 @@
+-- name: UpsertReadingProgress :one
+INSERT INTO
+    book.reading_progress (
+        user_id,
+        book_id,
+        page,
+        locator,
+        progress_percent,
+        is_completed,
+        read_count,
+        completed_at
+    )
+VALUES (
+        @user_id,
+        @book_id,
+        @page,
+        @locator,
+        @progress_percent,
+        @is_completed::boolean,
+        CASE WHEN @is_completed::boolean THEN 1 ELSE 0 END,
+        CASE WHEN @is_completed::boolean THEN NOW() END
+    ) ON CONFLICT (user_id, book_id) DO
+UPDATE
+SET
+    page = EXCLUDED.page,
+    locator = EXCLUDED.locator,
+    progress_percent = EXCLUDED.progress_percent,
+    is_completed = EXCLUDED.is_completed,
+    read_count = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT reading_progress.is_completed THEN reading_progress.read_count + 1
+        ELSE reading_progress.read_count
+    END,
+    completed_at = CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT reading_progress.is_completed THEN NOW()
+        WHEN EXCLUDED.is_completed THEN reading_progress.completed_at
+    END,
+    last_read_at = NOW() RETURNING *;
+
+-- name: GetReadingProgress :one
+SELECT *
+FROM book.reading_progress
+WHERE
+    user_id = $1
+    AND book_id = $2;
+
+-- name: DeleteReadingProgress :exec
+DELETE FROM book.reading_progress
+WHERE
+    user_id = $1
+    AND book_id = $2;
+
+-- name: ListContinueReading :many
+SELECT
+    sqlc.embed(b),
+    sqlc.embed(p)
+FROM book.books b
+    JOIN book.reading_progress p ON p.book_id = b.id
+WHERE
+    p.user_id = $1
+    AND p.is_completed = FALSE
+    AND (@kind::text = '' OR b.kind = @kind::text)
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(b.file_path, lp.path)
+        )
+    )
+ORDER BY p.last_read_at DESC
+LIMIT $2;
@@ -0,0 +1,135 @@
+-- name: GetFolder :one
+SELECT
+    sqlc.embed(f),
+    (
+        SELECT COUNT(*)
+        FROM homevideo.folders c
+        WHERE
+            c.parent_id = f.id
+    ) AS folder_count,
+    (
+        SELECT COUNT(*)
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+    ) AS video_count,
+    cover.id AS cover_video_id
+FROM homevideo.folders f
+    LEFT JOIN LATERAL (
+        SELECT v.id
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+            AND v.thumbnail_key IS NOT NULL
+        ORDER BY v.recorded_at DESC
+        LIMIT 1
+    ) cover ON TRUE
+WHERE
+    f.id = $1;
+
+-- name: UpsertFolder :one
+-- Folders are keyed by their path so rescans keep their IDs.
+INSERT INTO
+    homevideo.folders (
+        library_id,
+        parent_id,
+        path,
+        title
+    )
+VALUES ($1, $2, $3, $4) ON CONFLICT (library_id, path) DO
+UPDATE
+SET
+    parent_id = EXCLUDED.parent_id,
+    title = EXCLUDED.title RETURNING *;
+
+-- name: ListFolders :many
+-- Lists the subfolders of a folder, or the library roots when parent_id
+-- is NULL, by title. Video counts include subfolders; the cover is the
+-- latest recorded video with a thumbnail. restrict limits the result to
+-- library_ids, the libraries the user may access.
+SELECT
+    sqlc.embed(f),
+    (
+        SELECT COUNT(*)
+        FROM homevideo.folders c
+        WHERE
+            c.parent_id = f.id
+    ) AS folder_count,
+    (
+        SELECT COUNT(*)
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+    ) AS video_count,
+    cover.id AS cover_video_id
+FROM homevideo.folders f
+    LEFT JOIN LATERAL (
+        SELECT v.id
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+            AND v.thumbnail_key IS NOT NULL
+        ORDER BY v.recorded_at DESC
+        LIMIT 1
+    ) cover ON TRUE
+WHERE (
+        @restrict::bool = FALSE
+        OR f.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR f.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND f.parent_id IS NOT DISTINCT FROM sqlc.narg('parent_id')::uuid
+ORDER BY lower(f.title) ASC, f.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountFolders :one
+SELECT COUNT(*)
+FROM homevideo.folders f
+WHERE (
+        @restrict::bool = FALSE
+        OR f.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR f.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND f.parent_id IS NOT DISTINCT FROM sqlc.narg('parent_id')::uuid;
+
+-- name: ListFolderAncestors :many
+-- Returns the folders above a folder, the library root first.
+WITH RECURSIVE
+    ancestors AS (
+        SELECT f.id, f.parent_id, f.title, 0 AS depth
+        FROM homevideo.folders f
+        WHERE
+            f.id = $1
+        UNION ALL
+        SELECT p.id, p.parent_id, p.title, a.depth + 1
+        FROM homevideo.folders p
+            JOIN ancestors a ON p.id = a.parent_id
+    )
+SELECT id, title
+FROM ancestors
+WHERE
+    depth > 0
+ORDER BY depth DESC;
+
+-- name: DeleteEmptyFolders :execrows
+-- Removes folders left without videos, including in their subfolders.
+DELETE FROM homevideo.folders f
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM homevideo.videos v
+        WHERE
+            v.library_id = f.library_id
+            AND starts_with (v.file_path, f.path || '/')
+    );
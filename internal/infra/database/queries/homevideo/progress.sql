@@ -0,0 +1,67 @@
+-- name: UpsertWatchProgress :one
+-- Records the playback position. Finishing a video counts as a watch.
+INSERT INTO
+    homevideo.watch_progress (
+        user_id,
+        video_id,
+        progress_seconds,
+        duration_seconds,
+        is_completed,
+        watch_count
+    )
+VALUES (
+        @user_id,
+        @video_id,
+        @progress_seconds,
+        @duration_seconds,
+        @is_completed::boolean,
+        CASE
+            WHEN @is_completed::boolean THEN 1
+            ELSE 0
+        END
+    ) ON CONFLICT (user_id, video_id) DO
+UPDATE
+SET
+    progress_seconds = EXCLUDED.progress_seconds,
+    duration_seconds = EXCLUDED.duration_seconds,
+    is_completed = EXCLUDED.is_completed,
+    watch_count = watch_progress.watch_count + CASE
+        WHEN EXCLUDED.is_completed
+        AND NOT watch_progress.is_completed THEN 1
+        ELSE 0
+    END,
+    last_watched_at = NOW() RETURNING *;
+
+-- name: GetWatchProgress :one
+SELECT *
+FROM homevideo.watch_progress
+WHERE
+    user_id = $1
+    AND video_id = $2;
+
+-- name: DeleteWatchProgress :execrows
+DELETE FROM homevideo.watch_progress
+WHERE
+    user_id = $1
+    AND video_id = $2;
+
+-- name: ListContinueWatching :many
+-- Returns the videos a user started but did not finish, most recently
+-- watched first. restrict limits the result to library_ids.
+SELECT
+    sqlc.embed(v),
+    p.progress_seconds,
+    p.duration_seconds AS progress_duration_seconds,
+    p.last_watched_at
+FROM homevideo.watch_progress p
+    JOIN homevideo.videos v ON v.id = p.video_id
+WHERE
+    p.user_id = @user_id
+    AND NOT p.is_completed
+    AND p.progress_seconds > 0
+    AND (
+        @restrict::bool = FALSE
+        OR v.library_id = ANY(@library_ids::uuid[])
+    )
+ORDER BY p.last_watched_at DESC
+LIMIT $1;
@@ -0,0 +1,122 @@
+-- name: GetVideo :one
+SELECT * FROM homevideo.videos WHERE id = $1;
+
+-- name: GetVideoByPath :one
+SELECT * FROM homevideo.videos WHERE file_path = $1;
+
+-- name: UpsertVideo :one
+-- Videos are keyed by their file so rescans update them in place. The
+-- thumbnail is kept unless the file changed.
+INSERT INTO
+    homevideo.videos (
+        library_id,
+        folder_id,
+        file_path,
+        file_size,
+        container,
+        title,
+        title_source,
+        sort_title,
+        description,
+        recorded_at,
+        recorded_at_source,
+        duration_seconds,
+        width,
+        height,
+        video_codec,
+        audio_codec
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16
+    ) ON CONFLICT (file_path) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    folder_id = EXCLUDED.folder_id,
+    container = EXCLUDED.container,
+    title = EXCLUDED.title,
+    title_source = EXCLUDED.title_source,
+    sort_title = EXCLUDED.sort_title,
+    description = EXCLUDED.description,
+    recorded_at = EXCLUDED.recorded_at,
+    recorded_at_source = EXCLUDED.recorded_at_source,
+    duration_seconds = EXCLUDED.duration_seconds,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    video_codec = EXCLUDED.video_codec,
+    audio_codec = EXCLUDED.audio_codec,
+    thumbnail_key = CASE
+        WHEN homevideo.videos.file_size = EXCLUDED.file_size THEN homevideo.videos.thumbnail_key
+    END,
+    file_size = EXCLUDED.file_size RETURNING *;
+
+-- name: SetVideoThumbnail :exec
+UPDATE homevideo.videos SET thumbnail_key = $2 WHERE id = $1;
+
+-- name: ListVideos :many
+-- Lists videos by recording time, newest first, by title or by when they
+-- were added. restrict limits the result to library_ids, the libraries
+-- the user may access.
+SELECT *
+FROM homevideo.videos
+WHERE (
+        @restrict::bool = FALSE
+        OR library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        sqlc.narg('folder_id')::uuid IS NULL
+        OR folder_id = sqlc.narg('folder_id')::uuid
+    )
+ORDER BY
+    CASE
+        WHEN @sort::text = 'title' THEN sort_title
+    END ASC,
+    CASE
+        WHEN @sort::text = 'added' THEN created_at
+    END DESC,
+    recorded_at DESC,
+    id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountVideos :one
+SELECT COUNT(*)
+FROM homevideo.videos
+WHERE (
+        @restrict::bool = FALSE
+        OR library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        sqlc.narg('folder_id')::uuid IS NULL
+        OR folder_id = sqlc.narg('folder_id')::uuid
+    );
+
+-- name: ListVideoPaths :many
+SELECT id, file_path FROM homevideo.videos WHERE library_id = $1;
+
+-- name: DeleteVideo :exec
+DELETE FROM homevideo.videos WHERE id = $1;
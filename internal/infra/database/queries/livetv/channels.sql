@@ -0,0 +1,60 @@
+-- name: GetChannel :one
+SELECT * FROM livetv.channels WHERE id = $1;
+
+-- name: ListChannels :many
+-- Lists channels in playlist order, optionally including disabled ones.
+SELECT c.*
+FROM livetv.channels c
+    JOIN livetv.sources s ON s.id = c.source_id
+WHERE (
+        @include_disabled::boolean
+        OR (c.enabled AND s.enabled)
+    )
+ORDER BY s.created_at ASC, c.position ASC, c.id ASC;
+
+-- name: UpsertChannel :one
+INSERT INTO
+    livetv.channels (
+        source_id,
+        stream_url,
+        name,
+        number,
+        guide_id,
+        logo_url,
+        group_title,
+        position
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (source_id, stream_url) DO
+UPDATE
+SET
+    name = EXCLUDED.name,
+    number = EXCLUDED.number,
+    guide_id = COALESCE(
+        EXCLUDED.guide_id,
+        livetv.channels.guide_id
+    ),
+    logo_url = EXCLUDED.logo_url,
+    group_title = EXCLUDED.group_title,
+    position = EXCLUDED.position RETURNING *;
+
+-- name: DeleteChannelsNotIn :execrows
+-- Removes the channels a playlist no longer lists.
+DELETE FROM livetv.channels
+WHERE
+    source_id = $1
+    AND NOT (
+        stream_url = ANY (@stream_urls::text[])
+    );
+
+-- name: SetChannelEnabled :one
+UPDATE livetv.channels SET enabled = $2 WHERE id = $1 RETURNING *;
+
+-- name: AssignChannelGuideID :execrows
+-- Links channels without a tvg-id to the guide channel of the same name.
+UPDATE livetv.channels
+SET
+    guide_id = @guide_id
+WHERE
+    guide_id IS NULL
+    AND lower(name) = lower(@name);
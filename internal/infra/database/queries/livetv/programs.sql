@@ -0,0 +1,97 @@
+-- name: GetProgram :one
+SELECT * FROM livetv.programs WHERE id = $1;
+
+-- name: UpsertProgram :exec
+INSERT INTO
+    livetv.programs (
+        source_id,
+        guide_channel_id,
+        starts_at,
+        ends_at,
+        title,
+        subtitle,
+        description,
+        categories,
+        season_number,
+        episode_number,
+        episode_label,
+        icon_url,
+        air_date,
+        is_new,
+        refreshed_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    )
+ON CONFLICT (
+    source_id,
+    guide_channel_id,
+    starts_at
+) DO
+UPDATE
+SET
+    ends_at = EXCLUDED.ends_at,
+    title = EXCLUDED.title,
+    subtitle = EXCLUDED.subtitle,
+    description = EXCLUDED.description,
+    categories = EXCLUDED.categories,
+    season_number = EXCLUDED.season_number,
+    episode_number = EXCLUDED.episode_number,
+    episode_label = EXCLUDED.episode_label,
+    icon_url = EXCLUDED.icon_url,
+    air_date = EXCLUDED.air_date,
+    is_new = EXCLUDED.is_new,
+    refreshed_at = EXCLUDED.refreshed_at;
+
+-- name: DeleteStalePrograms :execrows
+-- Removes the programs of a source an earlier refresh saw last.
+DELETE FROM livetv.programs
+WHERE
+    source_id = $1
+    AND refreshed_at < $2;
+
+-- name: DeleteEndedPrograms :execrows
+DELETE FROM livetv.programs WHERE ends_at < $1;
+
+-- name: ListGuide :many
+-- Programs of the given guide channels overlapping a time window.
+SELECT *
+FROM livetv.programs
+WHERE
+    guide_channel_id = ANY (@guide_ids::text[])
+    AND ends_at > @starts_after
+    AND starts_at < @ends_before
+ORDER BY guide_channel_id ASC, starts_at ASC;
+
+-- name: ListUpcomingAirings :many
+-- Future airings of a title on enabled channels, optionally of one
+-- channel. A program carried by several channels is listed per channel.
+SELECT
+    sqlc.embed(p),
+    c.id AS channel_id,
+    c.name AS channel_name
+FROM livetv.programs p
+    JOIN livetv.channels c ON c.guide_id = p.guide_channel_id
+    AND c.enabled
+WHERE
+    lower(p.title) = lower(@title)
+    AND p.starts_at >= @starts_after
+    AND (
+        sqlc.narg ('channel_id')::uuid IS NULL
+        OR c.id = sqlc.narg ('channel_id')::uuid
+    )
+ORDER BY p.starts_at ASC, c.position ASC;
@@ -0,0 +1,151 @@
+-- name: GetRecording :one
+SELECT * FROM livetv.recordings WHERE id = $1;
+
+-- name: GetRecordingByProgram :one
+SELECT *
+FROM livetv.recordings
+WHERE
+    program_id = $1
+    AND status <> 'cancelled';
+
+-- name: CreateRecording :one
+INSERT INTO
+    livetv.recordings (
+        user_id,
+        library_id,
+        channel_id,
+        program_id,
+        series_rule_id,
+        channel_name,
+        title,
+        subtitle,
+        description,
+        season_number,
+        episode_number,
+        starts_at,
+        ends_at,
+        padding_before_seconds,
+        padding_after_seconds
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    ) RETURNING *;
+
+-- name: ListRecordings :many
+-- Lists a user's recordings, newest first, optionally of one status.
+SELECT *
+FROM livetv.recordings
+WHERE
+    user_id = $1
+    AND (
+        sqlc.narg ('status')::text IS NULL
+        OR status = sqlc.narg ('status')::text
+    )
+ORDER BY starts_at DESC, id ASC
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: CountRecordings :one
+SELECT COUNT(*)
+FROM livetv.recordings
+WHERE
+    user_id = $1
+    AND (
+        sqlc.narg ('status')::text IS NULL
+        OR status = sqlc.narg ('status')::text
+    );
+
+-- name: ListScheduledRecordings :many
+-- Recordings waiting for their start, including those that should have
+-- started already.
+SELECT *
+FROM livetv.recordings
+WHERE
+    status = 'scheduled'
+    AND ends_at > $1
+ORDER BY starts_at ASC;
+
+-- name: StartRecording :one
+-- Marks a scheduled recording as running. Returns no row for recordings
+-- that were cancelled or finished meanwhile.
+UPDATE livetv.recordings
+SET
+    status = 'recording',
+    error = NULL
+WHERE
+    id = $1
+    AND status IN ('scheduled', 'recording') RETURNING *;
+
+-- name: FinishRecording :one
+UPDATE livetv.recordings
+SET
+    status = $2,
+    file_path = $3,
+    file_size = $4,
+    error = $5
+WHERE
+    id = $1 RETURNING *;
+
+-- name: CancelRecording :one
+UPDATE livetv.recordings
+SET
+    status = 'cancelled'
+WHERE
+    id = $1
+    AND status = 'scheduled' RETURNING *;
+
+-- name: DeleteRecording :execrows
+DELETE FROM livetv.recordings WHERE id = $1;
+
+-- name: GetSeriesRule :one
+SELECT * FROM livetv.series_rules WHERE id = $1;
+
+-- name: ListSeriesRules :many
+SELECT *
+FROM livetv.series_rules
+WHERE
+    user_id = $1
+ORDER BY lower(title) ASC, id ASC;
+
+-- name: ListEnabledSeriesRules :many
+SELECT * FROM livetv.series_rules WHERE enabled = TRUE ORDER BY created_at ASC;
+
+-- name: CreateSeriesRule :one
+INSERT INTO
+    livetv.series_rules (
+        user_id,
+        library_id,
+        title,
+        channel_id,
+        new_only,
+        padding_before_seconds,
+        padding_after_seconds
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
+-- name: DeleteSeriesRule :execrows
+DELETE FROM livetv.series_rules WHERE id = $1;
+
+-- name: CancelRuleRecordings :execrows
+-- Cancels the recordings a series rule scheduled that have not started.
+UPDATE livetv.recordings
+SET
+    status = 'cancelled'
+WHERE
+    series_rule_id = $1
+    AND status = 'scheduled';
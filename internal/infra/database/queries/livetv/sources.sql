@@ -0,0 +1,34 @@
+-- name: GetSource :one
+SELECT * FROM livetv.sources WHERE id = $1;
+
+-- name: ListSources :many
+SELECT * FROM livetv.sources ORDER BY kind ASC, name ASC, id ASC;
+
+-- name: ListEnabledSources :many
+SELECT * FROM livetv.sources WHERE enabled = TRUE ORDER BY kind ASC, name ASC;
+
+-- name: CreateSource :one
+INSERT INTO
+    livetv.sources (name, kind, location, enabled)
+VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: UpdateSource :one
+UPDATE livetv.sources
+SET
+    name = $2,
+    location = $3,
+    enabled = $4
+WHERE
+    id = $1 RETURNING *;
+
+-- name: MarkSourceRefreshed :exec
+-- Records a refresh, with last_error set when it failed.
+UPDATE livetv.sources
+SET
+    last_refreshed_at = NOW(),
+    last_error = $2
+WHERE
+    id = $1;
+
+-- name: DeleteSource :execrows
+DELETE FROM livetv.sources WHERE id = $1;
@@ -27,7 +27,9 @@ INSERT INTO
         budget,
         revenue,
         radarr_id,
-        metadata_updated_at
+        metadata_updated_at,
+        sort_title,
+        min_age
     )
 VALUES (
         $1,
@@ -55,7 +57,9 @@ VALUES (
         $23,
         $24,
         $25,
-        $26
+        $26,
+        $27,
+        $28
     ) RETURNING *;
 
 -- name: GetMovie :one
@@ -136,10 +140,22 @@ SET
         sqlc.narg ('age_ratings'),
         age_ratings
     ),
+    min_age = CASE
+        WHEN sqlc.narg ('age_ratings') IS NULL THEN min_age
+        ELSE sqlc.narg ('min_age')::integer
+    END,
     external_ratings = COALESCE(
         sqlc.narg ('external_ratings'),
         external_ratings
     ),
+    metadata_sources = metadata_sources || COALESCE(
+        sqlc.narg ('metadata_sources')::jsonb,
+        '{}'::jsonb
+    ),
+    provider_ids = provider_ids || COALESCE(
+        sqlc.narg ('provider_ids')::jsonb,
+        '{}'::jsonb
+    ),
     poster_path = COALESCE(
         sqlc.narg ('poster_path'),
         poster_path
@@ -176,7 +192,39 @@ SET
     metadata_updated_at = COALESCE(
         sqlc.narg ('metadata_updated_at'),
         metadata_updated_at
+    ),
+    sort_title = COALESCE(
+        sqlc.narg ('sort_title'),
+        sort_title
+    )
+WHERE
+    id = sqlc.arg ('id')
+    AND deleted_at IS NULL RETURNING *;
+
+-- name: SetMovieImagePlaceholder :execrows
+-- Stores the placeholder of one of the movie's images. Placeholders of
+-- images the movie no longer uses are dropped.
+UPDATE movie.movies
+SET
+    image_placeholders = (
+        SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+        FROM jsonb_each(
+                image_placeholders || jsonb_build_object(
+                    sqlc.arg ('path')::text, sqlc.arg ('placeholder')::jsonb
+                )
+            ) AS p
+        WHERE
+            p.key IN (poster_path, backdrop_path)
     )
+WHERE
+    id = sqlc.arg ('id')
+    AND deleted_at IS NULL;
+
+-- name: SetMovieLockedFields :one
+UPDATE movie.movies
+SET
+    locked_fields = sqlc.arg ('locked_fields')::text[],
+    updated_at = NOW()
 WHERE
     id = sqlc.arg ('id')
     AND deleted_at IS NULL RETURNING *;
@@ -187,16 +235,53 @@ UPDATE movie.movies SET deleted_at = NOW() WHERE id = $1;
 -- name: ListMovies :many
 SELECT * FROM movie.movies
 WHERE deleted_at IS NULL
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY
-    CASE WHEN sqlc.narg('order_by')::text = 'title' THEN title END ASC,
+    CASE WHEN sqlc.narg('order_by')::text = 'title' THEN COALESCE(sort_title, title) END ASC,
     CASE WHEN sqlc.narg('order_by')::text = 'year' THEN year END DESC,
     CASE WHEN sqlc.narg('order_by')::text = 'added' THEN library_added_at END DESC,
     CASE WHEN sqlc.narg('order_by')::text = 'rating' THEN vote_average END DESC,
     library_added_at DESC
-LIMIT $1 OFFSET $2;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountMovies :one
-SELECT COUNT(*) FROM movie.movies WHERE deleted_at IS NULL;
+-- restrict limits the result to movies with a file under library_paths.
+SELECT COUNT(*) FROM movie.movies
+WHERE deleted_at IS NULL
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    );
 
 -- name: SearchMoviesByTitle :many
 SELECT *
@@ -207,6 +292,19 @@ WHERE
         title % $1
         OR original_title % $1
     )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY similarity (title, $1) DESC, similarity (original_title, $1) DESC
 LIMIT $2
 OFFSET
@@ -227,6 +325,19 @@ WHERE
                 value ILIKE '%' || $1 || '%'
         )
     )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY
     CASE
         WHEN title ILIKE $1 THEN 1
@@ -251,34 +362,134 @@ OFFSET
     $3;
 
 -- name: ListRecentlyAdded :many
+-- restrict limits the result to movies with a file under library_paths.
 SELECT *
 FROM movie.movies
 WHERE
     deleted_at IS NULL
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY library_added_at DESC
-LIMIT $1
+LIMIT sqlc.arg('limit')
 OFFSET
-    $2;
+    sqlc.arg('offset');
+
+-- name: ListMoviesDueForRefresh :many
+SELECT *
+FROM movie.movies
+WHERE
+    deleted_at IS NULL
+    AND tmdb_id IS NOT NULL
+    AND (
+        metadata_updated_at IS NULL
+        OR metadata_updated_at < CASE
+            WHEN overview IS NULL OR poster_path IS NULL THEN sqlc.arg('incomplete_before')::timestamptz
+            WHEN release_date IS NULL OR release_date >= sqlc.arg('active_since')::date THEN sqlc.arg('active_before')::timestamptz
+            ELSE sqlc.arg('settled_before')::timestamptz
+        END
+    )
+ORDER BY metadata_updated_at ASC NULLS FIRST
+LIMIT sqlc.arg('limit');
+
+-- name: ListMoviesPendingTagImport :many
+SELECT *
+FROM movie.movies
+WHERE
+    deleted_at IS NULL
+    AND tmdb_id IS NOT NULL
+    AND tags_imported_at IS NULL
+    AND id > sqlc.arg('after')
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: SetMovieTagsImported :exec
+UPDATE movie.movies SET tags_imported_at = NOW() WHERE id = $1;
 
 -- name: ListTopRated :many
+-- restrict limits the result to movies with a file under library_paths.
 SELECT *
 FROM movie.movies
 WHERE
     deleted_at IS NULL
     AND vote_average IS NOT NULL
-    AND vote_count > $1
+    AND vote_count > sqlc.arg('vote_count')
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY vote_average DESC, vote_count DESC
-LIMIT $2
+LIMIT sqlc.arg('limit')
 OFFSET
-    $3;
+    sqlc.arg('offset');
 
 -- name: CountTopRated :one
+-- restrict limits the result to movies with a file under library_paths.
 SELECT COUNT(*)
 FROM movie.movies
 WHERE
     deleted_at IS NULL
     AND vote_average IS NOT NULL
-    AND vote_count > $1;
+    AND vote_count > sqlc.arg('vote_count')
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.movie_id = movies.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = movies.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = movies.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    );
 
 -- Movie Files Operations
 -- name: CreateMovieFile :one
@@ -296,7 +507,8 @@ INSERT INTO
         bitrate_kbps,
         audio_languages,
         subtitle_languages,
-        radarr_file_id
+        radarr_file_id,
+        edition
     )
 VALUES (
         $1,
@@ -311,7 +523,8 @@ VALUES (
         $10,
         $11,
         $12,
-        $13
+        $13,
+        $14
     ) RETURNING *;
 
 -- name: GetMovieFile :one
@@ -397,8 +610,77 @@ WHERE
 -- name: DeleteMovieFile :exec
 UPDATE movie.movie_files SET deleted_at = NOW() WHERE id = $1;
 
+-- name: AssignMovieFileMatch :one
+UPDATE movie.movie_files
+SET
+    movie_id = sqlc.arg ('movie_id'),
+    match_locked = sqlc.arg ('match_locked')
+WHERE
+    id = sqlc.arg ('id')
+    AND deleted_at IS NULL RETURNING *;
+
+-- name: SetMovieFileMatchLocked :one
+UPDATE movie.movie_files
+SET
+    match_locked = sqlc.arg ('match_locked')
+WHERE
+    id = sqlc.arg ('id')
+    AND deleted_at IS NULL RETURNING *;
+
 -- Movie Credits Operations
 -- name: CreateMovieCredit :one
+-- Creates a credit and links it to the person with the same TMDb, TVDb or
+-- IMDb ID, creating the person if none matches. Provider IDs the person is
+-- missing are filled in unless another person already has them. Credits
+-- without any provider ID are not linked.
+WITH ids AS (
+    SELECT
+        NULLIF(sqlc.arg('tmdb_person_id')::integer, 0) AS tmdb_id,
+        sqlc.narg('tvdb_person_id')::integer AS tvdb_id,
+        NULLIF(sqlc.narg('imdb_person_id')::text, '') AS imdb_id
+),
+existing AS (
+    SELECT p.id
+    FROM public.people p, ids
+    WHERE
+        p.tmdb_id = ids.tmdb_id
+        OR p.tvdb_id = ids.tvdb_id
+        OR p.imdb_id = ids.imdb_id
+    ORDER BY
+        (p.tmdb_id = ids.tmdb_id) IS TRUE DESC,
+        (p.tvdb_id = ids.tvdb_id) IS TRUE DESC
+    LIMIT 1
+),
+linked AS (
+    UPDATE public.people p
+    SET
+        tmdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tmdb_id = ids.tmdb_id) THEN p.tmdb_id
+            ELSE COALESCE(p.tmdb_id, ids.tmdb_id)
+        END,
+        tvdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tvdb_id = ids.tvdb_id) THEN p.tvdb_id
+            ELSE COALESCE(p.tvdb_id, ids.tvdb_id)
+        END,
+        imdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.imdb_id = ids.imdb_id) THEN p.imdb_id
+            ELSE COALESCE(p.imdb_id, ids.imdb_id)
+        END,
+        profile_path = COALESCE(p.profile_path, sqlc.narg('profile_path')::text)
+    FROM existing, ids
+    WHERE p.id = existing.id
+),
+created AS (
+    INSERT INTO
+        public.people (tmdb_id, tvdb_id, imdb_id, name, profile_path)
+    SELECT ids.tmdb_id, ids.tvdb_id, ids.imdb_id, sqlc.arg('name')::text, sqlc.narg('profile_path')::text
+    FROM ids
+    WHERE
+        NOT EXISTS (SELECT 1 FROM existing)
+        AND (ids.tmdb_id IS NOT NULL OR ids.tvdb_id IS NOT NULL OR ids.imdb_id IS NOT NULL)
+    ON CONFLICT DO NOTHING
+    RETURNING id
+)
 INSERT INTO
     movie.movie_credits (
         movie_id,
@@ -409,18 +691,20 @@ INSERT INTO
         job,
         department,
         cast_order,
-        profile_path
+        profile_path,
+        person_id
     )
 VALUES (
-        $1,
-        $2,
-        $3,
-        $4,
-        $5,
-        $6,
-        $7,
-        $8,
-        $9
+        sqlc.arg('movie_id'),
+        sqlc.arg('tmdb_person_id'),
+        sqlc.arg('name'),
+        sqlc.arg('credit_type'),
+        sqlc.narg('character'),
+        sqlc.narg('job'),
+        sqlc.narg('department'),
+        sqlc.narg('cast_order'),
+        sqlc.narg('profile_path'),
+        COALESCE((SELECT id FROM existing), (SELECT id FROM created))
     ) RETURNING *;
 
 -- name: ListMovieCast :many
@@ -586,16 +870,89 @@ ORDER BY name ASC;
 DELETE FROM movie.movie_genres WHERE movie_id = $1;
 
 -- name: ListMoviesByGenre :many
+-- restrict limits the result to movies with a file under library_paths.
 SELECT m.*
 FROM movie.movies m
     JOIN movie.movie_genres mg ON m.id = mg.movie_id
 WHERE
-    mg.slug = $1
+    mg.slug = sqlc.arg('slug')
     AND m.deleted_at IS NULL
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.movie_id = m.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR m.min_age <= sqlc.narg('max_age')::integer
+        OR (m.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = m.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = m.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY m.vote_average DESC NULLS LAST, m.title ASC
-LIMIT $2
+LIMIT sqlc.arg('limit')
 OFFSET
-    $3;
+    sqlc.arg('offset');
+
+-- Movie Tags Operations
+-- name: AddMovieTag :exec
+INSERT INTO
+    movie.movie_tags (movie_id, slug, name, spoiler)
+VALUES ($1, $2, $3, $4) ON CONFLICT (movie_id, slug) DO NOTHING;
+
+-- name: ListMovieTags :many
+SELECT *
+FROM movie.movie_tags
+WHERE
+    movie_id = $1
+ORDER BY name ASC;
+
+-- name: ListDistinctMovieTags :many
+SELECT slug, name, COUNT(DISTINCT movie_id)::bigint AS item_count
+FROM movie.movie_tags
+WHERE
+    NOT spoiler
+GROUP BY slug, name
+ORDER BY item_count DESC, name ASC
+LIMIT sqlc.arg('limit');
+
+-- name: DeleteMovieTags :exec
+DELETE FROM movie.movie_tags WHERE movie_id = $1;
+
+-- name: ListMoviesByTag :many
+SELECT m.*
+FROM movie.movies m
+    JOIN movie.movie_tags mt ON m.id = mt.movie_id
+WHERE
+    mt.slug = sqlc.arg('slug')
+    AND m.deleted_at IS NULL
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR m.min_age <= sqlc.narg('max_age')::integer
+        OR (m.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = m.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = m.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+ORDER BY m.vote_average DESC NULLS LAST, m.title ASC
+LIMIT sqlc.arg('limit')
+OFFSET
+    sqlc.arg('offset');
 
 -- Movie Watch Progress Operations
 -- name: CreateOrUpdateWatchProgress :one
@@ -634,16 +991,39 @@ WHERE
     AND movie_id = $2;
 
 -- name: ListContinueWatching :many
+-- restrict limits the result to movies with a file under library_paths.
 SELECT m.*, mw.progress_seconds, mw.duration_seconds, mw.progress_percent, mw.last_watched_at
 FROM movie.movies m
     JOIN movie.movie_watched mw ON m.id = mw.movie_id
 WHERE
-    mw.user_id = $1
+    mw.user_id = sqlc.arg('user_id')
     AND mw.is_completed = FALSE
     AND mw.progress_percent > 5
     AND m.deleted_at IS NULL
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM movie.movie_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.movie_id = m.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR m.min_age <= sqlc.narg('max_age')::integer
+        OR (m.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_genres bg
+        WHERE bg.movie_id = m.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM movie.movie_tags bt
+        WHERE bt.movie_id = m.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY mw.last_watched_at DESC
-LIMIT $2;
+LIMIT sqlc.arg('limit');
 
 -- name: ListWatchedMovies :many
 SELECT m.*, mw.watch_count, mw.last_watched_at
@@ -665,3 +1045,36 @@ SELECT
     COALESCE(SUM(watch_count), 0)::bigint as total_watches
 FROM movie.movie_watched
 WHERE user_id = $1;
+
+-- Movie Extras Operations
+-- name: UpsertMovieExtra :one
+INSERT INTO
+    movie.movie_extras (
+        movie_id,
+        extra_type,
+        title,
+        file_path,
+        file_size,
+        container,
+        duration_seconds
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (file_path) DO UPDATE
+SET
+    movie_id = EXCLUDED.movie_id,
+    extra_type = EXCLUDED.extra_type,
+    title = EXCLUDED.title,
+    file_size = EXCLUDED.file_size,
+    container = COALESCE(EXCLUDED.container, movie.movie_extras.container),
+    duration_seconds = COALESCE(EXCLUDED.duration_seconds, movie.movie_extras.duration_seconds)
+RETURNING *;
+
+-- name: GetMovieExtra :one
+SELECT * FROM movie.movie_extras WHERE id = $1;
+
+-- name: ListMovieExtras :many
+SELECT *
+FROM movie.movie_extras
+WHERE
+    movie_id = $1
+ORDER BY extra_type, title;
@@ -0,0 +1,157 @@
+-- name: GetArtist :one
+SELECT * FROM music.artists WHERE id = $1;
+
+-- name: GetArtistByMusicBrainzID :one
+SELECT * FROM music.artists WHERE musicbrainz_id = $1;
+
+-- name: GetArtistByName :one
+-- Artists without a MusicBrainz ID are matched by name.
+SELECT *
+FROM music.artists
+WHERE
+    lower(name) = lower(@name)
+    AND musicbrainz_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateArtist :one
+INSERT INTO
+    music.artists (musicbrainz_id, name, sort_name)
+VALUES ($1, $2, $3) RETURNING *;
+
+-- name: ListArtists :many
+-- restrict limits the result to artists with a track under library_paths.
+SELECT a.*
+FROM music.artists a
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE
+                starts_with(t.file_path, lp.path)
+                AND (
+                    EXISTS (
+                        SELECT 1
+                        FROM music.track_artists ta
+                        WHERE ta.track_id = t.id AND ta.artist_id = a.id
+                    )
+                    OR EXISTS (
+                        SELECT 1
+                        FROM music.release_artists ra
+                        WHERE ra.release_id = t.release_id AND ra.artist_id = a.id
+                    )
+                )
+        )
+    )
+ORDER BY a.sort_name ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountArtists :one
+SELECT COUNT(*)
+FROM music.artists a
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE
+                starts_with(t.file_path, lp.path)
+                AND (
+                    EXISTS (
+                        SELECT 1
+                        FROM music.track_artists ta
+                        WHERE ta.track_id = t.id AND ta.artist_id = a.id
+                    )
+                    OR EXISTS (
+                        SELECT 1
+                        FROM music.release_artists ra
+                        WHERE ra.release_id = t.release_id AND ra.artist_id = a.id
+                    )
+                )
+        )
+    );
+
+-- name: ListAlbumArtists :many
+-- Artists credited on at least one release. restrict limits the result to
+-- artists of a release with a track under library_paths.
+SELECT a.*
+FROM music.artists a
+WHERE
+    EXISTS (
+        SELECT 1
+        FROM music.release_artists ra
+        WHERE
+            ra.artist_id = a.id
+    )
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.release_artists ra
+                JOIN music.tracks t ON t.release_id = ra.release_id
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE ra.artist_id = a.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY a.sort_name ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountAlbumArtists :one
+SELECT COUNT(*)
+FROM music.artists a
+WHERE
+    EXISTS (
+        SELECT 1
+        FROM music.release_artists ra
+        WHERE
+            ra.artist_id = a.id
+    )
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.release_artists ra
+                JOIN music.tracks t ON t.release_id = ra.release_id
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE ra.artist_id = a.id AND starts_with(t.file_path, lp.path)
+        )
+    );
+
+-- name: ListReleaseArtists :many
+SELECT a.*
+FROM music.artists a
+    JOIN music.release_artists ra ON ra.artist_id = a.id
+WHERE
+    ra.release_id = $1
+ORDER BY ra.position ASC;
+
+-- name: ListTrackArtists :many
+SELECT a.*
+FROM music.artists a
+    JOIN music.track_artists ta ON ta.artist_id = a.id
+WHERE
+    ta.track_id = $1
+ORDER BY ta.position ASC;
+
+-- name: DeleteOrphanArtists :execrows
+DELETE FROM music.artists a
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM music.release_artists ra
+        WHERE
+            ra.artist_id = a.id
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM music.track_artists ta
+        WHERE
+            ta.artist_id = a.id
+    );
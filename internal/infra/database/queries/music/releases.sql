@@ -0,0 +1,218 @@
+-- name: GetReleaseGroup :one
+SELECT * FROM music.release_groups WHERE id = $1;
+
+-- name: GetReleaseGroupByMusicBrainzID :one
+SELECT * FROM music.release_groups WHERE musicbrainz_id = $1;
+
+-- name: FindReleaseGroup :one
+-- Release groups without a MusicBrainz ID are matched by title and artist.
+SELECT *
+FROM music.release_groups
+WHERE
+    lower(title) = lower(@title)
+    AND lower(artist_credit) = lower(@artist_credit)
+    AND musicbrainz_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateReleaseGroup :one
+INSERT INTO
+    music.release_groups (
+        musicbrainz_id,
+        title,
+        artist_credit,
+        primary_type,
+        first_release_year
+    )
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: GetRelease :one
+SELECT * FROM music.releases WHERE id = $1;
+
+-- name: GetReleaseByMusicBrainzID :one
+SELECT * FROM music.releases WHERE musicbrainz_id = $1;
+
+-- name: FindRelease :one
+-- Releases without a MusicBrainz ID are matched by title and album artist.
+SELECT *
+FROM music.releases
+WHERE
+    lower(title) = lower(@title)
+    AND lower(artist_credit) = lower(@artist_credit)
+    AND musicbrainz_id IS NULL
+ORDER BY created_at ASC
+LIMIT 1;
+
+-- name: CreateRelease :one
+INSERT INTO
+    music.releases (
+        release_group_id,
+        musicbrainz_id,
+        title,
+        sort_title,
+        artist_credit,
+        is_compilation,
+        year,
+        release_date,
+        label,
+        catalog_number,
+        country,
+        genres,
+        replaygain_gain,
+        replaygain_peak,
+        cover_path
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    ) RETURNING *;
+
+-- name: UpdateReleaseCover :one
+UPDATE music.releases SET cover_path = $2 WHERE id = $1 RETURNING *;
+
+-- name: RefreshReleaseStats :one
+-- Recomputes the denormalized disc, track and duration totals.
+UPDATE music.releases r
+SET
+    disc_count = GREATEST(
+        COALESCE(
+            (
+                SELECT MAX(t.disc_number)
+                FROM music.tracks t
+                WHERE
+                    t.release_id = r.id
+            ),
+            1
+        ),
+        1
+    ),
+    track_count = (
+        SELECT COUNT(*)
+        FROM music.tracks t
+        WHERE
+            t.release_id = r.id
+    ),
+    duration_ms = (
+        SELECT COALESCE(SUM(t.duration_ms), 0)::BIGINT
+        FROM music.tracks t
+        WHERE
+            t.release_id = r.id
+    )
+WHERE
+    r.id = $1 RETURNING r.*;
+
+-- name: ListReleases :many
+-- restrict limits the result to releases with a track under library_paths.
+SELECT r.*
+FROM music.releases r
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY
+    CASE
+        WHEN @order_by::text = 'title' THEN r.sort_title
+    END ASC,
+    CASE
+        WHEN @order_by::text = 'year' THEN r.year
+    END DESC NULLS LAST,
+    r.created_at DESC,
+    r.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountReleases :one
+SELECT COUNT(*)
+FROM music.releases r
+WHERE (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    );
+
+-- name: ListReleasesByArtist :many
+-- restrict limits the result to releases with a track under library_paths.
+SELECT r.*
+FROM music.releases r
+    JOIN music.release_artists ra ON ra.release_id = r.id
+WHERE
+    ra.artist_id = @artist_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY r.year ASC NULLS LAST, r.sort_title ASC;
+
+-- name: ListReleasesByReleaseGroup :many
+-- restrict limits the result to releases with a track under library_paths.
+SELECT r.*
+FROM music.releases r
+WHERE
+    r.release_group_id = @release_group_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM music.tracks t
+                CROSS JOIN unnest(@library_paths::text[]) AS lp(path)
+            WHERE t.release_id = r.id AND starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY r.year ASC NULLS LAST, r.created_at ASC;
+
+-- name: AddReleaseArtist :exec
+INSERT INTO
+    music.release_artists (
+        release_id,
+        artist_id,
+        position
+    )
+VALUES ($1, $2, $3) ON CONFLICT (release_id, artist_id) DO NOTHING;
+
+-- name: DeleteEmptyReleases :execrows
+DELETE FROM music.releases r
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM music.tracks t
+        WHERE
+            t.release_id = r.id
+    );
+
+-- name: DeleteEmptyReleaseGroups :execrows
+DELETE FROM music.release_groups g
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM music.releases r
+        WHERE
+            r.release_group_id = g.id
+    );
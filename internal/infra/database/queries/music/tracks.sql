@@ -0,0 +1,104 @@
+-- name: GetTrack :one
+SELECT * FROM music.tracks WHERE id = $1;
+
+-- name: GetTrackByPath :one
+SELECT * FROM music.tracks WHERE file_path = $1;
+
+-- name: UpsertTrack :one
+-- Tracks are keyed by file path so rescans update tags in place.
+INSERT INTO
+    music.tracks (
+        release_id,
+        musicbrainz_recording_id,
+        musicbrainz_track_id,
+        isrc,
+        title,
+        artist_credit,
+        disc_number,
+        disc_subtitle,
+        track_number,
+        duration_ms,
+        replaygain_gain,
+        replaygain_peak,
+        file_path,
+        file_size,
+        container
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15
+    )
+ON CONFLICT (file_path) DO
+UPDATE
+SET
+    release_id = EXCLUDED.release_id,
+    musicbrainz_recording_id = EXCLUDED.musicbrainz_recording_id,
+    musicbrainz_track_id = EXCLUDED.musicbrainz_track_id,
+    isrc = EXCLUDED.isrc,
+    title = EXCLUDED.title,
+    artist_credit = EXCLUDED.artist_credit,
+    disc_number = EXCLUDED.disc_number,
+    disc_subtitle = EXCLUDED.disc_subtitle,
+    track_number = EXCLUDED.track_number,
+    duration_ms = EXCLUDED.duration_ms,
+    replaygain_gain = EXCLUDED.replaygain_gain,
+    replaygain_peak = EXCLUDED.replaygain_peak,
+    file_size = EXCLUDED.file_size,
+    container = EXCLUDED.container RETURNING *;
+
+-- name: ListTracksByRelease :many
+SELECT *
+FROM music.tracks
+WHERE
+    release_id = $1
+ORDER BY
+    disc_number ASC,
+    track_number ASC NULLS LAST,
+    title ASC;
+
+-- name: ListTracksByArtist :many
+-- restrict limits the result to tracks under library_paths.
+SELECT t.*
+FROM music.tracks t
+    JOIN music.track_artists ta ON ta.track_id = t.id
+WHERE
+    ta.artist_id = @artist_id
+    AND (
+        @restrict::bool = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM unnest(@library_paths::text[]) AS lp(path)
+            WHERE starts_with(t.file_path, lp.path)
+        )
+    )
+ORDER BY t.title ASC
+LIMIT sqlc.arg('limit')
+OFFSET
+    sqlc.arg('offset');
+
+-- name: CountTracks :one
+SELECT COUNT(*) FROM music.tracks;
+
+-- name: AddTrackArtist :exec
+INSERT INTO
+    music.track_artists (track_id, artist_id, position)
+VALUES ($1, $2, $3) ON CONFLICT (track_id, artist_id) DO NOTHING;
+
+-- name: DeleteTrackArtists :exec
+DELETE FROM music.track_artists WHERE track_id = $1;
+
+-- name: DeleteTrack :exec
+DELETE FROM music.tracks WHERE id = $1;
@@ -0,0 +1,89 @@
+-- name: GetArtist :one
+SELECT sqlc.embed(a), (
+        SELECT COUNT(*)
+        FROM musicvideo.videos v
+        WHERE
+            v.artist_id = a.id
+    ) AS video_count
+FROM musicvideo.artists a
+WHERE
+    a.id = $1;
+
+-- name: GetArtistByMusicBrainzID :one
+SELECT *
+FROM musicvideo.artists
+WHERE
+    library_id = $1
+    AND musicbrainz_artist_id = $2;
+
+-- name: UpsertArtist :one
+-- Artists are keyed by their normalized name so every spelling of a name
+-- in tags and file names files under one artist. An existing artist keeps
+-- its name, which may come from MusicBrainz.
+INSERT INTO
+    musicvideo.artists (
+        library_id,
+        name,
+        name_key,
+        sort_name
+    )
+VALUES ($1, $2, $3, $4) ON CONFLICT (library_id, name_key) DO
+UPDATE
+SET
+    name_key = EXCLUDED.name_key RETURNING *;
+
+-- name: SetArtistMusicBrainz :one
+UPDATE musicvideo.artists
+SET
+    musicbrainz_artist_id = $2,
+    name = $3,
+    sort_name = $4,
+    disambiguation = $5
+WHERE
+    id = $1 RETURNING *;
+
+-- name: ListArtists :many
+-- Lists artists by sort name with the number of their videos. restrict
+-- limits the result to library_ids, the libraries the user may access.
+SELECT sqlc.embed(a), (
+        SELECT COUNT(*)
+        FROM musicvideo.videos v
+        WHERE
+            v.artist_id = a.id
+    ) AS video_count
+FROM musicvideo.artists a
+WHERE (
+        @restrict::bool = FALSE
+        OR a.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR a.library_id = sqlc.narg('library_id')::uuid
+    )
+ORDER BY LOWER(a.sort_name) ASC, a.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountArtists :one
+SELECT COUNT(*)
+FROM musicvideo.artists a
+WHERE (
+        @restrict::bool = FALSE
+        OR a.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR a.library_id = sqlc.narg('library_id')::uuid
+    );
+
+-- name: DeleteOrphanArtists :execrows
+-- Removes artists left without videos after rescans and matches.
+DELETE FROM musicvideo.artists a
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM musicvideo.videos v
+        WHERE
+            v.artist_id = a.id
+    );
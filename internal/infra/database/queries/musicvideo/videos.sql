@@ -0,0 +1,171 @@
+-- name: GetVideo :one
+SELECT sqlc.embed(v), a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE
+    v.id = $1;
+
+-- name: GetVideoByPath :one
+SELECT sqlc.embed(v), a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE
+    v.file_path = $1;
+
+-- name: UpsertVideo :one
+-- Videos are keyed by their file so rescans update them in place.
+INSERT INTO
+    musicvideo.videos (
+        library_id,
+        artist_id,
+        file_path,
+        file_size,
+        container,
+        title,
+        title_source,
+        sort_title,
+        artist_credit,
+        year,
+        duration_seconds,
+        width,
+        height,
+        video_codec,
+        audio_codec,
+        musicbrainz_recording_id,
+        match_status,
+        matched_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18
+    ) ON CONFLICT (file_path) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    artist_id = EXCLUDED.artist_id,
+    file_size = EXCLUDED.file_size,
+    container = EXCLUDED.container,
+    title = EXCLUDED.title,
+    title_source = EXCLUDED.title_source,
+    sort_title = EXCLUDED.sort_title,
+    artist_credit = EXCLUDED.artist_credit,
+    year = EXCLUDED.year,
+    duration_seconds = EXCLUDED.duration_seconds,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    video_codec = EXCLUDED.video_codec,
+    audio_codec = EXCLUDED.audio_codec,
+    musicbrainz_recording_id = EXCLUDED.musicbrainz_recording_id,
+    match_status = EXCLUDED.match_status,
+    matched_at = EXCLUDED.matched_at RETURNING *;
+
+-- name: SetVideoMatch :one
+-- Stores a MusicBrainz match; its title and credit replace what the scan
+-- read.
+UPDATE musicvideo.videos
+SET
+    artist_id = $2,
+    title = $3,
+    title_source = 'musicbrainz',
+    sort_title = $4,
+    artist_credit = $5,
+    year = $6,
+    musicbrainz_recording_id = $7,
+    match_status = 'matched',
+    matched_at = NOW()
+WHERE
+    id = $1 RETURNING *;
+
+-- name: SetVideoUnmatched :exec
+UPDATE musicvideo.videos
+SET
+    match_status = 'unmatched',
+    matched_at = NOW()
+WHERE
+    id = $1;
+
+-- name: ListPendingVideos :many
+-- Lists videos waiting for a MusicBrainz match, oldest first.
+SELECT sqlc.embed(v), a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE
+    v.match_status = 'pending'
+ORDER BY v.created_at ASC, v.id ASC
+LIMIT $1;
+
+-- name: ListVideos :many
+-- Lists videos by artist, by title, newest added first or newest released
+-- first; videos of an artist follow by release year. restrict limits the
+-- result to library_ids, the libraries the user may access.
+SELECT sqlc.embed(v), a.name AS artist_name
+FROM musicvideo.videos v
+    JOIN musicvideo.artists a ON a.id = v.artist_id
+WHERE (
+        @restrict::bool = FALSE
+        OR v.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR v.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        sqlc.narg('artist_id')::uuid IS NULL
+        OR v.artist_id = sqlc.narg('artist_id')::uuid
+    )
+ORDER BY
+    CASE
+        WHEN @sort::text = 'artist' THEN LOWER(a.sort_name)
+    END ASC,
+    CASE
+        WHEN @sort::text = 'added' THEN v.created_at
+    END DESC,
+    CASE
+        WHEN @sort::text = 'year' THEN v.year
+    END DESC NULLS LAST,
+    CASE
+        WHEN @sort::text = 'artist' THEN v.year
+    END ASC NULLS LAST,
+    v.sort_title ASC,
+    v.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountVideos :one
+SELECT COUNT(*)
+FROM musicvideo.videos v
+WHERE (
+        @restrict::bool = FALSE
+        OR v.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR v.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        sqlc.narg('artist_id')::uuid IS NULL
+        OR v.artist_id = sqlc.narg('artist_id')::uuid
+    );
+
+-- name: ListVideoPaths :many
+SELECT id, file_path FROM musicvideo.videos WHERE library_id = $1;
+
+-- name: DeleteVideo :exec
+DELETE FROM musicvideo.videos WHERE id = $1;
@@ -0,0 +1,224 @@
+-- name: GetAlbum :one
+SELECT
+    sqlc.embed(a),
+    (
+        SELECT COUNT(*)
+        FROM photo.album_photos ap
+        WHERE
+            ap.album_id = a.id
+    ) AS photo_count,
+    first_photo.photo_id AS first_photo_id
+FROM photo.albums a
+    LEFT JOIN LATERAL (
+        SELECT ap.photo_id
+        FROM photo.album_photos ap
+            JOIN photo.photos p ON p.id = ap.photo_id
+        WHERE
+            ap.album_id = a.id
+        ORDER BY ap.position ASC, p.taken_at DESC
+        LIMIT 1
+    ) first_photo ON TRUE
+WHERE
+    a.id = $1;
+
+-- name: UpsertFolderAlbum :one
+INSERT INTO
+    photo.albums (
+        library_id,
+        kind,
+        title,
+        folder_path
+    )
+VALUES ($1, 'folder', $2, $3) ON CONFLICT (library_id, folder_path)
+WHERE
+    folder_path IS NOT NULL DO
+UPDATE
+SET
+    title = EXCLUDED.title RETURNING *;
+
+-- name: CreateAlbum :one
+INSERT INTO
+    photo.albums (
+        library_id,
+        kind,
+        title,
+        description,
+        owner_id
+    )
+VALUES ($1, 'user', $2, $3, $4) RETURNING *;
+
+-- name: UpdateAlbum :one
+UPDATE photo.albums
+SET
+    title = $2,
+    description = $3,
+    cover_photo_id = $4
+WHERE
+    id = $1 RETURNING *;
+
+-- name: DeleteAlbum :exec
+DELETE FROM photo.albums WHERE id = $1;
+
+-- name: ListAlbums :many
+-- Lists the folder albums and the user albums the user owns or was shared,
+-- user albums first. restrict limits the result to library_ids.
+SELECT
+    sqlc.embed(a),
+    (
+        SELECT COUNT(*)
+        FROM photo.album_photos ap
+        WHERE
+            ap.album_id = a.id
+    ) AS photo_count,
+    first_photo.photo_id AS first_photo_id
+FROM photo.albums a
+    LEFT JOIN LATERAL (
+        SELECT ap.photo_id
+        FROM photo.album_photos ap
+            JOIN photo.photos p ON p.id = ap.photo_id
+        WHERE
+            ap.album_id = a.id
+        ORDER BY ap.position ASC, p.taken_at DESC
+        LIMIT 1
+    ) first_photo ON TRUE
+WHERE (
+        @restrict::bool = FALSE
+        OR a.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR a.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        @kind::text = ''
+        OR a.kind = @kind::text
+    )
+    AND (
+        a.kind = 'folder'
+        OR a.owner_id = @user_id::uuid
+        OR EXISTS (
+            SELECT 1
+            FROM photo.album_shares s
+            WHERE
+                s.album_id = a.id
+                AND s.user_id = @user_id::uuid
+        )
+    )
+ORDER BY
+    CASE
+        WHEN a.kind = 'user' THEN 0
+        ELSE 1
+    END ASC,
+    lower(a.title) ASC,
+    a.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountAlbums :one
+SELECT COUNT(*)
+FROM photo.albums a
+WHERE (
+        @restrict::bool = FALSE
+        OR a.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR a.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        @kind::text = ''
+        OR a.kind = @kind::text
+    )
+    AND (
+        a.kind = 'folder'
+        OR a.owner_id = @user_id::uuid
+        OR EXISTS (
+            SELECT 1
+            FROM photo.album_shares s
+            WHERE
+                s.album_id = a.id
+                AND s.user_id = @user_id::uuid
+        )
+    );
+
+-- name: DeleteEmptyFolderAlbums :execrows
+-- Removes folder albums whose folder no longer holds photos.
+DELETE FROM photo.albums a
+WHERE
+    a.kind = 'folder'
+    AND NOT EXISTS (
+        SELECT 1
+        FROM photo.album_photos ap
+        WHERE
+            ap.album_id = a.id
+    );
+
+-- name: ListAlbumPhotos :many
+SELECT p.*
+FROM photo.photos p
+    JOIN photo.album_photos ap ON ap.photo_id = p.id
+WHERE
+    ap.album_id = $1
+ORDER BY ap.position ASC, p.taken_at DESC, p.id ASC
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: AddAlbumPhotos :execrows
+-- Appends photos to an album in the given order; photos already in the
+-- album keep their position.
+INSERT INTO
+    photo.album_photos (
+        album_id,
+        photo_id,
+        position,
+        added_by
+    )
+SELECT @album_id::uuid, photo_ids.id, (
+        SELECT COALESCE(MAX(position), 0)
+        FROM photo.album_photos
+        WHERE
+            album_id = @album_id::uuid
+    ) + photo_ids.ord::integer, sqlc.narg('added_by')::uuid
+FROM unnest(@photo_ids::uuid[]) WITH ORDINALITY AS photo_ids (id, ord) ON CONFLICT (album_id, photo_id) DO NOTHING;
+
+-- name: AddFolderAlbumPhoto :exec
+-- Files a photo under its folder album and takes it out of the album of
+-- the folder it was in before.
+WITH
+    moved AS (
+        DELETE FROM photo.album_photos ap USING photo.albums a
+        WHERE
+            ap.album_id = a.id
+            AND a.kind = 'folder'
+            AND ap.photo_id = @photo_id::uuid
+            AND ap.album_id <> @album_id::uuid
+    )
+INSERT INTO
+    photo.album_photos (album_id, photo_id)
+VALUES (@album_id::uuid, @photo_id::uuid) ON CONFLICT (album_id, photo_id) DO NOTHING;
+
+-- name: RemoveAlbumPhoto :execrows
+DELETE FROM photo.album_photos WHERE album_id = $1 AND photo_id = $2;
+
+-- name: ListAlbumShares :many
+SELECT * FROM photo.album_shares WHERE album_id = $1 ORDER BY created_at ASC;
+
+-- name: GetAlbumShare :one
+SELECT * FROM photo.album_shares WHERE album_id = $1 AND user_id = $2;
+
+-- name: UpsertAlbumShare :one
+INSERT INTO
+    photo.album_shares (
+        album_id,
+        user_id,
+        can_contribute
+    )
+VALUES ($1, $2, $3) ON CONFLICT (album_id, user_id) DO
+UPDATE
+SET
+    can_contribute = EXCLUDED.can_contribute RETURNING *;
+
+-- name: DeleteAlbumShare :execrows
+DELETE FROM photo.album_shares WHERE album_id = $1 AND user_id = $2;
@@ -0,0 +1,200 @@
+-- name: GetPhoto :one
+SELECT * FROM photo.photos WHERE id = $1;
+
+-- name: GetPhotoByPath :one
+SELECT * FROM photo.photos WHERE file_path = $1;
+
+-- name: UpsertPhoto :one
+-- Photos are keyed by their file so rescans update them in place. The
+-- placeholder is kept unless the file changed.
+INSERT INTO
+    photo.photos (
+        library_id,
+        file_path,
+        file_size,
+        folder,
+        kind,
+        format,
+        taken_at,
+        taken_at_source,
+        width,
+        height,
+        orientation,
+        camera_make,
+        camera_model,
+        lens_model,
+        exposure_time,
+        f_number,
+        iso,
+        focal_length,
+        latitude,
+        longitude,
+        altitude,
+        duration_seconds
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18,
+        $19,
+        $20,
+        $21,
+        $22
+    ) ON CONFLICT (file_path) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    folder = EXCLUDED.folder,
+    kind = EXCLUDED.kind,
+    format = EXCLUDED.format,
+    taken_at = EXCLUDED.taken_at,
+    taken_at_source = EXCLUDED.taken_at_source,
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    orientation = EXCLUDED.orientation,
+    camera_make = EXCLUDED.camera_make,
+    camera_model = EXCLUDED.camera_model,
+    lens_model = EXCLUDED.lens_model,
+    exposure_time = EXCLUDED.exposure_time,
+    f_number = EXCLUDED.f_number,
+    iso = EXCLUDED.iso,
+    focal_length = EXCLUDED.focal_length,
+    latitude = EXCLUDED.latitude,
+    longitude = EXCLUDED.longitude,
+    altitude = EXCLUDED.altitude,
+    duration_seconds = EXCLUDED.duration_seconds,
+    blurhash = CASE
+        WHEN photo.photos.file_size = EXCLUDED.file_size THEN photo.photos.blurhash
+    END,
+    dominant_color = CASE
+        WHEN photo.photos.file_size = EXCLUDED.file_size THEN photo.photos.dominant_color
+    END,
+    file_size = EXCLUDED.file_size RETURNING *;
+
+-- name: SetPhotoPlaceholder :exec
+UPDATE photo.photos
+SET
+    blurhash = $2,
+    dominant_color = $3
+WHERE
+    id = $1;
+
+-- name: ListPhotos :many
+-- Lists photos newest first, or oldest first. restrict limits the result
+-- to library_ids, the libraries the user may access.
+SELECT *
+FROM photo.photos
+WHERE (
+        @restrict::bool = FALSE
+        OR library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        @kind::text = ''
+        OR kind = @kind::text
+    )
+    AND (
+        sqlc.narg('taken_after')::timestamptz IS NULL
+        OR taken_at >= sqlc.narg('taken_after')::timestamptz
+    )
+    AND (
+        sqlc.narg('taken_before')::timestamptz IS NULL
+        OR taken_at < sqlc.narg('taken_before')::timestamptz
+    )
+ORDER BY
+    CASE
+        WHEN @oldest_first::bool THEN taken_at
+    END ASC,
+    taken_at DESC,
+    id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountPhotos :one
+SELECT COUNT(*)
+FROM photo.photos
+WHERE (
+        @restrict::bool = FALSE
+        OR library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        @kind::text = ''
+        OR kind = @kind::text
+    )
+    AND (
+        sqlc.narg('taken_after')::timestamptz IS NULL
+        OR taken_at >= sqlc.narg('taken_after')::timestamptz
+    )
+    AND (
+        sqlc.narg('taken_before')::timestamptz IS NULL
+        OR taken_at < sqlc.narg('taken_before')::timestamptz
+    );
+
+-- name: ListTimeline :many
+-- Groups photos by day or month of capture, newest first, with the
+-- latest photo of each group as its cover.
+SELECT
+    date_trunc(@granularity::text, taken_at)::timestamptz AS bucket,
+    COUNT(*) AS photo_count,
+    (
+        array_agg(
+            id
+            ORDER BY taken_at DESC
+        )
+    ) [1]::uuid AS cover_photo_id
+FROM photo.photos
+WHERE (
+        @restrict::bool = FALSE
+        OR library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        @kind::text = ''
+        OR kind = @kind::text
+    )
+GROUP BY
+    bucket
+ORDER BY bucket DESC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: ListPhotoPaths :many
+SELECT id, file_path FROM photo.photos WHERE library_id = $1;
+
+-- name: DeletePhoto :exec
+DELETE FROM photo.photos WHERE id = $1;
+
+-- name: CountPhotosInLibrary :one
+-- Counts the given photos that belong to a library.
+SELECT COUNT(*)
+FROM photo.photos
+WHERE
+    library_id = $1
+    AND id = ANY(@photo_ids::uuid[]);
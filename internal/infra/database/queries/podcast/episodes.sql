@@ -0,0 +1,151 @@
+-- name: GetEpisode :one
+SELECT * FROM podcast.episodes WHERE id = $1;
+
+-- name: UpsertEpisode :one
+-- Episodes are keyed by their feed guid so refreshes update them in place.
+-- Download state is left alone; a changed enclosure is picked up by the
+-- next download.
+INSERT INTO
+    podcast.episodes (
+        podcast_id,
+        guid,
+        title,
+        description,
+        link,
+        image_url,
+        published_at,
+        duration_seconds,
+        season,
+        episode_number,
+        episode_type,
+        explicit,
+        enclosure_url,
+        enclosure_type,
+        enclosure_length,
+        chapters_url,
+        chapters,
+        transcripts
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18
+    )
+ON CONFLICT (podcast_id, guid) DO
+UPDATE
+SET
+    title = EXCLUDED.title,
+    description = EXCLUDED.description,
+    link = EXCLUDED.link,
+    image_url = EXCLUDED.image_url,
+    published_at = EXCLUDED.published_at,
+    duration_seconds = EXCLUDED.duration_seconds,
+    season = EXCLUDED.season,
+    episode_number = EXCLUDED.episode_number,
+    episode_type = EXCLUDED.episode_type,
+    explicit = EXCLUDED.explicit,
+    enclosure_url = EXCLUDED.enclosure_url,
+    enclosure_type = EXCLUDED.enclosure_type,
+    enclosure_length = EXCLUDED.enclosure_length,
+    chapters_url = EXCLUDED.chapters_url,
+    chapters = COALESCE(EXCLUDED.chapters, episodes.chapters),
+    transcripts = EXCLUDED.transcripts RETURNING *;
+
+-- name: ListEpisodeGUIDs :many
+SELECT guid FROM podcast.episodes WHERE podcast_id = $1;
+
+-- name: ListEpisodes :many
+-- Lists a podcast's episodes, newest first.
+SELECT *
+FROM podcast.episodes
+WHERE
+    podcast_id = $1
+ORDER BY published_at DESC NULLS LAST, created_at DESC, id ASC
+LIMIT $2
+OFFSET
+    $3;
+
+-- name: CountEpisodes :one
+SELECT COUNT(*) FROM podcast.episodes WHERE podcast_id = $1;
+
+-- name: ListDownloadedEpisodes :many
+SELECT *
+FROM podcast.episodes
+WHERE
+    podcast_id = $1
+    AND file_path IS NOT NULL
+ORDER BY published_at DESC NULLS LAST, created_at DESC;
+
+-- name: ListNewEpisodes :many
+-- Unplayed episodes of the podcasts a user subscribes to, newest first.
+SELECT
+    sqlc.embed(e),
+    sqlc.embed(p)
+FROM podcast.episodes e
+    JOIN podcast.podcasts p ON p.id = e.podcast_id
+    JOIN podcast.subscriptions s ON s.podcast_id = e.podcast_id
+    AND s.user_id = $1
+    LEFT JOIN podcast.episode_progress ep ON ep.episode_id = e.id
+    AND ep.user_id = $1
+WHERE
+    COALESCE(ep.is_played, FALSE) = FALSE
+ORDER BY e.published_at DESC NULLS LAST, e.created_at DESC
+LIMIT $2;
+
+-- name: ListLatestEpisodes :many
+-- Episodes of the podcasts in a library, newest first.
+SELECT
+    sqlc.embed(e),
+    sqlc.embed(p)
+FROM podcast.episodes e
+    JOIN podcast.podcasts p ON p.id = e.podcast_id
+WHERE
+    p.library_id = $1
+ORDER BY e.published_at DESC NULLS LAST, e.created_at DESC
+LIMIT $2;
+
+-- name: SetEpisodeDownloaded :one
+UPDATE podcast.episodes
+SET
+    file_path = $2,
+    file_size = $3,
+    chapters = COALESCE(sqlc.narg ('chapters'), chapters),
+    transcript_path = $4,
+    transcript_type = $5,
+    downloaded_at = NOW(),
+    download_error = NULL
+WHERE
+    id = $1 RETURNING *;
+
+-- name: SetEpisodeDownloadError :exec
+UPDATE podcast.episodes SET download_error = $2 WHERE id = $1;
+
+-- name: ClearEpisodeDownload :exec
+UPDATE podcast.episodes
+SET
+    file_path = NULL,
+    file_size = 0,
+    transcript_path = NULL,
+    transcript_type = NULL,
+    downloaded_at = NULL,
+    pinned = FALSE
+WHERE
+    id = $1;
+
+-- name: SetEpisodePinned :exec
+UPDATE podcast.episodes SET pinned = $2 WHERE id = $1;
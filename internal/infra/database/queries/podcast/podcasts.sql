@@ -0,0 +1,177 @@
+-- name: GetPodcast :one
+SELECT * FROM podcast.podcasts WHERE id = $1;
+
+-- name: GetPodcastByFeedURL :one
+SELECT * FROM podcast.podcasts WHERE feed_url = $1;
+
+-- name: CreatePodcast :one
+INSERT INTO
+    podcast.podcasts (
+        library_id,
+        feed_url,
+        title,
+        sort_title,
+        author,
+        description,
+        link,
+        image_url,
+        language,
+        explicit,
+        categories,
+        podcast_guid,
+        etag,
+        last_modified,
+        last_checked_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        NOW()
+    ) RETURNING *;
+
+-- name: UpdatePodcastFeed :one
+-- Stores the feed metadata and validators of a successful fetch.
+UPDATE podcast.podcasts
+SET
+    title = $2,
+    sort_title = $3,
+    author = $4,
+    description = $5,
+    link = $6,
+    image_url = $7,
+    language = $8,
+    explicit = $9,
+    categories = $10,
+    podcast_guid = $11,
+    etag = $12,
+    last_modified = $13,
+    last_checked_at = NOW(),
+    last_error = NULL
+WHERE
+    id = $1 RETURNING *;
+
+-- name: MarkPodcastChecked :exec
+-- Records a fetch that returned no new feed: unchanged, or failed with
+-- last_error set.
+UPDATE podcast.podcasts
+SET
+    last_checked_at = NOW(),
+    last_error = $2
+WHERE
+    id = $1;
+
+-- name: UpdatePodcastSettings :one
+UPDATE podcast.podcasts
+SET
+    auto_download = $2,
+    keep_episodes = $3
+WHERE
+    id = $1 RETURNING *;
+
+-- name: ListPodcasts :many
+-- Lists podcasts by title, optionally only those a user subscribes to or
+-- those of a library.
+SELECT p.*
+FROM podcast.podcasts p
+WHERE (
+        sqlc.narg ('subscribed_by')::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM podcast.subscriptions s
+            WHERE
+                s.podcast_id = p.id
+                AND s.user_id = sqlc.narg ('subscribed_by')::uuid
+        )
+    )
+    AND (
+        sqlc.narg ('library_id')::uuid IS NULL
+        OR p.library_id = sqlc.narg ('library_id')::uuid
+    )
+    AND (
+        @query::text = ''
+        OR p.title ILIKE '%' || @query::text || '%'
+        OR p.author ILIKE '%' || @query::text || '%'
+    )
+ORDER BY p.sort_title ASC, p.id ASC
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountPodcasts :one
+SELECT COUNT(*)
+FROM podcast.podcasts p
+WHERE (
+        sqlc.narg ('subscribed_by')::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM podcast.subscriptions s
+            WHERE
+                s.podcast_id = p.id
+                AND s.user_id = sqlc.narg ('subscribed_by')::uuid
+        )
+    )
+    AND (
+        sqlc.narg ('library_id')::uuid IS NULL
+        OR p.library_id = sqlc.narg ('library_id')::uuid
+    )
+    AND (
+        @query::text = ''
+        OR p.title ILIKE '%' || @query::text || '%'
+        OR p.author ILIKE '%' || @query::text || '%'
+    );
+
+-- name: ListSubscribedPodcasts :many
+-- Podcasts with at least one subscriber, optionally of one library. These
+-- are the feeds that get refreshed.
+SELECT p.*
+FROM podcast.podcasts p
+WHERE
+    EXISTS (
+        SELECT 1
+        FROM podcast.subscriptions s
+        WHERE
+            s.podcast_id = p.id
+    )
+    AND (
+        sqlc.narg ('library_id')::uuid IS NULL
+        OR p.library_id = sqlc.narg ('library_id')::uuid
+    )
+ORDER BY p.last_checked_at ASC NULLS FIRST;
+
+-- name: DeletePodcast :exec
+DELETE FROM podcast.podcasts WHERE id = $1;
+
+-- name: Subscribe :exec
+INSERT INTO
+    podcast.subscriptions (user_id, podcast_id)
+VALUES ($1, $2) ON CONFLICT (user_id, podcast_id) DO NOTHING;
+
+-- name: Unsubscribe :execrows
+DELETE FROM podcast.subscriptions
+WHERE
+    user_id = $1
+    AND podcast_id = $2;
+
+-- name: IsSubscribed :one
+SELECT EXISTS (
+        SELECT 1
+        FROM podcast.subscriptions
+        WHERE
+            user_id = $1
+            AND podcast_id = $2
+    );
+
+-- name: ListSubscriberIDs :many
+SELECT user_id FROM podcast.subscriptions WHERE podcast_id = $1;
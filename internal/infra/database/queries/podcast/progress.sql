@@ -0,0 +1,68 @@
+-- name: UpsertEpisodeProgress :one
+INSERT INTO
+    podcast.episode_progress (
+        user_id,
+        episode_id,
+        position_seconds,
+        is_played,
+        played_at
+    )
+VALUES (
+        @user_id,
+        @episode_id,
+        @position_seconds,
+        @is_played::boolean,
+        CASE WHEN @is_played::boolean THEN NOW() END
+    ) ON CONFLICT (user_id, episode_id) DO
+UPDATE
+SET
+    position_seconds = EXCLUDED.position_seconds,
+    is_played = EXCLUDED.is_played,
+    played_at = CASE
+        WHEN EXCLUDED.is_played
+        AND NOT episode_progress.is_played THEN NOW()
+        WHEN EXCLUDED.is_played THEN episode_progress.played_at
+    END,
+    last_played_at = NOW() RETURNING *;
+
+-- name: GetEpisodeProgress :one
+SELECT *
+FROM podcast.episode_progress
+WHERE
+    user_id = $1
+    AND episode_id = $2;
+
+-- name: ListEpisodeProgress :many
+-- Returns a user's listening state for a page of episodes.
+SELECT *
+FROM podcast.episode_progress
+WHERE
+    user_id = $1
+    AND episode_id = ANY (@episode_ids::uuid[]);
+
+-- name: DeleteEpisodeProgress :exec
+DELETE FROM podcast.episode_progress
+WHERE
+    user_id = $1
+    AND episode_id = $2;
+
+-- name: ListContinueListening :many
+-- Returns the episodes a user started but did not finish, most recently
+-- played first. restrict limits the result to library_ids.
+SELECT
+    sqlc.embed(e),
+    sqlc.embed(p),
+    sqlc.embed(ep)
+FROM podcast.episode_progress ep
+    JOIN podcast.episodes e ON e.id = ep.episode_id
+    JOIN podcast.podcasts p ON p.id = e.podcast_id
+WHERE
+    ep.user_id = @user_id
+    AND NOT ep.is_played
+    AND ep.position_seconds > 0
+    AND (
+        @restrict::bool = FALSE
+        OR p.library_id = ANY(@library_ids::uuid[])
+    )
+ORDER BY ep.last_played_at DESC
+LIMIT $1;
@@ -0,0 +1,55 @@
+-- name: GetUserAccess :one
+-- Returns whether a user was granted QAR access (legacy:read scope).
+SELECT
+    username,
+    COALESCE(qar_enabled, FALSE)::bool AS qar_enabled
+FROM shared.users
+WHERE
+    id = $1
+    AND deleted_at IS NULL;
+
+-- name: GetUserPIN :one
+SELECT * FROM qar.user_pins WHERE user_id = $1;
+
+-- name: UpsertUserPIN :one
+-- Setting a new PIN locks the content again and clears failed attempts.
+INSERT INTO
+    qar.user_pins (user_id, pin_hash)
+VALUES ($1, $2) ON CONFLICT (user_id) DO
+UPDATE
+SET
+    pin_hash = EXCLUDED.pin_hash,
+    unlocked_until = NULL,
+    pin_failed_attempts = 0,
+    pin_locked_until = NULL RETURNING *;
+
+-- name: SetUserPINUnlockedUntil :one
+UPDATE qar.user_pins
+SET
+    unlocked_until = $2
+WHERE
+    user_id = $1 RETURNING *;
+
+-- name: RecordUserPINFailure :one
+-- Counts a wrong PIN. Reaching max_attempts locks PIN entry until
+-- locked_until and starts a new count.
+UPDATE qar.user_pins
+SET
+    pin_failed_attempts = CASE
+        WHEN pin_failed_attempts + 1 >= @max_attempts::int THEN 0
+        ELSE pin_failed_attempts + 1
+    END,
+    pin_locked_until = CASE
+        WHEN pin_failed_attempts + 1 >= @max_attempts::int THEN @locked_until::timestamptz
+        ELSE pin_locked_until
+    END
+WHERE
+    user_id = @user_id RETURNING *;
+
+-- name: ClearUserPINFailures :exec
+UPDATE qar.user_pins
+SET
+    pin_failed_attempts = 0,
+    pin_locked_until = NULL
+WHERE
+    user_id = $1;
@@ -0,0 +1,73 @@
+-- name: GetPerformer :one
+SELECT * FROM qar.performers WHERE id = $1;
+
+-- name: UpsertPerformer :one
+-- Performers are keyed by their StashDB ID and refreshed on every match.
+INSERT INTO
+    qar.performers (
+        stashdb_id,
+        gender,
+        country,
+        name_enc,
+        disambiguation_enc,
+        aliases_enc,
+        image_url_enc
+    )
+VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (stashdb_id) DO
+UPDATE
+SET
+    gender = EXCLUDED.gender,
+    country = EXCLUDED.country,
+    name_enc = EXCLUDED.name_enc,
+    disambiguation_enc = EXCLUDED.disambiguation_enc,
+    aliases_enc = EXCLUDED.aliases_enc,
+    image_url_enc = EXCLUDED.image_url_enc RETURNING *;
+
+-- name: ListPerformers :many
+-- Lists performers with scenes in the accessible libraries, those with
+-- most scenes first. Names are encrypted, so they cannot be sorted here.
+SELECT p.*, COUNT(s.id) AS scene_count
+FROM
+    qar.performers p
+    JOIN qar.scene_performers sp ON sp.performer_id = p.id
+    JOIN qar.scenes s ON s.id = sp.scene_id
+WHERE (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    )
+GROUP BY
+    p.id
+ORDER BY scene_count DESC, p.id
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountPerformers :one
+SELECT COUNT(DISTINCT sp.performer_id)
+FROM qar.scene_performers sp
+    JOIN qar.scenes s ON s.id = sp.scene_id
+WHERE (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    );
+
+-- name: CountPerformerScenes :one
+SELECT COUNT(*)
+FROM qar.scene_performers sp
+    JOIN qar.scenes s ON s.id = sp.scene_id
+WHERE
+    sp.performer_id = $1
+    AND (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    );
+
+-- name: DeleteOrphanPerformers :execrows
+DELETE FROM qar.performers p
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM qar.scene_performers sp
+        WHERE
+            sp.performer_id = p.id
+    );
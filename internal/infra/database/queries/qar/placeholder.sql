@@ -1,6 +0,0 @@
--- Placeholder query for adult content module (v0.3.0+)
--- This minimal query is here to satisfy sqlc's requirement for non-empty query directories
--- Real adult content queries will be implemented in v0.3.0
-
--- name: QarPlaceholder :one
-SELECT 1 AS placeholder;
@@ -0,0 +1,156 @@
+-- name: GetScene :one
+SELECT * FROM qar.scenes WHERE id = $1;
+
+-- name: GetSceneByPathHash :one
+SELECT * FROM qar.scenes WHERE file_path_hash = $1;
+
+-- name: UpsertScene :one
+-- Scenes are keyed by the hash of their file path so rescans update the
+-- file in place. The title is only set for new scenes; matched metadata is
+-- kept.
+INSERT INTO
+    qar.scenes (
+        library_id,
+        file_path_hash,
+        file_path_enc,
+        file_size,
+        oshash,
+        title_enc
+    )
+VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (file_path_hash) DO
+UPDATE
+SET
+    library_id = EXCLUDED.library_id,
+    file_path_enc = EXCLUDED.file_path_enc,
+    file_size = EXCLUDED.file_size,
+    oshash = EXCLUDED.oshash RETURNING *;
+
+-- name: SetSceneMetadata :exec
+UPDATE qar.scenes
+SET
+    stashdb_id = $2,
+    studio_id = $3,
+    release_date = $4,
+    duration_seconds = $5,
+    title_enc = $6,
+    details_enc = $7,
+    image_url_enc = $8,
+    match_status = 'matched',
+    match_type = $9,
+    match_confidence = $10,
+    matched_at = NOW()
+WHERE
+    id = $1;
+
+-- name: ResetSceneMatch :exec
+-- Forgets the metadata of a scene whose file was replaced.
+UPDATE qar.scenes
+SET
+    stashdb_id = NULL,
+    studio_id = NULL,
+    release_date = NULL,
+    duration_seconds = NULL,
+    title_enc = $2,
+    details_enc = NULL,
+    image_url_enc = NULL,
+    match_status = 'unmatched',
+    match_type = NULL,
+    match_confidence = NULL,
+    matched_at = NULL
+WHERE
+    id = $1;
+
+-- name: ListScenes :many
+-- Lists scenes recently added first, or by release date. restrict limits
+-- the result to library_ids, the libraries the user may access.
+SELECT *
+FROM qar.scenes s
+WHERE (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR s.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        sqlc.narg('studio_id')::uuid IS NULL
+        OR s.studio_id = sqlc.narg('studio_id')::uuid
+    )
+    AND (
+        sqlc.narg('performer_id')::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM qar.scene_performers sp
+            WHERE
+                sp.scene_id = s.id
+                AND sp.performer_id = sqlc.narg('performer_id')::uuid
+        )
+    )
+    AND (
+        @match_status::text = ''
+        OR s.match_status = @match_status::text
+    )
+ORDER BY
+    CASE
+        WHEN @sort_by::text = 'released' THEN s.release_date
+    END DESC NULLS LAST,
+    s.created_at DESC,
+    s.id
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountScenes :one
+SELECT COUNT(*)
+FROM qar.scenes s
+WHERE (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    )
+    AND (
+        sqlc.narg('library_id')::uuid IS NULL
+        OR s.library_id = sqlc.narg('library_id')::uuid
+    )
+    AND (
+        sqlc.narg('studio_id')::uuid IS NULL
+        OR s.studio_id = sqlc.narg('studio_id')::uuid
+    )
+    AND (
+        sqlc.narg('performer_id')::uuid IS NULL
+        OR EXISTS (
+            SELECT 1
+            FROM qar.scene_performers sp
+            WHERE
+                sp.scene_id = s.id
+                AND sp.performer_id = sqlc.narg('performer_id')::uuid
+        )
+    )
+    AND (
+        @match_status::text = ''
+        OR s.match_status = @match_status::text
+    );
+
+-- name: ListScenePaths :many
+-- Lists the encrypted file paths of a library's scenes for removing
+-- scenes whose files are gone.
+SELECT id, file_path_enc FROM qar.scenes WHERE library_id = $1;
+
+-- name: DeleteScene :exec
+DELETE FROM qar.scenes WHERE id = $1;
+
+-- name: ListScenePerformers :many
+SELECT p.*
+FROM qar.performers p
+    JOIN qar.scene_performers sp ON sp.performer_id = p.id
+WHERE
+    sp.scene_id = $1
+ORDER BY p.created_at, p.id;
+
+-- name: DeleteScenePerformers :exec
+DELETE FROM qar.scene_performers WHERE scene_id = $1;
+
+-- name: AddScenePerformer :exec
+INSERT INTO
+    qar.scene_performers (scene_id, performer_id)
+VALUES ($1, $2) ON CONFLICT DO NOTHING;
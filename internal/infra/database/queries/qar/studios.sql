@@ -0,0 +1,73 @@
+-- name: GetStudio :one
+SELECT * FROM qar.studios WHERE id = $1;
+
+-- name: UpsertStudio :one
+-- Studios are keyed by their StashDB ID and refreshed on every match.
+INSERT INTO
+    qar.studios (
+        stashdb_id,
+        parent_id,
+        name_enc,
+        image_url_enc
+    )
+VALUES ($1, $2, $3, $4) ON CONFLICT (stashdb_id) DO
+UPDATE
+SET
+    parent_id = EXCLUDED.parent_id,
+    name_enc = EXCLUDED.name_enc,
+    image_url_enc = EXCLUDED.image_url_enc RETURNING *;
+
+-- name: ListStudios :many
+-- Lists studios with scenes in the accessible libraries, those with most
+-- scenes first. Names are encrypted, so they cannot be sorted here.
+SELECT st.*, COUNT(s.id) AS scene_count
+FROM qar.studios st
+    JOIN qar.scenes s ON s.studio_id = st.id
+WHERE (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    )
+GROUP BY
+    st.id
+ORDER BY scene_count DESC, st.id
+LIMIT $1
+OFFSET
+    $2;
+
+-- name: CountStudios :one
+SELECT COUNT(DISTINCT s.studio_id)
+FROM qar.scenes s
+WHERE
+    s.studio_id IS NOT NULL
+    AND (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    );
+
+-- name: CountStudioScenes :one
+SELECT COUNT(*)
+FROM qar.scenes s
+WHERE
+    s.studio_id = $1
+    AND (
+        @restrict::bool = FALSE
+        OR s.library_id = ANY(@library_ids::uuid[])
+    );
+
+-- name: DeleteOrphanStudios :execrows
+-- Deletes studios without scenes that are not the parent of a studio
+-- with scenes.
+DELETE FROM qar.studios st
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM qar.scenes s
+        WHERE
+            s.studio_id = st.id
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM qar.studios c
+        WHERE
+            c.parent_id = st.id
+    );
@@ -0,0 +1,80 @@
+-- name: UpsertArtwork :one
+-- Records an artwork image, refreshing its dimensions, language and votes
+-- if it already exists.
+INSERT INTO public.artwork (
+    content_type,
+    content_id,
+    kind,
+    source,
+    path,
+    width,
+    height,
+    file_size,
+    language,
+    vote_average,
+    vote_count
+) VALUES (
+    @content_type,
+    @content_id,
+    @kind,
+    @source,
+    @path,
+    @width,
+    @height,
+    @file_size,
+    @language,
+    @vote_average,
+    @vote_count
+)
+ON CONFLICT (content_type, content_id, kind, source, path) DO UPDATE SET
+    width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    file_size = COALESCE(EXCLUDED.file_size, public.artwork.file_size),
+    language = EXCLUDED.language,
+    vote_average = EXCLUDED.vote_average,
+    vote_count = EXCLUDED.vote_count
+RETURNING *;
+
+-- name: GetArtwork :one
+-- Gets an artwork record by ID
+SELECT * FROM public.artwork
+WHERE id = @id;
+
+-- name: ListArtworkByContent :many
+-- Lists the artwork of a movie, series or season
+SELECT * FROM public.artwork
+WHERE content_type = @content_type
+  AND content_id = @content_id
+ORDER BY kind, source, created_at;
+
+-- name: DeleteStaleArtwork :execrows
+-- Removes artwork of one source that is no longer present, e.g. local
+-- images that were deleted from disk
+DELETE FROM public.artwork
+WHERE content_type = @content_type
+  AND content_id = @content_id
+  AND source = @source
+  AND NOT (id = ANY(@keep_ids::uuid[]));
+
+-- name: SelectArtwork :execrows
+-- Makes one image the selected image of its kind and deselects the others
+UPDATE public.artwork
+SET selected = (id = @id),
+    user_selected = (id = @id AND @user_selected::boolean)
+WHERE content_type = @content_type
+  AND content_id = @content_id
+  AND kind = @kind;
+
+-- name: MarkArtworkCached :exec
+-- Records that an image was stored in the local image cache
+UPDATE public.artwork
+SET cached_at = NOW(),
+    file_size = @file_size
+WHERE id = @id;
+
+-- name: SetArtworkPlaceholder :exec
+-- Stores the BlurHash and dominant color computed for an image
+UPDATE public.artwork
+SET blurhash = @blurhash,
+    dominant_color = @dominant_color
+WHERE id = @id;
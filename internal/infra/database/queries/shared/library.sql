@@ -9,7 +9,8 @@ INSERT INTO public.libraries (
     realtime_monitoring,
     metadata_provider,
     preferred_language,
-    scanner_config
+    scanner_config,
+    metadata_policy
 ) VALUES (
     @name,
     @type,
@@ -19,7 +20,8 @@ INSERT INTO public.libraries (
     @realtime_monitoring,
     @metadata_provider,
     @preferred_language,
-    @scanner_config
+    @scanner_config,
+    @metadata_policy
 )
 RETURNING *;
 
@@ -61,7 +63,8 @@ UPDATE public.libraries SET
     realtime_monitoring = COALESCE(sqlc.narg('realtime_monitoring'), realtime_monitoring),
     metadata_provider = COALESCE(sqlc.narg('metadata_provider'), metadata_provider),
     preferred_language = COALESCE(sqlc.narg('preferred_language'), preferred_language),
-    scanner_config = COALESCE(sqlc.narg('scanner_config'), scanner_config)
+    scanner_config = COALESCE(sqlc.narg('scanner_config'), scanner_config),
+    metadata_policy = COALESCE(sqlc.narg('metadata_policy'), metadata_policy)
 WHERE id = @id
 RETURNING *;
 
@@ -148,6 +151,13 @@ UPDATE public.library_scans SET
 WHERE id = @id
 RETURNING *;
 
+-- name: SetLibraryScanJobID :one
+-- Links a scan to the River job performing it
+UPDATE public.library_scans SET
+    job_id = @job_id
+WHERE id = @id
+RETURNING *;
+
 -- name: DeleteOldLibraryScans :execrows
 -- Deletes library scans older than a given time
 DELETE FROM public.library_scans
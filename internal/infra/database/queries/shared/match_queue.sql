@@ -0,0 +1,85 @@
+-- name: UpsertMatchQueueItem :one
+-- Records an unmatched file, refreshing candidates if it is already queued.
+-- Items an admin ignored stay ignored.
+INSERT INTO public.library_match_queue (
+    library_id,
+    content_type,
+    file_path,
+    parsed_title,
+    parsed_year,
+    status,
+    reason,
+    candidates,
+    best_confidence
+) VALUES (
+    @library_id,
+    @content_type,
+    @file_path,
+    @parsed_title,
+    @parsed_year,
+    @status,
+    @reason,
+    @candidates,
+    @best_confidence
+)
+ON CONFLICT (content_type, file_path) DO UPDATE SET
+    library_id = COALESCE(EXCLUDED.library_id, library_match_queue.library_id),
+    parsed_title = EXCLUDED.parsed_title,
+    parsed_year = EXCLUDED.parsed_year,
+    status = CASE
+        WHEN library_match_queue.status = 'ignored' THEN library_match_queue.status
+        ELSE EXCLUDED.status
+    END,
+    reason = EXCLUDED.reason,
+    candidates = EXCLUDED.candidates,
+    best_confidence = EXCLUDED.best_confidence,
+    resolved_content_id = NULL,
+    resolved_by = NULL,
+    resolved_at = NULL
+RETURNING *;
+
+-- name: GetMatchQueueItem :one
+-- Gets a queued item by ID
+SELECT * FROM public.library_match_queue
+WHERE id = @id;
+
+-- name: ListMatchQueueItems :many
+-- Lists queued items, newest first, with optional filters
+SELECT * FROM public.library_match_queue
+WHERE (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('content_type')::varchar IS NULL OR content_type = sqlc.narg('content_type'))
+  AND (sqlc.narg('library_id')::uuid IS NULL OR library_id = sqlc.narg('library_id'))
+ORDER BY created_at DESC
+LIMIT @limit_count OFFSET @offset_count;
+
+-- name: CountMatchQueueItems :one
+-- Counts queued items with the same optional filters as ListMatchQueueItems
+SELECT COUNT(*) FROM public.library_match_queue
+WHERE (sqlc.narg('status')::varchar IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.narg('content_type')::varchar IS NULL OR content_type = sqlc.narg('content_type'))
+  AND (sqlc.narg('library_id')::uuid IS NULL OR library_id = sqlc.narg('library_id'));
+
+-- name: ResolveMatchQueueItem :one
+-- Marks an item as manually identified
+UPDATE public.library_match_queue SET
+    status = 'resolved',
+    resolved_content_id = @resolved_content_id,
+    resolved_by = @resolved_by,
+    resolved_at = NOW()
+WHERE id = @id
+RETURNING *;
+
+-- name: SetMatchQueueItemStatus :one
+-- Updates the status of a queued item (e.g. ignore or re-open)
+UPDATE public.library_match_queue SET
+    status = @status
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteMatchQueueItemsByPaths :execrows
+-- Removes open queue entries for files that have since been matched
+DELETE FROM public.library_match_queue
+WHERE content_type = @content_type
+  AND file_path = ANY(@file_paths::text[])
+  AND status IN ('unmatched', 'low_confidence');
+
@@ -0,0 +1,46 @@
+-- name: CreateMetadataChange :one
+-- Records a metadata change as the next version of the item.
+INSERT INTO public.metadata_changes (
+    content_type,
+    content_id,
+    version,
+    source,
+    actor_id,
+    changes
+) VALUES (
+    @content_type,
+    @content_id,
+    (SELECT COALESCE(MAX(version), 0) + 1 FROM public.metadata_changes
+     WHERE content_type = @content_type AND content_id = @content_id),
+    @source,
+    @actor_id,
+    @changes
+)
+RETURNING *;
+
+-- name: ListMetadataChanges :many
+-- Lists the changes of an item, newest first.
+SELECT * FROM public.metadata_changes
+WHERE content_type = @content_type AND content_id = @content_id
+ORDER BY version DESC
+LIMIT @lim OFFSET @off;
+
+-- name: CountMetadataChanges :one
+SELECT COUNT(*) FROM public.metadata_changes
+WHERE content_type = @content_type AND content_id = @content_id;
+
+-- name: ListMetadataChangesAfter :many
+-- Lists the changes of an item made after a version, oldest first.
+SELECT * FROM public.metadata_changes
+WHERE content_type = @content_type AND content_id = @content_id AND version > @version
+ORDER BY version;
+
+-- name: GetLatestMetadataVersion :one
+-- Returns the current version of an item, 0 if it has no changes.
+SELECT COALESCE(MAX(version), 0)::INTEGER FROM public.metadata_changes
+WHERE content_type = @content_type AND content_id = @content_id;
+
+-- name: DeleteOldMetadataChanges :execrows
+-- Deletes changes older than a date (for the cleanup job).
+DELETE FROM public.metadata_changes
+WHERE created_at < $1;
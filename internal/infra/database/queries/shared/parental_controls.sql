@@ -0,0 +1,63 @@
+-- name: GetUserParentalControls :one
+-- Gets the parental controls of a user
+SELECT * FROM shared.user_parental_controls
+WHERE user_id = @user_id;
+
+-- name: UpsertUserParentalControls :one
+-- Creates or replaces the restrictions and PIN of a user. An active
+-- override is kept; setting a new PIN clears failed PIN attempts.
+INSERT INTO shared.user_parental_controls (
+    user_id,
+    max_age,
+    blocked_tags,
+    unrated_policy,
+    pin_hash
+) VALUES (
+    @user_id,
+    @max_age,
+    @blocked_tags,
+    @unrated_policy,
+    @pin_hash
+)
+ON CONFLICT (user_id) DO UPDATE SET
+    max_age = EXCLUDED.max_age,
+    blocked_tags = EXCLUDED.blocked_tags,
+    unrated_policy = EXCLUDED.unrated_policy,
+    pin_hash = EXCLUDED.pin_hash,
+    pin_failed_attempts = CASE
+        WHEN shared.user_parental_controls.pin_hash IS DISTINCT FROM EXCLUDED.pin_hash THEN 0
+        ELSE shared.user_parental_controls.pin_failed_attempts
+    END,
+    pin_locked_until = CASE
+        WHEN shared.user_parental_controls.pin_hash IS DISTINCT FROM EXCLUDED.pin_hash THEN NULL
+        ELSE shared.user_parental_controls.pin_locked_until
+    END,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: SetUserParentalOverride :one
+-- Lifts the restrictions of a user until the given time and clears failed
+-- PIN attempts
+UPDATE shared.user_parental_controls
+SET
+    override_until = @override_until,
+    pin_failed_attempts = 0,
+    pin_locked_until = NULL
+WHERE user_id = @user_id
+RETURNING *;
+
+-- name: RecordUserParentalPINFailure :one
+-- Counts a wrong override PIN. Reaching max_attempts locks PIN entry until
+-- locked_until and starts a new count.
+UPDATE shared.user_parental_controls
+SET
+    pin_failed_attempts = CASE
+        WHEN pin_failed_attempts + 1 >= @max_attempts::int THEN 0
+        ELSE pin_failed_attempts + 1
+    END,
+    pin_locked_until = CASE
+        WHEN pin_failed_attempts + 1 >= @max_attempts::int THEN @locked_until::timestamptz
+        ELSE pin_locked_until
+    END
+WHERE user_id = @user_id
+RETURNING *;
@@ -0,0 +1,149 @@
+-- name: GetPerson :one
+-- Gets a person by ID
+SELECT * FROM public.people
+WHERE id = @id;
+
+-- name: GetPersonByTMDbID :one
+-- Gets a person by TMDb person ID
+SELECT * FROM public.people
+WHERE tmdb_id = @tmdb_id;
+
+-- name: UpdatePersonMetadata :one
+-- Stores details fetched from a metadata provider. Provider IDs are only
+-- filled in, never cleared, and are left alone when another person already
+-- has them.
+UPDATE public.people
+SET
+    tvdb_id = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM public.people o
+            WHERE o.tvdb_id = sqlc.narg('tvdb_id')::integer AND o.id <> @id
+        ) THEN tvdb_id
+        ELSE COALESCE(sqlc.narg('tvdb_id')::integer, tvdb_id)
+    END,
+    imdb_id = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM public.people o
+            WHERE o.imdb_id = sqlc.narg('imdb_id')::text AND o.id <> @id
+        ) THEN imdb_id
+        ELSE COALESCE(NULLIF(sqlc.narg('imdb_id')::text, ''), imdb_id)
+    END,
+    name = @name,
+    also_known_as = @also_known_as,
+    biography = @biography,
+    birthday = @birthday,
+    deathday = @deathday,
+    gender = @gender,
+    place_of_birth = @place_of_birth,
+    profile_path = COALESCE(@profile_path, profile_path),
+    homepage = @homepage,
+    known_for_department = @known_for_department,
+    popularity = @popularity,
+    biographies_i18n = @biographies_i18n,
+    metadata_updated_at = NOW()
+WHERE id = @id
+RETURNING *;
+
+-- name: ListPersonMovieCredits :many
+-- Lists a person's credits on movies in the library the viewer may see
+SELECT
+    c.id,
+    c.movie_id,
+    c.credit_type,
+    c.character,
+    c.job,
+    c.department,
+    c.cast_order,
+    m.title,
+    m.year,
+    m.poster_path
+FROM movie.movie_credits c
+JOIN movie.movies m ON m.id = c.movie_id
+WHERE c.person_id = @person_id
+  AND c.deleted_at IS NULL
+  AND m.deleted_at IS NULL
+  AND (
+      sqlc.narg('max_age')::integer IS NULL
+      OR m.min_age <= sqlc.narg('max_age')::integer
+      OR (m.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM movie.movie_genres bg
+      WHERE bg.movie_id = m.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM movie.movie_tags bt
+      WHERE bt.movie_id = m.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+  )
+ORDER BY m.year DESC NULLS LAST, m.title;
+
+-- name: ListPersonSeriesCredits :many
+-- Lists a person's credits on series in the library the viewer may see,
+-- including guest appearances counted per series
+SELECT
+    s.id AS series_id,
+    s.title,
+    s.first_air_date,
+    s.poster_path,
+    c.credit_type,
+    c.character,
+    c.job,
+    c.department,
+    0::BIGINT AS episode_count
+FROM tvshow.series_credits c
+JOIN tvshow.series s ON s.id = c.series_id
+WHERE c.person_id = @person_id
+  AND (
+      sqlc.narg('max_age')::integer IS NULL
+      OR s.min_age <= sqlc.narg('max_age')::integer
+      OR (s.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_genres bg
+      WHERE bg.series_id = s.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_tags bt
+      WHERE bt.series_id = s.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+  )
+UNION ALL
+SELECT
+    s.id AS series_id,
+    s.title,
+    s.first_air_date,
+    s.poster_path,
+    ec.credit_type,
+    ec.character,
+    ec.job,
+    ec.department,
+    COUNT(*)::BIGINT AS episode_count
+FROM tvshow.episode_credits ec
+JOIN tvshow.episodes e ON e.id = ec.episode_id
+JOIN tvshow.series s ON s.id = e.series_id
+WHERE ec.person_id = @person_id
+  AND (
+      sqlc.narg('max_age')::integer IS NULL
+      OR s.min_age <= sqlc.narg('max_age')::integer
+      OR (s.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_genres bg
+      WHERE bg.series_id = s.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM tvshow.series_tags bt
+      WHERE bt.series_id = s.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+  )
+GROUP BY s.id, s.title, s.first_air_date, s.poster_path, ec.credit_type, ec.character, ec.job, ec.department
+ORDER BY first_air_date DESC NULLS LAST, title;
+
+-- name: SetPersonImagePlaceholder :execrows
+-- Stores the placeholder of the person's profile image. Placeholders of
+-- images the person no longer uses are dropped.
+UPDATE public.people
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object(@path::text, @placeholder::jsonb)) AS p
+    WHERE p.key = profile_path
+)
+WHERE id = @id;
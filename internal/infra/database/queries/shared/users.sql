@@ -169,7 +169,9 @@ INSERT INTO
         metadata_language,
         show_adult_content,
         show_spoilers,
-        auto_play_videos
+        auto_play_videos,
+        hidden_tags,
+        metadata_language_chain
     )
 VALUES (
         $1,
@@ -185,7 +187,9 @@ VALUES (
         $11,
         $12,
         $13,
-        $14
+        $14,
+        $15,
+        $16
     ) ON CONFLICT (user_id) DO
 UPDATE
 SET
@@ -241,8 +245,32 @@ SET
         EXCLUDED.auto_play_videos,
         user_preferences.auto_play_videos
     ),
+    hidden_tags = COALESCE(
+        EXCLUDED.hidden_tags,
+        user_preferences.hidden_tags
+    ),
+    metadata_language_chain = COALESCE(
+        EXCLUDED.metadata_language_chain,
+        user_preferences.metadata_language_chain
+    ),
     updated_at = NOW() RETURNING *;
 
+-- name: ListUserMetadataLanguages :many
+-- Languages referenced by any user's metadata language or fallback chain
+SELECT DISTINCT lang::text AS lang
+FROM (
+        SELECT unnest(metadata_language_chain) AS lang
+        FROM shared.user_preferences
+        UNION
+        SELECT metadata_language
+        FROM shared.user_preferences
+    ) AS l
+WHERE
+    lang IS NOT NULL
+    AND lang <> ''
+    AND lang <> 'original'
+ORDER BY lang;
+
 -- name: DeleteUserPreferences :exec
 -- Delete user preferences (cleanup on user deletion)
 DELETE FROM shared.user_preferences WHERE user_id = $1;
@@ -37,6 +37,58 @@ WHERE
     AND credit_type = 'crew';
 
 -- name: CreateSeriesCredit :one
+-- Creates a credit and links it to the person with the same TMDb, TVDb or
+-- IMDb ID, creating the person if none matches. Provider IDs the person is
+-- missing are filled in unless another person already has them. Credits
+-- without any provider ID are not linked.
+WITH ids AS (
+    SELECT
+        NULLIF(sqlc.arg('tmdb_person_id')::integer, 0) AS tmdb_id,
+        sqlc.narg('tvdb_person_id')::integer AS tvdb_id,
+        NULLIF(sqlc.narg('imdb_person_id')::text, '') AS imdb_id
+),
+existing AS (
+    SELECT p.id
+    FROM public.people p, ids
+    WHERE
+        p.tmdb_id = ids.tmdb_id
+        OR p.tvdb_id = ids.tvdb_id
+        OR p.imdb_id = ids.imdb_id
+    ORDER BY
+        (p.tmdb_id = ids.tmdb_id) IS TRUE DESC,
+        (p.tvdb_id = ids.tvdb_id) IS TRUE DESC
+    LIMIT 1
+),
+linked AS (
+    UPDATE public.people p
+    SET
+        tmdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tmdb_id = ids.tmdb_id) THEN p.tmdb_id
+            ELSE COALESCE(p.tmdb_id, ids.tmdb_id)
+        END,
+        tvdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tvdb_id = ids.tvdb_id) THEN p.tvdb_id
+            ELSE COALESCE(p.tvdb_id, ids.tvdb_id)
+        END,
+        imdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.imdb_id = ids.imdb_id) THEN p.imdb_id
+            ELSE COALESCE(p.imdb_id, ids.imdb_id)
+        END,
+        profile_path = COALESCE(p.profile_path, sqlc.narg('profile_path')::text)
+    FROM existing, ids
+    WHERE p.id = existing.id
+),
+created AS (
+    INSERT INTO
+        public.people (tmdb_id, tvdb_id, imdb_id, name, profile_path)
+    SELECT ids.tmdb_id, ids.tvdb_id, ids.imdb_id, sqlc.arg('name')::text, sqlc.narg('profile_path')::text
+    FROM ids
+    WHERE
+        NOT EXISTS (SELECT 1 FROM existing)
+        AND (ids.tmdb_id IS NOT NULL OR ids.tvdb_id IS NOT NULL OR ids.imdb_id IS NOT NULL)
+    ON CONFLICT DO NOTHING
+    RETURNING id
+)
 INSERT INTO
     tvshow.series_credits (
         series_id,
@@ -47,18 +99,20 @@ INSERT INTO
         cast_order,
         job,
         department,
-        profile_path
+        profile_path,
+        person_id
     )
 VALUES (
-        $1,
-        $2,
-        $3,
-        $4,
-        $5,
-        $6,
-        $7,
-        $8,
-        $9
+        sqlc.arg('series_id'),
+        sqlc.arg('tmdb_person_id'),
+        sqlc.arg('name'),
+        sqlc.arg('credit_type'),
+        sqlc.narg('character'),
+        sqlc.narg('cast_order'),
+        sqlc.narg('job'),
+        sqlc.narg('department'),
+        sqlc.narg('profile_path'),
+        COALESCE((SELECT id FROM existing), (SELECT id FROM created))
     ) RETURNING *;
 
 -- name: DeleteSeriesCredits :exec
@@ -83,6 +137,58 @@ WHERE
 ORDER BY department ASC, name ASC;
 
 -- name: CreateEpisodeCredit :one
+-- Creates a credit and links it to the person with the same TMDb, TVDb or
+-- IMDb ID, creating the person if none matches. Provider IDs the person is
+-- missing are filled in unless another person already has them. Credits
+-- without any provider ID are not linked.
+WITH ids AS (
+    SELECT
+        NULLIF(sqlc.arg('tmdb_person_id')::integer, 0) AS tmdb_id,
+        sqlc.narg('tvdb_person_id')::integer AS tvdb_id,
+        NULLIF(sqlc.narg('imdb_person_id')::text, '') AS imdb_id
+),
+existing AS (
+    SELECT p.id
+    FROM public.people p, ids
+    WHERE
+        p.tmdb_id = ids.tmdb_id
+        OR p.tvdb_id = ids.tvdb_id
+        OR p.imdb_id = ids.imdb_id
+    ORDER BY
+        (p.tmdb_id = ids.tmdb_id) IS TRUE DESC,
+        (p.tvdb_id = ids.tvdb_id) IS TRUE DESC
+    LIMIT 1
+),
+linked AS (
+    UPDATE public.people p
+    SET
+        tmdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tmdb_id = ids.tmdb_id) THEN p.tmdb_id
+            ELSE COALESCE(p.tmdb_id, ids.tmdb_id)
+        END,
+        tvdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.tvdb_id = ids.tvdb_id) THEN p.tvdb_id
+            ELSE COALESCE(p.tvdb_id, ids.tvdb_id)
+        END,
+        imdb_id = CASE
+            WHEN EXISTS (SELECT 1 FROM public.people o WHERE o.imdb_id = ids.imdb_id) THEN p.imdb_id
+            ELSE COALESCE(p.imdb_id, ids.imdb_id)
+        END,
+        profile_path = COALESCE(p.profile_path, sqlc.narg('profile_path')::text)
+    FROM existing, ids
+    WHERE p.id = existing.id
+),
+created AS (
+    INSERT INTO
+        public.people (tmdb_id, tvdb_id, imdb_id, name, profile_path)
+    SELECT ids.tmdb_id, ids.tvdb_id, ids.imdb_id, sqlc.arg('name')::text, sqlc.narg('profile_path')::text
+    FROM ids
+    WHERE
+        NOT EXISTS (SELECT 1 FROM existing)
+        AND (ids.tmdb_id IS NOT NULL OR ids.tvdb_id IS NOT NULL OR ids.imdb_id IS NOT NULL)
+    ON CONFLICT DO NOTHING
+    RETURNING id
+)
 INSERT INTO
     tvshow.episode_credits (
         episode_id,
@@ -93,18 +199,20 @@ INSERT INTO
         cast_order,
         job,
         department,
-        profile_path
+        profile_path,
+        person_id
     )
 VALUES (
-        $1,
-        $2,
-        $3,
-        $4,
-        $5,
-        $6,
-        $7,
-        $8,
-        $9
+        sqlc.arg('episode_id'),
+        sqlc.arg('tmdb_person_id'),
+        sqlc.arg('name'),
+        sqlc.arg('credit_type'),
+        sqlc.narg('character'),
+        sqlc.narg('cast_order'),
+        sqlc.narg('job'),
+        sqlc.narg('department'),
+        sqlc.narg('profile_path'),
+        COALESCE((SELECT id FROM existing), (SELECT id FROM created))
     ) RETURNING *;
 
 -- name: DeleteEpisodeCredits :exec
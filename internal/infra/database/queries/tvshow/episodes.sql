@@ -79,7 +79,15 @@ UPDATE tvshow.episodes SET
     vote_average = COALESCE(sqlc.narg('vote_average'), vote_average),
     vote_count = COALESCE(sqlc.narg('vote_count'), vote_count),
     still_path = COALESCE(sqlc.narg('still_path'), still_path),
-    production_code = COALESCE(sqlc.narg('production_code'), production_code)
+    production_code = COALESCE(sqlc.narg('production_code'), production_code),
+    metadata_sources = metadata_sources || COALESCE(sqlc.narg('metadata_sources')::jsonb, '{}'::jsonb)
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: SetEpisodeLockedFields :one
+UPDATE tvshow.episodes SET
+    locked_fields = sqlc.arg('locked_fields')::text[],
+    updated_at = NOW()
 WHERE id = sqlc.arg('id')
 RETURNING *;
 
@@ -125,3 +133,14 @@ ON CONFLICT (series_id, season_number, episode_number) DO UPDATE SET
     still_path = EXCLUDED.still_path,
     production_code = EXCLUDED.production_code
 RETURNING *;
+
+-- name: SetEpisodeImagePlaceholder :execrows
+-- Stores the placeholder of one of the episode's images. Placeholders of
+-- images the episode no longer uses are dropped.
+UPDATE tvshow.episodes
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object(@path::text, @placeholder::jsonb)) AS p
+    WHERE p.key = still_path
+)
+WHERE id = @id;
@@ -20,12 +20,37 @@ VALUES ($1, $2, $3) ON CONFLICT (series_id, slug) DO NOTHING;
 DELETE FROM tvshow.series_genres WHERE series_id = $1;
 
 -- name: ListSeriesByGenre :many
+-- restrict limits the result to series with an episode file under
+-- library_paths.
 SELECT s.*
 FROM tvshow.series s
     JOIN tvshow.series_genres sg ON s.id = sg.series_id
 WHERE
-    sg.slug = $1
+    sg.slug = sqlc.arg('slug')
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE re.series_id = s.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR s.min_age <= sqlc.narg('max_age')::integer
+        OR (s.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY s.popularity DESC NULLS LAST
-LIMIT $2
+LIMIT sqlc.arg('limit')
 OFFSET
-    $3;
+    sqlc.arg('offset');
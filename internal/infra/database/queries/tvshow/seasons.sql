@@ -42,7 +42,15 @@ UPDATE tvshow.seasons SET
     poster_path = COALESCE(sqlc.narg('poster_path'), poster_path),
     episode_count = COALESCE(sqlc.narg('episode_count'), episode_count),
     air_date = COALESCE(sqlc.narg('air_date'), air_date),
-    vote_average = COALESCE(sqlc.narg('vote_average'), vote_average)
+    vote_average = COALESCE(sqlc.narg('vote_average'), vote_average),
+    metadata_sources = metadata_sources || COALESCE(sqlc.narg('metadata_sources')::jsonb, '{}'::jsonb)
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: SetSeasonLockedFields :one
+UPDATE tvshow.seasons SET
+    locked_fields = sqlc.arg('locked_fields')::text[],
+    updated_at = NOW()
 WHERE id = sqlc.arg('id')
 RETURNING *;
 
@@ -75,3 +83,14 @@ ON CONFLICT (series_id, season_number) DO UPDATE SET
     air_date = EXCLUDED.air_date,
     vote_average = EXCLUDED.vote_average
 RETURNING *;
+
+-- name: SetSeasonImagePlaceholder :execrows
+-- Stores the placeholder of one of the season's images. Placeholders of
+-- images the season no longer uses are dropped.
+UPDATE tvshow.seasons
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object(@path::text, @placeholder::jsonb)) AS p
+    WHERE p.key = poster_path
+)
+WHERE id = @id;
@@ -7,11 +7,28 @@ SELECT * FROM tvshow.series WHERE tmdb_id = $1;
 -- name: GetSeriesByTVDbID :one
 SELECT * FROM tvshow.series WHERE tvdb_id = $1;
 
+-- name: GetSeriesByIMDbID :one
+SELECT * FROM tvshow.series WHERE imdb_id = $1;
+
 -- name: GetSeriesBySonarrID :one
 SELECT * FROM tvshow.series WHERE sonarr_id = $1;
 
 -- name: ListSeries :many
 SELECT * FROM tvshow.series
+WHERE
+    (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY
     CASE WHEN @sort_by::text = 'title' AND @sort_order::text = 'asc' THEN title END ASC,
     CASE WHEN @sort_by::text = 'title' AND @sort_order::text = 'desc' THEN title END DESC,
@@ -20,17 +37,81 @@ ORDER BY
     CASE WHEN @sort_by::text = 'created_at' AND @sort_order::text = 'asc' THEN created_at END ASC,
     CASE WHEN @sort_by::text = 'created_at' AND @sort_order::text = 'desc' THEN created_at END DESC,
     created_at DESC
-LIMIT $1 OFFSET $2;
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListSeriesDueForRefresh :many
+SELECT *
+FROM tvshow.series
+WHERE
+    (
+        tmdb_id IS NOT NULL
+        OR tvdb_id IS NOT NULL
+        OR imdb_id IS NOT NULL
+        OR provider_ids <> '{}'::jsonb
+    )
+    AND (
+        metadata_updated_at IS NULL
+        OR metadata_updated_at < CASE
+            WHEN overview IS NULL OR poster_path IS NULL THEN sqlc.arg('incomplete_before')::timestamptz
+            WHEN status IS NULL
+                OR status NOT IN ('Ended', 'Canceled')
+                OR last_air_date >= sqlc.arg('active_since')::date THEN sqlc.arg('active_before')::timestamptz
+            ELSE sqlc.arg('settled_before')::timestamptz
+        END
+    )
+ORDER BY metadata_updated_at ASC NULLS FIRST
+LIMIT sqlc.arg('limit');
 
 -- name: CountSeries :one
-SELECT COUNT(*) FROM tvshow.series;
+-- restrict limits the result to series with an episode file under
+-- library_paths.
+SELECT COUNT(*) FROM tvshow.series
+WHERE
+    (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE re.series_id = series.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    );
 
 -- name: SearchSeriesByTitle :many
 SELECT *
 FROM tvshow.series
 WHERE
-    title ILIKE '%' || $1 || '%'
-    OR original_title ILIKE '%' || $1 || '%'
+    (
+        title ILIKE '%' || $1 || '%'
+        OR original_title ILIKE '%' || $1 || '%'
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY
     CASE
         WHEN LOWER(title) = LOWER($1) THEN 0
@@ -44,9 +125,24 @@ OFFSET
 
 -- name: SearchSeriesByTitleAnyLanguage :many
 SELECT * FROM tvshow.series
-WHERE title ILIKE '%' || $1 || '%'
-   OR original_title ILIKE '%' || $1 || '%'
-   OR titles_i18n::text ILIKE '%' || $1 || '%'
+WHERE (
+        title ILIKE '%' || $1 || '%'
+        OR original_title ILIKE '%' || $1 || '%'
+        OR titles_i18n::text ILIKE '%' || $1 || '%'
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY
     CASE
         WHEN LOWER(title) = LOWER($1) THEN 0
@@ -57,12 +153,38 @@ ORDER BY
 LIMIT $2 OFFSET $3;
 
 -- name: ListRecentlyAddedSeries :many
+-- restrict limits the result to series with an episode file under
+-- library_paths.
 SELECT *
 FROM tvshow.series
+WHERE
+    (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE re.series_id = series.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR min_age <= sqlc.narg('max_age')::integer
+        OR (min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = series.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = series.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY created_at DESC
-LIMIT $1
+LIMIT sqlc.arg('limit')
 OFFSET
-    $2;
+    sqlc.arg('offset');
 
 -- name: ListSeriesByStatus :many
 SELECT *
@@ -104,7 +226,8 @@ INSERT INTO
         total_episodes,
         trailer_url,
         homepage,
-        metadata_updated_at
+        metadata_updated_at,
+        min_age
     )
 VALUES (
         $1,
@@ -134,7 +257,8 @@ VALUES (
         $25,
         $26,
         $27,
-        $28
+        $28,
+        $29
     ) RETURNING *;
 
 -- name: UpdateSeries :one
@@ -181,10 +305,22 @@ SET
         sqlc.narg ('age_ratings'),
         age_ratings
     ),
+    min_age = CASE
+        WHEN sqlc.narg ('age_ratings') IS NULL THEN min_age
+        ELSE sqlc.narg ('min_age')::integer
+    END,
     external_ratings = COALESCE(
         sqlc.narg ('external_ratings'),
         external_ratings
     ),
+    metadata_sources = metadata_sources || COALESCE(
+        sqlc.narg ('metadata_sources')::jsonb,
+        '{}'::jsonb
+    ),
+    provider_ids = provider_ids || COALESCE(
+        sqlc.narg ('provider_ids')::jsonb,
+        '{}'::jsonb
+    ),
     original_language = COALESCE(
         sqlc.narg ('original_language'),
         original_language
@@ -242,10 +378,22 @@ SET
     metadata_updated_at = COALESCE(
         sqlc.narg ('metadata_updated_at'),
         metadata_updated_at
+    ),
+    sort_title = COALESCE(
+        sqlc.narg ('sort_title'),
+        sort_title
     )
 WHERE
     id = sqlc.arg ('id') RETURNING *;
 
+-- name: SetSeriesLockedFields :one
+UPDATE tvshow.series
+SET
+    locked_fields = sqlc.arg ('locked_fields')::text[],
+    updated_at = NOW()
+WHERE
+    id = sqlc.arg ('id') RETURNING *;
+
 -- name: DeleteSeries :exec
 DELETE FROM tvshow.series WHERE id = $1;
 
@@ -266,3 +414,14 @@ SET
     )
 WHERE
     id = $1;
+
+-- name: SetSeriesImagePlaceholder :execrows
+-- Stores the placeholder of one of the series's images. Placeholders of
+-- images the series no longer uses are dropped.
+UPDATE tvshow.series
+SET image_placeholders = (
+    SELECT COALESCE(jsonb_object_agg(p.key, p.value), '{}'::jsonb)
+    FROM jsonb_each(image_placeholders || jsonb_build_object(@path::text, @placeholder::jsonb)) AS p
+    WHERE p.key IN (poster_path, backdrop_path)
+)
+WHERE id = @id;
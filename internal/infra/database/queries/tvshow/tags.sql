@@ -0,0 +1,60 @@
+-- name: ListSeriesTags :many
+SELECT *
+FROM tvshow.series_tags
+WHERE
+    series_id = $1
+ORDER BY name ASC;
+
+-- name: ListDistinctSeriesTags :many
+SELECT slug, name, COUNT(DISTINCT series_id)::bigint AS item_count
+FROM tvshow.series_tags
+WHERE
+    NOT spoiler
+GROUP BY slug, name
+ORDER BY item_count DESC, name ASC
+LIMIT sqlc.arg('limit');
+
+-- name: AddSeriesTag :exec
+INSERT INTO
+    tvshow.series_tags (series_id, slug, name, spoiler)
+VALUES ($1, $2, $3, $4) ON CONFLICT (series_id, slug) DO NOTHING;
+
+-- name: DeleteSeriesTags :exec
+DELETE FROM tvshow.series_tags WHERE series_id = $1;
+
+-- name: ListSeriesByTag :many
+SELECT s.*
+FROM tvshow.series s
+    JOIN tvshow.series_tags st ON s.id = st.series_id
+WHERE
+    st.slug = sqlc.arg('slug')
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR s.min_age <= sqlc.narg('max_age')::integer
+        OR (s.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+ORDER BY s.popularity DESC NULLS LAST
+LIMIT sqlc.arg('limit')
+OFFSET
+    sqlc.arg('offset');
+
+-- name: ListSeriesPendingTagImport :many
+SELECT *
+FROM tvshow.series
+WHERE
+    tmdb_id IS NOT NULL
+    AND tags_imported_at IS NULL
+    AND id > sqlc.arg('after')
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: SetSeriesTagsImported :exec
+UPDATE tvshow.series SET tags_imported_at = NOW() WHERE id = $1;
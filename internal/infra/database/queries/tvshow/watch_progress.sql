@@ -15,6 +15,7 @@ WHERE
 ORDER BY e.season_number ASC, e.episode_number ASC;
 
 -- name: ListContinueWatchingSeries :many
+-- restrict limits the result to episodes with a file under library_paths.
 SELECT DISTINCT
     ON (s.id) s.*,
     e.id as last_episode_id,
@@ -28,11 +29,33 @@ FROM tvshow.episode_watched ew
     JOIN tvshow.episodes e ON ew.episode_id = e.id
     JOIN tvshow.series s ON e.series_id = s.id
 WHERE
-    ew.user_id = $1
+    ew.user_id = sqlc.arg('user_id')
     AND NOT ew.is_completed
     AND ew.progress_seconds > 0
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episode_files f
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE f.episode_id = e.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR s.min_age <= sqlc.narg('max_age')::integer
+        OR (s.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
 ORDER BY s.id, ew.last_watched_at DESC
-LIMIT $2;
+LIMIT sqlc.arg('limit');
 
 -- name: ListWatchedEpisodesByUser :many
 SELECT
@@ -197,3 +220,89 @@ WHERE
     )
 ORDER BY e.season_number ASC, e.episode_number ASC
 LIMIT 1;
+
+-- name: ListNextUpEpisodes :many
+-- Returns the episode after the last one the user finished of each series,
+-- series watched most recently first. Series with an episode in progress
+-- are left to continue watching, and specials and unaired episodes are
+-- skipped. restrict limits the result to series with an episode file
+-- under library_paths.
+WITH last_completed AS (
+    SELECT DISTINCT
+        ON (le.series_id) le.series_id,
+        le.season_number,
+        le.episode_number,
+        lw.last_watched_at
+    FROM tvshow.episode_watched lw
+        JOIN tvshow.episodes le ON le.id = lw.episode_id
+    WHERE
+        lw.user_id = sqlc.arg('user_id')
+        AND lw.is_completed
+    ORDER BY le.series_id, lw.last_watched_at DESC
+)
+SELECT
+    sqlc.embed(e),
+    s.title AS series_title,
+    s.poster_path AS series_poster_path,
+    lc.last_watched_at
+FROM last_completed lc
+    JOIN tvshow.series s ON s.id = lc.series_id
+    JOIN LATERAL (
+        SELECT ne.id
+        FROM tvshow.episodes ne
+        WHERE
+            ne.series_id = lc.series_id
+            AND ne.season_number > 0
+            AND (ne.season_number, ne.episode_number) > (lc.season_number, lc.episode_number)
+            AND (
+                ne.air_date IS NULL
+                OR ne.air_date <= CURRENT_DATE
+            )
+            AND NOT EXISTS (
+                SELECT 1
+                FROM tvshow.episode_watched nw
+                WHERE
+                    nw.episode_id = ne.id
+                    AND nw.user_id = sqlc.arg('user_id')
+                    AND nw.is_completed
+            )
+        ORDER BY ne.season_number ASC, ne.episode_number ASC
+        LIMIT 1
+    ) nx ON TRUE
+    JOIN tvshow.episodes e ON e.id = nx.id
+WHERE
+    NOT EXISTS (
+        SELECT 1
+        FROM tvshow.episode_watched pw
+            JOIN tvshow.episodes pe ON pe.id = pw.episode_id
+        WHERE
+            pw.user_id = sqlc.arg('user_id')
+            AND pe.series_id = s.id
+            AND NOT pw.is_completed
+            AND pw.progress_seconds > 0
+    )
+    AND (
+        sqlc.arg('restrict')::boolean = FALSE
+        OR EXISTS (
+            SELECT 1
+            FROM tvshow.episodes re
+                JOIN tvshow.episode_files f ON f.episode_id = re.id
+                CROSS JOIN unnest(sqlc.arg('library_paths')::text[]) AS lp(path)
+            WHERE re.series_id = s.id AND starts_with(f.file_path, lp.path)
+        )
+    )
+    AND (
+        sqlc.narg('max_age')::integer IS NULL
+        OR s.min_age <= sqlc.narg('max_age')::integer
+        OR (s.min_age IS NULL AND sqlc.arg('allow_unrated')::boolean)
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_genres bg
+        WHERE bg.series_id = s.id AND bg.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+    AND NOT EXISTS (
+        SELECT 1 FROM tvshow.series_tags bt
+        WHERE bt.series_id = s.id AND bt.slug = ANY(sqlc.arg('blocked_tags')::text[])
+    )
+ORDER BY lc.last_watched_at DESC
+LIMIT sqlc.arg('limit');
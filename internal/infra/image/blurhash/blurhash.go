@@ -0,0 +1,184 @@
+// Package blurhash computes image placeholders: a BlurHash
+// (https://blurha.sh) and the dominant color of an image. It works on
+// decoded images only and needs no cgo, so content packages can use the
+// Placeholder type without linking libvips.
+package blurhash
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strings"
+)
+
+// Placeholder describes an image well enough to render a stand-in while the
+// image itself loads.
+type Placeholder struct {
+	BlurHash string `json:"blurhash"`
+	Color    string `json:"color"` // Dominant color as "#rrggbb"
+}
+
+// FromImage computes the placeholder of a decoded image. Portrait
+// images get more vertical than horizontal components and vice versa.
+func FromImage(img image.Image) (*Placeholder, error) {
+	if img == nil {
+		return nil, fmt.Errorf("empty image")
+	}
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return nil, fmt.Errorf("empty image")
+	}
+	xComp, yComp := 4, 3
+	if b.Dy() > b.Dx() {
+		xComp, yComp = 3, 4
+	}
+	return &Placeholder{
+		BlurHash: encodeBlurHash(img, xComp, yComp),
+		Color:    dominantColor(img),
+	}, nil
+}
+
+// encodeBlurHash implements the BlurHash encoder (https://blurha.sh) for
+// the given number of components, each between 1 and 9.
+func encodeBlurHash(img image.Image, xComp, yComp int) string {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+
+	// Convert pixels to linear RGB once; transparent areas count as black
+	// just like in the reference implementation.
+	linear := make([][3]float64, width*height)
+	for y := range height {
+		for x := range width {
+			r, g, bl, _ := img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			linear[y*width+x] = [3]float64{
+				srgbToLinear(r >> 8),
+				srgbToLinear(g >> 8),
+				srgbToLinear(bl >> 8),
+			}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComp*yComp)
+	for j := range yComp {
+		for i := range xComp {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+			var f [3]float64
+			for y := range height {
+				cy := math.Cos(math.Pi * float64(j) * float64(y) / float64(height))
+				for x := range width {
+					basis := math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) * cy
+					px := linear[y*width+x]
+					f[0] += basis * px[0]
+					f[1] += basis * px[1]
+					f[2] += basis * px[2]
+				}
+			}
+			scale := normalisation / float64(width*height)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	var sb strings.Builder
+	sb.Grow(4 + 2*len(factors))
+	encode83(&sb, (xComp-1)+(yComp-1)*9, 1)
+
+	dc, ac := factors[0], factors[1:]
+	maxValue := 1.0
+	if len(ac) > 0 {
+		var actualMax float64
+		for _, f := range ac {
+			actualMax = max(actualMax, math.Abs(f[0]), math.Abs(f[1]), math.Abs(f[2]))
+		}
+		quantisedMax := clampInt(int(math.Floor(actualMax*166-0.5)), 0, 82)
+		maxValue = float64(quantisedMax+1) / 166
+		encode83(&sb, quantisedMax, 1)
+	} else {
+		encode83(&sb, 0, 1)
+	}
+
+	encode83(&sb, linearToSRGB(dc[0])<<16|linearToSRGB(dc[1])<<8|linearToSRGB(dc[2]), 4)
+	for _, f := range ac {
+		q := func(v float64) int {
+			return clampInt(int(math.Floor(signPow(v/maxValue, 0.5)*9+9.5)), 0, 18)
+		}
+		encode83(&sb, q(f[0])*19*19+q(f[1])*19+q(f[2]), 2)
+	}
+	return sb.String()
+}
+
+// dominantColor returns the most common color of the opaque pixels of an
+// image as "#rrggbb", or "" for fully transparent images. Colors are
+// grouped into 4096 buckets and the mean of the fullest bucket is returned.
+func dominantColor(img image.Image) string {
+	type bucket struct {
+		count   int
+		r, g, b int
+	}
+	var buckets [4096]bucket
+	best := -1
+
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, a := img.At(x, y).RGBA()
+			if a < 0x8000 {
+				continue
+			}
+			// Undo alpha premultiplication for semi-transparent edges.
+			r8, g8, b8 := int(r*0xffff/a)>>8, int(g*0xffff/a)>>8, int(bl*0xffff/a)>>8
+			idx := (r8>>4)<<8 | (g8>>4)<<4 | b8>>4
+			bk := &buckets[idx]
+			bk.count++
+			bk.r += r8
+			bk.g += g8
+			bk.b += b8
+			if best < 0 || bk.count > buckets[best].count {
+				best = idx
+			}
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	bk := buckets[best]
+	return fmt.Sprintf("#%02x%02x%02x", bk.r/bk.count, bk.g/bk.count, bk.b/bk.count)
+}
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+func encode83(sb *strings.Builder, value, length int) {
+	for i := 1; i <= length; i++ {
+		divisor := 1
+		for range length - i {
+			divisor *= 83
+		}
+		sb.WriteByte(base83Chars[(value/divisor)%83])
+	}
+}
+
+func srgbToLinear(v uint32) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) int {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+func clampInt(v, lo, hi int) int {
+	return max(lo, min(v, hi))
+}
@@ -0,0 +1,80 @@
+package blurhash
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func solidImage(w, h int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func decode83(s string) int {
+	v := 0
+	for _, c := range s {
+		v = v*83 + strings.IndexRune(base83Chars, c)
+	}
+	return v
+}
+
+func TestEncodeBlurHash_SolidColor(t *testing.T) {
+	hash := encodeBlurHash(solidImage(8, 6, color.NRGBA{R: 200, G: 100, B: 50, A: 255}), 4, 3)
+
+	require.Len(t, hash, 4+2*12)
+	assert.Equal(t, (4-1)+(3-1)*9, decode83(hash[:1]), "size flag")
+	assert.Equal(t, 200<<16|100<<8|50, decode83(hash[2:6]), "DC component is the average color")
+}
+
+func TestEncodeBlurHash_Detail(t *testing.T) {
+	img := solidImage(10, 10, color.Black)
+	for y := range 10 {
+		for x := 5; x < 10; x++ {
+			img.Set(x, y, color.White)
+		}
+	}
+	hash := encodeBlurHash(img, 4, 3)
+	flat := encodeBlurHash(solidImage(10, 10, color.Gray{Y: 128}), 4, 3)
+	require.Len(t, hash, 28)
+	assert.Greater(t, decode83(hash[1:2]), decode83(flat[1:2]), "a black/white split has more AC energy than a flat image")
+}
+
+func TestDominantColor(t *testing.T) {
+	img := solidImage(10, 10, color.NRGBA{R: 10, G: 20, B: 200, A: 255})
+	for x := range 10 {
+		img.Set(x, 0, color.NRGBA{R: 250, G: 250, B: 250, A: 255})
+	}
+	assert.Equal(t, "#0a14c8", dominantColor(img))
+
+	transparent := solidImage(4, 4, color.NRGBA{R: 255, A: 0})
+	assert.Empty(t, dominantColor(transparent))
+
+	// Transparent pixels are ignored in favour of the opaque logo.
+	logo := solidImage(10, 10, color.NRGBA{A: 0})
+	logo.Set(3, 3, color.NRGBA{R: 255, A: 255})
+	assert.Equal(t, "#ff0000", dominantColor(logo))
+}
+
+func TestFromImage(t *testing.T) {
+	portrait, err := FromImage(solidImage(2, 3, color.White))
+	require.NoError(t, err)
+	assert.Equal(t, (3-1)+(4-1)*9, decode83(portrait.BlurHash[:1]))
+	assert.Equal(t, "#ffffff", portrait.Color)
+
+	landscape, err := FromImage(solidImage(3, 2, color.White))
+	require.NoError(t, err)
+	assert.Equal(t, (4-1)+(3-1)*9, decode83(landscape.BlurHash[:1]))
+
+	_, err = FromImage(image.NewNRGBA(image.Rect(0, 0, 0, 0)))
+	assert.Error(t, err)
+}
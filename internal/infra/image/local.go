@@ -0,0 +1,167 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"log/slog"
+
+	"github.com/davidbyttow/govips/v2/vips"
+)
+
+var vipsOnce sync.Once
+
+// ensureVips initializes libvips on first use.
+func ensureVips() {
+	vipsOnce.Do(func() {
+		vips.LoggingSettings(nil, vips.LogLevelError)
+		vips.Startup(&vips.Config{
+			ConcurrencyLevel: runtime.NumCPU(),
+			MaxCacheSize:     100,
+		})
+	})
+}
+
+// FetchLocalImage reads an image file from disk and scales it down to the
+// requested size. Resized images are cached under the cache directory and
+// invalidated when the source file changes.
+// Returns data, content-type, and error.
+func (s *Service) FetchLocalImage(ctx context.Context, imageType, filePath, size string) ([]byte, string, error) {
+	if filePath == "" {
+		return nil, "", fmt.Errorf("empty image path")
+	}
+	if size == "" {
+		size = s.getDefaultSize(imageType)
+	}
+	if !isValidSize(imageType, size) {
+		return nil, "", fmt.Errorf("invalid image size %q for %s", size, imageType)
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("stat local image: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, "", fmt.Errorf("local image is not a file: %s", filePath)
+	}
+
+	// #nosec G304 -- filePath comes from an artwork record written by the library scanner
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("read local image: %w", err)
+	}
+
+	// Originals are served as they are; resized images are re-encoded, so
+	// any format libvips loads can be scaled, HEIF and raw photos included.
+	width, height := sizeDimensions(size)
+	if width == 0 && height == 0 {
+		contentType := getContentType(filePath)
+		if !isValidImageType(contentType) {
+			return nil, "", fmt.Errorf("invalid content type: %s", contentType)
+		}
+		return data, contentType, nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+
+	// Key the cache on path, modification time and size so replaced
+	// files are picked up without clearing the cache.
+	key := fmt.Sprintf("%x", sha256.Sum256(fmt.Appendf(nil, "%s:%d:%d", filePath, info.ModTime().UnixNano(), info.Size())))
+	var cachePath string
+	if s.config.CacheDir != "" {
+		cachePath = filepath.Join(s.config.CacheDir, "local", imageType, size, key[:2], key)
+		// #nosec G304 -- cachePath is built from a hash inside CacheDir
+		if cached, err := os.ReadFile(cachePath); err == nil {
+			return cached, http.DetectContentType(cached), nil
+		}
+	}
+
+	resized, contentType, err := resizeImage(data, width, height)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if cachePath != "" {
+		if err := os.MkdirAll(filepath.Dir(cachePath), 0750); err == nil {
+			if err := os.WriteFile(cachePath, resized, 0600); err != nil {
+				s.logger.Warn("Failed to cache local image", slog.Any("error", err))
+			}
+		}
+	}
+
+	return resized, contentType, nil
+}
+
+// sizeDimensions converts a TMDb size name into a bounding box. "w342"
+// bounds the width, "h632" the height and "original" nothing.
+func sizeDimensions(size string) (width, height int) {
+	if len(size) < 2 {
+		return 0, 0
+	}
+	n, err := strconv.Atoi(size[1:])
+	if err != nil {
+		return 0, 0
+	}
+	switch size[0] {
+	case 'w':
+		return n, 0
+	case 'h':
+		return 0, n
+	}
+	return 0, 0
+}
+
+// resizeImage scales an image down to fit the bounding box. Images with an
+// alpha channel (logos, clear art) are encoded as PNG, everything else as
+// JPEG.
+func resizeImage(data []byte, width, height int) ([]byte, string, error) {
+	ensureVips()
+
+	// vips requires both dimensions; an oversized one leaves the other in charge.
+	const unbounded = 100000
+	if width == 0 {
+		width = unbounded
+	}
+	if height == 0 {
+		height = unbounded
+	}
+
+	img, err := vips.NewThumbnailWithSizeFromBuffer(data, width, height, vips.InterestingNone, vips.SizeDown)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode local image: %w", err)
+	}
+	defer img.Close()
+
+	if img.HasAlpha() {
+		out, _, err := img.ExportPng(vips.NewPngExportParams())
+		if err != nil {
+			return nil, "", fmt.Errorf("encode local image: %w", err)
+		}
+		return out, "image/png", nil
+	}
+
+	params := vips.NewJpegExportParams()
+	params.Quality = 85
+	params.StripMetadata = true
+	out, _, err := img.ExportJpeg(params)
+	if err != nil {
+		return nil, "", fmt.Errorf("encode local image: %w", err)
+	}
+	return out, "image/jpeg", nil
+}
+
+// RenderThumbnail scales an image down to fit the bounding box, like the
+// resized local images. libvips also loads documents, so the first page of
+// a PDF is rendered when it was built with PDF support.
+func RenderThumbnail(data []byte, width, height int) ([]byte, string, error) {
+	return resizeImage(data, width, height)
+}
@@ -0,0 +1,63 @@
+package image
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSizeDimensions(t *testing.T) {
+	tests := []struct {
+		size          string
+		width, height int
+	}{
+		{"w342", 342, 0},
+		{"h632", 0, 632},
+		{"original", 0, 0},
+		{"", 0, 0},
+	}
+	for _, tt := range tests {
+		w, h := sizeDimensions(tt.size)
+		assert.Equal(t, tt.width, w, tt.size)
+		assert.Equal(t, tt.height, h, tt.size)
+	}
+}
+
+func TestService_FetchLocalImage(t *testing.T) {
+	svc, err := NewService(Config{CacheDir: t.TempDir()}, logging.NewTestLogger())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	poster := filepath.Join(dir, "poster.png")
+	require.NoError(t, os.WriteFile(poster, []byte("\x89PNG\r\n\x1a\n"), 0o644))
+
+	t.Run("original is served as is", func(t *testing.T) {
+		data, contentType, err := svc.FetchLocalImage(ctx, TypePoster, poster, "original")
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", contentType)
+		assert.Equal(t, []byte("\x89PNG\r\n\x1a\n"), data)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		_, _, err := svc.FetchLocalImage(ctx, TypePoster, poster, "w1280")
+		assert.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, _, err := svc.FetchLocalImage(ctx, TypePoster, filepath.Join(dir, "missing.jpg"), "original")
+		assert.Error(t, err)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		notes := filepath.Join(dir, "notes.txt")
+		require.NoError(t, os.WriteFile(notes, []byte("hi"), 0o644))
+		_, _, err := svc.FetchLocalImage(ctx, TypePoster, notes, "original")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,35 @@
+package image
+
+import (
+	"fmt"
+
+	"github.com/davidbyttow/govips/v2/vips"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// placeholderSize bounds the thumbnail placeholders are computed from.
+// BlurHash keeps only a few frequency components, so a tiny image gives the
+// same result as the full one at a fraction of the cost.
+const placeholderSize = 32
+
+// ComputePlaceholder decodes an image and computes its BlurHash and
+// dominant color.
+func ComputePlaceholder(data []byte) (*blurhash.Placeholder, error) {
+	ensureVips()
+
+	ref, err := vips.NewThumbnailWithSizeFromBuffer(data, placeholderSize, placeholderSize, vips.InterestingNone, vips.SizeDown)
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	defer ref.Close()
+
+	if err := ref.ToColorSpace(vips.InterpretationSRGB); err != nil {
+		return nil, fmt.Errorf("convert image to sRGB: %w", err)
+	}
+	img, err := ref.ToImage(vips.NewDefaultPNGExportParams())
+	if err != nil {
+		return nil, fmt.Errorf("export image: %w", err)
+	}
+	return blurhash.FromImage(img)
+}
@@ -36,11 +36,20 @@ const (
 	SizeProfileLarge    = "h632"
 	SizeProfileOriginal = "original"
 
+	// Photo library thumbnails, rendered from local files only
+	SizePhotoSmall  = "w320"
+	SizePhotoMedium = "w720"
+	SizePhotoLarge  = "w1920"
+
 	// Image types
 	TypePoster   = "poster"
 	TypeBackdrop = "backdrop"
 	TypeProfile  = "profile"
 	TypeLogo     = "logo"
+	TypeClearArt = "clearart"
+	TypeBanner   = "banner"
+	TypeThumb    = "thumb"
+	TypePhoto    = "photo"
 )
 
 // Config holds image service configuration.
@@ -298,8 +307,10 @@ func (s *Service) getDefaultSize(imageType string) string {
 		return SizeBackdropMedium
 	case TypeProfile:
 		return SizeProfileMedium
-	case TypeLogo:
+	case TypeLogo, TypeClearArt:
 		return SizePosterMedium
+	case TypePhoto:
+		return SizePhotoSmall
 	default:
 		return "original"
 	}
@@ -396,7 +407,7 @@ func isValidImageType(contentType string) bool {
 
 func isValidType(imageType string) bool {
 	switch imageType {
-	case TypePoster, TypeBackdrop, TypeProfile, TypeLogo:
+	case TypePoster, TypeBackdrop, TypeProfile, TypeLogo, TypeClearArt, TypeBanner, TypeThumb:
 		return true
 	}
 	return false
@@ -423,11 +434,16 @@ func isValidSize(imageType, size string) bool {
 		case SizeProfileSmall, SizeProfileMedium, SizeProfileLarge:
 			return true
 		}
-	case TypeLogo:
+	case TypeLogo, TypeClearArt:
 		switch size {
 		case SizePosterSmall, SizePosterMedium, SizePosterLarge:
 			return true
 		}
+	case TypePhoto:
+		switch size {
+		case SizePhotoSmall, SizePhotoMedium, SizePhotoLarge:
+			return true
+		}
 	}
 	return false
 }
@@ -302,6 +302,8 @@ func TestHelperFunctions(t *testing.T) {
 		assert.True(t, isValidType(TypeBackdrop))
 		assert.True(t, isValidType(TypeProfile))
 		assert.True(t, isValidType(TypeLogo))
+		assert.True(t, isValidType(TypeBanner))
+		assert.True(t, isValidType(TypeThumb))
 		assert.False(t, isValidType("invalid"))
 	})
 
@@ -321,6 +323,13 @@ func TestHelperFunctions(t *testing.T) {
 		assert.False(t, isValidSize(TypeBackdrop, "w185"))
 	})
 
+	t.Run("isValidSize photo", func(t *testing.T) {
+		assert.True(t, isValidSize(TypePhoto, SizePhotoSmall))
+		assert.True(t, isValidSize(TypePhoto, SizePhotoLarge))
+		assert.False(t, isValidSize(TypePhoto, "w342"))
+		assert.False(t, isValidType(TypePhoto), "photos are not proxied from TMDb")
+	})
+
 	t.Run("getContentType", func(t *testing.T) {
 		assert.Equal(t, "image/jpeg", getContentType("test.jpg"))
 		assert.Equal(t, "image/jpeg", getContentType("test.jpeg"))
@@ -340,5 +349,6 @@ func TestService_getDefaultSize(t *testing.T) {
 	assert.Equal(t, SizeBackdropMedium, svc.getDefaultSize(TypeBackdrop))
 	assert.Equal(t, SizeProfileMedium, svc.getDefaultSize(TypeProfile))
 	assert.Equal(t, SizePosterMedium, svc.getDefaultSize(TypeLogo))
+	assert.Equal(t, SizePhotoSmall, svc.getDefaultSize(TypePhoto))
 	assert.Equal(t, "original", svc.getDefaultSize("unknown"))
 }
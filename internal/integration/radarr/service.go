@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
 	"github.com/lusoris/revenge/internal/util"
 )
@@ -297,10 +298,16 @@ func (s *SyncService) addMovie(ctx context.Context, rm Movie) error {
 
 // updateMovie updates an existing movie with Radarr data.
 func (s *SyncService) updateMovie(ctx context.Context, rm Movie, existingID uuid.UUID) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRadarr, nil)
 	m := s.mapper.ToMovie(&rm)
 	m.ID = existingID
 
 	updateParams := s.movieToUpdateParams(m)
+	existing, err := s.movieRepo.GetMovie(ctx, existingID)
+	if err != nil {
+		return fmt.Errorf("failed to get movie: %w", err)
+	}
+	updateParams.ApplyLocks(existing.LockedFields)
 	if _, err := s.movieRepo.UpdateMovie(ctx, updateParams); err != nil {
 		return fmt.Errorf("failed to update movie: %w", err)
 	}
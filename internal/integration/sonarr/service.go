@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/util"
 )
@@ -288,10 +289,16 @@ func (s *SyncService) addSeries(ctx context.Context, ss Series, result *SyncResu
 
 // updateSeries updates an existing series with Sonarr data.
 func (s *SyncService) updateSeries(ctx context.Context, ss Series, existingID uuid.UUID, result *SyncResult) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceSonarr, nil)
 	series := s.mapper.ToSeries(&ss)
 	series.ID = existingID
 
 	updateParams := s.seriesToUpdateParams(series)
+	existing, err := s.tvshowRepo.GetSeries(ctx, existingID)
+	if err != nil {
+		return fmt.Errorf("failed to get series: %w", err)
+	}
+	updateParams.ApplyLocks(existing.LockedFields)
 	if _, err := s.tvshowRepo.UpdateSeries(ctx, updateParams); err != nil {
 		return fmt.Errorf("failed to update series: %w", err)
 	}
@@ -307,6 +314,7 @@ func (s *SyncService) updateSeries(ctx context.Context, ss Series, existingID uu
 
 // syncSeasonsAndEpisodes syncs seasons and episodes for a series.
 func (s *SyncService) syncSeasonsAndEpisodes(ctx context.Context, sonarrSeriesID int, seriesID uuid.UUID, result *SyncResult) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceSonarr, nil)
 	// Get all episodes from Sonarr
 	sonarrEpisodes, err := s.client.GetEpisodes(ctx, sonarrSeriesID)
 	if err != nil {
@@ -373,9 +381,9 @@ func (s *SyncService) syncEpisodes(ctx context.Context, sonarrEpisodes []Episode
 		return fmt.Errorf("failed to get existing episodes: %w", err)
 	}
 
-	episodeMap := make(map[int32]uuid.UUID)
+	episodeMap := make(map[int32]tvshow.Episode)
 	for _, ep := range existingEpisodes {
-		episodeMap[ep.EpisodeNumber] = ep.ID
+		episodeMap[ep.EpisodeNumber] = ep
 	}
 
 	for _, se := range sonarrEpisodes {
@@ -386,10 +394,12 @@ func (s *SyncService) syncEpisodes(ctx context.Context, sonarrEpisodes []Episode
 
 		episode := s.mapper.ToEpisode(&se, seriesID, seasonID)
 
-		if existingEpisodeID, exists := episodeMap[util.SafeIntToInt32(se.EpisodeNumber)]; exists {
+		if existingEpisode, exists := episodeMap[util.SafeIntToInt32(se.EpisodeNumber)]; exists {
 			// Update existing episode
+			existingEpisodeID := existingEpisode.ID
 			episode.ID = existingEpisodeID
 			updateParams := s.episodeToUpdateParams(episode)
+			updateParams.ApplyLocks(existingEpisode.LockedFields)
 			if _, err := s.tvshowRepo.UpdateEpisode(ctx, updateParams); err != nil {
 				s.logger.Warn("failed to update episode", "episode_id", existingEpisodeID, "error", err)
 				continue
@@ -11,6 +11,10 @@ import (
 	"github.com/lusoris/revenge/internal/playback/transcode"
 )
 
+// audioOnlyBandwidth is the advertised bandwidth of an audio-only variant.
+// Lossless FLAC is copied as-is, so this errs on the high side.
+const audioOnlyBandwidth = 1_500_000
+
 // GenerateMasterPlaylist creates an HLS master playlist (.m3u8) for a playback session.
 // Uses EXT-X-VERSION:7 for fMP4 segment support (required for HEVC/AV1 passthrough).
 // It references media playlists for each quality profile, audio renditions, and subtitle tracks.
@@ -89,6 +93,21 @@ func GenerateMasterPlaylist(profiles []ProfileVariant, audioTracks []AudioVarian
 		fmt.Fprintf(&b, "%s/index.m3u8\n", p.Name)
 	}
 
+	// Audio-only sessions (music, audiobooks) have no video variants; the default audio
+	// rendition is listed as the only stream variant.
+	if len(profiles) == 0 && len(audioTracks) > 0 {
+		def := audioTracks[0]
+		for _, at := range audioTracks {
+			if at.IsDefault {
+				def = at
+				break
+			}
+		}
+		fmt.Fprintf(&b, "#EXT-X-STREAM-INF:BANDWIDTH=%d,CODECS=\"%s\"\n",
+			audioOnlyBandwidth, audioCodecString(def.Codec))
+		fmt.Fprintf(&b, "audio/%d/index.m3u8\n", def.Index)
+	}
+
 	return b.String()
 }
 
@@ -73,6 +73,19 @@ func TestGenerateMasterPlaylist_WithAudioTracks(t *testing.T) {
 	assert.NotContains(t, playlist, "SUBTITLES")
 }
 
+func TestGenerateMasterPlaylist_AudioOnly(t *testing.T) {
+	audio := []AudioVariant{
+		{Index: 0, Name: "Stereo", Channels: 2, IsDefault: true, Codec: "flac"},
+	}
+
+	playlist := GenerateMasterPlaylist(nil, audio, nil)
+
+	assert.Contains(t, playlist, `URI="audio/0/index.m3u8"`)
+	assert.Contains(t, playlist, `#EXT-X-STREAM-INF:BANDWIDTH=1500000,CODECS="fLaC"`)
+	assert.True(t, strings.HasSuffix(playlist, "audio/0/index.m3u8\n"))
+	assert.NotContains(t, playlist, "RESOLUTION")
+}
+
 func TestEstimateBandwidth_WithSourceBitrates(t *testing.T) {
 	from := estimateBandwidth(
 		transcode.ProfileDecision{VideoBitrate: 5000, AudioBitrate: 192},
@@ -108,7 +108,7 @@ func newIntegrationService(t *testing.T, videoPath string) (*playback.Service, *
 
 	prober := movie.NewMediaInfoProber()
 
-	svc, err := playback.NewService(cfg, sm, pm, prober, movieSvc, &fakeTVService{}, logger)
+	svc, err := playback.NewService(cfg, sm, pm, prober, movieSvc, &fakeTVService{}, nil, nil, nil, nil, nil, nil, logger)
 	require.NoError(t, err)
 	t.Cleanup(svc.Close)
 
@@ -416,7 +416,7 @@ func TestIntegration_MaxConcurrentSessions(t *testing.T) {
 	t.Cleanup(pm.Close)
 
 	prober := movie.NewMediaInfoProber()
-	svc, err := playback.NewService(cfg, sm, pm, prober, movieSvc, nil, logger)
+	svc, err := playback.NewService(cfg, sm, pm, prober, movieSvc, nil, nil, nil, nil, nil, nil, nil, logger)
 	require.NoError(t, err)
 	t.Cleanup(svc.Close)
 
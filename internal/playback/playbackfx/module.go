@@ -5,7 +5,13 @@ import (
 	"log/slog"
 
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/livetv"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/podcast"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/playback"
 	"github.com/lusoris/revenge/internal/playback/hls"
@@ -74,13 +80,19 @@ func providePlaybackService(
 	pipeline *transcode.PipelineManager,
 	movieSvc movie.Service,
 	tvSvc tvshow.Service,
+	musicSvc music.Service,
+	bookSvc audiobook.Service,
+	podcastSvc podcast.Service,
+	liveTVSvc livetv.Service,
+	homeVideoSvc homevideo.Service,
+	musicVideoSvc musicvideo.Service,
 	logger *slog.Logger,
 ) (*playback.Service, error) {
 	if !cfg.Playback.Enabled || sessions == nil || pipeline == nil {
 		return nil, nil
 	}
 	prober := movie.NewMediaInfoProber()
-	return playback.NewService(cfg, sessions, pipeline, prober, movieSvc, tvSvc, logger)
+	return playback.NewService(cfg, sessions, pipeline, prober, movieSvc, tvSvc, musicSvc, bookSvc, podcastSvc, liveTVSvc, homeVideoSvc, musicVideoSvc, logger)
 }
 
 func provideCleanupWorker(
@@ -10,7 +10,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/livetv"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/podcast"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/infra/cache"
 	"github.com/lusoris/revenge/internal/infra/observability"
@@ -20,15 +27,21 @@ import (
 
 // Service manages playback sessions and streaming pipelines.
 type Service struct {
-	cfg        *config.Config
-	sessions   *SessionManager
-	pipeline   *transcode.PipelineManager
-	prober     movie.Prober
-	movieSvc   movie.Service
-	tvSvc      tvshow.Service
-	profiles   []transcode.QualityProfile
-	probeCache *cache.L1Cache[uuid.UUID, *movie.MediaInfo]
-	logger     *slog.Logger
+	cfg           *config.Config
+	sessions      *SessionManager
+	pipeline      *transcode.PipelineManager
+	prober        movie.Prober
+	movieSvc      movie.Service
+	tvSvc         tvshow.Service
+	musicSvc      music.Service
+	bookSvc       audiobook.Service
+	podcastSvc    podcast.Service
+	liveTVSvc     livetv.Service
+	homeVideoSvc  homevideo.Service
+	musicVideoSvc musicvideo.Service
+	profiles      []transcode.QualityProfile
+	probeCache    *cache.L1Cache[uuid.UUID, *movie.MediaInfo]
+	logger        *slog.Logger
 }
 
 // NewService creates a new playback service.
@@ -39,6 +52,12 @@ func NewService(
 	prober movie.Prober,
 	movieSvc movie.Service,
 	tvSvc tvshow.Service,
+	musicSvc music.Service,
+	bookSvc audiobook.Service,
+	podcastSvc podcast.Service,
+	liveTVSvc livetv.Service,
+	homeVideoSvc homevideo.Service,
+	musicVideoSvc musicvideo.Service,
 	logger *slog.Logger,
 ) (*Service, error) {
 	profiles := transcode.GetEnabledProfiles(cfg.Playback.Transcode.Profiles)
@@ -49,20 +68,31 @@ func NewService(
 	}
 
 	return &Service{
-		cfg:        cfg,
-		sessions:   sessions,
-		pipeline:   pipeline,
-		prober:     prober,
-		movieSvc:   movieSvc,
-		tvSvc:      tvSvc,
-		profiles:   profiles,
-		probeCache: probeCache,
-		logger:     logger,
+		cfg:           cfg,
+		sessions:      sessions,
+		pipeline:      pipeline,
+		prober:        prober,
+		movieSvc:      movieSvc,
+		tvSvc:         tvSvc,
+		musicSvc:      musicSvc,
+		bookSvc:       bookSvc,
+		podcastSvc:    podcastSvc,
+		liveTVSvc:     liveTVSvc,
+		homeVideoSvc:  homeVideoSvc,
+		musicVideoSvc: musicVideoSvc,
+		profiles:      profiles,
+		probeCache:    probeCache,
+		logger:        logger,
 	}, nil
 }
 
 // StartSession creates a new playback session with FFmpeg pipeline.
 func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, req *StartPlaybackRequest) (*Session, error) {
+	// 0. Enforce parental controls
+	if err := s.checkAccess(ctx, req); err != nil {
+		return nil, err
+	}
+
 	// 1. Resolve the file path
 	filePath, fileID, err := s.resolveFilePath(ctx, req)
 	if err != nil {
@@ -95,11 +125,27 @@ func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, req *Start
 		}
 	}
 	decision := transcode.AnalyzeMedia(info, s.profiles, clientCaps)
+	if req.MediaType.AudioOnly() {
+		// Music, audiobooks and podcasts are audio-only: embedded cover art
+		// shows up as a video stream, but only the audio renditions are
+		// served.
+		decision.Profiles = nil
+	}
 
 	// 4. Create session
 	sessionID := uuid.Must(uuid.NewV7())
 	segmentDir := filepath.Join(s.cfg.Playback.SegmentDir, sessionID.String())
 
+	live := req.MediaType.Live()
+	startPosition := req.StartPosition
+	duration := info.DurationSeconds
+	subtitleTracks := SubtitleTracksFromMediaInfo(info, sessionID)
+	if live {
+		// Live channels join at the live edge; text subtitles would have to
+		// be extracted from a stream that never ends.
+		startPosition, duration, subtitleTracks = 0, 0, nil
+	}
+
 	sess := &Session{
 		ID:                sessionID,
 		UserID:            userID,
@@ -112,10 +158,10 @@ func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, req *Start
 		ActiveProfiles:    profileNames(decision.Profiles),
 		AudioTrack:        req.AudioTrack,
 		SubtitleTrack:     req.SubtitleTrack,
-		StartPosition:     req.StartPosition,
-		DurationSeconds:   info.DurationSeconds,
+		StartPosition:     startPosition,
+		DurationSeconds:   duration,
 		AudioTracks:       AudioTracksFromMediaInfo(info),
-		SubtitleTracks:    SubtitleTracksFromMediaInfo(info, sessionID),
+		SubtitleTracks:    subtitleTracks,
 	}
 
 	if err := s.sessions.Create(sess); err != nil {
@@ -134,7 +180,7 @@ func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, req *Start
 	// 480p) are started on-demand when the player requests them.
 	for _, pd := range decision.Profiles {
 		if pd.Name == "original" {
-			if _, err := s.pipeline.StartVideoSegmenting(ctx, sessionID, filePath, segmentDir, pd, req.StartPosition); err != nil {
+			if err := s.startVideo(ctx, sess, pd); err != nil {
 				s.logger.Error("failed to start original profile",
 					slog.String("session_id", sessionID.String()),
 					slog.String("error", err.Error()),
@@ -149,7 +195,13 @@ func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, req *Start
 	// Browser-decodable codecs (AAC, MP3, Opus, FLAC) are copied, others transcoded to AAC.
 	for _, as := range info.AudioStreams {
 		codec, bitrate := audioRenditionCodec(as.Codec)
-		if _, err := s.pipeline.StartAudioRendition(ctx, sessionID, filePath, segmentDir, as.Index, codec, bitrate, req.StartPosition); err != nil {
+		var err error
+		if live {
+			_, err = s.pipeline.StartLiveAudioRendition(ctx, sessionID, filePath, segmentDir, as.Index, codec, bitrate)
+		} else {
+			_, err = s.pipeline.StartAudioRendition(ctx, sessionID, filePath, segmentDir, as.Index, codec, bitrate, req.StartPosition)
+		}
+		if err != nil {
 			s.logger.Error("failed to start audio rendition",
 				slog.String("session_id", sessionID.String()),
 				slog.Int("track_index", as.Index),
@@ -159,7 +211,9 @@ func (s *Service) StartSession(ctx context.Context, userID uuid.UUID, req *Start
 	}
 
 	// 8. Extract subtitles (async, non-blocking) — full VTT files, not segmented
-	go s.extractSubtitles(sessionID, filePath, segmentDir, info)
+	if !live {
+		go s.extractSubtitles(sessionID, filePath, segmentDir, info)
+	}
 
 	// Record playback start metrics
 	quality := "direct"
@@ -238,6 +292,90 @@ func (s *Service) StopSession(sessionID uuid.UUID) error {
 	return nil
 }
 
+// checkAccess returns content.ErrParentalRestriction when the access filter
+// attached to ctx blocks the requested item. Episodes are checked against
+// their series.
+func (s *Service) checkAccess(ctx context.Context, req *StartPlaybackRequest) error {
+	filter := content.AccessFilterFromContext(ctx)
+	if filter == nil {
+		return nil
+	}
+
+	var minAge *int32
+	var tags []string
+	switch req.MediaType {
+	case MediaTypeMovie, MediaTypeMovieExtra:
+		movieID := req.MediaID
+		if req.MediaType == MediaTypeMovieExtra {
+			extra, err := s.movieSvc.GetMovieExtra(ctx, req.MediaID)
+			if err != nil {
+				return fmt.Errorf("movie extra not found: %w", err)
+			}
+			movieID = extra.MovieID
+		}
+		m, err := s.movieSvc.GetMovie(ctx, movieID)
+		if err != nil {
+			return fmt.Errorf("movie not found: %w", err)
+		}
+		genres, err := s.movieSvc.GetMovieGenres(ctx, movieID)
+		if err != nil {
+			return fmt.Errorf("failed to get movie genres: %w", err)
+		}
+		keywords, err := s.movieSvc.GetMovieTags(ctx, movieID)
+		if err != nil {
+			return fmt.Errorf("failed to get movie tags: %w", err)
+		}
+		minAge = m.MinAge
+		for _, g := range genres {
+			tags = append(tags, g.Slug)
+		}
+		for _, k := range keywords {
+			tags = append(tags, k.Slug)
+		}
+
+	case MediaTypeEpisode:
+		if s.tvSvc == nil {
+			return fmt.Errorf("TV show service not available")
+		}
+		episodeID := req.MediaID
+		if req.FileID != nil {
+			file, err := s.tvSvc.GetEpisodeFile(ctx, *req.FileID)
+			if err != nil {
+				return fmt.Errorf("episode file not found: %w", err)
+			}
+			episodeID = file.EpisodeID
+		}
+		episode, err := s.tvSvc.GetEpisode(ctx, episodeID)
+		if err != nil {
+			return fmt.Errorf("episode not found: %w", err)
+		}
+		series, err := s.tvSvc.GetSeries(ctx, episode.SeriesID)
+		if err != nil {
+			return fmt.Errorf("series not found: %w", err)
+		}
+		genres, err := s.tvSvc.GetSeriesGenres(ctx, episode.SeriesID)
+		if err != nil {
+			return fmt.Errorf("failed to get series genres: %w", err)
+		}
+		keywords, err := s.tvSvc.GetSeriesTags(ctx, episode.SeriesID)
+		if err != nil {
+			return fmt.Errorf("failed to get series tags: %w", err)
+		}
+		minAge = series.MinAge
+		for _, g := range genres {
+			tags = append(tags, g.Slug)
+		}
+		for _, k := range keywords {
+			tags = append(tags, k.Slug)
+		}
+	}
+
+	if !filter.Allows(minAge, tags) {
+		return content.ErrParentalRestriction
+	}
+	return nil
+}
+
 // resolveFilePath finds the media file path for a playback request.
 func (s *Service) resolveFilePath(ctx context.Context, req *StartPlaybackRequest) (string, uuid.UUID, error) {
 	switch req.MediaType {
@@ -263,6 +401,14 @@ func (s *Service) resolveFilePath(ctx context.Context, req *StartPlaybackRequest
 		// Default: first file
 		return files[0].FilePath, files[0].ID, nil
 
+	case MediaTypeMovieExtra:
+		// Extras are single files; the extra ID doubles as the file ID
+		extra, err := s.movieSvc.GetMovieExtra(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("movie extra not found: %w", err)
+		}
+		return extra.FilePath, extra.ID, nil
+
 	case MediaTypeEpisode:
 		if s.tvSvc == nil {
 			return "", uuid.Nil, fmt.Errorf("TV show service not available")
@@ -287,6 +433,93 @@ func (s *Service) resolveFilePath(ctx context.Context, req *StartPlaybackRequest
 		}
 		return files[0].FilePath, files[0].ID, nil
 
+	case MediaTypeTrack:
+		if s.musicSvc == nil {
+			return "", uuid.Nil, fmt.Errorf("music service not available")
+		}
+		// Tracks are single files; the track ID doubles as the file ID
+		track, err := s.musicSvc.GetTrack(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("track not found: %w", err)
+		}
+		return track.FilePath, track.ID, nil
+
+	case MediaTypeAudiobook:
+		if s.bookSvc == nil {
+			return "", uuid.Nil, fmt.Errorf("audiobook service not available")
+		}
+		if req.FileID != nil {
+			file, err := s.bookSvc.GetBookFile(ctx, *req.FileID)
+			if err != nil {
+				return "", uuid.Nil, fmt.Errorf("audiobook file not found: %w", err)
+			}
+			if file.BookID != req.MediaID {
+				return "", uuid.Nil, fmt.Errorf("file %s does not belong to audiobook %s", file.ID, req.MediaID)
+			}
+			return file.FilePath, file.ID, nil
+		}
+
+		// Without a file, playback starts at the book's first file
+		files, err := s.bookSvc.GetBookFiles(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("audiobook not found: %w", err)
+		}
+		if len(files) == 0 {
+			return "", uuid.Nil, fmt.Errorf("no files available for audiobook %s", req.MediaID)
+		}
+		return files[0].FilePath, files[0].ID, nil
+
+	case MediaTypePodcastEpisode:
+		if s.podcastSvc == nil {
+			return "", uuid.Nil, fmt.Errorf("podcast service not available")
+		}
+		// Episodes are single files; the episode ID doubles as the file ID
+		ep, err := s.podcastSvc.GetEpisode(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("podcast episode not found: %w", err)
+		}
+		if !ep.Downloaded() {
+			return "", uuid.Nil, fmt.Errorf("podcast episode %s is not downloaded", req.MediaID)
+		}
+		return *ep.FilePath, ep.ID, nil
+
+	case MediaTypeHomeVideo:
+		if s.homeVideoSvc == nil {
+			return "", uuid.Nil, fmt.Errorf("home video service not available")
+		}
+		// Home videos are single files; the video ID doubles as the file ID
+		video, err := s.homeVideoSvc.GetVideo(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("home video not found: %w", err)
+		}
+		return video.FilePath, video.ID, nil
+
+	case MediaTypeMusicVideo:
+		if s.musicVideoSvc == nil {
+			return "", uuid.Nil, fmt.Errorf("music video service not available")
+		}
+		// Music videos are single files; the video ID doubles as the file ID
+		video, err := s.musicVideoSvc.GetVideo(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("music video not found: %w", err)
+		}
+		return video.FilePath, video.ID, nil
+
+	case MediaTypeChannel:
+		if s.liveTVSvc == nil {
+			return "", uuid.Nil, fmt.Errorf("live TV service not available")
+		}
+		// Channels are restreamed from their tuner URL; the channel ID
+		// doubles as the file ID
+		ch, err := s.liveTVSvc.GetChannel(ctx, req.MediaID)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("channel not found: %w", err)
+		}
+		if !ch.Enabled {
+			return "", uuid.Nil, fmt.Errorf("channel %s is disabled", req.MediaID)
+		}
+		return ch.StreamURL, ch.ID, nil
+
 	default:
 		return "", uuid.Nil, fmt.Errorf("unsupported media type: %s", req.MediaType)
 	}
@@ -380,7 +613,7 @@ func (s *Service) EnsureVideoProfile(ctx context.Context, sess *Session, profile
 		return false
 	}
 
-	if _, err := s.pipeline.StartVideoSegmenting(ctx, sess.ID, sess.FilePath, sess.SegmentDir, *pd, sess.StartPosition); err != nil {
+	if err := s.startVideo(ctx, sess, *pd); err != nil {
 		s.logger.Error("failed to start video segmenting on demand",
 			slog.String("session_id", sess.ID.String()),
 			slog.String("profile", profileName),
@@ -396,6 +629,18 @@ func (s *Service) EnsureVideoProfile(ctx context.Context, sess *Session, profile
 	return true
 }
 
+// startVideo starts segmenting a video profile of the session, reading
+// live channels in real time.
+func (s *Service) startVideo(ctx context.Context, sess *Session, pd transcode.ProfileDecision) error {
+	var err error
+	if sess.MediaType.Live() {
+		_, err = s.pipeline.StartLiveVideoSegmenting(ctx, sess.ID, sess.FilePath, sess.SegmentDir, pd)
+	} else {
+		_, err = s.pipeline.StartVideoSegmenting(ctx, sess.ID, sess.FilePath, sess.SegmentDir, pd, sess.StartPosition)
+	}
+	return err
+}
+
 // audioRenditionCodec determines the output codec and bitrate for an audio rendition.
 // Only codecs that browsers can decode via MSE are passed through. AC-3, E-AC-3,
 // TrueHD, and DTS cannot be decoded by Chrome/Firefox and must be transcoded.
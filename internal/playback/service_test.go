@@ -8,7 +8,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/livetv"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/podcast"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/playback/transcode"
 	"github.com/stretchr/testify/assert"
@@ -32,12 +36,38 @@ type mockMovieService struct {
 	movie.Service // embed interface; panics on unimplemented methods
 	files         []movie.MovieFile
 	filesErr      error
+	extra         *movie.MovieExtra
+	item          *movie.Movie
+	genres        []movie.MovieGenre
+	tags          []movie.MovieTag
+}
+
+func (m *mockMovieService) GetMovie(_ context.Context, _ uuid.UUID) (*movie.Movie, error) {
+	if m.item == nil {
+		return nil, movie.ErrMovieNotFound
+	}
+	return m.item, nil
+}
+
+func (m *mockMovieService) GetMovieGenres(_ context.Context, _ uuid.UUID) ([]movie.MovieGenre, error) {
+	return m.genres, nil
+}
+
+func (m *mockMovieService) GetMovieTags(_ context.Context, _ uuid.UUID) ([]movie.MovieTag, error) {
+	return m.tags, nil
 }
 
 func (m *mockMovieService) GetMovieFiles(_ context.Context, _ uuid.UUID) ([]movie.MovieFile, error) {
 	return m.files, m.filesErr
 }
 
+func (m *mockMovieService) GetMovieExtra(_ context.Context, _ uuid.UUID) (*movie.MovieExtra, error) {
+	if m.extra == nil {
+		return nil, movie.ErrMovieExtraNotFound
+	}
+	return m.extra, nil
+}
+
 // ---------------------------------------------------------------------------
 // Minimal mock: tvshow.Service — only implements methods used by playback
 // ---------------------------------------------------------------------------
@@ -58,6 +88,72 @@ func (m *mockTVService) GetEpisodeFile(_ context.Context, _ uuid.UUID) (*tvshow.
 	return m.file, m.fileErr
 }
 
+// ---------------------------------------------------------------------------
+// Minimal mock: music.Service — only implements methods used by playback
+// ---------------------------------------------------------------------------
+
+type mockMusicService struct {
+	music.Service // embed interface
+	track         *music.Track
+}
+
+func (m *mockMusicService) GetTrack(_ context.Context, _ uuid.UUID) (*music.Track, error) {
+	if m.track == nil {
+		return nil, music.ErrTrackNotFound
+	}
+	return m.track, nil
+}
+
+// ---------------------------------------------------------------------------
+// Minimal mock: audiobook.Service
+// ---------------------------------------------------------------------------
+
+type mockAudiobookService struct {
+	audiobook.Service // embed interface
+	files             []audiobook.BookFile
+}
+
+func (m *mockAudiobookService) GetBookFiles(_ context.Context, _ uuid.UUID) ([]audiobook.BookFile, error) {
+	return m.files, nil
+}
+
+func (m *mockAudiobookService) GetBookFile(_ context.Context, id uuid.UUID) (*audiobook.BookFile, error) {
+	for i := range m.files {
+		if m.files[i].ID == id {
+			return &m.files[i], nil
+		}
+	}
+	return nil, audiobook.ErrBookFileNotFound
+}
+
+// ---------------------------------------------------------------------------
+// Minimal mock: podcast.Service
+// ---------------------------------------------------------------------------
+
+type mockPodcastService struct {
+	podcast.Service // embed interface
+	episode         *podcast.Episode
+}
+
+func (m *mockPodcastService) GetEpisode(_ context.Context, id uuid.UUID) (*podcast.Episode, error) {
+	if m.episode == nil || m.episode.ID != id {
+		return nil, podcast.ErrEpisodeNotFound
+	}
+	return m.episode, nil
+}
+
+type mockLiveTVService struct {
+	livetv.Service // embed interface
+	channel        *livetv.Channel
+}
+
+func (m *mockLiveTVService) GetChannel(_ context.Context, id uuid.UUID) (*livetv.Channel, error) {
+	if m.channel == nil || m.channel.ID != id {
+		return nil, livetv.ErrChannelNotFound
+	}
+	return m.channel, nil
+}
+
 // ---------------------------------------------------------------------------
 // Minimal mock: movie.Prober
 // ---------------------------------------------------------------------------
@@ -100,7 +196,7 @@ func newTestService(t *testing.T, cfg *config.Config, movieSvc movie.Service, tv
 	t.Cleanup(sm.Close)
 
 	pm := testPipelineManager(t)
-	svc, err := NewService(cfg, sm, pm, prober, movieSvc, tvSvc, testLogger())
+	svc, err := NewService(cfg, sm, pm, prober, movieSvc, tvSvc, nil, nil, nil, nil, nil, nil, testLogger())
 	require.NoError(t, err)
 	t.Cleanup(svc.Close)
 
@@ -119,7 +215,7 @@ func TestNewService(t *testing.T) {
 		defer sm.Close()
 
 		pm := testPipelineManager(t)
-		svc, err := NewService(cfg, sm, pm, nil, nil, nil, testLogger())
+		svc, err := NewService(cfg, sm, pm, nil, nil, nil, nil, nil, nil, nil, nil, nil, testLogger())
 		require.NoError(t, err)
 		require.NotNil(t, svc)
 		defer svc.Close()
@@ -343,6 +439,34 @@ func TestResolveFilePath(t *testing.T) {
 		assert.Contains(t, err.Error(), "movie files not found")
 	})
 
+	t.Run("movie extra: returns the extra's file", func(t *testing.T) {
+		extraID := uuid.New()
+		movieSvc := &mockMovieService{
+			extra: &movie.MovieExtra{ID: extraID, FilePath: "/media/movies/Heat/Trailers/Teaser.mkv"},
+		}
+		svc, _ := newTestService(t, testConfig(), movieSvc, nil, nil)
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeMovieExtra,
+			MediaID:   extraID,
+		}
+		path, id, err := svc.resolveFilePath(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "/media/movies/Heat/Trailers/Teaser.mkv", path)
+		assert.Equal(t, extraID, id)
+	})
+
+	t.Run("movie extra: error when extra not found", func(t *testing.T) {
+		svc, _ := newTestService(t, testConfig(), &mockMovieService{}, nil, nil)
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeMovieExtra,
+			MediaID:   uuid.New(),
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.ErrorIs(t, err, movie.ErrMovieExtraNotFound)
+	})
+
 	t.Run("episode: returns first file when no FileID specified", func(t *testing.T) {
 		fileID := uuid.New()
 		tvSvc := &mockTVService{
@@ -439,11 +563,190 @@ func TestResolveFilePath(t *testing.T) {
 		assert.Contains(t, err.Error(), "TV show service not available")
 	})
 
+	t.Run("track: returns track file", func(t *testing.T) {
+		trackID := uuid.New()
+		musicSvc := &mockMusicService{
+			track: &music.Track{ID: trackID, FilePath: "/media/music/Artist/Album/01 - Song.flac"},
+		}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.musicSvc = musicSvc
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeTrack,
+			MediaID:   trackID,
+		}
+		path, id, err := svc.resolveFilePath(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "/media/music/Artist/Album/01 - Song.flac", path)
+		assert.Equal(t, trackID, id)
+	})
+
+	t.Run("track: error when track not found", func(t *testing.T) {
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.musicSvc = &mockMusicService{}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeTrack,
+			MediaID:   uuid.New(),
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.ErrorIs(t, err, music.ErrTrackNotFound)
+	})
+
+	t.Run("track: error when music service is nil", func(t *testing.T) {
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeTrack,
+			MediaID:   uuid.New(),
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "music service not available")
+	})
+
+	t.Run("audiobook: returns first file by default", func(t *testing.T) {
+		bookID := uuid.New()
+		files := []audiobook.BookFile{
+			{ID: uuid.New(), BookID: bookID, Position: 1, FilePath: "/media/books/Author/Book/01.mp3"},
+			{ID: uuid.New(), BookID: bookID, Position: 2, FilePath: "/media/books/Author/Book/02.mp3"},
+		}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.bookSvc = &mockAudiobookService{files: files}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeAudiobook,
+			MediaID:   bookID,
+		}
+		path, id, err := svc.resolveFilePath(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "/media/books/Author/Book/01.mp3", path)
+		assert.Equal(t, files[0].ID, id)
+	})
+
+	t.Run("audiobook: returns requested file", func(t *testing.T) {
+		bookID := uuid.New()
+		files := []audiobook.BookFile{
+			{ID: uuid.New(), BookID: bookID, Position: 1, FilePath: "/media/books/Author/Book/01.mp3"},
+			{ID: uuid.New(), BookID: bookID, Position: 2, FilePath: "/media/books/Author/Book/02.mp3"},
+		}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.bookSvc = &mockAudiobookService{files: files}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeAudiobook,
+			MediaID:   bookID,
+			FileID:    &files[1].ID,
+		}
+		path, id, err := svc.resolveFilePath(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "/media/books/Author/Book/02.mp3", path)
+		assert.Equal(t, files[1].ID, id)
+	})
+
+	t.Run("audiobook: error when file belongs to another book", func(t *testing.T) {
+		file := audiobook.BookFile{ID: uuid.New(), BookID: uuid.New(), FilePath: "/media/books/Other/01.mp3"}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.bookSvc = &mockAudiobookService{files: []audiobook.BookFile{file}}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeAudiobook,
+			MediaID:   uuid.New(),
+			FileID:    &file.ID,
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not belong to audiobook")
+	})
+
+	t.Run("audiobook: error when audiobook service is nil", func(t *testing.T) {
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeAudiobook,
+			MediaID:   uuid.New(),
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "audiobook service not available")
+	})
+
+	t.Run("podcast episode: returns downloaded file", func(t *testing.T) {
+		path := "/media/podcasts/Show/2024-01-02 Episode.mp3"
+		ep := &podcast.Episode{ID: uuid.New(), FilePath: &path}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.podcastSvc = &mockPodcastService{episode: ep}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypePodcastEpisode,
+			MediaID:   ep.ID,
+		}
+		got, id, err := svc.resolveFilePath(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, path, got)
+		assert.Equal(t, ep.ID, id)
+	})
+
+	t.Run("podcast episode: error when not downloaded", func(t *testing.T) {
+		ep := &podcast.Episode{ID: uuid.New()}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.podcastSvc = &mockPodcastService{episode: ep}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypePodcastEpisode,
+			MediaID:   ep.ID,
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is not downloaded")
+	})
+
+	t.Run("channel: returns stream url", func(t *testing.T) {
+		ch := &livetv.Channel{ID: uuid.New(), StreamURL: "http://192.168.1.20:5004/auto/v5.1", Enabled: true}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.liveTVSvc = &mockLiveTVService{channel: ch}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeChannel,
+			MediaID:   ch.ID,
+		}
+		got, id, err := svc.resolveFilePath(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, ch.StreamURL, got)
+		assert.Equal(t, ch.ID, id)
+	})
+
+	t.Run("channel: error when disabled", func(t *testing.T) {
+		ch := &livetv.Channel{ID: uuid.New(), StreamURL: "http://192.168.1.20:5004/auto/v5.1"}
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+		svc.liveTVSvc = &mockLiveTVService{channel: ch}
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeChannel,
+			MediaID:   ch.ID,
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "is disabled")
+	})
+
+	t.Run("channel: error when live TV service is nil", func(t *testing.T) {
+		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
+
+		req := &StartPlaybackRequest{
+			MediaType: MediaTypeChannel,
+			MediaID:   uuid.New(),
+		}
+		_, _, err := svc.resolveFilePath(ctx, req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "live TV service not available")
+	})
+
 	t.Run("unsupported media type returns error", func(t *testing.T) {
 		svc, _ := newTestService(t, testConfig(), nil, nil, nil)
 
 		req := &StartPlaybackRequest{
-			MediaType: MediaType("audiobook"),
+			MediaType: MediaType("photo"),
 			MediaID:   uuid.New(),
 		}
 		_, _, err := svc.resolveFilePath(ctx, req)
@@ -769,7 +1072,7 @@ func TestServiceClose(t *testing.T) {
 	defer sm.Close()
 
 	pm := testPipelineManager(t)
-	svc, err := NewService(cfg, sm, pm, nil, nil, nil, testLogger())
+	svc, err := NewService(cfg, sm, pm, nil, nil, nil, nil, nil, nil, nil, nil, nil, testLogger())
 	require.NoError(t, err)
 
 	// Should not panic
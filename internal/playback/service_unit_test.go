@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/livetv"
 	"github.com/lusoris/revenge/internal/content/movie"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/stretchr/testify/assert"
@@ -139,6 +141,35 @@ func TestStartSession_ResolveFileError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to resolve file")
 }
 
+func TestStartSession_ParentalRestriction(t *testing.T) {
+	movieSvc := &mockMovieService{
+		files:  []movie.MovieFile{{ID: uuid.New(), FilePath: "/media/movies/test.mkv"}},
+		item:   &movie.Movie{MinAge: new(int32(16))},
+		genres: []movie.MovieGenre{{Slug: "horror"}},
+	}
+	cfg := testConfig()
+	cfg.Playback.SegmentDir = t.TempDir()
+
+	svc, _ := newTestService(t, cfg, movieSvc, nil, nil)
+	req := &StartPlaybackRequest{MediaType: MediaTypeMovie, MediaID: uuid.New()}
+
+	tests := []struct {
+		name   string
+		filter *content.AccessFilter
+	}{
+		{"age limit", &content.AccessFilter{MaxAge: new(int32(12))}},
+		{"blocked tag", &content.AccessFilter{BlockedTags: []string{"horror"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := content.WithAccessFilter(context.Background(), tt.filter)
+			sess, err := svc.StartSession(ctx, uuid.New(), req)
+			assert.ErrorIs(t, err, content.ErrParentalRestriction)
+			assert.Nil(t, sess)
+		})
+	}
+}
+
 func TestStartSession_ProbeError(t *testing.T) {
 	fileID := uuid.New()
 	movieSvc := &mockMovieService{
@@ -195,7 +226,7 @@ func TestStartSession_MaxSessionsExceeded(t *testing.T) {
 	t.Cleanup(sm.Close)
 
 	pm := testPipelineManager(t)
-	svc, err := NewService(cfg, sm, pm, prober, movieSvc, nil, testLogger())
+	svc, err := NewService(cfg, sm, pm, prober, movieSvc, nil, nil, nil, nil, nil, nil, nil, testLogger())
 	require.NoError(t, err)
 	t.Cleanup(svc.Close)
 
@@ -256,3 +287,50 @@ func TestStartSession_Episode(t *testing.T) {
 
 	_ = svc.StopSession(sess.ID)
 }
+
+func TestStartSession_Channel(t *testing.T) {
+	ch := &livetv.Channel{ID: uuid.New(), StreamURL: "/srv/tuner/channel1.ts", Enabled: true}
+	prober := &mockProber{
+		info: &movie.MediaInfo{
+			VideoCodec:      "h264",
+			Width:           1920,
+			Height:          1080,
+			DurationSeconds: 12.5,
+			AudioStreams: []movie.AudioStreamInfo{
+				{Index: 0, Codec: "aac", Channels: 2, Language: "eng"},
+			},
+			SubtitleStreams: []movie.SubtitleStreamInfo{
+				{Index: 0, Codec: "subrip", Language: "eng"},
+			},
+		},
+	}
+
+	cfg := testConfig()
+	cfg.Playback.SegmentDir = t.TempDir()
+
+	svc, _ := newTestService(t, cfg, nil, nil, prober)
+	svc.liveTVSvc = &mockLiveTVService{channel: ch}
+
+	req := &StartPlaybackRequest{
+		MediaType:     MediaTypeChannel,
+		MediaID:       ch.ID,
+		StartPosition: 300,
+	}
+
+	sess, err := svc.StartSession(context.Background(), uuid.New(), req)
+	require.NoError(t, err)
+	require.NotNil(t, sess)
+
+	// Live sessions join at the live edge without duration or subtitles.
+	assert.Equal(t, ch.StreamURL, sess.FilePath)
+	assert.Zero(t, sess.StartPosition)
+	assert.Zero(t, sess.DurationSeconds)
+	assert.Empty(t, sess.SubtitleTracks)
+	assert.Len(t, sess.AudioTracks, 1)
+
+	job, ok := svc.pipeline.GetProcess(sess.ID, "original")
+	require.True(t, ok)
+	assert.True(t, job.Live)
+
+	_ = svc.StopSession(sess.ID)
+}
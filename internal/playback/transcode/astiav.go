@@ -67,6 +67,9 @@ type TranscodeJob struct {
 	AudioStreamIndex int // -1 to disable audio
 	SeekSeconds      int
 
+	// Live input (tuner or IPTV stream): sliding-window playlist, realtime pacing
+	Live bool
+
 	// DV handling
 	StripDolbyVision bool // strip DV RPU NALs + patch hvcC for non-DV clients
 
@@ -102,6 +105,7 @@ type TranscodeJobConfig struct {
 	AudioStreamIndex  int // -1 to disable
 	SeekSeconds       int
 	StripDolbyVision  bool // strip DV RPU NALs + patch hvcC for non-DV clients
+	Live              bool // input is a live stream; SeekSeconds is ignored
 }
 
 // NewTranscodeJob creates a new transcode job from the given config.
@@ -144,6 +148,7 @@ func NewTranscodeJob(cfg TranscodeJobConfig) *TranscodeJob {
 		AudioStreamIndex: cfg.AudioStreamIndex,
 		SeekSeconds:      cfg.SeekSeconds,
 		StripDolbyVision: cfg.StripDolbyVision,
+		Live:             cfg.Live,
 		Done:             make(chan struct{}),
 		IsTranscode:      isTranscode,
 	}
@@ -190,7 +195,14 @@ func (j *TranscodeJob) Run(ctx context.Context) error {
 	defer inputFmtCtx.Free()
 	inputFmtCtx.SetIOInterrupter(j.interrupter)
 
-	if err := inputFmtCtx.OpenInput(j.InputFile, nil, nil); err != nil {
+	var inputOpts *astiav.Dictionary
+	if j.Live {
+		if inputOpts = liveInputOptions(j.InputFile); inputOpts != nil {
+			defer inputOpts.Free()
+		}
+	}
+
+	if err := inputFmtCtx.OpenInput(j.InputFile, nil, inputOpts); err != nil {
 		return fmt.Errorf("failed to open input %q: %w", j.InputFile, err)
 	}
 	defer inputFmtCtx.CloseInput()
@@ -200,7 +212,7 @@ func (j *TranscodeJob) Run(ctx context.Context) error {
 	}
 
 	// --- Seek if needed ---
-	if j.SeekSeconds > 0 {
+	if j.SeekSeconds > 0 && !j.Live {
 		// Seek to timestamp in AV_TIME_BASE units
 		ts := int64(j.SeekSeconds) * int64(astiav.TimeBase)
 		if err := inputFmtCtx.SeekFrame(-1, ts, astiav.NewSeekFlags(astiav.SeekFlagBackward)); err != nil {
@@ -300,7 +312,14 @@ func (j *TranscodeJob) Run(ctx context.Context) error {
 		opts := pd.Options()
 		searchFlags := astiav.NewOptionSearchFlags()
 		_ = opts.Set("hls_time", strconv.Itoa(j.SegmentDuration), searchFlags)
-		_ = opts.Set("hls_playlist_type", "event", searchFlags)
+		if j.Live {
+			// Live streams have no start to seek back to: keep a sliding
+			// window and delete segments that fall out of it.
+			_ = opts.Set("hls_list_size", strconv.Itoa(liveWindowSegments), searchFlags)
+			_ = opts.Set("hls_flags", "delete_segments", searchFlags)
+		} else {
+			_ = opts.Set("hls_playlist_type", "event", searchFlags)
+		}
 		_ = opts.Set("hls_segment_filename", j.SegmentPattern, searchFlags)
 		_ = opts.Set("start_number", "0", searchFlags)
 		// Use fMP4 segments instead of MPEG-TS for HEVC/AV1/modern codec support.
@@ -416,6 +435,11 @@ func (j *TranscodeJob) Run(ctx context.Context) error {
 	}
 	defer pkt.Free()
 
+	var pacer *livePacer
+	if j.Live {
+		pacer = newLivePacer()
+	}
+
 	for {
 		// Check cancellation
 		if ctx.Err() != nil {
@@ -439,6 +463,15 @@ func (j *TranscodeJob) Run(ctx context.Context) error {
 			continue
 		}
 
+		if pacer != nil {
+			if ts, ok := packetTime(pkt, sm.inputStream.TimeBase()); ok {
+				if err := pacer.wait(ctx, ts); err != nil {
+					pkt.Unref()
+					break
+				}
+			}
+		}
+
 		if sm.needsDecode {
 			// Decode → filter → encode → write
 			if err := j.decodeFilterEncode(sm, decFrame, pkt, outputFmtCtx); err != nil {
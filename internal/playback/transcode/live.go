@@ -0,0 +1,109 @@
+package transcode
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asticode/go-astiav"
+)
+
+// Live inputs (tuner and IPTV channels) are segmented into a sliding-window
+// playlist instead of an event playlist that grows from the start.
+const (
+	// liveWindowSegments is the number of segments kept in a live playlist.
+	liveWindowSegments = 10
+
+	// liveReconnectDelayMax caps libavformat's reconnect back-off for HTTP
+	// streams, in seconds.
+	liveReconnectDelayMax = 5
+
+	// maxPaceDrift is how far a live input may drift from the wall clock
+	// before the pacer resyncs instead of stalling or bursting. Timestamps
+	// jump like this on wrap-around and on stream discontinuities.
+	maxPaceDrift = 10 * time.Second
+)
+
+// liveInputOptions returns the demuxer options for a live input. HTTP
+// streams reconnect when the tuner drops the connection; other inputs use
+// the defaults. The caller frees the returned dictionary.
+func liveInputOptions(input string) *astiav.Dictionary {
+	if !isHTTPInput(input) {
+		return nil
+	}
+	d := astiav.NewDictionary()
+	flags := astiav.NewDictionaryFlags()
+	_ = d.Set("reconnect", "1", flags)
+	_ = d.Set("reconnect_streamed", "1", flags)
+	_ = d.Set("reconnect_on_network_error", "1", flags)
+	_ = d.Set("reconnect_delay_max", strconv.Itoa(liveReconnectDelayMax), flags)
+	return d
+}
+
+func isHTTPInput(input string) bool {
+	lower := strings.ToLower(input)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
+
+// livePacer holds the packets of a live input back to their timestamps,
+// like ffmpeg's -re. Tuners deliver in real time anyway, but local files
+// and IPTV servers that burst would otherwise be segmented far ahead of
+// the live edge and rotated out of the playlist before anyone plays them.
+type livePacer struct {
+	now     func() time.Time
+	start   time.Time
+	origin  time.Duration
+	started bool
+}
+
+func newLivePacer() *livePacer {
+	return &livePacer{now: time.Now}
+}
+
+// delay returns how long to hold a packet with timestamp ts. The first
+// packet anchors the clock; a packet that drifts more than maxPaceDrift
+// from it re-anchors the clock instead.
+func (p *livePacer) delay(ts time.Duration) time.Duration {
+	now := p.now()
+	if !p.started {
+		p.start, p.origin, p.started = now, ts, true
+		return 0
+	}
+	d := p.start.Add(ts - p.origin).Sub(now)
+	if d > maxPaceDrift || d < -maxPaceDrift {
+		p.start, p.origin = now, ts
+		return 0
+	}
+	return max(d, 0)
+}
+
+// wait blocks until the packet with timestamp ts is due or ctx is done.
+func (p *livePacer) wait(ctx context.Context, ts time.Duration) error {
+	d := p.delay(ts)
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// packetTime returns the decode timestamp of pkt as a duration, falling
+// back to the presentation timestamp. ok is false for packets without
+// either.
+func packetTime(pkt *astiav.Packet, tb astiav.Rational) (time.Duration, bool) {
+	ts := pkt.Dts()
+	if ts == astiav.NoPtsValue {
+		ts = pkt.Pts()
+	}
+	if ts == astiav.NoPtsValue || tb.Den() == 0 {
+		return 0, false
+	}
+	return time.Duration(float64(ts) * tb.Float64() * float64(time.Second)), true
+}
@@ -0,0 +1,78 @@
+package transcode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func TestLivePacer_Delay(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)}
+	p := &livePacer{now: clock.now}
+
+	// The first packet anchors the clock, whatever its timestamp.
+	assert.Zero(t, p.delay(90*time.Second))
+
+	// A packet 2s into the stream is held until 2s have passed.
+	assert.Equal(t, 2*time.Second, p.delay(92*time.Second))
+
+	clock.t = clock.t.Add(1500 * time.Millisecond)
+	assert.Equal(t, 500*time.Millisecond, p.delay(92*time.Second))
+
+	// Packets that are already late are passed on immediately.
+	clock.t = clock.t.Add(3 * time.Second)
+	assert.Zero(t, p.delay(93*time.Second))
+}
+
+func TestLivePacer_ResyncsOnDiscontinuity(t *testing.T) {
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)}
+	p := &livePacer{now: clock.now}
+
+	assert.Zero(t, p.delay(10*time.Second))
+
+	// A timestamp jump forward (e.g. a new program on the multiplex)
+	// re-anchors the clock instead of stalling the stream.
+	assert.Zero(t, p.delay(10*time.Hour))
+	assert.Equal(t, time.Second, p.delay(10*time.Hour+time.Second))
+
+	// So does a jump backwards, such as a PTS wrap-around.
+	assert.Zero(t, p.delay(0))
+	assert.Equal(t, 3*time.Second, p.delay(3*time.Second))
+}
+
+func TestLivePacer_WaitCancelled(t *testing.T) {
+	clock := &fakeClock{t: time.Now()}
+	p := &livePacer{now: clock.now}
+	require.NoError(t, p.wait(context.Background(), 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, p.wait(ctx, 5*time.Second), context.Canceled)
+}
+
+func TestLiveInputOptions(t *testing.T) {
+	assert.Nil(t, liveInputOptions("/srv/tuner/channel1.ts"))
+	assert.Nil(t, liveInputOptions("udp://239.0.0.1:1234"))
+
+	assert.True(t, isHTTPInput("http://192.168.1.20:5004/auto/v5.1"))
+	assert.True(t, isHTTPInput("HTTPS://iptv.example.com/live/1.ts"))
+	assert.False(t, isHTTPInput("file:///srv/tuner/channel1.ts"))
+}
+
+func TestNewTranscodeJob_Live(t *testing.T) {
+	job := NewTranscodeJob(TranscodeJobConfig{
+		InputFile:  "http://192.168.1.20:5004/auto/v5.1",
+		OutputDir:  t.TempDir(),
+		VideoCodec: "copy",
+		Live:       true,
+	})
+
+	assert.True(t, job.Live)
+}
@@ -87,6 +87,17 @@ func processKey(sessionID uuid.UUID, profile string) string {
 // StartVideoSegmenting launches an in-process transcode job to output video-only HLS segments.
 // Audio is excluded — each audio track gets its own rendition via StartAudioRendition.
 func (pm *PipelineManager) StartVideoSegmenting(ctx context.Context, sessionID uuid.UUID, filePath, segmentDir string, pd ProfileDecision, seekSeconds int) (*TranscodeJob, error) {
+	return pm.startVideo(ctx, sessionID, filePath, segmentDir, pd, seekSeconds, false)
+}
+
+// StartLiveVideoSegmenting is StartVideoSegmenting for a live stream such
+// as a tuner channel: the input is read in real time into a sliding-window
+// playlist.
+func (pm *PipelineManager) StartLiveVideoSegmenting(ctx context.Context, sessionID uuid.UUID, streamURL, segmentDir string, pd ProfileDecision) (*TranscodeJob, error) {
+	return pm.startVideo(ctx, sessionID, streamURL, segmentDir, pd, 0, true)
+}
+
+func (pm *PipelineManager) startVideo(ctx context.Context, sessionID uuid.UUID, filePath, segmentDir string, pd ProfileDecision, seekSeconds int, live bool) (*TranscodeJob, error) {
 	key := processKey(sessionID, pd.Name)
 
 	profileDir := filepath.Join(segmentDir, pd.Name)
@@ -109,6 +120,7 @@ func (pm *PipelineManager) StartVideoSegmenting(ctx context.Context, sessionID u
 		AudioStreamIndex: -1, // disable audio
 		SeekSeconds:      seekSeconds,
 		StripDolbyVision: pd.StripDolbyVision,
+		Live:             live,
 	})
 
 	return pm.startJob(ctx, job, key, sessionID, pd.Name, pd.VideoCodec, pd.NeedsTranscode)
@@ -118,6 +130,17 @@ func (pm *PipelineManager) StartVideoSegmenting(ctx context.Context, sessionID u
 // for a single audio track. Each track is a separate rendition — HLS.js downloads
 // only the selected track's segments, preserving original quality and saving bandwidth.
 func (pm *PipelineManager) StartAudioRendition(ctx context.Context, sessionID uuid.UUID, filePath, segmentDir string, trackIndex int, codec string, bitrate, seekSeconds int) (*TranscodeJob, error) {
+	return pm.startAudio(ctx, sessionID, filePath, segmentDir, trackIndex, codec, bitrate, seekSeconds, false)
+}
+
+// StartLiveAudioRendition is StartAudioRendition for a live stream. Each
+// rendition opens the stream on its own, so a tuner serves one connection
+// per rendition.
+func (pm *PipelineManager) StartLiveAudioRendition(ctx context.Context, sessionID uuid.UUID, streamURL, segmentDir string, trackIndex int, codec string, bitrate int) (*TranscodeJob, error) {
+	return pm.startAudio(ctx, sessionID, streamURL, segmentDir, trackIndex, codec, bitrate, 0, true)
+}
+
+func (pm *PipelineManager) startAudio(ctx context.Context, sessionID uuid.UUID, filePath, segmentDir string, trackIndex int, codec string, bitrate, seekSeconds int, live bool) (*TranscodeJob, error) {
 	renditionName := fmt.Sprintf("audio/%d", trackIndex)
 	key := processKey(sessionID, renditionName)
 
@@ -138,6 +161,7 @@ func (pm *PipelineManager) StartAudioRendition(ctx context.Context, sessionID uu
 		VideoStreamIndex: -1, // disable video
 		AudioStreamIndex: trackIndex,
 		SeekSeconds:      seekSeconds,
+		Live:             live,
 	})
 
 	return pm.startJob(ctx, job, key, sessionID, renditionName, codec, codec != "copy")
@@ -65,6 +65,28 @@ func TestPipelineManager_StartVideoSegmenting_WithSeek(t *testing.T) {
 	assert.NoError(t, statErr)
 }
 
+func TestPipelineManager_StartLiveVideoSegmenting(t *testing.T) {
+	pm, err := NewPipelineManager(6, testLogger())
+	require.NoError(t, err)
+	defer pm.Close()
+
+	sessionID := uuid.New()
+	segDir := t.TempDir()
+
+	pd := ProfileDecision{
+		Name:       "original",
+		VideoCodec: "copy",
+	}
+
+	job, err := pm.StartLiveVideoSegmenting(context.Background(), sessionID, "/dev/null", segDir, pd)
+	require.NoError(t, err)
+	assert.True(t, job.Live)
+	assert.Zero(t, job.SeekSeconds)
+
+	_, statErr := os.Stat(filepath.Join(segDir, "original"))
+	assert.NoError(t, statErr)
+}
+
 // ===========================================================================
 // StartAudioRendition — directory creation
 // ===========================================================================
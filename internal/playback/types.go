@@ -9,22 +9,43 @@ import (
 	"github.com/lusoris/revenge/internal/playback/transcode"
 )
 
-// MediaType distinguishes movie files from episode files.
+// MediaType distinguishes movie files, movie extras, episode files, music
+// tracks, audiobooks, podcast episodes, live TV channels, home videos and
+// music videos.
 type MediaType string
 
 const (
-	MediaTypeMovie   MediaType = "movie"
-	MediaTypeEpisode MediaType = "episode"
+	MediaTypeMovie      MediaType = "movie"
+	MediaTypeMovieExtra MediaType = "movie_extra"
+	MediaTypeEpisode    MediaType = "episode"
+	MediaTypeTrack      MediaType = "track"
+	MediaTypeAudiobook  MediaType = "audiobook"
+
+	MediaTypePodcastEpisode MediaType = "podcast_episode"
+	MediaTypeChannel        MediaType = "channel"
+	MediaTypeHomeVideo      MediaType = "home_video"
+	MediaTypeMusicVideo     MediaType = "music_video"
 )
 
+// AudioOnly reports whether the media type is played without video.
+func (t MediaType) AudioOnly() bool {
+	return t == MediaTypeTrack || t == MediaTypeAudiobook || t == MediaTypePodcastEpisode
+}
+
+// Live reports whether the media type is a live stream. Live sessions
+// have no duration, cannot seek and carry no subtitle tracks.
+func (t MediaType) Live() bool {
+	return t == MediaTypeChannel
+}
+
 // Session represents an active playback/streaming session.
 type Session struct {
 	ID                uuid.UUID
 	UserID            uuid.UUID
 	MediaType         MediaType
-	MediaID           uuid.UUID // movie or episode ID
+	MediaID           uuid.UUID // movie, movie extra, episode, track, audiobook, podcast episode or channel ID
 	FileID            uuid.UUID
-	FilePath          string // absolute path to the media file
+	FilePath          string // absolute path to the media file, or the stream URL of a channel
 	SegmentDir        string // directory for this session's HLS segments
 	TranscodeDecision transcode.Decision
 	ActiveProfiles    []string // profile names being generated
@@ -155,6 +155,18 @@ const (
 	ActionAdminRoleRevoke = "admin.role.revoke"
 	ActionAdminUserBan    = "admin.user.ban"
 	ActionAdminUserUnban  = "admin.user.unban"
+
+	// QAR actions
+	ActionQARSceneList     = "qar.scene.list"
+	ActionQARSceneView     = "qar.scene.view"
+	ActionQARScenePlay     = "qar.scene.play"
+	ActionQARPerformerList = "qar.performer.list"
+	ActionQARPerformerView = "qar.performer.view"
+	ActionQARStudioList    = "qar.studio.list"
+	ActionQARStudioView    = "qar.studio.view"
+	ActionQARPINSet        = "qar.pin.set"
+	ActionQARUnlock        = "qar.unlock"
+	ActionQARLock          = "qar.lock"
 )
 
 // Resource type constants
@@ -168,4 +180,8 @@ const (
 	ResourceTypeMovie   = "movie"
 	ResourceTypeTVShow  = "tvshow"
 	ResourceTypeEpisode = "episode"
+
+	ResourceTypeQARScene     = "qar_scene"
+	ResourceTypeQARPerformer = "qar_performer"
+	ResourceTypeQARStudio    = "qar_studio"
 )
@@ -0,0 +1,34 @@
+package artwork
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// LocalPathPrefix marks image paths that refer to a local artwork record
+// instead of a TMDb path, e.g. "/local-<artwork id>". Such paths are stored
+// as poster or backdrop paths and served through the same image endpoint.
+const LocalPathPrefix = "/local-"
+
+// LocalPath returns the image path for a local artwork record.
+func LocalPath(artworkID uuid.UUID) string {
+	return LocalPathPrefix + artworkID.String()
+}
+
+// IsLocalPath reports whether an image path refers to local artwork.
+func IsLocalPath(path string) bool {
+	return strings.HasPrefix(path, LocalPathPrefix)
+}
+
+// ParseLocalPath returns the artwork ID of a local image path.
+func ParseLocalPath(path string) (uuid.UUID, bool) {
+	if !IsLocalPath(path) {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(strings.TrimPrefix(path, LocalPathPrefix))
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
@@ -0,0 +1,28 @@
+package artwork
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// Module provides artwork service dependencies.
+var Module = fx.Module("artwork",
+	fx.Provide(
+		newRepository,
+		newService,
+	),
+)
+
+// newRepository creates the artwork repository.
+func newRepository(queries *db.Queries) Repository {
+	return NewRepositoryPg(queries)
+}
+
+// newService creates the artwork service from the local artwork config.
+func newService(repo Repository, cfg *config.Config, logger *slog.Logger) *Service {
+	return NewService(repo, cfg.Metadata.LocalArtwork, logger)
+}
@@ -0,0 +1,91 @@
+package artwork
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// ErrNotFound is returned when an artwork record is not found.
+var ErrNotFound = errors.New("artwork not found")
+
+// Content types artwork can belong to.
+const (
+	ContentTypeMovie   = "movie"
+	ContentTypeSeries  = "series"
+	ContentTypeSeason  = "season"
+	ContentTypeEpisode = "episode"
+	ContentTypePerson  = "person"
+)
+
+// Artwork kinds.
+const (
+	KindPoster   = "poster"
+	KindBackdrop = "backdrop"
+	KindLogo     = "logo"
+	KindClearArt = "clearart"
+	KindBanner   = "banner"
+	KindThumb    = "thumb"
+	KindProfile  = "profile" // Photo of a person
+)
+
+// SourceLocal marks artwork found next to the media files.
+const SourceLocal = "local"
+
+// Repository defines persistence for artwork records.
+type Repository interface {
+	Upsert(ctx context.Context, art *Artwork) error
+	Get(ctx context.Context, id uuid.UUID) (*Artwork, error)
+	ListByContent(ctx context.Context, contentType string, contentID uuid.UUID) ([]Artwork, error)
+	DeleteStale(ctx context.Context, contentType string, contentID uuid.UUID, source string, keep []uuid.UUID) (int64, error)
+	Select(ctx context.Context, art *Artwork, userSelected bool) error
+	MarkCached(ctx context.Context, id uuid.UUID, fileSize int64) error
+	SetPlaceholder(ctx context.Context, id uuid.UUID, placeholder blurhash.Placeholder) error
+}
+
+// Artwork is an image that belongs to a movie, series or season.
+type Artwork struct {
+	ID          uuid.UUID `json:"id"`
+	ContentType string    `json:"content_type"`
+	ContentID   uuid.UUID `json:"content_id"`
+	Kind        string    `json:"kind"`
+	Source      string    `json:"source"`
+	Path        string    `json:"path"`
+	Width       *int32    `json:"width,omitempty"`
+	Height      *int32    `json:"height,omitempty"`
+	FileSize    *int64    `json:"file_size,omitempty"`
+	// Language is the ISO 639-1 language of text in the image, nil for
+	// textless images.
+	Language     *string    `json:"language,omitempty"`
+	VoteAverage  float64    `json:"vote_average"`
+	VoteCount    int32      `json:"vote_count"`
+	Selected     bool       `json:"selected"`
+	UserSelected bool       `json:"user_selected"`
+	CachedAt     *time.Time `json:"cached_at,omitempty"`
+	// BlurHash and DominantColor are computed when the image is cached.
+	BlurHash      *string   `json:"blurhash,omitempty"`
+	DominantColor *string   `json:"dominant_color,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Placeholder returns the placeholder computed for the image, or nil.
+func (a *Artwork) Placeholder() *blurhash.Placeholder {
+	if a.BlurHash == nil {
+		return nil
+	}
+	ph := &blurhash.Placeholder{BlurHash: *a.BlurHash}
+	if a.DominantColor != nil {
+		ph.Color = *a.DominantColor
+	}
+	return ph
+}
+
+// IsLocal reports whether the artwork is a file next to the media.
+func (a *Artwork) IsLocal() bool {
+	return a.Source == SourceLocal
+}
@@ -0,0 +1,150 @@
+package artwork
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// RepositoryPg implements Repository using PostgreSQL.
+type RepositoryPg struct {
+	queries *db.Queries
+}
+
+// NewRepositoryPg creates a new PostgreSQL artwork repository.
+func NewRepositoryPg(queries *db.Queries) *RepositoryPg {
+	return &RepositoryPg{queries: queries}
+}
+
+// Upsert inserts or refreshes an artwork record keyed by owner, kind,
+// source and path.
+func (r *RepositoryPg) Upsert(ctx context.Context, art *Artwork) error {
+	result, err := r.queries.UpsertArtwork(ctx, db.UpsertArtworkParams{
+		ContentType: art.ContentType,
+		ContentID:   art.ContentID,
+		Kind:        art.Kind,
+		Source:      art.Source,
+		Path:        art.Path,
+		Width:       art.Width,
+		Height:      art.Height,
+		FileSize:    art.FileSize,
+		Language:    art.Language,
+		VoteAverage: art.VoteAverage,
+		VoteCount:   art.VoteCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	*art = *dbArtworkToArtwork(result)
+	return nil
+}
+
+// Get retrieves an artwork record by ID.
+func (r *RepositoryPg) Get(ctx context.Context, id uuid.UUID) (*Artwork, error) {
+	result, err := r.queries.GetArtwork(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbArtworkToArtwork(result), nil
+}
+
+// ListByContent returns the artwork of a movie, series or season.
+func (r *RepositoryPg) ListByContent(ctx context.Context, contentType string, contentID uuid.UUID) ([]Artwork, error) {
+	results, err := r.queries.ListArtworkByContent(ctx, db.ListArtworkByContentParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]Artwork, len(results))
+	for i, result := range results {
+		items[i] = *dbArtworkToArtwork(result)
+	}
+	return items, nil
+}
+
+// DeleteStale removes the artwork of one source except the kept IDs.
+func (r *RepositoryPg) DeleteStale(ctx context.Context, contentType string, contentID uuid.UUID, source string, keep []uuid.UUID) (int64, error) {
+	if keep == nil {
+		keep = []uuid.UUID{}
+	}
+	return r.queries.DeleteStaleArtwork(ctx, db.DeleteStaleArtworkParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Source:      source,
+		KeepIds:     keep,
+	})
+}
+
+// Select makes art the selected image of its kind for its owner.
+func (r *RepositoryPg) Select(ctx context.Context, art *Artwork, userSelected bool) error {
+	rows, err := r.queries.SelectArtwork(ctx, db.SelectArtworkParams{
+		ID:           art.ID,
+		UserSelected: userSelected,
+		ContentType:  art.ContentType,
+		ContentID:    art.ContentID,
+		Kind:         art.Kind,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	art.Selected = true
+	art.UserSelected = userSelected
+	return nil
+}
+
+// MarkCached records that an image was stored in the local image cache.
+func (r *RepositoryPg) MarkCached(ctx context.Context, id uuid.UUID, fileSize int64) error {
+	return r.queries.MarkArtworkCached(ctx, db.MarkArtworkCachedParams{
+		ID:       id,
+		FileSize: &fileSize,
+	})
+}
+
+// SetPlaceholder stores the placeholder computed for an image.
+func (r *RepositoryPg) SetPlaceholder(ctx context.Context, id uuid.UUID, placeholder blurhash.Placeholder) error {
+	params := db.SetArtworkPlaceholderParams{ID: id, Blurhash: &placeholder.BlurHash}
+	if placeholder.Color != "" {
+		params.DominantColor = &placeholder.Color
+	}
+	return r.queries.SetArtworkPlaceholder(ctx, params)
+}
+
+func dbArtworkToArtwork(a db.Artwork) *Artwork {
+	art := &Artwork{
+		ID:            a.ID,
+		ContentType:   a.ContentType,
+		ContentID:     a.ContentID,
+		Kind:          a.Kind,
+		Source:        a.Source,
+		Path:          a.Path,
+		Width:         a.Width,
+		Height:        a.Height,
+		FileSize:      a.FileSize,
+		Language:      a.Language,
+		VoteAverage:   a.VoteAverage,
+		VoteCount:     a.VoteCount,
+		Selected:      a.Selected,
+		UserSelected:  a.UserSelected,
+		BlurHash:      a.Blurhash,
+		DominantColor: a.DominantColor,
+		CreatedAt:     a.CreatedAt,
+		UpdatedAt:     a.UpdatedAt,
+	}
+	if a.CachedAt.Valid {
+		art.CachedAt = &a.CachedAt.Time
+	}
+	return art
+}
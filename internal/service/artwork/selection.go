@@ -0,0 +1,204 @@
+package artwork
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// selectableKinds are the kinds automatic selection picks an image for.
+var selectableKinds = []string{KindPoster, KindBackdrop, KindLogo, KindClearArt, KindBanner, KindThumb}
+
+// fallbackLanguage is preferred after the requested language.
+const fallbackLanguage = "en"
+
+// ImagePath returns the path to store as an item's poster or backdrop path.
+// Local artwork is referenced by ID so it is served through the image proxy.
+func (a *Artwork) ImagePath() string {
+	if a.IsLocal() {
+		return LocalPath(a.ID)
+	}
+	return a.Path
+}
+
+// NeedsCaching reports whether the image still has to be downloaded into
+// the image cache or have its placeholder computed. Local images are read
+// from disk and only need a placeholder.
+func (a *Artwork) NeedsCaching() bool {
+	return a.BlurHash == nil || (!a.IsLocal() && a.CachedAt == nil)
+}
+
+// Candidates returns the artwork of an item with the candidates of each kind
+// in order of preference for language.
+func (s *Service) Candidates(ctx context.Context, contentType string, contentID uuid.UUID, language string) ([]Artwork, error) {
+	items, err := s.repo.ListByContent(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+	Rank(items, language)
+	return items, nil
+}
+
+// AutoSelect picks the preferred image of each kind for an item and returns
+// the selected images. Kinds whose image was chosen by an admin keep it.
+func (s *Service) AutoSelect(ctx context.Context, contentType string, contentID uuid.UUID, language string) ([]Artwork, error) {
+	items, err := s.repo.ListByContent(ctx, contentType, contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]Artwork, 0, len(selectableKinds))
+	for _, kind := range selectableKinds {
+		current := selectedOf(items, kind)
+		if current != nil && current.UserSelected {
+			selected = append(selected, *current)
+			continue
+		}
+
+		best := Best(items, kind, language, s.config.PreferLocal)
+		if best == nil {
+			continue
+		}
+		if current == nil || current.ID != best.ID {
+			if err := s.repo.Select(ctx, best, false); err != nil {
+				return nil, fmt.Errorf("select %s artwork: %w", kind, err)
+			}
+		}
+		selected = append(selected, *best)
+	}
+	return selected, nil
+}
+
+// Choose makes an image the selected image of its kind on behalf of an
+// admin. Automatic selection keeps it until another image is chosen.
+func (s *Service) Choose(ctx context.Context, contentType string, contentID, id uuid.UUID) (*Artwork, error) {
+	art, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if art.ContentType != contentType || art.ContentID != contentID {
+		return nil, ErrNotFound
+	}
+	if err := s.repo.Select(ctx, art, true); err != nil {
+		return nil, fmt.Errorf("select artwork: %w", err)
+	}
+	return art, nil
+}
+
+// MarkCached records that an image was stored in the local image cache.
+func (s *Service) MarkCached(ctx context.Context, id uuid.UUID, fileSize int64) error {
+	return s.repo.MarkCached(ctx, id, fileSize)
+}
+
+// SetPlaceholder stores the placeholder computed for an image.
+func (s *Service) SetPlaceholder(ctx context.Context, id uuid.UUID, placeholder blurhash.Placeholder) error {
+	return s.repo.SetPlaceholder(ctx, id, placeholder)
+}
+
+// Best returns the preferred image of a kind, or nil. Local artwork is only
+// chosen when preferLocal is set, and then ahead of provider images.
+// Provider images are ordered as described by Rank.
+func Best(items []Artwork, kind, language string, preferLocal bool) *Artwork {
+	var best *Artwork
+	for i := range items {
+		art := &items[i]
+		if art.Kind != kind {
+			continue
+		}
+		if art.IsLocal() {
+			if preferLocal {
+				return art
+			}
+			continue
+		}
+		if best == nil || compareCandidates(art, best, language) < 0 {
+			best = art
+		}
+	}
+	return best
+}
+
+// Rank sorts artwork by kind and, within a kind, by preference: images in
+// language first, except for backdrops where textless images come first,
+// then textless images, then English, then by vote average, vote count and
+// resolution.
+func Rank(items []Artwork, language string) {
+	slices.SortStableFunc(items, func(a, b Artwork) int {
+		if c := cmp.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+		return compareCandidates(&a, &b, language)
+	})
+}
+
+// compareCandidates orders two images of the same kind, most preferred
+// first.
+func compareCandidates(a, b *Artwork, language string) int {
+	if c := cmp.Compare(languageRank(a, language), languageRank(b, language)); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(b.VoteAverage, a.VoteAverage); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(b.VoteCount, a.VoteCount); c != 0 {
+		return c
+	}
+	return cmp.Compare(pixels(b), pixels(a))
+}
+
+// languageRank scores how well the text of an image suits language; lower
+// is better.
+func languageRank(art *Artwork, language string) int {
+	textless := art.Language == nil || *art.Language == ""
+	switch {
+	case textless && art.Kind == KindBackdrop:
+		return 0
+	case !textless && sameLanguage(*art.Language, language):
+		return 1
+	case textless:
+		return 2
+	case sameLanguage(*art.Language, fallbackLanguage):
+		return 3
+	default:
+		return 4
+	}
+}
+
+// sameLanguage compares the primary subtags of two language tags, so "de"
+// matches "de-AT".
+func sameLanguage(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	return strings.EqualFold(primarySubtag(a), primarySubtag(b))
+}
+
+func primarySubtag(tag string) string {
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+func pixels(art *Artwork) int64 {
+	if art.Width == nil || art.Height == nil {
+		return 0
+	}
+	return int64(*art.Width) * int64(*art.Height)
+}
+
+// selectedOf returns the selected image of a kind, or nil.
+func selectedOf(items []Artwork, kind string) *Artwork {
+	for i := range items {
+		if items[i].Kind == kind && items[i].Selected {
+			return &items[i]
+		}
+	}
+	return nil
+}
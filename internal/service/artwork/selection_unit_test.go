@@ -0,0 +1,149 @@
+package artwork_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+func candidate(kind, path string, language *string, votes float64) artwork.Artwork {
+	return artwork.Artwork{
+		ID:          uuid.Must(uuid.NewV7()),
+		Kind:        kind,
+		Source:      "tmdb",
+		Path:        path,
+		Language:    language,
+		VoteAverage: votes,
+	}
+}
+
+func TestBest(t *testing.T) {
+	items := []artwork.Artwork{
+		candidate(artwork.KindPoster, "/en.jpg", new("en"), 5),
+		candidate(artwork.KindPoster, "/de.jpg", new("de"), 4),
+		candidate(artwork.KindPoster, "/textless.jpg", nil, 6),
+		candidate(artwork.KindPoster, "/fr.jpg", new("fr"), 9),
+		candidate(artwork.KindBackdrop, "/backdrop-de.jpg", new("de"), 9),
+		candidate(artwork.KindBackdrop, "/backdrop.jpg", nil, 2),
+		{ID: uuid.Must(uuid.NewV7()), Kind: artwork.KindPoster, Source: artwork.SourceLocal, Path: "/media/poster.jpg"},
+	}
+
+	tests := []struct {
+		name        string
+		kind        string
+		language    string
+		preferLocal bool
+		want        string
+	}{
+		{name: "poster in user language", kind: artwork.KindPoster, language: "de-AT", want: "/de.jpg"},
+		{name: "textless poster without a match", kind: artwork.KindPoster, language: "ja", want: "/textless.jpg"},
+		{name: "textless backdrop first", kind: artwork.KindBackdrop, language: "de", want: "/backdrop.jpg"},
+		{name: "preferred local artwork", kind: artwork.KindPoster, language: "de", preferLocal: true, want: "/media/poster.jpg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best := artwork.Best(items, tt.kind, tt.language, tt.preferLocal)
+			require.NotNil(t, best)
+			assert.Equal(t, tt.want, best.Path)
+		})
+	}
+
+	assert.Nil(t, artwork.Best(items, artwork.KindLogo, "en", false))
+}
+
+func TestRank(t *testing.T) {
+	small, large := int32(500), int32(1000)
+	a := candidate(artwork.KindPoster, "/a.jpg", new("en"), 5)
+	b := candidate(artwork.KindPoster, "/b.jpg", new("en"), 5)
+	b.VoteCount = 10
+	c := candidate(artwork.KindPoster, "/c.jpg", new("en"), 5)
+	c.VoteCount = 10
+	c.Width, c.Height = &large, &large
+	b.Width, b.Height = &small, &small
+	logo := candidate(artwork.KindLogo, "/logo.png", new("en"), 1)
+
+	items := []artwork.Artwork{a, logo, b, c}
+	artwork.Rank(items, "en")
+
+	paths := make([]string, len(items))
+	for i := range items {
+		paths[i] = items[i].Path
+	}
+	assert.Equal(t, []string{"/logo.png", "/c.jpg", "/b.jpg", "/a.jpg"}, paths)
+}
+
+func TestService_AutoSelect(t *testing.T) {
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+
+	chosen := candidate(artwork.KindPoster, "/chosen.jpg", new("fr"), 1)
+	chosen.Selected, chosen.UserSelected = true, true
+	stale := candidate(artwork.KindBackdrop, "/old.jpg", new("en"), 3)
+	stale.Selected = true
+	textless := candidate(artwork.KindBackdrop, "/textless.jpg", nil, 1)
+	items := []artwork.Artwork{
+		chosen,
+		candidate(artwork.KindPoster, "/en.jpg", new("en"), 9),
+		stale,
+		textless,
+	}
+
+	repo := new(mockRepository)
+	svc := artwork.NewService(repo, config.LocalArtworkConfig{}, logging.NewTestLogger())
+	repo.On("ListByContent", ctx, artwork.ContentTypeMovie, movieID).Return(items, nil)
+	repo.On("Select", ctx, mock.MatchedBy(func(a *artwork.Artwork) bool {
+		return a.ID == textless.ID
+	}), false).Return(nil)
+
+	selected, err := svc.AutoSelect(ctx, artwork.ContentTypeMovie, movieID, "en")
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	assert.Equal(t, "/chosen.jpg", selected[0].Path)
+	assert.Equal(t, "/textless.jpg", selected[1].Path)
+	repo.AssertExpectations(t)
+}
+
+func TestService_Choose(t *testing.T) {
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+	art := candidate(artwork.KindLogo, "/logo.png", nil, 0)
+	art.ContentType, art.ContentID = artwork.ContentTypeMovie, movieID
+
+	repo := new(mockRepository)
+	svc := artwork.NewService(repo, config.LocalArtworkConfig{}, logging.NewTestLogger())
+	repo.On("Get", ctx, art.ID).Return(&art, nil)
+	repo.On("Select", ctx, &art, true).Return(nil)
+
+	_, err := svc.Choose(ctx, artwork.ContentTypeSeries, movieID, art.ID)
+	assert.ErrorIs(t, err, artwork.ErrNotFound)
+
+	chosen, err := svc.Choose(ctx, artwork.ContentTypeMovie, movieID, art.ID)
+	require.NoError(t, err)
+	assert.Equal(t, art.ID, chosen.ID)
+	repo.AssertNumberOfCalls(t, "Select", 1)
+}
+
+func TestLocalPath(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.Must(uuid.NewV7())
+
+	path := artwork.LocalPath(id)
+	assert.True(t, artwork.IsLocalPath(path))
+	assert.False(t, artwork.IsLocalPath("/f89U3ADr1oiB1s9GkdPOEpXUk5H.jpg"))
+
+	parsed, ok := artwork.ParseLocalPath(path)
+	require.True(t, ok)
+	assert.Equal(t, id, parsed)
+
+	_, ok = artwork.ParseLocalPath(artwork.LocalPathPrefix + "not-a-uuid")
+	assert.False(t, ok)
+}
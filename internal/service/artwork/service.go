@@ -0,0 +1,176 @@
+package artwork
+
+import (
+	"context"
+	"fmt"
+	stdimage "image"
+	_ "image/gif"  // register GIF decoder for dimension detection
+	_ "image/jpeg" // register JPEG decoder for dimension detection
+	_ "image/png"  // register PNG decoder for dimension detection
+	"log/slog"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+// Service records artwork and resolves it for serving.
+type Service struct {
+	repo   Repository
+	config config.LocalArtworkConfig
+	logger *slog.Logger
+}
+
+// NewService creates a new artwork service.
+func NewService(repo Repository, cfg config.LocalArtworkConfig, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		config: cfg,
+		logger: logger.With("component", "artwork"),
+	}
+}
+
+// LocalEnabled reports whether scanners should look for local artwork.
+func (s *Service) LocalEnabled() bool {
+	return s.config.Enabled
+}
+
+// PreferLocal reports whether local posters and backdrops replace
+// provider images.
+func (s *Service) PreferLocal() bool {
+	return s.config.PreferLocal
+}
+
+// Get returns a single artwork record.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*Artwork, error) {
+	return s.repo.Get(ctx, id)
+}
+
+// List returns the artwork of a movie, series or season.
+func (s *Service) List(ctx context.Context, contentType string, contentID uuid.UUID) ([]Artwork, error) {
+	return s.repo.ListByContent(ctx, contentType, contentID)
+}
+
+// SyncLocal records the local artwork found for a movie, series or season
+// and removes local records whose files are gone. It returns the current
+// local artwork.
+func (s *Service) SyncLocal(ctx context.Context, contentType string, contentID uuid.UUID, files []scanner.LocalArtwork) ([]Artwork, error) {
+	synced := make([]Artwork, 0, len(files))
+	keep := make([]uuid.UUID, 0, len(files))
+
+	for _, file := range files {
+		art := &Artwork{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Kind:        file.Kind,
+			Source:      SourceLocal,
+			Path:        file.Path,
+		}
+		readImageInfo(art)
+
+		if err := s.repo.Upsert(ctx, art); err != nil {
+			return nil, fmt.Errorf("record local artwork %s: %w", file.Path, err)
+		}
+		synced = append(synced, *art)
+		keep = append(keep, art.ID)
+	}
+
+	removed, err := s.repo.DeleteStale(ctx, contentType, contentID, SourceLocal, keep)
+	if err != nil {
+		return nil, fmt.Errorf("remove stale local artwork: %w", err)
+	}
+
+	if len(synced) > 0 || removed > 0 {
+		s.logger.Debug("synced local artwork",
+			slog.String("content_type", contentType),
+			slog.String("content_id", contentID.String()),
+			slog.Int("found", len(synced)),
+			slog.Int64("removed", removed),
+		)
+	}
+	return synced, nil
+}
+
+// ProviderImage is an image offered by a metadata provider.
+type ProviderImage struct {
+	Kind        string
+	Path        string
+	Width       int
+	Height      int
+	Language    *string // ISO 639-1; nil for textless images
+	VoteAverage float64
+	VoteCount   int
+}
+
+// SyncProvider records the images a provider offers for an item and removes
+// the provider's records that are no longer offered. It returns the current
+// artwork from that provider.
+func (s *Service) SyncProvider(ctx context.Context, contentType string, contentID uuid.UUID, source string, images []ProviderImage) ([]Artwork, error) {
+	synced := make([]Artwork, 0, len(images))
+	keep := make([]uuid.UUID, 0, len(images))
+
+	for _, img := range images {
+		art := &Artwork{
+			ContentType: contentType,
+			ContentID:   contentID,
+			Kind:        img.Kind,
+			Source:      source,
+			Path:        img.Path,
+			Language:    img.Language,
+			VoteAverage: img.VoteAverage,
+			VoteCount:   util.SafeIntToInt32(img.VoteCount),
+		}
+		if img.Width > 0 && img.Height > 0 {
+			width := util.SafeIntToInt32(img.Width)
+			height := util.SafeIntToInt32(img.Height)
+			art.Width = &width
+			art.Height = &height
+		}
+
+		if err := s.repo.Upsert(ctx, art); err != nil {
+			return nil, fmt.Errorf("record %s artwork %s: %w", source, img.Path, err)
+		}
+		synced = append(synced, *art)
+		keep = append(keep, art.ID)
+	}
+
+	if _, err := s.repo.DeleteStale(ctx, contentType, contentID, source, keep); err != nil {
+		return nil, fmt.Errorf("remove stale %s artwork: %w", source, err)
+	}
+	return synced, nil
+}
+
+// readImageInfo fills in file size and, for formats the standard library
+// decodes, the image dimensions. Failures leave the fields empty.
+func readImageInfo(art *Artwork) {
+	f, err := os.Open(art.Path)
+	if err != nil {
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	if info, err := f.Stat(); err == nil {
+		size := info.Size()
+		art.FileSize = &size
+	}
+
+	if cfg, _, err := stdimage.DecodeConfig(f); err == nil {
+		width := util.SafeIntToInt32(cfg.Width)
+		height := util.SafeIntToInt32(cfg.Height)
+		art.Width = &width
+		art.Height = &height
+	}
+}
+
+// Select returns the artwork of a kind from a synced set, or nil.
+func Select(items []Artwork, kind string) *Artwork {
+	for i := range items {
+		if items[i].Kind == kind {
+			return &items[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package artwork_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content/shared/scanner"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/artwork"
+)
+
+// mockRepository implements artwork.Repository for testing.
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) Upsert(ctx context.Context, art *artwork.Artwork) error {
+	return m.Called(ctx, art).Error(0)
+}
+
+func (m *mockRepository) Get(ctx context.Context, id uuid.UUID) (*artwork.Artwork, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*artwork.Artwork), args.Error(1)
+}
+
+func (m *mockRepository) ListByContent(ctx context.Context, contentType string, contentID uuid.UUID) ([]artwork.Artwork, error) {
+	args := m.Called(ctx, contentType, contentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]artwork.Artwork), args.Error(1)
+}
+
+func (m *mockRepository) DeleteStale(ctx context.Context, contentType string, contentID uuid.UUID, source string, keep []uuid.UUID) (int64, error) {
+	args := m.Called(ctx, contentType, contentID, source, keep)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockRepository) Select(ctx context.Context, art *artwork.Artwork, userSelected bool) error {
+	return m.Called(ctx, art, userSelected).Error(0)
+}
+
+func (m *mockRepository) MarkCached(ctx context.Context, id uuid.UUID, fileSize int64) error {
+	return m.Called(ctx, id, fileSize).Error(0)
+}
+
+func (m *mockRepository) SetPlaceholder(ctx context.Context, id uuid.UUID, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, placeholder).Error(0)
+}
+
+func writePNG(t *testing.T, path string, width, height int) {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+}
+
+func TestService_SyncLocal(t *testing.T) {
+	ctx := context.Background()
+	movieID := uuid.Must(uuid.NewV7())
+	poster := filepath.Join(t.TempDir(), "poster.png")
+	writePNG(t, poster, 20, 30)
+
+	repo := new(mockRepository)
+	svc := artwork.NewService(repo, config.LocalArtworkConfig{Enabled: true}, logging.NewTestLogger())
+
+	artID := uuid.Must(uuid.NewV7())
+	repo.On("Upsert", ctx, mock.MatchedBy(func(a *artwork.Artwork) bool {
+		return a.ContentType == artwork.ContentTypeMovie &&
+			a.ContentID == movieID &&
+			a.Kind == scanner.ArtworkPoster &&
+			a.Source == artwork.SourceLocal &&
+			a.Path == poster &&
+			*a.Width == 20 && *a.Height == 30 && *a.FileSize > 0
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*artwork.Artwork).ID = artID
+	}).Return(nil)
+	repo.On("DeleteStale", ctx, artwork.ContentTypeMovie, movieID, artwork.SourceLocal, []uuid.UUID{artID}).Return(int64(1), nil)
+
+	synced, err := svc.SyncLocal(ctx, artwork.ContentTypeMovie, movieID, []scanner.LocalArtwork{
+		{Kind: scanner.ArtworkPoster, Path: poster},
+	})
+	require.NoError(t, err)
+	require.Len(t, synced, 1)
+	assert.Equal(t, artID, synced[0].ID)
+	assert.True(t, synced[0].IsLocal())
+
+	assert.Same(t, &synced[0], artwork.Select(synced, scanner.ArtworkPoster))
+	assert.Nil(t, artwork.Select(synced, scanner.ArtworkBackdrop))
+	repo.AssertExpectations(t)
+}
+
+func TestService_SyncLocal_NoFilesRemovesAll(t *testing.T) {
+	ctx := context.Background()
+	seriesID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := artwork.NewService(repo, config.LocalArtworkConfig{}, logging.NewTestLogger())
+	repo.On("DeleteStale", ctx, artwork.ContentTypeSeries, seriesID, artwork.SourceLocal, []uuid.UUID{}).Return(int64(2), nil)
+
+	synced, err := svc.SyncLocal(ctx, artwork.ContentTypeSeries, seriesID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, synced)
+	repo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
+
+func TestService_SyncLocal_UpsertError(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(mockRepository)
+	svc := artwork.NewService(repo, config.LocalArtworkConfig{}, logging.NewTestLogger())
+	repo.On("Upsert", ctx, mock.Anything).Return(errors.New("db down"))
+
+	_, err := svc.SyncLocal(ctx, artwork.ContentTypeMovie, uuid.Must(uuid.NewV7()), []scanner.LocalArtwork{
+		{Kind: scanner.ArtworkBackdrop, Path: "/missing/fanart.jpg"},
+	})
+	assert.ErrorContains(t, err, "db down")
+	repo.AssertNotCalled(t, "DeleteStale", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_SyncProvider(t *testing.T) {
+	ctx := context.Background()
+	personID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := artwork.NewService(repo, config.LocalArtworkConfig{}, logging.NewTestLogger())
+
+	artID := uuid.Must(uuid.NewV7())
+	repo.On("Upsert", ctx, mock.MatchedBy(func(a *artwork.Artwork) bool {
+		return a.ContentType == artwork.ContentTypePerson &&
+			a.Kind == artwork.KindProfile &&
+			a.Source == "tmdb" &&
+			a.Path == "/abc.jpg" &&
+			*a.Width == 500 && *a.Height == 750
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*artwork.Artwork).ID = artID
+	}).Return(nil)
+	repo.On("DeleteStale", ctx, artwork.ContentTypePerson, personID, "tmdb", []uuid.UUID{artID}).Return(int64(0), nil)
+
+	synced, err := svc.SyncProvider(ctx, artwork.ContentTypePerson, personID, "tmdb", []artwork.ProviderImage{
+		{Kind: artwork.KindProfile, Path: "/abc.jpg", Width: 500, Height: 750},
+	})
+	require.NoError(t, err)
+	require.Len(t, synced, 1)
+	assert.False(t, synced[0].IsLocal())
+	repo.AssertExpectations(t)
+}
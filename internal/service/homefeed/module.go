@@ -0,0 +1,57 @@
+package homefeed
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// Module provides the home feed service.
+var Module = fx.Module("homefeed",
+	fx.Provide(
+		newService,
+	),
+)
+
+// serviceParams are the dependencies of the home feed. Content services of
+// disabled modules are missing.
+type serviceParams struct {
+	fx.In
+
+	Libraries   *library.CachedService
+	Movies      movie.Service      `optional:"true"`
+	TVShows     tvshow.Service     `optional:"true"`
+	Music       music.Service      `optional:"true"`
+	Audiobooks  audiobook.Service  `optional:"true"`
+	Books       book.Service       `optional:"true"`
+	HomeVideos  homevideo.Service  `optional:"true"`
+	MusicVideos musicvideo.Service `optional:"true"`
+	Podcasts    podcast.Service    `optional:"true"`
+	Cache       *cache.Cache
+	Logger      *slog.Logger
+}
+
+// newService creates the home feed service.
+func newService(p serviceParams) *Service {
+	return NewService(p.Libraries, Sources{
+		Movies:      p.Movies,
+		TVShows:     p.TVShows,
+		Music:       p.Music,
+		Audiobooks:  p.Audiobooks,
+		Books:       p.Books,
+		HomeVideos:  p.HomeVideos,
+		MusicVideos: p.MusicVideos,
+		Podcasts:    p.Podcasts,
+	}, p.Cache, p.Logger)
+}
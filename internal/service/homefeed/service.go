@@ -0,0 +1,324 @@
+// Package homefeed builds the home screen of a user: typed, paginated rows
+// that aggregate all enabled content modules, such as on deck, continue
+// watching, next up, recently added per library and recommendations.
+package homefeed
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+const (
+	// MaxRowItems is the most items a row holds.
+	MaxRowItems = 100
+	// DefaultRowLimit is the page size of rows when none is requested.
+	DefaultRowLimit = 20
+
+	recentlyAddedPrefix = string(RowRecentlyAdded) + ":"
+)
+
+// LibraryLister resolves the libraries a viewer may browse.
+type LibraryLister interface {
+	ListEnabled(ctx context.Context) ([]library.Library, error)
+	ListAccessible(ctx context.Context, userID uuid.UUID) ([]library.Library, error)
+}
+
+// Sources are the content services the feed draws from. Services of
+// disabled modules are nil and skipped.
+type Sources struct {
+	Movies      movie.Service
+	TVShows     tvshow.Service
+	Music       music.Service
+	Audiobooks  audiobook.Service
+	Books       book.Service
+	HomeVideos  homevideo.Service
+	MusicVideos musicvideo.Service
+	Podcasts    podcast.Service
+}
+
+// Service builds home feeds. Rows are cached per user until the user's
+// progress changes; see Invalidate.
+type Service struct {
+	libraries LibraryLister
+	sources   Sources
+	cache     *cache.Cache
+	logger    *slog.Logger
+}
+
+// NewService creates a home feed service. The cache may be nil.
+func NewService(libraries LibraryLister, sources Sources, c *cache.Cache, logger *slog.Logger) *Service {
+	return &Service{
+		libraries: libraries,
+		sources:   sources,
+		cache:     c,
+		logger:    logger.With("component", "homefeed"),
+	}
+}
+
+// Feed returns the rows of the viewer's home feed with the first limit
+// items each. Empty rows and rows whose source fails are left out.
+func (s *Service) Feed(ctx context.Context, viewer Viewer, limit int32) ([]Row, error) {
+	acc, err := s.resolveAccess(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{string(RowOnDeck), string(RowContinueWatching), string(RowNextUp)}
+	ids = append(ids, s.recentlyAddedRowIDs(acc)...)
+	ids = append(ids, string(RowRecommended))
+
+	rows := make([]Row, 0, len(ids))
+	for _, id := range ids {
+		row, err := s.loadRow(ctx, viewer, acc, id)
+		if err != nil {
+			s.logger.Warn("failed to build home feed row",
+				slog.String("row", id), slog.Any("error", err))
+			continue
+		}
+		if row.Total > 0 {
+			rows = append(rows, row.page(limit, 0))
+		}
+	}
+	return rows, nil
+}
+
+// Row returns a page of a single row. It returns ErrRowNotFound for
+// unknown rows and libraries the viewer may not browse.
+func (s *Service) Row(ctx context.Context, viewer Viewer, id string, limit, offset int32) (*Row, error) {
+	acc, err := s.resolveAccess(ctx, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(s.rowIDs(acc), id) {
+		return nil, ErrRowNotFound
+	}
+
+	row, err := s.loadRow(ctx, viewer, acc, id)
+	if err != nil {
+		return nil, err
+	}
+	page := row.page(limit, offset)
+	return &page, nil
+}
+
+// Invalidate drops the cached feed of a user. Call it whenever the user's
+// watch, listen or read progress changes.
+func (s *Service) Invalidate(ctx context.Context, userID uuid.UUID) error {
+	if s.cache == nil {
+		return nil
+	}
+	return s.cache.InvalidateHomeFeed(ctx, userID.String())
+}
+
+// rowIDs returns the IDs of all rows the viewer may load.
+func (s *Service) rowIDs(acc access) []string {
+	ids := []string{string(RowOnDeck), string(RowContinueWatching), string(RowNextUp), string(RowRecommended)}
+	return append(ids, s.recentlyAddedRowIDs(acc)...)
+}
+
+// recentlyAddedRowIDs returns the recently added rows in library order.
+// Content of modules that store it per library gets a row per library;
+// the others get one row per library type.
+func (s *Service) recentlyAddedRowIDs(acc access) []string {
+	var ids []string
+	for _, lib := range acc.libraries {
+		if !s.hasRecentlyAdded(lib.Type) {
+			continue
+		}
+		id := recentlyAddedPrefix + lib.Type
+		if perLibraryTypes[lib.Type] {
+			id = recentlyAddedPrefix + lib.ID.String()
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// loadRow returns all items of a row, from the cache when possible. The
+// cache is bypassed while parental controls restrict the user, as the
+// rows are cached without the restriction.
+func (s *Service) loadRow(ctx context.Context, viewer Viewer, acc access, id string) (Row, error) {
+	load := func(ctx context.Context) (Row, error) {
+		return s.buildRow(ctx, viewer, acc, id)
+	}
+	if content.AccessFilterFromContext(ctx) != nil {
+		return load(ctx)
+	}
+	return cache.Get(ctx, s.cache, cache.HomeFeedKey(viewer.UserID.String(), id), cache.HomeFeedTTL, load)
+}
+
+// buildRow loads the items of a row from the content modules.
+func (s *Service) buildRow(ctx context.Context, viewer Viewer, acc access, id string) (Row, error) {
+	row := Row{ID: id}
+	var items []Item
+	var err error
+
+	switch RowKind(id) {
+	case RowOnDeck:
+		row.Kind, row.Title = RowOnDeck, "On deck"
+		items, err = s.onDeck(ctx, viewer, acc)
+	case RowContinueWatching:
+		row.Kind, row.Title = RowContinueWatching, "Continue watching"
+		items, err = s.continueWatching(ctx, viewer.UserID, acc)
+	case RowNextUp:
+		row.Kind, row.Title = RowNextUp, "Next up"
+		items, err = s.nextUp(ctx, viewer.UserID, acc)
+	case RowRecommended:
+		row.Kind, row.Title = RowRecommended, "Recommended"
+		items, err = s.recommended(ctx, viewer, acc)
+	default:
+		key, ok := strings.CutPrefix(id, recentlyAddedPrefix)
+		if !ok {
+			return Row{}, ErrRowNotFound
+		}
+		row.Kind = RowRecentlyAdded
+		if libID, parseErr := uuid.Parse(key); parseErr == nil {
+			lib, found := acc.library(libID)
+			if !found {
+				return Row{}, ErrRowNotFound
+			}
+			row.Title = "Recently added in " + lib.Name
+			row.LibraryID = &lib.ID
+			row.LibraryType = lib.Type
+			items, err = s.recentlyAddedInLibrary(ctx, lib)
+		} else {
+			row.Title = "Recently added " + typeLabels[key]
+			row.LibraryType = key
+			items, err = s.recentlyAdded(ctx, key, acc)
+		}
+	}
+	if err != nil {
+		return Row{}, fmt.Errorf("build %s: %w", id, err)
+	}
+
+	if len(items) > MaxRowItems {
+		items = items[:MaxRowItems]
+	}
+	row.Items = items
+	if row.Items == nil {
+		row.Items = []Item{}
+	}
+	row.Total = int64(len(row.Items))
+	return row, nil
+}
+
+// page returns the page of the row's items at offset.
+func (r Row) page(limit, offset int32) Row {
+	if limit <= 0 {
+		limit = DefaultRowLimit
+	}
+	start := min(int(max(offset, 0)), len(r.Items))
+	end := min(start+int(limit), len(r.Items))
+	r.Items = slices.Clone(r.Items[start:end])
+	return r
+}
+
+// sortByActivity orders items by last activity, most recent first.
+func sortByActivity(items []Item) {
+	slices.SortStableFunc(items, func(a, b Item) int {
+		switch {
+		case a.LastActivityAt == nil && b.LastActivityAt == nil:
+			return 0
+		case a.LastActivityAt == nil:
+			return 1
+		case b.LastActivityAt == nil:
+			return -1
+		}
+		return b.LastActivityAt.Compare(*a.LastActivityAt)
+	})
+}
+
+// access holds the enabled libraries a viewer may browse.
+type access struct {
+	libraries []library.Library
+}
+
+// resolveAccess returns the libraries the viewer may browse, ordered by
+// name. Adult libraries never appear on the home feed; they are behind
+// their own PIN gate.
+func (s *Service) resolveAccess(ctx context.Context, viewer Viewer) (access, error) {
+	var libs []library.Library
+	var err error
+	if viewer.IsAdmin {
+		libs, err = s.libraries.ListEnabled(ctx)
+	} else {
+		libs, err = s.libraries.ListAccessible(ctx, viewer.UserID)
+	}
+	if err != nil {
+		return access{}, fmt.Errorf("list libraries: %w", err)
+	}
+
+	acc := access{libraries: make([]library.Library, 0, len(libs))}
+	for _, lib := range libs {
+		if lib.Enabled && lib.Type != library.LibraryTypeAdult {
+			acc.libraries = append(acc.libraries, lib)
+		}
+	}
+	slices.SortStableFunc(acc.libraries, func(a, b library.Library) int {
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return acc, nil
+}
+
+// hasType reports whether the viewer may browse a library of the type.
+func (a access) hasType(libType string) bool {
+	return slices.ContainsFunc(a.libraries, func(lib library.Library) bool {
+		return lib.Type == libType
+	})
+}
+
+// libraryIDs returns the libraries of the type the viewer may browse. The
+// result is never nil, so it always restricts.
+func (a access) libraryIDs(libType string) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, lib := range a.libraries {
+		if lib.Type == libType {
+			ids = append(ids, lib.ID)
+		}
+	}
+	return ids
+}
+
+// libraryPaths returns the path prefixes of the libraries of the types the
+// viewer may browse, for modules that do not store content per library.
+// The result is never nil, so it always restricts.
+func (a access) libraryPaths(libTypes ...string) []string {
+	paths := []string{}
+	for _, lib := range a.libraries {
+		if slices.Contains(libTypes, lib.Type) {
+			paths = append(paths, lib.PathPrefixes()...)
+		}
+	}
+	return paths
+}
+
+// library returns an accessible library by ID.
+func (a access) library(id uuid.UUID) (library.Library, bool) {
+	i := slices.IndexFunc(a.libraries, func(lib library.Library) bool {
+		return lib.ID == id
+	})
+	if i < 0 {
+		return library.Library{}, false
+	}
+	return a.libraries[i], true
+}
@@ -0,0 +1,649 @@
+package homefeed
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+)
+
+// stubLibraries returns fixed libraries: all of them for admins, the
+// accessible ones for everybody else.
+type stubLibraries struct {
+	all        []library.Library
+	accessible []library.Library
+}
+
+func (s *stubLibraries) ListEnabled(context.Context) ([]library.Library, error) {
+	return s.all, nil
+}
+
+func (s *stubLibraries) ListAccessible(context.Context, uuid.UUID) ([]library.Library, error) {
+	return s.accessible, nil
+}
+
+// inLibraries keeps the items whose file is under one of the library
+// paths, like the repositories do. Items without a file are kept.
+func inLibraries[T any](items []T, id func(T) uuid.UUID, files map[uuid.UUID]string, libraryPaths []string) []T {
+	if libraryPaths == nil {
+		return items
+	}
+	var kept []T
+	for _, item := range items {
+		file, ok := files[id(item)]
+		if !ok || slices.ContainsFunc(libraryPaths, func(prefix string) bool {
+			return strings.HasPrefix(file, prefix)
+		}) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+func movieID(m movie.Movie) uuid.UUID { return m.ID }
+
+func seriesID(s tvshow.Series) uuid.UUID { return s.ID }
+
+// stubMovies answers the movie lists of the feed; other methods are not
+// called.
+type stubMovies struct {
+	movie.Service
+
+	continueWatching []movie.ContinueWatchingItem
+	recent           []movie.Movie
+	history          []movie.WatchedMovieItem
+	genres           map[uuid.UUID][]string
+	byGenre          map[string][]movie.Movie
+	topRated         []movie.Movie
+	files            map[uuid.UUID]string
+	libraryPaths     []string
+	calls            int
+}
+
+func (s *stubMovies) GetContinueWatching(_ context.Context, _ uuid.UUID, libraryPaths []string, _ int32) ([]movie.ContinueWatchingItem, error) {
+	s.calls++
+	s.libraryPaths = libraryPaths
+	return s.continueWatching, nil
+}
+
+func (s *stubMovies) ListRecentlyAdded(_ context.Context, libraryPaths []string, _, _ int32) ([]movie.Movie, int64, error) {
+	recent := inLibraries(s.recent, movieID, s.files, libraryPaths)
+	return recent, int64(len(recent)), nil
+}
+
+func (s *stubMovies) GetWatchHistory(context.Context, uuid.UUID, int32, int32) ([]movie.WatchedMovieItem, error) {
+	return s.history, nil
+}
+
+func (s *stubMovies) GetMovieGenres(_ context.Context, id uuid.UUID) ([]movie.MovieGenre, error) {
+	var genres []movie.MovieGenre
+	for _, slug := range s.genres[id] {
+		genres = append(genres, movie.MovieGenre{MovieID: id, Slug: slug})
+	}
+	return genres, nil
+}
+
+func (s *stubMovies) GetMoviesByGenre(_ context.Context, slug string, libraryPaths []string, _, _ int32) ([]movie.Movie, error) {
+	return inLibraries(s.byGenre[slug], movieID, s.files, libraryPaths), nil
+}
+
+func (s *stubMovies) ListTopRated(_ context.Context, _ int32, libraryPaths []string, _, _ int32) ([]movie.Movie, int64, error) {
+	top := inLibraries(s.topRated, movieID, s.files, libraryPaths)
+	return top, int64(len(top)), nil
+}
+
+// stubTVShows answers the TV lists of the feed.
+type stubTVShows struct {
+	tvshow.Service
+
+	continueWatching []tvshow.ContinueWatchingItem
+	nextUp           []tvshow.NextUpItem
+	genres           map[uuid.UUID][]string
+	byGenre          map[string][]tvshow.Series
+	recent           []tvshow.Series
+	files            map[uuid.UUID]string // by series
+	libraryPaths     []string
+}
+
+func (s *stubTVShows) GetContinueWatching(_ context.Context, _ uuid.UUID, libraryPaths []string, _ int32) ([]tvshow.ContinueWatchingItem, error) {
+	s.libraryPaths = libraryPaths
+	return s.continueWatching, nil
+}
+
+func (s *stubTVShows) ListNextUp(_ context.Context, _ uuid.UUID, libraryPaths []string, _ int32) ([]tvshow.NextUpItem, error) {
+	return inLibraries(s.nextUp, func(next tvshow.NextUpItem) uuid.UUID { return next.Episode.SeriesID }, s.files, libraryPaths), nil
+}
+
+func (s *stubTVShows) GetSeriesGenres(_ context.Context, id uuid.UUID) ([]tvshow.SeriesGenre, error) {
+	var genres []tvshow.SeriesGenre
+	for _, slug := range s.genres[id] {
+		genres = append(genres, tvshow.SeriesGenre{SeriesID: id, Slug: slug})
+	}
+	return genres, nil
+}
+
+func (s *stubTVShows) ListByGenre(_ context.Context, slug string, libraryPaths []string, _, _ int32) ([]tvshow.Series, error) {
+	return inLibraries(s.byGenre[slug], seriesID, s.files, libraryPaths), nil
+}
+
+func (s *stubTVShows) ListRecentlyAdded(_ context.Context, libraryPaths []string, _, _ int32) ([]tvshow.Series, int64, error) {
+	recent := inLibraries(s.recent, seriesID, s.files, libraryPaths)
+	return recent, int64(len(recent)), nil
+}
+
+// stubHomeVideos answers the home video lists of the feed and records the
+// libraries they were restricted to.
+type stubHomeVideos struct {
+	homevideo.Service
+
+	continueWatching []homevideo.ContinueWatchingItem
+	videos           []homevideo.Video
+	libraryIDs       []uuid.UUID
+	filters          *homevideo.VideoListFilters
+}
+
+func (s *stubHomeVideos) ListContinueWatching(_ context.Context, _ uuid.UUID, libraryIDs []uuid.UUID, _ int32) ([]homevideo.ContinueWatchingItem, error) {
+	s.libraryIDs = libraryIDs
+	return s.continueWatching, nil
+}
+
+func (s *stubHomeVideos) ListVideos(_ context.Context, filters homevideo.VideoListFilters) ([]homevideo.Video, int64, error) {
+	s.filters = &filters
+	return s.videos, int64(len(s.videos)), nil
+}
+
+// stubAudiobooks answers continue listening and the recently added list.
+type stubAudiobooks struct {
+	audiobook.Service
+
+	continueListening []audiobook.ContinueListeningItem
+	libraryPaths      []string
+	filters           *audiobook.BookListFilters
+	err               error
+}
+
+func (s *stubAudiobooks) GetContinueListening(_ context.Context, _ uuid.UUID, libraryPaths []string, _ int32) ([]audiobook.ContinueListeningItem, error) {
+	s.libraryPaths = libraryPaths
+	return s.continueListening, s.err
+}
+
+func (s *stubAudiobooks) ListBooks(_ context.Context, filters audiobook.BookListFilters) ([]audiobook.Book, int64, error) {
+	s.filters = &filters
+	return nil, 0, nil
+}
+
+// stubPodcasts answers continue listening and the latest episodes and
+// records the libraries they were restricted to.
+type stubPodcasts struct {
+	podcast.Service
+
+	continueListening []podcast.ContinueListeningItem
+	latest            []podcast.LatestEpisode
+	libraryIDs        []uuid.UUID
+	latestLibraryID   uuid.UUID
+}
+
+func (s *stubPodcasts) ListContinueListening(_ context.Context, _ uuid.UUID, libraryIDs []uuid.UUID, _ int32) ([]podcast.ContinueListeningItem, error) {
+	s.libraryIDs = libraryIDs
+	return s.continueListening, nil
+}
+
+func (s *stubPodcasts) ListLatestEpisodes(_ context.Context, libraryID uuid.UUID, _ int32) ([]podcast.LatestEpisode, error) {
+	s.latestLibraryID = libraryID
+	return s.latest, nil
+}
+
+var (
+	movieLibrary     = library.Library{ID: uuid.New(), Name: "Movies", Type: library.LibraryTypeMovie, Paths: []string{"/media/movies"}, Enabled: true}
+	kidsMovieLibrary = library.Library{ID: uuid.New(), Name: "Kids Movies", Type: library.LibraryTypeMovie, Paths: []string{"/media/kids"}, Enabled: true}
+	tvLibrary        = library.Library{ID: uuid.New(), Name: "Series", Type: library.LibraryTypeTVShow, Paths: []string{"/media/tv"}, Enabled: true}
+	kidsTVLibrary    = library.Library{ID: uuid.New(), Name: "Kids Series", Type: library.LibraryTypeTVShow, Paths: []string{"/media/kids-tv"}, Enabled: true}
+	familyLibrary    = library.Library{ID: uuid.New(), Name: "Family", Type: library.LibraryTypeHomeVideo, Enabled: true}
+	holidayLibrary   = library.Library{ID: uuid.New(), Name: "Holidays", Type: library.LibraryTypeHomeVideo, Enabled: true}
+	audiobookLibrary = library.Library{ID: uuid.New(), Name: "Audiobooks", Type: library.LibraryTypeAudiobook, Paths: []string{"/media/audiobooks/"}, Enabled: true}
+	podcastLibrary   = library.Library{ID: uuid.New(), Name: "Podcasts", Type: library.LibraryTypePodcast, Enabled: true}
+	adultLibrary     = library.Library{ID: uuid.New(), Name: "Adult", Type: library.LibraryTypeAdult, Enabled: true}
+	disabledLibrary  = library.Library{ID: uuid.New(), Name: "Archive", Type: library.LibraryTypeMovie, Enabled: false}
+)
+
+func at(hour int) time.Time {
+	return time.Date(2026, 10, 1, hour, 0, 0, 0, time.UTC)
+}
+
+func rowIDs(rows []Row) []string {
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	return ids
+}
+
+func itemIDs(items []Item) []uuid.UUID {
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestService_Feed_Rows(t *testing.T) {
+	ctx := context.Background()
+	movies := &stubMovies{
+		recent:   []movie.Movie{{ID: uuid.New(), Title: "Arrival"}},
+		topRated: []movie.Movie{{ID: uuid.New(), Title: "Heat"}},
+	}
+	homeVideos := &stubHomeVideos{videos: []homevideo.Video{{ID: uuid.New(), Title: "Birthday"}}}
+	libs := &stubLibraries{
+		all:        []library.Library{tvLibrary, movieLibrary, holidayLibrary, kidsMovieLibrary, adultLibrary, disabledLibrary, familyLibrary},
+		accessible: []library.Library{holidayLibrary, kidsMovieLibrary},
+	}
+	svc := NewService(libs, Sources{Movies: movies, HomeVideos: homeVideos}, nil, logging.NewTestLogger())
+
+	t.Run("admin", func(t *testing.T) {
+		rows, err := svc.Feed(ctx, Viewer{UserID: uuid.New(), IsAdmin: true}, 10)
+		require.NoError(t, err)
+		// No TV module, adult and disabled libraries left out, one row
+		// for both movie libraries, a row per home video library.
+		assert.Equal(t, []string{
+			"recently_added:" + familyLibrary.ID.String(),
+			"recently_added:" + holidayLibrary.ID.String(),
+			"recently_added:movie",
+			"recommended",
+		}, rowIDs(rows))
+
+		assert.Equal(t, RowRecentlyAdded, rows[0].Kind)
+		assert.Equal(t, "Recently added in Family", rows[0].Title)
+		assert.Equal(t, &familyLibrary.ID, rows[0].LibraryID)
+		assert.Equal(t, "Recently added movies", rows[2].Title)
+		assert.Equal(t, library.LibraryTypeMovie, rows[2].LibraryType)
+		assert.Nil(t, rows[2].LibraryID)
+	})
+
+	t.Run("user", func(t *testing.T) {
+		rows, err := svc.Feed(ctx, Viewer{UserID: uuid.New()}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"recently_added:" + holidayLibrary.ID.String(),
+			"recently_added:movie",
+			"recommended",
+		}, rowIDs(rows))
+		require.NotNil(t, homeVideos.filters.LibraryID)
+		assert.Equal(t, holidayLibrary.ID, *homeVideos.filters.LibraryID)
+		assert.Equal(t, homevideo.VideoSortAdded, homeVideos.filters.Sort)
+	})
+
+	t.Run("inaccessible library row", func(t *testing.T) {
+		_, err := svc.Row(ctx, Viewer{UserID: uuid.New()}, "recently_added:"+familyLibrary.ID.String(), 10, 0)
+		assert.ErrorIs(t, err, ErrRowNotFound)
+		_, err = svc.Row(ctx, Viewer{UserID: uuid.New()}, "recently_added:"+adultLibrary.ID.String(), 10, 0)
+		assert.ErrorIs(t, err, ErrRowNotFound)
+		_, err = svc.Row(ctx, Viewer{UserID: uuid.New()}, "popular", 10, 0)
+		assert.ErrorIs(t, err, ErrRowNotFound)
+	})
+}
+
+func TestService_ContinueWatching(t *testing.T) {
+	ctx := context.Background()
+	seriesID := uuid.New()
+	movies := &stubMovies{continueWatching: []movie.ContinueWatchingItem{{
+		Movie:           movie.Movie{ID: uuid.New(), Title: "Dune"},
+		ProgressSeconds: 1800, DurationSeconds: 9000, LastWatchedAt: at(9),
+	}}}
+	tvshows := &stubTVShows{continueWatching: []tvshow.ContinueWatchingItem{{
+		Series:        &tvshow.Series{ID: seriesID, Title: "Severance"},
+		LastEpisodeID: uuid.New(), LastSeasonNumber: 2, LastEpisodeNumber: 4, LastEpisodeTitle: "Woe's Hollow",
+		ProgressSeconds: 600, DurationSeconds: 3000, LastWatchedAt: at(12),
+	}}}
+	homeVideos := &stubHomeVideos{continueWatching: []homevideo.ContinueWatchingItem{{
+		Video:           homevideo.Video{ID: uuid.New(), LibraryID: holidayLibrary.ID, Title: "Beach"},
+		ProgressSeconds: 30, DurationSeconds: 120, LastWatchedAt: at(10),
+	}}}
+	audiobooks := &stubAudiobooks{continueListening: []audiobook.ContinueListeningItem{{
+		Book:     audiobook.Book{ID: uuid.New(), Title: "Project Hail Mary", DurationMs: 58_000_000},
+		Progress: audiobook.Progress{PositionMs: 5_800_000, LastListenedAt: at(11)},
+	}}}
+	sources := Sources{Movies: movies, TVShows: tvshows, HomeVideos: homeVideos, Audiobooks: audiobooks}
+
+	t.Run("all modules by activity", func(t *testing.T) {
+		libs := &stubLibraries{all: []library.Library{movieLibrary, tvLibrary, holidayLibrary, familyLibrary, audiobookLibrary}}
+		svc := NewService(libs, sources, nil, logging.NewTestLogger())
+
+		row, err := svc.Row(ctx, Viewer{UserID: uuid.New(), IsAdmin: true}, "continue_watching", 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), row.Total)
+		assert.Equal(t, []ItemType{ItemTypeEpisode, ItemTypeAudiobook, ItemTypeHomeVideo, ItemTypeMovie},
+			[]ItemType{row.Items[0].Type, row.Items[1].Type, row.Items[2].Type, row.Items[3].Type})
+
+		episode := row.Items[0]
+		assert.Equal(t, "Woe's Hollow", episode.Title)
+		assert.Equal(t, "Severance", episode.Subtitle)
+		assert.Equal(t, &seriesID, episode.ParentID)
+		assert.Equal(t, new(int32(2)), episode.SeasonNumber)
+		assert.Equal(t, new(int32(20)), episode.ProgressPercent)
+
+		book := row.Items[1]
+		assert.Equal(t, int32(5800), book.ProgressSeconds)
+		assert.Equal(t, int32(58000), book.DurationSeconds)
+		assert.Equal(t, new(int32(10)), book.ProgressPercent)
+
+		assert.ElementsMatch(t, []uuid.UUID{holidayLibrary.ID, familyLibrary.ID}, homeVideos.libraryIDs)
+		assert.Equal(t, []string{"/media/movies/"}, movies.libraryPaths)
+		assert.Equal(t, []string{"/media/tv/"}, tvshows.libraryPaths)
+		assert.Equal(t, []string{"/media/audiobooks/"}, audiobooks.libraryPaths)
+	})
+
+	t.Run("modules without accessible libraries are left out", func(t *testing.T) {
+		libs := &stubLibraries{accessible: []library.Library{movieLibrary}}
+		svc := NewService(libs, sources, nil, logging.NewTestLogger())
+
+		row, err := svc.Row(ctx, Viewer{UserID: uuid.New()}, "continue_watching", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, row.Items, 1)
+		assert.Equal(t, ItemTypeMovie, row.Items[0].Type)
+	})
+
+	t.Run("movies are limited to the accessible libraries", func(t *testing.T) {
+		libs := &stubLibraries{
+			all:        []library.Library{movieLibrary, kidsMovieLibrary},
+			accessible: []library.Library{kidsMovieLibrary},
+		}
+		svc := NewService(libs, sources, nil, logging.NewTestLogger())
+
+		_, err := svc.Row(ctx, Viewer{UserID: uuid.New()}, "continue_watching", 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/media/kids/"}, movies.libraryPaths)
+	})
+
+	t.Run("failing source", func(t *testing.T) {
+		libs := &stubLibraries{all: []library.Library{movieLibrary, audiobookLibrary}}
+		failing := Sources{Movies: movies, Audiobooks: &stubAudiobooks{err: errors.New("boom")}}
+		svc := NewService(libs, failing, nil, logging.NewTestLogger())
+		viewer := Viewer{UserID: uuid.New(), IsAdmin: true}
+
+		_, err := svc.Row(ctx, viewer, "continue_watching", 10, 0)
+		require.Error(t, err)
+
+		// The feed leaves out the failing rows.
+		rows, err := svc.Feed(ctx, viewer, 10)
+		require.NoError(t, err)
+		assert.NotContains(t, rowIDs(rows), "continue_watching")
+		assert.NotContains(t, rowIDs(rows), "on_deck")
+	})
+}
+
+func TestService_Podcasts(t *testing.T) {
+	ctx := context.Background()
+	show := podcast.Podcast{ID: uuid.New(), LibraryID: podcastLibrary.ID, Title: "The Daily"}
+	started := podcast.Episode{ID: uuid.New(), PodcastID: show.ID, Title: "Monday", DurationSeconds: 1200}
+	latest := podcast.Episode{ID: uuid.New(), PodcastID: show.ID, Title: "Tuesday", PublishedAt: new(at(6))}
+	podcasts := &stubPodcasts{
+		continueListening: []podcast.ContinueListeningItem{{
+			Episode:  started,
+			Podcast:  show,
+			Progress: podcast.Progress{PositionSeconds: 300, LastPlayedAt: at(8)},
+		}},
+		latest: []podcast.LatestEpisode{{Episode: latest, Podcast: show}},
+	}
+	libs := &stubLibraries{accessible: []library.Library{movieLibrary, podcastLibrary}}
+	svc := NewService(libs, Sources{Movies: &stubMovies{}, Podcasts: podcasts}, nil, logging.NewTestLogger())
+	viewer := Viewer{UserID: uuid.New()}
+
+	row, err := svc.Row(ctx, viewer, "continue_watching", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, row.Items, 1)
+	item := row.Items[0]
+	assert.Equal(t, ItemTypePodcastEpisode, item.Type)
+	assert.Equal(t, started.ID, item.ID)
+	assert.Equal(t, "The Daily", item.Subtitle)
+	assert.Equal(t, &show.ID, item.ParentID)
+	assert.Equal(t, &podcastLibrary.ID, item.LibraryID)
+	assert.Equal(t, new(int32(25)), item.ProgressPercent)
+	assert.Equal(t, []uuid.UUID{podcastLibrary.ID}, podcasts.libraryIDs)
+
+	rows, err := svc.Feed(ctx, viewer, 10)
+	require.NoError(t, err)
+	assert.Contains(t, rowIDs(rows), "recently_added:"+podcastLibrary.ID.String())
+
+	row, err = svc.Row(ctx, viewer, "recently_added:"+podcastLibrary.ID.String(), 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "Recently added in Podcasts", row.Title)
+	assert.Equal(t, library.LibraryTypePodcast, row.LibraryType)
+	assert.Equal(t, []uuid.UUID{latest.ID}, itemIDs(row.Items))
+	assert.Equal(t, new(int32(2026)), row.Items[0].Year)
+	assert.Equal(t, podcastLibrary.ID, podcasts.latestLibraryID)
+}
+
+func TestService_OnDeck(t *testing.T) {
+	ctx := context.Background()
+	inProgress := uuid.New()
+	next := tvshow.NextUpItem{
+		Episode:       tvshow.Episode{ID: uuid.New(), SeriesID: uuid.New(), SeasonNumber: 1, EpisodeNumber: 2, Title: "Half Loop"},
+		SeriesTitle:   "Severance",
+		LastWatchedAt: at(12),
+	}
+	sources := Sources{
+		Movies: &stubMovies{continueWatching: []movie.ContinueWatchingItem{{
+			Movie: movie.Movie{ID: inProgress}, ProgressSeconds: 60, DurationSeconds: 600, LastWatchedAt: at(9),
+		}}},
+		TVShows: &stubTVShows{nextUp: []tvshow.NextUpItem{next}},
+	}
+	libs := &stubLibraries{all: []library.Library{movieLibrary, tvLibrary}}
+	svc := NewService(libs, sources, nil, logging.NewTestLogger())
+	viewer := Viewer{UserID: uuid.New(), IsAdmin: true}
+
+	row, err := svc.Row(ctx, viewer, "on_deck", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{next.Episode.ID, inProgress}, itemIDs(row.Items))
+	assert.Equal(t, "Severance", row.Items[0].Subtitle)
+	assert.Equal(t, new(at(12)), row.Items[0].LastActivityAt)
+
+	row, err = svc.Row(ctx, viewer, "next_up", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{next.Episode.ID}, itemIDs(row.Items))
+}
+
+func TestService_Row_Paging(t *testing.T) {
+	ctx := context.Background()
+	recent := make([]movie.Movie, 150)
+	for i := range recent {
+		recent[i] = movie.Movie{ID: uuid.New()}
+	}
+	libs := &stubLibraries{all: []library.Library{movieLibrary}}
+	svc := NewService(libs, Sources{Movies: &stubMovies{recent: recent}}, nil, logging.NewTestLogger())
+	viewer := Viewer{UserID: uuid.New(), IsAdmin: true}
+
+	row, err := svc.Row(ctx, viewer, "recently_added:movie", 30, 20)
+	require.NoError(t, err)
+	assert.Equal(t, int64(MaxRowItems), row.Total)
+	assert.Equal(t, itemIDs(movieItems(recent[20:50])), itemIDs(row.Items))
+
+	row, err = svc.Row(ctx, viewer, "recently_added:movie", 30, 90)
+	require.NoError(t, err)
+	assert.Len(t, row.Items, 10)
+
+	row, err = svc.Row(ctx, viewer, "recently_added:movie", 0, 500)
+	require.NoError(t, err)
+	assert.Empty(t, row.Items)
+}
+
+func movieItems(movies []movie.Movie) []Item {
+	items := make([]Item, len(movies))
+	for i := range movies {
+		items[i] = movieItem(&movies[i])
+	}
+	return items
+}
+
+func TestService_Recommended(t *testing.T) {
+	ctx := context.Background()
+	libs := &stubLibraries{all: []library.Library{movieLibrary, tvLibrary}}
+	viewer := Viewer{UserID: uuid.New(), IsAdmin: true}
+
+	t.Run("from watched genres", func(t *testing.T) {
+		watched := movie.Movie{ID: uuid.New(), Title: "Alien"}
+		inProgress := movie.Movie{ID: uuid.New(), Title: "Aliens"}
+		both := movie.Movie{ID: uuid.New(), Title: "Prometheus", VoteAverage: new(decimal.MustParse("6.5"))}
+		horror := movie.Movie{ID: uuid.New(), Title: "The Thing", VoteAverage: new(decimal.MustParse("8.1"))}
+		scifi := movie.Movie{ID: uuid.New(), Title: "Sunshine", VoteAverage: new(decimal.MustParse("7.2"))}
+		seriesID := uuid.New()
+		similarSeries := tvshow.Series{ID: uuid.New(), Title: "Raised by Wolves", VoteAverage: new(decimal.MustParse("7.5"))}
+
+		movies := &stubMovies{
+			history:          []movie.WatchedMovieItem{{Movie: watched}},
+			continueWatching: []movie.ContinueWatchingItem{{Movie: inProgress, LastWatchedAt: at(8)}},
+			genres:           map[uuid.UUID][]string{watched.ID: {"horror", "science-fiction"}},
+			byGenre: map[string][]movie.Movie{
+				"horror":          {watched, both, horror},
+				"science-fiction": {inProgress, both, scifi},
+			},
+		}
+		tvshows := &stubTVShows{
+			nextUp: []tvshow.NextUpItem{{Episode: tvshow.Episode{ID: uuid.New(), SeriesID: seriesID}, LastWatchedAt: at(9)}},
+			genres: map[uuid.UUID][]string{seriesID: {"science-fiction"}},
+			byGenre: map[string][]tvshow.Series{
+				"science-fiction": {{ID: seriesID}, similarSeries},
+			},
+		}
+		svc := NewService(libs, Sources{Movies: movies, TVShows: tvshows}, nil, logging.NewTestLogger())
+
+		row, err := svc.Row(ctx, viewer, "recommended", 10, 0)
+		require.NoError(t, err)
+		// Matching both genres first, then by rating; watched, in
+		// progress and watching series are left out.
+		assert.Equal(t, []uuid.UUID{both.ID, horror.ID, similarSeries.ID, scifi.ID}, itemIDs(row.Items))
+		assert.Equal(t, ItemTypeSeries, row.Items[2].Type)
+	})
+
+	t.Run("top rated without history", func(t *testing.T) {
+		seen := movie.Movie{ID: uuid.New()}
+		top := movie.Movie{ID: uuid.New()}
+		movies := &stubMovies{
+			continueWatching: []movie.ContinueWatchingItem{{Movie: seen, LastWatchedAt: at(8)}},
+			topRated:         []movie.Movie{seen, top},
+		}
+		svc := NewService(libs, Sources{Movies: movies}, nil, logging.NewTestLogger())
+
+		row, err := svc.Row(ctx, viewer, "recommended", 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{top.ID}, itemIDs(row.Items))
+	})
+}
+
+func TestService_LibraryAccess(t *testing.T) {
+	ctx := context.Background()
+	arrival := movie.Movie{ID: uuid.New(), Title: "Arrival"}
+	zootopia := movie.Movie{ID: uuid.New(), Title: "Zootopia"}
+	severance := tvshow.Series{ID: uuid.New(), Title: "Severance"}
+	bluey := tvshow.Series{ID: uuid.New(), Title: "Bluey"}
+	peppa := tvshow.Series{ID: uuid.New(), Title: "Peppa Pig"}
+	movies := &stubMovies{
+		recent:   []movie.Movie{arrival, zootopia},
+		topRated: []movie.Movie{arrival, zootopia},
+		files: map[uuid.UUID]string{
+			arrival.ID:  "/media/movies/Arrival (2016)/Arrival.mkv",
+			zootopia.ID: "/media/kids/Zootopia (2016)/Zootopia.mkv",
+		},
+	}
+	tvshows := &stubTVShows{
+		nextUp: []tvshow.NextUpItem{
+			{Episode: tvshow.Episode{ID: uuid.New(), SeriesID: severance.ID}, LastWatchedAt: at(9)},
+			{Episode: tvshow.Episode{ID: uuid.New(), SeriesID: bluey.ID}, LastWatchedAt: at(8)},
+		},
+		genres:  map[uuid.UUID][]string{severance.ID: {"animation"}, bluey.ID: {"animation"}},
+		byGenre: map[string][]tvshow.Series{"animation": {severance, bluey, peppa}},
+		recent:  []tvshow.Series{severance, bluey},
+		files: map[uuid.UUID]string{
+			severance.ID: "/media/tv/Severance",
+			bluey.ID:     "/media/kids-tv/Bluey",
+			peppa.ID:     "/media/kids-tv/Peppa Pig",
+		},
+	}
+	audiobooks := &stubAudiobooks{}
+	// Two libraries of each type; the user may browse the kids ones only.
+	libs := &stubLibraries{
+		all:        []library.Library{movieLibrary, kidsMovieLibrary, tvLibrary, kidsTVLibrary, audiobookLibrary},
+		accessible: []library.Library{kidsMovieLibrary, kidsTVLibrary, audiobookLibrary},
+	}
+	svc := NewService(libs, Sources{Movies: movies, TVShows: tvshows, Audiobooks: audiobooks}, nil, logging.NewTestLogger())
+	user := Viewer{UserID: uuid.New()}
+
+	row, err := svc.Row(ctx, user, "recently_added:"+library.LibraryTypeMovie, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{zootopia.ID}, itemIDs(row.Items))
+
+	row, err = svc.Row(ctx, user, "recently_added:"+library.LibraryTypeTVShow, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{bluey.ID}, itemIDs(row.Items))
+
+	row, err = svc.Row(ctx, user, "next_up", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tvshows.nextUp[1].Episode.ID}, itemIDs(row.Items))
+
+	// Top rated movies and series in the genres of the series watched.
+	row, err = svc.Row(ctx, user, "recommended", 10, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{zootopia.ID, peppa.ID}, itemIDs(row.Items))
+
+	_, err = svc.Row(ctx, user, "recently_added:"+library.LibraryTypeAudiobook, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/media/audiobooks/"}, audiobooks.filters.LibraryPaths)
+
+	// Admins browse both libraries.
+	row, err = svc.Row(ctx, Viewer{UserID: uuid.New(), IsAdmin: true}, "recently_added:"+library.LibraryTypeMovie, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{arrival.ID, zootopia.ID}, itemIDs(row.Items))
+}
+
+func TestService_Cache(t *testing.T) {
+	ctx := context.Background()
+	c, err := cache.NewCache(nil, 1000, time.Minute)
+	require.NoError(t, err)
+
+	movies := &stubMovies{continueWatching: []movie.ContinueWatchingItem{{
+		Movie: movie.Movie{ID: uuid.New()}, ProgressSeconds: 60, DurationSeconds: 600, LastWatchedAt: at(9),
+	}}}
+	libs := &stubLibraries{all: []library.Library{movieLibrary}}
+	svc := NewService(libs, Sources{Movies: movies}, c, logging.NewTestLogger())
+	viewer := Viewer{UserID: uuid.New(), IsAdmin: true}
+
+	load := func(ctx context.Context) {
+		t.Helper()
+		_, err := svc.Row(ctx, viewer, "continue_watching", 10, 0)
+		require.NoError(t, err)
+	}
+
+	load(ctx)
+	load(ctx)
+	assert.Equal(t, 1, movies.calls, "second load is served from the cache")
+
+	// Other users have their own rows.
+	_, err = svc.Row(ctx, Viewer{UserID: uuid.New(), IsAdmin: true}, "continue_watching", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, movies.calls)
+
+	require.NoError(t, svc.Invalidate(ctx, viewer.UserID))
+	load(ctx)
+	assert.Equal(t, 3, movies.calls, "progress updates invalidate the row")
+
+	// Restricted users bypass the cache.
+	restricted := content.WithAccessFilter(ctx, &content.AccessFilter{MaxAge: new(int32(12))})
+	load(restricted)
+	assert.Equal(t, 4, movies.calls)
+}
@@ -0,0 +1,726 @@
+package homefeed
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/govalues/decimal"
+
+	"github.com/lusoris/revenge/internal/content/audiobook"
+	"github.com/lusoris/revenge/internal/content/book"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/content/podcast"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/util"
+)
+
+const (
+	// recommendationSeeds is how many recently watched titles the
+	// recommended genres are taken from.
+	recommendationSeeds = 10
+	// recommendationGenres is how many of the most watched genres
+	// recommendations are drawn from.
+	recommendationGenres = 3
+	// topRatedMinVotes matches the default of the top rated movie list,
+	// which new users are recommended from.
+	topRatedMinVotes = 100
+	// recentlyAddedOrder is the list order of modules sorting by addition.
+	recentlyAddedOrder = "added"
+)
+
+// perLibraryTypes are the library types whose content is stored per
+// library, so recently added rows are per library rather than per type.
+var perLibraryTypes = map[string]bool{
+	library.LibraryTypeHomeVideo:  true,
+	library.LibraryTypeMusicVideo: true,
+	library.LibraryTypePodcast:    true,
+}
+
+// typeLabels name the content of a library type in row titles.
+var typeLabels = map[string]string{
+	library.LibraryTypeMovie:      "movies",
+	library.LibraryTypeTVShow:     "TV shows",
+	library.LibraryTypeMusic:      "music",
+	library.LibraryTypeAudiobook:  "audiobooks",
+	library.LibraryTypeBook:       "books",
+	library.LibraryTypeComic:      "comics",
+	library.LibraryTypeHomeVideo:  "home videos",
+	library.LibraryTypeMusicVideo: "music videos",
+	library.LibraryTypePodcast:    "podcasts",
+}
+
+// hasRecentlyAdded reports whether the module of a library type is enabled
+// and can list its content by addition.
+func (s *Service) hasRecentlyAdded(libType string) bool {
+	switch libType {
+	case library.LibraryTypeMovie:
+		return s.sources.Movies != nil
+	case library.LibraryTypeTVShow:
+		return s.sources.TVShows != nil
+	case library.LibraryTypeMusic:
+		return s.sources.Music != nil
+	case library.LibraryTypeAudiobook:
+		return s.sources.Audiobooks != nil
+	case library.LibraryTypeBook, library.LibraryTypeComic:
+		return s.sources.Books != nil
+	case library.LibraryTypeHomeVideo:
+		return s.sources.HomeVideos != nil
+	case library.LibraryTypeMusicVideo:
+		return s.sources.MusicVideos != nil
+	case library.LibraryTypePodcast:
+		return s.sources.Podcasts != nil
+	}
+	return false
+}
+
+// onDeck merges the continue watching and next up rows. Next up leaves out
+// series with an episode in progress, so every series appears once.
+func (s *Service) onDeck(ctx context.Context, viewer Viewer, acc access) ([]Item, error) {
+	inProgress, err := s.loadRow(ctx, viewer, acc, string(RowContinueWatching))
+	if err != nil {
+		return nil, err
+	}
+	next, err := s.loadRow(ctx, viewer, acc, string(RowNextUp))
+	if err != nil {
+		return nil, err
+	}
+
+	items := slices.Concat(inProgress.Items, next.Items)
+	sortByActivity(items)
+	return items, nil
+}
+
+// continueWatching returns the started, unfinished items of all modules
+// the viewer may browse, most recently used first.
+func (s *Service) continueWatching(ctx context.Context, userID uuid.UUID, acc access) ([]Item, error) {
+	var items []Item
+
+	if s.sources.Movies != nil && acc.hasType(library.LibraryTypeMovie) {
+		movies, err := s.sources.Movies.GetContinueWatching(ctx, userID, acc.libraryPaths(library.LibraryTypeMovie), MaxRowItems)
+		if err != nil {
+			return nil, fmt.Errorf("movies: %w", err)
+		}
+		for i := range movies {
+			m := &movies[i]
+			item := movieItem(&m.Movie)
+			setProgress(&item, m.ProgressSeconds, m.DurationSeconds, m.LastWatchedAt)
+			items = append(items, item)
+		}
+	}
+
+	if s.sources.TVShows != nil && acc.hasType(library.LibraryTypeTVShow) {
+		series, err := s.sources.TVShows.GetContinueWatching(ctx, userID, acc.libraryPaths(library.LibraryTypeTVShow), MaxRowItems)
+		if err != nil {
+			return nil, fmt.Errorf("tv shows: %w", err)
+		}
+		for _, cw := range series {
+			if cw.Series == nil {
+				continue
+			}
+			item := Item{
+				Type:          ItemTypeEpisode,
+				ID:            cw.LastEpisodeID,
+				Title:         cw.LastEpisodeTitle,
+				Subtitle:      cw.Series.Title,
+				ParentID:      &cw.Series.ID,
+				SeasonNumber:  new(cw.LastSeasonNumber),
+				EpisodeNumber: new(cw.LastEpisodeNumber),
+				PosterPath:    cw.Series.PosterPath,
+				AddedAt:       cw.Series.CreatedAt,
+			}
+			setProgress(&item, cw.ProgressSeconds, cw.DurationSeconds, cw.LastWatchedAt)
+			items = append(items, item)
+		}
+	}
+
+	if s.sources.HomeVideos != nil && acc.hasType(library.LibraryTypeHomeVideo) {
+		videos, err := s.sources.HomeVideos.ListContinueWatching(ctx, userID, acc.libraryIDs(library.LibraryTypeHomeVideo), MaxRowItems)
+		if err != nil {
+			return nil, fmt.Errorf("home videos: %w", err)
+		}
+		for i := range videos {
+			v := &videos[i]
+			item := homeVideoItem(&v.Video)
+			setProgress(&item, v.ProgressSeconds, v.DurationSeconds, v.LastWatchedAt)
+			items = append(items, item)
+		}
+	}
+
+	if s.sources.Audiobooks != nil && acc.hasType(library.LibraryTypeAudiobook) {
+		books, err := s.sources.Audiobooks.GetContinueListening(ctx, userID, acc.libraryPaths(library.LibraryTypeAudiobook), MaxRowItems)
+		if err != nil {
+			return nil, fmt.Errorf("audiobooks: %w", err)
+		}
+		for i := range books {
+			b := &books[i]
+			item := audiobookItem(&b.Book)
+			duration := b.Progress.DurationMs
+			if duration <= 0 {
+				duration = b.Book.DurationMs
+			}
+			setProgress(&item, util.SafeInt64ToInt32(b.Progress.PositionMs/1000), util.SafeInt64ToInt32(duration/1000), b.Progress.LastListenedAt)
+			items = append(items, item)
+		}
+	}
+
+	if s.sources.Podcasts != nil && acc.hasType(library.LibraryTypePodcast) {
+		episodes, err := s.sources.Podcasts.ListContinueListening(ctx, userID, acc.libraryIDs(library.LibraryTypePodcast), MaxRowItems)
+		if err != nil {
+			return nil, fmt.Errorf("podcasts: %w", err)
+		}
+		for i := range episodes {
+			e := &episodes[i]
+			item := podcastEpisodeItem(&e.Episode, &e.Podcast)
+			setProgress(&item, e.Progress.PositionSeconds, e.Episode.DurationSeconds, e.Progress.LastPlayedAt)
+			items = append(items, item)
+		}
+	}
+
+	if s.sources.Books != nil {
+		kind, ok := readableKind(acc)
+		if ok {
+			libraryPaths := acc.libraryPaths(library.LibraryTypeBook, library.LibraryTypeComic)
+			books, err := s.sources.Books.GetContinueReading(ctx, userID, kind, libraryPaths, MaxRowItems)
+			if err != nil {
+				return nil, fmt.Errorf("books: %w", err)
+			}
+			for i := range books {
+				b := &books[i]
+				item := bookItem(&b.Book)
+				item.ProgressPercent = new(int32(b.Progress.Percent))
+				item.LastActivityAt = new(b.Progress.LastReadAt)
+				items = append(items, item)
+			}
+		}
+	}
+
+	sortByActivity(items)
+	return items, nil
+}
+
+// readableKind returns the book kind to list for the viewer: both when
+// they may browse book and comic libraries, else the one they may browse.
+func readableKind(acc access) (book.Kind, bool) {
+	books, comics := acc.hasType(library.LibraryTypeBook), acc.hasType(library.LibraryTypeComic)
+	switch {
+	case books && comics:
+		return "", true
+	case books:
+		return book.KindBook, true
+	case comics:
+		return book.KindComic, true
+	}
+	return "", false
+}
+
+// nextUp returns the next episode of each series the viewer is watching.
+func (s *Service) nextUp(ctx context.Context, userID uuid.UUID, acc access) ([]Item, error) {
+	if s.sources.TVShows == nil || !acc.hasType(library.LibraryTypeTVShow) {
+		return nil, nil
+	}
+	episodes, err := s.sources.TVShows.ListNextUp(ctx, userID, acc.libraryPaths(library.LibraryTypeTVShow), MaxRowItems)
+	if err != nil {
+		return nil, fmt.Errorf("tv shows: %w", err)
+	}
+
+	items := make([]Item, len(episodes))
+	for i, next := range episodes {
+		ep := next.Episode
+		items[i] = Item{
+			Type:           ItemTypeEpisode,
+			ID:             ep.ID,
+			Title:          ep.Title,
+			Subtitle:       next.SeriesTitle,
+			ParentID:       &ep.SeriesID,
+			SeasonNumber:   new(ep.SeasonNumber),
+			EpisodeNumber:  new(ep.EpisodeNumber),
+			PosterPath:     next.SeriesPosterPath,
+			LastActivityAt: new(next.LastWatchedAt),
+			AddedAt:        ep.CreatedAt,
+		}
+		if ep.Runtime != nil {
+			items[i].DurationSeconds = *ep.Runtime * 60
+		}
+	}
+	return items, nil
+}
+
+// recentlyAdded returns the content added last of a library type whose
+// module does not store content per library, limited to the libraries of
+// the type the viewer may browse.
+func (s *Service) recentlyAdded(ctx context.Context, libType string, acc access) ([]Item, error) {
+	libraryPaths := acc.libraryPaths(libType)
+	var items []Item
+	switch libType {
+	case library.LibraryTypeMovie:
+		if s.sources.Movies == nil {
+			break
+		}
+		movies, _, err := s.sources.Movies.ListRecentlyAdded(ctx, libraryPaths, MaxRowItems, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range movies {
+			items = append(items, movieItem(&movies[i]))
+		}
+	case library.LibraryTypeTVShow:
+		if s.sources.TVShows == nil {
+			break
+		}
+		series, _, err := s.sources.TVShows.ListRecentlyAdded(ctx, libraryPaths, MaxRowItems, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range series {
+			items = append(items, seriesItem(&series[i]))
+		}
+	case library.LibraryTypeMusic:
+		if s.sources.Music == nil {
+			break
+		}
+		releases, _, err := s.sources.Music.ListReleases(ctx, music.ReleaseListFilters{
+			LibraryPaths: libraryPaths,
+			OrderBy:      recentlyAddedOrder,
+			Limit:        MaxRowItems,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range releases {
+			items = append(items, albumItem(&releases[i]))
+		}
+	case library.LibraryTypeAudiobook:
+		if s.sources.Audiobooks == nil {
+			break
+		}
+		books, _, err := s.sources.Audiobooks.ListBooks(ctx, audiobook.BookListFilters{
+			LibraryPaths: libraryPaths,
+			OrderBy:      recentlyAddedOrder,
+			Limit:        MaxRowItems,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range books {
+			items = append(items, audiobookItem(&books[i]))
+		}
+	case library.LibraryTypeBook, library.LibraryTypeComic:
+		if s.sources.Books == nil {
+			break
+		}
+		kind := book.KindBook
+		if libType == library.LibraryTypeComic {
+			kind = book.KindComic
+		}
+		books, _, err := s.sources.Books.ListBooks(ctx, book.BookListFilters{
+			Kind:         kind,
+			LibraryPaths: libraryPaths,
+			OrderBy:      recentlyAddedOrder,
+			Limit:        MaxRowItems,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range books {
+			items = append(items, bookItem(&books[i]))
+		}
+	default:
+		return nil, ErrRowNotFound
+	}
+	return items, nil
+}
+
+// recentlyAddedInLibrary returns the content added last to a library of a
+// module that stores content per library.
+func (s *Service) recentlyAddedInLibrary(ctx context.Context, lib library.Library) ([]Item, error) {
+	var items []Item
+	switch lib.Type {
+	case library.LibraryTypeHomeVideo:
+		if s.sources.HomeVideos == nil {
+			break
+		}
+		videos, _, err := s.sources.HomeVideos.ListVideos(ctx, homevideo.VideoListFilters{
+			LibraryID: &lib.ID,
+			Sort:      homevideo.VideoSortAdded,
+			Limit:     MaxRowItems,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range videos {
+			items = append(items, homeVideoItem(&videos[i]))
+		}
+	case library.LibraryTypeMusicVideo:
+		if s.sources.MusicVideos == nil {
+			break
+		}
+		videos, _, err := s.sources.MusicVideos.ListVideos(ctx, musicvideo.VideoListFilters{
+			LibraryID: &lib.ID,
+			Sort:      musicvideo.VideoSortAdded,
+			Limit:     MaxRowItems,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range videos {
+			items = append(items, musicVideoItem(&videos[i]))
+		}
+	case library.LibraryTypePodcast:
+		if s.sources.Podcasts == nil {
+			break
+		}
+		episodes, err := s.sources.Podcasts.ListLatestEpisodes(ctx, lib.ID, MaxRowItems)
+		if err != nil {
+			return nil, err
+		}
+		for i := range episodes {
+			items = append(items, podcastEpisodeItem(&episodes[i].Episode, &episodes[i].Podcast))
+		}
+	default:
+		return nil, ErrRowNotFound
+	}
+	return items, nil
+}
+
+// candidate is a recommendation with the number of the viewer's favourite
+// genres it matches.
+type candidate struct {
+	item   Item
+	score  int
+	rating float64
+}
+
+// recommended returns unwatched movies and series in the genres of the
+// titles the viewer watched last, best matches first. Viewers without a
+// movie history are recommended the top rated movies.
+func (s *Service) recommended(ctx context.Context, viewer Viewer, acc access) ([]Item, error) {
+	var candidates []candidate
+
+	if s.sources.Movies != nil && acc.hasType(library.LibraryTypeMovie) {
+		movies, err := s.recommendedMovies(ctx, viewer, acc)
+		if err != nil {
+			return nil, fmt.Errorf("movies: %w", err)
+		}
+		candidates = append(candidates, movies...)
+	}
+	if s.sources.TVShows != nil && acc.hasType(library.LibraryTypeTVShow) {
+		series, err := s.recommendedSeries(ctx, viewer, acc)
+		if err != nil {
+			return nil, fmt.Errorf("tv shows: %w", err)
+		}
+		candidates = append(candidates, series...)
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.rating, a.rating)
+	})
+	items := make([]Item, len(candidates))
+	for i, c := range candidates {
+		items[i] = c.item
+	}
+	return items, nil
+}
+
+// recommendedMovies recommends movies from the viewer's watch history.
+func (s *Service) recommendedMovies(ctx context.Context, viewer Viewer, acc access) ([]candidate, error) {
+	movies := s.sources.Movies
+	libraryPaths := acc.libraryPaths(library.LibraryTypeMovie)
+	history, err := movies.GetWatchHistory(ctx, viewer.UserID, MaxRowItems, 0)
+	if err != nil {
+		return nil, err
+	}
+	inProgress, err := s.loadRow(ctx, viewer, acc, string(RowContinueWatching))
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(history)+len(inProgress.Items))
+	for _, h := range history {
+		seen[h.ID] = true
+	}
+	for _, item := range inProgress.Items {
+		seen[item.ID] = true
+	}
+
+	var seeds []uuid.UUID
+	for _, h := range history[:min(len(history), recommendationSeeds)] {
+		seeds = append(seeds, h.ID)
+	}
+	genres, err := favouriteGenres(ctx, seeds, func(ctx context.Context, id uuid.UUID) ([]string, error) {
+		genres, err := movies.GetMovieGenres(ctx, id)
+		slugs := make([]string, len(genres))
+		for i, g := range genres {
+			slugs[i] = g.Slug
+		}
+		return slugs, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(genres) == 0 {
+		top, _, err := movies.ListTopRated(ctx, topRatedMinVotes, libraryPaths, MaxRowItems, 0)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []candidate
+		for i := range top {
+			if !seen[top[i].ID] {
+				candidates = append(candidates, candidate{item: movieItem(&top[i]), rating: rating(top[i].VoteAverage)})
+			}
+		}
+		return candidates, nil
+	}
+
+	byID := make(map[uuid.UUID]*candidate)
+	var order []uuid.UUID
+	for _, slug := range genres {
+		list, err := movies.GetMoviesByGenre(ctx, slug, libraryPaths, MaxRowItems, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list {
+			m := &list[i]
+			if seen[m.ID] {
+				continue
+			}
+			if c, ok := byID[m.ID]; ok {
+				c.score++
+				continue
+			}
+			byID[m.ID] = &candidate{item: movieItem(m), score: 1, rating: rating(m.VoteAverage)}
+			order = append(order, m.ID)
+		}
+	}
+	return collect(byID, order), nil
+}
+
+// recommendedSeries recommends series from the series the viewer is
+// watching.
+func (s *Service) recommendedSeries(ctx context.Context, viewer Viewer, acc access) ([]candidate, error) {
+	tvshows := s.sources.TVShows
+	seen := make(map[uuid.UUID]bool)
+	var seeds []uuid.UUID
+	for _, id := range []string{string(RowContinueWatching), string(RowNextUp)} {
+		row, err := s.loadRow(ctx, viewer, acc, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range row.Items {
+			if item.Type != ItemTypeEpisode || item.ParentID == nil || seen[*item.ParentID] {
+				continue
+			}
+			seen[*item.ParentID] = true
+			seeds = append(seeds, *item.ParentID)
+		}
+	}
+
+	genres, err := favouriteGenres(ctx, seeds[:min(len(seeds), recommendationSeeds)], func(ctx context.Context, id uuid.UUID) ([]string, error) {
+		genres, err := tvshows.GetSeriesGenres(ctx, id)
+		slugs := make([]string, len(genres))
+		for i, g := range genres {
+			slugs[i] = g.Slug
+		}
+		return slugs, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*candidate)
+	var order []uuid.UUID
+	libraryPaths := acc.libraryPaths(library.LibraryTypeTVShow)
+	for _, slug := range genres {
+		list, err := tvshows.ListByGenre(ctx, slug, libraryPaths, MaxRowItems, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list {
+			series := &list[i]
+			if seen[series.ID] {
+				continue
+			}
+			if c, ok := byID[series.ID]; ok {
+				c.score++
+				continue
+			}
+			byID[series.ID] = &candidate{item: seriesItem(series), score: 1, rating: rating(series.VoteAverage)}
+			order = append(order, series.ID)
+		}
+	}
+	return collect(byID, order), nil
+}
+
+// favouriteGenres returns the genres most common among the seeds, most
+// common first.
+func favouriteGenres(ctx context.Context, seeds []uuid.UUID, genresOf func(context.Context, uuid.UUID) ([]string, error)) ([]string, error) {
+	counts := make(map[string]int)
+	var genres []string
+	for _, id := range seeds {
+		slugs, err := genresOf(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, slug := range slugs {
+			if counts[slug] == 0 {
+				genres = append(genres, slug)
+			}
+			counts[slug]++
+		}
+	}
+	slices.SortStableFunc(genres, func(a, b string) int {
+		return cmp.Compare(counts[b], counts[a])
+	})
+	return genres[:min(len(genres), recommendationGenres)], nil
+}
+
+// collect returns the candidates in the order they were found.
+func collect(byID map[uuid.UUID]*candidate, order []uuid.UUID) []candidate {
+	candidates := make([]candidate, len(order))
+	for i, id := range order {
+		candidates[i] = *byID[id]
+	}
+	return candidates
+}
+
+// rating returns a vote average as a float, zero when unrated.
+func rating(vote *decimal.Decimal) float64 {
+	if vote == nil {
+		return 0
+	}
+	f, _ := vote.Float64()
+	return f
+}
+
+// setProgress sets the progress of an item in progress.
+func setProgress(item *Item, progressSeconds, durationSeconds int32, lastActivity time.Time) {
+	item.ProgressSeconds = progressSeconds
+	item.DurationSeconds = durationSeconds
+	if durationSeconds > 0 {
+		item.ProgressPercent = new(min(progressSeconds*100/durationSeconds, 100))
+	}
+	item.LastActivityAt = new(lastActivity)
+}
+
+func movieItem(m *movie.Movie) Item {
+	item := Item{
+		Type:       ItemTypeMovie,
+		ID:         m.ID,
+		Title:      m.Title,
+		Year:       m.Year,
+		PosterPath: m.PosterPath,
+		AddedAt:    m.LibraryAddedAt,
+	}
+	if m.Runtime != nil {
+		item.DurationSeconds = *m.Runtime * 60
+	}
+	return item
+}
+
+func seriesItem(s *tvshow.Series) Item {
+	item := Item{
+		Type:       ItemTypeSeries,
+		ID:         s.ID,
+		Title:      s.Title,
+		PosterPath: s.PosterPath,
+		AddedAt:    s.CreatedAt,
+	}
+	if s.FirstAirDate != nil {
+		item.Year = new(int32(s.FirstAirDate.Year()))
+	}
+	return item
+}
+
+func albumItem(r *music.Release) Item {
+	return Item{
+		Type:            ItemTypeAlbum,
+		ID:              r.ID,
+		Title:           r.Title,
+		Subtitle:        r.ArtistCredit,
+		Year:            r.Year,
+		DurationSeconds: util.SafeInt64ToInt32(r.DurationMs / 1000),
+		AddedAt:         r.CreatedAt,
+	}
+}
+
+func audiobookItem(b *audiobook.Book) Item {
+	return Item{
+		Type:            ItemTypeAudiobook,
+		ID:              b.ID,
+		Title:           b.Title,
+		Subtitle:        b.AuthorCredit,
+		Year:            b.Year,
+		DurationSeconds: util.SafeInt64ToInt32(b.DurationMs / 1000),
+		AddedAt:         b.CreatedAt,
+	}
+}
+
+func bookItem(b *book.Book) Item {
+	itemType := ItemTypeBook
+	if b.Kind == book.KindComic {
+		itemType = ItemTypeComic
+	}
+	return Item{
+		Type:     itemType,
+		ID:       b.ID,
+		Title:    b.Title,
+		Subtitle: b.AuthorCredit,
+		Year:     b.Year,
+		AddedAt:  b.CreatedAt,
+	}
+}
+
+func homeVideoItem(v *homevideo.Video) Item {
+	return Item{
+		Type:            ItemTypeHomeVideo,
+		ID:              v.ID,
+		Title:           v.Title,
+		LibraryID:       &v.LibraryID,
+		Year:            new(int32(v.RecordedAt.Year())),
+		DurationSeconds: int32(v.DurationSeconds),
+		AddedAt:         v.CreatedAt,
+	}
+}
+
+func musicVideoItem(v *musicvideo.Video) Item {
+	return Item{
+		Type:            ItemTypeMusicVideo,
+		ID:              v.ID,
+		Title:           v.Title,
+		Subtitle:        v.ArtistCredit,
+		LibraryID:       &v.LibraryID,
+		Year:            v.Year,
+		DurationSeconds: int32(v.DurationSeconds),
+		AddedAt:         v.CreatedAt,
+	}
+}
+
+func podcastEpisodeItem(e *podcast.Episode, p *podcast.Podcast) Item {
+	item := Item{
+		Type:            ItemTypePodcastEpisode,
+		ID:              e.ID,
+		Title:           e.Title,
+		Subtitle:        p.Title,
+		ParentID:        &p.ID,
+		SeasonNumber:    e.Season,
+		EpisodeNumber:   e.EpisodeNumber,
+		LibraryID:       &p.LibraryID,
+		DurationSeconds: e.DurationSeconds,
+		AddedAt:         e.CreatedAt,
+	}
+	if e.PublishedAt != nil {
+		item.Year = new(int32(e.PublishedAt.Year()))
+	}
+	return item
+}
@@ -0,0 +1,104 @@
+package homefeed
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRowNotFound is returned for row IDs that do not exist or name a
+// library the viewer may not browse.
+var ErrRowNotFound = errors.New("home feed row not found")
+
+// RowKind is the kind of a home feed row.
+type RowKind string
+
+const (
+	// RowOnDeck merges continue watching and next up, most recent activity
+	// first.
+	RowOnDeck RowKind = "on_deck"
+	// RowContinueWatching holds the started, unfinished items of all modules.
+	RowContinueWatching RowKind = "continue_watching"
+	// RowNextUp holds the next episode of the series the user is watching.
+	RowNextUp RowKind = "next_up"
+	// RowRecentlyAdded holds the content added last to a library.
+	RowRecentlyAdded RowKind = "recently_added"
+	// RowRecommended holds unwatched movies and series in the genres the
+	// user watches most.
+	RowRecommended RowKind = "recommended"
+)
+
+// IsValid reports whether k is a known row kind.
+func (k RowKind) IsValid() bool {
+	switch k {
+	case RowOnDeck, RowContinueWatching, RowNextUp, RowRecentlyAdded, RowRecommended:
+		return true
+	}
+	return false
+}
+
+// ItemType is the kind of content a feed item refers to. Clients use it
+// with the item ID to pick the module endpoint to open it.
+type ItemType string
+
+const (
+	ItemTypeMovie          ItemType = "movie"
+	ItemTypeSeries         ItemType = "series"
+	ItemTypeEpisode        ItemType = "episode"
+	ItemTypeAlbum          ItemType = "album"
+	ItemTypeAudiobook      ItemType = "audiobook"
+	ItemTypeBook           ItemType = "book"
+	ItemTypeComic          ItemType = "comic"
+	ItemTypeHomeVideo      ItemType = "home_video"
+	ItemTypeMusicVideo     ItemType = "music_video"
+	ItemTypePodcastEpisode ItemType = "podcast_episode"
+)
+
+// Item is an entry of a feed row.
+type Item struct {
+	Type     ItemType  `json:"type"`
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Subtitle string    `json:"subtitle,omitempty"` // series, podcast, artist or author
+	// ParentID is the series or podcast of an episode.
+	ParentID      *uuid.UUID `json:"parent_id,omitempty"`
+	SeasonNumber  *int32     `json:"season_number,omitempty"`
+	EpisodeNumber *int32     `json:"episode_number,omitempty"`
+	// LibraryID is set for content stored per library.
+	LibraryID  *uuid.UUID `json:"library_id,omitempty"`
+	Year       *int32     `json:"year,omitempty"`
+	PosterPath *string    `json:"poster_path,omitempty"` // TMDb path of movies and series
+
+	// Progress of items in progress. Books only report a percentage.
+	ProgressSeconds int32  `json:"progress_seconds,omitempty"`
+	DurationSeconds int32  `json:"duration_seconds,omitempty"`
+	ProgressPercent *int32 `json:"progress_percent,omitempty"`
+
+	// LastActivityAt is when the user last watched, read or listened to
+	// the item, or the previous episode of a next up episode.
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+	AddedAt        time.Time  `json:"added_at"`
+}
+
+// Row is a typed row of the home feed. Rows hold at most MaxRowItems
+// items; Total counts all of them while Items is the requested page.
+type Row struct {
+	// ID identifies the row for paging, e.g. "on_deck",
+	// "recently_added:movie" or "recently_added:<library id>".
+	ID    string  `json:"id"`
+	Kind  RowKind `json:"kind"`
+	Title string  `json:"title"`
+	// LibraryID is set for recently added rows of a single library.
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+	// LibraryType is set for recently added rows.
+	LibraryType string `json:"library_type,omitempty"`
+	Items       []Item `json:"items"`
+	Total       int64  `json:"total"`
+}
+
+// Viewer is the user a feed is built for.
+type Viewer struct {
+	UserID  uuid.UUID
+	IsAdmin bool // admins browse every enabled library
+}
@@ -0,0 +1,192 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrMatchQueueItemNotFound is returned when a match queue item is not found.
+	ErrMatchQueueItemNotFound = errors.New("match queue item not found")
+	// ErrMatchQueueItemClosed is returned when acting on an item that was already resolved.
+	ErrMatchQueueItemClosed = errors.New("match queue item already resolved")
+)
+
+// Match queue statuses
+const (
+	MatchQueueStatusUnmatched     = "unmatched"
+	MatchQueueStatusLowConfidence = "low_confidence"
+	MatchQueueStatusResolved      = "resolved"
+	MatchQueueStatusIgnored       = "ignored"
+)
+
+// MatchQueueRepository defines persistence for the unmatched-items queue.
+type MatchQueueRepository interface {
+	Upsert(ctx context.Context, item *MatchQueueItem) error
+	Get(ctx context.Context, id uuid.UUID) (*MatchQueueItem, error)
+	List(ctx context.Context, filter MatchQueueFilter) ([]MatchQueueItem, error)
+	Count(ctx context.Context, filter MatchQueueFilter) (int64, error)
+	Resolve(ctx context.Context, id, contentID uuid.UUID, resolvedBy *uuid.UUID) (*MatchQueueItem, error)
+	SetStatus(ctx context.Context, id uuid.UUID, status string) (*MatchQueueItem, error)
+	DeleteOpenByPaths(ctx context.Context, contentType string, paths []string) (int64, error)
+}
+
+// MatchQueueItem is a scanned file the matcher could not identify with
+// enough confidence.
+type MatchQueueItem struct {
+	ID                uuid.UUID        `json:"id"`
+	LibraryID         *uuid.UUID       `json:"library_id,omitempty"`
+	ContentType       string           `json:"content_type"`
+	FilePath          string           `json:"file_path"`
+	ParsedTitle       *string          `json:"parsed_title,omitempty"`
+	ParsedYear        *int32           `json:"parsed_year,omitempty"`
+	Status            string           `json:"status"`
+	Reason            *string          `json:"reason,omitempty"`
+	Candidates        []MatchCandidate `json:"candidates"`
+	BestConfidence    *float64         `json:"best_confidence,omitempty"`
+	ResolvedContentID *uuid.UUID       `json:"resolved_content_id,omitempty"`
+	ResolvedBy        *uuid.UUID       `json:"resolved_by,omitempty"`
+	ResolvedAt        *time.Time       `json:"resolved_at,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+// MatchCandidate is a provider search result scored against a file.
+type MatchCandidate struct {
+	Provider   string  `json:"provider"`
+	ProviderID string  `json:"provider_id"`
+	IMDbID     *string `json:"imdb_id,omitempty"`
+	Title      string  `json:"title"`
+	Year       *int32  `json:"year,omitempty"`
+	Overview   *string `json:"overview,omitempty"`
+	PosterPath *string `json:"poster_path,omitempty"`
+	Confidence float64 `json:"confidence"`
+}
+
+// MatchQueueFilter narrows a match queue listing. Nil fields are ignored.
+type MatchQueueFilter struct {
+	Status      *string
+	ContentType *string
+	LibraryID   *uuid.UUID
+	Limit       int32
+	Offset      int32
+}
+
+// MatchQueueService manages files waiting for manual identification.
+type MatchQueueService struct {
+	repo   MatchQueueRepository
+	logger *slog.Logger
+}
+
+// NewMatchQueueService creates a new match queue service.
+func NewMatchQueueService(repo MatchQueueRepository, logger *slog.Logger) *MatchQueueService {
+	return &MatchQueueService{
+		repo:   repo,
+		logger: logger.With("component", "match_queue"),
+	}
+}
+
+// Record adds or refreshes a queue entry for an unmatched file. Items with
+// candidates are queued as low confidence, everything else as unmatched.
+func (s *MatchQueueService) Record(ctx context.Context, item *MatchQueueItem) error {
+	if item.Status == "" {
+		item.Status = MatchQueueStatusUnmatched
+		if len(item.Candidates) > 0 {
+			item.Status = MatchQueueStatusLowConfidence
+		}
+	}
+	if item.BestConfidence == nil && len(item.Candidates) > 0 {
+		best := item.Candidates[0].Confidence
+		for _, c := range item.Candidates[1:] {
+			best = max(best, c.Confidence)
+		}
+		item.BestConfidence = &best
+	}
+
+	if err := s.repo.Upsert(ctx, item); err != nil {
+		s.logger.Error("failed to record unmatched file",
+			slog.String("path", item.FilePath),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
+}
+
+// Get returns a single queue item.
+func (s *MatchQueueService) Get(ctx context.Context, id uuid.UUID) (*MatchQueueItem, error) {
+	return s.repo.Get(ctx, id)
+}
+
+// List returns queue items matching the filter along with the total count.
+func (s *MatchQueueService) List(ctx context.Context, filter MatchQueueFilter) ([]MatchQueueItem, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 200 {
+		filter.Limit = 200
+	}
+
+	items, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.repo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return items, count, nil
+}
+
+// Resolve marks an item as identified and links it to the matched content.
+func (s *MatchQueueService) Resolve(ctx context.Context, id, contentID uuid.UUID, resolvedBy *uuid.UUID) (*MatchQueueItem, error) {
+	item, err := s.repo.Resolve(ctx, id, contentID, resolvedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("match queue item resolved",
+		slog.String("id", id.String()),
+		slog.String("path", item.FilePath),
+		slog.String("content_id", contentID.String()),
+	)
+	return item, nil
+}
+
+// Ignore hides an item from the open queue. Rescans keep it ignored.
+func (s *MatchQueueService) Ignore(ctx context.Context, id uuid.UUID) (*MatchQueueItem, error) {
+	item, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Status == MatchQueueStatusResolved {
+		return nil, ErrMatchQueueItemClosed
+	}
+	return s.repo.SetStatus(ctx, id, MatchQueueStatusIgnored)
+}
+
+// ClearMatched removes open entries for files that were matched since they
+// were queued.
+func (s *MatchQueueService) ClearMatched(ctx context.Context, contentType string, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	removed, err := s.repo.DeleteOpenByPaths(ctx, contentType, paths)
+	if err != nil {
+		return err
+	}
+	if removed > 0 {
+		s.logger.Debug("cleared matched files from queue",
+			slog.String("content_type", contentType),
+			slog.Int64("removed", removed),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package library
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// MatchQueueRepositoryPg implements MatchQueueRepository using PostgreSQL.
+type MatchQueueRepositoryPg struct {
+	queries *db.Queries
+}
+
+// NewMatchQueueRepositoryPg creates a new PostgreSQL match queue repository.
+func NewMatchQueueRepositoryPg(queries *db.Queries) *MatchQueueRepositoryPg {
+	return &MatchQueueRepositoryPg{queries: queries}
+}
+
+// Upsert inserts or refreshes a queue item keyed by content type and path.
+func (r *MatchQueueRepositoryPg) Upsert(ctx context.Context, item *MatchQueueItem) error {
+	candidates := item.Candidates
+	if candidates == nil {
+		candidates = []MatchCandidate{}
+	}
+	raw, err := json.Marshal(candidates)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.queries.UpsertMatchQueueItem(ctx, db.UpsertMatchQueueItemParams{
+		LibraryID:      uuidToPg(item.LibraryID),
+		ContentType:    item.ContentType,
+		FilePath:       item.FilePath,
+		ParsedTitle:    item.ParsedTitle,
+		ParsedYear:     item.ParsedYear,
+		Status:         item.Status,
+		Reason:         item.Reason,
+		Candidates:     raw,
+		BestConfidence: item.BestConfidence,
+	})
+	if err != nil {
+		return err
+	}
+
+	*item = *dbMatchQueueToItem(result)
+	return nil
+}
+
+// Get retrieves a queue item by ID.
+func (r *MatchQueueRepositoryPg) Get(ctx context.Context, id uuid.UUID) (*MatchQueueItem, error) {
+	result, err := r.queries.GetMatchQueueItem(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMatchQueueItemNotFound
+		}
+		return nil, err
+	}
+	return dbMatchQueueToItem(result), nil
+}
+
+// List returns queue items matching the filter.
+func (r *MatchQueueRepositoryPg) List(ctx context.Context, filter MatchQueueFilter) ([]MatchQueueItem, error) {
+	results, err := r.queries.ListMatchQueueItems(ctx, db.ListMatchQueueItemsParams{
+		Status:      filter.Status,
+		ContentType: filter.ContentType,
+		LibraryID:   uuidToPg(filter.LibraryID),
+		LimitCount:  filter.Limit,
+		OffsetCount: filter.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]MatchQueueItem, len(results))
+	for i, result := range results {
+		items[i] = *dbMatchQueueToItem(result)
+	}
+	return items, nil
+}
+
+// Count counts queue items matching the filter.
+func (r *MatchQueueRepositoryPg) Count(ctx context.Context, filter MatchQueueFilter) (int64, error) {
+	return r.queries.CountMatchQueueItems(ctx, db.CountMatchQueueItemsParams{
+		Status:      filter.Status,
+		ContentType: filter.ContentType,
+		LibraryID:   uuidToPg(filter.LibraryID),
+	})
+}
+
+// Resolve marks a queue item as resolved.
+func (r *MatchQueueRepositoryPg) Resolve(ctx context.Context, id, contentID uuid.UUID, resolvedBy *uuid.UUID) (*MatchQueueItem, error) {
+	result, err := r.queries.ResolveMatchQueueItem(ctx, db.ResolveMatchQueueItemParams{
+		ID:                id,
+		ResolvedContentID: pgtype.UUID{Bytes: contentID, Valid: true},
+		ResolvedBy:        uuidToPg(resolvedBy),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMatchQueueItemNotFound
+		}
+		return nil, err
+	}
+	return dbMatchQueueToItem(result), nil
+}
+
+// SetStatus updates the status of a queue item.
+func (r *MatchQueueRepositoryPg) SetStatus(ctx context.Context, id uuid.UUID, status string) (*MatchQueueItem, error) {
+	result, err := r.queries.SetMatchQueueItemStatus(ctx, db.SetMatchQueueItemStatusParams{
+		ID:     id,
+		Status: status,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMatchQueueItemNotFound
+		}
+		return nil, err
+	}
+	return dbMatchQueueToItem(result), nil
+}
+
+// DeleteOpenByPaths removes unresolved queue items for the given paths.
+func (r *MatchQueueRepositoryPg) DeleteOpenByPaths(ctx context.Context, contentType string, paths []string) (int64, error) {
+	return r.queries.DeleteMatchQueueItemsByPaths(ctx, db.DeleteMatchQueueItemsByPathsParams{
+		ContentType: contentType,
+		FilePaths:   paths,
+	})
+}
+
+func dbMatchQueueToItem(row db.LibraryMatchQueue) *MatchQueueItem {
+	result := &MatchQueueItem{
+		ID:                row.ID,
+		LibraryID:         pgToUUID(row.LibraryID),
+		ContentType:       row.ContentType,
+		FilePath:          row.FilePath,
+		ParsedTitle:       row.ParsedTitle,
+		ParsedYear:        row.ParsedYear,
+		Status:            row.Status,
+		Reason:            row.Reason,
+		BestConfidence:    row.BestConfidence,
+		ResolvedContentID: pgToUUID(row.ResolvedContentID),
+		ResolvedBy:        pgToUUID(row.ResolvedBy),
+		CreatedAt:         row.CreatedAt,
+		UpdatedAt:         row.UpdatedAt,
+	}
+
+	if row.ResolvedAt.Valid {
+		result.ResolvedAt = &row.ResolvedAt.Time
+	}
+
+	if len(row.Candidates) > 0 {
+		_ = json.Unmarshal(row.Candidates, &result.Candidates)
+	}
+	if result.Candidates == nil {
+		result.Candidates = []MatchCandidate{}
+	}
+
+	return result
+}
+
+func uuidToPg(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+func pgToUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	u := uuid.UUID(id.Bytes)
+	return &u
+}
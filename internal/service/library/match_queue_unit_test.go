@@ -0,0 +1,160 @@
+package library_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMatchQueueRepository implements library.MatchQueueRepository for testing.
+type mockMatchQueueRepository struct {
+	mock.Mock
+}
+
+func (m *mockMatchQueueRepository) Upsert(ctx context.Context, item *library.MatchQueueItem) error {
+	return m.Called(ctx, item).Error(0)
+}
+
+func (m *mockMatchQueueRepository) Get(ctx context.Context, id uuid.UUID) (*library.MatchQueueItem, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*library.MatchQueueItem), args.Error(1)
+}
+
+func (m *mockMatchQueueRepository) List(ctx context.Context, filter library.MatchQueueFilter) ([]library.MatchQueueItem, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]library.MatchQueueItem), args.Error(1)
+}
+
+func (m *mockMatchQueueRepository) Count(ctx context.Context, filter library.MatchQueueFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockMatchQueueRepository) Resolve(ctx context.Context, id, contentID uuid.UUID, resolvedBy *uuid.UUID) (*library.MatchQueueItem, error) {
+	args := m.Called(ctx, id, contentID, resolvedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*library.MatchQueueItem), args.Error(1)
+}
+
+func (m *mockMatchQueueRepository) SetStatus(ctx context.Context, id uuid.UUID, status string) (*library.MatchQueueItem, error) {
+	args := m.Called(ctx, id, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*library.MatchQueueItem), args.Error(1)
+}
+
+func (m *mockMatchQueueRepository) DeleteOpenByPaths(ctx context.Context, contentType string, paths []string) (int64, error) {
+	args := m.Called(ctx, contentType, paths)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestMatchQueueService_Record(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no candidates is unmatched", func(t *testing.T) {
+		repo := new(mockMatchQueueRepository)
+		svc := library.NewMatchQueueService(repo, logging.NewTestLogger())
+
+		repo.On("Upsert", ctx, mock.MatchedBy(func(item *library.MatchQueueItem) bool {
+			return item.Status == library.MatchQueueStatusUnmatched && item.BestConfidence == nil
+		})).Return(nil)
+
+		err := svc.Record(ctx, &library.MatchQueueItem{ContentType: "movie", FilePath: "/movies/a.mkv"})
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("candidates are low confidence with best score", func(t *testing.T) {
+		repo := new(mockMatchQueueRepository)
+		svc := library.NewMatchQueueService(repo, logging.NewTestLogger())
+
+		repo.On("Upsert", ctx, mock.Anything).Return(nil)
+
+		item := &library.MatchQueueItem{
+			ContentType: "movie",
+			FilePath:    "/movies/b.mkv",
+			Candidates: []library.MatchCandidate{
+				{Provider: "tmdb", ProviderID: "1", Confidence: 0.2},
+				{Provider: "tmdb", ProviderID: "2", Confidence: 0.4},
+			},
+		}
+		require.NoError(t, svc.Record(ctx, item))
+		assert.Equal(t, library.MatchQueueStatusLowConfidence, item.Status)
+		require.NotNil(t, item.BestConfidence)
+		assert.InDelta(t, 0.4, *item.BestConfidence, 0.0001)
+	})
+}
+
+func TestMatchQueueService_List(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockMatchQueueRepository)
+	svc := library.NewMatchQueueService(repo, logging.NewTestLogger())
+
+	expected := library.MatchQueueFilter{Limit: 50}
+	repo.On("List", ctx, expected).Return([]library.MatchQueueItem{{ID: uuid.New()}}, nil)
+	repo.On("Count", ctx, expected).Return(int64(1), nil)
+
+	items, total, err := svc.List(ctx, library.MatchQueueFilter{})
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, int64(1), total)
+	repo.AssertExpectations(t)
+}
+
+func TestMatchQueueService_Ignore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("open item is ignored", func(t *testing.T) {
+		repo := new(mockMatchQueueRepository)
+		svc := library.NewMatchQueueService(repo, logging.NewTestLogger())
+		id := uuid.New()
+
+		repo.On("Get", ctx, id).Return(&library.MatchQueueItem{ID: id, Status: library.MatchQueueStatusUnmatched}, nil)
+		repo.On("SetStatus", ctx, id, library.MatchQueueStatusIgnored).
+			Return(&library.MatchQueueItem{ID: id, Status: library.MatchQueueStatusIgnored}, nil)
+
+		item, err := svc.Ignore(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, library.MatchQueueStatusIgnored, item.Status)
+	})
+
+	t.Run("resolved item cannot be ignored", func(t *testing.T) {
+		repo := new(mockMatchQueueRepository)
+		svc := library.NewMatchQueueService(repo, logging.NewTestLogger())
+		id := uuid.New()
+
+		repo.On("Get", ctx, id).Return(&library.MatchQueueItem{ID: id, Status: library.MatchQueueStatusResolved}, nil)
+
+		_, err := svc.Ignore(ctx, id)
+		assert.ErrorIs(t, err, library.ErrMatchQueueItemClosed)
+		repo.AssertNotCalled(t, "SetStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMatchQueueService_ClearMatched(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockMatchQueueRepository)
+	svc := library.NewMatchQueueService(repo, logging.NewTestLogger())
+
+	require.NoError(t, svc.ClearMatched(ctx, "movie", nil))
+	repo.AssertNotCalled(t, "DeleteOpenByPaths", mock.Anything, mock.Anything, mock.Anything)
+
+	repo.On("DeleteOpenByPaths", ctx, "movie", []string{"/movies/a.mkv"}).Return(int64(1), nil)
+	require.NoError(t, svc.ClearMatched(ctx, "movie", []string{"/movies/a.mkv"}))
+	repo.AssertExpectations(t)
+}
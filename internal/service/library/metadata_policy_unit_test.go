@@ -0,0 +1,94 @@
+package library_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/service/library"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+func TestLibrary_HasPath(t *testing.T) {
+	lib := &library.Library{Paths: []string{"/media/anime", "/shared/anime/"}}
+
+	assert.True(t, lib.HasPath("/media/anime/One Piece/S01E01.mkv"))
+	assert.True(t, lib.HasPath("/shared/anime/Frieren/S01E01.mkv"))
+	assert.True(t, lib.HasPath("/media/anime"))
+	assert.False(t, lib.HasPath("/media/anime-old/S01E01.mkv"))
+	assert.False(t, lib.HasPath("/media/tv/Dark/S01E01.mkv"))
+}
+
+func TestLibrary_PathPrefixes(t *testing.T) {
+	lib := &library.Library{Paths: []string{"/media/anime", "/shared/anime/", "/"}}
+
+	assert.Equal(t, []string{"/media/anime/", "/shared/anime/", "/"}, lib.PathPrefixes())
+}
+
+func TestLibrary_ProviderPolicy(t *testing.T) {
+	provider := "tvdb"
+
+	t.Run("legacy provider becomes the default chain", func(t *testing.T) {
+		lib := &library.Library{MetadataProvider: &provider}
+		assert.Equal(t, []metadata.ProviderID{metadata.ProviderTVDb}, lib.ProviderPolicy().Providers)
+	})
+
+	t.Run("policy chain wins", func(t *testing.T) {
+		lib := &library.Library{
+			MetadataProvider: &provider,
+			MetadataPolicy:   metadata.ProviderPolicy{Providers: []metadata.ProviderID{metadata.ProviderAniList}},
+		}
+		assert.Equal(t, []metadata.ProviderID{metadata.ProviderAniList}, lib.ProviderPolicy().Providers)
+	})
+}
+
+func TestLibraryService_MetadataContext_Short(t *testing.T) {
+	anime := makeTestLibrary(uuid.Must(uuid.NewV7()), "anime", library.LibraryTypeTVShow)
+	anime.MetadataPolicy = metadata.ProviderPolicy{
+		Providers: []metadata.ProviderID{metadata.ProviderAniList, metadata.ProviderTMDb},
+		Fields:    map[metadata.Field][]metadata.ProviderID{metadata.FieldImages: {metadata.ProviderTMDb}},
+	}
+	tv := makeTestLibrary(uuid.Must(uuid.NewV7()), "tv", library.LibraryTypeTVShow)
+
+	t.Run("by library ID", func(t *testing.T) {
+		mockRepo := NewMockLibraryRepository(t)
+		svc := setupLibraryService(mockRepo)
+		mockRepo.On("Get", mock.Anything, anime.ID).Return(anime, nil)
+
+		policy, ok := metadata.ProviderPolicyFromContext(svc.MetadataContext(context.Background(), anime.ID))
+		require.True(t, ok)
+		assert.Equal(t, anime.MetadataPolicy, policy)
+	})
+
+	t.Run("by file path", func(t *testing.T) {
+		mockRepo := NewMockLibraryRepository(t)
+		svc := setupLibraryService(mockRepo)
+		mockRepo.On("ListByType", mock.Anything, library.LibraryTypeTVShow).
+			Return([]library.Library{*tv, *anime}, nil)
+
+		ctx := svc.MetadataContextForPath(context.Background(), library.LibraryTypeTVShow, "/media/anime/One Piece/S01E01.mkv")
+		policy, ok := metadata.ProviderPolicyFromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, []metadata.ProviderID{metadata.ProviderTMDb}, policy.Chain(metadata.FieldImages))
+
+		ctx = svc.MetadataContextForPath(context.Background(), library.LibraryTypeTVShow, "/media/tv/Dark/S01E01.mkv")
+		_, ok = metadata.ProviderPolicyFromContext(ctx)
+		assert.False(t, ok)
+	})
+}
+
+func TestLibraryService_Create_InvalidPolicy_Short(t *testing.T) {
+	svc := setupLibraryService(NewMockLibraryRepository(t))
+
+	_, err := svc.Create(context.Background(), library.CreateLibraryRequest{
+		Name:           "Anime",
+		Type:           library.LibraryTypeTVShow,
+		Paths:          []string{"/media/anime"},
+		MetadataPolicy: metadata.ProviderPolicy{Providers: []metadata.ProviderID{"imdb"}},
+	})
+	assert.ErrorIs(t, err, metadata.ErrInvalidPolicy)
+}
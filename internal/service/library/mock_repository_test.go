@@ -1466,6 +1466,66 @@ func (_c *MockLibraryRepository_RevokeUserPermissions_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SetScanJobID provides a mock function with given fields: ctx, id, jobID
+func (_m *MockLibraryRepository) SetScanJobID(ctx context.Context, id uuid.UUID, jobID int64) (*library.LibraryScan, error) {
+	ret := _m.Called(ctx, id, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetScanJobID")
+	}
+
+	var r0 *library.LibraryScan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (*library.LibraryScan, error)); ok {
+		return rf(ctx, id, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) *library.LibraryScan); ok {
+		r0 = rf(ctx, id, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*library.LibraryScan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, id, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLibraryRepository_SetScanJobID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetScanJobID'
+type MockLibraryRepository_SetScanJobID_Call struct {
+	*mock.Call
+}
+
+// SetScanJobID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - jobID int64
+func (_e *MockLibraryRepository_Expecter) SetScanJobID(ctx interface{}, id interface{}, jobID interface{}) *MockLibraryRepository_SetScanJobID_Call {
+	return &MockLibraryRepository_SetScanJobID_Call{Call: _e.mock.On("SetScanJobID", ctx, id, jobID)}
+}
+
+func (_c *MockLibraryRepository_SetScanJobID_Call) Run(run func(ctx context.Context, id uuid.UUID, jobID int64)) *MockLibraryRepository_SetScanJobID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLibraryRepository_SetScanJobID_Call) Return(_a0 *library.LibraryScan, _a1 error) *MockLibraryRepository_SetScanJobID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLibraryRepository_SetScanJobID_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (*library.LibraryScan, error)) *MockLibraryRepository_SetScanJobID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, update
 func (_m *MockLibraryRepository) Update(ctx context.Context, id uuid.UUID, update *library.LibraryUpdate) (*library.Library, error) {
 	ret := _m.Called(ctx, id, update)
@@ -16,6 +16,8 @@ var Module = fx.Module("library",
 		newRepository,
 		newService,
 		newCachedService,
+		newMatchQueueRepository,
+		NewMatchQueueService,
 		NewLibraryScanCleanupWorker,
 		newPeriodicLibraryScanWorker,
 	),
@@ -26,6 +28,11 @@ func newRepository(queries *db.Queries) Repository {
 	return NewRepositoryPg(queries)
 }
 
+// newMatchQueueRepository creates the unmatched-items queue repository.
+func newMatchQueueRepository(queries *db.Queries) MatchQueueRepository {
+	return NewMatchQueueRepositoryPg(queries)
+}
+
 // newService creates a new library service with activity logger.
 func newService(repo Repository, logger *slog.Logger, activityLogger activity.Logger) *Service {
 	return NewService(repo, logger, activityLogger)
@@ -126,6 +126,71 @@ func (w *PeriodicLibraryScanWorker) enqueueScan(ctx context.Context, lib Library
 			Force:     false,
 		}, nil)
 		return err
+	case LibraryTypeMusic:
+		libID := lib.ID
+		_, err := w.jobClient.Insert(ctx, &musicScanArgs{
+			Paths:     lib.Paths,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypeAudiobook:
+		libID := lib.ID
+		_, err := w.jobClient.Insert(ctx, &audiobookScanArgs{
+			Paths:     lib.Paths,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypeBook, LibraryTypeComic:
+		libID := lib.ID
+		kind := "book"
+		if lib.Type == LibraryTypeComic {
+			kind = "comic"
+		}
+		_, err := w.jobClient.Insert(ctx, &bookScanArgs{
+			Paths:     lib.Paths,
+			BookKind:  kind,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypePhoto:
+		libID := lib.ID
+		_, err := w.jobClient.Insert(ctx, &photoScanArgs{
+			Paths:     lib.Paths,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypeHomeVideo:
+		libID := lib.ID
+		_, err := w.jobClient.Insert(ctx, &homeVideoScanArgs{
+			Paths:     lib.Paths,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypeMusicVideo:
+		libID := lib.ID
+		_, err := w.jobClient.Insert(ctx, &musicVideoScanArgs{
+			Paths:     lib.Paths,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypeAdult:
+		libID := lib.ID
+		_, err := w.jobClient.Insert(ctx, &qarScanArgs{
+			Paths:     lib.Paths,
+			Force:     false,
+			LibraryID: &libID,
+		}, nil)
+		return err
+	case LibraryTypePodcast:
+		// Podcast feeds are refreshed on their own schedule
+		// (podcast.refresh_interval)
+		return nil
 	default:
 		w.logger.Debug("skipping unsupported library type for periodic scan",
 			slog.String("type", lib.Type),
@@ -159,3 +224,67 @@ type movieScanArgs struct {
 }
 
 func (movieScanArgs) Kind() string { return "movie_library_scan" }
+
+// musicScanArgs mirrors musicjobs.LibraryScanArgs.
+type musicScanArgs struct {
+	Paths     []string   `json:"paths"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (musicScanArgs) Kind() string { return "music_library_scan" }
+
+// audiobookScanArgs mirrors audiobookjobs.LibraryScanArgs.
+type audiobookScanArgs struct {
+	Paths     []string   `json:"paths"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (audiobookScanArgs) Kind() string { return "audiobook_library_scan" }
+
+// bookScanArgs mirrors bookjobs.LibraryScanArgs.
+type bookScanArgs struct {
+	Paths     []string   `json:"paths"`
+	BookKind  string     `json:"book_kind,omitempty"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (bookScanArgs) Kind() string { return "book_library_scan" }
+
+// photoScanArgs mirrors photojobs.LibraryScanArgs.
+type photoScanArgs struct {
+	Paths     []string   `json:"paths"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (photoScanArgs) Kind() string { return "photo_library_scan" }
+
+// homeVideoScanArgs mirrors homevideojobs.LibraryScanArgs.
+type homeVideoScanArgs struct {
+	Paths     []string   `json:"paths"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (homeVideoScanArgs) Kind() string { return "homevideo_library_scan" }
+
+// musicVideoScanArgs mirrors musicvideojobs.LibraryScanArgs.
+type musicVideoScanArgs struct {
+	Paths     []string   `json:"paths"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (musicVideoScanArgs) Kind() string { return "musicvideo_library_scan" }
+
+// qarScanArgs mirrors qarjobs.LibraryScanArgs.
+type qarScanArgs struct {
+	Paths     []string   `json:"paths"`
+	Force     bool       `json:"force"`
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+}
+
+func (qarScanArgs) Kind() string { return "qar_library_scan" }
@@ -3,10 +3,14 @@ package library
 
 import (
 	"context"
+	"path/filepath"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/service/metadata"
 )
 
 // Repository defines the interface for library persistence.
@@ -32,6 +36,7 @@ type Repository interface {
 	GetRunningScans(ctx context.Context) ([]LibraryScan, error)
 	UpdateScanStatus(ctx context.Context, id uuid.UUID, status *ScanStatusUpdate) (*LibraryScan, error)
 	UpdateScanProgress(ctx context.Context, id uuid.UUID, progress *ScanProgress) (*LibraryScan, error)
+	SetScanJobID(ctx context.Context, id uuid.UUID, jobID int64) (*LibraryScan, error)
 	DeleteOldScans(ctx context.Context, olderThan time.Time) (int64, error)
 
 	// Library Permissions
@@ -49,31 +54,65 @@ type Repository interface {
 
 // Library represents a media library.
 type Library struct {
-	ID                 uuid.UUID      `json:"id"`
-	Name               string         `json:"name"`
-	Type               string         `json:"type"`
-	Paths              []string       `json:"paths"`
-	Enabled            bool           `json:"enabled"`
-	ScanOnStartup      bool           `json:"scan_on_startup"`
-	RealtimeMonitoring bool           `json:"realtime_monitoring"`
-	MetadataProvider   *string        `json:"metadata_provider,omitempty"`
-	PreferredLanguage  string         `json:"preferred_language"`
-	ScannerConfig      map[string]any `json:"scanner_config,omitempty"`
-	CreatedAt          time.Time      `json:"created_at"`
-	UpdatedAt          time.Time      `json:"updated_at"`
+	ID                 uuid.UUID               `json:"id"`
+	Name               string                  `json:"name"`
+	Type               string                  `json:"type"`
+	Paths              []string                `json:"paths"`
+	Enabled            bool                    `json:"enabled"`
+	ScanOnStartup      bool                    `json:"scan_on_startup"`
+	RealtimeMonitoring bool                    `json:"realtime_monitoring"`
+	MetadataProvider   *string                 `json:"metadata_provider,omitempty"`
+	MetadataPolicy     metadata.ProviderPolicy `json:"metadata_policy"`
+	PreferredLanguage  string                  `json:"preferred_language"`
+	ScannerConfig      map[string]any          `json:"scanner_config,omitempty"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+}
+
+// ProviderPolicy returns the library's metadata provider policy. A library
+// without a provider chain uses its legacy metadata provider as the chain.
+func (l *Library) ProviderPolicy() metadata.ProviderPolicy {
+	policy := l.MetadataPolicy
+	if len(policy.Providers) == 0 && l.MetadataProvider != nil && *l.MetadataProvider != "" {
+		policy.Providers = []metadata.ProviderID{metadata.ProviderID(*l.MetadataProvider)}
+	}
+	return policy
+}
+
+// HasPath reports whether path lies inside one of the library's paths.
+func (l *Library) HasPath(path string) bool {
+	clean := filepath.Clean(path)
+	for _, root := range l.Paths {
+		root = filepath.Clean(root)
+		if clean == root || strings.HasPrefix(clean, root+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// PathPrefixes returns the library's paths ending in a separator, so a
+// plain prefix match finds the files inside them.
+func (l *Library) PathPrefixes() []string {
+	prefixes := make([]string, len(l.Paths))
+	for i, root := range l.Paths {
+		prefixes[i] = strings.TrimSuffix(filepath.Clean(root), string(filepath.Separator)) + string(filepath.Separator)
+	}
+	return prefixes
 }
 
 // LibraryUpdate represents fields that can be updated on a library.
 type LibraryUpdate struct {
-	Name               *string        `json:"name,omitempty"`
-	Type               *string        `json:"type,omitempty"`
-	Paths              []string       `json:"paths,omitempty"`
-	Enabled            *bool          `json:"enabled,omitempty"`
-	ScanOnStartup      *bool          `json:"scan_on_startup,omitempty"`
-	RealtimeMonitoring *bool          `json:"realtime_monitoring,omitempty"`
-	MetadataProvider   *string        `json:"metadata_provider,omitempty"`
-	PreferredLanguage  *string        `json:"preferred_language,omitempty"`
-	ScannerConfig      map[string]any `json:"scanner_config,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	Type               *string                  `json:"type,omitempty"`
+	Paths              []string                 `json:"paths,omitempty"`
+	Enabled            *bool                    `json:"enabled,omitempty"`
+	ScanOnStartup      *bool                    `json:"scan_on_startup,omitempty"`
+	RealtimeMonitoring *bool                    `json:"realtime_monitoring,omitempty"`
+	MetadataProvider   *string                  `json:"metadata_provider,omitempty"`
+	MetadataPolicy     *metadata.ProviderPolicy `json:"metadata_policy,omitempty"`
+	PreferredLanguage  *string                  `json:"preferred_language,omitempty"`
+	ScannerConfig      map[string]any           `json:"scanner_config,omitempty"`
 }
 
 // LibraryScan represents a library scan job.
@@ -91,9 +130,15 @@ type LibraryScan struct {
 	StartedAt       *time.Time `json:"started_at,omitempty"`
 	CompletedAt     *time.Time `json:"completed_at,omitempty"`
 	DurationSeconds *int32     `json:"duration_seconds,omitempty"`
+	JobID           *int64     `json:"job_id,omitempty"`
 	CreatedAt       time.Time  `json:"created_at"`
 }
 
+// IsActive reports whether the scan is pending or running.
+func (s *LibraryScan) IsActive() bool {
+	return s.Status == ScanStatusPending || s.Status == ScanStatusRunning
+}
+
 // ScanStatusUpdate represents a scan status update.
 type ScanStatusUpdate struct {
 	Status          string
@@ -123,15 +168,17 @@ type Permission struct {
 
 // Library types
 const (
-	LibraryTypeMovie     = "movie"
-	LibraryTypeTVShow    = "tvshow"
-	LibraryTypeMusic     = "music"
-	LibraryTypePhoto     = "photo"
-	LibraryTypeBook      = "book"
-	LibraryTypeAudiobook = "audiobook"
-	LibraryTypeComic     = "comic"
-	LibraryTypePodcast   = "podcast"
-	LibraryTypeAdult     = "adult"
+	LibraryTypeMovie      = "movie"
+	LibraryTypeTVShow     = "tvshow"
+	LibraryTypeMusic      = "music"
+	LibraryTypePhoto      = "photo"
+	LibraryTypeHomeVideo  = "homevideo"
+	LibraryTypeMusicVideo = "musicvideo"
+	LibraryTypeBook       = "book"
+	LibraryTypeAudiobook  = "audiobook"
+	LibraryTypeComic      = "comic"
+	LibraryTypePodcast    = "podcast"
+	LibraryTypeAdult      = "adult"
 )
 
 // Scan types
@@ -164,6 +211,8 @@ func ValidLibraryTypes() []string {
 		LibraryTypeTVShow,
 		LibraryTypeMusic,
 		LibraryTypePhoto,
+		LibraryTypeHomeVideo,
+		LibraryTypeMusicVideo,
 		LibraryTypeBook,
 		LibraryTypeAudiobook,
 		LibraryTypeComic,
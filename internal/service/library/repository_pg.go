@@ -37,6 +37,11 @@ func (r *RepositoryPg) Create(ctx context.Context, lib *Library) error {
 		}
 	}
 
+	metadataPolicy, err := json.Marshal(lib.MetadataPolicy)
+	if err != nil {
+		return err
+	}
+
 	result, err := r.queries.CreateLibrary(ctx, db.CreateLibraryParams{
 		Name:               lib.Name,
 		Type:               lib.Type,
@@ -45,6 +50,7 @@ func (r *RepositoryPg) Create(ctx context.Context, lib *Library) error {
 		ScanOnStartup:      lib.ScanOnStartup,
 		RealtimeMonitoring: lib.RealtimeMonitoring,
 		MetadataProvider:   lib.MetadataProvider,
+		MetadataPolicy:     metadataPolicy,
 		PreferredLanguage:  lib.PreferredLanguage,
 		ScannerConfig:      scannerConfig,
 	})
@@ -143,6 +149,14 @@ func (r *RepositoryPg) Update(ctx context.Context, id uuid.UUID, update *Library
 		params.ScannerConfig = scannerConfig
 	}
 
+	if update.MetadataPolicy != nil {
+		metadataPolicy, err := json.Marshal(update.MetadataPolicy)
+		if err != nil {
+			return nil, err
+		}
+		params.MetadataPolicy = metadataPolicy
+	}
+
 	result, err := r.queries.UpdateLibrary(ctx, params)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -294,6 +308,21 @@ func (r *RepositoryPg) UpdateScanProgress(ctx context.Context, id uuid.UUID, pro
 	return dbScanToScan(result), nil
 }
 
+// SetScanJobID links a scan to the River job performing it.
+func (r *RepositoryPg) SetScanJobID(ctx context.Context, id uuid.UUID, jobID int64) (*LibraryScan, error) {
+	result, err := r.queries.SetLibraryScanJobID(ctx, db.SetLibraryScanJobIDParams{
+		ID:    id,
+		JobID: &jobID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrScanNotFound
+		}
+		return nil, err
+	}
+	return dbScanToScan(result), nil
+}
+
 // DeleteOldScans deletes scans older than the given time.
 func (r *RepositoryPg) DeleteOldScans(ctx context.Context, olderThan time.Time) (int64, error) {
 	return r.queries.DeleteOldLibraryScans(ctx, olderThan)
@@ -436,6 +465,10 @@ func dbLibraryToLibrary(lib db.Library) *Library {
 		}
 	}
 
+	if len(lib.MetadataPolicy) > 0 {
+		_ = json.Unmarshal(lib.MetadataPolicy, &result.MetadataPolicy)
+	}
+
 	return result
 }
 
@@ -452,6 +485,7 @@ func dbScanToScan(scan db.LibraryScan) *LibraryScan {
 		ErrorsCount:     scan.ErrorsCount,
 		ErrorMessage:    scan.ErrorMessage,
 		DurationSeconds: scan.DurationSeconds,
+		JobID:           scan.JobID,
 		CreatedAt:       scan.CreatedAt,
 	}
 
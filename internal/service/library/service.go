@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/service/activity"
+	"github.com/lusoris/revenge/internal/service/metadata"
 )
 
 var (
@@ -28,6 +29,8 @@ var (
 	ErrLibraryExists = errors.New("library with this name already exists")
 	// ErrScanInProgress is returned when a scan is already running.
 	ErrScanInProgress = errors.New("scan already in progress for this library")
+	// ErrScanNotActive is returned when a finished scan is cancelled.
+	ErrScanNotActive = errors.New("library scan is not pending or running")
 	// ErrAccessDenied is returned when the user doesn't have permission.
 	ErrAccessDenied = errors.New("access denied")
 )
@@ -54,15 +57,16 @@ func NewService(repo Repository, logger *slog.Logger, activityLogger activity.Lo
 
 // CreateLibraryRequest represents a request to create a library.
 type CreateLibraryRequest struct {
-	Name               string         `json:"name"`
-	Type               string         `json:"type"`
-	Paths              []string       `json:"paths"`
-	Enabled            bool           `json:"enabled"`
-	ScanOnStartup      bool           `json:"scan_on_startup"`
-	RealtimeMonitoring bool           `json:"realtime_monitoring"`
-	MetadataProvider   *string        `json:"metadata_provider,omitempty"`
-	PreferredLanguage  string         `json:"preferred_language"`
-	ScannerConfig      map[string]any `json:"scanner_config,omitempty"`
+	Name               string                  `json:"name"`
+	Type               string                  `json:"type"`
+	Paths              []string                `json:"paths"`
+	Enabled            bool                    `json:"enabled"`
+	ScanOnStartup      bool                    `json:"scan_on_startup"`
+	RealtimeMonitoring bool                    `json:"realtime_monitoring"`
+	MetadataProvider   *string                 `json:"metadata_provider,omitempty"`
+	MetadataPolicy     metadata.ProviderPolicy `json:"metadata_policy"`
+	PreferredLanguage  string                  `json:"preferred_language"`
+	ScannerConfig      map[string]any          `json:"scanner_config,omitempty"`
 }
 
 // Create creates a new library.
@@ -71,6 +75,9 @@ func (s *Service) Create(ctx context.Context, req CreateLibraryRequest) (*Librar
 	if !IsValidLibraryType(req.Type) {
 		return nil, ErrInvalidLibraryType
 	}
+	if err := req.MetadataPolicy.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Check if library with same name exists
 	_, err := s.repo.GetByName(ctx, req.Name)
@@ -94,6 +101,7 @@ func (s *Service) Create(ctx context.Context, req CreateLibraryRequest) (*Librar
 		ScanOnStartup:      req.ScanOnStartup,
 		RealtimeMonitoring: req.RealtimeMonitoring,
 		MetadataProvider:   req.MetadataProvider,
+		MetadataPolicy:     req.MetadataPolicy,
 		PreferredLanguage:  req.PreferredLanguage,
 		ScannerConfig:      req.ScannerConfig,
 	}
@@ -166,6 +174,11 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, update *LibraryUpdat
 	if update.Type != nil && !IsValidLibraryType(*update.Type) {
 		return nil, ErrInvalidLibraryType
 	}
+	if update.MetadataPolicy != nil {
+		if err := update.MetadataPolicy.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	// Check if name is being changed to an existing name
 	if update.Name != nil {
@@ -205,6 +218,40 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, update *LibraryUpdat
 	return lib, nil
 }
 
+// MetadataContext returns ctx carrying the metadata provider policy of the
+// library, so metadata lookups follow its provider chains. ctx is returned
+// unchanged if the library has no policy or cannot be loaded.
+func (s *Service) MetadataContext(ctx context.Context, libraryID uuid.UUID) context.Context {
+	lib, err := s.repo.Get(ctx, libraryID)
+	if err != nil {
+		return ctx
+	}
+	return withLibraryPolicy(ctx, lib)
+}
+
+// MetadataContextForPath is like MetadataContext for the library of the
+// given type whose paths contain path.
+func (s *Service) MetadataContextForPath(ctx context.Context, libType, path string) context.Context {
+	libs, err := s.repo.ListByType(ctx, libType)
+	if err != nil {
+		return ctx
+	}
+	for i := range libs {
+		if libs[i].HasPath(path) {
+			return withLibraryPolicy(ctx, &libs[i])
+		}
+	}
+	return ctx
+}
+
+func withLibraryPolicy(ctx context.Context, lib *Library) context.Context {
+	policy := lib.ProviderPolicy()
+	if policy.IsZero() {
+		return ctx
+	}
+	return metadata.WithProviderPolicy(ctx, policy)
+}
+
 // Delete deletes a library.
 func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
 	// Get library for logging
@@ -408,6 +455,25 @@ func (s *Service) CancelScan(ctx context.Context, scanID uuid.UUID) (*LibrarySca
 	})
 }
 
+// CancelActiveScan marks a pending or running scan cancelled. It returns
+// ErrScanNotActive with the scan if the scan has already finished.
+func (s *Service) CancelActiveScan(ctx context.Context, scanID uuid.UUID) (*LibraryScan, error) {
+	scan, err := s.repo.GetScan(ctx, scanID)
+	if err != nil {
+		return nil, err
+	}
+	if !scan.IsActive() {
+		return scan, ErrScanNotActive
+	}
+	return s.CancelScan(ctx, scanID)
+}
+
+// AttachScanJob records the River job performing a scan so the scan can
+// be cancelled later.
+func (s *Service) AttachScanJob(ctx context.Context, scanID uuid.UUID, jobID int64) (*LibraryScan, error) {
+	return s.repo.SetScanJobID(ctx, scanID, jobID)
+}
+
 // UpdateScanProgress updates scan progress.
 func (s *Service) UpdateScanProgress(ctx context.Context, scanID uuid.UUID, progress *ScanProgress) (*LibraryScan, error) {
 	return s.repo.UpdateScanProgress(ctx, scanID, progress)
@@ -635,6 +635,61 @@ func TestLibraryService_CancelScan_Short(t *testing.T) {
 	})
 }
 
+func TestLibraryService_CancelActiveScan_Short(t *testing.T) {
+	if testing.Short() {
+		t.Log("Running short test")
+	}
+
+	t.Run("running scan", func(t *testing.T) {
+		mockRepo := NewMockLibraryRepository(t)
+		svc := setupLibraryService(mockRepo)
+
+		scanID := uuid.Must(uuid.NewV7())
+		libID := uuid.Must(uuid.NewV7())
+
+		running := makeTestScan(scanID, libID, library.ScanTypeFull, library.ScanStatusRunning)
+		cancelled := makeTestScan(scanID, libID, library.ScanTypeFull, library.ScanStatusCancelled)
+
+		mockRepo.On("GetScan", mock.Anything, scanID).Return(running, nil)
+		mockRepo.On("UpdateScanStatus", mock.Anything, scanID, mock.MatchedBy(func(u *library.ScanStatusUpdate) bool {
+			return u.Status == library.ScanStatusCancelled && u.CompletedAt != nil
+		})).Return(cancelled, nil)
+
+		scan, err := svc.CancelActiveScan(context.Background(), scanID)
+
+		require.NoError(t, err)
+		assert.Equal(t, library.ScanStatusCancelled, scan.Status)
+	})
+
+	t.Run("finished scan", func(t *testing.T) {
+		mockRepo := NewMockLibraryRepository(t)
+		svc := setupLibraryService(mockRepo)
+
+		scanID := uuid.Must(uuid.NewV7())
+		libID := uuid.Must(uuid.NewV7())
+
+		completed := makeTestScan(scanID, libID, library.ScanTypeFull, library.ScanStatusCompleted)
+		mockRepo.On("GetScan", mock.Anything, scanID).Return(completed, nil)
+
+		scan, err := svc.CancelActiveScan(context.Background(), scanID)
+
+		assert.ErrorIs(t, err, library.ErrScanNotActive)
+		assert.Equal(t, library.ScanStatusCompleted, scan.Status)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := NewMockLibraryRepository(t)
+		svc := setupLibraryService(mockRepo)
+
+		scanID := uuid.Must(uuid.NewV7())
+		mockRepo.On("GetScan", mock.Anything, scanID).Return(nil, library.ErrScanNotFound)
+
+		_, err := svc.CancelActiveScan(context.Background(), scanID)
+
+		assert.ErrorIs(t, err, library.ErrScanNotFound)
+	})
+}
+
 func TestLibraryService_GrantPermission_Short(t *testing.T) {
 	if testing.Short() {
 		t.Log("Running short test")
@@ -58,7 +58,15 @@ func (a *Adapter) SearchMovies(ctx context.Context, query string, year *int) ([]
 
 // EnrichMovie enriches a movie with metadata from the shared service.
 func (a *Adapter) EnrichMovie(ctx context.Context, mov *contentmovie.Movie, opts ...contentmovie.MetadataRefreshOptions) error {
-	if mov.TMDbID == nil {
+	// TMDb resolves IMDb IDs on the movie endpoint, which lets manually
+	// identified files that only carry an IMDb ID be enriched too.
+	var providerID string
+	switch {
+	case mov.TMDbID != nil:
+		providerID = fmt.Sprintf("%d", *mov.TMDbID)
+	case mov.IMDbID != nil && *mov.IMDbID != "":
+		providerID = *mov.IMDbID
+	default:
 		return fmt.Errorf("movie has no TMDb ID")
 	}
 
@@ -72,8 +80,10 @@ func (a *Adapter) EnrichMovie(ctx context.Context, mov *contentmovie.Movie, opts
 			a.service.ClearCache()
 		}
 	}
+	languages = a.service.RefreshLanguages(ctx, languages)
 
-	meta, err := a.service.GetMovieMetadata(ctx, fmt.Sprintf("%d", *mov.TMDbID), languages)
+	ctx = metadata.WithProviderIDs(ctx, movieProviderIDs(mov, providerID))
+	meta, err := a.service.GetMovieMetadata(ctx, providerID, languages)
 	if err != nil {
 		return fmt.Errorf("get movie metadata: %w", err)
 	}
@@ -83,7 +93,10 @@ func (a *Adapter) EnrichMovie(ctx context.Context, mov *contentmovie.Movie, opts
 	a.service.EnrichMovieRatings(ctx, meta)
 
 	// Get release dates for age ratings
-	releaseDates, err := a.service.GetMovieReleaseDates(ctx, fmt.Sprintf("%d", *mov.TMDbID))
+	if meta.TMDbID != nil {
+		providerID = fmt.Sprintf("%d", *meta.TMDbID)
+	}
+	releaseDates, err := a.service.GetMovieReleaseDates(ctx, providerID)
 	if err != nil {
 		// Continue without release dates
 		releaseDates = nil
@@ -97,7 +110,7 @@ func (a *Adapter) EnrichMovie(ctx context.Context, mov *contentmovie.Movie, opts
 
 // GetMovieCredits retrieves movie credits using the shared service.
 func (a *Adapter) GetMovieCredits(ctx context.Context, movieID uuid.UUID, providerID string) ([]contentmovie.MovieCredit, error) {
-	credits, err := a.service.GetMovieCredits(ctx, providerID)
+	credits, err := a.service.GetMovieCredits(withTMDbID(ctx, providerID), providerID)
 	if err != nil {
 		return nil, fmt.Errorf("get movie credits: %w", err)
 	}
@@ -107,7 +120,7 @@ func (a *Adapter) GetMovieCredits(ctx context.Context, movieID uuid.UUID, provid
 
 // GetMovieGenres retrieves movie genres using the shared service.
 func (a *Adapter) GetMovieGenres(ctx context.Context, movieID uuid.UUID, providerID string) ([]contentmovie.MovieGenre, error) {
-	meta, err := a.service.GetMovieMetadata(ctx, providerID, []string{a.languages[0]})
+	meta, err := a.service.GetMovieMetadata(withTMDbID(ctx, providerID), providerID, []string{a.languages[0]})
 	if err != nil {
 		return nil, fmt.Errorf("get movie metadata for genres: %w", err)
 	}
@@ -125,6 +138,31 @@ func (a *Adapter) GetMovieGenres(ctx context.Context, movieID uuid.UUID, provide
 	return genres, nil
 }
 
+// GetMovieTags retrieves keywords and tags for a movie, keeping spoiler flags.
+func (a *Adapter) GetMovieTags(ctx context.Context, movieID uuid.UUID, providerID string) ([]contentmovie.MovieTag, error) {
+	meta, err := a.service.GetMovieMetadata(withTMDbID(ctx, providerID), providerID, []string{a.languages[0]})
+	if err != nil {
+		return nil, fmt.Errorf("get movie metadata for tags: %w", err)
+	}
+
+	tags := make([]contentmovie.MovieTag, 0, len(meta.Tags))
+	for _, t := range meta.Tags {
+		slug := util.Slugify(t.Name)
+		if slug == "" {
+			continue
+		}
+		tags = append(tags, contentmovie.MovieTag{
+			ID:      uuid.Must(uuid.NewV7()),
+			MovieID: movieID,
+			Slug:    slug,
+			Name:    t.Name,
+			Spoiler: t.Spoiler,
+		})
+	}
+
+	return tags, nil
+}
+
 // GetMovieByTMDbID retrieves a movie by TMDb ID.
 func (a *Adapter) GetMovieByTMDbID(ctx context.Context, tmdbID int) (*contentmovie.Movie, error) {
 	meta, err := a.service.GetMovieMetadata(ctx, strconv.Itoa(tmdbID), a.languages)
@@ -190,6 +228,41 @@ func mapSearchResultToMovie(r *metadata.MovieSearchResult) *contentmovie.Movie {
 	return mov
 }
 
+// movieProviderIDs collects the movie's IDs per metadata provider. TMDb is
+// queried with tmdbID, which may be an IMDb ID TMDb resolves itself.
+func movieProviderIDs(mov *contentmovie.Movie, tmdbID string) map[metadata.ProviderID]string {
+	ids := make(map[metadata.ProviderID]string, len(mov.ProviderIDs)+2)
+	for p, id := range mov.ProviderIDs {
+		ids[metadata.ProviderID(p)] = id
+	}
+	ids[metadata.ProviderTMDb] = tmdbID
+	if mov.IMDbID != nil && *mov.IMDbID != "" {
+		ids[metadata.ProviderOMDb] = *mov.IMDbID
+	}
+	return ids
+}
+
+// withTMDbID attaches a TMDb ID to ctx unless the caller already set provider
+// IDs, so provider chains skip providers the ID does not belong to.
+func withTMDbID(ctx context.Context, tmdbID string) context.Context {
+	if _, ok := metadata.ProviderIDsFromContext(ctx); ok {
+		return ctx
+	}
+	return metadata.WithProviderIDs(ctx, map[metadata.ProviderID]string{metadata.ProviderTMDb: tmdbID})
+}
+
+// providerIDStrings converts provider IDs to a plain string map for storage.
+func providerIDStrings(ids map[metadata.ProviderID]string) map[string]string {
+	if len(ids) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(ids))
+	for p, id := range ids {
+		out[string(p)] = id
+	}
+	return out
+}
+
 // mapMetadataToMovie maps shared metadata to movie domain type.
 func mapMetadataToMovie(mov *contentmovie.Movie, meta *metadata.MovieMetadata, releaseDates []metadata.ReleaseDate) {
 	mov.Title = meta.Title
@@ -204,8 +277,14 @@ func mapMetadataToMovie(mov *contentmovie.Movie, meta *metadata.MovieMetadata, r
 	mov.Revenue = meta.Revenue
 	mov.PosterPath = meta.PosterPath
 	mov.BackdropPath = meta.BackdropPath
-	mov.IMDbID = meta.IMDbID
-	mov.TMDbID = meta.TMDbID
+	if meta.IMDbID != nil {
+		mov.IMDbID = meta.IMDbID
+	}
+	if meta.TMDbID != nil {
+		mov.TMDbID = meta.TMDbID
+	}
+	mov.MetadataSources = meta.Sources.Strings()
+	mov.ProviderIDs = providerIDStrings(meta.ResolvedIDs)
 
 	// Extract year from release date
 	if meta.ReleaseDate != nil {
@@ -304,13 +383,14 @@ func mapCreditsToMovieCredits(movieID uuid.UUID, credits *metadata.Credits) []co
 
 	// Map cast
 	for _, c := range credits.Cast {
-		var personID int32
-		_, _ = fmt.Sscanf(c.ProviderID, "%d", &personID)
+		tmdbID, tvdbID, imdbID := personIDs(c.ExternalIDs)
 
 		credit := contentmovie.MovieCredit{
 			ID:           uuid.Must(uuid.NewV7()),
 			MovieID:      movieID,
-			TMDbPersonID: personID,
+			TMDbPersonID: tmdbID,
+			TVDbPersonID: tvdbID,
+			IMDbPersonID: imdbID,
 			Name:         c.Name,
 			Character:    ptrString(c.Character),
 			CreditType:   "cast",
@@ -322,13 +402,14 @@ func mapCreditsToMovieCredits(movieID uuid.UUID, credits *metadata.Credits) []co
 
 	// Map crew
 	for _, c := range credits.Crew {
-		var personID int32
-		_, _ = fmt.Sscanf(c.ProviderID, "%d", &personID)
+		tmdbID, tvdbID, imdbID := personIDs(c.ExternalIDs)
 
 		credit := contentmovie.MovieCredit{
 			ID:           uuid.Must(uuid.NewV7()),
 			MovieID:      movieID,
-			TMDbPersonID: personID,
+			TMDbPersonID: tmdbID,
+			TVDbPersonID: tvdbID,
+			IMDbPersonID: imdbID,
 			Name:         c.Name,
 			Job:          ptrString(c.Job),
 			Department:   ptrString(c.Department),
@@ -343,6 +424,19 @@ func mapCreditsToMovieCredits(movieID uuid.UUID, credits *metadata.Credits) []co
 
 // Helper functions
 
+// personIDs returns the TMDb, TVDb and IMDb IDs of a credited person. The
+// TMDb ID is 0 when unknown, as credits always store one.
+func personIDs(ids *metadata.ExternalIDs) (int32, *int32, *string) {
+	if ids == nil {
+		return 0, nil, nil
+	}
+	var tmdbID int32
+	if ids.TMDbID != nil {
+		tmdbID = *ids.TMDbID
+	}
+	return tmdbID, ids.TVDbID, ids.IMDbID
+}
+
 func ptrString(s string) *string {
 	if s == "" {
 		return nil
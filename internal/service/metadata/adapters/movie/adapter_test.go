@@ -228,10 +228,10 @@ func TestMapCreditsToMovieCredits(t *testing.T) {
 	t.Run("cast and crew", func(t *testing.T) {
 		credits := &metadata.Credits{
 			Cast: []metadata.CastMember{
-				{ProviderID: "6193", Name: "Leonardo DiCaprio", Character: "Cobb", Order: 0, ProfilePath: new("https://example.com/leo.jpg")},
+				{ProviderID: "6193", ExternalIDs: &metadata.ExternalIDs{TMDbID: new(int32(6193)), IMDbID: new("nm0000138")}, Name: "Leonardo DiCaprio", Character: "Cobb", Order: 0, ProfilePath: new("https://example.com/leo.jpg")},
 			},
 			Crew: []metadata.CrewMember{
-				{ProviderID: "525", Name: "Christopher Nolan", Job: "Director", Department: "Directing", ProfilePath: new("https://example.com/cn.jpg")},
+				{ProviderID: "525", ExternalIDs: &metadata.ExternalIDs{TMDbID: new(int32(525))}, Name: "Christopher Nolan", Job: "Director", Department: "Directing", ProfilePath: new("https://example.com/cn.jpg")},
 			},
 		}
 
@@ -240,6 +240,9 @@ func TestMapCreditsToMovieCredits(t *testing.T) {
 
 		assert.Equal(t, movieID, result[0].MovieID)
 		assert.Equal(t, int32(6193), result[0].TMDbPersonID)
+		require.NotNil(t, result[0].IMDbPersonID)
+		assert.Equal(t, "nm0000138", *result[0].IMDbPersonID)
+		assert.Nil(t, result[0].TVDbPersonID)
 		assert.Equal(t, "Leonardo DiCaprio", result[0].Name)
 		assert.Equal(t, "cast", result[0].CreditType)
 		require.NotNil(t, result[0].Character)
@@ -259,12 +262,19 @@ func TestMapCreditsToMovieCredits(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
-	t.Run("non-numeric provider ID", func(t *testing.T) {
+	t.Run("person without TMDb ID", func(t *testing.T) {
 		credits := &metadata.Credits{
-			Cast: []metadata.CastMember{{ProviderID: "not-a-number", Name: "Test"}},
+			Cast: []metadata.CastMember{
+				{ProviderID: "not-a-number", Name: "Test"},
+				{ProviderID: "312", ExternalIDs: &metadata.ExternalIDs{TVDbID: new(int32(7845))}, Name: "TVDb Person"},
+			},
 		}
 		result := mapCreditsToMovieCredits(movieID, credits)
-		require.Len(t, result, 1)
+		require.Len(t, result, 2)
 		assert.Equal(t, int32(0), result[0].TMDbPersonID)
+		assert.Nil(t, result[0].TVDbPersonID)
+		assert.Equal(t, int32(0), result[1].TMDbPersonID, "the provider ID is not a TMDb ID")
+		require.NotNil(t, result[1].TVDbPersonID)
+		assert.Equal(t, int32(7845), *result[1].TVDbPersonID)
 	})
 }
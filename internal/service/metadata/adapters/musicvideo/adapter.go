@@ -0,0 +1,110 @@
+// Package musicvideo provides an adapter that bridges the shared metadata
+// service to the music video content module.
+package musicvideo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	contentmusicvideo "github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// Adapter wraps the shared metadata service for music video matching.
+// This adapter implements the musicvideo.MetadataProvider interface using the shared service.
+type Adapter struct {
+	service metadata.Service
+}
+
+// NewAdapter creates a new adapter that uses the shared metadata service.
+func NewAdapter(service metadata.Service) *Adapter {
+	return &Adapter{service: service}
+}
+
+// Ensure Adapter implements MetadataProvider.
+var _ contentmusicvideo.MetadataProvider = (*Adapter)(nil)
+
+// SearchRecordings searches recordings by artist and title. No results is
+// not an error.
+func (a *Adapter) SearchRecordings(ctx context.Context, artist, title string) ([]contentmusicvideo.Recording, error) {
+	results, err := a.service.SearchRecording(ctx, artist, title, metadata.SearchOptions{})
+	if err != nil {
+		switch {
+		case errors.Is(err, metadata.ErrNotFound):
+			return nil, nil
+		case errors.Is(err, metadata.ErrNoProviders):
+			return nil, contentmusicvideo.ErrMatchingUnavailable
+		}
+		return nil, fmt.Errorf("search recordings: %w", err)
+	}
+
+	recordings := make([]contentmusicvideo.Recording, 0, len(results))
+	for i := range results {
+		if rec, ok := mapRecording(&results[i]); ok {
+			recordings = append(recordings, rec)
+		}
+	}
+	return recordings, nil
+}
+
+// GetRecording looks up a recording by its MusicBrainz ID. It returns nil
+// when the recording does not exist.
+func (a *Adapter) GetRecording(ctx context.Context, id uuid.UUID) (*contentmusicvideo.Recording, error) {
+	result, err := a.service.GetRecording(ctx, id.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, metadata.ErrNotFound):
+			return nil, nil
+		case errors.Is(err, metadata.ErrNoProviders):
+			return nil, contentmusicvideo.ErrMatchingUnavailable
+		}
+		return nil, fmt.Errorf("get recording: %w", err)
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	rec, ok := mapRecording(result)
+	if !ok {
+		return nil, nil
+	}
+	return &rec, nil
+}
+
+// mapRecording converts a search result. Results without a valid
+// MusicBrainz ID are skipped, as are credited artists without one.
+func mapRecording(r *metadata.RecordingSearchResult) (contentmusicvideo.Recording, bool) {
+	id, err := uuid.Parse(r.ProviderID)
+	if err != nil {
+		return contentmusicvideo.Recording{}, false
+	}
+
+	rec := contentmusicvideo.Recording{
+		MusicBrainzID: id,
+		Title:         r.Title,
+		ArtistCredit:  r.ArtistCredit,
+		Length:        r.Length,
+		Video:         r.Video,
+		Score:         r.Score,
+	}
+	if r.ReleaseDate != nil {
+		year := r.ReleaseDate.Year()
+		rec.Year = &year
+	}
+	for _, artist := range r.Artists {
+		artistID, err := uuid.Parse(artist.ProviderID)
+		if err != nil {
+			continue
+		}
+		rec.Artists = append(rec.Artists, contentmusicvideo.RecordingArtist{
+			MusicBrainzID:  artistID,
+			Name:           artist.Name,
+			SortName:       artist.SortName,
+			Disambiguation: artist.Disambiguation,
+		})
+	}
+	return rec, true
+}
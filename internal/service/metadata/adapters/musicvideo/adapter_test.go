@@ -0,0 +1,122 @@
+package musicvideo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contentmusicvideo "github.com/lusoris/revenge/internal/content/musicvideo"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// stubService answers recording lookups; other methods are not called.
+type stubService struct {
+	metadata.Service
+
+	results []metadata.RecordingSearchResult
+	err     error
+}
+
+func (s *stubService) SearchRecording(_ context.Context, _, _ string, _ metadata.SearchOptions) ([]metadata.RecordingSearchResult, error) {
+	return s.results, s.err
+}
+
+func (s *stubService) GetRecording(_ context.Context, id string) (*metadata.RecordingSearchResult, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for i := range s.results {
+		if s.results[i].ProviderID == id {
+			return &s.results[i], nil
+		}
+	}
+	return nil, metadata.ErrNotFound
+}
+
+const (
+	takeOnMeID = "b1a9c0e9-d987-4042-ae91-78d6a3267d69"
+	ahaID      = "7364dea6-ca9a-48e3-be01-b44ad0d19897"
+)
+
+func takeOnMe() metadata.RecordingSearchResult {
+	released := time.Date(1985, 4, 1, 0, 0, 0, 0, time.UTC)
+	return metadata.RecordingSearchResult{
+		ProviderID:   takeOnMeID,
+		Provider:     metadata.ProviderMusicBrainz,
+		Title:        "Take On Me",
+		ArtistCredit: "a-ha",
+		Artists: []metadata.RecordingArtist{
+			{ProviderID: ahaID, Name: "a-ha", SortName: "a-ha", Disambiguation: "Norwegian band"},
+			{ProviderID: "not-a-uuid", Name: "Nobody"},
+		},
+		Length:      225 * time.Second,
+		ReleaseDate: &released,
+		Video:       true,
+		Score:       1,
+	}
+}
+
+func TestAdapter_SearchRecordings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("maps results", func(t *testing.T) {
+		adapter := NewAdapter(&stubService{results: []metadata.RecordingSearchResult{
+			takeOnMe(),
+			{ProviderID: "bogus", Title: "Skipped"},
+		}})
+
+		recordings, err := adapter.SearchRecordings(ctx, "a-ha", "Take On Me")
+		require.NoError(t, err)
+		require.Len(t, recordings, 1)
+
+		rec := recordings[0]
+		assert.Equal(t, uuid.MustParse(takeOnMeID), rec.MusicBrainzID)
+		assert.Equal(t, "Take On Me", rec.Title)
+		assert.Equal(t, 225*time.Second, rec.Length)
+		assert.True(t, rec.Video)
+		require.NotNil(t, rec.Year)
+		assert.Equal(t, 1985, *rec.Year)
+		require.Len(t, rec.Artists, 1)
+		assert.Equal(t, uuid.MustParse(ahaID), rec.Artists[0].MusicBrainzID)
+		assert.Equal(t, "Norwegian band", rec.Artists[0].Disambiguation)
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		recordings, err := NewAdapter(&stubService{err: metadata.ErrNotFound}).SearchRecordings(ctx, "a-ha", "Nope")
+		require.NoError(t, err)
+		assert.Empty(t, recordings)
+	})
+
+	t.Run("no provider", func(t *testing.T) {
+		_, err := NewAdapter(&stubService{err: metadata.ErrNoProviders}).SearchRecordings(ctx, "a-ha", "Take On Me")
+		assert.ErrorIs(t, err, contentmusicvideo.ErrMatchingUnavailable)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		boom := errors.New("boom")
+		_, err := NewAdapter(&stubService{err: boom}).SearchRecordings(ctx, "a-ha", "Take On Me")
+		assert.ErrorIs(t, err, boom)
+	})
+}
+
+func TestAdapter_GetRecording(t *testing.T) {
+	ctx := context.Background()
+	adapter := NewAdapter(&stubService{results: []metadata.RecordingSearchResult{takeOnMe()}})
+
+	rec, err := adapter.GetRecording(ctx, uuid.MustParse(takeOnMeID))
+	require.NoError(t, err)
+	require.NotNil(t, rec)
+	assert.Equal(t, "a-ha", rec.ArtistCredit)
+
+	rec, err = adapter.GetRecording(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+
+	_, err = NewAdapter(&stubService{err: metadata.ErrNoProviders}).GetRecording(ctx, uuid.New())
+	assert.ErrorIs(t, err, contentmusicvideo.ErrMatchingUnavailable)
+}
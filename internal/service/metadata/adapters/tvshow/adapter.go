@@ -57,8 +57,18 @@ func (a *Adapter) SearchSeries(ctx context.Context, query string, year *int) ([]
 
 // EnrichSeries enriches a series with metadata from the shared service.
 func (a *Adapter) EnrichSeries(ctx context.Context, series *contenttvshow.Series, opts ...contenttvshow.MetadataRefreshOptions) error {
-	if series.TMDbID == nil {
-		return fmt.Errorf("series has no TMDb ID")
+	ids := seriesProviderIDs(series.AllProviderIDs())
+	if series.IMDbID != nil && *series.IMDbID != "" {
+		ids[metadata.ProviderOMDb] = *series.IMDbID
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("series has no provider ID")
+	}
+	// Series known only to other providers, e.g. TVDb, are looked up by the
+	// provider IDs attached to the context.
+	var tmdbID string
+	if series.TMDbID != nil {
+		tmdbID = fmt.Sprintf("%d", *series.TMDbID)
 	}
 
 	// Determine languages and force from options
@@ -71,8 +81,10 @@ func (a *Adapter) EnrichSeries(ctx context.Context, series *contenttvshow.Series
 			a.service.ClearCache()
 		}
 	}
+	languages = a.service.RefreshLanguages(ctx, languages)
 
-	meta, err := a.service.GetTVShowMetadata(ctx, fmt.Sprintf("%d", *series.TMDbID), languages)
+	ctx = metadata.WithProviderIDs(ctx, ids)
+	meta, err := a.service.GetTVShowMetadata(ctx, tmdbID, languages)
 	if err != nil {
 		return fmt.Errorf("get series metadata: %w", err)
 	}
@@ -82,10 +94,13 @@ func (a *Adapter) EnrichSeries(ctx context.Context, series *contenttvshow.Series
 	a.service.EnrichTVShowRatings(ctx, meta)
 
 	// Get content ratings for age ratings
-	contentRatings, err := a.service.GetTVShowContentRatings(ctx, fmt.Sprintf("%d", *series.TMDbID))
-	if err != nil {
-		// Continue without content ratings
-		contentRatings = nil
+	var contentRatings []metadata.ContentRating
+	if tmdbID != "" {
+		contentRatings, err = a.service.GetTVShowContentRatings(ctx, tmdbID)
+		if err != nil {
+			// Continue without content ratings
+			contentRatings = nil
+		}
 	}
 
 	// Map to series domain type
@@ -106,7 +121,9 @@ func (a *Adapter) EnrichSeason(ctx context.Context, season *contenttvshow.Season
 			a.service.ClearCache()
 		}
 	}
+	languages = a.service.RefreshLanguages(ctx, languages)
 
+	ctx = metadata.WithProviderIDs(ctx, seriesProviderIDs(seriesIDs(seriesProviderID, opts)))
 	meta, err := a.service.GetSeasonMetadata(ctx, seriesProviderID, int(season.SeasonNumber), languages)
 	if err != nil {
 		return fmt.Errorf("get season metadata: %w", err)
@@ -128,7 +145,9 @@ func (a *Adapter) EnrichEpisode(ctx context.Context, episode *contenttvshow.Epis
 			a.service.ClearCache()
 		}
 	}
+	languages = a.service.RefreshLanguages(ctx, languages)
 
+	ctx = metadata.WithProviderIDs(ctx, seriesProviderIDs(seriesIDs(seriesProviderID, opts)))
 	meta, err := a.service.GetEpisodeMetadata(ctx, seriesProviderID, int(episode.SeasonNumber), int(episode.EpisodeNumber), languages)
 	if err != nil {
 		return fmt.Errorf("get episode metadata: %w", err)
@@ -140,7 +159,7 @@ func (a *Adapter) EnrichEpisode(ctx context.Context, episode *contenttvshow.Epis
 
 // GetSeriesCredits retrieves series credits using the shared service.
 func (a *Adapter) GetSeriesCredits(ctx context.Context, seriesID uuid.UUID, providerID string) ([]contenttvshow.SeriesCredit, error) {
-	credits, err := a.service.GetTVShowCredits(ctx, providerID)
+	credits, err := a.service.GetTVShowCredits(withTMDbID(ctx, providerID), providerID)
 	if err != nil {
 		return nil, fmt.Errorf("get series credits: %w", err)
 	}
@@ -150,7 +169,7 @@ func (a *Adapter) GetSeriesCredits(ctx context.Context, seriesID uuid.UUID, prov
 
 // GetSeriesGenres retrieves series genres using the shared service.
 func (a *Adapter) GetSeriesGenres(ctx context.Context, seriesID uuid.UUID, providerID string) ([]contenttvshow.SeriesGenre, error) {
-	meta, err := a.service.GetTVShowMetadata(ctx, providerID, []string{a.languages[0]})
+	meta, err := a.service.GetTVShowMetadata(withTMDbID(ctx, providerID), providerID, []string{a.languages[0]})
 	if err != nil {
 		return nil, fmt.Errorf("get series metadata for genres: %w", err)
 	}
@@ -168,9 +187,34 @@ func (a *Adapter) GetSeriesGenres(ctx context.Context, seriesID uuid.UUID, provi
 	return genres, nil
 }
 
+// GetSeriesTags retrieves series keywords and tags, keeping spoiler flags.
+func (a *Adapter) GetSeriesTags(ctx context.Context, seriesID uuid.UUID, providerID string) ([]contenttvshow.SeriesTag, error) {
+	meta, err := a.service.GetTVShowMetadata(withTMDbID(ctx, providerID), providerID, []string{a.languages[0]})
+	if err != nil {
+		return nil, fmt.Errorf("get series metadata for tags: %w", err)
+	}
+
+	tags := make([]contenttvshow.SeriesTag, 0, len(meta.Tags))
+	for _, t := range meta.Tags {
+		slug := util.Slugify(t.Name)
+		if slug == "" {
+			continue
+		}
+		tags = append(tags, contenttvshow.SeriesTag{
+			ID:       uuid.Must(uuid.NewV7()),
+			SeriesID: seriesID,
+			Slug:     slug,
+			Name:     t.Name,
+			Spoiler:  t.Spoiler,
+		})
+	}
+
+	return tags, nil
+}
+
 // GetSeriesNetworks retrieves series networks using the shared service.
 func (a *Adapter) GetSeriesNetworks(ctx context.Context, providerID string) ([]contenttvshow.Network, error) {
-	meta, err := a.service.GetTVShowMetadata(ctx, providerID, []string{a.languages[0]})
+	meta, err := a.service.GetTVShowMetadata(withTMDbID(ctx, providerID), providerID, []string{a.languages[0]})
 	if err != nil {
 		return nil, fmt.Errorf("get series metadata for networks: %w", err)
 	}
@@ -195,6 +239,45 @@ func (a *Adapter) ClearCache() {
 	a.service.ClearCache()
 }
 
+// seriesIDs returns the series' provider IDs for a season or episode
+// refresh. Callers without them only know the series' TMDb ID.
+func seriesIDs(tmdbID string, opts []contenttvshow.MetadataRefreshOptions) map[string]string {
+	if len(opts) > 0 && len(opts[0].SeriesProviderIDs) > 0 {
+		return opts[0].SeriesProviderIDs
+	}
+	return map[string]string{string(metadata.ProviderTMDb): tmdbID}
+}
+
+// seriesProviderIDs converts stored provider IDs to the service's form.
+func seriesProviderIDs(ids map[string]string) map[metadata.ProviderID]string {
+	out := make(map[metadata.ProviderID]string, len(ids))
+	for p, id := range ids {
+		out[metadata.ProviderID(p)] = id
+	}
+	return out
+}
+
+// withTMDbID attaches a TMDb ID to ctx unless the caller already set provider
+// IDs, so provider chains skip providers the ID does not belong to.
+func withTMDbID(ctx context.Context, tmdbID string) context.Context {
+	if _, ok := metadata.ProviderIDsFromContext(ctx); ok {
+		return ctx
+	}
+	return metadata.WithProviderIDs(ctx, map[metadata.ProviderID]string{metadata.ProviderTMDb: tmdbID})
+}
+
+// providerIDStrings converts provider IDs to a plain string map for storage.
+func providerIDStrings(ids map[metadata.ProviderID]string) map[string]string {
+	if len(ids) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(ids))
+	for p, id := range ids {
+		out[string(p)] = id
+	}
+	return out
+}
+
 // mapSearchResultToSeries converts a search result to a series domain type.
 func mapSearchResultToSeries(r *metadata.TVShowSearchResult) *contenttvshow.Series {
 	series := &contenttvshow.Series{
@@ -248,9 +331,17 @@ func mapMetadataToSeries(series *contenttvshow.Series, meta *metadata.TVShowMeta
 	series.BackdropPath = meta.BackdropPath
 	series.Homepage = meta.Homepage
 	series.TrailerURL = meta.TrailerURL
-	series.IMDbID = meta.IMDbID
-	series.TMDbID = meta.TMDbID
-	series.TVDbID = meta.TVDbID
+	if meta.IMDbID != nil {
+		series.IMDbID = meta.IMDbID
+	}
+	if meta.TMDbID != nil {
+		series.TMDbID = meta.TMDbID
+	}
+	if meta.TVDbID != nil {
+		series.TVDbID = meta.TVDbID
+	}
+	series.MetadataSources = meta.Sources.Strings()
+	series.ProviderIDs = providerIDStrings(meta.ResolvedIDs)
 
 	// Map ratings
 	if meta.VoteAverage > 0 {
@@ -321,6 +412,7 @@ func mapSeasonMetadataToSeason(season *contenttvshow.Season, meta *metadata.Seas
 	season.AirDate = meta.AirDate
 	season.EpisodeCount = util.SafeIntToInt32(len(meta.Episodes))
 	season.TMDbID = meta.TMDbID
+	season.MetadataSources = meta.Sources.Strings()
 
 	if meta.VoteAverage > 0 {
 		va, _ := decimal.NewFromFloat64(meta.VoteAverage)
@@ -353,6 +445,7 @@ func mapEpisodeMetadataToEpisode(episode *contenttvshow.Episode, meta *metadata.
 	episode.ProductionCode = meta.ProductionCode
 	episode.TMDbID = meta.TMDbID
 	episode.IMDbID = meta.IMDbID
+	episode.MetadataSources = meta.Sources.Strings()
 
 	if meta.VoteAverage > 0 {
 		va, _ := decimal.NewFromFloat64(meta.VoteAverage)
@@ -411,13 +504,14 @@ func mapCreditsToSeriesCredits(seriesID uuid.UUID, credits *metadata.Credits) []
 
 	// Map cast
 	for _, c := range credits.Cast {
-		var personID int32
-		_, _ = fmt.Sscanf(c.ProviderID, "%d", &personID)
+		tmdbID, tvdbID, imdbID := personIDs(c.ExternalIDs)
 
 		credit := contenttvshow.SeriesCredit{
 			ID:           uuid.Must(uuid.NewV7()),
 			SeriesID:     seriesID,
-			TMDbPersonID: personID,
+			TMDbPersonID: tmdbID,
+			TVDbPersonID: tvdbID,
+			IMDbPersonID: imdbID,
 			Name:         c.Name,
 			Character:    ptrString(c.Character),
 			CreditType:   "cast",
@@ -429,13 +523,14 @@ func mapCreditsToSeriesCredits(seriesID uuid.UUID, credits *metadata.Credits) []
 
 	// Map crew
 	for _, c := range credits.Crew {
-		var personID int32
-		_, _ = fmt.Sscanf(c.ProviderID, "%d", &personID)
+		tmdbID, tvdbID, imdbID := personIDs(c.ExternalIDs)
 
 		credit := contenttvshow.SeriesCredit{
 			ID:           uuid.Must(uuid.NewV7()),
 			SeriesID:     seriesID,
-			TMDbPersonID: personID,
+			TMDbPersonID: tmdbID,
+			TVDbPersonID: tvdbID,
+			IMDbPersonID: imdbID,
 			Name:         c.Name,
 			Job:          ptrString(c.Job),
 			Department:   ptrString(c.Department),
@@ -450,6 +545,19 @@ func mapCreditsToSeriesCredits(seriesID uuid.UUID, credits *metadata.Credits) []
 
 // Helper functions
 
+// personIDs returns the TMDb, TVDb and IMDb IDs of a credited person. The
+// TMDb ID is 0 when unknown, as credits always store one.
+func personIDs(ids *metadata.ExternalIDs) (int32, *int32, *string) {
+	if ids == nil {
+		return 0, nil, nil
+	}
+	var tmdbID int32
+	if ids.TMDbID != nil {
+		tmdbID = *ids.TMDbID
+	}
+	return tmdbID, ids.TVDbID, ids.IMDbID
+}
+
 func ptrString(s string) *string {
 	if s == "" {
 		return nil
@@ -1,6 +1,8 @@
 package tvshow
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -299,10 +301,10 @@ func TestMapCreditsToSeriesCredits(t *testing.T) {
 	t.Run("cast and crew", func(t *testing.T) {
 		credits := &metadata.Credits{
 			Cast: []metadata.CastMember{
-				{ProviderID: "17419", Name: "Bryan Cranston", Character: "Walter White", Order: 0, ProfilePath: new("https://example.com/bc.jpg")},
+				{ProviderID: "17419", ExternalIDs: &metadata.ExternalIDs{TMDbID: new(int32(17419))}, Name: "Bryan Cranston", Character: "Walter White", Order: 0, ProfilePath: new("https://example.com/bc.jpg")},
 			},
 			Crew: []metadata.CrewMember{
-				{ProviderID: "66633", Name: "Vince Gilligan", Job: "Creator", Department: "Writing", ProfilePath: new("https://example.com/vg.jpg")},
+				{ProviderID: "66633", ExternalIDs: &metadata.ExternalIDs{TMDbID: new(int32(66633))}, Name: "Vince Gilligan", Job: "Creator", Department: "Writing", ProfilePath: new("https://example.com/vg.jpg")},
 			},
 		}
 
@@ -330,12 +332,57 @@ func TestMapCreditsToSeriesCredits(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
-	t.Run("non-numeric provider ID", func(t *testing.T) {
+	t.Run("person without TMDb ID", func(t *testing.T) {
 		credits := &metadata.Credits{
-			Cast: []metadata.CastMember{{ProviderID: "not-a-number", Name: "Test"}},
+			Cast: []metadata.CastMember{
+				{ProviderID: "not-a-number", Name: "Test"},
+				{ProviderID: "312", ExternalIDs: &metadata.ExternalIDs{TVDbID: new(int32(7845))}, Name: "TVDb Person"},
+			},
 		}
 		result := mapCreditsToSeriesCredits(seriesID, credits)
-		require.Len(t, result, 1)
+		require.Len(t, result, 2)
 		assert.Equal(t, int32(0), result[0].TMDbPersonID)
+		assert.Nil(t, result[0].TVDbPersonID)
+		assert.Equal(t, int32(0), result[1].TMDbPersonID, "the provider ID is not a TMDb ID")
+		require.NotNil(t, result[1].TVDbPersonID)
+		assert.Equal(t, int32(7845), *result[1].TVDbPersonID)
+	})
+}
+
+// stubMetadataService records the TV show lookup of EnrichSeries.
+type stubMetadataService struct {
+	metadata.Service
+	id  string
+	ids map[metadata.ProviderID]string
+}
+
+func (s *stubMetadataService) RefreshLanguages(_ context.Context, languages []string) []string {
+	return languages
+}
+
+func (s *stubMetadataService) GetTVShowMetadata(ctx context.Context, id string, _ []string) (*metadata.TVShowMetadata, error) {
+	s.id = id
+	s.ids, _ = metadata.ProviderIDsFromContext(ctx)
+	return nil, errors.New("not found")
+}
+
+func TestAdapter_EnrichSeries_ProviderIDs(t *testing.T) {
+	t.Run("TVDb only series is looked up by its TVDb ID", func(t *testing.T) {
+		svc := &stubMetadataService{}
+		adapter := NewAdapter(svc, []string{"en"})
+
+		err := adapter.EnrichSeries(context.Background(), &contenttvshow.Series{TVDbID: new(int32(81189))})
+		require.Error(t, err)
+		assert.Empty(t, svc.id)
+		assert.Equal(t, map[metadata.ProviderID]string{metadata.ProviderTVDb: "81189"}, svc.ids)
+	})
+
+	t.Run("series without provider IDs", func(t *testing.T) {
+		svc := &stubMetadataService{}
+		adapter := NewAdapter(svc, []string{"en"})
+
+		err := adapter.EnrichSeries(context.Background(), &contenttvshow.Series{})
+		require.Error(t, err)
+		assert.Nil(t, svc.ids)
 	})
 }
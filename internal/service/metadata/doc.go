@@ -34,11 +34,13 @@
 //
 //   - TMDb (primary): Movies, TV shows, people, images
 //   - TVDb: TV shows, episodes (better episode ordering)
-//   - More providers can be added (Fanart.tv, OMDb, MusicBrainz)
+//   - MusicBrainz: Music recordings and their artists (music video matching)
+//   - More providers can be added (Fanart.tv, OMDb)
 //
 // # Packages
 //
 //   - providers/tmdb: TMDb provider implementation
 //   - providers/tvdb: TVDb provider implementation
+//   - providers/musicbrainz: MusicBrainz provider implementation
 //   - jobs: River job workers for async metadata updates
 package metadata
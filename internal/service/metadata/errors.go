@@ -27,6 +27,9 @@ var (
 
 	// ErrUnsupported indicates the operation is not supported by any provider.
 	ErrUnsupported = errors.New("metadata: operation not supported")
+
+	// ErrInvalidPolicy indicates a provider policy references unknown fields or providers.
+	ErrInvalidPolicy = errors.New("metadata: invalid provider policy")
 )
 
 // ProviderError wraps an error from a specific provider.
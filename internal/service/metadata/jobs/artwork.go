@@ -0,0 +1,264 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// defaultArtworkLanguage is used when a sync job names no language.
+const defaultArtworkLanguage = "en"
+
+// artworkQueue enqueues downloads of selected artwork.
+type artworkQueue interface {
+	EnqueueCacheArtwork(ctx context.Context, art artwork.Artwork) error
+}
+
+// SyncArtworkWorker handles metadata_sync_artwork jobs. It records the
+// images every image provider offers for a movie or series as artwork
+// candidates, selects the preferred image of each kind, points the item's
+// poster and backdrop at the selection unless those fields are locked, and
+// queues the selected images for the local image cache.
+type SyncArtworkWorker struct {
+	river.WorkerDefaults[SyncArtworkArgs]
+	movies   movie.Service
+	tvshows  tvshow.Service
+	metadata metadata.Service
+	artwork  *artwork.Service
+	queue    artworkQueue
+	logger   *slog.Logger
+}
+
+// NewSyncArtworkWorker creates a new artwork sync worker.
+func NewSyncArtworkWorker(
+	movies movie.Service,
+	tvshows tvshow.Service,
+	metadataService metadata.Service,
+	artworkService *artwork.Service,
+	queue *Queue,
+	logger *slog.Logger,
+) *SyncArtworkWorker {
+	return &SyncArtworkWorker{
+		movies:   movies,
+		tvshows:  tvshows,
+		metadata: metadataService,
+		artwork:  artworkService,
+		queue:    queue,
+		logger:   logger.With("component", "metadata_sync_artwork"),
+	}
+}
+
+// Timeout returns the maximum execution time for artwork sync jobs.
+func (w *SyncArtworkWorker) Timeout(job *river.Job[SyncArtworkArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+// Work executes the artwork sync job.
+func (w *SyncArtworkWorker) Work(ctx context.Context, job *river.Job[SyncArtworkArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
+	args := job.Args
+	language := defaultArtworkLanguage
+	if len(args.Languages) > 0 && args.Languages[0] != "" {
+		language = args.Languages[0]
+	}
+
+	var selected []artwork.Artwork
+	var err error
+	switch args.ContentType {
+	case artwork.ContentTypeMovie:
+		selected, err = w.syncMovie(ctx, args.ContentID, language)
+	case artwork.ContentTypeSeries:
+		selected, err = w.syncSeries(ctx, args.ContentID, language)
+	default:
+		w.logger.Warn("unknown content type for artwork sync",
+			slog.String("content_type", args.ContentType),
+		)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("sync %s artwork: %w", args.ContentType, err)
+	}
+
+	w.cacheSelected(ctx, selected)
+
+	w.logger.Info("artwork synced",
+		slog.Int64("job_id", job.ID),
+		slog.String("content_type", args.ContentType),
+		slog.String("content_id", args.ContentID.String()),
+		slog.Int("selected", len(selected)),
+	)
+	return nil
+}
+
+func (w *SyncArtworkWorker) syncMovie(ctx context.Context, id uuid.UUID, language string) ([]artwork.Artwork, error) {
+	mov, err := w.movies.GetMovie(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get movie: %w", err)
+	}
+	if mov.TMDbID == nil {
+		return nil, nil
+	}
+
+	tmdbID := strconv.Itoa(int(*mov.TMDbID))
+	ids := providerIDs(mov.ProviderIDs)
+	ids[metadata.ProviderTMDb] = tmdbID
+	// Fanart.tv looks movies up by TMDb ID.
+	ids[metadata.ProviderFanartTV] = tmdbID
+
+	images, err := w.metadata.GetAllMovieImages(metadata.WithProviderIDs(ctx, ids), tmdbID)
+	selected, err := w.collect(ctx, artwork.ContentTypeMovie, id, images, err, language)
+	if err != nil {
+		return nil, err
+	}
+
+	params := movie.UpdateMovieParams{ID: id}
+	params.PosterPath = changedPath(mov.PosterPath, selected, artwork.KindPoster, mov.LockedFields.Has(content.FieldPoster))
+	params.BackdropPath = changedPath(mov.BackdropPath, selected, artwork.KindBackdrop, mov.LockedFields.Has(content.FieldBackdrop))
+	if params.PosterPath != nil || params.BackdropPath != nil {
+		if _, err := w.movies.UpdateMovie(ctx, params); err != nil {
+			return nil, fmt.Errorf("apply selected artwork: %w", err)
+		}
+	}
+	return selected, nil
+}
+
+func (w *SyncArtworkWorker) syncSeries(ctx context.Context, id uuid.UUID, language string) ([]artwork.Artwork, error) {
+	series, err := w.tvshows.GetSeries(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get series: %w", err)
+	}
+	if series.TMDbID == nil {
+		return nil, nil
+	}
+
+	tmdbID := strconv.Itoa(int(*series.TMDbID))
+	ids := providerIDs(series.AllProviderIDs())
+	// Fanart.tv looks shows up by TVDb ID.
+	if tvdbID, ok := ids[metadata.ProviderTVDb]; ok {
+		ids[metadata.ProviderFanartTV] = tvdbID
+	}
+
+	images, err := w.metadata.GetAllTVShowImages(metadata.WithProviderIDs(ctx, ids), tmdbID)
+	selected, err := w.collect(ctx, artwork.ContentTypeSeries, id, images, err, language)
+	if err != nil {
+		return nil, err
+	}
+
+	params := tvshow.UpdateSeriesParams{ID: id}
+	params.PosterPath = changedPath(series.PosterPath, selected, artwork.KindPoster, series.LockedFields.Has(content.FieldPoster))
+	params.BackdropPath = changedPath(series.BackdropPath, selected, artwork.KindBackdrop, series.LockedFields.Has(content.FieldBackdrop))
+	if params.PosterPath != nil || params.BackdropPath != nil {
+		if _, err := w.tvshows.UpdateSeries(ctx, params); err != nil {
+			return nil, fmt.Errorf("apply selected artwork: %w", err)
+		}
+	}
+	return selected, nil
+}
+
+// collect records the provider images as candidates and selects the
+// preferred image of each kind. Without provider images the selection is
+// made among the candidates already recorded.
+func (w *SyncArtworkWorker) collect(ctx context.Context, contentType string, id uuid.UUID, images []metadata.ProviderImages, fetchErr error, language string) ([]artwork.Artwork, error) {
+	if fetchErr != nil && !errors.Is(fetchErr, metadata.ErrNotFound) && !errors.Is(fetchErr, metadata.ErrNoProviders) {
+		return nil, fmt.Errorf("get images: %w", fetchErr)
+	}
+
+	for _, set := range images {
+		if _, err := w.artwork.SyncProvider(ctx, contentType, id, string(set.Provider), artworkCandidates(set.Images)); err != nil {
+			return nil, err
+		}
+	}
+	return w.artwork.AutoSelect(ctx, contentType, id, language)
+}
+
+// cacheSelected queues the selected images that are not cached yet or have
+// no placeholder. Local artwork is served from disk and is only queued for
+// its placeholder.
+func (w *SyncArtworkWorker) cacheSelected(ctx context.Context, selected []artwork.Artwork) {
+	for _, art := range selected {
+		if !art.NeedsCaching() {
+			continue
+		}
+		if err := w.queue.EnqueueCacheArtwork(ctx, art); err != nil {
+			w.logger.Warn("failed to queue artwork download",
+				slog.String("artwork_id", art.ID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// artworkCandidates converts provider images to artwork candidates.
+func artworkCandidates(images *metadata.Images) []artwork.ProviderImage {
+	if images == nil {
+		return nil
+	}
+	groups := []struct {
+		kind   string
+		images []metadata.Image
+	}{
+		{artwork.KindPoster, images.Posters},
+		{artwork.KindBackdrop, images.Backdrops},
+		{artwork.KindLogo, images.Logos},
+		{artwork.KindClearArt, images.ClearArt},
+		{artwork.KindBanner, images.Banners},
+		{artwork.KindThumb, images.Thumbs},
+	}
+
+	var candidates []artwork.ProviderImage
+	for _, group := range groups {
+		for _, img := range group.images {
+			if img.FilePath == "" {
+				continue
+			}
+			candidates = append(candidates, artwork.ProviderImage{
+				Kind:        group.kind,
+				Path:        img.FilePath,
+				Width:       img.Width,
+				Height:      img.Height,
+				Language:    img.Language,
+				VoteAverage: img.VoteAverage,
+				VoteCount:   img.VoteCount,
+			})
+		}
+	}
+	return candidates
+}
+
+// changedPath returns the image path of the selected artwork of a kind when
+// it differs from current and the field is not locked, or nil.
+func changedPath(current *string, selected []artwork.Artwork, kind string, locked bool) *string {
+	if locked {
+		return nil
+	}
+	art := artwork.Select(selected, kind)
+	if art == nil {
+		return nil
+	}
+	path := art.ImagePath()
+	if current != nil && *current == path {
+		return nil
+	}
+	return &path
+}
+
+// providerIDs converts stored provider IDs to metadata provider IDs.
+func providerIDs(ids map[string]string) map[metadata.ProviderID]string {
+	out := make(map[metadata.ProviderID]string, len(ids)+2)
+	for p, id := range ids {
+		out[metadata.ProviderID(p)] = id
+	}
+	return out
+}
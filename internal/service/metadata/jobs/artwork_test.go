@@ -0,0 +1,323 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/image"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// memoryArtworkRepo keeps artwork records in memory.
+type memoryArtworkRepo struct {
+	items []artwork.Artwork
+}
+
+func (r *memoryArtworkRepo) Upsert(_ context.Context, art *artwork.Artwork) error {
+	for i := range r.items {
+		it := &r.items[i]
+		if it.ContentType == art.ContentType && it.ContentID == art.ContentID &&
+			it.Kind == art.Kind && it.Source == art.Source && it.Path == art.Path {
+			art.ID, art.Selected, art.UserSelected = it.ID, it.Selected, it.UserSelected
+			*it = *art
+			return nil
+		}
+	}
+	art.ID = uuid.New()
+	r.items = append(r.items, *art)
+	return nil
+}
+
+func (r *memoryArtworkRepo) Get(_ context.Context, id uuid.UUID) (*artwork.Artwork, error) {
+	for i := range r.items {
+		if r.items[i].ID == id {
+			art := r.items[i]
+			return &art, nil
+		}
+	}
+	return nil, artwork.ErrNotFound
+}
+
+func (r *memoryArtworkRepo) ListByContent(_ context.Context, contentType string, contentID uuid.UUID) ([]artwork.Artwork, error) {
+	var out []artwork.Artwork
+	for _, it := range r.items {
+		if it.ContentType == contentType && it.ContentID == contentID {
+			out = append(out, it)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryArtworkRepo) DeleteStale(_ context.Context, contentType string, contentID uuid.UUID, source string, keep []uuid.UUID) (int64, error) {
+	var removed int64
+	r.items = slices.DeleteFunc(r.items, func(it artwork.Artwork) bool {
+		stale := it.ContentType == contentType && it.ContentID == contentID &&
+			it.Source == source && !slices.Contains(keep, it.ID)
+		if stale {
+			removed++
+		}
+		return stale
+	})
+	return removed, nil
+}
+
+func (r *memoryArtworkRepo) Select(_ context.Context, art *artwork.Artwork, userSelected bool) error {
+	for i := range r.items {
+		it := &r.items[i]
+		if it.ContentType == art.ContentType && it.ContentID == art.ContentID && it.Kind == art.Kind {
+			it.Selected = it.ID == art.ID
+			it.UserSelected = it.Selected && userSelected
+		}
+	}
+	art.Selected, art.UserSelected = true, userSelected
+	return nil
+}
+
+func (r *memoryArtworkRepo) MarkCached(_ context.Context, id uuid.UUID, _ int64) error {
+	for i := range r.items {
+		if r.items[i].ID == id {
+			r.items[i].CachedAt = new(time.Now())
+		}
+	}
+	return nil
+}
+
+func (r *memoryArtworkRepo) SetPlaceholder(_ context.Context, id uuid.UUID, placeholder blurhash.Placeholder) error {
+	for i := range r.items {
+		if r.items[i].ID == id {
+			r.items[i].BlurHash = &placeholder.BlurHash
+			r.items[i].DominantColor = &placeholder.Color
+		}
+	}
+	return nil
+}
+
+type fakeArtworkMovies struct {
+	movie.Service
+	movie        movie.Movie
+	updates      []movie.UpdateMovieParams
+	placeholders map[string]blurhash.Placeholder
+}
+
+func (f *fakeArtworkMovies) GetMovie(_ context.Context, _ uuid.UUID) (*movie.Movie, error) {
+	mov := f.movie
+	return &mov, nil
+}
+
+func (f *fakeArtworkMovies) UpdateMovie(_ context.Context, params movie.UpdateMovieParams) (*movie.Movie, error) {
+	f.updates = append(f.updates, params)
+	return &f.movie, nil
+}
+
+func (f *fakeArtworkMovies) SetImagePlaceholder(_ context.Context, _ uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	if f.placeholders == nil {
+		f.placeholders = map[string]blurhash.Placeholder{}
+	}
+	f.placeholders[path] = placeholder
+	return nil
+}
+
+type fakeArtworkSeries struct {
+	tvshow.Service
+	series  tvshow.Series
+	updates []tvshow.UpdateSeriesParams
+}
+
+func (f *fakeArtworkSeries) GetSeries(_ context.Context, _ uuid.UUID) (*tvshow.Series, error) {
+	series := f.series
+	return &series, nil
+}
+
+func (f *fakeArtworkSeries) UpdateSeries(_ context.Context, params tvshow.UpdateSeriesParams) (*tvshow.Series, error) {
+	f.updates = append(f.updates, params)
+	return &f.series, nil
+}
+
+type fakeImageProviders struct {
+	metadata.Service
+	images []metadata.ProviderImages
+	ids    map[metadata.ProviderID]string
+}
+
+func (f *fakeImageProviders) GetAllMovieImages(ctx context.Context, _ string) ([]metadata.ProviderImages, error) {
+	f.ids, _ = metadata.ProviderIDsFromContext(ctx)
+	return f.images, nil
+}
+
+func (f *fakeImageProviders) GetAllTVShowImages(ctx context.Context, _ string) ([]metadata.ProviderImages, error) {
+	f.ids, _ = metadata.ProviderIDsFromContext(ctx)
+	return f.images, nil
+}
+
+type fakeArtworkQueue struct {
+	cached []artwork.Artwork
+}
+
+func (q *fakeArtworkQueue) EnqueueCacheArtwork(_ context.Context, art artwork.Artwork) error {
+	q.cached = append(q.cached, art)
+	return nil
+}
+
+func testProviderImages() []metadata.ProviderImages {
+	return []metadata.ProviderImages{
+		{Provider: metadata.ProviderTMDb, Images: &metadata.Images{
+			Posters: []metadata.Image{
+				{FilePath: "/en.jpg", Language: new("en"), VoteAverage: 8},
+				{FilePath: "/de.jpg", Language: new("de"), VoteAverage: 5},
+			},
+			Backdrops: []metadata.Image{
+				{FilePath: "/backdrop-en.jpg", Language: new("en"), VoteAverage: 9},
+				{FilePath: "/backdrop.jpg", VoteAverage: 4},
+			},
+		}},
+		{Provider: metadata.ProviderFanartTV, Images: &metadata.Images{
+			Logos: []metadata.Image{{FilePath: "https://assets.fanart.tv/logo.png", Language: new("de")}},
+		}},
+	}
+}
+
+func newTestSyncArtworkWorker(movies movie.Service, series tvshow.Service, providers *fakeImageProviders, queue *fakeArtworkQueue) *SyncArtworkWorker {
+	return &SyncArtworkWorker{
+		movies:   movies,
+		tvshows:  series,
+		metadata: providers,
+		artwork:  artwork.NewService(&memoryArtworkRepo{}, config.LocalArtworkConfig{}, slog.Default()),
+		queue:    queue,
+		logger:   slog.Default(),
+	}
+}
+
+func TestSyncArtworkWorker_Movie(t *testing.T) {
+	movieID := uuid.New()
+
+	t.Run("selects and applies artwork", func(t *testing.T) {
+		movies := &fakeArtworkMovies{movie: movie.Movie{ID: movieID, TMDbID: new(int32(550)), PosterPath: new("/en.jpg")}}
+		providers := &fakeImageProviders{images: testProviderImages()}
+		queue := &fakeArtworkQueue{}
+		worker := newTestSyncArtworkWorker(movies, nil, providers, queue)
+
+		err := worker.Work(context.Background(), &river.Job[SyncArtworkArgs]{
+			JobRow: &rivertype.JobRow{ID: 1},
+			Args:   SyncArtworkArgs{ContentType: artwork.ContentTypeMovie, ContentID: movieID, Languages: []string{"de"}},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "550", providers.ids[metadata.ProviderFanartTV])
+		require.Len(t, movies.updates, 1)
+		assert.Equal(t, "/de.jpg", *movies.updates[0].PosterPath)
+		assert.Equal(t, "/backdrop.jpg", *movies.updates[0].BackdropPath)
+
+		kinds := make([]string, len(queue.cached))
+		for i, art := range queue.cached {
+			kinds[i] = art.Kind
+		}
+		assert.Equal(t, []string{artwork.KindPoster, artwork.KindBackdrop, artwork.KindLogo}, kinds)
+	})
+
+	t.Run("locked poster is kept", func(t *testing.T) {
+		movies := &fakeArtworkMovies{movie: movie.Movie{
+			ID:           movieID,
+			TMDbID:       new(int32(550)),
+			LockedFields: content.LockedFields{content.FieldPoster},
+		}}
+		worker := newTestSyncArtworkWorker(movies, nil, &fakeImageProviders{images: testProviderImages()}, &fakeArtworkQueue{})
+
+		err := worker.Work(context.Background(), &river.Job[SyncArtworkArgs]{
+			JobRow: &rivertype.JobRow{ID: 1},
+			Args:   SyncArtworkArgs{ContentType: artwork.ContentTypeMovie, ContentID: movieID},
+		})
+		require.NoError(t, err)
+		require.Len(t, movies.updates, 1)
+		assert.Nil(t, movies.updates[0].PosterPath)
+		assert.Equal(t, "/backdrop.jpg", *movies.updates[0].BackdropPath)
+	})
+
+	t.Run("movie without TMDb ID is skipped", func(t *testing.T) {
+		movies := &fakeArtworkMovies{movie: movie.Movie{ID: movieID}}
+		queue := &fakeArtworkQueue{}
+		worker := newTestSyncArtworkWorker(movies, nil, &fakeImageProviders{images: testProviderImages()}, queue)
+
+		err := worker.Work(context.Background(), &river.Job[SyncArtworkArgs]{
+			JobRow: &rivertype.JobRow{ID: 1},
+			Args:   SyncArtworkArgs{ContentType: artwork.ContentTypeMovie, ContentID: movieID},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, movies.updates)
+		assert.Empty(t, queue.cached)
+	})
+}
+
+func TestSyncArtworkWorker_Series(t *testing.T) {
+	seriesID := uuid.New()
+	series := &fakeArtworkSeries{series: tvshow.Series{ID: seriesID, TMDbID: new(int32(1399)), TVDbID: new(int32(121361))}}
+	providers := &fakeImageProviders{images: testProviderImages()}
+	worker := newTestSyncArtworkWorker(nil, series, providers, &fakeArtworkQueue{})
+
+	err := worker.Work(context.Background(), &river.Job[SyncArtworkArgs]{
+		JobRow: &rivertype.JobRow{ID: 1},
+		Args:   SyncArtworkArgs{ContentType: artwork.ContentTypeSeries, ContentID: seriesID},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "121361", providers.ids[metadata.ProviderFanartTV])
+	assert.Equal(t, "1399", providers.ids[metadata.ProviderTMDb])
+	require.Len(t, series.updates, 1)
+	assert.Equal(t, "/en.jpg", *series.updates[0].PosterPath)
+}
+
+func TestArtworkCandidates(t *testing.T) {
+	candidates := artworkCandidates(&metadata.Images{
+		Posters:  []metadata.Image{{FilePath: "/p.jpg", Width: 1000, Height: 1500, VoteAverage: 5.5, VoteCount: 3}},
+		ClearArt: []metadata.Image{{FilePath: ""}},
+		Thumbs:   []metadata.Image{{FilePath: "/t.jpg"}},
+	})
+	assert.Equal(t, []artwork.ProviderImage{
+		{Kind: artwork.KindPoster, Path: "/p.jpg", Width: 1000, Height: 1500, VoteAverage: 5.5, VoteCount: 3},
+		{Kind: artwork.KindThumb, Path: "/t.jpg"},
+	}, candidates)
+	assert.Nil(t, artworkCandidates(nil))
+}
+
+func TestDownloadImageWorker_StorePlaceholder(t *testing.T) {
+	ctx := context.Background()
+	movieID := uuid.New()
+	art := artwork.Artwork{ID: uuid.New(), ContentType: artwork.ContentTypeMovie, ContentID: movieID, Kind: artwork.KindPoster, Source: "tmdb", Path: "/p.jpg"}
+	repo := &memoryArtworkRepo{items: []artwork.Artwork{art}}
+	movies := &fakeArtworkMovies{}
+	worker := NewDownloadImageWorker(nil, artwork.NewService(repo, config.LocalArtworkConfig{}, slog.Default()), movies, nil, nil, slog.Default())
+
+	assert.True(t, repo.items[0].NeedsCaching())
+
+	placeholder := blurhash.Placeholder{BlurHash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj", Color: "#1a2b3c"}
+	worker.storePlaceholder(ctx, DownloadImageArgs{
+		ContentType: artwork.ContentTypeMovie,
+		ContentID:   movieID.String(),
+		ImageType:   image.TypePoster,
+		Path:        "/p.jpg",
+		ArtworkID:   art.ID,
+	}, placeholder)
+
+	assert.Equal(t, map[string]blurhash.Placeholder{"/p.jpg": placeholder}, movies.placeholders)
+	require.NotNil(t, repo.items[0].Placeholder())
+	assert.Equal(t, placeholder, *repo.items[0].Placeholder())
+
+	// Provider images still need the cache download; local ones are done.
+	assert.True(t, repo.items[0].NeedsCaching())
+	repo.items[0].Source = artwork.SourceLocal
+	assert.False(t, repo.items[0].NeedsCaching())
+}
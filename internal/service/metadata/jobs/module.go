@@ -16,13 +16,17 @@ var Module = fx.Module("metadatajobs",
 		NewRefreshPersonWorker,
 		NewEnrichContentWorker,
 		NewDownloadImageWorker,
+		NewSyncArtworkWorker,
+		NewScheduledRefreshWorker,
+		NewTagBackfillWorker,
 	),
 	fx.Invoke(RegisterWorkers, WireJobQueue),
 )
 
 // RegisterWorkers registers all metadata job workers with the River workers registry.
 // Note: RefreshMovieArgs worker is already registered by moviejobs.Module —
-// we only register workers for the 6 previously orphaned job kinds.
+// we register the remaining refresh kinds, artwork sync and the scheduled
+// refresh and the tag backfill.
 func RegisterWorkers(
 	workers *river.Workers,
 	tvshowWorker *RefreshTVShowWorker,
@@ -31,6 +35,9 @@ func RegisterWorkers(
 	personWorker *RefreshPersonWorker,
 	enrichWorker *EnrichContentWorker,
 	imageWorker *DownloadImageWorker,
+	artworkWorker *SyncArtworkWorker,
+	scheduledRefreshWorker *ScheduledRefreshWorker,
+	tagBackfillWorker *TagBackfillWorker,
 ) error {
 	river.AddWorker(workers, tvshowWorker)
 	river.AddWorker(workers, seasonWorker)
@@ -38,6 +45,9 @@ func RegisterWorkers(
 	river.AddWorker(workers, personWorker)
 	river.AddWorker(workers, enrichWorker)
 	river.AddWorker(workers, imageWorker)
+	river.AddWorker(workers, artworkWorker)
+	river.AddWorker(workers, scheduledRefreshWorker)
+	river.AddWorker(workers, tagBackfillWorker)
 	return nil
 }
 
@@ -5,7 +5,9 @@ import (
 
 	"github.com/google/uuid"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
 )
 
 // Queue provides an interface for enqueuing metadata jobs.
@@ -113,6 +115,43 @@ func (q *Queue) EnqueueDownloadImage(ctx context.Context, contentType, contentID
 	return err
 }
 
+// EnqueueSyncArtwork enqueues an artwork sync job for a movie or series.
+func (q *Queue) EnqueueSyncArtwork(ctx context.Context, contentType string, contentID uuid.UUID, languages []string) error {
+	_, err := q.client.Insert(ctx, SyncArtworkArgs{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Languages:   languages,
+	}, nil)
+	return err
+}
+
+// EnqueueCacheArtwork enqueues a download of a selected artwork image into
+// the local image cache, which also computes its placeholder.
+func (q *Queue) EnqueueCacheArtwork(ctx context.Context, art artwork.Artwork) error {
+	_, err := q.client.Insert(ctx, DownloadImageArgs{
+		ContentType: art.ContentType,
+		ContentID:   art.ContentID.String(),
+		ImageType:   art.Kind,
+		Path:        art.ImagePath(),
+		ArtworkID:   art.ID,
+	}, nil)
+	return err
+}
+
+// queuedUniqueOpts skips a batch refresh while the same refresh is still
+// waiting or running, however long ago it was queued. Completed refreshes
+// do not block a new one.
+var queuedUniqueOpts = river.UniqueOpts{
+	ByArgs: true,
+	ByState: []rivertype.JobState{
+		rivertype.JobStateAvailable,
+		rivertype.JobStatePending,
+		rivertype.JobStateRetryable,
+		rivertype.JobStateRunning,
+		rivertype.JobStateScheduled,
+	},
+}
+
 // BatchEnqueueRefreshMovies enqueues multiple movie refresh jobs.
 func (q *Queue) BatchEnqueueRefreshMovies(ctx context.Context, movieIDs []uuid.UUID, force bool, languages []string) error {
 	params := make([]river.InsertManyParams, len(movieIDs))
@@ -123,6 +162,7 @@ func (q *Queue) BatchEnqueueRefreshMovies(ctx context.Context, movieIDs []uuid.U
 				Force:     force,
 				Languages: languages,
 			},
+			InsertOpts: &river.InsertOpts{UniqueOpts: queuedUniqueOpts},
 		}
 	}
 	_, err := q.client.InsertMany(ctx, params)
@@ -131,6 +171,16 @@ func (q *Queue) BatchEnqueueRefreshMovies(ctx context.Context, movieIDs []uuid.U
 
 // BatchEnqueueRefreshTVShows enqueues multiple TV show refresh jobs.
 func (q *Queue) BatchEnqueueRefreshTVShows(ctx context.Context, seriesIDs []uuid.UUID, force bool, languages []string) error {
+	return q.batchEnqueueRefreshTVShows(ctx, seriesIDs, force, languages, false)
+}
+
+// BatchEnqueueRefreshTVShowsFull enqueues multiple full TV show refresh jobs
+// including episodes.
+func (q *Queue) BatchEnqueueRefreshTVShowsFull(ctx context.Context, seriesIDs []uuid.UUID, force bool, languages []string) error {
+	return q.batchEnqueueRefreshTVShows(ctx, seriesIDs, force, languages, true)
+}
+
+func (q *Queue) batchEnqueueRefreshTVShows(ctx context.Context, seriesIDs []uuid.UUID, force bool, languages []string, includeEpisodes bool) error {
 	params := make([]river.InsertManyParams, len(seriesIDs))
 	for i, id := range seriesIDs {
 		params[i] = river.InsertManyParams{
@@ -139,8 +189,9 @@ func (q *Queue) BatchEnqueueRefreshTVShows(ctx context.Context, seriesIDs []uuid
 				Force:           force,
 				Languages:       languages,
 				IncludeSeasons:  true,
-				IncludeEpisodes: false,
+				IncludeEpisodes: includeEpisodes,
 			},
+			InsertOpts: &river.InsertOpts{UniqueOpts: queuedUniqueOpts},
 		}
 	}
 	_, err := q.client.InsertMany(ctx, params)
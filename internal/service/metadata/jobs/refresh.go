@@ -170,11 +170,14 @@ func (EnrichContentArgs) InsertOpts() river.InsertOpts {
 
 // DownloadImageArgs are the arguments for downloading and caching an image.
 type DownloadImageArgs struct {
-	ContentType string `json:"content_type"` // "movie", "tvshow", "person", etc.
+	ContentType string `json:"content_type"` // artwork content type: "movie", "series", "person", etc.
 	ContentID   string `json:"content_id"`
 	ImageType   string `json:"image_type"` // "poster", "backdrop", "profile", "still"
 	Path        string `json:"path"`       // Provider image path
 	Size        string `json:"size"`       // Image size
+	// ArtworkID is the selected artwork record the image belongs to, if any.
+	// The record is marked as cached once the image is stored.
+	ArtworkID uuid.UUID `json:"artwork_id,omitzero"`
 }
 
 // Kind returns the unique job kind for River.
@@ -193,3 +196,28 @@ func (DownloadImageArgs) InsertOpts() river.InsertOpts {
 		},
 	}
 }
+
+// SyncArtworkArgs are the arguments for collecting the artwork candidates of
+// a movie or series from all image providers and selecting its images.
+type SyncArtworkArgs struct {
+	ContentType string    `json:"content_type"` // "movie" or "series"
+	ContentID   uuid.UUID `json:"content_id"`
+	Languages   []string  `json:"languages,omitempty"` // Preferred artwork language first
+}
+
+// Kind returns the unique job kind for River.
+func (SyncArtworkArgs) Kind() string {
+	return "metadata_sync_artwork"
+}
+
+// InsertOpts returns the default insert options for artwork sync jobs.
+func (SyncArtworkArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueLow,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs:   true,
+			ByPeriod: 10 * time.Minute,
+		},
+	}
+}
@@ -0,0 +1,223 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/circuitbreaker"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// ScheduledRefreshArgs triggers one run of the scheduled metadata refresh.
+type ScheduledRefreshArgs struct{}
+
+// Kind returns the unique job kind for River.
+func (ScheduledRefreshArgs) Kind() string {
+	return "metadata_scheduled_refresh"
+}
+
+// InsertOpts returns the default insert options for scheduled refresh runs.
+func (ScheduledRefreshArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueLow,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: 5 * time.Minute,
+		},
+	}
+}
+
+// RefreshSchedule decides how long metadata stays fresh for each class of
+// item.
+type RefreshSchedule struct {
+	// Continuing applies to continuing or recently aired series and to
+	// recently released movies.
+	Continuing time.Duration
+	// Ended applies to ended series and older movies.
+	Ended time.Duration
+	// Incomplete applies to items missing an overview or poster.
+	Incomplete time.Duration
+	// RecentWindow is how long after release an item counts as recent.
+	RecentWindow time.Duration
+}
+
+// NewRefreshSchedule builds a schedule from configuration.
+func NewRefreshSchedule(cfg config.MetadataRefreshConfig) RefreshSchedule {
+	return RefreshSchedule{
+		Continuing:   cfg.Continuing,
+		Ended:        cfg.Ended,
+		Incomplete:   cfg.Incomplete,
+		RecentWindow: cfg.RecentWindow,
+	}
+}
+
+// Cutoffs returns the refresh cutoffs of the schedule at now.
+func (s RefreshSchedule) Cutoffs(now time.Time) content.RefreshCutoffs {
+	return content.RefreshCutoffs{
+		IncompleteBefore: now.Add(-s.Incomplete),
+		ActiveBefore:     now.Add(-s.Continuing),
+		SettledBefore:    now.Add(-s.Ended),
+		ActiveSince:      now.Add(-s.RecentWindow),
+	}
+}
+
+// refreshQueue enqueues refresh jobs in batches.
+type refreshQueue interface {
+	BatchEnqueueRefreshMovies(ctx context.Context, movieIDs []uuid.UUID, force bool, languages []string) error
+	BatchEnqueueRefreshTVShows(ctx context.Context, seriesIDs []uuid.UUID, force bool, languages []string) error
+	BatchEnqueueRefreshTVShowsFull(ctx context.Context, seriesIDs []uuid.UUID, force bool, languages []string) error
+}
+
+// ScheduledRefreshWorker queues metadata refreshes for movies and series
+// that are due under the refresh schedule. Each run queues at most batchSize
+// items per content type so provider requests stay spread over time, and a
+// content type is skipped while its primary provider's circuit breaker is
+// open.
+type ScheduledRefreshWorker struct {
+	river.WorkerDefaults[ScheduledRefreshArgs]
+	movies    movie.Service
+	tvshows   tvshow.Service
+	metadata  metadata.Service
+	queue     refreshQueue
+	schedule  RefreshSchedule
+	batchSize int
+	now       func() time.Time
+	logger    *slog.Logger
+}
+
+// NewScheduledRefreshWorker creates a new scheduled refresh worker.
+func NewScheduledRefreshWorker(
+	cfg *config.Config,
+	movies movie.Service,
+	tvshows tvshow.Service,
+	metadataService metadata.Service,
+	queue *Queue,
+	logger *slog.Logger,
+) *ScheduledRefreshWorker {
+	return &ScheduledRefreshWorker{
+		movies:    movies,
+		tvshows:   tvshows,
+		metadata:  metadataService,
+		queue:     queue,
+		schedule:  NewRefreshSchedule(cfg.Metadata.Refresh),
+		batchSize: cfg.Metadata.Refresh.BatchSize,
+		now:       time.Now,
+		logger:    logger.With("component", "metadata_scheduled_refresh"),
+	}
+}
+
+// Timeout returns the maximum execution time for a scheduled refresh run.
+func (w *ScheduledRefreshWorker) Timeout(job *river.Job[ScheduledRefreshArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+// Work queues the movies and series that are due for a refresh.
+func (w *ScheduledRefreshWorker) Work(ctx context.Context, job *river.Job[ScheduledRefreshArgs]) error {
+	if w.batchSize <= 0 {
+		return nil
+	}
+	cutoffs := w.schedule.Cutoffs(w.now())
+
+	movieCount, movieErr := w.refreshMovies(ctx, cutoffs)
+	seriesCount, seriesErr := w.refreshSeries(ctx, cutoffs)
+
+	w.logger.Info("scheduled metadata refresh queued",
+		slog.Int64("job_id", job.ID),
+		slog.Int("movies", movieCount),
+		slog.Int("series", seriesCount),
+	)
+
+	if movieErr != nil {
+		return movieErr
+	}
+	return seriesErr
+}
+
+func (w *ScheduledRefreshWorker) refreshMovies(ctx context.Context, cutoffs content.RefreshCutoffs) (int, error) {
+	if w.movies == nil || w.providerUnavailable(metadata.Provider.SupportsMovies) {
+		return 0, nil
+	}
+	movies, err := w.movies.ListMoviesDueForRefresh(ctx, cutoffs, int32(w.batchSize))
+	if err != nil {
+		return 0, fmt.Errorf("list movies due for refresh: %w", err)
+	}
+	if len(movies) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, len(movies))
+	for i := range movies {
+		ids[i] = movies[i].ID
+	}
+	if err := w.queue.BatchEnqueueRefreshMovies(ctx, ids, false, nil); err != nil {
+		return 0, fmt.Errorf("enqueue movie refreshes: %w", err)
+	}
+	return len(ids), nil
+}
+
+func (w *ScheduledRefreshWorker) refreshSeries(ctx context.Context, cutoffs content.RefreshCutoffs) (int, error) {
+	if w.tvshows == nil || w.providerUnavailable(metadata.Provider.SupportsTVShows) {
+		return 0, nil
+	}
+	series, err := w.tvshows.ListSeriesDueForRefresh(ctx, cutoffs, int32(w.batchSize))
+	if err != nil {
+		return 0, fmt.Errorf("list series due for refresh: %w", err)
+	}
+	if len(series) == 0 {
+		return 0, nil
+	}
+
+	// Continuing series also refresh their episodes so newly announced and
+	// recently aired episodes pick up titles, overviews and stills.
+	var continuing, ended []uuid.UUID
+	for i := range series {
+		if series[i].IsEnded() {
+			ended = append(ended, series[i].ID)
+		} else {
+			continuing = append(continuing, series[i].ID)
+		}
+	}
+	if len(continuing) > 0 {
+		if err := w.queue.BatchEnqueueRefreshTVShowsFull(ctx, continuing, false, nil); err != nil {
+			return 0, fmt.Errorf("enqueue series refreshes: %w", err)
+		}
+	}
+	if len(ended) > 0 {
+		if err := w.queue.BatchEnqueueRefreshTVShows(ctx, ended, false, nil); err != nil {
+			return len(continuing), fmt.Errorf("enqueue series refreshes: %w", err)
+		}
+	}
+	return len(series), nil
+}
+
+// providerUnavailable reports whether the highest priority provider that
+// supports a content type has its circuit breaker open. Refreshes queued
+// now would only fail, so the content type waits for the next run.
+func (w *ScheduledRefreshWorker) providerUnavailable(supports func(metadata.Provider) bool) bool {
+	if w.metadata == nil {
+		return false
+	}
+	for _, p := range w.metadata.GetProviders() {
+		if !supports(p) {
+			continue
+		}
+		if circuitbreaker.IsOpen(string(p.ID())) {
+			w.logger.Warn("skipping scheduled refresh, provider circuit open",
+				slog.String("provider", string(p.ID())),
+			)
+			return true
+		}
+		return false
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/imroc/req/v3"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	"github.com/lusoris/revenge/internal/infra/circuitbreaker"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+type fakeDueMovies struct {
+	movie.Service
+	movies  []movie.Movie
+	cutoffs content.RefreshCutoffs
+	limit   int32
+}
+
+func (f *fakeDueMovies) ListMoviesDueForRefresh(_ context.Context, cutoffs content.RefreshCutoffs, limit int32) ([]movie.Movie, error) {
+	f.cutoffs, f.limit = cutoffs, limit
+	return f.movies, nil
+}
+
+type fakeDueSeries struct {
+	tvshow.Service
+	series []tvshow.Series
+}
+
+func (f *fakeDueSeries) ListSeriesDueForRefresh(_ context.Context, _ content.RefreshCutoffs, _ int32) ([]tvshow.Series, error) {
+	return f.series, nil
+}
+
+type fakeRefreshQueue struct {
+	movies     []uuid.UUID
+	series     []uuid.UUID
+	fullSeries []uuid.UUID
+}
+
+func (q *fakeRefreshQueue) BatchEnqueueRefreshMovies(_ context.Context, ids []uuid.UUID, _ bool, _ []string) error {
+	q.movies = append(q.movies, ids...)
+	return nil
+}
+
+func (q *fakeRefreshQueue) BatchEnqueueRefreshTVShows(_ context.Context, ids []uuid.UUID, _ bool, _ []string) error {
+	q.series = append(q.series, ids...)
+	return nil
+}
+
+func (q *fakeRefreshQueue) BatchEnqueueRefreshTVShowsFull(_ context.Context, ids []uuid.UUID, _ bool, _ []string) error {
+	q.fullSeries = append(q.fullSeries, ids...)
+	return nil
+}
+
+type fakeProvider struct {
+	metadata.Provider
+	id     metadata.ProviderID
+	movies bool
+	tv     bool
+}
+
+func (p fakeProvider) ID() metadata.ProviderID { return p.id }
+func (p fakeProvider) SupportsMovies() bool    { return p.movies }
+func (p fakeProvider) SupportsTVShows() bool   { return p.tv }
+
+type fakeProviders struct {
+	metadata.Service
+	providers []metadata.Provider
+}
+
+func (f fakeProviders) GetProviders() []metadata.Provider { return f.providers }
+
+func TestRefreshSchedule_Cutoffs(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	schedule := NewRefreshSchedule(config.MetadataRefreshConfig{
+		Continuing:   24 * time.Hour,
+		Ended:        720 * time.Hour,
+		Incomplete:   12 * time.Hour,
+		RecentWindow: 2160 * time.Hour,
+	})
+
+	cutoffs := schedule.Cutoffs(now)
+	assert.Equal(t, now.Add(-12*time.Hour), cutoffs.IncompleteBefore)
+	assert.Equal(t, now.Add(-24*time.Hour), cutoffs.ActiveBefore)
+	assert.Equal(t, now.Add(-720*time.Hour), cutoffs.SettledBefore)
+	assert.Equal(t, now.Add(-2160*time.Hour), cutoffs.ActiveSince)
+}
+
+func newTestScheduledRefreshWorker(movies *fakeDueMovies, series *fakeDueSeries, queue *fakeRefreshQueue, providers ...metadata.Provider) *ScheduledRefreshWorker {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	return &ScheduledRefreshWorker{
+		movies:    movies,
+		tvshows:   series,
+		metadata:  fakeProviders{providers: providers},
+		queue:     queue,
+		schedule:  RefreshSchedule{Continuing: 24 * time.Hour, Ended: 720 * time.Hour, Incomplete: 12 * time.Hour, RecentWindow: 2160 * time.Hour},
+		batchSize: 2,
+		now:       func() time.Time { return now },
+		logger:    slog.Default(),
+	}
+}
+
+func TestScheduledRefreshWorker_Work(t *testing.T) {
+	t.Run("queues due movies and series", func(t *testing.T) {
+		movieID, seriesID := uuid.New(), uuid.New()
+		movies := &fakeDueMovies{movies: []movie.Movie{{ID: movieID}}}
+		series := &fakeDueSeries{series: []tvshow.Series{{ID: seriesID}}}
+		queue := &fakeRefreshQueue{}
+		worker := newTestScheduledRefreshWorker(movies, series, queue,
+			fakeProvider{id: "test-closed", movies: true, tv: true})
+
+		err := worker.Work(context.Background(), &river.Job[ScheduledRefreshArgs]{JobRow: &rivertype.JobRow{ID: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{movieID}, queue.movies)
+		assert.Equal(t, []uuid.UUID{seriesID}, queue.fullSeries)
+		assert.Equal(t, int32(2), movies.limit)
+		assert.Equal(t, worker.now().Add(-24*time.Hour), movies.cutoffs.ActiveBefore)
+	})
+
+	t.Run("skips content whose primary provider circuit is open", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+		client := req.C().SetBaseURL(srv.URL).DisableAutoReadResponse().SetCommonRetryCount(0)
+		circuitbreaker.WrapReqClient(client, "test-open", circuitbreaker.TierLocal)
+		for range 3 {
+			_, _ = client.R().Get("/")
+		}
+		require.True(t, circuitbreaker.IsOpen("test-open"))
+
+		movies := &fakeDueMovies{movies: []movie.Movie{{ID: uuid.New()}}}
+		seriesID := uuid.New()
+		series := &fakeDueSeries{series: []tvshow.Series{{ID: seriesID}}}
+		queue := &fakeRefreshQueue{}
+		worker := newTestScheduledRefreshWorker(movies, series, queue,
+			fakeProvider{id: "test-open", movies: true},
+			fakeProvider{id: "test-tv", tv: true})
+
+		err := worker.Work(context.Background(), &river.Job[ScheduledRefreshArgs]{JobRow: &rivertype.JobRow{ID: 1}})
+		require.NoError(t, err)
+		assert.Empty(t, queue.movies)
+		assert.Equal(t, []uuid.UUID{seriesID}, queue.fullSeries)
+	})
+
+	t.Run("refreshes episodes of continuing series only", func(t *testing.T) {
+		continuingID, endedID := uuid.New(), uuid.New()
+		series := &fakeDueSeries{series: []tvshow.Series{
+			{ID: continuingID, Status: new("Returning Series")},
+			{ID: endedID, Status: new("Ended")},
+		}}
+		queue := &fakeRefreshQueue{}
+		worker := newTestScheduledRefreshWorker(&fakeDueMovies{}, series, queue,
+			fakeProvider{id: "test-closed", movies: true, tv: true})
+
+		err := worker.Work(context.Background(), &river.Job[ScheduledRefreshArgs]{JobRow: &rivertype.JobRow{ID: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{continuingID}, queue.fullSeries)
+		assert.Equal(t, []uuid.UUID{endedID}, queue.series)
+	})
+
+	t.Run("disabled batch size queues nothing", func(t *testing.T) {
+		queue := &fakeRefreshQueue{}
+		worker := newTestScheduledRefreshWorker(&fakeDueMovies{movies: []movie.Movie{{ID: uuid.New()}}}, &fakeDueSeries{}, queue)
+		worker.batchSize = 0
+
+		require.NoError(t, worker.Work(context.Background(), &river.Job[ScheduledRefreshArgs]{JobRow: &rivertype.JobRow{ID: 1}}))
+		assert.Empty(t, queue.movies)
+	})
+}
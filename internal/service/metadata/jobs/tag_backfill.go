@@ -0,0 +1,153 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+)
+
+// tagBackfillBatchSize is how many items are listed per query.
+const tagBackfillBatchSize = 100
+
+// TagBackfillArgs triggers one run of the tag backfill.
+type TagBackfillArgs struct{}
+
+// Kind returns the unique job kind for River.
+func (TagBackfillArgs) Kind() string {
+	return "metadata_tag_backfill"
+}
+
+// InsertOpts returns the default insert options for tag backfill runs.
+func (TagBackfillArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueLow,
+		MaxAttempts: 3,
+		UniqueOpts: river.UniqueOpts{
+			ByPeriod: time.Hour,
+		},
+	}
+}
+
+// TagBackfillWorker imports the keywords and tags of movies and series that
+// were added before tags were supported. Items whose import fails keep their
+// pending state and are retried on the next run.
+type TagBackfillWorker struct {
+	river.WorkerDefaults[TagBackfillArgs]
+	movies    movie.Service
+	tvshows   tvshow.Service
+	batchSize int32
+	logger    *slog.Logger
+}
+
+// NewTagBackfillWorker creates a new tag backfill worker.
+func NewTagBackfillWorker(
+	movies movie.Service,
+	tvshows tvshow.Service,
+	logger *slog.Logger,
+) *TagBackfillWorker {
+	return &TagBackfillWorker{
+		movies:    movies,
+		tvshows:   tvshows,
+		batchSize: tagBackfillBatchSize,
+		logger:    logger.With("component", "metadata_tag_backfill"),
+	}
+}
+
+// Timeout returns the maximum execution time for a tag backfill run.
+func (w *TagBackfillWorker) Timeout(job *river.Job[TagBackfillArgs]) time.Duration {
+	return time.Hour
+}
+
+// Work imports the tags of all movies and series still pending.
+func (w *TagBackfillWorker) Work(ctx context.Context, job *river.Job[TagBackfillArgs]) error {
+	var movieCount, seriesCount int
+	var movieErr, seriesErr error
+
+	if w.movies != nil {
+		movieCount, movieErr = backfillTags(ctx, w.logger, w.batchSize,
+			func(after uuid.UUID, limit int32) ([]uuid.UUID, error) {
+				movies, err := w.movies.ListMoviesPendingTagImport(ctx, after, limit)
+				if err != nil {
+					return nil, fmt.Errorf("list movies pending tag import: %w", err)
+				}
+				ids := make([]uuid.UUID, len(movies))
+				for i := range movies {
+					ids[i] = movies[i].ID
+				}
+				return ids, nil
+			},
+			w.movies.ImportMovieTags,
+		)
+	}
+	if w.tvshows != nil {
+		seriesCount, seriesErr = backfillTags(ctx, w.logger, w.batchSize,
+			func(after uuid.UUID, limit int32) ([]uuid.UUID, error) {
+				series, err := w.tvshows.ListSeriesPendingTagImport(ctx, after, limit)
+				if err != nil {
+					return nil, fmt.Errorf("list series pending tag import: %w", err)
+				}
+				ids := make([]uuid.UUID, len(series))
+				for i := range series {
+					ids[i] = series[i].ID
+				}
+				return ids, nil
+			},
+			w.tvshows.ImportSeriesTags,
+		)
+	}
+
+	w.logger.Info("tag backfill completed",
+		slog.Int64("job_id", job.ID),
+		slog.Int("movies", movieCount),
+		slog.Int("series", seriesCount),
+	)
+
+	if movieErr != nil {
+		return movieErr
+	}
+	return seriesErr
+}
+
+// backfillTags walks the pending items in ID order and imports the tags of
+// each one. It returns the number of items imported.
+func backfillTags(
+	ctx context.Context,
+	logger *slog.Logger,
+	batchSize int32,
+	list func(after uuid.UUID, limit int32) ([]uuid.UUID, error),
+	importTags func(ctx context.Context, id uuid.UUID) error,
+) (int, error) {
+	imported := 0
+	after := uuid.Nil
+	for {
+		ids, err := list(after, batchSize)
+		if err != nil {
+			return imported, err
+		}
+		for _, id := range ids {
+			if err := ctx.Err(); err != nil {
+				return imported, err
+			}
+			if err := importTags(ctx, id); err != nil {
+				logger.Warn("failed to import tags",
+					slog.String("id", id.String()),
+					slog.Any("error", err),
+				)
+				continue
+			}
+			imported++
+		}
+		if len(ids) < int(batchSize) {
+			return imported, nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
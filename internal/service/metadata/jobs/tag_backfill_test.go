@@ -0,0 +1,134 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/tvshow"
+)
+
+type fakePendingTagMovies struct {
+	movie.Service
+	pending  []movie.Movie
+	failing  map[uuid.UUID]bool
+	imported []uuid.UUID
+}
+
+func (f *fakePendingTagMovies) ListMoviesPendingTagImport(_ context.Context, after uuid.UUID, limit int32) ([]movie.Movie, error) {
+	var page []movie.Movie
+	for _, m := range f.pending {
+		if m.ID.String() > after.String() && len(page) < int(limit) {
+			page = append(page, m)
+		}
+	}
+	return page, nil
+}
+
+func (f *fakePendingTagMovies) ImportMovieTags(_ context.Context, id uuid.UUID) error {
+	if f.failing[id] {
+		return errors.New("provider unavailable")
+	}
+	f.imported = append(f.imported, id)
+	return nil
+}
+
+type fakePendingTagSeries struct {
+	tvshow.Service
+	pending  []tvshow.Series
+	imported []uuid.UUID
+}
+
+func (f *fakePendingTagSeries) ListSeriesPendingTagImport(_ context.Context, after uuid.UUID, limit int32) ([]tvshow.Series, error) {
+	var page []tvshow.Series
+	for _, s := range f.pending {
+		if s.ID.String() > after.String() && len(page) < int(limit) {
+			page = append(page, s)
+		}
+	}
+	return page, nil
+}
+
+func (f *fakePendingTagSeries) ImportSeriesTags(_ context.Context, id uuid.UUID) error {
+	f.imported = append(f.imported, id)
+	return nil
+}
+
+func orderedIDs(n int) []uuid.UUID {
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		ids[i][15] = byte(i + 1)
+	}
+	return ids
+}
+
+func TestTagBackfillArgs(t *testing.T) {
+	t.Parallel()
+
+	args := TagBackfillArgs{}
+	assert.Equal(t, "metadata_tag_backfill", args.Kind())
+	assert.Equal(t, time.Hour, args.InsertOpts().UniqueOpts.ByPeriod)
+}
+
+func TestTagBackfillWorker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("imports all pending items across batches", func(t *testing.T) {
+		t.Parallel()
+		movieIDs := orderedIDs(5)
+		seriesIDs := orderedIDs(2)
+		movies := &fakePendingTagMovies{}
+		for _, id := range movieIDs {
+			movies.pending = append(movies.pending, movie.Movie{ID: id})
+		}
+		series := &fakePendingTagSeries{}
+		for _, id := range seriesIDs {
+			series.pending = append(series.pending, tvshow.Series{ID: id})
+		}
+
+		w := NewTagBackfillWorker(movies, series, slog.Default())
+		w.batchSize = 2
+		err := w.Work(context.Background(), &river.Job[TagBackfillArgs]{JobRow: &rivertype.JobRow{ID: 1}})
+		require.NoError(t, err)
+
+		assert.Equal(t, movieIDs, movies.imported)
+		assert.Equal(t, seriesIDs, series.imported)
+	})
+
+	t.Run("skips failed items without stopping", func(t *testing.T) {
+		t.Parallel()
+		ids := orderedIDs(3)
+		movies := &fakePendingTagMovies{failing: map[uuid.UUID]bool{ids[0]: true}}
+		for _, id := range ids {
+			movies.pending = append(movies.pending, movie.Movie{ID: id})
+		}
+
+		w := NewTagBackfillWorker(movies, nil, slog.Default())
+		w.batchSize = 1
+		err := w.Work(context.Background(), &river.Job[TagBackfillArgs]{JobRow: &rivertype.JobRow{ID: 1}})
+		require.NoError(t, err)
+
+		assert.Equal(t, ids[1:], movies.imported)
+	})
+
+	t.Run("stops when the context ends", func(t *testing.T) {
+		t.Parallel()
+		movies := &fakePendingTagMovies{pending: []movie.Movie{{ID: orderedIDs(1)[0]}}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		w := NewTagBackfillWorker(movies, nil, slog.Default())
+		err := w.Work(ctx, &river.Job[TagBackfillArgs]{JobRow: &rivertype.JobRow{ID: 1}})
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, movies.imported)
+	})
+}
@@ -2,15 +2,21 @@ package jobs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/movie"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/infra/image"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/metadata"
+	"github.com/lusoris/revenge/internal/service/people"
 	"github.com/riverqueue/river"
 )
 
@@ -43,6 +49,7 @@ func (w *RefreshTVShowWorker) Timeout(job *river.Job[RefreshTVShowArgs]) time.Du
 
 // Work executes the TV show metadata refresh job.
 func (w *RefreshTVShowWorker) Work(ctx context.Context, job *river.Job[RefreshTVShowArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	args := job.Args
 
 	w.logger.Info("starting tvshow metadata refresh",
@@ -66,12 +73,67 @@ func (w *RefreshTVShowWorker) Work(ctx context.Context, job *river.Job[RefreshTV
 		return fmt.Errorf("tvshow metadata refresh failed: %w", err)
 	}
 
+	// Collect artwork candidates and select the images for the refreshed series
+	if _, err := w.jobClient.Insert(ctx, SyncArtworkArgs{
+		ContentType: artwork.ContentTypeSeries,
+		ContentID:   args.SeriesID,
+		Languages:   args.Languages,
+	}, nil); err != nil {
+		w.logger.Warn("failed to queue artwork sync",
+			slog.String("series_id", args.SeriesID.String()),
+			slog.Any("error", err),
+		)
+	}
+
+	if args.IncludeSeasons {
+		w.queueSeasonRefreshes(ctx, args)
+	}
+
 	w.logger.Info("tvshow metadata refresh completed",
 		slog.String("series_id", args.SeriesID.String()),
 	)
 	return nil
 }
 
+// queueSeasonRefreshes queues a refresh of every season of the series.
+// Season metadata is looked up by TMDb ID, so series without one are
+// skipped.
+func (w *RefreshTVShowWorker) queueSeasonRefreshes(ctx context.Context, args RefreshTVShowArgs) {
+	series, err := w.service.GetSeries(ctx, args.SeriesID)
+	if err != nil || series.TMDbID == nil {
+		return
+	}
+	seasons, err := w.service.ListSeasons(ctx, args.SeriesID)
+	if err != nil {
+		w.logger.Warn("failed to list seasons for refresh",
+			slog.String("series_id", args.SeriesID.String()),
+			slog.Any("error", err),
+		)
+		return
+	}
+	if len(seasons) == 0 {
+		return
+	}
+
+	params := make([]river.InsertManyParams, len(seasons))
+	for i, season := range seasons {
+		params[i] = river.InsertManyParams{Args: RefreshSeasonArgs{
+			SeriesID:        args.SeriesID,
+			SeasonID:        season.ID,
+			SeasonNumber:    int(season.SeasonNumber),
+			Force:           args.Force,
+			Languages:       args.Languages,
+			IncludeEpisodes: args.IncludeEpisodes,
+		}}
+	}
+	if _, err := w.jobClient.InsertMany(ctx, params); err != nil {
+		w.logger.Warn("failed to queue season refreshes",
+			slog.String("series_id", args.SeriesID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
 // =============================================================================
 // Season Metadata Refresh Worker
 // =============================================================================
@@ -100,6 +162,7 @@ func (w *RefreshSeasonWorker) Timeout(job *river.Job[RefreshSeasonArgs]) time.Du
 
 // Work executes the season metadata refresh job.
 func (w *RefreshSeasonWorker) Work(ctx context.Context, job *river.Job[RefreshSeasonArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	args := job.Args
 
 	w.logger.Info("starting season metadata refresh",
@@ -122,12 +185,79 @@ func (w *RefreshSeasonWorker) Work(ctx context.Context, job *river.Job[RefreshSe
 		return fmt.Errorf("season metadata refresh failed: %w", err)
 	}
 
+	if season, err := w.service.GetSeason(ctx, args.SeasonID); err == nil {
+		if err := queueImagePlaceholder(ctx, w.jobClient, artwork.ContentTypeSeason, season.ID, image.TypePoster, season.PosterPath, season.ImagePlaceholders); err != nil {
+			w.logger.Warn("failed to queue season poster download",
+				slog.String("season_id", args.SeasonID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if args.IncludeEpisodes {
+		w.queueEpisodeRefreshes(ctx, args)
+	}
+
 	w.logger.Info("season metadata refresh completed",
 		slog.String("season_id", args.SeasonID.String()),
 	)
 	return nil
 }
 
+// episodeRefreshWindow is how long after airing an episode keeps being
+// refreshed with its season; providers fill in stills and overviews of
+// new episodes over the first weeks.
+const episodeRefreshWindow = 30 * 24 * time.Hour
+
+// queueEpisodeRefreshes queues a refresh of the episodes of the season that
+// are likely to have changed at the provider, or of all episodes when the
+// refresh is forced.
+func (w *RefreshSeasonWorker) queueEpisodeRefreshes(ctx context.Context, args RefreshSeasonArgs) {
+	episodes, err := w.service.ListEpisodesBySeason(ctx, args.SeasonID)
+	if err != nil {
+		w.logger.Warn("failed to list episodes for refresh",
+			slog.String("season_id", args.SeasonID.String()),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	now := time.Now()
+	var params []river.InsertManyParams
+	for _, ep := range episodes {
+		if !args.Force && !episodeNeedsRefresh(ep, now) {
+			continue
+		}
+		params = append(params, river.InsertManyParams{Args: RefreshEpisodeArgs{
+			SeriesID:      args.SeriesID,
+			SeasonID:      args.SeasonID,
+			EpisodeID:     ep.ID,
+			SeasonNumber:  int(ep.SeasonNumber),
+			EpisodeNumber: int(ep.EpisodeNumber),
+			Force:         args.Force,
+			Languages:     args.Languages,
+		}})
+	}
+	if len(params) == 0 {
+		return
+	}
+	if _, err := w.jobClient.InsertMany(ctx, params); err != nil {
+		w.logger.Warn("failed to queue episode refreshes",
+			slog.String("season_id", args.SeasonID.String()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// episodeNeedsRefresh reports whether an episode is unaired, aired within
+// episodeRefreshWindow or still missing an overview or still.
+func episodeNeedsRefresh(ep tvshow.Episode, now time.Time) bool {
+	if ep.AirDate == nil || ep.AirDate.After(now.Add(-episodeRefreshWindow)) {
+		return true
+	}
+	return ep.Overview == nil || *ep.Overview == "" || ep.StillPath == nil
+}
+
 // =============================================================================
 // Episode Metadata Refresh Worker
 // =============================================================================
@@ -156,6 +286,7 @@ func (w *RefreshEpisodeWorker) Timeout(job *river.Job[RefreshEpisodeArgs]) time.
 
 // Work executes the episode metadata refresh job.
 func (w *RefreshEpisodeWorker) Work(ctx context.Context, job *river.Job[RefreshEpisodeArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	args := job.Args
 
 	w.logger.Info("starting episode metadata refresh",
@@ -178,6 +309,16 @@ func (w *RefreshEpisodeWorker) Work(ctx context.Context, job *river.Job[RefreshE
 		return fmt.Errorf("episode metadata refresh failed: %w", err)
 	}
 
+	// Stills are landscape frames and share the backdrop sizes.
+	if episode, err := w.service.GetEpisode(ctx, args.EpisodeID); err == nil {
+		if err := queueImagePlaceholder(ctx, w.jobClient, artwork.ContentTypeEpisode, episode.ID, image.TypeBackdrop, episode.StillPath, episode.ImagePlaceholders); err != nil {
+			w.logger.Warn("failed to queue episode still download",
+				slog.String("episode_id", args.EpisodeID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
 	w.logger.Info("episode metadata refresh completed",
 		slog.String("episode_id", args.EpisodeID.String()),
 	)
@@ -185,21 +326,24 @@ func (w *RefreshEpisodeWorker) Work(ctx context.Context, job *river.Job[RefreshE
 }
 
 // =============================================================================
-// Person Metadata Refresh Worker (stub — no person service yet)
+// Person Metadata Refresh Worker
 // =============================================================================
 
-// RefreshPersonWorker handles metadata_refresh_person jobs.
-// Currently a stub that logs and succeeds — will be implemented when
-// the person service is created.
+// RefreshPersonWorker handles metadata_refresh_person jobs by delegating
+// to the people service's RefreshMetadata method.
 type RefreshPersonWorker struct {
 	river.WorkerDefaults[RefreshPersonArgs]
-	logger *slog.Logger
+	service   *people.Service
+	jobClient *infrajobs.Client
+	logger    *slog.Logger
 }
 
 // NewRefreshPersonWorker creates a new person metadata refresh worker.
-func NewRefreshPersonWorker(logger *slog.Logger) *RefreshPersonWorker {
+func NewRefreshPersonWorker(service *people.Service, jobClient *infrajobs.Client, logger *slog.Logger) *RefreshPersonWorker {
 	return &RefreshPersonWorker{
-		logger: logger.With("component", "metadata_refresh_person"),
+		service:   service,
+		jobClient: jobClient,
+		logger:    logger.With("component", "metadata_refresh_person"),
 	}
 }
 
@@ -208,14 +352,46 @@ func (w *RefreshPersonWorker) Timeout(job *river.Job[RefreshPersonArgs]) time.Du
 	return 2 * time.Minute
 }
 
-// Work executes the person metadata refresh job.
-// Person service is not yet implemented — cancel the job so it doesn't retry.
+// Work executes the person metadata refresh job. Jobs for people that no
+// longer exist or cannot be looked up are cancelled instead of retried.
 func (w *RefreshPersonWorker) Work(ctx context.Context, job *river.Job[RefreshPersonArgs]) error {
-	w.logger.Warn("person metadata refresh not yet implemented — cancelling job",
+	args := job.Args
+
+	w.logger.Info("starting person metadata refresh",
 		slog.String("job_id", fmt.Sprintf("%d", job.ID)),
-		slog.String("provider_id", job.Args.ProviderID),
+		slog.String("person_id", args.PersonID.String()),
+	)
+
+	person, err := w.service.RefreshMetadata(ctx, args.PersonID, args.Languages)
+	if err != nil {
+		if errors.Is(err, people.ErrNotFound) ||
+			errors.Is(err, people.ErrNoProviderID) ||
+			errors.Is(err, metadata.ErrNoProviders) {
+			w.logger.Warn("cancelling person metadata refresh",
+				slog.String("person_id", args.PersonID.String()),
+				slog.Any("error", err),
+			)
+			return river.JobCancel(err)
+		}
+		w.logger.Error("person metadata refresh failed",
+			slog.String("person_id", args.PersonID.String()),
+			slog.Any("error", err),
+		)
+		return fmt.Errorf("person metadata refresh failed: %w", err)
+	}
+
+	if err := queueImagePlaceholder(ctx, w.jobClient, artwork.ContentTypePerson, person.ID, image.TypeProfile, person.ProfilePath, person.ImagePlaceholders); err != nil {
+		w.logger.Warn("failed to queue profile download",
+			slog.String("person_id", args.PersonID.String()),
+			slog.Any("error", err),
+		)
+	}
+
+	w.logger.Info("person metadata refresh completed",
+		slog.String("person_id", args.PersonID.String()),
+		slog.String("name", person.Name),
 	)
-	return river.JobCancel(fmt.Errorf("person metadata refresh not yet implemented"))
+	return nil
 }
 
 // =============================================================================
@@ -254,6 +430,7 @@ func (w *EnrichContentWorker) Timeout(job *river.Job[EnrichContentArgs]) time.Du
 
 // Work executes the content enrichment job.
 func (w *EnrichContentWorker) Work(ctx context.Context, job *river.Job[EnrichContentArgs]) error {
+	ctx = content.WithChangeSource(ctx, content.ChangeSourceRefresh, nil)
 	args := job.Args
 
 	w.logger.Info("starting content enrichment",
@@ -298,18 +475,34 @@ func (w *EnrichContentWorker) Work(ctx context.Context, job *river.Job[EnrichCon
 // =============================================================================
 
 // DownloadImageWorker handles metadata_download_image jobs.
-// It downloads images via the image service and stores them locally.
+// It fetches images into the local cache and computes their placeholders,
+// which are stored on the artwork record and the item the image belongs to.
 type DownloadImageWorker struct {
 	river.WorkerDefaults[DownloadImageArgs]
-	imageService *image.Service
-	logger       *slog.Logger
+	imageService   *image.Service
+	artworkService *artwork.Service
+	movies         movie.Service
+	tvshows        tvshow.Service
+	people         *people.Service
+	logger         *slog.Logger
 }
 
 // NewDownloadImageWorker creates a new image download worker.
-func NewDownloadImageWorker(imageService *image.Service, logger *slog.Logger) *DownloadImageWorker {
+func NewDownloadImageWorker(
+	imageService *image.Service,
+	artworkService *artwork.Service,
+	movies movie.Service,
+	tvshows tvshow.Service,
+	peopleService *people.Service,
+	logger *slog.Logger,
+) *DownloadImageWorker {
 	return &DownloadImageWorker{
-		imageService: imageService,
-		logger:       logger.With("component", "metadata_download_image"),
+		imageService:   imageService,
+		artworkService: artworkService,
+		movies:         movies,
+		tvshows:        tvshows,
+		people:         peopleService,
+		logger:         logger.With("component", "metadata_download_image"),
 	}
 }
 
@@ -318,7 +511,8 @@ func (w *DownloadImageWorker) Timeout(job *river.Job[DownloadImageArgs]) time.Du
 	return 2 * time.Minute
 }
 
-// Work executes the image download job by fetching the image into the local cache.
+// Work executes the image download job by fetching the image into the local
+// cache and computing its placeholder.
 func (w *DownloadImageWorker) Work(ctx context.Context, job *river.Job[DownloadImageArgs]) error {
 	args := job.Args
 
@@ -337,10 +531,111 @@ func (w *DownloadImageWorker) Work(ctx context.Context, job *river.Job[DownloadI
 		slog.String("size", args.Size),
 	)
 
-	_, _, err := w.imageService.FetchImage(ctx, args.ImageType, args.Path, args.Size)
+	data, local, err := w.fetch(ctx, args)
 	if err != nil {
 		return fmt.Errorf("download image %s/%s: %w", args.ImageType, args.Path, err)
 	}
 
+	if args.ArtworkID != uuid.Nil && w.artworkService != nil && !local {
+		if err := w.artworkService.MarkCached(ctx, args.ArtworkID, int64(len(data))); err != nil {
+			w.logger.Warn("failed to mark artwork cached",
+				slog.String("artwork_id", args.ArtworkID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	placeholder, err := image.ComputePlaceholder(data)
+	if err != nil {
+		w.logger.Warn("failed to compute image placeholder",
+			slog.String("path", args.Path),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+	w.storePlaceholder(ctx, args, *placeholder)
+
 	return nil
 }
+
+// queueImagePlaceholder queues the download of an item image that has no
+// placeholder yet. The download computes and stores the placeholder.
+func queueImagePlaceholder(ctx context.Context, jobClient *infrajobs.Client, contentType string, id uuid.UUID, imageType string, path *string, placeholders content.ImagePlaceholders) error {
+	if jobClient == nil || path == nil || *path == "" || placeholders.For(path) != nil {
+		return nil
+	}
+	_, err := jobClient.Insert(ctx, DownloadImageArgs{
+		ContentType: contentType,
+		ContentID:   id.String(),
+		ImageType:   imageType,
+		Path:        *path,
+	}, nil)
+	return err
+}
+
+// fetch returns the image data of a job and whether it is local artwork
+// read from disk.
+func (w *DownloadImageWorker) fetch(ctx context.Context, args DownloadImageArgs) ([]byte, bool, error) {
+	artworkID, ok := artwork.ParseLocalPath(args.Path)
+	if !ok {
+		data, _, err := w.imageService.FetchImage(ctx, args.ImageType, args.Path, args.Size)
+		return data, false, err
+	}
+	if w.artworkService == nil {
+		return nil, true, fmt.Errorf("local artwork %s: no artwork service", artworkID)
+	}
+	art, err := w.artworkService.Get(ctx, artworkID)
+	if err != nil {
+		return nil, true, fmt.Errorf("get local artwork: %w", err)
+	}
+	data, _, err := w.imageService.FetchLocalImage(ctx, args.ImageType, art.Path, args.Size)
+	return data, true, err
+}
+
+// storePlaceholder stores a computed placeholder on the artwork record and
+// on the item the image belongs to. Failures are logged, the image itself
+// is cached either way.
+func (w *DownloadImageWorker) storePlaceholder(ctx context.Context, args DownloadImageArgs, placeholder blurhash.Placeholder) {
+	if args.ArtworkID != uuid.Nil && w.artworkService != nil {
+		if err := w.artworkService.SetPlaceholder(ctx, args.ArtworkID, placeholder); err != nil {
+			w.logger.Warn("failed to store artwork placeholder",
+				slog.String("artwork_id", args.ArtworkID.String()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	id, err := uuid.Parse(args.ContentID)
+	if err != nil {
+		return
+	}
+	switch args.ContentType {
+	case artwork.ContentTypeMovie:
+		if w.movies != nil {
+			err = w.movies.SetImagePlaceholder(ctx, id, args.Path, placeholder)
+		}
+	case artwork.ContentTypeSeries:
+		if w.tvshows != nil {
+			err = w.tvshows.SetSeriesImagePlaceholder(ctx, id, args.Path, placeholder)
+		}
+	case artwork.ContentTypeSeason:
+		if w.tvshows != nil {
+			err = w.tvshows.SetSeasonImagePlaceholder(ctx, id, args.Path, placeholder)
+		}
+	case artwork.ContentTypeEpisode:
+		if w.tvshows != nil {
+			err = w.tvshows.SetEpisodeImagePlaceholder(ctx, id, args.Path, placeholder)
+		}
+	case artwork.ContentTypePerson:
+		if w.people != nil {
+			err = w.people.SetImagePlaceholder(ctx, id, args.Path, placeholder)
+		}
+	}
+	if err != nil {
+		w.logger.Warn("failed to store image placeholder",
+			slog.String("content_type", args.ContentType),
+			slog.String("content_id", args.ContentID),
+			slog.Any("error", err),
+		)
+	}
+}
@@ -2,13 +2,20 @@ package jobs
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/infra/image"
+	"github.com/lusoris/revenge/internal/service/metadata"
+	"github.com/lusoris/revenge/internal/service/people"
 	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -40,7 +47,7 @@ func TestDownloadImageWorker_Work(t *testing.T) {
 		}))
 		defer srv.Close()
 
-		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), slog.Default())
+		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), nil, nil, nil, nil, slog.Default())
 
 		err := worker.Work(context.Background(), &river.Job[DownloadImageArgs]{
 			Args: DownloadImageArgs{
@@ -64,7 +71,7 @@ func TestDownloadImageWorker_Work(t *testing.T) {
 		}))
 		defer srv.Close()
 
-		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), slog.Default())
+		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), nil, nil, nil, nil, slog.Default())
 
 		err := worker.Work(context.Background(), &river.Job[DownloadImageArgs]{
 			Args: DownloadImageArgs{
@@ -87,7 +94,7 @@ func TestDownloadImageWorker_Work(t *testing.T) {
 		}))
 		defer srv.Close()
 
-		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), slog.Default())
+		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), nil, nil, nil, nil, slog.Default())
 
 		err := worker.Work(context.Background(), &river.Job[DownloadImageArgs]{
 			Args: DownloadImageArgs{
@@ -113,7 +120,7 @@ func TestDownloadImageWorker_Work(t *testing.T) {
 		}))
 		defer srv.Close()
 
-		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), slog.Default())
+		worker := NewDownloadImageWorker(newTestImageService(t, srv.URL), nil, nil, nil, nil, slog.Default())
 
 		err := worker.Work(context.Background(), &river.Job[DownloadImageArgs]{
 			Args: DownloadImageArgs{
@@ -129,3 +136,92 @@ func TestDownloadImageWorker_Work(t *testing.T) {
 		assert.Contains(t, err.Error(), "download image")
 	})
 }
+
+// stubPeopleRepository serves people from a map.
+type stubPeopleRepository struct {
+	people.Repository
+	people map[uuid.UUID]*people.Person
+}
+
+func (r *stubPeopleRepository) Get(_ context.Context, id uuid.UUID) (*people.Person, error) {
+	if p, ok := r.people[id]; ok {
+		return p, nil
+	}
+	return nil, people.ErrNotFound
+}
+
+// stubPersonProvider returns no person metadata.
+type stubPersonProvider struct{}
+
+func (stubPersonProvider) GetPersonMetadata(context.Context, string, []string) (*metadata.PersonMetadata, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func (stubPersonProvider) GetPersonImages(context.Context, string) (*metadata.Images, error) {
+	return nil, errors.New("provider unavailable")
+}
+
+func TestRefreshPersonWorker_Work(t *testing.T) {
+	t.Parallel()
+
+	tmdbID := int32(1158)
+	known := uuid.Must(uuid.NewV7())
+	unlinked := uuid.Must(uuid.NewV7())
+	repo := &stubPeopleRepository{people: map[uuid.UUID]*people.Person{
+		known:    {ID: known, TMDbID: &tmdbID},
+		unlinked: {ID: unlinked},
+	}}
+	worker := NewRefreshPersonWorker(people.NewService(repo, stubPersonProvider{}, nil, slog.Default()), nil, slog.Default())
+
+	work := func(id uuid.UUID) error {
+		return worker.Work(context.Background(), &river.Job[RefreshPersonArgs]{
+			JobRow: &rivertype.JobRow{ID: 1},
+			Args:   RefreshPersonArgs{PersonID: id},
+		})
+	}
+
+	t.Run("cancels missing person", func(t *testing.T) {
+		t.Parallel()
+		var cancelErr *river.JobCancelError
+		require.ErrorAs(t, work(uuid.Must(uuid.NewV7())), &cancelErr)
+	})
+
+	t.Run("cancels person without provider ID", func(t *testing.T) {
+		t.Parallel()
+		var cancelErr *river.JobCancelError
+		require.ErrorAs(t, work(unlinked), &cancelErr)
+	})
+
+	t.Run("retries provider failures", func(t *testing.T) {
+		t.Parallel()
+		err := work(known)
+		require.Error(t, err)
+		var cancelErr *river.JobCancelError
+		assert.False(t, errors.As(err, &cancelErr))
+	})
+}
+
+func TestEpisodeNeedsRefresh(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	complete := func(airDate time.Time) tvshow.Episode {
+		return tvshow.Episode{AirDate: &airDate, Overview: new("Pilot"), StillPath: new("/still.jpg")}
+	}
+
+	tests := []struct {
+		name    string
+		episode tvshow.Episode
+		want    bool
+	}{
+		{"aired long ago and complete", complete(now.AddDate(-1, 0, 0)), false},
+		{"aired recently", complete(now.AddDate(0, 0, -7)), true},
+		{"not aired yet", complete(now.AddDate(0, 0, 7)), true},
+		{"no air date", tvshow.Episode{Overview: new("Pilot"), StillPath: new("/still.jpg")}, true},
+		{"missing overview", tvshow.Episode{AirDate: new(now.AddDate(-1, 0, 0)), StillPath: new("/still.jpg")}, true},
+		{"missing still", tvshow.Episode{AirDate: new(now.AddDate(-1, 0, 0)), Overview: new("Pilot")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, episodeNeedsRefresh(tt.episode, now))
+		})
+	}
+}
@@ -3,9 +3,11 @@ package metadatafx
 
 import (
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/content/musicvideo"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/lusoris/revenge/internal/service/metadata"
 	movieadapter "github.com/lusoris/revenge/internal/service/metadata/adapters/movie"
+	musicvideoadapter "github.com/lusoris/revenge/internal/service/metadata/adapters/musicvideo"
 	tvshowadapter "github.com/lusoris/revenge/internal/service/metadata/adapters/tvshow"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/anidb"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/anilist"
@@ -13,6 +15,7 @@ import (
 	"github.com/lusoris/revenge/internal/service/metadata/providers/kitsu"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/letterboxd"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/mal"
+	"github.com/lusoris/revenge/internal/service/metadata/providers/musicbrainz"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/omdb"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/simkl"
 	"github.com/lusoris/revenge/internal/service/metadata/providers/tmdb"
@@ -79,46 +82,53 @@ type Config struct {
 	LetterboxdEnabled   bool
 	LetterboxdAPIKey    string
 	LetterboxdAPISecret string
+
+	// MusicBrainz configuration (optional, no API key needed)
+	MusicBrainzEnabled bool
+	MusicBrainzContact string
 }
 
 // ModuleParams contains parameters for the metadata module.
 type ModuleParams struct {
 	fx.In
 
-	Config           Config            `optional:"true"`
-	TMDbConfig       tmdb.Config       `optional:"true"`
-	TVDbConfig       tvdb.Config       `optional:"true"`
-	FanartTVConfig   fanarttv.Config   `optional:"true"`
-	OMDbConfig       omdb.Config       `optional:"true"`
-	TVmazeConfig     tvmaze.Config     `optional:"true"`
-	AniListConfig    anilist.Config    `optional:"true"`
-	KitsuConfig      kitsu.Config      `optional:"true"`
-	AniDBConfig      anidb.Config      `optional:"true"`
-	MALConfig        mal.Config        `optional:"true"`
-	TraktConfig      trakt.Config      `optional:"true"`
-	SimklConfig      simkl.Config      `optional:"true"`
-	LetterboxdConfig letterboxd.Config `optional:"true"`
+	Config            Config             `optional:"true"`
+	TMDbConfig        tmdb.Config        `optional:"true"`
+	TVDbConfig        tvdb.Config        `optional:"true"`
+	FanartTVConfig    fanarttv.Config    `optional:"true"`
+	OMDbConfig        omdb.Config        `optional:"true"`
+	TVmazeConfig      tvmaze.Config      `optional:"true"`
+	AniListConfig     anilist.Config     `optional:"true"`
+	KitsuConfig       kitsu.Config       `optional:"true"`
+	AniDBConfig       anidb.Config       `optional:"true"`
+	MALConfig         mal.Config         `optional:"true"`
+	TraktConfig       trakt.Config       `optional:"true"`
+	SimklConfig       simkl.Config       `optional:"true"`
+	LetterboxdConfig  letterboxd.Config  `optional:"true"`
+	MusicBrainzConfig musicbrainz.Config `optional:"true"`
 }
 
 // ModuleResult contains the provided services.
 type ModuleResult struct {
 	fx.Out
 
-	Service               metadata.Service
-	MovieMetadataAdapter  movie.MetadataProvider
-	TVShowMetadataAdapter tvshow.MetadataProvider
-	TMDbProvider          *tmdb.Provider       `optional:"true"`
-	TVDbProvider          *tvdb.Provider       `optional:"true"`
-	FanartTVProvider      *fanarttv.Provider   `optional:"true"`
-	OMDbProvider          *omdb.Provider       `optional:"true"`
-	TVmazeProvider        *tvmaze.Provider     `optional:"true"`
-	AniListProvider       *anilist.Provider    `optional:"true"`
-	KitsuProvider         *kitsu.Provider      `optional:"true"`
-	AniDBProvider         *anidb.Provider      `optional:"true"`
-	MALProvider           *mal.Provider        `optional:"true"`
-	TraktProvider         *trakt.Provider      `optional:"true"`
-	SimklProvider         *simkl.Provider      `optional:"true"`
-	LetterboxdProvider    *letterboxd.Provider `optional:"true"`
+	Service                   metadata.Service
+	MovieMetadataAdapter      movie.MetadataProvider
+	TVShowMetadataAdapter     tvshow.MetadataProvider
+	MusicVideoMetadataAdapter musicvideo.MetadataProvider
+	TMDbProvider              *tmdb.Provider        `optional:"true"`
+	TVDbProvider              *tvdb.Provider        `optional:"true"`
+	FanartTVProvider          *fanarttv.Provider    `optional:"true"`
+	OMDbProvider              *omdb.Provider        `optional:"true"`
+	TVmazeProvider            *tvmaze.Provider      `optional:"true"`
+	AniListProvider           *anilist.Provider     `optional:"true"`
+	KitsuProvider             *kitsu.Provider       `optional:"true"`
+	AniDBProvider             *anidb.Provider       `optional:"true"`
+	MALProvider               *mal.Provider         `optional:"true"`
+	TraktProvider             *trakt.Provider       `optional:"true"`
+	SimklProvider             *simkl.Provider       `optional:"true"`
+	LetterboxdProvider        *letterboxd.Provider  `optional:"true"`
+	MusicBrainzProvider       *musicbrainz.Provider `optional:"true"`
 }
 
 // NewModule creates a new metadata service with providers.
@@ -352,11 +362,30 @@ func NewModule(params ModuleParams) (ModuleResult, error) {
 		result.LetterboxdProvider = letterboxdProvider
 	}
 
+	// Create and register MusicBrainz provider if enabled (no API key needed).
+	// MusicBrainz asks clients to identify themselves with a contact.
+	if params.MusicBrainzConfig.Enabled || params.Config.MusicBrainzEnabled {
+		musicbrainzConfig := params.MusicBrainzConfig
+		if !musicbrainzConfig.Enabled {
+			musicbrainzConfig.Enabled = true
+		}
+		if musicbrainzConfig.UserAgent == "" {
+			musicbrainzConfig.UserAgent = musicbrainz.UserAgentWithContact(params.Config.MusicBrainzContact)
+		}
+		musicbrainzProvider, err := musicbrainz.NewProvider(musicbrainzConfig)
+		if err != nil {
+			return ModuleResult{}, err
+		}
+		svc.RegisterProvider(musicbrainzProvider)
+		result.MusicBrainzProvider = musicbrainzProvider
+	}
+
 	result.Service = svc
 
 	// Create adapters using the service
 	result.MovieMetadataAdapter = movieadapter.NewAdapter(svc, serviceConfig.DefaultLanguages)
 	result.TVShowMetadataAdapter = tvshowadapter.NewAdapter(svc, serviceConfig.DefaultLanguages)
+	result.MusicVideoMetadataAdapter = musicvideoadapter.NewAdapter(svc)
 
 	return result, nil
 }
@@ -0,0 +1,259 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Field is a group of metadata fields that can be sourced from its own
+// provider chain.
+type Field string
+
+const (
+	// FieldTitle covers titles, original titles and localized titles.
+	FieldTitle Field = "title"
+	// FieldOverview covers overviews and taglines.
+	FieldOverview Field = "overview"
+	// FieldEpisodes covers season and episode metadata.
+	FieldEpisodes Field = "episodes"
+	// FieldImages covers posters, backdrops, logos and stills.
+	FieldImages Field = "images"
+	// FieldRatings covers vote averages, vote counts and external ratings.
+	FieldRatings Field = "ratings"
+	// FieldCast covers cast and crew credits.
+	FieldCast Field = "cast"
+	// FieldTags covers keywords and tags.
+	FieldTags Field = "tags"
+)
+
+// fieldAny selects the default chain of a policy, for data that is not
+// tied to a configurable field.
+const fieldAny Field = ""
+
+// Fields lists all fields a provider policy can configure.
+var Fields = []Field{FieldTitle, FieldOverview, FieldEpisodes, FieldImages, FieldRatings, FieldCast, FieldTags}
+
+// knownProviders lists the provider IDs a policy may reference.
+var knownProviders = []ProviderID{
+	ProviderTMDb, ProviderTVDb, ProviderFanartTV, ProviderOMDb, ProviderTVmaze,
+	ProviderAniList, ProviderKitsu, ProviderAniDB, ProviderMAL, ProviderTrakt,
+	ProviderSimkl, ProviderLetterboxd,
+}
+
+// ProviderPolicy configures provider ordering for a library. Providers is the
+// default chain; Fields overrides the chain for individual fields. Providers
+// missing from a chain are tried after it, in global priority order.
+type ProviderPolicy struct {
+	Providers []ProviderID           `json:"providers,omitempty"`
+	Fields    map[Field][]ProviderID `json:"fields,omitempty"`
+
+	// EnableProviderFallback and EnableEnrichment override the service
+	// configuration when set.
+	EnableProviderFallback *bool `json:"enable_provider_fallback,omitempty"`
+	EnableEnrichment       *bool `json:"enable_enrichment,omitempty"`
+}
+
+// IsZero reports whether the policy configures nothing.
+func (p ProviderPolicy) IsZero() bool {
+	return len(p.Providers) == 0 && len(p.Fields) == 0 &&
+		p.EnableProviderFallback == nil && p.EnableEnrichment == nil
+}
+
+// Chain returns the provider chain for a field.
+func (p ProviderPolicy) Chain(field Field) []ProviderID {
+	if chain := p.Fields[field]; len(chain) > 0 {
+		return chain
+	}
+	return p.Providers
+}
+
+// Validate checks that the policy only references known fields and providers.
+func (p ProviderPolicy) Validate() error {
+	if err := validateChain(p.Providers); err != nil {
+		return err
+	}
+	for field, chain := range p.Fields {
+		if !slices.Contains(Fields, field) {
+			return fmt.Errorf("%w: unknown field %q", ErrInvalidPolicy, field)
+		}
+		if err := validateChain(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateChain(chain []ProviderID) error {
+	for i, id := range chain {
+		if !slices.Contains(knownProviders, id) {
+			return fmt.Errorf("%w: unknown provider %q", ErrInvalidPolicy, id)
+		}
+		if slices.Contains(chain[:i], id) {
+			return fmt.Errorf("%w: provider %q listed twice", ErrInvalidPolicy, id)
+		}
+	}
+	return nil
+}
+
+// FieldSources records which provider each field was taken from.
+type FieldSources map[Field]ProviderID
+
+// Strings returns the sources as a plain string map for storage.
+func (s FieldSources) Strings() map[string]string {
+	if len(s) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(s))
+	for field, provider := range s {
+		out[string(field)] = string(provider)
+	}
+	return out
+}
+
+type policyKey struct{}
+
+type providerIDsKey struct{}
+
+// WithProviderPolicy returns a context that applies the policy to metadata
+// service calls made with it.
+func WithProviderPolicy(ctx context.Context, policy ProviderPolicy) context.Context {
+	return context.WithValue(ctx, policyKey{}, policy)
+}
+
+// ProviderPolicyFromContext returns the provider policy attached to ctx.
+func ProviderPolicyFromContext(ctx context.Context) (ProviderPolicy, bool) {
+	policy, ok := ctx.Value(policyKey{}).(ProviderPolicy)
+	return policy, ok
+}
+
+// WithProviderIDs returns a context carrying the item's IDs per provider.
+// Service calls made with it query each provider with its own ID and skip
+// providers the item has no ID for.
+func WithProviderIDs(ctx context.Context, ids map[ProviderID]string) context.Context {
+	return context.WithValue(ctx, providerIDsKey{}, ids)
+}
+
+// ProviderIDsFromContext returns the provider IDs attached to ctx.
+func ProviderIDsFromContext(ctx context.Context) (map[ProviderID]string, bool) {
+	ids, ok := ctx.Value(providerIDsKey{}).(map[ProviderID]string)
+	return ids, ok
+}
+
+// fallbackEnabled reports whether secondary providers are tried on failure.
+func (s *service) fallbackEnabled(ctx context.Context) bool {
+	if policy, ok := ProviderPolicyFromContext(ctx); ok && policy.EnableProviderFallback != nil {
+		return *policy.EnableProviderFallback
+	}
+	return s.config.EnableProviderFallback
+}
+
+// enrichmentEnabled reports whether secondary providers enrich results.
+func (s *service) enrichmentEnabled(ctx context.Context) bool {
+	if policy, ok := ProviderPolicyFromContext(ctx); ok && policy.EnableEnrichment != nil {
+		return *policy.EnableEnrichment
+	}
+	return s.config.EnableEnrichment
+}
+
+// chainFor orders providers by the field chain of the policy in ctx. Without
+// a policy the global priority order is kept.
+func chainFor[P Provider](ctx context.Context, providers []P, field Field) []P {
+	policy, ok := ProviderPolicyFromContext(ctx)
+	if !ok {
+		return providers
+	}
+	chain := policy.Chain(field)
+	if len(chain) == 0 {
+		return providers
+	}
+
+	ordered := make([]P, 0, len(providers))
+	for _, id := range chain {
+		for _, p := range providers {
+			if p.ID() == id {
+				ordered = append(ordered, p)
+			}
+		}
+	}
+	for _, p := range providers {
+		if !slices.Contains(chain, p.ID()) {
+			ordered = append(ordered, p)
+		}
+	}
+	return ordered
+}
+
+// providerItemID returns the ID to query provider p with. Without provider
+// IDs in ctx every provider gets id.
+func providerItemID(ctx context.Context, p ProviderID, id string) (string, bool) {
+	ids, ok := ProviderIDsFromContext(ctx)
+	if !ok {
+		return id, true
+	}
+	pid, ok := ids[p]
+	return pid, ok && pid != ""
+}
+
+// firstInChain calls fn on the providers of the field chain until one
+// succeeds. Secondary providers are only tried under a provider policy with
+// fallback enabled; otherwise the first provider with an ID decides.
+func firstInChain[P Provider, T any](ctx context.Context, s *service, providers []P, field Field, id string, fn func(P, string) (T, error)) (T, ProviderID, error) {
+	_, hasPolicy := ProviderPolicyFromContext(ctx)
+	fallback := hasPolicy && s.fallbackEnabled(ctx)
+
+	var zero T
+	var aggErr AggregateError
+	for _, p := range chainFor(ctx, providers, field) {
+		pid, ok := providerItemID(ctx, p.ID(), id)
+		if !ok {
+			continue
+		}
+		result, err := fn(p, pid)
+		if err == nil {
+			return result, p.ID(), nil
+		}
+		aggErr.Add(err)
+		if !fallback {
+			break
+		}
+	}
+
+	if aggErr.IsEmpty() {
+		return zero, "", ErrNotFound
+	}
+	return zero, "", aggErr.First()
+}
+
+// allImages calls fn on every provider of the images chain that has an ID
+// and collects the images found. Providers without images are skipped; an
+// error is only returned when no provider has any.
+func allImages[P Provider](ctx context.Context, providers []P, id string, fn func(P, string) (*Images, error)) ([]ProviderImages, error) {
+	var result []ProviderImages
+	var aggErr AggregateError
+	for _, p := range chainFor(ctx, providers, FieldImages) {
+		pid, ok := providerItemID(ctx, p.ID(), id)
+		if !ok {
+			continue
+		}
+		images, err := fn(p, pid)
+		if err != nil {
+			if !errors.Is(err, ErrNotFound) {
+				aggErr.Add(err)
+			}
+			continue
+		}
+		if images != nil {
+			result = append(result, ProviderImages{Provider: p.ID(), Images: images})
+		}
+	}
+
+	if len(result) > 0 {
+		return result, nil
+	}
+	if aggErr.IsEmpty() {
+		return nil, ErrNotFound
+	}
+	return nil, aggErr.First()
+}
@@ -0,0 +1,223 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTVProvider serves shows from memory. Methods not overridden panic.
+type fakeTVProvider struct {
+	TVShowProvider
+	id       ProviderID
+	priority int
+	shows    map[string]*TVShowMetadata
+	images   *Images
+	results  []TVShowSearchResult
+	calls    []string
+}
+
+func (p *fakeTVProvider) ID() ProviderID        { return p.id }
+func (p *fakeTVProvider) Name() string          { return string(p.id) }
+func (p *fakeTVProvider) Priority() int         { return p.priority }
+func (p *fakeTVProvider) SupportsMovies() bool  { return false }
+func (p *fakeTVProvider) SupportsTVShows() bool { return true }
+
+func (p *fakeTVProvider) SearchTVShow(_ context.Context, _ string, _ SearchOptions) ([]TVShowSearchResult, error) {
+	return p.results, nil
+}
+
+func (p *fakeTVProvider) GetTVShow(_ context.Context, id, _ string) (*TVShowMetadata, error) {
+	p.calls = append(p.calls, id)
+	show, ok := p.shows[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *show
+	return &copied, nil
+}
+
+func (p *fakeTVProvider) GetTVShowImages(_ context.Context, id string) (*Images, error) {
+	p.calls = append(p.calls, id)
+	if p.images == nil {
+		return nil, ErrNotFound
+	}
+	return p.images, nil
+}
+
+func newPolicyTestService(fallback bool) (*service, *fakeTVProvider, *fakeTVProvider) {
+	tmdb := &fakeTVProvider{
+		id:       ProviderTMDb,
+		priority: 100,
+		shows: map[string]*TVShowMetadata{"37854": {
+			ProviderID: "37854", Provider: ProviderTMDb, Name: "One Piece",
+			PosterPath: new("/tmdb-poster.jpg"), VoteAverage: 8.7,
+		}},
+	}
+	anilist := &fakeTVProvider{
+		id:       ProviderAniList,
+		priority: 50,
+		shows: map[string]*TVShowMetadata{"21": {
+			ProviderID: "21", Provider: ProviderAniList, Name: "ONE PIECE",
+			Overview: new("Gold Roger was known as the Pirate King."),
+		}},
+	}
+	svc := NewService(ServiceConfig{DefaultLanguages: []string{"en"}, EnableProviderFallback: fallback})
+	svc.RegisterProvider(tmdb)
+	svc.RegisterProvider(anilist)
+	return svc, tmdb, anilist
+}
+
+func TestProviderPolicy_Chain(t *testing.T) {
+	policy := ProviderPolicy{
+		Providers: []ProviderID{ProviderAniList, ProviderTMDb},
+		Fields:    map[Field][]ProviderID{FieldImages: {ProviderTMDb}},
+	}
+
+	assert.Equal(t, []ProviderID{ProviderAniList, ProviderTMDb}, policy.Chain(FieldTitle))
+	assert.Equal(t, []ProviderID{ProviderTMDb}, policy.Chain(FieldImages))
+	assert.False(t, policy.IsZero())
+	assert.True(t, ProviderPolicy{}.IsZero())
+}
+
+func TestProviderPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ProviderPolicy
+		wantErr bool
+	}{
+		{name: "empty", policy: ProviderPolicy{}},
+		{name: "valid", policy: ProviderPolicy{
+			Providers: []ProviderID{ProviderAniList, ProviderAniDB, ProviderTMDb},
+			Fields:    map[Field][]ProviderID{FieldImages: {ProviderTMDb, ProviderFanartTV}},
+		}},
+		{name: "unknown provider", policy: ProviderPolicy{Providers: []ProviderID{"imdb"}}, wantErr: true},
+		{name: "duplicate provider", policy: ProviderPolicy{Providers: []ProviderID{ProviderTMDb, ProviderTMDb}}, wantErr: true},
+		{name: "unknown field", policy: ProviderPolicy{Fields: map[Field][]ProviderID{"budget": {ProviderTMDb}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPolicy)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestChainFor(t *testing.T) {
+	svc, tmdb, anilist := newPolicyTestService(true)
+	providers := svc.tvProviders
+
+	assert.Equal(t, []TVShowProvider{tmdb, anilist}, chainFor(context.Background(), providers, FieldTitle))
+
+	ctx := WithProviderPolicy(context.Background(), ProviderPolicy{
+		Fields: map[Field][]ProviderID{FieldTitle: {ProviderAniList}},
+	})
+	assert.Equal(t, []TVShowProvider{anilist, tmdb}, chainFor(ctx, providers, FieldTitle))
+	assert.Equal(t, []TVShowProvider{tmdb, anilist}, chainFor(ctx, providers, FieldImages))
+}
+
+func TestGetTVShowMetadata_ProviderPolicy(t *testing.T) {
+	ids := map[ProviderID]string{ProviderTMDb: "37854", ProviderAniList: "21"}
+
+	t.Run("without policy uses the primary provider", func(t *testing.T) {
+		svc, _, anilist := newPolicyTestService(true)
+		ctx := WithProviderIDs(context.Background(), ids)
+
+		result, err := svc.GetTVShowMetadata(ctx, "37854", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "One Piece", result.Name)
+		assert.Equal(t, ProviderTMDb, result.Sources[FieldTitle])
+		assert.Equal(t, ProviderTMDb, result.Sources[FieldImages])
+		assert.Empty(t, anilist.calls)
+	})
+
+	t.Run("fields follow their chains", func(t *testing.T) {
+		svc, _, _ := newPolicyTestService(true)
+		ctx := WithProviderIDs(context.Background(), ids)
+		ctx = WithProviderPolicy(ctx, ProviderPolicy{
+			Providers: []ProviderID{ProviderAniList, ProviderTMDb},
+			Fields: map[Field][]ProviderID{
+				FieldImages:  {ProviderTMDb},
+				FieldRatings: {ProviderTMDb},
+			},
+		})
+
+		result, err := svc.GetTVShowMetadata(ctx, "37854", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "ONE PIECE", result.Name)
+		assert.Equal(t, "Gold Roger was known as the Pirate King.", *result.Overview)
+		assert.Equal(t, "/tmdb-poster.jpg", *result.PosterPath)
+		assert.InDelta(t, 8.7, result.VoteAverage, 0.001)
+		assert.Equal(t, FieldSources{
+			FieldTitle:    ProviderAniList,
+			FieldOverview: ProviderAniList,
+			FieldImages:   ProviderTMDb,
+			FieldRatings:  ProviderTMDb,
+			FieldTags:     ProviderAniList,
+		}, result.Sources)
+		assert.Equal(t, "37854", result.ResolvedIDs[ProviderTMDb])
+	})
+
+	t.Run("fallback follows the policy", func(t *testing.T) {
+		svc, _, anilist := newPolicyTestService(false)
+		delete(anilist.shows, "21")
+		ctx := WithProviderIDs(context.Background(), ids)
+		policy := ProviderPolicy{Providers: []ProviderID{ProviderAniList, ProviderTMDb}}
+
+		_, err := svc.GetTVShowMetadata(WithProviderPolicy(ctx, policy), "37854", nil)
+		assert.True(t, errors.Is(err, ErrNotFound))
+
+		policy.EnableProviderFallback = new(true)
+		result, err := svc.GetTVShowMetadata(WithProviderPolicy(ctx, policy), "37854", nil)
+		require.NoError(t, err)
+		assert.Equal(t, ProviderTMDb, result.Sources[FieldTitle])
+	})
+
+	t.Run("resolves missing provider IDs by name", func(t *testing.T) {
+		svc, _, anilist := newPolicyTestService(true)
+		anilist.results = []TVShowSearchResult{{ProviderID: "21", Provider: ProviderAniList, Name: "One Piece"}}
+		ctx := WithProviderIDs(context.Background(), map[ProviderID]string{ProviderTMDb: "37854"})
+		ctx = WithProviderPolicy(ctx, ProviderPolicy{
+			Fields: map[Field][]ProviderID{FieldOverview: {ProviderAniList}},
+		})
+
+		result, err := svc.GetTVShowMetadata(ctx, "37854", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "One Piece", result.Name)
+		assert.Equal(t, ProviderAniList, result.Sources[FieldOverview])
+		assert.Equal(t, "21", result.ResolvedIDs[ProviderAniList])
+	})
+}
+
+func TestGetAllTVShowImages(t *testing.T) {
+	svc, tmdb, anilist := newPolicyTestService(false)
+	tmdb.images = &Images{Posters: []Image{{FilePath: "/tmdb-poster.jpg"}}}
+	anilist.images = &Images{Posters: []Image{{FilePath: "https://anilist.co/poster.jpg"}}}
+
+	ctx := WithProviderIDs(context.Background(), map[ProviderID]string{ProviderTMDb: "37854", ProviderAniList: "21"})
+	sets, err := svc.GetAllTVShowImages(ctx, "37854")
+	require.NoError(t, err)
+	require.Len(t, sets, 2)
+	assert.Equal(t, ProviderTMDb, sets[0].Provider)
+	assert.Equal(t, ProviderAniList, sets[1].Provider)
+	assert.Equal(t, []string{"21"}, anilist.calls)
+
+	anilist.images = nil
+	ctx = WithProviderIDs(context.Background(), map[ProviderID]string{ProviderTMDb: "37854"})
+	sets, err = svc.GetAllTVShowImages(ctx, "37854")
+	require.NoError(t, err)
+	require.Len(t, sets, 1)
+	assert.Equal(t, ProviderTMDb, sets[0].Provider)
+
+	tmdb.images = nil
+	_, err = svc.GetAllTVShowImages(ctx, "37854")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -32,6 +32,8 @@ const (
 	ProviderSimkl ProviderID = "simkl"
 	// ProviderLetterboxd is Letterboxd provider (movie-focused community ratings and reviews).
 	ProviderLetterboxd ProviderID = "letterboxd"
+	// ProviderMusicBrainz is MusicBrainz provider (music recordings and artists, no auth required).
+	ProviderMusicBrainz ProviderID = "musicbrainz"
 )
 
 // Provider represents an external metadata provider.
@@ -184,6 +186,17 @@ type CollectionProvider interface {
 	GetCollectionImages(ctx context.Context, id string) (*Images, error)
 }
 
+// MusicProvider extends Provider with music recording methods.
+type MusicProvider interface {
+	Provider
+
+	// SearchRecording searches for recordings by artist and title.
+	SearchRecording(ctx context.Context, artist, title string, opts SearchOptions) ([]RecordingSearchResult, error)
+
+	// GetRecording retrieves a recording with its artist credit by provider ID.
+	GetRecording(ctx context.Context, id string) (*RecordingSearchResult, error)
+}
+
 // SearchOptions configures metadata search queries.
 type SearchOptions struct {
 	// ProviderID selects a specific provider for the search.
@@ -100,8 +100,15 @@ func mapAnimeToTVShowMetadata(a *AnimeResponse) *metadata.TVShowMetadata {
 		m.Homepage = &a.URL
 	}
 
-	// Tags → Genres (use non-spoiler, high-weight tags)
+	// Tags → Genres (use non-spoiler, high-weight tags); all weighted tags
+	// are kept as tags
 	for _, tag := range a.Tags.Tag {
+		if tag.Weight > 0 {
+			m.Tags = append(m.Tags, metadata.Tag{
+				Name:    tag.Name,
+				Spoiler: tag.GlobalSpoiler || tag.LocalSpoiler,
+			})
+		}
 		if tag.GlobalSpoiler || tag.LocalSpoiler || tag.Weight < 200 {
 			continue
 		}
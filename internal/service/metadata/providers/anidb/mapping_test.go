@@ -225,6 +225,12 @@ func TestMapAnimeToTVShowMetadata(t *testing.T) {
 		// Tags: only "action" survives filtering
 		require.Len(t, result.Genres, 1)
 		assert.Equal(t, "action", result.Genres[0].Name)
+		// All weighted tags are kept, spoilers flagged
+		assert.Equal(t, []metadata.Tag{
+			{Name: "action"},
+			{Name: "low-weight"},
+			{Name: "spoiler", Spoiler: true},
+		}, result.Tags)
 		// Studios
 		require.Len(t, result.Networks, 1)
 		assert.Equal(t, "Wit Studio", result.Networks[0].Name)
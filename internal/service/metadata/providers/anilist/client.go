@@ -290,6 +290,8 @@ query ($id: Int, $type: MediaType) {
       category
       rank
       isAdult
+      isGeneralSpoiler
+      isMediaSpoiler
     }
     averageScore
     meanScore
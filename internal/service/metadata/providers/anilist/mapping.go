@@ -165,6 +165,14 @@ func mapMediaToTVShowMetadata(m *Media) *metadata.TVShowMetadata {
 		})
 	}
 
+	// Tags
+	for _, t := range m.Tags {
+		md.Tags = append(md.Tags, metadata.Tag{
+			Name:    t.Name,
+			Spoiler: t.IsGeneralSpoiler || t.IsMediaSpoiler,
+		})
+	}
+
 	// Studios → Networks
 	for _, edge := range m.Studios.Edges {
 		n := metadata.Network{
@@ -279,7 +279,11 @@ func TestMapMediaToTVShowMetadata(t *testing.T) {
 			SiteURL:         "https://anilist.co/anime/12345",
 			Trailer:         &Trailer{ID: new("abc123"), Site: new("youtube")},
 			Genres:          []string{"Action"},
-			Studios:         StudioConnection{Edges: []StudioEdge{{Node: Studio{ID: 1, Name: "WIT"}}}},
+			Tags: []MediaTag{
+				{ID: 1, Name: "Military", Rank: 90},
+				{ID: 2, Name: "Tragedy", Rank: 80, IsMediaSpoiler: true},
+			},
+			Studios: StudioConnection{Edges: []StudioEdge{{Node: Studio{ID: 1, Name: "WIT"}}}},
 		}
 		result := mapMediaToTVShowMetadata(m)
 		require.NotNil(t, result)
@@ -303,6 +307,7 @@ func TestMapMediaToTVShowMetadata(t *testing.T) {
 		require.NotNil(t, result.TrailerURL)
 		assert.Equal(t, "https://www.youtube.com/watch?v=abc123", *result.TrailerURL)
 		require.Len(t, result.Genres, 1)
+		assert.Equal(t, []metadata.Tag{{Name: "Military"}, {Name: "Tragedy", Spoiler: true}}, result.Tags)
 		require.Len(t, result.Networks, 1)
 		assert.Equal(t, "WIT", result.Networks[0].Name)
 		// External rating from MeanScore
@@ -107,6 +107,9 @@ type MediaTag struct {
 	Category string `json:"category"`
 	Rank     int    `json:"rank"`
 	IsAdult  bool   `json:"isAdult"`
+
+	IsGeneralSpoiler bool `json:"isGeneralSpoiler"`
+	IsMediaSpoiler   bool `json:"isMediaSpoiler"`
 }
 
 // CoverImage contains image URLs at different sizes.
@@ -24,12 +24,13 @@ func mapMovieImages(resp *MovieResponse) *metadata.Images {
 	// Backdrops (backgrounds)
 	images.Backdrops = mapFanartImages(resp.MovieBackdrops, "backdrop")
 
-	// Clearart, disc art, banners, thumbs → stored as additional images
-	// Map banners as backdrops (wide format)
-	images.Backdrops = append(images.Backdrops, mapFanartImages(resp.MovieBanners, "banner")...)
+	// HD clearart + clearart
+	images.ClearArt = append(images.ClearArt, mapFanartImages(resp.HDClearArt, "clearart")...)
+	images.ClearArt = append(images.ClearArt, mapFanartImages(resp.MovieArt, "clearart")...)
 
-	// Thumbs as stills (landscape thumbnails)
-	images.Stills = mapFanartImages(resp.MovieThumbs, "thumb")
+	// Banners and landscape thumbnails
+	images.Banners = mapFanartImages(resp.MovieBanners, "banner")
+	images.Thumbs = mapFanartImages(resp.MovieThumbs, "thumb")
 
 	return images
 }
@@ -51,15 +52,17 @@ func mapTVShowImages(resp *TVShowResponse) *metadata.Images {
 
 	// Backdrops (show backgrounds)
 	images.Backdrops = mapFanartImages(resp.ShowBackdrops, "backdrop")
-	images.Backdrops = append(images.Backdrops, mapFanartImages(resp.TVBanners, "banner")...)
 
-	// Thumbs
-	images.Stills = mapFanartImages(resp.TVThumbs, "thumb")
+	// Banners and landscape thumbnails
+	images.Banners = mapFanartImages(resp.TVBanners, "banner")
+	images.Thumbs = mapFanartImages(resp.TVThumbs, "thumb")
 
-	// Clearart and character art → Profiles (artwork of characters/actors)
-	images.Profiles = append(images.Profiles, mapFanartImages(resp.HDClearArt, "clearart")...)
-	images.Profiles = append(images.Profiles, mapFanartImages(resp.ClearArt, "clearart")...)
-	images.Profiles = append(images.Profiles, mapFanartImages(resp.CharacterArt, "characterart")...)
+	// HD clearart + clearart
+	images.ClearArt = append(images.ClearArt, mapFanartImages(resp.HDClearArt, "clearart")...)
+	images.ClearArt = append(images.ClearArt, mapFanartImages(resp.ClearArt, "clearart")...)
+
+	// Character art → Profiles (artwork of characters)
+	images.Profiles = mapFanartImages(resp.CharacterArt, "characterart")
 
 	return images
 }
@@ -80,16 +83,16 @@ func mapSeasonImages(resp *TVShowResponse, seasonNum int) *metadata.Images {
 	}
 	for _, img := range resp.SeasonThumbs {
 		if img.Season == seasonStr {
-			images.Stills = append(images.Stills, mapSeasonImage(img, "thumb"))
+			images.Thumbs = append(images.Thumbs, mapSeasonImage(img, "thumb"))
 		}
 	}
 	for _, img := range resp.SeasonBanners {
 		if img.Season == seasonStr {
-			images.Backdrops = append(images.Backdrops, mapSeasonImage(img, "banner"))
+			images.Banners = append(images.Banners, mapSeasonImage(img, "banner"))
 		}
 	}
 
-	if len(images.Posters) == 0 && len(images.Stills) == 0 && len(images.Backdrops) == 0 {
+	if len(images.Posters) == 0 && len(images.Thumbs) == 0 && len(images.Banners) == 0 {
 		return nil
 	}
 	return images
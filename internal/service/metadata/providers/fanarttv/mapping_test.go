@@ -75,8 +75,9 @@ func TestMapMovieImages(t *testing.T) {
 		require.NotNil(t, result)
 		assert.Len(t, result.Logos, 2) // HD + standard
 		assert.Len(t, result.Posters, 1)
-		assert.Len(t, result.Backdrops, 2) // backgrounds + banners
-		assert.Len(t, result.Stills, 1)    // thumbs
+		assert.Len(t, result.Backdrops, 1)
+		assert.Len(t, result.Banners, 1)
+		assert.Len(t, result.Thumbs, 1)
 	})
 
 	t.Run("empty response", func(t *testing.T) {
@@ -109,9 +110,11 @@ func TestMapTVShowImages(t *testing.T) {
 		require.NotNil(t, result)
 		assert.Len(t, result.Logos, 2) // HD + clear logos
 		assert.Len(t, result.Posters, 1)
-		assert.Len(t, result.Backdrops, 2) // backgrounds + banners
-		assert.Len(t, result.Stills, 1)    // thumbs
-		assert.Len(t, result.Profiles, 3)  // HD clearart + clearart + characterart
+		assert.Len(t, result.Backdrops, 1)
+		assert.Len(t, result.Banners, 1)
+		assert.Len(t, result.Thumbs, 1)
+		assert.Len(t, result.ClearArt, 2) // HD clearart + clearart
+		assert.Len(t, result.Profiles, 1) // characterart
 	})
 }
 
@@ -138,8 +141,8 @@ func TestMapSeasonImages(t *testing.T) {
 		require.NotNil(t, result)
 		assert.Len(t, result.Posters, 1)
 		assert.Equal(t, "https://example.com/s1.jpg", result.Posters[0].FilePath)
-		assert.Len(t, result.Stills, 1)
-		assert.Len(t, result.Backdrops, 1)
+		assert.Len(t, result.Thumbs, 1)
+		assert.Len(t, result.Banners, 1)
 	})
 
 	t.Run("no matching season", func(t *testing.T) {
@@ -0,0 +1,231 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/imroc/req/v3"
+	"golang.org/x/time/rate"
+
+	"github.com/lusoris/revenge/internal/infra/cache"
+	"github.com/lusoris/revenge/internal/infra/circuitbreaker"
+	"github.com/lusoris/revenge/internal/infra/observability"
+)
+
+const (
+	// BaseURL is the MusicBrainz web service base URL.
+	BaseURL = "https://musicbrainz.org/ws/2"
+
+	// DefaultRateLimit is 1 request per second, the limit MusicBrainz
+	// enforces per client.
+	DefaultRateLimit = rate.Limit(1.0)
+
+	// DefaultBurst is the burst capacity.
+	DefaultBurst = 1
+
+	// DefaultCacheTTL is 24 hours (recordings rarely change).
+	DefaultCacheTTL = 24 * time.Hour
+
+	// DefaultUserAgent identifies the application. MusicBrainz blocks
+	// clients without a meaningful User-Agent.
+	DefaultUserAgent = "Revenge/1.0 ( https://github.com/lusoris/revenge )"
+
+	// searchLimit is the number of recordings requested per search.
+	searchLimit = 10
+)
+
+// Config configures the MusicBrainz client.
+type Config struct {
+	// Enabled activates MusicBrainz as a metadata provider.
+	Enabled bool
+
+	// BaseURL overrides the web service URL, e.g. for a mirror
+	// (default: BaseURL).
+	BaseURL string
+
+	// UserAgent is sent with every request (default: DefaultUserAgent).
+	UserAgent string
+
+	// RateLimit is requests per second (default: 1).
+	RateLimit rate.Limit
+
+	// Burst is the burst capacity (default: 1).
+	Burst int
+
+	// CacheTTL is the cache duration (default: 24h).
+	CacheTTL time.Duration
+
+	// Timeout is the request timeout (default: 10s).
+	Timeout time.Duration
+}
+
+// UserAgentWithContact returns the default User-Agent with a contact
+// address, the form MusicBrainz asks clients to identify themselves with.
+func UserAgentWithContact(contact string) string {
+	if contact == "" {
+		return DefaultUserAgent
+	}
+	return "Revenge/1.0 ( " + contact + " )"
+}
+
+// Client is the MusicBrainz API client with rate limiting and caching.
+type Client struct {
+	httpClient  *req.Client
+	rateLimiter *rate.Limiter
+	cache       *cache.L1Cache[string, any]
+	cacheTTL    time.Duration
+}
+
+// NewClient creates a new MusicBrainz client.
+func NewClient(config Config) (*Client, error) {
+	if config.BaseURL == "" {
+		config.BaseURL = BaseURL
+	}
+	if config.UserAgent == "" {
+		config.UserAgent = DefaultUserAgent
+	}
+	if config.RateLimit == 0 {
+		config.RateLimit = DefaultRateLimit
+	}
+	if config.Burst == 0 {
+		config.Burst = DefaultBurst
+	}
+	if config.CacheTTL == 0 {
+		config.CacheTTL = DefaultCacheTTL
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	l1, err := cache.NewL1Cache[string, any](10000, config.CacheTTL, cache.WithExpiryAccessing[string, any]())
+	if err != nil {
+		return nil, fmt.Errorf("create musicbrainz cache: %w", err)
+	}
+
+	client := req.C().
+		SetBaseURL(config.BaseURL).
+		SetUserAgent(config.UserAgent).
+		SetCommonQueryParam("fmt", "json").
+		SetTimeout(config.Timeout).
+		SetCommonRetryCount(2).
+		SetCommonRetryBackoffInterval(1*time.Second, 5*time.Second).
+		SetCommonRetryCondition(func(resp *req.Response, err error) bool {
+			if err != nil {
+				return true
+			}
+			// MusicBrainz answers 503 when the rate limit is exceeded
+			return resp.StatusCode >= 500
+		}).
+		OnAfterResponse(func(_ *req.Client, resp *req.Response) error {
+			status := "success"
+			if resp.IsErrorState() {
+				status = "error"
+				if resp.StatusCode == 503 {
+					status = "rate_limited"
+					observability.RecordMetadataRateLimited("musicbrainz")
+				}
+			}
+			observability.RecordMetadataFetch("musicbrainz", "recording", status, resp.TotalTime().Seconds())
+			return nil
+		})
+
+	// Circuit breaker
+	circuitbreaker.WrapReqClient(client, "musicbrainz", circuitbreaker.TierExternal)
+
+	return &Client{
+		httpClient:  client,
+		rateLimiter: rate.NewLimiter(config.RateLimit, config.Burst),
+		cache:       l1,
+		cacheTTL:    config.CacheTTL,
+	}, nil
+}
+
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	return c.rateLimiter.Wait(ctx)
+}
+
+func (c *Client) getFromCache(key string) any {
+	val, ok := c.cache.Get(key)
+	if !ok {
+		return nil
+	}
+	return val
+}
+
+func (c *Client) setCache(key string, data any) {
+	c.cache.Set(key, data)
+}
+
+func (c *Client) clearCache() {
+	c.cache.Clear()
+}
+
+// SearchRecordings searches recordings with a Lucene query, e.g.
+// `recording:"Take On Me" AND artist:"a-ha"`.
+func (c *Client) SearchRecordings(ctx context.Context, query string) (*RecordingSearchResponse, error) {
+	cacheKey := "search:recording:" + query
+	if cached := c.getFromCache(cacheKey); cached != nil {
+		if v, ok := cached.(*RecordingSearchResponse); ok {
+			return v, nil
+		}
+	}
+
+	if err := c.waitRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	var result RecordingSearchResponse
+	var errResp ErrorResponse
+	resp, err := c.httpClient.R().SetContext(ctx).
+		SetQueryParam("query", query).
+		SetQueryParam("limit", strconv.Itoa(searchLimit)).
+		SetSuccessResult(&result).
+		SetErrorResult(&errResp).
+		Get("/recording")
+	if err != nil {
+		return nil, fmt.Errorf("musicbrainz search: %w", err)
+	}
+	if resp.IsErrorState() {
+		return nil, fmt.Errorf("musicbrainz search: status %d: %s", resp.StatusCode, errResp.Error)
+	}
+
+	c.setCache(cacheKey, &result)
+	return &result, nil
+}
+
+// GetRecording fetches a recording with its artist credit by MBID. It
+// returns nil when the recording does not exist.
+func (c *Client) GetRecording(ctx context.Context, id string) (*Recording, error) {
+	cacheKey := "recording:" + id
+	if cached := c.getFromCache(cacheKey); cached != nil {
+		if v, ok := cached.(*Recording); ok {
+			return v, nil
+		}
+	}
+
+	if err := c.waitRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	var result Recording
+	var errResp ErrorResponse
+	resp, err := c.httpClient.R().SetContext(ctx).
+		SetQueryParam("inc", "artist-credits").
+		SetSuccessResult(&result).
+		SetErrorResult(&errResp).
+		Get("/recording/" + id)
+	if err != nil {
+		return nil, fmt.Errorf("musicbrainz recording: %w", err)
+	}
+	if resp.IsErrorState() {
+		if resp.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("musicbrainz recording: status %d: %s", resp.StatusCode, errResp.Error)
+	}
+
+	c.setCache(cacheKey, &result)
+	return &result, nil
+}
@@ -0,0 +1,65 @@
+package musicbrainz
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// luceneEscaper escapes the characters with a meaning inside a quoted
+// Lucene term.
+var luceneEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// recordingQuery builds the Lucene query of a recording search. The
+// artist clause is left out when the artist is unknown.
+func recordingQuery(artist, title string) string {
+	q := `recording:"` + luceneEscaper.Replace(strings.TrimSpace(title)) + `"`
+	if artist = strings.TrimSpace(artist); artist != "" {
+		q += ` AND artist:"` + luceneEscaper.Replace(artist) + `"`
+	}
+	return q
+}
+
+// mapRecording converts a MusicBrainz recording. Search results carry a
+// 0-100 score; lookups have none and count as certain.
+func mapRecording(r *Recording) metadata.RecordingSearchResult {
+	result := metadata.RecordingSearchResult{
+		ProviderID:     r.ID,
+		Provider:       metadata.ProviderMusicBrainz,
+		Title:          r.Title,
+		Disambiguation: r.Disambiguation,
+		Length:         time.Duration(r.Length) * time.Millisecond,
+		ReleaseDate:    parseReleaseDate(r.FirstReleaseDate),
+		Video:          r.Video,
+		Score:          1,
+	}
+	if r.Score > 0 {
+		result.Score = float64(r.Score) / 100
+	}
+
+	var credit strings.Builder
+	for _, ac := range r.ArtistCredit {
+		credit.WriteString(ac.Name)
+		credit.WriteString(ac.JoinPhrase)
+		result.Artists = append(result.Artists, metadata.RecordingArtist{
+			ProviderID:     ac.Artist.ID,
+			Name:           ac.Artist.Name,
+			SortName:       ac.Artist.SortName,
+			Disambiguation: ac.Artist.Disambiguation,
+		})
+	}
+	result.ArtistCredit = strings.TrimSpace(credit.String())
+	return result
+}
+
+// parseReleaseDate parses a MusicBrainz partial date: "2006", "2006-03" or
+// "2006-03-15". Missing months and days default to 1.
+func parseReleaseDate(s string) *time.Time {
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
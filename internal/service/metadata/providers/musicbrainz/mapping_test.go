@@ -0,0 +1,85 @@
+package musicbrainz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+func TestRecordingQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		artist string
+		title  string
+		want   string
+	}{
+		{"artist and title", "a-ha", "Take On Me", `recording:"Take On Me" AND artist:"a-ha"`},
+		{"no artist", "", "Take On Me", `recording:"Take On Me"`},
+		{"quotes escaped", "Guns N' Roses", `Sweet Child "O" Mine`, `recording:"Sweet Child \"O\" Mine" AND artist:"Guns N' Roses"`},
+		{"backslash escaped", `AC\DC`, " Thunderstruck ", `recording:"Thunderstruck" AND artist:"AC\\DC"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, recordingQuery(tt.artist, tt.title))
+		})
+	}
+}
+
+func TestParseReleaseDate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *time.Time
+	}{
+		{"1985-10-19", new(time.Date(1985, 10, 19, 0, 0, 0, 0, time.UTC))},
+		{"1985-10", new(time.Date(1985, 10, 1, 0, 0, 0, 0, time.UTC))},
+		{"1985", new(time.Date(1985, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{"", nil},
+		{"unknown", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseReleaseDate(tt.input))
+		})
+	}
+}
+
+func TestMapRecording(t *testing.T) {
+	r := &Recording{
+		ID:               "a2ca6a16-b3a8-4ba8-9c47-7a1b1d1bb2a2",
+		Score:            87,
+		Title:            "Umbrella",
+		Length:           275000,
+		Video:            true,
+		FirstReleaseDate: "2007-03-29",
+		ArtistCredit: []ArtistCredit{
+			{Name: "Rihanna", JoinPhrase: " feat. ", Artist: Artist{ID: "73e5e69d", Name: "Rihanna", SortName: "Rihanna"}},
+			{Name: "JAY‐Z", Artist: Artist{ID: "f82bcf78", Name: "JAY‐Z", SortName: "JAY‐Z", Disambiguation: "US rapper"}},
+		},
+	}
+
+	result := mapRecording(r)
+	assert.Equal(t, metadata.ProviderMusicBrainz, result.Provider)
+	assert.Equal(t, r.ID, result.ProviderID)
+	assert.Equal(t, "Umbrella", result.Title)
+	assert.Equal(t, "Rihanna feat. JAY‐Z", result.ArtistCredit)
+	require.Len(t, result.Artists, 2)
+	assert.Equal(t, "73e5e69d", result.Artists[0].ProviderID)
+	assert.Equal(t, "US rapper", result.Artists[1].Disambiguation)
+	assert.Equal(t, 275*time.Second, result.Length)
+	assert.True(t, result.Video)
+	assert.InDelta(t, 0.87, result.Score, 0.001)
+	require.NotNil(t, result.ReleaseDate)
+	assert.Equal(t, 2007, result.ReleaseDate.Year())
+
+	r.Score = 0
+	assert.InDelta(t, 1.0, mapRecording(r).Score, 0.001, "lookups count as certain")
+}
+
+func TestUserAgentWithContact(t *testing.T) {
+	assert.Equal(t, DefaultUserAgent, UserAgentWithContact(""))
+	assert.Equal(t, "Revenge/1.0 ( admin@example.com )", UserAgentWithContact("admin@example.com"))
+}
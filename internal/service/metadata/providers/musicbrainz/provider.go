@@ -0,0 +1,82 @@
+package musicbrainz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// Ensure Provider implements required interfaces.
+// MusicBrainz is a free, no-auth music encyclopedia. It is only asked for
+// recordings and their credited artists; it does NOT support movies, TV
+// shows, people, or images.
+var (
+	_ metadata.Provider      = (*Provider)(nil)
+	_ metadata.MusicProvider = (*Provider)(nil)
+)
+
+// Provider implements the metadata provider interface for MusicBrainz.
+type Provider struct {
+	client   *Client
+	priority int
+}
+
+// NewProvider creates a new MusicBrainz provider.
+func NewProvider(config Config) (*Provider, error) {
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("create musicbrainz provider: %w", err)
+	}
+	return &Provider{
+		client:   client,
+		priority: 50, // The only music provider; on par with TVmaze
+	}, nil
+}
+
+func (p *Provider) ID() metadata.ProviderID        { return metadata.ProviderMusicBrainz }
+func (p *Provider) Name() string                   { return "MusicBrainz" }
+func (p *Provider) Priority() int                  { return p.priority }
+func (p *Provider) SupportsMovies() bool           { return false }
+func (p *Provider) SupportsTVShows() bool          { return false }
+func (p *Provider) SupportsPeople() bool           { return false }
+func (p *Provider) SupportsLanguage(_ string) bool { return true } // Titles are as released
+func (p *Provider) ClearCache()                    { p.client.clearCache() }
+
+// --- MusicProvider ---
+
+func (p *Provider) SearchRecording(ctx context.Context, artist, title string, _ metadata.SearchOptions) ([]metadata.RecordingSearchResult, error) {
+	if title == "" {
+		return nil, metadata.ErrNotFound
+	}
+	resp, err := p.client.SearchRecordings(ctx, recordingQuery(artist, title))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Recordings) == 0 {
+		return nil, metadata.ErrNotFound
+	}
+
+	results := make([]metadata.RecordingSearchResult, 0, len(resp.Recordings))
+	for i := range resp.Recordings {
+		results = append(results, mapRecording(&resp.Recordings[i]))
+	}
+	return results, nil
+}
+
+func (p *Provider) GetRecording(ctx context.Context, id string) (*metadata.RecordingSearchResult, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("musicbrainz: recording ID %q: %w", id, metadata.ErrInvalidID)
+	}
+	recording, err := p.client.GetRecording(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recording == nil {
+		return nil, metadata.ErrNotFound
+	}
+	result := mapRecording(recording)
+	return &result, nil
+}
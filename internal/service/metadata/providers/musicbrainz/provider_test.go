@@ -0,0 +1,135 @@
+package musicbrainz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+const testRecordingID = "b1a9c0e9-d987-4042-ae91-78d6a3267d69"
+
+// newTestProvider starts a local stand-in for the MusicBrainz web service.
+func newTestProvider(t *testing.T, handler http.HandlerFunc) *Provider {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	p, err := NewProvider(Config{
+		BaseURL:   srv.URL,
+		UserAgent: "RevengeTest/1.0 ( test@example.com )",
+		RateLimit: rate.Inf,
+		Timeout:   5 * time.Second,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func TestProvider_SearchRecording(t *testing.T) {
+	calls := 0
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/recording", r.URL.Path)
+		assert.Equal(t, "RevengeTest/1.0 ( test@example.com )", r.UserAgent())
+		assert.Equal(t, "json", r.URL.Query().Get("fmt"))
+		assert.Equal(t, `recording:"Take On Me" AND artist:"a-ha"`, r.URL.Query().Get("query"))
+		writeJSON(t, w, http.StatusOK, RecordingSearchResponse{
+			Count: 1,
+			Recordings: []Recording{{
+				ID:     testRecordingID,
+				Score:  100,
+				Title:  "Take On Me",
+				Length: 225000,
+				Video:  true,
+				ArtistCredit: []ArtistCredit{{
+					Name:   "a-ha",
+					Artist: Artist{ID: "7364dea6-ca9a-48e3-be01-b44ad0d19897", Name: "a-ha", SortName: "a-ha"},
+				}},
+			}},
+		})
+	})
+
+	results, err := p.SearchRecording(context.Background(), "a-ha", "Take On Me", metadata.SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, testRecordingID, results[0].ProviderID)
+	assert.Equal(t, "a-ha", results[0].ArtistCredit)
+	assert.True(t, results[0].Video)
+
+	// Second search is served from cache
+	_, err = p.SearchRecording(context.Background(), "a-ha", "Take On Me", metadata.SearchOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestProvider_SearchRecording_NoResults(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusOK, RecordingSearchResponse{})
+	})
+
+	_, err := p.SearchRecording(context.Background(), "Nobody", "Nothing", metadata.SearchOptions{})
+	assert.ErrorIs(t, err, metadata.ErrNotFound)
+}
+
+func TestProvider_SearchRecording_ServerError(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusBadRequest, ErrorResponse{Error: "Invalid query"})
+	})
+
+	_, err := p.SearchRecording(context.Background(), "a-ha", "Take On Me", metadata.SearchOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid query")
+}
+
+func TestProvider_GetRecording(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/recording/"+testRecordingID, r.URL.Path)
+		assert.Equal(t, "artist-credits", r.URL.Query().Get("inc"))
+		writeJSON(t, w, http.StatusOK, Recording{
+			ID:               testRecordingID,
+			Title:            "Take On Me",
+			FirstReleaseDate: "1985",
+			ArtistCredit:     []ArtistCredit{{Name: "a-ha", Artist: Artist{ID: "7364dea6-ca9a-48e3-be01-b44ad0d19897", Name: "a-ha"}}},
+		})
+	})
+
+	result, err := p.GetRecording(context.Background(), testRecordingID)
+	require.NoError(t, err)
+	assert.Equal(t, "Take On Me", result.Title)
+	assert.InDelta(t, 1.0, result.Score, 0.001)
+	require.NotNil(t, result.ReleaseDate)
+	assert.Equal(t, 1985, result.ReleaseDate.Year())
+}
+
+func TestProvider_GetRecording_NotFound(t *testing.T) {
+	p := newTestProvider(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, ErrorResponse{Error: "Not Found"})
+	})
+
+	_, err := p.GetRecording(context.Background(), testRecordingID)
+	assert.ErrorIs(t, err, metadata.ErrNotFound)
+}
+
+func TestProvider_GetRecording_InvalidID(t *testing.T) {
+	p := newTestProvider(t, func(_ http.ResponseWriter, _ *http.Request) {
+		t.Fatal("no request expected for an invalid ID")
+	})
+
+	_, err := p.GetRecording(context.Background(), "not-a-uuid")
+	assert.ErrorIs(t, err, metadata.ErrInvalidID)
+}
@@ -0,0 +1,43 @@
+package musicbrainz
+
+// RecordingSearchResponse is the MusicBrainz response for a recording search.
+type RecordingSearchResponse struct {
+	Created    string      `json:"created"`
+	Count      int         `json:"count"`
+	Offset     int         `json:"offset"`
+	Recordings []Recording `json:"recordings"`
+}
+
+// Recording is a MusicBrainz recording: a distinct audio or video
+// performance of a track.
+type Recording struct {
+	ID               string         `json:"id"`
+	Score            int            `json:"score"` // Search relevance 0-100; absent on lookups
+	Title            string         `json:"title"`
+	Length           int            `json:"length"` // Milliseconds
+	Video            bool           `json:"video"`
+	Disambiguation   string         `json:"disambiguation"`
+	FirstReleaseDate string         `json:"first-release-date"` // "YYYY", "YYYY-MM" or "YYYY-MM-DD"
+	ArtistCredit     []ArtistCredit `json:"artist-credit"`
+}
+
+// ArtistCredit is one credited artist of a recording.
+type ArtistCredit struct {
+	Name       string `json:"name"` // Name as credited
+	JoinPhrase string `json:"joinphrase"`
+	Artist     Artist `json:"artist"`
+}
+
+// Artist is a MusicBrainz artist.
+type Artist struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	SortName       string `json:"sort-name"`
+	Disambiguation string `json:"disambiguation"`
+}
+
+// ErrorResponse is the body of MusicBrainz error responses.
+type ErrorResponse struct {
+	Error string `json:"error"`
+	Help  string `json:"help"`
+}
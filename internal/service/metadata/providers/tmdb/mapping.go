@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -99,6 +100,7 @@ func mapMovieMetadata(r *MovieResponse) *metadata.MovieMetadata {
 	for i, g := range r.Genres {
 		result.Genres[i] = metadata.Genre{ID: g.ID, Name: g.Name}
 	}
+	result.Tags = mapKeywords(r.Keywords)
 
 	// Map production companies
 	result.ProductionCompanies = make([]metadata.ProductionCompany, len(r.ProductionCompanies))
@@ -230,6 +232,7 @@ func mapTVShowMetadata(r *TVResponse) *metadata.TVShowMetadata {
 	for i, g := range r.Genres {
 		result.Genres[i] = metadata.Genre{ID: g.ID, Name: g.Name}
 	}
+	result.Tags = mapKeywords(r.Keywords)
 
 	// Map networks
 	result.Networks = make([]metadata.Network, len(r.Networks))
@@ -370,6 +373,7 @@ func mapEpisodeMetadata(r *EpisodeResponse, showID string) *metadata.EpisodeMeta
 	for i, g := range r.GuestStars {
 		result.GuestStars[i] = metadata.CastMember{
 			ProviderID:  strconv.Itoa(g.ID),
+			ExternalIDs: personIDs(g.ID),
 			Name:        g.Name,
 			Character:   g.Character,
 			Order:       g.Order,
@@ -384,6 +388,7 @@ func mapEpisodeMetadata(r *EpisodeResponse, showID string) *metadata.EpisodeMeta
 	for i, c := range r.Crew {
 		result.Crew[i] = metadata.CrewMember{
 			ProviderID:  strconv.Itoa(c.ID),
+			ExternalIDs: personIDs(c.ID),
 			Name:        c.Name,
 			Job:         c.Job,
 			Department:  c.Department,
@@ -522,6 +527,7 @@ func mapCredits(r *CreditsResponse) *metadata.Credits {
 	for i, c := range r.Cast {
 		result.Cast[i] = metadata.CastMember{
 			ProviderID:  strconv.Itoa(c.ID),
+			ExternalIDs: personIDs(c.ID),
 			Name:        c.Name,
 			Character:   c.Character,
 			Order:       c.Order,
@@ -534,6 +540,7 @@ func mapCredits(r *CreditsResponse) *metadata.Credits {
 	for i, c := range r.Crew {
 		result.Crew[i] = metadata.CrewMember{
 			ProviderID:  strconv.Itoa(c.ID),
+			ExternalIDs: personIDs(c.ID),
 			Name:        c.Name,
 			Job:         c.Job,
 			Department:  c.Department,
@@ -546,6 +553,12 @@ func mapCredits(r *CreditsResponse) *metadata.Credits {
 	return result
 }
 
+// personIDs returns the IDs of a person in a TMDb credit.
+func personIDs(id int) *metadata.ExternalIDs {
+	tmdbID := util.SafeIntToInt32(id)
+	return &metadata.ExternalIDs{TMDbID: &tmdbID}
+}
+
 // mapImages converts a TMDb images response to metadata type.
 func mapImages(r *ImagesResponse) *metadata.Images {
 	result := &metadata.Images{
@@ -752,3 +765,16 @@ func extractYear(dateStr string) *int {
 	}
 	return &year
 }
+
+// mapKeywords converts appended TMDb keywords to tags.
+func mapKeywords(r *KeywordsResponse) []metadata.Tag {
+	if r == nil {
+		return nil
+	}
+	keywords := slices.Concat(r.Keywords, r.Results)
+	tags := make([]metadata.Tag, len(keywords))
+	for i, k := range keywords {
+		tags[i] = metadata.Tag{Name: k.Name}
+	}
+	return tags
+}
@@ -161,6 +161,7 @@ func TestMapMovieMetadata(t *testing.T) {
 		BackdropPath:     new("/backdrop.jpg"),
 		Homepage:         new("https://fightclub.example.com"),
 		Genres:           []GenreResponse{{ID: 18, Name: "Drama"}, {ID: 53, Name: "Thriller"}},
+		Keywords:         &KeywordsResponse{Keywords: []GenreResponse{{ID: 825, Name: "support group"}}},
 		ProductionCompanies: []CompanyResponse{
 			{ID: 508, Name: "Regency Enterprises", LogoPath: new("/logo.png"), OriginCountry: "US"},
 		},
@@ -222,6 +223,9 @@ func TestMapMovieMetadata(t *testing.T) {
 	assert.Equal(t, 18, result.Genres[0].ID)
 	assert.Equal(t, "Drama", result.Genres[0].Name)
 
+	// Keywords
+	assert.Equal(t, []metadata.Tag{{Name: "support group"}}, result.Tags)
+
 	// Production companies
 	require.Len(t, result.ProductionCompanies, 1)
 	assert.Equal(t, "Regency Enterprises", result.ProductionCompanies[0].Name)
@@ -690,9 +694,13 @@ func TestMapCredits(t *testing.T) {
 	assert.Equal(t, "Tyler Durden", result.Cast[0].Character)
 	assert.Equal(t, 0, result.Cast[0].Order)
 	assert.Equal(t, new("cr1"), result.Cast[0].CreditID)
+	require.NotNil(t, result.Cast[0].ExternalIDs)
+	assert.Equal(t, new(int32(1)), result.Cast[0].ExternalIDs.TMDbID)
 
 	require.Len(t, result.Crew, 1)
 	assert.Equal(t, "3", result.Crew[0].ProviderID)
+	require.NotNil(t, result.Crew[0].ExternalIDs)
+	assert.Equal(t, new(int32(3)), result.Crew[0].ExternalIDs.TMDbID)
 	assert.Equal(t, "David Fincher", result.Crew[0].Name)
 	assert.Equal(t, "Director", result.Crew[0].Job)
 }
@@ -103,7 +103,7 @@ func (p *Provider) GetMovie(ctx context.Context, id string, lang string) (*metad
 		return nil, metadata.NewProviderError(metadata.ProviderTMDb, 400, "invalid id", metadata.ErrInvalidID)
 	}
 
-	resp, err := p.client.GetMovie(ctx, tmdbID, normalizeLang(lang), "")
+	resp, err := p.client.GetMovie(ctx, tmdbID, normalizeLang(lang), "keywords")
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +248,7 @@ func (p *Provider) GetTVShow(ctx context.Context, id string, lang string) (*meta
 		return nil, metadata.NewProviderError(metadata.ProviderTMDb, 400, "invalid id", metadata.ErrInvalidID)
 	}
 
-	resp, err := p.client.GetTV(ctx, tmdbID, normalizeLang(lang), "")
+	resp, err := p.client.GetTV(ctx, tmdbID, normalizeLang(lang), "keywords")
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +42,7 @@ type MovieResponse struct {
 	Translations *TranslationsWrapper `json:"translations,omitempty"`
 	ExternalIDs  *ExternalIDsResponse `json:"external_ids,omitempty"`
 	Videos       *VideosResponse      `json:"videos,omitempty"`
+	Keywords     *KeywordsResponse    `json:"keywords,omitempty"`
 }
 
 // SearchResultsResponse is the TMDb API response for search results.
@@ -133,6 +134,7 @@ type TVResponse struct {
 	Translations   *TranslationsWrapper   `json:"translations,omitempty"`
 	ExternalIDs    *ExternalIDsResponse   `json:"external_ids,omitempty"`
 	Videos         *VideosResponse        `json:"videos,omitempty"`
+	Keywords       *KeywordsResponse      `json:"keywords,omitempty"`
 }
 
 // SeasonResponse is the TMDb API response for season details.
@@ -349,6 +351,13 @@ type GenreResponse struct {
 	Name string `json:"name"`
 }
 
+// KeywordsResponse holds appended keywords. Movies list them under
+// "keywords", TV shows under "results".
+type KeywordsResponse struct {
+	Keywords []GenreResponse `json:"keywords,omitempty"`
+	Results  []GenreResponse `json:"results,omitempty"`
+}
+
 // CompanyResponse is a production company from TMDb.
 type CompanyResponse struct {
 	ID            int     `json:"id"`
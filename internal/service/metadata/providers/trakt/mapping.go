@@ -298,10 +298,11 @@ func mapCredits(c *Credits) *metadata.Credits {
 			character = strings.Join(cm.Characters, ", ")
 		}
 		credits.Cast = append(credits.Cast, metadata.CastMember{
-			ProviderID: strconv.Itoa(cm.Person.IDs.Trakt),
-			Name:       cm.Person.Name,
-			Character:  character,
-			Order:      i,
+			ProviderID:  strconv.Itoa(cm.Person.IDs.Trakt),
+			Name:        cm.Person.Name,
+			Character:   character,
+			Order:       i,
+			ExternalIDs: mapExternalIDs(cm.Person.IDs),
 		})
 	}
 
@@ -312,10 +313,11 @@ func mapCredits(c *Credits) *metadata.Credits {
 				job = strings.Join(cm.Jobs, ", ")
 			}
 			credits.Crew = append(credits.Crew, metadata.CrewMember{
-				ProviderID: strconv.Itoa(cm.Person.IDs.Trakt),
-				Name:       cm.Person.Name,
-				Job:        job,
-				Department: capitalizeGenre(dept),
+				ProviderID:  strconv.Itoa(cm.Person.IDs.Trakt),
+				Name:        cm.Person.Name,
+				Job:         job,
+				Department:  capitalizeGenre(dept),
+				ExternalIDs: mapExternalIDs(cm.Person.IDs),
 			})
 		}
 	}
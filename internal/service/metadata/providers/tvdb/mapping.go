@@ -230,6 +230,7 @@ func mapEpisodeMetadata(r *EpisodeResponse, showID string) *metadata.EpisodeMeta
 				Character:   c.Name,
 				Order:       c.Sort,
 				ProfilePath: c.PersonImgURL,
+				ExternalIDs: characterPersonIDs(c),
 			})
 		} else {
 			job := characterTypeToJob(c.Type)
@@ -239,6 +240,7 @@ func mapEpisodeMetadata(r *EpisodeResponse, showID string) *metadata.EpisodeMeta
 				Job:         job,
 				Department:  characterTypeToDepartment(c.Type),
 				ProfilePath: c.PersonImgURL,
+				ExternalIDs: characterPersonIDs(c),
 			})
 		}
 	}
@@ -379,6 +381,7 @@ func mapCharactersToCredits(characters []CharacterResponse) *metadata.Credits {
 				Character:   c.Name,
 				Order:       c.Sort,
 				ProfilePath: c.PersonImgURL,
+				ExternalIDs: characterPersonIDs(c),
 			})
 		} else {
 			result.Crew = append(result.Crew, metadata.CrewMember{
@@ -387,6 +390,7 @@ func mapCharactersToCredits(characters []CharacterResponse) *metadata.Credits {
 				Job:         characterTypeToJob(c.Type),
 				Department:  characterTypeToDepartment(c.Type),
 				ProfilePath: c.PersonImgURL,
+				ExternalIDs: characterPersonIDs(c),
 			})
 		}
 	}
@@ -394,6 +398,16 @@ func mapCharactersToCredits(characters []CharacterResponse) *metadata.Credits {
 	return result
 }
 
+// characterPersonIDs returns the TVDb ID of the person playing a
+// character, nil when TVDb does not link one.
+func characterPersonIDs(c CharacterResponse) *metadata.ExternalIDs {
+	if c.PeopleID == nil {
+		return nil
+	}
+	tvdbID := util.SafeIntToInt32(*c.PeopleID)
+	return &metadata.ExternalIDs{TVDbID: &tvdbID}
+}
+
 // mapArtworksToImages converts TVDb artworks to metadata images.
 func mapArtworksToImages(artworks []ArtworkResponse) *metadata.Images {
 	result := &metadata.Images{}
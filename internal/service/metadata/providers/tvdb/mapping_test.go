@@ -398,7 +398,7 @@ func TestMapPersonCredits(t *testing.T) {
 
 func TestMapCharactersToCredits(t *testing.T) {
 	characters := []CharacterResponse{
-		{ID: 1, Name: "Walter White", Type: CharacterTypeActor, PersonName: "Bryan Cranston", Sort: 0},
+		{ID: 1, Name: "Walter White", Type: CharacterTypeActor, PeopleID: new(256154), PersonName: "Bryan Cranston", Sort: 0},
 		{ID: 2, Name: "Jesse Pinkman", Type: CharacterTypeActor, PersonName: "Aaron Paul", Sort: 1},
 		{ID: 3, Name: "", Type: CharacterTypeWriter, PersonName: "Vince Gilligan", Sort: 0},
 	}
@@ -408,7 +408,10 @@ func TestMapCharactersToCredits(t *testing.T) {
 	require.Len(t, result.Cast, 2)
 	assert.Equal(t, "Bryan Cranston", result.Cast[0].Name)
 	assert.Equal(t, "Walter White", result.Cast[0].Character)
+	require.NotNil(t, result.Cast[0].ExternalIDs)
+	assert.Equal(t, new(int32(256154)), result.Cast[0].ExternalIDs.TVDbID)
 	assert.Equal(t, "Aaron Paul", result.Cast[1].Name)
+	assert.Nil(t, result.Cast[1].ExternalIDs, "no person linked to the character")
 
 	require.Len(t, result.Crew, 1)
 	assert.Equal(t, "Writer", result.Crew[0].Job)
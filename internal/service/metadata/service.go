@@ -3,6 +3,7 @@ package metadata
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"sync"
 
@@ -17,6 +18,7 @@ type Service interface {
 	GetMovieMetadata(ctx context.Context, id string, languages []string) (*MovieMetadata, error)
 	GetMovieCredits(ctx context.Context, id string) (*Credits, error)
 	GetMovieImages(ctx context.Context, id string) (*Images, error)
+	GetAllMovieImages(ctx context.Context, id string) ([]ProviderImages, error)
 	GetMovieReleaseDates(ctx context.Context, id string) ([]ReleaseDate, error)
 	GetMovieExternalIDs(ctx context.Context, id string) (*ExternalIDs, error)
 	GetSimilarMovies(ctx context.Context, id string, opts SearchOptions) ([]MovieSearchResult, int, error)
@@ -27,6 +29,7 @@ type Service interface {
 	GetTVShowMetadata(ctx context.Context, id string, languages []string) (*TVShowMetadata, error)
 	GetTVShowCredits(ctx context.Context, id string) (*Credits, error)
 	GetTVShowImages(ctx context.Context, id string) (*Images, error)
+	GetAllTVShowImages(ctx context.Context, id string) ([]ProviderImages, error)
 	GetTVShowContentRatings(ctx context.Context, id string) ([]ContentRating, error)
 	GetTVShowExternalIDs(ctx context.Context, id string) (*ExternalIDs, error)
 	GetSeasonMetadata(ctx context.Context, id string, seasonNum int, languages []string) (*SeasonMetadata, error)
@@ -43,6 +46,10 @@ type Service interface {
 	// Collection operations
 	GetCollectionMetadata(ctx context.Context, id string, languages []string) (*CollectionMetadata, error)
 
+	// Music operations
+	SearchRecording(ctx context.Context, artist, title string, opts SearchOptions) ([]RecordingSearchResult, error)
+	GetRecording(ctx context.Context, id string) (*RecordingSearchResult, error)
+
 	// Image operations
 	GetImageURL(path string, size ImageSize) string
 
@@ -63,6 +70,10 @@ type Service interface {
 
 	// Job queue configuration
 	SetJobQueue(queue JobQueue)
+
+	// Language configuration
+	SetLanguageSource(source LanguageSource)
+	RefreshLanguages(ctx context.Context, languages []string) []string
 }
 
 // ServiceConfig configures the metadata service.
@@ -96,12 +107,16 @@ type service struct {
 	personProviders     []PersonProvider
 	imageProviders      []ImageProvider
 	collectionProviders []CollectionProvider
+	musicProviders      []MusicProvider
 	allProviders        []Provider
 
 	mu sync.RWMutex
 
 	// Job queue interface (will be set by fx module)
 	jobQueue JobQueue
+
+	// Source of user-requested languages (will be set by fx module)
+	languageSource LanguageSource
 }
 
 // JobQueue is the interface for submitting metadata refresh jobs.
@@ -110,6 +125,12 @@ type JobQueue interface {
 	EnqueueRefreshTVShow(ctx context.Context, seriesID uuid.UUID, force bool, languages []string) error
 }
 
+// LanguageSource reports the languages users want metadata in, e.g. the
+// entries of their translation fallback chains.
+type LanguageSource interface {
+	MetadataLanguages(ctx context.Context) ([]string, error)
+}
+
 // NewService creates a new metadata service.
 func NewService(config ServiceConfig) *service {
 	return &service{
@@ -124,6 +145,39 @@ func (s *service) SetJobQueue(queue JobQueue) {
 	s.jobQueue = queue
 }
 
+// SetLanguageSource sets the source of user-requested languages.
+func (s *service) SetLanguageSource(source LanguageSource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.languageSource = source
+}
+
+// RefreshLanguages returns the given languages extended by every language
+// users have asked for, so a refresh stores all translations their fallback
+// chains can resolve. The given languages keep their order and come first.
+func (s *service) RefreshLanguages(ctx context.Context, languages []string) []string {
+	s.mu.RLock()
+	source := s.languageSource
+	s.mu.RUnlock()
+
+	if source == nil {
+		return languages
+	}
+
+	requested, err := source.MetadataLanguages(ctx)
+	if err != nil || len(requested) == 0 {
+		return languages
+	}
+
+	result := slices.Clone(languages)
+	for _, lang := range requested {
+		if !slices.Contains(result, lang) {
+			result = append(result, lang)
+		}
+	}
+	return result
+}
+
 // RegisterProvider adds a provider to the service.
 func (s *service) RegisterProvider(provider Provider) {
 	s.mu.Lock()
@@ -171,6 +225,13 @@ func (s *service) RegisterProvider(provider Provider) {
 			return s.collectionProviders[i].Priority() > s.collectionProviders[j].Priority()
 		})
 	}
+
+	if mp, ok := provider.(MusicProvider); ok {
+		s.musicProviders = append(s.musicProviders, mp)
+		sort.Slice(s.musicProviders, func(i, j int) bool {
+			return s.musicProviders[i].Priority() > s.musicProviders[j].Priority()
+		})
+	}
 }
 
 // GetProviders returns all registered providers.
@@ -207,9 +268,10 @@ func (s *service) SearchMovie(ctx context.Context, query string, opts SearchOpti
 		return nil, fmt.Errorf("provider %q does not support movie search: %w", opts.ProviderID, ErrNoProviders)
 	}
 
-	// Use primary provider with fallback
+	// Use the title chain with fallback
+	providers = chainFor(ctx, providers, FieldTitle)
 	results, err := providers[0].SearchMovie(ctx, query, opts)
-	if err != nil && s.config.EnableProviderFallback && len(providers) > 1 {
+	if err != nil && s.fallbackEnabled(ctx) && len(providers) > 1 {
 		for _, p := range providers[1:] {
 			results, err = p.SearchMovie(ctx, query, opts)
 			if err == nil {
@@ -221,7 +283,8 @@ func (s *service) SearchMovie(ctx context.Context, query string, opts SearchOpti
 	return results, err
 }
 
-// GetMovieMetadata retrieves movie metadata.
+// GetMovieMetadata retrieves movie metadata from the title chain. Under a
+// provider policy the other fields may come from their own chains.
 func (s *service) GetMovieMetadata(ctx context.Context, id string, languages []string) (*MovieMetadata, error) {
 	s.mu.RLock()
 	providers := s.movieProviders
@@ -234,12 +297,26 @@ func (s *service) GetMovieMetadata(ctx context.Context, id string, languages []s
 	if len(languages) == 0 {
 		languages = s.config.DefaultLanguages
 	}
+
+	result, source, err := firstInChain(ctx, s, providers, FieldTitle, id, func(p MovieProvider, pid string) (*MovieMetadata, error) {
+		return getMovieInLanguages(ctx, p, pid, languages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.applyMovieSources(ctx, providers, result, source, languages)
+	return result, nil
+}
+
+// getMovieInLanguages fetches a movie from one provider, merging additional
+// languages into the translations of the first.
+func getMovieInLanguages(ctx context.Context, p MovieProvider, id string, languages []string) (*MovieMetadata, error) {
 	var result *MovieMetadata
 	var aggErr AggregateError
 
-	// Get from primary provider
 	for _, lang := range languages {
-		metadata, err := providers[0].GetMovie(ctx, id, lang)
+		metadata, err := p.GetMovie(ctx, id, lang)
 		if err != nil {
 			aggErr.Add(err)
 			continue
@@ -262,6 +339,9 @@ func (s *service) GetMovieMetadata(ctx context.Context, id string, languages []s
 	}
 
 	if result == nil {
+		if aggErr.IsEmpty() {
+			return nil, ErrNotFound
+		}
 		return nil, aggErr.First()
 	}
 
@@ -278,7 +358,10 @@ func (s *service) GetMovieCredits(ctx context.Context, id string) (*Credits, err
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetMovieCredits(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, FieldCast, id, func(p MovieProvider, pid string) (*Credits, error) {
+		return p.GetMovieCredits(ctx, pid)
+	})
+	return result, err
 }
 
 // GetMovieImages retrieves movie images.
@@ -291,7 +374,26 @@ func (s *service) GetMovieImages(ctx context.Context, id string) (*Images, error
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetMovieImages(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, FieldImages, id, func(p MovieProvider, pid string) (*Images, error) {
+		return p.GetMovieImages(ctx, pid)
+	})
+	return result, err
+}
+
+// GetAllMovieImages retrieves the movie images of every provider in the
+// images chain, so artwork can be chosen across providers.
+func (s *service) GetAllMovieImages(ctx context.Context, id string) ([]ProviderImages, error) {
+	s.mu.RLock()
+	providers := s.movieProviders
+	s.mu.RUnlock()
+
+	if len(providers) == 0 {
+		return nil, ErrNoProviders
+	}
+
+	return allImages(ctx, providers, id, func(p MovieProvider, pid string) (*Images, error) {
+		return p.GetMovieImages(ctx, pid)
+	})
 }
 
 // GetMovieReleaseDates retrieves movie release dates.
@@ -304,7 +406,10 @@ func (s *service) GetMovieReleaseDates(ctx context.Context, id string) ([]Releas
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetMovieReleaseDates(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, fieldAny, id, func(p MovieProvider, pid string) ([]ReleaseDate, error) {
+		return p.GetMovieReleaseDates(ctx, pid)
+	})
+	return result, err
 }
 
 // GetMovieExternalIDs retrieves movie external IDs.
@@ -317,7 +422,10 @@ func (s *service) GetMovieExternalIDs(ctx context.Context, id string) (*External
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetMovieExternalIDs(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, fieldAny, id, func(p MovieProvider, pid string) (*ExternalIDs, error) {
+		return p.GetMovieExternalIDs(ctx, pid)
+	})
+	return result, err
 }
 
 // GetSimilarMovies retrieves movies similar to the given movie.
@@ -334,7 +442,12 @@ func (s *service) GetSimilarMovies(ctx context.Context, id string, opts SearchOp
 		opts.Language = s.getDefaultLanguage()
 	}
 
-	return providers[0].GetSimilarMovies(ctx, id, opts)
+	for _, p := range chainFor(ctx, providers, fieldAny) {
+		if pid, ok := providerItemID(ctx, p.ID(), id); ok {
+			return p.GetSimilarMovies(ctx, pid, opts)
+		}
+	}
+	return nil, 0, ErrNotFound
 }
 
 // GetMovieRecommendations retrieves recommended movies based on the given movie.
@@ -351,7 +464,12 @@ func (s *service) GetMovieRecommendations(ctx context.Context, id string, opts S
 		opts.Language = s.getDefaultLanguage()
 	}
 
-	return providers[0].GetMovieRecommendations(ctx, id, opts)
+	for _, p := range chainFor(ctx, providers, fieldAny) {
+		if pid, ok := providerItemID(ctx, p.ID(), id); ok {
+			return p.GetMovieRecommendations(ctx, pid, opts)
+		}
+	}
+	return nil, 0, ErrNotFound
 }
 
 // SearchTVShow searches for TV shows.
@@ -378,9 +496,10 @@ func (s *service) SearchTVShow(ctx context.Context, query string, opts SearchOpt
 		return nil, fmt.Errorf("provider %q does not support TV show search: %w", opts.ProviderID, ErrNoProviders)
 	}
 
-	// Use primary provider with fallback
+	// Use the title chain with fallback
+	providers = chainFor(ctx, providers, FieldTitle)
 	results, err := providers[0].SearchTVShow(ctx, query, opts)
-	if err != nil && s.config.EnableProviderFallback && len(providers) > 1 {
+	if err != nil && s.fallbackEnabled(ctx) && len(providers) > 1 {
 		for _, p := range providers[1:] {
 			results, err = p.SearchTVShow(ctx, query, opts)
 			if err == nil {
@@ -392,7 +511,8 @@ func (s *service) SearchTVShow(ctx context.Context, query string, opts SearchOpt
 	return results, err
 }
 
-// GetTVShowMetadata retrieves TV show metadata.
+// GetTVShowMetadata retrieves TV show metadata from the title chain. Under a
+// provider policy the other fields may come from their own chains.
 func (s *service) GetTVShowMetadata(ctx context.Context, id string, languages []string) (*TVShowMetadata, error) {
 	s.mu.RLock()
 	providers := s.tvProviders
@@ -405,11 +525,26 @@ func (s *service) GetTVShowMetadata(ctx context.Context, id string, languages []
 	if len(languages) == 0 {
 		languages = s.config.DefaultLanguages
 	}
+
+	result, source, err := firstInChain(ctx, s, providers, FieldTitle, id, func(p TVShowProvider, pid string) (*TVShowMetadata, error) {
+		return getTVShowInLanguages(ctx, p, pid, languages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.applyTVShowSources(ctx, providers, result, source, languages)
+	return result, nil
+}
+
+// getTVShowInLanguages fetches a show from one provider, merging additional
+// languages into the translations of the first.
+func getTVShowInLanguages(ctx context.Context, p TVShowProvider, id string, languages []string) (*TVShowMetadata, error) {
 	var result *TVShowMetadata
 	var aggErr AggregateError
 
 	for _, lang := range languages {
-		metadata, err := providers[0].GetTVShow(ctx, id, lang)
+		metadata, err := p.GetTVShow(ctx, id, lang)
 		if err != nil {
 			aggErr.Add(err)
 			continue
@@ -431,6 +566,9 @@ func (s *service) GetTVShowMetadata(ctx context.Context, id string, languages []
 	}
 
 	if result == nil {
+		if aggErr.IsEmpty() {
+			return nil, ErrNotFound
+		}
 		return nil, aggErr.First()
 	}
 
@@ -447,7 +585,10 @@ func (s *service) GetTVShowCredits(ctx context.Context, id string) (*Credits, er
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetTVShowCredits(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, FieldCast, id, func(p TVShowProvider, pid string) (*Credits, error) {
+		return p.GetTVShowCredits(ctx, pid)
+	})
+	return result, err
 }
 
 // GetTVShowImages retrieves TV show images.
@@ -460,7 +601,26 @@ func (s *service) GetTVShowImages(ctx context.Context, id string) (*Images, erro
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetTVShowImages(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, FieldImages, id, func(p TVShowProvider, pid string) (*Images, error) {
+		return p.GetTVShowImages(ctx, pid)
+	})
+	return result, err
+}
+
+// GetAllTVShowImages retrieves the TV show images of every provider in the
+// images chain, so artwork can be chosen across providers.
+func (s *service) GetAllTVShowImages(ctx context.Context, id string) ([]ProviderImages, error) {
+	s.mu.RLock()
+	providers := s.tvProviders
+	s.mu.RUnlock()
+
+	if len(providers) == 0 {
+		return nil, ErrNoProviders
+	}
+
+	return allImages(ctx, providers, id, func(p TVShowProvider, pid string) (*Images, error) {
+		return p.GetTVShowImages(ctx, pid)
+	})
 }
 
 // GetTVShowContentRatings retrieves TV show content ratings.
@@ -473,7 +633,10 @@ func (s *service) GetTVShowContentRatings(ctx context.Context, id string) ([]Con
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetTVShowContentRatings(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, fieldAny, id, func(p TVShowProvider, pid string) ([]ContentRating, error) {
+		return p.GetTVShowContentRatings(ctx, pid)
+	})
+	return result, err
 }
 
 // GetTVShowExternalIDs retrieves TV show external IDs.
@@ -486,10 +649,13 @@ func (s *service) GetTVShowExternalIDs(ctx context.Context, id string) (*Externa
 		return nil, ErrNoProviders
 	}
 
-	return providers[0].GetTVShowExternalIDs(ctx, id)
+	result, _, err := firstInChain(ctx, s, providers, fieldAny, id, func(p TVShowProvider, pid string) (*ExternalIDs, error) {
+		return p.GetTVShowExternalIDs(ctx, pid)
+	})
+	return result, err
 }
 
-// GetSeasonMetadata retrieves season metadata.
+// GetSeasonMetadata retrieves season metadata from the episodes chain.
 func (s *service) GetSeasonMetadata(ctx context.Context, id string, seasonNum int, languages []string) (*SeasonMetadata, error) {
 	s.mu.RLock()
 	providers := s.tvProviders
@@ -502,34 +668,45 @@ func (s *service) GetSeasonMetadata(ctx context.Context, id string, seasonNum in
 	if len(languages) == 0 {
 		languages = s.config.DefaultLanguages
 	}
-	var result *SeasonMetadata
-	var aggErr AggregateError
 
-	for _, lang := range languages {
-		metadata, err := providers[0].GetSeason(ctx, id, seasonNum, lang)
-		if err != nil {
-			aggErr.Add(err)
-			continue
-		}
+	result, source, err := firstInChain(ctx, s, providers, FieldEpisodes, id, func(p TVShowProvider, pid string) (*SeasonMetadata, error) {
+		var result *SeasonMetadata
+		var aggErr AggregateError
 
-		if result == nil {
-			result = metadata
-		} else {
-			if result.Translations == nil {
-				result.Translations = make(map[string]*LocalizedSeasonData)
+		for _, lang := range languages {
+			metadata, err := p.GetSeason(ctx, pid, seasonNum, lang)
+			if err != nil {
+				aggErr.Add(err)
+				continue
 			}
-			result.Translations[lang] = &LocalizedSeasonData{
-				Language: lang,
-				Name:     metadata.Name,
-				Overview: ptrToString(metadata.Overview),
+
+			if result == nil {
+				result = metadata
+			} else {
+				if result.Translations == nil {
+					result.Translations = make(map[string]*LocalizedSeasonData)
+				}
+				result.Translations[lang] = &LocalizedSeasonData{
+					Language: lang,
+					Name:     metadata.Name,
+					Overview: ptrToString(metadata.Overview),
+				}
 			}
 		}
-	}
 
-	if result == nil {
-		return nil, aggErr.First()
+		if result == nil {
+			if aggErr.IsEmpty() {
+				return nil, ErrNotFound
+			}
+			return nil, aggErr.First()
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	result.Sources = FieldSources{FieldEpisodes: source}
 	return result, nil
 }
 
@@ -543,8 +720,12 @@ func (s *service) GetSeasonImages(ctx context.Context, id string, seasonNum int)
 		return nil, ErrNoProviders
 	}
 
-	for _, p := range providers {
-		images, err := p.GetSeasonImages(ctx, id, seasonNum)
+	for _, p := range chainFor(ctx, providers, FieldImages) {
+		pid, ok := providerItemID(ctx, p.ID(), id)
+		if !ok {
+			continue
+		}
+		images, err := p.GetSeasonImages(ctx, pid, seasonNum)
 		if err != nil {
 			continue
 		}
@@ -554,7 +735,7 @@ func (s *service) GetSeasonImages(ctx context.Context, id string, seasonNum int)
 	return nil, ErrNotFound
 }
 
-// GetEpisodeMetadata retrieves episode metadata.
+// GetEpisodeMetadata retrieves episode metadata from the episodes chain.
 func (s *service) GetEpisodeMetadata(ctx context.Context, id string, seasonNum, episodeNum int, languages []string) (*EpisodeMetadata, error) {
 	s.mu.RLock()
 	providers := s.tvProviders
@@ -567,34 +748,45 @@ func (s *service) GetEpisodeMetadata(ctx context.Context, id string, seasonNum,
 	if len(languages) == 0 {
 		languages = s.config.DefaultLanguages
 	}
-	var result *EpisodeMetadata
-	var aggErr AggregateError
 
-	for _, lang := range languages {
-		metadata, err := providers[0].GetEpisode(ctx, id, seasonNum, episodeNum, lang)
-		if err != nil {
-			aggErr.Add(err)
-			continue
-		}
+	result, source, err := firstInChain(ctx, s, providers, FieldEpisodes, id, func(p TVShowProvider, pid string) (*EpisodeMetadata, error) {
+		var result *EpisodeMetadata
+		var aggErr AggregateError
 
-		if result == nil {
-			result = metadata
-		} else {
-			if result.Translations == nil {
-				result.Translations = make(map[string]*LocalizedEpisodeData)
+		for _, lang := range languages {
+			metadata, err := p.GetEpisode(ctx, pid, seasonNum, episodeNum, lang)
+			if err != nil {
+				aggErr.Add(err)
+				continue
 			}
-			result.Translations[lang] = &LocalizedEpisodeData{
-				Language: lang,
-				Name:     metadata.Name,
-				Overview: ptrToString(metadata.Overview),
+
+			if result == nil {
+				result = metadata
+			} else {
+				if result.Translations == nil {
+					result.Translations = make(map[string]*LocalizedEpisodeData)
+				}
+				result.Translations[lang] = &LocalizedEpisodeData{
+					Language: lang,
+					Name:     metadata.Name,
+					Overview: ptrToString(metadata.Overview),
+				}
 			}
 		}
-	}
 
-	if result == nil {
-		return nil, aggErr.First()
+		if result == nil {
+			if aggErr.IsEmpty() {
+				return nil, ErrNotFound
+			}
+			return nil, aggErr.First()
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	result.Sources = FieldSources{FieldEpisodes: source}
 	return result, nil
 }
 
@@ -608,8 +800,12 @@ func (s *service) GetEpisodeImages(ctx context.Context, id string, seasonNum, ep
 		return nil, ErrNoProviders
 	}
 
-	for _, p := range providers {
-		images, err := p.GetEpisodeImages(ctx, id, seasonNum, episodeNum)
+	for _, p := range chainFor(ctx, providers, FieldImages) {
+		pid, ok := providerItemID(ctx, p.ID(), id)
+		if !ok {
+			continue
+		}
+		images, err := p.GetEpisodeImages(ctx, pid, seasonNum, episodeNum)
 		if err != nil {
 			continue
 		}
@@ -645,7 +841,7 @@ func (s *service) SearchPerson(ctx context.Context, query string, opts SearchOpt
 
 	// Use primary provider with fallback
 	results, err := providers[0].SearchPerson(ctx, query, opts)
-	if err != nil && s.config.EnableProviderFallback && len(providers) > 1 {
+	if err != nil && s.fallbackEnabled(ctx) && len(providers) > 1 {
 		for _, p := range providers[1:] {
 			results, err = p.SearchPerson(ctx, query, opts)
 			if err == nil {
@@ -743,6 +939,53 @@ func (s *service) GetCollectionMetadata(ctx context.Context, id string, language
 	return providers[0].GetCollection(ctx, id, languages[0])
 }
 
+// SearchRecording searches music recordings by artist and title.
+func (s *service) SearchRecording(ctx context.Context, artist, title string, opts SearchOptions) ([]RecordingSearchResult, error) {
+	s.mu.RLock()
+	providers := s.musicProviders
+	s.mu.RUnlock()
+
+	if len(providers) == 0 {
+		return nil, ErrNoProviders
+	}
+
+	// Route to specific provider if requested
+	if opts.ProviderID != "" {
+		for _, p := range providers {
+			if p.ID() == opts.ProviderID {
+				return p.SearchRecording(ctx, artist, title, opts)
+			}
+		}
+		return nil, fmt.Errorf("provider %q does not support recording search: %w", opts.ProviderID, ErrNoProviders)
+	}
+
+	// Use primary provider with fallback
+	results, err := providers[0].SearchRecording(ctx, artist, title, opts)
+	if err != nil && s.fallbackEnabled(ctx) && len(providers) > 1 {
+		for _, p := range providers[1:] {
+			results, err = p.SearchRecording(ctx, artist, title, opts)
+			if err == nil {
+				break
+			}
+		}
+	}
+
+	return results, err
+}
+
+// GetRecording retrieves a music recording by its primary provider ID.
+func (s *service) GetRecording(ctx context.Context, id string) (*RecordingSearchResult, error) {
+	s.mu.RLock()
+	providers := s.musicProviders
+	s.mu.RUnlock()
+
+	if len(providers) == 0 {
+		return nil, ErrNoProviders
+	}
+
+	return providers[0].GetRecording(ctx, id)
+}
+
 // GetImageURL constructs an image URL using the primary image provider.
 func (s *service) GetImageURL(path string, size ImageSize) string {
 	s.mu.RLock()
@@ -797,7 +1040,7 @@ func (s *service) ClearCache() {
 // (e.g. OMDb for IMDb/RT/Metacritic) and merges them into the primary result.
 // This should be called from background workers only, not in the API request path.
 func (s *service) EnrichMovieRatings(ctx context.Context, result *MovieMetadata) {
-	if !s.config.EnableEnrichment {
+	if !s.enrichmentEnabled(ctx) {
 		return
 	}
 	if result.IMDbID == nil || *result.IMDbID == "" {
@@ -813,29 +1056,29 @@ func (s *service) EnrichMovieRatings(ctx context.Context, result *MovieMetadata)
 	}
 
 	imdbID := *result.IMDbID
-	primary := providers[0]
+	providers = chainFor(ctx, providers, FieldRatings)
+	primary := providers[0].ID()
+	if source, ok := result.Sources[FieldRatings]; ok {
+		primary = source
+	}
 
-	var (
-		wg      sync.WaitGroup
-		mu      sync.Mutex
-		results [][]ExternalRating
-	)
+	// Ratings are merged in chain order; the first source wins per rating.
+	var wg sync.WaitGroup
+	results := make([][]ExternalRating, len(providers))
 
-	for _, p := range providers[1:] {
-		if p.ID() == primary.ID() {
+	for i, p := range providers {
+		if p.ID() == primary {
 			continue
 		}
 		wg.Add(1)
-		go func(provider MovieProvider) {
+		go func(i int, provider MovieProvider) {
 			defer wg.Done()
 			meta, err := provider.GetMovie(ctx, imdbID, "en")
 			if err != nil || meta == nil || len(meta.ExternalRatings) == 0 {
 				return
 			}
-			mu.Lock()
-			results = append(results, meta.ExternalRatings)
-			mu.Unlock()
-		}(p)
+			results[i] = meta.ExternalRatings
+		}(i, p)
 	}
 
 	wg.Wait()
@@ -849,7 +1092,7 @@ func (s *service) EnrichMovieRatings(ctx context.Context, result *MovieMetadata)
 // and merges them into the primary result.
 // This should be called from background workers only, not in the API request path.
 func (s *service) EnrichTVShowRatings(ctx context.Context, result *TVShowMetadata) {
-	if !s.config.EnableEnrichment {
+	if !s.enrichmentEnabled(ctx) {
 		return
 	}
 	if result.IMDbID == nil || *result.IMDbID == "" {
@@ -865,29 +1108,29 @@ func (s *service) EnrichTVShowRatings(ctx context.Context, result *TVShowMetadat
 	}
 
 	imdbID := *result.IMDbID
-	primary := providers[0]
+	providers = chainFor(ctx, providers, FieldRatings)
+	primary := providers[0].ID()
+	if source, ok := result.Sources[FieldRatings]; ok {
+		primary = source
+	}
 
-	var (
-		wg      sync.WaitGroup
-		mu      sync.Mutex
-		results [][]ExternalRating
-	)
+	// Ratings are merged in chain order; the first source wins per rating.
+	var wg sync.WaitGroup
+	results := make([][]ExternalRating, len(providers))
 
-	for _, p := range providers[1:] {
-		if p.ID() == primary.ID() {
+	for i, p := range providers {
+		if p.ID() == primary {
 			continue
 		}
 		wg.Add(1)
-		go func(provider TVShowProvider) {
+		go func(i int, provider TVShowProvider) {
 			defer wg.Done()
 			meta, err := provider.GetTVShow(ctx, imdbID, "en")
 			if err != nil || meta == nil || len(meta.ExternalRatings) == 0 {
 				return
 			}
-			mu.Lock()
-			results = append(results, meta.ExternalRatings)
-			mu.Unlock()
-		}(p)
+			results[i] = meta.ExternalRatings
+		}(i, p)
 	}
 
 	wg.Wait()
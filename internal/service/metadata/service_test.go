@@ -1,6 +1,8 @@
 package metadata
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -68,3 +70,25 @@ func TestDefaultServiceConfig(t *testing.T) {
 	assert.True(t, cfg.EnableProviderFallback)
 	assert.False(t, cfg.EnableEnrichment)
 }
+
+type staticLanguageSource struct {
+	languages []string
+	err       error
+}
+
+func (s staticLanguageSource) MetadataLanguages(context.Context) ([]string, error) {
+	return s.languages, s.err
+}
+
+func TestRefreshLanguages(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(DefaultServiceConfig())
+
+	assert.Equal(t, []string{"en"}, svc.RefreshLanguages(ctx, []string{"en"}))
+
+	svc.SetLanguageSource(staticLanguageSource{languages: []string{"de", "de-AT", "en"}})
+	assert.Equal(t, []string{"en", "fr", "de", "de-AT"}, svc.RefreshLanguages(ctx, []string{"en", "fr"}))
+
+	svc.SetLanguageSource(staticLanguageSource{err: errors.New("db down")})
+	assert.Equal(t, []string{"en"}, svc.RefreshLanguages(ctx, []string{"en"}))
+}
@@ -0,0 +1,345 @@
+package metadata
+
+import (
+	"context"
+	"strings"
+)
+
+// itemFields are the movie and TV show fields that can be taken from a
+// different provider than the one that supplied the title.
+var itemFields = []Field{FieldTitle, FieldOverview, FieldImages, FieldRatings, FieldTags}
+
+// applyMovieSources records the source of each field. Under a provider
+// policy, fields whose chain prefers another provider are replaced with that
+// provider's data; providers the movie has no ID for are looked up by title.
+func (s *service) applyMovieSources(ctx context.Context, providers []MovieProvider, result *MovieMetadata, source ProviderID, languages []string) {
+	result.Sources = make(FieldSources, len(itemFields))
+	for _, field := range itemFields {
+		result.Sources[field] = source
+	}
+	resolved := seedResolvedIDs(ctx, source, result.ProviderID)
+	result.ResolvedIDs = resolved
+
+	if _, ok := ProviderPolicyFromContext(ctx); !ok {
+		return
+	}
+
+	titles := []string{result.Title, result.OriginalTitle}
+	var year *int
+	if result.ReleaseDate != nil {
+		y := result.ReleaseDate.Year()
+		year = &y
+	}
+	fetched := map[ProviderID]*MovieMetadata{source: result}
+
+	for _, field := range itemFields {
+		var from *MovieMetadata
+		var fromID ProviderID
+		for _, p := range chainFor(ctx, providers, field) {
+			meta, tried := fetched[p.ID()]
+			if !tried {
+				pid := resolved[p.ID()]
+				if pid == "" {
+					pid = s.resolveMovieID(ctx, p, titles, year)
+				}
+				if pid == "" {
+					continue
+				}
+				var err error
+				meta, err = getMovieInLanguages(ctx, p, pid, languages)
+				fetched[p.ID()] = meta
+				if err == nil {
+					resolved[p.ID()] = pid
+				}
+			}
+			if meta != nil {
+				from, fromID = meta, p.ID()
+				break
+			}
+			if !s.fallbackEnabled(ctx) {
+				break
+			}
+		}
+		if from == nil || fromID == source {
+			continue
+		}
+		copyMovieField(result, from, field)
+		fillMovieIDs(result, from)
+		result.Sources[field] = fromID
+	}
+}
+
+// applyTVShowSources is the TV show counterpart of applyMovieSources.
+func (s *service) applyTVShowSources(ctx context.Context, providers []TVShowProvider, result *TVShowMetadata, source ProviderID, languages []string) {
+	result.Sources = make(FieldSources, len(itemFields))
+	for _, field := range itemFields {
+		result.Sources[field] = source
+	}
+	resolved := seedResolvedIDs(ctx, source, result.ProviderID)
+	result.ResolvedIDs = resolved
+
+	if _, ok := ProviderPolicyFromContext(ctx); !ok {
+		return
+	}
+
+	names := []string{result.Name, result.OriginalName}
+	var year *int
+	if result.FirstAirDate != nil {
+		y := result.FirstAirDate.Year()
+		year = &y
+	}
+	fetched := map[ProviderID]*TVShowMetadata{source: result}
+
+	for _, field := range itemFields {
+		var from *TVShowMetadata
+		var fromID ProviderID
+		for _, p := range chainFor(ctx, providers, field) {
+			meta, tried := fetched[p.ID()]
+			if !tried {
+				pid := resolved[p.ID()]
+				if pid == "" {
+					pid = s.resolveTVShowID(ctx, p, names, year)
+				}
+				if pid == "" {
+					continue
+				}
+				var err error
+				meta, err = getTVShowInLanguages(ctx, p, pid, languages)
+				fetched[p.ID()] = meta
+				if err == nil {
+					resolved[p.ID()] = pid
+				}
+			}
+			if meta != nil {
+				from, fromID = meta, p.ID()
+				break
+			}
+			if !s.fallbackEnabled(ctx) {
+				break
+			}
+		}
+		if from == nil || fromID == source {
+			continue
+		}
+		copyTVShowField(result, from, field)
+		fillTVShowIDs(result, from)
+		result.Sources[field] = fromID
+	}
+}
+
+// seedResolvedIDs returns the item IDs known before any lookups: those in
+// ctx and the ID of the provider that supplied the base result.
+func seedResolvedIDs(ctx context.Context, source ProviderID, sourceID string) map[ProviderID]string {
+	resolved := make(map[ProviderID]string)
+	if ids, ok := ProviderIDsFromContext(ctx); ok {
+		for p, id := range ids {
+			resolved[p] = id
+		}
+	}
+	if sourceID != "" {
+		resolved[source] = sourceID
+	}
+	return resolved
+}
+
+// resolveMovieID searches provider p for the movie and returns its ID when a
+// result matches one of the titles exactly (ignoring case).
+func (s *service) resolveMovieID(ctx context.Context, p MovieProvider, titles []string, year *int) string {
+	for _, title := range titles {
+		if title == "" {
+			continue
+		}
+		results, err := p.SearchMovie(ctx, title, SearchOptions{Year: year, Language: s.getDefaultLanguage()})
+		if err != nil {
+			continue
+		}
+		for _, r := range results {
+			if matchesName(titles, r.Title, r.OriginalTitle) {
+				return r.ProviderID
+			}
+		}
+	}
+	return ""
+}
+
+// resolveTVShowID searches provider p for the show and returns its ID when a
+// result matches one of the names exactly (ignoring case).
+func (s *service) resolveTVShowID(ctx context.Context, p TVShowProvider, names []string, year *int) string {
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		results, err := p.SearchTVShow(ctx, name, SearchOptions{Year: year, Language: s.getDefaultLanguage()})
+		if err != nil {
+			continue
+		}
+		for _, r := range results {
+			if matchesName(names, r.Name, r.OriginalName) {
+				return r.ProviderID
+			}
+		}
+	}
+	return ""
+}
+
+func matchesName(names []string, candidates ...string) bool {
+	for _, name := range names {
+		for _, c := range candidates {
+			if name != "" && strings.EqualFold(name, c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// copyMovieField copies one field group from src into dst, keeping dst's
+// values where src has none.
+func copyMovieField(dst, src *MovieMetadata, field Field) {
+	switch field {
+	case FieldTitle:
+		if src.Title != "" {
+			dst.Title = src.Title
+		}
+		if src.OriginalTitle != "" {
+			dst.OriginalTitle = src.OriginalTitle
+		}
+		for lang, tr := range src.Translations {
+			if tr.Title != "" {
+				movieTranslation(dst, lang).Title = tr.Title
+			}
+		}
+	case FieldOverview:
+		if src.Overview != nil {
+			dst.Overview = src.Overview
+		}
+		if src.Tagline != nil {
+			dst.Tagline = src.Tagline
+		}
+		for lang, tr := range src.Translations {
+			if tr.Overview != "" {
+				movieTranslation(dst, lang).Overview = tr.Overview
+			}
+			if tr.Tagline != "" {
+				movieTranslation(dst, lang).Tagline = tr.Tagline
+			}
+		}
+	case FieldImages:
+		if src.PosterPath != nil {
+			dst.PosterPath = src.PosterPath
+		}
+		if src.BackdropPath != nil {
+			dst.BackdropPath = src.BackdropPath
+		}
+		if src.LogoPath != nil {
+			dst.LogoPath = src.LogoPath
+		}
+	case FieldRatings:
+		dst.VoteAverage = src.VoteAverage
+		dst.VoteCount = src.VoteCount
+		dst.ExternalRatings = mergeExternalRatings(append([]ExternalRating(nil), src.ExternalRatings...), dst.ExternalRatings)
+	case FieldTags:
+		if len(src.Tags) > 0 {
+			dst.Tags = src.Tags
+		}
+	}
+}
+
+// copyTVShowField copies one field group from src into dst, keeping dst's
+// values where src has none.
+func copyTVShowField(dst, src *TVShowMetadata, field Field) {
+	switch field {
+	case FieldTitle:
+		if src.Name != "" {
+			dst.Name = src.Name
+		}
+		if src.OriginalName != "" {
+			dst.OriginalName = src.OriginalName
+		}
+		for lang, tr := range src.Translations {
+			if tr.Name != "" {
+				tvShowTranslation(dst, lang).Name = tr.Name
+			}
+		}
+	case FieldOverview:
+		if src.Overview != nil {
+			dst.Overview = src.Overview
+		}
+		if src.Tagline != nil {
+			dst.Tagline = src.Tagline
+		}
+		for lang, tr := range src.Translations {
+			if tr.Overview != "" {
+				tvShowTranslation(dst, lang).Overview = tr.Overview
+			}
+			if tr.Tagline != "" {
+				tvShowTranslation(dst, lang).Tagline = tr.Tagline
+			}
+		}
+	case FieldImages:
+		if src.PosterPath != nil {
+			dst.PosterPath = src.PosterPath
+		}
+		if src.BackdropPath != nil {
+			dst.BackdropPath = src.BackdropPath
+		}
+	case FieldRatings:
+		dst.VoteAverage = src.VoteAverage
+		dst.VoteCount = src.VoteCount
+		dst.ExternalRatings = mergeExternalRatings(append([]ExternalRating(nil), src.ExternalRatings...), dst.ExternalRatings)
+	case FieldTags:
+		if len(src.Tags) > 0 {
+			dst.Tags = src.Tags
+		}
+	}
+}
+
+func movieTranslation(m *MovieMetadata, lang string) *LocalizedMovieData {
+	if m.Translations == nil {
+		m.Translations = make(map[string]*LocalizedMovieData)
+	}
+	tr, ok := m.Translations[lang]
+	if !ok {
+		tr = &LocalizedMovieData{Language: lang}
+		m.Translations[lang] = tr
+	}
+	return tr
+}
+
+func tvShowTranslation(m *TVShowMetadata, lang string) *LocalizedTVShowData {
+	if m.Translations == nil {
+		m.Translations = make(map[string]*LocalizedTVShowData)
+	}
+	tr, ok := m.Translations[lang]
+	if !ok {
+		tr = &LocalizedTVShowData{Language: lang}
+		m.Translations[lang] = tr
+	}
+	return tr
+}
+
+// fillMovieIDs copies cross-reference IDs dst is missing from src.
+func fillMovieIDs(dst, src *MovieMetadata) {
+	if dst.IMDbID == nil {
+		dst.IMDbID = src.IMDbID
+	}
+	if dst.TMDbID == nil {
+		dst.TMDbID = src.TMDbID
+	}
+	if dst.TVDbID == nil {
+		dst.TVDbID = src.TVDbID
+	}
+}
+
+// fillTVShowIDs copies cross-reference IDs dst is missing from src.
+func fillTVShowIDs(dst, src *TVShowMetadata) {
+	if dst.IMDbID == nil {
+		dst.IMDbID = src.IMDbID
+	}
+	if dst.TMDbID == nil {
+		dst.TMDbID = src.TMDbID
+	}
+	if dst.TVDbID == nil {
+		dst.TVDbID = src.TVDbID
+	}
+}
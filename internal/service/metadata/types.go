@@ -67,6 +67,7 @@ type MovieMetadata struct {
 
 	// Related data
 	Genres              []Genre
+	Tags                []Tag
 	ProductionCompanies []ProductionCompany
 	ProductionCountries []ProductionCountry
 	SpokenLanguages     []SpokenLanguage
@@ -74,6 +75,11 @@ type MovieMetadata struct {
 
 	// Localized data (populated when fetching multi-language)
 	Translations map[string]*LocalizedMovieData
+
+	// Sources records the provider each field was taken from; ResolvedIDs
+	// holds the movie's IDs at the providers consulted.
+	Sources     FieldSources
+	ResolvedIDs map[ProviderID]string
 }
 
 // LocalizedMovieData contains language-specific movie fields.
@@ -150,6 +156,7 @@ type TVShowMetadata struct {
 
 	// Related data
 	Genres          []Genre
+	Tags            []Tag
 	Networks        []Network
 	CreatedBy       []Creator
 	OriginCountries []string
@@ -160,6 +167,11 @@ type TVShowMetadata struct {
 
 	// Localized data
 	Translations map[string]*LocalizedTVShowData
+
+	// Sources records the provider each field was taken from; ResolvedIDs
+	// holds the show's IDs at the providers consulted.
+	Sources     FieldSources
+	ResolvedIDs map[ProviderID]string
 }
 
 // LocalizedTVShowData contains language-specific TV show fields.
@@ -202,6 +214,9 @@ type SeasonMetadata struct {
 
 	// Localized data
 	Translations map[string]*LocalizedSeasonData
+
+	// Sources records the provider the season was taken from.
+	Sources FieldSources
 }
 
 // LocalizedSeasonData contains language-specific season fields.
@@ -251,6 +266,9 @@ type EpisodeMetadata struct {
 
 	// Localized data
 	Translations map[string]*LocalizedEpisodeData
+
+	// Sources records the provider the episode was taken from.
+	Sources FieldSources
 }
 
 // LocalizedEpisodeData contains language-specific episode fields.
@@ -353,6 +371,29 @@ type CollectionMetadata struct {
 	Parts        []MovieSearchResult
 }
 
+// RecordingSearchResult represents a music recording from a provider.
+type RecordingSearchResult struct {
+	ProviderID string
+	Provider   ProviderID
+
+	Title          string
+	Disambiguation string
+	ArtistCredit   string            // Credited artists joined as printed, e.g. "Jay-Z feat. Rihanna"
+	Artists        []RecordingArtist // Credited artists in credit order
+	Length         time.Duration
+	ReleaseDate    *time.Time // First release; month and day default to 1 when unknown
+	Video          bool       // The recording is a music video
+	Score          float64    // Provider relevance, 0-1; 1 for lookups by ID
+}
+
+// RecordingArtist is an artist credited on a recording.
+type RecordingArtist struct {
+	ProviderID     string
+	Name           string
+	SortName       string
+	Disambiguation string
+}
+
 // Credits contains cast and crew information.
 type Credits struct {
 	Cast []CastMember
@@ -368,6 +409,10 @@ type CastMember struct {
 	CreditID    *string
 	Gender      int
 	ProfilePath *string
+
+	// ExternalIDs holds the person's TMDb, TVDb and IMDb IDs the provider
+	// knows, used to link the credit to a shared person record.
+	ExternalIDs *ExternalIDs
 }
 
 // CrewMember represents a crew member.
@@ -379,6 +424,10 @@ type CrewMember struct {
 	CreditID    *string
 	Gender      int
 	ProfilePath *string
+
+	// ExternalIDs holds the person's TMDb, TVDb and IMDb IDs the provider
+	// knows, used to link the credit to a shared person record.
+	ExternalIDs *ExternalIDs
 }
 
 // Images contains categorized images.
@@ -386,10 +435,19 @@ type Images struct {
 	Posters   []Image
 	Backdrops []Image
 	Logos     []Image
+	ClearArt  []Image
+	Banners   []Image
+	Thumbs    []Image // Landscape thumbnails
 	Stills    []Image // For episodes
 	Profiles  []Image // For people
 }
 
+// ProviderImages are the images one provider offers for an item.
+type ProviderImages struct {
+	Provider ProviderID
+	Images   *Images
+}
+
 // Image represents a single image.
 type Image struct {
 	FilePath    string
@@ -407,6 +465,13 @@ type Genre struct {
 	Name string
 }
 
+// Tag is a keyword or descriptive tag, such as a TMDb keyword or an AniList
+// tag. Spoiler tags reveal plot details.
+type Tag struct {
+	Name    string
+	Spoiler bool
+}
+
 // ProductionCompany represents a production company.
 type ProductionCompany struct {
 	ID            int
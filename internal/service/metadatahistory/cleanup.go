@@ -0,0 +1,76 @@
+package metadatahistory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	infrajobs "github.com/lusoris/revenge/internal/infra/jobs"
+)
+
+// CleanupJobKind is the unique identifier for metadata history cleanup jobs.
+const CleanupJobKind = "metadata_history_cleanup"
+
+// CleanupArgs defines the arguments for metadata history cleanup jobs.
+type CleanupArgs struct {
+	// RetentionDays specifies how many days of changes to keep
+	RetentionDays int `json:"retention_days"`
+}
+
+// Kind returns the job kind identifier.
+func (CleanupArgs) Kind() string {
+	return CleanupJobKind
+}
+
+// InsertOpts returns the default insert options for cleanup jobs.
+// Cleanup runs on the low-priority queue since it's maintenance work.
+func (CleanupArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       infrajobs.QueueLow,
+		MaxAttempts: 3,
+	}
+}
+
+// CleanupWorker removes metadata changes older than the retention period.
+type CleanupWorker struct {
+	river.WorkerDefaults[CleanupArgs]
+	service *Service
+	logger  *slog.Logger
+}
+
+// NewCleanupWorker creates a new metadata history cleanup worker.
+func NewCleanupWorker(service *Service, logger *slog.Logger) *CleanupWorker {
+	return &CleanupWorker{
+		service: service,
+		logger:  logger.With("component", "metadata-history-cleanup"),
+	}
+}
+
+// Timeout returns the maximum execution time for cleanup jobs.
+func (w *CleanupWorker) Timeout(job *river.Job[CleanupArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+// Work executes the cleanup job.
+func (w *CleanupWorker) Work(ctx context.Context, job *river.Job[CleanupArgs]) error {
+	retentionDays := job.Args.RetentionDays
+	if retentionDays <= 0 {
+		retentionDays = 90
+	}
+	olderThan := time.Now().AddDate(0, 0, -retentionDays)
+
+	deleted, err := w.service.Cleanup(ctx, olderThan)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup metadata changes: %w", err)
+	}
+
+	w.logger.Info("metadata history cleanup completed",
+		slog.Int64("job_id", job.ID),
+		slog.Int64("deleted_count", deleted),
+		slog.Time("older_than", olderThan),
+	)
+	return nil
+}
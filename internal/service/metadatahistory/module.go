@@ -0,0 +1,28 @@
+package metadatahistory
+
+import (
+	"github.com/riverqueue/river"
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// Module provides metadata history service dependencies.
+var Module = fx.Module("metadatahistory",
+	fx.Provide(
+		newRepository,
+		NewService,
+		NewCleanupWorker,
+	),
+	fx.Invoke(registerCleanupWorker),
+)
+
+// newRepository creates the metadata history repository.
+func newRepository(queries *db.Queries) Repository {
+	return NewRepositoryPg(queries)
+}
+
+// registerCleanupWorker registers the cleanup worker with River.
+func registerCleanupWorker(workers *river.Workers, worker *CleanupWorker) {
+	river.AddWorker(workers, worker)
+}
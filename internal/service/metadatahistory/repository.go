@@ -0,0 +1,35 @@
+// Package metadatahistory records versioned metadata changes of movies,
+// series, seasons and episodes and computes the values needed to roll an
+// item back to a previous version.
+package metadatahistory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+// Repository defines persistence for metadata changes.
+type Repository interface {
+	Create(ctx context.Context, change *Change) error
+	List(ctx context.Context, contentType string, contentID uuid.UUID, limit, offset int32) ([]Change, error)
+	Count(ctx context.Context, contentType string, contentID uuid.UUID) (int64, error)
+	ListAfter(ctx context.Context, contentType string, contentID uuid.UUID, version int32) ([]Change, error)
+	LatestVersion(ctx context.Context, contentType string, contentID uuid.UUID) (int32, error)
+	DeleteOlderThan(ctx context.Context, olderThan time.Time) (int64, error)
+}
+
+// Change is one version of an item's metadata.
+type Change struct {
+	ID          uuid.UUID                      `json:"id"`
+	ContentType string                         `json:"content_type"`
+	ContentID   uuid.UUID                      `json:"content_id"`
+	Version     int32                          `json:"version"`
+	Source      string                         `json:"source"`
+	ActorID     *uuid.UUID                     `json:"actor_id,omitempty"`
+	Changes     map[string]content.FieldChange `json:"changes"`
+	CreatedAt   time.Time                      `json:"created_at"`
+}
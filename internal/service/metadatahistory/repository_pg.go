@@ -0,0 +1,161 @@
+package metadatahistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// createAttempts bounds how often Create retries when a concurrent change of
+// the same item took the version it computed.
+const createAttempts = 5
+
+// versionConstraint is the unique constraint on the version of an item.
+const versionConstraint = "uq_metadata_change_version"
+
+// RepositoryPg implements Repository using PostgreSQL.
+type RepositoryPg struct {
+	queries *db.Queries
+}
+
+// NewRepositoryPg creates a new PostgreSQL metadata history repository.
+func NewRepositoryPg(queries *db.Queries) *RepositoryPg {
+	return &RepositoryPg{queries: queries}
+}
+
+// Create stores a change as the next version of its item.
+func (r *RepositoryPg) Create(ctx context.Context, change *Change) error {
+	changes, err := json.Marshal(change.Changes)
+	if err != nil {
+		return fmt.Errorf("marshal changes: %w", err)
+	}
+
+	var actorID pgtype.UUID
+	if change.ActorID != nil {
+		actorID = pgtype.UUID{Bytes: *change.ActorID, Valid: true}
+	}
+
+	params := db.CreateMetadataChangeParams{
+		ContentType: change.ContentType,
+		ContentID:   change.ContentID,
+		Source:      change.Source,
+		ActorID:     actorID,
+		Changes:     changes,
+	}
+
+	// The next version is computed in the insert, so concurrent changes of
+	// an item can pick the same one. The loser retries with the new maximum.
+	var result db.MetadataChange
+	for attempt := 1; ; attempt++ {
+		result, err = r.queries.CreateMetadataChange(ctx, params)
+		if err == nil {
+			break
+		}
+		if !isVersionConflict(err) || attempt == createAttempts {
+			return err
+		}
+	}
+
+	created, err := dbChangeToChange(result)
+	if err != nil {
+		return err
+	}
+	*change = *created
+	return nil
+}
+
+// List returns the changes of an item, newest first.
+func (r *RepositoryPg) List(ctx context.Context, contentType string, contentID uuid.UUID, limit, offset int32) ([]Change, error) {
+	rows, err := r.queries.ListMetadataChanges(ctx, db.ListMetadataChangesParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Lim:         limit,
+		Off:         offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbChangesToChanges(rows)
+}
+
+// Count returns the number of recorded changes of an item.
+func (r *RepositoryPg) Count(ctx context.Context, contentType string, contentID uuid.UUID) (int64, error) {
+	return r.queries.CountMetadataChanges(ctx, db.CountMetadataChangesParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+	})
+}
+
+// ListAfter returns the changes of an item made after a version, oldest
+// first.
+func (r *RepositoryPg) ListAfter(ctx context.Context, contentType string, contentID uuid.UUID, version int32) ([]Change, error) {
+	rows, err := r.queries.ListMetadataChangesAfter(ctx, db.ListMetadataChangesAfterParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Version:     version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbChangesToChanges(rows)
+}
+
+// LatestVersion returns the current version of an item, 0 if it has no
+// recorded changes.
+func (r *RepositoryPg) LatestVersion(ctx context.Context, contentType string, contentID uuid.UUID) (int32, error) {
+	return r.queries.GetLatestMetadataVersion(ctx, db.GetLatestMetadataVersionParams{
+		ContentType: contentType,
+		ContentID:   contentID,
+	})
+}
+
+// DeleteOlderThan removes changes recorded before the given time.
+func (r *RepositoryPg) DeleteOlderThan(ctx context.Context, olderThan time.Time) (int64, error) {
+	return r.queries.DeleteOldMetadataChanges(ctx, olderThan)
+}
+
+func dbChangesToChanges(rows []db.MetadataChange) ([]Change, error) {
+	changes := make([]Change, 0, len(rows))
+	for _, row := range rows {
+		change, err := dbChangeToChange(row)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, *change)
+	}
+	return changes, nil
+}
+
+func dbChangeToChange(row db.MetadataChange) (*Change, error) {
+	change := &Change{
+		ID:          row.ID,
+		ContentType: row.ContentType,
+		ContentID:   row.ContentID,
+		Version:     row.Version,
+		Source:      row.Source,
+		CreatedAt:   row.CreatedAt,
+	}
+	if row.ActorID.Valid {
+		actorID := uuid.UUID(row.ActorID.Bytes)
+		change.ActorID = &actorID
+	}
+	if err := json.Unmarshal(row.Changes, &change.Changes); err != nil {
+		return nil, fmt.Errorf("unmarshal changes of version %d: %w", row.Version, err)
+	}
+	return change, nil
+}
+
+// isVersionConflict reports whether err is a violation of the unique version
+// of an item.
+func isVersionConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == versionConstraint
+}
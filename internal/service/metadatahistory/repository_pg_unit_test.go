@@ -0,0 +1,90 @@
+package metadatahistory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// sequenceDBTX is a mock DBTX whose QueryRow calls fail with the given errors
+// in order, repeating the last one.
+type sequenceDBTX struct {
+	errs  []error
+	calls int
+}
+
+func (s *sequenceDBTX) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (s *sequenceDBTX) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *sequenceDBTX) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	err := s.errs[min(s.calls, len(s.errs)-1)]
+	s.calls++
+	return &errRow{err: err}
+}
+
+func (s *sequenceDBTX) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, nil
+}
+
+// errRow is a mock pgx.Row that always returns an error on Scan.
+type errRow struct {
+	err error
+}
+
+func (r *errRow) Scan(dest ...any) error {
+	return r.err
+}
+
+func newTestChange() *Change {
+	return &Change{
+		ContentType: "movie",
+		ContentID:   uuid.Must(uuid.NewV7()),
+		Source:      content.ChangeSourceManual,
+		Changes:     map[string]content.FieldChange{"title": {Old: new("Old"), New: new("New")}},
+	}
+}
+
+func TestRepositoryPg_Create_RetriesVersionConflict(t *testing.T) {
+	conflict := &pgconn.PgError{Code: "23505", ConstraintName: versionConstraint}
+	final := errors.New("connection reset")
+	dbtx := &sequenceDBTX{errs: []error{conflict, conflict, final}}
+	repo := NewRepositoryPg(db.New(dbtx))
+
+	err := repo.Create(context.Background(), newTestChange())
+	require.ErrorIs(t, err, final)
+	assert.Equal(t, 3, dbtx.calls)
+}
+
+func TestRepositoryPg_Create_GivesUpAfterAttempts(t *testing.T) {
+	conflict := &pgconn.PgError{Code: "23505", ConstraintName: versionConstraint}
+	dbtx := &sequenceDBTX{errs: []error{conflict}}
+	repo := NewRepositoryPg(db.New(dbtx))
+
+	err := repo.Create(context.Background(), newTestChange())
+	require.ErrorIs(t, err, conflict)
+	assert.Equal(t, createAttempts, dbtx.calls)
+}
+
+func TestRepositoryPg_Create_DoesNotRetryOtherErrors(t *testing.T) {
+	other := &pgconn.PgError{Code: "23503", ConstraintName: "fk_metadata_change_actor"}
+	dbtx := &sequenceDBTX{errs: []error{other}}
+	repo := NewRepositoryPg(db.New(dbtx))
+
+	err := repo.Create(context.Background(), newTestChange())
+	require.ErrorIs(t, err, other)
+	assert.Equal(t, 1, dbtx.calls)
+}
@@ -0,0 +1,110 @@
+package metadatahistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+// ErrVersionNotFound is returned when rolling back to a version that does not
+// exist or whose later changes were removed by the retention cleanup.
+var ErrVersionNotFound = errors.New("metadata version not found")
+
+// Service records metadata changes and resolves previous versions.
+type Service struct {
+	repo   Repository
+	logger *slog.Logger
+}
+
+// NewService creates a new metadata history service.
+func NewService(repo Repository, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		logger: logger.With("component", "metadata-history"),
+	}
+}
+
+// RecordChange stores the changed fields as the next version of an item,
+// tagged with the source and actor of the context. It implements
+// content.ChangeRecorder; failures are logged and never fail the write.
+func (s *Service) RecordChange(ctx context.Context, contentType string, contentID uuid.UUID, changes map[string]content.FieldChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	source, actorID := content.ChangeSourceFromContext(ctx)
+	change := &Change{
+		ContentType: contentType,
+		ContentID:   contentID,
+		Source:      source,
+		ActorID:     actorID,
+		Changes:     changes,
+	}
+	if err := s.repo.Create(ctx, change); err != nil {
+		s.logger.Warn("failed to record metadata change",
+			slog.String("content_type", contentType),
+			slog.String("content_id", contentID.String()),
+			slog.String("source", source),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// List returns the changes of an item, newest first, and the total count.
+func (s *Service) List(ctx context.Context, contentType string, contentID uuid.UUID, limit, offset int32) ([]Change, int64, error) {
+	changes, err := s.repo.List(ctx, contentType, contentID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list metadata changes: %w", err)
+	}
+	total, err := s.repo.Count(ctx, contentType, contentID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count metadata changes: %w", err)
+	}
+	return changes, total, nil
+}
+
+// ValuesAt returns the values the fields changed after a version had at that
+// version. Writing them back rolls the item back; fields unchanged since the
+// version are not included. Version 0 is the state before the first recorded
+// change.
+func (s *Service) ValuesAt(ctx context.Context, contentType string, contentID uuid.UUID, version int32) (content.Snapshot, error) {
+	latest, err := s.repo.LatestVersion(ctx, contentType, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("get latest version: %w", err)
+	}
+	if version < 0 || version > latest {
+		return nil, ErrVersionNotFound
+	}
+
+	changes, err := s.repo.ListAfter(ctx, contentType, contentID, version)
+	if err != nil {
+		return nil, fmt.Errorf("list metadata changes: %w", err)
+	}
+	if len(changes) > 0 && changes[0].Version != version+1 {
+		// The changes right after the version were removed by the cleanup.
+		return nil, ErrVersionNotFound
+	}
+
+	// The oldest change after the version holds the field's value at the
+	// version.
+	values := make(content.Snapshot)
+	for _, change := range changes {
+		for field, fc := range change.Changes {
+			if _, ok := values[field]; !ok {
+				values[field] = fc.Old
+			}
+		}
+	}
+	return values, nil
+}
+
+// Cleanup removes changes recorded before olderThan.
+func (s *Service) Cleanup(ctx context.Context, olderThan time.Time) (int64, error) {
+	return s.repo.DeleteOlderThan(ctx, olderThan)
+}
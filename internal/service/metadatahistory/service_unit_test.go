@@ -0,0 +1,128 @@
+package metadatahistory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/metadatahistory"
+)
+
+// mockRepository implements metadatahistory.Repository for testing.
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) Create(ctx context.Context, change *metadatahistory.Change) error {
+	return m.Called(ctx, change).Error(0)
+}
+
+func (m *mockRepository) List(ctx context.Context, contentType string, contentID uuid.UUID, limit, offset int32) ([]metadatahistory.Change, error) {
+	args := m.Called(ctx, contentType, contentID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]metadatahistory.Change), args.Error(1)
+}
+
+func (m *mockRepository) Count(ctx context.Context, contentType string, contentID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, contentType, contentID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockRepository) ListAfter(ctx context.Context, contentType string, contentID uuid.UUID, version int32) ([]metadatahistory.Change, error) {
+	args := m.Called(ctx, contentType, contentID, version)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]metadatahistory.Change), args.Error(1)
+}
+
+func (m *mockRepository) LatestVersion(ctx context.Context, contentType string, contentID uuid.UUID) (int32, error) {
+	args := m.Called(ctx, contentType, contentID)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *mockRepository) DeleteOlderThan(ctx context.Context, olderThan time.Time) (int64, error) {
+	args := m.Called(ctx, olderThan)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newTestService(repo metadatahistory.Repository) *metadatahistory.Service {
+	return metadatahistory.NewService(repo, logging.NewTestLogger())
+}
+
+func TestService_RecordChange(t *testing.T) {
+	repo := new(mockRepository)
+	svc := newTestService(repo)
+	movieID := uuid.New()
+	userID := uuid.New()
+	changes := map[string]content.FieldChange{content.FieldTitle: {Old: new("Alien"), New: new("Aliens")}}
+
+	ctx := content.WithChangeSource(context.Background(), content.ChangeSourceManual, &userID)
+	repo.On("Create", ctx, mock.MatchedBy(func(c *metadatahistory.Change) bool {
+		return c.ContentType == content.ContentTypeMovie && c.ContentID == movieID &&
+			c.Source == content.ChangeSourceManual && *c.ActorID == userID && len(c.Changes) == 1
+	})).Return(errors.New("db down")).Once()
+
+	// Failures are logged, not returned.
+	svc.RecordChange(ctx, content.ContentTypeMovie, movieID, changes)
+
+	// Empty diffs are not recorded.
+	svc.RecordChange(ctx, content.ContentTypeMovie, movieID, nil)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ValuesAt(t *testing.T) {
+	ctx := context.Background()
+	seriesID := uuid.New()
+	later := []metadatahistory.Change{
+		{Version: 3, Changes: map[string]content.FieldChange{
+			content.FieldTitle:  {Old: new("Dark"), New: new("Dark (2017)")},
+			content.FieldPoster: {New: new("/p.jpg")},
+		}},
+		{Version: 4, Changes: map[string]content.FieldChange{
+			content.FieldTitle:    {Old: new("Dark (2017)"), New: new("DARK")},
+			content.FieldOverview: {Old: new("A missing child"), New: new("Time travel")},
+		}},
+	}
+
+	t.Run("oldest later change wins", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("LatestVersion", ctx, content.ContentTypeSeries, seriesID).Return(int32(4), nil)
+		repo.On("ListAfter", ctx, content.ContentTypeSeries, seriesID, int32(2)).Return(later, nil)
+
+		values, err := newTestService(repo).ValuesAt(ctx, content.ContentTypeSeries, seriesID, 2)
+		require.NoError(t, err)
+		assert.Equal(t, content.Snapshot{
+			content.FieldTitle:    new("Dark"),
+			content.FieldPoster:   nil,
+			content.FieldOverview: new("A missing child"),
+		}, values)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("LatestVersion", ctx, content.ContentTypeSeries, seriesID).Return(int32(4), nil)
+
+		_, err := newTestService(repo).ValuesAt(ctx, content.ContentTypeSeries, seriesID, 5)
+		assert.ErrorIs(t, err, metadatahistory.ErrVersionNotFound)
+	})
+
+	t.Run("later changes removed by cleanup", func(t *testing.T) {
+		repo := new(mockRepository)
+		repo.On("LatestVersion", ctx, content.ContentTypeSeries, seriesID).Return(int32(4), nil)
+		repo.On("ListAfter", ctx, content.ContentTypeSeries, seriesID, int32(1)).Return(later, nil)
+
+		_, err := newTestService(repo).ValuesAt(ctx, content.ContentTypeSeries, seriesID, 1)
+		assert.ErrorIs(t, err, metadatahistory.ErrVersionNotFound)
+	})
+}
@@ -21,16 +21,22 @@ const (
 	EventMovieUpdated   EventType = "movie.updated"
 	EventMovieDeleted   EventType = "movie.deleted"
 
+	// EventPodcastEpisodeAdded is raised when a feed refresh finds new
+	// episodes. It is sent once per podcast with the subscribers' IDs.
+	EventPodcastEpisodeAdded EventType = "podcast.episode_added"
+
 	// Request events
 	EventRequestCreated  EventType = "request.created"
 	EventRequestApproved EventType = "request.approved"
 	EventRequestDenied   EventType = "request.denied"
 
 	// Library events
-	EventLibraryScanStarted EventType = "library.scan_started"
-	EventLibraryScanDone    EventType = "library.scan_done"
-	EventLibraryCreated     EventType = "library.created"
-	EventLibraryDeleted     EventType = "library.deleted"
+	EventLibraryScanStarted   EventType = "library.scan_started"
+	EventLibraryScanProgress  EventType = "library.scan_progress"
+	EventLibraryScanDone      EventType = "library.scan_done"
+	EventLibraryScanCancelled EventType = "library.scan_cancelled"
+	EventLibraryCreated       EventType = "library.created"
+	EventLibraryDeleted       EventType = "library.deleted"
 
 	// User events
 	EventUserCreated EventType = "user.created"
@@ -74,11 +80,13 @@ const (
 // GetCategory returns the category for an event type
 func (e EventType) GetCategory() EventCategory {
 	switch e {
-	case EventMovieAdded, EventMovieAvailable, EventMovieUpdated, EventMovieDeleted:
+	case EventMovieAdded, EventMovieAvailable, EventMovieUpdated, EventMovieDeleted,
+		EventPodcastEpisodeAdded:
 		return CategoryContent
 	case EventRequestCreated, EventRequestApproved, EventRequestDenied:
 		return CategoryRequests
-	case EventLibraryScanStarted, EventLibraryScanDone, EventLibraryCreated, EventLibraryDeleted:
+	case EventLibraryScanStarted, EventLibraryScanProgress, EventLibraryScanDone, EventLibraryScanCancelled,
+		EventLibraryCreated, EventLibraryDeleted:
 		return CategoryLibrary
 	case EventUserCreated, EventUserDeleted, EventUserUpdated:
 		return CategoryUser
@@ -91,6 +99,13 @@ func (e EventType) GetCategory() EventCategory {
 	}
 }
 
+// IsLiveOnly reports whether the event is a high-frequency update meant for
+// live clients. Agents only send such events when they list the type
+// explicitly.
+func (e EventType) IsLiveOnly() bool {
+	return e == EventLibraryScanProgress
+}
+
 // String returns the string representation of the event type
 func (e EventType) String() string {
 	return string(e)
@@ -191,6 +206,11 @@ func (c *AgentConfig) ShouldSend(eventType EventType) bool {
 		return false
 	}
 
+	// Live-only events need an explicit opt-in
+	if eventType.IsLiveOnly() {
+		return slices.Contains(c.EventTypes, eventType)
+	}
+
 	// If no specific types or categories configured, send all
 	if len(c.EventTypes) == 0 && len(c.EventCategories) == 0 {
 		return true
@@ -16,11 +16,14 @@ func TestEventType_GetCategory(t *testing.T) {
 		{EventMovieAvailable, CategoryContent},
 		{EventMovieUpdated, CategoryContent},
 		{EventMovieDeleted, CategoryContent},
+		{EventPodcastEpisodeAdded, CategoryContent},
 		{EventRequestCreated, CategoryRequests},
 		{EventRequestApproved, CategoryRequests},
 		{EventRequestDenied, CategoryRequests},
 		{EventLibraryScanStarted, CategoryLibrary},
+		{EventLibraryScanProgress, CategoryLibrary},
 		{EventLibraryScanDone, CategoryLibrary},
+		{EventLibraryScanCancelled, CategoryLibrary},
 		{EventUserCreated, CategoryUser},
 		{EventLoginSuccess, CategoryAuth},
 		{EventLoginFailed, CategoryAuth},
@@ -140,6 +143,30 @@ func TestAgentConfig_ShouldSend(t *testing.T) {
 			eventType:  EventMovieAdded,
 			shouldSend: false,
 		},
+		{
+			name:       "live-only event not sent without opt-in",
+			config:     AgentConfig{Enabled: true},
+			eventType:  EventLibraryScanProgress,
+			shouldSend: false,
+		},
+		{
+			name: "live-only event not sent for matching category",
+			config: AgentConfig{
+				Enabled:         true,
+				EventCategories: []EventCategory{CategoryLibrary},
+			},
+			eventType:  EventLibraryScanProgress,
+			shouldSend: false,
+		},
+		{
+			name: "live-only event sent when listed",
+			config: AgentConfig{
+				Enabled:    true,
+				EventTypes: []EventType{EventLibraryScanProgress},
+			},
+			eventType:  EventLibraryScanProgress,
+			shouldSend: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,27 @@
+package parental
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// Module provides parental controls service dependencies.
+var Module = fx.Module("parental",
+	fx.Provide(
+		newRepository,
+		newService,
+	),
+)
+
+// newRepository creates the parental controls repository.
+func newRepository(queries *db.Queries) Repository {
+	return NewRepositoryPg(queries)
+}
+
+// newService creates the parental controls service.
+func newService(repo Repository, logger *slog.Logger) *Service {
+	return NewService(repo, logger)
+}
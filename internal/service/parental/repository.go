@@ -0,0 +1,57 @@
+package parental
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned when a user has no parental controls.
+var ErrNotFound = errors.New("parental controls not found")
+
+// UnratedPolicy decides whether unrated content passes an age limit.
+type UnratedPolicy string
+
+const (
+	UnratedAllow UnratedPolicy = "allow"
+	UnratedBlock UnratedPolicy = "block"
+)
+
+// Repository defines persistence for parental controls.
+type Repository interface {
+	Get(ctx context.Context, userID uuid.UUID) (*Controls, error)
+	Upsert(ctx context.Context, controls Controls) (*Controls, error)
+	SetOverride(ctx context.Context, userID uuid.UUID, until *time.Time) (*Controls, error)
+	RecordPINFailure(ctx context.Context, userID uuid.UUID, maxAttempts int32, lockedUntil time.Time) (*Controls, error)
+}
+
+// Controls are the content restrictions of a user profile.
+type Controls struct {
+	UserID        uuid.UUID     `json:"user_id"`
+	MaxAge        *int32        `json:"max_age,omitempty"` // Highest allowed minimum viewer age, nil for no age limit
+	BlockedTags   []string      `json:"blocked_tags"`      // Genre slugs that are never shown
+	UnratedPolicy UnratedPolicy `json:"unrated_policy"`
+	PINHash       *string       `json:"-"`
+	OverrideUntil *time.Time    `json:"override_until,omitempty"` // Restrictions are lifted until then
+	UpdatedAt     time.Time     `json:"updated_at"`
+
+	FailedPINAttempts int32      `json:"-"` // Wrong PINs since the last unlock or lockout
+	PINLockedUntil    *time.Time `json:"-"` // PIN entry is refused until then
+}
+
+// HasPIN reports whether an override PIN is set.
+func (c *Controls) HasPIN() bool {
+	return c.PINHash != nil
+}
+
+// Restricted reports whether the controls limit any content.
+func (c *Controls) Restricted() bool {
+	return c.MaxAge != nil || len(c.BlockedTags) > 0
+}
+
+// OverrideActive reports whether the restrictions are lifted at now.
+func (c *Controls) OverrideActive(now time.Time) bool {
+	return c.OverrideUntil != nil && now.Before(*c.OverrideUntil)
+}
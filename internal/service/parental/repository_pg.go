@@ -0,0 +1,110 @@
+package parental
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/lusoris/revenge/internal/infra/database/db"
+)
+
+// RepositoryPg implements Repository using PostgreSQL.
+type RepositoryPg struct {
+	queries *db.Queries
+}
+
+// NewRepositoryPg creates a new PostgreSQL parental controls repository.
+func NewRepositoryPg(queries *db.Queries) *RepositoryPg {
+	return &RepositoryPg{queries: queries}
+}
+
+// Get retrieves the parental controls of a user.
+func (r *RepositoryPg) Get(ctx context.Context, userID uuid.UUID) (*Controls, error) {
+	row, err := r.queries.GetUserParentalControls(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbControlsToControls(row), nil
+}
+
+// Upsert creates or replaces the restrictions and PIN of a user.
+func (r *RepositoryPg) Upsert(ctx context.Context, controls Controls) (*Controls, error) {
+	blockedTags := controls.BlockedTags
+	if blockedTags == nil {
+		blockedTags = []string{}
+	}
+	row, err := r.queries.UpsertUserParentalControls(ctx, db.UpsertUserParentalControlsParams{
+		UserID:        controls.UserID,
+		MaxAge:        controls.MaxAge,
+		BlockedTags:   blockedTags,
+		UnratedPolicy: string(controls.UnratedPolicy),
+		PinHash:       controls.PINHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dbControlsToControls(row), nil
+}
+
+// SetOverride lifts the restrictions of a user until the given time, or
+// restores them when until is nil.
+func (r *RepositoryPg) SetOverride(ctx context.Context, userID uuid.UUID, until *time.Time) (*Controls, error) {
+	var overrideUntil pgtype.Timestamptz
+	if until != nil {
+		overrideUntil = pgtype.Timestamptz{Time: *until, Valid: true}
+	}
+	row, err := r.queries.SetUserParentalOverride(ctx, db.SetUserParentalOverrideParams{
+		UserID:        userID,
+		OverrideUntil: overrideUntil,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbControlsToControls(row), nil
+}
+
+// RecordPINFailure counts a wrong override PIN. Reaching maxAttempts locks
+// PIN entry until lockedUntil.
+func (r *RepositoryPg) RecordPINFailure(ctx context.Context, userID uuid.UUID, maxAttempts int32, lockedUntil time.Time) (*Controls, error) {
+	row, err := r.queries.RecordUserParentalPINFailure(ctx, db.RecordUserParentalPINFailureParams{
+		UserID:      userID,
+		MaxAttempts: maxAttempts,
+		LockedUntil: lockedUntil,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbControlsToControls(row), nil
+}
+
+func dbControlsToControls(row db.SharedUserParentalControl) *Controls {
+	controls := &Controls{
+		UserID:            row.UserID,
+		MaxAge:            row.MaxAge,
+		BlockedTags:       row.BlockedTags,
+		UnratedPolicy:     UnratedPolicy(row.UnratedPolicy),
+		PINHash:           row.PinHash,
+		FailedPINAttempts: row.PinFailedAttempts,
+		UpdatedAt:         row.UpdatedAt,
+	}
+	if row.OverrideUntil.Valid {
+		controls.OverrideUntil = &row.OverrideUntil.Time
+	}
+	if row.PinLockedUntil.Valid {
+		controls.PINLockedUntil = &row.PinLockedUntil.Time
+	}
+	return controls
+}
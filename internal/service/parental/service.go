@@ -0,0 +1,171 @@
+package parental
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/crypto"
+)
+
+var (
+	// ErrInvalidPIN is returned when an override PIN does not match.
+	ErrInvalidPIN = errors.New("invalid parental controls PIN")
+	// ErrNoPIN is returned when an override is requested but no PIN is set.
+	ErrNoPIN = errors.New("no parental controls PIN set")
+	// ErrInvalidControls is returned for restrictions that cannot be stored.
+	ErrInvalidControls = errors.New("invalid parental controls")
+	// ErrPINLockedOut is returned while PIN entry is locked after too many
+	// wrong PINs.
+	ErrPINLockedOut = errors.New("too many invalid parental controls PINs, try again later")
+)
+
+const (
+	// OverrideDuration is how long restrictions stay lifted after the PIN
+	// was entered.
+	OverrideDuration = time.Hour
+	// maxAgeLimit is the highest age limit that can be set.
+	maxAgeLimit = 21
+)
+
+// UpdateParams holds the restrictions an admin sets for a user.
+type UpdateParams struct {
+	MaxAge        *int32
+	BlockedTags   []string
+	UnratedPolicy UnratedPolicy
+	PIN           *string // nil keeps the current PIN, empty removes it
+}
+
+// Service manages per-user parental controls and derives the access
+// filters enforced by the content modules.
+type Service struct {
+	repo   Repository
+	hasher *crypto.PasswordHasher
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewService creates a parental controls service.
+func NewService(repo Repository, logger *slog.Logger) *Service {
+	return &Service{
+		repo:   repo,
+		hasher: crypto.NewPasswordHasher(),
+		logger: logger.With("component", "parental"),
+		now:    time.Now,
+	}
+}
+
+// Get returns the parental controls of a user. Users without controls get
+// unrestricted defaults.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID) (*Controls, error) {
+	controls, err := s.repo.Get(ctx, userID)
+	if errors.Is(err, ErrNotFound) {
+		return &Controls{UserID: userID, BlockedTags: []string{}, UnratedPolicy: UnratedAllow}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get parental controls: %w", err)
+	}
+	return controls, nil
+}
+
+// Update replaces the restrictions of a user and sets or removes the
+// override PIN.
+func (s *Service) Update(ctx context.Context, userID uuid.UUID, params UpdateParams) (*Controls, error) {
+	if params.MaxAge != nil && (*params.MaxAge < 0 || *params.MaxAge > maxAgeLimit) {
+		return nil, fmt.Errorf("%w: max age must be between 0 and %d", ErrInvalidControls, maxAgeLimit)
+	}
+	policy := params.UnratedPolicy
+	if policy == "" {
+		policy = UnratedAllow
+	}
+	if policy != UnratedAllow && policy != UnratedBlock {
+		return nil, fmt.Errorf("%w: unknown unrated policy %q", ErrInvalidControls, policy)
+	}
+
+	current, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	pinHash := current.PINHash
+	if params.PIN != nil {
+		pinHash = nil
+		if *params.PIN != "" {
+			if !content.ValidPIN(*params.PIN) {
+				return nil, fmt.Errorf("%w: PIN must be %d to %d digits", ErrInvalidControls, content.MinPINLength, content.MaxPINLength)
+			}
+			hash, err := s.hasher.HashPasswordContext(ctx, *params.PIN)
+			if err != nil {
+				return nil, fmt.Errorf("hash PIN: %w", err)
+			}
+			pinHash = &hash
+		}
+	}
+
+	controls, err := s.repo.Upsert(ctx, Controls{
+		UserID:        userID,
+		MaxAge:        params.MaxAge,
+		BlockedTags:   params.BlockedTags,
+		UnratedPolicy: policy,
+		PINHash:       pinHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update parental controls: %w", err)
+	}
+	return controls, nil
+}
+
+// Unlock lifts the restrictions of a user for OverrideDuration when the PIN
+// matches. After content.MaxPINAttempts wrong PINs in a row, PIN entry is
+// refused for content.PINLockoutDuration.
+func (s *Service) Unlock(ctx context.Context, userID uuid.UUID, pin string) (*Controls, error) {
+	controls, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !controls.HasPIN() {
+		return nil, ErrNoPIN
+	}
+	if content.PINLockedOut(controls.PINLockedUntil, s.now()) {
+		return nil, ErrPINLockedOut
+	}
+	ok, err := s.hasher.VerifyPasswordContext(ctx, pin, *controls.PINHash)
+	if err != nil {
+		return nil, fmt.Errorf("verify PIN: %w", err)
+	}
+	if !ok {
+		s.logger.Warn("invalid parental controls PIN", slog.String("user_id", userID.String()))
+		if _, err := s.repo.RecordPINFailure(ctx, userID, content.MaxPINAttempts, s.now().Add(content.PINLockoutDuration)); err != nil {
+			return nil, fmt.Errorf("record PIN failure: %w", err)
+		}
+		return nil, ErrInvalidPIN
+	}
+
+	until := s.now().Add(OverrideDuration)
+	controls, err = s.repo.SetOverride(ctx, userID, &until)
+	if err != nil {
+		return nil, fmt.Errorf("set parental override: %w", err)
+	}
+	return controls, nil
+}
+
+// Filter returns the access filter enforced for a user, or nil when the
+// user is unrestricted or the restrictions are lifted by an override.
+func (s *Service) Filter(ctx context.Context, userID uuid.UUID) (*content.AccessFilter, error) {
+	controls, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !controls.Restricted() || controls.OverrideActive(s.now()) {
+		return nil, nil
+	}
+	return &content.AccessFilter{
+		MaxAge:       controls.MaxAge,
+		AllowUnrated: controls.UnratedPolicy != UnratedBlock,
+		BlockedTags:  controls.BlockedTags,
+	}, nil
+}
@@ -0,0 +1,207 @@
+package parental_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/crypto"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/parental"
+)
+
+// mockRepository implements parental.Repository for testing.
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) Get(ctx context.Context, userID uuid.UUID) (*parental.Controls, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*parental.Controls), args.Error(1)
+}
+
+func (m *mockRepository) Upsert(ctx context.Context, controls parental.Controls) (*parental.Controls, error) {
+	args := m.Called(ctx, controls)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*parental.Controls), args.Error(1)
+}
+
+func (m *mockRepository) SetOverride(ctx context.Context, userID uuid.UUID, until *time.Time) (*parental.Controls, error) {
+	args := m.Called(ctx, userID, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*parental.Controls), args.Error(1)
+}
+
+func (m *mockRepository) RecordPINFailure(ctx context.Context, userID uuid.UUID, maxAttempts int32, lockedUntil time.Time) (*parental.Controls, error) {
+	args := m.Called(ctx, userID, maxAttempts, lockedUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*parental.Controls), args.Error(1)
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestService_Get_Defaults(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := parental.NewService(repo, logging.NewTestLogger())
+	repo.On("Get", ctx, userID).Return(nil, parental.ErrNotFound)
+
+	controls, err := svc.Get(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, userID, controls.UserID)
+	assert.Equal(t, parental.UnratedAllow, controls.UnratedPolicy)
+	assert.False(t, controls.Restricted())
+}
+
+func TestService_Update(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := parental.NewService(repo, logging.NewTestLogger())
+	repo.On("Get", ctx, userID).Return(nil, parental.ErrNotFound)
+	repo.On("Upsert", ctx, mock.MatchedBy(func(c parental.Controls) bool {
+		return c.UserID == userID && *c.MaxAge == 12 && c.UnratedPolicy == parental.UnratedBlock &&
+			c.PINHash != nil && *c.PINHash != "1234"
+	})).Return(&parental.Controls{UserID: userID, MaxAge: ptr(int32(12))}, nil)
+
+	_, err := svc.Update(ctx, userID, parental.UpdateParams{
+		MaxAge:        ptr(int32(12)),
+		BlockedTags:   []string{"horror"},
+		UnratedPolicy: parental.UnratedBlock,
+		PIN:           ptr("1234"),
+	})
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_Update_Invalid(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := parental.NewService(repo, logging.NewTestLogger())
+	repo.On("Get", ctx, userID).Return(nil, parental.ErrNotFound)
+
+	_, err := svc.Update(ctx, userID, parental.UpdateParams{MaxAge: ptr(int32(30))})
+	assert.ErrorIs(t, err, parental.ErrInvalidControls)
+
+	_, err = svc.Update(ctx, userID, parental.UpdateParams{UnratedPolicy: "maybe"})
+	assert.ErrorIs(t, err, parental.ErrInvalidControls)
+
+	_, err = svc.Update(ctx, userID, parental.UpdateParams{PIN: ptr("12ab")})
+	assert.ErrorIs(t, err, parental.ErrInvalidControls)
+
+	repo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
+
+func TestService_Unlock(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+
+	hash, err := crypto.NewPasswordHasher().HashPassword("4321")
+	require.NoError(t, err)
+
+	repo := new(mockRepository)
+	svc := parental.NewService(repo, logging.NewTestLogger())
+	repo.On("Get", ctx, userID).Return(&parental.Controls{UserID: userID, MaxAge: ptr(int32(12)), PINHash: &hash}, nil)
+
+	repo.On("RecordPINFailure", ctx, userID, int32(content.MaxPINAttempts), mock.MatchedBy(func(until time.Time) bool {
+		return time.Until(until) > content.PINLockoutDuration-time.Minute
+	})).Return(&parental.Controls{UserID: userID}, nil).Once()
+
+	_, err = svc.Unlock(ctx, userID, "0000")
+	assert.ErrorIs(t, err, parental.ErrInvalidPIN)
+
+	repo.On("SetOverride", ctx, userID, mock.MatchedBy(func(until *time.Time) bool {
+		return until != nil && time.Until(*until) > parental.OverrideDuration-time.Minute
+	})).Return(&parental.Controls{UserID: userID}, nil)
+
+	_, err = svc.Unlock(ctx, userID, "4321")
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestService_Unlock_LockedOut(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+
+	hash, err := crypto.NewPasswordHasher().HashPassword("4321")
+	require.NoError(t, err)
+
+	repo := new(mockRepository)
+	svc := parental.NewService(repo, logging.NewTestLogger())
+	repo.On("Get", ctx, userID).Return(&parental.Controls{
+		UserID:         userID,
+		MaxAge:         ptr(int32(12)),
+		PINHash:        &hash,
+		PINLockedUntil: ptr(time.Now().Add(time.Minute)),
+	}, nil)
+
+	// The right PIN is refused too while PIN entry is locked.
+	_, err = svc.Unlock(ctx, userID, "4321")
+	assert.ErrorIs(t, err, parental.ErrPINLockedOut)
+	repo.AssertNotCalled(t, "SetOverride", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "RecordPINFailure", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Unlock_NoPIN(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := parental.NewService(repo, logging.NewTestLogger())
+	repo.On("Get", ctx, userID).Return(&parental.Controls{UserID: userID, MaxAge: ptr(int32(12))}, nil)
+
+	_, err := svc.Unlock(ctx, userID, "1234")
+	assert.ErrorIs(t, err, parental.ErrNoPIN)
+}
+
+func TestService_Filter(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		controls *parental.Controls
+		want     bool
+	}{
+		{"unrestricted", &parental.Controls{UnratedPolicy: parental.UnratedAllow}, false},
+		{"age limit", &parental.Controls{MaxAge: ptr(int32(12)), UnratedPolicy: parental.UnratedBlock}, true},
+		{"blocked tags", &parental.Controls{BlockedTags: []string{"horror"}, UnratedPolicy: parental.UnratedAllow}, true},
+		{"override active", &parental.Controls{MaxAge: ptr(int32(12)), OverrideUntil: ptr(time.Now().Add(time.Hour))}, false},
+		{"override expired", &parental.Controls{MaxAge: ptr(int32(12)), OverrideUntil: ptr(time.Now().Add(-time.Hour))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.Must(uuid.NewV7())
+			repo := new(mockRepository)
+			svc := parental.NewService(repo, logging.NewTestLogger())
+			repo.On("Get", ctx, userID).Return(tt.controls, nil)
+
+			filter, err := svc.Filter(ctx, userID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter != nil)
+			if filter != nil {
+				assert.Equal(t, tt.controls.MaxAge, filter.MaxAge)
+				assert.Equal(t, tt.controls.UnratedPolicy != parental.UnratedBlock, filter.AllowUnrated)
+			}
+		})
+	}
+}
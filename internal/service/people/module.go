@@ -0,0 +1,43 @@
+package people
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/lusoris/revenge/internal/infra/database/db"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// Module provides people service dependencies.
+var Module = fx.Module("people",
+	fx.Provide(
+		newRepository,
+		newService,
+	),
+)
+
+// newRepository creates the people repository.
+func newRepository(queries *db.Queries) Repository {
+	return NewRepositoryPg(queries)
+}
+
+// serviceParams holds the people service dependencies.
+type serviceParams struct {
+	fx.In
+
+	Repo     Repository
+	Metadata metadata.Service `optional:"true"`
+	Artwork  *artwork.Service `optional:"true"`
+	Logger   *slog.Logger
+}
+
+// newService creates the people service.
+func newService(p serviceParams) *Service {
+	var provider MetadataProvider
+	if p.Metadata != nil {
+		provider = p.Metadata
+	}
+	return NewService(p.Repo, provider, p.Artwork, p.Logger)
+}
@@ -0,0 +1,111 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// ErrNotFound is returned when a person is not found.
+var ErrNotFound = errors.New("person not found")
+
+// Repository defines persistence for people and their credits.
+type Repository interface {
+	Get(ctx context.Context, id uuid.UUID) (*Person, error)
+	GetByTMDbID(ctx context.Context, tmdbID int32) (*Person, error)
+	UpdateMetadata(ctx context.Context, id uuid.UUID, update MetadataUpdate) (*Person, error)
+	ListMovieCredits(ctx context.Context, personID uuid.UUID) ([]MovieCredit, error)
+	ListSeriesCredits(ctx context.Context, personID uuid.UUID) ([]SeriesCredit, error)
+	SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error
+}
+
+// Person is a cast or crew member shared by all content modules.
+type Person struct {
+	ID                 uuid.UUID                 `json:"id"`
+	TMDbID             *int32                    `json:"tmdb_id,omitempty"`
+	TVDbID             *int32                    `json:"tvdb_id,omitempty"`
+	IMDbID             *string                   `json:"imdb_id,omitempty"`
+	Name               string                    `json:"name"`
+	AlsoKnownAs        []string                  `json:"also_known_as,omitempty"`
+	Biography          *string                   `json:"biography,omitempty"`
+	Biographies        map[string]string         `json:"biographies,omitempty"`
+	Birthday           *time.Time                `json:"birthday,omitempty"`
+	Deathday           *time.Time                `json:"deathday,omitempty"`
+	Gender             int32                     `json:"gender"`
+	PlaceOfBirth       *string                   `json:"place_of_birth,omitempty"`
+	ProfilePath        *string                   `json:"profile_path,omitempty"`
+	Homepage           *string                   `json:"homepage,omitempty"`
+	KnownForDepartment *string                   `json:"known_for_department,omitempty"`
+	Popularity         *float64                  `json:"popularity,omitempty"`
+	MetadataUpdatedAt  *time.Time                `json:"metadata_updated_at,omitempty"`
+	ImagePlaceholders  content.ImagePlaceholders `json:"image_placeholders,omitempty"`
+	CreatedAt          time.Time                 `json:"created_at"`
+	UpdatedAt          time.Time                 `json:"updated_at"`
+}
+
+// BiographyFor returns the biography in the given language, falling back to
+// the default biography.
+func (p *Person) BiographyFor(language string) *string {
+	if bio, ok := p.Biographies[language]; ok && bio != "" {
+		return &bio
+	}
+	return p.Biography
+}
+
+// MetadataUpdate holds person details fetched from a metadata provider.
+// Nil external IDs and profile path keep the stored values.
+type MetadataUpdate struct {
+	TVDbID             *int32
+	IMDbID             *string
+	Name               string
+	AlsoKnownAs        []string
+	Biography          *string
+	Biographies        map[string]string
+	Birthday           *time.Time
+	Deathday           *time.Time
+	Gender             int32
+	PlaceOfBirth       *string
+	ProfilePath        *string
+	Homepage           *string
+	KnownForDepartment *string
+	Popularity         *float64
+}
+
+// MovieCredit is a person's credit on a movie in the library.
+type MovieCredit struct {
+	CreditID   uuid.UUID `json:"credit_id"`
+	MovieID    uuid.UUID `json:"movie_id"`
+	Title      string    `json:"title"`
+	Year       *int32    `json:"year,omitempty"`
+	PosterPath *string   `json:"poster_path,omitempty"`
+	CreditType string    `json:"credit_type"`
+	Character  *string   `json:"character,omitempty"`
+	Job        *string   `json:"job,omitempty"`
+	Department *string   `json:"department,omitempty"`
+	CastOrder  *int32    `json:"cast_order,omitempty"`
+}
+
+// SeriesCredit is a person's credit on a series in the library. Guest and
+// per-episode credits are grouped per series with the episode count.
+type SeriesCredit struct {
+	SeriesID     uuid.UUID  `json:"series_id"`
+	Title        string     `json:"title"`
+	FirstAirDate *time.Time `json:"first_air_date,omitempty"`
+	PosterPath   *string    `json:"poster_path,omitempty"`
+	CreditType   string     `json:"credit_type"`
+	Character    *string    `json:"character,omitempty"`
+	Job          *string    `json:"job,omitempty"`
+	Department   *string    `json:"department,omitempty"`
+	EpisodeCount int64      `json:"episode_count"`
+}
+
+// Filmography is a person's in-library credits across movies and TV.
+type Filmography struct {
+	Movies []MovieCredit  `json:"movies"`
+	Series []SeriesCredit `json:"series"`
+}
@@ -0,0 +1,221 @@
+package people
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lusoris/revenge/internal/content"
+	"github.com/lusoris/revenge/internal/infra/database/db"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+)
+
+// RepositoryPg implements Repository using PostgreSQL.
+type RepositoryPg struct {
+	queries *db.Queries
+}
+
+// NewRepositoryPg creates a new PostgreSQL people repository.
+func NewRepositoryPg(queries *db.Queries) *RepositoryPg {
+	return &RepositoryPg{queries: queries}
+}
+
+// Get retrieves a person by ID.
+func (r *RepositoryPg) Get(ctx context.Context, id uuid.UUID) (*Person, error) {
+	result, err := r.queries.GetPerson(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbPersonToPerson(result), nil
+}
+
+// GetByTMDbID retrieves a person by TMDb person ID.
+func (r *RepositoryPg) GetByTMDbID(ctx context.Context, tmdbID int32) (*Person, error) {
+	result, err := r.queries.GetPersonByTMDbID(ctx, &tmdbID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbPersonToPerson(result), nil
+}
+
+// UpdateMetadata stores provider details for a person.
+func (r *RepositoryPg) UpdateMetadata(ctx context.Context, id uuid.UUID, update MetadataUpdate) (*Person, error) {
+	biographies := update.Biographies
+	if biographies == nil {
+		biographies = map[string]string{}
+	}
+	i18n, err := json.Marshal(biographies)
+	if err != nil {
+		return nil, fmt.Errorf("encode biographies: %w", err)
+	}
+
+	alsoKnownAs := update.AlsoKnownAs
+	if alsoKnownAs == nil {
+		alsoKnownAs = []string{}
+	}
+
+	result, err := r.queries.UpdatePersonMetadata(ctx, db.UpdatePersonMetadataParams{
+		TvdbID:             update.TVDbID,
+		ImdbID:             update.IMDbID,
+		Name:               update.Name,
+		AlsoKnownAs:        alsoKnownAs,
+		Biography:          update.Biography,
+		Birthday:           timeToPgDate(update.Birthday),
+		Deathday:           timeToPgDate(update.Deathday),
+		Gender:             update.Gender,
+		PlaceOfBirth:       update.PlaceOfBirth,
+		ProfilePath:        update.ProfilePath,
+		Homepage:           update.Homepage,
+		KnownForDepartment: update.KnownForDepartment,
+		Popularity:         update.Popularity,
+		BiographiesI18n:    i18n,
+		ID:                 id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return dbPersonToPerson(result), nil
+}
+
+// ListMovieCredits returns the person's credits on movies in the library,
+// honoring the access filter attached to ctx.
+func (r *RepositoryPg) ListMovieCredits(ctx context.Context, personID uuid.UUID) ([]MovieCredit, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	rows, err := r.queries.ListPersonMovieCredits(ctx, db.ListPersonMovieCreditsParams{
+		PersonID:     pgtype.UUID{Bytes: personID, Valid: true},
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credits := make([]MovieCredit, len(rows))
+	for i, row := range rows {
+		credits[i] = MovieCredit{
+			CreditID:   row.ID,
+			MovieID:    row.MovieID,
+			Title:      row.Title,
+			Year:       row.Year,
+			PosterPath: row.PosterPath,
+			CreditType: row.CreditType,
+			Character:  row.Character,
+			Job:        row.Job,
+			Department: row.Department,
+			CastOrder:  row.CastOrder,
+		}
+	}
+	return credits, nil
+}
+
+// ListSeriesCredits returns the person's credits on series in the library,
+// honoring the access filter attached to ctx.
+func (r *RepositoryPg) ListSeriesCredits(ctx context.Context, personID uuid.UUID) ([]SeriesCredit, error) {
+	maxAge, allowUnrated, blockedTags := content.AccessFilterFromContext(ctx).QueryParams()
+	rows, err := r.queries.ListPersonSeriesCredits(ctx, db.ListPersonSeriesCreditsParams{
+		PersonID:     pgtype.UUID{Bytes: personID, Valid: true},
+		MaxAge:       maxAge,
+		AllowUnrated: allowUnrated,
+		BlockedTags:  blockedTags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credits := make([]SeriesCredit, len(rows))
+	for i, row := range rows {
+		credits[i] = SeriesCredit{
+			SeriesID:     row.SeriesID,
+			Title:        row.Title,
+			FirstAirDate: pgDateToTimePtr(row.FirstAirDate),
+			PosterPath:   row.PosterPath,
+			CreditType:   row.CreditType,
+			Character:    row.Character,
+			Job:          row.Job,
+			Department:   row.Department,
+			EpisodeCount: row.EpisodeCount,
+		}
+	}
+	return credits, nil
+}
+
+// SetImagePlaceholder stores the placeholder of a person's profile image.
+func (r *RepositoryPg) SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	data, err := json.Marshal(placeholder)
+	if err != nil {
+		return fmt.Errorf("encode placeholder: %w", err)
+	}
+	rows, err := r.queries.SetPersonImagePlaceholder(ctx, db.SetPersonImagePlaceholderParams{
+		ID:          id,
+		Path:        path,
+		Placeholder: data,
+	})
+	if err != nil {
+		return fmt.Errorf("set image placeholder: %w", err)
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// dbPersonToPerson converts a database person to the domain type.
+func dbPersonToPerson(p db.Person) *Person {
+	person := &Person{
+		ID:                 p.ID,
+		TMDbID:             p.TmdbID,
+		TVDbID:             p.TvdbID,
+		IMDbID:             p.ImdbID,
+		Name:               p.Name,
+		AlsoKnownAs:        p.AlsoKnownAs,
+		Biography:          p.Biography,
+		Birthday:           pgDateToTimePtr(p.Birthday),
+		Deathday:           pgDateToTimePtr(p.Deathday),
+		Gender:             p.Gender,
+		PlaceOfBirth:       p.PlaceOfBirth,
+		ProfilePath:        p.ProfilePath,
+		Homepage:           p.Homepage,
+		KnownForDepartment: p.KnownForDepartment,
+		Popularity:         p.Popularity,
+		ImagePlaceholders:  content.ParseImagePlaceholders(p.ImagePlaceholders),
+		CreatedAt:          p.CreatedAt,
+		UpdatedAt:          p.UpdatedAt,
+	}
+	if len(p.BiographiesI18n) > 0 {
+		_ = json.Unmarshal(p.BiographiesI18n, &person.Biographies)
+	}
+	if p.MetadataUpdatedAt.Valid {
+		t := p.MetadataUpdatedAt.Time
+		person.MetadataUpdatedAt = &t
+	}
+	return person
+}
+
+func pgDateToTimePtr(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+func timeToPgDate(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *t, Valid: true}
+}
@@ -0,0 +1,165 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/service/artwork"
+	"github.com/lusoris/revenge/internal/service/metadata"
+)
+
+// ErrNoProviderID is returned when a person has no ID a metadata provider
+// can look up.
+var ErrNoProviderID = errors.New("person has no provider ID")
+
+// sourceTMDb is the artwork source for TMDb profile images.
+const sourceTMDb = "tmdb"
+
+// MetadataProvider fetches person details from external providers.
+type MetadataProvider interface {
+	GetPersonMetadata(ctx context.Context, id string, languages []string) (*metadata.PersonMetadata, error)
+	GetPersonImages(ctx context.Context, id string) (*metadata.Images, error)
+}
+
+// Service manages people shared by the content modules.
+type Service struct {
+	repo     Repository
+	provider MetadataProvider
+	artwork  *artwork.Service
+	logger   *slog.Logger
+}
+
+// NewService creates a people service. The metadata provider and the artwork
+// service may be nil, in which case metadata refresh is unavailable and
+// profile images are not recorded.
+func NewService(repo Repository, provider MetadataProvider, artworkService *artwork.Service, logger *slog.Logger) *Service {
+	return &Service{
+		repo:     repo,
+		provider: provider,
+		artwork:  artworkService,
+		logger:   logger.With("component", "people"),
+	}
+}
+
+// Get returns a person by ID.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*Person, error) {
+	return s.repo.Get(ctx, id)
+}
+
+// SetImagePlaceholder stores the placeholder of the person's profile image
+// at path.
+func (s *Service) SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return s.repo.SetImagePlaceholder(ctx, id, path, placeholder)
+}
+
+// GetFilmography returns the person's credits on movies and series in the
+// library.
+func (s *Service) GetFilmography(ctx context.Context, id uuid.UUID) (*Filmography, error) {
+	if _, err := s.repo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	movies, err := s.repo.ListMovieCredits(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list movie credits: %w", err)
+	}
+	series, err := s.repo.ListSeriesCredits(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list series credits: %w", err)
+	}
+	return &Filmography{Movies: movies, Series: series}, nil
+}
+
+// RefreshMetadata fetches biography, translations and profile images for a
+// person from the metadata providers. The first language is stored as the
+// default biography; the others are kept as translations.
+func (s *Service) RefreshMetadata(ctx context.Context, id uuid.UUID, languages []string) (*Person, error) {
+	if s.provider == nil {
+		return nil, metadata.ErrNoProviders
+	}
+
+	person, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if person.TMDbID == nil {
+		return nil, ErrNoProviderID
+	}
+	providerID := strconv.Itoa(int(*person.TMDbID))
+
+	meta, err := s.provider.GetPersonMetadata(ctx, providerID, languages)
+	if err != nil {
+		return nil, fmt.Errorf("fetch person metadata: %w", err)
+	}
+
+	updated, err := s.repo.UpdateMetadata(ctx, id, metadataToUpdate(meta))
+	if err != nil {
+		return nil, fmt.Errorf("update person: %w", err)
+	}
+
+	if s.artwork != nil {
+		s.syncProfiles(ctx, id, providerID)
+	}
+	return updated, nil
+}
+
+// syncProfiles records the provider's profile images. Failures are logged;
+// the person details are already stored.
+func (s *Service) syncProfiles(ctx context.Context, id uuid.UUID, providerID string) {
+	images, err := s.provider.GetPersonImages(ctx, providerID)
+	if err != nil {
+		s.logger.Warn("failed to fetch person images", slog.String("person_id", id.String()), slog.Any("error", err))
+		return
+	}
+
+	profiles := make([]artwork.ProviderImage, 0, len(images.Profiles))
+	for _, img := range images.Profiles {
+		profiles = append(profiles, artwork.ProviderImage{
+			Kind:   artwork.KindProfile,
+			Path:   img.FilePath,
+			Width:  img.Width,
+			Height: img.Height,
+		})
+	}
+	if _, err := s.artwork.SyncProvider(ctx, artwork.ContentTypePerson, id, sourceTMDb, profiles); err != nil {
+		s.logger.Warn("failed to record person images", slog.String("person_id", id.String()), slog.Any("error", err))
+	}
+}
+
+// metadataToUpdate converts provider details to a repository update.
+func metadataToUpdate(meta *metadata.PersonMetadata) MetadataUpdate {
+	update := MetadataUpdate{
+		IMDbID:       meta.IMDbID,
+		Name:         meta.Name,
+		AlsoKnownAs:  meta.AlsoKnownAs,
+		Biography:    meta.Biography,
+		Birthday:     meta.Birthday,
+		Deathday:     meta.Deathday,
+		Gender:       int32(meta.Gender), //nolint:gosec // provider gender codes are 0-3
+		PlaceOfBirth: meta.PlaceOfBirth,
+		ProfilePath:  meta.ProfilePath,
+		Homepage:     meta.Homepage,
+	}
+	if meta.KnownForDept != "" {
+		update.KnownForDepartment = &meta.KnownForDept
+	}
+	if meta.Popularity > 0 {
+		popularity := meta.Popularity
+		update.Popularity = &popularity
+	}
+	if len(meta.Translations) > 0 {
+		update.Biographies = make(map[string]string, len(meta.Translations))
+		for lang, tr := range meta.Translations {
+			if tr != nil && tr.Biography != "" {
+				update.Biographies[lang] = tr.Biography
+			}
+		}
+	}
+	return update
+}
@@ -0,0 +1,204 @@
+package people_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
+	"github.com/lusoris/revenge/internal/infra/logging"
+	"github.com/lusoris/revenge/internal/service/metadata"
+	"github.com/lusoris/revenge/internal/service/people"
+)
+
+// mockRepository implements people.Repository for testing.
+type mockRepository struct {
+	mock.Mock
+}
+
+func (m *mockRepository) Get(ctx context.Context, id uuid.UUID) (*people.Person, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*people.Person), args.Error(1)
+}
+
+func (m *mockRepository) GetByTMDbID(ctx context.Context, tmdbID int32) (*people.Person, error) {
+	args := m.Called(ctx, tmdbID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*people.Person), args.Error(1)
+}
+
+func (m *mockRepository) UpdateMetadata(ctx context.Context, id uuid.UUID, update people.MetadataUpdate) (*people.Person, error) {
+	args := m.Called(ctx, id, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*people.Person), args.Error(1)
+}
+
+func (m *mockRepository) ListMovieCredits(ctx context.Context, personID uuid.UUID) ([]people.MovieCredit, error) {
+	args := m.Called(ctx, personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]people.MovieCredit), args.Error(1)
+}
+
+func (m *mockRepository) ListSeriesCredits(ctx context.Context, personID uuid.UUID) ([]people.SeriesCredit, error) {
+	args := m.Called(ctx, personID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]people.SeriesCredit), args.Error(1)
+}
+
+func (m *mockRepository) SetImagePlaceholder(ctx context.Context, id uuid.UUID, path string, placeholder blurhash.Placeholder) error {
+	return m.Called(ctx, id, path, placeholder).Error(0)
+}
+
+// mockProvider implements people.MetadataProvider for testing.
+type mockProvider struct {
+	mock.Mock
+}
+
+func (m *mockProvider) GetPersonMetadata(ctx context.Context, id string, languages []string) (*metadata.PersonMetadata, error) {
+	args := m.Called(ctx, id, languages)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*metadata.PersonMetadata), args.Error(1)
+}
+
+func (m *mockProvider) GetPersonImages(ctx context.Context, id string) (*metadata.Images, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*metadata.Images), args.Error(1)
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestService_GetFilmography(t *testing.T) {
+	ctx := context.Background()
+	personID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := people.NewService(repo, nil, nil, logging.NewTestLogger())
+
+	movies := []people.MovieCredit{{MovieID: uuid.Must(uuid.NewV7()), Title: "Heat", CreditType: "cast"}}
+	series := []people.SeriesCredit{{SeriesID: uuid.Must(uuid.NewV7()), Title: "Crime Story", CreditType: "crew", EpisodeCount: 3}}
+	repo.On("Get", ctx, personID).Return(&people.Person{ID: personID, Name: "Michael Mann"}, nil)
+	repo.On("ListMovieCredits", ctx, personID).Return(movies, nil)
+	repo.On("ListSeriesCredits", ctx, personID).Return(series, nil)
+
+	got, err := svc.GetFilmography(ctx, personID)
+	require.NoError(t, err)
+	assert.Equal(t, movies, got.Movies)
+	assert.Equal(t, series, got.Series)
+}
+
+func TestService_GetFilmography_NotFound(t *testing.T) {
+	ctx := context.Background()
+	personID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	svc := people.NewService(repo, nil, nil, logging.NewTestLogger())
+	repo.On("Get", ctx, personID).Return(nil, people.ErrNotFound)
+
+	_, err := svc.GetFilmography(ctx, personID)
+	assert.ErrorIs(t, err, people.ErrNotFound)
+	repo.AssertNotCalled(t, "ListMovieCredits", mock.Anything, mock.Anything)
+}
+
+func TestService_RefreshMetadata(t *testing.T) {
+	ctx := context.Background()
+	personID := uuid.Must(uuid.NewV7())
+	languages := []string{"en", "de"}
+
+	repo := new(mockRepository)
+	provider := new(mockProvider)
+	svc := people.NewService(repo, provider, nil, logging.NewTestLogger())
+
+	repo.On("Get", ctx, personID).Return(&people.Person{ID: personID, TMDbID: ptr(int32(1158)), Name: "Al Pacino"}, nil)
+	provider.On("GetPersonMetadata", ctx, "1158", languages).Return(&metadata.PersonMetadata{
+		IMDbID:       ptr("nm0000199"),
+		Name:         "Al Pacino",
+		Biography:    ptr("Alfredo James Pacino is an American actor."),
+		Gender:       2,
+		KnownForDept: "Acting",
+		Popularity:   42.5,
+		Translations: map[string]*metadata.LocalizedPersonData{
+			"de": {Language: "de", Biography: "Alfredo James Pacino ist ein US-amerikanischer Schauspieler."},
+		},
+	}, nil)
+
+	updated := &people.Person{ID: personID, Name: "Al Pacino"}
+	repo.On("UpdateMetadata", ctx, personID, mock.MatchedBy(func(u people.MetadataUpdate) bool {
+		return *u.IMDbID == "nm0000199" &&
+			u.Gender == 2 &&
+			*u.KnownForDepartment == "Acting" &&
+			*u.Popularity == 42.5 &&
+			u.Biographies["de"] == "Alfredo James Pacino ist ein US-amerikanischer Schauspieler."
+	})).Return(updated, nil)
+
+	got, err := svc.RefreshMetadata(ctx, personID, languages)
+	require.NoError(t, err)
+	assert.Same(t, updated, got)
+	repo.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
+
+func TestService_RefreshMetadata_NoProviderID(t *testing.T) {
+	ctx := context.Background()
+	personID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	provider := new(mockProvider)
+	svc := people.NewService(repo, provider, nil, logging.NewTestLogger())
+	repo.On("Get", ctx, personID).Return(&people.Person{ID: personID, Name: "Unknown"}, nil)
+
+	_, err := svc.RefreshMetadata(ctx, personID, nil)
+	assert.ErrorIs(t, err, people.ErrNoProviderID)
+	provider.AssertNotCalled(t, "GetPersonMetadata", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_RefreshMetadata_ProviderError(t *testing.T) {
+	ctx := context.Background()
+	personID := uuid.Must(uuid.NewV7())
+
+	repo := new(mockRepository)
+	provider := new(mockProvider)
+	svc := people.NewService(repo, provider, nil, logging.NewTestLogger())
+	repo.On("Get", ctx, personID).Return(&people.Person{ID: personID, TMDbID: ptr(int32(1))}, nil)
+	provider.On("GetPersonMetadata", ctx, "1", []string(nil)).Return(nil, errors.New("rate limited"))
+
+	_, err := svc.RefreshMetadata(ctx, personID, nil)
+	assert.ErrorContains(t, err, "rate limited")
+	repo.AssertNotCalled(t, "UpdateMetadata", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_RefreshMetadata_NoProvider(t *testing.T) {
+	svc := people.NewService(new(mockRepository), nil, nil, logging.NewTestLogger())
+
+	_, err := svc.RefreshMetadata(context.Background(), uuid.Must(uuid.NewV7()), nil)
+	assert.ErrorIs(t, err, metadata.ErrNoProviders)
+}
+
+func TestPerson_BiographyFor(t *testing.T) {
+	p := &people.Person{
+		Biography:   ptr("English"),
+		Biographies: map[string]string{"de": "Deutsch"},
+	}
+	assert.Equal(t, "Deutsch", *p.BiographyFor("de"))
+	assert.Equal(t, "English", *p.BiographyFor("fr"))
+}
@@ -0,0 +1,60 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+// unratedMinAge is the min_age of documents for unrated items. Typesense
+// cannot filter on missing values, so unrated items get an age no limit
+// matches by range.
+const unratedMinAge int32 = -1
+
+// documentMinAge returns the min_age document value for an item.
+func documentMinAge(minAge *int32) int32 {
+	if minAge == nil {
+		return unratedMinAge
+	}
+	return *minAge
+}
+
+// accessFilterBy returns the Typesense filter enforcing the access filter,
+// or an empty string when nothing is restricted.
+func accessFilterBy(filter *content.AccessFilter) string {
+	if filter == nil {
+		return ""
+	}
+	var clauses []string
+	if filter.MaxAge != nil {
+		if filter.AllowUnrated {
+			clauses = append(clauses, fmt.Sprintf("min_age:<=%d", *filter.MaxAge))
+		} else {
+			clauses = append(clauses, fmt.Sprintf("min_age:[0..%d]", *filter.MaxAge))
+		}
+	}
+	if len(filter.BlockedTags) > 0 {
+		tags := make([]string, len(filter.BlockedTags))
+		for i, tag := range filter.BlockedTags {
+			tags[i] = "`" + strings.ReplaceAll(tag, "`", "") + "`"
+		}
+		blocked := "[" + strings.Join(tags, ",") + "]"
+		clauses = append(clauses, "genre_slugs:!="+blocked, "tag_slugs:!="+blocked)
+	}
+	return strings.Join(clauses, " && ")
+}
+
+// restrictFilterBy adds the access filter attached to ctx to a filter.
+func restrictFilterBy(ctx context.Context, filterBy string) string {
+	access := accessFilterBy(content.AccessFilterFromContext(ctx))
+	switch {
+	case access == "":
+		return filterBy
+	case filterBy == "":
+		return access
+	default:
+		return "(" + filterBy + ") && " + access
+	}
+}
@@ -0,0 +1,37 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lusoris/revenge/internal/content"
+)
+
+func TestAccessFilterBy(t *testing.T) {
+	twelve := int32(12)
+
+	assert.Empty(t, accessFilterBy(nil))
+	assert.Equal(t, "min_age:<=12", accessFilterBy(&content.AccessFilter{MaxAge: &twelve, AllowUnrated: true}))
+	assert.Equal(t, "min_age:[0..12]", accessFilterBy(&content.AccessFilter{MaxAge: &twelve}))
+	assert.Equal(t, "min_age:[0..12] && genre_slugs:!=[`horror`,`war`] && tag_slugs:!=[`horror`,`war`]", accessFilterBy(&content.AccessFilter{
+		MaxAge:      &twelve,
+		BlockedTags: []string{"horror", "war"},
+	}))
+}
+
+func TestRestrictFilterBy(t *testing.T) {
+	twelve := int32(12)
+	ctx := content.WithAccessFilter(context.Background(), &content.AccessFilter{MaxAge: &twelve, AllowUnrated: true})
+
+	assert.Equal(t, "year:>2000", restrictFilterBy(context.Background(), "year:>2000"))
+	assert.Equal(t, "min_age:<=12", restrictFilterBy(ctx, ""))
+	assert.Equal(t, "(year:>2000) && min_age:<=12", restrictFilterBy(ctx, "year:>2000"))
+}
+
+func TestDocumentMinAge(t *testing.T) {
+	sixteen := int32(16)
+	assert.Equal(t, int32(16), documentMinAge(&sixteen))
+	assert.Equal(t, unratedMinAge, documentMinAge(nil))
+}
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/infra/cache"
 )
 
@@ -29,7 +30,8 @@ func NewCachedMovieSearchService(svc *MovieSearchService, cache *cache.Cache, lo
 
 // Search searches for movies with caching (30 sec TTL).
 func (s *CachedMovieSearchService) Search(ctx context.Context, params SearchParams) (*SearchResult, error) {
-	if s.cache == nil {
+	// Searches restricted by parental controls differ per user and bypass the cache.
+	if s.cache == nil || content.AccessFilterFromContext(ctx) != nil {
 		return s.MovieSearchService.Search(ctx, params)
 	}
 
@@ -65,7 +67,7 @@ func (s *CachedMovieSearchService) Search(ctx context.Context, params SearchPara
 
 // Autocomplete performs autocomplete with caching (30 sec TTL).
 func (s *CachedMovieSearchService) Autocomplete(ctx context.Context, query string, limit int) ([]string, error) {
-	if s.cache == nil {
+	if s.cache == nil || content.AccessFilterFromContext(ctx) != nil {
 		return s.MovieSearchService.Autocomplete(ctx, query, limit)
 	}
 
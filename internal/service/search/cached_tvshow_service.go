@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/infra/cache"
 )
 
@@ -29,7 +30,8 @@ func NewCachedTVShowSearchService(svc *TVShowSearchService, cache *cache.Cache,
 
 // SearchSeries searches for TV shows with caching (30 sec TTL).
 func (s *CachedTVShowSearchService) SearchSeries(ctx context.Context, params TVShowSearchParams) (*TVShowSearchResult, error) {
-	if s.cache == nil {
+	// Searches restricted by parental controls differ per user and bypass the cache.
+	if s.cache == nil || content.AccessFilterFromContext(ctx) != nil {
 		return s.TVShowSearchService.SearchSeries(ctx, params)
 	}
 
@@ -61,7 +63,7 @@ func (s *CachedTVShowSearchService) SearchSeries(ctx context.Context, params TVS
 
 // AutocompleteSeries provides search suggestions for series titles with caching (30 sec TTL).
 func (s *CachedTVShowSearchService) AutocompleteSeries(ctx context.Context, query string, limit int) ([]string, error) {
-	if s.cache == nil {
+	if s.cache == nil || content.AccessFilterFromContext(ctx) != nil {
 		return s.TVShowSearchService.AutocompleteSeries(ctx, query, limit)
 	}
 
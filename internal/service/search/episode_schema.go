@@ -24,6 +24,8 @@ type EpisodeDocument struct {
 	VoteAverage   float64 `json:"vote_average"`   // Rating (sortable)
 	VoteCount     int32   `json:"vote_count"`     // Vote count
 	StillPath     string  `json:"still_path"`     // Still image path
+	StillBlurHash string  `json:"still_blurhash"` // Still BlurHash placeholder
+	StillColor    string  `json:"still_color"`    // Still dominant color
 	HasFile       bool    `json:"has_file"`       // Whether episode has a media file (facet)
 
 	// Series context for display in search results
@@ -74,6 +76,8 @@ func EpisodeCollectionSchema() *api.CollectionSchema {
 
 			// Image
 			{Name: "still_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "still_blurhash", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "still_color", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 
 			// Library status
 			{Name: "has_file", Type: "bool", Facet: new(true), Index: new(true)},
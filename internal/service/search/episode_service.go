@@ -508,6 +508,7 @@ func (s *EpisodeSearchService) episodeToDocument(ep *tvshow.Episode, seriesTitle
 	if ep.StillPath != nil {
 		doc.StillPath = *ep.StillPath
 	}
+	doc.StillBlurHash, doc.StillColor = placeholderFields(ep.ImagePlaceholders.For(ep.StillPath))
 
 	return doc
 }
@@ -561,6 +562,12 @@ func parseEpisodeDocument(data map[string]any) EpisodeDocument {
 	if v, ok := data["still_path"].(string); ok {
 		doc.StillPath = v
 	}
+	if v, ok := data["still_blurhash"].(string); ok {
+		doc.StillBlurHash = v
+	}
+	if v, ok := data["still_color"].(string); ok {
+		doc.StillColor = v
+	}
 	if v, ok := data["has_file"].(bool); ok {
 		doc.HasFile = v
 	}
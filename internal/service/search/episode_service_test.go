@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/govalues/decimal"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/content/tvshow"
 	"github.com/stretchr/testify/assert"
 	"github.com/typesense/typesense-go/v2/typesense/api"
@@ -167,8 +168,11 @@ func TestEpisodeToDocument(t *testing.T) {
 		VoteAverage:   &voteAverage,
 		VoteCount:     &voteCount,
 		StillPath:     &stillPath,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+		ImagePlaceholders: content.ImagePlaceholders{
+			stillPath: {BlurHash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj", Color: "#1a2b3c"},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
 	doc := s.episodeToDocument(ep, "Breaking Bad", "/poster.jpg", true)
@@ -188,6 +192,8 @@ func TestEpisodeToDocument(t *testing.T) {
 	assert.InDelta(t, 9.2, doc.VoteAverage, 0.01)
 	assert.Equal(t, voteCount, doc.VoteCount)
 	assert.Equal(t, stillPath, doc.StillPath)
+	assert.Equal(t, "LEHV6nWB2yk8pyo0adR*.7kCMdnj", doc.StillBlurHash)
+	assert.Equal(t, "#1a2b3c", doc.StillColor)
 	assert.True(t, doc.HasFile)
 	assert.Equal(t, "Breaking Bad", doc.SeriesTitle)
 	assert.Equal(t, "/poster.jpg", doc.SeriesPosterPath)
@@ -275,6 +281,8 @@ func TestParseEpisodeDocument(t *testing.T) {
 		"vote_average":       float64(9.2),
 		"vote_count":         float64(5000),
 		"still_path":         "/still.jpg",
+		"still_blurhash":     "LEHV6nWB2yk8pyo0adR*.7kCMdnj",
+		"still_color":        "#1a2b3c",
 		"has_file":           true,
 		"series_title":       "Breaking Bad",
 		"series_poster_path": "/poster.jpg",
@@ -299,6 +307,8 @@ func TestParseEpisodeDocument(t *testing.T) {
 	assert.InDelta(t, 9.2, doc.VoteAverage, 0.01)
 	assert.Equal(t, int32(5000), doc.VoteCount)
 	assert.Equal(t, "/still.jpg", doc.StillPath)
+	assert.Equal(t, "LEHV6nWB2yk8pyo0adR*.7kCMdnj", doc.StillBlurHash)
+	assert.Equal(t, "#1a2b3c", doc.StillColor)
 	assert.True(t, doc.HasFile)
 	assert.Equal(t, "Breaking Bad", doc.SeriesTitle)
 	assert.Equal(t, "/poster.jpg", doc.SeriesPosterPath)
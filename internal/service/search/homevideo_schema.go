@@ -0,0 +1,63 @@
+package search
+
+import (
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// HomeVideoCollectionName is the name of the home videos collection in Typesense.
+const HomeVideoCollectionName = "home_videos"
+
+// HomeVideoDocument represents a home video document in the search index.
+// The titles of the folders a video is in are indexed with it, so a search
+// for "Summer 2024" finds the videos of that folder.
+type HomeVideoDocument struct {
+	ID              string   `json:"id"`               // Video UUID as string
+	LibraryID       string   `json:"library_id"`       // Library UUID (filter)
+	FolderID        string   `json:"folder_id"`        // Folder UUID
+	Title           string   `json:"title"`            // Title (searchable)
+	Description     string   `json:"description"`      // Description tag (searchable)
+	Folders         []string `json:"folders"`          // Folder titles from the library root down (searchable)
+	RecordedAt      int64    `json:"recorded_at"`      // Recording time (sort)
+	Year            int32    `json:"year"`             // Recording year (facet)
+	DurationSeconds float64  `json:"duration_seconds"` // Duration
+	HasThumbnail    bool     `json:"has_thumbnail"`    // Thumbnail availability
+
+	// Timestamps
+	CreatedAt int64 `json:"created_at"` // Document creation time (sort)
+	UpdatedAt int64 `json:"updated_at"` // Document update time
+}
+
+// HomeVideoCollectionSchema returns the Typesense schema for the home videos collection.
+func HomeVideoCollectionSchema() *api.CollectionSchema {
+	return &api.CollectionSchema{
+		Name:                HomeVideoCollectionName,
+		EnableNestedFields:  new(false),
+		TokenSeparators:     &[]string{"-", "_", "."},
+		DefaultSortingField: new("recorded_at"),
+		Fields: []api.Field{
+			// ID field (primary key)
+			{Name: "id", Type: "string"},
+
+			// References
+			{Name: "library_id", Type: "string", Facet: new(true), Index: new(true)},
+			{Name: "folder_id", Type: "string", Facet: new(false), Index: new(true)},
+
+			// Title, description and folders (searchable with infix)
+			{Name: "title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true)},
+			{Name: "description", Type: "string", Facet: new(false), Index: new(true), Optional: new(true)},
+			{Name: "folders", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
+
+			// Recording time
+			{Name: "recorded_at", Type: "int64", Facet: new(false), Index: new(true), Sort: new(true)},
+			{Name: "year", Type: "int32", Facet: new(true), Index: new(true), Sort: new(true)},
+
+			// Video
+			{Name: "duration_seconds", Type: "float", Facet: new(false), Index: new(true), Sort: new(true), Optional: new(true)},
+			{Name: "has_thumbnail", Type: "bool", Facet: new(false), Index: new(false), Optional: new(true)},
+
+			// Timestamps
+			{Name: "created_at", Type: "int64", Facet: new(false), Index: new(true), Sort: new(true)},
+			{Name: "updated_at", Type: "int64", Facet: new(false), Index: new(false), Optional: new(true)},
+		},
+	}
+}
@@ -0,0 +1,447 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/lusoris/revenge/internal/infra/search"
+	"github.com/lusoris/revenge/internal/util"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// HomeVideoSearchService provides home video search operations using Typesense.
+type HomeVideoSearchService struct {
+	client *search.Client
+	logger *slog.Logger
+}
+
+// NewHomeVideoSearchService creates a new home video search service.
+func NewHomeVideoSearchService(client *search.Client, logger *slog.Logger) *HomeVideoSearchService {
+	return &HomeVideoSearchService{
+		client: client,
+		logger: logger.With("service", "homevideo_search"),
+	}
+}
+
+// IsEnabled returns true if search is enabled.
+func (s *HomeVideoSearchService) IsEnabled() bool {
+	return s.client != nil && s.client.IsEnabled()
+}
+
+// InitializeCollection creates the home videos collection if it doesn't exist.
+func (s *HomeVideoSearchService) InitializeCollection(ctx context.Context) error {
+	if !s.IsEnabled() {
+		s.logger.Debug("search disabled, skipping collection initialization")
+		return nil
+	}
+
+	_, err := s.client.GetCollection(ctx, HomeVideoCollectionName)
+	if err == nil {
+		s.logger.Debug("home videos collection already exists")
+		return nil
+	}
+
+	schema := HomeVideoCollectionSchema()
+	if err := s.client.CreateCollection(ctx, schema); err != nil {
+		return fmt.Errorf("failed to create home videos collection: %w", err)
+	}
+
+	s.logger.Info("created home videos collection")
+	return nil
+}
+
+// VideoWithFolders bundles a home video with the titles of its folders for
+// indexing.
+type VideoWithFolders struct {
+	Video   *homevideo.Video
+	Folders []string
+}
+
+// IndexVideo indexes a single home video in Typesense.
+func (s *HomeVideoSearchService) IndexVideo(ctx context.Context, video VideoWithFolders) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	doc := s.videoToDocument(video)
+	_, err := s.client.IndexDocument(ctx, HomeVideoCollectionName, doc)
+	if err != nil {
+		return fmt.Errorf("failed to index home video %s: %w", video.Video.ID, err)
+	}
+
+	s.logger.Debug("indexed home video", "id", video.Video.ID, "title", video.Video.Title)
+	return nil
+}
+
+// RemoveVideo removes a home video from the search index.
+func (s *HomeVideoSearchService) RemoveVideo(ctx context.Context, videoID uuid.UUID) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	_, err := s.client.DeleteDocument(ctx, HomeVideoCollectionName, videoID.String())
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return fmt.Errorf("failed to remove home video %s: %w", videoID, err)
+	}
+
+	s.logger.Debug("removed home video", "id", videoID)
+	return nil
+}
+
+// BulkIndexVideos indexes multiple home videos at once.
+func (s *HomeVideoSearchService) BulkIndexVideos(ctx context.Context, videos []VideoWithFolders) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	if len(videos) == 0 {
+		return nil
+	}
+
+	docs := make([]any, len(videos))
+	for i, video := range videos {
+		docs[i] = s.videoToDocument(video)
+	}
+
+	results, err := s.client.ImportDocuments(ctx, HomeVideoCollectionName, docs, "upsert")
+	if err != nil {
+		return fmt.Errorf("failed to bulk index home videos: %w", err)
+	}
+
+	var successCount, errorCount int
+	for _, result := range results {
+		if result.Success {
+			successCount++
+		} else {
+			errorCount++
+			if result.Error != "" {
+				s.logger.Warn("failed to index home video document", "error", result.Error)
+			}
+		}
+	}
+
+	s.logger.Info("bulk indexed home videos", "success", successCount, "errors", errorCount)
+	return nil
+}
+
+// HomeVideoSearchResult represents a home video search result with hits.
+type HomeVideoSearchResult struct {
+	Hits        []HomeVideoHit
+	TotalHits   int
+	TotalPages  int
+	CurrentPage int
+	Facets      map[string][]FacetValue
+	SearchTime  time.Duration
+}
+
+// HomeVideoHit represents a single home video hit in search results.
+type HomeVideoHit struct {
+	Document   HomeVideoDocument
+	Score      float64
+	Highlights map[string][]string
+}
+
+// HomeVideoSearchParams contains parameters for home video search.
+type HomeVideoSearchParams struct {
+	Query             string
+	Page              int
+	PerPage           int
+	SortBy            string
+	FilterBy          string
+	FacetBy           []string
+	IncludeHighlights bool
+
+	// LibraryIDs restricts the results to these libraries when non-nil,
+	// the libraries the user may access.
+	LibraryIDs []uuid.UUID
+}
+
+// DefaultHomeVideoSearchParams returns default search parameters for home videos.
+func DefaultHomeVideoSearchParams() HomeVideoSearchParams {
+	return HomeVideoSearchParams{
+		Page:              1,
+		PerPage:           20,
+		FacetBy:           []string{"year", "folders"},
+		IncludeHighlights: true,
+	}
+}
+
+// SearchVideos searches for home videos by title, description or folder.
+func (s *HomeVideoSearchService) SearchVideos(ctx context.Context, params HomeVideoSearchParams) (*HomeVideoSearchResult, error) {
+	if !s.IsEnabled() {
+		return &HomeVideoSearchResult{}, nil
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PerPage < 1 {
+		params.PerPage = 20
+	}
+	if params.PerPage > 100 {
+		params.PerPage = 100
+	}
+
+	// A user without libraries finds nothing
+	if params.LibraryIDs != nil && len(params.LibraryIDs) == 0 {
+		return &HomeVideoSearchResult{
+			CurrentPage: params.Page,
+			Hits:        []HomeVideoHit{},
+			Facets:      map[string][]FacetValue{},
+		}, nil
+	}
+
+	queryBy := "title,description,folders"
+	page := params.Page
+	perPage := params.PerPage
+
+	searchParams := &api.SearchCollectionParams{
+		Q:       &params.Query,
+		QueryBy: &queryBy,
+		Page:    &page,
+		PerPage: &perPage,
+	}
+
+	if params.SortBy != "" {
+		searchParams.SortBy = &params.SortBy
+	}
+	if filterBy := homeVideoFilterBy(params.FilterBy, params.LibraryIDs); filterBy != "" {
+		searchParams.FilterBy = &filterBy
+	}
+	if len(params.FacetBy) > 0 {
+		facetBy := strings.Join(params.FacetBy, ",")
+		searchParams.FacetBy = &facetBy
+	}
+
+	start := time.Now()
+	result, err := s.client.Search(ctx, HomeVideoCollectionName, searchParams)
+	searchTime := time.Since(start)
+
+	if err != nil {
+		return nil, fmt.Errorf("home video search failed: %w", err)
+	}
+
+	searchResult := &HomeVideoSearchResult{
+		TotalHits:   deref(result.Found),
+		CurrentPage: params.Page,
+		TotalPages:  (deref(result.Found) + params.PerPage - 1) / params.PerPage,
+		SearchTime:  searchTime,
+		Hits:        make([]HomeVideoHit, 0, len(derefHits(result.Hits))),
+		Facets:      make(map[string][]FacetValue),
+	}
+
+	if result.Hits != nil {
+		for _, hit := range *result.Hits {
+			vHit := HomeVideoHit{
+				Highlights: make(map[string][]string),
+			}
+
+			if hit.Document != nil {
+				vHit.Document = parseHomeVideoDocument(*hit.Document)
+			}
+
+			if hit.TextMatch != nil {
+				vHit.Score = float64(*hit.TextMatch)
+			}
+
+			if params.IncludeHighlights && hit.Highlights != nil {
+				for _, hl := range *hit.Highlights {
+					if hl.Field != nil && hl.Snippets != nil {
+						vHit.Highlights[*hl.Field] = *hl.Snippets
+					}
+				}
+			}
+
+			searchResult.Hits = append(searchResult.Hits, vHit)
+		}
+	}
+
+	if result.FacetCounts != nil {
+		for _, fc := range *result.FacetCounts {
+			if fc.FieldName == nil || fc.Counts == nil {
+				continue
+			}
+			values := make([]FacetValue, 0, len(*fc.Counts))
+			for _, count := range *fc.Counts {
+				if count.Value == nil || count.Count == nil {
+					continue
+				}
+				values = append(values, FacetValue{
+					Value: *count.Value,
+					Count: *count.Count,
+				})
+			}
+			searchResult.Facets[*fc.FieldName] = values
+		}
+	}
+
+	return searchResult, nil
+}
+
+// ReindexAll reindexes all home videos in the database.
+func (s *HomeVideoSearchService) ReindexAll(ctx context.Context, service homevideo.Service) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	s.logger.Info("starting full home video reindex")
+
+	_ = s.client.DeleteCollection(ctx, HomeVideoCollectionName)
+	if err := s.InitializeCollection(ctx); err != nil {
+		return fmt.Errorf("failed to reinitialize home videos collection: %w", err)
+	}
+
+	batchSize := int32(100)
+	var offset int32
+	totalIndexed := 0
+	folders := make(map[uuid.UUID][]string)
+
+	for {
+		videos, _, err := service.ListVideos(ctx, homevideo.VideoListFilters{
+			Sort:   homevideo.VideoSortAdded,
+			Limit:  batchSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list home videos: %w", err)
+		}
+
+		if len(videos) == 0 {
+			break
+		}
+
+		batch := make([]VideoWithFolders, 0, len(videos))
+		for i := range videos {
+			titles, ok := folders[videos[i].FolderID]
+			if !ok {
+				video, err := LoadHomeVideo(ctx, service, &videos[i])
+				if err != nil {
+					s.logger.Warn("failed to load home video for indexing",
+						slog.String("video_id", videos[i].ID.String()),
+						slog.Any("error", err),
+					)
+					continue
+				}
+				titles = video.Folders
+				folders[videos[i].FolderID] = titles
+			}
+			batch = append(batch, VideoWithFolders{Video: &videos[i], Folders: titles})
+		}
+
+		if err := s.BulkIndexVideos(ctx, batch); err != nil {
+			s.logger.Error("failed to index home video batch", slog.Any("error", err))
+		}
+		totalIndexed += len(batch)
+
+		offset += batchSize
+
+		if len(videos) < int(batchSize) {
+			break
+		}
+	}
+
+	s.logger.Info("completed full home video reindex", "total", totalIndexed)
+	return nil
+}
+
+// LoadHomeVideo collects the folder titles of a home video for indexing.
+func LoadHomeVideo(ctx context.Context, service homevideo.Service, video *homevideo.Video) (VideoWithFolders, error) {
+	refs, err := service.GetFolderPath(ctx, video.FolderID)
+	if err != nil {
+		return VideoWithFolders{}, err
+	}
+	titles := make([]string, len(refs))
+	for i, ref := range refs {
+		titles[i] = ref.Title
+	}
+	return VideoWithFolders{Video: video, Folders: titles}, nil
+}
+
+// homeVideoFilterBy adds the library restriction to a filter.
+func homeVideoFilterBy(filterBy string, libraryIDs []uuid.UUID) string {
+	if libraryIDs == nil {
+		return filterBy
+	}
+	ids := make([]string, len(libraryIDs))
+	for i, id := range libraryIDs {
+		ids[i] = id.String()
+	}
+	libraries := "library_id:=[" + strings.Join(ids, ",") + "]"
+	if filterBy == "" {
+		return libraries
+	}
+	return "(" + filterBy + ") && " + libraries
+}
+
+// videoToDocument converts a home video to a search document.
+func (s *HomeVideoSearchService) videoToDocument(video VideoWithFolders) HomeVideoDocument {
+	v := video.Video
+	doc := HomeVideoDocument{
+		ID:              v.ID.String(),
+		LibraryID:       v.LibraryID.String(),
+		FolderID:        v.FolderID.String(),
+		Title:           v.Title,
+		Folders:         video.Folders,
+		RecordedAt:      v.RecordedAt.Unix(),
+		Year:            util.SafeIntToInt32(v.RecordedAt.Year()),
+		DurationSeconds: v.DurationSeconds,
+		HasThumbnail:    v.HasThumbnail(),
+		CreatedAt:       v.CreatedAt.Unix(),
+		UpdatedAt:       time.Now().Unix(),
+	}
+
+	if v.Description != nil {
+		doc.Description = *v.Description
+	}
+
+	return doc
+}
+
+// parseHomeVideoDocument parses a map into a HomeVideoDocument.
+func parseHomeVideoDocument(data map[string]any) HomeVideoDocument {
+	doc := HomeVideoDocument{}
+
+	if v, ok := data["id"].(string); ok {
+		doc.ID = v
+	}
+	if v, ok := data["library_id"].(string); ok {
+		doc.LibraryID = v
+	}
+	if v, ok := data["folder_id"].(string); ok {
+		doc.FolderID = v
+	}
+	if v, ok := data["title"].(string); ok {
+		doc.Title = v
+	}
+	if v, ok := data["description"].(string); ok {
+		doc.Description = v
+	}
+	if v, ok := data["folders"].([]any); ok {
+		doc.Folders = toStringSlice(v)
+	}
+	if v, ok := data["recorded_at"].(float64); ok {
+		doc.RecordedAt = int64(v)
+	}
+	if v, ok := data["year"].(float64); ok {
+		doc.Year = int32(v)
+	}
+	if v, ok := data["duration_seconds"].(float64); ok {
+		doc.DurationSeconds = v
+	}
+	if v, ok := data["has_thumbnail"].(bool); ok {
+		doc.HasThumbnail = v
+	}
+	if v, ok := data["created_at"].(float64); ok {
+		doc.CreatedAt = int64(v)
+	}
+	if v, ok := data["updated_at"].(float64); ok {
+		doc.UpdatedAt = int64(v)
+	}
+
+	return doc
+}
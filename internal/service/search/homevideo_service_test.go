@@ -0,0 +1,89 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content/homevideo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHomeVideoCollectionSchema(t *testing.T) {
+	schema := HomeVideoCollectionSchema()
+
+	assert.Equal(t, HomeVideoCollectionName, schema.Name)
+	assert.NotNil(t, schema.DefaultSortingField)
+	assert.Equal(t, "recorded_at", *schema.DefaultSortingField)
+
+	fieldNames := make(map[string]bool)
+	for _, f := range schema.Fields {
+		fieldNames[f.Name] = true
+	}
+	for _, name := range []string{"id", "library_id", "title", "description", "folders", "recorded_at", "year", "created_at"} {
+		assert.True(t, fieldNames[name], "schema should have field: %s", name)
+	}
+}
+
+func TestVideoToDocument(t *testing.T) {
+	s := &HomeVideoSearchService{}
+	description := "Filmed by Dad"
+	thumb := "homevideo/thumbnails/x.jpg"
+	recorded := time.Date(2024, 6, 30, 14, 5, 9, 0, time.UTC)
+
+	video := &homevideo.Video{
+		ID:              uuid.New(),
+		LibraryID:       uuid.New(),
+		FolderID:        uuid.New(),
+		Title:           "Beach Day",
+		Description:     &description,
+		RecordedAt:      recorded,
+		DurationSeconds: 95.5,
+		ThumbnailKey:    &thumb,
+		CreatedAt:       recorded,
+	}
+
+	doc := s.videoToDocument(VideoWithFolders{Video: video, Folders: []string{"Family", "2024"}})
+	assert.Equal(t, video.ID.String(), doc.ID)
+	assert.Equal(t, video.LibraryID.String(), doc.LibraryID)
+	assert.Equal(t, "Beach Day", doc.Title)
+	assert.Equal(t, "Filmed by Dad", doc.Description)
+	assert.Equal(t, []string{"Family", "2024"}, doc.Folders)
+	assert.Equal(t, recorded.Unix(), doc.RecordedAt)
+	assert.Equal(t, int32(2024), doc.Year)
+	assert.True(t, doc.HasThumbnail)
+}
+
+func TestParseHomeVideoDocument(t *testing.T) {
+	doc := parseHomeVideoDocument(map[string]any{
+		"id":               "v1",
+		"library_id":       "l1",
+		"title":            "Beach Day",
+		"folders":          []any{"Family", "2024"},
+		"recorded_at":      float64(1719756309),
+		"year":             float64(2024),
+		"duration_seconds": 95.5,
+		"has_thumbnail":    true,
+	})
+	assert.Equal(t, "v1", doc.ID)
+	assert.Equal(t, "l1", doc.LibraryID)
+	assert.Equal(t, []string{"Family", "2024"}, doc.Folders)
+	assert.Equal(t, int64(1719756309), doc.RecordedAt)
+	assert.Equal(t, int32(2024), doc.Year)
+	assert.Equal(t, 95.5, doc.DurationSeconds)
+	assert.True(t, doc.HasThumbnail)
+}
+
+func TestHomeVideoFilterBy(t *testing.T) {
+	lib := uuid.MustParse("0190e2a0-0000-7000-8000-000000000001")
+
+	assert.Equal(t, "", homeVideoFilterBy("", nil))
+	assert.Equal(t, "year:=2024", homeVideoFilterBy("year:=2024", nil))
+	assert.Equal(t, "library_id:=["+lib.String()+"]", homeVideoFilterBy("", []uuid.UUID{lib}))
+	assert.Equal(t, "(year:=2024) && library_id:=["+lib.String()+"]", homeVideoFilterBy("year:=2024", []uuid.UUID{lib}))
+}
+
+func TestHomeVideoSearchServiceIsEnabled(t *testing.T) {
+	s := &HomeVideoSearchService{client: nil}
+	assert.False(t, s.IsEnabled())
+}
@@ -193,7 +193,7 @@ func TestMovieSearchService_IndexMovie_Enabled(t *testing.T) {
 		CreatedAt:      now,
 	}
 
-	err := svc.IndexMovie(ctx, m, nil, nil, nil)
+	err := svc.IndexMovie(ctx, m, nil, nil, nil, nil)
 	assert.NoError(t, err)
 }
 
@@ -214,7 +214,7 @@ func TestMovieSearchService_UpdateMovie_Enabled(t *testing.T) {
 		CreatedAt:      now,
 	}
 
-	err := svc.UpdateMovie(ctx, m, nil, nil, nil)
+	err := svc.UpdateMovie(ctx, m, nil, nil, nil, nil)
 	assert.NoError(t, err)
 }
 
@@ -258,7 +258,7 @@ func TestMovieSearchService_UpdateMovie_NotFound_FallsBackToIndex(t *testing.T)
 
 	// The "not found" error from PATCH triggers the fallback to IndexMovie.
 	// We just verify it doesn't panic.
-	err := svc.UpdateMovie(ctx, m, nil, nil, nil)
+	err := svc.UpdateMovie(ctx, m, nil, nil, nil, nil)
 	_ = err
 }
 
@@ -489,7 +489,7 @@ func TestTVShowSearchService_IndexSeries_Enabled(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	err := svc.IndexSeries(ctx, series, nil, nil, nil, false)
+	err := svc.IndexSeries(ctx, series, nil, nil, nil, nil, false)
 	assert.NoError(t, err)
 }
 
@@ -507,7 +507,7 @@ func TestTVShowSearchService_UpdateSeries_Enabled(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	err := svc.UpdateSeries(ctx, series, nil, nil, nil, true)
+	err := svc.UpdateSeries(ctx, series, nil, nil, nil, nil, true)
 	assert.NoError(t, err)
 }
 
@@ -747,7 +747,7 @@ func TestMovieSearchService_IndexMovie_Error(t *testing.T) {
 		CreatedAt:      time.Now(),
 	}
 
-	err := svc.IndexMovie(ctx, m, nil, nil, nil)
+	err := svc.IndexMovie(ctx, m, nil, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to index movie")
 }
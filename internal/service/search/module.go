@@ -17,6 +17,8 @@ var Module = fx.Module("search_service",
 	fx.Provide(NewSeasonSearchService),
 	fx.Provide(NewPersonSearchService),
 	fx.Provide(NewUserSearchService),
+	fx.Provide(NewMusicSearchService),
+	fx.Provide(NewHomeVideoSearchService),
 	fx.Provide(provideCachedMovieSearchService),
 	fx.Provide(provideCachedTVShowSearchService),
 	fx.Provide(provideCachedEpisodeSearchService),
@@ -57,7 +59,7 @@ func provideCachedUserSearchService(svc *UserSearchService, c *cache.Cache, logg
 }
 
 // initializeCollections creates Typesense collections on startup if they don't exist.
-func initializeCollections(lc fx.Lifecycle, movieSearch *MovieSearchService, tvshowSearch *TVShowSearchService, episodeSearch *EpisodeSearchService, seasonSearch *SeasonSearchService, personSearch *PersonSearchService, userSearch *UserSearchService, logger *slog.Logger) {
+func initializeCollections(lc fx.Lifecycle, movieSearch *MovieSearchService, tvshowSearch *TVShowSearchService, episodeSearch *EpisodeSearchService, seasonSearch *SeasonSearchService, personSearch *PersonSearchService, userSearch *UserSearchService, musicSearch *MusicSearchService, homeVideoSearch *HomeVideoSearchService, logger *slog.Logger) {
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			if movieSearch.IsEnabled() {
@@ -90,6 +92,16 @@ func initializeCollections(lc fx.Lifecycle, movieSearch *MovieSearchService, tvs
 					logger.Warn("failed to initialize users search collection", slog.Any("error", err))
 				}
 			}
+			if musicSearch.IsEnabled() {
+				if err := musicSearch.InitializeCollection(ctx); err != nil {
+					logger.Warn("failed to initialize music albums search collection", slog.Any("error", err))
+				}
+			}
+			if homeVideoSearch.IsEnabled() {
+				if err := homeVideoSearch.InitializeCollection(ctx); err != nil {
+					logger.Warn("failed to initialize home videos search collection", slog.Any("error", err))
+				}
+			}
 			return nil
 		},
 	})
@@ -14,6 +14,7 @@ type MovieDocument struct {
 	IMDbID           string   `json:"imdb_id"`           // IMDb ID (optional)
 	Title            string   `json:"title"`             // Main title (searchable)
 	OriginalTitle    string   `json:"original_title"`    // Original title (searchable)
+	Titles           []string `json:"titles"`            // Translated titles (searchable)
 	Year             int32    `json:"year"`              // Release year (facet + filter)
 	ReleaseDate      int64    `json:"release_date"`      // Unix timestamp for sorting
 	Runtime          int32    `json:"runtime"`           // Runtime in minutes
@@ -21,13 +22,20 @@ type MovieDocument struct {
 	Tagline          string   `json:"tagline"`           // Movie tagline
 	Status           string   `json:"status"`            // Release status (facet)
 	OriginalLanguage string   `json:"original_language"` // Original language (facet)
+	MinAge           int32    `json:"min_age"`           // Minimum viewer age, -1 when unrated (filter)
 	PosterPath       string   `json:"poster_path"`       // Poster image path
+	PosterBlurHash   string   `json:"poster_blurhash"`   // Poster BlurHash placeholder
+	PosterColor      string   `json:"poster_color"`      // Poster dominant color
 	BackdropPath     string   `json:"backdrop_path"`     // Backdrop image path
+	BackdropBlurHash string   `json:"backdrop_blurhash"` // Backdrop BlurHash placeholder
+	BackdropColor    string   `json:"backdrop_color"`    // Backdrop dominant color
 	VoteAverage      float64  `json:"vote_average"`      // Rating (sortable)
 	VoteCount        int32    `json:"vote_count"`        // Vote count
 	Popularity       float64  `json:"popularity"`        // TMDb popularity (sortable)
 	Genres           []string `json:"genres"`            // Genre names (facet + filter)
 	GenreSlugs       []string `json:"genre_slugs"`       // Genre slugs (facet + filter)
+	Tags             []string `json:"tags"`              // Non-spoiler keyword and tag names (facet + filter)
+	TagSlugs         []string `json:"tag_slugs"`         // All keyword and tag slugs, including spoilers (filter)
 	Cast             []string `json:"cast"`              // Cast member names (searchable)
 	Directors        []string `json:"directors"`         // Director names (searchable + facet)
 	HasFile          bool     `json:"has_file"`          // Whether movie has a file (facet)
@@ -36,6 +44,11 @@ type MovieDocument struct {
 	LibraryAddedAt   int64    `json:"library_added_at"`  // When added to library (sortable)
 	CreatedAt        int64    `json:"created_at"`        // Document creation time
 	UpdatedAt        int64    `json:"updated_at"`        // Document update time
+
+	// Per-language values used to localize results; stored but not indexed.
+	TitlesI18n    map[string]string `json:"titles_i18n,omitempty"`
+	OverviewsI18n map[string]string `json:"overviews_i18n,omitempty"`
+	TaglinesI18n  map[string]string `json:"taglines_i18n,omitempty"`
 }
 
 // MovieCollectionSchema returns the Typesense schema for the movies collection.
@@ -57,6 +70,7 @@ func MovieCollectionSchema() *api.CollectionSchema {
 			// Title fields (searchable with different weights)
 			{Name: "title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true)},
 			{Name: "original_title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true), Optional: new(true)},
+			{Name: "titles", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
 
 			// Year and dates
 			{Name: "year", Type: "int32", Facet: new(true), Index: new(true), Optional: new(true)},
@@ -68,10 +82,15 @@ func MovieCollectionSchema() *api.CollectionSchema {
 			{Name: "tagline", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 			{Name: "status", Type: "string", Facet: new(true), Index: new(true), Optional: new(true)},
 			{Name: "original_language", Type: "string", Facet: new(true), Index: new(true), Optional: new(true)},
+			{Name: "min_age", Type: "int32", Facet: new(false), Index: new(true), Optional: new(true)},
 
 			// Images
 			{Name: "poster_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "poster_blurhash", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "poster_color", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 			{Name: "backdrop_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "backdrop_blurhash", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "backdrop_color", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 
 			// Ratings and popularity (sortable)
 			{Name: "vote_average", Type: "float", Facet: new(false), Index: new(true), Sort: new(true), Optional: new(true)},
@@ -82,6 +101,10 @@ func MovieCollectionSchema() *api.CollectionSchema {
 			{Name: "genres", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
 			{Name: "genre_slugs", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
 
+			// Keywords and tags (spoiler slugs are filterable but never faceted)
+			{Name: "tags", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
+			{Name: "tag_slugs", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
+
 			// Credits (searchable)
 			{Name: "cast", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
 			{Name: "directors", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lusoris/revenge/internal/content/movie"
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 	"github.com/lusoris/revenge/internal/infra/observability"
 	"github.com/lusoris/revenge/internal/infra/search"
 	"github.com/typesense/typesense-go/v2/typesense/api"
@@ -58,12 +60,12 @@ func (s *MovieSearchService) InitializeCollection(ctx context.Context) error {
 }
 
 // IndexMovie indexes a single movie in Typesense.
-func (s *MovieSearchService) IndexMovie(ctx context.Context, m *movie.Movie, genres []movie.MovieGenre, credits []movie.MovieCredit, file *movie.MovieFile) error {
+func (s *MovieSearchService) IndexMovie(ctx context.Context, m *movie.Movie, genres []movie.MovieGenre, tags []movie.MovieTag, credits []movie.MovieCredit, file *movie.MovieFile) error {
 	if !s.IsEnabled() {
 		return nil
 	}
 
-	doc := s.movieToDocument(m, genres, credits, file)
+	doc := s.movieToDocument(m, genres, tags, credits, file)
 	_, err := s.client.IndexDocument(ctx, MovieCollectionName, doc)
 	if err != nil {
 		return fmt.Errorf("failed to index movie %s: %w", m.ID, err)
@@ -74,17 +76,17 @@ func (s *MovieSearchService) IndexMovie(ctx context.Context, m *movie.Movie, gen
 }
 
 // UpdateMovie updates a movie in the search index.
-func (s *MovieSearchService) UpdateMovie(ctx context.Context, m *movie.Movie, genres []movie.MovieGenre, credits []movie.MovieCredit, file *movie.MovieFile) error {
+func (s *MovieSearchService) UpdateMovie(ctx context.Context, m *movie.Movie, genres []movie.MovieGenre, tags []movie.MovieTag, credits []movie.MovieCredit, file *movie.MovieFile) error {
 	if !s.IsEnabled() {
 		return nil
 	}
 
-	doc := s.movieToDocument(m, genres, credits, file)
+	doc := s.movieToDocument(m, genres, tags, credits, file)
 	_, err := s.client.UpdateDocument(ctx, MovieCollectionName, m.ID.String(), doc)
 	if err != nil {
 		// If document doesn't exist, create it
 		if strings.Contains(err.Error(), "not found") {
-			return s.IndexMovie(ctx, m, genres, credits, file)
+			return s.IndexMovie(ctx, m, genres, tags, credits, file)
 		}
 		return fmt.Errorf("failed to update movie %s: %w", m.ID, err)
 	}
@@ -120,7 +122,7 @@ func (s *MovieSearchService) BulkIndexMovies(ctx context.Context, movies []Movie
 
 	docs := make([]any, len(movies))
 	for i, m := range movies {
-		docs[i] = s.movieToDocument(m.Movie, m.Genres, m.Credits, m.File)
+		docs[i] = s.movieToDocument(m.Movie, m.Genres, m.Tags, m.Credits, m.File)
 	}
 
 	results, err := s.client.ImportDocuments(ctx, MovieCollectionName, docs, "upsert")
@@ -153,6 +155,7 @@ func (s *MovieSearchService) BulkIndexMovies(ctx context.Context, movies []Movie
 type MovieWithRelations struct {
 	Movie   *movie.Movie
 	Genres  []movie.MovieGenre
+	Tags    []movie.MovieTag
 	Credits []movie.MovieCredit
 	File    *movie.MovieFile
 }
@@ -197,7 +200,7 @@ func DefaultSearchParams() SearchParams {
 		Page:              1,
 		PerPage:           20,
 		SortBy:            "popularity:desc",
-		FacetBy:           []string{"genres", "year", "status", "directors", "resolution", "has_file"},
+		FacetBy:           []string{"genres", "tags", "year", "status", "directors", "resolution", "has_file"},
 		IncludeHighlights: true,
 	}
 }
@@ -220,7 +223,7 @@ func (s *MovieSearchService) Search(ctx context.Context, params SearchParams) (*
 	}
 
 	// Build search parameters
-	queryBy := "title,original_title,overview,cast,directors"
+	queryBy := "title,original_title,titles,overview,cast,directors"
 	page := params.Page
 	perPage := params.PerPage
 
@@ -235,8 +238,8 @@ func (s *MovieSearchService) Search(ctx context.Context, params SearchParams) (*
 		searchParams.SortBy = &params.SortBy
 	}
 
-	if params.FilterBy != "" {
-		searchParams.FilterBy = &params.FilterBy
+	if filterBy := restrictFilterBy(ctx, params.FilterBy); filterBy != "" {
+		searchParams.FilterBy = &filterBy
 	}
 
 	if len(params.FacetBy) > 0 {
@@ -341,6 +344,9 @@ func (s *MovieSearchService) Autocomplete(ctx context.Context, query string, lim
 		Prefix:              new("true"),
 		DropTokensThreshold: new(0),
 	}
+	if filterBy := restrictFilterBy(ctx, ""); filterBy != "" {
+		searchParams.FilterBy = &filterBy
+	}
 
 	start := time.Now()
 	result, err := s.client.Search(ctx, MovieCollectionName, searchParams)
@@ -428,6 +434,7 @@ func (s *MovieSearchService) ReindexAll(ctx context.Context, movieRepo movie.Rep
 		moviesWithRelations := make([]MovieWithRelations, 0, len(movies))
 		for _, m := range movies {
 			genres, _ := movieRepo.ListMovieGenres(ctx, m.ID)
+			tags, _ := movieRepo.ListMovieTags(ctx, m.ID)
 			cast, _ := movieRepo.ListMovieCast(ctx, m.ID, 1000, 0)
 			crew, _ := movieRepo.ListMovieCrew(ctx, m.ID, 1000, 0)
 			files, _ := movieRepo.ListMovieFilesByMovieID(ctx, m.ID)
@@ -445,6 +452,7 @@ func (s *MovieSearchService) ReindexAll(ctx context.Context, movieRepo movie.Rep
 			moviesWithRelations = append(moviesWithRelations, MovieWithRelations{
 				Movie:   &mCopy,
 				Genres:  genres,
+				Tags:    tags,
 				Credits: credits,
 				File:    file,
 			})
@@ -468,12 +476,13 @@ func (s *MovieSearchService) ReindexAll(ctx context.Context, movieRepo movie.Rep
 }
 
 // movieToDocument converts a movie with related data to a search document.
-func (s *MovieSearchService) movieToDocument(m *movie.Movie, genres []movie.MovieGenre, credits []movie.MovieCredit, file *movie.MovieFile) MovieDocument {
+func (s *MovieSearchService) movieToDocument(m *movie.Movie, genres []movie.MovieGenre, tags []movie.MovieTag, credits []movie.MovieCredit, file *movie.MovieFile) MovieDocument {
 	now := time.Now().Unix()
 
 	doc := MovieDocument{
 		ID:        m.ID.String(),
 		Title:     m.Title,
+		MinAge:    documentMinAge(m.MinAge),
 		CreatedAt: m.CreatedAt.Unix(),
 		UpdatedAt: now,
 	}
@@ -518,6 +527,12 @@ func (s *MovieSearchService) movieToDocument(m *movie.Movie, genres []movie.Movi
 		doc.Tagline = *m.Tagline
 	}
 
+	// Translations
+	doc.Titles = translatedTitles(m.TitlesI18n, doc.Title, doc.OriginalTitle)
+	doc.TitlesI18n = m.TitlesI18n
+	doc.OverviewsI18n = m.OverviewsI18n
+	doc.TaglinesI18n = m.TaglinesI18n
+
 	// Status
 	if m.Status != nil {
 		doc.Status = *m.Status
@@ -535,6 +550,8 @@ func (s *MovieSearchService) movieToDocument(m *movie.Movie, genres []movie.Movi
 	if m.BackdropPath != nil {
 		doc.BackdropPath = *m.BackdropPath
 	}
+	doc.PosterBlurHash, doc.PosterColor = placeholderFields(m.ImagePlaceholders.For(m.PosterPath))
+	doc.BackdropBlurHash, doc.BackdropColor = placeholderFields(m.ImagePlaceholders.For(m.BackdropPath))
 
 	// Ratings
 	if m.VoteAverage != nil {
@@ -564,6 +581,14 @@ func (s *MovieSearchService) movieToDocument(m *movie.Movie, genres []movie.Movi
 		doc.GenreSlugs = genreSlugs
 	}
 
+	// Keywords and tags
+	for _, t := range tags {
+		if !t.Spoiler {
+			doc.Tags = append(doc.Tags, t.Name)
+		}
+		doc.TagSlugs = append(doc.TagSlugs, t.Slug)
+	}
+
 	// Credits - extract cast and directors
 	if len(credits) > 0 {
 		var cast, directors []string
@@ -635,12 +660,27 @@ func parseMovieDocument(data map[string]any) MovieDocument {
 	if v, ok := data["original_language"].(string); ok {
 		doc.OriginalLanguage = v
 	}
+	if v, ok := data["min_age"].(float64); ok {
+		doc.MinAge = int32(v)
+	}
 	if v, ok := data["poster_path"].(string); ok {
 		doc.PosterPath = v
 	}
+	if v, ok := data["poster_blurhash"].(string); ok {
+		doc.PosterBlurHash = v
+	}
+	if v, ok := data["poster_color"].(string); ok {
+		doc.PosterColor = v
+	}
 	if v, ok := data["backdrop_path"].(string); ok {
 		doc.BackdropPath = v
 	}
+	if v, ok := data["backdrop_blurhash"].(string); ok {
+		doc.BackdropBlurHash = v
+	}
+	if v, ok := data["backdrop_color"].(string); ok {
+		doc.BackdropColor = v
+	}
 	if v, ok := data["vote_average"].(float64); ok {
 		doc.VoteAverage = v
 	}
@@ -682,10 +722,49 @@ func parseMovieDocument(data map[string]any) MovieDocument {
 	if v, ok := data["genre_slugs"].([]any); ok {
 		doc.GenreSlugs = toStringSlice(v)
 	}
+	if v, ok := data["tags"].([]any); ok {
+		doc.Tags = toStringSlice(v)
+	}
+	if v, ok := data["tag_slugs"].([]any); ok {
+		doc.TagSlugs = toStringSlice(v)
+	}
+	if v, ok := data["titles"].([]any); ok {
+		doc.Titles = toStringSlice(v)
+	}
+	if v, ok := data["titles_i18n"].(map[string]any); ok {
+		doc.TitlesI18n = toStringMap(v)
+	}
+	if v, ok := data["overviews_i18n"].(map[string]any); ok {
+		doc.OverviewsI18n = toStringMap(v)
+	}
+	if v, ok := data["taglines_i18n"].(map[string]any); ok {
+		doc.TaglinesI18n = toStringMap(v)
+	}
 
 	return doc
 }
 
+// placeholderFields returns the document fields of an image placeholder.
+func placeholderFields(ph *blurhash.Placeholder) (blurHash, color string) {
+	if ph == nil {
+		return "", ""
+	}
+	return ph.BlurHash, ph.Color
+}
+
+// translatedTitles returns the distinct translated titles that differ from
+// the main and original title, sorted for stable documents.
+func translatedTitles(titles map[string]string, title, originalTitle string) []string {
+	result := make([]string, 0, len(titles))
+	for _, t := range titles {
+		if t != "" && t != title && t != originalTitle && !slices.Contains(result, t) {
+			result = append(result, t)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
 // toStringSlice converts an interface slice to a string slice.
 func toStringSlice(v []any) []string {
 	result := make([]string, 0, len(v))
@@ -697,6 +776,17 @@ func toStringSlice(v []any) []string {
 	return result
 }
 
+// toStringMap converts an interface map to a string map.
+func toStringMap(v map[string]any) map[string]string {
+	result := make(map[string]string, len(v))
+	for k, item := range v {
+		if s, ok := item.(string); ok {
+			result[k] = s
+		}
+	}
+	return result
+}
+
 // toInt32Slice converts an interface slice to an int32 slice.
 func toInt32Slice(v []any) []int32 {
 	result := make([]int32, 0, len(v))
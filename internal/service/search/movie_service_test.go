@@ -146,7 +146,7 @@ func TestMovieToDocument(t *testing.T) {
 		QualityProfile: &qualityProfile,
 	}
 
-	doc := s.movieToDocument(m, genres, credits, file)
+	doc := s.movieToDocument(m, genres, nil, credits, file)
 
 	// Verify basic fields
 	assert.Equal(t, movieID.String(), doc.ID)
@@ -195,7 +195,7 @@ func TestMovieToDocumentMinimal(t *testing.T) {
 		UpdatedAt:      now,
 	}
 
-	doc := s.movieToDocument(m, nil, nil, nil)
+	doc := s.movieToDocument(m, nil, nil, nil, nil)
 
 	assert.Equal(t, movieID.String(), doc.ID)
 	assert.Equal(t, "Unknown Movie", doc.Title)
@@ -231,7 +231,7 @@ func TestMovieToDocumentCastLimit(t *testing.T) {
 		}
 	}
 
-	doc := s.movieToDocument(m, nil, credits, nil)
+	doc := s.movieToDocument(m, nil, nil, credits, nil)
 
 	// Should be limited to 20
 	assert.Len(t, doc.Cast, 20)
@@ -545,7 +545,7 @@ func TestMovieToDocumentWithEmptyCredits(t *testing.T) {
 	genres := []movie.MovieGenre{}
 	credits := []movie.MovieCredit{}
 
-	doc := s.movieToDocument(m, genres, credits, nil)
+	doc := s.movieToDocument(m, genres, nil, credits, nil)
 
 	assert.Equal(t, movieID.String(), doc.ID)
 	assert.Empty(t, doc.Genres)
@@ -554,6 +554,56 @@ func TestMovieToDocumentWithEmptyCredits(t *testing.T) {
 	assert.Empty(t, doc.Directors)
 }
 
+func TestMovieToDocumentWithTags(t *testing.T) {
+	s := &MovieSearchService{}
+
+	now := time.Now()
+	m := &movie.Movie{
+		ID:             uuid.Must(uuid.NewV7()),
+		Title:          "Tagged Movie",
+		LibraryAddedAt: now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	tags := []movie.MovieTag{
+		{Slug: "time-travel", Name: "time travel"},
+		{Slug: "twist-ending", Name: "twist ending", Spoiler: true},
+	}
+
+	doc := s.movieToDocument(m, nil, tags, nil, nil)
+
+	assert.Equal(t, []string{"time travel"}, doc.Tags)
+	assert.Equal(t, []string{"time-travel", "twist-ending"}, doc.TagSlugs)
+}
+
+func TestMovieToDocumentWithTranslations(t *testing.T) {
+	s := &MovieSearchService{}
+
+	now := time.Now()
+	original := "千と千尋の神隠し"
+	m := &movie.Movie{
+		ID:             uuid.Must(uuid.NewV7()),
+		Title:          "Spirited Away",
+		OriginalTitle:  &original,
+		LibraryAddedAt: now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		TitlesI18n: map[string]string{
+			"en": "Spirited Away",
+			"ja": original,
+			"de": "Chihiros Reise ins Zauberland",
+			"fr": "Le Voyage de Chihiro",
+		},
+		OverviewsI18n: map[string]string{"de": "Ein Mädchen gerät in eine Geisterwelt."},
+	}
+
+	doc := s.movieToDocument(m, nil, nil, nil, nil)
+
+	assert.Equal(t, []string{"Chihiros Reise ins Zauberland", "Le Voyage de Chihiro"}, doc.Titles)
+	assert.Equal(t, m.TitlesI18n, doc.TitlesI18n)
+	assert.Equal(t, m.OverviewsI18n, doc.OverviewsI18n)
+}
+
 func TestMovieToDocumentWithCrewOnly(t *testing.T) {
 	s := &MovieSearchService{}
 
@@ -576,7 +626,7 @@ func TestMovieToDocumentWithCrewOnly(t *testing.T) {
 		{CreditType: "crew", Name: "Jonathan Nolan", Job: &writerJob},
 	}
 
-	doc := s.movieToDocument(m, nil, credits, nil)
+	doc := s.movieToDocument(m, nil, nil, credits, nil)
 
 	assert.Empty(t, doc.Cast)
 	assert.Equal(t, []string{"Christopher Nolan"}, doc.Directors)
@@ -602,7 +652,7 @@ func TestMovieToDocumentWithZeroValues(t *testing.T) {
 		UpdatedAt:      now,
 	}
 
-	doc := s.movieToDocument(m, nil, nil, nil)
+	doc := s.movieToDocument(m, nil, nil, nil, nil)
 
 	assert.Equal(t, float64(0), doc.VoteAverage)
 	assert.Equal(t, int32(0), doc.VoteCount)
@@ -653,7 +703,7 @@ func TestMovieToDocumentFileInfo(t *testing.T) {
 			QualityProfile: &qualityProfile,
 		}
 
-		doc := s.movieToDocument(m, nil, nil, file)
+		doc := s.movieToDocument(m, nil, nil, nil, file)
 
 		assert.True(t, doc.HasFile)
 		assert.Equal(t, "1080p", doc.Resolution)
@@ -669,7 +719,7 @@ func TestMovieToDocumentFileInfo(t *testing.T) {
 			// No QualityProfile
 		}
 
-		doc := s.movieToDocument(m, nil, nil, file)
+		doc := s.movieToDocument(m, nil, nil, nil, file)
 
 		assert.True(t, doc.HasFile)
 		assert.Equal(t, "720p", doc.Resolution)
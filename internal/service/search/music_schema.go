@@ -0,0 +1,68 @@
+package search
+
+import (
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// MusicAlbumCollectionName is the name of the music albums collection in Typesense.
+const MusicAlbumCollectionName = "music_albums"
+
+// MusicAlbumDocument represents a release (album) document in the search index.
+// Track titles and artist names are indexed with the album so a search for
+// a song finds the album it is on.
+type MusicAlbumDocument struct {
+	ID             string   `json:"id"`               // Release UUID as string
+	ReleaseGroupID string   `json:"release_group_id"` // Release group UUID
+	MusicBrainzID  string   `json:"musicbrainz_id"`   // MusicBrainz release ID
+	Title          string   `json:"title"`            // Album title (searchable)
+	ArtistCredit   string   `json:"artist_credit"`    // Album artist as credited (searchable)
+	Artists        []string `json:"artists"`          // All album and track artists (searchable, facet)
+	TrackTitles    []string `json:"track_titles"`     // Track titles (searchable)
+	Genres         []string `json:"genres"`           // Genres (facet)
+	Year           int32    `json:"year"`             // Release year (facet + sort)
+	IsCompilation  bool     `json:"is_compilation"`   // Compilation flag (filter)
+	TrackCount     int32    `json:"track_count"`      // Number of tracks
+	CoverPath      string   `json:"cover_path"`       // Cover image path
+
+	// Timestamps
+	CreatedAt int64 `json:"created_at"` // Document creation time (sort)
+	UpdatedAt int64 `json:"updated_at"` // Document update time
+}
+
+// MusicAlbumCollectionSchema returns the Typesense schema for the music albums collection.
+func MusicAlbumCollectionSchema() *api.CollectionSchema {
+	return &api.CollectionSchema{
+		Name:                MusicAlbumCollectionName,
+		EnableNestedFields:  new(false),
+		TokenSeparators:     &[]string{"-", "_"},
+		SymbolsToIndex:      &[]string{"&"},
+		DefaultSortingField: new("created_at"),
+		Fields: []api.Field{
+			// ID field (primary key)
+			{Name: "id", Type: "string"},
+
+			// References
+			{Name: "release_group_id", Type: "string", Facet: new(false), Index: new(true)},
+			{Name: "musicbrainz_id", Type: "string", Facet: new(false), Index: new(true), Optional: new(true)},
+
+			// Title and artists (searchable with infix)
+			{Name: "title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true)},
+			{Name: "artist_credit", Type: "string", Facet: new(false), Index: new(true), Infix: new(true)},
+			{Name: "artists", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
+			{Name: "track_titles", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
+
+			// Facets
+			{Name: "genres", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
+			{Name: "year", Type: "int32", Facet: new(true), Index: new(true), Sort: new(true), Optional: new(true)},
+			{Name: "is_compilation", Type: "bool", Facet: new(true), Index: new(true)},
+			{Name: "track_count", Type: "int32", Facet: new(false), Index: new(true), Sort: new(true)},
+
+			// Image
+			{Name: "cover_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+
+			// Timestamps
+			{Name: "created_at", Type: "int64", Facet: new(false), Index: new(true), Sort: new(true)},
+			{Name: "updated_at", Type: "int64", Facet: new(false), Index: new(false), Optional: new(true)},
+		},
+	}
+}
@@ -0,0 +1,439 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/lusoris/revenge/internal/infra/search"
+	"github.com/typesense/typesense-go/v2/typesense/api"
+)
+
+// MusicSearchService provides music album search operations using Typesense.
+type MusicSearchService struct {
+	client *search.Client
+	logger *slog.Logger
+}
+
+// NewMusicSearchService creates a new music search service.
+func NewMusicSearchService(client *search.Client, logger *slog.Logger) *MusicSearchService {
+	return &MusicSearchService{
+		client: client,
+		logger: logger.With("service", "music_search"),
+	}
+}
+
+// IsEnabled returns true if search is enabled.
+func (s *MusicSearchService) IsEnabled() bool {
+	return s.client != nil && s.client.IsEnabled()
+}
+
+// InitializeCollection creates the music albums collection if it doesn't exist.
+func (s *MusicSearchService) InitializeCollection(ctx context.Context) error {
+	if !s.IsEnabled() {
+		s.logger.Debug("search disabled, skipping collection initialization")
+		return nil
+	}
+
+	_, err := s.client.GetCollection(ctx, MusicAlbumCollectionName)
+	if err == nil {
+		s.logger.Debug("music albums collection already exists")
+		return nil
+	}
+
+	schema := MusicAlbumCollectionSchema()
+	if err := s.client.CreateCollection(ctx, schema); err != nil {
+		return fmt.Errorf("failed to create music albums collection: %w", err)
+	}
+
+	s.logger.Info("created music albums collection")
+	return nil
+}
+
+// AlbumWithTracks bundles a release with its artists and tracks for indexing.
+type AlbumWithTracks struct {
+	Release *music.Release
+	Artists []music.Artist
+	Tracks  []music.Track
+}
+
+// IndexAlbum indexes a single album in Typesense.
+func (s *MusicSearchService) IndexAlbum(ctx context.Context, album AlbumWithTracks) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	doc := s.albumToDocument(album)
+	_, err := s.client.IndexDocument(ctx, MusicAlbumCollectionName, doc)
+	if err != nil {
+		return fmt.Errorf("failed to index album %s: %w", album.Release.ID, err)
+	}
+
+	s.logger.Debug("indexed album", "id", album.Release.ID, "title", album.Release.Title)
+	return nil
+}
+
+// RemoveAlbum removes an album from the search index.
+func (s *MusicSearchService) RemoveAlbum(ctx context.Context, releaseID uuid.UUID) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	_, err := s.client.DeleteDocument(ctx, MusicAlbumCollectionName, releaseID.String())
+	if err != nil && !strings.Contains(err.Error(), "not found") {
+		return fmt.Errorf("failed to remove album %s: %w", releaseID, err)
+	}
+
+	s.logger.Debug("removed album", "id", releaseID)
+	return nil
+}
+
+// BulkIndexAlbums indexes multiple albums at once.
+func (s *MusicSearchService) BulkIndexAlbums(ctx context.Context, albums []AlbumWithTracks) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	if len(albums) == 0 {
+		return nil
+	}
+
+	docs := make([]any, len(albums))
+	for i, album := range albums {
+		docs[i] = s.albumToDocument(album)
+	}
+
+	results, err := s.client.ImportDocuments(ctx, MusicAlbumCollectionName, docs, "upsert")
+	if err != nil {
+		return fmt.Errorf("failed to bulk index albums: %w", err)
+	}
+
+	var successCount, errorCount int
+	for _, result := range results {
+		if result.Success {
+			successCount++
+		} else {
+			errorCount++
+			if result.Error != "" {
+				s.logger.Warn("failed to index album document", "error", result.Error)
+			}
+		}
+	}
+
+	s.logger.Info("bulk indexed albums", "success", successCount, "errors", errorCount)
+	return nil
+}
+
+// MusicSearchResult represents a music album search result with hits.
+type MusicSearchResult struct {
+	Hits        []MusicAlbumHit
+	TotalHits   int
+	TotalPages  int
+	CurrentPage int
+	Facets      map[string][]FacetValue
+	SearchTime  time.Duration
+}
+
+// MusicAlbumHit represents a single album hit in search results.
+type MusicAlbumHit struct {
+	Document   MusicAlbumDocument
+	Score      float64
+	Highlights map[string][]string
+}
+
+// MusicSearchParams contains parameters for music album search.
+type MusicSearchParams struct {
+	Query             string
+	Page              int
+	PerPage           int
+	SortBy            string
+	FilterBy          string
+	FacetBy           []string
+	IncludeHighlights bool
+}
+
+// DefaultMusicSearchParams returns default search parameters for music albums.
+func DefaultMusicSearchParams() MusicSearchParams {
+	return MusicSearchParams{
+		Page:              1,
+		PerPage:           20,
+		FacetBy:           []string{"genres", "year", "artists"},
+		IncludeHighlights: true,
+	}
+}
+
+// SearchAlbums searches for albums by title, artist or track title.
+func (s *MusicSearchService) SearchAlbums(ctx context.Context, params MusicSearchParams) (*MusicSearchResult, error) {
+	if !s.IsEnabled() {
+		return &MusicSearchResult{}, nil
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PerPage < 1 {
+		params.PerPage = 20
+	}
+	if params.PerPage > 100 {
+		params.PerPage = 100
+	}
+
+	queryBy := "title,artist_credit,artists,track_titles"
+	page := params.Page
+	perPage := params.PerPage
+
+	searchParams := &api.SearchCollectionParams{
+		Q:       &params.Query,
+		QueryBy: &queryBy,
+		Page:    &page,
+		PerPage: &perPage,
+	}
+
+	if params.SortBy != "" {
+		searchParams.SortBy = &params.SortBy
+	}
+	if params.FilterBy != "" {
+		searchParams.FilterBy = &params.FilterBy
+	}
+	if len(params.FacetBy) > 0 {
+		facetBy := strings.Join(params.FacetBy, ",")
+		searchParams.FacetBy = &facetBy
+	}
+
+	start := time.Now()
+	result, err := s.client.Search(ctx, MusicAlbumCollectionName, searchParams)
+	searchTime := time.Since(start)
+
+	if err != nil {
+		return nil, fmt.Errorf("music search failed: %w", err)
+	}
+
+	searchResult := &MusicSearchResult{
+		TotalHits:   deref(result.Found),
+		CurrentPage: params.Page,
+		TotalPages:  (deref(result.Found) + params.PerPage - 1) / params.PerPage,
+		SearchTime:  searchTime,
+		Hits:        make([]MusicAlbumHit, 0, len(derefHits(result.Hits))),
+		Facets:      make(map[string][]FacetValue),
+	}
+
+	if result.Hits != nil {
+		for _, hit := range *result.Hits {
+			aHit := MusicAlbumHit{
+				Highlights: make(map[string][]string),
+			}
+
+			if hit.Document != nil {
+				aHit.Document = parseMusicAlbumDocument(*hit.Document)
+			}
+
+			if hit.TextMatch != nil {
+				aHit.Score = float64(*hit.TextMatch)
+			}
+
+			if params.IncludeHighlights && hit.Highlights != nil {
+				for _, hl := range *hit.Highlights {
+					if hl.Field != nil && hl.Snippets != nil {
+						aHit.Highlights[*hl.Field] = *hl.Snippets
+					}
+				}
+			}
+
+			searchResult.Hits = append(searchResult.Hits, aHit)
+		}
+	}
+
+	if result.FacetCounts != nil {
+		for _, fc := range *result.FacetCounts {
+			if fc.FieldName == nil || fc.Counts == nil {
+				continue
+			}
+			values := make([]FacetValue, 0, len(*fc.Counts))
+			for _, count := range *fc.Counts {
+				if count.Value == nil || count.Count == nil {
+					continue
+				}
+				values = append(values, FacetValue{
+					Value: *count.Value,
+					Count: *count.Count,
+				})
+			}
+			searchResult.Facets[*fc.FieldName] = values
+		}
+	}
+
+	return searchResult, nil
+}
+
+// ReindexAll reindexes all albums in the database.
+func (s *MusicSearchService) ReindexAll(ctx context.Context, service music.Service) error {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	s.logger.Info("starting full music reindex")
+
+	_ = s.client.DeleteCollection(ctx, MusicAlbumCollectionName)
+	if err := s.InitializeCollection(ctx); err != nil {
+		return fmt.Errorf("failed to reinitialize music albums collection: %w", err)
+	}
+
+	batchSize := int32(100)
+	var offset int32
+	totalIndexed := 0
+
+	for {
+		releases, _, err := service.ListReleases(ctx, music.ReleaseListFilters{
+			Limit:  batchSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list releases: %w", err)
+		}
+
+		if len(releases) == 0 {
+			break
+		}
+
+		batch := make([]AlbumWithTracks, 0, len(releases))
+		for i := range releases {
+			album, err := LoadAlbum(ctx, service, &releases[i])
+			if err != nil {
+				s.logger.Warn("failed to load album for indexing",
+					slog.String("release_id", releases[i].ID.String()),
+					slog.Any("error", err),
+				)
+				continue
+			}
+			batch = append(batch, album)
+		}
+
+		if err := s.BulkIndexAlbums(ctx, batch); err != nil {
+			s.logger.Error("failed to index album batch", slog.Any("error", err))
+		}
+		totalIndexed += len(batch)
+
+		offset += batchSize
+
+		if len(releases) < int(batchSize) {
+			break
+		}
+	}
+
+	s.logger.Info("completed full music reindex", "total", totalIndexed)
+	return nil
+}
+
+// LoadAlbum collects the artists and tracks of a release for indexing.
+func LoadAlbum(ctx context.Context, service music.Service, release *music.Release) (AlbumWithTracks, error) {
+	artists, err := service.GetReleaseArtists(ctx, release.ID)
+	if err != nil {
+		return AlbumWithTracks{}, err
+	}
+	discs, err := service.GetReleaseDiscs(ctx, release.ID)
+	if err != nil {
+		return AlbumWithTracks{}, err
+	}
+	var tracks []music.Track
+	for _, disc := range discs {
+		tracks = append(tracks, disc.Tracks...)
+	}
+	return AlbumWithTracks{Release: release, Artists: artists, Tracks: tracks}, nil
+}
+
+// albumToDocument converts an album to a search document.
+func (s *MusicSearchService) albumToDocument(album AlbumWithTracks) MusicAlbumDocument {
+	release := album.Release
+	doc := MusicAlbumDocument{
+		ID:             release.ID.String(),
+		ReleaseGroupID: release.ReleaseGroupID.String(),
+		Title:          release.Title,
+		ArtistCredit:   release.ArtistCredit,
+		Genres:         release.Genres,
+		IsCompilation:  release.IsCompilation,
+		TrackCount:     release.TrackCount,
+		CreatedAt:      release.CreatedAt.Unix(),
+		UpdatedAt:      time.Now().Unix(),
+	}
+
+	if release.MusicBrainzID != nil {
+		doc.MusicBrainzID = release.MusicBrainzID.String()
+	}
+	if release.Year != nil {
+		doc.Year = *release.Year
+	}
+	if release.CoverPath != nil {
+		doc.CoverPath = *release.CoverPath
+	}
+
+	seen := make(map[string]bool)
+	addArtist := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			doc.Artists = append(doc.Artists, name)
+		}
+	}
+	for _, a := range album.Artists {
+		addArtist(a.Name)
+	}
+	for _, t := range album.Tracks {
+		addArtist(t.ArtistCredit)
+		doc.TrackTitles = append(doc.TrackTitles, t.Title)
+	}
+
+	return doc
+}
+
+// parseMusicAlbumDocument parses a map into a MusicAlbumDocument.
+func parseMusicAlbumDocument(data map[string]any) MusicAlbumDocument {
+	doc := MusicAlbumDocument{}
+
+	if v, ok := data["id"].(string); ok {
+		doc.ID = v
+	}
+	if v, ok := data["release_group_id"].(string); ok {
+		doc.ReleaseGroupID = v
+	}
+	if v, ok := data["musicbrainz_id"].(string); ok {
+		doc.MusicBrainzID = v
+	}
+	if v, ok := data["title"].(string); ok {
+		doc.Title = v
+	}
+	if v, ok := data["artist_credit"].(string); ok {
+		doc.ArtistCredit = v
+	}
+	if v, ok := data["artists"].([]any); ok {
+		doc.Artists = toStringSlice(v)
+	}
+	if v, ok := data["track_titles"].([]any); ok {
+		doc.TrackTitles = toStringSlice(v)
+	}
+	if v, ok := data["genres"].([]any); ok {
+		doc.Genres = toStringSlice(v)
+	}
+	if v, ok := data["year"].(float64); ok {
+		doc.Year = int32(v)
+	}
+	if v, ok := data["is_compilation"].(bool); ok {
+		doc.IsCompilation = v
+	}
+	if v, ok := data["track_count"].(float64); ok {
+		doc.TrackCount = int32(v)
+	}
+	if v, ok := data["cover_path"].(string); ok {
+		doc.CoverPath = v
+	}
+	if v, ok := data["created_at"].(float64); ok {
+		doc.CreatedAt = int64(v)
+	}
+	if v, ok := data["updated_at"].(float64); ok {
+		doc.UpdatedAt = int64(v)
+	}
+
+	return doc
+}
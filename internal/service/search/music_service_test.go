@@ -0,0 +1,99 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lusoris/revenge/internal/content/music"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMusicAlbumCollectionSchema(t *testing.T) {
+	schema := MusicAlbumCollectionSchema()
+
+	assert.Equal(t, MusicAlbumCollectionName, schema.Name)
+	assert.NotNil(t, schema.DefaultSortingField)
+	assert.Equal(t, "created_at", *schema.DefaultSortingField)
+
+	fieldNames := make(map[string]bool)
+	facetFields := make(map[string]bool)
+	for _, f := range schema.Fields {
+		fieldNames[f.Name] = true
+		if f.Facet != nil && *f.Facet {
+			facetFields[f.Name] = true
+		}
+	}
+
+	for _, name := range []string{"id", "title", "artist_credit", "artists", "track_titles", "genres", "year", "created_at"} {
+		assert.True(t, fieldNames[name], "schema should have field: %s", name)
+	}
+	for _, name := range []string{"artists", "genres", "year", "is_compilation"} {
+		assert.True(t, facetFields[name], "field %s should be facetable", name)
+	}
+}
+
+func TestAlbumToDocument(t *testing.T) {
+	s := &MusicSearchService{}
+	mbid := uuid.New()
+	year := int32(1969)
+	cover := "/music/The Beatles/Abbey Road/cover.jpg"
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	release := &music.Release{
+		ID:             uuid.New(),
+		ReleaseGroupID: uuid.New(),
+		MusicBrainzID:  &mbid,
+		Title:          "Abbey Road",
+		ArtistCredit:   "The Beatles",
+		Genres:         []string{"Rock"},
+		Year:           &year,
+		TrackCount:     17,
+		CoverPath:      &cover,
+		CreatedAt:      created,
+	}
+	album := AlbumWithTracks{
+		Release: release,
+		Artists: []music.Artist{{Name: "The Beatles"}},
+		Tracks: []music.Track{
+			{Title: "Come Together", ArtistCredit: "The Beatles"},
+			{Title: "Something", ArtistCredit: "The Beatles"},
+		},
+	}
+
+	doc := s.albumToDocument(album)
+
+	assert.Equal(t, release.ID.String(), doc.ID)
+	assert.Equal(t, mbid.String(), doc.MusicBrainzID)
+	assert.Equal(t, "Abbey Road", doc.Title)
+	assert.Equal(t, []string{"The Beatles"}, doc.Artists)
+	assert.Equal(t, []string{"Come Together", "Something"}, doc.TrackTitles)
+	assert.Equal(t, int32(1969), doc.Year)
+	assert.Equal(t, cover, doc.CoverPath)
+	assert.Equal(t, created.Unix(), doc.CreatedAt)
+}
+
+func TestParseMusicAlbumDocument(t *testing.T) {
+	doc := parseMusicAlbumDocument(map[string]any{
+		"id":             "abc",
+		"title":          "Homogenic",
+		"artist_credit":  "Björk",
+		"artists":        []any{"Björk"},
+		"track_titles":   []any{"Hunter", "Jóga"},
+		"year":           float64(1997),
+		"is_compilation": false,
+		"track_count":    float64(10),
+	})
+
+	assert.Equal(t, "abc", doc.ID)
+	assert.Equal(t, "Homogenic", doc.Title)
+	assert.Equal(t, []string{"Björk"}, doc.Artists)
+	assert.Equal(t, []string{"Hunter", "Jóga"}, doc.TrackTitles)
+	assert.Equal(t, int32(1997), doc.Year)
+	assert.Equal(t, int32(10), doc.TrackCount)
+}
+
+func TestMusicSearchServiceIsEnabled(t *testing.T) {
+	s := &MusicSearchService{client: nil}
+	assert.False(t, s.IsEnabled())
+}
@@ -9,16 +9,18 @@ const PersonCollectionName = "people"
 
 // PersonDocument represents a person in the search index, aggregated from all credit sources.
 type PersonDocument struct {
-	ID           string   `json:"id"`            // tmdb_person_id as string
-	TMDbID       int32    `json:"tmdb_id"`       // TMDb person ID
-	Name         string   `json:"name"`          // Person name
-	ProfilePath  string   `json:"profile_path"`  // Profile image path
-	KnownFor     []string `json:"known_for"`     // Movie/show titles they appeared in
-	Characters   []string `json:"characters"`    // Character names played
-	Departments  []string `json:"departments"`   // Unique departments: Acting, Directing, etc.
-	MovieCount   int32    `json:"movie_count"`   // Number of movie credits
-	TVShowCount  int32    `json:"tvshow_count"`  // Number of TV show credits
-	TotalCredits int32    `json:"total_credits"` // Total credit count
+	ID              string   `json:"id"`               // tmdb_person_id as string
+	TMDbID          int32    `json:"tmdb_id"`          // TMDb person ID
+	Name            string   `json:"name"`             // Person name
+	ProfilePath     string   `json:"profile_path"`     // Profile image path
+	ProfileBlurHash string   `json:"profile_blurhash"` // Profile BlurHash placeholder
+	ProfileColor    string   `json:"profile_color"`    // Profile dominant color
+	KnownFor        []string `json:"known_for"`        // Movie/show titles they appeared in
+	Characters      []string `json:"characters"`       // Character names played
+	Departments     []string `json:"departments"`      // Unique departments: Acting, Directing, etc.
+	MovieCount      int32    `json:"movie_count"`      // Number of movie credits
+	TVShowCount     int32    `json:"tvshow_count"`     // Number of TV show credits
+	TotalCredits    int32    `json:"total_credits"`    // Total credit count
 }
 
 // PersonCollectionSchema returns the Typesense collection schema for people.
@@ -33,6 +35,8 @@ func PersonCollectionSchema() *api.CollectionSchema {
 			{Name: "tmdb_id", Type: "int32"},
 			{Name: "name", Type: "string", Infix: new(true)},
 			{Name: "profile_path", Type: "string", Index: new(false), Optional: new(true)},
+			{Name: "profile_blurhash", Type: "string", Index: new(false), Optional: new(true)},
+			{Name: "profile_color", Type: "string", Index: new(false), Optional: new(true)},
 			{Name: "known_for", Type: "string[]", Optional: new(true)},
 			{Name: "characters", Type: "string[]", Optional: new(true)},
 			{Name: "departments", Type: "string[]", Facet: new(true), Optional: new(true)},
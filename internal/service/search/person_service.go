@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lusoris/revenge/internal/infra/image/blurhash"
 	"github.com/lusoris/revenge/internal/infra/search"
 	"github.com/typesense/typesense-go/v2/typesense/api"
 )
@@ -22,6 +23,9 @@ type PersonAggregate struct {
 	Departments  []string // Unique departments (Acting, Directing, etc.)
 	MovieCount   int32
 	TVShowCount  int32
+
+	// ProfilePlaceholder is the placeholder of the profile image, if computed.
+	ProfilePlaceholder *blurhash.Placeholder
 }
 
 // PersonSearchResult contains the search results for a person query.
@@ -361,6 +365,7 @@ func personToDocument(p PersonAggregate) PersonDocument {
 		TVShowCount:  p.TVShowCount,
 		TotalCredits: p.MovieCount + p.TVShowCount,
 	}
+	doc.ProfileBlurHash, doc.ProfileColor = placeholderFields(p.ProfilePlaceholder)
 
 	if len(p.KnownFor) > 0 {
 		// Deduplicate and cap at 20 titles
@@ -424,6 +429,12 @@ func parsePersonDocument(data map[string]any) PersonDocument {
 	if v, ok := data["profile_path"].(string); ok {
 		doc.ProfilePath = v
 	}
+	if v, ok := data["profile_blurhash"].(string); ok {
+		doc.ProfileBlurHash = v
+	}
+	if v, ok := data["profile_color"].(string); ok {
+		doc.ProfileColor = v
+	}
 	if v, ok := data["known_for"].([]any); ok {
 		doc.KnownFor = toStringSlice(v)
 	}
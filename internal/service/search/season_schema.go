@@ -9,16 +9,18 @@ const SeasonCollectionName = "seasons"
 
 // SeasonDocument represents a season document in the search index.
 type SeasonDocument struct {
-	ID           string  `json:"id"`            // UUID as string
-	SeriesID     string  `json:"series_id"`     // Parent series UUID
-	TMDbID       int32   `json:"tmdb_id"`       // TMDb ID for external reference
-	SeasonNumber int32   `json:"season_number"` // Season number (facet + filter)
-	Name         string  `json:"name"`          // Season name (searchable)
-	Overview     string  `json:"overview"`      // Season overview (searchable)
-	AirDate      int64   `json:"air_date"`      // Unix timestamp for sorting
-	EpisodeCount int32   `json:"episode_count"` // Number of episodes
-	VoteAverage  float64 `json:"vote_average"`  // Rating (sortable)
-	PosterPath   string  `json:"poster_path"`   // Poster image path
+	ID             string  `json:"id"`              // UUID as string
+	SeriesID       string  `json:"series_id"`       // Parent series UUID
+	TMDbID         int32   `json:"tmdb_id"`         // TMDb ID for external reference
+	SeasonNumber   int32   `json:"season_number"`   // Season number (facet + filter)
+	Name           string  `json:"name"`            // Season name (searchable)
+	Overview       string  `json:"overview"`        // Season overview (searchable)
+	AirDate        int64   `json:"air_date"`        // Unix timestamp for sorting
+	EpisodeCount   int32   `json:"episode_count"`   // Number of episodes
+	VoteAverage    float64 `json:"vote_average"`    // Rating (sortable)
+	PosterPath     string  `json:"poster_path"`     // Poster image path
+	PosterBlurHash string  `json:"poster_blurhash"` // Poster BlurHash placeholder
+	PosterColor    string  `json:"poster_color"`    // Poster dominant color
 
 	// Series context for display in search results
 	SeriesTitle      string `json:"series_title"`       // Parent series title (searchable)
@@ -63,6 +65,8 @@ func SeasonCollectionSchema() *api.CollectionSchema {
 
 			// Image
 			{Name: "poster_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "poster_blurhash", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "poster_color", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 
 			// Series context (searchable)
 			{Name: "series_title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true)},
@@ -486,6 +486,7 @@ func (s *SeasonSearchService) seasonToDocument(season *tvshow.Season, seriesTitl
 	if season.PosterPath != nil {
 		doc.PosterPath = *season.PosterPath
 	}
+	doc.PosterBlurHash, doc.PosterColor = placeholderFields(season.ImagePlaceholders.For(season.PosterPath))
 
 	return doc
 }
@@ -524,6 +525,12 @@ func parseSeasonDocument(data map[string]any) SeasonDocument {
 	if v, ok := data["poster_path"].(string); ok {
 		doc.PosterPath = v
 	}
+	if v, ok := data["poster_blurhash"].(string); ok {
+		doc.PosterBlurHash = v
+	}
+	if v, ok := data["poster_color"].(string); ok {
+		doc.PosterColor = v
+	}
 	if v, ok := data["series_title"].(string); ok {
 		doc.SeriesTitle = v
 	}
@@ -56,7 +56,7 @@ func TestMovieSearchService_IndexMovie_Disabled(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	err := svc.IndexMovie(ctx, m, nil, nil, nil)
+	err := svc.IndexMovie(ctx, m, nil, nil, nil, nil)
 	assert.NoError(t, err)
 }
 
@@ -73,7 +73,7 @@ func TestMovieSearchService_UpdateMovie_Disabled(t *testing.T) {
 		CreatedAt: time.Now(),
 	}
 
-	err := svc.UpdateMovie(ctx, m, nil, nil, nil)
+	err := svc.UpdateMovie(ctx, m, nil, nil, nil, nil)
 	assert.NoError(t, err)
 }
 
@@ -266,7 +266,7 @@ func TestTVShowSearchService_IndexSeries_Disabled(t *testing.T) {
 	}
 	ctx := context.Background()
 
-	err := svc.IndexSeries(ctx, nil, nil, nil, nil, false)
+	err := svc.IndexSeries(ctx, nil, nil, nil, nil, nil, false)
 	assert.NoError(t, err)
 }
 
@@ -277,7 +277,7 @@ func TestTVShowSearchService_UpdateSeries_Disabled(t *testing.T) {
 	}
 	ctx := context.Background()
 
-	err := svc.UpdateSeries(ctx, nil, nil, nil, nil, false)
+	err := svc.UpdateSeries(ctx, nil, nil, nil, nil, nil, false)
 	assert.NoError(t, err)
 }
 
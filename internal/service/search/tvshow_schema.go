@@ -15,19 +15,27 @@ type TVShowDocument struct {
 	IMDbID           string   `json:"imdb_id"`           // IMDb ID (optional)
 	Title            string   `json:"title"`             // Main title (searchable)
 	OriginalTitle    string   `json:"original_title"`    // Original title (searchable)
+	Titles           []string `json:"titles"`            // Translated titles (searchable)
 	Year             int32    `json:"year"`              // First air year (facet + filter)
 	FirstAirDate     int64    `json:"first_air_date"`    // Unix timestamp for sorting
 	Overview         string   `json:"overview"`          // Plot overview (searchable)
 	Status           string   `json:"status"`            // Series status (facet)
 	Type             string   `json:"type"`              // Scripted, Reality, etc. (facet)
 	OriginalLanguage string   `json:"original_language"` // Original language (facet)
+	MinAge           int32    `json:"min_age"`           // Minimum viewer age, -1 when unrated (filter)
 	PosterPath       string   `json:"poster_path"`       // Poster image path
+	PosterBlurHash   string   `json:"poster_blurhash"`   // Poster BlurHash placeholder
+	PosterColor      string   `json:"poster_color"`      // Poster dominant color
 	BackdropPath     string   `json:"backdrop_path"`     // Backdrop image path
+	BackdropBlurHash string   `json:"backdrop_blurhash"` // Backdrop BlurHash placeholder
+	BackdropColor    string   `json:"backdrop_color"`    // Backdrop dominant color
 	VoteAverage      float64  `json:"vote_average"`      // Rating (sortable)
 	VoteCount        int32    `json:"vote_count"`        // Vote count
 	Popularity       float64  `json:"popularity"`        // TMDb popularity (sortable)
 	Genres           []string `json:"genres"`            // Genre names (facet + filter)
 	GenreSlugs       []string `json:"genre_slugs"`       // Genre slugs (facet + filter)
+	Tags             []string `json:"tags"`              // Non-spoiler keyword and tag names (facet + filter)
+	TagSlugs         []string `json:"tag_slugs"`         // All keyword and tag slugs, including spoilers (filter)
 	Cast             []string `json:"cast"`              // Cast member names (searchable)
 	Networks         []string `json:"networks"`          // Network names (searchable + facet)
 	TotalSeasons     int32    `json:"total_seasons"`     // Number of seasons
@@ -35,6 +43,10 @@ type TVShowDocument struct {
 	HasFile          bool     `json:"has_file"`          // Whether series has any episode files (facet)
 	CreatedAt        int64    `json:"created_at"`        // Document creation time
 	UpdatedAt        int64    `json:"updated_at"`        // Document update time
+
+	// Per-language values used to localize results; stored but not indexed.
+	TitlesI18n    map[string]string `json:"titles_i18n,omitempty"`
+	OverviewsI18n map[string]string `json:"overviews_i18n,omitempty"`
 }
 
 // TVShowCollectionSchema returns the Typesense schema for the TV shows collection.
@@ -57,6 +69,7 @@ func TVShowCollectionSchema() *api.CollectionSchema {
 			// Title fields (searchable with infix for partial matching)
 			{Name: "title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true)},
 			{Name: "original_title", Type: "string", Facet: new(false), Index: new(true), Infix: new(true), Optional: new(true)},
+			{Name: "titles", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
 
 			// Year and dates
 			{Name: "year", Type: "int32", Facet: new(true), Index: new(true), Optional: new(true)},
@@ -67,10 +80,15 @@ func TVShowCollectionSchema() *api.CollectionSchema {
 			{Name: "status", Type: "string", Facet: new(true), Index: new(true), Optional: new(true)},
 			{Name: "type", Type: "string", Facet: new(true), Index: new(true), Optional: new(true)},
 			{Name: "original_language", Type: "string", Facet: new(true), Index: new(true), Optional: new(true)},
+			{Name: "min_age", Type: "int32", Facet: new(false), Index: new(true), Optional: new(true)},
 
 			// Images
 			{Name: "poster_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "poster_blurhash", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "poster_color", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 			{Name: "backdrop_path", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "backdrop_blurhash", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
+			{Name: "backdrop_color", Type: "string", Facet: new(false), Index: new(false), Optional: new(true)},
 
 			// Ratings and popularity (sortable)
 			{Name: "vote_average", Type: "float", Facet: new(false), Index: new(true), Sort: new(true), Optional: new(true)},
@@ -81,6 +99,10 @@ func TVShowCollectionSchema() *api.CollectionSchema {
 			{Name: "genres", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
 			{Name: "genre_slugs", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
 
+			// Keywords and tags (spoiler slugs are filterable but never faceted)
+			{Name: "tags", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
+			{Name: "tag_slugs", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
+
 			// Credits and networks (searchable)
 			{Name: "cast", Type: "string[]", Facet: new(false), Index: new(true), Optional: new(true)},
 			{Name: "networks", Type: "string[]", Facet: new(true), Index: new(true), Optional: new(true)},
@@ -59,18 +59,19 @@ func (s *TVShowSearchService) InitializeCollection(ctx context.Context) error {
 type TVShowWithRelations struct {
 	Series   *tvshow.Series
 	Genres   []tvshow.SeriesGenre
+	Tags     []tvshow.SeriesTag
 	Credits  []tvshow.SeriesCredit
 	Networks []tvshow.Network
 	HasFile  bool
 }
 
 // IndexSeries indexes a single series in Typesense.
-func (s *TVShowSearchService) IndexSeries(ctx context.Context, series *tvshow.Series, genres []tvshow.SeriesGenre, credits []tvshow.SeriesCredit, networks []tvshow.Network, hasFile bool) error {
+func (s *TVShowSearchService) IndexSeries(ctx context.Context, series *tvshow.Series, genres []tvshow.SeriesGenre, tags []tvshow.SeriesTag, credits []tvshow.SeriesCredit, networks []tvshow.Network, hasFile bool) error {
 	if !s.IsEnabled() {
 		return nil
 	}
 
-	doc := s.seriesToDocument(series, genres, credits, networks, hasFile)
+	doc := s.seriesToDocument(series, genres, tags, credits, networks, hasFile)
 	_, err := s.client.IndexDocument(ctx, TVShowCollectionName, doc)
 	if err != nil {
 		return fmt.Errorf("failed to index series %s: %w", series.ID, err)
@@ -81,16 +82,16 @@ func (s *TVShowSearchService) IndexSeries(ctx context.Context, series *tvshow.Se
 }
 
 // UpdateSeries updates a series in the search index.
-func (s *TVShowSearchService) UpdateSeries(ctx context.Context, series *tvshow.Series, genres []tvshow.SeriesGenre, credits []tvshow.SeriesCredit, networks []tvshow.Network, hasFile bool) error {
+func (s *TVShowSearchService) UpdateSeries(ctx context.Context, series *tvshow.Series, genres []tvshow.SeriesGenre, tags []tvshow.SeriesTag, credits []tvshow.SeriesCredit, networks []tvshow.Network, hasFile bool) error {
 	if !s.IsEnabled() {
 		return nil
 	}
 
-	doc := s.seriesToDocument(series, genres, credits, networks, hasFile)
+	doc := s.seriesToDocument(series, genres, tags, credits, networks, hasFile)
 	_, err := s.client.UpdateDocument(ctx, TVShowCollectionName, series.ID.String(), doc)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			return s.IndexSeries(ctx, series, genres, credits, networks, hasFile)
+			return s.IndexSeries(ctx, series, genres, tags, credits, networks, hasFile)
 		}
 		return fmt.Errorf("failed to update series %s: %w", series.ID, err)
 	}
@@ -126,7 +127,7 @@ func (s *TVShowSearchService) BulkIndexSeries(ctx context.Context, shows []TVSho
 
 	docs := make([]any, len(shows))
 	for i, show := range shows {
-		docs[i] = s.seriesToDocument(show.Series, show.Genres, show.Credits, show.Networks, show.HasFile)
+		docs[i] = s.seriesToDocument(show.Series, show.Genres, show.Tags, show.Credits, show.Networks, show.HasFile)
 	}
 
 	results, err := s.client.ImportDocuments(ctx, TVShowCollectionName, docs, "upsert")
@@ -184,7 +185,7 @@ func DefaultTVShowSearchParams() TVShowSearchParams {
 		Page:              1,
 		PerPage:           20,
 		SortBy:            "popularity:desc",
-		FacetBy:           []string{"genres", "year", "status", "type", "networks", "has_file"},
+		FacetBy:           []string{"genres", "tags", "year", "status", "type", "networks", "has_file"},
 		IncludeHighlights: true,
 	}
 }
@@ -205,7 +206,7 @@ func (s *TVShowSearchService) SearchSeries(ctx context.Context, params TVShowSea
 		params.PerPage = 100
 	}
 
-	queryBy := "title,original_title,overview,cast,networks"
+	queryBy := "title,original_title,titles,overview,cast,networks"
 	page := params.Page
 	perPage := params.PerPage
 
@@ -220,8 +221,8 @@ func (s *TVShowSearchService) SearchSeries(ctx context.Context, params TVShowSea
 		searchParams.SortBy = &params.SortBy
 	}
 
-	if params.FilterBy != "" {
-		searchParams.FilterBy = &params.FilterBy
+	if filterBy := restrictFilterBy(ctx, params.FilterBy); filterBy != "" {
+		searchParams.FilterBy = &filterBy
 	}
 
 	if len(params.FacetBy) > 0 {
@@ -317,6 +318,9 @@ func (s *TVShowSearchService) AutocompleteSeries(ctx context.Context, query stri
 		Prefix:              new("true"),
 		DropTokensThreshold: new(0),
 	}
+	if filterBy := restrictFilterBy(ctx, ""); filterBy != "" {
+		searchParams.FilterBy = &filterBy
+	}
 
 	result, err := s.client.Search(ctx, TVShowCollectionName, searchParams)
 	if err != nil {
@@ -397,6 +401,7 @@ func (s *TVShowSearchService) ReindexAll(ctx context.Context, service tvshow.Ser
 		showsWithRelations := make([]TVShowWithRelations, 0, len(seriesList))
 		for _, series := range seriesList {
 			genres, _ := service.GetSeriesGenres(ctx, series.ID)
+			tags, _ := service.GetSeriesTags(ctx, series.ID)
 			cast, _, _ := service.GetSeriesCast(ctx, series.ID, 1000, 0)
 			crew, _, _ := service.GetSeriesCrew(ctx, series.ID, 1000, 0)
 			networks, _ := service.GetSeriesNetworks(ctx, series.ID)
@@ -420,6 +425,7 @@ func (s *TVShowSearchService) ReindexAll(ctx context.Context, service tvshow.Ser
 			showsWithRelations = append(showsWithRelations, TVShowWithRelations{
 				Series:   &sCopy,
 				Genres:   genres,
+				Tags:     tags,
 				Credits:  credits,
 				Networks: networks,
 				HasFile:  hasFile,
@@ -443,12 +449,13 @@ func (s *TVShowSearchService) ReindexAll(ctx context.Context, service tvshow.Ser
 }
 
 // seriesToDocument converts a series with related data to a search document.
-func (s *TVShowSearchService) seriesToDocument(series *tvshow.Series, genres []tvshow.SeriesGenre, credits []tvshow.SeriesCredit, networks []tvshow.Network, hasFile bool) TVShowDocument {
+func (s *TVShowSearchService) seriesToDocument(series *tvshow.Series, genres []tvshow.SeriesGenre, tags []tvshow.SeriesTag, credits []tvshow.SeriesCredit, networks []tvshow.Network, hasFile bool) TVShowDocument {
 	now := time.Now().Unix()
 
 	doc := TVShowDocument{
 		ID:        series.ID.String(),
 		Title:     series.Title,
+		MinAge:    documentMinAge(series.MinAge),
 		HasFile:   hasFile,
 		CreatedAt: series.CreatedAt.Unix(),
 		UpdatedAt: now,
@@ -473,6 +480,9 @@ func (s *TVShowSearchService) seriesToDocument(series *tvshow.Series, genres []t
 	if series.Overview != nil {
 		doc.Overview = *series.Overview
 	}
+	doc.Titles = translatedTitles(series.TitlesI18n, doc.Title, doc.OriginalTitle)
+	doc.TitlesI18n = series.TitlesI18n
+	doc.OverviewsI18n = series.OverviewsI18n
 	if series.Status != nil {
 		doc.Status = *series.Status
 	}
@@ -486,6 +496,8 @@ func (s *TVShowSearchService) seriesToDocument(series *tvshow.Series, genres []t
 	if series.BackdropPath != nil {
 		doc.BackdropPath = *series.BackdropPath
 	}
+	doc.PosterBlurHash, doc.PosterColor = placeholderFields(series.ImagePlaceholders.For(series.PosterPath))
+	doc.BackdropBlurHash, doc.BackdropColor = placeholderFields(series.ImagePlaceholders.For(series.BackdropPath))
 	if series.VoteAverage != nil {
 		f, _ := series.VoteAverage.Float64()
 		doc.VoteAverage = f
@@ -513,6 +525,14 @@ func (s *TVShowSearchService) seriesToDocument(series *tvshow.Series, genres []t
 		doc.GenreSlugs = genreSlugs
 	}
 
+	// Keywords and tags
+	for _, t := range tags {
+		if !t.Spoiler {
+			doc.Tags = append(doc.Tags, t.Name)
+		}
+		doc.TagSlugs = append(doc.TagSlugs, t.Slug)
+	}
+
 	// Credits - extract cast names
 	if len(credits) > 0 {
 		var cast []string
@@ -579,12 +599,27 @@ func parseTVShowDocument(data map[string]any) TVShowDocument {
 	if v, ok := data["original_language"].(string); ok {
 		doc.OriginalLanguage = v
 	}
+	if v, ok := data["min_age"].(float64); ok {
+		doc.MinAge = int32(v)
+	}
 	if v, ok := data["poster_path"].(string); ok {
 		doc.PosterPath = v
 	}
+	if v, ok := data["poster_blurhash"].(string); ok {
+		doc.PosterBlurHash = v
+	}
+	if v, ok := data["poster_color"].(string); ok {
+		doc.PosterColor = v
+	}
 	if v, ok := data["backdrop_path"].(string); ok {
 		doc.BackdropPath = v
 	}
+	if v, ok := data["backdrop_blurhash"].(string); ok {
+		doc.BackdropBlurHash = v
+	}
+	if v, ok := data["backdrop_color"].(string); ok {
+		doc.BackdropColor = v
+	}
 	if v, ok := data["vote_average"].(float64); ok {
 		doc.VoteAverage = v
 	}
@@ -623,6 +658,21 @@ func parseTVShowDocument(data map[string]any) TVShowDocument {
 	if v, ok := data["genre_slugs"].([]any); ok {
 		doc.GenreSlugs = toStringSlice(v)
 	}
+	if v, ok := data["tags"].([]any); ok {
+		doc.Tags = toStringSlice(v)
+	}
+	if v, ok := data["tag_slugs"].([]any); ok {
+		doc.TagSlugs = toStringSlice(v)
+	}
+	if v, ok := data["titles"].([]any); ok {
+		doc.Titles = toStringSlice(v)
+	}
+	if v, ok := data["titles_i18n"].(map[string]any); ok {
+		doc.TitlesI18n = toStringMap(v)
+	}
+	if v, ok := data["overviews_i18n"].(map[string]any); ok {
+		doc.OverviewsI18n = toStringMap(v)
+	}
 
 	return doc
 }
@@ -189,7 +189,7 @@ func TestSeriesToDocument(t *testing.T) {
 		{Name: "AMC"},
 	}
 
-	doc := s.seriesToDocument(series, genres, credits, networks, true)
+	doc := s.seriesToDocument(series, genres, nil, credits, networks, true)
 
 	assert.Equal(t, seriesID.String(), doc.ID)
 	assert.Equal(t, tmdbID, doc.TMDbID)
@@ -238,7 +238,7 @@ func TestSeriesToDocumentMinimal(t *testing.T) {
 		UpdatedAt: now,
 	}
 
-	doc := s.seriesToDocument(series, nil, nil, nil, false)
+	doc := s.seriesToDocument(series, nil, nil, nil, nil, false)
 
 	assert.Equal(t, seriesID.String(), doc.ID)
 	assert.Equal(t, "Unknown Show", doc.Title)
@@ -275,7 +275,7 @@ func TestSeriesToDocumentCastLimit(t *testing.T) {
 		}
 	}
 
-	doc := s.seriesToDocument(series, nil, credits, nil, false)
+	doc := s.seriesToDocument(series, nil, nil, credits, nil, false)
 
 	// Should be limited to 20
 	assert.Len(t, doc.Cast, 20)
@@ -298,7 +298,7 @@ func TestSeriesToDocumentWithEmptyCredits(t *testing.T) {
 	credits := []tvshow.SeriesCredit{}
 	networks := []tvshow.Network{}
 
-	doc := s.seriesToDocument(series, genres, credits, networks, false)
+	doc := s.seriesToDocument(series, genres, nil, credits, networks, false)
 
 	assert.Equal(t, seriesID.String(), doc.ID)
 	assert.Empty(t, doc.Genres)
@@ -325,7 +325,7 @@ func TestSeriesToDocumentWithCrewOnly(t *testing.T) {
 		{CreditType: "crew", Name: "Peter Gould", Job: new("Writer")},
 	}
 
-	doc := s.seriesToDocument(series, nil, credits, nil, false)
+	doc := s.seriesToDocument(series, nil, nil, credits, nil, false)
 
 	// No cast members extracted
 	assert.Empty(t, doc.Cast)
@@ -349,7 +349,7 @@ func TestSeriesToDocumentWithZeroValues(t *testing.T) {
 		UpdatedAt:   now,
 	}
 
-	doc := s.seriesToDocument(series, nil, nil, nil, false)
+	doc := s.seriesToDocument(series, nil, nil, nil, nil, false)
 
 	assert.Equal(t, float64(0), doc.VoteAverage)
 	assert.Equal(t, int32(0), doc.VoteCount)
@@ -788,6 +788,64 @@ func (_c *MockUserRepository_HardDeleteUser_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ListMetadataLanguages provides a mock function with given fields: ctx
+func (_m *MockUserRepository) ListMetadataLanguages(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMetadataLanguages")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_ListMetadataLanguages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMetadataLanguages'
+type MockUserRepository_ListMetadataLanguages_Call struct {
+	*mock.Call
+}
+
+// ListMetadataLanguages is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserRepository_Expecter) ListMetadataLanguages(ctx interface{}) *MockUserRepository_ListMetadataLanguages_Call {
+	return &MockUserRepository_ListMetadataLanguages_Call{Call: _e.mock.On("ListMetadataLanguages", ctx)}
+}
+
+func (_c *MockUserRepository_ListMetadataLanguages_Call) Run(run func(ctx context.Context)) *MockUserRepository_ListMetadataLanguages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ListMetadataLanguages_Call) Return(_a0 []string, _a1 error) *MockUserRepository_ListMetadataLanguages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_ListMetadataLanguages_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockUserRepository_ListMetadataLanguages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserAvatars provides a mock function with given fields: ctx, userID, limit, offset
 func (_m *MockUserRepository) ListUserAvatars(ctx context.Context, userID uuid.UUID, limit int32, offset int32) ([]db.SharedUserAvatar, error) {
 	ret := _m.Called(ctx, userID, limit, offset)
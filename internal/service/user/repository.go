@@ -27,6 +27,7 @@ type Repository interface {
 	GetUserPreferences(ctx context.Context, userID uuid.UUID) (*db.SharedUserPreference, error)
 	UpsertUserPreferences(ctx context.Context, params UpsertPreferencesParams) (*db.SharedUserPreference, error)
 	DeleteUserPreferences(ctx context.Context, userID uuid.UUID) error
+	ListMetadataLanguages(ctx context.Context) ([]string, error)
 
 	// User avatars
 	GetCurrentAvatar(ctx context.Context, userID uuid.UUID) (*db.SharedUserAvatar, error)
@@ -93,20 +94,22 @@ type DigestSettings struct {
 
 // UpsertPreferencesParams contains parameters for creating/updating user preferences
 type UpsertPreferencesParams struct {
-	UserID              uuid.UUID
-	EmailNotifications  *json.RawMessage
-	PushNotifications   *json.RawMessage
-	DigestNotifications *json.RawMessage
-	ProfileVisibility   *string
-	ShowEmail           *bool
-	ShowActivity        *bool
-	Theme               *string
-	DisplayLanguage     *string
-	ContentLanguage     *string
-	MetadataLanguage    *string
-	ShowAdultContent    *bool
-	ShowSpoilers        *bool
-	AutoPlayVideos      *bool
+	UserID                uuid.UUID
+	EmailNotifications    *json.RawMessage
+	PushNotifications     *json.RawMessage
+	DigestNotifications   *json.RawMessage
+	ProfileVisibility     *string
+	ShowEmail             *bool
+	ShowActivity          *bool
+	Theme                 *string
+	DisplayLanguage       *string
+	ContentLanguage       *string
+	MetadataLanguage      *string
+	ShowAdultContent      *bool
+	ShowSpoilers          *bool
+	AutoPlayVideos        *bool
+	HiddenTags            []string // nil leaves hidden tags unchanged
+	MetadataLanguageChain []string // nil leaves the fallback chain unchanged
 }
 
 // CreateAvatarParams contains parameters for creating a new avatar
@@ -183,17 +183,19 @@ func (r *postgresRepository) GetUserPreferences(ctx context.Context, userID uuid
 
 func (r *postgresRepository) UpsertUserPreferences(ctx context.Context, params UpsertPreferencesParams) (*db.SharedUserPreference, error) {
 	upsertParams := db.UpsertUserPreferencesParams{
-		UserID:            params.UserID,
-		ProfileVisibility: params.ProfileVisibility,
-		ShowEmail:         params.ShowEmail,
-		ShowActivity:      params.ShowActivity,
-		Theme:             params.Theme,
-		DisplayLanguage:   params.DisplayLanguage,
-		ContentLanguage:   params.ContentLanguage,
-		MetadataLanguage:  params.MetadataLanguage,
-		ShowAdultContent:  params.ShowAdultContent,
-		ShowSpoilers:      params.ShowSpoilers,
-		AutoPlayVideos:    params.AutoPlayVideos,
+		UserID:                params.UserID,
+		ProfileVisibility:     params.ProfileVisibility,
+		ShowEmail:             params.ShowEmail,
+		ShowActivity:          params.ShowActivity,
+		Theme:                 params.Theme,
+		DisplayLanguage:       params.DisplayLanguage,
+		ContentLanguage:       params.ContentLanguage,
+		MetadataLanguage:      params.MetadataLanguage,
+		ShowAdultContent:      params.ShowAdultContent,
+		ShowSpoilers:          params.ShowSpoilers,
+		AutoPlayVideos:        params.AutoPlayVideos,
+		HiddenTags:            params.HiddenTags,
+		MetadataLanguageChain: params.MetadataLanguageChain,
 	}
 
 	// Convert JSONB fields (json.RawMessage is []byte)
@@ -223,6 +225,14 @@ func (r *postgresRepository) DeleteUserPreferences(ctx context.Context, userID u
 	return nil
 }
 
+func (r *postgresRepository) ListMetadataLanguages(ctx context.Context) ([]string, error) {
+	langs, err := r.queries.ListUserMetadataLanguages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user metadata languages: %w", err)
+	}
+	return langs, nil
+}
+
 // ============================================================================
 // User Avatars
 // ============================================================================
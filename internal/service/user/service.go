@@ -10,12 +10,16 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/lusoris/revenge/internal/config"
+	"github.com/lusoris/revenge/internal/content"
 	"github.com/lusoris/revenge/internal/crypto"
 	"github.com/lusoris/revenge/internal/infra/database/db"
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/storage"
 )
 
+// MaxHiddenTags is the maximum number of tags a user can hide.
+const MaxHiddenTags = 100
+
 // Service implements user business logic
 type Service struct {
 	pool           *pgxpool.Pool
@@ -266,9 +270,24 @@ func (s *Service) UpdateUserPreferences(ctx context.Context, params UpsertPrefer
 		return nil, err
 	}
 
+	if params.MetadataLanguageChain != nil {
+		chain, err := content.NormalizeLanguageChain(params.MetadataLanguageChain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata language chain: %w", err)
+		}
+		params.MetadataLanguageChain = chain
+	}
+
 	return s.repo.UpsertUserPreferences(ctx, params)
 }
 
+// MetadataLanguages returns every language referenced by a user's metadata
+// language or translation fallback chain. Metadata refreshes fetch these so
+// each user's chain can be resolved.
+func (s *Service) MetadataLanguages(ctx context.Context) ([]string, error) {
+	return s.repo.ListMetadataLanguages(ctx)
+}
+
 // UpdateNotificationPreferences updates notification settings
 func (s *Service) UpdateNotificationPreferences(ctx context.Context, userID uuid.UUID, email, push, digest *NotificationSettings) error {
 	params := UpsertPreferencesParams{
@@ -529,6 +548,10 @@ func (s *Service) validatePreferences(params UpsertPreferencesParams) error {
 		}
 	}
 
+	if len(params.HiddenTags) > MaxHiddenTags {
+		return fmt.Errorf("too many hidden tags: at most %d allowed", MaxHiddenTags)
+	}
+
 	return nil
 }
 
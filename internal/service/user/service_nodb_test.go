@@ -150,6 +150,10 @@ func (m *mockRepo) DeleteUserPreferences(ctx context.Context, userID uuid.UUID)
 	return nil
 }
 
+func (m *mockRepo) ListMetadataLanguages(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
 func (m *mockRepo) GetCurrentAvatar(ctx context.Context, userID uuid.UUID) (*db.SharedUserAvatar, error) {
 	if m.getCurrentAvatarFn != nil {
 		return m.getCurrentAvatarFn(ctx, userID)
@@ -1055,6 +1059,33 @@ func TestNoDB_UpdateUserPreferences(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid theme")
 	})
 
+	t.Run("metadata language chain is normalized", func(t *testing.T) {
+		t.Parallel()
+		repo := &mockRepo{
+			upsertUserPreferencesFn: func(_ context.Context, params UpsertPreferencesParams) (*db.SharedUserPreference, error) {
+				return &db.SharedUserPreference{UserID: params.UserID, MetadataLanguageChain: params.MetadataLanguageChain}, nil
+			},
+		}
+		svc := newTestService(repo)
+		result, err := svc.UpdateUserPreferences(ctx, UpsertPreferencesParams{
+			UserID:                userID,
+			MetadataLanguageChain: []string{"de_at", "de", "Original", "en", "de"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"de-AT", "de", "original", "en"}, result.MetadataLanguageChain)
+	})
+
+	t.Run("invalid metadata language chain", func(t *testing.T) {
+		t.Parallel()
+		svc := newTestService(&mockRepo{})
+		_, err := svc.UpdateUserPreferences(ctx, UpsertPreferencesParams{
+			UserID:                userID,
+			MetadataLanguageChain: []string{"de", "austrian"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid metadata language chain")
+	})
+
 	t.Run("valid profile visibility", func(t *testing.T) {
 		t.Parallel()
 		visibilities := []string{"public", "friends", "private"}
@@ -33,6 +33,7 @@ import (
 	"github.com/lusoris/revenge/internal/service/activity"
 	"github.com/lusoris/revenge/internal/service/analytics"
 	"github.com/lusoris/revenge/internal/service/apikeys"
+	"github.com/lusoris/revenge/internal/service/artwork"
 	"github.com/lusoris/revenge/internal/service/auth"
 	"github.com/lusoris/revenge/internal/service/email"
 	"github.com/lusoris/revenge/internal/service/library"
@@ -41,6 +42,7 @@ import (
 	"github.com/lusoris/revenge/internal/service/mfa"
 	"github.com/lusoris/revenge/internal/service/notification"
 	"github.com/lusoris/revenge/internal/service/oidc"
+	"github.com/lusoris/revenge/internal/service/people"
 	"github.com/lusoris/revenge/internal/service/rbac"
 	searchsvc "github.com/lusoris/revenge/internal/service/search"
 	"github.com/lusoris/revenge/internal/service/session"
@@ -148,6 +150,8 @@ func setupServer(t *testing.T) *TestServer {
 		storage.Module,
 		library.Module,
 		searchsvc.Module,
+		artwork.Module,
+		people.Module,
 
 		// Content modules
 		movie.Module,